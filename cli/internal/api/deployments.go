@@ -39,6 +39,7 @@ func NewDeploymentsClient(options *DeploymentsClientOptions) *DeploymentsClient
 		h.Redeploy(),
 		h.ListDeployments(),
 		h.GetDeploymentLogs(),
+		h.DiffDeployments(),
 	)
 
 	return &DeploymentsClient{client: client}
@@ -47,6 +48,7 @@ func NewDeploymentsClient(options *DeploymentsClientOptions) *DeploymentsClient
 type CreateDeploymentRequest struct {
 	APIKey          secret.Secret
 	NonBlocking     bool
+	FailOnBreaking  bool
 	ProjectSlug     string
 	IdempotencyKey  string
 	OpenAPIv3Assets []*deployments.AddOpenAPIv3DeploymentAssetForm
@@ -65,6 +67,7 @@ func (c *DeploymentsClient) CreateDeployment(
 	payload := &deployments.CreateDeploymentPayload{
 		ApikeyToken:      &key,
 		NonBlocking:      &req.NonBlocking,
+		FailOnBreaking:   &req.FailOnBreaking,
 		ProjectSlugInput: &req.ProjectSlug,
 		IdempotencyKey:   req.IdempotencyKey,
 		Openapiv3Assets:  req.OpenAPIv3Assets,
@@ -176,6 +179,7 @@ func (c *DeploymentsClient) GetActiveDeployment(
 type EvolveRequest struct {
 	OpenAPIv3Assets []*deployments.AddOpenAPIv3DeploymentAssetForm
 	NonBlocking     bool
+	FailOnBreaking  bool
 	Functions       []*deployments.AddFunctionsForm
 	APIKey          secret.Secret
 	DeploymentID    *string
@@ -191,6 +195,7 @@ func (c *DeploymentsClient) Evolve(
 	result, err := c.client.Evolve(ctx, &deployments.EvolvePayload{
 		ApikeyToken:            &key,
 		NonBlocking:            &req.NonBlocking,
+		FailOnBreaking:         &req.FailOnBreaking,
 		ProjectSlugInput:       &req.ProjectSlug,
 		DeploymentID:           req.DeploymentID,
 		UpsertOpenapiv3Assets:  req.OpenAPIv3Assets,
@@ -230,3 +235,27 @@ func (c *DeploymentsClient) Redeploy(
 
 	return result.Deployment, nil
 }
+
+// DiffDeployments compares the tools of two deployments and classifies each
+// change as additive or breaking.
+func (c *DeploymentsClient) DiffDeployments(
+	ctx context.Context,
+	apiKey secret.Secret,
+	projectSlug string,
+	fromID string,
+	toID string,
+) (*deployments.DiffDeploymentsResult, error) {
+	key := apiKey.Reveal()
+	result, err := c.client.DiffDeployments(ctx, &deployments.DiffDeploymentsPayload{
+		ApikeyToken:      &key,
+		ProjectSlugInput: &projectSlug,
+		FromID:           fromID,
+		ToID:             toID,
+		SessionToken:     nil,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("api error: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/speakeasy-api/gram/cli/internal/secret"
+	recordings_client "github.com/speakeasy-api/gram/server/gen/http/tool_call_recordings/client"
+	"github.com/speakeasy-api/gram/server/gen/tool_call_recordings"
+	"github.com/speakeasy-api/gram/server/gen/types"
+	goahttp "goa.design/goa/v3/http"
+)
+
+type ToolCallRecordingsClientOptions struct {
+	Scheme string
+	Host   string
+}
+
+type ToolCallRecordingsClient struct {
+	client *toolcallrecordings.Client
+}
+
+func NewToolCallRecordingsClient(options *ToolCallRecordingsClientOptions) *ToolCallRecordingsClient {
+	doer := goaSharedHTTPClient
+
+	enc := goahttp.RequestEncoder
+	dec := goahttp.ResponseDecoder
+	restoreBody := true // Enable body restoration to allow reading raw response on decode errors
+
+	h := recordings_client.NewClient(options.Scheme, options.Host, doer, enc, dec, restoreBody)
+
+	client := toolcallrecordings.NewClient(
+		h.StartToolCallRecording(),
+		h.StopToolCallRecording(),
+		h.ListToolCallRecordingSessions(),
+		h.ExportToolCallRecordings(),
+		h.ReplayToolCallRecordings(),
+	)
+
+	return &ToolCallRecordingsClient{client: client}
+}
+
+// Start begins recording tool calls made against a toolset.
+func (c *ToolCallRecordingsClient) Start(
+	ctx context.Context,
+	apiKey secret.Secret,
+	projectSlug string,
+	toolsetSlug string,
+	maxCalls int32,
+	durationMinutes int32,
+) (*types.ToolCallRecordingSession, error) {
+	key := apiKey.Reveal()
+	result, err := c.client.StartToolCallRecording(ctx, &toolcallrecordings.StartToolCallRecordingPayload{
+		ApikeyToken:      &key,
+		ProjectSlugInput: &projectSlug,
+		SessionToken:     nil,
+		ToolsetSlug:      types.Slug(toolsetSlug),
+		MaxCalls:         maxCalls,
+		DurationMinutes:  durationMinutes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("api error: %w", err)
+	}
+
+	return result, nil
+}
+
+// Stop ends a recording session.
+func (c *ToolCallRecordingsClient) Stop(
+	ctx context.Context,
+	apiKey secret.Secret,
+	projectSlug string,
+	sessionID string,
+) (*types.ToolCallRecordingSession, error) {
+	key := apiKey.Reveal()
+	result, err := c.client.StopToolCallRecording(ctx, &toolcallrecordings.StopToolCallRecordingPayload{
+		ApikeyToken:      &key,
+		ProjectSlugInput: &projectSlug,
+		SessionToken:     nil,
+		ID:               sessionID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("api error: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListSessions lists the most recent recording sessions, optionally for a
+// single toolset.
+func (c *ToolCallRecordingsClient) ListSessions(
+	ctx context.Context,
+	apiKey secret.Secret,
+	projectSlug string,
+	toolsetSlug string,
+) ([]*types.ToolCallRecordingSession, error) {
+	key := apiKey.Reveal()
+	payload := &toolcallrecordings.ListToolCallRecordingSessionsPayload{
+		ApikeyToken:      &key,
+		ProjectSlugInput: &projectSlug,
+		SessionToken:     nil,
+		ToolsetSlug:      nil,
+	}
+	if toolsetSlug != "" {
+		slug := types.Slug(toolsetSlug)
+		payload.ToolsetSlug = &slug
+	}
+
+	result, err := c.client.ListToolCallRecordingSessions(ctx, payload)
+	if err != nil {
+		return nil, fmt.Errorf("api error: %w", err)
+	}
+
+	return result.Sessions, nil
+}
+
+// Export downloads the tool calls captured by a recording session.
+func (c *ToolCallRecordingsClient) Export(
+	ctx context.Context,
+	apiKey secret.Secret,
+	projectSlug string,
+	sessionID string,
+) (*toolcallrecordings.ExportToolCallRecordingsResult, error) {
+	key := apiKey.Reveal()
+	result, err := c.client.ExportToolCallRecordings(ctx, &toolcallrecordings.ExportToolCallRecordingsPayload{
+		ApikeyToken:      &key,
+		ProjectSlugInput: &projectSlug,
+		SessionToken:     nil,
+		SessionID:        sessionID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("api error: %w", err)
+	}
+
+	return result, nil
+}
+
+// Replay replays a recording session against a deployment. An empty
+// deploymentID replays against the latest deployment.
+func (c *ToolCallRecordingsClient) Replay(
+	ctx context.Context,
+	apiKey secret.Secret,
+	projectSlug string,
+	sessionID string,
+	deploymentID string,
+) (*toolcallrecordings.ReplayToolCallRecordingsResult, error) {
+	key := apiKey.Reveal()
+	payload := &toolcallrecordings.ReplayToolCallRecordingsPayload{
+		ApikeyToken:      &key,
+		ProjectSlugInput: &projectSlug,
+		SessionToken:     nil,
+		SessionID:        sessionID,
+		DeploymentID:     nil,
+	}
+	if deploymentID != "" {
+		payload.DeploymentID = &deploymentID
+	}
+
+	result, err := c.client.ReplayToolCallRecordings(ctx, payload)
+	if err != nil {
+		return nil, fmt.Errorf("api error: %w", err)
+	}
+
+	return result, nil
+}
@@ -43,6 +43,8 @@ func NewToolsetsClient(options *ToolsetsClientOptions) *ToolsetsClient {
 		h.RemoveOAuthServer(),
 		h.SetUserSessionIssuer(),
 		h.SetToolVariationsGroup(),
+		h.DiffToolsetVersions(),
+		h.RevertToolset(),
 	)
 
 	return &ToolsetsClient{client: client}
@@ -36,6 +36,8 @@ func newApp() *cli.App {
 			newInstallCommand(),
 			newUpdateCommand(),
 			newRedeployCommand(),
+			newDiffCommand(),
+			newRecordingsCommand(),
 		},
 		Flags: []cli.Flag{
 			flags.APIKey(),
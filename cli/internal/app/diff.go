@@ -0,0 +1,161 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/speakeasy-api/gram/cli/internal/app/logging"
+	"github.com/speakeasy-api/gram/cli/internal/flags"
+	"github.com/speakeasy-api/gram/cli/internal/profile"
+	"github.com/speakeasy-api/gram/cli/internal/workflow"
+	"github.com/speakeasy-api/gram/server/gen/deployments"
+	"github.com/urfave/cli/v2"
+)
+
+// ErrBreakingChanges is returned when a deployment removes or narrows what
+// callers of its tools rely on and the caller asked to fail on that.
+var ErrBreakingChanges = errors.New("deployment introduces breaking changes")
+
+func newDiffCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "diff",
+		Usage: "Compare the tools of two deployments",
+		Description: `
+Compare the tools of two deployments and report what changed.
+
+Each change is classified as additive or breaking. Removing a tool, adding a
+required argument or narrowing an enum are breaking; adding tools or optional
+arguments is additive.
+
+If --from is not provided, the active deployment is used. If --to is not
+provided, the latest deployment is used.`,
+		Flags: []cli.Flag{
+			flags.APIEndpoint(),
+			flags.APIKey(),
+			flags.Project(),
+			flags.Org(),
+			&cli.StringFlag{
+				Name:  "from",
+				Usage: "The deployment ID to compare from (if not provided, uses the active deployment)",
+			},
+			&cli.StringFlag{
+				Name:  "to",
+				Usage: "The deployment ID to compare to (if not provided, uses the latest deployment)",
+			},
+			&cli.BoolFlag{
+				Name:    "fail-on-breaking",
+				Usage:   "Exit with an error if any change is breaking",
+				EnvVars: []string{"GRAM_FAIL_ON_BREAKING"},
+			},
+			flags.JSON(),
+		},
+		Action: func(c *cli.Context) error {
+			ctx, cancel := signal.NotifyContext(c.Context, os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			logger := logging.PullLogger(ctx)
+			prof := profile.FromContext(ctx)
+
+			workflowParams, err := workflow.ResolveParams(c, prof)
+			if err != nil {
+				return fmt.Errorf("failed to resolve workflow params: %w", err)
+			}
+
+			fromID := c.String("from")
+			if fromID == "" {
+				active := workflow.New(ctx, logger, workflowParams).LoadActiveDeployment(ctx)
+				if active.Failed() {
+					return fmt.Errorf("failed to resolve deployment to compare from: %w", active.Err)
+				}
+				if active.Deployment == nil {
+					return fmt.Errorf("no active deployment to compare from: provide --from")
+				}
+				fromID = active.Deployment.ID
+			}
+
+			toID := c.String("to")
+			if toID == "" {
+				latest := workflow.New(ctx, logger, workflowParams).LoadLatestDeployment(ctx)
+				if latest.Failed() {
+					return fmt.Errorf("failed to resolve deployment to compare to: %w", latest.Err)
+				}
+				if latest.Deployment == nil {
+					return fmt.Errorf("no deployment to compare to: provide --to")
+				}
+				toID = latest.Deployment.ID
+			}
+
+			result := workflow.New(ctx, logger, workflowParams).DiffDeployments(ctx, fromID, toID)
+			if result.Failed() {
+				return fmt.Errorf("failed to compare deployments: %w", result.Err)
+			}
+
+			if c.Bool("json") {
+				if err := printDeploymentDiffJSON(result.Diff); err != nil {
+					return err
+				}
+			} else {
+				printDeploymentDiff(result.Diff)
+			}
+
+			if c.Bool("fail-on-breaking") && result.Diff.HasBreakingChanges {
+				return ErrBreakingChanges
+			}
+
+			return nil
+		},
+	}
+}
+
+func printDeploymentDiff(diff *deployments.DiffDeploymentsResult) {
+	fmt.Printf("Deployment Diff\n")
+	fmt.Printf("===============\n\n")
+
+	fmt.Printf("From:     %s\n", diff.FromDeploymentID)
+	fmt.Printf("To:       %s\n", diff.ToDeploymentID)
+	fmt.Printf("Added:    %d tools\n", diff.ToolsAdded)
+	fmt.Printf("Removed:  %d tools\n", diff.ToolsRemoved)
+	fmt.Printf("Changed:  %d tools\n", diff.ToolsChanged)
+	fmt.Printf("Breaking: %d changes\n", diff.BreakingChanges)
+
+	if len(diff.Changes) == 0 {
+		fmt.Printf("\nNo changes\n")
+		return
+	}
+
+	currentTool := ""
+	for _, change := range diff.Changes {
+		if change.ToolUrn != currentTool {
+			currentTool = change.ToolUrn
+			fmt.Printf("\n%s (%s)\n", change.ToolName, change.ToolUrn)
+		}
+
+		marker := "+"
+		if change.Severity == "breaking" {
+			marker = "!"
+		}
+
+		if change.Path != nil {
+			fmt.Printf("  %s [%s] %s: %s\n", marker, change.Kind, *change.Path, change.Description)
+		} else {
+			fmt.Printf("  %s [%s] %s\n", marker, change.Kind, change.Description)
+		}
+	}
+
+	if diff.HasBreakingChanges {
+		fmt.Printf("\nChanges marked ! can break existing callers.\n")
+	}
+}
+
+func printDeploymentDiffJSON(diff *deployments.DiffDeploymentsResult) error {
+	jsonData, err := json.MarshalIndent(diff, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal deployment diff to JSON: %w", err)
+	}
+	fmt.Println(string(jsonData))
+	return nil
+}
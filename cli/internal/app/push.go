@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/url"
@@ -17,6 +18,7 @@ import (
 	"github.com/speakeasy-api/gram/cli/internal/profile"
 	"github.com/speakeasy-api/gram/cli/internal/secret"
 	"github.com/speakeasy-api/gram/cli/internal/workflow"
+	"github.com/speakeasy-api/gram/server/gen/deployments"
 	"github.com/urfave/cli/v2"
 )
 
@@ -30,12 +32,20 @@ type PushOptions struct {
 	NonBlocking    bool
 	APIKey         string
 	APIURL         string
+	// FailOnBreaking compares the new deployment with the active one before
+	// it is activated and fails the push, leaving the active deployment
+	// serving, when a change breaks existing callers.
+	FailOnBreaking bool
+	// AllowBreaking acknowledges breaking changes so FailOnBreaking activates
+	// the deployment and only reports them.
+	AllowBreaking bool
 }
 
 type PushResult struct {
 	DeploymentID string
 	Status       string
 	LogsURL      string
+	Diff         *deployments.DiffDeploymentsResult
 }
 
 func DoPush(ctx context.Context, opts PushOptions) (*PushResult, error) {
@@ -51,6 +61,9 @@ func DoPush(ctx context.Context, opts PushOptions) (*PushResult, error) {
 	if opts.ConfigFile == "" {
 		return nil, fmt.Errorf("config file is required")
 	}
+	if opts.FailOnBreaking && opts.NonBlocking {
+		return nil, fmt.Errorf("checking for breaking changes requires waiting for the deployment to complete")
+	}
 
 	apiKey := secret.Secret(opts.APIKey)
 	if apiKey == "" && prof != nil {
@@ -123,8 +136,26 @@ func DoPush(ctx context.Context, opts PushOptions) (*PushResult, error) {
 		slog.String("config", opts.ConfigFile),
 	)
 
-	result := workflow.New(ctx, logger, workflowParams).
-		UploadAssets(ctx, config.Sources)
+	// The diff baseline is whatever was serving before this push, so it has
+	// to be captured before the new deployment can become active.
+	var previousID string
+	if opts.FailOnBreaking {
+		active := workflow.New(ctx, logger, workflowParams).LoadActiveDeployment(ctx)
+		if active.Failed() {
+			return nil, fmt.Errorf("failed to load active deployment: %w", active.Err)
+		}
+		if active.Deployment != nil {
+			previousID = active.Deployment.ID
+		}
+	}
+
+	// With --allow-breaking the server activates the deployment regardless and
+	// the breaking changes are only reported once it completes.
+	gateOnBreaking := opts.FailOnBreaking && !opts.AllowBreaking
+
+	result := workflow.New(ctx, logger, workflowParams)
+	result.FailOnBreaking = gateOnBreaking
+	result = result.UploadAssets(ctx, config.Sources)
 
 	deployTicker := time.NewTicker(time.Second)
 	done := make(chan struct{})
@@ -176,13 +207,43 @@ func DoPush(ctx context.Context, opts PushOptions) (*PushResult, error) {
 
 	logsURL := fmt.Sprintf("%s://%s/%s/%s/deployments/%s", apiURL.Scheme, apiURL.Host, orgSlug, projectSlug, result.Deployment.ID)
 
-	return &PushResult{
+	pushResult := &PushResult{
 		DeploymentID: result.Deployment.ID,
 		Status:       result.Deployment.Status,
 		LogsURL:      logsURL,
-	}, nil
+		Diff:         nil,
+	}
+
+	if !opts.FailOnBreaking || previousID == "" || previousID == result.Deployment.ID {
+		return pushResult, nil
+	}
+
+	// The server compares the deployment with the active one before
+	// activating it, so a failed deployment may have been held back because of
+	// breaking changes. The diff tells which.
+	heldBack := gateOnBreaking && result.Deployment.Status == "failed"
+	reportOnly := opts.AllowBreaking && result.Deployment.Status == "completed"
+	if !heldBack && !reportOnly {
+		return pushResult, nil
+	}
+
+	result.DiffDeployments(ctx, previousID, result.Deployment.ID)
+	if result.Failed() {
+		return pushResult, fmt.Errorf("%w: %w", errBreakingCheck, result.Err)
+	}
+	pushResult.Diff = result.Diff
+
+	if result.Diff.HasBreakingChanges && gateOnBreaking {
+		return pushResult, ErrBreakingChanges
+	}
+
+	return pushResult, nil
 }
 
+// errBreakingCheck marks a failure to compare deployments when the caller
+// asked to fail on breaking changes.
+var errBreakingCheck = errors.New("check for breaking changes")
+
 func newPushCommand() *cli.Command {
 	return &cli.Command{
 		Name:  "push",
@@ -237,6 +298,16 @@ NOTE: Names and slugs must be unique across all sources.`[1:],
 				Usage: "Skip polling for deployment completion and return immediately",
 				Value: false,
 			},
+			&cli.BoolFlag{
+				Name:    "fail-on-breaking",
+				Usage:   "Compare the new deployment with the active one before activating it and exit with an error, without activating it, if it introduces breaking changes",
+				EnvVars: []string{"GRAM_FAIL_ON_BREAKING"},
+			},
+			&cli.BoolFlag{
+				Name:    "allow-breaking",
+				Usage:   "Activate the deployment and report breaking changes found by --fail-on-breaking without failing",
+				EnvVars: []string{"GRAM_ALLOW_BREAKING"},
+			},
 		},
 		Action: func(c *cli.Context) error {
 			ctx, cancel := signal.NotifyContext(c.Context, os.Interrupt, syscall.SIGTERM)
@@ -254,8 +325,20 @@ NOTE: Names and slugs must be unique across all sources.`[1:],
 				NonBlocking:    c.Bool("skip-poll"),
 				APIKey:         c.String("api-key"),
 				APIURL:         c.String("api-url"),
+				FailOnBreaking: c.Bool("fail-on-breaking"),
+				AllowBreaking:  c.Bool("allow-breaking"),
 			})
 
+			if errors.Is(err, ErrBreakingChanges) {
+				printDeploymentDiff(result.Diff)
+				fmt.Printf("\nThe deployment was not activated. View deployment: %s\n", result.LogsURL)
+				fmt.Printf("Re-run with --allow-breaking to accept these changes.\n")
+				return err
+			}
+			if errors.Is(err, errBreakingCheck) {
+				return err
+			}
+
 			if err != nil {
 				if result != nil && result.DeploymentID != "" {
 					statusCommand := fmt.Sprintf("gram status --id %s", result.DeploymentID)
@@ -276,6 +359,10 @@ NOTE: Names and slugs must be unique across all sources.`[1:],
 			switch result.Status {
 			case "completed":
 				logger.InfoContext(ctx, "Deployment succeeded", slogID, slog.String("logs_url", logsURL))
+				if result.Diff != nil && result.Diff.HasBreakingChanges {
+					logger.WarnContext(ctx, "Deployment introduces breaking changes", slogID, slog.Int("breaking_changes", result.Diff.BreakingChanges))
+					printDeploymentDiff(result.Diff)
+				}
 				fmt.Printf("\nView deployment: %s\n", logsURL)
 				return nil
 			case "failed":
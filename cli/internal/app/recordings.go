@@ -0,0 +1,395 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/speakeasy-api/gram/cli/internal/api"
+	"github.com/speakeasy-api/gram/cli/internal/flags"
+	"github.com/speakeasy-api/gram/cli/internal/profile"
+	"github.com/speakeasy-api/gram/cli/internal/workflow"
+	"github.com/speakeasy-api/gram/server/gen/tool_call_recordings"
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/urfave/cli/v2"
+)
+
+// ErrReplayRegressions is returned when a replayed tool call no longer
+// matches its recording.
+var ErrReplayRegressions = errors.New("replayed tool calls differ from their recordings")
+
+func newRecordingsCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "recordings",
+		Usage: "Record tool calls and replay them as regression tests",
+		Description: `
+Record real tool calls made against a toolset, then replay them against a new
+deployment to catch regressions.
+
+Recordings capture each call's arguments, the upstream HTTP requests it made,
+the responses it received and the result it returned, with secrets masked.
+Replays answer upstream requests from the recorded responses, so they never
+contact your APIs.`,
+		Subcommands: []*cli.Command{
+			newRecordingsStartCommand(),
+			newRecordingsStopCommand(),
+			newRecordingsListCommand(),
+			newRecordingsExportCommand(),
+			newRecordingsReplayCommand(),
+		},
+	}
+}
+
+func recordingsFlags(extra ...cli.Flag) []cli.Flag {
+	return append([]cli.Flag{
+		flags.APIEndpoint(),
+		flags.APIKey(),
+		flags.Project(),
+		flags.Org(),
+	}, extra...)
+}
+
+// recordingsClient resolves the caller's profile and builds a client for the
+// recordings API.
+func recordingsClient(c *cli.Context) (*api.ToolCallRecordingsClient, workflow.Params, error) {
+	params, err := workflow.ResolveParams(c, profile.FromContext(c.Context))
+	if err != nil {
+		return nil, params, fmt.Errorf("failed to resolve workflow params: %w", err)
+	}
+	if err := params.Validate(); err != nil {
+		return nil, params, err
+	}
+
+	client := api.NewToolCallRecordingsClient(&api.ToolCallRecordingsClientOptions{
+		Scheme: params.APIURL.Scheme,
+		Host:   params.APIURL.Host,
+	})
+
+	return client, params, nil
+}
+
+func newRecordingsStartCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "start",
+		Usage:     "Start recording tool calls made against a toolset",
+		ArgsUsage: "<toolset-slug>",
+		Flags: recordingsFlags(
+			&cli.IntFlag{
+				Name:  "max-calls",
+				Usage: "Stop recording after this many tool calls",
+				Value: 100,
+			},
+			&cli.IntFlag{
+				Name:  "duration",
+				Usage: "Stop recording after this many minutes",
+				Value: 60,
+			},
+			flags.JSON(),
+		),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := signal.NotifyContext(c.Context, os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			toolsetSlug := c.Args().First()
+			if toolsetSlug == "" {
+				return fmt.Errorf("a toolset slug is required")
+			}
+
+			client, params, err := recordingsClient(c)
+			if err != nil {
+				return err
+			}
+
+			session, err := client.Start(ctx, params.APIKey, params.ProjectSlug, toolsetSlug, int32(c.Int("max-calls")), int32(c.Int("duration")))
+			if err != nil {
+				return fmt.Errorf("failed to start recording: %w", err)
+			}
+
+			if c.Bool("json") {
+				return printRecordingsJSON(session)
+			}
+
+			fmt.Printf("Recording toolset %s\n\n", toolsetSlug)
+			printRecordingSession(session)
+			return nil
+		},
+	}
+}
+
+func newRecordingsStopCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "stop",
+		Usage:     "Stop a recording session",
+		ArgsUsage: "<session-id>",
+		Flags:     recordingsFlags(flags.JSON()),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := signal.NotifyContext(c.Context, os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			sessionID := c.Args().First()
+			if sessionID == "" {
+				return fmt.Errorf("a recording session ID is required")
+			}
+
+			client, params, err := recordingsClient(c)
+			if err != nil {
+				return err
+			}
+
+			session, err := client.Stop(ctx, params.APIKey, params.ProjectSlug, sessionID)
+			if err != nil {
+				return fmt.Errorf("failed to stop recording: %w", err)
+			}
+
+			if c.Bool("json") {
+				return printRecordingsJSON(session)
+			}
+
+			printRecordingSession(session)
+			return nil
+		},
+	}
+}
+
+func newRecordingsListCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "list",
+		Usage: "List recent recording sessions",
+		Flags: recordingsFlags(
+			&cli.StringFlag{
+				Name:  "toolset",
+				Usage: "Only list sessions recording this toolset",
+			},
+			flags.JSON(),
+		),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := signal.NotifyContext(c.Context, os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			client, params, err := recordingsClient(c)
+			if err != nil {
+				return err
+			}
+
+			sessions, err := client.ListSessions(ctx, params.APIKey, params.ProjectSlug, c.String("toolset"))
+			if err != nil {
+				return fmt.Errorf("failed to list recording sessions: %w", err)
+			}
+
+			if c.Bool("json") {
+				return printRecordingsJSON(sessions)
+			}
+
+			if len(sessions) == 0 {
+				fmt.Println("No recording sessions found")
+				return nil
+			}
+			for i, session := range sessions {
+				if i > 0 {
+					fmt.Println()
+				}
+				printRecordingSession(session)
+			}
+			return nil
+		},
+	}
+}
+
+func newRecordingsExportCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "export",
+		Usage:     "Export the tool calls captured by a recording session as JSON",
+		ArgsUsage: "<session-id>",
+		Flags: recordingsFlags(
+			&cli.StringFlag{
+				Name:    "output",
+				Aliases: []string{"o"},
+				Usage:   "Write the export to this file instead of stdout",
+			},
+		),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := signal.NotifyContext(c.Context, os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			sessionID := c.Args().First()
+			if sessionID == "" {
+				return fmt.Errorf("a recording session ID is required")
+			}
+
+			client, params, err := recordingsClient(c)
+			if err != nil {
+				return err
+			}
+
+			export, err := client.Export(ctx, params.APIKey, params.ProjectSlug, sessionID)
+			if err != nil {
+				return fmt.Errorf("failed to export recordings: %w", err)
+			}
+
+			jsonData, err := json.MarshalIndent(export, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal recordings to JSON: %w", err)
+			}
+
+			output := c.String("output")
+			if output == "" {
+				fmt.Println(string(jsonData))
+				return nil
+			}
+
+			if err := os.WriteFile(output, append(jsonData, '\n'), 0o600); err != nil {
+				return fmt.Errorf("failed to write recordings to %s: %w", output, err)
+			}
+			fmt.Printf("Exported %d recorded tool calls to %s\n", len(export.Recordings), output)
+			return nil
+		},
+	}
+}
+
+func newRecordingsReplayCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "replay",
+		Usage:     "Replay a recording session against a deployment",
+		ArgsUsage: "<session-id>",
+		Description: `
+Replay the tool calls captured by a recording session against a deployment and
+report how each result differs from its recording.
+
+Differences are classified as status, schema (a field changed type, appeared or
+disappeared) or content (a value changed). The command exits with an error when
+any replayed call fails or errors, so it can gate a CI pipeline.
+
+If --deployment is not provided, the latest deployment is used.`,
+		Flags: recordingsFlags(
+			&cli.StringFlag{
+				Name:  "deployment",
+				Usage: "The deployment ID to replay against (if not provided, uses the latest deployment)",
+			},
+			&cli.BoolFlag{
+				Name:  "allow-content-changes",
+				Usage: "Only fail on status and schema differences",
+			},
+			flags.JSON(),
+		),
+		Action: func(c *cli.Context) error {
+			ctx, cancel := signal.NotifyContext(c.Context, os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			sessionID := c.Args().First()
+			if sessionID == "" {
+				return fmt.Errorf("a recording session ID is required")
+			}
+
+			client, params, err := recordingsClient(c)
+			if err != nil {
+				return err
+			}
+
+			result, err := client.Replay(ctx, params.APIKey, params.ProjectSlug, sessionID, c.String("deployment"))
+			if err != nil {
+				return fmt.Errorf("failed to replay recordings: %w", err)
+			}
+
+			if c.Bool("json") {
+				if err := printRecordingsJSON(result); err != nil {
+					return err
+				}
+			} else {
+				printReplayResult(result)
+			}
+
+			if hasReplayRegressions(result, c.Bool("allow-content-changes")) {
+				return ErrReplayRegressions
+			}
+
+			return nil
+		},
+	}
+}
+
+// hasReplayRegressions reports whether a replay should fail the command.
+// Errored replays always do; failed ones do unless every difference is a
+// content change and content changes are allowed.
+func hasReplayRegressions(result *toolcallrecordings.ReplayToolCallRecordingsResult, allowContentChanges bool) bool {
+	if result.Errored > 0 {
+		return true
+	}
+	if !allowContentChanges {
+		return result.Failed > 0
+	}
+
+	for _, replay := range result.Replays {
+		if replay.Status != "failed" {
+			continue
+		}
+		if len(replay.Differences) == 0 {
+			return true
+		}
+		for _, diff := range replay.Differences {
+			if diff.Kind != "content" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func printRecordingSession(session *types.ToolCallRecordingSession) {
+	status := "stopped"
+	if session.Active {
+		status = "recording"
+	}
+
+	fmt.Printf("Session:  %s\n", session.ID)
+	fmt.Printf("Status:   %s\n", status)
+	fmt.Printf("Recorded: %d of %d calls\n", session.RecordedCalls, session.MaxCalls)
+	fmt.Printf("Started:  %s\n", session.CreatedAt)
+	if session.StoppedAt != nil {
+		fmt.Printf("Stopped:  %s\n", *session.StoppedAt)
+	} else {
+		fmt.Printf("Expires:  %s\n", session.ExpiresAt)
+	}
+}
+
+func printReplayResult(result *toolcallrecordings.ReplayToolCallRecordingsResult) {
+	fmt.Printf("Replay\n")
+	fmt.Printf("======\n\n")
+
+	fmt.Printf("Session:    %s\n", result.SessionID)
+	fmt.Printf("Deployment: %s\n", result.DeploymentID)
+	fmt.Printf("Passed:     %d\n", result.Passed)
+	fmt.Printf("Failed:     %d\n", result.Failed)
+	fmt.Printf("Skipped:    %d\n", result.Skipped)
+	fmt.Printf("Errored:    %d\n", result.Errored)
+
+	for _, replay := range result.Replays {
+		if replay.Status == "passed" {
+			continue
+		}
+
+		fmt.Printf("\n%s %s (%s)\n", replay.Status, replay.ToolName, replay.RecordingID)
+		if replay.Reason != nil {
+			fmt.Printf("  %s\n", *replay.Reason)
+		}
+		for _, diff := range replay.Differences {
+			if diff.Path != nil {
+				fmt.Printf("  [%s] %s: %s\n", diff.Kind, *diff.Path, diff.Description)
+			} else {
+				fmt.Printf("  [%s] %s\n", diff.Kind, diff.Description)
+			}
+		}
+	}
+}
+
+func printRecordingsJSON(v any) error {
+	jsonData, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal to JSON: %w", err)
+	}
+	fmt.Println(string(jsonData))
+	return nil
+}
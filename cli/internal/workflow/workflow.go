@@ -47,7 +47,11 @@ type Workflow struct {
 	NewFunctionAssets []*deployments.AddFunctionsForm
 	Deployment        *types.Deployment
 	Toolsets          []*types.ToolsetEntry
-	Err               error
+	Diff              *deployments.DiffDeploymentsResult
+	// FailOnBreaking asks the server to fail a new deployment instead of
+	// activating it when it breaks the active deployment's callers.
+	FailOnBreaking bool
+	Err            error
 }
 
 // Fail indicates an unexpected error and halts execution.
@@ -76,6 +80,8 @@ func New(
 		NewFunctionAssets: nil,
 		Deployment:        nil,
 		Toolsets:          nil,
+		Diff:              nil,
+		FailOnBreaking:    false,
 		Err:               nil,
 	}
 
@@ -172,6 +178,7 @@ func (s *Workflow) EvolveDeployment(
 	evolved, err := s.DeploymentsClient.Evolve(ctx, api.EvolveRequest{
 		OpenAPIv3Assets: s.NewOpenAPIAssets,
 		NonBlocking:     false,
+		FailOnBreaking:  s.FailOnBreaking,
 		Functions:       s.NewFunctionAssets,
 		APIKey:          s.Params.APIKey,
 		DeploymentID:    nil,
@@ -204,6 +211,7 @@ func (s *Workflow) CreateDeployment(
 	createReq := api.CreateDeploymentRequest{
 		APIKey:          s.Params.APIKey,
 		NonBlocking:     false,
+		FailOnBreaking:  s.FailOnBreaking,
 		IdempotencyKey:  idem,
 		OpenAPIv3Assets: s.NewOpenAPIAssets,
 		Functions:       s.NewFunctionAssets,
@@ -312,6 +320,31 @@ func (s *Workflow) RedeployDeployment(ctx context.Context) *Workflow {
 	return s
 }
 
+// DiffDeployments compares the tools of two deployments.
+func (s *Workflow) DiffDeployments(
+	ctx context.Context,
+	fromID string,
+	toID string,
+) *Workflow {
+	if s.Failed() {
+		return s
+	}
+
+	result, err := s.DeploymentsClient.DiffDeployments(
+		ctx,
+		s.Params.APIKey,
+		s.Params.ProjectSlug,
+		fromID,
+		toID,
+	)
+	if err != nil {
+		return s.Fail(fmt.Errorf("diff deployments '%s' and '%s': %w", fromID, toID, err))
+	}
+
+	s.Diff = result
+	return s
+}
+
 func (s *Workflow) ListToolsets(ctx context.Context) *Workflow {
 	if s.Failed() {
 		return s
@@ -21,6 +21,8 @@ export const AUDIT_ACTIONS = [
   "api_key:revoke",
   "asset:create",
   "assistant:tool_call",
+  "audit_log_export:delete",
+  "audit_log_export:upsert",
   "aws_iam_credential:create",
   "aws_iam_credential:delete",
   "aws_iam_credential:update",
@@ -66,6 +68,10 @@ export const AUDIT_ACTIONS = [
   "litellm_instance:create",
   "litellm_instance:revoke",
   "litellm_instance:rotate_key",
+  "mcp-endpoint:canary-abort",
+  "mcp-endpoint:canary-promote",
+  "mcp-endpoint:canary-start",
+  "mcp-endpoint:canary-update",
   "mcp-endpoint:create",
   "mcp-endpoint:delete",
   "mcp-endpoint:update",
@@ -96,6 +102,8 @@ export const AUDIT_ACTIONS = [
   "openrouter-key:disable",
   "openrouter-key:enable",
   "openrouter-key:set_spend_cap",
+  "organization:data_key_enabled",
+  "organization:data_key_shredded",
   "organization:device_agent_configuration_updated",
   "organization:enterprise_trial_armed",
   "organization:enterprise_trial_demoted",
@@ -112,6 +120,9 @@ export const AUDIT_ACTIONS = [
   "organization_invitation:update_role",
   "otel_forwarding:delete",
   "otel_forwarding:upsert",
+  "otel_forwarding_destination:create",
+  "otel_forwarding_destination:delete",
+  "otel_forwarding_destination:update",
   "platform-mcp-registration:create",
   "platform-mcp-registration:handoff_issue",
   "platform-mcp-registration:handoff_redeem",
@@ -181,6 +192,18 @@ export const AUDIT_ACTIONS = [
   "template:create",
   "template:delete",
   "template:update",
+  "telemetry-alert-rule:create",
+  "telemetry-alert-rule:delete",
+  "telemetry-alert-rule:update",
+  "tool-approval-policy:create",
+  "tool-approval-policy:delete",
+  "tool-approval-policy:update",
+  "tool-constraint:create",
+  "tool-constraint:delete",
+  "tool-constraint:update",
+  "tool-rate-limit:create",
+  "tool-rate-limit:delete",
+  "tool-rate-limit:update",
   "toolset:attach_external_oauth",
   "toolset:attach_oauth_proxy",
   "toolset:create",
@@ -214,6 +237,10 @@ export const AUDIT_ACTIONS = [
   "wake:cancelled",
   "wake:fired",
   "wake:scheduled",
+  "webhook-endpoint:create",
+  "webhook-endpoint:delete",
+  "webhook-endpoint:redeliver",
+  "webhook-endpoint:update",
 ] as const;
 
 export type AuditAction = (typeof AUDIT_ACTIONS)[number];
@@ -368,6 +395,14 @@ export function staticActionPhrase(action: AuditAction): string {
       return "updated MCP endpoint";
     case "mcp-endpoint:delete":
       return "deleted MCP endpoint";
+    case "mcp-endpoint:canary-start":
+      return "started canary on MCP endpoint";
+    case "mcp-endpoint:canary-update":
+      return "changed canary split on MCP endpoint";
+    case "mcp-endpoint:canary-promote":
+      return "promoted canary on MCP endpoint";
+    case "mcp-endpoint:canary-abort":
+      return "aborted canary on MCP endpoint";
 
     case "meta-mcp:create":
       return "created meta MCP server";
@@ -440,6 +475,10 @@ export function staticActionPhrase(action: AuditAction): string {
       return "disabled fail-open for hooks";
     case "organization:device_agent_configuration_updated":
       return "updated device agent configuration";
+    case "organization:data_key_enabled":
+      return "enabled customer-managed encryption for";
+    case "organization:data_key_shredded":
+      return "shredded the customer-managed encryption key of";
     case "organization:enterprise_trial_armed":
       return "started enterprise trial";
     case "organization:enterprise_trial_demoted":
@@ -460,10 +499,21 @@ export function staticActionPhrase(action: AuditAction): string {
     case "organization_invitation:update_role":
       return "changed invite role for";
 
+    case "audit_log_export:upsert":
+      return "updated audit log export configuration";
+    case "audit_log_export:delete":
+      return "removed audit log export configuration";
+
     case "otel_forwarding:upsert":
       return "updated OpenTelemetry forwarding configuration";
     case "otel_forwarding:delete":
       return "removed OpenTelemetry forwarding configuration";
+    case "otel_forwarding_destination:create":
+      return "added OpenTelemetry forwarding destination";
+    case "otel_forwarding_destination:update":
+      return "updated OpenTelemetry forwarding destination";
+    case "otel_forwarding_destination:delete":
+      return "removed OpenTelemetry forwarding destination";
 
     case "platform-mcp-registration:create":
       return "registered platform MCP server";
@@ -614,6 +664,34 @@ export function staticActionPhrase(action: AuditAction): string {
     case "template:delete":
       return "deleted template";
 
+    case "telemetry-alert-rule:create":
+      return "created telemetry alert rule";
+    case "telemetry-alert-rule:update":
+      return "updated telemetry alert rule";
+    case "telemetry-alert-rule:delete":
+      return "deleted telemetry alert rule";
+
+    case "tool-approval-policy:create":
+      return "created tool approval policy";
+    case "tool-approval-policy:update":
+      return "updated tool approval policy";
+    case "tool-approval-policy:delete":
+      return "deleted tool approval policy";
+
+    case "tool-constraint:create":
+      return "created tool argument constraint";
+    case "tool-constraint:update":
+      return "updated tool argument constraint";
+    case "tool-constraint:delete":
+      return "deleted tool argument constraint";
+
+    case "tool-rate-limit:create":
+      return "created tool rate limit";
+    case "tool-rate-limit:update":
+      return "updated tool rate limit";
+    case "tool-rate-limit:delete":
+      return "deleted tool rate limit";
+
     case "toolset:create":
       return "created MCP server";
     case "toolset:update":
@@ -648,6 +726,15 @@ export function staticActionPhrase(action: AuditAction): string {
     case "wake:cancelled":
       return "cancelled a wake for";
 
+    case "webhook-endpoint:create":
+      return "created webhook endpoint";
+    case "webhook-endpoint:update":
+      return "updated webhook endpoint";
+    case "webhook-endpoint:delete":
+      return "deleted webhook endpoint";
+    case "webhook-endpoint:redeliver":
+      return "redelivered a webhook to";
+
     case "tunneled-mcp:create":
       return "added tunneled MCP server";
     case "tunneled-mcp:update":
@@ -20,6 +20,8 @@ function getResourceLabel(resource: string): string {
       return "API key";
     case "asset":
       return "asset";
+    case "audit_log_export":
+      return "audit log export";
     case "custom_domains":
       return "custom domain";
     case "deployments":
@@ -219,6 +221,7 @@ const SUBJECT_TYPE_LABELS: Record<string, string> = {
   mcp_server: "MCP server",
   mcp_collection: "Collection",
   otel_forwarding_config: "OpenTelemetry forwarding",
+  otel_forwarding_destination: "OpenTelemetry destination",
   api_key: "API key",
   chat_session: "Chat session",
 };
@@ -54,6 +54,27 @@ func NewErrPublishResult(err error) PublishResult {
 	return &errPublishResult{err: err}
 }
 
+type settledPublishResult struct {
+	serverID string
+}
+
+func (s *settledPublishResult) Ready() <-chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}
+
+func (s *settledPublishResult) Get(ctx context.Context) (serverID string, err error) {
+	return s.serverID, nil
+}
+
+// NewSettledPublishResult returns a PublishResult already resolved with
+// serverID, for transports that publish synchronously and know the message ID
+// by the time Publish returns.
+func NewSettledPublishResult(serverID string) PublishResult {
+	return &settledPublishResult{serverID: serverID}
+}
+
 // stopBlocking races a publisher's blocking Stop against ctx. The underlying
 // flush cannot be cancelled, so when ctx expires first the flush is left to
 // finish in the background — shutdown stays bounded by the caller's deadline
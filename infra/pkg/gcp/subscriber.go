@@ -191,8 +191,44 @@ type incomingMessage struct {
 	nack            func()
 }
 
+// ReceivedMessage is a single delivery handed to a MessageSource's deliver
+// callback. Exactly one of Ack or Nack is called once the message has been
+// processed.
+type ReceivedMessage struct {
+	ID              string
+	Data            []byte
+	Attributes      map[string]string
+	DeliveryAttempt *int
+	Ack             func()
+	Nack            func()
+}
+
+// MessageSource produces messages for a subscriber backed by something other
+// than Pub/Sub. It blocks until ctx is cancelled or it fails, calling deliver
+// for every message it receives. Ack and Nack must keep working after ctx is
+// cancelled and until the source returns: batch subscribers settle their
+// in-flight batch during shutdown.
+type MessageSource func(ctx context.Context, deliver func(ReceivedMessage)) error
+
+// pubsubSource adapts a Pub/Sub subscriber to the delivery callback shared by
+// every receive mode.
+func pubsubSource(sub *pubsub.Subscriber) func(context.Context, func(incomingMessage)) error {
+	return func(ctx context.Context, deliver func(incomingMessage)) error {
+		return sub.Receive(ctx, func(_ context.Context, m *pubsub.Message) {
+			deliver(incomingMessage{
+				id:              m.ID,
+				data:            m.Data,
+				attributes:      m.Attributes,
+				deliveryAttempt: m.DeliveryAttempt,
+				ack:             m.Ack,
+				nack:            m.Nack,
+			})
+		})
+	}
+}
+
 type psSubscriber[M proto.Message] struct {
-	sub                   *pubsub.Subscriber
+	receive               func(ctx context.Context, deliver func(incomingMessage)) error
 	new                   func() M
 	logger                *slog.Logger
 	topicProtoName        string
@@ -200,15 +236,8 @@ type psSubscriber[M proto.Message] struct {
 }
 
 func (s *psSubscriber[M]) Receive(ctx context.Context, f func(context.Context, M, MessageMetadata) error) error {
-	err := s.sub.Receive(ctx, func(ctx context.Context, m *pubsub.Message) {
-		s.handle(ctx, incomingMessage{
-			id:              m.ID,
-			data:            m.Data,
-			attributes:      m.Attributes,
-			deliveryAttempt: m.DeliveryAttempt,
-			ack:             m.Ack,
-			nack:            m.Nack,
-		}, f)
+	err := s.receive(ctx, func(m incomingMessage) {
+		s.handle(ctx, m, f)
 	})
 	if err != nil {
 		return fmt.Errorf("receive: %w", err)
@@ -264,18 +293,7 @@ func (s *psSubscriber[M]) handle(ctx context.Context, m incomingMessage, f func(
 // f returns an error (or panics) the whole batch is nacked. Messages that fail
 // to unmarshal are nacked individually and excluded from the batch handed to f.
 func (s *psSubscriber[M]) ReceiveBatch(ctx context.Context, settings BatchReceiveSettings, f func(context.Context, []M, []MessageMetadata) error) error {
-	return s.batchLoop(ctx, settings, func(ctx context.Context, deliver func(incomingMessage)) error {
-		return s.sub.Receive(ctx, func(_ context.Context, m *pubsub.Message) {
-			deliver(incomingMessage{
-				id:              m.ID,
-				data:            m.Data,
-				attributes:      m.Attributes,
-				deliveryAttempt: m.DeliveryAttempt,
-				ack:             m.Ack,
-				nack:            m.Nack,
-			})
-		})
-	}, f)
+	return s.batchLoop(ctx, settings, s.receive, f)
 }
 
 // ReceiveBatchWithResult consumes messages in batches whose members may have
@@ -289,18 +307,7 @@ func (s *psSubscriber[M]) ReceiveBatch(ctx context.Context, settings BatchReceiv
 // whole batch. Messages that fail to unmarshal are nacked individually and
 // excluded from f.
 func (s *psSubscriber[M]) ReceiveBatchWithResult(ctx context.Context, settings BatchReceiveSettings, f func(context.Context, []BatchMessage[M]) error) error {
-	return s.batchLoopWithResult(ctx, settings, func(ctx context.Context, deliver func(incomingMessage)) error {
-		return s.sub.Receive(ctx, func(_ context.Context, m *pubsub.Message) {
-			deliver(incomingMessage{
-				id:              m.ID,
-				data:            m.Data,
-				attributes:      m.Attributes,
-				deliveryAttempt: m.DeliveryAttempt,
-				ack:             m.Ack,
-				nack:            m.Nack,
-			})
-		})
-	}, f)
+	return s.batchLoopWithResult(ctx, settings, s.receive, f)
 }
 
 // batchLoop adapts the all-or-nothing batch callback to the shared buffering
@@ -729,10 +736,60 @@ func PubSubSubscriberForMessage[M proto.Message](ctx context.Context, broker Sub
 
 	mt := msgref.Type()
 	return &psSubscriber[M]{
-		sub:                   sub,
+		receive:               pubsubSource(sub),
 		new:                   func() M { return mt.New().Interface().(M) },
 		logger:                logger,
 		topicProtoName:        string(msgref.Descriptor().FullName()),
 		subscriptionProtoName: string(descriptor.FullName()),
 	}, nil
 }
+
+// SubscriberForSource builds a Subscriber that reads from source instead of a
+// Pub/Sub subscription, so alternative transports share the decoding, batching
+// and panic-recovery semantics of PubSubSubscriberForMessage. Pub/Sub receive
+// settings passed in options are ignored.
+func SubscriberForSource[M proto.Message](msg M, subscription proto.Message, source MessageSource, options ...SubscriberOption) (Subscriber[M], error) {
+	if isNilMessage(msg) {
+		return nil, fmt.Errorf("message must not be nil")
+	}
+	if isNilMessage(subscription) {
+		return nil, fmt.Errorf("subscription marker message must not be nil")
+	}
+	if source == nil {
+		return nil, fmt.Errorf("message source must not be nil")
+	}
+
+	msgref := msg.ProtoReflect()
+	if _, ok := msgref.New().Interface().(M); !ok {
+		return nil, fmt.Errorf("proto message %s cannot be constructed as %T", msgref.Descriptor().FullName(), msg)
+	}
+
+	var opts psSubscriberOptions
+	for _, opt := range options {
+		opt(&opts)
+	}
+	logger := opts.logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	mt := msgref.Type()
+	return &psSubscriber[M]{
+		receive: func(ctx context.Context, deliver func(incomingMessage)) error {
+			return source(ctx, func(m ReceivedMessage) {
+				deliver(incomingMessage{
+					id:              m.ID,
+					data:            m.Data,
+					attributes:      m.Attributes,
+					deliveryAttempt: m.DeliveryAttempt,
+					ack:             m.Ack,
+					nack:            m.Nack,
+				})
+			})
+		},
+		new:                   func() M { return mt.New().Interface().(M) },
+		logger:                logger,
+		topicProtoName:        string(msgref.Descriptor().FullName()),
+		subscriptionProtoName: string(subscription.ProtoReflect().Descriptor().FullName()),
+	}, nil
+}
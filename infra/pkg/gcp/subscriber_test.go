@@ -45,7 +45,7 @@ func (c *captureMessage) isAcked() bool {
 
 func newPanicSubscriber(logger *slog.Logger) *psSubscriber[*emptypb.Empty] {
 	return &psSubscriber[*emptypb.Empty]{
-		sub:                   nil,
+		receive:               nil,
 		new:                   func() *emptypb.Empty { return &emptypb.Empty{} },
 		logger:                logger,
 		topicProtoName:        "test.v1.TopicMessage",
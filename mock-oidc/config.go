@@ -17,6 +17,9 @@ type User struct {
 	HD            string `yaml:"hd,omitempty"`
 	Picture       string `yaml:"picture,omitempty"`
 	EmailVerified bool   `yaml:"email_verified"`
+	// Groups is sent as the groups claim, the way Okta, Keycloak and Entra
+	// report directory group membership.
+	Groups []string `yaml:"groups,omitempty"`
 }
 
 func (u User) Subject() string {
@@ -80,7 +80,7 @@ func (s *Server) discovery(w http.ResponseWriter, _ *http.Request) {
 		"id_token_signing_alg_values_supported": []string{"RS256"},
 		"scopes_supported":                      []string{"openid", "email", "profile"},
 		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
-		"claims_supported":                      []string{"sub", "iss", "aud", "exp", "iat", "email", "email_verified", "name", "picture", "hd", "nonce"},
+		"claims_supported":                      []string{"sub", "iss", "aud", "exp", "iat", "email", "email_verified", "name", "picture", "hd", "groups", "nonce"},
 		"code_challenge_methods_supported":      []string{"S256", "plain"},
 	}
 	writeJSON(w, http.StatusOK, doc)
@@ -414,6 +414,9 @@ func (s *Server) userinfoHandler(w http.ResponseWriter, r *http.Request) {
 	if user.HD != "" {
 		resp["hd"] = user.HD
 	}
+	if len(user.Groups) > 0 {
+		resp["groups"] = user.Groups
+	}
 	writeJSON(w, http.StatusOK, resp)
 }
 
@@ -34,6 +34,7 @@ func newTestServer(t *testing.T) (*httptest.Server, *mockoidc.Config) {
 					HD:            "speakeasyapi.dev",
 					Picture:       "https://example.com/avatar.png",
 					EmailVerified: true,
+					Groups:        []string{"engineering", "gram-admins"},
 				},
 				{
 					Email:         "external@gmail.com",
@@ -182,11 +183,12 @@ func TestDiscoveryAndIDToken(t *testing.T) {
 	}
 
 	var claims struct {
-		Email         string `json:"email"`
-		EmailVerified bool   `json:"email_verified"`
-		Name          string `json:"name"`
-		HD            string `json:"hd"`
-		Picture       string `json:"picture"`
+		Email         string   `json:"email"`
+		EmailVerified bool     `json:"email_verified"`
+		Name          string   `json:"name"`
+		HD            string   `json:"hd"`
+		Picture       string   `json:"picture"`
+		Groups        []string `json:"groups"`
 	}
 	if err := idToken.Claims(&claims); err != nil {
 		t.Fatalf("claims: %v", err)
@@ -207,6 +209,9 @@ func TestDiscoveryAndIDToken(t *testing.T) {
 	if claims.Picture == "" {
 		t.Errorf("picture missing")
 	}
+	if strings.Join(claims.Groups, ",") != "engineering,gram-admins" {
+		t.Errorf("groups = %v", claims.Groups)
+	}
 
 	// userinfo
 	userinfo, err := provider.UserInfo(ctx, oauth2.StaticTokenSource(token))
@@ -240,6 +245,9 @@ func TestIDTokenOmitsHDForExternalUser(t *testing.T) {
 	if _, exists := payload["hd"]; exists {
 		t.Errorf("hd should not be present for non-hd user; got %v", payload["hd"])
 	}
+	if _, exists := payload["groups"]; exists {
+		t.Errorf("groups should not be present for a user with no groups; got %v", payload["groups"])
+	}
 }
 
 func TestRejectsUnknownClient(t *testing.T) {
@@ -282,6 +282,9 @@ func (p *Provider) SignIDToken(user User, clientID, nonce string, expires time.T
 	if user.HD != "" {
 		claims["hd"] = user.HD
 	}
+	if len(user.Groups) > 0 {
+		claims["groups"] = user.Groups
+	}
 
 	tok := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
 	tok.Header["kid"] = p.keyID
@@ -0,0 +1,4 @@
+-- reverse: create "spend_rule_dimension_usage_summaries_mv" view
+DROP VIEW `spend_rule_dimension_usage_summaries_mv`;
+-- reverse: create "spend_rule_dimension_usage_summaries" table
+DROP TABLE `spend_rule_dimension_usage_summaries`;
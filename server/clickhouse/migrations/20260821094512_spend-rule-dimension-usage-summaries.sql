@@ -0,0 +1,13 @@
+-- Create "spend_rule_dimension_usage_summaries" table
+CREATE TABLE `spend_rule_dimension_usage_summaries` (
+  `gram_project_id` UUID,
+  `user_email` String,
+  `model` String,
+  `assistant_id` String,
+  `toolset_slug` String,
+  `time_bucket` DateTime('UTC'),
+  `total_cost` Float64
+) ENGINE = SummingMergeTree
+PRIMARY KEY (`gram_project_id`, `user_email`, `time_bucket`, `model`, `assistant_id`, `toolset_slug`) ORDER BY (`gram_project_id`, `user_email`, `time_bucket`, `model`, `assistant_id`, `toolset_slug`) TTL time_bucket + toIntervalDay(400) SETTINGS index_granularity = 8192 COMMENT 'Minute-grained per-user LLM cost rollup by model, assistant and toolset for scoped spend-rule evaluation.';
+-- Create "spend_rule_dimension_usage_summaries_mv" view
+CREATE MATERIALIZED VIEW `spend_rule_dimension_usage_summaries_mv` TO `spend_rule_dimension_usage_summaries` AS WITH (gram_urn = 'claude-code:otel:logs') AND (chat_id != '') AND (toString(attributes.prompt.id) != '') AND ((toString(attributes.event.name) = 'api_request') OR (body = 'claude_code.api_request')) AS is_claude_api_request, startsWith(gram_urn, 'codex:usage') OR startsWith(gram_urn, 'cursor:usage') AS is_generic_usage_row SELECT gram_project_id, user_email, multiIf(is_claude_api_request AND (toString(attributes.model) != ''), toString(attributes.model), is_claude_api_request, toString(attributes.gen_ai.request.model), toString(attributes.gen_ai.response.model) != '', toString(attributes.gen_ai.response.model), toString(attributes.gen_ai.request.model)) AS model, toString(attributes.gram.assistant.id) AS assistant_id, toolset_slug, toStartOfMinute(fromUnixTimestamp64Nano(time_unix_nano)) AS time_bucket, sum(if(is_claude_api_request, multiIf(toString(attributes.cost_usd) != '', toFloat64OrZero(toString(attributes.cost_usd)), toString(attributes.cost_usd_micros) != '', toFloat64OrZero(toString(attributes.cost_usd_micros)) / 1000000, 0), toFloat64OrZero(toString(attributes.gen_ai.usage.cost)))) AS total_cost FROM telemetry_logs WHERE (user_email != '') AND (is_claude_api_request OR is_generic_usage_row) GROUP BY gram_project_id, user_email, model, assistant_id, toolset_slug, time_bucket;
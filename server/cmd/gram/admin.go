@@ -31,6 +31,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/control"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
 	"github.com/speakeasy-api/gram/server/internal/guardian"
 	"github.com/speakeasy-api/gram/server/internal/middleware"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
@@ -210,6 +211,31 @@ func newAdminCommand() *cli.Command {
 			EnvVars:  []string{"GRAM_DISALLOWED_CIDR_BLOCKS"},
 			Required: false,
 		},
+		&cli.StringFlag{
+			Name:     "circuit-breaker",
+			Usage:    "Circuit breaker for outbound HTTP calls: noop (observe only), inproc (per-replica state) or redis (state shared across replicas)",
+			EnvVars:  []string{"GRAM_CIRCUIT_BREAKER"},
+			Value:    "noop",
+			Required: false,
+		},
+		&cli.StringFlag{
+			Name:     "egress-proxy-url",
+			Usage:    "Proxy to send outbound HTTP calls through: an http, https (CONNECT), socks5 or socks5h URL, with credentials as userinfo",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_URL"},
+			Required: false,
+		},
+		&cli.StringSliceFlag{
+			Name:     "egress-proxy-project",
+			Usage:    "Proxy for a single project's tool calls, as <project-id>=<proxy-url>. Overrides --egress-proxy-url for that project",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_PROJECTS"},
+			Required: false,
+		},
+		&cli.PathFlag{
+			Name:     "egress-proxy-ca-bundle",
+			Usage:    "PEM file of CA certificates to trust for the egress proxies and for upstream TLS they intercept",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_CA_BUNDLE"},
+			Required: false,
+		},
 		&cli.PathFlag{
 			Name:     "config-file",
 			Usage:    "Path to a config file to load. Supported formats are JSON, TOML and YAML.",
@@ -244,6 +270,16 @@ func newAdminCommand() *cli.Command {
 			EnvVars:  []string{"GRAM_ENCRYPTION_KEY"},
 			Required: false,
 		},
+		&cli.StringFlag{
+			Name:    "encryption-key-id",
+			Usage:   "ID of --encryption-key, prefixed to new ciphertexts. Leave unset to keep writing unprefixed ciphertexts with a legacy key",
+			EnvVars: []string{"GRAM_ENCRYPTION_KEY_ID"},
+		},
+		&cli.StringSliceFlag{
+			Name:    "encryption-previous-keys",
+			Usage:   "Retired keys kept for decryption while their ciphertexts are re-encrypted, as <id>=<base64 key>, or a bare base64 key for the legacy unprefixed key",
+			EnvVars: []string{"GRAM_ENCRYPTION_PREVIOUS_KEYS"},
+		},
 		&cli.StringFlag{
 			Name:    "openrouter-provisioning-key",
 			Usage:   "Provisioning key for OpenRouter to create new API keys for orgs - https://openrouter.ai/settings/provisioning-keys",
@@ -437,8 +473,18 @@ func newAdminCommand() *cli.Command {
 			loopsWorkflowClient := loops.NewWorkflowClient(ctx, logger, guardianPolicy, c.String("loops-api-key"))
 			trialNotifier := trialemails.NewService(db, loopsWorkflowClient, logger, c.String("site-url"))
 
+			// The organization keyring falls back to the application keyring, so
+			// the routes managing customer-managed encryption keys need
+			// --encryption-key. Without it they report unavailable.
+			var orgKeys *envelope.Keyring
+			if appEncryption, err := newEncryptionClient(c); err != nil {
+				logger.WarnContext(ctx, "customer-managed encryption keys unavailable: no application encryption key", attr.SlogError(err))
+			} else {
+				orgKeys = newOrganizationKeyring(ctx, logger, c, db, appEncryption, newGCPIdentity(ctx, logger, c))
+			}
+
 			billingOperations := usage.NewBillingOperations(logger, db, stripeClient, billingTelemetry, audit.NewLogger())
-			admin.Attach(mux, admin.NewService(logger, tracerProvider, db, redisClient, adminOIDCClient, adminEncryption, adminAllowedOrigins, adminWorkOSClient, adminOpenRouter, trialNotifier, productFeatures, chatAnalysisSignaler, billingOperations, siteURL))
+			admin.Attach(mux, admin.NewService(logger, tracerProvider, db, redisClient, adminOIDCClient, adminEncryption, adminAllowedOrigins, adminWorkOSClient, adminOpenRouter, trialNotifier, productFeatures, chatAnalysisSignaler, billingOperations, siteURL, orgKeys))
 
 			srv := &http.Server{
 				Addr:              c.String("address"),
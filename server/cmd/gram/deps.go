@@ -3,6 +3,7 @@ package gram
 import (
 	"context"
 	"crypto/tls"
+	"encoding/base64"
 	"encoding/csv"
 	"errors"
 	"fmt"
@@ -19,6 +20,7 @@ import (
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/exaring/otelpgx"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/multitracer"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/tracelog"
@@ -43,6 +45,7 @@ import (
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
 
 	jose "github.com/go-jose/go-jose/v4"
 	"github.com/speakeasy-api/gram/infra/gen"
@@ -56,6 +59,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/assets"
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/auth/oidcidp"
 	"github.com/speakeasy-api/gram/server/internal/background"
 	bgtriggers "github.com/speakeasy-api/gram/server/internal/background/triggers"
 	"github.com/speakeasy-api/gram/server/internal/billing"
@@ -63,6 +67,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/email"
 	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
 	"github.com/speakeasy-api/gram/server/internal/environments"
 	"github.com/speakeasy-api/gram/server/internal/externalmcp"
 	"github.com/speakeasy-api/gram/server/internal/feature"
@@ -73,6 +78,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/o11y"
 	"github.com/speakeasy-api/gram/server/internal/organizations/orgprovision"
 	"github.com/speakeasy-api/gram/server/internal/productfeatures"
+	"github.com/speakeasy-api/gram/server/internal/secretrefs"
 	"github.com/speakeasy-api/gram/server/internal/telemetry"
 	"github.com/speakeasy-api/gram/server/internal/temporal"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/loops"
@@ -83,6 +89,9 @@ import (
 	stripeclient "github.com/speakeasy-api/gram/server/internal/thirdparty/stripe"
 	sv "github.com/speakeasy-api/gram/server/internal/thirdparty/svix"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/tracking"
+	"github.com/speakeasy-api/gram/server/internal/transport"
+	"github.com/speakeasy-api/gram/server/internal/transport/pgtransport"
+	"github.com/speakeasy-api/gram/server/internal/transport/redistransport"
 	"golang.org/x/oauth2"
 
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/gcp/gcpauth"
@@ -124,16 +133,35 @@ func loadConfigFromFile(c *cli.Context, flags []cli.Flag) error {
 }
 
 func newGuardianPolicy(c *cli.Context, logger *slog.Logger, tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider, redisClient redis.UniversalClient) (policy *guardian.Policy, err error) {
-	breaker := guardian.NewNoopBreaker(logger, meterProvider)
+	var breaker guardian.Breaker
+	switch mode := c.String("circuit-breaker"); mode {
+	case "", "noop":
+		breaker = guardian.NewNoopBreaker(logger, meterProvider)
+	case "inproc":
+		breaker = guardian.NewInProcBreaker(logger, meterProvider)
+	case "redis":
+		breaker = guardian.NewRedisBreaker(logger, meterProvider, redisClient)
+	default:
+		return nil, fmt.Errorf("unsupported circuit breaker %q: expected noop, inproc or redis", mode)
+	}
 	limiter := guardian.NewRedisRateLimiter(logger, meterProvider, redisClient)
 
+	proxyOptions, err := newEgressProxyOptions(c)
+	if err != nil {
+		return nil, err
+	}
+
+	options := append([]func(*guardian.Policy){
+		guardian.WithBreaker(breaker),
+		guardian.WithLimiter(limiter),
+	}, proxyOptions...)
+
 	// In local development, allow loopback addresses for internal tool-to-tool communication
 	if c.String("environment") == "local" {
 		policy, err = guardian.NewUnsafePolicy(
 			tracerProvider,
 			[]string{}, // Allow all traffic for local development
-			guardian.WithBreaker(breaker),
-			guardian.WithLimiter(limiter),
+			options...,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create unsafe http guardian policy: %w", err)
@@ -141,16 +169,14 @@ func newGuardianPolicy(c *cli.Context, logger *slog.Logger, tracerProvider trace
 	} else {
 		policy = guardian.NewDefaultPolicy(
 			tracerProvider,
-			guardian.WithBreaker(breaker),
-			guardian.WithLimiter(limiter),
+			options...,
 		)
 	}
 	if s := c.StringSlice("disallowed-cidr-blocks"); s != nil {
 		policy, err = guardian.NewUnsafePolicy(
 			tracerProvider,
 			s,
-			guardian.WithBreaker(breaker),
-			guardian.WithLimiter(limiter),
+			options...,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create unsafe http guardian policy: %w", err)
@@ -160,6 +186,78 @@ func newGuardianPolicy(c *cli.Context, logger *slog.Logger, tracerProvider trace
 	return policy, nil
 }
 
+// newEncryptionClient builds the application keyring: --encryption-key is the
+// primary key new ciphertexts are written with, and the previous keys stay
+// readable until the key rotation workflow has re-encrypted everything.
+func newEncryptionClient(c *cli.Context) (*encryption.Client, error) {
+	material, err := base64.StdEncoding.DecodeString(c.String("encryption-key"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode base64 key: %w", err)
+	}
+	primary := encryption.Key{ID: c.String("encryption-key-id"), Material: material}
+
+	specs := c.StringSlice("encryption-previous-keys")
+	previous := make([]encryption.Key, 0, len(specs))
+	for i, spec := range specs {
+		key, err := encryption.ParseKey(spec)
+		if err != nil {
+			return nil, fmt.Errorf("encryption-previous-keys[%d]: %w", i, err)
+		}
+		previous = append(previous, key)
+	}
+
+	client, err := encryption.NewKeyring(primary, previous...)
+	if err != nil {
+		return nil, fmt.Errorf("encryption keyring: %w", err)
+	}
+
+	return client, nil
+}
+
+// newEgressProxyOptions builds the guardian options for the operator's egress
+// proxies: a default for all outbound calls and per-project overrides, which
+// share one CA bundle.
+func newEgressProxyOptions(c *cli.Context) ([]func(*guardian.Policy), error) {
+	var caBundle []byte
+	if path := c.Path("egress-proxy-ca-bundle"); path != "" {
+		bundle, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, fmt.Errorf("read egress proxy ca bundle: %w", err)
+		}
+		caBundle = bundle
+	}
+
+	var options []func(*guardian.Policy)
+
+	if rawURL := c.String("egress-proxy-url"); rawURL != "" {
+		proxy, err := guardian.NewProxy(rawURL, caBundle)
+		if err != nil {
+			return nil, fmt.Errorf("egress proxy: %w", err)
+		}
+		options = append(options, guardian.WithProxy(proxy))
+	}
+
+	for _, entry := range c.StringSlice("egress-proxy-project") {
+		projectID, rawURL, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("egress proxy project entry must be <project-id>=<proxy-url>")
+		}
+
+		id, err := uuid.Parse(strings.TrimSpace(projectID))
+		if err != nil {
+			return nil, fmt.Errorf("egress proxy project id %q: %w", projectID, err)
+		}
+
+		proxy, err := guardian.NewProxy(strings.TrimSpace(rawURL), caBundle)
+		if err != nil {
+			return nil, fmt.Errorf("egress proxy for project %s: %w", id, err)
+		}
+		options = append(options, guardian.WithScopedProxy(id.String(), proxy))
+	}
+
+	return options, nil
+}
+
 func newClickhouseClient(ctx context.Context, logger *slog.Logger, c *cli.Context) (clickhouse.Conn, func(context.Context) error, error) {
 	logger = logger.With(attr.SlogComponent("clickhouse"))
 	nilFunc := noopShutdown
@@ -338,6 +436,40 @@ func newAssetStorage(ctx context.Context, logger *slog.Logger, opts assetStorage
 	}
 }
 
+// newAuditExportStorage opens the bucket audit logs are exported to. It
+// returns a nil store when no backend is configured, which leaves the export
+// schedule a no-op.
+func newAuditExportStorage(ctx context.Context, c *cli.Context, logger *slog.Logger) (assets.BlobStore, func(context.Context) error, error) {
+	backend := c.String("audit-export-backend")
+	uri := c.String("audit-export-uri")
+	if backend == "" {
+		return nil, noopShutdown, nil
+	}
+	if uri == "" {
+		return nil, noopShutdown, fmt.Errorf("--audit-export-uri must be set when --audit-export-backend is %s", backend)
+	}
+
+	if backend != auditExportBackendS3 {
+		return newAssetStorage(ctx, logger, assetStorageOptions{
+			assetsBackend: backend,
+			assetsURI:     uri,
+		})
+	}
+
+	store, err := assets.NewS3BlobStore(ctx, logger, uri, assets.S3BlobStoreOptions{
+		BaseEndpoint: c.String("audit-export-s3-endpoint"),
+		Region:       c.String("audit-export-s3-region"),
+		UsePathStyle: c.Bool("audit-export-s3-path-style"),
+		AccessKey:    c.String("audit-export-s3-access-key"),
+		AccessSecret: c.String("audit-export-s3-secret-key"),
+	})
+	if err != nil {
+		return nil, noopShutdown, fmt.Errorf("create s3 audit export store: %w", err)
+	}
+
+	return store, noopShutdown, nil
+}
+
 type redisClientOptions struct {
 	redisAddr     string
 	redisPassword string
@@ -687,7 +819,7 @@ func newAdminOpenRouter(
 		return admin.TrialKeysUnavailable{}
 	}
 
-	encryptionClient, err := encryption.New(c.String("encryption-key"))
+	encryptionClient, err := newEncryptionClient(c)
 	if err != nil {
 		logger.ErrorContext(ctx, "admin OpenRouter operations are unavailable: no usable encryption key configured", attr.SlogError(err))
 		return admin.TrialKeysUnavailable{}
@@ -712,13 +844,71 @@ func newWorkOSClient(guardianPolicy *guardian.Policy, c *cli.Context) (client *w
 	apiKey := c.String("idp-client-secret")
 
 	haveAPIKey := apiKey != "" && apiKey != "unset"
-	if env != "local" && !haveAPIKey {
+	if env != "local" && !haveAPIKey && c.String("auth-provider") == authProviderWorkOS {
 		return nil, false, errors.New("WorkOS API key not provided")
 	}
 
 	return workos.NewClient(guardianPolicy, apiKey, workosClientOpts(c)), haveAPIKey, nil
 }
 
+const (
+	authProviderWorkOS = "workos"
+	authProviderOIDC   = "oidc"
+)
+
+// validateAuthProviderFlags checks that the identity provider selected with
+// --auth-provider has the flags it needs.
+func validateAuthProviderFlags(c *cli.Context) error {
+	switch c.String("auth-provider") {
+	case authProviderWorkOS:
+		if c.String("idp-base-url") == "" || c.String("idp-client-id") == "" {
+			return errors.New("idp-base-url and idp-client-id are required with --auth-provider=workos")
+		}
+	case authProviderOIDC:
+	default:
+		return fmt.Errorf("auth-provider must be %q or %q", authProviderWorkOS, authProviderOIDC)
+	}
+	return nil
+}
+
+// newOIDCProvider returns the generic OIDC issuer dashboard users sign in
+// with, or nil when they sign in through WorkOS.
+func newOIDCProvider(ctx context.Context, c *cli.Context, tracerProvider trace.TracerProvider) (*oidcidp.Provider, error) {
+	if c.String("auth-provider") != authProviderOIDC {
+		return nil, nil
+	}
+
+	for _, flag := range []string{"oidc-issuer", "oidc-client-id", "oidc-rules-file"} {
+		if c.String(flag) == "" {
+			return nil, fmt.Errorf("%s is required with --auth-provider=oidc", flag)
+		}
+	}
+
+	rules, err := oidcidp.LoadRules(c.String("oidc-rules-file"))
+	if err != nil {
+		return nil, fmt.Errorf("load oidc rules: %w", err)
+	}
+
+	// The issuer is the operator's own and, in an air-gapped install, sits on
+	// the private network the default guardian policy blocks.
+	policy, err := guardian.NewUnsafePolicy(tracerProvider, []string{})
+	if err != nil {
+		return nil, fmt.Errorf("create oidc issuer policy: %w", err)
+	}
+
+	provider, err := oidcidp.NewProvider(ctx, oidcidp.Options{
+		Issuer:       c.String("oidc-issuer"),
+		ClientID:     c.String("oidc-client-id"),
+		ClientSecret: c.String("oidc-client-secret"),
+		HTTPClient:   policy.PooledClient(),
+		Rules:        rules,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create oidc provider: %w", err)
+	}
+	return provider, nil
+}
+
 // newIDPUserManagementClient creates a WorkOS user-management SDK client
 // scoped to the IDP application key. Returns nil only when the key is empty.
 // In mock-workos mode the key can be any non-empty string (e.g. "unset") —
@@ -951,6 +1141,8 @@ func newTriggersApp(
 	logger *slog.Logger,
 	db *pgxpool.Pool,
 	enc *encryption.Client,
+	orgKeys *envelope.Keyring,
+	secretRefs *secretrefs.Resolver,
 	temporalEnv *temporal.Environment,
 	telemetryLogger *telemetry.Logger,
 	auditLogger *audit.Logger,
@@ -958,7 +1150,7 @@ func newTriggersApp(
 	siteURL *url.URL,
 	slackClient *slack_client.SlackClient,
 ) *bgtriggers.App {
-	envEntries := environments.NewEnvironmentEntries(logger, db, enc, nil)
+	envEntries := environments.NewEnvironmentEntries(logger, db, enc, orgKeys, secretRefs, nil)
 	return bgtriggers.NewApp(
 		logger,
 		db,
@@ -1124,6 +1316,58 @@ func newPubSubClient(ctx context.Context, c *cli.Context, logger *slog.Logger) (
 	return client, broker, func(context.Context) error { return client.Close() }, nil
 }
 
+// messaging is the bus a process publishes and subscribes over. broker is set
+// for the default Pub/Sub transport and transport for the alternatives chosen
+// with --message-transport; exactly one of them is non-nil.
+type messaging struct {
+	broker    pubSubBroker
+	transport transport.Transport
+}
+
+func newMessaging(ctx context.Context, c *cli.Context, logger *slog.Logger, db *pgxpool.Pool, redisClient *redis.Client) (*messaging, func(ctx context.Context) error, error) {
+	var t transport.Transport
+	switch c.String("message-transport") {
+	case messageTransportPostgres:
+		t = pgtransport.New(logger, db, pgtransport.Options{})
+	case messageTransportRedis:
+		t = redistransport.New(logger, redisClient, redistransport.Options{})
+	default:
+		_, broker, shutdown, err := newPubSubClient(ctx, c, logger)
+		if err != nil {
+			return nil, shutdown, err
+		}
+		return &messaging{broker: broker, transport: nil}, shutdown, nil
+	}
+
+	// Provision every declared subscription before this process can publish,
+	// so a message does not miss a consumer that has not started yet.
+	subscriptions, err := transport.DeclaredSubscriptions(gen.Descriptors)
+	if err != nil {
+		return nil, noopShutdown, fmt.Errorf("discover transport subscriptions: %w", err)
+	}
+	if err := t.Provision(ctx, subscriptions); err != nil {
+		return nil, noopShutdown, fmt.Errorf("provision transport subscriptions: %w", err)
+	}
+
+	return &messaging{broker: nil, transport: t}, t.Stop, nil
+}
+
+// publisherForMessage returns a publisher for msg's topic on whichever bus m
+// runs over. settings tunes the Pub/Sub client's batching and flow control;
+// the alternative transports publish synchronously and ignore it.
+func publisherForMessage[M proto.Message](ctx context.Context, m *messaging, msg M, settings *pubsub.PublishSettings) (gcp.Publisher[M], error) {
+	if m.transport != nil {
+		return transport.NewPublisher(m.transport, msg), nil
+	}
+
+	pub, err := gcp.PubSubPublisherForMessage(ctx, m.broker, msg, gcp.WithPubSubPublishSettings(settings))
+	if err != nil {
+		return nil, fmt.Errorf("pubsub publisher: %w", err)
+	}
+
+	return pub, nil
+}
+
 type labelledStop struct {
 	label string
 	pub   interface {
@@ -1139,42 +1383,42 @@ func (l labelledStop) Stop(ctx context.Context) error {
 	return nil
 }
 
-func newPublishers(ctx context.Context, psbroker pubSubBroker) (*background.Publishers, func(ctx context.Context) error, error) {
+func newPublishers(ctx context.Context, bus *messaging) (*background.Publishers, func(ctx context.Context) error, error) {
 	pubs := make([]interface {
 		Stop(ctx context.Context) error
 	}, 0, 1)
 
-	presidioAnalysis, err := gcp.PubSubPublisherForMessage(ctx, psbroker, &riskv1.PresidioAnalysis{})
+	presidioAnalysis, err := publisherForMessage(ctx, bus, &riskv1.PresidioAnalysis{}, nil)
 	if err != nil {
 		return nil, noopShutdown, fmt.Errorf("failed to create pubsub publisher for presidio analysis: %w", err)
 	}
 	pubs = append(pubs, labelledStop{label: "presidioAnalysis", pub: presidioAnalysis})
 
-	gitleaksAnalysis, err := gcp.PubSubPublisherForMessage(ctx, psbroker, &riskv1.GitleaksAnalysis{})
+	gitleaksAnalysis, err := publisherForMessage(ctx, bus, &riskv1.GitleaksAnalysis{}, nil)
 	if err != nil {
 		return nil, noopShutdown, fmt.Errorf("failed to create pubsub publisher for gitleaks analysis: %w", err)
 	}
 	pubs = append(pubs, labelledStop{label: "gitleaksAnalysis", pub: gitleaksAnalysis})
 
-	promptInjectionAnalysis, err := gcp.PubSubPublisherForMessage(ctx, psbroker, &riskv1.PromptInjectionAnalysis{})
+	promptInjectionAnalysis, err := publisherForMessage(ctx, bus, &riskv1.PromptInjectionAnalysis{}, nil)
 	if err != nil {
 		return nil, noopShutdown, fmt.Errorf("failed to create pubsub publisher for prompt injection analysis: %w", err)
 	}
 	pubs = append(pubs, labelledStop{label: "promptInjectionAnalysis", pub: promptInjectionAnalysis})
 
-	promptPolicyAnalysis, err := gcp.PubSubPublisherForMessage(ctx, psbroker, &riskv1.PromptPolicyAnalysis{})
+	promptPolicyAnalysis, err := publisherForMessage(ctx, bus, &riskv1.PromptPolicyAnalysis{}, nil)
 	if err != nil {
 		return nil, noopShutdown, fmt.Errorf("failed to create pubsub publisher for prompt policy analysis: %w", err)
 	}
 	pubs = append(pubs, labelledStop{label: "promptPolicyAnalysis", pub: promptPolicyAnalysis})
 
-	customRulesAnalysis, err := gcp.PubSubPublisherForMessage(ctx, psbroker, &riskv1.CustomRulesAnalysis{})
+	customRulesAnalysis, err := publisherForMessage(ctx, bus, &riskv1.CustomRulesAnalysis{}, nil)
 	if err != nil {
 		return nil, noopShutdown, fmt.Errorf("failed to create pubsub publisher for custom rules analysis: %w", err)
 	}
 	pubs = append(pubs, labelledStop{label: "customRulesAnalysis", pub: customRulesAnalysis})
 
-	riskFindings, err := gcp.PubSubPublisherForMessage(ctx, psbroker, &riskv1.Finding{})
+	riskFindings, err := publisherForMessage(ctx, bus, &riskv1.Finding{}, nil)
 	if err != nil {
 		return nil, noopShutdown, fmt.Errorf("failed to create pubsub publisher for risk findings: %w", err)
 	}
@@ -1189,9 +1433,7 @@ func newPublishers(ctx context.Context, psbroker pubSubBroker) (*background.Publ
 	telemetryPublishSettings.FlowControlSettings.MaxOutstandingBytes = 128 * 1024 * 1024
 	telemetryPublishSettings.FlowControlSettings.LimitExceededBehavior = pubsub.FlowControlSignalError
 
-	telemetryLogs, err := gcp.PubSubPublisherForMessage(ctx, psbroker, &telemetryv1.LogRecord{},
-		gcp.WithPubSubPublishSettings(&telemetryPublishSettings),
-	)
+	telemetryLogs, err := publisherForMessage(ctx, bus, &telemetryv1.LogRecord{}, &telemetryPublishSettings)
 	if err != nil {
 		return nil, noopShutdown, fmt.Errorf("failed to create pubsub publisher for telemetry logs: %w", err)
 	}
@@ -1206,17 +1448,13 @@ func newPublishers(ctx context.Context, psbroker pubSubBroker) (*background.Publ
 	otelPublishSettings.FlowControlSettings.MaxOutstandingBytes = 128 * 1024 * 1024
 	otelPublishSettings.FlowControlSettings.LimitExceededBehavior = pubsub.FlowControlSignalError
 
-	otelLogs, err := gcp.PubSubPublisherForMessage(ctx, psbroker, &otelv1.InboundLogRecord{},
-		gcp.WithPubSubPublishSettings(&otelPublishSettings),
-	)
+	otelLogs, err := publisherForMessage(ctx, bus, &otelv1.InboundLogRecord{}, &otelPublishSettings)
 	if err != nil {
 		return nil, noopShutdown, fmt.Errorf("failed to create pubsub publisher for otel logs: %w", err)
 	}
 	pubs = append(pubs, labelledStop{label: "otelLogs", pub: otelLogs})
 
-	otelSpans, err := gcp.PubSubPublisherForMessage(ctx, psbroker, &otelv1.InboundSpan{},
-		gcp.WithPubSubPublishSettings(&otelPublishSettings),
-	)
+	otelSpans, err := publisherForMessage(ctx, bus, &otelv1.InboundSpan{}, &otelPublishSettings)
 	if err != nil {
 		return nil, noopShutdown, fmt.Errorf("failed to create pubsub publisher for otel spans: %w", err)
 	}
@@ -1238,9 +1476,15 @@ func newPublishers(ctx context.Context, psbroker pubSubBroker) (*background.Publ
 	// misconfigured emulator, say) fails boot naming the topic instead of
 	// dead-lettering outbox rows one retry budget at a time. On the emulator
 	// this is also what reconciles the topics into existence.
-	outboxPublisher := topics.NewMux(psbroker, &outboxPublishSettings)
-	if err := outboxPublisher.Warm(ctx); err != nil {
-		return nil, noopShutdown, fmt.Errorf("failed to warm outbox topic publishers: %w", err)
+	var outboxPublisher topics.Publisher
+	if bus.transport != nil {
+		outboxPublisher = transport.NewOutboxPublisher(bus.transport)
+	} else {
+		mux := topics.NewMux(bus.broker, &outboxPublishSettings)
+		if err := mux.Warm(ctx); err != nil {
+			return nil, noopShutdown, fmt.Errorf("failed to warm outbox topic publishers: %w", err)
+		}
+		outboxPublisher = mux
 	}
 	pubs = append(pubs, labelledStop{label: "outbox", pub: outboxPublisher})
 
@@ -1330,3 +1574,30 @@ func newKMSSigningClients(ctx context.Context, logger *slog.Logger, c *cli.Conte
 		return client, nil
 	}, nil
 }
+
+// localKMSEncryptionSeed derives the local KMS stand-in's encryption keys. It
+// is fixed so that gram-server, the worker and the admin server, each running
+// their own stand-in, unwrap one another's organization data keys.
+const localKMSEncryptionSeed = "gram-local-kms-encryption"
+
+// newKMSEncryptionClients returns the factory the organization keyring builds a
+// KMS client from. Local development gets an in-process stand-in for the same
+// reasons newKMSSigningClients does.
+func newKMSEncryptionClients(ctx context.Context, logger *slog.Logger, c *cli.Context) gcpkms.EncryptionClientFactory {
+	if c.String("environment") != "local" {
+		return gcpkms.NewEncryptionClient
+	}
+
+	logger.WarnContext(ctx, "using in-process kms encryption client: local development has no cloud kms to reach")
+
+	client := gcpkms.NewLocalEncryptionClient([]byte(localKMSEncryptionSeed))
+	return func(_ context.Context, _ oauth2.TokenSource) (gcpkms.EncryptionClient, error) {
+		return client, nil
+	}
+}
+
+// newOrganizationKeyring builds the keyring that seals secrets for
+// organizations with a customer-managed encryption key.
+func newOrganizationKeyring(ctx context.Context, logger *slog.Logger, c *cli.Context, db *pgxpool.Pool, enc *encryption.Client, identity *gcpauth.Identity) *envelope.Keyring {
+	return envelope.NewKeyring(logger, db, enc, identity, newKMSEncryptionClients(ctx, logger, c), envelope.DefaultCacheTTL)
+}
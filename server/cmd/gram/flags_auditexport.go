@@ -0,0 +1,62 @@
+package gram
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	auditExportBackendFS  = "fs"
+	auditExportBackendGCS = "gcs"
+	auditExportBackendS3  = "s3"
+)
+
+func auditExportFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    "audit-export-backend",
+			Usage:   "Object storage that organizations' audit logs are exported to. Leave empty to disable audit log export. Allowed values: fs, gcs, s3.",
+			EnvVars: []string{"GRAM_AUDIT_EXPORT_BACKEND"},
+			Action: func(_ *cli.Context, val string) error {
+				switch val {
+				case "", auditExportBackendFS, auditExportBackendGCS, auditExportBackendS3:
+					return nil
+				default:
+					return fmt.Errorf("invalid audit export backend: %s", val)
+				}
+			},
+		},
+		&cli.StringFlag{
+			Name:    "audit-export-uri",
+			Usage:   "The location of the audit export bucket: a directory for fs, or a gs:// or s3:// bucket URI.",
+			EnvVars: []string{"GRAM_AUDIT_EXPORT_URI"},
+		},
+		&cli.StringFlag{
+			Name:    "audit-export-s3-endpoint",
+			Usage:   "Endpoint of an S3-compatible service such as MinIO or R2. Leave empty for AWS S3.",
+			EnvVars: []string{"GRAM_AUDIT_EXPORT_S3_ENDPOINT"},
+		},
+		&cli.StringFlag{
+			Name:    "audit-export-s3-region",
+			Usage:   "Region of the S3 audit export bucket.",
+			Value:   "us-east-1",
+			EnvVars: []string{"GRAM_AUDIT_EXPORT_S3_REGION"},
+		},
+		&cli.BoolFlag{
+			Name:    "audit-export-s3-path-style",
+			Usage:   "Address the S3 audit export bucket with path-style URLs, as most self-hosted S3-compatible services require.",
+			EnvVars: []string{"GRAM_AUDIT_EXPORT_S3_PATH_STYLE"},
+		},
+		&cli.StringFlag{
+			Name:    "audit-export-s3-access-key",
+			Usage:   "Access key for the S3 audit export bucket. Leave empty to use the default AWS credential chain.",
+			EnvVars: []string{"GRAM_AUDIT_EXPORT_S3_ACCESS_KEY"},
+		},
+		&cli.StringFlag{
+			Name:    "audit-export-s3-secret-key",
+			Usage:   "Secret key for the S3 audit export bucket.",
+			EnvVars: []string{"GRAM_AUDIT_EXPORT_S3_SECRET_KEY"},
+		},
+	}
+}
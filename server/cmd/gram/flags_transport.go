@@ -0,0 +1,32 @@
+package gram
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	messageTransportPubSub   = "pubsub"
+	messageTransportPostgres = "postgres"
+	messageTransportRedis    = "redis"
+)
+
+func messageTransportFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    "message-transport",
+			Usage:   "Bus that carries published messages, including the outbox, to their subscribers. pubsub uses Google Pub/Sub (or its emulator); postgres and redis run the same topics over the application database or Redis Streams for deployments outside GCP. Every process in a deployment must use the same value. Allowed values: pubsub, postgres, redis.",
+			Value:   messageTransportPubSub,
+			EnvVars: []string{"GRAM_MESSAGE_TRANSPORT"},
+			Action: func(_ *cli.Context, val string) error {
+				switch val {
+				case messageTransportPubSub, messageTransportPostgres, messageTransportRedis:
+					return nil
+				default:
+					return fmt.Errorf("invalid message transport: %s", val)
+				}
+			},
+		},
+	}
+}
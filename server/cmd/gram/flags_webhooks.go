@@ -0,0 +1,31 @@
+package gram
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	webhookDeliveryBackendSvix       = "svix"
+	webhookDeliveryBackendSelfHosted = "self-hosted"
+)
+
+func webhookDeliveryFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    "webhook-delivery-backend",
+			Usage:   "Backend that delivers customer webhook events. svix relays them to Svix; self-hosted signs and sends them to endpoints registered through the webhookEndpoints API. Allowed values: svix, self-hosted.",
+			Value:   webhookDeliveryBackendSvix,
+			EnvVars: []string{"GRAM_WEBHOOK_DELIVERY_BACKEND"},
+			Action: func(_ *cli.Context, val string) error {
+				switch val {
+				case webhookDeliveryBackendSvix, webhookDeliveryBackendSelfHosted:
+					return nil
+				default:
+					return fmt.Errorf("invalid webhook delivery backend: %s", val)
+				}
+			},
+		},
+	}
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/assistants"
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/auditapi"
+	"github.com/speakeasy-api/gram/server/internal/auditexport"
 	"github.com/speakeasy-api/gram/server/internal/auth"
 	"github.com/speakeasy-api/gram/server/internal/auth/assistanttokens"
 	"github.com/speakeasy-api/gram/server/internal/auth/chatsessions"
@@ -60,7 +61,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/customdomains"
 	"github.com/speakeasy-api/gram/server/internal/deployments"
 	"github.com/speakeasy-api/gram/server/internal/deviceintegrations"
-	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/keyregistry"
 	"github.com/speakeasy-api/gram/server/internal/environments"
 	"github.com/speakeasy-api/gram/server/internal/external"
 	"github.com/speakeasy-api/gram/server/internal/externalcredentials"
@@ -68,6 +69,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/externalmcp"
 	"github.com/speakeasy-api/gram/server/internal/feature"
 	"github.com/speakeasy-api/gram/server/internal/functions"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
 	"github.com/speakeasy-api/gram/server/internal/hooks"
 	"github.com/speakeasy-api/gram/server/internal/instances"
 	"github.com/speakeasy-api/gram/server/internal/integrations"
@@ -89,6 +91,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/mcpapproval/repometa"
 	"github.com/speakeasy-api/gram/server/internal/mcpclient"
 	"github.com/speakeasy-api/gram/server/internal/mcpendpoints"
+	mcpendpointschrepo "github.com/speakeasy-api/gram/server/internal/mcpendpoints/chrepo"
 	"github.com/speakeasy-api/gram/server/internal/mcpmetadata"
 	mcpmetadata_repo "github.com/speakeasy-api/gram/server/internal/mcpmetadata/repo"
 	"github.com/speakeasy-api/gram/server/internal/mcpservers"
@@ -131,6 +134,7 @@ import (
 	piopenrouter "github.com/speakeasy-api/gram/server/internal/scanners/promptinjection/openrouter"
 	"github.com/speakeasy-api/gram/server/internal/scanners/promptpolicy"
 	ppopenrouter "github.com/speakeasy-api/gram/server/internal/scanners/promptpolicy/openrouter"
+	"github.com/speakeasy-api/gram/server/internal/secretrefs"
 	"github.com/speakeasy-api/gram/server/internal/shadowmcp"
 	"github.com/speakeasy-api/gram/server/internal/skillefficacy"
 	"github.com/speakeasy-api/gram/server/internal/skills"
@@ -140,7 +144,9 @@ import (
 	spendcelenv "github.com/speakeasy-api/gram/server/internal/spendrules/celenv"
 	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
 	telemetryrepo "github.com/speakeasy-api/gram/server/internal/telemetry/repo"
+	"github.com/speakeasy-api/gram/server/internal/telemetryalerts"
 	"github.com/speakeasy-api/gram/server/internal/templates"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/aws/awsauth"
 	ghclient "github.com/speakeasy-api/gram/server/internal/thirdparty/github"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/loops"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/openrouter"
@@ -149,7 +155,12 @@ import (
 	slackapi "github.com/speakeasy-api/gram/server/internal/thirdparty/slack/api"
 	slack_client "github.com/speakeasy-api/gram/server/internal/thirdparty/slack/client"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/workos"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals"
 	"github.com/speakeasy-api/gram/server/internal/toolcallobserver"
+	"github.com/speakeasy-api/gram/server/internal/toolcallrecordings"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+	constraintcelenv "github.com/speakeasy-api/gram/server/internal/toolconstraints/celenv"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
 	"github.com/speakeasy-api/gram/server/internal/trialemails"
 	"github.com/speakeasy-api/gram/server/internal/triggers"
 	"github.com/speakeasy-api/gram/server/internal/unproxiedmcp"
@@ -162,6 +173,7 @@ import (
 	userRepo "github.com/speakeasy-api/gram/server/internal/users/repo"
 	"github.com/speakeasy-api/gram/server/internal/usersessions"
 	"github.com/speakeasy-api/gram/server/internal/variations"
+	"github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted"
 	"github.com/speakeasy-api/gram/server/internal/xmcp"
 	"github.com/speakeasy-api/gram/tunnel/route"
 )
@@ -306,22 +318,46 @@ func newStartCommand() *cli.Command {
 			Value:   false,
 		},
 		&cli.StringFlag{
-			Name:     "idp-base-url",
-			Usage:    "OIDC identity provider base URL (e.g. http://localhost:35291/oauth2)",
-			EnvVars:  []string{"GRAM_IDP_BASE_URL"},
-			Required: true,
+			Name:    "auth-provider",
+			Usage:   "Identity provider for dashboard login: workos, or oidc for a generic OIDC issuer",
+			EnvVars: []string{"GRAM_AUTH_PROVIDER"},
+			Value:   authProviderWorkOS,
 		},
 		&cli.StringFlag{
-			Name:     "idp-client-id",
-			Usage:    "OIDC client ID for the identity provider",
-			EnvVars:  []string{"GRAM_IDP_CLIENT_ID"},
-			Required: true,
+			Name:    "idp-base-url",
+			Usage:   "OIDC identity provider base URL (e.g. http://localhost:35291/oauth2). Required with --auth-provider=workos",
+			EnvVars: []string{"GRAM_IDP_BASE_URL"},
+		},
+		&cli.StringFlag{
+			Name:    "idp-client-id",
+			Usage:   "OIDC client ID for the identity provider. Required with --auth-provider=workos",
+			EnvVars: []string{"GRAM_IDP_CLIENT_ID"},
 		},
 		&cli.StringFlag{
 			Name:    "idp-client-secret",
 			Usage:   "WorkOS API key for user management and identity lookups",
 			EnvVars: []string{"GRAM_IDP_CLIENT_SECRET"},
 		},
+		&cli.StringFlag{
+			Name:    "oidc-issuer",
+			Usage:   "Issuer URL of the generic OIDC identity provider used with --auth-provider=oidc",
+			EnvVars: []string{"GRAM_OIDC_ISSUER"},
+		},
+		&cli.StringFlag{
+			Name:    "oidc-client-id",
+			Usage:   "OAuth client ID registered with the generic OIDC identity provider",
+			EnvVars: []string{"GRAM_OIDC_CLIENT_ID"},
+		},
+		&cli.StringFlag{
+			Name:    "oidc-client-secret",
+			Usage:   "OAuth client secret registered with the generic OIDC identity provider",
+			EnvVars: []string{"GRAM_OIDC_CLIENT_SECRET"},
+		},
+		&cli.StringFlag{
+			Name:    "oidc-rules-file",
+			Usage:   "YAML file of the group, role and organization rules applied to generic OIDC sign-ins",
+			EnvVars: []string{"GRAM_OIDC_RULES_FILE"},
+		},
 		&cli.BoolFlag{
 			Name:    "with-otel-tracing",
 			Usage:   "Enable OpenTelemetry traces",
@@ -356,6 +392,16 @@ func newStartCommand() *cli.Command {
 			Required: true,
 			EnvVars:  []string{"GRAM_ENCRYPTION_KEY"},
 		},
+		&cli.StringFlag{
+			Name:    "encryption-key-id",
+			Usage:   "ID of --encryption-key, prefixed to new ciphertexts. Leave unset to keep writing unprefixed ciphertexts with a legacy key",
+			EnvVars: []string{"GRAM_ENCRYPTION_KEY_ID"},
+		},
+		&cli.StringSliceFlag{
+			Name:    "encryption-previous-keys",
+			Usage:   "Retired keys kept for decryption while their ciphertexts are re-encrypted, as <id>=<base64 key>, or a bare base64 key for the legacy unprefixed key",
+			EnvVars: []string{"GRAM_ENCRYPTION_PREVIOUS_KEYS"},
+		},
 		&cli.StringFlag{
 			Name:     usersessions.JWTSigningKeyFlag,
 			Usage:    "Key for JWT signing",
@@ -532,6 +578,31 @@ func newStartCommand() *cli.Command {
 			EnvVars:  []string{"GRAM_DISALLOWED_CIDR_BLOCKS"},
 			Required: false,
 		},
+		&cli.StringFlag{
+			Name:     "circuit-breaker",
+			Usage:    "Circuit breaker for outbound HTTP calls: noop (observe only), inproc (per-replica state) or redis (state shared across replicas)",
+			EnvVars:  []string{"GRAM_CIRCUIT_BREAKER"},
+			Value:    "noop",
+			Required: false,
+		},
+		&cli.StringFlag{
+			Name:     "egress-proxy-url",
+			Usage:    "Proxy to send outbound HTTP calls through: an http, https (CONNECT), socks5 or socks5h URL, with credentials as userinfo",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_URL"},
+			Required: false,
+		},
+		&cli.StringSliceFlag{
+			Name:     "egress-proxy-project",
+			Usage:    "Proxy for a single project's tool calls, as <project-id>=<proxy-url>. Overrides --egress-proxy-url for that project",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_PROJECTS"},
+			Required: false,
+		},
+		&cli.PathFlag{
+			Name:     "egress-proxy-ca-bundle",
+			Usage:    "PEM file of CA certificates to trust for the egress proxies and for upstream TLS they intercept",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_CA_BUNDLE"},
+			Required: false,
+		},
 		&cli.StringFlag{
 			Name:    "custom-domain-cname",
 			Usage:   "The expected CNAME target for custom domain verification (e.g., cname.getgram.ai.)",
@@ -584,6 +655,8 @@ func newStartCommand() *cli.Command {
 	flags = append(flags, svixFlags()...)
 	flags = append(flags, riskReconcileFlags()...)
 	flags = append(flags, gcpFlags()...)
+	flags = append(flags, messageTransportFlags()...)
+	flags = append(flags, auditExportFlags()...)
 
 	return &cli.Command{
 		Name:  "start",
@@ -662,6 +735,12 @@ func newStartCommand() *cli.Command {
 			}
 			shutdownFuncs = append(shutdownFuncs, shutdown)
 
+			auditExportStorage, auditExportShutdown, err := newAuditExportStorage(ctx, c, logger)
+			if err != nil {
+				return fmt.Errorf("failed to initialize audit export storage: %w", err)
+			}
+			shutdownFuncs = append(shutdownFuncs, auditExportShutdown)
+
 			redisClient, err := newRedisClient(ctx, redisClientOptions{
 				redisAddr:     c.String("redis-cache-addr"),
 				redisPassword: c.String("redis-cache-password"),
@@ -675,6 +754,8 @@ func newStartCommand() *cli.Command {
 			if err != nil {
 				return err
 			}
+			upstreamHealth := gateway.NewUpstreamHealth(logger, guardianPolicy)
+			shutdownFuncs = append(shutdownFuncs, upstreamHealth.Shutdown)
 
 			pylonClient, err := pylon.NewPylon(logger, c.String("pylon-verification-secret"))
 			if err != nil {
@@ -687,6 +768,10 @@ func newStartCommand() *cli.Command {
 				featureFlags = newLocalFeatureFlags(ctx, logger, c.String("local-feature-flags-csv"))
 			}
 
+			if err := validateAuthProviderFlags(c); err != nil {
+				return err
+			}
+
 			workosClient, workosAvailable, err := newWorkOSClient(guardianPolicy, c)
 			if err != nil {
 				return fmt.Errorf("failed to create WorkOS client: %w", err)
@@ -706,14 +791,24 @@ func newStartCommand() *cli.Command {
 				return fmt.Errorf("failed to create billing provider: %w", err)
 			}
 
-			idpClientSecret := c.String("idp-client-secret")
-
-			umClient := newIDPUserManagementClient(guardianPolicy, idpClientSecret, c)
-			if umClient == nil {
-				return fmt.Errorf("failed to create IDP user management client: idp-client-secret is required")
+			oidcProvider, err := newOIDCProvider(ctx, c, tracerProvider)
+			if err != nil {
+				return fmt.Errorf("failed to create OIDC identity provider: %w", err)
 			}
 
-			idpClient := identity.NewWorkOSAdapter(umClient)
+			// A generic OIDC issuer replaces WorkOS for sign-in, so the identity
+			// resolver and session manager are given no WorkOS clients at all.
+			var idpClient identity.IDPClient
+			var sessionRevoker sessions.SessionRevoker
+			var identityWorkOSClient identity.WorkOSClient
+			if oidcProvider == nil {
+				umClient := newIDPUserManagementClient(guardianPolicy, c.String("idp-client-secret"), c)
+				if umClient == nil {
+					return fmt.Errorf("failed to create IDP user management client: idp-client-secret is required")
+				}
+				workosAdapter := identity.NewWorkOSAdapter(umClient)
+				idpClient, sessionRevoker, identityWorkOSClient = workosAdapter, workosAdapter, workosClient
+			}
 
 			productFeatures := productfeatures.NewClient(logger, tracerProvider, db, redisClient)
 			authzProvisioner := authz.NewProvisioner(db)
@@ -725,7 +820,8 @@ func newStartCommand() *cli.Command {
 				c.String("idp-base-url"),
 				c.String("idp-client-id"),
 				idpClient,
-				workosClient,
+				identityWorkOSClient,
+				oidcProvider,
 				orgRepo.New(db),
 				userRepo.New(db),
 				pylonClient,
@@ -739,20 +835,31 @@ func newStartCommand() *cli.Command {
 				db,
 				redisClient,
 				cache.SuffixNone,
-				idpClient,
+				sessionRevoker,
 				billingRepo,
 				identityResolver,
 			)
 
 			chatSessionsManager := chatsessions.NewManager(logger, redisClient, c.String(usersessions.JWTSigningKeyFlag))
 
-			encryptionClient, err := encryption.New(c.String("encryption-key"))
+			encryptionClient, err := newEncryptionClient(c)
 			if err != nil {
 				return fmt.Errorf("failed to create encryption client: %w", err)
 			}
+			if err := keyregistry.Register(ctx, db, encryptionClient); err != nil {
+				return fmt.Errorf("register encryption keys: %w", err)
+			}
+
+			// Hoisted so the services that authenticate as a customer's GCP identity
+			// share one identity: they then agree on which impersonation targets are
+			// refused, and probe for Gram's own service account once between them
+			// rather than once each.
+			gcpIdentity := newGCPIdentity(ctx, logger, c)
+			orgKeys := newOrganizationKeyring(ctx, logger, c, db, encryptionClient, gcpIdentity)
+			secretRefs := secretrefs.NewResolver(logger, db, guardianPolicy, awsauth.NewIdentity(), secretrefs.DefaultCacheTTL)
 
 			mcpMetadataRepo := mcpmetadata_repo.New(db)
-			env := environments.NewEnvironmentEntries(logger, db, encryptionClient, mcpMetadataRepo)
+			env := environments.NewEnvironmentEntries(logger, db, encryptionClient, orgKeys, secretRefs, mcpMetadataRepo)
 
 			k8sClient, err := k8s.InitializeK8sClient(ctx, logger, c.String("environment"), c.String("custom-domain-k8s-namespace"), c.String("custom-domain-backend-service"))
 			if err != nil {
@@ -878,13 +985,13 @@ func newStartCommand() *cli.Command {
 				return errors.Join(errs...)
 			})
 
-			_, psbroker, shutdown, err := newPubSubClient(ctx, c, logger)
+			bus, shutdown, err := newMessaging(ctx, c, logger, db, redisClient)
 			pubsubShutdown = shutdown
 			if err != nil {
-				return fmt.Errorf("failed to create pubsub client: %w", err)
+				return fmt.Errorf("failed to create message transport: %w", err)
 			}
 
-			publishers, shutdown, err := newPublishers(ctx, psbroker)
+			publishers, shutdown, err := newPublishers(ctx, bus)
 			publishersShutdown = shutdown
 			if err != nil {
 				return fmt.Errorf("failed to create publishers: %w", err)
@@ -984,7 +1091,7 @@ func newStartCommand() *cli.Command {
 				return err
 			}
 			shadowMCPClient := shadowmcp.NewClient(logger, db, cache.NewRedisCacheAdapter(redisClient), serverURL)
-			triggerApp := newTriggersApp(logger, db, encryptionClient, temporalEnv, telemLogger, auditLogger, serverURL, siteURL, slackClient)
+			triggerApp := newTriggersApp(logger, db, encryptionClient, orgKeys, secretRefs, temporalEnv, telemLogger, auditLogger, serverURL, siteURL, slackClient)
 
 			platformFeatureChecker := productFeatures.PlatformFeatureCheck
 
@@ -1020,13 +1127,34 @@ func newStartCommand() *cli.Command {
 				meterProvider,
 				db,
 				encryptionClient,
+				orgKeys,
 				guardianPolicy,
 				cache.NewRedisCacheAdapter(redisClient),
 				serverURL,
 			)
 
 			toolDispositionCache := mcpservers.NewToolDispositionCache(logger, db, cache.NewRedisCacheAdapter(redisClient))
+			canaryRoutingCache := mcpendpoints.NewCanaryRoutingCache(logger, db, cache.NewRedisCacheAdapter(redisClient))
 			var platformSelectedUseRecorder toolcallobserver.SuccessRecorder = platformmcp.NewSelectedUseRecorder(db)
+			toolRateLimitCache := toolratelimits.NewLimitCache(logger, db, cache.NewRedisCacheAdapter(redisClient))
+			toolRateLimitEnforcer := toolratelimits.NewEnforcer(logger, meterProvider, toolRateLimitCache, ratelimit.NewRedisStore(redisClient))
+			toolConstraintCelEngine, err := constraintcelenv.New()
+			if err != nil {
+				return fmt.Errorf("create tool constraint cel engine: %w", err)
+			}
+			toolConstraintCache := toolconstraints.NewConstraintCache(logger, db, cache.NewRedisCacheAdapter(redisClient))
+			toolConstraintEnforcer, err := toolconstraints.NewEnforcer(logger, meterProvider, toolConstraintCache, toolConstraintCelEngine, publishers.RiskFindings)
+			if err != nil {
+				return fmt.Errorf("create tool constraint enforcer: %w", err)
+			}
+			toolApprovalNotifier := toolapprovals.NewNotifier(emailService, encryptionClient, guardianPolicy.Client())
+			toolApprovalPolicyCache := toolapprovals.NewPolicyCache(logger, db, cache.NewRedisCacheAdapter(redisClient))
+			toolApprovalGate, err := toolapprovals.NewGate(logger, meterProvider, db, toolApprovalPolicyCache, toolConstraintCelEngine, toolApprovalNotifier, serverURL)
+			if err != nil {
+				return fmt.Errorf("create tool approval gate: %w", err)
+			}
+			shutdownFuncs = append(shutdownFuncs, toolApprovalGate.Shutdown)
+			toolCallRecorder := toolcallrecordings.NewRecorder(logger, db, cache.NewRedisCacheAdapter(redisClient))
 			remoteProxyManager := remotemcp.NewProxyManager(
 				logger,
 				tracerProvider,
@@ -1040,6 +1168,8 @@ func newStartCommand() *cli.Command {
 				toolDispositionCache,
 				platformSelectedUseRecorder,
 				toolfilter.NewSessionToolWitnessStore(logger, cache.NewRedisCacheAdapter(redisClient)),
+				toolRateLimitEnforcer,
+				toolConstraintEnforcer,
 			)
 
 			// guardian.WithAllowedCIDRBlocks silently drops invalid CIDRs, so a
@@ -1067,6 +1197,7 @@ func newStartCommand() *cli.Command {
 				encryptionClient,
 				cache.NewRedisCacheAdapter(redisClient),
 				guardianPolicy,
+				upstreamHealth,
 				functionsOrchestrator,
 				billingTracker,
 				billingRepo,
@@ -1097,6 +1228,11 @@ func newStartCommand() *cli.Command {
 					RequestRate:        ratelimit.Rate{Tokens: 0, Interval: 0, Burst: 0},
 					MaxRequestLifetime: 0,
 				},
+				toolRateLimitEnforcer,
+				toolConstraintEnforcer,
+				toolApprovalGate,
+				toolCallRecorder,
+				canaryRoutingCache,
 			)
 
 			chatClient := chat.NewAgenticChatClient(
@@ -1360,6 +1496,7 @@ func newStartCommand() *cli.Command {
 			modelkeys.Attach(mux, modelkeys.NewService(logger, tracerProvider, db, sessionManager, authzEngine, encryptionClient, openRouter, productFeatures, auditLogger))
 			otelforwarding.Attach(mux, otelforwarding.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger, otelForwardClient))
 			auditapi.Attach(mux, auditapi.NewService(logger, tracerProvider, db, sessionManager, authzEngine))
+			auditexport.Attach(mux, auditexport.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger))
 			auth.Attach(mux, auth.NewService(
 				logger,
 				tracerProvider,
@@ -1465,11 +1602,6 @@ func newStartCommand() *cli.Command {
 			deploymentsService := deployments.NewService(logger, tracerProvider, db, temporalEnv, sessionManager, assetStorage, posthogClient, siteURL, mcpRegistryClient, authzEngine, auditLogger)
 			deployments.Attach(mux, deploymentsService)
 			keys.Attach(mux, keys.NewService(logger, tracerProvider, db, sessionManager, c.String("environment"), authzEngine, auditLogger))
-			// Hoisted so the services that authenticate as a customer's GCP identity
-			// share one identity: they then agree on which impersonation targets are
-			// refused, and probe for Gram's own service account once between them
-			// rather than once each.
-			gcpIdentity := newGCPIdentity(ctx, logger, c)
 			kmsSigningClients, err := newKMSSigningClients(ctx, logger, c)
 			if err != nil {
 				return fmt.Errorf("build kms signing client factory: %w", err)
@@ -1479,14 +1611,14 @@ func newStartCommand() *cli.Command {
 			jsonwebkeysets.Attach(mux, jsonwebkeysets.NewService(logger, tracerProvider, meterProvider, db, sessionManager, authzEngine, auditLogger, gcpIdentity, kmsSigningClients, productFeatures, ratelimit.NewRedisStore(redisClient)))
 			cliauth.Attach(mux, cliauth.NewService(logger, tracerProvider, db, sessionManager, authzEngine, redisClient, c.String("environment")))
 			chatsessionssvc.Attach(mux, chatsessionssvc.NewService(logger, tracerProvider, db, sessionManager, chatSessionsManager, authzEngine))
-			environments.Attach(mux, environments.NewService(logger, tracerProvider, db, sessionManager, encryptionClient, authzEngine, auditLogger))
+			environments.Attach(mux, environments.NewService(logger, tracerProvider, db, sessionManager, encryptionClient, orgKeys, authzEngine, auditLogger))
 			mcpServersService := mcpservers.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger, temporalEnv, toolDispositionCache, pluginsGitHub != nil, assetsService)
 			mcpservers.Attach(mux, mcpServersService)
-			mcpendpoints.Attach(mux, mcpendpoints.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger, temporalEnv, pluginsGitHub != nil))
+			mcpendpoints.Attach(mux, mcpendpoints.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger, temporalEnv, mcpendpointschrepo.New(chDB), canaryRoutingCache, pluginsGitHub != nil))
 			metamcp.Attach(mux, metamcp.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger, temporalEnv))
 			remoteSessionsCache := cache.NewRedisCacheAdapter(redisClient)
-			remoteSessionsService := remotesessions.NewService(logger, tracerProvider, meterProvider, db, sessionManager, authzEngine, encryptionClient, env, guardianPolicy, auditLogger, serverURL, remotesessions.NewRefreshService(logger, db, encryptionClient, guardianPolicy, remoteSessionsCache))
-			usersessions.Attach(mux, usersessions.NewService(logger, tracerProvider, meterProvider, db, sessionManager, chatSessionsManager, authzEngine, auditLogger, guardianPolicy, encryptionClient, usersessions.NewSigner(c.String(usersessions.JWTSigningKeyFlag)), serverURL.String(), ratelimit.NewRedisStore(redisClient)))
+			remoteSessionsService := remotesessions.NewService(logger, tracerProvider, meterProvider, db, sessionManager, authzEngine, encryptionClient, orgKeys, env, guardianPolicy, auditLogger, serverURL, remotesessions.NewRefreshService(logger, db, encryptionClient, orgKeys, guardianPolicy, remoteSessionsCache))
+			usersessions.Attach(mux, usersessions.NewService(logger, tracerProvider, meterProvider, db, sessionManager, chatSessionsManager, authzEngine, auditLogger, guardianPolicy, encryptionClient, orgKeys, usersessions.NewSigner(c.String(usersessions.JWTSigningKeyFlag)), serverURL.String(), ratelimit.NewRedisStore(redisClient)))
 			tokenexchange.Attach(mux, tokenexchange.NewService(logger, tracerProvider, db, sessionManager, authzEngine, c.String("environment")))
 			remotesessions.Attach(mux, remoteSessionsService)
 			remotemcp.Attach(mux, remotemcp.NewService(logger, tracerProvider, db, sessionManager, encryptionClient, authzEngine, guardianPolicy, auditLogger, mcpServersService))
@@ -1523,7 +1655,7 @@ func newStartCommand() *cli.Command {
 					return nil
 				})
 			mcpapproval.Attach(mux, mcpApprovalService)
-			instances.Attach(mux, instances.NewService(logger, tracerProvider, meterProvider, db, sessionManager, chatSessionsManager, env, encryptionClient, cache.NewRedisCacheAdapter(redisClient), guardianPolicy, functionsOrchestrator, platformSvc, billingTracker, telemLogger, productFeatures, serverURL, authzEngine))
+			instances.Attach(mux, instances.NewService(logger, tracerProvider, meterProvider, db, sessionManager, chatSessionsManager, env, encryptionClient, cache.NewRedisCacheAdapter(redisClient), guardianPolicy, upstreamHealth, functionsOrchestrator, platformSvc, billingTracker, telemLogger, productFeatures, serverURL, authzEngine))
 			mcpmetadata.Attach(mux, mcpMetadataService)
 			mcpCatalog := externalmcp.NewCatalogService(db, mcpRegistryClient, nil)
 			externalmcp.Attach(mux, externalmcp.NewService(logger, tracerProvider, db, sessionManager, mcpRegistryClient, mcpCatalog, authzEngine, serverURL))
@@ -1560,6 +1692,12 @@ func newStartCommand() *cli.Command {
 			mcp.Attach(mux, mcpService, mcpMetadataService)
 			chat.Attach(mux, chatService)
 			variations.Attach(mux, variations.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger))
+			toolratelimits.Attach(mux, toolratelimits.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger, toolRateLimitCache))
+			toolconstraints.Attach(mux, toolconstraints.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger, toolConstraintCelEngine, toolConstraintCache))
+			toolapprovals.Attach(mux, toolapprovals.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger, toolConstraintCelEngine, encryptionClient, toolApprovalPolicyCache), toolApprovalGate)
+			toolcallrecordings.Attach(mux, toolcallrecordings.NewService(logger, tracerProvider, meterProvider, db, sessionManager, authzEngine, encryptionClient, guardianPolicy, toolCallRecorder))
+			telemetryalerts.Attach(mux, telemetryalerts.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger))
+			selfhosted.Attach(mux, selfhosted.NewService(logger, tracerProvider, db, sessionManager, authzEngine, auditLogger, encryptionClient, guardianPolicy))
 			customdomains.Attach(mux, customdomains.NewService(logger, tracerProvider, db, sessionManager, &background.CustomDomainRegistrationClient{TemporalEnv: temporalEnv}, authzEngine, auditLogger))
 			usage.Attach(mux, usage.NewService(logger, tracerProvider, db, sessionManager, billingRepo, serverURL, siteURL, posthogClient, openRouter, openRouterKeyRefresher, stripeClient, authzEngine, telemetryrepo.New(chDB), auditLogger, featureFlags, productFeatures, trialEmailNotifier))
 			tm.Attach(mux, telemSvc)
@@ -1715,6 +1853,7 @@ func newStartCommand() *cli.Command {
 						GuardianPolicy:            guardianPolicy,
 						DB:                        db,
 						EncryptionClient:          encryptionClient,
+						OrganizationKeys:          orgKeys,
 						FeatureProvider:           featureFlags,
 						AssetStorage:              assetStorage,
 						SlackClient:               slackClient,
@@ -1724,6 +1863,7 @@ func newStartCommand() *cli.Command {
 						OpenRouterSpend:           openRouter,
 						K8sClient:                 k8sClient,
 						ExpectedTargetCNAME:       c.String("custom-domain-cname"),
+						AuditExportStorage:        auditExportStorage,
 						GitHubEvidenceToken:       c.String("github-evidence-token"),
 						SiteURL:                   siteURL,
 						BillingTracker:            billingTracker,
@@ -42,7 +42,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/constants"
 	"github.com/speakeasy-api/gram/server/internal/contextvalues"
 	"github.com/speakeasy-api/gram/server/internal/control"
-	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/keyregistry"
 	"github.com/speakeasy-api/gram/server/internal/feature"
 	"github.com/speakeasy-api/gram/server/internal/modelkeys"
 	"github.com/speakeasy-api/gram/server/internal/must"
@@ -64,7 +64,9 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/subscribers"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/openrouter"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/posthog"
+	"github.com/speakeasy-api/gram/server/internal/transport"
 	"github.com/speakeasy-api/gram/server/internal/usage"
+	"github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted"
 	"github.com/speakeasy-api/gram/server/internal/webhooks/svixrelay"
 )
 
@@ -130,6 +132,16 @@ func newStreamsCommand() *cli.Command {
 			Required: true,
 			EnvVars:  []string{"GRAM_ENCRYPTION_KEY"},
 		},
+		&cli.StringFlag{
+			Name:    "encryption-key-id",
+			Usage:   "ID of --encryption-key, prefixed to new ciphertexts. Leave unset to keep writing unprefixed ciphertexts with a legacy key",
+			EnvVars: []string{"GRAM_ENCRYPTION_KEY_ID"},
+		},
+		&cli.StringSliceFlag{
+			Name:    "encryption-previous-keys",
+			Usage:   "Retired keys kept for decryption while their ciphertexts are re-encrypted, as <id>=<base64 key>, or a bare base64 key for the legacy unprefixed key",
+			EnvVars: []string{"GRAM_ENCRYPTION_PREVIOUS_KEYS"},
+		},
 		&cli.StringFlag{
 			Name:    "openrouter-dev-key",
 			Usage:   "Dev API key for OpenRouter (primarily for local development) - https://openrouter.ai/settings/keys",
@@ -260,6 +272,31 @@ func newStreamsCommand() *cli.Command {
 			EnvVars:  []string{"GRAM_DISALLOWED_CIDR_BLOCKS"},
 			Required: false,
 		},
+		&cli.StringFlag{
+			Name:     "circuit-breaker",
+			Usage:    "Circuit breaker for outbound HTTP calls: noop (observe only), inproc (per-replica state) or redis (state shared across replicas)",
+			EnvVars:  []string{"GRAM_CIRCUIT_BREAKER"},
+			Value:    "noop",
+			Required: false,
+		},
+		&cli.StringFlag{
+			Name:     "egress-proxy-url",
+			Usage:    "Proxy to send outbound HTTP calls through: an http, https (CONNECT), socks5 or socks5h URL, with credentials as userinfo",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_URL"},
+			Required: false,
+		},
+		&cli.StringSliceFlag{
+			Name:     "egress-proxy-project",
+			Usage:    "Proxy for a single project's tool calls, as <project-id>=<proxy-url>. Overrides --egress-proxy-url for that project",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_PROJECTS"},
+			Required: false,
+		},
+		&cli.PathFlag{
+			Name:     "egress-proxy-ca-bundle",
+			Usage:    "PEM file of CA certificates to trust for the egress proxies and for upstream TLS they intercept",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_CA_BUNDLE"},
+			Required: false,
+		},
 		&cli.PathFlag{
 			Name:     "config-file",
 			Usage:    "Path to a config file to load. Supported formats are JSON, TOML and YAML.",
@@ -269,7 +306,9 @@ func newStreamsCommand() *cli.Command {
 	}
 
 	flags = append(flags, gcpFlags()...)
+	flags = append(flags, messageTransportFlags()...)
 	flags = append(flags, svixFlags()...)
+	flags = append(flags, webhookDeliveryFlags()...)
 	flags = append(flags, posthogFlags()...)
 	flags = append(flags, riskIngestFlags()...)
 	flags = append(flags, clickHouseFlags()...)
@@ -340,10 +379,13 @@ func newStreamsCommand() *cli.Command {
 			}
 			defer db.Close()
 
-			encryptionClient, err := encryption.New(c.String("encryption-key"))
+			encryptionClient, err := newEncryptionClient(c)
 			if err != nil {
 				return fmt.Errorf("failed to create encryption client: %w", err)
 			}
+			if err := keyregistry.Register(ctx, db, encryptionClient); err != nil {
+				return fmt.Errorf("register encryption keys: %w", err)
+			}
 
 			replicaDB, err := newDBClient(ctx, logger, meterProvider, c.String("database-read-replica-url"), dbClientOptions{
 				enableUnsafeLogging: c.Bool("unsafe-db-log"),
@@ -403,17 +445,18 @@ func newStreamsCommand() *cli.Command {
 			)
 			judgeRateLimiter := openrouter.NewJudgeRateLimiter(ratelimit.NewRedisStore(redisClient))
 
-			_, psbroker, pubsubShutdown, err := newPubSubClient(ctx, c, logger)
+			bus, pubsubShutdown, err := newMessaging(ctx, c, logger, db, redisClient)
 			if err != nil {
-				return fmt.Errorf("failed to create pubsub client: %w", err)
+				return fmt.Errorf("failed to create message transport: %w", err)
 			}
 			var (
 				findingsPub gcp.Publisher[*riskv1.Finding]
 				logPub      gcp.Publisher[*otelv1.LogRecord]
 				spanPub     gcp.Publisher[*otelv1.Span]
+				pingPub     gcp.Publisher[*pingv2.Message]
 			)
 			shutdownFuncs = append(shutdownFuncs, func(ctx context.Context) error {
-				return shutdownPubSubPublishers(ctx, pubsubShutdown, findingsPub, logPub, spanPub)
+				return shutdownPubSubPublishers(ctx, pubsubShutdown, findingsPub, logPub, spanPub, pingPub)
 			})
 
 			riskFingerprinter, err := risk.ParsePepperKeyRing([]byte(c.String("risk-fingerprint-pepper-keyring")))
@@ -431,7 +474,7 @@ func newStreamsCommand() *cli.Command {
 			// Gitleaks shadow-mode subscriber: re-runs the in-process gitleaks
 			// scan over GitleaksAnalysis requests and publishes any matches into
 			// the shared Finding topic (nothing consumes them yet).
-			findingsPub, err = gcp.PubSubPublisherForMessage(ctx, psbroker, &riskv1.Finding{})
+			findingsPub, err = publisherForMessage(ctx, bus, &riskv1.Finding{}, nil)
 			if err != nil {
 				return fmt.Errorf("failed to create pubsub publisher for risk findings: %w", err)
 			}
@@ -489,22 +532,43 @@ func newStreamsCommand() *cli.Command {
 				getContext: func() context.Context { return gctx },
 				tracer:     tracerProvider.Tracer("github.com/speakeasy-api/gram/server/cmd/gram/streams"),
 				logger:     logger,
-				broker:     psbroker,
+				bus:        bus,
 			}
 
-			svixClient, svixShutdown, err := newSvixClient(c, logger, guardianPolicy)
-			if err != nil {
-				return fmt.Errorf("failed to create svix client: %w", err)
+			// Customer webhooks go to exactly one delivery backend. The
+			// self-hosted backend needs no Svix account: its subscriber queues
+			// deliveries in Postgres and the dispatcher sends them.
+			var webhookDeliveryHandler streams.Handler[*webhooksv1.Event]
+			var webhookDeliveryName string
+			switch c.String("webhook-delivery-backend") {
+			case webhookDeliveryBackendSelfHosted:
+				webhookDeliveryHandler = selfhosted.NewHandler(logger, meterProvider, db)
+				webhookDeliveryName = "queue webhook event for self-hosted delivery"
+
+				dispatcher := selfhosted.NewDispatcher(logger, tracerProvider, meterProvider, db, encryptionClient, guardianPolicy)
+				group.Go(func() error {
+					if err := dispatcher.Run(gctx); err != nil {
+						return fmt.Errorf("dispatch self-hosted webhooks: %w", err)
+					}
+					return nil
+				})
+			default:
+				svixClient, svixShutdown, err := newSvixClient(c, logger, guardianPolicy)
+				if err != nil {
+					return fmt.Errorf("failed to create svix client: %w", err)
+				}
+				shutdownFuncs = append(shutdownFuncs, svixShutdown)
+
+				webhookDeliveryHandler = svixrelay.NewHandler(logger, meterProvider, db, svixClient)
+				webhookDeliveryName = "relay webhook event to Svix"
 			}
-			shutdownFuncs = append(shutdownFuncs, svixShutdown)
 
-			svixRelayHandler := svixrelay.NewHandler(logger, meterProvider, db, svixClient)
 			paygKeyRefreshHandler := usage.NewPaygKeyRefreshHandler(logger, openRouterKeyRefresher)
 			billingNotificationHandler := billingnotifications.NewEventHandler(logger, &background.TemporalBillingEmailScheduler{TemporalEnv: temporalEnv})
 			webhookEventHandler := streams.HandlerFunc[*webhooksv1.Event](func(ctx context.Context, event *webhooksv1.Event, metadata gcp.MessageMetadata) error {
 				var handlerErrors []error
-				if err := svixRelayHandler.Handle(ctx, event, metadata); err != nil {
-					handlerErrors = append(handlerErrors, fmt.Errorf("relay webhook event to Svix: %w", err))
+				if err := webhookDeliveryHandler.Handle(ctx, event, metadata); err != nil {
+					handlerErrors = append(handlerErrors, fmt.Errorf("%s: %w", webhookDeliveryName, err))
 				}
 				if err := paygKeyRefreshHandler.Handle(ctx, event, metadata); err != nil {
 					handlerErrors = append(handlerErrors, fmt.Errorf("schedule PAYG key refresh: %w", err))
@@ -515,12 +579,12 @@ func newStreamsCommand() *cli.Command {
 				return errors.Join(handlerErrors...)
 			})
 
-			logPub, err = gcp.PubSubPublisherForMessage(ctx, psbroker, &otelv1.LogRecord{})
+			logPub, err = publisherForMessage(ctx, bus, &otelv1.LogRecord{}, nil)
 			if err != nil {
 				return fmt.Errorf("failed to create pubsub publisher for otel logs: %w", err)
 			}
 
-			spanPub, err = gcp.PubSubPublisherForMessage(ctx, psbroker, &otelv1.Span{})
+			spanPub, err = publisherForMessage(ctx, bus, &otelv1.Span{}, nil)
 			if err != nil {
 				return fmt.Errorf("failed to create pubsub publisher for otel spans: %w", err)
 			}
@@ -581,8 +645,12 @@ func newStreamsCommand() *cli.Command {
 			// This is just a heartbeat publisher that validates the publisher-
 			// subscriber flow is working by driving a simple message through
 			// the system every N seconds and logging it in the subscriber.
+			pingPub, err = publisherForMessage(ctx, bus, &pingv2.Message{}, nil)
+			if err != nil {
+				return fmt.Errorf("failed to create pubsub publisher for pings: %w", err)
+			}
 			group.Go(func() error {
-				if err := ping.StartPublisher(gctx, logger, psbroker); err != nil {
+				if err := ping.StartPublisher(gctx, logger, pingPub); err != nil {
 					return fmt.Errorf("publish pings: %w", err)
 				}
 				return nil
@@ -637,7 +705,7 @@ type receiverGroup struct {
 	getContext func() context.Context
 	tracer     trace.Tracer
 	logger     *slog.Logger
-	broker     gcp.SubscriberBroker
+	bus        *messaging
 }
 
 // setupSubscriber resolves the subscriber for a message/subscription pair and
@@ -655,7 +723,11 @@ func setupSubscriber[M proto.Message](
 	ctx = g.getContext()
 	// Prepend so callers can still override the logger via options if needed.
 	options = append([]gcp.SubscriberOption{gcp.WithSubscriberLogger(g.logger)}, options...)
-	sub, err = gcp.PubSubSubscriberForMessage(ctx, g.broker, msg, subscription, options...)
+	if g.bus.transport != nil {
+		sub, err = transport.NewSubscriber(g.bus.transport, msg, subscription, options...)
+	} else {
+		sub, err = gcp.PubSubSubscriberForMessage(ctx, g.bus.broker, msg, subscription, options...)
+	}
 	if err != nil {
 		return nil, "", "", nil, fmt.Errorf("get subscriber for message %T: %T: %w", subscription, msg, err)
 	}
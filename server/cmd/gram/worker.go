@@ -32,13 +32,15 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/chat/analysis"
 	"github.com/speakeasy-api/gram/server/internal/control"
 	"github.com/speakeasy-api/gram/server/internal/conv"
-	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/keyregistry"
 	"github.com/speakeasy-api/gram/server/internal/environments"
 	"github.com/speakeasy-api/gram/server/internal/feature"
 	"github.com/speakeasy-api/gram/server/internal/functions"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
 	"github.com/speakeasy-api/gram/server/internal/k8s"
 	"github.com/speakeasy-api/gram/server/internal/mcp"
 	"github.com/speakeasy-api/gram/server/internal/mcpclient"
+	"github.com/speakeasy-api/gram/server/internal/mcpendpoints"
 	mcpmetadata_repo "github.com/speakeasy-api/gram/server/internal/mcpmetadata/repo"
 	"github.com/speakeasy-api/gram/server/internal/memory"
 	"github.com/speakeasy-api/gram/server/internal/modelkeys"
@@ -60,12 +62,14 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/scanners/customruleanalyzer"
 	"github.com/speakeasy-api/gram/server/internal/scanners/promptinjection"
 	piopenrouter "github.com/speakeasy-api/gram/server/internal/scanners/promptinjection/openrouter"
+	"github.com/speakeasy-api/gram/server/internal/secretrefs"
 	"github.com/speakeasy-api/gram/server/internal/shadowmcp"
 	"github.com/speakeasy-api/gram/server/internal/skills/efficacy"
 	feedbackrecorder "github.com/speakeasy-api/gram/server/internal/skills/feedback"
 	"github.com/speakeasy-api/gram/server/internal/spendrules"
 	"github.com/speakeasy-api/gram/server/internal/telemetry"
 	telemetryrepo "github.com/speakeasy-api/gram/server/internal/telemetry/repo"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/aws/awsauth"
 	ghclient "github.com/speakeasy-api/gram/server/internal/thirdparty/github"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/loops"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/openrouter"
@@ -74,6 +78,11 @@ import (
 	slackapi "github.com/speakeasy-api/gram/server/internal/thirdparty/slack/api"
 	slack_client "github.com/speakeasy-api/gram/server/internal/thirdparty/slack/client"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/workos"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals"
+	"github.com/speakeasy-api/gram/server/internal/toolcallrecordings"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+	constraintcelenv "github.com/speakeasy-api/gram/server/internal/toolconstraints/celenv"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
 	"github.com/speakeasy-api/gram/server/internal/trialemails"
 	userRepo "github.com/speakeasy-api/gram/server/internal/users/repo"
 	"github.com/speakeasy-api/gram/server/internal/usersessions"
@@ -186,6 +195,16 @@ func newWorkerCommand() *cli.Command {
 			Required: true,
 			EnvVars:  []string{"GRAM_ENCRYPTION_KEY"},
 		},
+		&cli.StringFlag{
+			Name:    "encryption-key-id",
+			Usage:   "ID of --encryption-key, prefixed to new ciphertexts. Leave unset to keep writing unprefixed ciphertexts with a legacy key",
+			EnvVars: []string{"GRAM_ENCRYPTION_KEY_ID"},
+		},
+		&cli.StringSliceFlag{
+			Name:    "encryption-previous-keys",
+			Usage:   "Retired keys kept for decryption while their ciphertexts are re-encrypted, as <id>=<base64 key>, or a bare base64 key for the legacy unprefixed key",
+			EnvVars: []string{"GRAM_ENCRYPTION_PREVIOUS_KEYS"},
+		},
 		&cli.StringFlag{
 			Name:    "openrouter-dev-key",
 			Usage:   "Dev API key for OpenRouter (primarily for local development) - https://openrouter.ai/settings/keys",
@@ -207,6 +226,31 @@ func newWorkerCommand() *cli.Command {
 			EnvVars:  []string{"GRAM_DISALLOWED_CIDR_BLOCKS"},
 			Required: false,
 		},
+		&cli.StringFlag{
+			Name:     "circuit-breaker",
+			Usage:    "Circuit breaker for outbound HTTP calls: noop (observe only), inproc (per-replica state) or redis (state shared across replicas)",
+			EnvVars:  []string{"GRAM_CIRCUIT_BREAKER"},
+			Value:    "noop",
+			Required: false,
+		},
+		&cli.StringFlag{
+			Name:     "egress-proxy-url",
+			Usage:    "Proxy to send outbound HTTP calls through: an http, https (CONNECT), socks5 or socks5h URL, with credentials as userinfo",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_URL"},
+			Required: false,
+		},
+		&cli.StringSliceFlag{
+			Name:     "egress-proxy-project",
+			Usage:    "Proxy for a single project's tool calls, as <project-id>=<proxy-url>. Overrides --egress-proxy-url for that project",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_PROJECTS"},
+			Required: false,
+		},
+		&cli.PathFlag{
+			Name:     "egress-proxy-ca-bundle",
+			Usage:    "PEM file of CA certificates to trust for the egress proxies and for upstream TLS they intercept",
+			EnvVars:  []string{"GRAM_EGRESS_PROXY_CA_BUNDLE"},
+			Required: false,
+		},
 		&cli.StringFlag{
 			Name:    "stripe-api-key",
 			Usage:   "The Stripe API key",
@@ -312,16 +356,20 @@ func newWorkerCommand() *cli.Command {
 			Required: false,
 		},
 		&cli.StringFlag{
-			Name:     "idp-base-url",
-			Usage:    "OIDC identity provider base URL (e.g. http://localhost:35291/oauth2)",
-			EnvVars:  []string{"GRAM_IDP_BASE_URL"},
-			Required: true,
+			Name:    "auth-provider",
+			Usage:   "Identity provider for dashboard login: workos, or oidc for a generic OIDC issuer",
+			EnvVars: []string{"GRAM_AUTH_PROVIDER"},
+			Value:   authProviderWorkOS,
 		},
 		&cli.StringFlag{
-			Name:     "idp-client-id",
-			Usage:    "OIDC client ID for the identity provider",
-			EnvVars:  []string{"GRAM_IDP_CLIENT_ID"},
-			Required: true,
+			Name:    "idp-base-url",
+			Usage:   "OIDC identity provider base URL (e.g. http://localhost:35291/oauth2). Required with --auth-provider=workos",
+			EnvVars: []string{"GRAM_IDP_BASE_URL"},
+		},
+		&cli.StringFlag{
+			Name:    "idp-client-id",
+			Usage:   "OIDC client ID for the identity provider. Required with --auth-provider=workos",
+			EnvVars: []string{"GRAM_IDP_CLIENT_ID"},
 		},
 		&cli.StringFlag{
 			Name:    "idp-client-secret",
@@ -375,6 +423,8 @@ func newWorkerCommand() *cli.Command {
 	flags = append(flags, posthogFlags()...)
 	flags = append(flags, riskReconcileFlags()...)
 	flags = append(flags, gcpFlags()...)
+	flags = append(flags, messageTransportFlags()...)
+	flags = append(flags, auditExportFlags()...)
 
 	return &cli.Command{
 		Name:  "worker",
@@ -451,11 +501,16 @@ func newWorkerCommand() *cli.Command {
 			if err != nil {
 				return err
 			}
+			upstreamHealth := gateway.NewUpstreamHealth(logger, guardianPolicy)
+			shutdownFuncs = append(shutdownFuncs, upstreamHealth.Shutdown)
 
-			encryptionClient, err := encryption.New(c.String("encryption-key"))
+			encryptionClient, err := newEncryptionClient(c)
 			if err != nil {
 				return fmt.Errorf("failed to create encryption client: %w", err)
 			}
+			if err := keyregistry.Register(ctx, db, encryptionClient); err != nil {
+				return fmt.Errorf("register encryption keys: %w", err)
+			}
 
 			auditLogger := newAuditLogger()
 
@@ -506,8 +561,11 @@ func newWorkerCommand() *cli.Command {
 				logger.InfoContext(ctx, "GitHub publishing for plugins: disabled")
 			}
 
+			orgKeys := newOrganizationKeyring(ctx, logger, c, db, encryptionClient, newGCPIdentity(ctx, logger, c))
+			secretRefs := secretrefs.NewResolver(logger, db, guardianPolicy, awsauth.NewIdentity(), secretrefs.DefaultCacheTTL)
+
 			mcpMetadataRepo := mcpmetadata_repo.New(db)
-			env := environments.NewEnvironmentEntries(logger, db, encryptionClient, mcpMetadataRepo)
+			env := environments.NewEnvironmentEntries(logger, db, encryptionClient, orgKeys, secretRefs, mcpMetadataRepo)
 
 			k8sClient, err := k8s.InitializeK8sClient(ctx, logger, c.String("environment"), c.String("custom-domain-k8s-namespace"), c.String("custom-domain-backend-service"))
 			if err != nil {
@@ -523,18 +581,24 @@ func newWorkerCommand() *cli.Command {
 			}
 			shutdownFuncs = append(shutdownFuncs, shutdown)
 
+			auditExportStorage, auditExportShutdown, err := newAuditExportStorage(ctx, c, logger)
+			if err != nil {
+				return fmt.Errorf("failed to initialize audit export storage: %w", err)
+			}
+			shutdownFuncs = append(shutdownFuncs, auditExportShutdown)
+
 			emailService, err := newEmailService(ctx, c, logger, guardianPolicy)
 			if err != nil {
 				return err
 			}
 
-			_, psbroker, pubsubShutdown, err := newPubSubClient(ctx, c, logger)
+			bus, pubsubShutdown, err := newMessaging(ctx, c, logger, db, redisClient)
 			if err != nil {
 				shutdownFuncs = append(shutdownFuncs, pubsubShutdown)
-				return fmt.Errorf("failed to create pubsub client: %w", err)
+				return fmt.Errorf("failed to create message transport: %w", err)
 			}
 
-			publishers, shutdown, err := newPublishers(ctx, psbroker)
+			publishers, shutdown, err := newPublishers(ctx, bus)
 			// Make sure topics are stopped and flushed before the pubsub client
 			// is stopped.
 			shutdownFuncs = append(shutdownFuncs, shutdown, pubsubShutdown)
@@ -633,6 +697,10 @@ func newWorkerCommand() *cli.Command {
 					DevMode: c.String("environment") == "local",
 				})
 
+			if err := validateAuthProviderFlags(c); err != nil {
+				return err
+			}
+
 			workosClient, workosAvailable, err := newWorkOSClient(guardianPolicy, c)
 			if err != nil {
 				return fmt.Errorf("failed to create WorkOS client: %w", err)
@@ -732,15 +800,19 @@ func newWorkerCommand() *cli.Command {
 				return fmt.Errorf("failed to create pylon client: %w", err)
 			}
 
-			idpClientSecret := c.String("idp-client-secret")
-
-			umClient := newIDPUserManagementClient(guardianPolicy, idpClientSecret, c)
-			if umClient == nil {
-				return fmt.Errorf("failed to create IDP user management client: idp-client-secret is required")
+			// The worker never signs anyone in, so with a generic OIDC issuer it
+			// needs no identity provider client at all.
+			var idpClient identity.IDPClient
+			var sessionRevoker sessions.SessionRevoker
+			if c.String("auth-provider") == authProviderWorkOS {
+				umClient := newIDPUserManagementClient(guardianPolicy, c.String("idp-client-secret"), c)
+				if umClient == nil {
+					return fmt.Errorf("failed to create IDP user management client: idp-client-secret is required")
+				}
+				workosAdapter := identity.NewWorkOSAdapter(umClient)
+				idpClient, sessionRevoker = workosAdapter, workosAdapter
 			}
 
-			idpClient := identity.NewWorkOSAdapter(umClient)
-
 			identityResolver := identity.NewResolver(
 				logger,
 				tracerProvider,
@@ -749,6 +821,7 @@ func newWorkerCommand() *cli.Command {
 				c.String("idp-client-id"),
 				idpClient,
 				nil, // no WorkOS client in worker
+				nil, // the worker never signs anyone in
 				orgRepo.New(db),
 				userRepo.New(db),
 				pylonClient,
@@ -756,14 +829,14 @@ func newWorkerCommand() *cli.Command {
 				cache.SuffixNone,
 			)
 
-			sessionManager := sessions.NewManager(logger, tracerProvider, db, redisClient, cache.SuffixNone, idpClient, billingRepo, identityResolver)
+			sessionManager := sessions.NewManager(logger, tracerProvider, db, redisClient, cache.SuffixNone, sessionRevoker, billingRepo, identityResolver)
 
 			chatSessionsManager := chatsessions.NewManager(logger, redisClient, c.String(usersessions.JWTSigningKeyFlag))
 
 			// The worker never serves webhook ingress (ProcessWebhook lives in
 			// the HTTP server), so the dashboard site URL used for Slack link
 			// unfurls is not needed here.
-			triggerApp := newTriggersApp(logger, db, encryptionClient, temporalEnv, telemetryLogger, auditLogger, serverURL, nil, slackClient)
+			triggerApp := newTriggersApp(logger, db, encryptionClient, orgKeys, secretRefs, temporalEnv, telemetryLogger, auditLogger, serverURL, nil, slackClient)
 
 			assistantTokenManager := assistanttokens.New(c.String(usersessions.JWTSigningKeyFlag), db, authzEngine)
 
@@ -791,11 +864,27 @@ func newWorkerCommand() *cli.Command {
 				meterProvider,
 				db,
 				encryptionClient,
+				orgKeys,
 				guardianPolicy,
 				cache.NewRedisCacheAdapter(redisClient),
 				serverURL,
 			)
 
+			toolConstraintCelEngine, err := constraintcelenv.New()
+			if err != nil {
+				return fmt.Errorf("create tool constraint cel engine: %w", err)
+			}
+			toolConstraintEnforcer, err := toolconstraints.NewEnforcer(logger, meterProvider, toolconstraints.NewConstraintCache(logger, db, cache.NewRedisCacheAdapter(redisClient)), toolConstraintCelEngine, publishers.RiskFindings)
+			if err != nil {
+				return fmt.Errorf("create tool constraint enforcer: %w", err)
+			}
+			toolApprovalNotifier := toolapprovals.NewNotifier(emailService, encryptionClient, guardianPolicy.Client())
+			toolApprovalGate, err := toolapprovals.NewGate(logger, meterProvider, db, toolapprovals.NewPolicyCache(logger, db, cache.NewRedisCacheAdapter(redisClient)), toolConstraintCelEngine, toolApprovalNotifier, serverURL)
+			if err != nil {
+				return fmt.Errorf("create tool approval gate: %w", err)
+			}
+			shutdownFuncs = append(shutdownFuncs, toolApprovalGate.Shutdown)
+
 			mcpService := mcp.NewService(
 				logger,
 				tracerProvider,
@@ -811,6 +900,7 @@ func newWorkerCommand() *cli.Command {
 				encryptionClient,
 				cache.NewRedisCacheAdapter(redisClient),
 				guardianPolicy,
+				upstreamHealth,
 				functionsOrchestrator,
 				billingTracker,
 				billingRepo,
@@ -845,6 +935,11 @@ func newWorkerCommand() *cli.Command {
 					RequestRate:        ratelimit.Rate{Tokens: 0, Interval: 0, Burst: 0},
 					MaxRequestLifetime: 0,
 				},
+				toolratelimits.NewEnforcer(logger, meterProvider, toolratelimits.NewLimitCache(logger, db, cache.NewRedisCacheAdapter(redisClient)), ratelimit.NewRedisStore(redisClient)),
+				toolConstraintEnforcer,
+				toolApprovalGate,
+				toolcallrecordings.NewRecorder(logger, db, cache.NewRedisCacheAdapter(redisClient)),
+				mcpendpoints.NewCanaryRoutingCache(logger, db, cache.NewRedisCacheAdapter(redisClient)),
 			)
 
 			chatClient := chat.NewAgenticChatClient(
@@ -908,6 +1003,7 @@ func newWorkerCommand() *cli.Command {
 				GuardianPolicy:            guardianPolicy,
 				DB:                        db,
 				EncryptionClient:          encryptionClient,
+				OrganizationKeys:          orgKeys,
 				FeatureProvider:           featureFlags,
 				AssetStorage:              assetStorage,
 				SlackClient:               slackClient,
@@ -917,6 +1013,7 @@ func newWorkerCommand() *cli.Command {
 				OpenRouterSpend:           openRouter,
 				K8sClient:                 k8sClient,
 				ExpectedTargetCNAME:       c.String("custom-domain-cname"),
+				AuditExportStorage:        auditExportStorage,
 				GitHubEvidenceToken:       c.String("github-evidence-token"),
 				SiteURL:                   siteURL,
 				BillingTracker:            billingTracker,
@@ -1302,6 +1302,30 @@ CREATE INDEX IF NOT EXISTS http_tool_definitions_deployment_deleted_id_idx ON ht
 CREATE INDEX IF NOT EXISTS http_tool_definitions_deployment_tool_urn_idx ON http_tool_definitions (deployment_id, tool_urn) WHERE deleted IS FALSE;
 CREATE INDEX IF NOT EXISTS http_tool_definitions_project_id_deleted_idx ON http_tool_definitions (project_id, deleted);
 
+-- Candidate server URLs for the HTTP tools of an OpenAPI source, in the order
+-- they are listed in the document. Only sources that list more than one usable
+-- server have a row; the first candidate is also the tools' default_server_url.
+CREATE TABLE IF NOT EXISTS http_server_candidates (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+
+  deployment_id uuid NOT NULL,
+  project_id uuid NOT NULL,
+  openapiv3_document_id uuid NOT NULL,
+
+  server_env_var TEXT NOT NULL CHECK (server_env_var <> ''),
+  server_urls TEXT[] NOT NULL CHECK (CARDINALITY(server_urls) > 0),
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT http_server_candidates_pkey PRIMARY KEY (id),
+  CONSTRAINT http_server_candidates_deployment_id_fkey FOREIGN KEY (deployment_id) REFERENCES deployments (id) ON DELETE SET NULL,
+  CONSTRAINT http_server_candidates_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE SET NULL,
+  CONSTRAINT http_server_candidates_openapiv3_document_id_fkey FOREIGN KEY (openapiv3_document_id) REFERENCES deployments_openapiv3_assets (id) ON DELETE RESTRICT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS http_server_candidates_deployment_env_var_key
+ON http_server_candidates (deployment_id, server_env_var);
+
 CREATE TABLE IF NOT EXISTS deployments_functions (
   id uuid NOT NULL DEFAULT generate_uuidv7(),
   deployment_id uuid NOT NULL,
@@ -3018,6 +3042,16 @@ CREATE TABLE IF NOT EXISTS tool_variations (
   idempotent_hint boolean,
   open_world_hint boolean,
 
+  -- Gateway response caching for read-only or idempotent tools (NULL TTL =
+  -- not cached). Cached responses are scoped to the caller unless
+  -- cache_shared is true.
+  cache_ttl_seconds INTEGER CHECK (cache_ttl_seconds IS NULL OR (cache_ttl_seconds > 0 AND cache_ttl_seconds <= 86400)),
+  cache_shared boolean,
+
+  -- Arguments filled server-side on every call and hidden from the tool's
+  -- input schema: a JSON array of {argument, source, value} objects.
+  argument_bindings JSONB CHECK (argument_bindings IS NULL OR jsonb_typeof(argument_bindings) = 'array'),
+
   created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
   updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
   deleted_at timestamptz,
@@ -4034,6 +4068,34 @@ CREATE UNIQUE INDEX IF NOT EXISTS otel_forwarding_configs_org_project_key
   ON otel_forwarding_configs (organization_id, project_id)
   WHERE project_id IS NOT NULL AND deleted IS FALSE;
 
+-- OTEL forwarding destinations: additional per-org OTLP endpoints, each with
+-- its own wire protocol and a processing pipeline (name/attribute filters,
+-- head sampling, attribute scrubbing) applied before payloads leave Gram.
+-- filters and scrub_rules hold the JSON-encoded otelforwarding.Filters and
+-- []otelforwarding.ScrubRule.
+CREATE TABLE IF NOT EXISTS otel_forwarding_destinations (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  organization_id TEXT NOT NULL,
+  name TEXT NOT NULL CHECK (name <> '' AND CHAR_LENGTH(name) <= 100),
+  endpoint_url TEXT NOT NULL,
+  protocol TEXT NOT NULL DEFAULT 'http/protobuf' CHECK (protocol IN ('http/protobuf', 'http/json', 'grpc')),
+  headers_encrypted TEXT,
+  enabled boolean NOT NULL DEFAULT true,
+  sampling_ratio double precision NOT NULL DEFAULT 1 CHECK (sampling_ratio >= 0 AND sampling_ratio <= 1),
+  filters jsonb NOT NULL DEFAULT '{}'::jsonb,
+  scrub_rules jsonb NOT NULL DEFAULT '[]'::jsonb,
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  deleted_at timestamptz,
+  deleted boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) stored,
+
+  CONSTRAINT otel_forwarding_destinations_pkey PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS otel_forwarding_destinations_org_name_key
+  ON otel_forwarding_destinations (organization_id, name)
+  WHERE deleted IS FALSE;
+
 -- AI integration configs: encrypted provider credentials and activation
 -- metadata. Provider-specific sync state lives in ai_integration_syncs.
 CREATE TABLE IF NOT EXISTS ai_integration_configs (
@@ -4213,6 +4275,13 @@ CREATE TABLE IF NOT EXISTS audit_logs (
   -- which is not the same as an unrecognized one.
   acting_client_id TEXT,
 
+  -- The transaction that wrote the row. seq is drawn at insert, not commit, so
+  -- a long transaction can commit a lower seq after higher ones are visible.
+  -- The audit log export walks rows in (tx_id, seq) order and only reads rows
+  -- whose transaction is older than every transaction still running, which
+  -- no later commit can precede.
+  tx_id BIGINT DEFAULT (pg_current_xact_id()::text::bigint),
+
   created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
 
   CONSTRAINT audit_logs_pkey PRIMARY KEY (id)
@@ -4227,6 +4296,9 @@ ON audit_logs (organization_id, seq DESC);
 CREATE INDEX IF NOT EXISTS audit_logs_organization_id_project_id_seq_idx
 ON audit_logs (organization_id, project_id, seq DESC);
 
+CREATE INDEX IF NOT EXISTS audit_logs_organization_id_tx_id_seq_idx
+ON audit_logs (organization_id, tx_id, seq);
+
 -- Remote MCP servers are upstream MCP endpoints that Gram proxies requests to.
 -- See https://modelcontextprotocol.io/registry/remote-servers
 CREATE TABLE IF NOT EXISTS remote_mcp_servers (
@@ -4435,6 +4507,9 @@ CREATE TABLE IF NOT EXISTS mcp_servers (
   -- modifications. Otherwise defaults to project global (source-level)
   -- variations for runtime modifications.
   tool_variations_group_id uuid,
+  -- Optionally pins a toolset-backed server to one of its toolset's
+  -- immutable toolset_versions. Otherwise the server serves the latest.
+  toolset_version BIGINT CHECK (toolset_version IS NULL OR toolset_version > 0),
   visibility TEXT NOT NULL CHECK (visibility <> ''),
 
   created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
@@ -4453,6 +4528,7 @@ CREATE TABLE IF NOT EXISTS mcp_servers (
   CONSTRAINT mcp_servers_tool_variations_group_id_fkey FOREIGN KEY (tool_variations_group_id) REFERENCES tool_variations_groups (id) ON DELETE SET NULL,
   -- Exactly one backend must be set.
   CONSTRAINT mcp_servers_backend_exclusivity_check CHECK (num_nonnulls(remote_mcp_server_id, tunneled_mcp_server_id, toolset_id, unproxied_mcp_server_id) = 1),
+  CONSTRAINT mcp_servers_toolset_version_requires_toolset_check CHECK (toolset_version IS NULL OR toolset_id IS NOT NULL),
   -- Remote and tunneled servers carry a Gram-as-AS issuer attached at create
   -- time for the server's lifetime, regardless of visibility. Toolset- and
   -- unproxied-backed servers are exempt (unproxied servers are never
@@ -4641,6 +4717,8 @@ CREATE TABLE IF NOT EXISTS mcp_endpoints (
   custom_domain_id uuid,
   mcp_server_id uuid,
   meta_mcp_server_id uuid,
+  -- Overrides the toolset version pinned on the endpoint's mcp_server, if any.
+  toolset_version BIGINT CHECK (toolset_version IS NULL OR toolset_version > 0),
   slug TEXT NOT NULL CHECK (slug <> '' AND CHAR_LENGTH(slug) <= 128),
   is_domain_root BOOLEAN,
 
@@ -4655,7 +4733,8 @@ CREATE TABLE IF NOT EXISTS mcp_endpoints (
   CONSTRAINT mcp_endpoints_project_id_meta_mcp_server_id_fkey FOREIGN KEY (project_id, meta_mcp_server_id) REFERENCES meta_mcp_servers (project_id, id) ON DELETE CASCADE,
   CONSTRAINT mcp_endpoints_custom_domain_id_fkey FOREIGN KEY (custom_domain_id) REFERENCES custom_domains (id) ON DELETE SET NULL,
   CONSTRAINT mcp_endpoints_backend_exclusivity_check CHECK (num_nonnulls(mcp_server_id, meta_mcp_server_id) = 1),
-  CONSTRAINT mcp_endpoints_domain_root_requires_custom_domain_check CHECK (is_domain_root IS NOT TRUE OR custom_domain_id IS NOT NULL)
+  CONSTRAINT mcp_endpoints_domain_root_requires_custom_domain_check CHECK (is_domain_root IS NOT TRUE OR custom_domain_id IS NOT NULL),
+  CONSTRAINT mcp_endpoints_toolset_version_requires_mcp_server_check CHECK (toolset_version IS NULL OR mcp_server_id IS NOT NULL)
 );
 
 CREATE INDEX IF NOT EXISTS mcp_endpoints_project_id_idx
@@ -4690,6 +4769,43 @@ CREATE UNIQUE INDEX IF NOT EXISTS mcp_endpoints_custom_domain_id_root_key
 ON mcp_endpoints (custom_domain_id)
 WHERE is_domain_root IS TRUE AND deleted IS FALSE;
 
+-- A canary splits an endpoint's tool calls between a baseline deployment and
+-- a newer candidate deployment. A NULL candidate follows the project's active
+-- deployment, so a canary can be armed before the deployment it guards is
+-- pushed. Rows are kept after promotion or abort so per-arm stats stay
+-- reviewable, and an aborted canary keeps the endpoint on its baseline until
+-- a deployment newer than its candidate completes.
+CREATE TABLE IF NOT EXISTS mcp_endpoint_canaries (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  project_id uuid NOT NULL,
+  mcp_endpoint_id uuid NOT NULL,
+
+  baseline_deployment_id uuid NOT NULL,
+  candidate_deployment_id uuid,
+  percentage INTEGER NOT NULL CHECK (percentage >= 0 AND percentage <= 100),
+  sticky_by TEXT NOT NULL DEFAULT 'session' CHECK (sticky_by IN ('session', 'user')),
+  status TEXT NOT NULL DEFAULT 'running' CHECK (status IN ('running', 'promoted', 'aborted')),
+
+  started_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  ended_at timestamptz,
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT mcp_endpoint_canaries_pkey PRIMARY KEY (id),
+  CONSTRAINT mcp_endpoint_canaries_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+  CONSTRAINT mcp_endpoint_canaries_mcp_endpoint_id_fkey FOREIGN KEY (mcp_endpoint_id) REFERENCES mcp_endpoints (id) ON DELETE CASCADE,
+  CONSTRAINT mcp_endpoint_canaries_baseline_deployment_id_fkey FOREIGN KEY (baseline_deployment_id) REFERENCES deployments (id) ON DELETE CASCADE,
+  CONSTRAINT mcp_endpoint_canaries_candidate_deployment_id_fkey FOREIGN KEY (candidate_deployment_id) REFERENCES deployments (id) ON DELETE CASCADE,
+  CONSTRAINT mcp_endpoint_canaries_distinct_deployments_check CHECK (baseline_deployment_id <> candidate_deployment_id)
+);
+
+CREATE INDEX IF NOT EXISTS mcp_endpoint_canaries_mcp_endpoint_id_idx
+ON mcp_endpoint_canaries (mcp_endpoint_id, created_at DESC);
+
+CREATE UNIQUE INDEX IF NOT EXISTS mcp_endpoint_canaries_running_key
+ON mcp_endpoint_canaries (mcp_endpoint_id)
+WHERE status = 'running';
+
 -- MCP servers attached directly to an assistant. The legacy toolset
 -- attachment path lives in assistant_toolsets; this table covers
 -- mcp_servers-modelled backends (remote, tunnelled) that have no toolsets
@@ -6274,6 +6390,11 @@ CREATE TABLE IF NOT EXISTS spend_rules (
   -- internal/spendrules/celenv). A rule applies to an actor when this
   -- evaluates true.
   target_expr TEXT NOT NULL,
+  -- CEL boolean expression over usage dimensions (model, model_family,
+  -- assistant_id, project_id, toolset_slug; see internal/spendrules/celenv)
+  -- selecting which of an actor's spend counts toward the limit. Empty means
+  -- all spend counts.
+  scope_expr TEXT NOT NULL DEFAULT '',
   -- Per-person budget in cents for one window. Must be positive; validated in
   -- application code. External APIs and CEL still expose USD values.
   limit_usd_cents BIGINT NOT NULL,
@@ -7498,3 +7619,481 @@ CREATE INDEX IF NOT EXISTS platform_mcp_feedback_organization_subject_created_at
 CREATE INDEX IF NOT EXISTS platform_mcp_feedback_organization_connection_created_at_idx ON platform_mcp_feedback (organization_id, connection_id, created_at DESC) WHERE connection_id IS NOT NULL;
 CREATE INDEX IF NOT EXISTS platform_mcp_feedback_organization_workflow_created_at_idx ON platform_mcp_feedback (organization_id, workflow_id, created_at DESC) WHERE workflow_id IS NOT NULL;
 CREATE INDEX IF NOT EXISTS platform_mcp_feedback_expires_at_idx ON platform_mcp_feedback (expires_at);
+
+CREATE TABLE IF NOT EXISTS tool_call_rate_limits (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  project_id uuid NOT NULL,
+
+  -- The limit attaches to one target: a toolset (enforced on the
+  -- hosted /mcp tools/call path) or an MCP server (enforced on both the
+  -- hosted path and the remote MCP proxy).
+  toolset_id uuid,
+  mcp_server_id uuid,
+
+  -- Who shares a token bucket: 'end_user' buckets per authenticated user,
+  -- 'api_key' per Gram API key, 'all' pools every caller of the target.
+  subject TEXT NOT NULL CHECK (subject IN ('end_user', 'api_key', 'all')),
+  -- Restricts the limit to a single tool. NULL applies it to every tool on
+  -- the target, sharing one bucket across them.
+  tool_name TEXT CHECK (tool_name IS NULL OR (tool_name <> '' AND CHAR_LENGTH(tool_name) <= 128)),
+  -- Token bucket: requests refill smoothly over interval_seconds and the
+  -- bucket holds at most burst tokens.
+  requests INTEGER NOT NULL CHECK (requests > 0),
+  interval_seconds INTEGER NOT NULL CHECK (interval_seconds > 0 AND interval_seconds <= 86400),
+  burst INTEGER NOT NULL CHECK (burst > 0),
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  deleted_at timestamptz,
+  deleted boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+
+  CONSTRAINT tool_call_rate_limits_pkey PRIMARY KEY (id),
+  CONSTRAINT tool_call_rate_limits_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE SET NULL,
+  CONSTRAINT tool_call_rate_limits_toolset_id_fkey FOREIGN KEY (toolset_id) REFERENCES toolsets (id) ON DELETE SET NULL,
+  CONSTRAINT tool_call_rate_limits_mcp_server_id_fkey FOREIGN KEY (mcp_server_id) REFERENCES mcp_servers (id) ON DELETE SET NULL,
+  -- At most one target is set. Limits are created with exactly one; a limit
+  -- whose target is hard-deleted is left with none and never matches a call.
+  CONSTRAINT tool_call_rate_limits_target_exclusivity_check CHECK (num_nonnulls(toolset_id, mcp_server_id) <= 1)
+);
+CREATE INDEX IF NOT EXISTS tool_call_rate_limits_project_id_idx ON tool_call_rate_limits (project_id) WHERE deleted IS FALSE;
+CREATE INDEX IF NOT EXISTS tool_call_rate_limits_toolset_id_idx ON tool_call_rate_limits (toolset_id) WHERE deleted IS FALSE AND toolset_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS tool_call_rate_limits_mcp_server_id_idx ON tool_call_rate_limits (mcp_server_id) WHERE deleted IS FALSE AND mcp_server_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS tool_call_constraints (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  project_id uuid NOT NULL,
+
+  -- The constraint attaches to exactly one target: a toolset (enforced on the
+  -- hosted /mcp tools/call path) or an MCP server (enforced on both the
+  -- hosted path and the remote MCP proxy).
+  toolset_id uuid,
+  mcp_server_id uuid,
+
+  -- The tool whose arguments the expression is evaluated against.
+  tool_name TEXT NOT NULL CHECK (tool_name <> '' AND CHAR_LENGTH(tool_name) <= 128),
+  -- 'allow' admits a call only when the expression is true; 'deny' rejects a
+  -- call when it is true.
+  effect TEXT NOT NULL CHECK (effect IN ('allow', 'deny')),
+  -- CEL boolean expression over the call's arguments.
+  expression TEXT NOT NULL CHECK (expression <> '' AND CHAR_LENGTH(expression) <= 4096),
+  -- Explanation returned to the model when the constraint rejects a call.
+  message TEXT CHECK (message IS NULL OR CHAR_LENGTH(message) <= 1000),
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  deleted_at timestamptz,
+  deleted boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+
+  CONSTRAINT tool_call_constraints_pkey PRIMARY KEY (id),
+  CONSTRAINT tool_call_constraints_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+  CONSTRAINT tool_call_constraints_toolset_id_fkey FOREIGN KEY (toolset_id) REFERENCES toolsets (id) ON DELETE CASCADE,
+  CONSTRAINT tool_call_constraints_mcp_server_id_fkey FOREIGN KEY (mcp_server_id) REFERENCES mcp_servers (id) ON DELETE CASCADE,
+  -- Exactly one target must be set.
+  CONSTRAINT tool_call_constraints_target_exclusivity_check CHECK (num_nonnulls(toolset_id, mcp_server_id) = 1)
+);
+CREATE INDEX IF NOT EXISTS tool_call_constraints_project_id_idx ON tool_call_constraints (project_id) WHERE deleted IS FALSE;
+CREATE INDEX IF NOT EXISTS tool_call_constraints_toolset_id_idx ON tool_call_constraints (toolset_id, tool_name) WHERE deleted IS FALSE AND toolset_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS tool_call_constraints_mcp_server_id_idx ON tool_call_constraints (mcp_server_id, tool_name) WHERE deleted IS FALSE AND mcp_server_id IS NOT NULL;
+
+-- Human-in-the-loop approval policies. A tools/call matching a policy is held
+-- until an end user confirms it, through MCP elicitation when the client
+-- supports it and otherwise through an approval link sent to notify_emails and
+-- the Slack incoming webhook.
+CREATE TABLE IF NOT EXISTS tool_approval_policies (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  project_id uuid NOT NULL,
+
+  -- The policy attaches to exactly one target, like tool_call_constraints.
+  toolset_id uuid,
+  mcp_server_id uuid,
+
+  -- The tool the policy governs. NULL governs every tool on the target.
+  tool_name TEXT CHECK (tool_name IS NULL OR (tool_name <> '' AND CHAR_LENGTH(tool_name) <= 128)),
+  -- Match calls to tools annotated destructive.
+  match_destructive BOOLEAN NOT NULL DEFAULT FALSE,
+  -- CEL boolean expression over the call's arguments; a call matches when it
+  -- is true.
+  expression TEXT CHECK (expression IS NULL OR (expression <> '' AND CHAR_LENGTH(expression) <= 4096)),
+  -- Shown to the approver alongside the call.
+  message TEXT CHECK (message IS NULL OR CHAR_LENGTH(message) <= 1000),
+
+  -- Out-of-band approvers, used when the MCP client cannot elicit.
+  notify_emails TEXT[] NOT NULL DEFAULT '{}' CHECK (cardinality(notify_emails) <= 20),
+  slack_webhook_url_encrypted TEXT,
+
+  -- How long a matching call is held waiting for a decision.
+  timeout_seconds INTEGER NOT NULL DEFAULT 300 CHECK (timeout_seconds >= 10 AND timeout_seconds <= 900),
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  deleted_at timestamptz,
+  deleted boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+
+  CONSTRAINT tool_approval_policies_pkey PRIMARY KEY (id),
+  CONSTRAINT tool_approval_policies_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+  CONSTRAINT tool_approval_policies_toolset_id_fkey FOREIGN KEY (toolset_id) REFERENCES toolsets (id) ON DELETE CASCADE,
+  CONSTRAINT tool_approval_policies_mcp_server_id_fkey FOREIGN KEY (mcp_server_id) REFERENCES mcp_servers (id) ON DELETE CASCADE,
+  -- Exactly one target must be set.
+  CONSTRAINT tool_approval_policies_target_exclusivity_check CHECK (num_nonnulls(toolset_id, mcp_server_id) = 1),
+  -- A policy must match on something.
+  CONSTRAINT tool_approval_policies_matcher_check CHECK (match_destructive OR expression IS NOT NULL)
+);
+CREATE INDEX IF NOT EXISTS tool_approval_policies_project_id_idx ON tool_approval_policies (project_id) WHERE deleted IS FALSE;
+CREATE INDEX IF NOT EXISTS tool_approval_policies_toolset_id_idx ON tool_approval_policies (toolset_id) WHERE deleted IS FALSE AND toolset_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS tool_approval_policies_mcp_server_id_idx ON tool_approval_policies (mcp_server_id) WHERE deleted IS FALSE AND mcp_server_id IS NOT NULL;
+
+-- One held tools/call awaiting, or past, a human decision. The row is the
+-- rendezvous between the server instance holding the call and whichever
+-- instance receives the decision.
+CREATE TABLE IF NOT EXISTS tool_call_approvals (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  project_id uuid NOT NULL,
+  policy_id uuid NOT NULL,
+  toolset_id uuid,
+  mcp_server_id uuid,
+  tool_name TEXT NOT NULL,
+  arguments JSONB NOT NULL DEFAULT '{}',
+
+  -- 'elicitation' asks the calling MCP client; 'link' asks out of band.
+  channel TEXT NOT NULL CHECK (channel IN ('elicitation', 'link')),
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'denied', 'expired')),
+
+  -- SHA-256 of the MCP session that must answer the elicitation.
+  session_id_hash TEXT,
+
+  requested_by TEXT,
+  decided_by TEXT,
+  decided_at timestamptz,
+  expires_at timestamptz NOT NULL,
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT tool_call_approvals_pkey PRIMARY KEY (id),
+  CONSTRAINT tool_call_approvals_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+  CONSTRAINT tool_call_approvals_policy_id_fkey FOREIGN KEY (policy_id) REFERENCES tool_approval_policies (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS tool_call_approvals_project_id_created_at_idx ON tool_call_approvals (project_id, created_at DESC);
+
+-- One approval link per approver of a held call (link channel). Each approver
+-- gets their own token, so a decision records who made it.
+CREATE TABLE IF NOT EXISTS tool_call_approval_links (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  approval_id uuid NOT NULL,
+  -- The approver the link was sent to: an email address, or 'slack' for the
+  -- policy's Slack channel.
+  approver TEXT NOT NULL CHECK (approver <> ''),
+  -- SHA-256 of the link token.
+  token_hash TEXT NOT NULL,
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT tool_call_approval_links_pkey PRIMARY KEY (id),
+  CONSTRAINT tool_call_approval_links_approval_id_fkey FOREIGN KEY (approval_id) REFERENCES tool_call_approvals (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS tool_call_approval_links_approval_id_token_hash_key ON tool_call_approval_links (approval_id, token_hash);
+
+-- Customer webhook endpoints for the self-hosted delivery backend, used in
+-- place of Svix when the server runs with --webhook-delivery-backend
+-- self-hosted.
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  organization_id TEXT NOT NULL,
+
+  url TEXT NOT NULL CHECK (url <> '' AND CHAR_LENGTH(url) <= 2048),
+  description TEXT CHECK (description IS NULL OR CHAR_LENGTH(description) <= 500),
+  -- The Standard Webhooks signing secret ("whsec_..."), encrypted at rest.
+  secret_encrypted TEXT NOT NULL,
+  -- Event types the endpoint subscribes to. Empty subscribes to every type.
+  event_types TEXT[] NOT NULL DEFAULT '{}'::TEXT[],
+  disabled_at timestamptz,
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  deleted_at timestamptz,
+  deleted boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+
+  CONSTRAINT webhook_endpoints_pkey PRIMARY KEY (id),
+  CONSTRAINT webhook_endpoints_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organization_metadata (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS webhook_endpoints_organization_id_idx ON webhook_endpoints (organization_id) WHERE deleted IS FALSE;
+
+-- One row per (endpoint, event): the unit that is retried and, once the
+-- attempt budget is spent, dead-lettered.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  organization_id TEXT NOT NULL,
+  endpoint_id uuid NOT NULL,
+
+  -- The outbox event id, sent as the webhook-id header. Stable across
+  -- retries so receivers can deduplicate.
+  event_id TEXT NOT NULL,
+  event_type TEXT NOT NULL,
+  -- The exact request body that is signed and sent on every attempt.
+  payload BYTEA NOT NULL,
+
+  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'dead_lettered')),
+  attempts INTEGER NOT NULL DEFAULT 0,
+  -- When a pending delivery is next due. Claiming a delivery pushes this
+  -- forward by a lease so a crashed dispatcher's work is picked up again.
+  next_attempt_at timestamptz,
+  last_attempt_at timestamptz,
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT webhook_deliveries_pkey PRIMARY KEY (id),
+  CONSTRAINT webhook_deliveries_endpoint_id_fkey FOREIGN KEY (endpoint_id) REFERENCES webhook_endpoints (id) ON DELETE CASCADE,
+  CONSTRAINT webhook_deliveries_endpoint_id_event_id_key UNIQUE (endpoint_id, event_id)
+);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_endpoint_id_idx ON webhook_deliveries (endpoint_id, id DESC);
+
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  delivery_id uuid NOT NULL,
+  attempt INTEGER NOT NULL,
+  -- NULL when no HTTP response was received (timeout, DNS, refused).
+  response_status INTEGER,
+  error TEXT,
+  duration_ms INTEGER NOT NULL,
+  attempted_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT webhook_delivery_attempts_pkey PRIMARY KEY (id),
+  CONSTRAINT webhook_delivery_attempts_delivery_id_fkey FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS webhook_delivery_attempts_delivery_id_idx ON webhook_delivery_attempts (delivery_id, attempt);
+
+-- One row per project and tool that has ever spiked, holding the last time a
+-- spike was announced so repeat detections inside the cooldown stay quiet.
+CREATE TABLE IF NOT EXISTS tool_error_rate_spikes (
+  project_id uuid NOT NULL,
+  tool_urn TEXT NOT NULL,
+  last_detected_at timestamptz NOT NULL,
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT tool_error_rate_spikes_pkey PRIMARY KEY (project_id, tool_urn),
+  CONSTRAINT tool_error_rate_spikes_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+-- Subscriptions opened on the Postgres message transport, used when Gram runs
+-- without Pub/Sub. A publish fans out one transport_deliveries row per
+-- subscription registered on its topic at the time. Declared subscriptions are
+-- registered at boot, before any receiver opens.
+CREATE TABLE IF NOT EXISTS transport_subscriptions (
+  topic TEXT NOT NULL,
+  subscription TEXT NOT NULL,
+  retention INTERVAL, -- NULL keeps unacked deliveries for 7 days
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT transport_subscriptions_pkey PRIMARY KEY (topic, subscription)
+);
+
+CREATE TABLE IF NOT EXISTS transport_deliveries (
+  id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY (CACHE 32),
+  message_id uuid NOT NULL,
+  topic TEXT NOT NULL,
+  subscription TEXT NOT NULL,
+
+  data BYTEA NOT NULL,
+  attributes JSONB NOT NULL DEFAULT '{}'::jsonb,
+
+  attempts INT NOT NULL DEFAULT 0,
+  visible_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  lease_token uuid,
+  expires_at timestamptz, -- NULL never expires
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT transport_deliveries_pkey PRIMARY KEY (id)
+) WITH (
+  fillfactor = 80,
+  autovacuum_vacuum_scale_factor = 0.05,
+  autovacuum_analyze_scale_factor = 0.05,
+  autovacuum_vacuum_insert_scale_factor = 0.05
+);
+
+COMMENT ON COLUMN transport_deliveries.visible_at IS 'When the delivery may next be claimed: pushed forward by the claim lease and by nack back-off.';
+
+CREATE INDEX IF NOT EXISTS transport_deliveries_subscription_id_idx
+ON transport_deliveries (topic, subscription, id);
+
+CREATE INDEX IF NOT EXISTS transport_deliveries_expires_at_idx
+ON transport_deliveries (expires_at)
+WHERE expires_at IS NOT NULL;
+
+-- Per-organization continuous export of audit_logs to the deployment's audit
+-- export bucket. The exporter walks audit_logs in seq order from cursor_seq and
+-- writes one object per hour partition, advancing the cursor only after the
+-- object is stored, so a failed run resumes where the last good one stopped.
+CREATE TABLE IF NOT EXISTS audit_log_exports (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  organization_id TEXT NOT NULL,
+
+  enabled boolean NOT NULL DEFAULT true,
+  -- Object encoding: 'ndjson' and 'parquet' carry Gram's native audit record,
+  -- 'ocsf' carries OCSF API Activity events as NDJSON and 'cef' carries one
+  -- ArcSight CEF line per event.
+  format TEXT NOT NULL CHECK (format IN ('ndjson', 'parquet', 'ocsf', 'cef')),
+  -- Key prefix objects are written under, inside the deployment's bucket.
+  prefix TEXT NOT NULL DEFAULT '' CHECK (CHAR_LENGTH(prefix) <= 256),
+
+  -- The last audit_logs row exported for the organization, as its (tx_id,
+  -- seq). cursor_tx_id is NULL until a run resolves it from cursor_seq, which
+  -- happens after the cursor is set through the API.
+  cursor_seq BIGINT NOT NULL DEFAULT 0,
+  cursor_tx_id BIGINT,
+  last_exported_at timestamptz,
+  last_object_key TEXT,
+  -- The error from the most recent failed run, cleared on the next success.
+  last_error TEXT,
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  deleted_at timestamptz,
+  deleted boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+
+  CONSTRAINT audit_log_exports_pkey PRIMARY KEY (id),
+  CONSTRAINT audit_log_exports_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organization_metadata (id) ON DELETE SET NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS audit_log_exports_organization_id_key ON audit_log_exports (organization_id) WHERE deleted IS FALSE;
+
+-- Alert rules over a project's tool call telemetry. A scheduled sweep
+-- evaluates each enabled rule against ClickHouse and moves it between 'ok'
+-- and 'firing', announcing each transition once through the outbox and email.
+CREATE TABLE IF NOT EXISTS telemetry_alert_rules (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  project_id uuid NOT NULL,
+  name TEXT NOT NULL CHECK (name <> '' AND CHAR_LENGTH(name) <= 100),
+
+  -- Narrows the rule to the calls of one toolset, one tool, or both. NULL
+  -- in both covers every tool call in the project.
+  toolset_id uuid,
+  tool_urn TEXT CHECK (tool_urn IS NULL OR (tool_urn <> '' AND CHAR_LENGTH(tool_urn) <= 500)),
+
+  -- 'error_rate' compares the fraction of failed calls against threshold;
+  -- the latency metrics compare that percentile of call duration, in
+  -- milliseconds.
+  metric TEXT NOT NULL CHECK (metric IN ('error_rate', 'latency_p50', 'latency_p90', 'latency_p95', 'latency_p99')),
+  threshold DOUBLE PRECISION NOT NULL CHECK (threshold > 0),
+  window_seconds INTEGER NOT NULL CHECK (window_seconds >= 60 AND window_seconds <= 86400),
+  -- A window with fewer calls than this never breaches, so a handful of
+  -- calls on a quiet tool cannot fire the rule.
+  min_calls INTEGER NOT NULL DEFAULT 10 CHECK (min_calls > 0),
+  email_recipients TEXT[] NOT NULL DEFAULT ARRAY[]::TEXT[] CHECK (array_length(email_recipients, 1) <= 20),
+  enabled boolean NOT NULL DEFAULT true,
+
+  state TEXT NOT NULL DEFAULT 'ok' CHECK (state IN ('ok', 'firing')),
+  -- Identifies the current or most recent firing episode; its firing and
+  -- resolved notifications share it.
+  incident_id uuid,
+  state_changed_at timestamptz,
+  last_value DOUBLE PRECISION,
+  last_evaluated_at timestamptz,
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  deleted_at timestamptz,
+  deleted boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+
+  CONSTRAINT telemetry_alert_rules_pkey PRIMARY KEY (id),
+  CONSTRAINT telemetry_alert_rules_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+  CONSTRAINT telemetry_alert_rules_toolset_id_fkey FOREIGN KEY (toolset_id) REFERENCES toolsets (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS telemetry_alert_rules_project_id_idx ON telemetry_alert_rules (project_id) WHERE deleted IS FALSE;
+
+-- A recording session captures the real tool calls made through a toolset so
+-- they can be replayed against later deployments. It records until it is
+-- stopped, it expires or max_calls calls have been recorded.
+CREATE TABLE IF NOT EXISTS tool_call_recording_sessions (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  project_id uuid NOT NULL,
+  toolset_id uuid NOT NULL,
+
+  max_calls INTEGER NOT NULL CHECK (max_calls > 0 AND max_calls <= 1000),
+  recorded_calls INTEGER NOT NULL DEFAULT 0 CHECK (recorded_calls >= 0),
+  expires_at timestamptz NOT NULL,
+  stopped_at timestamptz,
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT tool_call_recording_sessions_pkey PRIMARY KEY (id),
+  CONSTRAINT tool_call_recording_sessions_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE,
+  CONSTRAINT tool_call_recording_sessions_toolset_id_fkey FOREIGN KEY (toolset_id) REFERENCES toolsets (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS tool_call_recording_sessions_project_id_idx ON tool_call_recording_sessions (project_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS tool_call_recording_sessions_active_idx ON tool_call_recording_sessions (toolset_id) WHERE stopped_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS tool_call_recordings (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  session_id uuid NOT NULL,
+  project_id uuid NOT NULL,
+  tool_urn TEXT NOT NULL,
+  tool_name TEXT NOT NULL,
+  -- A gateway.ToolCallRecording with its secrets already masked.
+  recording jsonb NOT NULL,
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT tool_call_recordings_pkey PRIMARY KEY (id),
+  CONSTRAINT tool_call_recordings_session_id_fkey FOREIGN KEY (session_id) REFERENCES tool_call_recording_sessions (id) ON DELETE CASCADE,
+  CONSTRAINT tool_call_recordings_project_id_fkey FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS tool_call_recordings_session_id_idx ON tool_call_recordings (session_id, id);
+
+-- Per-organization data encryption keys for customer-managed encryption. The
+-- key is stored only wrapped by the customer's own GCP KMS key, so the
+-- customer can make every value sealed under it unreadable by revoking that
+-- key. Shredding erases the wrapped key on Gram's side to the same effect.
+CREATE TABLE IF NOT EXISTS organization_data_keys (
+  id uuid NOT NULL DEFAULT generate_uuidv7(),
+  organization_id TEXT NOT NULL,
+
+  -- The customer's ENCRYPT_DECRYPT key, named at the cryptoKeys level.
+  kms_key_name TEXT NOT NULL CHECK (kms_key_name <> '' AND CHAR_LENGTH(kms_key_name) <= 512),
+  -- The organization's GCP IAM credential Gram reaches the key through. NULL
+  -- once the credential is deleted, which leaves the key unreadable just like
+  -- a soft-deleted credential does.
+  external_credential_id uuid,
+  -- The data key wrapped by kms_key_name. NULL once shredded.
+  wrapped_key BYTEA,
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  shredded_at timestamptz,
+
+  CONSTRAINT organization_data_keys_pkey PRIMARY KEY (id),
+  CONSTRAINT organization_data_keys_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organization_metadata (id) ON DELETE CASCADE,
+  CONSTRAINT organization_data_keys_external_credential_id_fkey FOREIGN KEY (external_credential_id) REFERENCES external_credentials (id) ON DELETE SET NULL,
+  -- Key material is present exactly until the key is shredded.
+  CONSTRAINT organization_data_keys_shredded_check CHECK ((shredded_at IS NULL) = (wrapped_key IS NOT NULL))
+);
+-- At most one live data key per organization.
+CREATE UNIQUE INDEX IF NOT EXISTS organization_data_keys_organization_id_key ON organization_data_keys (organization_id) WHERE shredded_at IS NULL;
+
+-- Every key the application keyring (--encryption-key and
+-- --encryption-previous-keys) has held. A key may only leave the keyring once
+-- a rotation sweep has re-encrypted every stored ciphertext under it and any
+-- ciphertext handed out to clients under it has expired; processes refuse to
+-- start otherwise. The legacy unnamed key has the empty ID.
+CREATE TABLE IF NOT EXISTS encryption_keys (
+  id TEXT NOT NULL,
+
+  -- Last time a process started with this key as its primary key, i.e. the
+  -- latest point from which new ciphertexts may have been written under it.
+  -- NULL for a key only ever seen as a previous key.
+  last_primary_at timestamptz,
+  -- When a rotation sweep that started after last_primary_at finished with
+  -- every ciphertext under this key re-encrypted. Reset whenever the key
+  -- becomes primary again.
+  reencrypted_at timestamptz,
+
+  created_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+  updated_at timestamptz NOT NULL DEFAULT clock_timestamp(),
+
+  CONSTRAINT encryption_keys_pkey PRIMARY KEY (id)
+);
@@ -0,0 +1,141 @@
+package auditlogexports
+
+import (
+	. "goa.design/goa/v3/dsl"
+
+	"github.com/speakeasy-api/gram/server/design/security"
+	"github.com/speakeasy-api/gram/server/design/shared"
+)
+
+// AuditLogExportFormatEnum applies the allowed-values constraint to a format
+// attribute. ndjson and parquet carry Gram's native audit record, ocsf carries
+// OCSF API Activity events as NDJSON and cef carries one CEF line per event.
+func AuditLogExportFormatEnum() {
+	Enum("ndjson", "parquet", "ocsf", "cef")
+}
+
+var Config = Type("AuditLogExportConfig", func() {
+	Description("Per-organization config for continuously exporting audit logs to the deployment's audit export bucket. When no config is set, id/created_at/updated_at are omitted and enabled defaults to false.")
+
+	Attribute("id", String, "Config ID. Omitted when no config is set for the organization.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("organization_id", String, "Organization the config belongs to.")
+	Attribute("enabled", Boolean, "Whether the export is currently active.")
+	Attribute("format", String, "How events are encoded into exported objects.", func() {
+		AuditLogExportFormatEnum()
+	})
+	Attribute("prefix", String, "Key prefix objects are written under inside the bucket.")
+	Attribute("cursor_seq", Int64, "Sequence number of the last audit event exported. The next run resumes after it.")
+	Attribute("last_exported_at", String, "When an object was last written. Omitted before the first export.", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("last_object_key", String, "Key of the most recently written object.")
+	Attribute("last_error", String, "Error from the most recent failed run. Cleared by the next successful export.")
+	Attribute("created_at", String, "When the config was created. Omitted when no config is set.", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, "When the config was last changed. Omitted when no config is set.", func() {
+		Format(FormatDateTime)
+	})
+
+	Required("organization_id", "enabled", "format", "prefix", "cursor_seq")
+})
+
+var _ = Service("auditLogExports", func() {
+	Description("Manage per-organization streaming export of audit logs to object storage.")
+
+	shared.DeclareErrorResponses()
+
+	Method("getConfig", func() {
+		Description("Get the organization's audit log export config. Returns an empty config (enabled=false) when none is set.")
+
+		Security(security.ByKey, func() {
+			Scope("consumer")
+		})
+		Security(security.Session)
+
+		Payload(func() {
+			security.ByKeyPayload()
+			security.SessionPayload()
+		})
+
+		Result(Config)
+
+		HTTP(func() {
+			GET("/rpc/auditLogExports.getConfig")
+			security.ByKeyHeader()
+			security.SessionHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "getAuditLogExportConfig")
+		Meta("openapi:extension:x-speakeasy-name-override", "getConfig")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "AuditLogExportConfig"}`)
+	})
+
+	Method("upsertConfig", func() {
+		Description("Create or update the organization's audit log export config. A new export starts after the latest audit event unless resume_after_seq is given.")
+
+		Security(security.ByKey, func() {
+			Scope("producer")
+		})
+		Security(security.Session)
+
+		Payload(func() {
+			security.ByKeyPayload()
+			security.SessionPayload()
+			Attribute("enabled", Boolean, "Whether the export should be active.")
+			Attribute("format", String, "How events are encoded into exported objects.", func() {
+				AuditLogExportFormatEnum()
+			})
+			Attribute("prefix", String, "Key prefix to write objects under. Slash-separated segments of letters, digits, '.', '_', '=' and '-'.", func() {
+				MaxLength(256)
+			})
+			Attribute("resume_after_seq", Int64, "Move the export cursor: the next run exports events with a greater sequence number. Use 0 to export the full history. Omit to keep the current cursor.", func() {
+				Minimum(0)
+			})
+			Required("enabled", "format")
+		})
+
+		Result(Config)
+
+		HTTP(func() {
+			POST("/rpc/auditLogExports.upsertConfig")
+			security.ByKeyHeader()
+			security.SessionHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "upsertAuditLogExportConfig")
+		Meta("openapi:extension:x-speakeasy-name-override", "upsertConfig")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "UpsertAuditLogExportConfig"}`)
+	})
+
+	Method("deleteConfig", func() {
+		Description("Delete the organization's audit log export config. Objects already exported are left in place.")
+
+		Security(security.ByKey, func() {
+			Scope("producer")
+		})
+		Security(security.Session)
+
+		Payload(func() {
+			security.ByKeyPayload()
+			security.SessionPayload()
+		})
+
+		Result(Empty)
+
+		HTTP(func() {
+			POST("/rpc/auditLogExports.deleteConfig")
+			security.ByKeyHeader()
+			security.SessionHeader()
+			Response(StatusNoContent)
+		})
+
+		Meta("openapi:operationId", "deleteAuditLogExportConfig")
+		Meta("openapi:extension:x-speakeasy-name-override", "deleteConfig")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "DeleteAuditLogExportConfig"}`)
+	})
+})
@@ -220,6 +220,33 @@ var _ = Service("deployments", func() {
 		Meta("openapi:extension:x-speakeasy-name-override", "logs")
 		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "DeploymentLogs"}`)
 	})
+
+	Method("diffDeployments", func() {
+		Description("Compare the tools of two deployments and classify each change as additive or breaking.")
+
+		Payload(func() {
+			Extend(DiffDeploymentsForm)
+			security.ByKeyPayload()
+			security.SessionPayload()
+			security.ProjectPayload()
+		})
+
+		Result(DiffDeploymentsResult)
+
+		HTTP(func() {
+			GET("/rpc/deployments.diff")
+			security.ByKeyHeader()
+			security.SessionHeader()
+			security.ProjectHeader()
+			Param("from_id")
+			Param("to_id")
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "diffDeployments")
+		Meta("openapi:extension:x-speakeasy-name-override", "diff")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "DeploymentDiff"}`)
+	})
 })
 
 var DeploymentSummary = Type("DeploymentSummary", func() {
@@ -270,6 +297,10 @@ var CreateDeploymentForm = Type("CreateDeploymentForm", func() {
 		Description("If true, the deployment will be created in non-blocking mode where the request will return immediately and the deployment will proceed asynchronously.")
 		Example(false)
 	})
+	Attribute("fail_on_breaking", Boolean, func() {
+		Description("If true, the processed deployment is compared with the active deployment and fails instead of becoming active when it introduces breaking changes.")
+		Example(false)
+	})
 	Attribute("github_repo", String, func() {
 		Description("The github repository in the form of \"owner/repo\".")
 		Example("speakeasyapi/gram")
@@ -448,6 +479,10 @@ var EvolveForm = Type("EvolveForm", func() {
 		Description("If true, the deployment will be created in non-blocking mode where the request will return immediately and the deployment will proceed asynchronously.")
 		Example(false)
 	})
+	Attribute("fail_on_breaking", Boolean, func() {
+		Description("If true, the processed deployment is compared with the active deployment and fails instead of becoming active when it introduces breaking changes.")
+		Example(false)
+	})
 	Attribute("upsert_openapiv3_assets", ArrayOf(AddOpenAPIv3DeploymentAssetForm), "The OpenAPI 3.x documents to upsert in the new deployment.")
 	Attribute("upsert_packages", ArrayOf(AddPackageForm), "The packages to upsert in the new deployment.")
 	Attribute("upsert_functions", ArrayOf(AddFunctionsForm), "The tool functions to upsert in the new deployment.")
@@ -495,3 +530,37 @@ var DeploymentLogEvent = Type("DeploymentLogEvent", func() {
 	Attribute("event", String, "The type of event that occurred")
 	Attribute("message", String, "The message of the log event")
 })
+
+var DiffDeploymentsForm = Type("DiffDeploymentsForm", func() {
+	Required("from_id", "to_id")
+	Attribute("from_id", String, "The ID of the deployment to compare from, typically the active one")
+	Attribute("to_id", String, "The ID of the deployment to compare to, typically the latest one")
+})
+
+var DiffDeploymentsResult = Type("DiffDeploymentsResult", func() {
+	Required("from_deployment_id", "to_deployment_id", "has_breaking_changes", "tools_added", "tools_removed", "tools_changed", "breaking_changes", "changes")
+
+	Attribute("from_deployment_id", String, "The ID of the deployment compared from")
+	Attribute("to_deployment_id", String, "The ID of the deployment compared to")
+	Attribute("has_breaking_changes", Boolean, "Whether any change can break existing callers")
+	Attribute("tools_added", Int, "The number of tools only in the newer deployment")
+	Attribute("tools_removed", Int, "The number of tools only in the older deployment")
+	Attribute("tools_changed", Int, "The number of tools in both deployments with at least one change")
+	Attribute("breaking_changes", Int, "The number of breaking changes")
+	Attribute("changes", ArrayOf(DeploymentDiffChange), "The changes ordered by tool URN")
+})
+
+var DeploymentDiffChange = Type("DeploymentDiffChange", func() {
+	Required("tool_urn", "tool_name", "kind", "severity", "description")
+
+	Attribute("tool_urn", String, "The URN of the changed tool")
+	Attribute("tool_name", String, "The name of the changed tool")
+	Attribute("kind", String, "The aspect of the tool that changed", func() {
+		Enum("tool_added", "tool_removed", "input_schema", "annotations", "security", "server_url")
+	})
+	Attribute("severity", String, "Whether the change can break existing callers", func() {
+		Enum("additive", "breaking")
+	})
+	Attribute("path", String, "The location of an input schema change, e.g. body.status or ids[]")
+	Attribute("description", String, "A human readable description of the change")
+})
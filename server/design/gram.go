@@ -12,6 +12,7 @@ import (
 	_ "github.com/speakeasy-api/gram/server/design/assets"
 	_ "github.com/speakeasy-api/gram/server/design/assistantmemories"
 	_ "github.com/speakeasy-api/gram/server/design/assistants"
+	_ "github.com/speakeasy-api/gram/server/design/auditlogexports"
 	_ "github.com/speakeasy-api/gram/server/design/auditlogs"
 	_ "github.com/speakeasy-api/gram/server/design/auth"
 	_ "github.com/speakeasy-api/gram/server/design/businessmemories"
@@ -63,8 +64,13 @@ import (
 	_ "github.com/speakeasy-api/gram/server/design/skills"
 	_ "github.com/speakeasy-api/gram/server/design/spendrules"
 	_ "github.com/speakeasy-api/gram/server/design/telemetry"
+	_ "github.com/speakeasy-api/gram/server/design/telemetryalerts"
 	_ "github.com/speakeasy-api/gram/server/design/templates"
 	_ "github.com/speakeasy-api/gram/server/design/tokenexchange"
+	_ "github.com/speakeasy-api/gram/server/design/toolapprovals"
+	_ "github.com/speakeasy-api/gram/server/design/toolcallrecordings"
+	_ "github.com/speakeasy-api/gram/server/design/toolconstraints"
+	_ "github.com/speakeasy-api/gram/server/design/toolratelimits"
 	_ "github.com/speakeasy-api/gram/server/design/tools"
 	_ "github.com/speakeasy-api/gram/server/design/toolsets"
 	_ "github.com/speakeasy-api/gram/server/design/triggers"
@@ -77,6 +83,7 @@ import (
 	_ "github.com/speakeasy-api/gram/server/design/usersessionissuerscimdclients"
 	_ "github.com/speakeasy-api/gram/server/design/usersessions"
 	_ "github.com/speakeasy-api/gram/server/design/variations"
+	_ "github.com/speakeasy-api/gram/server/design/webhookendpoints"
 )
 
 var _ = API("gram", func() {
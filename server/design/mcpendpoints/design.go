@@ -189,6 +189,148 @@ var _ = Service("mcpEndpoints", func() {
 		Meta("openapi:extension:x-speakeasy-name-override", "delete")
 		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "DeleteMcpEndpoint"}`)
 	})
+
+	Method("startMcpEndpointCanary", func() {
+		Description("Start a canary on a toolset-backed MCP endpoint. A percentage of the endpoint's tool calls are served from the candidate deployment while the rest stay on the baseline. Callers are assigned sticky by Mcp-Session-Id or by user. Only one canary can run per endpoint.")
+
+		Payload(func() {
+			Extend(StartMcpEndpointCanaryForm)
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(McpEndpointCanary)
+
+		HTTP(func() {
+			POST("/rpc/mcpEndpoints.startCanary")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "startMcpEndpointCanary")
+		Meta("openapi:extension:x-speakeasy-name-override", "startCanary")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "StartMcpEndpointCanary"}`)
+	})
+
+	Method("getMcpEndpointCanary", func() {
+		Description("Get the most recent canary for an MCP endpoint, including per-arm tool call error rate and latency.")
+
+		Payload(func() {
+			Attribute("mcp_endpoint_id", String, "The ID of the MCP endpoint", func() {
+				Format(FormatUUID)
+			})
+			Required("mcp_endpoint_id")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(McpEndpointCanary)
+
+		HTTP(func() {
+			GET("/rpc/mcpEndpoints.getCanary")
+			Param("mcp_endpoint_id")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "getMcpEndpointCanary")
+		Meta("openapi:extension:x-speakeasy-name-override", "getCanary")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "McpEndpointCanary"}`)
+	})
+
+	Method("updateMcpEndpointCanary", func() {
+		Description("Change the share of tool calls a running canary sends to its candidate deployment.")
+
+		Payload(func() {
+			Attribute("mcp_endpoint_id", String, "The ID of the MCP endpoint", func() {
+				Format(FormatUUID)
+			})
+			Attribute("percentage", Int32, "Percentage of sticky keys routed to the candidate deployment", func() {
+				Minimum(0)
+				Maximum(100)
+			})
+			Required("mcp_endpoint_id", "percentage")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(McpEndpointCanary)
+
+		HTTP(func() {
+			POST("/rpc/mcpEndpoints.updateCanary")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "updateMcpEndpointCanary")
+		Meta("openapi:extension:x-speakeasy-name-override", "updateCanary")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "UpdateMcpEndpointCanary"}`)
+	})
+
+	Method("promoteMcpEndpointCanary", func() {
+		Description("Promote a running canary. The endpoint stops splitting traffic and follows the project's active deployment, which must be the canary's candidate.")
+
+		Payload(func() {
+			Attribute("mcp_endpoint_id", String, "The ID of the MCP endpoint", func() {
+				Format(FormatUUID)
+			})
+			Required("mcp_endpoint_id")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(McpEndpointCanary)
+
+		HTTP(func() {
+			POST("/rpc/mcpEndpoints.promoteCanary")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "promoteMcpEndpointCanary")
+		Meta("openapi:extension:x-speakeasy-name-override", "promoteCanary")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "PromoteMcpEndpointCanary"}`)
+	})
+
+	Method("abortMcpEndpointCanary", func() {
+		Description("Abort a running canary. Every tool call on the endpoint is served from the baseline deployment until a deployment newer than the candidate completes.")
+
+		Payload(func() {
+			Attribute("mcp_endpoint_id", String, "The ID of the MCP endpoint", func() {
+				Format(FormatUUID)
+			})
+			Required("mcp_endpoint_id")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(McpEndpointCanary)
+
+		HTTP(func() {
+			POST("/rpc/mcpEndpoints.abortCanary")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "abortMcpEndpointCanary")
+		Meta("openapi:extension:x-speakeasy-name-override", "abortCanary")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "AbortMcpEndpointCanary"}`)
+	})
 })
 
 var McpEndpointSlug = Type("McpEndpointSlug", String, func() {
@@ -210,6 +352,9 @@ var CreateMcpEndpointForm = Type("CreateMcpEndpointForm", func() {
 	Attribute("meta_mcp_server_id", String, "The ID of the meta MCP server this endpoint addresses. Mutually exclusive with mcp_server_id.", func() {
 		Format(FormatUUID)
 	})
+	Attribute("toolset_version", Int64, "Pin the endpoint to this version of its MCP server's toolset, overriding any version pinned on the server. Only valid for endpoints of toolset-backed MCP servers. Omit to follow the server.", func() {
+		Minimum(1)
+	})
 	Attribute("slug", McpEndpointSlug, "The slug")
 
 	Required("slug")
@@ -230,6 +375,9 @@ var UpdateMcpEndpointForm = Type("UpdateMcpEndpointForm", func() {
 	Attribute("meta_mcp_server_id", String, "The ID of the meta MCP server this endpoint addresses. Mutually exclusive with mcp_server_id.", func() {
 		Format(FormatUUID)
 	})
+	Attribute("toolset_version", Int64, "Pin the endpoint to this version of its MCP server's toolset, overriding any version pinned on the server. Only valid for endpoints of toolset-backed MCP servers. Omit to follow the server.", func() {
+		Minimum(1)
+	})
 	Attribute("slug", McpEndpointSlug, "The slug")
 
 	Required("id", "slug")
@@ -255,6 +403,7 @@ var McpEndpoint = Type("McpEndpoint", func() {
 	Attribute("meta_mcp_server_id", String, "The ID of the meta MCP server this endpoint addresses. Null for MCP-server-backed endpoints.", func() {
 		Format(FormatUUID)
 	})
+	Attribute("toolset_version", Int64, "The toolset version this endpoint is pinned to, if any. Overrides the version pinned on its MCP server.")
 	Attribute("slug", McpEndpointSlug, "The slug")
 	Attribute("is_domain_root", Boolean, "Whether this endpoint is mapped to its custom-domain root")
 	Attribute("created_at", String, func() {
@@ -275,3 +424,78 @@ var ListMcpEndpointsResult = Type("ListMcpEndpointsResult", func() {
 	Attribute("mcp_endpoints", ArrayOf(McpEndpoint))
 	Required("mcp_endpoints")
 })
+
+var StartMcpEndpointCanaryForm = Type("StartMcpEndpointCanaryForm", func() {
+	Description("Form for starting a canary on an MCP endpoint.")
+
+	Attribute("mcp_endpoint_id", String, "The ID of the MCP endpoint", func() {
+		Format(FormatUUID)
+	})
+	Attribute("baseline_deployment_id", String, "The deployment serving the rest of the traffic. Defaults to the active deployment.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("candidate_deployment_id", String, "The deployment under test. Omit to arm the canary before pushing: the candidate then follows the project's active deployment, so the next deployment only reaches the configured share of callers.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("percentage", Int32, "Percentage of sticky keys routed to the candidate deployment", func() {
+		Minimum(0)
+		Maximum(100)
+	})
+	Attribute("sticky_by", String, "Which request attribute keeps a caller on the same deployment. Requests without a user fall back to their Mcp-Session-Id.", func() {
+		Enum("session", "user")
+		Default("session")
+	})
+
+	Required("mcp_endpoint_id", "percentage")
+})
+
+var McpEndpointCanaryArm = Type("McpEndpointCanaryArm", func() {
+	Description("Tool call telemetry for one side of a canary since it started.")
+
+	Attribute("deployment_id", String, "The deployment serving this arm", func() {
+		Format(FormatUUID)
+	})
+	Attribute("tool_calls", Int64, "Tool calls served by this arm")
+	Attribute("failed_tool_calls", Int64, "Tool calls that returned an HTTP status of 400 or above")
+	Attribute("error_rate", Float64, "Failed tool calls as a fraction of all tool calls. Zero when there were no calls.")
+	Attribute("latency_p50_ms", Float64, "Median tool call latency in milliseconds")
+	Attribute("latency_p95_ms", Float64, "95th percentile tool call latency in milliseconds")
+
+	Required("deployment_id", "tool_calls", "failed_tool_calls", "error_rate", "latency_p50_ms", "latency_p95_ms")
+})
+
+var McpEndpointCanary = Type("McpEndpointCanary", func() {
+	Description("A canary splitting an MCP endpoint's tool calls between a baseline and a candidate deployment.")
+
+	Attribute("id", String, "The ID of the canary", func() {
+		Format(FormatUUID)
+	})
+	Attribute("mcp_endpoint_id", String, "The ID of the MCP endpoint", func() {
+		Format(FormatUUID)
+	})
+	Attribute("baseline_deployment_id", String, "The deployment serving the rest of the traffic", func() {
+		Format(FormatUUID)
+	})
+	Attribute("candidate_deployment_id", String, "The deployment under test. Null while a running canary follows the active deployment.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("percentage", Int32, "Percentage of sticky keys routed to the candidate deployment")
+	Attribute("sticky_by", String, "Which request attribute keeps a caller on the same deployment", func() {
+		Enum("session", "user")
+	})
+	Attribute("status", String, "The canary's lifecycle state", func() {
+		Enum("running", "promoted", "aborted")
+	})
+	Attribute("started_at", String, func() {
+		Description("When the canary started")
+		Format(FormatDateTime)
+	})
+	Attribute("ended_at", String, func() {
+		Description("When the canary was promoted or aborted")
+		Format(FormatDateTime)
+	})
+	Attribute("baseline", McpEndpointCanaryArm, "Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.")
+	Attribute("candidate", McpEndpointCanaryArm, "Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.")
+
+	Required("id", "mcp_endpoint_id", "baseline_deployment_id", "percentage", "sticky_by", "status", "started_at")
+})
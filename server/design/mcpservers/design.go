@@ -395,6 +395,9 @@ var CreateMcpServerForm = Type("CreateMcpServerForm", func() {
 	Attribute("tool_variations_group_id", String, "The ID of the tool variations group enabling MCP tool filtering for this server. Omit to leave filtering disabled.", func() {
 		Format(FormatUUID)
 	})
+	Attribute("toolset_version", Int64, "Pin the server to this version of its toolset instead of serving the latest. Only valid with toolset_id.", func() {
+		Minimum(1)
+	})
 	Attribute("visibility", McpServerVisibility, "The visibility of the server")
 
 	Required("name", "visibility")
@@ -425,6 +428,9 @@ var UpdateMcpServerForm = Type("UpdateMcpServerForm", func() {
 	Attribute("tool_variations_group_id", String, "The ID of the tool variations group enabling MCP tool filtering for this server. Omit to disable filtering (cleared to null, consistent with the full-record replace semantics of the other UUID references).", func() {
 		Format(FormatUUID)
 	})
+	Attribute("toolset_version", Int64, "Pin the server to this version of its toolset instead of serving the latest. Only valid with toolset_id. Omit to serve the latest version.", func() {
+		Minimum(1)
+	})
 	Attribute("visibility", McpServerVisibility, "The visibility of the server")
 
 	Required("id", "visibility")
@@ -464,6 +470,7 @@ var McpServer = Type("McpServer", func() {
 	Attribute("tool_variations_group_id", String, "The ID of the tool variations group enabling MCP tool filtering for this server, if any.", func() {
 		Format(FormatUUID)
 	})
+	Attribute("toolset_version", Int64, "The toolset version this server is pinned to, if any. Unpinned servers serve the latest toolset version.")
 	Attribute("visibility", McpServerVisibility, "The visibility of the server")
 	Attribute("created_at", String, func() {
 		Description("When the MCP server was created")
@@ -41,6 +41,107 @@ var Config = Type("OtelForwardingConfig", func() {
 	})
 })
 
+// ProtocolEnum applies the allowed-values constraint to a destination's
+// protocol. Values match OTEL_EXPORTER_OTLP_PROTOCOL.
+func ProtocolEnum() {
+	Enum("http/protobuf", "http/json", "grpc")
+}
+
+var AttributeMatch = Type("OtelForwardingAttributeMatch", func() {
+	Description("Matches telemetry carrying an attribute. Item attributes are checked first, then resource attributes.")
+	Required("key")
+	Attribute("key", String, "Attribute key.", func() {
+		MinLength(1)
+		MaxLength(256)
+	})
+	Attribute("value", String, "Value the attribute must equal, compared as text. Omit to match any value.", func() {
+		MaxLength(1024)
+	})
+})
+
+var Filters = Type("OtelForwardingFilters", func() {
+	Description("Decides which spans, log records and metric data points reach a destination. Names are span names, log event names and metric names and may use * wildcards. Empty include lists admit everything; an exclude match always wins.")
+	Attribute("include_names", ArrayOf(String), "Only forward items whose name matches one of these patterns.", func() {
+		MaxLength(50)
+	})
+	Attribute("exclude_names", ArrayOf(String), "Never forward items whose name matches one of these patterns.", func() {
+		MaxLength(50)
+	})
+	Attribute("include_attributes", ArrayOf(AttributeMatch), "Only forward items matching at least one of these attributes.", func() {
+		MaxLength(50)
+	})
+	Attribute("exclude_attributes", ArrayOf(AttributeMatch), "Never forward items matching any of these attributes.", func() {
+		MaxLength(50)
+	})
+})
+
+var ScrubRule = Type("OtelForwardingScrubRule", func() {
+	Description("Rewrites every resource, scope and item attribute whose key matches before it leaves Gram. The first matching rule wins.")
+	Required("key", "action")
+	Attribute("key", String, "Attribute key pattern; may use * wildcards, e.g. gen_ai.tool.call.*.", func() {
+		MinLength(1)
+		MaxLength(256)
+	})
+	Attribute("action", String, "drop removes the attribute, redact replaces its value with [REDACTED], hash replaces it with its SHA-256 hex digest.", func() {
+		Enum("drop", "redact", "hash")
+	})
+})
+
+var Destination = Type("OtelForwardingDestination", func() {
+	Description("An additional OTLP endpoint that receives the organization's forwarded telemetry after filtering, sampling and scrubbing.")
+	Required("id", "organization_id", "name", "endpoint_url", "protocol", "enabled", "headers", "sampling_ratio", "filters", "scrub_rules", "created_at", "updated_at")
+	Attribute("id", String, "Destination ID.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("organization_id", String, "Organization the destination belongs to.")
+	Attribute("name", String, "Name of the destination, unique within the organization.")
+	Attribute("endpoint_url", String, "Base URL of the OTLP receiver. OTLP/HTTP destinations receive /v1/<signal> under it; gRPC destinations dial its host and port.")
+	Attribute("protocol", String, "OTLP transport and encoding.", ProtocolEnum)
+	Attribute("enabled", Boolean, "Whether the destination currently receives telemetry.")
+	Attribute("headers", ArrayOf(HeaderModel), "Headers sent with each export (gRPC metadata for gRPC destinations). Values are never returned.")
+	Attribute("sampling_ratio", Float64, "Share of traces and log records forwarded, from 0 to 1. Items sharing a trace ID are kept or dropped together. Metrics are never sampled.")
+	Attribute("filters", Filters, "Filters applied before sampling.")
+	Attribute("scrub_rules", ArrayOf(ScrubRule), "Attribute scrubbing rules applied to everything that is forwarded.")
+	Attribute("created_at", String, "When the destination was created.", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, "When the destination was last updated.", func() {
+		Format(FormatDateTime)
+	})
+})
+
+var ListDestinationsResult = Type("ListOtelForwardingDestinationsResult", func() {
+	Required("destinations")
+	Attribute("destinations", ArrayOf(Destination), "The organization's destinations, oldest first.")
+})
+
+// destinationForm declares the writable destination attributes shared by
+// createDestination and updateDestination.
+func destinationForm() {
+	Attribute("name", String, "Name of the destination, unique within the organization.", func() {
+		MinLength(1)
+		MaxLength(100)
+	})
+	Attribute("endpoint_url", String, "Base URL of the OTLP receiver. Use https:// for TLS; gRPC destinations without a port use 4317.")
+	Attribute("protocol", String, "OTLP transport and encoding.", func() {
+		ProtocolEnum()
+		Default("http/protobuf")
+	})
+	Attribute("enabled", Boolean, "Whether the destination should receive telemetry.", func() {
+		Default(true)
+	})
+	Attribute("headers", ArrayOf(HeaderInput), "Complete desired header set. Omitted entries are removed; entries with an omitted value preserve the existing encrypted value for the same name.")
+	Attribute("sampling_ratio", Float64, "Share of traces and log records to forward, from 0 to 1.", func() {
+		Minimum(0)
+		Maximum(1)
+		Default(1.0)
+	})
+	Attribute("filters", Filters, "Filters applied before sampling. Omit to forward everything.")
+	Attribute("scrub_rules", ArrayOf(ScrubRule), "Attribute scrubbing rules.", func() {
+		MaxLength(50)
+	})
+}
+
 var _ = Service("otelForwarding", func() {
 	Description("Manage per-organization forwarding of inbound OTEL hook payloads to a customer-owned endpoint.")
 
@@ -130,4 +231,123 @@ var _ = Service("otelForwarding", func() {
 		Meta("openapi:extension:x-speakeasy-name-override", "deleteConfig")
 		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "DeleteOtelForwardingConfig"}`)
 	})
+
+	Method("listDestinations", func() {
+		Description("List the organization's OTEL forwarding destinations.")
+
+		Security(security.ByKey, func() {
+			Scope("consumer")
+		})
+		Security(security.Session)
+
+		Payload(func() {
+			security.ByKeyPayload()
+			security.SessionPayload()
+		})
+
+		Result(ListDestinationsResult)
+
+		HTTP(func() {
+			GET("/rpc/otelForwarding.listDestinations")
+			security.ByKeyHeader()
+			security.SessionHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "listOtelForwardingDestinations")
+		Meta("openapi:extension:x-speakeasy-name-override", "listDestinations")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "OtelForwardingDestinations"}`)
+	})
+
+	Method("createDestination", func() {
+		Description("Add an OTEL forwarding destination with its own protocol, filters, sampling ratio and scrub rules.")
+
+		Security(security.ByKey, func() {
+			Scope("producer")
+		})
+		Security(security.Session)
+
+		Payload(func() {
+			security.ByKeyPayload()
+			security.SessionPayload()
+			destinationForm()
+			Required("name", "endpoint_url")
+		})
+
+		Result(Destination)
+
+		HTTP(func() {
+			POST("/rpc/otelForwarding.createDestination")
+			security.ByKeyHeader()
+			security.SessionHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "createOtelForwardingDestination")
+		Meta("openapi:extension:x-speakeasy-name-override", "createDestination")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "CreateOtelForwardingDestination"}`)
+	})
+
+	Method("updateDestination", func() {
+		Description("Replace an OTEL forwarding destination. Every field is overwritten; header values may be omitted to keep the stored ones.")
+
+		Security(security.ByKey, func() {
+			Scope("producer")
+		})
+		Security(security.Session)
+
+		Payload(func() {
+			security.ByKeyPayload()
+			security.SessionPayload()
+			Attribute("id", String, "ID of the destination to update.", func() {
+				Format(FormatUUID)
+			})
+			destinationForm()
+			Required("id", "name", "endpoint_url")
+		})
+
+		Result(Destination)
+
+		HTTP(func() {
+			POST("/rpc/otelForwarding.updateDestination")
+			security.ByKeyHeader()
+			security.SessionHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "updateOtelForwardingDestination")
+		Meta("openapi:extension:x-speakeasy-name-override", "updateDestination")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "UpdateOtelForwardingDestination"}`)
+	})
+
+	Method("deleteDestination", func() {
+		Description("Delete an OTEL forwarding destination.")
+
+		Security(security.ByKey, func() {
+			Scope("producer")
+		})
+		Security(security.Session)
+
+		Payload(func() {
+			security.ByKeyPayload()
+			security.SessionPayload()
+			Attribute("id", String, "ID of the destination to delete.", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+		})
+
+		Result(Empty)
+
+		HTTP(func() {
+			POST("/rpc/otelForwarding.deleteDestination")
+			security.ByKeyHeader()
+			security.SessionHeader()
+			Response(StatusNoContent)
+		})
+
+		Meta("openapi:operationId", "deleteOtelForwardingDestination")
+		Meta("openapi:extension:x-speakeasy-name-override", "deleteDestination")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "DeleteOtelForwardingDestination"}`)
+	})
 })
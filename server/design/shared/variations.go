@@ -22,12 +22,31 @@ var ToolVariation = Type("ToolVariation", func() {
 	Attribute("destructive_hint", Boolean, "Override: if true, the tool may perform destructive updates")
 	Attribute("idempotent_hint", Boolean, "Override: if true, repeated calls have no additional effect")
 	Attribute("open_world_hint", Boolean, "Override: if true, the tool interacts with external entities")
+	Attribute("cache_ttl_seconds", Int32, "How long the gateway caches responses of this tool")
+	Attribute("cache_shared", Boolean, "If true, cached responses are shared between callers with identical environments")
+	Attribute("argument_bindings", ArrayOf(ToolArgumentBinding), "Arguments filled server-side on every call and hidden from the tool's input schema")
 	Attribute("created_at", String, "The creation date of the tool variation")
 	Attribute("updated_at", String, "The last update date of the tool variation")
 
 	Required("id", "group_id", "src_tool_name", "src_tool_urn", "created_at", "updated_at")
 })
 
+var ToolArgumentBinding = Type("ToolArgumentBinding", func() {
+	Meta("struct:pkg:path", "types")
+
+	Attribute("argument", String, "Dotted path of the bound argument in the tool's input, e.g. body.tenant_id", func() {
+		MinLength(1)
+	})
+	Attribute("source", String, "Where the bound value comes from", func() {
+		Enum("constant", "environment", "principal")
+	})
+	Attribute("value", String, "A JSON literal for constant bindings, an environment variable name for environment bindings, or a claim of the authenticated user (email, user_id, external_user_id, organization_id, organization_slug) for principal bindings", func() {
+		MinLength(1)
+	})
+
+	Required("argument", "source", "value")
+})
+
 var ToolVariationGroup = Type("ToolVariationGroup", func() {
 	Meta("struct:pkg:path", "types")
 
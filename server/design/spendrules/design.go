@@ -41,6 +41,7 @@ var _ = Service("spendRules", func() {
 				Default("")
 			})
 			Attribute("target", SpendRuleTargetCondition, "Structured member-attribute condition selecting who the rule applies to.")
+			Attribute("scope", SpendRuleScopeCondition, "Optional usage-dimension condition selecting which spend counts toward the limit. Omit to count all spend.")
 			Attribute("limit_usd", Float64, "Per-person budget in USD for one window.", func() {
 				Minimum(0)
 			})
@@ -146,6 +147,8 @@ var _ = Service("spendRules", func() {
 			Attribute("name", String, "The rule name. Omit to preserve the current name.")
 			Attribute("description", String, "Description of what the rule covers. Omit to preserve the current description.")
 			Attribute("target", SpendRuleTargetCondition, "Structured member-attribute condition. Omit to preserve the current target.")
+			Attribute("scope", SpendRuleScopeCondition, "Usage-dimension condition selecting which spend counts toward the limit. Omit to preserve the current scope.")
+			Attribute("clear_scope", Boolean, "Remove the rule's scope so all spend counts toward the limit. Ignored when scope is supplied.")
 			Attribute("limit_usd", Float64, "Per-person budget in USD for one window. Omit to preserve the current limit.", func() {
 				Minimum(0)
 			})
@@ -214,6 +217,7 @@ var _ = Service("spendRules", func() {
 			security.SessionPayload()
 			security.ProjectPayload()
 			Attribute("target", SpendRuleTargetCondition, "Structured member-attribute condition to preview.")
+			Attribute("scope", SpendRuleScopeCondition, "Optional usage-dimension condition selecting which spend counts toward the limit.")
 			Attribute("limit_usd", Float64, "Per-person budget in USD used to compute usage percentages.", func() {
 				Minimum(0)
 			})
@@ -244,6 +248,54 @@ var _ = Service("spendRules", func() {
 		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "SpendRulesPreviewRule", "type": "mutation"}`)
 	})
 
+	Method("simulateSpendRule", func() {
+		Description("Replay a draft rule over the last N windows of recorded spend to show who it would have warned or blocked, and when. Runs against historical usage only; nothing is enforced or recorded.")
+
+		Payload(func() {
+			security.ByKeyPayload()
+			security.SessionPayload()
+			security.ProjectPayload()
+			Attribute("target", SpendRuleTargetCondition, "Structured member-attribute condition to simulate.")
+			Attribute("scope", SpendRuleScopeCondition, "Optional usage-dimension condition selecting which spend counts toward the limit.")
+			Attribute("limit_usd", Float64, "Per-person budget in USD for one window.", func() {
+				Minimum(0)
+			})
+			Attribute("warn_at_pct", Int, "Percentage of the limit at which a warning event is emitted.", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(80)
+			})
+			Attribute("window_kind", String, "UTC calendar window the budget covers.", func() {
+				SpendRuleWindowKindEnum()
+			})
+			Attribute("action", String, "Rule action to simulate: flag (record events only) or block (deny agent traffic on breach).", func() {
+				SpendRuleActionEnum()
+				Default("block")
+			})
+			Attribute("windows", Int, "Number of most recent windows to replay, including the current in-progress window.", func() {
+				Minimum(1)
+				Maximum(12)
+				Default(3)
+			})
+			Required("target", "limit_usd", "window_kind")
+		})
+
+		Result(SimulateSpendRuleResult)
+
+		HTTP(func() {
+			POST("/rpc/spendrules.simulateRule")
+			security.ByKeyHeader()
+			security.SessionHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "simulateSpendRule")
+		Meta("openapi:extension:x-speakeasy-group", "spendRules.rules")
+		Meta("openapi:extension:x-speakeasy-name-override", "simulate")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "SpendRulesSimulateRule", "type": "mutation"}`)
+	})
+
 	Method("listSpendRuleEvents", func() {
 		Description("List warning and breach events emitted by budget rule evaluation, most recent first.")
 
@@ -333,6 +385,31 @@ var _ = Service("spendRules", func() {
 		Meta("openapi:extension:x-speakeasy-name-override", "list")
 		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "SpendRulesActorAttributes"}`)
 	})
+
+	Method("listUsageDimensions", func() {
+		Description("List the usage dimensions a rule scope condition can be written against. Static reference data that powers the rule editor's scope picker.")
+
+		Payload(func() {
+			security.ByKeyPayload()
+			security.SessionPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ListUsageDimensionsResult)
+
+		HTTP(func() {
+			GET("/rpc/spendrules.listUsageDimensions")
+			security.ByKeyHeader()
+			security.SessionHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "listSpendRuleUsageDimensions")
+		Meta("openapi:extension:x-speakeasy-group", "spendRules.usageDimensions")
+		Meta("openapi:extension:x-speakeasy-name-override", "list")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "SpendRulesUsageDimensions"}`)
+	})
 })
 
 var SpendRuleTargetCondition = Type("SpendRuleTargetCondition", func() {
@@ -345,6 +422,16 @@ var SpendRuleTargetCondition = Type("SpendRuleTargetCondition", func() {
 	Required("attribute", "operator", "value")
 })
 
+var SpendRuleScopeCondition = Type("SpendRuleScopeCondition", func() {
+	Meta("struct:pkg:path", "types")
+
+	Attribute("dimension", String, "Usage dimension name: model, model_family, assistant_id, project_id, or toolset_slug.")
+	Attribute("operator", String, "Comparison operator: equals, not_equals, starts_with, ends_with, contains, or matches.")
+	Attribute("value", String, "Comparison value.")
+
+	Required("dimension", "operator", "value")
+})
+
 var SpendRule = Type("SpendRule", func() {
 	Meta("struct:pkg:path", "types")
 
@@ -358,6 +445,8 @@ var SpendRule = Type("SpendRule", func() {
 	Attribute("description", String, "Description of what the rule covers. Empty when unset.")
 	Attribute("target", SpendRuleTargetCondition, "Structured member-attribute condition selecting who the rule applies to.")
 	Attribute("target_expr", String, "CEL boolean expression over member attributes (email, directory attributes, groups, roles) selecting who the rule applies to.")
+	Attribute("scope", SpendRuleScopeCondition, "Structured usage-dimension condition selecting which spend counts toward the limit. Absent when all spend counts.")
+	Attribute("scope_expr", String, "CEL boolean expression over usage dimensions (model, model_family, assistant_id, project_id, toolset_slug). Empty when all spend counts.")
 	Attribute("rule_expr", String, "CEL boolean expression over actor usage that identifies a budget breach for matched members.")
 	Attribute("limit_usd", Float64, "Per-person budget in USD for one window.")
 	Attribute("window_kind", String, "UTC calendar window the budget covers.", func() {
@@ -376,7 +465,7 @@ var SpendRule = Type("SpendRule", func() {
 		Format(FormatDateTime)
 	})
 
-	Required("id", "urn", "organization_id", "name", "slug", "description", "target", "target_expr", "rule_expr", "limit_usd", "window_kind", "warn_at_pct", "action", "enabled", "version", "created_at", "updated_at")
+	Required("id", "urn", "organization_id", "name", "slug", "description", "target", "target_expr", "scope_expr", "rule_expr", "limit_usd", "window_kind", "warn_at_pct", "action", "enabled", "version", "created_at", "updated_at")
 })
 
 var SpendRuleEvent = Type("SpendRuleEvent", func() {
@@ -434,6 +523,63 @@ var PreviewSpendRuleResult = Type("PreviewSpendRuleResult", func() {
 	Required("matched_count", "window_start", "window_end", "actors")
 })
 
+var SpendRuleSimulationWindow = Type("SpendRuleSimulationWindow", func() {
+	Attribute("window_start", String, "Inclusive start of the window.", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("window_end", String, "Exclusive end of the window.", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("in_progress", Boolean, "Whether the window contains the current time, so its spend is partial.")
+	Attribute("spend_usd", Float64, "Total spend in USD across matched users within the window.")
+	Attribute("users_warned", Int, "Matched users who would have reached the warning threshold within the window.")
+	Attribute("users_breached", Int, "Matched users who would have breached the rule within the window.")
+
+	Required("window_start", "window_end", "in_progress", "spend_usd", "users_warned", "users_breached")
+})
+
+var SpendRuleSimulationActorWindow = Type("SpendRuleSimulationActorWindow", func() {
+	Attribute("window_start", String, "Inclusive start of the window.", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("window_end", String, "Exclusive end of the window.", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("spend_usd", Float64, "Actor spend in USD within the window.")
+	Attribute("used_pct", Float64, "Window spend as a percentage of the limit (may exceed 100).")
+	Attribute("warned_at", String, "Start of the spend bucket in which the actor first reached the warning threshold. Absent when never reached.", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("breached_at", String, "Start of the spend bucket in which the actor first breached the rule. Absent when never breached.", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("blocked", Boolean, "Whether a block rule would have denied the actor's agent traffic from breached_at until the window reset.")
+
+	Required("window_start", "window_end", "spend_usd", "used_pct", "blocked")
+})
+
+var SpendRuleSimulationActor = Type("SpendRuleSimulationActor", func() {
+	Attribute("email", String, "Actor email.")
+	Attribute("display_name", String, "Actor display name, when known.")
+	Attribute("user_id", String, "Gram user ID of the actor, when linked.")
+	Attribute("windows_warned", Int, "Number of simulated windows in which the actor reached the warning threshold.")
+	Attribute("windows_breached", Int, "Number of simulated windows in which the actor breached the rule.")
+	Attribute("timeline", ArrayOf(SpendRuleSimulationActorWindow), "Per-window outcome, oldest window first.")
+
+	Required("email", "windows_warned", "windows_breached", "timeline")
+})
+
+var SimulateSpendRuleResult = Type("SimulateSpendRuleResult", func() {
+	Attribute("matched_count", Int, "Total number of organization members the target expression matches.")
+	Attribute("action", String, "The simulated rule action.", func() {
+		SpendRuleActionEnum()
+	})
+	Attribute("windows", ArrayOf(SpendRuleSimulationWindow), "Replayed windows, oldest first. The last window is the current, in-progress one.")
+	Attribute("actors", ArrayOf(SpendRuleSimulationActor), "Matched actors who would have reached the warning threshold in at least one window, most breached windows first. Capped at 50 entries.")
+
+	Required("matched_count", "action", "windows", "actors")
+})
+
 var SpendRuleUsage = Type("SpendRuleUsage", func() {
 	Attribute("rule_id", String, "The budget rule ID.", func() {
 		Format(FormatUUID)
@@ -489,3 +635,15 @@ var ListActorAttributesResult = Type("ListActorAttributesResult", func() {
 	Attribute("attributes", ArrayOf(ActorAttribute), "The member attributes available to target conditions, in editor display order.")
 	Required("attributes")
 })
+
+var UsageDimension = Type("UsageDimension", func() {
+	Attribute("name", String, "Dimension name as used in scope conditions, e.g. model_family.")
+	Attribute("description", String, "Human-readable description of the dimension.")
+
+	Required("name", "description")
+})
+
+var ListUsageDimensionsResult = Type("ListUsageDimensionsResult", func() {
+	Attribute("dimensions", ArrayOf(UsageDimension), "The usage dimensions available to scope conditions, in editor display order.")
+	Required("dimensions")
+})
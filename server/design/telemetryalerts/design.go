@@ -0,0 +1,254 @@
+package telemetryalerts
+
+import (
+	. "goa.design/goa/v3/dsl"
+
+	"github.com/speakeasy-api/gram/server/design/security"
+	"github.com/speakeasy-api/gram/server/design/shared"
+)
+
+// TelemetryAlertMetricEnum applies the allowed-values constraint to a metric
+// attribute. error_rate is the fraction of failed tool calls; the latency
+// metrics are percentiles of tool call duration.
+func TelemetryAlertMetricEnum() {
+	Enum("error_rate", "latency_p50", "latency_p90", "latency_p95", "latency_p99")
+}
+
+var _ = Service("telemetryAlerts", func() {
+	Description("Manage alert rules that watch a project's tool error rate and latency.")
+	Security(security.Session, security.ProjectSlug)
+	Security(security.ByKey, security.ProjectSlug, func() {
+		Scope("producer")
+	})
+	shared.DeclareErrorResponses()
+
+	Method("createTelemetryAlertRule", func() {
+		Description("Create an alert rule over the project's tool call telemetry, optionally narrowed to a toolset, a tool, or both.")
+
+		Payload(func() {
+			Extend(CreateTelemetryAlertRuleForm)
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(TelemetryAlertRule)
+
+		HTTP(func() {
+			POST("/rpc/telemetryAlerts.create")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "createTelemetryAlertRule")
+		Meta("openapi:extension:x-speakeasy-name-override", "create")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "CreateTelemetryAlertRule"}`)
+	})
+
+	Method("listTelemetryAlertRules", func() {
+		Description("List the project's telemetry alert rules with their current state. Optionally filter to rules narrowed to a specific toolset.")
+
+		Payload(func() {
+			Attribute("toolset_id", String, "Optional filter: only return rules narrowed to this toolset.", func() {
+				Format(FormatUUID)
+			})
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ListTelemetryAlertRulesResult)
+
+		HTTP(func() {
+			GET("/rpc/telemetryAlerts.list")
+			Param("toolset_id")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "listTelemetryAlertRules")
+		Meta("openapi:extension:x-speakeasy-name-override", "list")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "TelemetryAlertRules"}`)
+	})
+
+	Method("updateTelemetryAlertRule", func() {
+		Description("Update a telemetry alert rule. Omitted fields keep their stored values; the scope and metric are fixed at creation. Disabling a firing rule returns it to ok without a resolved notification.")
+
+		Payload(func() {
+			Extend(UpdateTelemetryAlertRuleForm)
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(TelemetryAlertRule)
+
+		HTTP(func() {
+			POST("/rpc/telemetryAlerts.update")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "updateTelemetryAlertRule")
+		Meta("openapi:extension:x-speakeasy-name-override", "update")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "UpdateTelemetryAlertRule"}`)
+	})
+
+	Method("deleteTelemetryAlertRule", func() {
+		Description("Delete a telemetry alert rule.")
+
+		Payload(func() {
+			Attribute("id", String, "The ID of the alert rule to delete", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		HTTP(func() {
+			DELETE("/rpc/telemetryAlerts.delete")
+			Param("id")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "deleteTelemetryAlertRule")
+		Meta("openapi:extension:x-speakeasy-name-override", "delete")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "DeleteTelemetryAlertRule"}`)
+	})
+})
+
+var CreateTelemetryAlertRuleForm = Type("CreateTelemetryAlertRuleForm", func() {
+	Description("Form for creating a telemetry alert rule.")
+
+	Attribute("name", String, "A human-readable name for the rule.", func() {
+		MinLength(1)
+		MaxLength(100)
+	})
+	Attribute("toolset_id", String, "Narrow the rule to calls made through this toolset. Omit to watch every toolset in the project.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("tool_urn", String, "Narrow the rule to calls of this tool. Omit to watch every tool.", func() {
+		MinLength(1)
+		MaxLength(500)
+	})
+	Attribute("metric", String, "The metric the rule watches.", func() {
+		TelemetryAlertMetricEnum()
+	})
+	Attribute("threshold", Float64, "The rule fires while the metric is above this value: a fraction between 0 and 1 for error_rate, milliseconds for the latency metrics.", func() {
+		ExclusiveMinimum(0)
+	})
+	Attribute("window_seconds", Int32, "Length of the trailing window the metric is computed over, in seconds.", func() {
+		Minimum(60)
+		Maximum(86400)
+	})
+	Attribute("min_calls", Int32, "Minimum tool calls in the window for the rule to fire. Defaults to 10.", func() {
+		Minimum(1)
+	})
+	Attribute("email_recipients", ArrayOf(String, func() {
+		Format(FormatEmail)
+	}), "Addresses emailed when the rule fires and resolves.", func() {
+		MaxLength(20)
+	})
+	Attribute("enabled", Boolean, "Whether the rule is evaluated. Defaults to true.")
+
+	Required("name", "metric", "threshold", "window_seconds")
+})
+
+var UpdateTelemetryAlertRuleForm = Type("UpdateTelemetryAlertRuleForm", func() {
+	Description("Form for updating a telemetry alert rule.")
+
+	Attribute("id", String, "The ID of the alert rule to update", func() {
+		Format(FormatUUID)
+	})
+	Attribute("name", String, "A human-readable name for the rule.", func() {
+		MinLength(1)
+		MaxLength(100)
+	})
+	Attribute("threshold", Float64, "The rule fires while the metric is above this value: a fraction between 0 and 1 for error_rate, milliseconds for the latency metrics.", func() {
+		ExclusiveMinimum(0)
+	})
+	Attribute("window_seconds", Int32, "Length of the trailing window the metric is computed over, in seconds.", func() {
+		Minimum(60)
+		Maximum(86400)
+	})
+	Attribute("min_calls", Int32, "Minimum tool calls in the window for the rule to fire.", func() {
+		Minimum(1)
+	})
+	Attribute("email_recipients", ArrayOf(String, func() {
+		Format(FormatEmail)
+	}), "Addresses emailed when the rule fires and resolves. Replaces the stored list.", func() {
+		MaxLength(20)
+	})
+	Attribute("enabled", Boolean, "Whether the rule is evaluated.")
+
+	Required("id")
+})
+
+var TelemetryAlertRule = Type("TelemetryAlertRule", func() {
+	Meta("struct:pkg:path", "types")
+
+	Description("An alert rule over a project's tool call error rate or latency, with its current state.")
+
+	Attribute("id", String, "The ID of the alert rule", func() {
+		Format(FormatUUID)
+	})
+	Attribute("project_id", String, "The project ID this rule belongs to", func() {
+		Format(FormatUUID)
+	})
+	Attribute("name", String, "A human-readable name for the rule.")
+	Attribute("toolset_id", String, "The toolset the rule is narrowed to. Null when it watches every toolset.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("tool_urn", String, "The tool the rule is narrowed to. Null when it watches every tool.")
+	Attribute("metric", String, "The metric the rule watches.", func() {
+		TelemetryAlertMetricEnum()
+	})
+	Attribute("threshold", Float64, "The rule fires while the metric is above this value: a fraction between 0 and 1 for error_rate, milliseconds for the latency metrics.")
+	Attribute("window_seconds", Int32, "Length of the trailing window the metric is computed over, in seconds.")
+	Attribute("min_calls", Int32, "Minimum tool calls in the window for the rule to fire.")
+	Attribute("email_recipients", ArrayOf(String), "Addresses emailed when the rule fires and resolves.")
+	Attribute("enabled", Boolean, "Whether the rule is evaluated.")
+	Attribute("state", String, "Whether the rule is currently firing.", func() {
+		Enum("ok", "firing")
+	})
+	Attribute("incident_id", String, "The current or most recent firing episode. Its firing and resolved notifications carry this ID.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("state_changed_at", String, func() {
+		Description("When the rule last fired or resolved")
+		Format(FormatDateTime)
+	})
+	Attribute("last_value", Float64, "The metric's value at the last evaluation. Null when the window had no calls.")
+	Attribute("last_evaluated_at", String, func() {
+		Description("When the rule was last evaluated")
+		Format(FormatDateTime)
+	})
+	Attribute("created_at", String, func() {
+		Description("When the rule was created")
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, func() {
+		Description("When the rule was last updated")
+		Format(FormatDateTime)
+	})
+
+	Required("id", "project_id", "name", "metric", "threshold", "window_seconds", "min_calls", "email_recipients", "enabled", "state", "created_at", "updated_at")
+})
+
+var ListTelemetryAlertRulesResult = Type("ListTelemetryAlertRulesResult", func() {
+	Description("Result type for listing telemetry alert rules")
+
+	Attribute("rules", ArrayOf(TelemetryAlertRule))
+	Required("rules")
+})
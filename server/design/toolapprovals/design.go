@@ -0,0 +1,246 @@
+package toolapprovals
+
+import (
+	. "goa.design/goa/v3/dsl"
+
+	"github.com/speakeasy-api/gram/server/design/security"
+	"github.com/speakeasy-api/gram/server/design/shared"
+)
+
+const expressionDescription = "CEL boolean expression over the call's arguments; a call matches when it is true. Each top-level argument is a variable, and args holds them all as a map, e.g. `amount > 500` or `env == \"production\"`."
+
+const matchDestructiveDescription = "Match calls to tools whose annotations mark them destructive."
+
+var _ = Service("toolApprovals", func() {
+	Description("Manage human-in-the-loop approval policies for tool calls on toolsets and MCP servers.")
+	Security(security.Session, security.ProjectSlug)
+	Security(security.ByKey, security.ProjectSlug, func() {
+		Scope("producer")
+	})
+	shared.DeclareErrorResponses()
+
+	Method("createToolApprovalPolicy", func() {
+		Description("Require human approval for matching tool calls on a toolset or an MCP server. Provide exactly one of toolset_id or mcp_server_id, and at least one of match_destructive or expression.")
+
+		Payload(func() {
+			Extend(CreateToolApprovalPolicyForm)
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ToolApprovalPolicy)
+
+		HTTP(func() {
+			POST("/rpc/toolApprovals.create")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "createToolApprovalPolicy")
+		Meta("openapi:extension:x-speakeasy-name-override", "create")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "CreateToolApprovalPolicy"}`)
+	})
+
+	Method("listToolApprovalPolicies", func() {
+		Description("List approval policies for a project. Optionally filter to those attached to a specific toolset or MCP server.")
+
+		Payload(func() {
+			Attribute("toolset_id", String, "Optional filter: only return policies attached to this toolset.", func() {
+				Format(FormatUUID)
+			})
+			Attribute("mcp_server_id", String, "Optional filter: only return policies attached to this MCP server.", func() {
+				Format(FormatUUID)
+			})
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ListToolApprovalPoliciesResult)
+
+		HTTP(func() {
+			GET("/rpc/toolApprovals.list")
+			Param("toolset_id")
+			Param("mcp_server_id")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "listToolApprovalPolicies")
+		Meta("openapi:extension:x-speakeasy-name-override", "list")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "ToolApprovalPolicies"}`)
+	})
+
+	Method("updateToolApprovalPolicy", func() {
+		Description("Update an approval policy. Omitted fields keep their stored values; the target is fixed at creation.")
+
+		Payload(func() {
+			Extend(UpdateToolApprovalPolicyForm)
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ToolApprovalPolicy)
+
+		HTTP(func() {
+			POST("/rpc/toolApprovals.update")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "updateToolApprovalPolicy")
+		Meta("openapi:extension:x-speakeasy-name-override", "update")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "UpdateToolApprovalPolicy"}`)
+	})
+
+	Method("deleteToolApprovalPolicy", func() {
+		Description("Delete an approval policy.")
+
+		Payload(func() {
+			Attribute("id", String, "The ID of the policy to delete", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		HTTP(func() {
+			DELETE("/rpc/toolApprovals.delete")
+			Param("id")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "deleteToolApprovalPolicy")
+		Meta("openapi:extension:x-speakeasy-name-override", "delete")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "DeleteToolApprovalPolicy"}`)
+	})
+})
+
+var CreateToolApprovalPolicyForm = Type("CreateToolApprovalPolicyForm", func() {
+	Description("Form for creating an approval policy. Provide exactly one of toolset_id or mcp_server_id.")
+
+	Attribute("toolset_id", String, "The ID of the toolset the policy applies to. Mutually exclusive with mcp_server_id.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("mcp_server_id", String, "The ID of the MCP server the policy applies to. Mutually exclusive with toolset_id.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("tool_name", String, "The tool the policy governs. Omit to govern every tool on the target.", func() {
+		MinLength(1)
+		MaxLength(128)
+	})
+	Attribute("match_destructive", Boolean, matchDestructiveDescription, func() {
+		Default(false)
+	})
+	Attribute("expression", String, expressionDescription, func() {
+		MinLength(1)
+		MaxLength(4096)
+	})
+	Attribute("message", String, "Explanation shown to the approver alongside the call.", func() {
+		MaxLength(1000)
+	})
+	Attribute("notify_emails", ArrayOf(String, func() {
+		Format(FormatEmail)
+	}), "Addresses sent an approval link when the MCP client cannot ask its user.", func() {
+		MaxLength(20)
+	})
+	Attribute("slack_webhook_url", String, "Slack incoming webhook posted an approval link when the MCP client cannot ask its user. Stored encrypted and never returned.", func() {
+		Format(FormatURI)
+		MaxLength(2048)
+	})
+	Attribute("timeout_seconds", Int, "How long a matching call is held waiting for a decision before it is refused.", func() {
+		Minimum(10)
+		Maximum(900)
+		Default(300)
+	})
+
+	Required("match_destructive", "timeout_seconds")
+})
+
+var UpdateToolApprovalPolicyForm = Type("UpdateToolApprovalPolicyForm", func() {
+	Description("Form for updating an approval policy.")
+
+	Attribute("id", String, "The ID of the policy to update", func() {
+		Format(FormatUUID)
+	})
+	Attribute("tool_name", String, "The tool the policy governs. An empty string governs every tool on the target.", func() {
+		MaxLength(128)
+	})
+	Attribute("match_destructive", Boolean, matchDestructiveDescription)
+	Attribute("expression", String, expressionDescription+" An empty string clears it.", func() {
+		MaxLength(4096)
+	})
+	Attribute("message", String, "Explanation shown to the approver alongside the call. An empty string clears it.", func() {
+		MaxLength(1000)
+	})
+	Attribute("notify_emails", ArrayOf(String, func() {
+		Format(FormatEmail)
+	}), "Addresses sent an approval link when the MCP client cannot ask its user. Replaces the stored list.", func() {
+		MaxLength(20)
+	})
+	Attribute("slack_webhook_url", String, "Slack incoming webhook posted an approval link. An empty string clears it.", func() {
+		MaxLength(2048)
+	})
+	Attribute("timeout_seconds", Int, "How long a matching call is held waiting for a decision before it is refused.", func() {
+		Minimum(10)
+		Maximum(900)
+	})
+
+	Required("id")
+})
+
+var ToolApprovalPolicy = Type("ToolApprovalPolicy", func() {
+	Meta("struct:pkg:path", "types")
+
+	Description("A policy holding matching tools/call requests against a toolset or an MCP server until a human approves them. Exactly one of toolset_id and mcp_server_id is set.")
+
+	Attribute("id", String, "The ID of the policy", func() {
+		Format(FormatUUID)
+	})
+	Attribute("project_id", String, "The project ID this policy belongs to", func() {
+		Format(FormatUUID)
+	})
+	Attribute("toolset_id", String, "The ID of the toolset the policy applies to. Null for MCP-server policies.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("mcp_server_id", String, "The ID of the MCP server the policy applies to. Null for toolset policies.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("tool_name", String, "The tool the policy governs. Null governs every tool on the target.")
+	Attribute("match_destructive", Boolean, matchDestructiveDescription)
+	Attribute("expression", String, expressionDescription)
+	Attribute("message", String, "Explanation shown to the approver alongside the call.")
+	Attribute("notify_emails", ArrayOf(String), "Addresses sent an approval link when the MCP client cannot ask its user.")
+	Attribute("slack_webhook_configured", Boolean, "Whether a Slack incoming webhook is configured for approval links.")
+	Attribute("timeout_seconds", Int, "How long a matching call is held waiting for a decision before it is refused.")
+	Attribute("created_at", String, func() {
+		Description("When the policy was created")
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, func() {
+		Description("When the policy was last updated")
+		Format(FormatDateTime)
+	})
+
+	Required("id", "project_id", "match_destructive", "notify_emails", "slack_webhook_configured", "timeout_seconds", "created_at", "updated_at")
+})
+
+var ListToolApprovalPoliciesResult = Type("ListToolApprovalPoliciesResult", func() {
+	Description("Result type for listing approval policies")
+
+	Attribute("policies", ArrayOf(ToolApprovalPolicy))
+	Required("policies")
+})
@@ -0,0 +1,296 @@
+package toolcallrecordings
+
+import (
+	. "goa.design/goa/v3/dsl"
+
+	"github.com/speakeasy-api/gram/server/design/security"
+	"github.com/speakeasy-api/gram/server/design/shared"
+)
+
+var _ = Service("toolCallRecordings", func() {
+	Description("Record real tool calls on a toolset and replay them against another deployment as regression tests.")
+	Security(security.Session, security.ProjectSlug)
+	Security(security.ByKey, security.ProjectSlug, func() {
+		Scope("producer")
+	})
+	shared.DeclareErrorResponses()
+
+	Method("startToolCallRecording", func() {
+		Description("Start recording tool calls made against a toolset. Any session already recording the toolset is stopped.")
+
+		Payload(func() {
+			Extend(StartToolCallRecordingForm)
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ToolCallRecordingSession)
+
+		HTTP(func() {
+			POST("/rpc/toolCallRecordings.start")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "startToolCallRecording")
+		Meta("openapi:extension:x-speakeasy-name-override", "start")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "StartToolCallRecording"}`)
+	})
+
+	Method("stopToolCallRecording", func() {
+		Description("Stop a recording session. Recordings already captured are kept.")
+
+		Payload(func() {
+			Attribute("id", String, "The ID of the recording session to stop", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ToolCallRecordingSession)
+
+		HTTP(func() {
+			POST("/rpc/toolCallRecordings.stop")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "stopToolCallRecording")
+		Meta("openapi:extension:x-speakeasy-name-override", "stop")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "StopToolCallRecording"}`)
+	})
+
+	Method("listToolCallRecordingSessions", func() {
+		Description("List the most recent recording sessions for a project. Optionally filter to a single toolset.")
+
+		Payload(func() {
+			Attribute("toolset_slug", shared.Slug, "Optional filter: only return sessions recording this toolset.")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ListToolCallRecordingSessionsResult)
+
+		HTTP(func() {
+			GET("/rpc/toolCallRecordings.listSessions")
+			Param("toolset_slug")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "listToolCallRecordingSessions")
+		Meta("openapi:extension:x-speakeasy-name-override", "listSessions")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "ToolCallRecordingSessions"}`)
+	})
+
+	Method("exportToolCallRecordings", func() {
+		Description("Export the tool calls captured by a recording session, with secrets masked.")
+
+		Payload(func() {
+			Attribute("session_id", String, "The ID of the recording session", func() {
+				Format(FormatUUID)
+			})
+			Required("session_id")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ExportToolCallRecordingsResult)
+
+		HTTP(func() {
+			GET("/rpc/toolCallRecordings.export")
+			Param("session_id")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "exportToolCallRecordings")
+		Meta("openapi:extension:x-speakeasy-name-override", "export")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "ExportToolCallRecordings"}`)
+	})
+
+	Method("replayToolCallRecordings", func() {
+		Description("Replay the tool calls captured by a recording session against a deployment and report how the results differ. Upstream APIs are not contacted: each call is answered from the responses recorded with it.")
+
+		Payload(func() {
+			Attribute("session_id", String, "The ID of the recording session to replay", func() {
+				Format(FormatUUID)
+			})
+			Attribute("deployment_id", String, "The deployment to replay against. Defaults to the latest deployment.", func() {
+				Format(FormatUUID)
+			})
+			Required("session_id")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ReplayToolCallRecordingsResult)
+
+		HTTP(func() {
+			POST("/rpc/toolCallRecordings.replay")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "replayToolCallRecordings")
+		Meta("openapi:extension:x-speakeasy-name-override", "replay")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "ReplayToolCallRecordings"}`)
+	})
+})
+
+var StartToolCallRecordingForm = Type("StartToolCallRecordingForm", func() {
+	Description("Form for starting a tool call recording session.")
+
+	Attribute("toolset_slug", shared.Slug, "The slug of the toolset to record.")
+	Attribute("max_calls", Int32, "Stop recording after this many tool calls.", func() {
+		Minimum(1)
+		Maximum(1000)
+		Default(100)
+	})
+	Attribute("duration_minutes", Int32, "Stop recording after this many minutes.", func() {
+		Minimum(1)
+		Maximum(1440)
+		Default(60)
+	})
+
+	Required("toolset_slug")
+})
+
+var ToolCallRecordingSession = Type("ToolCallRecordingSession", func() {
+	Meta("struct:pkg:path", "types")
+
+	Description("A window during which tool calls on a toolset are recorded.")
+
+	Attribute("id", String, "The ID of the recording session", func() {
+		Format(FormatUUID)
+	})
+	Attribute("project_id", String, "The project ID this session belongs to", func() {
+		Format(FormatUUID)
+	})
+	Attribute("toolset_id", String, "The ID of the recorded toolset", func() {
+		Format(FormatUUID)
+	})
+	Attribute("max_calls", Int32, "The number of tool calls after which recording stops.")
+	Attribute("recorded_calls", Int32, "The number of tool calls recorded so far.")
+	Attribute("active", Boolean, "Whether the session is still recording.")
+	Attribute("expires_at", String, func() {
+		Description("When recording stops if it has not been stopped earlier")
+		Format(FormatDateTime)
+	})
+	Attribute("stopped_at", String, func() {
+		Description("When the session was stopped")
+		Format(FormatDateTime)
+	})
+	Attribute("created_at", String, func() {
+		Description("When the session was started")
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, func() {
+		Description("When the session was last updated")
+		Format(FormatDateTime)
+	})
+
+	Required("id", "project_id", "toolset_id", "max_calls", "recorded_calls", "active", "expires_at", "created_at", "updated_at")
+})
+
+var ListToolCallRecordingSessionsResult = Type("ListToolCallRecordingSessionsResult", func() {
+	Description("Result type for listing tool call recording sessions")
+
+	Attribute("sessions", ArrayOf(ToolCallRecordingSession))
+	Required("sessions")
+})
+
+var ToolCallRecording = Type("ToolCallRecording", func() {
+	Meta("struct:pkg:path", "types")
+
+	Description("A recorded tool call.")
+
+	Attribute("id", String, "The ID of the recording", func() {
+		Format(FormatUUID)
+	})
+	Attribute("tool_urn", String, "The URN of the called tool")
+	Attribute("tool_name", String, "The name of the called tool")
+	Attribute("recording", Any, "The arguments, upstream HTTP exchanges and result of the call, with secrets masked.")
+	Attribute("created_at", String, func() {
+		Description("When the call was recorded")
+		Format(FormatDateTime)
+	})
+
+	Required("id", "tool_urn", "tool_name", "recording", "created_at")
+})
+
+var ExportToolCallRecordingsResult = Type("ExportToolCallRecordingsResult", func() {
+	Description("Result type for exporting the tool calls of a recording session")
+
+	Attribute("session", ToolCallRecordingSession)
+	Attribute("recordings", ArrayOf(ToolCallRecording))
+	Required("session", "recordings")
+})
+
+var ToolCallReplayDifference = Type("ToolCallReplayDifference", func() {
+	Meta("struct:pkg:path", "types")
+
+	Description("One way a replayed tool result differs from its recording.")
+
+	Attribute("kind", String, "status for a changed HTTP status, schema for a changed type or an added or removed field, content for a changed value.", func() {
+		Enum("status", "schema", "content")
+	})
+	Attribute("path", String, "The location of the difference in the result body, in $.a[0].b notation. Absent for status and non-JSON differences.")
+	Attribute("description", String, "A description of the difference")
+
+	Required("kind", "description")
+})
+
+var ToolCallReplay = Type("ToolCallReplay", func() {
+	Meta("struct:pkg:path", "types")
+
+	Description("The outcome of replaying one recorded tool call.")
+
+	Attribute("recording_id", String, "The ID of the replayed recording", func() {
+		Format(FormatUUID)
+	})
+	Attribute("tool_urn", String, "The URN of the called tool")
+	Attribute("tool_name", String, "The name of the called tool")
+	Attribute("status", String, "passed when the result matched the recording, failed when it differed, skipped when the tool cannot be replayed offline and errored when the replay itself failed.", func() {
+		Enum("passed", "failed", "skipped", "errored")
+	})
+	Attribute("reason", String, "Why the call was skipped or errored")
+	Attribute("differences", ArrayOf(ToolCallReplayDifference), "How the replayed result differs from the recording")
+
+	Required("recording_id", "tool_urn", "tool_name", "status", "differences")
+})
+
+var ReplayToolCallRecordingsResult = Type("ReplayToolCallRecordingsResult", func() {
+	Description("Result type for replaying a recording session")
+
+	Attribute("session_id", String, "The ID of the replayed session", func() {
+		Format(FormatUUID)
+	})
+	Attribute("deployment_id", String, "The deployment the calls were replayed against", func() {
+		Format(FormatUUID)
+	})
+	Attribute("passed", Int32, "Number of calls whose result matched the recording")
+	Attribute("failed", Int32, "Number of calls whose result differed from the recording")
+	Attribute("skipped", Int32, "Number of calls that cannot be replayed offline")
+	Attribute("errored", Int32, "Number of calls whose replay failed")
+	Attribute("replays", ArrayOf(ToolCallReplay))
+
+	Required("session_id", "deployment_id", "passed", "failed", "skipped", "errored", "replays")
+})
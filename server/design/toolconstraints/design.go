@@ -0,0 +1,224 @@
+package toolconstraints
+
+import (
+	. "goa.design/goa/v3/dsl"
+
+	"github.com/speakeasy-api/gram/server/design/security"
+	"github.com/speakeasy-api/gram/server/design/shared"
+)
+
+// ToolConstraintEffectEnum applies the allowed-values constraint to an effect
+// attribute. allow admits a call only when the expression is true; deny
+// rejects a call when the expression is true.
+func ToolConstraintEffectEnum() {
+	Enum("allow", "deny")
+}
+
+const expressionDescription = "CEL boolean expression over the call's arguments. Each top-level argument is a variable, and args holds them all as a map, e.g. `amount <= 500`, `repo in [\"docs\", \"web\"]`, `path.matches(\"^docs/\")` or `!has(args.force) || args.force == false`."
+
+var _ = Service("toolConstraints", func() {
+	Description("Manage argument constraints on tool calls attached to toolsets and MCP servers.")
+	Security(security.Session, security.ProjectSlug)
+	Security(security.ByKey, security.ProjectSlug, func() {
+		Scope("producer")
+	})
+	shared.DeclareErrorResponses()
+
+	Method("createToolConstraint", func() {
+		Description("Attach an argument constraint for one tool to a toolset or an MCP server. Provide exactly one of toolset_id or mcp_server_id.")
+
+		Payload(func() {
+			Extend(CreateToolConstraintForm)
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ToolConstraint)
+
+		HTTP(func() {
+			POST("/rpc/toolConstraints.create")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "createToolConstraint")
+		Meta("openapi:extension:x-speakeasy-name-override", "create")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "CreateToolConstraint"}`)
+	})
+
+	Method("listToolConstraints", func() {
+		Description("List argument constraints for a project. Optionally filter to those attached to a specific toolset or MCP server.")
+
+		Payload(func() {
+			Attribute("toolset_id", String, "Optional filter: only return constraints attached to this toolset.", func() {
+				Format(FormatUUID)
+			})
+			Attribute("mcp_server_id", String, "Optional filter: only return constraints attached to this MCP server.", func() {
+				Format(FormatUUID)
+			})
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ListToolConstraintsResult)
+
+		HTTP(func() {
+			GET("/rpc/toolConstraints.list")
+			Param("toolset_id")
+			Param("mcp_server_id")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "listToolConstraints")
+		Meta("openapi:extension:x-speakeasy-name-override", "list")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "ToolConstraints"}`)
+	})
+
+	Method("updateToolConstraint", func() {
+		Description("Update an argument constraint. Omitted fields keep their stored values; the target and tool are fixed at creation.")
+
+		Payload(func() {
+			Extend(UpdateToolConstraintForm)
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ToolConstraint)
+
+		HTTP(func() {
+			POST("/rpc/toolConstraints.update")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "updateToolConstraint")
+		Meta("openapi:extension:x-speakeasy-name-override", "update")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "UpdateToolConstraint"}`)
+	})
+
+	Method("deleteToolConstraint", func() {
+		Description("Delete an argument constraint.")
+
+		Payload(func() {
+			Attribute("id", String, "The ID of the constraint to delete", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		HTTP(func() {
+			DELETE("/rpc/toolConstraints.delete")
+			Param("id")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "deleteToolConstraint")
+		Meta("openapi:extension:x-speakeasy-name-override", "delete")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "DeleteToolConstraint"}`)
+	})
+})
+
+var CreateToolConstraintForm = Type("CreateToolConstraintForm", func() {
+	Description("Form for attaching an argument constraint. Provide exactly one of toolset_id or mcp_server_id.")
+
+	Attribute("toolset_id", String, "The ID of the toolset the constraint applies to. Mutually exclusive with mcp_server_id.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("mcp_server_id", String, "The ID of the MCP server the constraint applies to. Mutually exclusive with toolset_id.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("tool_name", String, "The tool whose arguments the constraint is evaluated against.", func() {
+		MinLength(1)
+		MaxLength(128)
+	})
+	Attribute("effect", String, "allow admits a call only when the expression is true; deny rejects a call when it is true.", func() {
+		ToolConstraintEffectEnum()
+	})
+	Attribute("expression", String, expressionDescription, func() {
+		MinLength(1)
+		MaxLength(4096)
+	})
+	Attribute("message", String, "Explanation returned to the model when the constraint rejects a call.", func() {
+		MaxLength(1000)
+	})
+
+	Required("tool_name", "effect", "expression")
+})
+
+var UpdateToolConstraintForm = Type("UpdateToolConstraintForm", func() {
+	Description("Form for updating an argument constraint.")
+
+	Attribute("id", String, "The ID of the constraint to update", func() {
+		Format(FormatUUID)
+	})
+	Attribute("effect", String, "allow admits a call only when the expression is true; deny rejects a call when it is true.", func() {
+		ToolConstraintEffectEnum()
+	})
+	Attribute("expression", String, expressionDescription, func() {
+		MinLength(1)
+		MaxLength(4096)
+	})
+	Attribute("message", String, "Explanation returned to the model when the constraint rejects a call. An empty string clears it.", func() {
+		MaxLength(1000)
+	})
+
+	Required("id")
+})
+
+var ToolConstraint = Type("ToolConstraint", func() {
+	Meta("struct:pkg:path", "types")
+
+	Description("A CEL constraint on the arguments of one tool, checked before tools/call requests against a toolset or an MCP server are proxied. Exactly one of toolset_id and mcp_server_id is set.")
+
+	Attribute("id", String, "The ID of the constraint", func() {
+		Format(FormatUUID)
+	})
+	Attribute("project_id", String, "The project ID this constraint belongs to", func() {
+		Format(FormatUUID)
+	})
+	Attribute("toolset_id", String, "The ID of the toolset the constraint applies to. Null for MCP-server constraints.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("mcp_server_id", String, "The ID of the MCP server the constraint applies to. Null for toolset constraints.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("tool_name", String, "The tool whose arguments the constraint is evaluated against.")
+	Attribute("effect", String, "allow admits a call only when the expression is true; deny rejects a call when it is true.", func() {
+		ToolConstraintEffectEnum()
+	})
+	Attribute("expression", String, expressionDescription)
+	Attribute("message", String, "Explanation returned to the model when the constraint rejects a call.")
+	Attribute("created_at", String, func() {
+		Description("When the constraint was created")
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, func() {
+		Description("When the constraint was last updated")
+		Format(FormatDateTime)
+	})
+
+	Required("id", "project_id", "tool_name", "effect", "expression", "created_at", "updated_at")
+})
+
+var ListToolConstraintsResult = Type("ListToolConstraintsResult", func() {
+	Description("Result type for listing argument constraints")
+
+	Attribute("constraints", ArrayOf(ToolConstraint))
+	Required("constraints")
+})
@@ -0,0 +1,227 @@
+package toolratelimits
+
+import (
+	. "goa.design/goa/v3/dsl"
+
+	"github.com/speakeasy-api/gram/server/design/security"
+	"github.com/speakeasy-api/gram/server/design/shared"
+)
+
+// ToolRateLimitSubjectEnum applies the allowed-values constraint to a subject
+// attribute. end_user gives every authenticated caller their own bucket,
+// api_key gives every Gram API key its own bucket, and all pools every caller
+// of the target into one bucket.
+func ToolRateLimitSubjectEnum() {
+	Enum("end_user", "api_key", "all")
+}
+
+var _ = Service("toolRateLimits", func() {
+	Description("Manage tool-call rate limits attached to toolsets and MCP servers.")
+	Security(security.Session, security.ProjectSlug)
+	Security(security.ByKey, security.ProjectSlug, func() {
+		Scope("producer")
+	})
+	shared.DeclareErrorResponses()
+
+	Method("createToolRateLimit", func() {
+		Description("Attach a tool-call rate limit to a toolset or an MCP server. Provide exactly one of toolset_id or mcp_server_id.")
+
+		Payload(func() {
+			Extend(CreateToolRateLimitForm)
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ToolRateLimit)
+
+		HTTP(func() {
+			POST("/rpc/toolRateLimits.create")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "createToolRateLimit")
+		Meta("openapi:extension:x-speakeasy-name-override", "create")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "CreateToolRateLimit"}`)
+	})
+
+	Method("listToolRateLimits", func() {
+		Description("List tool-call rate limits for a project. Optionally filter to those attached to a specific toolset or MCP server.")
+
+		Payload(func() {
+			Attribute("toolset_id", String, "Optional filter: only return limits attached to this toolset.", func() {
+				Format(FormatUUID)
+			})
+			Attribute("mcp_server_id", String, "Optional filter: only return limits attached to this MCP server.", func() {
+				Format(FormatUUID)
+			})
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ListToolRateLimitsResult)
+
+		HTTP(func() {
+			GET("/rpc/toolRateLimits.list")
+			Param("toolset_id")
+			Param("mcp_server_id")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "listToolRateLimits")
+		Meta("openapi:extension:x-speakeasy-name-override", "list")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "ToolRateLimits"}`)
+	})
+
+	Method("updateToolRateLimit", func() {
+		Description("Update the rate of a tool-call rate limit. Omitted fields keep their stored values; the target, subject and tool are fixed at creation.")
+
+		Payload(func() {
+			Extend(UpdateToolRateLimitForm)
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ToolRateLimit)
+
+		HTTP(func() {
+			POST("/rpc/toolRateLimits.update")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "updateToolRateLimit")
+		Meta("openapi:extension:x-speakeasy-name-override", "update")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "UpdateToolRateLimit"}`)
+	})
+
+	Method("deleteToolRateLimit", func() {
+		Description("Delete a tool-call rate limit.")
+
+		Payload(func() {
+			Attribute("id", String, "The ID of the rate limit to delete", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		HTTP(func() {
+			DELETE("/rpc/toolRateLimits.delete")
+			Param("id")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "deleteToolRateLimit")
+		Meta("openapi:extension:x-speakeasy-name-override", "delete")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "DeleteToolRateLimit"}`)
+	})
+})
+
+var CreateToolRateLimitForm = Type("CreateToolRateLimitForm", func() {
+	Description("Form for attaching a tool-call rate limit. Provide exactly one of toolset_id or mcp_server_id.")
+
+	Attribute("toolset_id", String, "The ID of the toolset the limit applies to. Mutually exclusive with mcp_server_id.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("mcp_server_id", String, "The ID of the MCP server the limit applies to. Mutually exclusive with toolset_id.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("subject", String, "Who shares a bucket: each end user, each API key, or all callers together.", func() {
+		ToolRateLimitSubjectEnum()
+	})
+	Attribute("tool_name", String, "Restrict the limit to a single tool. Omit to apply it to every tool on the target.", func() {
+		MinLength(1)
+		MaxLength(128)
+	})
+	Attribute("requests", Int32, "Requests allowed per interval.", func() {
+		Minimum(1)
+	})
+	Attribute("interval_seconds", Int32, "Length of the refill interval in seconds.", func() {
+		Minimum(1)
+		Maximum(86400)
+	})
+	Attribute("burst", Int32, "Maximum requests a momentarily idle caller may make at once. Defaults to requests.", func() {
+		Minimum(1)
+	})
+
+	Required("subject", "requests", "interval_seconds")
+})
+
+var UpdateToolRateLimitForm = Type("UpdateToolRateLimitForm", func() {
+	Description("Form for updating the rate of a tool-call rate limit.")
+
+	Attribute("id", String, "The ID of the rate limit to update", func() {
+		Format(FormatUUID)
+	})
+	Attribute("requests", Int32, "Requests allowed per interval.", func() {
+		Minimum(1)
+	})
+	Attribute("interval_seconds", Int32, "Length of the refill interval in seconds.", func() {
+		Minimum(1)
+		Maximum(86400)
+	})
+	Attribute("burst", Int32, "Maximum requests a momentarily idle caller may make at once.", func() {
+		Minimum(1)
+	})
+
+	Required("id")
+})
+
+var ToolRateLimit = Type("ToolRateLimit", func() {
+	Meta("struct:pkg:path", "types")
+
+	Description("A token-bucket quota on tools/call requests against a toolset or an MCP server. Exactly one of toolset_id and mcp_server_id is set.")
+
+	Attribute("id", String, "The ID of the rate limit", func() {
+		Format(FormatUUID)
+	})
+	Attribute("project_id", String, "The project ID this rate limit belongs to", func() {
+		Format(FormatUUID)
+	})
+	Attribute("toolset_id", String, "The ID of the toolset the limit applies to. Null for MCP-server limits.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("mcp_server_id", String, "The ID of the MCP server the limit applies to. Null for toolset limits.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("subject", String, "Who shares a bucket: each end user, each API key, or all callers together.", func() {
+		ToolRateLimitSubjectEnum()
+	})
+	Attribute("tool_name", String, "The single tool the limit applies to. Null when it applies to every tool on the target.")
+	Attribute("requests", Int32, "Requests allowed per interval.")
+	Attribute("interval_seconds", Int32, "Length of the refill interval in seconds.")
+	Attribute("burst", Int32, "Maximum requests a momentarily idle caller may make at once.")
+	Attribute("created_at", String, func() {
+		Description("When the rate limit was created")
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, func() {
+		Description("When the rate limit was last updated")
+		Format(FormatDateTime)
+	})
+
+	Required("id", "project_id", "subject", "requests", "interval_seconds", "burst", "created_at", "updated_at")
+})
+
+var ListToolRateLimitsResult = Type("ListToolRateLimitsResult", func() {
+	Description("Result type for listing tool-call rate limits")
+
+	Attribute("rate_limits", ArrayOf(ToolRateLimit))
+	Required("rate_limits")
+})
@@ -350,6 +350,66 @@ var _ = Service("toolsets", func() {
 		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "SetToolsetToolVariationsGroup"}`)
 	})
 
+	Method("diffToolsetVersions", func() {
+		Description("Compare the tools and resources of two versions of a toolset. Versions are recorded on every change to the toolset's tools or resources and never change afterwards.")
+
+		Payload(func() {
+			Required("slug", "from_version", "to_version")
+			Attribute("slug", shared.Slug, "The slug of the toolset")
+			Attribute("from_version", Int64, "The version to compare from", func() {
+				Minimum(1)
+			})
+			Attribute("to_version", Int64, "The version to compare to", func() {
+				Minimum(1)
+			})
+			security.SessionPayload()
+			security.ByKeyPayload()
+			security.ProjectPayload()
+		})
+
+		Result(ToolsetVersionDiff)
+
+		HTTP(func() {
+			GET("/rpc/toolsets.diffVersions")
+			Param("slug")
+			Param("from_version")
+			Param("to_version")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "diffToolsetVersions")
+		Meta("openapi:extension:x-speakeasy-name-override", "diffVersions")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "ToolsetVersionDiff"}`)
+	})
+
+	Method("revertToolset", func() {
+		Description("Revert a toolset's tools and resources to those of an earlier version. The revert is recorded as a new version, so MCP servers pinned to other versions are unaffected.")
+
+		Payload(func() {
+			Extend(RevertToolsetForm)
+			security.SessionPayload()
+			security.ByKeyPayload()
+		})
+
+		Result(shared.Toolset)
+
+		HTTP(func() {
+			Param("slug")
+			POST("/rpc/toolsets.revert")
+			security.SessionHeader()
+			security.ByKeyHeader()
+			security.ProjectHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "revertToolset")
+		Meta("openapi:extension:x-speakeasy-name-override", "revertBySlug")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "RevertToolset"}`)
+	})
+
 })
 
 var CreateToolsetForm = Type("CreateToolsetForm", func() {
@@ -415,6 +475,28 @@ var SetToolVariationsGroupForm = Type("SetToolVariationsGroupForm", func() {
 	Required("slug")
 })
 
+var RevertToolsetForm = Type("RevertToolsetForm", func() {
+	Attribute("slug", shared.Slug, "The slug of the toolset to revert")
+	Attribute("version", Int64, "The version whose tools and resources become the toolset's new latest version", func() {
+		Minimum(1)
+	})
+	security.ProjectPayload()
+	Required("slug", "version")
+})
+
+var ToolsetVersionDiff = Type("ToolsetVersionDiff", func() {
+	Description("The tools and resources added and removed between two versions of a toolset.")
+
+	Attribute("from_version", Int64, "The version compared from")
+	Attribute("to_version", Int64, "The version compared to")
+	Attribute("added_tool_urns", ArrayOf(String), "Tool URNs in to_version but not in from_version")
+	Attribute("removed_tool_urns", ArrayOf(String), "Tool URNs in from_version but not in to_version")
+	Attribute("added_resource_urns", ArrayOf(String), "Resource URNs in to_version but not in from_version")
+	Attribute("removed_resource_urns", ArrayOf(String), "Resource URNs in from_version but not in to_version")
+
+	Required("from_version", "to_version", "added_tool_urns", "removed_tool_urns", "added_resource_urns", "removed_resource_urns")
+})
+
 var UpdateSecurityVariableDisplayNameForm = Type("UpdateSecurityVariableDisplayNameForm", func() {
 	Attribute("toolset_slug", shared.Slug, "The slug of the toolset containing the security variable")
 	Attribute("security_key", String, func() {
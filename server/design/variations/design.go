@@ -158,6 +158,12 @@ var UpsertGlobalToolVariationForm = Type("UpsertGlobalToolVariationForm", func()
 	Attribute("destructive_hint", Boolean, "Override: if true, the tool may perform destructive updates")
 	Attribute("idempotent_hint", Boolean, "Override: if true, repeated calls have no additional effect")
 	Attribute("open_world_hint", Boolean, "Override: if true, the tool interacts with external entities")
+	Attribute("cache_ttl_seconds", Int32, "How long the gateway caches responses of this tool. Only applies to read-only or idempotent tools; unset disables caching.", func() {
+		Minimum(1)
+		Maximum(86400)
+	})
+	Attribute("cache_shared", Boolean, "If true, cached responses are shared between callers with identical environments instead of being kept per caller")
+	Attribute("argument_bindings", ArrayOf(shared.ToolArgumentBinding), "Arguments filled server-side on every call and hidden from the tool's input schema. Bound values cannot be chosen by the model.")
 })
 
 var UpsertGlobalToolVariationResult = Type("UpsertGlobalToolVariationResult", func() {
@@ -0,0 +1,185 @@
+package webhookendpoints
+
+import (
+	. "goa.design/goa/v3/dsl"
+
+	"github.com/speakeasy-api/gram/server/design/security"
+	"github.com/speakeasy-api/gram/server/design/shared"
+)
+
+// The webhookEndpoints service manages endpoints for the self-hosted webhook
+// delivery backend, the alternative to Svix selected with
+// --webhook-delivery-backend. On Svix-backed installs endpoints are managed in
+// the Svix app portal instead and these registrations receive nothing.
+var _ = Service("webhookEndpoints", func() {
+	Description("Register organization webhook endpoints for the self-hosted delivery backend and inspect their delivery log.")
+	Security(security.Session)
+	shared.DeclareErrorResponses()
+
+	Method("createEndpoint", func() {
+		Description("Register a webhook endpoint. The response carries the endpoint's signing secret, which is not retrievable afterwards. Requires org:admin.")
+
+		Payload(func() {
+			Attribute("url", String, "The URL to POST deliveries to.", func() {
+				MaxLength(2048)
+			})
+			Attribute("description", String, "A human-readable description.", func() {
+				MaxLength(500)
+			})
+			Attribute("event_types", ArrayOf(String), "The event types to receive. Omit or leave empty to receive every event type.")
+			Required("url")
+			security.SessionPayload()
+		})
+
+		Result(CreateWebhookEndpointResult)
+
+		HTTP(func() {
+			POST("/rpc/webhookEndpoints.create")
+			security.SessionHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "createWebhookEndpoint")
+		Meta("openapi:extension:x-speakeasy-name-override", "create")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "CreateWebhookEndpoint"}`)
+	})
+
+	Method("listEndpoints", func() {
+		Description("List the organization's webhook endpoints. Requires org:read.")
+
+		Payload(func() {
+			security.SessionPayload()
+		})
+
+		Result(ListWebhookEndpointsResult)
+
+		HTTP(func() {
+			GET("/rpc/webhookEndpoints.list")
+			security.SessionHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "listWebhookEndpoints")
+		Meta("openapi:extension:x-speakeasy-name-override", "list")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "ListWebhookEndpoints"}`)
+	})
+
+	Method("updateEndpoint", func() {
+		Description("Update a webhook endpoint. Omitted fields are left unchanged; pass an empty event_types list to receive every event type. Requires org:admin.")
+
+		Payload(func() {
+			Attribute("id", String, "The ID of the endpoint to update.", func() {
+				Format(FormatUUID)
+			})
+			Attribute("url", String, "The URL to POST deliveries to.", func() {
+				MaxLength(2048)
+			})
+			Attribute("description", String, "A human-readable description.", func() {
+				MaxLength(500)
+			})
+			Attribute("event_types", ArrayOf(String), "The event types to receive.")
+			Attribute("disabled", Boolean, "Pause or resume deliveries.")
+			Required("id")
+			security.SessionPayload()
+			Meta("openapi:typename", "UpdateWebhookEndpointRequestBody")
+		})
+
+		Result(WebhookEndpoint)
+
+		HTTP(func() {
+			POST("/rpc/webhookEndpoints.update")
+			security.SessionHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "updateWebhookEndpoint")
+		Meta("openapi:extension:x-speakeasy-name-override", "update")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "UpdateWebhookEndpoint"}`)
+	})
+
+	Method("deleteEndpoint", func() {
+		Description("Delete a webhook endpoint. Deliveries still pending for it are abandoned. Requires org:admin.")
+
+		Payload(func() {
+			Attribute("id", String, "The ID of the endpoint to delete.", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+			security.SessionPayload()
+		})
+
+		HTTP(func() {
+			DELETE("/rpc/webhookEndpoints.delete")
+			Param("id")
+			security.SessionHeader()
+			Response(StatusNoContent)
+		})
+
+		Meta("openapi:operationId", "deleteWebhookEndpoint")
+		Meta("openapi:extension:x-speakeasy-name-override", "delete")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "DeleteWebhookEndpoint"}`)
+	})
+
+	Method("listDeliveries", func() {
+		Description("List an endpoint's deliveries, newest first, each with its attempt log. Requires org:read.")
+
+		Payload(func() {
+			Attribute("endpoint_id", String, "The ID of the endpoint.", func() {
+				Format(FormatUUID)
+			})
+			Attribute("delivery_status", String, "Only return deliveries in this state.", func() {
+				Enum("pending", "succeeded", "dead_lettered")
+			})
+			Attribute("cursor", String, "The next_cursor from a previous page.", func() {
+				Format(FormatUUID)
+			})
+			Attribute("limit", Int32, "The page size.", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(50)
+			})
+			Required("endpoint_id")
+			security.SessionPayload()
+		})
+
+		Result(ListWebhookDeliveriesResult)
+
+		HTTP(func() {
+			GET("/rpc/webhookEndpoints.listDeliveries")
+			Param("endpoint_id")
+			Param("delivery_status")
+			Param("cursor")
+			Param("limit")
+			security.SessionHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "listWebhookDeliveries")
+		Meta("openapi:extension:x-speakeasy-name-override", "listDeliveries")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "ListWebhookDeliveries"}`)
+	})
+
+	Method("redeliver", func() {
+		Description("Queue one more attempt for a delivery that succeeded or was dead-lettered. The attempt count carries on, so a failed redelivery returns to dead_lettered rather than restarting the retry schedule. Requires org:admin.")
+
+		Payload(func() {
+			Attribute("id", String, "The ID of the delivery.", func() {
+				Format(FormatUUID)
+			})
+			Required("id")
+			security.SessionPayload()
+		})
+
+		Result(WebhookDelivery)
+
+		HTTP(func() {
+			POST("/rpc/webhookEndpoints.redeliver")
+			security.SessionHeader()
+			Response(StatusOK)
+		})
+
+		Meta("openapi:operationId", "redeliverWebhook")
+		Meta("openapi:extension:x-speakeasy-name-override", "redeliver")
+		Meta("openapi:extension:x-speakeasy-react-hook", `{"name": "RedeliverWebhook"}`)
+	})
+})
@@ -0,0 +1,99 @@
+package webhookendpoints
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+// WebhookEndpoint is an organization's registration to receive webhooks from
+// the self-hosted delivery backend. The signing secret is deliberately absent:
+// it is returned once, on creation.
+var WebhookEndpoint = Type("WebhookEndpoint", func() {
+	Description("A URL registered to receive the organization's webhook events.")
+
+	Attribute("id", String, "The ID of the endpoint.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("organization_id", String, "The organization that owns the endpoint.")
+	Attribute("url", String, "The URL webhook deliveries are POSTed to.")
+	Attribute("description", String, "A human-readable description of the endpoint.")
+	Attribute("event_types", ArrayOf(String), "The event types the endpoint receives. Empty receives every event type.")
+	Attribute("disabled", Boolean, "Whether the endpoint is paused. A disabled endpoint is not sent new events; deliveries already queued for it resume when it is re-enabled.")
+	Attribute("created_at", String, func() {
+		Description("When the endpoint was created.")
+		Format(FormatDateTime)
+	})
+	Attribute("updated_at", String, func() {
+		Description("When the endpoint was last updated.")
+		Format(FormatDateTime)
+	})
+
+	Required("id", "organization_id", "url", "event_types", "disabled", "created_at", "updated_at")
+})
+
+var CreateWebhookEndpointResult = Type("CreateWebhookEndpointResult", func() {
+	Description("A newly registered endpoint and its signing secret.")
+
+	Attribute("endpoint", WebhookEndpoint, "The registered endpoint.")
+	Attribute("secret", String, "The Standard Webhooks signing secret (whsec_...). Returned only once; store it to verify the webhook-signature header on deliveries.")
+
+	Required("endpoint", "secret")
+})
+
+var ListWebhookEndpointsResult = Type("ListWebhookEndpointsResult", func() {
+	Attribute("endpoints", ArrayOf(WebhookEndpoint), "The organization's webhook endpoints, newest first.")
+	Required("endpoints")
+})
+
+// WebhookDeliveryAttempt is one row of the delivery-attempt log.
+var WebhookDeliveryAttempt = Type("WebhookDeliveryAttempt", func() {
+	Description("A single attempt to deliver a webhook.")
+
+	Attribute("attempt", Int32, "The 1-based attempt number.")
+	Attribute("response_status", Int32, "The HTTP status the endpoint responded with. Absent when no response was received.")
+	Attribute("error", String, "Why the attempt failed. Absent on success.")
+	Attribute("duration_ms", Int32, "How long the attempt took, in milliseconds.")
+	Attribute("attempted_at", String, func() {
+		Description("When the attempt was made.")
+		Format(FormatDateTime)
+	})
+
+	Required("attempt", "duration_ms", "attempted_at")
+})
+
+var WebhookDelivery = Type("WebhookDelivery", func() {
+	Description("One event queued for one endpoint, with its attempt log.")
+
+	Attribute("id", String, "The ID of the delivery.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("endpoint_id", String, "The endpoint the delivery targets.", func() {
+		Format(FormatUUID)
+	})
+	Attribute("event_id", String, "The event ID, sent as the webhook-id header. Stable across attempts.")
+	Attribute("event_type", String, "The event type.")
+	Attribute("delivery_status", String, "pending: waiting for its next attempt. succeeded: the endpoint accepted it with a 2xx. dead_lettered: every attempt failed; it is not retried again unless redelivered.", func() {
+		Enum("pending", "succeeded", "dead_lettered")
+	})
+	Attribute("attempts", Int32, "How many attempts have been made.")
+	Attribute("next_attempt_at", String, func() {
+		Description("When the next attempt is due, for pending deliveries.")
+		Format(FormatDateTime)
+	})
+	Attribute("last_attempt_at", String, func() {
+		Description("When the most recent attempt was made.")
+		Format(FormatDateTime)
+	})
+	Attribute("created_at", String, func() {
+		Description("When the delivery was queued.")
+		Format(FormatDateTime)
+	})
+	Attribute("attempt_log", ArrayOf(WebhookDeliveryAttempt), "Every attempt made so far, oldest first.")
+
+	Required("id", "endpoint_id", "event_id", "event_type", "delivery_status", "attempts", "created_at", "attempt_log")
+})
+
+var ListWebhookDeliveriesResult = Type("ListWebhookDeliveriesResult", func() {
+	Attribute("deliveries", ArrayOf(WebhookDelivery), "Deliveries to the endpoint, newest first.")
+	Attribute("next_cursor", String, "Pass as cursor to fetch the next page. Absent on the last page.")
+	Required("deliveries")
+})
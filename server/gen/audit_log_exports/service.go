@@ -0,0 +1,159 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// auditLogExports service
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package auditlogexports
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Manage per-organization streaming export of audit logs to object storage.
+type Service interface {
+	// Get the organization's audit log export config. Returns an empty config
+	// (enabled=false) when none is set.
+	GetConfig(context.Context, *GetConfigPayload) (res *AuditLogExportConfig, err error)
+	// Create or update the organization's audit log export config. A new export
+	// starts after the latest audit event unless resume_after_seq is given.
+	UpsertConfig(context.Context, *UpsertConfigPayload) (res *AuditLogExportConfig, err error)
+	// Delete the organization's audit log export config. Objects already exported
+	// are left in place.
+	DeleteConfig(context.Context, *DeleteConfigPayload) (err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// APIKeyAuth implements the authorization logic for the APIKey security scheme.
+	APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "gram"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "auditLogExports"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [3]string{"getConfig", "upsertConfig", "deleteConfig"}
+
+// AuditLogExportConfig is the result type of the auditLogExports service
+// getConfig method.
+type AuditLogExportConfig struct {
+	// Config ID. Omitted when no config is set for the organization.
+	ID *string
+	// Organization the config belongs to.
+	OrganizationID string
+	// Whether the export is currently active.
+	Enabled bool
+	// How events are encoded into exported objects.
+	Format string
+	// Key prefix objects are written under inside the bucket.
+	Prefix string
+	// Sequence number of the last audit event exported. The next run resumes after
+	// it.
+	CursorSeq int64
+	// When an object was last written. Omitted before the first export.
+	LastExportedAt *string
+	// Key of the most recently written object.
+	LastObjectKey *string
+	// Error from the most recent failed run. Cleared by the next successful export.
+	LastError *string
+	// When the config was created. Omitted when no config is set.
+	CreatedAt *string
+	// When the config was last changed. Omitted when no config is set.
+	UpdatedAt *string
+}
+
+// DeleteConfigPayload is the payload type of the auditLogExports service
+// deleteConfig method.
+type DeleteConfigPayload struct {
+	ApikeyToken  *string
+	SessionToken *string
+}
+
+// GetConfigPayload is the payload type of the auditLogExports service
+// getConfig method.
+type GetConfigPayload struct {
+	ApikeyToken  *string
+	SessionToken *string
+}
+
+// UpsertConfigPayload is the payload type of the auditLogExports service
+// upsertConfig method.
+type UpsertConfigPayload struct {
+	ApikeyToken  *string
+	SessionToken *string
+	// Whether the export should be active.
+	Enabled bool
+	// How events are encoded into exported objects.
+	Format string
+	// Key prefix to write objects under. Slash-separated segments of letters,
+	// digits, '.', '_', '=' and '-'.
+	Prefix *string
+	// Move the export cursor: the next run exports events with a greater sequence
+	// number. Use 0 to export the full history. Omit to keep the current cursor.
+	ResumeAfterSeq *int64
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeBadRequest builds a goa.ServiceError from an error.
+func MakeBadRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "bad_request", false, false, false)
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeConflict builds a goa.ServiceError from an error.
+func MakeConflict(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "conflict", false, false, false)
+}
+
+// MakeUnsupportedMedia builds a goa.ServiceError from an error.
+func MakeUnsupportedMedia(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unsupported_media", false, false, false)
+}
+
+// MakeInvalid builds a goa.ServiceError from an error.
+func MakeInvalid(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid", false, false, false)
+}
+
+// MakeInvariantViolation builds a goa.ServiceError from an error.
+func MakeInvariantViolation(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invariant_violation", false, false, true)
+}
+
+// MakeUnexpected builds a goa.ServiceError from an error.
+func MakeUnexpected(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unexpected", false, false, true)
+}
+
+// MakeGatewayError builds a goa.ServiceError from an error.
+func MakeGatewayError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "gateway_error", false, false, true)
+}
@@ -23,10 +23,11 @@ type Client struct {
 	RedeployEndpoint            goa.Endpoint
 	ListDeploymentsEndpoint     goa.Endpoint
 	GetDeploymentLogsEndpoint   goa.Endpoint
+	DiffDeploymentsEndpoint     goa.Endpoint
 }
 
 // NewClient initializes a "deployments" service client given the endpoints.
-func NewClient(getDeployment, getLatestDeployment, getActiveDeployment, createDeployment, evolve, redeploy, listDeployments, getDeploymentLogs goa.Endpoint) *Client {
+func NewClient(getDeployment, getLatestDeployment, getActiveDeployment, createDeployment, evolve, redeploy, listDeployments, getDeploymentLogs, diffDeployments goa.Endpoint) *Client {
 	return &Client{
 		GetDeploymentEndpoint:       getDeployment,
 		GetLatestDeploymentEndpoint: getLatestDeployment,
@@ -36,6 +37,7 @@ func NewClient(getDeployment, getLatestDeployment, getActiveDeployment, createDe
 		RedeployEndpoint:            redeploy,
 		ListDeploymentsEndpoint:     listDeployments,
 		GetDeploymentLogsEndpoint:   getDeploymentLogs,
+		DiffDeploymentsEndpoint:     diffDeployments,
 	}
 }
 
@@ -220,3 +222,26 @@ func (c *Client) GetDeploymentLogs(ctx context.Context, p *GetDeploymentLogsPayl
 	}
 	return ires.(*GetDeploymentLogsResult), nil
 }
+
+// DiffDeployments calls the "diffDeployments" endpoint of the "deployments"
+// service.
+// DiffDeployments may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) DiffDeployments(ctx context.Context, p *DiffDeploymentsPayload) (res *DiffDeploymentsResult, err error) {
+	var ires any
+	ires, err = c.DiffDeploymentsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DiffDeploymentsResult), nil
+}
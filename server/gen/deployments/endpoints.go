@@ -24,6 +24,7 @@ type Endpoints struct {
 	Redeploy            goa.Endpoint
 	ListDeployments     goa.Endpoint
 	GetDeploymentLogs   goa.Endpoint
+	DiffDeployments     goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "deployments" service with endpoints.
@@ -39,6 +40,7 @@ func NewEndpoints(s Service) *Endpoints {
 		Redeploy:            NewRedeployEndpoint(s, a.APIKeyAuth),
 		ListDeployments:     NewListDeploymentsEndpoint(s, a.APIKeyAuth),
 		GetDeploymentLogs:   NewGetDeploymentLogsEndpoint(s, a.APIKeyAuth),
+		DiffDeployments:     NewDiffDeploymentsEndpoint(s, a.APIKeyAuth),
 	}
 }
 
@@ -52,6 +54,7 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Redeploy = m(e.Redeploy)
 	e.ListDeployments = m(e.ListDeployments)
 	e.GetDeploymentLogs = m(e.GetDeploymentLogs)
+	e.DiffDeployments = m(e.DiffDeployments)
 }
 
 // NewGetDeploymentEndpoint returns an endpoint function that calls the method
@@ -525,3 +528,62 @@ func NewGetDeploymentLogsEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFun
 		return s.GetDeploymentLogs(ctx, p)
 	}
 }
+
+// NewDiffDeploymentsEndpoint returns an endpoint function that calls the
+// method "diffDeployments" of service "deployments".
+func NewDiffDeploymentsEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DiffDeploymentsPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "apikey",
+			Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+			RequiredScopes: []string{"producer"},
+		}
+		var key string
+		if p.ApikeyToken != nil {
+			key = *p.ApikeyToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err == nil {
+			sc := security.APIKeyScheme{
+				Name:           "project_slug",
+				Scopes:         []string{},
+				RequiredScopes: []string{"producer"},
+			}
+			var key string
+			if p.ProjectSlugInput != nil {
+				key = *p.ProjectSlugInput
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "session",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.SessionToken != nil {
+				key = *p.SessionToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+			if err == nil {
+				sc := security.APIKeyScheme{
+					Name:           "project_slug",
+					Scopes:         []string{},
+					RequiredScopes: []string{},
+				}
+				var key string
+				if p.ProjectSlugInput != nil {
+					key = *p.ProjectSlugInput
+				}
+				ctx, err = authAPIKeyFn(ctx, key, &sc)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.DiffDeployments(ctx, p)
+	}
+}
@@ -33,6 +33,9 @@ type Service interface {
 	ListDeployments(context.Context, *ListDeploymentsPayload) (res *ListDeploymentResult, err error)
 	// Get logs for a deployment.
 	GetDeploymentLogs(context.Context, *GetDeploymentLogsPayload) (res *GetDeploymentLogsResult, err error)
+	// Compare the tools of two deployments and classify each change as additive or
+	// breaking.
+	DiffDeployments(context.Context, *DiffDeploymentsPayload) (res *DiffDeploymentsResult, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -55,7 +58,7 @@ const ServiceName = "deployments"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [8]string{"getDeployment", "getLatestDeployment", "getActiveDeployment", "createDeployment", "evolve", "redeploy", "listDeployments", "getDeploymentLogs"}
+var MethodNames = [9]string{"getDeployment", "getLatestDeployment", "getActiveDeployment", "createDeployment", "evolve", "redeploy", "listDeployments", "getDeploymentLogs", "diffDeployments"}
 
 type AddDeploymentPackageForm struct {
 	// The name of the package.
@@ -128,6 +131,9 @@ type CreateDeploymentPayload struct {
 	// request will return immediately and the deployment will proceed
 	// asynchronously.
 	NonBlocking *bool
+	// If true, the processed deployment is compared with the active deployment and
+	// fails instead of becoming active when it introduces breaking changes.
+	FailOnBreaking *bool
 	// The github repository in the form of "owner/repo".
 	GithubRepo *string
 	// The github pull request that resulted in the deployment.
@@ -153,6 +159,21 @@ type CreateDeploymentResult struct {
 	Deployment *types.Deployment
 }
 
+type DeploymentDiffChange struct {
+	// The URN of the changed tool
+	ToolUrn string
+	// The name of the changed tool
+	ToolName string
+	// The aspect of the tool that changed
+	Kind string
+	// Whether the change can break existing callers
+	Severity string
+	// The location of an input schema change, e.g. body.status or ids[]
+	Path *string
+	// A human readable description of the change
+	Description string
+}
+
 type DeploymentLogEvent struct {
 	// The ID of the log event
 	ID string
@@ -191,6 +212,39 @@ type DeploymentSummary struct {
 	ExternalMcpToolCount int64
 }
 
+// DiffDeploymentsPayload is the payload type of the deployments service
+// diffDeployments method.
+type DiffDeploymentsPayload struct {
+	ApikeyToken      *string
+	SessionToken     *string
+	ProjectSlugInput *string
+	// The ID of the deployment to compare from, typically the active one
+	FromID string
+	// The ID of the deployment to compare to, typically the latest one
+	ToID string
+}
+
+// DiffDeploymentsResult is the result type of the deployments service
+// diffDeployments method.
+type DiffDeploymentsResult struct {
+	// The ID of the deployment compared from
+	FromDeploymentID string
+	// The ID of the deployment compared to
+	ToDeploymentID string
+	// Whether any change can break existing callers
+	HasBreakingChanges bool
+	// The number of tools only in the newer deployment
+	ToolsAdded int
+	// The number of tools only in the older deployment
+	ToolsRemoved int
+	// The number of tools in both deployments with at least one change
+	ToolsChanged int
+	// The number of breaking changes
+	BreakingChanges int
+	// The changes ordered by tool URN
+	Changes []*DeploymentDiffChange
+}
+
 // EvolvePayload is the payload type of the deployments service evolve method.
 type EvolvePayload struct {
 	ApikeyToken      *string
@@ -203,6 +257,9 @@ type EvolvePayload struct {
 	// request will return immediately and the deployment will proceed
 	// asynchronously.
 	NonBlocking *bool
+	// If true, the processed deployment is compared with the active deployment and
+	// fails instead of becoming active when it introduces breaking changes.
+	FailOnBreaking *bool
 	// The OpenAPI 3.x documents to upsert in the new deployment.
 	UpsertOpenapiv3Assets []*AddOpenAPIv3DeploymentAssetForm
 	// The packages to upsert in the new deployment.
@@ -0,0 +1,112 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// auditLogExports HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	auditlogexports "github.com/speakeasy-api/gram/server/gen/audit_log_exports"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildGetConfigPayload builds the payload for the auditLogExports getConfig
+// endpoint from CLI flags.
+func BuildGetConfigPayload(auditLogExportsGetConfigApikeyToken string, auditLogExportsGetConfigSessionToken string) (*auditlogexports.GetConfigPayload, error) {
+	var apikeyToken *string
+	{
+		if auditLogExportsGetConfigApikeyToken != "" {
+			apikeyToken = &auditLogExportsGetConfigApikeyToken
+		}
+	}
+	var sessionToken *string
+	{
+		if auditLogExportsGetConfigSessionToken != "" {
+			sessionToken = &auditLogExportsGetConfigSessionToken
+		}
+	}
+	v := &auditlogexports.GetConfigPayload{}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildUpsertConfigPayload builds the payload for the auditLogExports
+// upsertConfig endpoint from CLI flags.
+func BuildUpsertConfigPayload(auditLogExportsUpsertConfigBody string, auditLogExportsUpsertConfigApikeyToken string, auditLogExportsUpsertConfigSessionToken string) (*auditlogexports.UpsertConfigPayload, error) {
+	var err error
+	var body UpsertConfigRequestBody
+	{
+		err = json.Unmarshal([]byte(auditLogExportsUpsertConfigBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"enabled\": false,\n      \"format\": \"parquet\",\n      \"prefix\": \"aaa\",\n      \"resume_after_seq\": 1\n   }'")
+		}
+		if !(body.Format == "ndjson" || body.Format == "parquet" || body.Format == "ocsf" || body.Format == "cef") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", body.Format, []any{"ndjson", "parquet", "ocsf", "cef"}))
+		}
+		if body.Prefix != nil {
+			if utf8.RuneCountInString(*body.Prefix) > 256 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.prefix", *body.Prefix, utf8.RuneCountInString(*body.Prefix), 256, false))
+			}
+		}
+		if body.ResumeAfterSeq != nil {
+			if *body.ResumeAfterSeq < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.resume_after_seq", *body.ResumeAfterSeq, 0, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var apikeyToken *string
+	{
+		if auditLogExportsUpsertConfigApikeyToken != "" {
+			apikeyToken = &auditLogExportsUpsertConfigApikeyToken
+		}
+	}
+	var sessionToken *string
+	{
+		if auditLogExportsUpsertConfigSessionToken != "" {
+			sessionToken = &auditLogExportsUpsertConfigSessionToken
+		}
+	}
+	v := &auditlogexports.UpsertConfigPayload{
+		Enabled:        body.Enabled,
+		Format:         body.Format,
+		Prefix:         body.Prefix,
+		ResumeAfterSeq: body.ResumeAfterSeq,
+	}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildDeleteConfigPayload builds the payload for the auditLogExports
+// deleteConfig endpoint from CLI flags.
+func BuildDeleteConfigPayload(auditLogExportsDeleteConfigApikeyToken string, auditLogExportsDeleteConfigSessionToken string) (*auditlogexports.DeleteConfigPayload, error) {
+	var apikeyToken *string
+	{
+		if auditLogExportsDeleteConfigApikeyToken != "" {
+			apikeyToken = &auditLogExportsDeleteConfigApikeyToken
+		}
+	}
+	var sessionToken *string
+	{
+		if auditLogExportsDeleteConfigSessionToken != "" {
+			sessionToken = &auditLogExportsDeleteConfigSessionToken
+		}
+	}
+	v := &auditlogexports.DeleteConfigPayload{}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
@@ -0,0 +1,23 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// HTTP request path constructors for the auditLogExports service.
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package server
+
+// GetConfigAuditLogExportsPath returns the URL path to the auditLogExports service getConfig HTTP endpoint.
+func GetConfigAuditLogExportsPath() string {
+	return "/rpc/auditLogExports.getConfig"
+}
+
+// UpsertConfigAuditLogExportsPath returns the URL path to the auditLogExports service upsertConfig HTTP endpoint.
+func UpsertConfigAuditLogExportsPath() string {
+	return "/rpc/auditLogExports.upsertConfig"
+}
+
+// DeleteConfigAuditLogExportsPath returns the URL path to the auditLogExports service deleteConfig HTTP endpoint.
+func DeleteConfigAuditLogExportsPath() string {
+	return "/rpc/auditLogExports.deleteConfig"
+}
@@ -26,6 +26,7 @@ import (
 	assetsc "github.com/speakeasy-api/gram/server/gen/http/assets/client"
 	assistantmemoriesc "github.com/speakeasy-api/gram/server/gen/http/assistant_memories/client"
 	assistantsc "github.com/speakeasy-api/gram/server/gen/http/assistants/client"
+	auditlogexportsc "github.com/speakeasy-api/gram/server/gen/http/audit_log_exports/client"
 	auditlogsc "github.com/speakeasy-api/gram/server/gen/http/auditlogs/client"
 	authc "github.com/speakeasy-api/gram/server/gen/http/auth/client"
 	businessmemoriesc "github.com/speakeasy-api/gram/server/gen/http/business_memories/client"
@@ -77,8 +78,13 @@ import (
 	skillsc "github.com/speakeasy-api/gram/server/gen/http/skills/client"
 	spendrulesc "github.com/speakeasy-api/gram/server/gen/http/spend_rules/client"
 	telemetryc "github.com/speakeasy-api/gram/server/gen/http/telemetry/client"
+	telemetryalertsc "github.com/speakeasy-api/gram/server/gen/http/telemetry_alerts/client"
 	templatesc "github.com/speakeasy-api/gram/server/gen/http/templates/client"
 	tokenexchangec "github.com/speakeasy-api/gram/server/gen/http/token_exchange/client"
+	toolapprovalsc "github.com/speakeasy-api/gram/server/gen/http/tool_approvals/client"
+	toolcallrecordingsc "github.com/speakeasy-api/gram/server/gen/http/tool_call_recordings/client"
+	toolconstraintsc "github.com/speakeasy-api/gram/server/gen/http/tool_constraints/client"
+	toolratelimitsc "github.com/speakeasy-api/gram/server/gen/http/tool_rate_limits/client"
 	toolsc "github.com/speakeasy-api/gram/server/gen/http/tools/client"
 	toolsetsc "github.com/speakeasy-api/gram/server/gen/http/toolsets/client"
 	triggersc "github.com/speakeasy-api/gram/server/gen/http/triggers/client"
@@ -91,6 +97,7 @@ import (
 	usersessionissuerscimdclientsc "github.com/speakeasy-api/gram/server/gen/http/user_session_issuers_cimd_clients/client"
 	usersessionsc "github.com/speakeasy-api/gram/server/gen/http/user_sessions/client"
 	variationsc "github.com/speakeasy-api/gram/server/gen/http/variations/client"
+	webhookendpointsc "github.com/speakeasy-api/gram/server/gen/http/webhook_endpoints/client"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -110,13 +117,14 @@ func UsageCommands() []string {
 		"organization-assets upload-organization-image",
 		"assistant-memories (list-assistant-memories|get-assistant-memory|delete-assistant-memory)",
 		"assistants (list-assistants|get-assistant|create-assistant|update-assistant|delete-assistant|send-message|get-managed-assistant|ensure-managed-assistant)",
+		"audit-log-exports (get-config|upsert-config|delete-config)",
 		"auditlogs (list|list-facets)",
 		"auth (callback|login|switch-scopes|enter-demo|logout|register|info)",
 		"business-memories (list-business-memories|list-business-memory-content-scopes|search-business-memories)",
 		"chat (list-chats|get-assistant-session-summary|get-work-units-trend|load-chat|generate-title|credit-usage|delete-chat|set-pinned|summarize|summarize-tool-call|submit-feedback|list-sources|list-session-links)",
 		"chat-sessions (create|revoke)",
 		"cli-auth (authorize|redeem)",
-		"deployments (get-deployment|get-latest-deployment|get-active-deployment|create-deployment|evolve|redeploy|list-deployments|get-deployment-logs)",
+		"deployments (get-deployment|get-latest-deployment|get-active-deployment|create-deployment|evolve|redeploy|list-deployments|get-deployment-logs|diff-deployments)",
 		"device-integrations (list-providers|get-config|upsert-config|delete-config|test-connection|list-schedules|set-schedule-enabled|retry-schedule|list-managed-devices|get-coverage)",
 		"domains (get-domain|list-domains|create-domain|update-domain|set-root-mcp-endpoint|check-health|delete-domain|list-mcp-endpoints)",
 		"environments (create-environment|list-environments|update-environment|clone-environment|delete-environment|set-source-environment-link|delete-source-environment-link|get-source-environment|set-toolset-environment-link|delete-toolset-environment-link|get-toolset-environment)",
@@ -133,14 +141,14 @@ func UsageCommands() []string {
 		"keys (create-key|list-keys|revoke-key|verify-key)",
 		"litellm (create-instance|list-instances|rotate-instance-key|revoke-instance|ingest|traces)",
 		"mcp-approval (list-requests|get-request|ensure-server-review|create-request|promote|refresh-evidence|start-research|record-decision)",
-		"mcp-endpoints (create-mcp-endpoint|get-mcp-endpoint|list-mcp-endpoints|update-mcp-endpoint|check-mcp-endpoint-slug-availability|delete-mcp-endpoint)",
+		"mcp-endpoints (create-mcp-endpoint|get-mcp-endpoint|list-mcp-endpoints|update-mcp-endpoint|check-mcp-endpoint-slug-availability|delete-mcp-endpoint|start-mcp-endpoint-canary|get-mcp-endpoint-canary|update-mcp-endpoint-canary|promote-mcp-endpoint-canary|abort-mcp-endpoint-canary)",
 		"mcp-metadata (get-mcp-metadata|set-mcp-metadata|export-mcp-metadata)",
 		"mcp-servers (create-mcp-server|get-mcp-server|list-mcp-servers|list-mcp-servers-for-org|update-mcp-server|list-tool-filters|set-tool-metadata-batch|add-tool-metadata-batch|list-tool-metadata|set-tool-metadata|delete-tool-metadata|delete-mcp-server)",
 		"meta-mcp (create-meta-mcp-server|get-meta-mcp-server|list-meta-mcp-servers|update-meta-mcp-server|delete-meta-mcp-server|list-meta-mcp-members|add-meta-mcp-member|update-meta-mcp-member|remove-meta-mcp-member)",
 		"model-keys (list-keys|upsert-key|set-key-enabled|delete-key)",
 		"organizations (get|send-invite|revoke-invite|update-invite-role|list-invites|list-users|remove-user|enable-webhooks|disable-webhooks|create-portal-session|get-onboarding-status|verify-onboarding-hooks-setup|send-enterprise-admin-onboarding-email|generate-work-os-admin-portal-link)",
 		"otel (logs|traces)",
-		"otel-forwarding (get-config|upsert-config|delete-config)",
+		"otel-forwarding (get-config|upsert-config|delete-config|list-destinations|create-destination|update-destination|delete-destination)",
 		"packages (create-package|update-package|list-packages|list-versions|publish)",
 		"admin-assets upload-platform-image",
 		"admin-chat-analysis (get-settings|upsert-work-units-settings|upsert-business-memory-settings|trigger-analysis)",
@@ -162,12 +170,17 @@ func UsageCommands() []string {
 		"risk (create-risk-policy|list-risk-policies|list-builtin-exclusions|get-risk-policy|update-risk-policy|delete-risk-policy|list-risk-results|list-risk-results-for-agent|unmask-risk-result|list-risk-results-by-chat|mark-risk-results-false-positive|unmark-risk-results-false-positive|list-dismissed-risk-results|get-risk-overview|list-risk-categories|compile-expr|get-risk-user-breakdown|get-risk-rule-breakdown|get-risk-signals|get-risk-policy-status|create-risk-policy-bypass-request|acknowledge-risk-policy-challenge|get-risk-policy-challenge|decline-risk-policy-challenge|get-risk-block|submit-risk-block-feedback|list-risk-policy-bypass-requests|approve-risk-policy-bypass-request|deny-risk-policy-bypass-request|revoke-risk-policy-bypass-request|trigger-risk-analysis|create-custom-detection-rule|list-custom-detection-rules|get-custom-detection-rule|update-custom-detection-rule|delete-custom-detection-rule|list-risk-exclusions|create-risk-exclusion|update-risk-exclusion|delete-risk-exclusion|suggest-custom-detection-rule|suggest-exclusion|test-detection-rule|evaluate-prompt-guardrail|save-risk-eval-review|list-risk-eval-reviews|delete-risk-eval-review)",
 		"skill-efficacy (get-settings|upsert-settings|query-insights)",
 		"skills (create|add-version|restore-version|update|list|list-tags|list-suggestions|list-feedback|trigger-suggestion|approve-suggestion|dismiss-suggestion|list-suggestion-feedback|approve-all-suggestions|get|list-unknown-activations|list-versions|archive|distribute|undistribute|share|unshare|get-shared|list-distributions)",
-		"spend-rules (create-spend-rule|list-spend-rules|get-spend-rule|update-spend-rule|archive-spend-rule|preview-spend-rule|list-spend-rule-events|get-spend-rules-overview|list-actor-attributes)",
+		"spend-rules (create-spend-rule|list-spend-rules|get-spend-rule|update-spend-rule|archive-spend-rule|preview-spend-rule|simulate-spend-rule|list-spend-rule-events|get-spend-rules-overview|list-actor-attributes|list-usage-dimensions)",
 		"telemetry (search-logs|search-tool-calls|search-chats|search-users|capture-event|get-project-metrics-summary|get-user-metrics-summary|get-employee-data-flow-graph|get-observability-overview|get-project-overview|get-unproxied-mcp-server-usage|get-unproxied-mcp-server-tool-usage|get-unproxied-mcp-server-user-usage|get-unproxied-mcp-server-client-usage|query|query-tum-details|list-sessions|list-filter-options|list-attribute-keys|get-hooks-summary|get-tool-usage-summary|get-tool-usage-totals|get-tool-usage-targets|get-tool-usage-users|get-tool-usage-target-time-series|get-tool-usage-user-time-series|get-tool-usage-users-by-target|get-tool-usage-target-tool-breakdown|list-tool-usage-traces|get-tool-usage-filter-options|get-mcp-server-activity|list-hooks-traces)",
+		"telemetry-alerts (create-telemetry-alert-rule|list-telemetry-alert-rules|update-telemetry-alert-rule|delete-telemetry-alert-rule)",
 		"templates (create-template|update-template|get-template|list-templates|delete-template|render-template-by-id|render-template)",
 		"token-exchange exchange",
+		"tool-approvals (create-tool-approval-policy|list-tool-approval-policies|update-tool-approval-policy|delete-tool-approval-policy)",
+		"tool-call-recordings (start-tool-call-recording|stop-tool-call-recording|list-tool-call-recording-sessions|export-tool-call-recordings|replay-tool-call-recordings)",
+		"tool-constraints (create-tool-constraint|list-tool-constraints|update-tool-constraint|delete-tool-constraint)",
+		"tool-rate-limits (create-tool-rate-limit|list-tool-rate-limits|update-tool-rate-limit|delete-tool-rate-limit)",
 		"tools list-tools",
-		"toolsets (create-toolset|list-toolsets|list-toolsets-for-org|update-toolset|delete-toolset|get-toolset|list-tool-filters|check-mcp-slug-availability|clone-toolset|add-externaloauth-server|removeoauth-server|set-user-session-issuer|set-tool-variations-group)",
+		"toolsets (create-toolset|list-toolsets|list-toolsets-for-org|update-toolset|delete-toolset|get-toolset|list-tool-filters|check-mcp-slug-availability|clone-toolset|add-externaloauth-server|removeoauth-server|set-user-session-issuer|set-tool-variations-group|diff-toolset-versions|revert-toolset)",
 		"triggers (list-trigger-definitions|list-trigger-instances|list-trigger-events|get-trigger-instance|create-trigger-instance|update-trigger-instance|delete-trigger-instance|pause-trigger-instance|resume-trigger-instance)",
 		"tunneled-mcp (create-server|list-servers|get-server|list-server-connections|update-server|rotate-server-key|delete-server)",
 		"unproxied-mcp (create-server|list-servers|get-server|list-tools|delete-server)",
@@ -177,6 +190,7 @@ func UsageCommands() []string {
 		"user-session-issuers (create-user-session-issuer|update-user-session-issuer|list-user-session-issuers|get-user-session-issuer|delete-user-session-issuer)",
 		"user-session-issuers-cimd-clients (list-presets|create-user-session-issuer-cimd-client|verify-url|list-user-session-issuer-cimd-clients|get-user-session-issuer-cimd-client|delete-user-session-issuer-cimd-client)",
 		"user-sessions (list-user-sessions|list-facets|mint-user-session|revoke-user-session)",
+		"webhook-endpoints (create-endpoint|list-endpoints|update-endpoint|delete-endpoint|list-deliveries|redeliver)",
 		"variations (upsert-global|delete-global|list-global|list-groups|create-global)",
 	}
 }
@@ -636,6 +650,21 @@ func ParseEndpoint(
 		assistantsEnsureManagedAssistantSessionTokenFlag     = assistantsEnsureManagedAssistantFlags.String("session-token", "", "")
 		assistantsEnsureManagedAssistantProjectSlugInputFlag = assistantsEnsureManagedAssistantFlags.String("project-slug-input", "", "")
 
+		auditLogExportsFlags = flag.NewFlagSet("audit-log-exports", flag.ContinueOnError)
+
+		auditLogExportsGetConfigFlags            = flag.NewFlagSet("get-config", flag.ExitOnError)
+		auditLogExportsGetConfigApikeyTokenFlag  = auditLogExportsGetConfigFlags.String("apikey-token", "", "")
+		auditLogExportsGetConfigSessionTokenFlag = auditLogExportsGetConfigFlags.String("session-token", "", "")
+
+		auditLogExportsUpsertConfigFlags            = flag.NewFlagSet("upsert-config", flag.ExitOnError)
+		auditLogExportsUpsertConfigBodyFlag         = auditLogExportsUpsertConfigFlags.String("body", "REQUIRED", "")
+		auditLogExportsUpsertConfigApikeyTokenFlag  = auditLogExportsUpsertConfigFlags.String("apikey-token", "", "")
+		auditLogExportsUpsertConfigSessionTokenFlag = auditLogExportsUpsertConfigFlags.String("session-token", "", "")
+
+		auditLogExportsDeleteConfigFlags            = flag.NewFlagSet("delete-config", flag.ExitOnError)
+		auditLogExportsDeleteConfigApikeyTokenFlag  = auditLogExportsDeleteConfigFlags.String("apikey-token", "", "")
+		auditLogExportsDeleteConfigSessionTokenFlag = auditLogExportsDeleteConfigFlags.String("session-token", "", "")
+
 		auditlogsFlags = flag.NewFlagSet("auditlogs", flag.ContinueOnError)
 
 		auditlogsListFlags             = flag.NewFlagSet("list", flag.ExitOnError)
@@ -873,6 +902,13 @@ func ParseEndpoint(
 		deploymentsGetDeploymentLogsSessionTokenFlag     = deploymentsGetDeploymentLogsFlags.String("session-token", "", "")
 		deploymentsGetDeploymentLogsProjectSlugInputFlag = deploymentsGetDeploymentLogsFlags.String("project-slug-input", "", "")
 
+		deploymentsDiffDeploymentsFlags                = flag.NewFlagSet("diff-deployments", flag.ExitOnError)
+		deploymentsDiffDeploymentsFromIDFlag           = deploymentsDiffDeploymentsFlags.String("from-id", "REQUIRED", "")
+		deploymentsDiffDeploymentsToIDFlag             = deploymentsDiffDeploymentsFlags.String("to-id", "REQUIRED", "")
+		deploymentsDiffDeploymentsApikeyTokenFlag      = deploymentsDiffDeploymentsFlags.String("apikey-token", "", "")
+		deploymentsDiffDeploymentsSessionTokenFlag     = deploymentsDiffDeploymentsFlags.String("session-token", "", "")
+		deploymentsDiffDeploymentsProjectSlugInputFlag = deploymentsDiffDeploymentsFlags.String("project-slug-input", "", "")
+
 		deviceIntegrationsFlags = flag.NewFlagSet("device-integrations", flag.ContinueOnError)
 
 		deviceIntegrationsListProvidersFlags            = flag.NewFlagSet("list-providers", flag.ExitOnError)
@@ -1467,6 +1503,36 @@ func ParseEndpoint(
 		mcpEndpointsDeleteMcpEndpointApikeyTokenFlag      = mcpEndpointsDeleteMcpEndpointFlags.String("apikey-token", "", "")
 		mcpEndpointsDeleteMcpEndpointProjectSlugInputFlag = mcpEndpointsDeleteMcpEndpointFlags.String("project-slug-input", "", "")
 
+		mcpEndpointsStartMcpEndpointCanaryFlags                = flag.NewFlagSet("start-mcp-endpoint-canary", flag.ExitOnError)
+		mcpEndpointsStartMcpEndpointCanaryBodyFlag             = mcpEndpointsStartMcpEndpointCanaryFlags.String("body", "REQUIRED", "")
+		mcpEndpointsStartMcpEndpointCanarySessionTokenFlag     = mcpEndpointsStartMcpEndpointCanaryFlags.String("session-token", "", "")
+		mcpEndpointsStartMcpEndpointCanaryApikeyTokenFlag      = mcpEndpointsStartMcpEndpointCanaryFlags.String("apikey-token", "", "")
+		mcpEndpointsStartMcpEndpointCanaryProjectSlugInputFlag = mcpEndpointsStartMcpEndpointCanaryFlags.String("project-slug-input", "", "")
+
+		mcpEndpointsGetMcpEndpointCanaryFlags                = flag.NewFlagSet("get-mcp-endpoint-canary", flag.ExitOnError)
+		mcpEndpointsGetMcpEndpointCanaryMcpEndpointIDFlag    = mcpEndpointsGetMcpEndpointCanaryFlags.String("mcp-endpoint-id", "REQUIRED", "")
+		mcpEndpointsGetMcpEndpointCanarySessionTokenFlag     = mcpEndpointsGetMcpEndpointCanaryFlags.String("session-token", "", "")
+		mcpEndpointsGetMcpEndpointCanaryApikeyTokenFlag      = mcpEndpointsGetMcpEndpointCanaryFlags.String("apikey-token", "", "")
+		mcpEndpointsGetMcpEndpointCanaryProjectSlugInputFlag = mcpEndpointsGetMcpEndpointCanaryFlags.String("project-slug-input", "", "")
+
+		mcpEndpointsUpdateMcpEndpointCanaryFlags                = flag.NewFlagSet("update-mcp-endpoint-canary", flag.ExitOnError)
+		mcpEndpointsUpdateMcpEndpointCanaryBodyFlag             = mcpEndpointsUpdateMcpEndpointCanaryFlags.String("body", "REQUIRED", "")
+		mcpEndpointsUpdateMcpEndpointCanarySessionTokenFlag     = mcpEndpointsUpdateMcpEndpointCanaryFlags.String("session-token", "", "")
+		mcpEndpointsUpdateMcpEndpointCanaryApikeyTokenFlag      = mcpEndpointsUpdateMcpEndpointCanaryFlags.String("apikey-token", "", "")
+		mcpEndpointsUpdateMcpEndpointCanaryProjectSlugInputFlag = mcpEndpointsUpdateMcpEndpointCanaryFlags.String("project-slug-input", "", "")
+
+		mcpEndpointsPromoteMcpEndpointCanaryFlags                = flag.NewFlagSet("promote-mcp-endpoint-canary", flag.ExitOnError)
+		mcpEndpointsPromoteMcpEndpointCanaryBodyFlag             = mcpEndpointsPromoteMcpEndpointCanaryFlags.String("body", "REQUIRED", "")
+		mcpEndpointsPromoteMcpEndpointCanarySessionTokenFlag     = mcpEndpointsPromoteMcpEndpointCanaryFlags.String("session-token", "", "")
+		mcpEndpointsPromoteMcpEndpointCanaryApikeyTokenFlag      = mcpEndpointsPromoteMcpEndpointCanaryFlags.String("apikey-token", "", "")
+		mcpEndpointsPromoteMcpEndpointCanaryProjectSlugInputFlag = mcpEndpointsPromoteMcpEndpointCanaryFlags.String("project-slug-input", "", "")
+
+		mcpEndpointsAbortMcpEndpointCanaryFlags                = flag.NewFlagSet("abort-mcp-endpoint-canary", flag.ExitOnError)
+		mcpEndpointsAbortMcpEndpointCanaryBodyFlag             = mcpEndpointsAbortMcpEndpointCanaryFlags.String("body", "REQUIRED", "")
+		mcpEndpointsAbortMcpEndpointCanarySessionTokenFlag     = mcpEndpointsAbortMcpEndpointCanaryFlags.String("session-token", "", "")
+		mcpEndpointsAbortMcpEndpointCanaryApikeyTokenFlag      = mcpEndpointsAbortMcpEndpointCanaryFlags.String("apikey-token", "", "")
+		mcpEndpointsAbortMcpEndpointCanaryProjectSlugInputFlag = mcpEndpointsAbortMcpEndpointCanaryFlags.String("project-slug-input", "", "")
+
 		mcpMetadataFlags = flag.NewFlagSet("mcp-metadata", flag.ContinueOnError)
 
 		mcpMetadataGetMcpMetadataFlags                = flag.NewFlagSet("get-mcp-metadata", flag.ExitOnError)
@@ -1726,6 +1792,25 @@ func ParseEndpoint(
 		otelForwardingDeleteConfigApikeyTokenFlag  = otelForwardingDeleteConfigFlags.String("apikey-token", "", "")
 		otelForwardingDeleteConfigSessionTokenFlag = otelForwardingDeleteConfigFlags.String("session-token", "", "")
 
+		otelForwardingListDestinationsFlags            = flag.NewFlagSet("list-destinations", flag.ExitOnError)
+		otelForwardingListDestinationsApikeyTokenFlag  = otelForwardingListDestinationsFlags.String("apikey-token", "", "")
+		otelForwardingListDestinationsSessionTokenFlag = otelForwardingListDestinationsFlags.String("session-token", "", "")
+
+		otelForwardingCreateDestinationFlags            = flag.NewFlagSet("create-destination", flag.ExitOnError)
+		otelForwardingCreateDestinationBodyFlag         = otelForwardingCreateDestinationFlags.String("body", "REQUIRED", "")
+		otelForwardingCreateDestinationApikeyTokenFlag  = otelForwardingCreateDestinationFlags.String("apikey-token", "", "")
+		otelForwardingCreateDestinationSessionTokenFlag = otelForwardingCreateDestinationFlags.String("session-token", "", "")
+
+		otelForwardingUpdateDestinationFlags            = flag.NewFlagSet("update-destination", flag.ExitOnError)
+		otelForwardingUpdateDestinationBodyFlag         = otelForwardingUpdateDestinationFlags.String("body", "REQUIRED", "")
+		otelForwardingUpdateDestinationApikeyTokenFlag  = otelForwardingUpdateDestinationFlags.String("apikey-token", "", "")
+		otelForwardingUpdateDestinationSessionTokenFlag = otelForwardingUpdateDestinationFlags.String("session-token", "", "")
+
+		otelForwardingDeleteDestinationFlags            = flag.NewFlagSet("delete-destination", flag.ExitOnError)
+		otelForwardingDeleteDestinationBodyFlag         = otelForwardingDeleteDestinationFlags.String("body", "REQUIRED", "")
+		otelForwardingDeleteDestinationApikeyTokenFlag  = otelForwardingDeleteDestinationFlags.String("apikey-token", "", "")
+		otelForwardingDeleteDestinationSessionTokenFlag = otelForwardingDeleteDestinationFlags.String("session-token", "", "")
+
 		packagesFlags = flag.NewFlagSet("packages", flag.ContinueOnError)
 
 		packagesCreatePackageFlags                = flag.NewFlagSet("create-package", flag.ExitOnError)
@@ -2957,6 +3042,12 @@ func ParseEndpoint(
 		spendRulesPreviewSpendRuleSessionTokenFlag     = spendRulesPreviewSpendRuleFlags.String("session-token", "", "")
 		spendRulesPreviewSpendRuleProjectSlugInputFlag = spendRulesPreviewSpendRuleFlags.String("project-slug-input", "", "")
 
+		spendRulesSimulateSpendRuleFlags                = flag.NewFlagSet("simulate-spend-rule", flag.ExitOnError)
+		spendRulesSimulateSpendRuleBodyFlag             = spendRulesSimulateSpendRuleFlags.String("body", "REQUIRED", "")
+		spendRulesSimulateSpendRuleApikeyTokenFlag      = spendRulesSimulateSpendRuleFlags.String("apikey-token", "", "")
+		spendRulesSimulateSpendRuleSessionTokenFlag     = spendRulesSimulateSpendRuleFlags.String("session-token", "", "")
+		spendRulesSimulateSpendRuleProjectSlugInputFlag = spendRulesSimulateSpendRuleFlags.String("project-slug-input", "", "")
+
 		spendRulesListSpendRuleEventsFlags                = flag.NewFlagSet("list-spend-rule-events", flag.ExitOnError)
 		spendRulesListSpendRuleEventsRuleIDFlag           = spendRulesListSpendRuleEventsFlags.String("rule-id", "", "")
 		spendRulesListSpendRuleEventsEventTypeFlag        = spendRulesListSpendRuleEventsFlags.String("event-type", "", "")
@@ -2976,6 +3067,11 @@ func ParseEndpoint(
 		spendRulesListActorAttributesSessionTokenFlag     = spendRulesListActorAttributesFlags.String("session-token", "", "")
 		spendRulesListActorAttributesProjectSlugInputFlag = spendRulesListActorAttributesFlags.String("project-slug-input", "", "")
 
+		spendRulesListUsageDimensionsFlags                = flag.NewFlagSet("list-usage-dimensions", flag.ExitOnError)
+		spendRulesListUsageDimensionsApikeyTokenFlag      = spendRulesListUsageDimensionsFlags.String("apikey-token", "", "")
+		spendRulesListUsageDimensionsSessionTokenFlag     = spendRulesListUsageDimensionsFlags.String("session-token", "", "")
+		spendRulesListUsageDimensionsProjectSlugInputFlag = spendRulesListUsageDimensionsFlags.String("project-slug-input", "", "")
+
 		telemetryFlags = flag.NewFlagSet("telemetry", flag.ContinueOnError)
 
 		telemetrySearchLogsFlags                = flag.NewFlagSet("search-logs", flag.ExitOnError)
@@ -3165,6 +3261,32 @@ func ParseEndpoint(
 		telemetryListHooksTracesSessionTokenFlag     = telemetryListHooksTracesFlags.String("session-token", "", "")
 		telemetryListHooksTracesProjectSlugInputFlag = telemetryListHooksTracesFlags.String("project-slug-input", "", "")
 
+		telemetryAlertsFlags = flag.NewFlagSet("telemetry-alerts", flag.ContinueOnError)
+
+		telemetryAlertsCreateTelemetryAlertRuleFlags                = flag.NewFlagSet("create-telemetry-alert-rule", flag.ExitOnError)
+		telemetryAlertsCreateTelemetryAlertRuleBodyFlag             = telemetryAlertsCreateTelemetryAlertRuleFlags.String("body", "REQUIRED", "")
+		telemetryAlertsCreateTelemetryAlertRuleSessionTokenFlag     = telemetryAlertsCreateTelemetryAlertRuleFlags.String("session-token", "", "")
+		telemetryAlertsCreateTelemetryAlertRuleApikeyTokenFlag      = telemetryAlertsCreateTelemetryAlertRuleFlags.String("apikey-token", "", "")
+		telemetryAlertsCreateTelemetryAlertRuleProjectSlugInputFlag = telemetryAlertsCreateTelemetryAlertRuleFlags.String("project-slug-input", "", "")
+
+		telemetryAlertsListTelemetryAlertRulesFlags                = flag.NewFlagSet("list-telemetry-alert-rules", flag.ExitOnError)
+		telemetryAlertsListTelemetryAlertRulesToolsetIDFlag        = telemetryAlertsListTelemetryAlertRulesFlags.String("toolset-id", "", "")
+		telemetryAlertsListTelemetryAlertRulesSessionTokenFlag     = telemetryAlertsListTelemetryAlertRulesFlags.String("session-token", "", "")
+		telemetryAlertsListTelemetryAlertRulesApikeyTokenFlag      = telemetryAlertsListTelemetryAlertRulesFlags.String("apikey-token", "", "")
+		telemetryAlertsListTelemetryAlertRulesProjectSlugInputFlag = telemetryAlertsListTelemetryAlertRulesFlags.String("project-slug-input", "", "")
+
+		telemetryAlertsUpdateTelemetryAlertRuleFlags                = flag.NewFlagSet("update-telemetry-alert-rule", flag.ExitOnError)
+		telemetryAlertsUpdateTelemetryAlertRuleBodyFlag             = telemetryAlertsUpdateTelemetryAlertRuleFlags.String("body", "REQUIRED", "")
+		telemetryAlertsUpdateTelemetryAlertRuleSessionTokenFlag     = telemetryAlertsUpdateTelemetryAlertRuleFlags.String("session-token", "", "")
+		telemetryAlertsUpdateTelemetryAlertRuleApikeyTokenFlag      = telemetryAlertsUpdateTelemetryAlertRuleFlags.String("apikey-token", "", "")
+		telemetryAlertsUpdateTelemetryAlertRuleProjectSlugInputFlag = telemetryAlertsUpdateTelemetryAlertRuleFlags.String("project-slug-input", "", "")
+
+		telemetryAlertsDeleteTelemetryAlertRuleFlags                = flag.NewFlagSet("delete-telemetry-alert-rule", flag.ExitOnError)
+		telemetryAlertsDeleteTelemetryAlertRuleIDFlag               = telemetryAlertsDeleteTelemetryAlertRuleFlags.String("id", "REQUIRED", "")
+		telemetryAlertsDeleteTelemetryAlertRuleSessionTokenFlag     = telemetryAlertsDeleteTelemetryAlertRuleFlags.String("session-token", "", "")
+		telemetryAlertsDeleteTelemetryAlertRuleApikeyTokenFlag      = telemetryAlertsDeleteTelemetryAlertRuleFlags.String("apikey-token", "", "")
+		telemetryAlertsDeleteTelemetryAlertRuleProjectSlugInputFlag = telemetryAlertsDeleteTelemetryAlertRuleFlags.String("project-slug-input", "", "")
+
 		templatesFlags = flag.NewFlagSet("templates", flag.ContinueOnError)
 
 		templatesCreateTemplateFlags                = flag.NewFlagSet("create-template", flag.ExitOnError)
@@ -3217,6 +3339,119 @@ func ParseEndpoint(
 		tokenExchangeExchangeBodyFlag        = tokenExchangeExchangeFlags.String("body", "REQUIRED", "")
 		tokenExchangeExchangeApikeyTokenFlag = tokenExchangeExchangeFlags.String("apikey-token", "", "")
 
+		toolApprovalsFlags = flag.NewFlagSet("tool-approvals", flag.ContinueOnError)
+
+		toolApprovalsCreateToolApprovalPolicyFlags                = flag.NewFlagSet("create-tool-approval-policy", flag.ExitOnError)
+		toolApprovalsCreateToolApprovalPolicyBodyFlag             = toolApprovalsCreateToolApprovalPolicyFlags.String("body", "REQUIRED", "")
+		toolApprovalsCreateToolApprovalPolicySessionTokenFlag     = toolApprovalsCreateToolApprovalPolicyFlags.String("session-token", "", "")
+		toolApprovalsCreateToolApprovalPolicyApikeyTokenFlag      = toolApprovalsCreateToolApprovalPolicyFlags.String("apikey-token", "", "")
+		toolApprovalsCreateToolApprovalPolicyProjectSlugInputFlag = toolApprovalsCreateToolApprovalPolicyFlags.String("project-slug-input", "", "")
+
+		toolApprovalsListToolApprovalPoliciesFlags                = flag.NewFlagSet("list-tool-approval-policies", flag.ExitOnError)
+		toolApprovalsListToolApprovalPoliciesToolsetIDFlag        = toolApprovalsListToolApprovalPoliciesFlags.String("toolset-id", "", "")
+		toolApprovalsListToolApprovalPoliciesMcpServerIDFlag      = toolApprovalsListToolApprovalPoliciesFlags.String("mcp-server-id", "", "")
+		toolApprovalsListToolApprovalPoliciesSessionTokenFlag     = toolApprovalsListToolApprovalPoliciesFlags.String("session-token", "", "")
+		toolApprovalsListToolApprovalPoliciesApikeyTokenFlag      = toolApprovalsListToolApprovalPoliciesFlags.String("apikey-token", "", "")
+		toolApprovalsListToolApprovalPoliciesProjectSlugInputFlag = toolApprovalsListToolApprovalPoliciesFlags.String("project-slug-input", "", "")
+
+		toolApprovalsUpdateToolApprovalPolicyFlags                = flag.NewFlagSet("update-tool-approval-policy", flag.ExitOnError)
+		toolApprovalsUpdateToolApprovalPolicyBodyFlag             = toolApprovalsUpdateToolApprovalPolicyFlags.String("body", "REQUIRED", "")
+		toolApprovalsUpdateToolApprovalPolicySessionTokenFlag     = toolApprovalsUpdateToolApprovalPolicyFlags.String("session-token", "", "")
+		toolApprovalsUpdateToolApprovalPolicyApikeyTokenFlag      = toolApprovalsUpdateToolApprovalPolicyFlags.String("apikey-token", "", "")
+		toolApprovalsUpdateToolApprovalPolicyProjectSlugInputFlag = toolApprovalsUpdateToolApprovalPolicyFlags.String("project-slug-input", "", "")
+
+		toolApprovalsDeleteToolApprovalPolicyFlags                = flag.NewFlagSet("delete-tool-approval-policy", flag.ExitOnError)
+		toolApprovalsDeleteToolApprovalPolicyIDFlag               = toolApprovalsDeleteToolApprovalPolicyFlags.String("id", "REQUIRED", "")
+		toolApprovalsDeleteToolApprovalPolicySessionTokenFlag     = toolApprovalsDeleteToolApprovalPolicyFlags.String("session-token", "", "")
+		toolApprovalsDeleteToolApprovalPolicyApikeyTokenFlag      = toolApprovalsDeleteToolApprovalPolicyFlags.String("apikey-token", "", "")
+		toolApprovalsDeleteToolApprovalPolicyProjectSlugInputFlag = toolApprovalsDeleteToolApprovalPolicyFlags.String("project-slug-input", "", "")
+
+		toolCallRecordingsFlags = flag.NewFlagSet("tool-call-recordings", flag.ContinueOnError)
+
+		toolCallRecordingsStartToolCallRecordingFlags                = flag.NewFlagSet("start-tool-call-recording", flag.ExitOnError)
+		toolCallRecordingsStartToolCallRecordingBodyFlag             = toolCallRecordingsStartToolCallRecordingFlags.String("body", "REQUIRED", "")
+		toolCallRecordingsStartToolCallRecordingSessionTokenFlag     = toolCallRecordingsStartToolCallRecordingFlags.String("session-token", "", "")
+		toolCallRecordingsStartToolCallRecordingApikeyTokenFlag      = toolCallRecordingsStartToolCallRecordingFlags.String("apikey-token", "", "")
+		toolCallRecordingsStartToolCallRecordingProjectSlugInputFlag = toolCallRecordingsStartToolCallRecordingFlags.String("project-slug-input", "", "")
+
+		toolCallRecordingsStopToolCallRecordingFlags                = flag.NewFlagSet("stop-tool-call-recording", flag.ExitOnError)
+		toolCallRecordingsStopToolCallRecordingBodyFlag             = toolCallRecordingsStopToolCallRecordingFlags.String("body", "REQUIRED", "")
+		toolCallRecordingsStopToolCallRecordingSessionTokenFlag     = toolCallRecordingsStopToolCallRecordingFlags.String("session-token", "", "")
+		toolCallRecordingsStopToolCallRecordingApikeyTokenFlag      = toolCallRecordingsStopToolCallRecordingFlags.String("apikey-token", "", "")
+		toolCallRecordingsStopToolCallRecordingProjectSlugInputFlag = toolCallRecordingsStopToolCallRecordingFlags.String("project-slug-input", "", "")
+
+		toolCallRecordingsListToolCallRecordingSessionsFlags                = flag.NewFlagSet("list-tool-call-recording-sessions", flag.ExitOnError)
+		toolCallRecordingsListToolCallRecordingSessionsToolsetSlugFlag      = toolCallRecordingsListToolCallRecordingSessionsFlags.String("toolset-slug", "", "")
+		toolCallRecordingsListToolCallRecordingSessionsSessionTokenFlag     = toolCallRecordingsListToolCallRecordingSessionsFlags.String("session-token", "", "")
+		toolCallRecordingsListToolCallRecordingSessionsApikeyTokenFlag      = toolCallRecordingsListToolCallRecordingSessionsFlags.String("apikey-token", "", "")
+		toolCallRecordingsListToolCallRecordingSessionsProjectSlugInputFlag = toolCallRecordingsListToolCallRecordingSessionsFlags.String("project-slug-input", "", "")
+
+		toolCallRecordingsExportToolCallRecordingsFlags                = flag.NewFlagSet("export-tool-call-recordings", flag.ExitOnError)
+		toolCallRecordingsExportToolCallRecordingsSessionIDFlag        = toolCallRecordingsExportToolCallRecordingsFlags.String("session-id", "REQUIRED", "")
+		toolCallRecordingsExportToolCallRecordingsSessionTokenFlag     = toolCallRecordingsExportToolCallRecordingsFlags.String("session-token", "", "")
+		toolCallRecordingsExportToolCallRecordingsApikeyTokenFlag      = toolCallRecordingsExportToolCallRecordingsFlags.String("apikey-token", "", "")
+		toolCallRecordingsExportToolCallRecordingsProjectSlugInputFlag = toolCallRecordingsExportToolCallRecordingsFlags.String("project-slug-input", "", "")
+
+		toolCallRecordingsReplayToolCallRecordingsFlags                = flag.NewFlagSet("replay-tool-call-recordings", flag.ExitOnError)
+		toolCallRecordingsReplayToolCallRecordingsBodyFlag             = toolCallRecordingsReplayToolCallRecordingsFlags.String("body", "REQUIRED", "")
+		toolCallRecordingsReplayToolCallRecordingsSessionTokenFlag     = toolCallRecordingsReplayToolCallRecordingsFlags.String("session-token", "", "")
+		toolCallRecordingsReplayToolCallRecordingsApikeyTokenFlag      = toolCallRecordingsReplayToolCallRecordingsFlags.String("apikey-token", "", "")
+		toolCallRecordingsReplayToolCallRecordingsProjectSlugInputFlag = toolCallRecordingsReplayToolCallRecordingsFlags.String("project-slug-input", "", "")
+
+		toolConstraintsFlags = flag.NewFlagSet("tool-constraints", flag.ContinueOnError)
+
+		toolConstraintsCreateToolConstraintFlags                = flag.NewFlagSet("create-tool-constraint", flag.ExitOnError)
+		toolConstraintsCreateToolConstraintBodyFlag             = toolConstraintsCreateToolConstraintFlags.String("body", "REQUIRED", "")
+		toolConstraintsCreateToolConstraintSessionTokenFlag     = toolConstraintsCreateToolConstraintFlags.String("session-token", "", "")
+		toolConstraintsCreateToolConstraintApikeyTokenFlag      = toolConstraintsCreateToolConstraintFlags.String("apikey-token", "", "")
+		toolConstraintsCreateToolConstraintProjectSlugInputFlag = toolConstraintsCreateToolConstraintFlags.String("project-slug-input", "", "")
+
+		toolConstraintsListToolConstraintsFlags                = flag.NewFlagSet("list-tool-constraints", flag.ExitOnError)
+		toolConstraintsListToolConstraintsToolsetIDFlag        = toolConstraintsListToolConstraintsFlags.String("toolset-id", "", "")
+		toolConstraintsListToolConstraintsMcpServerIDFlag      = toolConstraintsListToolConstraintsFlags.String("mcp-server-id", "", "")
+		toolConstraintsListToolConstraintsSessionTokenFlag     = toolConstraintsListToolConstraintsFlags.String("session-token", "", "")
+		toolConstraintsListToolConstraintsApikeyTokenFlag      = toolConstraintsListToolConstraintsFlags.String("apikey-token", "", "")
+		toolConstraintsListToolConstraintsProjectSlugInputFlag = toolConstraintsListToolConstraintsFlags.String("project-slug-input", "", "")
+
+		toolConstraintsUpdateToolConstraintFlags                = flag.NewFlagSet("update-tool-constraint", flag.ExitOnError)
+		toolConstraintsUpdateToolConstraintBodyFlag             = toolConstraintsUpdateToolConstraintFlags.String("body", "REQUIRED", "")
+		toolConstraintsUpdateToolConstraintSessionTokenFlag     = toolConstraintsUpdateToolConstraintFlags.String("session-token", "", "")
+		toolConstraintsUpdateToolConstraintApikeyTokenFlag      = toolConstraintsUpdateToolConstraintFlags.String("apikey-token", "", "")
+		toolConstraintsUpdateToolConstraintProjectSlugInputFlag = toolConstraintsUpdateToolConstraintFlags.String("project-slug-input", "", "")
+
+		toolConstraintsDeleteToolConstraintFlags                = flag.NewFlagSet("delete-tool-constraint", flag.ExitOnError)
+		toolConstraintsDeleteToolConstraintIDFlag               = toolConstraintsDeleteToolConstraintFlags.String("id", "REQUIRED", "")
+		toolConstraintsDeleteToolConstraintSessionTokenFlag     = toolConstraintsDeleteToolConstraintFlags.String("session-token", "", "")
+		toolConstraintsDeleteToolConstraintApikeyTokenFlag      = toolConstraintsDeleteToolConstraintFlags.String("apikey-token", "", "")
+		toolConstraintsDeleteToolConstraintProjectSlugInputFlag = toolConstraintsDeleteToolConstraintFlags.String("project-slug-input", "", "")
+
+		toolRateLimitsFlags = flag.NewFlagSet("tool-rate-limits", flag.ContinueOnError)
+
+		toolRateLimitsCreateToolRateLimitFlags                = flag.NewFlagSet("create-tool-rate-limit", flag.ExitOnError)
+		toolRateLimitsCreateToolRateLimitBodyFlag             = toolRateLimitsCreateToolRateLimitFlags.String("body", "REQUIRED", "")
+		toolRateLimitsCreateToolRateLimitSessionTokenFlag     = toolRateLimitsCreateToolRateLimitFlags.String("session-token", "", "")
+		toolRateLimitsCreateToolRateLimitApikeyTokenFlag      = toolRateLimitsCreateToolRateLimitFlags.String("apikey-token", "", "")
+		toolRateLimitsCreateToolRateLimitProjectSlugInputFlag = toolRateLimitsCreateToolRateLimitFlags.String("project-slug-input", "", "")
+
+		toolRateLimitsListToolRateLimitsFlags                = flag.NewFlagSet("list-tool-rate-limits", flag.ExitOnError)
+		toolRateLimitsListToolRateLimitsToolsetIDFlag        = toolRateLimitsListToolRateLimitsFlags.String("toolset-id", "", "")
+		toolRateLimitsListToolRateLimitsMcpServerIDFlag      = toolRateLimitsListToolRateLimitsFlags.String("mcp-server-id", "", "")
+		toolRateLimitsListToolRateLimitsSessionTokenFlag     = toolRateLimitsListToolRateLimitsFlags.String("session-token", "", "")
+		toolRateLimitsListToolRateLimitsApikeyTokenFlag      = toolRateLimitsListToolRateLimitsFlags.String("apikey-token", "", "")
+		toolRateLimitsListToolRateLimitsProjectSlugInputFlag = toolRateLimitsListToolRateLimitsFlags.String("project-slug-input", "", "")
+
+		toolRateLimitsUpdateToolRateLimitFlags                = flag.NewFlagSet("update-tool-rate-limit", flag.ExitOnError)
+		toolRateLimitsUpdateToolRateLimitBodyFlag             = toolRateLimitsUpdateToolRateLimitFlags.String("body", "REQUIRED", "")
+		toolRateLimitsUpdateToolRateLimitSessionTokenFlag     = toolRateLimitsUpdateToolRateLimitFlags.String("session-token", "", "")
+		toolRateLimitsUpdateToolRateLimitApikeyTokenFlag      = toolRateLimitsUpdateToolRateLimitFlags.String("apikey-token", "", "")
+		toolRateLimitsUpdateToolRateLimitProjectSlugInputFlag = toolRateLimitsUpdateToolRateLimitFlags.String("project-slug-input", "", "")
+
+		toolRateLimitsDeleteToolRateLimitFlags                = flag.NewFlagSet("delete-tool-rate-limit", flag.ExitOnError)
+		toolRateLimitsDeleteToolRateLimitIDFlag               = toolRateLimitsDeleteToolRateLimitFlags.String("id", "REQUIRED", "")
+		toolRateLimitsDeleteToolRateLimitSessionTokenFlag     = toolRateLimitsDeleteToolRateLimitFlags.String("session-token", "", "")
+		toolRateLimitsDeleteToolRateLimitApikeyTokenFlag      = toolRateLimitsDeleteToolRateLimitFlags.String("apikey-token", "", "")
+		toolRateLimitsDeleteToolRateLimitProjectSlugInputFlag = toolRateLimitsDeleteToolRateLimitFlags.String("project-slug-input", "", "")
+
 		toolsFlags = flag.NewFlagSet("tools", flag.ContinueOnError)
 
 		toolsListToolsFlags                = flag.NewFlagSet("list-tools", flag.ExitOnError)
@@ -3309,6 +3544,21 @@ func ParseEndpoint(
 		toolsetsSetToolVariationsGroupApikeyTokenFlag      = toolsetsSetToolVariationsGroupFlags.String("apikey-token", "", "")
 		toolsetsSetToolVariationsGroupProjectSlugInputFlag = toolsetsSetToolVariationsGroupFlags.String("project-slug-input", "", "")
 
+		toolsetsDiffToolsetVersionsFlags                = flag.NewFlagSet("diff-toolset-versions", flag.ExitOnError)
+		toolsetsDiffToolsetVersionsSlugFlag             = toolsetsDiffToolsetVersionsFlags.String("slug", "REQUIRED", "")
+		toolsetsDiffToolsetVersionsFromVersionFlag      = toolsetsDiffToolsetVersionsFlags.String("from-version", "REQUIRED", "")
+		toolsetsDiffToolsetVersionsToVersionFlag        = toolsetsDiffToolsetVersionsFlags.String("to-version", "REQUIRED", "")
+		toolsetsDiffToolsetVersionsSessionTokenFlag     = toolsetsDiffToolsetVersionsFlags.String("session-token", "", "")
+		toolsetsDiffToolsetVersionsApikeyTokenFlag      = toolsetsDiffToolsetVersionsFlags.String("apikey-token", "", "")
+		toolsetsDiffToolsetVersionsProjectSlugInputFlag = toolsetsDiffToolsetVersionsFlags.String("project-slug-input", "", "")
+
+		toolsetsRevertToolsetFlags                = flag.NewFlagSet("revert-toolset", flag.ExitOnError)
+		toolsetsRevertToolsetBodyFlag             = toolsetsRevertToolsetFlags.String("body", "REQUIRED", "")
+		toolsetsRevertToolsetSlugFlag             = toolsetsRevertToolsetFlags.String("slug", "REQUIRED", "")
+		toolsetsRevertToolsetSessionTokenFlag     = toolsetsRevertToolsetFlags.String("session-token", "", "")
+		toolsetsRevertToolsetApikeyTokenFlag      = toolsetsRevertToolsetFlags.String("apikey-token", "", "")
+		toolsetsRevertToolsetProjectSlugInputFlag = toolsetsRevertToolsetFlags.String("project-slug-input", "", "")
+
 		triggersFlags = flag.NewFlagSet("triggers", flag.ContinueOnError)
 
 		triggersListTriggerDefinitionsFlags                = flag.NewFlagSet("list-trigger-definitions", flag.ExitOnError)
@@ -3633,6 +3883,34 @@ func ParseEndpoint(
 		userSessionsRevokeUserSessionApikeyTokenFlag      = userSessionsRevokeUserSessionFlags.String("apikey-token", "", "")
 		userSessionsRevokeUserSessionProjectSlugInputFlag = userSessionsRevokeUserSessionFlags.String("project-slug-input", "", "")
 
+		webhookEndpointsFlags = flag.NewFlagSet("webhook-endpoints", flag.ContinueOnError)
+
+		webhookEndpointsCreateEndpointFlags            = flag.NewFlagSet("create-endpoint", flag.ExitOnError)
+		webhookEndpointsCreateEndpointBodyFlag         = webhookEndpointsCreateEndpointFlags.String("body", "REQUIRED", "")
+		webhookEndpointsCreateEndpointSessionTokenFlag = webhookEndpointsCreateEndpointFlags.String("session-token", "", "")
+
+		webhookEndpointsListEndpointsFlags            = flag.NewFlagSet("list-endpoints", flag.ExitOnError)
+		webhookEndpointsListEndpointsSessionTokenFlag = webhookEndpointsListEndpointsFlags.String("session-token", "", "")
+
+		webhookEndpointsUpdateEndpointFlags            = flag.NewFlagSet("update-endpoint", flag.ExitOnError)
+		webhookEndpointsUpdateEndpointBodyFlag         = webhookEndpointsUpdateEndpointFlags.String("body", "REQUIRED", "")
+		webhookEndpointsUpdateEndpointSessionTokenFlag = webhookEndpointsUpdateEndpointFlags.String("session-token", "", "")
+
+		webhookEndpointsDeleteEndpointFlags            = flag.NewFlagSet("delete-endpoint", flag.ExitOnError)
+		webhookEndpointsDeleteEndpointIDFlag           = webhookEndpointsDeleteEndpointFlags.String("id", "REQUIRED", "")
+		webhookEndpointsDeleteEndpointSessionTokenFlag = webhookEndpointsDeleteEndpointFlags.String("session-token", "", "")
+
+		webhookEndpointsListDeliveriesFlags              = flag.NewFlagSet("list-deliveries", flag.ExitOnError)
+		webhookEndpointsListDeliveriesEndpointIDFlag     = webhookEndpointsListDeliveriesFlags.String("endpoint-id", "REQUIRED", "")
+		webhookEndpointsListDeliveriesDeliveryStatusFlag = webhookEndpointsListDeliveriesFlags.String("delivery-status", "", "")
+		webhookEndpointsListDeliveriesCursorFlag         = webhookEndpointsListDeliveriesFlags.String("cursor", "", "")
+		webhookEndpointsListDeliveriesLimitFlag          = webhookEndpointsListDeliveriesFlags.String("limit", "50", "")
+		webhookEndpointsListDeliveriesSessionTokenFlag   = webhookEndpointsListDeliveriesFlags.String("session-token", "", "")
+
+		webhookEndpointsRedeliverFlags            = flag.NewFlagSet("redeliver", flag.ExitOnError)
+		webhookEndpointsRedeliverBodyFlag         = webhookEndpointsRedeliverFlags.String("body", "REQUIRED", "")
+		webhookEndpointsRedeliverSessionTokenFlag = webhookEndpointsRedeliverFlags.String("session-token", "", "")
+
 		variationsFlags = flag.NewFlagSet("variations", flag.ContinueOnError)
 
 		variationsUpsertGlobalFlags                = flag.NewFlagSet("upsert-global", flag.ExitOnError)
@@ -3762,6 +4040,11 @@ func ParseEndpoint(
 	assistantsGetManagedAssistantFlags.Usage = assistantsGetManagedAssistantUsage
 	assistantsEnsureManagedAssistantFlags.Usage = assistantsEnsureManagedAssistantUsage
 
+	auditLogExportsFlags.Usage = auditLogExportsUsage
+	auditLogExportsGetConfigFlags.Usage = auditLogExportsGetConfigUsage
+	auditLogExportsUpsertConfigFlags.Usage = auditLogExportsUpsertConfigUsage
+	auditLogExportsDeleteConfigFlags.Usage = auditLogExportsDeleteConfigUsage
+
 	auditlogsFlags.Usage = auditlogsUsage
 	auditlogsListFlags.Usage = auditlogsListUsage
 	auditlogsListFacetsFlags.Usage = auditlogsListFacetsUsage
@@ -3812,6 +4095,7 @@ func ParseEndpoint(
 	deploymentsRedeployFlags.Usage = deploymentsRedeployUsage
 	deploymentsListDeploymentsFlags.Usage = deploymentsListDeploymentsUsage
 	deploymentsGetDeploymentLogsFlags.Usage = deploymentsGetDeploymentLogsUsage
+	deploymentsDiffDeploymentsFlags.Usage = deploymentsDiffDeploymentsUsage
 
 	deviceIntegrationsFlags.Usage = deviceIntegrationsUsage
 	deviceIntegrationsListProvidersFlags.Usage = deviceIntegrationsListProvidersUsage
@@ -3961,6 +4245,11 @@ func ParseEndpoint(
 	mcpEndpointsUpdateMcpEndpointFlags.Usage = mcpEndpointsUpdateMcpEndpointUsage
 	mcpEndpointsCheckMcpEndpointSlugAvailabilityFlags.Usage = mcpEndpointsCheckMcpEndpointSlugAvailabilityUsage
 	mcpEndpointsDeleteMcpEndpointFlags.Usage = mcpEndpointsDeleteMcpEndpointUsage
+	mcpEndpointsStartMcpEndpointCanaryFlags.Usage = mcpEndpointsStartMcpEndpointCanaryUsage
+	mcpEndpointsGetMcpEndpointCanaryFlags.Usage = mcpEndpointsGetMcpEndpointCanaryUsage
+	mcpEndpointsUpdateMcpEndpointCanaryFlags.Usage = mcpEndpointsUpdateMcpEndpointCanaryUsage
+	mcpEndpointsPromoteMcpEndpointCanaryFlags.Usage = mcpEndpointsPromoteMcpEndpointCanaryUsage
+	mcpEndpointsAbortMcpEndpointCanaryFlags.Usage = mcpEndpointsAbortMcpEndpointCanaryUsage
 
 	mcpMetadataFlags.Usage = mcpMetadataUsage
 	mcpMetadataGetMcpMetadataFlags.Usage = mcpMetadataGetMcpMetadataUsage
@@ -4022,6 +4311,10 @@ func ParseEndpoint(
 	otelForwardingGetConfigFlags.Usage = otelForwardingGetConfigUsage
 	otelForwardingUpsertConfigFlags.Usage = otelForwardingUpsertConfigUsage
 	otelForwardingDeleteConfigFlags.Usage = otelForwardingDeleteConfigUsage
+	otelForwardingListDestinationsFlags.Usage = otelForwardingListDestinationsUsage
+	otelForwardingCreateDestinationFlags.Usage = otelForwardingCreateDestinationUsage
+	otelForwardingUpdateDestinationFlags.Usage = otelForwardingUpdateDestinationUsage
+	otelForwardingDeleteDestinationFlags.Usage = otelForwardingDeleteDestinationUsage
 
 	packagesFlags.Usage = packagesUsage
 	packagesCreatePackageFlags.Usage = packagesCreatePackageUsage
@@ -4280,9 +4573,11 @@ func ParseEndpoint(
 	spendRulesUpdateSpendRuleFlags.Usage = spendRulesUpdateSpendRuleUsage
 	spendRulesArchiveSpendRuleFlags.Usage = spendRulesArchiveSpendRuleUsage
 	spendRulesPreviewSpendRuleFlags.Usage = spendRulesPreviewSpendRuleUsage
+	spendRulesSimulateSpendRuleFlags.Usage = spendRulesSimulateSpendRuleUsage
 	spendRulesListSpendRuleEventsFlags.Usage = spendRulesListSpendRuleEventsUsage
 	spendRulesGetSpendRulesOverviewFlags.Usage = spendRulesGetSpendRulesOverviewUsage
 	spendRulesListActorAttributesFlags.Usage = spendRulesListActorAttributesUsage
+	spendRulesListUsageDimensionsFlags.Usage = spendRulesListUsageDimensionsUsage
 
 	telemetryFlags.Usage = telemetryUsage
 	telemetrySearchLogsFlags.Usage = telemetrySearchLogsUsage
@@ -4318,6 +4613,12 @@ func ParseEndpoint(
 	telemetryGetMcpServerActivityFlags.Usage = telemetryGetMcpServerActivityUsage
 	telemetryListHooksTracesFlags.Usage = telemetryListHooksTracesUsage
 
+	telemetryAlertsFlags.Usage = telemetryAlertsUsage
+	telemetryAlertsCreateTelemetryAlertRuleFlags.Usage = telemetryAlertsCreateTelemetryAlertRuleUsage
+	telemetryAlertsListTelemetryAlertRulesFlags.Usage = telemetryAlertsListTelemetryAlertRulesUsage
+	telemetryAlertsUpdateTelemetryAlertRuleFlags.Usage = telemetryAlertsUpdateTelemetryAlertRuleUsage
+	telemetryAlertsDeleteTelemetryAlertRuleFlags.Usage = telemetryAlertsDeleteTelemetryAlertRuleUsage
+
 	templatesFlags.Usage = templatesUsage
 	templatesCreateTemplateFlags.Usage = templatesCreateTemplateUsage
 	templatesUpdateTemplateFlags.Usage = templatesUpdateTemplateUsage
@@ -4330,6 +4631,31 @@ func ParseEndpoint(
 	tokenExchangeFlags.Usage = tokenExchangeUsage
 	tokenExchangeExchangeFlags.Usage = tokenExchangeExchangeUsage
 
+	toolApprovalsFlags.Usage = toolApprovalsUsage
+	toolApprovalsCreateToolApprovalPolicyFlags.Usage = toolApprovalsCreateToolApprovalPolicyUsage
+	toolApprovalsListToolApprovalPoliciesFlags.Usage = toolApprovalsListToolApprovalPoliciesUsage
+	toolApprovalsUpdateToolApprovalPolicyFlags.Usage = toolApprovalsUpdateToolApprovalPolicyUsage
+	toolApprovalsDeleteToolApprovalPolicyFlags.Usage = toolApprovalsDeleteToolApprovalPolicyUsage
+
+	toolCallRecordingsFlags.Usage = toolCallRecordingsUsage
+	toolCallRecordingsStartToolCallRecordingFlags.Usage = toolCallRecordingsStartToolCallRecordingUsage
+	toolCallRecordingsStopToolCallRecordingFlags.Usage = toolCallRecordingsStopToolCallRecordingUsage
+	toolCallRecordingsListToolCallRecordingSessionsFlags.Usage = toolCallRecordingsListToolCallRecordingSessionsUsage
+	toolCallRecordingsExportToolCallRecordingsFlags.Usage = toolCallRecordingsExportToolCallRecordingsUsage
+	toolCallRecordingsReplayToolCallRecordingsFlags.Usage = toolCallRecordingsReplayToolCallRecordingsUsage
+
+	toolConstraintsFlags.Usage = toolConstraintsUsage
+	toolConstraintsCreateToolConstraintFlags.Usage = toolConstraintsCreateToolConstraintUsage
+	toolConstraintsListToolConstraintsFlags.Usage = toolConstraintsListToolConstraintsUsage
+	toolConstraintsUpdateToolConstraintFlags.Usage = toolConstraintsUpdateToolConstraintUsage
+	toolConstraintsDeleteToolConstraintFlags.Usage = toolConstraintsDeleteToolConstraintUsage
+
+	toolRateLimitsFlags.Usage = toolRateLimitsUsage
+	toolRateLimitsCreateToolRateLimitFlags.Usage = toolRateLimitsCreateToolRateLimitUsage
+	toolRateLimitsListToolRateLimitsFlags.Usage = toolRateLimitsListToolRateLimitsUsage
+	toolRateLimitsUpdateToolRateLimitFlags.Usage = toolRateLimitsUpdateToolRateLimitUsage
+	toolRateLimitsDeleteToolRateLimitFlags.Usage = toolRateLimitsDeleteToolRateLimitUsage
+
 	toolsFlags.Usage = toolsUsage
 	toolsListToolsFlags.Usage = toolsListToolsUsage
 
@@ -4347,6 +4673,8 @@ func ParseEndpoint(
 	toolsetsRemoveOAuthServerFlags.Usage = toolsetsRemoveOAuthServerUsage
 	toolsetsSetUserSessionIssuerFlags.Usage = toolsetsSetUserSessionIssuerUsage
 	toolsetsSetToolVariationsGroupFlags.Usage = toolsetsSetToolVariationsGroupUsage
+	toolsetsDiffToolsetVersionsFlags.Usage = toolsetsDiffToolsetVersionsUsage
+	toolsetsRevertToolsetFlags.Usage = toolsetsRevertToolsetUsage
 
 	triggersFlags.Usage = triggersUsage
 	triggersListTriggerDefinitionsFlags.Usage = triggersListTriggerDefinitionsUsage
@@ -4425,6 +4753,14 @@ func ParseEndpoint(
 	userSessionsMintUserSessionFlags.Usage = userSessionsMintUserSessionUsage
 	userSessionsRevokeUserSessionFlags.Usage = userSessionsRevokeUserSessionUsage
 
+	webhookEndpointsFlags.Usage = webhookEndpointsUsage
+	webhookEndpointsCreateEndpointFlags.Usage = webhookEndpointsCreateEndpointUsage
+	webhookEndpointsListEndpointsFlags.Usage = webhookEndpointsListEndpointsUsage
+	webhookEndpointsUpdateEndpointFlags.Usage = webhookEndpointsUpdateEndpointUsage
+	webhookEndpointsDeleteEndpointFlags.Usage = webhookEndpointsDeleteEndpointUsage
+	webhookEndpointsListDeliveriesFlags.Usage = webhookEndpointsListDeliveriesUsage
+	webhookEndpointsRedeliverFlags.Usage = webhookEndpointsRedeliverUsage
+
 	variationsFlags.Usage = variationsUsage
 	variationsUpsertGlobalFlags.Usage = variationsUpsertGlobalUsage
 	variationsDeleteGlobalFlags.Usage = variationsDeleteGlobalUsage
@@ -4467,6 +4803,8 @@ func ParseEndpoint(
 			svcf = assistantMemoriesFlags
 		case "assistants":
 			svcf = assistantsFlags
+		case "audit-log-exports":
+			svcf = auditLogExportsFlags
 		case "auditlogs":
 			svcf = auditlogsFlags
 		case "auth":
@@ -4575,10 +4913,20 @@ func ParseEndpoint(
 			svcf = spendRulesFlags
 		case "telemetry":
 			svcf = telemetryFlags
+		case "telemetry-alerts":
+			svcf = telemetryAlertsFlags
 		case "templates":
 			svcf = templatesFlags
 		case "token-exchange":
 			svcf = tokenExchangeFlags
+		case "tool-approvals":
+			svcf = toolApprovalsFlags
+		case "tool-call-recordings":
+			svcf = toolCallRecordingsFlags
+		case "tool-constraints":
+			svcf = toolConstraintsFlags
+		case "tool-rate-limits":
+			svcf = toolRateLimitsFlags
 		case "tools":
 			svcf = toolsFlags
 		case "toolsets":
@@ -4601,6 +4949,8 @@ func ParseEndpoint(
 			svcf = userSessionIssuersCimdClientsFlags
 		case "user-sessions":
 			svcf = userSessionsFlags
+		case "webhook-endpoints":
+			svcf = webhookEndpointsFlags
 		case "variations":
 			svcf = variationsFlags
 		default:
@@ -4898,6 +5248,19 @@ func ParseEndpoint(
 
 			}
 
+		case "audit-log-exports":
+			switch epn {
+			case "get-config":
+				epf = auditLogExportsGetConfigFlags
+
+			case "upsert-config":
+				epf = auditLogExportsUpsertConfigFlags
+
+			case "delete-config":
+				epf = auditLogExportsDeleteConfigFlags
+
+			}
+
 		case "auditlogs":
 			switch epn {
 			case "list":
@@ -5035,6 +5398,9 @@ func ParseEndpoint(
 			case "get-deployment-logs":
 				epf = deploymentsGetDeploymentLogsFlags
 
+			case "diff-deployments":
+				epf = deploymentsDiffDeploymentsFlags
+
 			}
 
 		case "device-integrations":
@@ -5448,6 +5814,21 @@ func ParseEndpoint(
 			case "delete-mcp-endpoint":
 				epf = mcpEndpointsDeleteMcpEndpointFlags
 
+			case "start-mcp-endpoint-canary":
+				epf = mcpEndpointsStartMcpEndpointCanaryFlags
+
+			case "get-mcp-endpoint-canary":
+				epf = mcpEndpointsGetMcpEndpointCanaryFlags
+
+			case "update-mcp-endpoint-canary":
+				epf = mcpEndpointsUpdateMcpEndpointCanaryFlags
+
+			case "promote-mcp-endpoint-canary":
+				epf = mcpEndpointsPromoteMcpEndpointCanaryFlags
+
+			case "abort-mcp-endpoint-canary":
+				epf = mcpEndpointsAbortMcpEndpointCanaryFlags
+
 			}
 
 		case "mcp-metadata":
@@ -5617,6 +5998,18 @@ func ParseEndpoint(
 			case "delete-config":
 				epf = otelForwardingDeleteConfigFlags
 
+			case "list-destinations":
+				epf = otelForwardingListDestinationsFlags
+
+			case "create-destination":
+				epf = otelForwardingCreateDestinationFlags
+
+			case "update-destination":
+				epf = otelForwardingUpdateDestinationFlags
+
+			case "delete-destination":
+				epf = otelForwardingDeleteDestinationFlags
+
 			}
 
 		case "packages":
@@ -6347,6 +6740,9 @@ func ParseEndpoint(
 			case "preview-spend-rule":
 				epf = spendRulesPreviewSpendRuleFlags
 
+			case "simulate-spend-rule":
+				epf = spendRulesSimulateSpendRuleFlags
+
 			case "list-spend-rule-events":
 				epf = spendRulesListSpendRuleEventsFlags
 
@@ -6356,6 +6752,9 @@ func ParseEndpoint(
 			case "list-actor-attributes":
 				epf = spendRulesListActorAttributesFlags
 
+			case "list-usage-dimensions":
+				epf = spendRulesListUsageDimensionsFlags
+
 			}
 
 		case "telemetry":
@@ -6458,6 +6857,22 @@ func ParseEndpoint(
 
 			}
 
+		case "telemetry-alerts":
+			switch epn {
+			case "create-telemetry-alert-rule":
+				epf = telemetryAlertsCreateTelemetryAlertRuleFlags
+
+			case "list-telemetry-alert-rules":
+				epf = telemetryAlertsListTelemetryAlertRulesFlags
+
+			case "update-telemetry-alert-rule":
+				epf = telemetryAlertsUpdateTelemetryAlertRuleFlags
+
+			case "delete-telemetry-alert-rule":
+				epf = telemetryAlertsDeleteTelemetryAlertRuleFlags
+
+			}
+
 		case "templates":
 			switch epn {
 			case "create-template":
@@ -6490,6 +6905,73 @@ func ParseEndpoint(
 
 			}
 
+		case "tool-approvals":
+			switch epn {
+			case "create-tool-approval-policy":
+				epf = toolApprovalsCreateToolApprovalPolicyFlags
+
+			case "list-tool-approval-policies":
+				epf = toolApprovalsListToolApprovalPoliciesFlags
+
+			case "update-tool-approval-policy":
+				epf = toolApprovalsUpdateToolApprovalPolicyFlags
+
+			case "delete-tool-approval-policy":
+				epf = toolApprovalsDeleteToolApprovalPolicyFlags
+
+			}
+
+		case "tool-call-recordings":
+			switch epn {
+			case "start-tool-call-recording":
+				epf = toolCallRecordingsStartToolCallRecordingFlags
+
+			case "stop-tool-call-recording":
+				epf = toolCallRecordingsStopToolCallRecordingFlags
+
+			case "list-tool-call-recording-sessions":
+				epf = toolCallRecordingsListToolCallRecordingSessionsFlags
+
+			case "export-tool-call-recordings":
+				epf = toolCallRecordingsExportToolCallRecordingsFlags
+
+			case "replay-tool-call-recordings":
+				epf = toolCallRecordingsReplayToolCallRecordingsFlags
+
+			}
+
+		case "tool-constraints":
+			switch epn {
+			case "create-tool-constraint":
+				epf = toolConstraintsCreateToolConstraintFlags
+
+			case "list-tool-constraints":
+				epf = toolConstraintsListToolConstraintsFlags
+
+			case "update-tool-constraint":
+				epf = toolConstraintsUpdateToolConstraintFlags
+
+			case "delete-tool-constraint":
+				epf = toolConstraintsDeleteToolConstraintFlags
+
+			}
+
+		case "tool-rate-limits":
+			switch epn {
+			case "create-tool-rate-limit":
+				epf = toolRateLimitsCreateToolRateLimitFlags
+
+			case "list-tool-rate-limits":
+				epf = toolRateLimitsListToolRateLimitsFlags
+
+			case "update-tool-rate-limit":
+				epf = toolRateLimitsUpdateToolRateLimitFlags
+
+			case "delete-tool-rate-limit":
+				epf = toolRateLimitsDeleteToolRateLimitFlags
+
+			}
+
 		case "tools":
 			switch epn {
 			case "list-tools":
@@ -6538,6 +7020,12 @@ func ParseEndpoint(
 			case "set-tool-variations-group":
 				epf = toolsetsSetToolVariationsGroupFlags
 
+			case "diff-toolset-versions":
+				epf = toolsetsDiffToolsetVersionsFlags
+
+			case "revert-toolset":
+				epf = toolsetsRevertToolsetFlags
+
 			}
 
 		case "triggers":
@@ -6753,6 +7241,28 @@ func ParseEndpoint(
 
 			}
 
+		case "webhook-endpoints":
+			switch epn {
+			case "create-endpoint":
+				epf = webhookEndpointsCreateEndpointFlags
+
+			case "list-endpoints":
+				epf = webhookEndpointsListEndpointsFlags
+
+			case "update-endpoint":
+				epf = webhookEndpointsUpdateEndpointFlags
+
+			case "delete-endpoint":
+				epf = webhookEndpointsDeleteEndpointFlags
+
+			case "list-deliveries":
+				epf = webhookEndpointsListDeliveriesFlags
+
+			case "redeliver":
+				epf = webhookEndpointsRedeliverFlags
+
+			}
+
 		case "variations":
 			switch epn {
 			case "upsert-global":
@@ -7089,6 +7599,19 @@ func ParseEndpoint(
 				endpoint = c.EnsureManagedAssistant()
 				data, err = assistantsc.BuildEnsureManagedAssistantPayload(*assistantsEnsureManagedAssistantSessionTokenFlag, *assistantsEnsureManagedAssistantProjectSlugInputFlag)
 			}
+		case "audit-log-exports":
+			c := auditlogexportsc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "get-config":
+				endpoint = c.GetConfig()
+				data, err = auditlogexportsc.BuildGetConfigPayload(*auditLogExportsGetConfigApikeyTokenFlag, *auditLogExportsGetConfigSessionTokenFlag)
+			case "upsert-config":
+				endpoint = c.UpsertConfig()
+				data, err = auditlogexportsc.BuildUpsertConfigPayload(*auditLogExportsUpsertConfigBodyFlag, *auditLogExportsUpsertConfigApikeyTokenFlag, *auditLogExportsUpsertConfigSessionTokenFlag)
+			case "delete-config":
+				endpoint = c.DeleteConfig()
+				data, err = auditlogexportsc.BuildDeleteConfigPayload(*auditLogExportsDeleteConfigApikeyTokenFlag, *auditLogExportsDeleteConfigSessionTokenFlag)
+			}
 		case "auditlogs":
 			c := auditlogsc.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
@@ -7227,6 +7750,9 @@ func ParseEndpoint(
 			case "get-deployment-logs":
 				endpoint = c.GetDeploymentLogs()
 				data, err = deploymentsc.BuildGetDeploymentLogsPayload(*deploymentsGetDeploymentLogsDeploymentIDFlag, *deploymentsGetDeploymentLogsCursorFlag, *deploymentsGetDeploymentLogsApikeyTokenFlag, *deploymentsGetDeploymentLogsSessionTokenFlag, *deploymentsGetDeploymentLogsProjectSlugInputFlag)
+			case "diff-deployments":
+				endpoint = c.DiffDeployments()
+				data, err = deploymentsc.BuildDiffDeploymentsPayload(*deploymentsDiffDeploymentsFromIDFlag, *deploymentsDiffDeploymentsToIDFlag, *deploymentsDiffDeploymentsApikeyTokenFlag, *deploymentsDiffDeploymentsSessionTokenFlag, *deploymentsDiffDeploymentsProjectSlugInputFlag)
 			}
 		case "device-integrations":
 			c := deviceintegrationsc.NewClient(scheme, host, doer, enc, dec, restore)
@@ -7640,6 +8166,21 @@ func ParseEndpoint(
 			case "delete-mcp-endpoint":
 				endpoint = c.DeleteMcpEndpoint()
 				data, err = mcpendpointsc.BuildDeleteMcpEndpointPayload(*mcpEndpointsDeleteMcpEndpointIDFlag, *mcpEndpointsDeleteMcpEndpointSessionTokenFlag, *mcpEndpointsDeleteMcpEndpointApikeyTokenFlag, *mcpEndpointsDeleteMcpEndpointProjectSlugInputFlag)
+			case "start-mcp-endpoint-canary":
+				endpoint = c.StartMcpEndpointCanary()
+				data, err = mcpendpointsc.BuildStartMcpEndpointCanaryPayload(*mcpEndpointsStartMcpEndpointCanaryBodyFlag, *mcpEndpointsStartMcpEndpointCanarySessionTokenFlag, *mcpEndpointsStartMcpEndpointCanaryApikeyTokenFlag, *mcpEndpointsStartMcpEndpointCanaryProjectSlugInputFlag)
+			case "get-mcp-endpoint-canary":
+				endpoint = c.GetMcpEndpointCanary()
+				data, err = mcpendpointsc.BuildGetMcpEndpointCanaryPayload(*mcpEndpointsGetMcpEndpointCanaryMcpEndpointIDFlag, *mcpEndpointsGetMcpEndpointCanarySessionTokenFlag, *mcpEndpointsGetMcpEndpointCanaryApikeyTokenFlag, *mcpEndpointsGetMcpEndpointCanaryProjectSlugInputFlag)
+			case "update-mcp-endpoint-canary":
+				endpoint = c.UpdateMcpEndpointCanary()
+				data, err = mcpendpointsc.BuildUpdateMcpEndpointCanaryPayload(*mcpEndpointsUpdateMcpEndpointCanaryBodyFlag, *mcpEndpointsUpdateMcpEndpointCanarySessionTokenFlag, *mcpEndpointsUpdateMcpEndpointCanaryApikeyTokenFlag, *mcpEndpointsUpdateMcpEndpointCanaryProjectSlugInputFlag)
+			case "promote-mcp-endpoint-canary":
+				endpoint = c.PromoteMcpEndpointCanary()
+				data, err = mcpendpointsc.BuildPromoteMcpEndpointCanaryPayload(*mcpEndpointsPromoteMcpEndpointCanaryBodyFlag, *mcpEndpointsPromoteMcpEndpointCanarySessionTokenFlag, *mcpEndpointsPromoteMcpEndpointCanaryApikeyTokenFlag, *mcpEndpointsPromoteMcpEndpointCanaryProjectSlugInputFlag)
+			case "abort-mcp-endpoint-canary":
+				endpoint = c.AbortMcpEndpointCanary()
+				data, err = mcpendpointsc.BuildAbortMcpEndpointCanaryPayload(*mcpEndpointsAbortMcpEndpointCanaryBodyFlag, *mcpEndpointsAbortMcpEndpointCanarySessionTokenFlag, *mcpEndpointsAbortMcpEndpointCanaryApikeyTokenFlag, *mcpEndpointsAbortMcpEndpointCanaryProjectSlugInputFlag)
 			}
 		case "mcp-metadata":
 			c := mcpmetadatac.NewClient(scheme, host, doer, enc, dec, restore)
@@ -7815,6 +8356,18 @@ func ParseEndpoint(
 			case "delete-config":
 				endpoint = c.DeleteConfig()
 				data, err = otelforwardingc.BuildDeleteConfigPayload(*otelForwardingDeleteConfigApikeyTokenFlag, *otelForwardingDeleteConfigSessionTokenFlag)
+			case "list-destinations":
+				endpoint = c.ListDestinations()
+				data, err = otelforwardingc.BuildListDestinationsPayload(*otelForwardingListDestinationsApikeyTokenFlag, *otelForwardingListDestinationsSessionTokenFlag)
+			case "create-destination":
+				endpoint = c.CreateDestination()
+				data, err = otelforwardingc.BuildCreateDestinationPayload(*otelForwardingCreateDestinationBodyFlag, *otelForwardingCreateDestinationApikeyTokenFlag, *otelForwardingCreateDestinationSessionTokenFlag)
+			case "update-destination":
+				endpoint = c.UpdateDestination()
+				data, err = otelforwardingc.BuildUpdateDestinationPayload(*otelForwardingUpdateDestinationBodyFlag, *otelForwardingUpdateDestinationApikeyTokenFlag, *otelForwardingUpdateDestinationSessionTokenFlag)
+			case "delete-destination":
+				endpoint = c.DeleteDestination()
+				data, err = otelforwardingc.BuildDeleteDestinationPayload(*otelForwardingDeleteDestinationBodyFlag, *otelForwardingDeleteDestinationApikeyTokenFlag, *otelForwardingDeleteDestinationSessionTokenFlag)
 			}
 		case "packages":
 			c := packagesc.NewClient(scheme, host, doer, enc, dec, restore)
@@ -8548,6 +9101,9 @@ func ParseEndpoint(
 			case "preview-spend-rule":
 				endpoint = c.PreviewSpendRule()
 				data, err = spendrulesc.BuildPreviewSpendRulePayload(*spendRulesPreviewSpendRuleBodyFlag, *spendRulesPreviewSpendRuleApikeyTokenFlag, *spendRulesPreviewSpendRuleSessionTokenFlag, *spendRulesPreviewSpendRuleProjectSlugInputFlag)
+			case "simulate-spend-rule":
+				endpoint = c.SimulateSpendRule()
+				data, err = spendrulesc.BuildSimulateSpendRulePayload(*spendRulesSimulateSpendRuleBodyFlag, *spendRulesSimulateSpendRuleApikeyTokenFlag, *spendRulesSimulateSpendRuleSessionTokenFlag, *spendRulesSimulateSpendRuleProjectSlugInputFlag)
 			case "list-spend-rule-events":
 				endpoint = c.ListSpendRuleEvents()
 				data, err = spendrulesc.BuildListSpendRuleEventsPayload(*spendRulesListSpendRuleEventsRuleIDFlag, *spendRulesListSpendRuleEventsEventTypeFlag, *spendRulesListSpendRuleEventsCursorFlag, *spendRulesListSpendRuleEventsLimitFlag, *spendRulesListSpendRuleEventsApikeyTokenFlag, *spendRulesListSpendRuleEventsSessionTokenFlag, *spendRulesListSpendRuleEventsProjectSlugInputFlag)
@@ -8557,6 +9113,9 @@ func ParseEndpoint(
 			case "list-actor-attributes":
 				endpoint = c.ListActorAttributes()
 				data, err = spendrulesc.BuildListActorAttributesPayload(*spendRulesListActorAttributesApikeyTokenFlag, *spendRulesListActorAttributesSessionTokenFlag, *spendRulesListActorAttributesProjectSlugInputFlag)
+			case "list-usage-dimensions":
+				endpoint = c.ListUsageDimensions()
+				data, err = spendrulesc.BuildListUsageDimensionsPayload(*spendRulesListUsageDimensionsApikeyTokenFlag, *spendRulesListUsageDimensionsSessionTokenFlag, *spendRulesListUsageDimensionsProjectSlugInputFlag)
 			}
 		case "telemetry":
 			c := telemetryc.NewClient(scheme, host, doer, enc, dec, restore)
@@ -8658,6 +9217,22 @@ func ParseEndpoint(
 				endpoint = c.ListHooksTraces()
 				data, err = telemetryc.BuildListHooksTracesPayload(*telemetryListHooksTracesBodyFlag, *telemetryListHooksTracesApikeyTokenFlag, *telemetryListHooksTracesSessionTokenFlag, *telemetryListHooksTracesProjectSlugInputFlag)
 			}
+		case "telemetry-alerts":
+			c := telemetryalertsc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create-telemetry-alert-rule":
+				endpoint = c.CreateTelemetryAlertRule()
+				data, err = telemetryalertsc.BuildCreateTelemetryAlertRulePayload(*telemetryAlertsCreateTelemetryAlertRuleBodyFlag, *telemetryAlertsCreateTelemetryAlertRuleSessionTokenFlag, *telemetryAlertsCreateTelemetryAlertRuleApikeyTokenFlag, *telemetryAlertsCreateTelemetryAlertRuleProjectSlugInputFlag)
+			case "list-telemetry-alert-rules":
+				endpoint = c.ListTelemetryAlertRules()
+				data, err = telemetryalertsc.BuildListTelemetryAlertRulesPayload(*telemetryAlertsListTelemetryAlertRulesToolsetIDFlag, *telemetryAlertsListTelemetryAlertRulesSessionTokenFlag, *telemetryAlertsListTelemetryAlertRulesApikeyTokenFlag, *telemetryAlertsListTelemetryAlertRulesProjectSlugInputFlag)
+			case "update-telemetry-alert-rule":
+				endpoint = c.UpdateTelemetryAlertRule()
+				data, err = telemetryalertsc.BuildUpdateTelemetryAlertRulePayload(*telemetryAlertsUpdateTelemetryAlertRuleBodyFlag, *telemetryAlertsUpdateTelemetryAlertRuleSessionTokenFlag, *telemetryAlertsUpdateTelemetryAlertRuleApikeyTokenFlag, *telemetryAlertsUpdateTelemetryAlertRuleProjectSlugInputFlag)
+			case "delete-telemetry-alert-rule":
+				endpoint = c.DeleteTelemetryAlertRule()
+				data, err = telemetryalertsc.BuildDeleteTelemetryAlertRulePayload(*telemetryAlertsDeleteTelemetryAlertRuleIDFlag, *telemetryAlertsDeleteTelemetryAlertRuleSessionTokenFlag, *telemetryAlertsDeleteTelemetryAlertRuleApikeyTokenFlag, *telemetryAlertsDeleteTelemetryAlertRuleProjectSlugInputFlag)
+			}
 		case "templates":
 			c := templatesc.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
@@ -8690,6 +9265,73 @@ func ParseEndpoint(
 				endpoint = c.Exchange()
 				data, err = tokenexchangec.BuildExchangePayload(*tokenExchangeExchangeBodyFlag, *tokenExchangeExchangeApikeyTokenFlag)
 			}
+		case "tool-approvals":
+			c := toolapprovalsc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create-tool-approval-policy":
+				endpoint = c.CreateToolApprovalPolicy()
+				data, err = toolapprovalsc.BuildCreateToolApprovalPolicyPayload(*toolApprovalsCreateToolApprovalPolicyBodyFlag, *toolApprovalsCreateToolApprovalPolicySessionTokenFlag, *toolApprovalsCreateToolApprovalPolicyApikeyTokenFlag, *toolApprovalsCreateToolApprovalPolicyProjectSlugInputFlag)
+			case "list-tool-approval-policies":
+				endpoint = c.ListToolApprovalPolicies()
+				data, err = toolapprovalsc.BuildListToolApprovalPoliciesPayload(*toolApprovalsListToolApprovalPoliciesToolsetIDFlag, *toolApprovalsListToolApprovalPoliciesMcpServerIDFlag, *toolApprovalsListToolApprovalPoliciesSessionTokenFlag, *toolApprovalsListToolApprovalPoliciesApikeyTokenFlag, *toolApprovalsListToolApprovalPoliciesProjectSlugInputFlag)
+			case "update-tool-approval-policy":
+				endpoint = c.UpdateToolApprovalPolicy()
+				data, err = toolapprovalsc.BuildUpdateToolApprovalPolicyPayload(*toolApprovalsUpdateToolApprovalPolicyBodyFlag, *toolApprovalsUpdateToolApprovalPolicySessionTokenFlag, *toolApprovalsUpdateToolApprovalPolicyApikeyTokenFlag, *toolApprovalsUpdateToolApprovalPolicyProjectSlugInputFlag)
+			case "delete-tool-approval-policy":
+				endpoint = c.DeleteToolApprovalPolicy()
+				data, err = toolapprovalsc.BuildDeleteToolApprovalPolicyPayload(*toolApprovalsDeleteToolApprovalPolicyIDFlag, *toolApprovalsDeleteToolApprovalPolicySessionTokenFlag, *toolApprovalsDeleteToolApprovalPolicyApikeyTokenFlag, *toolApprovalsDeleteToolApprovalPolicyProjectSlugInputFlag)
+			}
+		case "tool-call-recordings":
+			c := toolcallrecordingsc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "start-tool-call-recording":
+				endpoint = c.StartToolCallRecording()
+				data, err = toolcallrecordingsc.BuildStartToolCallRecordingPayload(*toolCallRecordingsStartToolCallRecordingBodyFlag, *toolCallRecordingsStartToolCallRecordingSessionTokenFlag, *toolCallRecordingsStartToolCallRecordingApikeyTokenFlag, *toolCallRecordingsStartToolCallRecordingProjectSlugInputFlag)
+			case "stop-tool-call-recording":
+				endpoint = c.StopToolCallRecording()
+				data, err = toolcallrecordingsc.BuildStopToolCallRecordingPayload(*toolCallRecordingsStopToolCallRecordingBodyFlag, *toolCallRecordingsStopToolCallRecordingSessionTokenFlag, *toolCallRecordingsStopToolCallRecordingApikeyTokenFlag, *toolCallRecordingsStopToolCallRecordingProjectSlugInputFlag)
+			case "list-tool-call-recording-sessions":
+				endpoint = c.ListToolCallRecordingSessions()
+				data, err = toolcallrecordingsc.BuildListToolCallRecordingSessionsPayload(*toolCallRecordingsListToolCallRecordingSessionsToolsetSlugFlag, *toolCallRecordingsListToolCallRecordingSessionsSessionTokenFlag, *toolCallRecordingsListToolCallRecordingSessionsApikeyTokenFlag, *toolCallRecordingsListToolCallRecordingSessionsProjectSlugInputFlag)
+			case "export-tool-call-recordings":
+				endpoint = c.ExportToolCallRecordings()
+				data, err = toolcallrecordingsc.BuildExportToolCallRecordingsPayload(*toolCallRecordingsExportToolCallRecordingsSessionIDFlag, *toolCallRecordingsExportToolCallRecordingsSessionTokenFlag, *toolCallRecordingsExportToolCallRecordingsApikeyTokenFlag, *toolCallRecordingsExportToolCallRecordingsProjectSlugInputFlag)
+			case "replay-tool-call-recordings":
+				endpoint = c.ReplayToolCallRecordings()
+				data, err = toolcallrecordingsc.BuildReplayToolCallRecordingsPayload(*toolCallRecordingsReplayToolCallRecordingsBodyFlag, *toolCallRecordingsReplayToolCallRecordingsSessionTokenFlag, *toolCallRecordingsReplayToolCallRecordingsApikeyTokenFlag, *toolCallRecordingsReplayToolCallRecordingsProjectSlugInputFlag)
+			}
+		case "tool-constraints":
+			c := toolconstraintsc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create-tool-constraint":
+				endpoint = c.CreateToolConstraint()
+				data, err = toolconstraintsc.BuildCreateToolConstraintPayload(*toolConstraintsCreateToolConstraintBodyFlag, *toolConstraintsCreateToolConstraintSessionTokenFlag, *toolConstraintsCreateToolConstraintApikeyTokenFlag, *toolConstraintsCreateToolConstraintProjectSlugInputFlag)
+			case "list-tool-constraints":
+				endpoint = c.ListToolConstraints()
+				data, err = toolconstraintsc.BuildListToolConstraintsPayload(*toolConstraintsListToolConstraintsToolsetIDFlag, *toolConstraintsListToolConstraintsMcpServerIDFlag, *toolConstraintsListToolConstraintsSessionTokenFlag, *toolConstraintsListToolConstraintsApikeyTokenFlag, *toolConstraintsListToolConstraintsProjectSlugInputFlag)
+			case "update-tool-constraint":
+				endpoint = c.UpdateToolConstraint()
+				data, err = toolconstraintsc.BuildUpdateToolConstraintPayload(*toolConstraintsUpdateToolConstraintBodyFlag, *toolConstraintsUpdateToolConstraintSessionTokenFlag, *toolConstraintsUpdateToolConstraintApikeyTokenFlag, *toolConstraintsUpdateToolConstraintProjectSlugInputFlag)
+			case "delete-tool-constraint":
+				endpoint = c.DeleteToolConstraint()
+				data, err = toolconstraintsc.BuildDeleteToolConstraintPayload(*toolConstraintsDeleteToolConstraintIDFlag, *toolConstraintsDeleteToolConstraintSessionTokenFlag, *toolConstraintsDeleteToolConstraintApikeyTokenFlag, *toolConstraintsDeleteToolConstraintProjectSlugInputFlag)
+			}
+		case "tool-rate-limits":
+			c := toolratelimitsc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create-tool-rate-limit":
+				endpoint = c.CreateToolRateLimit()
+				data, err = toolratelimitsc.BuildCreateToolRateLimitPayload(*toolRateLimitsCreateToolRateLimitBodyFlag, *toolRateLimitsCreateToolRateLimitSessionTokenFlag, *toolRateLimitsCreateToolRateLimitApikeyTokenFlag, *toolRateLimitsCreateToolRateLimitProjectSlugInputFlag)
+			case "list-tool-rate-limits":
+				endpoint = c.ListToolRateLimits()
+				data, err = toolratelimitsc.BuildListToolRateLimitsPayload(*toolRateLimitsListToolRateLimitsToolsetIDFlag, *toolRateLimitsListToolRateLimitsMcpServerIDFlag, *toolRateLimitsListToolRateLimitsSessionTokenFlag, *toolRateLimitsListToolRateLimitsApikeyTokenFlag, *toolRateLimitsListToolRateLimitsProjectSlugInputFlag)
+			case "update-tool-rate-limit":
+				endpoint = c.UpdateToolRateLimit()
+				data, err = toolratelimitsc.BuildUpdateToolRateLimitPayload(*toolRateLimitsUpdateToolRateLimitBodyFlag, *toolRateLimitsUpdateToolRateLimitSessionTokenFlag, *toolRateLimitsUpdateToolRateLimitApikeyTokenFlag, *toolRateLimitsUpdateToolRateLimitProjectSlugInputFlag)
+			case "delete-tool-rate-limit":
+				endpoint = c.DeleteToolRateLimit()
+				data, err = toolratelimitsc.BuildDeleteToolRateLimitPayload(*toolRateLimitsDeleteToolRateLimitIDFlag, *toolRateLimitsDeleteToolRateLimitSessionTokenFlag, *toolRateLimitsDeleteToolRateLimitApikeyTokenFlag, *toolRateLimitsDeleteToolRateLimitProjectSlugInputFlag)
+			}
 		case "tools":
 			c := toolsc.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
@@ -8739,6 +9381,12 @@ func ParseEndpoint(
 			case "set-tool-variations-group":
 				endpoint = c.SetToolVariationsGroup()
 				data, err = toolsetsc.BuildSetToolVariationsGroupPayload(*toolsetsSetToolVariationsGroupBodyFlag, *toolsetsSetToolVariationsGroupSlugFlag, *toolsetsSetToolVariationsGroupSessionTokenFlag, *toolsetsSetToolVariationsGroupApikeyTokenFlag, *toolsetsSetToolVariationsGroupProjectSlugInputFlag)
+			case "diff-toolset-versions":
+				endpoint = c.DiffToolsetVersions()
+				data, err = toolsetsc.BuildDiffToolsetVersionsPayload(*toolsetsDiffToolsetVersionsSlugFlag, *toolsetsDiffToolsetVersionsFromVersionFlag, *toolsetsDiffToolsetVersionsToVersionFlag, *toolsetsDiffToolsetVersionsSessionTokenFlag, *toolsetsDiffToolsetVersionsApikeyTokenFlag, *toolsetsDiffToolsetVersionsProjectSlugInputFlag)
+			case "revert-toolset":
+				endpoint = c.RevertToolset()
+				data, err = toolsetsc.BuildRevertToolsetPayload(*toolsetsRevertToolsetBodyFlag, *toolsetsRevertToolsetSlugFlag, *toolsetsRevertToolsetSessionTokenFlag, *toolsetsRevertToolsetApikeyTokenFlag, *toolsetsRevertToolsetProjectSlugInputFlag)
 			}
 		case "triggers":
 			c := triggersc.NewClient(scheme, host, doer, enc, dec, restore)
@@ -8952,6 +9600,28 @@ func ParseEndpoint(
 				endpoint = c.RevokeUserSession()
 				data, err = usersessionsc.BuildRevokeUserSessionPayload(*userSessionsRevokeUserSessionIDFlag, *userSessionsRevokeUserSessionSessionTokenFlag, *userSessionsRevokeUserSessionApikeyTokenFlag, *userSessionsRevokeUserSessionProjectSlugInputFlag)
 			}
+		case "webhook-endpoints":
+			c := webhookendpointsc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create-endpoint":
+				endpoint = c.CreateEndpoint()
+				data, err = webhookendpointsc.BuildCreateEndpointPayload(*webhookEndpointsCreateEndpointBodyFlag, *webhookEndpointsCreateEndpointSessionTokenFlag)
+			case "list-endpoints":
+				endpoint = c.ListEndpoints()
+				data, err = webhookendpointsc.BuildListEndpointsPayload(*webhookEndpointsListEndpointsSessionTokenFlag)
+			case "update-endpoint":
+				endpoint = c.UpdateEndpoint()
+				data, err = webhookendpointsc.BuildUpdateEndpointPayload(*webhookEndpointsUpdateEndpointBodyFlag, *webhookEndpointsUpdateEndpointSessionTokenFlag)
+			case "delete-endpoint":
+				endpoint = c.DeleteEndpoint()
+				data, err = webhookendpointsc.BuildDeleteEndpointPayload(*webhookEndpointsDeleteEndpointIDFlag, *webhookEndpointsDeleteEndpointSessionTokenFlag)
+			case "list-deliveries":
+				endpoint = c.ListDeliveries()
+				data, err = webhookendpointsc.BuildListDeliveriesPayload(*webhookEndpointsListDeliveriesEndpointIDFlag, *webhookEndpointsListDeliveriesDeliveryStatusFlag, *webhookEndpointsListDeliveriesCursorFlag, *webhookEndpointsListDeliveriesLimitFlag, *webhookEndpointsListDeliveriesSessionTokenFlag)
+			case "redeliver":
+				endpoint = c.Redeliver()
+				data, err = webhookendpointsc.BuildRedeliverPayload(*webhookEndpointsRedeliverBodyFlag, *webhookEndpointsRedeliverSessionTokenFlag)
+			}
 		case "variations":
 			c := variationsc.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
@@ -10944,6 +11614,81 @@ func assistantsEnsureManagedAssistantUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "assistants ensure-managed-assistant --session-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
+// auditLogExportsUsage displays the usage of the audit-log-exports command and
+// its subcommands.
+func auditLogExportsUsage() {
+	fmt.Fprintln(os.Stderr, `Manage per-organization streaming export of audit logs to object storage.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] audit-log-exports COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    get-config: Get the organization's audit log export config. Returns an empty config (enabled=false) when none is set.`)
+	fmt.Fprintln(os.Stderr, `    upsert-config: Create or update the organization's audit log export config. A new export starts after the latest audit event unless resume_after_seq is given.`)
+	fmt.Fprintln(os.Stderr, `    delete-config: Delete the organization's audit log export config. Objects already exported are left in place.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s audit-log-exports COMMAND --help\n", os.Args[0])
+}
+func auditLogExportsGetConfigUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] audit-log-exports get-config", os.Args[0])
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get the organization's audit log export config. Returns an empty config (enabled=false) when none is set.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "audit-log-exports get-config --apikey-token \"abc123\" --session-token \"abc123\"")
+}
+
+func auditLogExportsUpsertConfigUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] audit-log-exports upsert-config", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create or update the organization's audit log export config. A new export starts after the latest audit event unless resume_after_seq is given.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "audit-log-exports upsert-config --body '{\n      \"enabled\": false,\n      \"format\": \"parquet\",\n      \"prefix\": \"aaa\",\n      \"resume_after_seq\": 1\n   }' --apikey-token \"abc123\" --session-token \"abc123\"")
+}
+
+func auditLogExportsDeleteConfigUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] audit-log-exports delete-config", os.Args[0])
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete the organization's audit log export config. Objects already exported are left in place.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "audit-log-exports delete-config --apikey-token \"abc123\" --session-token \"abc123\"")
+}
+
 // auditlogsUsage displays the usage of the auditlogs command and its
 // subcommands.
 func auditlogsUsage() {
@@ -11742,6 +12487,7 @@ func deploymentsUsage() {
 	fmt.Fprintln(os.Stderr, `    redeploy: Redeploys an existing deployment.`)
 	fmt.Fprintln(os.Stderr, `    list-deployments: List all deployments in descending order of creation.`)
 	fmt.Fprintln(os.Stderr, `    get-deployment-logs: Get logs for a deployment.`)
+	fmt.Fprintln(os.Stderr, `    diff-deployments: Compare the tools of two deployments and classify each change as additive or breaking.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s deployments COMMAND --help\n", os.Args[0])
@@ -11837,7 +12583,7 @@ func deploymentsCreateDeploymentUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "deployments create-deployment --body '{\n      \"external_id\": \"bc5f4a555e933e6861d12edba4c2d87ef6caf8e6\",\n      \"external_mcps\": [\n         {\n            \"name\": \"My Slack Integration\",\n            \"organization_mcp_collection_registry_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n            \"registry_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n            \"registry_server_specifier\": \"slack\",\n            \"selected_remotes\": [\n               \"https://mcp.example.com/sse\"\n            ],\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"external_url\": \"abc123\",\n      \"functions\": [\n         {\n            \"asset_id\": \"abc123\",\n            \"memory_mib\": 1,\n            \"name\": \"abc123\",\n            \"runtime\": \"abc123\",\n            \"scale\": 1,\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"github_pr\": \"1234\",\n      \"github_repo\": \"speakeasyapi/gram\",\n      \"github_sha\": \"f33e693e9e12552043bc0ec5c37f1b8a9e076161\",\n      \"non_blocking\": false,\n      \"openapiv3_assets\": [\n         {\n            \"asset_id\": \"abc123\",\n            \"name\": \"abc123\",\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"packages\": [\n         {\n            \"name\": \"abc123\",\n            \"version\": \"abc123\"\n         }\n      ]\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\" --idempotency-key \"01jqq0ajmb4qh9eppz48dejr2m\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "deployments create-deployment --body '{\n      \"external_id\": \"bc5f4a555e933e6861d12edba4c2d87ef6caf8e6\",\n      \"external_mcps\": [\n         {\n            \"name\": \"My Slack Integration\",\n            \"organization_mcp_collection_registry_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n            \"registry_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n            \"registry_server_specifier\": \"slack\",\n            \"selected_remotes\": [\n               \"https://mcp.example.com/sse\"\n            ],\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"external_url\": \"abc123\",\n      \"fail_on_breaking\": false,\n      \"functions\": [\n         {\n            \"asset_id\": \"abc123\",\n            \"memory_mib\": 1,\n            \"name\": \"abc123\",\n            \"runtime\": \"abc123\",\n            \"scale\": 1,\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"github_pr\": \"1234\",\n      \"github_repo\": \"speakeasyapi/gram\",\n      \"github_sha\": \"f33e693e9e12552043bc0ec5c37f1b8a9e076161\",\n      \"non_blocking\": false,\n      \"openapiv3_assets\": [\n         {\n            \"asset_id\": \"abc123\",\n            \"name\": \"abc123\",\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"packages\": [\n         {\n            \"name\": \"abc123\",\n            \"version\": \"abc123\"\n         }\n      ]\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\" --idempotency-key \"01jqq0ajmb4qh9eppz48dejr2m\"")
 }
 
 func deploymentsEvolveUsage() {
@@ -11861,7 +12607,7 @@ func deploymentsEvolveUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "deployments evolve --body '{\n      \"deployment_id\": \"abc123\",\n      \"exclude_external_mcps\": [\n         \"abc123\"\n      ],\n      \"exclude_functions\": [\n         \"abc123\"\n      ],\n      \"exclude_openapiv3_assets\": [\n         \"abc123\"\n      ],\n      \"exclude_packages\": [\n         \"abc123\"\n      ],\n      \"non_blocking\": false,\n      \"upsert_external_mcps\": [\n         {\n            \"name\": \"My Slack Integration\",\n            \"organization_mcp_collection_registry_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n            \"registry_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n            \"registry_server_specifier\": \"slack\",\n            \"selected_remotes\": [\n               \"https://mcp.example.com/sse\"\n            ],\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"upsert_functions\": [\n         {\n            \"asset_id\": \"abc123\",\n            \"memory_mib\": 1,\n            \"name\": \"abc123\",\n            \"runtime\": \"abc123\",\n            \"scale\": 1,\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"upsert_openapiv3_assets\": [\n         {\n            \"asset_id\": \"abc123\",\n            \"name\": \"abc123\",\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"upsert_packages\": [\n         {\n            \"name\": \"abc123\",\n            \"version\": \"abc123\"\n         }\n      ]\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "deployments evolve --body '{\n      \"deployment_id\": \"abc123\",\n      \"exclude_external_mcps\": [\n         \"abc123\"\n      ],\n      \"exclude_functions\": [\n         \"abc123\"\n      ],\n      \"exclude_openapiv3_assets\": [\n         \"abc123\"\n      ],\n      \"exclude_packages\": [\n         \"abc123\"\n      ],\n      \"fail_on_breaking\": false,\n      \"non_blocking\": false,\n      \"upsert_external_mcps\": [\n         {\n            \"name\": \"My Slack Integration\",\n            \"organization_mcp_collection_registry_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n            \"registry_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n            \"registry_server_specifier\": \"slack\",\n            \"selected_remotes\": [\n               \"https://mcp.example.com/sse\"\n            ],\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"upsert_functions\": [\n         {\n            \"asset_id\": \"abc123\",\n            \"memory_mib\": 1,\n            \"name\": \"abc123\",\n            \"runtime\": \"abc123\",\n            \"scale\": 1,\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"upsert_openapiv3_assets\": [\n         {\n            \"asset_id\": \"abc123\",\n            \"name\": \"abc123\",\n            \"slug\": \"aaa\"\n         }\n      ],\n      \"upsert_packages\": [\n         {\n            \"name\": \"abc123\",\n            \"version\": \"abc123\"\n         }\n      ]\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
 func deploymentsRedeployUsage() {
@@ -11938,6 +12684,32 @@ func deploymentsGetDeploymentLogsUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "deployments get-deployment-logs --deployment-id \"abc123\" --cursor \"abc123\" --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
+func deploymentsDiffDeploymentsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] deployments diff-deployments", os.Args[0])
+	fmt.Fprint(os.Stderr, " -from-id STRING")
+	fmt.Fprint(os.Stderr, " -to-id STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Compare the tools of two deployments and classify each change as additive or breaking.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -from-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -to-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "deployments diff-deployments --from-id \"abc123\" --to-id \"abc123\" --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
 // deviceIntegrationsUsage displays the usage of the device-integrations
 // command and its subcommands.
 func deviceIntegrationsUsage() {
@@ -14563,6 +15335,11 @@ func mcpEndpointsUsage() {
 	fmt.Fprintln(os.Stderr, `    update-mcp-endpoint: Update an MCP endpoint. This is a full-record replace: fields omitted from the request become null on the stored record. The id and slug fields are required, along with exactly one of mcp_server_id or meta_mcp_server_id.`)
 	fmt.Fprintln(os.Stderr, `    check-mcp-endpoint-slug-availability: Check whether an MCP endpoint slug is available. The uniqueness scope depends on whether a custom_domain_id is provided: platform-domain slugs are checked across all platform-domain endpoints (custom_domain_id IS NULL); custom-domain slugs are checked within the (custom_domain_id, slug) pair. Returns true when the slug is free.`)
 	fmt.Fprintln(os.Stderr, `    delete-mcp-endpoint: Delete an MCP endpoint`)
+	fmt.Fprintln(os.Stderr, `    start-mcp-endpoint-canary: Start a canary on a toolset-backed MCP endpoint. A percentage of the endpoint's tool calls are served from the candidate deployment while the rest stay on the baseline. Callers are assigned sticky by Mcp-Session-Id or by user. Only one canary can run per endpoint.`)
+	fmt.Fprintln(os.Stderr, `    get-mcp-endpoint-canary: Get the most recent canary for an MCP endpoint, including per-arm tool call error rate and latency.`)
+	fmt.Fprintln(os.Stderr, `    update-mcp-endpoint-canary: Change the share of tool calls a running canary sends to its candidate deployment.`)
+	fmt.Fprintln(os.Stderr, `    promote-mcp-endpoint-canary: Promote a running canary. The endpoint stops splitting traffic and follows the project's active deployment, which must be the canary's candidate.`)
+	fmt.Fprintln(os.Stderr, `    abort-mcp-endpoint-canary: Abort a running canary. Every tool call on the endpoint is served from the baseline deployment until a deployment newer than the candidate completes.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s mcp-endpoints COMMAND --help\n", os.Args[0])
@@ -14588,7 +15365,7 @@ func mcpEndpointsCreateMcpEndpointUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-endpoints create-mcp-endpoint --body '{\n      \"custom_domain_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"meta_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"slug\": \"aaa\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-endpoints create-mcp-endpoint --body '{\n      \"custom_domain_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"meta_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"slug\": \"aaa\",\n      \"toolset_version\": 2\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
 func mcpEndpointsGetMcpEndpointUsage() {
@@ -14666,7 +15443,7 @@ func mcpEndpointsUpdateMcpEndpointUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-endpoints update-mcp-endpoint --body '{\n      \"custom_domain_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"meta_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"slug\": \"aaa\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-endpoints update-mcp-endpoint --body '{\n      \"custom_domain_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"meta_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"slug\": \"aaa\",\n      \"toolset_version\": 2\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
 func mcpEndpointsCheckMcpEndpointSlugAvailabilityUsage() {
@@ -14719,101 +15496,221 @@ func mcpEndpointsDeleteMcpEndpointUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-endpoints delete-mcp-endpoint --id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-// mcpMetadataUsage displays the usage of the mcp-metadata command and its
-// subcommands.
-func mcpMetadataUsage() {
-	fmt.Fprintln(os.Stderr, `Manages metadata for the MCP install page shown to users.`)
-	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] mcp-metadata COMMAND [flags]\n\n", os.Args[0])
-	fmt.Fprintln(os.Stderr, "COMMAND:")
-	fmt.Fprintln(os.Stderr, `    get-mcp-metadata: Fetch the metadata that powers the MCP install page. Exactly one of toolset_slug or mcp_server_id must be provided.`)
-	fmt.Fprintln(os.Stderr, `    set-mcp-metadata: Create or update the metadata that powers the MCP install page. Exactly one of toolset_slug or mcp_server_id must be provided.`)
-	fmt.Fprintln(os.Stderr, `    export-mcp-metadata: Export MCP server details as JSON for documentation and integration purposes.`)
+func mcpEndpointsStartMcpEndpointCanaryUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] mcp-endpoints start-mcp-endpoint-canary", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, "Additional help:")
-	fmt.Fprintf(os.Stderr, "    %s mcp-metadata COMMAND --help\n", os.Args[0])
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Start a canary on a toolset-backed MCP endpoint. A percentage of the endpoint's tool calls are served from the candidate deployment while the rest stay on the baseline. Callers are assigned sticky by Mcp-Session-Id or by user. Only one canary can run per endpoint.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-endpoints start-mcp-endpoint-canary --body '{\n      \"baseline_deployment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"candidate_deployment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"mcp_endpoint_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"percentage\": 1,\n      \"sticky_by\": \"user\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
-func mcpMetadataGetMcpMetadataUsage() {
+
+func mcpEndpointsGetMcpEndpointCanaryUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] mcp-metadata get-mcp-metadata", os.Args[0])
-	fmt.Fprint(os.Stderr, " -toolset-slug STRING")
-	fmt.Fprint(os.Stderr, " -mcp-server-id STRING")
-	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] mcp-endpoints get-mcp-endpoint-canary", os.Args[0])
+	fmt.Fprint(os.Stderr, " -mcp-endpoint-id STRING")
 	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Fetch the metadata that powers the MCP install page. Exactly one of toolset_slug or mcp_server_id must be provided.`)
+	fmt.Fprintln(os.Stderr, `Get the most recent canary for an MCP endpoint, including per-arm tool call error rate and latency.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -toolset-slug STRING: `)
-	fmt.Fprintln(os.Stderr, `    -mcp-server-id STRING: `)
-	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -mcp-endpoint-id STRING: `)
 	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-metadata get-mcp-metadata --toolset-slug \"aaa\" --mcp-server-id \"550e8400-e29b-41d4-a716-446655440000\" --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-endpoints get-mcp-endpoint-canary --mcp-endpoint-id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-func mcpMetadataSetMcpMetadataUsage() {
+func mcpEndpointsUpdateMcpEndpointCanaryUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] mcp-metadata set-mcp-metadata", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] mcp-endpoints update-mcp-endpoint-canary", os.Args[0])
 	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Create or update the metadata that powers the MCP install page. Exactly one of toolset_slug or mcp_server_id must be provided.`)
+	fmt.Fprintln(os.Stderr, `Change the share of tool calls a running canary sends to its candidate deployment.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-metadata set-mcp-metadata --body '{\n      \"default_environment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"environment_configs\": [\n         {\n            \"header_display_name\": \"abc123\",\n            \"provided_by\": \"abc123\",\n            \"variable_name\": \"abc123\"\n         }\n      ],\n      \"external_documentation_text\": \"abc123\",\n      \"external_documentation_url\": \"abc123\",\n      \"installation_override_url\": \"https://example.com/foo\",\n      \"instructions\": \"abc123\",\n      \"logo_asset_id\": \"abc123\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"toolset_slug\": \"aaa\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-endpoints update-mcp-endpoint-canary --body '{\n      \"mcp_endpoint_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"percentage\": 1\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-func mcpMetadataExportMcpMetadataUsage() {
+func mcpEndpointsPromoteMcpEndpointCanaryUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] mcp-metadata export-mcp-metadata", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] mcp-endpoints promote-mcp-endpoint-canary", os.Args[0])
 	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Export MCP server details as JSON for documentation and integration purposes.`)
+	fmt.Fprintln(os.Stderr, `Promote a running canary. The endpoint stops splitting traffic and follows the project's active deployment, which must be the canary's candidate.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-metadata export-mcp-metadata --body '{\n      \"mcp_slug\": \"aaa\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-endpoints promote-mcp-endpoint-canary --body '{\n      \"mcp_endpoint_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-// mcpServersUsage displays the usage of the mcp-servers command and its
-// subcommands.
-func mcpServersUsage() {
-	fmt.Fprintln(os.Stderr, `Managing MCP servers, which configure authentication, environment, and backend selection for an MCP server.`)
-	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] mcp-servers COMMAND [flags]\n\n", os.Args[0])
-	fmt.Fprintln(os.Stderr, "COMMAND:")
-	fmt.Fprintln(os.Stderr, `    create-mcp-server: Create a new MCP server`)
-	fmt.Fprintln(os.Stderr, `    get-mcp-server: Get an MCP server by ID or slug. Exactly one of id or slug must be provided.`)
+func mcpEndpointsAbortMcpEndpointCanaryUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] mcp-endpoints abort-mcp-endpoint-canary", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Abort a running canary. Every tool call on the endpoint is served from the baseline deployment until a deployment newer than the candidate completes.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-endpoints abort-mcp-endpoint-canary --body '{\n      \"mcp_endpoint_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+// mcpMetadataUsage displays the usage of the mcp-metadata command and its
+// subcommands.
+func mcpMetadataUsage() {
+	fmt.Fprintln(os.Stderr, `Manages metadata for the MCP install page shown to users.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] mcp-metadata COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    get-mcp-metadata: Fetch the metadata that powers the MCP install page. Exactly one of toolset_slug or mcp_server_id must be provided.`)
+	fmt.Fprintln(os.Stderr, `    set-mcp-metadata: Create or update the metadata that powers the MCP install page. Exactly one of toolset_slug or mcp_server_id must be provided.`)
+	fmt.Fprintln(os.Stderr, `    export-mcp-metadata: Export MCP server details as JSON for documentation and integration purposes.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s mcp-metadata COMMAND --help\n", os.Args[0])
+}
+func mcpMetadataGetMcpMetadataUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] mcp-metadata get-mcp-metadata", os.Args[0])
+	fmt.Fprint(os.Stderr, " -toolset-slug STRING")
+	fmt.Fprint(os.Stderr, " -mcp-server-id STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Fetch the metadata that powers the MCP install page. Exactly one of toolset_slug or mcp_server_id must be provided.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -toolset-slug STRING: `)
+	fmt.Fprintln(os.Stderr, `    -mcp-server-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-metadata get-mcp-metadata --toolset-slug \"aaa\" --mcp-server-id \"550e8400-e29b-41d4-a716-446655440000\" --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func mcpMetadataSetMcpMetadataUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] mcp-metadata set-mcp-metadata", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create or update the metadata that powers the MCP install page. Exactly one of toolset_slug or mcp_server_id must be provided.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-metadata set-mcp-metadata --body '{\n      \"default_environment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"environment_configs\": [\n         {\n            \"header_display_name\": \"abc123\",\n            \"provided_by\": \"abc123\",\n            \"variable_name\": \"abc123\"\n         }\n      ],\n      \"external_documentation_text\": \"abc123\",\n      \"external_documentation_url\": \"abc123\",\n      \"installation_override_url\": \"https://example.com/foo\",\n      \"instructions\": \"abc123\",\n      \"logo_asset_id\": \"abc123\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"toolset_slug\": \"aaa\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func mcpMetadataExportMcpMetadataUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] mcp-metadata export-mcp-metadata", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Export MCP server details as JSON for documentation and integration purposes.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-metadata export-mcp-metadata --body '{\n      \"mcp_slug\": \"aaa\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+// mcpServersUsage displays the usage of the mcp-servers command and its
+// subcommands.
+func mcpServersUsage() {
+	fmt.Fprintln(os.Stderr, `Managing MCP servers, which configure authentication, environment, and backend selection for an MCP server.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] mcp-servers COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    create-mcp-server: Create a new MCP server`)
+	fmt.Fprintln(os.Stderr, `    get-mcp-server: Get an MCP server by ID or slug. Exactly one of id or slug must be provided.`)
 	fmt.Fprintln(os.Stderr, `    list-mcp-servers: List MCP servers for a project. Accepts optional remote_mcp_server_id, tunneled_mcp_server_id, toolset_id, or unproxied_mcp_server_id filters to scope the result to a single backend; at most one filter may be supplied since the backends are mutually exclusive.`)
 	fmt.Fprintln(os.Stderr, `    list-mcp-servers-for-org: List all MCP servers across the organization`)
 	fmt.Fprintln(os.Stderr, `    update-mcp-server: Update an MCP server. This is a full-record replace for the optional UUID references: fields omitted from the request become null on the stored record. name is an exception — omitting it leaves the existing display name unchanged, while providing it requires a non-empty value and recomputes the server-side slug. The id and visibility fields are required; exactly one of remote_mcp_server_id, tunneled_mcp_server_id, or toolset_id must be provided.`)
@@ -14849,7 +15746,7 @@ func mcpServersCreateMcpServerUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-servers create-mcp-server --body '{\n      \"environment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"name\": \"abc123\",\n      \"remote_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"tool_variations_group_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"tunneled_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"unproxied_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"visibility\": \"private\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-servers create-mcp-server --body '{\n      \"environment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"name\": \"abc123\",\n      \"remote_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"tool_variations_group_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"toolset_version\": 2,\n      \"tunneled_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"unproxied_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"visibility\": \"private\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
 func mcpServersGetMcpServerUsage() {
@@ -14947,7 +15844,7 @@ func mcpServersUpdateMcpServerUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-servers update-mcp-server --body '{\n      \"environment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"name\": \"abc123\",\n      \"remote_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"tool_variations_group_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"tunneled_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"unproxied_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"visibility\": \"private\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "mcp-servers update-mcp-server --body '{\n      \"environment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"name\": \"abc123\",\n      \"remote_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"tool_variations_group_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"toolset_version\": 2,\n      \"tunneled_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"unproxied_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"visibility\": \"private\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
 func mcpServersListToolFiltersUsage() {
@@ -15822,6 +16719,10 @@ func otelForwardingUsage() {
 	fmt.Fprintln(os.Stderr, `    get-config: Get the org-wide OTEL forwarding config. Returns an empty config (enabled=false, no URL) when none is set.`)
 	fmt.Fprintln(os.Stderr, `    upsert-config: Create or update the org-wide OTEL forwarding config. Replaces the full header set on each call.`)
 	fmt.Fprintln(os.Stderr, `    delete-config: Delete the org-wide OTEL forwarding config.`)
+	fmt.Fprintln(os.Stderr, `    list-destinations: List the organization's OTEL forwarding destinations.`)
+	fmt.Fprintln(os.Stderr, `    create-destination: Add an OTEL forwarding destination with its own protocol, filters, sampling ratio and scrub rules.`)
+	fmt.Fprintln(os.Stderr, `    update-destination: Replace an OTEL forwarding destination. Every field is overwritten; header values may be omitted to keep the stored ones.`)
+	fmt.Fprintln(os.Stderr, `    delete-destination: Delete an OTEL forwarding destination.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s otel-forwarding COMMAND --help\n", os.Args[0])
@@ -15888,6 +16789,92 @@ func otelForwardingDeleteConfigUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "otel-forwarding delete-config --apikey-token \"abc123\" --session-token \"abc123\"")
 }
 
+func otelForwardingListDestinationsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] otel-forwarding list-destinations", os.Args[0])
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the organization's OTEL forwarding destinations.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "otel-forwarding list-destinations --apikey-token \"abc123\" --session-token \"abc123\"")
+}
+
+func otelForwardingCreateDestinationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] otel-forwarding create-destination", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Add an OTEL forwarding destination with its own protocol, filters, sampling ratio and scrub rules.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "otel-forwarding create-destination --body '{\n      \"enabled\": false,\n      \"endpoint_url\": \"abc123\",\n      \"filters\": {\n         \"exclude_attributes\": [\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            }\n         ],\n         \"exclude_names\": [\n            \"abc123\",\n            \"abc123\",\n            \"abc123\"\n         ],\n         \"include_attributes\": [\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            }\n         ],\n         \"include_names\": [\n            \"abc123\",\n            \"abc123\",\n            \"abc123\"\n         ]\n      },\n      \"headers\": [\n         {\n            \"name\": \"abc123\",\n            \"value\": \"abc123\"\n         }\n      ],\n      \"name\": \"aa\",\n      \"protocol\": \"http/json\",\n      \"sampling_ratio\": 1,\n      \"scrub_rules\": [\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         },\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         },\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         }\n      ]\n   }' --apikey-token \"abc123\" --session-token \"abc123\"")
+}
+
+func otelForwardingUpdateDestinationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] otel-forwarding update-destination", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replace an OTEL forwarding destination. Every field is overwritten; header values may be omitted to keep the stored ones.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "otel-forwarding update-destination --body '{\n      \"enabled\": false,\n      \"endpoint_url\": \"abc123\",\n      \"filters\": {\n         \"exclude_attributes\": [\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            }\n         ],\n         \"exclude_names\": [\n            \"abc123\",\n            \"abc123\",\n            \"abc123\"\n         ],\n         \"include_attributes\": [\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            }\n         ],\n         \"include_names\": [\n            \"abc123\",\n            \"abc123\",\n            \"abc123\"\n         ]\n      },\n      \"headers\": [\n         {\n            \"name\": \"abc123\",\n            \"value\": \"abc123\"\n         }\n      ],\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"name\": \"aa\",\n      \"protocol\": \"http/json\",\n      \"sampling_ratio\": 1,\n      \"scrub_rules\": [\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         },\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         },\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         }\n      ]\n   }' --apikey-token \"abc123\" --session-token \"abc123\"")
+}
+
+func otelForwardingDeleteDestinationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] otel-forwarding delete-destination", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete an OTEL forwarding destination.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "otel-forwarding delete-destination --body '{\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\"")
+}
+
 // packagesUsage displays the usage of the packages command and its subcommands.
 func packagesUsage() {
 	fmt.Fprintln(os.Stderr, `Manages packages in Gram.`)
@@ -21144,9 +22131,11 @@ func spendRulesUsage() {
 	fmt.Fprintln(os.Stderr, `    update-spend-rule: Update a budget rule. Rule rows are immutable version snapshots: any change besides the enabled toggle archives the current version row and creates a successor at version + 1 (returned as the result, under a new ID). Enabled-only changes toggle the live row in place.`)
 	fmt.Fprintln(os.Stderr, `    archive-spend-rule: Archive a budget rule. There is no delete: archiving ends the rule's lineage while retaining its slug, version history, and events. Any open circuits for the rule close on the next evaluation cycle.`)
 	fmt.Fprintln(os.Stderr, `    preview-spend-rule: Preview which actors a target expression matches and their current spend against a proposed budget. Powers the live preview in the rule editor and the per-actor breakdown in the rule detail view.`)
+	fmt.Fprintln(os.Stderr, `    simulate-spend-rule: Replay a draft rule over the last N windows of recorded spend to show who it would have warned or blocked, and when. Runs against historical usage only; nothing is enforced or recorded.`)
 	fmt.Fprintln(os.Stderr, `    list-spend-rule-events: List warning and breach events emitted by budget rule evaluation, most recent first.`)
 	fmt.Fprintln(os.Stderr, `    get-spend-rules-overview: Get budgets overview metrics: aggregate card numbers plus current-window usage per rule.`)
 	fmt.Fprintln(os.Stderr, `    list-actor-attributes: List the member attributes a rule target condition can be written against, with each attribute's value kind. Static reference data that powers the rule editor's attribute picker.`)
+	fmt.Fprintln(os.Stderr, `    list-usage-dimensions: List the usage dimensions a rule scope condition can be written against. Static reference data that powers the rule editor's scope picker.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s spend-rules COMMAND --help\n", os.Args[0])
@@ -21172,7 +22161,7 @@ func spendRulesCreateSpendRuleUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "spend-rules create-spend-rule --body '{\n      \"action\": \"block\",\n      \"description\": \"abc123\",\n      \"enabled\": false,\n      \"limit_usd\": 1,\n      \"name\": \"abc123\",\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "spend-rules create-spend-rule --body '{\n      \"action\": \"block\",\n      \"description\": \"abc123\",\n      \"enabled\": false,\n      \"limit_usd\": 1,\n      \"name\": \"abc123\",\n      \"scope\": {\n         \"dimension\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
 func spendRulesListSpendRulesUsage() {
@@ -21242,7 +22231,7 @@ func spendRulesUpdateSpendRuleUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "spend-rules update-spend-rule --body '{\n      \"action\": \"block\",\n      \"description\": \"abc123\",\n      \"enabled\": false,\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"limit_usd\": 1,\n      \"name\": \"abc123\",\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "spend-rules update-spend-rule --body '{\n      \"action\": \"block\",\n      \"clear_scope\": false,\n      \"description\": \"abc123\",\n      \"enabled\": false,\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"limit_usd\": 1,\n      \"name\": \"abc123\",\n      \"scope\": {\n         \"dimension\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
 func spendRulesArchiveSpendRuleUsage() {
@@ -21290,7 +22279,31 @@ func spendRulesPreviewSpendRuleUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "spend-rules preview-spend-rule --body '{\n      \"limit_usd\": 1,\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "spend-rules preview-spend-rule --body '{\n      \"limit_usd\": 1,\n      \"scope\": {\n         \"dimension\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func spendRulesSimulateSpendRuleUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] spend-rules simulate-spend-rule", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replay a draft rule over the last N windows of recorded spend to show who it would have warned or blocked, and when. Runs against historical usage only; nothing is enforced or recorded.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "spend-rules simulate-spend-rule --body '{\n      \"action\": \"block\",\n      \"limit_usd\": 1,\n      \"scope\": {\n         \"dimension\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\",\n      \"windows\": 2\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
 func spendRulesListSpendRuleEventsUsage() {
@@ -21367,6 +22380,28 @@ func spendRulesListActorAttributesUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "spend-rules list-actor-attributes --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
+func spendRulesListUsageDimensionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] spend-rules list-usage-dimensions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the usage dimensions a rule scope condition can be written against. Static reference data that powers the rule editor's scope picker.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "spend-rules list-usage-dimensions --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
 // telemetryUsage displays the usage of the telemetry command and its
 // subcommands.
 func telemetryUsage() {
@@ -22167,63 +23202,173 @@ func telemetryListHooksTracesUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "telemetry list-hooks-traces --body '{\n      \"cursor\": \"abc123\",\n      \"filters\": [\n         {\n            \"operator\": \"not_eq\",\n            \"path\": \"@user.region\",\n            \"values\": [\n               \"abc123\",\n               \"abc123\",\n               \"abc123\"\n            ]\n         }\n      ],\n      \"from\": \"2025-12-19T10:00:00Z\",\n      \"limit\": 2,\n      \"sort\": \"desc\",\n      \"to\": \"2025-12-19T11:00:00Z\",\n      \"types_to_include\": [\n         \"mcp\",\n         \"skill\"\n      ]\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-// templatesUsage displays the usage of the templates command and its
-// subcommands.
-func templatesUsage() {
-	fmt.Fprintln(os.Stderr, `Manages re-usable prompt templates and higher-order tools for a project.`)
-	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] templates COMMAND [flags]\n\n", os.Args[0])
+// telemetryAlertsUsage displays the usage of the telemetry-alerts command and
+// its subcommands.
+func telemetryAlertsUsage() {
+	fmt.Fprintln(os.Stderr, `Manage alert rules that watch a project's tool error rate and latency.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] telemetry-alerts COMMAND [flags]\n\n", os.Args[0])
 	fmt.Fprintln(os.Stderr, "COMMAND:")
-	fmt.Fprintln(os.Stderr, `    create-template: Create a new prompt template.`)
-	fmt.Fprintln(os.Stderr, `    update-template: Update a prompt template.`)
-	fmt.Fprintln(os.Stderr, `    get-template: Get prompt template by its ID or name.`)
-	fmt.Fprintln(os.Stderr, `    list-templates: List available prompt template.`)
-	fmt.Fprintln(os.Stderr, `    delete-template: Delete prompt template by its ID or name.`)
-	fmt.Fprintln(os.Stderr, `    render-template-by-id: Render a prompt template by ID with provided input data.`)
-	fmt.Fprintln(os.Stderr, `    render-template: Render a prompt template directly with all template fields provided.`)
+	fmt.Fprintln(os.Stderr, `    create-telemetry-alert-rule: Create an alert rule over the project's tool call telemetry, optionally narrowed to a toolset, a tool, or both.`)
+	fmt.Fprintln(os.Stderr, `    list-telemetry-alert-rules: List the project's telemetry alert rules with their current state. Optionally filter to rules narrowed to a specific toolset.`)
+	fmt.Fprintln(os.Stderr, `    update-telemetry-alert-rule: Update a telemetry alert rule. Omitted fields keep their stored values; the scope and metric are fixed at creation. Disabling a firing rule returns it to ok without a resolved notification.`)
+	fmt.Fprintln(os.Stderr, `    delete-telemetry-alert-rule: Delete a telemetry alert rule.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
-	fmt.Fprintf(os.Stderr, "    %s templates COMMAND --help\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "    %s telemetry-alerts COMMAND --help\n", os.Args[0])
 }
-func templatesCreateTemplateUsage() {
+func telemetryAlertsCreateTelemetryAlertRuleUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] templates create-template", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] telemetry-alerts create-telemetry-alert-rule", os.Args[0])
 	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Create a new prompt template.`)
+	fmt.Fprintln(os.Stderr, `Create an alert rule over the project's tool call telemetry, optionally narrowed to a toolset, a tool, or both.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates create-template --body '{\n      \"arguments\": \"{\\\"name\\\":\\\"example\\\",\\\"email\\\":\\\"mail@example.com\\\"}\",\n      \"description\": \"abc123\",\n      \"engine\": \"mustache\",\n      \"kind\": \"higher_order_tool\",\n      \"name\": \"aaa\",\n      \"prompt\": \"abc123\",\n      \"tool_urns_hint\": [\n         \"abc123\",\n         \"abc123\",\n         \"abc123\"\n      ],\n      \"tools_hint\": [\n         \"abc123\",\n         \"abc123\",\n         \"abc123\"\n      ]\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "telemetry-alerts create-telemetry-alert-rule --body '{\n      \"email_recipients\": [\n         \"alice@example.com\",\n         \"alice@example.com\",\n         \"alice@example.com\"\n      ],\n      \"enabled\": false,\n      \"metric\": \"latency_p50\",\n      \"min_calls\": 2,\n      \"name\": \"aa\",\n      \"threshold\": 1,\n      \"tool_urn\": \"aa\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"window_seconds\": 61\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-func templatesUpdateTemplateUsage() {
+func telemetryAlertsListTelemetryAlertRulesUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] templates update-template", os.Args[0])
-	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] telemetry-alerts list-telemetry-alert-rules", os.Args[0])
+	fmt.Fprint(os.Stderr, " -toolset-id STRING")
 	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Update a prompt template.`)
+	fmt.Fprintln(os.Stderr, `List the project's telemetry alert rules with their current state. Optionally filter to rules narrowed to a specific toolset.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -toolset-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "telemetry-alerts list-telemetry-alert-rules --toolset-id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func telemetryAlertsUpdateTelemetryAlertRuleUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] telemetry-alerts update-telemetry-alert-rule", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Update a telemetry alert rule. Omitted fields keep their stored values; the scope and metric are fixed at creation. Disabling a firing rule returns it to ok without a resolved notification.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "telemetry-alerts update-telemetry-alert-rule --body '{\n      \"email_recipients\": [\n         \"alice@example.com\",\n         \"alice@example.com\",\n         \"alice@example.com\"\n      ],\n      \"enabled\": false,\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"min_calls\": 2,\n      \"name\": \"aa\",\n      \"threshold\": 1,\n      \"window_seconds\": 61\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func telemetryAlertsDeleteTelemetryAlertRuleUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] telemetry-alerts delete-telemetry-alert-rule", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete a telemetry alert rule.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "telemetry-alerts delete-telemetry-alert-rule --id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+// templatesUsage displays the usage of the templates command and its
+// subcommands.
+func templatesUsage() {
+	fmt.Fprintln(os.Stderr, `Manages re-usable prompt templates and higher-order tools for a project.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] templates COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    create-template: Create a new prompt template.`)
+	fmt.Fprintln(os.Stderr, `    update-template: Update a prompt template.`)
+	fmt.Fprintln(os.Stderr, `    get-template: Get prompt template by its ID or name.`)
+	fmt.Fprintln(os.Stderr, `    list-templates: List available prompt template.`)
+	fmt.Fprintln(os.Stderr, `    delete-template: Delete prompt template by its ID or name.`)
+	fmt.Fprintln(os.Stderr, `    render-template-by-id: Render a prompt template by ID with provided input data.`)
+	fmt.Fprintln(os.Stderr, `    render-template: Render a prompt template directly with all template fields provided.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s templates COMMAND --help\n", os.Args[0])
+}
+func templatesCreateTemplateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] templates create-template", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Create a new prompt template.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates create-template --body '{\n      \"arguments\": \"{\\\"name\\\":\\\"example\\\",\\\"email\\\":\\\"mail@example.com\\\"}\",\n      \"description\": \"abc123\",\n      \"engine\": \"mustache\",\n      \"kind\": \"higher_order_tool\",\n      \"name\": \"aaa\",\n      \"prompt\": \"abc123\",\n      \"tool_urns_hint\": [\n         \"abc123\",\n         \"abc123\",\n         \"abc123\"\n      ],\n      \"tools_hint\": [\n         \"abc123\",\n         \"abc123\",\n         \"abc123\"\n      ]\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func templatesUpdateTemplateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] templates update-template", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Update a prompt template.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
 
@@ -22232,159 +23377,630 @@ func templatesUpdateTemplateUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates update-template --body '{\n      \"arguments\": \"{\\\"name\\\":\\\"example\\\",\\\"email\\\":\\\"mail@example.com\\\"}\",\n      \"description\": \"abc123\",\n      \"engine\": \"mustache\",\n      \"id\": \"abc123\",\n      \"kind\": \"higher_order_tool\",\n      \"name\": \"abc123\",\n      \"prompt\": \"abc123\",\n      \"tool_urns_hint\": [\n         \"abc123\",\n         \"abc123\",\n         \"abc123\"\n      ],\n      \"tools_hint\": [\n         \"abc123\",\n         \"abc123\",\n         \"abc123\"\n      ]\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-func templatesGetTemplateUsage() {
+func templatesGetTemplateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] templates get-template", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -name STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Get prompt template by its ID or name.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -name STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates get-template --id \"abc123\" --name \"abc123\" --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func templatesListTemplatesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] templates list-templates", os.Args[0])
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List available prompt template.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates list-templates --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func templatesDeleteTemplateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] templates delete-template", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -name STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete prompt template by its ID or name.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -name STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates delete-template --id \"abc123\" --name \"abc123\" --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func templatesRenderTemplateByIDUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] templates render-template-by-id", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Render a prompt template by ID with provided input data.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates render-template-by-id --body '{\n      \"arguments\": {\n         \"abc123\": \"abc123\"\n      }\n   }' --id \"abc123\" --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func templatesRenderTemplateUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] templates render-template", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Render a prompt template directly with all template fields provided.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates render-template --body '{\n      \"arguments\": {\n         \"abc123\": \"abc123\"\n      },\n      \"engine\": \"mustache\",\n      \"kind\": \"higher_order_tool\",\n      \"prompt\": \"abc123\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+// tokenExchangeUsage displays the usage of the token-exchange command and its
+// subcommands.
+func tokenExchangeUsage() {
+	fmt.Fprintln(os.Stderr, `Device-agent token exchange: trade an org-scoped install credential (an API key with the 'agent' scope) plus a vouched user email for a long-lived, per-user API key scoped for the device agent.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] token-exchange COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    exchange: Exchange the org-scoped device-agent install credential plus a vouched user email for a long-lived, per-user API key carrying the 'agent_user' scope. Authenticated with an org-scoped API key carrying the 'agent' scope — deliberately broader than the 'agent_user' scope the minted per-user keys carry, so a leaked per-user key cannot re-enter this endpoint to forge another user's key. The raw key is returned exactly once.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s token-exchange COMMAND --help\n", os.Args[0])
+}
+func tokenExchangeExchangeUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] token-exchange exchange", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Exchange the org-scoped device-agent install credential plus a vouched user email for a long-lived, per-user API key carrying the 'agent_user' scope. Authenticated with an org-scoped API key carrying the 'agent' scope — deliberately broader than the 'agent_user' scope the minted per-user keys carry, so a leaked per-user key cannot re-enter this endpoint to forge another user's key. The raw key is returned exactly once.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "token-exchange exchange --body '{\n      \"email\": \"dev@acme.corp\"\n   }' --apikey-token \"abc123\"")
+}
+
+// toolApprovalsUsage displays the usage of the tool-approvals command and its
+// subcommands.
+func toolApprovalsUsage() {
+	fmt.Fprintln(os.Stderr, `Manage human-in-the-loop approval policies for tool calls on toolsets and MCP servers.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] tool-approvals COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    create-tool-approval-policy: Require human approval for matching tool calls on a toolset or an MCP server. Provide exactly one of toolset_id or mcp_server_id, and at least one of match_destructive or expression.`)
+	fmt.Fprintln(os.Stderr, `    list-tool-approval-policies: List approval policies for a project. Optionally filter to those attached to a specific toolset or MCP server.`)
+	fmt.Fprintln(os.Stderr, `    update-tool-approval-policy: Update an approval policy. Omitted fields keep their stored values; the target is fixed at creation.`)
+	fmt.Fprintln(os.Stderr, `    delete-tool-approval-policy: Delete an approval policy.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s tool-approvals COMMAND --help\n", os.Args[0])
+}
+func toolApprovalsCreateToolApprovalPolicyUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-approvals create-tool-approval-policy", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Require human approval for matching tool calls on a toolset or an MCP server. Provide exactly one of toolset_id or mcp_server_id, and at least one of match_destructive or expression.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-approvals create-tool-approval-policy --body '{\n      \"expression\": \"aa\",\n      \"match_destructive\": false,\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"message\": \"aaa\",\n      \"notify_emails\": [\n         \"alice@example.com\",\n         \"alice@example.com\",\n         \"alice@example.com\"\n      ],\n      \"slack_webhook_url\": \"aaa\",\n      \"timeout_seconds\": 11,\n      \"tool_name\": \"aa\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func toolApprovalsListToolApprovalPoliciesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-approvals list-tool-approval-policies", os.Args[0])
+	fmt.Fprint(os.Stderr, " -toolset-id STRING")
+	fmt.Fprint(os.Stderr, " -mcp-server-id STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List approval policies for a project. Optionally filter to those attached to a specific toolset or MCP server.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -toolset-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -mcp-server-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-approvals list-tool-approval-policies --toolset-id \"550e8400-e29b-41d4-a716-446655440000\" --mcp-server-id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func toolApprovalsUpdateToolApprovalPolicyUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-approvals update-tool-approval-policy", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Update an approval policy. Omitted fields keep their stored values; the target is fixed at creation.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-approvals update-tool-approval-policy --body '{\n      \"expression\": \"aaa\",\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"match_destructive\": false,\n      \"message\": \"aaa\",\n      \"notify_emails\": [\n         \"alice@example.com\",\n         \"alice@example.com\",\n         \"alice@example.com\"\n      ],\n      \"slack_webhook_url\": \"aaa\",\n      \"timeout_seconds\": 11,\n      \"tool_name\": \"aaa\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func toolApprovalsDeleteToolApprovalPolicyUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-approvals delete-tool-approval-policy", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete an approval policy.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-approvals delete-tool-approval-policy --id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+// toolCallRecordingsUsage displays the usage of the tool-call-recordings
+// command and its subcommands.
+func toolCallRecordingsUsage() {
+	fmt.Fprintln(os.Stderr, `Record real tool calls on a toolset and replay them against another deployment as regression tests.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] tool-call-recordings COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    start-tool-call-recording: Start recording tool calls made against a toolset. Any session already recording the toolset is stopped.`)
+	fmt.Fprintln(os.Stderr, `    stop-tool-call-recording: Stop a recording session. Recordings already captured are kept.`)
+	fmt.Fprintln(os.Stderr, `    list-tool-call-recording-sessions: List the most recent recording sessions for a project. Optionally filter to a single toolset.`)
+	fmt.Fprintln(os.Stderr, `    export-tool-call-recordings: Export the tool calls captured by a recording session, with secrets masked.`)
+	fmt.Fprintln(os.Stderr, `    replay-tool-call-recordings: Replay the tool calls captured by a recording session against a deployment and report how the results differ. Upstream APIs are not contacted: each call is answered from the responses recorded with it.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s tool-call-recordings COMMAND --help\n", os.Args[0])
+}
+func toolCallRecordingsStartToolCallRecordingUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-call-recordings start-tool-call-recording", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Start recording tool calls made against a toolset. Any session already recording the toolset is stopped.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-call-recordings start-tool-call-recording --body '{\n      \"duration_minutes\": 2,\n      \"max_calls\": 2,\n      \"toolset_slug\": \"aaa\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func toolCallRecordingsStopToolCallRecordingUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-call-recordings stop-tool-call-recording", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Stop a recording session. Recordings already captured are kept.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-call-recordings stop-tool-call-recording --body '{\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func toolCallRecordingsListToolCallRecordingSessionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-call-recordings list-tool-call-recording-sessions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -toolset-slug STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the most recent recording sessions for a project. Optionally filter to a single toolset.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -toolset-slug STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-call-recordings list-tool-call-recording-sessions --toolset-slug \"aaa\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func toolCallRecordingsExportToolCallRecordingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-call-recordings export-tool-call-recordings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -session-id STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Export the tool calls captured by a recording session, with secrets masked.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -session-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-call-recordings export-tool-call-recordings --session-id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func toolCallRecordingsReplayToolCallRecordingsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-call-recordings replay-tool-call-recordings", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replay the tool calls captured by a recording session against a deployment and report how the results differ. Upstream APIs are not contacted: each call is answered from the responses recorded with it.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-call-recordings replay-tool-call-recordings --body '{\n      \"deployment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"session_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+// toolConstraintsUsage displays the usage of the tool-constraints command and
+// its subcommands.
+func toolConstraintsUsage() {
+	fmt.Fprintln(os.Stderr, `Manage argument constraints on tool calls attached to toolsets and MCP servers.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] tool-constraints COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    create-tool-constraint: Attach an argument constraint for one tool to a toolset or an MCP server. Provide exactly one of toolset_id or mcp_server_id.`)
+	fmt.Fprintln(os.Stderr, `    list-tool-constraints: List argument constraints for a project. Optionally filter to those attached to a specific toolset or MCP server.`)
+	fmt.Fprintln(os.Stderr, `    update-tool-constraint: Update an argument constraint. Omitted fields keep their stored values; the target and tool are fixed at creation.`)
+	fmt.Fprintln(os.Stderr, `    delete-tool-constraint: Delete an argument constraint.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s tool-constraints COMMAND --help\n", os.Args[0])
+}
+func toolConstraintsCreateToolConstraintUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-constraints create-tool-constraint", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Attach an argument constraint for one tool to a toolset or an MCP server. Provide exactly one of toolset_id or mcp_server_id.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-constraints create-tool-constraint --body '{\n      \"effect\": \"deny\",\n      \"expression\": \"aa\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"message\": \"aaa\",\n      \"tool_name\": \"aa\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func toolConstraintsListToolConstraintsUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] templates get-template", os.Args[0])
-	fmt.Fprint(os.Stderr, " -id STRING")
-	fmt.Fprint(os.Stderr, " -name STRING")
-	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-constraints list-tool-constraints", os.Args[0])
+	fmt.Fprint(os.Stderr, " -toolset-id STRING")
+	fmt.Fprint(os.Stderr, " -mcp-server-id STRING")
 	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Get prompt template by its ID or name.`)
+	fmt.Fprintln(os.Stderr, `List argument constraints for a project. Optionally filter to those attached to a specific toolset or MCP server.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -id STRING: `)
-	fmt.Fprintln(os.Stderr, `    -name STRING: `)
-	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -toolset-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -mcp-server-id STRING: `)
 	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates get-template --id \"abc123\" --name \"abc123\" --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-constraints list-tool-constraints --toolset-id \"550e8400-e29b-41d4-a716-446655440000\" --mcp-server-id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-func templatesListTemplatesUsage() {
+func toolConstraintsUpdateToolConstraintUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] templates list-templates", os.Args[0])
-	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-constraints update-tool-constraint", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
 	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `List available prompt template.`)
+	fmt.Fprintln(os.Stderr, `Update an argument constraint. Omitted fields keep their stored values; the target and tool are fixed at creation.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
 	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates list-templates --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-constraints update-tool-constraint --body '{\n      \"effect\": \"deny\",\n      \"expression\": \"aa\",\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"message\": \"aaa\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-func templatesDeleteTemplateUsage() {
+func toolConstraintsDeleteToolConstraintUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] templates delete-template", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-constraints delete-tool-constraint", os.Args[0])
 	fmt.Fprint(os.Stderr, " -id STRING")
-	fmt.Fprint(os.Stderr, " -name STRING")
-	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Delete prompt template by its ID or name.`)
+	fmt.Fprintln(os.Stderr, `Delete an argument constraint.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -id STRING: `)
-	fmt.Fprintln(os.Stderr, `    -name STRING: `)
-	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates delete-template --id \"abc123\" --name \"abc123\" --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-constraints delete-tool-constraint --id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-func templatesRenderTemplateByIDUsage() {
+// toolRateLimitsUsage displays the usage of the tool-rate-limits command and
+// its subcommands.
+func toolRateLimitsUsage() {
+	fmt.Fprintln(os.Stderr, `Manage tool-call rate limits attached to toolsets and MCP servers.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] tool-rate-limits COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    create-tool-rate-limit: Attach a tool-call rate limit to a toolset or an MCP server. Provide exactly one of toolset_id or mcp_server_id.`)
+	fmt.Fprintln(os.Stderr, `    list-tool-rate-limits: List tool-call rate limits for a project. Optionally filter to those attached to a specific toolset or MCP server.`)
+	fmt.Fprintln(os.Stderr, `    update-tool-rate-limit: Update the rate of a tool-call rate limit. Omitted fields keep their stored values; the target, subject and tool are fixed at creation.`)
+	fmt.Fprintln(os.Stderr, `    delete-tool-rate-limit: Delete a tool-call rate limit.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s tool-rate-limits COMMAND --help\n", os.Args[0])
+}
+func toolRateLimitsCreateToolRateLimitUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] templates render-template-by-id", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-rate-limits create-tool-rate-limit", os.Args[0])
 	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -id STRING")
-	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Render a prompt template by ID with provided input data.`)
+	fmt.Fprintln(os.Stderr, `Attach a tool-call rate limit to a toolset or an MCP server. Provide exactly one of toolset_id or mcp_server_id.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-rate-limits create-tool-rate-limit --body '{\n      \"burst\": 2,\n      \"interval_seconds\": 2,\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"requests\": 2,\n      \"subject\": \"api_key\",\n      \"tool_name\": \"aa\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func toolRateLimitsListToolRateLimitsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-rate-limits list-tool-rate-limits", os.Args[0])
+	fmt.Fprint(os.Stderr, " -toolset-id STRING")
+	fmt.Fprint(os.Stderr, " -mcp-server-id STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List tool-call rate limits for a project. Optionally filter to those attached to a specific toolset or MCP server.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -toolset-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -mcp-server-id STRING: `)
 	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates render-template-by-id --body '{\n      \"arguments\": {\n         \"abc123\": \"abc123\"\n      }\n   }' --id \"abc123\" --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-rate-limits list-tool-rate-limits --toolset-id \"550e8400-e29b-41d4-a716-446655440000\" --mcp-server-id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-func templatesRenderTemplateUsage() {
+func toolRateLimitsUpdateToolRateLimitUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] templates render-template", os.Args[0])
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-rate-limits update-tool-rate-limit", os.Args[0])
 	fmt.Fprint(os.Stderr, " -body JSON")
-	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
 	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Render a prompt template directly with all template fields provided.`)
+	fmt.Fprintln(os.Stderr, `Update the rate of a tool-call rate limit. Omitted fields keep their stored values; the target, subject and tool are fixed at creation.`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
-	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "templates render-template --body '{\n      \"arguments\": {\n         \"abc123\": \"abc123\"\n      },\n      \"engine\": \"mustache\",\n      \"kind\": \"higher_order_tool\",\n      \"prompt\": \"abc123\"\n   }' --apikey-token \"abc123\" --session-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-rate-limits update-tool-rate-limit --body '{\n      \"burst\": 2,\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"interval_seconds\": 2,\n      \"requests\": 2\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
-// tokenExchangeUsage displays the usage of the token-exchange command and its
-// subcommands.
-func tokenExchangeUsage() {
-	fmt.Fprintln(os.Stderr, `Device-agent token exchange: trade an org-scoped install credential (an API key with the 'agent' scope) plus a vouched user email for a long-lived, per-user API key scoped for the device agent.`)
-	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] token-exchange COMMAND [flags]\n\n", os.Args[0])
-	fmt.Fprintln(os.Stderr, "COMMAND:")
-	fmt.Fprintln(os.Stderr, `    exchange: Exchange the org-scoped device-agent install credential plus a vouched user email for a long-lived, per-user API key carrying the 'agent_user' scope. Authenticated with an org-scoped API key carrying the 'agent' scope — deliberately broader than the 'agent_user' scope the minted per-user keys carry, so a leaked per-user key cannot re-enter this endpoint to forge another user's key. The raw key is returned exactly once.`)
-	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, "Additional help:")
-	fmt.Fprintf(os.Stderr, "    %s token-exchange COMMAND --help\n", os.Args[0])
-}
-func tokenExchangeExchangeUsage() {
+func toolRateLimitsDeleteToolRateLimitUsage() {
 	// Header with flags
-	fmt.Fprintf(os.Stderr, "%s [flags] token-exchange exchange", os.Args[0])
-	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintf(os.Stderr, "%s [flags] tool-rate-limits delete-tool-rate-limit", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
 	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Exchange the org-scoped device-agent install credential plus a vouched user email for a long-lived, per-user API key carrying the 'agent_user' scope. Authenticated with an org-scoped API key carrying the 'agent' scope — deliberately broader than the 'agent_user' scope the minted per-user keys carry, so a leaked per-user key cannot re-enter this endpoint to forge another user's key. The raw key is returned exactly once.`)
+	fmt.Fprintln(os.Stderr, `Delete a tool-call rate limit.`)
 
 	// Flags list
-	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
 	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "token-exchange exchange --body '{\n      \"email\": \"dev@acme.corp\"\n   }' --apikey-token \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "tool-rate-limits delete-tool-rate-limit --id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
 // toolsUsage displays the usage of the tools command and its subcommands.
@@ -22445,6 +24061,8 @@ func toolsetsUsage() {
 	fmt.Fprintln(os.Stderr, `    removeoauth-server: Remove OAuth server association from a toolset`)
 	fmt.Fprintln(os.Stderr, `    set-user-session-issuer: Link a toolset to a user_session_issuer (or pass null to unlink). The user_session_issuer must already exist in the caller's project.`)
 	fmt.Fprintln(os.Stderr, `    set-tool-variations-group: Assign a tool variations group to a toolset to enable MCP tool filtering (or pass null to disable). The group must already exist in the caller's project.`)
+	fmt.Fprintln(os.Stderr, `    diff-toolset-versions: Compare the tools and resources of two versions of a toolset. Versions are recorded on every change to the toolset's tools or resources and never change afterwards.`)
+	fmt.Fprintln(os.Stderr, `    revert-toolset: Revert a toolset's tools and resources to those of an earlier version. The revert is recorded as a new version, so MCP servers pinned to other versions are unaffected.`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s toolsets COMMAND --help\n", os.Args[0])
@@ -22763,6 +24381,60 @@ func toolsetsSetToolVariationsGroupUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "toolsets set-tool-variations-group --body '{\n      \"tool_variations_group_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }' --slug \"aaa\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
+func toolsetsDiffToolsetVersionsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] toolsets diff-toolset-versions", os.Args[0])
+	fmt.Fprint(os.Stderr, " -slug STRING")
+	fmt.Fprint(os.Stderr, " -from-version INT64")
+	fmt.Fprint(os.Stderr, " -to-version INT64")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Compare the tools and resources of two versions of a toolset. Versions are recorded on every change to the toolset's tools or resources and never change afterwards.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -slug STRING: `)
+	fmt.Fprintln(os.Stderr, `    -from-version INT64: `)
+	fmt.Fprintln(os.Stderr, `    -to-version INT64: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "toolsets diff-toolset-versions --slug \"aaa\" --from-version 2 --to-version 2 --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
+func toolsetsRevertToolsetUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] toolsets revert-toolset", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -slug STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprint(os.Stderr, " -apikey-token STRING")
+	fmt.Fprint(os.Stderr, " -project-slug-input STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Revert a toolset's tools and resources to those of an earlier version. The revert is recorded as a new version, so MCP servers pinned to other versions are unaffected.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -slug STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -apikey-token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -project-slug-input STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "toolsets revert-toolset --body '{\n      \"version\": 2\n   }' --slug \"aaa\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+}
+
 // triggersUsage displays the usage of the triggers command and its subcommands.
 func triggersUsage() {
 	fmt.Fprintln(os.Stderr, `Manage project trigger instances and static trigger definitions.`)
@@ -24230,6 +25902,146 @@ func userSessionsRevokeUserSessionUsage() {
 	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "user-sessions revoke-user-session --id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
+// webhookEndpointsUsage displays the usage of the webhook-endpoints command
+// and its subcommands.
+func webhookEndpointsUsage() {
+	fmt.Fprintln(os.Stderr, `Register organization webhook endpoints for the self-hosted delivery backend and inspect their delivery log.`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] webhook-endpoints COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    create-endpoint: Register a webhook endpoint. The response carries the endpoint's signing secret, which is not retrievable afterwards. Requires org:admin.`)
+	fmt.Fprintln(os.Stderr, `    list-endpoints: List the organization's webhook endpoints. Requires org:read.`)
+	fmt.Fprintln(os.Stderr, `    update-endpoint: Update a webhook endpoint. Omitted fields are left unchanged; pass an empty event_types list to receive every event type. Requires org:admin.`)
+	fmt.Fprintln(os.Stderr, `    delete-endpoint: Delete a webhook endpoint. Deliveries still pending for it are abandoned. Requires org:admin.`)
+	fmt.Fprintln(os.Stderr, `    list-deliveries: List an endpoint's deliveries, newest first, each with its attempt log. Requires org:read.`)
+	fmt.Fprintln(os.Stderr, `    redeliver: Queue one more attempt for a delivery that succeeded or was dead-lettered. The attempt count carries on, so a failed redelivery returns to dead_lettered rather than restarting the retry schedule. Requires org:admin.`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s webhook-endpoints COMMAND --help\n", os.Args[0])
+}
+func webhookEndpointsCreateEndpointUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] webhook-endpoints create-endpoint", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Register a webhook endpoint. The response carries the endpoint's signing secret, which is not retrievable afterwards. Requires org:admin.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "webhook-endpoints create-endpoint --body '{\n      \"description\": \"aaa\",\n      \"event_types\": [\n         \"abc123\"\n      ],\n      \"url\": \"aaa\"\n   }' --session-token \"abc123\"")
+}
+
+func webhookEndpointsListEndpointsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] webhook-endpoints list-endpoints", os.Args[0])
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List the organization's webhook endpoints. Requires org:read.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "webhook-endpoints list-endpoints --session-token \"abc123\"")
+}
+
+func webhookEndpointsUpdateEndpointUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] webhook-endpoints update-endpoint", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Update a webhook endpoint. Omitted fields are left unchanged; pass an empty event_types list to receive every event type. Requires org:admin.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "webhook-endpoints update-endpoint --body '{\n      \"description\": \"aaa\",\n      \"disabled\": false,\n      \"event_types\": [\n         \"abc123\"\n      ],\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"url\": \"aaa\"\n   }' --session-token \"abc123\"")
+}
+
+func webhookEndpointsDeleteEndpointUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] webhook-endpoints delete-endpoint", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Delete a webhook endpoint. Deliveries still pending for it are abandoned. Requires org:admin.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "webhook-endpoints delete-endpoint --id \"550e8400-e29b-41d4-a716-446655440000\" --session-token \"abc123\"")
+}
+
+func webhookEndpointsListDeliveriesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] webhook-endpoints list-deliveries", os.Args[0])
+	fmt.Fprint(os.Stderr, " -endpoint-id STRING")
+	fmt.Fprint(os.Stderr, " -delivery-status STRING")
+	fmt.Fprint(os.Stderr, " -cursor STRING")
+	fmt.Fprint(os.Stderr, " -limit INT32")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `List an endpoint's deliveries, newest first, each with its attempt log. Requires org:read.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -endpoint-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -delivery-status STRING: `)
+	fmt.Fprintln(os.Stderr, `    -cursor STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT32: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "webhook-endpoints list-deliveries --endpoint-id \"550e8400-e29b-41d4-a716-446655440000\" --delivery-status \"succeeded\" --cursor \"550e8400-e29b-41d4-a716-446655440000\" --limit 2 --session-token \"abc123\"")
+}
+
+func webhookEndpointsRedeliverUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] webhook-endpoints redeliver", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -session-token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Queue one more attempt for a delivery that succeeded or was dead-lettered. The attempt count carries on, so a failed redelivery returns to dead_lettered rather than restarting the retry schedule. Requires org:admin.`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -session-token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "webhook-endpoints redeliver --body '{\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }' --session-token \"abc123\"")
+}
+
 // variationsUsage displays the usage of the variations command and its
 // subcommands.
 func variationsUsage() {
@@ -24266,7 +26078,7 @@ func variationsUpsertGlobalUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "variations upsert-global --body '{\n      \"confirm\": \"never\",\n      \"confirm_prompt\": \"abc123\",\n      \"description\": \"abc123\",\n      \"destructive_hint\": false,\n      \"idempotent_hint\": false,\n      \"name\": \"abc123\",\n      \"open_world_hint\": false,\n      \"read_only_hint\": false,\n      \"src_tool_name\": \"abc123\",\n      \"src_tool_urn\": \"abc123\",\n      \"summarizer\": \"abc123\",\n      \"summary\": \"abc123\",\n      \"tags\": [\n         \"abc123\"\n      ],\n      \"title\": \"abc123\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "variations upsert-global --body '{\n      \"argument_bindings\": [\n         {\n            \"argument\": \"aa\",\n            \"source\": \"environment\",\n            \"value\": \"aa\"\n         }\n      ],\n      \"cache_shared\": false,\n      \"cache_ttl_seconds\": 2,\n      \"confirm\": \"never\",\n      \"confirm_prompt\": \"abc123\",\n      \"description\": \"abc123\",\n      \"destructive_hint\": false,\n      \"idempotent_hint\": false,\n      \"name\": \"abc123\",\n      \"open_world_hint\": false,\n      \"read_only_hint\": false,\n      \"src_tool_name\": \"abc123\",\n      \"src_tool_urn\": \"abc123\",\n      \"summarizer\": \"abc123\",\n      \"summary\": \"abc123\",\n      \"tags\": [\n         \"abc123\"\n      ],\n      \"title\": \"abc123\"\n   }' --session-token \"abc123\" --apikey-token \"abc123\" --project-slug-input \"abc123\"")
 }
 
 func variationsDeleteGlobalUsage() {
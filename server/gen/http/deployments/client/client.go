@@ -48,6 +48,10 @@ type Client struct {
 	// getDeploymentLogs endpoint.
 	GetDeploymentLogsDoer goahttp.Doer
 
+	// DiffDeployments Doer is the HTTP client used to make requests to the
+	// diffDeployments endpoint.
+	DiffDeploymentsDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -76,6 +80,7 @@ func NewClient(
 		RedeployDoer:            doer,
 		ListDeploymentsDoer:     doer,
 		GetDeploymentLogsDoer:   doer,
+		DiffDeploymentsDoer:     doer,
 		RestoreResponseBody:     restoreBody,
 		scheme:                  scheme,
 		host:                    host,
@@ -275,3 +280,27 @@ func (c *Client) GetDeploymentLogs() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// DiffDeployments returns an endpoint that makes HTTP requests to the
+// deployments service diffDeployments server.
+func (c *Client) DiffDeployments() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDiffDeploymentsRequest(c.encoder)
+		decodeResponse = DecodeDiffDeploymentsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDiffDeploymentsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DiffDeploymentsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("deployments", "diffDeployments", err)
+		}
+		return decodeResponse(resp)
+	}
+}
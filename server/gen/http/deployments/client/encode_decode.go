@@ -1955,6 +1955,248 @@ func DecodeGetDeploymentLogsResponse(decoder func(*http.Response) goahttp.Decode
 	}
 }
 
+// BuildDiffDeploymentsRequest instantiates a HTTP request object with method
+// and path set to call the "deployments" service "diffDeployments" endpoint
+func (c *Client) BuildDiffDeploymentsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DiffDeploymentsDeploymentsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("deployments", "diffDeployments", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDiffDeploymentsRequest returns an encoder for requests sent to the
+// deployments diffDeployments server.
+func EncodeDiffDeploymentsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*deployments.DiffDeploymentsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("deployments", "diffDeployments", "*deployments.DiffDeploymentsPayload", v)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		if p.ProjectSlugInput != nil {
+			head := *p.ProjectSlugInput
+			req.Header.Set("Gram-Project", head)
+		}
+		values := req.URL.Query()
+		values.Add("from_id", p.FromID)
+		values.Add("to_id", p.ToID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDiffDeploymentsResponse returns a decoder for responses returned by
+// the deployments diffDeployments endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDiffDeploymentsResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeDiffDeploymentsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DiffDeploymentsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deployments", "diffDeployments", err)
+			}
+			err = ValidateDiffDeploymentsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deployments", "diffDeployments", err)
+			}
+			res := NewDiffDeploymentsResultOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body DiffDeploymentsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deployments", "diffDeployments", err)
+			}
+			err = ValidateDiffDeploymentsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deployments", "diffDeployments", err)
+			}
+			return nil, NewDiffDeploymentsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body DiffDeploymentsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deployments", "diffDeployments", err)
+			}
+			err = ValidateDiffDeploymentsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deployments", "diffDeployments", err)
+			}
+			return nil, NewDiffDeploymentsForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body DiffDeploymentsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deployments", "diffDeployments", err)
+			}
+			err = ValidateDiffDeploymentsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deployments", "diffDeployments", err)
+			}
+			return nil, NewDiffDeploymentsBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body DiffDeploymentsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deployments", "diffDeployments", err)
+			}
+			err = ValidateDiffDeploymentsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deployments", "diffDeployments", err)
+			}
+			return nil, NewDiffDeploymentsNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body DiffDeploymentsConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deployments", "diffDeployments", err)
+			}
+			err = ValidateDiffDeploymentsConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deployments", "diffDeployments", err)
+			}
+			return nil, NewDiffDeploymentsConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body DiffDeploymentsUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deployments", "diffDeployments", err)
+			}
+			err = ValidateDiffDeploymentsUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deployments", "diffDeployments", err)
+			}
+			return nil, NewDiffDeploymentsUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body DiffDeploymentsInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deployments", "diffDeployments", err)
+			}
+			err = ValidateDiffDeploymentsInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deployments", "diffDeployments", err)
+			}
+			return nil, NewDiffDeploymentsInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body DiffDeploymentsInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("deployments", "diffDeployments", err)
+				}
+				err = ValidateDiffDeploymentsInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("deployments", "diffDeployments", err)
+				}
+				return nil, NewDiffDeploymentsInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body DiffDeploymentsUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("deployments", "diffDeployments", err)
+				}
+				err = ValidateDiffDeploymentsUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("deployments", "diffDeployments", err)
+				}
+				return nil, NewDiffDeploymentsUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("deployments", "diffDeployments", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body DiffDeploymentsGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("deployments", "diffDeployments", err)
+			}
+			err = ValidateDiffDeploymentsGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("deployments", "diffDeployments", err)
+			}
+			return nil, NewDiffDeploymentsGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("deployments", "diffDeployments", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // unmarshalOpenAPIv3DeploymentAssetResponseBodyToTypesOpenAPIv3DeploymentAsset
 // builds a value of type *types.OpenAPIv3DeploymentAsset from a value of type
 // *OpenAPIv3DeploymentAssetResponseBody.
@@ -2298,3 +2540,19 @@ func unmarshalDeploymentLogEventResponseBodyToDeploymentsDeploymentLogEvent(v *D
 
 	return res
 }
+
+// unmarshalDeploymentDiffChangeResponseBodyToDeploymentsDeploymentDiffChange
+// builds a value of type *deployments.DeploymentDiffChange from a value of
+// type *DeploymentDiffChangeResponseBody.
+func unmarshalDeploymentDiffChangeResponseBodyToDeploymentsDeploymentDiffChange(v *DeploymentDiffChangeResponseBody) *deployments.DeploymentDiffChange {
+	res := &deployments.DeploymentDiffChange{
+		ToolUrn:     *v.ToolUrn,
+		ToolName:    *v.ToolName,
+		Kind:        *v.Kind,
+		Severity:    *v.Severity,
+		Path:        v.Path,
+		Description: *v.Description,
+	}
+
+	return res
+}
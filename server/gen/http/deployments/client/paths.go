@@ -46,3 +46,8 @@ func ListDeploymentsDeploymentsPath() string {
 func GetDeploymentLogsDeploymentsPath() string {
 	return "/rpc/deployments.logs"
 }
+
+// DiffDeploymentsDeploymentsPath returns the URL path to the deployments service diffDeployments HTTP endpoint.
+func DiffDeploymentsDeploymentsPath() string {
+	return "/rpc/deployments.diff"
+}
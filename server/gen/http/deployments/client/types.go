@@ -22,6 +22,9 @@ type CreateDeploymentRequestBody struct {
 	// request will return immediately and the deployment will proceed
 	// asynchronously.
 	NonBlocking *bool `form:"non_blocking,omitempty" json:"non_blocking,omitempty" xml:"non_blocking,omitempty"`
+	// If true, the processed deployment is compared with the active deployment and
+	// fails instead of becoming active when it introduces breaking changes.
+	FailOnBreaking *bool `form:"fail_on_breaking,omitempty" json:"fail_on_breaking,omitempty" xml:"fail_on_breaking,omitempty"`
 	// The github repository in the form of "owner/repo".
 	GithubRepo *string `form:"github_repo,omitempty" json:"github_repo,omitempty" xml:"github_repo,omitempty"`
 	// The github pull request that resulted in the deployment.
@@ -50,6 +53,9 @@ type EvolveRequestBody struct {
 	// request will return immediately and the deployment will proceed
 	// asynchronously.
 	NonBlocking *bool `form:"non_blocking,omitempty" json:"non_blocking,omitempty" xml:"non_blocking,omitempty"`
+	// If true, the processed deployment is compared with the active deployment and
+	// fails instead of becoming active when it introduces breaking changes.
+	FailOnBreaking *bool `form:"fail_on_breaking,omitempty" json:"fail_on_breaking,omitempty" xml:"fail_on_breaking,omitempty"`
 	// The OpenAPI 3.x documents to upsert in the new deployment.
 	UpsertOpenapiv3Assets []*AddOpenAPIv3DeploymentAssetFormRequestBody `form:"upsert_openapiv3_assets,omitempty" json:"upsert_openapiv3_assets,omitempty" xml:"upsert_openapiv3_assets,omitempty"`
 	// The packages to upsert in the new deployment.
@@ -183,6 +189,27 @@ type GetDeploymentLogsResponseBody struct {
 	Events []*DeploymentLogEventResponseBody `form:"events,omitempty" json:"events,omitempty" xml:"events,omitempty"`
 }
 
+// DiffDeploymentsResponseBody is the type of the "deployments" service
+// "diffDeployments" endpoint HTTP response body.
+type DiffDeploymentsResponseBody struct {
+	// The ID of the deployment compared from
+	FromDeploymentID *string `form:"from_deployment_id,omitempty" json:"from_deployment_id,omitempty" xml:"from_deployment_id,omitempty"`
+	// The ID of the deployment compared to
+	ToDeploymentID *string `form:"to_deployment_id,omitempty" json:"to_deployment_id,omitempty" xml:"to_deployment_id,omitempty"`
+	// Whether any change can break existing callers
+	HasBreakingChanges *bool `form:"has_breaking_changes,omitempty" json:"has_breaking_changes,omitempty" xml:"has_breaking_changes,omitempty"`
+	// The number of tools only in the newer deployment
+	ToolsAdded *int `form:"tools_added,omitempty" json:"tools_added,omitempty" xml:"tools_added,omitempty"`
+	// The number of tools only in the older deployment
+	ToolsRemoved *int `form:"tools_removed,omitempty" json:"tools_removed,omitempty" xml:"tools_removed,omitempty"`
+	// The number of tools in both deployments with at least one change
+	ToolsChanged *int `form:"tools_changed,omitempty" json:"tools_changed,omitempty" xml:"tools_changed,omitempty"`
+	// The number of breaking changes
+	BreakingChanges *int `form:"breaking_changes,omitempty" json:"breaking_changes,omitempty" xml:"breaking_changes,omitempty"`
+	// The changes ordered by tool URN
+	Changes []*DeploymentDiffChangeResponseBody `form:"changes,omitempty" json:"changes,omitempty" xml:"changes,omitempty"`
+}
+
 // GetDeploymentUnauthorizedResponseBody is the type of the "deployments"
 // service "getDeployment" endpoint HTTP response body for the "unauthorized"
 // error.
@@ -1676,6 +1703,193 @@ type GetDeploymentLogsGatewayErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// DiffDeploymentsUnauthorizedResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the "unauthorized"
+// error.
+type DiffDeploymentsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffDeploymentsForbiddenResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the "forbidden"
+// error.
+type DiffDeploymentsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffDeploymentsBadRequestResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the "bad_request"
+// error.
+type DiffDeploymentsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffDeploymentsNotFoundResponseBody is the type of the "deployments" service
+// "diffDeployments" endpoint HTTP response body for the "not_found" error.
+type DiffDeploymentsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffDeploymentsConflictResponseBody is the type of the "deployments" service
+// "diffDeployments" endpoint HTTP response body for the "conflict" error.
+type DiffDeploymentsConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffDeploymentsUnsupportedMediaResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the
+// "unsupported_media" error.
+type DiffDeploymentsUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffDeploymentsInvalidResponseBody is the type of the "deployments" service
+// "diffDeployments" endpoint HTTP response body for the "invalid" error.
+type DiffDeploymentsInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffDeploymentsInvariantViolationResponseBody is the type of the
+// "deployments" service "diffDeployments" endpoint HTTP response body for the
+// "invariant_violation" error.
+type DiffDeploymentsInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffDeploymentsUnexpectedResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the "unexpected"
+// error.
+type DiffDeploymentsUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffDeploymentsGatewayErrorResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the
+// "gateway_error" error.
+type DiffDeploymentsGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // OpenAPIv3DeploymentAssetResponseBody is used to define fields on response
 // body types.
 type OpenAPIv3DeploymentAssetResponseBody struct {
@@ -1892,16 +2106,34 @@ type DeploymentLogEventResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// DeploymentDiffChangeResponseBody is used to define fields on response body
+// types.
+type DeploymentDiffChangeResponseBody struct {
+	// The URN of the changed tool
+	ToolUrn *string `form:"tool_urn,omitempty" json:"tool_urn,omitempty" xml:"tool_urn,omitempty"`
+	// The name of the changed tool
+	ToolName *string `form:"tool_name,omitempty" json:"tool_name,omitempty" xml:"tool_name,omitempty"`
+	// The aspect of the tool that changed
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Whether the change can break existing callers
+	Severity *string `form:"severity,omitempty" json:"severity,omitempty" xml:"severity,omitempty"`
+	// The location of an input schema change, e.g. body.status or ids[]
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// A human readable description of the change
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
 // NewCreateDeploymentRequestBody builds the HTTP request body from the payload
 // of the "createDeployment" endpoint of the "deployments" service.
 func NewCreateDeploymentRequestBody(p *deployments.CreateDeploymentPayload) *CreateDeploymentRequestBody {
 	body := &CreateDeploymentRequestBody{
-		NonBlocking: p.NonBlocking,
-		GithubRepo:  p.GithubRepo,
-		GithubPr:    p.GithubPr,
-		GithubSha:   p.GithubSha,
-		ExternalID:  p.ExternalID,
-		ExternalURL: p.ExternalURL,
+		NonBlocking:    p.NonBlocking,
+		FailOnBreaking: p.FailOnBreaking,
+		GithubRepo:     p.GithubRepo,
+		GithubPr:       p.GithubPr,
+		GithubSha:      p.GithubSha,
+		ExternalID:     p.ExternalID,
+		ExternalURL:    p.ExternalURL,
 	}
 	if p.Openapiv3Assets != nil {
 		body.Openapiv3Assets = make([]*AddOpenAPIv3DeploymentAssetFormRequestBody, len(p.Openapiv3Assets))
@@ -1950,8 +2182,9 @@ func NewCreateDeploymentRequestBody(p *deployments.CreateDeploymentPayload) *Cre
 // "evolve" endpoint of the "deployments" service.
 func NewEvolveRequestBody(p *deployments.EvolvePayload) *EvolveRequestBody {
 	body := &EvolveRequestBody{
-		DeploymentID: p.DeploymentID,
-		NonBlocking:  p.NonBlocking,
+		DeploymentID:   p.DeploymentID,
+		NonBlocking:    p.NonBlocking,
+		FailOnBreaking: p.FailOnBreaking,
 	}
 	if p.UpsertOpenapiv3Assets != nil {
 		body.UpsertOpenapiv3Assets = make([]*AddOpenAPIv3DeploymentAssetFormRequestBody, len(p.UpsertOpenapiv3Assets))
@@ -3381,91 +3614,265 @@ func NewGetDeploymentLogsGatewayError(body *GetDeploymentLogsGatewayErrorRespons
 	return v
 }
 
-// ValidateGetDeploymentResponseBody runs the validations defined on
-// GetDeploymentResponseBody
-func ValidateGetDeploymentResponseBody(body *GetDeploymentResponseBody) (err error) {
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
-	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
-	}
-	if body.OrganizationID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
-	}
-	if body.ProjectID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
-	}
-	if body.UserID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
-	}
-	if body.Openapiv3Assets == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("openapiv3_assets", "body"))
-	}
-	if body.Status == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
-	}
-	if body.Packages == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("packages", "body"))
-	}
-	if body.Openapiv3ToolCount == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("openapiv3_tool_count", "body"))
-	}
-	if body.FunctionsToolCount == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("functions_tool_count", "body"))
-	}
-	if body.ExternalMcpToolCount == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("external_mcp_tool_count", "body"))
-	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
-	}
-	for _, e := range body.Openapiv3Assets {
-		if e != nil {
-			if err2 := ValidateOpenAPIv3DeploymentAssetResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
-	}
-	for _, e := range body.FunctionsAssets {
-		if e != nil {
-			if err2 := ValidateDeploymentFunctionsResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
-	}
-	for _, e := range body.Packages {
-		if e != nil {
-			if err2 := ValidateDeploymentPackageResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
-	}
-	for _, e := range body.ExternalMcps {
-		if e != nil {
-			if err2 := ValidateDeploymentExternalMCPResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
+// NewDiffDeploymentsResultOK builds a "deployments" service "diffDeployments"
+// endpoint result from a HTTP "OK" response.
+func NewDiffDeploymentsResultOK(body *DiffDeploymentsResponseBody) *deployments.DiffDeploymentsResult {
+	v := &deployments.DiffDeploymentsResult{
+		FromDeploymentID:   *body.FromDeploymentID,
+		ToDeploymentID:     *body.ToDeploymentID,
+		HasBreakingChanges: *body.HasBreakingChanges,
+		ToolsAdded:         *body.ToolsAdded,
+		ToolsRemoved:       *body.ToolsRemoved,
+		ToolsChanged:       *body.ToolsChanged,
+		BreakingChanges:    *body.BreakingChanges,
+	}
+	v.Changes = make([]*deployments.DeploymentDiffChange, len(body.Changes))
+	for i, val := range body.Changes {
+		if val == nil {
+			v.Changes[i] = nil
+			continue
 		}
+		v.Changes[i] = unmarshalDeploymentDiffChangeResponseBodyToDeploymentsDeploymentDiffChange(val)
 	}
-	return
+
+	return v
 }
 
-// ValidateGetLatestDeploymentResponseBody runs the validations defined on
-// GetLatestDeploymentResponseBody
-func ValidateGetLatestDeploymentResponseBody(body *GetLatestDeploymentResponseBody) (err error) {
-	if body.Deployment != nil {
-		if err2 := ValidateDeploymentResponseBody(body.Deployment); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+// NewDiffDeploymentsUnauthorized builds a deployments service diffDeployments
+// endpoint unauthorized error.
+func NewDiffDeploymentsUnauthorized(body *DiffDeploymentsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	return
+
+	return v
 }
 
-// ValidateGetActiveDeploymentResponseBody runs the validations defined on
-// GetActiveDeploymentResponseBody
-func ValidateGetActiveDeploymentResponseBody(body *GetActiveDeploymentResponseBody) (err error) {
-	if body.Deployment != nil {
+// NewDiffDeploymentsForbidden builds a deployments service diffDeployments
+// endpoint forbidden error.
+func NewDiffDeploymentsForbidden(body *DiffDeploymentsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffDeploymentsBadRequest builds a deployments service diffDeployments
+// endpoint bad_request error.
+func NewDiffDeploymentsBadRequest(body *DiffDeploymentsBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffDeploymentsNotFound builds a deployments service diffDeployments
+// endpoint not_found error.
+func NewDiffDeploymentsNotFound(body *DiffDeploymentsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffDeploymentsConflict builds a deployments service diffDeployments
+// endpoint conflict error.
+func NewDiffDeploymentsConflict(body *DiffDeploymentsConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffDeploymentsUnsupportedMedia builds a deployments service
+// diffDeployments endpoint unsupported_media error.
+func NewDiffDeploymentsUnsupportedMedia(body *DiffDeploymentsUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffDeploymentsInvalid builds a deployments service diffDeployments
+// endpoint invalid error.
+func NewDiffDeploymentsInvalid(body *DiffDeploymentsInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffDeploymentsInvariantViolation builds a deployments service
+// diffDeployments endpoint invariant_violation error.
+func NewDiffDeploymentsInvariantViolation(body *DiffDeploymentsInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffDeploymentsUnexpected builds a deployments service diffDeployments
+// endpoint unexpected error.
+func NewDiffDeploymentsUnexpected(body *DiffDeploymentsUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffDeploymentsGatewayError builds a deployments service diffDeployments
+// endpoint gateway_error error.
+func NewDiffDeploymentsGatewayError(body *DiffDeploymentsGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateGetDeploymentResponseBody runs the validations defined on
+// GetDeploymentResponseBody
+func ValidateGetDeploymentResponseBody(body *GetDeploymentResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.OrganizationID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	}
+	if body.ProjectID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Openapiv3Assets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("openapiv3_assets", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Packages == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("packages", "body"))
+	}
+	if body.Openapiv3ToolCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("openapiv3_tool_count", "body"))
+	}
+	if body.FunctionsToolCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("functions_tool_count", "body"))
+	}
+	if body.ExternalMcpToolCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("external_mcp_tool_count", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	for _, e := range body.Openapiv3Assets {
+		if e != nil {
+			if err2 := ValidateOpenAPIv3DeploymentAssetResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.FunctionsAssets {
+		if e != nil {
+			if err2 := ValidateDeploymentFunctionsResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Packages {
+		if e != nil {
+			if err2 := ValidateDeploymentPackageResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.ExternalMcps {
+		if e != nil {
+			if err2 := ValidateDeploymentExternalMCPResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetLatestDeploymentResponseBody runs the validations defined on
+// GetLatestDeploymentResponseBody
+func ValidateGetLatestDeploymentResponseBody(body *GetLatestDeploymentResponseBody) (err error) {
+	if body.Deployment != nil {
+		if err2 := ValidateDeploymentResponseBody(body.Deployment); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetActiveDeploymentResponseBody runs the validations defined on
+// GetActiveDeploymentResponseBody
+func ValidateGetActiveDeploymentResponseBody(body *GetActiveDeploymentResponseBody) (err error) {
+	if body.Deployment != nil {
 		if err2 := ValidateDeploymentResponseBody(body.Deployment); err2 != nil {
 			err = goa.MergeErrors(err, err2)
 		}
@@ -3540,6 +3947,43 @@ func ValidateGetDeploymentLogsResponseBody(body *GetDeploymentLogsResponseBody)
 	return
 }
 
+// ValidateDiffDeploymentsResponseBody runs the validations defined on
+// DiffDeploymentsResponseBody
+func ValidateDiffDeploymentsResponseBody(body *DiffDeploymentsResponseBody) (err error) {
+	if body.FromDeploymentID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from_deployment_id", "body"))
+	}
+	if body.ToDeploymentID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to_deployment_id", "body"))
+	}
+	if body.HasBreakingChanges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("has_breaking_changes", "body"))
+	}
+	if body.ToolsAdded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tools_added", "body"))
+	}
+	if body.ToolsRemoved == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tools_removed", "body"))
+	}
+	if body.ToolsChanged == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tools_changed", "body"))
+	}
+	if body.BreakingChanges == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("breaking_changes", "body"))
+	}
+	if body.Changes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("changes", "body"))
+	}
+	for _, e := range body.Changes {
+		if e != nil {
+			if err2 := ValidateDeploymentDiffChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
 // ValidateGetDeploymentUnauthorizedResponseBody runs the validations defined
 // on getDeployment_unauthorized_response_body
 func ValidateGetDeploymentUnauthorizedResponseBody(body *GetDeploymentUnauthorizedResponseBody) (err error) {
@@ -5460,6 +5904,246 @@ func ValidateGetDeploymentLogsGatewayErrorResponseBody(body *GetDeploymentLogsGa
 	return
 }
 
+// ValidateDiffDeploymentsUnauthorizedResponseBody runs the validations defined
+// on diffDeployments_unauthorized_response_body
+func ValidateDiffDeploymentsUnauthorizedResponseBody(body *DiffDeploymentsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffDeploymentsForbiddenResponseBody runs the validations defined on
+// diffDeployments_forbidden_response_body
+func ValidateDiffDeploymentsForbiddenResponseBody(body *DiffDeploymentsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffDeploymentsBadRequestResponseBody runs the validations defined
+// on diffDeployments_bad_request_response_body
+func ValidateDiffDeploymentsBadRequestResponseBody(body *DiffDeploymentsBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffDeploymentsNotFoundResponseBody runs the validations defined on
+// diffDeployments_not_found_response_body
+func ValidateDiffDeploymentsNotFoundResponseBody(body *DiffDeploymentsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffDeploymentsConflictResponseBody runs the validations defined on
+// diffDeployments_conflict_response_body
+func ValidateDiffDeploymentsConflictResponseBody(body *DiffDeploymentsConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffDeploymentsUnsupportedMediaResponseBody runs the validations
+// defined on diffDeployments_unsupported_media_response_body
+func ValidateDiffDeploymentsUnsupportedMediaResponseBody(body *DiffDeploymentsUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffDeploymentsInvalidResponseBody runs the validations defined on
+// diffDeployments_invalid_response_body
+func ValidateDiffDeploymentsInvalidResponseBody(body *DiffDeploymentsInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffDeploymentsInvariantViolationResponseBody runs the validations
+// defined on diffDeployments_invariant_violation_response_body
+func ValidateDiffDeploymentsInvariantViolationResponseBody(body *DiffDeploymentsInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffDeploymentsUnexpectedResponseBody runs the validations defined
+// on diffDeployments_unexpected_response_body
+func ValidateDiffDeploymentsUnexpectedResponseBody(body *DiffDeploymentsUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffDeploymentsGatewayErrorResponseBody runs the validations defined
+// on diffDeployments_gateway_error_response_body
+func ValidateDiffDeploymentsGatewayErrorResponseBody(body *DiffDeploymentsGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateOpenAPIv3DeploymentAssetResponseBody runs the validations defined on
 // OpenAPIv3DeploymentAssetResponseBody
 func ValidateOpenAPIv3DeploymentAssetResponseBody(body *OpenAPIv3DeploymentAssetResponseBody) (err error) {
@@ -5738,3 +6422,34 @@ func ValidateDeploymentLogEventResponseBody(body *DeploymentLogEventResponseBody
 	}
 	return
 }
+
+// ValidateDeploymentDiffChangeResponseBody runs the validations defined on
+// DeploymentDiffChangeResponseBody
+func ValidateDeploymentDiffChangeResponseBody(body *DeploymentDiffChangeResponseBody) (err error) {
+	if body.ToolUrn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tool_urn", "body"))
+	}
+	if body.ToolName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tool_name", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Severity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("severity", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "tool_added" || *body.Kind == "tool_removed" || *body.Kind == "input_schema" || *body.Kind == "annotations" || *body.Kind == "security" || *body.Kind == "server_url") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"tool_added", "tool_removed", "input_schema", "annotations", "security", "server_url"}))
+		}
+	}
+	if body.Severity != nil {
+		if !(*body.Severity == "additive" || *body.Severity == "breaking") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.severity", *body.Severity, []any{"additive", "breaking"}))
+		}
+	}
+	return
+}
@@ -27,6 +27,7 @@ type Server struct {
 	Redeploy            http.Handler
 	ListDeployments     http.Handler
 	GetDeploymentLogs   http.Handler
+	DiffDeployments     http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -64,6 +65,7 @@ func New(
 			{"Redeploy", "POST", "/rpc/deployments.redeploy"},
 			{"ListDeployments", "GET", "/rpc/deployments.list"},
 			{"GetDeploymentLogs", "GET", "/rpc/deployments.logs"},
+			{"DiffDeployments", "GET", "/rpc/deployments.diff"},
 		},
 		GetDeployment:       NewGetDeploymentHandler(e.GetDeployment, mux, decoder, encoder, errhandler, formatter),
 		GetLatestDeployment: NewGetLatestDeploymentHandler(e.GetLatestDeployment, mux, decoder, encoder, errhandler, formatter),
@@ -73,6 +75,7 @@ func New(
 		Redeploy:            NewRedeployHandler(e.Redeploy, mux, decoder, encoder, errhandler, formatter),
 		ListDeployments:     NewListDeploymentsHandler(e.ListDeployments, mux, decoder, encoder, errhandler, formatter),
 		GetDeploymentLogs:   NewGetDeploymentLogsHandler(e.GetDeploymentLogs, mux, decoder, encoder, errhandler, formatter),
+		DiffDeployments:     NewDiffDeploymentsHandler(e.DiffDeployments, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -89,6 +92,7 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Redeploy = m(s.Redeploy)
 	s.ListDeployments = m(s.ListDeployments)
 	s.GetDeploymentLogs = m(s.GetDeploymentLogs)
+	s.DiffDeployments = m(s.DiffDeployments)
 }
 
 // MethodNames returns the methods served.
@@ -104,6 +108,7 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountRedeployHandler(mux, h.Redeploy)
 	MountListDeploymentsHandler(mux, h.ListDeployments)
 	MountGetDeploymentLogsHandler(mux, h.GetDeploymentLogs)
+	MountDiffDeploymentsHandler(mux, h.DiffDeployments)
 }
 
 // Mount configures the mux to serve the deployments endpoints.
@@ -534,3 +539,56 @@ func NewGetDeploymentLogsHandler(
 		}
 	})
 }
+
+// MountDiffDeploymentsHandler configures the mux to serve the "deployments"
+// service "diffDeployments" endpoint.
+func MountDiffDeploymentsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/rpc/deployments.diff", f)
+}
+
+// NewDiffDeploymentsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "deployments" service "diffDeployments" endpoint.
+func NewDiffDeploymentsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDiffDeploymentsRequest(mux, decoder)
+		encodeResponse = EncodeDiffDeploymentsResponse(encoder)
+		encodeError    = EncodeDiffDeploymentsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "diffDeployments")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "deployments")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
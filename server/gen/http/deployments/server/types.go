@@ -21,6 +21,9 @@ type CreateDeploymentRequestBody struct {
 	// request will return immediately and the deployment will proceed
 	// asynchronously.
 	NonBlocking *bool `form:"non_blocking,omitempty" json:"non_blocking,omitempty" xml:"non_blocking,omitempty"`
+	// If true, the processed deployment is compared with the active deployment and
+	// fails instead of becoming active when it introduces breaking changes.
+	FailOnBreaking *bool `form:"fail_on_breaking,omitempty" json:"fail_on_breaking,omitempty" xml:"fail_on_breaking,omitempty"`
 	// The github repository in the form of "owner/repo".
 	GithubRepo *string `form:"github_repo,omitempty" json:"github_repo,omitempty" xml:"github_repo,omitempty"`
 	// The github pull request that resulted in the deployment.
@@ -49,6 +52,9 @@ type EvolveRequestBody struct {
 	// request will return immediately and the deployment will proceed
 	// asynchronously.
 	NonBlocking *bool `form:"non_blocking,omitempty" json:"non_blocking,omitempty" xml:"non_blocking,omitempty"`
+	// If true, the processed deployment is compared with the active deployment and
+	// fails instead of becoming active when it introduces breaking changes.
+	FailOnBreaking *bool `form:"fail_on_breaking,omitempty" json:"fail_on_breaking,omitempty" xml:"fail_on_breaking,omitempty"`
 	// The OpenAPI 3.x documents to upsert in the new deployment.
 	UpsertOpenapiv3Assets []*AddOpenAPIv3DeploymentAssetFormRequestBody `form:"upsert_openapiv3_assets,omitempty" json:"upsert_openapiv3_assets,omitempty" xml:"upsert_openapiv3_assets,omitempty"`
 	// The packages to upsert in the new deployment.
@@ -182,6 +188,27 @@ type GetDeploymentLogsResponseBody struct {
 	Events []*DeploymentLogEventResponseBody `form:"events" json:"events" xml:"events"`
 }
 
+// DiffDeploymentsResponseBody is the type of the "deployments" service
+// "diffDeployments" endpoint HTTP response body.
+type DiffDeploymentsResponseBody struct {
+	// The ID of the deployment compared from
+	FromDeploymentID string `form:"from_deployment_id" json:"from_deployment_id" xml:"from_deployment_id"`
+	// The ID of the deployment compared to
+	ToDeploymentID string `form:"to_deployment_id" json:"to_deployment_id" xml:"to_deployment_id"`
+	// Whether any change can break existing callers
+	HasBreakingChanges bool `form:"has_breaking_changes" json:"has_breaking_changes" xml:"has_breaking_changes"`
+	// The number of tools only in the newer deployment
+	ToolsAdded int `form:"tools_added" json:"tools_added" xml:"tools_added"`
+	// The number of tools only in the older deployment
+	ToolsRemoved int `form:"tools_removed" json:"tools_removed" xml:"tools_removed"`
+	// The number of tools in both deployments with at least one change
+	ToolsChanged int `form:"tools_changed" json:"tools_changed" xml:"tools_changed"`
+	// The number of breaking changes
+	BreakingChanges int `form:"breaking_changes" json:"breaking_changes" xml:"breaking_changes"`
+	// The changes ordered by tool URN
+	Changes []*DeploymentDiffChangeResponseBody `form:"changes" json:"changes" xml:"changes"`
+}
+
 // GetDeploymentUnauthorizedResponseBody is the type of the "deployments"
 // service "getDeployment" endpoint HTTP response body for the "unauthorized"
 // error.
@@ -1675,6 +1702,193 @@ type GetDeploymentLogsGatewayErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// DiffDeploymentsUnauthorizedResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the "unauthorized"
+// error.
+type DiffDeploymentsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffDeploymentsForbiddenResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the "forbidden"
+// error.
+type DiffDeploymentsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffDeploymentsBadRequestResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the "bad_request"
+// error.
+type DiffDeploymentsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffDeploymentsNotFoundResponseBody is the type of the "deployments" service
+// "diffDeployments" endpoint HTTP response body for the "not_found" error.
+type DiffDeploymentsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffDeploymentsConflictResponseBody is the type of the "deployments" service
+// "diffDeployments" endpoint HTTP response body for the "conflict" error.
+type DiffDeploymentsConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffDeploymentsUnsupportedMediaResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the
+// "unsupported_media" error.
+type DiffDeploymentsUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffDeploymentsInvalidResponseBody is the type of the "deployments" service
+// "diffDeployments" endpoint HTTP response body for the "invalid" error.
+type DiffDeploymentsInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffDeploymentsInvariantViolationResponseBody is the type of the
+// "deployments" service "diffDeployments" endpoint HTTP response body for the
+// "invariant_violation" error.
+type DiffDeploymentsInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffDeploymentsUnexpectedResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the "unexpected"
+// error.
+type DiffDeploymentsUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffDeploymentsGatewayErrorResponseBody is the type of the "deployments"
+// service "diffDeployments" endpoint HTTP response body for the
+// "gateway_error" error.
+type DiffDeploymentsGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // OpenAPIv3DeploymentAssetResponseBody is used to define fields on response
 // body types.
 type OpenAPIv3DeploymentAssetResponseBody struct {
@@ -1825,6 +2039,23 @@ type DeploymentLogEventResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// DeploymentDiffChangeResponseBody is used to define fields on response body
+// types.
+type DeploymentDiffChangeResponseBody struct {
+	// The URN of the changed tool
+	ToolUrn string `form:"tool_urn" json:"tool_urn" xml:"tool_urn"`
+	// The name of the changed tool
+	ToolName string `form:"tool_name" json:"tool_name" xml:"tool_name"`
+	// The aspect of the tool that changed
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Whether the change can break existing callers
+	Severity string `form:"severity" json:"severity" xml:"severity"`
+	// The location of an input schema change, e.g. body.status or ids[]
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// A human readable description of the change
+	Description string `form:"description" json:"description" xml:"description"`
+}
+
 // AddOpenAPIv3DeploymentAssetFormRequestBody is used to define fields on
 // request body types.
 type AddOpenAPIv3DeploymentAssetFormRequestBody struct {
@@ -2052,6 +2283,33 @@ func NewGetDeploymentLogsResponseBody(res *deployments.GetDeploymentLogsResult)
 	return body
 }
 
+// NewDiffDeploymentsResponseBody builds the HTTP response body from the result
+// of the "diffDeployments" endpoint of the "deployments" service.
+func NewDiffDeploymentsResponseBody(res *deployments.DiffDeploymentsResult) *DiffDeploymentsResponseBody {
+	body := &DiffDeploymentsResponseBody{
+		FromDeploymentID:   res.FromDeploymentID,
+		ToDeploymentID:     res.ToDeploymentID,
+		HasBreakingChanges: res.HasBreakingChanges,
+		ToolsAdded:         res.ToolsAdded,
+		ToolsRemoved:       res.ToolsRemoved,
+		ToolsChanged:       res.ToolsChanged,
+		BreakingChanges:    res.BreakingChanges,
+	}
+	if res.Changes != nil {
+		body.Changes = make([]*DeploymentDiffChangeResponseBody, len(res.Changes))
+		for i, val := range res.Changes {
+			if val == nil {
+				body.Changes[i] = nil
+				continue
+			}
+			body.Changes[i] = marshalDeploymentsDeploymentDiffChangeToDeploymentDiffChangeResponseBody(val)
+		}
+	} else {
+		body.Changes = []*DeploymentDiffChangeResponseBody{}
+	}
+	return body
+}
+
 // NewGetDeploymentUnauthorizedResponseBody builds the HTTP response body from
 // the result of the "getDeployment" endpoint of the "deployments" service.
 func NewGetDeploymentUnauthorizedResponseBody(res *goa.ServiceError) *GetDeploymentUnauthorizedResponseBody {
@@ -3206,6 +3464,150 @@ func NewGetDeploymentLogsGatewayErrorResponseBody(res *goa.ServiceError) *GetDep
 	return body
 }
 
+// NewDiffDeploymentsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "diffDeployments" endpoint of the "deployments"
+// service.
+func NewDiffDeploymentsUnauthorizedResponseBody(res *goa.ServiceError) *DiffDeploymentsUnauthorizedResponseBody {
+	body := &DiffDeploymentsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffDeploymentsForbiddenResponseBody builds the HTTP response body from
+// the result of the "diffDeployments" endpoint of the "deployments" service.
+func NewDiffDeploymentsForbiddenResponseBody(res *goa.ServiceError) *DiffDeploymentsForbiddenResponseBody {
+	body := &DiffDeploymentsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffDeploymentsBadRequestResponseBody builds the HTTP response body from
+// the result of the "diffDeployments" endpoint of the "deployments" service.
+func NewDiffDeploymentsBadRequestResponseBody(res *goa.ServiceError) *DiffDeploymentsBadRequestResponseBody {
+	body := &DiffDeploymentsBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffDeploymentsNotFoundResponseBody builds the HTTP response body from
+// the result of the "diffDeployments" endpoint of the "deployments" service.
+func NewDiffDeploymentsNotFoundResponseBody(res *goa.ServiceError) *DiffDeploymentsNotFoundResponseBody {
+	body := &DiffDeploymentsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffDeploymentsConflictResponseBody builds the HTTP response body from
+// the result of the "diffDeployments" endpoint of the "deployments" service.
+func NewDiffDeploymentsConflictResponseBody(res *goa.ServiceError) *DiffDeploymentsConflictResponseBody {
+	body := &DiffDeploymentsConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffDeploymentsUnsupportedMediaResponseBody builds the HTTP response body
+// from the result of the "diffDeployments" endpoint of the "deployments"
+// service.
+func NewDiffDeploymentsUnsupportedMediaResponseBody(res *goa.ServiceError) *DiffDeploymentsUnsupportedMediaResponseBody {
+	body := &DiffDeploymentsUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffDeploymentsInvalidResponseBody builds the HTTP response body from the
+// result of the "diffDeployments" endpoint of the "deployments" service.
+func NewDiffDeploymentsInvalidResponseBody(res *goa.ServiceError) *DiffDeploymentsInvalidResponseBody {
+	body := &DiffDeploymentsInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffDeploymentsInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "diffDeployments" endpoint of the "deployments"
+// service.
+func NewDiffDeploymentsInvariantViolationResponseBody(res *goa.ServiceError) *DiffDeploymentsInvariantViolationResponseBody {
+	body := &DiffDeploymentsInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffDeploymentsUnexpectedResponseBody builds the HTTP response body from
+// the result of the "diffDeployments" endpoint of the "deployments" service.
+func NewDiffDeploymentsUnexpectedResponseBody(res *goa.ServiceError) *DiffDeploymentsUnexpectedResponseBody {
+	body := &DiffDeploymentsUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffDeploymentsGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "diffDeployments" endpoint of the "deployments"
+// service.
+func NewDiffDeploymentsGatewayErrorResponseBody(res *goa.ServiceError) *DiffDeploymentsGatewayErrorResponseBody {
+	body := &DiffDeploymentsGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewGetDeploymentPayload builds a deployments service getDeployment endpoint
 // payload.
 func NewGetDeploymentPayload(id string, apikeyToken *string, sessionToken *string, projectSlugInput *string) *deployments.GetDeploymentPayload {
@@ -3244,12 +3646,13 @@ func NewGetActiveDeploymentPayload(apikeyToken *string, sessionToken *string, pr
 // endpoint payload.
 func NewCreateDeploymentPayload(body *CreateDeploymentRequestBody, apikeyToken *string, sessionToken *string, projectSlugInput *string, idempotencyKey string) *deployments.CreateDeploymentPayload {
 	v := &deployments.CreateDeploymentPayload{
-		NonBlocking: body.NonBlocking,
-		GithubRepo:  body.GithubRepo,
-		GithubPr:    body.GithubPr,
-		GithubSha:   body.GithubSha,
-		ExternalID:  body.ExternalID,
-		ExternalURL: body.ExternalURL,
+		NonBlocking:    body.NonBlocking,
+		FailOnBreaking: body.FailOnBreaking,
+		GithubRepo:     body.GithubRepo,
+		GithubPr:       body.GithubPr,
+		GithubSha:      body.GithubSha,
+		ExternalID:     body.ExternalID,
+		ExternalURL:    body.ExternalURL,
 	}
 	if body.Openapiv3Assets != nil {
 		v.Openapiv3Assets = make([]*deployments.AddOpenAPIv3DeploymentAssetForm, len(body.Openapiv3Assets))
@@ -3302,8 +3705,9 @@ func NewCreateDeploymentPayload(body *CreateDeploymentRequestBody, apikeyToken *
 // NewEvolvePayload builds a deployments service evolve endpoint payload.
 func NewEvolvePayload(body *EvolveRequestBody, apikeyToken *string, sessionToken *string, projectSlugInput *string) *deployments.EvolvePayload {
 	v := &deployments.EvolvePayload{
-		DeploymentID: body.DeploymentID,
-		NonBlocking:  body.NonBlocking,
+		DeploymentID:   body.DeploymentID,
+		NonBlocking:    body.NonBlocking,
+		FailOnBreaking: body.FailOnBreaking,
 	}
 	if body.UpsertOpenapiv3Assets != nil {
 		v.UpsertOpenapiv3Assets = make([]*deployments.AddOpenAPIv3DeploymentAssetForm, len(body.UpsertOpenapiv3Assets))
@@ -3413,6 +3817,19 @@ func NewGetDeploymentLogsPayload(deploymentID string, cursor *string, apikeyToke
 	return v
 }
 
+// NewDiffDeploymentsPayload builds a deployments service diffDeployments
+// endpoint payload.
+func NewDiffDeploymentsPayload(fromID string, toID string, apikeyToken *string, sessionToken *string, projectSlugInput *string) *deployments.DiffDeploymentsPayload {
+	v := &deployments.DiffDeploymentsPayload{}
+	v.FromID = fromID
+	v.ToID = toID
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v
+}
+
 // ValidateCreateDeploymentRequestBody runs the validations defined on
 // CreateDeploymentRequestBody
 func ValidateCreateDeploymentRequestBody(body *CreateDeploymentRequestBody) (err error) {
@@ -25,7 +25,7 @@ func BuildCreateMcpEndpointPayload(mcpEndpointsCreateMcpEndpointBody string, mcp
 	{
 		err = json.Unmarshal([]byte(mcpEndpointsCreateMcpEndpointBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"custom_domain_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"meta_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"slug\": \"aaa\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"custom_domain_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"meta_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"slug\": \"aaa\",\n      \"toolset_version\": 2\n   }'")
 		}
 		if body.CustomDomainID != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.custom_domain_id", *body.CustomDomainID, goa.FormatUUID))
@@ -36,6 +36,11 @@ func BuildCreateMcpEndpointPayload(mcpEndpointsCreateMcpEndpointBody string, mcp
 		if body.MetaMcpServerID != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.meta_mcp_server_id", *body.MetaMcpServerID, goa.FormatUUID))
 		}
+		if body.ToolsetVersion != nil {
+			if *body.ToolsetVersion < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.toolset_version", *body.ToolsetVersion, 1, true))
+			}
+		}
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", body.Slug, "^[a-z0-9_-]{1,128}$"))
 		if utf8.RuneCountInString(body.Slug) > 128 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", body.Slug, utf8.RuneCountInString(body.Slug), 128, false))
@@ -66,6 +71,7 @@ func BuildCreateMcpEndpointPayload(mcpEndpointsCreateMcpEndpointBody string, mcp
 		CustomDomainID:  body.CustomDomainID,
 		McpServerID:     body.McpServerID,
 		MetaMcpServerID: body.MetaMcpServerID,
+		ToolsetVersion:  body.ToolsetVersion,
 		Slug:            types.McpEndpointSlug(body.Slug),
 	}
 	v.SessionToken = sessionToken
@@ -204,7 +210,7 @@ func BuildUpdateMcpEndpointPayload(mcpEndpointsUpdateMcpEndpointBody string, mcp
 	{
 		err = json.Unmarshal([]byte(mcpEndpointsUpdateMcpEndpointBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"custom_domain_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"meta_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"slug\": \"aaa\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"custom_domain_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"meta_mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"slug\": \"aaa\",\n      \"toolset_version\": 2\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatUUID))
 		if body.CustomDomainID != nil {
@@ -216,6 +222,11 @@ func BuildUpdateMcpEndpointPayload(mcpEndpointsUpdateMcpEndpointBody string, mcp
 		if body.MetaMcpServerID != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.meta_mcp_server_id", *body.MetaMcpServerID, goa.FormatUUID))
 		}
+		if body.ToolsetVersion != nil {
+			if *body.ToolsetVersion < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.toolset_version", *body.ToolsetVersion, 1, true))
+			}
+		}
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", body.Slug, "^[a-z0-9_-]{1,128}$"))
 		if utf8.RuneCountInString(body.Slug) > 128 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", body.Slug, utf8.RuneCountInString(body.Slug), 128, false))
@@ -247,6 +258,7 @@ func BuildUpdateMcpEndpointPayload(mcpEndpointsUpdateMcpEndpointBody string, mcp
 		CustomDomainID:  body.CustomDomainID,
 		McpServerID:     body.McpServerID,
 		MetaMcpServerID: body.MetaMcpServerID,
+		ToolsetVersion:  body.ToolsetVersion,
 		Slug:            types.McpEndpointSlug(body.Slug),
 	}
 	v.SessionToken = sessionToken
@@ -347,3 +359,246 @@ func BuildDeleteMcpEndpointPayload(mcpEndpointsDeleteMcpEndpointID string, mcpEn
 
 	return v, nil
 }
+
+// BuildStartMcpEndpointCanaryPayload builds the payload for the mcpEndpoints
+// startMcpEndpointCanary endpoint from CLI flags.
+func BuildStartMcpEndpointCanaryPayload(mcpEndpointsStartMcpEndpointCanaryBody string, mcpEndpointsStartMcpEndpointCanarySessionToken string, mcpEndpointsStartMcpEndpointCanaryApikeyToken string, mcpEndpointsStartMcpEndpointCanaryProjectSlugInput string) (*mcpendpoints.StartMcpEndpointCanaryPayload, error) {
+	var err error
+	var body StartMcpEndpointCanaryRequestBody
+	{
+		err = json.Unmarshal([]byte(mcpEndpointsStartMcpEndpointCanaryBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"baseline_deployment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"candidate_deployment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"mcp_endpoint_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"percentage\": 1,\n      \"sticky_by\": \"user\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", body.McpEndpointID, goa.FormatUUID))
+		if body.BaselineDeploymentID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.baseline_deployment_id", *body.BaselineDeploymentID, goa.FormatUUID))
+		}
+		if body.CandidateDeploymentID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.candidate_deployment_id", *body.CandidateDeploymentID, goa.FormatUUID))
+		}
+		if body.Percentage < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", body.Percentage, 0, true))
+		}
+		if body.Percentage > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", body.Percentage, 100, false))
+		}
+		if !(body.StickyBy == "session" || body.StickyBy == "user") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.sticky_by", body.StickyBy, []any{"session", "user"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if mcpEndpointsStartMcpEndpointCanarySessionToken != "" {
+			sessionToken = &mcpEndpointsStartMcpEndpointCanarySessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if mcpEndpointsStartMcpEndpointCanaryApikeyToken != "" {
+			apikeyToken = &mcpEndpointsStartMcpEndpointCanaryApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if mcpEndpointsStartMcpEndpointCanaryProjectSlugInput != "" {
+			projectSlugInput = &mcpEndpointsStartMcpEndpointCanaryProjectSlugInput
+		}
+	}
+	v := &mcpendpoints.StartMcpEndpointCanaryPayload{
+		McpEndpointID:         body.McpEndpointID,
+		BaselineDeploymentID:  body.BaselineDeploymentID,
+		CandidateDeploymentID: body.CandidateDeploymentID,
+		Percentage:            body.Percentage,
+		StickyBy:              body.StickyBy,
+	}
+	{
+		var zero string
+		if v.StickyBy == zero {
+			v.StickyBy = "session"
+		}
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildGetMcpEndpointCanaryPayload builds the payload for the mcpEndpoints
+// getMcpEndpointCanary endpoint from CLI flags.
+func BuildGetMcpEndpointCanaryPayload(mcpEndpointsGetMcpEndpointCanaryMcpEndpointID string, mcpEndpointsGetMcpEndpointCanarySessionToken string, mcpEndpointsGetMcpEndpointCanaryApikeyToken string, mcpEndpointsGetMcpEndpointCanaryProjectSlugInput string) (*mcpendpoints.GetMcpEndpointCanaryPayload, error) {
+	var err error
+	var mcpEndpointID string
+	{
+		mcpEndpointID = mcpEndpointsGetMcpEndpointCanaryMcpEndpointID
+		err = goa.MergeErrors(err, goa.ValidateFormat("mcp_endpoint_id", mcpEndpointID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if mcpEndpointsGetMcpEndpointCanarySessionToken != "" {
+			sessionToken = &mcpEndpointsGetMcpEndpointCanarySessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if mcpEndpointsGetMcpEndpointCanaryApikeyToken != "" {
+			apikeyToken = &mcpEndpointsGetMcpEndpointCanaryApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if mcpEndpointsGetMcpEndpointCanaryProjectSlugInput != "" {
+			projectSlugInput = &mcpEndpointsGetMcpEndpointCanaryProjectSlugInput
+		}
+	}
+	v := &mcpendpoints.GetMcpEndpointCanaryPayload{}
+	v.McpEndpointID = mcpEndpointID
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildUpdateMcpEndpointCanaryPayload builds the payload for the mcpEndpoints
+// updateMcpEndpointCanary endpoint from CLI flags.
+func BuildUpdateMcpEndpointCanaryPayload(mcpEndpointsUpdateMcpEndpointCanaryBody string, mcpEndpointsUpdateMcpEndpointCanarySessionToken string, mcpEndpointsUpdateMcpEndpointCanaryApikeyToken string, mcpEndpointsUpdateMcpEndpointCanaryProjectSlugInput string) (*mcpendpoints.UpdateMcpEndpointCanaryPayload, error) {
+	var err error
+	var body UpdateMcpEndpointCanaryRequestBody
+	{
+		err = json.Unmarshal([]byte(mcpEndpointsUpdateMcpEndpointCanaryBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"mcp_endpoint_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"percentage\": 1\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", body.McpEndpointID, goa.FormatUUID))
+		if body.Percentage < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", body.Percentage, 0, true))
+		}
+		if body.Percentage > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", body.Percentage, 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if mcpEndpointsUpdateMcpEndpointCanarySessionToken != "" {
+			sessionToken = &mcpEndpointsUpdateMcpEndpointCanarySessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if mcpEndpointsUpdateMcpEndpointCanaryApikeyToken != "" {
+			apikeyToken = &mcpEndpointsUpdateMcpEndpointCanaryApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if mcpEndpointsUpdateMcpEndpointCanaryProjectSlugInput != "" {
+			projectSlugInput = &mcpEndpointsUpdateMcpEndpointCanaryProjectSlugInput
+		}
+	}
+	v := &mcpendpoints.UpdateMcpEndpointCanaryPayload{
+		McpEndpointID: body.McpEndpointID,
+		Percentage:    body.Percentage,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildPromoteMcpEndpointCanaryPayload builds the payload for the mcpEndpoints
+// promoteMcpEndpointCanary endpoint from CLI flags.
+func BuildPromoteMcpEndpointCanaryPayload(mcpEndpointsPromoteMcpEndpointCanaryBody string, mcpEndpointsPromoteMcpEndpointCanarySessionToken string, mcpEndpointsPromoteMcpEndpointCanaryApikeyToken string, mcpEndpointsPromoteMcpEndpointCanaryProjectSlugInput string) (*mcpendpoints.PromoteMcpEndpointCanaryPayload, error) {
+	var err error
+	var body PromoteMcpEndpointCanaryRequestBody
+	{
+		err = json.Unmarshal([]byte(mcpEndpointsPromoteMcpEndpointCanaryBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"mcp_endpoint_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", body.McpEndpointID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if mcpEndpointsPromoteMcpEndpointCanarySessionToken != "" {
+			sessionToken = &mcpEndpointsPromoteMcpEndpointCanarySessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if mcpEndpointsPromoteMcpEndpointCanaryApikeyToken != "" {
+			apikeyToken = &mcpEndpointsPromoteMcpEndpointCanaryApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if mcpEndpointsPromoteMcpEndpointCanaryProjectSlugInput != "" {
+			projectSlugInput = &mcpEndpointsPromoteMcpEndpointCanaryProjectSlugInput
+		}
+	}
+	v := &mcpendpoints.PromoteMcpEndpointCanaryPayload{
+		McpEndpointID: body.McpEndpointID,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildAbortMcpEndpointCanaryPayload builds the payload for the mcpEndpoints
+// abortMcpEndpointCanary endpoint from CLI flags.
+func BuildAbortMcpEndpointCanaryPayload(mcpEndpointsAbortMcpEndpointCanaryBody string, mcpEndpointsAbortMcpEndpointCanarySessionToken string, mcpEndpointsAbortMcpEndpointCanaryApikeyToken string, mcpEndpointsAbortMcpEndpointCanaryProjectSlugInput string) (*mcpendpoints.AbortMcpEndpointCanaryPayload, error) {
+	var err error
+	var body AbortMcpEndpointCanaryRequestBody
+	{
+		err = json.Unmarshal([]byte(mcpEndpointsAbortMcpEndpointCanaryBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"mcp_endpoint_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", body.McpEndpointID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if mcpEndpointsAbortMcpEndpointCanarySessionToken != "" {
+			sessionToken = &mcpEndpointsAbortMcpEndpointCanarySessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if mcpEndpointsAbortMcpEndpointCanaryApikeyToken != "" {
+			apikeyToken = &mcpEndpointsAbortMcpEndpointCanaryApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if mcpEndpointsAbortMcpEndpointCanaryProjectSlugInput != "" {
+			projectSlugInput = &mcpEndpointsAbortMcpEndpointCanaryProjectSlugInput
+		}
+	}
+	v := &mcpendpoints.AbortMcpEndpointCanaryPayload{
+		McpEndpointID: body.McpEndpointID,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
@@ -41,6 +41,26 @@ type Client struct {
 	// deleteMcpEndpoint endpoint.
 	DeleteMcpEndpointDoer goahttp.Doer
 
+	// StartMcpEndpointCanary Doer is the HTTP client used to make requests to the
+	// startMcpEndpointCanary endpoint.
+	StartMcpEndpointCanaryDoer goahttp.Doer
+
+	// GetMcpEndpointCanary Doer is the HTTP client used to make requests to the
+	// getMcpEndpointCanary endpoint.
+	GetMcpEndpointCanaryDoer goahttp.Doer
+
+	// UpdateMcpEndpointCanary Doer is the HTTP client used to make requests to the
+	// updateMcpEndpointCanary endpoint.
+	UpdateMcpEndpointCanaryDoer goahttp.Doer
+
+	// PromoteMcpEndpointCanary Doer is the HTTP client used to make requests to
+	// the promoteMcpEndpointCanary endpoint.
+	PromoteMcpEndpointCanaryDoer goahttp.Doer
+
+	// AbortMcpEndpointCanary Doer is the HTTP client used to make requests to the
+	// abortMcpEndpointCanary endpoint.
+	AbortMcpEndpointCanaryDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -67,6 +87,11 @@ func NewClient(
 		UpdateMcpEndpointDoer:                doer,
 		CheckMcpEndpointSlugAvailabilityDoer: doer,
 		DeleteMcpEndpointDoer:                doer,
+		StartMcpEndpointCanaryDoer:           doer,
+		GetMcpEndpointCanaryDoer:             doer,
+		UpdateMcpEndpointCanaryDoer:          doer,
+		PromoteMcpEndpointCanaryDoer:         doer,
+		AbortMcpEndpointCanaryDoer:           doer,
 		RestoreResponseBody:                  restoreBody,
 		scheme:                               scheme,
 		host:                                 host,
@@ -218,3 +243,123 @@ func (c *Client) DeleteMcpEndpoint() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// StartMcpEndpointCanary returns an endpoint that makes HTTP requests to the
+// mcpEndpoints service startMcpEndpointCanary server.
+func (c *Client) StartMcpEndpointCanary() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeStartMcpEndpointCanaryRequest(c.encoder)
+		decodeResponse = DecodeStartMcpEndpointCanaryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildStartMcpEndpointCanaryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.StartMcpEndpointCanaryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mcpEndpoints", "startMcpEndpointCanary", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetMcpEndpointCanary returns an endpoint that makes HTTP requests to the
+// mcpEndpoints service getMcpEndpointCanary server.
+func (c *Client) GetMcpEndpointCanary() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetMcpEndpointCanaryRequest(c.encoder)
+		decodeResponse = DecodeGetMcpEndpointCanaryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetMcpEndpointCanaryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetMcpEndpointCanaryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mcpEndpoints", "getMcpEndpointCanary", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateMcpEndpointCanary returns an endpoint that makes HTTP requests to the
+// mcpEndpoints service updateMcpEndpointCanary server.
+func (c *Client) UpdateMcpEndpointCanary() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateMcpEndpointCanaryRequest(c.encoder)
+		decodeResponse = DecodeUpdateMcpEndpointCanaryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateMcpEndpointCanaryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateMcpEndpointCanaryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mcpEndpoints", "updateMcpEndpointCanary", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PromoteMcpEndpointCanary returns an endpoint that makes HTTP requests to the
+// mcpEndpoints service promoteMcpEndpointCanary server.
+func (c *Client) PromoteMcpEndpointCanary() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePromoteMcpEndpointCanaryRequest(c.encoder)
+		decodeResponse = DecodePromoteMcpEndpointCanaryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPromoteMcpEndpointCanaryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PromoteMcpEndpointCanaryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AbortMcpEndpointCanary returns an endpoint that makes HTTP requests to the
+// mcpEndpoints service abortMcpEndpointCanary server.
+func (c *Client) AbortMcpEndpointCanary() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAbortMcpEndpointCanaryRequest(c.encoder)
+		decodeResponse = DecodeAbortMcpEndpointCanaryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAbortMcpEndpointCanaryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AbortMcpEndpointCanaryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("mcpEndpoints", "abortMcpEndpointCanary", err)
+		}
+		return decodeResponse(resp)
+	}
+}
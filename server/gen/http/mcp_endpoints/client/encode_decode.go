@@ -1468,6 +1468,1220 @@ func DecodeDeleteMcpEndpointResponse(decoder func(*http.Response) goahttp.Decode
 	}
 }
 
+// BuildStartMcpEndpointCanaryRequest instantiates a HTTP request object with
+// method and path set to call the "mcpEndpoints" service
+// "startMcpEndpointCanary" endpoint
+func (c *Client) BuildStartMcpEndpointCanaryRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: StartMcpEndpointCanaryMcpEndpointsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mcpEndpoints", "startMcpEndpointCanary", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeStartMcpEndpointCanaryRequest returns an encoder for requests sent to
+// the mcpEndpoints startMcpEndpointCanary server.
+func EncodeStartMcpEndpointCanaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mcpendpoints.StartMcpEndpointCanaryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mcpEndpoints", "startMcpEndpointCanary", "*mcpendpoints.StartMcpEndpointCanaryPayload", v)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.ProjectSlugInput != nil {
+			head := *p.ProjectSlugInput
+			req.Header.Set("Gram-Project", head)
+		}
+		body := NewStartMcpEndpointCanaryRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+		}
+		return nil
+	}
+}
+
+// DecodeStartMcpEndpointCanaryResponse returns a decoder for responses
+// returned by the mcpEndpoints startMcpEndpointCanary endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeStartMcpEndpointCanaryResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeStartMcpEndpointCanaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body StartMcpEndpointCanaryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			err = ValidateStartMcpEndpointCanaryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			res := NewStartMcpEndpointCanaryMcpEndpointCanaryOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body StartMcpEndpointCanaryUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			err = ValidateStartMcpEndpointCanaryUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			return nil, NewStartMcpEndpointCanaryUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body StartMcpEndpointCanaryForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			err = ValidateStartMcpEndpointCanaryForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			return nil, NewStartMcpEndpointCanaryForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body StartMcpEndpointCanaryBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			err = ValidateStartMcpEndpointCanaryBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			return nil, NewStartMcpEndpointCanaryBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body StartMcpEndpointCanaryNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			err = ValidateStartMcpEndpointCanaryNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			return nil, NewStartMcpEndpointCanaryNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body StartMcpEndpointCanaryConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			err = ValidateStartMcpEndpointCanaryConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			return nil, NewStartMcpEndpointCanaryConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body StartMcpEndpointCanaryUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			err = ValidateStartMcpEndpointCanaryUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			return nil, NewStartMcpEndpointCanaryUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body StartMcpEndpointCanaryInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			err = ValidateStartMcpEndpointCanaryInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			return nil, NewStartMcpEndpointCanaryInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body StartMcpEndpointCanaryInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+				}
+				err = ValidateStartMcpEndpointCanaryInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("mcpEndpoints", "startMcpEndpointCanary", err)
+				}
+				return nil, NewStartMcpEndpointCanaryInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body StartMcpEndpointCanaryUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+				}
+				err = ValidateStartMcpEndpointCanaryUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("mcpEndpoints", "startMcpEndpointCanary", err)
+				}
+				return nil, NewStartMcpEndpointCanaryUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("mcpEndpoints", "startMcpEndpointCanary", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body StartMcpEndpointCanaryGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			err = ValidateStartMcpEndpointCanaryGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "startMcpEndpointCanary", err)
+			}
+			return nil, NewStartMcpEndpointCanaryGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mcpEndpoints", "startMcpEndpointCanary", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetMcpEndpointCanaryRequest instantiates a HTTP request object with
+// method and path set to call the "mcpEndpoints" service
+// "getMcpEndpointCanary" endpoint
+func (c *Client) BuildGetMcpEndpointCanaryRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetMcpEndpointCanaryMcpEndpointsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mcpEndpoints", "getMcpEndpointCanary", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetMcpEndpointCanaryRequest returns an encoder for requests sent to
+// the mcpEndpoints getMcpEndpointCanary server.
+func EncodeGetMcpEndpointCanaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mcpendpoints.GetMcpEndpointCanaryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mcpEndpoints", "getMcpEndpointCanary", "*mcpendpoints.GetMcpEndpointCanaryPayload", v)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.ProjectSlugInput != nil {
+			head := *p.ProjectSlugInput
+			req.Header.Set("Gram-Project", head)
+		}
+		values := req.URL.Query()
+		values.Add("mcp_endpoint_id", p.McpEndpointID)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetMcpEndpointCanaryResponse returns a decoder for responses returned
+// by the mcpEndpoints getMcpEndpointCanary endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeGetMcpEndpointCanaryResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeGetMcpEndpointCanaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetMcpEndpointCanaryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			err = ValidateGetMcpEndpointCanaryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			res := NewGetMcpEndpointCanaryMcpEndpointCanaryOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body GetMcpEndpointCanaryUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			err = ValidateGetMcpEndpointCanaryUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			return nil, NewGetMcpEndpointCanaryUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body GetMcpEndpointCanaryForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			err = ValidateGetMcpEndpointCanaryForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			return nil, NewGetMcpEndpointCanaryForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetMcpEndpointCanaryBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			err = ValidateGetMcpEndpointCanaryBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			return nil, NewGetMcpEndpointCanaryBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body GetMcpEndpointCanaryNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			err = ValidateGetMcpEndpointCanaryNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			return nil, NewGetMcpEndpointCanaryNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body GetMcpEndpointCanaryConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			err = ValidateGetMcpEndpointCanaryConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			return nil, NewGetMcpEndpointCanaryConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body GetMcpEndpointCanaryUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			err = ValidateGetMcpEndpointCanaryUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			return nil, NewGetMcpEndpointCanaryUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body GetMcpEndpointCanaryInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			err = ValidateGetMcpEndpointCanaryInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			return nil, NewGetMcpEndpointCanaryInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body GetMcpEndpointCanaryInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("mcpEndpoints", "getMcpEndpointCanary", err)
+				}
+				err = ValidateGetMcpEndpointCanaryInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("mcpEndpoints", "getMcpEndpointCanary", err)
+				}
+				return nil, NewGetMcpEndpointCanaryInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body GetMcpEndpointCanaryUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("mcpEndpoints", "getMcpEndpointCanary", err)
+				}
+				err = ValidateGetMcpEndpointCanaryUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("mcpEndpoints", "getMcpEndpointCanary", err)
+				}
+				return nil, NewGetMcpEndpointCanaryUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("mcpEndpoints", "getMcpEndpointCanary", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body GetMcpEndpointCanaryGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			err = ValidateGetMcpEndpointCanaryGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "getMcpEndpointCanary", err)
+			}
+			return nil, NewGetMcpEndpointCanaryGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mcpEndpoints", "getMcpEndpointCanary", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateMcpEndpointCanaryRequest instantiates a HTTP request object with
+// method and path set to call the "mcpEndpoints" service
+// "updateMcpEndpointCanary" endpoint
+func (c *Client) BuildUpdateMcpEndpointCanaryRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateMcpEndpointCanaryMcpEndpointsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mcpEndpoints", "updateMcpEndpointCanary", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateMcpEndpointCanaryRequest returns an encoder for requests sent to
+// the mcpEndpoints updateMcpEndpointCanary server.
+func EncodeUpdateMcpEndpointCanaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mcpendpoints.UpdateMcpEndpointCanaryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mcpEndpoints", "updateMcpEndpointCanary", "*mcpendpoints.UpdateMcpEndpointCanaryPayload", v)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.ProjectSlugInput != nil {
+			head := *p.ProjectSlugInput
+			req.Header.Set("Gram-Project", head)
+		}
+		body := NewUpdateMcpEndpointCanaryRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateMcpEndpointCanaryResponse returns a decoder for responses
+// returned by the mcpEndpoints updateMcpEndpointCanary endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeUpdateMcpEndpointCanaryResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeUpdateMcpEndpointCanaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateMcpEndpointCanaryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			err = ValidateUpdateMcpEndpointCanaryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			res := NewUpdateMcpEndpointCanaryMcpEndpointCanaryOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body UpdateMcpEndpointCanaryUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			err = ValidateUpdateMcpEndpointCanaryUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			return nil, NewUpdateMcpEndpointCanaryUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateMcpEndpointCanaryForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			err = ValidateUpdateMcpEndpointCanaryForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			return nil, NewUpdateMcpEndpointCanaryForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body UpdateMcpEndpointCanaryBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			err = ValidateUpdateMcpEndpointCanaryBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			return nil, NewUpdateMcpEndpointCanaryBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateMcpEndpointCanaryNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			err = ValidateUpdateMcpEndpointCanaryNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			return nil, NewUpdateMcpEndpointCanaryNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateMcpEndpointCanaryConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			err = ValidateUpdateMcpEndpointCanaryConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			return nil, NewUpdateMcpEndpointCanaryConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body UpdateMcpEndpointCanaryUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			err = ValidateUpdateMcpEndpointCanaryUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			return nil, NewUpdateMcpEndpointCanaryUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body UpdateMcpEndpointCanaryInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			err = ValidateUpdateMcpEndpointCanaryInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			return nil, NewUpdateMcpEndpointCanaryInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body UpdateMcpEndpointCanaryInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+				}
+				err = ValidateUpdateMcpEndpointCanaryInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("mcpEndpoints", "updateMcpEndpointCanary", err)
+				}
+				return nil, NewUpdateMcpEndpointCanaryInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body UpdateMcpEndpointCanaryUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+				}
+				err = ValidateUpdateMcpEndpointCanaryUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("mcpEndpoints", "updateMcpEndpointCanary", err)
+				}
+				return nil, NewUpdateMcpEndpointCanaryUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("mcpEndpoints", "updateMcpEndpointCanary", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body UpdateMcpEndpointCanaryGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			err = ValidateUpdateMcpEndpointCanaryGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "updateMcpEndpointCanary", err)
+			}
+			return nil, NewUpdateMcpEndpointCanaryGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mcpEndpoints", "updateMcpEndpointCanary", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPromoteMcpEndpointCanaryRequest instantiates a HTTP request object with
+// method and path set to call the "mcpEndpoints" service
+// "promoteMcpEndpointCanary" endpoint
+func (c *Client) BuildPromoteMcpEndpointCanaryRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PromoteMcpEndpointCanaryMcpEndpointsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mcpEndpoints", "promoteMcpEndpointCanary", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePromoteMcpEndpointCanaryRequest returns an encoder for requests sent
+// to the mcpEndpoints promoteMcpEndpointCanary server.
+func EncodePromoteMcpEndpointCanaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mcpendpoints.PromoteMcpEndpointCanaryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mcpEndpoints", "promoteMcpEndpointCanary", "*mcpendpoints.PromoteMcpEndpointCanaryPayload", v)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.ProjectSlugInput != nil {
+			head := *p.ProjectSlugInput
+			req.Header.Set("Gram-Project", head)
+		}
+		body := NewPromoteMcpEndpointCanaryRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+		}
+		return nil
+	}
+}
+
+// DecodePromoteMcpEndpointCanaryResponse returns a decoder for responses
+// returned by the mcpEndpoints promoteMcpEndpointCanary endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodePromoteMcpEndpointCanaryResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodePromoteMcpEndpointCanaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PromoteMcpEndpointCanaryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			err = ValidatePromoteMcpEndpointCanaryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			res := NewPromoteMcpEndpointCanaryMcpEndpointCanaryOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body PromoteMcpEndpointCanaryUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			err = ValidatePromoteMcpEndpointCanaryUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			return nil, NewPromoteMcpEndpointCanaryUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body PromoteMcpEndpointCanaryForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			err = ValidatePromoteMcpEndpointCanaryForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			return nil, NewPromoteMcpEndpointCanaryForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body PromoteMcpEndpointCanaryBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			err = ValidatePromoteMcpEndpointCanaryBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			return nil, NewPromoteMcpEndpointCanaryBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body PromoteMcpEndpointCanaryNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			err = ValidatePromoteMcpEndpointCanaryNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			return nil, NewPromoteMcpEndpointCanaryNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body PromoteMcpEndpointCanaryConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			err = ValidatePromoteMcpEndpointCanaryConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			return nil, NewPromoteMcpEndpointCanaryConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body PromoteMcpEndpointCanaryUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			err = ValidatePromoteMcpEndpointCanaryUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			return nil, NewPromoteMcpEndpointCanaryUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body PromoteMcpEndpointCanaryInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			err = ValidatePromoteMcpEndpointCanaryInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			return nil, NewPromoteMcpEndpointCanaryInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body PromoteMcpEndpointCanaryInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+				}
+				err = ValidatePromoteMcpEndpointCanaryInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+				}
+				return nil, NewPromoteMcpEndpointCanaryInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body PromoteMcpEndpointCanaryUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+				}
+				err = ValidatePromoteMcpEndpointCanaryUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+				}
+				return nil, NewPromoteMcpEndpointCanaryUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("mcpEndpoints", "promoteMcpEndpointCanary", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body PromoteMcpEndpointCanaryGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			err = ValidatePromoteMcpEndpointCanaryGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "promoteMcpEndpointCanary", err)
+			}
+			return nil, NewPromoteMcpEndpointCanaryGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mcpEndpoints", "promoteMcpEndpointCanary", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAbortMcpEndpointCanaryRequest instantiates a HTTP request object with
+// method and path set to call the "mcpEndpoints" service
+// "abortMcpEndpointCanary" endpoint
+func (c *Client) BuildAbortMcpEndpointCanaryRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AbortMcpEndpointCanaryMcpEndpointsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("mcpEndpoints", "abortMcpEndpointCanary", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAbortMcpEndpointCanaryRequest returns an encoder for requests sent to
+// the mcpEndpoints abortMcpEndpointCanary server.
+func EncodeAbortMcpEndpointCanaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*mcpendpoints.AbortMcpEndpointCanaryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("mcpEndpoints", "abortMcpEndpointCanary", "*mcpendpoints.AbortMcpEndpointCanaryPayload", v)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.ProjectSlugInput != nil {
+			head := *p.ProjectSlugInput
+			req.Header.Set("Gram-Project", head)
+		}
+		body := NewAbortMcpEndpointCanaryRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAbortMcpEndpointCanaryResponse returns a decoder for responses
+// returned by the mcpEndpoints abortMcpEndpointCanary endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeAbortMcpEndpointCanaryResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeAbortMcpEndpointCanaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body AbortMcpEndpointCanaryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			err = ValidateAbortMcpEndpointCanaryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			res := NewAbortMcpEndpointCanaryMcpEndpointCanaryOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body AbortMcpEndpointCanaryUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			err = ValidateAbortMcpEndpointCanaryUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			return nil, NewAbortMcpEndpointCanaryUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body AbortMcpEndpointCanaryForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			err = ValidateAbortMcpEndpointCanaryForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			return nil, NewAbortMcpEndpointCanaryForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body AbortMcpEndpointCanaryBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			err = ValidateAbortMcpEndpointCanaryBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			return nil, NewAbortMcpEndpointCanaryBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body AbortMcpEndpointCanaryNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			err = ValidateAbortMcpEndpointCanaryNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			return nil, NewAbortMcpEndpointCanaryNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body AbortMcpEndpointCanaryConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			err = ValidateAbortMcpEndpointCanaryConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			return nil, NewAbortMcpEndpointCanaryConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body AbortMcpEndpointCanaryUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			err = ValidateAbortMcpEndpointCanaryUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			return nil, NewAbortMcpEndpointCanaryUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body AbortMcpEndpointCanaryInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			err = ValidateAbortMcpEndpointCanaryInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			return nil, NewAbortMcpEndpointCanaryInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body AbortMcpEndpointCanaryInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+				}
+				err = ValidateAbortMcpEndpointCanaryInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("mcpEndpoints", "abortMcpEndpointCanary", err)
+				}
+				return nil, NewAbortMcpEndpointCanaryInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body AbortMcpEndpointCanaryUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+				}
+				err = ValidateAbortMcpEndpointCanaryUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("mcpEndpoints", "abortMcpEndpointCanary", err)
+				}
+				return nil, NewAbortMcpEndpointCanaryUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("mcpEndpoints", "abortMcpEndpointCanary", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body AbortMcpEndpointCanaryGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			err = ValidateAbortMcpEndpointCanaryGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("mcpEndpoints", "abortMcpEndpointCanary", err)
+			}
+			return nil, NewAbortMcpEndpointCanaryGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("mcpEndpoints", "abortMcpEndpointCanary", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // unmarshalMcpEndpointResponseBodyToTypesMcpEndpoint builds a value of type
 // *types.McpEndpoint from a value of type *McpEndpointResponseBody.
 func unmarshalMcpEndpointResponseBodyToTypesMcpEndpoint(v *McpEndpointResponseBody) *types.McpEndpoint {
@@ -1477,6 +2691,7 @@ func unmarshalMcpEndpointResponseBodyToTypesMcpEndpoint(v *McpEndpointResponseBo
 		CustomDomainID:  v.CustomDomainID,
 		McpServerID:     v.McpServerID,
 		MetaMcpServerID: v.MetaMcpServerID,
+		ToolsetVersion:  v.ToolsetVersion,
 		Slug:            types.McpEndpointSlug(*v.Slug),
 		IsDomainRoot:    *v.IsDomainRoot,
 		CreatedAt:       *v.CreatedAt,
@@ -1485,3 +2700,22 @@ func unmarshalMcpEndpointResponseBodyToTypesMcpEndpoint(v *McpEndpointResponseBo
 
 	return res
 }
+
+// unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm
+// builds a value of type *mcpendpoints.McpEndpointCanaryArm from a value of
+// type *McpEndpointCanaryArmResponseBody.
+func unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm(v *McpEndpointCanaryArmResponseBody) *mcpendpoints.McpEndpointCanaryArm {
+	if v == nil {
+		return nil
+	}
+	res := &mcpendpoints.McpEndpointCanaryArm{
+		DeploymentID:    *v.DeploymentID,
+		ToolCalls:       *v.ToolCalls,
+		FailedToolCalls: *v.FailedToolCalls,
+		ErrorRate:       *v.ErrorRate,
+		LatencyP50Ms:    *v.LatencyP50Ms,
+		LatencyP95Ms:    *v.LatencyP95Ms,
+	}
+
+	return res
+}
@@ -27,6 +27,10 @@ type CreateMcpEndpointRequestBody struct {
 	// The ID of the meta MCP server this endpoint addresses. Mutually exclusive
 	// with mcp_server_id.
 	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// Pin the endpoint to this version of its MCP server's toolset, overriding any
+	// version pinned on the server. Only valid for endpoints of toolset-backed MCP
+	// servers. Omit to follow the server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The slug
 	Slug string `form:"slug" json:"slug" xml:"slug"`
 }
@@ -45,10 +49,56 @@ type UpdateMcpEndpointRequestBody struct {
 	// The ID of the meta MCP server this endpoint addresses. Mutually exclusive
 	// with mcp_server_id.
 	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// Pin the endpoint to this version of its MCP server's toolset, overriding any
+	// version pinned on the server. Only valid for endpoints of toolset-backed MCP
+	// servers. Omit to follow the server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The slug
 	Slug string `form:"slug" json:"slug" xml:"slug"`
 }
 
+// StartMcpEndpointCanaryRequestBody is the type of the "mcpEndpoints" service
+// "startMcpEndpointCanary" endpoint HTTP request body.
+type StartMcpEndpointCanaryRequestBody struct {
+	// The ID of the MCP endpoint
+	McpEndpointID string `form:"mcp_endpoint_id" json:"mcp_endpoint_id" xml:"mcp_endpoint_id"`
+	// The deployment serving the rest of the traffic. Defaults to the active
+	// deployment.
+	BaselineDeploymentID *string `form:"baseline_deployment_id,omitempty" json:"baseline_deployment_id,omitempty" xml:"baseline_deployment_id,omitempty"`
+	// The deployment under test. Omit to arm the canary before pushing: the
+	// candidate then follows the project's active deployment, so the next
+	// deployment only reaches the configured share of callers.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage int32 `form:"percentage" json:"percentage" xml:"percentage"`
+	// Which request attribute keeps a caller on the same deployment. Requests
+	// without a user fall back to their Mcp-Session-Id.
+	StickyBy string `form:"sticky_by" json:"sticky_by" xml:"sticky_by"`
+}
+
+// UpdateMcpEndpointCanaryRequestBody is the type of the "mcpEndpoints" service
+// "updateMcpEndpointCanary" endpoint HTTP request body.
+type UpdateMcpEndpointCanaryRequestBody struct {
+	// The ID of the MCP endpoint
+	McpEndpointID string `form:"mcp_endpoint_id" json:"mcp_endpoint_id" xml:"mcp_endpoint_id"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage int32 `form:"percentage" json:"percentage" xml:"percentage"`
+}
+
+// PromoteMcpEndpointCanaryRequestBody is the type of the "mcpEndpoints"
+// service "promoteMcpEndpointCanary" endpoint HTTP request body.
+type PromoteMcpEndpointCanaryRequestBody struct {
+	// The ID of the MCP endpoint
+	McpEndpointID string `form:"mcp_endpoint_id" json:"mcp_endpoint_id" xml:"mcp_endpoint_id"`
+}
+
+// AbortMcpEndpointCanaryRequestBody is the type of the "mcpEndpoints" service
+// "abortMcpEndpointCanary" endpoint HTTP request body.
+type AbortMcpEndpointCanaryRequestBody struct {
+	// The ID of the MCP endpoint
+	McpEndpointID string `form:"mcp_endpoint_id" json:"mcp_endpoint_id" xml:"mcp_endpoint_id"`
+}
+
 // CreateMcpEndpointResponseBody is the type of the "mcpEndpoints" service
 // "createMcpEndpoint" endpoint HTTP response body.
 type CreateMcpEndpointResponseBody struct {
@@ -65,6 +115,9 @@ type CreateMcpEndpointResponseBody struct {
 	// The ID of the meta MCP server this endpoint addresses. Null for
 	// MCP-server-backed endpoints.
 	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// The toolset version this endpoint is pinned to, if any. Overrides the
+	// version pinned on its MCP server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The slug
 	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
 	// Whether this endpoint is mapped to its custom-domain root
@@ -91,6 +144,9 @@ type GetMcpEndpointResponseBody struct {
 	// The ID of the meta MCP server this endpoint addresses. Null for
 	// MCP-server-backed endpoints.
 	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// The toolset version this endpoint is pinned to, if any. Overrides the
+	// version pinned on its MCP server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The slug
 	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
 	// Whether this endpoint is mapped to its custom-domain root
@@ -123,6 +179,9 @@ type UpdateMcpEndpointResponseBody struct {
 	// The ID of the meta MCP server this endpoint addresses. Null for
 	// MCP-server-backed endpoints.
 	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// The toolset version this endpoint is pinned to, if any. Overrides the
+	// version pinned on its MCP server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The slug
 	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
 	// Whether this endpoint is mapped to its custom-domain root
@@ -133,6 +192,146 @@ type UpdateMcpEndpointResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// StartMcpEndpointCanaryResponseBody is the type of the "mcpEndpoints" service
+// "startMcpEndpointCanary" endpoint HTTP response body.
+type StartMcpEndpointCanaryResponseBody struct {
+	// The ID of the canary
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The ID of the MCP endpoint
+	McpEndpointID *string `form:"mcp_endpoint_id,omitempty" json:"mcp_endpoint_id,omitempty" xml:"mcp_endpoint_id,omitempty"`
+	// The deployment serving the rest of the traffic
+	BaselineDeploymentID *string `form:"baseline_deployment_id,omitempty" json:"baseline_deployment_id,omitempty" xml:"baseline_deployment_id,omitempty"`
+	// The deployment under test. Null while a running canary follows the active
+	// deployment.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage *int32 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Which request attribute keeps a caller on the same deployment
+	StickyBy *string `form:"sticky_by,omitempty" json:"sticky_by,omitempty" xml:"sticky_by,omitempty"`
+	// The canary's lifecycle state
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// When the canary started
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// When the canary was promoted or aborted
+	EndedAt *string `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.
+	Baseline *McpEndpointCanaryArmResponseBody `form:"baseline,omitempty" json:"baseline,omitempty" xml:"baseline,omitempty"`
+	// Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.
+	Candidate *McpEndpointCanaryArmResponseBody `form:"candidate,omitempty" json:"candidate,omitempty" xml:"candidate,omitempty"`
+}
+
+// GetMcpEndpointCanaryResponseBody is the type of the "mcpEndpoints" service
+// "getMcpEndpointCanary" endpoint HTTP response body.
+type GetMcpEndpointCanaryResponseBody struct {
+	// The ID of the canary
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The ID of the MCP endpoint
+	McpEndpointID *string `form:"mcp_endpoint_id,omitempty" json:"mcp_endpoint_id,omitempty" xml:"mcp_endpoint_id,omitempty"`
+	// The deployment serving the rest of the traffic
+	BaselineDeploymentID *string `form:"baseline_deployment_id,omitempty" json:"baseline_deployment_id,omitempty" xml:"baseline_deployment_id,omitempty"`
+	// The deployment under test. Null while a running canary follows the active
+	// deployment.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage *int32 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Which request attribute keeps a caller on the same deployment
+	StickyBy *string `form:"sticky_by,omitempty" json:"sticky_by,omitempty" xml:"sticky_by,omitempty"`
+	// The canary's lifecycle state
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// When the canary started
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// When the canary was promoted or aborted
+	EndedAt *string `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.
+	Baseline *McpEndpointCanaryArmResponseBody `form:"baseline,omitempty" json:"baseline,omitempty" xml:"baseline,omitempty"`
+	// Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.
+	Candidate *McpEndpointCanaryArmResponseBody `form:"candidate,omitempty" json:"candidate,omitempty" xml:"candidate,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryResponseBody is the type of the "mcpEndpoints"
+// service "updateMcpEndpointCanary" endpoint HTTP response body.
+type UpdateMcpEndpointCanaryResponseBody struct {
+	// The ID of the canary
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The ID of the MCP endpoint
+	McpEndpointID *string `form:"mcp_endpoint_id,omitempty" json:"mcp_endpoint_id,omitempty" xml:"mcp_endpoint_id,omitempty"`
+	// The deployment serving the rest of the traffic
+	BaselineDeploymentID *string `form:"baseline_deployment_id,omitempty" json:"baseline_deployment_id,omitempty" xml:"baseline_deployment_id,omitempty"`
+	// The deployment under test. Null while a running canary follows the active
+	// deployment.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage *int32 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Which request attribute keeps a caller on the same deployment
+	StickyBy *string `form:"sticky_by,omitempty" json:"sticky_by,omitempty" xml:"sticky_by,omitempty"`
+	// The canary's lifecycle state
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// When the canary started
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// When the canary was promoted or aborted
+	EndedAt *string `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.
+	Baseline *McpEndpointCanaryArmResponseBody `form:"baseline,omitempty" json:"baseline,omitempty" xml:"baseline,omitempty"`
+	// Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.
+	Candidate *McpEndpointCanaryArmResponseBody `form:"candidate,omitempty" json:"candidate,omitempty" xml:"candidate,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryResponseBody is the type of the "mcpEndpoints"
+// service "promoteMcpEndpointCanary" endpoint HTTP response body.
+type PromoteMcpEndpointCanaryResponseBody struct {
+	// The ID of the canary
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The ID of the MCP endpoint
+	McpEndpointID *string `form:"mcp_endpoint_id,omitempty" json:"mcp_endpoint_id,omitempty" xml:"mcp_endpoint_id,omitempty"`
+	// The deployment serving the rest of the traffic
+	BaselineDeploymentID *string `form:"baseline_deployment_id,omitempty" json:"baseline_deployment_id,omitempty" xml:"baseline_deployment_id,omitempty"`
+	// The deployment under test. Null while a running canary follows the active
+	// deployment.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage *int32 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Which request attribute keeps a caller on the same deployment
+	StickyBy *string `form:"sticky_by,omitempty" json:"sticky_by,omitempty" xml:"sticky_by,omitempty"`
+	// The canary's lifecycle state
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// When the canary started
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// When the canary was promoted or aborted
+	EndedAt *string `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.
+	Baseline *McpEndpointCanaryArmResponseBody `form:"baseline,omitempty" json:"baseline,omitempty" xml:"baseline,omitempty"`
+	// Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.
+	Candidate *McpEndpointCanaryArmResponseBody `form:"candidate,omitempty" json:"candidate,omitempty" xml:"candidate,omitempty"`
+}
+
+// AbortMcpEndpointCanaryResponseBody is the type of the "mcpEndpoints" service
+// "abortMcpEndpointCanary" endpoint HTTP response body.
+type AbortMcpEndpointCanaryResponseBody struct {
+	// The ID of the canary
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The ID of the MCP endpoint
+	McpEndpointID *string `form:"mcp_endpoint_id,omitempty" json:"mcp_endpoint_id,omitempty" xml:"mcp_endpoint_id,omitempty"`
+	// The deployment serving the rest of the traffic
+	BaselineDeploymentID *string `form:"baseline_deployment_id,omitempty" json:"baseline_deployment_id,omitempty" xml:"baseline_deployment_id,omitempty"`
+	// The deployment under test. Null while a running canary follows the active
+	// deployment.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage *int32 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Which request attribute keeps a caller on the same deployment
+	StickyBy *string `form:"sticky_by,omitempty" json:"sticky_by,omitempty" xml:"sticky_by,omitempty"`
+	// The canary's lifecycle state
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// When the canary started
+	StartedAt *string `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// When the canary was promoted or aborted
+	EndedAt *string `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.
+	Baseline *McpEndpointCanaryArmResponseBody `form:"baseline,omitempty" json:"baseline,omitempty" xml:"baseline,omitempty"`
+	// Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.
+	Candidate *McpEndpointCanaryArmResponseBody `form:"candidate,omitempty" json:"candidate,omitempty" xml:"candidate,omitempty"`
+}
+
 // CreateMcpEndpointUnauthorizedResponseBody is the type of the "mcpEndpoints"
 // service "createMcpEndpoint" endpoint HTTP response body for the
 // "unauthorized" error.
@@ -1270,141 +1469,2711 @@ type DeleteMcpEndpointGatewayErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// McpEndpointResponseBody is used to define fields on response body types.
-type McpEndpointResponseBody struct {
-	// The ID of the MCP endpoint
+// StartMcpEndpointCanaryUnauthorizedResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "unauthorized" error.
+type StartMcpEndpointCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// The project ID this MCP endpoint belongs to
-	ProjectID *string `form:"project_id,omitempty" json:"project_id,omitempty" xml:"project_id,omitempty"`
-	// The ID of the custom domain this endpoint slug is registered under. Null for
-	// platform-domain endpoints.
-	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
-	// The ID of the MCP server this endpoint addresses. Null for meta-MCP-backed
-	// endpoints.
-	McpServerID *string `form:"mcp_server_id,omitempty" json:"mcp_server_id,omitempty" xml:"mcp_server_id,omitempty"`
-	// The ID of the meta MCP server this endpoint addresses. Null for
-	// MCP-server-backed endpoints.
-	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
-	// The slug
-	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
-	// Whether this endpoint is mapped to its custom-domain root
-	IsDomainRoot *bool `form:"is_domain_root,omitempty" json:"is_domain_root,omitempty" xml:"is_domain_root,omitempty"`
-	// When the MCP endpoint was created
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// When the MCP endpoint was last updated
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCreateMcpEndpointRequestBody builds the HTTP request body from the
-// payload of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewCreateMcpEndpointRequestBody(p *mcpendpoints.CreateMcpEndpointPayload) *CreateMcpEndpointRequestBody {
-	body := &CreateMcpEndpointRequestBody{
-		CustomDomainID:  p.CustomDomainID,
-		McpServerID:     p.McpServerID,
-		MetaMcpServerID: p.MetaMcpServerID,
-		Slug:            string(p.Slug),
-	}
-	return body
+// StartMcpEndpointCanaryForbiddenResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "forbidden" error.
+type StartMcpEndpointCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewUpdateMcpEndpointRequestBody builds the HTTP request body from the
-// payload of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewUpdateMcpEndpointRequestBody(p *mcpendpoints.UpdateMcpEndpointPayload) *UpdateMcpEndpointRequestBody {
-	body := &UpdateMcpEndpointRequestBody{
-		ID:              p.ID,
-		CustomDomainID:  p.CustomDomainID,
-		McpServerID:     p.McpServerID,
-		MetaMcpServerID: p.MetaMcpServerID,
-		Slug:            string(p.Slug),
-	}
-	return body
+// StartMcpEndpointCanaryBadRequestResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "bad_request" error.
+type StartMcpEndpointCanaryBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCreateMcpEndpointMcpEndpointOK builds a "mcpEndpoints" service
-// "createMcpEndpoint" endpoint result from a HTTP "OK" response.
-func NewCreateMcpEndpointMcpEndpointOK(body *CreateMcpEndpointResponseBody) *types.McpEndpoint {
-	v := &types.McpEndpoint{
-		ID:              *body.ID,
-		ProjectID:       *body.ProjectID,
-		CustomDomainID:  body.CustomDomainID,
-		McpServerID:     body.McpServerID,
-		MetaMcpServerID: body.MetaMcpServerID,
-		Slug:            types.McpEndpointSlug(*body.Slug),
-		IsDomainRoot:    *body.IsDomainRoot,
-		CreatedAt:       *body.CreatedAt,
-		UpdatedAt:       *body.UpdatedAt,
-	}
-
-	return v
+// StartMcpEndpointCanaryNotFoundResponseBody is the type of the "mcpEndpoints"
+// service "startMcpEndpointCanary" endpoint HTTP response body for the
+// "not_found" error.
+type StartMcpEndpointCanaryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCreateMcpEndpointUnauthorized builds a mcpEndpoints service
-// createMcpEndpoint endpoint unauthorized error.
-func NewCreateMcpEndpointUnauthorized(body *CreateMcpEndpointUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
+// StartMcpEndpointCanaryConflictResponseBody is the type of the "mcpEndpoints"
+// service "startMcpEndpointCanary" endpoint HTTP response body for the
+// "conflict" error.
+type StartMcpEndpointCanaryConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCreateMcpEndpointForbidden builds a mcpEndpoints service
-// createMcpEndpoint endpoint forbidden error.
-func NewCreateMcpEndpointForbidden(body *CreateMcpEndpointForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
+// StartMcpEndpointCanaryUnsupportedMediaResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "unsupported_media" error.
+type StartMcpEndpointCanaryUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCreateMcpEndpointBadRequest builds a mcpEndpoints service
-// createMcpEndpoint endpoint bad_request error.
-func NewCreateMcpEndpointBadRequest(body *CreateMcpEndpointBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
+// StartMcpEndpointCanaryInvalidResponseBody is the type of the "mcpEndpoints"
+// service "startMcpEndpointCanary" endpoint HTTP response body for the
+// "invalid" error.
+type StartMcpEndpointCanaryInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCreateMcpEndpointNotFound builds a mcpEndpoints service createMcpEndpoint
-// endpoint not_found error.
-func NewCreateMcpEndpointNotFound(body *CreateMcpEndpointNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
+// StartMcpEndpointCanaryInvariantViolationResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "invariant_violation" error.
+type StartMcpEndpointCanaryInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
 
-	return v
+// StartMcpEndpointCanaryUnexpectedResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "unexpected" error.
+type StartMcpEndpointCanaryUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewCreateMcpEndpointConflict builds a mcpEndpoints service createMcpEndpoint
-// endpoint conflict error.
-func NewCreateMcpEndpointConflict(body *CreateMcpEndpointConflictResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
+// StartMcpEndpointCanaryGatewayErrorResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "gateway_error" error.
+type StartMcpEndpointCanaryGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetMcpEndpointCanaryUnauthorizedResponseBody is the type of the
+// "mcpEndpoints" service "getMcpEndpointCanary" endpoint HTTP response body
+// for the "unauthorized" error.
+type GetMcpEndpointCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetMcpEndpointCanaryForbiddenResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the
+// "forbidden" error.
+type GetMcpEndpointCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetMcpEndpointCanaryBadRequestResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the
+// "bad_request" error.
+type GetMcpEndpointCanaryBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetMcpEndpointCanaryNotFoundResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the
+// "not_found" error.
+type GetMcpEndpointCanaryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetMcpEndpointCanaryConflictResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the
+// "conflict" error.
+type GetMcpEndpointCanaryConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetMcpEndpointCanaryUnsupportedMediaResponseBody is the type of the
+// "mcpEndpoints" service "getMcpEndpointCanary" endpoint HTTP response body
+// for the "unsupported_media" error.
+type GetMcpEndpointCanaryUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetMcpEndpointCanaryInvalidResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the "invalid"
+// error.
+type GetMcpEndpointCanaryInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetMcpEndpointCanaryInvariantViolationResponseBody is the type of the
+// "mcpEndpoints" service "getMcpEndpointCanary" endpoint HTTP response body
+// for the "invariant_violation" error.
+type GetMcpEndpointCanaryInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetMcpEndpointCanaryUnexpectedResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the
+// "unexpected" error.
+type GetMcpEndpointCanaryUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetMcpEndpointCanaryGatewayErrorResponseBody is the type of the
+// "mcpEndpoints" service "getMcpEndpointCanary" endpoint HTTP response body
+// for the "gateway_error" error.
+type GetMcpEndpointCanaryGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryUnauthorizedResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "unauthorized" error.
+type UpdateMcpEndpointCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryForbiddenResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "forbidden" error.
+type UpdateMcpEndpointCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryBadRequestResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "bad_request" error.
+type UpdateMcpEndpointCanaryBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryNotFoundResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "not_found" error.
+type UpdateMcpEndpointCanaryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryConflictResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "conflict" error.
+type UpdateMcpEndpointCanaryConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryUnsupportedMediaResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "unsupported_media" error.
+type UpdateMcpEndpointCanaryUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryInvalidResponseBody is the type of the "mcpEndpoints"
+// service "updateMcpEndpointCanary" endpoint HTTP response body for the
+// "invalid" error.
+type UpdateMcpEndpointCanaryInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryInvariantViolationResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "invariant_violation" error.
+type UpdateMcpEndpointCanaryInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryUnexpectedResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "unexpected" error.
+type UpdateMcpEndpointCanaryUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryGatewayErrorResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "gateway_error" error.
+type UpdateMcpEndpointCanaryGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryUnauthorizedResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "unauthorized" error.
+type PromoteMcpEndpointCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryForbiddenResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "forbidden" error.
+type PromoteMcpEndpointCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryBadRequestResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "bad_request" error.
+type PromoteMcpEndpointCanaryBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryNotFoundResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "not_found" error.
+type PromoteMcpEndpointCanaryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryConflictResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "conflict" error.
+type PromoteMcpEndpointCanaryConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryUnsupportedMediaResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "unsupported_media" error.
+type PromoteMcpEndpointCanaryUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryInvalidResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "invalid" error.
+type PromoteMcpEndpointCanaryInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryInvariantViolationResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "invariant_violation" error.
+type PromoteMcpEndpointCanaryInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryUnexpectedResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "unexpected" error.
+type PromoteMcpEndpointCanaryUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryGatewayErrorResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "gateway_error" error.
+type PromoteMcpEndpointCanaryGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AbortMcpEndpointCanaryUnauthorizedResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "unauthorized" error.
+type AbortMcpEndpointCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AbortMcpEndpointCanaryForbiddenResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "forbidden" error.
+type AbortMcpEndpointCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AbortMcpEndpointCanaryBadRequestResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "bad_request" error.
+type AbortMcpEndpointCanaryBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AbortMcpEndpointCanaryNotFoundResponseBody is the type of the "mcpEndpoints"
+// service "abortMcpEndpointCanary" endpoint HTTP response body for the
+// "not_found" error.
+type AbortMcpEndpointCanaryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AbortMcpEndpointCanaryConflictResponseBody is the type of the "mcpEndpoints"
+// service "abortMcpEndpointCanary" endpoint HTTP response body for the
+// "conflict" error.
+type AbortMcpEndpointCanaryConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AbortMcpEndpointCanaryUnsupportedMediaResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "unsupported_media" error.
+type AbortMcpEndpointCanaryUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AbortMcpEndpointCanaryInvalidResponseBody is the type of the "mcpEndpoints"
+// service "abortMcpEndpointCanary" endpoint HTTP response body for the
+// "invalid" error.
+type AbortMcpEndpointCanaryInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AbortMcpEndpointCanaryInvariantViolationResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "invariant_violation" error.
+type AbortMcpEndpointCanaryInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AbortMcpEndpointCanaryUnexpectedResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "unexpected" error.
+type AbortMcpEndpointCanaryUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AbortMcpEndpointCanaryGatewayErrorResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "gateway_error" error.
+type AbortMcpEndpointCanaryGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// McpEndpointResponseBody is used to define fields on response body types.
+type McpEndpointResponseBody struct {
+	// The ID of the MCP endpoint
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The project ID this MCP endpoint belongs to
+	ProjectID *string `form:"project_id,omitempty" json:"project_id,omitempty" xml:"project_id,omitempty"`
+	// The ID of the custom domain this endpoint slug is registered under. Null for
+	// platform-domain endpoints.
+	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
+	// The ID of the MCP server this endpoint addresses. Null for meta-MCP-backed
+	// endpoints.
+	McpServerID *string `form:"mcp_server_id,omitempty" json:"mcp_server_id,omitempty" xml:"mcp_server_id,omitempty"`
+	// The ID of the meta MCP server this endpoint addresses. Null for
+	// MCP-server-backed endpoints.
+	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// The toolset version this endpoint is pinned to, if any. Overrides the
+	// version pinned on its MCP server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
+	// The slug
+	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
+	// Whether this endpoint is mapped to its custom-domain root
+	IsDomainRoot *bool `form:"is_domain_root,omitempty" json:"is_domain_root,omitempty" xml:"is_domain_root,omitempty"`
+	// When the MCP endpoint was created
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// When the MCP endpoint was last updated
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// McpEndpointCanaryArmResponseBody is used to define fields on response body
+// types.
+type McpEndpointCanaryArmResponseBody struct {
+	// The deployment serving this arm
+	DeploymentID *string `form:"deployment_id,omitempty" json:"deployment_id,omitempty" xml:"deployment_id,omitempty"`
+	// Tool calls served by this arm
+	ToolCalls *int64 `form:"tool_calls,omitempty" json:"tool_calls,omitempty" xml:"tool_calls,omitempty"`
+	// Tool calls that returned an HTTP status of 400 or above
+	FailedToolCalls *int64 `form:"failed_tool_calls,omitempty" json:"failed_tool_calls,omitempty" xml:"failed_tool_calls,omitempty"`
+	// Failed tool calls as a fraction of all tool calls. Zero when there were no
+	// calls.
+	ErrorRate *float64 `form:"error_rate,omitempty" json:"error_rate,omitempty" xml:"error_rate,omitempty"`
+	// Median tool call latency in milliseconds
+	LatencyP50Ms *float64 `form:"latency_p50_ms,omitempty" json:"latency_p50_ms,omitempty" xml:"latency_p50_ms,omitempty"`
+	// 95th percentile tool call latency in milliseconds
+	LatencyP95Ms *float64 `form:"latency_p95_ms,omitempty" json:"latency_p95_ms,omitempty" xml:"latency_p95_ms,omitempty"`
+}
+
+// NewCreateMcpEndpointRequestBody builds the HTTP request body from the
+// payload of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewCreateMcpEndpointRequestBody(p *mcpendpoints.CreateMcpEndpointPayload) *CreateMcpEndpointRequestBody {
+	body := &CreateMcpEndpointRequestBody{
+		CustomDomainID:  p.CustomDomainID,
+		McpServerID:     p.McpServerID,
+		MetaMcpServerID: p.MetaMcpServerID,
+		ToolsetVersion:  p.ToolsetVersion,
+		Slug:            string(p.Slug),
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointRequestBody builds the HTTP request body from the
+// payload of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewUpdateMcpEndpointRequestBody(p *mcpendpoints.UpdateMcpEndpointPayload) *UpdateMcpEndpointRequestBody {
+	body := &UpdateMcpEndpointRequestBody{
+		ID:              p.ID,
+		CustomDomainID:  p.CustomDomainID,
+		McpServerID:     p.McpServerID,
+		MetaMcpServerID: p.MetaMcpServerID,
+		ToolsetVersion:  p.ToolsetVersion,
+		Slug:            string(p.Slug),
+	}
+	return body
+}
+
+// NewStartMcpEndpointCanaryRequestBody builds the HTTP request body from the
+// payload of the "startMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewStartMcpEndpointCanaryRequestBody(p *mcpendpoints.StartMcpEndpointCanaryPayload) *StartMcpEndpointCanaryRequestBody {
+	body := &StartMcpEndpointCanaryRequestBody{
+		McpEndpointID:         p.McpEndpointID,
+		BaselineDeploymentID:  p.BaselineDeploymentID,
+		CandidateDeploymentID: p.CandidateDeploymentID,
+		Percentage:            p.Percentage,
+		StickyBy:              p.StickyBy,
+	}
+	{
+		var zero string
+		if body.StickyBy == zero {
+			body.StickyBy = "session"
+		}
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointCanaryRequestBody builds the HTTP request body from the
+// payload of the "updateMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewUpdateMcpEndpointCanaryRequestBody(p *mcpendpoints.UpdateMcpEndpointCanaryPayload) *UpdateMcpEndpointCanaryRequestBody {
+	body := &UpdateMcpEndpointCanaryRequestBody{
+		McpEndpointID: p.McpEndpointID,
+		Percentage:    p.Percentage,
+	}
+	return body
+}
+
+// NewPromoteMcpEndpointCanaryRequestBody builds the HTTP request body from the
+// payload of the "promoteMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewPromoteMcpEndpointCanaryRequestBody(p *mcpendpoints.PromoteMcpEndpointCanaryPayload) *PromoteMcpEndpointCanaryRequestBody {
+	body := &PromoteMcpEndpointCanaryRequestBody{
+		McpEndpointID: p.McpEndpointID,
+	}
+	return body
+}
+
+// NewAbortMcpEndpointCanaryRequestBody builds the HTTP request body from the
+// payload of the "abortMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewAbortMcpEndpointCanaryRequestBody(p *mcpendpoints.AbortMcpEndpointCanaryPayload) *AbortMcpEndpointCanaryRequestBody {
+	body := &AbortMcpEndpointCanaryRequestBody{
+		McpEndpointID: p.McpEndpointID,
+	}
+	return body
+}
+
+// NewCreateMcpEndpointMcpEndpointOK builds a "mcpEndpoints" service
+// "createMcpEndpoint" endpoint result from a HTTP "OK" response.
+func NewCreateMcpEndpointMcpEndpointOK(body *CreateMcpEndpointResponseBody) *types.McpEndpoint {
+	v := &types.McpEndpoint{
+		ID:              *body.ID,
+		ProjectID:       *body.ProjectID,
+		CustomDomainID:  body.CustomDomainID,
+		McpServerID:     body.McpServerID,
+		MetaMcpServerID: body.MetaMcpServerID,
+		ToolsetVersion:  body.ToolsetVersion,
+		Slug:            types.McpEndpointSlug(*body.Slug),
+		IsDomainRoot:    *body.IsDomainRoot,
+		CreatedAt:       *body.CreatedAt,
+		UpdatedAt:       *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewCreateMcpEndpointUnauthorized builds a mcpEndpoints service
+// createMcpEndpoint endpoint unauthorized error.
+func NewCreateMcpEndpointUnauthorized(body *CreateMcpEndpointUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateMcpEndpointForbidden builds a mcpEndpoints service
+// createMcpEndpoint endpoint forbidden error.
+func NewCreateMcpEndpointForbidden(body *CreateMcpEndpointForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateMcpEndpointBadRequest builds a mcpEndpoints service
+// createMcpEndpoint endpoint bad_request error.
+func NewCreateMcpEndpointBadRequest(body *CreateMcpEndpointBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateMcpEndpointNotFound builds a mcpEndpoints service createMcpEndpoint
+// endpoint not_found error.
+func NewCreateMcpEndpointNotFound(body *CreateMcpEndpointNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateMcpEndpointConflict builds a mcpEndpoints service createMcpEndpoint
+// endpoint conflict error.
+func NewCreateMcpEndpointConflict(body *CreateMcpEndpointConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateMcpEndpointUnsupportedMedia builds a mcpEndpoints service
+// createMcpEndpoint endpoint unsupported_media error.
+func NewCreateMcpEndpointUnsupportedMedia(body *CreateMcpEndpointUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateMcpEndpointInvalid builds a mcpEndpoints service createMcpEndpoint
+// endpoint invalid error.
+func NewCreateMcpEndpointInvalid(body *CreateMcpEndpointInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateMcpEndpointInvariantViolation builds a mcpEndpoints service
+// createMcpEndpoint endpoint invariant_violation error.
+func NewCreateMcpEndpointInvariantViolation(body *CreateMcpEndpointInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateMcpEndpointUnexpected builds a mcpEndpoints service
+// createMcpEndpoint endpoint unexpected error.
+func NewCreateMcpEndpointUnexpected(body *CreateMcpEndpointUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateMcpEndpointGatewayError builds a mcpEndpoints service
+// createMcpEndpoint endpoint gateway_error error.
+func NewCreateMcpEndpointGatewayError(body *CreateMcpEndpointGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointMcpEndpointOK builds a "mcpEndpoints" service
+// "getMcpEndpoint" endpoint result from a HTTP "OK" response.
+func NewGetMcpEndpointMcpEndpointOK(body *GetMcpEndpointResponseBody) *types.McpEndpoint {
+	v := &types.McpEndpoint{
+		ID:              *body.ID,
+		ProjectID:       *body.ProjectID,
+		CustomDomainID:  body.CustomDomainID,
+		McpServerID:     body.McpServerID,
+		MetaMcpServerID: body.MetaMcpServerID,
+		ToolsetVersion:  body.ToolsetVersion,
+		Slug:            types.McpEndpointSlug(*body.Slug),
+		IsDomainRoot:    *body.IsDomainRoot,
+		CreatedAt:       *body.CreatedAt,
+		UpdatedAt:       *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointUnauthorized builds a mcpEndpoints service getMcpEndpoint
+// endpoint unauthorized error.
+func NewGetMcpEndpointUnauthorized(body *GetMcpEndpointUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointForbidden builds a mcpEndpoints service getMcpEndpoint
+// endpoint forbidden error.
+func NewGetMcpEndpointForbidden(body *GetMcpEndpointForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointBadRequest builds a mcpEndpoints service getMcpEndpoint
+// endpoint bad_request error.
+func NewGetMcpEndpointBadRequest(body *GetMcpEndpointBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointNotFound builds a mcpEndpoints service getMcpEndpoint
+// endpoint not_found error.
+func NewGetMcpEndpointNotFound(body *GetMcpEndpointNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointConflict builds a mcpEndpoints service getMcpEndpoint
+// endpoint conflict error.
+func NewGetMcpEndpointConflict(body *GetMcpEndpointConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointUnsupportedMedia builds a mcpEndpoints service
+// getMcpEndpoint endpoint unsupported_media error.
+func NewGetMcpEndpointUnsupportedMedia(body *GetMcpEndpointUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointInvalid builds a mcpEndpoints service getMcpEndpoint
+// endpoint invalid error.
+func NewGetMcpEndpointInvalid(body *GetMcpEndpointInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointInvariantViolation builds a mcpEndpoints service
+// getMcpEndpoint endpoint invariant_violation error.
+func NewGetMcpEndpointInvariantViolation(body *GetMcpEndpointInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointUnexpected builds a mcpEndpoints service getMcpEndpoint
+// endpoint unexpected error.
+func NewGetMcpEndpointUnexpected(body *GetMcpEndpointUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointGatewayError builds a mcpEndpoints service getMcpEndpoint
+// endpoint gateway_error error.
+func NewGetMcpEndpointGatewayError(body *GetMcpEndpointGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListMcpEndpointsResultOK builds a "mcpEndpoints" service
+// "listMcpEndpoints" endpoint result from a HTTP "OK" response.
+func NewListMcpEndpointsResultOK(body *ListMcpEndpointsResponseBody) *mcpendpoints.ListMcpEndpointsResult {
+	v := &mcpendpoints.ListMcpEndpointsResult{}
+	v.McpEndpoints = make([]*types.McpEndpoint, len(body.McpEndpoints))
+	for i, val := range body.McpEndpoints {
+		if val == nil {
+			v.McpEndpoints[i] = nil
+			continue
+		}
+		v.McpEndpoints[i] = unmarshalMcpEndpointResponseBodyToTypesMcpEndpoint(val)
+	}
+
+	return v
+}
+
+// NewListMcpEndpointsUnauthorized builds a mcpEndpoints service
+// listMcpEndpoints endpoint unauthorized error.
+func NewListMcpEndpointsUnauthorized(body *ListMcpEndpointsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListMcpEndpointsForbidden builds a mcpEndpoints service listMcpEndpoints
+// endpoint forbidden error.
+func NewListMcpEndpointsForbidden(body *ListMcpEndpointsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListMcpEndpointsBadRequest builds a mcpEndpoints service listMcpEndpoints
+// endpoint bad_request error.
+func NewListMcpEndpointsBadRequest(body *ListMcpEndpointsBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListMcpEndpointsNotFound builds a mcpEndpoints service listMcpEndpoints
+// endpoint not_found error.
+func NewListMcpEndpointsNotFound(body *ListMcpEndpointsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListMcpEndpointsConflict builds a mcpEndpoints service listMcpEndpoints
+// endpoint conflict error.
+func NewListMcpEndpointsConflict(body *ListMcpEndpointsConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListMcpEndpointsUnsupportedMedia builds a mcpEndpoints service
+// listMcpEndpoints endpoint unsupported_media error.
+func NewListMcpEndpointsUnsupportedMedia(body *ListMcpEndpointsUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListMcpEndpointsInvalid builds a mcpEndpoints service listMcpEndpoints
+// endpoint invalid error.
+func NewListMcpEndpointsInvalid(body *ListMcpEndpointsInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListMcpEndpointsInvariantViolation builds a mcpEndpoints service
+// listMcpEndpoints endpoint invariant_violation error.
+func NewListMcpEndpointsInvariantViolation(body *ListMcpEndpointsInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListMcpEndpointsUnexpected builds a mcpEndpoints service listMcpEndpoints
+// endpoint unexpected error.
+func NewListMcpEndpointsUnexpected(body *ListMcpEndpointsUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListMcpEndpointsGatewayError builds a mcpEndpoints service
+// listMcpEndpoints endpoint gateway_error error.
+func NewListMcpEndpointsGatewayError(body *ListMcpEndpointsGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointMcpEndpointOK builds a "mcpEndpoints" service
+// "updateMcpEndpoint" endpoint result from a HTTP "OK" response.
+func NewUpdateMcpEndpointMcpEndpointOK(body *UpdateMcpEndpointResponseBody) *types.McpEndpoint {
+	v := &types.McpEndpoint{
+		ID:              *body.ID,
+		ProjectID:       *body.ProjectID,
+		CustomDomainID:  body.CustomDomainID,
+		McpServerID:     body.McpServerID,
+		MetaMcpServerID: body.MetaMcpServerID,
+		ToolsetVersion:  body.ToolsetVersion,
+		Slug:            types.McpEndpointSlug(*body.Slug),
+		IsDomainRoot:    *body.IsDomainRoot,
+		CreatedAt:       *body.CreatedAt,
+		UpdatedAt:       *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointUnauthorized builds a mcpEndpoints service
+// updateMcpEndpoint endpoint unauthorized error.
+func NewUpdateMcpEndpointUnauthorized(body *UpdateMcpEndpointUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointForbidden builds a mcpEndpoints service
+// updateMcpEndpoint endpoint forbidden error.
+func NewUpdateMcpEndpointForbidden(body *UpdateMcpEndpointForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointBadRequest builds a mcpEndpoints service
+// updateMcpEndpoint endpoint bad_request error.
+func NewUpdateMcpEndpointBadRequest(body *UpdateMcpEndpointBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointNotFound builds a mcpEndpoints service updateMcpEndpoint
+// endpoint not_found error.
+func NewUpdateMcpEndpointNotFound(body *UpdateMcpEndpointNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointConflict builds a mcpEndpoints service updateMcpEndpoint
+// endpoint conflict error.
+func NewUpdateMcpEndpointConflict(body *UpdateMcpEndpointConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointUnsupportedMedia builds a mcpEndpoints service
+// updateMcpEndpoint endpoint unsupported_media error.
+func NewUpdateMcpEndpointUnsupportedMedia(body *UpdateMcpEndpointUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointInvalid builds a mcpEndpoints service updateMcpEndpoint
+// endpoint invalid error.
+func NewUpdateMcpEndpointInvalid(body *UpdateMcpEndpointInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointInvariantViolation builds a mcpEndpoints service
+// updateMcpEndpoint endpoint invariant_violation error.
+func NewUpdateMcpEndpointInvariantViolation(body *UpdateMcpEndpointInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointUnexpected builds a mcpEndpoints service
+// updateMcpEndpoint endpoint unexpected error.
+func NewUpdateMcpEndpointUnexpected(body *UpdateMcpEndpointUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointGatewayError builds a mcpEndpoints service
+// updateMcpEndpoint endpoint gateway_error error.
+func NewUpdateMcpEndpointGatewayError(body *UpdateMcpEndpointGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckMcpEndpointSlugAvailabilityUnauthorized builds a mcpEndpoints
+// service checkMcpEndpointSlugAvailability endpoint unauthorized error.
+func NewCheckMcpEndpointSlugAvailabilityUnauthorized(body *CheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckMcpEndpointSlugAvailabilityForbidden builds a mcpEndpoints service
+// checkMcpEndpointSlugAvailability endpoint forbidden error.
+func NewCheckMcpEndpointSlugAvailabilityForbidden(body *CheckMcpEndpointSlugAvailabilityForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckMcpEndpointSlugAvailabilityBadRequest builds a mcpEndpoints service
+// checkMcpEndpointSlugAvailability endpoint bad_request error.
+func NewCheckMcpEndpointSlugAvailabilityBadRequest(body *CheckMcpEndpointSlugAvailabilityBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckMcpEndpointSlugAvailabilityNotFound builds a mcpEndpoints service
+// checkMcpEndpointSlugAvailability endpoint not_found error.
+func NewCheckMcpEndpointSlugAvailabilityNotFound(body *CheckMcpEndpointSlugAvailabilityNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckMcpEndpointSlugAvailabilityConflict builds a mcpEndpoints service
+// checkMcpEndpointSlugAvailability endpoint conflict error.
+func NewCheckMcpEndpointSlugAvailabilityConflict(body *CheckMcpEndpointSlugAvailabilityConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckMcpEndpointSlugAvailabilityUnsupportedMedia builds a mcpEndpoints
+// service checkMcpEndpointSlugAvailability endpoint unsupported_media error.
+func NewCheckMcpEndpointSlugAvailabilityUnsupportedMedia(body *CheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckMcpEndpointSlugAvailabilityInvalid builds a mcpEndpoints service
+// checkMcpEndpointSlugAvailability endpoint invalid error.
+func NewCheckMcpEndpointSlugAvailabilityInvalid(body *CheckMcpEndpointSlugAvailabilityInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckMcpEndpointSlugAvailabilityInvariantViolation builds a mcpEndpoints
+// service checkMcpEndpointSlugAvailability endpoint invariant_violation error.
+func NewCheckMcpEndpointSlugAvailabilityInvariantViolation(body *CheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckMcpEndpointSlugAvailabilityUnexpected builds a mcpEndpoints service
+// checkMcpEndpointSlugAvailability endpoint unexpected error.
+func NewCheckMcpEndpointSlugAvailabilityUnexpected(body *CheckMcpEndpointSlugAvailabilityUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCheckMcpEndpointSlugAvailabilityGatewayError builds a mcpEndpoints
+// service checkMcpEndpointSlugAvailability endpoint gateway_error error.
+func NewCheckMcpEndpointSlugAvailabilityGatewayError(body *CheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteMcpEndpointUnauthorized builds a mcpEndpoints service
+// deleteMcpEndpoint endpoint unauthorized error.
+func NewDeleteMcpEndpointUnauthorized(body *DeleteMcpEndpointUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteMcpEndpointForbidden builds a mcpEndpoints service
+// deleteMcpEndpoint endpoint forbidden error.
+func NewDeleteMcpEndpointForbidden(body *DeleteMcpEndpointForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteMcpEndpointBadRequest builds a mcpEndpoints service
+// deleteMcpEndpoint endpoint bad_request error.
+func NewDeleteMcpEndpointBadRequest(body *DeleteMcpEndpointBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteMcpEndpointNotFound builds a mcpEndpoints service deleteMcpEndpoint
+// endpoint not_found error.
+func NewDeleteMcpEndpointNotFound(body *DeleteMcpEndpointNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteMcpEndpointConflict builds a mcpEndpoints service deleteMcpEndpoint
+// endpoint conflict error.
+func NewDeleteMcpEndpointConflict(body *DeleteMcpEndpointConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteMcpEndpointUnsupportedMedia builds a mcpEndpoints service
+// deleteMcpEndpoint endpoint unsupported_media error.
+func NewDeleteMcpEndpointUnsupportedMedia(body *DeleteMcpEndpointUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteMcpEndpointInvalid builds a mcpEndpoints service deleteMcpEndpoint
+// endpoint invalid error.
+func NewDeleteMcpEndpointInvalid(body *DeleteMcpEndpointInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteMcpEndpointInvariantViolation builds a mcpEndpoints service
+// deleteMcpEndpoint endpoint invariant_violation error.
+func NewDeleteMcpEndpointInvariantViolation(body *DeleteMcpEndpointInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteMcpEndpointUnexpected builds a mcpEndpoints service
+// deleteMcpEndpoint endpoint unexpected error.
+func NewDeleteMcpEndpointUnexpected(body *DeleteMcpEndpointUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteMcpEndpointGatewayError builds a mcpEndpoints service
+// deleteMcpEndpoint endpoint gateway_error error.
+func NewDeleteMcpEndpointGatewayError(body *DeleteMcpEndpointGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStartMcpEndpointCanaryMcpEndpointCanaryOK builds a "mcpEndpoints" service
+// "startMcpEndpointCanary" endpoint result from a HTTP "OK" response.
+func NewStartMcpEndpointCanaryMcpEndpointCanaryOK(body *StartMcpEndpointCanaryResponseBody) *mcpendpoints.McpEndpointCanary {
+	v := &mcpendpoints.McpEndpointCanary{
+		ID:                    *body.ID,
+		McpEndpointID:         *body.McpEndpointID,
+		BaselineDeploymentID:  *body.BaselineDeploymentID,
+		CandidateDeploymentID: body.CandidateDeploymentID,
+		Percentage:            *body.Percentage,
+		StickyBy:              *body.StickyBy,
+		Status:                *body.Status,
+		StartedAt:             *body.StartedAt,
+		EndedAt:               body.EndedAt,
+	}
+	if body.Baseline != nil {
+		v.Baseline = unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm(body.Baseline)
+	}
+	if body.Candidate != nil {
+		v.Candidate = unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm(body.Candidate)
+	}
+
+	return v
+}
+
+// NewStartMcpEndpointCanaryUnauthorized builds a mcpEndpoints service
+// startMcpEndpointCanary endpoint unauthorized error.
+func NewStartMcpEndpointCanaryUnauthorized(body *StartMcpEndpointCanaryUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStartMcpEndpointCanaryForbidden builds a mcpEndpoints service
+// startMcpEndpointCanary endpoint forbidden error.
+func NewStartMcpEndpointCanaryForbidden(body *StartMcpEndpointCanaryForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStartMcpEndpointCanaryBadRequest builds a mcpEndpoints service
+// startMcpEndpointCanary endpoint bad_request error.
+func NewStartMcpEndpointCanaryBadRequest(body *StartMcpEndpointCanaryBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStartMcpEndpointCanaryNotFound builds a mcpEndpoints service
+// startMcpEndpointCanary endpoint not_found error.
+func NewStartMcpEndpointCanaryNotFound(body *StartMcpEndpointCanaryNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStartMcpEndpointCanaryConflict builds a mcpEndpoints service
+// startMcpEndpointCanary endpoint conflict error.
+func NewStartMcpEndpointCanaryConflict(body *StartMcpEndpointCanaryConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStartMcpEndpointCanaryUnsupportedMedia builds a mcpEndpoints service
+// startMcpEndpointCanary endpoint unsupported_media error.
+func NewStartMcpEndpointCanaryUnsupportedMedia(body *StartMcpEndpointCanaryUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStartMcpEndpointCanaryInvalid builds a mcpEndpoints service
+// startMcpEndpointCanary endpoint invalid error.
+func NewStartMcpEndpointCanaryInvalid(body *StartMcpEndpointCanaryInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStartMcpEndpointCanaryInvariantViolation builds a mcpEndpoints service
+// startMcpEndpointCanary endpoint invariant_violation error.
+func NewStartMcpEndpointCanaryInvariantViolation(body *StartMcpEndpointCanaryInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStartMcpEndpointCanaryUnexpected builds a mcpEndpoints service
+// startMcpEndpointCanary endpoint unexpected error.
+func NewStartMcpEndpointCanaryUnexpected(body *StartMcpEndpointCanaryUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewStartMcpEndpointCanaryGatewayError builds a mcpEndpoints service
+// startMcpEndpointCanary endpoint gateway_error error.
+func NewStartMcpEndpointCanaryGatewayError(body *StartMcpEndpointCanaryGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryMcpEndpointCanaryOK builds a "mcpEndpoints" service
+// "getMcpEndpointCanary" endpoint result from a HTTP "OK" response.
+func NewGetMcpEndpointCanaryMcpEndpointCanaryOK(body *GetMcpEndpointCanaryResponseBody) *mcpendpoints.McpEndpointCanary {
+	v := &mcpendpoints.McpEndpointCanary{
+		ID:                    *body.ID,
+		McpEndpointID:         *body.McpEndpointID,
+		BaselineDeploymentID:  *body.BaselineDeploymentID,
+		CandidateDeploymentID: body.CandidateDeploymentID,
+		Percentage:            *body.Percentage,
+		StickyBy:              *body.StickyBy,
+		Status:                *body.Status,
+		StartedAt:             *body.StartedAt,
+		EndedAt:               body.EndedAt,
+	}
+	if body.Baseline != nil {
+		v.Baseline = unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm(body.Baseline)
+	}
+	if body.Candidate != nil {
+		v.Candidate = unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm(body.Candidate)
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryUnauthorized builds a mcpEndpoints service
+// getMcpEndpointCanary endpoint unauthorized error.
+func NewGetMcpEndpointCanaryUnauthorized(body *GetMcpEndpointCanaryUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryForbidden builds a mcpEndpoints service
+// getMcpEndpointCanary endpoint forbidden error.
+func NewGetMcpEndpointCanaryForbidden(body *GetMcpEndpointCanaryForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryBadRequest builds a mcpEndpoints service
+// getMcpEndpointCanary endpoint bad_request error.
+func NewGetMcpEndpointCanaryBadRequest(body *GetMcpEndpointCanaryBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryNotFound builds a mcpEndpoints service
+// getMcpEndpointCanary endpoint not_found error.
+func NewGetMcpEndpointCanaryNotFound(body *GetMcpEndpointCanaryNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryConflict builds a mcpEndpoints service
+// getMcpEndpointCanary endpoint conflict error.
+func NewGetMcpEndpointCanaryConflict(body *GetMcpEndpointCanaryConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryUnsupportedMedia builds a mcpEndpoints service
+// getMcpEndpointCanary endpoint unsupported_media error.
+func NewGetMcpEndpointCanaryUnsupportedMedia(body *GetMcpEndpointCanaryUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryInvalid builds a mcpEndpoints service
+// getMcpEndpointCanary endpoint invalid error.
+func NewGetMcpEndpointCanaryInvalid(body *GetMcpEndpointCanaryInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryInvariantViolation builds a mcpEndpoints service
+// getMcpEndpointCanary endpoint invariant_violation error.
+func NewGetMcpEndpointCanaryInvariantViolation(body *GetMcpEndpointCanaryInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryUnexpected builds a mcpEndpoints service
+// getMcpEndpointCanary endpoint unexpected error.
+func NewGetMcpEndpointCanaryUnexpected(body *GetMcpEndpointCanaryUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryGatewayError builds a mcpEndpoints service
+// getMcpEndpointCanary endpoint gateway_error error.
+func NewGetMcpEndpointCanaryGatewayError(body *GetMcpEndpointCanaryGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryMcpEndpointCanaryOK builds a "mcpEndpoints"
+// service "updateMcpEndpointCanary" endpoint result from a HTTP "OK" response.
+func NewUpdateMcpEndpointCanaryMcpEndpointCanaryOK(body *UpdateMcpEndpointCanaryResponseBody) *mcpendpoints.McpEndpointCanary {
+	v := &mcpendpoints.McpEndpointCanary{
+		ID:                    *body.ID,
+		McpEndpointID:         *body.McpEndpointID,
+		BaselineDeploymentID:  *body.BaselineDeploymentID,
+		CandidateDeploymentID: body.CandidateDeploymentID,
+		Percentage:            *body.Percentage,
+		StickyBy:              *body.StickyBy,
+		Status:                *body.Status,
+		StartedAt:             *body.StartedAt,
+		EndedAt:               body.EndedAt,
+	}
+	if body.Baseline != nil {
+		v.Baseline = unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm(body.Baseline)
+	}
+	if body.Candidate != nil {
+		v.Candidate = unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm(body.Candidate)
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryUnauthorized builds a mcpEndpoints service
+// updateMcpEndpointCanary endpoint unauthorized error.
+func NewUpdateMcpEndpointCanaryUnauthorized(body *UpdateMcpEndpointCanaryUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryForbidden builds a mcpEndpoints service
+// updateMcpEndpointCanary endpoint forbidden error.
+func NewUpdateMcpEndpointCanaryForbidden(body *UpdateMcpEndpointCanaryForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryBadRequest builds a mcpEndpoints service
+// updateMcpEndpointCanary endpoint bad_request error.
+func NewUpdateMcpEndpointCanaryBadRequest(body *UpdateMcpEndpointCanaryBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryNotFound builds a mcpEndpoints service
+// updateMcpEndpointCanary endpoint not_found error.
+func NewUpdateMcpEndpointCanaryNotFound(body *UpdateMcpEndpointCanaryNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryConflict builds a mcpEndpoints service
+// updateMcpEndpointCanary endpoint conflict error.
+func NewUpdateMcpEndpointCanaryConflict(body *UpdateMcpEndpointCanaryConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryUnsupportedMedia builds a mcpEndpoints service
+// updateMcpEndpointCanary endpoint unsupported_media error.
+func NewUpdateMcpEndpointCanaryUnsupportedMedia(body *UpdateMcpEndpointCanaryUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryInvalid builds a mcpEndpoints service
+// updateMcpEndpointCanary endpoint invalid error.
+func NewUpdateMcpEndpointCanaryInvalid(body *UpdateMcpEndpointCanaryInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryInvariantViolation builds a mcpEndpoints service
+// updateMcpEndpointCanary endpoint invariant_violation error.
+func NewUpdateMcpEndpointCanaryInvariantViolation(body *UpdateMcpEndpointCanaryInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryUnexpected builds a mcpEndpoints service
+// updateMcpEndpointCanary endpoint unexpected error.
+func NewUpdateMcpEndpointCanaryUnexpected(body *UpdateMcpEndpointCanaryUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryGatewayError builds a mcpEndpoints service
+// updateMcpEndpointCanary endpoint gateway_error error.
+func NewUpdateMcpEndpointCanaryGatewayError(body *UpdateMcpEndpointCanaryGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteMcpEndpointCanaryMcpEndpointCanaryOK builds a "mcpEndpoints"
+// service "promoteMcpEndpointCanary" endpoint result from a HTTP "OK" response.
+func NewPromoteMcpEndpointCanaryMcpEndpointCanaryOK(body *PromoteMcpEndpointCanaryResponseBody) *mcpendpoints.McpEndpointCanary {
+	v := &mcpendpoints.McpEndpointCanary{
+		ID:                    *body.ID,
+		McpEndpointID:         *body.McpEndpointID,
+		BaselineDeploymentID:  *body.BaselineDeploymentID,
+		CandidateDeploymentID: body.CandidateDeploymentID,
+		Percentage:            *body.Percentage,
+		StickyBy:              *body.StickyBy,
+		Status:                *body.Status,
+		StartedAt:             *body.StartedAt,
+		EndedAt:               body.EndedAt,
+	}
+	if body.Baseline != nil {
+		v.Baseline = unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm(body.Baseline)
+	}
+	if body.Candidate != nil {
+		v.Candidate = unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm(body.Candidate)
+	}
+
+	return v
+}
+
+// NewPromoteMcpEndpointCanaryUnauthorized builds a mcpEndpoints service
+// promoteMcpEndpointCanary endpoint unauthorized error.
+func NewPromoteMcpEndpointCanaryUnauthorized(body *PromoteMcpEndpointCanaryUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteMcpEndpointCanaryForbidden builds a mcpEndpoints service
+// promoteMcpEndpointCanary endpoint forbidden error.
+func NewPromoteMcpEndpointCanaryForbidden(body *PromoteMcpEndpointCanaryForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteMcpEndpointCanaryBadRequest builds a mcpEndpoints service
+// promoteMcpEndpointCanary endpoint bad_request error.
+func NewPromoteMcpEndpointCanaryBadRequest(body *PromoteMcpEndpointCanaryBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteMcpEndpointCanaryNotFound builds a mcpEndpoints service
+// promoteMcpEndpointCanary endpoint not_found error.
+func NewPromoteMcpEndpointCanaryNotFound(body *PromoteMcpEndpointCanaryNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteMcpEndpointCanaryConflict builds a mcpEndpoints service
+// promoteMcpEndpointCanary endpoint conflict error.
+func NewPromoteMcpEndpointCanaryConflict(body *PromoteMcpEndpointCanaryConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteMcpEndpointCanaryUnsupportedMedia builds a mcpEndpoints service
+// promoteMcpEndpointCanary endpoint unsupported_media error.
+func NewPromoteMcpEndpointCanaryUnsupportedMedia(body *PromoteMcpEndpointCanaryUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteMcpEndpointCanaryInvalid builds a mcpEndpoints service
+// promoteMcpEndpointCanary endpoint invalid error.
+func NewPromoteMcpEndpointCanaryInvalid(body *PromoteMcpEndpointCanaryInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteMcpEndpointCanaryInvariantViolation builds a mcpEndpoints service
+// promoteMcpEndpointCanary endpoint invariant_violation error.
+func NewPromoteMcpEndpointCanaryInvariantViolation(body *PromoteMcpEndpointCanaryInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
 		Temporary: *body.Temporary,
 		Timeout:   *body.Timeout,
 		Fault:     *body.Fault,
@@ -1413,9 +4182,9 @@ func NewCreateMcpEndpointConflict(body *CreateMcpEndpointConflictResponseBody) *
 	return v
 }
 
-// NewCreateMcpEndpointUnsupportedMedia builds a mcpEndpoints service
-// createMcpEndpoint endpoint unsupported_media error.
-func NewCreateMcpEndpointUnsupportedMedia(body *CreateMcpEndpointUnsupportedMediaResponseBody) *goa.ServiceError {
+// NewPromoteMcpEndpointCanaryUnexpected builds a mcpEndpoints service
+// promoteMcpEndpointCanary endpoint unexpected error.
+func NewPromoteMcpEndpointCanaryUnexpected(body *PromoteMcpEndpointCanaryUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1428,9 +4197,9 @@ func NewCreateMcpEndpointUnsupportedMedia(body *CreateMcpEndpointUnsupportedMedi
 	return v
 }
 
-// NewCreateMcpEndpointInvalid builds a mcpEndpoints service createMcpEndpoint
-// endpoint invalid error.
-func NewCreateMcpEndpointInvalid(body *CreateMcpEndpointInvalidResponseBody) *goa.ServiceError {
+// NewPromoteMcpEndpointCanaryGatewayError builds a mcpEndpoints service
+// promoteMcpEndpointCanary endpoint gateway_error error.
+func NewPromoteMcpEndpointCanaryGatewayError(body *PromoteMcpEndpointCanaryGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1443,9 +4212,33 @@ func NewCreateMcpEndpointInvalid(body *CreateMcpEndpointInvalidResponseBody) *go
 	return v
 }
 
-// NewCreateMcpEndpointInvariantViolation builds a mcpEndpoints service
-// createMcpEndpoint endpoint invariant_violation error.
-func NewCreateMcpEndpointInvariantViolation(body *CreateMcpEndpointInvariantViolationResponseBody) *goa.ServiceError {
+// NewAbortMcpEndpointCanaryMcpEndpointCanaryOK builds a "mcpEndpoints" service
+// "abortMcpEndpointCanary" endpoint result from a HTTP "OK" response.
+func NewAbortMcpEndpointCanaryMcpEndpointCanaryOK(body *AbortMcpEndpointCanaryResponseBody) *mcpendpoints.McpEndpointCanary {
+	v := &mcpendpoints.McpEndpointCanary{
+		ID:                    *body.ID,
+		McpEndpointID:         *body.McpEndpointID,
+		BaselineDeploymentID:  *body.BaselineDeploymentID,
+		CandidateDeploymentID: body.CandidateDeploymentID,
+		Percentage:            *body.Percentage,
+		StickyBy:              *body.StickyBy,
+		Status:                *body.Status,
+		StartedAt:             *body.StartedAt,
+		EndedAt:               body.EndedAt,
+	}
+	if body.Baseline != nil {
+		v.Baseline = unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm(body.Baseline)
+	}
+	if body.Candidate != nil {
+		v.Candidate = unmarshalMcpEndpointCanaryArmResponseBodyToMcpendpointsMcpEndpointCanaryArm(body.Candidate)
+	}
+
+	return v
+}
+
+// NewAbortMcpEndpointCanaryUnauthorized builds a mcpEndpoints service
+// abortMcpEndpointCanary endpoint unauthorized error.
+func NewAbortMcpEndpointCanaryUnauthorized(body *AbortMcpEndpointCanaryUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1458,9 +4251,9 @@ func NewCreateMcpEndpointInvariantViolation(body *CreateMcpEndpointInvariantViol
 	return v
 }
 
-// NewCreateMcpEndpointUnexpected builds a mcpEndpoints service
-// createMcpEndpoint endpoint unexpected error.
-func NewCreateMcpEndpointUnexpected(body *CreateMcpEndpointUnexpectedResponseBody) *goa.ServiceError {
+// NewAbortMcpEndpointCanaryForbidden builds a mcpEndpoints service
+// abortMcpEndpointCanary endpoint forbidden error.
+func NewAbortMcpEndpointCanaryForbidden(body *AbortMcpEndpointCanaryForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1473,9 +4266,9 @@ func NewCreateMcpEndpointUnexpected(body *CreateMcpEndpointUnexpectedResponseBod
 	return v
 }
 
-// NewCreateMcpEndpointGatewayError builds a mcpEndpoints service
-// createMcpEndpoint endpoint gateway_error error.
-func NewCreateMcpEndpointGatewayError(body *CreateMcpEndpointGatewayErrorResponseBody) *goa.ServiceError {
+// NewAbortMcpEndpointCanaryBadRequest builds a mcpEndpoints service
+// abortMcpEndpointCanary endpoint bad_request error.
+func NewAbortMcpEndpointCanaryBadRequest(body *AbortMcpEndpointCanaryBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1488,27 +4281,24 @@ func NewCreateMcpEndpointGatewayError(body *CreateMcpEndpointGatewayErrorRespons
 	return v
 }
 
-// NewGetMcpEndpointMcpEndpointOK builds a "mcpEndpoints" service
-// "getMcpEndpoint" endpoint result from a HTTP "OK" response.
-func NewGetMcpEndpointMcpEndpointOK(body *GetMcpEndpointResponseBody) *types.McpEndpoint {
-	v := &types.McpEndpoint{
-		ID:              *body.ID,
-		ProjectID:       *body.ProjectID,
-		CustomDomainID:  body.CustomDomainID,
-		McpServerID:     body.McpServerID,
-		MetaMcpServerID: body.MetaMcpServerID,
-		Slug:            types.McpEndpointSlug(*body.Slug),
-		IsDomainRoot:    *body.IsDomainRoot,
-		CreatedAt:       *body.CreatedAt,
-		UpdatedAt:       *body.UpdatedAt,
+// NewAbortMcpEndpointCanaryNotFound builds a mcpEndpoints service
+// abortMcpEndpointCanary endpoint not_found error.
+func NewAbortMcpEndpointCanaryNotFound(body *AbortMcpEndpointCanaryNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
 
 	return v
 }
 
-// NewGetMcpEndpointUnauthorized builds a mcpEndpoints service getMcpEndpoint
-// endpoint unauthorized error.
-func NewGetMcpEndpointUnauthorized(body *GetMcpEndpointUnauthorizedResponseBody) *goa.ServiceError {
+// NewAbortMcpEndpointCanaryConflict builds a mcpEndpoints service
+// abortMcpEndpointCanary endpoint conflict error.
+func NewAbortMcpEndpointCanaryConflict(body *AbortMcpEndpointCanaryConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1521,9 +4311,9 @@ func NewGetMcpEndpointUnauthorized(body *GetMcpEndpointUnauthorizedResponseBody)
 	return v
 }
 
-// NewGetMcpEndpointForbidden builds a mcpEndpoints service getMcpEndpoint
-// endpoint forbidden error.
-func NewGetMcpEndpointForbidden(body *GetMcpEndpointForbiddenResponseBody) *goa.ServiceError {
+// NewAbortMcpEndpointCanaryUnsupportedMedia builds a mcpEndpoints service
+// abortMcpEndpointCanary endpoint unsupported_media error.
+func NewAbortMcpEndpointCanaryUnsupportedMedia(body *AbortMcpEndpointCanaryUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1536,9 +4326,9 @@ func NewGetMcpEndpointForbidden(body *GetMcpEndpointForbiddenResponseBody) *goa.
 	return v
 }
 
-// NewGetMcpEndpointBadRequest builds a mcpEndpoints service getMcpEndpoint
-// endpoint bad_request error.
-func NewGetMcpEndpointBadRequest(body *GetMcpEndpointBadRequestResponseBody) *goa.ServiceError {
+// NewAbortMcpEndpointCanaryInvalid builds a mcpEndpoints service
+// abortMcpEndpointCanary endpoint invalid error.
+func NewAbortMcpEndpointCanaryInvalid(body *AbortMcpEndpointCanaryInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1551,9 +4341,9 @@ func NewGetMcpEndpointBadRequest(body *GetMcpEndpointBadRequestResponseBody) *go
 	return v
 }
 
-// NewGetMcpEndpointNotFound builds a mcpEndpoints service getMcpEndpoint
-// endpoint not_found error.
-func NewGetMcpEndpointNotFound(body *GetMcpEndpointNotFoundResponseBody) *goa.ServiceError {
+// NewAbortMcpEndpointCanaryInvariantViolation builds a mcpEndpoints service
+// abortMcpEndpointCanary endpoint invariant_violation error.
+func NewAbortMcpEndpointCanaryInvariantViolation(body *AbortMcpEndpointCanaryInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1566,9 +4356,9 @@ func NewGetMcpEndpointNotFound(body *GetMcpEndpointNotFoundResponseBody) *goa.Se
 	return v
 }
 
-// NewGetMcpEndpointConflict builds a mcpEndpoints service getMcpEndpoint
-// endpoint conflict error.
-func NewGetMcpEndpointConflict(body *GetMcpEndpointConflictResponseBody) *goa.ServiceError {
+// NewAbortMcpEndpointCanaryUnexpected builds a mcpEndpoints service
+// abortMcpEndpointCanary endpoint unexpected error.
+func NewAbortMcpEndpointCanaryUnexpected(body *AbortMcpEndpointCanaryUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1581,9 +4371,9 @@ func NewGetMcpEndpointConflict(body *GetMcpEndpointConflictResponseBody) *goa.Se
 	return v
 }
 
-// NewGetMcpEndpointUnsupportedMedia builds a mcpEndpoints service
-// getMcpEndpoint endpoint unsupported_media error.
-func NewGetMcpEndpointUnsupportedMedia(body *GetMcpEndpointUnsupportedMediaResponseBody) *goa.ServiceError {
+// NewAbortMcpEndpointCanaryGatewayError builds a mcpEndpoints service
+// abortMcpEndpointCanary endpoint gateway_error error.
+func NewAbortMcpEndpointCanaryGatewayError(body *AbortMcpEndpointCanaryGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -1592,882 +4382,1722 @@ func NewGetMcpEndpointUnsupportedMedia(body *GetMcpEndpointUnsupportedMediaRespo
 		Timeout:   *body.Timeout,
 		Fault:     *body.Fault,
 	}
-
-	return v
-}
-
-// NewGetMcpEndpointInvalid builds a mcpEndpoints service getMcpEndpoint
-// endpoint invalid error.
-func NewGetMcpEndpointInvalid(body *GetMcpEndpointInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+
+	return v
+}
+
+// ValidateCreateMcpEndpointResponseBody runs the validations defined on
+// CreateMcpEndpointResponseBody
+func ValidateCreateMcpEndpointResponseBody(body *CreateMcpEndpointResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ProjectID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
+	}
+	if body.Slug == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+	}
+	if body.IsDomainRoot == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_domain_root", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.ProjectID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_id", *body.ProjectID, goa.FormatUUID))
+	}
+	if body.CustomDomainID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.custom_domain_id", *body.CustomDomainID, goa.FormatUUID))
+	}
+	if body.McpServerID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_server_id", *body.McpServerID, goa.FormatUUID))
+	}
+	if body.MetaMcpServerID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.meta_mcp_server_id", *body.MetaMcpServerID, goa.FormatUUID))
+	}
+	if body.Slug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.Slug != nil {
+		if utf8.RuneCountInString(*body.Slug) > 128 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 128, false))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetMcpEndpointResponseBody runs the validations defined on
+// GetMcpEndpointResponseBody
+func ValidateGetMcpEndpointResponseBody(body *GetMcpEndpointResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ProjectID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
+	}
+	if body.Slug == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+	}
+	if body.IsDomainRoot == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_domain_root", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.ProjectID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_id", *body.ProjectID, goa.FormatUUID))
+	}
+	if body.CustomDomainID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.custom_domain_id", *body.CustomDomainID, goa.FormatUUID))
+	}
+	if body.McpServerID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_server_id", *body.McpServerID, goa.FormatUUID))
+	}
+	if body.MetaMcpServerID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.meta_mcp_server_id", *body.MetaMcpServerID, goa.FormatUUID))
+	}
+	if body.Slug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.Slug != nil {
+		if utf8.RuneCountInString(*body.Slug) > 128 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 128, false))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListMcpEndpointsResponseBody runs the validations defined on
+// ListMcpEndpointsResponseBody
+func ValidateListMcpEndpointsResponseBody(body *ListMcpEndpointsResponseBody) (err error) {
+	if body.McpEndpoints == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mcp_endpoints", "body"))
+	}
+	for _, e := range body.McpEndpoints {
+		if e != nil {
+			if err2 := ValidateMcpEndpointResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUpdateMcpEndpointResponseBody runs the validations defined on
+// UpdateMcpEndpointResponseBody
+func ValidateUpdateMcpEndpointResponseBody(body *UpdateMcpEndpointResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ProjectID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
+	}
+	if body.Slug == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+	}
+	if body.IsDomainRoot == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_domain_root", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.ProjectID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_id", *body.ProjectID, goa.FormatUUID))
+	}
+	if body.CustomDomainID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.custom_domain_id", *body.CustomDomainID, goa.FormatUUID))
+	}
+	if body.McpServerID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_server_id", *body.McpServerID, goa.FormatUUID))
+	}
+	if body.MetaMcpServerID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.meta_mcp_server_id", *body.MetaMcpServerID, goa.FormatUUID))
+	}
+	if body.Slug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.Slug != nil {
+		if utf8.RuneCountInString(*body.Slug) > 128 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 128, false))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateStartMcpEndpointCanaryResponseBody runs the validations defined on
+// StartMcpEndpointCanaryResponseBody
+func ValidateStartMcpEndpointCanaryResponseBody(body *StartMcpEndpointCanaryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.McpEndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mcp_endpoint_id", "body"))
+	}
+	if body.BaselineDeploymentID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("baseline_deployment_id", "body"))
+	}
+	if body.Percentage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("percentage", "body"))
+	}
+	if body.StickyBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sticky_by", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.McpEndpointID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", *body.McpEndpointID, goa.FormatUUID))
+	}
+	if body.BaselineDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.baseline_deployment_id", *body.BaselineDeploymentID, goa.FormatUUID))
+	}
+	if body.CandidateDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.candidate_deployment_id", *body.CandidateDeploymentID, goa.FormatUUID))
+	}
+	if body.StickyBy != nil {
+		if !(*body.StickyBy == "session" || *body.StickyBy == "user") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.sticky_by", *body.StickyBy, []any{"session", "user"}))
+		}
 	}
-
-	return v
+	if body.Status != nil {
+		if !(*body.Status == "running" || *body.Status == "promoted" || *body.Status == "aborted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"running", "promoted", "aborted"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.started_at", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.EndedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.ended_at", *body.EndedAt, goa.FormatDateTime))
+	}
+	if body.Baseline != nil {
+		if err2 := ValidateMcpEndpointCanaryArmResponseBody(body.Baseline); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Candidate != nil {
+		if err2 := ValidateMcpEndpointCanaryArmResponseBody(body.Candidate); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
 }
 
-// NewGetMcpEndpointInvariantViolation builds a mcpEndpoints service
-// getMcpEndpoint endpoint invariant_violation error.
-func NewGetMcpEndpointInvariantViolation(body *GetMcpEndpointInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetMcpEndpointCanaryResponseBody runs the validations defined on
+// GetMcpEndpointCanaryResponseBody
+func ValidateGetMcpEndpointCanaryResponseBody(body *GetMcpEndpointCanaryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-
-	return v
+	if body.McpEndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mcp_endpoint_id", "body"))
+	}
+	if body.BaselineDeploymentID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("baseline_deployment_id", "body"))
+	}
+	if body.Percentage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("percentage", "body"))
+	}
+	if body.StickyBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sticky_by", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.McpEndpointID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", *body.McpEndpointID, goa.FormatUUID))
+	}
+	if body.BaselineDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.baseline_deployment_id", *body.BaselineDeploymentID, goa.FormatUUID))
+	}
+	if body.CandidateDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.candidate_deployment_id", *body.CandidateDeploymentID, goa.FormatUUID))
+	}
+	if body.StickyBy != nil {
+		if !(*body.StickyBy == "session" || *body.StickyBy == "user") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.sticky_by", *body.StickyBy, []any{"session", "user"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "running" || *body.Status == "promoted" || *body.Status == "aborted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"running", "promoted", "aborted"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.started_at", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.EndedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.ended_at", *body.EndedAt, goa.FormatDateTime))
+	}
+	if body.Baseline != nil {
+		if err2 := ValidateMcpEndpointCanaryArmResponseBody(body.Baseline); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Candidate != nil {
+		if err2 := ValidateMcpEndpointCanaryArmResponseBody(body.Candidate); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
 }
 
-// NewGetMcpEndpointUnexpected builds a mcpEndpoints service getMcpEndpoint
-// endpoint unexpected error.
-func NewGetMcpEndpointUnexpected(body *GetMcpEndpointUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateMcpEndpointCanaryResponseBody runs the validations defined on
+// UpdateMcpEndpointCanaryResponseBody
+func ValidateUpdateMcpEndpointCanaryResponseBody(body *UpdateMcpEndpointCanaryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-
-	return v
+	if body.McpEndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mcp_endpoint_id", "body"))
+	}
+	if body.BaselineDeploymentID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("baseline_deployment_id", "body"))
+	}
+	if body.Percentage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("percentage", "body"))
+	}
+	if body.StickyBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sticky_by", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.McpEndpointID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", *body.McpEndpointID, goa.FormatUUID))
+	}
+	if body.BaselineDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.baseline_deployment_id", *body.BaselineDeploymentID, goa.FormatUUID))
+	}
+	if body.CandidateDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.candidate_deployment_id", *body.CandidateDeploymentID, goa.FormatUUID))
+	}
+	if body.StickyBy != nil {
+		if !(*body.StickyBy == "session" || *body.StickyBy == "user") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.sticky_by", *body.StickyBy, []any{"session", "user"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "running" || *body.Status == "promoted" || *body.Status == "aborted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"running", "promoted", "aborted"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.started_at", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.EndedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.ended_at", *body.EndedAt, goa.FormatDateTime))
+	}
+	if body.Baseline != nil {
+		if err2 := ValidateMcpEndpointCanaryArmResponseBody(body.Baseline); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Candidate != nil {
+		if err2 := ValidateMcpEndpointCanaryArmResponseBody(body.Candidate); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
 }
 
-// NewGetMcpEndpointGatewayError builds a mcpEndpoints service getMcpEndpoint
-// endpoint gateway_error error.
-func NewGetMcpEndpointGatewayError(body *GetMcpEndpointGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidatePromoteMcpEndpointCanaryResponseBody runs the validations defined on
+// PromoteMcpEndpointCanaryResponseBody
+func ValidatePromoteMcpEndpointCanaryResponseBody(body *PromoteMcpEndpointCanaryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-
-	return v
+	if body.McpEndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mcp_endpoint_id", "body"))
+	}
+	if body.BaselineDeploymentID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("baseline_deployment_id", "body"))
+	}
+	if body.Percentage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("percentage", "body"))
+	}
+	if body.StickyBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sticky_by", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.McpEndpointID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", *body.McpEndpointID, goa.FormatUUID))
+	}
+	if body.BaselineDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.baseline_deployment_id", *body.BaselineDeploymentID, goa.FormatUUID))
+	}
+	if body.CandidateDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.candidate_deployment_id", *body.CandidateDeploymentID, goa.FormatUUID))
+	}
+	if body.StickyBy != nil {
+		if !(*body.StickyBy == "session" || *body.StickyBy == "user") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.sticky_by", *body.StickyBy, []any{"session", "user"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "running" || *body.Status == "promoted" || *body.Status == "aborted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"running", "promoted", "aborted"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.started_at", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.EndedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.ended_at", *body.EndedAt, goa.FormatDateTime))
+	}
+	if body.Baseline != nil {
+		if err2 := ValidateMcpEndpointCanaryArmResponseBody(body.Baseline); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Candidate != nil {
+		if err2 := ValidateMcpEndpointCanaryArmResponseBody(body.Candidate); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
 }
 
-// NewListMcpEndpointsResultOK builds a "mcpEndpoints" service
-// "listMcpEndpoints" endpoint result from a HTTP "OK" response.
-func NewListMcpEndpointsResultOK(body *ListMcpEndpointsResponseBody) *mcpendpoints.ListMcpEndpointsResult {
-	v := &mcpendpoints.ListMcpEndpointsResult{}
-	v.McpEndpoints = make([]*types.McpEndpoint, len(body.McpEndpoints))
-	for i, val := range body.McpEndpoints {
-		if val == nil {
-			v.McpEndpoints[i] = nil
-			continue
+// ValidateAbortMcpEndpointCanaryResponseBody runs the validations defined on
+// AbortMcpEndpointCanaryResponseBody
+func ValidateAbortMcpEndpointCanaryResponseBody(body *AbortMcpEndpointCanaryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.McpEndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mcp_endpoint_id", "body"))
+	}
+	if body.BaselineDeploymentID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("baseline_deployment_id", "body"))
+	}
+	if body.Percentage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("percentage", "body"))
+	}
+	if body.StickyBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sticky_by", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.McpEndpointID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", *body.McpEndpointID, goa.FormatUUID))
+	}
+	if body.BaselineDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.baseline_deployment_id", *body.BaselineDeploymentID, goa.FormatUUID))
+	}
+	if body.CandidateDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.candidate_deployment_id", *body.CandidateDeploymentID, goa.FormatUUID))
+	}
+	if body.StickyBy != nil {
+		if !(*body.StickyBy == "session" || *body.StickyBy == "user") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.sticky_by", *body.StickyBy, []any{"session", "user"}))
 		}
-		v.McpEndpoints[i] = unmarshalMcpEndpointResponseBodyToTypesMcpEndpoint(val)
 	}
-
-	return v
+	if body.Status != nil {
+		if !(*body.Status == "running" || *body.Status == "promoted" || *body.Status == "aborted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"running", "promoted", "aborted"}))
+		}
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.started_at", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.EndedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.ended_at", *body.EndedAt, goa.FormatDateTime))
+	}
+	if body.Baseline != nil {
+		if err2 := ValidateMcpEndpointCanaryArmResponseBody(body.Baseline); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Candidate != nil {
+		if err2 := ValidateMcpEndpointCanaryArmResponseBody(body.Candidate); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
 }
 
-// NewListMcpEndpointsUnauthorized builds a mcpEndpoints service
-// listMcpEndpoints endpoint unauthorized error.
-func NewListMcpEndpointsUnauthorized(body *ListMcpEndpointsUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateMcpEndpointUnauthorizedResponseBody runs the validations
+// defined on createMcpEndpoint_unauthorized_response_body
+func ValidateCreateMcpEndpointUnauthorizedResponseBody(body *CreateMcpEndpointUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
 
-	return v
+// ValidateCreateMcpEndpointForbiddenResponseBody runs the validations defined
+// on createMcpEndpoint_forbidden_response_body
+func ValidateCreateMcpEndpointForbiddenResponseBody(body *CreateMcpEndpointForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewListMcpEndpointsForbidden builds a mcpEndpoints service listMcpEndpoints
-// endpoint forbidden error.
-func NewListMcpEndpointsForbidden(body *ListMcpEndpointsForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateMcpEndpointBadRequestResponseBody runs the validations defined
+// on createMcpEndpoint_bad_request_response_body
+func ValidateCreateMcpEndpointBadRequestResponseBody(body *CreateMcpEndpointBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewListMcpEndpointsBadRequest builds a mcpEndpoints service listMcpEndpoints
-// endpoint bad_request error.
-func NewListMcpEndpointsBadRequest(body *ListMcpEndpointsBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateMcpEndpointNotFoundResponseBody runs the validations defined
+// on createMcpEndpoint_not_found_response_body
+func ValidateCreateMcpEndpointNotFoundResponseBody(body *CreateMcpEndpointNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
-}
-
-// NewListMcpEndpointsNotFound builds a mcpEndpoints service listMcpEndpoints
-// endpoint not_found error.
-func NewListMcpEndpointsNotFound(body *ListMcpEndpointsNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-
-	return v
-}
-
-// NewListMcpEndpointsConflict builds a mcpEndpoints service listMcpEndpoints
-// endpoint conflict error.
-func NewListMcpEndpointsConflict(body *ListMcpEndpointsConflictResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-
-	return v
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewListMcpEndpointsUnsupportedMedia builds a mcpEndpoints service
-// listMcpEndpoints endpoint unsupported_media error.
-func NewListMcpEndpointsUnsupportedMedia(body *ListMcpEndpointsUnsupportedMediaResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateMcpEndpointConflictResponseBody runs the validations defined
+// on createMcpEndpoint_conflict_response_body
+func ValidateCreateMcpEndpointConflictResponseBody(body *CreateMcpEndpointConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewListMcpEndpointsInvalid builds a mcpEndpoints service listMcpEndpoints
-// endpoint invalid error.
-func NewListMcpEndpointsInvalid(body *ListMcpEndpointsInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateMcpEndpointUnsupportedMediaResponseBody runs the validations
+// defined on createMcpEndpoint_unsupported_media_response_body
+func ValidateCreateMcpEndpointUnsupportedMediaResponseBody(body *CreateMcpEndpointUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewListMcpEndpointsInvariantViolation builds a mcpEndpoints service
-// listMcpEndpoints endpoint invariant_violation error.
-func NewListMcpEndpointsInvariantViolation(body *ListMcpEndpointsInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateMcpEndpointInvalidResponseBody runs the validations defined on
+// createMcpEndpoint_invalid_response_body
+func ValidateCreateMcpEndpointInvalidResponseBody(body *CreateMcpEndpointInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewListMcpEndpointsUnexpected builds a mcpEndpoints service listMcpEndpoints
-// endpoint unexpected error.
-func NewListMcpEndpointsUnexpected(body *ListMcpEndpointsUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateMcpEndpointInvariantViolationResponseBody runs the validations
+// defined on createMcpEndpoint_invariant_violation_response_body
+func ValidateCreateMcpEndpointInvariantViolationResponseBody(body *CreateMcpEndpointInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewListMcpEndpointsGatewayError builds a mcpEndpoints service
-// listMcpEndpoints endpoint gateway_error error.
-func NewListMcpEndpointsGatewayError(body *ListMcpEndpointsGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateMcpEndpointUnexpectedResponseBody runs the validations defined
+// on createMcpEndpoint_unexpected_response_body
+func ValidateCreateMcpEndpointUnexpectedResponseBody(body *CreateMcpEndpointUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateMcpEndpointMcpEndpointOK builds a "mcpEndpoints" service
-// "updateMcpEndpoint" endpoint result from a HTTP "OK" response.
-func NewUpdateMcpEndpointMcpEndpointOK(body *UpdateMcpEndpointResponseBody) *types.McpEndpoint {
-	v := &types.McpEndpoint{
-		ID:              *body.ID,
-		ProjectID:       *body.ProjectID,
-		CustomDomainID:  body.CustomDomainID,
-		McpServerID:     body.McpServerID,
-		MetaMcpServerID: body.MetaMcpServerID,
-		Slug:            types.McpEndpointSlug(*body.Slug),
-		IsDomainRoot:    *body.IsDomainRoot,
-		CreatedAt:       *body.CreatedAt,
-		UpdatedAt:       *body.UpdatedAt,
+// ValidateCreateMcpEndpointGatewayErrorResponseBody runs the validations
+// defined on createMcpEndpoint_gateway_error_response_body
+func ValidateCreateMcpEndpointGatewayErrorResponseBody(body *CreateMcpEndpointGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-
-	return v
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateMcpEndpointUnauthorized builds a mcpEndpoints service
-// updateMcpEndpoint endpoint unauthorized error.
-func NewUpdateMcpEndpointUnauthorized(body *UpdateMcpEndpointUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetMcpEndpointUnauthorizedResponseBody runs the validations defined
+// on getMcpEndpoint_unauthorized_response_body
+func ValidateGetMcpEndpointUnauthorizedResponseBody(body *GetMcpEndpointUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateMcpEndpointForbidden builds a mcpEndpoints service
-// updateMcpEndpoint endpoint forbidden error.
-func NewUpdateMcpEndpointForbidden(body *UpdateMcpEndpointForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetMcpEndpointForbiddenResponseBody runs the validations defined on
+// getMcpEndpoint_forbidden_response_body
+func ValidateGetMcpEndpointForbiddenResponseBody(body *GetMcpEndpointForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateMcpEndpointBadRequest builds a mcpEndpoints service
-// updateMcpEndpoint endpoint bad_request error.
-func NewUpdateMcpEndpointBadRequest(body *UpdateMcpEndpointBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetMcpEndpointBadRequestResponseBody runs the validations defined on
+// getMcpEndpoint_bad_request_response_body
+func ValidateGetMcpEndpointBadRequestResponseBody(body *GetMcpEndpointBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateMcpEndpointNotFound builds a mcpEndpoints service updateMcpEndpoint
-// endpoint not_found error.
-func NewUpdateMcpEndpointNotFound(body *UpdateMcpEndpointNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetMcpEndpointNotFoundResponseBody runs the validations defined on
+// getMcpEndpoint_not_found_response_body
+func ValidateGetMcpEndpointNotFoundResponseBody(body *GetMcpEndpointNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateMcpEndpointConflict builds a mcpEndpoints service updateMcpEndpoint
-// endpoint conflict error.
-func NewUpdateMcpEndpointConflict(body *UpdateMcpEndpointConflictResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetMcpEndpointConflictResponseBody runs the validations defined on
+// getMcpEndpoint_conflict_response_body
+func ValidateGetMcpEndpointConflictResponseBody(body *GetMcpEndpointConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateMcpEndpointUnsupportedMedia builds a mcpEndpoints service
-// updateMcpEndpoint endpoint unsupported_media error.
-func NewUpdateMcpEndpointUnsupportedMedia(body *UpdateMcpEndpointUnsupportedMediaResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetMcpEndpointUnsupportedMediaResponseBody runs the validations
+// defined on getMcpEndpoint_unsupported_media_response_body
+func ValidateGetMcpEndpointUnsupportedMediaResponseBody(body *GetMcpEndpointUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
 
-	return v
+// ValidateGetMcpEndpointInvalidResponseBody runs the validations defined on
+// getMcpEndpoint_invalid_response_body
+func ValidateGetMcpEndpointInvalidResponseBody(body *GetMcpEndpointInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateMcpEndpointInvalid builds a mcpEndpoints service updateMcpEndpoint
-// endpoint invalid error.
-func NewUpdateMcpEndpointInvalid(body *UpdateMcpEndpointInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetMcpEndpointInvariantViolationResponseBody runs the validations
+// defined on getMcpEndpoint_invariant_violation_response_body
+func ValidateGetMcpEndpointInvariantViolationResponseBody(body *GetMcpEndpointInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-
-	return v
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateMcpEndpointInvariantViolation builds a mcpEndpoints service
-// updateMcpEndpoint endpoint invariant_violation error.
-func NewUpdateMcpEndpointInvariantViolation(body *UpdateMcpEndpointInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetMcpEndpointUnexpectedResponseBody runs the validations defined on
+// getMcpEndpoint_unexpected_response_body
+func ValidateGetMcpEndpointUnexpectedResponseBody(body *GetMcpEndpointUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateMcpEndpointUnexpected builds a mcpEndpoints service
-// updateMcpEndpoint endpoint unexpected error.
-func NewUpdateMcpEndpointUnexpected(body *UpdateMcpEndpointUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetMcpEndpointGatewayErrorResponseBody runs the validations defined
+// on getMcpEndpoint_gateway_error_response_body
+func ValidateGetMcpEndpointGatewayErrorResponseBody(body *GetMcpEndpointGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateMcpEndpointGatewayError builds a mcpEndpoints service
-// updateMcpEndpoint endpoint gateway_error error.
-func NewUpdateMcpEndpointGatewayError(body *UpdateMcpEndpointGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListMcpEndpointsUnauthorizedResponseBody runs the validations
+// defined on listMcpEndpoints_unauthorized_response_body
+func ValidateListMcpEndpointsUnauthorizedResponseBody(body *ListMcpEndpointsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCheckMcpEndpointSlugAvailabilityUnauthorized builds a mcpEndpoints
-// service checkMcpEndpointSlugAvailability endpoint unauthorized error.
-func NewCheckMcpEndpointSlugAvailabilityUnauthorized(body *CheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListMcpEndpointsForbiddenResponseBody runs the validations defined
+// on listMcpEndpoints_forbidden_response_body
+func ValidateListMcpEndpointsForbiddenResponseBody(body *ListMcpEndpointsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCheckMcpEndpointSlugAvailabilityForbidden builds a mcpEndpoints service
-// checkMcpEndpointSlugAvailability endpoint forbidden error.
-func NewCheckMcpEndpointSlugAvailabilityForbidden(body *CheckMcpEndpointSlugAvailabilityForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListMcpEndpointsBadRequestResponseBody runs the validations defined
+// on listMcpEndpoints_bad_request_response_body
+func ValidateListMcpEndpointsBadRequestResponseBody(body *ListMcpEndpointsBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCheckMcpEndpointSlugAvailabilityBadRequest builds a mcpEndpoints service
-// checkMcpEndpointSlugAvailability endpoint bad_request error.
-func NewCheckMcpEndpointSlugAvailabilityBadRequest(body *CheckMcpEndpointSlugAvailabilityBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListMcpEndpointsNotFoundResponseBody runs the validations defined on
+// listMcpEndpoints_not_found_response_body
+func ValidateListMcpEndpointsNotFoundResponseBody(body *ListMcpEndpointsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
 
-	return v
+// ValidateListMcpEndpointsConflictResponseBody runs the validations defined on
+// listMcpEndpoints_conflict_response_body
+func ValidateListMcpEndpointsConflictResponseBody(body *ListMcpEndpointsConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCheckMcpEndpointSlugAvailabilityNotFound builds a mcpEndpoints service
-// checkMcpEndpointSlugAvailability endpoint not_found error.
-func NewCheckMcpEndpointSlugAvailabilityNotFound(body *CheckMcpEndpointSlugAvailabilityNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListMcpEndpointsUnsupportedMediaResponseBody runs the validations
+// defined on listMcpEndpoints_unsupported_media_response_body
+func ValidateListMcpEndpointsUnsupportedMediaResponseBody(body *ListMcpEndpointsUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewCheckMcpEndpointSlugAvailabilityConflict builds a mcpEndpoints service
-// checkMcpEndpointSlugAvailability endpoint conflict error.
-func NewCheckMcpEndpointSlugAvailabilityConflict(body *CheckMcpEndpointSlugAvailabilityConflictResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListMcpEndpointsInvalidResponseBody runs the validations defined on
+// listMcpEndpoints_invalid_response_body
+func ValidateListMcpEndpointsInvalidResponseBody(body *ListMcpEndpointsInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
-}
-
-// NewCheckMcpEndpointSlugAvailabilityUnsupportedMedia builds a mcpEndpoints
-// service checkMcpEndpointSlugAvailability endpoint unsupported_media error.
-func NewCheckMcpEndpointSlugAvailabilityUnsupportedMedia(body *CheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-
-	return v
-}
-
-// NewCheckMcpEndpointSlugAvailabilityInvalid builds a mcpEndpoints service
-// checkMcpEndpointSlugAvailability endpoint invalid error.
-func NewCheckMcpEndpointSlugAvailabilityInvalid(body *CheckMcpEndpointSlugAvailabilityInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-
-	return v
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCheckMcpEndpointSlugAvailabilityInvariantViolation builds a mcpEndpoints
-// service checkMcpEndpointSlugAvailability endpoint invariant_violation error.
-func NewCheckMcpEndpointSlugAvailabilityInvariantViolation(body *CheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListMcpEndpointsInvariantViolationResponseBody runs the validations
+// defined on listMcpEndpoints_invariant_violation_response_body
+func ValidateListMcpEndpointsInvariantViolationResponseBody(body *ListMcpEndpointsInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCheckMcpEndpointSlugAvailabilityUnexpected builds a mcpEndpoints service
-// checkMcpEndpointSlugAvailability endpoint unexpected error.
-func NewCheckMcpEndpointSlugAvailabilityUnexpected(body *CheckMcpEndpointSlugAvailabilityUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListMcpEndpointsUnexpectedResponseBody runs the validations defined
+// on listMcpEndpoints_unexpected_response_body
+func ValidateListMcpEndpointsUnexpectedResponseBody(body *ListMcpEndpointsUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCheckMcpEndpointSlugAvailabilityGatewayError builds a mcpEndpoints
-// service checkMcpEndpointSlugAvailability endpoint gateway_error error.
-func NewCheckMcpEndpointSlugAvailabilityGatewayError(body *CheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListMcpEndpointsGatewayErrorResponseBody runs the validations
+// defined on listMcpEndpoints_gateway_error_response_body
+func ValidateListMcpEndpointsGatewayErrorResponseBody(body *ListMcpEndpointsGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteMcpEndpointUnauthorized builds a mcpEndpoints service
-// deleteMcpEndpoint endpoint unauthorized error.
-func NewDeleteMcpEndpointUnauthorized(body *DeleteMcpEndpointUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateMcpEndpointUnauthorizedResponseBody runs the validations
+// defined on updateMcpEndpoint_unauthorized_response_body
+func ValidateUpdateMcpEndpointUnauthorizedResponseBody(body *UpdateMcpEndpointUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteMcpEndpointForbidden builds a mcpEndpoints service
-// deleteMcpEndpoint endpoint forbidden error.
-func NewDeleteMcpEndpointForbidden(body *DeleteMcpEndpointForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateMcpEndpointForbiddenResponseBody runs the validations defined
+// on updateMcpEndpoint_forbidden_response_body
+func ValidateUpdateMcpEndpointForbiddenResponseBody(body *UpdateMcpEndpointForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteMcpEndpointBadRequest builds a mcpEndpoints service
-// deleteMcpEndpoint endpoint bad_request error.
-func NewDeleteMcpEndpointBadRequest(body *DeleteMcpEndpointBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateMcpEndpointBadRequestResponseBody runs the validations defined
+// on updateMcpEndpoint_bad_request_response_body
+func ValidateUpdateMcpEndpointBadRequestResponseBody(body *UpdateMcpEndpointBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewDeleteMcpEndpointNotFound builds a mcpEndpoints service deleteMcpEndpoint
-// endpoint not_found error.
-func NewDeleteMcpEndpointNotFound(body *DeleteMcpEndpointNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateMcpEndpointNotFoundResponseBody runs the validations defined
+// on updateMcpEndpoint_not_found_response_body
+func ValidateUpdateMcpEndpointNotFoundResponseBody(body *UpdateMcpEndpointNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteMcpEndpointConflict builds a mcpEndpoints service deleteMcpEndpoint
-// endpoint conflict error.
-func NewDeleteMcpEndpointConflict(body *DeleteMcpEndpointConflictResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateMcpEndpointConflictResponseBody runs the validations defined
+// on updateMcpEndpoint_conflict_response_body
+func ValidateUpdateMcpEndpointConflictResponseBody(body *UpdateMcpEndpointConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteMcpEndpointUnsupportedMedia builds a mcpEndpoints service
-// deleteMcpEndpoint endpoint unsupported_media error.
-func NewDeleteMcpEndpointUnsupportedMedia(body *DeleteMcpEndpointUnsupportedMediaResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateMcpEndpointUnsupportedMediaResponseBody runs the validations
+// defined on updateMcpEndpoint_unsupported_media_response_body
+func ValidateUpdateMcpEndpointUnsupportedMediaResponseBody(body *UpdateMcpEndpointUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteMcpEndpointInvalid builds a mcpEndpoints service deleteMcpEndpoint
-// endpoint invalid error.
-func NewDeleteMcpEndpointInvalid(body *DeleteMcpEndpointInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateMcpEndpointInvalidResponseBody runs the validations defined on
+// updateMcpEndpoint_invalid_response_body
+func ValidateUpdateMcpEndpointInvalidResponseBody(body *UpdateMcpEndpointInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteMcpEndpointInvariantViolation builds a mcpEndpoints service
-// deleteMcpEndpoint endpoint invariant_violation error.
-func NewDeleteMcpEndpointInvariantViolation(body *DeleteMcpEndpointInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateMcpEndpointInvariantViolationResponseBody runs the validations
+// defined on updateMcpEndpoint_invariant_violation_response_body
+func ValidateUpdateMcpEndpointInvariantViolationResponseBody(body *UpdateMcpEndpointInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteMcpEndpointUnexpected builds a mcpEndpoints service
-// deleteMcpEndpoint endpoint unexpected error.
-func NewDeleteMcpEndpointUnexpected(body *DeleteMcpEndpointUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateMcpEndpointUnexpectedResponseBody runs the validations defined
+// on updateMcpEndpoint_unexpected_response_body
+func ValidateUpdateMcpEndpointUnexpectedResponseBody(body *UpdateMcpEndpointUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteMcpEndpointGatewayError builds a mcpEndpoints service
-// deleteMcpEndpoint endpoint gateway_error error.
-func NewDeleteMcpEndpointGatewayError(body *DeleteMcpEndpointGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateMcpEndpointGatewayErrorResponseBody runs the validations
+// defined on updateMcpEndpoint_gateway_error_response_body
+func ValidateUpdateMcpEndpointGatewayErrorResponseBody(body *UpdateMcpEndpointGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateCheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody runs the
+// validations defined on
+// checkMcpEndpointSlugAvailability_unauthorized_response_body
+func ValidateCheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody(body *CheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// ValidateCreateMcpEndpointResponseBody runs the validations defined on
-// CreateMcpEndpointResponseBody
-func ValidateCreateMcpEndpointResponseBody(body *CreateMcpEndpointResponseBody) (err error) {
+// ValidateCheckMcpEndpointSlugAvailabilityForbiddenResponseBody runs the
+// validations defined on
+// checkMcpEndpointSlugAvailability_forbidden_response_body
+func ValidateCheckMcpEndpointSlugAvailabilityForbiddenResponseBody(body *CheckMcpEndpointSlugAvailabilityForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ProjectID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Slug == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.IsDomainRoot == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("is_domain_root", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.UpdatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	return
+}
+
+// ValidateCheckMcpEndpointSlugAvailabilityBadRequestResponseBody runs the
+// validations defined on
+// checkMcpEndpointSlugAvailability_bad_request_response_body
+func ValidateCheckMcpEndpointSlugAvailabilityBadRequestResponseBody(body *CheckMcpEndpointSlugAvailabilityBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ProjectID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_id", *body.ProjectID, goa.FormatUUID))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.CustomDomainID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.custom_domain_id", *body.CustomDomainID, goa.FormatUUID))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.McpServerID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_server_id", *body.McpServerID, goa.FormatUUID))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.MetaMcpServerID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.meta_mcp_server_id", *body.MetaMcpServerID, goa.FormatUUID))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.Slug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	return
+}
+
+// ValidateCheckMcpEndpointSlugAvailabilityNotFoundResponseBody runs the
+// validations defined on
+// checkMcpEndpointSlugAvailability_not_found_response_body
+func ValidateCheckMcpEndpointSlugAvailabilityNotFoundResponseBody(body *CheckMcpEndpointSlugAvailabilityNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.Slug != nil {
-		if utf8.RuneCountInString(*body.Slug) > 128 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 128, false))
-		}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateGetMcpEndpointResponseBody runs the validations defined on
-// GetMcpEndpointResponseBody
-func ValidateGetMcpEndpointResponseBody(body *GetMcpEndpointResponseBody) (err error) {
+// ValidateCheckMcpEndpointSlugAvailabilityConflictResponseBody runs the
+// validations defined on
+// checkMcpEndpointSlugAvailability_conflict_response_body
+func ValidateCheckMcpEndpointSlugAvailabilityConflictResponseBody(body *CheckMcpEndpointSlugAvailabilityConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ProjectID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Slug == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.IsDomainRoot == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("is_domain_root", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.UpdatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	return
+}
+
+// ValidateCheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody runs
+// the validations defined on
+// checkMcpEndpointSlugAvailability_unsupported_media_response_body
+func ValidateCheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody(body *CheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ProjectID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_id", *body.ProjectID, goa.FormatUUID))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.CustomDomainID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.custom_domain_id", *body.CustomDomainID, goa.FormatUUID))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.McpServerID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_server_id", *body.McpServerID, goa.FormatUUID))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.MetaMcpServerID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.meta_mcp_server_id", *body.MetaMcpServerID, goa.FormatUUID))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.Slug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	return
+}
+
+// ValidateCheckMcpEndpointSlugAvailabilityInvalidResponseBody runs the
+// validations defined on checkMcpEndpointSlugAvailability_invalid_response_body
+func ValidateCheckMcpEndpointSlugAvailabilityInvalidResponseBody(body *CheckMcpEndpointSlugAvailabilityInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.Slug != nil {
-		if utf8.RuneCountInString(*body.Slug) > 128 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 128, false))
-		}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	return
-}
-
-// ValidateListMcpEndpointsResponseBody runs the validations defined on
-// ListMcpEndpointsResponseBody
-func ValidateListMcpEndpointsResponseBody(body *ListMcpEndpointsResponseBody) (err error) {
-	if body.McpEndpoints == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("mcp_endpoints", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	for _, e := range body.McpEndpoints {
-		if e != nil {
-			if err2 := ValidateMcpEndpointResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateUpdateMcpEndpointResponseBody runs the validations defined on
-// UpdateMcpEndpointResponseBody
-func ValidateUpdateMcpEndpointResponseBody(body *UpdateMcpEndpointResponseBody) (err error) {
+// ValidateCheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody runs
+// the validations defined on
+// checkMcpEndpointSlugAvailability_invariant_violation_response_body
+func ValidateCheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody(body *CheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ProjectID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Slug == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.IsDomainRoot == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("is_domain_root", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.UpdatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	return
+}
+
+// ValidateCheckMcpEndpointSlugAvailabilityUnexpectedResponseBody runs the
+// validations defined on
+// checkMcpEndpointSlugAvailability_unexpected_response_body
+func ValidateCheckMcpEndpointSlugAvailabilityUnexpectedResponseBody(body *CheckMcpEndpointSlugAvailabilityUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ProjectID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.project_id", *body.ProjectID, goa.FormatUUID))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.CustomDomainID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.custom_domain_id", *body.CustomDomainID, goa.FormatUUID))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.McpServerID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_server_id", *body.McpServerID, goa.FormatUUID))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.MetaMcpServerID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.meta_mcp_server_id", *body.MetaMcpServerID, goa.FormatUUID))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody runs the
+// validations defined on
+// checkMcpEndpointSlugAvailability_gateway_error_response_body
+func ValidateCheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody(body *CheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.Slug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.Slug != nil {
-		if utf8.RuneCountInString(*body.Slug) > 128 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 128, false))
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateCreateMcpEndpointUnauthorizedResponseBody runs the validations
-// defined on createMcpEndpoint_unauthorized_response_body
-func ValidateCreateMcpEndpointUnauthorizedResponseBody(body *CreateMcpEndpointUnauthorizedResponseBody) (err error) {
+// ValidateDeleteMcpEndpointUnauthorizedResponseBody runs the validations
+// defined on deleteMcpEndpoint_unauthorized_response_body
+func ValidateDeleteMcpEndpointUnauthorizedResponseBody(body *DeleteMcpEndpointUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2489,9 +6119,9 @@ func ValidateCreateMcpEndpointUnauthorizedResponseBody(body *CreateMcpEndpointUn
 	return
 }
 
-// ValidateCreateMcpEndpointForbiddenResponseBody runs the validations defined
-// on createMcpEndpoint_forbidden_response_body
-func ValidateCreateMcpEndpointForbiddenResponseBody(body *CreateMcpEndpointForbiddenResponseBody) (err error) {
+// ValidateDeleteMcpEndpointForbiddenResponseBody runs the validations defined
+// on deleteMcpEndpoint_forbidden_response_body
+func ValidateDeleteMcpEndpointForbiddenResponseBody(body *DeleteMcpEndpointForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2513,9 +6143,9 @@ func ValidateCreateMcpEndpointForbiddenResponseBody(body *CreateMcpEndpointForbi
 	return
 }
 
-// ValidateCreateMcpEndpointBadRequestResponseBody runs the validations defined
-// on createMcpEndpoint_bad_request_response_body
-func ValidateCreateMcpEndpointBadRequestResponseBody(body *CreateMcpEndpointBadRequestResponseBody) (err error) {
+// ValidateDeleteMcpEndpointBadRequestResponseBody runs the validations defined
+// on deleteMcpEndpoint_bad_request_response_body
+func ValidateDeleteMcpEndpointBadRequestResponseBody(body *DeleteMcpEndpointBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2537,9 +6167,9 @@ func ValidateCreateMcpEndpointBadRequestResponseBody(body *CreateMcpEndpointBadR
 	return
 }
 
-// ValidateCreateMcpEndpointNotFoundResponseBody runs the validations defined
-// on createMcpEndpoint_not_found_response_body
-func ValidateCreateMcpEndpointNotFoundResponseBody(body *CreateMcpEndpointNotFoundResponseBody) (err error) {
+// ValidateDeleteMcpEndpointNotFoundResponseBody runs the validations defined
+// on deleteMcpEndpoint_not_found_response_body
+func ValidateDeleteMcpEndpointNotFoundResponseBody(body *DeleteMcpEndpointNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2561,9 +6191,9 @@ func ValidateCreateMcpEndpointNotFoundResponseBody(body *CreateMcpEndpointNotFou
 	return
 }
 
-// ValidateCreateMcpEndpointConflictResponseBody runs the validations defined
-// on createMcpEndpoint_conflict_response_body
-func ValidateCreateMcpEndpointConflictResponseBody(body *CreateMcpEndpointConflictResponseBody) (err error) {
+// ValidateDeleteMcpEndpointConflictResponseBody runs the validations defined
+// on deleteMcpEndpoint_conflict_response_body
+func ValidateDeleteMcpEndpointConflictResponseBody(body *DeleteMcpEndpointConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2585,9 +6215,9 @@ func ValidateCreateMcpEndpointConflictResponseBody(body *CreateMcpEndpointConfli
 	return
 }
 
-// ValidateCreateMcpEndpointUnsupportedMediaResponseBody runs the validations
-// defined on createMcpEndpoint_unsupported_media_response_body
-func ValidateCreateMcpEndpointUnsupportedMediaResponseBody(body *CreateMcpEndpointUnsupportedMediaResponseBody) (err error) {
+// ValidateDeleteMcpEndpointUnsupportedMediaResponseBody runs the validations
+// defined on deleteMcpEndpoint_unsupported_media_response_body
+func ValidateDeleteMcpEndpointUnsupportedMediaResponseBody(body *DeleteMcpEndpointUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2609,9 +6239,9 @@ func ValidateCreateMcpEndpointUnsupportedMediaResponseBody(body *CreateMcpEndpoi
 	return
 }
 
-// ValidateCreateMcpEndpointInvalidResponseBody runs the validations defined on
-// createMcpEndpoint_invalid_response_body
-func ValidateCreateMcpEndpointInvalidResponseBody(body *CreateMcpEndpointInvalidResponseBody) (err error) {
+// ValidateDeleteMcpEndpointInvalidResponseBody runs the validations defined on
+// deleteMcpEndpoint_invalid_response_body
+func ValidateDeleteMcpEndpointInvalidResponseBody(body *DeleteMcpEndpointInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2633,9 +6263,9 @@ func ValidateCreateMcpEndpointInvalidResponseBody(body *CreateMcpEndpointInvalid
 	return
 }
 
-// ValidateCreateMcpEndpointInvariantViolationResponseBody runs the validations
-// defined on createMcpEndpoint_invariant_violation_response_body
-func ValidateCreateMcpEndpointInvariantViolationResponseBody(body *CreateMcpEndpointInvariantViolationResponseBody) (err error) {
+// ValidateDeleteMcpEndpointInvariantViolationResponseBody runs the validations
+// defined on deleteMcpEndpoint_invariant_violation_response_body
+func ValidateDeleteMcpEndpointInvariantViolationResponseBody(body *DeleteMcpEndpointInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2657,9 +6287,9 @@ func ValidateCreateMcpEndpointInvariantViolationResponseBody(body *CreateMcpEndp
 	return
 }
 
-// ValidateCreateMcpEndpointUnexpectedResponseBody runs the validations defined
-// on createMcpEndpoint_unexpected_response_body
-func ValidateCreateMcpEndpointUnexpectedResponseBody(body *CreateMcpEndpointUnexpectedResponseBody) (err error) {
+// ValidateDeleteMcpEndpointUnexpectedResponseBody runs the validations defined
+// on deleteMcpEndpoint_unexpected_response_body
+func ValidateDeleteMcpEndpointUnexpectedResponseBody(body *DeleteMcpEndpointUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2681,9 +6311,9 @@ func ValidateCreateMcpEndpointUnexpectedResponseBody(body *CreateMcpEndpointUnex
 	return
 }
 
-// ValidateCreateMcpEndpointGatewayErrorResponseBody runs the validations
-// defined on createMcpEndpoint_gateway_error_response_body
-func ValidateCreateMcpEndpointGatewayErrorResponseBody(body *CreateMcpEndpointGatewayErrorResponseBody) (err error) {
+// ValidateDeleteMcpEndpointGatewayErrorResponseBody runs the validations
+// defined on deleteMcpEndpoint_gateway_error_response_body
+func ValidateDeleteMcpEndpointGatewayErrorResponseBody(body *DeleteMcpEndpointGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2705,9 +6335,9 @@ func ValidateCreateMcpEndpointGatewayErrorResponseBody(body *CreateMcpEndpointGa
 	return
 }
 
-// ValidateGetMcpEndpointUnauthorizedResponseBody runs the validations defined
-// on getMcpEndpoint_unauthorized_response_body
-func ValidateGetMcpEndpointUnauthorizedResponseBody(body *GetMcpEndpointUnauthorizedResponseBody) (err error) {
+// ValidateStartMcpEndpointCanaryUnauthorizedResponseBody runs the validations
+// defined on startMcpEndpointCanary_unauthorized_response_body
+func ValidateStartMcpEndpointCanaryUnauthorizedResponseBody(body *StartMcpEndpointCanaryUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2729,9 +6359,9 @@ func ValidateGetMcpEndpointUnauthorizedResponseBody(body *GetMcpEndpointUnauthor
 	return
 }
 
-// ValidateGetMcpEndpointForbiddenResponseBody runs the validations defined on
-// getMcpEndpoint_forbidden_response_body
-func ValidateGetMcpEndpointForbiddenResponseBody(body *GetMcpEndpointForbiddenResponseBody) (err error) {
+// ValidateStartMcpEndpointCanaryForbiddenResponseBody runs the validations
+// defined on startMcpEndpointCanary_forbidden_response_body
+func ValidateStartMcpEndpointCanaryForbiddenResponseBody(body *StartMcpEndpointCanaryForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2753,9 +6383,9 @@ func ValidateGetMcpEndpointForbiddenResponseBody(body *GetMcpEndpointForbiddenRe
 	return
 }
 
-// ValidateGetMcpEndpointBadRequestResponseBody runs the validations defined on
-// getMcpEndpoint_bad_request_response_body
-func ValidateGetMcpEndpointBadRequestResponseBody(body *GetMcpEndpointBadRequestResponseBody) (err error) {
+// ValidateStartMcpEndpointCanaryBadRequestResponseBody runs the validations
+// defined on startMcpEndpointCanary_bad_request_response_body
+func ValidateStartMcpEndpointCanaryBadRequestResponseBody(body *StartMcpEndpointCanaryBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2777,9 +6407,9 @@ func ValidateGetMcpEndpointBadRequestResponseBody(body *GetMcpEndpointBadRequest
 	return
 }
 
-// ValidateGetMcpEndpointNotFoundResponseBody runs the validations defined on
-// getMcpEndpoint_not_found_response_body
-func ValidateGetMcpEndpointNotFoundResponseBody(body *GetMcpEndpointNotFoundResponseBody) (err error) {
+// ValidateStartMcpEndpointCanaryNotFoundResponseBody runs the validations
+// defined on startMcpEndpointCanary_not_found_response_body
+func ValidateStartMcpEndpointCanaryNotFoundResponseBody(body *StartMcpEndpointCanaryNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2801,9 +6431,9 @@ func ValidateGetMcpEndpointNotFoundResponseBody(body *GetMcpEndpointNotFoundResp
 	return
 }
 
-// ValidateGetMcpEndpointConflictResponseBody runs the validations defined on
-// getMcpEndpoint_conflict_response_body
-func ValidateGetMcpEndpointConflictResponseBody(body *GetMcpEndpointConflictResponseBody) (err error) {
+// ValidateStartMcpEndpointCanaryConflictResponseBody runs the validations
+// defined on startMcpEndpointCanary_conflict_response_body
+func ValidateStartMcpEndpointCanaryConflictResponseBody(body *StartMcpEndpointCanaryConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2825,9 +6455,9 @@ func ValidateGetMcpEndpointConflictResponseBody(body *GetMcpEndpointConflictResp
 	return
 }
 
-// ValidateGetMcpEndpointUnsupportedMediaResponseBody runs the validations
-// defined on getMcpEndpoint_unsupported_media_response_body
-func ValidateGetMcpEndpointUnsupportedMediaResponseBody(body *GetMcpEndpointUnsupportedMediaResponseBody) (err error) {
+// ValidateStartMcpEndpointCanaryUnsupportedMediaResponseBody runs the
+// validations defined on startMcpEndpointCanary_unsupported_media_response_body
+func ValidateStartMcpEndpointCanaryUnsupportedMediaResponseBody(body *StartMcpEndpointCanaryUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2849,9 +6479,9 @@ func ValidateGetMcpEndpointUnsupportedMediaResponseBody(body *GetMcpEndpointUnsu
 	return
 }
 
-// ValidateGetMcpEndpointInvalidResponseBody runs the validations defined on
-// getMcpEndpoint_invalid_response_body
-func ValidateGetMcpEndpointInvalidResponseBody(body *GetMcpEndpointInvalidResponseBody) (err error) {
+// ValidateStartMcpEndpointCanaryInvalidResponseBody runs the validations
+// defined on startMcpEndpointCanary_invalid_response_body
+func ValidateStartMcpEndpointCanaryInvalidResponseBody(body *StartMcpEndpointCanaryInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2873,9 +6503,10 @@ func ValidateGetMcpEndpointInvalidResponseBody(body *GetMcpEndpointInvalidRespon
 	return
 }
 
-// ValidateGetMcpEndpointInvariantViolationResponseBody runs the validations
-// defined on getMcpEndpoint_invariant_violation_response_body
-func ValidateGetMcpEndpointInvariantViolationResponseBody(body *GetMcpEndpointInvariantViolationResponseBody) (err error) {
+// ValidateStartMcpEndpointCanaryInvariantViolationResponseBody runs the
+// validations defined on
+// startMcpEndpointCanary_invariant_violation_response_body
+func ValidateStartMcpEndpointCanaryInvariantViolationResponseBody(body *StartMcpEndpointCanaryInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2897,9 +6528,9 @@ func ValidateGetMcpEndpointInvariantViolationResponseBody(body *GetMcpEndpointIn
 	return
 }
 
-// ValidateGetMcpEndpointUnexpectedResponseBody runs the validations defined on
-// getMcpEndpoint_unexpected_response_body
-func ValidateGetMcpEndpointUnexpectedResponseBody(body *GetMcpEndpointUnexpectedResponseBody) (err error) {
+// ValidateStartMcpEndpointCanaryUnexpectedResponseBody runs the validations
+// defined on startMcpEndpointCanary_unexpected_response_body
+func ValidateStartMcpEndpointCanaryUnexpectedResponseBody(body *StartMcpEndpointCanaryUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2921,9 +6552,9 @@ func ValidateGetMcpEndpointUnexpectedResponseBody(body *GetMcpEndpointUnexpected
 	return
 }
 
-// ValidateGetMcpEndpointGatewayErrorResponseBody runs the validations defined
-// on getMcpEndpoint_gateway_error_response_body
-func ValidateGetMcpEndpointGatewayErrorResponseBody(body *GetMcpEndpointGatewayErrorResponseBody) (err error) {
+// ValidateStartMcpEndpointCanaryGatewayErrorResponseBody runs the validations
+// defined on startMcpEndpointCanary_gateway_error_response_body
+func ValidateStartMcpEndpointCanaryGatewayErrorResponseBody(body *StartMcpEndpointCanaryGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2945,9 +6576,9 @@ func ValidateGetMcpEndpointGatewayErrorResponseBody(body *GetMcpEndpointGatewayE
 	return
 }
 
-// ValidateListMcpEndpointsUnauthorizedResponseBody runs the validations
-// defined on listMcpEndpoints_unauthorized_response_body
-func ValidateListMcpEndpointsUnauthorizedResponseBody(body *ListMcpEndpointsUnauthorizedResponseBody) (err error) {
+// ValidateGetMcpEndpointCanaryUnauthorizedResponseBody runs the validations
+// defined on getMcpEndpointCanary_unauthorized_response_body
+func ValidateGetMcpEndpointCanaryUnauthorizedResponseBody(body *GetMcpEndpointCanaryUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2969,9 +6600,9 @@ func ValidateListMcpEndpointsUnauthorizedResponseBody(body *ListMcpEndpointsUnau
 	return
 }
 
-// ValidateListMcpEndpointsForbiddenResponseBody runs the validations defined
-// on listMcpEndpoints_forbidden_response_body
-func ValidateListMcpEndpointsForbiddenResponseBody(body *ListMcpEndpointsForbiddenResponseBody) (err error) {
+// ValidateGetMcpEndpointCanaryForbiddenResponseBody runs the validations
+// defined on getMcpEndpointCanary_forbidden_response_body
+func ValidateGetMcpEndpointCanaryForbiddenResponseBody(body *GetMcpEndpointCanaryForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -2993,9 +6624,9 @@ func ValidateListMcpEndpointsForbiddenResponseBody(body *ListMcpEndpointsForbidd
 	return
 }
 
-// ValidateListMcpEndpointsBadRequestResponseBody runs the validations defined
-// on listMcpEndpoints_bad_request_response_body
-func ValidateListMcpEndpointsBadRequestResponseBody(body *ListMcpEndpointsBadRequestResponseBody) (err error) {
+// ValidateGetMcpEndpointCanaryBadRequestResponseBody runs the validations
+// defined on getMcpEndpointCanary_bad_request_response_body
+func ValidateGetMcpEndpointCanaryBadRequestResponseBody(body *GetMcpEndpointCanaryBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3017,9 +6648,9 @@ func ValidateListMcpEndpointsBadRequestResponseBody(body *ListMcpEndpointsBadReq
 	return
 }
 
-// ValidateListMcpEndpointsNotFoundResponseBody runs the validations defined on
-// listMcpEndpoints_not_found_response_body
-func ValidateListMcpEndpointsNotFoundResponseBody(body *ListMcpEndpointsNotFoundResponseBody) (err error) {
+// ValidateGetMcpEndpointCanaryNotFoundResponseBody runs the validations
+// defined on getMcpEndpointCanary_not_found_response_body
+func ValidateGetMcpEndpointCanaryNotFoundResponseBody(body *GetMcpEndpointCanaryNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3041,9 +6672,9 @@ func ValidateListMcpEndpointsNotFoundResponseBody(body *ListMcpEndpointsNotFound
 	return
 }
 
-// ValidateListMcpEndpointsConflictResponseBody runs the validations defined on
-// listMcpEndpoints_conflict_response_body
-func ValidateListMcpEndpointsConflictResponseBody(body *ListMcpEndpointsConflictResponseBody) (err error) {
+// ValidateGetMcpEndpointCanaryConflictResponseBody runs the validations
+// defined on getMcpEndpointCanary_conflict_response_body
+func ValidateGetMcpEndpointCanaryConflictResponseBody(body *GetMcpEndpointCanaryConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3065,9 +6696,9 @@ func ValidateListMcpEndpointsConflictResponseBody(body *ListMcpEndpointsConflict
 	return
 }
 
-// ValidateListMcpEndpointsUnsupportedMediaResponseBody runs the validations
-// defined on listMcpEndpoints_unsupported_media_response_body
-func ValidateListMcpEndpointsUnsupportedMediaResponseBody(body *ListMcpEndpointsUnsupportedMediaResponseBody) (err error) {
+// ValidateGetMcpEndpointCanaryUnsupportedMediaResponseBody runs the
+// validations defined on getMcpEndpointCanary_unsupported_media_response_body
+func ValidateGetMcpEndpointCanaryUnsupportedMediaResponseBody(body *GetMcpEndpointCanaryUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3089,9 +6720,9 @@ func ValidateListMcpEndpointsUnsupportedMediaResponseBody(body *ListMcpEndpoints
 	return
 }
 
-// ValidateListMcpEndpointsInvalidResponseBody runs the validations defined on
-// listMcpEndpoints_invalid_response_body
-func ValidateListMcpEndpointsInvalidResponseBody(body *ListMcpEndpointsInvalidResponseBody) (err error) {
+// ValidateGetMcpEndpointCanaryInvalidResponseBody runs the validations defined
+// on getMcpEndpointCanary_invalid_response_body
+func ValidateGetMcpEndpointCanaryInvalidResponseBody(body *GetMcpEndpointCanaryInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3113,9 +6744,9 @@ func ValidateListMcpEndpointsInvalidResponseBody(body *ListMcpEndpointsInvalidRe
 	return
 }
 
-// ValidateListMcpEndpointsInvariantViolationResponseBody runs the validations
-// defined on listMcpEndpoints_invariant_violation_response_body
-func ValidateListMcpEndpointsInvariantViolationResponseBody(body *ListMcpEndpointsInvariantViolationResponseBody) (err error) {
+// ValidateGetMcpEndpointCanaryInvariantViolationResponseBody runs the
+// validations defined on getMcpEndpointCanary_invariant_violation_response_body
+func ValidateGetMcpEndpointCanaryInvariantViolationResponseBody(body *GetMcpEndpointCanaryInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3137,9 +6768,9 @@ func ValidateListMcpEndpointsInvariantViolationResponseBody(body *ListMcpEndpoin
 	return
 }
 
-// ValidateListMcpEndpointsUnexpectedResponseBody runs the validations defined
-// on listMcpEndpoints_unexpected_response_body
-func ValidateListMcpEndpointsUnexpectedResponseBody(body *ListMcpEndpointsUnexpectedResponseBody) (err error) {
+// ValidateGetMcpEndpointCanaryUnexpectedResponseBody runs the validations
+// defined on getMcpEndpointCanary_unexpected_response_body
+func ValidateGetMcpEndpointCanaryUnexpectedResponseBody(body *GetMcpEndpointCanaryUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3161,9 +6792,9 @@ func ValidateListMcpEndpointsUnexpectedResponseBody(body *ListMcpEndpointsUnexpe
 	return
 }
 
-// ValidateListMcpEndpointsGatewayErrorResponseBody runs the validations
-// defined on listMcpEndpoints_gateway_error_response_body
-func ValidateListMcpEndpointsGatewayErrorResponseBody(body *ListMcpEndpointsGatewayErrorResponseBody) (err error) {
+// ValidateGetMcpEndpointCanaryGatewayErrorResponseBody runs the validations
+// defined on getMcpEndpointCanary_gateway_error_response_body
+func ValidateGetMcpEndpointCanaryGatewayErrorResponseBody(body *GetMcpEndpointCanaryGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3185,9 +6816,9 @@ func ValidateListMcpEndpointsGatewayErrorResponseBody(body *ListMcpEndpointsGate
 	return
 }
 
-// ValidateUpdateMcpEndpointUnauthorizedResponseBody runs the validations
-// defined on updateMcpEndpoint_unauthorized_response_body
-func ValidateUpdateMcpEndpointUnauthorizedResponseBody(body *UpdateMcpEndpointUnauthorizedResponseBody) (err error) {
+// ValidateUpdateMcpEndpointCanaryUnauthorizedResponseBody runs the validations
+// defined on updateMcpEndpointCanary_unauthorized_response_body
+func ValidateUpdateMcpEndpointCanaryUnauthorizedResponseBody(body *UpdateMcpEndpointCanaryUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3209,9 +6840,9 @@ func ValidateUpdateMcpEndpointUnauthorizedResponseBody(body *UpdateMcpEndpointUn
 	return
 }
 
-// ValidateUpdateMcpEndpointForbiddenResponseBody runs the validations defined
-// on updateMcpEndpoint_forbidden_response_body
-func ValidateUpdateMcpEndpointForbiddenResponseBody(body *UpdateMcpEndpointForbiddenResponseBody) (err error) {
+// ValidateUpdateMcpEndpointCanaryForbiddenResponseBody runs the validations
+// defined on updateMcpEndpointCanary_forbidden_response_body
+func ValidateUpdateMcpEndpointCanaryForbiddenResponseBody(body *UpdateMcpEndpointCanaryForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3233,9 +6864,9 @@ func ValidateUpdateMcpEndpointForbiddenResponseBody(body *UpdateMcpEndpointForbi
 	return
 }
 
-// ValidateUpdateMcpEndpointBadRequestResponseBody runs the validations defined
-// on updateMcpEndpoint_bad_request_response_body
-func ValidateUpdateMcpEndpointBadRequestResponseBody(body *UpdateMcpEndpointBadRequestResponseBody) (err error) {
+// ValidateUpdateMcpEndpointCanaryBadRequestResponseBody runs the validations
+// defined on updateMcpEndpointCanary_bad_request_response_body
+func ValidateUpdateMcpEndpointCanaryBadRequestResponseBody(body *UpdateMcpEndpointCanaryBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3257,9 +6888,9 @@ func ValidateUpdateMcpEndpointBadRequestResponseBody(body *UpdateMcpEndpointBadR
 	return
 }
 
-// ValidateUpdateMcpEndpointNotFoundResponseBody runs the validations defined
-// on updateMcpEndpoint_not_found_response_body
-func ValidateUpdateMcpEndpointNotFoundResponseBody(body *UpdateMcpEndpointNotFoundResponseBody) (err error) {
+// ValidateUpdateMcpEndpointCanaryNotFoundResponseBody runs the validations
+// defined on updateMcpEndpointCanary_not_found_response_body
+func ValidateUpdateMcpEndpointCanaryNotFoundResponseBody(body *UpdateMcpEndpointCanaryNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3281,9 +6912,9 @@ func ValidateUpdateMcpEndpointNotFoundResponseBody(body *UpdateMcpEndpointNotFou
 	return
 }
 
-// ValidateUpdateMcpEndpointConflictResponseBody runs the validations defined
-// on updateMcpEndpoint_conflict_response_body
-func ValidateUpdateMcpEndpointConflictResponseBody(body *UpdateMcpEndpointConflictResponseBody) (err error) {
+// ValidateUpdateMcpEndpointCanaryConflictResponseBody runs the validations
+// defined on updateMcpEndpointCanary_conflict_response_body
+func ValidateUpdateMcpEndpointCanaryConflictResponseBody(body *UpdateMcpEndpointCanaryConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3305,9 +6936,10 @@ func ValidateUpdateMcpEndpointConflictResponseBody(body *UpdateMcpEndpointConfli
 	return
 }
 
-// ValidateUpdateMcpEndpointUnsupportedMediaResponseBody runs the validations
-// defined on updateMcpEndpoint_unsupported_media_response_body
-func ValidateUpdateMcpEndpointUnsupportedMediaResponseBody(body *UpdateMcpEndpointUnsupportedMediaResponseBody) (err error) {
+// ValidateUpdateMcpEndpointCanaryUnsupportedMediaResponseBody runs the
+// validations defined on
+// updateMcpEndpointCanary_unsupported_media_response_body
+func ValidateUpdateMcpEndpointCanaryUnsupportedMediaResponseBody(body *UpdateMcpEndpointCanaryUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3329,9 +6961,9 @@ func ValidateUpdateMcpEndpointUnsupportedMediaResponseBody(body *UpdateMcpEndpoi
 	return
 }
 
-// ValidateUpdateMcpEndpointInvalidResponseBody runs the validations defined on
-// updateMcpEndpoint_invalid_response_body
-func ValidateUpdateMcpEndpointInvalidResponseBody(body *UpdateMcpEndpointInvalidResponseBody) (err error) {
+// ValidateUpdateMcpEndpointCanaryInvalidResponseBody runs the validations
+// defined on updateMcpEndpointCanary_invalid_response_body
+func ValidateUpdateMcpEndpointCanaryInvalidResponseBody(body *UpdateMcpEndpointCanaryInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3353,9 +6985,10 @@ func ValidateUpdateMcpEndpointInvalidResponseBody(body *UpdateMcpEndpointInvalid
 	return
 }
 
-// ValidateUpdateMcpEndpointInvariantViolationResponseBody runs the validations
-// defined on updateMcpEndpoint_invariant_violation_response_body
-func ValidateUpdateMcpEndpointInvariantViolationResponseBody(body *UpdateMcpEndpointInvariantViolationResponseBody) (err error) {
+// ValidateUpdateMcpEndpointCanaryInvariantViolationResponseBody runs the
+// validations defined on
+// updateMcpEndpointCanary_invariant_violation_response_body
+func ValidateUpdateMcpEndpointCanaryInvariantViolationResponseBody(body *UpdateMcpEndpointCanaryInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3377,9 +7010,9 @@ func ValidateUpdateMcpEndpointInvariantViolationResponseBody(body *UpdateMcpEndp
 	return
 }
 
-// ValidateUpdateMcpEndpointUnexpectedResponseBody runs the validations defined
-// on updateMcpEndpoint_unexpected_response_body
-func ValidateUpdateMcpEndpointUnexpectedResponseBody(body *UpdateMcpEndpointUnexpectedResponseBody) (err error) {
+// ValidateUpdateMcpEndpointCanaryUnexpectedResponseBody runs the validations
+// defined on updateMcpEndpointCanary_unexpected_response_body
+func ValidateUpdateMcpEndpointCanaryUnexpectedResponseBody(body *UpdateMcpEndpointCanaryUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3401,9 +7034,9 @@ func ValidateUpdateMcpEndpointUnexpectedResponseBody(body *UpdateMcpEndpointUnex
 	return
 }
 
-// ValidateUpdateMcpEndpointGatewayErrorResponseBody runs the validations
-// defined on updateMcpEndpoint_gateway_error_response_body
-func ValidateUpdateMcpEndpointGatewayErrorResponseBody(body *UpdateMcpEndpointGatewayErrorResponseBody) (err error) {
+// ValidateUpdateMcpEndpointCanaryGatewayErrorResponseBody runs the validations
+// defined on updateMcpEndpointCanary_gateway_error_response_body
+func ValidateUpdateMcpEndpointCanaryGatewayErrorResponseBody(body *UpdateMcpEndpointCanaryGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3425,10 +7058,9 @@ func ValidateUpdateMcpEndpointGatewayErrorResponseBody(body *UpdateMcpEndpointGa
 	return
 }
 
-// ValidateCheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody runs the
-// validations defined on
-// checkMcpEndpointSlugAvailability_unauthorized_response_body
-func ValidateCheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody(body *CheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody) (err error) {
+// ValidatePromoteMcpEndpointCanaryUnauthorizedResponseBody runs the
+// validations defined on promoteMcpEndpointCanary_unauthorized_response_body
+func ValidatePromoteMcpEndpointCanaryUnauthorizedResponseBody(body *PromoteMcpEndpointCanaryUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3450,10 +7082,9 @@ func ValidateCheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody(body *Chec
 	return
 }
 
-// ValidateCheckMcpEndpointSlugAvailabilityForbiddenResponseBody runs the
-// validations defined on
-// checkMcpEndpointSlugAvailability_forbidden_response_body
-func ValidateCheckMcpEndpointSlugAvailabilityForbiddenResponseBody(body *CheckMcpEndpointSlugAvailabilityForbiddenResponseBody) (err error) {
+// ValidatePromoteMcpEndpointCanaryForbiddenResponseBody runs the validations
+// defined on promoteMcpEndpointCanary_forbidden_response_body
+func ValidatePromoteMcpEndpointCanaryForbiddenResponseBody(body *PromoteMcpEndpointCanaryForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3475,10 +7106,9 @@ func ValidateCheckMcpEndpointSlugAvailabilityForbiddenResponseBody(body *CheckMc
 	return
 }
 
-// ValidateCheckMcpEndpointSlugAvailabilityBadRequestResponseBody runs the
-// validations defined on
-// checkMcpEndpointSlugAvailability_bad_request_response_body
-func ValidateCheckMcpEndpointSlugAvailabilityBadRequestResponseBody(body *CheckMcpEndpointSlugAvailabilityBadRequestResponseBody) (err error) {
+// ValidatePromoteMcpEndpointCanaryBadRequestResponseBody runs the validations
+// defined on promoteMcpEndpointCanary_bad_request_response_body
+func ValidatePromoteMcpEndpointCanaryBadRequestResponseBody(body *PromoteMcpEndpointCanaryBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3500,10 +7130,9 @@ func ValidateCheckMcpEndpointSlugAvailabilityBadRequestResponseBody(body *CheckM
 	return
 }
 
-// ValidateCheckMcpEndpointSlugAvailabilityNotFoundResponseBody runs the
-// validations defined on
-// checkMcpEndpointSlugAvailability_not_found_response_body
-func ValidateCheckMcpEndpointSlugAvailabilityNotFoundResponseBody(body *CheckMcpEndpointSlugAvailabilityNotFoundResponseBody) (err error) {
+// ValidatePromoteMcpEndpointCanaryNotFoundResponseBody runs the validations
+// defined on promoteMcpEndpointCanary_not_found_response_body
+func ValidatePromoteMcpEndpointCanaryNotFoundResponseBody(body *PromoteMcpEndpointCanaryNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3525,10 +7154,9 @@ func ValidateCheckMcpEndpointSlugAvailabilityNotFoundResponseBody(body *CheckMcp
 	return
 }
 
-// ValidateCheckMcpEndpointSlugAvailabilityConflictResponseBody runs the
-// validations defined on
-// checkMcpEndpointSlugAvailability_conflict_response_body
-func ValidateCheckMcpEndpointSlugAvailabilityConflictResponseBody(body *CheckMcpEndpointSlugAvailabilityConflictResponseBody) (err error) {
+// ValidatePromoteMcpEndpointCanaryConflictResponseBody runs the validations
+// defined on promoteMcpEndpointCanary_conflict_response_body
+func ValidatePromoteMcpEndpointCanaryConflictResponseBody(body *PromoteMcpEndpointCanaryConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3550,10 +7178,10 @@ func ValidateCheckMcpEndpointSlugAvailabilityConflictResponseBody(body *CheckMcp
 	return
 }
 
-// ValidateCheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody runs
-// the validations defined on
-// checkMcpEndpointSlugAvailability_unsupported_media_response_body
-func ValidateCheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody(body *CheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody) (err error) {
+// ValidatePromoteMcpEndpointCanaryUnsupportedMediaResponseBody runs the
+// validations defined on
+// promoteMcpEndpointCanary_unsupported_media_response_body
+func ValidatePromoteMcpEndpointCanaryUnsupportedMediaResponseBody(body *PromoteMcpEndpointCanaryUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3575,9 +7203,9 @@ func ValidateCheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody(body *
 	return
 }
 
-// ValidateCheckMcpEndpointSlugAvailabilityInvalidResponseBody runs the
-// validations defined on checkMcpEndpointSlugAvailability_invalid_response_body
-func ValidateCheckMcpEndpointSlugAvailabilityInvalidResponseBody(body *CheckMcpEndpointSlugAvailabilityInvalidResponseBody) (err error) {
+// ValidatePromoteMcpEndpointCanaryInvalidResponseBody runs the validations
+// defined on promoteMcpEndpointCanary_invalid_response_body
+func ValidatePromoteMcpEndpointCanaryInvalidResponseBody(body *PromoteMcpEndpointCanaryInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3599,10 +7227,10 @@ func ValidateCheckMcpEndpointSlugAvailabilityInvalidResponseBody(body *CheckMcpE
 	return
 }
 
-// ValidateCheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody runs
-// the validations defined on
-// checkMcpEndpointSlugAvailability_invariant_violation_response_body
-func ValidateCheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody(body *CheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody) (err error) {
+// ValidatePromoteMcpEndpointCanaryInvariantViolationResponseBody runs the
+// validations defined on
+// promoteMcpEndpointCanary_invariant_violation_response_body
+func ValidatePromoteMcpEndpointCanaryInvariantViolationResponseBody(body *PromoteMcpEndpointCanaryInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3624,10 +7252,9 @@ func ValidateCheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody(body
 	return
 }
 
-// ValidateCheckMcpEndpointSlugAvailabilityUnexpectedResponseBody runs the
-// validations defined on
-// checkMcpEndpointSlugAvailability_unexpected_response_body
-func ValidateCheckMcpEndpointSlugAvailabilityUnexpectedResponseBody(body *CheckMcpEndpointSlugAvailabilityUnexpectedResponseBody) (err error) {
+// ValidatePromoteMcpEndpointCanaryUnexpectedResponseBody runs the validations
+// defined on promoteMcpEndpointCanary_unexpected_response_body
+func ValidatePromoteMcpEndpointCanaryUnexpectedResponseBody(body *PromoteMcpEndpointCanaryUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3649,10 +7276,9 @@ func ValidateCheckMcpEndpointSlugAvailabilityUnexpectedResponseBody(body *CheckM
 	return
 }
 
-// ValidateCheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody runs the
-// validations defined on
-// checkMcpEndpointSlugAvailability_gateway_error_response_body
-func ValidateCheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody(body *CheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody) (err error) {
+// ValidatePromoteMcpEndpointCanaryGatewayErrorResponseBody runs the
+// validations defined on promoteMcpEndpointCanary_gateway_error_response_body
+func ValidatePromoteMcpEndpointCanaryGatewayErrorResponseBody(body *PromoteMcpEndpointCanaryGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3674,9 +7300,9 @@ func ValidateCheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody(body *Chec
 	return
 }
 
-// ValidateDeleteMcpEndpointUnauthorizedResponseBody runs the validations
-// defined on deleteMcpEndpoint_unauthorized_response_body
-func ValidateDeleteMcpEndpointUnauthorizedResponseBody(body *DeleteMcpEndpointUnauthorizedResponseBody) (err error) {
+// ValidateAbortMcpEndpointCanaryUnauthorizedResponseBody runs the validations
+// defined on abortMcpEndpointCanary_unauthorized_response_body
+func ValidateAbortMcpEndpointCanaryUnauthorizedResponseBody(body *AbortMcpEndpointCanaryUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3698,9 +7324,9 @@ func ValidateDeleteMcpEndpointUnauthorizedResponseBody(body *DeleteMcpEndpointUn
 	return
 }
 
-// ValidateDeleteMcpEndpointForbiddenResponseBody runs the validations defined
-// on deleteMcpEndpoint_forbidden_response_body
-func ValidateDeleteMcpEndpointForbiddenResponseBody(body *DeleteMcpEndpointForbiddenResponseBody) (err error) {
+// ValidateAbortMcpEndpointCanaryForbiddenResponseBody runs the validations
+// defined on abortMcpEndpointCanary_forbidden_response_body
+func ValidateAbortMcpEndpointCanaryForbiddenResponseBody(body *AbortMcpEndpointCanaryForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3722,9 +7348,9 @@ func ValidateDeleteMcpEndpointForbiddenResponseBody(body *DeleteMcpEndpointForbi
 	return
 }
 
-// ValidateDeleteMcpEndpointBadRequestResponseBody runs the validations defined
-// on deleteMcpEndpoint_bad_request_response_body
-func ValidateDeleteMcpEndpointBadRequestResponseBody(body *DeleteMcpEndpointBadRequestResponseBody) (err error) {
+// ValidateAbortMcpEndpointCanaryBadRequestResponseBody runs the validations
+// defined on abortMcpEndpointCanary_bad_request_response_body
+func ValidateAbortMcpEndpointCanaryBadRequestResponseBody(body *AbortMcpEndpointCanaryBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3746,9 +7372,9 @@ func ValidateDeleteMcpEndpointBadRequestResponseBody(body *DeleteMcpEndpointBadR
 	return
 }
 
-// ValidateDeleteMcpEndpointNotFoundResponseBody runs the validations defined
-// on deleteMcpEndpoint_not_found_response_body
-func ValidateDeleteMcpEndpointNotFoundResponseBody(body *DeleteMcpEndpointNotFoundResponseBody) (err error) {
+// ValidateAbortMcpEndpointCanaryNotFoundResponseBody runs the validations
+// defined on abortMcpEndpointCanary_not_found_response_body
+func ValidateAbortMcpEndpointCanaryNotFoundResponseBody(body *AbortMcpEndpointCanaryNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3770,9 +7396,9 @@ func ValidateDeleteMcpEndpointNotFoundResponseBody(body *DeleteMcpEndpointNotFou
 	return
 }
 
-// ValidateDeleteMcpEndpointConflictResponseBody runs the validations defined
-// on deleteMcpEndpoint_conflict_response_body
-func ValidateDeleteMcpEndpointConflictResponseBody(body *DeleteMcpEndpointConflictResponseBody) (err error) {
+// ValidateAbortMcpEndpointCanaryConflictResponseBody runs the validations
+// defined on abortMcpEndpointCanary_conflict_response_body
+func ValidateAbortMcpEndpointCanaryConflictResponseBody(body *AbortMcpEndpointCanaryConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3794,9 +7420,9 @@ func ValidateDeleteMcpEndpointConflictResponseBody(body *DeleteMcpEndpointConfli
 	return
 }
 
-// ValidateDeleteMcpEndpointUnsupportedMediaResponseBody runs the validations
-// defined on deleteMcpEndpoint_unsupported_media_response_body
-func ValidateDeleteMcpEndpointUnsupportedMediaResponseBody(body *DeleteMcpEndpointUnsupportedMediaResponseBody) (err error) {
+// ValidateAbortMcpEndpointCanaryUnsupportedMediaResponseBody runs the
+// validations defined on abortMcpEndpointCanary_unsupported_media_response_body
+func ValidateAbortMcpEndpointCanaryUnsupportedMediaResponseBody(body *AbortMcpEndpointCanaryUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3818,9 +7444,9 @@ func ValidateDeleteMcpEndpointUnsupportedMediaResponseBody(body *DeleteMcpEndpoi
 	return
 }
 
-// ValidateDeleteMcpEndpointInvalidResponseBody runs the validations defined on
-// deleteMcpEndpoint_invalid_response_body
-func ValidateDeleteMcpEndpointInvalidResponseBody(body *DeleteMcpEndpointInvalidResponseBody) (err error) {
+// ValidateAbortMcpEndpointCanaryInvalidResponseBody runs the validations
+// defined on abortMcpEndpointCanary_invalid_response_body
+func ValidateAbortMcpEndpointCanaryInvalidResponseBody(body *AbortMcpEndpointCanaryInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3842,9 +7468,10 @@ func ValidateDeleteMcpEndpointInvalidResponseBody(body *DeleteMcpEndpointInvalid
 	return
 }
 
-// ValidateDeleteMcpEndpointInvariantViolationResponseBody runs the validations
-// defined on deleteMcpEndpoint_invariant_violation_response_body
-func ValidateDeleteMcpEndpointInvariantViolationResponseBody(body *DeleteMcpEndpointInvariantViolationResponseBody) (err error) {
+// ValidateAbortMcpEndpointCanaryInvariantViolationResponseBody runs the
+// validations defined on
+// abortMcpEndpointCanary_invariant_violation_response_body
+func ValidateAbortMcpEndpointCanaryInvariantViolationResponseBody(body *AbortMcpEndpointCanaryInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3866,9 +7493,9 @@ func ValidateDeleteMcpEndpointInvariantViolationResponseBody(body *DeleteMcpEndp
 	return
 }
 
-// ValidateDeleteMcpEndpointUnexpectedResponseBody runs the validations defined
-// on deleteMcpEndpoint_unexpected_response_body
-func ValidateDeleteMcpEndpointUnexpectedResponseBody(body *DeleteMcpEndpointUnexpectedResponseBody) (err error) {
+// ValidateAbortMcpEndpointCanaryUnexpectedResponseBody runs the validations
+// defined on abortMcpEndpointCanary_unexpected_response_body
+func ValidateAbortMcpEndpointCanaryUnexpectedResponseBody(body *AbortMcpEndpointCanaryUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3890,9 +7517,9 @@ func ValidateDeleteMcpEndpointUnexpectedResponseBody(body *DeleteMcpEndpointUnex
 	return
 }
 
-// ValidateDeleteMcpEndpointGatewayErrorResponseBody runs the validations
-// defined on deleteMcpEndpoint_gateway_error_response_body
-func ValidateDeleteMcpEndpointGatewayErrorResponseBody(body *DeleteMcpEndpointGatewayErrorResponseBody) (err error) {
+// ValidateAbortMcpEndpointCanaryGatewayErrorResponseBody runs the validations
+// defined on abortMcpEndpointCanary_gateway_error_response_body
+func ValidateAbortMcpEndpointCanaryGatewayErrorResponseBody(body *AbortMcpEndpointCanaryGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -3966,3 +7593,30 @@ func ValidateMcpEndpointResponseBody(body *McpEndpointResponseBody) (err error)
 	}
 	return
 }
+
+// ValidateMcpEndpointCanaryArmResponseBody runs the validations defined on
+// McpEndpointCanaryArmResponseBody
+func ValidateMcpEndpointCanaryArmResponseBody(body *McpEndpointCanaryArmResponseBody) (err error) {
+	if body.DeploymentID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deployment_id", "body"))
+	}
+	if body.ToolCalls == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tool_calls", "body"))
+	}
+	if body.FailedToolCalls == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed_tool_calls", "body"))
+	}
+	if body.ErrorRate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error_rate", "body"))
+	}
+	if body.LatencyP50Ms == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("latency_p50_ms", "body"))
+	}
+	if body.LatencyP95Ms == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("latency_p95_ms", "body"))
+	}
+	if body.DeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deployment_id", *body.DeploymentID, goa.FormatUUID))
+	}
+	return
+}
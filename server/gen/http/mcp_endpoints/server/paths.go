@@ -36,3 +36,28 @@ func CheckMcpEndpointSlugAvailabilityMcpEndpointsPath() string {
 func DeleteMcpEndpointMcpEndpointsPath() string {
 	return "/rpc/mcpEndpoints.delete"
 }
+
+// StartMcpEndpointCanaryMcpEndpointsPath returns the URL path to the mcpEndpoints service startMcpEndpointCanary HTTP endpoint.
+func StartMcpEndpointCanaryMcpEndpointsPath() string {
+	return "/rpc/mcpEndpoints.startCanary"
+}
+
+// GetMcpEndpointCanaryMcpEndpointsPath returns the URL path to the mcpEndpoints service getMcpEndpointCanary HTTP endpoint.
+func GetMcpEndpointCanaryMcpEndpointsPath() string {
+	return "/rpc/mcpEndpoints.getCanary"
+}
+
+// UpdateMcpEndpointCanaryMcpEndpointsPath returns the URL path to the mcpEndpoints service updateMcpEndpointCanary HTTP endpoint.
+func UpdateMcpEndpointCanaryMcpEndpointsPath() string {
+	return "/rpc/mcpEndpoints.updateCanary"
+}
+
+// PromoteMcpEndpointCanaryMcpEndpointsPath returns the URL path to the mcpEndpoints service promoteMcpEndpointCanary HTTP endpoint.
+func PromoteMcpEndpointCanaryMcpEndpointsPath() string {
+	return "/rpc/mcpEndpoints.promoteCanary"
+}
+
+// AbortMcpEndpointCanaryMcpEndpointsPath returns the URL path to the mcpEndpoints service abortMcpEndpointCanary HTTP endpoint.
+func AbortMcpEndpointCanaryMcpEndpointsPath() string {
+	return "/rpc/mcpEndpoints.abortCanary"
+}
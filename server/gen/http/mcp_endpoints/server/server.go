@@ -25,6 +25,11 @@ type Server struct {
 	UpdateMcpEndpoint                http.Handler
 	CheckMcpEndpointSlugAvailability http.Handler
 	DeleteMcpEndpoint                http.Handler
+	StartMcpEndpointCanary           http.Handler
+	GetMcpEndpointCanary             http.Handler
+	UpdateMcpEndpointCanary          http.Handler
+	PromoteMcpEndpointCanary         http.Handler
+	AbortMcpEndpointCanary           http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -60,6 +65,11 @@ func New(
 			{"UpdateMcpEndpoint", "POST", "/rpc/mcpEndpoints.update"},
 			{"CheckMcpEndpointSlugAvailability", "GET", "/rpc/mcpEndpoints.checkSlugAvailability"},
 			{"DeleteMcpEndpoint", "DELETE", "/rpc/mcpEndpoints.delete"},
+			{"StartMcpEndpointCanary", "POST", "/rpc/mcpEndpoints.startCanary"},
+			{"GetMcpEndpointCanary", "GET", "/rpc/mcpEndpoints.getCanary"},
+			{"UpdateMcpEndpointCanary", "POST", "/rpc/mcpEndpoints.updateCanary"},
+			{"PromoteMcpEndpointCanary", "POST", "/rpc/mcpEndpoints.promoteCanary"},
+			{"AbortMcpEndpointCanary", "POST", "/rpc/mcpEndpoints.abortCanary"},
 		},
 		CreateMcpEndpoint:                NewCreateMcpEndpointHandler(e.CreateMcpEndpoint, mux, decoder, encoder, errhandler, formatter),
 		GetMcpEndpoint:                   NewGetMcpEndpointHandler(e.GetMcpEndpoint, mux, decoder, encoder, errhandler, formatter),
@@ -67,6 +77,11 @@ func New(
 		UpdateMcpEndpoint:                NewUpdateMcpEndpointHandler(e.UpdateMcpEndpoint, mux, decoder, encoder, errhandler, formatter),
 		CheckMcpEndpointSlugAvailability: NewCheckMcpEndpointSlugAvailabilityHandler(e.CheckMcpEndpointSlugAvailability, mux, decoder, encoder, errhandler, formatter),
 		DeleteMcpEndpoint:                NewDeleteMcpEndpointHandler(e.DeleteMcpEndpoint, mux, decoder, encoder, errhandler, formatter),
+		StartMcpEndpointCanary:           NewStartMcpEndpointCanaryHandler(e.StartMcpEndpointCanary, mux, decoder, encoder, errhandler, formatter),
+		GetMcpEndpointCanary:             NewGetMcpEndpointCanaryHandler(e.GetMcpEndpointCanary, mux, decoder, encoder, errhandler, formatter),
+		UpdateMcpEndpointCanary:          NewUpdateMcpEndpointCanaryHandler(e.UpdateMcpEndpointCanary, mux, decoder, encoder, errhandler, formatter),
+		PromoteMcpEndpointCanary:         NewPromoteMcpEndpointCanaryHandler(e.PromoteMcpEndpointCanary, mux, decoder, encoder, errhandler, formatter),
+		AbortMcpEndpointCanary:           NewAbortMcpEndpointCanaryHandler(e.AbortMcpEndpointCanary, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -81,6 +96,11 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.UpdateMcpEndpoint = m(s.UpdateMcpEndpoint)
 	s.CheckMcpEndpointSlugAvailability = m(s.CheckMcpEndpointSlugAvailability)
 	s.DeleteMcpEndpoint = m(s.DeleteMcpEndpoint)
+	s.StartMcpEndpointCanary = m(s.StartMcpEndpointCanary)
+	s.GetMcpEndpointCanary = m(s.GetMcpEndpointCanary)
+	s.UpdateMcpEndpointCanary = m(s.UpdateMcpEndpointCanary)
+	s.PromoteMcpEndpointCanary = m(s.PromoteMcpEndpointCanary)
+	s.AbortMcpEndpointCanary = m(s.AbortMcpEndpointCanary)
 }
 
 // MethodNames returns the methods served.
@@ -94,6 +114,11 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountUpdateMcpEndpointHandler(mux, h.UpdateMcpEndpoint)
 	MountCheckMcpEndpointSlugAvailabilityHandler(mux, h.CheckMcpEndpointSlugAvailability)
 	MountDeleteMcpEndpointHandler(mux, h.DeleteMcpEndpoint)
+	MountStartMcpEndpointCanaryHandler(mux, h.StartMcpEndpointCanary)
+	MountGetMcpEndpointCanaryHandler(mux, h.GetMcpEndpointCanary)
+	MountUpdateMcpEndpointCanaryHandler(mux, h.UpdateMcpEndpointCanary)
+	MountPromoteMcpEndpointCanaryHandler(mux, h.PromoteMcpEndpointCanary)
+	MountAbortMcpEndpointCanaryHandler(mux, h.AbortMcpEndpointCanary)
 }
 
 // Mount configures the mux to serve the mcpEndpoints endpoints.
@@ -419,3 +444,272 @@ func NewDeleteMcpEndpointHandler(
 		}
 	})
 }
+
+// MountStartMcpEndpointCanaryHandler configures the mux to serve the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint.
+func MountStartMcpEndpointCanaryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/mcpEndpoints.startCanary", f)
+}
+
+// NewStartMcpEndpointCanaryHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mcpEndpoints" service "startMcpEndpointCanary"
+// endpoint.
+func NewStartMcpEndpointCanaryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeStartMcpEndpointCanaryRequest(mux, decoder)
+		encodeResponse = EncodeStartMcpEndpointCanaryResponse(encoder)
+		encodeError    = EncodeStartMcpEndpointCanaryError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "startMcpEndpointCanary")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mcpEndpoints")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountGetMcpEndpointCanaryHandler configures the mux to serve the
+// "mcpEndpoints" service "getMcpEndpointCanary" endpoint.
+func MountGetMcpEndpointCanaryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/rpc/mcpEndpoints.getCanary", f)
+}
+
+// NewGetMcpEndpointCanaryHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mcpEndpoints" service "getMcpEndpointCanary" endpoint.
+func NewGetMcpEndpointCanaryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetMcpEndpointCanaryRequest(mux, decoder)
+		encodeResponse = EncodeGetMcpEndpointCanaryResponse(encoder)
+		encodeError    = EncodeGetMcpEndpointCanaryError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "getMcpEndpointCanary")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mcpEndpoints")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateMcpEndpointCanaryHandler configures the mux to serve the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint.
+func MountUpdateMcpEndpointCanaryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/mcpEndpoints.updateCanary", f)
+}
+
+// NewUpdateMcpEndpointCanaryHandler creates a HTTP handler which loads the
+// HTTP request and calls the "mcpEndpoints" service "updateMcpEndpointCanary"
+// endpoint.
+func NewUpdateMcpEndpointCanaryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateMcpEndpointCanaryRequest(mux, decoder)
+		encodeResponse = EncodeUpdateMcpEndpointCanaryResponse(encoder)
+		encodeError    = EncodeUpdateMcpEndpointCanaryError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "updateMcpEndpointCanary")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mcpEndpoints")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPromoteMcpEndpointCanaryHandler configures the mux to serve the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint.
+func MountPromoteMcpEndpointCanaryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/mcpEndpoints.promoteCanary", f)
+}
+
+// NewPromoteMcpEndpointCanaryHandler creates a HTTP handler which loads the
+// HTTP request and calls the "mcpEndpoints" service "promoteMcpEndpointCanary"
+// endpoint.
+func NewPromoteMcpEndpointCanaryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePromoteMcpEndpointCanaryRequest(mux, decoder)
+		encodeResponse = EncodePromoteMcpEndpointCanaryResponse(encoder)
+		encodeError    = EncodePromoteMcpEndpointCanaryError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "promoteMcpEndpointCanary")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mcpEndpoints")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountAbortMcpEndpointCanaryHandler configures the mux to serve the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint.
+func MountAbortMcpEndpointCanaryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/mcpEndpoints.abortCanary", f)
+}
+
+// NewAbortMcpEndpointCanaryHandler creates a HTTP handler which loads the HTTP
+// request and calls the "mcpEndpoints" service "abortMcpEndpointCanary"
+// endpoint.
+func NewAbortMcpEndpointCanaryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAbortMcpEndpointCanaryRequest(mux, decoder)
+		encodeResponse = EncodeAbortMcpEndpointCanaryResponse(encoder)
+		encodeError    = EncodeAbortMcpEndpointCanaryError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "abortMcpEndpointCanary")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "mcpEndpoints")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
@@ -27,6 +27,10 @@ type CreateMcpEndpointRequestBody struct {
 	// The ID of the meta MCP server this endpoint addresses. Mutually exclusive
 	// with mcp_server_id.
 	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// Pin the endpoint to this version of its MCP server's toolset, overriding any
+	// version pinned on the server. Only valid for endpoints of toolset-backed MCP
+	// servers. Omit to follow the server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The slug
 	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
 }
@@ -45,10 +49,56 @@ type UpdateMcpEndpointRequestBody struct {
 	// The ID of the meta MCP server this endpoint addresses. Mutually exclusive
 	// with mcp_server_id.
 	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// Pin the endpoint to this version of its MCP server's toolset, overriding any
+	// version pinned on the server. Only valid for endpoints of toolset-backed MCP
+	// servers. Omit to follow the server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The slug
 	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
 }
 
+// StartMcpEndpointCanaryRequestBody is the type of the "mcpEndpoints" service
+// "startMcpEndpointCanary" endpoint HTTP request body.
+type StartMcpEndpointCanaryRequestBody struct {
+	// The ID of the MCP endpoint
+	McpEndpointID *string `form:"mcp_endpoint_id,omitempty" json:"mcp_endpoint_id,omitempty" xml:"mcp_endpoint_id,omitempty"`
+	// The deployment serving the rest of the traffic. Defaults to the active
+	// deployment.
+	BaselineDeploymentID *string `form:"baseline_deployment_id,omitempty" json:"baseline_deployment_id,omitempty" xml:"baseline_deployment_id,omitempty"`
+	// The deployment under test. Omit to arm the canary before pushing: the
+	// candidate then follows the project's active deployment, so the next
+	// deployment only reaches the configured share of callers.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage *int32 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+	// Which request attribute keeps a caller on the same deployment. Requests
+	// without a user fall back to their Mcp-Session-Id.
+	StickyBy *string `form:"sticky_by,omitempty" json:"sticky_by,omitempty" xml:"sticky_by,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryRequestBody is the type of the "mcpEndpoints" service
+// "updateMcpEndpointCanary" endpoint HTTP request body.
+type UpdateMcpEndpointCanaryRequestBody struct {
+	// The ID of the MCP endpoint
+	McpEndpointID *string `form:"mcp_endpoint_id,omitempty" json:"mcp_endpoint_id,omitempty" xml:"mcp_endpoint_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage *int32 `form:"percentage,omitempty" json:"percentage,omitempty" xml:"percentage,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryRequestBody is the type of the "mcpEndpoints"
+// service "promoteMcpEndpointCanary" endpoint HTTP request body.
+type PromoteMcpEndpointCanaryRequestBody struct {
+	// The ID of the MCP endpoint
+	McpEndpointID *string `form:"mcp_endpoint_id,omitempty" json:"mcp_endpoint_id,omitempty" xml:"mcp_endpoint_id,omitempty"`
+}
+
+// AbortMcpEndpointCanaryRequestBody is the type of the "mcpEndpoints" service
+// "abortMcpEndpointCanary" endpoint HTTP request body.
+type AbortMcpEndpointCanaryRequestBody struct {
+	// The ID of the MCP endpoint
+	McpEndpointID *string `form:"mcp_endpoint_id,omitempty" json:"mcp_endpoint_id,omitempty" xml:"mcp_endpoint_id,omitempty"`
+}
+
 // CreateMcpEndpointResponseBody is the type of the "mcpEndpoints" service
 // "createMcpEndpoint" endpoint HTTP response body.
 type CreateMcpEndpointResponseBody struct {
@@ -65,6 +115,9 @@ type CreateMcpEndpointResponseBody struct {
 	// The ID of the meta MCP server this endpoint addresses. Null for
 	// MCP-server-backed endpoints.
 	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// The toolset version this endpoint is pinned to, if any. Overrides the
+	// version pinned on its MCP server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The slug
 	Slug string `form:"slug" json:"slug" xml:"slug"`
 	// Whether this endpoint is mapped to its custom-domain root
@@ -91,6 +144,9 @@ type GetMcpEndpointResponseBody struct {
 	// The ID of the meta MCP server this endpoint addresses. Null for
 	// MCP-server-backed endpoints.
 	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// The toolset version this endpoint is pinned to, if any. Overrides the
+	// version pinned on its MCP server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The slug
 	Slug string `form:"slug" json:"slug" xml:"slug"`
 	// Whether this endpoint is mapped to its custom-domain root
@@ -123,6 +179,9 @@ type UpdateMcpEndpointResponseBody struct {
 	// The ID of the meta MCP server this endpoint addresses. Null for
 	// MCP-server-backed endpoints.
 	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// The toolset version this endpoint is pinned to, if any. Overrides the
+	// version pinned on its MCP server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The slug
 	Slug string `form:"slug" json:"slug" xml:"slug"`
 	// Whether this endpoint is mapped to its custom-domain root
@@ -133,6 +192,146 @@ type UpdateMcpEndpointResponseBody struct {
 	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
+// StartMcpEndpointCanaryResponseBody is the type of the "mcpEndpoints" service
+// "startMcpEndpointCanary" endpoint HTTP response body.
+type StartMcpEndpointCanaryResponseBody struct {
+	// The ID of the canary
+	ID string `form:"id" json:"id" xml:"id"`
+	// The ID of the MCP endpoint
+	McpEndpointID string `form:"mcp_endpoint_id" json:"mcp_endpoint_id" xml:"mcp_endpoint_id"`
+	// The deployment serving the rest of the traffic
+	BaselineDeploymentID string `form:"baseline_deployment_id" json:"baseline_deployment_id" xml:"baseline_deployment_id"`
+	// The deployment under test. Null while a running canary follows the active
+	// deployment.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage int32 `form:"percentage" json:"percentage" xml:"percentage"`
+	// Which request attribute keeps a caller on the same deployment
+	StickyBy string `form:"sticky_by" json:"sticky_by" xml:"sticky_by"`
+	// The canary's lifecycle state
+	Status string `form:"status" json:"status" xml:"status"`
+	// When the canary started
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// When the canary was promoted or aborted
+	EndedAt *string `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.
+	Baseline *McpEndpointCanaryArmResponseBody `form:"baseline,omitempty" json:"baseline,omitempty" xml:"baseline,omitempty"`
+	// Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.
+	Candidate *McpEndpointCanaryArmResponseBody `form:"candidate,omitempty" json:"candidate,omitempty" xml:"candidate,omitempty"`
+}
+
+// GetMcpEndpointCanaryResponseBody is the type of the "mcpEndpoints" service
+// "getMcpEndpointCanary" endpoint HTTP response body.
+type GetMcpEndpointCanaryResponseBody struct {
+	// The ID of the canary
+	ID string `form:"id" json:"id" xml:"id"`
+	// The ID of the MCP endpoint
+	McpEndpointID string `form:"mcp_endpoint_id" json:"mcp_endpoint_id" xml:"mcp_endpoint_id"`
+	// The deployment serving the rest of the traffic
+	BaselineDeploymentID string `form:"baseline_deployment_id" json:"baseline_deployment_id" xml:"baseline_deployment_id"`
+	// The deployment under test. Null while a running canary follows the active
+	// deployment.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage int32 `form:"percentage" json:"percentage" xml:"percentage"`
+	// Which request attribute keeps a caller on the same deployment
+	StickyBy string `form:"sticky_by" json:"sticky_by" xml:"sticky_by"`
+	// The canary's lifecycle state
+	Status string `form:"status" json:"status" xml:"status"`
+	// When the canary started
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// When the canary was promoted or aborted
+	EndedAt *string `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.
+	Baseline *McpEndpointCanaryArmResponseBody `form:"baseline,omitempty" json:"baseline,omitempty" xml:"baseline,omitempty"`
+	// Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.
+	Candidate *McpEndpointCanaryArmResponseBody `form:"candidate,omitempty" json:"candidate,omitempty" xml:"candidate,omitempty"`
+}
+
+// UpdateMcpEndpointCanaryResponseBody is the type of the "mcpEndpoints"
+// service "updateMcpEndpointCanary" endpoint HTTP response body.
+type UpdateMcpEndpointCanaryResponseBody struct {
+	// The ID of the canary
+	ID string `form:"id" json:"id" xml:"id"`
+	// The ID of the MCP endpoint
+	McpEndpointID string `form:"mcp_endpoint_id" json:"mcp_endpoint_id" xml:"mcp_endpoint_id"`
+	// The deployment serving the rest of the traffic
+	BaselineDeploymentID string `form:"baseline_deployment_id" json:"baseline_deployment_id" xml:"baseline_deployment_id"`
+	// The deployment under test. Null while a running canary follows the active
+	// deployment.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage int32 `form:"percentage" json:"percentage" xml:"percentage"`
+	// Which request attribute keeps a caller on the same deployment
+	StickyBy string `form:"sticky_by" json:"sticky_by" xml:"sticky_by"`
+	// The canary's lifecycle state
+	Status string `form:"status" json:"status" xml:"status"`
+	// When the canary started
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// When the canary was promoted or aborted
+	EndedAt *string `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.
+	Baseline *McpEndpointCanaryArmResponseBody `form:"baseline,omitempty" json:"baseline,omitempty" xml:"baseline,omitempty"`
+	// Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.
+	Candidate *McpEndpointCanaryArmResponseBody `form:"candidate,omitempty" json:"candidate,omitempty" xml:"candidate,omitempty"`
+}
+
+// PromoteMcpEndpointCanaryResponseBody is the type of the "mcpEndpoints"
+// service "promoteMcpEndpointCanary" endpoint HTTP response body.
+type PromoteMcpEndpointCanaryResponseBody struct {
+	// The ID of the canary
+	ID string `form:"id" json:"id" xml:"id"`
+	// The ID of the MCP endpoint
+	McpEndpointID string `form:"mcp_endpoint_id" json:"mcp_endpoint_id" xml:"mcp_endpoint_id"`
+	// The deployment serving the rest of the traffic
+	BaselineDeploymentID string `form:"baseline_deployment_id" json:"baseline_deployment_id" xml:"baseline_deployment_id"`
+	// The deployment under test. Null while a running canary follows the active
+	// deployment.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage int32 `form:"percentage" json:"percentage" xml:"percentage"`
+	// Which request attribute keeps a caller on the same deployment
+	StickyBy string `form:"sticky_by" json:"sticky_by" xml:"sticky_by"`
+	// The canary's lifecycle state
+	Status string `form:"status" json:"status" xml:"status"`
+	// When the canary started
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// When the canary was promoted or aborted
+	EndedAt *string `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.
+	Baseline *McpEndpointCanaryArmResponseBody `form:"baseline,omitempty" json:"baseline,omitempty" xml:"baseline,omitempty"`
+	// Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.
+	Candidate *McpEndpointCanaryArmResponseBody `form:"candidate,omitempty" json:"candidate,omitempty" xml:"candidate,omitempty"`
+}
+
+// AbortMcpEndpointCanaryResponseBody is the type of the "mcpEndpoints" service
+// "abortMcpEndpointCanary" endpoint HTTP response body.
+type AbortMcpEndpointCanaryResponseBody struct {
+	// The ID of the canary
+	ID string `form:"id" json:"id" xml:"id"`
+	// The ID of the MCP endpoint
+	McpEndpointID string `form:"mcp_endpoint_id" json:"mcp_endpoint_id" xml:"mcp_endpoint_id"`
+	// The deployment serving the rest of the traffic
+	BaselineDeploymentID string `form:"baseline_deployment_id" json:"baseline_deployment_id" xml:"baseline_deployment_id"`
+	// The deployment under test. Null while a running canary follows the active
+	// deployment.
+	CandidateDeploymentID *string `form:"candidate_deployment_id,omitempty" json:"candidate_deployment_id,omitempty" xml:"candidate_deployment_id,omitempty"`
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage int32 `form:"percentage" json:"percentage" xml:"percentage"`
+	// Which request attribute keeps a caller on the same deployment
+	StickyBy string `form:"sticky_by" json:"sticky_by" xml:"sticky_by"`
+	// The canary's lifecycle state
+	Status string `form:"status" json:"status" xml:"status"`
+	// When the canary started
+	StartedAt string `form:"started_at" json:"started_at" xml:"started_at"`
+	// When the canary was promoted or aborted
+	EndedAt *string `form:"ended_at,omitempty" json:"ended_at,omitempty" xml:"ended_at,omitempty"`
+	// Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.
+	Baseline *McpEndpointCanaryArmResponseBody `form:"baseline,omitempty" json:"baseline,omitempty" xml:"baseline,omitempty"`
+	// Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.
+	Candidate *McpEndpointCanaryArmResponseBody `form:"candidate,omitempty" json:"candidate,omitempty" xml:"candidate,omitempty"`
+}
+
 // CreateMcpEndpointUnauthorizedResponseBody is the type of the "mcpEndpoints"
 // service "createMcpEndpoint" endpoint HTTP response body for the
 // "unauthorized" error.
@@ -1270,163 +1469,1867 @@ type DeleteMcpEndpointGatewayErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// McpEndpointResponseBody is used to define fields on response body types.
-type McpEndpointResponseBody struct {
-	// The ID of the MCP endpoint
+// StartMcpEndpointCanaryUnauthorizedResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "unauthorized" error.
+type StartMcpEndpointCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
 	ID string `form:"id" json:"id" xml:"id"`
-	// The project ID this MCP endpoint belongs to
-	ProjectID string `form:"project_id" json:"project_id" xml:"project_id"`
-	// The ID of the custom domain this endpoint slug is registered under. Null for
-	// platform-domain endpoints.
-	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
-	// The ID of the MCP server this endpoint addresses. Null for meta-MCP-backed
-	// endpoints.
-	McpServerID *string `form:"mcp_server_id,omitempty" json:"mcp_server_id,omitempty" xml:"mcp_server_id,omitempty"`
-	// The ID of the meta MCP server this endpoint addresses. Null for
-	// MCP-server-backed endpoints.
-	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
-	// The slug
-	Slug string `form:"slug" json:"slug" xml:"slug"`
-	// Whether this endpoint is mapped to its custom-domain root
-	IsDomainRoot bool `form:"is_domain_root" json:"is_domain_root" xml:"is_domain_root"`
-	// When the MCP endpoint was created
-	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
-	// When the MCP endpoint was last updated
-	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCreateMcpEndpointResponseBody builds the HTTP response body from the
-// result of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewCreateMcpEndpointResponseBody(res *types.McpEndpoint) *CreateMcpEndpointResponseBody {
-	body := &CreateMcpEndpointResponseBody{
-		ID:              res.ID,
-		ProjectID:       res.ProjectID,
-		CustomDomainID:  res.CustomDomainID,
-		McpServerID:     res.McpServerID,
-		MetaMcpServerID: res.MetaMcpServerID,
-		Slug:            string(res.Slug),
-		IsDomainRoot:    res.IsDomainRoot,
-		CreatedAt:       res.CreatedAt,
-		UpdatedAt:       res.UpdatedAt,
-	}
-	return body
+// StartMcpEndpointCanaryForbiddenResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "forbidden" error.
+type StartMcpEndpointCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewGetMcpEndpointResponseBody builds the HTTP response body from the result
-// of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewGetMcpEndpointResponseBody(res *types.McpEndpoint) *GetMcpEndpointResponseBody {
-	body := &GetMcpEndpointResponseBody{
-		ID:              res.ID,
-		ProjectID:       res.ProjectID,
-		CustomDomainID:  res.CustomDomainID,
-		McpServerID:     res.McpServerID,
-		MetaMcpServerID: res.MetaMcpServerID,
-		Slug:            string(res.Slug),
-		IsDomainRoot:    res.IsDomainRoot,
-		CreatedAt:       res.CreatedAt,
-		UpdatedAt:       res.UpdatedAt,
-	}
-	return body
+// StartMcpEndpointCanaryBadRequestResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "bad_request" error.
+type StartMcpEndpointCanaryBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewListMcpEndpointsResponseBody builds the HTTP response body from the
-// result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
-func NewListMcpEndpointsResponseBody(res *mcpendpoints.ListMcpEndpointsResult) *ListMcpEndpointsResponseBody {
-	body := &ListMcpEndpointsResponseBody{}
-	if res.McpEndpoints != nil {
-		body.McpEndpoints = make([]*McpEndpointResponseBody, len(res.McpEndpoints))
-		for i, val := range res.McpEndpoints {
-			if val == nil {
-				body.McpEndpoints[i] = nil
-				continue
-			}
-			body.McpEndpoints[i] = marshalTypesMcpEndpointToMcpEndpointResponseBody(val)
-		}
-	} else {
-		body.McpEndpoints = []*McpEndpointResponseBody{}
-	}
-	return body
+// StartMcpEndpointCanaryNotFoundResponseBody is the type of the "mcpEndpoints"
+// service "startMcpEndpointCanary" endpoint HTTP response body for the
+// "not_found" error.
+type StartMcpEndpointCanaryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewUpdateMcpEndpointResponseBody builds the HTTP response body from the
-// result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewUpdateMcpEndpointResponseBody(res *types.McpEndpoint) *UpdateMcpEndpointResponseBody {
-	body := &UpdateMcpEndpointResponseBody{
-		ID:              res.ID,
-		ProjectID:       res.ProjectID,
-		CustomDomainID:  res.CustomDomainID,
-		McpServerID:     res.McpServerID,
-		MetaMcpServerID: res.MetaMcpServerID,
-		Slug:            string(res.Slug),
-		IsDomainRoot:    res.IsDomainRoot,
-		CreatedAt:       res.CreatedAt,
-		UpdatedAt:       res.UpdatedAt,
-	}
-	return body
+// StartMcpEndpointCanaryConflictResponseBody is the type of the "mcpEndpoints"
+// service "startMcpEndpointCanary" endpoint HTTP response body for the
+// "conflict" error.
+type StartMcpEndpointCanaryConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCreateMcpEndpointUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewCreateMcpEndpointUnauthorizedResponseBody(res *goa.ServiceError) *CreateMcpEndpointUnauthorizedResponseBody {
-	body := &CreateMcpEndpointUnauthorizedResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// StartMcpEndpointCanaryUnsupportedMediaResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "unsupported_media" error.
+type StartMcpEndpointCanaryUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCreateMcpEndpointForbiddenResponseBody builds the HTTP response body from
-// the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewCreateMcpEndpointForbiddenResponseBody(res *goa.ServiceError) *CreateMcpEndpointForbiddenResponseBody {
-	body := &CreateMcpEndpointForbiddenResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// StartMcpEndpointCanaryInvalidResponseBody is the type of the "mcpEndpoints"
+// service "startMcpEndpointCanary" endpoint HTTP response body for the
+// "invalid" error.
+type StartMcpEndpointCanaryInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCreateMcpEndpointBadRequestResponseBody builds the HTTP response body
-// from the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewCreateMcpEndpointBadRequestResponseBody(res *goa.ServiceError) *CreateMcpEndpointBadRequestResponseBody {
-	body := &CreateMcpEndpointBadRequestResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// StartMcpEndpointCanaryInvariantViolationResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "invariant_violation" error.
+type StartMcpEndpointCanaryInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewCreateMcpEndpointNotFoundResponseBody builds the HTTP response body from
-// the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewCreateMcpEndpointNotFoundResponseBody(res *goa.ServiceError) *CreateMcpEndpointNotFoundResponseBody {
-	body := &CreateMcpEndpointNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
+// StartMcpEndpointCanaryUnexpectedResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "unexpected" error.
+type StartMcpEndpointCanaryUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// StartMcpEndpointCanaryGatewayErrorResponseBody is the type of the
+// "mcpEndpoints" service "startMcpEndpointCanary" endpoint HTTP response body
+// for the "gateway_error" error.
+type StartMcpEndpointCanaryGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetMcpEndpointCanaryUnauthorizedResponseBody is the type of the
+// "mcpEndpoints" service "getMcpEndpointCanary" endpoint HTTP response body
+// for the "unauthorized" error.
+type GetMcpEndpointCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetMcpEndpointCanaryForbiddenResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the
+// "forbidden" error.
+type GetMcpEndpointCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetMcpEndpointCanaryBadRequestResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the
+// "bad_request" error.
+type GetMcpEndpointCanaryBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetMcpEndpointCanaryNotFoundResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the
+// "not_found" error.
+type GetMcpEndpointCanaryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetMcpEndpointCanaryConflictResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the
+// "conflict" error.
+type GetMcpEndpointCanaryConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetMcpEndpointCanaryUnsupportedMediaResponseBody is the type of the
+// "mcpEndpoints" service "getMcpEndpointCanary" endpoint HTTP response body
+// for the "unsupported_media" error.
+type GetMcpEndpointCanaryUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetMcpEndpointCanaryInvalidResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the "invalid"
+// error.
+type GetMcpEndpointCanaryInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetMcpEndpointCanaryInvariantViolationResponseBody is the type of the
+// "mcpEndpoints" service "getMcpEndpointCanary" endpoint HTTP response body
+// for the "invariant_violation" error.
+type GetMcpEndpointCanaryInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetMcpEndpointCanaryUnexpectedResponseBody is the type of the "mcpEndpoints"
+// service "getMcpEndpointCanary" endpoint HTTP response body for the
+// "unexpected" error.
+type GetMcpEndpointCanaryUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetMcpEndpointCanaryGatewayErrorResponseBody is the type of the
+// "mcpEndpoints" service "getMcpEndpointCanary" endpoint HTTP response body
+// for the "gateway_error" error.
+type GetMcpEndpointCanaryGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateMcpEndpointCanaryUnauthorizedResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "unauthorized" error.
+type UpdateMcpEndpointCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateMcpEndpointCanaryForbiddenResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "forbidden" error.
+type UpdateMcpEndpointCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateMcpEndpointCanaryBadRequestResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "bad_request" error.
+type UpdateMcpEndpointCanaryBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateMcpEndpointCanaryNotFoundResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "not_found" error.
+type UpdateMcpEndpointCanaryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateMcpEndpointCanaryConflictResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "conflict" error.
+type UpdateMcpEndpointCanaryConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateMcpEndpointCanaryUnsupportedMediaResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "unsupported_media" error.
+type UpdateMcpEndpointCanaryUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateMcpEndpointCanaryInvalidResponseBody is the type of the "mcpEndpoints"
+// service "updateMcpEndpointCanary" endpoint HTTP response body for the
+// "invalid" error.
+type UpdateMcpEndpointCanaryInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateMcpEndpointCanaryInvariantViolationResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "invariant_violation" error.
+type UpdateMcpEndpointCanaryInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateMcpEndpointCanaryUnexpectedResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "unexpected" error.
+type UpdateMcpEndpointCanaryUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateMcpEndpointCanaryGatewayErrorResponseBody is the type of the
+// "mcpEndpoints" service "updateMcpEndpointCanary" endpoint HTTP response body
+// for the "gateway_error" error.
+type UpdateMcpEndpointCanaryGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteMcpEndpointCanaryUnauthorizedResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "unauthorized" error.
+type PromoteMcpEndpointCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteMcpEndpointCanaryForbiddenResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "forbidden" error.
+type PromoteMcpEndpointCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteMcpEndpointCanaryBadRequestResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "bad_request" error.
+type PromoteMcpEndpointCanaryBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteMcpEndpointCanaryNotFoundResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "not_found" error.
+type PromoteMcpEndpointCanaryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteMcpEndpointCanaryConflictResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "conflict" error.
+type PromoteMcpEndpointCanaryConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteMcpEndpointCanaryUnsupportedMediaResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "unsupported_media" error.
+type PromoteMcpEndpointCanaryUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteMcpEndpointCanaryInvalidResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "invalid" error.
+type PromoteMcpEndpointCanaryInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteMcpEndpointCanaryInvariantViolationResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "invariant_violation" error.
+type PromoteMcpEndpointCanaryInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteMcpEndpointCanaryUnexpectedResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "unexpected" error.
+type PromoteMcpEndpointCanaryUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteMcpEndpointCanaryGatewayErrorResponseBody is the type of the
+// "mcpEndpoints" service "promoteMcpEndpointCanary" endpoint HTTP response
+// body for the "gateway_error" error.
+type PromoteMcpEndpointCanaryGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AbortMcpEndpointCanaryUnauthorizedResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "unauthorized" error.
+type AbortMcpEndpointCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AbortMcpEndpointCanaryForbiddenResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "forbidden" error.
+type AbortMcpEndpointCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AbortMcpEndpointCanaryBadRequestResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "bad_request" error.
+type AbortMcpEndpointCanaryBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AbortMcpEndpointCanaryNotFoundResponseBody is the type of the "mcpEndpoints"
+// service "abortMcpEndpointCanary" endpoint HTTP response body for the
+// "not_found" error.
+type AbortMcpEndpointCanaryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AbortMcpEndpointCanaryConflictResponseBody is the type of the "mcpEndpoints"
+// service "abortMcpEndpointCanary" endpoint HTTP response body for the
+// "conflict" error.
+type AbortMcpEndpointCanaryConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AbortMcpEndpointCanaryUnsupportedMediaResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "unsupported_media" error.
+type AbortMcpEndpointCanaryUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AbortMcpEndpointCanaryInvalidResponseBody is the type of the "mcpEndpoints"
+// service "abortMcpEndpointCanary" endpoint HTTP response body for the
+// "invalid" error.
+type AbortMcpEndpointCanaryInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AbortMcpEndpointCanaryInvariantViolationResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "invariant_violation" error.
+type AbortMcpEndpointCanaryInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AbortMcpEndpointCanaryUnexpectedResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "unexpected" error.
+type AbortMcpEndpointCanaryUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AbortMcpEndpointCanaryGatewayErrorResponseBody is the type of the
+// "mcpEndpoints" service "abortMcpEndpointCanary" endpoint HTTP response body
+// for the "gateway_error" error.
+type AbortMcpEndpointCanaryGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// McpEndpointResponseBody is used to define fields on response body types.
+type McpEndpointResponseBody struct {
+	// The ID of the MCP endpoint
+	ID string `form:"id" json:"id" xml:"id"`
+	// The project ID this MCP endpoint belongs to
+	ProjectID string `form:"project_id" json:"project_id" xml:"project_id"`
+	// The ID of the custom domain this endpoint slug is registered under. Null for
+	// platform-domain endpoints.
+	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
+	// The ID of the MCP server this endpoint addresses. Null for meta-MCP-backed
+	// endpoints.
+	McpServerID *string `form:"mcp_server_id,omitempty" json:"mcp_server_id,omitempty" xml:"mcp_server_id,omitempty"`
+	// The ID of the meta MCP server this endpoint addresses. Null for
+	// MCP-server-backed endpoints.
+	MetaMcpServerID *string `form:"meta_mcp_server_id,omitempty" json:"meta_mcp_server_id,omitempty" xml:"meta_mcp_server_id,omitempty"`
+	// The toolset version this endpoint is pinned to, if any. Overrides the
+	// version pinned on its MCP server.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
+	// The slug
+	Slug string `form:"slug" json:"slug" xml:"slug"`
+	// Whether this endpoint is mapped to its custom-domain root
+	IsDomainRoot bool `form:"is_domain_root" json:"is_domain_root" xml:"is_domain_root"`
+	// When the MCP endpoint was created
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// When the MCP endpoint was last updated
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// McpEndpointCanaryArmResponseBody is used to define fields on response body
+// types.
+type McpEndpointCanaryArmResponseBody struct {
+	// The deployment serving this arm
+	DeploymentID string `form:"deployment_id" json:"deployment_id" xml:"deployment_id"`
+	// Tool calls served by this arm
+	ToolCalls int64 `form:"tool_calls" json:"tool_calls" xml:"tool_calls"`
+	// Tool calls that returned an HTTP status of 400 or above
+	FailedToolCalls int64 `form:"failed_tool_calls" json:"failed_tool_calls" xml:"failed_tool_calls"`
+	// Failed tool calls as a fraction of all tool calls. Zero when there were no
+	// calls.
+	ErrorRate float64 `form:"error_rate" json:"error_rate" xml:"error_rate"`
+	// Median tool call latency in milliseconds
+	LatencyP50Ms float64 `form:"latency_p50_ms" json:"latency_p50_ms" xml:"latency_p50_ms"`
+	// 95th percentile tool call latency in milliseconds
+	LatencyP95Ms float64 `form:"latency_p95_ms" json:"latency_p95_ms" xml:"latency_p95_ms"`
+}
+
+// NewCreateMcpEndpointResponseBody builds the HTTP response body from the
+// result of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewCreateMcpEndpointResponseBody(res *types.McpEndpoint) *CreateMcpEndpointResponseBody {
+	body := &CreateMcpEndpointResponseBody{
+		ID:              res.ID,
+		ProjectID:       res.ProjectID,
+		CustomDomainID:  res.CustomDomainID,
+		McpServerID:     res.McpServerID,
+		MetaMcpServerID: res.MetaMcpServerID,
+		ToolsetVersion:  res.ToolsetVersion,
+		Slug:            string(res.Slug),
+		IsDomainRoot:    res.IsDomainRoot,
+		CreatedAt:       res.CreatedAt,
+		UpdatedAt:       res.UpdatedAt,
+	}
+	return body
+}
+
+// NewGetMcpEndpointResponseBody builds the HTTP response body from the result
+// of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewGetMcpEndpointResponseBody(res *types.McpEndpoint) *GetMcpEndpointResponseBody {
+	body := &GetMcpEndpointResponseBody{
+		ID:              res.ID,
+		ProjectID:       res.ProjectID,
+		CustomDomainID:  res.CustomDomainID,
+		McpServerID:     res.McpServerID,
+		MetaMcpServerID: res.MetaMcpServerID,
+		ToolsetVersion:  res.ToolsetVersion,
+		Slug:            string(res.Slug),
+		IsDomainRoot:    res.IsDomainRoot,
+		CreatedAt:       res.CreatedAt,
+		UpdatedAt:       res.UpdatedAt,
+	}
+	return body
+}
+
+// NewListMcpEndpointsResponseBody builds the HTTP response body from the
+// result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
+func NewListMcpEndpointsResponseBody(res *mcpendpoints.ListMcpEndpointsResult) *ListMcpEndpointsResponseBody {
+	body := &ListMcpEndpointsResponseBody{}
+	if res.McpEndpoints != nil {
+		body.McpEndpoints = make([]*McpEndpointResponseBody, len(res.McpEndpoints))
+		for i, val := range res.McpEndpoints {
+			if val == nil {
+				body.McpEndpoints[i] = nil
+				continue
+			}
+			body.McpEndpoints[i] = marshalTypesMcpEndpointToMcpEndpointResponseBody(val)
+		}
+	} else {
+		body.McpEndpoints = []*McpEndpointResponseBody{}
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointResponseBody builds the HTTP response body from the
+// result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewUpdateMcpEndpointResponseBody(res *types.McpEndpoint) *UpdateMcpEndpointResponseBody {
+	body := &UpdateMcpEndpointResponseBody{
+		ID:              res.ID,
+		ProjectID:       res.ProjectID,
+		CustomDomainID:  res.CustomDomainID,
+		McpServerID:     res.McpServerID,
+		MetaMcpServerID: res.MetaMcpServerID,
+		ToolsetVersion:  res.ToolsetVersion,
+		Slug:            string(res.Slug),
+		IsDomainRoot:    res.IsDomainRoot,
+		CreatedAt:       res.CreatedAt,
+		UpdatedAt:       res.UpdatedAt,
+	}
+	return body
+}
+
+// NewStartMcpEndpointCanaryResponseBody builds the HTTP response body from the
+// result of the "startMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewStartMcpEndpointCanaryResponseBody(res *mcpendpoints.McpEndpointCanary) *StartMcpEndpointCanaryResponseBody {
+	body := &StartMcpEndpointCanaryResponseBody{
+		ID:                    res.ID,
+		McpEndpointID:         res.McpEndpointID,
+		BaselineDeploymentID:  res.BaselineDeploymentID,
+		CandidateDeploymentID: res.CandidateDeploymentID,
+		Percentage:            res.Percentage,
+		StickyBy:              res.StickyBy,
+		Status:                res.Status,
+		StartedAt:             res.StartedAt,
+		EndedAt:               res.EndedAt,
+	}
+	if res.Baseline != nil {
+		body.Baseline = marshalMcpendpointsMcpEndpointCanaryArmToMcpEndpointCanaryArmResponseBody(res.Baseline)
+	}
+	if res.Candidate != nil {
+		body.Candidate = marshalMcpendpointsMcpEndpointCanaryArmToMcpEndpointCanaryArmResponseBody(res.Candidate)
+	}
+	return body
+}
+
+// NewGetMcpEndpointCanaryResponseBody builds the HTTP response body from the
+// result of the "getMcpEndpointCanary" endpoint of the "mcpEndpoints" service.
+func NewGetMcpEndpointCanaryResponseBody(res *mcpendpoints.McpEndpointCanary) *GetMcpEndpointCanaryResponseBody {
+	body := &GetMcpEndpointCanaryResponseBody{
+		ID:                    res.ID,
+		McpEndpointID:         res.McpEndpointID,
+		BaselineDeploymentID:  res.BaselineDeploymentID,
+		CandidateDeploymentID: res.CandidateDeploymentID,
+		Percentage:            res.Percentage,
+		StickyBy:              res.StickyBy,
+		Status:                res.Status,
+		StartedAt:             res.StartedAt,
+		EndedAt:               res.EndedAt,
+	}
+	if res.Baseline != nil {
+		body.Baseline = marshalMcpendpointsMcpEndpointCanaryArmToMcpEndpointCanaryArmResponseBody(res.Baseline)
+	}
+	if res.Candidate != nil {
+		body.Candidate = marshalMcpendpointsMcpEndpointCanaryArmToMcpEndpointCanaryArmResponseBody(res.Candidate)
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointCanaryResponseBody builds the HTTP response body from
+// the result of the "updateMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewUpdateMcpEndpointCanaryResponseBody(res *mcpendpoints.McpEndpointCanary) *UpdateMcpEndpointCanaryResponseBody {
+	body := &UpdateMcpEndpointCanaryResponseBody{
+		ID:                    res.ID,
+		McpEndpointID:         res.McpEndpointID,
+		BaselineDeploymentID:  res.BaselineDeploymentID,
+		CandidateDeploymentID: res.CandidateDeploymentID,
+		Percentage:            res.Percentage,
+		StickyBy:              res.StickyBy,
+		Status:                res.Status,
+		StartedAt:             res.StartedAt,
+		EndedAt:               res.EndedAt,
+	}
+	if res.Baseline != nil {
+		body.Baseline = marshalMcpendpointsMcpEndpointCanaryArmToMcpEndpointCanaryArmResponseBody(res.Baseline)
+	}
+	if res.Candidate != nil {
+		body.Candidate = marshalMcpendpointsMcpEndpointCanaryArmToMcpEndpointCanaryArmResponseBody(res.Candidate)
+	}
+	return body
+}
+
+// NewPromoteMcpEndpointCanaryResponseBody builds the HTTP response body from
+// the result of the "promoteMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewPromoteMcpEndpointCanaryResponseBody(res *mcpendpoints.McpEndpointCanary) *PromoteMcpEndpointCanaryResponseBody {
+	body := &PromoteMcpEndpointCanaryResponseBody{
+		ID:                    res.ID,
+		McpEndpointID:         res.McpEndpointID,
+		BaselineDeploymentID:  res.BaselineDeploymentID,
+		CandidateDeploymentID: res.CandidateDeploymentID,
+		Percentage:            res.Percentage,
+		StickyBy:              res.StickyBy,
+		Status:                res.Status,
+		StartedAt:             res.StartedAt,
+		EndedAt:               res.EndedAt,
+	}
+	if res.Baseline != nil {
+		body.Baseline = marshalMcpendpointsMcpEndpointCanaryArmToMcpEndpointCanaryArmResponseBody(res.Baseline)
+	}
+	if res.Candidate != nil {
+		body.Candidate = marshalMcpendpointsMcpEndpointCanaryArmToMcpEndpointCanaryArmResponseBody(res.Candidate)
+	}
+	return body
+}
+
+// NewAbortMcpEndpointCanaryResponseBody builds the HTTP response body from the
+// result of the "abortMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewAbortMcpEndpointCanaryResponseBody(res *mcpendpoints.McpEndpointCanary) *AbortMcpEndpointCanaryResponseBody {
+	body := &AbortMcpEndpointCanaryResponseBody{
+		ID:                    res.ID,
+		McpEndpointID:         res.McpEndpointID,
+		BaselineDeploymentID:  res.BaselineDeploymentID,
+		CandidateDeploymentID: res.CandidateDeploymentID,
+		Percentage:            res.Percentage,
+		StickyBy:              res.StickyBy,
+		Status:                res.Status,
+		StartedAt:             res.StartedAt,
+		EndedAt:               res.EndedAt,
+	}
+	if res.Baseline != nil {
+		body.Baseline = marshalMcpendpointsMcpEndpointCanaryArmToMcpEndpointCanaryArmResponseBody(res.Baseline)
+	}
+	if res.Candidate != nil {
+		body.Candidate = marshalMcpendpointsMcpEndpointCanaryArmToMcpEndpointCanaryArmResponseBody(res.Candidate)
+	}
+	return body
+}
+
+// NewCreateMcpEndpointUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewCreateMcpEndpointUnauthorizedResponseBody(res *goa.ServiceError) *CreateMcpEndpointUnauthorizedResponseBody {
+	body := &CreateMcpEndpointUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateMcpEndpointForbiddenResponseBody builds the HTTP response body from
+// the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewCreateMcpEndpointForbiddenResponseBody(res *goa.ServiceError) *CreateMcpEndpointForbiddenResponseBody {
+	body := &CreateMcpEndpointForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateMcpEndpointBadRequestResponseBody builds the HTTP response body
+// from the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewCreateMcpEndpointBadRequestResponseBody(res *goa.ServiceError) *CreateMcpEndpointBadRequestResponseBody {
+	body := &CreateMcpEndpointBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateMcpEndpointNotFoundResponseBody builds the HTTP response body from
+// the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewCreateMcpEndpointNotFoundResponseBody(res *goa.ServiceError) *CreateMcpEndpointNotFoundResponseBody {
+	body := &CreateMcpEndpointNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateMcpEndpointConflictResponseBody builds the HTTP response body from
+// the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewCreateMcpEndpointConflictResponseBody(res *goa.ServiceError) *CreateMcpEndpointConflictResponseBody {
+	body := &CreateMcpEndpointConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateMcpEndpointUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "createMcpEndpoint" endpoint of the
+// "mcpEndpoints" service.
+func NewCreateMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *CreateMcpEndpointUnsupportedMediaResponseBody {
+	body := &CreateMcpEndpointUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateMcpEndpointInvalidResponseBody builds the HTTP response body from
+// the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewCreateMcpEndpointInvalidResponseBody(res *goa.ServiceError) *CreateMcpEndpointInvalidResponseBody {
+	body := &CreateMcpEndpointInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateMcpEndpointInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "createMcpEndpoint" endpoint of the
+// "mcpEndpoints" service.
+func NewCreateMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *CreateMcpEndpointInvariantViolationResponseBody {
+	body := &CreateMcpEndpointInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateMcpEndpointUnexpectedResponseBody builds the HTTP response body
+// from the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewCreateMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *CreateMcpEndpointUnexpectedResponseBody {
+	body := &CreateMcpEndpointUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateMcpEndpointGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewCreateMcpEndpointGatewayErrorResponseBody(res *goa.ServiceError) *CreateMcpEndpointGatewayErrorResponseBody {
+	body := &CreateMcpEndpointGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMcpEndpointUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewGetMcpEndpointUnauthorizedResponseBody(res *goa.ServiceError) *GetMcpEndpointUnauthorizedResponseBody {
+	body := &GetMcpEndpointUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMcpEndpointForbiddenResponseBody builds the HTTP response body from
+// the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewGetMcpEndpointForbiddenResponseBody(res *goa.ServiceError) *GetMcpEndpointForbiddenResponseBody {
+	body := &GetMcpEndpointForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMcpEndpointBadRequestResponseBody builds the HTTP response body from
+// the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewGetMcpEndpointBadRequestResponseBody(res *goa.ServiceError) *GetMcpEndpointBadRequestResponseBody {
+	body := &GetMcpEndpointBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMcpEndpointNotFoundResponseBody builds the HTTP response body from the
+// result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewGetMcpEndpointNotFoundResponseBody(res *goa.ServiceError) *GetMcpEndpointNotFoundResponseBody {
+	body := &GetMcpEndpointNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMcpEndpointConflictResponseBody builds the HTTP response body from the
+// result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewGetMcpEndpointConflictResponseBody(res *goa.ServiceError) *GetMcpEndpointConflictResponseBody {
+	body := &GetMcpEndpointConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMcpEndpointUnsupportedMediaResponseBody builds the HTTP response body
+// from the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewGetMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *GetMcpEndpointUnsupportedMediaResponseBody {
+	body := &GetMcpEndpointUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMcpEndpointInvalidResponseBody builds the HTTP response body from the
+// result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewGetMcpEndpointInvalidResponseBody(res *goa.ServiceError) *GetMcpEndpointInvalidResponseBody {
+	body := &GetMcpEndpointInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMcpEndpointInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewGetMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *GetMcpEndpointInvariantViolationResponseBody {
+	body := &GetMcpEndpointInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMcpEndpointUnexpectedResponseBody builds the HTTP response body from
+// the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewGetMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *GetMcpEndpointUnexpectedResponseBody {
+	body := &GetMcpEndpointUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetMcpEndpointGatewayErrorResponseBody builds the HTTP response body from
+// the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewGetMcpEndpointGatewayErrorResponseBody(res *goa.ServiceError) *GetMcpEndpointGatewayErrorResponseBody {
+	body := &GetMcpEndpointGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListMcpEndpointsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints"
+// service.
+func NewListMcpEndpointsUnauthorizedResponseBody(res *goa.ServiceError) *ListMcpEndpointsUnauthorizedResponseBody {
+	body := &ListMcpEndpointsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListMcpEndpointsForbiddenResponseBody builds the HTTP response body from
+// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
+func NewListMcpEndpointsForbiddenResponseBody(res *goa.ServiceError) *ListMcpEndpointsForbiddenResponseBody {
+	body := &ListMcpEndpointsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListMcpEndpointsBadRequestResponseBody builds the HTTP response body from
+// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
+func NewListMcpEndpointsBadRequestResponseBody(res *goa.ServiceError) *ListMcpEndpointsBadRequestResponseBody {
+	body := &ListMcpEndpointsBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListMcpEndpointsNotFoundResponseBody builds the HTTP response body from
+// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
+func NewListMcpEndpointsNotFoundResponseBody(res *goa.ServiceError) *ListMcpEndpointsNotFoundResponseBody {
+	body := &ListMcpEndpointsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListMcpEndpointsConflictResponseBody builds the HTTP response body from
+// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
+func NewListMcpEndpointsConflictResponseBody(res *goa.ServiceError) *ListMcpEndpointsConflictResponseBody {
+	body := &ListMcpEndpointsConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListMcpEndpointsUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "listMcpEndpoints" endpoint of the
+// "mcpEndpoints" service.
+func NewListMcpEndpointsUnsupportedMediaResponseBody(res *goa.ServiceError) *ListMcpEndpointsUnsupportedMediaResponseBody {
+	body := &ListMcpEndpointsUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListMcpEndpointsInvalidResponseBody builds the HTTP response body from
+// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
+func NewListMcpEndpointsInvalidResponseBody(res *goa.ServiceError) *ListMcpEndpointsInvalidResponseBody {
+	body := &ListMcpEndpointsInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListMcpEndpointsInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "listMcpEndpoints" endpoint of the
+// "mcpEndpoints" service.
+func NewListMcpEndpointsInvariantViolationResponseBody(res *goa.ServiceError) *ListMcpEndpointsInvariantViolationResponseBody {
+	body := &ListMcpEndpointsInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListMcpEndpointsUnexpectedResponseBody builds the HTTP response body from
+// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
+func NewListMcpEndpointsUnexpectedResponseBody(res *goa.ServiceError) *ListMcpEndpointsUnexpectedResponseBody {
+	body := &ListMcpEndpointsUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListMcpEndpointsGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints"
+// service.
+func NewListMcpEndpointsGatewayErrorResponseBody(res *goa.ServiceError) *ListMcpEndpointsGatewayErrorResponseBody {
+	body := &ListMcpEndpointsGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewUpdateMcpEndpointUnauthorizedResponseBody(res *goa.ServiceError) *UpdateMcpEndpointUnauthorizedResponseBody {
+	body := &UpdateMcpEndpointUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointForbiddenResponseBody builds the HTTP response body from
+// the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewUpdateMcpEndpointForbiddenResponseBody(res *goa.ServiceError) *UpdateMcpEndpointForbiddenResponseBody {
+	body := &UpdateMcpEndpointForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointBadRequestResponseBody builds the HTTP response body
+// from the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewUpdateMcpEndpointBadRequestResponseBody(res *goa.ServiceError) *UpdateMcpEndpointBadRequestResponseBody {
+	body := &UpdateMcpEndpointBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointNotFoundResponseBody builds the HTTP response body from
+// the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewUpdateMcpEndpointNotFoundResponseBody(res *goa.ServiceError) *UpdateMcpEndpointNotFoundResponseBody {
+	body := &UpdateMcpEndpointNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointConflictResponseBody builds the HTTP response body from
+// the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewUpdateMcpEndpointConflictResponseBody(res *goa.ServiceError) *UpdateMcpEndpointConflictResponseBody {
+	body := &UpdateMcpEndpointConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "updateMcpEndpoint" endpoint of the
+// "mcpEndpoints" service.
+func NewUpdateMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *UpdateMcpEndpointUnsupportedMediaResponseBody {
+	body := &UpdateMcpEndpointUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointInvalidResponseBody builds the HTTP response body from
+// the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewUpdateMcpEndpointInvalidResponseBody(res *goa.ServiceError) *UpdateMcpEndpointInvalidResponseBody {
+	body := &UpdateMcpEndpointInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "updateMcpEndpoint" endpoint of the
+// "mcpEndpoints" service.
+func NewUpdateMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *UpdateMcpEndpointInvariantViolationResponseBody {
+	body := &UpdateMcpEndpointInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointUnexpectedResponseBody builds the HTTP response body
+// from the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewUpdateMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *UpdateMcpEndpointUnexpectedResponseBody {
+	body := &UpdateMcpEndpointUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateMcpEndpointGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewUpdateMcpEndpointGatewayErrorResponseBody(res *goa.ServiceError) *UpdateMcpEndpointGatewayErrorResponseBody {
+	body := &UpdateMcpEndpointGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody builds the HTTP
+// response body from the result of the "checkMcpEndpointSlugAvailability"
+// endpoint of the "mcpEndpoints" service.
+func NewCheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody {
+	body := &CheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckMcpEndpointSlugAvailabilityForbiddenResponseBody builds the HTTP
+// response body from the result of the "checkMcpEndpointSlugAvailability"
+// endpoint of the "mcpEndpoints" service.
+func NewCheckMcpEndpointSlugAvailabilityForbiddenResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityForbiddenResponseBody {
+	body := &CheckMcpEndpointSlugAvailabilityForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckMcpEndpointSlugAvailabilityBadRequestResponseBody builds the HTTP
+// response body from the result of the "checkMcpEndpointSlugAvailability"
+// endpoint of the "mcpEndpoints" service.
+func NewCheckMcpEndpointSlugAvailabilityBadRequestResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityBadRequestResponseBody {
+	body := &CheckMcpEndpointSlugAvailabilityBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckMcpEndpointSlugAvailabilityNotFoundResponseBody builds the HTTP
+// response body from the result of the "checkMcpEndpointSlugAvailability"
+// endpoint of the "mcpEndpoints" service.
+func NewCheckMcpEndpointSlugAvailabilityNotFoundResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityNotFoundResponseBody {
+	body := &CheckMcpEndpointSlugAvailabilityNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckMcpEndpointSlugAvailabilityConflictResponseBody builds the HTTP
+// response body from the result of the "checkMcpEndpointSlugAvailability"
+// endpoint of the "mcpEndpoints" service.
+func NewCheckMcpEndpointSlugAvailabilityConflictResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityConflictResponseBody {
+	body := &CheckMcpEndpointSlugAvailabilityConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody builds the
+// HTTP response body from the result of the "checkMcpEndpointSlugAvailability"
+// endpoint of the "mcpEndpoints" service.
+func NewCheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody {
+	body := &CheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
 		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewCreateMcpEndpointConflictResponseBody builds the HTTP response body from
-// the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewCreateMcpEndpointConflictResponseBody(res *goa.ServiceError) *CreateMcpEndpointConflictResponseBody {
-	body := &CreateMcpEndpointConflictResponseBody{
+// NewCheckMcpEndpointSlugAvailabilityInvalidResponseBody builds the HTTP
+// response body from the result of the "checkMcpEndpointSlugAvailability"
+// endpoint of the "mcpEndpoints" service.
+func NewCheckMcpEndpointSlugAvailabilityInvalidResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityInvalidResponseBody {
+	body := &CheckMcpEndpointSlugAvailabilityInvalidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1437,11 +3340,128 @@ func NewCreateMcpEndpointConflictResponseBody(res *goa.ServiceError) *CreateMcpE
 	return body
 }
 
-// NewCreateMcpEndpointUnsupportedMediaResponseBody builds the HTTP response
-// body from the result of the "createMcpEndpoint" endpoint of the
+// NewCheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody builds the
+// HTTP response body from the result of the "checkMcpEndpointSlugAvailability"
+// endpoint of the "mcpEndpoints" service.
+func NewCheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody {
+	body := &CheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckMcpEndpointSlugAvailabilityUnexpectedResponseBody builds the HTTP
+// response body from the result of the "checkMcpEndpointSlugAvailability"
+// endpoint of the "mcpEndpoints" service.
+func NewCheckMcpEndpointSlugAvailabilityUnexpectedResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityUnexpectedResponseBody {
+	body := &CheckMcpEndpointSlugAvailabilityUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody builds the HTTP
+// response body from the result of the "checkMcpEndpointSlugAvailability"
+// endpoint of the "mcpEndpoints" service.
+func NewCheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody {
+	body := &CheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteMcpEndpointUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewDeleteMcpEndpointUnauthorizedResponseBody(res *goa.ServiceError) *DeleteMcpEndpointUnauthorizedResponseBody {
+	body := &DeleteMcpEndpointUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteMcpEndpointForbiddenResponseBody builds the HTTP response body from
+// the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewDeleteMcpEndpointForbiddenResponseBody(res *goa.ServiceError) *DeleteMcpEndpointForbiddenResponseBody {
+	body := &DeleteMcpEndpointForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteMcpEndpointBadRequestResponseBody builds the HTTP response body
+// from the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints"
+// service.
+func NewDeleteMcpEndpointBadRequestResponseBody(res *goa.ServiceError) *DeleteMcpEndpointBadRequestResponseBody {
+	body := &DeleteMcpEndpointBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteMcpEndpointNotFoundResponseBody builds the HTTP response body from
+// the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewDeleteMcpEndpointNotFoundResponseBody(res *goa.ServiceError) *DeleteMcpEndpointNotFoundResponseBody {
+	body := &DeleteMcpEndpointNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteMcpEndpointConflictResponseBody builds the HTTP response body from
+// the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewDeleteMcpEndpointConflictResponseBody(res *goa.ServiceError) *DeleteMcpEndpointConflictResponseBody {
+	body := &DeleteMcpEndpointConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteMcpEndpointUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "deleteMcpEndpoint" endpoint of the
 // "mcpEndpoints" service.
-func NewCreateMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *CreateMcpEndpointUnsupportedMediaResponseBody {
-	body := &CreateMcpEndpointUnsupportedMediaResponseBody{
+func NewDeleteMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *DeleteMcpEndpointUnsupportedMediaResponseBody {
+	body := &DeleteMcpEndpointUnsupportedMediaResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1452,10 +3472,10 @@ func NewCreateMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *Cr
 	return body
 }
 
-// NewCreateMcpEndpointInvalidResponseBody builds the HTTP response body from
-// the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewCreateMcpEndpointInvalidResponseBody(res *goa.ServiceError) *CreateMcpEndpointInvalidResponseBody {
-	body := &CreateMcpEndpointInvalidResponseBody{
+// NewDeleteMcpEndpointInvalidResponseBody builds the HTTP response body from
+// the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints" service.
+func NewDeleteMcpEndpointInvalidResponseBody(res *goa.ServiceError) *DeleteMcpEndpointInvalidResponseBody {
+	body := &DeleteMcpEndpointInvalidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1466,11 +3486,11 @@ func NewCreateMcpEndpointInvalidResponseBody(res *goa.ServiceError) *CreateMcpEn
 	return body
 }
 
-// NewCreateMcpEndpointInvariantViolationResponseBody builds the HTTP response
-// body from the result of the "createMcpEndpoint" endpoint of the
+// NewDeleteMcpEndpointInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "deleteMcpEndpoint" endpoint of the
 // "mcpEndpoints" service.
-func NewCreateMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *CreateMcpEndpointInvariantViolationResponseBody {
-	body := &CreateMcpEndpointInvariantViolationResponseBody{
+func NewDeleteMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *DeleteMcpEndpointInvariantViolationResponseBody {
+	body := &DeleteMcpEndpointInvariantViolationResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1481,11 +3501,11 @@ func NewCreateMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *
 	return body
 }
 
-// NewCreateMcpEndpointUnexpectedResponseBody builds the HTTP response body
-// from the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints"
+// NewDeleteMcpEndpointUnexpectedResponseBody builds the HTTP response body
+// from the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints"
 // service.
-func NewCreateMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *CreateMcpEndpointUnexpectedResponseBody {
-	body := &CreateMcpEndpointUnexpectedResponseBody{
+func NewDeleteMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *DeleteMcpEndpointUnexpectedResponseBody {
+	body := &DeleteMcpEndpointUnexpectedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1496,11 +3516,11 @@ func NewCreateMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *CreateMc
 	return body
 }
 
-// NewCreateMcpEndpointGatewayErrorResponseBody builds the HTTP response body
-// from the result of the "createMcpEndpoint" endpoint of the "mcpEndpoints"
+// NewDeleteMcpEndpointGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints"
 // service.
-func NewCreateMcpEndpointGatewayErrorResponseBody(res *goa.ServiceError) *CreateMcpEndpointGatewayErrorResponseBody {
-	body := &CreateMcpEndpointGatewayErrorResponseBody{
+func NewDeleteMcpEndpointGatewayErrorResponseBody(res *goa.ServiceError) *DeleteMcpEndpointGatewayErrorResponseBody {
+	body := &DeleteMcpEndpointGatewayErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1511,10 +3531,11 @@ func NewCreateMcpEndpointGatewayErrorResponseBody(res *goa.ServiceError) *Create
 	return body
 }
 
-// NewGetMcpEndpointUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewGetMcpEndpointUnauthorizedResponseBody(res *goa.ServiceError) *GetMcpEndpointUnauthorizedResponseBody {
-	body := &GetMcpEndpointUnauthorizedResponseBody{
+// NewStartMcpEndpointCanaryUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "startMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewStartMcpEndpointCanaryUnauthorizedResponseBody(res *goa.ServiceError) *StartMcpEndpointCanaryUnauthorizedResponseBody {
+	body := &StartMcpEndpointCanaryUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1525,10 +3546,11 @@ func NewGetMcpEndpointUnauthorizedResponseBody(res *goa.ServiceError) *GetMcpEnd
 	return body
 }
 
-// NewGetMcpEndpointForbiddenResponseBody builds the HTTP response body from
-// the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewGetMcpEndpointForbiddenResponseBody(res *goa.ServiceError) *GetMcpEndpointForbiddenResponseBody {
-	body := &GetMcpEndpointForbiddenResponseBody{
+// NewStartMcpEndpointCanaryForbiddenResponseBody builds the HTTP response body
+// from the result of the "startMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewStartMcpEndpointCanaryForbiddenResponseBody(res *goa.ServiceError) *StartMcpEndpointCanaryForbiddenResponseBody {
+	body := &StartMcpEndpointCanaryForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1539,10 +3561,11 @@ func NewGetMcpEndpointForbiddenResponseBody(res *goa.ServiceError) *GetMcpEndpoi
 	return body
 }
 
-// NewGetMcpEndpointBadRequestResponseBody builds the HTTP response body from
-// the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewGetMcpEndpointBadRequestResponseBody(res *goa.ServiceError) *GetMcpEndpointBadRequestResponseBody {
-	body := &GetMcpEndpointBadRequestResponseBody{
+// NewStartMcpEndpointCanaryBadRequestResponseBody builds the HTTP response
+// body from the result of the "startMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewStartMcpEndpointCanaryBadRequestResponseBody(res *goa.ServiceError) *StartMcpEndpointCanaryBadRequestResponseBody {
+	body := &StartMcpEndpointCanaryBadRequestResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1553,10 +3576,11 @@ func NewGetMcpEndpointBadRequestResponseBody(res *goa.ServiceError) *GetMcpEndpo
 	return body
 }
 
-// NewGetMcpEndpointNotFoundResponseBody builds the HTTP response body from the
-// result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewGetMcpEndpointNotFoundResponseBody(res *goa.ServiceError) *GetMcpEndpointNotFoundResponseBody {
-	body := &GetMcpEndpointNotFoundResponseBody{
+// NewStartMcpEndpointCanaryNotFoundResponseBody builds the HTTP response body
+// from the result of the "startMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewStartMcpEndpointCanaryNotFoundResponseBody(res *goa.ServiceError) *StartMcpEndpointCanaryNotFoundResponseBody {
+	body := &StartMcpEndpointCanaryNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1567,10 +3591,11 @@ func NewGetMcpEndpointNotFoundResponseBody(res *goa.ServiceError) *GetMcpEndpoin
 	return body
 }
 
-// NewGetMcpEndpointConflictResponseBody builds the HTTP response body from the
-// result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewGetMcpEndpointConflictResponseBody(res *goa.ServiceError) *GetMcpEndpointConflictResponseBody {
-	body := &GetMcpEndpointConflictResponseBody{
+// NewStartMcpEndpointCanaryConflictResponseBody builds the HTTP response body
+// from the result of the "startMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewStartMcpEndpointCanaryConflictResponseBody(res *goa.ServiceError) *StartMcpEndpointCanaryConflictResponseBody {
+	body := &StartMcpEndpointCanaryConflictResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1581,11 +3606,11 @@ func NewGetMcpEndpointConflictResponseBody(res *goa.ServiceError) *GetMcpEndpoin
 	return body
 }
 
-// NewGetMcpEndpointUnsupportedMediaResponseBody builds the HTTP response body
-// from the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewGetMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *GetMcpEndpointUnsupportedMediaResponseBody {
-	body := &GetMcpEndpointUnsupportedMediaResponseBody{
+// NewStartMcpEndpointCanaryUnsupportedMediaResponseBody builds the HTTP
+// response body from the result of the "startMcpEndpointCanary" endpoint of
+// the "mcpEndpoints" service.
+func NewStartMcpEndpointCanaryUnsupportedMediaResponseBody(res *goa.ServiceError) *StartMcpEndpointCanaryUnsupportedMediaResponseBody {
+	body := &StartMcpEndpointCanaryUnsupportedMediaResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1596,10 +3621,11 @@ func NewGetMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *GetMc
 	return body
 }
 
-// NewGetMcpEndpointInvalidResponseBody builds the HTTP response body from the
-// result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewGetMcpEndpointInvalidResponseBody(res *goa.ServiceError) *GetMcpEndpointInvalidResponseBody {
-	body := &GetMcpEndpointInvalidResponseBody{
+// NewStartMcpEndpointCanaryInvalidResponseBody builds the HTTP response body
+// from the result of the "startMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewStartMcpEndpointCanaryInvalidResponseBody(res *goa.ServiceError) *StartMcpEndpointCanaryInvalidResponseBody {
+	body := &StartMcpEndpointCanaryInvalidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1610,11 +3636,11 @@ func NewGetMcpEndpointInvalidResponseBody(res *goa.ServiceError) *GetMcpEndpoint
 	return body
 }
 
-// NewGetMcpEndpointInvariantViolationResponseBody builds the HTTP response
-// body from the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewGetMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *GetMcpEndpointInvariantViolationResponseBody {
-	body := &GetMcpEndpointInvariantViolationResponseBody{
+// NewStartMcpEndpointCanaryInvariantViolationResponseBody builds the HTTP
+// response body from the result of the "startMcpEndpointCanary" endpoint of
+// the "mcpEndpoints" service.
+func NewStartMcpEndpointCanaryInvariantViolationResponseBody(res *goa.ServiceError) *StartMcpEndpointCanaryInvariantViolationResponseBody {
+	body := &StartMcpEndpointCanaryInvariantViolationResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1625,10 +3651,11 @@ func NewGetMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *Get
 	return body
 }
 
-// NewGetMcpEndpointUnexpectedResponseBody builds the HTTP response body from
-// the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewGetMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *GetMcpEndpointUnexpectedResponseBody {
-	body := &GetMcpEndpointUnexpectedResponseBody{
+// NewStartMcpEndpointCanaryUnexpectedResponseBody builds the HTTP response
+// body from the result of the "startMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewStartMcpEndpointCanaryUnexpectedResponseBody(res *goa.ServiceError) *StartMcpEndpointCanaryUnexpectedResponseBody {
+	body := &StartMcpEndpointCanaryUnexpectedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1639,10 +3666,11 @@ func NewGetMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *GetMcpEndpo
 	return body
 }
 
-// NewGetMcpEndpointGatewayErrorResponseBody builds the HTTP response body from
-// the result of the "getMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewGetMcpEndpointGatewayErrorResponseBody(res *goa.ServiceError) *GetMcpEndpointGatewayErrorResponseBody {
-	body := &GetMcpEndpointGatewayErrorResponseBody{
+// NewStartMcpEndpointCanaryGatewayErrorResponseBody builds the HTTP response
+// body from the result of the "startMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewStartMcpEndpointCanaryGatewayErrorResponseBody(res *goa.ServiceError) *StartMcpEndpointCanaryGatewayErrorResponseBody {
+	body := &StartMcpEndpointCanaryGatewayErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1653,11 +3681,11 @@ func NewGetMcpEndpointGatewayErrorResponseBody(res *goa.ServiceError) *GetMcpEnd
 	return body
 }
 
-// NewListMcpEndpointsUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints"
-// service.
-func NewListMcpEndpointsUnauthorizedResponseBody(res *goa.ServiceError) *ListMcpEndpointsUnauthorizedResponseBody {
-	body := &ListMcpEndpointsUnauthorizedResponseBody{
+// NewGetMcpEndpointCanaryUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "getMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewGetMcpEndpointCanaryUnauthorizedResponseBody(res *goa.ServiceError) *GetMcpEndpointCanaryUnauthorizedResponseBody {
+	body := &GetMcpEndpointCanaryUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1668,10 +3696,11 @@ func NewListMcpEndpointsUnauthorizedResponseBody(res *goa.ServiceError) *ListMcp
 	return body
 }
 
-// NewListMcpEndpointsForbiddenResponseBody builds the HTTP response body from
-// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
-func NewListMcpEndpointsForbiddenResponseBody(res *goa.ServiceError) *ListMcpEndpointsForbiddenResponseBody {
-	body := &ListMcpEndpointsForbiddenResponseBody{
+// NewGetMcpEndpointCanaryForbiddenResponseBody builds the HTTP response body
+// from the result of the "getMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewGetMcpEndpointCanaryForbiddenResponseBody(res *goa.ServiceError) *GetMcpEndpointCanaryForbiddenResponseBody {
+	body := &GetMcpEndpointCanaryForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1682,10 +3711,11 @@ func NewListMcpEndpointsForbiddenResponseBody(res *goa.ServiceError) *ListMcpEnd
 	return body
 }
 
-// NewListMcpEndpointsBadRequestResponseBody builds the HTTP response body from
-// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
-func NewListMcpEndpointsBadRequestResponseBody(res *goa.ServiceError) *ListMcpEndpointsBadRequestResponseBody {
-	body := &ListMcpEndpointsBadRequestResponseBody{
+// NewGetMcpEndpointCanaryBadRequestResponseBody builds the HTTP response body
+// from the result of the "getMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewGetMcpEndpointCanaryBadRequestResponseBody(res *goa.ServiceError) *GetMcpEndpointCanaryBadRequestResponseBody {
+	body := &GetMcpEndpointCanaryBadRequestResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1696,10 +3726,11 @@ func NewListMcpEndpointsBadRequestResponseBody(res *goa.ServiceError) *ListMcpEn
 	return body
 }
 
-// NewListMcpEndpointsNotFoundResponseBody builds the HTTP response body from
-// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
-func NewListMcpEndpointsNotFoundResponseBody(res *goa.ServiceError) *ListMcpEndpointsNotFoundResponseBody {
-	body := &ListMcpEndpointsNotFoundResponseBody{
+// NewGetMcpEndpointCanaryNotFoundResponseBody builds the HTTP response body
+// from the result of the "getMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewGetMcpEndpointCanaryNotFoundResponseBody(res *goa.ServiceError) *GetMcpEndpointCanaryNotFoundResponseBody {
+	body := &GetMcpEndpointCanaryNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1710,10 +3741,11 @@ func NewListMcpEndpointsNotFoundResponseBody(res *goa.ServiceError) *ListMcpEndp
 	return body
 }
 
-// NewListMcpEndpointsConflictResponseBody builds the HTTP response body from
-// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
-func NewListMcpEndpointsConflictResponseBody(res *goa.ServiceError) *ListMcpEndpointsConflictResponseBody {
-	body := &ListMcpEndpointsConflictResponseBody{
+// NewGetMcpEndpointCanaryConflictResponseBody builds the HTTP response body
+// from the result of the "getMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewGetMcpEndpointCanaryConflictResponseBody(res *goa.ServiceError) *GetMcpEndpointCanaryConflictResponseBody {
+	body := &GetMcpEndpointCanaryConflictResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1724,11 +3756,11 @@ func NewListMcpEndpointsConflictResponseBody(res *goa.ServiceError) *ListMcpEndp
 	return body
 }
 
-// NewListMcpEndpointsUnsupportedMediaResponseBody builds the HTTP response
-// body from the result of the "listMcpEndpoints" endpoint of the
+// NewGetMcpEndpointCanaryUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "getMcpEndpointCanary" endpoint of the
 // "mcpEndpoints" service.
-func NewListMcpEndpointsUnsupportedMediaResponseBody(res *goa.ServiceError) *ListMcpEndpointsUnsupportedMediaResponseBody {
-	body := &ListMcpEndpointsUnsupportedMediaResponseBody{
+func NewGetMcpEndpointCanaryUnsupportedMediaResponseBody(res *goa.ServiceError) *GetMcpEndpointCanaryUnsupportedMediaResponseBody {
+	body := &GetMcpEndpointCanaryUnsupportedMediaResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1739,10 +3771,11 @@ func NewListMcpEndpointsUnsupportedMediaResponseBody(res *goa.ServiceError) *Lis
 	return body
 }
 
-// NewListMcpEndpointsInvalidResponseBody builds the HTTP response body from
-// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
-func NewListMcpEndpointsInvalidResponseBody(res *goa.ServiceError) *ListMcpEndpointsInvalidResponseBody {
-	body := &ListMcpEndpointsInvalidResponseBody{
+// NewGetMcpEndpointCanaryInvalidResponseBody builds the HTTP response body
+// from the result of the "getMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewGetMcpEndpointCanaryInvalidResponseBody(res *goa.ServiceError) *GetMcpEndpointCanaryInvalidResponseBody {
+	body := &GetMcpEndpointCanaryInvalidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1753,11 +3786,11 @@ func NewListMcpEndpointsInvalidResponseBody(res *goa.ServiceError) *ListMcpEndpo
 	return body
 }
 
-// NewListMcpEndpointsInvariantViolationResponseBody builds the HTTP response
-// body from the result of the "listMcpEndpoints" endpoint of the
+// NewGetMcpEndpointCanaryInvariantViolationResponseBody builds the HTTP
+// response body from the result of the "getMcpEndpointCanary" endpoint of the
 // "mcpEndpoints" service.
-func NewListMcpEndpointsInvariantViolationResponseBody(res *goa.ServiceError) *ListMcpEndpointsInvariantViolationResponseBody {
-	body := &ListMcpEndpointsInvariantViolationResponseBody{
+func NewGetMcpEndpointCanaryInvariantViolationResponseBody(res *goa.ServiceError) *GetMcpEndpointCanaryInvariantViolationResponseBody {
+	body := &GetMcpEndpointCanaryInvariantViolationResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1768,10 +3801,11 @@ func NewListMcpEndpointsInvariantViolationResponseBody(res *goa.ServiceError) *L
 	return body
 }
 
-// NewListMcpEndpointsUnexpectedResponseBody builds the HTTP response body from
-// the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints" service.
-func NewListMcpEndpointsUnexpectedResponseBody(res *goa.ServiceError) *ListMcpEndpointsUnexpectedResponseBody {
-	body := &ListMcpEndpointsUnexpectedResponseBody{
+// NewGetMcpEndpointCanaryUnexpectedResponseBody builds the HTTP response body
+// from the result of the "getMcpEndpointCanary" endpoint of the "mcpEndpoints"
+// service.
+func NewGetMcpEndpointCanaryUnexpectedResponseBody(res *goa.ServiceError) *GetMcpEndpointCanaryUnexpectedResponseBody {
+	body := &GetMcpEndpointCanaryUnexpectedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1782,11 +3816,11 @@ func NewListMcpEndpointsUnexpectedResponseBody(res *goa.ServiceError) *ListMcpEn
 	return body
 }
 
-// NewListMcpEndpointsGatewayErrorResponseBody builds the HTTP response body
-// from the result of the "listMcpEndpoints" endpoint of the "mcpEndpoints"
-// service.
-func NewListMcpEndpointsGatewayErrorResponseBody(res *goa.ServiceError) *ListMcpEndpointsGatewayErrorResponseBody {
-	body := &ListMcpEndpointsGatewayErrorResponseBody{
+// NewGetMcpEndpointCanaryGatewayErrorResponseBody builds the HTTP response
+// body from the result of the "getMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewGetMcpEndpointCanaryGatewayErrorResponseBody(res *goa.ServiceError) *GetMcpEndpointCanaryGatewayErrorResponseBody {
+	body := &GetMcpEndpointCanaryGatewayErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1797,11 +3831,11 @@ func NewListMcpEndpointsGatewayErrorResponseBody(res *goa.ServiceError) *ListMcp
 	return body
 }
 
-// NewUpdateMcpEndpointUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewUpdateMcpEndpointUnauthorizedResponseBody(res *goa.ServiceError) *UpdateMcpEndpointUnauthorizedResponseBody {
-	body := &UpdateMcpEndpointUnauthorizedResponseBody{
+// NewUpdateMcpEndpointCanaryUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "updateMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewUpdateMcpEndpointCanaryUnauthorizedResponseBody(res *goa.ServiceError) *UpdateMcpEndpointCanaryUnauthorizedResponseBody {
+	body := &UpdateMcpEndpointCanaryUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1812,10 +3846,11 @@ func NewUpdateMcpEndpointUnauthorizedResponseBody(res *goa.ServiceError) *Update
 	return body
 }
 
-// NewUpdateMcpEndpointForbiddenResponseBody builds the HTTP response body from
-// the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewUpdateMcpEndpointForbiddenResponseBody(res *goa.ServiceError) *UpdateMcpEndpointForbiddenResponseBody {
-	body := &UpdateMcpEndpointForbiddenResponseBody{
+// NewUpdateMcpEndpointCanaryForbiddenResponseBody builds the HTTP response
+// body from the result of the "updateMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewUpdateMcpEndpointCanaryForbiddenResponseBody(res *goa.ServiceError) *UpdateMcpEndpointCanaryForbiddenResponseBody {
+	body := &UpdateMcpEndpointCanaryForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1826,11 +3861,11 @@ func NewUpdateMcpEndpointForbiddenResponseBody(res *goa.ServiceError) *UpdateMcp
 	return body
 }
 
-// NewUpdateMcpEndpointBadRequestResponseBody builds the HTTP response body
-// from the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewUpdateMcpEndpointBadRequestResponseBody(res *goa.ServiceError) *UpdateMcpEndpointBadRequestResponseBody {
-	body := &UpdateMcpEndpointBadRequestResponseBody{
+// NewUpdateMcpEndpointCanaryBadRequestResponseBody builds the HTTP response
+// body from the result of the "updateMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewUpdateMcpEndpointCanaryBadRequestResponseBody(res *goa.ServiceError) *UpdateMcpEndpointCanaryBadRequestResponseBody {
+	body := &UpdateMcpEndpointCanaryBadRequestResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1841,10 +3876,11 @@ func NewUpdateMcpEndpointBadRequestResponseBody(res *goa.ServiceError) *UpdateMc
 	return body
 }
 
-// NewUpdateMcpEndpointNotFoundResponseBody builds the HTTP response body from
-// the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewUpdateMcpEndpointNotFoundResponseBody(res *goa.ServiceError) *UpdateMcpEndpointNotFoundResponseBody {
-	body := &UpdateMcpEndpointNotFoundResponseBody{
+// NewUpdateMcpEndpointCanaryNotFoundResponseBody builds the HTTP response body
+// from the result of the "updateMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewUpdateMcpEndpointCanaryNotFoundResponseBody(res *goa.ServiceError) *UpdateMcpEndpointCanaryNotFoundResponseBody {
+	body := &UpdateMcpEndpointCanaryNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1855,10 +3891,11 @@ func NewUpdateMcpEndpointNotFoundResponseBody(res *goa.ServiceError) *UpdateMcpE
 	return body
 }
 
-// NewUpdateMcpEndpointConflictResponseBody builds the HTTP response body from
-// the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewUpdateMcpEndpointConflictResponseBody(res *goa.ServiceError) *UpdateMcpEndpointConflictResponseBody {
-	body := &UpdateMcpEndpointConflictResponseBody{
+// NewUpdateMcpEndpointCanaryConflictResponseBody builds the HTTP response body
+// from the result of the "updateMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewUpdateMcpEndpointCanaryConflictResponseBody(res *goa.ServiceError) *UpdateMcpEndpointCanaryConflictResponseBody {
+	body := &UpdateMcpEndpointCanaryConflictResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1869,11 +3906,11 @@ func NewUpdateMcpEndpointConflictResponseBody(res *goa.ServiceError) *UpdateMcpE
 	return body
 }
 
-// NewUpdateMcpEndpointUnsupportedMediaResponseBody builds the HTTP response
-// body from the result of the "updateMcpEndpoint" endpoint of the
-// "mcpEndpoints" service.
-func NewUpdateMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *UpdateMcpEndpointUnsupportedMediaResponseBody {
-	body := &UpdateMcpEndpointUnsupportedMediaResponseBody{
+// NewUpdateMcpEndpointCanaryUnsupportedMediaResponseBody builds the HTTP
+// response body from the result of the "updateMcpEndpointCanary" endpoint of
+// the "mcpEndpoints" service.
+func NewUpdateMcpEndpointCanaryUnsupportedMediaResponseBody(res *goa.ServiceError) *UpdateMcpEndpointCanaryUnsupportedMediaResponseBody {
+	body := &UpdateMcpEndpointCanaryUnsupportedMediaResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1884,10 +3921,11 @@ func NewUpdateMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *Up
 	return body
 }
 
-// NewUpdateMcpEndpointInvalidResponseBody builds the HTTP response body from
-// the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewUpdateMcpEndpointInvalidResponseBody(res *goa.ServiceError) *UpdateMcpEndpointInvalidResponseBody {
-	body := &UpdateMcpEndpointInvalidResponseBody{
+// NewUpdateMcpEndpointCanaryInvalidResponseBody builds the HTTP response body
+// from the result of the "updateMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewUpdateMcpEndpointCanaryInvalidResponseBody(res *goa.ServiceError) *UpdateMcpEndpointCanaryInvalidResponseBody {
+	body := &UpdateMcpEndpointCanaryInvalidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1898,11 +3936,11 @@ func NewUpdateMcpEndpointInvalidResponseBody(res *goa.ServiceError) *UpdateMcpEn
 	return body
 }
 
-// NewUpdateMcpEndpointInvariantViolationResponseBody builds the HTTP response
-// body from the result of the "updateMcpEndpoint" endpoint of the
-// "mcpEndpoints" service.
-func NewUpdateMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *UpdateMcpEndpointInvariantViolationResponseBody {
-	body := &UpdateMcpEndpointInvariantViolationResponseBody{
+// NewUpdateMcpEndpointCanaryInvariantViolationResponseBody builds the HTTP
+// response body from the result of the "updateMcpEndpointCanary" endpoint of
+// the "mcpEndpoints" service.
+func NewUpdateMcpEndpointCanaryInvariantViolationResponseBody(res *goa.ServiceError) *UpdateMcpEndpointCanaryInvariantViolationResponseBody {
+	body := &UpdateMcpEndpointCanaryInvariantViolationResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1913,11 +3951,11 @@ func NewUpdateMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *
 	return body
 }
 
-// NewUpdateMcpEndpointUnexpectedResponseBody builds the HTTP response body
-// from the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewUpdateMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *UpdateMcpEndpointUnexpectedResponseBody {
-	body := &UpdateMcpEndpointUnexpectedResponseBody{
+// NewUpdateMcpEndpointCanaryUnexpectedResponseBody builds the HTTP response
+// body from the result of the "updateMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewUpdateMcpEndpointCanaryUnexpectedResponseBody(res *goa.ServiceError) *UpdateMcpEndpointCanaryUnexpectedResponseBody {
+	body := &UpdateMcpEndpointCanaryUnexpectedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1928,11 +3966,11 @@ func NewUpdateMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *UpdateMc
 	return body
 }
 
-// NewUpdateMcpEndpointGatewayErrorResponseBody builds the HTTP response body
-// from the result of the "updateMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewUpdateMcpEndpointGatewayErrorResponseBody(res *goa.ServiceError) *UpdateMcpEndpointGatewayErrorResponseBody {
-	body := &UpdateMcpEndpointGatewayErrorResponseBody{
+// NewUpdateMcpEndpointCanaryGatewayErrorResponseBody builds the HTTP response
+// body from the result of the "updateMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewUpdateMcpEndpointCanaryGatewayErrorResponseBody(res *goa.ServiceError) *UpdateMcpEndpointCanaryGatewayErrorResponseBody {
+	body := &UpdateMcpEndpointCanaryGatewayErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1943,11 +3981,11 @@ func NewUpdateMcpEndpointGatewayErrorResponseBody(res *goa.ServiceError) *Update
 	return body
 }
 
-// NewCheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody builds the HTTP
-// response body from the result of the "checkMcpEndpointSlugAvailability"
-// endpoint of the "mcpEndpoints" service.
-func NewCheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody {
-	body := &CheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody{
+// NewPromoteMcpEndpointCanaryUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "promoteMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewPromoteMcpEndpointCanaryUnauthorizedResponseBody(res *goa.ServiceError) *PromoteMcpEndpointCanaryUnauthorizedResponseBody {
+	body := &PromoteMcpEndpointCanaryUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1958,11 +3996,11 @@ func NewCheckMcpEndpointSlugAvailabilityUnauthorizedResponseBody(res *goa.Servic
 	return body
 }
 
-// NewCheckMcpEndpointSlugAvailabilityForbiddenResponseBody builds the HTTP
-// response body from the result of the "checkMcpEndpointSlugAvailability"
-// endpoint of the "mcpEndpoints" service.
-func NewCheckMcpEndpointSlugAvailabilityForbiddenResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityForbiddenResponseBody {
-	body := &CheckMcpEndpointSlugAvailabilityForbiddenResponseBody{
+// NewPromoteMcpEndpointCanaryForbiddenResponseBody builds the HTTP response
+// body from the result of the "promoteMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewPromoteMcpEndpointCanaryForbiddenResponseBody(res *goa.ServiceError) *PromoteMcpEndpointCanaryForbiddenResponseBody {
+	body := &PromoteMcpEndpointCanaryForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1973,11 +4011,11 @@ func NewCheckMcpEndpointSlugAvailabilityForbiddenResponseBody(res *goa.ServiceEr
 	return body
 }
 
-// NewCheckMcpEndpointSlugAvailabilityBadRequestResponseBody builds the HTTP
-// response body from the result of the "checkMcpEndpointSlugAvailability"
-// endpoint of the "mcpEndpoints" service.
-func NewCheckMcpEndpointSlugAvailabilityBadRequestResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityBadRequestResponseBody {
-	body := &CheckMcpEndpointSlugAvailabilityBadRequestResponseBody{
+// NewPromoteMcpEndpointCanaryBadRequestResponseBody builds the HTTP response
+// body from the result of the "promoteMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewPromoteMcpEndpointCanaryBadRequestResponseBody(res *goa.ServiceError) *PromoteMcpEndpointCanaryBadRequestResponseBody {
+	body := &PromoteMcpEndpointCanaryBadRequestResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1988,11 +4026,11 @@ func NewCheckMcpEndpointSlugAvailabilityBadRequestResponseBody(res *goa.ServiceE
 	return body
 }
 
-// NewCheckMcpEndpointSlugAvailabilityNotFoundResponseBody builds the HTTP
-// response body from the result of the "checkMcpEndpointSlugAvailability"
-// endpoint of the "mcpEndpoints" service.
-func NewCheckMcpEndpointSlugAvailabilityNotFoundResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityNotFoundResponseBody {
-	body := &CheckMcpEndpointSlugAvailabilityNotFoundResponseBody{
+// NewPromoteMcpEndpointCanaryNotFoundResponseBody builds the HTTP response
+// body from the result of the "promoteMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewPromoteMcpEndpointCanaryNotFoundResponseBody(res *goa.ServiceError) *PromoteMcpEndpointCanaryNotFoundResponseBody {
+	body := &PromoteMcpEndpointCanaryNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2003,11 +4041,11 @@ func NewCheckMcpEndpointSlugAvailabilityNotFoundResponseBody(res *goa.ServiceErr
 	return body
 }
 
-// NewCheckMcpEndpointSlugAvailabilityConflictResponseBody builds the HTTP
-// response body from the result of the "checkMcpEndpointSlugAvailability"
-// endpoint of the "mcpEndpoints" service.
-func NewCheckMcpEndpointSlugAvailabilityConflictResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityConflictResponseBody {
-	body := &CheckMcpEndpointSlugAvailabilityConflictResponseBody{
+// NewPromoteMcpEndpointCanaryConflictResponseBody builds the HTTP response
+// body from the result of the "promoteMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewPromoteMcpEndpointCanaryConflictResponseBody(res *goa.ServiceError) *PromoteMcpEndpointCanaryConflictResponseBody {
+	body := &PromoteMcpEndpointCanaryConflictResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2018,11 +4056,11 @@ func NewCheckMcpEndpointSlugAvailabilityConflictResponseBody(res *goa.ServiceErr
 	return body
 }
 
-// NewCheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody builds the
-// HTTP response body from the result of the "checkMcpEndpointSlugAvailability"
-// endpoint of the "mcpEndpoints" service.
-func NewCheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody {
-	body := &CheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody{
+// NewPromoteMcpEndpointCanaryUnsupportedMediaResponseBody builds the HTTP
+// response body from the result of the "promoteMcpEndpointCanary" endpoint of
+// the "mcpEndpoints" service.
+func NewPromoteMcpEndpointCanaryUnsupportedMediaResponseBody(res *goa.ServiceError) *PromoteMcpEndpointCanaryUnsupportedMediaResponseBody {
+	body := &PromoteMcpEndpointCanaryUnsupportedMediaResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2033,11 +4071,11 @@ func NewCheckMcpEndpointSlugAvailabilityUnsupportedMediaResponseBody(res *goa.Se
 	return body
 }
 
-// NewCheckMcpEndpointSlugAvailabilityInvalidResponseBody builds the HTTP
-// response body from the result of the "checkMcpEndpointSlugAvailability"
-// endpoint of the "mcpEndpoints" service.
-func NewCheckMcpEndpointSlugAvailabilityInvalidResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityInvalidResponseBody {
-	body := &CheckMcpEndpointSlugAvailabilityInvalidResponseBody{
+// NewPromoteMcpEndpointCanaryInvalidResponseBody builds the HTTP response body
+// from the result of the "promoteMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewPromoteMcpEndpointCanaryInvalidResponseBody(res *goa.ServiceError) *PromoteMcpEndpointCanaryInvalidResponseBody {
+	body := &PromoteMcpEndpointCanaryInvalidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2048,11 +4086,11 @@ func NewCheckMcpEndpointSlugAvailabilityInvalidResponseBody(res *goa.ServiceErro
 	return body
 }
 
-// NewCheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody builds the
-// HTTP response body from the result of the "checkMcpEndpointSlugAvailability"
-// endpoint of the "mcpEndpoints" service.
-func NewCheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody {
-	body := &CheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody{
+// NewPromoteMcpEndpointCanaryInvariantViolationResponseBody builds the HTTP
+// response body from the result of the "promoteMcpEndpointCanary" endpoint of
+// the "mcpEndpoints" service.
+func NewPromoteMcpEndpointCanaryInvariantViolationResponseBody(res *goa.ServiceError) *PromoteMcpEndpointCanaryInvariantViolationResponseBody {
+	body := &PromoteMcpEndpointCanaryInvariantViolationResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2063,11 +4101,11 @@ func NewCheckMcpEndpointSlugAvailabilityInvariantViolationResponseBody(res *goa.
 	return body
 }
 
-// NewCheckMcpEndpointSlugAvailabilityUnexpectedResponseBody builds the HTTP
-// response body from the result of the "checkMcpEndpointSlugAvailability"
-// endpoint of the "mcpEndpoints" service.
-func NewCheckMcpEndpointSlugAvailabilityUnexpectedResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityUnexpectedResponseBody {
-	body := &CheckMcpEndpointSlugAvailabilityUnexpectedResponseBody{
+// NewPromoteMcpEndpointCanaryUnexpectedResponseBody builds the HTTP response
+// body from the result of the "promoteMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewPromoteMcpEndpointCanaryUnexpectedResponseBody(res *goa.ServiceError) *PromoteMcpEndpointCanaryUnexpectedResponseBody {
+	body := &PromoteMcpEndpointCanaryUnexpectedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2078,11 +4116,11 @@ func NewCheckMcpEndpointSlugAvailabilityUnexpectedResponseBody(res *goa.ServiceE
 	return body
 }
 
-// NewCheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody builds the HTTP
-// response body from the result of the "checkMcpEndpointSlugAvailability"
-// endpoint of the "mcpEndpoints" service.
-func NewCheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody(res *goa.ServiceError) *CheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody {
-	body := &CheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody{
+// NewPromoteMcpEndpointCanaryGatewayErrorResponseBody builds the HTTP response
+// body from the result of the "promoteMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewPromoteMcpEndpointCanaryGatewayErrorResponseBody(res *goa.ServiceError) *PromoteMcpEndpointCanaryGatewayErrorResponseBody {
+	body := &PromoteMcpEndpointCanaryGatewayErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2093,11 +4131,11 @@ func NewCheckMcpEndpointSlugAvailabilityGatewayErrorResponseBody(res *goa.Servic
 	return body
 }
 
-// NewDeleteMcpEndpointUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewDeleteMcpEndpointUnauthorizedResponseBody(res *goa.ServiceError) *DeleteMcpEndpointUnauthorizedResponseBody {
-	body := &DeleteMcpEndpointUnauthorizedResponseBody{
+// NewAbortMcpEndpointCanaryUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "abortMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewAbortMcpEndpointCanaryUnauthorizedResponseBody(res *goa.ServiceError) *AbortMcpEndpointCanaryUnauthorizedResponseBody {
+	body := &AbortMcpEndpointCanaryUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2108,10 +4146,11 @@ func NewDeleteMcpEndpointUnauthorizedResponseBody(res *goa.ServiceError) *Delete
 	return body
 }
 
-// NewDeleteMcpEndpointForbiddenResponseBody builds the HTTP response body from
-// the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewDeleteMcpEndpointForbiddenResponseBody(res *goa.ServiceError) *DeleteMcpEndpointForbiddenResponseBody {
-	body := &DeleteMcpEndpointForbiddenResponseBody{
+// NewAbortMcpEndpointCanaryForbiddenResponseBody builds the HTTP response body
+// from the result of the "abortMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewAbortMcpEndpointCanaryForbiddenResponseBody(res *goa.ServiceError) *AbortMcpEndpointCanaryForbiddenResponseBody {
+	body := &AbortMcpEndpointCanaryForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2122,11 +4161,11 @@ func NewDeleteMcpEndpointForbiddenResponseBody(res *goa.ServiceError) *DeleteMcp
 	return body
 }
 
-// NewDeleteMcpEndpointBadRequestResponseBody builds the HTTP response body
-// from the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewDeleteMcpEndpointBadRequestResponseBody(res *goa.ServiceError) *DeleteMcpEndpointBadRequestResponseBody {
-	body := &DeleteMcpEndpointBadRequestResponseBody{
+// NewAbortMcpEndpointCanaryBadRequestResponseBody builds the HTTP response
+// body from the result of the "abortMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewAbortMcpEndpointCanaryBadRequestResponseBody(res *goa.ServiceError) *AbortMcpEndpointCanaryBadRequestResponseBody {
+	body := &AbortMcpEndpointCanaryBadRequestResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2137,10 +4176,11 @@ func NewDeleteMcpEndpointBadRequestResponseBody(res *goa.ServiceError) *DeleteMc
 	return body
 }
 
-// NewDeleteMcpEndpointNotFoundResponseBody builds the HTTP response body from
-// the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewDeleteMcpEndpointNotFoundResponseBody(res *goa.ServiceError) *DeleteMcpEndpointNotFoundResponseBody {
-	body := &DeleteMcpEndpointNotFoundResponseBody{
+// NewAbortMcpEndpointCanaryNotFoundResponseBody builds the HTTP response body
+// from the result of the "abortMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewAbortMcpEndpointCanaryNotFoundResponseBody(res *goa.ServiceError) *AbortMcpEndpointCanaryNotFoundResponseBody {
+	body := &AbortMcpEndpointCanaryNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2151,10 +4191,11 @@ func NewDeleteMcpEndpointNotFoundResponseBody(res *goa.ServiceError) *DeleteMcpE
 	return body
 }
 
-// NewDeleteMcpEndpointConflictResponseBody builds the HTTP response body from
-// the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewDeleteMcpEndpointConflictResponseBody(res *goa.ServiceError) *DeleteMcpEndpointConflictResponseBody {
-	body := &DeleteMcpEndpointConflictResponseBody{
+// NewAbortMcpEndpointCanaryConflictResponseBody builds the HTTP response body
+// from the result of the "abortMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewAbortMcpEndpointCanaryConflictResponseBody(res *goa.ServiceError) *AbortMcpEndpointCanaryConflictResponseBody {
+	body := &AbortMcpEndpointCanaryConflictResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2165,11 +4206,11 @@ func NewDeleteMcpEndpointConflictResponseBody(res *goa.ServiceError) *DeleteMcpE
 	return body
 }
 
-// NewDeleteMcpEndpointUnsupportedMediaResponseBody builds the HTTP response
-// body from the result of the "deleteMcpEndpoint" endpoint of the
-// "mcpEndpoints" service.
-func NewDeleteMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *DeleteMcpEndpointUnsupportedMediaResponseBody {
-	body := &DeleteMcpEndpointUnsupportedMediaResponseBody{
+// NewAbortMcpEndpointCanaryUnsupportedMediaResponseBody builds the HTTP
+// response body from the result of the "abortMcpEndpointCanary" endpoint of
+// the "mcpEndpoints" service.
+func NewAbortMcpEndpointCanaryUnsupportedMediaResponseBody(res *goa.ServiceError) *AbortMcpEndpointCanaryUnsupportedMediaResponseBody {
+	body := &AbortMcpEndpointCanaryUnsupportedMediaResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2180,10 +4221,11 @@ func NewDeleteMcpEndpointUnsupportedMediaResponseBody(res *goa.ServiceError) *De
 	return body
 }
 
-// NewDeleteMcpEndpointInvalidResponseBody builds the HTTP response body from
-// the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints" service.
-func NewDeleteMcpEndpointInvalidResponseBody(res *goa.ServiceError) *DeleteMcpEndpointInvalidResponseBody {
-	body := &DeleteMcpEndpointInvalidResponseBody{
+// NewAbortMcpEndpointCanaryInvalidResponseBody builds the HTTP response body
+// from the result of the "abortMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewAbortMcpEndpointCanaryInvalidResponseBody(res *goa.ServiceError) *AbortMcpEndpointCanaryInvalidResponseBody {
+	body := &AbortMcpEndpointCanaryInvalidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2194,11 +4236,11 @@ func NewDeleteMcpEndpointInvalidResponseBody(res *goa.ServiceError) *DeleteMcpEn
 	return body
 }
 
-// NewDeleteMcpEndpointInvariantViolationResponseBody builds the HTTP response
-// body from the result of the "deleteMcpEndpoint" endpoint of the
-// "mcpEndpoints" service.
-func NewDeleteMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *DeleteMcpEndpointInvariantViolationResponseBody {
-	body := &DeleteMcpEndpointInvariantViolationResponseBody{
+// NewAbortMcpEndpointCanaryInvariantViolationResponseBody builds the HTTP
+// response body from the result of the "abortMcpEndpointCanary" endpoint of
+// the "mcpEndpoints" service.
+func NewAbortMcpEndpointCanaryInvariantViolationResponseBody(res *goa.ServiceError) *AbortMcpEndpointCanaryInvariantViolationResponseBody {
+	body := &AbortMcpEndpointCanaryInvariantViolationResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2209,11 +4251,11 @@ func NewDeleteMcpEndpointInvariantViolationResponseBody(res *goa.ServiceError) *
 	return body
 }
 
-// NewDeleteMcpEndpointUnexpectedResponseBody builds the HTTP response body
-// from the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewDeleteMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *DeleteMcpEndpointUnexpectedResponseBody {
-	body := &DeleteMcpEndpointUnexpectedResponseBody{
+// NewAbortMcpEndpointCanaryUnexpectedResponseBody builds the HTTP response
+// body from the result of the "abortMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewAbortMcpEndpointCanaryUnexpectedResponseBody(res *goa.ServiceError) *AbortMcpEndpointCanaryUnexpectedResponseBody {
+	body := &AbortMcpEndpointCanaryUnexpectedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2224,11 +4266,11 @@ func NewDeleteMcpEndpointUnexpectedResponseBody(res *goa.ServiceError) *DeleteMc
 	return body
 }
 
-// NewDeleteMcpEndpointGatewayErrorResponseBody builds the HTTP response body
-// from the result of the "deleteMcpEndpoint" endpoint of the "mcpEndpoints"
-// service.
-func NewDeleteMcpEndpointGatewayErrorResponseBody(res *goa.ServiceError) *DeleteMcpEndpointGatewayErrorResponseBody {
-	body := &DeleteMcpEndpointGatewayErrorResponseBody{
+// NewAbortMcpEndpointCanaryGatewayErrorResponseBody builds the HTTP response
+// body from the result of the "abortMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+func NewAbortMcpEndpointCanaryGatewayErrorResponseBody(res *goa.ServiceError) *AbortMcpEndpointCanaryGatewayErrorResponseBody {
+	body := &AbortMcpEndpointCanaryGatewayErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -2246,6 +4288,7 @@ func NewCreateMcpEndpointPayload(body *CreateMcpEndpointRequestBody, sessionToke
 		CustomDomainID:  body.CustomDomainID,
 		McpServerID:     body.McpServerID,
 		MetaMcpServerID: body.MetaMcpServerID,
+		ToolsetVersion:  body.ToolsetVersion,
 		Slug:            types.McpEndpointSlug(*body.Slug),
 	}
 	v.SessionToken = sessionToken
@@ -2293,6 +4336,7 @@ func NewUpdateMcpEndpointPayload(body *UpdateMcpEndpointRequestBody, sessionToke
 		CustomDomainID:  body.CustomDomainID,
 		McpServerID:     body.McpServerID,
 		MetaMcpServerID: body.MetaMcpServerID,
+		ToolsetVersion:  body.ToolsetVersion,
 		Slug:            types.McpEndpointSlug(*body.Slug),
 	}
 	v.SessionToken = sessionToken
@@ -2327,6 +4371,80 @@ func NewDeleteMcpEndpointPayload(id string, sessionToken *string, apikeyToken *s
 	return v
 }
 
+// NewStartMcpEndpointCanaryPayload builds a mcpEndpoints service
+// startMcpEndpointCanary endpoint payload.
+func NewStartMcpEndpointCanaryPayload(body *StartMcpEndpointCanaryRequestBody, sessionToken *string, apikeyToken *string, projectSlugInput *string) *mcpendpoints.StartMcpEndpointCanaryPayload {
+	v := &mcpendpoints.StartMcpEndpointCanaryPayload{
+		McpEndpointID:         *body.McpEndpointID,
+		BaselineDeploymentID:  body.BaselineDeploymentID,
+		CandidateDeploymentID: body.CandidateDeploymentID,
+		Percentage:            *body.Percentage,
+	}
+	if body.StickyBy != nil {
+		v.StickyBy = *body.StickyBy
+	}
+	if body.StickyBy == nil {
+		v.StickyBy = "session"
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v
+}
+
+// NewGetMcpEndpointCanaryPayload builds a mcpEndpoints service
+// getMcpEndpointCanary endpoint payload.
+func NewGetMcpEndpointCanaryPayload(mcpEndpointID string, sessionToken *string, apikeyToken *string, projectSlugInput *string) *mcpendpoints.GetMcpEndpointCanaryPayload {
+	v := &mcpendpoints.GetMcpEndpointCanaryPayload{}
+	v.McpEndpointID = mcpEndpointID
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v
+}
+
+// NewUpdateMcpEndpointCanaryPayload builds a mcpEndpoints service
+// updateMcpEndpointCanary endpoint payload.
+func NewUpdateMcpEndpointCanaryPayload(body *UpdateMcpEndpointCanaryRequestBody, sessionToken *string, apikeyToken *string, projectSlugInput *string) *mcpendpoints.UpdateMcpEndpointCanaryPayload {
+	v := &mcpendpoints.UpdateMcpEndpointCanaryPayload{
+		McpEndpointID: *body.McpEndpointID,
+		Percentage:    *body.Percentage,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v
+}
+
+// NewPromoteMcpEndpointCanaryPayload builds a mcpEndpoints service
+// promoteMcpEndpointCanary endpoint payload.
+func NewPromoteMcpEndpointCanaryPayload(body *PromoteMcpEndpointCanaryRequestBody, sessionToken *string, apikeyToken *string, projectSlugInput *string) *mcpendpoints.PromoteMcpEndpointCanaryPayload {
+	v := &mcpendpoints.PromoteMcpEndpointCanaryPayload{
+		McpEndpointID: *body.McpEndpointID,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v
+}
+
+// NewAbortMcpEndpointCanaryPayload builds a mcpEndpoints service
+// abortMcpEndpointCanary endpoint payload.
+func NewAbortMcpEndpointCanaryPayload(body *AbortMcpEndpointCanaryRequestBody, sessionToken *string, apikeyToken *string, projectSlugInput *string) *mcpendpoints.AbortMcpEndpointCanaryPayload {
+	v := &mcpendpoints.AbortMcpEndpointCanaryPayload{
+		McpEndpointID: *body.McpEndpointID,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v
+}
+
 // ValidateCreateMcpEndpointRequestBody runs the validations defined on
 // CreateMcpEndpointRequestBody
 func ValidateCreateMcpEndpointRequestBody(body *CreateMcpEndpointRequestBody) (err error) {
@@ -2342,6 +4460,11 @@ func ValidateCreateMcpEndpointRequestBody(body *CreateMcpEndpointRequestBody) (e
 	if body.MetaMcpServerID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.meta_mcp_server_id", *body.MetaMcpServerID, goa.FormatUUID))
 	}
+	if body.ToolsetVersion != nil {
+		if *body.ToolsetVersion < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.toolset_version", *body.ToolsetVersion, 1, true))
+		}
+	}
 	if body.Slug != nil {
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
 	}
@@ -2374,6 +4497,11 @@ func ValidateUpdateMcpEndpointRequestBody(body *UpdateMcpEndpointRequestBody) (e
 	if body.MetaMcpServerID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.meta_mcp_server_id", *body.MetaMcpServerID, goa.FormatUUID))
 	}
+	if body.ToolsetVersion != nil {
+		if *body.ToolsetVersion < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.toolset_version", *body.ToolsetVersion, 1, true))
+		}
+	}
 	if body.Slug != nil {
 		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
 	}
@@ -2384,3 +4512,88 @@ func ValidateUpdateMcpEndpointRequestBody(body *UpdateMcpEndpointRequestBody) (e
 	}
 	return
 }
+
+// ValidateStartMcpEndpointCanaryRequestBody runs the validations defined on
+// StartMcpEndpointCanaryRequestBody
+func ValidateStartMcpEndpointCanaryRequestBody(body *StartMcpEndpointCanaryRequestBody) (err error) {
+	if body.McpEndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mcp_endpoint_id", "body"))
+	}
+	if body.Percentage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("percentage", "body"))
+	}
+	if body.McpEndpointID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", *body.McpEndpointID, goa.FormatUUID))
+	}
+	if body.BaselineDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.baseline_deployment_id", *body.BaselineDeploymentID, goa.FormatUUID))
+	}
+	if body.CandidateDeploymentID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.candidate_deployment_id", *body.CandidateDeploymentID, goa.FormatUUID))
+	}
+	if body.Percentage != nil {
+		if *body.Percentage < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 0, true))
+		}
+	}
+	if body.Percentage != nil {
+		if *body.Percentage > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 100, false))
+		}
+	}
+	if body.StickyBy != nil {
+		if !(*body.StickyBy == "session" || *body.StickyBy == "user") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.sticky_by", *body.StickyBy, []any{"session", "user"}))
+		}
+	}
+	return
+}
+
+// ValidateUpdateMcpEndpointCanaryRequestBody runs the validations defined on
+// UpdateMcpEndpointCanaryRequestBody
+func ValidateUpdateMcpEndpointCanaryRequestBody(body *UpdateMcpEndpointCanaryRequestBody) (err error) {
+	if body.McpEndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mcp_endpoint_id", "body"))
+	}
+	if body.Percentage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("percentage", "body"))
+	}
+	if body.McpEndpointID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", *body.McpEndpointID, goa.FormatUUID))
+	}
+	if body.Percentage != nil {
+		if *body.Percentage < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 0, true))
+		}
+	}
+	if body.Percentage != nil {
+		if *body.Percentage > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.percentage", *body.Percentage, 100, false))
+		}
+	}
+	return
+}
+
+// ValidatePromoteMcpEndpointCanaryRequestBody runs the validations defined on
+// PromoteMcpEndpointCanaryRequestBody
+func ValidatePromoteMcpEndpointCanaryRequestBody(body *PromoteMcpEndpointCanaryRequestBody) (err error) {
+	if body.McpEndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mcp_endpoint_id", "body"))
+	}
+	if body.McpEndpointID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", *body.McpEndpointID, goa.FormatUUID))
+	}
+	return
+}
+
+// ValidateAbortMcpEndpointCanaryRequestBody runs the validations defined on
+// AbortMcpEndpointCanaryRequestBody
+func ValidateAbortMcpEndpointCanaryRequestBody(body *AbortMcpEndpointCanaryRequestBody) (err error) {
+	if body.McpEndpointID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mcp_endpoint_id", "body"))
+	}
+	if body.McpEndpointID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_endpoint_id", *body.McpEndpointID, goa.FormatUUID))
+	}
+	return
+}
@@ -2924,6 +2924,7 @@ func unmarshalMcpServerResponseBodyToTypesMcpServer(v *McpServerResponseBody) *t
 		ToolsetID:             v.ToolsetID,
 		UnproxiedMcpServerID:  v.UnproxiedMcpServerID,
 		ToolVariationsGroupID: v.ToolVariationsGroupID,
+		ToolsetVersion:        v.ToolsetVersion,
 		Visibility:            types.McpServerVisibility(*v.Visibility),
 		CreatedAt:             *v.CreatedAt,
 		UpdatedAt:             *v.UpdatedAt,
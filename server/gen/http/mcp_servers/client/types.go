@@ -31,6 +31,9 @@ type CreateMcpServerRequestBody struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server. Omit to leave filtering disabled.
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// Pin the server to this version of its toolset instead of serving the latest.
+	// Only valid with toolset_id.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility string `form:"visibility" json:"visibility" xml:"visibility"`
 }
@@ -57,6 +60,9 @@ type UpdateMcpServerRequestBody struct {
 	// server. Omit to disable filtering (cleared to null, consistent with the
 	// full-record replace semantics of the other UUID references).
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// Pin the server to this version of its toolset instead of serving the latest.
+	// Only valid with toolset_id. Omit to serve the latest version.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility string `form:"visibility" json:"visibility" xml:"visibility"`
 }
@@ -129,6 +135,9 @@ type CreateMcpServerResponseBody struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server, if any.
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// The toolset version this server is pinned to, if any. Unpinned servers serve
+	// the latest toolset version.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility *string `form:"visibility,omitempty" json:"visibility,omitempty" xml:"visibility,omitempty"`
 	// When the MCP server was created
@@ -165,6 +174,9 @@ type GetMcpServerResponseBody struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server, if any.
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// The toolset version this server is pinned to, if any. Unpinned servers serve
+	// the latest toolset version.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility *string `form:"visibility,omitempty" json:"visibility,omitempty" xml:"visibility,omitempty"`
 	// When the MCP server was created
@@ -213,6 +225,9 @@ type UpdateMcpServerResponseBody struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server, if any.
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// The toolset version this server is pinned to, if any. Unpinned servers serve
+	// the latest toolset version.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility *string `form:"visibility,omitempty" json:"visibility,omitempty" xml:"visibility,omitempty"`
 	// When the MCP server was created
@@ -2562,6 +2577,9 @@ type McpServerResponseBody struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server, if any.
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// The toolset version this server is pinned to, if any. Unpinned servers serve
+	// the latest toolset version.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility *string `form:"visibility,omitempty" json:"visibility,omitempty" xml:"visibility,omitempty"`
 	// When the MCP server was created
@@ -2643,6 +2661,7 @@ func NewCreateMcpServerRequestBody(p *mcpservers.CreateMcpServerPayload) *Create
 		ToolsetID:             p.ToolsetID,
 		UnproxiedMcpServerID:  p.UnproxiedMcpServerID,
 		ToolVariationsGroupID: p.ToolVariationsGroupID,
+		ToolsetVersion:        p.ToolsetVersion,
 		Visibility:            string(p.Visibility),
 	}
 	return body
@@ -2660,6 +2679,7 @@ func NewUpdateMcpServerRequestBody(p *mcpservers.UpdateMcpServerPayload) *Update
 		ToolsetID:             p.ToolsetID,
 		UnproxiedMcpServerID:  p.UnproxiedMcpServerID,
 		ToolVariationsGroupID: p.ToolVariationsGroupID,
+		ToolsetVersion:        p.ToolsetVersion,
 		Visibility:            string(p.Visibility),
 	}
 	return body
@@ -2737,6 +2757,7 @@ func NewCreateMcpServerMcpServerOK(body *CreateMcpServerResponseBody) *types.Mcp
 		ToolsetID:             body.ToolsetID,
 		UnproxiedMcpServerID:  body.UnproxiedMcpServerID,
 		ToolVariationsGroupID: body.ToolVariationsGroupID,
+		ToolsetVersion:        body.ToolsetVersion,
 		Visibility:            types.McpServerVisibility(*body.Visibility),
 		CreatedAt:             *body.CreatedAt,
 		UpdatedAt:             *body.UpdatedAt,
@@ -2910,6 +2931,7 @@ func NewGetMcpServerMcpServerOK(body *GetMcpServerResponseBody) *types.McpServer
 		ToolsetID:             body.ToolsetID,
 		UnproxiedMcpServerID:  body.UnproxiedMcpServerID,
 		ToolVariationsGroupID: body.ToolVariationsGroupID,
+		ToolsetVersion:        body.ToolsetVersion,
 		Visibility:            types.McpServerVisibility(*body.Visibility),
 		CreatedAt:             *body.CreatedAt,
 		UpdatedAt:             *body.UpdatedAt,
@@ -3415,6 +3437,7 @@ func NewUpdateMcpServerMcpServerOK(body *UpdateMcpServerResponseBody) *types.Mcp
 		ToolsetID:             body.ToolsetID,
 		UnproxiedMcpServerID:  body.UnproxiedMcpServerID,
 		ToolVariationsGroupID: body.ToolVariationsGroupID,
+		ToolsetVersion:        body.ToolsetVersion,
 		Visibility:            types.McpServerVisibility(*body.Visibility),
 		CreatedAt:             *body.CreatedAt,
 		UpdatedAt:             *body.UpdatedAt,
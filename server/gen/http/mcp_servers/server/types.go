@@ -31,6 +31,9 @@ type CreateMcpServerRequestBody struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server. Omit to leave filtering disabled.
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// Pin the server to this version of its toolset instead of serving the latest.
+	// Only valid with toolset_id.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility *string `form:"visibility,omitempty" json:"visibility,omitempty" xml:"visibility,omitempty"`
 }
@@ -57,6 +60,9 @@ type UpdateMcpServerRequestBody struct {
 	// server. Omit to disable filtering (cleared to null, consistent with the
 	// full-record replace semantics of the other UUID references).
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// Pin the server to this version of its toolset instead of serving the latest.
+	// Only valid with toolset_id. Omit to serve the latest version.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility *string `form:"visibility,omitempty" json:"visibility,omitempty" xml:"visibility,omitempty"`
 }
@@ -129,6 +135,9 @@ type CreateMcpServerResponseBody struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server, if any.
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// The toolset version this server is pinned to, if any. Unpinned servers serve
+	// the latest toolset version.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility string `form:"visibility" json:"visibility" xml:"visibility"`
 	// When the MCP server was created
@@ -165,6 +174,9 @@ type GetMcpServerResponseBody struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server, if any.
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// The toolset version this server is pinned to, if any. Unpinned servers serve
+	// the latest toolset version.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility string `form:"visibility" json:"visibility" xml:"visibility"`
 	// When the MCP server was created
@@ -213,6 +225,9 @@ type UpdateMcpServerResponseBody struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server, if any.
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// The toolset version this server is pinned to, if any. Unpinned servers serve
+	// the latest toolset version.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility string `form:"visibility" json:"visibility" xml:"visibility"`
 	// When the MCP server was created
@@ -2562,6 +2577,9 @@ type McpServerResponseBody struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server, if any.
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// The toolset version this server is pinned to, if any. Unpinned servers serve
+	// the latest toolset version.
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
 	// The visibility of the server
 	Visibility string `form:"visibility" json:"visibility" xml:"visibility"`
 	// When the MCP server was created
@@ -2647,6 +2665,7 @@ func NewCreateMcpServerResponseBody(res *types.McpServer) *CreateMcpServerRespon
 		ToolsetID:             res.ToolsetID,
 		UnproxiedMcpServerID:  res.UnproxiedMcpServerID,
 		ToolVariationsGroupID: res.ToolVariationsGroupID,
+		ToolsetVersion:        res.ToolsetVersion,
 		Visibility:            string(res.Visibility),
 		CreatedAt:             res.CreatedAt,
 		UpdatedAt:             res.UpdatedAt,
@@ -2669,6 +2688,7 @@ func NewGetMcpServerResponseBody(res *types.McpServer) *GetMcpServerResponseBody
 		ToolsetID:             res.ToolsetID,
 		UnproxiedMcpServerID:  res.UnproxiedMcpServerID,
 		ToolVariationsGroupID: res.ToolVariationsGroupID,
+		ToolsetVersion:        res.ToolsetVersion,
 		Visibility:            string(res.Visibility),
 		CreatedAt:             res.CreatedAt,
 		UpdatedAt:             res.UpdatedAt,
@@ -2729,6 +2749,7 @@ func NewUpdateMcpServerResponseBody(res *types.McpServer) *UpdateMcpServerRespon
 		ToolsetID:             res.ToolsetID,
 		UnproxiedMcpServerID:  res.UnproxiedMcpServerID,
 		ToolVariationsGroupID: res.ToolVariationsGroupID,
+		ToolsetVersion:        res.ToolsetVersion,
 		Visibility:            string(res.Visibility),
 		CreatedAt:             res.CreatedAt,
 		UpdatedAt:             res.UpdatedAt,
@@ -4601,6 +4622,7 @@ func NewCreateMcpServerPayload(body *CreateMcpServerRequestBody, sessionToken *s
 		ToolsetID:             body.ToolsetID,
 		UnproxiedMcpServerID:  body.UnproxiedMcpServerID,
 		ToolVariationsGroupID: body.ToolVariationsGroupID,
+		ToolsetVersion:        body.ToolsetVersion,
 		Visibility:            types.McpServerVisibility(*body.Visibility),
 	}
 	v.SessionToken = sessionToken
@@ -4659,6 +4681,7 @@ func NewUpdateMcpServerPayload(body *UpdateMcpServerRequestBody, sessionToken *s
 		ToolsetID:             body.ToolsetID,
 		UnproxiedMcpServerID:  body.UnproxiedMcpServerID,
 		ToolVariationsGroupID: body.ToolVariationsGroupID,
+		ToolsetVersion:        body.ToolsetVersion,
 		Visibility:            types.McpServerVisibility(*body.Visibility),
 	}
 	v.SessionToken = sessionToken
@@ -4807,6 +4830,11 @@ func ValidateCreateMcpServerRequestBody(body *CreateMcpServerRequestBody) (err e
 	if body.ToolVariationsGroupID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.tool_variations_group_id", *body.ToolVariationsGroupID, goa.FormatUUID))
 	}
+	if body.ToolsetVersion != nil {
+		if *body.ToolsetVersion < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.toolset_version", *body.ToolsetVersion, 1, true))
+		}
+	}
 	if body.Visibility != nil {
 		if !(*body.Visibility == "disabled" || *body.Visibility == "private" || *body.Visibility == "public") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.visibility", *body.Visibility, []any{"disabled", "private", "public"}))
@@ -4845,6 +4873,11 @@ func ValidateUpdateMcpServerRequestBody(body *UpdateMcpServerRequestBody) (err e
 	if body.ToolVariationsGroupID != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.tool_variations_group_id", *body.ToolVariationsGroupID, goa.FormatUUID))
 	}
+	if body.ToolsetVersion != nil {
+		if *body.ToolsetVersion < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.toolset_version", *body.ToolsetVersion, 1, true))
+		}
+	}
 	if body.Visibility != nil {
 		if !(*body.Visibility == "disabled" || *body.Visibility == "private" || *body.Visibility == "public") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.visibility", *body.Visibility, []any{"disabled", "private", "public"}))
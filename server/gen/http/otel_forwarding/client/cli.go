@@ -10,8 +10,10 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"unicode/utf8"
 
 	otelforwarding "github.com/speakeasy-api/gram/server/gen/otel_forwarding"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildGetConfigPayload builds the payload for the otelForwarding getConfig
@@ -100,3 +102,283 @@ func BuildDeleteConfigPayload(otelForwardingDeleteConfigApikeyToken string, otel
 
 	return v, nil
 }
+
+// BuildListDestinationsPayload builds the payload for the otelForwarding
+// listDestinations endpoint from CLI flags.
+func BuildListDestinationsPayload(otelForwardingListDestinationsApikeyToken string, otelForwardingListDestinationsSessionToken string) (*otelforwarding.ListDestinationsPayload, error) {
+	var apikeyToken *string
+	{
+		if otelForwardingListDestinationsApikeyToken != "" {
+			apikeyToken = &otelForwardingListDestinationsApikeyToken
+		}
+	}
+	var sessionToken *string
+	{
+		if otelForwardingListDestinationsSessionToken != "" {
+			sessionToken = &otelForwardingListDestinationsSessionToken
+		}
+	}
+	v := &otelforwarding.ListDestinationsPayload{}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildCreateDestinationPayload builds the payload for the otelForwarding
+// createDestination endpoint from CLI flags.
+func BuildCreateDestinationPayload(otelForwardingCreateDestinationBody string, otelForwardingCreateDestinationApikeyToken string, otelForwardingCreateDestinationSessionToken string) (*otelforwarding.CreateDestinationPayload, error) {
+	var err error
+	var body CreateDestinationRequestBody
+	{
+		err = json.Unmarshal([]byte(otelForwardingCreateDestinationBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"enabled\": false,\n      \"endpoint_url\": \"abc123\",\n      \"filters\": {\n         \"exclude_attributes\": [\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            }\n         ],\n         \"exclude_names\": [\n            \"abc123\",\n            \"abc123\",\n            \"abc123\"\n         ],\n         \"include_attributes\": [\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            }\n         ],\n         \"include_names\": [\n            \"abc123\",\n            \"abc123\",\n            \"abc123\"\n         ]\n      },\n      \"headers\": [\n         {\n            \"name\": \"abc123\",\n            \"value\": \"abc123\"\n         }\n      ],\n      \"name\": \"aa\",\n      \"protocol\": \"http/json\",\n      \"sampling_ratio\": 1,\n      \"scrub_rules\": [\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         },\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         },\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         }\n      ]\n   }'")
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if utf8.RuneCountInString(body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 100, false))
+		}
+		if !(body.Protocol == "http/protobuf" || body.Protocol == "http/json" || body.Protocol == "grpc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.protocol", body.Protocol, []any{"http/protobuf", "http/json", "grpc"}))
+		}
+		if body.SamplingRatio < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sampling_ratio", body.SamplingRatio, 0, true))
+		}
+		if body.SamplingRatio > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sampling_ratio", body.SamplingRatio, 1, false))
+		}
+		if body.Filters != nil {
+			if err2 := ValidateOtelForwardingFiltersRequestBody(body.Filters); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if len(body.ScrubRules) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_rules", body.ScrubRules, len(body.ScrubRules), 50, false))
+		}
+		for _, e := range body.ScrubRules {
+			if e != nil {
+				if err2 := ValidateOtelForwardingScrubRuleRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var apikeyToken *string
+	{
+		if otelForwardingCreateDestinationApikeyToken != "" {
+			apikeyToken = &otelForwardingCreateDestinationApikeyToken
+		}
+	}
+	var sessionToken *string
+	{
+		if otelForwardingCreateDestinationSessionToken != "" {
+			sessionToken = &otelForwardingCreateDestinationSessionToken
+		}
+	}
+	v := &otelforwarding.CreateDestinationPayload{
+		Name:          body.Name,
+		EndpointURL:   body.EndpointURL,
+		Protocol:      body.Protocol,
+		Enabled:       body.Enabled,
+		SamplingRatio: body.SamplingRatio,
+	}
+	{
+		var zero string
+		if v.Protocol == zero {
+			v.Protocol = "http/protobuf"
+		}
+	}
+	{
+		var zero bool
+		if v.Enabled == zero {
+			v.Enabled = true
+		}
+	}
+	if body.Headers != nil {
+		v.Headers = make([]*otelforwarding.OtelForwardingHeaderInput, len(body.Headers))
+		for i, val := range body.Headers {
+			if val == nil {
+				v.Headers[i] = nil
+				continue
+			}
+			v.Headers[i] = marshalOtelForwardingHeaderInputRequestBodyToOtelforwardingOtelForwardingHeaderInput(val)
+		}
+	}
+	{
+		var zero float64
+		if v.SamplingRatio == zero {
+			v.SamplingRatio = 1
+		}
+	}
+	if body.Filters != nil {
+		v.Filters = marshalOtelForwardingFiltersRequestBodyToOtelforwardingOtelForwardingFilters(body.Filters)
+	}
+	if body.ScrubRules != nil {
+		v.ScrubRules = make([]*otelforwarding.OtelForwardingScrubRule, len(body.ScrubRules))
+		for i, val := range body.ScrubRules {
+			if val == nil {
+				v.ScrubRules[i] = nil
+				continue
+			}
+			v.ScrubRules[i] = marshalOtelForwardingScrubRuleRequestBodyToOtelforwardingOtelForwardingScrubRule(val)
+		}
+	}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildUpdateDestinationPayload builds the payload for the otelForwarding
+// updateDestination endpoint from CLI flags.
+func BuildUpdateDestinationPayload(otelForwardingUpdateDestinationBody string, otelForwardingUpdateDestinationApikeyToken string, otelForwardingUpdateDestinationSessionToken string) (*otelforwarding.UpdateDestinationPayload, error) {
+	var err error
+	var body UpdateDestinationRequestBody
+	{
+		err = json.Unmarshal([]byte(otelForwardingUpdateDestinationBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"enabled\": false,\n      \"endpoint_url\": \"abc123\",\n      \"filters\": {\n         \"exclude_attributes\": [\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            }\n         ],\n         \"exclude_names\": [\n            \"abc123\",\n            \"abc123\",\n            \"abc123\"\n         ],\n         \"include_attributes\": [\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            },\n            {\n               \"key\": \"aa\",\n               \"value\": \"aaa\"\n            }\n         ],\n         \"include_names\": [\n            \"abc123\",\n            \"abc123\",\n            \"abc123\"\n         ]\n      },\n      \"headers\": [\n         {\n            \"name\": \"abc123\",\n            \"value\": \"abc123\"\n         }\n      ],\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"name\": \"aa\",\n      \"protocol\": \"http/json\",\n      \"sampling_ratio\": 1,\n      \"scrub_rules\": [\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         },\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         },\n         {\n            \"action\": \"redact\",\n            \"key\": \"aa\"\n         }\n      ]\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatUUID))
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if utf8.RuneCountInString(body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 100, false))
+		}
+		if !(body.Protocol == "http/protobuf" || body.Protocol == "http/json" || body.Protocol == "grpc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.protocol", body.Protocol, []any{"http/protobuf", "http/json", "grpc"}))
+		}
+		if body.SamplingRatio < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sampling_ratio", body.SamplingRatio, 0, true))
+		}
+		if body.SamplingRatio > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sampling_ratio", body.SamplingRatio, 1, false))
+		}
+		if body.Filters != nil {
+			if err2 := ValidateOtelForwardingFiltersRequestBody(body.Filters); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if len(body.ScrubRules) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_rules", body.ScrubRules, len(body.ScrubRules), 50, false))
+		}
+		for _, e := range body.ScrubRules {
+			if e != nil {
+				if err2 := ValidateOtelForwardingScrubRuleRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var apikeyToken *string
+	{
+		if otelForwardingUpdateDestinationApikeyToken != "" {
+			apikeyToken = &otelForwardingUpdateDestinationApikeyToken
+		}
+	}
+	var sessionToken *string
+	{
+		if otelForwardingUpdateDestinationSessionToken != "" {
+			sessionToken = &otelForwardingUpdateDestinationSessionToken
+		}
+	}
+	v := &otelforwarding.UpdateDestinationPayload{
+		ID:            body.ID,
+		Name:          body.Name,
+		EndpointURL:   body.EndpointURL,
+		Protocol:      body.Protocol,
+		Enabled:       body.Enabled,
+		SamplingRatio: body.SamplingRatio,
+	}
+	{
+		var zero string
+		if v.Protocol == zero {
+			v.Protocol = "http/protobuf"
+		}
+	}
+	{
+		var zero bool
+		if v.Enabled == zero {
+			v.Enabled = true
+		}
+	}
+	if body.Headers != nil {
+		v.Headers = make([]*otelforwarding.OtelForwardingHeaderInput, len(body.Headers))
+		for i, val := range body.Headers {
+			if val == nil {
+				v.Headers[i] = nil
+				continue
+			}
+			v.Headers[i] = marshalOtelForwardingHeaderInputRequestBodyToOtelforwardingOtelForwardingHeaderInput(val)
+		}
+	}
+	{
+		var zero float64
+		if v.SamplingRatio == zero {
+			v.SamplingRatio = 1
+		}
+	}
+	if body.Filters != nil {
+		v.Filters = marshalOtelForwardingFiltersRequestBodyToOtelforwardingOtelForwardingFilters(body.Filters)
+	}
+	if body.ScrubRules != nil {
+		v.ScrubRules = make([]*otelforwarding.OtelForwardingScrubRule, len(body.ScrubRules))
+		for i, val := range body.ScrubRules {
+			if val == nil {
+				v.ScrubRules[i] = nil
+				continue
+			}
+			v.ScrubRules[i] = marshalOtelForwardingScrubRuleRequestBodyToOtelforwardingOtelForwardingScrubRule(val)
+		}
+	}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildDeleteDestinationPayload builds the payload for the otelForwarding
+// deleteDestination endpoint from CLI flags.
+func BuildDeleteDestinationPayload(otelForwardingDeleteDestinationBody string, otelForwardingDeleteDestinationApikeyToken string, otelForwardingDeleteDestinationSessionToken string) (*otelforwarding.DeleteDestinationPayload, error) {
+	var err error
+	var body DeleteDestinationRequestBody
+	{
+		err = json.Unmarshal([]byte(otelForwardingDeleteDestinationBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var apikeyToken *string
+	{
+		if otelForwardingDeleteDestinationApikeyToken != "" {
+			apikeyToken = &otelForwardingDeleteDestinationApikeyToken
+		}
+	}
+	var sessionToken *string
+	{
+		if otelForwardingDeleteDestinationSessionToken != "" {
+			sessionToken = &otelForwardingDeleteDestinationSessionToken
+		}
+	}
+	v := &otelforwarding.DeleteDestinationPayload{
+		ID: body.ID,
+	}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
@@ -29,6 +29,22 @@ type Client struct {
 	// deleteConfig endpoint.
 	DeleteConfigDoer goahttp.Doer
 
+	// ListDestinations Doer is the HTTP client used to make requests to the
+	// listDestinations endpoint.
+	ListDestinationsDoer goahttp.Doer
+
+	// CreateDestination Doer is the HTTP client used to make requests to the
+	// createDestination endpoint.
+	CreateDestinationDoer goahttp.Doer
+
+	// UpdateDestination Doer is the HTTP client used to make requests to the
+	// updateDestination endpoint.
+	UpdateDestinationDoer goahttp.Doer
+
+	// DeleteDestination Doer is the HTTP client used to make requests to the
+	// deleteDestination endpoint.
+	DeleteDestinationDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -50,14 +66,18 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		GetConfigDoer:       doer,
-		UpsertConfigDoer:    doer,
-		DeleteConfigDoer:    doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		GetConfigDoer:         doer,
+		UpsertConfigDoer:      doer,
+		DeleteConfigDoer:      doer,
+		ListDestinationsDoer:  doer,
+		CreateDestinationDoer: doer,
+		UpdateDestinationDoer: doer,
+		DeleteDestinationDoer: doer,
+		RestoreResponseBody:   restoreBody,
+		scheme:                scheme,
+		host:                  host,
+		decoder:               dec,
+		encoder:               enc,
 	}
 }
 
@@ -132,3 +152,99 @@ func (c *Client) DeleteConfig() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// ListDestinations returns an endpoint that makes HTTP requests to the
+// otelForwarding service listDestinations server.
+func (c *Client) ListDestinations() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListDestinationsRequest(c.encoder)
+		decodeResponse = DecodeListDestinationsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListDestinationsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListDestinationsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("otelForwarding", "listDestinations", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateDestination returns an endpoint that makes HTTP requests to the
+// otelForwarding service createDestination server.
+func (c *Client) CreateDestination() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateDestinationRequest(c.encoder)
+		decodeResponse = DecodeCreateDestinationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateDestinationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateDestinationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("otelForwarding", "createDestination", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateDestination returns an endpoint that makes HTTP requests to the
+// otelForwarding service updateDestination server.
+func (c *Client) UpdateDestination() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateDestinationRequest(c.encoder)
+		decodeResponse = DecodeUpdateDestinationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateDestinationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateDestinationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("otelForwarding", "updateDestination", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteDestination returns an endpoint that makes HTTP requests to the
+// otelForwarding service deleteDestination server.
+func (c *Client) DeleteDestination() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteDestinationRequest(c.encoder)
+		decodeResponse = DecodeDeleteDestinationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteDestinationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteDestinationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("otelForwarding", "deleteDestination", err)
+		}
+		return decodeResponse(resp)
+	}
+}
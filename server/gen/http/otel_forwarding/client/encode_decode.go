@@ -711,6 +711,944 @@ func DecodeDeleteConfigResponse(decoder func(*http.Response) goahttp.Decoder, re
 	}
 }
 
+// BuildListDestinationsRequest instantiates a HTTP request object with method
+// and path set to call the "otelForwarding" service "listDestinations" endpoint
+func (c *Client) BuildListDestinationsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListDestinationsOtelForwardingPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("otelForwarding", "listDestinations", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListDestinationsRequest returns an encoder for requests sent to the
+// otelForwarding listDestinations server.
+func EncodeListDestinationsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*otelforwarding.ListDestinationsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("otelForwarding", "listDestinations", "*otelforwarding.ListDestinationsPayload", v)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		return nil
+	}
+}
+
+// DecodeListDestinationsResponse returns a decoder for responses returned by
+// the otelForwarding listDestinations endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListDestinationsResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeListDestinationsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListDestinationsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "listDestinations", err)
+			}
+			err = ValidateListDestinationsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "listDestinations", err)
+			}
+			res := NewListDestinationsListOtelForwardingDestinationsResultOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListDestinationsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "listDestinations", err)
+			}
+			err = ValidateListDestinationsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "listDestinations", err)
+			}
+			return nil, NewListDestinationsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListDestinationsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "listDestinations", err)
+			}
+			err = ValidateListDestinationsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "listDestinations", err)
+			}
+			return nil, NewListDestinationsForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListDestinationsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "listDestinations", err)
+			}
+			err = ValidateListDestinationsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "listDestinations", err)
+			}
+			return nil, NewListDestinationsBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body ListDestinationsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "listDestinations", err)
+			}
+			err = ValidateListDestinationsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "listDestinations", err)
+			}
+			return nil, NewListDestinationsNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body ListDestinationsConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "listDestinations", err)
+			}
+			err = ValidateListDestinationsConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "listDestinations", err)
+			}
+			return nil, NewListDestinationsConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body ListDestinationsUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "listDestinations", err)
+			}
+			err = ValidateListDestinationsUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "listDestinations", err)
+			}
+			return nil, NewListDestinationsUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body ListDestinationsInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "listDestinations", err)
+			}
+			err = ValidateListDestinationsInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "listDestinations", err)
+			}
+			return nil, NewListDestinationsInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body ListDestinationsInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("otelForwarding", "listDestinations", err)
+				}
+				err = ValidateListDestinationsInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("otelForwarding", "listDestinations", err)
+				}
+				return nil, NewListDestinationsInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body ListDestinationsUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("otelForwarding", "listDestinations", err)
+				}
+				err = ValidateListDestinationsUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("otelForwarding", "listDestinations", err)
+				}
+				return nil, NewListDestinationsUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("otelForwarding", "listDestinations", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body ListDestinationsGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "listDestinations", err)
+			}
+			err = ValidateListDestinationsGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "listDestinations", err)
+			}
+			return nil, NewListDestinationsGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("otelForwarding", "listDestinations", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateDestinationRequest instantiates a HTTP request object with method
+// and path set to call the "otelForwarding" service "createDestination"
+// endpoint
+func (c *Client) BuildCreateDestinationRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateDestinationOtelForwardingPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("otelForwarding", "createDestination", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateDestinationRequest returns an encoder for requests sent to the
+// otelForwarding createDestination server.
+func EncodeCreateDestinationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*otelforwarding.CreateDestinationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("otelForwarding", "createDestination", "*otelforwarding.CreateDestinationPayload", v)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		body := NewCreateDestinationRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("otelForwarding", "createDestination", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateDestinationResponse returns a decoder for responses returned by
+// the otelForwarding createDestination endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeCreateDestinationResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeCreateDestinationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CreateDestinationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "createDestination", err)
+			}
+			err = ValidateCreateDestinationResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "createDestination", err)
+			}
+			res := NewCreateDestinationOtelForwardingDestinationOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CreateDestinationUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "createDestination", err)
+			}
+			err = ValidateCreateDestinationUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "createDestination", err)
+			}
+			return nil, NewCreateDestinationUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateDestinationForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "createDestination", err)
+			}
+			err = ValidateCreateDestinationForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "createDestination", err)
+			}
+			return nil, NewCreateDestinationForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body CreateDestinationBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "createDestination", err)
+			}
+			err = ValidateCreateDestinationBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "createDestination", err)
+			}
+			return nil, NewCreateDestinationBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body CreateDestinationNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "createDestination", err)
+			}
+			err = ValidateCreateDestinationNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "createDestination", err)
+			}
+			return nil, NewCreateDestinationNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body CreateDestinationConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "createDestination", err)
+			}
+			err = ValidateCreateDestinationConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "createDestination", err)
+			}
+			return nil, NewCreateDestinationConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body CreateDestinationUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "createDestination", err)
+			}
+			err = ValidateCreateDestinationUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "createDestination", err)
+			}
+			return nil, NewCreateDestinationUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body CreateDestinationInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "createDestination", err)
+			}
+			err = ValidateCreateDestinationInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "createDestination", err)
+			}
+			return nil, NewCreateDestinationInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body CreateDestinationInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("otelForwarding", "createDestination", err)
+				}
+				err = ValidateCreateDestinationInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("otelForwarding", "createDestination", err)
+				}
+				return nil, NewCreateDestinationInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body CreateDestinationUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("otelForwarding", "createDestination", err)
+				}
+				err = ValidateCreateDestinationUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("otelForwarding", "createDestination", err)
+				}
+				return nil, NewCreateDestinationUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("otelForwarding", "createDestination", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body CreateDestinationGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "createDestination", err)
+			}
+			err = ValidateCreateDestinationGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "createDestination", err)
+			}
+			return nil, NewCreateDestinationGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("otelForwarding", "createDestination", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateDestinationRequest instantiates a HTTP request object with method
+// and path set to call the "otelForwarding" service "updateDestination"
+// endpoint
+func (c *Client) BuildUpdateDestinationRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateDestinationOtelForwardingPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("otelForwarding", "updateDestination", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateDestinationRequest returns an encoder for requests sent to the
+// otelForwarding updateDestination server.
+func EncodeUpdateDestinationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*otelforwarding.UpdateDestinationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("otelForwarding", "updateDestination", "*otelforwarding.UpdateDestinationPayload", v)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		body := NewUpdateDestinationRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("otelForwarding", "updateDestination", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateDestinationResponse returns a decoder for responses returned by
+// the otelForwarding updateDestination endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeUpdateDestinationResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeUpdateDestinationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateDestinationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "updateDestination", err)
+			}
+			err = ValidateUpdateDestinationResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "updateDestination", err)
+			}
+			res := NewUpdateDestinationOtelForwardingDestinationOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body UpdateDestinationUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "updateDestination", err)
+			}
+			err = ValidateUpdateDestinationUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "updateDestination", err)
+			}
+			return nil, NewUpdateDestinationUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateDestinationForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "updateDestination", err)
+			}
+			err = ValidateUpdateDestinationForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "updateDestination", err)
+			}
+			return nil, NewUpdateDestinationForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body UpdateDestinationBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "updateDestination", err)
+			}
+			err = ValidateUpdateDestinationBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "updateDestination", err)
+			}
+			return nil, NewUpdateDestinationBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateDestinationNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "updateDestination", err)
+			}
+			err = ValidateUpdateDestinationNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "updateDestination", err)
+			}
+			return nil, NewUpdateDestinationNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateDestinationConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "updateDestination", err)
+			}
+			err = ValidateUpdateDestinationConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "updateDestination", err)
+			}
+			return nil, NewUpdateDestinationConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body UpdateDestinationUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "updateDestination", err)
+			}
+			err = ValidateUpdateDestinationUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "updateDestination", err)
+			}
+			return nil, NewUpdateDestinationUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body UpdateDestinationInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "updateDestination", err)
+			}
+			err = ValidateUpdateDestinationInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "updateDestination", err)
+			}
+			return nil, NewUpdateDestinationInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body UpdateDestinationInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("otelForwarding", "updateDestination", err)
+				}
+				err = ValidateUpdateDestinationInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("otelForwarding", "updateDestination", err)
+				}
+				return nil, NewUpdateDestinationInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body UpdateDestinationUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("otelForwarding", "updateDestination", err)
+				}
+				err = ValidateUpdateDestinationUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("otelForwarding", "updateDestination", err)
+				}
+				return nil, NewUpdateDestinationUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("otelForwarding", "updateDestination", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body UpdateDestinationGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "updateDestination", err)
+			}
+			err = ValidateUpdateDestinationGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "updateDestination", err)
+			}
+			return nil, NewUpdateDestinationGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("otelForwarding", "updateDestination", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteDestinationRequest instantiates a HTTP request object with method
+// and path set to call the "otelForwarding" service "deleteDestination"
+// endpoint
+func (c *Client) BuildDeleteDestinationRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteDestinationOtelForwardingPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("otelForwarding", "deleteDestination", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteDestinationRequest returns an encoder for requests sent to the
+// otelForwarding deleteDestination server.
+func EncodeDeleteDestinationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*otelforwarding.DeleteDestinationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("otelForwarding", "deleteDestination", "*otelforwarding.DeleteDestinationPayload", v)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		body := NewDeleteDestinationRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("otelForwarding", "deleteDestination", err)
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteDestinationResponse returns a decoder for responses returned by
+// the otelForwarding deleteDestination endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeDeleteDestinationResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeDeleteDestinationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusUnauthorized:
+			var (
+				body DeleteDestinationUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "deleteDestination", err)
+			}
+			err = ValidateDeleteDestinationUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "deleteDestination", err)
+			}
+			return nil, NewDeleteDestinationUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteDestinationForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "deleteDestination", err)
+			}
+			err = ValidateDeleteDestinationForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "deleteDestination", err)
+			}
+			return nil, NewDeleteDestinationForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body DeleteDestinationBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "deleteDestination", err)
+			}
+			err = ValidateDeleteDestinationBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "deleteDestination", err)
+			}
+			return nil, NewDeleteDestinationBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteDestinationNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "deleteDestination", err)
+			}
+			err = ValidateDeleteDestinationNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "deleteDestination", err)
+			}
+			return nil, NewDeleteDestinationNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body DeleteDestinationConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "deleteDestination", err)
+			}
+			err = ValidateDeleteDestinationConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "deleteDestination", err)
+			}
+			return nil, NewDeleteDestinationConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body DeleteDestinationUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "deleteDestination", err)
+			}
+			err = ValidateDeleteDestinationUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "deleteDestination", err)
+			}
+			return nil, NewDeleteDestinationUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body DeleteDestinationInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "deleteDestination", err)
+			}
+			err = ValidateDeleteDestinationInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "deleteDestination", err)
+			}
+			return nil, NewDeleteDestinationInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body DeleteDestinationInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("otelForwarding", "deleteDestination", err)
+				}
+				err = ValidateDeleteDestinationInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("otelForwarding", "deleteDestination", err)
+				}
+				return nil, NewDeleteDestinationInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body DeleteDestinationUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("otelForwarding", "deleteDestination", err)
+				}
+				err = ValidateDeleteDestinationUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("otelForwarding", "deleteDestination", err)
+				}
+				return nil, NewDeleteDestinationUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("otelForwarding", "deleteDestination", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body DeleteDestinationGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("otelForwarding", "deleteDestination", err)
+			}
+			err = ValidateDeleteDestinationGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("otelForwarding", "deleteDestination", err)
+			}
+			return nil, NewDeleteDestinationGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("otelForwarding", "deleteDestination", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // unmarshalOtelForwardingHeaderResponseBodyToOtelforwardingOtelForwardingHeader
 // builds a value of type *otelforwarding.OtelForwardingHeader from a value of
 // type *OtelForwardingHeaderResponseBody.
@@ -752,3 +1690,255 @@ func marshalOtelForwardingHeaderInputRequestBodyToOtelforwardingOtelForwardingHe
 
 	return res
 }
+
+// unmarshalOtelForwardingDestinationResponseBodyToOtelforwardingOtelForwardingDestination
+// builds a value of type *otelforwarding.OtelForwardingDestination from a
+// value of type *OtelForwardingDestinationResponseBody.
+func unmarshalOtelForwardingDestinationResponseBodyToOtelforwardingOtelForwardingDestination(v *OtelForwardingDestinationResponseBody) *otelforwarding.OtelForwardingDestination {
+	res := &otelforwarding.OtelForwardingDestination{
+		ID:             *v.ID,
+		OrganizationID: *v.OrganizationID,
+		Name:           *v.Name,
+		EndpointURL:    *v.EndpointURL,
+		Protocol:       *v.Protocol,
+		Enabled:        *v.Enabled,
+		SamplingRatio:  *v.SamplingRatio,
+		CreatedAt:      *v.CreatedAt,
+		UpdatedAt:      *v.UpdatedAt,
+	}
+	res.Headers = make([]*otelforwarding.OtelForwardingHeader, len(v.Headers))
+	for i, val := range v.Headers {
+		if val == nil {
+			res.Headers[i] = nil
+			continue
+		}
+		res.Headers[i] = unmarshalOtelForwardingHeaderResponseBodyToOtelforwardingOtelForwardingHeader(val)
+	}
+	res.Filters = unmarshalOtelForwardingFiltersResponseBodyToOtelforwardingOtelForwardingFilters(v.Filters)
+	res.ScrubRules = make([]*otelforwarding.OtelForwardingScrubRule, len(v.ScrubRules))
+	for i, val := range v.ScrubRules {
+		if val == nil {
+			res.ScrubRules[i] = nil
+			continue
+		}
+		res.ScrubRules[i] = unmarshalOtelForwardingScrubRuleResponseBodyToOtelforwardingOtelForwardingScrubRule(val)
+	}
+
+	return res
+}
+
+// unmarshalOtelForwardingFiltersResponseBodyToOtelforwardingOtelForwardingFilters
+// builds a value of type *otelforwarding.OtelForwardingFilters from a value of
+// type *OtelForwardingFiltersResponseBody.
+func unmarshalOtelForwardingFiltersResponseBodyToOtelforwardingOtelForwardingFilters(v *OtelForwardingFiltersResponseBody) *otelforwarding.OtelForwardingFilters {
+	res := &otelforwarding.OtelForwardingFilters{}
+	if v.IncludeNames != nil {
+		res.IncludeNames = make([]string, len(v.IncludeNames))
+		for i, val := range v.IncludeNames {
+			res.IncludeNames[i] = val
+		}
+	}
+	if v.ExcludeNames != nil {
+		res.ExcludeNames = make([]string, len(v.ExcludeNames))
+		for i, val := range v.ExcludeNames {
+			res.ExcludeNames[i] = val
+		}
+	}
+	if v.IncludeAttributes != nil {
+		res.IncludeAttributes = make([]*otelforwarding.OtelForwardingAttributeMatch, len(v.IncludeAttributes))
+		for i, val := range v.IncludeAttributes {
+			if val == nil {
+				res.IncludeAttributes[i] = nil
+				continue
+			}
+			res.IncludeAttributes[i] = unmarshalOtelForwardingAttributeMatchResponseBodyToOtelforwardingOtelForwardingAttributeMatch(val)
+		}
+	}
+	if v.ExcludeAttributes != nil {
+		res.ExcludeAttributes = make([]*otelforwarding.OtelForwardingAttributeMatch, len(v.ExcludeAttributes))
+		for i, val := range v.ExcludeAttributes {
+			if val == nil {
+				res.ExcludeAttributes[i] = nil
+				continue
+			}
+			res.ExcludeAttributes[i] = unmarshalOtelForwardingAttributeMatchResponseBodyToOtelforwardingOtelForwardingAttributeMatch(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalOtelForwardingAttributeMatchResponseBodyToOtelforwardingOtelForwardingAttributeMatch
+// builds a value of type *otelforwarding.OtelForwardingAttributeMatch from a
+// value of type *OtelForwardingAttributeMatchResponseBody.
+func unmarshalOtelForwardingAttributeMatchResponseBodyToOtelforwardingOtelForwardingAttributeMatch(v *OtelForwardingAttributeMatchResponseBody) *otelforwarding.OtelForwardingAttributeMatch {
+	if v == nil {
+		return nil
+	}
+	res := &otelforwarding.OtelForwardingAttributeMatch{
+		Key:   *v.Key,
+		Value: v.Value,
+	}
+
+	return res
+}
+
+// unmarshalOtelForwardingScrubRuleResponseBodyToOtelforwardingOtelForwardingScrubRule
+// builds a value of type *otelforwarding.OtelForwardingScrubRule from a value
+// of type *OtelForwardingScrubRuleResponseBody.
+func unmarshalOtelForwardingScrubRuleResponseBodyToOtelforwardingOtelForwardingScrubRule(v *OtelForwardingScrubRuleResponseBody) *otelforwarding.OtelForwardingScrubRule {
+	res := &otelforwarding.OtelForwardingScrubRule{
+		Key:    *v.Key,
+		Action: *v.Action,
+	}
+
+	return res
+}
+
+// marshalOtelforwardingOtelForwardingFiltersToOtelForwardingFiltersRequestBody
+// builds a value of type *OtelForwardingFiltersRequestBody from a value of
+// type *otelforwarding.OtelForwardingFilters.
+func marshalOtelforwardingOtelForwardingFiltersToOtelForwardingFiltersRequestBody(v *otelforwarding.OtelForwardingFilters) *OtelForwardingFiltersRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &OtelForwardingFiltersRequestBody{}
+	if v.IncludeNames != nil {
+		res.IncludeNames = make([]string, len(v.IncludeNames))
+		for i, val := range v.IncludeNames {
+			res.IncludeNames[i] = val
+		}
+	}
+	if v.ExcludeNames != nil {
+		res.ExcludeNames = make([]string, len(v.ExcludeNames))
+		for i, val := range v.ExcludeNames {
+			res.ExcludeNames[i] = val
+		}
+	}
+	if v.IncludeAttributes != nil {
+		res.IncludeAttributes = make([]*OtelForwardingAttributeMatchRequestBody, len(v.IncludeAttributes))
+		for i, val := range v.IncludeAttributes {
+			if val == nil {
+				res.IncludeAttributes[i] = nil
+				continue
+			}
+			res.IncludeAttributes[i] = marshalOtelforwardingOtelForwardingAttributeMatchToOtelForwardingAttributeMatchRequestBody(val)
+		}
+	}
+	if v.ExcludeAttributes != nil {
+		res.ExcludeAttributes = make([]*OtelForwardingAttributeMatchRequestBody, len(v.ExcludeAttributes))
+		for i, val := range v.ExcludeAttributes {
+			if val == nil {
+				res.ExcludeAttributes[i] = nil
+				continue
+			}
+			res.ExcludeAttributes[i] = marshalOtelforwardingOtelForwardingAttributeMatchToOtelForwardingAttributeMatchRequestBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalOtelforwardingOtelForwardingAttributeMatchToOtelForwardingAttributeMatchRequestBody
+// builds a value of type *OtelForwardingAttributeMatchRequestBody from a value
+// of type *otelforwarding.OtelForwardingAttributeMatch.
+func marshalOtelforwardingOtelForwardingAttributeMatchToOtelForwardingAttributeMatchRequestBody(v *otelforwarding.OtelForwardingAttributeMatch) *OtelForwardingAttributeMatchRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &OtelForwardingAttributeMatchRequestBody{
+		Key:   v.Key,
+		Value: v.Value,
+	}
+
+	return res
+}
+
+// marshalOtelforwardingOtelForwardingScrubRuleToOtelForwardingScrubRuleRequestBody
+// builds a value of type *OtelForwardingScrubRuleRequestBody from a value of
+// type *otelforwarding.OtelForwardingScrubRule.
+func marshalOtelforwardingOtelForwardingScrubRuleToOtelForwardingScrubRuleRequestBody(v *otelforwarding.OtelForwardingScrubRule) *OtelForwardingScrubRuleRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &OtelForwardingScrubRuleRequestBody{
+		Key:    v.Key,
+		Action: v.Action,
+	}
+
+	return res
+}
+
+// marshalOtelForwardingFiltersRequestBodyToOtelforwardingOtelForwardingFilters
+// builds a value of type *otelforwarding.OtelForwardingFilters from a value of
+// type *OtelForwardingFiltersRequestBody.
+func marshalOtelForwardingFiltersRequestBodyToOtelforwardingOtelForwardingFilters(v *OtelForwardingFiltersRequestBody) *otelforwarding.OtelForwardingFilters {
+	if v == nil {
+		return nil
+	}
+	res := &otelforwarding.OtelForwardingFilters{}
+	if v.IncludeNames != nil {
+		res.IncludeNames = make([]string, len(v.IncludeNames))
+		for i, val := range v.IncludeNames {
+			res.IncludeNames[i] = val
+		}
+	}
+	if v.ExcludeNames != nil {
+		res.ExcludeNames = make([]string, len(v.ExcludeNames))
+		for i, val := range v.ExcludeNames {
+			res.ExcludeNames[i] = val
+		}
+	}
+	if v.IncludeAttributes != nil {
+		res.IncludeAttributes = make([]*otelforwarding.OtelForwardingAttributeMatch, len(v.IncludeAttributes))
+		for i, val := range v.IncludeAttributes {
+			if val == nil {
+				res.IncludeAttributes[i] = nil
+				continue
+			}
+			res.IncludeAttributes[i] = marshalOtelForwardingAttributeMatchRequestBodyToOtelforwardingOtelForwardingAttributeMatch(val)
+		}
+	}
+	if v.ExcludeAttributes != nil {
+		res.ExcludeAttributes = make([]*otelforwarding.OtelForwardingAttributeMatch, len(v.ExcludeAttributes))
+		for i, val := range v.ExcludeAttributes {
+			if val == nil {
+				res.ExcludeAttributes[i] = nil
+				continue
+			}
+			res.ExcludeAttributes[i] = marshalOtelForwardingAttributeMatchRequestBodyToOtelforwardingOtelForwardingAttributeMatch(val)
+		}
+	}
+
+	return res
+}
+
+// marshalOtelForwardingAttributeMatchRequestBodyToOtelforwardingOtelForwardingAttributeMatch
+// builds a value of type *otelforwarding.OtelForwardingAttributeMatch from a
+// value of type *OtelForwardingAttributeMatchRequestBody.
+func marshalOtelForwardingAttributeMatchRequestBodyToOtelforwardingOtelForwardingAttributeMatch(v *OtelForwardingAttributeMatchRequestBody) *otelforwarding.OtelForwardingAttributeMatch {
+	if v == nil {
+		return nil
+	}
+	res := &otelforwarding.OtelForwardingAttributeMatch{
+		Key:   v.Key,
+		Value: v.Value,
+	}
+
+	return res
+}
+
+// marshalOtelForwardingScrubRuleRequestBodyToOtelforwardingOtelForwardingScrubRule
+// builds a value of type *otelforwarding.OtelForwardingScrubRule from a value
+// of type *OtelForwardingScrubRuleRequestBody.
+func marshalOtelForwardingScrubRuleRequestBodyToOtelforwardingOtelForwardingScrubRule(v *OtelForwardingScrubRuleRequestBody) *otelforwarding.OtelForwardingScrubRule {
+	if v == nil {
+		return nil
+	}
+	res := &otelforwarding.OtelForwardingScrubRule{
+		Key:    v.Key,
+		Action: v.Action,
+	}
+
+	return res
+}
@@ -21,3 +21,23 @@ func UpsertConfigOtelForwardingPath() string {
 func DeleteConfigOtelForwardingPath() string {
 	return "/rpc/otelForwarding.deleteConfig"
 }
+
+// ListDestinationsOtelForwardingPath returns the URL path to the otelForwarding service listDestinations HTTP endpoint.
+func ListDestinationsOtelForwardingPath() string {
+	return "/rpc/otelForwarding.listDestinations"
+}
+
+// CreateDestinationOtelForwardingPath returns the URL path to the otelForwarding service createDestination HTTP endpoint.
+func CreateDestinationOtelForwardingPath() string {
+	return "/rpc/otelForwarding.createDestination"
+}
+
+// UpdateDestinationOtelForwardingPath returns the URL path to the otelForwarding service updateDestination HTTP endpoint.
+func UpdateDestinationOtelForwardingPath() string {
+	return "/rpc/otelForwarding.updateDestination"
+}
+
+// DeleteDestinationOtelForwardingPath returns the URL path to the otelForwarding service deleteDestination HTTP endpoint.
+func DeleteDestinationOtelForwardingPath() string {
+	return "/rpc/otelForwarding.deleteDestination"
+}
@@ -8,6 +8,8 @@
 package client
 
 import (
+	"unicode/utf8"
+
 	otelforwarding "github.com/speakeasy-api/gram/server/gen/otel_forwarding"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -24,6 +26,61 @@ type UpsertConfigRequestBody struct {
 	Headers []*OtelForwardingHeaderInputRequestBody `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
 }
 
+// CreateDestinationRequestBody is the type of the "otelForwarding" service
+// "createDestination" endpoint HTTP request body.
+type CreateDestinationRequestBody struct {
+	// Name of the destination, unique within the organization.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Base URL of the OTLP receiver. Use https:// for TLS; gRPC destinations
+	// without a port use 4317.
+	EndpointURL string `form:"endpoint_url" json:"endpoint_url" xml:"endpoint_url"`
+	// OTLP transport and encoding.
+	Protocol string `form:"protocol" json:"protocol" xml:"protocol"`
+	// Whether the destination should receive telemetry.
+	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
+	// Complete desired header set. Omitted entries are removed; entries with an
+	// omitted value preserve the existing encrypted value for the same name.
+	Headers []*OtelForwardingHeaderInputRequestBody `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Share of traces and log records to forward, from 0 to 1.
+	SamplingRatio float64 `form:"sampling_ratio" json:"sampling_ratio" xml:"sampling_ratio"`
+	// Filters applied before sampling. Omit to forward everything.
+	Filters *OtelForwardingFiltersRequestBody `form:"filters,omitempty" json:"filters,omitempty" xml:"filters,omitempty"`
+	// Attribute scrubbing rules.
+	ScrubRules []*OtelForwardingScrubRuleRequestBody `form:"scrub_rules,omitempty" json:"scrub_rules,omitempty" xml:"scrub_rules,omitempty"`
+}
+
+// UpdateDestinationRequestBody is the type of the "otelForwarding" service
+// "updateDestination" endpoint HTTP request body.
+type UpdateDestinationRequestBody struct {
+	// ID of the destination to update.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Name of the destination, unique within the organization.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Base URL of the OTLP receiver. Use https:// for TLS; gRPC destinations
+	// without a port use 4317.
+	EndpointURL string `form:"endpoint_url" json:"endpoint_url" xml:"endpoint_url"`
+	// OTLP transport and encoding.
+	Protocol string `form:"protocol" json:"protocol" xml:"protocol"`
+	// Whether the destination should receive telemetry.
+	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
+	// Complete desired header set. Omitted entries are removed; entries with an
+	// omitted value preserve the existing encrypted value for the same name.
+	Headers []*OtelForwardingHeaderInputRequestBody `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Share of traces and log records to forward, from 0 to 1.
+	SamplingRatio float64 `form:"sampling_ratio" json:"sampling_ratio" xml:"sampling_ratio"`
+	// Filters applied before sampling. Omit to forward everything.
+	Filters *OtelForwardingFiltersRequestBody `form:"filters,omitempty" json:"filters,omitempty" xml:"filters,omitempty"`
+	// Attribute scrubbing rules.
+	ScrubRules []*OtelForwardingScrubRuleRequestBody `form:"scrub_rules,omitempty" json:"scrub_rules,omitempty" xml:"scrub_rules,omitempty"`
+}
+
+// DeleteDestinationRequestBody is the type of the "otelForwarding" service
+// "deleteDestination" endpoint HTTP request body.
+type DeleteDestinationRequestBody struct {
+	// ID of the destination to delete.
+	ID string `form:"id" json:"id" xml:"id"`
+}
+
 // GetConfigResponseBody is the type of the "otelForwarding" service
 // "getConfig" endpoint HTTP response body.
 type GetConfigResponseBody struct {
@@ -64,6 +121,77 @@ type UpsertConfigResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// ListDestinationsResponseBody is the type of the "otelForwarding" service
+// "listDestinations" endpoint HTTP response body.
+type ListDestinationsResponseBody struct {
+	// The organization's destinations, oldest first.
+	Destinations []*OtelForwardingDestinationResponseBody `form:"destinations,omitempty" json:"destinations,omitempty" xml:"destinations,omitempty"`
+}
+
+// CreateDestinationResponseBody is the type of the "otelForwarding" service
+// "createDestination" endpoint HTTP response body.
+type CreateDestinationResponseBody struct {
+	// Destination ID.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Organization the destination belongs to.
+	OrganizationID *string `form:"organization_id,omitempty" json:"organization_id,omitempty" xml:"organization_id,omitempty"`
+	// Name of the destination, unique within the organization.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Base URL of the OTLP receiver. OTLP/HTTP destinations receive /v1/<signal>
+	// under it; gRPC destinations dial its host and port.
+	EndpointURL *string `form:"endpoint_url,omitempty" json:"endpoint_url,omitempty" xml:"endpoint_url,omitempty"`
+	// OTLP transport and encoding.
+	Protocol *string `form:"protocol,omitempty" json:"protocol,omitempty" xml:"protocol,omitempty"`
+	// Whether the destination currently receives telemetry.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Headers sent with each export (gRPC metadata for gRPC destinations). Values
+	// are never returned.
+	Headers []*OtelForwardingHeaderResponseBody `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Share of traces and log records forwarded, from 0 to 1. Items sharing a
+	// trace ID are kept or dropped together. Metrics are never sampled.
+	SamplingRatio *float64 `form:"sampling_ratio,omitempty" json:"sampling_ratio,omitempty" xml:"sampling_ratio,omitempty"`
+	// Filters applied before sampling.
+	Filters *OtelForwardingFiltersResponseBody `form:"filters,omitempty" json:"filters,omitempty" xml:"filters,omitempty"`
+	// Attribute scrubbing rules applied to everything that is forwarded.
+	ScrubRules []*OtelForwardingScrubRuleResponseBody `form:"scrub_rules,omitempty" json:"scrub_rules,omitempty" xml:"scrub_rules,omitempty"`
+	// When the destination was created.
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// When the destination was last updated.
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// UpdateDestinationResponseBody is the type of the "otelForwarding" service
+// "updateDestination" endpoint HTTP response body.
+type UpdateDestinationResponseBody struct {
+	// Destination ID.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Organization the destination belongs to.
+	OrganizationID *string `form:"organization_id,omitempty" json:"organization_id,omitempty" xml:"organization_id,omitempty"`
+	// Name of the destination, unique within the organization.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Base URL of the OTLP receiver. OTLP/HTTP destinations receive /v1/<signal>
+	// under it; gRPC destinations dial its host and port.
+	EndpointURL *string `form:"endpoint_url,omitempty" json:"endpoint_url,omitempty" xml:"endpoint_url,omitempty"`
+	// OTLP transport and encoding.
+	Protocol *string `form:"protocol,omitempty" json:"protocol,omitempty" xml:"protocol,omitempty"`
+	// Whether the destination currently receives telemetry.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Headers sent with each export (gRPC metadata for gRPC destinations). Values
+	// are never returned.
+	Headers []*OtelForwardingHeaderResponseBody `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Share of traces and log records forwarded, from 0 to 1. Items sharing a
+	// trace ID are kept or dropped together. Metrics are never sampled.
+	SamplingRatio *float64 `form:"sampling_ratio,omitempty" json:"sampling_ratio,omitempty" xml:"sampling_ratio,omitempty"`
+	// Filters applied before sampling.
+	Filters *OtelForwardingFiltersResponseBody `form:"filters,omitempty" json:"filters,omitempty" xml:"filters,omitempty"`
+	// Attribute scrubbing rules applied to everything that is forwarded.
+	ScrubRules []*OtelForwardingScrubRuleResponseBody `form:"scrub_rules,omitempty" json:"scrub_rules,omitempty" xml:"scrub_rules,omitempty"`
+	// When the destination was created.
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// When the destination was last updated.
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
 // GetConfigUnauthorizedResponseBody is the type of the "otelForwarding"
 // service "getConfig" endpoint HTTP response body for the "unauthorized" error.
 type GetConfigUnauthorizedResponseBody struct {
@@ -619,130 +747,1104 @@ type DeleteConfigGatewayErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// OtelForwardingHeaderResponseBody is used to define fields on response body
-// types.
-type OtelForwardingHeaderResponseBody struct {
-	// Header name.
+// ListDestinationsUnauthorizedResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListDestinationsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// Whether a non-empty value is currently stored for this header. Always false
-	// on write-only operations.
-	HasValue *bool `form:"has_value,omitempty" json:"has_value,omitempty" xml:"has_value,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// OtelForwardingHeaderInputRequestBody is used to define fields on request
-// body types.
-type OtelForwardingHeaderInputRequestBody struct {
-	// Header name.
-	Name string `form:"name" json:"name" xml:"name"`
-	// Header value. Omit to preserve an existing value; provide to create,
-	// replace, or clear it. Stored encrypted at rest and never returned on reads.
-	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+// ListDestinationsForbiddenResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "forbidden"
+// error.
+type ListDestinationsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewUpsertConfigRequestBody builds the HTTP request body from the payload of
-// the "upsertConfig" endpoint of the "otelForwarding" service.
-func NewUpsertConfigRequestBody(p *otelforwarding.UpsertConfigPayload) *UpsertConfigRequestBody {
-	body := &UpsertConfigRequestBody{
-		EndpointURL: p.EndpointURL,
-		Enabled:     p.Enabled,
-	}
-	if p.Headers != nil {
-		body.Headers = make([]*OtelForwardingHeaderInputRequestBody, len(p.Headers))
-		for i, val := range p.Headers {
-			if val == nil {
-				body.Headers[i] = nil
-				continue
-			}
-			body.Headers[i] = marshalOtelforwardingOtelForwardingHeaderInputToOtelForwardingHeaderInputRequestBody(val)
-		}
-	}
-	return body
+// ListDestinationsBadRequestResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "bad_request"
+// error.
+type ListDestinationsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewGetConfigOtelForwardingConfigOK builds a "otelForwarding" service
-// "getConfig" endpoint result from a HTTP "OK" response.
-func NewGetConfigOtelForwardingConfigOK(body *GetConfigResponseBody) *otelforwarding.OtelForwardingConfig {
-	v := &otelforwarding.OtelForwardingConfig{
-		ID:             body.ID,
-		OrganizationID: *body.OrganizationID,
-		EndpointURL:    *body.EndpointURL,
-		Enabled:        *body.Enabled,
-		CreatedAt:      body.CreatedAt,
-		UpdatedAt:      body.UpdatedAt,
-	}
-	v.Headers = make([]*otelforwarding.OtelForwardingHeader, len(body.Headers))
-	for i, val := range body.Headers {
-		if val == nil {
-			v.Headers[i] = nil
-			continue
-		}
-		v.Headers[i] = unmarshalOtelForwardingHeaderResponseBodyToOtelforwardingOtelForwardingHeader(val)
-	}
-
-	return v
+// ListDestinationsNotFoundResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "not_found"
+// error.
+type ListDestinationsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewGetConfigUnauthorized builds a otelForwarding service getConfig endpoint
-// unauthorized error.
-func NewGetConfigUnauthorized(body *GetConfigUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
+// ListDestinationsConflictResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "conflict"
+// error.
+type ListDestinationsConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewGetConfigForbidden builds a otelForwarding service getConfig endpoint
-// forbidden error.
-func NewGetConfigForbidden(body *GetConfigForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
+// ListDestinationsUnsupportedMediaResponseBody is the type of the
+// "otelForwarding" service "listDestinations" endpoint HTTP response body for
+// the "unsupported_media" error.
+type ListDestinationsUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewGetConfigBadRequest builds a otelForwarding service getConfig endpoint
-// bad_request error.
-func NewGetConfigBadRequest(body *GetConfigBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
+// ListDestinationsInvalidResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "invalid"
+// error.
+type ListDestinationsInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewGetConfigNotFound builds a otelForwarding service getConfig endpoint
-// not_found error.
-func NewGetConfigNotFound(body *GetConfigNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
+// ListDestinationsInvariantViolationResponseBody is the type of the
+// "otelForwarding" service "listDestinations" endpoint HTTP response body for
+// the "invariant_violation" error.
+type ListDestinationsInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewGetConfigConflict builds a otelForwarding service getConfig endpoint
+// ListDestinationsUnexpectedResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "unexpected"
+// error.
+type ListDestinationsUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListDestinationsGatewayErrorResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the
+// "gateway_error" error.
+type ListDestinationsGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateDestinationUnauthorizedResponseBody is the type of the
+// "otelForwarding" service "createDestination" endpoint HTTP response body for
+// the "unauthorized" error.
+type CreateDestinationUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateDestinationForbiddenResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the "forbidden"
+// error.
+type CreateDestinationForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateDestinationBadRequestResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the
+// "bad_request" error.
+type CreateDestinationBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateDestinationNotFoundResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the "not_found"
+// error.
+type CreateDestinationNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateDestinationConflictResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the "conflict"
+// error.
+type CreateDestinationConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateDestinationUnsupportedMediaResponseBody is the type of the
+// "otelForwarding" service "createDestination" endpoint HTTP response body for
+// the "unsupported_media" error.
+type CreateDestinationUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateDestinationInvalidResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the "invalid"
+// error.
+type CreateDestinationInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateDestinationInvariantViolationResponseBody is the type of the
+// "otelForwarding" service "createDestination" endpoint HTTP response body for
+// the "invariant_violation" error.
+type CreateDestinationInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateDestinationUnexpectedResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the "unexpected"
+// error.
+type CreateDestinationUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateDestinationGatewayErrorResponseBody is the type of the
+// "otelForwarding" service "createDestination" endpoint HTTP response body for
+// the "gateway_error" error.
+type CreateDestinationGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateDestinationUnauthorizedResponseBody is the type of the
+// "otelForwarding" service "updateDestination" endpoint HTTP response body for
+// the "unauthorized" error.
+type UpdateDestinationUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateDestinationForbiddenResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the "forbidden"
+// error.
+type UpdateDestinationForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateDestinationBadRequestResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the
+// "bad_request" error.
+type UpdateDestinationBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateDestinationNotFoundResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the "not_found"
+// error.
+type UpdateDestinationNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateDestinationConflictResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the "conflict"
+// error.
+type UpdateDestinationConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateDestinationUnsupportedMediaResponseBody is the type of the
+// "otelForwarding" service "updateDestination" endpoint HTTP response body for
+// the "unsupported_media" error.
+type UpdateDestinationUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateDestinationInvalidResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the "invalid"
+// error.
+type UpdateDestinationInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateDestinationInvariantViolationResponseBody is the type of the
+// "otelForwarding" service "updateDestination" endpoint HTTP response body for
+// the "invariant_violation" error.
+type UpdateDestinationInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateDestinationUnexpectedResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the "unexpected"
+// error.
+type UpdateDestinationUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateDestinationGatewayErrorResponseBody is the type of the
+// "otelForwarding" service "updateDestination" endpoint HTTP response body for
+// the "gateway_error" error.
+type UpdateDestinationGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteDestinationUnauthorizedResponseBody is the type of the
+// "otelForwarding" service "deleteDestination" endpoint HTTP response body for
+// the "unauthorized" error.
+type DeleteDestinationUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteDestinationForbiddenResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the "forbidden"
+// error.
+type DeleteDestinationForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteDestinationBadRequestResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the
+// "bad_request" error.
+type DeleteDestinationBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteDestinationNotFoundResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the "not_found"
+// error.
+type DeleteDestinationNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteDestinationConflictResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the "conflict"
+// error.
+type DeleteDestinationConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteDestinationUnsupportedMediaResponseBody is the type of the
+// "otelForwarding" service "deleteDestination" endpoint HTTP response body for
+// the "unsupported_media" error.
+type DeleteDestinationUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteDestinationInvalidResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the "invalid"
+// error.
+type DeleteDestinationInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteDestinationInvariantViolationResponseBody is the type of the
+// "otelForwarding" service "deleteDestination" endpoint HTTP response body for
+// the "invariant_violation" error.
+type DeleteDestinationInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteDestinationUnexpectedResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the "unexpected"
+// error.
+type DeleteDestinationUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteDestinationGatewayErrorResponseBody is the type of the
+// "otelForwarding" service "deleteDestination" endpoint HTTP response body for
+// the "gateway_error" error.
+type DeleteDestinationGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// OtelForwardingHeaderResponseBody is used to define fields on response body
+// types.
+type OtelForwardingHeaderResponseBody struct {
+	// Header name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Whether a non-empty value is currently stored for this header. Always false
+	// on write-only operations.
+	HasValue *bool `form:"has_value,omitempty" json:"has_value,omitempty" xml:"has_value,omitempty"`
+}
+
+// OtelForwardingHeaderInputRequestBody is used to define fields on request
+// body types.
+type OtelForwardingHeaderInputRequestBody struct {
+	// Header name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Header value. Omit to preserve an existing value; provide to create,
+	// replace, or clear it. Stored encrypted at rest and never returned on reads.
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// OtelForwardingDestinationResponseBody is used to define fields on response
+// body types.
+type OtelForwardingDestinationResponseBody struct {
+	// Destination ID.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Organization the destination belongs to.
+	OrganizationID *string `form:"organization_id,omitempty" json:"organization_id,omitempty" xml:"organization_id,omitempty"`
+	// Name of the destination, unique within the organization.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Base URL of the OTLP receiver. OTLP/HTTP destinations receive /v1/<signal>
+	// under it; gRPC destinations dial its host and port.
+	EndpointURL *string `form:"endpoint_url,omitempty" json:"endpoint_url,omitempty" xml:"endpoint_url,omitempty"`
+	// OTLP transport and encoding.
+	Protocol *string `form:"protocol,omitempty" json:"protocol,omitempty" xml:"protocol,omitempty"`
+	// Whether the destination currently receives telemetry.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Headers sent with each export (gRPC metadata for gRPC destinations). Values
+	// are never returned.
+	Headers []*OtelForwardingHeaderResponseBody `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Share of traces and log records forwarded, from 0 to 1. Items sharing a
+	// trace ID are kept or dropped together. Metrics are never sampled.
+	SamplingRatio *float64 `form:"sampling_ratio,omitempty" json:"sampling_ratio,omitempty" xml:"sampling_ratio,omitempty"`
+	// Filters applied before sampling.
+	Filters *OtelForwardingFiltersResponseBody `form:"filters,omitempty" json:"filters,omitempty" xml:"filters,omitempty"`
+	// Attribute scrubbing rules applied to everything that is forwarded.
+	ScrubRules []*OtelForwardingScrubRuleResponseBody `form:"scrub_rules,omitempty" json:"scrub_rules,omitempty" xml:"scrub_rules,omitempty"`
+	// When the destination was created.
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// When the destination was last updated.
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// OtelForwardingFiltersResponseBody is used to define fields on response body
+// types.
+type OtelForwardingFiltersResponseBody struct {
+	// Only forward items whose name matches one of these patterns.
+	IncludeNames []string `form:"include_names,omitempty" json:"include_names,omitempty" xml:"include_names,omitempty"`
+	// Never forward items whose name matches one of these patterns.
+	ExcludeNames []string `form:"exclude_names,omitempty" json:"exclude_names,omitempty" xml:"exclude_names,omitempty"`
+	// Only forward items matching at least one of these attributes.
+	IncludeAttributes []*OtelForwardingAttributeMatchResponseBody `form:"include_attributes,omitempty" json:"include_attributes,omitempty" xml:"include_attributes,omitempty"`
+	// Never forward items matching any of these attributes.
+	ExcludeAttributes []*OtelForwardingAttributeMatchResponseBody `form:"exclude_attributes,omitempty" json:"exclude_attributes,omitempty" xml:"exclude_attributes,omitempty"`
+}
+
+// OtelForwardingAttributeMatchResponseBody is used to define fields on
+// response body types.
+type OtelForwardingAttributeMatchResponseBody struct {
+	// Attribute key.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Value the attribute must equal, compared as text. Omit to match any value.
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// OtelForwardingScrubRuleResponseBody is used to define fields on response
+// body types.
+type OtelForwardingScrubRuleResponseBody struct {
+	// Attribute key pattern; may use * wildcards, e.g. gen_ai.tool.call.*.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// drop removes the attribute, redact replaces its value with [REDACTED], hash
+	// replaces it with its SHA-256 hex digest.
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+}
+
+// OtelForwardingFiltersRequestBody is used to define fields on request body
+// types.
+type OtelForwardingFiltersRequestBody struct {
+	// Only forward items whose name matches one of these patterns.
+	IncludeNames []string `form:"include_names,omitempty" json:"include_names,omitempty" xml:"include_names,omitempty"`
+	// Never forward items whose name matches one of these patterns.
+	ExcludeNames []string `form:"exclude_names,omitempty" json:"exclude_names,omitempty" xml:"exclude_names,omitempty"`
+	// Only forward items matching at least one of these attributes.
+	IncludeAttributes []*OtelForwardingAttributeMatchRequestBody `form:"include_attributes,omitempty" json:"include_attributes,omitempty" xml:"include_attributes,omitempty"`
+	// Never forward items matching any of these attributes.
+	ExcludeAttributes []*OtelForwardingAttributeMatchRequestBody `form:"exclude_attributes,omitempty" json:"exclude_attributes,omitempty" xml:"exclude_attributes,omitempty"`
+}
+
+// OtelForwardingAttributeMatchRequestBody is used to define fields on request
+// body types.
+type OtelForwardingAttributeMatchRequestBody struct {
+	// Attribute key.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Value the attribute must equal, compared as text. Omit to match any value.
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// OtelForwardingScrubRuleRequestBody is used to define fields on request body
+// types.
+type OtelForwardingScrubRuleRequestBody struct {
+	// Attribute key pattern; may use * wildcards, e.g. gen_ai.tool.call.*.
+	Key string `form:"key" json:"key" xml:"key"`
+	// drop removes the attribute, redact replaces its value with [REDACTED], hash
+	// replaces it with its SHA-256 hex digest.
+	Action string `form:"action" json:"action" xml:"action"`
+}
+
+// NewUpsertConfigRequestBody builds the HTTP request body from the payload of
+// the "upsertConfig" endpoint of the "otelForwarding" service.
+func NewUpsertConfigRequestBody(p *otelforwarding.UpsertConfigPayload) *UpsertConfigRequestBody {
+	body := &UpsertConfigRequestBody{
+		EndpointURL: p.EndpointURL,
+		Enabled:     p.Enabled,
+	}
+	if p.Headers != nil {
+		body.Headers = make([]*OtelForwardingHeaderInputRequestBody, len(p.Headers))
+		for i, val := range p.Headers {
+			if val == nil {
+				body.Headers[i] = nil
+				continue
+			}
+			body.Headers[i] = marshalOtelforwardingOtelForwardingHeaderInputToOtelForwardingHeaderInputRequestBody(val)
+		}
+	}
+	return body
+}
+
+// NewCreateDestinationRequestBody builds the HTTP request body from the
+// payload of the "createDestination" endpoint of the "otelForwarding" service.
+func NewCreateDestinationRequestBody(p *otelforwarding.CreateDestinationPayload) *CreateDestinationRequestBody {
+	body := &CreateDestinationRequestBody{
+		Name:          p.Name,
+		EndpointURL:   p.EndpointURL,
+		Protocol:      p.Protocol,
+		Enabled:       p.Enabled,
+		SamplingRatio: p.SamplingRatio,
+	}
+	{
+		var zero string
+		if body.Protocol == zero {
+			body.Protocol = "http/protobuf"
+		}
+	}
+	{
+		var zero bool
+		if body.Enabled == zero {
+			body.Enabled = true
+		}
+	}
+	if p.Headers != nil {
+		body.Headers = make([]*OtelForwardingHeaderInputRequestBody, len(p.Headers))
+		for i, val := range p.Headers {
+			if val == nil {
+				body.Headers[i] = nil
+				continue
+			}
+			body.Headers[i] = marshalOtelforwardingOtelForwardingHeaderInputToOtelForwardingHeaderInputRequestBody(val)
+		}
+	}
+	{
+		var zero float64
+		if body.SamplingRatio == zero {
+			body.SamplingRatio = 1
+		}
+	}
+	if p.Filters != nil {
+		body.Filters = marshalOtelforwardingOtelForwardingFiltersToOtelForwardingFiltersRequestBody(p.Filters)
+	}
+	if p.ScrubRules != nil {
+		body.ScrubRules = make([]*OtelForwardingScrubRuleRequestBody, len(p.ScrubRules))
+		for i, val := range p.ScrubRules {
+			if val == nil {
+				body.ScrubRules[i] = nil
+				continue
+			}
+			body.ScrubRules[i] = marshalOtelforwardingOtelForwardingScrubRuleToOtelForwardingScrubRuleRequestBody(val)
+		}
+	}
+	return body
+}
+
+// NewUpdateDestinationRequestBody builds the HTTP request body from the
+// payload of the "updateDestination" endpoint of the "otelForwarding" service.
+func NewUpdateDestinationRequestBody(p *otelforwarding.UpdateDestinationPayload) *UpdateDestinationRequestBody {
+	body := &UpdateDestinationRequestBody{
+		ID:            p.ID,
+		Name:          p.Name,
+		EndpointURL:   p.EndpointURL,
+		Protocol:      p.Protocol,
+		Enabled:       p.Enabled,
+		SamplingRatio: p.SamplingRatio,
+	}
+	{
+		var zero string
+		if body.Protocol == zero {
+			body.Protocol = "http/protobuf"
+		}
+	}
+	{
+		var zero bool
+		if body.Enabled == zero {
+			body.Enabled = true
+		}
+	}
+	if p.Headers != nil {
+		body.Headers = make([]*OtelForwardingHeaderInputRequestBody, len(p.Headers))
+		for i, val := range p.Headers {
+			if val == nil {
+				body.Headers[i] = nil
+				continue
+			}
+			body.Headers[i] = marshalOtelforwardingOtelForwardingHeaderInputToOtelForwardingHeaderInputRequestBody(val)
+		}
+	}
+	{
+		var zero float64
+		if body.SamplingRatio == zero {
+			body.SamplingRatio = 1
+		}
+	}
+	if p.Filters != nil {
+		body.Filters = marshalOtelforwardingOtelForwardingFiltersToOtelForwardingFiltersRequestBody(p.Filters)
+	}
+	if p.ScrubRules != nil {
+		body.ScrubRules = make([]*OtelForwardingScrubRuleRequestBody, len(p.ScrubRules))
+		for i, val := range p.ScrubRules {
+			if val == nil {
+				body.ScrubRules[i] = nil
+				continue
+			}
+			body.ScrubRules[i] = marshalOtelforwardingOtelForwardingScrubRuleToOtelForwardingScrubRuleRequestBody(val)
+		}
+	}
+	return body
+}
+
+// NewDeleteDestinationRequestBody builds the HTTP request body from the
+// payload of the "deleteDestination" endpoint of the "otelForwarding" service.
+func NewDeleteDestinationRequestBody(p *otelforwarding.DeleteDestinationPayload) *DeleteDestinationRequestBody {
+	body := &DeleteDestinationRequestBody{
+		ID: p.ID,
+	}
+	return body
+}
+
+// NewGetConfigOtelForwardingConfigOK builds a "otelForwarding" service
+// "getConfig" endpoint result from a HTTP "OK" response.
+func NewGetConfigOtelForwardingConfigOK(body *GetConfigResponseBody) *otelforwarding.OtelForwardingConfig {
+	v := &otelforwarding.OtelForwardingConfig{
+		ID:             body.ID,
+		OrganizationID: *body.OrganizationID,
+		EndpointURL:    *body.EndpointURL,
+		Enabled:        *body.Enabled,
+		CreatedAt:      body.CreatedAt,
+		UpdatedAt:      body.UpdatedAt,
+	}
+	v.Headers = make([]*otelforwarding.OtelForwardingHeader, len(body.Headers))
+	for i, val := range body.Headers {
+		if val == nil {
+			v.Headers[i] = nil
+			continue
+		}
+		v.Headers[i] = unmarshalOtelForwardingHeaderResponseBodyToOtelforwardingOtelForwardingHeader(val)
+	}
+
+	return v
+}
+
+// NewGetConfigUnauthorized builds a otelForwarding service getConfig endpoint
+// unauthorized error.
+func NewGetConfigUnauthorized(body *GetConfigUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetConfigForbidden builds a otelForwarding service getConfig endpoint
+// forbidden error.
+func NewGetConfigForbidden(body *GetConfigForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetConfigBadRequest builds a otelForwarding service getConfig endpoint
+// bad_request error.
+func NewGetConfigBadRequest(body *GetConfigBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetConfigNotFound builds a otelForwarding service getConfig endpoint
+// not_found error.
+func NewGetConfigNotFound(body *GetConfigNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetConfigConflict builds a otelForwarding service getConfig endpoint
 // conflict error.
 func NewGetConfigConflict(body *GetConfigConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
@@ -754,472 +1856,2285 @@ func NewGetConfigConflict(body *GetConfigConflictResponseBody) *goa.ServiceError
 		Fault:     *body.Fault,
 	}
 
-	return v
+	return v
+}
+
+// NewGetConfigUnsupportedMedia builds a otelForwarding service getConfig
+// endpoint unsupported_media error.
+func NewGetConfigUnsupportedMedia(body *GetConfigUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetConfigInvalid builds a otelForwarding service getConfig endpoint
+// invalid error.
+func NewGetConfigInvalid(body *GetConfigInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetConfigInvariantViolation builds a otelForwarding service getConfig
+// endpoint invariant_violation error.
+func NewGetConfigInvariantViolation(body *GetConfigInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetConfigUnexpected builds a otelForwarding service getConfig endpoint
+// unexpected error.
+func NewGetConfigUnexpected(body *GetConfigUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetConfigGatewayError builds a otelForwarding service getConfig endpoint
+// gateway_error error.
+func NewGetConfigGatewayError(body *GetConfigGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpsertConfigOtelForwardingConfigOK builds a "otelForwarding" service
+// "upsertConfig" endpoint result from a HTTP "OK" response.
+func NewUpsertConfigOtelForwardingConfigOK(body *UpsertConfigResponseBody) *otelforwarding.OtelForwardingConfig {
+	v := &otelforwarding.OtelForwardingConfig{
+		ID:             body.ID,
+		OrganizationID: *body.OrganizationID,
+		EndpointURL:    *body.EndpointURL,
+		Enabled:        *body.Enabled,
+		CreatedAt:      body.CreatedAt,
+		UpdatedAt:      body.UpdatedAt,
+	}
+	v.Headers = make([]*otelforwarding.OtelForwardingHeader, len(body.Headers))
+	for i, val := range body.Headers {
+		if val == nil {
+			v.Headers[i] = nil
+			continue
+		}
+		v.Headers[i] = unmarshalOtelForwardingHeaderResponseBodyToOtelforwardingOtelForwardingHeader(val)
+	}
+
+	return v
+}
+
+// NewUpsertConfigUnauthorized builds a otelForwarding service upsertConfig
+// endpoint unauthorized error.
+func NewUpsertConfigUnauthorized(body *UpsertConfigUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpsertConfigForbidden builds a otelForwarding service upsertConfig
+// endpoint forbidden error.
+func NewUpsertConfigForbidden(body *UpsertConfigForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpsertConfigBadRequest builds a otelForwarding service upsertConfig
+// endpoint bad_request error.
+func NewUpsertConfigBadRequest(body *UpsertConfigBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpsertConfigNotFound builds a otelForwarding service upsertConfig
+// endpoint not_found error.
+func NewUpsertConfigNotFound(body *UpsertConfigNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpsertConfigConflict builds a otelForwarding service upsertConfig
+// endpoint conflict error.
+func NewUpsertConfigConflict(body *UpsertConfigConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpsertConfigUnsupportedMedia builds a otelForwarding service upsertConfig
+// endpoint unsupported_media error.
+func NewUpsertConfigUnsupportedMedia(body *UpsertConfigUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpsertConfigInvalid builds a otelForwarding service upsertConfig endpoint
+// invalid error.
+func NewUpsertConfigInvalid(body *UpsertConfigInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpsertConfigInvariantViolation builds a otelForwarding service
+// upsertConfig endpoint invariant_violation error.
+func NewUpsertConfigInvariantViolation(body *UpsertConfigInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpsertConfigUnexpected builds a otelForwarding service upsertConfig
+// endpoint unexpected error.
+func NewUpsertConfigUnexpected(body *UpsertConfigUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpsertConfigGatewayError builds a otelForwarding service upsertConfig
+// endpoint gateway_error error.
+func NewUpsertConfigGatewayError(body *UpsertConfigGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteConfigUnauthorized builds a otelForwarding service deleteConfig
+// endpoint unauthorized error.
+func NewDeleteConfigUnauthorized(body *DeleteConfigUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteConfigForbidden builds a otelForwarding service deleteConfig
+// endpoint forbidden error.
+func NewDeleteConfigForbidden(body *DeleteConfigForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteConfigBadRequest builds a otelForwarding service deleteConfig
+// endpoint bad_request error.
+func NewDeleteConfigBadRequest(body *DeleteConfigBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteConfigNotFound builds a otelForwarding service deleteConfig
+// endpoint not_found error.
+func NewDeleteConfigNotFound(body *DeleteConfigNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteConfigConflict builds a otelForwarding service deleteConfig
+// endpoint conflict error.
+func NewDeleteConfigConflict(body *DeleteConfigConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteConfigUnsupportedMedia builds a otelForwarding service deleteConfig
+// endpoint unsupported_media error.
+func NewDeleteConfigUnsupportedMedia(body *DeleteConfigUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteConfigInvalid builds a otelForwarding service deleteConfig endpoint
+// invalid error.
+func NewDeleteConfigInvalid(body *DeleteConfigInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteConfigInvariantViolation builds a otelForwarding service
+// deleteConfig endpoint invariant_violation error.
+func NewDeleteConfigInvariantViolation(body *DeleteConfigInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteConfigUnexpected builds a otelForwarding service deleteConfig
+// endpoint unexpected error.
+func NewDeleteConfigUnexpected(body *DeleteConfigUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteConfigGatewayError builds a otelForwarding service deleteConfig
+// endpoint gateway_error error.
+func NewDeleteConfigGatewayError(body *DeleteConfigGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListDestinationsListOtelForwardingDestinationsResultOK builds a
+// "otelForwarding" service "listDestinations" endpoint result from a HTTP "OK"
+// response.
+func NewListDestinationsListOtelForwardingDestinationsResultOK(body *ListDestinationsResponseBody) *otelforwarding.ListOtelForwardingDestinationsResult {
+	v := &otelforwarding.ListOtelForwardingDestinationsResult{}
+	v.Destinations = make([]*otelforwarding.OtelForwardingDestination, len(body.Destinations))
+	for i, val := range body.Destinations {
+		if val == nil {
+			v.Destinations[i] = nil
+			continue
+		}
+		v.Destinations[i] = unmarshalOtelForwardingDestinationResponseBodyToOtelforwardingOtelForwardingDestination(val)
+	}
+
+	return v
+}
+
+// NewListDestinationsUnauthorized builds a otelForwarding service
+// listDestinations endpoint unauthorized error.
+func NewListDestinationsUnauthorized(body *ListDestinationsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListDestinationsForbidden builds a otelForwarding service
+// listDestinations endpoint forbidden error.
+func NewListDestinationsForbidden(body *ListDestinationsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListDestinationsBadRequest builds a otelForwarding service
+// listDestinations endpoint bad_request error.
+func NewListDestinationsBadRequest(body *ListDestinationsBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListDestinationsNotFound builds a otelForwarding service listDestinations
+// endpoint not_found error.
+func NewListDestinationsNotFound(body *ListDestinationsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListDestinationsConflict builds a otelForwarding service listDestinations
+// endpoint conflict error.
+func NewListDestinationsConflict(body *ListDestinationsConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListDestinationsUnsupportedMedia builds a otelForwarding service
+// listDestinations endpoint unsupported_media error.
+func NewListDestinationsUnsupportedMedia(body *ListDestinationsUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListDestinationsInvalid builds a otelForwarding service listDestinations
+// endpoint invalid error.
+func NewListDestinationsInvalid(body *ListDestinationsInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListDestinationsInvariantViolation builds a otelForwarding service
+// listDestinations endpoint invariant_violation error.
+func NewListDestinationsInvariantViolation(body *ListDestinationsInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListDestinationsUnexpected builds a otelForwarding service
+// listDestinations endpoint unexpected error.
+func NewListDestinationsUnexpected(body *ListDestinationsUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListDestinationsGatewayError builds a otelForwarding service
+// listDestinations endpoint gateway_error error.
+func NewListDestinationsGatewayError(body *ListDestinationsGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateDestinationOtelForwardingDestinationOK builds a "otelForwarding"
+// service "createDestination" endpoint result from a HTTP "OK" response.
+func NewCreateDestinationOtelForwardingDestinationOK(body *CreateDestinationResponseBody) *otelforwarding.OtelForwardingDestination {
+	v := &otelforwarding.OtelForwardingDestination{
+		ID:             *body.ID,
+		OrganizationID: *body.OrganizationID,
+		Name:           *body.Name,
+		EndpointURL:    *body.EndpointURL,
+		Protocol:       *body.Protocol,
+		Enabled:        *body.Enabled,
+		SamplingRatio:  *body.SamplingRatio,
+		CreatedAt:      *body.CreatedAt,
+		UpdatedAt:      *body.UpdatedAt,
+	}
+	v.Headers = make([]*otelforwarding.OtelForwardingHeader, len(body.Headers))
+	for i, val := range body.Headers {
+		if val == nil {
+			v.Headers[i] = nil
+			continue
+		}
+		v.Headers[i] = unmarshalOtelForwardingHeaderResponseBodyToOtelforwardingOtelForwardingHeader(val)
+	}
+	v.Filters = unmarshalOtelForwardingFiltersResponseBodyToOtelforwardingOtelForwardingFilters(body.Filters)
+	v.ScrubRules = make([]*otelforwarding.OtelForwardingScrubRule, len(body.ScrubRules))
+	for i, val := range body.ScrubRules {
+		if val == nil {
+			v.ScrubRules[i] = nil
+			continue
+		}
+		v.ScrubRules[i] = unmarshalOtelForwardingScrubRuleResponseBodyToOtelforwardingOtelForwardingScrubRule(val)
+	}
+
+	return v
+}
+
+// NewCreateDestinationUnauthorized builds a otelForwarding service
+// createDestination endpoint unauthorized error.
+func NewCreateDestinationUnauthorized(body *CreateDestinationUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateDestinationForbidden builds a otelForwarding service
+// createDestination endpoint forbidden error.
+func NewCreateDestinationForbidden(body *CreateDestinationForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateDestinationBadRequest builds a otelForwarding service
+// createDestination endpoint bad_request error.
+func NewCreateDestinationBadRequest(body *CreateDestinationBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateDestinationNotFound builds a otelForwarding service
+// createDestination endpoint not_found error.
+func NewCreateDestinationNotFound(body *CreateDestinationNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateDestinationConflict builds a otelForwarding service
+// createDestination endpoint conflict error.
+func NewCreateDestinationConflict(body *CreateDestinationConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateDestinationUnsupportedMedia builds a otelForwarding service
+// createDestination endpoint unsupported_media error.
+func NewCreateDestinationUnsupportedMedia(body *CreateDestinationUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateDestinationInvalid builds a otelForwarding service
+// createDestination endpoint invalid error.
+func NewCreateDestinationInvalid(body *CreateDestinationInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateDestinationInvariantViolation builds a otelForwarding service
+// createDestination endpoint invariant_violation error.
+func NewCreateDestinationInvariantViolation(body *CreateDestinationInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateDestinationUnexpected builds a otelForwarding service
+// createDestination endpoint unexpected error.
+func NewCreateDestinationUnexpected(body *CreateDestinationUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateDestinationGatewayError builds a otelForwarding service
+// createDestination endpoint gateway_error error.
+func NewCreateDestinationGatewayError(body *CreateDestinationGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateDestinationOtelForwardingDestinationOK builds a "otelForwarding"
+// service "updateDestination" endpoint result from a HTTP "OK" response.
+func NewUpdateDestinationOtelForwardingDestinationOK(body *UpdateDestinationResponseBody) *otelforwarding.OtelForwardingDestination {
+	v := &otelforwarding.OtelForwardingDestination{
+		ID:             *body.ID,
+		OrganizationID: *body.OrganizationID,
+		Name:           *body.Name,
+		EndpointURL:    *body.EndpointURL,
+		Protocol:       *body.Protocol,
+		Enabled:        *body.Enabled,
+		SamplingRatio:  *body.SamplingRatio,
+		CreatedAt:      *body.CreatedAt,
+		UpdatedAt:      *body.UpdatedAt,
+	}
+	v.Headers = make([]*otelforwarding.OtelForwardingHeader, len(body.Headers))
+	for i, val := range body.Headers {
+		if val == nil {
+			v.Headers[i] = nil
+			continue
+		}
+		v.Headers[i] = unmarshalOtelForwardingHeaderResponseBodyToOtelforwardingOtelForwardingHeader(val)
+	}
+	v.Filters = unmarshalOtelForwardingFiltersResponseBodyToOtelforwardingOtelForwardingFilters(body.Filters)
+	v.ScrubRules = make([]*otelforwarding.OtelForwardingScrubRule, len(body.ScrubRules))
+	for i, val := range body.ScrubRules {
+		if val == nil {
+			v.ScrubRules[i] = nil
+			continue
+		}
+		v.ScrubRules[i] = unmarshalOtelForwardingScrubRuleResponseBodyToOtelforwardingOtelForwardingScrubRule(val)
+	}
+
+	return v
+}
+
+// NewUpdateDestinationUnauthorized builds a otelForwarding service
+// updateDestination endpoint unauthorized error.
+func NewUpdateDestinationUnauthorized(body *UpdateDestinationUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateDestinationForbidden builds a otelForwarding service
+// updateDestination endpoint forbidden error.
+func NewUpdateDestinationForbidden(body *UpdateDestinationForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateDestinationBadRequest builds a otelForwarding service
+// updateDestination endpoint bad_request error.
+func NewUpdateDestinationBadRequest(body *UpdateDestinationBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateDestinationNotFound builds a otelForwarding service
+// updateDestination endpoint not_found error.
+func NewUpdateDestinationNotFound(body *UpdateDestinationNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateDestinationConflict builds a otelForwarding service
+// updateDestination endpoint conflict error.
+func NewUpdateDestinationConflict(body *UpdateDestinationConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateDestinationUnsupportedMedia builds a otelForwarding service
+// updateDestination endpoint unsupported_media error.
+func NewUpdateDestinationUnsupportedMedia(body *UpdateDestinationUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateDestinationInvalid builds a otelForwarding service
+// updateDestination endpoint invalid error.
+func NewUpdateDestinationInvalid(body *UpdateDestinationInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateDestinationInvariantViolation builds a otelForwarding service
+// updateDestination endpoint invariant_violation error.
+func NewUpdateDestinationInvariantViolation(body *UpdateDestinationInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateDestinationUnexpected builds a otelForwarding service
+// updateDestination endpoint unexpected error.
+func NewUpdateDestinationUnexpected(body *UpdateDestinationUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateDestinationGatewayError builds a otelForwarding service
+// updateDestination endpoint gateway_error error.
+func NewUpdateDestinationGatewayError(body *UpdateDestinationGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteDestinationUnauthorized builds a otelForwarding service
+// deleteDestination endpoint unauthorized error.
+func NewDeleteDestinationUnauthorized(body *DeleteDestinationUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteDestinationForbidden builds a otelForwarding service
+// deleteDestination endpoint forbidden error.
+func NewDeleteDestinationForbidden(body *DeleteDestinationForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteDestinationBadRequest builds a otelForwarding service
+// deleteDestination endpoint bad_request error.
+func NewDeleteDestinationBadRequest(body *DeleteDestinationBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteDestinationNotFound builds a otelForwarding service
+// deleteDestination endpoint not_found error.
+func NewDeleteDestinationNotFound(body *DeleteDestinationNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteDestinationConflict builds a otelForwarding service
+// deleteDestination endpoint conflict error.
+func NewDeleteDestinationConflict(body *DeleteDestinationConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteDestinationUnsupportedMedia builds a otelForwarding service
+// deleteDestination endpoint unsupported_media error.
+func NewDeleteDestinationUnsupportedMedia(body *DeleteDestinationUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteDestinationInvalid builds a otelForwarding service
+// deleteDestination endpoint invalid error.
+func NewDeleteDestinationInvalid(body *DeleteDestinationInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteDestinationInvariantViolation builds a otelForwarding service
+// deleteDestination endpoint invariant_violation error.
+func NewDeleteDestinationInvariantViolation(body *DeleteDestinationInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteDestinationUnexpected builds a otelForwarding service
+// deleteDestination endpoint unexpected error.
+func NewDeleteDestinationUnexpected(body *DeleteDestinationUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteDestinationGatewayError builds a otelForwarding service
+// deleteDestination endpoint gateway_error error.
+func NewDeleteDestinationGatewayError(body *DeleteDestinationGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateGetConfigResponseBody runs the validations defined on
+// GetConfigResponseBody
+func ValidateGetConfigResponseBody(body *GetConfigResponseBody) (err error) {
+	if body.OrganizationID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	}
+	if body.EndpointURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_url", "body"))
+	}
+	if body.Enabled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("enabled", "body"))
+	}
+	if body.Headers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("headers", "body"))
+	}
+	for _, e := range body.Headers {
+		if e != nil {
+			if err2 := ValidateOtelForwardingHeaderResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateUpsertConfigResponseBody runs the validations defined on
+// UpsertConfigResponseBody
+func ValidateUpsertConfigResponseBody(body *UpsertConfigResponseBody) (err error) {
+	if body.OrganizationID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	}
+	if body.EndpointURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_url", "body"))
+	}
+	if body.Enabled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("enabled", "body"))
+	}
+	if body.Headers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("headers", "body"))
+	}
+	for _, e := range body.Headers {
+		if e != nil {
+			if err2 := ValidateOtelForwardingHeaderResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListDestinationsResponseBody runs the validations defined on
+// ListDestinationsResponseBody
+func ValidateListDestinationsResponseBody(body *ListDestinationsResponseBody) (err error) {
+	if body.Destinations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("destinations", "body"))
+	}
+	for _, e := range body.Destinations {
+		if e != nil {
+			if err2 := ValidateOtelForwardingDestinationResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateDestinationResponseBody runs the validations defined on
+// CreateDestinationResponseBody
+func ValidateCreateDestinationResponseBody(body *CreateDestinationResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.OrganizationID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.EndpointURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_url", "body"))
+	}
+	if body.Protocol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("protocol", "body"))
+	}
+	if body.Enabled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("enabled", "body"))
+	}
+	if body.Headers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("headers", "body"))
+	}
+	if body.SamplingRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sampling_ratio", "body"))
+	}
+	if body.Filters == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("filters", "body"))
+	}
+	if body.ScrubRules == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scrub_rules", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.Protocol != nil {
+		if !(*body.Protocol == "http/protobuf" || *body.Protocol == "http/json" || *body.Protocol == "grpc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.protocol", *body.Protocol, []any{"http/protobuf", "http/json", "grpc"}))
+		}
+	}
+	for _, e := range body.Headers {
+		if e != nil {
+			if err2 := ValidateOtelForwardingHeaderResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Filters != nil {
+		if err2 := ValidateOtelForwardingFiltersResponseBody(body.Filters); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.ScrubRules {
+		if e != nil {
+			if err2 := ValidateOtelForwardingScrubRuleResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateUpdateDestinationResponseBody runs the validations defined on
+// UpdateDestinationResponseBody
+func ValidateUpdateDestinationResponseBody(body *UpdateDestinationResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.OrganizationID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.EndpointURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_url", "body"))
+	}
+	if body.Protocol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("protocol", "body"))
+	}
+	if body.Enabled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("enabled", "body"))
+	}
+	if body.Headers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("headers", "body"))
+	}
+	if body.SamplingRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sampling_ratio", "body"))
+	}
+	if body.Filters == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("filters", "body"))
+	}
+	if body.ScrubRules == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scrub_rules", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.Protocol != nil {
+		if !(*body.Protocol == "http/protobuf" || *body.Protocol == "http/json" || *body.Protocol == "grpc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.protocol", *body.Protocol, []any{"http/protobuf", "http/json", "grpc"}))
+		}
+	}
+	for _, e := range body.Headers {
+		if e != nil {
+			if err2 := ValidateOtelForwardingHeaderResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Filters != nil {
+		if err2 := ValidateOtelForwardingFiltersResponseBody(body.Filters); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.ScrubRules {
+		if e != nil {
+			if err2 := ValidateOtelForwardingScrubRuleResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetConfigUnauthorizedResponseBody runs the validations defined on
+// getConfig_unauthorized_response_body
+func ValidateGetConfigUnauthorizedResponseBody(body *GetConfigUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetConfigForbiddenResponseBody runs the validations defined on
+// getConfig_forbidden_response_body
+func ValidateGetConfigForbiddenResponseBody(body *GetConfigForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetConfigBadRequestResponseBody runs the validations defined on
+// getConfig_bad_request_response_body
+func ValidateGetConfigBadRequestResponseBody(body *GetConfigBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetConfigNotFoundResponseBody runs the validations defined on
+// getConfig_not_found_response_body
+func ValidateGetConfigNotFoundResponseBody(body *GetConfigNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetConfigConflictResponseBody runs the validations defined on
+// getConfig_conflict_response_body
+func ValidateGetConfigConflictResponseBody(body *GetConfigConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetConfigUnsupportedMediaResponseBody runs the validations defined
+// on getConfig_unsupported_media_response_body
+func ValidateGetConfigUnsupportedMediaResponseBody(body *GetConfigUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetConfigInvalidResponseBody runs the validations defined on
+// getConfig_invalid_response_body
+func ValidateGetConfigInvalidResponseBody(body *GetConfigInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetConfigUnsupportedMedia builds a otelForwarding service getConfig
-// endpoint unsupported_media error.
-func NewGetConfigUnsupportedMedia(body *GetConfigUnsupportedMediaResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetConfigInvariantViolationResponseBody runs the validations defined
+// on getConfig_invariant_violation_response_body
+func ValidateGetConfigInvariantViolationResponseBody(body *GetConfigInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateGetConfigUnexpectedResponseBody runs the validations defined on
+// getConfig_unexpected_response_body
+func ValidateGetConfigUnexpectedResponseBody(body *GetConfigUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetConfigInvalid builds a otelForwarding service getConfig endpoint
-// invalid error.
-func NewGetConfigInvalid(body *GetConfigInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetConfigGatewayErrorResponseBody runs the validations defined on
+// getConfig_gateway_error_response_body
+func ValidateGetConfigGatewayErrorResponseBody(body *GetConfigGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateUpsertConfigUnauthorizedResponseBody runs the validations defined on
+// upsertConfig_unauthorized_response_body
+func ValidateUpsertConfigUnauthorizedResponseBody(body *UpsertConfigUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetConfigInvariantViolation builds a otelForwarding service getConfig
-// endpoint invariant_violation error.
-func NewGetConfigInvariantViolation(body *GetConfigInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpsertConfigForbiddenResponseBody runs the validations defined on
+// upsertConfig_forbidden_response_body
+func ValidateUpsertConfigForbiddenResponseBody(body *UpsertConfigForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpsertConfigBadRequestResponseBody runs the validations defined on
+// upsertConfig_bad_request_response_body
+func ValidateUpsertConfigBadRequestResponseBody(body *UpsertConfigBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpsertConfigNotFoundResponseBody runs the validations defined on
+// upsertConfig_not_found_response_body
+func ValidateUpsertConfigNotFoundResponseBody(body *UpsertConfigNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpsertConfigConflictResponseBody runs the validations defined on
+// upsertConfig_conflict_response_body
+func ValidateUpsertConfigConflictResponseBody(body *UpsertConfigConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewGetConfigUnexpected builds a otelForwarding service getConfig endpoint
-// unexpected error.
-func NewGetConfigUnexpected(body *GetConfigUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpsertConfigUnsupportedMediaResponseBody runs the validations
+// defined on upsertConfig_unsupported_media_response_body
+func ValidateUpsertConfigUnsupportedMediaResponseBody(body *UpsertConfigUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetConfigGatewayError builds a otelForwarding service getConfig endpoint
-// gateway_error error.
-func NewGetConfigGatewayError(body *GetConfigGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpsertConfigInvalidResponseBody runs the validations defined on
+// upsertConfig_invalid_response_body
+func ValidateUpsertConfigInvalidResponseBody(body *UpsertConfigInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpsertConfigOtelForwardingConfigOK builds a "otelForwarding" service
-// "upsertConfig" endpoint result from a HTTP "OK" response.
-func NewUpsertConfigOtelForwardingConfigOK(body *UpsertConfigResponseBody) *otelforwarding.OtelForwardingConfig {
-	v := &otelforwarding.OtelForwardingConfig{
-		ID:             body.ID,
-		OrganizationID: *body.OrganizationID,
-		EndpointURL:    *body.EndpointURL,
-		Enabled:        *body.Enabled,
-		CreatedAt:      body.CreatedAt,
-		UpdatedAt:      body.UpdatedAt,
+// ValidateUpsertConfigInvariantViolationResponseBody runs the validations
+// defined on upsertConfig_invariant_violation_response_body
+func ValidateUpsertConfigInvariantViolationResponseBody(body *UpsertConfigInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	v.Headers = make([]*otelforwarding.OtelForwardingHeader, len(body.Headers))
-	for i, val := range body.Headers {
-		if val == nil {
-			v.Headers[i] = nil
-			continue
-		}
-		v.Headers[i] = unmarshalOtelForwardingHeaderResponseBodyToOtelforwardingOtelForwardingHeader(val)
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpsertConfigUnauthorized builds a otelForwarding service upsertConfig
-// endpoint unauthorized error.
-func NewUpsertConfigUnauthorized(body *UpsertConfigUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpsertConfigUnexpectedResponseBody runs the validations defined on
+// upsertConfig_unexpected_response_body
+func ValidateUpsertConfigUnexpectedResponseBody(body *UpsertConfigUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpsertConfigForbidden builds a otelForwarding service upsertConfig
-// endpoint forbidden error.
-func NewUpsertConfigForbidden(body *UpsertConfigForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpsertConfigGatewayErrorResponseBody runs the validations defined on
+// upsertConfig_gateway_error_response_body
+func ValidateUpsertConfigGatewayErrorResponseBody(body *UpsertConfigGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpsertConfigBadRequest builds a otelForwarding service upsertConfig
-// endpoint bad_request error.
-func NewUpsertConfigBadRequest(body *UpsertConfigBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteConfigUnauthorizedResponseBody runs the validations defined on
+// deleteConfig_unauthorized_response_body
+func ValidateDeleteConfigUnauthorizedResponseBody(body *DeleteConfigUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
 
-	return v
+// ValidateDeleteConfigForbiddenResponseBody runs the validations defined on
+// deleteConfig_forbidden_response_body
+func ValidateDeleteConfigForbiddenResponseBody(body *DeleteConfigForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpsertConfigNotFound builds a otelForwarding service upsertConfig
-// endpoint not_found error.
-func NewUpsertConfigNotFound(body *UpsertConfigNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteConfigBadRequestResponseBody runs the validations defined on
+// deleteConfig_bad_request_response_body
+func ValidateDeleteConfigBadRequestResponseBody(body *DeleteConfigBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-
-	return v
-}
-
-// NewUpsertConfigConflict builds a otelForwarding service upsertConfig
-// endpoint conflict error.
-func NewUpsertConfigConflict(body *UpsertConfigConflictResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewUpsertConfigUnsupportedMedia builds a otelForwarding service upsertConfig
-// endpoint unsupported_media error.
-func NewUpsertConfigUnsupportedMedia(body *UpsertConfigUnsupportedMediaResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteConfigNotFoundResponseBody runs the validations defined on
+// deleteConfig_not_found_response_body
+func ValidateDeleteConfigNotFoundResponseBody(body *DeleteConfigNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
-}
-
-// NewUpsertConfigInvalid builds a otelForwarding service upsertConfig endpoint
-// invalid error.
-func NewUpsertConfigInvalid(body *UpsertConfigInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpsertConfigInvariantViolation builds a otelForwarding service
-// upsertConfig endpoint invariant_violation error.
-func NewUpsertConfigInvariantViolation(body *UpsertConfigInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteConfigConflictResponseBody runs the validations defined on
+// deleteConfig_conflict_response_body
+func ValidateDeleteConfigConflictResponseBody(body *DeleteConfigConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpsertConfigUnexpected builds a otelForwarding service upsertConfig
-// endpoint unexpected error.
-func NewUpsertConfigUnexpected(body *UpsertConfigUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteConfigUnsupportedMediaResponseBody runs the validations
+// defined on deleteConfig_unsupported_media_response_body
+func ValidateDeleteConfigUnsupportedMediaResponseBody(body *DeleteConfigUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpsertConfigGatewayError builds a otelForwarding service upsertConfig
-// endpoint gateway_error error.
-func NewUpsertConfigGatewayError(body *UpsertConfigGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteConfigInvalidResponseBody runs the validations defined on
+// deleteConfig_invalid_response_body
+func ValidateDeleteConfigInvalidResponseBody(body *DeleteConfigInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteConfigUnauthorized builds a otelForwarding service deleteConfig
-// endpoint unauthorized error.
-func NewDeleteConfigUnauthorized(body *DeleteConfigUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteConfigInvariantViolationResponseBody runs the validations
+// defined on deleteConfig_invariant_violation_response_body
+func ValidateDeleteConfigInvariantViolationResponseBody(body *DeleteConfigInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteConfigForbidden builds a otelForwarding service deleteConfig
-// endpoint forbidden error.
-func NewDeleteConfigForbidden(body *DeleteConfigForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteConfigUnexpectedResponseBody runs the validations defined on
+// deleteConfig_unexpected_response_body
+func ValidateDeleteConfigUnexpectedResponseBody(body *DeleteConfigUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteConfigBadRequest builds a otelForwarding service deleteConfig
-// endpoint bad_request error.
-func NewDeleteConfigBadRequest(body *DeleteConfigBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteConfigGatewayErrorResponseBody runs the validations defined on
+// deleteConfig_gateway_error_response_body
+func ValidateDeleteConfigGatewayErrorResponseBody(body *DeleteConfigGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-
-	return v
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteConfigNotFound builds a otelForwarding service deleteConfig
-// endpoint not_found error.
-func NewDeleteConfigNotFound(body *DeleteConfigNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListDestinationsUnauthorizedResponseBody runs the validations
+// defined on listDestinations_unauthorized_response_body
+func ValidateListDestinationsUnauthorizedResponseBody(body *ListDestinationsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteConfigConflict builds a otelForwarding service deleteConfig
-// endpoint conflict error.
-func NewDeleteConfigConflict(body *DeleteConfigConflictResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListDestinationsForbiddenResponseBody runs the validations defined
+// on listDestinations_forbidden_response_body
+func ValidateListDestinationsForbiddenResponseBody(body *ListDestinationsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteConfigUnsupportedMedia builds a otelForwarding service deleteConfig
-// endpoint unsupported_media error.
-func NewDeleteConfigUnsupportedMedia(body *DeleteConfigUnsupportedMediaResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListDestinationsBadRequestResponseBody runs the validations defined
+// on listDestinations_bad_request_response_body
+func ValidateListDestinationsBadRequestResponseBody(body *ListDestinationsBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteConfigInvalid builds a otelForwarding service deleteConfig endpoint
-// invalid error.
-func NewDeleteConfigInvalid(body *DeleteConfigInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListDestinationsNotFoundResponseBody runs the validations defined on
+// listDestinations_not_found_response_body
+func ValidateListDestinationsNotFoundResponseBody(body *ListDestinationsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteConfigInvariantViolation builds a otelForwarding service
-// deleteConfig endpoint invariant_violation error.
-func NewDeleteConfigInvariantViolation(body *DeleteConfigInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListDestinationsConflictResponseBody runs the validations defined on
+// listDestinations_conflict_response_body
+func ValidateListDestinationsConflictResponseBody(body *ListDestinationsConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteConfigUnexpected builds a otelForwarding service deleteConfig
-// endpoint unexpected error.
-func NewDeleteConfigUnexpected(body *DeleteConfigUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListDestinationsUnsupportedMediaResponseBody runs the validations
+// defined on listDestinations_unsupported_media_response_body
+func ValidateListDestinationsUnsupportedMediaResponseBody(body *ListDestinationsUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewDeleteConfigGatewayError builds a otelForwarding service deleteConfig
-// endpoint gateway_error error.
-func NewDeleteConfigGatewayError(body *DeleteConfigGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListDestinationsInvalidResponseBody runs the validations defined on
+// listDestinations_invalid_response_body
+func ValidateListDestinationsInvalidResponseBody(body *ListDestinationsInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// ValidateGetConfigResponseBody runs the validations defined on
-// GetConfigResponseBody
-func ValidateGetConfigResponseBody(body *GetConfigResponseBody) (err error) {
-	if body.OrganizationID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+// ValidateListDestinationsInvariantViolationResponseBody runs the validations
+// defined on listDestinations_invariant_violation_response_body
+func ValidateListDestinationsInvariantViolationResponseBody(body *ListDestinationsInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.EndpointURL == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_url", "body"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.Enabled == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("enabled", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Headers == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("headers", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	for _, e := range body.Headers {
-		if e != nil {
-			if err2 := ValidateOtelForwardingHeaderResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListDestinationsUnexpectedResponseBody runs the validations defined
+// on listDestinations_unexpected_response_body
+func ValidateListDestinationsUnexpectedResponseBody(body *ListDestinationsUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateUpsertConfigResponseBody runs the validations defined on
-// UpsertConfigResponseBody
-func ValidateUpsertConfigResponseBody(body *UpsertConfigResponseBody) (err error) {
-	if body.OrganizationID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
-	}
-	if body.EndpointURL == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_url", "body"))
+// ValidateListDestinationsGatewayErrorResponseBody runs the validations
+// defined on listDestinations_gateway_error_response_body
+func ValidateListDestinationsGatewayErrorResponseBody(body *ListDestinationsGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.Enabled == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("enabled", "body"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.Headers == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("headers", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	for _, e := range body.Headers {
-		if e != nil {
-			if err2 := ValidateOtelForwardingHeaderResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateGetConfigUnauthorizedResponseBody runs the validations defined on
-// getConfig_unauthorized_response_body
-func ValidateGetConfigUnauthorizedResponseBody(body *GetConfigUnauthorizedResponseBody) (err error) {
+// ValidateCreateDestinationUnauthorizedResponseBody runs the validations
+// defined on createDestination_unauthorized_response_body
+func ValidateCreateDestinationUnauthorizedResponseBody(body *CreateDestinationUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1241,9 +4156,9 @@ func ValidateGetConfigUnauthorizedResponseBody(body *GetConfigUnauthorizedRespon
 	return
 }
 
-// ValidateGetConfigForbiddenResponseBody runs the validations defined on
-// getConfig_forbidden_response_body
-func ValidateGetConfigForbiddenResponseBody(body *GetConfigForbiddenResponseBody) (err error) {
+// ValidateCreateDestinationForbiddenResponseBody runs the validations defined
+// on createDestination_forbidden_response_body
+func ValidateCreateDestinationForbiddenResponseBody(body *CreateDestinationForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1265,9 +4180,9 @@ func ValidateGetConfigForbiddenResponseBody(body *GetConfigForbiddenResponseBody
 	return
 }
 
-// ValidateGetConfigBadRequestResponseBody runs the validations defined on
-// getConfig_bad_request_response_body
-func ValidateGetConfigBadRequestResponseBody(body *GetConfigBadRequestResponseBody) (err error) {
+// ValidateCreateDestinationBadRequestResponseBody runs the validations defined
+// on createDestination_bad_request_response_body
+func ValidateCreateDestinationBadRequestResponseBody(body *CreateDestinationBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1289,9 +4204,9 @@ func ValidateGetConfigBadRequestResponseBody(body *GetConfigBadRequestResponseBo
 	return
 }
 
-// ValidateGetConfigNotFoundResponseBody runs the validations defined on
-// getConfig_not_found_response_body
-func ValidateGetConfigNotFoundResponseBody(body *GetConfigNotFoundResponseBody) (err error) {
+// ValidateCreateDestinationNotFoundResponseBody runs the validations defined
+// on createDestination_not_found_response_body
+func ValidateCreateDestinationNotFoundResponseBody(body *CreateDestinationNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1313,9 +4228,9 @@ func ValidateGetConfigNotFoundResponseBody(body *GetConfigNotFoundResponseBody)
 	return
 }
 
-// ValidateGetConfigConflictResponseBody runs the validations defined on
-// getConfig_conflict_response_body
-func ValidateGetConfigConflictResponseBody(body *GetConfigConflictResponseBody) (err error) {
+// ValidateCreateDestinationConflictResponseBody runs the validations defined
+// on createDestination_conflict_response_body
+func ValidateCreateDestinationConflictResponseBody(body *CreateDestinationConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1337,9 +4252,9 @@ func ValidateGetConfigConflictResponseBody(body *GetConfigConflictResponseBody)
 	return
 }
 
-// ValidateGetConfigUnsupportedMediaResponseBody runs the validations defined
-// on getConfig_unsupported_media_response_body
-func ValidateGetConfigUnsupportedMediaResponseBody(body *GetConfigUnsupportedMediaResponseBody) (err error) {
+// ValidateCreateDestinationUnsupportedMediaResponseBody runs the validations
+// defined on createDestination_unsupported_media_response_body
+func ValidateCreateDestinationUnsupportedMediaResponseBody(body *CreateDestinationUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1361,9 +4276,9 @@ func ValidateGetConfigUnsupportedMediaResponseBody(body *GetConfigUnsupportedMed
 	return
 }
 
-// ValidateGetConfigInvalidResponseBody runs the validations defined on
-// getConfig_invalid_response_body
-func ValidateGetConfigInvalidResponseBody(body *GetConfigInvalidResponseBody) (err error) {
+// ValidateCreateDestinationInvalidResponseBody runs the validations defined on
+// createDestination_invalid_response_body
+func ValidateCreateDestinationInvalidResponseBody(body *CreateDestinationInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1385,9 +4300,9 @@ func ValidateGetConfigInvalidResponseBody(body *GetConfigInvalidResponseBody) (e
 	return
 }
 
-// ValidateGetConfigInvariantViolationResponseBody runs the validations defined
-// on getConfig_invariant_violation_response_body
-func ValidateGetConfigInvariantViolationResponseBody(body *GetConfigInvariantViolationResponseBody) (err error) {
+// ValidateCreateDestinationInvariantViolationResponseBody runs the validations
+// defined on createDestination_invariant_violation_response_body
+func ValidateCreateDestinationInvariantViolationResponseBody(body *CreateDestinationInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1409,9 +4324,9 @@ func ValidateGetConfigInvariantViolationResponseBody(body *GetConfigInvariantVio
 	return
 }
 
-// ValidateGetConfigUnexpectedResponseBody runs the validations defined on
-// getConfig_unexpected_response_body
-func ValidateGetConfigUnexpectedResponseBody(body *GetConfigUnexpectedResponseBody) (err error) {
+// ValidateCreateDestinationUnexpectedResponseBody runs the validations defined
+// on createDestination_unexpected_response_body
+func ValidateCreateDestinationUnexpectedResponseBody(body *CreateDestinationUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1433,9 +4348,9 @@ func ValidateGetConfigUnexpectedResponseBody(body *GetConfigUnexpectedResponseBo
 	return
 }
 
-// ValidateGetConfigGatewayErrorResponseBody runs the validations defined on
-// getConfig_gateway_error_response_body
-func ValidateGetConfigGatewayErrorResponseBody(body *GetConfigGatewayErrorResponseBody) (err error) {
+// ValidateCreateDestinationGatewayErrorResponseBody runs the validations
+// defined on createDestination_gateway_error_response_body
+func ValidateCreateDestinationGatewayErrorResponseBody(body *CreateDestinationGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1457,9 +4372,9 @@ func ValidateGetConfigGatewayErrorResponseBody(body *GetConfigGatewayErrorRespon
 	return
 }
 
-// ValidateUpsertConfigUnauthorizedResponseBody runs the validations defined on
-// upsertConfig_unauthorized_response_body
-func ValidateUpsertConfigUnauthorizedResponseBody(body *UpsertConfigUnauthorizedResponseBody) (err error) {
+// ValidateUpdateDestinationUnauthorizedResponseBody runs the validations
+// defined on updateDestination_unauthorized_response_body
+func ValidateUpdateDestinationUnauthorizedResponseBody(body *UpdateDestinationUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1481,9 +4396,9 @@ func ValidateUpsertConfigUnauthorizedResponseBody(body *UpsertConfigUnauthorized
 	return
 }
 
-// ValidateUpsertConfigForbiddenResponseBody runs the validations defined on
-// upsertConfig_forbidden_response_body
-func ValidateUpsertConfigForbiddenResponseBody(body *UpsertConfigForbiddenResponseBody) (err error) {
+// ValidateUpdateDestinationForbiddenResponseBody runs the validations defined
+// on updateDestination_forbidden_response_body
+func ValidateUpdateDestinationForbiddenResponseBody(body *UpdateDestinationForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1505,9 +4420,9 @@ func ValidateUpsertConfigForbiddenResponseBody(body *UpsertConfigForbiddenRespon
 	return
 }
 
-// ValidateUpsertConfigBadRequestResponseBody runs the validations defined on
-// upsertConfig_bad_request_response_body
-func ValidateUpsertConfigBadRequestResponseBody(body *UpsertConfigBadRequestResponseBody) (err error) {
+// ValidateUpdateDestinationBadRequestResponseBody runs the validations defined
+// on updateDestination_bad_request_response_body
+func ValidateUpdateDestinationBadRequestResponseBody(body *UpdateDestinationBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1529,9 +4444,9 @@ func ValidateUpsertConfigBadRequestResponseBody(body *UpsertConfigBadRequestResp
 	return
 }
 
-// ValidateUpsertConfigNotFoundResponseBody runs the validations defined on
-// upsertConfig_not_found_response_body
-func ValidateUpsertConfigNotFoundResponseBody(body *UpsertConfigNotFoundResponseBody) (err error) {
+// ValidateUpdateDestinationNotFoundResponseBody runs the validations defined
+// on updateDestination_not_found_response_body
+func ValidateUpdateDestinationNotFoundResponseBody(body *UpdateDestinationNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1553,9 +4468,9 @@ func ValidateUpsertConfigNotFoundResponseBody(body *UpsertConfigNotFoundResponse
 	return
 }
 
-// ValidateUpsertConfigConflictResponseBody runs the validations defined on
-// upsertConfig_conflict_response_body
-func ValidateUpsertConfigConflictResponseBody(body *UpsertConfigConflictResponseBody) (err error) {
+// ValidateUpdateDestinationConflictResponseBody runs the validations defined
+// on updateDestination_conflict_response_body
+func ValidateUpdateDestinationConflictResponseBody(body *UpdateDestinationConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1577,9 +4492,9 @@ func ValidateUpsertConfigConflictResponseBody(body *UpsertConfigConflictResponse
 	return
 }
 
-// ValidateUpsertConfigUnsupportedMediaResponseBody runs the validations
-// defined on upsertConfig_unsupported_media_response_body
-func ValidateUpsertConfigUnsupportedMediaResponseBody(body *UpsertConfigUnsupportedMediaResponseBody) (err error) {
+// ValidateUpdateDestinationUnsupportedMediaResponseBody runs the validations
+// defined on updateDestination_unsupported_media_response_body
+func ValidateUpdateDestinationUnsupportedMediaResponseBody(body *UpdateDestinationUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1601,9 +4516,9 @@ func ValidateUpsertConfigUnsupportedMediaResponseBody(body *UpsertConfigUnsuppor
 	return
 }
 
-// ValidateUpsertConfigInvalidResponseBody runs the validations defined on
-// upsertConfig_invalid_response_body
-func ValidateUpsertConfigInvalidResponseBody(body *UpsertConfigInvalidResponseBody) (err error) {
+// ValidateUpdateDestinationInvalidResponseBody runs the validations defined on
+// updateDestination_invalid_response_body
+func ValidateUpdateDestinationInvalidResponseBody(body *UpdateDestinationInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1625,9 +4540,9 @@ func ValidateUpsertConfigInvalidResponseBody(body *UpsertConfigInvalidResponseBo
 	return
 }
 
-// ValidateUpsertConfigInvariantViolationResponseBody runs the validations
-// defined on upsertConfig_invariant_violation_response_body
-func ValidateUpsertConfigInvariantViolationResponseBody(body *UpsertConfigInvariantViolationResponseBody) (err error) {
+// ValidateUpdateDestinationInvariantViolationResponseBody runs the validations
+// defined on updateDestination_invariant_violation_response_body
+func ValidateUpdateDestinationInvariantViolationResponseBody(body *UpdateDestinationInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1649,9 +4564,9 @@ func ValidateUpsertConfigInvariantViolationResponseBody(body *UpsertConfigInvari
 	return
 }
 
-// ValidateUpsertConfigUnexpectedResponseBody runs the validations defined on
-// upsertConfig_unexpected_response_body
-func ValidateUpsertConfigUnexpectedResponseBody(body *UpsertConfigUnexpectedResponseBody) (err error) {
+// ValidateUpdateDestinationUnexpectedResponseBody runs the validations defined
+// on updateDestination_unexpected_response_body
+func ValidateUpdateDestinationUnexpectedResponseBody(body *UpdateDestinationUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1673,9 +4588,9 @@ func ValidateUpsertConfigUnexpectedResponseBody(body *UpsertConfigUnexpectedResp
 	return
 }
 
-// ValidateUpsertConfigGatewayErrorResponseBody runs the validations defined on
-// upsertConfig_gateway_error_response_body
-func ValidateUpsertConfigGatewayErrorResponseBody(body *UpsertConfigGatewayErrorResponseBody) (err error) {
+// ValidateUpdateDestinationGatewayErrorResponseBody runs the validations
+// defined on updateDestination_gateway_error_response_body
+func ValidateUpdateDestinationGatewayErrorResponseBody(body *UpdateDestinationGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1697,9 +4612,9 @@ func ValidateUpsertConfigGatewayErrorResponseBody(body *UpsertConfigGatewayError
 	return
 }
 
-// ValidateDeleteConfigUnauthorizedResponseBody runs the validations defined on
-// deleteConfig_unauthorized_response_body
-func ValidateDeleteConfigUnauthorizedResponseBody(body *DeleteConfigUnauthorizedResponseBody) (err error) {
+// ValidateDeleteDestinationUnauthorizedResponseBody runs the validations
+// defined on deleteDestination_unauthorized_response_body
+func ValidateDeleteDestinationUnauthorizedResponseBody(body *DeleteDestinationUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1721,9 +4636,9 @@ func ValidateDeleteConfigUnauthorizedResponseBody(body *DeleteConfigUnauthorized
 	return
 }
 
-// ValidateDeleteConfigForbiddenResponseBody runs the validations defined on
-// deleteConfig_forbidden_response_body
-func ValidateDeleteConfigForbiddenResponseBody(body *DeleteConfigForbiddenResponseBody) (err error) {
+// ValidateDeleteDestinationForbiddenResponseBody runs the validations defined
+// on deleteDestination_forbidden_response_body
+func ValidateDeleteDestinationForbiddenResponseBody(body *DeleteDestinationForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1745,9 +4660,9 @@ func ValidateDeleteConfigForbiddenResponseBody(body *DeleteConfigForbiddenRespon
 	return
 }
 
-// ValidateDeleteConfigBadRequestResponseBody runs the validations defined on
-// deleteConfig_bad_request_response_body
-func ValidateDeleteConfigBadRequestResponseBody(body *DeleteConfigBadRequestResponseBody) (err error) {
+// ValidateDeleteDestinationBadRequestResponseBody runs the validations defined
+// on deleteDestination_bad_request_response_body
+func ValidateDeleteDestinationBadRequestResponseBody(body *DeleteDestinationBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1769,9 +4684,9 @@ func ValidateDeleteConfigBadRequestResponseBody(body *DeleteConfigBadRequestResp
 	return
 }
 
-// ValidateDeleteConfigNotFoundResponseBody runs the validations defined on
-// deleteConfig_not_found_response_body
-func ValidateDeleteConfigNotFoundResponseBody(body *DeleteConfigNotFoundResponseBody) (err error) {
+// ValidateDeleteDestinationNotFoundResponseBody runs the validations defined
+// on deleteDestination_not_found_response_body
+func ValidateDeleteDestinationNotFoundResponseBody(body *DeleteDestinationNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1793,9 +4708,9 @@ func ValidateDeleteConfigNotFoundResponseBody(body *DeleteConfigNotFoundResponse
 	return
 }
 
-// ValidateDeleteConfigConflictResponseBody runs the validations defined on
-// deleteConfig_conflict_response_body
-func ValidateDeleteConfigConflictResponseBody(body *DeleteConfigConflictResponseBody) (err error) {
+// ValidateDeleteDestinationConflictResponseBody runs the validations defined
+// on deleteDestination_conflict_response_body
+func ValidateDeleteDestinationConflictResponseBody(body *DeleteDestinationConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1817,9 +4732,9 @@ func ValidateDeleteConfigConflictResponseBody(body *DeleteConfigConflictResponse
 	return
 }
 
-// ValidateDeleteConfigUnsupportedMediaResponseBody runs the validations
-// defined on deleteConfig_unsupported_media_response_body
-func ValidateDeleteConfigUnsupportedMediaResponseBody(body *DeleteConfigUnsupportedMediaResponseBody) (err error) {
+// ValidateDeleteDestinationUnsupportedMediaResponseBody runs the validations
+// defined on deleteDestination_unsupported_media_response_body
+func ValidateDeleteDestinationUnsupportedMediaResponseBody(body *DeleteDestinationUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1841,9 +4756,9 @@ func ValidateDeleteConfigUnsupportedMediaResponseBody(body *DeleteConfigUnsuppor
 	return
 }
 
-// ValidateDeleteConfigInvalidResponseBody runs the validations defined on
-// deleteConfig_invalid_response_body
-func ValidateDeleteConfigInvalidResponseBody(body *DeleteConfigInvalidResponseBody) (err error) {
+// ValidateDeleteDestinationInvalidResponseBody runs the validations defined on
+// deleteDestination_invalid_response_body
+func ValidateDeleteDestinationInvalidResponseBody(body *DeleteDestinationInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1865,9 +4780,9 @@ func ValidateDeleteConfigInvalidResponseBody(body *DeleteConfigInvalidResponseBo
 	return
 }
 
-// ValidateDeleteConfigInvariantViolationResponseBody runs the validations
-// defined on deleteConfig_invariant_violation_response_body
-func ValidateDeleteConfigInvariantViolationResponseBody(body *DeleteConfigInvariantViolationResponseBody) (err error) {
+// ValidateDeleteDestinationInvariantViolationResponseBody runs the validations
+// defined on deleteDestination_invariant_violation_response_body
+func ValidateDeleteDestinationInvariantViolationResponseBody(body *DeleteDestinationInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1889,9 +4804,9 @@ func ValidateDeleteConfigInvariantViolationResponseBody(body *DeleteConfigInvari
 	return
 }
 
-// ValidateDeleteConfigUnexpectedResponseBody runs the validations defined on
-// deleteConfig_unexpected_response_body
-func ValidateDeleteConfigUnexpectedResponseBody(body *DeleteConfigUnexpectedResponseBody) (err error) {
+// ValidateDeleteDestinationUnexpectedResponseBody runs the validations defined
+// on deleteDestination_unexpected_response_body
+func ValidateDeleteDestinationUnexpectedResponseBody(body *DeleteDestinationUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1913,9 +4828,9 @@ func ValidateDeleteConfigUnexpectedResponseBody(body *DeleteConfigUnexpectedResp
 	return
 }
 
-// ValidateDeleteConfigGatewayErrorResponseBody runs the validations defined on
-// deleteConfig_gateway_error_response_body
-func ValidateDeleteConfigGatewayErrorResponseBody(body *DeleteConfigGatewayErrorResponseBody) (err error) {
+// ValidateDeleteDestinationGatewayErrorResponseBody runs the validations
+// defined on deleteDestination_gateway_error_response_body
+func ValidateDeleteDestinationGatewayErrorResponseBody(body *DeleteDestinationGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1948,3 +4863,225 @@ func ValidateOtelForwardingHeaderResponseBody(body *OtelForwardingHeaderResponse
 	}
 	return
 }
+
+// ValidateOtelForwardingDestinationResponseBody runs the validations defined
+// on OtelForwardingDestinationResponseBody
+func ValidateOtelForwardingDestinationResponseBody(body *OtelForwardingDestinationResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.OrganizationID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.EndpointURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_url", "body"))
+	}
+	if body.Protocol == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("protocol", "body"))
+	}
+	if body.Enabled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("enabled", "body"))
+	}
+	if body.Headers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("headers", "body"))
+	}
+	if body.SamplingRatio == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("sampling_ratio", "body"))
+	}
+	if body.Filters == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("filters", "body"))
+	}
+	if body.ScrubRules == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scrub_rules", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.Protocol != nil {
+		if !(*body.Protocol == "http/protobuf" || *body.Protocol == "http/json" || *body.Protocol == "grpc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.protocol", *body.Protocol, []any{"http/protobuf", "http/json", "grpc"}))
+		}
+	}
+	for _, e := range body.Headers {
+		if e != nil {
+			if err2 := ValidateOtelForwardingHeaderResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Filters != nil {
+		if err2 := ValidateOtelForwardingFiltersResponseBody(body.Filters); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.ScrubRules {
+		if e != nil {
+			if err2 := ValidateOtelForwardingScrubRuleResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateOtelForwardingFiltersResponseBody runs the validations defined on
+// OtelForwardingFiltersResponseBody
+func ValidateOtelForwardingFiltersResponseBody(body *OtelForwardingFiltersResponseBody) (err error) {
+	if len(body.IncludeNames) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.include_names", body.IncludeNames, len(body.IncludeNames), 50, false))
+	}
+	if len(body.ExcludeNames) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.exclude_names", body.ExcludeNames, len(body.ExcludeNames), 50, false))
+	}
+	if len(body.IncludeAttributes) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.include_attributes", body.IncludeAttributes, len(body.IncludeAttributes), 50, false))
+	}
+	for _, e := range body.IncludeAttributes {
+		if e != nil {
+			if err2 := ValidateOtelForwardingAttributeMatchResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if len(body.ExcludeAttributes) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.exclude_attributes", body.ExcludeAttributes, len(body.ExcludeAttributes), 50, false))
+	}
+	for _, e := range body.ExcludeAttributes {
+		if e != nil {
+			if err2 := ValidateOtelForwardingAttributeMatchResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateOtelForwardingAttributeMatchResponseBody runs the validations
+// defined on OtelForwardingAttributeMatchResponseBody
+func ValidateOtelForwardingAttributeMatchResponseBody(body *OtelForwardingAttributeMatchResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 1, true))
+		}
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 256, false))
+		}
+	}
+	if body.Value != nil {
+		if utf8.RuneCountInString(*body.Value) > 1024 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", *body.Value, utf8.RuneCountInString(*body.Value), 1024, false))
+		}
+	}
+	return
+}
+
+// ValidateOtelForwardingScrubRuleResponseBody runs the validations defined on
+// OtelForwardingScrubRuleResponseBody
+func ValidateOtelForwardingScrubRuleResponseBody(body *OtelForwardingScrubRuleResponseBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "body"))
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 1, true))
+		}
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 256, false))
+		}
+	}
+	if body.Action != nil {
+		if !(*body.Action == "drop" || *body.Action == "redact" || *body.Action == "hash") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", *body.Action, []any{"drop", "redact", "hash"}))
+		}
+	}
+	return
+}
+
+// ValidateOtelForwardingFiltersRequestBody runs the validations defined on
+// OtelForwardingFiltersRequestBody
+func ValidateOtelForwardingFiltersRequestBody(body *OtelForwardingFiltersRequestBody) (err error) {
+	if len(body.IncludeNames) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.include_names", body.IncludeNames, len(body.IncludeNames), 50, false))
+	}
+	if len(body.ExcludeNames) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.exclude_names", body.ExcludeNames, len(body.ExcludeNames), 50, false))
+	}
+	if len(body.IncludeAttributes) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.include_attributes", body.IncludeAttributes, len(body.IncludeAttributes), 50, false))
+	}
+	for _, e := range body.IncludeAttributes {
+		if e != nil {
+			if err2 := ValidateOtelForwardingAttributeMatchRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if len(body.ExcludeAttributes) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.exclude_attributes", body.ExcludeAttributes, len(body.ExcludeAttributes), 50, false))
+	}
+	for _, e := range body.ExcludeAttributes {
+		if e != nil {
+			if err2 := ValidateOtelForwardingAttributeMatchRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateOtelForwardingAttributeMatchRequestBody runs the validations defined
+// on OtelForwardingAttributeMatchRequestBody
+func ValidateOtelForwardingAttributeMatchRequestBody(body *OtelForwardingAttributeMatchRequestBody) (err error) {
+	if utf8.RuneCountInString(body.Key) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", body.Key, utf8.RuneCountInString(body.Key), 1, true))
+	}
+	if utf8.RuneCountInString(body.Key) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", body.Key, utf8.RuneCountInString(body.Key), 256, false))
+	}
+	if body.Value != nil {
+		if utf8.RuneCountInString(*body.Value) > 1024 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", *body.Value, utf8.RuneCountInString(*body.Value), 1024, false))
+		}
+	}
+	return
+}
+
+// ValidateOtelForwardingScrubRuleRequestBody runs the validations defined on
+// OtelForwardingScrubRuleRequestBody
+func ValidateOtelForwardingScrubRuleRequestBody(body *OtelForwardingScrubRuleRequestBody) (err error) {
+	if utf8.RuneCountInString(body.Key) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", body.Key, utf8.RuneCountInString(body.Key), 1, true))
+	}
+	if utf8.RuneCountInString(body.Key) > 256 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", body.Key, utf8.RuneCountInString(body.Key), 256, false))
+	}
+	if !(body.Action == "drop" || body.Action == "redact" || body.Action == "hash") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", body.Action, []any{"drop", "redact", "hash"}))
+	}
+	return
+}
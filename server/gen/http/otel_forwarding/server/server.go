@@ -18,10 +18,14 @@ import (
 
 // Server lists the otelForwarding service endpoint HTTP handlers.
 type Server struct {
-	Mounts       []*MountPoint
-	GetConfig    http.Handler
-	UpsertConfig http.Handler
-	DeleteConfig http.Handler
+	Mounts            []*MountPoint
+	GetConfig         http.Handler
+	UpsertConfig      http.Handler
+	DeleteConfig      http.Handler
+	ListDestinations  http.Handler
+	CreateDestination http.Handler
+	UpdateDestination http.Handler
+	DeleteDestination http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -54,10 +58,18 @@ func New(
 			{"GetConfig", "GET", "/rpc/otelForwarding.getConfig"},
 			{"UpsertConfig", "POST", "/rpc/otelForwarding.upsertConfig"},
 			{"DeleteConfig", "POST", "/rpc/otelForwarding.deleteConfig"},
+			{"ListDestinations", "GET", "/rpc/otelForwarding.listDestinations"},
+			{"CreateDestination", "POST", "/rpc/otelForwarding.createDestination"},
+			{"UpdateDestination", "POST", "/rpc/otelForwarding.updateDestination"},
+			{"DeleteDestination", "POST", "/rpc/otelForwarding.deleteDestination"},
 		},
-		GetConfig:    NewGetConfigHandler(e.GetConfig, mux, decoder, encoder, errhandler, formatter),
-		UpsertConfig: NewUpsertConfigHandler(e.UpsertConfig, mux, decoder, encoder, errhandler, formatter),
-		DeleteConfig: NewDeleteConfigHandler(e.DeleteConfig, mux, decoder, encoder, errhandler, formatter),
+		GetConfig:         NewGetConfigHandler(e.GetConfig, mux, decoder, encoder, errhandler, formatter),
+		UpsertConfig:      NewUpsertConfigHandler(e.UpsertConfig, mux, decoder, encoder, errhandler, formatter),
+		DeleteConfig:      NewDeleteConfigHandler(e.DeleteConfig, mux, decoder, encoder, errhandler, formatter),
+		ListDestinations:  NewListDestinationsHandler(e.ListDestinations, mux, decoder, encoder, errhandler, formatter),
+		CreateDestination: NewCreateDestinationHandler(e.CreateDestination, mux, decoder, encoder, errhandler, formatter),
+		UpdateDestination: NewUpdateDestinationHandler(e.UpdateDestination, mux, decoder, encoder, errhandler, formatter),
+		DeleteDestination: NewDeleteDestinationHandler(e.DeleteDestination, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -69,6 +81,10 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.GetConfig = m(s.GetConfig)
 	s.UpsertConfig = m(s.UpsertConfig)
 	s.DeleteConfig = m(s.DeleteConfig)
+	s.ListDestinations = m(s.ListDestinations)
+	s.CreateDestination = m(s.CreateDestination)
+	s.UpdateDestination = m(s.UpdateDestination)
+	s.DeleteDestination = m(s.DeleteDestination)
 }
 
 // MethodNames returns the methods served.
@@ -79,6 +95,10 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetConfigHandler(mux, h.GetConfig)
 	MountUpsertConfigHandler(mux, h.UpsertConfig)
 	MountDeleteConfigHandler(mux, h.DeleteConfig)
+	MountListDestinationsHandler(mux, h.ListDestinations)
+	MountCreateDestinationHandler(mux, h.CreateDestination)
+	MountUpdateDestinationHandler(mux, h.UpdateDestination)
+	MountDeleteDestinationHandler(mux, h.DeleteDestination)
 }
 
 // Mount configures the mux to serve the otelForwarding endpoints.
@@ -244,3 +264,215 @@ func NewDeleteConfigHandler(
 		}
 	})
 }
+
+// MountListDestinationsHandler configures the mux to serve the
+// "otelForwarding" service "listDestinations" endpoint.
+func MountListDestinationsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/rpc/otelForwarding.listDestinations", f)
+}
+
+// NewListDestinationsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "otelForwarding" service "listDestinations" endpoint.
+func NewListDestinationsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListDestinationsRequest(mux, decoder)
+		encodeResponse = EncodeListDestinationsResponse(encoder)
+		encodeError    = EncodeListDestinationsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listDestinations")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "otelForwarding")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCreateDestinationHandler configures the mux to serve the
+// "otelForwarding" service "createDestination" endpoint.
+func MountCreateDestinationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/otelForwarding.createDestination", f)
+}
+
+// NewCreateDestinationHandler creates a HTTP handler which loads the HTTP
+// request and calls the "otelForwarding" service "createDestination" endpoint.
+func NewCreateDestinationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateDestinationRequest(mux, decoder)
+		encodeResponse = EncodeCreateDestinationResponse(encoder)
+		encodeError    = EncodeCreateDestinationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "createDestination")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "otelForwarding")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateDestinationHandler configures the mux to serve the
+// "otelForwarding" service "updateDestination" endpoint.
+func MountUpdateDestinationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/otelForwarding.updateDestination", f)
+}
+
+// NewUpdateDestinationHandler creates a HTTP handler which loads the HTTP
+// request and calls the "otelForwarding" service "updateDestination" endpoint.
+func NewUpdateDestinationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateDestinationRequest(mux, decoder)
+		encodeResponse = EncodeUpdateDestinationResponse(encoder)
+		encodeError    = EncodeUpdateDestinationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "updateDestination")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "otelForwarding")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteDestinationHandler configures the mux to serve the
+// "otelForwarding" service "deleteDestination" endpoint.
+func MountDeleteDestinationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/otelForwarding.deleteDestination", f)
+}
+
+// NewDeleteDestinationHandler creates a HTTP handler which loads the HTTP
+// request and calls the "otelForwarding" service "deleteDestination" endpoint.
+func NewDeleteDestinationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteDestinationRequest(mux, decoder)
+		encodeResponse = EncodeDeleteDestinationResponse(encoder)
+		encodeError    = EncodeDeleteDestinationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "deleteDestination")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "otelForwarding")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
@@ -8,6 +8,8 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	otelforwarding "github.com/speakeasy-api/gram/server/gen/otel_forwarding"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -24,6 +26,61 @@ type UpsertConfigRequestBody struct {
 	Headers []*OtelForwardingHeaderInputRequestBody `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
 }
 
+// CreateDestinationRequestBody is the type of the "otelForwarding" service
+// "createDestination" endpoint HTTP request body.
+type CreateDestinationRequestBody struct {
+	// Name of the destination, unique within the organization.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Base URL of the OTLP receiver. Use https:// for TLS; gRPC destinations
+	// without a port use 4317.
+	EndpointURL *string `form:"endpoint_url,omitempty" json:"endpoint_url,omitempty" xml:"endpoint_url,omitempty"`
+	// OTLP transport and encoding.
+	Protocol *string `form:"protocol,omitempty" json:"protocol,omitempty" xml:"protocol,omitempty"`
+	// Whether the destination should receive telemetry.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Complete desired header set. Omitted entries are removed; entries with an
+	// omitted value preserve the existing encrypted value for the same name.
+	Headers []*OtelForwardingHeaderInputRequestBody `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Share of traces and log records to forward, from 0 to 1.
+	SamplingRatio *float64 `form:"sampling_ratio,omitempty" json:"sampling_ratio,omitempty" xml:"sampling_ratio,omitempty"`
+	// Filters applied before sampling. Omit to forward everything.
+	Filters *OtelForwardingFiltersRequestBody `form:"filters,omitempty" json:"filters,omitempty" xml:"filters,omitempty"`
+	// Attribute scrubbing rules.
+	ScrubRules []*OtelForwardingScrubRuleRequestBody `form:"scrub_rules,omitempty" json:"scrub_rules,omitempty" xml:"scrub_rules,omitempty"`
+}
+
+// UpdateDestinationRequestBody is the type of the "otelForwarding" service
+// "updateDestination" endpoint HTTP request body.
+type UpdateDestinationRequestBody struct {
+	// ID of the destination to update.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the destination, unique within the organization.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Base URL of the OTLP receiver. Use https:// for TLS; gRPC destinations
+	// without a port use 4317.
+	EndpointURL *string `form:"endpoint_url,omitempty" json:"endpoint_url,omitempty" xml:"endpoint_url,omitempty"`
+	// OTLP transport and encoding.
+	Protocol *string `form:"protocol,omitempty" json:"protocol,omitempty" xml:"protocol,omitempty"`
+	// Whether the destination should receive telemetry.
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+	// Complete desired header set. Omitted entries are removed; entries with an
+	// omitted value preserve the existing encrypted value for the same name.
+	Headers []*OtelForwardingHeaderInputRequestBody `form:"headers,omitempty" json:"headers,omitempty" xml:"headers,omitempty"`
+	// Share of traces and log records to forward, from 0 to 1.
+	SamplingRatio *float64 `form:"sampling_ratio,omitempty" json:"sampling_ratio,omitempty" xml:"sampling_ratio,omitempty"`
+	// Filters applied before sampling. Omit to forward everything.
+	Filters *OtelForwardingFiltersRequestBody `form:"filters,omitempty" json:"filters,omitempty" xml:"filters,omitempty"`
+	// Attribute scrubbing rules.
+	ScrubRules []*OtelForwardingScrubRuleRequestBody `form:"scrub_rules,omitempty" json:"scrub_rules,omitempty" xml:"scrub_rules,omitempty"`
+}
+
+// DeleteDestinationRequestBody is the type of the "otelForwarding" service
+// "deleteDestination" endpoint HTTP request body.
+type DeleteDestinationRequestBody struct {
+	// ID of the destination to delete.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
 // GetConfigResponseBody is the type of the "otelForwarding" service
 // "getConfig" endpoint HTTP response body.
 type GetConfigResponseBody struct {
@@ -64,6 +121,77 @@ type UpsertConfigResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// ListDestinationsResponseBody is the type of the "otelForwarding" service
+// "listDestinations" endpoint HTTP response body.
+type ListDestinationsResponseBody struct {
+	// The organization's destinations, oldest first.
+	Destinations []*OtelForwardingDestinationResponseBody `form:"destinations" json:"destinations" xml:"destinations"`
+}
+
+// CreateDestinationResponseBody is the type of the "otelForwarding" service
+// "createDestination" endpoint HTTP response body.
+type CreateDestinationResponseBody struct {
+	// Destination ID.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Organization the destination belongs to.
+	OrganizationID string `form:"organization_id" json:"organization_id" xml:"organization_id"`
+	// Name of the destination, unique within the organization.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Base URL of the OTLP receiver. OTLP/HTTP destinations receive /v1/<signal>
+	// under it; gRPC destinations dial its host and port.
+	EndpointURL string `form:"endpoint_url" json:"endpoint_url" xml:"endpoint_url"`
+	// OTLP transport and encoding.
+	Protocol string `form:"protocol" json:"protocol" xml:"protocol"`
+	// Whether the destination currently receives telemetry.
+	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
+	// Headers sent with each export (gRPC metadata for gRPC destinations). Values
+	// are never returned.
+	Headers []*OtelForwardingHeaderResponseBody `form:"headers" json:"headers" xml:"headers"`
+	// Share of traces and log records forwarded, from 0 to 1. Items sharing a
+	// trace ID are kept or dropped together. Metrics are never sampled.
+	SamplingRatio float64 `form:"sampling_ratio" json:"sampling_ratio" xml:"sampling_ratio"`
+	// Filters applied before sampling.
+	Filters *OtelForwardingFiltersResponseBody `form:"filters" json:"filters" xml:"filters"`
+	// Attribute scrubbing rules applied to everything that is forwarded.
+	ScrubRules []*OtelForwardingScrubRuleResponseBody `form:"scrub_rules" json:"scrub_rules" xml:"scrub_rules"`
+	// When the destination was created.
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// When the destination was last updated.
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// UpdateDestinationResponseBody is the type of the "otelForwarding" service
+// "updateDestination" endpoint HTTP response body.
+type UpdateDestinationResponseBody struct {
+	// Destination ID.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Organization the destination belongs to.
+	OrganizationID string `form:"organization_id" json:"organization_id" xml:"organization_id"`
+	// Name of the destination, unique within the organization.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Base URL of the OTLP receiver. OTLP/HTTP destinations receive /v1/<signal>
+	// under it; gRPC destinations dial its host and port.
+	EndpointURL string `form:"endpoint_url" json:"endpoint_url" xml:"endpoint_url"`
+	// OTLP transport and encoding.
+	Protocol string `form:"protocol" json:"protocol" xml:"protocol"`
+	// Whether the destination currently receives telemetry.
+	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
+	// Headers sent with each export (gRPC metadata for gRPC destinations). Values
+	// are never returned.
+	Headers []*OtelForwardingHeaderResponseBody `form:"headers" json:"headers" xml:"headers"`
+	// Share of traces and log records forwarded, from 0 to 1. Items sharing a
+	// trace ID are kept or dropped together. Metrics are never sampled.
+	SamplingRatio float64 `form:"sampling_ratio" json:"sampling_ratio" xml:"sampling_ratio"`
+	// Filters applied before sampling.
+	Filters *OtelForwardingFiltersResponseBody `form:"filters" json:"filters" xml:"filters"`
+	// Attribute scrubbing rules applied to everything that is forwarded.
+	ScrubRules []*OtelForwardingScrubRuleResponseBody `form:"scrub_rules" json:"scrub_rules" xml:"scrub_rules"`
+	// When the destination was created.
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// When the destination was last updated.
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
 // GetConfigUnauthorizedResponseBody is the type of the "otelForwarding"
 // service "getConfig" endpoint HTTP response body for the "unauthorized" error.
 type GetConfigUnauthorizedResponseBody struct {
@@ -619,138 +747,1427 @@ type DeleteConfigGatewayErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// OtelForwardingHeaderResponseBody is used to define fields on response body
-// types.
-type OtelForwardingHeaderResponseBody struct {
-	// Header name.
+// ListDestinationsUnauthorizedResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListDestinationsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
-	// Whether a non-empty value is currently stored for this header. Always false
-	// on write-only operations.
-	HasValue bool `form:"has_value" json:"has_value" xml:"has_value"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// OtelForwardingHeaderInputRequestBody is used to define fields on request
-// body types.
-type OtelForwardingHeaderInputRequestBody struct {
-	// Header name.
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// Header value. Omit to preserve an existing value; provide to create,
-	// replace, or clear it. Stored encrypted at rest and never returned on reads.
-	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+// ListDestinationsForbiddenResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "forbidden"
+// error.
+type ListDestinationsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewGetConfigResponseBody builds the HTTP response body from the result of
-// the "getConfig" endpoint of the "otelForwarding" service.
-func NewGetConfigResponseBody(res *otelforwarding.OtelForwardingConfig) *GetConfigResponseBody {
-	body := &GetConfigResponseBody{
-		ID:             res.ID,
-		OrganizationID: res.OrganizationID,
-		EndpointURL:    res.EndpointURL,
-		Enabled:        res.Enabled,
-		CreatedAt:      res.CreatedAt,
-		UpdatedAt:      res.UpdatedAt,
-	}
-	if res.Headers != nil {
-		body.Headers = make([]*OtelForwardingHeaderResponseBody, len(res.Headers))
-		for i, val := range res.Headers {
-			if val == nil {
-				body.Headers[i] = nil
-				continue
-			}
-			body.Headers[i] = marshalOtelforwardingOtelForwardingHeaderToOtelForwardingHeaderResponseBody(val)
-		}
-	} else {
-		body.Headers = []*OtelForwardingHeaderResponseBody{}
-	}
-	return body
+// ListDestinationsBadRequestResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "bad_request"
+// error.
+type ListDestinationsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewUpsertConfigResponseBody builds the HTTP response body from the result of
-// the "upsertConfig" endpoint of the "otelForwarding" service.
-func NewUpsertConfigResponseBody(res *otelforwarding.OtelForwardingConfig) *UpsertConfigResponseBody {
-	body := &UpsertConfigResponseBody{
-		ID:             res.ID,
-		OrganizationID: res.OrganizationID,
-		EndpointURL:    res.EndpointURL,
-		Enabled:        res.Enabled,
-		CreatedAt:      res.CreatedAt,
-		UpdatedAt:      res.UpdatedAt,
-	}
-	if res.Headers != nil {
-		body.Headers = make([]*OtelForwardingHeaderResponseBody, len(res.Headers))
-		for i, val := range res.Headers {
-			if val == nil {
-				body.Headers[i] = nil
-				continue
-			}
-			body.Headers[i] = marshalOtelforwardingOtelForwardingHeaderToOtelForwardingHeaderResponseBody(val)
-		}
-	} else {
-		body.Headers = []*OtelForwardingHeaderResponseBody{}
-	}
-	return body
+// ListDestinationsNotFoundResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "not_found"
+// error.
+type ListDestinationsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewGetConfigUnauthorizedResponseBody builds the HTTP response body from the
-// result of the "getConfig" endpoint of the "otelForwarding" service.
-func NewGetConfigUnauthorizedResponseBody(res *goa.ServiceError) *GetConfigUnauthorizedResponseBody {
-	body := &GetConfigUnauthorizedResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// ListDestinationsConflictResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "conflict"
+// error.
+type ListDestinationsConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewGetConfigForbiddenResponseBody builds the HTTP response body from the
-// result of the "getConfig" endpoint of the "otelForwarding" service.
-func NewGetConfigForbiddenResponseBody(res *goa.ServiceError) *GetConfigForbiddenResponseBody {
-	body := &GetConfigForbiddenResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// ListDestinationsUnsupportedMediaResponseBody is the type of the
+// "otelForwarding" service "listDestinations" endpoint HTTP response body for
+// the "unsupported_media" error.
+type ListDestinationsUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewGetConfigBadRequestResponseBody builds the HTTP response body from the
-// result of the "getConfig" endpoint of the "otelForwarding" service.
-func NewGetConfigBadRequestResponseBody(res *goa.ServiceError) *GetConfigBadRequestResponseBody {
-	body := &GetConfigBadRequestResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// ListDestinationsInvalidResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "invalid"
+// error.
+type ListDestinationsInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewGetConfigNotFoundResponseBody builds the HTTP response body from the
-// result of the "getConfig" endpoint of the "otelForwarding" service.
-func NewGetConfigNotFoundResponseBody(res *goa.ServiceError) *GetConfigNotFoundResponseBody {
-	body := &GetConfigNotFoundResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
-	}
-	return body
+// ListDestinationsInvariantViolationResponseBody is the type of the
+// "otelForwarding" service "listDestinations" endpoint HTTP response body for
+// the "invariant_violation" error.
+type ListDestinationsInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// NewGetConfigConflictResponseBody builds the HTTP response body from the
-// result of the "getConfig" endpoint of the "otelForwarding" service.
-func NewGetConfigConflictResponseBody(res *goa.ServiceError) *GetConfigConflictResponseBody {
-	body := &GetConfigConflictResponseBody{
+// ListDestinationsUnexpectedResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the "unexpected"
+// error.
+type ListDestinationsUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListDestinationsGatewayErrorResponseBody is the type of the "otelForwarding"
+// service "listDestinations" endpoint HTTP response body for the
+// "gateway_error" error.
+type ListDestinationsGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateDestinationUnauthorizedResponseBody is the type of the
+// "otelForwarding" service "createDestination" endpoint HTTP response body for
+// the "unauthorized" error.
+type CreateDestinationUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateDestinationForbiddenResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the "forbidden"
+// error.
+type CreateDestinationForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateDestinationBadRequestResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the
+// "bad_request" error.
+type CreateDestinationBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateDestinationNotFoundResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the "not_found"
+// error.
+type CreateDestinationNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateDestinationConflictResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the "conflict"
+// error.
+type CreateDestinationConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateDestinationUnsupportedMediaResponseBody is the type of the
+// "otelForwarding" service "createDestination" endpoint HTTP response body for
+// the "unsupported_media" error.
+type CreateDestinationUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateDestinationInvalidResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the "invalid"
+// error.
+type CreateDestinationInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateDestinationInvariantViolationResponseBody is the type of the
+// "otelForwarding" service "createDestination" endpoint HTTP response body for
+// the "invariant_violation" error.
+type CreateDestinationInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateDestinationUnexpectedResponseBody is the type of the "otelForwarding"
+// service "createDestination" endpoint HTTP response body for the "unexpected"
+// error.
+type CreateDestinationUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateDestinationGatewayErrorResponseBody is the type of the
+// "otelForwarding" service "createDestination" endpoint HTTP response body for
+// the "gateway_error" error.
+type CreateDestinationGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateDestinationUnauthorizedResponseBody is the type of the
+// "otelForwarding" service "updateDestination" endpoint HTTP response body for
+// the "unauthorized" error.
+type UpdateDestinationUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateDestinationForbiddenResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the "forbidden"
+// error.
+type UpdateDestinationForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateDestinationBadRequestResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the
+// "bad_request" error.
+type UpdateDestinationBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateDestinationNotFoundResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the "not_found"
+// error.
+type UpdateDestinationNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateDestinationConflictResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the "conflict"
+// error.
+type UpdateDestinationConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateDestinationUnsupportedMediaResponseBody is the type of the
+// "otelForwarding" service "updateDestination" endpoint HTTP response body for
+// the "unsupported_media" error.
+type UpdateDestinationUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateDestinationInvalidResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the "invalid"
+// error.
+type UpdateDestinationInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateDestinationInvariantViolationResponseBody is the type of the
+// "otelForwarding" service "updateDestination" endpoint HTTP response body for
+// the "invariant_violation" error.
+type UpdateDestinationInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateDestinationUnexpectedResponseBody is the type of the "otelForwarding"
+// service "updateDestination" endpoint HTTP response body for the "unexpected"
+// error.
+type UpdateDestinationUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateDestinationGatewayErrorResponseBody is the type of the
+// "otelForwarding" service "updateDestination" endpoint HTTP response body for
+// the "gateway_error" error.
+type UpdateDestinationGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteDestinationUnauthorizedResponseBody is the type of the
+// "otelForwarding" service "deleteDestination" endpoint HTTP response body for
+// the "unauthorized" error.
+type DeleteDestinationUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteDestinationForbiddenResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the "forbidden"
+// error.
+type DeleteDestinationForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteDestinationBadRequestResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the
+// "bad_request" error.
+type DeleteDestinationBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteDestinationNotFoundResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the "not_found"
+// error.
+type DeleteDestinationNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteDestinationConflictResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the "conflict"
+// error.
+type DeleteDestinationConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteDestinationUnsupportedMediaResponseBody is the type of the
+// "otelForwarding" service "deleteDestination" endpoint HTTP response body for
+// the "unsupported_media" error.
+type DeleteDestinationUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteDestinationInvalidResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the "invalid"
+// error.
+type DeleteDestinationInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteDestinationInvariantViolationResponseBody is the type of the
+// "otelForwarding" service "deleteDestination" endpoint HTTP response body for
+// the "invariant_violation" error.
+type DeleteDestinationInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteDestinationUnexpectedResponseBody is the type of the "otelForwarding"
+// service "deleteDestination" endpoint HTTP response body for the "unexpected"
+// error.
+type DeleteDestinationUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteDestinationGatewayErrorResponseBody is the type of the
+// "otelForwarding" service "deleteDestination" endpoint HTTP response body for
+// the "gateway_error" error.
+type DeleteDestinationGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// OtelForwardingHeaderResponseBody is used to define fields on response body
+// types.
+type OtelForwardingHeaderResponseBody struct {
+	// Header name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Whether a non-empty value is currently stored for this header. Always false
+	// on write-only operations.
+	HasValue bool `form:"has_value" json:"has_value" xml:"has_value"`
+}
+
+// OtelForwardingDestinationResponseBody is used to define fields on response
+// body types.
+type OtelForwardingDestinationResponseBody struct {
+	// Destination ID.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Organization the destination belongs to.
+	OrganizationID string `form:"organization_id" json:"organization_id" xml:"organization_id"`
+	// Name of the destination, unique within the organization.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Base URL of the OTLP receiver. OTLP/HTTP destinations receive /v1/<signal>
+	// under it; gRPC destinations dial its host and port.
+	EndpointURL string `form:"endpoint_url" json:"endpoint_url" xml:"endpoint_url"`
+	// OTLP transport and encoding.
+	Protocol string `form:"protocol" json:"protocol" xml:"protocol"`
+	// Whether the destination currently receives telemetry.
+	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
+	// Headers sent with each export (gRPC metadata for gRPC destinations). Values
+	// are never returned.
+	Headers []*OtelForwardingHeaderResponseBody `form:"headers" json:"headers" xml:"headers"`
+	// Share of traces and log records forwarded, from 0 to 1. Items sharing a
+	// trace ID are kept or dropped together. Metrics are never sampled.
+	SamplingRatio float64 `form:"sampling_ratio" json:"sampling_ratio" xml:"sampling_ratio"`
+	// Filters applied before sampling.
+	Filters *OtelForwardingFiltersResponseBody `form:"filters" json:"filters" xml:"filters"`
+	// Attribute scrubbing rules applied to everything that is forwarded.
+	ScrubRules []*OtelForwardingScrubRuleResponseBody `form:"scrub_rules" json:"scrub_rules" xml:"scrub_rules"`
+	// When the destination was created.
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// When the destination was last updated.
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// OtelForwardingFiltersResponseBody is used to define fields on response body
+// types.
+type OtelForwardingFiltersResponseBody struct {
+	// Only forward items whose name matches one of these patterns.
+	IncludeNames []string `form:"include_names,omitempty" json:"include_names,omitempty" xml:"include_names,omitempty"`
+	// Never forward items whose name matches one of these patterns.
+	ExcludeNames []string `form:"exclude_names,omitempty" json:"exclude_names,omitempty" xml:"exclude_names,omitempty"`
+	// Only forward items matching at least one of these attributes.
+	IncludeAttributes []*OtelForwardingAttributeMatchResponseBody `form:"include_attributes,omitempty" json:"include_attributes,omitempty" xml:"include_attributes,omitempty"`
+	// Never forward items matching any of these attributes.
+	ExcludeAttributes []*OtelForwardingAttributeMatchResponseBody `form:"exclude_attributes,omitempty" json:"exclude_attributes,omitempty" xml:"exclude_attributes,omitempty"`
+}
+
+// OtelForwardingAttributeMatchResponseBody is used to define fields on
+// response body types.
+type OtelForwardingAttributeMatchResponseBody struct {
+	// Attribute key.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Value the attribute must equal, compared as text. Omit to match any value.
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// OtelForwardingScrubRuleResponseBody is used to define fields on response
+// body types.
+type OtelForwardingScrubRuleResponseBody struct {
+	// Attribute key pattern; may use * wildcards, e.g. gen_ai.tool.call.*.
+	Key string `form:"key" json:"key" xml:"key"`
+	// drop removes the attribute, redact replaces its value with [REDACTED], hash
+	// replaces it with its SHA-256 hex digest.
+	Action string `form:"action" json:"action" xml:"action"`
+}
+
+// OtelForwardingHeaderInputRequestBody is used to define fields on request
+// body types.
+type OtelForwardingHeaderInputRequestBody struct {
+	// Header name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Header value. Omit to preserve an existing value; provide to create,
+	// replace, or clear it. Stored encrypted at rest and never returned on reads.
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// OtelForwardingFiltersRequestBody is used to define fields on request body
+// types.
+type OtelForwardingFiltersRequestBody struct {
+	// Only forward items whose name matches one of these patterns.
+	IncludeNames []string `form:"include_names,omitempty" json:"include_names,omitempty" xml:"include_names,omitempty"`
+	// Never forward items whose name matches one of these patterns.
+	ExcludeNames []string `form:"exclude_names,omitempty" json:"exclude_names,omitempty" xml:"exclude_names,omitempty"`
+	// Only forward items matching at least one of these attributes.
+	IncludeAttributes []*OtelForwardingAttributeMatchRequestBody `form:"include_attributes,omitempty" json:"include_attributes,omitempty" xml:"include_attributes,omitempty"`
+	// Never forward items matching any of these attributes.
+	ExcludeAttributes []*OtelForwardingAttributeMatchRequestBody `form:"exclude_attributes,omitempty" json:"exclude_attributes,omitempty" xml:"exclude_attributes,omitempty"`
+}
+
+// OtelForwardingAttributeMatchRequestBody is used to define fields on request
+// body types.
+type OtelForwardingAttributeMatchRequestBody struct {
+	// Attribute key.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Value the attribute must equal, compared as text. Omit to match any value.
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// OtelForwardingScrubRuleRequestBody is used to define fields on request body
+// types.
+type OtelForwardingScrubRuleRequestBody struct {
+	// Attribute key pattern; may use * wildcards, e.g. gen_ai.tool.call.*.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// drop removes the attribute, redact replaces its value with [REDACTED], hash
+	// replaces it with its SHA-256 hex digest.
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+}
+
+// NewGetConfigResponseBody builds the HTTP response body from the result of
+// the "getConfig" endpoint of the "otelForwarding" service.
+func NewGetConfigResponseBody(res *otelforwarding.OtelForwardingConfig) *GetConfigResponseBody {
+	body := &GetConfigResponseBody{
+		ID:             res.ID,
+		OrganizationID: res.OrganizationID,
+		EndpointURL:    res.EndpointURL,
+		Enabled:        res.Enabled,
+		CreatedAt:      res.CreatedAt,
+		UpdatedAt:      res.UpdatedAt,
+	}
+	if res.Headers != nil {
+		body.Headers = make([]*OtelForwardingHeaderResponseBody, len(res.Headers))
+		for i, val := range res.Headers {
+			if val == nil {
+				body.Headers[i] = nil
+				continue
+			}
+			body.Headers[i] = marshalOtelforwardingOtelForwardingHeaderToOtelForwardingHeaderResponseBody(val)
+		}
+	} else {
+		body.Headers = []*OtelForwardingHeaderResponseBody{}
+	}
+	return body
+}
+
+// NewUpsertConfigResponseBody builds the HTTP response body from the result of
+// the "upsertConfig" endpoint of the "otelForwarding" service.
+func NewUpsertConfigResponseBody(res *otelforwarding.OtelForwardingConfig) *UpsertConfigResponseBody {
+	body := &UpsertConfigResponseBody{
+		ID:             res.ID,
+		OrganizationID: res.OrganizationID,
+		EndpointURL:    res.EndpointURL,
+		Enabled:        res.Enabled,
+		CreatedAt:      res.CreatedAt,
+		UpdatedAt:      res.UpdatedAt,
+	}
+	if res.Headers != nil {
+		body.Headers = make([]*OtelForwardingHeaderResponseBody, len(res.Headers))
+		for i, val := range res.Headers {
+			if val == nil {
+				body.Headers[i] = nil
+				continue
+			}
+			body.Headers[i] = marshalOtelforwardingOtelForwardingHeaderToOtelForwardingHeaderResponseBody(val)
+		}
+	} else {
+		body.Headers = []*OtelForwardingHeaderResponseBody{}
+	}
+	return body
+}
+
+// NewListDestinationsResponseBody builds the HTTP response body from the
+// result of the "listDestinations" endpoint of the "otelForwarding" service.
+func NewListDestinationsResponseBody(res *otelforwarding.ListOtelForwardingDestinationsResult) *ListDestinationsResponseBody {
+	body := &ListDestinationsResponseBody{}
+	if res.Destinations != nil {
+		body.Destinations = make([]*OtelForwardingDestinationResponseBody, len(res.Destinations))
+		for i, val := range res.Destinations {
+			if val == nil {
+				body.Destinations[i] = nil
+				continue
+			}
+			body.Destinations[i] = marshalOtelforwardingOtelForwardingDestinationToOtelForwardingDestinationResponseBody(val)
+		}
+	} else {
+		body.Destinations = []*OtelForwardingDestinationResponseBody{}
+	}
+	return body
+}
+
+// NewCreateDestinationResponseBody builds the HTTP response body from the
+// result of the "createDestination" endpoint of the "otelForwarding" service.
+func NewCreateDestinationResponseBody(res *otelforwarding.OtelForwardingDestination) *CreateDestinationResponseBody {
+	body := &CreateDestinationResponseBody{
+		ID:             res.ID,
+		OrganizationID: res.OrganizationID,
+		Name:           res.Name,
+		EndpointURL:    res.EndpointURL,
+		Protocol:       res.Protocol,
+		Enabled:        res.Enabled,
+		SamplingRatio:  res.SamplingRatio,
+		CreatedAt:      res.CreatedAt,
+		UpdatedAt:      res.UpdatedAt,
+	}
+	if res.Headers != nil {
+		body.Headers = make([]*OtelForwardingHeaderResponseBody, len(res.Headers))
+		for i, val := range res.Headers {
+			if val == nil {
+				body.Headers[i] = nil
+				continue
+			}
+			body.Headers[i] = marshalOtelforwardingOtelForwardingHeaderToOtelForwardingHeaderResponseBody(val)
+		}
+	} else {
+		body.Headers = []*OtelForwardingHeaderResponseBody{}
+	}
+	if res.Filters != nil {
+		body.Filters = marshalOtelforwardingOtelForwardingFiltersToOtelForwardingFiltersResponseBody(res.Filters)
+	}
+	if res.ScrubRules != nil {
+		body.ScrubRules = make([]*OtelForwardingScrubRuleResponseBody, len(res.ScrubRules))
+		for i, val := range res.ScrubRules {
+			if val == nil {
+				body.ScrubRules[i] = nil
+				continue
+			}
+			body.ScrubRules[i] = marshalOtelforwardingOtelForwardingScrubRuleToOtelForwardingScrubRuleResponseBody(val)
+		}
+	} else {
+		body.ScrubRules = []*OtelForwardingScrubRuleResponseBody{}
+	}
+	return body
+}
+
+// NewUpdateDestinationResponseBody builds the HTTP response body from the
+// result of the "updateDestination" endpoint of the "otelForwarding" service.
+func NewUpdateDestinationResponseBody(res *otelforwarding.OtelForwardingDestination) *UpdateDestinationResponseBody {
+	body := &UpdateDestinationResponseBody{
+		ID:             res.ID,
+		OrganizationID: res.OrganizationID,
+		Name:           res.Name,
+		EndpointURL:    res.EndpointURL,
+		Protocol:       res.Protocol,
+		Enabled:        res.Enabled,
+		SamplingRatio:  res.SamplingRatio,
+		CreatedAt:      res.CreatedAt,
+		UpdatedAt:      res.UpdatedAt,
+	}
+	if res.Headers != nil {
+		body.Headers = make([]*OtelForwardingHeaderResponseBody, len(res.Headers))
+		for i, val := range res.Headers {
+			if val == nil {
+				body.Headers[i] = nil
+				continue
+			}
+			body.Headers[i] = marshalOtelforwardingOtelForwardingHeaderToOtelForwardingHeaderResponseBody(val)
+		}
+	} else {
+		body.Headers = []*OtelForwardingHeaderResponseBody{}
+	}
+	if res.Filters != nil {
+		body.Filters = marshalOtelforwardingOtelForwardingFiltersToOtelForwardingFiltersResponseBody(res.Filters)
+	}
+	if res.ScrubRules != nil {
+		body.ScrubRules = make([]*OtelForwardingScrubRuleResponseBody, len(res.ScrubRules))
+		for i, val := range res.ScrubRules {
+			if val == nil {
+				body.ScrubRules[i] = nil
+				continue
+			}
+			body.ScrubRules[i] = marshalOtelforwardingOtelForwardingScrubRuleToOtelForwardingScrubRuleResponseBody(val)
+		}
+	} else {
+		body.ScrubRules = []*OtelForwardingScrubRuleResponseBody{}
+	}
+	return body
+}
+
+// NewGetConfigUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "getConfig" endpoint of the "otelForwarding" service.
+func NewGetConfigUnauthorizedResponseBody(res *goa.ServiceError) *GetConfigUnauthorizedResponseBody {
+	body := &GetConfigUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetConfigForbiddenResponseBody builds the HTTP response body from the
+// result of the "getConfig" endpoint of the "otelForwarding" service.
+func NewGetConfigForbiddenResponseBody(res *goa.ServiceError) *GetConfigForbiddenResponseBody {
+	body := &GetConfigForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetConfigBadRequestResponseBody builds the HTTP response body from the
+// result of the "getConfig" endpoint of the "otelForwarding" service.
+func NewGetConfigBadRequestResponseBody(res *goa.ServiceError) *GetConfigBadRequestResponseBody {
+	body := &GetConfigBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetConfigNotFoundResponseBody builds the HTTP response body from the
+// result of the "getConfig" endpoint of the "otelForwarding" service.
+func NewGetConfigNotFoundResponseBody(res *goa.ServiceError) *GetConfigNotFoundResponseBody {
+	body := &GetConfigNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetConfigConflictResponseBody builds the HTTP response body from the
+// result of the "getConfig" endpoint of the "otelForwarding" service.
+func NewGetConfigConflictResponseBody(res *goa.ServiceError) *GetConfigConflictResponseBody {
+	body := &GetConfigConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetConfigUnsupportedMediaResponseBody builds the HTTP response body from
+// the result of the "getConfig" endpoint of the "otelForwarding" service.
+func NewGetConfigUnsupportedMediaResponseBody(res *goa.ServiceError) *GetConfigUnsupportedMediaResponseBody {
+	body := &GetConfigUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetConfigInvalidResponseBody builds the HTTP response body from the
+// result of the "getConfig" endpoint of the "otelForwarding" service.
+func NewGetConfigInvalidResponseBody(res *goa.ServiceError) *GetConfigInvalidResponseBody {
+	body := &GetConfigInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetConfigInvariantViolationResponseBody builds the HTTP response body
+// from the result of the "getConfig" endpoint of the "otelForwarding" service.
+func NewGetConfigInvariantViolationResponseBody(res *goa.ServiceError) *GetConfigInvariantViolationResponseBody {
+	body := &GetConfigInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetConfigUnexpectedResponseBody builds the HTTP response body from the
+// result of the "getConfig" endpoint of the "otelForwarding" service.
+func NewGetConfigUnexpectedResponseBody(res *goa.ServiceError) *GetConfigUnexpectedResponseBody {
+	body := &GetConfigUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetConfigGatewayErrorResponseBody builds the HTTP response body from the
+// result of the "getConfig" endpoint of the "otelForwarding" service.
+func NewGetConfigGatewayErrorResponseBody(res *goa.ServiceError) *GetConfigGatewayErrorResponseBody {
+	body := &GetConfigGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpsertConfigUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "upsertConfig" endpoint of the "otelForwarding" service.
+func NewUpsertConfigUnauthorizedResponseBody(res *goa.ServiceError) *UpsertConfigUnauthorizedResponseBody {
+	body := &UpsertConfigUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpsertConfigForbiddenResponseBody builds the HTTP response body from the
+// result of the "upsertConfig" endpoint of the "otelForwarding" service.
+func NewUpsertConfigForbiddenResponseBody(res *goa.ServiceError) *UpsertConfigForbiddenResponseBody {
+	body := &UpsertConfigForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpsertConfigBadRequestResponseBody builds the HTTP response body from the
+// result of the "upsertConfig" endpoint of the "otelForwarding" service.
+func NewUpsertConfigBadRequestResponseBody(res *goa.ServiceError) *UpsertConfigBadRequestResponseBody {
+	body := &UpsertConfigBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpsertConfigNotFoundResponseBody builds the HTTP response body from the
+// result of the "upsertConfig" endpoint of the "otelForwarding" service.
+func NewUpsertConfigNotFoundResponseBody(res *goa.ServiceError) *UpsertConfigNotFoundResponseBody {
+	body := &UpsertConfigNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpsertConfigConflictResponseBody builds the HTTP response body from the
+// result of the "upsertConfig" endpoint of the "otelForwarding" service.
+func NewUpsertConfigConflictResponseBody(res *goa.ServiceError) *UpsertConfigConflictResponseBody {
+	body := &UpsertConfigConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpsertConfigUnsupportedMediaResponseBody builds the HTTP response body
+// from the result of the "upsertConfig" endpoint of the "otelForwarding"
+// service.
+func NewUpsertConfigUnsupportedMediaResponseBody(res *goa.ServiceError) *UpsertConfigUnsupportedMediaResponseBody {
+	body := &UpsertConfigUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpsertConfigInvalidResponseBody builds the HTTP response body from the
+// result of the "upsertConfig" endpoint of the "otelForwarding" service.
+func NewUpsertConfigInvalidResponseBody(res *goa.ServiceError) *UpsertConfigInvalidResponseBody {
+	body := &UpsertConfigInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpsertConfigInvariantViolationResponseBody builds the HTTP response body
+// from the result of the "upsertConfig" endpoint of the "otelForwarding"
+// service.
+func NewUpsertConfigInvariantViolationResponseBody(res *goa.ServiceError) *UpsertConfigInvariantViolationResponseBody {
+	body := &UpsertConfigInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpsertConfigUnexpectedResponseBody builds the HTTP response body from the
+// result of the "upsertConfig" endpoint of the "otelForwarding" service.
+func NewUpsertConfigUnexpectedResponseBody(res *goa.ServiceError) *UpsertConfigUnexpectedResponseBody {
+	body := &UpsertConfigUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpsertConfigGatewayErrorResponseBody builds the HTTP response body from
+// the result of the "upsertConfig" endpoint of the "otelForwarding" service.
+func NewUpsertConfigGatewayErrorResponseBody(res *goa.ServiceError) *UpsertConfigGatewayErrorResponseBody {
+	body := &UpsertConfigGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteConfigUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "deleteConfig" endpoint of the "otelForwarding" service.
+func NewDeleteConfigUnauthorizedResponseBody(res *goa.ServiceError) *DeleteConfigUnauthorizedResponseBody {
+	body := &DeleteConfigUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteConfigForbiddenResponseBody builds the HTTP response body from the
+// result of the "deleteConfig" endpoint of the "otelForwarding" service.
+func NewDeleteConfigForbiddenResponseBody(res *goa.ServiceError) *DeleteConfigForbiddenResponseBody {
+	body := &DeleteConfigForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteConfigBadRequestResponseBody builds the HTTP response body from the
+// result of the "deleteConfig" endpoint of the "otelForwarding" service.
+func NewDeleteConfigBadRequestResponseBody(res *goa.ServiceError) *DeleteConfigBadRequestResponseBody {
+	body := &DeleteConfigBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteConfigNotFoundResponseBody builds the HTTP response body from the
+// result of the "deleteConfig" endpoint of the "otelForwarding" service.
+func NewDeleteConfigNotFoundResponseBody(res *goa.ServiceError) *DeleteConfigNotFoundResponseBody {
+	body := &DeleteConfigNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteConfigConflictResponseBody builds the HTTP response body from the
+// result of the "deleteConfig" endpoint of the "otelForwarding" service.
+func NewDeleteConfigConflictResponseBody(res *goa.ServiceError) *DeleteConfigConflictResponseBody {
+	body := &DeleteConfigConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteConfigUnsupportedMediaResponseBody builds the HTTP response body
+// from the result of the "deleteConfig" endpoint of the "otelForwarding"
+// service.
+func NewDeleteConfigUnsupportedMediaResponseBody(res *goa.ServiceError) *DeleteConfigUnsupportedMediaResponseBody {
+	body := &DeleteConfigUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteConfigInvalidResponseBody builds the HTTP response body from the
+// result of the "deleteConfig" endpoint of the "otelForwarding" service.
+func NewDeleteConfigInvalidResponseBody(res *goa.ServiceError) *DeleteConfigInvalidResponseBody {
+	body := &DeleteConfigInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteConfigInvariantViolationResponseBody builds the HTTP response body
+// from the result of the "deleteConfig" endpoint of the "otelForwarding"
+// service.
+func NewDeleteConfigInvariantViolationResponseBody(res *goa.ServiceError) *DeleteConfigInvariantViolationResponseBody {
+	body := &DeleteConfigInvariantViolationResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -761,10 +2178,10 @@ func NewGetConfigConflictResponseBody(res *goa.ServiceError) *GetConfigConflictR
 	return body
 }
 
-// NewGetConfigUnsupportedMediaResponseBody builds the HTTP response body from
-// the result of the "getConfig" endpoint of the "otelForwarding" service.
-func NewGetConfigUnsupportedMediaResponseBody(res *goa.ServiceError) *GetConfigUnsupportedMediaResponseBody {
-	body := &GetConfigUnsupportedMediaResponseBody{
+// NewDeleteConfigUnexpectedResponseBody builds the HTTP response body from the
+// result of the "deleteConfig" endpoint of the "otelForwarding" service.
+func NewDeleteConfigUnexpectedResponseBody(res *goa.ServiceError) *DeleteConfigUnexpectedResponseBody {
+	body := &DeleteConfigUnexpectedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -775,10 +2192,10 @@ func NewGetConfigUnsupportedMediaResponseBody(res *goa.ServiceError) *GetConfigU
 	return body
 }
 
-// NewGetConfigInvalidResponseBody builds the HTTP response body from the
-// result of the "getConfig" endpoint of the "otelForwarding" service.
-func NewGetConfigInvalidResponseBody(res *goa.ServiceError) *GetConfigInvalidResponseBody {
-	body := &GetConfigInvalidResponseBody{
+// NewDeleteConfigGatewayErrorResponseBody builds the HTTP response body from
+// the result of the "deleteConfig" endpoint of the "otelForwarding" service.
+func NewDeleteConfigGatewayErrorResponseBody(res *goa.ServiceError) *DeleteConfigGatewayErrorResponseBody {
+	body := &DeleteConfigGatewayErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -789,10 +2206,11 @@ func NewGetConfigInvalidResponseBody(res *goa.ServiceError) *GetConfigInvalidRes
 	return body
 }
 
-// NewGetConfigInvariantViolationResponseBody builds the HTTP response body
-// from the result of the "getConfig" endpoint of the "otelForwarding" service.
-func NewGetConfigInvariantViolationResponseBody(res *goa.ServiceError) *GetConfigInvariantViolationResponseBody {
-	body := &GetConfigInvariantViolationResponseBody{
+// NewListDestinationsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "listDestinations" endpoint of the "otelForwarding"
+// service.
+func NewListDestinationsUnauthorizedResponseBody(res *goa.ServiceError) *ListDestinationsUnauthorizedResponseBody {
+	body := &ListDestinationsUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -803,10 +2221,11 @@ func NewGetConfigInvariantViolationResponseBody(res *goa.ServiceError) *GetConfi
 	return body
 }
 
-// NewGetConfigUnexpectedResponseBody builds the HTTP response body from the
-// result of the "getConfig" endpoint of the "otelForwarding" service.
-func NewGetConfigUnexpectedResponseBody(res *goa.ServiceError) *GetConfigUnexpectedResponseBody {
-	body := &GetConfigUnexpectedResponseBody{
+// NewListDestinationsForbiddenResponseBody builds the HTTP response body from
+// the result of the "listDestinations" endpoint of the "otelForwarding"
+// service.
+func NewListDestinationsForbiddenResponseBody(res *goa.ServiceError) *ListDestinationsForbiddenResponseBody {
+	body := &ListDestinationsForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -817,10 +2236,11 @@ func NewGetConfigUnexpectedResponseBody(res *goa.ServiceError) *GetConfigUnexpec
 	return body
 }
 
-// NewGetConfigGatewayErrorResponseBody builds the HTTP response body from the
-// result of the "getConfig" endpoint of the "otelForwarding" service.
-func NewGetConfigGatewayErrorResponseBody(res *goa.ServiceError) *GetConfigGatewayErrorResponseBody {
-	body := &GetConfigGatewayErrorResponseBody{
+// NewListDestinationsBadRequestResponseBody builds the HTTP response body from
+// the result of the "listDestinations" endpoint of the "otelForwarding"
+// service.
+func NewListDestinationsBadRequestResponseBody(res *goa.ServiceError) *ListDestinationsBadRequestResponseBody {
+	body := &ListDestinationsBadRequestResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -831,10 +2251,11 @@ func NewGetConfigGatewayErrorResponseBody(res *goa.ServiceError) *GetConfigGatew
 	return body
 }
 
-// NewUpsertConfigUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "upsertConfig" endpoint of the "otelForwarding" service.
-func NewUpsertConfigUnauthorizedResponseBody(res *goa.ServiceError) *UpsertConfigUnauthorizedResponseBody {
-	body := &UpsertConfigUnauthorizedResponseBody{
+// NewListDestinationsNotFoundResponseBody builds the HTTP response body from
+// the result of the "listDestinations" endpoint of the "otelForwarding"
+// service.
+func NewListDestinationsNotFoundResponseBody(res *goa.ServiceError) *ListDestinationsNotFoundResponseBody {
+	body := &ListDestinationsNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -845,10 +2266,11 @@ func NewUpsertConfigUnauthorizedResponseBody(res *goa.ServiceError) *UpsertConfi
 	return body
 }
 
-// NewUpsertConfigForbiddenResponseBody builds the HTTP response body from the
-// result of the "upsertConfig" endpoint of the "otelForwarding" service.
-func NewUpsertConfigForbiddenResponseBody(res *goa.ServiceError) *UpsertConfigForbiddenResponseBody {
-	body := &UpsertConfigForbiddenResponseBody{
+// NewListDestinationsConflictResponseBody builds the HTTP response body from
+// the result of the "listDestinations" endpoint of the "otelForwarding"
+// service.
+func NewListDestinationsConflictResponseBody(res *goa.ServiceError) *ListDestinationsConflictResponseBody {
+	body := &ListDestinationsConflictResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -859,10 +2281,11 @@ func NewUpsertConfigForbiddenResponseBody(res *goa.ServiceError) *UpsertConfigFo
 	return body
 }
 
-// NewUpsertConfigBadRequestResponseBody builds the HTTP response body from the
-// result of the "upsertConfig" endpoint of the "otelForwarding" service.
-func NewUpsertConfigBadRequestResponseBody(res *goa.ServiceError) *UpsertConfigBadRequestResponseBody {
-	body := &UpsertConfigBadRequestResponseBody{
+// NewListDestinationsUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "listDestinations" endpoint of the
+// "otelForwarding" service.
+func NewListDestinationsUnsupportedMediaResponseBody(res *goa.ServiceError) *ListDestinationsUnsupportedMediaResponseBody {
+	body := &ListDestinationsUnsupportedMediaResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -873,10 +2296,11 @@ func NewUpsertConfigBadRequestResponseBody(res *goa.ServiceError) *UpsertConfigB
 	return body
 }
 
-// NewUpsertConfigNotFoundResponseBody builds the HTTP response body from the
-// result of the "upsertConfig" endpoint of the "otelForwarding" service.
-func NewUpsertConfigNotFoundResponseBody(res *goa.ServiceError) *UpsertConfigNotFoundResponseBody {
-	body := &UpsertConfigNotFoundResponseBody{
+// NewListDestinationsInvalidResponseBody builds the HTTP response body from
+// the result of the "listDestinations" endpoint of the "otelForwarding"
+// service.
+func NewListDestinationsInvalidResponseBody(res *goa.ServiceError) *ListDestinationsInvalidResponseBody {
+	body := &ListDestinationsInvalidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -887,10 +2311,11 @@ func NewUpsertConfigNotFoundResponseBody(res *goa.ServiceError) *UpsertConfigNot
 	return body
 }
 
-// NewUpsertConfigConflictResponseBody builds the HTTP response body from the
-// result of the "upsertConfig" endpoint of the "otelForwarding" service.
-func NewUpsertConfigConflictResponseBody(res *goa.ServiceError) *UpsertConfigConflictResponseBody {
-	body := &UpsertConfigConflictResponseBody{
+// NewListDestinationsInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "listDestinations" endpoint of the
+// "otelForwarding" service.
+func NewListDestinationsInvariantViolationResponseBody(res *goa.ServiceError) *ListDestinationsInvariantViolationResponseBody {
+	body := &ListDestinationsInvariantViolationResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -901,11 +2326,11 @@ func NewUpsertConfigConflictResponseBody(res *goa.ServiceError) *UpsertConfigCon
 	return body
 }
 
-// NewUpsertConfigUnsupportedMediaResponseBody builds the HTTP response body
-// from the result of the "upsertConfig" endpoint of the "otelForwarding"
+// NewListDestinationsUnexpectedResponseBody builds the HTTP response body from
+// the result of the "listDestinations" endpoint of the "otelForwarding"
 // service.
-func NewUpsertConfigUnsupportedMediaResponseBody(res *goa.ServiceError) *UpsertConfigUnsupportedMediaResponseBody {
-	body := &UpsertConfigUnsupportedMediaResponseBody{
+func NewListDestinationsUnexpectedResponseBody(res *goa.ServiceError) *ListDestinationsUnexpectedResponseBody {
+	body := &ListDestinationsUnexpectedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -916,10 +2341,266 @@ func NewUpsertConfigUnsupportedMediaResponseBody(res *goa.ServiceError) *UpsertC
 	return body
 }
 
-// NewUpsertConfigInvalidResponseBody builds the HTTP response body from the
-// result of the "upsertConfig" endpoint of the "otelForwarding" service.
-func NewUpsertConfigInvalidResponseBody(res *goa.ServiceError) *UpsertConfigInvalidResponseBody {
-	body := &UpsertConfigInvalidResponseBody{
+// NewListDestinationsGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "listDestinations" endpoint of the "otelForwarding"
+// service.
+func NewListDestinationsGatewayErrorResponseBody(res *goa.ServiceError) *ListDestinationsGatewayErrorResponseBody {
+	body := &ListDestinationsGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateDestinationUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "createDestination" endpoint of the "otelForwarding"
+// service.
+func NewCreateDestinationUnauthorizedResponseBody(res *goa.ServiceError) *CreateDestinationUnauthorizedResponseBody {
+	body := &CreateDestinationUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateDestinationForbiddenResponseBody builds the HTTP response body from
+// the result of the "createDestination" endpoint of the "otelForwarding"
+// service.
+func NewCreateDestinationForbiddenResponseBody(res *goa.ServiceError) *CreateDestinationForbiddenResponseBody {
+	body := &CreateDestinationForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateDestinationBadRequestResponseBody builds the HTTP response body
+// from the result of the "createDestination" endpoint of the "otelForwarding"
+// service.
+func NewCreateDestinationBadRequestResponseBody(res *goa.ServiceError) *CreateDestinationBadRequestResponseBody {
+	body := &CreateDestinationBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateDestinationNotFoundResponseBody builds the HTTP response body from
+// the result of the "createDestination" endpoint of the "otelForwarding"
+// service.
+func NewCreateDestinationNotFoundResponseBody(res *goa.ServiceError) *CreateDestinationNotFoundResponseBody {
+	body := &CreateDestinationNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateDestinationConflictResponseBody builds the HTTP response body from
+// the result of the "createDestination" endpoint of the "otelForwarding"
+// service.
+func NewCreateDestinationConflictResponseBody(res *goa.ServiceError) *CreateDestinationConflictResponseBody {
+	body := &CreateDestinationConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateDestinationUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "createDestination" endpoint of the
+// "otelForwarding" service.
+func NewCreateDestinationUnsupportedMediaResponseBody(res *goa.ServiceError) *CreateDestinationUnsupportedMediaResponseBody {
+	body := &CreateDestinationUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateDestinationInvalidResponseBody builds the HTTP response body from
+// the result of the "createDestination" endpoint of the "otelForwarding"
+// service.
+func NewCreateDestinationInvalidResponseBody(res *goa.ServiceError) *CreateDestinationInvalidResponseBody {
+	body := &CreateDestinationInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateDestinationInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "createDestination" endpoint of the
+// "otelForwarding" service.
+func NewCreateDestinationInvariantViolationResponseBody(res *goa.ServiceError) *CreateDestinationInvariantViolationResponseBody {
+	body := &CreateDestinationInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateDestinationUnexpectedResponseBody builds the HTTP response body
+// from the result of the "createDestination" endpoint of the "otelForwarding"
+// service.
+func NewCreateDestinationUnexpectedResponseBody(res *goa.ServiceError) *CreateDestinationUnexpectedResponseBody {
+	body := &CreateDestinationUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateDestinationGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "createDestination" endpoint of the "otelForwarding"
+// service.
+func NewCreateDestinationGatewayErrorResponseBody(res *goa.ServiceError) *CreateDestinationGatewayErrorResponseBody {
+	body := &CreateDestinationGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateDestinationUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "updateDestination" endpoint of the "otelForwarding"
+// service.
+func NewUpdateDestinationUnauthorizedResponseBody(res *goa.ServiceError) *UpdateDestinationUnauthorizedResponseBody {
+	body := &UpdateDestinationUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateDestinationForbiddenResponseBody builds the HTTP response body from
+// the result of the "updateDestination" endpoint of the "otelForwarding"
+// service.
+func NewUpdateDestinationForbiddenResponseBody(res *goa.ServiceError) *UpdateDestinationForbiddenResponseBody {
+	body := &UpdateDestinationForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateDestinationBadRequestResponseBody builds the HTTP response body
+// from the result of the "updateDestination" endpoint of the "otelForwarding"
+// service.
+func NewUpdateDestinationBadRequestResponseBody(res *goa.ServiceError) *UpdateDestinationBadRequestResponseBody {
+	body := &UpdateDestinationBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateDestinationNotFoundResponseBody builds the HTTP response body from
+// the result of the "updateDestination" endpoint of the "otelForwarding"
+// service.
+func NewUpdateDestinationNotFoundResponseBody(res *goa.ServiceError) *UpdateDestinationNotFoundResponseBody {
+	body := &UpdateDestinationNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateDestinationConflictResponseBody builds the HTTP response body from
+// the result of the "updateDestination" endpoint of the "otelForwarding"
+// service.
+func NewUpdateDestinationConflictResponseBody(res *goa.ServiceError) *UpdateDestinationConflictResponseBody {
+	body := &UpdateDestinationConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateDestinationUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "updateDestination" endpoint of the
+// "otelForwarding" service.
+func NewUpdateDestinationUnsupportedMediaResponseBody(res *goa.ServiceError) *UpdateDestinationUnsupportedMediaResponseBody {
+	body := &UpdateDestinationUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateDestinationInvalidResponseBody builds the HTTP response body from
+// the result of the "updateDestination" endpoint of the "otelForwarding"
+// service.
+func NewUpdateDestinationInvalidResponseBody(res *goa.ServiceError) *UpdateDestinationInvalidResponseBody {
+	body := &UpdateDestinationInvalidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -930,11 +2611,11 @@ func NewUpsertConfigInvalidResponseBody(res *goa.ServiceError) *UpsertConfigInva
 	return body
 }
 
-// NewUpsertConfigInvariantViolationResponseBody builds the HTTP response body
-// from the result of the "upsertConfig" endpoint of the "otelForwarding"
-// service.
-func NewUpsertConfigInvariantViolationResponseBody(res *goa.ServiceError) *UpsertConfigInvariantViolationResponseBody {
-	body := &UpsertConfigInvariantViolationResponseBody{
+// NewUpdateDestinationInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "updateDestination" endpoint of the
+// "otelForwarding" service.
+func NewUpdateDestinationInvariantViolationResponseBody(res *goa.ServiceError) *UpdateDestinationInvariantViolationResponseBody {
+	body := &UpdateDestinationInvariantViolationResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -945,10 +2626,11 @@ func NewUpsertConfigInvariantViolationResponseBody(res *goa.ServiceError) *Upser
 	return body
 }
 
-// NewUpsertConfigUnexpectedResponseBody builds the HTTP response body from the
-// result of the "upsertConfig" endpoint of the "otelForwarding" service.
-func NewUpsertConfigUnexpectedResponseBody(res *goa.ServiceError) *UpsertConfigUnexpectedResponseBody {
-	body := &UpsertConfigUnexpectedResponseBody{
+// NewUpdateDestinationUnexpectedResponseBody builds the HTTP response body
+// from the result of the "updateDestination" endpoint of the "otelForwarding"
+// service.
+func NewUpdateDestinationUnexpectedResponseBody(res *goa.ServiceError) *UpdateDestinationUnexpectedResponseBody {
+	body := &UpdateDestinationUnexpectedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -959,10 +2641,11 @@ func NewUpsertConfigUnexpectedResponseBody(res *goa.ServiceError) *UpsertConfigU
 	return body
 }
 
-// NewUpsertConfigGatewayErrorResponseBody builds the HTTP response body from
-// the result of the "upsertConfig" endpoint of the "otelForwarding" service.
-func NewUpsertConfigGatewayErrorResponseBody(res *goa.ServiceError) *UpsertConfigGatewayErrorResponseBody {
-	body := &UpsertConfigGatewayErrorResponseBody{
+// NewUpdateDestinationGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "updateDestination" endpoint of the "otelForwarding"
+// service.
+func NewUpdateDestinationGatewayErrorResponseBody(res *goa.ServiceError) *UpdateDestinationGatewayErrorResponseBody {
+	body := &UpdateDestinationGatewayErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -973,10 +2656,11 @@ func NewUpsertConfigGatewayErrorResponseBody(res *goa.ServiceError) *UpsertConfi
 	return body
 }
 
-// NewDeleteConfigUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "deleteConfig" endpoint of the "otelForwarding" service.
-func NewDeleteConfigUnauthorizedResponseBody(res *goa.ServiceError) *DeleteConfigUnauthorizedResponseBody {
-	body := &DeleteConfigUnauthorizedResponseBody{
+// NewDeleteDestinationUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "deleteDestination" endpoint of the "otelForwarding"
+// service.
+func NewDeleteDestinationUnauthorizedResponseBody(res *goa.ServiceError) *DeleteDestinationUnauthorizedResponseBody {
+	body := &DeleteDestinationUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -987,10 +2671,11 @@ func NewDeleteConfigUnauthorizedResponseBody(res *goa.ServiceError) *DeleteConfi
 	return body
 }
 
-// NewDeleteConfigForbiddenResponseBody builds the HTTP response body from the
-// result of the "deleteConfig" endpoint of the "otelForwarding" service.
-func NewDeleteConfigForbiddenResponseBody(res *goa.ServiceError) *DeleteConfigForbiddenResponseBody {
-	body := &DeleteConfigForbiddenResponseBody{
+// NewDeleteDestinationForbiddenResponseBody builds the HTTP response body from
+// the result of the "deleteDestination" endpoint of the "otelForwarding"
+// service.
+func NewDeleteDestinationForbiddenResponseBody(res *goa.ServiceError) *DeleteDestinationForbiddenResponseBody {
+	body := &DeleteDestinationForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1001,10 +2686,11 @@ func NewDeleteConfigForbiddenResponseBody(res *goa.ServiceError) *DeleteConfigFo
 	return body
 }
 
-// NewDeleteConfigBadRequestResponseBody builds the HTTP response body from the
-// result of the "deleteConfig" endpoint of the "otelForwarding" service.
-func NewDeleteConfigBadRequestResponseBody(res *goa.ServiceError) *DeleteConfigBadRequestResponseBody {
-	body := &DeleteConfigBadRequestResponseBody{
+// NewDeleteDestinationBadRequestResponseBody builds the HTTP response body
+// from the result of the "deleteDestination" endpoint of the "otelForwarding"
+// service.
+func NewDeleteDestinationBadRequestResponseBody(res *goa.ServiceError) *DeleteDestinationBadRequestResponseBody {
+	body := &DeleteDestinationBadRequestResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1015,10 +2701,11 @@ func NewDeleteConfigBadRequestResponseBody(res *goa.ServiceError) *DeleteConfigB
 	return body
 }
 
-// NewDeleteConfigNotFoundResponseBody builds the HTTP response body from the
-// result of the "deleteConfig" endpoint of the "otelForwarding" service.
-func NewDeleteConfigNotFoundResponseBody(res *goa.ServiceError) *DeleteConfigNotFoundResponseBody {
-	body := &DeleteConfigNotFoundResponseBody{
+// NewDeleteDestinationNotFoundResponseBody builds the HTTP response body from
+// the result of the "deleteDestination" endpoint of the "otelForwarding"
+// service.
+func NewDeleteDestinationNotFoundResponseBody(res *goa.ServiceError) *DeleteDestinationNotFoundResponseBody {
+	body := &DeleteDestinationNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1029,10 +2716,11 @@ func NewDeleteConfigNotFoundResponseBody(res *goa.ServiceError) *DeleteConfigNot
 	return body
 }
 
-// NewDeleteConfigConflictResponseBody builds the HTTP response body from the
-// result of the "deleteConfig" endpoint of the "otelForwarding" service.
-func NewDeleteConfigConflictResponseBody(res *goa.ServiceError) *DeleteConfigConflictResponseBody {
-	body := &DeleteConfigConflictResponseBody{
+// NewDeleteDestinationConflictResponseBody builds the HTTP response body from
+// the result of the "deleteDestination" endpoint of the "otelForwarding"
+// service.
+func NewDeleteDestinationConflictResponseBody(res *goa.ServiceError) *DeleteDestinationConflictResponseBody {
+	body := &DeleteDestinationConflictResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1043,11 +2731,11 @@ func NewDeleteConfigConflictResponseBody(res *goa.ServiceError) *DeleteConfigCon
 	return body
 }
 
-// NewDeleteConfigUnsupportedMediaResponseBody builds the HTTP response body
-// from the result of the "deleteConfig" endpoint of the "otelForwarding"
-// service.
-func NewDeleteConfigUnsupportedMediaResponseBody(res *goa.ServiceError) *DeleteConfigUnsupportedMediaResponseBody {
-	body := &DeleteConfigUnsupportedMediaResponseBody{
+// NewDeleteDestinationUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "deleteDestination" endpoint of the
+// "otelForwarding" service.
+func NewDeleteDestinationUnsupportedMediaResponseBody(res *goa.ServiceError) *DeleteDestinationUnsupportedMediaResponseBody {
+	body := &DeleteDestinationUnsupportedMediaResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1058,10 +2746,11 @@ func NewDeleteConfigUnsupportedMediaResponseBody(res *goa.ServiceError) *DeleteC
 	return body
 }
 
-// NewDeleteConfigInvalidResponseBody builds the HTTP response body from the
-// result of the "deleteConfig" endpoint of the "otelForwarding" service.
-func NewDeleteConfigInvalidResponseBody(res *goa.ServiceError) *DeleteConfigInvalidResponseBody {
-	body := &DeleteConfigInvalidResponseBody{
+// NewDeleteDestinationInvalidResponseBody builds the HTTP response body from
+// the result of the "deleteDestination" endpoint of the "otelForwarding"
+// service.
+func NewDeleteDestinationInvalidResponseBody(res *goa.ServiceError) *DeleteDestinationInvalidResponseBody {
+	body := &DeleteDestinationInvalidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1072,11 +2761,11 @@ func NewDeleteConfigInvalidResponseBody(res *goa.ServiceError) *DeleteConfigInva
 	return body
 }
 
-// NewDeleteConfigInvariantViolationResponseBody builds the HTTP response body
-// from the result of the "deleteConfig" endpoint of the "otelForwarding"
-// service.
-func NewDeleteConfigInvariantViolationResponseBody(res *goa.ServiceError) *DeleteConfigInvariantViolationResponseBody {
-	body := &DeleteConfigInvariantViolationResponseBody{
+// NewDeleteDestinationInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "deleteDestination" endpoint of the
+// "otelForwarding" service.
+func NewDeleteDestinationInvariantViolationResponseBody(res *goa.ServiceError) *DeleteDestinationInvariantViolationResponseBody {
+	body := &DeleteDestinationInvariantViolationResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1087,10 +2776,11 @@ func NewDeleteConfigInvariantViolationResponseBody(res *goa.ServiceError) *Delet
 	return body
 }
 
-// NewDeleteConfigUnexpectedResponseBody builds the HTTP response body from the
-// result of the "deleteConfig" endpoint of the "otelForwarding" service.
-func NewDeleteConfigUnexpectedResponseBody(res *goa.ServiceError) *DeleteConfigUnexpectedResponseBody {
-	body := &DeleteConfigUnexpectedResponseBody{
+// NewDeleteDestinationUnexpectedResponseBody builds the HTTP response body
+// from the result of the "deleteDestination" endpoint of the "otelForwarding"
+// service.
+func NewDeleteDestinationUnexpectedResponseBody(res *goa.ServiceError) *DeleteDestinationUnexpectedResponseBody {
+	body := &DeleteDestinationUnexpectedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1101,10 +2791,11 @@ func NewDeleteConfigUnexpectedResponseBody(res *goa.ServiceError) *DeleteConfigU
 	return body
 }
 
-// NewDeleteConfigGatewayErrorResponseBody builds the HTTP response body from
-// the result of the "deleteConfig" endpoint of the "otelForwarding" service.
-func NewDeleteConfigGatewayErrorResponseBody(res *goa.ServiceError) *DeleteConfigGatewayErrorResponseBody {
-	body := &DeleteConfigGatewayErrorResponseBody{
+// NewDeleteDestinationGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "deleteDestination" endpoint of the "otelForwarding"
+// service.
+func NewDeleteDestinationGatewayErrorResponseBody(res *goa.ServiceError) *DeleteDestinationGatewayErrorResponseBody {
+	body := &DeleteDestinationGatewayErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1158,6 +2849,137 @@ func NewDeleteConfigPayload(apikeyToken *string, sessionToken *string) *otelforw
 	return v
 }
 
+// NewListDestinationsPayload builds a otelForwarding service listDestinations
+// endpoint payload.
+func NewListDestinationsPayload(apikeyToken *string, sessionToken *string) *otelforwarding.ListDestinationsPayload {
+	v := &otelforwarding.ListDestinationsPayload{}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewCreateDestinationPayload builds a otelForwarding service
+// createDestination endpoint payload.
+func NewCreateDestinationPayload(body *CreateDestinationRequestBody, apikeyToken *string, sessionToken *string) *otelforwarding.CreateDestinationPayload {
+	v := &otelforwarding.CreateDestinationPayload{
+		Name:        *body.Name,
+		EndpointURL: *body.EndpointURL,
+	}
+	if body.Protocol != nil {
+		v.Protocol = *body.Protocol
+	}
+	if body.Enabled != nil {
+		v.Enabled = *body.Enabled
+	}
+	if body.SamplingRatio != nil {
+		v.SamplingRatio = *body.SamplingRatio
+	}
+	if body.Protocol == nil {
+		v.Protocol = "http/protobuf"
+	}
+	if body.Enabled == nil {
+		v.Enabled = true
+	}
+	if body.Headers != nil {
+		v.Headers = make([]*otelforwarding.OtelForwardingHeaderInput, len(body.Headers))
+		for i, val := range body.Headers {
+			if val == nil {
+				v.Headers[i] = nil
+				continue
+			}
+			v.Headers[i] = unmarshalOtelForwardingHeaderInputRequestBodyToOtelforwardingOtelForwardingHeaderInput(val)
+		}
+	}
+	if body.SamplingRatio == nil {
+		v.SamplingRatio = 1
+	}
+	if body.Filters != nil {
+		v.Filters = unmarshalOtelForwardingFiltersRequestBodyToOtelforwardingOtelForwardingFilters(body.Filters)
+	}
+	if body.ScrubRules != nil {
+		v.ScrubRules = make([]*otelforwarding.OtelForwardingScrubRule, len(body.ScrubRules))
+		for i, val := range body.ScrubRules {
+			if val == nil {
+				v.ScrubRules[i] = nil
+				continue
+			}
+			v.ScrubRules[i] = unmarshalOtelForwardingScrubRuleRequestBodyToOtelforwardingOtelForwardingScrubRule(val)
+		}
+	}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewUpdateDestinationPayload builds a otelForwarding service
+// updateDestination endpoint payload.
+func NewUpdateDestinationPayload(body *UpdateDestinationRequestBody, apikeyToken *string, sessionToken *string) *otelforwarding.UpdateDestinationPayload {
+	v := &otelforwarding.UpdateDestinationPayload{
+		ID:          *body.ID,
+		Name:        *body.Name,
+		EndpointURL: *body.EndpointURL,
+	}
+	if body.Protocol != nil {
+		v.Protocol = *body.Protocol
+	}
+	if body.Enabled != nil {
+		v.Enabled = *body.Enabled
+	}
+	if body.SamplingRatio != nil {
+		v.SamplingRatio = *body.SamplingRatio
+	}
+	if body.Protocol == nil {
+		v.Protocol = "http/protobuf"
+	}
+	if body.Enabled == nil {
+		v.Enabled = true
+	}
+	if body.Headers != nil {
+		v.Headers = make([]*otelforwarding.OtelForwardingHeaderInput, len(body.Headers))
+		for i, val := range body.Headers {
+			if val == nil {
+				v.Headers[i] = nil
+				continue
+			}
+			v.Headers[i] = unmarshalOtelForwardingHeaderInputRequestBodyToOtelforwardingOtelForwardingHeaderInput(val)
+		}
+	}
+	if body.SamplingRatio == nil {
+		v.SamplingRatio = 1
+	}
+	if body.Filters != nil {
+		v.Filters = unmarshalOtelForwardingFiltersRequestBodyToOtelforwardingOtelForwardingFilters(body.Filters)
+	}
+	if body.ScrubRules != nil {
+		v.ScrubRules = make([]*otelforwarding.OtelForwardingScrubRule, len(body.ScrubRules))
+		for i, val := range body.ScrubRules {
+			if val == nil {
+				v.ScrubRules[i] = nil
+				continue
+			}
+			v.ScrubRules[i] = unmarshalOtelForwardingScrubRuleRequestBodyToOtelforwardingOtelForwardingScrubRule(val)
+		}
+	}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewDeleteDestinationPayload builds a otelForwarding service
+// deleteDestination endpoint payload.
+func NewDeleteDestinationPayload(body *DeleteDestinationRequestBody, apikeyToken *string, sessionToken *string) *otelforwarding.DeleteDestinationPayload {
+	v := &otelforwarding.DeleteDestinationPayload{
+		ID: *body.ID,
+	}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+
+	return v
+}
+
 // ValidateUpsertConfigRequestBody runs the validations defined on
 // UpsertConfigRequestBody
 func ValidateUpsertConfigRequestBody(body *UpsertConfigRequestBody) (err error) {
@@ -1177,6 +2999,142 @@ func ValidateUpsertConfigRequestBody(body *UpsertConfigRequestBody) (err error)
 	return
 }
 
+// ValidateCreateDestinationRequestBody runs the validations defined on
+// CreateDestinationRequestBody
+func ValidateCreateDestinationRequestBody(body *CreateDestinationRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.EndpointURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_url", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 100, false))
+		}
+	}
+	if body.Protocol != nil {
+		if !(*body.Protocol == "http/protobuf" || *body.Protocol == "http/json" || *body.Protocol == "grpc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.protocol", *body.Protocol, []any{"http/protobuf", "http/json", "grpc"}))
+		}
+	}
+	for _, e := range body.Headers {
+		if e != nil {
+			if err2 := ValidateOtelForwardingHeaderInputRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.SamplingRatio != nil {
+		if *body.SamplingRatio < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sampling_ratio", *body.SamplingRatio, 0, true))
+		}
+	}
+	if body.SamplingRatio != nil {
+		if *body.SamplingRatio > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sampling_ratio", *body.SamplingRatio, 1, false))
+		}
+	}
+	if body.Filters != nil {
+		if err2 := ValidateOtelForwardingFiltersRequestBody(body.Filters); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if len(body.ScrubRules) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_rules", body.ScrubRules, len(body.ScrubRules), 50, false))
+	}
+	for _, e := range body.ScrubRules {
+		if e != nil {
+			if err2 := ValidateOtelForwardingScrubRuleRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUpdateDestinationRequestBody runs the validations defined on
+// UpdateDestinationRequestBody
+func ValidateUpdateDestinationRequestBody(body *UpdateDestinationRequestBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.EndpointURL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("endpoint_url", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 100, false))
+		}
+	}
+	if body.Protocol != nil {
+		if !(*body.Protocol == "http/protobuf" || *body.Protocol == "http/json" || *body.Protocol == "grpc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.protocol", *body.Protocol, []any{"http/protobuf", "http/json", "grpc"}))
+		}
+	}
+	for _, e := range body.Headers {
+		if e != nil {
+			if err2 := ValidateOtelForwardingHeaderInputRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.SamplingRatio != nil {
+		if *body.SamplingRatio < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sampling_ratio", *body.SamplingRatio, 0, true))
+		}
+	}
+	if body.SamplingRatio != nil {
+		if *body.SamplingRatio > 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.sampling_ratio", *body.SamplingRatio, 1, false))
+		}
+	}
+	if body.Filters != nil {
+		if err2 := ValidateOtelForwardingFiltersRequestBody(body.Filters); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if len(body.ScrubRules) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.scrub_rules", body.ScrubRules, len(body.ScrubRules), 50, false))
+	}
+	for _, e := range body.ScrubRules {
+		if e != nil {
+			if err2 := ValidateOtelForwardingScrubRuleRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDeleteDestinationRequestBody runs the validations defined on
+// DeleteDestinationRequestBody
+func ValidateDeleteDestinationRequestBody(body *DeleteDestinationRequestBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatUUID))
+	}
+	return
+}
+
 // ValidateOtelForwardingHeaderInputRequestBody runs the validations defined on
 // OtelForwardingHeaderInputRequestBody
 func ValidateOtelForwardingHeaderInputRequestBody(body *OtelForwardingHeaderInputRequestBody) (err error) {
@@ -1185,3 +3143,86 @@ func ValidateOtelForwardingHeaderInputRequestBody(body *OtelForwardingHeaderInpu
 	}
 	return
 }
+
+// ValidateOtelForwardingFiltersRequestBody runs the validations defined on
+// OtelForwardingFiltersRequestBody
+func ValidateOtelForwardingFiltersRequestBody(body *OtelForwardingFiltersRequestBody) (err error) {
+	if len(body.IncludeNames) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.include_names", body.IncludeNames, len(body.IncludeNames), 50, false))
+	}
+	if len(body.ExcludeNames) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.exclude_names", body.ExcludeNames, len(body.ExcludeNames), 50, false))
+	}
+	if len(body.IncludeAttributes) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.include_attributes", body.IncludeAttributes, len(body.IncludeAttributes), 50, false))
+	}
+	for _, e := range body.IncludeAttributes {
+		if e != nil {
+			if err2 := ValidateOtelForwardingAttributeMatchRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if len(body.ExcludeAttributes) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.exclude_attributes", body.ExcludeAttributes, len(body.ExcludeAttributes), 50, false))
+	}
+	for _, e := range body.ExcludeAttributes {
+		if e != nil {
+			if err2 := ValidateOtelForwardingAttributeMatchRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateOtelForwardingAttributeMatchRequestBody runs the validations defined
+// on OtelForwardingAttributeMatchRequestBody
+func ValidateOtelForwardingAttributeMatchRequestBody(body *OtelForwardingAttributeMatchRequestBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 1, true))
+		}
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 256, false))
+		}
+	}
+	if body.Value != nil {
+		if utf8.RuneCountInString(*body.Value) > 1024 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", *body.Value, utf8.RuneCountInString(*body.Value), 1024, false))
+		}
+	}
+	return
+}
+
+// ValidateOtelForwardingScrubRuleRequestBody runs the validations defined on
+// OtelForwardingScrubRuleRequestBody
+func ValidateOtelForwardingScrubRuleRequestBody(body *OtelForwardingScrubRuleRequestBody) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "body"))
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 1, true))
+		}
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) > 256 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 256, false))
+		}
+	}
+	if body.Action != nil {
+		if !(*body.Action == "drop" || *body.Action == "redact" || *body.Action == "hash") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", *body.Action, []any{"drop", "redact", "hash"}))
+		}
+	}
+	return
+}
@@ -3066,6 +3066,7 @@ func marshalTypesMcpServerToMcpServerResponseBody(v *types.McpServer) *McpServer
 		ToolsetID:             v.ToolsetID,
 		UnproxiedMcpServerID:  v.UnproxiedMcpServerID,
 		ToolVariationsGroupID: v.ToolVariationsGroupID,
+		ToolsetVersion:        v.ToolsetVersion,
 		Visibility:            string(v.Visibility),
 		CreatedAt:             v.CreatedAt,
 		UpdatedAt:             v.UpdatedAt,
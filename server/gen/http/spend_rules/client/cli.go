@@ -24,7 +24,7 @@ func BuildCreateSpendRulePayload(spendRulesCreateSpendRuleBody string, spendRule
 	{
 		err = json.Unmarshal([]byte(spendRulesCreateSpendRuleBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"action\": \"block\",\n      \"description\": \"abc123\",\n      \"enabled\": false,\n      \"limit_usd\": 1,\n      \"name\": \"abc123\",\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"action\": \"block\",\n      \"description\": \"abc123\",\n      \"enabled\": false,\n      \"limit_usd\": 1,\n      \"name\": \"abc123\",\n      \"scope\": {\n         \"dimension\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }'")
 		}
 		if body.Target == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("target", "body"))
@@ -84,6 +84,9 @@ func BuildCreateSpendRulePayload(spendRulesCreateSpendRuleBody string, spendRule
 	if body.Target != nil {
 		v.Target = marshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition(body.Target)
 	}
+	if body.Scope != nil {
+		v.Scope = marshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition(body.Scope)
+	}
 	{
 		var zero int
 		if v.WarnAtPct == zero {
@@ -185,7 +188,7 @@ func BuildUpdateSpendRulePayload(spendRulesUpdateSpendRuleBody string, spendRule
 	{
 		err = json.Unmarshal([]byte(spendRulesUpdateSpendRuleBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"action\": \"block\",\n      \"description\": \"abc123\",\n      \"enabled\": false,\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"limit_usd\": 1,\n      \"name\": \"abc123\",\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"action\": \"block\",\n      \"clear_scope\": false,\n      \"description\": \"abc123\",\n      \"enabled\": false,\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"limit_usd\": 1,\n      \"name\": \"abc123\",\n      \"scope\": {\n         \"dimension\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatUUID))
 		if body.LimitUsd != nil {
@@ -239,6 +242,7 @@ func BuildUpdateSpendRulePayload(spendRulesUpdateSpendRuleBody string, spendRule
 		ID:          body.ID,
 		Name:        body.Name,
 		Description: body.Description,
+		ClearScope:  body.ClearScope,
 		LimitUsd:    body.LimitUsd,
 		WindowKind:  body.WindowKind,
 		WarnAtPct:   body.WarnAtPct,
@@ -248,6 +252,9 @@ func BuildUpdateSpendRulePayload(spendRulesUpdateSpendRuleBody string, spendRule
 	if body.Target != nil {
 		v.Target = marshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition(body.Target)
 	}
+	if body.Scope != nil {
+		v.Scope = marshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition(body.Scope)
+	}
 	v.ApikeyToken = apikeyToken
 	v.SessionToken = sessionToken
 	v.ProjectSlugInput = projectSlugInput
@@ -306,7 +313,7 @@ func BuildPreviewSpendRulePayload(spendRulesPreviewSpendRuleBody string, spendRu
 	{
 		err = json.Unmarshal([]byte(spendRulesPreviewSpendRuleBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"limit_usd\": 1,\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"limit_usd\": 1,\n      \"scope\": {\n         \"dimension\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\"\n   }'")
 		}
 		if body.Target == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("target", "body"))
@@ -353,12 +360,109 @@ func BuildPreviewSpendRulePayload(spendRulesPreviewSpendRuleBody string, spendRu
 	if body.Target != nil {
 		v.Target = marshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition(body.Target)
 	}
+	if body.Scope != nil {
+		v.Scope = marshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition(body.Scope)
+	}
+	{
+		var zero int
+		if v.WarnAtPct == zero {
+			v.WarnAtPct = 80
+		}
+	}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildSimulateSpendRulePayload builds the payload for the spendRules
+// simulateSpendRule endpoint from CLI flags.
+func BuildSimulateSpendRulePayload(spendRulesSimulateSpendRuleBody string, spendRulesSimulateSpendRuleApikeyToken string, spendRulesSimulateSpendRuleSessionToken string, spendRulesSimulateSpendRuleProjectSlugInput string) (*spendrules.SimulateSpendRulePayload, error) {
+	var err error
+	var body SimulateSpendRuleRequestBody
+	{
+		err = json.Unmarshal([]byte(spendRulesSimulateSpendRuleBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"action\": \"block\",\n      \"limit_usd\": 1,\n      \"scope\": {\n         \"dimension\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"target\": {\n         \"attribute\": \"abc123\",\n         \"operator\": \"abc123\",\n         \"value\": \"abc123\"\n      },\n      \"warn_at_pct\": 2,\n      \"window_kind\": \"weekly\",\n      \"windows\": 2\n   }'")
+		}
+		if body.Target == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("target", "body"))
+		}
+		if body.LimitUsd < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit_usd", body.LimitUsd, 0, true))
+		}
+		if body.WarnAtPct < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.warn_at_pct", body.WarnAtPct, 1, true))
+		}
+		if body.WarnAtPct > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.warn_at_pct", body.WarnAtPct, 100, false))
+		}
+		if !(body.WindowKind == "daily" || body.WindowKind == "weekly" || body.WindowKind == "monthly") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.window_kind", body.WindowKind, []any{"daily", "weekly", "monthly"}))
+		}
+		if !(body.Action == "flag" || body.Action == "block") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", body.Action, []any{"flag", "block"}))
+		}
+		if body.Windows < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.windows", body.Windows, 1, true))
+		}
+		if body.Windows > 12 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.windows", body.Windows, 12, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var apikeyToken *string
+	{
+		if spendRulesSimulateSpendRuleApikeyToken != "" {
+			apikeyToken = &spendRulesSimulateSpendRuleApikeyToken
+		}
+	}
+	var sessionToken *string
+	{
+		if spendRulesSimulateSpendRuleSessionToken != "" {
+			sessionToken = &spendRulesSimulateSpendRuleSessionToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if spendRulesSimulateSpendRuleProjectSlugInput != "" {
+			projectSlugInput = &spendRulesSimulateSpendRuleProjectSlugInput
+		}
+	}
+	v := &spendrules.SimulateSpendRulePayload{
+		LimitUsd:   body.LimitUsd,
+		WarnAtPct:  body.WarnAtPct,
+		WindowKind: body.WindowKind,
+		Action:     body.Action,
+		Windows:    body.Windows,
+	}
+	if body.Target != nil {
+		v.Target = marshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition(body.Target)
+	}
+	if body.Scope != nil {
+		v.Scope = marshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition(body.Scope)
+	}
 	{
 		var zero int
 		if v.WarnAtPct == zero {
 			v.WarnAtPct = 80
 		}
 	}
+	{
+		var zero string
+		if v.Action == zero {
+			v.Action = "block"
+		}
+	}
+	{
+		var zero int
+		if v.Windows == zero {
+			v.Windows = 3
+		}
+	}
 	v.ApikeyToken = apikeyToken
 	v.SessionToken = sessionToken
 	v.ProjectSlugInput = projectSlugInput
@@ -506,3 +610,32 @@ func BuildListActorAttributesPayload(spendRulesListActorAttributesApikeyToken st
 
 	return v, nil
 }
+
+// BuildListUsageDimensionsPayload builds the payload for the spendRules
+// listUsageDimensions endpoint from CLI flags.
+func BuildListUsageDimensionsPayload(spendRulesListUsageDimensionsApikeyToken string, spendRulesListUsageDimensionsSessionToken string, spendRulesListUsageDimensionsProjectSlugInput string) (*spendrules.ListUsageDimensionsPayload, error) {
+	var apikeyToken *string
+	{
+		if spendRulesListUsageDimensionsApikeyToken != "" {
+			apikeyToken = &spendRulesListUsageDimensionsApikeyToken
+		}
+	}
+	var sessionToken *string
+	{
+		if spendRulesListUsageDimensionsSessionToken != "" {
+			sessionToken = &spendRulesListUsageDimensionsSessionToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if spendRulesListUsageDimensionsProjectSlugInput != "" {
+			projectSlugInput = &spendRulesListUsageDimensionsProjectSlugInput
+		}
+	}
+	v := &spendrules.ListUsageDimensionsPayload{}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
@@ -41,6 +41,10 @@ type Client struct {
 	// previewSpendRule endpoint.
 	PreviewSpendRuleDoer goahttp.Doer
 
+	// SimulateSpendRule Doer is the HTTP client used to make requests to the
+	// simulateSpendRule endpoint.
+	SimulateSpendRuleDoer goahttp.Doer
+
 	// ListSpendRuleEvents Doer is the HTTP client used to make requests to the
 	// listSpendRuleEvents endpoint.
 	ListSpendRuleEventsDoer goahttp.Doer
@@ -53,6 +57,10 @@ type Client struct {
 	// listActorAttributes endpoint.
 	ListActorAttributesDoer goahttp.Doer
 
+	// ListUsageDimensions Doer is the HTTP client used to make requests to the
+	// listUsageDimensions endpoint.
+	ListUsageDimensionsDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -79,9 +87,11 @@ func NewClient(
 		UpdateSpendRuleDoer:       doer,
 		ArchiveSpendRuleDoer:      doer,
 		PreviewSpendRuleDoer:      doer,
+		SimulateSpendRuleDoer:     doer,
 		ListSpendRuleEventsDoer:   doer,
 		GetSpendRulesOverviewDoer: doer,
 		ListActorAttributesDoer:   doer,
+		ListUsageDimensionsDoer:   doer,
 		RestoreResponseBody:       restoreBody,
 		scheme:                    scheme,
 		host:                      host,
@@ -234,6 +244,30 @@ func (c *Client) PreviewSpendRule() goa.Endpoint {
 	}
 }
 
+// SimulateSpendRule returns an endpoint that makes HTTP requests to the
+// spendRules service simulateSpendRule server.
+func (c *Client) SimulateSpendRule() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSimulateSpendRuleRequest(c.encoder)
+		decodeResponse = DecodeSimulateSpendRuleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSimulateSpendRuleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SimulateSpendRuleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("spendRules", "simulateSpendRule", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ListSpendRuleEvents returns an endpoint that makes HTTP requests to the
 // spendRules service listSpendRuleEvents server.
 func (c *Client) ListSpendRuleEvents() goa.Endpoint {
@@ -305,3 +339,27 @@ func (c *Client) ListActorAttributes() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// ListUsageDimensions returns an endpoint that makes HTTP requests to the
+// spendRules service listUsageDimensions server.
+func (c *Client) ListUsageDimensions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListUsageDimensionsRequest(c.encoder)
+		decodeResponse = DecodeListUsageDimensionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListUsageDimensionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListUsageDimensionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("spendRules", "listUsageDimensions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
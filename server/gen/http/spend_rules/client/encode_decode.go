@@ -1454,6 +1454,248 @@ func DecodePreviewSpendRuleResponse(decoder func(*http.Response) goahttp.Decoder
 	}
 }
 
+// BuildSimulateSpendRuleRequest instantiates a HTTP request object with method
+// and path set to call the "spendRules" service "simulateSpendRule" endpoint
+func (c *Client) BuildSimulateSpendRuleRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SimulateSpendRuleSpendRulesPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("spendRules", "simulateSpendRule", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSimulateSpendRuleRequest returns an encoder for requests sent to the
+// spendRules simulateSpendRule server.
+func EncodeSimulateSpendRuleRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*spendrules.SimulateSpendRulePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("spendRules", "simulateSpendRule", "*spendrules.SimulateSpendRulePayload", v)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		if p.ProjectSlugInput != nil {
+			head := *p.ProjectSlugInput
+			req.Header.Set("Gram-Project", head)
+		}
+		body := NewSimulateSpendRuleRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("spendRules", "simulateSpendRule", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSimulateSpendRuleResponse returns a decoder for responses returned by
+// the spendRules simulateSpendRule endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeSimulateSpendRuleResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeSimulateSpendRuleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SimulateSpendRuleResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "simulateSpendRule", err)
+			}
+			err = ValidateSimulateSpendRuleResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "simulateSpendRule", err)
+			}
+			res := NewSimulateSpendRuleResultOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body SimulateSpendRuleUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "simulateSpendRule", err)
+			}
+			err = ValidateSimulateSpendRuleUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "simulateSpendRule", err)
+			}
+			return nil, NewSimulateSpendRuleUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body SimulateSpendRuleForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "simulateSpendRule", err)
+			}
+			err = ValidateSimulateSpendRuleForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "simulateSpendRule", err)
+			}
+			return nil, NewSimulateSpendRuleForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body SimulateSpendRuleBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "simulateSpendRule", err)
+			}
+			err = ValidateSimulateSpendRuleBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "simulateSpendRule", err)
+			}
+			return nil, NewSimulateSpendRuleBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body SimulateSpendRuleNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "simulateSpendRule", err)
+			}
+			err = ValidateSimulateSpendRuleNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "simulateSpendRule", err)
+			}
+			return nil, NewSimulateSpendRuleNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body SimulateSpendRuleConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "simulateSpendRule", err)
+			}
+			err = ValidateSimulateSpendRuleConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "simulateSpendRule", err)
+			}
+			return nil, NewSimulateSpendRuleConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body SimulateSpendRuleUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "simulateSpendRule", err)
+			}
+			err = ValidateSimulateSpendRuleUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "simulateSpendRule", err)
+			}
+			return nil, NewSimulateSpendRuleUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body SimulateSpendRuleInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "simulateSpendRule", err)
+			}
+			err = ValidateSimulateSpendRuleInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "simulateSpendRule", err)
+			}
+			return nil, NewSimulateSpendRuleInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body SimulateSpendRuleInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("spendRules", "simulateSpendRule", err)
+				}
+				err = ValidateSimulateSpendRuleInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("spendRules", "simulateSpendRule", err)
+				}
+				return nil, NewSimulateSpendRuleInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body SimulateSpendRuleUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("spendRules", "simulateSpendRule", err)
+				}
+				err = ValidateSimulateSpendRuleUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("spendRules", "simulateSpendRule", err)
+				}
+				return nil, NewSimulateSpendRuleUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("spendRules", "simulateSpendRule", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body SimulateSpendRuleGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "simulateSpendRule", err)
+			}
+			err = ValidateSimulateSpendRuleGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "simulateSpendRule", err)
+			}
+			return nil, NewSimulateSpendRuleGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("spendRules", "simulateSpendRule", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildListSpendRuleEventsRequest instantiates a HTTP request object with
 // method and path set to call the "spendRules" service "listSpendRuleEvents"
 // endpoint
@@ -2185,6 +2427,245 @@ func DecodeListActorAttributesResponse(decoder func(*http.Response) goahttp.Deco
 	}
 }
 
+// BuildListUsageDimensionsRequest instantiates a HTTP request object with
+// method and path set to call the "spendRules" service "listUsageDimensions"
+// endpoint
+func (c *Client) BuildListUsageDimensionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListUsageDimensionsSpendRulesPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("spendRules", "listUsageDimensions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListUsageDimensionsRequest returns an encoder for requests sent to the
+// spendRules listUsageDimensions server.
+func EncodeListUsageDimensionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*spendrules.ListUsageDimensionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("spendRules", "listUsageDimensions", "*spendrules.ListUsageDimensionsPayload", v)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		if p.ProjectSlugInput != nil {
+			head := *p.ProjectSlugInput
+			req.Header.Set("Gram-Project", head)
+		}
+		return nil
+	}
+}
+
+// DecodeListUsageDimensionsResponse returns a decoder for responses returned
+// by the spendRules listUsageDimensions endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListUsageDimensionsResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeListUsageDimensionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListUsageDimensionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "listUsageDimensions", err)
+			}
+			err = ValidateListUsageDimensionsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "listUsageDimensions", err)
+			}
+			res := NewListUsageDimensionsResultOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListUsageDimensionsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "listUsageDimensions", err)
+			}
+			err = ValidateListUsageDimensionsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "listUsageDimensions", err)
+			}
+			return nil, NewListUsageDimensionsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListUsageDimensionsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "listUsageDimensions", err)
+			}
+			err = ValidateListUsageDimensionsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "listUsageDimensions", err)
+			}
+			return nil, NewListUsageDimensionsForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListUsageDimensionsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "listUsageDimensions", err)
+			}
+			err = ValidateListUsageDimensionsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "listUsageDimensions", err)
+			}
+			return nil, NewListUsageDimensionsBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body ListUsageDimensionsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "listUsageDimensions", err)
+			}
+			err = ValidateListUsageDimensionsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "listUsageDimensions", err)
+			}
+			return nil, NewListUsageDimensionsNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body ListUsageDimensionsConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "listUsageDimensions", err)
+			}
+			err = ValidateListUsageDimensionsConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "listUsageDimensions", err)
+			}
+			return nil, NewListUsageDimensionsConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body ListUsageDimensionsUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "listUsageDimensions", err)
+			}
+			err = ValidateListUsageDimensionsUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "listUsageDimensions", err)
+			}
+			return nil, NewListUsageDimensionsUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body ListUsageDimensionsInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "listUsageDimensions", err)
+			}
+			err = ValidateListUsageDimensionsInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "listUsageDimensions", err)
+			}
+			return nil, NewListUsageDimensionsInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body ListUsageDimensionsInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("spendRules", "listUsageDimensions", err)
+				}
+				err = ValidateListUsageDimensionsInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("spendRules", "listUsageDimensions", err)
+				}
+				return nil, NewListUsageDimensionsInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body ListUsageDimensionsUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("spendRules", "listUsageDimensions", err)
+				}
+				err = ValidateListUsageDimensionsUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("spendRules", "listUsageDimensions", err)
+				}
+				return nil, NewListUsageDimensionsUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("spendRules", "listUsageDimensions", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body ListUsageDimensionsGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("spendRules", "listUsageDimensions", err)
+			}
+			err = ValidateListUsageDimensionsGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("spendRules", "listUsageDimensions", err)
+			}
+			return nil, NewListUsageDimensionsGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("spendRules", "listUsageDimensions", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionRequestBody
 // builds a value of type *SpendRuleTargetConditionRequestBody from a value of
 // type *types.SpendRuleTargetCondition.
@@ -2198,6 +2679,22 @@ func marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionRequestBody(v
 	return res
 }
 
+// marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionRequestBody
+// builds a value of type *SpendRuleScopeConditionRequestBody from a value of
+// type *types.SpendRuleScopeCondition.
+func marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionRequestBody(v *types.SpendRuleScopeCondition) *SpendRuleScopeConditionRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &SpendRuleScopeConditionRequestBody{
+		Dimension: v.Dimension,
+		Operator:  v.Operator,
+		Value:     v.Value,
+	}
+
+	return res
+}
+
 // marshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition
 // builds a value of type *types.SpendRuleTargetCondition from a value of type
 // *SpendRuleTargetConditionRequestBody.
@@ -2211,6 +2708,22 @@ func marshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition(v
 	return res
 }
 
+// marshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition
+// builds a value of type *types.SpendRuleScopeCondition from a value of type
+// *SpendRuleScopeConditionRequestBody.
+func marshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition(v *SpendRuleScopeConditionRequestBody) *types.SpendRuleScopeCondition {
+	if v == nil {
+		return nil
+	}
+	res := &types.SpendRuleScopeCondition{
+		Dimension: v.Dimension,
+		Operator:  v.Operator,
+		Value:     v.Value,
+	}
+
+	return res
+}
+
 // unmarshalSpendRuleTargetConditionResponseBodyToTypesSpendRuleTargetCondition
 // builds a value of type *types.SpendRuleTargetCondition from a value of type
 // *SpendRuleTargetConditionResponseBody.
@@ -2224,6 +2737,22 @@ func unmarshalSpendRuleTargetConditionResponseBodyToTypesSpendRuleTargetConditio
 	return res
 }
 
+// unmarshalSpendRuleScopeConditionResponseBodyToTypesSpendRuleScopeCondition
+// builds a value of type *types.SpendRuleScopeCondition from a value of type
+// *SpendRuleScopeConditionResponseBody.
+func unmarshalSpendRuleScopeConditionResponseBodyToTypesSpendRuleScopeCondition(v *SpendRuleScopeConditionResponseBody) *types.SpendRuleScopeCondition {
+	if v == nil {
+		return nil
+	}
+	res := &types.SpendRuleScopeCondition{
+		Dimension: *v.Dimension,
+		Operator:  *v.Operator,
+		Value:     *v.Value,
+	}
+
+	return res
+}
+
 // unmarshalSpendRuleResponseBodyToTypesSpendRule builds a value of type
 // *types.SpendRule from a value of type *SpendRuleResponseBody.
 func unmarshalSpendRuleResponseBodyToTypesSpendRule(v *SpendRuleResponseBody) *types.SpendRule {
@@ -2235,6 +2764,7 @@ func unmarshalSpendRuleResponseBodyToTypesSpendRule(v *SpendRuleResponseBody) *t
 		Slug:           *v.Slug,
 		Description:    *v.Description,
 		TargetExpr:     *v.TargetExpr,
+		ScopeExpr:      *v.ScopeExpr,
 		RuleExpr:       *v.RuleExpr,
 		LimitUsd:       *v.LimitUsd,
 		WindowKind:     *v.WindowKind,
@@ -2246,6 +2776,9 @@ func unmarshalSpendRuleResponseBodyToTypesSpendRule(v *SpendRuleResponseBody) *t
 		UpdatedAt:      *v.UpdatedAt,
 	}
 	res.Target = unmarshalSpendRuleTargetConditionResponseBodyToTypesSpendRuleTargetCondition(v.Target)
+	if v.Scope != nil {
+		res.Scope = unmarshalSpendRuleScopeConditionResponseBodyToTypesSpendRuleScopeCondition(v.Scope)
+	}
 
 	return res
 }
@@ -2267,6 +2800,62 @@ func unmarshalSpendRuleActorUsageResponseBodyToSpendrulesSpendRuleActorUsage(v *
 	return res
 }
 
+// unmarshalSpendRuleSimulationWindowResponseBodyToSpendrulesSpendRuleSimulationWindow
+// builds a value of type *spendrules.SpendRuleSimulationWindow from a value of
+// type *SpendRuleSimulationWindowResponseBody.
+func unmarshalSpendRuleSimulationWindowResponseBodyToSpendrulesSpendRuleSimulationWindow(v *SpendRuleSimulationWindowResponseBody) *spendrules.SpendRuleSimulationWindow {
+	res := &spendrules.SpendRuleSimulationWindow{
+		WindowStart:   *v.WindowStart,
+		WindowEnd:     *v.WindowEnd,
+		InProgress:    *v.InProgress,
+		SpendUsd:      *v.SpendUsd,
+		UsersWarned:   *v.UsersWarned,
+		UsersBreached: *v.UsersBreached,
+	}
+
+	return res
+}
+
+// unmarshalSpendRuleSimulationActorResponseBodyToSpendrulesSpendRuleSimulationActor
+// builds a value of type *spendrules.SpendRuleSimulationActor from a value of
+// type *SpendRuleSimulationActorResponseBody.
+func unmarshalSpendRuleSimulationActorResponseBodyToSpendrulesSpendRuleSimulationActor(v *SpendRuleSimulationActorResponseBody) *spendrules.SpendRuleSimulationActor {
+	res := &spendrules.SpendRuleSimulationActor{
+		Email:           *v.Email,
+		DisplayName:     v.DisplayName,
+		UserID:          v.UserID,
+		WindowsWarned:   *v.WindowsWarned,
+		WindowsBreached: *v.WindowsBreached,
+	}
+	res.Timeline = make([]*spendrules.SpendRuleSimulationActorWindow, len(v.Timeline))
+	for i, val := range v.Timeline {
+		if val == nil {
+			res.Timeline[i] = nil
+			continue
+		}
+		res.Timeline[i] = unmarshalSpendRuleSimulationActorWindowResponseBodyToSpendrulesSpendRuleSimulationActorWindow(val)
+	}
+
+	return res
+}
+
+// unmarshalSpendRuleSimulationActorWindowResponseBodyToSpendrulesSpendRuleSimulationActorWindow
+// builds a value of type *spendrules.SpendRuleSimulationActorWindow from a
+// value of type *SpendRuleSimulationActorWindowResponseBody.
+func unmarshalSpendRuleSimulationActorWindowResponseBodyToSpendrulesSpendRuleSimulationActorWindow(v *SpendRuleSimulationActorWindowResponseBody) *spendrules.SpendRuleSimulationActorWindow {
+	res := &spendrules.SpendRuleSimulationActorWindow{
+		WindowStart: *v.WindowStart,
+		WindowEnd:   *v.WindowEnd,
+		SpendUsd:    *v.SpendUsd,
+		UsedPct:     *v.UsedPct,
+		WarnedAt:    v.WarnedAt,
+		BreachedAt:  v.BreachedAt,
+		Blocked:     *v.Blocked,
+	}
+
+	return res
+}
+
 // unmarshalSpendRuleEventResponseBodyToSpendrulesSpendRuleEvent builds a value
 // of type *spendrules.SpendRuleEvent from a value of type
 // *SpendRuleEventResponseBody.
@@ -2320,3 +2909,15 @@ func unmarshalActorAttributeResponseBodyToSpendrulesActorAttribute(v *ActorAttri
 
 	return res
 }
+
+// unmarshalUsageDimensionResponseBodyToSpendrulesUsageDimension builds a value
+// of type *spendrules.UsageDimension from a value of type
+// *UsageDimensionResponseBody.
+func unmarshalUsageDimensionResponseBodyToSpendrulesUsageDimension(v *UsageDimensionResponseBody) *spendrules.UsageDimension {
+	res := &spendrules.UsageDimension{
+		Name:        *v.Name,
+		Description: *v.Description,
+	}
+
+	return res
+}
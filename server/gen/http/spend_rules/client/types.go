@@ -22,6 +22,9 @@ type CreateSpendRuleRequestBody struct {
 	Description string `form:"description" json:"description" xml:"description"`
 	// Structured member-attribute condition selecting who the rule applies to.
 	Target *SpendRuleTargetConditionRequestBody `form:"target" json:"target" xml:"target"`
+	// Optional usage-dimension condition selecting which spend counts toward the
+	// limit. Omit to count all spend.
+	Scope *SpendRuleScopeConditionRequestBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
 	// Per-person budget in USD for one window.
 	LimitUsd float64 `form:"limit_usd" json:"limit_usd" xml:"limit_usd"`
 	// UTC calendar window the budget covers.
@@ -46,6 +49,12 @@ type UpdateSpendRuleRequestBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	// Structured member-attribute condition. Omit to preserve the current target.
 	Target *SpendRuleTargetConditionRequestBody `form:"target,omitempty" json:"target,omitempty" xml:"target,omitempty"`
+	// Usage-dimension condition selecting which spend counts toward the limit.
+	// Omit to preserve the current scope.
+	Scope *SpendRuleScopeConditionRequestBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Remove the rule's scope so all spend counts toward the limit. Ignored when
+	// scope is supplied.
+	ClearScope *bool `form:"clear_scope,omitempty" json:"clear_scope,omitempty" xml:"clear_scope,omitempty"`
 	// Per-person budget in USD for one window. Omit to preserve the current limit.
 	LimitUsd *float64 `form:"limit_usd,omitempty" json:"limit_usd,omitempty" xml:"limit_usd,omitempty"`
 	// UTC calendar window the budget covers. Omit to preserve the current window.
@@ -71,6 +80,9 @@ type ArchiveSpendRuleRequestBody struct {
 type PreviewSpendRuleRequestBody struct {
 	// Structured member-attribute condition to preview.
 	Target *SpendRuleTargetConditionRequestBody `form:"target" json:"target" xml:"target"`
+	// Optional usage-dimension condition selecting which spend counts toward the
+	// limit.
+	Scope *SpendRuleScopeConditionRequestBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
 	// Per-person budget in USD used to compute usage percentages.
 	LimitUsd float64 `form:"limit_usd" json:"limit_usd" xml:"limit_usd"`
 	// Percentage of the limit at which a warning event is emitted.
@@ -79,6 +91,28 @@ type PreviewSpendRuleRequestBody struct {
 	WindowKind string `form:"window_kind" json:"window_kind" xml:"window_kind"`
 }
 
+// SimulateSpendRuleRequestBody is the type of the "spendRules" service
+// "simulateSpendRule" endpoint HTTP request body.
+type SimulateSpendRuleRequestBody struct {
+	// Structured member-attribute condition to simulate.
+	Target *SpendRuleTargetConditionRequestBody `form:"target" json:"target" xml:"target"`
+	// Optional usage-dimension condition selecting which spend counts toward the
+	// limit.
+	Scope *SpendRuleScopeConditionRequestBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Per-person budget in USD for one window.
+	LimitUsd float64 `form:"limit_usd" json:"limit_usd" xml:"limit_usd"`
+	// Percentage of the limit at which a warning event is emitted.
+	WarnAtPct int `form:"warn_at_pct" json:"warn_at_pct" xml:"warn_at_pct"`
+	// UTC calendar window the budget covers.
+	WindowKind string `form:"window_kind" json:"window_kind" xml:"window_kind"`
+	// Rule action to simulate: flag (record events only) or block (deny agent
+	// traffic on breach).
+	Action string `form:"action" json:"action" xml:"action"`
+	// Number of most recent windows to replay, including the current in-progress
+	// window.
+	Windows int `form:"windows" json:"windows" xml:"windows"`
+}
+
 // CreateSpendRuleResponseBody is the type of the "spendRules" service
 // "createSpendRule" endpoint HTTP response body.
 type CreateSpendRuleResponseBody struct {
@@ -102,6 +136,12 @@ type CreateSpendRuleResponseBody struct {
 	// CEL boolean expression over member attributes (email, directory attributes,
 	// groups, roles) selecting who the rule applies to.
 	TargetExpr *string `form:"target_expr,omitempty" json:"target_expr,omitempty" xml:"target_expr,omitempty"`
+	// Structured usage-dimension condition selecting which spend counts toward the
+	// limit. Absent when all spend counts.
+	Scope *SpendRuleScopeConditionResponseBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// CEL boolean expression over usage dimensions (model, model_family,
+	// assistant_id, project_id, toolset_slug). Empty when all spend counts.
+	ScopeExpr *string `form:"scope_expr,omitempty" json:"scope_expr,omitempty" xml:"scope_expr,omitempty"`
 	// CEL boolean expression over actor usage that identifies a budget breach for
 	// matched members.
 	RuleExpr *string `form:"rule_expr,omitempty" json:"rule_expr,omitempty" xml:"rule_expr,omitempty"`
@@ -155,6 +195,12 @@ type GetSpendRuleResponseBody struct {
 	// CEL boolean expression over member attributes (email, directory attributes,
 	// groups, roles) selecting who the rule applies to.
 	TargetExpr *string `form:"target_expr,omitempty" json:"target_expr,omitempty" xml:"target_expr,omitempty"`
+	// Structured usage-dimension condition selecting which spend counts toward the
+	// limit. Absent when all spend counts.
+	Scope *SpendRuleScopeConditionResponseBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// CEL boolean expression over usage dimensions (model, model_family,
+	// assistant_id, project_id, toolset_slug). Empty when all spend counts.
+	ScopeExpr *string `form:"scope_expr,omitempty" json:"scope_expr,omitempty" xml:"scope_expr,omitempty"`
 	// CEL boolean expression over actor usage that identifies a budget breach for
 	// matched members.
 	RuleExpr *string `form:"rule_expr,omitempty" json:"rule_expr,omitempty" xml:"rule_expr,omitempty"`
@@ -201,6 +247,12 @@ type UpdateSpendRuleResponseBody struct {
 	// CEL boolean expression over member attributes (email, directory attributes,
 	// groups, roles) selecting who the rule applies to.
 	TargetExpr *string `form:"target_expr,omitempty" json:"target_expr,omitempty" xml:"target_expr,omitempty"`
+	// Structured usage-dimension condition selecting which spend counts toward the
+	// limit. Absent when all spend counts.
+	Scope *SpendRuleScopeConditionResponseBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// CEL boolean expression over usage dimensions (model, model_family,
+	// assistant_id, project_id, toolset_slug). Empty when all spend counts.
+	ScopeExpr *string `form:"scope_expr,omitempty" json:"scope_expr,omitempty" xml:"scope_expr,omitempty"`
 	// CEL boolean expression over actor usage that identifies a budget breach for
 	// matched members.
 	RuleExpr *string `form:"rule_expr,omitempty" json:"rule_expr,omitempty" xml:"rule_expr,omitempty"`
@@ -238,6 +290,21 @@ type PreviewSpendRuleResponseBody struct {
 	Actors []*SpendRuleActorUsageResponseBody `form:"actors,omitempty" json:"actors,omitempty" xml:"actors,omitempty"`
 }
 
+// SimulateSpendRuleResponseBody is the type of the "spendRules" service
+// "simulateSpendRule" endpoint HTTP response body.
+type SimulateSpendRuleResponseBody struct {
+	// Total number of organization members the target expression matches.
+	MatchedCount *int `form:"matched_count,omitempty" json:"matched_count,omitempty" xml:"matched_count,omitempty"`
+	// The simulated rule action.
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+	// Replayed windows, oldest first. The last window is the current, in-progress
+	// one.
+	Windows []*SpendRuleSimulationWindowResponseBody `form:"windows,omitempty" json:"windows,omitempty" xml:"windows,omitempty"`
+	// Matched actors who would have reached the warning threshold in at least one
+	// window, most breached windows first. Capped at 50 entries.
+	Actors []*SpendRuleSimulationActorResponseBody `form:"actors,omitempty" json:"actors,omitempty" xml:"actors,omitempty"`
+}
+
 // ListSpendRuleEventsResponseBody is the type of the "spendRules" service
 // "listSpendRuleEvents" endpoint HTTP response body.
 type ListSpendRuleEventsResponseBody struct {
@@ -279,6 +346,13 @@ type ListActorAttributesResponseBody struct {
 	Attributes []*ActorAttributeResponseBody `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
 }
 
+// ListUsageDimensionsResponseBody is the type of the "spendRules" service
+// "listUsageDimensions" endpoint HTTP response body.
+type ListUsageDimensionsResponseBody struct {
+	// The usage dimensions available to scope conditions, in editor display order.
+	Dimensions []*UsageDimensionResponseBody `form:"dimensions,omitempty" json:"dimensions,omitempty" xml:"dimensions,omitempty"`
+}
+
 // CreateSpendRuleUnauthorizedResponseBody is the type of the "spendRules"
 // service "createSpendRule" endpoint HTTP response body for the "unauthorized"
 // error.
@@ -1391,6 +1465,195 @@ type PreviewSpendRuleGatewayErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// SimulateSpendRuleUnauthorizedResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the
+// "unauthorized" error.
+type SimulateSpendRuleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SimulateSpendRuleForbiddenResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the "forbidden"
+// error.
+type SimulateSpendRuleForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SimulateSpendRuleBadRequestResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the
+// "bad_request" error.
+type SimulateSpendRuleBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SimulateSpendRuleNotFoundResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the "not_found"
+// error.
+type SimulateSpendRuleNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SimulateSpendRuleConflictResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the "conflict"
+// error.
+type SimulateSpendRuleConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SimulateSpendRuleUnsupportedMediaResponseBody is the type of the
+// "spendRules" service "simulateSpendRule" endpoint HTTP response body for the
+// "unsupported_media" error.
+type SimulateSpendRuleUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SimulateSpendRuleInvalidResponseBody is the type of the "spendRules" service
+// "simulateSpendRule" endpoint HTTP response body for the "invalid" error.
+type SimulateSpendRuleInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SimulateSpendRuleInvariantViolationResponseBody is the type of the
+// "spendRules" service "simulateSpendRule" endpoint HTTP response body for the
+// "invariant_violation" error.
+type SimulateSpendRuleInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SimulateSpendRuleUnexpectedResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the "unexpected"
+// error.
+type SimulateSpendRuleUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SimulateSpendRuleGatewayErrorResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the
+// "gateway_error" error.
+type SimulateSpendRuleGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // ListSpendRuleEventsUnauthorizedResponseBody is the type of the "spendRules"
 // service "listSpendRuleEvents" endpoint HTTP response body for the
 // "unauthorized" error.
@@ -1961,52 +2224,274 @@ type ListActorAttributesGatewayErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// SpendRuleTargetConditionRequestBody is used to define fields on request body
-// types.
-type SpendRuleTargetConditionRequestBody struct {
-	// Member attribute name.
-	Attribute string `form:"attribute" json:"attribute" xml:"attribute"`
-	// Comparison operator: equals, not_equals, starts_with, ends_with, contains,
-	// matches, or includes.
-	Operator string `form:"operator" json:"operator" xml:"operator"`
-	// Comparison value.
-	Value string `form:"value" json:"value" xml:"value"`
+// ListUsageDimensionsUnauthorizedResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListUsageDimensionsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// SpendRuleTargetConditionResponseBody is used to define fields on response
-// body types.
-type SpendRuleTargetConditionResponseBody struct {
-	// Member attribute name.
-	Attribute *string `form:"attribute,omitempty" json:"attribute,omitempty" xml:"attribute,omitempty"`
-	// Comparison operator: equals, not_equals, starts_with, ends_with, contains,
-	// matches, or includes.
-	Operator *string `form:"operator,omitempty" json:"operator,omitempty" xml:"operator,omitempty"`
-	// Comparison value.
-	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+// ListUsageDimensionsForbiddenResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "forbidden" error.
+type ListUsageDimensionsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// SpendRuleResponseBody is used to define fields on response body types.
-type SpendRuleResponseBody struct {
-	// The budget rule ID. Identifies one immutable version row: edits produce a
-	// successor row with a fresh ID.
+// ListUsageDimensionsBadRequestResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "bad_request" error.
+type ListUsageDimensionsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Versioned rule URN, e.g. spend_rule:eng-monthly-cap:v3. Pins the exact rule
-	// configuration that produced an event.
-	Urn *string `form:"urn,omitempty" json:"urn,omitempty" xml:"urn,omitempty"`
-	// The organization ID.
-	OrganizationID *string `form:"organization_id,omitempty" json:"organization_id,omitempty" xml:"organization_id,omitempty"`
-	// The rule name.
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUsageDimensionsNotFoundResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "not_found" error.
+type ListUsageDimensionsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// URL-safe identifier derived from the name at creation time. Unique per
-	// organization and immutable; the rule URN embeds it.
-	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
-	// Description of what the rule covers. Empty when unset.
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUsageDimensionsConflictResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the "conflict"
+// error.
+type ListUsageDimensionsConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUsageDimensionsUnsupportedMediaResponseBody is the type of the
+// "spendRules" service "listUsageDimensions" endpoint HTTP response body for
+// the "unsupported_media" error.
+type ListUsageDimensionsUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUsageDimensionsInvalidResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the "invalid"
+// error.
+type ListUsageDimensionsInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUsageDimensionsInvariantViolationResponseBody is the type of the
+// "spendRules" service "listUsageDimensions" endpoint HTTP response body for
+// the "invariant_violation" error.
+type ListUsageDimensionsInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUsageDimensionsUnexpectedResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "unexpected" error.
+type ListUsageDimensionsUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListUsageDimensionsGatewayErrorResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "gateway_error" error.
+type ListUsageDimensionsGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SpendRuleTargetConditionRequestBody is used to define fields on request body
+// types.
+type SpendRuleTargetConditionRequestBody struct {
+	// Member attribute name.
+	Attribute string `form:"attribute" json:"attribute" xml:"attribute"`
+	// Comparison operator: equals, not_equals, starts_with, ends_with, contains,
+	// matches, or includes.
+	Operator string `form:"operator" json:"operator" xml:"operator"`
+	// Comparison value.
+	Value string `form:"value" json:"value" xml:"value"`
+}
+
+// SpendRuleScopeConditionRequestBody is used to define fields on request body
+// types.
+type SpendRuleScopeConditionRequestBody struct {
+	// Usage dimension name: model, model_family, assistant_id, project_id, or
+	// toolset_slug.
+	Dimension string `form:"dimension" json:"dimension" xml:"dimension"`
+	// Comparison operator: equals, not_equals, starts_with, ends_with, contains,
+	// or matches.
+	Operator string `form:"operator" json:"operator" xml:"operator"`
+	// Comparison value.
+	Value string `form:"value" json:"value" xml:"value"`
+}
+
+// SpendRuleTargetConditionResponseBody is used to define fields on response
+// body types.
+type SpendRuleTargetConditionResponseBody struct {
+	// Member attribute name.
+	Attribute *string `form:"attribute,omitempty" json:"attribute,omitempty" xml:"attribute,omitempty"`
+	// Comparison operator: equals, not_equals, starts_with, ends_with, contains,
+	// matches, or includes.
+	Operator *string `form:"operator,omitempty" json:"operator,omitempty" xml:"operator,omitempty"`
+	// Comparison value.
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// SpendRuleScopeConditionResponseBody is used to define fields on response
+// body types.
+type SpendRuleScopeConditionResponseBody struct {
+	// Usage dimension name: model, model_family, assistant_id, project_id, or
+	// toolset_slug.
+	Dimension *string `form:"dimension,omitempty" json:"dimension,omitempty" xml:"dimension,omitempty"`
+	// Comparison operator: equals, not_equals, starts_with, ends_with, contains,
+	// or matches.
+	Operator *string `form:"operator,omitempty" json:"operator,omitempty" xml:"operator,omitempty"`
+	// Comparison value.
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// SpendRuleResponseBody is used to define fields on response body types.
+type SpendRuleResponseBody struct {
+	// The budget rule ID. Identifies one immutable version row: edits produce a
+	// successor row with a fresh ID.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Versioned rule URN, e.g. spend_rule:eng-monthly-cap:v3. Pins the exact rule
+	// configuration that produced an event.
+	Urn *string `form:"urn,omitempty" json:"urn,omitempty" xml:"urn,omitempty"`
+	// The organization ID.
+	OrganizationID *string `form:"organization_id,omitempty" json:"organization_id,omitempty" xml:"organization_id,omitempty"`
+	// The rule name.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// URL-safe identifier derived from the name at creation time. Unique per
+	// organization and immutable; the rule URN embeds it.
+	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
+	// Description of what the rule covers. Empty when unset.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	// Structured member-attribute condition selecting who the rule applies to.
 	Target *SpendRuleTargetConditionResponseBody `form:"target,omitempty" json:"target,omitempty" xml:"target,omitempty"`
 	// CEL boolean expression over member attributes (email, directory attributes,
 	// groups, roles) selecting who the rule applies to.
 	TargetExpr *string `form:"target_expr,omitempty" json:"target_expr,omitempty" xml:"target_expr,omitempty"`
+	// Structured usage-dimension condition selecting which spend counts toward the
+	// limit. Absent when all spend counts.
+	Scope *SpendRuleScopeConditionResponseBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// CEL boolean expression over usage dimensions (model, model_family,
+	// assistant_id, project_id, toolset_slug). Empty when all spend counts.
+	ScopeExpr *string `form:"scope_expr,omitempty" json:"scope_expr,omitempty" xml:"scope_expr,omitempty"`
 	// CEL boolean expression over actor usage that identifies a budget breach for
 	// matched members.
 	RuleExpr *string `form:"rule_expr,omitempty" json:"rule_expr,omitempty" xml:"rule_expr,omitempty"`
@@ -2049,6 +2534,62 @@ type SpendRuleActorUsageResponseBody struct {
 	Breached *bool `form:"breached,omitempty" json:"breached,omitempty" xml:"breached,omitempty"`
 }
 
+// SpendRuleSimulationWindowResponseBody is used to define fields on response
+// body types.
+type SpendRuleSimulationWindowResponseBody struct {
+	// Inclusive start of the window.
+	WindowStart *string `form:"window_start,omitempty" json:"window_start,omitempty" xml:"window_start,omitempty"`
+	// Exclusive end of the window.
+	WindowEnd *string `form:"window_end,omitempty" json:"window_end,omitempty" xml:"window_end,omitempty"`
+	// Whether the window contains the current time, so its spend is partial.
+	InProgress *bool `form:"in_progress,omitempty" json:"in_progress,omitempty" xml:"in_progress,omitempty"`
+	// Total spend in USD across matched users within the window.
+	SpendUsd *float64 `form:"spend_usd,omitempty" json:"spend_usd,omitempty" xml:"spend_usd,omitempty"`
+	// Matched users who would have reached the warning threshold within the window.
+	UsersWarned *int `form:"users_warned,omitempty" json:"users_warned,omitempty" xml:"users_warned,omitempty"`
+	// Matched users who would have breached the rule within the window.
+	UsersBreached *int `form:"users_breached,omitempty" json:"users_breached,omitempty" xml:"users_breached,omitempty"`
+}
+
+// SpendRuleSimulationActorResponseBody is used to define fields on response
+// body types.
+type SpendRuleSimulationActorResponseBody struct {
+	// Actor email.
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Actor display name, when known.
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Gram user ID of the actor, when linked.
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Number of simulated windows in which the actor reached the warning threshold.
+	WindowsWarned *int `form:"windows_warned,omitempty" json:"windows_warned,omitempty" xml:"windows_warned,omitempty"`
+	// Number of simulated windows in which the actor breached the rule.
+	WindowsBreached *int `form:"windows_breached,omitempty" json:"windows_breached,omitempty" xml:"windows_breached,omitempty"`
+	// Per-window outcome, oldest window first.
+	Timeline []*SpendRuleSimulationActorWindowResponseBody `form:"timeline,omitempty" json:"timeline,omitempty" xml:"timeline,omitempty"`
+}
+
+// SpendRuleSimulationActorWindowResponseBody is used to define fields on
+// response body types.
+type SpendRuleSimulationActorWindowResponseBody struct {
+	// Inclusive start of the window.
+	WindowStart *string `form:"window_start,omitempty" json:"window_start,omitempty" xml:"window_start,omitempty"`
+	// Exclusive end of the window.
+	WindowEnd *string `form:"window_end,omitempty" json:"window_end,omitempty" xml:"window_end,omitempty"`
+	// Actor spend in USD within the window.
+	SpendUsd *float64 `form:"spend_usd,omitempty" json:"spend_usd,omitempty" xml:"spend_usd,omitempty"`
+	// Window spend as a percentage of the limit (may exceed 100).
+	UsedPct *float64 `form:"used_pct,omitempty" json:"used_pct,omitempty" xml:"used_pct,omitempty"`
+	// Start of the spend bucket in which the actor first reached the warning
+	// threshold. Absent when never reached.
+	WarnedAt *string `form:"warned_at,omitempty" json:"warned_at,omitempty" xml:"warned_at,omitempty"`
+	// Start of the spend bucket in which the actor first breached the rule. Absent
+	// when never breached.
+	BreachedAt *string `form:"breached_at,omitempty" json:"breached_at,omitempty" xml:"breached_at,omitempty"`
+	// Whether a block rule would have denied the actor's agent traffic from
+	// breached_at until the window reset.
+	Blocked *bool `form:"blocked,omitempty" json:"blocked,omitempty" xml:"blocked,omitempty"`
+}
+
 // SpendRuleEventResponseBody is used to define fields on response body types.
 type SpendRuleEventResponseBody struct {
 	// The event ID.
@@ -2109,6 +2650,14 @@ type ActorAttributeResponseBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 }
 
+// UsageDimensionResponseBody is used to define fields on response body types.
+type UsageDimensionResponseBody struct {
+	// Dimension name as used in scope conditions, e.g. model_family.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Human-readable description of the dimension.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
 // NewCreateSpendRuleRequestBody builds the HTTP request body from the payload
 // of the "createSpendRule" endpoint of the "spendRules" service.
 func NewCreateSpendRuleRequestBody(p *spendrules.CreateSpendRulePayload) *CreateSpendRuleRequestBody {
@@ -2130,6 +2679,9 @@ func NewCreateSpendRuleRequestBody(p *spendrules.CreateSpendRulePayload) *Create
 	if p.Target != nil {
 		body.Target = marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionRequestBody(p.Target)
 	}
+	if p.Scope != nil {
+		body.Scope = marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionRequestBody(p.Scope)
+	}
 	{
 		var zero int
 		if body.WarnAtPct == zero {
@@ -2158,6 +2710,7 @@ func NewUpdateSpendRuleRequestBody(p *spendrules.UpdateSpendRulePayload) *Update
 		ID:          p.ID,
 		Name:        p.Name,
 		Description: p.Description,
+		ClearScope:  p.ClearScope,
 		LimitUsd:    p.LimitUsd,
 		WindowKind:  p.WindowKind,
 		WarnAtPct:   p.WarnAtPct,
@@ -2167,6 +2720,9 @@ func NewUpdateSpendRuleRequestBody(p *spendrules.UpdateSpendRulePayload) *Update
 	if p.Target != nil {
 		body.Target = marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionRequestBody(p.Target)
 	}
+	if p.Scope != nil {
+		body.Scope = marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionRequestBody(p.Scope)
+	}
 	return body
 }
 
@@ -2190,12 +2746,52 @@ func NewPreviewSpendRuleRequestBody(p *spendrules.PreviewSpendRulePayload) *Prev
 	if p.Target != nil {
 		body.Target = marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionRequestBody(p.Target)
 	}
+	if p.Scope != nil {
+		body.Scope = marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionRequestBody(p.Scope)
+	}
+	{
+		var zero int
+		if body.WarnAtPct == zero {
+			body.WarnAtPct = 80
+		}
+	}
+	return body
+}
+
+// NewSimulateSpendRuleRequestBody builds the HTTP request body from the
+// payload of the "simulateSpendRule" endpoint of the "spendRules" service.
+func NewSimulateSpendRuleRequestBody(p *spendrules.SimulateSpendRulePayload) *SimulateSpendRuleRequestBody {
+	body := &SimulateSpendRuleRequestBody{
+		LimitUsd:   p.LimitUsd,
+		WarnAtPct:  p.WarnAtPct,
+		WindowKind: p.WindowKind,
+		Action:     p.Action,
+		Windows:    p.Windows,
+	}
+	if p.Target != nil {
+		body.Target = marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionRequestBody(p.Target)
+	}
+	if p.Scope != nil {
+		body.Scope = marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionRequestBody(p.Scope)
+	}
 	{
 		var zero int
 		if body.WarnAtPct == zero {
 			body.WarnAtPct = 80
 		}
 	}
+	{
+		var zero string
+		if body.Action == zero {
+			body.Action = "block"
+		}
+	}
+	{
+		var zero int
+		if body.Windows == zero {
+			body.Windows = 3
+		}
+	}
 	return body
 }
 
@@ -2210,6 +2806,7 @@ func NewCreateSpendRuleSpendRuleOK(body *CreateSpendRuleResponseBody) *types.Spe
 		Slug:           *body.Slug,
 		Description:    *body.Description,
 		TargetExpr:     *body.TargetExpr,
+		ScopeExpr:      *body.ScopeExpr,
 		RuleExpr:       *body.RuleExpr,
 		LimitUsd:       *body.LimitUsd,
 		WindowKind:     *body.WindowKind,
@@ -2221,6 +2818,9 @@ func NewCreateSpendRuleSpendRuleOK(body *CreateSpendRuleResponseBody) *types.Spe
 		UpdatedAt:      *body.UpdatedAt,
 	}
 	v.Target = unmarshalSpendRuleTargetConditionResponseBodyToTypesSpendRuleTargetCondition(body.Target)
+	if body.Scope != nil {
+		v.Scope = unmarshalSpendRuleScopeConditionResponseBodyToTypesSpendRuleScopeCondition(body.Scope)
+	}
 
 	return v
 }
@@ -2552,6 +3152,7 @@ func NewGetSpendRuleSpendRuleOK(body *GetSpendRuleResponseBody) *types.SpendRule
 		Slug:           *body.Slug,
 		Description:    *body.Description,
 		TargetExpr:     *body.TargetExpr,
+		ScopeExpr:      *body.ScopeExpr,
 		RuleExpr:       *body.RuleExpr,
 		LimitUsd:       *body.LimitUsd,
 		WindowKind:     *body.WindowKind,
@@ -2563,6 +3164,9 @@ func NewGetSpendRuleSpendRuleOK(body *GetSpendRuleResponseBody) *types.SpendRule
 		UpdatedAt:      *body.UpdatedAt,
 	}
 	v.Target = unmarshalSpendRuleTargetConditionResponseBodyToTypesSpendRuleTargetCondition(body.Target)
+	if body.Scope != nil {
+		v.Scope = unmarshalSpendRuleScopeConditionResponseBodyToTypesSpendRuleScopeCondition(body.Scope)
+	}
 
 	return v
 }
@@ -2728,6 +3332,7 @@ func NewUpdateSpendRuleSpendRuleOK(body *UpdateSpendRuleResponseBody) *types.Spe
 		Slug:           *body.Slug,
 		Description:    *body.Description,
 		TargetExpr:     *body.TargetExpr,
+		ScopeExpr:      *body.ScopeExpr,
 		RuleExpr:       *body.RuleExpr,
 		LimitUsd:       *body.LimitUsd,
 		WindowKind:     *body.WindowKind,
@@ -2739,6 +3344,9 @@ func NewUpdateSpendRuleSpendRuleOK(body *UpdateSpendRuleResponseBody) *types.Spe
 		UpdatedAt:      *body.UpdatedAt,
 	}
 	v.Target = unmarshalSpendRuleTargetConditionResponseBodyToTypesSpendRuleTargetCondition(body.Target)
+	if body.Scope != nil {
+		v.Scope = unmarshalSpendRuleScopeConditionResponseBodyToTypesSpendRuleScopeCondition(body.Scope)
+	}
 
 	return v
 }
@@ -3213,27 +3821,36 @@ func NewPreviewSpendRuleGatewayError(body *PreviewSpendRuleGatewayErrorResponseB
 	return v
 }
 
-// NewListSpendRuleEventsResultOK builds a "spendRules" service
-// "listSpendRuleEvents" endpoint result from a HTTP "OK" response.
-func NewListSpendRuleEventsResultOK(body *ListSpendRuleEventsResponseBody) *spendrules.ListSpendRuleEventsResult {
-	v := &spendrules.ListSpendRuleEventsResult{
-		NextCursor: body.NextCursor,
+// NewSimulateSpendRuleResultOK builds a "spendRules" service
+// "simulateSpendRule" endpoint result from a HTTP "OK" response.
+func NewSimulateSpendRuleResultOK(body *SimulateSpendRuleResponseBody) *spendrules.SimulateSpendRuleResult {
+	v := &spendrules.SimulateSpendRuleResult{
+		MatchedCount: *body.MatchedCount,
+		Action:       *body.Action,
 	}
-	v.Events = make([]*spendrules.SpendRuleEvent, len(body.Events))
-	for i, val := range body.Events {
+	v.Windows = make([]*spendrules.SpendRuleSimulationWindow, len(body.Windows))
+	for i, val := range body.Windows {
 		if val == nil {
-			v.Events[i] = nil
+			v.Windows[i] = nil
 			continue
 		}
-		v.Events[i] = unmarshalSpendRuleEventResponseBodyToSpendrulesSpendRuleEvent(val)
+		v.Windows[i] = unmarshalSpendRuleSimulationWindowResponseBodyToSpendrulesSpendRuleSimulationWindow(val)
+	}
+	v.Actors = make([]*spendrules.SpendRuleSimulationActor, len(body.Actors))
+	for i, val := range body.Actors {
+		if val == nil {
+			v.Actors[i] = nil
+			continue
+		}
+		v.Actors[i] = unmarshalSpendRuleSimulationActorResponseBodyToSpendrulesSpendRuleSimulationActor(val)
 	}
 
 	return v
 }
 
-// NewListSpendRuleEventsUnauthorized builds a spendRules service
-// listSpendRuleEvents endpoint unauthorized error.
-func NewListSpendRuleEventsUnauthorized(body *ListSpendRuleEventsUnauthorizedResponseBody) *goa.ServiceError {
+// NewSimulateSpendRuleUnauthorized builds a spendRules service
+// simulateSpendRule endpoint unauthorized error.
+func NewSimulateSpendRuleUnauthorized(body *SimulateSpendRuleUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -3246,9 +3863,9 @@ func NewListSpendRuleEventsUnauthorized(body *ListSpendRuleEventsUnauthorizedRes
 	return v
 }
 
-// NewListSpendRuleEventsForbidden builds a spendRules service
-// listSpendRuleEvents endpoint forbidden error.
-func NewListSpendRuleEventsForbidden(body *ListSpendRuleEventsForbiddenResponseBody) *goa.ServiceError {
+// NewSimulateSpendRuleForbidden builds a spendRules service simulateSpendRule
+// endpoint forbidden error.
+func NewSimulateSpendRuleForbidden(body *SimulateSpendRuleForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -3261,9 +3878,9 @@ func NewListSpendRuleEventsForbidden(body *ListSpendRuleEventsForbiddenResponseB
 	return v
 }
 
-// NewListSpendRuleEventsBadRequest builds a spendRules service
-// listSpendRuleEvents endpoint bad_request error.
-func NewListSpendRuleEventsBadRequest(body *ListSpendRuleEventsBadRequestResponseBody) *goa.ServiceError {
+// NewSimulateSpendRuleBadRequest builds a spendRules service simulateSpendRule
+// endpoint bad_request error.
+func NewSimulateSpendRuleBadRequest(body *SimulateSpendRuleBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -3276,9 +3893,9 @@ func NewListSpendRuleEventsBadRequest(body *ListSpendRuleEventsBadRequestRespons
 	return v
 }
 
-// NewListSpendRuleEventsNotFound builds a spendRules service
-// listSpendRuleEvents endpoint not_found error.
-func NewListSpendRuleEventsNotFound(body *ListSpendRuleEventsNotFoundResponseBody) *goa.ServiceError {
+// NewSimulateSpendRuleNotFound builds a spendRules service simulateSpendRule
+// endpoint not_found error.
+func NewSimulateSpendRuleNotFound(body *SimulateSpendRuleNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -3291,9 +3908,177 @@ func NewListSpendRuleEventsNotFound(body *ListSpendRuleEventsNotFoundResponseBod
 	return v
 }
 
-// NewListSpendRuleEventsConflict builds a spendRules service
-// listSpendRuleEvents endpoint conflict error.
-func NewListSpendRuleEventsConflict(body *ListSpendRuleEventsConflictResponseBody) *goa.ServiceError {
+// NewSimulateSpendRuleConflict builds a spendRules service simulateSpendRule
+// endpoint conflict error.
+func NewSimulateSpendRuleConflict(body *SimulateSpendRuleConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSimulateSpendRuleUnsupportedMedia builds a spendRules service
+// simulateSpendRule endpoint unsupported_media error.
+func NewSimulateSpendRuleUnsupportedMedia(body *SimulateSpendRuleUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSimulateSpendRuleInvalid builds a spendRules service simulateSpendRule
+// endpoint invalid error.
+func NewSimulateSpendRuleInvalid(body *SimulateSpendRuleInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSimulateSpendRuleInvariantViolation builds a spendRules service
+// simulateSpendRule endpoint invariant_violation error.
+func NewSimulateSpendRuleInvariantViolation(body *SimulateSpendRuleInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSimulateSpendRuleUnexpected builds a spendRules service simulateSpendRule
+// endpoint unexpected error.
+func NewSimulateSpendRuleUnexpected(body *SimulateSpendRuleUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSimulateSpendRuleGatewayError builds a spendRules service
+// simulateSpendRule endpoint gateway_error error.
+func NewSimulateSpendRuleGatewayError(body *SimulateSpendRuleGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSpendRuleEventsResultOK builds a "spendRules" service
+// "listSpendRuleEvents" endpoint result from a HTTP "OK" response.
+func NewListSpendRuleEventsResultOK(body *ListSpendRuleEventsResponseBody) *spendrules.ListSpendRuleEventsResult {
+	v := &spendrules.ListSpendRuleEventsResult{
+		NextCursor: body.NextCursor,
+	}
+	v.Events = make([]*spendrules.SpendRuleEvent, len(body.Events))
+	for i, val := range body.Events {
+		if val == nil {
+			v.Events[i] = nil
+			continue
+		}
+		v.Events[i] = unmarshalSpendRuleEventResponseBodyToSpendrulesSpendRuleEvent(val)
+	}
+
+	return v
+}
+
+// NewListSpendRuleEventsUnauthorized builds a spendRules service
+// listSpendRuleEvents endpoint unauthorized error.
+func NewListSpendRuleEventsUnauthorized(body *ListSpendRuleEventsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSpendRuleEventsForbidden builds a spendRules service
+// listSpendRuleEvents endpoint forbidden error.
+func NewListSpendRuleEventsForbidden(body *ListSpendRuleEventsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSpendRuleEventsBadRequest builds a spendRules service
+// listSpendRuleEvents endpoint bad_request error.
+func NewListSpendRuleEventsBadRequest(body *ListSpendRuleEventsBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSpendRuleEventsNotFound builds a spendRules service
+// listSpendRuleEvents endpoint not_found error.
+func NewListSpendRuleEventsNotFound(body *ListSpendRuleEventsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSpendRuleEventsConflict builds a spendRules service
+// listSpendRuleEvents endpoint conflict error.
+func NewListSpendRuleEventsConflict(body *ListSpendRuleEventsConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -3721,35 +4506,204 @@ func NewListActorAttributesGatewayError(body *ListActorAttributesGatewayErrorRes
 	return v
 }
 
-// ValidateCreateSpendRuleResponseBody runs the validations defined on
-// CreateSpendRuleResponseBody
-func ValidateCreateSpendRuleResponseBody(body *CreateSpendRuleResponseBody) (err error) {
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+// NewListUsageDimensionsResultOK builds a "spendRules" service
+// "listUsageDimensions" endpoint result from a HTTP "OK" response.
+func NewListUsageDimensionsResultOK(body *ListUsageDimensionsResponseBody) *spendrules.ListUsageDimensionsResult {
+	v := &spendrules.ListUsageDimensionsResult{}
+	v.Dimensions = make([]*spendrules.UsageDimension, len(body.Dimensions))
+	for i, val := range body.Dimensions {
+		if val == nil {
+			v.Dimensions[i] = nil
+			continue
+		}
+		v.Dimensions[i] = unmarshalUsageDimensionResponseBodyToSpendrulesUsageDimension(val)
 	}
-	if body.Urn == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("urn", "body"))
+
+	return v
+}
+
+// NewListUsageDimensionsUnauthorized builds a spendRules service
+// listUsageDimensions endpoint unauthorized error.
+func NewListUsageDimensionsUnauthorized(body *ListUsageDimensionsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if body.OrganizationID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+
+	return v
+}
+
+// NewListUsageDimensionsForbidden builds a spendRules service
+// listUsageDimensions endpoint forbidden error.
+func NewListUsageDimensionsForbidden(body *ListUsageDimensionsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if body.Name == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+
+	return v
+}
+
+// NewListUsageDimensionsBadRequest builds a spendRules service
+// listUsageDimensions endpoint bad_request error.
+func NewListUsageDimensionsBadRequest(body *ListUsageDimensionsBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if body.Slug == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+
+	return v
+}
+
+// NewListUsageDimensionsNotFound builds a spendRules service
+// listUsageDimensions endpoint not_found error.
+func NewListUsageDimensionsNotFound(body *ListUsageDimensionsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if body.Description == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+
+	return v
+}
+
+// NewListUsageDimensionsConflict builds a spendRules service
+// listUsageDimensions endpoint conflict error.
+func NewListUsageDimensionsConflict(body *ListUsageDimensionsConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if body.Target == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("target", "body"))
+
+	return v
+}
+
+// NewListUsageDimensionsUnsupportedMedia builds a spendRules service
+// listUsageDimensions endpoint unsupported_media error.
+func NewListUsageDimensionsUnsupportedMedia(body *ListUsageDimensionsUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if body.TargetExpr == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("target_expr", "body"))
+
+	return v
+}
+
+// NewListUsageDimensionsInvalid builds a spendRules service
+// listUsageDimensions endpoint invalid error.
+func NewListUsageDimensionsInvalid(body *ListUsageDimensionsInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if body.RuleExpr == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("rule_expr", "body"))
+
+	return v
+}
+
+// NewListUsageDimensionsInvariantViolation builds a spendRules service
+// listUsageDimensions endpoint invariant_violation error.
+func NewListUsageDimensionsInvariantViolation(body *ListUsageDimensionsInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUsageDimensionsUnexpected builds a spendRules service
+// listUsageDimensions endpoint unexpected error.
+func NewListUsageDimensionsUnexpected(body *ListUsageDimensionsUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListUsageDimensionsGatewayError builds a spendRules service
+// listUsageDimensions endpoint gateway_error error.
+func NewListUsageDimensionsGatewayError(body *ListUsageDimensionsGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateCreateSpendRuleResponseBody runs the validations defined on
+// CreateSpendRuleResponseBody
+func ValidateCreateSpendRuleResponseBody(body *CreateSpendRuleResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Urn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("urn", "body"))
+	}
+	if body.OrganizationID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Slug == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Target == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("target", "body"))
+	}
+	if body.TargetExpr == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("target_expr", "body"))
+	}
+	if body.ScopeExpr == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scope_expr", "body"))
+	}
+	if body.RuleExpr == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rule_expr", "body"))
 	}
 	if body.LimitUsd == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("limit_usd", "body"))
@@ -3783,6 +4737,11 @@ func ValidateCreateSpendRuleResponseBody(body *CreateSpendRuleResponseBody) (err
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Scope != nil {
+		if err2 := ValidateSpendRuleScopeConditionResponseBody(body.Scope); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	if body.WindowKind != nil {
 		if !(*body.WindowKind == "daily" || *body.WindowKind == "weekly" || *body.WindowKind == "monthly") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.window_kind", *body.WindowKind, []any{"daily", "weekly", "monthly"}))
@@ -3845,6 +4804,9 @@ func ValidateGetSpendRuleResponseBody(body *GetSpendRuleResponseBody) (err error
 	if body.TargetExpr == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("target_expr", "body"))
 	}
+	if body.ScopeExpr == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scope_expr", "body"))
+	}
 	if body.RuleExpr == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("rule_expr", "body"))
 	}
@@ -3880,6 +4842,11 @@ func ValidateGetSpendRuleResponseBody(body *GetSpendRuleResponseBody) (err error
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Scope != nil {
+		if err2 := ValidateSpendRuleScopeConditionResponseBody(body.Scope); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	if body.WindowKind != nil {
 		if !(*body.WindowKind == "daily" || *body.WindowKind == "weekly" || *body.WindowKind == "monthly") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.window_kind", *body.WindowKind, []any{"daily", "weekly", "monthly"}))
@@ -3926,6 +4893,9 @@ func ValidateUpdateSpendRuleResponseBody(body *UpdateSpendRuleResponseBody) (err
 	if body.TargetExpr == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("target_expr", "body"))
 	}
+	if body.ScopeExpr == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scope_expr", "body"))
+	}
 	if body.RuleExpr == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("rule_expr", "body"))
 	}
@@ -3961,6 +4931,11 @@ func ValidateUpdateSpendRuleResponseBody(body *UpdateSpendRuleResponseBody) (err
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Scope != nil {
+		if err2 := ValidateSpendRuleScopeConditionResponseBody(body.Scope); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	if body.WindowKind != nil {
 		if !(*body.WindowKind == "daily" || *body.WindowKind == "weekly" || *body.WindowKind == "monthly") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.window_kind", *body.WindowKind, []any{"daily", "weekly", "monthly"}))
@@ -4011,6 +4986,43 @@ func ValidatePreviewSpendRuleResponseBody(body *PreviewSpendRuleResponseBody) (e
 	return
 }
 
+// ValidateSimulateSpendRuleResponseBody runs the validations defined on
+// SimulateSpendRuleResponseBody
+func ValidateSimulateSpendRuleResponseBody(body *SimulateSpendRuleResponseBody) (err error) {
+	if body.MatchedCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("matched_count", "body"))
+	}
+	if body.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "body"))
+	}
+	if body.Windows == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("windows", "body"))
+	}
+	if body.Actors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actors", "body"))
+	}
+	if body.Action != nil {
+		if !(*body.Action == "flag" || *body.Action == "block") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", *body.Action, []any{"flag", "block"}))
+		}
+	}
+	for _, e := range body.Windows {
+		if e != nil {
+			if err2 := ValidateSpendRuleSimulationWindowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Actors {
+		if e != nil {
+			if err2 := ValidateSpendRuleSimulationActorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
 // ValidateListSpendRuleEventsResponseBody runs the validations defined on
 // ListSpendRuleEventsResponseBody
 func ValidateListSpendRuleEventsResponseBody(body *ListSpendRuleEventsResponseBody) (err error) {
@@ -4080,6 +5092,22 @@ func ValidateListActorAttributesResponseBody(body *ListActorAttributesResponseBo
 	return
 }
 
+// ValidateListUsageDimensionsResponseBody runs the validations defined on
+// ListUsageDimensionsResponseBody
+func ValidateListUsageDimensionsResponseBody(body *ListUsageDimensionsResponseBody) (err error) {
+	if body.Dimensions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dimensions", "body"))
+	}
+	for _, e := range body.Dimensions {
+		if e != nil {
+			if err2 := ValidateUsageDimensionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
 // ValidateCreateSpendRuleUnauthorizedResponseBody runs the validations defined
 // on createSpendRule_unauthorized_response_body
 func ValidateCreateSpendRuleUnauthorizedResponseBody(body *CreateSpendRuleUnauthorizedResponseBody) (err error) {
@@ -4320,9 +5348,489 @@ func ValidateCreateSpendRuleGatewayErrorResponseBody(body *CreateSpendRuleGatewa
 	return
 }
 
-// ValidateListSpendRulesUnauthorizedResponseBody runs the validations defined
-// on listSpendRules_unauthorized_response_body
-func ValidateListSpendRulesUnauthorizedResponseBody(body *ListSpendRulesUnauthorizedResponseBody) (err error) {
+// ValidateListSpendRulesUnauthorizedResponseBody runs the validations defined
+// on listSpendRules_unauthorized_response_body
+func ValidateListSpendRulesUnauthorizedResponseBody(body *ListSpendRulesUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSpendRulesForbiddenResponseBody runs the validations defined on
+// listSpendRules_forbidden_response_body
+func ValidateListSpendRulesForbiddenResponseBody(body *ListSpendRulesForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSpendRulesBadRequestResponseBody runs the validations defined on
+// listSpendRules_bad_request_response_body
+func ValidateListSpendRulesBadRequestResponseBody(body *ListSpendRulesBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSpendRulesNotFoundResponseBody runs the validations defined on
+// listSpendRules_not_found_response_body
+func ValidateListSpendRulesNotFoundResponseBody(body *ListSpendRulesNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSpendRulesConflictResponseBody runs the validations defined on
+// listSpendRules_conflict_response_body
+func ValidateListSpendRulesConflictResponseBody(body *ListSpendRulesConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSpendRulesUnsupportedMediaResponseBody runs the validations
+// defined on listSpendRules_unsupported_media_response_body
+func ValidateListSpendRulesUnsupportedMediaResponseBody(body *ListSpendRulesUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSpendRulesInvalidResponseBody runs the validations defined on
+// listSpendRules_invalid_response_body
+func ValidateListSpendRulesInvalidResponseBody(body *ListSpendRulesInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSpendRulesInvariantViolationResponseBody runs the validations
+// defined on listSpendRules_invariant_violation_response_body
+func ValidateListSpendRulesInvariantViolationResponseBody(body *ListSpendRulesInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSpendRulesUnexpectedResponseBody runs the validations defined on
+// listSpendRules_unexpected_response_body
+func ValidateListSpendRulesUnexpectedResponseBody(body *ListSpendRulesUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSpendRulesGatewayErrorResponseBody runs the validations defined
+// on listSpendRules_gateway_error_response_body
+func ValidateListSpendRulesGatewayErrorResponseBody(body *ListSpendRulesGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSpendRuleUnauthorizedResponseBody runs the validations defined on
+// getSpendRule_unauthorized_response_body
+func ValidateGetSpendRuleUnauthorizedResponseBody(body *GetSpendRuleUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSpendRuleForbiddenResponseBody runs the validations defined on
+// getSpendRule_forbidden_response_body
+func ValidateGetSpendRuleForbiddenResponseBody(body *GetSpendRuleForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSpendRuleBadRequestResponseBody runs the validations defined on
+// getSpendRule_bad_request_response_body
+func ValidateGetSpendRuleBadRequestResponseBody(body *GetSpendRuleBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSpendRuleNotFoundResponseBody runs the validations defined on
+// getSpendRule_not_found_response_body
+func ValidateGetSpendRuleNotFoundResponseBody(body *GetSpendRuleNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSpendRuleConflictResponseBody runs the validations defined on
+// getSpendRule_conflict_response_body
+func ValidateGetSpendRuleConflictResponseBody(body *GetSpendRuleConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSpendRuleUnsupportedMediaResponseBody runs the validations
+// defined on getSpendRule_unsupported_media_response_body
+func ValidateGetSpendRuleUnsupportedMediaResponseBody(body *GetSpendRuleUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSpendRuleInvalidResponseBody runs the validations defined on
+// getSpendRule_invalid_response_body
+func ValidateGetSpendRuleInvalidResponseBody(body *GetSpendRuleInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSpendRuleInvariantViolationResponseBody runs the validations
+// defined on getSpendRule_invariant_violation_response_body
+func ValidateGetSpendRuleInvariantViolationResponseBody(body *GetSpendRuleInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSpendRuleUnexpectedResponseBody runs the validations defined on
+// getSpendRule_unexpected_response_body
+func ValidateGetSpendRuleUnexpectedResponseBody(body *GetSpendRuleUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSpendRuleGatewayErrorResponseBody runs the validations defined on
+// getSpendRule_gateway_error_response_body
+func ValidateGetSpendRuleGatewayErrorResponseBody(body *GetSpendRuleGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSpendRuleUnauthorizedResponseBody runs the validations defined
+// on updateSpendRule_unauthorized_response_body
+func ValidateUpdateSpendRuleUnauthorizedResponseBody(body *UpdateSpendRuleUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4344,9 +5852,9 @@ func ValidateListSpendRulesUnauthorizedResponseBody(body *ListSpendRulesUnauthor
 	return
 }
 
-// ValidateListSpendRulesForbiddenResponseBody runs the validations defined on
-// listSpendRules_forbidden_response_body
-func ValidateListSpendRulesForbiddenResponseBody(body *ListSpendRulesForbiddenResponseBody) (err error) {
+// ValidateUpdateSpendRuleForbiddenResponseBody runs the validations defined on
+// updateSpendRule_forbidden_response_body
+func ValidateUpdateSpendRuleForbiddenResponseBody(body *UpdateSpendRuleForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4368,9 +5876,9 @@ func ValidateListSpendRulesForbiddenResponseBody(body *ListSpendRulesForbiddenRe
 	return
 }
 
-// ValidateListSpendRulesBadRequestResponseBody runs the validations defined on
-// listSpendRules_bad_request_response_body
-func ValidateListSpendRulesBadRequestResponseBody(body *ListSpendRulesBadRequestResponseBody) (err error) {
+// ValidateUpdateSpendRuleBadRequestResponseBody runs the validations defined
+// on updateSpendRule_bad_request_response_body
+func ValidateUpdateSpendRuleBadRequestResponseBody(body *UpdateSpendRuleBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4392,9 +5900,9 @@ func ValidateListSpendRulesBadRequestResponseBody(body *ListSpendRulesBadRequest
 	return
 }
 
-// ValidateListSpendRulesNotFoundResponseBody runs the validations defined on
-// listSpendRules_not_found_response_body
-func ValidateListSpendRulesNotFoundResponseBody(body *ListSpendRulesNotFoundResponseBody) (err error) {
+// ValidateUpdateSpendRuleNotFoundResponseBody runs the validations defined on
+// updateSpendRule_not_found_response_body
+func ValidateUpdateSpendRuleNotFoundResponseBody(body *UpdateSpendRuleNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4416,9 +5924,9 @@ func ValidateListSpendRulesNotFoundResponseBody(body *ListSpendRulesNotFoundResp
 	return
 }
 
-// ValidateListSpendRulesConflictResponseBody runs the validations defined on
-// listSpendRules_conflict_response_body
-func ValidateListSpendRulesConflictResponseBody(body *ListSpendRulesConflictResponseBody) (err error) {
+// ValidateUpdateSpendRuleConflictResponseBody runs the validations defined on
+// updateSpendRule_conflict_response_body
+func ValidateUpdateSpendRuleConflictResponseBody(body *UpdateSpendRuleConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4440,9 +5948,9 @@ func ValidateListSpendRulesConflictResponseBody(body *ListSpendRulesConflictResp
 	return
 }
 
-// ValidateListSpendRulesUnsupportedMediaResponseBody runs the validations
-// defined on listSpendRules_unsupported_media_response_body
-func ValidateListSpendRulesUnsupportedMediaResponseBody(body *ListSpendRulesUnsupportedMediaResponseBody) (err error) {
+// ValidateUpdateSpendRuleUnsupportedMediaResponseBody runs the validations
+// defined on updateSpendRule_unsupported_media_response_body
+func ValidateUpdateSpendRuleUnsupportedMediaResponseBody(body *UpdateSpendRuleUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4464,9 +5972,9 @@ func ValidateListSpendRulesUnsupportedMediaResponseBody(body *ListSpendRulesUnsu
 	return
 }
 
-// ValidateListSpendRulesInvalidResponseBody runs the validations defined on
-// listSpendRules_invalid_response_body
-func ValidateListSpendRulesInvalidResponseBody(body *ListSpendRulesInvalidResponseBody) (err error) {
+// ValidateUpdateSpendRuleInvalidResponseBody runs the validations defined on
+// updateSpendRule_invalid_response_body
+func ValidateUpdateSpendRuleInvalidResponseBody(body *UpdateSpendRuleInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4488,9 +5996,9 @@ func ValidateListSpendRulesInvalidResponseBody(body *ListSpendRulesInvalidRespon
 	return
 }
 
-// ValidateListSpendRulesInvariantViolationResponseBody runs the validations
-// defined on listSpendRules_invariant_violation_response_body
-func ValidateListSpendRulesInvariantViolationResponseBody(body *ListSpendRulesInvariantViolationResponseBody) (err error) {
+// ValidateUpdateSpendRuleInvariantViolationResponseBody runs the validations
+// defined on updateSpendRule_invariant_violation_response_body
+func ValidateUpdateSpendRuleInvariantViolationResponseBody(body *UpdateSpendRuleInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4512,9 +6020,9 @@ func ValidateListSpendRulesInvariantViolationResponseBody(body *ListSpendRulesIn
 	return
 }
 
-// ValidateListSpendRulesUnexpectedResponseBody runs the validations defined on
-// listSpendRules_unexpected_response_body
-func ValidateListSpendRulesUnexpectedResponseBody(body *ListSpendRulesUnexpectedResponseBody) (err error) {
+// ValidateUpdateSpendRuleUnexpectedResponseBody runs the validations defined
+// on updateSpendRule_unexpected_response_body
+func ValidateUpdateSpendRuleUnexpectedResponseBody(body *UpdateSpendRuleUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4536,9 +6044,9 @@ func ValidateListSpendRulesUnexpectedResponseBody(body *ListSpendRulesUnexpected
 	return
 }
 
-// ValidateListSpendRulesGatewayErrorResponseBody runs the validations defined
-// on listSpendRules_gateway_error_response_body
-func ValidateListSpendRulesGatewayErrorResponseBody(body *ListSpendRulesGatewayErrorResponseBody) (err error) {
+// ValidateUpdateSpendRuleGatewayErrorResponseBody runs the validations defined
+// on updateSpendRule_gateway_error_response_body
+func ValidateUpdateSpendRuleGatewayErrorResponseBody(body *UpdateSpendRuleGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4560,9 +6068,9 @@ func ValidateListSpendRulesGatewayErrorResponseBody(body *ListSpendRulesGatewayE
 	return
 }
 
-// ValidateGetSpendRuleUnauthorizedResponseBody runs the validations defined on
-// getSpendRule_unauthorized_response_body
-func ValidateGetSpendRuleUnauthorizedResponseBody(body *GetSpendRuleUnauthorizedResponseBody) (err error) {
+// ValidateArchiveSpendRuleUnauthorizedResponseBody runs the validations
+// defined on archiveSpendRule_unauthorized_response_body
+func ValidateArchiveSpendRuleUnauthorizedResponseBody(body *ArchiveSpendRuleUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4584,9 +6092,9 @@ func ValidateGetSpendRuleUnauthorizedResponseBody(body *GetSpendRuleUnauthorized
 	return
 }
 
-// ValidateGetSpendRuleForbiddenResponseBody runs the validations defined on
-// getSpendRule_forbidden_response_body
-func ValidateGetSpendRuleForbiddenResponseBody(body *GetSpendRuleForbiddenResponseBody) (err error) {
+// ValidateArchiveSpendRuleForbiddenResponseBody runs the validations defined
+// on archiveSpendRule_forbidden_response_body
+func ValidateArchiveSpendRuleForbiddenResponseBody(body *ArchiveSpendRuleForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4608,9 +6116,9 @@ func ValidateGetSpendRuleForbiddenResponseBody(body *GetSpendRuleForbiddenRespon
 	return
 }
 
-// ValidateGetSpendRuleBadRequestResponseBody runs the validations defined on
-// getSpendRule_bad_request_response_body
-func ValidateGetSpendRuleBadRequestResponseBody(body *GetSpendRuleBadRequestResponseBody) (err error) {
+// ValidateArchiveSpendRuleBadRequestResponseBody runs the validations defined
+// on archiveSpendRule_bad_request_response_body
+func ValidateArchiveSpendRuleBadRequestResponseBody(body *ArchiveSpendRuleBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4632,9 +6140,9 @@ func ValidateGetSpendRuleBadRequestResponseBody(body *GetSpendRuleBadRequestResp
 	return
 }
 
-// ValidateGetSpendRuleNotFoundResponseBody runs the validations defined on
-// getSpendRule_not_found_response_body
-func ValidateGetSpendRuleNotFoundResponseBody(body *GetSpendRuleNotFoundResponseBody) (err error) {
+// ValidateArchiveSpendRuleNotFoundResponseBody runs the validations defined on
+// archiveSpendRule_not_found_response_body
+func ValidateArchiveSpendRuleNotFoundResponseBody(body *ArchiveSpendRuleNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4656,9 +6164,9 @@ func ValidateGetSpendRuleNotFoundResponseBody(body *GetSpendRuleNotFoundResponse
 	return
 }
 
-// ValidateGetSpendRuleConflictResponseBody runs the validations defined on
-// getSpendRule_conflict_response_body
-func ValidateGetSpendRuleConflictResponseBody(body *GetSpendRuleConflictResponseBody) (err error) {
+// ValidateArchiveSpendRuleConflictResponseBody runs the validations defined on
+// archiveSpendRule_conflict_response_body
+func ValidateArchiveSpendRuleConflictResponseBody(body *ArchiveSpendRuleConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4680,9 +6188,9 @@ func ValidateGetSpendRuleConflictResponseBody(body *GetSpendRuleConflictResponse
 	return
 }
 
-// ValidateGetSpendRuleUnsupportedMediaResponseBody runs the validations
-// defined on getSpendRule_unsupported_media_response_body
-func ValidateGetSpendRuleUnsupportedMediaResponseBody(body *GetSpendRuleUnsupportedMediaResponseBody) (err error) {
+// ValidateArchiveSpendRuleUnsupportedMediaResponseBody runs the validations
+// defined on archiveSpendRule_unsupported_media_response_body
+func ValidateArchiveSpendRuleUnsupportedMediaResponseBody(body *ArchiveSpendRuleUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4704,9 +6212,9 @@ func ValidateGetSpendRuleUnsupportedMediaResponseBody(body *GetSpendRuleUnsuppor
 	return
 }
 
-// ValidateGetSpendRuleInvalidResponseBody runs the validations defined on
-// getSpendRule_invalid_response_body
-func ValidateGetSpendRuleInvalidResponseBody(body *GetSpendRuleInvalidResponseBody) (err error) {
+// ValidateArchiveSpendRuleInvalidResponseBody runs the validations defined on
+// archiveSpendRule_invalid_response_body
+func ValidateArchiveSpendRuleInvalidResponseBody(body *ArchiveSpendRuleInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4728,9 +6236,9 @@ func ValidateGetSpendRuleInvalidResponseBody(body *GetSpendRuleInvalidResponseBo
 	return
 }
 
-// ValidateGetSpendRuleInvariantViolationResponseBody runs the validations
-// defined on getSpendRule_invariant_violation_response_body
-func ValidateGetSpendRuleInvariantViolationResponseBody(body *GetSpendRuleInvariantViolationResponseBody) (err error) {
+// ValidateArchiveSpendRuleInvariantViolationResponseBody runs the validations
+// defined on archiveSpendRule_invariant_violation_response_body
+func ValidateArchiveSpendRuleInvariantViolationResponseBody(body *ArchiveSpendRuleInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4752,9 +6260,9 @@ func ValidateGetSpendRuleInvariantViolationResponseBody(body *GetSpendRuleInvari
 	return
 }
 
-// ValidateGetSpendRuleUnexpectedResponseBody runs the validations defined on
-// getSpendRule_unexpected_response_body
-func ValidateGetSpendRuleUnexpectedResponseBody(body *GetSpendRuleUnexpectedResponseBody) (err error) {
+// ValidateArchiveSpendRuleUnexpectedResponseBody runs the validations defined
+// on archiveSpendRule_unexpected_response_body
+func ValidateArchiveSpendRuleUnexpectedResponseBody(body *ArchiveSpendRuleUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4776,9 +6284,9 @@ func ValidateGetSpendRuleUnexpectedResponseBody(body *GetSpendRuleUnexpectedResp
 	return
 }
 
-// ValidateGetSpendRuleGatewayErrorResponseBody runs the validations defined on
-// getSpendRule_gateway_error_response_body
-func ValidateGetSpendRuleGatewayErrorResponseBody(body *GetSpendRuleGatewayErrorResponseBody) (err error) {
+// ValidateArchiveSpendRuleGatewayErrorResponseBody runs the validations
+// defined on archiveSpendRule_gateway_error_response_body
+func ValidateArchiveSpendRuleGatewayErrorResponseBody(body *ArchiveSpendRuleGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4800,9 +6308,9 @@ func ValidateGetSpendRuleGatewayErrorResponseBody(body *GetSpendRuleGatewayError
 	return
 }
 
-// ValidateUpdateSpendRuleUnauthorizedResponseBody runs the validations defined
-// on updateSpendRule_unauthorized_response_body
-func ValidateUpdateSpendRuleUnauthorizedResponseBody(body *UpdateSpendRuleUnauthorizedResponseBody) (err error) {
+// ValidatePreviewSpendRuleUnauthorizedResponseBody runs the validations
+// defined on previewSpendRule_unauthorized_response_body
+func ValidatePreviewSpendRuleUnauthorizedResponseBody(body *PreviewSpendRuleUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4824,9 +6332,9 @@ func ValidateUpdateSpendRuleUnauthorizedResponseBody(body *UpdateSpendRuleUnauth
 	return
 }
 
-// ValidateUpdateSpendRuleForbiddenResponseBody runs the validations defined on
-// updateSpendRule_forbidden_response_body
-func ValidateUpdateSpendRuleForbiddenResponseBody(body *UpdateSpendRuleForbiddenResponseBody) (err error) {
+// ValidatePreviewSpendRuleForbiddenResponseBody runs the validations defined
+// on previewSpendRule_forbidden_response_body
+func ValidatePreviewSpendRuleForbiddenResponseBody(body *PreviewSpendRuleForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4848,9 +6356,9 @@ func ValidateUpdateSpendRuleForbiddenResponseBody(body *UpdateSpendRuleForbidden
 	return
 }
 
-// ValidateUpdateSpendRuleBadRequestResponseBody runs the validations defined
-// on updateSpendRule_bad_request_response_body
-func ValidateUpdateSpendRuleBadRequestResponseBody(body *UpdateSpendRuleBadRequestResponseBody) (err error) {
+// ValidatePreviewSpendRuleBadRequestResponseBody runs the validations defined
+// on previewSpendRule_bad_request_response_body
+func ValidatePreviewSpendRuleBadRequestResponseBody(body *PreviewSpendRuleBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4872,9 +6380,9 @@ func ValidateUpdateSpendRuleBadRequestResponseBody(body *UpdateSpendRuleBadReque
 	return
 }
 
-// ValidateUpdateSpendRuleNotFoundResponseBody runs the validations defined on
-// updateSpendRule_not_found_response_body
-func ValidateUpdateSpendRuleNotFoundResponseBody(body *UpdateSpendRuleNotFoundResponseBody) (err error) {
+// ValidatePreviewSpendRuleNotFoundResponseBody runs the validations defined on
+// previewSpendRule_not_found_response_body
+func ValidatePreviewSpendRuleNotFoundResponseBody(body *PreviewSpendRuleNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4896,9 +6404,9 @@ func ValidateUpdateSpendRuleNotFoundResponseBody(body *UpdateSpendRuleNotFoundRe
 	return
 }
 
-// ValidateUpdateSpendRuleConflictResponseBody runs the validations defined on
-// updateSpendRule_conflict_response_body
-func ValidateUpdateSpendRuleConflictResponseBody(body *UpdateSpendRuleConflictResponseBody) (err error) {
+// ValidatePreviewSpendRuleConflictResponseBody runs the validations defined on
+// previewSpendRule_conflict_response_body
+func ValidatePreviewSpendRuleConflictResponseBody(body *PreviewSpendRuleConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4920,9 +6428,9 @@ func ValidateUpdateSpendRuleConflictResponseBody(body *UpdateSpendRuleConflictRe
 	return
 }
 
-// ValidateUpdateSpendRuleUnsupportedMediaResponseBody runs the validations
-// defined on updateSpendRule_unsupported_media_response_body
-func ValidateUpdateSpendRuleUnsupportedMediaResponseBody(body *UpdateSpendRuleUnsupportedMediaResponseBody) (err error) {
+// ValidatePreviewSpendRuleUnsupportedMediaResponseBody runs the validations
+// defined on previewSpendRule_unsupported_media_response_body
+func ValidatePreviewSpendRuleUnsupportedMediaResponseBody(body *PreviewSpendRuleUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4944,9 +6452,9 @@ func ValidateUpdateSpendRuleUnsupportedMediaResponseBody(body *UpdateSpendRuleUn
 	return
 }
 
-// ValidateUpdateSpendRuleInvalidResponseBody runs the validations defined on
-// updateSpendRule_invalid_response_body
-func ValidateUpdateSpendRuleInvalidResponseBody(body *UpdateSpendRuleInvalidResponseBody) (err error) {
+// ValidatePreviewSpendRuleInvalidResponseBody runs the validations defined on
+// previewSpendRule_invalid_response_body
+func ValidatePreviewSpendRuleInvalidResponseBody(body *PreviewSpendRuleInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4968,9 +6476,9 @@ func ValidateUpdateSpendRuleInvalidResponseBody(body *UpdateSpendRuleInvalidResp
 	return
 }
 
-// ValidateUpdateSpendRuleInvariantViolationResponseBody runs the validations
-// defined on updateSpendRule_invariant_violation_response_body
-func ValidateUpdateSpendRuleInvariantViolationResponseBody(body *UpdateSpendRuleInvariantViolationResponseBody) (err error) {
+// ValidatePreviewSpendRuleInvariantViolationResponseBody runs the validations
+// defined on previewSpendRule_invariant_violation_response_body
+func ValidatePreviewSpendRuleInvariantViolationResponseBody(body *PreviewSpendRuleInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -4992,9 +6500,9 @@ func ValidateUpdateSpendRuleInvariantViolationResponseBody(body *UpdateSpendRule
 	return
 }
 
-// ValidateUpdateSpendRuleUnexpectedResponseBody runs the validations defined
-// on updateSpendRule_unexpected_response_body
-func ValidateUpdateSpendRuleUnexpectedResponseBody(body *UpdateSpendRuleUnexpectedResponseBody) (err error) {
+// ValidatePreviewSpendRuleUnexpectedResponseBody runs the validations defined
+// on previewSpendRule_unexpected_response_body
+func ValidatePreviewSpendRuleUnexpectedResponseBody(body *PreviewSpendRuleUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5016,9 +6524,9 @@ func ValidateUpdateSpendRuleUnexpectedResponseBody(body *UpdateSpendRuleUnexpect
 	return
 }
 
-// ValidateUpdateSpendRuleGatewayErrorResponseBody runs the validations defined
-// on updateSpendRule_gateway_error_response_body
-func ValidateUpdateSpendRuleGatewayErrorResponseBody(body *UpdateSpendRuleGatewayErrorResponseBody) (err error) {
+// ValidatePreviewSpendRuleGatewayErrorResponseBody runs the validations
+// defined on previewSpendRule_gateway_error_response_body
+func ValidatePreviewSpendRuleGatewayErrorResponseBody(body *PreviewSpendRuleGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5040,9 +6548,9 @@ func ValidateUpdateSpendRuleGatewayErrorResponseBody(body *UpdateSpendRuleGatewa
 	return
 }
 
-// ValidateArchiveSpendRuleUnauthorizedResponseBody runs the validations
-// defined on archiveSpendRule_unauthorized_response_body
-func ValidateArchiveSpendRuleUnauthorizedResponseBody(body *ArchiveSpendRuleUnauthorizedResponseBody) (err error) {
+// ValidateSimulateSpendRuleUnauthorizedResponseBody runs the validations
+// defined on simulateSpendRule_unauthorized_response_body
+func ValidateSimulateSpendRuleUnauthorizedResponseBody(body *SimulateSpendRuleUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5064,9 +6572,9 @@ func ValidateArchiveSpendRuleUnauthorizedResponseBody(body *ArchiveSpendRuleUnau
 	return
 }
 
-// ValidateArchiveSpendRuleForbiddenResponseBody runs the validations defined
-// on archiveSpendRule_forbidden_response_body
-func ValidateArchiveSpendRuleForbiddenResponseBody(body *ArchiveSpendRuleForbiddenResponseBody) (err error) {
+// ValidateSimulateSpendRuleForbiddenResponseBody runs the validations defined
+// on simulateSpendRule_forbidden_response_body
+func ValidateSimulateSpendRuleForbiddenResponseBody(body *SimulateSpendRuleForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5088,9 +6596,9 @@ func ValidateArchiveSpendRuleForbiddenResponseBody(body *ArchiveSpendRuleForbidd
 	return
 }
 
-// ValidateArchiveSpendRuleBadRequestResponseBody runs the validations defined
-// on archiveSpendRule_bad_request_response_body
-func ValidateArchiveSpendRuleBadRequestResponseBody(body *ArchiveSpendRuleBadRequestResponseBody) (err error) {
+// ValidateSimulateSpendRuleBadRequestResponseBody runs the validations defined
+// on simulateSpendRule_bad_request_response_body
+func ValidateSimulateSpendRuleBadRequestResponseBody(body *SimulateSpendRuleBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5112,9 +6620,9 @@ func ValidateArchiveSpendRuleBadRequestResponseBody(body *ArchiveSpendRuleBadReq
 	return
 }
 
-// ValidateArchiveSpendRuleNotFoundResponseBody runs the validations defined on
-// archiveSpendRule_not_found_response_body
-func ValidateArchiveSpendRuleNotFoundResponseBody(body *ArchiveSpendRuleNotFoundResponseBody) (err error) {
+// ValidateSimulateSpendRuleNotFoundResponseBody runs the validations defined
+// on simulateSpendRule_not_found_response_body
+func ValidateSimulateSpendRuleNotFoundResponseBody(body *SimulateSpendRuleNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5136,9 +6644,9 @@ func ValidateArchiveSpendRuleNotFoundResponseBody(body *ArchiveSpendRuleNotFound
 	return
 }
 
-// ValidateArchiveSpendRuleConflictResponseBody runs the validations defined on
-// archiveSpendRule_conflict_response_body
-func ValidateArchiveSpendRuleConflictResponseBody(body *ArchiveSpendRuleConflictResponseBody) (err error) {
+// ValidateSimulateSpendRuleConflictResponseBody runs the validations defined
+// on simulateSpendRule_conflict_response_body
+func ValidateSimulateSpendRuleConflictResponseBody(body *SimulateSpendRuleConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5160,9 +6668,9 @@ func ValidateArchiveSpendRuleConflictResponseBody(body *ArchiveSpendRuleConflict
 	return
 }
 
-// ValidateArchiveSpendRuleUnsupportedMediaResponseBody runs the validations
-// defined on archiveSpendRule_unsupported_media_response_body
-func ValidateArchiveSpendRuleUnsupportedMediaResponseBody(body *ArchiveSpendRuleUnsupportedMediaResponseBody) (err error) {
+// ValidateSimulateSpendRuleUnsupportedMediaResponseBody runs the validations
+// defined on simulateSpendRule_unsupported_media_response_body
+func ValidateSimulateSpendRuleUnsupportedMediaResponseBody(body *SimulateSpendRuleUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5184,9 +6692,9 @@ func ValidateArchiveSpendRuleUnsupportedMediaResponseBody(body *ArchiveSpendRule
 	return
 }
 
-// ValidateArchiveSpendRuleInvalidResponseBody runs the validations defined on
-// archiveSpendRule_invalid_response_body
-func ValidateArchiveSpendRuleInvalidResponseBody(body *ArchiveSpendRuleInvalidResponseBody) (err error) {
+// ValidateSimulateSpendRuleInvalidResponseBody runs the validations defined on
+// simulateSpendRule_invalid_response_body
+func ValidateSimulateSpendRuleInvalidResponseBody(body *SimulateSpendRuleInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5208,9 +6716,9 @@ func ValidateArchiveSpendRuleInvalidResponseBody(body *ArchiveSpendRuleInvalidRe
 	return
 }
 
-// ValidateArchiveSpendRuleInvariantViolationResponseBody runs the validations
-// defined on archiveSpendRule_invariant_violation_response_body
-func ValidateArchiveSpendRuleInvariantViolationResponseBody(body *ArchiveSpendRuleInvariantViolationResponseBody) (err error) {
+// ValidateSimulateSpendRuleInvariantViolationResponseBody runs the validations
+// defined on simulateSpendRule_invariant_violation_response_body
+func ValidateSimulateSpendRuleInvariantViolationResponseBody(body *SimulateSpendRuleInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5232,9 +6740,9 @@ func ValidateArchiveSpendRuleInvariantViolationResponseBody(body *ArchiveSpendRu
 	return
 }
 
-// ValidateArchiveSpendRuleUnexpectedResponseBody runs the validations defined
-// on archiveSpendRule_unexpected_response_body
-func ValidateArchiveSpendRuleUnexpectedResponseBody(body *ArchiveSpendRuleUnexpectedResponseBody) (err error) {
+// ValidateSimulateSpendRuleUnexpectedResponseBody runs the validations defined
+// on simulateSpendRule_unexpected_response_body
+func ValidateSimulateSpendRuleUnexpectedResponseBody(body *SimulateSpendRuleUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5256,9 +6764,9 @@ func ValidateArchiveSpendRuleUnexpectedResponseBody(body *ArchiveSpendRuleUnexpe
 	return
 }
 
-// ValidateArchiveSpendRuleGatewayErrorResponseBody runs the validations
-// defined on archiveSpendRule_gateway_error_response_body
-func ValidateArchiveSpendRuleGatewayErrorResponseBody(body *ArchiveSpendRuleGatewayErrorResponseBody) (err error) {
+// ValidateSimulateSpendRuleGatewayErrorResponseBody runs the validations
+// defined on simulateSpendRule_gateway_error_response_body
+func ValidateSimulateSpendRuleGatewayErrorResponseBody(body *SimulateSpendRuleGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5280,9 +6788,9 @@ func ValidateArchiveSpendRuleGatewayErrorResponseBody(body *ArchiveSpendRuleGate
 	return
 }
 
-// ValidatePreviewSpendRuleUnauthorizedResponseBody runs the validations
-// defined on previewSpendRule_unauthorized_response_body
-func ValidatePreviewSpendRuleUnauthorizedResponseBody(body *PreviewSpendRuleUnauthorizedResponseBody) (err error) {
+// ValidateListSpendRuleEventsUnauthorizedResponseBody runs the validations
+// defined on listSpendRuleEvents_unauthorized_response_body
+func ValidateListSpendRuleEventsUnauthorizedResponseBody(body *ListSpendRuleEventsUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5304,9 +6812,9 @@ func ValidatePreviewSpendRuleUnauthorizedResponseBody(body *PreviewSpendRuleUnau
 	return
 }
 
-// ValidatePreviewSpendRuleForbiddenResponseBody runs the validations defined
-// on previewSpendRule_forbidden_response_body
-func ValidatePreviewSpendRuleForbiddenResponseBody(body *PreviewSpendRuleForbiddenResponseBody) (err error) {
+// ValidateListSpendRuleEventsForbiddenResponseBody runs the validations
+// defined on listSpendRuleEvents_forbidden_response_body
+func ValidateListSpendRuleEventsForbiddenResponseBody(body *ListSpendRuleEventsForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5328,9 +6836,9 @@ func ValidatePreviewSpendRuleForbiddenResponseBody(body *PreviewSpendRuleForbidd
 	return
 }
 
-// ValidatePreviewSpendRuleBadRequestResponseBody runs the validations defined
-// on previewSpendRule_bad_request_response_body
-func ValidatePreviewSpendRuleBadRequestResponseBody(body *PreviewSpendRuleBadRequestResponseBody) (err error) {
+// ValidateListSpendRuleEventsBadRequestResponseBody runs the validations
+// defined on listSpendRuleEvents_bad_request_response_body
+func ValidateListSpendRuleEventsBadRequestResponseBody(body *ListSpendRuleEventsBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5352,9 +6860,9 @@ func ValidatePreviewSpendRuleBadRequestResponseBody(body *PreviewSpendRuleBadReq
 	return
 }
 
-// ValidatePreviewSpendRuleNotFoundResponseBody runs the validations defined on
-// previewSpendRule_not_found_response_body
-func ValidatePreviewSpendRuleNotFoundResponseBody(body *PreviewSpendRuleNotFoundResponseBody) (err error) {
+// ValidateListSpendRuleEventsNotFoundResponseBody runs the validations defined
+// on listSpendRuleEvents_not_found_response_body
+func ValidateListSpendRuleEventsNotFoundResponseBody(body *ListSpendRuleEventsNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5376,9 +6884,9 @@ func ValidatePreviewSpendRuleNotFoundResponseBody(body *PreviewSpendRuleNotFound
 	return
 }
 
-// ValidatePreviewSpendRuleConflictResponseBody runs the validations defined on
-// previewSpendRule_conflict_response_body
-func ValidatePreviewSpendRuleConflictResponseBody(body *PreviewSpendRuleConflictResponseBody) (err error) {
+// ValidateListSpendRuleEventsConflictResponseBody runs the validations defined
+// on listSpendRuleEvents_conflict_response_body
+func ValidateListSpendRuleEventsConflictResponseBody(body *ListSpendRuleEventsConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5400,9 +6908,9 @@ func ValidatePreviewSpendRuleConflictResponseBody(body *PreviewSpendRuleConflict
 	return
 }
 
-// ValidatePreviewSpendRuleUnsupportedMediaResponseBody runs the validations
-// defined on previewSpendRule_unsupported_media_response_body
-func ValidatePreviewSpendRuleUnsupportedMediaResponseBody(body *PreviewSpendRuleUnsupportedMediaResponseBody) (err error) {
+// ValidateListSpendRuleEventsUnsupportedMediaResponseBody runs the validations
+// defined on listSpendRuleEvents_unsupported_media_response_body
+func ValidateListSpendRuleEventsUnsupportedMediaResponseBody(body *ListSpendRuleEventsUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5424,9 +6932,9 @@ func ValidatePreviewSpendRuleUnsupportedMediaResponseBody(body *PreviewSpendRule
 	return
 }
 
-// ValidatePreviewSpendRuleInvalidResponseBody runs the validations defined on
-// previewSpendRule_invalid_response_body
-func ValidatePreviewSpendRuleInvalidResponseBody(body *PreviewSpendRuleInvalidResponseBody) (err error) {
+// ValidateListSpendRuleEventsInvalidResponseBody runs the validations defined
+// on listSpendRuleEvents_invalid_response_body
+func ValidateListSpendRuleEventsInvalidResponseBody(body *ListSpendRuleEventsInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5448,9 +6956,9 @@ func ValidatePreviewSpendRuleInvalidResponseBody(body *PreviewSpendRuleInvalidRe
 	return
 }
 
-// ValidatePreviewSpendRuleInvariantViolationResponseBody runs the validations
-// defined on previewSpendRule_invariant_violation_response_body
-func ValidatePreviewSpendRuleInvariantViolationResponseBody(body *PreviewSpendRuleInvariantViolationResponseBody) (err error) {
+// ValidateListSpendRuleEventsInvariantViolationResponseBody runs the
+// validations defined on listSpendRuleEvents_invariant_violation_response_body
+func ValidateListSpendRuleEventsInvariantViolationResponseBody(body *ListSpendRuleEventsInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5472,9 +6980,9 @@ func ValidatePreviewSpendRuleInvariantViolationResponseBody(body *PreviewSpendRu
 	return
 }
 
-// ValidatePreviewSpendRuleUnexpectedResponseBody runs the validations defined
-// on previewSpendRule_unexpected_response_body
-func ValidatePreviewSpendRuleUnexpectedResponseBody(body *PreviewSpendRuleUnexpectedResponseBody) (err error) {
+// ValidateListSpendRuleEventsUnexpectedResponseBody runs the validations
+// defined on listSpendRuleEvents_unexpected_response_body
+func ValidateListSpendRuleEventsUnexpectedResponseBody(body *ListSpendRuleEventsUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5496,9 +7004,9 @@ func ValidatePreviewSpendRuleUnexpectedResponseBody(body *PreviewSpendRuleUnexpe
 	return
 }
 
-// ValidatePreviewSpendRuleGatewayErrorResponseBody runs the validations
-// defined on previewSpendRule_gateway_error_response_body
-func ValidatePreviewSpendRuleGatewayErrorResponseBody(body *PreviewSpendRuleGatewayErrorResponseBody) (err error) {
+// ValidateListSpendRuleEventsGatewayErrorResponseBody runs the validations
+// defined on listSpendRuleEvents_gateway_error_response_body
+func ValidateListSpendRuleEventsGatewayErrorResponseBody(body *ListSpendRuleEventsGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5520,9 +7028,9 @@ func ValidatePreviewSpendRuleGatewayErrorResponseBody(body *PreviewSpendRuleGate
 	return
 }
 
-// ValidateListSpendRuleEventsUnauthorizedResponseBody runs the validations
-// defined on listSpendRuleEvents_unauthorized_response_body
-func ValidateListSpendRuleEventsUnauthorizedResponseBody(body *ListSpendRuleEventsUnauthorizedResponseBody) (err error) {
+// ValidateGetSpendRulesOverviewUnauthorizedResponseBody runs the validations
+// defined on getSpendRulesOverview_unauthorized_response_body
+func ValidateGetSpendRulesOverviewUnauthorizedResponseBody(body *GetSpendRulesOverviewUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5544,9 +7052,9 @@ func ValidateListSpendRuleEventsUnauthorizedResponseBody(body *ListSpendRuleEven
 	return
 }
 
-// ValidateListSpendRuleEventsForbiddenResponseBody runs the validations
-// defined on listSpendRuleEvents_forbidden_response_body
-func ValidateListSpendRuleEventsForbiddenResponseBody(body *ListSpendRuleEventsForbiddenResponseBody) (err error) {
+// ValidateGetSpendRulesOverviewForbiddenResponseBody runs the validations
+// defined on getSpendRulesOverview_forbidden_response_body
+func ValidateGetSpendRulesOverviewForbiddenResponseBody(body *GetSpendRulesOverviewForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5568,9 +7076,9 @@ func ValidateListSpendRuleEventsForbiddenResponseBody(body *ListSpendRuleEventsF
 	return
 }
 
-// ValidateListSpendRuleEventsBadRequestResponseBody runs the validations
-// defined on listSpendRuleEvents_bad_request_response_body
-func ValidateListSpendRuleEventsBadRequestResponseBody(body *ListSpendRuleEventsBadRequestResponseBody) (err error) {
+// ValidateGetSpendRulesOverviewBadRequestResponseBody runs the validations
+// defined on getSpendRulesOverview_bad_request_response_body
+func ValidateGetSpendRulesOverviewBadRequestResponseBody(body *GetSpendRulesOverviewBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5592,9 +7100,9 @@ func ValidateListSpendRuleEventsBadRequestResponseBody(body *ListSpendRuleEvents
 	return
 }
 
-// ValidateListSpendRuleEventsNotFoundResponseBody runs the validations defined
-// on listSpendRuleEvents_not_found_response_body
-func ValidateListSpendRuleEventsNotFoundResponseBody(body *ListSpendRuleEventsNotFoundResponseBody) (err error) {
+// ValidateGetSpendRulesOverviewNotFoundResponseBody runs the validations
+// defined on getSpendRulesOverview_not_found_response_body
+func ValidateGetSpendRulesOverviewNotFoundResponseBody(body *GetSpendRulesOverviewNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5616,9 +7124,9 @@ func ValidateListSpendRuleEventsNotFoundResponseBody(body *ListSpendRuleEventsNo
 	return
 }
 
-// ValidateListSpendRuleEventsConflictResponseBody runs the validations defined
-// on listSpendRuleEvents_conflict_response_body
-func ValidateListSpendRuleEventsConflictResponseBody(body *ListSpendRuleEventsConflictResponseBody) (err error) {
+// ValidateGetSpendRulesOverviewConflictResponseBody runs the validations
+// defined on getSpendRulesOverview_conflict_response_body
+func ValidateGetSpendRulesOverviewConflictResponseBody(body *GetSpendRulesOverviewConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5640,9 +7148,9 @@ func ValidateListSpendRuleEventsConflictResponseBody(body *ListSpendRuleEventsCo
 	return
 }
 
-// ValidateListSpendRuleEventsUnsupportedMediaResponseBody runs the validations
-// defined on listSpendRuleEvents_unsupported_media_response_body
-func ValidateListSpendRuleEventsUnsupportedMediaResponseBody(body *ListSpendRuleEventsUnsupportedMediaResponseBody) (err error) {
+// ValidateGetSpendRulesOverviewUnsupportedMediaResponseBody runs the
+// validations defined on getSpendRulesOverview_unsupported_media_response_body
+func ValidateGetSpendRulesOverviewUnsupportedMediaResponseBody(body *GetSpendRulesOverviewUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5664,9 +7172,9 @@ func ValidateListSpendRuleEventsUnsupportedMediaResponseBody(body *ListSpendRule
 	return
 }
 
-// ValidateListSpendRuleEventsInvalidResponseBody runs the validations defined
-// on listSpendRuleEvents_invalid_response_body
-func ValidateListSpendRuleEventsInvalidResponseBody(body *ListSpendRuleEventsInvalidResponseBody) (err error) {
+// ValidateGetSpendRulesOverviewInvalidResponseBody runs the validations
+// defined on getSpendRulesOverview_invalid_response_body
+func ValidateGetSpendRulesOverviewInvalidResponseBody(body *GetSpendRulesOverviewInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5688,9 +7196,10 @@ func ValidateListSpendRuleEventsInvalidResponseBody(body *ListSpendRuleEventsInv
 	return
 }
 
-// ValidateListSpendRuleEventsInvariantViolationResponseBody runs the
-// validations defined on listSpendRuleEvents_invariant_violation_response_body
-func ValidateListSpendRuleEventsInvariantViolationResponseBody(body *ListSpendRuleEventsInvariantViolationResponseBody) (err error) {
+// ValidateGetSpendRulesOverviewInvariantViolationResponseBody runs the
+// validations defined on
+// getSpendRulesOverview_invariant_violation_response_body
+func ValidateGetSpendRulesOverviewInvariantViolationResponseBody(body *GetSpendRulesOverviewInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5712,9 +7221,9 @@ func ValidateListSpendRuleEventsInvariantViolationResponseBody(body *ListSpendRu
 	return
 }
 
-// ValidateListSpendRuleEventsUnexpectedResponseBody runs the validations
-// defined on listSpendRuleEvents_unexpected_response_body
-func ValidateListSpendRuleEventsUnexpectedResponseBody(body *ListSpendRuleEventsUnexpectedResponseBody) (err error) {
+// ValidateGetSpendRulesOverviewUnexpectedResponseBody runs the validations
+// defined on getSpendRulesOverview_unexpected_response_body
+func ValidateGetSpendRulesOverviewUnexpectedResponseBody(body *GetSpendRulesOverviewUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5736,9 +7245,9 @@ func ValidateListSpendRuleEventsUnexpectedResponseBody(body *ListSpendRuleEvents
 	return
 }
 
-// ValidateListSpendRuleEventsGatewayErrorResponseBody runs the validations
-// defined on listSpendRuleEvents_gateway_error_response_body
-func ValidateListSpendRuleEventsGatewayErrorResponseBody(body *ListSpendRuleEventsGatewayErrorResponseBody) (err error) {
+// ValidateGetSpendRulesOverviewGatewayErrorResponseBody runs the validations
+// defined on getSpendRulesOverview_gateway_error_response_body
+func ValidateGetSpendRulesOverviewGatewayErrorResponseBody(body *GetSpendRulesOverviewGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5760,9 +7269,9 @@ func ValidateListSpendRuleEventsGatewayErrorResponseBody(body *ListSpendRuleEven
 	return
 }
 
-// ValidateGetSpendRulesOverviewUnauthorizedResponseBody runs the validations
-// defined on getSpendRulesOverview_unauthorized_response_body
-func ValidateGetSpendRulesOverviewUnauthorizedResponseBody(body *GetSpendRulesOverviewUnauthorizedResponseBody) (err error) {
+// ValidateListActorAttributesUnauthorizedResponseBody runs the validations
+// defined on listActorAttributes_unauthorized_response_body
+func ValidateListActorAttributesUnauthorizedResponseBody(body *ListActorAttributesUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5784,9 +7293,9 @@ func ValidateGetSpendRulesOverviewUnauthorizedResponseBody(body *GetSpendRulesOv
 	return
 }
 
-// ValidateGetSpendRulesOverviewForbiddenResponseBody runs the validations
-// defined on getSpendRulesOverview_forbidden_response_body
-func ValidateGetSpendRulesOverviewForbiddenResponseBody(body *GetSpendRulesOverviewForbiddenResponseBody) (err error) {
+// ValidateListActorAttributesForbiddenResponseBody runs the validations
+// defined on listActorAttributes_forbidden_response_body
+func ValidateListActorAttributesForbiddenResponseBody(body *ListActorAttributesForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5808,9 +7317,9 @@ func ValidateGetSpendRulesOverviewForbiddenResponseBody(body *GetSpendRulesOverv
 	return
 }
 
-// ValidateGetSpendRulesOverviewBadRequestResponseBody runs the validations
-// defined on getSpendRulesOverview_bad_request_response_body
-func ValidateGetSpendRulesOverviewBadRequestResponseBody(body *GetSpendRulesOverviewBadRequestResponseBody) (err error) {
+// ValidateListActorAttributesBadRequestResponseBody runs the validations
+// defined on listActorAttributes_bad_request_response_body
+func ValidateListActorAttributesBadRequestResponseBody(body *ListActorAttributesBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5832,9 +7341,9 @@ func ValidateGetSpendRulesOverviewBadRequestResponseBody(body *GetSpendRulesOver
 	return
 }
 
-// ValidateGetSpendRulesOverviewNotFoundResponseBody runs the validations
-// defined on getSpendRulesOverview_not_found_response_body
-func ValidateGetSpendRulesOverviewNotFoundResponseBody(body *GetSpendRulesOverviewNotFoundResponseBody) (err error) {
+// ValidateListActorAttributesNotFoundResponseBody runs the validations defined
+// on listActorAttributes_not_found_response_body
+func ValidateListActorAttributesNotFoundResponseBody(body *ListActorAttributesNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5856,9 +7365,9 @@ func ValidateGetSpendRulesOverviewNotFoundResponseBody(body *GetSpendRulesOvervi
 	return
 }
 
-// ValidateGetSpendRulesOverviewConflictResponseBody runs the validations
-// defined on getSpendRulesOverview_conflict_response_body
-func ValidateGetSpendRulesOverviewConflictResponseBody(body *GetSpendRulesOverviewConflictResponseBody) (err error) {
+// ValidateListActorAttributesConflictResponseBody runs the validations defined
+// on listActorAttributes_conflict_response_body
+func ValidateListActorAttributesConflictResponseBody(body *ListActorAttributesConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5880,9 +7389,9 @@ func ValidateGetSpendRulesOverviewConflictResponseBody(body *GetSpendRulesOvervi
 	return
 }
 
-// ValidateGetSpendRulesOverviewUnsupportedMediaResponseBody runs the
-// validations defined on getSpendRulesOverview_unsupported_media_response_body
-func ValidateGetSpendRulesOverviewUnsupportedMediaResponseBody(body *GetSpendRulesOverviewUnsupportedMediaResponseBody) (err error) {
+// ValidateListActorAttributesUnsupportedMediaResponseBody runs the validations
+// defined on listActorAttributes_unsupported_media_response_body
+func ValidateListActorAttributesUnsupportedMediaResponseBody(body *ListActorAttributesUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5904,9 +7413,9 @@ func ValidateGetSpendRulesOverviewUnsupportedMediaResponseBody(body *GetSpendRul
 	return
 }
 
-// ValidateGetSpendRulesOverviewInvalidResponseBody runs the validations
-// defined on getSpendRulesOverview_invalid_response_body
-func ValidateGetSpendRulesOverviewInvalidResponseBody(body *GetSpendRulesOverviewInvalidResponseBody) (err error) {
+// ValidateListActorAttributesInvalidResponseBody runs the validations defined
+// on listActorAttributes_invalid_response_body
+func ValidateListActorAttributesInvalidResponseBody(body *ListActorAttributesInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5928,10 +7437,9 @@ func ValidateGetSpendRulesOverviewInvalidResponseBody(body *GetSpendRulesOvervie
 	return
 }
 
-// ValidateGetSpendRulesOverviewInvariantViolationResponseBody runs the
-// validations defined on
-// getSpendRulesOverview_invariant_violation_response_body
-func ValidateGetSpendRulesOverviewInvariantViolationResponseBody(body *GetSpendRulesOverviewInvariantViolationResponseBody) (err error) {
+// ValidateListActorAttributesInvariantViolationResponseBody runs the
+// validations defined on listActorAttributes_invariant_violation_response_body
+func ValidateListActorAttributesInvariantViolationResponseBody(body *ListActorAttributesInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5953,9 +7461,9 @@ func ValidateGetSpendRulesOverviewInvariantViolationResponseBody(body *GetSpendR
 	return
 }
 
-// ValidateGetSpendRulesOverviewUnexpectedResponseBody runs the validations
-// defined on getSpendRulesOverview_unexpected_response_body
-func ValidateGetSpendRulesOverviewUnexpectedResponseBody(body *GetSpendRulesOverviewUnexpectedResponseBody) (err error) {
+// ValidateListActorAttributesUnexpectedResponseBody runs the validations
+// defined on listActorAttributes_unexpected_response_body
+func ValidateListActorAttributesUnexpectedResponseBody(body *ListActorAttributesUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -5977,9 +7485,9 @@ func ValidateGetSpendRulesOverviewUnexpectedResponseBody(body *GetSpendRulesOver
 	return
 }
 
-// ValidateGetSpendRulesOverviewGatewayErrorResponseBody runs the validations
-// defined on getSpendRulesOverview_gateway_error_response_body
-func ValidateGetSpendRulesOverviewGatewayErrorResponseBody(body *GetSpendRulesOverviewGatewayErrorResponseBody) (err error) {
+// ValidateListActorAttributesGatewayErrorResponseBody runs the validations
+// defined on listActorAttributes_gateway_error_response_body
+func ValidateListActorAttributesGatewayErrorResponseBody(body *ListActorAttributesGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -6001,9 +7509,9 @@ func ValidateGetSpendRulesOverviewGatewayErrorResponseBody(body *GetSpendRulesOv
 	return
 }
 
-// ValidateListActorAttributesUnauthorizedResponseBody runs the validations
-// defined on listActorAttributes_unauthorized_response_body
-func ValidateListActorAttributesUnauthorizedResponseBody(body *ListActorAttributesUnauthorizedResponseBody) (err error) {
+// ValidateListUsageDimensionsUnauthorizedResponseBody runs the validations
+// defined on listUsageDimensions_unauthorized_response_body
+func ValidateListUsageDimensionsUnauthorizedResponseBody(body *ListUsageDimensionsUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -6025,9 +7533,9 @@ func ValidateListActorAttributesUnauthorizedResponseBody(body *ListActorAttribut
 	return
 }
 
-// ValidateListActorAttributesForbiddenResponseBody runs the validations
-// defined on listActorAttributes_forbidden_response_body
-func ValidateListActorAttributesForbiddenResponseBody(body *ListActorAttributesForbiddenResponseBody) (err error) {
+// ValidateListUsageDimensionsForbiddenResponseBody runs the validations
+// defined on listUsageDimensions_forbidden_response_body
+func ValidateListUsageDimensionsForbiddenResponseBody(body *ListUsageDimensionsForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -6049,9 +7557,9 @@ func ValidateListActorAttributesForbiddenResponseBody(body *ListActorAttributesF
 	return
 }
 
-// ValidateListActorAttributesBadRequestResponseBody runs the validations
-// defined on listActorAttributes_bad_request_response_body
-func ValidateListActorAttributesBadRequestResponseBody(body *ListActorAttributesBadRequestResponseBody) (err error) {
+// ValidateListUsageDimensionsBadRequestResponseBody runs the validations
+// defined on listUsageDimensions_bad_request_response_body
+func ValidateListUsageDimensionsBadRequestResponseBody(body *ListUsageDimensionsBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -6073,9 +7581,9 @@ func ValidateListActorAttributesBadRequestResponseBody(body *ListActorAttributes
 	return
 }
 
-// ValidateListActorAttributesNotFoundResponseBody runs the validations defined
-// on listActorAttributes_not_found_response_body
-func ValidateListActorAttributesNotFoundResponseBody(body *ListActorAttributesNotFoundResponseBody) (err error) {
+// ValidateListUsageDimensionsNotFoundResponseBody runs the validations defined
+// on listUsageDimensions_not_found_response_body
+func ValidateListUsageDimensionsNotFoundResponseBody(body *ListUsageDimensionsNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -6097,9 +7605,9 @@ func ValidateListActorAttributesNotFoundResponseBody(body *ListActorAttributesNo
 	return
 }
 
-// ValidateListActorAttributesConflictResponseBody runs the validations defined
-// on listActorAttributes_conflict_response_body
-func ValidateListActorAttributesConflictResponseBody(body *ListActorAttributesConflictResponseBody) (err error) {
+// ValidateListUsageDimensionsConflictResponseBody runs the validations defined
+// on listUsageDimensions_conflict_response_body
+func ValidateListUsageDimensionsConflictResponseBody(body *ListUsageDimensionsConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -6121,9 +7629,9 @@ func ValidateListActorAttributesConflictResponseBody(body *ListActorAttributesCo
 	return
 }
 
-// ValidateListActorAttributesUnsupportedMediaResponseBody runs the validations
-// defined on listActorAttributes_unsupported_media_response_body
-func ValidateListActorAttributesUnsupportedMediaResponseBody(body *ListActorAttributesUnsupportedMediaResponseBody) (err error) {
+// ValidateListUsageDimensionsUnsupportedMediaResponseBody runs the validations
+// defined on listUsageDimensions_unsupported_media_response_body
+func ValidateListUsageDimensionsUnsupportedMediaResponseBody(body *ListUsageDimensionsUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -6145,9 +7653,9 @@ func ValidateListActorAttributesUnsupportedMediaResponseBody(body *ListActorAttr
 	return
 }
 
-// ValidateListActorAttributesInvalidResponseBody runs the validations defined
-// on listActorAttributes_invalid_response_body
-func ValidateListActorAttributesInvalidResponseBody(body *ListActorAttributesInvalidResponseBody) (err error) {
+// ValidateListUsageDimensionsInvalidResponseBody runs the validations defined
+// on listUsageDimensions_invalid_response_body
+func ValidateListUsageDimensionsInvalidResponseBody(body *ListUsageDimensionsInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -6169,9 +7677,9 @@ func ValidateListActorAttributesInvalidResponseBody(body *ListActorAttributesInv
 	return
 }
 
-// ValidateListActorAttributesInvariantViolationResponseBody runs the
-// validations defined on listActorAttributes_invariant_violation_response_body
-func ValidateListActorAttributesInvariantViolationResponseBody(body *ListActorAttributesInvariantViolationResponseBody) (err error) {
+// ValidateListUsageDimensionsInvariantViolationResponseBody runs the
+// validations defined on listUsageDimensions_invariant_violation_response_body
+func ValidateListUsageDimensionsInvariantViolationResponseBody(body *ListUsageDimensionsInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -6193,9 +7701,9 @@ func ValidateListActorAttributesInvariantViolationResponseBody(body *ListActorAt
 	return
 }
 
-// ValidateListActorAttributesUnexpectedResponseBody runs the validations
-// defined on listActorAttributes_unexpected_response_body
-func ValidateListActorAttributesUnexpectedResponseBody(body *ListActorAttributesUnexpectedResponseBody) (err error) {
+// ValidateListUsageDimensionsUnexpectedResponseBody runs the validations
+// defined on listUsageDimensions_unexpected_response_body
+func ValidateListUsageDimensionsUnexpectedResponseBody(body *ListUsageDimensionsUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -6217,9 +7725,9 @@ func ValidateListActorAttributesUnexpectedResponseBody(body *ListActorAttributes
 	return
 }
 
-// ValidateListActorAttributesGatewayErrorResponseBody runs the validations
-// defined on listActorAttributes_gateway_error_response_body
-func ValidateListActorAttributesGatewayErrorResponseBody(body *ListActorAttributesGatewayErrorResponseBody) (err error) {
+// ValidateListUsageDimensionsGatewayErrorResponseBody runs the validations
+// defined on listUsageDimensions_gateway_error_response_body
+func ValidateListUsageDimensionsGatewayErrorResponseBody(body *ListUsageDimensionsGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -6256,6 +7764,21 @@ func ValidateSpendRuleTargetConditionResponseBody(body *SpendRuleTargetCondition
 	return
 }
 
+// ValidateSpendRuleScopeConditionResponseBody runs the validations defined on
+// SpendRuleScopeConditionResponseBody
+func ValidateSpendRuleScopeConditionResponseBody(body *SpendRuleScopeConditionResponseBody) (err error) {
+	if body.Dimension == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dimension", "body"))
+	}
+	if body.Operator == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operator", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	return
+}
+
 // ValidateSpendRuleResponseBody runs the validations defined on
 // SpendRuleResponseBody
 func ValidateSpendRuleResponseBody(body *SpendRuleResponseBody) (err error) {
@@ -6283,6 +7806,9 @@ func ValidateSpendRuleResponseBody(body *SpendRuleResponseBody) (err error) {
 	if body.TargetExpr == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("target_expr", "body"))
 	}
+	if body.ScopeExpr == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scope_expr", "body"))
+	}
 	if body.RuleExpr == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("rule_expr", "body"))
 	}
@@ -6318,6 +7844,11 @@ func ValidateSpendRuleResponseBody(body *SpendRuleResponseBody) (err error) {
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Scope != nil {
+		if err2 := ValidateSpendRuleScopeConditionResponseBody(body.Scope); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	if body.WindowKind != nil {
 		if !(*body.WindowKind == "daily" || *body.WindowKind == "weekly" || *body.WindowKind == "monthly") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.window_kind", *body.WindowKind, []any{"daily", "weekly", "monthly"}))
@@ -6358,6 +7889,94 @@ func ValidateSpendRuleActorUsageResponseBody(body *SpendRuleActorUsageResponseBo
 	return
 }
 
+// ValidateSpendRuleSimulationWindowResponseBody runs the validations defined
+// on SpendRuleSimulationWindowResponseBody
+func ValidateSpendRuleSimulationWindowResponseBody(body *SpendRuleSimulationWindowResponseBody) (err error) {
+	if body.WindowStart == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("window_start", "body"))
+	}
+	if body.WindowEnd == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("window_end", "body"))
+	}
+	if body.InProgress == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("in_progress", "body"))
+	}
+	if body.SpendUsd == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("spend_usd", "body"))
+	}
+	if body.UsersWarned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("users_warned", "body"))
+	}
+	if body.UsersBreached == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("users_breached", "body"))
+	}
+	if body.WindowStart != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.window_start", *body.WindowStart, goa.FormatDateTime))
+	}
+	if body.WindowEnd != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.window_end", *body.WindowEnd, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSpendRuleSimulationActorResponseBody runs the validations defined on
+// SpendRuleSimulationActorResponseBody
+func ValidateSpendRuleSimulationActorResponseBody(body *SpendRuleSimulationActorResponseBody) (err error) {
+	if body.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
+	}
+	if body.WindowsWarned == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("windows_warned", "body"))
+	}
+	if body.WindowsBreached == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("windows_breached", "body"))
+	}
+	if body.Timeline == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeline", "body"))
+	}
+	for _, e := range body.Timeline {
+		if e != nil {
+			if err2 := ValidateSpendRuleSimulationActorWindowResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSpendRuleSimulationActorWindowResponseBody runs the validations
+// defined on SpendRuleSimulationActorWindowResponseBody
+func ValidateSpendRuleSimulationActorWindowResponseBody(body *SpendRuleSimulationActorWindowResponseBody) (err error) {
+	if body.WindowStart == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("window_start", "body"))
+	}
+	if body.WindowEnd == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("window_end", "body"))
+	}
+	if body.SpendUsd == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("spend_usd", "body"))
+	}
+	if body.UsedPct == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("used_pct", "body"))
+	}
+	if body.Blocked == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("blocked", "body"))
+	}
+	if body.WindowStart != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.window_start", *body.WindowStart, goa.FormatDateTime))
+	}
+	if body.WindowEnd != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.window_end", *body.WindowEnd, goa.FormatDateTime))
+	}
+	if body.WarnedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.warned_at", *body.WarnedAt, goa.FormatDateTime))
+	}
+	if body.BreachedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.breached_at", *body.BreachedAt, goa.FormatDateTime))
+	}
+	return
+}
+
 // ValidateSpendRuleEventResponseBody runs the validations defined on
 // SpendRuleEventResponseBody
 func ValidateSpendRuleEventResponseBody(body *SpendRuleEventResponseBody) (err error) {
@@ -6474,3 +8093,15 @@ func ValidateActorAttributeResponseBody(body *ActorAttributeResponseBody) (err e
 	}
 	return
 }
+
+// ValidateUsageDimensionResponseBody runs the validations defined on
+// UsageDimensionResponseBody
+func ValidateUsageDimensionResponseBody(body *UsageDimensionResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	return
+}
@@ -1410,6 +1410,243 @@ func EncodePreviewSpendRuleError(encoder func(context.Context, http.ResponseWrit
 	}
 }
 
+// EncodeSimulateSpendRuleResponse returns an encoder for responses returned by
+// the spendRules simulateSpendRule endpoint.
+func EncodeSimulateSpendRuleResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*spendrules.SimulateSpendRuleResult)
+		enc := encoder(ctx, w)
+		body := NewSimulateSpendRuleResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSimulateSpendRuleRequest returns a decoder for requests sent to the
+// spendRules simulateSpendRule endpoint.
+func DecodeSimulateSpendRuleRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*spendrules.SimulateSpendRulePayload, error) {
+	return func(r *http.Request) (*spendrules.SimulateSpendRulePayload, error) {
+		var payload *spendrules.SimulateSpendRulePayload
+		var (
+			body SimulateSpendRuleRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return payload, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return payload, gerr
+			}
+			return payload, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateSimulateSpendRuleRequestBody(&body)
+		if err != nil {
+			return payload, err
+		}
+
+		var (
+			apikeyToken      *string
+			sessionToken     *string
+			projectSlugInput *string
+		)
+		apikeyTokenRaw := r.Header.Get("Gram-Key")
+		if apikeyTokenRaw != "" {
+			apikeyToken = &apikeyTokenRaw
+		}
+		sessionTokenRaw := r.Header.Get("Gram-Session")
+		if sessionTokenRaw != "" {
+			sessionToken = &sessionTokenRaw
+		}
+		projectSlugInputRaw := r.Header.Get("Gram-Project")
+		if projectSlugInputRaw != "" {
+			projectSlugInput = &projectSlugInputRaw
+		}
+		payload = NewSimulateSpendRulePayload(&body, apikeyToken, sessionToken, projectSlugInput)
+		if payload.ApikeyToken != nil {
+			if strings.Contains(*payload.ApikeyToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.ApikeyToken, " ", 2)[1]
+				payload.ApikeyToken = &cred
+			}
+		}
+		if payload.ProjectSlugInput != nil {
+			if strings.Contains(*payload.ProjectSlugInput, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.ProjectSlugInput, " ", 2)[1]
+				payload.ProjectSlugInput = &cred
+			}
+		}
+		if payload.SessionToken != nil {
+			if strings.Contains(*payload.SessionToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.SessionToken, " ", 2)[1]
+				payload.SessionToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeSimulateSpendRuleError returns an encoder for errors returned by the
+// simulateSpendRule spendRules endpoint.
+func EncodeSimulateSpendRuleError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSimulateSpendRuleUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "forbidden":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSimulateSpendRuleForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "bad_request":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSimulateSpendRuleBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSimulateSpendRuleNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "conflict":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSimulateSpendRuleConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "unsupported_media":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSimulateSpendRuleUnsupportedMediaResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return enc.Encode(body)
+		case "invalid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSimulateSpendRuleInvalidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
+		case "invariant_violation":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSimulateSpendRuleInvariantViolationResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "unexpected":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSimulateSpendRuleUnexpectedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "gateway_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSimulateSpendRuleGatewayErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadGateway)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeListSpendRuleEventsResponse returns an encoder for responses returned
 // by the spendRules listSpendRuleEvents endpoint.
 func EncodeListSpendRuleEventsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -2111,6 +2348,223 @@ func EncodeListActorAttributesError(encoder func(context.Context, http.ResponseW
 	}
 }
 
+// EncodeListUsageDimensionsResponse returns an encoder for responses returned
+// by the spendRules listUsageDimensions endpoint.
+func EncodeListUsageDimensionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*spendrules.ListUsageDimensionsResult)
+		enc := encoder(ctx, w)
+		body := NewListUsageDimensionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListUsageDimensionsRequest returns a decoder for requests sent to the
+// spendRules listUsageDimensions endpoint.
+func DecodeListUsageDimensionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*spendrules.ListUsageDimensionsPayload, error) {
+	return func(r *http.Request) (*spendrules.ListUsageDimensionsPayload, error) {
+		var payload *spendrules.ListUsageDimensionsPayload
+		var (
+			apikeyToken      *string
+			sessionToken     *string
+			projectSlugInput *string
+		)
+		apikeyTokenRaw := r.Header.Get("Gram-Key")
+		if apikeyTokenRaw != "" {
+			apikeyToken = &apikeyTokenRaw
+		}
+		sessionTokenRaw := r.Header.Get("Gram-Session")
+		if sessionTokenRaw != "" {
+			sessionToken = &sessionTokenRaw
+		}
+		projectSlugInputRaw := r.Header.Get("Gram-Project")
+		if projectSlugInputRaw != "" {
+			projectSlugInput = &projectSlugInputRaw
+		}
+		payload = NewListUsageDimensionsPayload(apikeyToken, sessionToken, projectSlugInput)
+		if payload.ApikeyToken != nil {
+			if strings.Contains(*payload.ApikeyToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.ApikeyToken, " ", 2)[1]
+				payload.ApikeyToken = &cred
+			}
+		}
+		if payload.ProjectSlugInput != nil {
+			if strings.Contains(*payload.ProjectSlugInput, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.ProjectSlugInput, " ", 2)[1]
+				payload.ProjectSlugInput = &cred
+			}
+		}
+		if payload.SessionToken != nil {
+			if strings.Contains(*payload.SessionToken, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.SessionToken, " ", 2)[1]
+				payload.SessionToken = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListUsageDimensionsError returns an encoder for errors returned by the
+// listUsageDimensions spendRules endpoint.
+func EncodeListUsageDimensionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthorized":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsageDimensionsUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		case "forbidden":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsageDimensionsForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "bad_request":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsageDimensionsBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsageDimensionsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "conflict":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsageDimensionsConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "unsupported_media":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsageDimensionsUnsupportedMediaResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return enc.Encode(body)
+		case "invalid":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsageDimensionsInvalidResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
+		case "invariant_violation":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsageDimensionsInvariantViolationResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "unexpected":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsageDimensionsUnexpectedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "gateway_error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsageDimensionsGatewayErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadGateway)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // unmarshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition
 // builds a value of type *types.SpendRuleTargetCondition from a value of type
 // *SpendRuleTargetConditionRequestBody.
@@ -2124,6 +2578,22 @@ func unmarshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition
 	return res
 }
 
+// unmarshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition
+// builds a value of type *types.SpendRuleScopeCondition from a value of type
+// *SpendRuleScopeConditionRequestBody.
+func unmarshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition(v *SpendRuleScopeConditionRequestBody) *types.SpendRuleScopeCondition {
+	if v == nil {
+		return nil
+	}
+	res := &types.SpendRuleScopeCondition{
+		Dimension: *v.Dimension,
+		Operator:  *v.Operator,
+		Value:     *v.Value,
+	}
+
+	return res
+}
+
 // marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionResponseBody
 // builds a value of type *SpendRuleTargetConditionResponseBody from a value of
 // type *types.SpendRuleTargetCondition.
@@ -2137,6 +2607,22 @@ func marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionResponseBody(
 	return res
 }
 
+// marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionResponseBody
+// builds a value of type *SpendRuleScopeConditionResponseBody from a value of
+// type *types.SpendRuleScopeCondition.
+func marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionResponseBody(v *types.SpendRuleScopeCondition) *SpendRuleScopeConditionResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &SpendRuleScopeConditionResponseBody{
+		Dimension: v.Dimension,
+		Operator:  v.Operator,
+		Value:     v.Value,
+	}
+
+	return res
+}
+
 // marshalTypesSpendRuleToSpendRuleResponseBody builds a value of type
 // *SpendRuleResponseBody from a value of type *types.SpendRule.
 func marshalTypesSpendRuleToSpendRuleResponseBody(v *types.SpendRule) *SpendRuleResponseBody {
@@ -2148,6 +2634,7 @@ func marshalTypesSpendRuleToSpendRuleResponseBody(v *types.SpendRule) *SpendRule
 		Slug:           v.Slug,
 		Description:    v.Description,
 		TargetExpr:     v.TargetExpr,
+		ScopeExpr:      v.ScopeExpr,
 		RuleExpr:       v.RuleExpr,
 		LimitUsd:       v.LimitUsd,
 		WindowKind:     v.WindowKind,
@@ -2161,6 +2648,9 @@ func marshalTypesSpendRuleToSpendRuleResponseBody(v *types.SpendRule) *SpendRule
 	if v.Target != nil {
 		res.Target = marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionResponseBody(v.Target)
 	}
+	if v.Scope != nil {
+		res.Scope = marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionResponseBody(v.Scope)
+	}
 
 	return res
 }
@@ -2182,6 +2672,66 @@ func marshalSpendrulesSpendRuleActorUsageToSpendRuleActorUsageResponseBody(v *sp
 	return res
 }
 
+// marshalSpendrulesSpendRuleSimulationWindowToSpendRuleSimulationWindowResponseBody
+// builds a value of type *SpendRuleSimulationWindowResponseBody from a value
+// of type *spendrules.SpendRuleSimulationWindow.
+func marshalSpendrulesSpendRuleSimulationWindowToSpendRuleSimulationWindowResponseBody(v *spendrules.SpendRuleSimulationWindow) *SpendRuleSimulationWindowResponseBody {
+	res := &SpendRuleSimulationWindowResponseBody{
+		WindowStart:   v.WindowStart,
+		WindowEnd:     v.WindowEnd,
+		InProgress:    v.InProgress,
+		SpendUsd:      v.SpendUsd,
+		UsersWarned:   v.UsersWarned,
+		UsersBreached: v.UsersBreached,
+	}
+
+	return res
+}
+
+// marshalSpendrulesSpendRuleSimulationActorToSpendRuleSimulationActorResponseBody
+// builds a value of type *SpendRuleSimulationActorResponseBody from a value of
+// type *spendrules.SpendRuleSimulationActor.
+func marshalSpendrulesSpendRuleSimulationActorToSpendRuleSimulationActorResponseBody(v *spendrules.SpendRuleSimulationActor) *SpendRuleSimulationActorResponseBody {
+	res := &SpendRuleSimulationActorResponseBody{
+		Email:           v.Email,
+		DisplayName:     v.DisplayName,
+		UserID:          v.UserID,
+		WindowsWarned:   v.WindowsWarned,
+		WindowsBreached: v.WindowsBreached,
+	}
+	if v.Timeline != nil {
+		res.Timeline = make([]*SpendRuleSimulationActorWindowResponseBody, len(v.Timeline))
+		for i, val := range v.Timeline {
+			if val == nil {
+				res.Timeline[i] = nil
+				continue
+			}
+			res.Timeline[i] = marshalSpendrulesSpendRuleSimulationActorWindowToSpendRuleSimulationActorWindowResponseBody(val)
+		}
+	} else {
+		res.Timeline = []*SpendRuleSimulationActorWindowResponseBody{}
+	}
+
+	return res
+}
+
+// marshalSpendrulesSpendRuleSimulationActorWindowToSpendRuleSimulationActorWindowResponseBody
+// builds a value of type *SpendRuleSimulationActorWindowResponseBody from a
+// value of type *spendrules.SpendRuleSimulationActorWindow.
+func marshalSpendrulesSpendRuleSimulationActorWindowToSpendRuleSimulationActorWindowResponseBody(v *spendrules.SpendRuleSimulationActorWindow) *SpendRuleSimulationActorWindowResponseBody {
+	res := &SpendRuleSimulationActorWindowResponseBody{
+		WindowStart: v.WindowStart,
+		WindowEnd:   v.WindowEnd,
+		SpendUsd:    v.SpendUsd,
+		UsedPct:     v.UsedPct,
+		WarnedAt:    v.WarnedAt,
+		BreachedAt:  v.BreachedAt,
+		Blocked:     v.Blocked,
+	}
+
+	return res
+}
+
 // marshalSpendrulesSpendRuleEventToSpendRuleEventResponseBody builds a value
 // of type *SpendRuleEventResponseBody from a value of type
 // *spendrules.SpendRuleEvent.
@@ -2235,3 +2785,15 @@ func marshalSpendrulesActorAttributeToActorAttributeResponseBody(v *spendrules.A
 
 	return res
 }
+
+// marshalSpendrulesUsageDimensionToUsageDimensionResponseBody builds a value
+// of type *UsageDimensionResponseBody from a value of type
+// *spendrules.UsageDimension.
+func marshalSpendrulesUsageDimensionToUsageDimensionResponseBody(v *spendrules.UsageDimension) *UsageDimensionResponseBody {
+	res := &UsageDimensionResponseBody{
+		Name:        v.Name,
+		Description: v.Description,
+	}
+
+	return res
+}
@@ -37,6 +37,11 @@ func PreviewSpendRuleSpendRulesPath() string {
 	return "/rpc/spendrules.previewRule"
 }
 
+// SimulateSpendRuleSpendRulesPath returns the URL path to the spendRules service simulateSpendRule HTTP endpoint.
+func SimulateSpendRuleSpendRulesPath() string {
+	return "/rpc/spendrules.simulateRule"
+}
+
 // ListSpendRuleEventsSpendRulesPath returns the URL path to the spendRules service listSpendRuleEvents HTTP endpoint.
 func ListSpendRuleEventsSpendRulesPath() string {
 	return "/rpc/spendrules.listEvents"
@@ -51,3 +56,8 @@ func GetSpendRulesOverviewSpendRulesPath() string {
 func ListActorAttributesSpendRulesPath() string {
 	return "/rpc/spendrules.listActorAttributes"
 }
+
+// ListUsageDimensionsSpendRulesPath returns the URL path to the spendRules service listUsageDimensions HTTP endpoint.
+func ListUsageDimensionsSpendRulesPath() string {
+	return "/rpc/spendrules.listUsageDimensions"
+}
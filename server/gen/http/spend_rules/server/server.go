@@ -25,9 +25,11 @@ type Server struct {
 	UpdateSpendRule       http.Handler
 	ArchiveSpendRule      http.Handler
 	PreviewSpendRule      http.Handler
+	SimulateSpendRule     http.Handler
 	ListSpendRuleEvents   http.Handler
 	GetSpendRulesOverview http.Handler
 	ListActorAttributes   http.Handler
+	ListUsageDimensions   http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -63,9 +65,11 @@ func New(
 			{"UpdateSpendRule", "PUT", "/rpc/spendrules.updateRule"},
 			{"ArchiveSpendRule", "POST", "/rpc/spendrules.archiveRule"},
 			{"PreviewSpendRule", "POST", "/rpc/spendrules.previewRule"},
+			{"SimulateSpendRule", "POST", "/rpc/spendrules.simulateRule"},
 			{"ListSpendRuleEvents", "GET", "/rpc/spendrules.listEvents"},
 			{"GetSpendRulesOverview", "GET", "/rpc/spendrules.getOverview"},
 			{"ListActorAttributes", "GET", "/rpc/spendrules.listActorAttributes"},
+			{"ListUsageDimensions", "GET", "/rpc/spendrules.listUsageDimensions"},
 		},
 		CreateSpendRule:       NewCreateSpendRuleHandler(e.CreateSpendRule, mux, decoder, encoder, errhandler, formatter),
 		ListSpendRules:        NewListSpendRulesHandler(e.ListSpendRules, mux, decoder, encoder, errhandler, formatter),
@@ -73,9 +77,11 @@ func New(
 		UpdateSpendRule:       NewUpdateSpendRuleHandler(e.UpdateSpendRule, mux, decoder, encoder, errhandler, formatter),
 		ArchiveSpendRule:      NewArchiveSpendRuleHandler(e.ArchiveSpendRule, mux, decoder, encoder, errhandler, formatter),
 		PreviewSpendRule:      NewPreviewSpendRuleHandler(e.PreviewSpendRule, mux, decoder, encoder, errhandler, formatter),
+		SimulateSpendRule:     NewSimulateSpendRuleHandler(e.SimulateSpendRule, mux, decoder, encoder, errhandler, formatter),
 		ListSpendRuleEvents:   NewListSpendRuleEventsHandler(e.ListSpendRuleEvents, mux, decoder, encoder, errhandler, formatter),
 		GetSpendRulesOverview: NewGetSpendRulesOverviewHandler(e.GetSpendRulesOverview, mux, decoder, encoder, errhandler, formatter),
 		ListActorAttributes:   NewListActorAttributesHandler(e.ListActorAttributes, mux, decoder, encoder, errhandler, formatter),
+		ListUsageDimensions:   NewListUsageDimensionsHandler(e.ListUsageDimensions, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -90,9 +96,11 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.UpdateSpendRule = m(s.UpdateSpendRule)
 	s.ArchiveSpendRule = m(s.ArchiveSpendRule)
 	s.PreviewSpendRule = m(s.PreviewSpendRule)
+	s.SimulateSpendRule = m(s.SimulateSpendRule)
 	s.ListSpendRuleEvents = m(s.ListSpendRuleEvents)
 	s.GetSpendRulesOverview = m(s.GetSpendRulesOverview)
 	s.ListActorAttributes = m(s.ListActorAttributes)
+	s.ListUsageDimensions = m(s.ListUsageDimensions)
 }
 
 // MethodNames returns the methods served.
@@ -106,9 +114,11 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountUpdateSpendRuleHandler(mux, h.UpdateSpendRule)
 	MountArchiveSpendRuleHandler(mux, h.ArchiveSpendRule)
 	MountPreviewSpendRuleHandler(mux, h.PreviewSpendRule)
+	MountSimulateSpendRuleHandler(mux, h.SimulateSpendRule)
 	MountListSpendRuleEventsHandler(mux, h.ListSpendRuleEvents)
 	MountGetSpendRulesOverviewHandler(mux, h.GetSpendRulesOverview)
 	MountListActorAttributesHandler(mux, h.ListActorAttributes)
+	MountListUsageDimensionsHandler(mux, h.ListUsageDimensions)
 }
 
 // Mount configures the mux to serve the spendRules endpoints.
@@ -434,6 +444,59 @@ func NewPreviewSpendRuleHandler(
 	})
 }
 
+// MountSimulateSpendRuleHandler configures the mux to serve the "spendRules"
+// service "simulateSpendRule" endpoint.
+func MountSimulateSpendRuleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/spendrules.simulateRule", f)
+}
+
+// NewSimulateSpendRuleHandler creates a HTTP handler which loads the HTTP
+// request and calls the "spendRules" service "simulateSpendRule" endpoint.
+func NewSimulateSpendRuleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSimulateSpendRuleRequest(mux, decoder)
+		encodeResponse = EncodeSimulateSpendRuleResponse(encoder)
+		encodeError    = EncodeSimulateSpendRuleError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "simulateSpendRule")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "spendRules")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountListSpendRuleEventsHandler configures the mux to serve the "spendRules"
 // service "listSpendRuleEvents" endpoint.
 func MountListSpendRuleEventsHandler(mux goahttp.Muxer, h http.Handler) {
@@ -592,3 +655,56 @@ func NewListActorAttributesHandler(
 		}
 	})
 }
+
+// MountListUsageDimensionsHandler configures the mux to serve the "spendRules"
+// service "listUsageDimensions" endpoint.
+func MountListUsageDimensionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/rpc/spendrules.listUsageDimensions", f)
+}
+
+// NewListUsageDimensionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "spendRules" service "listUsageDimensions" endpoint.
+func NewListUsageDimensionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListUsageDimensionsRequest(mux, decoder)
+		encodeResponse = EncodeListUsageDimensionsResponse(encoder)
+		encodeError    = EncodeListUsageDimensionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listUsageDimensions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "spendRules")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
@@ -22,6 +22,9 @@ type CreateSpendRuleRequestBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	// Structured member-attribute condition selecting who the rule applies to.
 	Target *SpendRuleTargetConditionRequestBody `form:"target,omitempty" json:"target,omitempty" xml:"target,omitempty"`
+	// Optional usage-dimension condition selecting which spend counts toward the
+	// limit. Omit to count all spend.
+	Scope *SpendRuleScopeConditionRequestBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
 	// Per-person budget in USD for one window.
 	LimitUsd *float64 `form:"limit_usd,omitempty" json:"limit_usd,omitempty" xml:"limit_usd,omitempty"`
 	// UTC calendar window the budget covers.
@@ -46,6 +49,12 @@ type UpdateSpendRuleRequestBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	// Structured member-attribute condition. Omit to preserve the current target.
 	Target *SpendRuleTargetConditionRequestBody `form:"target,omitempty" json:"target,omitempty" xml:"target,omitempty"`
+	// Usage-dimension condition selecting which spend counts toward the limit.
+	// Omit to preserve the current scope.
+	Scope *SpendRuleScopeConditionRequestBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Remove the rule's scope so all spend counts toward the limit. Ignored when
+	// scope is supplied.
+	ClearScope *bool `form:"clear_scope,omitempty" json:"clear_scope,omitempty" xml:"clear_scope,omitempty"`
 	// Per-person budget in USD for one window. Omit to preserve the current limit.
 	LimitUsd *float64 `form:"limit_usd,omitempty" json:"limit_usd,omitempty" xml:"limit_usd,omitempty"`
 	// UTC calendar window the budget covers. Omit to preserve the current window.
@@ -71,6 +80,9 @@ type ArchiveSpendRuleRequestBody struct {
 type PreviewSpendRuleRequestBody struct {
 	// Structured member-attribute condition to preview.
 	Target *SpendRuleTargetConditionRequestBody `form:"target,omitempty" json:"target,omitempty" xml:"target,omitempty"`
+	// Optional usage-dimension condition selecting which spend counts toward the
+	// limit.
+	Scope *SpendRuleScopeConditionRequestBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
 	// Per-person budget in USD used to compute usage percentages.
 	LimitUsd *float64 `form:"limit_usd,omitempty" json:"limit_usd,omitempty" xml:"limit_usd,omitempty"`
 	// Percentage of the limit at which a warning event is emitted.
@@ -79,6 +91,28 @@ type PreviewSpendRuleRequestBody struct {
 	WindowKind *string `form:"window_kind,omitempty" json:"window_kind,omitempty" xml:"window_kind,omitempty"`
 }
 
+// SimulateSpendRuleRequestBody is the type of the "spendRules" service
+// "simulateSpendRule" endpoint HTTP request body.
+type SimulateSpendRuleRequestBody struct {
+	// Structured member-attribute condition to simulate.
+	Target *SpendRuleTargetConditionRequestBody `form:"target,omitempty" json:"target,omitempty" xml:"target,omitempty"`
+	// Optional usage-dimension condition selecting which spend counts toward the
+	// limit.
+	Scope *SpendRuleScopeConditionRequestBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Per-person budget in USD for one window.
+	LimitUsd *float64 `form:"limit_usd,omitempty" json:"limit_usd,omitempty" xml:"limit_usd,omitempty"`
+	// Percentage of the limit at which a warning event is emitted.
+	WarnAtPct *int `form:"warn_at_pct,omitempty" json:"warn_at_pct,omitempty" xml:"warn_at_pct,omitempty"`
+	// UTC calendar window the budget covers.
+	WindowKind *string `form:"window_kind,omitempty" json:"window_kind,omitempty" xml:"window_kind,omitempty"`
+	// Rule action to simulate: flag (record events only) or block (deny agent
+	// traffic on breach).
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+	// Number of most recent windows to replay, including the current in-progress
+	// window.
+	Windows *int `form:"windows,omitempty" json:"windows,omitempty" xml:"windows,omitempty"`
+}
+
 // CreateSpendRuleResponseBody is the type of the "spendRules" service
 // "createSpendRule" endpoint HTTP response body.
 type CreateSpendRuleResponseBody struct {
@@ -102,6 +136,12 @@ type CreateSpendRuleResponseBody struct {
 	// CEL boolean expression over member attributes (email, directory attributes,
 	// groups, roles) selecting who the rule applies to.
 	TargetExpr string `form:"target_expr" json:"target_expr" xml:"target_expr"`
+	// Structured usage-dimension condition selecting which spend counts toward the
+	// limit. Absent when all spend counts.
+	Scope *SpendRuleScopeConditionResponseBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// CEL boolean expression over usage dimensions (model, model_family,
+	// assistant_id, project_id, toolset_slug). Empty when all spend counts.
+	ScopeExpr string `form:"scope_expr" json:"scope_expr" xml:"scope_expr"`
 	// CEL boolean expression over actor usage that identifies a budget breach for
 	// matched members.
 	RuleExpr string `form:"rule_expr" json:"rule_expr" xml:"rule_expr"`
@@ -155,6 +195,12 @@ type GetSpendRuleResponseBody struct {
 	// CEL boolean expression over member attributes (email, directory attributes,
 	// groups, roles) selecting who the rule applies to.
 	TargetExpr string `form:"target_expr" json:"target_expr" xml:"target_expr"`
+	// Structured usage-dimension condition selecting which spend counts toward the
+	// limit. Absent when all spend counts.
+	Scope *SpendRuleScopeConditionResponseBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// CEL boolean expression over usage dimensions (model, model_family,
+	// assistant_id, project_id, toolset_slug). Empty when all spend counts.
+	ScopeExpr string `form:"scope_expr" json:"scope_expr" xml:"scope_expr"`
 	// CEL boolean expression over actor usage that identifies a budget breach for
 	// matched members.
 	RuleExpr string `form:"rule_expr" json:"rule_expr" xml:"rule_expr"`
@@ -201,6 +247,12 @@ type UpdateSpendRuleResponseBody struct {
 	// CEL boolean expression over member attributes (email, directory attributes,
 	// groups, roles) selecting who the rule applies to.
 	TargetExpr string `form:"target_expr" json:"target_expr" xml:"target_expr"`
+	// Structured usage-dimension condition selecting which spend counts toward the
+	// limit. Absent when all spend counts.
+	Scope *SpendRuleScopeConditionResponseBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// CEL boolean expression over usage dimensions (model, model_family,
+	// assistant_id, project_id, toolset_slug). Empty when all spend counts.
+	ScopeExpr string `form:"scope_expr" json:"scope_expr" xml:"scope_expr"`
 	// CEL boolean expression over actor usage that identifies a budget breach for
 	// matched members.
 	RuleExpr string `form:"rule_expr" json:"rule_expr" xml:"rule_expr"`
@@ -238,6 +290,21 @@ type PreviewSpendRuleResponseBody struct {
 	Actors []*SpendRuleActorUsageResponseBody `form:"actors" json:"actors" xml:"actors"`
 }
 
+// SimulateSpendRuleResponseBody is the type of the "spendRules" service
+// "simulateSpendRule" endpoint HTTP response body.
+type SimulateSpendRuleResponseBody struct {
+	// Total number of organization members the target expression matches.
+	MatchedCount int `form:"matched_count" json:"matched_count" xml:"matched_count"`
+	// The simulated rule action.
+	Action string `form:"action" json:"action" xml:"action"`
+	// Replayed windows, oldest first. The last window is the current, in-progress
+	// one.
+	Windows []*SpendRuleSimulationWindowResponseBody `form:"windows" json:"windows" xml:"windows"`
+	// Matched actors who would have reached the warning threshold in at least one
+	// window, most breached windows first. Capped at 50 entries.
+	Actors []*SpendRuleSimulationActorResponseBody `form:"actors" json:"actors" xml:"actors"`
+}
+
 // ListSpendRuleEventsResponseBody is the type of the "spendRules" service
 // "listSpendRuleEvents" endpoint HTTP response body.
 type ListSpendRuleEventsResponseBody struct {
@@ -279,6 +346,13 @@ type ListActorAttributesResponseBody struct {
 	Attributes []*ActorAttributeResponseBody `form:"attributes" json:"attributes" xml:"attributes"`
 }
 
+// ListUsageDimensionsResponseBody is the type of the "spendRules" service
+// "listUsageDimensions" endpoint HTTP response body.
+type ListUsageDimensionsResponseBody struct {
+	// The usage dimensions available to scope conditions, in editor display order.
+	Dimensions []*UsageDimensionResponseBody `form:"dimensions" json:"dimensions" xml:"dimensions"`
+}
+
 // CreateSpendRuleUnauthorizedResponseBody is the type of the "spendRules"
 // service "createSpendRule" endpoint HTTP response body for the "unauthorized"
 // error.
@@ -1391,6 +1465,195 @@ type PreviewSpendRuleGatewayErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// SimulateSpendRuleUnauthorizedResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the
+// "unauthorized" error.
+type SimulateSpendRuleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SimulateSpendRuleForbiddenResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the "forbidden"
+// error.
+type SimulateSpendRuleForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SimulateSpendRuleBadRequestResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the
+// "bad_request" error.
+type SimulateSpendRuleBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SimulateSpendRuleNotFoundResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the "not_found"
+// error.
+type SimulateSpendRuleNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SimulateSpendRuleConflictResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the "conflict"
+// error.
+type SimulateSpendRuleConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SimulateSpendRuleUnsupportedMediaResponseBody is the type of the
+// "spendRules" service "simulateSpendRule" endpoint HTTP response body for the
+// "unsupported_media" error.
+type SimulateSpendRuleUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SimulateSpendRuleInvalidResponseBody is the type of the "spendRules" service
+// "simulateSpendRule" endpoint HTTP response body for the "invalid" error.
+type SimulateSpendRuleInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SimulateSpendRuleInvariantViolationResponseBody is the type of the
+// "spendRules" service "simulateSpendRule" endpoint HTTP response body for the
+// "invariant_violation" error.
+type SimulateSpendRuleInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SimulateSpendRuleUnexpectedResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the "unexpected"
+// error.
+type SimulateSpendRuleUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SimulateSpendRuleGatewayErrorResponseBody is the type of the "spendRules"
+// service "simulateSpendRule" endpoint HTTP response body for the
+// "gateway_error" error.
+type SimulateSpendRuleGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // ListSpendRuleEventsUnauthorizedResponseBody is the type of the "spendRules"
 // service "listSpendRuleEvents" endpoint HTTP response body for the
 // "unauthorized" error.
@@ -1961,64 +2224,273 @@ type ListActorAttributesGatewayErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// SpendRuleTargetConditionResponseBody is used to define fields on response
-// body types.
-type SpendRuleTargetConditionResponseBody struct {
-	// Member attribute name.
-	Attribute string `form:"attribute" json:"attribute" xml:"attribute"`
-	// Comparison operator: equals, not_equals, starts_with, ends_with, contains,
-	// matches, or includes.
-	Operator string `form:"operator" json:"operator" xml:"operator"`
-	// Comparison value.
-	Value string `form:"value" json:"value" xml:"value"`
+// ListUsageDimensionsUnauthorizedResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListUsageDimensionsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// SpendRuleResponseBody is used to define fields on response body types.
-type SpendRuleResponseBody struct {
-	// The budget rule ID. Identifies one immutable version row: edits produce a
-	// successor row with a fresh ID.
-	ID string `form:"id" json:"id" xml:"id"`
-	// Versioned rule URN, e.g. spend_rule:eng-monthly-cap:v3. Pins the exact rule
-	// configuration that produced an event.
-	Urn string `form:"urn" json:"urn" xml:"urn"`
-	// The organization ID.
-	OrganizationID string `form:"organization_id" json:"organization_id" xml:"organization_id"`
-	// The rule name.
+// ListUsageDimensionsForbiddenResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "forbidden" error.
+type ListUsageDimensionsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
-	// URL-safe identifier derived from the name at creation time. Unique per
-	// organization and immutable; the rule URN embeds it.
-	Slug string `form:"slug" json:"slug" xml:"slug"`
-	// Description of what the rule covers. Empty when unset.
-	Description string `form:"description" json:"description" xml:"description"`
-	// Structured member-attribute condition selecting who the rule applies to.
-	Target *SpendRuleTargetConditionResponseBody `form:"target" json:"target" xml:"target"`
-	// CEL boolean expression over member attributes (email, directory attributes,
-	// groups, roles) selecting who the rule applies to.
-	TargetExpr string `form:"target_expr" json:"target_expr" xml:"target_expr"`
-	// CEL boolean expression over actor usage that identifies a budget breach for
-	// matched members.
-	RuleExpr string `form:"rule_expr" json:"rule_expr" xml:"rule_expr"`
-	// Per-person budget in USD for one window.
-	LimitUsd float64 `form:"limit_usd" json:"limit_usd" xml:"limit_usd"`
-	// UTC calendar window the budget covers.
-	WindowKind string `form:"window_kind" json:"window_kind" xml:"window_kind"`
-	// Percentage of the limit at which a warning event is emitted.
-	WarnAtPct int `form:"warn_at_pct" json:"warn_at_pct" xml:"warn_at_pct"`
-	// Rule action: flag (record events only) or block (deny agent traffic on
-	// breach).
-	Action string `form:"action" json:"action" xml:"action"`
-	// Whether the rule is active.
-	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
-	// Position of this row in its slug lineage; every edit archives the current
-	// row and creates version + 1.
-	Version int64 `form:"version" json:"version" xml:"version"`
-	// When the rule was created.
-	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
-	// When the rule was last updated.
-	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// SpendRuleActorUsageResponseBody is used to define fields on response body
+// ListUsageDimensionsBadRequestResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "bad_request" error.
+type ListUsageDimensionsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsageDimensionsNotFoundResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "not_found" error.
+type ListUsageDimensionsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsageDimensionsConflictResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the "conflict"
+// error.
+type ListUsageDimensionsConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsageDimensionsUnsupportedMediaResponseBody is the type of the
+// "spendRules" service "listUsageDimensions" endpoint HTTP response body for
+// the "unsupported_media" error.
+type ListUsageDimensionsUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsageDimensionsInvalidResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the "invalid"
+// error.
+type ListUsageDimensionsInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsageDimensionsInvariantViolationResponseBody is the type of the
+// "spendRules" service "listUsageDimensions" endpoint HTTP response body for
+// the "invariant_violation" error.
+type ListUsageDimensionsInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsageDimensionsUnexpectedResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "unexpected" error.
+type ListUsageDimensionsUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListUsageDimensionsGatewayErrorResponseBody is the type of the "spendRules"
+// service "listUsageDimensions" endpoint HTTP response body for the
+// "gateway_error" error.
+type ListUsageDimensionsGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SpendRuleTargetConditionResponseBody is used to define fields on response
+// body types.
+type SpendRuleTargetConditionResponseBody struct {
+	// Member attribute name.
+	Attribute string `form:"attribute" json:"attribute" xml:"attribute"`
+	// Comparison operator: equals, not_equals, starts_with, ends_with, contains,
+	// matches, or includes.
+	Operator string `form:"operator" json:"operator" xml:"operator"`
+	// Comparison value.
+	Value string `form:"value" json:"value" xml:"value"`
+}
+
+// SpendRuleScopeConditionResponseBody is used to define fields on response
+// body types.
+type SpendRuleScopeConditionResponseBody struct {
+	// Usage dimension name: model, model_family, assistant_id, project_id, or
+	// toolset_slug.
+	Dimension string `form:"dimension" json:"dimension" xml:"dimension"`
+	// Comparison operator: equals, not_equals, starts_with, ends_with, contains,
+	// or matches.
+	Operator string `form:"operator" json:"operator" xml:"operator"`
+	// Comparison value.
+	Value string `form:"value" json:"value" xml:"value"`
+}
+
+// SpendRuleResponseBody is used to define fields on response body types.
+type SpendRuleResponseBody struct {
+	// The budget rule ID. Identifies one immutable version row: edits produce a
+	// successor row with a fresh ID.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Versioned rule URN, e.g. spend_rule:eng-monthly-cap:v3. Pins the exact rule
+	// configuration that produced an event.
+	Urn string `form:"urn" json:"urn" xml:"urn"`
+	// The organization ID.
+	OrganizationID string `form:"organization_id" json:"organization_id" xml:"organization_id"`
+	// The rule name.
+	Name string `form:"name" json:"name" xml:"name"`
+	// URL-safe identifier derived from the name at creation time. Unique per
+	// organization and immutable; the rule URN embeds it.
+	Slug string `form:"slug" json:"slug" xml:"slug"`
+	// Description of what the rule covers. Empty when unset.
+	Description string `form:"description" json:"description" xml:"description"`
+	// Structured member-attribute condition selecting who the rule applies to.
+	Target *SpendRuleTargetConditionResponseBody `form:"target" json:"target" xml:"target"`
+	// CEL boolean expression over member attributes (email, directory attributes,
+	// groups, roles) selecting who the rule applies to.
+	TargetExpr string `form:"target_expr" json:"target_expr" xml:"target_expr"`
+	// Structured usage-dimension condition selecting which spend counts toward the
+	// limit. Absent when all spend counts.
+	Scope *SpendRuleScopeConditionResponseBody `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// CEL boolean expression over usage dimensions (model, model_family,
+	// assistant_id, project_id, toolset_slug). Empty when all spend counts.
+	ScopeExpr string `form:"scope_expr" json:"scope_expr" xml:"scope_expr"`
+	// CEL boolean expression over actor usage that identifies a budget breach for
+	// matched members.
+	RuleExpr string `form:"rule_expr" json:"rule_expr" xml:"rule_expr"`
+	// Per-person budget in USD for one window.
+	LimitUsd float64 `form:"limit_usd" json:"limit_usd" xml:"limit_usd"`
+	// UTC calendar window the budget covers.
+	WindowKind string `form:"window_kind" json:"window_kind" xml:"window_kind"`
+	// Percentage of the limit at which a warning event is emitted.
+	WarnAtPct int `form:"warn_at_pct" json:"warn_at_pct" xml:"warn_at_pct"`
+	// Rule action: flag (record events only) or block (deny agent traffic on
+	// breach).
+	Action string `form:"action" json:"action" xml:"action"`
+	// Whether the rule is active.
+	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
+	// Position of this row in its slug lineage; every edit archives the current
+	// row and creates version + 1.
+	Version int64 `form:"version" json:"version" xml:"version"`
+	// When the rule was created.
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// When the rule was last updated.
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// SpendRuleActorUsageResponseBody is used to define fields on response body
 // types.
 type SpendRuleActorUsageResponseBody struct {
 	// Actor email.
@@ -2037,6 +2509,62 @@ type SpendRuleActorUsageResponseBody struct {
 	Breached bool `form:"breached" json:"breached" xml:"breached"`
 }
 
+// SpendRuleSimulationWindowResponseBody is used to define fields on response
+// body types.
+type SpendRuleSimulationWindowResponseBody struct {
+	// Inclusive start of the window.
+	WindowStart string `form:"window_start" json:"window_start" xml:"window_start"`
+	// Exclusive end of the window.
+	WindowEnd string `form:"window_end" json:"window_end" xml:"window_end"`
+	// Whether the window contains the current time, so its spend is partial.
+	InProgress bool `form:"in_progress" json:"in_progress" xml:"in_progress"`
+	// Total spend in USD across matched users within the window.
+	SpendUsd float64 `form:"spend_usd" json:"spend_usd" xml:"spend_usd"`
+	// Matched users who would have reached the warning threshold within the window.
+	UsersWarned int `form:"users_warned" json:"users_warned" xml:"users_warned"`
+	// Matched users who would have breached the rule within the window.
+	UsersBreached int `form:"users_breached" json:"users_breached" xml:"users_breached"`
+}
+
+// SpendRuleSimulationActorResponseBody is used to define fields on response
+// body types.
+type SpendRuleSimulationActorResponseBody struct {
+	// Actor email.
+	Email string `form:"email" json:"email" xml:"email"`
+	// Actor display name, when known.
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Gram user ID of the actor, when linked.
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Number of simulated windows in which the actor reached the warning threshold.
+	WindowsWarned int `form:"windows_warned" json:"windows_warned" xml:"windows_warned"`
+	// Number of simulated windows in which the actor breached the rule.
+	WindowsBreached int `form:"windows_breached" json:"windows_breached" xml:"windows_breached"`
+	// Per-window outcome, oldest window first.
+	Timeline []*SpendRuleSimulationActorWindowResponseBody `form:"timeline" json:"timeline" xml:"timeline"`
+}
+
+// SpendRuleSimulationActorWindowResponseBody is used to define fields on
+// response body types.
+type SpendRuleSimulationActorWindowResponseBody struct {
+	// Inclusive start of the window.
+	WindowStart string `form:"window_start" json:"window_start" xml:"window_start"`
+	// Exclusive end of the window.
+	WindowEnd string `form:"window_end" json:"window_end" xml:"window_end"`
+	// Actor spend in USD within the window.
+	SpendUsd float64 `form:"spend_usd" json:"spend_usd" xml:"spend_usd"`
+	// Window spend as a percentage of the limit (may exceed 100).
+	UsedPct float64 `form:"used_pct" json:"used_pct" xml:"used_pct"`
+	// Start of the spend bucket in which the actor first reached the warning
+	// threshold. Absent when never reached.
+	WarnedAt *string `form:"warned_at,omitempty" json:"warned_at,omitempty" xml:"warned_at,omitempty"`
+	// Start of the spend bucket in which the actor first breached the rule. Absent
+	// when never breached.
+	BreachedAt *string `form:"breached_at,omitempty" json:"breached_at,omitempty" xml:"breached_at,omitempty"`
+	// Whether a block rule would have denied the actor's agent traffic from
+	// breached_at until the window reset.
+	Blocked bool `form:"blocked" json:"blocked" xml:"blocked"`
+}
+
 // SpendRuleEventResponseBody is used to define fields on response body types.
 type SpendRuleEventResponseBody struct {
 	// The event ID.
@@ -2097,6 +2625,14 @@ type ActorAttributeResponseBody struct {
 	Description string `form:"description" json:"description" xml:"description"`
 }
 
+// UsageDimensionResponseBody is used to define fields on response body types.
+type UsageDimensionResponseBody struct {
+	// Dimension name as used in scope conditions, e.g. model_family.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Human-readable description of the dimension.
+	Description string `form:"description" json:"description" xml:"description"`
+}
+
 // SpendRuleTargetConditionRequestBody is used to define fields on request body
 // types.
 type SpendRuleTargetConditionRequestBody struct {
@@ -2109,6 +2645,19 @@ type SpendRuleTargetConditionRequestBody struct {
 	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
 }
 
+// SpendRuleScopeConditionRequestBody is used to define fields on request body
+// types.
+type SpendRuleScopeConditionRequestBody struct {
+	// Usage dimension name: model, model_family, assistant_id, project_id, or
+	// toolset_slug.
+	Dimension *string `form:"dimension,omitempty" json:"dimension,omitempty" xml:"dimension,omitempty"`
+	// Comparison operator: equals, not_equals, starts_with, ends_with, contains,
+	// or matches.
+	Operator *string `form:"operator,omitempty" json:"operator,omitempty" xml:"operator,omitempty"`
+	// Comparison value.
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
 // NewCreateSpendRuleResponseBody builds the HTTP response body from the result
 // of the "createSpendRule" endpoint of the "spendRules" service.
 func NewCreateSpendRuleResponseBody(res *types.SpendRule) *CreateSpendRuleResponseBody {
@@ -2120,6 +2669,7 @@ func NewCreateSpendRuleResponseBody(res *types.SpendRule) *CreateSpendRuleRespon
 		Slug:           res.Slug,
 		Description:    res.Description,
 		TargetExpr:     res.TargetExpr,
+		ScopeExpr:      res.ScopeExpr,
 		RuleExpr:       res.RuleExpr,
 		LimitUsd:       res.LimitUsd,
 		WindowKind:     res.WindowKind,
@@ -2133,6 +2683,9 @@ func NewCreateSpendRuleResponseBody(res *types.SpendRule) *CreateSpendRuleRespon
 	if res.Target != nil {
 		body.Target = marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionResponseBody(res.Target)
 	}
+	if res.Scope != nil {
+		body.Scope = marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionResponseBody(res.Scope)
+	}
 	return body
 }
 
@@ -2166,6 +2719,7 @@ func NewGetSpendRuleResponseBody(res *types.SpendRule) *GetSpendRuleResponseBody
 		Slug:           res.Slug,
 		Description:    res.Description,
 		TargetExpr:     res.TargetExpr,
+		ScopeExpr:      res.ScopeExpr,
 		RuleExpr:       res.RuleExpr,
 		LimitUsd:       res.LimitUsd,
 		WindowKind:     res.WindowKind,
@@ -2179,6 +2733,9 @@ func NewGetSpendRuleResponseBody(res *types.SpendRule) *GetSpendRuleResponseBody
 	if res.Target != nil {
 		body.Target = marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionResponseBody(res.Target)
 	}
+	if res.Scope != nil {
+		body.Scope = marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionResponseBody(res.Scope)
+	}
 	return body
 }
 
@@ -2193,6 +2750,7 @@ func NewUpdateSpendRuleResponseBody(res *types.SpendRule) *UpdateSpendRuleRespon
 		Slug:           res.Slug,
 		Description:    res.Description,
 		TargetExpr:     res.TargetExpr,
+		ScopeExpr:      res.ScopeExpr,
 		RuleExpr:       res.RuleExpr,
 		LimitUsd:       res.LimitUsd,
 		WindowKind:     res.WindowKind,
@@ -2206,6 +2764,9 @@ func NewUpdateSpendRuleResponseBody(res *types.SpendRule) *UpdateSpendRuleRespon
 	if res.Target != nil {
 		body.Target = marshalTypesSpendRuleTargetConditionToSpendRuleTargetConditionResponseBody(res.Target)
 	}
+	if res.Scope != nil {
+		body.Scope = marshalTypesSpendRuleScopeConditionToSpendRuleScopeConditionResponseBody(res.Scope)
+	}
 	return body
 }
 
@@ -2232,6 +2793,40 @@ func NewPreviewSpendRuleResponseBody(res *spendrules.PreviewSpendRuleResult) *Pr
 	return body
 }
 
+// NewSimulateSpendRuleResponseBody builds the HTTP response body from the
+// result of the "simulateSpendRule" endpoint of the "spendRules" service.
+func NewSimulateSpendRuleResponseBody(res *spendrules.SimulateSpendRuleResult) *SimulateSpendRuleResponseBody {
+	body := &SimulateSpendRuleResponseBody{
+		MatchedCount: res.MatchedCount,
+		Action:       res.Action,
+	}
+	if res.Windows != nil {
+		body.Windows = make([]*SpendRuleSimulationWindowResponseBody, len(res.Windows))
+		for i, val := range res.Windows {
+			if val == nil {
+				body.Windows[i] = nil
+				continue
+			}
+			body.Windows[i] = marshalSpendrulesSpendRuleSimulationWindowToSpendRuleSimulationWindowResponseBody(val)
+		}
+	} else {
+		body.Windows = []*SpendRuleSimulationWindowResponseBody{}
+	}
+	if res.Actors != nil {
+		body.Actors = make([]*SpendRuleSimulationActorResponseBody, len(res.Actors))
+		for i, val := range res.Actors {
+			if val == nil {
+				body.Actors[i] = nil
+				continue
+			}
+			body.Actors[i] = marshalSpendrulesSpendRuleSimulationActorToSpendRuleSimulationActorResponseBody(val)
+		}
+	} else {
+		body.Actors = []*SpendRuleSimulationActorResponseBody{}
+	}
+	return body
+}
+
 // NewListSpendRuleEventsResponseBody builds the HTTP response body from the
 // result of the "listSpendRuleEvents" endpoint of the "spendRules" service.
 func NewListSpendRuleEventsResponseBody(res *spendrules.ListSpendRuleEventsResult) *ListSpendRuleEventsResponseBody {
@@ -2299,6 +2894,25 @@ func NewListActorAttributesResponseBody(res *spendrules.ListActorAttributesResul
 	return body
 }
 
+// NewListUsageDimensionsResponseBody builds the HTTP response body from the
+// result of the "listUsageDimensions" endpoint of the "spendRules" service.
+func NewListUsageDimensionsResponseBody(res *spendrules.ListUsageDimensionsResult) *ListUsageDimensionsResponseBody {
+	body := &ListUsageDimensionsResponseBody{}
+	if res.Dimensions != nil {
+		body.Dimensions = make([]*UsageDimensionResponseBody, len(res.Dimensions))
+		for i, val := range res.Dimensions {
+			if val == nil {
+				body.Dimensions[i] = nil
+				continue
+			}
+			body.Dimensions[i] = marshalSpendrulesUsageDimensionToUsageDimensionResponseBody(val)
+		}
+	} else {
+		body.Dimensions = []*UsageDimensionResponseBody{}
+	}
+	return body
+}
+
 // NewCreateSpendRuleUnauthorizedResponseBody builds the HTTP response body
 // from the result of the "createSpendRule" endpoint of the "spendRules"
 // service.
@@ -3012,11 +3626,155 @@ func NewArchiveSpendRuleGatewayErrorResponseBody(res *goa.ServiceError) *Archive
 	return body
 }
 
-// NewPreviewSpendRuleUnauthorizedResponseBody builds the HTTP response body
-// from the result of the "previewSpendRule" endpoint of the "spendRules"
+// NewPreviewSpendRuleUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "previewSpendRule" endpoint of the "spendRules"
+// service.
+func NewPreviewSpendRuleUnauthorizedResponseBody(res *goa.ServiceError) *PreviewSpendRuleUnauthorizedResponseBody {
+	body := &PreviewSpendRuleUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPreviewSpendRuleForbiddenResponseBody builds the HTTP response body from
+// the result of the "previewSpendRule" endpoint of the "spendRules" service.
+func NewPreviewSpendRuleForbiddenResponseBody(res *goa.ServiceError) *PreviewSpendRuleForbiddenResponseBody {
+	body := &PreviewSpendRuleForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPreviewSpendRuleBadRequestResponseBody builds the HTTP response body from
+// the result of the "previewSpendRule" endpoint of the "spendRules" service.
+func NewPreviewSpendRuleBadRequestResponseBody(res *goa.ServiceError) *PreviewSpendRuleBadRequestResponseBody {
+	body := &PreviewSpendRuleBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPreviewSpendRuleNotFoundResponseBody builds the HTTP response body from
+// the result of the "previewSpendRule" endpoint of the "spendRules" service.
+func NewPreviewSpendRuleNotFoundResponseBody(res *goa.ServiceError) *PreviewSpendRuleNotFoundResponseBody {
+	body := &PreviewSpendRuleNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPreviewSpendRuleConflictResponseBody builds the HTTP response body from
+// the result of the "previewSpendRule" endpoint of the "spendRules" service.
+func NewPreviewSpendRuleConflictResponseBody(res *goa.ServiceError) *PreviewSpendRuleConflictResponseBody {
+	body := &PreviewSpendRuleConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPreviewSpendRuleUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "previewSpendRule" endpoint of the "spendRules"
+// service.
+func NewPreviewSpendRuleUnsupportedMediaResponseBody(res *goa.ServiceError) *PreviewSpendRuleUnsupportedMediaResponseBody {
+	body := &PreviewSpendRuleUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPreviewSpendRuleInvalidResponseBody builds the HTTP response body from
+// the result of the "previewSpendRule" endpoint of the "spendRules" service.
+func NewPreviewSpendRuleInvalidResponseBody(res *goa.ServiceError) *PreviewSpendRuleInvalidResponseBody {
+	body := &PreviewSpendRuleInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPreviewSpendRuleInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "previewSpendRule" endpoint of the "spendRules"
+// service.
+func NewPreviewSpendRuleInvariantViolationResponseBody(res *goa.ServiceError) *PreviewSpendRuleInvariantViolationResponseBody {
+	body := &PreviewSpendRuleInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPreviewSpendRuleUnexpectedResponseBody builds the HTTP response body from
+// the result of the "previewSpendRule" endpoint of the "spendRules" service.
+func NewPreviewSpendRuleUnexpectedResponseBody(res *goa.ServiceError) *PreviewSpendRuleUnexpectedResponseBody {
+	body := &PreviewSpendRuleUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPreviewSpendRuleGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "previewSpendRule" endpoint of the "spendRules"
+// service.
+func NewPreviewSpendRuleGatewayErrorResponseBody(res *goa.ServiceError) *PreviewSpendRuleGatewayErrorResponseBody {
+	body := &PreviewSpendRuleGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSimulateSpendRuleUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "simulateSpendRule" endpoint of the "spendRules"
 // service.
-func NewPreviewSpendRuleUnauthorizedResponseBody(res *goa.ServiceError) *PreviewSpendRuleUnauthorizedResponseBody {
-	body := &PreviewSpendRuleUnauthorizedResponseBody{
+func NewSimulateSpendRuleUnauthorizedResponseBody(res *goa.ServiceError) *SimulateSpendRuleUnauthorizedResponseBody {
+	body := &SimulateSpendRuleUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -3027,10 +3785,10 @@ func NewPreviewSpendRuleUnauthorizedResponseBody(res *goa.ServiceError) *Preview
 	return body
 }
 
-// NewPreviewSpendRuleForbiddenResponseBody builds the HTTP response body from
-// the result of the "previewSpendRule" endpoint of the "spendRules" service.
-func NewPreviewSpendRuleForbiddenResponseBody(res *goa.ServiceError) *PreviewSpendRuleForbiddenResponseBody {
-	body := &PreviewSpendRuleForbiddenResponseBody{
+// NewSimulateSpendRuleForbiddenResponseBody builds the HTTP response body from
+// the result of the "simulateSpendRule" endpoint of the "spendRules" service.
+func NewSimulateSpendRuleForbiddenResponseBody(res *goa.ServiceError) *SimulateSpendRuleForbiddenResponseBody {
+	body := &SimulateSpendRuleForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -3041,10 +3799,11 @@ func NewPreviewSpendRuleForbiddenResponseBody(res *goa.ServiceError) *PreviewSpe
 	return body
 }
 
-// NewPreviewSpendRuleBadRequestResponseBody builds the HTTP response body from
-// the result of the "previewSpendRule" endpoint of the "spendRules" service.
-func NewPreviewSpendRuleBadRequestResponseBody(res *goa.ServiceError) *PreviewSpendRuleBadRequestResponseBody {
-	body := &PreviewSpendRuleBadRequestResponseBody{
+// NewSimulateSpendRuleBadRequestResponseBody builds the HTTP response body
+// from the result of the "simulateSpendRule" endpoint of the "spendRules"
+// service.
+func NewSimulateSpendRuleBadRequestResponseBody(res *goa.ServiceError) *SimulateSpendRuleBadRequestResponseBody {
+	body := &SimulateSpendRuleBadRequestResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -3055,10 +3814,10 @@ func NewPreviewSpendRuleBadRequestResponseBody(res *goa.ServiceError) *PreviewSp
 	return body
 }
 
-// NewPreviewSpendRuleNotFoundResponseBody builds the HTTP response body from
-// the result of the "previewSpendRule" endpoint of the "spendRules" service.
-func NewPreviewSpendRuleNotFoundResponseBody(res *goa.ServiceError) *PreviewSpendRuleNotFoundResponseBody {
-	body := &PreviewSpendRuleNotFoundResponseBody{
+// NewSimulateSpendRuleNotFoundResponseBody builds the HTTP response body from
+// the result of the "simulateSpendRule" endpoint of the "spendRules" service.
+func NewSimulateSpendRuleNotFoundResponseBody(res *goa.ServiceError) *SimulateSpendRuleNotFoundResponseBody {
+	body := &SimulateSpendRuleNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -3069,10 +3828,10 @@ func NewPreviewSpendRuleNotFoundResponseBody(res *goa.ServiceError) *PreviewSpen
 	return body
 }
 
-// NewPreviewSpendRuleConflictResponseBody builds the HTTP response body from
-// the result of the "previewSpendRule" endpoint of the "spendRules" service.
-func NewPreviewSpendRuleConflictResponseBody(res *goa.ServiceError) *PreviewSpendRuleConflictResponseBody {
-	body := &PreviewSpendRuleConflictResponseBody{
+// NewSimulateSpendRuleConflictResponseBody builds the HTTP response body from
+// the result of the "simulateSpendRule" endpoint of the "spendRules" service.
+func NewSimulateSpendRuleConflictResponseBody(res *goa.ServiceError) *SimulateSpendRuleConflictResponseBody {
+	body := &SimulateSpendRuleConflictResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -3083,11 +3842,11 @@ func NewPreviewSpendRuleConflictResponseBody(res *goa.ServiceError) *PreviewSpen
 	return body
 }
 
-// NewPreviewSpendRuleUnsupportedMediaResponseBody builds the HTTP response
-// body from the result of the "previewSpendRule" endpoint of the "spendRules"
+// NewSimulateSpendRuleUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "simulateSpendRule" endpoint of the "spendRules"
 // service.
-func NewPreviewSpendRuleUnsupportedMediaResponseBody(res *goa.ServiceError) *PreviewSpendRuleUnsupportedMediaResponseBody {
-	body := &PreviewSpendRuleUnsupportedMediaResponseBody{
+func NewSimulateSpendRuleUnsupportedMediaResponseBody(res *goa.ServiceError) *SimulateSpendRuleUnsupportedMediaResponseBody {
+	body := &SimulateSpendRuleUnsupportedMediaResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -3098,10 +3857,10 @@ func NewPreviewSpendRuleUnsupportedMediaResponseBody(res *goa.ServiceError) *Pre
 	return body
 }
 
-// NewPreviewSpendRuleInvalidResponseBody builds the HTTP response body from
-// the result of the "previewSpendRule" endpoint of the "spendRules" service.
-func NewPreviewSpendRuleInvalidResponseBody(res *goa.ServiceError) *PreviewSpendRuleInvalidResponseBody {
-	body := &PreviewSpendRuleInvalidResponseBody{
+// NewSimulateSpendRuleInvalidResponseBody builds the HTTP response body from
+// the result of the "simulateSpendRule" endpoint of the "spendRules" service.
+func NewSimulateSpendRuleInvalidResponseBody(res *goa.ServiceError) *SimulateSpendRuleInvalidResponseBody {
+	body := &SimulateSpendRuleInvalidResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -3112,11 +3871,11 @@ func NewPreviewSpendRuleInvalidResponseBody(res *goa.ServiceError) *PreviewSpend
 	return body
 }
 
-// NewPreviewSpendRuleInvariantViolationResponseBody builds the HTTP response
-// body from the result of the "previewSpendRule" endpoint of the "spendRules"
+// NewSimulateSpendRuleInvariantViolationResponseBody builds the HTTP response
+// body from the result of the "simulateSpendRule" endpoint of the "spendRules"
 // service.
-func NewPreviewSpendRuleInvariantViolationResponseBody(res *goa.ServiceError) *PreviewSpendRuleInvariantViolationResponseBody {
-	body := &PreviewSpendRuleInvariantViolationResponseBody{
+func NewSimulateSpendRuleInvariantViolationResponseBody(res *goa.ServiceError) *SimulateSpendRuleInvariantViolationResponseBody {
+	body := &SimulateSpendRuleInvariantViolationResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -3127,10 +3886,11 @@ func NewPreviewSpendRuleInvariantViolationResponseBody(res *goa.ServiceError) *P
 	return body
 }
 
-// NewPreviewSpendRuleUnexpectedResponseBody builds the HTTP response body from
-// the result of the "previewSpendRule" endpoint of the "spendRules" service.
-func NewPreviewSpendRuleUnexpectedResponseBody(res *goa.ServiceError) *PreviewSpendRuleUnexpectedResponseBody {
-	body := &PreviewSpendRuleUnexpectedResponseBody{
+// NewSimulateSpendRuleUnexpectedResponseBody builds the HTTP response body
+// from the result of the "simulateSpendRule" endpoint of the "spendRules"
+// service.
+func NewSimulateSpendRuleUnexpectedResponseBody(res *goa.ServiceError) *SimulateSpendRuleUnexpectedResponseBody {
+	body := &SimulateSpendRuleUnexpectedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -3141,11 +3901,11 @@ func NewPreviewSpendRuleUnexpectedResponseBody(res *goa.ServiceError) *PreviewSp
 	return body
 }
 
-// NewPreviewSpendRuleGatewayErrorResponseBody builds the HTTP response body
-// from the result of the "previewSpendRule" endpoint of the "spendRules"
+// NewSimulateSpendRuleGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "simulateSpendRule" endpoint of the "spendRules"
 // service.
-func NewPreviewSpendRuleGatewayErrorResponseBody(res *goa.ServiceError) *PreviewSpendRuleGatewayErrorResponseBody {
-	body := &PreviewSpendRuleGatewayErrorResponseBody{
+func NewSimulateSpendRuleGatewayErrorResponseBody(res *goa.ServiceError) *SimulateSpendRuleGatewayErrorResponseBody {
+	body := &SimulateSpendRuleGatewayErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -3604,6 +4364,155 @@ func NewListActorAttributesGatewayErrorResponseBody(res *goa.ServiceError) *List
 	return body
 }
 
+// NewListUsageDimensionsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "listUsageDimensions" endpoint of the "spendRules"
+// service.
+func NewListUsageDimensionsUnauthorizedResponseBody(res *goa.ServiceError) *ListUsageDimensionsUnauthorizedResponseBody {
+	body := &ListUsageDimensionsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsageDimensionsForbiddenResponseBody builds the HTTP response body
+// from the result of the "listUsageDimensions" endpoint of the "spendRules"
+// service.
+func NewListUsageDimensionsForbiddenResponseBody(res *goa.ServiceError) *ListUsageDimensionsForbiddenResponseBody {
+	body := &ListUsageDimensionsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsageDimensionsBadRequestResponseBody builds the HTTP response body
+// from the result of the "listUsageDimensions" endpoint of the "spendRules"
+// service.
+func NewListUsageDimensionsBadRequestResponseBody(res *goa.ServiceError) *ListUsageDimensionsBadRequestResponseBody {
+	body := &ListUsageDimensionsBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsageDimensionsNotFoundResponseBody builds the HTTP response body
+// from the result of the "listUsageDimensions" endpoint of the "spendRules"
+// service.
+func NewListUsageDimensionsNotFoundResponseBody(res *goa.ServiceError) *ListUsageDimensionsNotFoundResponseBody {
+	body := &ListUsageDimensionsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsageDimensionsConflictResponseBody builds the HTTP response body
+// from the result of the "listUsageDimensions" endpoint of the "spendRules"
+// service.
+func NewListUsageDimensionsConflictResponseBody(res *goa.ServiceError) *ListUsageDimensionsConflictResponseBody {
+	body := &ListUsageDimensionsConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsageDimensionsUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "listUsageDimensions" endpoint of the
+// "spendRules" service.
+func NewListUsageDimensionsUnsupportedMediaResponseBody(res *goa.ServiceError) *ListUsageDimensionsUnsupportedMediaResponseBody {
+	body := &ListUsageDimensionsUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsageDimensionsInvalidResponseBody builds the HTTP response body from
+// the result of the "listUsageDimensions" endpoint of the "spendRules" service.
+func NewListUsageDimensionsInvalidResponseBody(res *goa.ServiceError) *ListUsageDimensionsInvalidResponseBody {
+	body := &ListUsageDimensionsInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsageDimensionsInvariantViolationResponseBody builds the HTTP
+// response body from the result of the "listUsageDimensions" endpoint of the
+// "spendRules" service.
+func NewListUsageDimensionsInvariantViolationResponseBody(res *goa.ServiceError) *ListUsageDimensionsInvariantViolationResponseBody {
+	body := &ListUsageDimensionsInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsageDimensionsUnexpectedResponseBody builds the HTTP response body
+// from the result of the "listUsageDimensions" endpoint of the "spendRules"
+// service.
+func NewListUsageDimensionsUnexpectedResponseBody(res *goa.ServiceError) *ListUsageDimensionsUnexpectedResponseBody {
+	body := &ListUsageDimensionsUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListUsageDimensionsGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "listUsageDimensions" endpoint of the "spendRules"
+// service.
+func NewListUsageDimensionsGatewayErrorResponseBody(res *goa.ServiceError) *ListUsageDimensionsGatewayErrorResponseBody {
+	body := &ListUsageDimensionsGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewCreateSpendRulePayload builds a spendRules service createSpendRule
 // endpoint payload.
 func NewCreateSpendRulePayload(body *CreateSpendRuleRequestBody, apikeyToken *string, sessionToken *string, projectSlugInput *string) *spendrules.CreateSpendRulePayload {
@@ -3628,6 +4537,9 @@ func NewCreateSpendRulePayload(body *CreateSpendRuleRequestBody, apikeyToken *st
 		v.Description = ""
 	}
 	v.Target = unmarshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition(body.Target)
+	if body.Scope != nil {
+		v.Scope = unmarshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition(body.Scope)
+	}
 	if body.WarnAtPct == nil {
 		v.WarnAtPct = 80
 	}
@@ -3674,6 +4586,7 @@ func NewUpdateSpendRulePayload(body *UpdateSpendRuleRequestBody, apikeyToken *st
 		ID:          *body.ID,
 		Name:        body.Name,
 		Description: body.Description,
+		ClearScope:  body.ClearScope,
 		LimitUsd:    body.LimitUsd,
 		WindowKind:  body.WindowKind,
 		WarnAtPct:   body.WarnAtPct,
@@ -3683,6 +4596,9 @@ func NewUpdateSpendRulePayload(body *UpdateSpendRuleRequestBody, apikeyToken *st
 	if body.Target != nil {
 		v.Target = unmarshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition(body.Target)
 	}
+	if body.Scope != nil {
+		v.Scope = unmarshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition(body.Scope)
+	}
 	v.ApikeyToken = apikeyToken
 	v.SessionToken = sessionToken
 	v.ProjectSlugInput = projectSlugInput
@@ -3714,9 +4630,48 @@ func NewPreviewSpendRulePayload(body *PreviewSpendRuleRequestBody, apikeyToken *
 		v.WarnAtPct = *body.WarnAtPct
 	}
 	v.Target = unmarshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition(body.Target)
+	if body.Scope != nil {
+		v.Scope = unmarshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition(body.Scope)
+	}
+	if body.WarnAtPct == nil {
+		v.WarnAtPct = 80
+	}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v
+}
+
+// NewSimulateSpendRulePayload builds a spendRules service simulateSpendRule
+// endpoint payload.
+func NewSimulateSpendRulePayload(body *SimulateSpendRuleRequestBody, apikeyToken *string, sessionToken *string, projectSlugInput *string) *spendrules.SimulateSpendRulePayload {
+	v := &spendrules.SimulateSpendRulePayload{
+		LimitUsd:   *body.LimitUsd,
+		WindowKind: *body.WindowKind,
+	}
+	if body.WarnAtPct != nil {
+		v.WarnAtPct = *body.WarnAtPct
+	}
+	if body.Action != nil {
+		v.Action = *body.Action
+	}
+	if body.Windows != nil {
+		v.Windows = *body.Windows
+	}
+	v.Target = unmarshalSpendRuleTargetConditionRequestBodyToTypesSpendRuleTargetCondition(body.Target)
+	if body.Scope != nil {
+		v.Scope = unmarshalSpendRuleScopeConditionRequestBodyToTypesSpendRuleScopeCondition(body.Scope)
+	}
 	if body.WarnAtPct == nil {
 		v.WarnAtPct = 80
 	}
+	if body.Action == nil {
+		v.Action = "block"
+	}
+	if body.Windows == nil {
+		v.Windows = 3
+	}
 	v.ApikeyToken = apikeyToken
 	v.SessionToken = sessionToken
 	v.ProjectSlugInput = projectSlugInput
@@ -3761,6 +4716,17 @@ func NewListActorAttributesPayload(apikeyToken *string, sessionToken *string, pr
 	return v
 }
 
+// NewListUsageDimensionsPayload builds a spendRules service
+// listUsageDimensions endpoint payload.
+func NewListUsageDimensionsPayload(apikeyToken *string, sessionToken *string, projectSlugInput *string) *spendrules.ListUsageDimensionsPayload {
+	v := &spendrules.ListUsageDimensionsPayload{}
+	v.ApikeyToken = apikeyToken
+	v.SessionToken = sessionToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v
+}
+
 // ValidateCreateSpendRuleRequestBody runs the validations defined on
 // CreateSpendRuleRequestBody
 func ValidateCreateSpendRuleRequestBody(body *CreateSpendRuleRequestBody) (err error) {
@@ -3781,6 +4747,11 @@ func ValidateCreateSpendRuleRequestBody(body *CreateSpendRuleRequestBody) (err e
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Scope != nil {
+		if err2 := ValidateSpendRuleScopeConditionRequestBody(body.Scope); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	if body.LimitUsd != nil {
 		if *body.LimitUsd < 0 {
 			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit_usd", *body.LimitUsd, 0, true))
@@ -3823,6 +4794,11 @@ func ValidateUpdateSpendRuleRequestBody(body *UpdateSpendRuleRequestBody) (err e
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Scope != nil {
+		if err2 := ValidateSpendRuleScopeConditionRequestBody(body.Scope); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	if body.LimitUsd != nil {
 		if *body.LimitUsd < 0 {
 			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit_usd", *body.LimitUsd, 0, true))
@@ -3880,6 +4856,56 @@ func ValidatePreviewSpendRuleRequestBody(body *PreviewSpendRuleRequestBody) (err
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.Scope != nil {
+		if err2 := ValidateSpendRuleScopeConditionRequestBody(body.Scope); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.LimitUsd != nil {
+		if *body.LimitUsd < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit_usd", *body.LimitUsd, 0, true))
+		}
+	}
+	if body.WarnAtPct != nil {
+		if *body.WarnAtPct < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.warn_at_pct", *body.WarnAtPct, 1, true))
+		}
+	}
+	if body.WarnAtPct != nil {
+		if *body.WarnAtPct > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.warn_at_pct", *body.WarnAtPct, 100, false))
+		}
+	}
+	if body.WindowKind != nil {
+		if !(*body.WindowKind == "daily" || *body.WindowKind == "weekly" || *body.WindowKind == "monthly") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.window_kind", *body.WindowKind, []any{"daily", "weekly", "monthly"}))
+		}
+	}
+	return
+}
+
+// ValidateSimulateSpendRuleRequestBody runs the validations defined on
+// SimulateSpendRuleRequestBody
+func ValidateSimulateSpendRuleRequestBody(body *SimulateSpendRuleRequestBody) (err error) {
+	if body.Target == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("target", "body"))
+	}
+	if body.LimitUsd == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("limit_usd", "body"))
+	}
+	if body.WindowKind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("window_kind", "body"))
+	}
+	if body.Target != nil {
+		if err2 := ValidateSpendRuleTargetConditionRequestBody(body.Target); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Scope != nil {
+		if err2 := ValidateSpendRuleScopeConditionRequestBody(body.Scope); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	if body.LimitUsd != nil {
 		if *body.LimitUsd < 0 {
 			err = goa.MergeErrors(err, goa.InvalidRangeError("body.limit_usd", *body.LimitUsd, 0, true))
@@ -3900,6 +4926,21 @@ func ValidatePreviewSpendRuleRequestBody(body *PreviewSpendRuleRequestBody) (err
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.window_kind", *body.WindowKind, []any{"daily", "weekly", "monthly"}))
 		}
 	}
+	if body.Action != nil {
+		if !(*body.Action == "flag" || *body.Action == "block") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", *body.Action, []any{"flag", "block"}))
+		}
+	}
+	if body.Windows != nil {
+		if *body.Windows < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.windows", *body.Windows, 1, true))
+		}
+	}
+	if body.Windows != nil {
+		if *body.Windows > 12 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.windows", *body.Windows, 12, false))
+		}
+	}
 	return
 }
 
@@ -3917,3 +4958,18 @@ func ValidateSpendRuleTargetConditionRequestBody(body *SpendRuleTargetConditionR
 	}
 	return
 }
+
+// ValidateSpendRuleScopeConditionRequestBody runs the validations defined on
+// SpendRuleScopeConditionRequestBody
+func ValidateSpendRuleScopeConditionRequestBody(body *SpendRuleScopeConditionRequestBody) (err error) {
+	if body.Dimension == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dimension", "body"))
+	}
+	if body.Operator == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("operator", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	return
+}
@@ -0,0 +1,284 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// telemetryAlerts HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	telemetryalerts "github.com/speakeasy-api/gram/server/gen/telemetry_alerts"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreateTelemetryAlertRulePayload builds the payload for the
+// telemetryAlerts createTelemetryAlertRule endpoint from CLI flags.
+func BuildCreateTelemetryAlertRulePayload(telemetryAlertsCreateTelemetryAlertRuleBody string, telemetryAlertsCreateTelemetryAlertRuleSessionToken string, telemetryAlertsCreateTelemetryAlertRuleApikeyToken string, telemetryAlertsCreateTelemetryAlertRuleProjectSlugInput string) (*telemetryalerts.CreateTelemetryAlertRulePayload, error) {
+	var err error
+	var body CreateTelemetryAlertRuleRequestBody
+	{
+		err = json.Unmarshal([]byte(telemetryAlertsCreateTelemetryAlertRuleBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email_recipients\": [\n         \"alice@example.com\",\n         \"alice@example.com\",\n         \"alice@example.com\"\n      ],\n      \"enabled\": false,\n      \"metric\": \"latency_p50\",\n      \"min_calls\": 2,\n      \"name\": \"aa\",\n      \"threshold\": 1,\n      \"tool_urn\": \"aa\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"window_seconds\": 61\n   }'")
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if utf8.RuneCountInString(body.Name) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 100, false))
+		}
+		if body.ToolsetID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.toolset_id", *body.ToolsetID, goa.FormatUUID))
+		}
+		if body.ToolUrn != nil {
+			if utf8.RuneCountInString(*body.ToolUrn) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tool_urn", *body.ToolUrn, utf8.RuneCountInString(*body.ToolUrn), 1, true))
+			}
+		}
+		if body.ToolUrn != nil {
+			if utf8.RuneCountInString(*body.ToolUrn) > 500 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tool_urn", *body.ToolUrn, utf8.RuneCountInString(*body.ToolUrn), 500, false))
+			}
+		}
+		if !(body.Metric == "error_rate" || body.Metric == "latency_p50" || body.Metric == "latency_p90" || body.Metric == "latency_p95" || body.Metric == "latency_p99") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.metric", body.Metric, []any{"error_rate", "latency_p50", "latency_p90", "latency_p95", "latency_p99"}))
+		}
+		if body.Threshold <= 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.threshold", body.Threshold, 0, true))
+		}
+		if body.WindowSeconds < 60 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.window_seconds", body.WindowSeconds, 60, true))
+		}
+		if body.WindowSeconds > 86400 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.window_seconds", body.WindowSeconds, 86400, false))
+		}
+		if body.MinCalls != nil {
+			if *body.MinCalls < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_calls", *body.MinCalls, 1, true))
+			}
+		}
+		if len(body.EmailRecipients) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.email_recipients", body.EmailRecipients, len(body.EmailRecipients), 20, false))
+		}
+		for _, e := range body.EmailRecipients {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.email_recipients[*]", e, goa.FormatEmail))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if telemetryAlertsCreateTelemetryAlertRuleSessionToken != "" {
+			sessionToken = &telemetryAlertsCreateTelemetryAlertRuleSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if telemetryAlertsCreateTelemetryAlertRuleApikeyToken != "" {
+			apikeyToken = &telemetryAlertsCreateTelemetryAlertRuleApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if telemetryAlertsCreateTelemetryAlertRuleProjectSlugInput != "" {
+			projectSlugInput = &telemetryAlertsCreateTelemetryAlertRuleProjectSlugInput
+		}
+	}
+	v := &telemetryalerts.CreateTelemetryAlertRulePayload{
+		Name:          body.Name,
+		ToolsetID:     body.ToolsetID,
+		ToolUrn:       body.ToolUrn,
+		Metric:        body.Metric,
+		Threshold:     body.Threshold,
+		WindowSeconds: body.WindowSeconds,
+		MinCalls:      body.MinCalls,
+		Enabled:       body.Enabled,
+	}
+	if body.EmailRecipients != nil {
+		v.EmailRecipients = make([]string, len(body.EmailRecipients))
+		for i, val := range body.EmailRecipients {
+			v.EmailRecipients[i] = val
+		}
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildListTelemetryAlertRulesPayload builds the payload for the
+// telemetryAlerts listTelemetryAlertRules endpoint from CLI flags.
+func BuildListTelemetryAlertRulesPayload(telemetryAlertsListTelemetryAlertRulesToolsetID string, telemetryAlertsListTelemetryAlertRulesSessionToken string, telemetryAlertsListTelemetryAlertRulesApikeyToken string, telemetryAlertsListTelemetryAlertRulesProjectSlugInput string) (*telemetryalerts.ListTelemetryAlertRulesPayload, error) {
+	var err error
+	var toolsetID *string
+	{
+		if telemetryAlertsListTelemetryAlertRulesToolsetID != "" {
+			toolsetID = &telemetryAlertsListTelemetryAlertRulesToolsetID
+			err = goa.MergeErrors(err, goa.ValidateFormat("toolset_id", *toolsetID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sessionToken *string
+	{
+		if telemetryAlertsListTelemetryAlertRulesSessionToken != "" {
+			sessionToken = &telemetryAlertsListTelemetryAlertRulesSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if telemetryAlertsListTelemetryAlertRulesApikeyToken != "" {
+			apikeyToken = &telemetryAlertsListTelemetryAlertRulesApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if telemetryAlertsListTelemetryAlertRulesProjectSlugInput != "" {
+			projectSlugInput = &telemetryAlertsListTelemetryAlertRulesProjectSlugInput
+		}
+	}
+	v := &telemetryalerts.ListTelemetryAlertRulesPayload{}
+	v.ToolsetID = toolsetID
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildUpdateTelemetryAlertRulePayload builds the payload for the
+// telemetryAlerts updateTelemetryAlertRule endpoint from CLI flags.
+func BuildUpdateTelemetryAlertRulePayload(telemetryAlertsUpdateTelemetryAlertRuleBody string, telemetryAlertsUpdateTelemetryAlertRuleSessionToken string, telemetryAlertsUpdateTelemetryAlertRuleApikeyToken string, telemetryAlertsUpdateTelemetryAlertRuleProjectSlugInput string) (*telemetryalerts.UpdateTelemetryAlertRulePayload, error) {
+	var err error
+	var body UpdateTelemetryAlertRuleRequestBody
+	{
+		err = json.Unmarshal([]byte(telemetryAlertsUpdateTelemetryAlertRuleBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email_recipients\": [\n         \"alice@example.com\",\n         \"alice@example.com\",\n         \"alice@example.com\"\n      ],\n      \"enabled\": false,\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"min_calls\": 2,\n      \"name\": \"aa\",\n      \"threshold\": 1,\n      \"window_seconds\": 61\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatUUID))
+		if body.Name != nil {
+			if utf8.RuneCountInString(*body.Name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+			}
+		}
+		if body.Name != nil {
+			if utf8.RuneCountInString(*body.Name) > 100 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 100, false))
+			}
+		}
+		if body.Threshold != nil {
+			if *body.Threshold <= 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.threshold", *body.Threshold, 0, true))
+			}
+		}
+		if body.WindowSeconds != nil {
+			if *body.WindowSeconds < 60 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.window_seconds", *body.WindowSeconds, 60, true))
+			}
+		}
+		if body.WindowSeconds != nil {
+			if *body.WindowSeconds > 86400 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.window_seconds", *body.WindowSeconds, 86400, false))
+			}
+		}
+		if body.MinCalls != nil {
+			if *body.MinCalls < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.min_calls", *body.MinCalls, 1, true))
+			}
+		}
+		if len(body.EmailRecipients) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.email_recipients", body.EmailRecipients, len(body.EmailRecipients), 20, false))
+		}
+		for _, e := range body.EmailRecipients {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.email_recipients[*]", e, goa.FormatEmail))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if telemetryAlertsUpdateTelemetryAlertRuleSessionToken != "" {
+			sessionToken = &telemetryAlertsUpdateTelemetryAlertRuleSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if telemetryAlertsUpdateTelemetryAlertRuleApikeyToken != "" {
+			apikeyToken = &telemetryAlertsUpdateTelemetryAlertRuleApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if telemetryAlertsUpdateTelemetryAlertRuleProjectSlugInput != "" {
+			projectSlugInput = &telemetryAlertsUpdateTelemetryAlertRuleProjectSlugInput
+		}
+	}
+	v := &telemetryalerts.UpdateTelemetryAlertRulePayload{
+		ID:            body.ID,
+		Name:          body.Name,
+		Threshold:     body.Threshold,
+		WindowSeconds: body.WindowSeconds,
+		MinCalls:      body.MinCalls,
+		Enabled:       body.Enabled,
+	}
+	if body.EmailRecipients != nil {
+		v.EmailRecipients = make([]string, len(body.EmailRecipients))
+		for i, val := range body.EmailRecipients {
+			v.EmailRecipients[i] = val
+		}
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildDeleteTelemetryAlertRulePayload builds the payload for the
+// telemetryAlerts deleteTelemetryAlertRule endpoint from CLI flags.
+func BuildDeleteTelemetryAlertRulePayload(telemetryAlertsDeleteTelemetryAlertRuleID string, telemetryAlertsDeleteTelemetryAlertRuleSessionToken string, telemetryAlertsDeleteTelemetryAlertRuleApikeyToken string, telemetryAlertsDeleteTelemetryAlertRuleProjectSlugInput string) (*telemetryalerts.DeleteTelemetryAlertRulePayload, error) {
+	var err error
+	var id string
+	{
+		id = telemetryAlertsDeleteTelemetryAlertRuleID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if telemetryAlertsDeleteTelemetryAlertRuleSessionToken != "" {
+			sessionToken = &telemetryAlertsDeleteTelemetryAlertRuleSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if telemetryAlertsDeleteTelemetryAlertRuleApikeyToken != "" {
+			apikeyToken = &telemetryAlertsDeleteTelemetryAlertRuleApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if telemetryAlertsDeleteTelemetryAlertRuleProjectSlugInput != "" {
+			projectSlugInput = &telemetryAlertsDeleteTelemetryAlertRuleProjectSlugInput
+		}
+	}
+	v := &telemetryalerts.DeleteTelemetryAlertRulePayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
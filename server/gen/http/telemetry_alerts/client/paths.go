@@ -0,0 +1,28 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// HTTP request path constructors for the telemetryAlerts service.
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+// CreateTelemetryAlertRuleTelemetryAlertsPath returns the URL path to the telemetryAlerts service createTelemetryAlertRule HTTP endpoint.
+func CreateTelemetryAlertRuleTelemetryAlertsPath() string {
+	return "/rpc/telemetryAlerts.create"
+}
+
+// ListTelemetryAlertRulesTelemetryAlertsPath returns the URL path to the telemetryAlerts service listTelemetryAlertRules HTTP endpoint.
+func ListTelemetryAlertRulesTelemetryAlertsPath() string {
+	return "/rpc/telemetryAlerts.list"
+}
+
+// UpdateTelemetryAlertRuleTelemetryAlertsPath returns the URL path to the telemetryAlerts service updateTelemetryAlertRule HTTP endpoint.
+func UpdateTelemetryAlertRuleTelemetryAlertsPath() string {
+	return "/rpc/telemetryAlerts.update"
+}
+
+// DeleteTelemetryAlertRuleTelemetryAlertsPath returns the URL path to the telemetryAlerts service deleteTelemetryAlertRule HTTP endpoint.
+func DeleteTelemetryAlertRuleTelemetryAlertsPath() string {
+	return "/rpc/telemetryAlerts.delete"
+}
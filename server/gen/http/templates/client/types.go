@@ -8,6 +8,8 @@
 package client
 
 import (
+	"unicode/utf8"
+
 	templates "github.com/speakeasy-api/gram/server/gen/templates"
 	types "github.com/speakeasy-api/gram/server/gen/types"
 	goa "goa.design/goa/v3/pkg"
@@ -1508,12 +1510,33 @@ type ToolVariationResponseBody struct {
 	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
 	// Override: if true, the tool interacts with external entities
 	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+	// How long the gateway caches responses of this tool
+	CacheTTLSeconds *int32 `form:"cache_ttl_seconds,omitempty" json:"cache_ttl_seconds,omitempty" xml:"cache_ttl_seconds,omitempty"`
+	// If true, cached responses are shared between callers with identical
+	// environments
+	CacheShared *bool `form:"cache_shared,omitempty" json:"cache_shared,omitempty" xml:"cache_shared,omitempty"`
+	// Arguments filled server-side on every call and hidden from the tool's input
+	// schema
+	ArgumentBindings []*ToolArgumentBindingResponseBody `form:"argument_bindings,omitempty" json:"argument_bindings,omitempty" xml:"argument_bindings,omitempty"`
 	// The creation date of the tool variation
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// The last update date of the tool variation
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// ToolArgumentBindingResponseBody is used to define fields on response body
+// types.
+type ToolArgumentBindingResponseBody struct {
+	// Dotted path of the bound argument in the tool's input, e.g. body.tenant_id
+	Argument *string `form:"argument,omitempty" json:"argument,omitempty" xml:"argument,omitempty"`
+	// Where the bound value comes from
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// A JSON literal for constant bindings, an environment variable name for
+	// environment bindings, or a claim of the authenticated user (email, user_id,
+	// external_user_id, organization_id, organization_slug) for principal bindings
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
 // ToolAnnotationsResponseBody is used to define fields on response body types.
 type ToolAnnotationsResponseBody struct {
 	// Human-readable display name for the tool
@@ -4603,5 +4626,42 @@ func ValidateToolVariationResponseBody(body *ToolVariationResponseBody) (err err
 	if body.UpdatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
 	}
+	for _, e := range body.ArgumentBindings {
+		if e != nil {
+			if err2 := ValidateToolArgumentBindingResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateToolArgumentBindingResponseBody runs the validations defined on
+// ToolArgumentBindingResponseBody
+func ValidateToolArgumentBindingResponseBody(body *ToolArgumentBindingResponseBody) (err error) {
+	if body.Argument == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("argument", "body"))
+	}
+	if body.Source == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("source", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.Argument != nil {
+		if utf8.RuneCountInString(*body.Argument) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.argument", *body.Argument, utf8.RuneCountInString(*body.Argument), 1, true))
+		}
+	}
+	if body.Source != nil {
+		if !(*body.Source == "constant" || *body.Source == "environment" || *body.Source == "principal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"constant", "environment", "principal"}))
+		}
+	}
+	if body.Value != nil {
+		if utf8.RuneCountInString(*body.Value) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", *body.Value, utf8.RuneCountInString(*body.Value), 1, true))
+		}
+	}
 	return
 }
@@ -1736,6 +1736,8 @@ func marshalTypesToolVariationToToolVariationResponseBody(v *types.ToolVariation
 		DestructiveHint: v.DestructiveHint,
 		IdempotentHint:  v.IdempotentHint,
 		OpenWorldHint:   v.OpenWorldHint,
+		CacheTTLSeconds: v.CacheTTLSeconds,
+		CacheShared:     v.CacheShared,
 		CreatedAt:       v.CreatedAt,
 		UpdatedAt:       v.UpdatedAt,
 	}
@@ -1745,6 +1747,32 @@ func marshalTypesToolVariationToToolVariationResponseBody(v *types.ToolVariation
 			res.Tags[i] = val
 		}
 	}
+	if v.ArgumentBindings != nil {
+		res.ArgumentBindings = make([]*ToolArgumentBindingResponseBody, len(v.ArgumentBindings))
+		for i, val := range v.ArgumentBindings {
+			if val == nil {
+				res.ArgumentBindings[i] = nil
+				continue
+			}
+			res.ArgumentBindings[i] = marshalTypesToolArgumentBindingToToolArgumentBindingResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalTypesToolArgumentBindingToToolArgumentBindingResponseBody builds a
+// value of type *ToolArgumentBindingResponseBody from a value of type
+// *types.ToolArgumentBinding.
+func marshalTypesToolArgumentBindingToToolArgumentBindingResponseBody(v *types.ToolArgumentBinding) *ToolArgumentBindingResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolArgumentBindingResponseBody{
+		Argument: v.Argument,
+		Source:   v.Source,
+		Value:    v.Value,
+	}
 
 	return res
 }
@@ -1510,12 +1510,33 @@ type ToolVariationResponseBody struct {
 	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
 	// Override: if true, the tool interacts with external entities
 	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+	// How long the gateway caches responses of this tool
+	CacheTTLSeconds *int32 `form:"cache_ttl_seconds,omitempty" json:"cache_ttl_seconds,omitempty" xml:"cache_ttl_seconds,omitempty"`
+	// If true, cached responses are shared between callers with identical
+	// environments
+	CacheShared *bool `form:"cache_shared,omitempty" json:"cache_shared,omitempty" xml:"cache_shared,omitempty"`
+	// Arguments filled server-side on every call and hidden from the tool's input
+	// schema
+	ArgumentBindings []*ToolArgumentBindingResponseBody `form:"argument_bindings,omitempty" json:"argument_bindings,omitempty" xml:"argument_bindings,omitempty"`
 	// The creation date of the tool variation
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
 	// The last update date of the tool variation
 	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
+// ToolArgumentBindingResponseBody is used to define fields on response body
+// types.
+type ToolArgumentBindingResponseBody struct {
+	// Dotted path of the bound argument in the tool's input, e.g. body.tenant_id
+	Argument string `form:"argument" json:"argument" xml:"argument"`
+	// Where the bound value comes from
+	Source string `form:"source" json:"source" xml:"source"`
+	// A JSON literal for constant bindings, an environment variable name for
+	// environment bindings, or a claim of the authenticated user (email, user_id,
+	// external_user_id, organization_id, organization_slug) for principal bindings
+	Value string `form:"value" json:"value" xml:"value"`
+}
+
 // ToolAnnotationsResponseBody is used to define fields on response body types.
 type ToolAnnotationsResponseBody struct {
 	// Human-readable display name for the tool
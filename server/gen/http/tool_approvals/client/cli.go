@@ -0,0 +1,305 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// toolApprovals HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	toolapprovals "github.com/speakeasy-api/gram/server/gen/tool_approvals"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreateToolApprovalPolicyPayload builds the payload for the
+// toolApprovals createToolApprovalPolicy endpoint from CLI flags.
+func BuildCreateToolApprovalPolicyPayload(toolApprovalsCreateToolApprovalPolicyBody string, toolApprovalsCreateToolApprovalPolicySessionToken string, toolApprovalsCreateToolApprovalPolicyApikeyToken string, toolApprovalsCreateToolApprovalPolicyProjectSlugInput string) (*toolapprovals.CreateToolApprovalPolicyPayload, error) {
+	var err error
+	var body CreateToolApprovalPolicyRequestBody
+	{
+		err = json.Unmarshal([]byte(toolApprovalsCreateToolApprovalPolicyBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"expression\": \"aa\",\n      \"match_destructive\": false,\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"message\": \"aaa\",\n      \"notify_emails\": [\n         \"alice@example.com\",\n         \"alice@example.com\",\n         \"alice@example.com\"\n      ],\n      \"slack_webhook_url\": \"aaa\",\n      \"timeout_seconds\": 11,\n      \"tool_name\": \"aa\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }'")
+		}
+		if body.ToolsetID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.toolset_id", *body.ToolsetID, goa.FormatUUID))
+		}
+		if body.McpServerID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_server_id", *body.McpServerID, goa.FormatUUID))
+		}
+		if body.ToolName != nil {
+			if utf8.RuneCountInString(*body.ToolName) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tool_name", *body.ToolName, utf8.RuneCountInString(*body.ToolName), 1, true))
+			}
+		}
+		if body.ToolName != nil {
+			if utf8.RuneCountInString(*body.ToolName) > 128 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tool_name", *body.ToolName, utf8.RuneCountInString(*body.ToolName), 128, false))
+			}
+		}
+		if body.Expression != nil {
+			if utf8.RuneCountInString(*body.Expression) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.expression", *body.Expression, utf8.RuneCountInString(*body.Expression), 1, true))
+			}
+		}
+		if body.Expression != nil {
+			if utf8.RuneCountInString(*body.Expression) > 4096 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.expression", *body.Expression, utf8.RuneCountInString(*body.Expression), 4096, false))
+			}
+		}
+		if body.Message != nil {
+			if utf8.RuneCountInString(*body.Message) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.message", *body.Message, utf8.RuneCountInString(*body.Message), 1000, false))
+			}
+		}
+		if len(body.NotifyEmails) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.notify_emails", body.NotifyEmails, len(body.NotifyEmails), 20, false))
+		}
+		for _, e := range body.NotifyEmails {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.notify_emails[*]", e, goa.FormatEmail))
+		}
+		if body.SlackWebhookURL != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.slack_webhook_url", *body.SlackWebhookURL, goa.FormatURI))
+		}
+		if body.SlackWebhookURL != nil {
+			if utf8.RuneCountInString(*body.SlackWebhookURL) > 2048 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.slack_webhook_url", *body.SlackWebhookURL, utf8.RuneCountInString(*body.SlackWebhookURL), 2048, false))
+			}
+		}
+		if body.TimeoutSeconds < 10 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_seconds", body.TimeoutSeconds, 10, true))
+		}
+		if body.TimeoutSeconds > 900 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_seconds", body.TimeoutSeconds, 900, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolApprovalsCreateToolApprovalPolicySessionToken != "" {
+			sessionToken = &toolApprovalsCreateToolApprovalPolicySessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolApprovalsCreateToolApprovalPolicyApikeyToken != "" {
+			apikeyToken = &toolApprovalsCreateToolApprovalPolicyApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolApprovalsCreateToolApprovalPolicyProjectSlugInput != "" {
+			projectSlugInput = &toolApprovalsCreateToolApprovalPolicyProjectSlugInput
+		}
+	}
+	v := &toolapprovals.CreateToolApprovalPolicyPayload{
+		ToolsetID:        body.ToolsetID,
+		McpServerID:      body.McpServerID,
+		ToolName:         body.ToolName,
+		MatchDestructive: body.MatchDestructive,
+		Expression:       body.Expression,
+		Message:          body.Message,
+		SlackWebhookURL:  body.SlackWebhookURL,
+		TimeoutSeconds:   body.TimeoutSeconds,
+	}
+	if body.NotifyEmails != nil {
+		v.NotifyEmails = make([]string, len(body.NotifyEmails))
+		for i, val := range body.NotifyEmails {
+			v.NotifyEmails[i] = val
+		}
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildListToolApprovalPoliciesPayload builds the payload for the
+// toolApprovals listToolApprovalPolicies endpoint from CLI flags.
+func BuildListToolApprovalPoliciesPayload(toolApprovalsListToolApprovalPoliciesToolsetID string, toolApprovalsListToolApprovalPoliciesMcpServerID string, toolApprovalsListToolApprovalPoliciesSessionToken string, toolApprovalsListToolApprovalPoliciesApikeyToken string, toolApprovalsListToolApprovalPoliciesProjectSlugInput string) (*toolapprovals.ListToolApprovalPoliciesPayload, error) {
+	var err error
+	var toolsetID *string
+	{
+		if toolApprovalsListToolApprovalPoliciesToolsetID != "" {
+			toolsetID = &toolApprovalsListToolApprovalPoliciesToolsetID
+			err = goa.MergeErrors(err, goa.ValidateFormat("toolset_id", *toolsetID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var mcpServerID *string
+	{
+		if toolApprovalsListToolApprovalPoliciesMcpServerID != "" {
+			mcpServerID = &toolApprovalsListToolApprovalPoliciesMcpServerID
+			err = goa.MergeErrors(err, goa.ValidateFormat("mcp_server_id", *mcpServerID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sessionToken *string
+	{
+		if toolApprovalsListToolApprovalPoliciesSessionToken != "" {
+			sessionToken = &toolApprovalsListToolApprovalPoliciesSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolApprovalsListToolApprovalPoliciesApikeyToken != "" {
+			apikeyToken = &toolApprovalsListToolApprovalPoliciesApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolApprovalsListToolApprovalPoliciesProjectSlugInput != "" {
+			projectSlugInput = &toolApprovalsListToolApprovalPoliciesProjectSlugInput
+		}
+	}
+	v := &toolapprovals.ListToolApprovalPoliciesPayload{}
+	v.ToolsetID = toolsetID
+	v.McpServerID = mcpServerID
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildUpdateToolApprovalPolicyPayload builds the payload for the
+// toolApprovals updateToolApprovalPolicy endpoint from CLI flags.
+func BuildUpdateToolApprovalPolicyPayload(toolApprovalsUpdateToolApprovalPolicyBody string, toolApprovalsUpdateToolApprovalPolicySessionToken string, toolApprovalsUpdateToolApprovalPolicyApikeyToken string, toolApprovalsUpdateToolApprovalPolicyProjectSlugInput string) (*toolapprovals.UpdateToolApprovalPolicyPayload, error) {
+	var err error
+	var body UpdateToolApprovalPolicyRequestBody
+	{
+		err = json.Unmarshal([]byte(toolApprovalsUpdateToolApprovalPolicyBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"expression\": \"aaa\",\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"match_destructive\": false,\n      \"message\": \"aaa\",\n      \"notify_emails\": [\n         \"alice@example.com\",\n         \"alice@example.com\",\n         \"alice@example.com\"\n      ],\n      \"slack_webhook_url\": \"aaa\",\n      \"timeout_seconds\": 11,\n      \"tool_name\": \"aaa\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatUUID))
+		if body.ToolName != nil {
+			if utf8.RuneCountInString(*body.ToolName) > 128 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tool_name", *body.ToolName, utf8.RuneCountInString(*body.ToolName), 128, false))
+			}
+		}
+		if body.Expression != nil {
+			if utf8.RuneCountInString(*body.Expression) > 4096 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.expression", *body.Expression, utf8.RuneCountInString(*body.Expression), 4096, false))
+			}
+		}
+		if body.Message != nil {
+			if utf8.RuneCountInString(*body.Message) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.message", *body.Message, utf8.RuneCountInString(*body.Message), 1000, false))
+			}
+		}
+		if len(body.NotifyEmails) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.notify_emails", body.NotifyEmails, len(body.NotifyEmails), 20, false))
+		}
+		for _, e := range body.NotifyEmails {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.notify_emails[*]", e, goa.FormatEmail))
+		}
+		if body.SlackWebhookURL != nil {
+			if utf8.RuneCountInString(*body.SlackWebhookURL) > 2048 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.slack_webhook_url", *body.SlackWebhookURL, utf8.RuneCountInString(*body.SlackWebhookURL), 2048, false))
+			}
+		}
+		if body.TimeoutSeconds != nil {
+			if *body.TimeoutSeconds < 10 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_seconds", *body.TimeoutSeconds, 10, true))
+			}
+		}
+		if body.TimeoutSeconds != nil {
+			if *body.TimeoutSeconds > 900 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.timeout_seconds", *body.TimeoutSeconds, 900, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolApprovalsUpdateToolApprovalPolicySessionToken != "" {
+			sessionToken = &toolApprovalsUpdateToolApprovalPolicySessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolApprovalsUpdateToolApprovalPolicyApikeyToken != "" {
+			apikeyToken = &toolApprovalsUpdateToolApprovalPolicyApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolApprovalsUpdateToolApprovalPolicyProjectSlugInput != "" {
+			projectSlugInput = &toolApprovalsUpdateToolApprovalPolicyProjectSlugInput
+		}
+	}
+	v := &toolapprovals.UpdateToolApprovalPolicyPayload{
+		ID:               body.ID,
+		ToolName:         body.ToolName,
+		MatchDestructive: body.MatchDestructive,
+		Expression:       body.Expression,
+		Message:          body.Message,
+		SlackWebhookURL:  body.SlackWebhookURL,
+		TimeoutSeconds:   body.TimeoutSeconds,
+	}
+	if body.NotifyEmails != nil {
+		v.NotifyEmails = make([]string, len(body.NotifyEmails))
+		for i, val := range body.NotifyEmails {
+			v.NotifyEmails[i] = val
+		}
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildDeleteToolApprovalPolicyPayload builds the payload for the
+// toolApprovals deleteToolApprovalPolicy endpoint from CLI flags.
+func BuildDeleteToolApprovalPolicyPayload(toolApprovalsDeleteToolApprovalPolicyID string, toolApprovalsDeleteToolApprovalPolicySessionToken string, toolApprovalsDeleteToolApprovalPolicyApikeyToken string, toolApprovalsDeleteToolApprovalPolicyProjectSlugInput string) (*toolapprovals.DeleteToolApprovalPolicyPayload, error) {
+	var err error
+	var id string
+	{
+		id = toolApprovalsDeleteToolApprovalPolicyID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolApprovalsDeleteToolApprovalPolicySessionToken != "" {
+			sessionToken = &toolApprovalsDeleteToolApprovalPolicySessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolApprovalsDeleteToolApprovalPolicyApikeyToken != "" {
+			apikeyToken = &toolApprovalsDeleteToolApprovalPolicyApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolApprovalsDeleteToolApprovalPolicyProjectSlugInput != "" {
+			projectSlugInput = &toolApprovalsDeleteToolApprovalPolicyProjectSlugInput
+		}
+	}
+	v := &toolapprovals.DeleteToolApprovalPolicyPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
@@ -0,0 +1,28 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// HTTP request path constructors for the toolApprovals service.
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+// CreateToolApprovalPolicyToolApprovalsPath returns the URL path to the toolApprovals service createToolApprovalPolicy HTTP endpoint.
+func CreateToolApprovalPolicyToolApprovalsPath() string {
+	return "/rpc/toolApprovals.create"
+}
+
+// ListToolApprovalPoliciesToolApprovalsPath returns the URL path to the toolApprovals service listToolApprovalPolicies HTTP endpoint.
+func ListToolApprovalPoliciesToolApprovalsPath() string {
+	return "/rpc/toolApprovals.list"
+}
+
+// UpdateToolApprovalPolicyToolApprovalsPath returns the URL path to the toolApprovals service updateToolApprovalPolicy HTTP endpoint.
+func UpdateToolApprovalPolicyToolApprovalsPath() string {
+	return "/rpc/toolApprovals.update"
+}
+
+// DeleteToolApprovalPolicyToolApprovalsPath returns the URL path to the toolApprovals service deleteToolApprovalPolicy HTTP endpoint.
+func DeleteToolApprovalPolicyToolApprovalsPath() string {
+	return "/rpc/toolApprovals.delete"
+}
@@ -0,0 +1,266 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// toolCallRecordings HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	toolcallrecordings "github.com/speakeasy-api/gram/server/gen/tool_call_recordings"
+	types "github.com/speakeasy-api/gram/server/gen/types"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildStartToolCallRecordingPayload builds the payload for the
+// toolCallRecordings startToolCallRecording endpoint from CLI flags.
+func BuildStartToolCallRecordingPayload(toolCallRecordingsStartToolCallRecordingBody string, toolCallRecordingsStartToolCallRecordingSessionToken string, toolCallRecordingsStartToolCallRecordingApikeyToken string, toolCallRecordingsStartToolCallRecordingProjectSlugInput string) (*toolcallrecordings.StartToolCallRecordingPayload, error) {
+	var err error
+	var body StartToolCallRecordingRequestBody
+	{
+		err = json.Unmarshal([]byte(toolCallRecordingsStartToolCallRecordingBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"duration_minutes\": 2,\n      \"max_calls\": 2,\n      \"toolset_slug\": \"aaa\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.toolset_slug", body.ToolsetSlug, "^[a-z0-9_-]{1,128}$"))
+		if utf8.RuneCountInString(body.ToolsetSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.toolset_slug", body.ToolsetSlug, utf8.RuneCountInString(body.ToolsetSlug), 40, false))
+		}
+		if body.MaxCalls < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_calls", body.MaxCalls, 1, true))
+		}
+		if body.MaxCalls > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_calls", body.MaxCalls, 1000, false))
+		}
+		if body.DurationMinutes < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration_minutes", body.DurationMinutes, 1, true))
+		}
+		if body.DurationMinutes > 1440 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration_minutes", body.DurationMinutes, 1440, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolCallRecordingsStartToolCallRecordingSessionToken != "" {
+			sessionToken = &toolCallRecordingsStartToolCallRecordingSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolCallRecordingsStartToolCallRecordingApikeyToken != "" {
+			apikeyToken = &toolCallRecordingsStartToolCallRecordingApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolCallRecordingsStartToolCallRecordingProjectSlugInput != "" {
+			projectSlugInput = &toolCallRecordingsStartToolCallRecordingProjectSlugInput
+		}
+	}
+	v := &toolcallrecordings.StartToolCallRecordingPayload{
+		ToolsetSlug:     types.Slug(body.ToolsetSlug),
+		MaxCalls:        body.MaxCalls,
+		DurationMinutes: body.DurationMinutes,
+	}
+	{
+		var zero int32
+		if v.MaxCalls == zero {
+			v.MaxCalls = 100
+		}
+	}
+	{
+		var zero int32
+		if v.DurationMinutes == zero {
+			v.DurationMinutes = 60
+		}
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildStopToolCallRecordingPayload builds the payload for the
+// toolCallRecordings stopToolCallRecording endpoint from CLI flags.
+func BuildStopToolCallRecordingPayload(toolCallRecordingsStopToolCallRecordingBody string, toolCallRecordingsStopToolCallRecordingSessionToken string, toolCallRecordingsStopToolCallRecordingApikeyToken string, toolCallRecordingsStopToolCallRecordingProjectSlugInput string) (*toolcallrecordings.StopToolCallRecordingPayload, error) {
+	var err error
+	var body StopToolCallRecordingRequestBody
+	{
+		err = json.Unmarshal([]byte(toolCallRecordingsStopToolCallRecordingBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolCallRecordingsStopToolCallRecordingSessionToken != "" {
+			sessionToken = &toolCallRecordingsStopToolCallRecordingSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolCallRecordingsStopToolCallRecordingApikeyToken != "" {
+			apikeyToken = &toolCallRecordingsStopToolCallRecordingApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolCallRecordingsStopToolCallRecordingProjectSlugInput != "" {
+			projectSlugInput = &toolCallRecordingsStopToolCallRecordingProjectSlugInput
+		}
+	}
+	v := &toolcallrecordings.StopToolCallRecordingPayload{
+		ID: body.ID,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildListToolCallRecordingSessionsPayload builds the payload for the
+// toolCallRecordings listToolCallRecordingSessions endpoint from CLI flags.
+func BuildListToolCallRecordingSessionsPayload(toolCallRecordingsListToolCallRecordingSessionsToolsetSlug string, toolCallRecordingsListToolCallRecordingSessionsSessionToken string, toolCallRecordingsListToolCallRecordingSessionsApikeyToken string, toolCallRecordingsListToolCallRecordingSessionsProjectSlugInput string) (*toolcallrecordings.ListToolCallRecordingSessionsPayload, error) {
+	var err error
+	var toolsetSlug *string
+	{
+		if toolCallRecordingsListToolCallRecordingSessionsToolsetSlug != "" {
+			toolsetSlug = &toolCallRecordingsListToolCallRecordingSessionsToolsetSlug
+			err = goa.MergeErrors(err, goa.ValidatePattern("toolset_slug", *toolsetSlug, "^[a-z0-9_-]{1,128}$"))
+			if utf8.RuneCountInString(*toolsetSlug) > 40 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("toolset_slug", *toolsetSlug, utf8.RuneCountInString(*toolsetSlug), 40, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sessionToken *string
+	{
+		if toolCallRecordingsListToolCallRecordingSessionsSessionToken != "" {
+			sessionToken = &toolCallRecordingsListToolCallRecordingSessionsSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolCallRecordingsListToolCallRecordingSessionsApikeyToken != "" {
+			apikeyToken = &toolCallRecordingsListToolCallRecordingSessionsApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolCallRecordingsListToolCallRecordingSessionsProjectSlugInput != "" {
+			projectSlugInput = &toolCallRecordingsListToolCallRecordingSessionsProjectSlugInput
+		}
+	}
+	v := &toolcallrecordings.ListToolCallRecordingSessionsPayload{}
+	if toolsetSlug != nil {
+		tmptoolsetSlug := types.Slug(*toolsetSlug)
+		v.ToolsetSlug = &tmptoolsetSlug
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildExportToolCallRecordingsPayload builds the payload for the
+// toolCallRecordings exportToolCallRecordings endpoint from CLI flags.
+func BuildExportToolCallRecordingsPayload(toolCallRecordingsExportToolCallRecordingsSessionID string, toolCallRecordingsExportToolCallRecordingsSessionToken string, toolCallRecordingsExportToolCallRecordingsApikeyToken string, toolCallRecordingsExportToolCallRecordingsProjectSlugInput string) (*toolcallrecordings.ExportToolCallRecordingsPayload, error) {
+	var err error
+	var sessionID string
+	{
+		sessionID = toolCallRecordingsExportToolCallRecordingsSessionID
+		err = goa.MergeErrors(err, goa.ValidateFormat("session_id", sessionID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolCallRecordingsExportToolCallRecordingsSessionToken != "" {
+			sessionToken = &toolCallRecordingsExportToolCallRecordingsSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolCallRecordingsExportToolCallRecordingsApikeyToken != "" {
+			apikeyToken = &toolCallRecordingsExportToolCallRecordingsApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolCallRecordingsExportToolCallRecordingsProjectSlugInput != "" {
+			projectSlugInput = &toolCallRecordingsExportToolCallRecordingsProjectSlugInput
+		}
+	}
+	v := &toolcallrecordings.ExportToolCallRecordingsPayload{}
+	v.SessionID = sessionID
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildReplayToolCallRecordingsPayload builds the payload for the
+// toolCallRecordings replayToolCallRecordings endpoint from CLI flags.
+func BuildReplayToolCallRecordingsPayload(toolCallRecordingsReplayToolCallRecordingsBody string, toolCallRecordingsReplayToolCallRecordingsSessionToken string, toolCallRecordingsReplayToolCallRecordingsApikeyToken string, toolCallRecordingsReplayToolCallRecordingsProjectSlugInput string) (*toolcallrecordings.ReplayToolCallRecordingsPayload, error) {
+	var err error
+	var body ReplayToolCallRecordingsRequestBody
+	{
+		err = json.Unmarshal([]byte(toolCallRecordingsReplayToolCallRecordingsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"deployment_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"session_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.session_id", body.SessionID, goa.FormatUUID))
+		if body.DeploymentID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.deployment_id", *body.DeploymentID, goa.FormatUUID))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolCallRecordingsReplayToolCallRecordingsSessionToken != "" {
+			sessionToken = &toolCallRecordingsReplayToolCallRecordingsSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolCallRecordingsReplayToolCallRecordingsApikeyToken != "" {
+			apikeyToken = &toolCallRecordingsReplayToolCallRecordingsApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolCallRecordingsReplayToolCallRecordingsProjectSlugInput != "" {
+			projectSlugInput = &toolCallRecordingsReplayToolCallRecordingsProjectSlugInput
+		}
+	}
+	v := &toolcallrecordings.ReplayToolCallRecordingsPayload{
+		SessionID:    body.SessionID,
+		DeploymentID: body.DeploymentID,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
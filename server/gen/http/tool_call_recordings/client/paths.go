@@ -0,0 +1,33 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// HTTP request path constructors for the toolCallRecordings service.
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+// StartToolCallRecordingToolCallRecordingsPath returns the URL path to the toolCallRecordings service startToolCallRecording HTTP endpoint.
+func StartToolCallRecordingToolCallRecordingsPath() string {
+	return "/rpc/toolCallRecordings.start"
+}
+
+// StopToolCallRecordingToolCallRecordingsPath returns the URL path to the toolCallRecordings service stopToolCallRecording HTTP endpoint.
+func StopToolCallRecordingToolCallRecordingsPath() string {
+	return "/rpc/toolCallRecordings.stop"
+}
+
+// ListToolCallRecordingSessionsToolCallRecordingsPath returns the URL path to the toolCallRecordings service listToolCallRecordingSessions HTTP endpoint.
+func ListToolCallRecordingSessionsToolCallRecordingsPath() string {
+	return "/rpc/toolCallRecordings.listSessions"
+}
+
+// ExportToolCallRecordingsToolCallRecordingsPath returns the URL path to the toolCallRecordings service exportToolCallRecordings HTTP endpoint.
+func ExportToolCallRecordingsToolCallRecordingsPath() string {
+	return "/rpc/toolCallRecordings.export"
+}
+
+// ReplayToolCallRecordingsToolCallRecordingsPath returns the URL path to the toolCallRecordings service replayToolCallRecordings HTTP endpoint.
+func ReplayToolCallRecordingsToolCallRecordingsPath() string {
+	return "/rpc/toolCallRecordings.replay"
+}
@@ -0,0 +1,247 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// toolConstraints HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	toolconstraints "github.com/speakeasy-api/gram/server/gen/tool_constraints"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreateToolConstraintPayload builds the payload for the toolConstraints
+// createToolConstraint endpoint from CLI flags.
+func BuildCreateToolConstraintPayload(toolConstraintsCreateToolConstraintBody string, toolConstraintsCreateToolConstraintSessionToken string, toolConstraintsCreateToolConstraintApikeyToken string, toolConstraintsCreateToolConstraintProjectSlugInput string) (*toolconstraints.CreateToolConstraintPayload, error) {
+	var err error
+	var body CreateToolConstraintRequestBody
+	{
+		err = json.Unmarshal([]byte(toolConstraintsCreateToolConstraintBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"effect\": \"deny\",\n      \"expression\": \"aa\",\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"message\": \"aaa\",\n      \"tool_name\": \"aa\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }'")
+		}
+		if body.ToolsetID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.toolset_id", *body.ToolsetID, goa.FormatUUID))
+		}
+		if body.McpServerID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_server_id", *body.McpServerID, goa.FormatUUID))
+		}
+		if utf8.RuneCountInString(body.ToolName) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tool_name", body.ToolName, utf8.RuneCountInString(body.ToolName), 1, true))
+		}
+		if utf8.RuneCountInString(body.ToolName) > 128 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tool_name", body.ToolName, utf8.RuneCountInString(body.ToolName), 128, false))
+		}
+		if !(body.Effect == "allow" || body.Effect == "deny") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.effect", body.Effect, []any{"allow", "deny"}))
+		}
+		if utf8.RuneCountInString(body.Expression) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.expression", body.Expression, utf8.RuneCountInString(body.Expression), 1, true))
+		}
+		if utf8.RuneCountInString(body.Expression) > 4096 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.expression", body.Expression, utf8.RuneCountInString(body.Expression), 4096, false))
+		}
+		if body.Message != nil {
+			if utf8.RuneCountInString(*body.Message) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.message", *body.Message, utf8.RuneCountInString(*body.Message), 1000, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolConstraintsCreateToolConstraintSessionToken != "" {
+			sessionToken = &toolConstraintsCreateToolConstraintSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolConstraintsCreateToolConstraintApikeyToken != "" {
+			apikeyToken = &toolConstraintsCreateToolConstraintApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolConstraintsCreateToolConstraintProjectSlugInput != "" {
+			projectSlugInput = &toolConstraintsCreateToolConstraintProjectSlugInput
+		}
+	}
+	v := &toolconstraints.CreateToolConstraintPayload{
+		ToolsetID:   body.ToolsetID,
+		McpServerID: body.McpServerID,
+		ToolName:    body.ToolName,
+		Effect:      body.Effect,
+		Expression:  body.Expression,
+		Message:     body.Message,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildListToolConstraintsPayload builds the payload for the toolConstraints
+// listToolConstraints endpoint from CLI flags.
+func BuildListToolConstraintsPayload(toolConstraintsListToolConstraintsToolsetID string, toolConstraintsListToolConstraintsMcpServerID string, toolConstraintsListToolConstraintsSessionToken string, toolConstraintsListToolConstraintsApikeyToken string, toolConstraintsListToolConstraintsProjectSlugInput string) (*toolconstraints.ListToolConstraintsPayload, error) {
+	var err error
+	var toolsetID *string
+	{
+		if toolConstraintsListToolConstraintsToolsetID != "" {
+			toolsetID = &toolConstraintsListToolConstraintsToolsetID
+			err = goa.MergeErrors(err, goa.ValidateFormat("toolset_id", *toolsetID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var mcpServerID *string
+	{
+		if toolConstraintsListToolConstraintsMcpServerID != "" {
+			mcpServerID = &toolConstraintsListToolConstraintsMcpServerID
+			err = goa.MergeErrors(err, goa.ValidateFormat("mcp_server_id", *mcpServerID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sessionToken *string
+	{
+		if toolConstraintsListToolConstraintsSessionToken != "" {
+			sessionToken = &toolConstraintsListToolConstraintsSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolConstraintsListToolConstraintsApikeyToken != "" {
+			apikeyToken = &toolConstraintsListToolConstraintsApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolConstraintsListToolConstraintsProjectSlugInput != "" {
+			projectSlugInput = &toolConstraintsListToolConstraintsProjectSlugInput
+		}
+	}
+	v := &toolconstraints.ListToolConstraintsPayload{}
+	v.ToolsetID = toolsetID
+	v.McpServerID = mcpServerID
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildUpdateToolConstraintPayload builds the payload for the toolConstraints
+// updateToolConstraint endpoint from CLI flags.
+func BuildUpdateToolConstraintPayload(toolConstraintsUpdateToolConstraintBody string, toolConstraintsUpdateToolConstraintSessionToken string, toolConstraintsUpdateToolConstraintApikeyToken string, toolConstraintsUpdateToolConstraintProjectSlugInput string) (*toolconstraints.UpdateToolConstraintPayload, error) {
+	var err error
+	var body UpdateToolConstraintRequestBody
+	{
+		err = json.Unmarshal([]byte(toolConstraintsUpdateToolConstraintBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"effect\": \"deny\",\n      \"expression\": \"aa\",\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"message\": \"aaa\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatUUID))
+		if body.Effect != nil {
+			if !(*body.Effect == "allow" || *body.Effect == "deny") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.effect", *body.Effect, []any{"allow", "deny"}))
+			}
+		}
+		if body.Expression != nil {
+			if utf8.RuneCountInString(*body.Expression) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.expression", *body.Expression, utf8.RuneCountInString(*body.Expression), 1, true))
+			}
+		}
+		if body.Expression != nil {
+			if utf8.RuneCountInString(*body.Expression) > 4096 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.expression", *body.Expression, utf8.RuneCountInString(*body.Expression), 4096, false))
+			}
+		}
+		if body.Message != nil {
+			if utf8.RuneCountInString(*body.Message) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.message", *body.Message, utf8.RuneCountInString(*body.Message), 1000, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolConstraintsUpdateToolConstraintSessionToken != "" {
+			sessionToken = &toolConstraintsUpdateToolConstraintSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolConstraintsUpdateToolConstraintApikeyToken != "" {
+			apikeyToken = &toolConstraintsUpdateToolConstraintApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolConstraintsUpdateToolConstraintProjectSlugInput != "" {
+			projectSlugInput = &toolConstraintsUpdateToolConstraintProjectSlugInput
+		}
+	}
+	v := &toolconstraints.UpdateToolConstraintPayload{
+		ID:         body.ID,
+		Effect:     body.Effect,
+		Expression: body.Expression,
+		Message:    body.Message,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildDeleteToolConstraintPayload builds the payload for the toolConstraints
+// deleteToolConstraint endpoint from CLI flags.
+func BuildDeleteToolConstraintPayload(toolConstraintsDeleteToolConstraintID string, toolConstraintsDeleteToolConstraintSessionToken string, toolConstraintsDeleteToolConstraintApikeyToken string, toolConstraintsDeleteToolConstraintProjectSlugInput string) (*toolconstraints.DeleteToolConstraintPayload, error) {
+	var err error
+	var id string
+	{
+		id = toolConstraintsDeleteToolConstraintID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolConstraintsDeleteToolConstraintSessionToken != "" {
+			sessionToken = &toolConstraintsDeleteToolConstraintSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolConstraintsDeleteToolConstraintApikeyToken != "" {
+			apikeyToken = &toolConstraintsDeleteToolConstraintApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolConstraintsDeleteToolConstraintProjectSlugInput != "" {
+			projectSlugInput = &toolConstraintsDeleteToolConstraintProjectSlugInput
+		}
+	}
+	v := &toolconstraints.DeleteToolConstraintPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
@@ -0,0 +1,28 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// HTTP request path constructors for the toolConstraints service.
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+// CreateToolConstraintToolConstraintsPath returns the URL path to the toolConstraints service createToolConstraint HTTP endpoint.
+func CreateToolConstraintToolConstraintsPath() string {
+	return "/rpc/toolConstraints.create"
+}
+
+// ListToolConstraintsToolConstraintsPath returns the URL path to the toolConstraints service listToolConstraints HTTP endpoint.
+func ListToolConstraintsToolConstraintsPath() string {
+	return "/rpc/toolConstraints.list"
+}
+
+// UpdateToolConstraintToolConstraintsPath returns the URL path to the toolConstraints service updateToolConstraint HTTP endpoint.
+func UpdateToolConstraintToolConstraintsPath() string {
+	return "/rpc/toolConstraints.update"
+}
+
+// DeleteToolConstraintToolConstraintsPath returns the URL path to the toolConstraints service deleteToolConstraint HTTP endpoint.
+func DeleteToolConstraintToolConstraintsPath() string {
+	return "/rpc/toolConstraints.delete"
+}
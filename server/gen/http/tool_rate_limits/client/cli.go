@@ -0,0 +1,255 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// toolRateLimits HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+
+	toolratelimits "github.com/speakeasy-api/gram/server/gen/tool_rate_limits"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreateToolRateLimitPayload builds the payload for the toolRateLimits
+// createToolRateLimit endpoint from CLI flags.
+func BuildCreateToolRateLimitPayload(toolRateLimitsCreateToolRateLimitBody string, toolRateLimitsCreateToolRateLimitSessionToken string, toolRateLimitsCreateToolRateLimitApikeyToken string, toolRateLimitsCreateToolRateLimitProjectSlugInput string) (*toolratelimits.CreateToolRateLimitPayload, error) {
+	var err error
+	var body CreateToolRateLimitRequestBody
+	{
+		err = json.Unmarshal([]byte(toolRateLimitsCreateToolRateLimitBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"burst\": 2,\n      \"interval_seconds\": 2,\n      \"mcp_server_id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"requests\": 2,\n      \"subject\": \"api_key\",\n      \"tool_name\": \"aa\",\n      \"toolset_id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }'")
+		}
+		if body.ToolsetID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.toolset_id", *body.ToolsetID, goa.FormatUUID))
+		}
+		if body.McpServerID != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.mcp_server_id", *body.McpServerID, goa.FormatUUID))
+		}
+		if !(body.Subject == "end_user" || body.Subject == "api_key" || body.Subject == "all") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.subject", body.Subject, []any{"end_user", "api_key", "all"}))
+		}
+		if body.ToolName != nil {
+			if utf8.RuneCountInString(*body.ToolName) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tool_name", *body.ToolName, utf8.RuneCountInString(*body.ToolName), 1, true))
+			}
+		}
+		if body.ToolName != nil {
+			if utf8.RuneCountInString(*body.ToolName) > 128 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.tool_name", *body.ToolName, utf8.RuneCountInString(*body.ToolName), 128, false))
+			}
+		}
+		if body.Requests < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.requests", body.Requests, 1, true))
+		}
+		if body.IntervalSeconds < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.interval_seconds", body.IntervalSeconds, 1, true))
+		}
+		if body.IntervalSeconds > 86400 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.interval_seconds", body.IntervalSeconds, 86400, false))
+		}
+		if body.Burst != nil {
+			if *body.Burst < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.burst", *body.Burst, 1, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolRateLimitsCreateToolRateLimitSessionToken != "" {
+			sessionToken = &toolRateLimitsCreateToolRateLimitSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolRateLimitsCreateToolRateLimitApikeyToken != "" {
+			apikeyToken = &toolRateLimitsCreateToolRateLimitApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolRateLimitsCreateToolRateLimitProjectSlugInput != "" {
+			projectSlugInput = &toolRateLimitsCreateToolRateLimitProjectSlugInput
+		}
+	}
+	v := &toolratelimits.CreateToolRateLimitPayload{
+		ToolsetID:       body.ToolsetID,
+		McpServerID:     body.McpServerID,
+		Subject:         body.Subject,
+		ToolName:        body.ToolName,
+		Requests:        body.Requests,
+		IntervalSeconds: body.IntervalSeconds,
+		Burst:           body.Burst,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildListToolRateLimitsPayload builds the payload for the toolRateLimits
+// listToolRateLimits endpoint from CLI flags.
+func BuildListToolRateLimitsPayload(toolRateLimitsListToolRateLimitsToolsetID string, toolRateLimitsListToolRateLimitsMcpServerID string, toolRateLimitsListToolRateLimitsSessionToken string, toolRateLimitsListToolRateLimitsApikeyToken string, toolRateLimitsListToolRateLimitsProjectSlugInput string) (*toolratelimits.ListToolRateLimitsPayload, error) {
+	var err error
+	var toolsetID *string
+	{
+		if toolRateLimitsListToolRateLimitsToolsetID != "" {
+			toolsetID = &toolRateLimitsListToolRateLimitsToolsetID
+			err = goa.MergeErrors(err, goa.ValidateFormat("toolset_id", *toolsetID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var mcpServerID *string
+	{
+		if toolRateLimitsListToolRateLimitsMcpServerID != "" {
+			mcpServerID = &toolRateLimitsListToolRateLimitsMcpServerID
+			err = goa.MergeErrors(err, goa.ValidateFormat("mcp_server_id", *mcpServerID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sessionToken *string
+	{
+		if toolRateLimitsListToolRateLimitsSessionToken != "" {
+			sessionToken = &toolRateLimitsListToolRateLimitsSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolRateLimitsListToolRateLimitsApikeyToken != "" {
+			apikeyToken = &toolRateLimitsListToolRateLimitsApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolRateLimitsListToolRateLimitsProjectSlugInput != "" {
+			projectSlugInput = &toolRateLimitsListToolRateLimitsProjectSlugInput
+		}
+	}
+	v := &toolratelimits.ListToolRateLimitsPayload{}
+	v.ToolsetID = toolsetID
+	v.McpServerID = mcpServerID
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildUpdateToolRateLimitPayload builds the payload for the toolRateLimits
+// updateToolRateLimit endpoint from CLI flags.
+func BuildUpdateToolRateLimitPayload(toolRateLimitsUpdateToolRateLimitBody string, toolRateLimitsUpdateToolRateLimitSessionToken string, toolRateLimitsUpdateToolRateLimitApikeyToken string, toolRateLimitsUpdateToolRateLimitProjectSlugInput string) (*toolratelimits.UpdateToolRateLimitPayload, error) {
+	var err error
+	var body UpdateToolRateLimitRequestBody
+	{
+		err = json.Unmarshal([]byte(toolRateLimitsUpdateToolRateLimitBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"burst\": 2,\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"interval_seconds\": 2,\n      \"requests\": 2\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatUUID))
+		if body.Requests != nil {
+			if *body.Requests < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.requests", *body.Requests, 1, true))
+			}
+		}
+		if body.IntervalSeconds != nil {
+			if *body.IntervalSeconds < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.interval_seconds", *body.IntervalSeconds, 1, true))
+			}
+		}
+		if body.IntervalSeconds != nil {
+			if *body.IntervalSeconds > 86400 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.interval_seconds", *body.IntervalSeconds, 86400, false))
+			}
+		}
+		if body.Burst != nil {
+			if *body.Burst < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.burst", *body.Burst, 1, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolRateLimitsUpdateToolRateLimitSessionToken != "" {
+			sessionToken = &toolRateLimitsUpdateToolRateLimitSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolRateLimitsUpdateToolRateLimitApikeyToken != "" {
+			apikeyToken = &toolRateLimitsUpdateToolRateLimitApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolRateLimitsUpdateToolRateLimitProjectSlugInput != "" {
+			projectSlugInput = &toolRateLimitsUpdateToolRateLimitProjectSlugInput
+		}
+	}
+	v := &toolratelimits.UpdateToolRateLimitPayload{
+		ID:              body.ID,
+		Requests:        body.Requests,
+		IntervalSeconds: body.IntervalSeconds,
+		Burst:           body.Burst,
+	}
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildDeleteToolRateLimitPayload builds the payload for the toolRateLimits
+// deleteToolRateLimit endpoint from CLI flags.
+func BuildDeleteToolRateLimitPayload(toolRateLimitsDeleteToolRateLimitID string, toolRateLimitsDeleteToolRateLimitSessionToken string, toolRateLimitsDeleteToolRateLimitApikeyToken string, toolRateLimitsDeleteToolRateLimitProjectSlugInput string) (*toolratelimits.DeleteToolRateLimitPayload, error) {
+	var err error
+	var id string
+	{
+		id = toolRateLimitsDeleteToolRateLimitID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolRateLimitsDeleteToolRateLimitSessionToken != "" {
+			sessionToken = &toolRateLimitsDeleteToolRateLimitSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolRateLimitsDeleteToolRateLimitApikeyToken != "" {
+			apikeyToken = &toolRateLimitsDeleteToolRateLimitApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolRateLimitsDeleteToolRateLimitProjectSlugInput != "" {
+			projectSlugInput = &toolRateLimitsDeleteToolRateLimitProjectSlugInput
+		}
+	}
+	v := &toolratelimits.DeleteToolRateLimitPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
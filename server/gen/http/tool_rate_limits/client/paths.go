@@ -0,0 +1,28 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// HTTP request path constructors for the toolRateLimits service.
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+// CreateToolRateLimitToolRateLimitsPath returns the URL path to the toolRateLimits service createToolRateLimit HTTP endpoint.
+func CreateToolRateLimitToolRateLimitsPath() string {
+	return "/rpc/toolRateLimits.create"
+}
+
+// ListToolRateLimitsToolRateLimitsPath returns the URL path to the toolRateLimits service listToolRateLimits HTTP endpoint.
+func ListToolRateLimitsToolRateLimitsPath() string {
+	return "/rpc/toolRateLimits.list"
+}
+
+// UpdateToolRateLimitToolRateLimitsPath returns the URL path to the toolRateLimits service updateToolRateLimit HTTP endpoint.
+func UpdateToolRateLimitToolRateLimitsPath() string {
+	return "/rpc/toolRateLimits.update"
+}
+
+// DeleteToolRateLimitToolRateLimitsPath returns the URL path to the toolRateLimits service deleteToolRateLimit HTTP endpoint.
+func DeleteToolRateLimitToolRateLimitsPath() string {
+	return "/rpc/toolRateLimits.delete"
+}
@@ -408,6 +408,8 @@ func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResp
 		DestructiveHint: v.DestructiveHint,
 		IdempotentHint:  v.IdempotentHint,
 		OpenWorldHint:   v.OpenWorldHint,
+		CacheTTLSeconds: v.CacheTTLSeconds,
+		CacheShared:     v.CacheShared,
 		CreatedAt:       *v.CreatedAt,
 		UpdatedAt:       *v.UpdatedAt,
 	}
@@ -417,6 +419,32 @@ func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResp
 			res.Tags[i] = val
 		}
 	}
+	if v.ArgumentBindings != nil {
+		res.ArgumentBindings = make([]*types.ToolArgumentBinding, len(v.ArgumentBindings))
+		for i, val := range v.ArgumentBindings {
+			if val == nil {
+				res.ArgumentBindings[i] = nil
+				continue
+			}
+			res.ArgumentBindings[i] = unmarshalToolArgumentBindingResponseBodyToTypesToolArgumentBinding(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalToolArgumentBindingResponseBodyToTypesToolArgumentBinding builds a
+// value of type *types.ToolArgumentBinding from a value of type
+// *ToolArgumentBindingResponseBody.
+func unmarshalToolArgumentBindingResponseBodyToTypesToolArgumentBinding(v *ToolArgumentBindingResponseBody) *types.ToolArgumentBinding {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolArgumentBinding{
+		Argument: *v.Argument,
+		Source:   *v.Source,
+		Value:    *v.Value,
+	}
 
 	return res
 }
@@ -10,6 +10,7 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"unicode/utf8"
 
 	toolsets "github.com/speakeasy-api/gram/server/gen/toolsets"
@@ -667,3 +668,130 @@ func BuildSetToolVariationsGroupPayload(toolsetsSetToolVariationsGroupBody strin
 
 	return v, nil
 }
+
+// BuildDiffToolsetVersionsPayload builds the payload for the toolsets
+// diffToolsetVersions endpoint from CLI flags.
+func BuildDiffToolsetVersionsPayload(toolsetsDiffToolsetVersionsSlug string, toolsetsDiffToolsetVersionsFromVersion string, toolsetsDiffToolsetVersionsToVersion string, toolsetsDiffToolsetVersionsSessionToken string, toolsetsDiffToolsetVersionsApikeyToken string, toolsetsDiffToolsetVersionsProjectSlugInput string) (*toolsets.DiffToolsetVersionsPayload, error) {
+	var err error
+	var slug string
+	{
+		slug = toolsetsDiffToolsetVersionsSlug
+		err = goa.MergeErrors(err, goa.ValidatePattern("slug", slug, "^[a-z0-9_-]{1,128}$"))
+		if utf8.RuneCountInString(slug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("slug", slug, utf8.RuneCountInString(slug), 40, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var fromVersion int64
+	{
+		fromVersion, err = strconv.ParseInt(toolsetsDiffToolsetVersionsFromVersion, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for fromVersion, must be INT64")
+		}
+		if fromVersion < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("from_version", fromVersion, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var toVersion int64
+	{
+		toVersion, err = strconv.ParseInt(toolsetsDiffToolsetVersionsToVersion, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for toVersion, must be INT64")
+		}
+		if toVersion < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("to_version", toVersion, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolsetsDiffToolsetVersionsSessionToken != "" {
+			sessionToken = &toolsetsDiffToolsetVersionsSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolsetsDiffToolsetVersionsApikeyToken != "" {
+			apikeyToken = &toolsetsDiffToolsetVersionsApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolsetsDiffToolsetVersionsProjectSlugInput != "" {
+			projectSlugInput = &toolsetsDiffToolsetVersionsProjectSlugInput
+		}
+	}
+	v := &toolsets.DiffToolsetVersionsPayload{}
+	v.Slug = types.Slug(slug)
+	v.FromVersion = fromVersion
+	v.ToVersion = toVersion
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
+
+// BuildRevertToolsetPayload builds the payload for the toolsets revertToolset
+// endpoint from CLI flags.
+func BuildRevertToolsetPayload(toolsetsRevertToolsetBody string, toolsetsRevertToolsetSlug string, toolsetsRevertToolsetSessionToken string, toolsetsRevertToolsetApikeyToken string, toolsetsRevertToolsetProjectSlugInput string) (*toolsets.RevertToolsetPayload, error) {
+	var err error
+	var body RevertToolsetRequestBody
+	{
+		err = json.Unmarshal([]byte(toolsetsRevertToolsetBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"version\": 2\n   }'")
+		}
+		if body.Version < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.version", body.Version, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var slug string
+	{
+		slug = toolsetsRevertToolsetSlug
+		err = goa.MergeErrors(err, goa.ValidatePattern("slug", slug, "^[a-z0-9_-]{1,128}$"))
+		if utf8.RuneCountInString(slug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("slug", slug, utf8.RuneCountInString(slug), 40, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if toolsetsRevertToolsetSessionToken != "" {
+			sessionToken = &toolsetsRevertToolsetSessionToken
+		}
+	}
+	var apikeyToken *string
+	{
+		if toolsetsRevertToolsetApikeyToken != "" {
+			apikeyToken = &toolsetsRevertToolsetApikeyToken
+		}
+	}
+	var projectSlugInput *string
+	{
+		if toolsetsRevertToolsetProjectSlugInput != "" {
+			projectSlugInput = &toolsetsRevertToolsetProjectSlugInput
+		}
+	}
+	v := &toolsets.RevertToolsetPayload{
+		Version: body.Version,
+	}
+	v.Slug = types.Slug(slug)
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v, nil
+}
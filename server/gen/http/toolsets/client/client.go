@@ -69,6 +69,14 @@ type Client struct {
 	// setToolVariationsGroup endpoint.
 	SetToolVariationsGroupDoer goahttp.Doer
 
+	// DiffToolsetVersions Doer is the HTTP client used to make requests to the
+	// diffToolsetVersions endpoint.
+	DiffToolsetVersionsDoer goahttp.Doer
+
+	// RevertToolset Doer is the HTTP client used to make requests to the
+	// revertToolset endpoint.
+	RevertToolsetDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -102,6 +110,8 @@ func NewClient(
 		RemoveOAuthServerDoer:        doer,
 		SetUserSessionIssuerDoer:     doer,
 		SetToolVariationsGroupDoer:   doer,
+		DiffToolsetVersionsDoer:      doer,
+		RevertToolsetDoer:            doer,
 		RestoreResponseBody:          restoreBody,
 		scheme:                       scheme,
 		host:                         host,
@@ -421,3 +431,51 @@ func (c *Client) SetToolVariationsGroup() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// DiffToolsetVersions returns an endpoint that makes HTTP requests to the
+// toolsets service diffToolsetVersions server.
+func (c *Client) DiffToolsetVersions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDiffToolsetVersionsRequest(c.encoder)
+		decodeResponse = DecodeDiffToolsetVersionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDiffToolsetVersionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DiffToolsetVersionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("toolsets", "diffToolsetVersions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RevertToolset returns an endpoint that makes HTTP requests to the toolsets
+// service revertToolset server.
+func (c *Client) RevertToolset() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevertToolsetRequest(c.encoder)
+		decodeResponse = DecodeRevertToolsetResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevertToolsetRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevertToolsetDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("toolsets", "revertToolset", err)
+		}
+		return decodeResponse(resp)
+	}
+}
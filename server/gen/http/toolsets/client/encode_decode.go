@@ -10,6 +10,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -3146,6 +3147,495 @@ func DecodeSetToolVariationsGroupResponse(decoder func(*http.Response) goahttp.D
 	}
 }
 
+// BuildDiffToolsetVersionsRequest instantiates a HTTP request object with
+// method and path set to call the "toolsets" service "diffToolsetVersions"
+// endpoint
+func (c *Client) BuildDiffToolsetVersionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DiffToolsetVersionsToolsetsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("toolsets", "diffToolsetVersions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDiffToolsetVersionsRequest returns an encoder for requests sent to the
+// toolsets diffToolsetVersions server.
+func EncodeDiffToolsetVersionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*toolsets.DiffToolsetVersionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("toolsets", "diffToolsetVersions", "*toolsets.DiffToolsetVersionsPayload", v)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.ProjectSlugInput != nil {
+			head := *p.ProjectSlugInput
+			req.Header.Set("Gram-Project", head)
+		}
+		values := req.URL.Query()
+		values.Add("slug", string(p.Slug))
+		values.Add("from_version", fmt.Sprintf("%v", p.FromVersion))
+		values.Add("to_version", fmt.Sprintf("%v", p.ToVersion))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDiffToolsetVersionsResponse returns a decoder for responses returned
+// by the toolsets diffToolsetVersions endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeDiffToolsetVersionsResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeDiffToolsetVersionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DiffToolsetVersionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "diffToolsetVersions", err)
+			}
+			err = ValidateDiffToolsetVersionsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "diffToolsetVersions", err)
+			}
+			res := NewDiffToolsetVersionsToolsetVersionDiffOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body DiffToolsetVersionsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "diffToolsetVersions", err)
+			}
+			err = ValidateDiffToolsetVersionsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "diffToolsetVersions", err)
+			}
+			return nil, NewDiffToolsetVersionsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body DiffToolsetVersionsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "diffToolsetVersions", err)
+			}
+			err = ValidateDiffToolsetVersionsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "diffToolsetVersions", err)
+			}
+			return nil, NewDiffToolsetVersionsForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body DiffToolsetVersionsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "diffToolsetVersions", err)
+			}
+			err = ValidateDiffToolsetVersionsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "diffToolsetVersions", err)
+			}
+			return nil, NewDiffToolsetVersionsBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body DiffToolsetVersionsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "diffToolsetVersions", err)
+			}
+			err = ValidateDiffToolsetVersionsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "diffToolsetVersions", err)
+			}
+			return nil, NewDiffToolsetVersionsNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body DiffToolsetVersionsConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "diffToolsetVersions", err)
+			}
+			err = ValidateDiffToolsetVersionsConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "diffToolsetVersions", err)
+			}
+			return nil, NewDiffToolsetVersionsConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body DiffToolsetVersionsUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "diffToolsetVersions", err)
+			}
+			err = ValidateDiffToolsetVersionsUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "diffToolsetVersions", err)
+			}
+			return nil, NewDiffToolsetVersionsUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body DiffToolsetVersionsInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "diffToolsetVersions", err)
+			}
+			err = ValidateDiffToolsetVersionsInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "diffToolsetVersions", err)
+			}
+			return nil, NewDiffToolsetVersionsInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body DiffToolsetVersionsInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("toolsets", "diffToolsetVersions", err)
+				}
+				err = ValidateDiffToolsetVersionsInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("toolsets", "diffToolsetVersions", err)
+				}
+				return nil, NewDiffToolsetVersionsInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body DiffToolsetVersionsUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("toolsets", "diffToolsetVersions", err)
+				}
+				err = ValidateDiffToolsetVersionsUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("toolsets", "diffToolsetVersions", err)
+				}
+				return nil, NewDiffToolsetVersionsUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("toolsets", "diffToolsetVersions", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body DiffToolsetVersionsGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "diffToolsetVersions", err)
+			}
+			err = ValidateDiffToolsetVersionsGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "diffToolsetVersions", err)
+			}
+			return nil, NewDiffToolsetVersionsGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("toolsets", "diffToolsetVersions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevertToolsetRequest instantiates a HTTP request object with method and
+// path set to call the "toolsets" service "revertToolset" endpoint
+func (c *Client) BuildRevertToolsetRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevertToolsetToolsetsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("toolsets", "revertToolset", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevertToolsetRequest returns an encoder for requests sent to the
+// toolsets revertToolset server.
+func EncodeRevertToolsetRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*toolsets.RevertToolsetPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("toolsets", "revertToolset", "*toolsets.RevertToolsetPayload", v)
+		}
+		if p.SessionToken != nil {
+			head := *p.SessionToken
+			req.Header.Set("Gram-Session", head)
+		}
+		if p.ApikeyToken != nil {
+			head := *p.ApikeyToken
+			req.Header.Set("Gram-Key", head)
+		}
+		if p.ProjectSlugInput != nil {
+			head := *p.ProjectSlugInput
+			req.Header.Set("Gram-Project", head)
+		}
+		values := req.URL.Query()
+		values.Add("slug", string(p.Slug))
+		req.URL.RawQuery = values.Encode()
+		body := NewRevertToolsetRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("toolsets", "revertToolset", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRevertToolsetResponse returns a decoder for responses returned by the
+// toolsets revertToolset endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeRevertToolsetResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "bad_request" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "conflict" (type *goa.ServiceError): http.StatusConflict
+//   - "unsupported_media" (type *goa.ServiceError): http.StatusUnsupportedMediaType
+//   - "invalid" (type *goa.ServiceError): http.StatusUnprocessableEntity
+//   - "invariant_violation" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "unexpected" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "gateway_error" (type *goa.ServiceError): http.StatusBadGateway
+//   - error: internal error
+func DecodeRevertToolsetResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RevertToolsetResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "revertToolset", err)
+			}
+			err = ValidateRevertToolsetResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "revertToolset", err)
+			}
+			res := NewRevertToolsetToolsetOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body RevertToolsetUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "revertToolset", err)
+			}
+			err = ValidateRevertToolsetUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "revertToolset", err)
+			}
+			return nil, NewRevertToolsetUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body RevertToolsetForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "revertToolset", err)
+			}
+			err = ValidateRevertToolsetForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "revertToolset", err)
+			}
+			return nil, NewRevertToolsetForbidden(&body)
+		case http.StatusBadRequest:
+			var (
+				body RevertToolsetBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "revertToolset", err)
+			}
+			err = ValidateRevertToolsetBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "revertToolset", err)
+			}
+			return nil, NewRevertToolsetBadRequest(&body)
+		case http.StatusNotFound:
+			var (
+				body RevertToolsetNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "revertToolset", err)
+			}
+			err = ValidateRevertToolsetNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "revertToolset", err)
+			}
+			return nil, NewRevertToolsetNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body RevertToolsetConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "revertToolset", err)
+			}
+			err = ValidateRevertToolsetConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "revertToolset", err)
+			}
+			return nil, NewRevertToolsetConflict(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body RevertToolsetUnsupportedMediaResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "revertToolset", err)
+			}
+			err = ValidateRevertToolsetUnsupportedMediaResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "revertToolset", err)
+			}
+			return nil, NewRevertToolsetUnsupportedMedia(&body)
+		case http.StatusUnprocessableEntity:
+			var (
+				body RevertToolsetInvalidResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "revertToolset", err)
+			}
+			err = ValidateRevertToolsetInvalidResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "revertToolset", err)
+			}
+			return nil, NewRevertToolsetInvalid(&body)
+		case http.StatusInternalServerError:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invariant_violation":
+				var (
+					body RevertToolsetInvariantViolationResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("toolsets", "revertToolset", err)
+				}
+				err = ValidateRevertToolsetInvariantViolationResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("toolsets", "revertToolset", err)
+				}
+				return nil, NewRevertToolsetInvariantViolation(&body)
+			case "unexpected":
+				var (
+					body RevertToolsetUnexpectedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("toolsets", "revertToolset", err)
+				}
+				err = ValidateRevertToolsetUnexpectedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("toolsets", "revertToolset", err)
+				}
+				return nil, NewRevertToolsetUnexpected(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("toolsets", "revertToolset", resp.StatusCode, string(body))
+			}
+		case http.StatusBadGateway:
+			var (
+				body RevertToolsetGatewayErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("toolsets", "revertToolset", err)
+			}
+			err = ValidateRevertToolsetGatewayErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("toolsets", "revertToolset", err)
+			}
+			return nil, NewRevertToolsetGatewayError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("toolsets", "revertToolset", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // marshalTypesToolsetOriginToToolsetOriginRequestBody builds a value of type
 // *ToolsetOriginRequestBody from a value of type *types.ToolsetOrigin.
 func marshalTypesToolsetOriginToToolsetOriginRequestBody(v *types.ToolsetOrigin) *ToolsetOriginRequestBody {
@@ -3402,6 +3892,8 @@ func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResp
 		DestructiveHint: v.DestructiveHint,
 		IdempotentHint:  v.IdempotentHint,
 		OpenWorldHint:   v.OpenWorldHint,
+		CacheTTLSeconds: v.CacheTTLSeconds,
+		CacheShared:     v.CacheShared,
 		CreatedAt:       *v.CreatedAt,
 		UpdatedAt:       *v.UpdatedAt,
 	}
@@ -3411,6 +3903,32 @@ func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResp
 			res.Tags[i] = val
 		}
 	}
+	if v.ArgumentBindings != nil {
+		res.ArgumentBindings = make([]*types.ToolArgumentBinding, len(v.ArgumentBindings))
+		for i, val := range v.ArgumentBindings {
+			if val == nil {
+				res.ArgumentBindings[i] = nil
+				continue
+			}
+			res.ArgumentBindings[i] = unmarshalToolArgumentBindingResponseBodyToTypesToolArgumentBinding(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalToolArgumentBindingResponseBodyToTypesToolArgumentBinding builds a
+// value of type *types.ToolArgumentBinding from a value of type
+// *ToolArgumentBindingResponseBody.
+func unmarshalToolArgumentBindingResponseBodyToTypesToolArgumentBinding(v *ToolArgumentBindingResponseBody) *types.ToolArgumentBinding {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolArgumentBinding{
+		Argument: *v.Argument,
+		Source:   *v.Source,
+		Value:    *v.Value,
+	}
 
 	return res
 }
@@ -81,6 +81,13 @@ type SetToolVariationsGroupRequestBody struct {
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
 }
 
+// RevertToolsetRequestBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP request body.
+type RevertToolsetRequestBody struct {
+	// The version whose tools and resources become the toolset's new latest version
+	Version int64 `form:"version" json:"version" xml:"version"`
+}
+
 // CreateToolsetResponseBody is the type of the "toolsets" service
 // "createToolset" endpoint HTTP response body.
 type CreateToolsetResponseBody struct {
@@ -691,6 +698,95 @@ type SetToolVariationsGroupResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// DiffToolsetVersionsResponseBody is the type of the "toolsets" service
+// "diffToolsetVersions" endpoint HTTP response body.
+type DiffToolsetVersionsResponseBody struct {
+	// The version compared from
+	FromVersion *int64 `form:"from_version,omitempty" json:"from_version,omitempty" xml:"from_version,omitempty"`
+	// The version compared to
+	ToVersion *int64 `form:"to_version,omitempty" json:"to_version,omitempty" xml:"to_version,omitempty"`
+	// Tool URNs in to_version but not in from_version
+	AddedToolUrns []string `form:"added_tool_urns,omitempty" json:"added_tool_urns,omitempty" xml:"added_tool_urns,omitempty"`
+	// Tool URNs in from_version but not in to_version
+	RemovedToolUrns []string `form:"removed_tool_urns,omitempty" json:"removed_tool_urns,omitempty" xml:"removed_tool_urns,omitempty"`
+	// Resource URNs in to_version but not in from_version
+	AddedResourceUrns []string `form:"added_resource_urns,omitempty" json:"added_resource_urns,omitempty" xml:"added_resource_urns,omitempty"`
+	// Resource URNs in from_version but not in to_version
+	RemovedResourceUrns []string `form:"removed_resource_urns,omitempty" json:"removed_resource_urns,omitempty" xml:"removed_resource_urns,omitempty"`
+}
+
+// RevertToolsetResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body.
+type RevertToolsetResponseBody struct {
+	// The ID of the toolset
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The project ID this toolset belongs to
+	ProjectID *string `form:"project_id,omitempty" json:"project_id,omitempty" xml:"project_id,omitempty"`
+	// The organization ID this toolset belongs to
+	OrganizationID *string `form:"organization_id,omitempty" json:"organization_id,omitempty" xml:"organization_id,omitempty"`
+	// The account type of the organization
+	AccountType *string `form:"account_type,omitempty" json:"account_type,omitempty" xml:"account_type,omitempty"`
+	// The name of the toolset
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// The slug of the toolset
+	Slug *string `form:"slug,omitempty" json:"slug,omitempty" xml:"slug,omitempty"`
+	// Description of the toolset
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The slug of the environment to use as the default for the toolset
+	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
+	// The security variables that are relevant to the toolset
+	SecurityVariables []*SecurityVariableResponseBody `form:"security_variables,omitempty" json:"security_variables,omitempty" xml:"security_variables,omitempty"`
+	// The server variables that are relevant to the toolset
+	ServerVariables []*ServerVariableResponseBody `form:"server_variables,omitempty" json:"server_variables,omitempty" xml:"server_variables,omitempty"`
+	// The function environment variables that are relevant to the toolset
+	FunctionEnvironmentVariables []*FunctionEnvironmentVariableResponseBody `form:"function_environment_variables,omitempty" json:"function_environment_variables,omitempty" xml:"function_environment_variables,omitempty"`
+	// The external MCP header definitions that are relevant to the toolset
+	ExternalMcpHeaderDefinitions []*ExternalMCPHeaderDefinitionResponseBody `form:"external_mcp_header_definitions,omitempty" json:"external_mcp_header_definitions,omitempty" xml:"external_mcp_header_definitions,omitempty"`
+	// The metadata surrounding oauth enabled tools within this server
+	OauthEnablementMetadata *OAuthEnablementMetadataResponseBody `form:"oauth_enablement_metadata,omitempty" json:"oauth_enablement_metadata,omitempty" xml:"oauth_enablement_metadata,omitempty"`
+	// The tools in this toolset
+	Tools []*ToolResponseBody `form:"tools,omitempty" json:"tools,omitempty" xml:"tools,omitempty"`
+	// The tool URNs in this toolset
+	ToolUrns []string `form:"tool_urns,omitempty" json:"tool_urns,omitempty" xml:"tool_urns,omitempty"`
+	// The version of the toolset (will be 0 if none exists)
+	ToolsetVersion *int64 `form:"toolset_version,omitempty" json:"toolset_version,omitempty" xml:"toolset_version,omitempty"`
+	// The resources in this toolset
+	Resources []*ResourceResponseBody `form:"resources,omitempty" json:"resources,omitempty" xml:"resources,omitempty"`
+	// The resource URNs in this toolset
+	ResourceUrns []string `form:"resource_urns,omitempty" json:"resource_urns,omitempty" xml:"resource_urns,omitempty"`
+	// The prompt templates in this toolset -- Note: these are actual prompts, as
+	// in MCP prompts
+	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates,omitempty" json:"prompt_templates,omitempty" xml:"prompt_templates,omitempty"`
+	// The slug of the MCP to use for the toolset
+	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
+	// Whether the toolset is public in MCP
+	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
+	// Whether the toolset is enabled for MCP
+	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// The mode to use for tool selection
+	ToolSelectionMode *string `form:"tool_selection_mode,omitempty" json:"tool_selection_mode,omitempty" xml:"tool_selection_mode,omitempty"`
+	// The ID of the custom domain to use for the toolset
+	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
+	// The registry lineage for toolsets installed from an external MCP catalog
+	Origin *ToolsetOriginResponseBody `form:"origin,omitempty" json:"origin,omitempty" xml:"origin,omitempty"`
+	// The external OAuth server details
+	ExternalOauthServer *ExternalOAuthServerResponseBody `form:"external_oauth_server,omitempty" json:"external_oauth_server,omitempty" xml:"external_oauth_server,omitempty"`
+	// The id of the user_session_issuer wired to this toolset. Set via
+	// toolsets.setUserSessionIssuer; null when no USI is linked.
+	UserSessionIssuerID *string `form:"user_session_issuer_id,omitempty" json:"user_session_issuer_id,omitempty" xml:"user_session_issuer_id,omitempty"`
+	// The slug of the user_session_issuer wired to this toolset; present when
+	// user_session_issuer_id is.
+	UserSessionIssuerSlug *string `form:"user_session_issuer_slug,omitempty" json:"user_session_issuer_slug,omitempty" xml:"user_session_issuer_slug,omitempty"`
+	// The id of the tool variations group enabling MCP tool filtering for this
+	// toolset. Set via toolsets.setToolVariationsGroup; null when filtering is
+	// disabled.
+	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// When the toolset was created.
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// When the toolset was last updated.
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
 // CreateToolsetUnauthorizedResponseBody is the type of the "toolsets" service
 // "createToolset" endpoint HTTP response body for the "unauthorized" error.
 type CreateToolsetUnauthorizedResponseBody struct {
@@ -3099,306 +3195,492 @@ type SetToolVariationsGroupGatewayErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ToolsetOriginRequestBody is used to define fields on request body types.
-type ToolsetOriginRequestBody struct {
-	// The globally unique registry specifier this toolset originated from
-	RegistrySpecifier string `form:"registry_specifier" json:"registry_specifier" xml:"registry_specifier"`
+// DiffToolsetVersionsUnauthorizedResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "unauthorized" error.
+type DiffToolsetVersionsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// SecurityVariableResponseBody is used to define fields on response body types.
-type SecurityVariableResponseBody struct {
-	// The unique identifier of the security variable
+// DiffToolsetVersionsForbiddenResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "forbidden" error.
+type DiffToolsetVersionsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// The type of security
-	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
-	// The name of the security scheme (actual header/parameter name)
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffToolsetVersionsBadRequestResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "bad_request" error.
+type DiffToolsetVersionsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// User-friendly display name for the security variable (defaults to name if
-	// not set)
-	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
-	// Where the security token is placed
-	InPlacement *string `form:"in_placement,omitempty" json:"in_placement,omitempty" xml:"in_placement,omitempty"`
-	// The security scheme
-	Scheme *string `form:"scheme,omitempty" json:"scheme,omitempty" xml:"scheme,omitempty"`
-	// The bearer format
-	BearerFormat *string `form:"bearer_format,omitempty" json:"bearer_format,omitempty" xml:"bearer_format,omitempty"`
-	// The OAuth types
-	OauthTypes []string `form:"oauth_types,omitempty" json:"oauth_types,omitempty" xml:"oauth_types,omitempty"`
-	// The OAuth flows
-	OauthFlows []byte `form:"oauth_flows,omitempty" json:"oauth_flows,omitempty" xml:"oauth_flows,omitempty"`
-	// The environment variables
-	EnvVariables []string `form:"env_variables,omitempty" json:"env_variables,omitempty" xml:"env_variables,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ServerVariableResponseBody is used to define fields on response body types.
-type ServerVariableResponseBody struct {
-	// Description of the server variable
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// The environment variables
-	EnvVariables []string `form:"env_variables,omitempty" json:"env_variables,omitempty" xml:"env_variables,omitempty"`
+// DiffToolsetVersionsNotFoundResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "not_found" error.
+type DiffToolsetVersionsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// FunctionEnvironmentVariableResponseBody is used to define fields on response
-// body types.
-type FunctionEnvironmentVariableResponseBody struct {
-	// Description of the function environment variable
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Optional value of the function variable comes from a specific auth input
-	AuthInputType *string `form:"auth_input_type,omitempty" json:"auth_input_type,omitempty" xml:"auth_input_type,omitempty"`
-	// The environment variables
+// DiffToolsetVersionsConflictResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the "conflict"
+// error.
+type DiffToolsetVersionsConflictResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ExternalMCPHeaderDefinitionResponseBody is used to define fields on response
-// body types.
-type ExternalMCPHeaderDefinitionResponseBody struct {
-	// The prefixed environment variable name (e.g., SLACK_X_API_KEY)
+// DiffToolsetVersionsUnsupportedMediaResponseBody is the type of the
+// "toolsets" service "diffToolsetVersions" endpoint HTTP response body for the
+// "unsupported_media" error.
+type DiffToolsetVersionsUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// The actual HTTP header name to send (e.g., X-Api-Key)
-	HeaderName *string `form:"header_name,omitempty" json:"header_name,omitempty" xml:"header_name,omitempty"`
-	// Description of the header
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Placeholder value for the header
-	Placeholder *string `form:"placeholder,omitempty" json:"placeholder,omitempty" xml:"placeholder,omitempty"`
-	// Whether the header is required
-	Required *bool `form:"required,omitempty" json:"required,omitempty" xml:"required,omitempty"`
-	// Whether the header value is secret
-	Secret *bool `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// OAuthEnablementMetadataResponseBody is used to define fields on response
-// body types.
-type OAuthEnablementMetadataResponseBody struct {
-	// Count of security variables that are OAuth2 supported
-	Oauth2SecurityCount *int `form:"oauth2_security_count,omitempty" json:"oauth2_security_count,omitempty" xml:"oauth2_security_count,omitempty"`
+// DiffToolsetVersionsInvalidResponseBody is the type of the "toolsets" service
+// "diffToolsetVersions" endpoint HTTP response body for the "invalid" error.
+type DiffToolsetVersionsInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ToolResponseBody is used to define fields on response body types.
-type ToolResponseBody struct {
-	// The HTTP tool definition
-	HTTPToolDefinition *HTTPToolDefinitionResponseBody `form:"http_tool_definition,omitempty" json:"http_tool_definition,omitempty" xml:"http_tool_definition,omitempty"`
-	// The function tool definition
-	FunctionToolDefinition *FunctionToolDefinitionResponseBody `form:"function_tool_definition,omitempty" json:"function_tool_definition,omitempty" xml:"function_tool_definition,omitempty"`
-	// The prompt template
-	PromptTemplate *PromptTemplateResponseBody `form:"prompt_template,omitempty" json:"prompt_template,omitempty" xml:"prompt_template,omitempty"`
-	// The Platform tool definition
-	PlatformToolDefinition *PlatformToolDefinitionResponseBody `form:"platform_tool_definition,omitempty" json:"platform_tool_definition,omitempty" xml:"platform_tool_definition,omitempty"`
-	// The external MCP tool definition
-	ExternalMcpToolDefinition *ExternalMCPToolDefinitionResponseBody `form:"external_mcp_tool_definition,omitempty" json:"external_mcp_tool_definition,omitempty" xml:"external_mcp_tool_definition,omitempty"`
+// DiffToolsetVersionsInvariantViolationResponseBody is the type of the
+// "toolsets" service "diffToolsetVersions" endpoint HTTP response body for the
+// "invariant_violation" error.
+type DiffToolsetVersionsInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// HTTPToolDefinitionResponseBody is used to define fields on response body
-// types.
-type HTTPToolDefinitionResponseBody struct {
-	// The ID of the deployment
-	DeploymentID *string `form:"deployment_id,omitempty" json:"deployment_id,omitempty" xml:"deployment_id,omitempty"`
-	// The ID of the asset
-	AssetID *string `form:"asset_id,omitempty" json:"asset_id,omitempty" xml:"asset_id,omitempty"`
-	// Summary of the tool
-	Summary *string `form:"summary,omitempty" json:"summary,omitempty" xml:"summary,omitempty"`
-	// Response filter metadata for the tool
-	ResponseFilter *ResponseFilterResponseBody `form:"response_filter,omitempty" json:"response_filter,omitempty" xml:"response_filter,omitempty"`
-	// The ID of the OpenAPI v3 document
-	Openapiv3DocumentID *string `form:"openapiv3_document_id,omitempty" json:"openapiv3_document_id,omitempty" xml:"openapiv3_document_id,omitempty"`
-	// OpenAPI v3 operation
-	Openapiv3Operation *string `form:"openapiv3_operation,omitempty" json:"openapiv3_operation,omitempty" xml:"openapiv3_operation,omitempty"`
-	// The tags list for this http tool
-	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
-	// Security requirements for the underlying HTTP endpoint
-	Security *string `form:"security,omitempty" json:"security,omitempty" xml:"security,omitempty"`
-	// The default server URL for the tool
-	DefaultServerURL *string `form:"default_server_url,omitempty" json:"default_server_url,omitempty" xml:"default_server_url,omitempty"`
-	// HTTP method for the request
-	HTTPMethod *string `form:"http_method,omitempty" json:"http_method,omitempty" xml:"http_method,omitempty"`
-	// Path for the request
-	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
-	// The name of the source package
-	PackageName *string `form:"package_name,omitempty" json:"package_name,omitempty" xml:"package_name,omitempty"`
-	// The ID of the tool
+// DiffToolsetVersionsUnexpectedResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "unexpected" error.
+type DiffToolsetVersionsUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// The URN of this tool
-	ToolUrn *string `form:"tool_urn,omitempty" json:"tool_urn,omitempty" xml:"tool_urn,omitempty"`
-	// The ID of the project
-	ProjectID *string `form:"project_id,omitempty" json:"project_id,omitempty" xml:"project_id,omitempty"`
-	// The name of the tool
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffToolsetVersionsGatewayErrorResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "gateway_error" error.
+type DiffToolsetVersionsGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// The canonical name of the tool. Will be the same as the name if there is no
-	// variation.
-	CanonicalName *string `form:"canonical_name,omitempty" json:"canonical_name,omitempty" xml:"canonical_name,omitempty"`
-	// Description of the tool
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Version of the schema
-	SchemaVersion *string `form:"schema_version,omitempty" json:"schema_version,omitempty" xml:"schema_version,omitempty"`
-	// JSON schema for the request
-	Schema *string `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
-	// Confirmation mode for the tool
-	Confirm *string `form:"confirm,omitempty" json:"confirm,omitempty" xml:"confirm,omitempty"`
-	// Prompt for the confirmation
-	ConfirmPrompt *string `form:"confirm_prompt,omitempty" json:"confirm_prompt,omitempty" xml:"confirm_prompt,omitempty"`
-	// Summarizer for the tool
-	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
-	// The creation date of the tool.
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// The last update date of the tool.
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
-	// The original details of a tool, excluding any variations
-	Canonical *CanonicalToolAttributesResponseBody `form:"canonical,omitempty" json:"canonical,omitempty" xml:"canonical,omitempty"`
-	// The variation details of a tool. Only includes explicitly varied fields.
-	Variation *ToolVariationResponseBody `form:"variation,omitempty" json:"variation,omitempty" xml:"variation,omitempty"`
-	// MCP tool annotations providing hints about tool behavior
-	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ResponseFilterResponseBody is used to define fields on response body types.
-type ResponseFilterResponseBody struct {
-	// Response filter type for the tool
-	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
-	// Status codes to filter for
-	StatusCodes []string `form:"status_codes,omitempty" json:"status_codes,omitempty" xml:"status_codes,omitempty"`
-	// Content types to filter for
-	ContentTypes []string `form:"content_types,omitempty" json:"content_types,omitempty" xml:"content_types,omitempty"`
+// RevertToolsetUnauthorizedResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "unauthorized" error.
+type RevertToolsetUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// CanonicalToolAttributesResponseBody is used to define fields on response
-// body types.
-type CanonicalToolAttributesResponseBody struct {
-	// The ID of the variation that was applied to the tool
-	VariationID *string `form:"variation_id,omitempty" json:"variation_id,omitempty" xml:"variation_id,omitempty"`
-	// The name of the tool
+// RevertToolsetForbiddenResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "forbidden" error.
+type RevertToolsetForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// Description of the tool
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Confirmation mode for the tool
-	Confirm *string `form:"confirm,omitempty" json:"confirm,omitempty" xml:"confirm,omitempty"`
-	// Prompt for the confirmation
-	ConfirmPrompt *string `form:"confirm_prompt,omitempty" json:"confirm_prompt,omitempty" xml:"confirm_prompt,omitempty"`
-	// Summarizer for the tool
-	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ToolVariationResponseBody is used to define fields on response body types.
-type ToolVariationResponseBody struct {
-	// The ID of the tool variation
+// RevertToolsetBadRequestResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "bad_request" error.
+type RevertToolsetBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// The ID of the tool variation group
-	GroupID *string `form:"group_id,omitempty" json:"group_id,omitempty" xml:"group_id,omitempty"`
-	// The URN of the source tool
-	SrcToolUrn *string `form:"src_tool_urn,omitempty" json:"src_tool_urn,omitempty" xml:"src_tool_urn,omitempty"`
-	// The name of the source tool
-	SrcToolName *string `form:"src_tool_name,omitempty" json:"src_tool_name,omitempty" xml:"src_tool_name,omitempty"`
-	// The confirmation mode for the tool variation
-	Confirm *string `form:"confirm,omitempty" json:"confirm,omitempty" xml:"confirm,omitempty"`
-	// The confirmation prompt for the tool variation
-	ConfirmPrompt *string `form:"confirm_prompt,omitempty" json:"confirm_prompt,omitempty" xml:"confirm_prompt,omitempty"`
-	// The name of the tool variation
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevertToolsetNotFoundResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "not_found" error.
+type RevertToolsetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// The description of the tool variation
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// The tags of the tool variation
-	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
-	// The summarizer of the tool variation
-	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
-	// Display name override for the tool
-	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
-	// Override: if true, the tool does not modify its environment
-	ReadOnlyHint *bool `form:"read_only_hint,omitempty" json:"read_only_hint,omitempty" xml:"read_only_hint,omitempty"`
-	// Override: if true, the tool may perform destructive updates
-	DestructiveHint *bool `form:"destructive_hint,omitempty" json:"destructive_hint,omitempty" xml:"destructive_hint,omitempty"`
-	// Override: if true, repeated calls have no additional effect
-	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
-	// Override: if true, the tool interacts with external entities
-	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
-	// The creation date of the tool variation
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// The last update date of the tool variation
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ToolAnnotationsResponseBody is used to define fields on response body types.
-type ToolAnnotationsResponseBody struct {
-	// Human-readable display name for the tool
-	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
-	// If true, the tool does not modify its environment
-	ReadOnlyHint *bool `form:"read_only_hint,omitempty" json:"read_only_hint,omitempty" xml:"read_only_hint,omitempty"`
-	// If true, the tool may perform destructive updates (only meaningful when
-	// read_only_hint is false)
-	DestructiveHint *bool `form:"destructive_hint,omitempty" json:"destructive_hint,omitempty" xml:"destructive_hint,omitempty"`
-	// If true, repeated calls with same arguments have no additional effect (only
-	// meaningful when read_only_hint is false)
-	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
-	// If true, the tool interacts with external entities beyond its local
-	// environment
-	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+// RevertToolsetConflictResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "conflict" error.
+type RevertToolsetConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// FunctionToolDefinitionResponseBody is used to define fields on response body
-// types.
-type FunctionToolDefinitionResponseBody struct {
-	// The ID of the deployment
-	DeploymentID *string `form:"deployment_id,omitempty" json:"deployment_id,omitempty" xml:"deployment_id,omitempty"`
-	// The ID of the asset
-	AssetID *string `form:"asset_id,omitempty" json:"asset_id,omitempty" xml:"asset_id,omitempty"`
-	// The ID of the function
-	FunctionID *string `form:"function_id,omitempty" json:"function_id,omitempty" xml:"function_id,omitempty"`
-	// Runtime environment (e.g., nodejs:24, python:3.12)
-	Runtime *string `form:"runtime,omitempty" json:"runtime,omitempty" xml:"runtime,omitempty"`
-	// Variables configuration for the function
-	Variables any `form:"variables,omitempty" json:"variables,omitempty" xml:"variables,omitempty"`
-	// The tags list for this function tool
-	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
-	// Meta tags for the tool
-	Meta map[string]any `form:"meta,omitempty" json:"meta,omitempty" xml:"meta,omitempty"`
-	// The ID of the tool
+// RevertToolsetUnsupportedMediaResponseBody is the type of the "toolsets"
+// service "revertToolset" endpoint HTTP response body for the
+// "unsupported_media" error.
+type RevertToolsetUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// The URN of this tool
-	ToolUrn *string `form:"tool_urn,omitempty" json:"tool_urn,omitempty" xml:"tool_urn,omitempty"`
-	// The ID of the project
-	ProjectID *string `form:"project_id,omitempty" json:"project_id,omitempty" xml:"project_id,omitempty"`
-	// The name of the tool
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevertToolsetInvalidResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "invalid" error.
+type RevertToolsetInvalidResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// The canonical name of the tool. Will be the same as the name if there is no
-	// variation.
-	CanonicalName *string `form:"canonical_name,omitempty" json:"canonical_name,omitempty" xml:"canonical_name,omitempty"`
-	// Description of the tool
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevertToolsetInvariantViolationResponseBody is the type of the "toolsets"
+// service "revertToolset" endpoint HTTP response body for the
+// "invariant_violation" error.
+type RevertToolsetInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevertToolsetUnexpectedResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "unexpected" error.
+type RevertToolsetUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevertToolsetGatewayErrorResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "gateway_error" error.
+type RevertToolsetGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ToolsetOriginRequestBody is used to define fields on request body types.
+type ToolsetOriginRequestBody struct {
+	// The globally unique registry specifier this toolset originated from
+	RegistrySpecifier string `form:"registry_specifier" json:"registry_specifier" xml:"registry_specifier"`
+}
+
+// SecurityVariableResponseBody is used to define fields on response body types.
+type SecurityVariableResponseBody struct {
+	// The unique identifier of the security variable
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The type of security
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// The name of the security scheme (actual header/parameter name)
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// User-friendly display name for the security variable (defaults to name if
+	// not set)
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Where the security token is placed
+	InPlacement *string `form:"in_placement,omitempty" json:"in_placement,omitempty" xml:"in_placement,omitempty"`
+	// The security scheme
+	Scheme *string `form:"scheme,omitempty" json:"scheme,omitempty" xml:"scheme,omitempty"`
+	// The bearer format
+	BearerFormat *string `form:"bearer_format,omitempty" json:"bearer_format,omitempty" xml:"bearer_format,omitempty"`
+	// The OAuth types
+	OauthTypes []string `form:"oauth_types,omitempty" json:"oauth_types,omitempty" xml:"oauth_types,omitempty"`
+	// The OAuth flows
+	OauthFlows []byte `form:"oauth_flows,omitempty" json:"oauth_flows,omitempty" xml:"oauth_flows,omitempty"`
+	// The environment variables
+	EnvVariables []string `form:"env_variables,omitempty" json:"env_variables,omitempty" xml:"env_variables,omitempty"`
+}
+
+// ServerVariableResponseBody is used to define fields on response body types.
+type ServerVariableResponseBody struct {
+	// Description of the server variable
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Version of the schema
-	SchemaVersion *string `form:"schema_version,omitempty" json:"schema_version,omitempty" xml:"schema_version,omitempty"`
-	// JSON schema for the request
-	Schema *string `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
-	// Confirmation mode for the tool
-	Confirm *string `form:"confirm,omitempty" json:"confirm,omitempty" xml:"confirm,omitempty"`
-	// Prompt for the confirmation
-	ConfirmPrompt *string `form:"confirm_prompt,omitempty" json:"confirm_prompt,omitempty" xml:"confirm_prompt,omitempty"`
-	// Summarizer for the tool
-	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
-	// The creation date of the tool.
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// The last update date of the tool.
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
-	// The original details of a tool, excluding any variations
-	Canonical *CanonicalToolAttributesResponseBody `form:"canonical,omitempty" json:"canonical,omitempty" xml:"canonical,omitempty"`
-	// The variation details of a tool. Only includes explicitly varied fields.
-	Variation *ToolVariationResponseBody `form:"variation,omitempty" json:"variation,omitempty" xml:"variation,omitempty"`
-	// MCP tool annotations providing hints about tool behavior
-	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+	// The environment variables
+	EnvVariables []string `form:"env_variables,omitempty" json:"env_variables,omitempty" xml:"env_variables,omitempty"`
 }
 
-// PromptTemplateResponseBody is used to define fields on response body types.
-type PromptTemplateResponseBody struct {
-	// The revision tree ID for the prompt template
-	HistoryID *string `form:"history_id,omitempty" json:"history_id,omitempty" xml:"history_id,omitempty"`
-	// The previous version of the prompt template to use as predecessor
-	PredecessorID *string `form:"predecessor_id,omitempty" json:"predecessor_id,omitempty" xml:"predecessor_id,omitempty"`
-	// The template content
-	Prompt *string `form:"prompt,omitempty" json:"prompt,omitempty" xml:"prompt,omitempty"`
-	// The template engine
-	Engine *string `form:"engine,omitempty" json:"engine,omitempty" xml:"engine,omitempty"`
-	// The kind of prompt the template is used for
-	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
-	// The suggested tool names associated with the prompt template
-	ToolsHint []string `form:"tools_hint,omitempty" json:"tools_hint,omitempty" xml:"tools_hint,omitempty"`
-	// The suggested tool URNS associated with the prompt template
-	ToolUrnsHint []string `form:"tool_urns_hint,omitempty" json:"tool_urns_hint,omitempty" xml:"tool_urns_hint,omitempty"`
+// FunctionEnvironmentVariableResponseBody is used to define fields on response
+// body types.
+type FunctionEnvironmentVariableResponseBody struct {
+	// Description of the function environment variable
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Optional value of the function variable comes from a specific auth input
+	AuthInputType *string `form:"auth_input_type,omitempty" json:"auth_input_type,omitempty" xml:"auth_input_type,omitempty"`
+	// The environment variables
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// ExternalMCPHeaderDefinitionResponseBody is used to define fields on response
+// body types.
+type ExternalMCPHeaderDefinitionResponseBody struct {
+	// The prefixed environment variable name (e.g., SLACK_X_API_KEY)
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// The actual HTTP header name to send (e.g., X-Api-Key)
+	HeaderName *string `form:"header_name,omitempty" json:"header_name,omitempty" xml:"header_name,omitempty"`
+	// Description of the header
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Placeholder value for the header
+	Placeholder *string `form:"placeholder,omitempty" json:"placeholder,omitempty" xml:"placeholder,omitempty"`
+	// Whether the header is required
+	Required *bool `form:"required,omitempty" json:"required,omitempty" xml:"required,omitempty"`
+	// Whether the header value is secret
+	Secret *bool `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+}
+
+// OAuthEnablementMetadataResponseBody is used to define fields on response
+// body types.
+type OAuthEnablementMetadataResponseBody struct {
+	// Count of security variables that are OAuth2 supported
+	Oauth2SecurityCount *int `form:"oauth2_security_count,omitempty" json:"oauth2_security_count,omitempty" xml:"oauth2_security_count,omitempty"`
+}
+
+// ToolResponseBody is used to define fields on response body types.
+type ToolResponseBody struct {
+	// The HTTP tool definition
+	HTTPToolDefinition *HTTPToolDefinitionResponseBody `form:"http_tool_definition,omitempty" json:"http_tool_definition,omitempty" xml:"http_tool_definition,omitempty"`
+	// The function tool definition
+	FunctionToolDefinition *FunctionToolDefinitionResponseBody `form:"function_tool_definition,omitempty" json:"function_tool_definition,omitempty" xml:"function_tool_definition,omitempty"`
+	// The prompt template
+	PromptTemplate *PromptTemplateResponseBody `form:"prompt_template,omitempty" json:"prompt_template,omitempty" xml:"prompt_template,omitempty"`
+	// The Platform tool definition
+	PlatformToolDefinition *PlatformToolDefinitionResponseBody `form:"platform_tool_definition,omitempty" json:"platform_tool_definition,omitempty" xml:"platform_tool_definition,omitempty"`
+	// The external MCP tool definition
+	ExternalMcpToolDefinition *ExternalMCPToolDefinitionResponseBody `form:"external_mcp_tool_definition,omitempty" json:"external_mcp_tool_definition,omitempty" xml:"external_mcp_tool_definition,omitempty"`
+}
+
+// HTTPToolDefinitionResponseBody is used to define fields on response body
+// types.
+type HTTPToolDefinitionResponseBody struct {
+	// The ID of the deployment
+	DeploymentID *string `form:"deployment_id,omitempty" json:"deployment_id,omitempty" xml:"deployment_id,omitempty"`
+	// The ID of the asset
+	AssetID *string `form:"asset_id,omitempty" json:"asset_id,omitempty" xml:"asset_id,omitempty"`
+	// Summary of the tool
+	Summary *string `form:"summary,omitempty" json:"summary,omitempty" xml:"summary,omitempty"`
+	// Response filter metadata for the tool
+	ResponseFilter *ResponseFilterResponseBody `form:"response_filter,omitempty" json:"response_filter,omitempty" xml:"response_filter,omitempty"`
+	// The ID of the OpenAPI v3 document
+	Openapiv3DocumentID *string `form:"openapiv3_document_id,omitempty" json:"openapiv3_document_id,omitempty" xml:"openapiv3_document_id,omitempty"`
+	// OpenAPI v3 operation
+	Openapiv3Operation *string `form:"openapiv3_operation,omitempty" json:"openapiv3_operation,omitempty" xml:"openapiv3_operation,omitempty"`
+	// The tags list for this http tool
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Security requirements for the underlying HTTP endpoint
+	Security *string `form:"security,omitempty" json:"security,omitempty" xml:"security,omitempty"`
+	// The default server URL for the tool
+	DefaultServerURL *string `form:"default_server_url,omitempty" json:"default_server_url,omitempty" xml:"default_server_url,omitempty"`
+	// HTTP method for the request
+	HTTPMethod *string `form:"http_method,omitempty" json:"http_method,omitempty" xml:"http_method,omitempty"`
+	// Path for the request
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// The name of the source package
+	PackageName *string `form:"package_name,omitempty" json:"package_name,omitempty" xml:"package_name,omitempty"`
 	// The ID of the tool
 	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
 	// The URN of this tool
@@ -3434,48 +3716,254 @@ type PromptTemplateResponseBody struct {
 	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
 }
 
-// PlatformToolDefinitionResponseBody is used to define fields on response body
-// types.
-type PlatformToolDefinitionResponseBody struct {
-	// The backing platform tool source (for example: logs)
-	SourceSlug *string `form:"source_slug,omitempty" json:"source_slug,omitempty" xml:"source_slug,omitempty"`
-	// The entity kind that owns this tool's lifecycle
-	OwnerKind *string `form:"owner_kind,omitempty" json:"owner_kind,omitempty" xml:"owner_kind,omitempty"`
-	// Optional owning entity ID
-	OwnerID *string `form:"owner_id,omitempty" json:"owner_id,omitempty" xml:"owner_id,omitempty"`
-	// The ID of the tool
-	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// The URN of this tool
-	ToolUrn *string `form:"tool_urn,omitempty" json:"tool_urn,omitempty" xml:"tool_urn,omitempty"`
-	// The ID of the project
-	ProjectID *string `form:"project_id,omitempty" json:"project_id,omitempty" xml:"project_id,omitempty"`
+// ResponseFilterResponseBody is used to define fields on response body types.
+type ResponseFilterResponseBody struct {
+	// Response filter type for the tool
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Status codes to filter for
+	StatusCodes []string `form:"status_codes,omitempty" json:"status_codes,omitempty" xml:"status_codes,omitempty"`
+	// Content types to filter for
+	ContentTypes []string `form:"content_types,omitempty" json:"content_types,omitempty" xml:"content_types,omitempty"`
+}
+
+// CanonicalToolAttributesResponseBody is used to define fields on response
+// body types.
+type CanonicalToolAttributesResponseBody struct {
+	// The ID of the variation that was applied to the tool
+	VariationID *string `form:"variation_id,omitempty" json:"variation_id,omitempty" xml:"variation_id,omitempty"`
 	// The name of the tool
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// The canonical name of the tool. Will be the same as the name if there is no
-	// variation.
-	CanonicalName *string `form:"canonical_name,omitempty" json:"canonical_name,omitempty" xml:"canonical_name,omitempty"`
 	// Description of the tool
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Version of the schema
-	SchemaVersion *string `form:"schema_version,omitempty" json:"schema_version,omitempty" xml:"schema_version,omitempty"`
-	// JSON schema for the request
-	Schema *string `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
 	// Confirmation mode for the tool
 	Confirm *string `form:"confirm,omitempty" json:"confirm,omitempty" xml:"confirm,omitempty"`
 	// Prompt for the confirmation
 	ConfirmPrompt *string `form:"confirm_prompt,omitempty" json:"confirm_prompt,omitempty" xml:"confirm_prompt,omitempty"`
 	// Summarizer for the tool
 	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
-	// The creation date of the tool.
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// The last update date of the tool.
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
-	// The original details of a tool, excluding any variations
-	Canonical *CanonicalToolAttributesResponseBody `form:"canonical,omitempty" json:"canonical,omitempty" xml:"canonical,omitempty"`
-	// The variation details of a tool. Only includes explicitly varied fields.
-	Variation *ToolVariationResponseBody `form:"variation,omitempty" json:"variation,omitempty" xml:"variation,omitempty"`
-	// MCP tool annotations providing hints about tool behavior
-	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+}
+
+// ToolVariationResponseBody is used to define fields on response body types.
+type ToolVariationResponseBody struct {
+	// The ID of the tool variation
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The ID of the tool variation group
+	GroupID *string `form:"group_id,omitempty" json:"group_id,omitempty" xml:"group_id,omitempty"`
+	// The URN of the source tool
+	SrcToolUrn *string `form:"src_tool_urn,omitempty" json:"src_tool_urn,omitempty" xml:"src_tool_urn,omitempty"`
+	// The name of the source tool
+	SrcToolName *string `form:"src_tool_name,omitempty" json:"src_tool_name,omitempty" xml:"src_tool_name,omitempty"`
+	// The confirmation mode for the tool variation
+	Confirm *string `form:"confirm,omitempty" json:"confirm,omitempty" xml:"confirm,omitempty"`
+	// The confirmation prompt for the tool variation
+	ConfirmPrompt *string `form:"confirm_prompt,omitempty" json:"confirm_prompt,omitempty" xml:"confirm_prompt,omitempty"`
+	// The name of the tool variation
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// The description of the tool variation
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The tags of the tool variation
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// The summarizer of the tool variation
+	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
+	// Display name override for the tool
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Override: if true, the tool does not modify its environment
+	ReadOnlyHint *bool `form:"read_only_hint,omitempty" json:"read_only_hint,omitempty" xml:"read_only_hint,omitempty"`
+	// Override: if true, the tool may perform destructive updates
+	DestructiveHint *bool `form:"destructive_hint,omitempty" json:"destructive_hint,omitempty" xml:"destructive_hint,omitempty"`
+	// Override: if true, repeated calls have no additional effect
+	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
+	// Override: if true, the tool interacts with external entities
+	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+	// How long the gateway caches responses of this tool
+	CacheTTLSeconds *int32 `form:"cache_ttl_seconds,omitempty" json:"cache_ttl_seconds,omitempty" xml:"cache_ttl_seconds,omitempty"`
+	// If true, cached responses are shared between callers with identical
+	// environments
+	CacheShared *bool `form:"cache_shared,omitempty" json:"cache_shared,omitempty" xml:"cache_shared,omitempty"`
+	// Arguments filled server-side on every call and hidden from the tool's input
+	// schema
+	ArgumentBindings []*ToolArgumentBindingResponseBody `form:"argument_bindings,omitempty" json:"argument_bindings,omitempty" xml:"argument_bindings,omitempty"`
+	// The creation date of the tool variation
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The last update date of the tool variation
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// ToolArgumentBindingResponseBody is used to define fields on response body
+// types.
+type ToolArgumentBindingResponseBody struct {
+	// Dotted path of the bound argument in the tool's input, e.g. body.tenant_id
+	Argument *string `form:"argument,omitempty" json:"argument,omitempty" xml:"argument,omitempty"`
+	// Where the bound value comes from
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// A JSON literal for constant bindings, an environment variable name for
+	// environment bindings, or a claim of the authenticated user (email, user_id,
+	// external_user_id, organization_id, organization_slug) for principal bindings
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// ToolAnnotationsResponseBody is used to define fields on response body types.
+type ToolAnnotationsResponseBody struct {
+	// Human-readable display name for the tool
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// If true, the tool does not modify its environment
+	ReadOnlyHint *bool `form:"read_only_hint,omitempty" json:"read_only_hint,omitempty" xml:"read_only_hint,omitempty"`
+	// If true, the tool may perform destructive updates (only meaningful when
+	// read_only_hint is false)
+	DestructiveHint *bool `form:"destructive_hint,omitempty" json:"destructive_hint,omitempty" xml:"destructive_hint,omitempty"`
+	// If true, repeated calls with same arguments have no additional effect (only
+	// meaningful when read_only_hint is false)
+	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
+	// If true, the tool interacts with external entities beyond its local
+	// environment
+	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+}
+
+// FunctionToolDefinitionResponseBody is used to define fields on response body
+// types.
+type FunctionToolDefinitionResponseBody struct {
+	// The ID of the deployment
+	DeploymentID *string `form:"deployment_id,omitempty" json:"deployment_id,omitempty" xml:"deployment_id,omitempty"`
+	// The ID of the asset
+	AssetID *string `form:"asset_id,omitempty" json:"asset_id,omitempty" xml:"asset_id,omitempty"`
+	// The ID of the function
+	FunctionID *string `form:"function_id,omitempty" json:"function_id,omitempty" xml:"function_id,omitempty"`
+	// Runtime environment (e.g., nodejs:24, python:3.12)
+	Runtime *string `form:"runtime,omitempty" json:"runtime,omitempty" xml:"runtime,omitempty"`
+	// Variables configuration for the function
+	Variables any `form:"variables,omitempty" json:"variables,omitempty" xml:"variables,omitempty"`
+	// The tags list for this function tool
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Meta tags for the tool
+	Meta map[string]any `form:"meta,omitempty" json:"meta,omitempty" xml:"meta,omitempty"`
+	// The ID of the tool
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The URN of this tool
+	ToolUrn *string `form:"tool_urn,omitempty" json:"tool_urn,omitempty" xml:"tool_urn,omitempty"`
+	// The ID of the project
+	ProjectID *string `form:"project_id,omitempty" json:"project_id,omitempty" xml:"project_id,omitempty"`
+	// The name of the tool
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// The canonical name of the tool. Will be the same as the name if there is no
+	// variation.
+	CanonicalName *string `form:"canonical_name,omitempty" json:"canonical_name,omitempty" xml:"canonical_name,omitempty"`
+	// Description of the tool
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Version of the schema
+	SchemaVersion *string `form:"schema_version,omitempty" json:"schema_version,omitempty" xml:"schema_version,omitempty"`
+	// JSON schema for the request
+	Schema *string `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// Confirmation mode for the tool
+	Confirm *string `form:"confirm,omitempty" json:"confirm,omitempty" xml:"confirm,omitempty"`
+	// Prompt for the confirmation
+	ConfirmPrompt *string `form:"confirm_prompt,omitempty" json:"confirm_prompt,omitempty" xml:"confirm_prompt,omitempty"`
+	// Summarizer for the tool
+	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
+	// The creation date of the tool.
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The last update date of the tool.
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The original details of a tool, excluding any variations
+	Canonical *CanonicalToolAttributesResponseBody `form:"canonical,omitempty" json:"canonical,omitempty" xml:"canonical,omitempty"`
+	// The variation details of a tool. Only includes explicitly varied fields.
+	Variation *ToolVariationResponseBody `form:"variation,omitempty" json:"variation,omitempty" xml:"variation,omitempty"`
+	// MCP tool annotations providing hints about tool behavior
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+}
+
+// PromptTemplateResponseBody is used to define fields on response body types.
+type PromptTemplateResponseBody struct {
+	// The revision tree ID for the prompt template
+	HistoryID *string `form:"history_id,omitempty" json:"history_id,omitempty" xml:"history_id,omitempty"`
+	// The previous version of the prompt template to use as predecessor
+	PredecessorID *string `form:"predecessor_id,omitempty" json:"predecessor_id,omitempty" xml:"predecessor_id,omitempty"`
+	// The template content
+	Prompt *string `form:"prompt,omitempty" json:"prompt,omitempty" xml:"prompt,omitempty"`
+	// The template engine
+	Engine *string `form:"engine,omitempty" json:"engine,omitempty" xml:"engine,omitempty"`
+	// The kind of prompt the template is used for
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The suggested tool names associated with the prompt template
+	ToolsHint []string `form:"tools_hint,omitempty" json:"tools_hint,omitempty" xml:"tools_hint,omitempty"`
+	// The suggested tool URNS associated with the prompt template
+	ToolUrnsHint []string `form:"tool_urns_hint,omitempty" json:"tool_urns_hint,omitempty" xml:"tool_urns_hint,omitempty"`
+	// The ID of the tool
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The URN of this tool
+	ToolUrn *string `form:"tool_urn,omitempty" json:"tool_urn,omitempty" xml:"tool_urn,omitempty"`
+	// The ID of the project
+	ProjectID *string `form:"project_id,omitempty" json:"project_id,omitempty" xml:"project_id,omitempty"`
+	// The name of the tool
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// The canonical name of the tool. Will be the same as the name if there is no
+	// variation.
+	CanonicalName *string `form:"canonical_name,omitempty" json:"canonical_name,omitempty" xml:"canonical_name,omitempty"`
+	// Description of the tool
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Version of the schema
+	SchemaVersion *string `form:"schema_version,omitempty" json:"schema_version,omitempty" xml:"schema_version,omitempty"`
+	// JSON schema for the request
+	Schema *string `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// Confirmation mode for the tool
+	Confirm *string `form:"confirm,omitempty" json:"confirm,omitempty" xml:"confirm,omitempty"`
+	// Prompt for the confirmation
+	ConfirmPrompt *string `form:"confirm_prompt,omitempty" json:"confirm_prompt,omitempty" xml:"confirm_prompt,omitempty"`
+	// Summarizer for the tool
+	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
+	// The creation date of the tool.
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The last update date of the tool.
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The original details of a tool, excluding any variations
+	Canonical *CanonicalToolAttributesResponseBody `form:"canonical,omitempty" json:"canonical,omitempty" xml:"canonical,omitempty"`
+	// The variation details of a tool. Only includes explicitly varied fields.
+	Variation *ToolVariationResponseBody `form:"variation,omitempty" json:"variation,omitempty" xml:"variation,omitempty"`
+	// MCP tool annotations providing hints about tool behavior
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
+}
+
+// PlatformToolDefinitionResponseBody is used to define fields on response body
+// types.
+type PlatformToolDefinitionResponseBody struct {
+	// The backing platform tool source (for example: logs)
+	SourceSlug *string `form:"source_slug,omitempty" json:"source_slug,omitempty" xml:"source_slug,omitempty"`
+	// The entity kind that owns this tool's lifecycle
+	OwnerKind *string `form:"owner_kind,omitempty" json:"owner_kind,omitempty" xml:"owner_kind,omitempty"`
+	// Optional owning entity ID
+	OwnerID *string `form:"owner_id,omitempty" json:"owner_id,omitempty" xml:"owner_id,omitempty"`
+	// The ID of the tool
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The URN of this tool
+	ToolUrn *string `form:"tool_urn,omitempty" json:"tool_urn,omitempty" xml:"tool_urn,omitempty"`
+	// The ID of the project
+	ProjectID *string `form:"project_id,omitempty" json:"project_id,omitempty" xml:"project_id,omitempty"`
+	// The name of the tool
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// The canonical name of the tool. Will be the same as the name if there is no
+	// variation.
+	CanonicalName *string `form:"canonical_name,omitempty" json:"canonical_name,omitempty" xml:"canonical_name,omitempty"`
+	// Description of the tool
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Version of the schema
+	SchemaVersion *string `form:"schema_version,omitempty" json:"schema_version,omitempty" xml:"schema_version,omitempty"`
+	// JSON schema for the request
+	Schema *string `form:"schema,omitempty" json:"schema,omitempty" xml:"schema,omitempty"`
+	// Confirmation mode for the tool
+	Confirm *string `form:"confirm,omitempty" json:"confirm,omitempty" xml:"confirm,omitempty"`
+	// Prompt for the confirmation
+	ConfirmPrompt *string `form:"confirm_prompt,omitempty" json:"confirm_prompt,omitempty" xml:"confirm_prompt,omitempty"`
+	// Summarizer for the tool
+	Summarizer *string `form:"summarizer,omitempty" json:"summarizer,omitempty" xml:"summarizer,omitempty"`
+	// The creation date of the tool.
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// The last update date of the tool.
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// The original details of a tool, excluding any variations
+	Canonical *CanonicalToolAttributesResponseBody `form:"canonical,omitempty" json:"canonical,omitempty" xml:"canonical,omitempty"`
+	// The variation details of a tool. Only includes explicitly varied fields.
+	Variation *ToolVariationResponseBody `form:"variation,omitempty" json:"variation,omitempty" xml:"variation,omitempty"`
+	// MCP tool annotations providing hints about tool behavior
+	Annotations *ToolAnnotationsResponseBody `form:"annotations,omitempty" json:"annotations,omitempty" xml:"annotations,omitempty"`
 }
 
 // ExternalMCPToolDefinitionResponseBody is used to define fields on response
@@ -3733,134 +4221,1157 @@ type ToolsetSummaryResponseBody struct {
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
-// ToolFilterScopeResponseBody is used to define fields on response body types.
-type ToolFilterScopeResponseBody struct {
-	// The filter tag
-	Tag *string `form:"tag,omitempty" json:"tag,omitempty" xml:"tag,omitempty"`
-	// The number of tools under this scope
-	ToolCount *int `form:"tool_count,omitempty" json:"tool_count,omitempty" xml:"tool_count,omitempty"`
-	// The tools under this scope
-	Tools []*ToolFilterToolResponseBody `form:"tools,omitempty" json:"tools,omitempty" xml:"tools,omitempty"`
+// ToolFilterScopeResponseBody is used to define fields on response body types.
+type ToolFilterScopeResponseBody struct {
+	// The filter tag
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty" xml:"tag,omitempty"`
+	// The number of tools under this scope
+	ToolCount *int `form:"tool_count,omitempty" json:"tool_count,omitempty" xml:"tool_count,omitempty"`
+	// The tools under this scope
+	Tools []*ToolFilterToolResponseBody `form:"tools,omitempty" json:"tools,omitempty" xml:"tools,omitempty"`
+}
+
+// ToolFilterToolResponseBody is used to define fields on response body types.
+type ToolFilterToolResponseBody struct {
+	// The URN of the tool
+	ToolUrn *string `form:"tool_urn,omitempty" json:"tool_urn,omitempty" xml:"tool_urn,omitempty"`
+	// The display name of the tool, with any variation rename from the resolved
+	// group applied (matching the runtime wire)
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// ExternalOAuthServerFormRequestBody is used to define fields on request body
+// types.
+type ExternalOAuthServerFormRequestBody struct {
+	// The slug of the external OAuth server
+	Slug string `form:"slug" json:"slug" xml:"slug"`
+	// The metadata for the external OAuth server
+	Metadata any `form:"metadata" json:"metadata" xml:"metadata"`
+}
+
+// NewCreateToolsetRequestBody builds the HTTP request body from the payload of
+// the "createToolset" endpoint of the "toolsets" service.
+func NewCreateToolsetRequestBody(p *toolsets.CreateToolsetPayload) *CreateToolsetRequestBody {
+	body := &CreateToolsetRequestBody{
+		Name:        p.Name,
+		Description: p.Description,
+	}
+	if p.DefaultEnvironmentSlug != nil {
+		defaultEnvironmentSlug := string(*p.DefaultEnvironmentSlug)
+		body.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+	}
+	if p.ToolUrns != nil {
+		body.ToolUrns = make([]string, len(p.ToolUrns))
+		for i, val := range p.ToolUrns {
+			body.ToolUrns[i] = val
+		}
+	}
+	if p.ResourceUrns != nil {
+		body.ResourceUrns = make([]string, len(p.ResourceUrns))
+		for i, val := range p.ResourceUrns {
+			body.ResourceUrns[i] = val
+		}
+	}
+	if p.Origin != nil {
+		body.Origin = marshalTypesToolsetOriginToToolsetOriginRequestBody(p.Origin)
+	}
+	return body
+}
+
+// NewUpdateToolsetRequestBody builds the HTTP request body from the payload of
+// the "updateToolset" endpoint of the "toolsets" service.
+func NewUpdateToolsetRequestBody(p *toolsets.UpdateToolsetPayload) *UpdateToolsetRequestBody {
+	body := &UpdateToolsetRequestBody{
+		Name:              p.Name,
+		Description:       p.Description,
+		McpEnabled:        p.McpEnabled,
+		McpIsPublic:       p.McpIsPublic,
+		CustomDomainID:    p.CustomDomainID,
+		ToolSelectionMode: p.ToolSelectionMode,
+	}
+	if p.DefaultEnvironmentSlug != nil {
+		defaultEnvironmentSlug := string(*p.DefaultEnvironmentSlug)
+		body.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+	}
+	if p.McpSlug != nil {
+		mcpSlug := string(*p.McpSlug)
+		body.McpSlug = &mcpSlug
+	}
+	if p.PromptTemplateNames != nil {
+		body.PromptTemplateNames = make([]string, len(p.PromptTemplateNames))
+		for i, val := range p.PromptTemplateNames {
+			body.PromptTemplateNames[i] = val
+		}
+	}
+	if p.ToolUrns != nil {
+		body.ToolUrns = make([]string, len(p.ToolUrns))
+		for i, val := range p.ToolUrns {
+			body.ToolUrns[i] = val
+		}
+	}
+	if p.ResourceUrns != nil {
+		body.ResourceUrns = make([]string, len(p.ResourceUrns))
+		for i, val := range p.ResourceUrns {
+			body.ResourceUrns[i] = val
+		}
+	}
+	return body
+}
+
+// NewAddExternalOAuthServerRequestBody builds the HTTP request body from the
+// payload of the "addExternalOAuthServer" endpoint of the "toolsets" service.
+func NewAddExternalOAuthServerRequestBody(p *toolsets.AddExternalOAuthServerPayload) *AddExternalOAuthServerRequestBody {
+	body := &AddExternalOAuthServerRequestBody{}
+	if p.ExternalOauthServer != nil {
+		body.ExternalOauthServer = marshalTypesExternalOAuthServerFormToExternalOAuthServerFormRequestBody(p.ExternalOauthServer)
+	}
+	return body
+}
+
+// NewSetUserSessionIssuerRequestBody builds the HTTP request body from the
+// payload of the "setUserSessionIssuer" endpoint of the "toolsets" service.
+func NewSetUserSessionIssuerRequestBody(p *toolsets.SetUserSessionIssuerPayload) *SetUserSessionIssuerRequestBody {
+	body := &SetUserSessionIssuerRequestBody{
+		UserSessionIssuerID: p.UserSessionIssuerID,
+	}
+	return body
+}
+
+// NewSetToolVariationsGroupRequestBody builds the HTTP request body from the
+// payload of the "setToolVariationsGroup" endpoint of the "toolsets" service.
+func NewSetToolVariationsGroupRequestBody(p *toolsets.SetToolVariationsGroupPayload) *SetToolVariationsGroupRequestBody {
+	body := &SetToolVariationsGroupRequestBody{
+		ToolVariationsGroupID: p.ToolVariationsGroupID,
+	}
+	return body
+}
+
+// NewRevertToolsetRequestBody builds the HTTP request body from the payload of
+// the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetRequestBody(p *toolsets.RevertToolsetPayload) *RevertToolsetRequestBody {
+	body := &RevertToolsetRequestBody{
+		Version: p.Version,
+	}
+	return body
+}
+
+// NewCreateToolsetToolsetOK builds a "toolsets" service "createToolset"
+// endpoint result from a HTTP "OK" response.
+func NewCreateToolsetToolsetOK(body *CreateToolsetResponseBody) *types.Toolset {
+	v := &types.Toolset{
+		ID:                    *body.ID,
+		ProjectID:             *body.ProjectID,
+		OrganizationID:        *body.OrganizationID,
+		AccountType:           *body.AccountType,
+		Name:                  *body.Name,
+		Slug:                  types.Slug(*body.Slug),
+		Description:           body.Description,
+		ToolsetVersion:        *body.ToolsetVersion,
+		McpIsPublic:           body.McpIsPublic,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     *body.ToolSelectionMode,
+		CustomDomainID:        body.CustomDomainID,
+		UserSessionIssuerID:   body.UserSessionIssuerID,
+		ToolVariationsGroupID: body.ToolVariationsGroupID,
+		CreatedAt:             *body.CreatedAt,
+		UpdatedAt:             *body.UpdatedAt,
+	}
+	if body.DefaultEnvironmentSlug != nil {
+		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
+		v.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+	}
+	if body.McpSlug != nil {
+		mcpSlug := types.Slug(*body.McpSlug)
+		v.McpSlug = &mcpSlug
+	}
+	if body.UserSessionIssuerSlug != nil {
+		userSessionIssuerSlug := types.Slug(*body.UserSessionIssuerSlug)
+		v.UserSessionIssuerSlug = &userSessionIssuerSlug
+	}
+	if body.SecurityVariables != nil {
+		v.SecurityVariables = make([]*types.SecurityVariable, len(body.SecurityVariables))
+		for i, val := range body.SecurityVariables {
+			if val == nil {
+				v.SecurityVariables[i] = nil
+				continue
+			}
+			v.SecurityVariables[i] = unmarshalSecurityVariableResponseBodyToTypesSecurityVariable(val)
+		}
+	}
+	if body.ServerVariables != nil {
+		v.ServerVariables = make([]*types.ServerVariable, len(body.ServerVariables))
+		for i, val := range body.ServerVariables {
+			if val == nil {
+				v.ServerVariables[i] = nil
+				continue
+			}
+			v.ServerVariables[i] = unmarshalServerVariableResponseBodyToTypesServerVariable(val)
+		}
+	}
+	if body.FunctionEnvironmentVariables != nil {
+		v.FunctionEnvironmentVariables = make([]*types.FunctionEnvironmentVariable, len(body.FunctionEnvironmentVariables))
+		for i, val := range body.FunctionEnvironmentVariables {
+			if val == nil {
+				v.FunctionEnvironmentVariables[i] = nil
+				continue
+			}
+			v.FunctionEnvironmentVariables[i] = unmarshalFunctionEnvironmentVariableResponseBodyToTypesFunctionEnvironmentVariable(val)
+		}
+	}
+	if body.ExternalMcpHeaderDefinitions != nil {
+		v.ExternalMcpHeaderDefinitions = make([]*types.ExternalMCPHeaderDefinition, len(body.ExternalMcpHeaderDefinitions))
+		for i, val := range body.ExternalMcpHeaderDefinitions {
+			if val == nil {
+				v.ExternalMcpHeaderDefinitions[i] = nil
+				continue
+			}
+			v.ExternalMcpHeaderDefinitions[i] = unmarshalExternalMCPHeaderDefinitionResponseBodyToTypesExternalMCPHeaderDefinition(val)
+		}
+	}
+	v.OauthEnablementMetadata = unmarshalOAuthEnablementMetadataResponseBodyToTypesOAuthEnablementMetadata(body.OauthEnablementMetadata)
+	v.Tools = make([]*types.Tool, len(body.Tools))
+	for i, val := range body.Tools {
+		if val == nil {
+			v.Tools[i] = nil
+			continue
+		}
+		v.Tools[i] = unmarshalToolResponseBodyToTypesTool(val)
+	}
+	v.ToolUrns = make([]string, len(body.ToolUrns))
+	for i, val := range body.ToolUrns {
+		v.ToolUrns[i] = val
+	}
+	v.Resources = make([]*types.Resource, len(body.Resources))
+	for i, val := range body.Resources {
+		if val == nil {
+			v.Resources[i] = nil
+			continue
+		}
+		v.Resources[i] = unmarshalResourceResponseBodyToTypesResource(val)
+	}
+	v.ResourceUrns = make([]string, len(body.ResourceUrns))
+	for i, val := range body.ResourceUrns {
+		v.ResourceUrns[i] = val
+	}
+	v.PromptTemplates = make([]*types.PromptTemplate, len(body.PromptTemplates))
+	for i, val := range body.PromptTemplates {
+		if val == nil {
+			v.PromptTemplates[i] = nil
+			continue
+		}
+		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
+	}
+	if body.Origin != nil {
+		v.Origin = unmarshalToolsetOriginResponseBodyToTypesToolsetOrigin(body.Origin)
+	}
+	if body.ExternalOauthServer != nil {
+		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
+	}
+
+	return v
+}
+
+// NewCreateToolsetUnauthorized builds a toolsets service createToolset
+// endpoint unauthorized error.
+func NewCreateToolsetUnauthorized(body *CreateToolsetUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateToolsetForbidden builds a toolsets service createToolset endpoint
+// forbidden error.
+func NewCreateToolsetForbidden(body *CreateToolsetForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateToolsetBadRequest builds a toolsets service createToolset endpoint
+// bad_request error.
+func NewCreateToolsetBadRequest(body *CreateToolsetBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateToolsetNotFound builds a toolsets service createToolset endpoint
+// not_found error.
+func NewCreateToolsetNotFound(body *CreateToolsetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateToolsetConflict builds a toolsets service createToolset endpoint
+// conflict error.
+func NewCreateToolsetConflict(body *CreateToolsetConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateToolsetUnsupportedMedia builds a toolsets service createToolset
+// endpoint unsupported_media error.
+func NewCreateToolsetUnsupportedMedia(body *CreateToolsetUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateToolsetInvalid builds a toolsets service createToolset endpoint
+// invalid error.
+func NewCreateToolsetInvalid(body *CreateToolsetInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateToolsetInvariantViolation builds a toolsets service createToolset
+// endpoint invariant_violation error.
+func NewCreateToolsetInvariantViolation(body *CreateToolsetInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateToolsetUnexpected builds a toolsets service createToolset endpoint
+// unexpected error.
+func NewCreateToolsetUnexpected(body *CreateToolsetUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateToolsetGatewayError builds a toolsets service createToolset
+// endpoint gateway_error error.
+func NewCreateToolsetGatewayError(body *CreateToolsetGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsResultOK builds a "toolsets" service "listToolsets" endpoint
+// result from a HTTP "OK" response.
+func NewListToolsetsResultOK(body *ListToolsetsResponseBody) *toolsets.ListToolsetsResult {
+	v := &toolsets.ListToolsetsResult{}
+	v.Toolsets = make([]*types.ToolsetEntry, len(body.Toolsets))
+	for i, val := range body.Toolsets {
+		if val == nil {
+			v.Toolsets[i] = nil
+			continue
+		}
+		v.Toolsets[i] = unmarshalToolsetEntryResponseBodyToTypesToolsetEntry(val)
+	}
+
+	return v
+}
+
+// NewListToolsetsUnauthorized builds a toolsets service listToolsets endpoint
+// unauthorized error.
+func NewListToolsetsUnauthorized(body *ListToolsetsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsForbidden builds a toolsets service listToolsets endpoint
+// forbidden error.
+func NewListToolsetsForbidden(body *ListToolsetsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsBadRequest builds a toolsets service listToolsets endpoint
+// bad_request error.
+func NewListToolsetsBadRequest(body *ListToolsetsBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsNotFound builds a toolsets service listToolsets endpoint
+// not_found error.
+func NewListToolsetsNotFound(body *ListToolsetsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsConflict builds a toolsets service listToolsets endpoint
+// conflict error.
+func NewListToolsetsConflict(body *ListToolsetsConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsUnsupportedMedia builds a toolsets service listToolsets
+// endpoint unsupported_media error.
+func NewListToolsetsUnsupportedMedia(body *ListToolsetsUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsInvalid builds a toolsets service listToolsets endpoint
+// invalid error.
+func NewListToolsetsInvalid(body *ListToolsetsInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsInvariantViolation builds a toolsets service listToolsets
+// endpoint invariant_violation error.
+func NewListToolsetsInvariantViolation(body *ListToolsetsInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsUnexpected builds a toolsets service listToolsets endpoint
+// unexpected error.
+func NewListToolsetsUnexpected(body *ListToolsetsUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsGatewayError builds a toolsets service listToolsets endpoint
+// gateway_error error.
+func NewListToolsetsGatewayError(body *ListToolsetsGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsForOrgListToolsetSummariesResultOK builds a "toolsets"
+// service "listToolsetsForOrg" endpoint result from a HTTP "OK" response.
+func NewListToolsetsForOrgListToolsetSummariesResultOK(body *ListToolsetsForOrgResponseBody) *toolsets.ListToolsetSummariesResult {
+	v := &toolsets.ListToolsetSummariesResult{}
+	v.Toolsets = make([]*types.ToolsetSummary, len(body.Toolsets))
+	for i, val := range body.Toolsets {
+		if val == nil {
+			v.Toolsets[i] = nil
+			continue
+		}
+		v.Toolsets[i] = unmarshalToolsetSummaryResponseBodyToTypesToolsetSummary(val)
+	}
+
+	return v
+}
+
+// NewListToolsetsForOrgUnauthorized builds a toolsets service
+// listToolsetsForOrg endpoint unauthorized error.
+func NewListToolsetsForOrgUnauthorized(body *ListToolsetsForOrgUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsForOrgForbidden builds a toolsets service listToolsetsForOrg
+// endpoint forbidden error.
+func NewListToolsetsForOrgForbidden(body *ListToolsetsForOrgForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsForOrgBadRequest builds a toolsets service listToolsetsForOrg
+// endpoint bad_request error.
+func NewListToolsetsForOrgBadRequest(body *ListToolsetsForOrgBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsForOrgNotFound builds a toolsets service listToolsetsForOrg
+// endpoint not_found error.
+func NewListToolsetsForOrgNotFound(body *ListToolsetsForOrgNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsForOrgConflict builds a toolsets service listToolsetsForOrg
+// endpoint conflict error.
+func NewListToolsetsForOrgConflict(body *ListToolsetsForOrgConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsForOrgUnsupportedMedia builds a toolsets service
+// listToolsetsForOrg endpoint unsupported_media error.
+func NewListToolsetsForOrgUnsupportedMedia(body *ListToolsetsForOrgUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsForOrgInvalid builds a toolsets service listToolsetsForOrg
+// endpoint invalid error.
+func NewListToolsetsForOrgInvalid(body *ListToolsetsForOrgInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsForOrgInvariantViolation builds a toolsets service
+// listToolsetsForOrg endpoint invariant_violation error.
+func NewListToolsetsForOrgInvariantViolation(body *ListToolsetsForOrgInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsForOrgUnexpected builds a toolsets service listToolsetsForOrg
+// endpoint unexpected error.
+func NewListToolsetsForOrgUnexpected(body *ListToolsetsForOrgUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolsetsForOrgGatewayError builds a toolsets service
+// listToolsetsForOrg endpoint gateway_error error.
+func NewListToolsetsForOrgGatewayError(body *ListToolsetsForOrgGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateToolsetToolsetOK builds a "toolsets" service "updateToolset"
+// endpoint result from a HTTP "OK" response.
+func NewUpdateToolsetToolsetOK(body *UpdateToolsetResponseBody) *types.Toolset {
+	v := &types.Toolset{
+		ID:                    *body.ID,
+		ProjectID:             *body.ProjectID,
+		OrganizationID:        *body.OrganizationID,
+		AccountType:           *body.AccountType,
+		Name:                  *body.Name,
+		Slug:                  types.Slug(*body.Slug),
+		Description:           body.Description,
+		ToolsetVersion:        *body.ToolsetVersion,
+		McpIsPublic:           body.McpIsPublic,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     *body.ToolSelectionMode,
+		CustomDomainID:        body.CustomDomainID,
+		UserSessionIssuerID:   body.UserSessionIssuerID,
+		ToolVariationsGroupID: body.ToolVariationsGroupID,
+		CreatedAt:             *body.CreatedAt,
+		UpdatedAt:             *body.UpdatedAt,
+	}
+	if body.DefaultEnvironmentSlug != nil {
+		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
+		v.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+	}
+	if body.McpSlug != nil {
+		mcpSlug := types.Slug(*body.McpSlug)
+		v.McpSlug = &mcpSlug
+	}
+	if body.UserSessionIssuerSlug != nil {
+		userSessionIssuerSlug := types.Slug(*body.UserSessionIssuerSlug)
+		v.UserSessionIssuerSlug = &userSessionIssuerSlug
+	}
+	if body.SecurityVariables != nil {
+		v.SecurityVariables = make([]*types.SecurityVariable, len(body.SecurityVariables))
+		for i, val := range body.SecurityVariables {
+			if val == nil {
+				v.SecurityVariables[i] = nil
+				continue
+			}
+			v.SecurityVariables[i] = unmarshalSecurityVariableResponseBodyToTypesSecurityVariable(val)
+		}
+	}
+	if body.ServerVariables != nil {
+		v.ServerVariables = make([]*types.ServerVariable, len(body.ServerVariables))
+		for i, val := range body.ServerVariables {
+			if val == nil {
+				v.ServerVariables[i] = nil
+				continue
+			}
+			v.ServerVariables[i] = unmarshalServerVariableResponseBodyToTypesServerVariable(val)
+		}
+	}
+	if body.FunctionEnvironmentVariables != nil {
+		v.FunctionEnvironmentVariables = make([]*types.FunctionEnvironmentVariable, len(body.FunctionEnvironmentVariables))
+		for i, val := range body.FunctionEnvironmentVariables {
+			if val == nil {
+				v.FunctionEnvironmentVariables[i] = nil
+				continue
+			}
+			v.FunctionEnvironmentVariables[i] = unmarshalFunctionEnvironmentVariableResponseBodyToTypesFunctionEnvironmentVariable(val)
+		}
+	}
+	if body.ExternalMcpHeaderDefinitions != nil {
+		v.ExternalMcpHeaderDefinitions = make([]*types.ExternalMCPHeaderDefinition, len(body.ExternalMcpHeaderDefinitions))
+		for i, val := range body.ExternalMcpHeaderDefinitions {
+			if val == nil {
+				v.ExternalMcpHeaderDefinitions[i] = nil
+				continue
+			}
+			v.ExternalMcpHeaderDefinitions[i] = unmarshalExternalMCPHeaderDefinitionResponseBodyToTypesExternalMCPHeaderDefinition(val)
+		}
+	}
+	v.OauthEnablementMetadata = unmarshalOAuthEnablementMetadataResponseBodyToTypesOAuthEnablementMetadata(body.OauthEnablementMetadata)
+	v.Tools = make([]*types.Tool, len(body.Tools))
+	for i, val := range body.Tools {
+		if val == nil {
+			v.Tools[i] = nil
+			continue
+		}
+		v.Tools[i] = unmarshalToolResponseBodyToTypesTool(val)
+	}
+	v.ToolUrns = make([]string, len(body.ToolUrns))
+	for i, val := range body.ToolUrns {
+		v.ToolUrns[i] = val
+	}
+	v.Resources = make([]*types.Resource, len(body.Resources))
+	for i, val := range body.Resources {
+		if val == nil {
+			v.Resources[i] = nil
+			continue
+		}
+		v.Resources[i] = unmarshalResourceResponseBodyToTypesResource(val)
+	}
+	v.ResourceUrns = make([]string, len(body.ResourceUrns))
+	for i, val := range body.ResourceUrns {
+		v.ResourceUrns[i] = val
+	}
+	v.PromptTemplates = make([]*types.PromptTemplate, len(body.PromptTemplates))
+	for i, val := range body.PromptTemplates {
+		if val == nil {
+			v.PromptTemplates[i] = nil
+			continue
+		}
+		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
+	}
+	if body.Origin != nil {
+		v.Origin = unmarshalToolsetOriginResponseBodyToTypesToolsetOrigin(body.Origin)
+	}
+	if body.ExternalOauthServer != nil {
+		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
+	}
+
+	return v
+}
+
+// NewUpdateToolsetUnauthorized builds a toolsets service updateToolset
+// endpoint unauthorized error.
+func NewUpdateToolsetUnauthorized(body *UpdateToolsetUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateToolsetForbidden builds a toolsets service updateToolset endpoint
+// forbidden error.
+func NewUpdateToolsetForbidden(body *UpdateToolsetForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateToolsetBadRequest builds a toolsets service updateToolset endpoint
+// bad_request error.
+func NewUpdateToolsetBadRequest(body *UpdateToolsetBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateToolsetNotFound builds a toolsets service updateToolset endpoint
+// not_found error.
+func NewUpdateToolsetNotFound(body *UpdateToolsetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
 }
 
-// ToolFilterToolResponseBody is used to define fields on response body types.
-type ToolFilterToolResponseBody struct {
-	// The URN of the tool
-	ToolUrn *string `form:"tool_urn,omitempty" json:"tool_urn,omitempty" xml:"tool_urn,omitempty"`
-	// The display name of the tool, with any variation rename from the resolved
-	// group applied (matching the runtime wire)
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+// NewUpdateToolsetConflict builds a toolsets service updateToolset endpoint
+// conflict error.
+func NewUpdateToolsetConflict(body *UpdateToolsetConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
 }
 
-// ExternalOAuthServerFormRequestBody is used to define fields on request body
-// types.
-type ExternalOAuthServerFormRequestBody struct {
-	// The slug of the external OAuth server
-	Slug string `form:"slug" json:"slug" xml:"slug"`
-	// The metadata for the external OAuth server
-	Metadata any `form:"metadata" json:"metadata" xml:"metadata"`
+// NewUpdateToolsetUnsupportedMedia builds a toolsets service updateToolset
+// endpoint unsupported_media error.
+func NewUpdateToolsetUnsupportedMedia(body *UpdateToolsetUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
 }
 
-// NewCreateToolsetRequestBody builds the HTTP request body from the payload of
-// the "createToolset" endpoint of the "toolsets" service.
-func NewCreateToolsetRequestBody(p *toolsets.CreateToolsetPayload) *CreateToolsetRequestBody {
-	body := &CreateToolsetRequestBody{
-		Name:        p.Name,
-		Description: p.Description,
+// NewUpdateToolsetInvalid builds a toolsets service updateToolset endpoint
+// invalid error.
+func NewUpdateToolsetInvalid(body *UpdateToolsetInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if p.DefaultEnvironmentSlug != nil {
-		defaultEnvironmentSlug := string(*p.DefaultEnvironmentSlug)
-		body.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+
+	return v
+}
+
+// NewUpdateToolsetInvariantViolation builds a toolsets service updateToolset
+// endpoint invariant_violation error.
+func NewUpdateToolsetInvariantViolation(body *UpdateToolsetInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if p.ToolUrns != nil {
-		body.ToolUrns = make([]string, len(p.ToolUrns))
-		for i, val := range p.ToolUrns {
-			body.ToolUrns[i] = val
-		}
+
+	return v
+}
+
+// NewUpdateToolsetUnexpected builds a toolsets service updateToolset endpoint
+// unexpected error.
+func NewUpdateToolsetUnexpected(body *UpdateToolsetUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if p.ResourceUrns != nil {
-		body.ResourceUrns = make([]string, len(p.ResourceUrns))
-		for i, val := range p.ResourceUrns {
-			body.ResourceUrns[i] = val
-		}
+
+	return v
+}
+
+// NewUpdateToolsetGatewayError builds a toolsets service updateToolset
+// endpoint gateway_error error.
+func NewUpdateToolsetGatewayError(body *UpdateToolsetGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if p.Origin != nil {
-		body.Origin = marshalTypesToolsetOriginToToolsetOriginRequestBody(p.Origin)
+
+	return v
+}
+
+// NewDeleteToolsetUnauthorized builds a toolsets service deleteToolset
+// endpoint unauthorized error.
+func NewDeleteToolsetUnauthorized(body *DeleteToolsetUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	return body
+
+	return v
 }
 
-// NewUpdateToolsetRequestBody builds the HTTP request body from the payload of
-// the "updateToolset" endpoint of the "toolsets" service.
-func NewUpdateToolsetRequestBody(p *toolsets.UpdateToolsetPayload) *UpdateToolsetRequestBody {
-	body := &UpdateToolsetRequestBody{
-		Name:              p.Name,
-		Description:       p.Description,
-		McpEnabled:        p.McpEnabled,
-		McpIsPublic:       p.McpIsPublic,
-		CustomDomainID:    p.CustomDomainID,
-		ToolSelectionMode: p.ToolSelectionMode,
+// NewDeleteToolsetForbidden builds a toolsets service deleteToolset endpoint
+// forbidden error.
+func NewDeleteToolsetForbidden(body *DeleteToolsetForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if p.DefaultEnvironmentSlug != nil {
-		defaultEnvironmentSlug := string(*p.DefaultEnvironmentSlug)
-		body.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+
+	return v
+}
+
+// NewDeleteToolsetBadRequest builds a toolsets service deleteToolset endpoint
+// bad_request error.
+func NewDeleteToolsetBadRequest(body *DeleteToolsetBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if p.McpSlug != nil {
-		mcpSlug := string(*p.McpSlug)
-		body.McpSlug = &mcpSlug
+
+	return v
+}
+
+// NewDeleteToolsetNotFound builds a toolsets service deleteToolset endpoint
+// not_found error.
+func NewDeleteToolsetNotFound(body *DeleteToolsetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if p.PromptTemplateNames != nil {
-		body.PromptTemplateNames = make([]string, len(p.PromptTemplateNames))
-		for i, val := range p.PromptTemplateNames {
-			body.PromptTemplateNames[i] = val
-		}
+
+	return v
+}
+
+// NewDeleteToolsetConflict builds a toolsets service deleteToolset endpoint
+// conflict error.
+func NewDeleteToolsetConflict(body *DeleteToolsetConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if p.ToolUrns != nil {
-		body.ToolUrns = make([]string, len(p.ToolUrns))
-		for i, val := range p.ToolUrns {
-			body.ToolUrns[i] = val
-		}
+
+	return v
+}
+
+// NewDeleteToolsetUnsupportedMedia builds a toolsets service deleteToolset
+// endpoint unsupported_media error.
+func NewDeleteToolsetUnsupportedMedia(body *DeleteToolsetUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	if p.ResourceUrns != nil {
-		body.ResourceUrns = make([]string, len(p.ResourceUrns))
-		for i, val := range p.ResourceUrns {
-			body.ResourceUrns[i] = val
-		}
+
+	return v
+}
+
+// NewDeleteToolsetInvalid builds a toolsets service deleteToolset endpoint
+// invalid error.
+func NewDeleteToolsetInvalid(body *DeleteToolsetInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	return body
+
+	return v
 }
 
-// NewAddExternalOAuthServerRequestBody builds the HTTP request body from the
-// payload of the "addExternalOAuthServer" endpoint of the "toolsets" service.
-func NewAddExternalOAuthServerRequestBody(p *toolsets.AddExternalOAuthServerPayload) *AddExternalOAuthServerRequestBody {
-	body := &AddExternalOAuthServerRequestBody{}
-	if p.ExternalOauthServer != nil {
-		body.ExternalOauthServer = marshalTypesExternalOAuthServerFormToExternalOAuthServerFormRequestBody(p.ExternalOauthServer)
+// NewDeleteToolsetInvariantViolation builds a toolsets service deleteToolset
+// endpoint invariant_violation error.
+func NewDeleteToolsetInvariantViolation(body *DeleteToolsetInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	return body
+
+	return v
 }
 
-// NewSetUserSessionIssuerRequestBody builds the HTTP request body from the
-// payload of the "setUserSessionIssuer" endpoint of the "toolsets" service.
-func NewSetUserSessionIssuerRequestBody(p *toolsets.SetUserSessionIssuerPayload) *SetUserSessionIssuerRequestBody {
-	body := &SetUserSessionIssuerRequestBody{
-		UserSessionIssuerID: p.UserSessionIssuerID,
+// NewDeleteToolsetUnexpected builds a toolsets service deleteToolset endpoint
+// unexpected error.
+func NewDeleteToolsetUnexpected(body *DeleteToolsetUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	return body
+
+	return v
 }
 
-// NewSetToolVariationsGroupRequestBody builds the HTTP request body from the
-// payload of the "setToolVariationsGroup" endpoint of the "toolsets" service.
-func NewSetToolVariationsGroupRequestBody(p *toolsets.SetToolVariationsGroupPayload) *SetToolVariationsGroupRequestBody {
-	body := &SetToolVariationsGroupRequestBody{
-		ToolVariationsGroupID: p.ToolVariationsGroupID,
+// NewDeleteToolsetGatewayError builds a toolsets service deleteToolset
+// endpoint gateway_error error.
+func NewDeleteToolsetGatewayError(body *DeleteToolsetGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
 	}
-	return body
+
+	return v
 }
 
-// NewCreateToolsetToolsetOK builds a "toolsets" service "createToolset"
-// endpoint result from a HTTP "OK" response.
-func NewCreateToolsetToolsetOK(body *CreateToolsetResponseBody) *types.Toolset {
+// NewGetToolsetToolsetOK builds a "toolsets" service "getToolset" endpoint
+// result from a HTTP "OK" response.
+func NewGetToolsetToolsetOK(body *GetToolsetResponseBody) *types.Toolset {
 	v := &types.Toolset{
 		ID:                    *body.ID,
 		ProjectID:             *body.ProjectID,
@@ -3974,9 +5485,9 @@ func NewCreateToolsetToolsetOK(body *CreateToolsetResponseBody) *types.Toolset {
 	return v
 }
 
-// NewCreateToolsetUnauthorized builds a toolsets service createToolset
-// endpoint unauthorized error.
-func NewCreateToolsetUnauthorized(body *CreateToolsetUnauthorizedResponseBody) *goa.ServiceError {
+// NewGetToolsetUnauthorized builds a toolsets service getToolset endpoint
+// unauthorized error.
+func NewGetToolsetUnauthorized(body *GetToolsetUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -3989,9 +5500,9 @@ func NewCreateToolsetUnauthorized(body *CreateToolsetUnauthorizedResponseBody) *
 	return v
 }
 
-// NewCreateToolsetForbidden builds a toolsets service createToolset endpoint
+// NewGetToolsetForbidden builds a toolsets service getToolset endpoint
 // forbidden error.
-func NewCreateToolsetForbidden(body *CreateToolsetForbiddenResponseBody) *goa.ServiceError {
+func NewGetToolsetForbidden(body *GetToolsetForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4004,9 +5515,187 @@ func NewCreateToolsetForbidden(body *CreateToolsetForbiddenResponseBody) *goa.Se
 	return v
 }
 
-// NewCreateToolsetBadRequest builds a toolsets service createToolset endpoint
+// NewGetToolsetBadRequest builds a toolsets service getToolset endpoint
 // bad_request error.
-func NewCreateToolsetBadRequest(body *CreateToolsetBadRequestResponseBody) *goa.ServiceError {
+func NewGetToolsetBadRequest(body *GetToolsetBadRequestResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetToolsetNotFound builds a toolsets service getToolset endpoint
+// not_found error.
+func NewGetToolsetNotFound(body *GetToolsetNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetToolsetConflict builds a toolsets service getToolset endpoint conflict
+// error.
+func NewGetToolsetConflict(body *GetToolsetConflictResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetToolsetUnsupportedMedia builds a toolsets service getToolset endpoint
+// unsupported_media error.
+func NewGetToolsetUnsupportedMedia(body *GetToolsetUnsupportedMediaResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetToolsetInvalid builds a toolsets service getToolset endpoint invalid
+// error.
+func NewGetToolsetInvalid(body *GetToolsetInvalidResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetToolsetInvariantViolation builds a toolsets service getToolset
+// endpoint invariant_violation error.
+func NewGetToolsetInvariantViolation(body *GetToolsetInvariantViolationResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetToolsetUnexpected builds a toolsets service getToolset endpoint
+// unexpected error.
+func NewGetToolsetUnexpected(body *GetToolsetUnexpectedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetToolsetGatewayError builds a toolsets service getToolset endpoint
+// gateway_error error.
+func NewGetToolsetGatewayError(body *GetToolsetGatewayErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolFiltersResultOK builds a "toolsets" service "listToolFilters"
+// endpoint result from a HTTP "OK" response.
+func NewListToolFiltersResultOK(body *ListToolFiltersResponseBody) *types.ListToolFiltersResult {
+	v := &types.ListToolFiltersResult{
+		FilteringEnabled:        *body.FilteringEnabled,
+		ToolVariationsGroupID:   body.ToolVariationsGroupID,
+		ToolVariationsGroupName: body.ToolVariationsGroupName,
+	}
+	v.Scopes = make([]*types.ToolFilterScope, len(body.Scopes))
+	for i, val := range body.Scopes {
+		if val == nil {
+			v.Scopes[i] = nil
+			continue
+		}
+		v.Scopes[i] = unmarshalToolFilterScopeResponseBodyToTypesToolFilterScope(val)
+	}
+	v.Excluded = make([]*types.ToolFilterTool, len(body.Excluded))
+	for i, val := range body.Excluded {
+		if val == nil {
+			v.Excluded[i] = nil
+			continue
+		}
+		v.Excluded[i] = unmarshalToolFilterToolResponseBodyToTypesToolFilterTool(val)
+	}
+
+	return v
+}
+
+// NewListToolFiltersUnauthorized builds a toolsets service listToolFilters
+// endpoint unauthorized error.
+func NewListToolFiltersUnauthorized(body *ListToolFiltersUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolFiltersForbidden builds a toolsets service listToolFilters
+// endpoint forbidden error.
+func NewListToolFiltersForbidden(body *ListToolFiltersForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListToolFiltersBadRequest builds a toolsets service listToolFilters
+// endpoint bad_request error.
+func NewListToolFiltersBadRequest(body *ListToolFiltersBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4019,9 +5708,9 @@ func NewCreateToolsetBadRequest(body *CreateToolsetBadRequestResponseBody) *goa.
 	return v
 }
 
-// NewCreateToolsetNotFound builds a toolsets service createToolset endpoint
-// not_found error.
-func NewCreateToolsetNotFound(body *CreateToolsetNotFoundResponseBody) *goa.ServiceError {
+// NewListToolFiltersNotFound builds a toolsets service listToolFilters
+// endpoint not_found error.
+func NewListToolFiltersNotFound(body *ListToolFiltersNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4034,9 +5723,9 @@ func NewCreateToolsetNotFound(body *CreateToolsetNotFoundResponseBody) *goa.Serv
 	return v
 }
 
-// NewCreateToolsetConflict builds a toolsets service createToolset endpoint
-// conflict error.
-func NewCreateToolsetConflict(body *CreateToolsetConflictResponseBody) *goa.ServiceError {
+// NewListToolFiltersConflict builds a toolsets service listToolFilters
+// endpoint conflict error.
+func NewListToolFiltersConflict(body *ListToolFiltersConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4049,9 +5738,9 @@ func NewCreateToolsetConflict(body *CreateToolsetConflictResponseBody) *goa.Serv
 	return v
 }
 
-// NewCreateToolsetUnsupportedMedia builds a toolsets service createToolset
+// NewListToolFiltersUnsupportedMedia builds a toolsets service listToolFilters
 // endpoint unsupported_media error.
-func NewCreateToolsetUnsupportedMedia(body *CreateToolsetUnsupportedMediaResponseBody) *goa.ServiceError {
+func NewListToolFiltersUnsupportedMedia(body *ListToolFiltersUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4064,9 +5753,9 @@ func NewCreateToolsetUnsupportedMedia(body *CreateToolsetUnsupportedMediaRespons
 	return v
 }
 
-// NewCreateToolsetInvalid builds a toolsets service createToolset endpoint
+// NewListToolFiltersInvalid builds a toolsets service listToolFilters endpoint
 // invalid error.
-func NewCreateToolsetInvalid(body *CreateToolsetInvalidResponseBody) *goa.ServiceError {
+func NewListToolFiltersInvalid(body *ListToolFiltersInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4079,9 +5768,9 @@ func NewCreateToolsetInvalid(body *CreateToolsetInvalidResponseBody) *goa.Servic
 	return v
 }
 
-// NewCreateToolsetInvariantViolation builds a toolsets service createToolset
-// endpoint invariant_violation error.
-func NewCreateToolsetInvariantViolation(body *CreateToolsetInvariantViolationResponseBody) *goa.ServiceError {
+// NewListToolFiltersInvariantViolation builds a toolsets service
+// listToolFilters endpoint invariant_violation error.
+func NewListToolFiltersInvariantViolation(body *ListToolFiltersInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4094,9 +5783,9 @@ func NewCreateToolsetInvariantViolation(body *CreateToolsetInvariantViolationRes
 	return v
 }
 
-// NewCreateToolsetUnexpected builds a toolsets service createToolset endpoint
-// unexpected error.
-func NewCreateToolsetUnexpected(body *CreateToolsetUnexpectedResponseBody) *goa.ServiceError {
+// NewListToolFiltersUnexpected builds a toolsets service listToolFilters
+// endpoint unexpected error.
+func NewListToolFiltersUnexpected(body *ListToolFiltersUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4109,9 +5798,9 @@ func NewCreateToolsetUnexpected(body *CreateToolsetUnexpectedResponseBody) *goa.
 	return v
 }
 
-// NewCreateToolsetGatewayError builds a toolsets service createToolset
+// NewListToolFiltersGatewayError builds a toolsets service listToolFilters
 // endpoint gateway_error error.
-func NewCreateToolsetGatewayError(body *CreateToolsetGatewayErrorResponseBody) *goa.ServiceError {
+func NewListToolFiltersGatewayError(body *ListToolFiltersGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4124,25 +5813,9 @@ func NewCreateToolsetGatewayError(body *CreateToolsetGatewayErrorResponseBody) *
 	return v
 }
 
-// NewListToolsetsResultOK builds a "toolsets" service "listToolsets" endpoint
-// result from a HTTP "OK" response.
-func NewListToolsetsResultOK(body *ListToolsetsResponseBody) *toolsets.ListToolsetsResult {
-	v := &toolsets.ListToolsetsResult{}
-	v.Toolsets = make([]*types.ToolsetEntry, len(body.Toolsets))
-	for i, val := range body.Toolsets {
-		if val == nil {
-			v.Toolsets[i] = nil
-			continue
-		}
-		v.Toolsets[i] = unmarshalToolsetEntryResponseBodyToTypesToolsetEntry(val)
-	}
-
-	return v
-}
-
-// NewListToolsetsUnauthorized builds a toolsets service listToolsets endpoint
-// unauthorized error.
-func NewListToolsetsUnauthorized(body *ListToolsetsUnauthorizedResponseBody) *goa.ServiceError {
+// NewCheckMCPSlugAvailabilityUnauthorized builds a toolsets service
+// checkMCPSlugAvailability endpoint unauthorized error.
+func NewCheckMCPSlugAvailabilityUnauthorized(body *CheckMCPSlugAvailabilityUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4155,9 +5828,9 @@ func NewListToolsetsUnauthorized(body *ListToolsetsUnauthorizedResponseBody) *go
 	return v
 }
 
-// NewListToolsetsForbidden builds a toolsets service listToolsets endpoint
-// forbidden error.
-func NewListToolsetsForbidden(body *ListToolsetsForbiddenResponseBody) *goa.ServiceError {
+// NewCheckMCPSlugAvailabilityForbidden builds a toolsets service
+// checkMCPSlugAvailability endpoint forbidden error.
+func NewCheckMCPSlugAvailabilityForbidden(body *CheckMCPSlugAvailabilityForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4170,9 +5843,9 @@ func NewListToolsetsForbidden(body *ListToolsetsForbiddenResponseBody) *goa.Serv
 	return v
 }
 
-// NewListToolsetsBadRequest builds a toolsets service listToolsets endpoint
-// bad_request error.
-func NewListToolsetsBadRequest(body *ListToolsetsBadRequestResponseBody) *goa.ServiceError {
+// NewCheckMCPSlugAvailabilityBadRequest builds a toolsets service
+// checkMCPSlugAvailability endpoint bad_request error.
+func NewCheckMCPSlugAvailabilityBadRequest(body *CheckMCPSlugAvailabilityBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4185,9 +5858,9 @@ func NewListToolsetsBadRequest(body *ListToolsetsBadRequestResponseBody) *goa.Se
 	return v
 }
 
-// NewListToolsetsNotFound builds a toolsets service listToolsets endpoint
-// not_found error.
-func NewListToolsetsNotFound(body *ListToolsetsNotFoundResponseBody) *goa.ServiceError {
+// NewCheckMCPSlugAvailabilityNotFound builds a toolsets service
+// checkMCPSlugAvailability endpoint not_found error.
+func NewCheckMCPSlugAvailabilityNotFound(body *CheckMCPSlugAvailabilityNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4200,9 +5873,9 @@ func NewListToolsetsNotFound(body *ListToolsetsNotFoundResponseBody) *goa.Servic
 	return v
 }
 
-// NewListToolsetsConflict builds a toolsets service listToolsets endpoint
-// conflict error.
-func NewListToolsetsConflict(body *ListToolsetsConflictResponseBody) *goa.ServiceError {
+// NewCheckMCPSlugAvailabilityConflict builds a toolsets service
+// checkMCPSlugAvailability endpoint conflict error.
+func NewCheckMCPSlugAvailabilityConflict(body *CheckMCPSlugAvailabilityConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4215,9 +5888,9 @@ func NewListToolsetsConflict(body *ListToolsetsConflictResponseBody) *goa.Servic
 	return v
 }
 
-// NewListToolsetsUnsupportedMedia builds a toolsets service listToolsets
-// endpoint unsupported_media error.
-func NewListToolsetsUnsupportedMedia(body *ListToolsetsUnsupportedMediaResponseBody) *goa.ServiceError {
+// NewCheckMCPSlugAvailabilityUnsupportedMedia builds a toolsets service
+// checkMCPSlugAvailability endpoint unsupported_media error.
+func NewCheckMCPSlugAvailabilityUnsupportedMedia(body *CheckMCPSlugAvailabilityUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4230,9 +5903,9 @@ func NewListToolsetsUnsupportedMedia(body *ListToolsetsUnsupportedMediaResponseB
 	return v
 }
 
-// NewListToolsetsInvalid builds a toolsets service listToolsets endpoint
-// invalid error.
-func NewListToolsetsInvalid(body *ListToolsetsInvalidResponseBody) *goa.ServiceError {
+// NewCheckMCPSlugAvailabilityInvalid builds a toolsets service
+// checkMCPSlugAvailability endpoint invalid error.
+func NewCheckMCPSlugAvailabilityInvalid(body *CheckMCPSlugAvailabilityInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4245,9 +5918,9 @@ func NewListToolsetsInvalid(body *ListToolsetsInvalidResponseBody) *goa.ServiceE
 	return v
 }
 
-// NewListToolsetsInvariantViolation builds a toolsets service listToolsets
-// endpoint invariant_violation error.
-func NewListToolsetsInvariantViolation(body *ListToolsetsInvariantViolationResponseBody) *goa.ServiceError {
+// NewCheckMCPSlugAvailabilityInvariantViolation builds a toolsets service
+// checkMCPSlugAvailability endpoint invariant_violation error.
+func NewCheckMCPSlugAvailabilityInvariantViolation(body *CheckMCPSlugAvailabilityInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4260,9 +5933,9 @@ func NewListToolsetsInvariantViolation(body *ListToolsetsInvariantViolationRespo
 	return v
 }
 
-// NewListToolsetsUnexpected builds a toolsets service listToolsets endpoint
-// unexpected error.
-func NewListToolsetsUnexpected(body *ListToolsetsUnexpectedResponseBody) *goa.ServiceError {
+// NewCheckMCPSlugAvailabilityUnexpected builds a toolsets service
+// checkMCPSlugAvailability endpoint unexpected error.
+func NewCheckMCPSlugAvailabilityUnexpected(body *CheckMCPSlugAvailabilityUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4275,9 +5948,9 @@ func NewListToolsetsUnexpected(body *ListToolsetsUnexpectedResponseBody) *goa.Se
 	return v
 }
 
-// NewListToolsetsGatewayError builds a toolsets service listToolsets endpoint
-// gateway_error error.
-func NewListToolsetsGatewayError(body *ListToolsetsGatewayErrorResponseBody) *goa.ServiceError {
+// NewCheckMCPSlugAvailabilityGatewayError builds a toolsets service
+// checkMCPSlugAvailability endpoint gateway_error error.
+func NewCheckMCPSlugAvailabilityGatewayError(body *CheckMCPSlugAvailabilityGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4290,25 +5963,125 @@ func NewListToolsetsGatewayError(body *ListToolsetsGatewayErrorResponseBody) *go
 	return v
 }
 
-// NewListToolsetsForOrgListToolsetSummariesResultOK builds a "toolsets"
-// service "listToolsetsForOrg" endpoint result from a HTTP "OK" response.
-func NewListToolsetsForOrgListToolsetSummariesResultOK(body *ListToolsetsForOrgResponseBody) *toolsets.ListToolsetSummariesResult {
-	v := &toolsets.ListToolsetSummariesResult{}
-	v.Toolsets = make([]*types.ToolsetSummary, len(body.Toolsets))
-	for i, val := range body.Toolsets {
+// NewCloneToolsetToolsetOK builds a "toolsets" service "cloneToolset" endpoint
+// result from a HTTP "OK" response.
+func NewCloneToolsetToolsetOK(body *CloneToolsetResponseBody) *types.Toolset {
+	v := &types.Toolset{
+		ID:                    *body.ID,
+		ProjectID:             *body.ProjectID,
+		OrganizationID:        *body.OrganizationID,
+		AccountType:           *body.AccountType,
+		Name:                  *body.Name,
+		Slug:                  types.Slug(*body.Slug),
+		Description:           body.Description,
+		ToolsetVersion:        *body.ToolsetVersion,
+		McpIsPublic:           body.McpIsPublic,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     *body.ToolSelectionMode,
+		CustomDomainID:        body.CustomDomainID,
+		UserSessionIssuerID:   body.UserSessionIssuerID,
+		ToolVariationsGroupID: body.ToolVariationsGroupID,
+		CreatedAt:             *body.CreatedAt,
+		UpdatedAt:             *body.UpdatedAt,
+	}
+	if body.DefaultEnvironmentSlug != nil {
+		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
+		v.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+	}
+	if body.McpSlug != nil {
+		mcpSlug := types.Slug(*body.McpSlug)
+		v.McpSlug = &mcpSlug
+	}
+	if body.UserSessionIssuerSlug != nil {
+		userSessionIssuerSlug := types.Slug(*body.UserSessionIssuerSlug)
+		v.UserSessionIssuerSlug = &userSessionIssuerSlug
+	}
+	if body.SecurityVariables != nil {
+		v.SecurityVariables = make([]*types.SecurityVariable, len(body.SecurityVariables))
+		for i, val := range body.SecurityVariables {
+			if val == nil {
+				v.SecurityVariables[i] = nil
+				continue
+			}
+			v.SecurityVariables[i] = unmarshalSecurityVariableResponseBodyToTypesSecurityVariable(val)
+		}
+	}
+	if body.ServerVariables != nil {
+		v.ServerVariables = make([]*types.ServerVariable, len(body.ServerVariables))
+		for i, val := range body.ServerVariables {
+			if val == nil {
+				v.ServerVariables[i] = nil
+				continue
+			}
+			v.ServerVariables[i] = unmarshalServerVariableResponseBodyToTypesServerVariable(val)
+		}
+	}
+	if body.FunctionEnvironmentVariables != nil {
+		v.FunctionEnvironmentVariables = make([]*types.FunctionEnvironmentVariable, len(body.FunctionEnvironmentVariables))
+		for i, val := range body.FunctionEnvironmentVariables {
+			if val == nil {
+				v.FunctionEnvironmentVariables[i] = nil
+				continue
+			}
+			v.FunctionEnvironmentVariables[i] = unmarshalFunctionEnvironmentVariableResponseBodyToTypesFunctionEnvironmentVariable(val)
+		}
+	}
+	if body.ExternalMcpHeaderDefinitions != nil {
+		v.ExternalMcpHeaderDefinitions = make([]*types.ExternalMCPHeaderDefinition, len(body.ExternalMcpHeaderDefinitions))
+		for i, val := range body.ExternalMcpHeaderDefinitions {
+			if val == nil {
+				v.ExternalMcpHeaderDefinitions[i] = nil
+				continue
+			}
+			v.ExternalMcpHeaderDefinitions[i] = unmarshalExternalMCPHeaderDefinitionResponseBodyToTypesExternalMCPHeaderDefinition(val)
+		}
+	}
+	v.OauthEnablementMetadata = unmarshalOAuthEnablementMetadataResponseBodyToTypesOAuthEnablementMetadata(body.OauthEnablementMetadata)
+	v.Tools = make([]*types.Tool, len(body.Tools))
+	for i, val := range body.Tools {
 		if val == nil {
-			v.Toolsets[i] = nil
+			v.Tools[i] = nil
 			continue
 		}
-		v.Toolsets[i] = unmarshalToolsetSummaryResponseBodyToTypesToolsetSummary(val)
+		v.Tools[i] = unmarshalToolResponseBodyToTypesTool(val)
+	}
+	v.ToolUrns = make([]string, len(body.ToolUrns))
+	for i, val := range body.ToolUrns {
+		v.ToolUrns[i] = val
+	}
+	v.Resources = make([]*types.Resource, len(body.Resources))
+	for i, val := range body.Resources {
+		if val == nil {
+			v.Resources[i] = nil
+			continue
+		}
+		v.Resources[i] = unmarshalResourceResponseBodyToTypesResource(val)
+	}
+	v.ResourceUrns = make([]string, len(body.ResourceUrns))
+	for i, val := range body.ResourceUrns {
+		v.ResourceUrns[i] = val
+	}
+	v.PromptTemplates = make([]*types.PromptTemplate, len(body.PromptTemplates))
+	for i, val := range body.PromptTemplates {
+		if val == nil {
+			v.PromptTemplates[i] = nil
+			continue
+		}
+		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
+	}
+	if body.Origin != nil {
+		v.Origin = unmarshalToolsetOriginResponseBodyToTypesToolsetOrigin(body.Origin)
+	}
+	if body.ExternalOauthServer != nil {
+		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
 	}
 
 	return v
 }
 
-// NewListToolsetsForOrgUnauthorized builds a toolsets service
-// listToolsetsForOrg endpoint unauthorized error.
-func NewListToolsetsForOrgUnauthorized(body *ListToolsetsForOrgUnauthorizedResponseBody) *goa.ServiceError {
+// NewCloneToolsetUnauthorized builds a toolsets service cloneToolset endpoint
+// unauthorized error.
+func NewCloneToolsetUnauthorized(body *CloneToolsetUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4321,9 +6094,9 @@ func NewListToolsetsForOrgUnauthorized(body *ListToolsetsForOrgUnauthorizedRespo
 	return v
 }
 
-// NewListToolsetsForOrgForbidden builds a toolsets service listToolsetsForOrg
-// endpoint forbidden error.
-func NewListToolsetsForOrgForbidden(body *ListToolsetsForOrgForbiddenResponseBody) *goa.ServiceError {
+// NewCloneToolsetForbidden builds a toolsets service cloneToolset endpoint
+// forbidden error.
+func NewCloneToolsetForbidden(body *CloneToolsetForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4336,9 +6109,9 @@ func NewListToolsetsForOrgForbidden(body *ListToolsetsForOrgForbiddenResponseBod
 	return v
 }
 
-// NewListToolsetsForOrgBadRequest builds a toolsets service listToolsetsForOrg
-// endpoint bad_request error.
-func NewListToolsetsForOrgBadRequest(body *ListToolsetsForOrgBadRequestResponseBody) *goa.ServiceError {
+// NewCloneToolsetBadRequest builds a toolsets service cloneToolset endpoint
+// bad_request error.
+func NewCloneToolsetBadRequest(body *CloneToolsetBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4349,11 +6122,11 @@ func NewListToolsetsForOrgBadRequest(body *ListToolsetsForOrgBadRequestResponseB
 	}
 
 	return v
-}
-
-// NewListToolsetsForOrgNotFound builds a toolsets service listToolsetsForOrg
-// endpoint not_found error.
-func NewListToolsetsForOrgNotFound(body *ListToolsetsForOrgNotFoundResponseBody) *goa.ServiceError {
+}
+
+// NewCloneToolsetNotFound builds a toolsets service cloneToolset endpoint
+// not_found error.
+func NewCloneToolsetNotFound(body *CloneToolsetNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4366,9 +6139,9 @@ func NewListToolsetsForOrgNotFound(body *ListToolsetsForOrgNotFoundResponseBody)
 	return v
 }
 
-// NewListToolsetsForOrgConflict builds a toolsets service listToolsetsForOrg
-// endpoint conflict error.
-func NewListToolsetsForOrgConflict(body *ListToolsetsForOrgConflictResponseBody) *goa.ServiceError {
+// NewCloneToolsetConflict builds a toolsets service cloneToolset endpoint
+// conflict error.
+func NewCloneToolsetConflict(body *CloneToolsetConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4381,9 +6154,9 @@ func NewListToolsetsForOrgConflict(body *ListToolsetsForOrgConflictResponseBody)
 	return v
 }
 
-// NewListToolsetsForOrgUnsupportedMedia builds a toolsets service
-// listToolsetsForOrg endpoint unsupported_media error.
-func NewListToolsetsForOrgUnsupportedMedia(body *ListToolsetsForOrgUnsupportedMediaResponseBody) *goa.ServiceError {
+// NewCloneToolsetUnsupportedMedia builds a toolsets service cloneToolset
+// endpoint unsupported_media error.
+func NewCloneToolsetUnsupportedMedia(body *CloneToolsetUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4396,9 +6169,9 @@ func NewListToolsetsForOrgUnsupportedMedia(body *ListToolsetsForOrgUnsupportedMe
 	return v
 }
 
-// NewListToolsetsForOrgInvalid builds a toolsets service listToolsetsForOrg
-// endpoint invalid error.
-func NewListToolsetsForOrgInvalid(body *ListToolsetsForOrgInvalidResponseBody) *goa.ServiceError {
+// NewCloneToolsetInvalid builds a toolsets service cloneToolset endpoint
+// invalid error.
+func NewCloneToolsetInvalid(body *CloneToolsetInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4411,9 +6184,9 @@ func NewListToolsetsForOrgInvalid(body *ListToolsetsForOrgInvalidResponseBody) *
 	return v
 }
 
-// NewListToolsetsForOrgInvariantViolation builds a toolsets service
-// listToolsetsForOrg endpoint invariant_violation error.
-func NewListToolsetsForOrgInvariantViolation(body *ListToolsetsForOrgInvariantViolationResponseBody) *goa.ServiceError {
+// NewCloneToolsetInvariantViolation builds a toolsets service cloneToolset
+// endpoint invariant_violation error.
+func NewCloneToolsetInvariantViolation(body *CloneToolsetInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4426,9 +6199,9 @@ func NewListToolsetsForOrgInvariantViolation(body *ListToolsetsForOrgInvariantVi
 	return v
 }
 
-// NewListToolsetsForOrgUnexpected builds a toolsets service listToolsetsForOrg
-// endpoint unexpected error.
-func NewListToolsetsForOrgUnexpected(body *ListToolsetsForOrgUnexpectedResponseBody) *goa.ServiceError {
+// NewCloneToolsetUnexpected builds a toolsets service cloneToolset endpoint
+// unexpected error.
+func NewCloneToolsetUnexpected(body *CloneToolsetUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4441,9 +6214,9 @@ func NewListToolsetsForOrgUnexpected(body *ListToolsetsForOrgUnexpectedResponseB
 	return v
 }
 
-// NewListToolsetsForOrgGatewayError builds a toolsets service
-// listToolsetsForOrg endpoint gateway_error error.
-func NewListToolsetsForOrgGatewayError(body *ListToolsetsForOrgGatewayErrorResponseBody) *goa.ServiceError {
+// NewCloneToolsetGatewayError builds a toolsets service cloneToolset endpoint
+// gateway_error error.
+func NewCloneToolsetGatewayError(body *CloneToolsetGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4456,9 +6229,9 @@ func NewListToolsetsForOrgGatewayError(body *ListToolsetsForOrgGatewayErrorRespo
 	return v
 }
 
-// NewUpdateToolsetToolsetOK builds a "toolsets" service "updateToolset"
-// endpoint result from a HTTP "OK" response.
-func NewUpdateToolsetToolsetOK(body *UpdateToolsetResponseBody) *types.Toolset {
+// NewAddExternalOAuthServerToolsetOK builds a "toolsets" service
+// "addExternalOAuthServer" endpoint result from a HTTP "OK" response.
+func NewAddExternalOAuthServerToolsetOK(body *AddExternalOAuthServerResponseBody) *types.Toolset {
 	v := &types.Toolset{
 		ID:                    *body.ID,
 		ProjectID:             *body.ProjectID,
@@ -4572,9 +6345,9 @@ func NewUpdateToolsetToolsetOK(body *UpdateToolsetResponseBody) *types.Toolset {
 	return v
 }
 
-// NewUpdateToolsetUnauthorized builds a toolsets service updateToolset
-// endpoint unauthorized error.
-func NewUpdateToolsetUnauthorized(body *UpdateToolsetUnauthorizedResponseBody) *goa.ServiceError {
+// NewAddExternalOAuthServerUnauthorized builds a toolsets service
+// addExternalOAuthServer endpoint unauthorized error.
+func NewAddExternalOAuthServerUnauthorized(body *AddExternalOAuthServerUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4587,9 +6360,9 @@ func NewUpdateToolsetUnauthorized(body *UpdateToolsetUnauthorizedResponseBody) *
 	return v
 }
 
-// NewUpdateToolsetForbidden builds a toolsets service updateToolset endpoint
-// forbidden error.
-func NewUpdateToolsetForbidden(body *UpdateToolsetForbiddenResponseBody) *goa.ServiceError {
+// NewAddExternalOAuthServerForbidden builds a toolsets service
+// addExternalOAuthServer endpoint forbidden error.
+func NewAddExternalOAuthServerForbidden(body *AddExternalOAuthServerForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4602,9 +6375,9 @@ func NewUpdateToolsetForbidden(body *UpdateToolsetForbiddenResponseBody) *goa.Se
 	return v
 }
 
-// NewUpdateToolsetBadRequest builds a toolsets service updateToolset endpoint
-// bad_request error.
-func NewUpdateToolsetBadRequest(body *UpdateToolsetBadRequestResponseBody) *goa.ServiceError {
+// NewAddExternalOAuthServerBadRequest builds a toolsets service
+// addExternalOAuthServer endpoint bad_request error.
+func NewAddExternalOAuthServerBadRequest(body *AddExternalOAuthServerBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4617,9 +6390,9 @@ func NewUpdateToolsetBadRequest(body *UpdateToolsetBadRequestResponseBody) *goa.
 	return v
 }
 
-// NewUpdateToolsetNotFound builds a toolsets service updateToolset endpoint
-// not_found error.
-func NewUpdateToolsetNotFound(body *UpdateToolsetNotFoundResponseBody) *goa.ServiceError {
+// NewAddExternalOAuthServerNotFound builds a toolsets service
+// addExternalOAuthServer endpoint not_found error.
+func NewAddExternalOAuthServerNotFound(body *AddExternalOAuthServerNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4632,9 +6405,9 @@ func NewUpdateToolsetNotFound(body *UpdateToolsetNotFoundResponseBody) *goa.Serv
 	return v
 }
 
-// NewUpdateToolsetConflict builds a toolsets service updateToolset endpoint
-// conflict error.
-func NewUpdateToolsetConflict(body *UpdateToolsetConflictResponseBody) *goa.ServiceError {
+// NewAddExternalOAuthServerConflict builds a toolsets service
+// addExternalOAuthServer endpoint conflict error.
+func NewAddExternalOAuthServerConflict(body *AddExternalOAuthServerConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4647,9 +6420,9 @@ func NewUpdateToolsetConflict(body *UpdateToolsetConflictResponseBody) *goa.Serv
 	return v
 }
 
-// NewUpdateToolsetUnsupportedMedia builds a toolsets service updateToolset
-// endpoint unsupported_media error.
-func NewUpdateToolsetUnsupportedMedia(body *UpdateToolsetUnsupportedMediaResponseBody) *goa.ServiceError {
+// NewAddExternalOAuthServerUnsupportedMedia builds a toolsets service
+// addExternalOAuthServer endpoint unsupported_media error.
+func NewAddExternalOAuthServerUnsupportedMedia(body *AddExternalOAuthServerUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4662,9 +6435,9 @@ func NewUpdateToolsetUnsupportedMedia(body *UpdateToolsetUnsupportedMediaRespons
 	return v
 }
 
-// NewUpdateToolsetInvalid builds a toolsets service updateToolset endpoint
-// invalid error.
-func NewUpdateToolsetInvalid(body *UpdateToolsetInvalidResponseBody) *goa.ServiceError {
+// NewAddExternalOAuthServerInvalid builds a toolsets service
+// addExternalOAuthServer endpoint invalid error.
+func NewAddExternalOAuthServerInvalid(body *AddExternalOAuthServerInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4677,9 +6450,9 @@ func NewUpdateToolsetInvalid(body *UpdateToolsetInvalidResponseBody) *goa.Servic
 	return v
 }
 
-// NewUpdateToolsetInvariantViolation builds a toolsets service updateToolset
-// endpoint invariant_violation error.
-func NewUpdateToolsetInvariantViolation(body *UpdateToolsetInvariantViolationResponseBody) *goa.ServiceError {
+// NewAddExternalOAuthServerInvariantViolation builds a toolsets service
+// addExternalOAuthServer endpoint invariant_violation error.
+func NewAddExternalOAuthServerInvariantViolation(body *AddExternalOAuthServerInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4692,9 +6465,9 @@ func NewUpdateToolsetInvariantViolation(body *UpdateToolsetInvariantViolationRes
 	return v
 }
 
-// NewUpdateToolsetUnexpected builds a toolsets service updateToolset endpoint
-// unexpected error.
-func NewUpdateToolsetUnexpected(body *UpdateToolsetUnexpectedResponseBody) *goa.ServiceError {
+// NewAddExternalOAuthServerUnexpected builds a toolsets service
+// addExternalOAuthServer endpoint unexpected error.
+func NewAddExternalOAuthServerUnexpected(body *AddExternalOAuthServerUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4707,9 +6480,9 @@ func NewUpdateToolsetUnexpected(body *UpdateToolsetUnexpectedResponseBody) *goa.
 	return v
 }
 
-// NewUpdateToolsetGatewayError builds a toolsets service updateToolset
-// endpoint gateway_error error.
-func NewUpdateToolsetGatewayError(body *UpdateToolsetGatewayErrorResponseBody) *goa.ServiceError {
+// NewAddExternalOAuthServerGatewayError builds a toolsets service
+// addExternalOAuthServer endpoint gateway_error error.
+func NewAddExternalOAuthServerGatewayError(body *AddExternalOAuthServerGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4722,9 +6495,125 @@ func NewUpdateToolsetGatewayError(body *UpdateToolsetGatewayErrorResponseBody) *
 	return v
 }
 
-// NewDeleteToolsetUnauthorized builds a toolsets service deleteToolset
+// NewRemoveOAuthServerToolsetOK builds a "toolsets" service
+// "removeOAuthServer" endpoint result from a HTTP "OK" response.
+func NewRemoveOAuthServerToolsetOK(body *RemoveOAuthServerResponseBody) *types.Toolset {
+	v := &types.Toolset{
+		ID:                    *body.ID,
+		ProjectID:             *body.ProjectID,
+		OrganizationID:        *body.OrganizationID,
+		AccountType:           *body.AccountType,
+		Name:                  *body.Name,
+		Slug:                  types.Slug(*body.Slug),
+		Description:           body.Description,
+		ToolsetVersion:        *body.ToolsetVersion,
+		McpIsPublic:           body.McpIsPublic,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     *body.ToolSelectionMode,
+		CustomDomainID:        body.CustomDomainID,
+		UserSessionIssuerID:   body.UserSessionIssuerID,
+		ToolVariationsGroupID: body.ToolVariationsGroupID,
+		CreatedAt:             *body.CreatedAt,
+		UpdatedAt:             *body.UpdatedAt,
+	}
+	if body.DefaultEnvironmentSlug != nil {
+		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
+		v.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+	}
+	if body.McpSlug != nil {
+		mcpSlug := types.Slug(*body.McpSlug)
+		v.McpSlug = &mcpSlug
+	}
+	if body.UserSessionIssuerSlug != nil {
+		userSessionIssuerSlug := types.Slug(*body.UserSessionIssuerSlug)
+		v.UserSessionIssuerSlug = &userSessionIssuerSlug
+	}
+	if body.SecurityVariables != nil {
+		v.SecurityVariables = make([]*types.SecurityVariable, len(body.SecurityVariables))
+		for i, val := range body.SecurityVariables {
+			if val == nil {
+				v.SecurityVariables[i] = nil
+				continue
+			}
+			v.SecurityVariables[i] = unmarshalSecurityVariableResponseBodyToTypesSecurityVariable(val)
+		}
+	}
+	if body.ServerVariables != nil {
+		v.ServerVariables = make([]*types.ServerVariable, len(body.ServerVariables))
+		for i, val := range body.ServerVariables {
+			if val == nil {
+				v.ServerVariables[i] = nil
+				continue
+			}
+			v.ServerVariables[i] = unmarshalServerVariableResponseBodyToTypesServerVariable(val)
+		}
+	}
+	if body.FunctionEnvironmentVariables != nil {
+		v.FunctionEnvironmentVariables = make([]*types.FunctionEnvironmentVariable, len(body.FunctionEnvironmentVariables))
+		for i, val := range body.FunctionEnvironmentVariables {
+			if val == nil {
+				v.FunctionEnvironmentVariables[i] = nil
+				continue
+			}
+			v.FunctionEnvironmentVariables[i] = unmarshalFunctionEnvironmentVariableResponseBodyToTypesFunctionEnvironmentVariable(val)
+		}
+	}
+	if body.ExternalMcpHeaderDefinitions != nil {
+		v.ExternalMcpHeaderDefinitions = make([]*types.ExternalMCPHeaderDefinition, len(body.ExternalMcpHeaderDefinitions))
+		for i, val := range body.ExternalMcpHeaderDefinitions {
+			if val == nil {
+				v.ExternalMcpHeaderDefinitions[i] = nil
+				continue
+			}
+			v.ExternalMcpHeaderDefinitions[i] = unmarshalExternalMCPHeaderDefinitionResponseBodyToTypesExternalMCPHeaderDefinition(val)
+		}
+	}
+	v.OauthEnablementMetadata = unmarshalOAuthEnablementMetadataResponseBodyToTypesOAuthEnablementMetadata(body.OauthEnablementMetadata)
+	v.Tools = make([]*types.Tool, len(body.Tools))
+	for i, val := range body.Tools {
+		if val == nil {
+			v.Tools[i] = nil
+			continue
+		}
+		v.Tools[i] = unmarshalToolResponseBodyToTypesTool(val)
+	}
+	v.ToolUrns = make([]string, len(body.ToolUrns))
+	for i, val := range body.ToolUrns {
+		v.ToolUrns[i] = val
+	}
+	v.Resources = make([]*types.Resource, len(body.Resources))
+	for i, val := range body.Resources {
+		if val == nil {
+			v.Resources[i] = nil
+			continue
+		}
+		v.Resources[i] = unmarshalResourceResponseBodyToTypesResource(val)
+	}
+	v.ResourceUrns = make([]string, len(body.ResourceUrns))
+	for i, val := range body.ResourceUrns {
+		v.ResourceUrns[i] = val
+	}
+	v.PromptTemplates = make([]*types.PromptTemplate, len(body.PromptTemplates))
+	for i, val := range body.PromptTemplates {
+		if val == nil {
+			v.PromptTemplates[i] = nil
+			continue
+		}
+		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
+	}
+	if body.Origin != nil {
+		v.Origin = unmarshalToolsetOriginResponseBodyToTypesToolsetOrigin(body.Origin)
+	}
+	if body.ExternalOauthServer != nil {
+		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
+	}
+
+	return v
+}
+
+// NewRemoveOAuthServerUnauthorized builds a toolsets service removeOAuthServer
 // endpoint unauthorized error.
-func NewDeleteToolsetUnauthorized(body *DeleteToolsetUnauthorizedResponseBody) *goa.ServiceError {
+func NewRemoveOAuthServerUnauthorized(body *RemoveOAuthServerUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4737,9 +6626,9 @@ func NewDeleteToolsetUnauthorized(body *DeleteToolsetUnauthorizedResponseBody) *
 	return v
 }
 
-// NewDeleteToolsetForbidden builds a toolsets service deleteToolset endpoint
-// forbidden error.
-func NewDeleteToolsetForbidden(body *DeleteToolsetForbiddenResponseBody) *goa.ServiceError {
+// NewRemoveOAuthServerForbidden builds a toolsets service removeOAuthServer
+// endpoint forbidden error.
+func NewRemoveOAuthServerForbidden(body *RemoveOAuthServerForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4752,9 +6641,9 @@ func NewDeleteToolsetForbidden(body *DeleteToolsetForbiddenResponseBody) *goa.Se
 	return v
 }
 
-// NewDeleteToolsetBadRequest builds a toolsets service deleteToolset endpoint
-// bad_request error.
-func NewDeleteToolsetBadRequest(body *DeleteToolsetBadRequestResponseBody) *goa.ServiceError {
+// NewRemoveOAuthServerBadRequest builds a toolsets service removeOAuthServer
+// endpoint bad_request error.
+func NewRemoveOAuthServerBadRequest(body *RemoveOAuthServerBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4767,9 +6656,9 @@ func NewDeleteToolsetBadRequest(body *DeleteToolsetBadRequestResponseBody) *goa.
 	return v
 }
 
-// NewDeleteToolsetNotFound builds a toolsets service deleteToolset endpoint
-// not_found error.
-func NewDeleteToolsetNotFound(body *DeleteToolsetNotFoundResponseBody) *goa.ServiceError {
+// NewRemoveOAuthServerNotFound builds a toolsets service removeOAuthServer
+// endpoint not_found error.
+func NewRemoveOAuthServerNotFound(body *RemoveOAuthServerNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4782,9 +6671,9 @@ func NewDeleteToolsetNotFound(body *DeleteToolsetNotFoundResponseBody) *goa.Serv
 	return v
 }
 
-// NewDeleteToolsetConflict builds a toolsets service deleteToolset endpoint
-// conflict error.
-func NewDeleteToolsetConflict(body *DeleteToolsetConflictResponseBody) *goa.ServiceError {
+// NewRemoveOAuthServerConflict builds a toolsets service removeOAuthServer
+// endpoint conflict error.
+func NewRemoveOAuthServerConflict(body *RemoveOAuthServerConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4795,11 +6684,11 @@ func NewDeleteToolsetConflict(body *DeleteToolsetConflictResponseBody) *goa.Serv
 	}
 
 	return v
-}
-
-// NewDeleteToolsetUnsupportedMedia builds a toolsets service deleteToolset
-// endpoint unsupported_media error.
-func NewDeleteToolsetUnsupportedMedia(body *DeleteToolsetUnsupportedMediaResponseBody) *goa.ServiceError {
+}
+
+// NewRemoveOAuthServerUnsupportedMedia builds a toolsets service
+// removeOAuthServer endpoint unsupported_media error.
+func NewRemoveOAuthServerUnsupportedMedia(body *RemoveOAuthServerUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4812,9 +6701,9 @@ func NewDeleteToolsetUnsupportedMedia(body *DeleteToolsetUnsupportedMediaRespons
 	return v
 }
 
-// NewDeleteToolsetInvalid builds a toolsets service deleteToolset endpoint
-// invalid error.
-func NewDeleteToolsetInvalid(body *DeleteToolsetInvalidResponseBody) *goa.ServiceError {
+// NewRemoveOAuthServerInvalid builds a toolsets service removeOAuthServer
+// endpoint invalid error.
+func NewRemoveOAuthServerInvalid(body *RemoveOAuthServerInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4827,9 +6716,9 @@ func NewDeleteToolsetInvalid(body *DeleteToolsetInvalidResponseBody) *goa.Servic
 	return v
 }
 
-// NewDeleteToolsetInvariantViolation builds a toolsets service deleteToolset
-// endpoint invariant_violation error.
-func NewDeleteToolsetInvariantViolation(body *DeleteToolsetInvariantViolationResponseBody) *goa.ServiceError {
+// NewRemoveOAuthServerInvariantViolation builds a toolsets service
+// removeOAuthServer endpoint invariant_violation error.
+func NewRemoveOAuthServerInvariantViolation(body *RemoveOAuthServerInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4842,9 +6731,9 @@ func NewDeleteToolsetInvariantViolation(body *DeleteToolsetInvariantViolationRes
 	return v
 }
 
-// NewDeleteToolsetUnexpected builds a toolsets service deleteToolset endpoint
-// unexpected error.
-func NewDeleteToolsetUnexpected(body *DeleteToolsetUnexpectedResponseBody) *goa.ServiceError {
+// NewRemoveOAuthServerUnexpected builds a toolsets service removeOAuthServer
+// endpoint unexpected error.
+func NewRemoveOAuthServerUnexpected(body *RemoveOAuthServerUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4857,9 +6746,9 @@ func NewDeleteToolsetUnexpected(body *DeleteToolsetUnexpectedResponseBody) *goa.
 	return v
 }
 
-// NewDeleteToolsetGatewayError builds a toolsets service deleteToolset
+// NewRemoveOAuthServerGatewayError builds a toolsets service removeOAuthServer
 // endpoint gateway_error error.
-func NewDeleteToolsetGatewayError(body *DeleteToolsetGatewayErrorResponseBody) *goa.ServiceError {
+func NewRemoveOAuthServerGatewayError(body *RemoveOAuthServerGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -4872,9 +6761,9 @@ func NewDeleteToolsetGatewayError(body *DeleteToolsetGatewayErrorResponseBody) *
 	return v
 }
 
-// NewGetToolsetToolsetOK builds a "toolsets" service "getToolset" endpoint
-// result from a HTTP "OK" response.
-func NewGetToolsetToolsetOK(body *GetToolsetResponseBody) *types.Toolset {
+// NewSetUserSessionIssuerToolsetOK builds a "toolsets" service
+// "setUserSessionIssuer" endpoint result from a HTTP "OK" response.
+func NewSetUserSessionIssuerToolsetOK(body *SetUserSessionIssuerResponseBody) *types.Toolset {
 	v := &types.Toolset{
 		ID:                    *body.ID,
 		ProjectID:             *body.ProjectID,
@@ -4988,9 +6877,9 @@ func NewGetToolsetToolsetOK(body *GetToolsetResponseBody) *types.Toolset {
 	return v
 }
 
-// NewGetToolsetUnauthorized builds a toolsets service getToolset endpoint
-// unauthorized error.
-func NewGetToolsetUnauthorized(body *GetToolsetUnauthorizedResponseBody) *goa.ServiceError {
+// NewSetUserSessionIssuerUnauthorized builds a toolsets service
+// setUserSessionIssuer endpoint unauthorized error.
+func NewSetUserSessionIssuerUnauthorized(body *SetUserSessionIssuerUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5003,9 +6892,9 @@ func NewGetToolsetUnauthorized(body *GetToolsetUnauthorizedResponseBody) *goa.Se
 	return v
 }
 
-// NewGetToolsetForbidden builds a toolsets service getToolset endpoint
-// forbidden error.
-func NewGetToolsetForbidden(body *GetToolsetForbiddenResponseBody) *goa.ServiceError {
+// NewSetUserSessionIssuerForbidden builds a toolsets service
+// setUserSessionIssuer endpoint forbidden error.
+func NewSetUserSessionIssuerForbidden(body *SetUserSessionIssuerForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5018,9 +6907,9 @@ func NewGetToolsetForbidden(body *GetToolsetForbiddenResponseBody) *goa.ServiceE
 	return v
 }
 
-// NewGetToolsetBadRequest builds a toolsets service getToolset endpoint
-// bad_request error.
-func NewGetToolsetBadRequest(body *GetToolsetBadRequestResponseBody) *goa.ServiceError {
+// NewSetUserSessionIssuerBadRequest builds a toolsets service
+// setUserSessionIssuer endpoint bad_request error.
+func NewSetUserSessionIssuerBadRequest(body *SetUserSessionIssuerBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5033,9 +6922,9 @@ func NewGetToolsetBadRequest(body *GetToolsetBadRequestResponseBody) *goa.Servic
 	return v
 }
 
-// NewGetToolsetNotFound builds a toolsets service getToolset endpoint
-// not_found error.
-func NewGetToolsetNotFound(body *GetToolsetNotFoundResponseBody) *goa.ServiceError {
+// NewSetUserSessionIssuerNotFound builds a toolsets service
+// setUserSessionIssuer endpoint not_found error.
+func NewSetUserSessionIssuerNotFound(body *SetUserSessionIssuerNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5048,9 +6937,9 @@ func NewGetToolsetNotFound(body *GetToolsetNotFoundResponseBody) *goa.ServiceErr
 	return v
 }
 
-// NewGetToolsetConflict builds a toolsets service getToolset endpoint conflict
-// error.
-func NewGetToolsetConflict(body *GetToolsetConflictResponseBody) *goa.ServiceError {
+// NewSetUserSessionIssuerConflict builds a toolsets service
+// setUserSessionIssuer endpoint conflict error.
+func NewSetUserSessionIssuerConflict(body *SetUserSessionIssuerConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5063,9 +6952,9 @@ func NewGetToolsetConflict(body *GetToolsetConflictResponseBody) *goa.ServiceErr
 	return v
 }
 
-// NewGetToolsetUnsupportedMedia builds a toolsets service getToolset endpoint
-// unsupported_media error.
-func NewGetToolsetUnsupportedMedia(body *GetToolsetUnsupportedMediaResponseBody) *goa.ServiceError {
+// NewSetUserSessionIssuerUnsupportedMedia builds a toolsets service
+// setUserSessionIssuer endpoint unsupported_media error.
+func NewSetUserSessionIssuerUnsupportedMedia(body *SetUserSessionIssuerUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5078,9 +6967,9 @@ func NewGetToolsetUnsupportedMedia(body *GetToolsetUnsupportedMediaResponseBody)
 	return v
 }
 
-// NewGetToolsetInvalid builds a toolsets service getToolset endpoint invalid
-// error.
-func NewGetToolsetInvalid(body *GetToolsetInvalidResponseBody) *goa.ServiceError {
+// NewSetUserSessionIssuerInvalid builds a toolsets service
+// setUserSessionIssuer endpoint invalid error.
+func NewSetUserSessionIssuerInvalid(body *SetUserSessionIssuerInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5093,9 +6982,9 @@ func NewGetToolsetInvalid(body *GetToolsetInvalidResponseBody) *goa.ServiceError
 	return v
 }
 
-// NewGetToolsetInvariantViolation builds a toolsets service getToolset
-// endpoint invariant_violation error.
-func NewGetToolsetInvariantViolation(body *GetToolsetInvariantViolationResponseBody) *goa.ServiceError {
+// NewSetUserSessionIssuerInvariantViolation builds a toolsets service
+// setUserSessionIssuer endpoint invariant_violation error.
+func NewSetUserSessionIssuerInvariantViolation(body *SetUserSessionIssuerInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5108,9 +6997,9 @@ func NewGetToolsetInvariantViolation(body *GetToolsetInvariantViolationResponseB
 	return v
 }
 
-// NewGetToolsetUnexpected builds a toolsets service getToolset endpoint
-// unexpected error.
-func NewGetToolsetUnexpected(body *GetToolsetUnexpectedResponseBody) *goa.ServiceError {
+// NewSetUserSessionIssuerUnexpected builds a toolsets service
+// setUserSessionIssuer endpoint unexpected error.
+func NewSetUserSessionIssuerUnexpected(body *SetUserSessionIssuerUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5123,9 +7012,9 @@ func NewGetToolsetUnexpected(body *GetToolsetUnexpectedResponseBody) *goa.Servic
 	return v
 }
 
-// NewGetToolsetGatewayError builds a toolsets service getToolset endpoint
-// gateway_error error.
-func NewGetToolsetGatewayError(body *GetToolsetGatewayErrorResponseBody) *goa.ServiceError {
+// NewSetUserSessionIssuerGatewayError builds a toolsets service
+// setUserSessionIssuer endpoint gateway_error error.
+func NewSetUserSessionIssuerGatewayError(body *SetUserSessionIssuerGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5138,37 +7027,125 @@ func NewGetToolsetGatewayError(body *GetToolsetGatewayErrorResponseBody) *goa.Se
 	return v
 }
 
-// NewListToolFiltersResultOK builds a "toolsets" service "listToolFilters"
-// endpoint result from a HTTP "OK" response.
-func NewListToolFiltersResultOK(body *ListToolFiltersResponseBody) *types.ListToolFiltersResult {
-	v := &types.ListToolFiltersResult{
-		FilteringEnabled:        *body.FilteringEnabled,
-		ToolVariationsGroupID:   body.ToolVariationsGroupID,
-		ToolVariationsGroupName: body.ToolVariationsGroupName,
+// NewSetToolVariationsGroupToolsetOK builds a "toolsets" service
+// "setToolVariationsGroup" endpoint result from a HTTP "OK" response.
+func NewSetToolVariationsGroupToolsetOK(body *SetToolVariationsGroupResponseBody) *types.Toolset {
+	v := &types.Toolset{
+		ID:                    *body.ID,
+		ProjectID:             *body.ProjectID,
+		OrganizationID:        *body.OrganizationID,
+		AccountType:           *body.AccountType,
+		Name:                  *body.Name,
+		Slug:                  types.Slug(*body.Slug),
+		Description:           body.Description,
+		ToolsetVersion:        *body.ToolsetVersion,
+		McpIsPublic:           body.McpIsPublic,
+		McpEnabled:            body.McpEnabled,
+		ToolSelectionMode:     *body.ToolSelectionMode,
+		CustomDomainID:        body.CustomDomainID,
+		UserSessionIssuerID:   body.UserSessionIssuerID,
+		ToolVariationsGroupID: body.ToolVariationsGroupID,
+		CreatedAt:             *body.CreatedAt,
+		UpdatedAt:             *body.UpdatedAt,
 	}
-	v.Scopes = make([]*types.ToolFilterScope, len(body.Scopes))
-	for i, val := range body.Scopes {
+	if body.DefaultEnvironmentSlug != nil {
+		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
+		v.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+	}
+	if body.McpSlug != nil {
+		mcpSlug := types.Slug(*body.McpSlug)
+		v.McpSlug = &mcpSlug
+	}
+	if body.UserSessionIssuerSlug != nil {
+		userSessionIssuerSlug := types.Slug(*body.UserSessionIssuerSlug)
+		v.UserSessionIssuerSlug = &userSessionIssuerSlug
+	}
+	if body.SecurityVariables != nil {
+		v.SecurityVariables = make([]*types.SecurityVariable, len(body.SecurityVariables))
+		for i, val := range body.SecurityVariables {
+			if val == nil {
+				v.SecurityVariables[i] = nil
+				continue
+			}
+			v.SecurityVariables[i] = unmarshalSecurityVariableResponseBodyToTypesSecurityVariable(val)
+		}
+	}
+	if body.ServerVariables != nil {
+		v.ServerVariables = make([]*types.ServerVariable, len(body.ServerVariables))
+		for i, val := range body.ServerVariables {
+			if val == nil {
+				v.ServerVariables[i] = nil
+				continue
+			}
+			v.ServerVariables[i] = unmarshalServerVariableResponseBodyToTypesServerVariable(val)
+		}
+	}
+	if body.FunctionEnvironmentVariables != nil {
+		v.FunctionEnvironmentVariables = make([]*types.FunctionEnvironmentVariable, len(body.FunctionEnvironmentVariables))
+		for i, val := range body.FunctionEnvironmentVariables {
+			if val == nil {
+				v.FunctionEnvironmentVariables[i] = nil
+				continue
+			}
+			v.FunctionEnvironmentVariables[i] = unmarshalFunctionEnvironmentVariableResponseBodyToTypesFunctionEnvironmentVariable(val)
+		}
+	}
+	if body.ExternalMcpHeaderDefinitions != nil {
+		v.ExternalMcpHeaderDefinitions = make([]*types.ExternalMCPHeaderDefinition, len(body.ExternalMcpHeaderDefinitions))
+		for i, val := range body.ExternalMcpHeaderDefinitions {
+			if val == nil {
+				v.ExternalMcpHeaderDefinitions[i] = nil
+				continue
+			}
+			v.ExternalMcpHeaderDefinitions[i] = unmarshalExternalMCPHeaderDefinitionResponseBodyToTypesExternalMCPHeaderDefinition(val)
+		}
+	}
+	v.OauthEnablementMetadata = unmarshalOAuthEnablementMetadataResponseBodyToTypesOAuthEnablementMetadata(body.OauthEnablementMetadata)
+	v.Tools = make([]*types.Tool, len(body.Tools))
+	for i, val := range body.Tools {
 		if val == nil {
-			v.Scopes[i] = nil
+			v.Tools[i] = nil
 			continue
 		}
-		v.Scopes[i] = unmarshalToolFilterScopeResponseBodyToTypesToolFilterScope(val)
+		v.Tools[i] = unmarshalToolResponseBodyToTypesTool(val)
 	}
-	v.Excluded = make([]*types.ToolFilterTool, len(body.Excluded))
-	for i, val := range body.Excluded {
+	v.ToolUrns = make([]string, len(body.ToolUrns))
+	for i, val := range body.ToolUrns {
+		v.ToolUrns[i] = val
+	}
+	v.Resources = make([]*types.Resource, len(body.Resources))
+	for i, val := range body.Resources {
 		if val == nil {
-			v.Excluded[i] = nil
+			v.Resources[i] = nil
 			continue
 		}
-		v.Excluded[i] = unmarshalToolFilterToolResponseBodyToTypesToolFilterTool(val)
+		v.Resources[i] = unmarshalResourceResponseBodyToTypesResource(val)
+	}
+	v.ResourceUrns = make([]string, len(body.ResourceUrns))
+	for i, val := range body.ResourceUrns {
+		v.ResourceUrns[i] = val
+	}
+	v.PromptTemplates = make([]*types.PromptTemplate, len(body.PromptTemplates))
+	for i, val := range body.PromptTemplates {
+		if val == nil {
+			v.PromptTemplates[i] = nil
+			continue
+		}
+		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
+	}
+	if body.Origin != nil {
+		v.Origin = unmarshalToolsetOriginResponseBodyToTypesToolsetOrigin(body.Origin)
+	}
+	if body.ExternalOauthServer != nil {
+		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
 	}
 
 	return v
 }
 
-// NewListToolFiltersUnauthorized builds a toolsets service listToolFilters
-// endpoint unauthorized error.
-func NewListToolFiltersUnauthorized(body *ListToolFiltersUnauthorizedResponseBody) *goa.ServiceError {
+// NewSetToolVariationsGroupUnauthorized builds a toolsets service
+// setToolVariationsGroup endpoint unauthorized error.
+func NewSetToolVariationsGroupUnauthorized(body *SetToolVariationsGroupUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5181,9 +7158,9 @@ func NewListToolFiltersUnauthorized(body *ListToolFiltersUnauthorizedResponseBod
 	return v
 }
 
-// NewListToolFiltersForbidden builds a toolsets service listToolFilters
-// endpoint forbidden error.
-func NewListToolFiltersForbidden(body *ListToolFiltersForbiddenResponseBody) *goa.ServiceError {
+// NewSetToolVariationsGroupForbidden builds a toolsets service
+// setToolVariationsGroup endpoint forbidden error.
+func NewSetToolVariationsGroupForbidden(body *SetToolVariationsGroupForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5196,9 +7173,9 @@ func NewListToolFiltersForbidden(body *ListToolFiltersForbiddenResponseBody) *go
 	return v
 }
 
-// NewListToolFiltersBadRequest builds a toolsets service listToolFilters
-// endpoint bad_request error.
-func NewListToolFiltersBadRequest(body *ListToolFiltersBadRequestResponseBody) *goa.ServiceError {
+// NewSetToolVariationsGroupBadRequest builds a toolsets service
+// setToolVariationsGroup endpoint bad_request error.
+func NewSetToolVariationsGroupBadRequest(body *SetToolVariationsGroupBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5211,9 +7188,9 @@ func NewListToolFiltersBadRequest(body *ListToolFiltersBadRequestResponseBody) *
 	return v
 }
 
-// NewListToolFiltersNotFound builds a toolsets service listToolFilters
-// endpoint not_found error.
-func NewListToolFiltersNotFound(body *ListToolFiltersNotFoundResponseBody) *goa.ServiceError {
+// NewSetToolVariationsGroupNotFound builds a toolsets service
+// setToolVariationsGroup endpoint not_found error.
+func NewSetToolVariationsGroupNotFound(body *SetToolVariationsGroupNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5226,9 +7203,9 @@ func NewListToolFiltersNotFound(body *ListToolFiltersNotFoundResponseBody) *goa.
 	return v
 }
 
-// NewListToolFiltersConflict builds a toolsets service listToolFilters
-// endpoint conflict error.
-func NewListToolFiltersConflict(body *ListToolFiltersConflictResponseBody) *goa.ServiceError {
+// NewSetToolVariationsGroupConflict builds a toolsets service
+// setToolVariationsGroup endpoint conflict error.
+func NewSetToolVariationsGroupConflict(body *SetToolVariationsGroupConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5241,9 +7218,9 @@ func NewListToolFiltersConflict(body *ListToolFiltersConflictResponseBody) *goa.
 	return v
 }
 
-// NewListToolFiltersUnsupportedMedia builds a toolsets service listToolFilters
-// endpoint unsupported_media error.
-func NewListToolFiltersUnsupportedMedia(body *ListToolFiltersUnsupportedMediaResponseBody) *goa.ServiceError {
+// NewSetToolVariationsGroupUnsupportedMedia builds a toolsets service
+// setToolVariationsGroup endpoint unsupported_media error.
+func NewSetToolVariationsGroupUnsupportedMedia(body *SetToolVariationsGroupUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5256,9 +7233,9 @@ func NewListToolFiltersUnsupportedMedia(body *ListToolFiltersUnsupportedMediaRes
 	return v
 }
 
-// NewListToolFiltersInvalid builds a toolsets service listToolFilters endpoint
-// invalid error.
-func NewListToolFiltersInvalid(body *ListToolFiltersInvalidResponseBody) *goa.ServiceError {
+// NewSetToolVariationsGroupInvalid builds a toolsets service
+// setToolVariationsGroup endpoint invalid error.
+func NewSetToolVariationsGroupInvalid(body *SetToolVariationsGroupInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5271,9 +7248,9 @@ func NewListToolFiltersInvalid(body *ListToolFiltersInvalidResponseBody) *goa.Se
 	return v
 }
 
-// NewListToolFiltersInvariantViolation builds a toolsets service
-// listToolFilters endpoint invariant_violation error.
-func NewListToolFiltersInvariantViolation(body *ListToolFiltersInvariantViolationResponseBody) *goa.ServiceError {
+// NewSetToolVariationsGroupInvariantViolation builds a toolsets service
+// setToolVariationsGroup endpoint invariant_violation error.
+func NewSetToolVariationsGroupInvariantViolation(body *SetToolVariationsGroupInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5286,9 +7263,9 @@ func NewListToolFiltersInvariantViolation(body *ListToolFiltersInvariantViolatio
 	return v
 }
 
-// NewListToolFiltersUnexpected builds a toolsets service listToolFilters
-// endpoint unexpected error.
-func NewListToolFiltersUnexpected(body *ListToolFiltersUnexpectedResponseBody) *goa.ServiceError {
+// NewSetToolVariationsGroupUnexpected builds a toolsets service
+// setToolVariationsGroup endpoint unexpected error.
+func NewSetToolVariationsGroupUnexpected(body *SetToolVariationsGroupUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5301,9 +7278,9 @@ func NewListToolFiltersUnexpected(body *ListToolFiltersUnexpectedResponseBody) *
 	return v
 }
 
-// NewListToolFiltersGatewayError builds a toolsets service listToolFilters
-// endpoint gateway_error error.
-func NewListToolFiltersGatewayError(body *ListToolFiltersGatewayErrorResponseBody) *goa.ServiceError {
+// NewSetToolVariationsGroupGatewayError builds a toolsets service
+// setToolVariationsGroup endpoint gateway_error error.
+func NewSetToolVariationsGroupGatewayError(body *SetToolVariationsGroupGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5316,9 +7293,36 @@ func NewListToolFiltersGatewayError(body *ListToolFiltersGatewayErrorResponseBod
 	return v
 }
 
-// NewCheckMCPSlugAvailabilityUnauthorized builds a toolsets service
-// checkMCPSlugAvailability endpoint unauthorized error.
-func NewCheckMCPSlugAvailabilityUnauthorized(body *CheckMCPSlugAvailabilityUnauthorizedResponseBody) *goa.ServiceError {
+// NewDiffToolsetVersionsToolsetVersionDiffOK builds a "toolsets" service
+// "diffToolsetVersions" endpoint result from a HTTP "OK" response.
+func NewDiffToolsetVersionsToolsetVersionDiffOK(body *DiffToolsetVersionsResponseBody) *toolsets.ToolsetVersionDiff {
+	v := &toolsets.ToolsetVersionDiff{
+		FromVersion: *body.FromVersion,
+		ToVersion:   *body.ToVersion,
+	}
+	v.AddedToolUrns = make([]string, len(body.AddedToolUrns))
+	for i, val := range body.AddedToolUrns {
+		v.AddedToolUrns[i] = val
+	}
+	v.RemovedToolUrns = make([]string, len(body.RemovedToolUrns))
+	for i, val := range body.RemovedToolUrns {
+		v.RemovedToolUrns[i] = val
+	}
+	v.AddedResourceUrns = make([]string, len(body.AddedResourceUrns))
+	for i, val := range body.AddedResourceUrns {
+		v.AddedResourceUrns[i] = val
+	}
+	v.RemovedResourceUrns = make([]string, len(body.RemovedResourceUrns))
+	for i, val := range body.RemovedResourceUrns {
+		v.RemovedResourceUrns[i] = val
+	}
+
+	return v
+}
+
+// NewDiffToolsetVersionsUnauthorized builds a toolsets service
+// diffToolsetVersions endpoint unauthorized error.
+func NewDiffToolsetVersionsUnauthorized(body *DiffToolsetVersionsUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5331,9 +7335,9 @@ func NewCheckMCPSlugAvailabilityUnauthorized(body *CheckMCPSlugAvailabilityUnaut
 	return v
 }
 
-// NewCheckMCPSlugAvailabilityForbidden builds a toolsets service
-// checkMCPSlugAvailability endpoint forbidden error.
-func NewCheckMCPSlugAvailabilityForbidden(body *CheckMCPSlugAvailabilityForbiddenResponseBody) *goa.ServiceError {
+// NewDiffToolsetVersionsForbidden builds a toolsets service
+// diffToolsetVersions endpoint forbidden error.
+func NewDiffToolsetVersionsForbidden(body *DiffToolsetVersionsForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5346,9 +7350,9 @@ func NewCheckMCPSlugAvailabilityForbidden(body *CheckMCPSlugAvailabilityForbidde
 	return v
 }
 
-// NewCheckMCPSlugAvailabilityBadRequest builds a toolsets service
-// checkMCPSlugAvailability endpoint bad_request error.
-func NewCheckMCPSlugAvailabilityBadRequest(body *CheckMCPSlugAvailabilityBadRequestResponseBody) *goa.ServiceError {
+// NewDiffToolsetVersionsBadRequest builds a toolsets service
+// diffToolsetVersions endpoint bad_request error.
+func NewDiffToolsetVersionsBadRequest(body *DiffToolsetVersionsBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5361,9 +7365,9 @@ func NewCheckMCPSlugAvailabilityBadRequest(body *CheckMCPSlugAvailabilityBadRequ
 	return v
 }
 
-// NewCheckMCPSlugAvailabilityNotFound builds a toolsets service
-// checkMCPSlugAvailability endpoint not_found error.
-func NewCheckMCPSlugAvailabilityNotFound(body *CheckMCPSlugAvailabilityNotFoundResponseBody) *goa.ServiceError {
+// NewDiffToolsetVersionsNotFound builds a toolsets service diffToolsetVersions
+// endpoint not_found error.
+func NewDiffToolsetVersionsNotFound(body *DiffToolsetVersionsNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5376,9 +7380,9 @@ func NewCheckMCPSlugAvailabilityNotFound(body *CheckMCPSlugAvailabilityNotFoundR
 	return v
 }
 
-// NewCheckMCPSlugAvailabilityConflict builds a toolsets service
-// checkMCPSlugAvailability endpoint conflict error.
-func NewCheckMCPSlugAvailabilityConflict(body *CheckMCPSlugAvailabilityConflictResponseBody) *goa.ServiceError {
+// NewDiffToolsetVersionsConflict builds a toolsets service diffToolsetVersions
+// endpoint conflict error.
+func NewDiffToolsetVersionsConflict(body *DiffToolsetVersionsConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5391,9 +7395,9 @@ func NewCheckMCPSlugAvailabilityConflict(body *CheckMCPSlugAvailabilityConflictR
 	return v
 }
 
-// NewCheckMCPSlugAvailabilityUnsupportedMedia builds a toolsets service
-// checkMCPSlugAvailability endpoint unsupported_media error.
-func NewCheckMCPSlugAvailabilityUnsupportedMedia(body *CheckMCPSlugAvailabilityUnsupportedMediaResponseBody) *goa.ServiceError {
+// NewDiffToolsetVersionsUnsupportedMedia builds a toolsets service
+// diffToolsetVersions endpoint unsupported_media error.
+func NewDiffToolsetVersionsUnsupportedMedia(body *DiffToolsetVersionsUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5406,9 +7410,9 @@ func NewCheckMCPSlugAvailabilityUnsupportedMedia(body *CheckMCPSlugAvailabilityU
 	return v
 }
 
-// NewCheckMCPSlugAvailabilityInvalid builds a toolsets service
-// checkMCPSlugAvailability endpoint invalid error.
-func NewCheckMCPSlugAvailabilityInvalid(body *CheckMCPSlugAvailabilityInvalidResponseBody) *goa.ServiceError {
+// NewDiffToolsetVersionsInvalid builds a toolsets service diffToolsetVersions
+// endpoint invalid error.
+func NewDiffToolsetVersionsInvalid(body *DiffToolsetVersionsInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5421,9 +7425,9 @@ func NewCheckMCPSlugAvailabilityInvalid(body *CheckMCPSlugAvailabilityInvalidRes
 	return v
 }
 
-// NewCheckMCPSlugAvailabilityInvariantViolation builds a toolsets service
-// checkMCPSlugAvailability endpoint invariant_violation error.
-func NewCheckMCPSlugAvailabilityInvariantViolation(body *CheckMCPSlugAvailabilityInvariantViolationResponseBody) *goa.ServiceError {
+// NewDiffToolsetVersionsInvariantViolation builds a toolsets service
+// diffToolsetVersions endpoint invariant_violation error.
+func NewDiffToolsetVersionsInvariantViolation(body *DiffToolsetVersionsInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5436,9 +7440,9 @@ func NewCheckMCPSlugAvailabilityInvariantViolation(body *CheckMCPSlugAvailabilit
 	return v
 }
 
-// NewCheckMCPSlugAvailabilityUnexpected builds a toolsets service
-// checkMCPSlugAvailability endpoint unexpected error.
-func NewCheckMCPSlugAvailabilityUnexpected(body *CheckMCPSlugAvailabilityUnexpectedResponseBody) *goa.ServiceError {
+// NewDiffToolsetVersionsUnexpected builds a toolsets service
+// diffToolsetVersions endpoint unexpected error.
+func NewDiffToolsetVersionsUnexpected(body *DiffToolsetVersionsUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5451,9 +7455,9 @@ func NewCheckMCPSlugAvailabilityUnexpected(body *CheckMCPSlugAvailabilityUnexpec
 	return v
 }
 
-// NewCheckMCPSlugAvailabilityGatewayError builds a toolsets service
-// checkMCPSlugAvailability endpoint gateway_error error.
-func NewCheckMCPSlugAvailabilityGatewayError(body *CheckMCPSlugAvailabilityGatewayErrorResponseBody) *goa.ServiceError {
+// NewDiffToolsetVersionsGatewayError builds a toolsets service
+// diffToolsetVersions endpoint gateway_error error.
+func NewDiffToolsetVersionsGatewayError(body *DiffToolsetVersionsGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5466,9 +7470,9 @@ func NewCheckMCPSlugAvailabilityGatewayError(body *CheckMCPSlugAvailabilityGatew
 	return v
 }
 
-// NewCloneToolsetToolsetOK builds a "toolsets" service "cloneToolset" endpoint
-// result from a HTTP "OK" response.
-func NewCloneToolsetToolsetOK(body *CloneToolsetResponseBody) *types.Toolset {
+// NewRevertToolsetToolsetOK builds a "toolsets" service "revertToolset"
+// endpoint result from a HTTP "OK" response.
+func NewRevertToolsetToolsetOK(body *RevertToolsetResponseBody) *types.Toolset {
 	v := &types.Toolset{
 		ID:                    *body.ID,
 		ProjectID:             *body.ProjectID,
@@ -5582,9 +7586,9 @@ func NewCloneToolsetToolsetOK(body *CloneToolsetResponseBody) *types.Toolset {
 	return v
 }
 
-// NewCloneToolsetUnauthorized builds a toolsets service cloneToolset endpoint
-// unauthorized error.
-func NewCloneToolsetUnauthorized(body *CloneToolsetUnauthorizedResponseBody) *goa.ServiceError {
+// NewRevertToolsetUnauthorized builds a toolsets service revertToolset
+// endpoint unauthorized error.
+func NewRevertToolsetUnauthorized(body *RevertToolsetUnauthorizedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5597,9 +7601,9 @@ func NewCloneToolsetUnauthorized(body *CloneToolsetUnauthorizedResponseBody) *go
 	return v
 }
 
-// NewCloneToolsetForbidden builds a toolsets service cloneToolset endpoint
+// NewRevertToolsetForbidden builds a toolsets service revertToolset endpoint
 // forbidden error.
-func NewCloneToolsetForbidden(body *CloneToolsetForbiddenResponseBody) *goa.ServiceError {
+func NewRevertToolsetForbidden(body *RevertToolsetForbiddenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5612,9 +7616,9 @@ func NewCloneToolsetForbidden(body *CloneToolsetForbiddenResponseBody) *goa.Serv
 	return v
 }
 
-// NewCloneToolsetBadRequest builds a toolsets service cloneToolset endpoint
+// NewRevertToolsetBadRequest builds a toolsets service revertToolset endpoint
 // bad_request error.
-func NewCloneToolsetBadRequest(body *CloneToolsetBadRequestResponseBody) *goa.ServiceError {
+func NewRevertToolsetBadRequest(body *RevertToolsetBadRequestResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5627,9 +7631,9 @@ func NewCloneToolsetBadRequest(body *CloneToolsetBadRequestResponseBody) *goa.Se
 	return v
 }
 
-// NewCloneToolsetNotFound builds a toolsets service cloneToolset endpoint
+// NewRevertToolsetNotFound builds a toolsets service revertToolset endpoint
 // not_found error.
-func NewCloneToolsetNotFound(body *CloneToolsetNotFoundResponseBody) *goa.ServiceError {
+func NewRevertToolsetNotFound(body *RevertToolsetNotFoundResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5642,9 +7646,9 @@ func NewCloneToolsetNotFound(body *CloneToolsetNotFoundResponseBody) *goa.Servic
 	return v
 }
 
-// NewCloneToolsetConflict builds a toolsets service cloneToolset endpoint
+// NewRevertToolsetConflict builds a toolsets service revertToolset endpoint
 // conflict error.
-func NewCloneToolsetConflict(body *CloneToolsetConflictResponseBody) *goa.ServiceError {
+func NewRevertToolsetConflict(body *RevertToolsetConflictResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5657,9 +7661,9 @@ func NewCloneToolsetConflict(body *CloneToolsetConflictResponseBody) *goa.Servic
 	return v
 }
 
-// NewCloneToolsetUnsupportedMedia builds a toolsets service cloneToolset
+// NewRevertToolsetUnsupportedMedia builds a toolsets service revertToolset
 // endpoint unsupported_media error.
-func NewCloneToolsetUnsupportedMedia(body *CloneToolsetUnsupportedMediaResponseBody) *goa.ServiceError {
+func NewRevertToolsetUnsupportedMedia(body *RevertToolsetUnsupportedMediaResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5672,9 +7676,9 @@ func NewCloneToolsetUnsupportedMedia(body *CloneToolsetUnsupportedMediaResponseB
 	return v
 }
 
-// NewCloneToolsetInvalid builds a toolsets service cloneToolset endpoint
+// NewRevertToolsetInvalid builds a toolsets service revertToolset endpoint
 // invalid error.
-func NewCloneToolsetInvalid(body *CloneToolsetInvalidResponseBody) *goa.ServiceError {
+func NewRevertToolsetInvalid(body *RevertToolsetInvalidResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5687,9 +7691,9 @@ func NewCloneToolsetInvalid(body *CloneToolsetInvalidResponseBody) *goa.ServiceE
 	return v
 }
 
-// NewCloneToolsetInvariantViolation builds a toolsets service cloneToolset
+// NewRevertToolsetInvariantViolation builds a toolsets service revertToolset
 // endpoint invariant_violation error.
-func NewCloneToolsetInvariantViolation(body *CloneToolsetInvariantViolationResponseBody) *goa.ServiceError {
+func NewRevertToolsetInvariantViolation(body *RevertToolsetInvariantViolationResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5702,9 +7706,9 @@ func NewCloneToolsetInvariantViolation(body *CloneToolsetInvariantViolationRespo
 	return v
 }
 
-// NewCloneToolsetUnexpected builds a toolsets service cloneToolset endpoint
+// NewRevertToolsetUnexpected builds a toolsets service revertToolset endpoint
 // unexpected error.
-func NewCloneToolsetUnexpected(body *CloneToolsetUnexpectedResponseBody) *goa.ServiceError {
+func NewRevertToolsetUnexpected(body *RevertToolsetUnexpectedResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5717,9 +7721,9 @@ func NewCloneToolsetUnexpected(body *CloneToolsetUnexpectedResponseBody) *goa.Se
 	return v
 }
 
-// NewCloneToolsetGatewayError builds a toolsets service cloneToolset endpoint
-// gateway_error error.
-func NewCloneToolsetGatewayError(body *CloneToolsetGatewayErrorResponseBody) *goa.ServiceError {
+// NewRevertToolsetGatewayError builds a toolsets service revertToolset
+// endpoint gateway_error error.
+func NewRevertToolsetGatewayError(body *RevertToolsetGatewayErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -5732,1073 +7736,697 @@ func NewCloneToolsetGatewayError(body *CloneToolsetGatewayErrorResponseBody) *go
 	return v
 }
 
-// NewAddExternalOAuthServerToolsetOK builds a "toolsets" service
-// "addExternalOAuthServer" endpoint result from a HTTP "OK" response.
-func NewAddExternalOAuthServerToolsetOK(body *AddExternalOAuthServerResponseBody) *types.Toolset {
-	v := &types.Toolset{
-		ID:                    *body.ID,
-		ProjectID:             *body.ProjectID,
-		OrganizationID:        *body.OrganizationID,
-		AccountType:           *body.AccountType,
-		Name:                  *body.Name,
-		Slug:                  types.Slug(*body.Slug),
-		Description:           body.Description,
-		ToolsetVersion:        *body.ToolsetVersion,
-		McpIsPublic:           body.McpIsPublic,
-		McpEnabled:            body.McpEnabled,
-		ToolSelectionMode:     *body.ToolSelectionMode,
-		CustomDomainID:        body.CustomDomainID,
-		UserSessionIssuerID:   body.UserSessionIssuerID,
-		ToolVariationsGroupID: body.ToolVariationsGroupID,
-		CreatedAt:             *body.CreatedAt,
-		UpdatedAt:             *body.UpdatedAt,
+// ValidateCreateToolsetResponseBody runs the validations defined on
+// CreateToolsetResponseBody
+func ValidateCreateToolsetResponseBody(body *CreateToolsetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.DefaultEnvironmentSlug != nil {
-		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
-		v.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+	if body.ProjectID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
 	}
-	if body.McpSlug != nil {
-		mcpSlug := types.Slug(*body.McpSlug)
-		v.McpSlug = &mcpSlug
+	if body.OrganizationID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
 	}
-	if body.UserSessionIssuerSlug != nil {
-		userSessionIssuerSlug := types.Slug(*body.UserSessionIssuerSlug)
-		v.UserSessionIssuerSlug = &userSessionIssuerSlug
+	if body.AccountType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account_type", "body"))
 	}
-	if body.SecurityVariables != nil {
-		v.SecurityVariables = make([]*types.SecurityVariable, len(body.SecurityVariables))
-		for i, val := range body.SecurityVariables {
-			if val == nil {
-				v.SecurityVariables[i] = nil
-				continue
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Slug == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+	}
+	if body.Tools == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tools", "body"))
+	}
+	if body.ToolSelectionMode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tool_selection_mode", "body"))
+	}
+	if body.ToolsetVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("toolset_version", "body"))
+	}
+	if body.PromptTemplates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("prompt_templates", "body"))
+	}
+	if body.ToolUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tool_urns", "body"))
+	}
+	if body.Resources == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "body"))
+	}
+	if body.ResourceUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resource_urns", "body"))
+	}
+	if body.OauthEnablementMetadata == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("oauth_enablement_metadata", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Slug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.Slug != nil {
+		if utf8.RuneCountInString(*body.Slug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 40, false))
+		}
+	}
+	if body.DefaultEnvironmentSlug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.default_environment_slug", *body.DefaultEnvironmentSlug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.DefaultEnvironmentSlug != nil {
+		if utf8.RuneCountInString(*body.DefaultEnvironmentSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.default_environment_slug", *body.DefaultEnvironmentSlug, utf8.RuneCountInString(*body.DefaultEnvironmentSlug), 40, false))
+		}
+	}
+	for _, e := range body.SecurityVariables {
+		if e != nil {
+			if err2 := ValidateSecurityVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.SecurityVariables[i] = unmarshalSecurityVariableResponseBodyToTypesSecurityVariable(val)
 		}
 	}
-	if body.ServerVariables != nil {
-		v.ServerVariables = make([]*types.ServerVariable, len(body.ServerVariables))
-		for i, val := range body.ServerVariables {
-			if val == nil {
-				v.ServerVariables[i] = nil
-				continue
+	for _, e := range body.ServerVariables {
+		if e != nil {
+			if err2 := ValidateServerVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.ServerVariables[i] = unmarshalServerVariableResponseBodyToTypesServerVariable(val)
 		}
 	}
-	if body.FunctionEnvironmentVariables != nil {
-		v.FunctionEnvironmentVariables = make([]*types.FunctionEnvironmentVariable, len(body.FunctionEnvironmentVariables))
-		for i, val := range body.FunctionEnvironmentVariables {
-			if val == nil {
-				v.FunctionEnvironmentVariables[i] = nil
-				continue
+	for _, e := range body.FunctionEnvironmentVariables {
+		if e != nil {
+			if err2 := ValidateFunctionEnvironmentVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.FunctionEnvironmentVariables[i] = unmarshalFunctionEnvironmentVariableResponseBodyToTypesFunctionEnvironmentVariable(val)
 		}
 	}
-	if body.ExternalMcpHeaderDefinitions != nil {
-		v.ExternalMcpHeaderDefinitions = make([]*types.ExternalMCPHeaderDefinition, len(body.ExternalMcpHeaderDefinitions))
-		for i, val := range body.ExternalMcpHeaderDefinitions {
-			if val == nil {
-				v.ExternalMcpHeaderDefinitions[i] = nil
-				continue
+	for _, e := range body.ExternalMcpHeaderDefinitions {
+		if e != nil {
+			if err2 := ValidateExternalMCPHeaderDefinitionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.ExternalMcpHeaderDefinitions[i] = unmarshalExternalMCPHeaderDefinitionResponseBodyToTypesExternalMCPHeaderDefinition(val)
 		}
 	}
-	v.OauthEnablementMetadata = unmarshalOAuthEnablementMetadataResponseBodyToTypesOAuthEnablementMetadata(body.OauthEnablementMetadata)
-	v.Tools = make([]*types.Tool, len(body.Tools))
-	for i, val := range body.Tools {
-		if val == nil {
-			v.Tools[i] = nil
-			continue
+	if body.OauthEnablementMetadata != nil {
+		if err2 := ValidateOAuthEnablementMetadataResponseBody(body.OauthEnablementMetadata); err2 != nil {
+			err = goa.MergeErrors(err, err2)
 		}
-		v.Tools[i] = unmarshalToolResponseBodyToTypesTool(val)
 	}
-	v.ToolUrns = make([]string, len(body.ToolUrns))
-	for i, val := range body.ToolUrns {
-		v.ToolUrns[i] = val
+	for _, e := range body.Tools {
+		if e != nil {
+			if err2 := ValidateToolResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-	v.Resources = make([]*types.Resource, len(body.Resources))
-	for i, val := range body.Resources {
-		if val == nil {
-			v.Resources[i] = nil
-			continue
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateResourceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.PromptTemplates {
+		if e != nil {
+			if err2 := ValidatePromptTemplateResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
 		}
-		v.Resources[i] = unmarshalResourceResponseBodyToTypesResource(val)
 	}
-	v.ResourceUrns = make([]string, len(body.ResourceUrns))
-	for i, val := range body.ResourceUrns {
-		v.ResourceUrns[i] = val
+	if body.McpSlug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9_-]{1,128}$"))
 	}
-	v.PromptTemplates = make([]*types.PromptTemplate, len(body.PromptTemplates))
-	for i, val := range body.PromptTemplates {
-		if val == nil {
-			v.PromptTemplates[i] = nil
-			continue
+	if body.McpSlug != nil {
+		if utf8.RuneCountInString(*body.McpSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
 		}
-		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
 	}
 	if body.Origin != nil {
-		v.Origin = unmarshalToolsetOriginResponseBodyToTypesToolsetOrigin(body.Origin)
+		if err2 := ValidateToolsetOriginResponseBody(body.Origin); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
 	}
 	if body.ExternalOauthServer != nil {
-		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
+		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
 	}
-
-	return v
-}
-
-// NewAddExternalOAuthServerUnauthorized builds a toolsets service
-// addExternalOAuthServer endpoint unauthorized error.
-func NewAddExternalOAuthServerUnauthorized(body *AddExternalOAuthServerUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.UserSessionIssuerSlug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, "^[a-z0-9_-]{1,128}$"))
 	}
-
-	return v
-}
-
-// NewAddExternalOAuthServerForbidden builds a toolsets service
-// addExternalOAuthServer endpoint forbidden error.
-func NewAddExternalOAuthServerForbidden(body *AddExternalOAuthServerForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.UserSessionIssuerSlug != nil {
+		if utf8.RuneCountInString(*body.UserSessionIssuerSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, utf8.RuneCountInString(*body.UserSessionIssuerSlug), 40, false))
+		}
 	}
-
-	return v
-}
-
-// NewAddExternalOAuthServerBadRequest builds a toolsets service
-// addExternalOAuthServer endpoint bad_request error.
-func NewAddExternalOAuthServerBadRequest(body *AddExternalOAuthServerBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
 	}
-
-	return v
-}
-
-// NewAddExternalOAuthServerNotFound builds a toolsets service
-// addExternalOAuthServer endpoint not_found error.
-func NewAddExternalOAuthServerNotFound(body *AddExternalOAuthServerNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
 	}
-
-	return v
+	return
 }
 
-// NewAddExternalOAuthServerConflict builds a toolsets service
-// addExternalOAuthServer endpoint conflict error.
-func NewAddExternalOAuthServerConflict(body *AddExternalOAuthServerConflictResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListToolsetsResponseBody runs the validations defined on
+// ListToolsetsResponseBody
+func ValidateListToolsetsResponseBody(body *ListToolsetsResponseBody) (err error) {
+	if body.Toolsets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("toolsets", "body"))
 	}
-
-	return v
-}
-
-// NewAddExternalOAuthServerUnsupportedMedia builds a toolsets service
-// addExternalOAuthServer endpoint unsupported_media error.
-func NewAddExternalOAuthServerUnsupportedMedia(body *AddExternalOAuthServerUnsupportedMediaResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	for _, e := range body.Toolsets {
+		if e != nil {
+			if err2 := ValidateToolsetEntryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-
-	return v
+	return
 }
 
-// NewAddExternalOAuthServerInvalid builds a toolsets service
-// addExternalOAuthServer endpoint invalid error.
-func NewAddExternalOAuthServerInvalid(body *AddExternalOAuthServerInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListToolsetsForOrgResponseBody runs the validations defined on
+// ListToolsetsForOrgResponseBody
+func ValidateListToolsetsForOrgResponseBody(body *ListToolsetsForOrgResponseBody) (err error) {
+	if body.Toolsets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("toolsets", "body"))
 	}
-
-	return v
-}
-
-// NewAddExternalOAuthServerInvariantViolation builds a toolsets service
-// addExternalOAuthServer endpoint invariant_violation error.
-func NewAddExternalOAuthServerInvariantViolation(body *AddExternalOAuthServerInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	for _, e := range body.Toolsets {
+		if e != nil {
+			if err2 := ValidateToolsetSummaryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-
-	return v
+	return
 }
 
-// NewAddExternalOAuthServerUnexpected builds a toolsets service
-// addExternalOAuthServer endpoint unexpected error.
-func NewAddExternalOAuthServerUnexpected(body *AddExternalOAuthServerUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateToolsetResponseBody runs the validations defined on
+// UpdateToolsetResponseBody
+func ValidateUpdateToolsetResponseBody(body *UpdateToolsetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-
-	return v
-}
-
-// NewAddExternalOAuthServerGatewayError builds a toolsets service
-// addExternalOAuthServer endpoint gateway_error error.
-func NewAddExternalOAuthServerGatewayError(body *AddExternalOAuthServerGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.ProjectID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
 	}
-
-	return v
-}
-
-// NewRemoveOAuthServerToolsetOK builds a "toolsets" service
-// "removeOAuthServer" endpoint result from a HTTP "OK" response.
-func NewRemoveOAuthServerToolsetOK(body *RemoveOAuthServerResponseBody) *types.Toolset {
-	v := &types.Toolset{
-		ID:                    *body.ID,
-		ProjectID:             *body.ProjectID,
-		OrganizationID:        *body.OrganizationID,
-		AccountType:           *body.AccountType,
-		Name:                  *body.Name,
-		Slug:                  types.Slug(*body.Slug),
-		Description:           body.Description,
-		ToolsetVersion:        *body.ToolsetVersion,
-		McpIsPublic:           body.McpIsPublic,
-		McpEnabled:            body.McpEnabled,
-		ToolSelectionMode:     *body.ToolSelectionMode,
-		CustomDomainID:        body.CustomDomainID,
-		UserSessionIssuerID:   body.UserSessionIssuerID,
-		ToolVariationsGroupID: body.ToolVariationsGroupID,
-		CreatedAt:             *body.CreatedAt,
-		UpdatedAt:             *body.UpdatedAt,
+	if body.OrganizationID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	}
+	if body.AccountType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account_type", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Slug == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+	}
+	if body.Tools == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tools", "body"))
+	}
+	if body.ToolSelectionMode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tool_selection_mode", "body"))
+	}
+	if body.ToolsetVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("toolset_version", "body"))
+	}
+	if body.PromptTemplates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("prompt_templates", "body"))
+	}
+	if body.ToolUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tool_urns", "body"))
+	}
+	if body.Resources == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "body"))
+	}
+	if body.ResourceUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resource_urns", "body"))
 	}
-	if body.DefaultEnvironmentSlug != nil {
-		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
-		v.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+	if body.OauthEnablementMetadata == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("oauth_enablement_metadata", "body"))
 	}
-	if body.McpSlug != nil {
-		mcpSlug := types.Slug(*body.McpSlug)
-		v.McpSlug = &mcpSlug
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
 	}
-	if body.UserSessionIssuerSlug != nil {
-		userSessionIssuerSlug := types.Slug(*body.UserSessionIssuerSlug)
-		v.UserSessionIssuerSlug = &userSessionIssuerSlug
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
 	}
-	if body.SecurityVariables != nil {
-		v.SecurityVariables = make([]*types.SecurityVariable, len(body.SecurityVariables))
-		for i, val := range body.SecurityVariables {
-			if val == nil {
-				v.SecurityVariables[i] = nil
-				continue
+	if body.Slug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.Slug != nil {
+		if utf8.RuneCountInString(*body.Slug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 40, false))
+		}
+	}
+	if body.DefaultEnvironmentSlug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.default_environment_slug", *body.DefaultEnvironmentSlug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.DefaultEnvironmentSlug != nil {
+		if utf8.RuneCountInString(*body.DefaultEnvironmentSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.default_environment_slug", *body.DefaultEnvironmentSlug, utf8.RuneCountInString(*body.DefaultEnvironmentSlug), 40, false))
+		}
+	}
+	for _, e := range body.SecurityVariables {
+		if e != nil {
+			if err2 := ValidateSecurityVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.SecurityVariables[i] = unmarshalSecurityVariableResponseBodyToTypesSecurityVariable(val)
 		}
 	}
-	if body.ServerVariables != nil {
-		v.ServerVariables = make([]*types.ServerVariable, len(body.ServerVariables))
-		for i, val := range body.ServerVariables {
-			if val == nil {
-				v.ServerVariables[i] = nil
-				continue
+	for _, e := range body.ServerVariables {
+		if e != nil {
+			if err2 := ValidateServerVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.ServerVariables[i] = unmarshalServerVariableResponseBodyToTypesServerVariable(val)
 		}
 	}
-	if body.FunctionEnvironmentVariables != nil {
-		v.FunctionEnvironmentVariables = make([]*types.FunctionEnvironmentVariable, len(body.FunctionEnvironmentVariables))
-		for i, val := range body.FunctionEnvironmentVariables {
-			if val == nil {
-				v.FunctionEnvironmentVariables[i] = nil
-				continue
+	for _, e := range body.FunctionEnvironmentVariables {
+		if e != nil {
+			if err2 := ValidateFunctionEnvironmentVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.FunctionEnvironmentVariables[i] = unmarshalFunctionEnvironmentVariableResponseBodyToTypesFunctionEnvironmentVariable(val)
 		}
 	}
-	if body.ExternalMcpHeaderDefinitions != nil {
-		v.ExternalMcpHeaderDefinitions = make([]*types.ExternalMCPHeaderDefinition, len(body.ExternalMcpHeaderDefinitions))
-		for i, val := range body.ExternalMcpHeaderDefinitions {
-			if val == nil {
-				v.ExternalMcpHeaderDefinitions[i] = nil
-				continue
+	for _, e := range body.ExternalMcpHeaderDefinitions {
+		if e != nil {
+			if err2 := ValidateExternalMCPHeaderDefinitionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.ExternalMcpHeaderDefinitions[i] = unmarshalExternalMCPHeaderDefinitionResponseBodyToTypesExternalMCPHeaderDefinition(val)
 		}
 	}
-	v.OauthEnablementMetadata = unmarshalOAuthEnablementMetadataResponseBodyToTypesOAuthEnablementMetadata(body.OauthEnablementMetadata)
-	v.Tools = make([]*types.Tool, len(body.Tools))
-	for i, val := range body.Tools {
-		if val == nil {
-			v.Tools[i] = nil
-			continue
+	if body.OauthEnablementMetadata != nil {
+		if err2 := ValidateOAuthEnablementMetadataResponseBody(body.OauthEnablementMetadata); err2 != nil {
+			err = goa.MergeErrors(err, err2)
 		}
-		v.Tools[i] = unmarshalToolResponseBodyToTypesTool(val)
 	}
-	v.ToolUrns = make([]string, len(body.ToolUrns))
-	for i, val := range body.ToolUrns {
-		v.ToolUrns[i] = val
+	for _, e := range body.Tools {
+		if e != nil {
+			if err2 := ValidateToolResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-	v.Resources = make([]*types.Resource, len(body.Resources))
-	for i, val := range body.Resources {
-		if val == nil {
-			v.Resources[i] = nil
-			continue
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateResourceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
 		}
-		v.Resources[i] = unmarshalResourceResponseBodyToTypesResource(val)
 	}
-	v.ResourceUrns = make([]string, len(body.ResourceUrns))
-	for i, val := range body.ResourceUrns {
-		v.ResourceUrns[i] = val
+	for _, e := range body.PromptTemplates {
+		if e != nil {
+			if err2 := ValidatePromptTemplateResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-	v.PromptTemplates = make([]*types.PromptTemplate, len(body.PromptTemplates))
-	for i, val := range body.PromptTemplates {
-		if val == nil {
-			v.PromptTemplates[i] = nil
-			continue
+	if body.McpSlug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.McpSlug != nil {
+		if utf8.RuneCountInString(*body.McpSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
 		}
-		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
 	}
 	if body.Origin != nil {
-		v.Origin = unmarshalToolsetOriginResponseBodyToTypesToolsetOrigin(body.Origin)
+		if err2 := ValidateToolsetOriginResponseBody(body.Origin); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
 	}
 	if body.ExternalOauthServer != nil {
-		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
+		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
 	}
-
-	return v
-}
-
-// NewRemoveOAuthServerUnauthorized builds a toolsets service removeOAuthServer
-// endpoint unauthorized error.
-func NewRemoveOAuthServerUnauthorized(body *RemoveOAuthServerUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.UserSessionIssuerSlug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, "^[a-z0-9_-]{1,128}$"))
 	}
-
-	return v
-}
-
-// NewRemoveOAuthServerForbidden builds a toolsets service removeOAuthServer
-// endpoint forbidden error.
-func NewRemoveOAuthServerForbidden(body *RemoveOAuthServerForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.UserSessionIssuerSlug != nil {
+		if utf8.RuneCountInString(*body.UserSessionIssuerSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, utf8.RuneCountInString(*body.UserSessionIssuerSlug), 40, false))
+		}
 	}
-
-	return v
-}
-
-// NewRemoveOAuthServerBadRequest builds a toolsets service removeOAuthServer
-// endpoint bad_request error.
-func NewRemoveOAuthServerBadRequest(body *RemoveOAuthServerBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
 	}
-
-	return v
-}
-
-// NewRemoveOAuthServerNotFound builds a toolsets service removeOAuthServer
-// endpoint not_found error.
-func NewRemoveOAuthServerNotFound(body *RemoveOAuthServerNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
 	}
-
-	return v
+	return
 }
 
-// NewRemoveOAuthServerConflict builds a toolsets service removeOAuthServer
-// endpoint conflict error.
-func NewRemoveOAuthServerConflict(body *RemoveOAuthServerConflictResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetToolsetResponseBody runs the validations defined on
+// GetToolsetResponseBody
+func ValidateGetToolsetResponseBody(body *GetToolsetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-
-	return v
-}
-
-// NewRemoveOAuthServerUnsupportedMedia builds a toolsets service
-// removeOAuthServer endpoint unsupported_media error.
-func NewRemoveOAuthServerUnsupportedMedia(body *RemoveOAuthServerUnsupportedMediaResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.ProjectID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
+	}
+	if body.OrganizationID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	}
+	if body.AccountType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account_type", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Slug == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+	}
+	if body.Tools == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tools", "body"))
+	}
+	if body.ToolSelectionMode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tool_selection_mode", "body"))
+	}
+	if body.ToolsetVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("toolset_version", "body"))
+	}
+	if body.PromptTemplates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("prompt_templates", "body"))
 	}
-
-	return v
-}
-
-// NewRemoveOAuthServerInvalid builds a toolsets service removeOAuthServer
-// endpoint invalid error.
-func NewRemoveOAuthServerInvalid(body *RemoveOAuthServerInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.ToolUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tool_urns", "body"))
 	}
-
-	return v
-}
-
-// NewRemoveOAuthServerInvariantViolation builds a toolsets service
-// removeOAuthServer endpoint invariant_violation error.
-func NewRemoveOAuthServerInvariantViolation(body *RemoveOAuthServerInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.Resources == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "body"))
 	}
-
-	return v
-}
-
-// NewRemoveOAuthServerUnexpected builds a toolsets service removeOAuthServer
-// endpoint unexpected error.
-func NewRemoveOAuthServerUnexpected(body *RemoveOAuthServerUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.ResourceUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resource_urns", "body"))
 	}
-
-	return v
-}
-
-// NewRemoveOAuthServerGatewayError builds a toolsets service removeOAuthServer
-// endpoint gateway_error error.
-func NewRemoveOAuthServerGatewayError(body *RemoveOAuthServerGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.OauthEnablementMetadata == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("oauth_enablement_metadata", "body"))
 	}
-
-	return v
-}
-
-// NewSetUserSessionIssuerToolsetOK builds a "toolsets" service
-// "setUserSessionIssuer" endpoint result from a HTTP "OK" response.
-func NewSetUserSessionIssuerToolsetOK(body *SetUserSessionIssuerResponseBody) *types.Toolset {
-	v := &types.Toolset{
-		ID:                    *body.ID,
-		ProjectID:             *body.ProjectID,
-		OrganizationID:        *body.OrganizationID,
-		AccountType:           *body.AccountType,
-		Name:                  *body.Name,
-		Slug:                  types.Slug(*body.Slug),
-		Description:           body.Description,
-		ToolsetVersion:        *body.ToolsetVersion,
-		McpIsPublic:           body.McpIsPublic,
-		McpEnabled:            body.McpEnabled,
-		ToolSelectionMode:     *body.ToolSelectionMode,
-		CustomDomainID:        body.CustomDomainID,
-		UserSessionIssuerID:   body.UserSessionIssuerID,
-		ToolVariationsGroupID: body.ToolVariationsGroupID,
-		CreatedAt:             *body.CreatedAt,
-		UpdatedAt:             *body.UpdatedAt,
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Slug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.Slug != nil {
+		if utf8.RuneCountInString(*body.Slug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 40, false))
+		}
 	}
 	if body.DefaultEnvironmentSlug != nil {
-		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
-		v.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.default_environment_slug", *body.DefaultEnvironmentSlug, "^[a-z0-9_-]{1,128}$"))
 	}
-	if body.McpSlug != nil {
-		mcpSlug := types.Slug(*body.McpSlug)
-		v.McpSlug = &mcpSlug
+	if body.DefaultEnvironmentSlug != nil {
+		if utf8.RuneCountInString(*body.DefaultEnvironmentSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.default_environment_slug", *body.DefaultEnvironmentSlug, utf8.RuneCountInString(*body.DefaultEnvironmentSlug), 40, false))
+		}
 	}
-	if body.UserSessionIssuerSlug != nil {
-		userSessionIssuerSlug := types.Slug(*body.UserSessionIssuerSlug)
-		v.UserSessionIssuerSlug = &userSessionIssuerSlug
+	for _, e := range body.SecurityVariables {
+		if e != nil {
+			if err2 := ValidateSecurityVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-	if body.SecurityVariables != nil {
-		v.SecurityVariables = make([]*types.SecurityVariable, len(body.SecurityVariables))
-		for i, val := range body.SecurityVariables {
-			if val == nil {
-				v.SecurityVariables[i] = nil
-				continue
+	for _, e := range body.ServerVariables {
+		if e != nil {
+			if err2 := ValidateServerVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.SecurityVariables[i] = unmarshalSecurityVariableResponseBodyToTypesSecurityVariable(val)
 		}
 	}
-	if body.ServerVariables != nil {
-		v.ServerVariables = make([]*types.ServerVariable, len(body.ServerVariables))
-		for i, val := range body.ServerVariables {
-			if val == nil {
-				v.ServerVariables[i] = nil
-				continue
+	for _, e := range body.FunctionEnvironmentVariables {
+		if e != nil {
+			if err2 := ValidateFunctionEnvironmentVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.ServerVariables[i] = unmarshalServerVariableResponseBodyToTypesServerVariable(val)
 		}
 	}
-	if body.FunctionEnvironmentVariables != nil {
-		v.FunctionEnvironmentVariables = make([]*types.FunctionEnvironmentVariable, len(body.FunctionEnvironmentVariables))
-		for i, val := range body.FunctionEnvironmentVariables {
-			if val == nil {
-				v.FunctionEnvironmentVariables[i] = nil
-				continue
+	for _, e := range body.ExternalMcpHeaderDefinitions {
+		if e != nil {
+			if err2 := ValidateExternalMCPHeaderDefinitionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.FunctionEnvironmentVariables[i] = unmarshalFunctionEnvironmentVariableResponseBodyToTypesFunctionEnvironmentVariable(val)
 		}
 	}
-	if body.ExternalMcpHeaderDefinitions != nil {
-		v.ExternalMcpHeaderDefinitions = make([]*types.ExternalMCPHeaderDefinition, len(body.ExternalMcpHeaderDefinitions))
-		for i, val := range body.ExternalMcpHeaderDefinitions {
-			if val == nil {
-				v.ExternalMcpHeaderDefinitions[i] = nil
-				continue
+	if body.OauthEnablementMetadata != nil {
+		if err2 := ValidateOAuthEnablementMetadataResponseBody(body.OauthEnablementMetadata); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Tools {
+		if e != nil {
+			if err2 := ValidateToolResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.ExternalMcpHeaderDefinitions[i] = unmarshalExternalMCPHeaderDefinitionResponseBodyToTypesExternalMCPHeaderDefinition(val)
 		}
 	}
-	v.OauthEnablementMetadata = unmarshalOAuthEnablementMetadataResponseBodyToTypesOAuthEnablementMetadata(body.OauthEnablementMetadata)
-	v.Tools = make([]*types.Tool, len(body.Tools))
-	for i, val := range body.Tools {
-		if val == nil {
-			v.Tools[i] = nil
-			continue
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateResourceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
 		}
-		v.Tools[i] = unmarshalToolResponseBodyToTypesTool(val)
 	}
-	v.ToolUrns = make([]string, len(body.ToolUrns))
-	for i, val := range body.ToolUrns {
-		v.ToolUrns[i] = val
+	for _, e := range body.PromptTemplates {
+		if e != nil {
+			if err2 := ValidatePromptTemplateResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.McpSlug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.McpSlug != nil {
+		if utf8.RuneCountInString(*body.McpSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
+		}
+	}
+	if body.Origin != nil {
+		if err2 := ValidateToolsetOriginResponseBody(body.Origin); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ExternalOauthServer != nil {
+		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.UserSessionIssuerSlug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.UserSessionIssuerSlug != nil {
+		if utf8.RuneCountInString(*body.UserSessionIssuerSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, utf8.RuneCountInString(*body.UserSessionIssuerSlug), 40, false))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListToolFiltersResponseBody runs the validations defined on
+// ListToolFiltersResponseBody
+func ValidateListToolFiltersResponseBody(body *ListToolFiltersResponseBody) (err error) {
+	if body.FilteringEnabled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("filtering_enabled", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	if body.Excluded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("excluded", "body"))
+	}
+	if body.ToolVariationsGroupID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.tool_variations_group_id", *body.ToolVariationsGroupID, goa.FormatUUID))
+	}
+	for _, e := range body.Scopes {
+		if e != nil {
+			if err2 := ValidateToolFilterScopeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Excluded {
+		if e != nil {
+			if err2 := ValidateToolFilterToolResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCloneToolsetResponseBody runs the validations defined on
+// CloneToolsetResponseBody
+func ValidateCloneToolsetResponseBody(body *CloneToolsetResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ProjectID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
 	}
-	v.Resources = make([]*types.Resource, len(body.Resources))
-	for i, val := range body.Resources {
-		if val == nil {
-			v.Resources[i] = nil
-			continue
-		}
-		v.Resources[i] = unmarshalResourceResponseBodyToTypesResource(val)
+	if body.OrganizationID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
 	}
-	v.ResourceUrns = make([]string, len(body.ResourceUrns))
-	for i, val := range body.ResourceUrns {
-		v.ResourceUrns[i] = val
+	if body.AccountType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("account_type", "body"))
 	}
-	v.PromptTemplates = make([]*types.PromptTemplate, len(body.PromptTemplates))
-	for i, val := range body.PromptTemplates {
-		if val == nil {
-			v.PromptTemplates[i] = nil
-			continue
-		}
-		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.Origin != nil {
-		v.Origin = unmarshalToolsetOriginResponseBodyToTypesToolsetOrigin(body.Origin)
+	if body.Slug == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
 	}
-	if body.ExternalOauthServer != nil {
-		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
+	if body.Tools == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tools", "body"))
 	}
-
-	return v
-}
-
-// NewSetUserSessionIssuerUnauthorized builds a toolsets service
-// setUserSessionIssuer endpoint unauthorized error.
-func NewSetUserSessionIssuerUnauthorized(body *SetUserSessionIssuerUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.ToolSelectionMode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tool_selection_mode", "body"))
 	}
-
-	return v
-}
-
-// NewSetUserSessionIssuerForbidden builds a toolsets service
-// setUserSessionIssuer endpoint forbidden error.
-func NewSetUserSessionIssuerForbidden(body *SetUserSessionIssuerForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.ToolsetVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("toolset_version", "body"))
 	}
-
-	return v
-}
-
-// NewSetUserSessionIssuerBadRequest builds a toolsets service
-// setUserSessionIssuer endpoint bad_request error.
-func NewSetUserSessionIssuerBadRequest(body *SetUserSessionIssuerBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.PromptTemplates == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("prompt_templates", "body"))
 	}
-
-	return v
-}
-
-// NewSetUserSessionIssuerNotFound builds a toolsets service
-// setUserSessionIssuer endpoint not_found error.
-func NewSetUserSessionIssuerNotFound(body *SetUserSessionIssuerNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.ToolUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tool_urns", "body"))
 	}
-
-	return v
-}
-
-// NewSetUserSessionIssuerConflict builds a toolsets service
-// setUserSessionIssuer endpoint conflict error.
-func NewSetUserSessionIssuerConflict(body *SetUserSessionIssuerConflictResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.Resources == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "body"))
 	}
-
-	return v
-}
-
-// NewSetUserSessionIssuerUnsupportedMedia builds a toolsets service
-// setUserSessionIssuer endpoint unsupported_media error.
-func NewSetUserSessionIssuerUnsupportedMedia(body *SetUserSessionIssuerUnsupportedMediaResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.ResourceUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resource_urns", "body"))
 	}
-
-	return v
-}
-
-// NewSetUserSessionIssuerInvalid builds a toolsets service
-// setUserSessionIssuer endpoint invalid error.
-func NewSetUserSessionIssuerInvalid(body *SetUserSessionIssuerInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.OauthEnablementMetadata == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("oauth_enablement_metadata", "body"))
 	}
-
-	return v
-}
-
-// NewSetUserSessionIssuerInvariantViolation builds a toolsets service
-// setUserSessionIssuer endpoint invariant_violation error.
-func NewSetUserSessionIssuerInvariantViolation(body *SetUserSessionIssuerInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
 	}
-
-	return v
-}
-
-// NewSetUserSessionIssuerUnexpected builds a toolsets service
-// setUserSessionIssuer endpoint unexpected error.
-func NewSetUserSessionIssuerUnexpected(body *SetUserSessionIssuerUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
 	}
-
-	return v
-}
-
-// NewSetUserSessionIssuerGatewayError builds a toolsets service
-// setUserSessionIssuer endpoint gateway_error error.
-func NewSetUserSessionIssuerGatewayError(body *SetUserSessionIssuerGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.Slug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
 	}
-
-	return v
-}
-
-// NewSetToolVariationsGroupToolsetOK builds a "toolsets" service
-// "setToolVariationsGroup" endpoint result from a HTTP "OK" response.
-func NewSetToolVariationsGroupToolsetOK(body *SetToolVariationsGroupResponseBody) *types.Toolset {
-	v := &types.Toolset{
-		ID:                    *body.ID,
-		ProjectID:             *body.ProjectID,
-		OrganizationID:        *body.OrganizationID,
-		AccountType:           *body.AccountType,
-		Name:                  *body.Name,
-		Slug:                  types.Slug(*body.Slug),
-		Description:           body.Description,
-		ToolsetVersion:        *body.ToolsetVersion,
-		McpIsPublic:           body.McpIsPublic,
-		McpEnabled:            body.McpEnabled,
-		ToolSelectionMode:     *body.ToolSelectionMode,
-		CustomDomainID:        body.CustomDomainID,
-		UserSessionIssuerID:   body.UserSessionIssuerID,
-		ToolVariationsGroupID: body.ToolVariationsGroupID,
-		CreatedAt:             *body.CreatedAt,
-		UpdatedAt:             *body.UpdatedAt,
+	if body.Slug != nil {
+		if utf8.RuneCountInString(*body.Slug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 40, false))
+		}
 	}
 	if body.DefaultEnvironmentSlug != nil {
-		defaultEnvironmentSlug := types.Slug(*body.DefaultEnvironmentSlug)
-		v.DefaultEnvironmentSlug = &defaultEnvironmentSlug
-	}
-	if body.McpSlug != nil {
-		mcpSlug := types.Slug(*body.McpSlug)
-		v.McpSlug = &mcpSlug
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.default_environment_slug", *body.DefaultEnvironmentSlug, "^[a-z0-9_-]{1,128}$"))
 	}
-	if body.UserSessionIssuerSlug != nil {
-		userSessionIssuerSlug := types.Slug(*body.UserSessionIssuerSlug)
-		v.UserSessionIssuerSlug = &userSessionIssuerSlug
+	if body.DefaultEnvironmentSlug != nil {
+		if utf8.RuneCountInString(*body.DefaultEnvironmentSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.default_environment_slug", *body.DefaultEnvironmentSlug, utf8.RuneCountInString(*body.DefaultEnvironmentSlug), 40, false))
+		}
 	}
-	if body.SecurityVariables != nil {
-		v.SecurityVariables = make([]*types.SecurityVariable, len(body.SecurityVariables))
-		for i, val := range body.SecurityVariables {
-			if val == nil {
-				v.SecurityVariables[i] = nil
-				continue
+	for _, e := range body.SecurityVariables {
+		if e != nil {
+			if err2 := ValidateSecurityVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.SecurityVariables[i] = unmarshalSecurityVariableResponseBodyToTypesSecurityVariable(val)
 		}
 	}
-	if body.ServerVariables != nil {
-		v.ServerVariables = make([]*types.ServerVariable, len(body.ServerVariables))
-		for i, val := range body.ServerVariables {
-			if val == nil {
-				v.ServerVariables[i] = nil
-				continue
+	for _, e := range body.ServerVariables {
+		if e != nil {
+			if err2 := ValidateServerVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.ServerVariables[i] = unmarshalServerVariableResponseBodyToTypesServerVariable(val)
 		}
 	}
-	if body.FunctionEnvironmentVariables != nil {
-		v.FunctionEnvironmentVariables = make([]*types.FunctionEnvironmentVariable, len(body.FunctionEnvironmentVariables))
-		for i, val := range body.FunctionEnvironmentVariables {
-			if val == nil {
-				v.FunctionEnvironmentVariables[i] = nil
-				continue
+	for _, e := range body.FunctionEnvironmentVariables {
+		if e != nil {
+			if err2 := ValidateFunctionEnvironmentVariableResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.FunctionEnvironmentVariables[i] = unmarshalFunctionEnvironmentVariableResponseBodyToTypesFunctionEnvironmentVariable(val)
 		}
 	}
-	if body.ExternalMcpHeaderDefinitions != nil {
-		v.ExternalMcpHeaderDefinitions = make([]*types.ExternalMCPHeaderDefinition, len(body.ExternalMcpHeaderDefinitions))
-		for i, val := range body.ExternalMcpHeaderDefinitions {
-			if val == nil {
-				v.ExternalMcpHeaderDefinitions[i] = nil
-				continue
+	for _, e := range body.ExternalMcpHeaderDefinitions {
+		if e != nil {
+			if err2 := ValidateExternalMCPHeaderDefinitionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
 			}
-			v.ExternalMcpHeaderDefinitions[i] = unmarshalExternalMCPHeaderDefinitionResponseBodyToTypesExternalMCPHeaderDefinition(val)
 		}
 	}
-	v.OauthEnablementMetadata = unmarshalOAuthEnablementMetadataResponseBodyToTypesOAuthEnablementMetadata(body.OauthEnablementMetadata)
-	v.Tools = make([]*types.Tool, len(body.Tools))
-	for i, val := range body.Tools {
-		if val == nil {
-			v.Tools[i] = nil
-			continue
+	if body.OauthEnablementMetadata != nil {
+		if err2 := ValidateOAuthEnablementMetadataResponseBody(body.OauthEnablementMetadata); err2 != nil {
+			err = goa.MergeErrors(err, err2)
 		}
-		v.Tools[i] = unmarshalToolResponseBodyToTypesTool(val)
 	}
-	v.ToolUrns = make([]string, len(body.ToolUrns))
-	for i, val := range body.ToolUrns {
-		v.ToolUrns[i] = val
+	for _, e := range body.Tools {
+		if e != nil {
+			if err2 := ValidateToolResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-	v.Resources = make([]*types.Resource, len(body.Resources))
-	for i, val := range body.Resources {
-		if val == nil {
-			v.Resources[i] = nil
-			continue
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateResourceResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
 		}
-		v.Resources[i] = unmarshalResourceResponseBodyToTypesResource(val)
 	}
-	v.ResourceUrns = make([]string, len(body.ResourceUrns))
-	for i, val := range body.ResourceUrns {
-		v.ResourceUrns[i] = val
+	for _, e := range body.PromptTemplates {
+		if e != nil {
+			if err2 := ValidatePromptTemplateResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
-	v.PromptTemplates = make([]*types.PromptTemplate, len(body.PromptTemplates))
-	for i, val := range body.PromptTemplates {
-		if val == nil {
-			v.PromptTemplates[i] = nil
-			continue
+	if body.McpSlug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9_-]{1,128}$"))
+	}
+	if body.McpSlug != nil {
+		if utf8.RuneCountInString(*body.McpSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
 		}
-		v.PromptTemplates[i] = unmarshalPromptTemplateResponseBodyToTypesPromptTemplate(val)
 	}
 	if body.Origin != nil {
-		v.Origin = unmarshalToolsetOriginResponseBodyToTypesToolsetOrigin(body.Origin)
+		if err2 := ValidateToolsetOriginResponseBody(body.Origin); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
 	}
 	if body.ExternalOauthServer != nil {
-		v.ExternalOauthServer = unmarshalExternalOAuthServerResponseBodyToTypesExternalOAuthServer(body.ExternalOauthServer)
-	}
-
-	return v
-}
-
-// NewSetToolVariationsGroupUnauthorized builds a toolsets service
-// setToolVariationsGroup endpoint unauthorized error.
-func NewSetToolVariationsGroupUnauthorized(body *SetToolVariationsGroupUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
-}
-
-// NewSetToolVariationsGroupForbidden builds a toolsets service
-// setToolVariationsGroup endpoint forbidden error.
-func NewSetToolVariationsGroupForbidden(body *SetToolVariationsGroupForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
-}
-
-// NewSetToolVariationsGroupBadRequest builds a toolsets service
-// setToolVariationsGroup endpoint bad_request error.
-func NewSetToolVariationsGroupBadRequest(body *SetToolVariationsGroupBadRequestResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
-}
-
-// NewSetToolVariationsGroupNotFound builds a toolsets service
-// setToolVariationsGroup endpoint not_found error.
-func NewSetToolVariationsGroupNotFound(body *SetToolVariationsGroupNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
-}
-
-// NewSetToolVariationsGroupConflict builds a toolsets service
-// setToolVariationsGroup endpoint conflict error.
-func NewSetToolVariationsGroupConflict(body *SetToolVariationsGroupConflictResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
-	}
-
-	return v
-}
-
-// NewSetToolVariationsGroupUnsupportedMedia builds a toolsets service
-// setToolVariationsGroup endpoint unsupported_media error.
-func NewSetToolVariationsGroupUnsupportedMedia(body *SetToolVariationsGroupUnsupportedMediaResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
 	}
-
-	return v
-}
-
-// NewSetToolVariationsGroupInvalid builds a toolsets service
-// setToolVariationsGroup endpoint invalid error.
-func NewSetToolVariationsGroupInvalid(body *SetToolVariationsGroupInvalidResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.UserSessionIssuerSlug != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, "^[a-z0-9_-]{1,128}$"))
 	}
-
-	return v
-}
-
-// NewSetToolVariationsGroupInvariantViolation builds a toolsets service
-// setToolVariationsGroup endpoint invariant_violation error.
-func NewSetToolVariationsGroupInvariantViolation(body *SetToolVariationsGroupInvariantViolationResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.UserSessionIssuerSlug != nil {
+		if utf8.RuneCountInString(*body.UserSessionIssuerSlug) > 40 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, utf8.RuneCountInString(*body.UserSessionIssuerSlug), 40, false))
+		}
 	}
-
-	return v
-}
-
-// NewSetToolVariationsGroupUnexpected builds a toolsets service
-// setToolVariationsGroup endpoint unexpected error.
-func NewSetToolVariationsGroupUnexpected(body *SetToolVariationsGroupUnexpectedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
 	}
-
-	return v
-}
-
-// NewSetToolVariationsGroupGatewayError builds a toolsets service
-// setToolVariationsGroup endpoint gateway_error error.
-func NewSetToolVariationsGroupGatewayError(body *SetToolVariationsGroupGatewayErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
 	}
-
-	return v
+	return
 }
 
-// ValidateCreateToolsetResponseBody runs the validations defined on
-// CreateToolsetResponseBody
-func ValidateCreateToolsetResponseBody(body *CreateToolsetResponseBody) (err error) {
+// ValidateAddExternalOAuthServerResponseBody runs the validations defined on
+// AddExternalOAuthServerResponseBody
+func ValidateAddExternalOAuthServerResponseBody(body *AddExternalOAuthServerResponseBody) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
@@ -6952,41 +8580,9 @@ func ValidateCreateToolsetResponseBody(body *CreateToolsetResponseBody) (err err
 	return
 }
 
-// ValidateListToolsetsResponseBody runs the validations defined on
-// ListToolsetsResponseBody
-func ValidateListToolsetsResponseBody(body *ListToolsetsResponseBody) (err error) {
-	if body.Toolsets == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("toolsets", "body"))
-	}
-	for _, e := range body.Toolsets {
-		if e != nil {
-			if err2 := ValidateToolsetEntryResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
-	}
-	return
-}
-
-// ValidateListToolsetsForOrgResponseBody runs the validations defined on
-// ListToolsetsForOrgResponseBody
-func ValidateListToolsetsForOrgResponseBody(body *ListToolsetsForOrgResponseBody) (err error) {
-	if body.Toolsets == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("toolsets", "body"))
-	}
-	for _, e := range body.Toolsets {
-		if e != nil {
-			if err2 := ValidateToolsetSummaryResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
-	}
-	return
-}
-
-// ValidateUpdateToolsetResponseBody runs the validations defined on
-// UpdateToolsetResponseBody
-func ValidateUpdateToolsetResponseBody(body *UpdateToolsetResponseBody) (err error) {
+// ValidateRemoveOAuthServerResponseBody runs the validations defined on
+// RemoveOAuthServerResponseBody
+func ValidateRemoveOAuthServerResponseBody(body *RemoveOAuthServerResponseBody) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
@@ -7140,9 +8736,9 @@ func ValidateUpdateToolsetResponseBody(body *UpdateToolsetResponseBody) (err err
 	return
 }
 
-// ValidateGetToolsetResponseBody runs the validations defined on
-// GetToolsetResponseBody
-func ValidateGetToolsetResponseBody(body *GetToolsetResponseBody) (err error) {
+// ValidateSetUserSessionIssuerResponseBody runs the validations defined on
+// SetUserSessionIssuerResponseBody
+func ValidateSetUserSessionIssuerResponseBody(body *SetUserSessionIssuerResponseBody) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
@@ -7296,41 +8892,9 @@ func ValidateGetToolsetResponseBody(body *GetToolsetResponseBody) (err error) {
 	return
 }
 
-// ValidateListToolFiltersResponseBody runs the validations defined on
-// ListToolFiltersResponseBody
-func ValidateListToolFiltersResponseBody(body *ListToolFiltersResponseBody) (err error) {
-	if body.FilteringEnabled == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("filtering_enabled", "body"))
-	}
-	if body.Scopes == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
-	}
-	if body.Excluded == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("excluded", "body"))
-	}
-	if body.ToolVariationsGroupID != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.tool_variations_group_id", *body.ToolVariationsGroupID, goa.FormatUUID))
-	}
-	for _, e := range body.Scopes {
-		if e != nil {
-			if err2 := ValidateToolFilterScopeResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
-	}
-	for _, e := range body.Excluded {
-		if e != nil {
-			if err2 := ValidateToolFilterToolResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
-	}
-	return
-}
-
-// ValidateCloneToolsetResponseBody runs the validations defined on
-// CloneToolsetResponseBody
-func ValidateCloneToolsetResponseBody(body *CloneToolsetResponseBody) (err error) {
+// ValidateSetToolVariationsGroupResponseBody runs the validations defined on
+// SetToolVariationsGroupResponseBody
+func ValidateSetToolVariationsGroupResponseBody(body *SetToolVariationsGroupResponseBody) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
@@ -7484,9 +9048,33 @@ func ValidateCloneToolsetResponseBody(body *CloneToolsetResponseBody) (err error
 	return
 }
 
-// ValidateAddExternalOAuthServerResponseBody runs the validations defined on
-// AddExternalOAuthServerResponseBody
-func ValidateAddExternalOAuthServerResponseBody(body *AddExternalOAuthServerResponseBody) (err error) {
+// ValidateDiffToolsetVersionsResponseBody runs the validations defined on
+// DiffToolsetVersionsResponseBody
+func ValidateDiffToolsetVersionsResponseBody(body *DiffToolsetVersionsResponseBody) (err error) {
+	if body.FromVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from_version", "body"))
+	}
+	if body.ToVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to_version", "body"))
+	}
+	if body.AddedToolUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("added_tool_urns", "body"))
+	}
+	if body.RemovedToolUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("removed_tool_urns", "body"))
+	}
+	if body.AddedResourceUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("added_resource_urns", "body"))
+	}
+	if body.RemovedResourceUrns == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("removed_resource_urns", "body"))
+	}
+	return
+}
+
+// ValidateRevertToolsetResponseBody runs the validations defined on
+// RevertToolsetResponseBody
+func ValidateRevertToolsetResponseBody(body *RevertToolsetResponseBody) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
@@ -7640,477 +9228,489 @@ func ValidateAddExternalOAuthServerResponseBody(body *AddExternalOAuthServerResp
 	return
 }
 
-// ValidateRemoveOAuthServerResponseBody runs the validations defined on
-// RemoveOAuthServerResponseBody
-func ValidateRemoveOAuthServerResponseBody(body *RemoveOAuthServerResponseBody) (err error) {
+// ValidateCreateToolsetUnauthorizedResponseBody runs the validations defined
+// on createToolset_unauthorized_response_body
+func ValidateCreateToolsetUnauthorizedResponseBody(body *CreateToolsetUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ProjectID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.OrganizationID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.AccountType == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("account_type", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateToolsetForbiddenResponseBody runs the validations defined on
+// createToolset_forbidden_response_body
+func ValidateCreateToolsetForbiddenResponseBody(body *CreateToolsetForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.Slug == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.Tools == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("tools", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.ToolSelectionMode == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("tool_selection_mode", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.ToolsetVersion == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("toolset_version", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.PromptTemplates == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("prompt_templates", "body"))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.ToolUrns == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("tool_urns", "body"))
+	return
+}
+
+// ValidateCreateToolsetBadRequestResponseBody runs the validations defined on
+// createToolset_bad_request_response_body
+func ValidateCreateToolsetBadRequestResponseBody(body *CreateToolsetBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.Resources == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "body"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ResourceUrns == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("resource_urns", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.OauthEnablementMetadata == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("oauth_enablement_metadata", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.UpdatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.Slug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	return
+}
+
+// ValidateCreateToolsetNotFoundResponseBody runs the validations defined on
+// createToolset_not_found_response_body
+func ValidateCreateToolsetNotFoundResponseBody(body *CreateToolsetNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.Slug != nil {
-		if utf8.RuneCountInString(*body.Slug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 40, false))
-		}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.DefaultEnvironmentSlug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.default_environment_slug", *body.DefaultEnvironmentSlug, "^[a-z0-9_-]{1,128}$"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.DefaultEnvironmentSlug != nil {
-		if utf8.RuneCountInString(*body.DefaultEnvironmentSlug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.default_environment_slug", *body.DefaultEnvironmentSlug, utf8.RuneCountInString(*body.DefaultEnvironmentSlug), 40, false))
-		}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	for _, e := range body.SecurityVariables {
-		if e != nil {
-			if err2 := ValidateSecurityVariableResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	for _, e := range body.ServerVariables {
-		if e != nil {
-			if err2 := ValidateServerVariableResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateToolsetConflictResponseBody runs the validations defined on
+// createToolset_conflict_response_body
+func ValidateCreateToolsetConflictResponseBody(body *CreateToolsetConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateToolsetUnsupportedMediaResponseBody runs the validations
+// defined on createToolset_unsupported_media_response_body
+func ValidateCreateToolsetUnsupportedMediaResponseBody(body *CreateToolsetUnsupportedMediaResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	for _, e := range body.FunctionEnvironmentVariables {
-		if e != nil {
-			if err2 := ValidateFunctionEnvironmentVariableResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	for _, e := range body.ExternalMcpHeaderDefinitions {
-		if e != nil {
-			if err2 := ValidateExternalMCPHeaderDefinitionResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.OauthEnablementMetadata != nil {
-		if err2 := ValidateOAuthEnablementMetadataResponseBody(body.OauthEnablementMetadata); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	for _, e := range body.Tools {
-		if e != nil {
-			if err2 := ValidateToolResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	return
+}
+
+// ValidateCreateToolsetInvalidResponseBody runs the validations defined on
+// createToolset_invalid_response_body
+func ValidateCreateToolsetInvalidResponseBody(body *CreateToolsetInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	for _, e := range body.Resources {
-		if e != nil {
-			if err2 := ValidateResourceResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	for _, e := range body.PromptTemplates {
-		if e != nil {
-			if err2 := ValidatePromptTemplateResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.McpSlug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9_-]{1,128}$"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.McpSlug != nil {
-		if utf8.RuneCountInString(*body.McpSlug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
-		}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.Origin != nil {
-		if err2 := ValidateToolsetOriginResponseBody(body.Origin); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.ExternalOauthServer != nil {
-		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	return
+}
+
+// ValidateCreateToolsetInvariantViolationResponseBody runs the validations
+// defined on createToolset_invariant_violation_response_body
+func ValidateCreateToolsetInvariantViolationResponseBody(body *CreateToolsetInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.UserSessionIssuerSlug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, "^[a-z0-9_-]{1,128}$"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.UserSessionIssuerSlug != nil {
-		if utf8.RuneCountInString(*body.UserSessionIssuerSlug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, utf8.RuneCountInString(*body.UserSessionIssuerSlug), 40, false))
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateSetUserSessionIssuerResponseBody runs the validations defined on
-// SetUserSessionIssuerResponseBody
-func ValidateSetUserSessionIssuerResponseBody(body *SetUserSessionIssuerResponseBody) (err error) {
+// ValidateCreateToolsetUnexpectedResponseBody runs the validations defined on
+// createToolset_unexpected_response_body
+func ValidateCreateToolsetUnexpectedResponseBody(body *CreateToolsetUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ProjectID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.OrganizationID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.AccountType == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("account_type", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
+	return
+}
+
+// ValidateCreateToolsetGatewayErrorResponseBody runs the validations defined
+// on createToolset_gateway_error_response_body
+func ValidateCreateToolsetGatewayErrorResponseBody(body *CreateToolsetGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.Slug == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
-	}
-	if body.Tools == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("tools", "body"))
-	}
-	if body.ToolSelectionMode == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("tool_selection_mode", "body"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ToolsetVersion == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("toolset_version", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.PromptTemplates == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("prompt_templates", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.ToolUrns == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("tool_urns", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.Resources == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "body"))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.ResourceUrns == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("resource_urns", "body"))
+	return
+}
+
+// ValidateListToolsetsUnauthorizedResponseBody runs the validations defined on
+// listToolsets_unauthorized_response_body
+func ValidateListToolsetsUnauthorizedResponseBody(body *ListToolsetsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.OauthEnablementMetadata == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("oauth_enablement_metadata", "body"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UpdatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.Slug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.Slug != nil {
-		if utf8.RuneCountInString(*body.Slug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 40, false))
-		}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.DefaultEnvironmentSlug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.default_environment_slug", *body.DefaultEnvironmentSlug, "^[a-z0-9_-]{1,128}$"))
+	return
+}
+
+// ValidateListToolsetsForbiddenResponseBody runs the validations defined on
+// listToolsets_forbidden_response_body
+func ValidateListToolsetsForbiddenResponseBody(body *ListToolsetsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.DefaultEnvironmentSlug != nil {
-		if utf8.RuneCountInString(*body.DefaultEnvironmentSlug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.default_environment_slug", *body.DefaultEnvironmentSlug, utf8.RuneCountInString(*body.DefaultEnvironmentSlug), 40, false))
-		}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	for _, e := range body.SecurityVariables {
-		if e != nil {
-			if err2 := ValidateSecurityVariableResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	for _, e := range body.ServerVariables {
-		if e != nil {
-			if err2 := ValidateServerVariableResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	for _, e := range body.FunctionEnvironmentVariables {
-		if e != nil {
-			if err2 := ValidateFunctionEnvironmentVariableResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	for _, e := range body.ExternalMcpHeaderDefinitions {
-		if e != nil {
-			if err2 := ValidateExternalMCPHeaderDefinitionResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.OauthEnablementMetadata != nil {
-		if err2 := ValidateOAuthEnablementMetadataResponseBody(body.OauthEnablementMetadata); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	return
+}
+
+// ValidateListToolsetsBadRequestResponseBody runs the validations defined on
+// listToolsets_bad_request_response_body
+func ValidateListToolsetsBadRequestResponseBody(body *ListToolsetsBadRequestResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	for _, e := range body.Tools {
-		if e != nil {
-			if err2 := ValidateToolResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	for _, e := range body.Resources {
-		if e != nil {
-			if err2 := ValidateResourceResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	for _, e := range body.PromptTemplates {
-		if e != nil {
-			if err2 := ValidatePromptTemplateResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.McpSlug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9_-]{1,128}$"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.McpSlug != nil {
-		if utf8.RuneCountInString(*body.McpSlug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
-		}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.Origin != nil {
-		if err2 := ValidateToolsetOriginResponseBody(body.Origin); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	return
+}
+
+// ValidateListToolsetsNotFoundResponseBody runs the validations defined on
+// listToolsets_not_found_response_body
+func ValidateListToolsetsNotFoundResponseBody(body *ListToolsetsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ExternalOauthServer != nil {
-		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.UserSessionIssuerSlug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, "^[a-z0-9_-]{1,128}$"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UserSessionIssuerSlug != nil {
-		if utf8.RuneCountInString(*body.UserSessionIssuerSlug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, utf8.RuneCountInString(*body.UserSessionIssuerSlug), 40, false))
-		}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateSetToolVariationsGroupResponseBody runs the validations defined on
-// SetToolVariationsGroupResponseBody
-func ValidateSetToolVariationsGroupResponseBody(body *SetToolVariationsGroupResponseBody) (err error) {
+// ValidateListToolsetsConflictResponseBody runs the validations defined on
+// listToolsets_conflict_response_body
+func ValidateListToolsetsConflictResponseBody(body *ListToolsetsConflictResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ProjectID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("project_id", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.OrganizationID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("organization_id", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.AccountType == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("account_type", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListToolsetsUnsupportedMediaResponseBody runs the validations
+// defined on listToolsets_unsupported_media_response_body
+func ValidateListToolsetsUnsupportedMediaResponseBody(body *ListToolsetsUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.Slug == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("slug", "body"))
-	}
-	if body.Tools == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("tools", "body"))
-	}
-	if body.ToolSelectionMode == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("tool_selection_mode", "body"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.ToolsetVersion == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("toolset_version", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.PromptTemplates == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("prompt_templates", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.ToolUrns == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("tool_urns", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.Resources == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("resources", "body"))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.ResourceUrns == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("resource_urns", "body"))
+	return
+}
+
+// ValidateListToolsetsInvalidResponseBody runs the validations defined on
+// listToolsets_invalid_response_body
+func ValidateListToolsetsInvalidResponseBody(body *ListToolsetsInvalidResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.OauthEnablementMetadata == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("oauth_enablement_metadata", "body"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UpdatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.Slug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.slug", *body.Slug, "^[a-z0-9_-]{1,128}$"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.Slug != nil {
-		if utf8.RuneCountInString(*body.Slug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.slug", *body.Slug, utf8.RuneCountInString(*body.Slug), 40, false))
-		}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.DefaultEnvironmentSlug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.default_environment_slug", *body.DefaultEnvironmentSlug, "^[a-z0-9_-]{1,128}$"))
+	return
+}
+
+// ValidateListToolsetsInvariantViolationResponseBody runs the validations
+// defined on listToolsets_invariant_violation_response_body
+func ValidateListToolsetsInvariantViolationResponseBody(body *ListToolsetsInvariantViolationResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.DefaultEnvironmentSlug != nil {
-		if utf8.RuneCountInString(*body.DefaultEnvironmentSlug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.default_environment_slug", *body.DefaultEnvironmentSlug, utf8.RuneCountInString(*body.DefaultEnvironmentSlug), 40, false))
-		}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	for _, e := range body.SecurityVariables {
-		if e != nil {
-			if err2 := ValidateSecurityVariableResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	for _, e := range body.ServerVariables {
-		if e != nil {
-			if err2 := ValidateServerVariableResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	for _, e := range body.FunctionEnvironmentVariables {
-		if e != nil {
-			if err2 := ValidateFunctionEnvironmentVariableResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	for _, e := range body.ExternalMcpHeaderDefinitions {
-		if e != nil {
-			if err2 := ValidateExternalMCPHeaderDefinitionResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.OauthEnablementMetadata != nil {
-		if err2 := ValidateOAuthEnablementMetadataResponseBody(body.OauthEnablementMetadata); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	return
+}
+
+// ValidateListToolsetsUnexpectedResponseBody runs the validations defined on
+// listToolsets_unexpected_response_body
+func ValidateListToolsetsUnexpectedResponseBody(body *ListToolsetsUnexpectedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	for _, e := range body.Tools {
-		if e != nil {
-			if err2 := ValidateToolResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	for _, e := range body.Resources {
-		if e != nil {
-			if err2 := ValidateResourceResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	for _, e := range body.PromptTemplates {
-		if e != nil {
-			if err2 := ValidatePromptTemplateResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.McpSlug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.mcp_slug", *body.McpSlug, "^[a-z0-9_-]{1,128}$"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.McpSlug != nil {
-		if utf8.RuneCountInString(*body.McpSlug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.mcp_slug", *body.McpSlug, utf8.RuneCountInString(*body.McpSlug), 40, false))
-		}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-	if body.Origin != nil {
-		if err2 := ValidateToolsetOriginResponseBody(body.Origin); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	return
+}
+
+// ValidateListToolsetsGatewayErrorResponseBody runs the validations defined on
+// listToolsets_gateway_error_response_body
+func ValidateListToolsetsGatewayErrorResponseBody(body *ListToolsetsGatewayErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.ExternalOauthServer != nil {
-		if err2 := ValidateExternalOAuthServerResponseBody(body.ExternalOauthServer); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.UserSessionIssuerSlug != nil {
-		err = goa.MergeErrors(err, goa.ValidatePattern("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, "^[a-z0-9_-]{1,128}$"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.UserSessionIssuerSlug != nil {
-		if utf8.RuneCountInString(*body.UserSessionIssuerSlug) > 40 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.user_session_issuer_slug", *body.UserSessionIssuerSlug, utf8.RuneCountInString(*body.UserSessionIssuerSlug), 40, false))
-		}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.CreatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.UpdatedAt != nil {
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateCreateToolsetUnauthorizedResponseBody runs the validations defined
-// on createToolset_unauthorized_response_body
-func ValidateCreateToolsetUnauthorizedResponseBody(body *CreateToolsetUnauthorizedResponseBody) (err error) {
+// ValidateListToolsetsForOrgUnauthorizedResponseBody runs the validations
+// defined on listToolsetsForOrg_unauthorized_response_body
+func ValidateListToolsetsForOrgUnauthorizedResponseBody(body *ListToolsetsForOrgUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8132,9 +9732,9 @@ func ValidateCreateToolsetUnauthorizedResponseBody(body *CreateToolsetUnauthoriz
 	return
 }
 
-// ValidateCreateToolsetForbiddenResponseBody runs the validations defined on
-// createToolset_forbidden_response_body
-func ValidateCreateToolsetForbiddenResponseBody(body *CreateToolsetForbiddenResponseBody) (err error) {
+// ValidateListToolsetsForOrgForbiddenResponseBody runs the validations defined
+// on listToolsetsForOrg_forbidden_response_body
+func ValidateListToolsetsForOrgForbiddenResponseBody(body *ListToolsetsForOrgForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8156,9 +9756,9 @@ func ValidateCreateToolsetForbiddenResponseBody(body *CreateToolsetForbiddenResp
 	return
 }
 
-// ValidateCreateToolsetBadRequestResponseBody runs the validations defined on
-// createToolset_bad_request_response_body
-func ValidateCreateToolsetBadRequestResponseBody(body *CreateToolsetBadRequestResponseBody) (err error) {
+// ValidateListToolsetsForOrgBadRequestResponseBody runs the validations
+// defined on listToolsetsForOrg_bad_request_response_body
+func ValidateListToolsetsForOrgBadRequestResponseBody(body *ListToolsetsForOrgBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8180,9 +9780,9 @@ func ValidateCreateToolsetBadRequestResponseBody(body *CreateToolsetBadRequestRe
 	return
 }
 
-// ValidateCreateToolsetNotFoundResponseBody runs the validations defined on
-// createToolset_not_found_response_body
-func ValidateCreateToolsetNotFoundResponseBody(body *CreateToolsetNotFoundResponseBody) (err error) {
+// ValidateListToolsetsForOrgNotFoundResponseBody runs the validations defined
+// on listToolsetsForOrg_not_found_response_body
+func ValidateListToolsetsForOrgNotFoundResponseBody(body *ListToolsetsForOrgNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8204,9 +9804,9 @@ func ValidateCreateToolsetNotFoundResponseBody(body *CreateToolsetNotFoundRespon
 	return
 }
 
-// ValidateCreateToolsetConflictResponseBody runs the validations defined on
-// createToolset_conflict_response_body
-func ValidateCreateToolsetConflictResponseBody(body *CreateToolsetConflictResponseBody) (err error) {
+// ValidateListToolsetsForOrgConflictResponseBody runs the validations defined
+// on listToolsetsForOrg_conflict_response_body
+func ValidateListToolsetsForOrgConflictResponseBody(body *ListToolsetsForOrgConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8228,9 +9828,9 @@ func ValidateCreateToolsetConflictResponseBody(body *CreateToolsetConflictRespon
 	return
 }
 
-// ValidateCreateToolsetUnsupportedMediaResponseBody runs the validations
-// defined on createToolset_unsupported_media_response_body
-func ValidateCreateToolsetUnsupportedMediaResponseBody(body *CreateToolsetUnsupportedMediaResponseBody) (err error) {
+// ValidateListToolsetsForOrgUnsupportedMediaResponseBody runs the validations
+// defined on listToolsetsForOrg_unsupported_media_response_body
+func ValidateListToolsetsForOrgUnsupportedMediaResponseBody(body *ListToolsetsForOrgUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8252,9 +9852,9 @@ func ValidateCreateToolsetUnsupportedMediaResponseBody(body *CreateToolsetUnsupp
 	return
 }
 
-// ValidateCreateToolsetInvalidResponseBody runs the validations defined on
-// createToolset_invalid_response_body
-func ValidateCreateToolsetInvalidResponseBody(body *CreateToolsetInvalidResponseBody) (err error) {
+// ValidateListToolsetsForOrgInvalidResponseBody runs the validations defined
+// on listToolsetsForOrg_invalid_response_body
+func ValidateListToolsetsForOrgInvalidResponseBody(body *ListToolsetsForOrgInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8276,9 +9876,9 @@ func ValidateCreateToolsetInvalidResponseBody(body *CreateToolsetInvalidResponse
 	return
 }
 
-// ValidateCreateToolsetInvariantViolationResponseBody runs the validations
-// defined on createToolset_invariant_violation_response_body
-func ValidateCreateToolsetInvariantViolationResponseBody(body *CreateToolsetInvariantViolationResponseBody) (err error) {
+// ValidateListToolsetsForOrgInvariantViolationResponseBody runs the
+// validations defined on listToolsetsForOrg_invariant_violation_response_body
+func ValidateListToolsetsForOrgInvariantViolationResponseBody(body *ListToolsetsForOrgInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8300,9 +9900,9 @@ func ValidateCreateToolsetInvariantViolationResponseBody(body *CreateToolsetInva
 	return
 }
 
-// ValidateCreateToolsetUnexpectedResponseBody runs the validations defined on
-// createToolset_unexpected_response_body
-func ValidateCreateToolsetUnexpectedResponseBody(body *CreateToolsetUnexpectedResponseBody) (err error) {
+// ValidateListToolsetsForOrgUnexpectedResponseBody runs the validations
+// defined on listToolsetsForOrg_unexpected_response_body
+func ValidateListToolsetsForOrgUnexpectedResponseBody(body *ListToolsetsForOrgUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8324,9 +9924,9 @@ func ValidateCreateToolsetUnexpectedResponseBody(body *CreateToolsetUnexpectedRe
 	return
 }
 
-// ValidateCreateToolsetGatewayErrorResponseBody runs the validations defined
-// on createToolset_gateway_error_response_body
-func ValidateCreateToolsetGatewayErrorResponseBody(body *CreateToolsetGatewayErrorResponseBody) (err error) {
+// ValidateListToolsetsForOrgGatewayErrorResponseBody runs the validations
+// defined on listToolsetsForOrg_gateway_error_response_body
+func ValidateListToolsetsForOrgGatewayErrorResponseBody(body *ListToolsetsForOrgGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8348,9 +9948,9 @@ func ValidateCreateToolsetGatewayErrorResponseBody(body *CreateToolsetGatewayErr
 	return
 }
 
-// ValidateListToolsetsUnauthorizedResponseBody runs the validations defined on
-// listToolsets_unauthorized_response_body
-func ValidateListToolsetsUnauthorizedResponseBody(body *ListToolsetsUnauthorizedResponseBody) (err error) {
+// ValidateUpdateToolsetUnauthorizedResponseBody runs the validations defined
+// on updateToolset_unauthorized_response_body
+func ValidateUpdateToolsetUnauthorizedResponseBody(body *UpdateToolsetUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8372,9 +9972,9 @@ func ValidateListToolsetsUnauthorizedResponseBody(body *ListToolsetsUnauthorized
 	return
 }
 
-// ValidateListToolsetsForbiddenResponseBody runs the validations defined on
-// listToolsets_forbidden_response_body
-func ValidateListToolsetsForbiddenResponseBody(body *ListToolsetsForbiddenResponseBody) (err error) {
+// ValidateUpdateToolsetForbiddenResponseBody runs the validations defined on
+// updateToolset_forbidden_response_body
+func ValidateUpdateToolsetForbiddenResponseBody(body *UpdateToolsetForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8396,9 +9996,9 @@ func ValidateListToolsetsForbiddenResponseBody(body *ListToolsetsForbiddenRespon
 	return
 }
 
-// ValidateListToolsetsBadRequestResponseBody runs the validations defined on
-// listToolsets_bad_request_response_body
-func ValidateListToolsetsBadRequestResponseBody(body *ListToolsetsBadRequestResponseBody) (err error) {
+// ValidateUpdateToolsetBadRequestResponseBody runs the validations defined on
+// updateToolset_bad_request_response_body
+func ValidateUpdateToolsetBadRequestResponseBody(body *UpdateToolsetBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8420,9 +10020,9 @@ func ValidateListToolsetsBadRequestResponseBody(body *ListToolsetsBadRequestResp
 	return
 }
 
-// ValidateListToolsetsNotFoundResponseBody runs the validations defined on
-// listToolsets_not_found_response_body
-func ValidateListToolsetsNotFoundResponseBody(body *ListToolsetsNotFoundResponseBody) (err error) {
+// ValidateUpdateToolsetNotFoundResponseBody runs the validations defined on
+// updateToolset_not_found_response_body
+func ValidateUpdateToolsetNotFoundResponseBody(body *UpdateToolsetNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8444,9 +10044,9 @@ func ValidateListToolsetsNotFoundResponseBody(body *ListToolsetsNotFoundResponse
 	return
 }
 
-// ValidateListToolsetsConflictResponseBody runs the validations defined on
-// listToolsets_conflict_response_body
-func ValidateListToolsetsConflictResponseBody(body *ListToolsetsConflictResponseBody) (err error) {
+// ValidateUpdateToolsetConflictResponseBody runs the validations defined on
+// updateToolset_conflict_response_body
+func ValidateUpdateToolsetConflictResponseBody(body *UpdateToolsetConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8468,9 +10068,9 @@ func ValidateListToolsetsConflictResponseBody(body *ListToolsetsConflictResponse
 	return
 }
 
-// ValidateListToolsetsUnsupportedMediaResponseBody runs the validations
-// defined on listToolsets_unsupported_media_response_body
-func ValidateListToolsetsUnsupportedMediaResponseBody(body *ListToolsetsUnsupportedMediaResponseBody) (err error) {
+// ValidateUpdateToolsetUnsupportedMediaResponseBody runs the validations
+// defined on updateToolset_unsupported_media_response_body
+func ValidateUpdateToolsetUnsupportedMediaResponseBody(body *UpdateToolsetUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8492,9 +10092,9 @@ func ValidateListToolsetsUnsupportedMediaResponseBody(body *ListToolsetsUnsuppor
 	return
 }
 
-// ValidateListToolsetsInvalidResponseBody runs the validations defined on
-// listToolsets_invalid_response_body
-func ValidateListToolsetsInvalidResponseBody(body *ListToolsetsInvalidResponseBody) (err error) {
+// ValidateUpdateToolsetInvalidResponseBody runs the validations defined on
+// updateToolset_invalid_response_body
+func ValidateUpdateToolsetInvalidResponseBody(body *UpdateToolsetInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8516,9 +10116,9 @@ func ValidateListToolsetsInvalidResponseBody(body *ListToolsetsInvalidResponseBo
 	return
 }
 
-// ValidateListToolsetsInvariantViolationResponseBody runs the validations
-// defined on listToolsets_invariant_violation_response_body
-func ValidateListToolsetsInvariantViolationResponseBody(body *ListToolsetsInvariantViolationResponseBody) (err error) {
+// ValidateUpdateToolsetInvariantViolationResponseBody runs the validations
+// defined on updateToolset_invariant_violation_response_body
+func ValidateUpdateToolsetInvariantViolationResponseBody(body *UpdateToolsetInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8540,9 +10140,9 @@ func ValidateListToolsetsInvariantViolationResponseBody(body *ListToolsetsInvari
 	return
 }
 
-// ValidateListToolsetsUnexpectedResponseBody runs the validations defined on
-// listToolsets_unexpected_response_body
-func ValidateListToolsetsUnexpectedResponseBody(body *ListToolsetsUnexpectedResponseBody) (err error) {
+// ValidateUpdateToolsetUnexpectedResponseBody runs the validations defined on
+// updateToolset_unexpected_response_body
+func ValidateUpdateToolsetUnexpectedResponseBody(body *UpdateToolsetUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8564,9 +10164,9 @@ func ValidateListToolsetsUnexpectedResponseBody(body *ListToolsetsUnexpectedResp
 	return
 }
 
-// ValidateListToolsetsGatewayErrorResponseBody runs the validations defined on
-// listToolsets_gateway_error_response_body
-func ValidateListToolsetsGatewayErrorResponseBody(body *ListToolsetsGatewayErrorResponseBody) (err error) {
+// ValidateUpdateToolsetGatewayErrorResponseBody runs the validations defined
+// on updateToolset_gateway_error_response_body
+func ValidateUpdateToolsetGatewayErrorResponseBody(body *UpdateToolsetGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8588,9 +10188,9 @@ func ValidateListToolsetsGatewayErrorResponseBody(body *ListToolsetsGatewayError
 	return
 }
 
-// ValidateListToolsetsForOrgUnauthorizedResponseBody runs the validations
-// defined on listToolsetsForOrg_unauthorized_response_body
-func ValidateListToolsetsForOrgUnauthorizedResponseBody(body *ListToolsetsForOrgUnauthorizedResponseBody) (err error) {
+// ValidateDeleteToolsetUnauthorizedResponseBody runs the validations defined
+// on deleteToolset_unauthorized_response_body
+func ValidateDeleteToolsetUnauthorizedResponseBody(body *DeleteToolsetUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8612,9 +10212,9 @@ func ValidateListToolsetsForOrgUnauthorizedResponseBody(body *ListToolsetsForOrg
 	return
 }
 
-// ValidateListToolsetsForOrgForbiddenResponseBody runs the validations defined
-// on listToolsetsForOrg_forbidden_response_body
-func ValidateListToolsetsForOrgForbiddenResponseBody(body *ListToolsetsForOrgForbiddenResponseBody) (err error) {
+// ValidateDeleteToolsetForbiddenResponseBody runs the validations defined on
+// deleteToolset_forbidden_response_body
+func ValidateDeleteToolsetForbiddenResponseBody(body *DeleteToolsetForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8636,9 +10236,9 @@ func ValidateListToolsetsForOrgForbiddenResponseBody(body *ListToolsetsForOrgFor
 	return
 }
 
-// ValidateListToolsetsForOrgBadRequestResponseBody runs the validations
-// defined on listToolsetsForOrg_bad_request_response_body
-func ValidateListToolsetsForOrgBadRequestResponseBody(body *ListToolsetsForOrgBadRequestResponseBody) (err error) {
+// ValidateDeleteToolsetBadRequestResponseBody runs the validations defined on
+// deleteToolset_bad_request_response_body
+func ValidateDeleteToolsetBadRequestResponseBody(body *DeleteToolsetBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8660,9 +10260,9 @@ func ValidateListToolsetsForOrgBadRequestResponseBody(body *ListToolsetsForOrgBa
 	return
 }
 
-// ValidateListToolsetsForOrgNotFoundResponseBody runs the validations defined
-// on listToolsetsForOrg_not_found_response_body
-func ValidateListToolsetsForOrgNotFoundResponseBody(body *ListToolsetsForOrgNotFoundResponseBody) (err error) {
+// ValidateDeleteToolsetNotFoundResponseBody runs the validations defined on
+// deleteToolset_not_found_response_body
+func ValidateDeleteToolsetNotFoundResponseBody(body *DeleteToolsetNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8684,9 +10284,9 @@ func ValidateListToolsetsForOrgNotFoundResponseBody(body *ListToolsetsForOrgNotF
 	return
 }
 
-// ValidateListToolsetsForOrgConflictResponseBody runs the validations defined
-// on listToolsetsForOrg_conflict_response_body
-func ValidateListToolsetsForOrgConflictResponseBody(body *ListToolsetsForOrgConflictResponseBody) (err error) {
+// ValidateDeleteToolsetConflictResponseBody runs the validations defined on
+// deleteToolset_conflict_response_body
+func ValidateDeleteToolsetConflictResponseBody(body *DeleteToolsetConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8708,9 +10308,9 @@ func ValidateListToolsetsForOrgConflictResponseBody(body *ListToolsetsForOrgConf
 	return
 }
 
-// ValidateListToolsetsForOrgUnsupportedMediaResponseBody runs the validations
-// defined on listToolsetsForOrg_unsupported_media_response_body
-func ValidateListToolsetsForOrgUnsupportedMediaResponseBody(body *ListToolsetsForOrgUnsupportedMediaResponseBody) (err error) {
+// ValidateDeleteToolsetUnsupportedMediaResponseBody runs the validations
+// defined on deleteToolset_unsupported_media_response_body
+func ValidateDeleteToolsetUnsupportedMediaResponseBody(body *DeleteToolsetUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8732,9 +10332,9 @@ func ValidateListToolsetsForOrgUnsupportedMediaResponseBody(body *ListToolsetsFo
 	return
 }
 
-// ValidateListToolsetsForOrgInvalidResponseBody runs the validations defined
-// on listToolsetsForOrg_invalid_response_body
-func ValidateListToolsetsForOrgInvalidResponseBody(body *ListToolsetsForOrgInvalidResponseBody) (err error) {
+// ValidateDeleteToolsetInvalidResponseBody runs the validations defined on
+// deleteToolset_invalid_response_body
+func ValidateDeleteToolsetInvalidResponseBody(body *DeleteToolsetInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8756,9 +10356,9 @@ func ValidateListToolsetsForOrgInvalidResponseBody(body *ListToolsetsForOrgInval
 	return
 }
 
-// ValidateListToolsetsForOrgInvariantViolationResponseBody runs the
-// validations defined on listToolsetsForOrg_invariant_violation_response_body
-func ValidateListToolsetsForOrgInvariantViolationResponseBody(body *ListToolsetsForOrgInvariantViolationResponseBody) (err error) {
+// ValidateDeleteToolsetInvariantViolationResponseBody runs the validations
+// defined on deleteToolset_invariant_violation_response_body
+func ValidateDeleteToolsetInvariantViolationResponseBody(body *DeleteToolsetInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8780,9 +10380,9 @@ func ValidateListToolsetsForOrgInvariantViolationResponseBody(body *ListToolsets
 	return
 }
 
-// ValidateListToolsetsForOrgUnexpectedResponseBody runs the validations
-// defined on listToolsetsForOrg_unexpected_response_body
-func ValidateListToolsetsForOrgUnexpectedResponseBody(body *ListToolsetsForOrgUnexpectedResponseBody) (err error) {
+// ValidateDeleteToolsetUnexpectedResponseBody runs the validations defined on
+// deleteToolset_unexpected_response_body
+func ValidateDeleteToolsetUnexpectedResponseBody(body *DeleteToolsetUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8804,9 +10404,9 @@ func ValidateListToolsetsForOrgUnexpectedResponseBody(body *ListToolsetsForOrgUn
 	return
 }
 
-// ValidateListToolsetsForOrgGatewayErrorResponseBody runs the validations
-// defined on listToolsetsForOrg_gateway_error_response_body
-func ValidateListToolsetsForOrgGatewayErrorResponseBody(body *ListToolsetsForOrgGatewayErrorResponseBody) (err error) {
+// ValidateDeleteToolsetGatewayErrorResponseBody runs the validations defined
+// on deleteToolset_gateway_error_response_body
+func ValidateDeleteToolsetGatewayErrorResponseBody(body *DeleteToolsetGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8828,9 +10428,9 @@ func ValidateListToolsetsForOrgGatewayErrorResponseBody(body *ListToolsetsForOrg
 	return
 }
 
-// ValidateUpdateToolsetUnauthorizedResponseBody runs the validations defined
-// on updateToolset_unauthorized_response_body
-func ValidateUpdateToolsetUnauthorizedResponseBody(body *UpdateToolsetUnauthorizedResponseBody) (err error) {
+// ValidateGetToolsetUnauthorizedResponseBody runs the validations defined on
+// getToolset_unauthorized_response_body
+func ValidateGetToolsetUnauthorizedResponseBody(body *GetToolsetUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8852,9 +10452,9 @@ func ValidateUpdateToolsetUnauthorizedResponseBody(body *UpdateToolsetUnauthoriz
 	return
 }
 
-// ValidateUpdateToolsetForbiddenResponseBody runs the validations defined on
-// updateToolset_forbidden_response_body
-func ValidateUpdateToolsetForbiddenResponseBody(body *UpdateToolsetForbiddenResponseBody) (err error) {
+// ValidateGetToolsetForbiddenResponseBody runs the validations defined on
+// getToolset_forbidden_response_body
+func ValidateGetToolsetForbiddenResponseBody(body *GetToolsetForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8876,9 +10476,9 @@ func ValidateUpdateToolsetForbiddenResponseBody(body *UpdateToolsetForbiddenResp
 	return
 }
 
-// ValidateUpdateToolsetBadRequestResponseBody runs the validations defined on
-// updateToolset_bad_request_response_body
-func ValidateUpdateToolsetBadRequestResponseBody(body *UpdateToolsetBadRequestResponseBody) (err error) {
+// ValidateGetToolsetBadRequestResponseBody runs the validations defined on
+// getToolset_bad_request_response_body
+func ValidateGetToolsetBadRequestResponseBody(body *GetToolsetBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8900,9 +10500,9 @@ func ValidateUpdateToolsetBadRequestResponseBody(body *UpdateToolsetBadRequestRe
 	return
 }
 
-// ValidateUpdateToolsetNotFoundResponseBody runs the validations defined on
-// updateToolset_not_found_response_body
-func ValidateUpdateToolsetNotFoundResponseBody(body *UpdateToolsetNotFoundResponseBody) (err error) {
+// ValidateGetToolsetNotFoundResponseBody runs the validations defined on
+// getToolset_not_found_response_body
+func ValidateGetToolsetNotFoundResponseBody(body *GetToolsetNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8924,9 +10524,9 @@ func ValidateUpdateToolsetNotFoundResponseBody(body *UpdateToolsetNotFoundRespon
 	return
 }
 
-// ValidateUpdateToolsetConflictResponseBody runs the validations defined on
-// updateToolset_conflict_response_body
-func ValidateUpdateToolsetConflictResponseBody(body *UpdateToolsetConflictResponseBody) (err error) {
+// ValidateGetToolsetConflictResponseBody runs the validations defined on
+// getToolset_conflict_response_body
+func ValidateGetToolsetConflictResponseBody(body *GetToolsetConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8948,9 +10548,9 @@ func ValidateUpdateToolsetConflictResponseBody(body *UpdateToolsetConflictRespon
 	return
 }
 
-// ValidateUpdateToolsetUnsupportedMediaResponseBody runs the validations
-// defined on updateToolset_unsupported_media_response_body
-func ValidateUpdateToolsetUnsupportedMediaResponseBody(body *UpdateToolsetUnsupportedMediaResponseBody) (err error) {
+// ValidateGetToolsetUnsupportedMediaResponseBody runs the validations defined
+// on getToolset_unsupported_media_response_body
+func ValidateGetToolsetUnsupportedMediaResponseBody(body *GetToolsetUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8972,9 +10572,9 @@ func ValidateUpdateToolsetUnsupportedMediaResponseBody(body *UpdateToolsetUnsupp
 	return
 }
 
-// ValidateUpdateToolsetInvalidResponseBody runs the validations defined on
-// updateToolset_invalid_response_body
-func ValidateUpdateToolsetInvalidResponseBody(body *UpdateToolsetInvalidResponseBody) (err error) {
+// ValidateGetToolsetInvalidResponseBody runs the validations defined on
+// getToolset_invalid_response_body
+func ValidateGetToolsetInvalidResponseBody(body *GetToolsetInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -8996,9 +10596,9 @@ func ValidateUpdateToolsetInvalidResponseBody(body *UpdateToolsetInvalidResponse
 	return
 }
 
-// ValidateUpdateToolsetInvariantViolationResponseBody runs the validations
-// defined on updateToolset_invariant_violation_response_body
-func ValidateUpdateToolsetInvariantViolationResponseBody(body *UpdateToolsetInvariantViolationResponseBody) (err error) {
+// ValidateGetToolsetInvariantViolationResponseBody runs the validations
+// defined on getToolset_invariant_violation_response_body
+func ValidateGetToolsetInvariantViolationResponseBody(body *GetToolsetInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9020,9 +10620,9 @@ func ValidateUpdateToolsetInvariantViolationResponseBody(body *UpdateToolsetInva
 	return
 }
 
-// ValidateUpdateToolsetUnexpectedResponseBody runs the validations defined on
-// updateToolset_unexpected_response_body
-func ValidateUpdateToolsetUnexpectedResponseBody(body *UpdateToolsetUnexpectedResponseBody) (err error) {
+// ValidateGetToolsetUnexpectedResponseBody runs the validations defined on
+// getToolset_unexpected_response_body
+func ValidateGetToolsetUnexpectedResponseBody(body *GetToolsetUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9044,9 +10644,9 @@ func ValidateUpdateToolsetUnexpectedResponseBody(body *UpdateToolsetUnexpectedRe
 	return
 }
 
-// ValidateUpdateToolsetGatewayErrorResponseBody runs the validations defined
-// on updateToolset_gateway_error_response_body
-func ValidateUpdateToolsetGatewayErrorResponseBody(body *UpdateToolsetGatewayErrorResponseBody) (err error) {
+// ValidateGetToolsetGatewayErrorResponseBody runs the validations defined on
+// getToolset_gateway_error_response_body
+func ValidateGetToolsetGatewayErrorResponseBody(body *GetToolsetGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9068,9 +10668,9 @@ func ValidateUpdateToolsetGatewayErrorResponseBody(body *UpdateToolsetGatewayErr
 	return
 }
 
-// ValidateDeleteToolsetUnauthorizedResponseBody runs the validations defined
-// on deleteToolset_unauthorized_response_body
-func ValidateDeleteToolsetUnauthorizedResponseBody(body *DeleteToolsetUnauthorizedResponseBody) (err error) {
+// ValidateListToolFiltersUnauthorizedResponseBody runs the validations defined
+// on listToolFilters_unauthorized_response_body
+func ValidateListToolFiltersUnauthorizedResponseBody(body *ListToolFiltersUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9092,9 +10692,9 @@ func ValidateDeleteToolsetUnauthorizedResponseBody(body *DeleteToolsetUnauthoriz
 	return
 }
 
-// ValidateDeleteToolsetForbiddenResponseBody runs the validations defined on
-// deleteToolset_forbidden_response_body
-func ValidateDeleteToolsetForbiddenResponseBody(body *DeleteToolsetForbiddenResponseBody) (err error) {
+// ValidateListToolFiltersForbiddenResponseBody runs the validations defined on
+// listToolFilters_forbidden_response_body
+func ValidateListToolFiltersForbiddenResponseBody(body *ListToolFiltersForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9116,9 +10716,9 @@ func ValidateDeleteToolsetForbiddenResponseBody(body *DeleteToolsetForbiddenResp
 	return
 }
 
-// ValidateDeleteToolsetBadRequestResponseBody runs the validations defined on
-// deleteToolset_bad_request_response_body
-func ValidateDeleteToolsetBadRequestResponseBody(body *DeleteToolsetBadRequestResponseBody) (err error) {
+// ValidateListToolFiltersBadRequestResponseBody runs the validations defined
+// on listToolFilters_bad_request_response_body
+func ValidateListToolFiltersBadRequestResponseBody(body *ListToolFiltersBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9140,9 +10740,9 @@ func ValidateDeleteToolsetBadRequestResponseBody(body *DeleteToolsetBadRequestRe
 	return
 }
 
-// ValidateDeleteToolsetNotFoundResponseBody runs the validations defined on
-// deleteToolset_not_found_response_body
-func ValidateDeleteToolsetNotFoundResponseBody(body *DeleteToolsetNotFoundResponseBody) (err error) {
+// ValidateListToolFiltersNotFoundResponseBody runs the validations defined on
+// listToolFilters_not_found_response_body
+func ValidateListToolFiltersNotFoundResponseBody(body *ListToolFiltersNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9164,9 +10764,9 @@ func ValidateDeleteToolsetNotFoundResponseBody(body *DeleteToolsetNotFoundRespon
 	return
 }
 
-// ValidateDeleteToolsetConflictResponseBody runs the validations defined on
-// deleteToolset_conflict_response_body
-func ValidateDeleteToolsetConflictResponseBody(body *DeleteToolsetConflictResponseBody) (err error) {
+// ValidateListToolFiltersConflictResponseBody runs the validations defined on
+// listToolFilters_conflict_response_body
+func ValidateListToolFiltersConflictResponseBody(body *ListToolFiltersConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9188,9 +10788,9 @@ func ValidateDeleteToolsetConflictResponseBody(body *DeleteToolsetConflictRespon
 	return
 }
 
-// ValidateDeleteToolsetUnsupportedMediaResponseBody runs the validations
-// defined on deleteToolset_unsupported_media_response_body
-func ValidateDeleteToolsetUnsupportedMediaResponseBody(body *DeleteToolsetUnsupportedMediaResponseBody) (err error) {
+// ValidateListToolFiltersUnsupportedMediaResponseBody runs the validations
+// defined on listToolFilters_unsupported_media_response_body
+func ValidateListToolFiltersUnsupportedMediaResponseBody(body *ListToolFiltersUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9212,9 +10812,9 @@ func ValidateDeleteToolsetUnsupportedMediaResponseBody(body *DeleteToolsetUnsupp
 	return
 }
 
-// ValidateDeleteToolsetInvalidResponseBody runs the validations defined on
-// deleteToolset_invalid_response_body
-func ValidateDeleteToolsetInvalidResponseBody(body *DeleteToolsetInvalidResponseBody) (err error) {
+// ValidateListToolFiltersInvalidResponseBody runs the validations defined on
+// listToolFilters_invalid_response_body
+func ValidateListToolFiltersInvalidResponseBody(body *ListToolFiltersInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9236,9 +10836,9 @@ func ValidateDeleteToolsetInvalidResponseBody(body *DeleteToolsetInvalidResponse
 	return
 }
 
-// ValidateDeleteToolsetInvariantViolationResponseBody runs the validations
-// defined on deleteToolset_invariant_violation_response_body
-func ValidateDeleteToolsetInvariantViolationResponseBody(body *DeleteToolsetInvariantViolationResponseBody) (err error) {
+// ValidateListToolFiltersInvariantViolationResponseBody runs the validations
+// defined on listToolFilters_invariant_violation_response_body
+func ValidateListToolFiltersInvariantViolationResponseBody(body *ListToolFiltersInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9260,9 +10860,9 @@ func ValidateDeleteToolsetInvariantViolationResponseBody(body *DeleteToolsetInva
 	return
 }
 
-// ValidateDeleteToolsetUnexpectedResponseBody runs the validations defined on
-// deleteToolset_unexpected_response_body
-func ValidateDeleteToolsetUnexpectedResponseBody(body *DeleteToolsetUnexpectedResponseBody) (err error) {
+// ValidateListToolFiltersUnexpectedResponseBody runs the validations defined
+// on listToolFilters_unexpected_response_body
+func ValidateListToolFiltersUnexpectedResponseBody(body *ListToolFiltersUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9284,9 +10884,9 @@ func ValidateDeleteToolsetUnexpectedResponseBody(body *DeleteToolsetUnexpectedRe
 	return
 }
 
-// ValidateDeleteToolsetGatewayErrorResponseBody runs the validations defined
-// on deleteToolset_gateway_error_response_body
-func ValidateDeleteToolsetGatewayErrorResponseBody(body *DeleteToolsetGatewayErrorResponseBody) (err error) {
+// ValidateListToolFiltersGatewayErrorResponseBody runs the validations defined
+// on listToolFilters_gateway_error_response_body
+func ValidateListToolFiltersGatewayErrorResponseBody(body *ListToolFiltersGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9308,9 +10908,9 @@ func ValidateDeleteToolsetGatewayErrorResponseBody(body *DeleteToolsetGatewayErr
 	return
 }
 
-// ValidateGetToolsetUnauthorizedResponseBody runs the validations defined on
-// getToolset_unauthorized_response_body
-func ValidateGetToolsetUnauthorizedResponseBody(body *GetToolsetUnauthorizedResponseBody) (err error) {
+// ValidateCheckMCPSlugAvailabilityUnauthorizedResponseBody runs the
+// validations defined on checkMCPSlugAvailability_unauthorized_response_body
+func ValidateCheckMCPSlugAvailabilityUnauthorizedResponseBody(body *CheckMCPSlugAvailabilityUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9332,9 +10932,9 @@ func ValidateGetToolsetUnauthorizedResponseBody(body *GetToolsetUnauthorizedResp
 	return
 }
 
-// ValidateGetToolsetForbiddenResponseBody runs the validations defined on
-// getToolset_forbidden_response_body
-func ValidateGetToolsetForbiddenResponseBody(body *GetToolsetForbiddenResponseBody) (err error) {
+// ValidateCheckMCPSlugAvailabilityForbiddenResponseBody runs the validations
+// defined on checkMCPSlugAvailability_forbidden_response_body
+func ValidateCheckMCPSlugAvailabilityForbiddenResponseBody(body *CheckMCPSlugAvailabilityForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9356,9 +10956,9 @@ func ValidateGetToolsetForbiddenResponseBody(body *GetToolsetForbiddenResponseBo
 	return
 }
 
-// ValidateGetToolsetBadRequestResponseBody runs the validations defined on
-// getToolset_bad_request_response_body
-func ValidateGetToolsetBadRequestResponseBody(body *GetToolsetBadRequestResponseBody) (err error) {
+// ValidateCheckMCPSlugAvailabilityBadRequestResponseBody runs the validations
+// defined on checkMCPSlugAvailability_bad_request_response_body
+func ValidateCheckMCPSlugAvailabilityBadRequestResponseBody(body *CheckMCPSlugAvailabilityBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9380,9 +10980,9 @@ func ValidateGetToolsetBadRequestResponseBody(body *GetToolsetBadRequestResponse
 	return
 }
 
-// ValidateGetToolsetNotFoundResponseBody runs the validations defined on
-// getToolset_not_found_response_body
-func ValidateGetToolsetNotFoundResponseBody(body *GetToolsetNotFoundResponseBody) (err error) {
+// ValidateCheckMCPSlugAvailabilityNotFoundResponseBody runs the validations
+// defined on checkMCPSlugAvailability_not_found_response_body
+func ValidateCheckMCPSlugAvailabilityNotFoundResponseBody(body *CheckMCPSlugAvailabilityNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9404,9 +11004,9 @@ func ValidateGetToolsetNotFoundResponseBody(body *GetToolsetNotFoundResponseBody
 	return
 }
 
-// ValidateGetToolsetConflictResponseBody runs the validations defined on
-// getToolset_conflict_response_body
-func ValidateGetToolsetConflictResponseBody(body *GetToolsetConflictResponseBody) (err error) {
+// ValidateCheckMCPSlugAvailabilityConflictResponseBody runs the validations
+// defined on checkMCPSlugAvailability_conflict_response_body
+func ValidateCheckMCPSlugAvailabilityConflictResponseBody(body *CheckMCPSlugAvailabilityConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9428,9 +11028,10 @@ func ValidateGetToolsetConflictResponseBody(body *GetToolsetConflictResponseBody
 	return
 }
 
-// ValidateGetToolsetUnsupportedMediaResponseBody runs the validations defined
-// on getToolset_unsupported_media_response_body
-func ValidateGetToolsetUnsupportedMediaResponseBody(body *GetToolsetUnsupportedMediaResponseBody) (err error) {
+// ValidateCheckMCPSlugAvailabilityUnsupportedMediaResponseBody runs the
+// validations defined on
+// checkMCPSlugAvailability_unsupported_media_response_body
+func ValidateCheckMCPSlugAvailabilityUnsupportedMediaResponseBody(body *CheckMCPSlugAvailabilityUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9452,9 +11053,9 @@ func ValidateGetToolsetUnsupportedMediaResponseBody(body *GetToolsetUnsupportedM
 	return
 }
 
-// ValidateGetToolsetInvalidResponseBody runs the validations defined on
-// getToolset_invalid_response_body
-func ValidateGetToolsetInvalidResponseBody(body *GetToolsetInvalidResponseBody) (err error) {
+// ValidateCheckMCPSlugAvailabilityInvalidResponseBody runs the validations
+// defined on checkMCPSlugAvailability_invalid_response_body
+func ValidateCheckMCPSlugAvailabilityInvalidResponseBody(body *CheckMCPSlugAvailabilityInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9476,9 +11077,10 @@ func ValidateGetToolsetInvalidResponseBody(body *GetToolsetInvalidResponseBody)
 	return
 }
 
-// ValidateGetToolsetInvariantViolationResponseBody runs the validations
-// defined on getToolset_invariant_violation_response_body
-func ValidateGetToolsetInvariantViolationResponseBody(body *GetToolsetInvariantViolationResponseBody) (err error) {
+// ValidateCheckMCPSlugAvailabilityInvariantViolationResponseBody runs the
+// validations defined on
+// checkMCPSlugAvailability_invariant_violation_response_body
+func ValidateCheckMCPSlugAvailabilityInvariantViolationResponseBody(body *CheckMCPSlugAvailabilityInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9500,9 +11102,9 @@ func ValidateGetToolsetInvariantViolationResponseBody(body *GetToolsetInvariantV
 	return
 }
 
-// ValidateGetToolsetUnexpectedResponseBody runs the validations defined on
-// getToolset_unexpected_response_body
-func ValidateGetToolsetUnexpectedResponseBody(body *GetToolsetUnexpectedResponseBody) (err error) {
+// ValidateCheckMCPSlugAvailabilityUnexpectedResponseBody runs the validations
+// defined on checkMCPSlugAvailability_unexpected_response_body
+func ValidateCheckMCPSlugAvailabilityUnexpectedResponseBody(body *CheckMCPSlugAvailabilityUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9524,9 +11126,9 @@ func ValidateGetToolsetUnexpectedResponseBody(body *GetToolsetUnexpectedResponse
 	return
 }
 
-// ValidateGetToolsetGatewayErrorResponseBody runs the validations defined on
-// getToolset_gateway_error_response_body
-func ValidateGetToolsetGatewayErrorResponseBody(body *GetToolsetGatewayErrorResponseBody) (err error) {
+// ValidateCheckMCPSlugAvailabilityGatewayErrorResponseBody runs the
+// validations defined on checkMCPSlugAvailability_gateway_error_response_body
+func ValidateCheckMCPSlugAvailabilityGatewayErrorResponseBody(body *CheckMCPSlugAvailabilityGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9548,9 +11150,9 @@ func ValidateGetToolsetGatewayErrorResponseBody(body *GetToolsetGatewayErrorResp
 	return
 }
 
-// ValidateListToolFiltersUnauthorizedResponseBody runs the validations defined
-// on listToolFilters_unauthorized_response_body
-func ValidateListToolFiltersUnauthorizedResponseBody(body *ListToolFiltersUnauthorizedResponseBody) (err error) {
+// ValidateCloneToolsetUnauthorizedResponseBody runs the validations defined on
+// cloneToolset_unauthorized_response_body
+func ValidateCloneToolsetUnauthorizedResponseBody(body *CloneToolsetUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9572,9 +11174,9 @@ func ValidateListToolFiltersUnauthorizedResponseBody(body *ListToolFiltersUnauth
 	return
 }
 
-// ValidateListToolFiltersForbiddenResponseBody runs the validations defined on
-// listToolFilters_forbidden_response_body
-func ValidateListToolFiltersForbiddenResponseBody(body *ListToolFiltersForbiddenResponseBody) (err error) {
+// ValidateCloneToolsetForbiddenResponseBody runs the validations defined on
+// cloneToolset_forbidden_response_body
+func ValidateCloneToolsetForbiddenResponseBody(body *CloneToolsetForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9596,9 +11198,9 @@ func ValidateListToolFiltersForbiddenResponseBody(body *ListToolFiltersForbidden
 	return
 }
 
-// ValidateListToolFiltersBadRequestResponseBody runs the validations defined
-// on listToolFilters_bad_request_response_body
-func ValidateListToolFiltersBadRequestResponseBody(body *ListToolFiltersBadRequestResponseBody) (err error) {
+// ValidateCloneToolsetBadRequestResponseBody runs the validations defined on
+// cloneToolset_bad_request_response_body
+func ValidateCloneToolsetBadRequestResponseBody(body *CloneToolsetBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9620,9 +11222,9 @@ func ValidateListToolFiltersBadRequestResponseBody(body *ListToolFiltersBadReque
 	return
 }
 
-// ValidateListToolFiltersNotFoundResponseBody runs the validations defined on
-// listToolFilters_not_found_response_body
-func ValidateListToolFiltersNotFoundResponseBody(body *ListToolFiltersNotFoundResponseBody) (err error) {
+// ValidateCloneToolsetNotFoundResponseBody runs the validations defined on
+// cloneToolset_not_found_response_body
+func ValidateCloneToolsetNotFoundResponseBody(body *CloneToolsetNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9644,9 +11246,9 @@ func ValidateListToolFiltersNotFoundResponseBody(body *ListToolFiltersNotFoundRe
 	return
 }
 
-// ValidateListToolFiltersConflictResponseBody runs the validations defined on
-// listToolFilters_conflict_response_body
-func ValidateListToolFiltersConflictResponseBody(body *ListToolFiltersConflictResponseBody) (err error) {
+// ValidateCloneToolsetConflictResponseBody runs the validations defined on
+// cloneToolset_conflict_response_body
+func ValidateCloneToolsetConflictResponseBody(body *CloneToolsetConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9668,9 +11270,9 @@ func ValidateListToolFiltersConflictResponseBody(body *ListToolFiltersConflictRe
 	return
 }
 
-// ValidateListToolFiltersUnsupportedMediaResponseBody runs the validations
-// defined on listToolFilters_unsupported_media_response_body
-func ValidateListToolFiltersUnsupportedMediaResponseBody(body *ListToolFiltersUnsupportedMediaResponseBody) (err error) {
+// ValidateCloneToolsetUnsupportedMediaResponseBody runs the validations
+// defined on cloneToolset_unsupported_media_response_body
+func ValidateCloneToolsetUnsupportedMediaResponseBody(body *CloneToolsetUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9692,9 +11294,9 @@ func ValidateListToolFiltersUnsupportedMediaResponseBody(body *ListToolFiltersUn
 	return
 }
 
-// ValidateListToolFiltersInvalidResponseBody runs the validations defined on
-// listToolFilters_invalid_response_body
-func ValidateListToolFiltersInvalidResponseBody(body *ListToolFiltersInvalidResponseBody) (err error) {
+// ValidateCloneToolsetInvalidResponseBody runs the validations defined on
+// cloneToolset_invalid_response_body
+func ValidateCloneToolsetInvalidResponseBody(body *CloneToolsetInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9716,9 +11318,9 @@ func ValidateListToolFiltersInvalidResponseBody(body *ListToolFiltersInvalidResp
 	return
 }
 
-// ValidateListToolFiltersInvariantViolationResponseBody runs the validations
-// defined on listToolFilters_invariant_violation_response_body
-func ValidateListToolFiltersInvariantViolationResponseBody(body *ListToolFiltersInvariantViolationResponseBody) (err error) {
+// ValidateCloneToolsetInvariantViolationResponseBody runs the validations
+// defined on cloneToolset_invariant_violation_response_body
+func ValidateCloneToolsetInvariantViolationResponseBody(body *CloneToolsetInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9740,9 +11342,9 @@ func ValidateListToolFiltersInvariantViolationResponseBody(body *ListToolFilters
 	return
 }
 
-// ValidateListToolFiltersUnexpectedResponseBody runs the validations defined
-// on listToolFilters_unexpected_response_body
-func ValidateListToolFiltersUnexpectedResponseBody(body *ListToolFiltersUnexpectedResponseBody) (err error) {
+// ValidateCloneToolsetUnexpectedResponseBody runs the validations defined on
+// cloneToolset_unexpected_response_body
+func ValidateCloneToolsetUnexpectedResponseBody(body *CloneToolsetUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9764,9 +11366,9 @@ func ValidateListToolFiltersUnexpectedResponseBody(body *ListToolFiltersUnexpect
 	return
 }
 
-// ValidateListToolFiltersGatewayErrorResponseBody runs the validations defined
-// on listToolFilters_gateway_error_response_body
-func ValidateListToolFiltersGatewayErrorResponseBody(body *ListToolFiltersGatewayErrorResponseBody) (err error) {
+// ValidateCloneToolsetGatewayErrorResponseBody runs the validations defined on
+// cloneToolset_gateway_error_response_body
+func ValidateCloneToolsetGatewayErrorResponseBody(body *CloneToolsetGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9788,9 +11390,9 @@ func ValidateListToolFiltersGatewayErrorResponseBody(body *ListToolFiltersGatewa
 	return
 }
 
-// ValidateCheckMCPSlugAvailabilityUnauthorizedResponseBody runs the
-// validations defined on checkMCPSlugAvailability_unauthorized_response_body
-func ValidateCheckMCPSlugAvailabilityUnauthorizedResponseBody(body *CheckMCPSlugAvailabilityUnauthorizedResponseBody) (err error) {
+// ValidateAddExternalOAuthServerUnauthorizedResponseBody runs the validations
+// defined on addExternalOAuthServer_unauthorized_response_body
+func ValidateAddExternalOAuthServerUnauthorizedResponseBody(body *AddExternalOAuthServerUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9812,9 +11414,9 @@ func ValidateCheckMCPSlugAvailabilityUnauthorizedResponseBody(body *CheckMCPSlug
 	return
 }
 
-// ValidateCheckMCPSlugAvailabilityForbiddenResponseBody runs the validations
-// defined on checkMCPSlugAvailability_forbidden_response_body
-func ValidateCheckMCPSlugAvailabilityForbiddenResponseBody(body *CheckMCPSlugAvailabilityForbiddenResponseBody) (err error) {
+// ValidateAddExternalOAuthServerForbiddenResponseBody runs the validations
+// defined on addExternalOAuthServer_forbidden_response_body
+func ValidateAddExternalOAuthServerForbiddenResponseBody(body *AddExternalOAuthServerForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9836,9 +11438,9 @@ func ValidateCheckMCPSlugAvailabilityForbiddenResponseBody(body *CheckMCPSlugAva
 	return
 }
 
-// ValidateCheckMCPSlugAvailabilityBadRequestResponseBody runs the validations
-// defined on checkMCPSlugAvailability_bad_request_response_body
-func ValidateCheckMCPSlugAvailabilityBadRequestResponseBody(body *CheckMCPSlugAvailabilityBadRequestResponseBody) (err error) {
+// ValidateAddExternalOAuthServerBadRequestResponseBody runs the validations
+// defined on addExternalOAuthServer_bad_request_response_body
+func ValidateAddExternalOAuthServerBadRequestResponseBody(body *AddExternalOAuthServerBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9860,9 +11462,9 @@ func ValidateCheckMCPSlugAvailabilityBadRequestResponseBody(body *CheckMCPSlugAv
 	return
 }
 
-// ValidateCheckMCPSlugAvailabilityNotFoundResponseBody runs the validations
-// defined on checkMCPSlugAvailability_not_found_response_body
-func ValidateCheckMCPSlugAvailabilityNotFoundResponseBody(body *CheckMCPSlugAvailabilityNotFoundResponseBody) (err error) {
+// ValidateAddExternalOAuthServerNotFoundResponseBody runs the validations
+// defined on addExternalOAuthServer_not_found_response_body
+func ValidateAddExternalOAuthServerNotFoundResponseBody(body *AddExternalOAuthServerNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9884,9 +11486,9 @@ func ValidateCheckMCPSlugAvailabilityNotFoundResponseBody(body *CheckMCPSlugAvai
 	return
 }
 
-// ValidateCheckMCPSlugAvailabilityConflictResponseBody runs the validations
-// defined on checkMCPSlugAvailability_conflict_response_body
-func ValidateCheckMCPSlugAvailabilityConflictResponseBody(body *CheckMCPSlugAvailabilityConflictResponseBody) (err error) {
+// ValidateAddExternalOAuthServerConflictResponseBody runs the validations
+// defined on addExternalOAuthServer_conflict_response_body
+func ValidateAddExternalOAuthServerConflictResponseBody(body *AddExternalOAuthServerConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9908,10 +11510,9 @@ func ValidateCheckMCPSlugAvailabilityConflictResponseBody(body *CheckMCPSlugAvai
 	return
 }
 
-// ValidateCheckMCPSlugAvailabilityUnsupportedMediaResponseBody runs the
-// validations defined on
-// checkMCPSlugAvailability_unsupported_media_response_body
-func ValidateCheckMCPSlugAvailabilityUnsupportedMediaResponseBody(body *CheckMCPSlugAvailabilityUnsupportedMediaResponseBody) (err error) {
+// ValidateAddExternalOAuthServerUnsupportedMediaResponseBody runs the
+// validations defined on addExternalOAuthServer_unsupported_media_response_body
+func ValidateAddExternalOAuthServerUnsupportedMediaResponseBody(body *AddExternalOAuthServerUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9933,9 +11534,9 @@ func ValidateCheckMCPSlugAvailabilityUnsupportedMediaResponseBody(body *CheckMCP
 	return
 }
 
-// ValidateCheckMCPSlugAvailabilityInvalidResponseBody runs the validations
-// defined on checkMCPSlugAvailability_invalid_response_body
-func ValidateCheckMCPSlugAvailabilityInvalidResponseBody(body *CheckMCPSlugAvailabilityInvalidResponseBody) (err error) {
+// ValidateAddExternalOAuthServerInvalidResponseBody runs the validations
+// defined on addExternalOAuthServer_invalid_response_body
+func ValidateAddExternalOAuthServerInvalidResponseBody(body *AddExternalOAuthServerInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9957,10 +11558,10 @@ func ValidateCheckMCPSlugAvailabilityInvalidResponseBody(body *CheckMCPSlugAvail
 	return
 }
 
-// ValidateCheckMCPSlugAvailabilityInvariantViolationResponseBody runs the
+// ValidateAddExternalOAuthServerInvariantViolationResponseBody runs the
 // validations defined on
-// checkMCPSlugAvailability_invariant_violation_response_body
-func ValidateCheckMCPSlugAvailabilityInvariantViolationResponseBody(body *CheckMCPSlugAvailabilityInvariantViolationResponseBody) (err error) {
+// addExternalOAuthServer_invariant_violation_response_body
+func ValidateAddExternalOAuthServerInvariantViolationResponseBody(body *AddExternalOAuthServerInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -9982,9 +11583,9 @@ func ValidateCheckMCPSlugAvailabilityInvariantViolationResponseBody(body *CheckM
 	return
 }
 
-// ValidateCheckMCPSlugAvailabilityUnexpectedResponseBody runs the validations
-// defined on checkMCPSlugAvailability_unexpected_response_body
-func ValidateCheckMCPSlugAvailabilityUnexpectedResponseBody(body *CheckMCPSlugAvailabilityUnexpectedResponseBody) (err error) {
+// ValidateAddExternalOAuthServerUnexpectedResponseBody runs the validations
+// defined on addExternalOAuthServer_unexpected_response_body
+func ValidateAddExternalOAuthServerUnexpectedResponseBody(body *AddExternalOAuthServerUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10006,9 +11607,9 @@ func ValidateCheckMCPSlugAvailabilityUnexpectedResponseBody(body *CheckMCPSlugAv
 	return
 }
 
-// ValidateCheckMCPSlugAvailabilityGatewayErrorResponseBody runs the
-// validations defined on checkMCPSlugAvailability_gateway_error_response_body
-func ValidateCheckMCPSlugAvailabilityGatewayErrorResponseBody(body *CheckMCPSlugAvailabilityGatewayErrorResponseBody) (err error) {
+// ValidateAddExternalOAuthServerGatewayErrorResponseBody runs the validations
+// defined on addExternalOAuthServer_gateway_error_response_body
+func ValidateAddExternalOAuthServerGatewayErrorResponseBody(body *AddExternalOAuthServerGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10030,9 +11631,9 @@ func ValidateCheckMCPSlugAvailabilityGatewayErrorResponseBody(body *CheckMCPSlug
 	return
 }
 
-// ValidateCloneToolsetUnauthorizedResponseBody runs the validations defined on
-// cloneToolset_unauthorized_response_body
-func ValidateCloneToolsetUnauthorizedResponseBody(body *CloneToolsetUnauthorizedResponseBody) (err error) {
+// ValidateRemoveOAuthServerUnauthorizedResponseBody runs the validations
+// defined on removeOAuthServer_unauthorized_response_body
+func ValidateRemoveOAuthServerUnauthorizedResponseBody(body *RemoveOAuthServerUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10054,9 +11655,9 @@ func ValidateCloneToolsetUnauthorizedResponseBody(body *CloneToolsetUnauthorized
 	return
 }
 
-// ValidateCloneToolsetForbiddenResponseBody runs the validations defined on
-// cloneToolset_forbidden_response_body
-func ValidateCloneToolsetForbiddenResponseBody(body *CloneToolsetForbiddenResponseBody) (err error) {
+// ValidateRemoveOAuthServerForbiddenResponseBody runs the validations defined
+// on removeOAuthServer_forbidden_response_body
+func ValidateRemoveOAuthServerForbiddenResponseBody(body *RemoveOAuthServerForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10078,9 +11679,9 @@ func ValidateCloneToolsetForbiddenResponseBody(body *CloneToolsetForbiddenRespon
 	return
 }
 
-// ValidateCloneToolsetBadRequestResponseBody runs the validations defined on
-// cloneToolset_bad_request_response_body
-func ValidateCloneToolsetBadRequestResponseBody(body *CloneToolsetBadRequestResponseBody) (err error) {
+// ValidateRemoveOAuthServerBadRequestResponseBody runs the validations defined
+// on removeOAuthServer_bad_request_response_body
+func ValidateRemoveOAuthServerBadRequestResponseBody(body *RemoveOAuthServerBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10102,9 +11703,9 @@ func ValidateCloneToolsetBadRequestResponseBody(body *CloneToolsetBadRequestResp
 	return
 }
 
-// ValidateCloneToolsetNotFoundResponseBody runs the validations defined on
-// cloneToolset_not_found_response_body
-func ValidateCloneToolsetNotFoundResponseBody(body *CloneToolsetNotFoundResponseBody) (err error) {
+// ValidateRemoveOAuthServerNotFoundResponseBody runs the validations defined
+// on removeOAuthServer_not_found_response_body
+func ValidateRemoveOAuthServerNotFoundResponseBody(body *RemoveOAuthServerNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10126,9 +11727,9 @@ func ValidateCloneToolsetNotFoundResponseBody(body *CloneToolsetNotFoundResponse
 	return
 }
 
-// ValidateCloneToolsetConflictResponseBody runs the validations defined on
-// cloneToolset_conflict_response_body
-func ValidateCloneToolsetConflictResponseBody(body *CloneToolsetConflictResponseBody) (err error) {
+// ValidateRemoveOAuthServerConflictResponseBody runs the validations defined
+// on removeOAuthServer_conflict_response_body
+func ValidateRemoveOAuthServerConflictResponseBody(body *RemoveOAuthServerConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10150,9 +11751,9 @@ func ValidateCloneToolsetConflictResponseBody(body *CloneToolsetConflictResponse
 	return
 }
 
-// ValidateCloneToolsetUnsupportedMediaResponseBody runs the validations
-// defined on cloneToolset_unsupported_media_response_body
-func ValidateCloneToolsetUnsupportedMediaResponseBody(body *CloneToolsetUnsupportedMediaResponseBody) (err error) {
+// ValidateRemoveOAuthServerUnsupportedMediaResponseBody runs the validations
+// defined on removeOAuthServer_unsupported_media_response_body
+func ValidateRemoveOAuthServerUnsupportedMediaResponseBody(body *RemoveOAuthServerUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10174,9 +11775,9 @@ func ValidateCloneToolsetUnsupportedMediaResponseBody(body *CloneToolsetUnsuppor
 	return
 }
 
-// ValidateCloneToolsetInvalidResponseBody runs the validations defined on
-// cloneToolset_invalid_response_body
-func ValidateCloneToolsetInvalidResponseBody(body *CloneToolsetInvalidResponseBody) (err error) {
+// ValidateRemoveOAuthServerInvalidResponseBody runs the validations defined on
+// removeOAuthServer_invalid_response_body
+func ValidateRemoveOAuthServerInvalidResponseBody(body *RemoveOAuthServerInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10198,9 +11799,9 @@ func ValidateCloneToolsetInvalidResponseBody(body *CloneToolsetInvalidResponseBo
 	return
 }
 
-// ValidateCloneToolsetInvariantViolationResponseBody runs the validations
-// defined on cloneToolset_invariant_violation_response_body
-func ValidateCloneToolsetInvariantViolationResponseBody(body *CloneToolsetInvariantViolationResponseBody) (err error) {
+// ValidateRemoveOAuthServerInvariantViolationResponseBody runs the validations
+// defined on removeOAuthServer_invariant_violation_response_body
+func ValidateRemoveOAuthServerInvariantViolationResponseBody(body *RemoveOAuthServerInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10222,9 +11823,9 @@ func ValidateCloneToolsetInvariantViolationResponseBody(body *CloneToolsetInvari
 	return
 }
 
-// ValidateCloneToolsetUnexpectedResponseBody runs the validations defined on
-// cloneToolset_unexpected_response_body
-func ValidateCloneToolsetUnexpectedResponseBody(body *CloneToolsetUnexpectedResponseBody) (err error) {
+// ValidateRemoveOAuthServerUnexpectedResponseBody runs the validations defined
+// on removeOAuthServer_unexpected_response_body
+func ValidateRemoveOAuthServerUnexpectedResponseBody(body *RemoveOAuthServerUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10246,9 +11847,9 @@ func ValidateCloneToolsetUnexpectedResponseBody(body *CloneToolsetUnexpectedResp
 	return
 }
 
-// ValidateCloneToolsetGatewayErrorResponseBody runs the validations defined on
-// cloneToolset_gateway_error_response_body
-func ValidateCloneToolsetGatewayErrorResponseBody(body *CloneToolsetGatewayErrorResponseBody) (err error) {
+// ValidateRemoveOAuthServerGatewayErrorResponseBody runs the validations
+// defined on removeOAuthServer_gateway_error_response_body
+func ValidateRemoveOAuthServerGatewayErrorResponseBody(body *RemoveOAuthServerGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10270,9 +11871,9 @@ func ValidateCloneToolsetGatewayErrorResponseBody(body *CloneToolsetGatewayError
 	return
 }
 
-// ValidateAddExternalOAuthServerUnauthorizedResponseBody runs the validations
-// defined on addExternalOAuthServer_unauthorized_response_body
-func ValidateAddExternalOAuthServerUnauthorizedResponseBody(body *AddExternalOAuthServerUnauthorizedResponseBody) (err error) {
+// ValidateSetUserSessionIssuerUnauthorizedResponseBody runs the validations
+// defined on setUserSessionIssuer_unauthorized_response_body
+func ValidateSetUserSessionIssuerUnauthorizedResponseBody(body *SetUserSessionIssuerUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10294,9 +11895,9 @@ func ValidateAddExternalOAuthServerUnauthorizedResponseBody(body *AddExternalOAu
 	return
 }
 
-// ValidateAddExternalOAuthServerForbiddenResponseBody runs the validations
-// defined on addExternalOAuthServer_forbidden_response_body
-func ValidateAddExternalOAuthServerForbiddenResponseBody(body *AddExternalOAuthServerForbiddenResponseBody) (err error) {
+// ValidateSetUserSessionIssuerForbiddenResponseBody runs the validations
+// defined on setUserSessionIssuer_forbidden_response_body
+func ValidateSetUserSessionIssuerForbiddenResponseBody(body *SetUserSessionIssuerForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10318,9 +11919,9 @@ func ValidateAddExternalOAuthServerForbiddenResponseBody(body *AddExternalOAuthS
 	return
 }
 
-// ValidateAddExternalOAuthServerBadRequestResponseBody runs the validations
-// defined on addExternalOAuthServer_bad_request_response_body
-func ValidateAddExternalOAuthServerBadRequestResponseBody(body *AddExternalOAuthServerBadRequestResponseBody) (err error) {
+// ValidateSetUserSessionIssuerBadRequestResponseBody runs the validations
+// defined on setUserSessionIssuer_bad_request_response_body
+func ValidateSetUserSessionIssuerBadRequestResponseBody(body *SetUserSessionIssuerBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10342,9 +11943,9 @@ func ValidateAddExternalOAuthServerBadRequestResponseBody(body *AddExternalOAuth
 	return
 }
 
-// ValidateAddExternalOAuthServerNotFoundResponseBody runs the validations
-// defined on addExternalOAuthServer_not_found_response_body
-func ValidateAddExternalOAuthServerNotFoundResponseBody(body *AddExternalOAuthServerNotFoundResponseBody) (err error) {
+// ValidateSetUserSessionIssuerNotFoundResponseBody runs the validations
+// defined on setUserSessionIssuer_not_found_response_body
+func ValidateSetUserSessionIssuerNotFoundResponseBody(body *SetUserSessionIssuerNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10366,9 +11967,9 @@ func ValidateAddExternalOAuthServerNotFoundResponseBody(body *AddExternalOAuthSe
 	return
 }
 
-// ValidateAddExternalOAuthServerConflictResponseBody runs the validations
-// defined on addExternalOAuthServer_conflict_response_body
-func ValidateAddExternalOAuthServerConflictResponseBody(body *AddExternalOAuthServerConflictResponseBody) (err error) {
+// ValidateSetUserSessionIssuerConflictResponseBody runs the validations
+// defined on setUserSessionIssuer_conflict_response_body
+func ValidateSetUserSessionIssuerConflictResponseBody(body *SetUserSessionIssuerConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10390,9 +11991,9 @@ func ValidateAddExternalOAuthServerConflictResponseBody(body *AddExternalOAuthSe
 	return
 }
 
-// ValidateAddExternalOAuthServerUnsupportedMediaResponseBody runs the
-// validations defined on addExternalOAuthServer_unsupported_media_response_body
-func ValidateAddExternalOAuthServerUnsupportedMediaResponseBody(body *AddExternalOAuthServerUnsupportedMediaResponseBody) (err error) {
+// ValidateSetUserSessionIssuerUnsupportedMediaResponseBody runs the
+// validations defined on setUserSessionIssuer_unsupported_media_response_body
+func ValidateSetUserSessionIssuerUnsupportedMediaResponseBody(body *SetUserSessionIssuerUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10414,9 +12015,9 @@ func ValidateAddExternalOAuthServerUnsupportedMediaResponseBody(body *AddExterna
 	return
 }
 
-// ValidateAddExternalOAuthServerInvalidResponseBody runs the validations
-// defined on addExternalOAuthServer_invalid_response_body
-func ValidateAddExternalOAuthServerInvalidResponseBody(body *AddExternalOAuthServerInvalidResponseBody) (err error) {
+// ValidateSetUserSessionIssuerInvalidResponseBody runs the validations defined
+// on setUserSessionIssuer_invalid_response_body
+func ValidateSetUserSessionIssuerInvalidResponseBody(body *SetUserSessionIssuerInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10438,10 +12039,9 @@ func ValidateAddExternalOAuthServerInvalidResponseBody(body *AddExternalOAuthSer
 	return
 }
 
-// ValidateAddExternalOAuthServerInvariantViolationResponseBody runs the
-// validations defined on
-// addExternalOAuthServer_invariant_violation_response_body
-func ValidateAddExternalOAuthServerInvariantViolationResponseBody(body *AddExternalOAuthServerInvariantViolationResponseBody) (err error) {
+// ValidateSetUserSessionIssuerInvariantViolationResponseBody runs the
+// validations defined on setUserSessionIssuer_invariant_violation_response_body
+func ValidateSetUserSessionIssuerInvariantViolationResponseBody(body *SetUserSessionIssuerInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10463,9 +12063,9 @@ func ValidateAddExternalOAuthServerInvariantViolationResponseBody(body *AddExter
 	return
 }
 
-// ValidateAddExternalOAuthServerUnexpectedResponseBody runs the validations
-// defined on addExternalOAuthServer_unexpected_response_body
-func ValidateAddExternalOAuthServerUnexpectedResponseBody(body *AddExternalOAuthServerUnexpectedResponseBody) (err error) {
+// ValidateSetUserSessionIssuerUnexpectedResponseBody runs the validations
+// defined on setUserSessionIssuer_unexpected_response_body
+func ValidateSetUserSessionIssuerUnexpectedResponseBody(body *SetUserSessionIssuerUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10487,9 +12087,9 @@ func ValidateAddExternalOAuthServerUnexpectedResponseBody(body *AddExternalOAuth
 	return
 }
 
-// ValidateAddExternalOAuthServerGatewayErrorResponseBody runs the validations
-// defined on addExternalOAuthServer_gateway_error_response_body
-func ValidateAddExternalOAuthServerGatewayErrorResponseBody(body *AddExternalOAuthServerGatewayErrorResponseBody) (err error) {
+// ValidateSetUserSessionIssuerGatewayErrorResponseBody runs the validations
+// defined on setUserSessionIssuer_gateway_error_response_body
+func ValidateSetUserSessionIssuerGatewayErrorResponseBody(body *SetUserSessionIssuerGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10511,9 +12111,9 @@ func ValidateAddExternalOAuthServerGatewayErrorResponseBody(body *AddExternalOAu
 	return
 }
 
-// ValidateRemoveOAuthServerUnauthorizedResponseBody runs the validations
-// defined on removeOAuthServer_unauthorized_response_body
-func ValidateRemoveOAuthServerUnauthorizedResponseBody(body *RemoveOAuthServerUnauthorizedResponseBody) (err error) {
+// ValidateSetToolVariationsGroupUnauthorizedResponseBody runs the validations
+// defined on setToolVariationsGroup_unauthorized_response_body
+func ValidateSetToolVariationsGroupUnauthorizedResponseBody(body *SetToolVariationsGroupUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10535,9 +12135,9 @@ func ValidateRemoveOAuthServerUnauthorizedResponseBody(body *RemoveOAuthServerUn
 	return
 }
 
-// ValidateRemoveOAuthServerForbiddenResponseBody runs the validations defined
-// on removeOAuthServer_forbidden_response_body
-func ValidateRemoveOAuthServerForbiddenResponseBody(body *RemoveOAuthServerForbiddenResponseBody) (err error) {
+// ValidateSetToolVariationsGroupForbiddenResponseBody runs the validations
+// defined on setToolVariationsGroup_forbidden_response_body
+func ValidateSetToolVariationsGroupForbiddenResponseBody(body *SetToolVariationsGroupForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10559,9 +12159,9 @@ func ValidateRemoveOAuthServerForbiddenResponseBody(body *RemoveOAuthServerForbi
 	return
 }
 
-// ValidateRemoveOAuthServerBadRequestResponseBody runs the validations defined
-// on removeOAuthServer_bad_request_response_body
-func ValidateRemoveOAuthServerBadRequestResponseBody(body *RemoveOAuthServerBadRequestResponseBody) (err error) {
+// ValidateSetToolVariationsGroupBadRequestResponseBody runs the validations
+// defined on setToolVariationsGroup_bad_request_response_body
+func ValidateSetToolVariationsGroupBadRequestResponseBody(body *SetToolVariationsGroupBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10583,9 +12183,9 @@ func ValidateRemoveOAuthServerBadRequestResponseBody(body *RemoveOAuthServerBadR
 	return
 }
 
-// ValidateRemoveOAuthServerNotFoundResponseBody runs the validations defined
-// on removeOAuthServer_not_found_response_body
-func ValidateRemoveOAuthServerNotFoundResponseBody(body *RemoveOAuthServerNotFoundResponseBody) (err error) {
+// ValidateSetToolVariationsGroupNotFoundResponseBody runs the validations
+// defined on setToolVariationsGroup_not_found_response_body
+func ValidateSetToolVariationsGroupNotFoundResponseBody(body *SetToolVariationsGroupNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10607,9 +12207,9 @@ func ValidateRemoveOAuthServerNotFoundResponseBody(body *RemoveOAuthServerNotFou
 	return
 }
 
-// ValidateRemoveOAuthServerConflictResponseBody runs the validations defined
-// on removeOAuthServer_conflict_response_body
-func ValidateRemoveOAuthServerConflictResponseBody(body *RemoveOAuthServerConflictResponseBody) (err error) {
+// ValidateSetToolVariationsGroupConflictResponseBody runs the validations
+// defined on setToolVariationsGroup_conflict_response_body
+func ValidateSetToolVariationsGroupConflictResponseBody(body *SetToolVariationsGroupConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10631,9 +12231,9 @@ func ValidateRemoveOAuthServerConflictResponseBody(body *RemoveOAuthServerConfli
 	return
 }
 
-// ValidateRemoveOAuthServerUnsupportedMediaResponseBody runs the validations
-// defined on removeOAuthServer_unsupported_media_response_body
-func ValidateRemoveOAuthServerUnsupportedMediaResponseBody(body *RemoveOAuthServerUnsupportedMediaResponseBody) (err error) {
+// ValidateSetToolVariationsGroupUnsupportedMediaResponseBody runs the
+// validations defined on setToolVariationsGroup_unsupported_media_response_body
+func ValidateSetToolVariationsGroupUnsupportedMediaResponseBody(body *SetToolVariationsGroupUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10655,9 +12255,9 @@ func ValidateRemoveOAuthServerUnsupportedMediaResponseBody(body *RemoveOAuthServ
 	return
 }
 
-// ValidateRemoveOAuthServerInvalidResponseBody runs the validations defined on
-// removeOAuthServer_invalid_response_body
-func ValidateRemoveOAuthServerInvalidResponseBody(body *RemoveOAuthServerInvalidResponseBody) (err error) {
+// ValidateSetToolVariationsGroupInvalidResponseBody runs the validations
+// defined on setToolVariationsGroup_invalid_response_body
+func ValidateSetToolVariationsGroupInvalidResponseBody(body *SetToolVariationsGroupInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10679,9 +12279,10 @@ func ValidateRemoveOAuthServerInvalidResponseBody(body *RemoveOAuthServerInvalid
 	return
 }
 
-// ValidateRemoveOAuthServerInvariantViolationResponseBody runs the validations
-// defined on removeOAuthServer_invariant_violation_response_body
-func ValidateRemoveOAuthServerInvariantViolationResponseBody(body *RemoveOAuthServerInvariantViolationResponseBody) (err error) {
+// ValidateSetToolVariationsGroupInvariantViolationResponseBody runs the
+// validations defined on
+// setToolVariationsGroup_invariant_violation_response_body
+func ValidateSetToolVariationsGroupInvariantViolationResponseBody(body *SetToolVariationsGroupInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10703,9 +12304,9 @@ func ValidateRemoveOAuthServerInvariantViolationResponseBody(body *RemoveOAuthSe
 	return
 }
 
-// ValidateRemoveOAuthServerUnexpectedResponseBody runs the validations defined
-// on removeOAuthServer_unexpected_response_body
-func ValidateRemoveOAuthServerUnexpectedResponseBody(body *RemoveOAuthServerUnexpectedResponseBody) (err error) {
+// ValidateSetToolVariationsGroupUnexpectedResponseBody runs the validations
+// defined on setToolVariationsGroup_unexpected_response_body
+func ValidateSetToolVariationsGroupUnexpectedResponseBody(body *SetToolVariationsGroupUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10727,9 +12328,9 @@ func ValidateRemoveOAuthServerUnexpectedResponseBody(body *RemoveOAuthServerUnex
 	return
 }
 
-// ValidateRemoveOAuthServerGatewayErrorResponseBody runs the validations
-// defined on removeOAuthServer_gateway_error_response_body
-func ValidateRemoveOAuthServerGatewayErrorResponseBody(body *RemoveOAuthServerGatewayErrorResponseBody) (err error) {
+// ValidateSetToolVariationsGroupGatewayErrorResponseBody runs the validations
+// defined on setToolVariationsGroup_gateway_error_response_body
+func ValidateSetToolVariationsGroupGatewayErrorResponseBody(body *SetToolVariationsGroupGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10751,9 +12352,9 @@ func ValidateRemoveOAuthServerGatewayErrorResponseBody(body *RemoveOAuthServerGa
 	return
 }
 
-// ValidateSetUserSessionIssuerUnauthorizedResponseBody runs the validations
-// defined on setUserSessionIssuer_unauthorized_response_body
-func ValidateSetUserSessionIssuerUnauthorizedResponseBody(body *SetUserSessionIssuerUnauthorizedResponseBody) (err error) {
+// ValidateDiffToolsetVersionsUnauthorizedResponseBody runs the validations
+// defined on diffToolsetVersions_unauthorized_response_body
+func ValidateDiffToolsetVersionsUnauthorizedResponseBody(body *DiffToolsetVersionsUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10775,9 +12376,9 @@ func ValidateSetUserSessionIssuerUnauthorizedResponseBody(body *SetUserSessionIs
 	return
 }
 
-// ValidateSetUserSessionIssuerForbiddenResponseBody runs the validations
-// defined on setUserSessionIssuer_forbidden_response_body
-func ValidateSetUserSessionIssuerForbiddenResponseBody(body *SetUserSessionIssuerForbiddenResponseBody) (err error) {
+// ValidateDiffToolsetVersionsForbiddenResponseBody runs the validations
+// defined on diffToolsetVersions_forbidden_response_body
+func ValidateDiffToolsetVersionsForbiddenResponseBody(body *DiffToolsetVersionsForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10799,9 +12400,9 @@ func ValidateSetUserSessionIssuerForbiddenResponseBody(body *SetUserSessionIssue
 	return
 }
 
-// ValidateSetUserSessionIssuerBadRequestResponseBody runs the validations
-// defined on setUserSessionIssuer_bad_request_response_body
-func ValidateSetUserSessionIssuerBadRequestResponseBody(body *SetUserSessionIssuerBadRequestResponseBody) (err error) {
+// ValidateDiffToolsetVersionsBadRequestResponseBody runs the validations
+// defined on diffToolsetVersions_bad_request_response_body
+func ValidateDiffToolsetVersionsBadRequestResponseBody(body *DiffToolsetVersionsBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10823,9 +12424,9 @@ func ValidateSetUserSessionIssuerBadRequestResponseBody(body *SetUserSessionIssu
 	return
 }
 
-// ValidateSetUserSessionIssuerNotFoundResponseBody runs the validations
-// defined on setUserSessionIssuer_not_found_response_body
-func ValidateSetUserSessionIssuerNotFoundResponseBody(body *SetUserSessionIssuerNotFoundResponseBody) (err error) {
+// ValidateDiffToolsetVersionsNotFoundResponseBody runs the validations defined
+// on diffToolsetVersions_not_found_response_body
+func ValidateDiffToolsetVersionsNotFoundResponseBody(body *DiffToolsetVersionsNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10847,9 +12448,9 @@ func ValidateSetUserSessionIssuerNotFoundResponseBody(body *SetUserSessionIssuer
 	return
 }
 
-// ValidateSetUserSessionIssuerConflictResponseBody runs the validations
-// defined on setUserSessionIssuer_conflict_response_body
-func ValidateSetUserSessionIssuerConflictResponseBody(body *SetUserSessionIssuerConflictResponseBody) (err error) {
+// ValidateDiffToolsetVersionsConflictResponseBody runs the validations defined
+// on diffToolsetVersions_conflict_response_body
+func ValidateDiffToolsetVersionsConflictResponseBody(body *DiffToolsetVersionsConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10871,9 +12472,9 @@ func ValidateSetUserSessionIssuerConflictResponseBody(body *SetUserSessionIssuer
 	return
 }
 
-// ValidateSetUserSessionIssuerUnsupportedMediaResponseBody runs the
-// validations defined on setUserSessionIssuer_unsupported_media_response_body
-func ValidateSetUserSessionIssuerUnsupportedMediaResponseBody(body *SetUserSessionIssuerUnsupportedMediaResponseBody) (err error) {
+// ValidateDiffToolsetVersionsUnsupportedMediaResponseBody runs the validations
+// defined on diffToolsetVersions_unsupported_media_response_body
+func ValidateDiffToolsetVersionsUnsupportedMediaResponseBody(body *DiffToolsetVersionsUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10895,9 +12496,9 @@ func ValidateSetUserSessionIssuerUnsupportedMediaResponseBody(body *SetUserSessi
 	return
 }
 
-// ValidateSetUserSessionIssuerInvalidResponseBody runs the validations defined
-// on setUserSessionIssuer_invalid_response_body
-func ValidateSetUserSessionIssuerInvalidResponseBody(body *SetUserSessionIssuerInvalidResponseBody) (err error) {
+// ValidateDiffToolsetVersionsInvalidResponseBody runs the validations defined
+// on diffToolsetVersions_invalid_response_body
+func ValidateDiffToolsetVersionsInvalidResponseBody(body *DiffToolsetVersionsInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10919,9 +12520,9 @@ func ValidateSetUserSessionIssuerInvalidResponseBody(body *SetUserSessionIssuerI
 	return
 }
 
-// ValidateSetUserSessionIssuerInvariantViolationResponseBody runs the
-// validations defined on setUserSessionIssuer_invariant_violation_response_body
-func ValidateSetUserSessionIssuerInvariantViolationResponseBody(body *SetUserSessionIssuerInvariantViolationResponseBody) (err error) {
+// ValidateDiffToolsetVersionsInvariantViolationResponseBody runs the
+// validations defined on diffToolsetVersions_invariant_violation_response_body
+func ValidateDiffToolsetVersionsInvariantViolationResponseBody(body *DiffToolsetVersionsInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10943,9 +12544,9 @@ func ValidateSetUserSessionIssuerInvariantViolationResponseBody(body *SetUserSes
 	return
 }
 
-// ValidateSetUserSessionIssuerUnexpectedResponseBody runs the validations
-// defined on setUserSessionIssuer_unexpected_response_body
-func ValidateSetUserSessionIssuerUnexpectedResponseBody(body *SetUserSessionIssuerUnexpectedResponseBody) (err error) {
+// ValidateDiffToolsetVersionsUnexpectedResponseBody runs the validations
+// defined on diffToolsetVersions_unexpected_response_body
+func ValidateDiffToolsetVersionsUnexpectedResponseBody(body *DiffToolsetVersionsUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10967,9 +12568,9 @@ func ValidateSetUserSessionIssuerUnexpectedResponseBody(body *SetUserSessionIssu
 	return
 }
 
-// ValidateSetUserSessionIssuerGatewayErrorResponseBody runs the validations
-// defined on setUserSessionIssuer_gateway_error_response_body
-func ValidateSetUserSessionIssuerGatewayErrorResponseBody(body *SetUserSessionIssuerGatewayErrorResponseBody) (err error) {
+// ValidateDiffToolsetVersionsGatewayErrorResponseBody runs the validations
+// defined on diffToolsetVersions_gateway_error_response_body
+func ValidateDiffToolsetVersionsGatewayErrorResponseBody(body *DiffToolsetVersionsGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -10991,9 +12592,9 @@ func ValidateSetUserSessionIssuerGatewayErrorResponseBody(body *SetUserSessionIs
 	return
 }
 
-// ValidateSetToolVariationsGroupUnauthorizedResponseBody runs the validations
-// defined on setToolVariationsGroup_unauthorized_response_body
-func ValidateSetToolVariationsGroupUnauthorizedResponseBody(body *SetToolVariationsGroupUnauthorizedResponseBody) (err error) {
+// ValidateRevertToolsetUnauthorizedResponseBody runs the validations defined
+// on revertToolset_unauthorized_response_body
+func ValidateRevertToolsetUnauthorizedResponseBody(body *RevertToolsetUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -11015,9 +12616,9 @@ func ValidateSetToolVariationsGroupUnauthorizedResponseBody(body *SetToolVariati
 	return
 }
 
-// ValidateSetToolVariationsGroupForbiddenResponseBody runs the validations
-// defined on setToolVariationsGroup_forbidden_response_body
-func ValidateSetToolVariationsGroupForbiddenResponseBody(body *SetToolVariationsGroupForbiddenResponseBody) (err error) {
+// ValidateRevertToolsetForbiddenResponseBody runs the validations defined on
+// revertToolset_forbidden_response_body
+func ValidateRevertToolsetForbiddenResponseBody(body *RevertToolsetForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -11039,9 +12640,9 @@ func ValidateSetToolVariationsGroupForbiddenResponseBody(body *SetToolVariations
 	return
 }
 
-// ValidateSetToolVariationsGroupBadRequestResponseBody runs the validations
-// defined on setToolVariationsGroup_bad_request_response_body
-func ValidateSetToolVariationsGroupBadRequestResponseBody(body *SetToolVariationsGroupBadRequestResponseBody) (err error) {
+// ValidateRevertToolsetBadRequestResponseBody runs the validations defined on
+// revertToolset_bad_request_response_body
+func ValidateRevertToolsetBadRequestResponseBody(body *RevertToolsetBadRequestResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -11063,9 +12664,9 @@ func ValidateSetToolVariationsGroupBadRequestResponseBody(body *SetToolVariation
 	return
 }
 
-// ValidateSetToolVariationsGroupNotFoundResponseBody runs the validations
-// defined on setToolVariationsGroup_not_found_response_body
-func ValidateSetToolVariationsGroupNotFoundResponseBody(body *SetToolVariationsGroupNotFoundResponseBody) (err error) {
+// ValidateRevertToolsetNotFoundResponseBody runs the validations defined on
+// revertToolset_not_found_response_body
+func ValidateRevertToolsetNotFoundResponseBody(body *RevertToolsetNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -11087,9 +12688,9 @@ func ValidateSetToolVariationsGroupNotFoundResponseBody(body *SetToolVariationsG
 	return
 }
 
-// ValidateSetToolVariationsGroupConflictResponseBody runs the validations
-// defined on setToolVariationsGroup_conflict_response_body
-func ValidateSetToolVariationsGroupConflictResponseBody(body *SetToolVariationsGroupConflictResponseBody) (err error) {
+// ValidateRevertToolsetConflictResponseBody runs the validations defined on
+// revertToolset_conflict_response_body
+func ValidateRevertToolsetConflictResponseBody(body *RevertToolsetConflictResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -11111,9 +12712,9 @@ func ValidateSetToolVariationsGroupConflictResponseBody(body *SetToolVariationsG
 	return
 }
 
-// ValidateSetToolVariationsGroupUnsupportedMediaResponseBody runs the
-// validations defined on setToolVariationsGroup_unsupported_media_response_body
-func ValidateSetToolVariationsGroupUnsupportedMediaResponseBody(body *SetToolVariationsGroupUnsupportedMediaResponseBody) (err error) {
+// ValidateRevertToolsetUnsupportedMediaResponseBody runs the validations
+// defined on revertToolset_unsupported_media_response_body
+func ValidateRevertToolsetUnsupportedMediaResponseBody(body *RevertToolsetUnsupportedMediaResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -11135,9 +12736,9 @@ func ValidateSetToolVariationsGroupUnsupportedMediaResponseBody(body *SetToolVar
 	return
 }
 
-// ValidateSetToolVariationsGroupInvalidResponseBody runs the validations
-// defined on setToolVariationsGroup_invalid_response_body
-func ValidateSetToolVariationsGroupInvalidResponseBody(body *SetToolVariationsGroupInvalidResponseBody) (err error) {
+// ValidateRevertToolsetInvalidResponseBody runs the validations defined on
+// revertToolset_invalid_response_body
+func ValidateRevertToolsetInvalidResponseBody(body *RevertToolsetInvalidResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -11159,10 +12760,9 @@ func ValidateSetToolVariationsGroupInvalidResponseBody(body *SetToolVariationsGr
 	return
 }
 
-// ValidateSetToolVariationsGroupInvariantViolationResponseBody runs the
-// validations defined on
-// setToolVariationsGroup_invariant_violation_response_body
-func ValidateSetToolVariationsGroupInvariantViolationResponseBody(body *SetToolVariationsGroupInvariantViolationResponseBody) (err error) {
+// ValidateRevertToolsetInvariantViolationResponseBody runs the validations
+// defined on revertToolset_invariant_violation_response_body
+func ValidateRevertToolsetInvariantViolationResponseBody(body *RevertToolsetInvariantViolationResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -11184,9 +12784,9 @@ func ValidateSetToolVariationsGroupInvariantViolationResponseBody(body *SetToolV
 	return
 }
 
-// ValidateSetToolVariationsGroupUnexpectedResponseBody runs the validations
-// defined on setToolVariationsGroup_unexpected_response_body
-func ValidateSetToolVariationsGroupUnexpectedResponseBody(body *SetToolVariationsGroupUnexpectedResponseBody) (err error) {
+// ValidateRevertToolsetUnexpectedResponseBody runs the validations defined on
+// revertToolset_unexpected_response_body
+func ValidateRevertToolsetUnexpectedResponseBody(body *RevertToolsetUnexpectedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -11208,9 +12808,9 @@ func ValidateSetToolVariationsGroupUnexpectedResponseBody(body *SetToolVariation
 	return
 }
 
-// ValidateSetToolVariationsGroupGatewayErrorResponseBody runs the validations
-// defined on setToolVariationsGroup_gateway_error_response_body
-func ValidateSetToolVariationsGroupGatewayErrorResponseBody(body *SetToolVariationsGroupGatewayErrorResponseBody) (err error) {
+// ValidateRevertToolsetGatewayErrorResponseBody runs the validations defined
+// on revertToolset_gateway_error_response_body
+func ValidateRevertToolsetGatewayErrorResponseBody(body *RevertToolsetGatewayErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -11454,6 +13054,43 @@ func ValidateToolVariationResponseBody(body *ToolVariationResponseBody) (err err
 	if body.UpdatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
 	}
+	for _, e := range body.ArgumentBindings {
+		if e != nil {
+			if err2 := ValidateToolArgumentBindingResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateToolArgumentBindingResponseBody runs the validations defined on
+// ToolArgumentBindingResponseBody
+func ValidateToolArgumentBindingResponseBody(body *ToolArgumentBindingResponseBody) (err error) {
+	if body.Argument == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("argument", "body"))
+	}
+	if body.Source == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("source", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.Argument != nil {
+		if utf8.RuneCountInString(*body.Argument) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.argument", *body.Argument, utf8.RuneCountInString(*body.Argument), 1, true))
+		}
+	}
+	if body.Source != nil {
+		if !(*body.Source == "constant" || *body.Source == "environment" || *body.Source == "principal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"constant", "environment", "principal"}))
+		}
+	}
+	if body.Value != nil {
+		if utf8.RuneCountInString(*body.Value) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", *body.Value, utf8.RuneCountInString(*body.Value), 1, true))
+		}
+	}
 	return
 }
 
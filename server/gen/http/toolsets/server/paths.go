@@ -71,3 +71,13 @@ func SetUserSessionIssuerToolsetsPath() string {
 func SetToolVariationsGroupToolsetsPath() string {
 	return "/rpc/toolsets.setToolVariationsGroup"
 }
+
+// DiffToolsetVersionsToolsetsPath returns the URL path to the toolsets service diffToolsetVersions HTTP endpoint.
+func DiffToolsetVersionsToolsetsPath() string {
+	return "/rpc/toolsets.diffVersions"
+}
+
+// RevertToolsetToolsetsPath returns the URL path to the toolsets service revertToolset HTTP endpoint.
+func RevertToolsetToolsetsPath() string {
+	return "/rpc/toolsets.revert"
+}
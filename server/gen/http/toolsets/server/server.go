@@ -32,6 +32,8 @@ type Server struct {
 	RemoveOAuthServer        http.Handler
 	SetUserSessionIssuer     http.Handler
 	SetToolVariationsGroup   http.Handler
+	DiffToolsetVersions      http.Handler
+	RevertToolset            http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -74,6 +76,8 @@ func New(
 			{"RemoveOAuthServer", "POST", "/rpc/toolsets.removeOAuthServer"},
 			{"SetUserSessionIssuer", "POST", "/rpc/toolsets.setUserSessionIssuer"},
 			{"SetToolVariationsGroup", "POST", "/rpc/toolsets.setToolVariationsGroup"},
+			{"DiffToolsetVersions", "GET", "/rpc/toolsets.diffVersions"},
+			{"RevertToolset", "POST", "/rpc/toolsets.revert"},
 		},
 		CreateToolset:            NewCreateToolsetHandler(e.CreateToolset, mux, decoder, encoder, errhandler, formatter),
 		ListToolsets:             NewListToolsetsHandler(e.ListToolsets, mux, decoder, encoder, errhandler, formatter),
@@ -88,6 +92,8 @@ func New(
 		RemoveOAuthServer:        NewRemoveOAuthServerHandler(e.RemoveOAuthServer, mux, decoder, encoder, errhandler, formatter),
 		SetUserSessionIssuer:     NewSetUserSessionIssuerHandler(e.SetUserSessionIssuer, mux, decoder, encoder, errhandler, formatter),
 		SetToolVariationsGroup:   NewSetToolVariationsGroupHandler(e.SetToolVariationsGroup, mux, decoder, encoder, errhandler, formatter),
+		DiffToolsetVersions:      NewDiffToolsetVersionsHandler(e.DiffToolsetVersions, mux, decoder, encoder, errhandler, formatter),
+		RevertToolset:            NewRevertToolsetHandler(e.RevertToolset, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -109,6 +115,8 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.RemoveOAuthServer = m(s.RemoveOAuthServer)
 	s.SetUserSessionIssuer = m(s.SetUserSessionIssuer)
 	s.SetToolVariationsGroup = m(s.SetToolVariationsGroup)
+	s.DiffToolsetVersions = m(s.DiffToolsetVersions)
+	s.RevertToolset = m(s.RevertToolset)
 }
 
 // MethodNames returns the methods served.
@@ -129,6 +137,8 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountRemoveOAuthServerHandler(mux, h.RemoveOAuthServer)
 	MountSetUserSessionIssuerHandler(mux, h.SetUserSessionIssuer)
 	MountSetToolVariationsGroupHandler(mux, h.SetToolVariationsGroup)
+	MountDiffToolsetVersionsHandler(mux, h.DiffToolsetVersions)
+	MountRevertToolsetHandler(mux, h.RevertToolset)
 }
 
 // Mount configures the mux to serve the toolsets endpoints.
@@ -825,3 +835,109 @@ func NewSetToolVariationsGroupHandler(
 		}
 	})
 }
+
+// MountDiffToolsetVersionsHandler configures the mux to serve the "toolsets"
+// service "diffToolsetVersions" endpoint.
+func MountDiffToolsetVersionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/rpc/toolsets.diffVersions", f)
+}
+
+// NewDiffToolsetVersionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "toolsets" service "diffToolsetVersions" endpoint.
+func NewDiffToolsetVersionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDiffToolsetVersionsRequest(mux, decoder)
+		encodeResponse = EncodeDiffToolsetVersionsResponse(encoder)
+		encodeError    = EncodeDiffToolsetVersionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "diffToolsetVersions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "toolsets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRevertToolsetHandler configures the mux to serve the "toolsets" service
+// "revertToolset" endpoint.
+func MountRevertToolsetHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/toolsets.revert", f)
+}
+
+// NewRevertToolsetHandler creates a HTTP handler which loads the HTTP request
+// and calls the "toolsets" service "revertToolset" endpoint.
+func NewRevertToolsetHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevertToolsetRequest(mux, decoder)
+		encodeResponse = EncodeRevertToolsetResponse(encoder)
+		encodeError    = EncodeRevertToolsetError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "revertToolset")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "toolsets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
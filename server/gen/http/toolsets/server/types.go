@@ -81,6 +81,13 @@ type SetToolVariationsGroupRequestBody struct {
 	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
 }
 
+// RevertToolsetRequestBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP request body.
+type RevertToolsetRequestBody struct {
+	// The version whose tools and resources become the toolset's new latest version
+	Version *int64 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+}
+
 // CreateToolsetResponseBody is the type of the "toolsets" service
 // "createToolset" endpoint HTTP response body.
 type CreateToolsetResponseBody struct {
@@ -691,6 +698,95 @@ type SetToolVariationsGroupResponseBody struct {
 	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
+// DiffToolsetVersionsResponseBody is the type of the "toolsets" service
+// "diffToolsetVersions" endpoint HTTP response body.
+type DiffToolsetVersionsResponseBody struct {
+	// The version compared from
+	FromVersion int64 `form:"from_version" json:"from_version" xml:"from_version"`
+	// The version compared to
+	ToVersion int64 `form:"to_version" json:"to_version" xml:"to_version"`
+	// Tool URNs in to_version but not in from_version
+	AddedToolUrns []string `form:"added_tool_urns" json:"added_tool_urns" xml:"added_tool_urns"`
+	// Tool URNs in from_version but not in to_version
+	RemovedToolUrns []string `form:"removed_tool_urns" json:"removed_tool_urns" xml:"removed_tool_urns"`
+	// Resource URNs in to_version but not in from_version
+	AddedResourceUrns []string `form:"added_resource_urns" json:"added_resource_urns" xml:"added_resource_urns"`
+	// Resource URNs in from_version but not in to_version
+	RemovedResourceUrns []string `form:"removed_resource_urns" json:"removed_resource_urns" xml:"removed_resource_urns"`
+}
+
+// RevertToolsetResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body.
+type RevertToolsetResponseBody struct {
+	// The ID of the toolset
+	ID string `form:"id" json:"id" xml:"id"`
+	// The project ID this toolset belongs to
+	ProjectID string `form:"project_id" json:"project_id" xml:"project_id"`
+	// The organization ID this toolset belongs to
+	OrganizationID string `form:"organization_id" json:"organization_id" xml:"organization_id"`
+	// The account type of the organization
+	AccountType string `form:"account_type" json:"account_type" xml:"account_type"`
+	// The name of the toolset
+	Name string `form:"name" json:"name" xml:"name"`
+	// The slug of the toolset
+	Slug string `form:"slug" json:"slug" xml:"slug"`
+	// Description of the toolset
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// The slug of the environment to use as the default for the toolset
+	DefaultEnvironmentSlug *string `form:"default_environment_slug,omitempty" json:"default_environment_slug,omitempty" xml:"default_environment_slug,omitempty"`
+	// The security variables that are relevant to the toolset
+	SecurityVariables []*SecurityVariableResponseBody `form:"security_variables,omitempty" json:"security_variables,omitempty" xml:"security_variables,omitempty"`
+	// The server variables that are relevant to the toolset
+	ServerVariables []*ServerVariableResponseBody `form:"server_variables,omitempty" json:"server_variables,omitempty" xml:"server_variables,omitempty"`
+	// The function environment variables that are relevant to the toolset
+	FunctionEnvironmentVariables []*FunctionEnvironmentVariableResponseBody `form:"function_environment_variables,omitempty" json:"function_environment_variables,omitempty" xml:"function_environment_variables,omitempty"`
+	// The external MCP header definitions that are relevant to the toolset
+	ExternalMcpHeaderDefinitions []*ExternalMCPHeaderDefinitionResponseBody `form:"external_mcp_header_definitions,omitempty" json:"external_mcp_header_definitions,omitempty" xml:"external_mcp_header_definitions,omitempty"`
+	// The metadata surrounding oauth enabled tools within this server
+	OauthEnablementMetadata *OAuthEnablementMetadataResponseBody `form:"oauth_enablement_metadata" json:"oauth_enablement_metadata" xml:"oauth_enablement_metadata"`
+	// The tools in this toolset
+	Tools []*ToolResponseBody `form:"tools" json:"tools" xml:"tools"`
+	// The tool URNs in this toolset
+	ToolUrns []string `form:"tool_urns" json:"tool_urns" xml:"tool_urns"`
+	// The version of the toolset (will be 0 if none exists)
+	ToolsetVersion int64 `form:"toolset_version" json:"toolset_version" xml:"toolset_version"`
+	// The resources in this toolset
+	Resources []*ResourceResponseBody `form:"resources" json:"resources" xml:"resources"`
+	// The resource URNs in this toolset
+	ResourceUrns []string `form:"resource_urns" json:"resource_urns" xml:"resource_urns"`
+	// The prompt templates in this toolset -- Note: these are actual prompts, as
+	// in MCP prompts
+	PromptTemplates []*PromptTemplateResponseBody `form:"prompt_templates" json:"prompt_templates" xml:"prompt_templates"`
+	// The slug of the MCP to use for the toolset
+	McpSlug *string `form:"mcp_slug,omitempty" json:"mcp_slug,omitempty" xml:"mcp_slug,omitempty"`
+	// Whether the toolset is public in MCP
+	McpIsPublic *bool `form:"mcp_is_public,omitempty" json:"mcp_is_public,omitempty" xml:"mcp_is_public,omitempty"`
+	// Whether the toolset is enabled for MCP
+	McpEnabled *bool `form:"mcp_enabled,omitempty" json:"mcp_enabled,omitempty" xml:"mcp_enabled,omitempty"`
+	// The mode to use for tool selection
+	ToolSelectionMode string `form:"tool_selection_mode" json:"tool_selection_mode" xml:"tool_selection_mode"`
+	// The ID of the custom domain to use for the toolset
+	CustomDomainID *string `form:"custom_domain_id,omitempty" json:"custom_domain_id,omitempty" xml:"custom_domain_id,omitempty"`
+	// The registry lineage for toolsets installed from an external MCP catalog
+	Origin *ToolsetOriginResponseBody `form:"origin,omitempty" json:"origin,omitempty" xml:"origin,omitempty"`
+	// The external OAuth server details
+	ExternalOauthServer *ExternalOAuthServerResponseBody `form:"external_oauth_server,omitempty" json:"external_oauth_server,omitempty" xml:"external_oauth_server,omitempty"`
+	// The id of the user_session_issuer wired to this toolset. Set via
+	// toolsets.setUserSessionIssuer; null when no USI is linked.
+	UserSessionIssuerID *string `form:"user_session_issuer_id,omitempty" json:"user_session_issuer_id,omitempty" xml:"user_session_issuer_id,omitempty"`
+	// The slug of the user_session_issuer wired to this toolset; present when
+	// user_session_issuer_id is.
+	UserSessionIssuerSlug *string `form:"user_session_issuer_slug,omitempty" json:"user_session_issuer_slug,omitempty" xml:"user_session_issuer_slug,omitempty"`
+	// The id of the tool variations group enabling MCP tool filtering for this
+	// toolset. Set via toolsets.setToolVariationsGroup; null when filtering is
+	// disabled.
+	ToolVariationsGroupID *string `form:"tool_variations_group_id,omitempty" json:"tool_variations_group_id,omitempty" xml:"tool_variations_group_id,omitempty"`
+	// When the toolset was created.
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// When the toolset was last updated.
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
 // CreateToolsetUnauthorizedResponseBody is the type of the "toolsets" service
 // "createToolset" endpoint HTTP response body for the "unauthorized" error.
 type CreateToolsetUnauthorizedResponseBody struct {
@@ -3099,125 +3195,496 @@ type SetToolVariationsGroupGatewayErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// SecurityVariableResponseBody is used to define fields on response body types.
-type SecurityVariableResponseBody struct {
-	// The unique identifier of the security variable
-	ID string `form:"id" json:"id" xml:"id"`
-	// The type of security
-	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
-	// The name of the security scheme (actual header/parameter name)
+// DiffToolsetVersionsUnauthorizedResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "unauthorized" error.
+type DiffToolsetVersionsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
-	// User-friendly display name for the security variable (defaults to name if
-	// not set)
-	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
-	// Where the security token is placed
-	InPlacement string `form:"in_placement" json:"in_placement" xml:"in_placement"`
-	// The security scheme
-	Scheme string `form:"scheme" json:"scheme" xml:"scheme"`
-	// The bearer format
-	BearerFormat *string `form:"bearer_format,omitempty" json:"bearer_format,omitempty" xml:"bearer_format,omitempty"`
-	// The OAuth types
-	OauthTypes []string `form:"oauth_types,omitempty" json:"oauth_types,omitempty" xml:"oauth_types,omitempty"`
-	// The OAuth flows
-	OauthFlows []byte `form:"oauth_flows,omitempty" json:"oauth_flows,omitempty" xml:"oauth_flows,omitempty"`
-	// The environment variables
-	EnvVariables []string `form:"env_variables" json:"env_variables" xml:"env_variables"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ServerVariableResponseBody is used to define fields on response body types.
-type ServerVariableResponseBody struct {
-	// Description of the server variable
-	Description string `form:"description" json:"description" xml:"description"`
-	// The environment variables
-	EnvVariables []string `form:"env_variables" json:"env_variables" xml:"env_variables"`
+// DiffToolsetVersionsForbiddenResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "forbidden" error.
+type DiffToolsetVersionsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// FunctionEnvironmentVariableResponseBody is used to define fields on response
-// body types.
-type FunctionEnvironmentVariableResponseBody struct {
-	// Description of the function environment variable
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Optional value of the function variable comes from a specific auth input
-	AuthInputType *string `form:"auth_input_type,omitempty" json:"auth_input_type,omitempty" xml:"auth_input_type,omitempty"`
-	// The environment variables
+// DiffToolsetVersionsBadRequestResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "bad_request" error.
+type DiffToolsetVersionsBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ExternalMCPHeaderDefinitionResponseBody is used to define fields on response
-// body types.
-type ExternalMCPHeaderDefinitionResponseBody struct {
-	// The prefixed environment variable name (e.g., SLACK_X_API_KEY)
+// DiffToolsetVersionsNotFoundResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "not_found" error.
+type DiffToolsetVersionsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
-	// The actual HTTP header name to send (e.g., X-Api-Key)
-	HeaderName string `form:"header_name" json:"header_name" xml:"header_name"`
-	// Description of the header
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Placeholder value for the header
-	Placeholder *string `form:"placeholder,omitempty" json:"placeholder,omitempty" xml:"placeholder,omitempty"`
-	// Whether the header is required
-	Required bool `form:"required" json:"required" xml:"required"`
-	// Whether the header value is secret
-	Secret bool `form:"secret" json:"secret" xml:"secret"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// OAuthEnablementMetadataResponseBody is used to define fields on response
-// body types.
-type OAuthEnablementMetadataResponseBody struct {
-	// Count of security variables that are OAuth2 supported
-	Oauth2SecurityCount int `form:"oauth2_security_count" json:"oauth2_security_count" xml:"oauth2_security_count"`
+// DiffToolsetVersionsConflictResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the "conflict"
+// error.
+type DiffToolsetVersionsConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ToolResponseBody is used to define fields on response body types.
-type ToolResponseBody struct {
-	// The HTTP tool definition
-	HTTPToolDefinition *HTTPToolDefinitionResponseBody `form:"http_tool_definition,omitempty" json:"http_tool_definition,omitempty" xml:"http_tool_definition,omitempty"`
-	// The function tool definition
-	FunctionToolDefinition *FunctionToolDefinitionResponseBody `form:"function_tool_definition,omitempty" json:"function_tool_definition,omitempty" xml:"function_tool_definition,omitempty"`
-	// The prompt template
-	PromptTemplate *PromptTemplateResponseBody `form:"prompt_template,omitempty" json:"prompt_template,omitempty" xml:"prompt_template,omitempty"`
-	// The Platform tool definition
-	PlatformToolDefinition *PlatformToolDefinitionResponseBody `form:"platform_tool_definition,omitempty" json:"platform_tool_definition,omitempty" xml:"platform_tool_definition,omitempty"`
-	// The external MCP tool definition
-	ExternalMcpToolDefinition *ExternalMCPToolDefinitionResponseBody `form:"external_mcp_tool_definition,omitempty" json:"external_mcp_tool_definition,omitempty" xml:"external_mcp_tool_definition,omitempty"`
+// DiffToolsetVersionsUnsupportedMediaResponseBody is the type of the
+// "toolsets" service "diffToolsetVersions" endpoint HTTP response body for the
+// "unsupported_media" error.
+type DiffToolsetVersionsUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// HTTPToolDefinitionResponseBody is used to define fields on response body
-// types.
-type HTTPToolDefinitionResponseBody struct {
-	// The ID of the deployment
-	DeploymentID string `form:"deployment_id" json:"deployment_id" xml:"deployment_id"`
-	// The ID of the asset
-	AssetID string `form:"asset_id" json:"asset_id" xml:"asset_id"`
-	// Summary of the tool
-	Summary string `form:"summary" json:"summary" xml:"summary"`
-	// Response filter metadata for the tool
-	ResponseFilter *ResponseFilterResponseBody `form:"response_filter,omitempty" json:"response_filter,omitempty" xml:"response_filter,omitempty"`
-	// The ID of the OpenAPI v3 document
-	Openapiv3DocumentID *string `form:"openapiv3_document_id,omitempty" json:"openapiv3_document_id,omitempty" xml:"openapiv3_document_id,omitempty"`
-	// OpenAPI v3 operation
-	Openapiv3Operation *string `form:"openapiv3_operation,omitempty" json:"openapiv3_operation,omitempty" xml:"openapiv3_operation,omitempty"`
-	// The tags list for this http tool
-	Tags []string `form:"tags" json:"tags" xml:"tags"`
-	// Security requirements for the underlying HTTP endpoint
-	Security *string `form:"security,omitempty" json:"security,omitempty" xml:"security,omitempty"`
-	// The default server URL for the tool
-	DefaultServerURL *string `form:"default_server_url,omitempty" json:"default_server_url,omitempty" xml:"default_server_url,omitempty"`
-	// HTTP method for the request
-	HTTPMethod string `form:"http_method" json:"http_method" xml:"http_method"`
-	// Path for the request
-	Path string `form:"path" json:"path" xml:"path"`
-	// The name of the source package
-	PackageName *string `form:"package_name,omitempty" json:"package_name,omitempty" xml:"package_name,omitempty"`
-	// The ID of the tool
-	ID string `form:"id" json:"id" xml:"id"`
-	// The URN of this tool
-	ToolUrn string `form:"tool_urn" json:"tool_urn" xml:"tool_urn"`
-	// The ID of the project
-	ProjectID string `form:"project_id" json:"project_id" xml:"project_id"`
-	// The name of the tool
+// DiffToolsetVersionsInvalidResponseBody is the type of the "toolsets" service
+// "diffToolsetVersions" endpoint HTTP response body for the "invalid" error.
+type DiffToolsetVersionsInvalidResponseBody struct {
+	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
-	// The canonical name of the tool. Will be the same as the name if there is no
-	// variation.
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffToolsetVersionsInvariantViolationResponseBody is the type of the
+// "toolsets" service "diffToolsetVersions" endpoint HTTP response body for the
+// "invariant_violation" error.
+type DiffToolsetVersionsInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffToolsetVersionsUnexpectedResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "unexpected" error.
+type DiffToolsetVersionsUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffToolsetVersionsGatewayErrorResponseBody is the type of the "toolsets"
+// service "diffToolsetVersions" endpoint HTTP response body for the
+// "gateway_error" error.
+type DiffToolsetVersionsGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevertToolsetUnauthorizedResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "unauthorized" error.
+type RevertToolsetUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevertToolsetForbiddenResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "forbidden" error.
+type RevertToolsetForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevertToolsetBadRequestResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "bad_request" error.
+type RevertToolsetBadRequestResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevertToolsetNotFoundResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "not_found" error.
+type RevertToolsetNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevertToolsetConflictResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "conflict" error.
+type RevertToolsetConflictResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevertToolsetUnsupportedMediaResponseBody is the type of the "toolsets"
+// service "revertToolset" endpoint HTTP response body for the
+// "unsupported_media" error.
+type RevertToolsetUnsupportedMediaResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevertToolsetInvalidResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "invalid" error.
+type RevertToolsetInvalidResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevertToolsetInvariantViolationResponseBody is the type of the "toolsets"
+// service "revertToolset" endpoint HTTP response body for the
+// "invariant_violation" error.
+type RevertToolsetInvariantViolationResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevertToolsetUnexpectedResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "unexpected" error.
+type RevertToolsetUnexpectedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevertToolsetGatewayErrorResponseBody is the type of the "toolsets" service
+// "revertToolset" endpoint HTTP response body for the "gateway_error" error.
+type RevertToolsetGatewayErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SecurityVariableResponseBody is used to define fields on response body types.
+type SecurityVariableResponseBody struct {
+	// The unique identifier of the security variable
+	ID string `form:"id" json:"id" xml:"id"`
+	// The type of security
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// The name of the security scheme (actual header/parameter name)
+	Name string `form:"name" json:"name" xml:"name"`
+	// User-friendly display name for the security variable (defaults to name if
+	// not set)
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Where the security token is placed
+	InPlacement string `form:"in_placement" json:"in_placement" xml:"in_placement"`
+	// The security scheme
+	Scheme string `form:"scheme" json:"scheme" xml:"scheme"`
+	// The bearer format
+	BearerFormat *string `form:"bearer_format,omitempty" json:"bearer_format,omitempty" xml:"bearer_format,omitempty"`
+	// The OAuth types
+	OauthTypes []string `form:"oauth_types,omitempty" json:"oauth_types,omitempty" xml:"oauth_types,omitempty"`
+	// The OAuth flows
+	OauthFlows []byte `form:"oauth_flows,omitempty" json:"oauth_flows,omitempty" xml:"oauth_flows,omitempty"`
+	// The environment variables
+	EnvVariables []string `form:"env_variables" json:"env_variables" xml:"env_variables"`
+}
+
+// ServerVariableResponseBody is used to define fields on response body types.
+type ServerVariableResponseBody struct {
+	// Description of the server variable
+	Description string `form:"description" json:"description" xml:"description"`
+	// The environment variables
+	EnvVariables []string `form:"env_variables" json:"env_variables" xml:"env_variables"`
+}
+
+// FunctionEnvironmentVariableResponseBody is used to define fields on response
+// body types.
+type FunctionEnvironmentVariableResponseBody struct {
+	// Description of the function environment variable
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Optional value of the function variable comes from a specific auth input
+	AuthInputType *string `form:"auth_input_type,omitempty" json:"auth_input_type,omitempty" xml:"auth_input_type,omitempty"`
+	// The environment variables
+	Name string `form:"name" json:"name" xml:"name"`
+}
+
+// ExternalMCPHeaderDefinitionResponseBody is used to define fields on response
+// body types.
+type ExternalMCPHeaderDefinitionResponseBody struct {
+	// The prefixed environment variable name (e.g., SLACK_X_API_KEY)
+	Name string `form:"name" json:"name" xml:"name"`
+	// The actual HTTP header name to send (e.g., X-Api-Key)
+	HeaderName string `form:"header_name" json:"header_name" xml:"header_name"`
+	// Description of the header
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Placeholder value for the header
+	Placeholder *string `form:"placeholder,omitempty" json:"placeholder,omitempty" xml:"placeholder,omitempty"`
+	// Whether the header is required
+	Required bool `form:"required" json:"required" xml:"required"`
+	// Whether the header value is secret
+	Secret bool `form:"secret" json:"secret" xml:"secret"`
+}
+
+// OAuthEnablementMetadataResponseBody is used to define fields on response
+// body types.
+type OAuthEnablementMetadataResponseBody struct {
+	// Count of security variables that are OAuth2 supported
+	Oauth2SecurityCount int `form:"oauth2_security_count" json:"oauth2_security_count" xml:"oauth2_security_count"`
+}
+
+// ToolResponseBody is used to define fields on response body types.
+type ToolResponseBody struct {
+	// The HTTP tool definition
+	HTTPToolDefinition *HTTPToolDefinitionResponseBody `form:"http_tool_definition,omitempty" json:"http_tool_definition,omitempty" xml:"http_tool_definition,omitempty"`
+	// The function tool definition
+	FunctionToolDefinition *FunctionToolDefinitionResponseBody `form:"function_tool_definition,omitempty" json:"function_tool_definition,omitempty" xml:"function_tool_definition,omitempty"`
+	// The prompt template
+	PromptTemplate *PromptTemplateResponseBody `form:"prompt_template,omitempty" json:"prompt_template,omitempty" xml:"prompt_template,omitempty"`
+	// The Platform tool definition
+	PlatformToolDefinition *PlatformToolDefinitionResponseBody `form:"platform_tool_definition,omitempty" json:"platform_tool_definition,omitempty" xml:"platform_tool_definition,omitempty"`
+	// The external MCP tool definition
+	ExternalMcpToolDefinition *ExternalMCPToolDefinitionResponseBody `form:"external_mcp_tool_definition,omitempty" json:"external_mcp_tool_definition,omitempty" xml:"external_mcp_tool_definition,omitempty"`
+}
+
+// HTTPToolDefinitionResponseBody is used to define fields on response body
+// types.
+type HTTPToolDefinitionResponseBody struct {
+	// The ID of the deployment
+	DeploymentID string `form:"deployment_id" json:"deployment_id" xml:"deployment_id"`
+	// The ID of the asset
+	AssetID string `form:"asset_id" json:"asset_id" xml:"asset_id"`
+	// Summary of the tool
+	Summary string `form:"summary" json:"summary" xml:"summary"`
+	// Response filter metadata for the tool
+	ResponseFilter *ResponseFilterResponseBody `form:"response_filter,omitempty" json:"response_filter,omitempty" xml:"response_filter,omitempty"`
+	// The ID of the OpenAPI v3 document
+	Openapiv3DocumentID *string `form:"openapiv3_document_id,omitempty" json:"openapiv3_document_id,omitempty" xml:"openapiv3_document_id,omitempty"`
+	// OpenAPI v3 operation
+	Openapiv3Operation *string `form:"openapiv3_operation,omitempty" json:"openapiv3_operation,omitempty" xml:"openapiv3_operation,omitempty"`
+	// The tags list for this http tool
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+	// Security requirements for the underlying HTTP endpoint
+	Security *string `form:"security,omitempty" json:"security,omitempty" xml:"security,omitempty"`
+	// The default server URL for the tool
+	DefaultServerURL *string `form:"default_server_url,omitempty" json:"default_server_url,omitempty" xml:"default_server_url,omitempty"`
+	// HTTP method for the request
+	HTTPMethod string `form:"http_method" json:"http_method" xml:"http_method"`
+	// Path for the request
+	Path string `form:"path" json:"path" xml:"path"`
+	// The name of the source package
+	PackageName *string `form:"package_name,omitempty" json:"package_name,omitempty" xml:"package_name,omitempty"`
+	// The ID of the tool
+	ID string `form:"id" json:"id" xml:"id"`
+	// The URN of this tool
+	ToolUrn string `form:"tool_urn" json:"tool_urn" xml:"tool_urn"`
+	// The ID of the project
+	ProjectID string `form:"project_id" json:"project_id" xml:"project_id"`
+	// The name of the tool
+	Name string `form:"name" json:"name" xml:"name"`
+	// The canonical name of the tool. Will be the same as the name if there is no
+	// variation.
 	CanonicalName string `form:"canonical_name" json:"canonical_name" xml:"canonical_name"`
 	// Description of the tool
 	Description string `form:"description" json:"description" xml:"description"`
@@ -3302,12 +3769,33 @@ type ToolVariationResponseBody struct {
 	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
 	// Override: if true, the tool interacts with external entities
 	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+	// How long the gateway caches responses of this tool
+	CacheTTLSeconds *int32 `form:"cache_ttl_seconds,omitempty" json:"cache_ttl_seconds,omitempty" xml:"cache_ttl_seconds,omitempty"`
+	// If true, cached responses are shared between callers with identical
+	// environments
+	CacheShared *bool `form:"cache_shared,omitempty" json:"cache_shared,omitempty" xml:"cache_shared,omitempty"`
+	// Arguments filled server-side on every call and hidden from the tool's input
+	// schema
+	ArgumentBindings []*ToolArgumentBindingResponseBody `form:"argument_bindings,omitempty" json:"argument_bindings,omitempty" xml:"argument_bindings,omitempty"`
 	// The creation date of the tool variation
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
 	// The last update date of the tool variation
 	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
+// ToolArgumentBindingResponseBody is used to define fields on response body
+// types.
+type ToolArgumentBindingResponseBody struct {
+	// Dotted path of the bound argument in the tool's input, e.g. body.tenant_id
+	Argument string `form:"argument" json:"argument" xml:"argument"`
+	// Where the bound value comes from
+	Source string `form:"source" json:"source" xml:"source"`
+	// A JSON literal for constant bindings, an environment variable name for
+	// environment bindings, or a claim of the authenticated user (email, user_id,
+	// external_user_id, organization_id, organization_slug) for principal bindings
+	Value string `form:"value" json:"value" xml:"value"`
+}
+
 // ToolAnnotationsResponseBody is used to define fields on response body types.
 type ToolAnnotationsResponseBody struct {
 	// Human-readable display name for the tool
@@ -4793,10 +5281,189 @@ func NewSetUserSessionIssuerResponseBody(res *types.Toolset) *SetUserSessionIssu
 	return body
 }
 
-// NewSetToolVariationsGroupResponseBody builds the HTTP response body from the
-// result of the "setToolVariationsGroup" endpoint of the "toolsets" service.
-func NewSetToolVariationsGroupResponseBody(res *types.Toolset) *SetToolVariationsGroupResponseBody {
-	body := &SetToolVariationsGroupResponseBody{
+// NewSetToolVariationsGroupResponseBody builds the HTTP response body from the
+// result of the "setToolVariationsGroup" endpoint of the "toolsets" service.
+func NewSetToolVariationsGroupResponseBody(res *types.Toolset) *SetToolVariationsGroupResponseBody {
+	body := &SetToolVariationsGroupResponseBody{
+		ID:                    res.ID,
+		ProjectID:             res.ProjectID,
+		OrganizationID:        res.OrganizationID,
+		AccountType:           res.AccountType,
+		Name:                  res.Name,
+		Slug:                  string(res.Slug),
+		Description:           res.Description,
+		ToolsetVersion:        res.ToolsetVersion,
+		McpIsPublic:           res.McpIsPublic,
+		McpEnabled:            res.McpEnabled,
+		ToolSelectionMode:     res.ToolSelectionMode,
+		CustomDomainID:        res.CustomDomainID,
+		UserSessionIssuerID:   res.UserSessionIssuerID,
+		ToolVariationsGroupID: res.ToolVariationsGroupID,
+		CreatedAt:             res.CreatedAt,
+		UpdatedAt:             res.UpdatedAt,
+	}
+	if res.DefaultEnvironmentSlug != nil {
+		defaultEnvironmentSlug := string(*res.DefaultEnvironmentSlug)
+		body.DefaultEnvironmentSlug = &defaultEnvironmentSlug
+	}
+	if res.McpSlug != nil {
+		mcpSlug := string(*res.McpSlug)
+		body.McpSlug = &mcpSlug
+	}
+	if res.UserSessionIssuerSlug != nil {
+		userSessionIssuerSlug := string(*res.UserSessionIssuerSlug)
+		body.UserSessionIssuerSlug = &userSessionIssuerSlug
+	}
+	if res.SecurityVariables != nil {
+		body.SecurityVariables = make([]*SecurityVariableResponseBody, len(res.SecurityVariables))
+		for i, val := range res.SecurityVariables {
+			if val == nil {
+				body.SecurityVariables[i] = nil
+				continue
+			}
+			body.SecurityVariables[i] = marshalTypesSecurityVariableToSecurityVariableResponseBody(val)
+		}
+	}
+	if res.ServerVariables != nil {
+		body.ServerVariables = make([]*ServerVariableResponseBody, len(res.ServerVariables))
+		for i, val := range res.ServerVariables {
+			if val == nil {
+				body.ServerVariables[i] = nil
+				continue
+			}
+			body.ServerVariables[i] = marshalTypesServerVariableToServerVariableResponseBody(val)
+		}
+	}
+	if res.FunctionEnvironmentVariables != nil {
+		body.FunctionEnvironmentVariables = make([]*FunctionEnvironmentVariableResponseBody, len(res.FunctionEnvironmentVariables))
+		for i, val := range res.FunctionEnvironmentVariables {
+			if val == nil {
+				body.FunctionEnvironmentVariables[i] = nil
+				continue
+			}
+			body.FunctionEnvironmentVariables[i] = marshalTypesFunctionEnvironmentVariableToFunctionEnvironmentVariableResponseBody(val)
+		}
+	}
+	if res.ExternalMcpHeaderDefinitions != nil {
+		body.ExternalMcpHeaderDefinitions = make([]*ExternalMCPHeaderDefinitionResponseBody, len(res.ExternalMcpHeaderDefinitions))
+		for i, val := range res.ExternalMcpHeaderDefinitions {
+			if val == nil {
+				body.ExternalMcpHeaderDefinitions[i] = nil
+				continue
+			}
+			body.ExternalMcpHeaderDefinitions[i] = marshalTypesExternalMCPHeaderDefinitionToExternalMCPHeaderDefinitionResponseBody(val)
+		}
+	}
+	if res.OauthEnablementMetadata != nil {
+		body.OauthEnablementMetadata = marshalTypesOAuthEnablementMetadataToOAuthEnablementMetadataResponseBody(res.OauthEnablementMetadata)
+	}
+	if res.Tools != nil {
+		body.Tools = make([]*ToolResponseBody, len(res.Tools))
+		for i, val := range res.Tools {
+			if val == nil {
+				body.Tools[i] = nil
+				continue
+			}
+			body.Tools[i] = marshalTypesToolToToolResponseBody(val)
+		}
+	} else {
+		body.Tools = []*ToolResponseBody{}
+	}
+	if res.ToolUrns != nil {
+		body.ToolUrns = make([]string, len(res.ToolUrns))
+		for i, val := range res.ToolUrns {
+			body.ToolUrns[i] = val
+		}
+	} else {
+		body.ToolUrns = []string{}
+	}
+	if res.Resources != nil {
+		body.Resources = make([]*ResourceResponseBody, len(res.Resources))
+		for i, val := range res.Resources {
+			if val == nil {
+				body.Resources[i] = nil
+				continue
+			}
+			body.Resources[i] = marshalTypesResourceToResourceResponseBody(val)
+		}
+	} else {
+		body.Resources = []*ResourceResponseBody{}
+	}
+	if res.ResourceUrns != nil {
+		body.ResourceUrns = make([]string, len(res.ResourceUrns))
+		for i, val := range res.ResourceUrns {
+			body.ResourceUrns[i] = val
+		}
+	} else {
+		body.ResourceUrns = []string{}
+	}
+	if res.PromptTemplates != nil {
+		body.PromptTemplates = make([]*PromptTemplateResponseBody, len(res.PromptTemplates))
+		for i, val := range res.PromptTemplates {
+			if val == nil {
+				body.PromptTemplates[i] = nil
+				continue
+			}
+			body.PromptTemplates[i] = marshalTypesPromptTemplateToPromptTemplateResponseBody(val)
+		}
+	} else {
+		body.PromptTemplates = []*PromptTemplateResponseBody{}
+	}
+	if res.Origin != nil {
+		body.Origin = marshalTypesToolsetOriginToToolsetOriginResponseBody(res.Origin)
+	}
+	if res.ExternalOauthServer != nil {
+		body.ExternalOauthServer = marshalTypesExternalOAuthServerToExternalOAuthServerResponseBody(res.ExternalOauthServer)
+	}
+	return body
+}
+
+// NewDiffToolsetVersionsResponseBody builds the HTTP response body from the
+// result of the "diffToolsetVersions" endpoint of the "toolsets" service.
+func NewDiffToolsetVersionsResponseBody(res *toolsets.ToolsetVersionDiff) *DiffToolsetVersionsResponseBody {
+	body := &DiffToolsetVersionsResponseBody{
+		FromVersion: res.FromVersion,
+		ToVersion:   res.ToVersion,
+	}
+	if res.AddedToolUrns != nil {
+		body.AddedToolUrns = make([]string, len(res.AddedToolUrns))
+		for i, val := range res.AddedToolUrns {
+			body.AddedToolUrns[i] = val
+		}
+	} else {
+		body.AddedToolUrns = []string{}
+	}
+	if res.RemovedToolUrns != nil {
+		body.RemovedToolUrns = make([]string, len(res.RemovedToolUrns))
+		for i, val := range res.RemovedToolUrns {
+			body.RemovedToolUrns[i] = val
+		}
+	} else {
+		body.RemovedToolUrns = []string{}
+	}
+	if res.AddedResourceUrns != nil {
+		body.AddedResourceUrns = make([]string, len(res.AddedResourceUrns))
+		for i, val := range res.AddedResourceUrns {
+			body.AddedResourceUrns[i] = val
+		}
+	} else {
+		body.AddedResourceUrns = []string{}
+	}
+	if res.RemovedResourceUrns != nil {
+		body.RemovedResourceUrns = make([]string, len(res.RemovedResourceUrns))
+		for i, val := range res.RemovedResourceUrns {
+			body.RemovedResourceUrns[i] = val
+		}
+	} else {
+		body.RemovedResourceUrns = []string{}
+	}
+	return body
+}
+
+// NewRevertToolsetResponseBody builds the HTTP response body from the result
+// of the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetResponseBody(res *types.Toolset) *RevertToolsetResponseBody {
+	body := &RevertToolsetResponseBody{
 		ID:                    res.ID,
 		ProjectID:             res.ProjectID,
 		OrganizationID:        res.OrganizationID,
@@ -6804,6 +7471,295 @@ func NewSetToolVariationsGroupGatewayErrorResponseBody(res *goa.ServiceError) *S
 	return body
 }
 
+// NewDiffToolsetVersionsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "diffToolsetVersions" endpoint of the "toolsets"
+// service.
+func NewDiffToolsetVersionsUnauthorizedResponseBody(res *goa.ServiceError) *DiffToolsetVersionsUnauthorizedResponseBody {
+	body := &DiffToolsetVersionsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffToolsetVersionsForbiddenResponseBody builds the HTTP response body
+// from the result of the "diffToolsetVersions" endpoint of the "toolsets"
+// service.
+func NewDiffToolsetVersionsForbiddenResponseBody(res *goa.ServiceError) *DiffToolsetVersionsForbiddenResponseBody {
+	body := &DiffToolsetVersionsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffToolsetVersionsBadRequestResponseBody builds the HTTP response body
+// from the result of the "diffToolsetVersions" endpoint of the "toolsets"
+// service.
+func NewDiffToolsetVersionsBadRequestResponseBody(res *goa.ServiceError) *DiffToolsetVersionsBadRequestResponseBody {
+	body := &DiffToolsetVersionsBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffToolsetVersionsNotFoundResponseBody builds the HTTP response body
+// from the result of the "diffToolsetVersions" endpoint of the "toolsets"
+// service.
+func NewDiffToolsetVersionsNotFoundResponseBody(res *goa.ServiceError) *DiffToolsetVersionsNotFoundResponseBody {
+	body := &DiffToolsetVersionsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffToolsetVersionsConflictResponseBody builds the HTTP response body
+// from the result of the "diffToolsetVersions" endpoint of the "toolsets"
+// service.
+func NewDiffToolsetVersionsConflictResponseBody(res *goa.ServiceError) *DiffToolsetVersionsConflictResponseBody {
+	body := &DiffToolsetVersionsConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffToolsetVersionsUnsupportedMediaResponseBody builds the HTTP response
+// body from the result of the "diffToolsetVersions" endpoint of the "toolsets"
+// service.
+func NewDiffToolsetVersionsUnsupportedMediaResponseBody(res *goa.ServiceError) *DiffToolsetVersionsUnsupportedMediaResponseBody {
+	body := &DiffToolsetVersionsUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffToolsetVersionsInvalidResponseBody builds the HTTP response body from
+// the result of the "diffToolsetVersions" endpoint of the "toolsets" service.
+func NewDiffToolsetVersionsInvalidResponseBody(res *goa.ServiceError) *DiffToolsetVersionsInvalidResponseBody {
+	body := &DiffToolsetVersionsInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffToolsetVersionsInvariantViolationResponseBody builds the HTTP
+// response body from the result of the "diffToolsetVersions" endpoint of the
+// "toolsets" service.
+func NewDiffToolsetVersionsInvariantViolationResponseBody(res *goa.ServiceError) *DiffToolsetVersionsInvariantViolationResponseBody {
+	body := &DiffToolsetVersionsInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffToolsetVersionsUnexpectedResponseBody builds the HTTP response body
+// from the result of the "diffToolsetVersions" endpoint of the "toolsets"
+// service.
+func NewDiffToolsetVersionsUnexpectedResponseBody(res *goa.ServiceError) *DiffToolsetVersionsUnexpectedResponseBody {
+	body := &DiffToolsetVersionsUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffToolsetVersionsGatewayErrorResponseBody builds the HTTP response body
+// from the result of the "diffToolsetVersions" endpoint of the "toolsets"
+// service.
+func NewDiffToolsetVersionsGatewayErrorResponseBody(res *goa.ServiceError) *DiffToolsetVersionsGatewayErrorResponseBody {
+	body := &DiffToolsetVersionsGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevertToolsetUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetUnauthorizedResponseBody(res *goa.ServiceError) *RevertToolsetUnauthorizedResponseBody {
+	body := &RevertToolsetUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevertToolsetForbiddenResponseBody builds the HTTP response body from the
+// result of the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetForbiddenResponseBody(res *goa.ServiceError) *RevertToolsetForbiddenResponseBody {
+	body := &RevertToolsetForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevertToolsetBadRequestResponseBody builds the HTTP response body from
+// the result of the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetBadRequestResponseBody(res *goa.ServiceError) *RevertToolsetBadRequestResponseBody {
+	body := &RevertToolsetBadRequestResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevertToolsetNotFoundResponseBody builds the HTTP response body from the
+// result of the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetNotFoundResponseBody(res *goa.ServiceError) *RevertToolsetNotFoundResponseBody {
+	body := &RevertToolsetNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevertToolsetConflictResponseBody builds the HTTP response body from the
+// result of the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetConflictResponseBody(res *goa.ServiceError) *RevertToolsetConflictResponseBody {
+	body := &RevertToolsetConflictResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevertToolsetUnsupportedMediaResponseBody builds the HTTP response body
+// from the result of the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetUnsupportedMediaResponseBody(res *goa.ServiceError) *RevertToolsetUnsupportedMediaResponseBody {
+	body := &RevertToolsetUnsupportedMediaResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevertToolsetInvalidResponseBody builds the HTTP response body from the
+// result of the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetInvalidResponseBody(res *goa.ServiceError) *RevertToolsetInvalidResponseBody {
+	body := &RevertToolsetInvalidResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevertToolsetInvariantViolationResponseBody builds the HTTP response body
+// from the result of the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetInvariantViolationResponseBody(res *goa.ServiceError) *RevertToolsetInvariantViolationResponseBody {
+	body := &RevertToolsetInvariantViolationResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevertToolsetUnexpectedResponseBody builds the HTTP response body from
+// the result of the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetUnexpectedResponseBody(res *goa.ServiceError) *RevertToolsetUnexpectedResponseBody {
+	body := &RevertToolsetUnexpectedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevertToolsetGatewayErrorResponseBody builds the HTTP response body from
+// the result of the "revertToolset" endpoint of the "toolsets" service.
+func NewRevertToolsetGatewayErrorResponseBody(res *goa.ServiceError) *RevertToolsetGatewayErrorResponseBody {
+	body := &RevertToolsetGatewayErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewCreateToolsetPayload builds a toolsets service createToolset endpoint
 // payload.
 func NewCreateToolsetPayload(body *CreateToolsetRequestBody, sessionToken *string, apikeyToken *string, projectSlugInput *string) *toolsets.CreateToolsetPayload {
@@ -7015,6 +7971,34 @@ func NewSetToolVariationsGroupPayload(body *SetToolVariationsGroupRequestBody, s
 	return v
 }
 
+// NewDiffToolsetVersionsPayload builds a toolsets service diffToolsetVersions
+// endpoint payload.
+func NewDiffToolsetVersionsPayload(slug string, fromVersion int64, toVersion int64, sessionToken *string, apikeyToken *string, projectSlugInput *string) *toolsets.DiffToolsetVersionsPayload {
+	v := &toolsets.DiffToolsetVersionsPayload{}
+	v.Slug = types.Slug(slug)
+	v.FromVersion = fromVersion
+	v.ToVersion = toVersion
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v
+}
+
+// NewRevertToolsetPayload builds a toolsets service revertToolset endpoint
+// payload.
+func NewRevertToolsetPayload(body *RevertToolsetRequestBody, slug string, sessionToken *string, apikeyToken *string, projectSlugInput *string) *toolsets.RevertToolsetPayload {
+	v := &toolsets.RevertToolsetPayload{
+		Version: *body.Version,
+	}
+	v.Slug = types.Slug(slug)
+	v.SessionToken = sessionToken
+	v.ApikeyToken = apikeyToken
+	v.ProjectSlugInput = projectSlugInput
+
+	return v
+}
+
 // ValidateCreateToolsetRequestBody runs the validations defined on
 // CreateToolsetRequestBody
 func ValidateCreateToolsetRequestBody(body *CreateToolsetRequestBody) (err error) {
@@ -7091,6 +8075,20 @@ func ValidateSetToolVariationsGroupRequestBody(body *SetToolVariationsGroupReque
 	return
 }
 
+// ValidateRevertToolsetRequestBody runs the validations defined on
+// RevertToolsetRequestBody
+func ValidateRevertToolsetRequestBody(body *RevertToolsetRequestBody) (err error) {
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Version != nil {
+		if *body.Version < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.version", *body.Version, 1, true))
+		}
+	}
+	return
+}
+
 // ValidateToolsetOriginRequestBody runs the validations defined on
 // ToolsetOriginRequestBody
 func ValidateToolsetOriginRequestBody(body *ToolsetOriginRequestBody) (err error) {
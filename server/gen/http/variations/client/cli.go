@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	types "github.com/speakeasy-api/gram/server/gen/types"
 	variations "github.com/speakeasy-api/gram/server/gen/variations"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -23,13 +24,30 @@ func BuildUpsertGlobalPayload(variationsUpsertGlobalBody string, variationsUpser
 	{
 		err = json.Unmarshal([]byte(variationsUpsertGlobalBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"confirm\": \"never\",\n      \"confirm_prompt\": \"abc123\",\n      \"description\": \"abc123\",\n      \"destructive_hint\": false,\n      \"idempotent_hint\": false,\n      \"name\": \"abc123\",\n      \"open_world_hint\": false,\n      \"read_only_hint\": false,\n      \"src_tool_name\": \"abc123\",\n      \"src_tool_urn\": \"abc123\",\n      \"summarizer\": \"abc123\",\n      \"summary\": \"abc123\",\n      \"tags\": [\n         \"abc123\"\n      ],\n      \"title\": \"abc123\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"argument_bindings\": [\n         {\n            \"argument\": \"aa\",\n            \"source\": \"environment\",\n            \"value\": \"aa\"\n         }\n      ],\n      \"cache_shared\": false,\n      \"cache_ttl_seconds\": 2,\n      \"confirm\": \"never\",\n      \"confirm_prompt\": \"abc123\",\n      \"description\": \"abc123\",\n      \"destructive_hint\": false,\n      \"idempotent_hint\": false,\n      \"name\": \"abc123\",\n      \"open_world_hint\": false,\n      \"read_only_hint\": false,\n      \"src_tool_name\": \"abc123\",\n      \"src_tool_urn\": \"abc123\",\n      \"summarizer\": \"abc123\",\n      \"summary\": \"abc123\",\n      \"tags\": [\n         \"abc123\"\n      ],\n      \"title\": \"abc123\"\n   }'")
 		}
 		if body.Confirm != nil {
 			if !(*body.Confirm == "always" || *body.Confirm == "never" || *body.Confirm == "session") {
 				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.confirm", *body.Confirm, []any{"always", "never", "session"}))
 			}
 		}
+		if body.CacheTTLSeconds != nil {
+			if *body.CacheTTLSeconds < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.cache_ttl_seconds", *body.CacheTTLSeconds, 1, true))
+			}
+		}
+		if body.CacheTTLSeconds != nil {
+			if *body.CacheTTLSeconds > 86400 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.cache_ttl_seconds", *body.CacheTTLSeconds, 86400, false))
+			}
+		}
+		for _, e := range body.ArgumentBindings {
+			if e != nil {
+				if err2 := ValidateToolArgumentBindingRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -66,6 +84,8 @@ func BuildUpsertGlobalPayload(variationsUpsertGlobalBody string, variationsUpser
 		DestructiveHint: body.DestructiveHint,
 		IdempotentHint:  body.IdempotentHint,
 		OpenWorldHint:   body.OpenWorldHint,
+		CacheTTLSeconds: body.CacheTTLSeconds,
+		CacheShared:     body.CacheShared,
 	}
 	if body.Tags != nil {
 		v.Tags = make([]string, len(body.Tags))
@@ -73,6 +93,16 @@ func BuildUpsertGlobalPayload(variationsUpsertGlobalBody string, variationsUpser
 			v.Tags[i] = val
 		}
 	}
+	if body.ArgumentBindings != nil {
+		v.ArgumentBindings = make([]*types.ToolArgumentBinding, len(body.ArgumentBindings))
+		for i, val := range body.ArgumentBindings {
+			if val == nil {
+				v.ArgumentBindings[i] = nil
+				continue
+			}
+			v.ArgumentBindings[i] = marshalToolArgumentBindingRequestBodyToTypesToolArgumentBinding(val)
+		}
+	}
 	v.SessionToken = sessionToken
 	v.ApikeyToken = apikeyToken
 	v.ProjectSlugInput = projectSlugInput
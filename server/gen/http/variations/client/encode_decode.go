@@ -1216,6 +1216,38 @@ func DecodeCreateGlobalResponse(decoder func(*http.Response) goahttp.Decoder, re
 	}
 }
 
+// marshalTypesToolArgumentBindingToToolArgumentBindingRequestBody builds a
+// value of type *ToolArgumentBindingRequestBody from a value of type
+// *types.ToolArgumentBinding.
+func marshalTypesToolArgumentBindingToToolArgumentBindingRequestBody(v *types.ToolArgumentBinding) *ToolArgumentBindingRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolArgumentBindingRequestBody{
+		Argument: v.Argument,
+		Source:   v.Source,
+		Value:    v.Value,
+	}
+
+	return res
+}
+
+// marshalToolArgumentBindingRequestBodyToTypesToolArgumentBinding builds a
+// value of type *types.ToolArgumentBinding from a value of type
+// *ToolArgumentBindingRequestBody.
+func marshalToolArgumentBindingRequestBodyToTypesToolArgumentBinding(v *ToolArgumentBindingRequestBody) *types.ToolArgumentBinding {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolArgumentBinding{
+		Argument: v.Argument,
+		Source:   v.Source,
+		Value:    v.Value,
+	}
+
+	return res
+}
+
 // unmarshalToolVariationResponseBodyToTypesToolVariation builds a value of
 // type *types.ToolVariation from a value of type *ToolVariationResponseBody.
 func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResponseBody) *types.ToolVariation {
@@ -1234,6 +1266,8 @@ func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResp
 		DestructiveHint: v.DestructiveHint,
 		IdempotentHint:  v.IdempotentHint,
 		OpenWorldHint:   v.OpenWorldHint,
+		CacheTTLSeconds: v.CacheTTLSeconds,
+		CacheShared:     v.CacheShared,
 		CreatedAt:       *v.CreatedAt,
 		UpdatedAt:       *v.UpdatedAt,
 	}
@@ -1243,6 +1277,32 @@ func unmarshalToolVariationResponseBodyToTypesToolVariation(v *ToolVariationResp
 			res.Tags[i] = val
 		}
 	}
+	if v.ArgumentBindings != nil {
+		res.ArgumentBindings = make([]*types.ToolArgumentBinding, len(v.ArgumentBindings))
+		for i, val := range v.ArgumentBindings {
+			if val == nil {
+				res.ArgumentBindings[i] = nil
+				continue
+			}
+			res.ArgumentBindings[i] = unmarshalToolArgumentBindingResponseBodyToTypesToolArgumentBinding(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalToolArgumentBindingResponseBodyToTypesToolArgumentBinding builds a
+// value of type *types.ToolArgumentBinding from a value of type
+// *ToolArgumentBindingResponseBody.
+func unmarshalToolArgumentBindingResponseBodyToTypesToolArgumentBinding(v *ToolArgumentBindingResponseBody) *types.ToolArgumentBinding {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolArgumentBinding{
+		Argument: *v.Argument,
+		Source:   *v.Source,
+		Value:    *v.Value,
+	}
 
 	return res
 }
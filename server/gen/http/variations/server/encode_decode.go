@@ -1134,6 +1134,22 @@ func EncodeCreateGlobalError(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
+// unmarshalToolArgumentBindingRequestBodyToTypesToolArgumentBinding builds a
+// value of type *types.ToolArgumentBinding from a value of type
+// *ToolArgumentBindingRequestBody.
+func unmarshalToolArgumentBindingRequestBodyToTypesToolArgumentBinding(v *ToolArgumentBindingRequestBody) *types.ToolArgumentBinding {
+	if v == nil {
+		return nil
+	}
+	res := &types.ToolArgumentBinding{
+		Argument: *v.Argument,
+		Source:   *v.Source,
+		Value:    *v.Value,
+	}
+
+	return res
+}
+
 // marshalTypesToolVariationToToolVariationResponseBody builds a value of type
 // *ToolVariationResponseBody from a value of type *types.ToolVariation.
 func marshalTypesToolVariationToToolVariationResponseBody(v *types.ToolVariation) *ToolVariationResponseBody {
@@ -1152,6 +1168,8 @@ func marshalTypesToolVariationToToolVariationResponseBody(v *types.ToolVariation
 		DestructiveHint: v.DestructiveHint,
 		IdempotentHint:  v.IdempotentHint,
 		OpenWorldHint:   v.OpenWorldHint,
+		CacheTTLSeconds: v.CacheTTLSeconds,
+		CacheShared:     v.CacheShared,
 		CreatedAt:       v.CreatedAt,
 		UpdatedAt:       v.UpdatedAt,
 	}
@@ -1161,6 +1179,32 @@ func marshalTypesToolVariationToToolVariationResponseBody(v *types.ToolVariation
 			res.Tags[i] = val
 		}
 	}
+	if v.ArgumentBindings != nil {
+		res.ArgumentBindings = make([]*ToolArgumentBindingResponseBody, len(v.ArgumentBindings))
+		for i, val := range v.ArgumentBindings {
+			if val == nil {
+				res.ArgumentBindings[i] = nil
+				continue
+			}
+			res.ArgumentBindings[i] = marshalTypesToolArgumentBindingToToolArgumentBindingResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalTypesToolArgumentBindingToToolArgumentBindingResponseBody builds a
+// value of type *ToolArgumentBindingResponseBody from a value of type
+// *types.ToolArgumentBinding.
+func marshalTypesToolArgumentBindingToToolArgumentBindingResponseBody(v *types.ToolArgumentBinding) *ToolArgumentBindingResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ToolArgumentBindingResponseBody{
+		Argument: v.Argument,
+		Source:   v.Source,
+		Value:    v.Value,
+	}
 
 	return res
 }
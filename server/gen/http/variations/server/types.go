@@ -8,6 +8,9 @@
 package server
 
 import (
+	"unicode/utf8"
+
+	types "github.com/speakeasy-api/gram/server/gen/types"
 	variations "github.com/speakeasy-api/gram/server/gen/variations"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -43,6 +46,15 @@ type UpsertGlobalRequestBody struct {
 	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
 	// Override: if true, the tool interacts with external entities
 	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+	// How long the gateway caches responses of this tool. Only applies to
+	// read-only or idempotent tools; unset disables caching.
+	CacheTTLSeconds *int32 `form:"cache_ttl_seconds,omitempty" json:"cache_ttl_seconds,omitempty" xml:"cache_ttl_seconds,omitempty"`
+	// If true, cached responses are shared between callers with identical
+	// environments instead of being kept per caller
+	CacheShared *bool `form:"cache_shared,omitempty" json:"cache_shared,omitempty" xml:"cache_shared,omitempty"`
+	// Arguments filled server-side on every call and hidden from the tool's input
+	// schema. Bound values cannot be chosen by the model.
+	ArgumentBindings []*ToolArgumentBindingRequestBody `form:"argument_bindings,omitempty" json:"argument_bindings,omitempty" xml:"argument_bindings,omitempty"`
 }
 
 // UpsertGlobalResponseBody is the type of the "variations" service
@@ -1018,12 +1030,33 @@ type ToolVariationResponseBody struct {
 	IdempotentHint *bool `form:"idempotent_hint,omitempty" json:"idempotent_hint,omitempty" xml:"idempotent_hint,omitempty"`
 	// Override: if true, the tool interacts with external entities
 	OpenWorldHint *bool `form:"open_world_hint,omitempty" json:"open_world_hint,omitempty" xml:"open_world_hint,omitempty"`
+	// How long the gateway caches responses of this tool
+	CacheTTLSeconds *int32 `form:"cache_ttl_seconds,omitempty" json:"cache_ttl_seconds,omitempty" xml:"cache_ttl_seconds,omitempty"`
+	// If true, cached responses are shared between callers with identical
+	// environments
+	CacheShared *bool `form:"cache_shared,omitempty" json:"cache_shared,omitempty" xml:"cache_shared,omitempty"`
+	// Arguments filled server-side on every call and hidden from the tool's input
+	// schema
+	ArgumentBindings []*ToolArgumentBindingResponseBody `form:"argument_bindings,omitempty" json:"argument_bindings,omitempty" xml:"argument_bindings,omitempty"`
 	// The creation date of the tool variation
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
 	// The last update date of the tool variation
 	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
+// ToolArgumentBindingResponseBody is used to define fields on response body
+// types.
+type ToolArgumentBindingResponseBody struct {
+	// Dotted path of the bound argument in the tool's input, e.g. body.tenant_id
+	Argument string `form:"argument" json:"argument" xml:"argument"`
+	// Where the bound value comes from
+	Source string `form:"source" json:"source" xml:"source"`
+	// A JSON literal for constant bindings, an environment variable name for
+	// environment bindings, or a claim of the authenticated user (email, user_id,
+	// external_user_id, organization_id, organization_slug) for principal bindings
+	Value string `form:"value" json:"value" xml:"value"`
+}
+
 // ToolVariationGroupResponseBody is used to define fields on response body
 // types.
 type ToolVariationGroupResponseBody struct {
@@ -1039,6 +1072,19 @@ type ToolVariationGroupResponseBody struct {
 	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
+// ToolArgumentBindingRequestBody is used to define fields on request body
+// types.
+type ToolArgumentBindingRequestBody struct {
+	// Dotted path of the bound argument in the tool's input, e.g. body.tenant_id
+	Argument *string `form:"argument,omitempty" json:"argument,omitempty" xml:"argument,omitempty"`
+	// Where the bound value comes from
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// A JSON literal for constant bindings, an environment variable name for
+	// environment bindings, or a claim of the authenticated user (email, user_id,
+	// external_user_id, organization_id, organization_slug) for principal bindings
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
 // NewUpsertGlobalResponseBody builds the HTTP response body from the result of
 // the "upsertGlobal" endpoint of the "variations" service.
 func NewUpsertGlobalResponseBody(res *variations.UpsertGlobalToolVariationResult) *UpsertGlobalResponseBody {
@@ -1823,6 +1869,8 @@ func NewUpsertGlobalPayload(body *UpsertGlobalRequestBody, sessionToken *string,
 		DestructiveHint: body.DestructiveHint,
 		IdempotentHint:  body.IdempotentHint,
 		OpenWorldHint:   body.OpenWorldHint,
+		CacheTTLSeconds: body.CacheTTLSeconds,
+		CacheShared:     body.CacheShared,
 	}
 	if body.Tags != nil {
 		v.Tags = make([]string, len(body.Tags))
@@ -1830,6 +1878,16 @@ func NewUpsertGlobalPayload(body *UpsertGlobalRequestBody, sessionToken *string,
 			v.Tags[i] = val
 		}
 	}
+	if body.ArgumentBindings != nil {
+		v.ArgumentBindings = make([]*types.ToolArgumentBinding, len(body.ArgumentBindings))
+		for i, val := range body.ArgumentBindings {
+			if val == nil {
+				v.ArgumentBindings[i] = nil
+				continue
+			}
+			v.ArgumentBindings[i] = unmarshalToolArgumentBindingRequestBodyToTypesToolArgumentBinding(val)
+		}
+	}
 	v.SessionToken = sessionToken
 	v.ApikeyToken = apikeyToken
 	v.ProjectSlugInput = projectSlugInput
@@ -1894,5 +1952,52 @@ func ValidateUpsertGlobalRequestBody(body *UpsertGlobalRequestBody) (err error)
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.confirm", *body.Confirm, []any{"always", "never", "session"}))
 		}
 	}
+	if body.CacheTTLSeconds != nil {
+		if *body.CacheTTLSeconds < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cache_ttl_seconds", *body.CacheTTLSeconds, 1, true))
+		}
+	}
+	if body.CacheTTLSeconds != nil {
+		if *body.CacheTTLSeconds > 86400 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cache_ttl_seconds", *body.CacheTTLSeconds, 86400, false))
+		}
+	}
+	for _, e := range body.ArgumentBindings {
+		if e != nil {
+			if err2 := ValidateToolArgumentBindingRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateToolArgumentBindingRequestBody runs the validations defined on
+// ToolArgumentBindingRequestBody
+func ValidateToolArgumentBindingRequestBody(body *ToolArgumentBindingRequestBody) (err error) {
+	if body.Argument == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("argument", "body"))
+	}
+	if body.Source == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("source", "body"))
+	}
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.Argument != nil {
+		if utf8.RuneCountInString(*body.Argument) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.argument", *body.Argument, utf8.RuneCountInString(*body.Argument), 1, true))
+		}
+	}
+	if body.Source != nil {
+		if !(*body.Source == "constant" || *body.Source == "environment" || *body.Source == "principal") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.source", *body.Source, []any{"constant", "environment", "principal"}))
+		}
+	}
+	if body.Value != nil {
+		if utf8.RuneCountInString(*body.Value) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", *body.Value, utf8.RuneCountInString(*body.Value), 1, true))
+		}
+	}
 	return
 }
@@ -0,0 +1,248 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// webhookEndpoints HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	webhookendpoints "github.com/speakeasy-api/gram/server/gen/webhook_endpoints"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreateEndpointPayload builds the payload for the webhookEndpoints
+// createEndpoint endpoint from CLI flags.
+func BuildCreateEndpointPayload(webhookEndpointsCreateEndpointBody string, webhookEndpointsCreateEndpointSessionToken string) (*webhookendpoints.CreateEndpointPayload, error) {
+	var err error
+	var body CreateEndpointRequestBody
+	{
+		err = json.Unmarshal([]byte(webhookEndpointsCreateEndpointBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"aaa\",\n      \"event_types\": [\n         \"abc123\"\n      ],\n      \"url\": \"aaa\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.URL) > 2048 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", body.URL, utf8.RuneCountInString(body.URL), 2048, false))
+		}
+		if body.Description != nil {
+			if utf8.RuneCountInString(*body.Description) > 500 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 500, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if webhookEndpointsCreateEndpointSessionToken != "" {
+			sessionToken = &webhookEndpointsCreateEndpointSessionToken
+		}
+	}
+	v := &webhookendpoints.CreateEndpointPayload{
+		URL:         body.URL,
+		Description: body.Description,
+	}
+	if body.EventTypes != nil {
+		v.EventTypes = make([]string, len(body.EventTypes))
+		for i, val := range body.EventTypes {
+			v.EventTypes[i] = val
+		}
+	}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildListEndpointsPayload builds the payload for the webhookEndpoints
+// listEndpoints endpoint from CLI flags.
+func BuildListEndpointsPayload(webhookEndpointsListEndpointsSessionToken string) (*webhookendpoints.ListEndpointsPayload, error) {
+	var sessionToken *string
+	{
+		if webhookEndpointsListEndpointsSessionToken != "" {
+			sessionToken = &webhookEndpointsListEndpointsSessionToken
+		}
+	}
+	v := &webhookendpoints.ListEndpointsPayload{}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildUpdateEndpointPayload builds the payload for the webhookEndpoints
+// updateEndpoint endpoint from CLI flags.
+func BuildUpdateEndpointPayload(webhookEndpointsUpdateEndpointBody string, webhookEndpointsUpdateEndpointSessionToken string) (*webhookendpoints.UpdateEndpointPayload, error) {
+	var err error
+	var body UpdateEndpointRequestBody
+	{
+		err = json.Unmarshal([]byte(webhookEndpointsUpdateEndpointBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"aaa\",\n      \"disabled\": false,\n      \"event_types\": [\n         \"abc123\"\n      ],\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\",\n      \"url\": \"aaa\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatUUID))
+		if body.URL != nil {
+			if utf8.RuneCountInString(*body.URL) > 2048 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.url", *body.URL, utf8.RuneCountInString(*body.URL), 2048, false))
+			}
+		}
+		if body.Description != nil {
+			if utf8.RuneCountInString(*body.Description) > 500 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.description", *body.Description, utf8.RuneCountInString(*body.Description), 500, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if webhookEndpointsUpdateEndpointSessionToken != "" {
+			sessionToken = &webhookEndpointsUpdateEndpointSessionToken
+		}
+	}
+	v := &webhookendpoints.UpdateEndpointPayload{
+		ID:          body.ID,
+		URL:         body.URL,
+		Description: body.Description,
+		Disabled:    body.Disabled,
+	}
+	if body.EventTypes != nil {
+		v.EventTypes = make([]string, len(body.EventTypes))
+		for i, val := range body.EventTypes {
+			v.EventTypes[i] = val
+		}
+	}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildDeleteEndpointPayload builds the payload for the webhookEndpoints
+// deleteEndpoint endpoint from CLI flags.
+func BuildDeleteEndpointPayload(webhookEndpointsDeleteEndpointID string, webhookEndpointsDeleteEndpointSessionToken string) (*webhookendpoints.DeleteEndpointPayload, error) {
+	var err error
+	var id string
+	{
+		id = webhookEndpointsDeleteEndpointID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if webhookEndpointsDeleteEndpointSessionToken != "" {
+			sessionToken = &webhookEndpointsDeleteEndpointSessionToken
+		}
+	}
+	v := &webhookendpoints.DeleteEndpointPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildListDeliveriesPayload builds the payload for the webhookEndpoints
+// listDeliveries endpoint from CLI flags.
+func BuildListDeliveriesPayload(webhookEndpointsListDeliveriesEndpointID string, webhookEndpointsListDeliveriesDeliveryStatus string, webhookEndpointsListDeliveriesCursor string, webhookEndpointsListDeliveriesLimit string, webhookEndpointsListDeliveriesSessionToken string) (*webhookendpoints.ListDeliveriesPayload, error) {
+	var err error
+	var endpointID string
+	{
+		endpointID = webhookEndpointsListDeliveriesEndpointID
+		err = goa.MergeErrors(err, goa.ValidateFormat("endpoint_id", endpointID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var deliveryStatus *string
+	{
+		if webhookEndpointsListDeliveriesDeliveryStatus != "" {
+			deliveryStatus = &webhookEndpointsListDeliveriesDeliveryStatus
+			if !(*deliveryStatus == "pending" || *deliveryStatus == "succeeded" || *deliveryStatus == "dead_lettered") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("delivery_status", *deliveryStatus, []any{"pending", "succeeded", "dead_lettered"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if webhookEndpointsListDeliveriesCursor != "" {
+			cursor = &webhookEndpointsListDeliveriesCursor
+			err = goa.MergeErrors(err, goa.ValidateFormat("cursor", *cursor, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int32
+	{
+		if webhookEndpointsListDeliveriesLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(webhookEndpointsListDeliveriesLimit, 10, 32)
+			limit = int32(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT32")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sessionToken *string
+	{
+		if webhookEndpointsListDeliveriesSessionToken != "" {
+			sessionToken = &webhookEndpointsListDeliveriesSessionToken
+		}
+	}
+	v := &webhookendpoints.ListDeliveriesPayload{}
+	v.EndpointID = endpointID
+	v.DeliveryStatus = deliveryStatus
+	v.Cursor = cursor
+	v.Limit = limit
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildRedeliverPayload builds the payload for the webhookEndpoints redeliver
+// endpoint from CLI flags.
+func BuildRedeliverPayload(webhookEndpointsRedeliverBody string, webhookEndpointsRedeliverSessionToken string) (*webhookendpoints.RedeliverPayload, error) {
+	var err error
+	var body RedeliverRequestBody
+	{
+		err = json.Unmarshal([]byte(webhookEndpointsRedeliverBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"550e8400-e29b-41d4-a716-446655440000\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken *string
+	{
+		if webhookEndpointsRedeliverSessionToken != "" {
+			sessionToken = &webhookEndpointsRedeliverSessionToken
+		}
+	}
+	v := &webhookendpoints.RedeliverPayload{
+		ID: body.ID,
+	}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
@@ -0,0 +1,38 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// HTTP request path constructors for the webhookEndpoints service.
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package server
+
+// CreateEndpointWebhookEndpointsPath returns the URL path to the webhookEndpoints service createEndpoint HTTP endpoint.
+func CreateEndpointWebhookEndpointsPath() string {
+	return "/rpc/webhookEndpoints.create"
+}
+
+// ListEndpointsWebhookEndpointsPath returns the URL path to the webhookEndpoints service listEndpoints HTTP endpoint.
+func ListEndpointsWebhookEndpointsPath() string {
+	return "/rpc/webhookEndpoints.list"
+}
+
+// UpdateEndpointWebhookEndpointsPath returns the URL path to the webhookEndpoints service updateEndpoint HTTP endpoint.
+func UpdateEndpointWebhookEndpointsPath() string {
+	return "/rpc/webhookEndpoints.update"
+}
+
+// DeleteEndpointWebhookEndpointsPath returns the URL path to the webhookEndpoints service deleteEndpoint HTTP endpoint.
+func DeleteEndpointWebhookEndpointsPath() string {
+	return "/rpc/webhookEndpoints.delete"
+}
+
+// ListDeliveriesWebhookEndpointsPath returns the URL path to the webhookEndpoints service listDeliveries HTTP endpoint.
+func ListDeliveriesWebhookEndpointsPath() string {
+	return "/rpc/webhookEndpoints.listDeliveries"
+}
+
+// RedeliverWebhookEndpointsPath returns the URL path to the webhookEndpoints service redeliver HTTP endpoint.
+func RedeliverWebhookEndpointsPath() string {
+	return "/rpc/webhookEndpoints.redeliver"
+}
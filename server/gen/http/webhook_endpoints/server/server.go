@@ -0,0 +1,420 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// webhookEndpoints HTTP server
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	webhookendpoints "github.com/speakeasy-api/gram/server/gen/webhook_endpoints"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Server lists the webhookEndpoints service endpoint HTTP handlers.
+type Server struct {
+	Mounts         []*MountPoint
+	CreateEndpoint http.Handler
+	ListEndpoints  http.Handler
+	UpdateEndpoint http.Handler
+	DeleteEndpoint http.Handler
+	ListDeliveries http.Handler
+	Redeliver      http.Handler
+}
+
+// MountPoint holds information about the mounted endpoints.
+type MountPoint struct {
+	// Method is the name of the service method served by the mounted HTTP handler.
+	Method string
+	// Verb is the HTTP method used to match requests to the mounted handler.
+	Verb string
+	// Pattern is the HTTP request path pattern used to match requests to the
+	// mounted handler.
+	Pattern string
+}
+
+// New instantiates HTTP handlers for all the webhookEndpoints service
+// endpoints using the provided encoder and decoder. The handlers are mounted
+// on the given mux using the HTTP verb and path defined in the design.
+// errhandler is called whenever a response fails to be encoded. formatter is
+// used to format errors returned by the service methods prior to encoding.
+// Both errhandler and formatter are optional and can be nil.
+func New(
+	e *webhookendpoints.Endpoints,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) *Server {
+	return &Server{
+		Mounts: []*MountPoint{
+			{"CreateEndpoint", "POST", "/rpc/webhookEndpoints.create"},
+			{"ListEndpoints", "GET", "/rpc/webhookEndpoints.list"},
+			{"UpdateEndpoint", "POST", "/rpc/webhookEndpoints.update"},
+			{"DeleteEndpoint", "DELETE", "/rpc/webhookEndpoints.delete"},
+			{"ListDeliveries", "GET", "/rpc/webhookEndpoints.listDeliveries"},
+			{"Redeliver", "POST", "/rpc/webhookEndpoints.redeliver"},
+		},
+		CreateEndpoint: NewCreateEndpointHandler(e.CreateEndpoint, mux, decoder, encoder, errhandler, formatter),
+		ListEndpoints:  NewListEndpointsHandler(e.ListEndpoints, mux, decoder, encoder, errhandler, formatter),
+		UpdateEndpoint: NewUpdateEndpointHandler(e.UpdateEndpoint, mux, decoder, encoder, errhandler, formatter),
+		DeleteEndpoint: NewDeleteEndpointHandler(e.DeleteEndpoint, mux, decoder, encoder, errhandler, formatter),
+		ListDeliveries: NewListDeliveriesHandler(e.ListDeliveries, mux, decoder, encoder, errhandler, formatter),
+		Redeliver:      NewRedeliverHandler(e.Redeliver, mux, decoder, encoder, errhandler, formatter),
+	}
+}
+
+// Service returns the name of the service served.
+func (s *Server) Service() string { return "webhookEndpoints" }
+
+// Use wraps the server handlers with the given middleware.
+func (s *Server) Use(m func(http.Handler) http.Handler) {
+	s.CreateEndpoint = m(s.CreateEndpoint)
+	s.ListEndpoints = m(s.ListEndpoints)
+	s.UpdateEndpoint = m(s.UpdateEndpoint)
+	s.DeleteEndpoint = m(s.DeleteEndpoint)
+	s.ListDeliveries = m(s.ListDeliveries)
+	s.Redeliver = m(s.Redeliver)
+}
+
+// MethodNames returns the methods served.
+func (s *Server) MethodNames() []string { return webhookendpoints.MethodNames[:] }
+
+// Mount configures the mux to serve the webhookEndpoints endpoints.
+func Mount(mux goahttp.Muxer, h *Server) {
+	MountCreateEndpointHandler(mux, h.CreateEndpoint)
+	MountListEndpointsHandler(mux, h.ListEndpoints)
+	MountUpdateEndpointHandler(mux, h.UpdateEndpoint)
+	MountDeleteEndpointHandler(mux, h.DeleteEndpoint)
+	MountListDeliveriesHandler(mux, h.ListDeliveries)
+	MountRedeliverHandler(mux, h.Redeliver)
+}
+
+// Mount configures the mux to serve the webhookEndpoints endpoints.
+func (s *Server) Mount(mux goahttp.Muxer) {
+	Mount(mux, s)
+}
+
+// MountCreateEndpointHandler configures the mux to serve the
+// "webhookEndpoints" service "createEndpoint" endpoint.
+func MountCreateEndpointHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/webhookEndpoints.create", f)
+}
+
+// NewCreateEndpointHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhookEndpoints" service "createEndpoint" endpoint.
+func NewCreateEndpointHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateEndpointRequest(mux, decoder)
+		encodeResponse = EncodeCreateEndpointResponse(encoder)
+		encodeError    = EncodeCreateEndpointError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "createEndpoint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhookEndpoints")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListEndpointsHandler configures the mux to serve the "webhookEndpoints"
+// service "listEndpoints" endpoint.
+func MountListEndpointsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/rpc/webhookEndpoints.list", f)
+}
+
+// NewListEndpointsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhookEndpoints" service "listEndpoints" endpoint.
+func NewListEndpointsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListEndpointsRequest(mux, decoder)
+		encodeResponse = EncodeListEndpointsResponse(encoder)
+		encodeError    = EncodeListEndpointsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listEndpoints")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhookEndpoints")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUpdateEndpointHandler configures the mux to serve the
+// "webhookEndpoints" service "updateEndpoint" endpoint.
+func MountUpdateEndpointHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/webhookEndpoints.update", f)
+}
+
+// NewUpdateEndpointHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhookEndpoints" service "updateEndpoint" endpoint.
+func NewUpdateEndpointHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateEndpointRequest(mux, decoder)
+		encodeResponse = EncodeUpdateEndpointResponse(encoder)
+		encodeError    = EncodeUpdateEndpointError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "updateEndpoint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhookEndpoints")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteEndpointHandler configures the mux to serve the
+// "webhookEndpoints" service "deleteEndpoint" endpoint.
+func MountDeleteEndpointHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/rpc/webhookEndpoints.delete", f)
+}
+
+// NewDeleteEndpointHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhookEndpoints" service "deleteEndpoint" endpoint.
+func NewDeleteEndpointHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteEndpointRequest(mux, decoder)
+		encodeResponse = EncodeDeleteEndpointResponse(encoder)
+		encodeError    = EncodeDeleteEndpointError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "deleteEndpoint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhookEndpoints")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListDeliveriesHandler configures the mux to serve the
+// "webhookEndpoints" service "listDeliveries" endpoint.
+func MountListDeliveriesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/rpc/webhookEndpoints.listDeliveries", f)
+}
+
+// NewListDeliveriesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhookEndpoints" service "listDeliveries" endpoint.
+func NewListDeliveriesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListDeliveriesRequest(mux, decoder)
+		encodeResponse = EncodeListDeliveriesResponse(encoder)
+		encodeError    = EncodeListDeliveriesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "listDeliveries")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhookEndpoints")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRedeliverHandler configures the mux to serve the "webhookEndpoints"
+// service "redeliver" endpoint.
+func MountRedeliverHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/rpc/webhookEndpoints.redeliver", f)
+}
+
+// NewRedeliverHandler creates a HTTP handler which loads the HTTP request and
+// calls the "webhookEndpoints" service "redeliver" endpoint.
+func NewRedeliverHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRedeliverRequest(mux, decoder)
+		encodeResponse = EncodeRedeliverResponse(encoder)
+		encodeError    = EncodeRedeliverError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "redeliver")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhookEndpoints")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
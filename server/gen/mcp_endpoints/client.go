@@ -22,10 +22,15 @@ type Client struct {
 	UpdateMcpEndpointEndpoint                goa.Endpoint
 	CheckMcpEndpointSlugAvailabilityEndpoint goa.Endpoint
 	DeleteMcpEndpointEndpoint                goa.Endpoint
+	StartMcpEndpointCanaryEndpoint           goa.Endpoint
+	GetMcpEndpointCanaryEndpoint             goa.Endpoint
+	UpdateMcpEndpointCanaryEndpoint          goa.Endpoint
+	PromoteMcpEndpointCanaryEndpoint         goa.Endpoint
+	AbortMcpEndpointCanaryEndpoint           goa.Endpoint
 }
 
 // NewClient initializes a "mcpEndpoints" service client given the endpoints.
-func NewClient(createMcpEndpoint, getMcpEndpoint, listMcpEndpoints, updateMcpEndpoint, checkMcpEndpointSlugAvailability, deleteMcpEndpoint goa.Endpoint) *Client {
+func NewClient(createMcpEndpoint, getMcpEndpoint, listMcpEndpoints, updateMcpEndpoint, checkMcpEndpointSlugAvailability, deleteMcpEndpoint, startMcpEndpointCanary, getMcpEndpointCanary, updateMcpEndpointCanary, promoteMcpEndpointCanary, abortMcpEndpointCanary goa.Endpoint) *Client {
 	return &Client{
 		CreateMcpEndpointEndpoint:                createMcpEndpoint,
 		GetMcpEndpointEndpoint:                   getMcpEndpoint,
@@ -33,6 +38,11 @@ func NewClient(createMcpEndpoint, getMcpEndpoint, listMcpEndpoints, updateMcpEnd
 		UpdateMcpEndpointEndpoint:                updateMcpEndpoint,
 		CheckMcpEndpointSlugAvailabilityEndpoint: checkMcpEndpointSlugAvailability,
 		DeleteMcpEndpointEndpoint:                deleteMcpEndpoint,
+		StartMcpEndpointCanaryEndpoint:           startMcpEndpointCanary,
+		GetMcpEndpointCanaryEndpoint:             getMcpEndpointCanary,
+		UpdateMcpEndpointCanaryEndpoint:          updateMcpEndpointCanary,
+		PromoteMcpEndpointCanaryEndpoint:         promoteMcpEndpointCanary,
+		AbortMcpEndpointCanaryEndpoint:           abortMcpEndpointCanary,
 	}
 }
 
@@ -169,3 +179,118 @@ func (c *Client) DeleteMcpEndpoint(ctx context.Context, p *DeleteMcpEndpointPayl
 	_, err = c.DeleteMcpEndpointEndpoint(ctx, p)
 	return
 }
+
+// StartMcpEndpointCanary calls the "startMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+// StartMcpEndpointCanary may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) StartMcpEndpointCanary(ctx context.Context, p *StartMcpEndpointCanaryPayload) (res *McpEndpointCanary, err error) {
+	var ires any
+	ires, err = c.StartMcpEndpointCanaryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*McpEndpointCanary), nil
+}
+
+// GetMcpEndpointCanary calls the "getMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+// GetMcpEndpointCanary may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) GetMcpEndpointCanary(ctx context.Context, p *GetMcpEndpointCanaryPayload) (res *McpEndpointCanary, err error) {
+	var ires any
+	ires, err = c.GetMcpEndpointCanaryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*McpEndpointCanary), nil
+}
+
+// UpdateMcpEndpointCanary calls the "updateMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+// UpdateMcpEndpointCanary may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) UpdateMcpEndpointCanary(ctx context.Context, p *UpdateMcpEndpointCanaryPayload) (res *McpEndpointCanary, err error) {
+	var ires any
+	ires, err = c.UpdateMcpEndpointCanaryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*McpEndpointCanary), nil
+}
+
+// PromoteMcpEndpointCanary calls the "promoteMcpEndpointCanary" endpoint of
+// the "mcpEndpoints" service.
+// PromoteMcpEndpointCanary may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) PromoteMcpEndpointCanary(ctx context.Context, p *PromoteMcpEndpointCanaryPayload) (res *McpEndpointCanary, err error) {
+	var ires any
+	ires, err = c.PromoteMcpEndpointCanaryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*McpEndpointCanary), nil
+}
+
+// AbortMcpEndpointCanary calls the "abortMcpEndpointCanary" endpoint of the
+// "mcpEndpoints" service.
+// AbortMcpEndpointCanary may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) AbortMcpEndpointCanary(ctx context.Context, p *AbortMcpEndpointCanaryPayload) (res *McpEndpointCanary, err error) {
+	var ires any
+	ires, err = c.AbortMcpEndpointCanaryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*McpEndpointCanary), nil
+}
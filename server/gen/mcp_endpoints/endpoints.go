@@ -22,6 +22,11 @@ type Endpoints struct {
 	UpdateMcpEndpoint                goa.Endpoint
 	CheckMcpEndpointSlugAvailability goa.Endpoint
 	DeleteMcpEndpoint                goa.Endpoint
+	StartMcpEndpointCanary           goa.Endpoint
+	GetMcpEndpointCanary             goa.Endpoint
+	UpdateMcpEndpointCanary          goa.Endpoint
+	PromoteMcpEndpointCanary         goa.Endpoint
+	AbortMcpEndpointCanary           goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "mcpEndpoints" service with endpoints.
@@ -35,6 +40,11 @@ func NewEndpoints(s Service) *Endpoints {
 		UpdateMcpEndpoint:                NewUpdateMcpEndpointEndpoint(s, a.APIKeyAuth),
 		CheckMcpEndpointSlugAvailability: NewCheckMcpEndpointSlugAvailabilityEndpoint(s, a.APIKeyAuth),
 		DeleteMcpEndpoint:                NewDeleteMcpEndpointEndpoint(s, a.APIKeyAuth),
+		StartMcpEndpointCanary:           NewStartMcpEndpointCanaryEndpoint(s, a.APIKeyAuth),
+		GetMcpEndpointCanary:             NewGetMcpEndpointCanaryEndpoint(s, a.APIKeyAuth),
+		UpdateMcpEndpointCanary:          NewUpdateMcpEndpointCanaryEndpoint(s, a.APIKeyAuth),
+		PromoteMcpEndpointCanary:         NewPromoteMcpEndpointCanaryEndpoint(s, a.APIKeyAuth),
+		AbortMcpEndpointCanary:           NewAbortMcpEndpointCanaryEndpoint(s, a.APIKeyAuth),
 	}
 }
 
@@ -46,6 +56,11 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.UpdateMcpEndpoint = m(e.UpdateMcpEndpoint)
 	e.CheckMcpEndpointSlugAvailability = m(e.CheckMcpEndpointSlugAvailability)
 	e.DeleteMcpEndpoint = m(e.DeleteMcpEndpoint)
+	e.StartMcpEndpointCanary = m(e.StartMcpEndpointCanary)
+	e.GetMcpEndpointCanary = m(e.GetMcpEndpointCanary)
+	e.UpdateMcpEndpointCanary = m(e.UpdateMcpEndpointCanary)
+	e.PromoteMcpEndpointCanary = m(e.PromoteMcpEndpointCanary)
+	e.AbortMcpEndpointCanary = m(e.AbortMcpEndpointCanary)
 }
 
 // NewCreateMcpEndpointEndpoint returns an endpoint function that calls the
@@ -402,3 +417,298 @@ func NewDeleteMcpEndpointEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFun
 		return nil, s.DeleteMcpEndpoint(ctx, p)
 	}
 }
+
+// NewStartMcpEndpointCanaryEndpoint returns an endpoint function that calls
+// the method "startMcpEndpointCanary" of service "mcpEndpoints".
+func NewStartMcpEndpointCanaryEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*StartMcpEndpointCanaryPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "session",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var key string
+		if p.SessionToken != nil {
+			key = *p.SessionToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err == nil {
+			sc := security.APIKeyScheme{
+				Name:           "project_slug",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.ProjectSlugInput != nil {
+				key = *p.ProjectSlugInput
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "apikey",
+				Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+				RequiredScopes: []string{"producer"},
+			}
+			var key string
+			if p.ApikeyToken != nil {
+				key = *p.ApikeyToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+			if err == nil {
+				sc := security.APIKeyScheme{
+					Name:           "project_slug",
+					Scopes:         []string{},
+					RequiredScopes: []string{"producer"},
+				}
+				var key string
+				if p.ProjectSlugInput != nil {
+					key = *p.ProjectSlugInput
+				}
+				ctx, err = authAPIKeyFn(ctx, key, &sc)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.StartMcpEndpointCanary(ctx, p)
+	}
+}
+
+// NewGetMcpEndpointCanaryEndpoint returns an endpoint function that calls the
+// method "getMcpEndpointCanary" of service "mcpEndpoints".
+func NewGetMcpEndpointCanaryEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetMcpEndpointCanaryPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "session",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var key string
+		if p.SessionToken != nil {
+			key = *p.SessionToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err == nil {
+			sc := security.APIKeyScheme{
+				Name:           "project_slug",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.ProjectSlugInput != nil {
+				key = *p.ProjectSlugInput
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "apikey",
+				Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+				RequiredScopes: []string{"producer"},
+			}
+			var key string
+			if p.ApikeyToken != nil {
+				key = *p.ApikeyToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+			if err == nil {
+				sc := security.APIKeyScheme{
+					Name:           "project_slug",
+					Scopes:         []string{},
+					RequiredScopes: []string{"producer"},
+				}
+				var key string
+				if p.ProjectSlugInput != nil {
+					key = *p.ProjectSlugInput
+				}
+				ctx, err = authAPIKeyFn(ctx, key, &sc)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.GetMcpEndpointCanary(ctx, p)
+	}
+}
+
+// NewUpdateMcpEndpointCanaryEndpoint returns an endpoint function that calls
+// the method "updateMcpEndpointCanary" of service "mcpEndpoints".
+func NewUpdateMcpEndpointCanaryEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateMcpEndpointCanaryPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "session",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var key string
+		if p.SessionToken != nil {
+			key = *p.SessionToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err == nil {
+			sc := security.APIKeyScheme{
+				Name:           "project_slug",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.ProjectSlugInput != nil {
+				key = *p.ProjectSlugInput
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "apikey",
+				Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+				RequiredScopes: []string{"producer"},
+			}
+			var key string
+			if p.ApikeyToken != nil {
+				key = *p.ApikeyToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+			if err == nil {
+				sc := security.APIKeyScheme{
+					Name:           "project_slug",
+					Scopes:         []string{},
+					RequiredScopes: []string{"producer"},
+				}
+				var key string
+				if p.ProjectSlugInput != nil {
+					key = *p.ProjectSlugInput
+				}
+				ctx, err = authAPIKeyFn(ctx, key, &sc)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateMcpEndpointCanary(ctx, p)
+	}
+}
+
+// NewPromoteMcpEndpointCanaryEndpoint returns an endpoint function that calls
+// the method "promoteMcpEndpointCanary" of service "mcpEndpoints".
+func NewPromoteMcpEndpointCanaryEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PromoteMcpEndpointCanaryPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "session",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var key string
+		if p.SessionToken != nil {
+			key = *p.SessionToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err == nil {
+			sc := security.APIKeyScheme{
+				Name:           "project_slug",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.ProjectSlugInput != nil {
+				key = *p.ProjectSlugInput
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "apikey",
+				Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+				RequiredScopes: []string{"producer"},
+			}
+			var key string
+			if p.ApikeyToken != nil {
+				key = *p.ApikeyToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+			if err == nil {
+				sc := security.APIKeyScheme{
+					Name:           "project_slug",
+					Scopes:         []string{},
+					RequiredScopes: []string{"producer"},
+				}
+				var key string
+				if p.ProjectSlugInput != nil {
+					key = *p.ProjectSlugInput
+				}
+				ctx, err = authAPIKeyFn(ctx, key, &sc)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.PromoteMcpEndpointCanary(ctx, p)
+	}
+}
+
+// NewAbortMcpEndpointCanaryEndpoint returns an endpoint function that calls
+// the method "abortMcpEndpointCanary" of service "mcpEndpoints".
+func NewAbortMcpEndpointCanaryEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AbortMcpEndpointCanaryPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "session",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var key string
+		if p.SessionToken != nil {
+			key = *p.SessionToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err == nil {
+			sc := security.APIKeyScheme{
+				Name:           "project_slug",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.ProjectSlugInput != nil {
+				key = *p.ProjectSlugInput
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "apikey",
+				Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+				RequiredScopes: []string{"producer"},
+			}
+			var key string
+			if p.ApikeyToken != nil {
+				key = *p.ApikeyToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+			if err == nil {
+				sc := security.APIKeyScheme{
+					Name:           "project_slug",
+					Scopes:         []string{},
+					RequiredScopes: []string{"producer"},
+				}
+				var key string
+				if p.ProjectSlugInput != nil {
+					key = *p.ProjectSlugInput
+				}
+				ctx, err = authAPIKeyFn(ctx, key, &sc)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.AbortMcpEndpointCanary(ctx, p)
+	}
+}
@@ -39,6 +39,23 @@ type Service interface {
 	CheckMcpEndpointSlugAvailability(context.Context, *CheckMcpEndpointSlugAvailabilityPayload) (res bool, err error)
 	// Delete an MCP endpoint
 	DeleteMcpEndpoint(context.Context, *DeleteMcpEndpointPayload) (err error)
+	// Start a canary on a toolset-backed MCP endpoint. A percentage of the
+	// endpoint's tool calls are served from the candidate deployment while the
+	// rest stay on the baseline. Callers are assigned sticky by Mcp-Session-Id or
+	// by user. Only one canary can run per endpoint.
+	StartMcpEndpointCanary(context.Context, *StartMcpEndpointCanaryPayload) (res *McpEndpointCanary, err error)
+	// Get the most recent canary for an MCP endpoint, including per-arm tool call
+	// error rate and latency.
+	GetMcpEndpointCanary(context.Context, *GetMcpEndpointCanaryPayload) (res *McpEndpointCanary, err error)
+	// Change the share of tool calls a running canary sends to its candidate
+	// deployment.
+	UpdateMcpEndpointCanary(context.Context, *UpdateMcpEndpointCanaryPayload) (res *McpEndpointCanary, err error)
+	// Promote a running canary. The endpoint stops splitting traffic and follows
+	// the project's active deployment, which must be the canary's candidate.
+	PromoteMcpEndpointCanary(context.Context, *PromoteMcpEndpointCanaryPayload) (res *McpEndpointCanary, err error)
+	// Abort a running canary. Every tool call on the endpoint is served from the
+	// baseline deployment until a deployment newer than the candidate completes.
+	AbortMcpEndpointCanary(context.Context, *AbortMcpEndpointCanaryPayload) (res *McpEndpointCanary, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -61,7 +78,17 @@ const ServiceName = "mcpEndpoints"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [6]string{"createMcpEndpoint", "getMcpEndpoint", "listMcpEndpoints", "updateMcpEndpoint", "checkMcpEndpointSlugAvailability", "deleteMcpEndpoint"}
+var MethodNames = [11]string{"createMcpEndpoint", "getMcpEndpoint", "listMcpEndpoints", "updateMcpEndpoint", "checkMcpEndpointSlugAvailability", "deleteMcpEndpoint", "startMcpEndpointCanary", "getMcpEndpointCanary", "updateMcpEndpointCanary", "promoteMcpEndpointCanary", "abortMcpEndpointCanary"}
+
+// AbortMcpEndpointCanaryPayload is the payload type of the mcpEndpoints
+// service abortMcpEndpointCanary method.
+type AbortMcpEndpointCanaryPayload struct {
+	// The ID of the MCP endpoint
+	McpEndpointID    string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
 
 // CheckMcpEndpointSlugAvailabilityPayload is the payload type of the
 // mcpEndpoints service checkMcpEndpointSlugAvailability method.
@@ -90,6 +117,10 @@ type CreateMcpEndpointPayload struct {
 	// The ID of the meta MCP server this endpoint addresses. Mutually exclusive
 	// with mcp_server_id.
 	MetaMcpServerID *string
+	// Pin the endpoint to this version of its MCP server's toolset, overriding any
+	// version pinned on the server. Only valid for endpoints of toolset-backed MCP
+	// servers. Omit to follow the server.
+	ToolsetVersion *int64
 	// The slug
 	Slug types.McpEndpointSlug
 }
@@ -104,6 +135,16 @@ type DeleteMcpEndpointPayload struct {
 	ProjectSlugInput *string
 }
 
+// GetMcpEndpointCanaryPayload is the payload type of the mcpEndpoints service
+// getMcpEndpointCanary method.
+type GetMcpEndpointCanaryPayload struct {
+	// The ID of the MCP endpoint
+	McpEndpointID    string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
 // GetMcpEndpointPayload is the payload type of the mcpEndpoints service
 // getMcpEndpoint method.
 type GetMcpEndpointPayload struct {
@@ -137,6 +178,95 @@ type ListMcpEndpointsResult struct {
 	McpEndpoints []*types.McpEndpoint
 }
 
+// McpEndpointCanary is the result type of the mcpEndpoints service
+// startMcpEndpointCanary method.
+type McpEndpointCanary struct {
+	// The ID of the canary
+	ID string
+	// The ID of the MCP endpoint
+	McpEndpointID string
+	// The deployment serving the rest of the traffic
+	BaselineDeploymentID string
+	// The deployment under test. Null while a running canary follows the active
+	// deployment.
+	CandidateDeploymentID *string
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage int32
+	// Which request attribute keeps a caller on the same deployment
+	StickyBy string
+	// The canary's lifecycle state
+	Status string
+	// When the canary started
+	StartedAt string
+	// When the canary was promoted or aborted
+	EndedAt *string
+	// Tool call telemetry for the baseline arm. Only set by getMcpEndpointCanary.
+	Baseline *McpEndpointCanaryArm
+	// Tool call telemetry for the candidate arm. Only set by getMcpEndpointCanary.
+	Candidate *McpEndpointCanaryArm
+}
+
+// Tool call telemetry for one side of a canary since it started.
+type McpEndpointCanaryArm struct {
+	// The deployment serving this arm
+	DeploymentID string
+	// Tool calls served by this arm
+	ToolCalls int64
+	// Tool calls that returned an HTTP status of 400 or above
+	FailedToolCalls int64
+	// Failed tool calls as a fraction of all tool calls. Zero when there were no
+	// calls.
+	ErrorRate float64
+	// Median tool call latency in milliseconds
+	LatencyP50Ms float64
+	// 95th percentile tool call latency in milliseconds
+	LatencyP95Ms float64
+}
+
+// PromoteMcpEndpointCanaryPayload is the payload type of the mcpEndpoints
+// service promoteMcpEndpointCanary method.
+type PromoteMcpEndpointCanaryPayload struct {
+	// The ID of the MCP endpoint
+	McpEndpointID    string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// StartMcpEndpointCanaryPayload is the payload type of the mcpEndpoints
+// service startMcpEndpointCanary method.
+type StartMcpEndpointCanaryPayload struct {
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+	// The ID of the MCP endpoint
+	McpEndpointID string
+	// The deployment serving the rest of the traffic. Defaults to the active
+	// deployment.
+	BaselineDeploymentID *string
+	// The deployment under test. Omit to arm the canary before pushing: the
+	// candidate then follows the project's active deployment, so the next
+	// deployment only reaches the configured share of callers.
+	CandidateDeploymentID *string
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage int32
+	// Which request attribute keeps a caller on the same deployment. Requests
+	// without a user fall back to their Mcp-Session-Id.
+	StickyBy string
+}
+
+// UpdateMcpEndpointCanaryPayload is the payload type of the mcpEndpoints
+// service updateMcpEndpointCanary method.
+type UpdateMcpEndpointCanaryPayload struct {
+	// The ID of the MCP endpoint
+	McpEndpointID string
+	// Percentage of sticky keys routed to the candidate deployment
+	Percentage       int32
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
 // UpdateMcpEndpointPayload is the payload type of the mcpEndpoints service
 // updateMcpEndpoint method.
 type UpdateMcpEndpointPayload struct {
@@ -154,6 +284,10 @@ type UpdateMcpEndpointPayload struct {
 	// The ID of the meta MCP server this endpoint addresses. Mutually exclusive
 	// with mcp_server_id.
 	MetaMcpServerID *string
+	// Pin the endpoint to this version of its MCP server's toolset, overriding any
+	// version pinned on the server. Only valid for endpoints of toolset-backed MCP
+	// servers. Omit to follow the server.
+	ToolsetVersion *int64
 	// The slug
 	Slug types.McpEndpointSlug
 }
@@ -128,6 +128,9 @@ type CreateMcpServerPayload struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server. Omit to leave filtering disabled.
 	ToolVariationsGroupID *string
+	// Pin the server to this version of its toolset instead of serving the latest.
+	// Only valid with toolset_id.
+	ToolsetVersion *int64
 	// The visibility of the server
 	Visibility types.McpServerVisibility
 }
@@ -314,6 +317,9 @@ type UpdateMcpServerPayload struct {
 	// server. Omit to disable filtering (cleared to null, consistent with the
 	// full-record replace semantics of the other UUID references).
 	ToolVariationsGroupID *string
+	// Pin the server to this version of its toolset instead of serving the latest.
+	// Only valid with toolset_id. Omit to serve the latest version.
+	ToolsetVersion *int64
 	// The visibility of the server
 	Visibility types.McpServerVisibility
 }
@@ -15,17 +15,25 @@ import (
 
 // Client is the "otelForwarding" service client.
 type Client struct {
-	GetConfigEndpoint    goa.Endpoint
-	UpsertConfigEndpoint goa.Endpoint
-	DeleteConfigEndpoint goa.Endpoint
+	GetConfigEndpoint         goa.Endpoint
+	UpsertConfigEndpoint      goa.Endpoint
+	DeleteConfigEndpoint      goa.Endpoint
+	ListDestinationsEndpoint  goa.Endpoint
+	CreateDestinationEndpoint goa.Endpoint
+	UpdateDestinationEndpoint goa.Endpoint
+	DeleteDestinationEndpoint goa.Endpoint
 }
 
 // NewClient initializes a "otelForwarding" service client given the endpoints.
-func NewClient(getConfig, upsertConfig, deleteConfig goa.Endpoint) *Client {
+func NewClient(getConfig, upsertConfig, deleteConfig, listDestinations, createDestination, updateDestination, deleteDestination goa.Endpoint) *Client {
 	return &Client{
-		GetConfigEndpoint:    getConfig,
-		UpsertConfigEndpoint: upsertConfig,
-		DeleteConfigEndpoint: deleteConfig,
+		GetConfigEndpoint:         getConfig,
+		UpsertConfigEndpoint:      upsertConfig,
+		DeleteConfigEndpoint:      deleteConfig,
+		ListDestinationsEndpoint:  listDestinations,
+		CreateDestinationEndpoint: createDestination,
+		UpdateDestinationEndpoint: updateDestination,
+		DeleteDestinationEndpoint: deleteDestination,
 	}
 }
 
@@ -92,3 +100,91 @@ func (c *Client) DeleteConfig(ctx context.Context, p *DeleteConfigPayload) (err
 	_, err = c.DeleteConfigEndpoint(ctx, p)
 	return
 }
+
+// ListDestinations calls the "listDestinations" endpoint of the
+// "otelForwarding" service.
+// ListDestinations may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) ListDestinations(ctx context.Context, p *ListDestinationsPayload) (res *ListOtelForwardingDestinationsResult, err error) {
+	var ires any
+	ires, err = c.ListDestinationsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListOtelForwardingDestinationsResult), nil
+}
+
+// CreateDestination calls the "createDestination" endpoint of the
+// "otelForwarding" service.
+// CreateDestination may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) CreateDestination(ctx context.Context, p *CreateDestinationPayload) (res *OtelForwardingDestination, err error) {
+	var ires any
+	ires, err = c.CreateDestinationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*OtelForwardingDestination), nil
+}
+
+// UpdateDestination calls the "updateDestination" endpoint of the
+// "otelForwarding" service.
+// UpdateDestination may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) UpdateDestination(ctx context.Context, p *UpdateDestinationPayload) (res *OtelForwardingDestination, err error) {
+	var ires any
+	ires, err = c.UpdateDestinationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*OtelForwardingDestination), nil
+}
+
+// DeleteDestination calls the "deleteDestination" endpoint of the
+// "otelForwarding" service.
+// DeleteDestination may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) DeleteDestination(ctx context.Context, p *DeleteDestinationPayload) (err error) {
+	_, err = c.DeleteDestinationEndpoint(ctx, p)
+	return
+}
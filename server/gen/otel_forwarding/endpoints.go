@@ -16,9 +16,13 @@ import (
 
 // Endpoints wraps the "otelForwarding" service endpoints.
 type Endpoints struct {
-	GetConfig    goa.Endpoint
-	UpsertConfig goa.Endpoint
-	DeleteConfig goa.Endpoint
+	GetConfig         goa.Endpoint
+	UpsertConfig      goa.Endpoint
+	DeleteConfig      goa.Endpoint
+	ListDestinations  goa.Endpoint
+	CreateDestination goa.Endpoint
+	UpdateDestination goa.Endpoint
+	DeleteDestination goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "otelForwarding" service with
@@ -27,9 +31,13 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		GetConfig:    NewGetConfigEndpoint(s, a.APIKeyAuth),
-		UpsertConfig: NewUpsertConfigEndpoint(s, a.APIKeyAuth),
-		DeleteConfig: NewDeleteConfigEndpoint(s, a.APIKeyAuth),
+		GetConfig:         NewGetConfigEndpoint(s, a.APIKeyAuth),
+		UpsertConfig:      NewUpsertConfigEndpoint(s, a.APIKeyAuth),
+		DeleteConfig:      NewDeleteConfigEndpoint(s, a.APIKeyAuth),
+		ListDestinations:  NewListDestinationsEndpoint(s, a.APIKeyAuth),
+		CreateDestination: NewCreateDestinationEndpoint(s, a.APIKeyAuth),
+		UpdateDestination: NewUpdateDestinationEndpoint(s, a.APIKeyAuth),
+		DeleteDestination: NewDeleteDestinationEndpoint(s, a.APIKeyAuth),
 	}
 }
 
@@ -39,6 +47,10 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetConfig = m(e.GetConfig)
 	e.UpsertConfig = m(e.UpsertConfig)
 	e.DeleteConfig = m(e.DeleteConfig)
+	e.ListDestinations = m(e.ListDestinations)
+	e.CreateDestination = m(e.CreateDestination)
+	e.UpdateDestination = m(e.UpdateDestination)
+	e.DeleteDestination = m(e.DeleteDestination)
 }
 
 // NewGetConfigEndpoint returns an endpoint function that calls the method
@@ -145,3 +157,143 @@ func NewDeleteConfigEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) go
 		return nil, s.DeleteConfig(ctx, p)
 	}
 }
+
+// NewListDestinationsEndpoint returns an endpoint function that calls the
+// method "listDestinations" of service "otelForwarding".
+func NewListDestinationsEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListDestinationsPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "apikey",
+			Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+			RequiredScopes: []string{"consumer"},
+		}
+		var key string
+		if p.ApikeyToken != nil {
+			key = *p.ApikeyToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "session",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.SessionToken != nil {
+				key = *p.SessionToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.ListDestinations(ctx, p)
+	}
+}
+
+// NewCreateDestinationEndpoint returns an endpoint function that calls the
+// method "createDestination" of service "otelForwarding".
+func NewCreateDestinationEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateDestinationPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "apikey",
+			Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+			RequiredScopes: []string{"producer"},
+		}
+		var key string
+		if p.ApikeyToken != nil {
+			key = *p.ApikeyToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "session",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.SessionToken != nil {
+				key = *p.SessionToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateDestination(ctx, p)
+	}
+}
+
+// NewUpdateDestinationEndpoint returns an endpoint function that calls the
+// method "updateDestination" of service "otelForwarding".
+func NewUpdateDestinationEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateDestinationPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "apikey",
+			Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+			RequiredScopes: []string{"producer"},
+		}
+		var key string
+		if p.ApikeyToken != nil {
+			key = *p.ApikeyToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "session",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.SessionToken != nil {
+				key = *p.SessionToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateDestination(ctx, p)
+	}
+}
+
+// NewDeleteDestinationEndpoint returns an endpoint function that calls the
+// method "deleteDestination" of service "otelForwarding".
+func NewDeleteDestinationEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteDestinationPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "apikey",
+			Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+			RequiredScopes: []string{"producer"},
+		}
+		var key string
+		if p.ApikeyToken != nil {
+			key = *p.ApikeyToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "session",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.SessionToken != nil {
+				key = *p.SessionToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteDestination(ctx, p)
+	}
+}
@@ -25,6 +25,16 @@ type Service interface {
 	UpsertConfig(context.Context, *UpsertConfigPayload) (res *OtelForwardingConfig, err error)
 	// Delete the org-wide OTEL forwarding config.
 	DeleteConfig(context.Context, *DeleteConfigPayload) (err error)
+	// List the organization's OTEL forwarding destinations.
+	ListDestinations(context.Context, *ListDestinationsPayload) (res *ListOtelForwardingDestinationsResult, err error)
+	// Add an OTEL forwarding destination with its own protocol, filters, sampling
+	// ratio and scrub rules.
+	CreateDestination(context.Context, *CreateDestinationPayload) (res *OtelForwardingDestination, err error)
+	// Replace an OTEL forwarding destination. Every field is overwritten; header
+	// values may be omitted to keep the stored ones.
+	UpdateDestination(context.Context, *UpdateDestinationPayload) (res *OtelForwardingDestination, err error)
+	// Delete an OTEL forwarding destination.
+	DeleteDestination(context.Context, *DeleteDestinationPayload) (err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -47,7 +57,32 @@ const ServiceName = "otelForwarding"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [3]string{"getConfig", "upsertConfig", "deleteConfig"}
+var MethodNames = [7]string{"getConfig", "upsertConfig", "deleteConfig", "listDestinations", "createDestination", "updateDestination", "deleteDestination"}
+
+// CreateDestinationPayload is the payload type of the otelForwarding service
+// createDestination method.
+type CreateDestinationPayload struct {
+	ApikeyToken  *string
+	SessionToken *string
+	// Name of the destination, unique within the organization.
+	Name string
+	// Base URL of the OTLP receiver. Use https:// for TLS; gRPC destinations
+	// without a port use 4317.
+	EndpointURL string
+	// OTLP transport and encoding.
+	Protocol string
+	// Whether the destination should receive telemetry.
+	Enabled bool
+	// Complete desired header set. Omitted entries are removed; entries with an
+	// omitted value preserve the existing encrypted value for the same name.
+	Headers []*OtelForwardingHeaderInput
+	// Share of traces and log records to forward, from 0 to 1.
+	SamplingRatio float64
+	// Filters applied before sampling. Omit to forward everything.
+	Filters *OtelForwardingFilters
+	// Attribute scrubbing rules.
+	ScrubRules []*OtelForwardingScrubRule
+}
 
 // DeleteConfigPayload is the payload type of the otelForwarding service
 // deleteConfig method.
@@ -56,6 +91,15 @@ type DeleteConfigPayload struct {
 	SessionToken *string
 }
 
+// DeleteDestinationPayload is the payload type of the otelForwarding service
+// deleteDestination method.
+type DeleteDestinationPayload struct {
+	ApikeyToken  *string
+	SessionToken *string
+	// ID of the destination to delete.
+	ID string
+}
+
 // GetConfigPayload is the payload type of the otelForwarding service getConfig
 // method.
 type GetConfigPayload struct {
@@ -63,6 +107,29 @@ type GetConfigPayload struct {
 	SessionToken *string
 }
 
+// ListDestinationsPayload is the payload type of the otelForwarding service
+// listDestinations method.
+type ListDestinationsPayload struct {
+	ApikeyToken  *string
+	SessionToken *string
+}
+
+// ListOtelForwardingDestinationsResult is the result type of the
+// otelForwarding service listDestinations method.
+type ListOtelForwardingDestinationsResult struct {
+	// The organization's destinations, oldest first.
+	Destinations []*OtelForwardingDestination
+}
+
+// Matches telemetry carrying an attribute. Item attributes are checked first,
+// then resource attributes.
+type OtelForwardingAttributeMatch struct {
+	// Attribute key.
+	Key string
+	// Value the attribute must equal, compared as text. Omit to match any value.
+	Value *string
+}
+
 // OtelForwardingConfig is the result type of the otelForwarding service
 // getConfig method.
 type OtelForwardingConfig struct {
@@ -83,6 +150,53 @@ type OtelForwardingConfig struct {
 	UpdatedAt *string
 }
 
+// OtelForwardingDestination is the result type of the otelForwarding service
+// createDestination method.
+type OtelForwardingDestination struct {
+	// Destination ID.
+	ID string
+	// Organization the destination belongs to.
+	OrganizationID string
+	// Name of the destination, unique within the organization.
+	Name string
+	// Base URL of the OTLP receiver. OTLP/HTTP destinations receive /v1/<signal>
+	// under it; gRPC destinations dial its host and port.
+	EndpointURL string
+	// OTLP transport and encoding.
+	Protocol string
+	// Whether the destination currently receives telemetry.
+	Enabled bool
+	// Headers sent with each export (gRPC metadata for gRPC destinations). Values
+	// are never returned.
+	Headers []*OtelForwardingHeader
+	// Share of traces and log records forwarded, from 0 to 1. Items sharing a
+	// trace ID are kept or dropped together. Metrics are never sampled.
+	SamplingRatio float64
+	// Filters applied before sampling.
+	Filters *OtelForwardingFilters
+	// Attribute scrubbing rules applied to everything that is forwarded.
+	ScrubRules []*OtelForwardingScrubRule
+	// When the destination was created.
+	CreatedAt string
+	// When the destination was last updated.
+	UpdatedAt string
+}
+
+// Decides which spans, log records and metric data points reach a destination.
+// Names are span names, log event names and metric names and may use *
+// wildcards. Empty include lists admit everything; an exclude match always
+// wins.
+type OtelForwardingFilters struct {
+	// Only forward items whose name matches one of these patterns.
+	IncludeNames []string
+	// Never forward items whose name matches one of these patterns.
+	ExcludeNames []string
+	// Only forward items matching at least one of these attributes.
+	IncludeAttributes []*OtelForwardingAttributeMatch
+	// Never forward items matching any of these attributes.
+	ExcludeAttributes []*OtelForwardingAttributeMatch
+}
+
 // HTTP header forwarded with each OTEL payload.
 type OtelForwardingHeader struct {
 	// Header name.
@@ -102,6 +216,43 @@ type OtelForwardingHeaderInput struct {
 	Value *string
 }
 
+// Rewrites every resource, scope and item attribute whose key matches before
+// it leaves Gram. The first matching rule wins.
+type OtelForwardingScrubRule struct {
+	// Attribute key pattern; may use * wildcards, e.g. gen_ai.tool.call.*.
+	Key string
+	// drop removes the attribute, redact replaces its value with [REDACTED], hash
+	// replaces it with its SHA-256 hex digest.
+	Action string
+}
+
+// UpdateDestinationPayload is the payload type of the otelForwarding service
+// updateDestination method.
+type UpdateDestinationPayload struct {
+	ApikeyToken  *string
+	SessionToken *string
+	// ID of the destination to update.
+	ID string
+	// Name of the destination, unique within the organization.
+	Name string
+	// Base URL of the OTLP receiver. Use https:// for TLS; gRPC destinations
+	// without a port use 4317.
+	EndpointURL string
+	// OTLP transport and encoding.
+	Protocol string
+	// Whether the destination should receive telemetry.
+	Enabled bool
+	// Complete desired header set. Omitted entries are removed; entries with an
+	// omitted value preserve the existing encrypted value for the same name.
+	Headers []*OtelForwardingHeaderInput
+	// Share of traces and log records to forward, from 0 to 1.
+	SamplingRatio float64
+	// Filters applied before sampling. Omit to forward everything.
+	Filters *OtelForwardingFilters
+	// Attribute scrubbing rules.
+	ScrubRules []*OtelForwardingScrubRule
+}
+
 // UpsertConfigPayload is the payload type of the otelForwarding service
 // upsertConfig method.
 type UpsertConfigPayload struct {
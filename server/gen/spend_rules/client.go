@@ -22,13 +22,15 @@ type Client struct {
 	UpdateSpendRuleEndpoint       goa.Endpoint
 	ArchiveSpendRuleEndpoint      goa.Endpoint
 	PreviewSpendRuleEndpoint      goa.Endpoint
+	SimulateSpendRuleEndpoint     goa.Endpoint
 	ListSpendRuleEventsEndpoint   goa.Endpoint
 	GetSpendRulesOverviewEndpoint goa.Endpoint
 	ListActorAttributesEndpoint   goa.Endpoint
+	ListUsageDimensionsEndpoint   goa.Endpoint
 }
 
 // NewClient initializes a "spendRules" service client given the endpoints.
-func NewClient(createSpendRule, listSpendRules, getSpendRule, updateSpendRule, archiveSpendRule, previewSpendRule, listSpendRuleEvents, getSpendRulesOverview, listActorAttributes goa.Endpoint) *Client {
+func NewClient(createSpendRule, listSpendRules, getSpendRule, updateSpendRule, archiveSpendRule, previewSpendRule, simulateSpendRule, listSpendRuleEvents, getSpendRulesOverview, listActorAttributes, listUsageDimensions goa.Endpoint) *Client {
 	return &Client{
 		CreateSpendRuleEndpoint:       createSpendRule,
 		ListSpendRulesEndpoint:        listSpendRules,
@@ -36,9 +38,11 @@ func NewClient(createSpendRule, listSpendRules, getSpendRule, updateSpendRule, a
 		UpdateSpendRuleEndpoint:       updateSpendRule,
 		ArchiveSpendRuleEndpoint:      archiveSpendRule,
 		PreviewSpendRuleEndpoint:      previewSpendRule,
+		SimulateSpendRuleEndpoint:     simulateSpendRule,
 		ListSpendRuleEventsEndpoint:   listSpendRuleEvents,
 		GetSpendRulesOverviewEndpoint: getSpendRulesOverview,
 		ListActorAttributesEndpoint:   listActorAttributes,
+		ListUsageDimensionsEndpoint:   listUsageDimensions,
 	}
 }
 
@@ -175,6 +179,29 @@ func (c *Client) PreviewSpendRule(ctx context.Context, p *PreviewSpendRulePayloa
 	return ires.(*PreviewSpendRuleResult), nil
 }
 
+// SimulateSpendRule calls the "simulateSpendRule" endpoint of the "spendRules"
+// service.
+// SimulateSpendRule may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) SimulateSpendRule(ctx context.Context, p *SimulateSpendRulePayload) (res *SimulateSpendRuleResult, err error) {
+	var ires any
+	ires, err = c.SimulateSpendRuleEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimulateSpendRuleResult), nil
+}
+
 // ListSpendRuleEvents calls the "listSpendRuleEvents" endpoint of the
 // "spendRules" service.
 // ListSpendRuleEvents may return the following errors:
@@ -243,3 +270,26 @@ func (c *Client) ListActorAttributes(ctx context.Context, p *ListActorAttributes
 	}
 	return ires.(*ListActorAttributesResult), nil
 }
+
+// ListUsageDimensions calls the "listUsageDimensions" endpoint of the
+// "spendRules" service.
+// ListUsageDimensions may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) ListUsageDimensions(ctx context.Context, p *ListUsageDimensionsPayload) (res *ListUsageDimensionsResult, err error) {
+	var ires any
+	ires, err = c.ListUsageDimensionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ListUsageDimensionsResult), nil
+}
@@ -22,9 +22,11 @@ type Endpoints struct {
 	UpdateSpendRule       goa.Endpoint
 	ArchiveSpendRule      goa.Endpoint
 	PreviewSpendRule      goa.Endpoint
+	SimulateSpendRule     goa.Endpoint
 	ListSpendRuleEvents   goa.Endpoint
 	GetSpendRulesOverview goa.Endpoint
 	ListActorAttributes   goa.Endpoint
+	ListUsageDimensions   goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "spendRules" service with endpoints.
@@ -38,9 +40,11 @@ func NewEndpoints(s Service) *Endpoints {
 		UpdateSpendRule:       NewUpdateSpendRuleEndpoint(s, a.APIKeyAuth),
 		ArchiveSpendRule:      NewArchiveSpendRuleEndpoint(s, a.APIKeyAuth),
 		PreviewSpendRule:      NewPreviewSpendRuleEndpoint(s, a.APIKeyAuth),
+		SimulateSpendRule:     NewSimulateSpendRuleEndpoint(s, a.APIKeyAuth),
 		ListSpendRuleEvents:   NewListSpendRuleEventsEndpoint(s, a.APIKeyAuth),
 		GetSpendRulesOverview: NewGetSpendRulesOverviewEndpoint(s, a.APIKeyAuth),
 		ListActorAttributes:   NewListActorAttributesEndpoint(s, a.APIKeyAuth),
+		ListUsageDimensions:   NewListUsageDimensionsEndpoint(s, a.APIKeyAuth),
 	}
 }
 
@@ -52,9 +56,11 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.UpdateSpendRule = m(e.UpdateSpendRule)
 	e.ArchiveSpendRule = m(e.ArchiveSpendRule)
 	e.PreviewSpendRule = m(e.PreviewSpendRule)
+	e.SimulateSpendRule = m(e.SimulateSpendRule)
 	e.ListSpendRuleEvents = m(e.ListSpendRuleEvents)
 	e.GetSpendRulesOverview = m(e.GetSpendRulesOverview)
 	e.ListActorAttributes = m(e.ListActorAttributes)
+	e.ListUsageDimensions = m(e.ListUsageDimensions)
 }
 
 // NewCreateSpendRuleEndpoint returns an endpoint function that calls the
@@ -411,6 +417,65 @@ func NewPreviewSpendRuleEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc
 	}
 }
 
+// NewSimulateSpendRuleEndpoint returns an endpoint function that calls the
+// method "simulateSpendRule" of service "spendRules".
+func NewSimulateSpendRuleEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SimulateSpendRulePayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "apikey",
+			Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+			RequiredScopes: []string{"producer"},
+		}
+		var key string
+		if p.ApikeyToken != nil {
+			key = *p.ApikeyToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err == nil {
+			sc := security.APIKeyScheme{
+				Name:           "project_slug",
+				Scopes:         []string{},
+				RequiredScopes: []string{"producer"},
+			}
+			var key string
+			if p.ProjectSlugInput != nil {
+				key = *p.ProjectSlugInput
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "session",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.SessionToken != nil {
+				key = *p.SessionToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+			if err == nil {
+				sc := security.APIKeyScheme{
+					Name:           "project_slug",
+					Scopes:         []string{},
+					RequiredScopes: []string{},
+				}
+				var key string
+				if p.ProjectSlugInput != nil {
+					key = *p.ProjectSlugInput
+				}
+				ctx, err = authAPIKeyFn(ctx, key, &sc)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.SimulateSpendRule(ctx, p)
+	}
+}
+
 // NewListSpendRuleEventsEndpoint returns an endpoint function that calls the
 // method "listSpendRuleEvents" of service "spendRules".
 func NewListSpendRuleEventsEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
@@ -587,3 +652,62 @@ func NewListActorAttributesEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyF
 		return s.ListActorAttributes(ctx, p)
 	}
 }
+
+// NewListUsageDimensionsEndpoint returns an endpoint function that calls the
+// method "listUsageDimensions" of service "spendRules".
+func NewListUsageDimensionsEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListUsageDimensionsPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "apikey",
+			Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+			RequiredScopes: []string{"producer"},
+		}
+		var key string
+		if p.ApikeyToken != nil {
+			key = *p.ApikeyToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err == nil {
+			sc := security.APIKeyScheme{
+				Name:           "project_slug",
+				Scopes:         []string{},
+				RequiredScopes: []string{"producer"},
+			}
+			var key string
+			if p.ProjectSlugInput != nil {
+				key = *p.ProjectSlugInput
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "session",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.SessionToken != nil {
+				key = *p.SessionToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+			if err == nil {
+				sc := security.APIKeyScheme{
+					Name:           "project_slug",
+					Scopes:         []string{},
+					RequiredScopes: []string{},
+				}
+				var key string
+				if p.ProjectSlugInput != nil {
+					key = *p.ProjectSlugInput
+				}
+				ctx, err = authAPIKeyFn(ctx, key, &sc)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.ListUsageDimensions(ctx, p)
+	}
+}
@@ -36,6 +36,10 @@ type Service interface {
 	// against a proposed budget. Powers the live preview in the rule editor and
 	// the per-actor breakdown in the rule detail view.
 	PreviewSpendRule(context.Context, *PreviewSpendRulePayload) (res *PreviewSpendRuleResult, err error)
+	// Replay a draft rule over the last N windows of recorded spend to show who it
+	// would have warned or blocked, and when. Runs against historical usage only;
+	// nothing is enforced or recorded.
+	SimulateSpendRule(context.Context, *SimulateSpendRulePayload) (res *SimulateSpendRuleResult, err error)
 	// List warning and breach events emitted by budget rule evaluation, most
 	// recent first.
 	ListSpendRuleEvents(context.Context, *ListSpendRuleEventsPayload) (res *ListSpendRuleEventsResult, err error)
@@ -46,6 +50,9 @@ type Service interface {
 	// with each attribute's value kind. Static reference data that powers the rule
 	// editor's attribute picker.
 	ListActorAttributes(context.Context, *ListActorAttributesPayload) (res *ListActorAttributesResult, err error)
+	// List the usage dimensions a rule scope condition can be written against.
+	// Static reference data that powers the rule editor's scope picker.
+	ListUsageDimensions(context.Context, *ListUsageDimensionsPayload) (res *ListUsageDimensionsResult, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -68,7 +75,7 @@ const ServiceName = "spendRules"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [9]string{"createSpendRule", "listSpendRules", "getSpendRule", "updateSpendRule", "archiveSpendRule", "previewSpendRule", "listSpendRuleEvents", "getSpendRulesOverview", "listActorAttributes"}
+var MethodNames = [11]string{"createSpendRule", "listSpendRules", "getSpendRule", "updateSpendRule", "archiveSpendRule", "previewSpendRule", "simulateSpendRule", "listSpendRuleEvents", "getSpendRulesOverview", "listActorAttributes", "listUsageDimensions"}
 
 type ActorAttribute struct {
 	// Attribute name as used in target conditions, e.g. department_name.
@@ -101,6 +108,9 @@ type CreateSpendRulePayload struct {
 	Description string
 	// Structured member-attribute condition selecting who the rule applies to.
 	Target *types.SpendRuleTargetCondition
+	// Optional usage-dimension condition selecting which spend counts toward the
+	// limit. Omit to count all spend.
+	Scope *types.SpendRuleScopeCondition
 	// Per-person budget in USD for one window.
 	LimitUsd float64
 	// UTC calendar window the budget covers.
@@ -188,6 +198,21 @@ type ListSpendRulesResult struct {
 	Rules []*types.SpendRule
 }
 
+// ListUsageDimensionsPayload is the payload type of the spendRules service
+// listUsageDimensions method.
+type ListUsageDimensionsPayload struct {
+	ApikeyToken      *string
+	SessionToken     *string
+	ProjectSlugInput *string
+}
+
+// ListUsageDimensionsResult is the result type of the spendRules service
+// listUsageDimensions method.
+type ListUsageDimensionsResult struct {
+	// The usage dimensions available to scope conditions, in editor display order.
+	Dimensions []*UsageDimension
+}
+
 // PreviewSpendRulePayload is the payload type of the spendRules service
 // previewSpendRule method.
 type PreviewSpendRulePayload struct {
@@ -196,6 +221,9 @@ type PreviewSpendRulePayload struct {
 	ProjectSlugInput *string
 	// Structured member-attribute condition to preview.
 	Target *types.SpendRuleTargetCondition
+	// Optional usage-dimension condition selecting which spend counts toward the
+	// limit.
+	Scope *types.SpendRuleScopeCondition
 	// Per-person budget in USD used to compute usage percentages.
 	LimitUsd float64
 	// Percentage of the limit at which a warning event is emitted.
@@ -218,6 +246,46 @@ type PreviewSpendRuleResult struct {
 	Actors []*SpendRuleActorUsage
 }
 
+// SimulateSpendRulePayload is the payload type of the spendRules service
+// simulateSpendRule method.
+type SimulateSpendRulePayload struct {
+	ApikeyToken      *string
+	SessionToken     *string
+	ProjectSlugInput *string
+	// Structured member-attribute condition to simulate.
+	Target *types.SpendRuleTargetCondition
+	// Optional usage-dimension condition selecting which spend counts toward the
+	// limit.
+	Scope *types.SpendRuleScopeCondition
+	// Per-person budget in USD for one window.
+	LimitUsd float64
+	// Percentage of the limit at which a warning event is emitted.
+	WarnAtPct int
+	// UTC calendar window the budget covers.
+	WindowKind string
+	// Rule action to simulate: flag (record events only) or block (deny agent
+	// traffic on breach).
+	Action string
+	// Number of most recent windows to replay, including the current in-progress
+	// window.
+	Windows int
+}
+
+// SimulateSpendRuleResult is the result type of the spendRules service
+// simulateSpendRule method.
+type SimulateSpendRuleResult struct {
+	// Total number of organization members the target expression matches.
+	MatchedCount int
+	// The simulated rule action.
+	Action string
+	// Replayed windows, oldest first. The last window is the current, in-progress
+	// one.
+	Windows []*SpendRuleSimulationWindow
+	// Matched actors who would have reached the warning threshold in at least one
+	// window, most breached windows first. Capped at 50 entries.
+	Actors []*SpendRuleSimulationActor
+}
+
 type SpendRuleActorUsage struct {
 	// Actor email.
 	Email string
@@ -264,6 +332,56 @@ type SpendRuleEvent struct {
 	CreatedAt string
 }
 
+type SpendRuleSimulationActor struct {
+	// Actor email.
+	Email string
+	// Actor display name, when known.
+	DisplayName *string
+	// Gram user ID of the actor, when linked.
+	UserID *string
+	// Number of simulated windows in which the actor reached the warning threshold.
+	WindowsWarned int
+	// Number of simulated windows in which the actor breached the rule.
+	WindowsBreached int
+	// Per-window outcome, oldest window first.
+	Timeline []*SpendRuleSimulationActorWindow
+}
+
+type SpendRuleSimulationActorWindow struct {
+	// Inclusive start of the window.
+	WindowStart string
+	// Exclusive end of the window.
+	WindowEnd string
+	// Actor spend in USD within the window.
+	SpendUsd float64
+	// Window spend as a percentage of the limit (may exceed 100).
+	UsedPct float64
+	// Start of the spend bucket in which the actor first reached the warning
+	// threshold. Absent when never reached.
+	WarnedAt *string
+	// Start of the spend bucket in which the actor first breached the rule. Absent
+	// when never breached.
+	BreachedAt *string
+	// Whether a block rule would have denied the actor's agent traffic from
+	// breached_at until the window reset.
+	Blocked bool
+}
+
+type SpendRuleSimulationWindow struct {
+	// Inclusive start of the window.
+	WindowStart string
+	// Exclusive end of the window.
+	WindowEnd string
+	// Whether the window contains the current time, so its spend is partial.
+	InProgress bool
+	// Total spend in USD across matched users within the window.
+	SpendUsd float64
+	// Matched users who would have reached the warning threshold within the window.
+	UsersWarned int
+	// Matched users who would have breached the rule within the window.
+	UsersBreached int
+}
+
 type SpendRuleUsage struct {
 	// The budget rule ID.
 	RuleID string
@@ -322,6 +440,12 @@ type UpdateSpendRulePayload struct {
 	Description *string
 	// Structured member-attribute condition. Omit to preserve the current target.
 	Target *types.SpendRuleTargetCondition
+	// Usage-dimension condition selecting which spend counts toward the limit.
+	// Omit to preserve the current scope.
+	Scope *types.SpendRuleScopeCondition
+	// Remove the rule's scope so all spend counts toward the limit. Ignored when
+	// scope is supplied.
+	ClearScope *bool
 	// Per-person budget in USD for one window. Omit to preserve the current limit.
 	LimitUsd *float64
 	// UTC calendar window the budget covers. Omit to preserve the current window.
@@ -335,6 +459,13 @@ type UpdateSpendRulePayload struct {
 	Enabled *bool
 }
 
+type UsageDimension struct {
+	// Dimension name as used in scope conditions, e.g. model_family.
+	Name string
+	// Human-readable description of the dimension.
+	Description string
+}
+
 // MakeUnauthorized builds a goa.ServiceError from an error.
 func MakeUnauthorized(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "unauthorized", false, false, false)
@@ -0,0 +1,181 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// telemetryAlerts service
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package telemetryalerts
+
+import (
+	"context"
+
+	types "github.com/speakeasy-api/gram/server/gen/types"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Manage alert rules that watch a project's tool error rate and latency.
+type Service interface {
+	// Create an alert rule over the project's tool call telemetry, optionally
+	// narrowed to a toolset, a tool, or both.
+	CreateTelemetryAlertRule(context.Context, *CreateTelemetryAlertRulePayload) (res *types.TelemetryAlertRule, err error)
+	// List the project's telemetry alert rules with their current state.
+	// Optionally filter to rules narrowed to a specific toolset.
+	ListTelemetryAlertRules(context.Context, *ListTelemetryAlertRulesPayload) (res *ListTelemetryAlertRulesResult, err error)
+	// Update a telemetry alert rule. Omitted fields keep their stored values; the
+	// scope and metric are fixed at creation. Disabling a firing rule returns it
+	// to ok without a resolved notification.
+	UpdateTelemetryAlertRule(context.Context, *UpdateTelemetryAlertRulePayload) (res *types.TelemetryAlertRule, err error)
+	// Delete a telemetry alert rule.
+	DeleteTelemetryAlertRule(context.Context, *DeleteTelemetryAlertRulePayload) (err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// APIKeyAuth implements the authorization logic for the APIKey security scheme.
+	APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "gram"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "telemetryAlerts"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [4]string{"createTelemetryAlertRule", "listTelemetryAlertRules", "updateTelemetryAlertRule", "deleteTelemetryAlertRule"}
+
+// CreateTelemetryAlertRulePayload is the payload type of the telemetryAlerts
+// service createTelemetryAlertRule method.
+type CreateTelemetryAlertRulePayload struct {
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+	// A human-readable name for the rule.
+	Name string
+	// Narrow the rule to calls made through this toolset. Omit to watch every
+	// toolset in the project.
+	ToolsetID *string
+	// Narrow the rule to calls of this tool. Omit to watch every tool.
+	ToolUrn *string
+	// The metric the rule watches.
+	Metric string
+	// The rule fires while the metric is above this value: a fraction between 0
+	// and 1 for error_rate, milliseconds for the latency metrics.
+	Threshold float64
+	// Length of the trailing window the metric is computed over, in seconds.
+	WindowSeconds int32
+	// Minimum tool calls in the window for the rule to fire. Defaults to 10.
+	MinCalls *int32
+	// Addresses emailed when the rule fires and resolves.
+	EmailRecipients []string
+	// Whether the rule is evaluated. Defaults to true.
+	Enabled *bool
+}
+
+// DeleteTelemetryAlertRulePayload is the payload type of the telemetryAlerts
+// service deleteTelemetryAlertRule method.
+type DeleteTelemetryAlertRulePayload struct {
+	// The ID of the alert rule to delete
+	ID               string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// ListTelemetryAlertRulesPayload is the payload type of the telemetryAlerts
+// service listTelemetryAlertRules method.
+type ListTelemetryAlertRulesPayload struct {
+	// Optional filter: only return rules narrowed to this toolset.
+	ToolsetID        *string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// ListTelemetryAlertRulesResult is the result type of the telemetryAlerts
+// service listTelemetryAlertRules method.
+type ListTelemetryAlertRulesResult struct {
+	Rules []*types.TelemetryAlertRule
+}
+
+// UpdateTelemetryAlertRulePayload is the payload type of the telemetryAlerts
+// service updateTelemetryAlertRule method.
+type UpdateTelemetryAlertRulePayload struct {
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+	// The ID of the alert rule to update
+	ID string
+	// A human-readable name for the rule.
+	Name *string
+	// The rule fires while the metric is above this value: a fraction between 0
+	// and 1 for error_rate, milliseconds for the latency metrics.
+	Threshold *float64
+	// Length of the trailing window the metric is computed over, in seconds.
+	WindowSeconds *int32
+	// Minimum tool calls in the window for the rule to fire.
+	MinCalls *int32
+	// Addresses emailed when the rule fires and resolves. Replaces the stored list.
+	EmailRecipients []string
+	// Whether the rule is evaluated.
+	Enabled *bool
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeBadRequest builds a goa.ServiceError from an error.
+func MakeBadRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "bad_request", false, false, false)
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeConflict builds a goa.ServiceError from an error.
+func MakeConflict(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "conflict", false, false, false)
+}
+
+// MakeUnsupportedMedia builds a goa.ServiceError from an error.
+func MakeUnsupportedMedia(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unsupported_media", false, false, false)
+}
+
+// MakeInvalid builds a goa.ServiceError from an error.
+func MakeInvalid(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid", false, false, false)
+}
+
+// MakeInvariantViolation builds a goa.ServiceError from an error.
+func MakeInvariantViolation(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invariant_violation", false, false, true)
+}
+
+// MakeUnexpected builds a goa.ServiceError from an error.
+func MakeUnexpected(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unexpected", false, false, true)
+}
+
+// MakeGatewayError builds a goa.ServiceError from an error.
+func MakeGatewayError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "gateway_error", false, false, true)
+}
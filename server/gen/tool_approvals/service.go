@@ -0,0 +1,193 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// toolApprovals service
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package toolapprovals
+
+import (
+	"context"
+
+	types "github.com/speakeasy-api/gram/server/gen/types"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Manage human-in-the-loop approval policies for tool calls on toolsets and
+// MCP servers.
+type Service interface {
+	// Require human approval for matching tool calls on a toolset or an MCP
+	// server. Provide exactly one of toolset_id or mcp_server_id, and at least one
+	// of match_destructive or expression.
+	CreateToolApprovalPolicy(context.Context, *CreateToolApprovalPolicyPayload) (res *types.ToolApprovalPolicy, err error)
+	// List approval policies for a project. Optionally filter to those attached to
+	// a specific toolset or MCP server.
+	ListToolApprovalPolicies(context.Context, *ListToolApprovalPoliciesPayload) (res *ListToolApprovalPoliciesResult, err error)
+	// Update an approval policy. Omitted fields keep their stored values; the
+	// target is fixed at creation.
+	UpdateToolApprovalPolicy(context.Context, *UpdateToolApprovalPolicyPayload) (res *types.ToolApprovalPolicy, err error)
+	// Delete an approval policy.
+	DeleteToolApprovalPolicy(context.Context, *DeleteToolApprovalPolicyPayload) (err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// APIKeyAuth implements the authorization logic for the APIKey security scheme.
+	APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "gram"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "toolApprovals"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [4]string{"createToolApprovalPolicy", "listToolApprovalPolicies", "updateToolApprovalPolicy", "deleteToolApprovalPolicy"}
+
+// CreateToolApprovalPolicyPayload is the payload type of the toolApprovals
+// service createToolApprovalPolicy method.
+type CreateToolApprovalPolicyPayload struct {
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+	// The ID of the toolset the policy applies to. Mutually exclusive with
+	// mcp_server_id.
+	ToolsetID *string
+	// The ID of the MCP server the policy applies to. Mutually exclusive with
+	// toolset_id.
+	McpServerID *string
+	// The tool the policy governs. Omit to govern every tool on the target.
+	ToolName *string
+	// Match calls to tools whose annotations mark them destructive.
+	MatchDestructive bool
+	// CEL boolean expression over the call's arguments; a call matches when it is
+	// true. Each top-level argument is a variable, and args holds them all as a
+	// map, e.g. `amount > 500` or `env == "production"`.
+	Expression *string
+	// Explanation shown to the approver alongside the call.
+	Message *string
+	// Addresses sent an approval link when the MCP client cannot ask its user.
+	NotifyEmails []string
+	// Slack incoming webhook posted an approval link when the MCP client cannot
+	// ask its user. Stored encrypted and never returned.
+	SlackWebhookURL *string
+	// How long a matching call is held waiting for a decision before it is refused.
+	TimeoutSeconds int
+}
+
+// DeleteToolApprovalPolicyPayload is the payload type of the toolApprovals
+// service deleteToolApprovalPolicy method.
+type DeleteToolApprovalPolicyPayload struct {
+	// The ID of the policy to delete
+	ID               string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// ListToolApprovalPoliciesPayload is the payload type of the toolApprovals
+// service listToolApprovalPolicies method.
+type ListToolApprovalPoliciesPayload struct {
+	// Optional filter: only return policies attached to this toolset.
+	ToolsetID *string
+	// Optional filter: only return policies attached to this MCP server.
+	McpServerID      *string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// ListToolApprovalPoliciesResult is the result type of the toolApprovals
+// service listToolApprovalPolicies method.
+type ListToolApprovalPoliciesResult struct {
+	Policies []*types.ToolApprovalPolicy
+}
+
+// UpdateToolApprovalPolicyPayload is the payload type of the toolApprovals
+// service updateToolApprovalPolicy method.
+type UpdateToolApprovalPolicyPayload struct {
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+	// The ID of the policy to update
+	ID string
+	// The tool the policy governs. An empty string governs every tool on the
+	// target.
+	ToolName *string
+	// Match calls to tools whose annotations mark them destructive.
+	MatchDestructive *bool
+	// CEL boolean expression over the call's arguments; a call matches when it is
+	// true. Each top-level argument is a variable, and args holds them all as a
+	// map, e.g. `amount > 500` or `env == "production"`. An empty string clears it.
+	Expression *string
+	// Explanation shown to the approver alongside the call. An empty string clears
+	// it.
+	Message *string
+	// Addresses sent an approval link when the MCP client cannot ask its user.
+	// Replaces the stored list.
+	NotifyEmails []string
+	// Slack incoming webhook posted an approval link. An empty string clears it.
+	SlackWebhookURL *string
+	// How long a matching call is held waiting for a decision before it is refused.
+	TimeoutSeconds *int
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeBadRequest builds a goa.ServiceError from an error.
+func MakeBadRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "bad_request", false, false, false)
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeConflict builds a goa.ServiceError from an error.
+func MakeConflict(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "conflict", false, false, false)
+}
+
+// MakeUnsupportedMedia builds a goa.ServiceError from an error.
+func MakeUnsupportedMedia(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unsupported_media", false, false, false)
+}
+
+// MakeInvalid builds a goa.ServiceError from an error.
+func MakeInvalid(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid", false, false, false)
+}
+
+// MakeInvariantViolation builds a goa.ServiceError from an error.
+func MakeInvariantViolation(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invariant_violation", false, false, true)
+}
+
+// MakeUnexpected builds a goa.ServiceError from an error.
+func MakeUnexpected(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unexpected", false, false, true)
+}
+
+// MakeGatewayError builds a goa.ServiceError from an error.
+func MakeGatewayError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "gateway_error", false, false, true)
+}
@@ -0,0 +1,194 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// toolCallRecordings service
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package toolcallrecordings
+
+import (
+	"context"
+
+	types "github.com/speakeasy-api/gram/server/gen/types"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Record real tool calls on a toolset and replay them against another
+// deployment as regression tests.
+type Service interface {
+	// Start recording tool calls made against a toolset. Any session already
+	// recording the toolset is stopped.
+	StartToolCallRecording(context.Context, *StartToolCallRecordingPayload) (res *types.ToolCallRecordingSession, err error)
+	// Stop a recording session. Recordings already captured are kept.
+	StopToolCallRecording(context.Context, *StopToolCallRecordingPayload) (res *types.ToolCallRecordingSession, err error)
+	// List the most recent recording sessions for a project. Optionally filter to
+	// a single toolset.
+	ListToolCallRecordingSessions(context.Context, *ListToolCallRecordingSessionsPayload) (res *ListToolCallRecordingSessionsResult, err error)
+	// Export the tool calls captured by a recording session, with secrets masked.
+	ExportToolCallRecordings(context.Context, *ExportToolCallRecordingsPayload) (res *ExportToolCallRecordingsResult, err error)
+	// Replay the tool calls captured by a recording session against a deployment
+	// and report how the results differ. Upstream APIs are not contacted: each
+	// call is answered from the responses recorded with it.
+	ReplayToolCallRecordings(context.Context, *ReplayToolCallRecordingsPayload) (res *ReplayToolCallRecordingsResult, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// APIKeyAuth implements the authorization logic for the APIKey security scheme.
+	APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "gram"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "toolCallRecordings"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [5]string{"startToolCallRecording", "stopToolCallRecording", "listToolCallRecordingSessions", "exportToolCallRecordings", "replayToolCallRecordings"}
+
+// ExportToolCallRecordingsPayload is the payload type of the
+// toolCallRecordings service exportToolCallRecordings method.
+type ExportToolCallRecordingsPayload struct {
+	// The ID of the recording session
+	SessionID        string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// ExportToolCallRecordingsResult is the result type of the toolCallRecordings
+// service exportToolCallRecordings method.
+type ExportToolCallRecordingsResult struct {
+	Session    *types.ToolCallRecordingSession
+	Recordings []*types.ToolCallRecording
+}
+
+// ListToolCallRecordingSessionsPayload is the payload type of the
+// toolCallRecordings service listToolCallRecordingSessions method.
+type ListToolCallRecordingSessionsPayload struct {
+	// Optional filter: only return sessions recording this toolset.
+	ToolsetSlug      *types.Slug
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// ListToolCallRecordingSessionsResult is the result type of the
+// toolCallRecordings service listToolCallRecordingSessions method.
+type ListToolCallRecordingSessionsResult struct {
+	Sessions []*types.ToolCallRecordingSession
+}
+
+// ReplayToolCallRecordingsPayload is the payload type of the
+// toolCallRecordings service replayToolCallRecordings method.
+type ReplayToolCallRecordingsPayload struct {
+	// The ID of the recording session to replay
+	SessionID string
+	// The deployment to replay against. Defaults to the latest deployment.
+	DeploymentID     *string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// ReplayToolCallRecordingsResult is the result type of the toolCallRecordings
+// service replayToolCallRecordings method.
+type ReplayToolCallRecordingsResult struct {
+	// The ID of the replayed session
+	SessionID string
+	// The deployment the calls were replayed against
+	DeploymentID string
+	// Number of calls whose result matched the recording
+	Passed int32
+	// Number of calls whose result differed from the recording
+	Failed int32
+	// Number of calls that cannot be replayed offline
+	Skipped int32
+	// Number of calls whose replay failed
+	Errored int32
+	Replays []*types.ToolCallReplay
+}
+
+// StartToolCallRecordingPayload is the payload type of the toolCallRecordings
+// service startToolCallRecording method.
+type StartToolCallRecordingPayload struct {
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+	// The slug of the toolset to record.
+	ToolsetSlug types.Slug
+	// Stop recording after this many tool calls.
+	MaxCalls int32
+	// Stop recording after this many minutes.
+	DurationMinutes int32
+}
+
+// StopToolCallRecordingPayload is the payload type of the toolCallRecordings
+// service stopToolCallRecording method.
+type StopToolCallRecordingPayload struct {
+	// The ID of the recording session to stop
+	ID               string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeBadRequest builds a goa.ServiceError from an error.
+func MakeBadRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "bad_request", false, false, false)
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeConflict builds a goa.ServiceError from an error.
+func MakeConflict(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "conflict", false, false, false)
+}
+
+// MakeUnsupportedMedia builds a goa.ServiceError from an error.
+func MakeUnsupportedMedia(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unsupported_media", false, false, false)
+}
+
+// MakeInvalid builds a goa.ServiceError from an error.
+func MakeInvalid(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid", false, false, false)
+}
+
+// MakeInvariantViolation builds a goa.ServiceError from an error.
+func MakeInvariantViolation(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invariant_violation", false, false, true)
+}
+
+// MakeUnexpected builds a goa.ServiceError from an error.
+func MakeUnexpected(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unexpected", false, false, true)
+}
+
+// MakeGatewayError builds a goa.ServiceError from an error.
+func MakeGatewayError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "gateway_error", false, false, true)
+}
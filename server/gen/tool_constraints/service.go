@@ -0,0 +1,179 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// toolConstraints service
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package toolconstraints
+
+import (
+	"context"
+
+	types "github.com/speakeasy-api/gram/server/gen/types"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Manage argument constraints on tool calls attached to toolsets and MCP
+// servers.
+type Service interface {
+	// Attach an argument constraint for one tool to a toolset or an MCP server.
+	// Provide exactly one of toolset_id or mcp_server_id.
+	CreateToolConstraint(context.Context, *CreateToolConstraintPayload) (res *types.ToolConstraint, err error)
+	// List argument constraints for a project. Optionally filter to those attached
+	// to a specific toolset or MCP server.
+	ListToolConstraints(context.Context, *ListToolConstraintsPayload) (res *ListToolConstraintsResult, err error)
+	// Update an argument constraint. Omitted fields keep their stored values; the
+	// target and tool are fixed at creation.
+	UpdateToolConstraint(context.Context, *UpdateToolConstraintPayload) (res *types.ToolConstraint, err error)
+	// Delete an argument constraint.
+	DeleteToolConstraint(context.Context, *DeleteToolConstraintPayload) (err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// APIKeyAuth implements the authorization logic for the APIKey security scheme.
+	APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "gram"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "toolConstraints"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [4]string{"createToolConstraint", "listToolConstraints", "updateToolConstraint", "deleteToolConstraint"}
+
+// CreateToolConstraintPayload is the payload type of the toolConstraints
+// service createToolConstraint method.
+type CreateToolConstraintPayload struct {
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+	// The ID of the toolset the constraint applies to. Mutually exclusive with
+	// mcp_server_id.
+	ToolsetID *string
+	// The ID of the MCP server the constraint applies to. Mutually exclusive with
+	// toolset_id.
+	McpServerID *string
+	// The tool whose arguments the constraint is evaluated against.
+	ToolName string
+	// allow admits a call only when the expression is true; deny rejects a call
+	// when it is true.
+	Effect string
+	// CEL boolean expression over the call's arguments. Each top-level argument is
+	// a variable, and args holds them all as a map, e.g. `amount <= 500`, `repo in
+	// ["docs", "web"]`, `path.matches("^docs/")` or `!has(args.force) ||
+	// args.force == false`.
+	Expression string
+	// Explanation returned to the model when the constraint rejects a call.
+	Message *string
+}
+
+// DeleteToolConstraintPayload is the payload type of the toolConstraints
+// service deleteToolConstraint method.
+type DeleteToolConstraintPayload struct {
+	// The ID of the constraint to delete
+	ID               string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// ListToolConstraintsPayload is the payload type of the toolConstraints
+// service listToolConstraints method.
+type ListToolConstraintsPayload struct {
+	// Optional filter: only return constraints attached to this toolset.
+	ToolsetID *string
+	// Optional filter: only return constraints attached to this MCP server.
+	McpServerID      *string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// ListToolConstraintsResult is the result type of the toolConstraints service
+// listToolConstraints method.
+type ListToolConstraintsResult struct {
+	Constraints []*types.ToolConstraint
+}
+
+// UpdateToolConstraintPayload is the payload type of the toolConstraints
+// service updateToolConstraint method.
+type UpdateToolConstraintPayload struct {
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+	// The ID of the constraint to update
+	ID string
+	// allow admits a call only when the expression is true; deny rejects a call
+	// when it is true.
+	Effect *string
+	// CEL boolean expression over the call's arguments. Each top-level argument is
+	// a variable, and args holds them all as a map, e.g. `amount <= 500`, `repo in
+	// ["docs", "web"]`, `path.matches("^docs/")` or `!has(args.force) ||
+	// args.force == false`.
+	Expression *string
+	// Explanation returned to the model when the constraint rejects a call. An
+	// empty string clears it.
+	Message *string
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeBadRequest builds a goa.ServiceError from an error.
+func MakeBadRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "bad_request", false, false, false)
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeConflict builds a goa.ServiceError from an error.
+func MakeConflict(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "conflict", false, false, false)
+}
+
+// MakeUnsupportedMedia builds a goa.ServiceError from an error.
+func MakeUnsupportedMedia(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unsupported_media", false, false, false)
+}
+
+// MakeInvalid builds a goa.ServiceError from an error.
+func MakeInvalid(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid", false, false, false)
+}
+
+// MakeInvariantViolation builds a goa.ServiceError from an error.
+func MakeInvariantViolation(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invariant_violation", false, false, true)
+}
+
+// MakeUnexpected builds a goa.ServiceError from an error.
+func MakeUnexpected(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unexpected", false, false, true)
+}
+
+// MakeGatewayError builds a goa.ServiceError from an error.
+func MakeGatewayError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "gateway_error", false, false, true)
+}
@@ -0,0 +1,173 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// toolRateLimits service
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package toolratelimits
+
+import (
+	"context"
+
+	types "github.com/speakeasy-api/gram/server/gen/types"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Manage tool-call rate limits attached to toolsets and MCP servers.
+type Service interface {
+	// Attach a tool-call rate limit to a toolset or an MCP server. Provide exactly
+	// one of toolset_id or mcp_server_id.
+	CreateToolRateLimit(context.Context, *CreateToolRateLimitPayload) (res *types.ToolRateLimit, err error)
+	// List tool-call rate limits for a project. Optionally filter to those
+	// attached to a specific toolset or MCP server.
+	ListToolRateLimits(context.Context, *ListToolRateLimitsPayload) (res *ListToolRateLimitsResult, err error)
+	// Update the rate of a tool-call rate limit. Omitted fields keep their stored
+	// values; the target, subject and tool are fixed at creation.
+	UpdateToolRateLimit(context.Context, *UpdateToolRateLimitPayload) (res *types.ToolRateLimit, err error)
+	// Delete a tool-call rate limit.
+	DeleteToolRateLimit(context.Context, *DeleteToolRateLimitPayload) (err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// APIKeyAuth implements the authorization logic for the APIKey security scheme.
+	APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "gram"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "toolRateLimits"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [4]string{"createToolRateLimit", "listToolRateLimits", "updateToolRateLimit", "deleteToolRateLimit"}
+
+// CreateToolRateLimitPayload is the payload type of the toolRateLimits service
+// createToolRateLimit method.
+type CreateToolRateLimitPayload struct {
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+	// The ID of the toolset the limit applies to. Mutually exclusive with
+	// mcp_server_id.
+	ToolsetID *string
+	// The ID of the MCP server the limit applies to. Mutually exclusive with
+	// toolset_id.
+	McpServerID *string
+	// Who shares a bucket: each end user, each API key, or all callers together.
+	Subject string
+	// Restrict the limit to a single tool. Omit to apply it to every tool on the
+	// target.
+	ToolName *string
+	// Requests allowed per interval.
+	Requests int32
+	// Length of the refill interval in seconds.
+	IntervalSeconds int32
+	// Maximum requests a momentarily idle caller may make at once. Defaults to
+	// requests.
+	Burst *int32
+}
+
+// DeleteToolRateLimitPayload is the payload type of the toolRateLimits service
+// deleteToolRateLimit method.
+type DeleteToolRateLimitPayload struct {
+	// The ID of the rate limit to delete
+	ID               string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// ListToolRateLimitsPayload is the payload type of the toolRateLimits service
+// listToolRateLimits method.
+type ListToolRateLimitsPayload struct {
+	// Optional filter: only return limits attached to this toolset.
+	ToolsetID *string
+	// Optional filter: only return limits attached to this MCP server.
+	McpServerID      *string
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
+// ListToolRateLimitsResult is the result type of the toolRateLimits service
+// listToolRateLimits method.
+type ListToolRateLimitsResult struct {
+	RateLimits []*types.ToolRateLimit
+}
+
+// UpdateToolRateLimitPayload is the payload type of the toolRateLimits service
+// updateToolRateLimit method.
+type UpdateToolRateLimitPayload struct {
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+	// The ID of the rate limit to update
+	ID string
+	// Requests allowed per interval.
+	Requests *int32
+	// Length of the refill interval in seconds.
+	IntervalSeconds *int32
+	// Maximum requests a momentarily idle caller may make at once.
+	Burst *int32
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeBadRequest builds a goa.ServiceError from an error.
+func MakeBadRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "bad_request", false, false, false)
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeConflict builds a goa.ServiceError from an error.
+func MakeConflict(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "conflict", false, false, false)
+}
+
+// MakeUnsupportedMedia builds a goa.ServiceError from an error.
+func MakeUnsupportedMedia(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unsupported_media", false, false, false)
+}
+
+// MakeInvalid builds a goa.ServiceError from an error.
+func MakeInvalid(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid", false, false, false)
+}
+
+// MakeInvariantViolation builds a goa.ServiceError from an error.
+func MakeInvariantViolation(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invariant_violation", false, false, true)
+}
+
+// MakeUnexpected builds a goa.ServiceError from an error.
+func MakeUnexpected(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unexpected", false, false, true)
+}
+
+// MakeGatewayError builds a goa.ServiceError from an error.
+func MakeGatewayError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "gateway_error", false, false, true)
+}
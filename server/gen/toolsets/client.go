@@ -29,10 +29,12 @@ type Client struct {
 	RemoveOAuthServerEndpoint        goa.Endpoint
 	SetUserSessionIssuerEndpoint     goa.Endpoint
 	SetToolVariationsGroupEndpoint   goa.Endpoint
+	DiffToolsetVersionsEndpoint      goa.Endpoint
+	RevertToolsetEndpoint            goa.Endpoint
 }
 
 // NewClient initializes a "toolsets" service client given the endpoints.
-func NewClient(createToolset, listToolsets, listToolsetsForOrg, updateToolset, deleteToolset, getToolset, listToolFilters, checkMCPSlugAvailability, cloneToolset, addExternalOAuthServer, removeOAuthServer, setUserSessionIssuer, setToolVariationsGroup goa.Endpoint) *Client {
+func NewClient(createToolset, listToolsets, listToolsetsForOrg, updateToolset, deleteToolset, getToolset, listToolFilters, checkMCPSlugAvailability, cloneToolset, addExternalOAuthServer, removeOAuthServer, setUserSessionIssuer, setToolVariationsGroup, diffToolsetVersions, revertToolset goa.Endpoint) *Client {
 	return &Client{
 		CreateToolsetEndpoint:            createToolset,
 		ListToolsetsEndpoint:             listToolsets,
@@ -47,6 +49,8 @@ func NewClient(createToolset, listToolsets, listToolsetsForOrg, updateToolset, d
 		RemoveOAuthServerEndpoint:        removeOAuthServer,
 		SetUserSessionIssuerEndpoint:     setUserSessionIssuer,
 		SetToolVariationsGroupEndpoint:   setToolVariationsGroup,
+		DiffToolsetVersionsEndpoint:      diffToolsetVersions,
+		RevertToolsetEndpoint:            revertToolset,
 	}
 }
 
@@ -338,3 +342,48 @@ func (c *Client) SetToolVariationsGroup(ctx context.Context, p *SetToolVariation
 	}
 	return ires.(*types.Toolset), nil
 }
+
+// DiffToolsetVersions calls the "diffToolsetVersions" endpoint of the
+// "toolsets" service.
+// DiffToolsetVersions may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) DiffToolsetVersions(ctx context.Context, p *DiffToolsetVersionsPayload) (res *ToolsetVersionDiff, err error) {
+	var ires any
+	ires, err = c.DiffToolsetVersionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ToolsetVersionDiff), nil
+}
+
+// RevertToolset calls the "revertToolset" endpoint of the "toolsets" service.
+// RevertToolset may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): unauthorized access
+//   - "forbidden" (type *goa.ServiceError): permission denied
+//   - "bad_request" (type *goa.ServiceError): request is invalid
+//   - "not_found" (type *goa.ServiceError): resource not found
+//   - "conflict" (type *goa.ServiceError): resource already exists
+//   - "unsupported_media" (type *goa.ServiceError): unsupported media type
+//   - "invalid" (type *goa.ServiceError): request contains one or more invalidation fields
+//   - "invariant_violation" (type *goa.ServiceError): an unexpected error occurred
+//   - "unexpected" (type *goa.ServiceError): an unexpected error occurred
+//   - "gateway_error" (type *goa.ServiceError): an unexpected error occurred
+//   - error: internal error
+func (c *Client) RevertToolset(ctx context.Context, p *RevertToolsetPayload) (res *types.Toolset, err error) {
+	var ires any
+	ires, err = c.RevertToolsetEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*types.Toolset), nil
+}
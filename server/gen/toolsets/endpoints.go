@@ -29,6 +29,8 @@ type Endpoints struct {
 	RemoveOAuthServer        goa.Endpoint
 	SetUserSessionIssuer     goa.Endpoint
 	SetToolVariationsGroup   goa.Endpoint
+	DiffToolsetVersions      goa.Endpoint
+	RevertToolset            goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "toolsets" service with endpoints.
@@ -49,6 +51,8 @@ func NewEndpoints(s Service) *Endpoints {
 		RemoveOAuthServer:        NewRemoveOAuthServerEndpoint(s, a.APIKeyAuth),
 		SetUserSessionIssuer:     NewSetUserSessionIssuerEndpoint(s, a.APIKeyAuth),
 		SetToolVariationsGroup:   NewSetToolVariationsGroupEndpoint(s, a.APIKeyAuth),
+		DiffToolsetVersions:      NewDiffToolsetVersionsEndpoint(s, a.APIKeyAuth),
+		RevertToolset:            NewRevertToolsetEndpoint(s, a.APIKeyAuth),
 	}
 }
 
@@ -67,6 +71,8 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.RemoveOAuthServer = m(e.RemoveOAuthServer)
 	e.SetUserSessionIssuer = m(e.SetUserSessionIssuer)
 	e.SetToolVariationsGroup = m(e.SetToolVariationsGroup)
+	e.DiffToolsetVersions = m(e.DiffToolsetVersions)
+	e.RevertToolset = m(e.RevertToolset)
 }
 
 // NewCreateToolsetEndpoint returns an endpoint function that calls the method
@@ -811,3 +817,121 @@ func NewSetToolVariationsGroupEndpoint(s Service, authAPIKeyFn security.AuthAPIK
 		return s.SetToolVariationsGroup(ctx, p)
 	}
 }
+
+// NewDiffToolsetVersionsEndpoint returns an endpoint function that calls the
+// method "diffToolsetVersions" of service "toolsets".
+func NewDiffToolsetVersionsEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DiffToolsetVersionsPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "session",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var key string
+		if p.SessionToken != nil {
+			key = *p.SessionToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err == nil {
+			sc := security.APIKeyScheme{
+				Name:           "project_slug",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.ProjectSlugInput != nil {
+				key = *p.ProjectSlugInput
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "apikey",
+				Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+				RequiredScopes: []string{"producer"},
+			}
+			var key string
+			if p.ApikeyToken != nil {
+				key = *p.ApikeyToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+			if err == nil {
+				sc := security.APIKeyScheme{
+					Name:           "project_slug",
+					Scopes:         []string{},
+					RequiredScopes: []string{"producer"},
+				}
+				var key string
+				if p.ProjectSlugInput != nil {
+					key = *p.ProjectSlugInput
+				}
+				ctx, err = authAPIKeyFn(ctx, key, &sc)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.DiffToolsetVersions(ctx, p)
+	}
+}
+
+// NewRevertToolsetEndpoint returns an endpoint function that calls the method
+// "revertToolset" of service "toolsets".
+func NewRevertToolsetEndpoint(s Service, authAPIKeyFn security.AuthAPIKeyFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevertToolsetPayload)
+		var err error
+		sc := security.APIKeyScheme{
+			Name:           "session",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		var key string
+		if p.SessionToken != nil {
+			key = *p.SessionToken
+		}
+		ctx, err = authAPIKeyFn(ctx, key, &sc)
+		if err == nil {
+			sc := security.APIKeyScheme{
+				Name:           "project_slug",
+				Scopes:         []string{},
+				RequiredScopes: []string{},
+			}
+			var key string
+			if p.ProjectSlugInput != nil {
+				key = *p.ProjectSlugInput
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+		}
+		if err != nil {
+			sc := security.APIKeyScheme{
+				Name:           "apikey",
+				Scopes:         []string{"consumer", "producer", "chat", "hooks", "agent", "agent_user"},
+				RequiredScopes: []string{"producer"},
+			}
+			var key string
+			if p.ApikeyToken != nil {
+				key = *p.ApikeyToken
+			}
+			ctx, err = authAPIKeyFn(ctx, key, &sc)
+			if err == nil {
+				sc := security.APIKeyScheme{
+					Name:           "project_slug",
+					Scopes:         []string{},
+					RequiredScopes: []string{"producer"},
+				}
+				var key string
+				if p.ProjectSlugInput != nil {
+					key = *p.ProjectSlugInput
+				}
+				ctx, err = authAPIKeyFn(ctx, key, &sc)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		return s.RevertToolset(ctx, p)
+	}
+}
@@ -49,6 +49,14 @@ type Service interface {
 	// Assign a tool variations group to a toolset to enable MCP tool filtering (or
 	// pass null to disable). The group must already exist in the caller's project.
 	SetToolVariationsGroup(context.Context, *SetToolVariationsGroupPayload) (res *types.Toolset, err error)
+	// Compare the tools and resources of two versions of a toolset. Versions are
+	// recorded on every change to the toolset's tools or resources and never
+	// change afterwards.
+	DiffToolsetVersions(context.Context, *DiffToolsetVersionsPayload) (res *ToolsetVersionDiff, err error)
+	// Revert a toolset's tools and resources to those of an earlier version. The
+	// revert is recorded as a new version, so MCP servers pinned to other versions
+	// are unaffected.
+	RevertToolset(context.Context, *RevertToolsetPayload) (res *types.Toolset, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -71,7 +79,7 @@ const ServiceName = "toolsets"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [13]string{"createToolset", "listToolsets", "listToolsetsForOrg", "updateToolset", "deleteToolset", "getToolset", "listToolFilters", "checkMCPSlugAvailability", "cloneToolset", "addExternalOAuthServer", "removeOAuthServer", "setUserSessionIssuer", "setToolVariationsGroup"}
+var MethodNames = [15]string{"createToolset", "listToolsets", "listToolsetsForOrg", "updateToolset", "deleteToolset", "getToolset", "listToolFilters", "checkMCPSlugAvailability", "cloneToolset", "addExternalOAuthServer", "removeOAuthServer", "setUserSessionIssuer", "setToolVariationsGroup", "diffToolsetVersions", "revertToolset"}
 
 // AddExternalOAuthServerPayload is the payload type of the toolsets service
 // addExternalOAuthServer method.
@@ -135,6 +143,20 @@ type DeleteToolsetPayload struct {
 	ProjectSlugInput *string
 }
 
+// DiffToolsetVersionsPayload is the payload type of the toolsets service
+// diffToolsetVersions method.
+type DiffToolsetVersionsPayload struct {
+	// The slug of the toolset
+	Slug types.Slug
+	// The version to compare from
+	FromVersion int64
+	// The version to compare to
+	ToVersion        int64
+	SessionToken     *string
+	ApikeyToken      *string
+	ProjectSlugInput *string
+}
+
 // GetToolsetPayload is the payload type of the toolsets service getToolset
 // method.
 type GetToolsetPayload struct {
@@ -194,6 +216,18 @@ type RemoveOAuthServerPayload struct {
 	ProjectSlugInput *string
 }
 
+// RevertToolsetPayload is the payload type of the toolsets service
+// revertToolset method.
+type RevertToolsetPayload struct {
+	SessionToken *string
+	ApikeyToken  *string
+	// The slug of the toolset to revert
+	Slug types.Slug
+	// The version whose tools and resources become the toolset's new latest version
+	Version          int64
+	ProjectSlugInput *string
+}
+
 // SetToolVariationsGroupPayload is the payload type of the toolsets service
 // setToolVariationsGroup method.
 type SetToolVariationsGroupPayload struct {
@@ -218,6 +252,23 @@ type SetUserSessionIssuerPayload struct {
 	ProjectSlugInput    *string
 }
 
+// ToolsetVersionDiff is the result type of the toolsets service
+// diffToolsetVersions method.
+type ToolsetVersionDiff struct {
+	// The version compared from
+	FromVersion int64
+	// The version compared to
+	ToVersion int64
+	// Tool URNs in to_version but not in from_version
+	AddedToolUrns []string
+	// Tool URNs in from_version but not in to_version
+	RemovedToolUrns []string
+	// Resource URNs in to_version but not in from_version
+	AddedResourceUrns []string
+	// Resource URNs in from_version but not in to_version
+	RemovedResourceUrns []string
+}
+
 // UpdateToolsetPayload is the payload type of the toolsets service
 // updateToolset method.
 type UpdateToolsetPayload struct {
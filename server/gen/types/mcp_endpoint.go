@@ -23,6 +23,9 @@ type McpEndpoint struct {
 	// The ID of the meta MCP server this endpoint addresses. Null for
 	// MCP-server-backed endpoints.
 	MetaMcpServerID *string
+	// The toolset version this endpoint is pinned to, if any. Overrides the
+	// version pinned on its MCP server.
+	ToolsetVersion *int64
 	// The slug
 	Slug McpEndpointSlug
 	// Whether this endpoint is mapped to its custom-domain root
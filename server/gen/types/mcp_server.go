@@ -35,6 +35,9 @@ type McpServer struct {
 	// The ID of the tool variations group enabling MCP tool filtering for this
 	// server, if any.
 	ToolVariationsGroupID *string
+	// The toolset version this server is pinned to, if any. Unpinned servers serve
+	// the latest toolset version.
+	ToolsetVersion *int64
 	// The visibility of the server
 	Visibility McpServerVisibility
 	// When the MCP server was created
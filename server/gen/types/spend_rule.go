@@ -30,6 +30,12 @@ type SpendRule struct {
 	// CEL boolean expression over member attributes (email, directory attributes,
 	// groups, roles) selecting who the rule applies to.
 	TargetExpr string
+	// Structured usage-dimension condition selecting which spend counts toward the
+	// limit. Absent when all spend counts.
+	Scope *SpendRuleScopeCondition
+	// CEL boolean expression over usage dimensions (model, model_family,
+	// assistant_id, project_id, toolset_slug). Empty when all spend counts.
+	ScopeExpr string
 	// CEL boolean expression over actor usage that identifies a budget breach for
 	// matched members.
 	RuleExpr string
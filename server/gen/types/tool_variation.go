@@ -38,6 +38,14 @@ type ToolVariation struct {
 	IdempotentHint *bool
 	// Override: if true, the tool interacts with external entities
 	OpenWorldHint *bool
+	// How long the gateway caches responses of this tool
+	CacheTTLSeconds *int32
+	// If true, cached responses are shared between callers with identical
+	// environments
+	CacheShared *bool
+	// Arguments filled server-side on every call and hidden from the tool's input
+	// schema
+	ArgumentBindings []*ToolArgumentBinding
 	// The creation date of the tool variation
 	CreatedAt string
 	// The last update date of the tool variation
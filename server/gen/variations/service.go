@@ -148,6 +148,15 @@ type UpsertGlobalPayload struct {
 	IdempotentHint *bool
 	// Override: if true, the tool interacts with external entities
 	OpenWorldHint *bool
+	// How long the gateway caches responses of this tool. Only applies to
+	// read-only or idempotent tools; unset disables caching.
+	CacheTTLSeconds *int32
+	// If true, cached responses are shared between callers with identical
+	// environments instead of being kept per caller
+	CacheShared *bool
+	// Arguments filled server-side on every call and hidden from the tool's input
+	// schema. Bound values cannot be chosen by the model.
+	ArgumentBindings []*types.ToolArgumentBinding
 }
 
 // UpsertGlobalToolVariationResult is the result type of the variations service
@@ -0,0 +1,264 @@
+// Code generated by goa v3.25.3, DO NOT EDIT.
+//
+// webhookEndpoints service
+//
+// Command:
+// $ goa gen github.com/speakeasy-api/gram/server/design
+
+package webhookendpoints
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
+)
+
+// Register organization webhook endpoints for the self-hosted delivery backend
+// and inspect their delivery log.
+type Service interface {
+	// Register a webhook endpoint. The response carries the endpoint's signing
+	// secret, which is not retrievable afterwards. Requires org:admin.
+	CreateEndpoint(context.Context, *CreateEndpointPayload) (res *CreateWebhookEndpointResult, err error)
+	// List the organization's webhook endpoints. Requires org:read.
+	ListEndpoints(context.Context, *ListEndpointsPayload) (res *ListWebhookEndpointsResult, err error)
+	// Update a webhook endpoint. Omitted fields are left unchanged; pass an empty
+	// event_types list to receive every event type. Requires org:admin.
+	UpdateEndpoint(context.Context, *UpdateEndpointPayload) (res *WebhookEndpoint, err error)
+	// Delete a webhook endpoint. Deliveries still pending for it are abandoned.
+	// Requires org:admin.
+	DeleteEndpoint(context.Context, *DeleteEndpointPayload) (err error)
+	// List an endpoint's deliveries, newest first, each with its attempt log.
+	// Requires org:read.
+	ListDeliveries(context.Context, *ListDeliveriesPayload) (res *ListWebhookDeliveriesResult, err error)
+	// Queue one more attempt for a delivery that succeeded or was dead-lettered.
+	// The attempt count carries on, so a failed redelivery returns to
+	// dead_lettered rather than restarting the retry schedule. Requires org:admin.
+	Redeliver(context.Context, *RedeliverPayload) (res *WebhookDelivery, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// APIKeyAuth implements the authorization logic for the APIKey security scheme.
+	APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "gram"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "0.0.1"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "webhookEndpoints"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [6]string{"createEndpoint", "listEndpoints", "updateEndpoint", "deleteEndpoint", "listDeliveries", "redeliver"}
+
+// CreateEndpointPayload is the payload type of the webhookEndpoints service
+// createEndpoint method.
+type CreateEndpointPayload struct {
+	// The URL to POST deliveries to.
+	URL string
+	// A human-readable description.
+	Description *string
+	// The event types to receive. Omit or leave empty to receive every event type.
+	EventTypes   []string
+	SessionToken *string
+}
+
+// CreateWebhookEndpointResult is the result type of the webhookEndpoints
+// service createEndpoint method.
+type CreateWebhookEndpointResult struct {
+	// The registered endpoint.
+	Endpoint *WebhookEndpoint
+	// The Standard Webhooks signing secret (whsec_...). Returned only once; store
+	// it to verify the webhook-signature header on deliveries.
+	Secret string
+}
+
+// DeleteEndpointPayload is the payload type of the webhookEndpoints service
+// deleteEndpoint method.
+type DeleteEndpointPayload struct {
+	// The ID of the endpoint to delete.
+	ID           string
+	SessionToken *string
+}
+
+// ListDeliveriesPayload is the payload type of the webhookEndpoints service
+// listDeliveries method.
+type ListDeliveriesPayload struct {
+	// The ID of the endpoint.
+	EndpointID string
+	// Only return deliveries in this state.
+	DeliveryStatus *string
+	// The next_cursor from a previous page.
+	Cursor *string
+	// The page size.
+	Limit        int32
+	SessionToken *string
+}
+
+// ListEndpointsPayload is the payload type of the webhookEndpoints service
+// listEndpoints method.
+type ListEndpointsPayload struct {
+	SessionToken *string
+}
+
+// ListWebhookDeliveriesResult is the result type of the webhookEndpoints
+// service listDeliveries method.
+type ListWebhookDeliveriesResult struct {
+	// Deliveries to the endpoint, newest first.
+	Deliveries []*WebhookDelivery
+	// Pass as cursor to fetch the next page. Absent on the last page.
+	NextCursor *string
+}
+
+// ListWebhookEndpointsResult is the result type of the webhookEndpoints
+// service listEndpoints method.
+type ListWebhookEndpointsResult struct {
+	// The organization's webhook endpoints, newest first.
+	Endpoints []*WebhookEndpoint
+}
+
+// RedeliverPayload is the payload type of the webhookEndpoints service
+// redeliver method.
+type RedeliverPayload struct {
+	// The ID of the delivery.
+	ID           string
+	SessionToken *string
+}
+
+// UpdateEndpointPayload is the payload type of the webhookEndpoints service
+// updateEndpoint method.
+type UpdateEndpointPayload struct {
+	// The ID of the endpoint to update.
+	ID string
+	// The URL to POST deliveries to.
+	URL *string
+	// A human-readable description.
+	Description *string
+	// The event types to receive.
+	EventTypes []string
+	// Pause or resume deliveries.
+	Disabled     *bool
+	SessionToken *string
+}
+
+// WebhookDelivery is the result type of the webhookEndpoints service redeliver
+// method.
+type WebhookDelivery struct {
+	// The ID of the delivery.
+	ID string
+	// The endpoint the delivery targets.
+	EndpointID string
+	// The event ID, sent as the webhook-id header. Stable across attempts.
+	EventID string
+	// The event type.
+	EventType string
+	// pending: waiting for its next attempt. succeeded: the endpoint accepted it
+	// with a 2xx. dead_lettered: every attempt failed; it is not retried again
+	// unless redelivered.
+	DeliveryStatus string
+	// How many attempts have been made.
+	Attempts int32
+	// When the next attempt is due, for pending deliveries.
+	NextAttemptAt *string
+	// When the most recent attempt was made.
+	LastAttemptAt *string
+	// When the delivery was queued.
+	CreatedAt string
+	// Every attempt made so far, oldest first.
+	AttemptLog []*WebhookDeliveryAttempt
+}
+
+// A single attempt to deliver a webhook.
+type WebhookDeliveryAttempt struct {
+	// The 1-based attempt number.
+	Attempt int32
+	// The HTTP status the endpoint responded with. Absent when no response was
+	// received.
+	ResponseStatus *int32
+	// Why the attempt failed. Absent on success.
+	Error *string
+	// How long the attempt took, in milliseconds.
+	DurationMs int32
+	// When the attempt was made.
+	AttemptedAt string
+}
+
+// WebhookEndpoint is the result type of the webhookEndpoints service
+// updateEndpoint method.
+type WebhookEndpoint struct {
+	// The ID of the endpoint.
+	ID string
+	// The organization that owns the endpoint.
+	OrganizationID string
+	// The URL webhook deliveries are POSTed to.
+	URL string
+	// A human-readable description of the endpoint.
+	Description *string
+	// The event types the endpoint receives. Empty receives every event type.
+	EventTypes []string
+	// Whether the endpoint is paused. A disabled endpoint is not sent new events;
+	// deliveries already queued for it resume when it is re-enabled.
+	Disabled bool
+	// When the endpoint was created.
+	CreatedAt string
+	// When the endpoint was last updated.
+	UpdatedAt string
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeBadRequest builds a goa.ServiceError from an error.
+func MakeBadRequest(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "bad_request", false, false, false)
+}
+
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not_found", false, false, false)
+}
+
+// MakeConflict builds a goa.ServiceError from an error.
+func MakeConflict(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "conflict", false, false, false)
+}
+
+// MakeUnsupportedMedia builds a goa.ServiceError from an error.
+func MakeUnsupportedMedia(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unsupported_media", false, false, false)
+}
+
+// MakeInvalid builds a goa.ServiceError from an error.
+func MakeInvalid(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid", false, false, false)
+}
+
+// MakeInvariantViolation builds a goa.ServiceError from an error.
+func MakeInvariantViolation(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invariant_violation", false, false, true)
+}
+
+// MakeUnexpected builds a goa.ServiceError from an error.
+func MakeUnexpected(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unexpected", false, false, true)
+}
+
+// MakeGatewayError builds a goa.ServiceError from an error.
+func MakeGatewayError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "gateway_error", false, false, true)
+}
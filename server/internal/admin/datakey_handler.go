@@ -0,0 +1,268 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/admin/repo"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/productfeatures"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/gcp/gcpkms"
+)
+
+// adminOrganizationDataKeyResponse describes an organization's customer-managed
+// encryption key. Like adminOrganizationFeaturesResponse, these routes are
+// hand written so they stay out of the public OpenAPI document: enabling and
+// shredding are onboarding and offboarding steps run by the Speakeasy team.
+type adminOrganizationDataKeyResponse struct {
+	Enabled              bool       `json:"enabled"`
+	DataKeyID            *string    `json:"data_key_id"`
+	KmsKeyName           *string    `json:"kms_key_name"`
+	ExternalCredentialID *string    `json:"external_credential_id"`
+	CreatedAt            *time.Time `json:"created_at"`
+}
+
+type enableAdminOrganizationDataKeyRequest struct {
+	OrganizationID       string `json:"organization_id"`
+	KmsKeyName           string `json:"kms_key_name"`
+	ExternalCredentialID string `json:"external_credential_id"`
+}
+
+type shredAdminOrganizationDataKeyRequest struct {
+	OrganizationID string `json:"organization_id"`
+}
+
+func (s *Service) requireOrganizationKeys() error {
+	if s.orgKeys == nil {
+		return oops.E(oops.CodeUnavailable, nil, "customer-managed encryption keys are not configured on this server")
+	}
+	return nil
+}
+
+func (s *Service) writeAdminOrganizationDataKey(w http.ResponseWriter, ctx context.Context, organizationID string) error {
+	key, err := s.orgKeys.ActiveDataKey(ctx, organizationID)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "load organization data key").LogError(ctx, s.logger)
+	}
+
+	res := adminOrganizationDataKeyResponse{
+		Enabled:              false,
+		DataKeyID:            nil,
+		KmsKeyName:           nil,
+		ExternalCredentialID: nil,
+		CreatedAt:            nil,
+	}
+	if key != nil {
+		res = adminOrganizationDataKeyResponse{
+			Enabled:              true,
+			DataKeyID:            new(key.ID.String()),
+			KmsKeyName:           new(key.KmsKeyName),
+			ExternalCredentialID: nil,
+			CreatedAt:            new(key.CreatedAt.Time),
+		}
+		if key.ExternalCredentialID.Valid {
+			res.ExternalCredentialID = new(key.ExternalCredentialID.UUID.String())
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "encode organization data key").LogError(ctx, s.logger)
+	}
+	return nil
+}
+
+func (s *Service) handleGetOrganizationDataKey(w http.ResponseWriter, r *http.Request) error {
+	ctx, err := s.authorizeAdminRequest(r)
+	if err != nil {
+		return err
+	}
+	if err := s.requireOrganizationKeys(); err != nil {
+		return err
+	}
+	organizationID, err := s.canonicalAdminOrganizationForRequest(ctx, r.URL.Query().Get("organization_id"))
+	if err != nil {
+		return err
+	}
+	return s.writeAdminOrganizationDataKey(w, ctx, organizationID)
+}
+
+// handleEnableOrganizationDataKey turns on customer-managed encryption for an
+// organization: secrets it writes from then on are sealed under a data key
+// wrapped by the named KMS key. The organization must have the
+// customer_managed_encryption_keys feature, and the credential must be one of
+// its organization-level GCP credentials.
+func (s *Service) handleEnableOrganizationDataKey(w http.ResponseWriter, r *http.Request) error {
+	ctx, err := s.authorizeAdminRequest(r)
+	if err != nil {
+		return err
+	}
+	if err := s.requireOrganizationKeys(); err != nil {
+		return err
+	}
+
+	var body enableAdminOrganizationDataKeyRequest
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&body); err != nil {
+		return oops.E(oops.CodeBadRequest, err, "decode organization data key request")
+	}
+	if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return oops.E(oops.CodeBadRequest, err, "decode organization data key request")
+	}
+	credentialID, err := uuid.Parse(body.ExternalCredentialID)
+	if err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid external credential id")
+	}
+
+	organizationID, err := s.canonicalAdminOrganizationForRequest(ctx, body.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	enabled, err := s.productFeatures.IsFeatureEnabled(ctx, organizationID, productfeatures.FeatureCustomerManagedEncryptionKeys)
+	switch {
+	case err != nil:
+		return oops.E(oops.CodeUnexpected, err, "read organization feature flag").LogError(ctx, s.logger)
+	case !enabled:
+		return oops.E(oops.CodeFailedPrecondition, nil, "enable the customer_managed_encryption_keys feature for this organization first")
+	}
+
+	logger := s.logger.With(attr.SlogOrganizationID(organizationID))
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "begin data key transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return tx.Rollback(ctx) })
+
+	key, err := s.orgKeys.Enable(ctx, tx, organizationID, body.KmsKeyName, credentialID)
+	switch {
+	case errors.Is(err, gcpkms.ErrInvalidResourceName):
+		return oops.E(oops.CodeBadRequest, err, "kms key name must be a projects/<p>/locations/<l>/keyRings/<r>/cryptoKeys/<k> path")
+	case errors.Is(err, envelope.ErrCredentialUnusable):
+		return oops.E(oops.CodeBadRequest, err, "%s", err.Error())
+	case errors.Is(err, envelope.ErrCustomerKeyUnavailable):
+		return oops.E(oops.CodeBadRequest, err, "the credential cannot encrypt and decrypt with this key; grant it roles/cloudkms.cryptoKeyEncrypterDecrypter on the key")
+	case errors.Is(err, envelope.ErrDataKeyExists):
+		return oops.E(oops.CodeConflict, err, "organization already has a customer-managed encryption key")
+	case err != nil:
+		return oops.E(oops.CodeUnexpected, err, "enable customer-managed encryption").LogError(ctx, logger)
+	}
+
+	organization, err := repo.New(tx).AdminGetOrganization(ctx, repo.AdminGetOrganizationParams{
+		ID:        organizationID,
+		AllowSlug: false,
+	})
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "read organization for data key").LogError(ctx, logger)
+	}
+
+	actor, operatorEmail := adminActor(ctx)
+	if err := s.audit.LogOrganizationDataKeyEnabled(ctx, tx, audit.LogOrganizationDataKeyEnabledEvent{
+		OrganizationID:       organizationID,
+		Actor:                actor,
+		ActorDisplayName:     conv.PtrEmpty(audit.SpeakeasyTeamActorLabel),
+		ActorSlug:            nil,
+		OrganizationName:     organization.Name,
+		OrganizationSlug:     organization.Slug,
+		DataKeyID:            key.ID,
+		KmsKeyName:           key.KmsKeyName,
+		ExternalCredentialID: credentialID,
+	}); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "log data key enabled").LogError(ctx, logger)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "commit data key").LogError(ctx, logger)
+	}
+
+	logger.InfoContext(ctx, "enabled customer-managed encryption",
+		attr.SlogAuthUserEmail(conv.PtrValOr(operatorEmail, "unknown")),
+	)
+	return s.writeAdminOrganizationDataKey(w, ctx, organizationID)
+}
+
+// handleShredOrganizationDataKey erases an organization's wrapped data key.
+// Every secret sealed under it becomes unrecoverable; there is no undo.
+func (s *Service) handleShredOrganizationDataKey(w http.ResponseWriter, r *http.Request) error {
+	ctx, err := s.authorizeAdminRequest(r)
+	if err != nil {
+		return err
+	}
+	if err := s.requireOrganizationKeys(); err != nil {
+		return err
+	}
+
+	var body shredAdminOrganizationDataKeyRequest
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&body); err != nil {
+		return oops.E(oops.CodeBadRequest, err, "decode organization data key shred request")
+	}
+	if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return oops.E(oops.CodeBadRequest, err, "decode organization data key shred request")
+	}
+
+	organizationID, err := s.canonicalAdminOrganizationForRequest(ctx, body.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	logger := s.logger.With(attr.SlogOrganizationID(organizationID))
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "begin data key shred transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return tx.Rollback(ctx) })
+
+	shredded, err := s.orgKeys.Shred(ctx, tx, organizationID)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "shred organization data key").LogError(ctx, logger)
+	}
+	if len(shredded) == 0 {
+		return oops.E(oops.CodeNotFound, nil, "organization has no customer-managed encryption key")
+	}
+
+	organization, err := repo.New(tx).AdminGetOrganization(ctx, repo.AdminGetOrganizationParams{
+		ID:        organizationID,
+		AllowSlug: false,
+	})
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "read organization for data key shred").LogError(ctx, logger)
+	}
+
+	actor, operatorEmail := adminActor(ctx)
+	if err := s.audit.LogOrganizationDataKeyShredded(ctx, tx, audit.LogOrganizationDataKeyShreddedEvent{
+		OrganizationID:   organizationID,
+		Actor:            actor,
+		ActorDisplayName: conv.PtrEmpty(audit.SpeakeasyTeamActorLabel),
+		ActorSlug:        nil,
+		OrganizationName: organization.Name,
+		OrganizationSlug: organization.Slug,
+		DataKeyIDs:       shredded,
+	}); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "log data key shred").LogError(ctx, logger)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "commit data key shred").LogError(ctx, logger)
+	}
+
+	logger.WarnContext(ctx, "shredded organization data key",
+		attr.SlogAuthUserEmail(conv.PtrValOr(operatorEmail, "unknown")),
+	)
+	return s.writeAdminOrganizationDataKey(w, ctx, organizationID)
+}
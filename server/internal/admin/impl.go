@@ -34,6 +34,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/contextvalues"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
 	"github.com/speakeasy-api/gram/server/internal/middleware"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
 	"github.com/speakeasy-api/gram/server/internal/oops"
@@ -75,6 +76,11 @@ type Service struct {
 
 	trial   trialemails.Notifier
 	billing BillingOperations
+
+	// orgKeys manages customer-managed encryption keys. It is nil when the
+	// admin server has no encryption key configured, in which case the
+	// organization.dataKey routes report unavailable.
+	orgKeys *envelope.Keyring
 }
 
 type BillingOperations interface {
@@ -151,6 +157,7 @@ func NewService(
 	chatAnalysisSignaler analysis.Signaler,
 	billing BillingOperations,
 	dashboardURL *url.URL,
+	orgKeys *envelope.Keyring,
 ) *Service {
 	logger = logger.With(attr.SlogComponent("admin"))
 
@@ -193,6 +200,7 @@ func NewService(
 		),
 		trial:   trialNotifier,
 		billing: billing,
+		orgKeys: orgKeys,
 	}
 }
 
@@ -243,6 +251,21 @@ func Attach(mux goahttp.Muxer, service *Service) {
 		"/admin/organization.open-dashboard",
 		oops.ErrHandle(service.logger, service.handleOpenOrganizationInDashboard).ServeHTTP,
 	)
+	mux.Handle(
+		http.MethodGet,
+		"/admin/organization.dataKey",
+		oops.ErrHandle(service.logger, service.handleGetOrganizationDataKey).ServeHTTP,
+	)
+	mux.Handle(
+		http.MethodPost,
+		"/admin/organization.dataKey",
+		oops.ErrHandle(service.logger, service.handleEnableOrganizationDataKey).ServeHTTP,
+	)
+	mux.Handle(
+		http.MethodPost,
+		"/admin/organization.dataKeyShred",
+		oops.ErrHandle(service.logger, service.handleShredOrganizationDataKey).ServeHTTP,
+	)
 }
 
 func (s *Service) APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error) {
@@ -186,13 +186,10 @@ func (sbs *S3BlobStore) Write(ctx context.Context, subpath string, contentType s
 	}
 
 	pr, pw := io.Pipe()
+	w := &s3ObjectWriter{pw: pw, done: make(chan struct{}), err: nil}
 
 	go func() {
-		defer func() {
-			if closeErr := pw.Close(); closeErr != nil {
-				sbs.logger.ErrorContext(ctx, "failed to close pipe writer", attr.SlogError(closeErr))
-			}
-		}()
+		defer close(w.done)
 		_, err := sbs.client.PutObject(ctx, &s3.PutObjectInput{
 			Bucket:        aws.String(sbs.bucket),
 			Key:           aws.String(strings.TrimPrefix(uri.Path, "/")),
@@ -202,10 +199,39 @@ func (sbs *S3BlobStore) Write(ctx context.Context, subpath string, contentType s
 		})
 		if err != nil {
 			sbs.logger.ErrorContext(ctx, "failed to put object to s3", attr.SlogError(err))
+			w.err = fmt.Errorf("put object: %w", err)
 		}
+		// Unblock a writer still feeding a request that has already finished,
+		// successfully or not.
+		pr.CloseWithError(w.err)
 	}()
 
-	return pw, uri, nil
+	return w, uri, nil
+}
+
+// s3ObjectWriter feeds a PutObject running in the background. Close waits for
+// the upload to finish and reports its outcome, so a caller that checks the
+// Close error knows whether the object was stored.
+type s3ObjectWriter struct {
+	pw   *io.PipeWriter
+	done chan struct{}
+	err  error
+}
+
+func (w *s3ObjectWriter) Write(p []byte) (int, error) {
+	n, err := w.pw.Write(p)
+	if err != nil {
+		return n, fmt.Errorf("write s3 object body: %w", err)
+	}
+	return n, nil
+}
+
+func (w *s3ObjectWriter) Close() error {
+	if err := w.pw.Close(); err != nil {
+		return fmt.Errorf("close s3 object body: %w", err)
+	}
+	<-w.done
+	return w.err
 }
 
 // Delete removes the object; a missing key is success (S3 DeleteObject is
@@ -89,7 +89,12 @@ func (s *ServiceCore) slackTriggerToken(ctx context.Context, thread assistantThr
 	if err != nil {
 		return "", fmt.Errorf("load trigger environment for turn image inlining: %w", err)
 	}
-	token, err := slackapi.TokenFromEnv(slackapi.TokenPreferBot, toolconfig.CIEnvFrom(envMap))
+	env := toolconfig.CIEnvFrom(envMap)
+	tokenVars := []string{slackapi.BotTokenEnvVar, slackapi.UserTokenEnvVar, slackapi.TokenEnvVar}
+	if err := s.envLoader.ResolveSecretRefs(ctx, thread.ProjectID, env, tokenVars); err != nil {
+		return "", fmt.Errorf("resolve slack token for turn image inlining: %w", err)
+	}
+	token, err := slackapi.TokenFromEnv(slackapi.TokenPreferBot, env)
 	if err != nil {
 		return "", nil
 	}
@@ -164,6 +164,7 @@ const (
 	URLKey                      = attribute.Key("url")
 	CacheKeyKey                 = attribute.Key("gram.cache.key")
 	CacheNamespaceKey           = attribute.Key("gram.cache.namespace")
+	CanaryArmKey                = attribute.Key("gram.canary.arm")
 
 	// CIMDOriginKey is the host of a Client ID Metadata Document URL — the
 	// per-metadata-host dimension on cimd.fetch.* metrics. Attacker-influenced
@@ -407,6 +408,22 @@ const (
 	RiskReconcileRowCountKey       = attribute.Key("gram.risk.reconcile_row_count")
 	RiskReconcileRowsKeptKey       = attribute.Key("gram.risk.reconcile_rows_kept")
 	SpendRuleIDKey                 = attribute.Key("gram.spend.rule_id")
+	TelemetryAlertRuleIDKey        = attribute.Key("gram.telemetry_alert.rule_id")
+	TelemetryAlertStateKey         = attribute.Key("gram.telemetry_alert.state")
+	ToolConstraintIDKey            = attribute.Key("gram.tool_constraint.id")
+	ToolConstraintEffectKey        = attribute.Key("gram.tool_constraint.effect")
+	ToolApprovalPolicyIDKey        = attribute.Key("gram.tool_approval.policy_id")
+	ToolCallApprovalIDKey          = attribute.Key("gram.tool_approval.id")
+	ToolCallApprovalChannelKey     = attribute.Key("gram.tool_approval.channel")
+	ToolCallApprovalStatusKey      = attribute.Key("gram.tool_approval.status")
+	ToolRateLimitIDKey             = attribute.Key("gram.tool_rate_limit.id")
+	ToolRateLimitSubjectKey        = attribute.Key("gram.tool_rate_limit.subject")
+	ToolRateLimitTargetKey         = attribute.Key("gram.tool_rate_limit.target")
+	EncryptionKeyIDKey             = attribute.Key("gram.encryption.key_id")
+	EncryptionReencryptTargetKey   = attribute.Key("gram.encryption.reencrypt_target")
+	AuditExportFormatKey           = attribute.Key("gram.audit_export.format")
+	AuditExportObjectKeyKey        = attribute.Key("gram.audit_export.object_key")
+	AuditExportCursorSeqKey        = attribute.Key("gram.audit_export.cursor_seq")
 	RiskSourceKey                  = attribute.Key("gram.risk.source")
 	RiskScanAttemptKey             = attribute.Key("gram.risk.scan.attempt")
 	RiskScanMaxAttemptsKey         = attribute.Key("gram.risk.scan.max_attempts")
@@ -426,6 +443,7 @@ const (
 	ToolCallDurationKey            = attribute.Key("gram.tool_call.duration")
 	ToolCallKindKey                = attribute.Key("gram.tool_call.kind")
 	ToolCallSourceKey              = attribute.Key("gram.tool_call.source")
+	ToolCallCacheResultKey         = attribute.Key("gram.tool_call.cache.result")
 	ToolHTTPResponseContentTypeKey = attribute.Key("gram.tool.http.response.content_type")
 	ToolIDKey                      = attribute.Key("gram.tool.id")
 	ToolURNKey                     = attribute.Key("gram.tool.urn")
@@ -437,6 +455,11 @@ const (
 	SvixAppIDKey                   = attribute.Key("gram.svix.app_id")
 	SvixMessageIDKey               = attribute.Key("gram.svix.message_id")
 	WebhookDropReasonKey           = attribute.Key("gram.webhook.drop_reason")
+	WebhookEndpointIDKey           = attribute.Key("gram.webhook.endpoint.id")
+	WebhookDeliveryIDKey           = attribute.Key("gram.webhook.delivery.id")
+	WebhookDeliveryAttemptKey      = attribute.Key("gram.webhook.delivery.attempt")
+	WebhookDeliveryOutcomeKey      = attribute.Key("gram.webhook.delivery.outcome")
+	WebhookEventTypeKey            = attribute.Key("gram.webhook.event.type")
 	SvixPreviousAppIDKey           = attribute.Key("gram.svix.previous_app_id")
 	ToolsetIDKey                   = attribute.Key("gram.toolset.id")
 	ToolsetSlugKey                 = attribute.Key("gram.toolset.slug")
@@ -1069,6 +1092,9 @@ func SlogCacheKey(v string) slog.Attr      { return slog.String(string(CacheKeyK
 func CacheNamespace(v string) attribute.KeyValue { return CacheNamespaceKey.String(v) }
 func SlogCacheNamespace(v string) slog.Attr      { return slog.String(string(CacheNamespaceKey), v) }
 
+func CanaryArm(v string) attribute.KeyValue { return CanaryArmKey.String(v) }
+func SlogCanaryArm(v string) slog.Attr      { return slog.String(string(CanaryArmKey), v) }
+
 func CIMDOrigin(v string) attribute.KeyValue { return CIMDOriginKey.String(v) }
 func SlogCIMDOrigin(v string) slog.Attr      { return slog.String(string(CIMDOriginKey), v) }
 
@@ -1770,6 +1796,80 @@ func SlogRiskReconcileRowsKept(v int) slog.Attr {
 func SpendRuleID(v string) attribute.KeyValue { return SpendRuleIDKey.String(v) }
 func SlogSpendRuleID(v string) slog.Attr      { return slog.String(string(SpendRuleIDKey), v) }
 
+func TelemetryAlertRuleID(v string) attribute.KeyValue { return TelemetryAlertRuleIDKey.String(v) }
+func SlogTelemetryAlertRuleID(v string) slog.Attr {
+	return slog.String(string(TelemetryAlertRuleIDKey), v)
+}
+
+func TelemetryAlertState(v string) attribute.KeyValue { return TelemetryAlertStateKey.String(v) }
+func SlogTelemetryAlertState(v string) slog.Attr {
+	return slog.String(string(TelemetryAlertStateKey), v)
+}
+
+func ToolConstraintID(v string) attribute.KeyValue { return ToolConstraintIDKey.String(v) }
+func SlogToolConstraintID(v string) slog.Attr      { return slog.String(string(ToolConstraintIDKey), v) }
+
+func ToolConstraintEffect(v string) attribute.KeyValue { return ToolConstraintEffectKey.String(v) }
+func SlogToolConstraintEffect(v string) slog.Attr {
+	return slog.String(string(ToolConstraintEffectKey), v)
+}
+
+func ToolApprovalPolicyID(v string) attribute.KeyValue { return ToolApprovalPolicyIDKey.String(v) }
+func SlogToolApprovalPolicyID(v string) slog.Attr {
+	return slog.String(string(ToolApprovalPolicyIDKey), v)
+}
+
+func ToolCallApprovalID(v string) attribute.KeyValue { return ToolCallApprovalIDKey.String(v) }
+func SlogToolCallApprovalID(v string) slog.Attr      { return slog.String(string(ToolCallApprovalIDKey), v) }
+
+func ToolCallApprovalChannel(v string) attribute.KeyValue {
+	return ToolCallApprovalChannelKey.String(v)
+}
+func SlogToolCallApprovalChannel(v string) slog.Attr {
+	return slog.String(string(ToolCallApprovalChannelKey), v)
+}
+
+func ToolCallApprovalStatus(v string) attribute.KeyValue { return ToolCallApprovalStatusKey.String(v) }
+func SlogToolCallApprovalStatus(v string) slog.Attr {
+	return slog.String(string(ToolCallApprovalStatusKey), v)
+}
+
+func ToolRateLimitID(v string) attribute.KeyValue { return ToolRateLimitIDKey.String(v) }
+func SlogToolRateLimitID(v string) slog.Attr      { return slog.String(string(ToolRateLimitIDKey), v) }
+
+func ToolRateLimitSubject(v string) attribute.KeyValue { return ToolRateLimitSubjectKey.String(v) }
+func SlogToolRateLimitSubject(v string) slog.Attr {
+	return slog.String(string(ToolRateLimitSubjectKey), v)
+}
+
+func ToolRateLimitTarget(v string) attribute.KeyValue { return ToolRateLimitTargetKey.String(v) }
+func SlogToolRateLimitTarget(v string) slog.Attr {
+	return slog.String(string(ToolRateLimitTargetKey), v)
+}
+
+func EncryptionKeyID(v string) attribute.KeyValue { return EncryptionKeyIDKey.String(v) }
+func SlogEncryptionKeyID(v string) slog.Attr      { return slog.String(string(EncryptionKeyIDKey), v) }
+
+func EncryptionReencryptTarget(v string) attribute.KeyValue {
+	return EncryptionReencryptTargetKey.String(v)
+}
+func SlogEncryptionReencryptTarget(v string) slog.Attr {
+	return slog.String(string(EncryptionReencryptTargetKey), v)
+}
+
+func AuditExportFormat(v string) attribute.KeyValue { return AuditExportFormatKey.String(v) }
+func SlogAuditExportFormat(v string) slog.Attr      { return slog.String(string(AuditExportFormatKey), v) }
+
+func AuditExportObjectKey(v string) attribute.KeyValue { return AuditExportObjectKeyKey.String(v) }
+func SlogAuditExportObjectKey(v string) slog.Attr {
+	return slog.String(string(AuditExportObjectKeyKey), v)
+}
+
+func AuditExportCursorSeq(v int64) attribute.KeyValue { return AuditExportCursorSeqKey.Int64(v) }
+func SlogAuditExportCursorSeq(v int64) slog.Attr {
+	return slog.Int64(string(AuditExportCursorSeqKey), v)
+}
+
 func RiskSource(v string) attribute.KeyValue { return RiskSourceKey.String(v) }
 func SlogRiskSource(v string) slog.Attr      { return slog.String(string(RiskSourceKey), v) }
 
@@ -1838,6 +1938,25 @@ func SlogWebhookDropReason(v string) slog.Attr {
 	return slog.String(string(WebhookDropReasonKey), v)
 }
 
+func WebhookEndpointID(v string) attribute.KeyValue { return WebhookEndpointIDKey.String(v) }
+func SlogWebhookEndpointID(v string) slog.Attr      { return slog.String(string(WebhookEndpointIDKey), v) }
+
+func WebhookDeliveryID(v string) attribute.KeyValue { return WebhookDeliveryIDKey.String(v) }
+func SlogWebhookDeliveryID(v string) slog.Attr      { return slog.String(string(WebhookDeliveryIDKey), v) }
+
+func WebhookDeliveryAttempt(v int) attribute.KeyValue { return WebhookDeliveryAttemptKey.Int(v) }
+func SlogWebhookDeliveryAttempt(v int) slog.Attr {
+	return slog.Int(string(WebhookDeliveryAttemptKey), v)
+}
+
+func WebhookDeliveryOutcome(v string) attribute.KeyValue { return WebhookDeliveryOutcomeKey.String(v) }
+func SlogWebhookDeliveryOutcome(v string) slog.Attr {
+	return slog.String(string(WebhookDeliveryOutcomeKey), v)
+}
+
+func WebhookEventType(v string) attribute.KeyValue { return WebhookEventTypeKey.String(v) }
+func SlogWebhookEventType(v string) slog.Attr      { return slog.String(string(WebhookEventTypeKey), v) }
+
 func SvixPreviousAppID(v string) attribute.KeyValue { return SvixPreviousAppIDKey.String(v) }
 func SlogSvixPreviousAppID(v string) slog.Attr      { return slog.String(string(SvixPreviousAppIDKey), v) }
 
@@ -1859,6 +1978,11 @@ func SlogToolCallKind[V string](v V) slog.Attr {
 func ToolCallSource(v string) attribute.KeyValue { return ToolCallSourceKey.String(v) }
 func SlogToolCallSource(v string) slog.Attr      { return slog.String(string(ToolCallSourceKey), v) }
 
+func ToolCallCacheResult(v string) attribute.KeyValue { return ToolCallCacheResultKey.String(v) }
+func SlogToolCallCacheResult(v string) slog.Attr {
+	return slog.String(string(ToolCallCacheResultKey), v)
+}
+
 func ToolID(v string) attribute.KeyValue { return ToolIDKey.String(v) }
 func SlogToolID(v string) slog.Attr      { return slog.String(string(ToolIDKey), v) }
 
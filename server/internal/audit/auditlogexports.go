@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/audit/repo"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/outbox/events"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+const (
+	ActionAuditLogExportUpsert Action = "audit_log_export:upsert"
+	ActionAuditLogExportDelete Action = "audit_log_export:delete"
+)
+
+// AuditLogExportSnapshot is the shape persisted on upsert events. The cursor
+// is included so a rewind that re-exports history is visible in the log.
+type AuditLogExportSnapshot struct {
+	Enabled   bool   `json:"enabled"`
+	Format    string `json:"format"`
+	Prefix    string `json:"prefix"`
+	CursorSeq int64  `json:"cursor_seq"`
+}
+
+type LogAuditLogExportUpsertEvent struct {
+	OrganizationID string
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	ConfigURN urn.AuditLogExport
+
+	SnapshotBefore *AuditLogExportSnapshot
+	SnapshotAfter  *AuditLogExportSnapshot
+}
+
+func (l *Logger) LogAuditLogExportUpsert(ctx context.Context, dbtx repo.DBTX, event LogAuditLogExportUpsertEvent) error {
+	action := ActionAuditLogExportUpsert
+
+	beforeSnapshot, err := marshalAuditPayload(event.SnapshotBefore)
+	if err != nil {
+		return fmt.Errorf("marshal %s before snapshot: %w", action, err)
+	}
+
+	afterSnapshot, err := marshalAuditPayload(event.SnapshotAfter)
+	if err != nil {
+		return fmt.Errorf("marshal %s after snapshot: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.ConfigURN.ID.String(),
+		SubjectType:        string(subjectTypeAuditLogExport),
+		SubjectDisplayName: conv.ToPGTextEmpty(""),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: beforeSnapshot,
+		AfterSnapshot:  afterSnapshot,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.AuditLogExportV1})
+}
+
+type LogAuditLogExportDeleteEvent struct {
+	OrganizationID string
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	ConfigURN urn.AuditLogExport
+}
+
+func (l *Logger) LogAuditLogExportDelete(ctx context.Context, dbtx repo.DBTX, event LogAuditLogExportDeleteEvent) error {
+	action := ActionAuditLogExportDelete
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.ConfigURN.ID.String(),
+		SubjectType:        string(subjectTypeAuditLogExport),
+		SubjectDisplayName: conv.ToPGTextEmpty(""),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: nil,
+		AfterSnapshot:  nil,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.AuditLogExportV1})
+}
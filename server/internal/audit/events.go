@@ -16,6 +16,7 @@ const (
 	subjectTypeAIIntegration               subjectType = "ai_integration_config"
 	subjectTypeAssistant                   subjectType = "assistant"
 	subjectTypeAssistantMemory             subjectType = "assistant_memory"
+	subjectTypeAuditLogExport              subjectType = "audit_log_export"
 	subjectTypeAsset                       subjectType = "asset"
 	subjectTypeAwsIamCredential            subjectType = "aws_iam_credential"
 	subjectTypeAwsKmsKey                   subjectType = "aws_kms_key"
@@ -39,6 +40,7 @@ const (
 	subjectTypeModelProviderKey            subjectType = "model_provider_key"
 	subjectTypeOpenRouterAPIKey            subjectType = "openrouter_api_key"
 	subjectTypeOtelForwarding              subjectType = "otel_forwarding_config"
+	subjectTypeOtelForwardingDestination   subjectType = "otel_forwarding_destination"
 	subjectTypeOrganizationInvite          subjectType = "organization_invitation"
 	subjectTypePlatformMcpRegistration     subjectType = "platform_mcp_registration"
 	subjectTypeUnproxiedMcpServer          subjectType = "unproxied_mcp_server"
@@ -55,7 +57,11 @@ const (
 	subjectTypeSkill                       subjectType = "skill"
 	subjectTypeSkillEfficacySettings       subjectType = "skill_efficacy_settings"
 	subjectTypeSpendRule                   subjectType = "spend_rule"
+	subjectTypeTelemetryAlertRule          subjectType = "telemetry_alert_rule"
 	subjectTypeTemplate                    subjectType = "template"
+	subjectTypeToolConstraint              subjectType = "tool_constraint"
+	subjectTypeToolApprovalPolicy          subjectType = "tool_approval_policy"
+	subjectTypeToolRateLimit               subjectType = "tool_rate_limit"
 	subjectTypeToolset                     subjectType = "toolset"
 	subjectTypeTriggerInstance             subjectType = "trigger_instance"
 	subjectTypeTunneledMcpServer           subjectType = "tunneled_mcp_server"
@@ -65,6 +71,7 @@ const (
 	subjectTypeUserSessionIssuer           subjectType = "user_session_issuer"
 	subjectTypeUserSessionIssuerCimdClient subjectType = "user_session_issuer_cimd_client"
 	subjectTypeVariation                   subjectType = "variation"
+	subjectTypeWebhookEndpoint             subjectType = "webhook_endpoint"
 )
 
 type Action string
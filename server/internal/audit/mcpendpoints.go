@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	mcpendpointsgen "github.com/speakeasy-api/gram/server/gen/mcp_endpoints"
 	"github.com/speakeasy-api/gram/server/gen/types"
 	"github.com/speakeasy-api/gram/server/internal/audit/repo"
 	"github.com/speakeasy-api/gram/server/internal/conv"
@@ -17,6 +18,11 @@ const (
 	ActionMcpEndpointCreate Action = "mcp-endpoint:create"
 	ActionMcpEndpointUpdate Action = "mcp-endpoint:update"
 	ActionMcpEndpointDelete Action = "mcp-endpoint:delete"
+
+	ActionMcpEndpointCanaryStart   Action = "mcp-endpoint:canary-start"
+	ActionMcpEndpointCanaryUpdate  Action = "mcp-endpoint:canary-update"
+	ActionMcpEndpointCanaryPromote Action = "mcp-endpoint:canary-promote"
+	ActionMcpEndpointCanaryAbort   Action = "mcp-endpoint:canary-abort"
 )
 
 type LogMcpEndpointCreateEvent struct {
@@ -145,3 +151,63 @@ func (l *Logger) LogMcpEndpointDelete(ctx context.Context, dbtx repo.DBTX, event
 
 	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.McpEndpointV1})
 }
+
+// LogMcpEndpointCanaryEvent records a change to an endpoint's canary. Action
+// must be one of the ActionMcpEndpointCanary* values; the canary is logged
+// against its endpoint so the endpoint's history shows every rollout.
+type LogMcpEndpointCanaryEvent struct {
+	OrganizationID string
+	ProjectID      uuid.UUID
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	Action               Action
+	McpEndpointURN       urn.McpEndpoint
+	Slug                 string
+	CanarySnapshotBefore *mcpendpointsgen.McpEndpointCanary
+	CanarySnapshotAfter  *mcpendpointsgen.McpEndpointCanary
+}
+
+func (l *Logger) LogMcpEndpointCanary(ctx context.Context, dbtx repo.DBTX, event LogMcpEndpointCanaryEvent) error {
+	action := event.Action
+	switch action {
+	case ActionMcpEndpointCanaryStart, ActionMcpEndpointCanaryUpdate, ActionMcpEndpointCanaryPromote, ActionMcpEndpointCanaryAbort:
+	default:
+		return fmt.Errorf("unsupported mcp endpoint canary action %q", action)
+	}
+
+	beforeSnapshot, err := marshalAuditPayload(event.CanarySnapshotBefore)
+	if err != nil {
+		return fmt.Errorf("marshal %s before snapshot: %w", action, err)
+	}
+
+	afterSnapshot, err := marshalAuditPayload(event.CanarySnapshotAfter)
+	if err != nil {
+		return fmt.Errorf("marshal %s after snapshot: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: event.ProjectID, Valid: event.ProjectID != uuid.Nil},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.McpEndpointURN.ID.String(),
+		SubjectType:        string(subjectTypeMcpEndpoint),
+		SubjectDisplayName: conv.ToPGTextEmpty(event.Slug),
+		SubjectSlug:        conv.ToPGTextEmpty(event.Slug),
+
+		BeforeSnapshot: beforeSnapshot,
+		AfterSnapshot:  afterSnapshot,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.McpEndpointV1})
+}
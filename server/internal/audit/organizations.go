@@ -33,6 +33,9 @@ const (
 
 	ActionOrganizationPaygActivated   Action = "organization:payg_activated"
 	ActionOrganizationPaygDeactivated Action = "organization:payg_deactivated"
+
+	ActionOrganizationDataKeyEnabled  Action = "organization:data_key_enabled"
+	ActionOrganizationDataKeyShredded Action = "organization:data_key_shredded"
 )
 
 type LogOrganizationInviteCreateEvent struct {
@@ -635,3 +638,111 @@ func (l *Logger) LogOrganizationPaygDeactivated(ctx context.Context, dbtx repo.D
 
 	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.OrganizationBillingV1})
 }
+
+// LogOrganizationDataKeyEnabledEvent records an operator turning on
+// customer-managed encryption. The wrapped key itself is never recorded, only
+// which customer key and credential it is reached through.
+type LogOrganizationDataKeyEnabledEvent struct {
+	OrganizationID string
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	OrganizationName string
+	OrganizationSlug string
+
+	DataKeyID            uuid.UUID
+	KmsKeyName           string
+	ExternalCredentialID uuid.UUID
+}
+
+func (l *Logger) LogOrganizationDataKeyEnabled(ctx context.Context, dbtx repo.DBTX, event LogOrganizationDataKeyEnabledEvent) error {
+	action := ActionOrganizationDataKeyEnabled
+
+	metadata, err := marshalAuditPayload(map[string]any{
+		"data_key_id":            event.DataKeyID.String(),
+		"kms_key_name":           event.KmsKeyName,
+		"external_credential_id": event.ExternalCredentialID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal %s metadata: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.OrganizationID,
+		SubjectType:        "organization",
+		SubjectDisplayName: conv.ToPGTextEmpty(event.OrganizationName),
+		SubjectSlug:        conv.ToPGTextEmpty(event.OrganizationSlug),
+
+		Metadata:       metadata,
+		BeforeSnapshot: nil,
+		AfterSnapshot:  nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.OrganizationDataKeyV1})
+}
+
+// LogOrganizationDataKeyShreddedEvent records an operator erasing an
+// organization's data keys, after which every secret sealed under them is
+// unrecoverable.
+type LogOrganizationDataKeyShreddedEvent struct {
+	OrganizationID string
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	OrganizationName string
+	OrganizationSlug string
+
+	DataKeyIDs []uuid.UUID
+}
+
+func (l *Logger) LogOrganizationDataKeyShredded(ctx context.Context, dbtx repo.DBTX, event LogOrganizationDataKeyShreddedEvent) error {
+	action := ActionOrganizationDataKeyShredded
+
+	ids := make([]string, len(event.DataKeyIDs))
+	for i, id := range event.DataKeyIDs {
+		ids[i] = id.String()
+	}
+	metadata, err := marshalAuditPayload(map[string]any{
+		"data_key_ids": ids,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal %s metadata: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.OrganizationID,
+		SubjectType:        "organization",
+		SubjectDisplayName: conv.ToPGTextEmpty(event.OrganizationName),
+		SubjectSlug:        conv.ToPGTextEmpty(event.OrganizationSlug),
+
+		Metadata:       metadata,
+		BeforeSnapshot: nil,
+		AfterSnapshot:  nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.OrganizationDataKeyV1})
+}
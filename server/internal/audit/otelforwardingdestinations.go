@@ -0,0 +1,166 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/audit/repo"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/outbox/events"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+const (
+	ActionOtelForwardingDestinationCreate Action = "otel_forwarding_destination:create"
+	ActionOtelForwardingDestinationUpdate Action = "otel_forwarding_destination:update"
+	ActionOtelForwardingDestinationDelete Action = "otel_forwarding_destination:delete"
+)
+
+// OtelForwardingDestinationSnapshot is the public-facing shape persisted on
+// destination events. As with OtelForwardingSnapshot, header values are
+// omitted. Filters and ScrubRules hold the destination's stored JSON.
+type OtelForwardingDestinationSnapshot struct {
+	Name          string          `json:"name"`
+	EndpointURL   string          `json:"endpoint_url"`
+	Protocol      string          `json:"protocol"`
+	HeaderNames   []string        `json:"header_names"`
+	Enabled       bool            `json:"enabled"`
+	SamplingRatio float64         `json:"sampling_ratio"`
+	Filters       json.RawMessage `json:"filters"`
+	ScrubRules    json.RawMessage `json:"scrub_rules"`
+}
+
+type LogOtelForwardingDestinationCreateEvent struct {
+	OrganizationID string
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	DestinationURN      urn.OtelForwardingDestination
+	DestinationName     string
+	DestinationSnapshot *OtelForwardingDestinationSnapshot
+}
+
+func (l *Logger) LogOtelForwardingDestinationCreate(ctx context.Context, dbtx repo.DBTX, event LogOtelForwardingDestinationCreateEvent) error {
+	action := ActionOtelForwardingDestinationCreate
+
+	afterSnapshot, err := marshalAuditPayload(event.DestinationSnapshot)
+	if err != nil {
+		return fmt.Errorf("marshal %s after snapshot: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.DestinationURN.ID.String(),
+		SubjectType:        string(subjectTypeOtelForwardingDestination),
+		SubjectDisplayName: conv.ToPGTextEmpty(event.DestinationName),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: nil,
+		AfterSnapshot:  afterSnapshot,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.OtelForwardingV1})
+}
+
+type LogOtelForwardingDestinationUpdateEvent struct {
+	OrganizationID string
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	DestinationURN            urn.OtelForwardingDestination
+	DestinationName           string
+	DestinationSnapshotBefore *OtelForwardingDestinationSnapshot
+	DestinationSnapshotAfter  *OtelForwardingDestinationSnapshot
+}
+
+func (l *Logger) LogOtelForwardingDestinationUpdate(ctx context.Context, dbtx repo.DBTX, event LogOtelForwardingDestinationUpdateEvent) error {
+	action := ActionOtelForwardingDestinationUpdate
+
+	beforeSnapshot, err := marshalAuditPayload(event.DestinationSnapshotBefore)
+	if err != nil {
+		return fmt.Errorf("marshal %s before snapshot: %w", action, err)
+	}
+
+	afterSnapshot, err := marshalAuditPayload(event.DestinationSnapshotAfter)
+	if err != nil {
+		return fmt.Errorf("marshal %s after snapshot: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.DestinationURN.ID.String(),
+		SubjectType:        string(subjectTypeOtelForwardingDestination),
+		SubjectDisplayName: conv.ToPGTextEmpty(event.DestinationName),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: beforeSnapshot,
+		AfterSnapshot:  afterSnapshot,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.OtelForwardingV1})
+}
+
+type LogOtelForwardingDestinationDeleteEvent struct {
+	OrganizationID string
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	DestinationURN  urn.OtelForwardingDestination
+	DestinationName string
+}
+
+func (l *Logger) LogOtelForwardingDestinationDelete(ctx context.Context, dbtx repo.DBTX, event LogOtelForwardingDestinationDeleteEvent) error {
+	action := ActionOtelForwardingDestinationDelete
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.DestinationURN.ID.String(),
+		SubjectType:        string(subjectTypeOtelForwardingDestination),
+		SubjectDisplayName: conv.ToPGTextEmpty(event.DestinationName),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: nil,
+		AfterSnapshot:  nil,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.OtelForwardingV1})
+}
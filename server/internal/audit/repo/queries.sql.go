@@ -287,7 +287,7 @@ func (q *Queries) ListAuditActorFacets(ctx context.Context, arg ListAuditActorFa
 }
 
 const listAuditLogs = `-- name: ListAuditLogs :many
-SELECT a.id, a.seq, a.organization_id, a.project_id, a.actor_id, a.actor_type, a.actor_display_name, a.actor_slug, a.action, a.subject_id, a.subject_type, a.subject_display_name, a.subject_slug, a.before_snapshot, a.after_snapshot, a.metadata, a.acting_surface, a.acting_client_id, a.created_at, p.slug AS project_slug
+SELECT a.id, a.seq, a.organization_id, a.project_id, a.actor_id, a.actor_type, a.actor_display_name, a.actor_slug, a.action, a.subject_id, a.subject_type, a.subject_display_name, a.subject_slug, a.before_snapshot, a.after_snapshot, a.metadata, a.acting_surface, a.acting_client_id, a.tx_id, a.created_at, p.slug AS project_slug
 FROM audit_logs a
 LEFT JOIN projects p ON p.id = a.project_id
 WHERE a.organization_id = $1
@@ -356,6 +356,7 @@ type ListAuditLogsRow struct {
 	Metadata           []byte
 	ActingSurface      pgtype.Text
 	ActingClientID     pgtype.Text
+	TxID               pgtype.Int8
 	CreatedAt          pgtype.Timestamptz
 	ProjectSlug        pgtype.Text
 }
@@ -400,6 +401,7 @@ func (q *Queries) ListAuditLogs(ctx context.Context, arg ListAuditLogsParams) ([
 			&i.Metadata,
 			&i.ActingSurface,
 			&i.ActingClientID,
+			&i.TxID,
 			&i.CreatedAt,
 			&i.ProjectSlug,
 		); err != nil {
@@ -0,0 +1,154 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/audit/repo"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/outbox/events"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+const (
+	ActionTelemetryAlertRuleCreate Action = "telemetry-alert-rule:create"
+	ActionTelemetryAlertRuleUpdate Action = "telemetry-alert-rule:update"
+	ActionTelemetryAlertRuleDelete Action = "telemetry-alert-rule:delete"
+)
+
+type LogTelemetryAlertRuleCreateEvent struct {
+	OrganizationID string
+	ProjectID      uuid.UUID
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	TelemetryAlertRuleURN      urn.TelemetryAlertRule
+	TelemetryAlertRuleName     string
+	TelemetryAlertRuleSnapshot *types.TelemetryAlertRule
+}
+
+func (l *Logger) LogTelemetryAlertRuleCreate(ctx context.Context, dbtx repo.DBTX, event LogTelemetryAlertRuleCreateEvent) error {
+	action := ActionTelemetryAlertRuleCreate
+
+	afterSnapshot, err := marshalAuditPayload(event.TelemetryAlertRuleSnapshot)
+	if err != nil {
+		return fmt.Errorf("marshal %s after snapshot: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: event.ProjectID, Valid: event.ProjectID != uuid.Nil},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.TelemetryAlertRuleURN.ID.String(),
+		SubjectType:        string(subjectTypeTelemetryAlertRule),
+		SubjectDisplayName: conv.ToPGTextEmpty(event.TelemetryAlertRuleName),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: nil,
+		AfterSnapshot:  afterSnapshot,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.TelemetryAlertRuleV1})
+}
+
+type LogTelemetryAlertRuleUpdateEvent struct {
+	OrganizationID string
+	ProjectID      uuid.UUID
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	TelemetryAlertRuleURN            urn.TelemetryAlertRule
+	TelemetryAlertRuleName           string
+	TelemetryAlertRuleSnapshotBefore *types.TelemetryAlertRule
+	TelemetryAlertRuleSnapshotAfter  *types.TelemetryAlertRule
+}
+
+func (l *Logger) LogTelemetryAlertRuleUpdate(ctx context.Context, dbtx repo.DBTX, event LogTelemetryAlertRuleUpdateEvent) error {
+	action := ActionTelemetryAlertRuleUpdate
+
+	beforeSnapshot, err := marshalAuditPayload(event.TelemetryAlertRuleSnapshotBefore)
+	if err != nil {
+		return fmt.Errorf("marshal %s before snapshot: %w", action, err)
+	}
+
+	afterSnapshot, err := marshalAuditPayload(event.TelemetryAlertRuleSnapshotAfter)
+	if err != nil {
+		return fmt.Errorf("marshal %s after snapshot: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: event.ProjectID, Valid: event.ProjectID != uuid.Nil},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.TelemetryAlertRuleURN.ID.String(),
+		SubjectType:        string(subjectTypeTelemetryAlertRule),
+		SubjectDisplayName: conv.ToPGTextEmpty(event.TelemetryAlertRuleName),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: beforeSnapshot,
+		AfterSnapshot:  afterSnapshot,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.TelemetryAlertRuleV1})
+}
+
+type LogTelemetryAlertRuleDeleteEvent struct {
+	OrganizationID string
+	ProjectID      uuid.UUID
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	TelemetryAlertRuleURN  urn.TelemetryAlertRule
+	TelemetryAlertRuleName string
+}
+
+func (l *Logger) LogTelemetryAlertRuleDelete(ctx context.Context, dbtx repo.DBTX, event LogTelemetryAlertRuleDeleteEvent) error {
+	action := ActionTelemetryAlertRuleDelete
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: event.ProjectID, Valid: event.ProjectID != uuid.Nil},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.TelemetryAlertRuleURN.ID.String(),
+		SubjectType:        string(subjectTypeTelemetryAlertRule),
+		SubjectDisplayName: conv.ToPGTextEmpty(event.TelemetryAlertRuleName),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: nil,
+		AfterSnapshot:  nil,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.TelemetryAlertRuleV1})
+}
@@ -0,0 +1,151 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/audit/repo"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/outbox/events"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+const (
+	ActionToolApprovalPolicyCreate Action = "tool-approval-policy:create"
+	ActionToolApprovalPolicyUpdate Action = "tool-approval-policy:update"
+	ActionToolApprovalPolicyDelete Action = "tool-approval-policy:delete"
+)
+
+type LogToolApprovalPolicyCreateEvent struct {
+	OrganizationID string
+	ProjectID      uuid.UUID
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	ToolApprovalPolicyURN      urn.ToolApprovalPolicy
+	ToolApprovalPolicySnapshot *types.ToolApprovalPolicy
+}
+
+func (l *Logger) LogToolApprovalPolicyCreate(ctx context.Context, dbtx repo.DBTX, event LogToolApprovalPolicyCreateEvent) error {
+	action := ActionToolApprovalPolicyCreate
+
+	afterSnapshot, err := marshalAuditPayload(event.ToolApprovalPolicySnapshot)
+	if err != nil {
+		return fmt.Errorf("marshal %s after snapshot: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: event.ProjectID, Valid: event.ProjectID != uuid.Nil},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.ToolApprovalPolicyURN.ID.String(),
+		SubjectType:        string(subjectTypeToolApprovalPolicy),
+		SubjectDisplayName: conv.ToPGTextEmpty(""),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: nil,
+		AfterSnapshot:  afterSnapshot,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.ToolApprovalPolicyV1})
+}
+
+type LogToolApprovalPolicyUpdateEvent struct {
+	OrganizationID string
+	ProjectID      uuid.UUID
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	ToolApprovalPolicyURN            urn.ToolApprovalPolicy
+	ToolApprovalPolicySnapshotBefore *types.ToolApprovalPolicy
+	ToolApprovalPolicySnapshotAfter  *types.ToolApprovalPolicy
+}
+
+func (l *Logger) LogToolApprovalPolicyUpdate(ctx context.Context, dbtx repo.DBTX, event LogToolApprovalPolicyUpdateEvent) error {
+	action := ActionToolApprovalPolicyUpdate
+
+	beforeSnapshot, err := marshalAuditPayload(event.ToolApprovalPolicySnapshotBefore)
+	if err != nil {
+		return fmt.Errorf("marshal %s before snapshot: %w", action, err)
+	}
+
+	afterSnapshot, err := marshalAuditPayload(event.ToolApprovalPolicySnapshotAfter)
+	if err != nil {
+		return fmt.Errorf("marshal %s after snapshot: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: event.ProjectID, Valid: event.ProjectID != uuid.Nil},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.ToolApprovalPolicyURN.ID.String(),
+		SubjectType:        string(subjectTypeToolApprovalPolicy),
+		SubjectDisplayName: conv.ToPGTextEmpty(""),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: beforeSnapshot,
+		AfterSnapshot:  afterSnapshot,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.ToolApprovalPolicyV1})
+}
+
+type LogToolApprovalPolicyDeleteEvent struct {
+	OrganizationID string
+	ProjectID      uuid.UUID
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	ToolApprovalPolicyURN urn.ToolApprovalPolicy
+}
+
+func (l *Logger) LogToolApprovalPolicyDelete(ctx context.Context, dbtx repo.DBTX, event LogToolApprovalPolicyDeleteEvent) error {
+	action := ActionToolApprovalPolicyDelete
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      uuid.NullUUID{UUID: event.ProjectID, Valid: event.ProjectID != uuid.Nil},
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.ToolApprovalPolicyURN.ID.String(),
+		SubjectType:        string(subjectTypeToolApprovalPolicy),
+		SubjectDisplayName: conv.ToPGTextEmpty(""),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: nil,
+		AfterSnapshot:  nil,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.ToolApprovalPolicyV1})
+}
@@ -0,0 +1,220 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/audit/repo"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/outbox/events"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+const (
+	ActionWebhookEndpointCreate Action = "webhook-endpoint:create"
+	ActionWebhookEndpointUpdate Action = "webhook-endpoint:update"
+	ActionWebhookEndpointDelete Action = "webhook-endpoint:delete"
+
+	ActionWebhookDeliveryRedeliver Action = "webhook-endpoint:redeliver"
+)
+
+// WebhookEndpointSnapshot is the audited state of a self-hosted webhook
+// endpoint. The signing secret is never part of it.
+type WebhookEndpointSnapshot struct {
+	URL         string   `json:"url"`
+	Description *string  `json:"description,omitempty"`
+	EventTypes  []string `json:"event_types"`
+	Disabled    bool     `json:"disabled"`
+}
+
+type LogWebhookEndpointCreateEvent struct {
+	OrganizationID string
+	ProjectID      uuid.NullUUID
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	WebhookEndpointURN      urn.WebhookEndpoint
+	WebhookEndpointSnapshot *WebhookEndpointSnapshot
+}
+
+func (l *Logger) LogWebhookEndpointCreate(ctx context.Context, dbtx repo.DBTX, event LogWebhookEndpointCreateEvent) error {
+	action := ActionWebhookEndpointCreate
+
+	afterSnapshot, err := marshalAuditPayload(event.WebhookEndpointSnapshot)
+	if err != nil {
+		return fmt.Errorf("marshal %s after snapshot: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      event.ProjectID,
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.WebhookEndpointURN.ID.String(),
+		SubjectType:        string(subjectTypeWebhookEndpoint),
+		SubjectDisplayName: conv.ToPGTextEmpty(""),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: nil,
+		AfterSnapshot:  afterSnapshot,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.WebhookEndpointV1})
+}
+
+type LogWebhookEndpointUpdateEvent struct {
+	OrganizationID string
+	ProjectID      uuid.NullUUID
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	WebhookEndpointURN            urn.WebhookEndpoint
+	WebhookEndpointSnapshotBefore *WebhookEndpointSnapshot
+	WebhookEndpointSnapshotAfter  *WebhookEndpointSnapshot
+}
+
+func (l *Logger) LogWebhookEndpointUpdate(ctx context.Context, dbtx repo.DBTX, event LogWebhookEndpointUpdateEvent) error {
+	action := ActionWebhookEndpointUpdate
+
+	beforeSnapshot, err := marshalAuditPayload(event.WebhookEndpointSnapshotBefore)
+	if err != nil {
+		return fmt.Errorf("marshal %s before snapshot: %w", action, err)
+	}
+
+	afterSnapshot, err := marshalAuditPayload(event.WebhookEndpointSnapshotAfter)
+	if err != nil {
+		return fmt.Errorf("marshal %s after snapshot: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      event.ProjectID,
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.WebhookEndpointURN.ID.String(),
+		SubjectType:        string(subjectTypeWebhookEndpoint),
+		SubjectDisplayName: conv.ToPGTextEmpty(""),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: beforeSnapshot,
+		AfterSnapshot:  afterSnapshot,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.WebhookEndpointV1})
+}
+
+type LogWebhookEndpointDeleteEvent struct {
+	OrganizationID string
+	ProjectID      uuid.NullUUID
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	WebhookEndpointURN      urn.WebhookEndpoint
+	WebhookEndpointSnapshot *WebhookEndpointSnapshot
+}
+
+func (l *Logger) LogWebhookEndpointDelete(ctx context.Context, dbtx repo.DBTX, event LogWebhookEndpointDeleteEvent) error {
+	action := ActionWebhookEndpointDelete
+
+	beforeSnapshot, err := marshalAuditPayload(event.WebhookEndpointSnapshot)
+	if err != nil {
+		return fmt.Errorf("marshal %s before snapshot: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      event.ProjectID,
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.WebhookEndpointURN.ID.String(),
+		SubjectType:        string(subjectTypeWebhookEndpoint),
+		SubjectDisplayName: conv.ToPGTextEmpty(""),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: beforeSnapshot,
+		AfterSnapshot:  nil,
+		Metadata:       nil,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.WebhookEndpointV1})
+}
+
+type LogWebhookDeliveryRedeliverEvent struct {
+	OrganizationID string
+	ProjectID      uuid.NullUUID
+
+	Actor            urn.Principal
+	ActorDisplayName *string
+	ActorSlug        *string
+
+	WebhookEndpointURN urn.WebhookEndpoint
+	DeliveryID         uuid.UUID
+	EventID            string
+	EventType          string
+}
+
+// LogWebhookDeliveryRedeliver records a delivery being queued again. It is
+// recorded against the endpoint the delivery is sent to.
+func (l *Logger) LogWebhookDeliveryRedeliver(ctx context.Context, dbtx repo.DBTX, event LogWebhookDeliveryRedeliverEvent) error {
+	action := ActionWebhookDeliveryRedeliver
+
+	metadata, err := marshalAuditPayload(map[string]any{
+		"delivery_id": event.DeliveryID.String(),
+		"event_id":    event.EventID,
+		"event_type":  event.EventType,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal %s metadata: %w", action, err)
+	}
+
+	entry := repo.InsertAuditLogParams{
+		OrganizationID: event.OrganizationID,
+		ProjectID:      event.ProjectID,
+
+		ActorID:          event.Actor.ID,
+		ActorType:        string(event.Actor.Type),
+		ActorDisplayName: conv.PtrToPGTextEmpty(event.ActorDisplayName),
+		ActorSlug:        conv.PtrToPGTextEmpty(event.ActorSlug),
+
+		Action: string(action),
+
+		SubjectID:          event.WebhookEndpointURN.ID.String(),
+		SubjectType:        string(subjectTypeWebhookEndpoint),
+		SubjectDisplayName: conv.ToPGTextEmpty(""),
+		SubjectSlug:        conv.ToPGTextEmpty(""),
+
+		BeforeSnapshot: nil,
+		AfterSnapshot:  nil,
+		Metadata:       metadata,
+	}
+
+	return l.log(ctx, dbtx, auditEntry{Params: entry, OutboxEvent: events.WebhookEndpointV1})
+}
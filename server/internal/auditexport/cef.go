@@ -0,0 +1,99 @@
+package auditexport
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	cefVendor        = "Speakeasy"
+	cefProduct       = "Gram"
+	cefDeviceVersion = "1"
+	// cefSeverity is Low on CEF's 0-10 scale: audit events record
+	// authorized changes, not detections.
+	cefSeverity = 3
+)
+
+// FormatCEFLine renders r as a single CEF:0 line without a trailing newline.
+// The action is the signature ID so SIEM rules can key on it, and Gram fields
+// without a standard CEF key are carried in labelled custom strings.
+func FormatCEFLine(r Record) string {
+	var b strings.Builder
+	b.WriteString("CEF:0|")
+	b.WriteString(cefHeader(cefVendor))
+	b.WriteByte('|')
+	b.WriteString(cefHeader(cefProduct))
+	b.WriteByte('|')
+	b.WriteString(cefHeader(cefDeviceVersion))
+	b.WriteByte('|')
+	b.WriteString(cefHeader(r.Action))
+	b.WriteByte('|')
+	b.WriteString(cefHeader(r.Action + " " + r.SubjectType))
+	b.WriteByte('|')
+	b.WriteString(strconv.Itoa(cefSeverity))
+	b.WriteByte('|')
+
+	ext := []struct{ key, value string }{
+		{"rt", strconv.FormatInt(r.Time.UnixMilli(), 10)},
+		{"externalId", r.ID},
+		{"cat", "audit"},
+		{"act", r.Action},
+		{"suid", r.ActorID},
+		{"suser", r.ActorDisplayName},
+		{"cn1Label", "seq"},
+		{"cn1", strconv.FormatInt(r.Seq, 10)},
+		{"cs1Label", "organizationId"},
+		{"cs1", r.OrganizationID},
+		{"cs2Label", "projectId"},
+		{"cs2", deref(r.ProjectID)},
+		{"cs3Label", "subjectType"},
+		{"cs3", r.SubjectType},
+		{"cs4Label", "subjectId"},
+		{"cs4", r.SubjectID},
+		{"cs5Label", "actorType"},
+		{"cs5", r.ActorType},
+		{"cs6Label", "actingSurface"},
+		{"cs6", r.ActingSurface},
+		{"requestClientApplication", r.ActingClientID},
+		{"fname", r.SubjectDisplayName},
+	}
+
+	first := true
+	for i, kv := range ext {
+		// Drop empty values, and the label of a custom field whose value is
+		// empty, so consumers do not index blank fields.
+		if kv.value == "" || (strings.HasSuffix(kv.key, "Label") && i+1 < len(ext) && ext[i+1].value == "") {
+			continue
+		}
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		b.WriteString(kv.key)
+		b.WriteByte('=')
+		b.WriteString(cefExtension(kv.value))
+	}
+
+	return b.String()
+}
+
+var cefHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+
+// cefHeader escapes a header field. The spec forbids line breaks in headers,
+// so they collapse to spaces rather than breaking the line-per-event framing.
+func cefHeader(s string) string {
+	return cefHeaderEscaper.Replace(s)
+}
+
+var cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+
+func cefExtension(s string) string {
+	return cefExtensionEscaper.Replace(s)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
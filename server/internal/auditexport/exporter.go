@@ -0,0 +1,262 @@
+package auditexport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/speakeasy-api/gram/server/internal/assets"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/auditexport/repo"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+)
+
+const (
+	// ExportInterval is how often the scheduled sweep runs. Each run writes
+	// at most one object per hour partition touched since the previous run,
+	// so this also bounds how many objects an active organization produces.
+	ExportInterval = 10 * time.Minute
+
+	// exportBatchSize bounds the rows read, rendered and held in memory for
+	// one batch.
+	exportBatchSize = 5000
+
+	// exportMaxBatchesPerOrg bounds one organization's share of a run so a
+	// large backfill cannot starve the others; it continues on the next run.
+	exportMaxBatchesPerOrg = 20
+)
+
+// errCursorMoved reports that the export's cursor changed underneath a run,
+// either through the API or an overlapping run.
+var errCursorMoved = errors.New("audit log export cursor moved")
+
+// ExportResult summarizes one sweep.
+type ExportResult struct {
+	Organizations int `json:"organizations"`
+	Objects       int `json:"objects"`
+	Events        int `json:"events"`
+	Failed        int `json:"failed"`
+}
+
+// Exporter copies each enabled organization's audit log into the export
+// bucket. Delivery is at least once: an object is written before the cursor
+// moves past it, so a run interrupted between the two writes the same range
+// to the same key again.
+//
+// Events are walked in the order their transactions started, (tx_id, seq),
+// rather than by seq alone: seq is drawn at insert, so a long transaction can
+// commit a lower seq after the cursor has passed it. Only events whose
+// transaction is older than every transaction still running are read, so
+// nothing can commit behind the cursor.
+type Exporter struct {
+	logger *slog.Logger
+	tracer trace.Tracer
+	db     *pgxpool.Pool
+	store  assets.BlobStore
+}
+
+// NewExporter returns an exporter writing to store. A nil store turns every
+// run into a no-op, for deployments that have not configured an export
+// bucket.
+func NewExporter(logger *slog.Logger, tracerProvider trace.TracerProvider, db *pgxpool.Pool, store assets.BlobStore) *Exporter {
+	return &Exporter{
+		logger: logger.With(attr.SlogComponent("auditexport")),
+		tracer: tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/auditexport"),
+		db:     db,
+		store:  store,
+	}
+}
+
+// Export runs one sweep over every enabled export. A failing organization is
+// recorded on its export row and skipped; only failing to list exports fails
+// the sweep.
+func (e *Exporter) Export(ctx context.Context) (ExportResult, error) {
+	result := ExportResult{Organizations: 0, Objects: 0, Events: 0, Failed: 0}
+
+	if e.store == nil {
+		e.logger.DebugContext(ctx, "audit log export bucket not configured, skipping export")
+		return result, nil
+	}
+
+	ctx, span := e.tracer.Start(ctx, "auditexport.export")
+	defer span.End()
+
+	exports, err := repo.New(e.db).ListEnabledAuditLogExports(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return result, fmt.Errorf("list enabled audit log exports: %w", err)
+	}
+
+	for _, export := range exports {
+		if ctx.Err() != nil {
+			return result, fmt.Errorf("export audit logs: %w", ctx.Err())
+		}
+
+		result.Organizations++
+		logger := e.logger.With(
+			attr.SlogOrganizationID(export.OrganizationID),
+			attr.SlogAuditExportFormat(export.Format),
+		)
+
+		objects, events, err := e.exportOrg(ctx, logger, export)
+		result.Objects += objects
+		result.Events += events
+		switch {
+		case errors.Is(err, errCursorMoved):
+			logger.InfoContext(ctx, "audit log export cursor moved during run, resuming next run")
+		case err != nil:
+			result.Failed++
+			logger.ErrorContext(ctx, "export audit logs", attr.SlogError(err))
+			if recErr := repo.New(e.db).RecordAuditLogExportError(ctx, repo.RecordAuditLogExportErrorParams{
+				LastError: conv.ToPGText(err.Error()),
+				ID:        export.ID,
+			}); recErr != nil {
+				logger.ErrorContext(ctx, "record audit log export error", attr.SlogError(recErr))
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (e *Exporter) exportOrg(ctx context.Context, logger *slog.Logger, export repo.AuditLogExport) (objects int, events int, err error) {
+	format := Format(export.Format)
+	if !format.Valid() {
+		return 0, 0, fmt.Errorf("unsupported audit export format %q", export.Format)
+	}
+
+	queries := repo.New(e.db)
+	cursorSeq := export.CursorSeq
+	cursorTxID := export.CursorTxID
+
+	// A cursor set through the API, or by an export created before events
+	// carried their transaction, names a seq only. Resume after the
+	// transaction of the event at that seq.
+	afterTxID := cursorTxID.Int64
+	if !cursorTxID.Valid {
+		afterTxID, err = queries.GetAuditLogTxIDAtSeq(ctx, repo.GetAuditLogTxIDAtSeqParams{
+			OrganizationID: export.OrganizationID,
+			Seq:            cursorSeq,
+		})
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			afterTxID = 0
+		case err != nil:
+			return 0, 0, fmt.Errorf("resolve audit log export cursor %d: %w", cursorSeq, err)
+		}
+	}
+
+	for range exportMaxBatchesPerOrg {
+		rows, err := queries.ListCommittedAuditLogsAfter(ctx, repo.ListCommittedAuditLogsAfterParams{
+			OrganizationID: export.OrganizationID,
+			AfterTxID:      afterTxID,
+			AfterSeq:       cursorSeq,
+			BatchLimit:     exportBatchSize,
+		})
+		if err != nil {
+			return objects, events, fmt.Errorf("list audit logs after seq %d: %w", cursorSeq, err)
+		}
+
+		for _, partition := range partitionByHour(rows) {
+			key, err := e.writeObject(ctx, export, format, partition)
+			if err != nil {
+				return objects, events, err
+			}
+
+			last := partition.records[len(partition.records)-1]
+			lastTxID := partition.txIDs[len(partition.txIDs)-1]
+			advanced, err := queries.AdvanceAuditLogExportCursor(ctx, repo.AdvanceAuditLogExportCursorParams{
+				CursorSeq:          last.Seq,
+				CursorTxID:         pgtype.Int8{Int64: lastTxID, Valid: true},
+				LastObjectKey:      conv.ToPGText(key),
+				ID:                 export.ID,
+				PreviousCursorSeq:  cursorSeq,
+				PreviousCursorTxID: cursorTxID,
+			})
+			if err != nil {
+				return objects, events, fmt.Errorf("advance audit log export cursor: %w", err)
+			}
+			if advanced == 0 {
+				return objects, events, errCursorMoved
+			}
+
+			logger.DebugContext(ctx, "exported audit logs",
+				attr.SlogAuditExportObjectKey(key),
+				attr.SlogAuditExportCursorSeq(last.Seq),
+			)
+
+			cursorSeq = last.Seq
+			cursorTxID = pgtype.Int8{Int64: lastTxID, Valid: true}
+			afterTxID = lastTxID
+			objects++
+			events += len(partition.records)
+		}
+
+		if len(rows) < exportBatchSize {
+			break
+		}
+	}
+
+	return objects, events, nil
+}
+
+func (e *Exporter) writeObject(ctx context.Context, export repo.AuditLogExport, format Format, partition hourPartition) (string, error) {
+	records := partition.records
+	key := ObjectKey(export.Prefix, export.OrganizationID, partition.hour, records[0].Seq, records[len(records)-1].Seq, format)
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, format, records); err != nil {
+		return "", fmt.Errorf("encode %s: %w", key, err)
+	}
+
+	w, _, err := e.store.Write(ctx, key, format.contentType(), int64(buf.Len()))
+	if err != nil {
+		return "", fmt.Errorf("open %s: %w", key, err)
+	}
+	if _, err := buf.WriteTo(w); err != nil {
+		_ = w.Close()
+		return "", fmt.Errorf("write %s: %w", key, err)
+	}
+	// Close reports whether the object was stored; the cursor must not move
+	// past events that are not in the bucket.
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("store %s: %w", key, err)
+	}
+
+	return key, nil
+}
+
+type hourPartition struct {
+	hour    time.Time
+	records []Record
+	// txIDs holds each record's audit_logs.tx_id, in step with records.
+	txIDs []int64
+}
+
+// partitionByHour splits rows, in commit order, into runs that share a UTC
+// hour. Rows are ordered by transaction rather than time, so an hour can
+// appear in more than one run when a late commit straddles an hour boundary;
+// each run becomes its own object.
+func partitionByHour(rows []repo.AuditLog) []hourPartition {
+	var partitions []hourPartition
+	for _, row := range rows {
+		record := NewRecord(row)
+		hour := record.Time.Truncate(time.Hour)
+		if n := len(partitions); n > 0 && partitions[n-1].hour.Equal(hour) {
+			partitions[n-1].records = append(partitions[n-1].records, record)
+			partitions[n-1].txIDs = append(partitions[n-1].txIDs, row.TxID.Int64)
+			continue
+		}
+		partitions = append(partitions, hourPartition{hour: hour, records: []Record{record}, txIDs: []int64{row.TxID.Int64}})
+	}
+	return partitions
+}
@@ -0,0 +1,110 @@
+package auditexport
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Format is how an export encodes audit events into objects.
+type Format string
+
+const (
+	// FormatNDJSON writes Gram's native audit record, one JSON object per
+	// line.
+	FormatNDJSON Format = "ndjson"
+	// FormatParquet writes Gram's native audit record as Parquet rows, with
+	// snapshots and metadata kept as JSON columns.
+	FormatParquet Format = "parquet"
+	// FormatOCSF writes OCSF API Activity events, one JSON object per line,
+	// for SIEMs that ingest OCSF such as Sentinel and Security Lake.
+	FormatOCSF Format = "ocsf"
+	// FormatCEF writes one ArcSight Common Event Format line per event, for
+	// syslog-oriented pipelines such as Splunk.
+	FormatCEF Format = "cef"
+)
+
+// Formats lists every supported format.
+var Formats = []Format{FormatNDJSON, FormatParquet, FormatOCSF, FormatCEF}
+
+func (f Format) Valid() bool {
+	switch f {
+	case FormatNDJSON, FormatParquet, FormatOCSF, FormatCEF:
+		return true
+	default:
+		return false
+	}
+}
+
+func (f Format) extension() string {
+	switch f {
+	case FormatParquet:
+		return "parquet"
+	case FormatOCSF:
+		return "ocsf.ndjson"
+	case FormatCEF:
+		return "cef"
+	case FormatNDJSON:
+		return "ndjson"
+	default:
+		return "ndjson"
+	}
+}
+
+func (f Format) contentType() string {
+	switch f {
+	case FormatParquet:
+		return "application/vnd.apache.parquet"
+	case FormatCEF:
+		return "text/plain; charset=utf-8"
+	case FormatNDJSON, FormatOCSF:
+		return "application/x-ndjson"
+	default:
+		return "application/x-ndjson"
+	}
+}
+
+// MaxPrefixLength matches the audit_log_exports.prefix column constraint.
+const MaxPrefixLength = 256
+
+var prefixSegment = regexp.MustCompile(`^[A-Za-z0-9._=-]+$`)
+
+// NormalizePrefix trims surrounding slashes from a key prefix and rejects one
+// that would escape or obscure the partition layout: empty or dot segments
+// and characters outside a conservative, bucket-portable set.
+func NormalizePrefix(prefix string) (string, error) {
+	prefix = strings.Trim(strings.TrimSpace(prefix), "/")
+	if prefix == "" {
+		return "", nil
+	}
+	if len(prefix) > MaxPrefixLength {
+		return "", fmt.Errorf("prefix must be at most %d characters", MaxPrefixLength)
+	}
+	for segment := range strings.SplitSeq(prefix, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", fmt.Errorf("prefix contains an empty or relative segment")
+		}
+		if !prefixSegment.MatchString(segment) {
+			return "", fmt.Errorf("prefix segment %q may only contain letters, digits, '.', '_', '=' and '-'", segment)
+		}
+	}
+	return prefix, nil
+}
+
+// ObjectKey names the object holding events first through last of one hour
+// partition. Partitions use Hive-style key=value segments so Athena, BigQuery
+// and Spark can prune on them, and zero-padded sequence numbers keep objects
+// in export order within a partition. A retried run that exports the same
+// range lands on the same key.
+func ObjectKey(prefix string, organizationID string, hour time.Time, first int64, last int64, format Format) string {
+	hour = hour.UTC()
+	return path.Join(
+		prefix,
+		"organization_id="+organizationID,
+		"date="+hour.Format(time.DateOnly),
+		"hour="+hour.Format("15"),
+		fmt.Sprintf("%020d-%020d.%s", first, last, format.extension()),
+	)
+}
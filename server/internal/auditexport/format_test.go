@@ -0,0 +1,71 @@
+package auditexport
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectKey(t *testing.T) {
+	t.Parallel()
+
+	hour := time.Date(2026, 8, 30, 9, 0, 0, 0, time.UTC)
+
+	require.Equal(t,
+		"audit/gram/organization_id=org-1/date=2026-08-30/hour=09/00000000000000000007-00000000000000000042.ocsf.ndjson",
+		ObjectKey("audit/gram", "org-1", hour, 7, 42, FormatOCSF),
+	)
+	require.Equal(t,
+		"organization_id=org-1/date=2026-08-30/hour=09/00000000000000000001-00000000000000000001.parquet",
+		ObjectKey("", "org-1", hour, 1, 1, FormatParquet),
+	)
+}
+
+func TestObjectKeyUsesUTC(t *testing.T) {
+	t.Parallel()
+
+	hour := time.Date(2026, 8, 30, 1, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+
+	require.Contains(t, ObjectKey("", "org-1", hour, 1, 2, FormatCEF), "date=2026-08-29/hour=23/")
+}
+
+func TestNormalizePrefix(t *testing.T) {
+	t.Parallel()
+
+	valid := map[string]string{
+		"":                "",
+		"/":               "",
+		"gram":            "gram",
+		"/audit/gram/":    "audit/gram",
+		" env=prod/gram ": "env=prod/gram",
+	}
+	for in, want := range valid {
+		got, err := NormalizePrefix(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, got, in)
+	}
+
+	invalid := []string{
+		"audit//gram",
+		"audit/../gram",
+		"./audit",
+		"audit gram",
+		"audit/gr?am",
+		strings.Repeat("a", MaxPrefixLength+1),
+	}
+	for _, in := range invalid {
+		_, err := NormalizePrefix(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestFormatValid(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range Formats {
+		require.True(t, f.Valid(), f)
+	}
+	require.False(t, Format("json").Valid())
+}
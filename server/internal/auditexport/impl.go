@@ -0,0 +1,267 @@
+package auditexport
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+	goahttp "goa.design/goa/v3/http"
+	"goa.design/goa/v3/security"
+
+	gen "github.com/speakeasy-api/gram/server/gen/audit_log_exports"
+	srv "github.com/speakeasy-api/gram/server/gen/http/audit_log_exports/server"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/auditexport/repo"
+	"github.com/speakeasy-api/gram/server/internal/auth"
+	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/middleware"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+type Service struct {
+	tracer trace.Tracer
+	logger *slog.Logger
+	db     *pgxpool.Pool
+	auth   *auth.Auth
+	authz  *authz.Engine
+	audit  *audit.Logger
+}
+
+var _ gen.Service = (*Service)(nil)
+var _ gen.Auther = (*Service)(nil)
+
+func NewService(
+	logger *slog.Logger,
+	tracerProvider trace.TracerProvider,
+	db *pgxpool.Pool,
+	sessions *sessions.Manager,
+	authzEngine *authz.Engine,
+	auditLogger *audit.Logger,
+) *Service {
+	logger = logger.With(attr.SlogComponent("auditexport.api"))
+	return &Service{
+		tracer: tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/auditexport"),
+		logger: logger,
+		db:     db,
+		auth:   auth.New(logger, db, sessions, authzEngine),
+		authz:  authzEngine,
+		audit:  auditLogger,
+	}
+}
+
+func Attach(mux goahttp.Muxer, service *Service) {
+	endpoints := gen.NewEndpoints(service)
+	endpoints.Use(middleware.MapErrors())
+	endpoints.Use(middleware.TraceMethods(service.tracer))
+	srv.Mount(
+		mux,
+		srv.New(endpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil),
+	)
+}
+
+func (s *Service) APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error) {
+	return s.auth.Authorize(ctx, key, schema)
+}
+
+func (s *Service) GetConfig(ctx context.Context, _ *gen.GetConfigPayload) (*gen.AuditLogExportConfig, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeOrgRead, ResourceKind: "", ResourceID: authCtx.ActiveOrganizationID, Dimensions: nil}); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogOrganizationID(authCtx.ActiveOrganizationID))
+
+	row, err := repo.New(s.db).GetAuditLogExport(ctx, authCtx.ActiveOrganizationID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return emptyView(authCtx.ActiveOrganizationID), nil
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "get audit log export config").LogError(ctx, logger)
+	}
+
+	return toView(row), nil
+}
+
+func (s *Service) UpsertConfig(ctx context.Context, payload *gen.UpsertConfigPayload) (*gen.AuditLogExportConfig, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeOrgAdmin, ResourceKind: "", ResourceID: authCtx.ActiveOrganizationID, Dimensions: nil}); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogOrganizationID(authCtx.ActiveOrganizationID), attr.SlogUserID(authCtx.UserID))
+
+	format := Format(payload.Format)
+	if !format.Valid() {
+		return nil, oops.E(oops.CodeInvalid, nil, "unsupported format: %q", payload.Format)
+	}
+
+	prefix, err := NormalizePrefix(conv.PtrValOr(payload.Prefix, ""))
+	if err != nil {
+		return nil, oops.E(oops.CodeInvalid, err, "invalid prefix: %s", err.Error())
+	}
+
+	resumeAfterSeq := pgtype.Int8{Int64: 0, Valid: false}
+	if payload.ResumeAfterSeq != nil {
+		resumeAfterSeq = pgtype.Int8{Int64: *payload.ResumeAfterSeq, Valid: true}
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	tx := repo.New(dbtx)
+
+	var before *audit.AuditLogExportSnapshot
+	locked, err := tx.GetAuditLogExportForUpdate(ctx, authCtx.ActiveOrganizationID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "lock audit log export config").LogError(ctx, logger)
+	default:
+		snap := toSnapshot(locked)
+		before = &snap
+	}
+
+	row, err := tx.UpsertAuditLogExport(ctx, repo.UpsertAuditLogExportParams{
+		OrganizationID: authCtx.ActiveOrganizationID,
+		Enabled:        payload.Enabled,
+		Format:         string(format),
+		Prefix:         prefix,
+		ResumeAfterSeq: resumeAfterSeq,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "upsert audit log export config").LogError(ctx, logger)
+	}
+
+	after := toSnapshot(row)
+	if err := s.audit.LogAuditLogExportUpsert(ctx, dbtx, audit.LogAuditLogExportUpsertEvent{
+		OrganizationID:   authCtx.ActiveOrganizationID,
+		Actor:            urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName: authCtx.Email,
+		ActorSlug:        nil,
+		ConfigURN:        urn.NewAuditLogExport(row.ID),
+		SnapshotBefore:   before,
+		SnapshotAfter:    &after,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log audit log export upsert").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit audit log export upsert").LogError(ctx, logger)
+	}
+
+	return toView(row), nil
+}
+
+func (s *Service) DeleteConfig(ctx context.Context, _ *gen.DeleteConfigPayload) error {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil {
+		return oops.C(oops.CodeUnauthorized)
+	}
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeOrgAdmin, ResourceKind: "", ResourceID: authCtx.ActiveOrganizationID, Dimensions: nil}); err != nil {
+		return err
+	}
+
+	logger := s.logger.With(attr.SlogOrganizationID(authCtx.ActiveOrganizationID), attr.SlogUserID(authCtx.UserID))
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "failed to begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	tx := repo.New(dbtx)
+
+	row, err := tx.GetAuditLogExportForUpdate(ctx, authCtx.ActiveOrganizationID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// No active config — nothing to delete, no audit row to emit.
+		return nil
+	case err != nil:
+		return oops.E(oops.CodeUnexpected, err, "lock audit log export config").LogError(ctx, logger)
+	}
+
+	if err := tx.SoftDeleteAuditLogExport(ctx, authCtx.ActiveOrganizationID); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "delete audit log export config").LogError(ctx, logger)
+	}
+
+	if err := s.audit.LogAuditLogExportDelete(ctx, dbtx, audit.LogAuditLogExportDeleteEvent{
+		OrganizationID:   authCtx.ActiveOrganizationID,
+		Actor:            urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName: authCtx.Email,
+		ActorSlug:        nil,
+		ConfigURN:        urn.NewAuditLogExport(row.ID),
+	}); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "log audit log export delete").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "commit audit log export delete").LogError(ctx, logger)
+	}
+
+	return nil
+}
+
+func toSnapshot(row repo.AuditLogExport) audit.AuditLogExportSnapshot {
+	return audit.AuditLogExportSnapshot{
+		Enabled:   row.Enabled,
+		Format:    row.Format,
+		Prefix:    row.Prefix,
+		CursorSeq: row.CursorSeq,
+	}
+}
+
+func emptyView(orgID string) *gen.AuditLogExportConfig {
+	return &gen.AuditLogExportConfig{
+		ID:             nil,
+		OrganizationID: orgID,
+		Enabled:        false,
+		Format:         string(FormatNDJSON),
+		Prefix:         "",
+		CursorSeq:      0,
+		LastExportedAt: nil,
+		LastObjectKey:  nil,
+		LastError:      nil,
+		CreatedAt:      nil,
+		UpdatedAt:      nil,
+	}
+}
+
+func toView(row repo.AuditLogExport) *gen.AuditLogExportConfig {
+	var lastExportedAt *string
+	if row.LastExportedAt.Valid {
+		lastExportedAt = new(conv.FromPGTimestamptz(row.LastExportedAt))
+	}
+
+	return &gen.AuditLogExportConfig{
+		ID:             new(row.ID.String()),
+		OrganizationID: row.OrganizationID,
+		Enabled:        row.Enabled,
+		Format:         row.Format,
+		Prefix:         row.Prefix,
+		CursorSeq:      row.CursorSeq,
+		LastExportedAt: lastExportedAt,
+		LastObjectKey:  conv.FromPGText[string](row.LastObjectKey),
+		LastError:      conv.FromPGText[string](row.LastError),
+		CreatedAt:      new(conv.FromPGTimestamptz(row.CreatedAt)),
+		UpdatedAt:      new(conv.FromPGTimestamptz(row.UpdatedAt)),
+	}
+}
@@ -0,0 +1,103 @@
+-- name: GetAuditLogExport :one
+SELECT *
+FROM audit_log_exports
+WHERE organization_id = @organization_id
+  AND deleted IS FALSE;
+
+-- name: GetAuditLogExportForUpdate :one
+SELECT *
+FROM audit_log_exports
+WHERE organization_id = @organization_id
+  AND deleted IS FALSE
+FOR UPDATE;
+
+-- name: UpsertAuditLogExport :one
+-- A new export starts after the organization's latest audit event unless a
+-- cursor is given; resume_after_seq = 0 exports the full history. On update
+-- the stored cursor is kept unless a new one is given. A new cursor_seq
+-- clears cursor_tx_id, which the next run resolves from it.
+INSERT INTO audit_log_exports (
+    organization_id
+  , enabled
+  , format
+  , prefix
+  , cursor_seq
+) VALUES (
+    @organization_id
+  , @enabled
+  , @format
+  , @prefix
+  , COALESCE(
+      sqlc.narg(resume_after_seq)::bigint,
+      (SELECT MAX(seq) FROM audit_logs WHERE organization_id = @organization_id),
+      0
+    )
+)
+ON CONFLICT (organization_id) WHERE deleted IS FALSE
+DO UPDATE SET
+    enabled = EXCLUDED.enabled
+  , format = EXCLUDED.format
+  , prefix = EXCLUDED.prefix
+  , cursor_seq = COALESCE(sqlc.narg(resume_after_seq)::bigint, audit_log_exports.cursor_seq)
+  , cursor_tx_id = CASE
+      WHEN sqlc.narg(resume_after_seq)::bigint IS NULL THEN audit_log_exports.cursor_tx_id
+    END
+  , updated_at = clock_timestamp()
+RETURNING *;
+
+-- name: SoftDeleteAuditLogExport :exec
+UPDATE audit_log_exports
+SET deleted_at = clock_timestamp()
+WHERE organization_id = @organization_id
+  AND deleted IS FALSE;
+
+-- name: ListEnabledAuditLogExports :many
+SELECT *
+FROM audit_log_exports
+WHERE enabled IS TRUE
+  AND deleted IS FALSE
+ORDER BY organization_id;
+
+-- name: GetAuditLogTxIDAtSeq :one
+-- Resolves a seq cursor to the (tx_id, seq) position the export walks: the
+-- transaction of the organization's last event at or before the seq.
+SELECT COALESCE(tx_id, 0)::bigint AS tx_id
+FROM audit_logs
+WHERE organization_id = @organization_id
+  AND seq <= @seq
+ORDER BY seq DESC
+LIMIT 1;
+
+-- name: ListCommittedAuditLogsAfter :many
+-- Walks audit_logs in (tx_id, seq) order, reading only rows written by
+-- transactions older than the snapshot's xmin. Every such transaction has
+-- finished and every later one has a greater tx_id, so no row can commit
+-- behind the cursor once it moves. Served by
+-- audit_logs_organization_id_tx_id_seq_idx.
+SELECT *
+FROM audit_logs
+WHERE organization_id = @organization_id
+  AND tx_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+  AND (tx_id, seq) > (@after_tx_id::bigint, @after_seq::bigint)
+ORDER BY tx_id ASC, seq ASC
+LIMIT @batch_limit;
+
+-- name: AdvanceAuditLogExportCursor :execrows
+-- Compare-and-set on the cursor so a run that overlaps another, or a cursor
+-- rewound through the API mid-run, stops instead of skipping events.
+UPDATE audit_log_exports
+SET cursor_seq = @cursor_seq
+  , cursor_tx_id = @cursor_tx_id
+  , last_exported_at = clock_timestamp()
+  , last_object_key = @last_object_key
+  , last_error = NULL
+WHERE id = @id
+  AND cursor_seq = @previous_cursor_seq
+  AND cursor_tx_id IS NOT DISTINCT FROM sqlc.narg(previous_cursor_tx_id)::bigint
+  AND deleted IS FALSE;
+
+-- name: RecordAuditLogExportError :exec
+UPDATE audit_log_exports
+SET last_error = @last_error
+WHERE id = @id
+  AND deleted IS FALSE;
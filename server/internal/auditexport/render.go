@@ -0,0 +1,113 @@
+package auditexport
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/auditexport/repo"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+)
+
+// Record is Gram's native audit record as it appears in ndjson exports. It
+// carries the same fields as the audit_log webhook payload plus the sequence
+// number and timestamp a consumer needs to order and deduplicate events
+// across objects.
+type Record struct {
+	ID                 string          `json:"id"`
+	Seq                int64           `json:"seq"`
+	Time               time.Time       `json:"time"`
+	OrganizationID     string          `json:"organization_id"`
+	ProjectID          *string         `json:"project_id"`
+	ActorID            string          `json:"actor_id"`
+	ActorType          string          `json:"actor_type"`
+	ActorDisplayName   string          `json:"actor_display_name,omitempty"`
+	ActorSlug          string          `json:"actor_slug,omitempty"`
+	Action             string          `json:"action"`
+	SubjectID          string          `json:"subject_id"`
+	SubjectType        string          `json:"subject_type"`
+	SubjectDisplayName string          `json:"subject_display_name,omitempty"`
+	SubjectSlug        string          `json:"subject_slug,omitempty"`
+	BeforeSnapshot     json.RawMessage `json:"before_snapshot,omitempty"`
+	AfterSnapshot      json.RawMessage `json:"after_snapshot,omitempty"`
+	Metadata           json.RawMessage `json:"metadata,omitempty"`
+	ActingSurface      string          `json:"acting_surface,omitempty"`
+	ActingClientID     string          `json:"acting_client_id,omitempty"`
+}
+
+// NewRecord converts a stored audit log row into its export record.
+func NewRecord(row repo.AuditLog) Record {
+	var projectID *string
+	if row.ProjectID.Valid {
+		projectID = new(row.ProjectID.UUID.String())
+	}
+
+	return Record{
+		ID:                 row.ID.String(),
+		Seq:                row.Seq,
+		Time:               row.CreatedAt.Time.UTC(),
+		OrganizationID:     row.OrganizationID,
+		ProjectID:          projectID,
+		ActorID:            row.ActorID,
+		ActorType:          row.ActorType,
+		ActorDisplayName:   conv.FromPGTextOrEmpty[string](row.ActorDisplayName),
+		ActorSlug:          conv.FromPGTextOrEmpty[string](row.ActorSlug),
+		Action:             row.Action,
+		SubjectID:          row.SubjectID,
+		SubjectType:        row.SubjectType,
+		SubjectDisplayName: conv.FromPGTextOrEmpty[string](row.SubjectDisplayName),
+		SubjectSlug:        conv.FromPGTextOrEmpty[string](row.SubjectSlug),
+		BeforeSnapshot:     rawJSON(row.BeforeSnapshot),
+		AfterSnapshot:      rawJSON(row.AfterSnapshot),
+		Metadata:           rawJSON(row.Metadata),
+		ActingSurface:      conv.FromPGTextOrEmpty[string](row.ActingSurface),
+		ActingClientID:     conv.FromPGTextOrEmpty[string](row.ActingClientID),
+	}
+}
+
+// Encode renders records, in order, into w using format.
+func Encode(w io.Writer, format Format, records []Record) error {
+	switch format {
+	case FormatNDJSON:
+		return encodeLines(w, records, func(r Record) ([]byte, error) { return json.Marshal(r) })
+	case FormatOCSF:
+		return encodeLines(w, records, func(r Record) ([]byte, error) { return json.Marshal(NewOCSFEvent(r)) })
+	case FormatCEF:
+		return encodeLines(w, records, func(r Record) ([]byte, error) { return []byte(FormatCEFLine(r)), nil })
+	case FormatParquet:
+		return encodeParquet(w, records)
+	default:
+		return fmt.Errorf("unsupported audit export format %q", format)
+	}
+}
+
+func encodeLines(w io.Writer, records []Record, render func(Record) ([]byte, error)) error {
+	bw := bufio.NewWriter(w)
+	for _, r := range records {
+		line, err := render(r)
+		if err != nil {
+			return fmt.Errorf("render audit event %s: %w", r.ID, err)
+		}
+		if _, err := bw.Write(line); err != nil {
+			return fmt.Errorf("write audit event %s: %w", r.ID, err)
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			return fmt.Errorf("write audit event %s: %w", r.ID, err)
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("flush audit events: %w", err)
+	}
+	return nil
+}
+
+// rawJSON passes stored JSONB through untouched; a SQL NULL or JSON null is
+// omitted rather than written as null.
+func rawJSON(b []byte) json.RawMessage {
+	if len(b) == 0 || string(b) == "null" {
+		return nil
+	}
+	return json.RawMessage(b)
+}
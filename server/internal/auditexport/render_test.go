@@ -0,0 +1,183 @@
+package auditexport
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/auditexport/repo"
+)
+
+func newTestAuditLog(seq int64, createdAt time.Time) repo.AuditLog {
+	return repo.AuditLog{
+		ID:                 uuid.MustParse("0195f7a4-2b1c-7d3e-8f40-5a6b7c8d9e0f"),
+		Seq:                seq,
+		OrganizationID:     "org-1",
+		ProjectID:          uuid.NullUUID{UUID: uuid.MustParse("0195f7a4-0000-7000-8000-000000000001"), Valid: true},
+		ActorID:            "user-1",
+		ActorType:          "user",
+		ActorDisplayName:   pgtype.Text{String: "ada@example.com", Valid: true},
+		ActorSlug:          pgtype.Text{String: "", Valid: false},
+		Action:             "toolset:update",
+		SubjectID:          "toolset-1",
+		SubjectType:        "toolset",
+		SubjectDisplayName: pgtype.Text{String: "Billing|Tools", Valid: true},
+		SubjectSlug:        pgtype.Text{String: "billing", Valid: true},
+		BeforeSnapshot:     []byte(`{"name":"Billing"}`),
+		AfterSnapshot:      []byte(`{"name":"Billing|Tools"}`),
+		Metadata:           []byte(`null`),
+		ActingSurface:      pgtype.Text{String: "dashboard", Valid: true},
+		ActingClientID:     pgtype.Text{String: "", Valid: false},
+		TxID:               pgtype.Int8{Int64: seq, Valid: true},
+		CreatedAt:          pgtype.Timestamptz{Time: createdAt, Valid: true, InfinityModifier: pgtype.Finite},
+	}
+}
+
+func TestEncodeNDJSON(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2026, 8, 30, 9, 15, 0, 0, time.UTC)
+	records := []Record{NewRecord(newTestAuditLog(7, ts)), NewRecord(newTestAuditLog(8, ts))}
+
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, FormatNDJSON, records))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &got))
+	require.InDelta(t, 7, got["seq"], 0)
+	require.Equal(t, "toolset:update", got["action"])
+	require.Equal(t, "0195f7a4-0000-7000-8000-000000000001", got["project_id"])
+	require.Equal(t, map[string]any{"name": "Billing"}, got["before_snapshot"])
+	require.NotContains(t, got, "metadata", "JSON null metadata should be omitted")
+	require.NotContains(t, got, "actor_slug")
+}
+
+func TestEncodeOCSF(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2026, 8, 30, 9, 15, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, FormatOCSF, []Record{NewRecord(newTestAuditLog(7, ts))}))
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &got))
+	require.InDelta(t, 6003, got["class_uid"], 0)
+	require.InDelta(t, 3, got["activity_id"], 0)
+	require.InDelta(t, 600303, got["type_uid"], 0)
+	require.InDelta(t, ts.UnixMilli(), got["time"], 0)
+
+	metadata, ok := got["metadata"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "org-1", metadata["tenant_uid"])
+	require.InDelta(t, 7, metadata["sequence"], 0)
+
+	actor, ok := got["actor"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, map[string]any{"uid": "user-1", "name": "ada@example.com", "type": "user"}, actor["user"])
+}
+
+func TestOCSFActivity(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		id   int
+		name string
+	}{
+		"toolset:create":           {ocsfActivityCreate, "Create"},
+		"api_key:revoke":           {ocsfActivityDelete, "Delete"},
+		"audit_log_export:upsert":  {ocsfActivityUpdate, "Update"},
+		"environment:update_entry": {ocsfActivityUpdate, "Update"},
+		"deployment:redeploy":      {ocsfActivityOther, "redeploy"},
+	}
+	for action, want := range cases {
+		id, name := ocsfActivity(action)
+		require.Equal(t, want.id, id, action)
+		require.Equal(t, want.name, name, action)
+	}
+}
+
+func TestFormatCEFLine(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2026, 8, 30, 9, 15, 0, 0, time.UTC)
+	record := NewRecord(newTestAuditLog(7, ts))
+	record.ActorDisplayName = "ada=admin\nroot"
+
+	line := FormatCEFLine(record)
+
+	require.True(t, strings.HasPrefix(line, "CEF:0|Speakeasy|Gram|1|toolset:update|toolset:update toolset|3|"), line)
+	require.NotContains(t, line, "\n")
+	require.Contains(t, line, `suser=ada\=admin\nroot`)
+	require.Contains(t, line, `fname=Billing|Tools`)
+	require.Contains(t, line, "cn1Label=seq cn1=7")
+	require.Contains(t, line, "cs6Label=actingSurface cs6=dashboard")
+	require.NotContains(t, line, "requestClientApplication", "empty fields should be dropped")
+}
+
+func TestFormatCEFLineDropsLabelOfEmptyCustomField(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2026, 8, 30, 9, 15, 0, 0, time.UTC)
+	row := newTestAuditLog(7, ts)
+	row.ProjectID = uuid.NullUUID{UUID: uuid.Nil, Valid: false}
+
+	line := FormatCEFLine(NewRecord(row))
+
+	require.NotContains(t, line, "cs2Label")
+	require.Contains(t, line, "cs3Label=subjectType cs3=toolset")
+}
+
+func TestEncodeParquetRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2026, 8, 30, 9, 15, 0, 0, time.UTC)
+	records := []Record{NewRecord(newTestAuditLog(7, ts)), NewRecord(newTestAuditLog(8, ts.Add(time.Second)))}
+
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, FormatParquet, records))
+
+	rows, err := parquet.Read[parquetRecord](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, int64(8), rows[1].Seq)
+	require.Equal(t, ts.Add(time.Second).UnixMilli(), rows[1].Time)
+	require.Equal(t, "toolset:update", rows[0].Action)
+	require.NotNil(t, rows[0].AfterSnapshot)
+	require.JSONEq(t, `{"name":"Billing|Tools"}`, *rows[0].AfterSnapshot)
+	require.Nil(t, rows[0].Metadata)
+	require.Nil(t, rows[0].ActorSlug)
+}
+
+func TestPartitionByHour(t *testing.T) {
+	t.Parallel()
+
+	nine := time.Date(2026, 8, 30, 9, 0, 0, 0, time.UTC)
+	rows := []repo.AuditLog{
+		newTestAuditLog(1, nine.Add(10*time.Minute)),
+		newTestAuditLog(2, nine.Add(59*time.Minute)),
+		newTestAuditLog(3, nine.Add(61*time.Minute)),
+		// A late commit from the previous hour starts a new partition rather
+		// than reordering the run.
+		newTestAuditLog(4, nine.Add(58*time.Minute)),
+	}
+
+	partitions := partitionByHour(rows)
+	require.Len(t, partitions, 3)
+	require.Equal(t, nine, partitions[0].hour)
+	require.Len(t, partitions[0].records, 2)
+	require.Equal(t, nine.Add(time.Hour), partitions[1].hour)
+	require.Equal(t, nine, partitions[2].hour)
+	require.Equal(t, int64(4), partitions[2].records[0].Seq)
+	require.Equal(t, []int64{4}, partitions[2].txIDs)
+}
@@ -10,14 +10,17 @@ import (
 	"net/url"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/oauth2"
 
 	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/auth/oidcidp"
 	"github.com/speakeasy-api/gram/server/internal/auth/orgslug"
 	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
 	"github.com/speakeasy-api/gram/server/internal/cache"
@@ -55,6 +58,11 @@ type AuthorizationURLParams struct {
 	// ScreenHint selects which AuthKit screen to land on. Only "sign-up" is
 	// used, and only while provider is "authkit". Omitted when empty.
 	ScreenHint string
+
+	// Nonce is the login nonce. A generic OIDC issuer echoes it in the ID
+	// token, and it keys the PKCE verifier until the callback. Required with
+	// a generic OIDC provider; unused by WorkOS.
+	Nonce string
 }
 
 // AuthenticateResult holds the fields Gram uses from the IDP code exchange.
@@ -108,6 +116,7 @@ type IDPUserInfo struct {
 	WorkOSSessionID   string  `json:"-"`
 	OrganizationID    string  `json:"-"` // WorkOS org ID selected during auth
 	impersonatorEmail string
+	oidcIdentity      *oidcidp.Identity
 }
 
 // ImpersonatorEmail returns the WorkOS Dashboard operator who initiated an
@@ -119,8 +128,26 @@ func (u *IDPUserInfo) ImpersonatorEmail() string {
 	return u.impersonatorEmail
 }
 
+// OIDCIdentity returns the identity a generic OIDC issuer vouched for. It is
+// nil when the user signed in through WorkOS.
+func (u *IDPUserInfo) OIDCIdentity() *oidcidp.Identity {
+	if u == nil {
+		return nil
+	}
+	return u.oidcIdentity
+}
+
+// ErrOIDCUnsupported is returned by WorkOS-only sign-in flows when dashboard
+// login goes through a generic OIDC issuer instead.
+var ErrOIDCUnsupported = errors.New("this sign-in flow is not available with a generic OIDC identity provider")
+
+// pkceVerifierTTL matches the login nonce it is stored against.
+const pkceVerifierTTL = 10 * time.Minute
+
 // Resolver handles identity concerns: IDP code exchange, user upsert, org
 // membership sync, user-info caching, and authorization URL construction.
+// With a generic OIDC provider configured it signs users in through that
+// issuer instead of WorkOS.
 type Resolver struct {
 	logger        *slog.Logger
 	tracer        trace.Tracer
@@ -129,6 +156,8 @@ type Resolver struct {
 	idpClientID   string
 	idpClient     IDPClient
 	workosClient  WorkOSClient
+	oidc          *oidcidp.Provider
+	pkceStore     cache.Cache
 	orgRepo       *orgRepo.Queries
 	userRepo      *userRepo.Queries
 	pylon         *pylon.Pylon
@@ -143,6 +172,7 @@ func NewResolver(
 	idpClientID string,
 	idpClient IDPClient,
 	workosClient WorkOSClient,
+	oidcProvider *oidcidp.Provider,
 	orgRepo *orgRepo.Queries,
 	userRepo *userRepo.Queries,
 	pylon *pylon.Pylon,
@@ -158,6 +188,8 @@ func NewResolver(
 		idpClientID:   idpClientID,
 		idpClient:     idpClient,
 		workosClient:  workosClient,
+		oidc:          oidcProvider,
+		pkceStore:     redisClient,
 		orgRepo:       orgRepo,
 		userRepo:      userRepo,
 		pylon:         pylon,
@@ -165,6 +197,62 @@ func NewResolver(
 	}
 }
 
+// OIDCProvider returns the generic OIDC provider dashboard users sign in
+// with, or nil when they sign in through WorkOS.
+func (r *Resolver) OIDCProvider() *oidcidp.Provider {
+	return r.oidc
+}
+
+// ExchangeAuthorizationCode exchanges the code a dashboard login came back
+// with for the user's identity. The callback URL and nonce must be the ones
+// the authorization URL was built with.
+func (r *Resolver) ExchangeAuthorizationCode(ctx context.Context, code, callbackURL, nonce string) (_ *IDPUserInfo, err error) {
+	if r.oidc == nil {
+		return r.ExchangeCodeForTokens(ctx, code)
+	}
+
+	ctx, span := r.tracer.Start(ctx, "identity.exchangeOIDCCode")
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	if nonce == "" {
+		return nil, errors.New("oidc login is missing its nonce")
+	}
+	var verifier string
+	if err := r.pkceStore.GetAndDelete(ctx, pkceVerifierKey(nonce), &verifier); err != nil {
+		return nil, fmt.Errorf("load oidc pkce verifier: %w", err)
+	}
+
+	id, err := r.oidc.Exchange(ctx, code, callbackURL, nonce, verifier)
+	if err != nil {
+		return nil, fmt.Errorf("oidc sign-in: %w", err)
+	}
+
+	var picture *string
+	if id.Picture != "" {
+		picture = &id.Picture
+	}
+	return &IDPUserInfo{
+		Sub:               id.UserKey(),
+		Email:             id.Email,
+		Name:              id.Name,
+		Picture:           picture,
+		ExternalID:        "",
+		WorkOSSessionID:   "",
+		OrganizationID:    "",
+		impersonatorEmail: "",
+		oidcIdentity:      id,
+	}, nil
+}
+
+func pkceVerifierKey(nonce string) string {
+	return "oidc_pkce:" + nonce
+}
+
 // ExchangeCodeForTokens exchanges an authorization code for user identity
 // via the WorkOS user-management SDK.
 func (r *Resolver) ExchangeCodeForTokens(ctx context.Context, code string) (_ *IDPUserInfo, err error) {
@@ -176,6 +264,10 @@ func (r *Resolver) ExchangeCodeForTokens(ctx context.Context, code string) (_ *I
 		span.End()
 	}()
 
+	if r.oidc != nil {
+		return nil, ErrOIDCUnsupported
+	}
+
 	resp, err := r.idpClient.AuthenticateWithCode(ctx, r.idpClientID, code)
 	if err != nil {
 		return nil, fmt.Errorf("workos authenticate with code: %w", err)
@@ -196,6 +288,10 @@ func (r *Resolver) AuthenticateWithMagicAuth(ctx context.Context, email string)
 		span.End()
 	}()
 
+	if r.oidc != nil {
+		return nil, ErrOIDCUnsupported
+	}
+
 	challenge, err := r.idpClient.CreateMagicAuth(ctx, email)
 	if err != nil {
 		return nil, fmt.Errorf("workos create magic auth: %w", err)
@@ -268,6 +364,16 @@ func (r *Resolver) UpsertUserFromIDP(ctx context.Context, idpUser *IDPUserInfo)
 		span.End()
 	}()
 
+	if idpUser.oidcIdentity != nil && r.oidc.Rules().UserProvisioning == oidcidp.ProvisioningExisting {
+		_, err := r.userRepo.GetUserByEmail(ctx, idpUser.Email)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return "", oidcidp.ErrUserNotProvisioned
+		case err != nil:
+			return "", fmt.Errorf("look up user by email: %w", err)
+		}
+	}
+
 	gramUserID, admin := r.resolveGramUserID(ctx, idpUser)
 	span.SetAttributes(
 		attr.AuthUserID(gramUserID),
@@ -611,6 +717,10 @@ func (r *Resolver) HasWorkOSUser(ctx context.Context, email string) (bool, error
 // BuildAuthorizationURL constructs the OIDC authorization URL that the
 // browser should be redirected to.
 func (r *Resolver) BuildAuthorizationURL(ctx context.Context, params AuthorizationURLParams) (*url.URL, error) {
+	if r.oidc != nil {
+		return r.buildOIDCAuthorizationURL(ctx, params)
+	}
+
 	q := url.Values{}
 	q.Set("response_type", "code")
 	q.Set("client_id", r.idpClientID)
@@ -642,6 +752,26 @@ func (r *Resolver) BuildAuthorizationURL(ctx context.Context, params Authorizati
 	return authURL, nil
 }
 
+// buildOIDCAuthorizationURL points the browser at the generic OIDC issuer.
+// The PKCE verifier is held server-side under the login nonce, since the
+// state parameter round-trips through the browser.
+func (r *Resolver) buildOIDCAuthorizationURL(ctx context.Context, params AuthorizationURLParams) (*url.URL, error) {
+	if params.Nonce == "" {
+		return nil, ErrOIDCUnsupported
+	}
+
+	verifier := oauth2.GenerateVerifier()
+	if err := r.pkceStore.Set(ctx, pkceVerifierKey(params.Nonce), verifier, pkceVerifierTTL); err != nil {
+		return nil, fmt.Errorf("store oidc pkce verifier: %w", err)
+	}
+
+	authURL, err := url.Parse(r.oidc.AuthCodeURL(params.CallbackURL, params.State, params.Nonce, verifier, params.LoginHint))
+	if err != nil {
+		return nil, fmt.Errorf("parse OIDC authorization URL: %w", err)
+	}
+	return authURL, nil
+}
+
 type ProvisionedOrganization struct {
 	WorkOSOrganizationID string
 	GramOrganizationID   string
@@ -656,12 +786,22 @@ type ProvisionedOrganization struct {
 // When no WorkOS client is configured (tests, OSS), returns a random UUID as gramOrgID.
 func (r *Resolver) ProvisionOrgInWorkOS(ctx context.Context, orgName, gramUserID string) (ProvisionedOrganization, error) {
 	if r.workosClient == nil {
-		return ProvisionedOrganization{
+		provisioned := ProvisionedOrganization{
 			WorkOSOrganizationID: "",
 			GramOrganizationID:   uuid.New().String(),
 			WorkOSUserID:         "",
 			WorkOSMembershipID:   "",
-		}, nil
+		}
+		// Generic OIDC users hold their user key where the WorkOS user ID
+		// would be, and the first admin's role assignment is keyed on it.
+		if r.oidc != nil {
+			user, err := r.userRepo.GetUser(ctx, gramUserID)
+			if err != nil {
+				return ProvisionedOrganization{}, fmt.Errorf("look up user for organization provisioning: %w", err)
+			}
+			provisioned.WorkOSUserID = user.WorkosID.String
+		}
+		return provisioned, nil
 	}
 
 	// Look up user's WorkOS ID from the database.
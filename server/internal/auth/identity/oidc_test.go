@@ -0,0 +1,161 @@
+package identity_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	mockoidc "github.com/speakeasy-api/gram/mock-oidc"
+	"github.com/speakeasy-api/gram/server/internal/auth/identity"
+	"github.com/speakeasy-api/gram/server/internal/auth/oidcidp"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+const oidcCallbackURL = "https://app.example.com/rpc/auth.callback"
+
+var oidcUser = mockoidc.User{
+	Email:         "ada@acme.com",
+	Name:          "Ada Admin",
+	EmailVerified: true,
+	Groups:        []string{"gram-admins"},
+}
+
+// newOIDCResolver builds a Resolver that signs users in through a mock OIDC
+// issuer. Building the authorization URL and exchanging the code touch only
+// the issuer and the PKCE store, so there is no database.
+func newOIDCResolver(t *testing.T) (*identity.Resolver, *testenv.MockOIDCIssuer) {
+	t.Helper()
+
+	issuer := testenv.NewMockOIDCIssuer(t, oidcCallbackURL, oidcUser)
+	rules, err := oidcidp.ParseRules([]byte(`
+organizations:
+  - slug: acme
+    roles:
+      - group: gram-admins
+        role: admin
+`))
+	require.NoError(t, err)
+	provider, err := oidcidp.NewProvider(t.Context(), oidcidp.Options{
+		Issuer:       issuer.URL,
+		ClientID:     issuer.ClientID,
+		ClientSecret: issuer.ClientSecret,
+		HTTPClient:   http.DefaultClient,
+		Rules:        rules,
+	})
+	require.NoError(t, err)
+
+	resolver := identity.NewResolver(
+		testenv.NewLogger(t),
+		testenv.NewTracerProvider(t),
+		testenv.NewMemoryCache(),
+		"",  // idpBaseURL
+		"",  // idpClientID
+		nil, // idpClient
+		nil, // workosClient
+		provider,
+		nil, // orgRepo
+		nil, // userRepo
+		nil, // pylon
+		nil, // posthog
+		"",  // cache suffix
+	)
+	return resolver, issuer
+}
+
+func TestBuildAuthorizationURL_OIDC(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r, issuer := newOIDCResolver(t)
+
+	u, err := r.BuildAuthorizationURL(ctx, identity.AuthorizationURLParams{
+		CallbackURL:     oidcCallbackURL,
+		Scope:           "",
+		State:           "state-value",
+		ScopesSupported: nil,
+		LoginHint:       "ada@acme.com",
+		ScreenHint:      "sign-up",
+		Nonce:           "nonce-value",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, issuer.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	q := u.Query()
+	require.Equal(t, issuer.ClientID, q.Get("client_id"))
+	require.Equal(t, oidcCallbackURL, q.Get("redirect_uri"))
+	require.Equal(t, "state-value", q.Get("state"))
+	require.Equal(t, "nonce-value", q.Get("nonce"))
+	require.Equal(t, "S256", q.Get("code_challenge_method"))
+	require.NotEmpty(t, q.Get("code_challenge"))
+	require.Equal(t, "ada@acme.com", q.Get("login_hint"))
+	require.Equal(t, "openid email profile", q.Get("scope"))
+	require.False(t, q.Has("provider"), "AuthKit parameters must not reach a generic issuer")
+	require.False(t, q.Has("screen_hint"), "AuthKit parameters must not reach a generic issuer")
+}
+
+func TestBuildAuthorizationURL_OIDCRequiresNonce(t *testing.T) {
+	t.Parallel()
+
+	r, _ := newOIDCResolver(t)
+
+	// The MCP and platform OAuth flows build URLs without a login nonce.
+	_, err := r.BuildAuthorizationURL(context.Background(), identity.AuthorizationURLParams{
+		CallbackURL:     oidcCallbackURL,
+		Scope:           "",
+		State:           "state-value",
+		ScopesSupported: nil,
+		LoginHint:       "",
+		ScreenHint:      "",
+		Nonce:           "",
+	})
+	require.ErrorIs(t, err, identity.ErrOIDCUnsupported)
+}
+
+func TestExchangeAuthorizationCode_OIDC(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r, issuer := newOIDCResolver(t)
+	u, err := r.BuildAuthorizationURL(ctx, identity.AuthorizationURLParams{
+		CallbackURL:     oidcCallbackURL,
+		Scope:           "",
+		State:           "state-value",
+		ScopesSupported: nil,
+		LoginHint:       "",
+		ScreenHint:      "",
+		Nonce:           "nonce-value",
+	})
+	require.NoError(t, err)
+	code := issuer.SignIn(t, u.String(), oidcUser.Email).Query().Get("code")
+
+	idpUser, err := r.ExchangeAuthorizationCode(ctx, code, oidcCallbackURL, "nonce-value")
+	require.NoError(t, err)
+
+	id := idpUser.OIDCIdentity()
+	require.NotNil(t, id)
+	require.Equal(t, issuer.URL, id.Issuer)
+	require.Equal(t, []string{"gram-admins"}, id.Groups)
+	require.Equal(t, id.UserKey(), idpUser.Sub)
+	require.Equal(t, oidcUser.Email, idpUser.Email)
+	require.Equal(t, oidcUser.Name, idpUser.Name)
+	require.Empty(t, idpUser.WorkOSSessionID)
+
+	// The PKCE verifier is single use, so replaying the callback fails.
+	_, err = r.ExchangeAuthorizationCode(ctx, code, oidcCallbackURL, "nonce-value")
+	require.Error(t, err)
+}
+
+func TestWorkOSFlowsRefusedWithOIDC(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r, _ := newOIDCResolver(t)
+
+	_, err := r.ExchangeCodeForTokens(ctx, "code")
+	require.ErrorIs(t, err, identity.ErrOIDCUnsupported)
+
+	_, err = r.AuthenticateWithMagicAuth(ctx, "ada@acme.com")
+	require.ErrorIs(t, err, identity.ErrOIDCUnsupported)
+}
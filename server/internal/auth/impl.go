@@ -349,7 +349,11 @@ func (s *Service) Callback(ctx context.Context, payload *gen.CallbackPayload) (r
 		return redirectWithError(authErrInit, errors.New("support login intent is missing an organization"))
 	}
 
-	idpUser, err := s.identity.ExchangeCodeForTokens(ctx, payload.Code)
+	loginNonce := ""
+	if state := decodeStateParam(payload); state != nil {
+		loginNonce = state.Nonce
+	}
+	idpUser, err := s.identity.ExchangeAuthorizationCode(ctx, payload.Code, s.buildCallbackURL(ctx), loginNonce)
 	if err != nil {
 		return redirectWithError(authErrCodeLookup, err)
 	}
@@ -380,7 +384,17 @@ func (s *Service) Callback(ctx context.Context, payload *gen.CallbackPayload) (r
 		supportOrgID = supportLogin.OrganizationID
 	}
 
-	if supportOrgID == "" && idpUser.Sub != "" {
+	if oidcIdentity := idpUser.OIDCIdentity(); oidcIdentity != nil {
+		// Rules apply on every sign-in, support logins included, so group
+		// changes at the issuer cannot be sidestepped.
+		if err := s.syncOIDCMemberships(ctx, userID, oidcIdentity); err != nil {
+			return redirectWithError(authErrInit, err)
+		}
+		userInfo, _, err = s.identity.GetUserInfo(ctx, userID)
+		if err != nil {
+			return redirectWithError(authErrInit, err)
+		}
+	} else if supportOrgID == "" && idpUser.Sub != "" {
 		if err := s.identity.SyncMembershipsFromWorkOS(ctx, userID, idpUser.Sub); err != nil {
 			return redirectWithError(authErrInit, err)
 		}
@@ -735,6 +749,7 @@ func (s *Service) Login(ctx context.Context, payload *gen.LoginPayload) (res *ge
 		ScopesSupported: nil,
 		LoginHint:       email,
 		ScreenHint:      screenHint,
+		Nonce:           nonce,
 	})
 	if err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "error building authorization URL").LogError(ctx, s.logger)
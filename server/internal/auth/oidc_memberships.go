@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	accessrepo "github.com/speakeasy-api/gram/server/internal/access/repo"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/auth/oidcidp"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	orgRepo "github.com/speakeasy-api/gram/server/internal/organizations/repo"
+)
+
+// syncOIDCMemberships applies the generic OIDC provider's organization rules
+// to a user who just signed in: it adds them to every organization the rules
+// admit them to with the role the rules give them, creating organizations on
+// first use, and removes them from the organizations the rules govern but no
+// longer admit them to. Organizations the rules do not name are left alone.
+func (s *Service) syncOIDCMemberships(ctx context.Context, userID string, id *oidcidp.Identity) error {
+	rules := s.identity.OIDCProvider().Rules()
+	userKey := id.UserKey()
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin oidc membership sync: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return tx.Rollback(ctx) })
+
+	orgs := orgRepo.New(tx)
+	access := accessrepo.New(tx)
+	now := conv.ToPGTimestamptz(time.Now().UTC())
+
+	assigned := make(map[string]struct{})
+	for _, assignment := range rules.Assign(*id) {
+		org, err := s.ensureOIDCOrganization(ctx, tx, assignment)
+		if err != nil {
+			return err
+		}
+		assigned[org.Slug] = struct{}{}
+
+		if _, err := orgs.UpsertOrganizationUserRelationship(ctx, orgRepo.UpsertOrganizationUserRelationshipParams{
+			OrganizationID: org.ID,
+			UserID:         conv.ToPGText(userID),
+		}); err != nil {
+			return fmt.Errorf("add user to organization %s: %w", org.Slug, err)
+		}
+
+		replaced, err := access.ReplaceOrganizationRoleAssignment(ctx, accessrepo.ReplaceOrganizationRoleAssignmentParams{
+			OrganizationID:     org.ID,
+			WorkosRoleSlug:     assignment.Role,
+			WorkosUserID:       userKey,
+			UserID:             conv.ToPGText(userID),
+			WorkosMembershipID: conv.ToPGTextEmpty(""),
+			WorkosUpdatedAt:    now,
+			WorkosLastEventID:  conv.ToPGTextEmpty(""),
+		})
+		if err != nil {
+			return fmt.Errorf("assign role in organization %s: %w", org.Slug, err)
+		}
+		if replaced == 0 {
+			return fmt.Errorf("organization %s has no role %q", org.Slug, assignment.Role)
+		}
+	}
+
+	for _, slug := range rules.ManagedOrganizations() {
+		if _, ok := assigned[slug]; ok {
+			continue
+		}
+		org, err := orgs.GetOrganizationMetadataBySlug(ctx, slug)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			continue
+		case err != nil:
+			return fmt.Errorf("get organization %s: %w", slug, err)
+		}
+
+		if err := orgs.DeleteOrganizationUserRelationship(ctx, orgRepo.DeleteOrganizationUserRelationshipParams{
+			OrganizationID: org.ID,
+			UserID:         conv.ToPGText(userID),
+		}); err != nil {
+			return fmt.Errorf("remove user from organization %s: %w", slug, err)
+		}
+		if _, err := access.SoftDeleteAllRoleAssignmentsByWorkosUser(ctx, accessrepo.SoftDeleteAllRoleAssignmentsByWorkosUserParams{
+			OrganizationID: org.ID,
+			WorkosUserID:   userKey,
+		}); err != nil {
+			return fmt.Errorf("remove roles in organization %s: %w", slug, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit oidc membership sync: %w", err)
+	}
+
+	if err := s.identity.InvalidateUserInfoCache(ctx, userID); err != nil {
+		return fmt.Errorf("invalidate user info cache: %w", err)
+	}
+	return nil
+}
+
+// ensureOIDCOrganization returns the organization an assignment names,
+// creating it with its system roles and default entitlements if this is the
+// first time anyone has been admitted to it.
+func (s *Service) ensureOIDCOrganization(ctx context.Context, tx pgx.Tx, assignment oidcidp.Assignment) (orgRepo.OrganizationMetadatum, error) {
+	orgs := orgRepo.New(tx)
+
+	// Serialize first sign-ins racing to create the same organization.
+	if err := orgs.LockOrganizationSlug(ctx, assignment.OrganizationSlug); err != nil {
+		return orgRepo.OrganizationMetadatum{}, fmt.Errorf("lock organization slug: %w", err)
+	}
+
+	org, err := orgs.GetOrganizationMetadataBySlug(ctx, assignment.OrganizationSlug)
+	switch {
+	case err == nil:
+		return org, nil
+	case !errors.Is(err, pgx.ErrNoRows):
+		return orgRepo.OrganizationMetadatum{}, fmt.Errorf("get organization %s: %w", assignment.OrganizationSlug, err)
+	}
+
+	org, err = orgs.UpsertOrganizationMetadata(ctx, orgRepo.UpsertOrganizationMetadataParams{
+		ID:          uuid.NewString(),
+		Name:        assignment.OrganizationName,
+		Slug:        assignment.OrganizationSlug,
+		WorkosID:    pgtype.Text{String: "", Valid: false},
+		Whitelisted: pgtype.Bool{Bool: true, Valid: true},
+	})
+	if err != nil {
+		return orgRepo.OrganizationMetadatum{}, fmt.Errorf("create organization %s: %w", assignment.OrganizationSlug, err)
+	}
+
+	if err := authz.SeedSystemRoleGrantsTx(ctx, tx, org.ID); err != nil {
+		return orgRepo.OrganizationMetadatum{}, fmt.Errorf("seed organization %s roles: %w", org.Slug, err)
+	}
+	if s.organizationSeeder == nil {
+		return orgRepo.OrganizationMetadatum{}, errors.New("organization feature seeder is not configured")
+	}
+	if err := s.organizationSeeder(ctx, tx, org.ID); err != nil {
+		return orgRepo.OrganizationMetadatum{}, fmt.Errorf("seed organization %s default entitlements: %w", org.Slug, err)
+	}
+
+	s.logger.InfoContext(ctx, "created organization from oidc rules",
+		attr.SlogOrganizationID(org.ID),
+		attr.SlogOrganizationSlug(org.Slug),
+	)
+	return org, nil
+}
@@ -0,0 +1,291 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	mockoidc "github.com/speakeasy-api/gram/mock-oidc"
+	gen "github.com/speakeasy-api/gram/server/gen/auth"
+	accessrepo "github.com/speakeasy-api/gram/server/internal/access/repo"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/auth"
+	"github.com/speakeasy-api/gram/server/internal/auth/identity"
+	"github.com/speakeasy-api/gram/server/internal/auth/oidcidp"
+	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/authztest"
+	"github.com/speakeasy-api/gram/server/internal/billing"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	orgRepo "github.com/speakeasy-api/gram/server/internal/organizations/repo"
+	"github.com/speakeasy-api/gram/server/internal/productfeatures"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/posthog"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/pylon"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/workos"
+	userRepo "github.com/speakeasy-api/gram/server/internal/users/repo"
+)
+
+const acmeOIDCRules = `
+organizations:
+  - slug: acme
+    name: Acme
+    email_domains: [acme.com]
+    roles:
+      - group: gram-admins
+        role: admin
+    default_role: member
+  - slug: acme-support
+    groups: [support]
+    default_role: member
+`
+
+var (
+	oidcAdmin = mockoidc.User{
+		Email:         "ada@acme.com",
+		Name:          "Ada Admin",
+		EmailVerified: true,
+		Groups:        []string{"gram-admins"},
+	}
+	oidcMember = mockoidc.User{
+		Email:         "bob@acme.com",
+		Name:          "Bob Builder",
+		EmailVerified: true,
+		Groups:        []string{"engineering"},
+	}
+	oidcOutsider = mockoidc.User{
+		Email:         "olly@example.com",
+		Name:          "Olly Outsider",
+		EmailVerified: true,
+	}
+)
+
+type oidcTestInstance struct {
+	*testInstance
+	issuer *testenv.MockOIDCIssuer
+}
+
+// newTestAuthServiceWithOIDC builds an auth service that signs users in
+// through a mock OIDC issuer instead of WorkOS.
+func newTestAuthServiceWithOIDC(t *testing.T, rules string) (context.Context, *oidcTestInstance) {
+	t.Helper()
+
+	ctx := authztest.WithAdminGrants(t.Context())
+	logger := testenv.NewLogger(t)
+	tracerProvider := testenv.NewTracerProvider(t)
+
+	conn, err := infra.CloneTestDatabase(t, "authtest")
+	require.NoError(t, err)
+
+	redisClient, err := infra.NewRedisClient(t, 0)
+	require.NoError(t, err)
+
+	authConfigs := auth.AuthConfigurations{
+		IDPBaseURL:        "",
+		GramServerURL:     "http://localhost:8080",
+		SignInRedirectURL: "http://localhost:3000/dashboard",
+		Environment:       "test",
+	}
+
+	issuer := testenv.NewMockOIDCIssuer(t, authConfigs.GramServerURL+"/rpc/auth.callback", oidcAdmin, oidcMember, oidcOutsider)
+	parsed, err := oidcidp.ParseRules([]byte(rules))
+	require.NoError(t, err)
+	provider, err := oidcidp.NewProvider(ctx, oidcidp.Options{
+		Issuer:       issuer.URL,
+		ClientID:     issuer.ClientID,
+		ClientSecret: issuer.ClientSecret,
+		HTTPClient:   http.DefaultClient,
+		Rules:        parsed,
+	})
+	require.NoError(t, err)
+
+	pylon, err := pylon.NewPylon(logger, "")
+	require.NoError(t, err)
+
+	posthog := posthog.New(ctx, logger, "test-posthog-key", "test-posthog-host", "")
+
+	billingClient := billing.NewStubClient(logger, tracerProvider)
+
+	authzProvisioner := authz.NewProvisioner(conn)
+	cacheSuffix := testenv.NewCacheSuffix(t, cache.Suffix("auth"))
+	resolver := identity.NewResolver(logger, tracerProvider, cache.NewRedisCacheAdapter(redisClient), "", "", nil, nil, provider, orgRepo.New(conn), userRepo.New(conn), pylon, posthog, cacheSuffix)
+	sessionManager := sessions.NewManager(logger, testenv.NewTracerProvider(t), conn, redisClient, cacheSuffix, nil, billingClient, resolver)
+
+	nonceStore := cache.NewRedisCacheAdapter(redisClient)
+	authzEngine := authz.NewEngine(logger, conn, authztest.ChallengeLoggingAlwaysDisabled, workos.NewStubClient())
+	trialNotifier := &fakeTrialNotifier{}
+	svc := auth.NewService(logger, tracerProvider, conn, sessionManager, resolver, authConfigs, authzEngine, billingClient, noopCancelScheduler{}, posthog, nonceStore, authzProvisioner, productfeatures.SeedOrganizationDefaultsTx, productfeatures.SeedEnterpriseTrialBundleTx, audit.NewLogger(), trialNotifier)
+	result := newTestAuthServiceResult(t, svc, conn, sessionManager, resolver, nil, authConfigs, nonceStore)
+	result.authorizer = auth.New(logger, conn, sessionManager, authzEngine)
+	result.trialNotifier = trialNotifier
+
+	return ctx, &oidcTestInstance{testInstance: result, issuer: issuer}
+}
+
+// signIn runs a full dashboard login as email: Login, the issuer's login
+// screen, then Callback with the code the issuer redirects back with.
+func (ti *oidcTestInstance) signIn(ctx context.Context, t *testing.T, email string) *gen.CallbackResult {
+	t.Helper()
+
+	ctx = auth.TestNonceBindingContext(ctx, "oidc-binding-"+email)
+	login, err := ti.service.Login(ctx, &gen.LoginPayload{})
+	require.NoError(t, err)
+
+	redirect := ti.issuer.SignIn(t, login.Location, email)
+	code := redirect.Query().Get("code")
+	require.NotEmpty(t, code, redirect.String())
+	state := redirect.Query().Get("state")
+	require.Equal(t, nonceStateFromLocation(t, login.Location), state)
+
+	result, err := ti.service.Callback(ctx, &gen.CallbackPayload{Code: code, State: &state})
+	require.NoError(t, err)
+	return result
+}
+
+func (ti *oidcTestInstance) sessionUserID(ctx context.Context, t *testing.T, result *gen.CallbackResult) string {
+	t.Helper()
+
+	require.NotContains(t, result.Location, "signin_error")
+	require.NotEmpty(t, result.SessionToken)
+	session, err := ti.sessionManager.GetSession(ctx, result.SessionToken)
+	require.NoError(t, err)
+	return session.UserID
+}
+
+func (ti *oidcTestInstance) roleSlugs(ctx context.Context, t *testing.T, orgID, userID string) []string {
+	t.Helper()
+
+	rows, err := accessrepo.New(ti.conn).ListMemberRolePrincipalsByUser(ctx, accessrepo.ListMemberRolePrincipalsByUserParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	require.NoError(t, err)
+	slugs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		slugs = append(slugs, row.RoleSlug)
+	}
+	return slugs
+}
+
+func (ti *oidcTestInstance) isMember(ctx context.Context, t *testing.T, orgID, userID string) bool {
+	t.Helper()
+
+	member, err := orgRepo.New(ti.conn).HasOrganizationUserRelationship(ctx, orgRepo.HasOrganizationUserRelationshipParams{
+		OrganizationID: orgID,
+		UserID:         conv.ToPGText(userID),
+	})
+	require.NoError(t, err)
+	return member
+}
+
+func TestService_CallbackOIDC_CreatesOrganizationsAndMapsGroupsToRoles(t *testing.T) {
+	t.Parallel()
+
+	ctx, instance := newTestAuthServiceWithOIDC(t, acmeOIDCRules)
+
+	adminID := instance.sessionUserID(ctx, t, instance.signIn(ctx, t, oidcAdmin.Email))
+	memberID := instance.sessionUserID(ctx, t, instance.signIn(ctx, t, oidcMember.Email))
+
+	orgs := orgRepo.New(instance.conn)
+	acme, err := orgs.GetOrganizationMetadataBySlug(ctx, "acme")
+	require.NoError(t, err)
+	require.Equal(t, "Acme", acme.Name)
+	require.False(t, acme.WorkosID.Valid)
+
+	require.True(t, instance.isMember(ctx, t, acme.ID, adminID))
+	require.Equal(t, []string{"admin"}, instance.roleSlugs(ctx, t, acme.ID, adminID))
+	require.True(t, instance.isMember(ctx, t, acme.ID, memberID))
+	require.Equal(t, []string{"member"}, instance.roleSlugs(ctx, t, acme.ID, memberID))
+
+	// Nobody is in the support group, so that organization is never created.
+	_, err = orgs.GetOrganizationMetadataBySlug(ctx, "acme-support")
+	require.Error(t, err)
+
+	user, err := userRepo.New(instance.conn).GetUser(ctx, adminID)
+	require.NoError(t, err)
+	require.Equal(t, oidcAdmin.Email, user.Email)
+	require.Equal(t, oidcAdmin.Name, user.DisplayName)
+}
+
+func TestService_CallbackOIDC_RepeatSignInKeepsOneUserAndRole(t *testing.T) {
+	t.Parallel()
+
+	ctx, instance := newTestAuthServiceWithOIDC(t, acmeOIDCRules)
+
+	first := instance.sessionUserID(ctx, t, instance.signIn(ctx, t, oidcAdmin.Email))
+	second := instance.sessionUserID(ctx, t, instance.signIn(ctx, t, oidcAdmin.Email))
+	require.Equal(t, first, second)
+
+	acme, err := orgRepo.New(instance.conn).GetOrganizationMetadataBySlug(ctx, "acme")
+	require.NoError(t, err)
+	require.Equal(t, []string{"admin"}, instance.roleSlugs(ctx, t, acme.ID, first))
+}
+
+func TestService_CallbackOIDC_RemovesMembershipRulesNoLongerGrant(t *testing.T) {
+	t.Parallel()
+
+	ctx, instance := newTestAuthServiceWithOIDC(t, acmeOIDCRules)
+
+	// The user already exists and was added by hand to the rule-managed
+	// support organization and to an organization the rules do not mention.
+	require.NoError(t, instance.createTestUser(ctx, &MockUserInfo{
+		UserID: "existing-bob",
+		Email:  oidcMember.Email,
+	}))
+	require.NoError(t, instance.createTestOrganization(ctx, MockOrganizationEntry{
+		ID: "acme-support-id", Name: "Acme Support", Slug: "acme-support",
+	}, "existing-bob"))
+	require.NoError(t, authz.SeedSystemRoleGrantsTx(ctx, instance.conn, "acme-support-id"))
+	require.NoError(t, instance.createTestOrganization(ctx, MockOrganizationEntry{
+		ID: "unmanaged-id", Name: "Unmanaged", Slug: "unmanaged",
+	}, "existing-bob"))
+
+	userID := instance.sessionUserID(ctx, t, instance.signIn(ctx, t, oidcMember.Email))
+	require.Equal(t, "existing-bob", userID)
+
+	require.False(t, instance.isMember(ctx, t, "acme-support-id", userID))
+	require.Empty(t, instance.roleSlugs(ctx, t, "acme-support-id", userID))
+	require.True(t, instance.isMember(ctx, t, "unmanaged-id", userID))
+}
+
+func TestService_CallbackOIDC_RefusesUnadmittedUser(t *testing.T) {
+	t.Parallel()
+
+	ctx, instance := newTestAuthServiceWithOIDC(t, acmeOIDCRules)
+
+	result := instance.signIn(ctx, t, oidcOutsider.Email)
+	require.Contains(t, result.Location, "signin_error")
+	require.Empty(t, result.SessionToken)
+
+	_, err := userRepo.New(instance.conn).GetUserByEmail(ctx, oidcOutsider.Email)
+	require.Error(t, err)
+}
+
+func TestService_CallbackOIDC_ExistingProvisioningRefusesUnknownUser(t *testing.T) {
+	t.Parallel()
+
+	ctx, instance := newTestAuthServiceWithOIDC(t, "user_provisioning: existing\n"+acmeOIDCRules)
+
+	result := instance.signIn(ctx, t, oidcAdmin.Email)
+	require.Contains(t, result.Location, "signin_error")
+	require.Empty(t, result.SessionToken)
+
+	_, err := userRepo.New(instance.conn).GetUserByEmail(ctx, oidcAdmin.Email)
+	require.Error(t, err)
+}
+
+func TestService_CallbackOIDC_ExistingProvisioningSignsInKnownUser(t *testing.T) {
+	t.Parallel()
+
+	ctx, instance := newTestAuthServiceWithOIDC(t, "user_provisioning: existing\n"+acmeOIDCRules)
+
+	require.NoError(t, instance.createTestUser(ctx, &MockUserInfo{
+		UserID: "existing-ada",
+		Email:  oidcAdmin.Email,
+	}))
+	userID := instance.sessionUserID(ctx, t, instance.signIn(ctx, t, oidcAdmin.Email))
+	require.Equal(t, "existing-ada", userID)
+}
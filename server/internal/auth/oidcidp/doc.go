@@ -0,0 +1,8 @@
+// Package oidcidp signs dashboard users in through a generic OpenID Connect
+// issuer such as Okta, Keycloak or Microsoft Entra ID, for installs that
+// cannot reach WorkOS.
+//
+// The issuer only says who the user is. A rules file decides the rest: which
+// organizations they belong to, with which role, and whether signing in may
+// create their account. See [Rules].
+package oidcidp
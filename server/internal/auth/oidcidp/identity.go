@@ -0,0 +1,123 @@
+package oidcidp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// userKeyPrefix marks user keys minted here apart from WorkOS user IDs, which
+// share the same columns.
+const userKeyPrefix = "oidc_"
+
+// Identity is the user an issuer vouched for.
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+	Groups        []string
+}
+
+// UserKey identifies the user across sign-ins. OIDC only promises that the
+// issuer and subject together are unique, so the key is derived from both.
+// Gram stores it where it would store a WorkOS user ID.
+func (id Identity) UserKey() string {
+	sum := sha256.Sum256([]byte(id.Issuer + "\x00" + id.Subject))
+	return userKeyPrefix + hex.EncodeToString(sum[:16])
+}
+
+// InGroup reports whether the issuer listed the user in group.
+func (id Identity) InGroup(group string) bool {
+	return slices.Contains(id.Groups, group)
+}
+
+// fill copies the claims id does not have yet, and reports whether the claims
+// carried groups.
+func (id *Identity) fill(claims map[string]any, groupsClaim string) (bool, error) {
+	if id.Email == "" {
+		id.Email, _ = claims["email"].(string)
+		id.EmailVerified = isTrue(claims["email_verified"])
+	}
+	if id.Name == "" {
+		id.Name, _ = claims["name"].(string)
+	}
+	if id.Name == "" {
+		given, _ := claims["given_name"].(string)
+		family, _ := claims["family_name"].(string)
+		id.Name = strings.TrimSpace(given + " " + family)
+	}
+	if id.Picture == "" {
+		id.Picture, _ = claims["picture"].(string)
+	}
+
+	raw, ok := lookupClaim(claims, groupsClaim)
+	if !ok || id.Groups != nil {
+		return ok, nil
+	}
+	groups, err := stringList(raw)
+	if err != nil {
+		return false, fmt.Errorf("read %s claim: %w", groupsClaim, err)
+	}
+	id.Groups = groups
+	return true, nil
+}
+
+// lookupClaim finds a claim by name, or by a dotted path into nested claims
+// when no claim has the whole name. Auth0-style namespaced claims such as
+// https://example.com/groups contain dots of their own.
+func lookupClaim(claims map[string]any, name string) (any, bool) {
+	if value, ok := claims[name]; ok {
+		return value, true
+	}
+
+	var current any = claims
+	for part := range strings.SplitSeq(name, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = object[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func stringList(value any) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return []string{}, nil
+	case string:
+		return []string{v}, nil
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected strings, found %T", item)
+			}
+			list = append(list, s)
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("expected a list of strings, found %T", value)
+	}
+}
+
+// isTrue reads a boolean claim. Some issuers send email_verified as a string.
+func isTrue(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
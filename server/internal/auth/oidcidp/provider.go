@@ -0,0 +1,185 @@
+package oidcidp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+)
+
+var (
+	// ErrEmailNotVerified is returned for an account whose email address the
+	// issuer has not verified, unless the rules allow it.
+	ErrEmailNotVerified = errors.New("the identity provider has not verified this email address")
+	// ErrNotAdmitted is returned for an account no organization rule admits,
+	// unless the rules allow unassigned users.
+	ErrNotAdmitted = errors.New("this account is not in a group that has access to Gram")
+	// ErrUserNotProvisioned is returned when the rules only sign in existing
+	// users and no Gram account has the email address.
+	ErrUserNotProvisioned = errors.New("this account has not been given access to Gram")
+)
+
+// Options configures a [Provider].
+type Options struct {
+	// Issuer is the issuer URL; its discovery document is read from
+	// <Issuer>/.well-known/openid-configuration.
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// HTTPClient is used for discovery, key fetches, and the token and
+	// userinfo endpoints.
+	HTTPClient *guardian.HTTPClient
+	Rules      *Rules
+}
+
+// Provider runs the authorization code flow against an OIDC issuer and
+// applies the rules to whoever signs in.
+type Provider struct {
+	clientID     string
+	clientSecret string
+	scopes       []string
+	httpClient   *guardian.HTTPClient
+	provider     *oidc.Provider
+	verifier     *oidc.IDTokenVerifier
+	rules        *Rules
+}
+
+// NewProvider reads the issuer's discovery document and returns a provider
+// for it.
+func NewProvider(ctx context.Context, opts Options) (*Provider, error) {
+	if opts.Issuer == "" || opts.ClientID == "" {
+		return nil, errors.New("oidc issuer and client id are required")
+	}
+	if opts.Rules == nil {
+		return nil, errors.New("oidc rules are required")
+	}
+
+	// go-oidc keeps the client in this context for later key fetches.
+	provider, err := oidc.NewProvider(oidc.ClientContext(ctx, opts.HTTPClient), opts.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("discover oidc issuer %s: %w", opts.Issuer, err)
+	}
+
+	scopes := append([]string{oidc.ScopeOpenID, "email", "profile"}, opts.Rules.Scopes...)
+
+	return &Provider{
+		clientID:     opts.ClientID,
+		clientSecret: opts.ClientSecret,
+		scopes:       scopes,
+		httpClient:   opts.HTTPClient,
+		provider:     provider,
+		verifier:     provider.Verifier(&oidc.Config{ClientID: opts.ClientID}), //nolint:exhaustruct // third-party struct; only ClientID is relevant
+		rules:        opts.Rules,
+	}, nil
+}
+
+// Rules returns the rules the provider admits users with.
+func (p *Provider) Rules() *Rules {
+	return p.rules
+}
+
+func (p *Provider) oauth2Config(redirectURL string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     p.clientID,
+		ClientSecret: p.clientSecret,
+		Endpoint:     p.provider.Endpoint(),
+		RedirectURL:  redirectURL,
+		Scopes:       p.scopes,
+	}
+}
+
+// AuthCodeURL returns the URL to send the browser to. The nonce comes back in
+// the ID token, and the PKCE verifier must be passed to Exchange unchanged.
+func (p *Provider) AuthCodeURL(redirectURL, state, nonce, pkceVerifier, loginHint string) string {
+	opts := []oauth2.AuthCodeOption{
+		oidc.Nonce(nonce),
+		oauth2.S256ChallengeOption(pkceVerifier),
+	}
+	if loginHint != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("login_hint", loginHint))
+	}
+	return p.oauth2Config(redirectURL).AuthCodeURL(state, opts...)
+}
+
+// Exchange redeems an authorization code and returns the verified identity of
+// the user it was issued to. Groups missing from the ID token are read from
+// the userinfo endpoint, since issuers such as Okta leave them out of ID
+// tokens for large directories.
+//
+// # Errors
+//   - [ErrEmailNotVerified]: see [Rules.AllowUnverifiedEmail].
+//   - [ErrNotAdmitted]: see [Rules.AllowUnassignedUsers].
+//   - `error`: the exchange failed or the ID token is invalid.
+func (p *Provider) Exchange(ctx context.Context, code, redirectURL, nonce, pkceVerifier string) (*Identity, error) {
+	if nonce == "" {
+		return nil, errors.New("oidc exchange: missing login nonce")
+	}
+	ctx = oidc.ClientContext(ctx, p.httpClient)
+
+	token, err := p.oauth2Config(redirectURL).Exchange(ctx, code, oauth2.VerifierOption(pkceVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("oidc code exchange: %w", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("oidc token response has no id_token")
+	}
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("verify id_token: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, errors.New("id_token nonce does not match the login")
+	}
+
+	var claims map[string]any
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("parse id_token claims: %w", err)
+	}
+	id := &Identity{
+		Issuer:        idToken.Issuer,
+		Subject:       idToken.Subject,
+		Email:         "",
+		EmailVerified: false,
+		Name:          "",
+		Picture:       "",
+		Groups:        nil,
+	}
+	hasGroups, err := id.fill(claims, p.rules.GroupsClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	if (!hasGroups || id.Email == "") && p.provider.UserInfoEndpoint() != "" {
+		info, err := p.provider.UserInfo(ctx, oauth2.StaticTokenSource(token))
+		if err != nil {
+			return nil, fmt.Errorf("read oidc userinfo: %w", err)
+		}
+		if info.Subject != idToken.Subject {
+			return nil, errors.New("oidc userinfo subject does not match the id_token")
+		}
+		var userinfoClaims map[string]any
+		if err := info.Claims(&userinfoClaims); err != nil {
+			return nil, fmt.Errorf("parse oidc userinfo claims: %w", err)
+		}
+		if _, err := id.fill(userinfoClaims, p.rules.GroupsClaim); err != nil {
+			return nil, err
+		}
+	}
+
+	if id.Email == "" {
+		return nil, errors.New("oidc identity has no email claim")
+	}
+	if !id.EmailVerified && !p.rules.AllowUnverifiedEmail {
+		return nil, ErrEmailNotVerified
+	}
+	if len(p.rules.Assign(*id)) == 0 && !p.rules.AllowUnassignedUsers {
+		return nil, ErrNotAdmitted
+	}
+
+	return id, nil
+}
@@ -0,0 +1,136 @@
+package oidcidp_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	mockoidc "github.com/speakeasy-api/gram/mock-oidc"
+	"github.com/speakeasy-api/gram/server/internal/auth/oidcidp"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+const callbackURL = "https://gram.example.com/rpc/auth.callback"
+
+var (
+	adminUser = mockoidc.User{
+		Email:         "ada@acme.com",
+		Name:          "Ada Admin",
+		EmailVerified: true,
+		Groups:        []string{"engineering", "gram-admins"},
+	}
+	outsideUser = mockoidc.User{
+		Email:         "olly@example.com",
+		Name:          "Olly Outsider",
+		EmailVerified: true,
+	}
+	unverifiedUser = mockoidc.User{
+		Email:         "uma@acme.com",
+		Name:          "Uma Unverified",
+		EmailVerified: false,
+		Groups:        []string{"engineering"},
+	}
+)
+
+func newProvider(t *testing.T, rules string) (*oidcidp.Provider, *testenv.MockOIDCIssuer) {
+	t.Helper()
+
+	issuer := testenv.NewMockOIDCIssuer(t, callbackURL, adminUser, outsideUser, unverifiedUser)
+	parsed, err := oidcidp.ParseRules([]byte(rules))
+	require.NoError(t, err)
+
+	provider, err := oidcidp.NewProvider(t.Context(), oidcidp.Options{
+		Issuer:       issuer.URL,
+		ClientID:     issuer.ClientID,
+		ClientSecret: issuer.ClientSecret,
+		HTTPClient:   http.DefaultClient,
+		Rules:        parsed,
+	})
+	require.NoError(t, err)
+	return provider, issuer
+}
+
+// signIn runs the browser half of the flow and returns the authorization code.
+func signIn(t *testing.T, provider *oidcidp.Provider, issuer *testenv.MockOIDCIssuer, email, nonce, verifier string) string {
+	t.Helper()
+
+	location := issuer.SignIn(t, provider.AuthCodeURL(callbackURL, "state-1", nonce, verifier, email), email)
+	require.Equal(t, "state-1", location.Query().Get("state"))
+	code := location.Query().Get("code")
+	require.NotEmpty(t, code, location.String())
+	return code
+}
+
+func TestExchange(t *testing.T) {
+	t.Parallel()
+
+	provider, issuer := newProvider(t, acmeRules)
+	verifier := oauth2.GenerateVerifier()
+	code := signIn(t, provider, issuer, adminUser.Email, "nonce-1", verifier)
+
+	id, err := provider.Exchange(t.Context(), code, callbackURL, "nonce-1", verifier)
+	require.NoError(t, err)
+	require.Equal(t, issuer.URL, id.Issuer)
+	require.Equal(t, adminUser.Subject(), id.Subject)
+	require.Equal(t, adminUser.Email, id.Email)
+	require.True(t, id.EmailVerified)
+	require.Equal(t, adminUser.Name, id.Name)
+	require.Equal(t, adminUser.Groups, id.Groups)
+	require.Equal(t, []oidcidp.Assignment{{OrganizationSlug: "acme", OrganizationName: "Acme", Role: "admin"}}, provider.Rules().Assign(*id))
+}
+
+func TestExchange_RejectsAnotherLoginsNonce(t *testing.T) {
+	t.Parallel()
+
+	provider, issuer := newProvider(t, acmeRules)
+	verifier := oauth2.GenerateVerifier()
+	code := signIn(t, provider, issuer, adminUser.Email, "nonce-1", verifier)
+
+	_, err := provider.Exchange(t.Context(), code, callbackURL, "nonce-2", verifier)
+	require.ErrorContains(t, err, "nonce")
+}
+
+func TestExchange_RejectsWrongPKCEVerifier(t *testing.T) {
+	t.Parallel()
+
+	provider, issuer := newProvider(t, acmeRules)
+	code := signIn(t, provider, issuer, adminUser.Email, "nonce-1", oauth2.GenerateVerifier())
+
+	_, err := provider.Exchange(t.Context(), code, callbackURL, "nonce-1", oauth2.GenerateVerifier())
+	require.ErrorContains(t, err, "oidc code exchange")
+}
+
+func TestExchange_UnverifiedEmail(t *testing.T) {
+	t.Parallel()
+
+	provider, issuer := newProvider(t, acmeRules)
+	verifier := oauth2.GenerateVerifier()
+	code := signIn(t, provider, issuer, unverifiedUser.Email, "nonce-1", verifier)
+	_, err := provider.Exchange(t.Context(), code, callbackURL, "nonce-1", verifier)
+	require.ErrorIs(t, err, oidcidp.ErrEmailNotVerified)
+
+	provider, issuer = newProvider(t, "allow_unverified_email: true\n"+acmeRules)
+	code = signIn(t, provider, issuer, unverifiedUser.Email, "nonce-1", verifier)
+	id, err := provider.Exchange(t.Context(), code, callbackURL, "nonce-1", verifier)
+	require.NoError(t, err)
+	require.False(t, id.EmailVerified)
+}
+
+func TestExchange_UnassignedUser(t *testing.T) {
+	t.Parallel()
+
+	provider, issuer := newProvider(t, acmeRules)
+	verifier := oauth2.GenerateVerifier()
+	code := signIn(t, provider, issuer, outsideUser.Email, "nonce-1", verifier)
+	_, err := provider.Exchange(t.Context(), code, callbackURL, "nonce-1", verifier)
+	require.ErrorIs(t, err, oidcidp.ErrNotAdmitted)
+
+	provider, issuer = newProvider(t, "allow_unassigned_users: true\n"+acmeRules)
+	code = signIn(t, provider, issuer, outsideUser.Email, "nonce-1", verifier)
+	id, err := provider.Exchange(t.Context(), code, callbackURL, "nonce-1", verifier)
+	require.NoError(t, err)
+	require.Empty(t, id.Groups)
+	require.Empty(t, provider.Rules().Assign(*id))
+}
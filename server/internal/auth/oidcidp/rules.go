@@ -0,0 +1,220 @@
+package oidcidp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/speakeasy-api/gram/server/internal/auth/orgslug"
+)
+
+// DefaultGroupsClaim is the claim groups are read from when the rules do not
+// name one. Okta, Keycloak (with a group mapper) and Entra ID all use it.
+const DefaultGroupsClaim = "groups"
+
+// UserProvisioning selects whether signing in can create a Gram account.
+type UserProvisioning string
+
+const (
+	// ProvisioningJIT creates an account the first time a user signs in.
+	ProvisioningJIT UserProvisioning = "jit"
+	// ProvisioningExisting signs in only users who already have an account
+	// with the same email address.
+	ProvisioningExisting UserProvisioning = "existing"
+)
+
+// Rules decide who may sign in and which organizations and roles they get.
+// They are applied again on every sign-in, so a group change at the issuer
+// takes effect the next time the user logs in.
+//
+//	groups_claim: groups
+//	organizations:
+//	  - slug: acme
+//	    name: Acme
+//	    email_domains: [acme.com]
+//	    roles:
+//	      - group: gram-admins
+//	        role: admin
+//	    default_role: member
+type Rules struct {
+	// GroupsClaim names the claim listing the user's groups. A dotted path
+	// reaches into a nested claim, as in Keycloak's realm_access.roles.
+	GroupsClaim string `yaml:"groups_claim"`
+	// Scopes are requested on top of openid, email and profile. Some issuers
+	// send groups only when a scope such as "groups" is asked for.
+	Scopes []string `yaml:"scopes"`
+	// AllowUnverifiedEmail accepts users whose email_verified claim is false
+	// or missing. Entra ID never sends it. Accounts are matched by email, so
+	// only enable this for an issuer that owns every address it vouches for.
+	AllowUnverifiedEmail bool `yaml:"allow_unverified_email"`
+	// UserProvisioning is "jit", the default, or "existing".
+	UserProvisioning UserProvisioning `yaml:"user_provisioning"`
+	// AllowUnassignedUsers lets users no organization rule admits sign in
+	// anyway, to create an organization of their own.
+	AllowUnassignedUsers bool `yaml:"allow_unassigned_users"`
+	// Organizations admit users to organizations, which are created the
+	// first time a rule admits someone to them.
+	Organizations []OrganizationRule `yaml:"organizations"`
+}
+
+// OrganizationRule admits users to one organization. It applies to a user
+// when every condition it sets holds: their email domain is one of
+// EmailDomains and they are in one of Groups. A rule with neither applies to
+// everyone.
+type OrganizationRule struct {
+	Slug         string   `yaml:"slug"`
+	Name         string   `yaml:"name"`
+	EmailDomains []string `yaml:"email_domains"`
+	Groups       []string `yaml:"groups"`
+	// Roles map groups to role slugs. The first mapping whose group the user
+	// is in wins, so list the most privileged first.
+	Roles []RoleMapping `yaml:"roles"`
+	// DefaultRole is the role of users the rule applies to but no mapping
+	// does. When empty, those users are not admitted.
+	DefaultRole string `yaml:"default_role"`
+}
+
+// RoleMapping gives members of an issuer group a Gram role.
+type RoleMapping struct {
+	Group string `yaml:"group"`
+	Role  string `yaml:"role"`
+}
+
+// Assignment places a user in an organization with a role.
+type Assignment struct {
+	OrganizationSlug string
+	OrganizationName string
+	Role             string
+}
+
+// LoadRules reads and validates a rules file.
+func LoadRules(path string) (*Rules, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("read oidc rules: %w", err)
+	}
+	return ParseRules(data)
+}
+
+// ParseRules parses and validates rules written as YAML. Unknown keys are
+// rejected so a misspelt condition cannot quietly admit everyone.
+func ParseRules(data []byte) (*Rules, error) {
+	var rules Rules
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&rules); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parse oidc rules: %w", err)
+	}
+
+	if err := rules.normalize(); err != nil {
+		return nil, fmt.Errorf("invalid oidc rules: %w", err)
+	}
+	return &rules, nil
+}
+
+func (r *Rules) normalize() error {
+	if r.GroupsClaim == "" {
+		r.GroupsClaim = DefaultGroupsClaim
+	}
+	for _, scope := range r.Scopes {
+		if scope == "" || strings.ContainsFunc(scope, unicode.IsSpace) {
+			return fmt.Errorf("scope %q must be a single non-empty word", scope)
+		}
+	}
+
+	switch r.UserProvisioning {
+	case "":
+		r.UserProvisioning = ProvisioningJIT
+	case ProvisioningJIT, ProvisioningExisting:
+	default:
+		return fmt.Errorf("user_provisioning must be %q or %q, not %q", ProvisioningJIT, ProvisioningExisting, r.UserProvisioning)
+	}
+
+	seen := make(map[string]struct{}, len(r.Organizations))
+	for i := range r.Organizations {
+		org := &r.Organizations[i]
+		if org.Slug == "" || orgslug.Slugify(org.Slug) != org.Slug {
+			return fmt.Errorf("organization %d: slug %q must be lowercase letters, digits and dashes", i, org.Slug)
+		}
+		if _, dup := seen[org.Slug]; dup {
+			return fmt.Errorf("organization %q is listed twice", org.Slug)
+		}
+		seen[org.Slug] = struct{}{}
+
+		if strings.TrimSpace(org.Name) == "" {
+			org.Name = org.Slug
+		}
+		for j, domain := range org.EmailDomains {
+			domain = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(domain), "@"))
+			if domain == "" {
+				return fmt.Errorf("organization %q: empty email domain", org.Slug)
+			}
+			org.EmailDomains[j] = domain
+		}
+		if slices.Contains(org.Groups, "") {
+			return fmt.Errorf("organization %q: empty group", org.Slug)
+		}
+		for _, mapping := range org.Roles {
+			if mapping.Group == "" || mapping.Role == "" {
+				return fmt.Errorf("organization %q: role mappings need both a group and a role", org.Slug)
+			}
+		}
+		if len(org.Roles) == 0 && org.DefaultRole == "" {
+			return fmt.Errorf("organization %q: set roles, default_role or both", org.Slug)
+		}
+	}
+
+	return nil
+}
+
+// Assign returns the organizations the rules admit a user to, in the order
+// the rules are listed.
+func (r *Rules) Assign(id Identity) []Assignment {
+	_, domain, _ := strings.Cut(strings.ToLower(id.Email), "@")
+
+	var assignments []Assignment
+	for _, org := range r.Organizations {
+		if len(org.EmailDomains) > 0 && !slices.Contains(org.EmailDomains, domain) {
+			continue
+		}
+		if len(org.Groups) > 0 && !slices.ContainsFunc(org.Groups, id.InGroup) {
+			continue
+		}
+
+		role := org.DefaultRole
+		for _, mapping := range org.Roles {
+			if id.InGroup(mapping.Group) {
+				role = mapping.Role
+				break
+			}
+		}
+		if role == "" {
+			continue
+		}
+
+		assignments = append(assignments, Assignment{
+			OrganizationSlug: org.Slug,
+			OrganizationName: org.Name,
+			Role:             role,
+		})
+	}
+	return assignments
+}
+
+// ManagedOrganizations returns the slug of every organization the rules
+// govern. Membership in these is granted and revoked by the rules alone.
+func (r *Rules) ManagedOrganizations() []string {
+	slugs := make([]string, 0, len(r.Organizations))
+	for _, org := range r.Organizations {
+		slugs = append(slugs, org.Slug)
+	}
+	return slugs
+}
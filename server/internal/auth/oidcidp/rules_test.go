@@ -0,0 +1,144 @@
+package oidcidp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/auth/oidcidp"
+)
+
+const acmeRules = `
+organizations:
+  - slug: acme
+    name: Acme
+    email_domains: ["@Acme.com"]
+    roles:
+      - group: gram-admins
+        role: admin
+      - group: engineering
+        role: member
+  - slug: acme-support
+    groups: [support]
+    default_role: member
+`
+
+func TestParseRules_Defaults(t *testing.T) {
+	t.Parallel()
+
+	rules, err := oidcidp.ParseRules([]byte(acmeRules))
+	require.NoError(t, err)
+	require.Equal(t, oidcidp.DefaultGroupsClaim, rules.GroupsClaim)
+	require.Equal(t, oidcidp.ProvisioningJIT, rules.UserProvisioning)
+	require.False(t, rules.AllowUnverifiedEmail)
+	require.False(t, rules.AllowUnassignedUsers)
+	require.Equal(t, []string{"acme.com"}, rules.Organizations[0].EmailDomains)
+	require.Equal(t, "acme-support", rules.Organizations[1].Name)
+	require.Equal(t, []string{"acme", "acme-support"}, rules.ManagedOrganizations())
+}
+
+func TestParseRules_Rejects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		rules string
+	}{
+		{name: "unknown key", rules: "organisations: []"},
+		{name: "unknown provisioning", rules: "user_provisioning: scim"},
+		{name: "scope with spaces", rules: "scopes: [\"groups offline_access\"]"},
+		{name: "missing slug", rules: "organizations: [{name: Acme, default_role: member}]"},
+		{name: "unslugged slug", rules: "organizations: [{slug: Acme Inc, default_role: member}]"},
+		{name: "duplicate slug", rules: "organizations: [{slug: acme, default_role: member}, {slug: acme, default_role: admin}]"},
+		{name: "no roles", rules: "organizations: [{slug: acme}]"},
+		{name: "role without group", rules: "organizations: [{slug: acme, roles: [{role: admin}]}]"},
+		{name: "empty group condition", rules: "organizations: [{slug: acme, groups: [\"\"], default_role: member}]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := oidcidp.ParseRules([]byte(tt.rules))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRules_Assign(t *testing.T) {
+	t.Parallel()
+
+	rules, err := oidcidp.ParseRules([]byte(acmeRules))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		email  string
+		groups []string
+		want   []oidcidp.Assignment
+	}{
+		{
+			name:   "first matching role wins",
+			email:  "ada@ACME.com",
+			groups: []string{"engineering", "gram-admins"},
+			want:   []oidcidp.Assignment{{OrganizationSlug: "acme", OrganizationName: "Acme", Role: "admin"}},
+		},
+		{
+			name:   "mapped group without default role",
+			email:  "bob@acme.com",
+			groups: []string{"engineering"},
+			want:   []oidcidp.Assignment{{OrganizationSlug: "acme", OrganizationName: "Acme", Role: "member"}},
+		},
+		{
+			name:   "no mapped group and no default role",
+			email:  "eve@acme.com",
+			groups: []string{"sales"},
+			want:   nil,
+		},
+		{
+			name:   "group outside the email domain",
+			email:  "mallory@example.com",
+			groups: []string{"gram-admins"},
+			want:   nil,
+		},
+		{
+			name:   "several organizations",
+			email:  "sam@acme.com",
+			groups: []string{"engineering", "support"},
+			want: []oidcidp.Assignment{
+				{OrganizationSlug: "acme", OrganizationName: "Acme", Role: "member"},
+				{OrganizationSlug: "acme-support", OrganizationName: "acme-support", Role: "member"},
+			},
+		},
+		{
+			name:   "group condition is case sensitive",
+			email:  "pat@example.com",
+			groups: []string{"Support"},
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := rules.Assign(oidcidp.Identity{
+				Issuer:        "https://issuer.example.com",
+				Subject:       "subject",
+				Email:         tt.email,
+				EmailVerified: true,
+				Name:          "",
+				Picture:       "",
+				Groups:        tt.groups,
+			})
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIdentity_UserKey(t *testing.T) {
+	t.Parallel()
+
+	id := oidcidp.Identity{Issuer: "https://a.example.com", Subject: "123"}
+	sameSubject := oidcidp.Identity{Issuer: "https://b.example.com", Subject: "123"}
+
+	require.Regexp(t, `^oidc_[0-9a-f]{32}$`, id.UserKey())
+	require.Equal(t, id.UserKey(), oidcidp.Identity{Issuer: "https://a.example.com", Subject: "123", Email: "changed@example.com"}.UserKey())
+	require.NotEqual(t, id.UserKey(), sameSubject.UserKey())
+}
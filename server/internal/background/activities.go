@@ -23,6 +23,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/assets"
 	"github.com/speakeasy-api/gram/server/internal/assistants"
 	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/auditexport"
 	"github.com/speakeasy-api/gram/server/internal/background/activities"
 	resolution_activities "github.com/speakeasy-api/gram/server/internal/background/activities/chat_resolutions"
 	"github.com/speakeasy-api/gram/server/internal/background/activities/outbox_relay"
@@ -42,6 +43,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/deviceintegrations"
 	"github.com/speakeasy-api/gram/server/internal/email"
 	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
 	"github.com/speakeasy-api/gram/server/internal/externalmcp"
 	"github.com/speakeasy-api/gram/server/internal/feature"
 	"github.com/speakeasy-api/gram/server/internal/functions"
@@ -74,11 +76,15 @@ import (
 	spendrulesch "github.com/speakeasy-api/gram/server/internal/spendrules/chrepo"
 	"github.com/speakeasy-api/gram/server/internal/telemetry"
 	telemetryrepo "github.com/speakeasy-api/gram/server/internal/telemetry/repo"
+	"github.com/speakeasy-api/gram/server/internal/telemetryalerts"
+	telemetryalertsch "github.com/speakeasy-api/gram/server/internal/telemetryalerts/chrepo"
 	tenv "github.com/speakeasy-api/gram/server/internal/temporal"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/openrouter"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/posthog"
 	slack_client "github.com/speakeasy-api/gram/server/internal/thirdparty/slack/client"
 	stripeclient "github.com/speakeasy-api/gram/server/internal/thirdparty/stripe"
+	"github.com/speakeasy-api/gram/server/internal/toolerrorspikes"
+	toolerrorspikesch "github.com/speakeasy-api/gram/server/internal/toolerrorspikes/chrepo"
 	"github.com/speakeasy-api/gram/server/internal/trialemails"
 )
 
@@ -129,6 +135,7 @@ type Activities struct {
 	setOpenRouterSpendCap           *activities.SetOpenRouterSpendCap
 	reconcilePaygOpenRouterChatKey  *activities.ReconcilePaygOpenRouterChatKey
 	transitionDeployment            *activities.TransitionDeployment
+	checkBreakingChanges            *activities.CheckBreakingChanges
 	validateDeployment              *activities.ValidateDeployment
 	verifyCustomDomain              *activities.VerifyCustomDomain
 	generateToolsetEmbeddings       *activities.GenerateToolsetEmbeddings
@@ -165,6 +172,10 @@ type Activities struct {
 	pluginPublisher                 *activities.PluginPublisher
 	listSpendRuleOrgs               *spend_rules.ListOrgs
 	evaluateOrgSpendRules           *spend_rules.EvaluateOrg
+	toolErrorSpikeDetector          *toolerrorspikes.Detector
+	telemetryAlertEvaluator         *telemetryalerts.Evaluator
+	telemetryAlertNotifier          *telemetryalerts.Notifier
+	auditLogExporter                *auditexport.Exporter
 	skillEfficacyScorer             *activities.SkillEfficacyScorer
 	skillSuggestionAnalyzer         *activities.SkillSuggestionAnalyzer
 	chatAnalysisScorer              *activities.ChatAnalysisScorer
@@ -173,6 +184,7 @@ type Activities struct {
 	trialEmails                     *trialemails.Service
 	mcpResearch                     *activities.McpResearch
 	mcpApprovalRecheck              *activities.McpApprovalRecheck
+	reencryptSecrets                *activities.ReencryptSecrets
 	billingNotifications            *billingnotifications.Service
 }
 
@@ -183,6 +195,7 @@ func NewActivities(
 	guardianPolicy *guardian.Policy,
 	db *pgxpool.Pool,
 	encryption *encryption.Client,
+	orgKeys *envelope.Keyring,
 	features feature.Provider,
 	assetStorage assets.BlobStore,
 	slackClient *slack_client.SlackClient,
@@ -191,6 +204,7 @@ func NewActivities(
 	chatClient *chat.Client,
 	k8sClient *k8s.KubernetesClients,
 	expectedTargetCNAME string,
+	auditExportStorage assets.BlobStore,
 	siteURL *url.URL,
 	billingTracker billing.Tracker,
 	billingRepo billing.Repository,
@@ -234,6 +248,20 @@ func NewActivities(
 		spendRulesCH = spendrulesch.New(chConn)
 	}
 
+	// Spike detection has nothing to read without ClickHouse; the sweep is a
+	// no-op on such workers.
+	var toolErrorSpikesCH *toolerrorspikesch.Queries
+	if chConn != nil {
+		toolErrorSpikesCH = toolerrorspikesch.New(chConn)
+	}
+
+	// Likewise, alert rules are left untouched by sweeps on workers without
+	// ClickHouse.
+	var telemetryAlertsCH *telemetryalertsch.Queries
+	if chConn != nil {
+		telemetryAlertsCH = telemetryalertsch.New(chConn)
+	}
+
 	// The exclusion reconcile propagates flag changes into ClickHouse;
 	// workers without a ClickHouse connection — or with the kill switch set —
 	// get a nil repo and the activity degrades to its Postgres phases with a
@@ -301,7 +329,7 @@ func NewActivities(
 		remoteSessionRefresh = activities.NewRemoteSessionRefresh(
 			logger,
 			db,
-			remotesessions.NewRefreshService(logger, db, encryption, guardianPolicy, cacheAdapter),
+			remotesessions.NewRefreshService(logger, db, encryption, orgKeys, guardianPolicy, cacheAdapter),
 		)
 	}
 
@@ -389,6 +417,7 @@ func NewActivities(
 		setOpenRouterSpendCap:           activities.NewSetOpenRouterSpendCap(logger, db, openrouterProvisioner, auditLogger, cacheAdapter),
 		reconcilePaygOpenRouterChatKey:  activities.NewReconcilePaygOpenRouterChatKey(logger, db, openrouterProvisioner),
 		transitionDeployment:            activities.NewTransitionDeployment(logger, db),
+		checkBreakingChanges:            activities.NewCheckBreakingChanges(logger, db),
 		validateDeployment:              activities.NewValidateDeployment(logger, db, billingRepo),
 		verifyCustomDomain:              activities.NewVerifyCustomDomain(logger, db, auditLogger, expectedTargetCNAME),
 		generateToolsetEmbeddings:       activities.NewGenerateToolsetEmbeddingsActivity(tracerProvider, db, ragService, logger),
@@ -432,7 +461,11 @@ func NewActivities(
 			&TemporalTrialEmailNotifier{TemporalEnv: temporalEnv},
 			productFeatures,
 		),
-		evaluateOrgSpendRules: spend_rules.NewEvaluateOrg(logger, tracerProvider, db, spendRulesCH, cacheAdapter, features),
+		evaluateOrgSpendRules:   spend_rules.NewEvaluateOrg(logger, tracerProvider, db, spendRulesCH, cacheAdapter, features),
+		toolErrorSpikeDetector:  toolerrorspikes.NewDetector(logger, db, toolErrorSpikesCH),
+		telemetryAlertEvaluator: telemetryalerts.NewEvaluator(logger, db, telemetryAlertsCH),
+		telemetryAlertNotifier:  telemetryalerts.NewNotifier(emailService, siteURL),
+		auditLogExporter:        auditexport.NewExporter(logger, tracerProvider, db, auditExportStorage),
 		// The judge draws on the same per-(org, model) bucket and the same
 		// completion client as every other platform judge, so efficacy scoring
 		// cannot outspend the org's key behind their backs.
@@ -449,6 +482,7 @@ func NewActivities(
 		trialEmails:             trialEmailsService,
 		mcpResearch:             mcpResearch,
 		mcpApprovalRecheck:      mcpApprovalRecheck,
+		reencryptSecrets:        activities.NewReencryptSecrets(logger, db, encryption),
 		billingNotifications:    billingnotifications.NewService(logger, db, emailService, features, siteURL),
 		// The judges draw on the same per-(org, model) bucket and the same
 		// completion client as every other platform judge, so chat analysis
@@ -707,6 +741,10 @@ func (a *Activities) DeployFunctionRunners(ctx context.Context, req activities.D
 	return a.deployFunctionRunners.Do(ctx, req)
 }
 
+func (a *Activities) CheckBreakingChanges(ctx context.Context, projectID uuid.UUID, deploymentID uuid.UUID) (*activities.CheckBreakingChangesResult, error) {
+	return a.checkBreakingChanges.Do(ctx, projectID, deploymentID)
+}
+
 func (a *Activities) ValidateDeployment(ctx context.Context, projectID uuid.UUID, deploymentID uuid.UUID) error {
 	return a.validateDeployment.Do(ctx, projectID, deploymentID)
 }
@@ -966,6 +1004,37 @@ func (a *Activities) EvaluateOrgSpendRules(ctx context.Context, args spend_rules
 	return nil
 }
 
+func (a *Activities) DetectToolErrorSpikes(ctx context.Context) (toolerrorspikes.DetectResult, error) {
+	result, err := a.toolErrorSpikeDetector.Detect(ctx, time.Now())
+	if err != nil {
+		return result, fmt.Errorf("detect tool error spikes: %w", err)
+	}
+	return result, nil
+}
+
+func (a *Activities) EvaluateTelemetryAlerts(ctx context.Context, now time.Time) (telemetryalerts.EvaluateResult, error) {
+	result, err := a.telemetryAlertEvaluator.Evaluate(ctx, now)
+	if err != nil {
+		return result, fmt.Errorf("evaluate telemetry alerts: %w", err)
+	}
+	return result, nil
+}
+
+func (a *Activities) NotifyTelemetryAlert(ctx context.Context, transition telemetryalerts.Transition) error {
+	if err := a.telemetryAlertNotifier.Notify(ctx, transition); err != nil {
+		return fmt.Errorf("notify telemetry alert: %w", err)
+	}
+	return nil
+}
+
+func (a *Activities) ExportAuditLogs(ctx context.Context) (auditexport.ExportResult, error) {
+	result, err := a.auditLogExporter.Export(ctx)
+	if err != nil {
+		return result, fmt.Errorf("export audit logs: %w", err)
+	}
+	return result, nil
+}
+
 func (a *Activities) RefreshSpendRuleActor(ctx context.Context, args spend_rules.EvaluateActorArgs) error {
 	if err := a.evaluateOrgSpendRules.RefreshActor(ctx, args); err != nil {
 		return fmt.Errorf("refresh spend rule actor: %w", err)
@@ -1062,3 +1131,22 @@ func (a *Activities) RecheckMcpApprovalRequest(ctx context.Context, target activ
 	}
 	return nil
 }
+
+// ReencryptSecretsBatch re-encrypts one batch of a key rotation target under
+// the primary encryption key.
+func (a *Activities) ReencryptSecretsBatch(ctx context.Context, input activities.ReencryptBatchInput) (*activities.ReencryptBatchResult, error) {
+	result, err := a.reencryptSecrets.Do(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("re-encrypt secrets batch: %w", err)
+	}
+	return result, nil
+}
+
+// MarkEncryptionKeysReencrypted records that a key rotation drained every
+// key other than the primary one.
+func (a *Activities) MarkEncryptionKeysReencrypted(ctx context.Context, input activities.MarkKeysReencryptedInput) error {
+	if err := a.reencryptSecrets.MarkKeysReencrypted(ctx, input); err != nil {
+		return fmt.Errorf("mark encryption keys re-encrypted: %w", err)
+	}
+	return nil
+}
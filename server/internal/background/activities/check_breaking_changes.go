@@ -0,0 +1,87 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/deployments/diff"
+	"github.com/speakeasy-api/gram/server/internal/deployments/repo"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+// CheckBreakingChanges compares a processed deployment with the project's
+// active deployment so a deployment that would break existing callers can be
+// failed before it becomes active.
+type CheckBreakingChanges struct {
+	logger *slog.Logger
+	db     *pgxpool.Pool
+}
+
+func NewCheckBreakingChanges(logger *slog.Logger, db *pgxpool.Pool) *CheckBreakingChanges {
+	return &CheckBreakingChanges{
+		logger: logger,
+		db:     db,
+	}
+}
+
+type CheckBreakingChangesResult struct {
+	// ActiveDeploymentID is the deployment compared against. It is uuid.Nil
+	// when the project has no active deployment yet.
+	ActiveDeploymentID uuid.UUID
+	BreakingChanges    int
+}
+
+func (c *CheckBreakingChanges) Do(ctx context.Context, projectID uuid.UUID, deploymentID uuid.UUID) (*CheckBreakingChangesResult, error) {
+	logger := c.logger.With(attr.SlogProjectID(projectID.String()), attr.SlogDeploymentID(deploymentID.String()))
+	queries := repo.New(c.db)
+
+	activeID, err := queries.GetActiveDeploymentID(ctx, projectID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return &CheckBreakingChangesResult{ActiveDeploymentID: uuid.Nil, BreakingChanges: 0}, nil
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "error getting active deployment").LogError(ctx, logger)
+	}
+
+	fromTools, err := diff.LoadTools(ctx, queries, activeID)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error loading active deployment tools").LogError(ctx, logger)
+	}
+	toTools, err := diff.LoadTools(ctx, queries, deploymentID)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error loading deployment tools").LogError(ctx, logger)
+	}
+	report, err := diff.Compare(fromTools, toTools)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error comparing deployments").LogError(ctx, logger)
+	}
+
+	result := &CheckBreakingChangesResult{
+		ActiveDeploymentID: activeID,
+		BreakingChanges:    report.BreakingChanges(),
+	}
+	if result.BreakingChanges == 0 {
+		return result, nil
+	}
+
+	if err := queries.LogDeploymentEvent(ctx, repo.LogDeploymentEventParams{
+		DeploymentID:   deploymentID,
+		ProjectID:      projectID,
+		Event:          "log:error",
+		Message:        fmt.Sprintf("Deployment introduces %d breaking changes compared with active deployment %s and was not activated", result.BreakingChanges, activeID),
+		AttachmentID:   uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		AttachmentType: conv.ToPGTextEmpty(""),
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error logging breaking changes").LogError(ctx, logger)
+	}
+
+	return result, nil
+}
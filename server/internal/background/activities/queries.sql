@@ -1109,3 +1109,259 @@ WHERE NOT EXISTS (
       AND dd.email_lower = uao.email_lower
 )
 ORDER BY organization_id, email_lower;
+
+-- name: ListEnvironmentEntriesForReencryption :many
+-- Key rotation re-encrypts stored ciphertexts in keyset-paginated batches.
+-- The list queries return only rows holding a ciphertext not yet under
+-- key_prefix, the primary key's "<id>:" prefix; the updates swap a ciphertext
+-- only while it still holds the value that was read, so a concurrent write is
+-- never overwritten.
+SELECT environment_id, name, value
+FROM environment_entries
+WHERE is_secret
+  AND NOT starts_with(value, @key_prefix::text)
+  AND (environment_id, name) > (@after_environment_id::uuid, @after_name::text)
+ORDER BY environment_id, name
+LIMIT @limit_value;
+
+-- name: ReencryptEnvironmentEntry :execrows
+UPDATE environment_entries
+SET value = @new_value
+WHERE environment_id = @environment_id
+  AND name = @name
+  AND value = @old_value;
+
+-- name: ListExternalOAuthClientSecretsForReencryption :many
+SELECT id, client_secret_encrypted::text AS ciphertext
+FROM external_oauth_client_registrations
+WHERE client_secret_encrypted IS NOT NULL
+  AND NOT starts_with(client_secret_encrypted, @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptExternalOAuthClientSecret :execrows
+UPDATE external_oauth_client_registrations
+SET client_secret_encrypted = @new_value
+WHERE id = @id
+  AND client_secret_encrypted = @old_value;
+
+-- name: ListUserOAuthTokensForReencryption :many
+SELECT id, access_token_encrypted, refresh_token_encrypted
+FROM user_oauth_tokens
+WHERE (
+    NOT starts_with(access_token_encrypted, @key_prefix::text)
+    OR NOT starts_with(COALESCE(refresh_token_encrypted, @key_prefix::text), @key_prefix::text)
+  )
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptUserOAuthToken :execrows
+UPDATE user_oauth_tokens
+SET access_token_encrypted = @new_access_token,
+    refresh_token_encrypted = sqlc.narg(new_refresh_token)
+WHERE id = @id
+  AND access_token_encrypted = @old_access_token
+  AND refresh_token_encrypted IS NOT DISTINCT FROM sqlc.narg(old_refresh_token);
+
+-- name: ListRemoteSessionClientSecretsForReencryption :many
+SELECT id, client_secret_encrypted::text AS ciphertext
+FROM remote_session_clients
+WHERE client_secret_encrypted IS NOT NULL
+  AND NOT starts_with(client_secret_encrypted, @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptRemoteSessionClientSecret :execrows
+UPDATE remote_session_clients
+SET client_secret_encrypted = @new_value
+WHERE id = @id
+  AND client_secret_encrypted = @old_value;
+
+-- name: ListRemoteSessionTokensForReencryption :many
+SELECT id, access_token_encrypted, refresh_token_encrypted
+FROM remote_sessions
+WHERE (
+    NOT starts_with(access_token_encrypted, @key_prefix::text)
+    OR NOT starts_with(COALESCE(refresh_token_encrypted, @key_prefix::text), @key_prefix::text)
+  )
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptRemoteSessionToken :execrows
+UPDATE remote_sessions
+SET access_token_encrypted = @new_access_token,
+    refresh_token_encrypted = sqlc.narg(new_refresh_token)
+WHERE id = @id
+  AND access_token_encrypted = @old_access_token
+  AND refresh_token_encrypted IS NOT DISTINCT FROM sqlc.narg(old_refresh_token);
+
+-- name: ListAIIntegrationAPIKeysForReencryption :many
+SELECT id, api_key_encrypted::text AS ciphertext
+FROM ai_integration_configs
+WHERE api_key_encrypted <> ''
+  AND NOT starts_with(api_key_encrypted, @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptAIIntegrationAPIKey :execrows
+UPDATE ai_integration_configs
+SET api_key_encrypted = @new_value::text
+WHERE id = @id
+  AND api_key_encrypted = @old_value::text;
+
+-- name: ListAssistantMCPOAuthClientSecretsForReencryption :many
+SELECT id, client_secret_encrypted::text AS ciphertext
+FROM assistant_mcp_oauth_clients
+WHERE client_secret_encrypted <> ''
+  AND NOT starts_with(client_secret_encrypted, @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptAssistantMCPOAuthClientSecret :execrows
+UPDATE assistant_mcp_oauth_clients
+SET client_secret_encrypted = @new_value::text
+WHERE id = @id
+  AND client_secret_encrypted = @old_value::text;
+
+-- name: ListDeviceIntegrationCredentialsForReencryption :many
+SELECT id, credentials_encrypted::text AS ciphertext
+FROM device_integration_configs
+WHERE credentials_encrypted <> ''
+  AND NOT starts_with(credentials_encrypted, @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptDeviceIntegrationCredentials :execrows
+UPDATE device_integration_configs
+SET credentials_encrypted = @new_value::text
+WHERE id = @id
+  AND credentials_encrypted = @old_value::text;
+
+-- name: ListFunctionsAccessKeysForReencryption :many
+SELECT id, convert_from(encryption_key, 'UTF8')::text AS ciphertext
+FROM functions_access
+WHERE encryption_key IS NOT NULL
+  AND NOT starts_with(convert_from(encryption_key, 'UTF8'), @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptFunctionsAccessKey :execrows
+UPDATE functions_access
+SET encryption_key = convert_to(@new_value::text, 'UTF8')
+WHERE id = @id
+  AND encryption_key = convert_to(@old_value::text, 'UTF8');
+
+-- name: ListModelProviderKeysForReencryption :many
+SELECT id, api_key_encrypted::text AS ciphertext
+FROM model_provider_keys
+WHERE api_key_encrypted <> ''
+  AND NOT starts_with(api_key_encrypted, @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptModelProviderKey :execrows
+UPDATE model_provider_keys
+SET api_key_encrypted = @new_value::text
+WHERE id = @id
+  AND api_key_encrypted = @old_value::text;
+
+-- name: ListOpenRouterKeysForReencryption :many
+SELECT organization_id, key_type, key_encrypted::text AS ciphertext
+FROM openrouter_api_keys
+WHERE key_encrypted <> ''
+  AND NOT starts_with(key_encrypted, @key_prefix::text)
+  AND (organization_id, key_type) > (@after_organization_id::text, @after_key_type::text)
+ORDER BY organization_id, key_type
+LIMIT @limit_value;
+
+-- name: ReencryptOpenRouterKey :execrows
+UPDATE openrouter_api_keys
+SET key_encrypted = @new_value::text
+WHERE organization_id = @organization_id
+  AND key_type = @key_type
+  AND key_encrypted = @old_value::text;
+
+-- name: ListOTelForwardingConfigHeadersForReencryption :many
+SELECT id, headers_encrypted::text AS ciphertext
+FROM otel_forwarding_configs
+WHERE headers_encrypted <> ''
+  AND NOT starts_with(headers_encrypted, @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptOTelForwardingConfigHeaders :execrows
+UPDATE otel_forwarding_configs
+SET headers_encrypted = @new_value::text
+WHERE id = @id
+  AND headers_encrypted = @old_value::text;
+
+-- name: ListOTelForwardingDestinationHeadersForReencryption :many
+SELECT id, headers_encrypted::text AS ciphertext
+FROM otel_forwarding_destinations
+WHERE headers_encrypted <> ''
+  AND NOT starts_with(headers_encrypted, @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptOTelForwardingDestinationHeaders :execrows
+UPDATE otel_forwarding_destinations
+SET headers_encrypted = @new_value::text
+WHERE id = @id
+  AND headers_encrypted = @old_value::text;
+
+-- name: ListRemoteMCPServerHeadersForReencryption :many
+SELECT id, value::text AS ciphertext
+FROM remote_mcp_server_headers
+WHERE is_secret
+  AND value <> ''
+  AND NOT starts_with(value, @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptRemoteMCPServerHeader :execrows
+UPDATE remote_mcp_server_headers
+SET value = @new_value::text
+WHERE id = @id
+  AND value = @old_value::text;
+
+-- name: ListToolApprovalSlackWebhooksForReencryption :many
+SELECT id, slack_webhook_url_encrypted::text AS ciphertext
+FROM tool_approval_policies
+WHERE slack_webhook_url_encrypted <> ''
+  AND NOT starts_with(slack_webhook_url_encrypted, @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptToolApprovalSlackWebhook :execrows
+UPDATE tool_approval_policies
+SET slack_webhook_url_encrypted = @new_value::text
+WHERE id = @id
+  AND slack_webhook_url_encrypted = @old_value::text;
+
+-- name: ListWebhookEndpointSecretsForReencryption :many
+SELECT id, secret_encrypted::text AS ciphertext
+FROM webhook_endpoints
+WHERE secret_encrypted <> ''
+  AND NOT starts_with(secret_encrypted, @key_prefix::text)
+  AND id > @after_id
+ORDER BY id
+LIMIT @limit_value;
+
+-- name: ReencryptWebhookEndpointSecret :execrows
+UPDATE webhook_endpoints
+SET secret_encrypted = @new_value::text
+WHERE id = @id
+  AND secret_encrypted = @old_value::text;
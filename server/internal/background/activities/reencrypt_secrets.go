@@ -0,0 +1,691 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.temporal.io/sdk/temporal"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/background/activities/repo"
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
+	"github.com/speakeasy-api/gram/server/internal/encryption/keyregistry"
+)
+
+// ReencryptTarget names a set of stored ciphertexts the key rotation
+// workflow re-encrypts under the primary key.
+type ReencryptTarget string
+
+const (
+	ReencryptTargetEnvironmentEntries           ReencryptTarget = "environment_entries"
+	ReencryptTargetExternalOAuthClientSecret    ReencryptTarget = "external_oauth_client_secrets"
+	ReencryptTargetUserOAuthTokens              ReencryptTarget = "user_oauth_tokens"
+	ReencryptTargetRemoteSessionClientSecret    ReencryptTarget = "remote_session_client_secrets"
+	ReencryptTargetRemoteSessionTokens          ReencryptTarget = "remote_session_tokens"
+	ReencryptTargetRemoteMCPServerHeaders       ReencryptTarget = "remote_mcp_server_headers"
+	ReencryptTargetAssistantMCPClientSecret     ReencryptTarget = "assistant_mcp_oauth_client_secrets"
+	ReencryptTargetFunctionsAccessKeys          ReencryptTarget = "functions_access_keys"
+	ReencryptTargetModelProviderKeys            ReencryptTarget = "model_provider_keys"
+	ReencryptTargetOpenRouterKeys               ReencryptTarget = "openrouter_api_keys"
+	ReencryptTargetAIIntegrationAPIKeys         ReencryptTarget = "ai_integration_api_keys"
+	ReencryptTargetDeviceIntegrationCredentials ReencryptTarget = "device_integration_credentials"
+	ReencryptTargetOTelForwardingConfigs        ReencryptTarget = "otel_forwarding_config_headers"
+	ReencryptTargetOTelForwardingDestinations   ReencryptTarget = "otel_forwarding_destination_headers"
+	ReencryptTargetToolApprovalSlackWebhooks    ReencryptTarget = "tool_approval_slack_webhooks"
+	ReencryptTargetWebhookEndpointSecrets       ReencryptTarget = "webhook_endpoint_secrets"
+)
+
+// ReencryptTargets lists every target in the order the rotation visits them.
+// Every column written with encryption.Client.Encrypt must have a target
+// here: a previous key can only be dropped from the keyring once a rotation
+// has swept all of them (see keyregistry).
+var ReencryptTargets = []ReencryptTarget{
+	ReencryptTargetEnvironmentEntries,
+	ReencryptTargetExternalOAuthClientSecret,
+	ReencryptTargetUserOAuthTokens,
+	ReencryptTargetRemoteSessionClientSecret,
+	ReencryptTargetRemoteSessionTokens,
+	ReencryptTargetRemoteMCPServerHeaders,
+	ReencryptTargetAssistantMCPClientSecret,
+	ReencryptTargetFunctionsAccessKeys,
+	ReencryptTargetModelProviderKeys,
+	ReencryptTargetOpenRouterKeys,
+	ReencryptTargetAIIntegrationAPIKeys,
+	ReencryptTargetDeviceIntegrationCredentials,
+	ReencryptTargetOTelForwardingConfigs,
+	ReencryptTargetOTelForwardingDestinations,
+	ReencryptTargetToolApprovalSlackWebhooks,
+	ReencryptTargetWebhookEndpointSecrets,
+}
+
+// ErrTypeEncryptionKeyMismatch marks a batch picked up by a worker whose
+// keyring has a different primary key than the rotation it belongs to, which
+// happens while a rolling deploy is still replacing old replicas.
+const ErrTypeEncryptionKeyMismatch = "encryption_key_mismatch"
+
+// ReencryptCursor is the keyset position of a target's scan. Environment
+// entries are keyed by (environment, name), OpenRouter keys by (organization,
+// key type) with the key type in AfterName; every other target by ID.
+type ReencryptCursor struct {
+	AfterID             uuid.UUID
+	AfterOrganizationID string
+	AfterName           string
+}
+
+type ReencryptBatchInput struct {
+	// PrimaryKeyID is the key the rotation re-encrypts under. A worker whose
+	// primary key differs refuses the batch rather than writing under the
+	// wrong key.
+	PrimaryKeyID string
+	Target       ReencryptTarget
+	Cursor       ReencryptCursor
+	Limit        int32
+}
+
+type ReencryptBatchResult struct {
+	Scanned int
+	Rotated int
+	// Conflicts counts rows whose ciphertext changed between the read and
+	// the swap. A concurrent write has already replaced the value, under the
+	// primary key unless it came from a replica on the old configuration; a
+	// later rotation run picks those up.
+	Conflicts int
+	// Failed counts ciphertexts no key in the keyring can decrypt. They are
+	// left untouched and logged by row.
+	Failed int
+	Next   ReencryptCursor
+	Done   bool
+}
+
+// ReencryptSecrets re-encrypts stored secrets under the primary encryption
+// key, one keyset-paginated batch at a time.
+type ReencryptSecrets struct {
+	logger *slog.Logger
+	db     *pgxpool.Pool
+	enc    *encryption.Client
+}
+
+func NewReencryptSecrets(logger *slog.Logger, db *pgxpool.Pool, enc *encryption.Client) *ReencryptSecrets {
+	return &ReencryptSecrets{
+		logger: logger.With(attr.SlogComponent("reencrypt-secrets")),
+		db:     db,
+		enc:    enc,
+	}
+}
+
+func (r *ReencryptSecrets) Do(ctx context.Context, input ReencryptBatchInput) (*ReencryptBatchResult, error) {
+	if got := r.enc.PrimaryKeyID(); got == "" || got != input.PrimaryKeyID {
+		// Retryable: the retry most likely lands on an updated replica.
+		return nil, temporal.NewApplicationError(
+			fmt.Sprintf("worker primary encryption key is %q, rotation targets %q", got, input.PrimaryKeyID),
+			ErrTypeEncryptionKeyMismatch,
+		)
+	}
+
+	prefix := input.PrimaryKeyID + ":"
+	queries := repo.New(r.db)
+	result := &ReencryptBatchResult{Scanned: 0, Rotated: 0, Conflicts: 0, Failed: 0, Next: input.Cursor, Done: false}
+
+	var err error
+	switch input.Target {
+	case ReencryptTargetEnvironmentEntries:
+		err = r.environmentEntries(ctx, queries, prefix, input, result)
+	case ReencryptTargetExternalOAuthClientSecret:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListExternalOAuthClientSecretsForReencryption(ctx, repo.ListExternalOAuthClientSecretsForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptExternalOAuthClientSecret(ctx, repo.ReencryptExternalOAuthClientSecretParams{
+					NewValue: pgtype.Text{String: newValue, Valid: true},
+					ID:       row.id,
+					OldValue: pgtype.Text{String: row.ciphertext, Valid: true},
+				})
+			},
+		)
+	case ReencryptTargetRemoteSessionClientSecret:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListRemoteSessionClientSecretsForReencryption(ctx, repo.ListRemoteSessionClientSecretsForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptRemoteSessionClientSecret(ctx, repo.ReencryptRemoteSessionClientSecretParams{
+					NewValue: pgtype.Text{String: newValue, Valid: true},
+					ID:       row.id,
+					OldValue: pgtype.Text{String: row.ciphertext, Valid: true},
+				})
+			},
+		)
+	case ReencryptTargetUserOAuthTokens:
+		err = r.tokenPairs(ctx, input, result,
+			func() ([]tokenPairRow, error) {
+				rows, err := queries.ListUserOAuthTokensForReencryption(ctx, repo.ListUserOAuthTokensForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]tokenPairRow, len(rows))
+				for i, row := range rows {
+					out[i] = tokenPairRow{id: row.ID, access: row.AccessTokenEncrypted, refresh: row.RefreshTokenEncrypted}
+				}
+				return out, err
+			},
+			func(row tokenPairRow, access string, refresh pgtype.Text) (int64, error) {
+				return queries.ReencryptUserOAuthToken(ctx, repo.ReencryptUserOAuthTokenParams{
+					NewAccessToken:  access,
+					NewRefreshToken: refresh,
+					ID:              row.id,
+					OldAccessToken:  row.access,
+					OldRefreshToken: row.refresh,
+				})
+			},
+		)
+	case ReencryptTargetRemoteSessionTokens:
+		err = r.tokenPairs(ctx, input, result,
+			func() ([]tokenPairRow, error) {
+				rows, err := queries.ListRemoteSessionTokensForReencryption(ctx, repo.ListRemoteSessionTokensForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]tokenPairRow, len(rows))
+				for i, row := range rows {
+					out[i] = tokenPairRow{id: row.ID, access: row.AccessTokenEncrypted, refresh: row.RefreshTokenEncrypted}
+				}
+				return out, err
+			},
+			func(row tokenPairRow, access string, refresh pgtype.Text) (int64, error) {
+				return queries.ReencryptRemoteSessionToken(ctx, repo.ReencryptRemoteSessionTokenParams{
+					NewAccessToken:  access,
+					NewRefreshToken: refresh,
+					ID:              row.id,
+					OldAccessToken:  row.access,
+					OldRefreshToken: row.refresh,
+				})
+			},
+		)
+	case ReencryptTargetRemoteMCPServerHeaders:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListRemoteMCPServerHeadersForReencryption(ctx, repo.ListRemoteMCPServerHeadersForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptRemoteMCPServerHeader(ctx, repo.ReencryptRemoteMCPServerHeaderParams{
+					NewValue: newValue,
+					ID:       row.id,
+					OldValue: row.ciphertext,
+				})
+			},
+		)
+	case ReencryptTargetAssistantMCPClientSecret:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListAssistantMCPOAuthClientSecretsForReencryption(ctx, repo.ListAssistantMCPOAuthClientSecretsForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptAssistantMCPOAuthClientSecret(ctx, repo.ReencryptAssistantMCPOAuthClientSecretParams{
+					NewValue: newValue,
+					ID:       row.id,
+					OldValue: row.ciphertext,
+				})
+			},
+		)
+	case ReencryptTargetFunctionsAccessKeys:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListFunctionsAccessKeysForReencryption(ctx, repo.ListFunctionsAccessKeysForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptFunctionsAccessKey(ctx, repo.ReencryptFunctionsAccessKeyParams{
+					NewValue: newValue,
+					ID:       row.id,
+					OldValue: row.ciphertext,
+				})
+			},
+		)
+	case ReencryptTargetModelProviderKeys:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListModelProviderKeysForReencryption(ctx, repo.ListModelProviderKeysForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptModelProviderKey(ctx, repo.ReencryptModelProviderKeyParams{
+					NewValue: newValue,
+					ID:       row.id,
+					OldValue: row.ciphertext,
+				})
+			},
+		)
+	case ReencryptTargetOpenRouterKeys:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListOpenRouterKeysForReencryption(ctx, repo.ListOpenRouterKeysForReencryptionParams{
+					KeyPrefix:           prefix,
+					AfterOrganizationID: input.Cursor.AfterOrganizationID,
+					AfterKeyType:        input.Cursor.AfterName,
+					LimitValue:          input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = secretRow{
+						id:         uuid.Nil,
+						ref:        row.OrganizationID + "/" + row.KeyType,
+						next:       ReencryptCursor{AfterID: uuid.Nil, AfterOrganizationID: row.OrganizationID, AfterName: row.KeyType},
+						ciphertext: row.Ciphertext,
+					}
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptOpenRouterKey(ctx, repo.ReencryptOpenRouterKeyParams{
+					NewValue:       newValue,
+					OrganizationID: row.next.AfterOrganizationID,
+					KeyType:        row.next.AfterName,
+					OldValue:       row.ciphertext,
+				})
+			},
+		)
+	case ReencryptTargetAIIntegrationAPIKeys:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListAIIntegrationAPIKeysForReencryption(ctx, repo.ListAIIntegrationAPIKeysForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptAIIntegrationAPIKey(ctx, repo.ReencryptAIIntegrationAPIKeyParams{
+					NewValue: newValue,
+					ID:       row.id,
+					OldValue: row.ciphertext,
+				})
+			},
+		)
+	case ReencryptTargetDeviceIntegrationCredentials:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListDeviceIntegrationCredentialsForReencryption(ctx, repo.ListDeviceIntegrationCredentialsForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptDeviceIntegrationCredentials(ctx, repo.ReencryptDeviceIntegrationCredentialsParams{
+					NewValue: newValue,
+					ID:       row.id,
+					OldValue: row.ciphertext,
+				})
+			},
+		)
+	case ReencryptTargetOTelForwardingConfigs:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListOTelForwardingConfigHeadersForReencryption(ctx, repo.ListOTelForwardingConfigHeadersForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptOTelForwardingConfigHeaders(ctx, repo.ReencryptOTelForwardingConfigHeadersParams{
+					NewValue: newValue,
+					ID:       row.id,
+					OldValue: row.ciphertext,
+				})
+			},
+		)
+	case ReencryptTargetOTelForwardingDestinations:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListOTelForwardingDestinationHeadersForReencryption(ctx, repo.ListOTelForwardingDestinationHeadersForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptOTelForwardingDestinationHeaders(ctx, repo.ReencryptOTelForwardingDestinationHeadersParams{
+					NewValue: newValue,
+					ID:       row.id,
+					OldValue: row.ciphertext,
+				})
+			},
+		)
+	case ReencryptTargetToolApprovalSlackWebhooks:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListToolApprovalSlackWebhooksForReencryption(ctx, repo.ListToolApprovalSlackWebhooksForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptToolApprovalSlackWebhook(ctx, repo.ReencryptToolApprovalSlackWebhookParams{
+					NewValue: newValue,
+					ID:       row.id,
+					OldValue: row.ciphertext,
+				})
+			},
+		)
+	case ReencryptTargetWebhookEndpointSecrets:
+		err = r.secrets(ctx, input, result,
+			func() ([]secretRow, error) {
+				rows, err := queries.ListWebhookEndpointSecretsForReencryption(ctx, repo.ListWebhookEndpointSecretsForReencryptionParams{
+					KeyPrefix:  prefix,
+					AfterID:    input.Cursor.AfterID,
+					LimitValue: input.Limit,
+				})
+				out := make([]secretRow, len(rows))
+				for i, row := range rows {
+					out[i] = idSecretRow(row.ID, row.Ciphertext)
+				}
+				return out, err
+			},
+			func(row secretRow, newValue string) (int64, error) {
+				return queries.ReencryptWebhookEndpointSecret(ctx, repo.ReencryptWebhookEndpointSecretParams{
+					NewValue: newValue,
+					ID:       row.id,
+					OldValue: row.ciphertext,
+				})
+			},
+		)
+	default:
+		return nil, fmt.Errorf("unknown re-encryption target: %q", input.Target)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("re-encrypt %s: %w", input.Target, err)
+	}
+
+	return result, nil
+}
+
+type MarkKeysReencryptedInput struct {
+	PrimaryKeyID string
+	// SweptSince is when the rotation run that drained the other keys
+	// started.
+	SweptSince time.Time
+}
+
+// MarkKeysReencrypted records in the key registry that a rotation drained
+// every key other than the primary one, which is what allows them to be
+// dropped from the keyring later.
+func (r *ReencryptSecrets) MarkKeysReencrypted(ctx context.Context, input MarkKeysReencryptedInput) error {
+	ids, err := keyregistry.MarkReencrypted(ctx, r.db, input.PrimaryKeyID, input.SweptSince)
+	if err != nil {
+		return fmt.Errorf("mark keys re-encrypted: %w", err)
+	}
+
+	for _, id := range ids {
+		r.logger.InfoContext(ctx, "encryption key fully re-encrypted", attr.SlogEncryptionKeyID(id))
+	}
+
+	return nil
+}
+
+func (r *ReencryptSecrets) environmentEntries(ctx context.Context, queries *repo.Queries, prefix string, input ReencryptBatchInput, result *ReencryptBatchResult) error {
+	rows, err := queries.ListEnvironmentEntriesForReencryption(ctx, repo.ListEnvironmentEntriesForReencryptionParams{
+		KeyPrefix:          prefix,
+		AfterEnvironmentID: input.Cursor.AfterID,
+		AfterName:          input.Cursor.AfterName,
+		LimitValue:         input.Limit,
+	})
+	if err != nil {
+		return fmt.Errorf("list batch: %w", err)
+	}
+
+	for _, row := range rows {
+		result.Scanned++
+		result.Next = ReencryptCursor{AfterID: row.EnvironmentID, AfterOrganizationID: "", AfterName: row.Name}
+
+		// Entries sealed under an organization data key are not encrypted with
+		// the application keyring at all, so there is nothing to rotate.
+		if envelope.IsEnvelopeCiphertext(row.Value) {
+			continue
+		}
+
+		rotated, _, err := r.enc.Rotate(row.Value)
+		if err != nil {
+			result.Failed++
+			r.logger.ErrorContext(ctx, "cannot re-encrypt environment entry",
+				attr.SlogEnvironmentID(row.EnvironmentID.String()),
+				attr.SlogEnvVarName(row.Name),
+				attr.SlogError(err),
+			)
+			continue
+		}
+
+		n, err := queries.ReencryptEnvironmentEntry(ctx, repo.ReencryptEnvironmentEntryParams{
+			NewValue:      rotated,
+			EnvironmentID: row.EnvironmentID,
+			Name:          row.Name,
+			OldValue:      row.Value,
+		})
+		if err != nil {
+			return fmt.Errorf("update entry: %w", err)
+		}
+		countSwap(result, n)
+	}
+
+	result.Done = len(rows) < int(input.Limit)
+	return nil
+}
+
+// secretRow is one stored ciphertext of a single-column target. ref names
+// the row in logs and next is the cursor position just past it.
+type secretRow struct {
+	id         uuid.UUID
+	ref        string
+	next       ReencryptCursor
+	ciphertext string
+}
+
+func idSecretRow(id uuid.UUID, ciphertext string) secretRow {
+	return secretRow{
+		id:         id,
+		ref:        id.String(),
+		next:       ReencryptCursor{AfterID: id, AfterOrganizationID: "", AfterName: ""},
+		ciphertext: ciphertext,
+	}
+}
+
+func (r *ReencryptSecrets) secrets(
+	ctx context.Context,
+	input ReencryptBatchInput,
+	result *ReencryptBatchResult,
+	list func() ([]secretRow, error),
+	swap func(row secretRow, newValue string) (int64, error),
+) error {
+	rows, err := list()
+	if err != nil {
+		return fmt.Errorf("list batch: %w", err)
+	}
+
+	for _, row := range rows {
+		result.Scanned++
+		result.Next = row.next
+
+		rotated, _, err := r.enc.Rotate(row.ciphertext)
+		if err != nil {
+			result.Failed++
+			r.logFailure(ctx, input.Target, row.ref, err)
+			continue
+		}
+
+		n, err := swap(row, rotated)
+		if err != nil {
+			return fmt.Errorf("update %s: %w", row.ref, err)
+		}
+		countSwap(result, n)
+	}
+
+	result.Done = len(rows) < int(input.Limit)
+	return nil
+}
+
+type tokenPairRow struct {
+	id      uuid.UUID
+	access  string
+	refresh pgtype.Text
+}
+
+func (r *ReencryptSecrets) tokenPairs(
+	ctx context.Context,
+	input ReencryptBatchInput,
+	result *ReencryptBatchResult,
+	list func() ([]tokenPairRow, error),
+	swap func(row tokenPairRow, access string, refresh pgtype.Text) (int64, error),
+) error {
+	rows, err := list()
+	if err != nil {
+		return fmt.Errorf("list batch: %w", err)
+	}
+
+	for _, row := range rows {
+		result.Scanned++
+		result.Next = ReencryptCursor{AfterID: row.id, AfterOrganizationID: "", AfterName: ""}
+
+		// A refresh that returns no new refresh token keeps the stored one, so
+		// a pair can mix an organization data key with the application keyring.
+		// Only the application keyring half is rotated.
+		sealedAccess := envelope.IsEnvelopeCiphertext(row.access)
+		sealedRefresh := !row.refresh.Valid || envelope.IsEnvelopeCiphertext(row.refresh.String)
+		if sealedAccess && sealedRefresh {
+			continue
+		}
+
+		access := row.access
+		if !sealedAccess {
+			rotated, _, err := r.enc.Rotate(access)
+			if err != nil {
+				result.Failed++
+				r.logFailure(ctx, input.Target, row.id.String(), fmt.Errorf("access token: %w", err))
+				continue
+			}
+			access = rotated
+		}
+
+		refresh := row.refresh
+		if !sealedRefresh {
+			rotated, _, err := r.enc.Rotate(refresh.String)
+			if err != nil {
+				result.Failed++
+				r.logFailure(ctx, input.Target, row.id.String(), fmt.Errorf("refresh token: %w", err))
+				continue
+			}
+			refresh = pgtype.Text{String: rotated, Valid: true}
+		}
+
+		n, err := swap(row, access, refresh)
+		if err != nil {
+			return fmt.Errorf("update %s: %w", row.id, err)
+		}
+		countSwap(result, n)
+	}
+
+	result.Done = len(rows) < int(input.Limit)
+	return nil
+}
+
+func (r *ReencryptSecrets) logFailure(ctx context.Context, target ReencryptTarget, ref string, err error) {
+	r.logger.ErrorContext(ctx, "cannot re-encrypt secret",
+		attr.SlogEncryptionReencryptTarget(string(target)),
+		attr.SlogResourceID(ref),
+		attr.SlogError(err),
+	)
+}
+
+func countSwap(result *ReencryptBatchResult, rowsAffected int64) {
+	if rowsAffected == 0 {
+		result.Conflicts++
+		return
+	}
+	result.Rotated++
+}
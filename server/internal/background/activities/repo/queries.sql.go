@@ -1264,6 +1264,303 @@ func (q *Queries) GetUserEmailsByOrgIDs(ctx context.Context, dollar_1 []string)
 	return items, nil
 }
 
+const listAIIntegrationAPIKeysForReencryption = `-- name: ListAIIntegrationAPIKeysForReencryption :many
+SELECT id, api_key_encrypted::text AS ciphertext
+FROM ai_integration_configs
+WHERE api_key_encrypted <> ''
+  AND NOT starts_with(api_key_encrypted, $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListAIIntegrationAPIKeysForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListAIIntegrationAPIKeysForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListAIIntegrationAPIKeysForReencryption(ctx context.Context, arg ListAIIntegrationAPIKeysForReencryptionParams) ([]ListAIIntegrationAPIKeysForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listAIIntegrationAPIKeysForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAIIntegrationAPIKeysForReencryptionRow
+	for rows.Next() {
+		var i ListAIIntegrationAPIKeysForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAssistantMCPOAuthClientSecretsForReencryption = `-- name: ListAssistantMCPOAuthClientSecretsForReencryption :many
+SELECT id, client_secret_encrypted::text AS ciphertext
+FROM assistant_mcp_oauth_clients
+WHERE client_secret_encrypted <> ''
+  AND NOT starts_with(client_secret_encrypted, $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListAssistantMCPOAuthClientSecretsForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListAssistantMCPOAuthClientSecretsForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListAssistantMCPOAuthClientSecretsForReencryption(ctx context.Context, arg ListAssistantMCPOAuthClientSecretsForReencryptionParams) ([]ListAssistantMCPOAuthClientSecretsForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listAssistantMCPOAuthClientSecretsForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAssistantMCPOAuthClientSecretsForReencryptionRow
+	for rows.Next() {
+		var i ListAssistantMCPOAuthClientSecretsForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeviceIntegrationCredentialsForReencryption = `-- name: ListDeviceIntegrationCredentialsForReencryption :many
+SELECT id, credentials_encrypted::text AS ciphertext
+FROM device_integration_configs
+WHERE credentials_encrypted <> ''
+  AND NOT starts_with(credentials_encrypted, $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListDeviceIntegrationCredentialsForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListDeviceIntegrationCredentialsForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListDeviceIntegrationCredentialsForReencryption(ctx context.Context, arg ListDeviceIntegrationCredentialsForReencryptionParams) ([]ListDeviceIntegrationCredentialsForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listDeviceIntegrationCredentialsForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDeviceIntegrationCredentialsForReencryptionRow
+	for rows.Next() {
+		var i ListDeviceIntegrationCredentialsForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEnvironmentEntriesForReencryption = `-- name: ListEnvironmentEntriesForReencryption :many
+SELECT environment_id, name, value
+FROM environment_entries
+WHERE is_secret
+  AND NOT starts_with(value, $1::text)
+  AND (environment_id, name) > ($2::uuid, $3::text)
+ORDER BY environment_id, name
+LIMIT $4
+`
+
+type ListEnvironmentEntriesForReencryptionParams struct {
+	KeyPrefix          string
+	AfterEnvironmentID uuid.UUID
+	AfterName          string
+	LimitValue         int32
+}
+
+type ListEnvironmentEntriesForReencryptionRow struct {
+	EnvironmentID uuid.UUID
+	Name          string
+	Value         string
+}
+
+// Key rotation re-encrypts stored ciphertexts in keyset-paginated batches.
+// The list queries return only rows holding a ciphertext not yet under
+// key_prefix, the primary key's "<id>:" prefix; the updates swap a ciphertext
+// only while it still holds the value that was read, so a concurrent write is
+// never overwritten.
+func (q *Queries) ListEnvironmentEntriesForReencryption(ctx context.Context, arg ListEnvironmentEntriesForReencryptionParams) ([]ListEnvironmentEntriesForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listEnvironmentEntriesForReencryption,
+		arg.KeyPrefix,
+		arg.AfterEnvironmentID,
+		arg.AfterName,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEnvironmentEntriesForReencryptionRow
+	for rows.Next() {
+		var i ListEnvironmentEntriesForReencryptionRow
+		if err := rows.Scan(
+			&i.EnvironmentID,
+			&i.Name,
+			&i.Value,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExternalOAuthClientSecretsForReencryption = `-- name: ListExternalOAuthClientSecretsForReencryption :many
+SELECT id, client_secret_encrypted::text AS ciphertext
+FROM external_oauth_client_registrations
+WHERE client_secret_encrypted IS NOT NULL
+  AND NOT starts_with(client_secret_encrypted, $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListExternalOAuthClientSecretsForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListExternalOAuthClientSecretsForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListExternalOAuthClientSecretsForReencryption(ctx context.Context, arg ListExternalOAuthClientSecretsForReencryptionParams) ([]ListExternalOAuthClientSecretsForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listExternalOAuthClientSecretsForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExternalOAuthClientSecretsForReencryptionRow
+	for rows.Next() {
+		var i ListExternalOAuthClientSecretsForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFunctionsAccessKeysForReencryption = `-- name: ListFunctionsAccessKeysForReencryption :many
+SELECT id, convert_from(encryption_key, 'UTF8')::text AS ciphertext
+FROM functions_access
+WHERE encryption_key IS NOT NULL
+  AND NOT starts_with(convert_from(encryption_key, 'UTF8'), $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListFunctionsAccessKeysForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListFunctionsAccessKeysForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListFunctionsAccessKeysForReencryption(ctx context.Context, arg ListFunctionsAccessKeysForReencryptionParams) ([]ListFunctionsAccessKeysForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listFunctionsAccessKeysForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFunctionsAccessKeysForReencryptionRow
+	for rows.Next() {
+		var i ListFunctionsAccessKeysForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listIdentityMapEntries = `-- name: ListIdentityMapEntries :many
 WITH directory AS (
     SELECT
@@ -1368,6 +1665,150 @@ func (q *Queries) ListIdentityMapEntries(ctx context.Context) ([]ListIdentityMap
 	return items, nil
 }
 
+const listModelProviderKeysForReencryption = `-- name: ListModelProviderKeysForReencryption :many
+SELECT id, api_key_encrypted::text AS ciphertext
+FROM model_provider_keys
+WHERE api_key_encrypted <> ''
+  AND NOT starts_with(api_key_encrypted, $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListModelProviderKeysForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListModelProviderKeysForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListModelProviderKeysForReencryption(ctx context.Context, arg ListModelProviderKeysForReencryptionParams) ([]ListModelProviderKeysForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listModelProviderKeysForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListModelProviderKeysForReencryptionRow
+	for rows.Next() {
+		var i ListModelProviderKeysForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOTelForwardingConfigHeadersForReencryption = `-- name: ListOTelForwardingConfigHeadersForReencryption :many
+SELECT id, headers_encrypted::text AS ciphertext
+FROM otel_forwarding_configs
+WHERE headers_encrypted <> ''
+  AND NOT starts_with(headers_encrypted, $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListOTelForwardingConfigHeadersForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListOTelForwardingConfigHeadersForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListOTelForwardingConfigHeadersForReencryption(ctx context.Context, arg ListOTelForwardingConfigHeadersForReencryptionParams) ([]ListOTelForwardingConfigHeadersForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listOTelForwardingConfigHeadersForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOTelForwardingConfigHeadersForReencryptionRow
+	for rows.Next() {
+		var i ListOTelForwardingConfigHeadersForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOTelForwardingDestinationHeadersForReencryption = `-- name: ListOTelForwardingDestinationHeadersForReencryption :many
+SELECT id, headers_encrypted::text AS ciphertext
+FROM otel_forwarding_destinations
+WHERE headers_encrypted <> ''
+  AND NOT starts_with(headers_encrypted, $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListOTelForwardingDestinationHeadersForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListOTelForwardingDestinationHeadersForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListOTelForwardingDestinationHeadersForReencryption(ctx context.Context, arg ListOTelForwardingDestinationHeadersForReencryptionParams) ([]ListOTelForwardingDestinationHeadersForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listOTelForwardingDestinationHeadersForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOTelForwardingDestinationHeadersForReencryptionRow
+	for rows.Next() {
+		var i ListOTelForwardingDestinationHeadersForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOpenRouterDailySpend = `-- name: ListOpenRouterDailySpend :many
 SELECT
     organization_id,
@@ -1640,41 +2081,242 @@ func (q *Queries) ListOpenRouterInvoiceSourceDays(ctx context.Context, arg ListO
 	return items, nil
 }
 
-const listStripeInvoiceAllocationsFixture = `-- name: ListStripeInvoiceAllocationsFixture :many
-SELECT
-    seq
-  , source_kind
-  , source_key
-  , source_snapshot_usd
-  , amount_usd
-  , original_invoice_id
-  , destination_invoice_id
-  , idempotency_key
-  , delivery_state
-  , stripe_invoice_item_id
-  , stripe_credit_note_id
-  , first_attempted_at
-  , last_attempted_at
-  , reconciled_at
-FROM stripe_invoice_allocations
-WHERE organization_id = $1
-ORDER BY source_key, seq
+const listOpenRouterKeysForReencryption = `-- name: ListOpenRouterKeysForReencryption :many
+SELECT organization_id, key_type, key_encrypted::text AS ciphertext
+FROM openrouter_api_keys
+WHERE key_encrypted <> ''
+  AND NOT starts_with(key_encrypted, $1::text)
+  AND (organization_id, key_type) > ($2::text, $3::text)
+ORDER BY organization_id, key_type
+LIMIT $4
 `
 
-type ListStripeInvoiceAllocationsFixtureRow struct {
-	Seq                  int32
-	SourceKind           string
-	SourceKey            string
-	SourceSnapshotUsd    pgtype.Numeric
-	AmountUsd            pgtype.Numeric
-	OriginalInvoiceID    pgtype.Text
-	DestinationInvoiceID pgtype.Text
-	IdempotencyKey       string
-	DeliveryState        string
-	StripeInvoiceItemID  pgtype.Text
-	StripeCreditNoteID   pgtype.Text
-	FirstAttemptedAt     pgtype.Timestamptz
-	LastAttemptedAt      pgtype.Timestamptz
+type ListOpenRouterKeysForReencryptionParams struct {
+	KeyPrefix           string
+	AfterOrganizationID string
+	AfterKeyType        string
+	LimitValue          int32
+}
+
+type ListOpenRouterKeysForReencryptionRow struct {
+	OrganizationID string
+	KeyType        string
+	Ciphertext     string
+}
+
+func (q *Queries) ListOpenRouterKeysForReencryption(ctx context.Context, arg ListOpenRouterKeysForReencryptionParams) ([]ListOpenRouterKeysForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listOpenRouterKeysForReencryption,
+		arg.KeyPrefix,
+		arg.AfterOrganizationID,
+		arg.AfterKeyType,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOpenRouterKeysForReencryptionRow
+	for rows.Next() {
+		var i ListOpenRouterKeysForReencryptionRow
+		if err := rows.Scan(
+			&i.OrganizationID,
+			&i.KeyType,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRemoteMCPServerHeadersForReencryption = `-- name: ListRemoteMCPServerHeadersForReencryption :many
+SELECT id, value::text AS ciphertext
+FROM remote_mcp_server_headers
+WHERE is_secret
+  AND value <> ''
+  AND NOT starts_with(value, $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListRemoteMCPServerHeadersForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListRemoteMCPServerHeadersForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListRemoteMCPServerHeadersForReencryption(ctx context.Context, arg ListRemoteMCPServerHeadersForReencryptionParams) ([]ListRemoteMCPServerHeadersForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listRemoteMCPServerHeadersForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRemoteMCPServerHeadersForReencryptionRow
+	for rows.Next() {
+		var i ListRemoteMCPServerHeadersForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRemoteSessionClientSecretsForReencryption = `-- name: ListRemoteSessionClientSecretsForReencryption :many
+SELECT id, client_secret_encrypted::text AS ciphertext
+FROM remote_session_clients
+WHERE client_secret_encrypted IS NOT NULL
+  AND NOT starts_with(client_secret_encrypted, $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListRemoteSessionClientSecretsForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListRemoteSessionClientSecretsForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListRemoteSessionClientSecretsForReencryption(ctx context.Context, arg ListRemoteSessionClientSecretsForReencryptionParams) ([]ListRemoteSessionClientSecretsForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listRemoteSessionClientSecretsForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRemoteSessionClientSecretsForReencryptionRow
+	for rows.Next() {
+		var i ListRemoteSessionClientSecretsForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRemoteSessionTokensForReencryption = `-- name: ListRemoteSessionTokensForReencryption :many
+SELECT id, access_token_encrypted, refresh_token_encrypted
+FROM remote_sessions
+WHERE (
+    NOT starts_with(access_token_encrypted, $1::text)
+    OR NOT starts_with(COALESCE(refresh_token_encrypted, $1::text), $1::text)
+  )
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListRemoteSessionTokensForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListRemoteSessionTokensForReencryptionRow struct {
+	ID                    uuid.UUID
+	AccessTokenEncrypted  string
+	RefreshTokenEncrypted pgtype.Text
+}
+
+func (q *Queries) ListRemoteSessionTokensForReencryption(ctx context.Context, arg ListRemoteSessionTokensForReencryptionParams) ([]ListRemoteSessionTokensForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listRemoteSessionTokensForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRemoteSessionTokensForReencryptionRow
+	for rows.Next() {
+		var i ListRemoteSessionTokensForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccessTokenEncrypted,
+			&i.RefreshTokenEncrypted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStripeInvoiceAllocationsFixture = `-- name: ListStripeInvoiceAllocationsFixture :many
+SELECT
+    seq
+  , source_kind
+  , source_key
+  , source_snapshot_usd
+  , amount_usd
+  , original_invoice_id
+  , destination_invoice_id
+  , idempotency_key
+  , delivery_state
+  , stripe_invoice_item_id
+  , stripe_credit_note_id
+  , first_attempted_at
+  , last_attempted_at
+  , reconciled_at
+FROM stripe_invoice_allocations
+WHERE organization_id = $1
+ORDER BY source_key, seq
+`
+
+type ListStripeInvoiceAllocationsFixtureRow struct {
+	Seq                  int32
+	SourceKind           string
+	SourceKey            string
+	SourceSnapshotUsd    pgtype.Numeric
+	AmountUsd            pgtype.Numeric
+	OriginalInvoiceID    pgtype.Text
+	DestinationInvoiceID pgtype.Text
+	IdempotencyKey       string
+	DeliveryState        string
+	StripeInvoiceItemID  pgtype.Text
+	StripeCreditNoteID   pgtype.Text
+	FirstAttemptedAt     pgtype.Timestamptz
+	LastAttemptedAt      pgtype.Timestamptz
 	ReconciledAt         pgtype.Timestamptz
 }
 
@@ -1923,6 +2565,154 @@ func (q *Queries) ListStripeInvoicesForOpenRouterBilling(ctx context.Context, ar
 	return items, nil
 }
 
+const listToolApprovalSlackWebhooksForReencryption = `-- name: ListToolApprovalSlackWebhooksForReencryption :many
+SELECT id, slack_webhook_url_encrypted::text AS ciphertext
+FROM tool_approval_policies
+WHERE slack_webhook_url_encrypted <> ''
+  AND NOT starts_with(slack_webhook_url_encrypted, $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListToolApprovalSlackWebhooksForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListToolApprovalSlackWebhooksForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListToolApprovalSlackWebhooksForReencryption(ctx context.Context, arg ListToolApprovalSlackWebhooksForReencryptionParams) ([]ListToolApprovalSlackWebhooksForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listToolApprovalSlackWebhooksForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListToolApprovalSlackWebhooksForReencryptionRow
+	for rows.Next() {
+		var i ListToolApprovalSlackWebhooksForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserOAuthTokensForReencryption = `-- name: ListUserOAuthTokensForReencryption :many
+SELECT id, access_token_encrypted, refresh_token_encrypted
+FROM user_oauth_tokens
+WHERE (
+    NOT starts_with(access_token_encrypted, $1::text)
+    OR NOT starts_with(COALESCE(refresh_token_encrypted, $1::text), $1::text)
+  )
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListUserOAuthTokensForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListUserOAuthTokensForReencryptionRow struct {
+	ID                    uuid.UUID
+	AccessTokenEncrypted  string
+	RefreshTokenEncrypted pgtype.Text
+}
+
+func (q *Queries) ListUserOAuthTokensForReencryption(ctx context.Context, arg ListUserOAuthTokensForReencryptionParams) ([]ListUserOAuthTokensForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listUserOAuthTokensForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserOAuthTokensForReencryptionRow
+	for rows.Next() {
+		var i ListUserOAuthTokensForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccessTokenEncrypted,
+			&i.RefreshTokenEncrypted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookEndpointSecretsForReencryption = `-- name: ListWebhookEndpointSecretsForReencryption :many
+SELECT id, secret_encrypted::text AS ciphertext
+FROM webhook_endpoints
+WHERE secret_encrypted <> ''
+  AND NOT starts_with(secret_encrypted, $1::text)
+  AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListWebhookEndpointSecretsForReencryptionParams struct {
+	KeyPrefix  string
+	AfterID    uuid.UUID
+	LimitValue int32
+}
+
+type ListWebhookEndpointSecretsForReencryptionRow struct {
+	ID         uuid.UUID
+	Ciphertext string
+}
+
+func (q *Queries) ListWebhookEndpointSecretsForReencryption(ctx context.Context, arg ListWebhookEndpointSecretsForReencryptionParams) ([]ListWebhookEndpointSecretsForReencryptionRow, error) {
+	rows, err := q.db.Query(ctx, listWebhookEndpointSecretsForReencryption,
+		arg.KeyPrefix,
+		arg.AfterID,
+		arg.LimitValue,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookEndpointSecretsForReencryptionRow
+	for rows.Next() {
+		var i ListWebhookEndpointSecretsForReencryptionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ciphertext,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWeeklyUsageSummaryTargets = `-- name: ListWeeklyUsageSummaryTargets :many
 SELECT
     om.id AS organization_id,
@@ -2153,6 +2943,424 @@ func (q *Queries) ReconcileAndRotateStripeInvoiceAllocation(ctx context.Context,
 	return idempotency_key, err
 }
 
+const reencryptAIIntegrationAPIKey = `-- name: ReencryptAIIntegrationAPIKey :execrows
+UPDATE ai_integration_configs
+SET api_key_encrypted = $1::text
+WHERE id = $2
+  AND api_key_encrypted = $3::text
+`
+
+type ReencryptAIIntegrationAPIKeyParams struct {
+	NewValue string
+	ID       uuid.UUID
+	OldValue string
+}
+
+func (q *Queries) ReencryptAIIntegrationAPIKey(ctx context.Context, arg ReencryptAIIntegrationAPIKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptAIIntegrationAPIKey,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptAssistantMCPOAuthClientSecret = `-- name: ReencryptAssistantMCPOAuthClientSecret :execrows
+UPDATE assistant_mcp_oauth_clients
+SET client_secret_encrypted = $1::text
+WHERE id = $2
+  AND client_secret_encrypted = $3::text
+`
+
+type ReencryptAssistantMCPOAuthClientSecretParams struct {
+	NewValue string
+	ID       uuid.UUID
+	OldValue string
+}
+
+func (q *Queries) ReencryptAssistantMCPOAuthClientSecret(ctx context.Context, arg ReencryptAssistantMCPOAuthClientSecretParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptAssistantMCPOAuthClientSecret,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptDeviceIntegrationCredentials = `-- name: ReencryptDeviceIntegrationCredentials :execrows
+UPDATE device_integration_configs
+SET credentials_encrypted = $1::text
+WHERE id = $2
+  AND credentials_encrypted = $3::text
+`
+
+type ReencryptDeviceIntegrationCredentialsParams struct {
+	NewValue string
+	ID       uuid.UUID
+	OldValue string
+}
+
+func (q *Queries) ReencryptDeviceIntegrationCredentials(ctx context.Context, arg ReencryptDeviceIntegrationCredentialsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptDeviceIntegrationCredentials,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptEnvironmentEntry = `-- name: ReencryptEnvironmentEntry :execrows
+UPDATE environment_entries
+SET value = $1
+WHERE environment_id = $2
+  AND name = $3
+  AND value = $4
+`
+
+type ReencryptEnvironmentEntryParams struct {
+	NewValue      string
+	EnvironmentID uuid.UUID
+	Name          string
+	OldValue      string
+}
+
+func (q *Queries) ReencryptEnvironmentEntry(ctx context.Context, arg ReencryptEnvironmentEntryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptEnvironmentEntry,
+		arg.NewValue,
+		arg.EnvironmentID,
+		arg.Name,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptExternalOAuthClientSecret = `-- name: ReencryptExternalOAuthClientSecret :execrows
+UPDATE external_oauth_client_registrations
+SET client_secret_encrypted = $1
+WHERE id = $2
+  AND client_secret_encrypted = $3
+`
+
+type ReencryptExternalOAuthClientSecretParams struct {
+	NewValue pgtype.Text
+	ID       uuid.UUID
+	OldValue pgtype.Text
+}
+
+func (q *Queries) ReencryptExternalOAuthClientSecret(ctx context.Context, arg ReencryptExternalOAuthClientSecretParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptExternalOAuthClientSecret,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptFunctionsAccessKey = `-- name: ReencryptFunctionsAccessKey :execrows
+UPDATE functions_access
+SET encryption_key = convert_to($1::text, 'UTF8')
+WHERE id = $2
+  AND encryption_key = convert_to($3::text, 'UTF8')
+`
+
+type ReencryptFunctionsAccessKeyParams struct {
+	NewValue string
+	ID       uuid.UUID
+	OldValue string
+}
+
+func (q *Queries) ReencryptFunctionsAccessKey(ctx context.Context, arg ReencryptFunctionsAccessKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptFunctionsAccessKey,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptModelProviderKey = `-- name: ReencryptModelProviderKey :execrows
+UPDATE model_provider_keys
+SET api_key_encrypted = $1::text
+WHERE id = $2
+  AND api_key_encrypted = $3::text
+`
+
+type ReencryptModelProviderKeyParams struct {
+	NewValue string
+	ID       uuid.UUID
+	OldValue string
+}
+
+func (q *Queries) ReencryptModelProviderKey(ctx context.Context, arg ReencryptModelProviderKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptModelProviderKey,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptOTelForwardingConfigHeaders = `-- name: ReencryptOTelForwardingConfigHeaders :execrows
+UPDATE otel_forwarding_configs
+SET headers_encrypted = $1::text
+WHERE id = $2
+  AND headers_encrypted = $3::text
+`
+
+type ReencryptOTelForwardingConfigHeadersParams struct {
+	NewValue string
+	ID       uuid.UUID
+	OldValue string
+}
+
+func (q *Queries) ReencryptOTelForwardingConfigHeaders(ctx context.Context, arg ReencryptOTelForwardingConfigHeadersParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptOTelForwardingConfigHeaders,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptOTelForwardingDestinationHeaders = `-- name: ReencryptOTelForwardingDestinationHeaders :execrows
+UPDATE otel_forwarding_destinations
+SET headers_encrypted = $1::text
+WHERE id = $2
+  AND headers_encrypted = $3::text
+`
+
+type ReencryptOTelForwardingDestinationHeadersParams struct {
+	NewValue string
+	ID       uuid.UUID
+	OldValue string
+}
+
+func (q *Queries) ReencryptOTelForwardingDestinationHeaders(ctx context.Context, arg ReencryptOTelForwardingDestinationHeadersParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptOTelForwardingDestinationHeaders,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptOpenRouterKey = `-- name: ReencryptOpenRouterKey :execrows
+UPDATE openrouter_api_keys
+SET key_encrypted = $1::text
+WHERE organization_id = $2
+  AND key_type = $3
+  AND key_encrypted = $4::text
+`
+
+type ReencryptOpenRouterKeyParams struct {
+	NewValue       string
+	OrganizationID string
+	KeyType        string
+	OldValue       string
+}
+
+func (q *Queries) ReencryptOpenRouterKey(ctx context.Context, arg ReencryptOpenRouterKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptOpenRouterKey,
+		arg.NewValue,
+		arg.OrganizationID,
+		arg.KeyType,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptRemoteMCPServerHeader = `-- name: ReencryptRemoteMCPServerHeader :execrows
+UPDATE remote_mcp_server_headers
+SET value = $1::text
+WHERE id = $2
+  AND value = $3::text
+`
+
+type ReencryptRemoteMCPServerHeaderParams struct {
+	NewValue string
+	ID       uuid.UUID
+	OldValue string
+}
+
+func (q *Queries) ReencryptRemoteMCPServerHeader(ctx context.Context, arg ReencryptRemoteMCPServerHeaderParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptRemoteMCPServerHeader,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptRemoteSessionClientSecret = `-- name: ReencryptRemoteSessionClientSecret :execrows
+UPDATE remote_session_clients
+SET client_secret_encrypted = $1
+WHERE id = $2
+  AND client_secret_encrypted = $3
+`
+
+type ReencryptRemoteSessionClientSecretParams struct {
+	NewValue pgtype.Text
+	ID       uuid.UUID
+	OldValue pgtype.Text
+}
+
+func (q *Queries) ReencryptRemoteSessionClientSecret(ctx context.Context, arg ReencryptRemoteSessionClientSecretParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptRemoteSessionClientSecret,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptRemoteSessionToken = `-- name: ReencryptRemoteSessionToken :execrows
+UPDATE remote_sessions
+SET access_token_encrypted = $1,
+    refresh_token_encrypted = $2
+WHERE id = $3
+  AND access_token_encrypted = $4
+  AND refresh_token_encrypted IS NOT DISTINCT FROM $5
+`
+
+type ReencryptRemoteSessionTokenParams struct {
+	NewAccessToken  string
+	NewRefreshToken pgtype.Text
+	ID              uuid.UUID
+	OldAccessToken  string
+	OldRefreshToken pgtype.Text
+}
+
+func (q *Queries) ReencryptRemoteSessionToken(ctx context.Context, arg ReencryptRemoteSessionTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptRemoteSessionToken,
+		arg.NewAccessToken,
+		arg.NewRefreshToken,
+		arg.ID,
+		arg.OldAccessToken,
+		arg.OldRefreshToken,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptToolApprovalSlackWebhook = `-- name: ReencryptToolApprovalSlackWebhook :execrows
+UPDATE tool_approval_policies
+SET slack_webhook_url_encrypted = $1::text
+WHERE id = $2
+  AND slack_webhook_url_encrypted = $3::text
+`
+
+type ReencryptToolApprovalSlackWebhookParams struct {
+	NewValue string
+	ID       uuid.UUID
+	OldValue string
+}
+
+func (q *Queries) ReencryptToolApprovalSlackWebhook(ctx context.Context, arg ReencryptToolApprovalSlackWebhookParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptToolApprovalSlackWebhook,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptUserOAuthToken = `-- name: ReencryptUserOAuthToken :execrows
+UPDATE user_oauth_tokens
+SET access_token_encrypted = $1,
+    refresh_token_encrypted = $2
+WHERE id = $3
+  AND access_token_encrypted = $4
+  AND refresh_token_encrypted IS NOT DISTINCT FROM $5
+`
+
+type ReencryptUserOAuthTokenParams struct {
+	NewAccessToken  string
+	NewRefreshToken pgtype.Text
+	ID              uuid.UUID
+	OldAccessToken  string
+	OldRefreshToken pgtype.Text
+}
+
+func (q *Queries) ReencryptUserOAuthToken(ctx context.Context, arg ReencryptUserOAuthTokenParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptUserOAuthToken,
+		arg.NewAccessToken,
+		arg.NewRefreshToken,
+		arg.ID,
+		arg.OldAccessToken,
+		arg.OldRefreshToken,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reencryptWebhookEndpointSecret = `-- name: ReencryptWebhookEndpointSecret :execrows
+UPDATE webhook_endpoints
+SET secret_encrypted = $1::text
+WHERE id = $2
+  AND secret_encrypted = $3::text
+`
+
+type ReencryptWebhookEndpointSecretParams struct {
+	NewValue string
+	ID       uuid.UUID
+	OldValue string
+}
+
+func (q *Queries) ReencryptWebhookEndpointSecret(ctx context.Context, arg ReencryptWebhookEndpointSecretParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reencryptWebhookEndpointSecret,
+		arg.NewValue,
+		arg.ID,
+		arg.OldValue,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const releaseOpenRouterKeyBillingLock = `-- name: ReleaseOpenRouterKeyBillingLock :one
 SELECT pg_advisory_unlock(hashtextextended('openrouter-' || $1::text || '-billing:' || $2::text, 0)) AS unlocked
 `
@@ -19,7 +19,10 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/cache"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/feature"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
 	orgRepo "github.com/speakeasy-api/gram/server/internal/organizations/repo"
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+	"github.com/speakeasy-api/gram/server/internal/outbox/events"
 	projectsRepo "github.com/speakeasy-api/gram/server/internal/projects/repo"
 	"github.com/speakeasy-api/gram/server/internal/spendrules"
 	"github.com/speakeasy-api/gram/server/internal/spendrules/celenv"
@@ -172,12 +175,23 @@ func (a *EvaluateOrg) Do(ctx context.Context, args EvaluateOrgArgs) (err error)
 		return fmt.Errorf("load actor window spend: %w", err)
 	}
 
+	// The per-dimension rollup is only read when a rule is scoped to a model,
+	// assistant, project or toolset; unscoped orgs keep the single rollup read.
+	var actorDimensionSpend map[string][]chrepo.ActorDimensionWindowSpendRow
+	if spendrules.HasScopedRule(rules) {
+		actorDimensionSpend, err = chrepo.LoadActorDimensionWindowSpend(ctx, a.chQueries, projectIDs, now)
+		if err != nil {
+			return fmt.Errorf("load actor dimension window spend: %w", err)
+		}
+	}
+
 	for _, actor := range actors {
-		spend := actorWindowSpend[conv.NormalizeEmail(actor.Email)]
+		email := conv.NormalizeEmail(actor.Email)
+		spend := actorWindowSpend[email]
 		if spend.Email == "" {
 			spend = spendrules.EmptyActorSpend(actor.Email)
 		}
-		if err := spendrules.WriteGateActor(ctx, a.cacheImpl, args.OrganizationID, spendrules.NewGateActor(actor, spend, now)); err != nil {
+		if err := spendrules.WriteGateActor(ctx, a.cacheImpl, args.OrganizationID, spendrules.NewGateActor(actor, spend, actorDimensionSpend[email], now)); err != nil {
 			return fmt.Errorf("write spend gate actor: %w", err)
 		}
 	}
@@ -187,7 +201,7 @@ func (a *EvaluateOrg) Do(ctx context.Context, args EvaluateOrgArgs) (err error)
 	// only errors it returns are transient event-write failures.
 	var eventWriteErrs []error
 	for _, rule := range rules {
-		if err := a.evaluateRule(ctx, logger, queries, rule, actors, actorWindowSpend, now); err != nil {
+		if err := a.evaluateRule(ctx, logger, rule, actors, actorWindowSpend, actorDimensionSpend, now); err != nil {
 			eventWriteErrs = append(eventWriteErrs, err)
 		}
 	}
@@ -250,7 +264,19 @@ func (a *EvaluateOrg) RefreshActor(ctx context.Context, args EvaluateActorArgs)
 	if spend.Email == "" {
 		spend = spendrules.EmptyActorSpend(actor.Email)
 	}
-	if err := spendrules.WriteGateActor(ctx, a.cacheImpl, args.OrganizationID, spendrules.NewGateActor(actor, spend, computedAt)); err != nil {
+
+	rules, err := queries.ListEnabledSpendRules(ctx, args.OrganizationID)
+	if err != nil {
+		return fmt.Errorf("list enabled spend rules: %w", err)
+	}
+	var dimensionSpend []chrepo.ActorDimensionWindowSpendRow
+	if spendrules.HasScopedRule(rules) {
+		dimensionSpend, err = chrepo.LoadActorDimensionWindowSpendByEmail(ctx, a.chQueries, projectIDs, actor.Email, computedAt)
+		if err != nil {
+			return fmt.Errorf("load actor dimension window spend by email: %w", err)
+		}
+	}
+	if err := spendrules.WriteGateActor(ctx, a.cacheImpl, args.OrganizationID, spendrules.NewGateActor(actor, spend, dimensionSpend, computedAt)); err != nil {
 		return fmt.Errorf("write spend gate actor: %w", err)
 	}
 	return nil
@@ -323,10 +349,10 @@ func (a *EvaluateOrg) budgetsEnabled(ctx context.Context, logger *slog.Logger, o
 func (a *EvaluateOrg) evaluateRule(
 	ctx context.Context,
 	logger *slog.Logger,
-	queries *spendrepo.Queries,
 	rule spendrepo.SpendRule,
 	actors []spendrules.Actor,
 	actorWindowSpend map[string]chrepo.ActorWindowSpendRow,
+	actorDimensionSpend map[string][]chrepo.ActorDimensionWindowSpendRow,
 	now time.Time,
 ) error {
 	windowStart, windowEnd, err := spendrules.WindowBounds(rule.WindowKind, now)
@@ -347,7 +373,12 @@ func (a *EvaluateOrg) evaluateRule(
 		return nil
 	}
 
-	usages, err := spendrules.BuildActorWindowUsages(matched, actorWindowSpend, rule.WindowKind, limitUSD)
+	ruleWindowSpend, err := spendrules.EnforcedWindowSpend(a.celEng, rule.ScopeExpr, actorWindowSpend, actorDimensionSpend)
+	if err != nil {
+		logger.WarnContext(ctx, "evaluate scoped spend rule on unscoped spend", attr.SlogError(err), attr.SlogSpendRuleID(rule.ID.String()))
+	}
+
+	usages, err := spendrules.BuildActorWindowUsages(matched, ruleWindowSpend, rule.WindowKind, limitUSD)
 	if err != nil {
 		logger.ErrorContext(ctx, "select actor spend for rule", attr.SlogError(err), attr.SlogSpendRuleID(rule.ID.String()))
 		return nil
@@ -379,9 +410,7 @@ func (a *EvaluateOrg) evaluateRule(
 			logger.ErrorContext(ctx, "convert spend to cents", attr.SlogError(err), attr.SlogSpendRuleID(rule.ID.String()))
 			continue
 		}
-		limitUSDCents := rule.LimitUsdCents
-
-		if _, err := queries.InsertSpendRuleEvent(ctx, spendrepo.InsertSpendRuleEventParams{
+		params := spendrepo.InsertSpendRuleEventParams{
 			OrganizationID: rule.OrganizationID,
 			SpendRuleID:    rule.ID,
 			RuleUrn:        ruleURN.String(),
@@ -390,10 +419,11 @@ func (a *EvaluateOrg) evaluateRule(
 			Email:          conv.NormalizeEmail(usage.Actor.Email),
 			DisplayName:    conv.ToPGTextEmpty(usage.Actor.DisplayName),
 			SpendUsdCents:  spendUSDCents,
-			LimitUsdCents:  limitUSDCents,
+			LimitUsdCents:  rule.LimitUsdCents,
 			WindowStart:    conv.ToPGTimestamptz(windowStart),
 			WindowEnd:      conv.ToPGTimestamptz(windowEnd),
-		}); err != nil {
+		}
+		if err := a.recordEvent(ctx, rule, params); err != nil {
 			// Surface transient write failures so the activity retries rather
 			// than silently succeeding without the event recorded.
 			writeErrs = append(writeErrs, fmt.Errorf("record %s event for rule %s: %w", eventType, rule.ID, err))
@@ -402,3 +432,56 @@ func (a *EvaluateOrg) evaluateRule(
 
 	return errors.Join(writeErrs...)
 }
+
+// recordEvent writes one warning/breach event and, when the write is the
+// actor's first for this rule and window, enqueues the matching webhook in the
+// same transaction. The unique index is what makes the webhook a transition
+// rather than a repeat on every evaluation.
+func (a *EvaluateOrg) recordEvent(ctx context.Context, rule spendrepo.SpendRule, params spendrepo.InsertSpendRuleEventParams) error {
+	dbtx, err := a.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	inserted, err := spendrepo.New(dbtx).InsertSpendRuleEvent(ctx, params)
+	if err != nil {
+		return fmt.Errorf("insert spend rule event: %w", err)
+	}
+	if inserted == 0 {
+		return nil
+	}
+
+	def := events.SpendRuleWarnedV1
+	switch {
+	case params.EventType != spendrules.EventTypeBreach:
+	case rule.Action == spendrules.ActionBlock:
+		def = events.SpendRuleBlockedV1
+	default:
+		def = events.SpendRuleFlaggedV1
+	}
+
+	if _, err := outbox.PublishWebhookEvent(ctx, dbtx, rule.OrganizationID, def, events.SpendRuleTransitionPayloadV1{
+		OrganizationID: rule.OrganizationID,
+		SpendRuleID:    rule.ID,
+		RuleURN:        params.RuleUrn,
+		RuleName:       rule.Name,
+		Action:         rule.Action,
+		UserID:         conv.FromPGTextOrEmpty[string](params.UserID),
+		Email:          params.Email,
+		SpendUSDCents:  int64(params.SpendUsdCents),
+		LimitUSDCents:  int64(params.LimitUsdCents),
+		WarnAtPct:      rule.WarnAtPct,
+		WindowKind:     rule.WindowKind,
+		WindowStart:    params.WindowStart.Time.UTC(),
+		WindowEnd:      params.WindowEnd.Time.UTC(),
+	}); err != nil {
+		return fmt.Errorf("publish spend rule webhook: %w", err)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit spend rule event: %w", err)
+	}
+
+	return nil
+}
@@ -7,7 +7,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	deploymentEvents "github.com/speakeasy-api/gram/server/internal/deployments/events"
 	"github.com/speakeasy-api/gram/server/internal/deployments/repo"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
 	"github.com/speakeasy-api/gram/server/internal/oops"
 )
 
@@ -31,7 +34,13 @@ type TransitionDeploymentResult struct {
 }
 
 func (t *TransitionDeployment) Do(ctx context.Context, projectID uuid.UUID, deploymentID uuid.UUID, status string) (*TransitionDeploymentResult, error) {
-	state, err := t.repo.TransitionDeployment(ctx, repo.TransitionDeploymentParams{
+	dbtx, err := t.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error transitioning deployment").LogError(ctx, t.logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	state, err := t.repo.WithTx(dbtx).TransitionDeployment(ctx, repo.TransitionDeploymentParams{
 		DeploymentID: deploymentID,
 		Status:       status,
 		ProjectID:    projectID,
@@ -42,6 +51,18 @@ func (t *TransitionDeployment) Do(ctx context.Context, projectID uuid.UUID, depl
 		return nil, oops.E(oops.CodeUnexpected, err, "error transitioning deployment").LogError(ctx, t.logger)
 	}
 
+	// A retried activity finds the deployment already moved and publishes
+	// nothing, so the webhook goes out once per transition.
+	if state.Moved {
+		if err := deploymentEvents.PublishLifecycleWebhook(ctx, dbtx, projectID, deploymentID, state.Status); err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "error publishing deployment webhook").LogError(ctx, t.logger)
+		}
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error transitioning deployment").LogError(ctx, t.logger)
+	}
+
 	return &TransitionDeploymentResult{
 		Status: state.Status,
 		Moved:  state.Moved,
@@ -0,0 +1,93 @@
+package background
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/speakeasy-api/gram/server/internal/auditexport"
+	tenv "github.com/speakeasy-api/gram/server/internal/temporal"
+)
+
+const (
+	auditLogExportWorkflowID = "v1:audit-log-export"
+	auditLogExportScheduleID = "v1:audit-log-export-schedule"
+
+	// auditLogExportActivityTimeout budgets one sweep over every enabled
+	// export. Each organization's share is bounded by the exporter, and an
+	// interrupted sweep resumes from the stored cursors.
+	auditLogExportActivityTimeout = 8 * time.Minute
+)
+
+// AuditLogExportWorkflow runs one export sweep. It is scheduled every
+// auditexport.ExportInterval with overlap-skip; the compare-and-set cursor
+// makes a retried or overlapping sweep harmless.
+func AuditLogExportWorkflow(ctx workflow.Context) (auditexport.ExportResult, error) {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: auditLogExportActivityTimeout,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts:    3,
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2,
+		},
+	})
+
+	var a *Activities
+	var result auditexport.ExportResult
+	if err := workflow.ExecuteActivity(activityCtx, a.ExportAuditLogs).Get(activityCtx, &result); err != nil {
+		return result, fmt.Errorf("export audit logs: %w", err)
+	}
+	return result, nil
+}
+
+func AddAuditLogExportSchedule(ctx context.Context, temporalEnv *tenv.Environment) error {
+	scheduleClient := temporalEnv.Client().ScheduleClient()
+	options := buildAuditLogExportScheduleOptions(temporalEnv)
+
+	_, err := scheduleClient.Create(ctx, options)
+	if err != nil && !errors.Is(err, temporal.ErrScheduleAlreadyRunning) {
+		return fmt.Errorf("create audit log export schedule: %w", err)
+	}
+
+	if err := scheduleClient.GetHandle(ctx, auditLogExportScheduleID).Update(ctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(input client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			schedule := input.Description.Schedule
+			schedule.Spec = &options.Spec
+			schedule.Action = options.Action
+			if schedule.Policy == nil {
+				schedule.Policy = &client.SchedulePolicies{
+					Overlap:        enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+					CatchupWindow:  0,
+					PauseOnFailure: false,
+				}
+			}
+			return &client.ScheduleUpdate{Schedule: &schedule, TypedSearchAttributes: nil}, nil
+		},
+	}); err != nil {
+		return fmt.Errorf("update audit log export schedule: %w", err)
+	}
+	return nil
+}
+
+func buildAuditLogExportScheduleOptions(temporalEnv *tenv.Environment) client.ScheduleOptions {
+	return client.ScheduleOptions{
+		ID:      auditLogExportScheduleID,
+		Overlap: enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+		Spec: client.ScheduleSpec{
+			Intervals: []client.ScheduleIntervalSpec{{Every: auditexport.ExportInterval}},
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:                 auditLogExportWorkflowID,
+			Workflow:           AuditLogExportWorkflow,
+			Args:               nil,
+			TaskQueue:          string(temporalEnv.Queue()),
+			WorkflowRunTimeout: 3 * auditLogExportActivityTimeout,
+		},
+	}
+}
@@ -20,6 +20,9 @@ type ProcessDeploymentWorkflowParams struct {
 	ProjectID      uuid.UUID
 	DeploymentID   uuid.UUID
 	IdempotencyKey string
+	// FailOnBreaking fails the deployment instead of activating it when it
+	// introduces breaking changes compared with the active deployment.
+	FailOnBreaking bool
 }
 
 type ProcessDeploymentWorkflowResult struct {
@@ -160,6 +163,31 @@ func ProcessDeploymentWorkflow(ctx workflow.Context, params ProcessDeploymentWor
 		}
 	}
 
+	// The comparison has to happen before the final transition: a completed
+	// deployment is the active one, so checking afterwards would only report
+	// a breaking change that is already serving traffic.
+	if params.FailOnBreaking && finalStatus == "completed" {
+		var check activities.CheckBreakingChangesResult
+		err = workflow.ExecuteActivity(
+			ctx,
+			a.CheckBreakingChanges,
+			params.ProjectID,
+			params.DeploymentID,
+		).Get(ctx, &check)
+		switch {
+		case err != nil:
+			finalStatus = "failed"
+			logger.Error(
+				"failed to check deployment for breaking changes",
+				"error", err.Error(),
+				string(attr.ProjectIDKey), params.ProjectID,
+				string(attr.DeploymentIDKey), params.DeploymentID,
+			)
+		case check.BreakingChanges > 0:
+			finalStatus = "failed"
+		}
+	}
+
 	var finalTransition activities.TransitionDeploymentResult
 	err = workflow.ExecuteActivity(
 		ctx,
@@ -0,0 +1,205 @@
+package background
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/speakeasy-api/gram/server/internal/background/activities"
+	tenv "github.com/speakeasy-api/gram/server/internal/temporal"
+)
+
+const (
+	encryptionKeyRotationWorkflowIDPrefix = "v1:encryption-key-rotation:"
+
+	// EncryptionKeyRotationProgressQuery returns the running
+	// EncryptionKeyRotationProgress of a rotation, e.g.
+	//
+	//	temporal workflow query --workflow-id v1:encryption-key-rotation:<key id> --type progress
+	EncryptionKeyRotationProgressQuery = "progress"
+
+	encryptionKeyRotationBatchSize int32 = 200
+
+	// encryptionKeyRotationStartDelay holds the sweep back until a rolling
+	// deploy has replaced every replica: until then, old replicas keep
+	// writing under the previous key and the sweep would race them.
+	encryptionKeyRotationStartDelay = 15 * time.Minute
+
+	encryptionKeyRotationRunTimeout = 24 * time.Hour
+)
+
+type EncryptionKeyRotationInput struct {
+	PrimaryKeyID string
+
+	// StartedAt is when the first run of the rotation started. It is zero on
+	// a fresh run and carried across continue-as-new.
+	StartedAt time.Time
+
+	// Progress carries the tallies and the scan position across
+	// continue-as-new. It is zero on a fresh run.
+	Progress EncryptionKeyRotationProgress
+}
+
+// EncryptionKeyRotationProgress reports a rotation's advance through its
+// targets, in activities.ReencryptTargets order.
+type EncryptionKeyRotationProgress struct {
+	Targets []EncryptionKeyRotationTargetProgress
+}
+
+type EncryptionKeyRotationTargetProgress struct {
+	Target    activities.ReencryptTarget
+	Scanned   int
+	Rotated   int
+	Conflicts int
+	Failed    int
+	Cursor    activities.ReencryptCursor
+	Done      bool
+}
+
+// EncryptionKeyRotationWorkflow re-encrypts stored secrets written under any
+// key other than the primary one, across every activities.ReencryptTargets
+// column. It walks each target in keyset-paginated batches and can be queried
+// for progress.
+// Rows no key can decrypt are counted and logged, never rewritten, so the
+// sweep finishes even when some ciphertexts are already lost.
+//
+// A sweep that ends with no failures and no conflicts marks the other keys
+// re-encrypted in the key registry, the precondition for dropping them from
+// the keyring.
+func EncryptionKeyRotationWorkflow(ctx workflow.Context, input EncryptionKeyRotationInput) (*EncryptionKeyRotationProgress, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 5 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts:    10,
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    5 * time.Minute,
+		},
+	})
+
+	startedAt := input.StartedAt
+	if startedAt.IsZero() {
+		startedAt = workflow.Now(ctx)
+	}
+
+	progress := input.Progress
+	if len(progress.Targets) == 0 {
+		progress.Targets = make([]EncryptionKeyRotationTargetProgress, len(activities.ReencryptTargets))
+		for i, target := range activities.ReencryptTargets {
+			progress.Targets[i] = EncryptionKeyRotationTargetProgress{
+				Target:    target,
+				Scanned:   0,
+				Rotated:   0,
+				Conflicts: 0,
+				Failed:    0,
+				Cursor:    activities.ReencryptCursor{AfterID: uuid.Nil, AfterOrganizationID: "", AfterName: ""},
+				Done:      false,
+			}
+		}
+	}
+
+	if err := workflow.SetQueryHandler(ctx, EncryptionKeyRotationProgressQuery, func() (EncryptionKeyRotationProgress, error) {
+		return progress, nil
+	}); err != nil {
+		return nil, fmt.Errorf("register progress query: %w", err)
+	}
+
+	var a *Activities
+	logger := workflow.GetLogger(ctx)
+
+	for i := range progress.Targets {
+		tp := &progress.Targets[i]
+		for !tp.Done {
+			if workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+				return nil, workflow.NewContinueAsNewError(ctx, EncryptionKeyRotationWorkflow, EncryptionKeyRotationInput{
+					PrimaryKeyID: input.PrimaryKeyID,
+					StartedAt:    startedAt,
+					Progress:     progress,
+				})
+			}
+
+			var batch activities.ReencryptBatchResult
+			if err := workflow.ExecuteActivity(ctx, a.ReencryptSecretsBatch, activities.ReencryptBatchInput{
+				PrimaryKeyID: input.PrimaryKeyID,
+				Target:       tp.Target,
+				Cursor:       tp.Cursor,
+				Limit:        encryptionKeyRotationBatchSize,
+			}).Get(ctx, &batch); err != nil {
+				return nil, fmt.Errorf("re-encrypt %s: %w", tp.Target, err)
+			}
+
+			tp.Scanned += batch.Scanned
+			tp.Rotated += batch.Rotated
+			tp.Conflicts += batch.Conflicts
+			tp.Failed += batch.Failed
+			tp.Cursor = batch.Next
+			tp.Done = batch.Done
+
+			logger.Info("encryption key rotation batch completed",
+				"target", string(tp.Target),
+				"scanned", tp.Scanned,
+				"rotated", tp.Rotated,
+				"conflicts", tp.Conflicts,
+				"failed", tp.Failed,
+			)
+		}
+	}
+
+	logger.Info("encryption key rotation complete", "primary_key_id", input.PrimaryKeyID)
+
+	for _, tp := range progress.Targets {
+		if tp.Failed > 0 || tp.Conflicts > 0 {
+			// Failed rows are still under a previous key, and a conflict may
+			// be a write from a replica on the old configuration; either way
+			// the previous keys must stay in the keyring until a later run
+			// comes out clean.
+			logger.Warn("encryption key rotation left ciphertexts under previous keys", "target", string(tp.Target))
+			return &progress, nil
+		}
+	}
+
+	if err := workflow.ExecuteActivity(ctx, a.MarkEncryptionKeysReencrypted, activities.MarkKeysReencryptedInput{
+		PrimaryKeyID: input.PrimaryKeyID,
+		SweptSince:   startedAt,
+	}).Get(ctx, nil); err != nil {
+		return nil, fmt.Errorf("mark encryption keys re-encrypted: %w", err)
+	}
+
+	return &progress, nil
+}
+
+// KickEncryptionKeyRotation starts a rotation sweep for the given primary key
+// after encryptionKeyRotationStartDelay. Like the runtime image recycle, the
+// workflow ID is keyed on the deploy's identity: concurrent kicks from sibling
+// replicas collapse into one sweep, and a later restart sweeps again, which
+// picks up values written by replicas that were still on the old key and
+// otherwise costs one scan that finds nothing to do.
+func KickEncryptionKeyRotation(ctx context.Context, temporalEnv *tenv.Environment, primaryKeyID string) error {
+	_, err := temporalEnv.Client().ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:                    encryptionKeyRotationWorkflowIDPrefix + primaryKeyID,
+		TaskQueue:             string(temporalEnv.Queue()),
+		WorkflowIDReusePolicy: enums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		WorkflowRunTimeout:    encryptionKeyRotationRunTimeout,
+		StartDelay:            encryptionKeyRotationStartDelay,
+	}, EncryptionKeyRotationWorkflow, EncryptionKeyRotationInput{
+		PrimaryKeyID: primaryKeyID,
+		StartedAt:    time.Time{},
+		Progress:     EncryptionKeyRotationProgress{Targets: nil},
+	})
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("start encryption key rotation workflow: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package background
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+
+	"github.com/speakeasy-api/gram/server/internal/background/activities"
+)
+
+func TestEncryptionKeyRotationWorkflow_WalksEveryTargetInBatches(t *testing.T) {
+	t.Parallel()
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflow(EncryptionKeyRotationWorkflow)
+
+	firstPageEnd := activities.ReencryptCursor{AfterID: uuid.New(), AfterOrganizationID: "", AfterName: "STRIPE_KEY"}
+	var calls []activities.ReencryptBatchInput
+	env.RegisterActivityWithOptions(
+		func(_ context.Context, input activities.ReencryptBatchInput) (*activities.ReencryptBatchResult, error) {
+			calls = append(calls, input)
+			require.Equal(t, "2026-10", input.PrimaryKeyID)
+			require.Equal(t, encryptionKeyRotationBatchSize, input.Limit)
+
+			if input.Target == activities.ReencryptTargetEnvironmentEntries && input.Cursor.AfterID == uuid.Nil {
+				return &activities.ReencryptBatchResult{Scanned: 200, Rotated: 198, Conflicts: 1, Failed: 1, Next: firstPageEnd, Done: false}, nil
+			}
+			return &activities.ReencryptBatchResult{Scanned: 3, Rotated: 3, Conflicts: 0, Failed: 0, Next: input.Cursor, Done: true}, nil
+		},
+		activity.RegisterOptions{Name: "ReencryptSecretsBatch"},
+	)
+	env.RegisterActivityWithOptions(
+		func(_ context.Context, _ activities.MarkKeysReencryptedInput) error {
+			require.Fail(t, "a rotation with failures must not mark the previous keys re-encrypted")
+			return nil
+		},
+		activity.RegisterOptions{Name: "MarkEncryptionKeysReencrypted"},
+	)
+
+	env.ExecuteWorkflow(EncryptionKeyRotationWorkflow, EncryptionKeyRotationInput{
+		PrimaryKeyID: "2026-10",
+		StartedAt:    time.Time{},
+		Progress:     EncryptionKeyRotationProgress{Targets: nil},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	require.Len(t, calls, len(activities.ReencryptTargets)+1)
+	require.Equal(t, firstPageEnd, calls[1].Cursor, "the second batch must resume after the first")
+
+	var progress EncryptionKeyRotationProgress
+	require.NoError(t, env.GetWorkflowResult(&progress))
+	require.Len(t, progress.Targets, len(activities.ReencryptTargets))
+	for _, tp := range progress.Targets {
+		require.True(t, tp.Done, "%s should be done", tp.Target)
+	}
+
+	entries := progress.Targets[0]
+	require.Equal(t, activities.ReencryptTargetEnvironmentEntries, entries.Target)
+	require.Equal(t, 203, entries.Scanned)
+	require.Equal(t, 201, entries.Rotated)
+	require.Equal(t, 1, entries.Conflicts)
+	require.Equal(t, 1, entries.Failed)
+
+	value, err := env.QueryWorkflow(EncryptionKeyRotationProgressQuery)
+	require.NoError(t, err)
+	var queried EncryptionKeyRotationProgress
+	require.NoError(t, value.Get(&queried))
+	require.Equal(t, progress, queried)
+}
+
+func TestEncryptionKeyRotationWorkflow_CleanSweepMarksKeysReencrypted(t *testing.T) {
+	t.Parallel()
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflow(EncryptionKeyRotationWorkflow)
+
+	env.RegisterActivityWithOptions(
+		func(_ context.Context, input activities.ReencryptBatchInput) (*activities.ReencryptBatchResult, error) {
+			return &activities.ReencryptBatchResult{Scanned: 2, Rotated: 2, Conflicts: 0, Failed: 0, Next: input.Cursor, Done: true}, nil
+		},
+		activity.RegisterOptions{Name: "ReencryptSecretsBatch"},
+	)
+
+	var marked []activities.MarkKeysReencryptedInput
+	env.RegisterActivityWithOptions(
+		func(_ context.Context, input activities.MarkKeysReencryptedInput) error {
+			marked = append(marked, input)
+			return nil
+		},
+		activity.RegisterOptions{Name: "MarkEncryptionKeysReencrypted"},
+	)
+
+	startedAt := time.Date(2026, time.October, 1, 12, 0, 0, 0, time.UTC)
+	env.ExecuteWorkflow(EncryptionKeyRotationWorkflow, EncryptionKeyRotationInput{
+		PrimaryKeyID: "2026-10",
+		StartedAt:    startedAt,
+		Progress:     EncryptionKeyRotationProgress{Targets: nil},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.Len(t, marked, 1)
+	require.Equal(t, "2026-10", marked[0].PrimaryKeyID)
+	require.True(t, startedAt.Equal(marked[0].SweptSince), "the sweep start must survive continue-as-new")
+}
@@ -0,0 +1,138 @@
+package background
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/speakeasy-api/gram/server/internal/telemetryalerts"
+	tenv "github.com/speakeasy-api/gram/server/internal/temporal"
+)
+
+const (
+	telemetryAlertEvaluationWorkflowID = "v1:telemetry-alert-evaluation"
+	telemetryAlertEvaluationScheduleID = "v1:telemetry-alert-evaluation-schedule"
+
+	// telemetryAlertEvaluationActivityTimeout budgets one sweep: a ClickHouse
+	// aggregation and a short transaction per enabled rule.
+	telemetryAlertEvaluationActivityTimeout = 2 * time.Minute
+
+	telemetryAlertNotifyRunTimeout = 10 * time.Minute
+)
+
+// TelemetryAlertEvaluationWorkflow runs one alert sweep and starts a detached
+// notification workflow per transition. It is scheduled every
+// telemetryalerts.EvaluationInterval with overlap-skip; the compare-and-set
+// on each rule's state makes a retried or overlapping sweep harmless.
+func TelemetryAlertEvaluationWorkflow(ctx workflow.Context) (telemetryalerts.EvaluateResult, error) {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: telemetryAlertEvaluationActivityTimeout,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts:    3,
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2,
+		},
+	})
+
+	var a *Activities
+	var result telemetryalerts.EvaluateResult
+	// The activity only fails before it commits anything. Rules that fail on
+	// their own come back in FailedRuleIDs alongside the transitions that
+	// committed, so those are still announced.
+	if err := workflow.ExecuteActivity(activityCtx, a.EvaluateTelemetryAlerts, workflow.Now(ctx).UTC()).Get(activityCtx, &result); err != nil {
+		return result, fmt.Errorf("evaluate telemetry alerts: %w", err)
+	}
+
+	for _, transition := range result.Transitions {
+		// Email delivery runs in a detached child so a slow provider cannot
+		// hold up the next sweep. The workflow ID dedupes a transition that
+		// is somehow announced twice.
+		childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID:         telemetryAlertNotifyWorkflowID(transition),
+			WorkflowRunTimeout: telemetryAlertNotifyRunTimeout,
+			ParentClosePolicy:  enums.PARENT_CLOSE_POLICY_ABANDON,
+		})
+		if err := workflow.ExecuteChildWorkflow(childCtx, TelemetryAlertNotifyWorkflow, transition).GetChildWorkflowExecution().Get(childCtx, nil); err != nil {
+			return result, fmt.Errorf("start telemetry alert notification: %w", err)
+		}
+	}
+
+	if len(result.FailedRuleIDs) > 0 {
+		return result, fmt.Errorf("evaluate %d telemetry alert rules", len(result.FailedRuleIDs))
+	}
+	return result, nil
+}
+
+func TelemetryAlertNotifyWorkflow(ctx workflow.Context, transition telemetryalerts.Transition) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts:    10,
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    30 * time.Second,
+		},
+	})
+
+	var a *Activities
+	if err := workflow.ExecuteActivity(ctx, a.NotifyTelemetryAlert, transition).Get(ctx, nil); err != nil {
+		return fmt.Errorf("notify telemetry alert: %w", err)
+	}
+	return nil
+}
+
+func telemetryAlertNotifyWorkflowID(transition telemetryalerts.Transition) string {
+	return fmt.Sprintf("v1:telemetry-alert-notify:%s:%s", transition.IncidentID, transition.State)
+}
+
+func AddTelemetryAlertEvaluationSchedule(ctx context.Context, temporalEnv *tenv.Environment) error {
+	scheduleClient := temporalEnv.Client().ScheduleClient()
+	options := buildTelemetryAlertEvaluationScheduleOptions(temporalEnv)
+
+	_, err := scheduleClient.Create(ctx, options)
+	if err != nil && !errors.Is(err, temporal.ErrScheduleAlreadyRunning) {
+		return fmt.Errorf("create telemetry alert evaluation schedule: %w", err)
+	}
+
+	if err := scheduleClient.GetHandle(ctx, telemetryAlertEvaluationScheduleID).Update(ctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(input client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			schedule := input.Description.Schedule
+			schedule.Spec = &options.Spec
+			schedule.Action = options.Action
+			if schedule.Policy == nil {
+				schedule.Policy = &client.SchedulePolicies{
+					Overlap:        enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+					CatchupWindow:  0,
+					PauseOnFailure: false,
+				}
+			}
+			return &client.ScheduleUpdate{Schedule: &schedule, TypedSearchAttributes: nil}, nil
+		},
+	}); err != nil {
+		return fmt.Errorf("update telemetry alert evaluation schedule: %w", err)
+	}
+	return nil
+}
+
+func buildTelemetryAlertEvaluationScheduleOptions(temporalEnv *tenv.Environment) client.ScheduleOptions {
+	return client.ScheduleOptions{
+		ID:      telemetryAlertEvaluationScheduleID,
+		Overlap: enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+		Spec: client.ScheduleSpec{
+			Intervals: []client.ScheduleIntervalSpec{{Every: telemetryalerts.EvaluationInterval}},
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:                 telemetryAlertEvaluationWorkflowID,
+			Workflow:           TelemetryAlertEvaluationWorkflow,
+			Args:               nil,
+			TaskQueue:          string(temporalEnv.Queue()),
+			WorkflowRunTimeout: 3 * telemetryAlertEvaluationActivityTimeout,
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package background
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	tenv "github.com/speakeasy-api/gram/server/internal/temporal"
+	"github.com/speakeasy-api/gram/server/internal/toolerrorspikes"
+)
+
+const (
+	toolErrorSpikeDetectionWorkflowID = "v1:tool-error-spike-detection"
+	toolErrorSpikeDetectionScheduleID = "v1:tool-error-spike-detection-schedule"
+
+	// toolErrorSpikeDetectionActivityTimeout budgets one sweep: a single
+	// ClickHouse aggregation over the baseline window plus one short
+	// transaction per spiking tool.
+	toolErrorSpikeDetectionActivityTimeout = 2 * time.Minute
+)
+
+// ToolErrorSpikeDetectionWorkflow runs one spike sweep. It is scheduled every
+// toolerrorspikes.DetectionInterval with overlap-skip; the cooldown claim
+// makes a retried or overlapping sweep harmless.
+func ToolErrorSpikeDetectionWorkflow(ctx workflow.Context) (toolerrorspikes.DetectResult, error) {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: toolErrorSpikeDetectionActivityTimeout,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts:    3,
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2,
+		},
+	})
+
+	var a *Activities
+	var result toolerrorspikes.DetectResult
+	if err := workflow.ExecuteActivity(activityCtx, a.DetectToolErrorSpikes).Get(activityCtx, &result); err != nil {
+		return result, fmt.Errorf("detect tool error spikes: %w", err)
+	}
+	return result, nil
+}
+
+func AddToolErrorSpikeDetectionSchedule(ctx context.Context, temporalEnv *tenv.Environment) error {
+	scheduleClient := temporalEnv.Client().ScheduleClient()
+	options := buildToolErrorSpikeDetectionScheduleOptions(temporalEnv)
+
+	_, err := scheduleClient.Create(ctx, options)
+	if err != nil && !errors.Is(err, temporal.ErrScheduleAlreadyRunning) {
+		return fmt.Errorf("create tool error spike detection schedule: %w", err)
+	}
+
+	if err := scheduleClient.GetHandle(ctx, toolErrorSpikeDetectionScheduleID).Update(ctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(input client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			schedule := input.Description.Schedule
+			schedule.Spec = &options.Spec
+			schedule.Action = options.Action
+			if schedule.Policy == nil {
+				schedule.Policy = &client.SchedulePolicies{
+					Overlap:        enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+					CatchupWindow:  0,
+					PauseOnFailure: false,
+				}
+			}
+			return &client.ScheduleUpdate{Schedule: &schedule, TypedSearchAttributes: nil}, nil
+		},
+	}); err != nil {
+		return fmt.Errorf("update tool error spike detection schedule: %w", err)
+	}
+	return nil
+}
+
+func buildToolErrorSpikeDetectionScheduleOptions(temporalEnv *tenv.Environment) client.ScheduleOptions {
+	return client.ScheduleOptions{
+		ID:      toolErrorSpikeDetectionScheduleID,
+		Overlap: enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+		Spec: client.ScheduleSpec{
+			Intervals: []client.ScheduleIntervalSpec{{Every: toolerrorspikes.DetectionInterval}},
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:                 toolErrorSpikeDetectionWorkflowID,
+			Workflow:           ToolErrorSpikeDetectionWorkflow,
+			Args:               nil,
+			TaskQueue:          string(temporalEnv.Queue()),
+			WorkflowRunTimeout: 3 * toolErrorSpikeDetectionActivityTimeout,
+		},
+	}
+}
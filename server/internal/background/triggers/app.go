@@ -62,6 +62,7 @@ const (
 
 type EnvironmentLoader interface {
 	Load(context.Context, uuid.UUID, toolconfig.SlugOrID) (map[string]string, error)
+	ResolveSecretRefs(context.Context, uuid.UUID, *toolconfig.CaseInsensitiveEnv, []string) error
 }
 
 type DeliveryLogger interface {
@@ -727,6 +728,20 @@ func (a *App) ProcessWebhook(ctx context.Context, instanceID uuid.UUID, body []b
 		if err != nil {
 			return nil, fmt.Errorf("load environment: %w", err)
 		}
+
+		// Only the variables the definition declares are read, so only
+		// their secret references are resolved.
+		env := toolconfig.CIEnvFrom(envMap)
+		names := make([]string, 0, len(definition.EnvRequirements))
+		for _, requirement := range definition.EnvRequirements {
+			names = append(names, requirement.Name)
+		}
+		if err := a.envLoader.ResolveSecretRefs(ctx, instance.ProjectID, env, names); err != nil {
+			return nil, fmt.Errorf("resolve environment secret references: %w", err)
+		}
+		for name := range envMap {
+			envMap[name] = env.Get(name)
+		}
 	}
 
 	if err := definition.AuthenticateWebhook(ctx, body, headers, envMap, config); err != nil {
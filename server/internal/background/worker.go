@@ -38,6 +38,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/email"
 	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
 	"github.com/speakeasy-api/gram/server/internal/externalmcp"
 	"github.com/speakeasy-api/gram/server/internal/feature"
 	"github.com/speakeasy-api/gram/server/internal/functions"
@@ -68,6 +69,7 @@ type WorkerOptions struct {
 	GuardianPolicy      *guardian.Policy
 	DB                  *pgxpool.Pool
 	EncryptionClient    *encryption.Client
+	OrganizationKeys    *envelope.Keyring
 	FeatureProvider     feature.Provider
 	AssetStorage        assets.BlobStore
 	SlackClient         *slack_client.SlackClient
@@ -78,6 +80,10 @@ type WorkerOptions struct {
 	K8sClient           *k8s.KubernetesClients
 	ExpectedTargetCNAME string
 
+	// AuditExportStorage is the bucket audit logs are exported to. Nil leaves
+	// the scheduled export a no-op.
+	AuditExportStorage assets.BlobStore
+
 	// GitHubEvidenceToken authenticates the recheck sweep's repository
 	// lookups; empty falls back to GitHub's small unauthenticated budget.
 	GitHubEvidenceToken string
@@ -150,6 +156,7 @@ func ForDeploymentProcessing(
 		DB:                  db,
 		GuardianPolicy:      guardianPolicy,
 		EncryptionClient:    enc,
+		OrganizationKeys:    nil,
 		FeatureProvider:     f,
 		AssetStorage:        assetStorage,
 		FunctionsDeployer:   deployer,
@@ -163,6 +170,7 @@ func ForDeploymentProcessing(
 		OpenRouterSpend:     nil,
 		K8sClient:           nil,
 		ExpectedTargetCNAME: "",
+		AuditExportStorage:  nil,
 		GitHubEvidenceToken: "",
 		SiteURL:             nil,
 		BillingTracker:      nil,
@@ -217,6 +225,7 @@ func NewTemporalWorker(
 		GuardianPolicy:            nil,
 		DB:                        nil,
 		EncryptionClient:          nil,
+		OrganizationKeys:          nil,
 		FeatureProvider:           nil,
 		AssetStorage:              nil,
 		SlackClient:               nil,
@@ -226,6 +235,7 @@ func NewTemporalWorker(
 		OpenRouterSpend:           nil,
 		K8sClient:                 nil,
 		ExpectedTargetCNAME:       "",
+		AuditExportStorage:        nil,
 		GitHubEvidenceToken:       "",
 		SiteURL:                   nil,
 		BillingTracker:            nil,
@@ -266,6 +276,7 @@ func NewTemporalWorker(
 			GuardianPolicy:            conv.Default(o.GuardianPolicy, opts.GuardianPolicy),
 			DB:                        conv.Default(o.DB, opts.DB),
 			EncryptionClient:          conv.Default(o.EncryptionClient, opts.EncryptionClient),
+			OrganizationKeys:          conv.Default(o.OrganizationKeys, opts.OrganizationKeys),
 			FeatureProvider:           conv.Default(o.FeatureProvider, opts.FeatureProvider),
 			AssetStorage:              conv.Default(o.AssetStorage, opts.AssetStorage),
 			SlackClient:               conv.Default(o.SlackClient, opts.SlackClient),
@@ -275,6 +286,7 @@ func NewTemporalWorker(
 			ChatClient:                conv.Default(o.ChatClient, opts.ChatClient),
 			K8sClient:                 conv.Default(o.K8sClient, opts.K8sClient),
 			ExpectedTargetCNAME:       conv.Default(o.ExpectedTargetCNAME, opts.ExpectedTargetCNAME),
+			AuditExportStorage:        conv.Default(o.AuditExportStorage, opts.AuditExportStorage),
 			GitHubEvidenceToken:       conv.Default(o.GitHubEvidenceToken, opts.GitHubEvidenceToken),
 			SiteURL:                   conv.Default(o.SiteURL, opts.SiteURL),
 			BillingTracker:            conv.Default(o.BillingTracker, opts.BillingTracker),
@@ -354,6 +366,7 @@ func NewTemporalWorker(
 		opts.GuardianPolicy,
 		opts.DB,
 		opts.EncryptionClient,
+		opts.OrganizationKeys,
 		opts.FeatureProvider,
 		opts.AssetStorage,
 		opts.SlackClient,
@@ -362,6 +375,7 @@ func NewTemporalWorker(
 		opts.ChatClient,
 		opts.K8sClient,
 		opts.ExpectedTargetCNAME,
+		opts.AuditExportStorage,
 		opts.SiteURL,
 		opts.BillingTracker,
 		opts.BillingRepository,
@@ -433,6 +447,7 @@ func NewTemporalWorker(
 	temporalWorker.RegisterActivity(activities.ForwardTokenUsageToPostHog)
 	temporalWorker.RegisterActivity(activities.GetAllOrganizations)
 	temporalWorker.RegisterActivity(activities.ValidateDeployment)
+	temporalWorker.RegisterActivity(activities.CheckBreakingChanges)
 	temporalWorker.RegisterActivity(activities.GenerateToolsetEmbeddings)
 	temporalWorker.RegisterActivity(activities.GenerateChatTitle)
 	temporalWorker.RegisterActivity(activities.SyncIdentityMap)
@@ -486,9 +501,16 @@ func NewTemporalWorker(
 	temporalWorker.RegisterActivity(activities.ListSpendRuleOrgs)
 	temporalWorker.RegisterActivity(activities.EvaluateOrgSpendRules)
 	temporalWorker.RegisterActivity(activities.RefreshSpendRuleActor)
+	temporalWorker.RegisterActivity(activities.DetectToolErrorSpikes)
+	temporalWorker.RegisterActivity(activities.EvaluateTelemetryAlerts)
+	temporalWorker.RegisterActivity(activities.NotifyTelemetryAlert)
+	temporalWorker.RegisterActivity(activities.ExportAuditLogs)
 	// Pre-emptive remote session refresh activities
 	temporalWorker.RegisterActivity(activities.ClaimDueRemoteSessionRefreshCandidates)
 	temporalWorker.RegisterActivity(activities.RefreshRemoteSession)
+	// Encryption key rotation activities
+	temporalWorker.RegisterActivity(activities.ReencryptSecretsBatch)
+	temporalWorker.RegisterActivity(activities.MarkEncryptionKeysReencrypted)
 	// Trial expiry activities
 	temporalWorker.RegisterActivity(activities.ListExpiredTrials)
 	temporalWorker.RegisterActivity(activities.DemoteExpiredTrial)
@@ -608,6 +630,10 @@ func NewTemporalWorker(
 	temporalWorker.RegisterWorkflow(SpendRuleOrgEvaluationWorkflowDebounced)
 	temporalWorker.RegisterWorkflow(SpendRuleActorEvaluationWorkflow)
 	temporalWorker.RegisterWorkflow(SpendRuleActorEvaluationWorkflowDebounced)
+	temporalWorker.RegisterWorkflow(ToolErrorSpikeDetectionWorkflow)
+	temporalWorker.RegisterWorkflow(TelemetryAlertEvaluationWorkflow)
+	temporalWorker.RegisterWorkflow(TelemetryAlertNotifyWorkflow)
+	temporalWorker.RegisterWorkflow(AuditLogExportWorkflow)
 	// Skill efficacy workflows
 	temporalWorker.RegisterWorkflow(SkillEfficacyCoordinatorWorkflow)
 	temporalWorker.RegisterWorkflow(SkillEfficacySweepWorkflow)
@@ -622,6 +648,8 @@ func NewTemporalWorker(
 	temporalWorker.RegisterWorkflow(TrialLifecycleEmailWorkflow)
 	temporalWorker.RegisterWorkflow(AccessPausedEmailWorkflow)
 	temporalWorker.RegisterWorkflow(PaygActivatedEmailWorkflow)
+	// Encryption key rotation
+	temporalWorker.RegisterWorkflow(EncryptionKeyRotationWorkflow)
 
 	return &Workers{
 		main:              temporalWorker,
@@ -712,6 +740,17 @@ func (w *Workers) registerSchedules(ctx context.Context) {
 		}
 	}
 
+	// A new primary encryption key is deployed by restarting with it, so the
+	// startup kick is the rotation signal. Legacy keys without an ID cannot
+	// be told apart in storage and are never swept.
+	if opts.EncryptionClient != nil {
+		if keyID := opts.EncryptionClient.PrimaryKeyID(); keyID != "" {
+			if err := KickEncryptionKeyRotation(ctx, env, keyID); err != nil {
+				logger.ErrorContext(ctx, "failed to kick encryption key rotation", attr.SlogEncryptionKeyID(keyID), attr.SlogError(err))
+			}
+		}
+	}
+
 	if err := AddOutboxGCSchedule(ctx, env); err != nil {
 		logger.ErrorContext(ctx, "failed to add outbox gc schedule", attr.SlogError(err))
 	}
@@ -738,6 +777,18 @@ func (w *Workers) registerSchedules(ctx context.Context) {
 		}
 	}
 
+	if err := AddToolErrorSpikeDetectionSchedule(ctx, env); err != nil {
+		logger.ErrorContext(ctx, "failed to add tool error spike detection schedule", attr.SlogError(err))
+	}
+
+	if err := AddTelemetryAlertEvaluationSchedule(ctx, env); err != nil {
+		logger.ErrorContext(ctx, "failed to add telemetry alert evaluation schedule", attr.SlogError(err))
+	}
+
+	if err := AddAuditLogExportSchedule(ctx, env); err != nil {
+		logger.ErrorContext(ctx, "failed to add audit log export schedule", attr.SlogError(err))
+	}
+
 	if err := AddSkillObservationReconciliationSchedule(ctx, env); err != nil {
 		logger.ErrorContext(ctx, "failed to add skill observation reconciliation schedule", attr.SlogError(err))
 	}
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+)
+
+// TargetCacheTTL bounds staleness of a TargetCache. Writes evict eagerly (see
+// TargetCache.Invalidate), so this is only the ceiling for a change that slips
+// past invalidation, e.g. an eviction that failed against a briefly
+// unavailable Redis.
+const TargetCacheTTL = 10 * time.Minute
+
+// TargetLoader lists the live rows attached to a toolset or an MCP server,
+// exactly one of which is set.
+type TargetLoader[T any] func(ctx context.Context, projectID uuid.UUID, toolsetID uuid.NullUUID, mcpServerID uuid.NullUUID) ([]T, error)
+
+// targetEntry is the cached set of rows attached to one toolset or MCP server,
+// across all of its tools. An empty set is a valid negative entry: most
+// targets have nothing attached and must not cost a query per call.
+type targetEntry[T any] struct {
+	Key   string `json:"key"`
+	Items []T    `json:"items"`
+}
+
+func (e targetEntry[T]) CacheKey() string {
+	return e.Key
+}
+
+func (e targetEntry[T]) TTL() time.Duration {
+	return TargetCacheTTL
+}
+
+// TargetCache serves the rows attached to a toolset or MCP server, such as
+// tool call rate limits or constraints, through a Redis pull-through cache over
+// Postgres, so enforcing them does not query the database on every
+// tools/call. One instance is shared by the enforcing side, which reads it,
+// and the service that owns the rows, which evicts it on every write.
+type TargetCache[T any] struct {
+	logger    *slog.Logger
+	namespace string
+	load      TargetLoader[T]
+	cache     TypedCacheObject[targetEntry[T]]
+}
+
+// NewTargetCache builds a cache whose keys are prefixed with namespace and
+// whose misses are filled by load.
+func NewTargetCache[T any](logger *slog.Logger, c Cache, namespace string, load TargetLoader[T]) *TargetCache[T] {
+	logger = logger.With(attr.SlogCacheNamespace(namespace))
+	return &TargetCache[T]{
+		logger:    logger,
+		namespace: namespace,
+		load:      load,
+		cache:     NewTypedObjectCache[targetEntry[T]](logger, c, SuffixNone),
+	}
+}
+
+// Get returns the live rows attached to a toolset or an MCP server, exactly
+// one of which is set.
+func (c *TargetCache[T]) Get(ctx context.Context, projectID uuid.UUID, toolsetID uuid.NullUUID, mcpServerID uuid.NullUUID) ([]T, error) {
+	key := c.key(toolsetID, mcpServerID)
+
+	if cached, err := c.cache.Get(ctx, key); err == nil {
+		return cached.Items, nil
+	}
+
+	items, err := c.load(ctx, projectID, toolsetID, mcpServerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.cache.Store(ctx, targetEntry[T]{Key: key, Items: items}); err != nil {
+		c.logger.WarnContext(ctx, "cache target entry",
+			attr.SlogError(err),
+			attr.SlogProjectID(projectID.String()),
+		)
+	}
+
+	return items, nil
+}
+
+// Invalidate evicts the cached rows of a toolset or an MCP server, exactly one
+// of which is set, so a write takes effect before the TTL lapses.
+func (c *TargetCache[T]) Invalidate(ctx context.Context, toolsetID uuid.NullUUID, mcpServerID uuid.NullUUID) error {
+	if err := c.cache.DeleteByKey(ctx, c.key(toolsetID, mcpServerID)); err != nil {
+		return fmt.Errorf("invalidate %s target: %w", c.namespace, err)
+	}
+	return nil
+}
+
+func (c *TargetCache[T]) key(toolsetID uuid.NullUUID, mcpServerID uuid.NullUUID) string {
+	targetID := toolsetID.UUID
+	if mcpServerID.Valid {
+		targetID = mcpServerID.UUID
+	}
+	return fmt.Sprintf("%s:target:%s", c.namespace, targetID)
+}
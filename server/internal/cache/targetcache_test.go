@@ -0,0 +1,57 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+func TestTargetCache_PullsThroughUntilInvalidated(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	projectID := uuid.New()
+	toolsetID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+	mcpServerID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+	none := uuid.NullUUID{UUID: uuid.Nil, Valid: false}
+
+	loads := map[uuid.UUID]int{}
+	rows := map[uuid.UUID][]string{toolsetID.UUID: {"a", "b"}}
+	targets := cache.NewTargetCache(testenv.NewLogger(t), testenv.NewMemoryCache(), "test", func(_ context.Context, gotProjectID uuid.UUID, gotToolsetID uuid.NullUUID, gotMcpServerID uuid.NullUUID) ([]string, error) {
+		require.Equal(t, projectID, gotProjectID)
+		require.NotEqual(t, gotToolsetID.Valid, gotMcpServerID.Valid, "exactly one target must be set")
+		targetID := gotToolsetID.UUID
+		if gotMcpServerID.Valid {
+			targetID = gotMcpServerID.UUID
+		}
+		loads[targetID]++
+		return rows[targetID], nil
+	})
+
+	for range 3 {
+		items, err := targets.Get(ctx, projectID, toolsetID, none)
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b"}, items)
+
+		// A target with nothing attached is cached as a negative entry.
+		items, err = targets.Get(ctx, projectID, none, mcpServerID)
+		require.NoError(t, err)
+		require.Empty(t, items)
+	}
+	require.Equal(t, 1, loads[toolsetID.UUID])
+	require.Equal(t, 1, loads[mcpServerID.UUID])
+
+	rows[mcpServerID.UUID] = []string{"c"}
+	require.NoError(t, targets.Invalidate(ctx, none, mcpServerID))
+
+	items, err := targets.Get(ctx, projectID, none, mcpServerID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c"}, items)
+	require.Equal(t, 2, loads[mcpServerID.UUID])
+	require.Equal(t, 1, loads[toolsetID.UUID], "invalidating one target must not evict another")
+}
@@ -0,0 +1,139 @@
+// Package canary routes a share of an MCP endpoint's tool calls to a
+// candidate deployment while the rest keep reading from a baseline
+// deployment. The split travels on the request context so that tool lookups
+// deep in mv and toolsets can pin their queries without threading a
+// deployment ID through every signature.
+package canary
+
+import (
+	"context"
+	"hash/fnv"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+)
+
+// StickyBy selects which request attribute keeps a caller on the same arm.
+type StickyBy string
+
+const (
+	StickyBySession StickyBy = "session"
+	StickyByUser    StickyBy = "user"
+)
+
+// Arm is one side of a canary split.
+type Arm string
+
+const (
+	ArmBaseline  Arm = "baseline"
+	ArmCandidate Arm = "candidate"
+)
+
+// Split describes how an endpoint's traffic is divided between two
+// deployments.
+type Split struct {
+	ID                    uuid.UUID
+	BaselineDeploymentID  uuid.UUID
+	CandidateDeploymentID uuid.UUID
+	// Percentage is the share of sticky keys, 0 to 100, routed to the
+	// candidate deployment.
+	Percentage int
+	StickyBy   StickyBy
+}
+
+// Assign picks the arm for a sticky key. A key always lands on the same arm
+// for a given canary, and raising the percentage only ever moves keys from
+// the baseline to the candidate. Requests without a key stay on the
+// baseline.
+func (s Split) Assign(key string) Arm {
+	if key == "" || s.Percentage <= 0 {
+		return ArmBaseline
+	}
+	if s.Percentage >= 100 {
+		return ArmCandidate
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write(s.ID[:])
+	_, _ = h.Write([]byte(key))
+	if h.Sum64()%100 < uint64(s.Percentage) {
+		return ArmCandidate
+	}
+	return ArmBaseline
+}
+
+// DeploymentFor returns the deployment serving an arm.
+func (s Split) DeploymentFor(arm Arm) uuid.UUID {
+	if arm == ArmCandidate {
+		return s.CandidateDeploymentID
+	}
+	return s.BaselineDeploymentID
+}
+
+type contextKey struct{}
+
+type routing struct {
+	split     Split
+	sessionID string
+}
+
+// WithSplit attaches a split to the request context. sessionID is the
+// request's Mcp-Session-Id, used as the sticky key for session stickiness
+// and as the fallback when a user-sticky request is anonymous.
+func WithSplit(ctx context.Context, split Split, sessionID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, &routing{split: split, sessionID: sessionID})
+}
+
+// WithDeployment pins every tool lookup made with the returned context to a
+// single deployment, outside of any canary.
+func WithDeployment(ctx context.Context, deploymentID uuid.UUID) context.Context {
+	return WithSplit(ctx, Split{
+		ID:                    uuid.Nil,
+		BaselineDeploymentID:  deploymentID,
+		CandidateDeploymentID: deploymentID,
+		Percentage:            0,
+		StickyBy:              StickyBySession,
+	}, "")
+}
+
+// ArmFromContext reports the arm the request was assigned to, if it is
+// running under a canary.
+func ArmFromContext(ctx context.Context) (Arm, bool) {
+	r, ok := ctx.Value(contextKey{}).(*routing)
+	if !ok || r == nil {
+		return "", false
+	}
+	return r.split.Assign(stickyKey(ctx, r)), true
+}
+
+// DeploymentID returns the deployment tool lookups should read from. It is
+// invalid when the request is not under a canary, in which case lookups
+// follow the project's active deployment.
+func DeploymentID(ctx context.Context) uuid.NullUUID {
+	r, ok := ctx.Value(contextKey{}).(*routing)
+	if !ok || r == nil {
+		return uuid.NullUUID{UUID: uuid.Nil, Valid: false}
+	}
+	arm := r.split.Assign(stickyKey(ctx, r))
+	return uuid.NullUUID{UUID: r.split.DeploymentFor(arm), Valid: true}
+}
+
+// stickyKey is evaluated lazily because the user is only known once the
+// MCP handler has authenticated the request, after the split was attached.
+func stickyKey(ctx context.Context, r *routing) string {
+	if r.split.StickyBy == StickyByUser {
+		if authCtx, ok := contextvalues.GetAuthContext(ctx); ok && authCtx != nil {
+			switch {
+			case authCtx.UserID != "":
+				return "user:" + authCtx.UserID
+			case authCtx.ExternalUserID != "":
+				return "external:" + authCtx.ExternalUserID
+			}
+		}
+	}
+	if r.sessionID == "" {
+		return ""
+	}
+	return "session:" + r.sessionID
+}
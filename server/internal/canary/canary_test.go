@@ -0,0 +1,77 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+)
+
+func testSplit(percentage int, stickyBy StickyBy) Split {
+	return Split{
+		ID:                    uuid.MustParse("8d1c3b0e-6f0f-4a53-9a4f-2f0b8e7f2b11"),
+		BaselineDeploymentID:  uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+		CandidateDeploymentID: uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+		Percentage:            percentage,
+		StickyBy:              stickyBy,
+	}
+}
+
+func TestAssignIsStickyAndMonotonic(t *testing.T) {
+	t.Parallel()
+
+	low, high := testSplit(10, StickyBySession), testSplit(50, StickyBySession)
+	candidates := 0
+	for i := range 2000 {
+		key := fmt.Sprintf("session-%d", i)
+		arm := high.Assign(key)
+		require.Equal(t, arm, high.Assign(key))
+		if low.Assign(key) == ArmCandidate {
+			require.Equal(t, ArmCandidate, arm, "raising the percentage must not move %s back to baseline", key)
+		}
+		if arm == ArmCandidate {
+			candidates++
+		}
+	}
+	require.InDelta(t, 1000, candidates, 150)
+}
+
+func TestAssignBounds(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, ArmBaseline, testSplit(0, StickyBySession).Assign("k"))
+	require.Equal(t, ArmCandidate, testSplit(100, StickyBySession).Assign("k"))
+	require.Equal(t, ArmBaseline, testSplit(100, StickyBySession).Assign(""))
+}
+
+func TestDeploymentIDFromContext(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, DeploymentID(t.Context()).Valid)
+
+	split := testSplit(100, StickyByUser)
+	anonymous := WithSplit(t.Context(), split, "")
+	require.Equal(t, split.BaselineDeploymentID, DeploymentID(anonymous).UUID)
+
+	ctx := contextvalues.SetAuthContext(WithSplit(context.Background(), split, ""), &contextvalues.AuthContext{UserID: "user_1"})
+	got := DeploymentID(ctx)
+	require.True(t, got.Valid)
+	require.Equal(t, split.CandidateDeploymentID, got.UUID)
+
+	arm, ok := ArmFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, ArmCandidate, arm)
+}
+
+func TestWithDeploymentPinsLookups(t *testing.T) {
+	t.Parallel()
+
+	deploymentID := uuid.New()
+	got := DeploymentID(WithDeployment(t.Context(), deploymentID))
+	require.True(t, got.Valid)
+	require.Equal(t, deploymentID, got.UUID)
+}
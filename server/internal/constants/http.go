@@ -4,6 +4,9 @@ const (
 	HeaderProxiedResponse  = "X-Gram-Proxy-Response"
 	HeaderFilteredResponse = "X-Gram-Proxy-ResponseFiltered"
 	HeaderSource           = "X-Gram-Source"
+	// HeaderToolCache reports how the gateway's response cache served a tool
+	// call: hit, miss or revalidated.
+	HeaderToolCache = "X-Gram-Tool-Cache"
 	// HeaderAssistantID carries the assistant id on setup/onboarding
 	// completions (X-Gram-Source: assistant) so the completion handler can
 	// link the chat to the assistant via an assistant_threads row, making the
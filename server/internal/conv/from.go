@@ -235,6 +235,24 @@ func PtrToPGInt8(v *int) pgtype.Int8 {
 	return pgtype.Int8{Int64: int64(*v), Valid: true}
 }
 
+// PtrInt64ToPGInt8 converts an int64 pointer to a pgtype.Int8. If the pointer
+// is nil, the result has Valid set to false.
+func PtrInt64ToPGInt8(v *int64) pgtype.Int8 {
+	if v == nil {
+		return pgtype.Int8{Int64: 0, Valid: false}
+	}
+	return pgtype.Int8{Int64: *v, Valid: true}
+}
+
+// PtrToPGInt4 converts an int32 pointer to a pgtype.Int4. If the pointer is
+// nil, the result has Valid set to false.
+func PtrToPGInt4(v *int32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{Int32: 0, Valid: false}
+	}
+	return pgtype.Int4{Int32: *v, Valid: true}
+}
+
 // PtrToPGBool converts a bool pointer to a pgtype.Bool. If the pointer is nil,
 // the result has Valid set to false.
 func PtrToPGBool(b *bool) pgtype.Bool {
@@ -264,6 +282,14 @@ func FromPGInt4(t pgtype.Int4) *int32 {
 	return &t.Int32
 }
 
+// FromPGInt8 converts a pgtype.Int8 to *int64. If not valid, returns nil.
+func FromPGInt8(t pgtype.Int8) *int64 {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Int64
+}
+
 // PtrInt32ToInt converts a *int32 to *int. If nil, returns nil.
 func PtrInt32ToInt(v *int32) *int {
 	if v == nil {
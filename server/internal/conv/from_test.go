@@ -104,6 +104,33 @@ func TestFromPGInt4_Invalid(t *testing.T) {
 	require.Nil(t, result)
 }
 
+func TestFromPGInt8_Valid(t *testing.T) {
+	t.Parallel()
+
+	input := pgtype.Int8{Int64: 42, Valid: true}
+	result := conv.FromPGInt8(input)
+
+	require.NotNil(t, result)
+	require.Equal(t, int64(42), *result)
+}
+
+func TestFromPGInt8_Invalid(t *testing.T) {
+	t.Parallel()
+
+	input := pgtype.Int8{Int64: 0, Valid: false}
+	result := conv.FromPGInt8(input)
+
+	require.Nil(t, result)
+}
+
+func TestPtrInt64ToPGInt8(t *testing.T) {
+	t.Parallel()
+
+	v := int64(7)
+	require.Equal(t, pgtype.Int8{Int64: 7, Valid: true}, conv.PtrInt64ToPGInt8(&v))
+	require.False(t, conv.PtrInt64ToPGInt8(nil).Valid)
+}
+
 func TestPtrInt32ToInt_NonNil(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,8 @@ package conv
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/speakeasy-api/gram/server/gen/types"
@@ -216,6 +218,9 @@ func ApplyVariation(tool types.Tool, variation types.ToolVariation) {
 		if newSchema, err := variedToolSchema(baseTool.Schema, baseTool.Summarizer); err == nil {
 			tool.HTTPToolDefinition.Schema = newSchema
 		}
+		if newSchema, err := boundToolSchema(tool.HTTPToolDefinition.Schema, variation.ArgumentBindings); err == nil {
+			tool.HTTPToolDefinition.Schema = newSchema
+		}
 	}
 
 	if tool.PromptTemplate != nil {
@@ -232,6 +237,9 @@ func ApplyVariation(tool types.Tool, variation types.ToolVariation) {
 		if newSchema, err := variedToolSchema(baseTool.Schema, baseTool.Summarizer); err == nil {
 			tool.PromptTemplate.Schema = newSchema
 		}
+		if newSchema, err := boundToolSchema(tool.PromptTemplate.Schema, variation.ArgumentBindings); err == nil {
+			tool.PromptTemplate.Schema = newSchema
+		}
 	}
 
 	if tool.FunctionToolDefinition != nil {
@@ -248,6 +256,9 @@ func ApplyVariation(tool types.Tool, variation types.ToolVariation) {
 		if newSchema, err := variedToolSchema(baseTool.Schema, baseTool.Summarizer); err == nil {
 			tool.FunctionToolDefinition.Schema = newSchema
 		}
+		if newSchema, err := boundToolSchema(tool.FunctionToolDefinition.Schema, variation.ArgumentBindings); err == nil {
+			tool.FunctionToolDefinition.Schema = newSchema
+		}
 	}
 
 	if tool.PlatformToolDefinition != nil {
@@ -264,6 +275,9 @@ func ApplyVariation(tool types.Tool, variation types.ToolVariation) {
 		if newSchema, err := variedToolSchema(baseTool.Schema, baseTool.Summarizer); err == nil {
 			tool.PlatformToolDefinition.Schema = newSchema
 		}
+		if newSchema, err := boundToolSchema(tool.PlatformToolDefinition.Schema, variation.ArgumentBindings); err == nil {
+			tool.PlatformToolDefinition.Schema = newSchema
+		}
 	}
 
 	if tool.ExternalMcpToolDefinition != nil {
@@ -276,6 +290,10 @@ func ApplyVariation(tool types.Tool, variation types.ToolVariation) {
 
 		tool.ExternalMcpToolDefinition.Canonical = &canonicalAttributes
 		tool.ExternalMcpToolDefinition.Variation = &variation
+
+		if newSchema, err := boundToolSchema(tool.ExternalMcpToolDefinition.Schema, variation.ArgumentBindings); err == nil {
+			tool.ExternalMcpToolDefinition.Schema = newSchema
+		}
 	}
 }
 
@@ -361,6 +379,170 @@ func variedToolSchema(baseSchema string, summarizer *string) (string, error) {
 	return schema, nil
 }
 
+// boundToolSchema removes arguments bound by a variation from a tool's input
+// schema, so that the model is neither asked for them nor able to supply them.
+// Each binding names a dotted path through nested object properties; a
+// property reached through a local $ref is removed from an inlined copy of the
+// referenced schema, leaving the shared definition intact.
+func boundToolSchema(baseSchema string, bindings []*types.ToolArgumentBinding) (string, error) {
+	if len(bindings) == 0 || baseSchema == "" {
+		return baseSchema, nil
+	}
+
+	var jsonSchema map[string]any
+	if err := json.Unmarshal([]byte(baseSchema), &jsonSchema); err != nil {
+		return "", errors.New("failed to unmarshal schema")
+	}
+
+	for _, binding := range bindings {
+		if binding == nil {
+			continue
+		}
+		removeSchemaProperty(jsonSchema, jsonSchema, strings.Split(binding.Argument, "."))
+	}
+
+	newSchema, err := json.Marshal(jsonSchema)
+	if err != nil {
+		return "", errors.New("failed to marshal schema")
+	}
+
+	return string(newSchema), nil
+}
+
+func removeSchemaProperty(root map[string]any, schema map[string]any, path []string) {
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	name := path[0]
+	if len(path) == 1 {
+		delete(properties, name)
+		if required, ok := schema["required"].([]any); ok {
+			kept := make([]any, 0, len(required))
+			for _, r := range required {
+				if r != name {
+					kept = append(kept, r)
+				}
+			}
+			schema["required"] = kept
+		}
+		return
+	}
+
+	child, ok := properties[name].(map[string]any)
+	if !ok {
+		return
+	}
+	if ref, ok := child["$ref"].(string); ok {
+		resolved := resolveLocalSchemaRef(root, ref)
+		if resolved == nil {
+			return
+		}
+		child = resolved
+		properties[name] = child
+	}
+
+	removeSchemaProperty(root, child, path[1:])
+}
+
+// resolveLocalSchemaRef returns a shallow copy of the schema a local $ref
+// points to, with its properties copied so they can be edited in place.
+func resolveLocalSchemaRef(root map[string]any, ref string) map[string]any {
+	var defs map[string]any
+	var name string
+	switch {
+	case strings.HasPrefix(ref, "#/$defs/"):
+		defs, _ = root["$defs"].(map[string]any)
+		name = strings.TrimPrefix(ref, "#/$defs/")
+	case strings.HasPrefix(ref, "#/definitions/"):
+		defs, _ = root["definitions"].(map[string]any)
+		name = strings.TrimPrefix(ref, "#/definitions/")
+	default:
+		return nil
+	}
+
+	target, ok := defs[name].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	resolved := make(map[string]any, len(target))
+	for k, v := range target {
+		resolved[k] = v
+	}
+	if properties, ok := target["properties"].(map[string]any); ok {
+		copied := make(map[string]any, len(properties))
+		for k, v := range properties {
+			copied[k] = v
+		}
+		resolved["properties"] = copied
+	}
+
+	return resolved
+}
+
+// storedArgumentBinding is the shape of an argument binding in the
+// tool_variations.argument_bindings column.
+type storedArgumentBinding struct {
+	Argument string `json:"argument"`
+	Source   string `json:"source"`
+	Value    string `json:"value"`
+}
+
+// ArgumentBindingsFromJSON decodes the argument bindings stored on a tool
+// variation. A NULL column yields no bindings. A malformed column is an error
+// rather than no bindings, since dropping them would let the tool be called
+// with caller-supplied values for arguments meant to be bound.
+func ArgumentBindingsFromJSON(data []byte) ([]*types.ToolArgumentBinding, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var stored []storedArgumentBinding
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("decode argument bindings: %w", err)
+	}
+
+	bindings := make([]*types.ToolArgumentBinding, 0, len(stored))
+	for _, b := range stored {
+		bindings = append(bindings, &types.ToolArgumentBinding{
+			Argument: b.Argument,
+			Source:   b.Source,
+			Value:    b.Value,
+		})
+	}
+
+	return bindings, nil
+}
+
+// ArgumentBindingsToJSON encodes argument bindings for storage on a tool
+// variation. No bindings are stored as NULL.
+func ArgumentBindingsToJSON(bindings []*types.ToolArgumentBinding) ([]byte, error) {
+	if len(bindings) == 0 {
+		return nil, nil
+	}
+
+	stored := make([]storedArgumentBinding, 0, len(bindings))
+	for _, b := range bindings {
+		if b == nil {
+			continue
+		}
+		stored = append(stored, storedArgumentBinding{
+			Argument: b.Argument,
+			Source:   b.Source,
+			Value:    b.Value,
+		})
+	}
+
+	bs, err := json.Marshal(stored)
+	if err != nil {
+		return nil, fmt.Errorf("marshal argument bindings: %w", err)
+	}
+
+	return bs, nil
+}
+
 // ToolAnnotations contains tool behavior hints.
 type ToolAnnotations struct {
 	Title           string `json:"title,omitempty"`
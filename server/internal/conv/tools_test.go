@@ -0,0 +1,102 @@
+package conv_test
+
+import (
+	"testing"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyVariation_HidesBoundArguments(t *testing.T) {
+	t.Parallel()
+
+	tool := types.Tool{
+		HTTPToolDefinition: &types.HTTPToolDefinition{
+			ToolUrn: "tools:http:pets:create_pet",
+			Name:    "create_pet",
+			Schema: `{
+				"type": "object",
+				"properties": {
+					"body": {"$ref": "#/$defs/Pet"},
+					"queryParameters": {
+						"type": "object",
+						"properties": {"tenant_id": {"type": "string"}, "dry_run": {"type": "boolean"}},
+						"required": ["tenant_id"]
+					}
+				},
+				"required": ["body", "queryParameters"],
+				"$defs": {
+					"Pet": {
+						"type": "object",
+						"properties": {"name": {"type": "string"}, "owner_email": {"type": "string"}},
+						"required": ["name", "owner_email"]
+					}
+				}
+			}`,
+		},
+	}
+
+	conv.ApplyVariation(tool, types.ToolVariation{
+		ID:          "variation-id",
+		SrcToolUrn:  "tools:http:pets:create_pet",
+		SrcToolName: "create_pet",
+		ArgumentBindings: []*types.ToolArgumentBinding{
+			{Argument: "queryParameters.tenant_id", Source: "principal", Value: "organization_id"},
+			{Argument: "body.owner_email", Source: "principal", Value: "email"},
+		},
+	})
+
+	require.JSONEq(t, `{
+		"type": "object",
+		"properties": {
+			"body": {
+				"type": "object",
+				"properties": {"name": {"type": "string"}},
+				"required": ["name"]
+			},
+			"queryParameters": {
+				"type": "object",
+				"properties": {"dry_run": {"type": "boolean"}},
+				"required": []
+			}
+		},
+		"required": ["body", "queryParameters"],
+		"$defs": {
+			"Pet": {
+				"type": "object",
+				"properties": {"name": {"type": "string"}, "owner_email": {"type": "string"}},
+				"required": ["name", "owner_email"]
+			}
+		}
+	}`, tool.HTTPToolDefinition.Schema)
+}
+
+func TestArgumentBindingsJSON_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	bindings := []*types.ToolArgumentBinding{
+		{Argument: "tenant_id", Source: "constant", Value: `"acme"`},
+	}
+
+	data, err := conv.ArgumentBindingsToJSON(bindings)
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"argument":"tenant_id","source":"constant","value":"\"acme\""}]`, string(data))
+	decoded, err := conv.ArgumentBindingsFromJSON(data)
+	require.NoError(t, err)
+	require.Equal(t, bindings, decoded)
+
+	data, err = conv.ArgumentBindingsToJSON(nil)
+	require.NoError(t, err)
+	require.Nil(t, data, "no bindings are stored as NULL")
+	decoded, err = conv.ArgumentBindingsFromJSON(nil)
+	require.NoError(t, err)
+	require.Nil(t, decoded)
+}
+
+func TestArgumentBindingsFromJSON_MalformedIsAnError(t *testing.T) {
+	t.Parallel()
+
+	_, err := conv.ArgumentBindingsFromJSON([]byte(`{"argument":"tenant_id"}`))
+	require.Error(t, err, "malformed bindings must not decode as no bindings")
+}
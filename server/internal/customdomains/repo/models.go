@@ -38,6 +38,7 @@ type McpEndpoint struct {
 	CustomDomainID  uuid.NullUUID
 	McpServerID     uuid.NullUUID
 	MetaMcpServerID uuid.NullUUID
+	ToolsetVersion  pgtype.Int8
 	Slug            string
 	IsDomainRoot    pgtype.Bool
 	CreatedAt       pgtype.Timestamptz
@@ -401,7 +401,7 @@ func (q *Queries) GetCustomDomainRouteConfig(ctx context.Context, id uuid.UUID)
 }
 
 const getEligibleRootMcpEndpoint = `-- name: GetEligibleRootMcpEndpoint :one
-SELECT e.id, e.project_id, e.custom_domain_id, e.mcp_server_id, e.meta_mcp_server_id, e.slug, e.is_domain_root, e.created_at, e.updated_at, e.deleted_at, e.deleted
+SELECT e.id, e.project_id, e.custom_domain_id, e.mcp_server_id, e.meta_mcp_server_id, e.toolset_version, e.slug, e.is_domain_root, e.created_at, e.updated_at, e.deleted_at, e.deleted
 FROM mcp_endpoints AS e
 JOIN projects AS p
   ON p.id = e.project_id
@@ -432,6 +432,7 @@ func (q *Queries) GetEligibleRootMcpEndpoint(ctx context.Context, arg GetEligibl
 		&i.CustomDomainID,
 		&i.McpServerID,
 		&i.MetaMcpServerID,
+		&i.ToolsetVersion,
 		&i.Slug,
 		&i.IsDomainRoot,
 		&i.CreatedAt,
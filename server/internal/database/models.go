@@ -260,6 +260,7 @@ type AuditLog struct {
 	Metadata           []byte
 	ActingSurface      pgtype.Text
 	ActingClientID     pgtype.Text
+	TxID               pgtype.Int8
 	CreatedAt          pgtype.Timestamptz
 }
 
@@ -1165,6 +1166,7 @@ type McpEndpoint struct {
 	CustomDomainID  uuid.NullUUID
 	McpServerID     uuid.NullUUID
 	MetaMcpServerID uuid.NullUUID
+	ToolsetVersion  pgtype.Int8
 	Slug            string
 	IsDomainRoot    pgtype.Bool
 	CreatedAt       pgtype.Timestamptz
@@ -1252,6 +1254,7 @@ type McpServer struct {
 	ToolsetID             uuid.NullUUID
 	UnproxiedMcpServerID  uuid.NullUUID
 	ToolVariationsGroupID uuid.NullUUID
+	ToolsetVersion        pgtype.Int8
 	Visibility            string
 	CreatedAt             pgtype.Timestamptz
 	UpdatedAt             pgtype.Timestamptz
@@ -2668,26 +2671,29 @@ type ToolCallBlock struct {
 }
 
 type ToolVariation struct {
-	ID              uuid.UUID
-	GroupID         uuid.UUID
-	SrcToolUrn      urn.Tool
-	SrcToolName     string
-	Confirm         pgtype.Text
-	ConfirmPrompt   pgtype.Text
-	Name            pgtype.Text
-	Summary         pgtype.Text
-	Description     pgtype.Text
-	Tags            []string
-	Summarizer      pgtype.Text
-	Title           pgtype.Text
-	ReadOnlyHint    pgtype.Bool
-	DestructiveHint pgtype.Bool
-	IdempotentHint  pgtype.Bool
-	OpenWorldHint   pgtype.Bool
-	CreatedAt       pgtype.Timestamptz
-	UpdatedAt       pgtype.Timestamptz
-	DeletedAt       pgtype.Timestamptz
-	Deleted         bool
+	ID               uuid.UUID
+	GroupID          uuid.UUID
+	SrcToolUrn       urn.Tool
+	SrcToolName      string
+	Confirm          pgtype.Text
+	ConfirmPrompt    pgtype.Text
+	Name             pgtype.Text
+	Summary          pgtype.Text
+	Description      pgtype.Text
+	Tags             []string
+	Summarizer       pgtype.Text
+	Title            pgtype.Text
+	ReadOnlyHint     pgtype.Bool
+	DestructiveHint  pgtype.Bool
+	IdempotentHint   pgtype.Bool
+	OpenWorldHint    pgtype.Bool
+	CacheTtlSeconds  pgtype.Int4
+	CacheShared      pgtype.Bool
+	ArgumentBindings []byte
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+	DeletedAt        pgtype.Timestamptz
+	Deleted          bool
 }
 
 type ToolVariationsGroup struct {
@@ -71,6 +71,8 @@ DELETE FROM chat_session_summaries WHERE gram_project_id IN
   (toUUID('dec0de00-0000-4000-a000-000000000001'));
 DELETE FROM spend_rule_usage_summaries WHERE gram_project_id IN
   (toUUID('dec0de00-0000-4000-a000-000000000001'));
+DELETE FROM spend_rule_dimension_usage_summaries WHERE gram_project_id IN
+  (toUUID('dec0de00-0000-4000-a000-000000000001'));
 DELETE FROM attribute_keys WHERE gram_project_id IN
   (toUUID('dec0de00-0000-4000-a000-000000000001'));
 DELETE FROM shadow_mcp_inventory_urls WHERE gram_project_id IN
@@ -14,6 +14,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/constants"
 	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/deployments/events"
 	"github.com/speakeasy-api/gram/server/internal/deployments/repo"
 	"github.com/speakeasy-api/gram/server/internal/oops"
 )
@@ -66,7 +67,7 @@ func createDeployment(
 	ctx context.Context,
 	tracer trace.Tracer,
 	logger *slog.Logger,
-	tx *repo.Queries,
+	dbtx repo.DBTX,
 	idempotencyKey IdempotencyKey,
 	fields deploymentFields,
 	openAPIv3ToUpsert []upsertOpenAPIv3,
@@ -77,6 +78,7 @@ func createDeployment(
 	ctx, span := tracer.Start(ctx, "createDeployment")
 	defer span.End()
 	defer span.SetStatus(codes.Ok, "deployment created")
+	tx := repo.New(dbtx)
 	key := conv.PtrValOr(idempotencyKey, "")
 	if key == "" {
 		key = uuid.New().String()
@@ -145,6 +147,10 @@ func createDeployment(
 		return uuid.Nil, oops.E(oops.CodeUnexpected, err, "error logging deployment creation").LogError(ctx, logger)
 	}
 
+	if err := events.PublishLifecycleWebhook(ctx, dbtx, fields.projectID, newID, "created"); err != nil {
+		return uuid.Nil, oops.E(oops.CodeUnexpected, err, "error publishing deployment webhook").LogError(ctx, logger)
+	}
+
 	return newID, nil
 }
 
@@ -152,7 +158,7 @@ func cloneDeployment(
 	ctx context.Context,
 	tracer trace.Tracer,
 	logger *slog.Logger,
-	depRepo *repo.Queries,
+	dbtx repo.DBTX,
 	projectID ProjectID,
 	srcDeploymentID DeploymentID,
 	openAPIv3ToUpsert []upsertOpenAPIv3,
@@ -167,6 +173,7 @@ func cloneDeployment(
 	ctx, span := tracer.Start(ctx, "cloneDeployment")
 	defer span.End()
 	defer span.SetStatus(codes.Ok, "deployment cloned")
+	depRepo := repo.New(dbtx)
 
 	if err := validateUpserts(openAPIv3ToUpsert, functionsToUpsert, externalMCPsToUpsert); err != nil {
 		return uuid.Nil, oops.E(oops.CodeInvalid, err, "one or more deployment assets are invalid:\n%s", err.Error()).LogError(ctx, logger)
@@ -240,6 +247,10 @@ func cloneDeployment(
 		return uuid.Nil, oops.E(oops.CodeUnexpected, err, "error logging deployment creation").LogError(ctx, logger)
 	}
 
+	if err := events.PublishLifecycleWebhook(ctx, dbtx, projID, newID, "created"); err != nil {
+		return uuid.Nil, oops.E(oops.CodeUnexpected, err, "error publishing deployment webhook").LogError(ctx, logger)
+	}
+
 	return newID, nil
 }
 
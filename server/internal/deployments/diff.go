@@ -0,0 +1,92 @@
+package deployments
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	gen "github.com/speakeasy-api/gram/server/gen/deployments"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/deployments/diff"
+	"github.com/speakeasy-api/gram/server/internal/deployments/repo"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+func (s *Service) DiffDeployments(ctx context.Context, form *gen.DiffDeploymentsPayload) (*gen.DiffDeploymentsResult, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeProjectRead, ResourceKind: "", ResourceID: authCtx.ProjectID.String(), Dimensions: nil}); err != nil {
+		return nil, err
+	}
+
+	fromID, err := uuid.Parse(form.FromID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "error parsing from deployment id").LogError(ctx, s.logger)
+	}
+	toID, err := uuid.Parse(form.ToID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "error parsing to deployment id").LogError(ctx, s.logger)
+	}
+
+	fromTools, err := s.loadDiffTools(ctx, *authCtx.ProjectID, fromID)
+	if err != nil {
+		return nil, err
+	}
+	toTools, err := s.loadDiffTools(ctx, *authCtx.ProjectID, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := diff.Compare(fromTools, toTools)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error comparing deployments").LogError(ctx, s.logger)
+	}
+
+	changes := make([]*gen.DeploymentDiffChange, 0, len(report.Changes))
+	for _, c := range report.Changes {
+		changes = append(changes, &gen.DeploymentDiffChange{
+			ToolUrn:     c.ToolURN,
+			ToolName:    c.ToolName,
+			Kind:        string(c.Kind),
+			Severity:    string(c.Severity),
+			Path:        conv.PtrEmpty(c.Path),
+			Description: c.Description,
+		})
+	}
+
+	return &gen.DiffDeploymentsResult{
+		FromDeploymentID:   fromID.String(),
+		ToDeploymentID:     toID.String(),
+		HasBreakingChanges: report.HasBreaking(),
+		ToolsAdded:         report.ToolsAdded,
+		ToolsRemoved:       report.ToolsRemoved,
+		ToolsChanged:       report.ToolsChanged,
+		BreakingChanges:    report.BreakingChanges(),
+		Changes:            changes,
+	}, nil
+}
+
+// loadDiffTools collects the caller-facing shape of every tool in a
+// deployment after checking that the deployment belongs to the project.
+func (s *Service) loadDiffTools(ctx context.Context, projectID uuid.UUID, deploymentID uuid.UUID) ([]diff.Tool, error) {
+	_, err := s.repo.GetDeployment(ctx, repo.GetDeploymentParams{ID: deploymentID, ProjectID: projectID})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "deployment %s not found", deploymentID).LogError(ctx, s.logger)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "error getting deployment").LogError(ctx, s.logger)
+	}
+
+	tools, err := diff.LoadTools(ctx, s.repo, deploymentID)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error loading deployment tools").LogError(ctx, s.logger)
+	}
+	return tools, nil
+}
@@ -0,0 +1,321 @@
+// Package diff compares the tools produced by two deployments and classifies
+// every difference as additive or breaking for the clients calling them.
+package diff
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Severity says whether a change can break an existing caller.
+type Severity string
+
+const (
+	SeverityAdditive Severity = "additive"
+	SeverityBreaking Severity = "breaking"
+)
+
+// Kind is the aspect of a tool a change touches.
+type Kind string
+
+const (
+	KindToolAdded   Kind = "tool_added"
+	KindToolRemoved Kind = "tool_removed"
+	KindInputSchema Kind = "input_schema"
+	KindAnnotations Kind = "annotations"
+	KindSecurity    Kind = "security"
+	KindServerURL   Kind = "server_url"
+)
+
+// Annotations are a tool's MCP behavior hints. A nil hint is unset.
+type Annotations struct {
+	ReadOnly    *bool
+	Destructive *bool
+	Idempotent  *bool
+	OpenWorld   *bool
+}
+
+// Tool is the part of a deployed tool that callers depend on.
+type Tool struct {
+	URN  string
+	Name string
+
+	// InputSchema is the tool's JSON Schema for arguments, if any.
+	InputSchema []byte
+	Annotations Annotations
+
+	// Security lists the alternative security requirements a caller may
+	// satisfy, each mapping a scheme key to its scopes, in the OpenAPI
+	// shape. Nil means the tool can be called without credentials.
+	Security []map[string][]string
+	// RequiredVariables are further settings a caller must provide, such as
+	// a function's environment variables.
+	RequiredVariables []string
+
+	ServerURL    string
+	ServerEnvVar string
+}
+
+// Change is one classified difference between two versions of a tool.
+type Change struct {
+	ToolURN  string
+	ToolName string
+	Kind     Kind
+	Severity Severity
+	// Path locates schema changes, e.g. "filter.status" or "ids[]". It is
+	// empty for changes to the tool as a whole.
+	Path        string
+	Description string
+}
+
+// Report is the outcome of comparing two deployments.
+type Report struct {
+	ToolsAdded   int
+	ToolsRemoved int
+	ToolsChanged int
+	Changes      []Change
+}
+
+// BreakingChanges counts the breaking changes in the report.
+func (r Report) BreakingChanges() int {
+	n := 0
+	for _, c := range r.Changes {
+		if c.Severity == SeverityBreaking {
+			n++
+		}
+	}
+	return n
+}
+
+// HasBreaking reports whether any change in the report is breaking.
+func (r Report) HasBreaking() bool {
+	return r.BreakingChanges() > 0
+}
+
+// Compare diffs the tools of a "from" deployment against a "to" deployment.
+// Tools are matched by URN, so a renamed tool shows up as one removal and
+// one addition. Changes are ordered by tool URN, then kind and path.
+func Compare(from, to []Tool) (Report, error) {
+	fromByURN := make(map[string]Tool, len(from))
+	for _, t := range from {
+		fromByURN[t.URN] = t
+	}
+	toByURN := make(map[string]Tool, len(to))
+	for _, t := range to {
+		toByURN[t.URN] = t
+	}
+
+	var report Report
+	for _, before := range from {
+		if _, ok := toByURN[before.URN]; ok {
+			continue
+		}
+		report.ToolsRemoved++
+		report.Changes = append(report.Changes, Change{
+			ToolURN:     before.URN,
+			ToolName:    before.Name,
+			Kind:        KindToolRemoved,
+			Severity:    SeverityBreaking,
+			Path:        "",
+			Description: "tool removed",
+		})
+	}
+
+	for _, after := range to {
+		before, ok := fromByURN[after.URN]
+		if !ok {
+			report.ToolsAdded++
+			report.Changes = append(report.Changes, Change{
+				ToolURN:     after.URN,
+				ToolName:    after.Name,
+				Kind:        KindToolAdded,
+				Severity:    SeverityAdditive,
+				Path:        "",
+				Description: "tool added",
+			})
+			continue
+		}
+
+		changes, err := compareTool(before, after)
+		if err != nil {
+			return Report{}, fmt.Errorf("compare tool %s: %w", after.URN, err)
+		}
+		if len(changes) > 0 {
+			report.ToolsChanged++
+			report.Changes = append(report.Changes, changes...)
+		}
+	}
+
+	slices.SortStableFunc(report.Changes, func(a, b Change) int {
+		return cmp.Or(
+			strings.Compare(a.ToolURN, b.ToolURN),
+			strings.Compare(string(a.Kind), string(b.Kind)),
+			strings.Compare(a.Path, b.Path),
+		)
+	})
+	return report, nil
+}
+
+func compareTool(before, after Tool) ([]Change, error) {
+	var changes []Change
+	add := func(kind Kind, severity Severity, path, format string, args ...any) {
+		changes = append(changes, Change{
+			ToolURN:     after.URN,
+			ToolName:    after.Name,
+			Kind:        kind,
+			Severity:    severity,
+			Path:        path,
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if err := compareInputSchemas(before.InputSchema, after.InputSchema, func(severity Severity, path, description string) {
+		add(KindInputSchema, severity, path, "%s", description)
+	}); err != nil {
+		return nil, err
+	}
+
+	compareAnnotations(before.Annotations, after.Annotations, func(severity Severity, description string) {
+		add(KindAnnotations, severity, "", "%s", description)
+	})
+
+	compareSecurity(before.Security, after.Security, func(severity Severity, description string) {
+		add(KindSecurity, severity, "", "%s", description)
+	})
+
+	removedVars, addedVars := setDifference(before.RequiredVariables, after.RequiredVariables)
+	for _, v := range addedVars {
+		add(KindSecurity, SeverityBreaking, "", "now requires %s", v)
+	}
+	for _, v := range removedVars {
+		add(KindSecurity, SeverityAdditive, "", "no longer requires %s", v)
+	}
+
+	switch {
+	case before.ServerURL == after.ServerURL:
+	case before.ServerURL == "":
+		add(KindServerURL, SeverityAdditive, "", "default server URL set to %s", after.ServerURL)
+	case after.ServerURL == "":
+		add(KindServerURL, SeverityBreaking, "", "default server URL %s removed", before.ServerURL)
+	default:
+		add(KindServerURL, SeverityBreaking, "", "default server URL changed from %s to %s", before.ServerURL, after.ServerURL)
+	}
+	if before.ServerEnvVar != after.ServerEnvVar && before.ServerEnvVar != "" {
+		add(KindServerURL, SeverityBreaking, "", "server URL variable renamed from %s to %s", before.ServerEnvVar, after.ServerEnvVar)
+	}
+
+	return changes, nil
+}
+
+// compareAnnotations treats hints that relax what a client may assume about
+// a tool as breaking: clients auto-approve read-only and idempotent tools
+// and gate destructive or open-world ones.
+func compareAnnotations(before, after Annotations, emit func(Severity, string)) {
+	hints := []struct {
+		name   string
+		before *bool
+		after  *bool
+		// riskyWhenTrue marks hints where true asks more of the client.
+		riskyWhenTrue bool
+	}{
+		{"readOnlyHint", before.ReadOnly, after.ReadOnly, false},
+		{"destructiveHint", before.Destructive, after.Destructive, true},
+		{"idempotentHint", before.Idempotent, after.Idempotent, false},
+		{"openWorldHint", before.OpenWorld, after.OpenWorld, true},
+	}
+	for _, h := range hints {
+		if boolPtrEqual(h.before, h.after) {
+			continue
+		}
+		wasTrue, isTrue := h.before != nil && *h.before, h.after != nil && *h.after
+		severity := SeverityAdditive
+		if h.riskyWhenTrue && isTrue && !wasTrue || !h.riskyWhenTrue && wasTrue && !isTrue {
+			severity = SeverityBreaking
+		}
+		emit(severity, fmt.Sprintf("%s changed from %s to %s", h.name, describeHint(h.before), describeHint(h.after)))
+	}
+}
+
+// compareSecurity diffs the alternative requirement sets. Dropping an
+// alternative breaks callers that satisfied it; adding one only gives
+// callers another option.
+func compareSecurity(before, after []map[string][]string, emit func(Severity, string)) {
+	removed, added := setDifference(securityAlternatives(before), securityAlternatives(after))
+	for _, alt := range removed {
+		emit(SeverityBreaking, fmt.Sprintf("no longer accepts %s", alt))
+	}
+	for _, alt := range added {
+		emit(SeverityAdditive, fmt.Sprintf("now accepts %s", alt))
+	}
+}
+
+func securityAlternatives(requirements []map[string][]string) []string {
+	if len(requirements) == 0 {
+		return []string{describeRequirement(nil)}
+	}
+	out := make([]string, 0, len(requirements))
+	for _, req := range requirements {
+		out = append(out, describeRequirement(req))
+	}
+	return out
+}
+
+func describeRequirement(req map[string][]string) string {
+	if len(req) == 0 {
+		return "unauthenticated calls"
+	}
+	parts := make([]string, 0, len(req))
+	for key, scopes := range req {
+		if len(scopes) == 0 {
+			parts = append(parts, key)
+			continue
+		}
+		sorted := slices.Clone(scopes)
+		slices.Sort(sorted)
+		parts = append(parts, fmt.Sprintf("%s[%s]", key, strings.Join(sorted, ",")))
+	}
+	slices.Sort(parts)
+	return strings.Join(parts, " + ")
+}
+
+// setDifference returns the values only in before and only in after, each
+// sorted and deduplicated.
+func setDifference(before, after []string) (removed, added []string) {
+	inBefore := make(map[string]struct{}, len(before))
+	for _, v := range before {
+		inBefore[v] = struct{}{}
+	}
+	inAfter := make(map[string]struct{}, len(after))
+	for _, v := range after {
+		inAfter[v] = struct{}{}
+	}
+	for v := range inBefore {
+		if _, ok := inAfter[v]; !ok {
+			removed = append(removed, v)
+		}
+	}
+	for v := range inAfter {
+		if _, ok := inBefore[v]; !ok {
+			added = append(added, v)
+		}
+	}
+	slices.Sort(removed)
+	slices.Sort(added)
+	return removed, added
+}
+
+func boolPtrEqual(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func describeHint(v *bool) string {
+	if v == nil {
+		return "unset"
+	}
+	return fmt.Sprintf("%t", *v)
+}
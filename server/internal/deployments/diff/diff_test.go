@@ -0,0 +1,186 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareClassifiesToolAdditionsAndRemovals(t *testing.T) {
+	t.Parallel()
+
+	report, err := Compare(
+		[]Tool{testTool("tools:http:api:list_pets", ``), testTool("tools:http:api:delete_pet", ``)},
+		[]Tool{testTool("tools:http:api:list_pets", ``), testTool("tools:http:api:create_pet", ``)},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, report.ToolsAdded)
+	require.Equal(t, 1, report.ToolsRemoved)
+	require.Zero(t, report.ToolsChanged)
+	require.Equal(t, []Change{
+		{ToolURN: "tools:http:api:create_pet", ToolName: "tools:http:api:create_pet", Kind: KindToolAdded, Severity: SeverityAdditive, Path: "", Description: "tool added"},
+		{ToolURN: "tools:http:api:delete_pet", ToolName: "tools:http:api:delete_pet", Kind: KindToolRemoved, Severity: SeverityBreaking, Path: "", Description: "tool removed"},
+	}, report.Changes)
+	require.True(t, report.HasBreaking())
+}
+
+func TestCompareInputSchemaChanges(t *testing.T) {
+	t.Parallel()
+
+	before := `{
+		"type": "object",
+		"required": ["body"],
+		"properties": {
+			"body": {
+				"type": "object",
+				"required": ["name"],
+				"properties": {
+					"name": {"type": "string"},
+					"status": {"type": "string", "enum": ["available", "pending", "sold"]},
+					"nickname": {"type": "string"},
+					"tags": {"type": "array", "items": {"type": "string"}},
+					"legacy": {"type": "string"}
+				}
+			}
+		}
+	}`
+	after := `{
+		"type": "object",
+		"required": ["body"],
+		"properties": {
+			"body": {
+				"type": "object",
+				"required": ["name", "nickname", "owner"],
+				"properties": {
+					"name": {"type": "string", "description": "renamed description only"},
+					"status": {"type": "string", "enum": ["available", "pending", "archived"]},
+					"nickname": {"type": "string"},
+					"tags": {"type": "array", "items": {"type": "integer"}},
+					"owner": {"type": "string"},
+					"color": {"type": "string"}
+				}
+			}
+		}
+	}`
+
+	report, err := Compare([]Tool{testTool("tools:http:api:update_pet", before)}, []Tool{testTool("tools:http:api:update_pet", after)})
+	require.NoError(t, err)
+	require.Equal(t, 1, report.ToolsChanged)
+
+	got := make(map[string]Severity)
+	for _, c := range report.Changes {
+		require.Equal(t, KindInputSchema, c.Kind)
+		got[c.Path+": "+c.Description] = c.Severity
+	}
+	require.Equal(t, map[string]Severity{
+		"body.color: new optional argument":           SeverityAdditive,
+		"body.legacy: argument removed":               SeverityBreaking,
+		"body.nickname: argument is now required":     SeverityBreaking,
+		"body.owner: new required argument":           SeverityBreaking,
+		`body.status: enum narrowed, removed "sold"`:  SeverityBreaking,
+		`body.status: enum widened, added "archived"`: SeverityAdditive,
+		"body.tags[]: no longer accepts type string":  SeverityBreaking,
+		"body.tags[]: now also accepts type integer":  SeverityAdditive,
+	}, got)
+}
+
+func TestCompareTreatsConstBranchesAsEnum(t *testing.T) {
+	t.Parallel()
+
+	before := `{"type": "object", "properties": {"level": {"anyOf": [{"const": 1}, {"const": 2}, {"const": 3}]}}}`
+	after := `{"type": "object", "properties": {"level": {"anyOf": [{"const": 1}, {"const": 2}]}}}`
+
+	report, err := Compare([]Tool{testTool("t", before)}, []Tool{testTool("t", after)})
+	require.NoError(t, err)
+	require.Len(t, report.Changes, 1)
+	require.Equal(t, "level", report.Changes[0].Path)
+	require.Equal(t, "enum narrowed, removed 3", report.Changes[0].Description)
+	require.Equal(t, SeverityBreaking, report.Changes[0].Severity)
+}
+
+func TestCompareAnnotations(t *testing.T) {
+	t.Parallel()
+
+	yes, no := true, false
+	before := testTool("t", ``)
+	before.Annotations = Annotations{ReadOnly: &yes, Destructive: &no, Idempotent: nil, OpenWorld: &yes}
+	after := testTool("t", ``)
+	after.Annotations = Annotations{ReadOnly: &no, Destructive: &no, Idempotent: &yes, OpenWorld: &no}
+
+	report, err := Compare([]Tool{before}, []Tool{after})
+	require.NoError(t, err)
+
+	got := make(map[string]Severity)
+	for _, c := range report.Changes {
+		got[c.Description] = c.Severity
+	}
+	require.Equal(t, map[string]Severity{
+		"readOnlyHint changed from true to false":   SeverityBreaking,
+		"idempotentHint changed from unset to true": SeverityAdditive,
+		"openWorldHint changed from true to false":  SeverityAdditive,
+	}, got)
+}
+
+func TestCompareSecurityAndServer(t *testing.T) {
+	t.Parallel()
+
+	before := testTool("t", ``)
+	before.Security = []map[string][]string{{"apiKey": nil}, {"oauth": {"read"}}}
+	before.ServerURL = "https://api.example.com"
+	before.RequiredVariables = []string{"API_KEY"}
+
+	after := testTool("t", ``)
+	after.Security = []map[string][]string{{"oauth": {"read"}}, {"bearer": nil}}
+	after.ServerURL = "https://api.example.com/v2"
+	after.RequiredVariables = []string{"API_KEY", "REGION"}
+
+	report, err := Compare([]Tool{before}, []Tool{after})
+	require.NoError(t, err)
+
+	got := make(map[string]Severity)
+	for _, c := range report.Changes {
+		got[string(c.Kind)+": "+c.Description] = c.Severity
+	}
+	require.Equal(t, map[string]Severity{
+		"security: no longer accepts apiKey": SeverityBreaking,
+		"security: now accepts bearer":       SeverityAdditive,
+		"security: now requires REGION":      SeverityBreaking,
+		"server_url: default server URL changed from https://api.example.com to https://api.example.com/v2": SeverityBreaking,
+	}, got)
+}
+
+func TestCompareAddingAuthToOpenToolIsBreaking(t *testing.T) {
+	t.Parallel()
+
+	after := testTool("t", ``)
+	after.Security = []map[string][]string{{"apiKey": nil}}
+
+	report, err := Compare([]Tool{testTool("t", ``)}, []Tool{after})
+	require.NoError(t, err)
+	require.Equal(t, 1, report.BreakingChanges())
+	require.Equal(t, "no longer accepts unauthenticated calls", report.Changes[0].Description)
+}
+
+func TestCompareIdenticalDeploymentsHasNoChanges(t *testing.T) {
+	t.Parallel()
+
+	schema := `{"type": "object", "properties": {"id": {"type": "string"}}, "required": ["id"]}`
+	report, err := Compare([]Tool{testTool("t", schema)}, []Tool{testTool("t", schema)})
+	require.NoError(t, err)
+	require.Empty(t, report.Changes)
+	require.False(t, report.HasBreaking())
+}
+
+func testTool(urn, schema string) Tool {
+	return Tool{
+		URN:               urn,
+		Name:              urn,
+		InputSchema:       []byte(schema),
+		Annotations:       Annotations{ReadOnly: nil, Destructive: nil, Idempotent: nil, OpenWorld: nil},
+		Security:          nil,
+		RequiredVariables: nil,
+		ServerURL:         "",
+		ServerEnvVar:      "",
+	}
+}
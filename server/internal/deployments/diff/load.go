@@ -0,0 +1,114 @@
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/deployments/repo"
+)
+
+// LoadTools collects the caller-facing shape of every tool in a deployment.
+// Callers check that the deployment belongs to their project first.
+func LoadTools(ctx context.Context, queries *repo.Queries, deploymentID uuid.UUID) ([]Tool, error) {
+	httpTools, err := queries.ListDeploymentHTTPToolsForDiff(ctx, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("list deployment http tools: %w", err)
+	}
+	functionTools, err := queries.ListDeploymentFunctionToolsForDiff(ctx, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("list deployment function tools: %w", err)
+	}
+	externalTools, err := queries.ListDeploymentExternalMCPToolsForDiff(ctx, deploymentID)
+	if err != nil {
+		return nil, fmt.Errorf("list deployment external mcp tools: %w", err)
+	}
+
+	tools := make([]Tool, 0, len(httpTools)+len(functionTools)+len(externalTools))
+	for _, t := range httpTools {
+		var security []map[string][]string
+		if len(t.Security) > 0 {
+			if err := json.Unmarshal(t.Security, &security); err != nil {
+				return nil, fmt.Errorf("decode security of tool %s: %w", t.Name, err)
+			}
+		}
+		tools = append(tools, Tool{
+			URN:               t.ToolUrn.String(),
+			Name:              t.Name,
+			InputSchema:       t.Schema,
+			Annotations:       annotations(t.ReadOnlyHint, t.DestructiveHint, t.IdempotentHint, t.OpenWorldHint),
+			Security:          security,
+			RequiredVariables: nil,
+			ServerURL:         conv.FromPGTextOrEmpty[string](t.DefaultServerUrl),
+			ServerEnvVar:      t.ServerEnvVar,
+		})
+	}
+
+	for _, t := range functionTools {
+		variables, err := functionVariableNames(t.Variables)
+		if err != nil {
+			return nil, fmt.Errorf("decode variables of tool %s: %w", t.Name, err)
+		}
+		tools = append(tools, Tool{
+			URN:               t.ToolUrn.String(),
+			Name:              t.Name,
+			InputSchema:       t.InputSchema,
+			Annotations:       annotations(t.ReadOnlyHint, t.DestructiveHint, t.IdempotentHint, t.OpenWorldHint),
+			Security:          nil,
+			RequiredVariables: variables,
+			ServerURL:         "",
+			ServerEnvVar:      "",
+		})
+	}
+
+	for _, t := range externalTools {
+		var required []string
+		if t.RequiresOauth {
+			required = []string{"OAuth"}
+		}
+		tools = append(tools, Tool{
+			URN:               t.ToolUrn,
+			Name:              t.Name,
+			InputSchema:       t.Schema,
+			Annotations:       annotations(t.ReadOnlyHint, t.DestructiveHint, t.IdempotentHint, t.OpenWorldHint),
+			Security:          nil,
+			RequiredVariables: required,
+			ServerURL:         t.RemoteUrl,
+			ServerEnvVar:      "",
+		})
+	}
+
+	return tools, nil
+}
+
+func annotations(readOnly, destructive, idempotent, openWorld pgtype.Bool) Annotations {
+	return Annotations{
+		ReadOnly:    conv.FromPGBool[bool](readOnly),
+		Destructive: conv.FromPGBool[bool](destructive),
+		Idempotent:  conv.FromPGBool[bool](idempotent),
+		OpenWorld:   conv.FromPGBool[bool](openWorld),
+	}
+}
+
+// functionVariableNames returns the environment variables a function tool
+// declares, which callers must configure before invoking it.
+func functionVariableNames(raw []byte) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var variables map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &variables); err != nil {
+		return nil, fmt.Errorf("unmarshal function variables: %w", err)
+	}
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
@@ -0,0 +1,201 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// compareInputSchemas walks two tool input schemas and reports differences in
+// argument types, enums, properties and required-ness. Descriptions,
+// examples and other annotations are ignored since they cannot break a
+// caller.
+func compareInputSchemas(before, after []byte, emit func(severity Severity, path, description string)) error {
+	beforeSchema, err := parseSchema(before)
+	if err != nil {
+		return fmt.Errorf("parse previous input schema: %w", err)
+	}
+	afterSchema, err := parseSchema(after)
+	if err != nil {
+		return fmt.Errorf("parse new input schema: %w", err)
+	}
+
+	compareSchemaNode("", beforeSchema, afterSchema, emit)
+	return nil
+}
+
+func parseSchema(raw []byte) (map[string]any, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return map[string]any{}, nil
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return nil, fmt.Errorf("unmarshal json schema: %w", err)
+	}
+	return schema, nil
+}
+
+func compareSchemaNode(path string, before, after map[string]any, emit func(Severity, string, string)) {
+	beforeTypes, afterTypes := schemaTypes(before), schemaTypes(after)
+	if len(beforeTypes) > 0 && len(afterTypes) > 0 {
+		removed, added := setDifference(beforeTypes, afterTypes)
+		if len(removed) > 0 {
+			emit(SeverityBreaking, path, fmt.Sprintf("no longer accepts type %s", strings.Join(removed, ", ")))
+		}
+		if len(added) > 0 {
+			emit(SeverityAdditive, path, fmt.Sprintf("now also accepts type %s", strings.Join(added, ", ")))
+		}
+	}
+
+	beforeEnum, beforeHasEnum := schemaEnum(before)
+	afterEnum, afterHasEnum := schemaEnum(after)
+	switch {
+	case beforeHasEnum && afterHasEnum:
+		removed, added := setDifference(beforeEnum, afterEnum)
+		if len(removed) > 0 {
+			emit(SeverityBreaking, path, fmt.Sprintf("enum narrowed, removed %s", strings.Join(removed, ", ")))
+		}
+		if len(added) > 0 {
+			emit(SeverityAdditive, path, fmt.Sprintf("enum widened, added %s", strings.Join(added, ", ")))
+		}
+	case afterHasEnum:
+		emit(SeverityBreaking, path, fmt.Sprintf("now restricted to %s", strings.Join(afterEnum, ", ")))
+	case beforeHasEnum:
+		emit(SeverityAdditive, path, "no longer restricted to an enum")
+	}
+
+	compareProperties(path, before, after, emit)
+
+	beforeItems, beforeOK := before["items"].(map[string]any)
+	afterItems, afterOK := after["items"].(map[string]any)
+	if beforeOK && afterOK {
+		compareSchemaNode(path+"[]", beforeItems, afterItems, emit)
+	}
+}
+
+func compareProperties(path string, before, after map[string]any, emit func(Severity, string, string)) {
+	beforeProps, _ := before["properties"].(map[string]any)
+	afterProps, _ := after["properties"].(map[string]any)
+	beforeRequired, afterRequired := schemaRequired(before), schemaRequired(after)
+
+	names := make([]string, 0, len(beforeProps)+len(afterProps))
+	for name := range beforeProps {
+		names = append(names, name)
+	}
+	for name := range afterProps {
+		if _, ok := beforeProps[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		propPath := joinPath(path, name)
+		beforeProp, inBefore := beforeProps[name]
+		afterProp, inAfter := afterProps[name]
+		_, wasRequired := beforeRequired[name]
+		_, isRequired := afterRequired[name]
+
+		switch {
+		case !inAfter:
+			emit(SeverityBreaking, propPath, "argument removed")
+		case !inBefore && isRequired:
+			emit(SeverityBreaking, propPath, "new required argument")
+		case !inBefore:
+			emit(SeverityAdditive, propPath, "new optional argument")
+		default:
+			if !wasRequired && isRequired {
+				emit(SeverityBreaking, propPath, "argument is now required")
+			}
+			if wasRequired && !isRequired {
+				emit(SeverityAdditive, propPath, "argument is now optional")
+			}
+			beforeSchema, _ := beforeProp.(map[string]any)
+			afterSchema, _ := afterProp.(map[string]any)
+			if beforeSchema != nil && afterSchema != nil {
+				compareSchemaNode(propPath, beforeSchema, afterSchema, emit)
+			}
+		}
+	}
+}
+
+func schemaTypes(schema map[string]any) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	case []any:
+		out := make([]string, 0, len(t))
+		for _, v := range t {
+			if s, ok := v.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// schemaEnum returns the allowed values of an enumerated schema, rendered
+// as JSON. Besides "enum" it understands the anyOf/oneOf-of-const shape that
+// integer enums are rewritten into at deployment time.
+func schemaEnum(schema map[string]any) ([]string, bool) {
+	if values, ok := schema["enum"].([]any); ok {
+		return renderValues(values), true
+	}
+	if value, ok := schema["const"]; ok {
+		return renderValues([]any{value}), true
+	}
+	for _, key := range []string{"anyOf", "oneOf"} {
+		branches, ok := schema[key].([]any)
+		if !ok || len(branches) == 0 {
+			continue
+		}
+		values := make([]any, 0, len(branches))
+		for _, branch := range branches {
+			b, ok := branch.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			value, ok := b["const"]
+			if !ok {
+				return nil, false
+			}
+			values = append(values, value)
+		}
+		return renderValues(values), true
+	}
+	return nil, false
+}
+
+func renderValues(values []any) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		b, err := json.Marshal(v)
+		if err != nil {
+			out = append(out, fmt.Sprint(v))
+			continue
+		}
+		out = append(out, string(b))
+	}
+	return out
+}
+
+func schemaRequired(schema map[string]any) map[string]struct{} {
+	values, _ := schema["required"].([]any)
+	out := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			out[s] = struct{}{}
+		}
+	}
+	return out
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
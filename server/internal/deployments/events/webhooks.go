@@ -0,0 +1,107 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/deployments/repo"
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+	outboxevents "github.com/speakeasy-api/gram/server/internal/outbox/events"
+)
+
+// failureSummaryEntries caps how many error log lines ride along on
+// deployment.failed_v1. The count is always exact; the lines are a preview.
+const failureSummaryEntries = 10
+
+// PublishLifecycleWebhook enqueues the customer-facing webhook for a
+// deployment that has just moved to status. Call it in the transaction that
+// made the move, and only when the move happened, so each transition is
+// announced exactly once. Statuses without a webhook are ignored.
+func PublishLifecycleWebhook(ctx context.Context, dbtx repo.DBTX, projectID, deploymentID uuid.UUID, status string) error {
+	var def *outbox.EventDef[outboxevents.DeploymentPayloadV1]
+	switch status {
+	case "created":
+		def = outboxevents.DeploymentCreatedV1
+	case "completed":
+		def = outboxevents.DeploymentSucceededV1
+	case "failed":
+	default:
+		return nil
+	}
+
+	queries := repo.New(dbtx)
+	row, err := queries.GetDeployment(ctx, repo.GetDeploymentParams{ID: deploymentID, ProjectID: projectID})
+	if err != nil {
+		return fmt.Errorf("read deployment: %w", err)
+	}
+	payload := deploymentPayload(row.Deployment, status)
+
+	if def != nil {
+		if _, err := outbox.PublishWebhookEvent(ctx, dbtx, row.Deployment.OrganizationID, def, payload); err != nil {
+			return fmt.Errorf("publish deployment webhook: %w", err)
+		}
+		return nil
+	}
+
+	logs, err := queries.ListDeploymentErrorLogs(ctx, repo.ListDeploymentErrorLogsParams{
+		DeploymentID: deploymentID,
+		ProjectID:    projectID,
+		MaxEntries:   failureSummaryEntries,
+	})
+	if err != nil {
+		return fmt.Errorf("list deployment error logs: %w", err)
+	}
+
+	summary := outboxevents.DeploymentLogSummaryV1{
+		ErrorCount: 0,
+		Errors:     make([]outboxevents.DeploymentLogEntryV1, 0, len(logs)),
+	}
+	for _, log := range logs {
+		summary.ErrorCount = log.TotalCount
+		summary.Errors = append(summary.Errors, outboxevents.DeploymentLogEntryV1{
+			Event:     log.Event,
+			Message:   log.Message,
+			CreatedAt: log.CreatedAt.Time.UTC(),
+		})
+	}
+
+	if _, err := outbox.PublishWebhookEvent(ctx, dbtx, row.Deployment.OrganizationID, outboxevents.DeploymentFailedV1, outboxevents.DeploymentFailedPayloadV1{
+		ID:             payload.ID,
+		OrganizationID: payload.OrganizationID,
+		ProjectID:      payload.ProjectID,
+		Status:         payload.Status,
+		UserID:         payload.UserID,
+		ClonedFrom:     payload.ClonedFrom,
+		GithubRepo:     payload.GithubRepo,
+		GithubPr:       payload.GithubPr,
+		GithubSha:      payload.GithubSha,
+		ExternalID:     payload.ExternalID,
+		ExternalURL:    payload.ExternalURL,
+		CreatedAt:      payload.CreatedAt,
+		LogSummary:     summary,
+	}); err != nil {
+		return fmt.Errorf("publish deployment webhook: %w", err)
+	}
+
+	return nil
+}
+
+func deploymentPayload(d repo.Deployment, status string) outboxevents.DeploymentPayloadV1 {
+	return outboxevents.DeploymentPayloadV1{
+		ID:             d.ID,
+		OrganizationID: d.OrganizationID,
+		ProjectID:      d.ProjectID,
+		Status:         status,
+		UserID:         d.UserID,
+		ClonedFrom:     d.ClonedFrom,
+		GithubRepo:     conv.FromPGTextOrEmpty[string](d.GithubRepo),
+		GithubPr:       conv.FromPGTextOrEmpty[string](d.GithubPr),
+		GithubSha:      conv.FromPGTextOrEmpty[string](d.GithubSha),
+		ExternalID:     conv.FromPGTextOrEmpty[string](d.ExternalID),
+		ExternalURL:    conv.FromPGTextOrEmpty[string](d.ExternalUrl),
+		CreatedAt:      d.CreatedAt.Time.UTC(),
+	}
+}
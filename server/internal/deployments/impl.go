@@ -493,7 +493,7 @@ func (s *Service) CreateDeployment(ctx context.Context, form *gen.CreateDeployme
 	}
 
 	newID, err := createDeployment(
-		ctx, s.tracer, logger, tx,
+		ctx, s.tracer, logger, dbtx,
 		IdempotencyKey(&form.IdempotencyKey),
 		deploymentFields{
 			projectID:      projectID,
@@ -539,7 +539,7 @@ func (s *Service) CreateDeployment(ctx context.Context, form *gen.CreateDeployme
 
 	status := dep.Status
 	if status == "created" {
-		s, err := s.startDeployment(ctx, logger, projectID, newID, dep, form.NonBlocking != nil && *form.NonBlocking)
+		s, err := s.startDeployment(ctx, logger, projectID, newID, dep, form.NonBlocking != nil && *form.NonBlocking, conv.PtrValOr(form.FailOnBreaking, false))
 		if err != nil {
 			return nil, err
 		}
@@ -713,7 +713,7 @@ func (s *Service) Evolve(ctx context.Context, form *gen.EvolvePayload) (*gen.Evo
 	// 1️⃣ Project has no deployments, we need to create an initial one instead of cloning
 	case errors.Is(err, pgx.ErrNoRows), latestDeploymentID == uuid.Nil:
 		newID, err := createDeployment(
-			ctx, s.tracer, logger, tx,
+			ctx, s.tracer, logger, dbtx,
 			IdempotencyKey(nil),
 			deploymentFields{
 				projectID:      projectID,
@@ -754,7 +754,7 @@ func (s *Service) Evolve(ctx context.Context, form *gen.EvolvePayload) (*gen.Evo
 		}
 
 		newID, err := cloneDeployment(
-			ctx, s.tracer, logger, tx,
+			ctx, s.tracer, logger, dbtx,
 			ProjectID(projectID), DeploymentID(latestDeploymentID),
 			openapiv3ToUpsert,
 			functionsToUpsert,
@@ -807,7 +807,7 @@ func (s *Service) Evolve(ctx context.Context, form *gen.EvolvePayload) (*gen.Evo
 
 	status := dep.Status
 	if status == "created" {
-		s, err := s.startDeployment(ctx, logger, projectID, cloneID, dep, form.NonBlocking != nil && *form.NonBlocking)
+		s, err := s.startDeployment(ctx, logger, projectID, cloneID, dep, form.NonBlocking != nil && *form.NonBlocking, conv.PtrValOr(form.FailOnBreaking, false))
 		if err != nil {
 			return nil, err
 		}
@@ -868,7 +868,7 @@ func (s *Service) Redeploy(ctx context.Context, payload *gen.RedeployPayload) (*
 	}
 
 	newID, err := cloneDeployment(
-		ctx, s.tracer, logger, tx,
+		ctx, s.tracer, logger, dbtx,
 		ProjectID(projectID), DeploymentID(deploymentID),
 		[]upsertOpenAPIv3{},
 		[]upsertFunctions{},
@@ -917,7 +917,7 @@ func (s *Service) Redeploy(ctx context.Context, payload *gen.RedeployPayload) (*
 
 	status := dep.Status
 	if status == "created" {
-		s, err := s.startDeployment(ctx, logger, projectID, newID, dep, false)
+		s, err := s.startDeployment(ctx, logger, projectID, newID, dep, false, false)
 		if err != nil {
 			return nil, err
 		}
@@ -1007,7 +1007,7 @@ func (s *Service) resolvePackages(ctx context.Context, tx *packagesRepo.Queries,
 	return res, nil
 }
 
-func (s *Service) startDeployment(ctx context.Context, logger *slog.Logger, projectID uuid.UUID, deploymentID uuid.UUID, dep *types.Deployment, nonBlocking bool) (string, error) {
+func (s *Service) startDeployment(ctx context.Context, logger *slog.Logger, projectID uuid.UUID, deploymentID uuid.UUID, dep *types.Deployment, nonBlocking bool, failOnBreaking bool) (string, error) {
 	defer func() {
 		logger.InfoContext(ctx, "starting project-scoped functions reaper")
 		_, err := background.ExecuteProjectFunctionsReaperWorkflow(ctx, s.temporalEnv, projectID)
@@ -1023,6 +1023,7 @@ func (s *Service) startDeployment(ctx context.Context, logger *slog.Logger, proj
 		ProjectID:      projectID,
 		DeploymentID:   deploymentID,
 		IdempotencyKey: conv.PtrValOr(dep.IdempotencyKey, ""),
+		FailOnBreaking: failOnBreaking,
 	})
 	if err != nil {
 		return "", oops.E(oops.CodeUnexpected, err, "error starting deployment").LogError(ctx, logger)
@@ -111,6 +111,21 @@ WHERE
 ORDER BY log.seq ASC
 LIMIT 51;
 
+-- name: ListDeploymentErrorLogs :many
+-- The first error entries a deployment logged, with the total repeated on
+-- every row. Feeds the log summary sent with deployment.failed_v1.
+SELECT
+    log.event
+  , log.message
+  , log.created_at
+  , COUNT(*) OVER () AS total_count
+FROM deployment_logs log
+WHERE log.deployment_id = @deployment_id
+  AND log.project_id = @project_id
+  AND log.event LIKE '%:error'
+ORDER BY log.seq ASC
+LIMIT @max_entries;
+
 -- name: GetDeploymentWithAssets :many
 WITH latest_status as (
     SELECT deployment_id, status
@@ -593,6 +608,22 @@ INSERT INTO http_security (
 )
 RETURNING *;
 
+-- name: CreateHTTPServerCandidates :exec
+INSERT INTO http_server_candidates (
+    deployment_id
+  , project_id
+  , openapiv3_document_id
+  , server_env_var
+  , server_urls
+) VALUES (
+    @deployment_id
+  , @project_id
+  , @openapiv3_document_id
+  , @server_env_var
+  , @server_urls
+)
+ON CONFLICT (deployment_id, server_env_var) DO UPDATE SET server_urls = EXCLUDED.server_urls;
+
 -- name: CreateFunctionsTool :one
 INSERT INTO function_tool_definitions (
     deployment_id
@@ -685,6 +716,13 @@ WHERE
   AND (deployment_id = @deployment_id AND deployment_id IS NOT NULL)
   AND (openapiv3_document_id = @openapiv3_document_id AND openapiv3_document_id IS NOT NULL);
 
+-- name: DangerouslyClearDeploymentHTTPServerCandidates :execrows
+DELETE FROM http_server_candidates
+WHERE
+  project_id = @project_id
+  AND deployment_id = @deployment_id
+  AND openapiv3_document_id = @openapiv3_document_id;
+
 -- name: GetDeploymentFunctions :many
 SELECT df.*
 FROM deployments_functions df
@@ -751,6 +789,62 @@ FROM external_mcp_attachments
 WHERE deployment_id = @deployment_id AND deleted IS FALSE
 ORDER BY created_at ASC;
 
+-- name: ListDeploymentHTTPToolsForDiff :many
+-- The caller-facing shape of a deployment's HTTP tools, for comparing two
+-- deployments.
+SELECT
+  t.tool_urn
+  , t.name
+  , t.schema
+  , t.security
+  , t.server_env_var
+  , t.default_server_url
+  , t.read_only_hint
+  , t.destructive_hint
+  , t.idempotent_hint
+  , t.open_world_hint
+FROM http_tool_definitions t
+WHERE t.deployment_id = @deployment_id
+  AND t.deleted IS FALSE
+ORDER BY t.tool_urn ASC;
+
+-- name: ListDeploymentFunctionToolsForDiff :many
+-- The caller-facing shape of a deployment's function tools, for comparing two
+-- deployments.
+SELECT
+  t.tool_urn
+  , t.name
+  , t.input_schema
+  , t.variables
+  , t.read_only_hint
+  , t.destructive_hint
+  , t.idempotent_hint
+  , t.open_world_hint
+FROM function_tool_definitions t
+WHERE t.deployment_id = @deployment_id
+  AND t.deleted IS FALSE
+ORDER BY t.tool_urn ASC;
+
+-- name: ListDeploymentExternalMCPToolsForDiff :many
+-- The caller-facing shape of a deployment's external MCP tools, for comparing
+-- two deployments.
+SELECT
+  t.tool_urn
+  , COALESCE(t.name, a.name)::text AS name
+  , t.schema
+  , t.remote_url
+  , t.requires_oauth
+  , t.read_only_hint
+  , t.destructive_hint
+  , t.idempotent_hint
+  , t.open_world_hint
+FROM external_mcp_tool_definitions t
+INNER JOIN external_mcp_attachments a ON a.id = t.external_mcp_attachment_id
+WHERE a.deployment_id = @deployment_id
+  AND a.deleted IS FALSE
+  AND t.deleted IS FALSE
+ORDER BY t.tool_urn ASC;
+
 -- name: CloneDeploymentExternalMCPs :many
 INSERT INTO external_mcp_attachments (deployment_id, registry_id, organization_mcp_collection_registry_id, name, slug, registry_server_specifier, selected_remotes)
 SELECT
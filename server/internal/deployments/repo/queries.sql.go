@@ -731,6 +731,42 @@ func (q *Queries) CreateHTTPSecurity(ctx context.Context, arg CreateHTTPSecurity
 	return i, err
 }
 
+const createHTTPServerCandidates = `-- name: CreateHTTPServerCandidates :exec
+INSERT INTO http_server_candidates (
+    deployment_id
+  , project_id
+  , openapiv3_document_id
+  , server_env_var
+  , server_urls
+) VALUES (
+    $1
+  , $2
+  , $3
+  , $4
+  , $5
+)
+ON CONFLICT (deployment_id, server_env_var) DO UPDATE SET server_urls = EXCLUDED.server_urls
+`
+
+type CreateHTTPServerCandidatesParams struct {
+	DeploymentID        uuid.UUID
+	ProjectID           uuid.UUID
+	Openapiv3DocumentID uuid.UUID
+	ServerEnvVar        string
+	ServerUrls          []string
+}
+
+func (q *Queries) CreateHTTPServerCandidates(ctx context.Context, arg CreateHTTPServerCandidatesParams) error {
+	_, err := q.db.Exec(ctx, createHTTPServerCandidates,
+		arg.DeploymentID,
+		arg.ProjectID,
+		arg.Openapiv3DocumentID,
+		arg.ServerEnvVar,
+		arg.ServerUrls,
+	)
+	return err
+}
+
 const createOpenAPIv3ToolDefinition = `-- name: CreateOpenAPIv3ToolDefinition :one
 INSERT INTO http_tool_definitions (
     project_id
@@ -938,6 +974,28 @@ func (q *Queries) DangerouslyClearDeploymentHTTPSecurity(ctx context.Context, ar
 	return result.RowsAffected(), nil
 }
 
+const dangerouslyClearDeploymentHTTPServerCandidates = `-- name: DangerouslyClearDeploymentHTTPServerCandidates :execrows
+DELETE FROM http_server_candidates
+WHERE
+  project_id = $1
+  AND deployment_id = $2
+  AND openapiv3_document_id = $3
+`
+
+type DangerouslyClearDeploymentHTTPServerCandidatesParams struct {
+	ProjectID           uuid.UUID
+	DeploymentID        uuid.UUID
+	Openapiv3DocumentID uuid.UUID
+}
+
+func (q *Queries) DangerouslyClearDeploymentHTTPServerCandidates(ctx context.Context, arg DangerouslyClearDeploymentHTTPServerCandidatesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, dangerouslyClearDeploymentHTTPServerCandidates, arg.ProjectID, arg.DeploymentID, arg.Openapiv3DocumentID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const dangerouslyClearDeploymentTools = `-- name: DangerouslyClearDeploymentTools :execrows
 DELETE FROM http_tool_definitions
 WHERE
@@ -1591,6 +1649,123 @@ func (q *Queries) InsertDeployment(ctx context.Context, arg InsertDeploymentPara
 	return id, err
 }
 
+const listDeploymentErrorLogs = `-- name: ListDeploymentErrorLogs :many
+SELECT
+    log.event
+  , log.message
+  , log.created_at
+  , COUNT(*) OVER () AS total_count
+FROM deployment_logs log
+WHERE log.deployment_id = $1
+  AND log.project_id = $2
+  AND log.event LIKE '%:error'
+ORDER BY log.seq ASC
+LIMIT $3
+`
+
+type ListDeploymentErrorLogsParams struct {
+	DeploymentID uuid.UUID
+	ProjectID    uuid.UUID
+	MaxEntries   int32
+}
+
+type ListDeploymentErrorLogsRow struct {
+	Event      string
+	Message    string
+	CreatedAt  pgtype.Timestamptz
+	TotalCount int64
+}
+
+// The first error entries a deployment logged, with the total repeated on
+// every row. Feeds the log summary sent with deployment.failed_v1.
+func (q *Queries) ListDeploymentErrorLogs(ctx context.Context, arg ListDeploymentErrorLogsParams) ([]ListDeploymentErrorLogsRow, error) {
+	rows, err := q.db.Query(ctx, listDeploymentErrorLogs, arg.DeploymentID, arg.ProjectID, arg.MaxEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDeploymentErrorLogsRow
+	for rows.Next() {
+		var i ListDeploymentErrorLogsRow
+		if err := rows.Scan(
+			&i.Event,
+			&i.Message,
+			&i.CreatedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeploymentExternalMCPToolsForDiff = `-- name: ListDeploymentExternalMCPToolsForDiff :many
+SELECT
+  t.tool_urn
+  , COALESCE(t.name, a.name)::text AS name
+  , t.schema
+  , t.remote_url
+  , t.requires_oauth
+  , t.read_only_hint
+  , t.destructive_hint
+  , t.idempotent_hint
+  , t.open_world_hint
+FROM external_mcp_tool_definitions t
+INNER JOIN external_mcp_attachments a ON a.id = t.external_mcp_attachment_id
+WHERE a.deployment_id = $1
+  AND a.deleted IS FALSE
+  AND t.deleted IS FALSE
+ORDER BY t.tool_urn ASC
+`
+
+type ListDeploymentExternalMCPToolsForDiffRow struct {
+	ToolUrn         string
+	Name            string
+	Schema          []byte
+	RemoteUrl       string
+	RequiresOauth   bool
+	ReadOnlyHint    pgtype.Bool
+	DestructiveHint pgtype.Bool
+	IdempotentHint  pgtype.Bool
+	OpenWorldHint   pgtype.Bool
+}
+
+// The caller-facing shape of a deployment's external MCP tools, for comparing
+// two deployments.
+func (q *Queries) ListDeploymentExternalMCPToolsForDiff(ctx context.Context, deploymentID uuid.UUID) ([]ListDeploymentExternalMCPToolsForDiffRow, error) {
+	rows, err := q.db.Query(ctx, listDeploymentExternalMCPToolsForDiff, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDeploymentExternalMCPToolsForDiffRow
+	for rows.Next() {
+		var i ListDeploymentExternalMCPToolsForDiffRow
+		if err := rows.Scan(
+			&i.ToolUrn,
+			&i.Name,
+			&i.Schema,
+			&i.RemoteUrl,
+			&i.RequiresOauth,
+			&i.ReadOnlyHint,
+			&i.DestructiveHint,
+			&i.IdempotentHint,
+			&i.OpenWorldHint,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listDeploymentExternalMCPs = `-- name: ListDeploymentExternalMCPs :many
 SELECT id, deployment_id, registry_id, organization_mcp_collection_registry_id, name, slug, registry_server_specifier, selected_remotes, created_at, updated_at
 FROM external_mcp_attachments
@@ -1642,6 +1817,128 @@ func (q *Queries) ListDeploymentExternalMCPs(ctx context.Context, deploymentID u
 	return items, nil
 }
 
+const listDeploymentFunctionToolsForDiff = `-- name: ListDeploymentFunctionToolsForDiff :many
+SELECT
+  t.tool_urn
+  , t.name
+  , t.input_schema
+  , t.variables
+  , t.read_only_hint
+  , t.destructive_hint
+  , t.idempotent_hint
+  , t.open_world_hint
+FROM function_tool_definitions t
+WHERE t.deployment_id = $1
+  AND t.deleted IS FALSE
+ORDER BY t.tool_urn ASC
+`
+
+type ListDeploymentFunctionToolsForDiffRow struct {
+	ToolUrn         urn.Tool
+	Name            string
+	InputSchema     []byte
+	Variables       []byte
+	ReadOnlyHint    pgtype.Bool
+	DestructiveHint pgtype.Bool
+	IdempotentHint  pgtype.Bool
+	OpenWorldHint   pgtype.Bool
+}
+
+// The caller-facing shape of a deployment's function tools, for comparing two
+// deployments.
+func (q *Queries) ListDeploymentFunctionToolsForDiff(ctx context.Context, deploymentID uuid.UUID) ([]ListDeploymentFunctionToolsForDiffRow, error) {
+	rows, err := q.db.Query(ctx, listDeploymentFunctionToolsForDiff, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDeploymentFunctionToolsForDiffRow
+	for rows.Next() {
+		var i ListDeploymentFunctionToolsForDiffRow
+		if err := rows.Scan(
+			&i.ToolUrn,
+			&i.Name,
+			&i.InputSchema,
+			&i.Variables,
+			&i.ReadOnlyHint,
+			&i.DestructiveHint,
+			&i.IdempotentHint,
+			&i.OpenWorldHint,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeploymentHTTPToolsForDiff = `-- name: ListDeploymentHTTPToolsForDiff :many
+SELECT
+  t.tool_urn
+  , t.name
+  , t.schema
+  , t.security
+  , t.server_env_var
+  , t.default_server_url
+  , t.read_only_hint
+  , t.destructive_hint
+  , t.idempotent_hint
+  , t.open_world_hint
+FROM http_tool_definitions t
+WHERE t.deployment_id = $1
+  AND t.deleted IS FALSE
+ORDER BY t.tool_urn ASC
+`
+
+type ListDeploymentHTTPToolsForDiffRow struct {
+	ToolUrn          urn.Tool
+	Name             string
+	Schema           []byte
+	Security         []byte
+	ServerEnvVar     string
+	DefaultServerUrl pgtype.Text
+	ReadOnlyHint     pgtype.Bool
+	DestructiveHint  pgtype.Bool
+	IdempotentHint   pgtype.Bool
+	OpenWorldHint    pgtype.Bool
+}
+
+// The caller-facing shape of a deployment's HTTP tools, for comparing two
+// deployments.
+func (q *Queries) ListDeploymentHTTPToolsForDiff(ctx context.Context, deploymentID uuid.UUID) ([]ListDeploymentHTTPToolsForDiffRow, error) {
+	rows, err := q.db.Query(ctx, listDeploymentHTTPToolsForDiff, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDeploymentHTTPToolsForDiffRow
+	for rows.Next() {
+		var i ListDeploymentHTTPToolsForDiffRow
+		if err := rows.Scan(
+			&i.ToolUrn,
+			&i.Name,
+			&i.Schema,
+			&i.Security,
+			&i.ServerEnvVar,
+			&i.DefaultServerUrl,
+			&i.ReadOnlyHint,
+			&i.DestructiveHint,
+			&i.IdempotentHint,
+			&i.OpenWorldHint,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listDeployments = `-- name: ListDeployments :many
 WITH paged AS (
   SELECT d.id, d.user_id, d.created_at
@@ -0,0 +1,33 @@
+package email
+
+type TelemetryAlert struct {
+	Email       string
+	RuleName    string
+	Status      string
+	Headline    string
+	ProjectSlug string
+	Scope       string
+	Condition   string
+	Observed    string
+	LogsLink    string
+}
+
+func (t TelemetryAlert) Key() TemplateKey {
+	return TemplateKeyTelemetryAlert
+}
+
+func (t TelemetryAlert) AddToAudience() bool { return false }
+
+func (t TelemetryAlert) Variables() map[string]string {
+	return map[string]string{
+		"email":        t.Email,
+		"rule_name":    t.RuleName,
+		"status":       t.Status,
+		"headline":     t.Headline,
+		"project_slug": t.ProjectSlug,
+		"scope":        t.Scope,
+		"condition":    t.Condition,
+		"observed":     t.Observed,
+		"logs_link":    t.LogsLink,
+	}
+}
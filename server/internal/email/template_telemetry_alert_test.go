@@ -0,0 +1,67 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelemetryAlert_Key(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, TemplateKeyTelemetryAlert, TelemetryAlert{
+		Email:       "",
+		RuleName:    "",
+		Status:      "",
+		Headline:    "",
+		ProjectSlug: "",
+		Scope:       "",
+		Condition:   "",
+		Observed:    "",
+		LogsLink:    "",
+	}.Key())
+}
+
+func TestTelemetryAlert_Variables_RendersExpectedKeys(t *testing.T) {
+	t.Parallel()
+
+	tmpl := TelemetryAlert{
+		Email:       "oncall@example.com",
+		RuleName:    "Billing tools failing",
+		Status:      "FIRING",
+		Headline:    "The error rate crossed this rule's threshold.",
+		ProjectSlug: "default",
+		Scope:       "Toolset billing",
+		Condition:   "Error rate above 5% over 10m",
+		Observed:    "8.3% across 240 calls",
+		LogsLink:    "https://app.getgram.ai/acme/projects/default/logs",
+	}
+
+	require.Equal(t, map[string]string{
+		"email":        "oncall@example.com",
+		"rule_name":    "Billing tools failing",
+		"status":       "FIRING",
+		"headline":     "The error rate crossed this rule's threshold.",
+		"project_slug": "default",
+		"scope":        "Toolset billing",
+		"condition":    "Error rate above 5% over 10m",
+		"observed":     "8.3% across 240 calls",
+		"logs_link":    "https://app.getgram.ai/acme/projects/default/logs",
+	}, tmpl.Variables())
+}
+
+func TestTelemetryAlert_AddToAudience(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, TelemetryAlert{
+		Email:       "",
+		RuleName:    "",
+		Status:      "",
+		Headline:    "",
+		ProjectSlug: "",
+		Scope:       "",
+		Condition:   "",
+		Observed:    "",
+		LogsLink:    "",
+	}.AddToAudience())
+}
@@ -0,0 +1,31 @@
+package email
+
+type ToolCallApproval struct {
+	Email       string
+	ToolName    string
+	ProjectSlug string
+	Scope       string
+	Reason      string
+	Arguments   string
+	ExpiresIn   string
+	ApprovalURL string
+}
+
+func (t ToolCallApproval) Key() TemplateKey {
+	return TemplateKeyToolCallApproval
+}
+
+func (t ToolCallApproval) AddToAudience() bool { return false }
+
+func (t ToolCallApproval) Variables() map[string]string {
+	return map[string]string{
+		"email":        t.Email,
+		"tool_name":    t.ToolName,
+		"project_slug": t.ProjectSlug,
+		"scope":        t.Scope,
+		"reason":       t.Reason,
+		"arguments":    t.Arguments,
+		"expires_in":   t.ExpiresIn,
+		"approval_url": t.ApprovalURL,
+	}
+}
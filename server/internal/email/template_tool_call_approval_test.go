@@ -0,0 +1,35 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolCallApproval_Contract(t *testing.T) {
+	t.Parallel()
+
+	tmpl := ToolCallApproval{
+		Email:       "oncall@example.com",
+		ToolName:    "delete_customer",
+		ProjectSlug: "billing",
+		Scope:       "Toolset billing-ops",
+		Reason:      "Deleting customers needs a human.",
+		Arguments:   `{"customer_id":"cus_123"}`,
+		ExpiresIn:   "5m",
+		ApprovalURL: "https://app.getgram.ai/tool-approvals/abc?token=xyz",
+	}
+
+	require.Equal(t, TemplateKeyToolCallApproval, tmpl.Key())
+	require.Equal(t, map[string]string{
+		"email":        "oncall@example.com",
+		"tool_name":    "delete_customer",
+		"project_slug": "billing",
+		"scope":        "Toolset billing-ops",
+		"reason":       "Deleting customers needs a human.",
+		"arguments":    `{"customer_id":"cus_123"}`,
+		"expires_in":   "5m",
+		"approval_url": "https://app.getgram.ai/tool-approvals/abc?token=xyz",
+	}, tmpl.Variables())
+	require.False(t, tmpl.AddToAudience())
+}
@@ -39,6 +39,8 @@ const (
 	TemplateKeyTrialEndingSoon           TemplateKey = "trial_ending_soon"
 	TemplateKeyAccessPaused              TemplateKey = "access_paused"
 	TemplateKeyPaygActivated             TemplateKey = "payg_activated"
+	TemplateKeyTelemetryAlert            TemplateKey = "telemetry_alert"
+	TemplateKeyToolCallApproval          TemplateKey = "tool_call_approval"
 )
 
 var (
@@ -126,4 +128,6 @@ var RegisteredTemplates = []Template{
 	TrialEndingSoon{OrganizationName: "", TrialEndDate: "", ActionURL: ""},
 	AccessPaused{OrganizationName: "", ActionURL: ""},
 	PaygActivated{OrganizationName: "", TumPricePerMillionUsd: "", ActionURL: ""},
+	TelemetryAlert{Email: "", RuleName: "", Status: "", Headline: "", ProjectSlug: "", Scope: "", Condition: "", Observed: "", LogsLink: ""},
+	ToolCallApproval{Email: "", ToolName: "", ProjectSlug: "", Scope: "", Reason: "", Arguments: "", ExpiresIn: "", ApprovalURL: ""},
 }
@@ -35,7 +35,9 @@ func TestParseTemplateIDs_ValidatesCompleteRegistry(t *testing.T) {
 		"access_request":"id-9",
 		"trial_ending_soon":"id-10",
 		"access_paused":"id-11",
-		"payg_activated":"id-12"
+		"payg_activated":"id-12",
+		"telemetry_alert":"id-13",
+		"tool_call_approval":"id-14"
 	}`)
 	require.NoError(t, err)
 	require.NoError(t, ids.ValidateRegistered())
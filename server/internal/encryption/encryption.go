@@ -5,8 +5,11 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"strings"
 )
 
 const (
@@ -15,6 +18,7 @@ const (
 	errCodeNonceRead    = "ENC-3"
 	errCodeGCMOpen      = "ENC-4"
 	errCodeBase64Decode = "ENC-5"
+	errCodeUnknownKey   = "ENC-6"
 )
 
 type encryptionError struct {
@@ -63,8 +67,61 @@ func (e *decryptionError) Unwrap() error {
 	return e.inner
 }
 
+// keyIDSeparator splits a key ID from the ciphertext it prefixes. It is not
+// part of the standard base64 alphabet, so unprefixed ciphertexts written
+// before key rotation existed can never be mistaken for prefixed ones.
+const keyIDSeparator = ":"
+
+// maxKeyIDLength keeps the prefix small: stored columns such as environment
+// entry values have length limits sized for the unprefixed ciphertext.
+const maxKeyIDLength = 32
+
+// ErrUnknownKey is returned when a ciphertext names a key that is not in the
+// keyring, typically because it was retired before re-encryption finished.
+var ErrUnknownKey = errors.New("unknown encryption key")
+
+// Key is one AES-256 key in a keyring. Keys with an empty ID are legacy keys:
+// they encrypt without a prefix and decrypt ciphertexts that carry none.
+type Key struct {
+	ID       string
+	Material []byte
+}
+
+// ParseKey parses a key given as "<id>=<base64 key>", or as a bare base64 key
+// for a legacy key without an ID.
+func ParseKey(spec string) (Key, error) {
+	id, encoded, found := strings.Cut(spec, "=")
+	if !found || strings.Trim(encoded, "=") == "" {
+		// "=" only appears in base64 as trailing padding, so a spec whose
+		// first "=" starts a run of padding is a bare key.
+		id, encoded = "", spec
+	}
+
+	material, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return Key{}, fmt.Errorf("failed to decode base64 key: %w", err)
+	}
+
+	return Key{ID: id, Material: material}, nil
+}
+
+type keyEntry struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// Client encrypts with its primary key and decrypts with any key in its
+// keyring, which lets the primary key rotate without downtime: ciphertexts
+// written under a previous key stay readable until they are re-encrypted.
 type Client struct {
-	key []byte
+	primary *keyEntry
+	keys    map[string]*keyEntry
+	// legacy holds the keys tried, in order, for ciphertexts without a key
+	// ID: the unnamed key first, then every named key, since a legacy key may
+	// since have been given an ID.
+	legacy []*keyEntry
+	// ids lists the key IDs, primary first, in configuration order.
+	ids []string
 }
 
 func New(base64Key string) (*Client, error) {
@@ -76,50 +133,170 @@ func New(base64Key string) (*Client, error) {
 }
 
 func NewWithBytes(key []byte) (*Client, error) {
-	if len(key) != 32 {
-		return nil, fmt.Errorf("invalid AES-256 key size: %d bytes", len(key))
+	return NewKeyring(Key{ID: "", Material: key})
+}
+
+// NewKeyring returns a client that encrypts with primary and decrypts with
+// primary or any of the previous keys.
+func NewKeyring(primary Key, previous ...Key) (*Client, error) {
+	c := &Client{
+		primary: nil,
+		keys:    make(map[string]*keyEntry, len(previous)+1),
+		legacy:  nil,
+		ids:     make([]string, 0, len(previous)+1),
 	}
-	return &Client{key: key}, nil
+
+	var named []*keyEntry
+	for i, key := range append([]Key{primary}, previous...) {
+		entry, err := newKeyEntry(key)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := c.keys[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate encryption key id: %q", key.ID)
+		}
+		c.keys[key.ID] = entry
+		c.ids = append(c.ids, key.ID)
+
+		if i == 0 {
+			c.primary = entry
+		}
+		if key.ID == "" {
+			c.legacy = append(c.legacy, entry)
+		} else {
+			named = append(named, entry)
+		}
+	}
+	c.legacy = append(c.legacy, named...)
+
+	return c, nil
 }
 
-// Encrypt encrypts the plaintext using AES-GCM and returns a base64 encoded string
-func (e *Client) Encrypt(plaintext []byte) (string, error) {
-	block, err := aes.NewCipher(e.key)
+func newKeyEntry(key Key) (*keyEntry, error) {
+	if len(key.ID) > maxKeyIDLength {
+		return nil, fmt.Errorf("encryption key id too long: %q", key.ID)
+	}
+	for _, r := range key.ID {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return nil, fmt.Errorf("invalid encryption key id: %q", key.ID)
+		}
+	}
+	if len(key.Material) != 32 {
+		return nil, fmt.Errorf("invalid AES-256 key size: %d bytes", len(key.Material))
+	}
+
+	block, err := aes.NewCipher(key.Material)
 	if err != nil {
-		return "", newEncryptionError(errCodeAESNewCipher, err)
+		return nil, newEncryptionError(errCodeAESNewCipher, err)
 	}
 
 	gcm, err := cipher.NewGCM(block)
 	if err != nil {
-		return "", newEncryptionError(errCodeNewGCM, err)
+		return nil, newEncryptionError(errCodeNewGCM, err)
 	}
 
+	return &keyEntry{id: key.ID, aead: gcm}, nil
+}
+
+// PrimaryKeyID returns the ID of the key new ciphertexts are written with. It
+// is empty for a legacy key.
+func (e *Client) PrimaryKeyID() string {
+	return e.primary.id
+}
+
+// KeyIDs returns the IDs of every key in the keyring, the primary key's
+// first. A legacy key has the empty ID.
+func (e *Client) KeyIDs() []string {
+	return slices.Clone(e.ids)
+}
+
+// Encrypt encrypts the plaintext using AES-GCM with the primary key and
+// returns a base64 encoded string, prefixed with the key's ID if it has one.
+func (e *Client) Encrypt(plaintext []byte) (string, error) {
+	gcm := e.primary.aead
+
 	nonce := make([]byte, gcm.NonceSize())
-	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
 		return "", newEncryptionError(errCodeNonceRead, err)
 	}
 
-	ciphertext := gcm.Seal(nonce, nonce, plaintext, nil)
-	return base64.StdEncoding.EncodeToString(ciphertext), nil
+	ciphertext := base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, plaintext, nil))
+	if e.primary.id == "" {
+		return ciphertext, nil
+	}
+
+	return e.primary.id + keyIDSeparator + ciphertext, nil
 }
 
-// Decrypt decrypts the base64 encoded ciphertext using AES-GCM
+// Decrypt decrypts the base64 encoded ciphertext using AES-GCM with the key
+// it names, or with the legacy keys if it names none.
 func (e *Client) Decrypt(ciphertextStr string) (string, error) {
-	ciphertext, err := base64.StdEncoding.DecodeString(ciphertextStr)
+	id, encoded, prefixed := strings.Cut(ciphertextStr, keyIDSeparator)
+	if !prefixed {
+		encoded = ciphertextStr
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
 		return "", newDecryptionError(errCodeBase64Decode, err)
 	}
 
-	block, err := aes.NewCipher(e.key)
+	if prefixed {
+		entry, ok := e.keys[id]
+		if !ok || id == "" {
+			return "", newDecryptionError(errCodeUnknownKey, fmt.Errorf("%w: %q", ErrUnknownKey, id))
+		}
+		return open(entry.aead, ciphertext)
+	}
+
+	// GCM authenticates, so trying each legacy key in turn cannot yield a
+	// wrong plaintext; only the key that sealed the value opens it.
+	var lastErr error
+	for _, entry := range e.legacy {
+		plaintext, err := open(entry.aead, ciphertext)
+		if err == nil {
+			return plaintext, nil
+		}
+		lastErr = err
+	}
+	if lastErr == nil {
+		lastErr = newDecryptionError(errCodeUnknownKey, fmt.Errorf("%w: no key for unprefixed ciphertext", ErrUnknownKey))
+	}
+
+	return "", lastErr
+}
+
+// NeedsRotation reports whether the ciphertext was written under a key other
+// than the primary key.
+func (e *Client) NeedsRotation(ciphertext string) bool {
+	id, _, prefixed := strings.Cut(ciphertext, keyIDSeparator)
+	if !prefixed {
+		return e.primary.id != ""
+	}
+	return id != e.primary.id
+}
+
+// Rotate re-encrypts a ciphertext under the primary key. It returns the
+// ciphertext unchanged, and false, if it is already under the primary key.
+func (e *Client) Rotate(ciphertext string) (string, bool, error) {
+	if !e.NeedsRotation(ciphertext) {
+		return ciphertext, false, nil
+	}
+
+	plaintext, err := e.Decrypt(ciphertext)
 	if err != nil {
-		return "", newDecryptionError(errCodeAESNewCipher, err)
+		return "", false, err
 	}
 
-	gcm, err := cipher.NewGCM(block)
+	rotated, err := e.Encrypt([]byte(plaintext))
 	if err != nil {
-		return "", newDecryptionError(errCodeNewGCM, err)
+		return "", false, err
 	}
 
+	return rotated, true, nil
+}
+
+func open(gcm cipher.AEAD, ciphertext []byte) (string, error) {
 	nonceSize := gcm.NonceSize()
 	if len(ciphertext) < nonceSize {
 		return "", fmt.Errorf("ciphertext too short")
@@ -0,0 +1,128 @@
+package encryption_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+)
+
+func testKey(id string, fill byte) encryption.Key {
+	return encryption.Key{ID: id, Material: bytes.Repeat([]byte{fill}, 32)}
+}
+
+func TestKeyring_DecryptsWithAnyKnownKey(t *testing.T) {
+	t.Parallel()
+
+	legacy, err := encryption.NewKeyring(testKey("", 1))
+	require.NoError(t, err)
+	v1, err := encryption.NewKeyring(testKey("v1", 2), testKey("", 1))
+	require.NoError(t, err)
+	v2, err := encryption.NewKeyring(testKey("v2", 3), testKey("v1", 2), testKey("", 1))
+	require.NoError(t, err)
+
+	legacyCiphertext, err := legacy.Encrypt([]byte("legacy"))
+	require.NoError(t, err)
+	require.NotContains(t, legacyCiphertext, ":")
+
+	v1Ciphertext, err := v1.Encrypt([]byte("first"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(v1Ciphertext, "v1:"))
+
+	for ciphertext, want := range map[string]string{legacyCiphertext: "legacy", v1Ciphertext: "first"} {
+		plaintext, err := v2.Decrypt(ciphertext)
+		require.NoError(t, err)
+		require.Equal(t, want, plaintext)
+	}
+
+	v2Ciphertext, err := v2.Encrypt([]byte("second"))
+	require.NoError(t, err)
+	_, err = v1.Decrypt(v2Ciphertext)
+	require.ErrorIs(t, err, encryption.ErrUnknownKey)
+
+	require.Equal(t, []string{"v2", "v1", ""}, v2.KeyIDs())
+}
+
+func TestKeyring_LegacyKeyGivenAnID(t *testing.T) {
+	t.Parallel()
+
+	legacy, err := encryption.NewKeyring(testKey("", 1))
+	require.NoError(t, err)
+	ciphertext, err := legacy.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+
+	// The key that wrote unprefixed values was later named in the keyring.
+	named, err := encryption.NewKeyring(testKey("v2", 3), testKey("v1", 1))
+	require.NoError(t, err)
+
+	plaintext, err := named.Decrypt(ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, "secret", plaintext)
+}
+
+func TestKeyring_Rotate(t *testing.T) {
+	t.Parallel()
+
+	v1, err := encryption.NewKeyring(testKey("v1", 1))
+	require.NoError(t, err)
+	v2, err := encryption.NewKeyring(testKey("v2", 2), testKey("v1", 1))
+	require.NoError(t, err)
+
+	old, err := v1.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	require.True(t, v2.NeedsRotation(old))
+
+	rotated, changed, err := v2.Rotate(old)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.True(t, strings.HasPrefix(rotated, "v2:"))
+	require.False(t, v2.NeedsRotation(rotated))
+
+	plaintext, err := v2.Decrypt(rotated)
+	require.NoError(t, err)
+	require.Equal(t, "secret", plaintext)
+
+	again, changed, err := v2.Rotate(rotated)
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.Equal(t, rotated, again)
+}
+
+func TestParseKey(t *testing.T) {
+	t.Parallel()
+
+	material := bytes.Repeat([]byte{7}, 32)
+	encoded := base64.StdEncoding.EncodeToString(material)
+	require.True(t, strings.HasSuffix(encoded, "="), "fixture should carry base64 padding")
+
+	bare, err := encryption.ParseKey(encoded)
+	require.NoError(t, err)
+	require.Empty(t, bare.ID)
+	require.Equal(t, material, bare.Material)
+
+	named, err := encryption.ParseKey("2026-10=" + encoded)
+	require.NoError(t, err)
+	require.Equal(t, "2026-10", named.ID)
+	require.Equal(t, material, named.Material)
+}
+
+func TestNewKeyring_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for name, keys := range map[string][]encryption.Key{
+		"short key":    {{ID: "v1", Material: []byte("short")}},
+		"invalid id":   {testKey("v1:x", 1)},
+		"duplicate id": {testKey("v1", 1), testKey("v1", 2)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := encryption.NewKeyring(keys[0], keys[1:]...)
+			require.Error(t, err)
+		})
+	}
+}
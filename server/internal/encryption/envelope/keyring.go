@@ -0,0 +1,404 @@
+// Package envelope implements customer-managed encryption keys: an
+// organization's secrets are sealed under a data key of its own, and that data
+// key is stored only wrapped by the organization's GCP KMS key. Gram never
+// holds the customer key, so the customer can make every value sealed for them
+// unreadable by revoking it.
+//
+// Unwrapped data keys are cached in process for a bounded TTL, which is also
+// how long a revocation takes to reach every replica. Shred erases the wrapped
+// data key on Gram's side to the same effect, without waiting on the customer.
+//
+// Organizations without a data key keep using the application keyring, and
+// Keyring.Decrypt reads both kinds of ciphertext, so callers adopt it without a
+// migration.
+package envelope
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope/repo"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/gcp/gcpauth"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/gcp/gcpkms"
+)
+
+const (
+	// DefaultCacheTTL bounds how long an unwrapped data key stays in memory,
+	// and so how long a replica keeps reading an organization's secrets after
+	// the customer revokes their key.
+	DefaultCacheTTL = 5 * time.Minute
+
+	// ciphertextScheme prefixes values sealed under a data key, followed by the
+	// data key's ID and a second separator. Application keyring ciphertexts
+	// carry at most one separator, so the two never collide.
+	ciphertextScheme = "dek:"
+
+	dataKeySize  = 32
+	maxCachedKey = 1024
+)
+
+var (
+	// ErrDataKeyShredded is returned when reading a value sealed under a data
+	// key that has been shredded. The value is unrecoverable.
+	ErrDataKeyShredded = errors.New("organization data key has been shredded")
+
+	// ErrCustomerKeyUnavailable is returned when the organization's KMS key, or
+	// the credential Gram reaches it through, has been withdrawn.
+	ErrCustomerKeyUnavailable = errors.New("customer encryption key unavailable")
+
+	// ErrDataKeyExists is returned when enabling an organization that already
+	// has a live data key.
+	ErrDataKeyExists = errors.New("organization already has a data key")
+
+	// ErrCredentialUnusable is returned when enabling with a credential that
+	// is missing, deleted, or fails screening.
+	ErrCredentialUnusable = errors.New("credential cannot be used for customer-managed encryption")
+)
+
+// Sealer encrypts values for storage. *encryption.Client is the Sealer for
+// organizations without a data key.
+type Sealer interface {
+	Encrypt(plaintext []byte) (string, error)
+}
+
+// Keyring seals values under the owning organization's data key and reads them
+// back, falling back to the application keyring for everything else.
+type Keyring struct {
+	logger     *slog.Logger
+	db         *pgxpool.Pool
+	app        *encryption.Client
+	identity   *gcpauth.Identity
+	kmsClients gcpkms.EncryptionClientFactory
+	keys       *expirable.LRU[uuid.UUID, *encryption.Client]
+	unwraps    singleflight.Group
+}
+
+func NewKeyring(
+	logger *slog.Logger,
+	db *pgxpool.Pool,
+	app *encryption.Client,
+	identity *gcpauth.Identity,
+	kmsClients gcpkms.EncryptionClientFactory,
+	ttl time.Duration,
+) *Keyring {
+	return &Keyring{
+		logger:     logger.With(attr.SlogComponent("envelope_keyring")),
+		db:         db,
+		app:        app,
+		identity:   identity,
+		kmsClients: kmsClients,
+		keys:       expirable.NewLRU[uuid.UUID, *encryption.Client](maxCachedKey, nil, ttl),
+		unwraps:    singleflight.Group{},
+	}
+}
+
+// IsEnvelopeCiphertext reports whether a stored value was sealed under an
+// organization data key rather than the application keyring.
+func IsEnvelopeCiphertext(ciphertext string) bool {
+	_, _, ok := parseCiphertext(ciphertext)
+	return ok
+}
+
+// SealerForProject returns the Sealer for values owned by a project: its
+// organization's data key when it has one, and the application keyring
+// otherwise. Resolve it once per write rather than once per value.
+func (k *Keyring) SealerForProject(ctx context.Context, projectID uuid.UUID) (Sealer, error) {
+	id, err := repo.New(k.db).GetActiveDataKeyIDForProject(ctx, projectID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return k.app, nil
+	case err != nil:
+		return nil, fmt.Errorf("look up organization data key: %w", err)
+	}
+
+	enc, err := k.dataKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dataKeySealer{id: id, enc: enc}, nil
+}
+
+// Decrypt opens a value sealed by SealerForProject, whichever keyring sealed
+// it.
+func (k *Keyring) Decrypt(ctx context.Context, ciphertext string) (string, error) {
+	id, sealed, ok := parseCiphertext(ciphertext)
+	if !ok {
+		plaintext, err := k.app.Decrypt(ciphertext)
+		if err != nil {
+			return "", fmt.Errorf("decrypt: %w", err)
+		}
+		return plaintext, nil
+	}
+
+	enc, err := k.dataKey(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := enc.Decrypt(sealed)
+	if err != nil {
+		return "", fmt.Errorf("decrypt with organization data key: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// Enable generates a data key for the organization and wraps it with the named
+// KMS key, reached through one of the organization's GCP IAM credentials. The
+// wrapped key is unwrapped again before it is stored, so a credential granted
+// only encrypt, or a key Gram cannot read back, fails here rather than on the
+// first secret read. Values written before Enable stay under the application
+// keyring until they are next written. The key is stored through dbtx, so the
+// caller can audit it in the same transaction.
+func (k *Keyring) Enable(ctx context.Context, dbtx repo.DBTX, organizationID string, kmsKeyName string, credentialID uuid.UUID) (*repo.OrganizationDataKey, error) {
+	if err := gcpkms.ValidateCryptoKeyName(kmsKeyName); err != nil {
+		return nil, fmt.Errorf("enable customer-managed encryption: %w", err)
+	}
+
+	queries := repo.New(dbtx)
+
+	row, err := queries.GetOrganizationGcpCredential(ctx, repo.GetOrganizationGcpCredentialParams{
+		ID:             credentialID,
+		OrganizationID: conv.ToPGText(organizationID),
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("load credential: %w", err)
+	}
+	found := err == nil
+
+	credential, problem, detail, err := k.identity.ScreenStoredCredential(ctx, k.logger, gcpauth.StoredCredential{
+		Present:                   found,
+		ImpersonateServiceAccount: row.ImpersonateServiceAccount.String,
+		HasWifConfig:              row.WifPoolID.Valid || row.WifProviderID.Valid || row.WifProjectNumber.Valid,
+	})
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("screen credential: %w", err)
+	case problem != "":
+		return nil, fmt.Errorf("%w: %s", ErrCredentialUnusable, detail)
+	}
+
+	material := make([]byte, dataKeySize)
+	if _, err := rand.Read(material); err != nil {
+		return nil, fmt.Errorf("generate data key: %w", err)
+	}
+
+	client, err := k.kmsClient(ctx, credential)
+	if err != nil {
+		return nil, err
+	}
+	defer o11y.LogDefer(ctx, k.logger, func() error { return client.Close() })
+
+	aad := dataKeyAAD(organizationID)
+	wrapped, err := client.Encrypt(ctx, kmsKeyName, material, aad)
+	if err != nil {
+		return nil, customerKeyError("wrap data key", err)
+	}
+	if _, err := client.Decrypt(ctx, kmsKeyName, wrapped, aad); err != nil {
+		return nil, customerKeyError("unwrap data key", err)
+	}
+
+	enc, err := encryption.NewWithBytes(material)
+	if err != nil {
+		return nil, fmt.Errorf("build data key cipher: %w", err)
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("generate data key id: %w", err)
+	}
+
+	created, err := queries.CreateDataKey(ctx, repo.CreateDataKeyParams{
+		ID:                   id,
+		OrganizationID:       organizationID,
+		KmsKeyName:           kmsKeyName,
+		ExternalCredentialID: uuid.NullUUID{UUID: credentialID, Valid: true},
+		WrappedKey:           wrapped,
+	})
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation:
+		return nil, ErrDataKeyExists
+	case err != nil:
+		return nil, fmt.Errorf("store data key: %w", err)
+	}
+
+	k.keys.Add(id, enc)
+
+	return &created, nil
+}
+
+// ActiveDataKey returns the organization's live data key, or nil when it has
+// none.
+func (k *Keyring) ActiveDataKey(ctx context.Context, organizationID string) (*repo.OrganizationDataKey, error) {
+	key, err := repo.New(k.db).GetActiveDataKey(ctx, organizationID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("get organization data key: %w", err)
+	}
+
+	return &key, nil
+}
+
+// Shred erases the organization's wrapped data key, making every value sealed
+// under it unrecoverable, and returns the IDs of the keys it erased. This
+// replica forgets the key at once; others hold it until their cache entry
+// expires. New writes fall back to the application keyring until Enable is
+// called again.
+func (k *Keyring) Shred(ctx context.Context, dbtx repo.DBTX, organizationID string) ([]uuid.UUID, error) {
+	ids, err := repo.New(dbtx).ShredDataKeys(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("shred organization data key: %w", err)
+	}
+
+	for _, id := range ids {
+		k.keys.Remove(id)
+	}
+
+	return ids, nil
+}
+
+// dataKey returns the unwrapped data key, from the cache when it is fresh.
+// Concurrent misses for the same key share one KMS call.
+func (k *Keyring) dataKey(ctx context.Context, id uuid.UUID) (*encryption.Client, error) {
+	if enc, ok := k.keys.Get(id); ok {
+		return enc, nil
+	}
+
+	v, err, _ := k.unwraps.Do(id.String(), func() (any, error) {
+		if enc, ok := k.keys.Get(id); ok {
+			return enc, nil
+		}
+		return k.unwrap(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	enc, ok := v.(*encryption.Client)
+	if !ok {
+		return nil, fmt.Errorf("unexpected data key type %T", v)
+	}
+	return enc, nil
+}
+
+func (k *Keyring) unwrap(ctx context.Context, id uuid.UUID) (*encryption.Client, error) {
+	row, err := repo.New(k.db).GetDataKeyForUnwrap(ctx, id)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, fmt.Errorf("%w: data key %s no longer exists", ErrDataKeyShredded, id)
+	case err != nil:
+		return nil, fmt.Errorf("load data key: %w", err)
+	case row.ShreddedAt.Valid:
+		return nil, fmt.Errorf("%w: data key %s", ErrDataKeyShredded, id)
+	case !row.CredentialID.Valid:
+		return nil, fmt.Errorf("%w: the credential for data key %s was deleted", ErrCustomerKeyUnavailable, id)
+	}
+
+	client, err := k.kmsClient(ctx, gcpauth.Credential{
+		ImpersonateServiceAccount: row.ImpersonateServiceAccount.String,
+		WifPoolID:                 row.WifPoolID.String,
+		WifProviderID:             row.WifProviderID.String,
+		WifProjectNumber:          row.WifProjectNumber.String,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer o11y.LogDefer(ctx, k.logger, func() error { return client.Close() })
+
+	material, err := client.Decrypt(ctx, row.KmsKeyName, row.WrappedKey, dataKeyAAD(row.OrganizationID))
+	if err != nil {
+		k.logger.WarnContext(ctx, "failed to unwrap organization data key",
+			attr.SlogError(err),
+			attr.SlogOrganizationID(row.OrganizationID),
+		)
+		return nil, customerKeyError("unwrap data key", err)
+	}
+
+	enc, err := encryption.NewWithBytes(material)
+	if err != nil {
+		return nil, fmt.Errorf("build data key cipher: %w", err)
+	}
+
+	k.keys.Add(id, enc)
+
+	return enc, nil
+}
+
+func (k *Keyring) kmsClient(ctx context.Context, credential gcpauth.Credential) (gcpkms.EncryptionClient, error) {
+	tokenSource, err := k.identity.TokenSource(ctx, credential)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCustomerKeyUnavailable, err)
+	}
+
+	client, err := k.kmsClients(ctx, tokenSource)
+	if err != nil {
+		return nil, fmt.Errorf("build kms client: %w", err)
+	}
+
+	return client, nil
+}
+
+// customerKeyError marks a KMS refusal as the customer having withdrawn their
+// key, so callers can tell it apart from an outage.
+func customerKeyError(op string, err error) error {
+	if errors.Is(err, gcpkms.ErrKeyUnavailable) {
+		return fmt.Errorf("%s: %w: %w", op, ErrCustomerKeyUnavailable, err)
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
+
+// dataKeyAAD binds a wrapped data key to its organization, so a wrapped key
+// copied onto another organization's row does not unwrap.
+func dataKeyAAD(organizationID string) []byte {
+	return []byte("gram:organization-data-key:" + organizationID)
+}
+
+type dataKeySealer struct {
+	id  uuid.UUID
+	enc *encryption.Client
+}
+
+func (s *dataKeySealer) Encrypt(plaintext []byte) (string, error) {
+	sealed, err := s.enc.Encrypt(plaintext)
+	if err != nil {
+		return "", fmt.Errorf("encrypt with organization data key: %w", err)
+	}
+	return ciphertextScheme + s.id.String() + ":" + sealed, nil
+}
+
+func parseCiphertext(ciphertext string) (uuid.UUID, string, bool) {
+	rest, ok := strings.CutPrefix(ciphertext, ciphertextScheme)
+	if !ok {
+		return uuid.Nil, "", false
+	}
+	rawID, sealed, ok := strings.Cut(rest, ":")
+	if !ok {
+		return uuid.Nil, "", false
+	}
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return uuid.Nil, "", false
+	}
+	return id, sealed, true
+}
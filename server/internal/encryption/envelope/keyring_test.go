@@ -0,0 +1,175 @@
+package envelope_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+func TestKeyring_SealsUnderOrganizationDataKey(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestInstance(t)
+	keyring := ti.newKeyring(t, envelope.DefaultCacheTTL)
+
+	orgID, projectID := ti.organizationID, ti.projectID
+	credentialID := ti.seedCredential(t, ctx, orgID)
+	_, otherProjectID := testenv.CreateOrganizationWithProject(t, ctx, ti.conn)
+
+	key, err := keyring.Enable(ctx, ti.conn, orgID, testKMSKeyName, credentialID)
+	require.NoError(t, err)
+	require.Equal(t, testKMSKeyName, key.KmsKeyName)
+
+	sealer, err := keyring.SealerForProject(ctx, projectID)
+	require.NoError(t, err)
+	ciphertext, err := sealer.Encrypt([]byte("sk_live_123"))
+	require.NoError(t, err)
+	require.True(t, envelope.IsEnvelopeCiphertext(ciphertext))
+	require.True(t, strings.HasPrefix(ciphertext, "dek:"+key.ID.String()+":"))
+
+	// A replica that has never seen the key unwraps it through KMS.
+	plaintext, err := ti.newKeyring(t, envelope.DefaultCacheTTL).Decrypt(ctx, ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, "sk_live_123", plaintext)
+
+	// Organizations without a data key keep the application keyring.
+	otherSealer, err := keyring.SealerForProject(ctx, otherProjectID)
+	require.NoError(t, err)
+	otherCiphertext, err := otherSealer.Encrypt([]byte("plain"))
+	require.NoError(t, err)
+	require.False(t, envelope.IsEnvelopeCiphertext(otherCiphertext))
+
+	plaintext, err = keyring.Decrypt(ctx, otherCiphertext)
+	require.NoError(t, err)
+	require.Equal(t, "plain", plaintext)
+}
+
+func TestKeyring_RevokedCustomerKey(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestInstance(t)
+	warm := ti.newKeyring(t, envelope.DefaultCacheTTL)
+
+	orgID, projectID := ti.organizationID, ti.projectID
+	credentialID := ti.seedCredential(t, ctx, orgID)
+	_, err := warm.Enable(ctx, ti.conn, orgID, testKMSKeyName, credentialID)
+	require.NoError(t, err)
+
+	sealer, err := warm.SealerForProject(ctx, projectID)
+	require.NoError(t, err)
+	ciphertext, err := sealer.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+
+	ti.kms.Revoke(testKMSKeyName)
+
+	// A replica with the key cached keeps reading until the entry expires...
+	plaintext, err := warm.Decrypt(ctx, ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, "secret", plaintext)
+
+	// ...and one that has to unwrap cannot.
+	_, err = ti.newKeyring(t, envelope.DefaultCacheTTL).Decrypt(ctx, ciphertext)
+	require.ErrorIs(t, err, envelope.ErrCustomerKeyUnavailable)
+}
+
+func TestKeyring_DeletedCredential(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestInstance(t)
+	keyring := ti.newKeyring(t, envelope.DefaultCacheTTL)
+
+	orgID, projectID := ti.organizationID, ti.projectID
+	credentialID := ti.seedCredential(t, ctx, orgID)
+	_, err := keyring.Enable(ctx, ti.conn, orgID, testKMSKeyName, credentialID)
+	require.NoError(t, err)
+
+	sealer, err := keyring.SealerForProject(ctx, projectID)
+	require.NoError(t, err)
+	ciphertext, err := sealer.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+
+	// Deleting the credential outright detaches it from the data key rather
+	// than being refused, and the key can no longer be unwrapped.
+	testenv.DeleteExternalCredential(t, ctx, ti.conn, credentialID)
+
+	active, err := keyring.ActiveDataKey(ctx, orgID)
+	require.NoError(t, err)
+	require.False(t, active.ExternalCredentialID.Valid)
+
+	_, err = ti.newKeyring(t, envelope.DefaultCacheTTL).Decrypt(ctx, ciphertext)
+	require.ErrorIs(t, err, envelope.ErrCustomerKeyUnavailable)
+}
+
+func TestKeyring_Shred(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestInstance(t)
+	keyring := ti.newKeyring(t, envelope.DefaultCacheTTL)
+
+	orgID, projectID := ti.organizationID, ti.projectID
+	credentialID := ti.seedCredential(t, ctx, orgID)
+	_, err := keyring.Enable(ctx, ti.conn, orgID, testKMSKeyName, credentialID)
+	require.NoError(t, err)
+
+	sealer, err := keyring.SealerForProject(ctx, projectID)
+	require.NoError(t, err)
+	ciphertext, err := sealer.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+
+	shredded, err := keyring.Shred(ctx, ti.conn, orgID)
+	require.NoError(t, err)
+	require.Len(t, shredded, 1)
+
+	_, err = keyring.Decrypt(ctx, ciphertext)
+	require.ErrorIs(t, err, envelope.ErrDataKeyShredded)
+
+	active, err := keyring.ActiveDataKey(ctx, orgID)
+	require.NoError(t, err)
+	require.Nil(t, active)
+
+	// New writes fall back to the application keyring, and the organization
+	// can enable a fresh data key.
+	sealer, err = keyring.SealerForProject(ctx, projectID)
+	require.NoError(t, err)
+	ciphertext, err = sealer.Encrypt([]byte("after"))
+	require.NoError(t, err)
+	require.False(t, envelope.IsEnvelopeCiphertext(ciphertext))
+
+	_, err = keyring.Enable(ctx, ti.conn, orgID, testKMSKeyName, credentialID)
+	require.NoError(t, err)
+}
+
+func TestKeyring_EnableRejects(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestInstance(t)
+	keyring := ti.newKeyring(t, envelope.DefaultCacheTTL)
+
+	orgID := ti.organizationID
+	credentialID := ti.seedCredential(t, ctx, orgID)
+	otherOrgID, _ := testenv.CreateOrganizationWithProject(t, ctx, ti.conn)
+	otherCredentialID := ti.seedCredential(t, ctx, otherOrgID)
+
+	_, err := keyring.Enable(ctx, ti.conn, orgID, testKMSKeyName+"/cryptoKeyVersions/1", credentialID)
+	require.Error(t, err)
+
+	_, err = keyring.Enable(ctx, ti.conn, orgID, testKMSKeyName, otherCredentialID)
+	require.ErrorIs(t, err, envelope.ErrCredentialUnusable, "another organization's credential must not be usable")
+
+	_, err = keyring.Enable(ctx, ti.conn, orgID, testKMSKeyName, uuid.New())
+	require.ErrorIs(t, err, envelope.ErrCredentialUnusable)
+
+	ti.kms.Revoke(testKMSKeyName + "-revoked")
+	_, err = keyring.Enable(ctx, ti.conn, otherOrgID, testKMSKeyName+"-revoked", otherCredentialID)
+	require.ErrorIs(t, err, envelope.ErrCustomerKeyUnavailable)
+
+	_, err = keyring.Enable(ctx, ti.conn, orgID, testKMSKeyName, credentialID)
+	require.NoError(t, err)
+	_, err = keyring.Enable(ctx, ti.conn, orgID, testKMSKeyName, credentialID)
+	require.ErrorIs(t, err, envelope.ErrDataKeyExists)
+}
@@ -0,0 +1,80 @@
+-- name: CreateDataKey :one
+INSERT INTO organization_data_keys (
+  id,
+  organization_id,
+  kms_key_name,
+  external_credential_id,
+  wrapped_key
+) VALUES (
+  @id,
+  @organization_id,
+  @kms_key_name,
+  @external_credential_id,
+  @wrapped_key
+)
+RETURNING *;
+
+-- name: GetActiveDataKey :one
+SELECT *
+FROM organization_data_keys
+WHERE organization_id = @organization_id
+  AND shredded_at IS NULL;
+
+-- name: GetActiveDataKeyIDForProject :one
+SELECT odk.id
+FROM organization_data_keys AS odk
+JOIN projects AS p ON p.organization_id = odk.organization_id
+WHERE p.id = @project_id
+  AND odk.shredded_at IS NULL;
+
+-- name: GetDataKeyForUnwrap :one
+-- A data key together with the credential Gram authenticates to its KMS key
+-- as. The credential columns are NULL when the credential has since been
+-- deleted, which also nulls external_credential_id, or is no longer an
+-- organization-level GCP credential.
+SELECT
+  odk.id,
+  odk.organization_id,
+  odk.kms_key_name,
+  odk.wrapped_key,
+  odk.shredded_at,
+  ec.id AS credential_id,
+  gic.impersonate_service_account,
+  gic.wif_pool_id,
+  gic.wif_provider_id,
+  gic.wif_project_number
+FROM organization_data_keys AS odk
+LEFT JOIN external_credentials AS ec
+       ON ec.id = odk.external_credential_id
+      AND ec.organization_id = odk.organization_id
+      AND ec.project_id IS NULL
+      AND ec.provider = 'gcp_iam'
+      AND ec.deleted IS FALSE
+LEFT JOIN gcp_iam_credentials AS gic
+       ON gic.external_credential_id = ec.id
+WHERE odk.id = @id;
+
+-- name: GetOrganizationGcpCredential :one
+SELECT
+  gic.impersonate_service_account,
+  gic.wif_pool_id,
+  gic.wif_provider_id,
+  gic.wif_project_number
+FROM external_credentials AS ec
+JOIN gcp_iam_credentials AS gic ON gic.external_credential_id = ec.id
+WHERE ec.id = @id
+  AND ec.organization_id = @organization_id
+  AND ec.project_id IS NULL
+  AND ec.provider = 'gcp_iam'
+  AND ec.deleted IS FALSE;
+
+-- name: ShredDataKeys :many
+-- Erases the organization's wrapped data key. Every value sealed under it is
+-- unrecoverable afterwards.
+UPDATE organization_data_keys
+SET wrapped_key = NULL,
+    shredded_at = clock_timestamp(),
+    updated_at = clock_timestamp()
+WHERE organization_id = @organization_id
+  AND shredded_at IS NULL
+RETURNING id;
@@ -0,0 +1,112 @@
+package envelope_test
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	"github.com/speakeasy-api/gram/server/internal/billing"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/gcp/gcpauth"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/gcp/gcpkms"
+)
+
+var (
+	infra *testenv.Environment
+)
+
+func TestMain(m *testing.M) {
+	res, cleanup, err := testenv.Launch(context.Background(), testenv.LaunchOptions{Postgres: true, Redis: true})
+	if err != nil {
+		log.Fatalf("Failed to launch test infrastructure: %v", err)
+		os.Exit(1)
+	}
+
+	infra = res
+
+	code := m.Run()
+
+	if err := cleanup(); err != nil {
+		log.Fatalf("Failed to cleanup test infrastructure: %v", err)
+		os.Exit(1)
+	}
+
+	os.Exit(code)
+}
+
+const testKMSKeyName = "projects/customer/locations/global/keyRings/gram/cryptoKeys/secrets"
+
+type testInstance struct {
+	conn           *pgxpool.Pool
+	kms            *gcpkms.LocalEncryptionClient
+	organizationID string
+	projectID      uuid.UUID
+}
+
+func newTestInstance(t *testing.T) (context.Context, *testInstance) {
+	t.Helper()
+
+	ctx := t.Context()
+
+	logger := testenv.NewLogger(t)
+	tracerProvider := testenv.NewTracerProvider(t)
+
+	conn, err := infra.CloneTestDatabase(t, "testdb")
+	require.NoError(t, err)
+
+	redisClient, err := infra.NewRedisClient(t, 0)
+	require.NoError(t, err)
+
+	billingClient := billing.NewStubClient(logger, tracerProvider)
+	sessionManager := testenv.NewTestManager(t, logger, tracerProvider, conn, redisClient, cache.Suffix("gram-local"), billingClient)
+
+	ctx = testenv.InitAuthContext(t, ctx, conn, sessionManager)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+	require.NotNil(t, authCtx.ProjectID)
+
+	return ctx, &testInstance{
+		conn:           conn,
+		kms:            gcpkms.NewLocalEncryptionClient([]byte("envelope-test")),
+		organizationID: authCtx.ActiveOrganizationID,
+		projectID:      *authCtx.ProjectID,
+	}
+}
+
+// newKeyring builds a keyring with a cold cache over the instance's database
+// and KMS, as a second replica would see them.
+func (ti *testInstance) newKeyring(t *testing.T, ttl time.Duration) *envelope.Keyring {
+	t.Helper()
+
+	factory := func(context.Context, oauth2.TokenSource) (gcpkms.EncryptionClient, error) {
+		return ti.kms, nil
+	}
+
+	return envelope.NewKeyring(
+		testenv.NewLogger(t),
+		ti.conn,
+		testenv.NewEncryptionClient(t),
+		gcpauth.NewIdentity(gcpauth.NewStubResolver()),
+		factory,
+		ttl,
+	)
+}
+
+// seedCredential creates an impersonation credential in the organization
+// and returns its ID.
+func (ti *testInstance) seedCredential(t *testing.T, ctx context.Context, organizationID string) uuid.UUID {
+	t.Helper()
+
+	return testenv.CreateGCPIAMCredential(t, ctx, ti.conn, organizationID, "byok", "gram-byok@customer-project.iam.gserviceaccount.com")
+}
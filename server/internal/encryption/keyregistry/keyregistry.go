@@ -0,0 +1,99 @@
+// Package keyregistry records every key the application keyring has held, so
+// a previous key is only dropped from the keyring once nothing encrypted
+// under it can still come back to be decrypted.
+//
+// Stored ciphertexts are swept by the key rotation workflow, which marks the
+// keys it has drained. Ciphertexts handed out rather than stored, such as
+// platform MCP credentials, cached admin sessions and signed OAuth flow state,
+// cannot be swept and are only safe to orphan once they have expired.
+package keyregistry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/keyregistry/repo"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+)
+
+// IssuedCiphertextLifetime is the longest a ciphertext handed out to a client
+// stays valid. Platform MCP refresh tokens, at 30 days, are the longest-lived.
+const IssuedCiphertextLifetime = 30 * 24 * time.Hour
+
+// ErrKeyInUse is returned by Register when the keyring has dropped a key that
+// ciphertexts may still be encrypted under.
+var ErrKeyInUse = errors.New("encryption key removed from the keyring while still in use")
+
+// Register records the keyring's keys and refuses a keyring that has dropped
+// a key before a rotation sweep drained it and IssuedCiphertextLifetime has
+// passed since. Every process holding the keyring calls it on startup.
+func Register(ctx context.Context, db *pgxpool.Pool, enc *encryption.Client) error {
+	dbtx, err := db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	queries := repo.New(dbtx)
+
+	if err := queries.LockEncryptionKeys(ctx); err != nil {
+		return fmt.Errorf("lock encryption keys: %w", err)
+	}
+
+	ids := enc.KeyIDs()
+	if err := queries.RegisterPrimaryEncryptionKey(ctx, ids[0]); err != nil {
+		return fmt.Errorf("register primary encryption key: %w", err)
+	}
+	for _, id := range ids[1:] {
+		if err := queries.RegisterPreviousEncryptionKey(ctx, id); err != nil {
+			return fmt.Errorf("register previous encryption key %q: %w", id, err)
+		}
+	}
+
+	retired, err := queries.ListRetiredEncryptionKeys(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("list retired encryption keys: %w", err)
+	}
+
+	var inUse []string
+	for _, key := range retired {
+		switch {
+		case !key.ReencryptedAt.Valid:
+			inUse = append(inUse, fmt.Sprintf("%q has not been re-encrypted by a rotation", key.ID))
+		case time.Since(key.ReencryptedAt.Time) < IssuedCiphertextLifetime:
+			until := key.ReencryptedAt.Time.Add(IssuedCiphertextLifetime)
+			inUse = append(inUse, fmt.Sprintf("%q protects issued credentials until %s", key.ID, until.UTC().Format(time.RFC3339)))
+		}
+	}
+	if len(inUse) > 0 {
+		return fmt.Errorf("%w: %s; keep it in --encryption-previous-keys", ErrKeyInUse, strings.Join(inUse, ", "))
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// MarkReencrypted records that a rotation sweep under primaryKeyID, started
+// at sweptSince, re-encrypted every stored ciphertext under the other keys.
+// It returns the IDs of the keys it marked.
+func MarkReencrypted(ctx context.Context, db *pgxpool.Pool, primaryKeyID string, sweptSince time.Time) ([]string, error) {
+	ids, err := repo.New(db).MarkEncryptionKeysReencrypted(ctx, repo.MarkEncryptionKeysReencryptedParams{
+		PrimaryKeyID: primaryKeyID,
+		SweptSince:   pgtype.Timestamptz{Time: sweptSince, InfinityModifier: pgtype.Finite, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("mark encryption keys re-encrypted: %w", err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,37 @@
+-- name: LockEncryptionKeys :exec
+-- Serializes registration across replicas starting at the same time.
+SELECT pg_advisory_xact_lock(hashtextextended('encryption-keys', 0));
+
+-- name: RegisterPrimaryEncryptionKey :exec
+-- A key becoming primary again may have ciphertexts written under it from
+-- now on, so any earlier sweep no longer vouches for it.
+INSERT INTO encryption_keys (id, last_primary_at)
+VALUES (@id, clock_timestamp())
+ON CONFLICT (id) DO UPDATE
+SET last_primary_at = clock_timestamp(),
+    reencrypted_at = NULL,
+    updated_at = clock_timestamp();
+
+-- name: RegisterPreviousEncryptionKey :exec
+INSERT INTO encryption_keys (id)
+VALUES (@id)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: ListRetiredEncryptionKeys :many
+-- Keys the registry knows about that the running keyring no longer holds.
+SELECT id, reencrypted_at
+FROM encryption_keys
+WHERE NOT (id = ANY(@keyring_ids::text[]))
+ORDER BY id;
+
+-- name: MarkEncryptionKeysReencrypted :many
+-- Only keys that have not been primary since the sweep started are vouched
+-- for: a replica that started on one of them during the sweep may have
+-- written ciphertexts behind its cursor.
+UPDATE encryption_keys
+SET reencrypted_at = clock_timestamp(),
+    updated_at = clock_timestamp()
+WHERE id <> @primary_key_id
+  AND reencrypted_at IS NULL
+  AND (last_primary_at IS NULL OR last_primary_at < @swept_since)
+RETURNING id;
@@ -27,6 +27,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/contextvalues"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
 	"github.com/speakeasy-api/gram/server/internal/environments/repo"
 	mcpmetadata_repo "github.com/speakeasy-api/gram/server/internal/mcpmetadata/repo"
 	"github.com/speakeasy-api/gram/server/internal/middleware"
@@ -53,6 +54,7 @@ func NewService(logger *slog.Logger,
 	db *pgxpool.Pool,
 	sessions *sessions.Manager,
 	enc *encryption.Client,
+	orgKeys *envelope.Keyring,
 	authz *authz.Engine,
 	auditLogger *audit.Logger,
 ) *Service {
@@ -67,7 +69,7 @@ func NewService(logger *slog.Logger,
 		repo:    envRepo,
 		auth:    auth.New(logger, db, sessions, authz),
 		authz:   authz,
-		entries: NewEnvironmentEntries(logger, db, enc, mcpMetadataRepo),
+		entries: NewEnvironmentEntries(logger, db, enc, orgKeys, nil, mcpMetadataRepo),
 		audit:   auditLogger,
 	}
 }
@@ -144,7 +146,7 @@ func (s *Service) CreateEnvironment(ctx context.Context, payload *gen.CreateEnvi
 	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
 
 	er := s.repo.WithTx(dbtx)
-	entriesRepo := NewEnvironmentEntries(logger, dbtx, s.entries.enc, s.entries.mcpMetadataRepo)
+	entriesRepo := NewEnvironmentEntries(logger, dbtx, s.entries.enc, s.entries.orgKeys, s.entries.secretRefs, s.entries.mcpMetadataRepo)
 
 	environment, err := er.CreateEnvironment(ctx, input)
 	if err != nil {
@@ -175,7 +177,7 @@ func (s *Service) CreateEnvironment(ctx context.Context, payload *gen.CreateEnvi
 		isSecrets[i] = isSecret
 	}
 
-	rows, err := entriesRepo.CreateEnvironmentEntries(ctx, repo.CreateEnvironmentEntriesParams{
+	rows, err := entriesRepo.CreateEnvironmentEntries(ctx, *authCtx.ProjectID, repo.CreateEnvironmentEntriesParams{
 		EnvironmentID: environment.ID,
 		Names:         names,
 		Values:        values,
@@ -276,7 +278,7 @@ func (s *Service) UpdateEnvironment(ctx context.Context, payload *gen.UpdateEnvi
 	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
 
 	er := s.repo.WithTx(dbtx)
-	entriesRepo := NewEnvironmentEntries(logger, dbtx, s.entries.enc, s.entries.mcpMetadataRepo)
+	entriesRepo := NewEnvironmentEntries(logger, dbtx, s.entries.enc, s.entries.orgKeys, s.entries.secretRefs, s.entries.mcpMetadataRepo)
 
 	// Unredacted entries back the secrecy-flip rules below: flipping a
 	// non-secret entry to secret without a new value encrypts the stored
@@ -467,7 +469,7 @@ func (s *Service) CloneEnvironment(ctx context.Context, payload *gen.CloneEnviro
 	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
 
 	er := s.repo.WithTx(dbtx)
-	entriesRepo := NewEnvironmentEntries(logger, dbtx, s.entries.enc, s.entries.mcpMetadataRepo)
+	entriesRepo := NewEnvironmentEntries(logger, dbtx, s.entries.enc, s.entries.orgKeys, s.entries.secretRefs, s.entries.mcpMetadataRepo)
 
 	newName := payload.NewName
 	newSlug := conv.ToSlug(newName)
@@ -497,7 +499,11 @@ func (s *Service) CloneEnvironment(ctx context.Context, payload *gen.CloneEnviro
 			return nil, oops.E(oops.CodeUnexpected, err, "failed to clone environment entries").LogError(ctx, logger)
 		}
 	} else {
-		placeholder, err := s.entries.enc.Encrypt([]byte(""))
+		sealer, err := s.entries.sealer(ctx, *authCtx.ProjectID)
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "failed to prepare placeholder value").LogError(ctx, logger)
+		}
+		placeholder, err := sealer.Encrypt([]byte(""))
 		if err != nil {
 			return nil, oops.E(oops.CodeUnexpected, err, "failed to prepare placeholder value").LogError(ctx, logger)
 		}
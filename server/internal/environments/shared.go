@@ -11,8 +11,10 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
 	"github.com/speakeasy-api/gram/server/internal/environments/repo"
 	mcpmetadata_repo "github.com/speakeasy-api/gram/server/internal/mcpmetadata/repo"
+	"github.com/speakeasy-api/gram/server/internal/secretrefs"
 	"github.com/speakeasy-api/gram/server/internal/toolconfig"
 )
 
@@ -21,14 +23,23 @@ type EnvironmentEntries struct {
 	logger          *slog.Logger
 	repo            *repo.Queries
 	enc             *encryption.Client
+	orgKeys         *envelope.Keyring
+	secretRefs      *secretrefs.Resolver
 	mcpMetadataRepo *mcpmetadata_repo.Queries
 }
 
-func NewEnvironmentEntries(logger *slog.Logger, db repo.DBTX, enc *encryption.Client, mcpMetadataRepo *mcpmetadata_repo.Queries) *EnvironmentEntries {
+// NewEnvironmentEntries builds the entries accessor. orgKeys seals secrets
+// under the owning organization's data key when it has one; nil keeps every
+// value on the application keyring. secretRefs resolves values that reference
+// an external secret manager when a tool call names them; nil leaves
+// them as written.
+func NewEnvironmentEntries(logger *slog.Logger, db repo.DBTX, enc *encryption.Client, orgKeys *envelope.Keyring, secretRefs *secretrefs.Resolver, mcpMetadataRepo *mcpmetadata_repo.Queries) *EnvironmentEntries {
 	return &EnvironmentEntries{
 		logger:          logger.With(attr.SlogComponent("environment_entries")),
 		repo:            repo.New(db),
 		enc:             enc,
+		orgKeys:         orgKeys,
+		secretRefs:      secretRefs,
 		mcpMetadataRepo: mcpMetadataRepo,
 	}
 }
@@ -204,6 +215,21 @@ func (e *EnvironmentEntries) LoadSystemEnv(ctx context.Context, projectID uuid.U
 	return systemEnv, nil
 }
 
+// ResolveSecretRefs replaces the named variables' values that reference an
+// external secret manager with the secrets they name. It runs on the merged
+// environment so the secret manager settings can live in a different
+// environment than the references.
+func (e *EnvironmentEntries) ResolveSecretRefs(ctx context.Context, projectID uuid.UUID, env *toolconfig.CaseInsensitiveEnv, names []string) error {
+	if e.secretRefs == nil {
+		return nil
+	}
+
+	if err := e.secretRefs.Resolve(ctx, projectID, env, names); err != nil {
+		return fmt.Errorf("resolve secret references: %w", err)
+	}
+	return nil
+}
+
 func (e *EnvironmentEntries) ListEnvironmentEntries(ctx context.Context, projectID uuid.UUID, environmentID uuid.UUID, redacted bool) ([]repo.EnvironmentEntry, error) {
 	entries, err := e.repo.ListEnvironmentEntries(ctx, repo.ListEnvironmentEntriesParams{
 		ProjectID:     projectID,
@@ -213,7 +239,7 @@ func (e *EnvironmentEntries) ListEnvironmentEntries(ctx context.Context, project
 		return nil, fmt.Errorf("query error: %w", err)
 	}
 
-	return e.decryptEntries(entries, redacted)
+	return e.decryptEntries(ctx, entries, redacted)
 }
 
 // ListEnvironmentEntriesForUpdate is ListEnvironmentEntries with a row lock held
@@ -229,15 +255,15 @@ func (e *EnvironmentEntries) ListEnvironmentEntriesForUpdate(ctx context.Context
 		return nil, fmt.Errorf("query error: %w", err)
 	}
 
-	return e.decryptEntries(entries, false)
+	return e.decryptEntries(ctx, entries, false)
 }
 
-func (e *EnvironmentEntries) decryptEntries(entries []repo.EnvironmentEntry, redacted bool) ([]repo.EnvironmentEntry, error) {
+func (e *EnvironmentEntries) decryptEntries(ctx context.Context, entries []repo.EnvironmentEntry, redacted bool) ([]repo.EnvironmentEntry, error) {
 	decryptedEntries := make([]repo.EnvironmentEntry, len(entries))
 	for i, entry := range entries {
 		value := entry.Value
 		if entry.IsSecret {
-			decrypted, err := e.enc.Decrypt(entry.Value)
+			decrypted, err := e.decrypt(ctx, entry.Value)
 			if err != nil {
 				return nil, fmt.Errorf("decrypt entry %s: %w", entry.Name, err)
 			}
@@ -261,14 +287,19 @@ func (e *EnvironmentEntries) decryptEntries(entries []repo.EnvironmentEntry, red
 	return decryptedEntries, nil
 }
 
-func (e *EnvironmentEntries) CreateEnvironmentEntries(ctx context.Context, params repo.CreateEnvironmentEntriesParams) ([]repo.EnvironmentEntry, error) {
+func (e *EnvironmentEntries) CreateEnvironmentEntries(ctx context.Context, projectID uuid.UUID, params repo.CreateEnvironmentEntriesParams) ([]repo.EnvironmentEntry, error) {
 	storedValues := make([]string, len(params.Values))
 	originalValues := make(map[string]string, len(params.Values))
 
+	sealer, err := e.sealer(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
 	for i, value := range params.Values {
 		storedValue := value
 		if params.IsSecrets[i] {
-			encryptedValue, err := e.enc.Encrypt([]byte(value))
+			encryptedValue, err := sealer.Encrypt([]byte(value))
 			if err != nil {
 				return nil, fmt.Errorf("failed to encrypt value for entry %s: %w", params.Names[i], err)
 			}
@@ -306,7 +337,11 @@ func (e *EnvironmentEntries) CreateEnvironmentEntries(ctx context.Context, param
 
 func (e *EnvironmentEntries) UpdateEnvironmentEntry(ctx context.Context, params repo.UpsertEnvironmentEntryParams) error {
 	if params.IsSecret {
-		encryptedValue, err := e.enc.Encrypt([]byte(params.Value))
+		sealer, err := e.sealer(ctx, params.ProjectID)
+		if err != nil {
+			return err
+		}
+		encryptedValue, err := sealer.Encrypt([]byte(params.Value))
 		if err != nil {
 			return fmt.Errorf("failed to encrypt value: %w", err)
 		}
@@ -330,6 +365,33 @@ func (e *EnvironmentEntries) DeleteEnvironmentEntry(ctx context.Context, params
 	return nil
 }
 
+// sealer returns what secrets written to the project are encrypted with.
+func (e *EnvironmentEntries) sealer(ctx context.Context, projectID uuid.UUID) (envelope.Sealer, error) {
+	if e.orgKeys == nil {
+		return e.enc, nil
+	}
+
+	sealer, err := e.orgKeys.SealerForProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve encryption key: %w", err)
+	}
+	return sealer, nil
+}
+
+func (e *EnvironmentEntries) decrypt(ctx context.Context, ciphertext string) (string, error) {
+	var plaintext string
+	var err error
+	if e.orgKeys == nil {
+		plaintext, err = e.enc.Decrypt(ciphertext)
+	} else {
+		plaintext, err = e.orgKeys.Decrypt(ctx, ciphertext)
+	}
+	if err != nil {
+		return "", fmt.Errorf("decrypt: %w", err)
+	}
+	return plaintext, nil
+}
+
 func redactedEnvironment(val string) string {
 	if val == "" {
 		return "<EMPTY>"
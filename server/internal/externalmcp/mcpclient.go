@@ -41,6 +41,9 @@ type ClientOptions struct {
 	// this, an unreachable server can take minutes to report as such instead
 	// of the ~10s the probe intends.
 	DisableRetries bool
+	// Proxy, when set, tunnels the connection through this egress proxy
+	// instead of the guardian policy's.
+	Proxy *guardian.Proxy
 }
 
 // Client represents an active connection to an external MCP server.
@@ -60,6 +63,7 @@ func NewClient(ctx context.Context, logger *slog.Logger, guardianPolicy *guardia
 			Authorization:  "",
 			Headers:        nil,
 			DisableRetries: false,
+			Proxy:          nil,
 		}
 	}
 
@@ -67,9 +71,9 @@ func NewClient(ctx context.Context, logger *slog.Logger, guardianPolicy *guardia
 
 	var httpClient *guardian.HTTPClient
 	if opts.DisableRetries {
-		httpClient = guardianPolicy.PooledClient()
+		httpClient = guardianPolicy.PooledClient(guardian.WithClientProxy(opts.Proxy))
 	} else {
-		httpClient = guardianPolicy.PooledClient(guardian.WithDefaultRetryConfig())
+		httpClient = guardianPolicy.PooledClient(guardian.WithDefaultRetryConfig(), guardian.WithClientProxy(opts.Proxy))
 	}
 	trasnport := httpClient.Transport
 	authRT := &authRoundTripper{
@@ -2,6 +2,7 @@ package externalmcp
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"strings"
 
@@ -30,8 +31,9 @@ type ProxyToolEntry struct {
 // PlanResolver resolves a tool URN to a ToolCallPlan.
 type PlanResolver func(ctx context.Context, toolURN urn.Tool, projectID uuid.UUID) (*ToolCallPlan, error)
 
-// SystemEnvLoader loads system environment variables for a given tool URN.
-type SystemEnvLoader func(ctx context.Context, toolURN urn.Tool) (*toolconfig.CaseInsensitiveEnv, error)
+// SystemEnvLoader loads system environment variables for a given tool URN,
+// resolving the secret references of the named variables.
+type SystemEnvLoader func(ctx context.Context, toolURN urn.Tool, names []string) (*toolconfig.CaseInsensitiveEnv, error)
 
 // ToolCallPlan contains the execution plan for calling a tool on an external MCP server.
 type ToolCallPlan struct {
@@ -43,6 +45,16 @@ type ToolCallPlan struct {
 	HeaderDefinitions []HeaderDefinition
 }
 
+// EnvVariables returns the environment variables calls to the server read:
+// its header values and the egress proxy settings.
+func (p *ToolCallPlan) EnvVariables() []string {
+	names := []string{toolconfig.EgressProxyURLVar, toolconfig.EgressProxyCABundleVar}
+	for _, def := range p.HeaderDefinitions {
+		names = append(names, def.Name)
+	}
+	return names
+}
+
 // HeaderDefinition maps an environment variable name to an HTTP header name.
 type HeaderDefinition struct {
 	Name       string // Prefixed environment variable name (e.g., "SLACK_X_API_KEY")
@@ -140,7 +152,7 @@ func (e *ProxyToolExecutor) listToolsForEntry(
 		return nil, err
 	}
 
-	systemEnv, err := loadSystemEnv(ctx, entry.URN)
+	systemEnv, err := loadSystemEnv(ctx, entry.URN, plan.EnvVariables())
 	if err != nil {
 		return nil, err
 	}
@@ -153,10 +165,16 @@ func (e *ProxyToolExecutor) listToolsForEntry(
 
 	headers := BuildHeaders(systemEnv, userConfig, plan.HeaderDefinitions, tokenForHeaders)
 
+	egress, err := toolconfig.EgressProxy(e.guardianPolicy, systemEnv, projectID.String())
+	if err != nil {
+		return nil, fmt.Errorf("egress proxy: %w", err)
+	}
+
 	client, err := NewClient(ctx, e.logger, e.guardianPolicy, plan.RemoteURL, plan.TransportType, &ClientOptions{
 		Authorization:  "",
 		Headers:        headers,
 		DisableRetries: false,
+		Proxy:          egress,
 	})
 	if err != nil {
 		return nil, err
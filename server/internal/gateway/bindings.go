@@ -0,0 +1,150 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+// ArgumentBindingSource is where the value of a bound argument comes from.
+type ArgumentBindingSource string
+
+const (
+	// ArgumentBindingSourceConstant binds a fixed value. The binding value is
+	// a JSON literal; a value that is not valid JSON is bound as a string.
+	ArgumentBindingSourceConstant ArgumentBindingSource = "constant"
+	// ArgumentBindingSourceEnvironment binds the value of a system environment
+	// variable. User-supplied configuration is never consulted, since the
+	// caller controls it.
+	ArgumentBindingSourceEnvironment ArgumentBindingSource = "environment"
+	// ArgumentBindingSourcePrincipal binds a claim of the authenticated
+	// caller.
+	ArgumentBindingSourcePrincipal ArgumentBindingSource = "principal"
+)
+
+// ArgumentBinding fills one argument of a tool call server-side. Argument is
+// the dotted path of the argument in the call's input, such as body.tenant_id
+// for HTTP tools or tenant_id for functions.
+type ArgumentBinding struct {
+	Argument string                `json:"argument"`
+	Source   ArgumentBindingSource `json:"source"`
+	Value    string                `json:"value"`
+}
+
+// ArgumentBindings are the arguments bound on a tool call together with the
+// caller they are resolved for.
+type ArgumentBindings struct {
+	Bindings  []ArgumentBinding
+	Principal toolconfig.Principal
+}
+
+// WithArgumentBindings returns a copy of the plan whose bound arguments
+// resolve against principal. A nil bindings keeps the ones the plan resolver
+// loaded; a non-nil one, even if empty, replaces them, as when the tool was
+// called through a toolset with its own tool variations. Plans can be shared
+// between calls, so the per-caller bindings never go on the plan itself.
+func (p *ToolCallPlan) WithArgumentBindings(bindings []ArgumentBinding, principal toolconfig.Principal) *ToolCallPlan {
+	if bindings == nil && p.ArgumentBindings != nil {
+		bindings = p.ArgumentBindings.Bindings
+	}
+
+	bound := *p
+	bound.ArgumentBindings = &ArgumentBindings{
+		Bindings:  bindings,
+		Principal: principal,
+	}
+	return &bound
+}
+
+// BindArguments returns a tool call's arguments with their bound values
+// applied, exactly as the proxy will send them. Checks that judge a call by
+// its arguments run on this form, so a caller cannot get a call past them with
+// a value the binding then replaces. Arguments are returned unchanged when
+// nothing is bound.
+func BindArguments(arguments json.RawMessage, env toolconfig.ToolCallEnv, bindings *ArgumentBindings) (json.RawMessage, error) {
+	if bindings == nil || len(bindings.Bindings) == 0 {
+		return arguments, nil
+	}
+
+	return bindArguments(bytes.NewReader(arguments), env, bindings)
+}
+
+// bindArguments overwrites the bound arguments of a tool call's input with
+// their server-side values, creating intermediate objects as needed. Any value
+// the caller supplied for a bound argument is discarded. A binding that cannot
+// be resolved fails the call rather than letting it through unbound.
+func bindArguments(requestBody io.Reader, env toolconfig.ToolCallEnv, bindings *ArgumentBindings) ([]byte, error) {
+	raw, err := io.ReadAll(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("read tool call arguments: %w", err)
+	}
+
+	arguments := map[string]any{}
+	if len(strings.TrimSpace(string(raw))) > 0 {
+		if err := json.Unmarshal(raw, &arguments); err != nil {
+			return nil, oops.E(oops.CodeBadRequest, err, "tool call arguments must be a JSON object")
+		}
+		if arguments == nil {
+			arguments = map[string]any{}
+		}
+	}
+
+	for _, binding := range bindings.Bindings {
+		value, err := resolveArgumentBinding(binding, env, bindings.Principal)
+		if err != nil {
+			return nil, err
+		}
+
+		setArgument(arguments, strings.Split(binding.Argument, "."), value)
+	}
+
+	bs, err := json.Marshal(arguments)
+	if err != nil {
+		return nil, fmt.Errorf("marshal bound tool call arguments: %w", err)
+	}
+
+	return bs, nil
+}
+
+func resolveArgumentBinding(binding ArgumentBinding, env toolconfig.ToolCallEnv, principal toolconfig.Principal) (any, error) {
+	switch binding.Source {
+	case ArgumentBindingSourceConstant:
+		var value any
+		if err := json.Unmarshal([]byte(binding.Value), &value); err != nil {
+			return binding.Value, nil
+		}
+		return value, nil
+	case ArgumentBindingSourceEnvironment:
+		if env.SystemEnv == nil || !env.SystemEnv.Has(binding.Value) {
+			return nil, oops.E(oops.CodeFailedPrecondition, nil, "environment variable %q bound to argument %q is not set", binding.Value, binding.Argument)
+		}
+		return env.SystemEnv.Get(binding.Value), nil
+	case ArgumentBindingSourcePrincipal:
+		value := principal.Claim(binding.Value)
+		if value == "" {
+			return nil, oops.E(oops.CodeForbidden, nil, "argument %q is bound to the caller's %s, which is not available", binding.Argument, binding.Value)
+		}
+		return value, nil
+	default:
+		return nil, oops.E(oops.CodeInvariantViolation, nil, "unsupported argument binding source %q", binding.Source)
+	}
+}
+
+func setArgument(arguments map[string]any, path []string, value any) {
+	current := arguments
+	for _, segment := range path[:len(path)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			current[segment] = next
+		}
+		current = next
+	}
+
+	current[path[len(path)-1]] = value
+}
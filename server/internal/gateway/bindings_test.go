@@ -0,0 +1,140 @@
+package gateway
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+func TestBindArguments_OverwritesCallerValues(t *testing.T) {
+	t.Parallel()
+
+	env := emptyToolCallEnv()
+	env.SystemEnv.Set("REGION", "eu-west-1")
+	env.UserConfig.Set("REGION", "us-east-1")
+
+	bound, err := bindArguments(bytes.NewReader([]byte(`{"body":{"tenant_id":"other","name":"rex"}}`)), env, &ArgumentBindings{
+		Bindings: []ArgumentBinding{
+			{Argument: "body.tenant_id", Source: ArgumentBindingSourcePrincipal, Value: toolconfig.PrincipalClaimOrganizationID},
+			{Argument: "queryParameters.region", Source: ArgumentBindingSourceEnvironment, Value: "region"},
+			{Argument: "queryParameters.limit", Source: ArgumentBindingSourceConstant, Value: "10"},
+			{Argument: "headerParameters.X-Team", Source: ArgumentBindingSourceConstant, Value: "platform"},
+		},
+		Principal: toolconfig.Principal{Email: "", UserID: "", ExternalUserID: "", OrganizationID: "org_123", OrganizationSlug: ""},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"body": {"tenant_id": "org_123", "name": "rex"},
+		"queryParameters": {"region": "eu-west-1", "limit": 10},
+		"headerParameters": {"X-Team": "platform"}
+	}`, string(bound))
+}
+
+func TestBindArguments_EmptyArguments(t *testing.T) {
+	t.Parallel()
+
+	bound, err := bindArguments(bytes.NewReader(nil), emptyToolCallEnv(), &ArgumentBindings{
+		Bindings:  []ArgumentBinding{{Argument: "tenant_id", Source: ArgumentBindingSourceConstant, Value: "acme"}},
+		Principal: toolconfig.Principal{Email: "", UserID: "", ExternalUserID: "", OrganizationID: "", OrganizationSlug: ""},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"tenant_id":"acme"}`, string(bound))
+}
+
+func TestBindArguments_UnresolvableBindingsFailTheCall(t *testing.T) {
+	t.Parallel()
+
+	_, err := bindArguments(bytes.NewReader([]byte(`{}`)), emptyToolCallEnv(), &ArgumentBindings{
+		Bindings:  []ArgumentBinding{{Argument: "tenant_id", Source: ArgumentBindingSourcePrincipal, Value: toolconfig.PrincipalClaimEmail}},
+		Principal: toolconfig.Principal{Email: "", UserID: "user_1", ExternalUserID: "", OrganizationID: "", OrganizationSlug: ""},
+	})
+	var shareable *oops.ShareableError
+	require.ErrorAs(t, err, &shareable)
+	require.Equal(t, oops.CodeForbidden, shareable.Code, "a missing claim must not let the call through unbound")
+
+	_, err = bindArguments(bytes.NewReader([]byte(`{}`)), emptyToolCallEnv(), &ArgumentBindings{
+		Bindings:  []ArgumentBinding{{Argument: "tenant_id", Source: ArgumentBindingSourceEnvironment, Value: "TENANT_ID"}},
+		Principal: toolconfig.Principal{Email: "", UserID: "", ExternalUserID: "", OrganizationID: "", OrganizationSlug: ""},
+	})
+	require.ErrorAs(t, err, &shareable)
+	require.Equal(t, oops.CodeFailedPrecondition, shareable.Code)
+}
+
+func TestToolCallPlan_WithArgumentBindings(t *testing.T) {
+	t.Parallel()
+
+	principal := toolconfig.Principal{Email: "", UserID: "user_1", ExternalUserID: "", OrganizationID: "", OrganizationSlug: ""}
+	defaults := []ArgumentBinding{{Argument: "tenant_id", Source: ArgumentBindingSourceConstant, Value: "acme"}}
+	plan := NewHTTPToolCallPlan(nil, nil)
+	plan.ArgumentBindings = &ArgumentBindings{
+		Bindings:  defaults,
+		Principal: toolconfig.Principal{Email: "", UserID: "", ExternalUserID: "", OrganizationID: "", OrganizationSlug: ""},
+	}
+
+	kept := plan.WithArgumentBindings(nil, principal)
+	require.Equal(t, defaults, kept.ArgumentBindings.Bindings, "nil keeps the resolver's bindings")
+	require.Equal(t, principal, kept.ArgumentBindings.Principal)
+	require.Empty(t, plan.ArgumentBindings.Principal.UserID, "the shared plan is not modified")
+
+	replaced := plan.WithArgumentBindings([]ArgumentBinding{}, principal)
+	require.Empty(t, replaced.ArgumentBindings.Bindings, "a toolset variation without bindings replaces the defaults")
+}
+
+func TestToolProxy_Do_SendsBoundArguments(t *testing.T) {
+	t.Parallel()
+
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	plan := newFailoverPlan(http.MethodPost, server.URL)
+	plan.ArgumentBindings = &ArgumentBindings{
+		Bindings:  []ArgumentBinding{{Argument: "body.tenant_id", Source: ArgumentBindingSourceConstant, Value: "acme"}},
+		Principal: toolconfig.Principal{Email: "", UserID: "", ExternalUserID: "", OrganizationID: "", OrganizationSlug: ""},
+	}
+
+	proxy := newFailoverTestProxy(t)
+	rw := httptest.NewRecorder()
+	err := proxy.Do(t.Context(), rw, bytes.NewReader([]byte(`{"body":{"tenant_id":"evil","name":"rex"}}`)), emptyToolCallEnv(), plan, tm.HTTPLogAttributes{})
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, rw.Code)
+	require.JSONEq(t, `{"tenant_id":"acme","name":"rex"}`, string(received))
+}
+
+func TestToolCallPlan_EnvVariablesIncludeBoundVariables(t *testing.T) {
+	t.Parallel()
+
+	plan := NewHTTPToolCallPlan(&ToolDescriptor{}, &HTTPToolCallPlan{
+		ServerEnvVar: "PETS_SERVER_URL",
+		Security:     []*HTTPToolSecurity{{EnvVariables: []string{"PETS_API_KEY"}}},
+	}).WithArgumentBindings([]ArgumentBinding{
+		{Argument: "queryParameters.region", Source: ArgumentBindingSourceEnvironment, Value: "REGION"},
+		{Argument: "queryParameters.limit", Source: ArgumentBindingSourceConstant, Value: "10"},
+	}, toolconfig.Principal{})
+
+	names, ok := plan.EnvVariables()
+	require.True(t, ok)
+	require.ElementsMatch(t, []string{
+		toolconfig.EgressProxyURLVar,
+		toolconfig.EgressProxyCABundleVar,
+		"PETS_SERVER_URL",
+		"PETS_API_KEY",
+		"REGION",
+	}, names)
+
+	_, ok = NewPlatformToolCallPlan(&ToolDescriptor{}, &PlatformToolCallPlan{}).EnvVariables()
+	require.False(t, ok)
+}
@@ -14,7 +14,8 @@ import (
 )
 
 type metrics struct {
-	toolCallsCounter metric.Int64Counter
+	toolCallsCounter     metric.Int64Counter
+	toolCallCacheCounter metric.Int64Counter
 }
 
 func newMetrics(meter metric.Meter, logger *slog.Logger) *metrics {
@@ -27,8 +28,18 @@ func newMetrics(meter metric.Meter, logger *slog.Logger) *metrics {
 		logger.ErrorContext(context.Background(), "failed to create tool calls counter", attr.SlogError(err))
 	}
 
+	toolCallCacheCounter, err := meter.Int64Counter(
+		"tool.call.cache",
+		metric.WithDescription("Number of tool call response cache lookups by result"),
+		metric.WithUnit("{lookup}"),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "failed to create tool call cache counter", attr.SlogError(err))
+	}
+
 	return &metrics{
-		toolCallsCounter: toolCallsCounter,
+		toolCallsCounter:     toolCallsCounter,
+		toolCallCacheCounter: toolCallCacheCounter,
 	}
 }
 
@@ -73,3 +84,24 @@ func (m *metrics) RecordResourceCall(ctx context.Context, orgID string, resource
 	// for now we will keep it in the general tool call counter, we don't bill differently
 	m.toolCallsCounter.Add(ctx, 1, metric.WithAttributes(kv...))
 }
+
+func (m *metrics) RecordToolCallCache(ctx context.Context, orgID string, toolURN urn.Tool, result string) {
+	if m.toolCallCacheCounter == nil {
+		return
+	}
+
+	kv := []attribute.KeyValue{
+		attr.ToolCallKind(string(toolURN.Kind)),
+		attr.ToolName(toolURN.Name),
+		attr.OrganizationID(orgID),
+		attr.ToolCallCacheResult(result),
+	}
+
+	bag := baggage.FromContext(ctx)
+
+	if org := bag.Member(string(attr.OrganizationSlugKey)).Value(); org != "" {
+		kv = append(kv, attr.OrganizationSlug(org))
+	}
+
+	m.toolCallCacheCounter.Add(ctx, 1, metric.WithAttributes(kv...))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net/http"
 
 	"github.com/speakeasy-api/gram/server/internal/billing"
 	"github.com/speakeasy-api/gram/server/internal/externalmcp"
@@ -20,6 +21,14 @@ type PlatformDirectExecutor interface {
 	Call(ctx context.Context, env toolconfig.ToolCallEnv, payload io.Reader, wr io.Writer) error
 }
 
+// WorkflowStepCaller calls the tool of one step of a server-executed workflow
+// tool and writes the tool's response to w. It is supplied by the tools/call
+// handler, which subjects every step to the same checks as a call the client
+// made itself and only lets steps call tools of the calling toolset.
+type WorkflowStepCaller interface {
+	CallWorkflowStep(ctx context.Context, w http.ResponseWriter, toolURN urn.Tool, arguments map[string]any) error
+}
+
 type FilterType string
 
 const (
@@ -63,10 +72,13 @@ type ResourceDescriptor struct {
 }
 
 // HTTPToolCallPlan describes how to translate a tool call into an HTTP request to be
-// proxied to some downstream server.
+// proxied to some downstream server. ServerCandidates lists every server the
+// tool's source declares, in order, when it declares more than one: the first
+// is DefaultServerUrl and the rest are failed over to when it is unhealthy.
 type HTTPToolCallPlan struct {
 	DefaultServerUrl   NullString                `json:"default_server_url" yaml:"default_server_url"`
 	ServerEnvVar       string                    `json:"server_env_var" yaml:"server_env_var"`
+	ServerCandidates   []string                  `json:"server_candidates" yaml:"server_candidates"`
 	Method             string                    `json:"method" yaml:"method"`
 	Path               string                    `json:"path" yaml:"path"`
 	Schema             []byte                    `json:"schema" yaml:"schema"`
@@ -138,6 +150,14 @@ type PromptToolCallPlan struct {
 	Prompt     string `json:"prompt" yaml:"prompt"`
 	Engine     string `json:"engine" yaml:"engine"`
 	Kind       string `json:"kind" yaml:"kind"`
+	// Workflow is the compiled definition of a higher-order tool configured
+	// for server execution, and nil for every other prompt. It is compiled
+	// when the plan is loaded. In-process only; never serialized.
+	Workflow *Workflow `json:"-" yaml:"-"`
+	// StepCaller runs the steps of Workflow. It is attached per call by the
+	// tools/call handler; a workflow without one cannot run. In-process only;
+	// never serialized.
+	StepCaller WorkflowStepCaller `json:"-" yaml:"-"`
 }
 
 type PlatformToolCallPlan struct {
@@ -196,74 +216,103 @@ type ToolCallPlan struct {
 	Prompt      *PromptToolCallPlan
 	Platform    *PlatformToolCallPlan
 	ExternalMCP *ExternalMCPToolCallPlan
+
+	// ResponseCache opts the call into response caching. It is set per call
+	// by the caller that knows who is calling, never by the constructors.
+	ResponseCache *ResponseCachePolicy
+	// Recorder, when set, receives a masked recording of the call. Like
+	// ResponseCache it is only ever set per call.
+	Recorder ToolCallRecorder
+	// ArgumentBindings, when set, fills arguments server-side before the call
+	// is cached, recorded or dispatched. The plan resolver loads the bindings
+	// of the project's default tool variation with no principal, so a caller
+	// that does not resolve them for itself (see WithArgumentBindings) gets
+	// the constant and environment bindings applied and is refused by
+	// principal bindings rather than skipping them.
+	ArgumentBindings *ArgumentBindings
 }
 
 // NewHTTPToolCallPlan creates a new Tool wrapping an HTTPTool.
 func NewHTTPToolCallPlan(tool *ToolDescriptor, plan *HTTPToolCallPlan) *ToolCallPlan {
 	return &ToolCallPlan{
-		Kind:        ToolKindHTTP,
-		BillingType: billing.ToolCallTypeHTTP,
-		Descriptor:  tool,
-		HTTP:        plan,
-		Function:    nil,
-		Prompt:      nil,
-		Platform:    nil,
-		ExternalMCP: nil,
+		Kind:             ToolKindHTTP,
+		BillingType:      billing.ToolCallTypeHTTP,
+		Descriptor:       tool,
+		HTTP:             plan,
+		Function:         nil,
+		Prompt:           nil,
+		Platform:         nil,
+		ExternalMCP:      nil,
+		ResponseCache:    nil,
+		Recorder:         nil,
+		ArgumentBindings: nil,
 	}
 }
 
 // NewFunctionToolCallPlan creates a new Tool wrapping a FunctionTool.
 func NewFunctionToolCallPlan(tool *ToolDescriptor, plan *FunctionToolCallPlan) *ToolCallPlan {
 	return &ToolCallPlan{
-		Kind:        ToolKindFunction,
-		BillingType: billing.ToolCallTypeFunction,
-		Descriptor:  tool,
-		HTTP:        nil,
-		Function:    plan,
-		Prompt:      nil,
-		Platform:    nil,
-		ExternalMCP: nil,
+		Kind:             ToolKindFunction,
+		BillingType:      billing.ToolCallTypeFunction,
+		Descriptor:       tool,
+		HTTP:             nil,
+		Function:         plan,
+		Prompt:           nil,
+		Platform:         nil,
+		ExternalMCP:      nil,
+		ResponseCache:    nil,
+		Recorder:         nil,
+		ArgumentBindings: nil,
 	}
 }
 
 // NewPromptToolCallPlan creates a new Tool wrapping a PromptTool.
 func NewPromptToolCallPlan(tool *ToolDescriptor, plan *PromptToolCallPlan) *ToolCallPlan {
 	return &ToolCallPlan{
-		Kind:        ToolKindPrompt,
-		BillingType: billing.ToolCallTypeHigherOrder,
-		Descriptor:  tool,
-		HTTP:        nil,
-		Function:    nil,
-		Prompt:      plan,
-		Platform:    nil,
-		ExternalMCP: nil,
+		Kind:             ToolKindPrompt,
+		BillingType:      billing.ToolCallTypeHigherOrder,
+		Descriptor:       tool,
+		HTTP:             nil,
+		Function:         nil,
+		Prompt:           plan,
+		Platform:         nil,
+		ExternalMCP:      nil,
+		ResponseCache:    nil,
+		Recorder:         nil,
+		ArgumentBindings: nil,
 	}
 }
 
 func NewPlatformToolCallPlan(tool *ToolDescriptor, plan *PlatformToolCallPlan) *ToolCallPlan {
 	return &ToolCallPlan{
-		Kind:        ToolKindPlatform,
-		BillingType: billing.ToolCallTypePlatform,
-		Descriptor:  tool,
-		HTTP:        nil,
-		Function:    nil,
-		Prompt:      nil,
-		Platform:    plan,
-		ExternalMCP: nil,
+		Kind:             ToolKindPlatform,
+		BillingType:      billing.ToolCallTypePlatform,
+		Descriptor:       tool,
+		HTTP:             nil,
+		Function:         nil,
+		Prompt:           nil,
+		Platform:         plan,
+		ExternalMCP:      nil,
+		ResponseCache:    nil,
+		Recorder:         nil,
+		ArgumentBindings: nil,
 	}
 }
 
 // NewExternalMCPToolCallPlan creates a new Tool wrapping an ExternalMCPTool.
 func NewExternalMCPToolCallPlan(tool *ToolDescriptor, plan *ExternalMCPToolCallPlan) *ToolCallPlan {
 	return &ToolCallPlan{
-		Kind:        ToolKindExternalMCP,
-		BillingType: billing.ToolCallTypeExternalMCP,
-		Descriptor:  tool,
-		HTTP:        nil,
-		Function:    nil,
-		Prompt:      nil,
-		Platform:    nil,
-		ExternalMCP: plan,
+		Kind:             ToolKindExternalMCP,
+		BillingType:      billing.ToolCallTypeExternalMCP,
+		Descriptor:       tool,
+		HTTP:             nil,
+		Function:         nil,
+		Prompt:           nil,
+		Platform:         nil,
+		ExternalMCP:      plan,
+		ResponseCache:    nil,
+		Recorder:         nil,
+		ArgumentBindings: nil,
 	}
 }
 
@@ -283,3 +332,44 @@ func NewResourceFunctionCallPlan(resource *ResourceDescriptor, plan *ResourceFun
 		Function:    plan,
 	}
 }
+
+// EnvVariables returns the environment variables the plan reads, so that only
+// those have their secret references resolved. ok is false when the tool
+// reads variables it does not declare, as platform tools do, and every
+// variable has to be treated as read.
+func (p *ToolCallPlan) EnvVariables() (names []string, ok bool) {
+	switch p.Kind {
+	case ToolKindHTTP:
+		names = append(names, toolconfig.EgressProxyURLVar, toolconfig.EgressProxyCABundleVar)
+		if p.HTTP.ServerEnvVar != "" {
+			names = append(names, p.HTTP.ServerEnvVar)
+		}
+		for _, security := range p.HTTP.Security {
+			names = append(names, security.EnvVariables...)
+		}
+	case ToolKindFunction:
+		for name := range p.Function.Variables {
+			names = append(names, name)
+		}
+		if p.Function.AuthInput != nil {
+			names = append(names, p.Function.AuthInput.Variable)
+		}
+	case ToolKindExternalMCP:
+		names = p.ExternalMCP.EnvVariables()
+	case ToolKindPrompt:
+	case ToolKindPlatform:
+		return nil, false
+	default:
+		return nil, false
+	}
+
+	if p.ArgumentBindings != nil {
+		for _, binding := range p.ArgumentBindings.Bindings {
+			if binding.Source == ArgumentBindingSourceEnvironment {
+				names = append(names, binding.Value)
+			}
+		}
+	}
+
+	return names, true
+}
@@ -45,6 +45,7 @@ type ToolCallSource string
 const (
 	ToolCallSourceDirect ToolCallSource = "direct"
 	ToolCallSourceMCP    ToolCallSource = "mcp"
+	ToolCallSourceReplay ToolCallSource = "replay"
 
 	// gramUserEmailEnvVar is the environment variable injected into function
 	// payloads when authInput.gramEmail is enabled and Gram has authenticated
@@ -70,6 +71,7 @@ var proxiedHeaders = []string{
 	"Content-Language",
 	"Content-Length",
 	"Content-Type",
+	"Etag",
 	"Expires",
 	"Last-Modified",
 	"Pragma",
@@ -127,6 +129,8 @@ type ToolProxy struct {
 	policy        *guardian.Policy
 	functions     functions.ToolCaller
 	platformTools PlatformExecutor
+	// upstreams is nil for proxies that never fail over between servers.
+	upstreams *UpstreamHealth
 }
 
 func NewToolProxy(
@@ -137,6 +141,7 @@ func NewToolProxy(
 	enc *encryption.Client,
 	cache cache.Cache,
 	policy *guardian.Policy,
+	upstreams *UpstreamHealth,
 	funcCaller functions.ToolCaller,
 	platformTools PlatformExecutor,
 ) *ToolProxy {
@@ -153,6 +158,7 @@ func NewToolProxy(
 		policy:        policy,
 		functions:     funcCaller,
 		platformTools: platformTools,
+		upstreams:     upstreams,
 	}
 }
 
@@ -194,6 +200,34 @@ func (tp *ToolProxy) Do(
 		attr.SlogToolCallSource(string(tp.source)),
 	)
 
+	if plan.ArgumentBindings != nil && len(plan.ArgumentBindings.Bindings) > 0 {
+		bound, err := bindArguments(requestBody, env, plan.ArgumentBindings)
+		if err != nil {
+			return err
+		}
+		requestBody = bytes.NewReader(bound)
+	}
+
+	switch {
+	case plan.Recorder != nil:
+		return tp.doRecorded(ctx, logger, w, requestBody, env, plan, attrs)
+	case plan.ResponseCache != nil:
+		return tp.doCached(ctx, logger, w, requestBody, env, plan, attrs)
+	default:
+		return tp.dispatch(ctx, logger, w, requestBody, env, plan, attrs)
+	}
+}
+
+// dispatch calls the tool described by the plan with the executor for its kind.
+func (tp *ToolProxy) dispatch(
+	ctx context.Context,
+	logger *slog.Logger,
+	w http.ResponseWriter,
+	requestBody io.Reader,
+	env toolconfig.ToolCallEnv,
+	plan *ToolCallPlan,
+	attrs tm.HTTPLogAttributes,
+) error {
 	switch plan.Kind {
 	case "":
 		return oops.E(oops.CodeInvariantViolation, nil, "tool kind is not set").LogError(ctx, tp.logger)
@@ -206,7 +240,7 @@ func (tp *ToolProxy) Do(
 	case ToolKindPlatform:
 		return tp.doPlatform(ctx, logger.With(attr.SlogComponent("gateway-platform-caller")), w, requestBody, env, plan, attrs)
 	case ToolKindExternalMCP:
-		return tp.doExternalMCP(ctx, logger.With(attr.SlogComponent("gateway-externalmcp-caller")), w, requestBody, env, plan.ExternalMCP)
+		return tp.doExternalMCP(ctx, logger.With(attr.SlogComponent("gateway-externalmcp-caller")), w, requestBody, env, plan.Descriptor, plan.ExternalMCP)
 	default:
 		return fmt.Errorf("tool type not supported: %s", plan.Kind)
 	}
@@ -418,6 +452,10 @@ func (tp *ToolProxy) doFunction(
 	// GRAM_USER_EMAIL is a platform-controlled variable — remove any
 	// user-supplied value and only set it from the authenticated context.
 	delete(payloadEnv, gramUserEmailEnvVar)
+	// Egress proxy settings configure the gateway, and may carry proxy
+	// credentials that function code has no business seeing.
+	delete(payloadEnv, toolconfig.EgressProxyURLVar)
+	delete(payloadEnv, toolconfig.EgressProxyCABundleVar)
 	if plan.AuthInput != nil && plan.AuthInput.GramEmail && env.GramEmail != "" {
 		payloadEnv[gramUserEmailEnvVar] = env.GramEmail
 	}
@@ -472,6 +510,7 @@ func (tp *ToolProxy) doFunction(
 		Expression:                &FilterRequest{Type: "none", Filter: ""},
 		FilterConfig:              DisableResponseFiltering,
 		Policy:                    tp.policy,
+		Proxy:                     nil,
 		ResponseStatusCodeCapture: &responseStatusCode,
 		Attributes:                attrs,
 		VerifyResponse: func(resp *http.Response) error {
@@ -483,6 +522,7 @@ func (tp *ToolProxy) doFunction(
 		RetryConfig:       functionRunnerRetryConfig(),
 		Timeout:           functionRunnerCallTimeout,
 		DisableKeepAlives: true,
+		Failover:          nil,
 		ID:                descriptor.ID,
 		Name:              descriptor.Name,
 		DeploymentID:      descriptor.DeploymentID,
@@ -635,8 +675,12 @@ func (tp *ToolProxy) doHTTP(
 		serverURL = plan.DefaultServerUrl.Value
 	}
 
+	// A server URL set in the environment is deliberately chosen, so only the
+	// servers declared by the source are failed over between.
+	serverCandidates := plan.ServerCandidates
 	if envServerURL := processServerEnvVars(ctx, logger, plan, env); envServerURL != "" {
 		serverURL = envServerURL
+		serverCandidates = nil
 	}
 
 	if serverURL == "" {
@@ -757,10 +801,27 @@ func (tp *ToolProxy) doHTTP(
 
 	req.Header.Set("X-Gram-Proxy", "1")
 
+	if etag := revalidationETag(ctx); etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
 	if req.Header.Get("Accept") == "" {
 		req.Header.Set("Accept", "*/*")
 	}
 
+	egress, err := toolconfig.EgressProxy(tp.policy, env.SystemEnv, descriptor.ProjectID)
+	if err != nil {
+		return oops.E(oops.CodeInvalid, err, "invalid egress proxy configuration: %s", err.Error()).LogError(ctx, logger)
+	}
+
+	var failover *upstreamFailover
+	if tp.upstreams != nil && len(serverCandidates) > 1 {
+		failover = newUpstreamFailover(tp.upstreams, serverCandidates, requestPath)
+		if failover != nil {
+			tp.upstreams.track(serverCandidates)
+		}
+	}
+
 	return reverseProxyRequest(ctx, ReverseProxyOptions{
 		Logger:                    logger,
 		Tracer:                    tp.tracer,
@@ -770,6 +831,7 @@ func (tp *ToolProxy) doHTTP(
 		Expression:                toolCallBody.ResponseFilter,
 		FilterConfig:              plan.ResponseFilter,
 		Policy:                    tp.policy,
+		Proxy:                     egress,
 		ResponseStatusCodeCapture: &responseStatusCode,
 		Attributes:                attrRecorder,
 		VerifyResponse:            func(resp *http.Response) error { return nil },
@@ -783,6 +845,7 @@ func (tp *ToolProxy) doHTTP(
 		OrganizationID:            descriptor.OrganizationID,
 		OrganizationSlug:          descriptor.OrganizationSlug,
 		DisableKeepAlives:         false,
+		Failover:                  failover,
 	})
 }
 
@@ -791,6 +854,10 @@ type promptGetParams struct {
 }
 
 func (tp *ToolProxy) doPrompt(ctx context.Context, logger *slog.Logger, w http.ResponseWriter, requestBody io.Reader, env toolconfig.ToolCallEnv, descriptor *ToolDescriptor, plan *PromptToolCallPlan) error {
+	if plan.Workflow != nil {
+		return tp.doWorkflow(ctx, logger, w, requestBody, plan)
+	}
+
 	var params promptGetParams
 	if err := json.NewDecoder(requestBody).Decode(&params); err != nil {
 		return oops.E(oops.CodeBadRequest, err, "failed to parse get prompt request").LogError(ctx, logger)
@@ -857,6 +924,7 @@ func (tp *ToolProxy) doExternalMCP(
 	w http.ResponseWriter,
 	requestBody io.Reader,
 	env toolconfig.ToolCallEnv,
+	descriptor *ToolDescriptor,
 	plan *ExternalMCPToolCallPlan,
 ) error {
 	arguments, err := io.ReadAll(requestBody)
@@ -875,10 +943,17 @@ func (tp *ToolProxy) doExternalMCP(
 
 	// Build headers from environment variables
 	headers := externalmcp.BuildHeaders(env.SystemEnv, env.UserConfig, plan.HeaderDefinitions, oauthToken)
+
+	egress, err := toolconfig.EgressProxy(tp.policy, env.SystemEnv, descriptor.ProjectID)
+	if err != nil {
+		return oops.E(oops.CodeInvalid, err, "invalid egress proxy configuration: %s", err.Error()).LogError(ctx, logger)
+	}
+
 	opts := &externalmcp.ClientOptions{
 		Authorization:  "",
 		Headers:        headers,
 		DisableRetries: false,
+		Proxy:          egress,
 	}
 
 	// Connect to the external MCP server
@@ -928,14 +1003,17 @@ func (tp *ToolProxy) doExternalMCP(
 }
 
 type ReverseProxyOptions struct {
-	Logger                    *slog.Logger
-	Tracer                    trace.Tracer
-	Writer                    http.ResponseWriter
-	Request                   *http.Request
-	URN                       string
-	Expression                *FilterRequest
-	FilterConfig              *ResponseFilter
-	Policy                    *guardian.Policy
+	Logger       *slog.Logger
+	Tracer       trace.Tracer
+	Writer       http.ResponseWriter
+	Request      *http.Request
+	URN          string
+	Expression   *FilterRequest
+	FilterConfig *ResponseFilter
+	Policy       *guardian.Policy
+	// Proxy, when set, tunnels the request through this egress proxy instead
+	// of the policy's.
+	Proxy                     *guardian.Proxy
 	ResponseStatusCodeCapture *int
 	VerifyResponse            func(*http.Response) error
 	Attributes                tm.HTTPLogAttributes
@@ -956,6 +1034,9 @@ type ReverseProxyOptions struct {
 	// rather than re-drawing a pooled keep-alive to a Fly machine that may have
 	// been idled/suspended out from under us (which surfaces as an instant EOF).
 	DisableKeepAlives bool
+	// Failover, when set, sends the request to the other servers of the tool's
+	// source when its server is unhealthy. Only outbound HTTP tool calls set it.
+	Failover *upstreamFailover
 	// Descriptor fields
 	ID               string
 	Name             string
@@ -984,7 +1065,6 @@ func reverseProxyRequest(ctx context.Context, opts ReverseProxyOptions) error {
 
 	transport := &http.Transport{
 		Proxy:                 http.ProxyFromEnvironment,
-		DialContext:           opts.Policy.Dialer().DialContext,
 		MaxIdleConns:          100,
 		IdleConnTimeout:       90 * time.Second,
 		TLSHandshakeTimeout:   10 * time.Second,
@@ -993,8 +1073,20 @@ func reverseProxyRequest(ctx context.Context, opts ReverseProxyOptions) error {
 		MaxIdleConnsPerHost:   runtime.GOMAXPROCS(0) + 1,
 		DisableKeepAlives:     opts.DisableKeepAlives,
 	}
+	opts.Policy.ConfigureTransport(transport, guardian.WithDialerProxy(opts.Proxy))
 
 	var baseTransport http.RoundTripper = transport
+	if standIn := upstreamStandInFromContext(ctx); standIn != nil {
+		baseTransport = standIn
+	}
+	if capture := toolCallCaptureFromContext(ctx); capture != nil {
+		baseTransport = &recordingRoundTripper{next: baseTransport, capture: capture}
+	}
+	// Replayed calls are answered by the stand-in whichever server they are
+	// sent to, so there is nothing to fail over between.
+	if opts.Failover != nil && upstreamStandInFromContext(ctx) == nil {
+		baseTransport = &failoverRoundTripper{next: baseTransport, logger: opts.Logger, failover: opts.Failover}
+	}
 	baseTransport = tm.NewToolCallLogRoundTripper(
 		baseTransport,
 		opts.Logger,
@@ -53,6 +53,7 @@ func callFunctionToolWithClient(t *testing.T, client toolconfig.MCPClientIdentit
 		testenv.NewEncryptionClient(t),
 		nil,
 		policy,
+		nil,
 		&mockToolCaller{
 			serverURL: mockServer.URL,
 			onCall:    func(invID uuid.UUID) { invocationID = invID },
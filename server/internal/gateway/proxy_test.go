@@ -281,6 +281,7 @@ func TestToolProxy_Do_PathParams(t *testing.T) {
 				enc,
 				nil, // no cache needed for this test
 				policy,
+				nil,
 				funcs,
 				nil,
 			)
@@ -411,6 +412,7 @@ func TestToolProxy_Do_HeaderParams(t *testing.T) {
 				enc,
 				nil, // no cache needed for this test
 				policy,
+				nil,
 				funcs,
 				nil,
 			)
@@ -762,6 +764,7 @@ func TestToolProxy_Do_QueryParams(t *testing.T) {
 				enc,
 				nil, // no cache needed for this test
 				policy,
+				nil,
 				funcs,
 				nil,
 			)
@@ -983,6 +986,7 @@ func TestToolProxy_Do_Body(t *testing.T) {
 				enc,
 				nil, // no cache needed for this test
 				policy,
+				nil,
 				funcs,
 				nil,
 			)
@@ -1324,6 +1328,7 @@ func TestToolProxy_Do_StringifiedJSONBody(t *testing.T) {
 				enc,
 				nil,
 				policy,
+				nil,
 				funcs,
 				nil,
 			)
@@ -1426,6 +1431,7 @@ func TestResourceProxy_ReadResource(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		mockFuncCaller,
 		nil,
 	)
@@ -1561,6 +1567,7 @@ func TestToolProxy_Do_FunctionMetricsTrailers(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		mockFuncCaller,
 		nil,
 	)
@@ -1651,6 +1658,7 @@ func TestToolProxy_Do_PlatformTool_UsesWrappedBodyPayload(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		funcs,
 		platformExecutor,
 	)
@@ -1718,6 +1726,7 @@ func TestToolProxy_Do_PlatformTool_PreservesRawBodyFieldPayload(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		funcs,
 		platformExecutor,
 	)
@@ -1767,6 +1776,7 @@ func TestToolProxy_Do_PlatformTool_PreservesCallerFaultAttribution(t *testing.T)
 		testenv.NewEncryptionClient(t),
 		nil,
 		policy,
+		nil,
 		funcs,
 		platformExecutor,
 	)
@@ -1852,6 +1862,7 @@ func TestToolProxy_Do_HTTPTool_UserConfigVariablesSent(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		funcs,
 		nil,
 	)
@@ -1938,6 +1949,7 @@ func TestToolProxy_Do_HTTPTool_UserConfigNotInPlanNotSent(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		funcs,
 		nil,
 	)
@@ -2035,6 +2047,7 @@ func TestToolProxy_Do_FunctionTool_UserConfigNotInPlanNotSent(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		mockFuncCaller,
 		nil,
 	)
@@ -2147,6 +2160,7 @@ func TestToolProxy_Do_HTTPTool_SystemEnvSentWhenInPlan(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		funcs,
 		nil,
 	)
@@ -2231,6 +2245,7 @@ func TestToolProxy_Do_HTTPTool_SystemEnvKeysConvertedToHTTPHeaders(t *testing.T)
 		enc,
 		nil,
 		policy,
+		nil,
 		funcs,
 		nil,
 	)
@@ -2327,6 +2342,7 @@ func TestToolProxy_Do_FunctionTool_SystemEnvSentWhenInPlan(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		mockFuncCaller,
 		nil,
 	)
@@ -2441,6 +2457,7 @@ func TestToolProxy_Do_HTTPTool_UserConfigPrefersOverSystemEnv(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		funcs,
 		nil,
 	)
@@ -2539,6 +2556,7 @@ func TestToolProxy_Do_FunctionTool_UserConfigPrefersOverSystemEnv(t *testing.T)
 		enc,
 		nil,
 		policy,
+		nil,
 		mockFuncCaller,
 		nil,
 	)
@@ -2657,6 +2675,7 @@ func TestToolProxy_Do_FunctionTool_AuthInputSentWhenInUserConfig(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		mockFuncCaller,
 		nil,
 	)
@@ -2769,6 +2788,7 @@ func TestToolProxy_Do_FunctionTool_AuthInputNotSentWhenNotInUserConfig(t *testin
 		enc,
 		nil,
 		policy,
+		nil,
 		mockFuncCaller,
 		nil,
 	)
@@ -2881,6 +2901,7 @@ func TestToolProxy_Do_FunctionTool_AuthInputPrefersUserConfigOverSystemEnv(t *te
 		enc,
 		nil,
 		policy,
+		nil,
 		mockFuncCaller,
 		nil,
 	)
@@ -2999,6 +3020,7 @@ func TestToolProxy_Do_FunctionTool_AuthInputSentWithRegularVariables(t *testing.
 		enc,
 		nil,
 		policy,
+		nil,
 		mockFuncCaller,
 		nil,
 	)
@@ -3112,6 +3134,7 @@ func TestToolProxy_Do_FunctionTool_AuthInputNilNotSent(t *testing.T) {
 		enc,
 		nil,
 		policy,
+		nil,
 		mockFuncCaller,
 		nil,
 	)
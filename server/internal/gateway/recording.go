@@ -0,0 +1,488 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+const (
+	// RedactedValue replaces every secret in a recording.
+	RedactedValue = "[REDACTED]"
+
+	// maxRecordedBodyBytes bounds how much of each request and response body
+	// a recording keeps. The caller still receives the full body.
+	maxRecordedBodyBytes = 1 << 20
+
+	// minMaskedSecretLength keeps very short environment values, which are
+	// unlikely to be credentials, from masking unrelated text.
+	minMaskedSecretLength = 4
+)
+
+// ToolCallRecorder receives a masked record of every tool call made with a
+// plan that carries it.
+type ToolCallRecorder interface {
+	RecordToolCall(ctx context.Context, recording *ToolCallRecording)
+}
+
+// ToolCallRecording is a captured tool call: the arguments it was called
+// with, every upstream HTTP exchange it made and the response the tool
+// returned. Secrets are masked before it is handed to a recorder.
+type ToolCallRecording struct {
+	ToolURN    string             `json:"tool_urn"`
+	ToolName   string             `json:"tool_name"`
+	Arguments  json.RawMessage    `json:"arguments"`
+	Upstream   []RecordedExchange `json:"upstream"`
+	Result     RecordedResponse   `json:"result"`
+	RecordedAt time.Time          `json:"recorded_at"`
+}
+
+// RecordedExchange is one upstream request and the response it received.
+type RecordedExchange struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header"`
+	Body   string      `json:"body"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+	// Truncated is set when the body was larger than the recording keeps.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+type toolCallCaptureKey struct{}
+
+// toolCallCapture collects the upstream exchanges of a recorded tool call,
+// including those made by the steps of a workflow tool.
+type toolCallCapture struct {
+	mu        sync.Mutex
+	exchanges []RecordedExchange
+}
+
+func (c *toolCallCapture) add(exchange RecordedExchange) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.exchanges = append(c.exchanges, exchange)
+}
+
+func toolCallCaptureFromContext(ctx context.Context) *toolCallCapture {
+	capture, _ := ctx.Value(toolCallCaptureKey{}).(*toolCallCapture)
+	return capture
+}
+
+// doRecorded calls the tool while capturing its upstream traffic and hands a
+// masked recording of the call to the plan's recorder. Recorded calls skip
+// the response cache so that every recording carries the upstream exchanges
+// a replay needs.
+func (tp *ToolProxy) doRecorded(
+	ctx context.Context,
+	logger *slog.Logger,
+	w http.ResponseWriter,
+	requestBody io.Reader,
+	env toolconfig.ToolCallEnv,
+	plan *ToolCallPlan,
+	attrs tm.HTTPLogAttributes,
+) error {
+	body, err := io.ReadAll(requestBody)
+	if err != nil {
+		return oops.E(oops.CodeBadRequest, err, "failed to read request body").LogError(ctx, logger)
+	}
+
+	capture := &toolCallCapture{mu: sync.Mutex{}, exchanges: nil}
+	callCtx := context.WithValue(ctx, toolCallCaptureKey{}, capture)
+
+	rw := &bufferedResponseWriter{
+		statusCode: http.StatusOK,
+		headers:    make(http.Header),
+		body:       &bytes.Buffer{},
+	}
+	if err := tp.dispatch(callCtx, logger, rw, bytes.NewReader(body), env, plan, attrs); err != nil {
+		return err
+	}
+
+	masker := newSecretMasker(env, plan)
+	recording := &ToolCallRecording{
+		ToolURN:   plan.Descriptor.URN.String(),
+		ToolName:  plan.Descriptor.Name,
+		Arguments: masker.maskJSON(body),
+		Upstream:  make([]RecordedExchange, 0, len(capture.exchanges)),
+		Result: masker.maskResponse(RecordedResponse{
+			StatusCode: rw.statusCode,
+			Header:     rw.headers.Clone(),
+			Body:       string(truncateRecordedBody(rw.body.Bytes())),
+			Truncated:  rw.body.Len() > maxRecordedBodyBytes,
+		}),
+		RecordedAt: time.Now().UTC(),
+	}
+	for _, exchange := range capture.exchanges {
+		recording.Upstream = append(recording.Upstream, RecordedExchange{
+			Request:  masker.maskRequest(exchange.Request),
+			Response: masker.maskResponse(exchange.Response),
+		})
+	}
+	plan.Recorder.RecordToolCall(ctx, recording)
+
+	return rw.writeTo(w)
+}
+
+// recordingRoundTripper copies every upstream exchange into a capture while
+// passing requests and responses through unchanged.
+type recordingRoundTripper struct {
+	next    http.RoundTripper
+	capture *toolCallCapture
+}
+
+func (rt *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := peekRequestBody(req)
+	if err != nil {
+		return nil, fmt.Errorf("read request body for recording: %w", err)
+	}
+
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		return nil, fmt.Errorf("recorded roundtrip: %w", err)
+	}
+
+	recorded := RecordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       "",
+		Truncated:  false,
+	}
+	// Event streams are passed through as they arrive and only their
+	// headers are recorded.
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		head, err := io.ReadAll(io.LimitReader(resp.Body, maxRecordedBodyBytes+1))
+		if err != nil {
+			return nil, fmt.Errorf("read response body for recording: %w", err)
+		}
+		recorded.Body = string(truncateRecordedBody(head))
+		recorded.Truncated = len(head) > maxRecordedBodyBytes
+		resp.Body = &replayedBody{Reader: io.MultiReader(bytes.NewReader(head), resp.Body), Closer: resp.Body}
+	}
+
+	rt.capture.add(RecordedExchange{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: req.Header.Clone(),
+			Body:   string(truncateRecordedBody(reqBody)),
+		},
+		Response: recorded,
+	})
+
+	return resp, nil
+}
+
+type replayedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// peekRequestBody returns the request body without consuming it.
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("get request body: %w", err)
+		}
+		defer func() { _ = body.Close() }()
+
+		bs, err := io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("read request body: %w", err)
+		}
+		return bs, nil
+	}
+
+	bs, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(bs))
+
+	return bs, nil
+}
+
+func truncateRecordedBody(body []byte) []byte {
+	if len(body) > maxRecordedBodyBytes {
+		return body[:maxRecordedBodyBytes]
+	}
+	return body
+}
+
+// sensitiveHeaders are masked wholesale whatever their value.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+}
+
+// secretNameMarkers flag environment variables whose values are treated as
+// secrets even when no security scheme references them.
+var secretNameMarkers = []string{
+	"AUTH",
+	"CREDENTIAL",
+	"KEY",
+	"PASSWORD",
+	"PASSWD",
+	"PRIVATE",
+	"SECRET",
+	"SESSION",
+	"TOKEN",
+}
+
+// secretMasker replaces the secrets a tool call was made with wherever they
+// appear in its recording.
+type secretMasker struct {
+	secrets []string
+}
+
+func newSecretMasker(env toolconfig.ToolCallEnv, plan *ToolCallPlan) *secretMasker {
+	merged := env.Merged()
+
+	candidates := []string{env.OAuthToken}
+	for name, value := range merged.All() {
+		upper := strings.ToUpper(name)
+		for _, marker := range secretNameMarkers {
+			if strings.Contains(upper, marker) {
+				candidates = append(candidates, value)
+				break
+			}
+		}
+	}
+	if plan.HTTP != nil {
+		for _, security := range plan.HTTP.Security {
+			for _, name := range security.EnvVariables {
+				candidates = append(candidates, merged.Get(name))
+			}
+		}
+	}
+
+	secrets := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		if len(candidate) >= minMaskedSecretLength && !slices.Contains(secrets, candidate) {
+			secrets = append(secrets, candidate)
+		}
+	}
+	// Longer secrets go first so a secret that contains another is masked
+	// whole.
+	slices.SortFunc(secrets, func(a, b string) int { return len(b) - len(a) })
+
+	return &secretMasker{secrets: secrets}
+}
+
+func (m *secretMasker) mask(s string) string {
+	for _, secret := range m.secrets {
+		s = strings.ReplaceAll(s, secret, RedactedValue)
+	}
+	return s
+}
+
+func (m *secretMasker) maskJSON(bs []byte) json.RawMessage {
+	masked := m.mask(string(bs))
+	if json.Valid([]byte(masked)) {
+		return json.RawMessage(masked)
+	}
+
+	quoted, err := json.Marshal(masked)
+	if err != nil {
+		return json.RawMessage("null")
+	}
+	return quoted
+}
+
+func (m *secretMasker) maskHeader(header http.Header) http.Header {
+	masked := make(http.Header, len(header))
+	for key, values := range header {
+		for _, value := range values {
+			if slices.Contains(sensitiveHeaders, http.CanonicalHeaderKey(key)) {
+				value = RedactedValue
+			}
+			masked.Add(key, m.mask(value))
+		}
+	}
+	return masked
+}
+
+func (m *secretMasker) maskRequest(req RecordedRequest) RecordedRequest {
+	return RecordedRequest{
+		Method: req.Method,
+		URL:    m.mask(req.URL),
+		Header: m.maskHeader(req.Header),
+		Body:   m.mask(req.Body),
+	}
+}
+
+func (m *secretMasker) maskResponse(resp RecordedResponse) RecordedResponse {
+	return RecordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     m.maskHeader(resp.Header),
+		Body:       m.mask(resp.Body),
+		Truncated:  resp.Truncated,
+	}
+}
+
+type upstreamStandInKey struct{}
+
+// WithUpstreamStandIn makes tool calls made with the returned context send
+// their upstream HTTP requests to the stand-in instead of the network.
+func WithUpstreamStandIn(ctx context.Context, standIn http.RoundTripper) context.Context {
+	return context.WithValue(ctx, upstreamStandInKey{}, standIn)
+}
+
+func upstreamStandInFromContext(ctx context.Context) http.RoundTripper {
+	standIn, _ := ctx.Value(upstreamStandInKey{}).(http.RoundTripper)
+	return standIn
+}
+
+// UpstreamStandIn answers upstream requests with the responses recorded for
+// them, so recorded tool calls can be replayed without network access.
+type UpstreamStandIn struct {
+	mu        sync.Mutex
+	exchanges []RecordedExchange
+	used      []bool
+}
+
+var _ http.RoundTripper = (*UpstreamStandIn)(nil)
+
+func NewUpstreamStandIn(exchanges []RecordedExchange) *UpstreamStandIn {
+	return &UpstreamStandIn{
+		mu:        sync.Mutex{},
+		exchanges: exchanges,
+		used:      make([]bool, len(exchanges)),
+	}
+}
+
+// RoundTrip serves the first unused exchange with the same method, path and
+// query, then the first unused one with the same method and path, and
+// finally reuses a served one. Query parameters whose recorded value was
+// masked are ignored when comparing. A request with no recorded counterpart
+// gets a 502.
+func (s *UpstreamStandIn) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	match := -1
+	for _, exact := range []bool{true, false} {
+		for i, exchange := range s.exchanges {
+			if !s.used[i] && matchesRecordedRequest(exchange.Request, req, exact) {
+				match = i
+				break
+			}
+		}
+		if match >= 0 {
+			break
+		}
+	}
+	if match < 0 {
+		for i, exchange := range s.exchanges {
+			if matchesRecordedRequest(exchange.Request, req, false) {
+				match = i
+			}
+		}
+	}
+
+	if match < 0 {
+		body, _ := json.Marshal(map[string]string{
+			"error": fmt.Sprintf("no recorded upstream response for %s %s", req.Method, req.URL.Path),
+		})
+		return standInResponse(req, http.StatusBadGateway, http.Header{"Content-Type": []string{"application/json"}}, string(body)), nil
+	}
+
+	s.used[match] = true
+	recorded := s.exchanges[match].Response
+
+	return standInResponse(req, recorded.StatusCode, recorded.Header.Clone(), recorded.Body), nil
+}
+
+func matchesRecordedRequest(recorded RecordedRequest, req *http.Request, compareQuery bool) bool {
+	if !strings.EqualFold(recorded.Method, req.Method) {
+		return false
+	}
+
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil || recordedURL.Path != req.URL.Path {
+		return false
+	}
+	if !compareQuery {
+		return true
+	}
+
+	want := recordedURL.Query()
+	got := req.URL.Query()
+	for name, values := range want {
+		if slices.ContainsFunc(values, func(v string) bool { return strings.Contains(v, RedactedValue) }) {
+			delete(want, name)
+			delete(got, name)
+		}
+	}
+	if len(want) != len(got) {
+		return false
+	}
+	for name, values := range want {
+		if !slices.Equal(values, got[name]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func standInResponse(req *http.Request, statusCode int, header http.Header, body string) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+	// The recorded body is already decoded, so length and encoding headers
+	// from the original response no longer describe it.
+	header.Del("Content-Length")
+	header.Del("Content-Encoding")
+	header.Del("Transfer-Encoding")
+
+	return &http.Response{
+		Status:           fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:       statusCode,
+		Proto:            "HTTP/1.1",
+		ProtoMajor:       1,
+		ProtoMinor:       1,
+		Header:           header,
+		Body:             io.NopCloser(strings.NewReader(body)),
+		ContentLength:    int64(len(body)),
+		TransferEncoding: nil,
+		Close:            false,
+		Uncompressed:     false,
+		Trailer:          nil,
+		Request:          req,
+		TLS:              nil,
+	}
+}
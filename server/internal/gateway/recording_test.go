@@ -0,0 +1,158 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+type capturingRecorder struct {
+	recordings []*ToolCallRecording
+}
+
+func (r *capturingRecorder) RecordToolCall(_ context.Context, recording *ToolCallRecording) {
+	r.recordings = append(r.recordings, recording)
+}
+
+func newRecordingTestProxy(t *testing.T) *ToolProxy {
+	t.Helper()
+
+	tracerProvider := testenv.NewTracerProvider(t)
+	policy, err := guardian.NewUnsafePolicy(tracerProvider, []string{})
+	require.NoError(t, err)
+
+	return NewToolProxy(
+		testenv.NewLogger(t),
+		tracerProvider,
+		testenv.NewMeterProvider(t),
+		ToolCallSourceMCP,
+		testenv.NewEncryptionClient(t),
+		nil,
+		policy,
+		nil,
+		funcs,
+		nil,
+	)
+}
+
+func newRecordedHTTPPlan(serverURL string) *HTTPToolCallPlan {
+	return &HTTPToolCallPlan{
+		ServerEnvVar:     "",
+		DefaultServerUrl: NullString{Value: serverURL, Valid: true},
+		Security: []*HTTPToolSecurity{
+			{
+				Type:         NullString{Value: "apiKey", Valid: true},
+				Scheme:       NullString{Value: "apiKey", Valid: true},
+				Name:         NullString{Value: "X-Upstream-Key", Valid: true},
+				Placement:    NullString{Value: "header", Valid: true},
+				EnvVariables: []string{"UPSTREAM_CREDENTIAL"},
+			},
+		},
+		SecurityScopes:     map[string][]string{},
+		Method:             "GET",
+		Path:               "/pets",
+		Schema:             []byte{},
+		HeaderParams:       map[string]*HTTPParameter{},
+		QueryParams:        map[string]*HTTPParameter{},
+		PathParams:         map[string]*HTTPParameter{},
+		RequestContentType: NullString{Value: "application/json", Valid: true},
+		ResponseFilter:     nil,
+	}
+}
+
+func TestToolProxy_Do_RecordsAndReplaysToolCall(t *testing.T) {
+	t.Parallel()
+
+	const secret = "sk-live-0123456789"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"species":"` + r.URL.Query().Get("species") + `","key":"` + r.Header.Get("X-Upstream-Key") + `"}`))
+	}))
+
+	proxy := newRecordingTestProxy(t)
+	recorder := &capturingRecorder{recordings: nil}
+	args := []byte(`{"queryParameters":{"species":"cat"}}`)
+
+	plan := NewHTTPToolCallPlan(newTestToolDescriptor(), newRecordedHTTPPlan(server.URL))
+	plan.Recorder = recorder
+
+	systemEnv := toolconfig.NewCaseInsensitiveEnv()
+	systemEnv.Set("UPSTREAM_CREDENTIAL", secret)
+
+	live := httptest.NewRecorder()
+	err := proxy.Do(t.Context(), live, bytes.NewReader(args), toolconfig.ToolCallEnv{
+		SystemEnv:  systemEnv,
+		UserConfig: toolconfig.NewCaseInsensitiveEnv(),
+	}, plan, tm.HTTPLogAttributes{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, live.Code)
+	require.Contains(t, live.Body.String(), secret, "the caller still sees the unmasked response")
+
+	require.Len(t, recorder.recordings, 1)
+	recording := recorder.recordings[0]
+	require.Equal(t, plan.Descriptor.URN.String(), recording.ToolURN)
+	require.JSONEq(t, string(args), string(recording.Arguments))
+	require.Len(t, recording.Upstream, 1)
+
+	exchange := recording.Upstream[0]
+	require.Equal(t, http.MethodGet, exchange.Request.Method)
+	require.Contains(t, exchange.Request.URL, "/pets?species=cat")
+	require.Equal(t, RedactedValue, exchange.Request.Header.Get("X-Upstream-Key"))
+	require.NotContains(t, exchange.Response.Body, secret)
+	require.Equal(t, http.StatusOK, recording.Result.StatusCode)
+	require.NotContains(t, recording.Result.Body, secret)
+	require.Contains(t, recording.Result.Body, RedactedValue)
+
+	// Replay against a stand-in built from the recording, with the real
+	// upstream gone.
+	server.Close()
+
+	replayPlan := NewHTTPToolCallPlan(newTestToolDescriptor(), newRecordedHTTPPlan(server.URL))
+	ctx := WithUpstreamStandIn(t.Context(), NewUpstreamStandIn(recording.Upstream))
+
+	replayed := httptest.NewRecorder()
+	err = proxy.Do(ctx, replayed, bytes.NewReader(recording.Arguments), toolconfig.ToolCallEnv{
+		SystemEnv:  toolconfig.NewCaseInsensitiveEnv(),
+		UserConfig: toolconfig.NewCaseInsensitiveEnv(),
+	}, replayPlan, tm.HTTPLogAttributes{})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, replayed.Code)
+	require.JSONEq(t, recording.Result.Body, replayed.Body.String())
+}
+
+func TestUpstreamStandIn_UnmatchedRequest(t *testing.T) {
+	t.Parallel()
+
+	standIn := NewUpstreamStandIn([]RecordedExchange{
+		{
+			Request: RecordedRequest{Method: http.MethodGet, URL: "https://api.example.com/pets?limit=1", Header: nil, Body: ""},
+			Response: RecordedResponse{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       `[]`,
+				Truncated:  false,
+			},
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.example.com/pets?limit=2", nil)
+	resp, err := standIn.RoundTrip(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode, "a path match is served when no query matches exactly")
+
+	req = httptest.NewRequest(http.MethodPost, "https://api.example.com/pets", nil)
+	resp, err = standIn.RoundTrip(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+}
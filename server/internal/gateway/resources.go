@@ -161,6 +161,7 @@ func (tp *ToolProxy) doFunctionResource(
 		Expression:                &FilterRequest{Type: "none", Filter: ""},
 		FilterConfig:              DisableResponseFiltering,
 		Policy:                    tp.policy,
+		Proxy:                     nil,
 		ResponseStatusCodeCapture: &responseStatusCode,
 		Attributes:                attrs,
 		VerifyResponse: func(resp *http.Response) error {
@@ -172,6 +173,7 @@ func (tp *ToolProxy) doFunctionResource(
 		RetryConfig:       functionRunnerRetryConfig(),
 		Timeout:           functionRunnerCallTimeout,
 		DisableKeepAlives: true,
+		Failover:          nil,
 		ID:                descriptor.ID,
 		Name:              descriptor.Name,
 		DeploymentID:      descriptor.DeploymentID,
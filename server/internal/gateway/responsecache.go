@@ -0,0 +1,326 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/constants"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+const (
+	toolCacheResultHit         = "hit"
+	toolCacheResultMiss        = "miss"
+	toolCacheResultRevalidated = "revalidated"
+	toolCacheResultBypass      = "bypass"
+)
+
+// ResponseCachePolicy opts a single tool call into response caching.
+type ResponseCachePolicy struct {
+	// TTL is how long a response stays fresh. Upstream Cache-Control max-age
+	// can shorten it but never extend it.
+	TTL time.Duration
+	// Shared allows one cached response to be served to every caller with the
+	// same arguments and environment. When false, entries are scoped to
+	// Identity.
+	Shared bool
+	// Identity is the stable identifier of the caller: a user, an external
+	// OAuth subject or an API key. An unshared policy without an identity is
+	// never cached, so responses cannot leak between callers.
+	Identity string
+}
+
+// cachedToolResponse is a cached tool response once read back from the
+// cache.
+type cachedToolResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	ETag       string
+	FreshUntil time.Time
+}
+
+// storedToolResponse is a tool response as stored in the cache. Upstream
+// headers and bodies can carry anything the upstream returns, so they are
+// encrypted at rest; only the fields that drive freshness stay readable.
+type storedToolResponse struct {
+	StatusCode int       `json:"status_code"`
+	ETag       string    `json:"etag"`
+	FreshUntil time.Time `json:"fresh_until"`
+	// Content is the encrypted JSON of storedToolResponseContent.
+	Content string `json:"content"`
+}
+
+type storedToolResponseContent struct {
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+type revalidationETagKey struct{}
+
+// revalidationETag returns the entity tag of a stale cached response that the
+// HTTP executor should send upstream as If-None-Match.
+func revalidationETag(ctx context.Context) string {
+	etag, _ := ctx.Value(revalidationETagKey{}).(string)
+	return etag
+}
+
+// doCached serves a tool call from the response cache when a fresh entry
+// exists and otherwise calls the tool, storing its response if both the
+// policy and the upstream Cache-Control header allow it. Stale entries from
+// HTTP tools that carry an ETag are revalidated with a conditional request.
+func (tp *ToolProxy) doCached(
+	ctx context.Context,
+	logger *slog.Logger,
+	w http.ResponseWriter,
+	requestBody io.Reader,
+	env toolconfig.ToolCallEnv,
+	plan *ToolCallPlan,
+	attrs tm.HTTPLogAttributes,
+) error {
+	policy := plan.ResponseCache
+	descriptor := plan.Descriptor
+
+	if tp.cache == nil || policy.TTL <= 0 || (!policy.Shared && policy.Identity == "") {
+		tp.metrics.RecordToolCallCache(ctx, descriptor.OrganizationID, descriptor.URN, toolCacheResultBypass)
+		return tp.dispatch(ctx, logger, w, requestBody, env, plan, attrs)
+	}
+
+	body, err := io.ReadAll(requestBody)
+	if err != nil {
+		return oops.E(oops.CodeBadRequest, err, "failed to read request body").LogError(ctx, logger)
+	}
+
+	key, err := responseCacheKey(descriptor.URN.String(), descriptor.DeploymentID, body, env, policy)
+	if err != nil {
+		logger.InfoContext(ctx, "skipping response cache for tool call", attr.SlogError(err))
+		tp.metrics.RecordToolCallCache(ctx, descriptor.OrganizationID, descriptor.URN, toolCacheResultBypass)
+		return tp.dispatch(ctx, logger, w, bytes.NewReader(body), env, plan, attrs)
+	}
+
+	now := time.Now()
+
+	entry, found := tp.loadCachedToolResponse(ctx, logger, key)
+	if found && now.Before(entry.FreshUntil) {
+		tp.metrics.RecordToolCallCache(ctx, descriptor.OrganizationID, descriptor.URN, toolCacheResultHit)
+		return writeCachedToolResponse(w, entry, toolCacheResultHit)
+	}
+
+	callCtx := ctx
+	if found && entry.ETag != "" && plan.Kind == ToolKindHTTP {
+		callCtx = context.WithValue(ctx, revalidationETagKey{}, entry.ETag)
+	}
+
+	rw := &bufferedResponseWriter{
+		statusCode: http.StatusOK,
+		headers:    make(http.Header),
+		body:       &bytes.Buffer{},
+	}
+	if err := tp.dispatch(callCtx, logger, rw, bytes.NewReader(body), env, plan, attrs); err != nil {
+		return err
+	}
+
+	if found && rw.statusCode == http.StatusNotModified {
+		if ttl, ok := cacheableTTL(policy, rw.headers.Get("Cache-Control")); ok {
+			entry.FreshUntil = now.Add(ttl)
+			tp.storeCachedToolResponse(ctx, logger, key, entry, ttl)
+		}
+		tp.metrics.RecordToolCallCache(ctx, descriptor.OrganizationID, descriptor.URN, toolCacheResultRevalidated)
+		return writeCachedToolResponse(w, entry, toolCacheResultRevalidated)
+	}
+
+	tp.metrics.RecordToolCallCache(ctx, descriptor.OrganizationID, descriptor.URN, toolCacheResultMiss)
+
+	if ttl, ok := cacheableTTL(policy, rw.headers.Get("Cache-Control")); ok && isCacheableToolResponse(rw) {
+		tp.storeCachedToolResponse(ctx, logger, key, &cachedToolResponse{
+			StatusCode: rw.statusCode,
+			Header:     rw.headers.Clone(),
+			Body:       rw.body.Bytes(),
+			ETag:       rw.headers.Get("Etag"),
+			FreshUntil: now.Add(ttl),
+		}, ttl)
+	}
+
+	w.Header().Set(constants.HeaderToolCache, toolCacheResultMiss)
+	return rw.writeTo(w)
+}
+
+// loadCachedToolResponse reads and decrypts a cached response. An entry that
+// cannot be read, for example one sealed under a retired key, is a miss.
+func (tp *ToolProxy) loadCachedToolResponse(ctx context.Context, logger *slog.Logger, key string) (*cachedToolResponse, bool) {
+	var stored storedToolResponse
+	if err := tp.cache.Get(ctx, key, &stored); err != nil {
+		return nil, false
+	}
+
+	plaintext, err := tp.encryption.Decrypt(stored.Content)
+	if err != nil {
+		logger.WarnContext(ctx, "decrypt cached tool response", attr.SlogError(err))
+		return nil, false
+	}
+
+	var content storedToolResponseContent
+	if err := json.Unmarshal([]byte(plaintext), &content); err != nil {
+		logger.WarnContext(ctx, "decode cached tool response", attr.SlogError(err))
+		return nil, false
+	}
+
+	return &cachedToolResponse{
+		StatusCode: stored.StatusCode,
+		Header:     content.Header,
+		Body:       content.Body,
+		ETag:       stored.ETag,
+		FreshUntil: stored.FreshUntil,
+	}, true
+}
+
+func (tp *ToolProxy) storeCachedToolResponse(ctx context.Context, logger *slog.Logger, key string, entry *cachedToolResponse, ttl time.Duration) {
+	// Entries that can be revalidated are kept past their freshness so a
+	// later call can send a conditional request instead of a full one.
+	if entry.ETag != "" {
+		ttl *= 2
+	}
+
+	content, err := json.Marshal(storedToolResponseContent{Header: entry.Header, Body: entry.Body})
+	if err != nil {
+		logger.WarnContext(ctx, "encode tool response for cache", attr.SlogError(err))
+		return
+	}
+	sealed, err := tp.encryption.Encrypt(content)
+	if err != nil {
+		logger.WarnContext(ctx, "encrypt tool response for cache", attr.SlogError(err))
+		return
+	}
+
+	stored := storedToolResponse{
+		StatusCode: entry.StatusCode,
+		ETag:       entry.ETag,
+		FreshUntil: entry.FreshUntil,
+		Content:    sealed,
+	}
+	if err := tp.cache.Set(ctx, key, &stored, ttl); err != nil {
+		logger.WarnContext(ctx, "failed to store tool response in cache", attr.SlogError(err))
+	}
+}
+
+func writeCachedToolResponse(w http.ResponseWriter, entry *cachedToolResponse, result string) error {
+	for key, values := range entry.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.Header().Set(constants.HeaderToolCache, result)
+	w.WriteHeader(entry.StatusCode)
+
+	if _, err := w.Write(entry.Body); err != nil {
+		return fmt.Errorf("write cached tool response: %w", err)
+	}
+
+	return nil
+}
+
+// isCacheableToolResponse reports whether a response may be stored at all.
+// Only complete, successful responses are cached.
+func isCacheableToolResponse(rw *bufferedResponseWriter) bool {
+	if rw.statusCode != http.StatusOK {
+		return false
+	}
+
+	return !strings.HasPrefix(rw.headers.Get("Content-Type"), "text/event-stream")
+}
+
+// cacheableTTL applies the upstream Cache-Control header to the policy TTL.
+// no-store and no-cache prevent caching, private prevents shared caching and
+// max-age caps how long the response stays fresh.
+func cacheableTTL(policy *ResponseCachePolicy, cacheControl string) (time.Duration, bool) {
+	ttl := policy.TTL
+
+	for directive := range strings.SplitSeq(cacheControl, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-store", "no-cache":
+			return 0, false
+		case "private":
+			if policy.Shared {
+				return 0, false
+			}
+		case "max-age", "s-maxage":
+			seconds, err := strconv.Atoi(strings.Trim(value, `"`))
+			if err != nil {
+				continue
+			}
+			if seconds <= 0 {
+				return 0, false
+			}
+			ttl = min(ttl, time.Duration(seconds)*time.Second)
+		}
+	}
+
+	return ttl, ttl > 0
+}
+
+// responseCacheKey derives the cache key of a tool call from the tool, the
+// deployment serving it, its canonicalized arguments, the caller scope and
+// the environment the call runs with. The deployment is part of the key so a
+// redeploy, which can change what the tool calls, never serves responses
+// from the previous one. The environment is part of the key because it
+// carries the upstream credentials: two callers with different credentials
+// must not share entries even when the policy is shared.
+func responseCacheKey(toolURN string, deploymentID string, arguments []byte, env toolconfig.ToolCallEnv, policy *ResponseCachePolicy) (string, error) {
+	var args any
+	if len(bytes.TrimSpace(arguments)) > 0 {
+		// Numbers are kept as their literal text: decoding them as float64
+		// would collapse distinct large integer IDs onto the same key.
+		dec := json.NewDecoder(bytes.NewReader(arguments))
+		dec.UseNumber()
+		if err := dec.Decode(&args); err != nil {
+			return "", fmt.Errorf("arguments are not json: %w", err)
+		}
+	}
+
+	// encoding/json writes map keys in sorted order, which makes this a
+	// canonical form of the arguments.
+	canonicalArgs, err := json.Marshal(args)
+	if err != nil {
+		return "", fmt.Errorf("canonicalize arguments: %w", err)
+	}
+
+	scope := "user:" + policy.Identity
+	if policy.Shared {
+		scope = "shared"
+	}
+
+	envFingerprint, err := json.Marshal(struct {
+		Env        map[string]string `json:"env"`
+		OAuthToken string            `json:"oauth_token"`
+		GramEmail  string            `json:"gram_email"`
+	}{
+		Env:        env.Merged().All(),
+		OAuthToken: env.OAuthToken,
+		GramEmail:  env.GramEmail,
+	})
+	if err != nil {
+		return "", fmt.Errorf("fingerprint environment: %w", err)
+	}
+
+	h := sha256.New()
+	for _, part := range [][]byte{[]byte(toolURN), []byte(deploymentID), canonicalArgs, []byte(scope), envFingerprint} {
+		_, _ = h.Write(part)
+		_, _ = h.Write([]byte{0})
+	}
+
+	return "gateway:toolcache:" + hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,327 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/constants"
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+// memoryCache implements the parts of cache.Cache the response cache uses.
+type memoryCache struct {
+	cache.Cache
+
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{Cache: nil, mu: sync.Mutex{}, entries: map[string][]byte{}}
+}
+
+func (c *memoryCache) Get(_ context.Context, key string, value any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	bs, ok := c.entries[key]
+	if !ok {
+		return errors.New("cache miss")
+	}
+	return json.Unmarshal(bs, value)
+}
+
+func (c *memoryCache) Set(_ context.Context, key string, value any, _ time.Duration) error {
+	bs, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = bs
+	return nil
+}
+
+// expireAll makes every stored response stale without evicting it.
+func (c *memoryCache) expireAll(t *testing.T) {
+	t.Helper()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, bs := range c.entries {
+		var entry storedToolResponse
+		require.NoError(t, json.Unmarshal(bs, &entry))
+		entry.FreshUntil = time.Now().Add(-time.Second)
+		updated, err := json.Marshal(entry)
+		require.NoError(t, err)
+		c.entries[key] = updated
+	}
+}
+
+type cachedToolFixture struct {
+	proxy      *ToolProxy
+	cache      *memoryCache
+	calls      *atomic.Int32
+	descriptor *ToolDescriptor
+	plan       *HTTPToolCallPlan
+}
+
+func newCachedToolFixture(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *cachedToolFixture {
+	t.Helper()
+
+	calls := &atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	tracerProvider := testenv.NewTracerProvider(t)
+	policy, err := guardian.NewUnsafePolicy(tracerProvider, []string{})
+	require.NoError(t, err)
+
+	memCache := newMemoryCache()
+
+	return &cachedToolFixture{
+		proxy: NewToolProxy(
+			testenv.NewLogger(t),
+			tracerProvider,
+			testenv.NewMeterProvider(t),
+			ToolCallSourceMCP,
+			testenv.NewEncryptionClient(t),
+			memCache,
+			policy,
+			nil,
+			funcs,
+			nil,
+		),
+		cache:      memCache,
+		calls:      calls,
+		descriptor: newTestToolDescriptor(),
+		plan: &HTTPToolCallPlan{
+			ServerEnvVar:       "",
+			DefaultServerUrl:   NullString{Value: server.URL, Valid: true},
+			Security:           []*HTTPToolSecurity{},
+			SecurityScopes:     map[string][]string{},
+			Method:             "GET",
+			Path:               "/items",
+			Schema:             []byte{},
+			HeaderParams:       map[string]*HTTPParameter{},
+			QueryParams:        map[string]*HTTPParameter{},
+			PathParams:         map[string]*HTTPParameter{},
+			RequestContentType: NullString{Value: "application/json", Valid: true},
+			ResponseFilter:     nil,
+		},
+	}
+}
+
+func (f *cachedToolFixture) call(t *testing.T, arguments string, policy *ResponseCachePolicy) *httptest.ResponseRecorder {
+	t.Helper()
+
+	plan := NewHTTPToolCallPlan(f.descriptor, f.plan)
+	plan.ResponseCache = policy
+
+	recorder := httptest.NewRecorder()
+	err := f.proxy.Do(t.Context(), recorder, bytes.NewReader([]byte(arguments)), toolconfig.ToolCallEnv{
+		SystemEnv:  toolconfig.NewCaseInsensitiveEnv(),
+		UserConfig: toolconfig.NewCaseInsensitiveEnv(),
+	}, plan, tm.HTTPLogAttributes{})
+	require.NoError(t, err)
+
+	return recorder
+}
+
+func writeItems(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`{"query":"` + r.URL.RawQuery + `"}`))
+}
+
+func TestToolProxy_Do_ResponseCache_ServesRepeatedCallsFromCache(t *testing.T) {
+	t.Parallel()
+
+	f := newCachedToolFixture(t, writeItems)
+	policy := &ResponseCachePolicy{TTL: time.Minute, Shared: false, Identity: "user:alice"}
+
+	first := f.call(t, `{"queryParameters":{"a":"1","b":"2"}}`, policy)
+	require.Equal(t, http.StatusOK, first.Code)
+	require.Equal(t, toolCacheResultMiss, first.Header().Get(constants.HeaderToolCache))
+
+	// Same arguments in a different key order hit the same entry.
+	second := f.call(t, `{"queryParameters":{"b":"2","a":"1"}}`, policy)
+	require.Equal(t, http.StatusOK, second.Code)
+	require.Equal(t, toolCacheResultHit, second.Header().Get(constants.HeaderToolCache))
+	require.Equal(t, first.Body.String(), second.Body.String())
+	require.Equal(t, "application/json", second.Header().Get("Content-Type"))
+
+	f.call(t, `{"queryParameters":{"a":"other"}}`, policy)
+	require.Equal(t, int32(2), f.calls.Load())
+}
+
+func TestToolProxy_Do_ResponseCache_ScopesEntriesToCaller(t *testing.T) {
+	t.Parallel()
+
+	f := newCachedToolFixture(t, writeItems)
+	args := `{"queryParameters":{"a":"1"}}`
+
+	f.call(t, args, &ResponseCachePolicy{TTL: time.Minute, Shared: false, Identity: "user:alice"})
+	bob := f.call(t, args, &ResponseCachePolicy{TTL: time.Minute, Shared: false, Identity: "user:bob"})
+	require.Equal(t, toolCacheResultMiss, bob.Header().Get(constants.HeaderToolCache))
+	require.Equal(t, int32(2), f.calls.Load())
+
+	// Anonymous callers are never cached unless the tool allows sharing.
+	anonymous := &ResponseCachePolicy{TTL: time.Minute, Shared: false, Identity: ""}
+	f.call(t, args, anonymous)
+	f.call(t, args, anonymous)
+	require.Equal(t, int32(4), f.calls.Load())
+
+	shared := &ResponseCachePolicy{TTL: time.Minute, Shared: true, Identity: ""}
+	f.call(t, args, shared)
+	hit := f.call(t, args, &ResponseCachePolicy{TTL: time.Minute, Shared: true, Identity: "user:carol"})
+	require.Equal(t, toolCacheResultHit, hit.Header().Get(constants.HeaderToolCache))
+	require.Equal(t, int32(5), f.calls.Load())
+}
+
+func TestToolProxy_Do_ResponseCache_HonorsCacheControl(t *testing.T) {
+	t.Parallel()
+
+	f := newCachedToolFixture(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", r.URL.Query().Get("cc"))
+		writeItems(w, r)
+	})
+
+	for _, cc := range []string{"no-store", "no-cache", "max-age=0"} {
+		args := `{"queryParameters":{"cc":"` + cc + `"}}`
+		policy := &ResponseCachePolicy{TTL: time.Minute, Shared: false, Identity: "user:alice"}
+		f.call(t, args, policy)
+		again := f.call(t, args, policy)
+		require.Equal(t, toolCacheResultMiss, again.Header().Get(constants.HeaderToolCache), cc)
+	}
+	require.Equal(t, int32(6), f.calls.Load())
+
+	// private responses may be cached per caller but never shared.
+	args := `{"queryParameters":{"cc":"private"}}`
+	shared := &ResponseCachePolicy{TTL: time.Minute, Shared: true, Identity: ""}
+	f.call(t, args, shared)
+	require.Equal(t, toolCacheResultMiss, f.call(t, args, shared).Header().Get(constants.HeaderToolCache))
+
+	perUser := &ResponseCachePolicy{TTL: time.Minute, Shared: false, Identity: "user:alice"}
+	f.call(t, args, perUser)
+	require.Equal(t, toolCacheResultHit, f.call(t, args, perUser).Header().Get(constants.HeaderToolCache))
+}
+
+func TestToolProxy_Do_ResponseCache_RevalidatesStaleEntriesWithETag(t *testing.T) {
+	t.Parallel()
+
+	f := newCachedToolFixture(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		writeItems(w, r)
+	})
+	policy := &ResponseCachePolicy{TTL: time.Minute, Shared: false, Identity: "user:alice"}
+	args := `{"queryParameters":{"a":"1"}}`
+
+	first := f.call(t, args, policy)
+	require.Equal(t, `"v1"`, first.Header().Get("Etag"))
+
+	f.cache.expireAll(t)
+
+	revalidated := f.call(t, args, policy)
+	require.Equal(t, http.StatusOK, revalidated.Code)
+	require.Equal(t, toolCacheResultRevalidated, revalidated.Header().Get(constants.HeaderToolCache))
+	require.Equal(t, first.Body.String(), revalidated.Body.String())
+	require.Equal(t, int32(2), f.calls.Load())
+
+	hit := f.call(t, args, policy)
+	require.Equal(t, toolCacheResultHit, hit.Header().Get(constants.HeaderToolCache))
+	require.Equal(t, int32(2), f.calls.Load())
+}
+
+func TestToolProxy_Do_ResponseCache_KeepsLargeIntegerArgumentsDistinct(t *testing.T) {
+	t.Parallel()
+
+	f := newCachedToolFixture(t, writeItems)
+	policy := &ResponseCachePolicy{TTL: time.Minute, Shared: false, Identity: "user:alice"}
+
+	// Both IDs round to the same float64.
+	f.call(t, `{"queryParameters":{"id":9007199254740993}}`, policy)
+	other := f.call(t, `{"queryParameters":{"id":9007199254740992}}`, policy)
+	require.Equal(t, toolCacheResultMiss, other.Header().Get(constants.HeaderToolCache))
+	require.Equal(t, int32(2), f.calls.Load())
+}
+
+func TestToolProxy_Do_ResponseCache_ScopesEntriesToDeployment(t *testing.T) {
+	t.Parallel()
+
+	f := newCachedToolFixture(t, writeItems)
+	policy := &ResponseCachePolicy{TTL: time.Minute, Shared: true, Identity: ""}
+	args := `{"queryParameters":{"a":"1"}}`
+
+	f.call(t, args, policy)
+	f.descriptor = newTestToolDescriptor()
+	redeployed := f.call(t, args, policy)
+	require.Equal(t, toolCacheResultMiss, redeployed.Header().Get(constants.HeaderToolCache))
+	require.Equal(t, int32(2), f.calls.Load())
+}
+
+func TestToolProxy_Do_ResponseCache_EncryptsStoredResponses(t *testing.T) {
+	t.Parallel()
+
+	f := newCachedToolFixture(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Account", "acct-secret-header")
+		writeItems(w, r)
+	})
+	policy := &ResponseCachePolicy{TTL: time.Minute, Shared: false, Identity: "user:alice"}
+	args := `{"queryParameters":{"token":"secret-value"}}`
+
+	f.call(t, args, policy)
+
+	f.cache.mu.Lock()
+	require.Len(t, f.cache.entries, 1)
+	for _, bs := range f.cache.entries {
+		require.NotContains(t, string(bs), "secret-value")
+		require.NotContains(t, string(bs), "acct-secret-header")
+	}
+	f.cache.mu.Unlock()
+
+	hit := f.call(t, args, policy)
+	require.Equal(t, toolCacheResultHit, hit.Header().Get(constants.HeaderToolCache))
+	require.Equal(t, "acct-secret-header", hit.Header().Get("X-Account"))
+	require.Contains(t, hit.Body.String(), "secret-value")
+}
+
+func TestCacheableTTL(t *testing.T) {
+	t.Parallel()
+
+	perUser := &ResponseCachePolicy{TTL: time.Minute, Shared: false, Identity: "user:alice"}
+
+	ttl, ok := cacheableTTL(perUser, "")
+	require.True(t, ok)
+	require.Equal(t, time.Minute, ttl)
+
+	ttl, ok = cacheableTTL(perUser, "public, max-age=10")
+	require.True(t, ok)
+	require.Equal(t, 10*time.Second, ttl)
+
+	ttl, ok = cacheableTTL(perUser, "max-age=3600")
+	require.True(t, ok)
+	require.Equal(t, time.Minute, ttl)
+}
@@ -0,0 +1,382 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+)
+
+// upstreamNamespace is the guardian partition namespace for the servers HTTP
+// tools are proxied to. Breaker state is kept per host within it, so every
+// tool and every source sharing a server shares its health.
+const upstreamNamespace = "http-tool-upstream"
+
+const (
+	// upstreamProbeInterval is how often tracked servers are actively probed.
+	upstreamProbeInterval = 15 * time.Second
+	// upstreamProbeTimeout bounds a single health probe.
+	upstreamProbeTimeout = 5 * time.Second
+	// upstreamProbeIdleAfter is how long a server keeps being probed after the
+	// last tool call that could have been routed to it.
+	upstreamProbeIdleAfter = 10 * time.Minute
+)
+
+// upstreamBreakerPolicy ejects a server once half of the requests and probes
+// sent to it within a minute fail, and lets a single trial through after 30
+// seconds to find out whether it has recovered.
+var upstreamBreakerPolicy = guardian.BreakerPolicy{
+	FailureRateThreshold: 0.5,
+	MinThroughput:        10,
+	Window:               time.Minute,
+	Delay:                30 * time.Second,
+	SuccessThreshold:     1,
+	IncludeSubset:        false,
+}
+
+// UpstreamHealth tracks the health of the servers HTTP tools fail over
+// between. Detection is both passive and active: the outcome of every proxied
+// request is reported to the guardian circuit breaker of its host, and servers
+// that took part in a failover-capable call recently are probed in the
+// background so that an outage is noticed, and a recovery confirmed, without
+// waiting for tool calls to fail.
+//
+// Health lives in the breaker rather than here, so it is shared with every
+// other client built from the same [guardian.Policy] and follows whichever
+// breaker the policy is configured with.
+//
+// One instance is shared by every [ToolProxy] in a process and its background
+// prober is bound to the process: Shutdown stops it, and no probe starts
+// afterwards.
+type UpstreamHealth struct {
+	logger *slog.Logger
+	policy *guardian.Policy
+	client *guardian.HTTPClient
+
+	// ctx is cancelled by Shutdown and bounds the prober and its probes.
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu      sync.Mutex
+	servers map[string]time.Time
+	probing bool
+}
+
+func NewUpstreamHealth(logger *slog.Logger, policy *guardian.Policy) *UpstreamHealth {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &UpstreamHealth{
+		logger:  logger.With(attr.SlogComponent("upstream-health")),
+		policy:  policy,
+		client:  policy.PooledClient(),
+		ctx:     ctx,
+		cancel:  cancel,
+		wg:      sync.WaitGroup{},
+		mu:      sync.Mutex{},
+		servers: make(map[string]time.Time),
+		probing: false,
+	}
+}
+
+// Shutdown stops the background prober, cancelling any probe in flight, and
+// waits for it to exit or for ctx to be done.
+func (h *UpstreamHealth) Shutdown(ctx context.Context) error {
+	h.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		h.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait for upstream health prober: %w", ctx.Err())
+	}
+}
+
+// admit asks the breaker of the request's host whether it may be sent.
+func (h *UpstreamHealth) admit(req *http.Request) (guardian.BreakerResult, error) {
+	key := guardian.NewPartition(upstreamNamespace, guardian.PartitionByHost()(req)...)
+
+	result, err := h.policy.Breaker().Allow(req.Context(), key, upstreamBreakerPolicy)
+	if err != nil {
+		return result, fmt.Errorf("upstream circuit breaker check: %w", err)
+	}
+
+	return result, nil
+}
+
+// track marks servers as in use, starting the background prober if it is not
+// already running. The prober stops by itself once no server has been used
+// for upstreamProbeIdleAfter, and for good once Shutdown is called.
+func (h *UpstreamHealth) track(servers []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	for _, server := range servers {
+		h.servers[server] = now
+	}
+
+	if !h.probing && len(h.servers) > 0 && h.ctx.Err() == nil {
+		h.probing = true
+		h.wg.Go(h.probeLoop)
+	}
+}
+
+func (h *UpstreamHealth) probeLoop() {
+	ticker := time.NewTicker(upstreamProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		servers := h.activeServers()
+		if len(servers) == 0 {
+			return
+		}
+
+		for _, server := range servers {
+			if h.ctx.Err() != nil {
+				return
+			}
+			h.probe(server)
+		}
+	}
+}
+
+// activeServers drops servers that have gone idle and returns the rest. When
+// none are left it marks the prober as stopped, under the same lock track
+// checks, so a concurrent track call starts a new one.
+func (h *UpstreamHealth) activeServers() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	cutoff := time.Now().Add(-upstreamProbeIdleAfter)
+	servers := make([]string, 0, len(h.servers))
+	for server, lastUsed := range h.servers {
+		if lastUsed.Before(cutoff) {
+			delete(h.servers, server)
+			continue
+		}
+		servers = append(servers, server)
+	}
+
+	if len(servers) == 0 {
+		h.probing = false
+	}
+
+	return servers
+}
+
+// probe sends a HEAD request to a server and reports the outcome to its
+// breaker. Any response below 500 shows the server is up, including 404 and
+// 405 from servers that do not serve their base URL. Servers whose circuit is
+// open are left alone until it turns half-open, when the probe becomes the
+// trial that closes it again.
+func (h *UpstreamHealth) probe(server string) {
+	ctx, cancel := context.WithTimeout(h.ctx, upstreamProbeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, server, nil)
+	if err != nil {
+		h.logger.WarnContext(ctx, "failed to build upstream health probe", attr.SlogURL(server), attr.SlogError(err))
+		return
+	}
+
+	result, err := h.admit(req)
+	if err != nil {
+		h.logger.WarnContext(ctx, "failed to admit upstream health probe", attr.SlogURL(server), attr.SlogError(err))
+		return
+	}
+	if !result.Allowed {
+		return
+	}
+
+	resp, err := h.client.Do(req)
+	failure := upstreamFailed(resp, err)
+	result.Report(failure)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+
+	if failure {
+		h.logger.WarnContext(ctx, "upstream health probe failed", attr.SlogURL(server))
+	}
+}
+
+// upstreamFailed reports whether an outcome counts against a server's health:
+// it could not be reached or it answered with a server error. Cancellation by
+// the caller says nothing about the server.
+func upstreamFailed(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled)
+	}
+
+	return resp.StatusCode >= http.StatusInternalServerError
+}
+
+// upstreamFailover routes an HTTP tool call to the first healthy server of its
+// source. Targets holds the request URL for each candidate server, in order.
+type upstreamFailover struct {
+	health  *UpstreamHealth
+	targets []*url.URL
+}
+
+// failoverRoundTripper sends a request to each failover target in turn until
+// one succeeds. Targets whose circuit is open are skipped. A target that
+// cannot be connected to is always failed over from; one that errors later or
+// answers with a 5xx is only failed over from for idempotent methods, since
+// the request may already have had an effect. When every target fails, the
+// last server error response is returned so the caller sees what the upstream
+// said.
+type failoverRoundTripper struct {
+	next     http.RoundTripper
+	logger   *slog.Logger
+	failover *upstreamFailover
+}
+
+var _ http.RoundTripper = (*failoverRoundTripper)(nil)
+
+func (t *failoverRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var fallback *http.Response
+	var lastErr error
+	for i, target := range t.failover.targets {
+		if ctx.Err() != nil {
+			break
+		}
+
+		attempt := req.Clone(ctx)
+		attemptURL := *target
+		attemptURL.RawQuery = req.URL.RawQuery
+		attempt.URL = &attemptURL
+		attempt.Host = attemptURL.Host
+
+		if i > 0 && req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				break
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				break
+			}
+			attempt.Body = body
+		}
+
+		result, err := t.failover.health.admit(attempt)
+		if err != nil {
+			return nil, err
+		}
+		if !result.Allowed {
+			lastErr = &guardian.ResilienceError{Reason: guardian.ErrCircuitOpen, RetryAfter: result.RetryAfter}
+			continue
+		}
+
+		resp, err := t.next.RoundTrip(attempt)
+		failed := upstreamFailed(resp, err)
+		result.Report(failed)
+
+		isLast := i == len(t.failover.targets)-1
+		if !failed || isLast || !canFailOver(attempt.Method, err) {
+			if fallback != nil {
+				drainAndClose(fallback)
+			}
+			return resp, err
+		}
+
+		t.logger.WarnContext(ctx, "upstream failed, failing over to next server",
+			attr.SlogURL(attemptURL.Scheme+"://"+attemptURL.Host),
+			attr.SlogHTTPResponseStatusCode(statusCodeOf(resp)),
+		)
+
+		if resp != nil {
+			if fallback != nil {
+				drainAndClose(fallback)
+			}
+			fallback = resp
+		}
+		lastErr = err
+	}
+
+	if fallback != nil {
+		return fallback, nil
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no upstream server could be tried: %w", ctx.Err())
+	}
+
+	return nil, lastErr
+}
+
+// canFailOver reports whether a failed request may be resent to another
+// server. Connection failures happen before anything is sent, so they are
+// safe for every method; other failures only for idempotent ones.
+func canFailOver(method string, err error) bool {
+	var opErr *net.OpError
+	if err != nil && errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func statusCodeOf(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
+
+func drainAndClose(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	_ = resp.Body.Close()
+}
+
+// newUpstreamFailover builds the failover targets for a request path across a
+// tool's candidate servers. Candidates that do not form a valid URL are
+// skipped; failover is only worthwhile with at least two targets, so nil is
+// returned otherwise.
+func newUpstreamFailover(health *UpstreamHealth, servers []string, requestPath string) *upstreamFailover {
+	targets := make([]*url.URL, 0, len(servers))
+	for _, server := range servers {
+		fullURL, err := url.JoinPath(server, requestPath)
+		if err != nil {
+			continue
+		}
+		target, err := url.Parse(fullURL)
+		if err != nil {
+			continue
+		}
+		targets = append(targets, target)
+	}
+
+	if len(targets) < 2 {
+		return nil
+	}
+
+	return &upstreamFailover{health: health, targets: targets}
+}
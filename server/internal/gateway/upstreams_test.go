@@ -0,0 +1,249 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+// countingServer answers every request with status and counts the requests it
+// received.
+func countingServer(t *testing.T, status int, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &hits
+}
+
+func newFailoverTestProxy(t *testing.T, options ...func(*guardian.Policy)) *ToolProxy {
+	t.Helper()
+
+	tracerProvider := testenv.NewTracerProvider(t)
+	policy, err := guardian.NewUnsafePolicy(tracerProvider, []string{}, options...)
+	require.NoError(t, err)
+
+	upstreams := NewUpstreamHealth(testenv.NewLogger(t), policy)
+	t.Cleanup(func() {
+		require.NoError(t, upstreams.Shutdown(context.Background()))
+	})
+
+	return NewToolProxy(
+		testenv.NewLogger(t),
+		tracerProvider,
+		testenv.NewMeterProvider(t),
+		ToolCallSourceMCP,
+		testenv.NewEncryptionClient(t),
+		nil,
+		policy,
+		upstreams,
+		funcs,
+		nil,
+	)
+}
+
+func newFailoverPlan(method string, servers ...string) *ToolCallPlan {
+	return NewHTTPToolCallPlan(newTestToolDescriptor(), &HTTPToolCallPlan{
+		ServerEnvVar:       "PETS_SERVER_URL",
+		DefaultServerUrl:   NullString{Value: servers[0], Valid: true},
+		ServerCandidates:   servers,
+		Security:           []*HTTPToolSecurity{},
+		SecurityScopes:     map[string][]string{},
+		Method:             method,
+		Path:               "/pets",
+		Schema:             []byte{},
+		HeaderParams:       map[string]*HTTPParameter{},
+		QueryParams:        map[string]*HTTPParameter{},
+		PathParams:         map[string]*HTTPParameter{},
+		RequestContentType: NullString{Value: "application/json", Valid: true},
+		ResponseFilter:     nil,
+	})
+}
+
+func emptyToolCallEnv() toolconfig.ToolCallEnv {
+	return toolconfig.ToolCallEnv{
+		SystemEnv:  toolconfig.NewCaseInsensitiveEnv(),
+		UserConfig: toolconfig.NewCaseInsensitiveEnv(),
+	}
+}
+
+func TestToolProxy_Do_FailsOverOnServerError(t *testing.T) {
+	t.Parallel()
+
+	primary, primaryHits := countingServer(t, http.StatusServiceUnavailable, `{"error":"down"}`)
+	secondary, secondaryHits := countingServer(t, http.StatusOK, `{"region":"secondary"}`)
+
+	proxy := newFailoverTestProxy(t)
+	rw := httptest.NewRecorder()
+	err := proxy.Do(t.Context(), rw, bytes.NewReader([]byte(`{}`)), emptyToolCallEnv(), newFailoverPlan(http.MethodGet, primary.URL, secondary.URL), tm.HTTPLogAttributes{})
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, rw.Code)
+	require.JSONEq(t, `{"region":"secondary"}`, rw.Body.String())
+	require.Equal(t, int32(1), primaryHits.Load())
+	require.Equal(t, int32(1), secondaryHits.Load())
+}
+
+func TestToolProxy_Do_FailsOverOnConnectionError(t *testing.T) {
+	t.Parallel()
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachableURL := unreachable.URL
+	unreachable.Close()
+
+	secondary, secondaryHits := countingServer(t, http.StatusCreated, `{"id":1}`)
+
+	proxy := newFailoverTestProxy(t)
+	rw := httptest.NewRecorder()
+	err := proxy.Do(t.Context(), rw, bytes.NewReader([]byte(`{"body":{"name":"rex"}}`)), emptyToolCallEnv(), newFailoverPlan(http.MethodPost, unreachableURL, secondary.URL), tm.HTTPLogAttributes{})
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusCreated, rw.Code, "connection failures are safe to fail over from for any method")
+	require.Equal(t, int32(1), secondaryHits.Load())
+}
+
+func TestToolProxy_Do_DoesNotFailOverNonIdempotentServerError(t *testing.T) {
+	t.Parallel()
+
+	primary, primaryHits := countingServer(t, http.StatusInternalServerError, `{"error":"boom"}`)
+	secondary, secondaryHits := countingServer(t, http.StatusCreated, `{"id":1}`)
+
+	proxy := newFailoverTestProxy(t)
+	rw := httptest.NewRecorder()
+	err := proxy.Do(t.Context(), rw, bytes.NewReader([]byte(`{"body":{"name":"rex"}}`)), emptyToolCallEnv(), newFailoverPlan(http.MethodPost, primary.URL, secondary.URL), tm.HTTPLogAttributes{})
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusInternalServerError, rw.Code)
+	require.Equal(t, int32(1), primaryHits.Load())
+	require.Zero(t, secondaryHits.Load(), "a POST that reached the server may already have had an effect")
+}
+
+func TestToolProxy_Do_ReturnsLastServerErrorWhenAllServersFail(t *testing.T) {
+	t.Parallel()
+
+	primary, _ := countingServer(t, http.StatusBadGateway, `{"error":"primary"}`)
+	secondary, _ := countingServer(t, http.StatusServiceUnavailable, `{"error":"secondary"}`)
+
+	proxy := newFailoverTestProxy(t)
+	rw := httptest.NewRecorder()
+	err := proxy.Do(t.Context(), rw, bytes.NewReader([]byte(`{}`)), emptyToolCallEnv(), newFailoverPlan(http.MethodDelete, primary.URL, secondary.URL), tm.HTTPLogAttributes{})
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusServiceUnavailable, rw.Code)
+	require.JSONEq(t, `{"error":"secondary"}`, rw.Body.String())
+}
+
+func TestToolProxy_Do_EnvironmentServerURLDisablesFailover(t *testing.T) {
+	t.Parallel()
+
+	configured, configuredHits := countingServer(t, http.StatusServiceUnavailable, `{"error":"down"}`)
+	declared, declaredHits := countingServer(t, http.StatusOK, `{}`)
+
+	env := emptyToolCallEnv()
+	env.SystemEnv.Set("PETS_SERVER_URL", configured.URL)
+
+	proxy := newFailoverTestProxy(t)
+	rw := httptest.NewRecorder()
+	err := proxy.Do(t.Context(), rw, bytes.NewReader([]byte(`{}`)), env, newFailoverPlan(http.MethodPost, declared.URL, declared.URL+"/v2"), tm.HTTPLogAttributes{})
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusServiceUnavailable, rw.Code)
+	require.Equal(t, int32(1), configuredHits.Load())
+	require.Zero(t, declaredHits.Load())
+}
+
+// hostDenyingBreaker is a [guardian.Breaker] whose circuit is open for one
+// host and closed for every other.
+type hostDenyingBreaker struct {
+	deniedHost string
+	reports    atomic.Int32
+}
+
+func (b *hostDenyingBreaker) Allow(_ context.Context, key guardian.Partition, _ guardian.BreakerPolicy) (guardian.BreakerResult, error) {
+	if strings.Contains(key.String(), b.deniedHost) {
+		return guardian.BreakerResult{State: guardian.BreakerStateOpen, Allowed: false, RetryAfter: 0, Report: func(bool) {}}, nil
+	}
+
+	return guardian.BreakerResult{
+		State:      guardian.BreakerStateClosed,
+		Allowed:    true,
+		RetryAfter: -1,
+		Report:     func(bool) { b.reports.Add(1) },
+	}, nil
+}
+
+func TestToolProxy_Do_SkipsServersWithOpenCircuit(t *testing.T) {
+	t.Parallel()
+
+	primary, primaryHits := countingServer(t, http.StatusOK, `{"region":"primary"}`)
+	secondary, secondaryHits := countingServer(t, http.StatusOK, `{"region":"secondary"}`)
+
+	primaryURL, err := url.Parse(primary.URL)
+	require.NoError(t, err)
+
+	breaker := &hostDenyingBreaker{deniedHost: primaryURL.Port(), reports: atomic.Int32{}}
+	proxy := newFailoverTestProxy(t, guardian.WithBreaker(breaker))
+
+	rw := httptest.NewRecorder()
+	err = proxy.Do(t.Context(), rw, bytes.NewReader([]byte(`{}`)), emptyToolCallEnv(), newFailoverPlan(http.MethodPost, primary.URL, secondary.URL), tm.HTTPLogAttributes{})
+	require.NoError(t, err)
+
+	require.JSONEq(t, `{"region":"secondary"}`, rw.Body.String())
+	require.Zero(t, primaryHits.Load())
+	require.Equal(t, int32(1), secondaryHits.Load())
+	require.Equal(t, int32(1), breaker.reports.Load(), "the outcome of the admitted request is reported")
+}
+
+func TestUpstreamFailed(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, upstreamFailed(nil, &url.Error{Op: "Get", URL: "https://api.example.com", Err: http.ErrHandlerTimeout}))
+	require.False(t, upstreamFailed(nil, context.Canceled))
+	require.True(t, upstreamFailed(&http.Response{StatusCode: http.StatusBadGateway}, nil))
+	require.False(t, upstreamFailed(&http.Response{StatusCode: http.StatusNotFound}, nil))
+	require.False(t, upstreamFailed(&http.Response{StatusCode: http.StatusTooManyRequests}, nil))
+}
+
+func TestUpstreamHealth_ShutdownStopsProber(t *testing.T) {
+	t.Parallel()
+
+	server, _ := countingServer(t, http.StatusOK, `{}`)
+	policy, err := guardian.NewUnsafePolicy(testenv.NewTracerProvider(t), []string{})
+	require.NoError(t, err)
+
+	health := NewUpstreamHealth(testenv.NewLogger(t), policy)
+	health.track([]string{server.URL})
+
+	// The prober is parked on a 15 second ticker; Shutdown must not wait for it.
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+	require.NoError(t, health.Shutdown(ctx))
+
+	stopped := NewUpstreamHealth(testenv.NewLogger(t), policy)
+	require.NoError(t, stopped.Shutdown(t.Context()))
+	stopped.track([]string{server.URL})
+
+	stopped.mu.Lock()
+	defer stopped.mu.Unlock()
+	require.False(t, stopped.probing, "no prober should start after shutdown")
+}
@@ -0,0 +1,463 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/itchyny/gojq"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/templates"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+// maxWorkflowDepth bounds how deeply server-executed workflows may call other
+// workflows. It is also what stops a workflow that, directly or not, calls
+// itself.
+const maxWorkflowDepth = 4
+
+type workflowDepthKey struct{}
+
+func workflowDepth(ctx context.Context) int {
+	depth, _ := ctx.Value(workflowDepthKey{}).(int)
+	return depth
+}
+
+// Workflow is the definition of a higher-order tool configured for
+// server-side execution, with its jq expressions compiled. It is built once,
+// when the tool's plan is loaded, and shared by every call of the tool.
+type Workflow struct {
+	steps []workflowStepDef
+}
+
+// workflowStepDef is one step of a Workflow.
+type workflowStepDef struct {
+	templates.Step
+	toolURN  urn.Tool
+	when     *gojq.Code
+	bindings []workflowBinding
+}
+
+// workflowBinding sets the argument at path to the result of code.
+type workflowBinding struct {
+	path []string
+	code *gojq.Code
+}
+
+// CompileWorkflow returns the compiled definition of a higher-order tool
+// configured for server-side execution, or nil for any other prompt template.
+func CompileWorkflow(kind string, prompt string) (*Workflow, error) {
+	definition, err := templates.ParseServerWorkflow(kind, prompt)
+	if err != nil || definition == nil {
+		return nil, err
+	}
+
+	steps := make([]workflowStepDef, 0, len(definition.Steps))
+	for _, step := range definition.Steps {
+		toolURN, err := urn.ParseTool(step.ToolUrn)
+		if err != nil {
+			return nil, fmt.Errorf("step %q: parse tool urn: %w", step.ID, err)
+		}
+
+		def := workflowStepDef{
+			Step:     step,
+			toolURN:  toolURN,
+			when:     nil,
+			bindings: make([]workflowBinding, 0, len(step.Bindings)),
+		}
+
+		if step.When != "" {
+			def.when, err = compileWorkflowExpr(step.When)
+			if err != nil {
+				return nil, fmt.Errorf("step %q: when: %w", step.ID, err)
+			}
+		}
+
+		// Bindings apply in path order, so a binding to a nested path always
+		// lands in the object a binding to its parent produced.
+		for _, path := range slices.Sorted(maps.Keys(step.Bindings)) {
+			code, err := compileWorkflowExpr(step.Bindings[path])
+			if err != nil {
+				return nil, fmt.Errorf("step %q: binding for %q: %w", step.ID, path, err)
+			}
+			def.bindings = append(def.bindings, workflowBinding{path: strings.Split(path, "."), code: code})
+		}
+
+		steps = append(steps, def)
+	}
+
+	return &Workflow{steps: steps}, nil
+}
+
+// WorkflowStepRequestBody encodes the arguments of a workflow step as the
+// request body a tool of the given kind reads. Prompt tools, workflows
+// included, read their arguments from a prompt-get style envelope.
+func WorkflowStepRequestBody(kind ToolKind, arguments map[string]any) ([]byte, error) {
+	var payload any = arguments
+	if kind == ToolKindPrompt {
+		payload = promptGetParams{Arguments: arguments}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal workflow step arguments: %w", err)
+	}
+
+	return body, nil
+}
+
+const (
+	workflowStepStatusOK      = "ok"
+	workflowStepStatusError   = "error"
+	workflowStepStatusSkipped = "skipped"
+)
+
+// workflowStep is the record of one executed step. It is both returned in the
+// trace and exposed to later steps' expressions as .steps.<id>.
+type workflowStep struct {
+	ID         string `json:"id"`
+	ToolURN    string `json:"toolUrn"`
+	Status     string `json:"status"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	Output     any    `json:"output,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+type workflowResult struct {
+	// Output is the output of the last step that ran successfully.
+	Output any            `json:"output"`
+	Error  string         `json:"error,omitempty"`
+	Steps  []workflowStep `json:"steps"`
+}
+
+// doWorkflow runs the steps of a server-executed higher-order tool in order,
+// calling each step's tool through the plan's step caller and feeding outputs
+// forward through jq bindings. The response is a JSON workflowResult carrying a trace
+// of every step. A failed step ends the workflow unless it is marked to
+// continue on error, in which case its error is recorded and later steps can
+// branch on it.
+func (tp *ToolProxy) doWorkflow(
+	ctx context.Context,
+	logger *slog.Logger,
+	w http.ResponseWriter,
+	requestBody io.Reader,
+	plan *PromptToolCallPlan,
+) error {
+	if plan.StepCaller == nil {
+		return oops.E(oops.CodeBadRequest, nil, "server-executed workflow tools can only be called through an MCP server").LogError(ctx, logger)
+	}
+
+	depth := workflowDepth(ctx)
+	if depth >= maxWorkflowDepth {
+		return oops.E(oops.CodeBadRequest, nil, "workflow tools cannot be nested more than %d levels deep", maxWorkflowDepth).LogError(ctx, logger)
+	}
+	ctx = context.WithValue(ctx, workflowDepthKey{}, depth+1)
+
+	inputs, err := decodeWorkflowInputs(requestBody)
+	if err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid workflow arguments").LogError(ctx, logger)
+	}
+
+	stepStates := map[string]any{}
+	state := map[string]any{
+		"inputs": inputs,
+		"steps":  stepStates,
+	}
+
+	result := workflowResult{
+		Output: nil,
+		Error:  "",
+		Steps:  make([]workflowStep, 0, len(plan.Workflow.steps)),
+	}
+	failedStatus := 0
+
+	for _, def := range plan.Workflow.steps {
+		step := runWorkflowStep(ctx, plan.StepCaller, def, state)
+		result.Steps = append(result.Steps, step)
+
+		stepState, err := normalizeJQValue(step)
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "failed to record workflow step").LogError(ctx, logger)
+		}
+		stepStates[def.ID] = stepState
+
+		switch step.Status {
+		case workflowStepStatusOK:
+			result.Output = step.Output
+		case workflowStepStatusError:
+			logger.InfoContext(ctx, "workflow step failed",
+				attr.SlogToolURN(step.ToolURN),
+				attr.SlogErrorMessage(step.Error),
+			)
+			if def.OnError == templates.StepOnErrorContinue {
+				continue
+			}
+
+			result.Error = fmt.Sprintf("step %q failed: %s", def.ID, step.Error)
+			failedStatus = http.StatusBadGateway
+			if step.StatusCode >= 400 {
+				failedStatus = step.StatusCode
+			}
+		}
+
+		if failedStatus != 0 {
+			break
+		}
+	}
+
+	statusCode := http.StatusOK
+	if failedStatus != 0 {
+		statusCode = failedStatus
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "failed to write workflow result").LogError(ctx, logger)
+	}
+
+	return nil
+}
+
+// runWorkflowStep calls one step's tool through caller, which subjects it to
+// the same checks as any other call of that tool.
+func runWorkflowStep(ctx context.Context, caller WorkflowStepCaller, def workflowStepDef, state map[string]any) workflowStep {
+	start := time.Now()
+	step := workflowStep{
+		ID:         def.ID,
+		ToolURN:    def.ToolUrn,
+		Status:     workflowStepStatusOK,
+		StatusCode: 0,
+		Output:     nil,
+		Error:      "",
+		DurationMs: 0,
+	}
+	fail := func(err error) workflowStep {
+		step.Status = workflowStepStatusError
+		step.Error = err.Error()
+		step.DurationMs = time.Since(start).Milliseconds()
+		return step
+	}
+
+	if def.when != nil {
+		v, err := evalWorkflowExpr(ctx, def.when, state)
+		if err != nil {
+			return fail(fmt.Errorf("evaluate when: %w", err))
+		}
+		if v == nil || v == false {
+			step.Status = workflowStepStatusSkipped
+			return step
+		}
+	}
+
+	args, err := workflowStepArguments(ctx, def, state)
+	if err != nil {
+		return fail(err)
+	}
+
+	rw := &bufferedResponseWriter{
+		statusCode: http.StatusOK,
+		headers:    make(http.Header),
+		body:       &bytes.Buffer{},
+	}
+	if err := caller.CallWorkflowStep(ctx, rw, def.toolURN, args); err != nil {
+		return fail(fmt.Errorf("call tool: %w", err))
+	}
+
+	step.StatusCode = rw.statusCode
+	step.Output = decodeWorkflowStepOutput(rw.body.Bytes())
+	step.DurationMs = time.Since(start).Milliseconds()
+	if rw.statusCode < 200 || rw.statusCode >= 300 {
+		step.Status = workflowStepStatusError
+		step.Error = fmt.Sprintf("tool responded with status %d", rw.statusCode)
+	}
+
+	return step
+}
+
+// workflowStepArguments assembles the arguments sent to a step's tool. The
+// workflow inputs the step lists come first, then its literal arguments, then
+// its bindings, so a binding always wins over a literal at the same path.
+func workflowStepArguments(ctx context.Context, def workflowStepDef, state map[string]any) (map[string]any, error) {
+	args := map[string]any{}
+
+	if inputs, ok := state["inputs"].(map[string]any); ok {
+		for _, name := range def.Inputs {
+			if v, ok := inputs[name]; ok {
+				args[name] = v
+			}
+		}
+	}
+
+	for k, v := range def.Arguments {
+		args[k] = v
+	}
+
+	for _, binding := range def.bindings {
+		v, err := evalWorkflowExpr(ctx, binding.code, state)
+		if err != nil {
+			return nil, fmt.Errorf("evaluate binding for %q: %w", strings.Join(binding.path, "."), err)
+		}
+		setArgumentPath(args, binding.path, v)
+	}
+
+	return args, nil
+}
+
+func setArgumentPath(args map[string]any, path []string, v any) {
+	for _, key := range path[:len(path)-1] {
+		next, ok := args[key].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			args[key] = next
+		}
+		args = next
+	}
+	args[path[len(path)-1]] = v
+}
+
+func compileWorkflowExpr(expr string) (*gojq.Code, error) {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("parse expression: %w", err)
+	}
+
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("compile expression: %w", err)
+	}
+
+	return code, nil
+}
+
+// evalWorkflowExpr runs a compiled jq expression against the workflow state
+// and returns its first result, or nil when it produces none.
+func evalWorkflowExpr(ctx context.Context, code *gojq.Code, state map[string]any) (any, error) {
+	iter := code.RunWithContext(ctx, state)
+	v, ok := iter.Next()
+	if !ok {
+		return nil, nil
+	}
+	if err, ok := v.(error); ok {
+		var haltErr *gojq.HaltError
+		if errors.As(err, &haltErr) && haltErr.Value() == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("run expression: %w", err)
+	}
+
+	return v, nil
+}
+
+// decodeWorkflowInputs reads the arguments a workflow was called with. MCP
+// clients send the arguments object as is, while nested workflow steps and
+// prompt-get style callers wrap it in an "arguments" envelope; both are
+// accepted.
+func decodeWorkflowInputs(requestBody io.Reader) (map[string]any, error) {
+	bs, err := io.ReadAll(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("read arguments: %w", err)
+	}
+	if len(bytes.TrimSpace(bs)) == 0 {
+		return map[string]any{}, nil
+	}
+
+	var inputs map[string]any
+	if err := json.Unmarshal(bs, &inputs); err != nil {
+		return nil, fmt.Errorf("unmarshal arguments: %w", err)
+	}
+
+	if wrapped, ok := inputs["arguments"].(map[string]any); ok && len(inputs) == 1 {
+		inputs = wrapped
+	}
+	if inputs == nil {
+		inputs = map[string]any{}
+	}
+
+	return inputs, nil
+}
+
+// normalizeJQValue round-trips a value through JSON so it only holds the
+// types gojq accepts.
+func normalizeJQValue(v any) (map[string]any, error) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal value: %w", err)
+	}
+
+	var out map[string]any
+	if err := json.Unmarshal(bs, &out); err != nil {
+		return nil, fmt.Errorf("unmarshal value: %w", err)
+	}
+
+	return out, nil
+}
+
+// decodeWorkflowStepOutput parses a step's response as JSON when it is JSON
+// and otherwise keeps it as text.
+func decodeWorkflowStepOutput(body []byte) any {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	var out any
+	if err := json.Unmarshal(trimmed, &out); err != nil {
+		return string(body)
+	}
+
+	return out
+}
+
+// bufferedResponseWriter holds a tool's response in memory so it can be
+// inspected before, or instead of, being written to the client.
+type bufferedResponseWriter struct {
+	statusCode int
+	headers    http.Header
+	body       *bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.headers
+}
+
+func (w *bufferedResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+}
+
+func (w *bufferedResponseWriter) Write(p []byte) (int, error) {
+	n, err := w.body.Write(p)
+	if err != nil {
+		return n, fmt.Errorf("write buffered response: %w", err)
+	}
+
+	return n, nil
+}
+
+// writeTo sends the buffered response to w.
+func (w *bufferedResponseWriter) writeTo(dst http.ResponseWriter) error {
+	for key, values := range w.headers {
+		for _, value := range values {
+			dst.Header().Add(key, value)
+		}
+	}
+	dst.WriteHeader(w.statusCode)
+
+	if _, err := dst.Write(w.body.Bytes()); err != nil {
+		return fmt.Errorf("write buffered response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+// fakeWorkflowStepCaller calls step tools straight through the proxy, standing
+// in for the tools/call pipeline the MCP handler supplies.
+type fakeWorkflowStepCaller struct {
+	proxy *ToolProxy
+	plans map[string]*ToolCallPlan
+}
+
+func newFakeWorkflowStepCaller(t *testing.T) *fakeWorkflowStepCaller {
+	t.Helper()
+
+	return &fakeWorkflowStepCaller{
+		proxy: newWorkflowTestProxy(t),
+		plans: map[string]*ToolCallPlan{},
+	}
+}
+
+func (c *fakeWorkflowStepCaller) CallWorkflowStep(ctx context.Context, w http.ResponseWriter, toolURN urn.Tool, arguments map[string]any) error {
+	plan, ok := c.plans[toolURN.String()]
+	if !ok {
+		return fmt.Errorf("tool %s not found", toolURN.String())
+	}
+
+	body, err := WorkflowStepRequestBody(plan.Kind, arguments)
+	if err != nil {
+		return err
+	}
+
+	return c.proxy.Do(ctx, w, bytes.NewReader(body), workflowTestEnv(), plan, tm.HTTPLogAttributes{})
+}
+
+// addPrompt registers a mustache prompt tool that the workflow steps can call.
+func (c *fakeWorkflowStepCaller) addPrompt(name string, prompt string) string {
+	descriptor := newTestToolDescriptor()
+	descriptor.Name = name
+	descriptor.URN = urn.NewTool(urn.ToolKindPrompt, "prompt", name)
+
+	c.plans[descriptor.URN.String()] = NewPromptToolCallPlan(descriptor, &PromptToolCallPlan{
+		TemplateID: uuid.NewString(),
+		Prompt:     prompt,
+		Engine:     "mustache",
+		Kind:       "prompt",
+		Workflow:   nil,
+		StepCaller: nil,
+	})
+
+	return descriptor.URN.String()
+}
+
+// addWorkflow registers a server-executed workflow tool and returns its plan.
+func (c *fakeWorkflowStepCaller) addWorkflow(t *testing.T, name string, steps []map[string]any) *ToolCallPlan {
+	t.Helper()
+
+	plan := newWorkflowPlan(t, name, steps)
+	plan.Prompt.StepCaller = c
+	c.plans[plan.Descriptor.URN.String()] = plan
+
+	return plan
+}
+
+func newWorkflowPlan(t *testing.T, name string, steps []map[string]any) *ToolCallPlan {
+	t.Helper()
+
+	definition, err := json.Marshal(map[string]any{
+		"toolName":  name,
+		"purpose":   "test workflow",
+		"inputs":    []any{},
+		"steps":     steps,
+		"execution": "server",
+	})
+	require.NoError(t, err)
+
+	workflow, err := CompileWorkflow("higher_order_tool", string(definition))
+	require.NoError(t, err)
+	require.NotNil(t, workflow)
+
+	descriptor := newTestToolDescriptor()
+	descriptor.Name = name
+	descriptor.URN = urn.NewTool(urn.ToolKindPrompt, "higher_order_tool", name)
+
+	return NewPromptToolCallPlan(descriptor, &PromptToolCallPlan{
+		TemplateID: uuid.NewString(),
+		Prompt:     string(definition),
+		Engine:     "mustache",
+		Kind:       "higher_order_tool",
+		Workflow:   workflow,
+		StepCaller: nil,
+	})
+}
+
+func newWorkflowTestProxy(t *testing.T) *ToolProxy {
+	t.Helper()
+
+	tracerProvider := testenv.NewTracerProvider(t)
+	policy, err := guardian.NewUnsafePolicy(tracerProvider, []string{})
+	require.NoError(t, err)
+
+	return NewToolProxy(
+		testenv.NewLogger(t),
+		tracerProvider,
+		testenv.NewMeterProvider(t),
+		ToolCallSourceMCP,
+		testenv.NewEncryptionClient(t),
+		nil,
+		policy,
+		nil,
+		funcs,
+		nil,
+	)
+}
+
+func workflowTestEnv() toolconfig.ToolCallEnv {
+	return toolconfig.ToolCallEnv{
+		SystemEnv:  toolconfig.NewCaseInsensitiveEnv(),
+		UserConfig: toolconfig.NewCaseInsensitiveEnv(),
+	}
+}
+
+func runWorkflow(t *testing.T, caller *fakeWorkflowStepCaller, plan *ToolCallPlan, arguments map[string]any) (int, workflowResult) {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]any{"arguments": arguments})
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	err = caller.proxy.Do(t.Context(), recorder, bytes.NewReader(body), workflowTestEnv(), plan, tm.HTTPLogAttributes{})
+	require.NoError(t, err)
+	require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+	var result workflowResult
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &result))
+
+	return recorder.Code, result
+}
+
+func TestToolProxy_Do_Workflow_PassesOutputsBetweenSteps(t *testing.T) {
+	t.Parallel()
+
+	caller := newFakeWorkflowStepCaller(t)
+	greet := caller.addPrompt("greet", "Hello {{name}}")
+	echo := caller.addPrompt("echo", "Echo: {{message}} ({{source}})")
+
+	plan := caller.addWorkflow(t, "greet_and_echo", []map[string]any{
+		{"id": "greet", "toolUrn": greet, "inputs": []string{"name"}},
+		{
+			"id":        "echo",
+			"toolUrn":   echo,
+			"arguments": map[string]any{"source": "literal", "message": "overridden"},
+			"bindings":  map[string]string{"message": ".steps.greet.output"},
+		},
+	})
+
+	status, result := runWorkflow(t, caller, plan, map[string]any{"name": "Ada"})
+	require.Equal(t, http.StatusOK, status)
+	require.Empty(t, result.Error)
+	require.Equal(t, "Echo: Hello Ada (literal)", result.Output)
+	require.Len(t, result.Steps, 2)
+	require.Equal(t, workflowStepStatusOK, result.Steps[0].Status)
+	require.Equal(t, "Hello Ada", result.Steps[0].Output)
+	require.Equal(t, workflowStepStatusOK, result.Steps[1].Status)
+}
+
+func TestToolProxy_Do_Workflow_ConditionsAndContinueOnError(t *testing.T) {
+	t.Parallel()
+
+	caller := newFakeWorkflowStepCaller(t)
+	fallback := caller.addPrompt("fallback", "fallback ran")
+	never := caller.addPrompt("never", "should not run")
+	missing := urn.NewTool(urn.ToolKindPrompt, "prompt", "missing").String()
+
+	plan := caller.addWorkflow(t, "branching", []map[string]any{
+		{"id": "lookup", "toolUrn": missing, "onError": "continue"},
+		{"id": "fallback", "toolUrn": fallback, "when": `.steps.lookup.status == "error"`},
+		{"id": "gated", "toolUrn": never, "when": ".inputs.enabled"},
+	})
+
+	status, result := runWorkflow(t, caller, plan, map[string]any{"enabled": false})
+	require.Equal(t, http.StatusOK, status)
+	require.Empty(t, result.Error)
+	require.Equal(t, "fallback ran", result.Output)
+	require.Len(t, result.Steps, 3)
+	require.Equal(t, workflowStepStatusError, result.Steps[0].Status)
+	require.Contains(t, result.Steps[0].Error, "not found")
+	require.Equal(t, workflowStepStatusOK, result.Steps[1].Status)
+	require.Equal(t, workflowStepStatusSkipped, result.Steps[2].Status)
+}
+
+func TestToolProxy_Do_Workflow_FailedStepStopsWorkflow(t *testing.T) {
+	t.Parallel()
+
+	caller := newFakeWorkflowStepCaller(t)
+	after := caller.addPrompt("after", "should not run")
+	missing := urn.NewTool(urn.ToolKindPrompt, "prompt", "missing").String()
+
+	plan := caller.addWorkflow(t, "failing", []map[string]any{
+		{"id": "lookup", "toolUrn": missing},
+		{"id": "after", "toolUrn": after},
+	})
+
+	status, result := runWorkflow(t, caller, plan, nil)
+	require.Equal(t, http.StatusBadGateway, status)
+	require.Contains(t, result.Error, `step "lookup" failed`)
+	require.Len(t, result.Steps, 1)
+}
+
+func TestToolProxy_Do_Workflow_RejectsUnboundedNesting(t *testing.T) {
+	t.Parallel()
+
+	caller := newFakeWorkflowStepCaller(t)
+	self := urn.NewTool(urn.ToolKindPrompt, "higher_order_tool", "recursive").String()
+
+	plan := caller.addWorkflow(t, "recursive", []map[string]any{
+		{"id": "again", "toolUrn": self},
+	})
+
+	status, result := runWorkflow(t, caller, plan, nil)
+	require.Equal(t, http.StatusBadGateway, status)
+	require.Len(t, result.Steps, 1)
+	require.Equal(t, workflowStepStatusError, result.Steps[0].Status)
+}
+
+func TestCompileWorkflow_RejectsInvalidExpressions(t *testing.T) {
+	t.Parallel()
+
+	definition, err := json.Marshal(map[string]any{
+		"toolName":  "broken",
+		"purpose":   "test workflow",
+		"inputs":    []any{},
+		"steps":     []map[string]any{{"id": "lookup", "toolUrn": urn.NewTool(urn.ToolKindPrompt, "prompt", "lookup").String(), "when": ".inputs |"}},
+		"execution": "server",
+	})
+	require.NoError(t, err)
+
+	_, err = CompileWorkflow("higher_order_tool", string(definition))
+	require.Error(t, err)
+
+	workflow, err := CompileWorkflow("prompt", "Hello {{name}}")
+	require.NoError(t, err)
+	require.Nil(t, workflow)
+}
+
+func TestToolProxy_Do_Workflow_RequiresStepCaller(t *testing.T) {
+	t.Parallel()
+
+	plan := newWorkflowPlan(t, "uncallable", []map[string]any{
+		{"id": "greet", "toolUrn": urn.NewTool(urn.ToolKindPrompt, "prompt", "greet").String()},
+	})
+
+	recorder := httptest.NewRecorder()
+	err := newWorkflowTestProxy(t).Do(t.Context(), recorder, bytes.NewReader([]byte(`{}`)), workflowTestEnv(), plan, tm.HTTPLogAttributes{})
+	require.Error(t, err)
+}
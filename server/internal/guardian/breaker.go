@@ -3,6 +3,8 @@ package guardian
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
 	"time"
 )
 
@@ -75,6 +77,36 @@ type BreakerPolicy struct {
 	IncludeSubset bool
 }
 
+// validateBreakerPolicy rejects policies that would configure a breaker
+// that never trips or never recovers. name prefixes the returned error.
+func validateBreakerPolicy(name string, policy BreakerPolicy) error {
+	switch {
+	// NaN compares false against both bounds, so it must be rejected
+	// explicitly or it would silently configure a breaker that never trips.
+	case math.IsNaN(policy.FailureRateThreshold) || policy.FailureRateThreshold <= 0 || policy.FailureRateThreshold > 1:
+		return fmt.Errorf("%s: failure rate threshold must be in (0, 1], got %v", name, policy.FailureRateThreshold)
+	case policy.MinThroughput == 0:
+		return fmt.Errorf("%s: min throughput must be positive", name)
+	case policy.Window <= 0:
+		return fmt.Errorf("%s: window must be positive, got %s", name, policy.Window)
+	case policy.Delay <= 0:
+		return fmt.Errorf("%s: delay must be positive, got %s", name, policy.Delay)
+	case policy.SuccessThreshold == 0:
+		return fmt.Errorf("%s: success threshold must be positive", name)
+	default:
+		return nil
+	}
+}
+
+// fingerprint identifies the policy fields that shape breaker state, so a
+// partition's state can be reset when the policy seen for it changes.
+// IncludeSubset only affects key derivation upstream, so it is not part of
+// the fingerprint.
+func (p BreakerPolicy) fingerprint() string {
+	return fmt.Sprintf("%g|%d|%s|%s|%d",
+		p.FailureRateThreshold, p.MinThroughput, p.Window, p.Delay, p.SuccessThreshold)
+}
+
 // BreakerResult is the outcome of a circuit breaker admission check.
 type BreakerResult struct {
 	// State is the breaker state observed at admission time.
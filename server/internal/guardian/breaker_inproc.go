@@ -2,9 +2,7 @@ package guardian
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
-	"math"
 	"sync"
 	"sync/atomic"
 
@@ -29,33 +27,41 @@ var _ Breaker = (*InProcBreaker)(nil)
 func NewInProcBreaker(logger *slog.Logger, meterProvider metric.MeterProvider) *InProcBreaker {
 	meter := meterProvider.Meter("github.com/speakeasy-api/gram/server/internal/guardian")
 
-	b := &InProcBreaker{
-		logger:   logger,
-		metrics:  newCircuitBreakerMetrics(logger, meter),
-		breakers: new(sync.Map),
-	}
+	b := newInProcBreaker(logger, newCircuitBreakerMetrics(logger, meter))
 
 	registerInstanceGauge(logger, meter,
 		"gram.circuit_breaker.instances",
 		"Number of circuit breaker instances resident in this process",
 		"{circuit_breaker}",
-		func() map[string]int64 {
-			counts := make(map[string]int64)
-			b.breakers.Range(func(_, val any) bool {
-				if entry, ok := val.(*breakerEntry); ok {
-					counts[entry.namespace]++
-				}
-
-				return true
-			})
-
-			return counts
-		},
+		b.countByNamespace,
 	)
 
 	return b
 }
 
+// newInProcBreaker builds an InProcBreaker that records into existing metrics
+// and registers no instance gauge, for embedding in another [Breaker].
+func newInProcBreaker(logger *slog.Logger, metrics *circuitBreakerMetrics) *InProcBreaker {
+	return &InProcBreaker{
+		logger:   logger,
+		metrics:  metrics,
+		breakers: new(sync.Map),
+	}
+}
+
+func (b *InProcBreaker) countByNamespace() map[string]int64 {
+	counts := make(map[string]int64)
+	b.breakers.Range(func(_, val any) bool {
+		if entry, ok := val.(*breakerEntry); ok {
+			counts[entry.namespace]++
+		}
+
+		return true
+	})
+
+	return counts
+}
+
 type breakerEntry struct {
 	cb          circuitbreaker.CircuitBreaker[any]
 	fingerprint string
@@ -65,19 +71,8 @@ type breakerEntry struct {
 func (b *InProcBreaker) Allow(ctx context.Context, key Partition, policy BreakerPolicy) (BreakerResult, error) {
 	var zero BreakerResult
 
-	switch {
-	// NaN compares false against both bounds, so it must be rejected
-	// explicitly or it would silently configure a breaker that never trips.
-	case math.IsNaN(policy.FailureRateThreshold) || policy.FailureRateThreshold <= 0 || policy.FailureRateThreshold > 1:
-		return zero, fmt.Errorf("in-proc breaker: failure rate threshold must be in (0, 1], got %v", policy.FailureRateThreshold)
-	case policy.MinThroughput == 0:
-		return zero, fmt.Errorf("in-proc breaker: min throughput must be positive")
-	case policy.Window <= 0:
-		return zero, fmt.Errorf("in-proc breaker: window must be positive, got %s", policy.Window)
-	case policy.Delay <= 0:
-		return zero, fmt.Errorf("in-proc breaker: delay must be positive, got %s", policy.Delay)
-	case policy.SuccessThreshold == 0:
-		return zero, fmt.Errorf("in-proc breaker: success threshold must be positive")
+	if err := validateBreakerPolicy("in-proc breaker", policy); err != nil {
+		return zero, err
 	}
 
 	cb := b.breakerFor(ctx, key, policy)
@@ -115,10 +110,7 @@ func (b *InProcBreaker) Allow(ctx context.Context, key Partition, policy Breaker
 }
 
 func (b *InProcBreaker) breakerFor(ctx context.Context, key Partition, policy BreakerPolicy) circuitbreaker.CircuitBreaker[any] {
-	// IncludeSubset only affects key derivation upstream, so it is not part
-	// of the fingerprint.
-	fingerprint := fmt.Sprintf("%g|%d|%s|%s|%d",
-		policy.FailureRateThreshold, policy.MinThroughput, policy.Window, policy.Delay, policy.SuccessThreshold)
+	fingerprint := policy.fingerprint()
 
 	if val, ok := b.breakers.Load(key.String()); ok {
 		if entry, ok := val.(*breakerEntry); ok && entry.fingerprint == fingerprint {
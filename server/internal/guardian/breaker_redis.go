@@ -0,0 +1,408 @@
+package guardian
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+)
+
+const (
+	// redisBreakerBuckets is the number of buckets the failure window is
+	// divided into. The window rolls forward one bucket at a time, so
+	// outcomes expire up to Window/redisBreakerBuckets late.
+	redisBreakerBuckets = 10
+
+	// redisBreakerRetryInterval is how long the breaker serves admission
+	// checks from in-process state after Redis fails before trying it
+	// again, so an outage does not add a failing round trip to every call.
+	redisBreakerRetryInterval = 5 * time.Second
+
+	// redisBreakerReportTimeout bounds the round trip that records an
+	// execution outcome. Report has no context of its own and runs on the
+	// caller's return path.
+	redisBreakerReportTimeout = time.Second
+)
+
+// RedisBreaker is a [Breaker] whose state lives in Redis, so every replica
+// sees the same open, half-open and closed state and failure window for a
+// partition, and a failing upstream is cut off fleet-wide as soon as the
+// fleet's combined failures trip it. Half-open trial executions are
+// coordinated across replicas: no more than SuccessThreshold are in flight
+// at once.
+//
+// When Redis is unavailable the breaker degrades to in-process state, as if
+// it were an [InProcBreaker], and retries Redis after a short interval.
+type RedisBreaker struct {
+	logger   *slog.Logger
+	metrics  *circuitBreakerMetrics
+	client   redis.UniversalClient
+	fallback *InProcBreaker
+
+	// degradedUntil is the unix-nano time before which admission checks skip
+	// Redis and go straight to the fallback.
+	degradedUntil *atomic.Int64
+}
+
+var _ Breaker = (*RedisBreaker)(nil)
+
+func NewRedisBreaker(logger *slog.Logger, meterProvider metric.MeterProvider, client redis.UniversalClient) *RedisBreaker {
+	meter := meterProvider.Meter("github.com/speakeasy-api/gram/server/internal/guardian")
+	metrics := newCircuitBreakerMetrics(logger, meter)
+	fallback := newInProcBreaker(logger, metrics)
+
+	// Shared state is not resident in this process; only the fallback's
+	// breakers are.
+	registerInstanceGauge(logger, meter,
+		"gram.circuit_breaker.instances",
+		"Number of circuit breaker instances resident in this process",
+		"{circuit_breaker}",
+		fallback.countByNamespace,
+	)
+
+	return &RedisBreaker{
+		logger:        logger,
+		metrics:       metrics,
+		client:        client,
+		fallback:      fallback,
+		degradedUntil: new(atomic.Int64),
+	}
+}
+
+// redisBreakerPreamble is shared by both scripts. It reads the time from the
+// Redis server rather than the caller so that clock skew between replicas
+// cannot move a shared window, and defines how a partition's state is reset.
+//
+// KEYS[1] is a hash holding the state, the policy fingerprint, the time the
+// circuit opened, the consecutive half-open successes and the failure window
+// as per-bucket "s:<n>" and "f:<n>" counters, along with the oldest bucket
+// still in the window as of the last report. KEYS[2] is a sorted set of the
+// half-open trial executions in flight, scored by admission time.
+const redisBreakerPreamble = `
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local fp = ARGV[1]
+local ttl = tonumber(ARGV[2])
+
+local function reset(state)
+  redis.call('DEL', KEYS[1], KEYS[2])
+  redis.call('HSET', KEYS[1], 'state', state, 'fp', fp, 'opened_at', now, 'successes', 0)
+  redis.call('PEXPIRE', KEYS[1], ttl)
+end
+`
+
+// redisBreakerAllowScript admits an execution. It returns the state, 1 or 0
+// for admitted, the retry-after in milliseconds, and the previous state and
+// cause when the check itself transitioned the circuit (empty otherwise).
+//
+// ARGV: fingerprint, ttl ms, delay ms, success threshold, probe id, probe
+// ttl ms.
+var redisBreakerAllowScript = redis.NewScript(redisBreakerPreamble + `
+local delay = tonumber(ARGV[3])
+local success_threshold = tonumber(ARGV[4])
+local probe = ARGV[5]
+local probe_ttl = tonumber(ARGV[6])
+
+local cur = redis.call('HMGET', KEYS[1], 'state', 'fp', 'opened_at')
+local state, opened_at = cur[1], tonumber(cur[3]) or now
+local from, cause = '', ''
+
+if not state then
+  reset('closed')
+  state = 'closed'
+elseif cur[2] ~= fp then
+  if state == 'open' then
+    from, cause = 'open', 'reconfigured'
+  end
+  reset('closed')
+  state = 'closed'
+end
+
+if state == 'open' then
+  local elapsed = now - opened_at
+  if elapsed < delay then
+    return {'open', 0, delay - elapsed, from, cause}
+  end
+  redis.call('HSET', KEYS[1], 'state', 'half-open', 'successes', 0)
+  redis.call('DEL', KEYS[2])
+  redis.call('PEXPIRE', KEYS[1], ttl)
+  state, from, cause = 'half-open', 'open', 'traffic'
+end
+
+if state == 'half-open' then
+  -- Trials whose replica never reported are presumed lost after probe_ttl
+  -- so their permits cannot wedge the circuit half-open.
+  redis.call('ZREMRANGEBYSCORE', KEYS[2], '-inf', now - probe_ttl)
+  if redis.call('ZCARD', KEYS[2]) >= success_threshold then
+    return {'half-open', 0, 0, from, cause}
+  end
+  redis.call('ZADD', KEYS[2], now, probe)
+  redis.call('PEXPIRE', KEYS[2], ttl)
+  return {'half-open', 1, -1, from, cause}
+end
+
+return {'closed', 1, -1, from, cause}
+`)
+
+// redisBreakerReportScript records an execution outcome. It returns the
+// previous and new state when the outcome transitioned the circuit, and two
+// empty strings otherwise.
+//
+// ARGV: fingerprint, ttl ms, 1 or 0 for failure, probe id, failure rate
+// threshold, min throughput, success threshold, bucket width ms.
+var redisBreakerReportScript = redis.NewScript(redisBreakerPreamble + `
+local failure = ARGV[3] == '1'
+local probe = ARGV[4]
+local rate_threshold = tonumber(ARGV[5])
+local min_throughput = tonumber(ARGV[6])
+local success_threshold = tonumber(ARGV[7])
+local bucket_width = tonumber(ARGV[8])
+
+local cur = redis.call('HMGET', KEYS[1], 'state', 'fp')
+local state = cur[1]
+-- The partition was reset or reconfigured since admission; the outcome
+-- belongs to state that no longer exists.
+if not state or cur[2] ~= fp then
+  return {'', ''}
+end
+
+if state == 'open' then
+  return {'', ''}
+end
+
+if state == 'half-open' then
+  -- Only trials admitted in this half-open period count towards closing or
+  -- reopening the circuit.
+  if redis.call('ZREM', KEYS[2], probe) == 0 then
+    return {'', ''}
+  end
+  if failure then
+    reset('open')
+    return {'half-open', 'open'}
+  end
+  if redis.call('HINCRBY', KEYS[1], 'successes', 1) >= success_threshold then
+    reset('closed')
+    return {'half-open', 'closed'}
+  end
+  return {'', ''}
+end
+
+local buckets = ` + fmt.Sprint(redisBreakerBuckets) + `
+local bucket = math.floor(now / bucket_width)
+local oldest = bucket - buckets + 1
+
+-- Every report drops the buckets that aged out since the previous one, so
+-- the hash never holds more than a window of them. The previous report
+-- wrote no bucket newer than swept + buckets - 1, which bounds the sweep
+-- however long ago it was.
+local swept = tonumber(redis.call('HGET', KEYS[1], 'swept')) or oldest
+for n = swept, math.min(oldest - 1, swept + buckets - 1) do
+  redis.call('HDEL', KEYS[1], 's:' .. n, 'f:' .. n)
+end
+redis.call('HSET', KEYS[1], 'swept', math.max(swept, oldest))
+
+redis.call('HINCRBY', KEYS[1], (failure and 'f:' or 's:') .. bucket, 1)
+redis.call('PEXPIRE', KEYS[1], ttl)
+
+if not failure then
+  return {'', ''}
+end
+
+local fields = {}
+for n = oldest, bucket do
+  table.insert(fields, 's:' .. n)
+  table.insert(fields, 'f:' .. n)
+end
+local counts = redis.call('HMGET', KEYS[1], unpack(fields))
+local successes, failures = 0, 0
+for i = 1, #counts, 2 do
+  successes = successes + (tonumber(counts[i]) or 0)
+  failures = failures + (tonumber(counts[i + 1]) or 0)
+end
+
+local total = successes + failures
+if total >= min_throughput and failures / total >= rate_threshold then
+  reset('open')
+  return {'closed', 'open'}
+end
+return {'', ''}
+`)
+
+func (b *RedisBreaker) Allow(ctx context.Context, key Partition, policy BreakerPolicy) (BreakerResult, error) {
+	var zero BreakerResult
+
+	if err := validateBreakerPolicy("redis breaker", policy); err != nil {
+		return zero, err
+	}
+
+	if time.Now().UnixNano() < b.degradedUntil.Load() {
+		b.metrics.recordFallback(ctx, key)
+		return b.fallback.Allow(ctx, key, policy)
+	}
+
+	res, err := b.allow(ctx, key, policy)
+	switch {
+	case err != nil && ctx.Err() != nil:
+		// The caller gave up; that says nothing about Redis.
+		return zero, err
+	case err != nil:
+		b.degrade(ctx, err)
+		b.metrics.recordFallback(ctx, key)
+		return b.fallback.Allow(ctx, key, policy)
+	}
+
+	b.metrics.recordRequest(ctx, key, res.Allowed)
+
+	return res, nil
+}
+
+func (b *RedisBreaker) allow(ctx context.Context, key Partition, policy BreakerPolicy) (BreakerResult, error) {
+	var zero BreakerResult
+
+	keys := redisBreakerKeys(key)
+	fingerprint := policy.fingerprint()
+	ttl := redisBreakerTTL(policy)
+	probe := uuid.NewString()
+
+	vals, err := redisBreakerAllowScript.Run(ctx, b.client, keys,
+		fingerprint,
+		ttl.Milliseconds(),
+		policy.Delay.Milliseconds(),
+		policy.SuccessThreshold,
+		probe,
+		policy.Window.Milliseconds(),
+	).Slice()
+	if err != nil {
+		return zero, fmt.Errorf("redis breaker allow: %w", err)
+	}
+	if len(vals) != 5 {
+		return zero, fmt.Errorf("redis breaker allow: unexpected reply length %d", len(vals))
+	}
+
+	state := parseBreakerState(fmt.Sprint(vals[0]))
+	allowed, _ := vals[1].(int64)
+	retryAfterMs, _ := vals[2].(int64)
+	if from := fmt.Sprint(vals[3]); from != "" {
+		b.transitioned(ctx, key, parseBreakerState(from), state, fmt.Sprint(vals[4]))
+	}
+
+	if allowed == 0 {
+		return BreakerResult{
+			State:      state,
+			Allowed:    false,
+			RetryAfter: time.Duration(retryAfterMs) * time.Millisecond,
+			Report:     func(bool) {},
+		}, nil
+	}
+
+	var reported atomic.Bool
+
+	return BreakerResult{
+		State:      state,
+		Allowed:    true,
+		RetryAfter: -1,
+		Report: func(failure bool) {
+			if !reported.CompareAndSwap(false, true) {
+				return
+			}
+
+			b.report(context.WithoutCancel(ctx), key, policy, keys, fingerprint, probe, failure)
+		},
+	}, nil
+}
+
+func (b *RedisBreaker) report(ctx context.Context, key Partition, policy BreakerPolicy, keys []string, fingerprint string, probe string, failure bool) {
+	ctx, cancel := context.WithTimeout(ctx, redisBreakerReportTimeout)
+	defer cancel()
+
+	failureArg := 0
+	if failure {
+		failureArg = 1
+	}
+
+	bucketWidth := max(policy.Window.Milliseconds()/redisBreakerBuckets, 1)
+
+	vals, err := redisBreakerReportScript.Run(ctx, b.client, keys,
+		fingerprint,
+		redisBreakerTTL(policy).Milliseconds(),
+		failureArg,
+		probe,
+		policy.FailureRateThreshold,
+		policy.MinThroughput,
+		policy.SuccessThreshold,
+		bucketWidth,
+	).StringSlice()
+	if err != nil {
+		// The outcome is lost, but the fleet's other reports still shape the
+		// window; degrading here keeps subsequent checks off a failing Redis.
+		b.degrade(ctx, fmt.Errorf("redis breaker report: %w", err))
+		return
+	}
+
+	if len(vals) == 2 && vals[0] != "" {
+		b.transitioned(ctx, key, parseBreakerState(vals[0]), parseBreakerState(vals[1]), transitionCauseTraffic)
+	}
+}
+
+// transitioned records a state change observed by this replica. Each shared
+// transition is observed by exactly one replica, so the open-circuits gauge
+// balances when summed across the fleet rather than per process.
+func (b *RedisBreaker) transitioned(ctx context.Context, key Partition, from, to BreakerState, cause string) {
+	b.metrics.recordTransition(ctx, key, from, to, cause)
+	b.logger.InfoContext(ctx, "circuit breaker state changed",
+		attr.SlogResilienceNamespace(key.Namespace()),
+		attr.SlogResiliencePartition(key.Partition()),
+		attr.SlogResilienceSubset(key.Subset()),
+		attr.SlogResilienceBreakerState(to.String()),
+		attr.SlogResilienceBreakerPreviousState(from.String()),
+		attr.SlogResilienceBreakerTransitionCause(cause),
+	)
+}
+
+// degrade switches admission checks to the in-process fallback for
+// redisBreakerRetryInterval, logging only when this replica was not already
+// degraded so an outage produces one line per interval rather than one per
+// call.
+func (b *RedisBreaker) degrade(ctx context.Context, err error) {
+	now := time.Now()
+	prev := b.degradedUntil.Load()
+	if !b.degradedUntil.CompareAndSwap(prev, now.Add(redisBreakerRetryInterval).UnixNano()) || now.UnixNano() < prev {
+		return
+	}
+
+	b.logger.WarnContext(ctx, "shared circuit breaker state unavailable, falling back to in-process state", attr.SlogError(err))
+}
+
+// redisBreakerKeys returns the state and trial keys for a partition. Both
+// share a hash tag so the scripts touching them run on one cluster slot.
+func redisBreakerKeys(key Partition) []string {
+	base := "guardian:breaker:{" + key.String() + "}"
+	return []string{base, base + ":probes"}
+}
+
+// redisBreakerTTL is how long an idle partition's state is kept. It outlives
+// a full window plus an open delay, after which an untouched partition has
+// nothing left to remember and starts over closed.
+func redisBreakerTTL(policy BreakerPolicy) time.Duration {
+	return 2 * (policy.Window + policy.Delay)
+}
+
+func parseBreakerState(s string) BreakerState {
+	switch s {
+	case BreakerStateOpen.String():
+		return BreakerStateOpen
+	case BreakerStateHalfOpen.String():
+		return BreakerStateHalfOpen
+	case BreakerStateClosed.String():
+		return BreakerStateClosed
+	default:
+		return BreakerStateUnknown
+	}
+}
@@ -0,0 +1,315 @@
+package guardian_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+// newRedisReplicas returns n breakers sharing one Redis, standing in for the
+// replicas of a fleet.
+func newRedisReplicas(t *testing.T, n int) []*guardian.RedisBreaker {
+	t.Helper()
+
+	client, err := infra.NewRedisClient(t, 0)
+	require.NoError(t, err)
+
+	replicas := make([]*guardian.RedisBreaker, n)
+	for i := range replicas {
+		replicas[i] = guardian.NewRedisBreaker(testenv.NewLogger(t), testenv.NewMeterProvider(t), client)
+	}
+
+	return replicas
+}
+
+func TestRedisBreaker_Allow_SharesFailureWindowAcrossReplicas(t *testing.T) {
+	t.Parallel()
+
+	replicas := newRedisReplicas(t, 2)
+	// Random key segment: breaker state outlives the test binary, so a fixed
+	// key would fail on repeated runs (-count) against the same container.
+	key := guardian.NewPartition("svc", uuid.NewString())
+	policy := guardian.BreakerPolicy{
+		FailureRateThreshold: 0.5,
+		MinThroughput:        4,
+		Window:               time.Minute,
+		Delay:                time.Hour,
+		SuccessThreshold:     1,
+		IncludeSubset:        false,
+	}
+
+	// Each replica sees two failures, below MinThroughput on its own, but
+	// the fleet's four together trip the shared circuit.
+	for i := range 4 {
+		res, err := replicas[i%2].Allow(t.Context(), key, policy)
+		require.NoError(t, err)
+		require.True(t, res.Allowed, "request %d should be admitted", i)
+		require.Equal(t, guardian.BreakerStateClosed, res.State)
+		require.Equal(t, time.Duration(-1), res.RetryAfter)
+		res.Report(true)
+	}
+
+	for _, b := range replicas {
+		res, err := b.Allow(t.Context(), key, policy)
+		require.NoError(t, err)
+		require.False(t, res.Allowed)
+		require.Equal(t, guardian.BreakerStateOpen, res.State)
+		require.Greater(t, res.RetryAfter, 50*time.Minute, "RetryAfter should reflect the open delay")
+	}
+
+	// Other partitions are unaffected.
+	other, err := replicas[0].Allow(t.Context(), guardian.NewPartition("svc", uuid.NewString()), policy)
+	require.NoError(t, err)
+	require.True(t, other.Allowed)
+	other.Report(false)
+}
+
+func TestRedisBreaker_Allow_SuccessesKeepCircuitClosed(t *testing.T) {
+	t.Parallel()
+
+	replicas := newRedisReplicas(t, 1)
+	key := guardian.NewPartition("svc", uuid.NewString())
+	policy := guardian.BreakerPolicy{
+		FailureRateThreshold: 0.7,
+		MinThroughput:        4,
+		Window:               time.Minute,
+		Delay:                time.Hour,
+		SuccessThreshold:     1,
+		IncludeSubset:        false,
+	}
+
+	for i := range 10 {
+		res, err := replicas[0].Allow(t.Context(), key, policy)
+		require.NoError(t, err)
+		require.True(t, res.Allowed, "request %d should be admitted", i)
+		res.Report(i%2 == 0)
+	}
+}
+
+func TestRedisBreaker_Report_DropsAgedOutBuckets(t *testing.T) {
+	t.Parallel()
+
+	client, err := infra.NewRedisClient(t, 0)
+	require.NoError(t, err)
+
+	b := guardian.NewRedisBreaker(testenv.NewLogger(t), testenv.NewMeterProvider(t), client)
+	key := guardian.NewPartition("svc", uuid.NewString())
+	policy := guardian.BreakerPolicy{
+		FailureRateThreshold: 0.5,
+		MinThroughput:        1,
+		Window:               100 * time.Millisecond,
+		Delay:                time.Hour,
+		SuccessThreshold:     1,
+		IncludeSubset:        false,
+	}
+
+	// Successes spread over many times the window's ten buckets.
+	deadline := time.Now().Add(10 * policy.Window)
+	for time.Now().Before(deadline) {
+		res, err := b.Allow(t.Context(), key, policy)
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+		res.Report(false)
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	fields, err := client.HKeys(t.Context(), "guardian:breaker:{"+key.String()+"}").Result()
+	require.NoError(t, err)
+
+	var buckets int
+	for _, field := range fields {
+		if strings.HasPrefix(field, "s:") || strings.HasPrefix(field, "f:") {
+			buckets++
+		}
+	}
+	require.LessOrEqual(t, buckets, 10, "only the window's buckets should be kept")
+
+	// Once the successes age out, a single failure trips the circuit.
+	time.Sleep(policy.Window)
+	res, err := b.Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	res.Report(true)
+
+	res, err = b.Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, guardian.BreakerStateOpen, res.State)
+}
+
+func TestRedisBreaker_Allow_HalfOpenCoordinatesTrials(t *testing.T) {
+	t.Parallel()
+
+	replicas := newRedisReplicas(t, 2)
+	key := guardian.NewPartition("svc", uuid.NewString())
+	policy := guardian.BreakerPolicy{
+		FailureRateThreshold: 1,
+		MinThroughput:        1,
+		Window:               time.Minute,
+		Delay:                200 * time.Millisecond,
+		SuccessThreshold:     2,
+		IncludeSubset:        false,
+	}
+
+	tripRedisBreaker(t, replicas[0], key, policy)
+	time.Sleep(policy.Delay)
+
+	// SuccessThreshold trials are admitted fleet-wide; the next replica to
+	// ask is turned away until one of them reports.
+	first, err := replicas[0].Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	require.True(t, first.Allowed)
+	require.Equal(t, guardian.BreakerStateHalfOpen, first.State)
+
+	second, err := replicas[1].Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	require.True(t, second.Allowed)
+
+	denied, err := replicas[1].Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	require.False(t, denied.Allowed)
+	require.Equal(t, guardian.BreakerStateHalfOpen, denied.State)
+	require.Equal(t, time.Duration(0), denied.RetryAfter)
+
+	first.Report(false)
+	// Report is idempotent: a second success must not count twice.
+	first.Report(false)
+
+	third, err := replicas[1].Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	require.True(t, third.Allowed)
+	require.Equal(t, guardian.BreakerStateHalfOpen, third.State)
+
+	second.Report(false)
+	third.Report(false)
+
+	res, err := replicas[1].Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, guardian.BreakerStateClosed, res.State)
+	res.Report(false)
+}
+
+func TestRedisBreaker_Allow_HalfOpenFailureReopens(t *testing.T) {
+	t.Parallel()
+
+	replicas := newRedisReplicas(t, 2)
+	key := guardian.NewPartition("svc", uuid.NewString())
+	policy := guardian.BreakerPolicy{
+		FailureRateThreshold: 1,
+		MinThroughput:        1,
+		Window:               time.Minute,
+		Delay:                200 * time.Millisecond,
+		SuccessThreshold:     1,
+		IncludeSubset:        false,
+	}
+
+	tripRedisBreaker(t, replicas[0], key, policy)
+	time.Sleep(policy.Delay)
+
+	trial, err := replicas[0].Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	require.True(t, trial.Allowed)
+	trial.Report(true)
+
+	res, err := replicas[1].Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, guardian.BreakerStateOpen, res.State)
+	require.Positive(t, res.RetryAfter)
+}
+
+func TestRedisBreaker_Allow_PolicyChangeResetsPartition(t *testing.T) {
+	t.Parallel()
+
+	replicas := newRedisReplicas(t, 1)
+	key := guardian.NewPartition("svc", uuid.NewString())
+	policy := guardian.BreakerPolicy{
+		FailureRateThreshold: 1,
+		MinThroughput:        1,
+		Window:               time.Minute,
+		Delay:                time.Hour,
+		SuccessThreshold:     1,
+		IncludeSubset:        false,
+	}
+
+	tripRedisBreaker(t, replicas[0], key, policy)
+
+	policy.MinThroughput = 2
+	res, err := replicas[0].Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, guardian.BreakerStateClosed, res.State)
+	res.Report(false)
+}
+
+func TestRedisBreaker_Allow_FallsBackWhenRedisUnavailable(t *testing.T) {
+	t.Parallel()
+
+	client := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+
+	b := guardian.NewRedisBreaker(testenv.NewLogger(t), testenv.NewMeterProvider(t), client)
+	key := guardian.NewPartition("svc", uuid.NewString())
+	policy := guardian.BreakerPolicy{
+		FailureRateThreshold: 1,
+		MinThroughput:        2,
+		Window:               time.Minute,
+		Delay:                time.Hour,
+		SuccessThreshold:     1,
+		IncludeSubset:        false,
+	}
+
+	// In-process state still protects the upstream while Redis is down.
+	for range 2 {
+		res, err := b.Allow(t.Context(), key, policy)
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+		res.Report(true)
+	}
+
+	res, err := b.Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, guardian.BreakerStateOpen, res.State)
+}
+
+func TestRedisBreaker_Allow_InvalidPolicy(t *testing.T) {
+	t.Parallel()
+
+	replicas := newRedisReplicas(t, 1)
+	key := guardian.NewPartition("svc", uuid.NewString())
+
+	_, err := replicas[0].Allow(t.Context(), key, guardian.BreakerPolicy{
+		FailureRateThreshold: 0,
+		MinThroughput:        1,
+		Window:               time.Minute,
+		Delay:                time.Minute,
+		SuccessThreshold:     1,
+		IncludeSubset:        false,
+	})
+	require.ErrorContains(t, err, "failure rate threshold")
+}
+
+func tripRedisBreaker(t *testing.T, b *guardian.RedisBreaker, key guardian.Partition, policy guardian.BreakerPolicy) {
+	t.Helper()
+
+	for range int(policy.MinThroughput) + 1 {
+		res, err := b.Allow(t.Context(), key, policy)
+		require.NoError(t, err)
+		if !res.Allowed {
+			return
+		}
+		res.Report(true)
+	}
+
+	res, err := b.Allow(t.Context(), key, policy)
+	require.NoError(t, err)
+	require.False(t, res.Allowed, "breaker should have tripped")
+}
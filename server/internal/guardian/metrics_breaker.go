@@ -23,6 +23,7 @@ type circuitBreakerMetrics struct {
 	transitions  metric.Int64Counter
 	requests     metric.Int64Counter
 	openCircuits metric.Int64UpDownCounter
+	fallbacks    metric.Int64Counter
 }
 
 func newCircuitBreakerMetrics(logger *slog.Logger, meter metric.Meter) *circuitBreakerMetrics {
@@ -55,11 +56,21 @@ func newCircuitBreakerMetrics(logger *slog.Logger, meter metric.Meter) *circuitB
 		logger.ErrorContext(context.Background(), "failed to create circuit breaker open circuits counter", attr.SlogError(err))
 	}
 
+	fallbacks, err := meter.Int64Counter(
+		"gram.circuit_breaker.fallbacks",
+		metric.WithDescription("Number of admission checks served from in-process state because shared breaker state was unavailable"),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "failed to create circuit breaker fallbacks counter", attr.SlogError(err))
+	}
+
 	return &circuitBreakerMetrics{
 		logger:       logger,
 		transitions:  transitions,
 		requests:     requests,
 		openCircuits: openCircuits,
+		fallbacks:    fallbacks,
 	}
 }
 
@@ -99,3 +110,11 @@ func (m *circuitBreakerMetrics) recordRequest(ctx context.Context, key Partition
 
 	m.requests.Add(ctx, 1, metric.WithAttributes(append(partitionAttrs(key), attr.Outcome(outcome))...))
 }
+
+func (m *circuitBreakerMetrics) recordFallback(ctx context.Context, key Partition) {
+	if m.fallbacks == nil {
+		return
+	}
+
+	m.fallbacks.Add(ctx, 1, metric.WithAttributes(partitionAttrs(key)...))
+}
@@ -28,7 +28,6 @@ package guardian
 
 import (
 	"context"
-	"crypto/tls"
 	"crypto/x509"
 	"fmt"
 	"log/slog"
@@ -143,6 +142,7 @@ type httpClientOptions struct {
 	resolver          *net.Resolver
 	allowedCIDRBlocks []*net.IPNet
 	resilience        *resilienceOptions
+	proxy             *Proxy
 }
 
 // ClientOption configures a single [Policy.Client] / [Policy.PooledClient]
@@ -202,6 +202,8 @@ type Policy struct {
 	limiter           Limiter
 	breaker           Breaker
 	tlsRootCAs        *x509.CertPool
+	proxy             *Proxy
+	scopedProxies     map[string]*Proxy
 }
 
 // WithResolver is a functional option that sets the Policy's resolver.
@@ -266,6 +268,8 @@ func newPolicy(tracerProvider trace.TracerProvider, blockedCIDRBlocks []*net.IPN
 		limiter:           nil,
 		breaker:           nil,
 		tlsRootCAs:        nil,
+		proxy:             nil,
+		scopedProxies:     map[string]*Proxy{},
 	}
 
 	for _, option := range options {
@@ -336,18 +340,10 @@ func (p *Policy) clientWithBaseTransport(transport *http.Transport, options ...f
 	if len(opts.allowedCIDRBlocks) > 0 {
 		dialOpts = append(dialOpts, WithDialerAllowedCIDRBlocks(opts.allowedCIDRBlocks))
 	}
-	transport.DialContext = p.Dialer(dialOpts...).DialContext
-
-	// Merge into any existing transport TLS config rather than replacing
-	// it, so a future option that sets client certificates or pinning is
-	// not silently discarded when a root pool is also configured.
-	if p.tlsRootCAs != nil {
-		if transport.TLSClientConfig == nil {
-			transport.TLSClientConfig = &tls.Config{RootCAs: p.tlsRootCAs, MinVersion: tls.VersionTLS12}
-		} else {
-			transport.TLSClientConfig.RootCAs = p.tlsRootCAs
-		}
+	if opts.proxy != nil {
+		dialOpts = append(dialOpts, WithDialerProxy(opts.proxy))
 	}
+	p.ConfigureTransport(transport, dialOpts...)
 
 	otelOpts := []otelhttp.Option{otelhttp.WithTracerProvider(p.tracerProvider)}
 	otelOpts = append(otelOpts, opts.otelHTTPOptions...)
@@ -419,6 +415,7 @@ func (p *Policy) clientWithBaseTransport(transport *http.Transport, options ...f
 type dialerOptions struct {
 	resolver          *net.Resolver
 	allowedCIDRBlocks []*net.IPNet
+	proxy             *Proxy
 }
 
 func WithDialerResolver(resolver *net.Resolver) func(*dialerOptions) {
@@ -436,6 +433,22 @@ func WithDialerAllowedCIDRBlocks(blocks []*net.IPNet) func(*dialerOptions) {
 	}
 }
 
+// TLSRootCAs returns the root pool configured with [WithTLSRootCAs], or nil
+// when the system pool is in use. Use it to give a non-HTTP client, such as a
+// gRPC connection dialed through [Policy.Dialer], the same trust roots as
+// Client and PooledClient.
+func (p *Policy) TLSRootCAs() *x509.CertPool {
+	return p.tlsRootCAs
+}
+
+// Breaker returns the circuit breaker configured with [WithBreaker]. Use it to
+// share breaker state with [WithResilience] clients from code that routes
+// requests itself, such as choosing between upstream hosts, rather than going
+// through a resilient client.
+func (p *Policy) Breaker() Breaker {
+	return p.breaker
+}
+
 // Dialer returns a [net.Dialer] that enforces the policy's CIDR blocklist via
 // [net.Dialer.ControlContext]. The check runs after DNS resolution on the
 // raw IP address, so it cannot be bypassed by hostnames that resolve to
@@ -0,0 +1,461 @@
+package guardian
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// ErrProxy is wrapped by errors from establishing a tunnel through an egress
+// proxy: the proxy refused the CONNECT, rejected its credentials, or could
+// not be reached.
+var ErrProxy = errors.New("egress proxy")
+
+// proxyConnectTimeout bounds the CONNECT handshake with an HTTP proxy, on
+// top of the dial itself.
+const proxyConnectTimeout = 30 * time.Second
+
+// Proxy is an outbound proxy that clients built from a [Policy] tunnel every
+// connection through. Supported schemes are http and https (HTTP CONNECT),
+// socks5 (target resolved locally) and socks5h (target resolved by the
+// proxy). Credentials are taken from the URL's userinfo.
+//
+// The policy's SSRF checks still apply to the target: its host is resolved
+// and checked against the blocklist before the proxy is asked to connect to
+// it. For socks5 the proxy is handed the checked IP, so the check cannot be
+// raced by DNS rebinding; for http, https and socks5h the proxy resolves the
+// host again itself, which leaves the usual window for a rebinding attack
+// between the check and the proxy's lookup.
+type Proxy struct {
+	url     *url.URL
+	caCerts []*x509.Certificate
+	trusted bool
+}
+
+// NewProxy parses an operator-configured proxy. Its own address is exempt
+// from the policy's blocklist, since egress proxies usually sit on a private
+// network. caBundle holds PEM certificates to trust, in addition to the
+// usual roots, for the TLS connection to an https proxy and for upstream TLS
+// tunneled through it, as a proxy that inspects TLS re-signs upstream
+// certificates with its own CA. It may be empty.
+func NewProxy(rawURL string, caBundle []byte) (*Proxy, error) {
+	return newProxy(rawURL, caBundle, true)
+}
+
+// NewUntrustedProxy is [NewProxy] for proxies configured by tenants rather
+// than operators. The proxy's own address is subject to the policy's
+// blocklist like any other destination, so it cannot be pointed at an
+// internal service.
+func NewUntrustedProxy(rawURL string, caBundle []byte) (*Proxy, error) {
+	return newProxy(rawURL, caBundle, false)
+}
+
+func newProxy(rawURL string, caBundle []byte, trusted bool) (*Proxy, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		// url.Error quotes the input, which would put the proxy's password
+		// into logs and tool errors.
+		if urlErr, ok := errors.AsType[*url.Error](err); ok {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("parse proxy url: %w", err)
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("proxy url scheme must be http, https, socks5 or socks5h, got %q", u.Scheme)
+	}
+
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("proxy url must include a host")
+	}
+	if u.Path != "" && u.Path != "/" {
+		return nil, fmt.Errorf("proxy url must not include a path")
+	}
+	if u.Port() == "" {
+		port := "1080"
+		switch u.Scheme {
+		case "http":
+			port = "80"
+		case "https":
+			port = "443"
+		}
+		u.Host = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	var certs []*x509.Certificate
+	for rest := caBundle; len(rest) > 0; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse proxy ca bundle: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(caBundle) > 0 && len(certs) == 0 {
+		return nil, fmt.Errorf("proxy ca bundle contains no certificates")
+	}
+
+	return &Proxy{url: u, caCerts: certs, trusted: trusted}, nil
+}
+
+// String returns the proxy URL with any password redacted.
+func (p *Proxy) String() string {
+	return p.url.Redacted()
+}
+
+// WithProxy routes every client built from the Policy through proxy, unless
+// a client overrides it with [WithClientProxy].
+func WithProxy(proxy *Proxy) func(*Policy) {
+	return func(p *Policy) {
+		p.proxy = proxy
+	}
+}
+
+// WithScopedProxy registers proxy for scope, an identifier meaningful to the
+// caller such as a project ID. It does not route anything by itself: callers
+// look the proxy up with [Policy.ScopedProxy] and pass it to
+// [WithClientProxy] or [WithDialerProxy].
+func WithScopedProxy(scope string, proxy *Proxy) func(*Policy) {
+	return func(p *Policy) {
+		p.scopedProxies[scope] = proxy
+	}
+}
+
+// ScopedProxy returns the proxy registered for scope with
+// [WithScopedProxy], or nil when there is none.
+func (p *Policy) ScopedProxy(scope string) *Proxy {
+	return p.scopedProxies[scope]
+}
+
+// WithClientProxy routes this client through proxy instead of the policy's
+// proxy, for egress that must leave through a proxy chosen per project or
+// environment. A nil proxy keeps the policy's.
+func WithClientProxy(proxy *Proxy) func(*httpClientOptions) {
+	return func(o *httpClientOptions) {
+		o.proxy = proxy
+	}
+}
+
+// WithDialerProxy makes the dial function returned by [Policy.DialContext]
+// tunnel through proxy instead of the policy's proxy. See [WithClientProxy].
+func WithDialerProxy(proxy *Proxy) func(*dialerOptions) {
+	return func(o *dialerOptions) {
+		o.proxy = proxy
+	}
+}
+
+// DialContext returns the dial function clients built from the policy use:
+// [Policy.Dialer]'s when no proxy is configured, and otherwise one that
+// checks the target against the blocklist and then tunnels to it through
+// the proxy.
+func (p *Policy) DialContext(options ...func(*dialerOptions)) func(ctx context.Context, network, address string) (net.Conn, error) {
+	var opts dialerOptions
+	for _, option := range options {
+		option(&opts)
+	}
+
+	egress := opts.proxy
+	if egress == nil {
+		egress = p.proxy
+	}
+	if egress == nil {
+		return p.Dialer(options...).DialContext
+	}
+
+	// The target is checked before the tunnel is requested, so the proxy's
+	// own address is the only thing the dialer connects to. Operator proxies
+	// commonly live on private networks and skip the blocklist.
+	direct := p.Dialer(options...)
+
+	var toProxy *net.Dialer
+	if egress.trusted {
+		toProxy = &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Resolver:  opts.resolver,
+		}
+	} else {
+		toProxy = direct
+	}
+
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, fmt.Errorf("%s: split host port: %w: %w", address, ErrBadHost, err)
+		}
+
+		ips, err := p.resolveTarget(ctx, host, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		// Destinations the client explicitly allowed are trusted internal
+		// services, such as runner pods, that an egress proxy cannot reach.
+		if allAllowed(ips, opts.allowedCIDRBlocks) {
+			return direct.DialContext(ctx, network, address)
+		}
+
+		switch egress.url.Scheme {
+		case "socks5":
+			return egress.dialSOCKS(ctx, toProxy, network, net.JoinHostPort(ips[0].String(), port))
+		case "socks5h":
+			return egress.dialSOCKS(ctx, toProxy, network, address)
+		default:
+			return egress.dialConnect(ctx, toProxy, address)
+		}
+	}
+}
+
+// ConfigureTransport points transport at the policy's dial function and TLS
+// roots, accounting for any proxy. It is what Client and PooledClient do to
+// their transports; use it when a caller has to build its own
+// [http.Transport].
+func (p *Policy) ConfigureTransport(transport *http.Transport, options ...func(*dialerOptions)) {
+	var opts dialerOptions
+	for _, option := range options {
+		option(&opts)
+	}
+
+	egress := opts.proxy
+	if egress == nil {
+		egress = p.proxy
+	}
+
+	transport.DialContext = p.DialContext(options...)
+
+	roots := p.tlsRootCAs
+	if egress != nil {
+		// Tunneling happens in DialContext, so the transport must not also
+		// send requests to a proxy from the environment.
+		transport.Proxy = nil
+		roots = egress.withCACerts(roots)
+	}
+
+	// Merge into any existing transport TLS config rather than replacing
+	// it, so a future option that sets client certificates or pinning is
+	// not silently discarded when a root pool is also configured.
+	if roots != nil {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+		} else {
+			transport.TLSClientConfig.RootCAs = roots
+		}
+	}
+}
+
+// resolveTarget resolves host and checks every address against the
+// blocklist, failing closed like [Policy.ValidateHost], except for addresses
+// in the client's allowed blocks.
+func (p *Policy) resolveTarget(ctx context.Context, host string, opts dialerOptions) ([]net.IP, error) {
+	if host == "" {
+		return nil, fmt.Errorf("%w: empty host", ErrBadHost)
+	}
+
+	var ips []net.IP
+	switch {
+	case net.ParseIP(host) != nil:
+		ips = []net.IP{net.ParseIP(host)}
+	case opts.resolver != nil:
+		addrs, err := opts.resolver.LookupIP(ctx, "ip", host)
+		if err != nil {
+			return nil, fmt.Errorf("%s: lookup ip: %w: %w", host, ErrBadHost, err)
+		}
+		ips = addrs
+	default:
+		addrs, err := p.resolver.LookupIP(ctx, "ip", host)
+		if err != nil {
+			return nil, fmt.Errorf("%s: lookup ip: %w: %w", host, ErrBadHost, err)
+		}
+		ips = addrs
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("%s: %w: no addresses", host, ErrBadHost)
+	}
+
+	for _, ip := range ips {
+		if allowedByBlocks(ip, opts.allowedCIDRBlocks) {
+			continue
+		}
+		if err := p.checkIP(ip); err != nil {
+			return nil, err
+		}
+	}
+
+	return ips, nil
+}
+
+func allAllowed(ips []net.IP, blocks []*net.IPNet) bool {
+	for _, ip := range ips {
+		if !allowedByBlocks(ip, blocks) {
+			return false
+		}
+	}
+
+	return len(blocks) > 0
+}
+
+func allowedByBlocks(ip net.IP, blocks []*net.IPNet) bool {
+	for _, block := range blocks {
+		if block.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withCACerts returns base, or the system pool when base is nil, extended
+// with the proxy's CA bundle. It returns base unchanged when the proxy has
+// no bundle.
+func (p *Proxy) withCACerts(base *x509.CertPool) *x509.CertPool {
+	if len(p.caCerts) == 0 {
+		return base
+	}
+
+	var pool *x509.CertPool
+	switch {
+	case base != nil:
+		pool = base.Clone()
+	default:
+		system, err := x509.SystemCertPool()
+		if err != nil {
+			system = x509.NewCertPool()
+		}
+		pool = system
+	}
+
+	for _, cert := range p.caCerts {
+		pool.AddCert(cert)
+	}
+
+	return pool
+}
+
+func (p *Proxy) dialSOCKS(ctx context.Context, toProxy *net.Dialer, network, address string) (net.Conn, error) {
+	var auth *proxy.Auth
+	if user := p.url.User; user != nil {
+		password, _ := user.Password()
+		auth = &proxy.Auth{User: user.Username(), Password: password}
+	}
+
+	dialer, err := proxy.SOCKS5("tcp", p.url.Host, auth, toProxy)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrProxy, p, err)
+	}
+
+	contextDialer, ok := dialer.(proxy.ContextDialer)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s: socks dialer does not support contexts", ErrProxy, p)
+	}
+
+	conn, err := contextDialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrProxy, p, err)
+	}
+
+	return conn, nil
+}
+
+func (p *Proxy) dialConnect(ctx context.Context, toProxy *net.Dialer, address string) (net.Conn, error) {
+	conn, err := toProxy.DialContext(ctx, "tcp", p.url.Host)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: dial: %w", ErrProxy, p, err)
+	}
+
+	if p.url.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{
+			ServerName: p.url.Hostname(),
+			RootCAs:    p.withCACerts(nil),
+			MinVersion: tls.VersionTLS12,
+		})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("%w: %s: tls handshake: %w", ErrProxy, p, err)
+		}
+		conn = tlsConn
+	}
+
+	// The deadline covers the CONNECT exchange only, and also unblocks the
+	// read below if ctx is cancelled first.
+	deadline := time.Now().Add(proxyConnectTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%w: %s: set deadline: %w", ErrProxy, p, err)
+	}
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodConnect, "", nil)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%w: %s: build connect request: %w", ErrProxy, p, err)
+	}
+	req.URL = &url.URL{Opaque: address}
+	req.Host = address
+	if user := p.url.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+
+	if err := req.Write(conn); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%w: %s: write connect: %w", ErrProxy, p, err)
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%w: %s: read connect response: %w", ErrProxy, p, err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%w: %s: connect to %s: %s", ErrProxy, p, address, resp.Status)
+	}
+
+	// A proxy must not send anything after the CONNECT response until the
+	// client speaks, so buffered bytes would be a protocol violation that
+	// the caller could not recover from.
+	if br.Buffered() > 0 {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%w: %s: unexpected data after connect response", ErrProxy, p)
+	}
+
+	if !stop() {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%w: %s: %w", ErrProxy, p, ctx.Err())
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%w: %s: clear deadline: %w", ErrProxy, p, err)
+	}
+
+	return conn, nil
+}
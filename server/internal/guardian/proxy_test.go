@@ -0,0 +1,267 @@
+package guardian_test
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+// connectProxy is a minimal HTTP CONNECT proxy that counts the tunnels it
+// was asked for and requires basic credentials when wantAuth is set.
+type connectProxy struct {
+	server   *httptest.Server
+	addr     string
+	connects *atomic.Int32
+}
+
+func newConnectProxy(t *testing.T, wantAuth string) *connectProxy {
+	t.Helper()
+
+	return startConnectProxy(t, wantAuth, false)
+}
+
+func startConnectProxy(t *testing.T, wantAuth string, useTLS bool) *connectProxy {
+	t.Helper()
+
+	connects := new(atomic.Int32)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		connects.Add(1)
+
+		if wantAuth != "" && r.Header.Get("Proxy-Authorization") != "Basic "+base64.StdEncoding.EncodeToString([]byte(wantAuth)) {
+			w.WriteHeader(http.StatusProxyAuthRequired)
+			return
+		}
+
+		upstream, err := (&net.Dialer{}).DialContext(r.Context(), "tcp", r.Host)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer func() { _ = upstream.Close() }()
+
+		conn, _, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		_, _ = io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+
+		done := make(chan struct{}, 2)
+		go func() { _, _ = io.Copy(upstream, conn); done <- struct{}{} }()
+		go func() { _, _ = io.Copy(conn, upstream); done <- struct{}{} }()
+		<-done
+	}))
+	if useTLS {
+		srv.StartTLS()
+	} else {
+		srv.Start()
+	}
+	t.Cleanup(srv.Close)
+
+	return &connectProxy{server: srv, addr: srv.Listener.Addr().String(), connects: connects}
+}
+
+func newTestUpstream(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newGetRequest(t *testing.T, rawURL string) *http.Request {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, rawURL, nil)
+	require.NoError(t, err)
+
+	return req
+}
+
+func requireRequestFails(t *testing.T, client *guardian.HTTPClient, rawURL string, target error) error {
+	t.Helper()
+
+	resp, err := client.Do(newGetRequest(t, rawURL))
+	if resp != nil {
+		require.NoError(t, resp.Body.Close())
+	}
+	require.ErrorIs(t, err, target)
+
+	return err
+}
+
+func TestPolicy_ProxyTunnelsThroughConnect(t *testing.T) {
+	t.Parallel()
+
+	proxy := newConnectProxy(t, "egress:s3cret")
+	upstream := newTestUpstream(t)
+
+	egress, err := guardian.NewProxy("http://egress:s3cret@"+proxy.addr, nil)
+	require.NoError(t, err)
+	require.NotContains(t, egress.String(), "s3cret")
+
+	policy, err := guardian.NewUnsafePolicy(testenv.NewTracerProvider(t), nil, guardian.WithProxy(egress))
+	require.NoError(t, err)
+
+	for _, client := range []*guardian.HTTPClient{policy.Client(), policy.PooledClient()} {
+		resp, err := client.Do(newGetRequest(t, upstream.URL))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	}
+	require.Equal(t, int32(2), proxy.connects.Load())
+}
+
+func TestPolicy_ProxyChecksTargetBeforeConnect(t *testing.T) {
+	t.Parallel()
+
+	proxy := newConnectProxy(t, "")
+	upstream := newTestUpstream(t)
+
+	egress, err := guardian.NewProxy("http://"+proxy.addr, nil)
+	require.NoError(t, err)
+
+	// The operator's proxy is itself on loopback, which is blocked: it must
+	// still be reachable, while loopback targets must not be.
+	policy, err := guardian.NewUnsafePolicy(testenv.NewTracerProvider(t), []string{"127.0.0.0/8"}, guardian.WithProxy(egress))
+	require.NoError(t, err)
+
+	requireRequestFails(t, policy.Client(), upstream.URL, guardian.ErrBlockedIP)
+	require.Zero(t, proxy.connects.Load(), "the proxy must not be asked to connect to a blocked target")
+}
+
+func TestPolicy_UntrustedProxyAddressIsChecked(t *testing.T) {
+	t.Parallel()
+
+	proxy := newConnectProxy(t, "")
+
+	egress, err := guardian.NewUntrustedProxy("http://"+proxy.addr, nil)
+	require.NoError(t, err)
+
+	policy, err := guardian.NewUnsafePolicy(testenv.NewTracerProvider(t), []string{"127.0.0.0/8"})
+	require.NoError(t, err)
+
+	requireRequestFails(t, policy.Client(guardian.WithClientProxy(egress)), "http://203.0.113.5/", guardian.ErrBlockedIP)
+	require.Zero(t, proxy.connects.Load())
+}
+
+func TestPolicy_ClientProxyOverridesPolicyProxy(t *testing.T) {
+	t.Parallel()
+
+	policyProxy := newConnectProxy(t, "")
+	clientProxy := newConnectProxy(t, "")
+	upstream := newTestUpstream(t)
+
+	defaultEgress, err := guardian.NewProxy("http://"+policyProxy.addr, nil)
+	require.NoError(t, err)
+	projectEgress, err := guardian.NewProxy("http://"+clientProxy.addr, nil)
+	require.NoError(t, err)
+
+	policy, err := guardian.NewUnsafePolicy(testenv.NewTracerProvider(t), nil, guardian.WithProxy(defaultEgress))
+	require.NoError(t, err)
+
+	resp, err := policy.Client(guardian.WithClientProxy(projectEgress)).Do(newGetRequest(t, upstream.URL))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	require.Zero(t, policyProxy.connects.Load())
+	require.Equal(t, int32(1), clientProxy.connects.Load())
+}
+
+func TestPolicy_ProxyRejectedCredentials(t *testing.T) {
+	t.Parallel()
+
+	proxy := newConnectProxy(t, "egress:s3cret")
+	upstream := newTestUpstream(t)
+
+	egress, err := guardian.NewProxy("http://egress:wrong@"+proxy.addr, nil)
+	require.NoError(t, err)
+
+	policy, err := guardian.NewUnsafePolicy(testenv.NewTracerProvider(t), nil, guardian.WithProxy(egress))
+	require.NoError(t, err)
+
+	err = requireRequestFails(t, policy.Client(), upstream.URL, guardian.ErrProxy)
+	require.ErrorContains(t, err, "407")
+}
+
+func TestPolicy_HTTPSProxyTrustsCABundle(t *testing.T) {
+	t.Parallel()
+
+	proxy := startConnectProxy(t, "", true)
+	upstream := newTestUpstream(t)
+
+	caBundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Headers: nil, Bytes: proxy.server.Certificate().Raw})
+
+	policy, err := guardian.NewUnsafePolicy(testenv.NewTracerProvider(t), nil)
+	require.NoError(t, err)
+
+	// Without the bundle the proxy's self-signed certificate is rejected.
+	untrusted, err := guardian.NewProxy("https://"+proxy.addr, nil)
+	require.NoError(t, err)
+	requireRequestFails(t, policy.Client(guardian.WithClientProxy(untrusted)), upstream.URL, guardian.ErrProxy)
+
+	trusted, err := guardian.NewProxy("https://"+proxy.addr, caBundle)
+	require.NoError(t, err)
+	resp, err := policy.Client(guardian.WithClientProxy(trusted)).Do(newGetRequest(t, upstream.URL))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestNewProxy_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		url      string
+		caBundle []byte
+	}{
+		"unsupported scheme": {url: "ftp://proxy.internal:21", caBundle: nil},
+		"missing host":       {url: "http://", caBundle: nil},
+		"path":               {url: "http://proxy.internal:3128/egress", caBundle: nil},
+		"empty ca bundle":    {url: "https://proxy.internal:3128", caBundle: []byte("not a certificate")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := guardian.NewProxy(tc.url, tc.caBundle)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestPolicy_ProxyBypassedForAllowedCIDRBlocks(t *testing.T) {
+	t.Parallel()
+
+	proxy := newConnectProxy(t, "")
+	upstream := newTestUpstream(t)
+
+	egress, err := guardian.NewProxy("http://"+proxy.addr, nil)
+	require.NoError(t, err)
+
+	policy, err := guardian.NewUnsafePolicy(testenv.NewTracerProvider(t), []string{"127.0.0.0/8"}, guardian.WithProxy(egress))
+	require.NoError(t, err)
+
+	resp, err := policy.Client(guardian.WithAllowedCIDRBlocks("127.0.0.0/8")).Do(newGetRequest(t, upstream.URL))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.Zero(t, proxy.connects.Load(), "explicitly allowed internal targets are dialed directly")
+}
@@ -96,7 +96,7 @@ func seedSpendBlock(t *testing.T, ctx context.Context, ti *testInstance, organiz
 			DailyCost:   0,
 			WeeklyCost:  0,
 			MonthlyCost: 100,
-		}, time.Now().UTC())))
+		}, nil, time.Now().UTC())))
 	}
 }
 
@@ -3,6 +3,7 @@ package instances
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -86,6 +87,7 @@ func NewService(
 	enc *encryption.Client,
 	cacheImpl cache.Cache,
 	guardianPolicy *guardian.Policy,
+	upstreams *gateway.UpstreamHealth,
 	funcCaller functions.ToolCaller,
 	platformTools gateway.PlatformExecutor,
 	tracking billing.Tracker,
@@ -116,6 +118,7 @@ func NewService(
 			enc,
 			cacheImpl,
 			guardianPolicy,
+			upstreams,
 			funcCaller,
 			platformTools,
 		),
@@ -315,11 +318,45 @@ func (s *Service) ExecuteInstanceTool(w http.ResponseWriter, r *http.Request) er
 		}
 	}
 
+	// Bound arguments resolve for the caller, with the toolset's own tool
+	// variations when the call is made through one.
+	var toolsetBindings []gateway.ArgumentBinding
+	if toolset != nil {
+		for _, tool := range toolset.Tools {
+			if tool == nil {
+				continue
+			}
+			if u, err := conv.GetToolURN(*tool); err == nil && u != nil && u.String() == toolURN.String() {
+				toolsetBindings = toolsets.ToolArgumentBindings(tool)
+				break
+			}
+		}
+	}
+	plan = plan.WithArgumentBindings(toolsetBindings, toolconfig.Principal{
+		Email:            conv.PtrValOr(authCtx.Email, ""),
+		UserID:           authCtx.UserID,
+		ExternalUserID:   "",
+		OrganizationID:   authCtx.ActiveOrganizationID,
+		OrganizationSlug: authCtx.OrganizationSlug,
+	})
+
 	systemConfig, err := s.env.LoadSystemEnv(ctx, *authCtx.ProjectID, toolsetUUID, string(toolURN.Kind), toolURN.Source)
 	if err != nil {
 		return oops.E(oops.CodeUnexpected, err, "failed to load system environment").LogError(ctx, logger)
 	}
 
+	envNames, declared := plan.EnvVariables()
+	if !declared {
+		envNames = systemConfig.Keys()
+	}
+	err = s.env.ResolveSecretRefs(ctx, *authCtx.ProjectID, systemConfig, envNames)
+	if refErr, ok := errors.AsType[*toolconfig.SecretReferenceError](err); ok {
+		return oops.E(oops.CodeBadRequest, err, "%s", refErr.Error()).LogWarn(ctx, logger)
+	}
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "failed to resolve environment secret references").LogError(ctx, logger)
+	}
+
 	requestBody := r.Body
 	requestBodyBytes, err := io.ReadAll(requestBody)
 	if err != nil {
@@ -62,6 +62,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/mcp/sessionclientinfo"
 	"github.com/speakeasy-api/gram/server/internal/mcp/toolfilter"
 	"github.com/speakeasy-api/gram/server/internal/mcpaccess"
+	"github.com/speakeasy-api/gram/server/internal/mcpendpoints"
 	"github.com/speakeasy-api/gram/server/internal/mcpjsonrpc"
 	"github.com/speakeasy-api/gram/server/internal/mcpmetadata"
 	metadata_repo "github.com/speakeasy-api/gram/server/internal/mcpmetadata/repo"
@@ -78,7 +79,11 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/sessiontokens"
 	"github.com/speakeasy-api/gram/server/internal/shadowmcp"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/posthog"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals"
+	"github.com/speakeasy-api/gram/server/internal/toolcallrecordings"
 	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
 	toolsets_repo "github.com/speakeasy-api/gram/server/internal/toolsets/repo"
 	"github.com/speakeasy-api/gram/server/internal/usersessions/cimd"
 	"github.com/speakeasy-api/gram/server/internal/usersessions/cimd/admission"
@@ -126,6 +131,11 @@ type Service struct {
 	oauthRepo              *oauth_repo.Queries
 	billingTracker         billing.Tracker
 	billingRepository      billing.Repository
+	toolRateLimits         *toolratelimits.Enforcer
+	toolConstraints        *toolconstraints.Enforcer
+	toolApprovals          *toolapprovals.Gate
+	canaryRouting          *mcpendpoints.CanaryRoutingCache
+	toolCallRecorder       *toolcallrecordings.Recorder
 	toolsetCache           cache.TypedCacheObject[mv.ToolsetBaseContents]
 	telemLogger            *tm.Logger
 	vectorToolStore        *rag.ToolsetVectorStore
@@ -274,6 +284,10 @@ type mcpInputs struct {
 	// session row by the issuer gate. Nil means all tools; non-nil is always
 	// restrictive and intersects with the live toolset, ?tags=, and RBAC.
 	toolSelection *toolfilter.SessionSelection
+	// stream lets a held tools/call switch the response to SSE to elicit
+	// approval and keep the connection alive. Nil when the client does not
+	// accept text/event-stream, and for internal callers.
+	stream *eventStream
 }
 
 func NewService(
@@ -291,6 +305,7 @@ func NewService(
 	enc *encryption.Client,
 	cacheImpl cache.Cache,
 	guardianPolicy *guardian.Policy,
+	upstreams *gateway.UpstreamHealth,
 	funcCaller functions.ToolCaller,
 	billingTracker billing.Tracker,
 	billingRepository billing.Repository,
@@ -315,6 +330,11 @@ func NewService(
 	tunnelGatewayCIDRs []string,
 	redisClient *redis.Client,
 	tunnelPublicConfig TunnelPublicConfig,
+	toolRateLimits *toolratelimits.Enforcer,
+	toolConstraints *toolconstraints.Enforcer,
+	toolApprovals *toolapprovals.Gate,
+	toolCallRecorder *toolcallrecordings.Recorder,
+	canaryRouting *mcpendpoints.CanaryRoutingCache,
 ) *Service {
 	tracer := tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/mcp")
 	meter := meterProvider.Meter("github.com/speakeasy-api/gram/server/internal/mcp")
@@ -359,12 +379,18 @@ func NewService(
 			enc,
 			cacheImpl,
 			guardianPolicy,
+			upstreams,
 			funcCaller,
 			platformSvc,
 		),
 		oauthRepo:              oauth_repo.New(db),
 		billingTracker:         billingTracker,
 		billingRepository:      billingRepository,
+		toolRateLimits:         toolRateLimits,
+		toolConstraints:        toolConstraints,
+		toolApprovals:          toolApprovals,
+		canaryRouting:          canaryRouting,
+		toolCallRecorder:       toolCallRecorder,
 		toolsetCache:           cache.NewTypedObjectCache[mv.ToolsetBaseContents](logger.With(attr.SlogCacheNamespace("toolset")), cacheImpl, cache.SuffixNone),
 		telemLogger:            telemLogger,
 		vectorToolStore:        vectorToolStore,
@@ -1018,6 +1044,7 @@ func (s *Service) ServeToolsetResolved(w http.ResponseWriter, r *http.Request, t
 		tags:                  tags,
 		protocolVersion:       mcpversions.Resolve(mcprequests.DeclaredProtocolVersion(r.Header.Get(mcpversions.HTTPHeader), req.Params), mcpversions.SupportedHostedToolset()),
 		toolSelection:         callerToolSelection,
+		stream:                nil,
 	}
 
 	// Record the resolved variation group and requested tag filter for
@@ -1048,7 +1075,24 @@ func (s *Service) ServeToolsetResolved(w http.ResponseWriter, r *http.Request, t
 		return oops.C(oops.CodeUnauthorized)
 	}
 
+	// A response to a request this server sent, such as an approval
+	// elicitation, is routed back to whatever is waiting on it.
+	if isClientResponse(&req) {
+		if err := handleClientResponse(ctx, s.logger, s.toolApprovals, mcpInputs, &req); err != nil {
+			return err
+		}
+		return respondWithNoContent(true, w)
+	}
+
+	if req.Method == "tools/call" {
+		mcpInputs.stream = newEventStream(w, r)
+	}
+
 	body, err := s.handleRequest(ctx, mcpInputs, &req)
+	if mcpInputs.stream.Started() {
+		return streamFinal(ctx, s.logger, mcpInputs.stream, body, err)
+	}
+
 	switch {
 	case body == nil && err == nil:
 		return respondWithNoContent(true, w)
@@ -1062,6 +1106,12 @@ func (s *Service) ServeToolsetResolved(w http.ResponseWriter, r *http.Request, t
 			return oops.E(oops.CodeUnexpected, merr, "failed to serialize error response").LogError(ctx, s.logger)
 		}
 
+		if exceeded, ok := errors.AsType[*toolratelimits.ExceededError](err); ok {
+			for key, values := range exceeded.Headers() {
+				w.Header()[key] = values
+			}
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write(bs)
@@ -1337,7 +1387,7 @@ func (s *Service) handleRequest(ctx context.Context, payload *mcpInputs, req *ra
 	case "tools/list":
 		return handleToolsList(ctx, s.logger, s.authz, s.guardianPolicy, s.db, s.env, payload, req, s.posthog, &s.toolsetCache, s.vectorToolStore, s.temporal, s.shadowMCPClient, s.platformExtras, s.sessionClientInfo)
 	case "tools/call":
-		return handleToolsCall(ctx, s.logger, s.metrics, s.authz, s.guardianPolicy, s.db, s.env, payload, req, s.toolProxy, s.billingTracker, s.billingRepository, &s.toolsetCache, s.telemLogger, s.vectorToolStore, s.temporal, s.mcpMetadataRepo, s.auditLogger, s.platformExtras, s.sessionClientInfo)
+		return handleToolsCall(ctx, s.logger, s.metrics, s.authz, s.guardianPolicy, s.db, s.env, payload, req, s.toolProxy, s.billingTracker, s.billingRepository, s.toolRateLimits, s.toolConstraints, s.toolApprovals, s.toolCallRecorder, &s.toolsetCache, s.telemLogger, s.vectorToolStore, s.temporal, s.mcpMetadataRepo, s.auditLogger, s.platformExtras, s.sessionClientInfo)
 	case "prompts/list":
 		return handlePromptsList(ctx, s.logger, s.db, payload, req, &s.toolsetCache, s.platformExtras)
 	case "prompts/get":
@@ -1499,6 +1549,8 @@ func (s *Service) HandleToolsList(
 		ID:      mcpjsonrpc.NumberID(1),
 		Method:  "tools/list",
 		Params:  json.RawMessage("{}"),
+		Result:  nil,
+		Error:   nil,
 	}
 
 	// Call existing handleToolsList with all dependencies. Internal callers
@@ -1572,6 +1624,8 @@ func (s *Service) HandleToolsCall(
 		ID:      mcpjsonrpc.NumberID(1),
 		Method:  "tools/call",
 		Params:  params,
+		Result:  nil,
+		Error:   nil,
 	}
 
 	// Call existing handleToolsCall
@@ -1588,6 +1642,10 @@ func (s *Service) HandleToolsCall(
 		s.toolProxy,
 		s.billingTracker,
 		s.billingRepository,
+		s.toolRateLimits,
+		s.toolConstraints,
+		s.toolApprovals,
+		s.toolCallRecorder,
 		&s.toolsetCache,
 		s.telemLogger,
 		s.vectorToolStore,
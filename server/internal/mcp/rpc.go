@@ -163,6 +163,10 @@ type rawRequest struct {
 	ID      mcpjsonrpc.ID   `json:"id"`
 	Method  string          `json:"method"`
 	Params  json.RawMessage `json:"params"`
+	// Result and Error are set when the client POSTs a response to a request
+	// the server sent it, such as an approval elicitation.
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
 }
 
 func respondWithNoContent(ack bool, w http.ResponseWriter) error {
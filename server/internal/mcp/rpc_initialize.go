@@ -86,7 +86,7 @@ func handleInitialize(ctx context.Context, logger *slog.Logger, telemetry *mcpme
 	recordMCPProtocolVersionSpan(ctx, params.ProtocolVersion, negotiated)
 	telemetry.RecordMCPInitialize(ctx, params.ProtocolVersion, negotiated)
 
-	storeSessionClientInfo(ctx, logger, clientInfoStore, payload, params.ClientInfo.Name, params.ClientInfo.Version, params.ProtocolVersion)
+	storeSessionClientInfo(ctx, logger, clientInfoStore, payload, params.ClientInfo.Name, params.ClientInfo.Version, params.ProtocolVersion, slices.Contains(capabilities, elicitationCapability))
 
 	if requestContext, _ := contextvalues.GetRequestContext(ctx); requestContext != nil {
 		if err := productMetrics.CaptureEvent(ctx, "mcp_initialized", payload.sessionID, map[string]any{
@@ -161,6 +161,8 @@ func TestHandleInitialize_WritesNegotiatedVersionBackIntoPayload(t *testing.T) {
 		ID:      mcpjsonrpc.NumberID(1),
 		Method:  "initialize",
 		Params:  rawParams,
+		Result:  nil,
+		Error:   nil,
 	}
 
 	body, err := handleInitialize(t.Context(), testenv.NewLogger(t), nil, req, payload, nil, toolsets_repo.New(failingDBTX{}), metadata_repo.New(failingDBTX{}), store)
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"mime"
@@ -106,9 +107,11 @@ func handleResourcesRead(
 
 	ctx, logger = o11y.EnrichToolCallContext(ctx, logger, descriptor.OrganizationSlug, descriptor.ProjectSlug)
 
-	userConfig, err := resolveUserConfiguration(ctx, logger, env, payload, nil)
+	reads := envReads{names: plan.Function.Variables, all: false}
+
+	userConfig, err := resolveUserConfiguration(ctx, logger, env, payload, nil, reads)
 	if err != nil {
-		return nil, err
+		return nil, resourceReadError(err)
 	}
 
 	toolsetID, err := uuid.Parse(toolset.ID)
@@ -121,6 +124,10 @@ func handleResourcesRead(
 		return nil, oops.E(oops.CodeUnexpected, err, "failed to load system environment").LogError(ctx, logger)
 	}
 
+	if err := resolveSystemSecretRefs(ctx, logger, env, payload, reads, systemConfig); err != nil {
+		return nil, resourceReadError(err)
+	}
+
 	rw := &resourceResponseWriter{
 		statusCode: http.StatusOK,
 		headers:    make(http.Header),
@@ -352,3 +359,12 @@ func (rw *resourceResponseWriter) Write(b []byte) (int, error) {
 func (rw *resourceResponseWriter) WriteHeader(statusCode int) {
 	rw.statusCode = statusCode
 }
+
+// resourceReadError reports a refused read as a bad request, since resources
+// have no tool result to carry the refusal.
+func resourceReadError(err error) error {
+	if refusal, ok := errors.AsType[*toolCallRefusedError](err); ok {
+		return oops.E(oops.CodeBadRequest, refusal, "%s", refusal.message)
+	}
+	return err
+}
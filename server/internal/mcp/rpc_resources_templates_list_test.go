@@ -21,6 +21,8 @@ func TestHandleResourcesTemplatesList_ReturnsEmptyList(t *testing.T) {
 		ID:      mcpjsonrpc.NumberID(7),
 		Method:  "resources/templates/list",
 		Params:  nil,
+		Result:  nil,
+		Error:   nil,
 	})
 	require.NoError(t, err)
 
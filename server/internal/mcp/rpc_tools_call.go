@@ -24,6 +24,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/authz"
 	"github.com/speakeasy-api/gram/server/internal/billing"
 	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/canary"
 	"github.com/speakeasy-api/gram/server/internal/contenttypes"
 	"github.com/speakeasy-api/gram/server/internal/contextvalues"
 	"github.com/speakeasy-api/gram/server/internal/conv"
@@ -35,6 +36,8 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/mcp/mcprequests"
 	"github.com/speakeasy-api/gram/server/internal/mcp/toolfilter"
 	"github.com/speakeasy-api/gram/server/internal/mcpaccess"
+	"github.com/speakeasy-api/gram/server/internal/mcpapproval/capability"
+	"github.com/speakeasy-api/gram/server/internal/mcpjsonrpc"
 	"github.com/speakeasy-api/gram/server/internal/mcpmetadata"
 	mcpmetadata_repo "github.com/speakeasy-api/gram/server/internal/mcpmetadata/repo"
 	"github.com/speakeasy-api/gram/server/internal/mv"
@@ -46,7 +49,11 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/shadowmcp"
 	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
 	"github.com/speakeasy-api/gram/server/internal/temporal"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals"
+	"github.com/speakeasy-api/gram/server/internal/toolcallrecordings"
 	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
 	"github.com/speakeasy-api/gram/server/internal/toolsets"
 	"github.com/speakeasy-api/gram/server/internal/urn"
 )
@@ -80,6 +87,10 @@ func handleToolsCall(
 	toolProxy *gateway.ToolProxy,
 	billingTracker billing.Tracker,
 	billingRepository billing.Repository,
+	toolRateLimits *toolratelimits.Enforcer,
+	toolConstraints *toolconstraints.Enforcer,
+	toolApprovals *toolapprovals.Gate,
+	toolCallRecorder *toolcallrecordings.Recorder,
 	toolsetCache *cache.TypedCacheObject[mv.ToolsetBaseContents],
 	telemLogger *tm.Logger,
 	vectorToolStore *rag.ToolsetVectorStore,
@@ -235,41 +246,170 @@ func handleToolsCall(
 		}
 	}
 
+	pipeline := &toolCallPipeline{
+		authzEngine:       authzEngine,
+		db:                db,
+		env:               env,
+		payload:           payload,
+		reqMeta:           reqMeta,
+		toolProxy:         toolProxy,
+		billingTracker:    billingTracker,
+		billingRepository: billingRepository,
+		toolRateLimits:    toolRateLimits,
+		toolConstraints:   toolConstraints,
+		toolApprovals:     toolApprovals,
+		toolCallRecorder:  toolCallRecorder,
+		telemLogger:       telemLogger,
+		mcpMetadataRepo:   mcpMetadataRepo,
+		auditLogger:       auditLogger,
+		clientInfoStore:   clientInfoStore,
+		toolset:           toolset,
+		toolsetID:         toolsetID,
+		toolsetHelpers:    toolsetHelpers,
+		mcpURL:            mcpURL,
+	}
+
+	rw, err := pipeline.run(ctx, logger, toolCall{
+		name:      params.Name,
+		arguments: params.Arguments,
+		tool:      tool,
+		toolURN:   toolURN,
+		plan:      plan,
+	})
+	if err != nil {
+		if refusal, ok := errors.AsType[*toolCallRefusedError](err); ok {
+			return refusedToolCallResult(ctx, logger, req.ID, refusal.message)
+		}
+		return nil, err
+	}
+
+	var meta map[string]any
+	if tool != nil && tool.FunctionToolDefinition != nil {
+		meta = tool.FunctionToolDefinition.Meta
+	}
+
+	// External MCP tools and MCP passthrough tools already return properly formatted responses
+	if plan.Kind == gateway.ToolKindExternalMCP || isMCPPassthrough(meta) {
+		bs, err := json.Marshal(result[json.RawMessage]{
+			ID:             req.ID,
+			Result:         json.RawMessage(rw.body.Bytes()),
+			serverIdentity: serverInfoHostedToolset,
+		})
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize MCP result").LogError(ctx, logger)
+		}
+
+		return bs, nil
+	}
+
+	chunk, structured, err := formatResult(*rw, plan.Kind)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed format tool call result").LogError(ctx, logger)
+	}
+
+	bs, err := json.Marshal(result[toolCallResult]{
+		ID: req.ID,
+		Result: toolCallResult{
+			Content:           []json.RawMessage{chunk},
+			StructuredContent: structured,
+			IsError:           rw.statusCode < 200 || rw.statusCode >= 300,
+		},
+		serverIdentity: serverInfoHostedToolset,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize tools/call result").LogError(ctx, logger)
+	}
+
+	return bs, nil
+}
+
+// toolCall is one call for toolCallPipeline to run: the tool resolved from
+// the toolset, its plan and the arguments as the caller sent them.
+type toolCall struct {
+	name      string
+	arguments json.RawMessage
+	tool      *types.Tool
+	toolURN   urn.Tool
+	plan      *gateway.ToolCallPlan
+}
+
+// toolCallRefusedError reports a call that was refused before it reached the
+// tool. The MCP handler answers it with an isError result carrying message
+// rather than a JSON-RPC error, so the model can see why.
+type toolCallRefusedError struct {
+	message string
+}
+
+func (e *toolCallRefusedError) Error() string {
+	return e.message
+}
+
+// toolCallPipeline runs a resolved tool call through the per-tool checks of a
+// tools/call request — authorization, environment, usage and rate limits,
+// argument bindings, constraints and approvals — then executes it and records
+// its audit, billing and telemetry. It is shared by the call the client made
+// and by the steps of a server-executed workflow that call makes.
+type toolCallPipeline struct {
+	authzEngine       *authz.Engine
+	db                *pgxpool.Pool
+	env               toolconfig.EnvironmentLoader
+	payload           *mcpInputs
+	reqMeta           mcprequests.SanitizedMeta
+	toolProxy         *gateway.ToolProxy
+	billingTracker    billing.Tracker
+	billingRepository billing.Repository
+	toolRateLimits    *toolratelimits.Enforcer
+	toolConstraints   *toolconstraints.Enforcer
+	toolApprovals     *toolapprovals.Gate
+	toolCallRecorder  *toolcallrecordings.Recorder
+	telemLogger       *tm.Logger
+	mcpMetadataRepo   *mcpmetadata_repo.Queries
+	auditLogger       *audit.Logger
+	clientInfoStore   sessionClientInfoStore
+	toolset           *types.Toolset
+	toolsetID         uuid.UUID
+	toolsetHelpers    *toolsets.Toolsets
+	mcpURL            string
+}
+
+func (p *toolCallPipeline) run(ctx context.Context, logger *slog.Logger, call toolCall) (*toolCallResponseWriter, error) {
+	plan := call.plan
+
 	// Per-tool RBAC check: if the user is authenticated on a private MCP,
 	// verify they have mcp:connect for this specific tool (not just the server).
 	// The connection-level check only validates the server; this narrows to the
 	// tool and disposition dimensions. Public MCPs skip this — they're open to
 	// everyone, mirroring the connection-level guard in impl.go.
-	if payload.authenticated && authzEngine != nil && (toolset.McpIsPublic == nil || !*toolset.McpIsPublic) {
+	if p.payload.authenticated && p.authzEngine != nil && (p.toolset.McpIsPublic == nil || !*p.toolset.McpIsPublic) {
 		var disposition string
-		if tool != nil {
-			baseTool, err := conv.ToBaseTool(tool)
+		if call.tool != nil {
+			baseTool, err := conv.ToBaseTool(call.tool)
 			if err == nil {
 				disposition = conv.DispositionFromAnnotations(baseTool.Annotations)
 			}
 		}
-		if err := authzEngine.Require(ctx, authz.MCPToolCallCheck(toolset.ID, authz.MCPToolCallDimensions{
-			Tool:        params.Name,
+		if err := p.authzEngine.Require(ctx, authz.MCPToolCallCheck(p.toolset.ID, authz.MCPToolCallDimensions{
+			Tool:        call.name,
 			Disposition: disposition,
-			ProjectID:   payload.projectID.String(),
+			ProjectID:   p.payload.projectID.String(),
 		})); err != nil {
 			return nil, fmt.Errorf("authorize MCP tool call: %w", mcpaccess.ToolPermissionDenied(err))
 		}
 	}
 
-	userConfig, err := resolveUserConfiguration(ctx, logger, env, payload, plan)
+	userConfig, err := resolveUserConfiguration(ctx, logger, p.env, p.payload, plan, planEnvReads(plan))
 	if err != nil {
 		return nil, err
 	}
 
-	systemConfig, err := env.LoadSystemEnv(ctx, payload.projectID, toolsetID, string(toolURN.Kind), toolURN.Source)
+	systemConfig, err := p.env.LoadSystemEnv(ctx, p.payload.projectID, p.toolsetID, string(call.toolURN.Kind), call.toolURN.Source)
 	if err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "failed to load system environment").LogError(ctx, logger)
 	}
 
 	// Extract general OAuth token (no security-key binding)
 	var oauthToken string
-	for _, t := range payload.oauthTokenInputs {
+	for _, t := range p.payload.oauthTokenInputs {
 		if len(t.securityKeys) == 0 && t.Token != "" {
 			oauthToken = t.Token
 			break
@@ -277,24 +417,24 @@ func handleToolsCall(
 	}
 
 	var gramEmail string
-	if payload.authenticated {
+	if p.payload.authenticated {
 		if authCtx, ok := contextvalues.GetAuthContext(ctx); ok && authCtx.Email != nil {
 			gramEmail = *authCtx.Email
 		}
 	}
 
-	clientIdentity, _ := resolveClientIdentity(ctx, logger, clientInfoStore, payload, reqMeta.ClientInfo)
+	clientIdentity, _ := resolveClientIdentity(ctx, logger, p.clientInfoStore, p.payload, p.reqMeta.ClientInfo)
 
 	toolCallEnv := toolconfig.ToolCallEnv{
 		UserConfig: userConfig,
 		SystemEnv:  systemConfig,
 		OAuthToken: oauthToken,
 		GramEmail:  gramEmail,
-		GramChatID: payload.chatID,
+		GramChatID: p.payload.chatID,
 		MCPClient:  clientIdentity,
 	}
 
-	err = filterOmittedEnvVars(ctx, toolCallEnv, mcpMetadataRepo, toolsetID)
+	err = filterOmittedEnvVars(ctx, toolCallEnv, p.mcpMetadataRepo, p.toolsetID)
 	if err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "failed to filter omitted environment variables").LogError(ctx, logger)
 	}
@@ -309,15 +449,96 @@ func handleToolsCall(
 		statusCode: http.StatusOK,
 	}
 
-	requestBodyBytes := params.Arguments
+	requestBodyBytes := call.arguments
 	requestBytes := int64(len(requestBodyBytes))
 	var outputBytes int64
 	var functionCPU *float64
 	var functionMem *float64
 	var functionsExecutionTime *float64
 
-	err = checkToolUsageLimits(ctx, logger, toolset.OrganizationID, toolset.AccountType, billingRepository)
+	err = checkToolUsageLimits(ctx, logger, p.toolset.OrganizationID, p.toolset.AccountType, p.billingRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	mcpServerID := uuid.NullUUID{UUID: uuid.Nil, Valid: false}
+	if p.payload.mcpServerID != nil {
+		mcpServerID = uuid.NullUUID{UUID: *p.payload.mcpServerID, Valid: true}
+	}
+	if err := p.toolRateLimits.Check(ctx, toolratelimits.Call{
+		ProjectID:      p.payload.projectID,
+		ToolsetID:      uuid.NullUUID{UUID: p.toolsetID, Valid: true},
+		McpServerID:    mcpServerID,
+		ToolName:       call.name,
+		UserID:         p.payload.userID,
+		ExternalUserID: p.payload.externalUserID,
+		APIKeyID:       p.payload.apiKeyID,
+	}); err != nil {
+		return nil, err
+	}
+
+	// Constraints and approvals judge the arguments the tool will receive, so
+	// bound arguments are resolved first; otherwise a caller could satisfy a
+	// constraint with a value the binding then replaces.
+	plan = plan.WithArgumentBindings(toolsets.ToolArgumentBindings(call.tool), toolCallPrincipal(ctx, p.payload))
+
+	// Secrets are read only once the call has cleared its limits, and only
+	// for the variables the tool reads, including the ones its arguments are
+	// bound to.
+	if err := resolveSystemSecretRefs(ctx, logger, p.env, p.payload, planEnvReads(plan), systemConfig); err != nil {
+		return nil, err
+	}
+
+	boundArguments, err := gateway.BindArguments(call.arguments, toolCallEnv, plan.ArgumentBindings)
 	if err != nil {
+		if rejected, ok := toolCallRejection(ctx, logger, err, attr.SlogToolName(call.name)); ok {
+			return nil, rejected
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to bind tool arguments").LogError(ctx, logger, attr.SlogToolName(call.name))
+	}
+
+	if err := p.toolConstraints.Check(ctx, toolconstraints.Call{
+		OrganizationID: p.toolset.OrganizationID,
+		ProjectID:      p.payload.projectID,
+		ToolsetID:      uuid.NullUUID{UUID: p.toolsetID, Valid: true},
+		McpServerID:    mcpServerID,
+		ToolName:       call.name,
+		Arguments:      boundArguments,
+	}); err != nil {
+		if violation, ok := errors.AsType[*toolconstraints.ViolationError](err); ok {
+			return nil, &toolCallRefusedError{message: violation.Message()}
+		}
+		return nil, err
+	}
+
+	// Destructive tools are identified by their own annotations; proxied
+	// tools are only matched by policy expressions.
+	destructive := false
+	if call.tool != nil {
+		if baseTool, err := conv.ToBaseTool(call.tool); err == nil && baseTool.Annotations != nil {
+			destructive = capability.DeclaresDestructive(baseTool.Annotations.DestructiveHint)
+		}
+	}
+	requestedBy := gramEmail
+	if requestedBy == "" {
+		requestedBy = p.payload.externalUserID
+	}
+	if err := p.toolApprovals.Await(ctx, toolapprovals.Call{
+		OrganizationID: p.toolset.OrganizationID,
+		ProjectID:      p.payload.projectID,
+		ProjectSlug:    descriptor.ProjectSlug,
+		ToolsetID:      uuid.NullUUID{UUID: p.toolsetID, Valid: true},
+		ToolsetSlug:    p.payload.toolset,
+		McpServerID:    mcpServerID,
+		ToolName:       call.name,
+		Arguments:      boundArguments,
+		Destructive:    destructive,
+		SessionID:      p.payload.sessionID,
+		RequestedBy:    requestedBy,
+	}, newApprovalClient(logger, p.clientInfoStore, p.payload, p.reqMeta)); err != nil {
+		if refusal, ok := errors.AsType[*toolapprovals.RefusedError](err); ok {
+			return nil, &toolCallRefusedError{message: refusal.Message()}
+		}
 		return nil, err
 	}
 
@@ -325,35 +546,35 @@ func handleToolsCall(
 	// regardless of how the tool execution turns out. Ordinary user MCP
 	// traffic is not audited here.
 	if principal, ok := contextvalues.GetAssistantPrincipal(ctx); ok {
-		recordAssistantToolCallAudit(ctx, logger, auditLogger, db, assistantToolCallAudit{
-			organizationID: toolset.OrganizationID,
-			projectID:      payload.projectID,
+		recordAssistantToolCallAudit(ctx, logger, p.auditLogger, p.db, assistantToolCallAudit{
+			organizationID: p.toolset.OrganizationID,
+			projectID:      p.payload.projectID,
 			principal:      principal,
-			chatID:         payload.chatID,
-			toolsetSlug:    payload.toolset,
-			toolName:       params.Name,
-			toolURN:        toolURN,
-			params:         params.Arguments,
+			chatID:         p.payload.chatID,
+			toolsetSlug:    p.payload.toolset,
+			toolName:       call.name,
+			toolURN:        call.toolURN,
+			params:         call.arguments,
 		})
 	}
 
 	logAttrs := tm.HTTPLogAttributes{}
 	defer func() {
-		go billingTracker.TrackToolCallUsage(context.WithoutCancel(ctx), billing.ToolCallUsageEvent{
-			OrganizationID:        toolset.OrganizationID,
+		go p.billingTracker.TrackToolCallUsage(context.WithoutCancel(ctx), billing.ToolCallUsageEvent{
+			OrganizationID:        p.toolset.OrganizationID,
 			RequestBytes:          requestBytes,
 			OutputBytes:           outputBytes,
-			ToolURN:               toolURN.String(),
-			ToolName:              params.Name,
-			ProjectID:             payload.projectID.String(),
+			ToolURN:               call.toolURN.String(),
+			ToolName:              call.name,
+			ProjectID:             p.payload.projectID.String(),
 			ProjectSlug:           &descriptor.ProjectSlug,
 			OrganizationSlug:      &descriptor.OrganizationSlug,
-			ToolsetSlug:           &payload.toolset,
-			ToolsetID:             &toolset.ID,
+			ToolsetSlug:           &p.payload.toolset,
+			ToolsetID:             &p.toolset.ID,
 			ResponseStatusCode:    rw.statusCode,
-			MCPURL:                &mcpURL,
-			MCPSessionID:          &payload.sessionID,
-			ChatID:                conv.PtrEmpty(payload.chatID),
+			MCPURL:                &p.mcpURL,
+			MCPSessionID:          &p.payload.sessionID,
+			ChatID:                conv.PtrEmpty(p.payload.chatID),
 			Type:                  plan.BillingType,
 			ResourceURI:           "",
 			FunctionCPUUsage:      functionCPU,
@@ -369,24 +590,27 @@ func handleToolsCall(
 		logAttrs.RecordRequestBodyContent(requestBodyBytes)
 		logAttrs.RecordResponseBodyContent(rw.body.Bytes())
 
-		if payload.chatID != "" {
-			logAttrs[attr.GenAIConversationIDKey] = payload.chatID
+		if p.payload.chatID != "" {
+			logAttrs[attr.GenAIConversationIDKey] = p.payload.chatID
 		}
-		externalUserID := payload.externalUserID
+		externalUserID := p.payload.externalUserID
 		if externalUserID == "" && oauthToken != "" {
 			externalUserID = jwtclaims.UnsafeExtractSubject(oauthToken)
 		}
 		if externalUserID != "" {
 			logAttrs[attr.ExternalUserIDKey] = externalUserID
 		}
-		if payload.apiKeyID != "" {
-			logAttrs[attr.APIKeyIDKey] = payload.apiKeyID
+		if p.payload.apiKeyID != "" {
+			logAttrs[attr.APIKeyIDKey] = p.payload.apiKeyID
+		}
+		logAttrs.RecordToolsetSlug(p.payload.toolset)
+		if p.payload.mcpServerID != nil {
+			logAttrs[attr.McpServerIDKey] = p.payload.mcpServerID.String()
 		}
-		logAttrs.RecordToolsetSlug(payload.toolset)
-		if payload.mcpServerID != nil {
-			logAttrs[attr.McpServerIDKey] = payload.mcpServerID.String()
+		if arm, ok := canary.ArmFromContext(ctx); ok {
+			logAttrs[attr.CanaryArmKey] = string(arm)
 		}
-		logAttrs.RecordMCPURL(mcpURL)
+		logAttrs.RecordMCPURL(p.mcpURL)
 		params := tm.LogParams{
 			Timestamp: time.Now(),
 			ToolInfo: tm.ToolInfo{
@@ -398,19 +622,44 @@ func handleToolsCall(
 				OrganizationID: descriptor.OrganizationID,
 				FunctionID:     nil,
 			},
-			UserInfo:   tm.UserInfoByID(payload.userID),
+			UserInfo:   tm.UserInfoByID(p.payload.userID),
 			Attributes: logAttrs,
 		}
-		telemLogger.Log(ctx, params)
+		p.telemLogger.Log(ctx, params)
 	}()
 
-	err = toolProxy.Do(ctx, rw, bytes.NewBuffer(params.Arguments), toolCallEnv, plan, logAttrs)
+	if cachePolicy := toolResponseCachePolicy(call.tool, p.payload, oauthToken); cachePolicy != nil {
+		// Plans can be shared between calls, so the per-caller policy goes on
+		// a copy.
+		cachedPlan := *plan
+		cachedPlan.ResponseCache = cachePolicy
+		plan = &cachedPlan
+	}
+
+	if recorder := p.toolCallRecorder.ForToolCall(ctx, p.payload.projectID, p.toolsetID); recorder != nil {
+		recordedPlan := *plan
+		recordedPlan.Recorder = recorder
+		plan = &recordedPlan
+	}
+
+	// A server-executed workflow calls its steps' tools back through this
+	// pipeline, restricted to the tools of the same toolset. The step caller
+	// is per call, so it goes on a copy like the policies above.
+	if plan.Prompt != nil && plan.Prompt.Workflow != nil {
+		workflowPrompt := *plan.Prompt
+		workflowPrompt.StepCaller = &workflowStepCaller{logger: logger, pipeline: p}
+		workflowPlan := *plan
+		workflowPlan.Prompt = &workflowPrompt
+		plan = &workflowPlan
+	}
+
+	err = p.toolProxy.Do(ctx, rw, bytes.NewBuffer(call.arguments), toolCallEnv, plan, logAttrs)
 	if err != nil {
-		if rejected, ok := toolCallRejection(ctx, logger, err, attr.SlogToolName(params.Name)); ok {
+		if rejected, ok := toolCallRejection(ctx, logger, err, attr.SlogToolName(call.name)); ok {
 			recordToolCallErrorStatus(ctx, rw, rejected)
 			return nil, rejected
 		}
-		failure := oops.E(oops.CodeUnexpected, err, "failed to execute tool call").LogError(ctx, logger, attr.SlogToolName(params.Name))
+		failure := oops.E(oops.CodeUnexpected, err, "failed to execute tool call").LogError(ctx, logger, attr.SlogToolName(call.name))
 		recordToolCallErrorStatus(ctx, rw, failure)
 		return nil, failure
 	}
@@ -434,44 +683,79 @@ func handleToolsCall(
 		}
 	}
 
-	var meta map[string]any
-	if tool != nil && tool.FunctionToolDefinition != nil {
-		meta = tool.FunctionToolDefinition.Meta
-	}
+	return rw, nil
+}
 
-	// External MCP tools and MCP passthrough tools already return properly formatted responses
-	if plan.Kind == gateway.ToolKindExternalMCP || isMCPPassthrough(meta) {
-		bs, err := json.Marshal(result[json.RawMessage]{
-			ID:             req.ID,
-			Result:         json.RawMessage(rw.body.Bytes()),
-			serverIdentity: serverInfoHostedToolset,
-		})
+// workflowStepCaller runs the steps of a server-executed workflow tool through
+// the pipeline of the tools/call that invoked it, so each step is authorized,
+// limited, constrained, gated and recorded like a call the client made itself.
+// Steps may only call tools of the calling toolset.
+type workflowStepCaller struct {
+	logger   *slog.Logger
+	pipeline *toolCallPipeline
+}
+
+var _ gateway.WorkflowStepCaller = (*workflowStepCaller)(nil)
+
+func (c *workflowStepCaller) CallWorkflowStep(ctx context.Context, w http.ResponseWriter, toolURN urn.Tool, arguments map[string]any) error {
+	var tool *types.Tool
+	var name string
+	for _, t := range c.pipeline.toolset.Tools {
+		if conv.IsProxyTool(t) {
+			continue
+		}
+
+		u, err := conv.GetToolURN(*t)
+		if err != nil || u.String() != toolURN.String() {
+			continue
+		}
+
+		baseTool, err := conv.ToBaseTool(t)
 		if err != nil {
-			return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize MCP result").LogError(ctx, logger)
+			continue
 		}
 
-		return bs, nil
+		tool = t
+		name = baseTool.Name
+		break
+	}
+	if tool == nil {
+		return fmt.Errorf("tool %s is not in toolset %s", toolURN.String(), c.pipeline.payload.toolset)
 	}
 
-	chunk, structured, err := formatResult(*rw, plan.Kind)
+	plan, err := c.pipeline.toolsetHelpers.GetToolCallPlanByURN(ctx, toolURN, c.pipeline.payload.projectID)
 	if err != nil {
-		return nil, oops.E(oops.CodeUnexpected, err, "failed format tool call result").LogError(ctx, logger)
+		return fmt.Errorf("get tool call plan: %w", err)
 	}
 
-	bs, err := json.Marshal(result[toolCallResult]{
-		ID: req.ID,
-		Result: toolCallResult{
-			Content:           []json.RawMessage{chunk},
-			StructuredContent: structured,
-			IsError:           rw.statusCode < 200 || rw.statusCode >= 300,
-		},
-		serverIdentity: serverInfoHostedToolset,
+	body, err := gateway.WorkflowStepRequestBody(plan.Kind, arguments)
+	if err != nil {
+		return err
+	}
+
+	rw, err := c.pipeline.run(ctx, c.logger, toolCall{
+		name:      name,
+		arguments: body,
+		tool:      tool,
+		toolURN:   toolURN,
+		plan:      plan,
 	})
 	if err != nil {
-		return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize tools/call result").LogError(ctx, logger)
+		if refusal, ok := errors.AsType[*toolCallRefusedError](err); ok {
+			return fmt.Errorf("tool call refused: %s", refusal.message)
+		}
+		return err
 	}
 
-	return bs, nil
+	for k, v := range rw.headers {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(rw.statusCode)
+	if _, err := w.Write(rw.body.Bytes()); err != nil {
+		return fmt.Errorf("write step response: %w", err)
+	}
+
+	return nil
 }
 
 // toolCallRejection answers a failed tool execution that the caller has to fix
@@ -510,6 +794,7 @@ func resolveUserConfiguration(
 	env toolconfig.EnvironmentLoader,
 	payload *mcpInputs,
 	plan *gateway.ToolCallPlan,
+	reads envReads,
 ) (*toolconfig.CaseInsensitiveEnv, error) {
 	userConfig := toolconfig.NewCaseInsensitiveEnv()
 
@@ -527,6 +812,17 @@ func resolveUserConfiguration(
 		for k, v := range storedEnvVars {
 			userConfig.Set(k, v)
 		}
+
+		// Only stored values are resolved: a reference the client sends is
+		// passed through as written, never read with the project's access.
+		err = env.ResolveSecretRefs(ctx, payload.projectID, userConfig, reads.in(userConfig))
+		var refErr *toolconfig.SecretReferenceError
+		switch {
+		case errors.As(err, &refErr):
+			return nil, &toolCallRefusedError{message: refErr.Error()}
+		case err != nil:
+			return nil, oops.E(oops.CodeUnexpected, err, "failed to resolve environment secret references").LogError(ctx, logger)
+		}
 	}
 
 	for k, v := range payload.mcpEnvVariables {
@@ -560,6 +856,55 @@ func resolveUserConfiguration(
 	return userConfig, nil
 }
 
+// envReads names the environment variables a call reads, so that only their
+// secret references are resolved. all is set when the call may read any of
+// them.
+type envReads struct {
+	names []string
+	all   bool
+}
+
+func planEnvReads(plan *gateway.ToolCallPlan) envReads {
+	names, ok := plan.EnvVariables()
+	return envReads{names: names, all: !ok}
+}
+
+func (r envReads) in(env *toolconfig.CaseInsensitiveEnv) []string {
+	if r.all {
+		return env.Keys()
+	}
+	return r.names
+}
+
+// resolveSystemSecretRefs resolves the secret references among the system
+// environment variables a call reads. Public callers are only told that a
+// secret could not be read: the reference and the secret manager's answer
+// describe how the project stores its secrets, so they go to the log.
+func resolveSystemSecretRefs(
+	ctx context.Context,
+	logger *slog.Logger,
+	env toolconfig.EnvironmentLoader,
+	payload *mcpInputs,
+	reads envReads,
+	systemEnv *toolconfig.CaseInsensitiveEnv,
+) error {
+	err := env.ResolveSecretRefs(ctx, payload.projectID, systemEnv, reads.in(systemEnv))
+	if err == nil {
+		return nil
+	}
+
+	refErr, ok := errors.AsType[*toolconfig.SecretReferenceError](err)
+	switch {
+	case !ok:
+		return oops.E(oops.CodeUnexpected, err, "failed to resolve environment secret references").LogError(ctx, logger)
+	case !payload.authenticated:
+		logger.WarnContext(ctx, "secret reference could not be resolved for public caller", attr.SlogEnvVarName(refErr.Name), attr.SlogError(refErr))
+		return &toolCallRefusedError{message: "a secret this tool needs could not be read; contact the server's owner"}
+	default:
+		return &toolCallRefusedError{message: refErr.Error()}
+	}
+}
+
 func checkToolUsageLimits(ctx context.Context, logger *slog.Logger, orgID string, accountType string, billingRepository billing.Repository) error {
 	if accountType != string(billing.TierBase) {
 		return nil
@@ -806,3 +1151,34 @@ func filterOmittedEnvVars(
 
 	return nil
 }
+
+// refusedToolCallResult answers a call refused before dispatch, by an
+// argument constraint, an approval policy or a secret reference that could not
+// be resolved, with a tool error rather than a protocol error, so the model
+// sees why and can act on it.
+func refusedToolCallResult(ctx context.Context, logger *slog.Logger, reqID mcpjsonrpc.ID, message string) (json.RawMessage, error) {
+	chunk, err := json.Marshal(contentChunk[string, json.RawMessage]{
+		Type:     "text",
+		MimeType: nil,
+		Text:     message,
+		Data:     nil,
+		Meta:     nil,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize refused tool call content").LogError(ctx, logger)
+	}
+
+	bs, err := json.Marshal(&result[toolCallResult]{
+		ID: reqID,
+		Result: toolCallResult{
+			Content:           []json.RawMessage{chunk},
+			StructuredContent: nil,
+			IsError:           true,
+		},
+		serverIdentity: serverInfoHostedToolset,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to serialize tools/call response").LogError(ctx, logger)
+	}
+	return bs, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 
 	"github.com/google/uuid"
@@ -245,8 +246,15 @@ func buildToolListEntries(
 			return plan.ExternalMCP, nil
 		}
 
-		loadSystemEnv := func(ctx context.Context, toolURN urn.Tool) (*toolconfig.CaseInsensitiveEnv, error) {
-			return envLoader.LoadSystemEnv(ctx, payload.projectID, toolsetID, string(toolURN.Kind), toolURN.Source)
+		loadSystemEnv := func(ctx context.Context, toolURN urn.Tool, names []string) (*toolconfig.CaseInsensitiveEnv, error) {
+			systemEnv, err := envLoader.LoadSystemEnv(ctx, payload.projectID, toolsetID, string(toolURN.Kind), toolURN.Source)
+			if err != nil {
+				return nil, fmt.Errorf("load system environment: %w", err)
+			}
+			if err := envLoader.ResolveSecretRefs(ctx, payload.projectID, systemEnv, names); err != nil {
+				return nil, fmt.Errorf("resolve secret references: %w", err)
+			}
+			return systemEnv, nil
 		}
 
 		proxyTools, err := executor.DoList(ctx, payload.projectID, userConfig, oauthToken, loadSystemEnv, resolve)
@@ -32,6 +32,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/platformtools"
 	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
 	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+	"github.com/speakeasy-api/gram/server/internal/toolsets"
 )
 
 // PlatformToolsetRoute is the chi route pattern reserved for platform
@@ -528,6 +529,20 @@ func (s *Service) callPlatformToolsetTool(
 		})
 	}()
 
+	// Platform tools are built here rather than by the plan resolver, so the
+	// project's default bindings for them are loaded directly.
+	argumentBindings, err := toolsets.NewToolsets(s.db).DefaultArgumentBindings(ctx, descriptor.URN, *authCtx.ProjectID)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to load tool argument bindings").LogError(ctx, logger, attr.SlogToolName(params.Name))
+	}
+	plan = plan.WithArgumentBindings(argumentBindings, toolconfig.Principal{
+		Email:            gramEmail,
+		UserID:           authCtx.UserID,
+		ExternalUserID:   "",
+		OrganizationID:   authCtx.ActiveOrganizationID,
+		OrganizationSlug: authCtx.OrganizationSlug,
+	})
+
 	if err := s.toolProxy.Do(ctx, rw, bytes.NewReader(requestBodyBytes), toolCallEnv, plan, logAttrs); err != nil {
 		failure := platformToolCallError(ctx, logger, err, attr.SlogToolName(params.Name))
 		recordToolCallErrorStatus(ctx, rw, failure)
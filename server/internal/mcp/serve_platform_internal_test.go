@@ -32,6 +32,8 @@ func runPlatformInitialize(t *testing.T, requested string) (string, mcpversions.
 		ID:      mcpjsonrpc.NumberID(1),
 		Method:  "initialize",
 		Params:  rawParams,
+		Result:  nil,
+		Error:   nil,
 	}
 
 	// A conforming handshake request declares no version, so entry-time
@@ -95,6 +97,8 @@ func TestHandlePlatformInitialize_MalformedParamsNegotiateTheDefault(t *testing.
 		ID:      mcpjsonrpc.NumberID(1),
 		Method:  "initialize",
 		Params:  json.RawMessage(`["unexpected"]`),
+		Result:  nil,
+		Error:   nil,
 	}
 	resolution := mcpversions.Resolve("", mcpversions.SupportedPlatformToolset())
 
@@ -13,6 +13,7 @@ import (
 
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/canary"
 	"github.com/speakeasy-api/gram/server/internal/contextvalues"
 	"github.com/speakeasy-api/gram/server/internal/customdomains"
 	customdomainsrepo "github.com/speakeasy-api/gram/server/internal/customdomains/repo"
@@ -25,6 +26,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/mcpservers"
 	mcpserversrepo "github.com/speakeasy-api/gram/server/internal/mcpservers/repo"
 	metamcprepo "github.com/speakeasy-api/gram/server/internal/metamcp/repo"
+	"github.com/speakeasy-api/gram/server/internal/mv"
 	"github.com/speakeasy-api/gram/server/internal/oops"
 	projectsrepo "github.com/speakeasy-api/gram/server/internal/projects/repo"
 	"github.com/speakeasy-api/gram/server/internal/remotemcp"
@@ -220,6 +222,29 @@ func (s *Service) serveResolvedMCPEndpoint(
 			mcpServerVariationsGroupID = &id
 		}
 
+		// A toolset version pinned on the endpoint wins over one pinned on the
+		// mcp_server; with neither, the toolset's latest version is served.
+		toolsetVersion := mcpServer.ToolsetVersion
+		if mcpEndpoint.ToolsetVersion.Valid {
+			toolsetVersion = mcpEndpoint.ToolsetVersion
+		}
+		if toolsetVersion.Valid {
+			ctx = mv.WithPinnedToolsetVersion(ctx, toolset.ID, toolsetVersion.Int64)
+			r = r.WithContext(ctx)
+		}
+
+		// An endpoint under a canary pins its tool lookups to the deployment
+		// the caller's arm was assigned; everything else follows the active
+		// deployment as before.
+		split, err := s.canaryRouting.Split(ctx, logger, mcpEndpoint)
+		if err != nil {
+			return err
+		}
+		if split != nil {
+			ctx = canary.WithSplit(ctx, *split, r.Header.Get("Mcp-Session-Id"))
+			r = r.WithContext(ctx)
+		}
+
 		if err := s.ServeToolsetResolved(w, r, &toolset, slug, mcpRouteBase, issuerGated, upstreamTokens, sessionToolSelection, mcpServerVariationsGroupID, &mcpServer.ID); err != nil {
 			return fmt.Errorf("serve toolset-backed mcp: %w", err)
 		}
@@ -34,10 +34,11 @@ type sessionClientInfoStore interface {
 // generation for the rest of its session, which is the more useful of the two
 // for diagnosing version-specific behaviour. Admitting those records does not
 // affect how much can be stored: the per-server record cap is what bounds that.
-func storeSessionClientInfo(ctx context.Context, logger *slog.Logger, store sessionClientInfoStore, payload *mcpInputs, name, version, protocolVersion string) {
+// elicitation records whether the client declared the elicitation capability.
+func storeSessionClientInfo(ctx context.Context, logger *slog.Logger, store sessionClientInfoStore, payload *mcpInputs, name, version, protocolVersion string, elicitation bool) {
 	name = mcprequests.SanitizeClientInfoField(name)
 	protocolVersion = mcpversions.Sanitize(protocolVersion)
-	if payload.sessionID == "" || (name == "" && protocolVersion == "") {
+	if payload.sessionID == "" || (name == "" && protocolVersion == "" && !elicitation) {
 		return
 	}
 
@@ -45,6 +46,7 @@ func storeSessionClientInfo(ctx context.Context, logger *slog.Logger, store sess
 		Name:            name,
 		Version:         mcprequests.SanitizeClientInfoField(version),
 		ProtocolVersion: protocolVersion,
+		Elicitation:     elicitation,
 	}, time.Now().UnixMilli())
 	if err != nil {
 		logger.WarnContext(ctx, "failed to record mcp session client info", attr.SlogError(err))
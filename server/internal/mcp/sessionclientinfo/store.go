@@ -49,6 +49,11 @@ type Info struct {
 	// protocol generation without the client having to repeat it. Empty when
 	// the client omits it, which callers treat as "generation unknown".
 	ProtocolVersion string `json:"protocol_version"`
+
+	// Elicitation records that the client declared the elicitation capability
+	// at initialize, so a held tools/call later in the session knows it can
+	// ask the user to approve it.
+	Elicitation bool `json:"elicitation,omitempty"`
 }
 
 // Store reads and writes session identities in Redis.
@@ -76,7 +76,7 @@ func TestResolveClientIdentity_FallsBackToHandshake(t *testing.T) {
 	logger := testenv.NewLogger(t)
 	store, payload := newClientIdentityFixture(t)
 
-	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20250618)
+	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20250618, false)
 
 	identity, storedProtocolVersion := resolveClientIdentity(ctx, logger, store, payload, nil)
 	require.Equal(t, toolconfig.MCPClientIdentity{
@@ -99,7 +99,7 @@ func TestResolveClientIdentity_HintPathReportsNoStoredVersion(t *testing.T) {
 	logger := testenv.NewLogger(t)
 	store, payload := newClientIdentityFixture(t)
 
-	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20250618)
+	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20250618, false)
 
 	_, storedProtocolVersion := resolveClientIdentity(ctx, logger, store, payload, &mcprequests.SanitizedClientInfo{
 		Name:    "per-call-client",
@@ -119,7 +119,7 @@ func TestResolveClientIdentity_PerCallHintWins(t *testing.T) {
 	logger := testenv.NewLogger(t)
 	store, payload := newClientIdentityFixture(t)
 
-	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20250618)
+	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20250618, false)
 
 	identity, _ := resolveClientIdentity(ctx, logger, store, payload, &mcprequests.SanitizedClientInfo{
 		Name:    "per-call-client",
@@ -138,7 +138,7 @@ func TestResolveClientIdentity_NamelessHintFallsBack(t *testing.T) {
 	logger := testenv.NewLogger(t)
 	store, payload := newClientIdentityFixture(t)
 
-	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20250618)
+	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20250618, false)
 
 	identity, _ := resolveClientIdentity(ctx, logger, store, payload, &mcprequests.SanitizedClientInfo{
 		Name:    "",
@@ -173,7 +173,7 @@ func TestStoreSessionClientInfo_BoundsRecordedFields(t *testing.T) {
 	logger := testenv.NewLogger(t)
 	store, payload := newClientIdentityFixture(t)
 
-	storeSessionClientInfo(ctx, logger, store, payload, "ev\x00il\nclient", "1.\t0", "2025-06-18\x00injected")
+	storeSessionClientInfo(ctx, logger, store, payload, "ev\x00il\nclient", "1.\t0", "2025-06-18\x00injected", false)
 
 	info, err := store.Load(ctx, payload.projectID, payload.toolset, payload.sessionID, 0)
 	require.NoError(t, err)
@@ -189,7 +189,7 @@ func TestStoreSessionClientInfo_AnonymousClientRecordsNothing(t *testing.T) {
 	logger := testenv.NewLogger(t)
 	store, payload := newClientIdentityFixture(t)
 
-	storeSessionClientInfo(ctx, logger, store, payload, "", "1.2.3", "")
+	storeSessionClientInfo(ctx, logger, store, payload, "", "1.2.3", "", false)
 
 	_, err := store.Load(ctx, payload.projectID, payload.toolset, payload.sessionID, 0)
 	require.ErrorIs(t, err, sessionclientinfo.ErrNotFound)
@@ -206,7 +206,7 @@ func TestStoreSessionClientInfo_NamelessClientStillRecordsProtocolVersion(t *tes
 	logger := testenv.NewLogger(t)
 	store, payload := newClientIdentityFixture(t)
 
-	storeSessionClientInfo(ctx, logger, store, payload, "", "", mcpversions.Version20250618)
+	storeSessionClientInfo(ctx, logger, store, payload, "", "", mcpversions.Version20250618, false)
 
 	info, err := store.Load(ctx, payload.projectID, payload.toolset, payload.sessionID, 0)
 	require.NoError(t, err)
@@ -221,7 +221,7 @@ func TestStoreSessionClientInfo_RecordsProtocolVersionAlongsideIdentity(t *testi
 	logger := testenv.NewLogger(t)
 	store, payload := newClientIdentityFixture(t)
 
-	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20251125)
+	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20251125, false)
 
 	info, err := store.Load(ctx, payload.projectID, payload.toolset, payload.sessionID, 0)
 	require.NoError(t, err)
@@ -266,7 +266,7 @@ func TestResolveClientIdentity_StampsBothProtocolVersions(t *testing.T) {
 	logger := testenv.NewLogger(t)
 	store, payload := newClientIdentityFixture(t)
 
-	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20241105)
+	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20241105, false)
 
 	recorder := tracetest.NewSpanRecorder()
 	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
@@ -301,7 +301,7 @@ func TestResolveClientIdentity_DerivesDowngradedNegotiatedVersion(t *testing.T)
 	logger := testenv.NewLogger(t)
 	store, payload := newClientIdentityFixture(t)
 
-	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20260728)
+	storeSessionClientInfo(ctx, logger, store, payload, "handshake-client", "1.2.3", mcpversions.Version20260728, false)
 
 	recorder := tracetest.NewSpanRecorder()
 	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
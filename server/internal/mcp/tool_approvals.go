@@ -0,0 +1,246 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/mcp/mcprequests"
+	"github.com/speakeasy-api/gram/server/internal/mcp/sessionclientinfo"
+	"github.com/speakeasy-api/gram/server/internal/mcpjsonrpc"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals"
+)
+
+// elicitationCapability is the client capability key that advertises support
+// for server-initiated elicitation/create requests.
+const elicitationCapability = "elicitation"
+
+// eventStream upgrades a Streamable HTTP response to text/event-stream the
+// first time the server needs to speak before the final response: to send an
+// elicitation/create request or to keep a held tools/call alive. A response
+// that never needs it stays plain JSON.
+type eventStream struct {
+	mu      sync.Mutex
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	started bool
+}
+
+// newEventStream returns nil unless the client accepts an SSE response.
+func newEventStream(w http.ResponseWriter, r *http.Request) *eventStream {
+	if !strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		return nil
+	}
+
+	return &eventStream{
+		mu:      sync.Mutex{},
+		w:       w,
+		rc:      http.NewResponseController(w),
+		started: false,
+	}
+}
+
+// Started reports whether the response has switched to SSE, after which the
+// final response must be written with streamFinal.
+func (s *eventStream) Started() bool {
+	if s == nil {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.started
+}
+
+// Message writes one JSON-RPC message as an SSE event.
+func (s *eventStream) Message(msg json.RawMessage) error {
+	var frame bytes.Buffer
+	frame.WriteString("event: message\ndata: ")
+	frame.Write(bytes.ReplaceAll(msg, []byte("\n"), []byte("\ndata: ")))
+	frame.WriteString("\n\n")
+
+	return s.write(frame.Bytes())
+}
+
+// Keepalive writes an SSE comment so proxies do not reap an idle connection
+// while a call is held.
+func (s *eventStream) Keepalive() error {
+	return s.write([]byte(": keepalive\n\n"))
+}
+
+func (s *eventStream) write(frame []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		s.w.Header().Set("Content-Type", "text/event-stream")
+		s.w.Header().Set("Cache-Control", "no-cache")
+		s.w.WriteHeader(http.StatusOK)
+		s.started = true
+	}
+
+	if _, err := s.w.Write(frame); err != nil {
+		return fmt.Errorf("write event stream: %w", err)
+	}
+	if err := s.rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return fmt.Errorf("flush event stream: %w", err)
+	}
+
+	return nil
+}
+
+// approvalClient adapts a tools/call's event stream to the approval gate.
+type approvalClient struct {
+	logger    *slog.Logger
+	stream    *eventStream
+	canElicit func(ctx context.Context) bool
+}
+
+var _ toolapprovals.ClientChannel = (*approvalClient)(nil)
+
+// newApprovalClient returns nil when the response cannot stream, which leaves
+// the gate to fall back to an approval link.
+func newApprovalClient(logger *slog.Logger, store sessionClientInfoStore, payload *mcpInputs, reqMeta mcprequests.SanitizedMeta) toolapprovals.ClientChannel {
+	if payload.stream == nil {
+		return nil
+	}
+
+	return &approvalClient{
+		logger: logger,
+		stream: payload.stream,
+		canElicit: func(ctx context.Context) bool {
+			return clientSupportsElicitation(ctx, logger, store, payload, reqMeta)
+		},
+	}
+}
+
+func (c *approvalClient) CanElicit(ctx context.Context) bool {
+	return c.canElicit(ctx)
+}
+
+func (c *approvalClient) Elicit(ctx context.Context, requestID string, message string) error {
+	bs, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      requestID,
+		"method":  "elicitation/create",
+		"params": map[string]any{
+			"message": message,
+			"requestedSchema": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"approve": map[string]any{
+						"type":        "boolean",
+						"title":       "Approve",
+						"description": "Allow this tool call to run.",
+					},
+				},
+				"required": []string{"approve"},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("encode elicitation request: %w", err)
+	}
+
+	return c.stream.Message(bs)
+}
+
+func (c *approvalClient) Keepalive(ctx context.Context) {
+	if err := c.stream.Keepalive(); err != nil {
+		c.logger.DebugContext(ctx, "failed to write keepalive while awaiting approval", attr.SlogError(err))
+	}
+}
+
+// clientSupportsElicitation reports whether the caller can answer an
+// elicitation: either this request's `_meta` declares the capability, or the
+// session declared it at initialize.
+func clientSupportsElicitation(ctx context.Context, logger *slog.Logger, store sessionClientInfoStore, payload *mcpInputs, reqMeta mcprequests.SanitizedMeta) bool {
+	if slices.Contains(reqMeta.CapabilityKeys, elicitationCapability) {
+		return true
+	}
+	if store == nil || payload.sessionID == "" {
+		return false
+	}
+
+	info, err := store.Load(ctx, payload.projectID, payload.toolset, payload.sessionID, time.Now().UnixMilli())
+	switch {
+	case errors.Is(err, sessionclientinfo.ErrNotFound):
+		return false
+	case err != nil:
+		logger.WarnContext(ctx, "failed to load mcp session client info", attr.SlogError(err))
+		return false
+	}
+
+	return info.Elicitation
+}
+
+// elicitationResult is the client's answer to an approval elicitation.
+type elicitationResult struct {
+	Action  string `json:"action"`
+	Content struct {
+		Approve bool `json:"approve"`
+	} `json:"content"`
+}
+
+// handleClientResponse consumes a JSON-RPC response the client POSTed back to
+// a request the server sent it. Only answers to approval elicitations are
+// meaningful; anything else is acknowledged and dropped, as the transport
+// requires.
+func handleClientResponse(ctx context.Context, logger *slog.Logger, gate *toolapprovals.Gate, payload *mcpInputs, req *rawRequest) error {
+	approved := false
+	if len(req.Result) > 0 {
+		var res elicitationResult
+		if err := json.Unmarshal(req.Result, &res); err != nil {
+			logger.WarnContext(ctx, "failed to decode elicitation result", attr.SlogError(err))
+		}
+		approved = res.Action == "accept" && res.Content.Approve
+	}
+
+	if _, err := gate.RecordElicitationDecision(ctx, req.ID.Value(), payload.sessionID, approved); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// isClientResponse reports whether req is a JSON-RPC response rather than a
+// request or notification.
+func isClientResponse(req *rawRequest) bool {
+	return req.Method == "" && req.ID.IsSet() && (len(req.Result) > 0 || len(req.Error) > 0)
+}
+
+// streamFinal writes the final tools/call answer, or the error it failed
+// with, onto a response that already switched to SSE.
+func streamFinal(ctx context.Context, logger *slog.Logger, stream *eventStream, body json.RawMessage, callErr error) error {
+	if callErr != nil {
+		mcpID := mcpjsonrpc.NullID()
+		if rpcCtx, ok := contextvalues.GetRPCContext(ctx); ok && rpcCtx.ID.IsSet() {
+			mcpID = rpcCtx.ID
+		}
+		bs, err := json.Marshal(oops.NewMCPErrorFromCause(mcpID, callErr))
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "failed to serialize error response").LogError(ctx, logger)
+		}
+		body = bs
+	}
+	if len(body) == 0 {
+		return nil
+	}
+
+	if err := stream.Message(body); err != nil {
+		logger.WarnContext(ctx, "failed to write final event stream message", attr.SlogError(err))
+	}
+
+	return nil
+}
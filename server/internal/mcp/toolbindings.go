@@ -0,0 +1,30 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+// toolCallPrincipal returns the caller that bound tool arguments resolve
+// against. Claims of the Gram user are only available on authenticated
+// requests, and only verified identities are used: an unverified OAuth token
+// subject never becomes a claim.
+func toolCallPrincipal(ctx context.Context, payload *mcpInputs) toolconfig.Principal {
+	principal := toolconfig.Principal{
+		Email:            "",
+		UserID:           payload.userID,
+		ExternalUserID:   payload.externalUserID,
+		OrganizationID:   "",
+		OrganizationSlug: "",
+	}
+	if authCtx, ok := contextvalues.GetAuthContext(ctx); ok && authCtx != nil && payload.authenticated {
+		principal.Email = conv.PtrValOr(authCtx.Email, "")
+		principal.OrganizationID = authCtx.ActiveOrganizationID
+		principal.OrganizationSlug = authCtx.OrganizationSlug
+	}
+
+	return principal
+}
@@ -0,0 +1,64 @@
+package mcp
+
+import (
+	"time"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+	"github.com/speakeasy-api/gram/server/internal/oauth/jwtclaims"
+)
+
+// toolResponseCachePolicy returns the response cache policy for a call to the
+// given tool, or nil when the call must not be cached. Caching is opt-in per
+// tool through its variation's cache TTL, and only applies to tools annotated
+// as read-only or idempotent.
+func toolResponseCachePolicy(tool *types.Tool, payload *mcpInputs, oauthToken string) *gateway.ResponseCachePolicy {
+	if tool == nil {
+		return nil
+	}
+
+	baseTool, err := conv.ToBaseTool(tool)
+	if err != nil || baseTool.Variation == nil || baseTool.Variation.CacheTTLSeconds == nil {
+		return nil
+	}
+
+	annotations := baseTool.Annotations
+	if annotations == nil {
+		return nil
+	}
+	readOnly := annotations.ReadOnlyHint != nil && *annotations.ReadOnlyHint
+	idempotent := annotations.IdempotentHint != nil && *annotations.IdempotentHint
+	if !readOnly && !idempotent {
+		return nil
+	}
+
+	return &gateway.ResponseCachePolicy{
+		TTL:      time.Duration(*baseTool.Variation.CacheTTLSeconds) * time.Second,
+		Shared:   baseTool.Variation.CacheShared != nil && *baseTool.Variation.CacheShared,
+		Identity: toolCallerIdentity(payload, oauthToken),
+	}
+}
+
+// toolCallerIdentity returns a stable identifier for the caller of a tool, or
+// an empty string when the caller is anonymous.
+func toolCallerIdentity(payload *mcpInputs, oauthToken string) string {
+	switch {
+	case payload.userID != "":
+		return "user:" + payload.userID
+	case payload.externalUserID != "":
+		return "external:" + payload.externalUserID
+	}
+
+	if oauthToken != "" {
+		if subject := jwtclaims.UnsafeExtractSubject(oauthToken); subject != "" {
+			return "external:" + subject
+		}
+	}
+
+	if payload.apiKeyID != "" {
+		return "apikey:" + payload.apiKeyID
+	}
+
+	return ""
+}
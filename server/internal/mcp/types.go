@@ -105,5 +105,6 @@ func (m *McpInputs) toInternal() *mcpInputs {
 		tags:                  nil,
 		protocolVersion:       mcpversions.Resolve("", mcpversions.SupportedHostedToolset()),
 		toolSelection:         nil,
+		stream:                nil,
 	}
 }
@@ -418,6 +418,15 @@ func (s *Service) admit(ctx context.Context, projectID uuid.UUID, organizationID
 		}
 	}
 
+	// Only a real ask is announced. An unreviewed dossier is Gram gathering
+	// evidence on its own initiative, which is nothing for a reviewer to act
+	// on yet.
+	if adm.status == statusRequested {
+		if err := publishRequested(ctx, dbtx, request, adm); err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "error recording approval request").LogError(ctx, s.logger)
+		}
+	}
+
 	if err := dbtx.Commit(ctx); err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "error recording approval request").LogError(ctx, s.logger)
 	}
@@ -1339,6 +1348,10 @@ func (s *Service) RecordDecision(ctx context.Context, payload *gen.RecordDecisio
 		return nil, oops.E(oops.CodeUnexpected, err, "error auditing decision").LogError(ctx, s.logger)
 	}
 
+	if err := publishDecided(ctx, dbtx, request, projectID, decision); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error recording decision").LogError(ctx, s.logger)
+	}
+
 	if err := queries.SetApprovalRequestStatus(ctx, repo.SetApprovalRequestStatusParams{
 		ID:        requestID,
 		ProjectID: projectID,
@@ -101,6 +101,7 @@ func (p *Probe) ListToolDeclarations(ctx context.Context, serverURL string) ([]c
 		Authorization:  "",
 		Headers:        nil,
 		DisableRetries: true,
+		Proxy:          nil,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("connect for tool declarations: %w", err)
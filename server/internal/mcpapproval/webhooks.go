@@ -0,0 +1,63 @@
+package mcpapproval
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mcpapproval/repo"
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+	"github.com/speakeasy-api/gram/server/internal/outbox/events"
+)
+
+// publishRequested enqueues the customer-facing webhook for a real ask. It
+// runs in the admission's transaction, so the event exists only if the ask
+// was recorded.
+func publishRequested(ctx context.Context, dbtx outbox.DBTX, request repo.UpsertApprovalRequestRow, adm admission) error {
+	_, err := outbox.PublishWebhookEvent(ctx, dbtx, request.OrganizationID, events.McpApprovalRequestRequestedV1, events.McpApprovalRequestRequestedPayloadV1{
+		ID:             request.ID,
+		OrganizationID: request.OrganizationID,
+		ProjectID:      request.ProjectID,
+		Target:         request.TargetRaw,
+		TargetKind:     request.TargetKind,
+		RequesterID:    adm.requesterID,
+		RequesterEmail: conv.PtrValOr(adm.requesterEmail, ""),
+		Note:           conv.PtrValOr(adm.note, ""),
+		RequestedAt:    time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("publish approval request webhook: %w", err)
+	}
+
+	return nil
+}
+
+// publishDecided enqueues the customer-facing webhook for a decision, in the
+// decision's transaction.
+func publishDecided(ctx context.Context, dbtx outbox.DBTX, request repo.GetApprovalRequestForDecisionRow, projectID uuid.UUID, decision repo.McpApprovalDecision) error {
+	decidedAt := time.Now().UTC()
+	if decision.DecidedAt.Valid {
+		decidedAt = decision.DecidedAt.Time.UTC()
+	}
+
+	_, err := outbox.PublishWebhookEvent(ctx, dbtx, request.OrganizationID, events.McpApprovalRequestDecidedV1, events.McpApprovalRequestDecidedPayloadV1{
+		ID:                   request.ID,
+		OrganizationID:       request.OrganizationID,
+		ProjectID:            projectID,
+		Target:               request.TargetRaw,
+		TargetKind:           request.TargetKind,
+		Decision:             decision.Decision,
+		DecidedBy:            decision.DecidedBy,
+		Rationale:            conv.FromPGTextOrEmpty[string](decision.Rationale),
+		GrantedPrincipalURNs: decision.GrantedPrincipalUrns,
+		DecidedAt:            decidedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("publish approval decision webhook: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package mcpendpoints
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/canary"
+	"github.com/speakeasy-api/gram/server/internal/mcpendpoints/repo"
+)
+
+// canaryRoutingTTL bounds how stale a cached split can be. Canary writes
+// evict the entry eagerly, but routing also follows the project's active
+// deployment, which changes without passing through this package, so the
+// TTL is kept short enough for a new deployment to take effect promptly.
+const canaryRoutingTTL = 30 * time.Second
+
+// canaryRouting is the cached routing of one endpoint. A nil Split is a
+// negative entry: the endpoint follows the active deployment, which is the
+// common case and must not cost a database query per request either.
+type canaryRouting struct {
+	McpEndpointID string        `json:"mcp_endpoint_id"`
+	Split         *canary.Split `json:"split"`
+}
+
+var _ cache.CacheableObject[canaryRouting] = (*canaryRouting)(nil)
+
+func canaryRoutingCacheKey(mcpEndpointID string) string {
+	return fmt.Sprintf("mcpendpoints:canary_routing:%s", mcpEndpointID)
+}
+
+func (c canaryRouting) CacheKey() string {
+	return canaryRoutingCacheKey(c.McpEndpointID)
+}
+
+func (c canaryRouting) AdditionalCacheKeys() []string {
+	return []string{}
+}
+
+func (c canaryRouting) TTL() time.Duration {
+	return canaryRoutingTTL
+}
+
+// CanaryRoutingCache serves CanarySplit through a Redis pull-through cache so
+// the public serve path does not query Postgres on every request. One
+// instance is shared by the serve path, which reads it, and the canary API,
+// which evicts it.
+type CanaryRoutingCache struct {
+	logger *slog.Logger
+	db     *pgxpool.Pool
+	cache  cache.TypedCacheObject[canaryRouting]
+}
+
+// NewCanaryRoutingCache builds the cache over the given database and cache
+// backends.
+func NewCanaryRoutingCache(logger *slog.Logger, db *pgxpool.Pool, c cache.Cache) *CanaryRoutingCache {
+	logger = logger.With(attr.SlogComponent("mcpendpoints-canary-routing"))
+	return &CanaryRoutingCache{
+		logger: logger,
+		db:     db,
+		cache:  cache.NewTypedObjectCache[canaryRouting](logger.With(attr.SlogCacheNamespace("mcp_endpoint_canary_routing")), c, cache.SuffixNone),
+	}
+}
+
+// Split returns how tool lookups on an endpoint should be routed, or nil when
+// the endpoint follows the project's active deployment.
+func (c *CanaryRoutingCache) Split(ctx context.Context, logger *slog.Logger, endpoint *repo.McpEndpoint) (*canary.Split, error) {
+	key := canaryRoutingCacheKey(endpoint.ID.String())
+	if cached, err := c.cache.Get(ctx, key); err == nil {
+		return cached.Split, nil
+	}
+
+	split, err := CanarySplit(ctx, c.db, logger, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := canaryRouting{McpEndpointID: endpoint.ID.String(), Split: split}
+	if err := c.cache.Store(ctx, entry); err != nil {
+		logger.WarnContext(ctx, "cache mcp endpoint canary routing", attr.SlogError(err))
+	}
+
+	return split, nil
+}
+
+// Invalidate evicts an endpoint's cached routing so a canary change takes
+// effect before the TTL lapses.
+func (c *CanaryRoutingCache) Invalidate(ctx context.Context, mcpEndpointID uuid.UUID) error {
+	if err := c.cache.DeleteByKey(ctx, canaryRoutingCacheKey(mcpEndpointID.String())); err != nil {
+		return fmt.Errorf("invalidate mcp endpoint canary routing: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package mcpendpoints_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/mcp_endpoints"
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+func createCanaryTestEndpoint(t *testing.T, ctx context.Context, ti *testInstance) string {
+	t.Helper()
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	mcpServerID := seedMcpServer(t, ctx, ti.conn, *authCtx.ProjectID).String()
+	created, err := ti.service.CreateMcpEndpoint(ctx, &gen.CreateMcpEndpointPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		CustomDomainID:   nil,
+		McpServerID:      conv.PtrEmpty(mcpServerID),
+		Slug:             types.McpEndpointSlug(authCtx.OrganizationSlug + "-canary-" + uuid.NewString()[:8]),
+	})
+	require.NoError(t, err)
+
+	return created.ID
+}
+
+func TestStartMcpEndpointCanary_RejectsNonToolsetServer(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	endpointID := createCanaryTestEndpoint(t, ctx, ti)
+
+	_, err := ti.service.StartMcpEndpointCanary(ctx, &gen.StartMcpEndpointCanaryPayload{
+		SessionToken:          nil,
+		ApikeyToken:           nil,
+		ProjectSlugInput:      nil,
+		McpEndpointID:         endpointID,
+		BaselineDeploymentID:  nil,
+		CandidateDeploymentID: nil,
+		Percentage:            10,
+		StickyBy:              "session",
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+}
+
+func TestStartMcpEndpointCanary_EndpointNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	_, err := ti.service.StartMcpEndpointCanary(ctx, &gen.StartMcpEndpointCanaryPayload{
+		SessionToken:          nil,
+		ApikeyToken:           nil,
+		ProjectSlugInput:      nil,
+		McpEndpointID:         uuid.NewString(),
+		BaselineDeploymentID:  nil,
+		CandidateDeploymentID: nil,
+		Percentage:            10,
+		StickyBy:              "session",
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
+
+func TestGetMcpEndpointCanary_NoCanary(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	endpointID := createCanaryTestEndpoint(t, ctx, ti)
+
+	_, err := ti.service.GetMcpEndpointCanary(ctx, &gen.GetMcpEndpointCanaryPayload{
+		McpEndpointID:    endpointID,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
+
+func TestAbortMcpEndpointCanary_NoneRunning(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	endpointID := createCanaryTestEndpoint(t, ctx, ti)
+
+	_, err := ti.service.AbortMcpEndpointCanary(ctx, &gen.AbortMcpEndpointCanaryPayload{
+		McpEndpointID:    endpointID,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
+
+func TestUpdateMcpEndpointCanary_RBACForbidden(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	ctx = withExactAuthzGrants(t, ctx, ti.conn)
+
+	_, err := ti.service.UpdateMcpEndpointCanary(ctx, &gen.UpdateMcpEndpointCanaryPayload{
+		McpEndpointID:    uuid.NewString(),
+		Percentage:       50,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	requireOopsCode(t, err, oops.CodeForbidden)
+}
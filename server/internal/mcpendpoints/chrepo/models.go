@@ -0,0 +1,11 @@
+package chrepo
+
+// DeploymentStatsRow is the tool call volume, failures and latency quantiles
+// served by one deployment. Quantiles are in milliseconds.
+type DeploymentStatsRow struct {
+	DeploymentID string  `ch:"deployment_id"`
+	Calls        uint64  `ch:"calls"`
+	Failures     uint64  `ch:"failures"`
+	LatencyP50   float64 `ch:"latency_p50"`
+	LatencyP95   float64 `ch:"latency_p95"`
+}
@@ -0,0 +1,82 @@
+package chrepo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+)
+
+// sq is the squirrel statement builder pre-configured for ClickHouse.
+var sq = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Question)
+
+// failureExpr and latencyMsExpr match the definitions used by the tool
+// metrics breakdown, so canary arms line up with what the dashboard shows.
+const (
+	failureExpr   = "toInt32OrZero(toString(attributes.http.response.status_code)) >= 400"
+	latencyMsExpr = "toFloat64OrZero(toString(attributes.http.server.request.duration)) * 1000"
+)
+
+// DeploymentStatsParams scopes a per-deployment stats query to the tool calls
+// one MCP server made between Start and End.
+type DeploymentStatsParams struct {
+	ProjectID     string
+	McpServerID   string
+	DeploymentIDs []string
+	Start         int64
+	End           int64
+}
+
+// ListDeploymentStats returns one row per deployment that served tool calls
+// in the window. Deployments without calls are omitted.
+func (q *Queries) ListDeploymentStats(ctx context.Context, arg DeploymentStatsParams) ([]DeploymentStatsRow, error) {
+	deploymentIDs := make([]string, 0, len(arg.DeploymentIDs))
+	placeholders := make([]any, 0, len(arg.DeploymentIDs))
+	for _, id := range arg.DeploymentIDs {
+		deploymentIDs = append(deploymentIDs, "toUUIDOrNull(?)")
+		placeholders = append(placeholders, id)
+	}
+
+	sb := sq.Select(
+		"toString(gram_deployment_id) AS deployment_id",
+		"count(*) AS calls",
+		"countIf("+failureExpr+") AS failures",
+		"quantile(0.5)("+latencyMsExpr+") AS latency_p50",
+		"quantile(0.95)("+latencyMsExpr+") AS latency_p95",
+	).
+		From("telemetry_logs").
+		Where("gram_project_id = ?", arg.ProjectID).
+		Where("mcp_server_id = ?", arg.McpServerID).
+		Where("time_unix_nano >= ?", arg.Start).
+		Where("time_unix_nano <= ?", arg.End).
+		Where("startsWith(gram_urn, 'tools:')").
+		Where(squirrel.Expr("gram_deployment_id IN ("+strings.Join(deploymentIDs, ", ")+")", placeholders...)).
+		GroupBy("deployment_id")
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building deployment stats query: %w", err)
+	}
+
+	rows, err := q.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query deployment stats: %w", err)
+	}
+	defer o11y.NoLogDefer(rows.Close)
+
+	var out []DeploymentStatsRow
+	for rows.Next() {
+		var row DeploymentStatsRow
+		if err = rows.ScanStruct(&row); err != nil {
+			return nil, fmt.Errorf("scanning deployment stats row: %w", err)
+		}
+		out = append(out, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate deployment stats rows: %w", err)
+	}
+	return out, nil
+}
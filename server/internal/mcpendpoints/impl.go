@@ -30,6 +30,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/contextvalues"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	customdomainsrepo "github.com/speakeasy-api/gram/server/internal/customdomains/repo"
+	"github.com/speakeasy-api/gram/server/internal/mcpendpoints/chrepo"
 	"github.com/speakeasy-api/gram/server/internal/mcpendpoints/repo"
 	"github.com/speakeasy-api/gram/server/internal/mcpservers"
 	mcpserversrepo "github.com/speakeasy-api/gram/server/internal/mcpservers/repo"
@@ -51,6 +52,8 @@ type Service struct {
 	authz                *authz.Engine
 	audit                *audit.Logger
 	temporalEnv          *tenv.Environment
+	chRepo               *chrepo.Queries
+	canaryRouting        *CanaryRoutingCache
 	pluginsGitHubEnabled bool
 }
 
@@ -65,6 +68,8 @@ func NewService(
 	authzEngine *authz.Engine,
 	auditLogger *audit.Logger,
 	temporalEnv *tenv.Environment,
+	chRepo *chrepo.Queries,
+	canaryRouting *CanaryRoutingCache,
 	pluginsGitHubEnabled bool,
 ) *Service {
 	logger = logger.With(attr.SlogComponent("mcpendpoints"))
@@ -77,6 +82,8 @@ func NewService(
 		authz:                authzEngine,
 		audit:                auditLogger,
 		temporalEnv:          temporalEnv,
+		chRepo:               chRepo,
+		canaryRouting:        canaryRouting,
 		pluginsGitHubEnabled: pluginsGitHubEnabled,
 	}
 }
@@ -146,16 +153,19 @@ func (s *Service) CreateMcpEndpoint(ctx context.Context, payload *gen.CreateMcpE
 	// one transaction, so an unlocked create could validate a live backend,
 	// then insert after that cascade commits, leaving a live endpoint pointing
 	// at a tombstoned backend.
+	targetToolsetID := uuid.NullUUID{UUID: uuid.Nil, Valid: false}
 	if mcpServerID.Valid {
-		if _, err := mcpserversrepo.New(dbtx).LockMCPServerByIDAndProjectID(ctx, mcpserversrepo.LockMCPServerByIDAndProjectIDParams{
+		server, err := mcpserversrepo.New(dbtx).LockMCPServerByIDAndProjectID(ctx, mcpserversrepo.LockMCPServerByIDAndProjectIDParams{
 			ID:        mcpServerID.UUID,
 			ProjectID: *authCtx.ProjectID,
-		}); err != nil {
+		})
+		if err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
 				return nil, oops.E(oops.CodeInvalid, err, "mcp_server_id does not reference a resource in this project").LogError(ctx, logger)
 			}
 			return nil, oops.E(oops.CodeUnexpected, err, "lock mcp server").LogError(ctx, logger)
 		}
+		targetToolsetID = server.ToolsetID
 	}
 	if err := s.lockMetaMcpServers(ctx, dbtx, authCtx, uniqueIDs(metaMcpServerID), metaMcpServerID); err != nil {
 		return nil, err
@@ -164,12 +174,16 @@ func (s *Service) CreateMcpEndpoint(ctx context.Context, payload *gen.CreateMcpE
 	if err := verifyEndpointReferenceOwnership(ctx, dbtx, *authCtx.ProjectID, authCtx.ActiveOrganizationID, mcpServerID, metaMcpServerID, customDomainID); err != nil {
 		return nil, oops.E(oops.CodeInvalid, err, "invalid mcp endpoint").LogError(ctx, logger)
 	}
+	if err := mcpservers.VerifyToolsetVersion(ctx, dbtx, targetToolsetID, payload.ToolsetVersion); err != nil {
+		return nil, oops.E(oops.CodeInvalid, err, "invalid mcp endpoint").LogError(ctx, logger)
+	}
 
 	created, err := txRepo.CreateMCPEndpoint(ctx, repo.CreateMCPEndpointParams{
 		ProjectID:       *authCtx.ProjectID,
 		CustomDomainID:  customDomainID,
 		McpServerID:     mcpServerID,
 		MetaMcpServerID: metaMcpServerID,
+		ToolsetVersion:  conv.PtrInt64ToPGInt8(payload.ToolsetVersion),
 		Slug:            slug,
 	})
 	if err != nil {
@@ -495,6 +509,13 @@ func (s *Service) UpdateMcpEndpoint(ctx context.Context, payload *gen.UpdateMcpE
 	if err := verifyEndpointReferenceOwnership(ctx, dbtx, *authCtx.ProjectID, authCtx.ActiveOrganizationID, mcpServerID, metaMcpServerID, customDomainID); err != nil {
 		return nil, oops.E(oops.CodeInvalid, err, "invalid mcp endpoint").LogError(ctx, logger)
 	}
+	targetToolsetID := uuid.NullUUID{UUID: uuid.Nil, Valid: false}
+	if targetServer != nil {
+		targetToolsetID = targetServer.ToolsetID
+	}
+	if err := mcpservers.VerifyToolsetVersion(ctx, dbtx, targetToolsetID, payload.ToolsetVersion); err != nil {
+		return nil, oops.E(oops.CodeInvalid, err, "invalid mcp endpoint").LogError(ctx, logger)
+	}
 
 	wasRoot := existing.IsDomainRoot.Valid && existing.IsDomainRoot.Bool
 	sameDomain := existing.CustomDomainID == customDomainID
@@ -510,6 +531,7 @@ func (s *Service) UpdateMcpEndpoint(ctx context.Context, payload *gen.UpdateMcpE
 		CustomDomainID:  customDomainID,
 		McpServerID:     mcpServerID,
 		MetaMcpServerID: metaMcpServerID,
+		ToolsetVersion:  conv.PtrInt64ToPGInt8(payload.ToolsetVersion),
 		Slug:            slug,
 		IsDomainRoot:    rootMarker,
 		ID:              endpointID,
@@ -0,0 +1,524 @@
+package mcpendpoints
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	gen "github.com/speakeasy-api/gram/server/gen/mcp_endpoints"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/canary"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	deploymentsrepo "github.com/speakeasy-api/gram/server/internal/deployments/repo"
+	"github.com/speakeasy-api/gram/server/internal/mcpendpoints/chrepo"
+	"github.com/speakeasy-api/gram/server/internal/mcpendpoints/repo"
+	mcpserversrepo "github.com/speakeasy-api/gram/server/internal/mcpservers/repo"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+const (
+	canaryStatusRunning  = "running"
+	canaryStatusPromoted = "promoted"
+	canaryStatusAborted  = "aborted"
+)
+
+func (s *Service) StartMcpEndpointCanary(ctx context.Context, payload *gen.StartMcpEndpointCanaryPayload) (*gen.McpEndpointCanary, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeMCPWrite, ResourceKind: "", ResourceID: authCtx.ProjectID.String(), Dimensions: nil}); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	endpointID, err := uuid.Parse(payload.McpEndpointID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid mcp endpoint id").LogError(ctx, logger)
+	}
+	baselineID, err := conv.PtrToNullUUID(payload.BaselineDeploymentID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid baseline_deployment_id").LogError(ctx, logger)
+	}
+	candidateID, err := conv.PtrToNullUUID(payload.CandidateDeploymentID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid candidate_deployment_id").LogError(ctx, logger)
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	txRepo := repo.New(dbtx)
+
+	endpoint, err := txRepo.LockMCPEndpointByID(ctx, repo.LockMCPEndpointByIDParams{
+		ID:        endpointID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "mcp endpoint not found").LogError(ctx, logger)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "lock mcp endpoint").LogError(ctx, logger)
+	}
+
+	// Only toolset-backed servers read tools from a deployment. Remote,
+	// tunneled and meta MCP backends have nothing to split.
+	if !endpoint.McpServerID.Valid {
+		return nil, oops.E(oops.CodeInvalid, nil, "canaries are only supported on endpoints for toolset-backed mcp servers").LogError(ctx, logger)
+	}
+	server, err := mcpserversrepo.New(dbtx).GetMCPServerByIDAndProjectID(ctx, mcpserversrepo.GetMCPServerByIDAndProjectIDParams{
+		ID:        endpoint.McpServerID.UUID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "mcp server not found").LogError(ctx, logger)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "get mcp server").LogError(ctx, logger)
+	}
+	if !server.ToolsetID.Valid {
+		return nil, oops.E(oops.CodeInvalid, nil, "canaries are only supported on endpoints for toolset-backed mcp servers").LogError(ctx, logger)
+	}
+
+	if !baselineID.Valid {
+		activeID, err := activeDeploymentID(ctx, dbtx, *authCtx.ProjectID)
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "get active deployment").LogError(ctx, logger)
+		}
+		if !activeID.Valid {
+			return nil, oops.E(oops.CodeInvalid, nil, "project has no active deployment to use as the baseline").LogError(ctx, logger)
+		}
+		baselineID = activeID
+	}
+	if err := requireCompletedDeployment(ctx, logger, dbtx, *authCtx.ProjectID, baselineID.UUID, "baseline"); err != nil {
+		return nil, err
+	}
+	if candidateID.Valid {
+		if candidateID.UUID == baselineID.UUID {
+			return nil, oops.E(oops.CodeInvalid, nil, "candidate and baseline deployments must differ").LogError(ctx, logger)
+		}
+		if err := requireCompletedDeployment(ctx, logger, dbtx, *authCtx.ProjectID, candidateID.UUID, "candidate"); err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := txRepo.CreateMCPEndpointCanary(ctx, repo.CreateMCPEndpointCanaryParams{
+		ProjectID:             *authCtx.ProjectID,
+		McpEndpointID:         endpoint.ID,
+		BaselineDeploymentID:  baselineID.UUID,
+		CandidateDeploymentID: candidateID,
+		Percentage:            payload.Percentage,
+		StickyBy:              payload.StickyBy,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return nil, oops.E(oops.CodeConflict, err, "a canary is already running on this mcp endpoint").LogError(ctx, logger)
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "create mcp endpoint canary").LogError(ctx, logger)
+	}
+
+	view := buildCanaryView(created)
+	if err := s.logCanaryEvent(ctx, dbtx, authCtx, audit.ActionMcpEndpointCanaryStart, endpoint, nil, view); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log mcp endpoint canary start").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidateCanaryRouting(ctx, endpoint.ID, logger)
+
+	return view, nil
+}
+
+func (s *Service) GetMcpEndpointCanary(ctx context.Context, payload *gen.GetMcpEndpointCanaryPayload) (*gen.McpEndpointCanary, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeMCPRead, ResourceKind: "", ResourceID: authCtx.ProjectID.String(), Dimensions: nil}); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	endpointID, err := uuid.Parse(payload.McpEndpointID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid mcp endpoint id").LogError(ctx, logger)
+	}
+
+	r := repo.New(s.db)
+	endpoint, err := r.GetMCPEndpointByID(ctx, repo.GetMCPEndpointByIDParams{
+		ID:        endpointID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "mcp endpoint not found").LogError(ctx, logger)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "get mcp endpoint").LogError(ctx, logger)
+	}
+
+	row, err := r.GetLatestMCPEndpointCanary(ctx, repo.GetLatestMCPEndpointCanaryParams{
+		McpEndpointID: endpoint.ID,
+		ProjectID:     *authCtx.ProjectID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "mcp endpoint has no canary").LogError(ctx, logger)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "get mcp endpoint canary").LogError(ctx, logger)
+	}
+
+	view := buildCanaryView(row)
+
+	// A running canary with a floating candidate reports stats for whichever
+	// deployment is active now, which is what its candidate arm is serving.
+	candidateID := row.CandidateDeploymentID
+	if !candidateID.Valid && row.Status == canaryStatusRunning {
+		candidateID, err = activeDeploymentID(ctx, s.db, *authCtx.ProjectID)
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "get active deployment").LogError(ctx, logger)
+		}
+	}
+
+	end := time.Now()
+	if row.EndedAt.Valid {
+		end = row.EndedAt.Time
+	}
+	deploymentIDs := []string{row.BaselineDeploymentID.String()}
+	if candidateID.Valid && candidateID.UUID != row.BaselineDeploymentID {
+		deploymentIDs = append(deploymentIDs, candidateID.UUID.String())
+	}
+
+	stats, err := s.chRepo.ListDeploymentStats(ctx, chrepo.DeploymentStatsParams{
+		ProjectID:     authCtx.ProjectID.String(),
+		McpServerID:   endpoint.McpServerID.UUID.String(),
+		DeploymentIDs: deploymentIDs,
+		Start:         row.StartedAt.Time.UnixNano(),
+		End:           end.UnixNano(),
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "get canary telemetry").LogError(ctx, logger)
+	}
+
+	byDeployment := make(map[string]chrepo.DeploymentStatsRow, len(stats))
+	for _, st := range stats {
+		byDeployment[st.DeploymentID] = st
+	}
+	view.Baseline = buildCanaryArm(row.BaselineDeploymentID.String(), byDeployment)
+	if len(deploymentIDs) > 1 {
+		view.Candidate = buildCanaryArm(deploymentIDs[1], byDeployment)
+	}
+
+	return view, nil
+}
+
+func (s *Service) UpdateMcpEndpointCanary(ctx context.Context, payload *gen.UpdateMcpEndpointCanaryPayload) (*gen.McpEndpointCanary, error) {
+	return s.changeRunningCanary(ctx, payload.McpEndpointID, audit.ActionMcpEndpointCanaryUpdate, func(ctx context.Context, logger *slog.Logger, dbtx pgx.Tx, current repo.McpEndpointCanary) (repo.McpEndpointCanary, error) {
+		updated, err := repo.New(dbtx).UpdateMCPEndpointCanaryPercentage(ctx, repo.UpdateMCPEndpointCanaryPercentageParams{
+			Percentage: payload.Percentage,
+			ID:         current.ID,
+			ProjectID:  current.ProjectID,
+		})
+		if err != nil {
+			return updated, oops.E(oops.CodeUnexpected, err, "update mcp endpoint canary").LogError(ctx, logger)
+		}
+		return updated, nil
+	})
+}
+
+func (s *Service) PromoteMcpEndpointCanary(ctx context.Context, payload *gen.PromoteMcpEndpointCanaryPayload) (*gen.McpEndpointCanary, error) {
+	return s.changeRunningCanary(ctx, payload.McpEndpointID, audit.ActionMcpEndpointCanaryPromote, func(ctx context.Context, logger *slog.Logger, dbtx pgx.Tx, current repo.McpEndpointCanary) (repo.McpEndpointCanary, error) {
+		activeID, err := activeDeploymentID(ctx, dbtx, current.ProjectID)
+		if err != nil {
+			return current, oops.E(oops.CodeUnexpected, err, "get active deployment").LogError(ctx, logger)
+		}
+
+		// Promoting hands the endpoint back to the active deployment, so it
+		// only means "ship the candidate" while the candidate is active.
+		candidateID := current.CandidateDeploymentID
+		if !candidateID.Valid {
+			candidateID = activeID
+		}
+		switch {
+		case !candidateID.Valid || candidateID.UUID == current.BaselineDeploymentID:
+			return current, oops.E(oops.CodeInvalid, nil, "no candidate deployment has completed since the canary started").LogError(ctx, logger)
+		case candidateID != activeID:
+			return current, oops.E(oops.CodeConflict, nil, "candidate deployment is no longer the active deployment").LogError(ctx, logger)
+		}
+
+		return s.endCanary(ctx, logger, dbtx, current, canaryStatusPromoted, candidateID)
+	})
+}
+
+func (s *Service) AbortMcpEndpointCanary(ctx context.Context, payload *gen.AbortMcpEndpointCanaryPayload) (*gen.McpEndpointCanary, error) {
+	return s.changeRunningCanary(ctx, payload.McpEndpointID, audit.ActionMcpEndpointCanaryAbort, func(ctx context.Context, logger *slog.Logger, dbtx pgx.Tx, current repo.McpEndpointCanary) (repo.McpEndpointCanary, error) {
+		// Record the candidate the endpoint is backing away from so routing
+		// can keep it on the baseline until something newer is deployed. A
+		// floating candidate that never saw a new deployment records none.
+		candidateID := current.CandidateDeploymentID
+		if !candidateID.Valid {
+			activeID, err := activeDeploymentID(ctx, dbtx, current.ProjectID)
+			if err != nil {
+				return current, oops.E(oops.CodeUnexpected, err, "get active deployment").LogError(ctx, logger)
+			}
+			if activeID.Valid && activeID.UUID != current.BaselineDeploymentID {
+				candidateID = activeID
+			}
+		}
+
+		return s.endCanary(ctx, logger, dbtx, current, canaryStatusAborted, candidateID)
+	})
+}
+
+// changeRunningCanary locks the endpoint's running canary, applies change and
+// records the before and after state in the audit log in one transaction.
+func (s *Service) changeRunningCanary(
+	ctx context.Context,
+	rawEndpointID string,
+	action audit.Action,
+	change func(ctx context.Context, logger *slog.Logger, dbtx pgx.Tx, current repo.McpEndpointCanary) (repo.McpEndpointCanary, error),
+) (*gen.McpEndpointCanary, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeMCPWrite, ResourceKind: "", ResourceID: authCtx.ProjectID.String(), Dimensions: nil}); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	endpointID, err := uuid.Parse(rawEndpointID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid mcp endpoint id").LogError(ctx, logger)
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	txRepo := repo.New(dbtx)
+
+	endpoint, err := txRepo.GetMCPEndpointByID(ctx, repo.GetMCPEndpointByIDParams{
+		ID:        endpointID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "mcp endpoint not found").LogError(ctx, logger)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "get mcp endpoint").LogError(ctx, logger)
+	}
+
+	current, err := txRepo.LockRunningMCPEndpointCanary(ctx, repo.LockRunningMCPEndpointCanaryParams{
+		McpEndpointID: endpoint.ID,
+		ProjectID:     *authCtx.ProjectID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "no canary is running on this mcp endpoint").LogError(ctx, logger)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "lock mcp endpoint canary").LogError(ctx, logger)
+	}
+
+	updated, err := change(ctx, logger, dbtx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	view := buildCanaryView(updated)
+	if err := s.logCanaryEvent(ctx, dbtx, authCtx, action, endpoint, buildCanaryView(current), view); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log mcp endpoint canary change").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidateCanaryRouting(ctx, endpoint.ID, logger)
+
+	return view, nil
+}
+
+// invalidateCanaryRouting best-effort evicts the endpoint's cached routing
+// after a committed canary change. A failure only means the serve path keeps
+// the prior split until the short cache TTL lapses, so it is logged rather
+// than surfaced.
+func (s *Service) invalidateCanaryRouting(ctx context.Context, endpointID uuid.UUID, logger *slog.Logger) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	if err := s.canaryRouting.Invalidate(ctx, endpointID); err != nil {
+		logger.WarnContext(ctx, "invalidate mcp endpoint canary routing", attr.SlogError(err))
+	}
+}
+
+func (s *Service) endCanary(ctx context.Context, logger *slog.Logger, dbtx pgx.Tx, current repo.McpEndpointCanary, status string, candidateID uuid.NullUUID) (repo.McpEndpointCanary, error) {
+	ended, err := repo.New(dbtx).EndMCPEndpointCanary(ctx, repo.EndMCPEndpointCanaryParams{
+		Status:                status,
+		CandidateDeploymentID: candidateID,
+		ID:                    current.ID,
+		ProjectID:             current.ProjectID,
+	})
+	if err != nil {
+		return ended, oops.E(oops.CodeUnexpected, err, "end mcp endpoint canary").LogError(ctx, logger)
+	}
+	return ended, nil
+}
+
+func (s *Service) logCanaryEvent(
+	ctx context.Context,
+	dbtx pgx.Tx,
+	authCtx *contextvalues.AuthContext,
+	action audit.Action,
+	endpoint repo.McpEndpoint,
+	before *gen.McpEndpointCanary,
+	after *gen.McpEndpointCanary,
+) error {
+	//nolint:wrapcheck // callers wrap this in an oops error.
+	return s.audit.LogMcpEndpointCanary(ctx, dbtx, audit.LogMcpEndpointCanaryEvent{
+		OrganizationID:       authCtx.ActiveOrganizationID,
+		ProjectID:            *authCtx.ProjectID,
+		Actor:                urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:     authCtx.Email,
+		ActorSlug:            nil,
+		Action:               action,
+		McpEndpointURN:       urn.NewMcpEndpoint(endpoint.ID),
+		Slug:                 endpoint.Slug,
+		CanarySnapshotBefore: before,
+		CanarySnapshotAfter:  after,
+	})
+}
+
+// activeDeploymentID returns the project's active deployment, or an invalid
+// NullUUID when no deployment has completed yet.
+func activeDeploymentID(ctx context.Context, dbtx deploymentsrepo.DBTX, projectID uuid.UUID) (uuid.NullUUID, error) {
+	id, err := deploymentsrepo.New(dbtx).GetActiveDeploymentID(ctx, projectID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return uuid.NullUUID{UUID: uuid.Nil, Valid: false}, nil
+	case err != nil:
+		return uuid.NullUUID{UUID: uuid.Nil, Valid: false}, err //nolint:wrapcheck // callers wrap this in an oops error.
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
+
+func requireCompletedDeployment(ctx context.Context, logger *slog.Logger, dbtx pgx.Tx, projectID uuid.UUID, deploymentID uuid.UUID, role string) error {
+	deployment, err := deploymentsrepo.New(dbtx).GetDeployment(ctx, deploymentsrepo.GetDeploymentParams{
+		ID:        deploymentID,
+		ProjectID: projectID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return oops.E(oops.CodeInvalid, err, "%s deployment not found in this project", role).LogError(ctx, logger)
+	case err != nil:
+		return oops.E(oops.CodeUnexpected, err, "get %s deployment", role).LogError(ctx, logger)
+	case deployment.Status != "completed":
+		return oops.E(oops.CodeInvalid, nil, "%s deployment has not completed", role).LogError(ctx, logger)
+	}
+	return nil
+}
+
+func buildCanaryView(row repo.McpEndpointCanary) *gen.McpEndpointCanary {
+	var endedAt *string
+	if row.EndedAt.Valid {
+		endedAt = new(row.EndedAt.Time.Format(time.RFC3339))
+	}
+
+	return &gen.McpEndpointCanary{
+		ID:                    row.ID.String(),
+		McpEndpointID:         row.McpEndpointID.String(),
+		BaselineDeploymentID:  row.BaselineDeploymentID.String(),
+		CandidateDeploymentID: conv.FromNullableUUID(row.CandidateDeploymentID),
+		Percentage:            row.Percentage,
+		StickyBy:              row.StickyBy,
+		Status:                row.Status,
+		StartedAt:             row.StartedAt.Time.Format(time.RFC3339),
+		EndedAt:               endedAt,
+		Baseline:              nil,
+		Candidate:             nil,
+	}
+}
+
+func buildCanaryArm(deploymentID string, stats map[string]chrepo.DeploymentStatsRow) *gen.McpEndpointCanaryArm {
+	st, ok := stats[deploymentID]
+	if !ok || st.Calls == 0 {
+		return &gen.McpEndpointCanaryArm{
+			DeploymentID:    deploymentID,
+			ToolCalls:       0,
+			FailedToolCalls: 0,
+			ErrorRate:       0,
+			LatencyP50Ms:    0,
+			LatencyP95Ms:    0,
+		}
+	}
+
+	return &gen.McpEndpointCanaryArm{
+		DeploymentID:    deploymentID,
+		ToolCalls:       int64(st.Calls),    //nolint:gosec // call counts fit in int64
+		FailedToolCalls: int64(st.Failures), //nolint:gosec // call counts fit in int64
+		ErrorRate:       float64(st.Failures) / float64(st.Calls),
+		LatencyP50Ms:    finiteOrZero(st.LatencyP50),
+		LatencyP95Ms:    finiteOrZero(st.LatencyP95),
+	}
+}
+
+func finiteOrZero(v float64) float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0
+	}
+	return v
+}
+
+// CanarySplit returns how tool lookups on an endpoint should be routed, or
+// nil when the endpoint follows the project's active deployment. An aborted
+// canary comes back as a split that sends every caller to its baseline.
+func CanarySplit(ctx context.Context, dbtx repo.DBTX, logger *slog.Logger, endpoint *repo.McpEndpoint) (*canary.Split, error) {
+	row, err := repo.New(dbtx).GetMCPEndpointCanaryRouting(ctx, repo.GetMCPEndpointCanaryRoutingParams{
+		ProjectID:     endpoint.ProjectID,
+		McpEndpointID: endpoint.ID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "load mcp endpoint canary").LogError(ctx, logger)
+	}
+
+	percentage := int(row.Percentage)
+	if row.Status == canaryStatusAborted {
+		percentage = 0
+	}
+
+	return &canary.Split{
+		ID:                    row.ID,
+		BaselineDeploymentID:  row.BaselineDeploymentID,
+		CandidateDeploymentID: row.CandidateDeploymentID,
+		Percentage:            percentage,
+		StickyBy:              canary.StickyBy(row.StickyBy),
+	}, nil
+}
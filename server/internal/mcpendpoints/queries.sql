@@ -4,6 +4,7 @@ INSERT INTO mcp_endpoints (
     custom_domain_id,
     mcp_server_id,
     meta_mcp_server_id,
+    toolset_version,
     slug
 )
 VALUES (
@@ -11,6 +12,7 @@ VALUES (
     @custom_domain_id,
     @mcp_server_id,
     @meta_mcp_server_id,
+    @toolset_version,
     @slug
 )
 RETURNING *;
@@ -100,6 +102,7 @@ SET
     custom_domain_id = @custom_domain_id,
     mcp_server_id = @mcp_server_id,
     meta_mcp_server_id = @meta_mcp_server_id,
+    toolset_version = @toolset_version,
     slug = @slug,
     is_domain_root = @is_domain_root,
     updated_at = clock_timestamp()
@@ -196,6 +199,19 @@ WHERE mcp_server_id = @mcp_server_id::uuid
   AND deleted IS FALSE
 ORDER BY custom_domain_id, id;
 
+-- name: HasPinnedMCPEndpointsByMCPServerID :one
+-- Reports whether any live endpoint of the server pins a toolset version. A
+-- pin is a version of the server's current toolset, so it has to be cleared
+-- before the server moves to another toolset.
+SELECT EXISTS (
+  SELECT 1
+  FROM mcp_endpoints
+  WHERE mcp_server_id = @mcp_server_id::uuid
+    AND project_id = @project_id
+    AND toolset_version IS NOT NULL
+    AND deleted IS FALSE
+);
+
 -- name: ListCustomDomainIDsByMCPServerID :many
 SELECT DISTINCT custom_domain_id::uuid
 FROM mcp_endpoints
@@ -261,3 +277,92 @@ WHERE mcp_server_id = @mcp_server_id::uuid
   AND is_domain_root IS TRUE
   AND deleted IS FALSE
 RETURNING *;
+
+-- name: CreateMCPEndpointCanary :one
+INSERT INTO mcp_endpoint_canaries (
+    project_id,
+    mcp_endpoint_id,
+    baseline_deployment_id,
+    candidate_deployment_id,
+    percentage,
+    sticky_by
+)
+VALUES (
+    @project_id,
+    @mcp_endpoint_id,
+    @baseline_deployment_id,
+    @candidate_deployment_id,
+    @percentage,
+    @sticky_by
+)
+RETURNING *;
+
+-- name: GetLatestMCPEndpointCanary :one
+SELECT *
+FROM mcp_endpoint_canaries
+WHERE mcp_endpoint_id = @mcp_endpoint_id AND project_id = @project_id
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: LockRunningMCPEndpointCanary :one
+SELECT *
+FROM mcp_endpoint_canaries
+WHERE mcp_endpoint_id = @mcp_endpoint_id
+  AND project_id = @project_id
+  AND status = 'running'
+FOR UPDATE;
+
+-- name: UpdateMCPEndpointCanaryPercentage :one
+UPDATE mcp_endpoint_canaries
+SET
+    percentage = @percentage,
+    updated_at = clock_timestamp()
+WHERE id = @id AND project_id = @project_id AND status = 'running'
+RETURNING *;
+
+-- name: EndMCPEndpointCanary :one
+-- Promote or abort a running canary. The candidate is recorded as resolved
+-- at the time the canary ended so a floating candidate stops following the
+-- active deployment.
+UPDATE mcp_endpoint_canaries
+SET
+    status = @status,
+    candidate_deployment_id = sqlc.narg(candidate_deployment_id)::uuid,
+    ended_at = clock_timestamp(),
+    updated_at = clock_timestamp()
+WHERE id = @id AND project_id = @project_id AND status = 'running'
+RETURNING *;
+
+-- name: GetMCPEndpointCanaryRouting :one
+-- Resolve how the public serve path should route an endpoint's tool lookups.
+-- Only the endpoint's most recent canary is considered: a running canary
+-- splits traffic, and an aborted canary keeps the endpoint on its baseline
+-- while its candidate is still the project's active deployment. A NULL
+-- candidate follows the active deployment, falling back to the baseline when
+-- the project has no completed deployment.
+WITH active_deployment AS (
+    SELECT d.id
+    FROM deployments d
+    JOIN deployment_statuses ds ON d.id = ds.deployment_id
+    WHERE d.project_id = @project_id
+      AND ds.status = 'completed'
+    ORDER BY d.seq DESC
+    LIMIT 1
+),
+latest_canary AS (
+    SELECT *
+    FROM mcp_endpoint_canaries
+    WHERE mcp_endpoint_id = @mcp_endpoint_id AND project_id = @project_id
+    ORDER BY created_at DESC
+    LIMIT 1
+)
+SELECT
+    c.id,
+    c.status,
+    c.baseline_deployment_id,
+    COALESCE(c.candidate_deployment_id, (SELECT id FROM active_deployment), c.baseline_deployment_id)::uuid AS candidate_deployment_id,
+    c.percentage,
+    c.sticky_by
+FROM latest_canary c
+WHERE c.status = 'running'
+   OR (c.status = 'aborted' AND c.candidate_deployment_id = (SELECT id FROM active_deployment));
@@ -15,6 +15,7 @@ type McpEndpoint struct {
 	CustomDomainID  uuid.NullUUID
 	McpServerID     uuid.NullUUID
 	MetaMcpServerID uuid.NullUUID
+	ToolsetVersion  pgtype.Int8
 	Slug            string
 	IsDomainRoot    pgtype.Bool
 	CreatedAt       pgtype.Timestamptz
@@ -22,3 +23,18 @@ type McpEndpoint struct {
 	DeletedAt       pgtype.Timestamptz
 	Deleted         bool
 }
+
+type McpEndpointCanary struct {
+	ID                    uuid.UUID
+	ProjectID             uuid.UUID
+	McpEndpointID         uuid.UUID
+	BaselineDeploymentID  uuid.UUID
+	CandidateDeploymentID uuid.NullUUID
+	Percentage            int32
+	StickyBy              string
+	Status                string
+	StartedAt             pgtype.Timestamptz
+	EndedAt               pgtype.Timestamptz
+	CreatedAt             pgtype.Timestamptz
+	UpdatedAt             pgtype.Timestamptz
+}
@@ -66,7 +66,7 @@ WHERE mcp_server_id = $1::uuid
   AND project_id = $2
   AND is_domain_root IS TRUE
   AND deleted IS FALSE
-RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 `
 
 type ClearRootMCPEndpointsByMCPServerIDParams struct {
@@ -89,6 +89,7 @@ func (q *Queries) ClearRootMCPEndpointsByMCPServerID(ctx context.Context, arg Cl
 			&i.CustomDomainID,
 			&i.McpServerID,
 			&i.MetaMcpServerID,
+			&i.ToolsetVersion,
 			&i.Slug,
 			&i.IsDomainRoot,
 			&i.CreatedAt,
@@ -112,6 +113,7 @@ INSERT INTO mcp_endpoints (
     custom_domain_id,
     mcp_server_id,
     meta_mcp_server_id,
+    toolset_version,
     slug
 )
 VALUES (
@@ -119,9 +121,10 @@ VALUES (
     $2,
     $3,
     $4,
-    $5
+    $5,
+    $6
 )
-RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 `
 
 type CreateMCPEndpointParams struct {
@@ -129,6 +132,7 @@ type CreateMCPEndpointParams struct {
 	CustomDomainID  uuid.NullUUID
 	McpServerID     uuid.NullUUID
 	MetaMcpServerID uuid.NullUUID
+	ToolsetVersion  pgtype.Int8
 	Slug            string
 }
 
@@ -138,6 +142,7 @@ func (q *Queries) CreateMCPEndpoint(ctx context.Context, arg CreateMCPEndpointPa
 		arg.CustomDomainID,
 		arg.McpServerID,
 		arg.MetaMcpServerID,
+		arg.ToolsetVersion,
 		arg.Slug,
 	)
 	var i McpEndpoint
@@ -147,6 +152,7 @@ func (q *Queries) CreateMCPEndpoint(ctx context.Context, arg CreateMCPEndpointPa
 		&i.CustomDomainID,
 		&i.McpServerID,
 		&i.MetaMcpServerID,
+		&i.ToolsetVersion,
 		&i.Slug,
 		&i.IsDomainRoot,
 		&i.CreatedAt,
@@ -157,13 +163,69 @@ func (q *Queries) CreateMCPEndpoint(ctx context.Context, arg CreateMCPEndpointPa
 	return i, err
 }
 
+const createMCPEndpointCanary = `-- name: CreateMCPEndpointCanary :one
+INSERT INTO mcp_endpoint_canaries (
+    project_id,
+    mcp_endpoint_id,
+    baseline_deployment_id,
+    candidate_deployment_id,
+    percentage,
+    sticky_by
+)
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+RETURNING id, project_id, mcp_endpoint_id, baseline_deployment_id, candidate_deployment_id, percentage, sticky_by, status, started_at, ended_at, created_at, updated_at
+`
+
+type CreateMCPEndpointCanaryParams struct {
+	ProjectID             uuid.UUID
+	McpEndpointID         uuid.UUID
+	BaselineDeploymentID  uuid.UUID
+	CandidateDeploymentID uuid.NullUUID
+	Percentage            int32
+	StickyBy              string
+}
+
+func (q *Queries) CreateMCPEndpointCanary(ctx context.Context, arg CreateMCPEndpointCanaryParams) (McpEndpointCanary, error) {
+	row := q.db.QueryRow(ctx, createMCPEndpointCanary,
+		arg.ProjectID,
+		arg.McpEndpointID,
+		arg.BaselineDeploymentID,
+		arg.CandidateDeploymentID,
+		arg.Percentage,
+		arg.StickyBy,
+	)
+	var i McpEndpointCanary
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.McpEndpointID,
+		&i.BaselineDeploymentID,
+		&i.CandidateDeploymentID,
+		&i.Percentage,
+		&i.StickyBy,
+		&i.Status,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const deleteMCPEndpoint = `-- name: DeleteMCPEndpoint :one
 UPDATE mcp_endpoints
 SET
     is_domain_root = NULL,
     deleted_at = clock_timestamp()
 WHERE id = $1 AND project_id = $2 AND deleted IS FALSE
-RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 `
 
 type DeleteMCPEndpointParams struct {
@@ -180,6 +242,7 @@ func (q *Queries) DeleteMCPEndpoint(ctx context.Context, arg DeleteMCPEndpointPa
 		&i.CustomDomainID,
 		&i.McpServerID,
 		&i.MetaMcpServerID,
+		&i.ToolsetVersion,
 		&i.Slug,
 		&i.IsDomainRoot,
 		&i.CreatedAt,
@@ -190,8 +253,82 @@ func (q *Queries) DeleteMCPEndpoint(ctx context.Context, arg DeleteMCPEndpointPa
 	return i, err
 }
 
+const endMCPEndpointCanary = `-- name: EndMCPEndpointCanary :one
+UPDATE mcp_endpoint_canaries
+SET
+    status = $1,
+    candidate_deployment_id = $2::uuid,
+    ended_at = clock_timestamp(),
+    updated_at = clock_timestamp()
+WHERE id = $3 AND project_id = $4 AND status = 'running'
+RETURNING id, project_id, mcp_endpoint_id, baseline_deployment_id, candidate_deployment_id, percentage, sticky_by, status, started_at, ended_at, created_at, updated_at
+`
+
+type EndMCPEndpointCanaryParams struct {
+	Status                string
+	CandidateDeploymentID uuid.NullUUID
+	ID                    uuid.UUID
+	ProjectID             uuid.UUID
+}
+
+// Promote or abort a running canary. The candidate is recorded as resolved
+// at the time the canary ended so a floating candidate stops following the
+// active deployment.
+func (q *Queries) EndMCPEndpointCanary(ctx context.Context, arg EndMCPEndpointCanaryParams) (McpEndpointCanary, error) {
+	row := q.db.QueryRow(ctx, endMCPEndpointCanary, arg.Status, arg.CandidateDeploymentID, arg.ID, arg.ProjectID)
+	var i McpEndpointCanary
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.McpEndpointID,
+		&i.BaselineDeploymentID,
+		&i.CandidateDeploymentID,
+		&i.Percentage,
+		&i.StickyBy,
+		&i.Status,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getLatestMCPEndpointCanary = `-- name: GetLatestMCPEndpointCanary :one
+SELECT id, project_id, mcp_endpoint_id, baseline_deployment_id, candidate_deployment_id, percentage, sticky_by, status, started_at, ended_at, created_at, updated_at
+FROM mcp_endpoint_canaries
+WHERE mcp_endpoint_id = $1 AND project_id = $2
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetLatestMCPEndpointCanaryParams struct {
+	McpEndpointID uuid.UUID
+	ProjectID     uuid.UUID
+}
+
+func (q *Queries) GetLatestMCPEndpointCanary(ctx context.Context, arg GetLatestMCPEndpointCanaryParams) (McpEndpointCanary, error) {
+	row := q.db.QueryRow(ctx, getLatestMCPEndpointCanary, arg.McpEndpointID, arg.ProjectID)
+	var i McpEndpointCanary
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.McpEndpointID,
+		&i.BaselineDeploymentID,
+		&i.CandidateDeploymentID,
+		&i.Percentage,
+		&i.StickyBy,
+		&i.Status,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getMCPEndpointByCustomDomainAndSlug = `-- name: GetMCPEndpointByCustomDomainAndSlug :one
-SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 FROM mcp_endpoints
 WHERE slug = $1
   AND custom_domain_id IS NOT DISTINCT FROM $2
@@ -214,6 +351,7 @@ func (q *Queries) GetMCPEndpointByCustomDomainAndSlug(ctx context.Context, arg G
 		&i.CustomDomainID,
 		&i.McpServerID,
 		&i.MetaMcpServerID,
+		&i.ToolsetVersion,
 		&i.Slug,
 		&i.IsDomainRoot,
 		&i.CreatedAt,
@@ -225,7 +363,7 @@ func (q *Queries) GetMCPEndpointByCustomDomainAndSlug(ctx context.Context, arg G
 }
 
 const getMCPEndpointByID = `-- name: GetMCPEndpointByID :one
-SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 FROM mcp_endpoints
 WHERE id = $1 AND project_id = $2 AND deleted IS FALSE
 `
@@ -244,6 +382,7 @@ func (q *Queries) GetMCPEndpointByID(ctx context.Context, arg GetMCPEndpointByID
 		&i.CustomDomainID,
 		&i.McpServerID,
 		&i.MetaMcpServerID,
+		&i.ToolsetVersion,
 		&i.Slug,
 		&i.IsDomainRoot,
 		&i.CreatedAt,
@@ -255,7 +394,7 @@ func (q *Queries) GetMCPEndpointByID(ctx context.Context, arg GetMCPEndpointByID
 }
 
 const getMCPEndpointByProjectAndCustomDomainAndSlug = `-- name: GetMCPEndpointByProjectAndCustomDomainAndSlug :one
-SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 FROM mcp_endpoints
 WHERE project_id = $1
   AND slug = $2
@@ -281,6 +420,7 @@ func (q *Queries) GetMCPEndpointByProjectAndCustomDomainAndSlug(ctx context.Cont
 		&i.CustomDomainID,
 		&i.McpServerID,
 		&i.MetaMcpServerID,
+		&i.ToolsetVersion,
 		&i.Slug,
 		&i.IsDomainRoot,
 		&i.CreatedAt,
@@ -291,6 +431,95 @@ func (q *Queries) GetMCPEndpointByProjectAndCustomDomainAndSlug(ctx context.Cont
 	return i, err
 }
 
+const getMCPEndpointCanaryRouting = `-- name: GetMCPEndpointCanaryRouting :one
+WITH active_deployment AS (
+    SELECT d.id
+    FROM deployments d
+    JOIN deployment_statuses ds ON d.id = ds.deployment_id
+    WHERE d.project_id = $1
+      AND ds.status = 'completed'
+    ORDER BY d.seq DESC
+    LIMIT 1
+),
+latest_canary AS (
+    SELECT id, project_id, mcp_endpoint_id, baseline_deployment_id, candidate_deployment_id, percentage, sticky_by, status, started_at, ended_at, created_at, updated_at
+    FROM mcp_endpoint_canaries
+    WHERE mcp_endpoint_id = $2 AND project_id = $1
+    ORDER BY created_at DESC
+    LIMIT 1
+)
+SELECT
+    c.id,
+    c.status,
+    c.baseline_deployment_id,
+    COALESCE(c.candidate_deployment_id, (SELECT id FROM active_deployment), c.baseline_deployment_id)::uuid AS candidate_deployment_id,
+    c.percentage,
+    c.sticky_by
+FROM latest_canary c
+WHERE c.status = 'running'
+   OR (c.status = 'aborted' AND c.candidate_deployment_id = (SELECT id FROM active_deployment))
+`
+
+type GetMCPEndpointCanaryRoutingParams struct {
+	ProjectID     uuid.UUID
+	McpEndpointID uuid.UUID
+}
+
+type GetMCPEndpointCanaryRoutingRow struct {
+	ID                    uuid.UUID
+	Status                string
+	BaselineDeploymentID  uuid.UUID
+	CandidateDeploymentID uuid.UUID
+	Percentage            int32
+	StickyBy              string
+}
+
+// Resolve how the public serve path should route an endpoint's tool lookups.
+// Only the endpoint's most recent canary is considered: a running canary
+// splits traffic, and an aborted canary keeps the endpoint on its baseline
+// while its candidate is still the project's active deployment. A NULL
+// candidate follows the active deployment, falling back to the baseline when
+// the project has no completed deployment.
+func (q *Queries) GetMCPEndpointCanaryRouting(ctx context.Context, arg GetMCPEndpointCanaryRoutingParams) (GetMCPEndpointCanaryRoutingRow, error) {
+	row := q.db.QueryRow(ctx, getMCPEndpointCanaryRouting, arg.ProjectID, arg.McpEndpointID)
+	var i GetMCPEndpointCanaryRoutingRow
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.BaselineDeploymentID,
+		&i.CandidateDeploymentID,
+		&i.Percentage,
+		&i.StickyBy,
+	)
+	return i, err
+}
+
+const hasPinnedMCPEndpointsByMCPServerID = `-- name: HasPinnedMCPEndpointsByMCPServerID :one
+SELECT EXISTS (
+  SELECT 1
+  FROM mcp_endpoints
+  WHERE mcp_server_id = $1::uuid
+    AND project_id = $2
+    AND toolset_version IS NOT NULL
+    AND deleted IS FALSE
+)
+`
+
+type HasPinnedMCPEndpointsByMCPServerIDParams struct {
+	McpServerID uuid.UUID
+	ProjectID   uuid.UUID
+}
+
+// Reports whether any live endpoint of the server pins a toolset version. A
+// pin is a version of the server's current toolset, so it has to be cleared
+// before the server moves to another toolset.
+func (q *Queries) HasPinnedMCPEndpointsByMCPServerID(ctx context.Context, arg HasPinnedMCPEndpointsByMCPServerIDParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasPinnedMCPEndpointsByMCPServerID, arg.McpServerID, arg.ProjectID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const listCustomDomainIDsByMCPServerID = `-- name: ListCustomDomainIDsByMCPServerID :many
 SELECT DISTINCT custom_domain_id::uuid
 FROM mcp_endpoints
@@ -433,7 +662,7 @@ func (q *Queries) ListMCPEndpointsByCustomDomainID(ctx context.Context, customDo
 }
 
 const listMCPEndpointsByMCPServerID = `-- name: ListMCPEndpointsByMCPServerID :many
-SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 FROM mcp_endpoints
 WHERE project_id = $1
   AND mcp_server_id = $2::uuid
@@ -461,6 +690,7 @@ func (q *Queries) ListMCPEndpointsByMCPServerID(ctx context.Context, arg ListMCP
 			&i.CustomDomainID,
 			&i.McpServerID,
 			&i.MetaMcpServerID,
+			&i.ToolsetVersion,
 			&i.Slug,
 			&i.IsDomainRoot,
 			&i.CreatedAt,
@@ -479,7 +709,7 @@ func (q *Queries) ListMCPEndpointsByMCPServerID(ctx context.Context, arg ListMCP
 }
 
 const listMCPEndpointsByMetaMCPServerID = `-- name: ListMCPEndpointsByMetaMCPServerID :many
-SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 FROM mcp_endpoints
 WHERE project_id = $1
   AND meta_mcp_server_id = $2::uuid
@@ -507,6 +737,7 @@ func (q *Queries) ListMCPEndpointsByMetaMCPServerID(ctx context.Context, arg Lis
 			&i.CustomDomainID,
 			&i.McpServerID,
 			&i.MetaMcpServerID,
+			&i.ToolsetVersion,
 			&i.Slug,
 			&i.IsDomainRoot,
 			&i.CreatedAt,
@@ -525,7 +756,7 @@ func (q *Queries) ListMCPEndpointsByMetaMCPServerID(ctx context.Context, arg Lis
 }
 
 const listMCPEndpointsByProject = `-- name: ListMCPEndpointsByProject :many
-SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 FROM mcp_endpoints
 WHERE project_id = $1 AND deleted IS FALSE
 ORDER BY created_at DESC
@@ -546,6 +777,7 @@ func (q *Queries) ListMCPEndpointsByProject(ctx context.Context, projectID uuid.
 			&i.CustomDomainID,
 			&i.McpServerID,
 			&i.MetaMcpServerID,
+			&i.ToolsetVersion,
 			&i.Slug,
 			&i.IsDomainRoot,
 			&i.CreatedAt,
@@ -564,7 +796,7 @@ func (q *Queries) ListMCPEndpointsByProject(ctx context.Context, projectID uuid.
 }
 
 const listRootMCPEndpointsByMCPServerID = `-- name: ListRootMCPEndpointsByMCPServerID :many
-SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 FROM mcp_endpoints
 WHERE mcp_server_id = $1::uuid
   AND project_id = $2
@@ -593,6 +825,7 @@ func (q *Queries) ListRootMCPEndpointsByMCPServerID(ctx context.Context, arg Lis
 			&i.CustomDomainID,
 			&i.McpServerID,
 			&i.MetaMcpServerID,
+			&i.ToolsetVersion,
 			&i.Slug,
 			&i.IsDomainRoot,
 			&i.CreatedAt,
@@ -611,7 +844,7 @@ func (q *Queries) ListRootMCPEndpointsByMCPServerID(ctx context.Context, arg Lis
 }
 
 const lockMCPEndpointByID = `-- name: LockMCPEndpointByID :one
-SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 FROM mcp_endpoints
 WHERE id = $1 AND project_id = $2 AND deleted IS FALSE
 FOR UPDATE
@@ -631,6 +864,7 @@ func (q *Queries) LockMCPEndpointByID(ctx context.Context, arg LockMCPEndpointBy
 		&i.CustomDomainID,
 		&i.McpServerID,
 		&i.MetaMcpServerID,
+		&i.ToolsetVersion,
 		&i.Slug,
 		&i.IsDomainRoot,
 		&i.CreatedAt,
@@ -642,7 +876,7 @@ func (q *Queries) LockMCPEndpointByID(ctx context.Context, arg LockMCPEndpointBy
 }
 
 const lockMCPEndpointsByMCPServerID = `-- name: LockMCPEndpointsByMCPServerID :many
-SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 FROM mcp_endpoints
 WHERE mcp_server_id = $1::uuid
   AND project_id = $2
@@ -675,6 +909,7 @@ func (q *Queries) LockMCPEndpointsByMCPServerID(ctx context.Context, arg LockMCP
 			&i.CustomDomainID,
 			&i.McpServerID,
 			&i.MetaMcpServerID,
+			&i.ToolsetVersion,
 			&i.Slug,
 			&i.IsDomainRoot,
 			&i.CreatedAt,
@@ -693,7 +928,7 @@ func (q *Queries) LockMCPEndpointsByMCPServerID(ctx context.Context, arg LockMCP
 }
 
 const lockMCPEndpointsByMetaMCPServerID = `-- name: LockMCPEndpointsByMetaMCPServerID :many
-SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 FROM mcp_endpoints
 WHERE meta_mcp_server_id = $1::uuid
   AND project_id = $2
@@ -727,6 +962,7 @@ func (q *Queries) LockMCPEndpointsByMetaMCPServerID(ctx context.Context, arg Loc
 			&i.CustomDomainID,
 			&i.McpServerID,
 			&i.MetaMcpServerID,
+			&i.ToolsetVersion,
 			&i.Slug,
 			&i.IsDomainRoot,
 			&i.CreatedAt,
@@ -745,7 +981,7 @@ func (q *Queries) LockMCPEndpointsByMetaMCPServerID(ctx context.Context, arg Loc
 }
 
 const lockRootMCPEndpointsByMCPServerID = `-- name: LockRootMCPEndpointsByMCPServerID :many
-SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 FROM mcp_endpoints
 WHERE mcp_server_id = $1::uuid
   AND project_id = $2
@@ -775,6 +1011,7 @@ func (q *Queries) LockRootMCPEndpointsByMCPServerID(ctx context.Context, arg Loc
 			&i.CustomDomainID,
 			&i.McpServerID,
 			&i.MetaMcpServerID,
+			&i.ToolsetVersion,
 			&i.Slug,
 			&i.IsDomainRoot,
 			&i.CreatedAt,
@@ -792,13 +1029,47 @@ func (q *Queries) LockRootMCPEndpointsByMCPServerID(ctx context.Context, arg Loc
 	return items, nil
 }
 
+const lockRunningMCPEndpointCanary = `-- name: LockRunningMCPEndpointCanary :one
+SELECT id, project_id, mcp_endpoint_id, baseline_deployment_id, candidate_deployment_id, percentage, sticky_by, status, started_at, ended_at, created_at, updated_at
+FROM mcp_endpoint_canaries
+WHERE mcp_endpoint_id = $1
+  AND project_id = $2
+  AND status = 'running'
+FOR UPDATE
+`
+
+type LockRunningMCPEndpointCanaryParams struct {
+	McpEndpointID uuid.UUID
+	ProjectID     uuid.UUID
+}
+
+func (q *Queries) LockRunningMCPEndpointCanary(ctx context.Context, arg LockRunningMCPEndpointCanaryParams) (McpEndpointCanary, error) {
+	row := q.db.QueryRow(ctx, lockRunningMCPEndpointCanary, arg.McpEndpointID, arg.ProjectID)
+	var i McpEndpointCanary
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.McpEndpointID,
+		&i.BaselineDeploymentID,
+		&i.CandidateDeploymentID,
+		&i.Percentage,
+		&i.StickyBy,
+		&i.Status,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const softDeleteMCPEndpointsByCustomDomainID = `-- name: SoftDeleteMCPEndpointsByCustomDomainID :many
 UPDATE mcp_endpoints
 SET
     is_domain_root = NULL,
     deleted_at = clock_timestamp()
 WHERE custom_domain_id = $1::uuid AND deleted IS FALSE
-RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 `
 
 // Soft-delete all endpoints registered under a given custom_domain. Used when
@@ -824,6 +1095,7 @@ func (q *Queries) SoftDeleteMCPEndpointsByCustomDomainID(ctx context.Context, cu
 			&i.CustomDomainID,
 			&i.McpServerID,
 			&i.MetaMcpServerID,
+			&i.ToolsetVersion,
 			&i.Slug,
 			&i.IsDomainRoot,
 			&i.CreatedAt,
@@ -847,7 +1119,7 @@ SET
     is_domain_root = NULL,
     deleted_at = clock_timestamp()
 WHERE mcp_server_id = $1::uuid AND project_id = $2 AND deleted IS FALSE
-RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 `
 
 type SoftDeleteMCPEndpointsByMCPServerIDParams struct {
@@ -875,6 +1147,7 @@ func (q *Queries) SoftDeleteMCPEndpointsByMCPServerID(ctx context.Context, arg S
 			&i.CustomDomainID,
 			&i.McpServerID,
 			&i.MetaMcpServerID,
+			&i.ToolsetVersion,
 			&i.Slug,
 			&i.IsDomainRoot,
 			&i.CreatedAt,
@@ -898,7 +1171,7 @@ SET
     is_domain_root = NULL,
     deleted_at = clock_timestamp()
 WHERE meta_mcp_server_id = $1::uuid AND project_id = $2 AND deleted IS FALSE
-RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 `
 
 type SoftDeleteMCPEndpointsByMetaMCPServerIDParams struct {
@@ -926,6 +1199,7 @@ func (q *Queries) SoftDeleteMCPEndpointsByMetaMCPServerID(ctx context.Context, a
 			&i.CustomDomainID,
 			&i.McpServerID,
 			&i.MetaMcpServerID,
+			&i.ToolsetVersion,
 			&i.Slug,
 			&i.IsDomainRoot,
 			&i.CreatedAt,
@@ -949,17 +1223,19 @@ SET
     custom_domain_id = $1,
     mcp_server_id = $2,
     meta_mcp_server_id = $3,
-    slug = $4,
-    is_domain_root = $5,
+    toolset_version = $4,
+    slug = $5,
+    is_domain_root = $6,
     updated_at = clock_timestamp()
-WHERE id = $6 AND project_id = $7 AND deleted IS FALSE
-RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
+WHERE id = $7 AND project_id = $8 AND deleted IS FALSE
+RETURNING id, project_id, custom_domain_id, mcp_server_id, meta_mcp_server_id, toolset_version, slug, is_domain_root, created_at, updated_at, deleted_at, deleted
 `
 
 type UpdateMCPEndpointParams struct {
 	CustomDomainID  uuid.NullUUID
 	McpServerID     uuid.NullUUID
 	MetaMcpServerID uuid.NullUUID
+	ToolsetVersion  pgtype.Int8
 	Slug            string
 	IsDomainRoot    pgtype.Bool
 	ID              uuid.UUID
@@ -971,6 +1247,7 @@ func (q *Queries) UpdateMCPEndpoint(ctx context.Context, arg UpdateMCPEndpointPa
 		arg.CustomDomainID,
 		arg.McpServerID,
 		arg.MetaMcpServerID,
+		arg.ToolsetVersion,
 		arg.Slug,
 		arg.IsDomainRoot,
 		arg.ID,
@@ -983,6 +1260,7 @@ func (q *Queries) UpdateMCPEndpoint(ctx context.Context, arg UpdateMCPEndpointPa
 		&i.CustomDomainID,
 		&i.McpServerID,
 		&i.MetaMcpServerID,
+		&i.ToolsetVersion,
 		&i.Slug,
 		&i.IsDomainRoot,
 		&i.CreatedAt,
@@ -992,3 +1270,38 @@ func (q *Queries) UpdateMCPEndpoint(ctx context.Context, arg UpdateMCPEndpointPa
 	)
 	return i, err
 }
+
+const updateMCPEndpointCanaryPercentage = `-- name: UpdateMCPEndpointCanaryPercentage :one
+UPDATE mcp_endpoint_canaries
+SET
+    percentage = $1,
+    updated_at = clock_timestamp()
+WHERE id = $2 AND project_id = $3 AND status = 'running'
+RETURNING id, project_id, mcp_endpoint_id, baseline_deployment_id, candidate_deployment_id, percentage, sticky_by, status, started_at, ended_at, created_at, updated_at
+`
+
+type UpdateMCPEndpointCanaryPercentageParams struct {
+	Percentage int32
+	ID         uuid.UUID
+	ProjectID  uuid.UUID
+}
+
+func (q *Queries) UpdateMCPEndpointCanaryPercentage(ctx context.Context, arg UpdateMCPEndpointCanaryPercentageParams) (McpEndpointCanary, error) {
+	row := q.db.QueryRow(ctx, updateMCPEndpointCanaryPercentage, arg.Percentage, arg.ID, arg.ProjectID)
+	var i McpEndpointCanary
+	err := row.Scan(
+		&i.ID,
+		&i.ProjectID,
+		&i.McpEndpointID,
+		&i.BaselineDeploymentID,
+		&i.CandidateDeploymentID,
+		&i.Percentage,
+		&i.StickyBy,
+		&i.Status,
+		&i.StartedAt,
+		&i.EndedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
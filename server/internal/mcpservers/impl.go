@@ -160,6 +160,9 @@ func (s *Service) CreateMcpServer(ctx context.Context, payload *gen.CreateMcpSer
 	if err := verifyServerReferenceOwnership(ctx, dbtx, *authCtx.ProjectID, ids); err != nil {
 		return nil, oops.E(oops.CodeInvalid, err, "invalid mcp server").LogError(ctx, logger)
 	}
+	if err := VerifyToolsetVersion(ctx, dbtx, ids.ToolsetID, payload.ToolsetVersion); err != nil {
+		return nil, oops.E(oops.CodeInvalid, err, "invalid mcp server").LogError(ctx, logger)
+	}
 
 	if err := verifyTunneledPublicConsent(ctx, dbtx, *authCtx.ProjectID, ids.TunneledMcpServerID, string(payload.Visibility)); err != nil {
 		return nil, oops.E(oops.CodeInvalid, err, "invalid mcp server").LogWarn(ctx, logger)
@@ -178,6 +181,7 @@ func (s *Service) CreateMcpServer(ctx context.Context, payload *gen.CreateMcpSer
 		ToolsetID:             ids.ToolsetID,
 		UnproxiedMCPServerID:  ids.UnproxiedMcpServerID,
 		ToolVariationsGroupID: ids.ToolVariationsGroupID,
+		ToolsetVersion:        conv.PtrInt64ToPGInt8(payload.ToolsetVersion),
 	})
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -447,6 +451,10 @@ func (s *Service) ListToolFilters(ctx context.Context, payload *gen.ListToolFilt
 
 	// DescribeToolset applies the resolved group's variation overrides to the
 	// tools, so the derived effective tags match the runtime ?tags= result.
+	// A server pinned to a toolset version filters that version's tools.
+	if server.ToolsetVersion.Valid {
+		ctx = mv.WithPinnedToolsetVersion(ctx, toolset.ID, server.ToolsetVersion.Int64)
+	}
 	described, err := mv.DescribeToolset(ctx, s.logger, s.db, mv.ProjectID(*authCtx.ProjectID), mv.ToolsetSlug(toolset.Slug), nil, resolved)
 	if err != nil {
 		return nil, err
@@ -616,6 +624,25 @@ func (s *Service) UpdateMcpServer(ctx context.Context, payload *gen.UpdateMcpSer
 	if err := verifyServerReferenceOwnership(ctx, dbtx, *authCtx.ProjectID, ids); err != nil {
 		return nil, oops.E(oops.CodeInvalid, err, "invalid mcp server").LogError(ctx, logger)
 	}
+	if err := VerifyToolsetVersion(ctx, dbtx, ids.ToolsetID, payload.ToolsetVersion); err != nil {
+		return nil, oops.E(oops.CodeInvalid, err, "invalid mcp server").LogError(ctx, logger)
+	}
+
+	// An endpoint's pinned version names a version of the server's toolset.
+	// Endpoint updates lock this server row before checking their pin, so
+	// none can be pinned to the old toolset once this check passes.
+	if ids.ToolsetID != existing.ToolsetID {
+		pinned, err := mcpendpointsrepo.New(dbtx).HasPinnedMCPEndpointsByMCPServerID(ctx, mcpendpointsrepo.HasPinnedMCPEndpointsByMCPServerIDParams{
+			McpServerID: serverID,
+			ProjectID:   *authCtx.ProjectID,
+		})
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "check pinned mcp endpoints").LogError(ctx, logger)
+		}
+		if pinned {
+			return nil, oops.E(oops.CodeConflict, nil, "mcp endpoints of this server pin a toolset version; unpin them before changing its toolset").LogError(ctx, logger)
+		}
+	}
 
 	// Resolve name: nil = leave existing; non-nil = trim and require non-empty.
 	name := existing.Name
@@ -656,6 +683,7 @@ func (s *Service) UpdateMcpServer(ctx context.Context, payload *gen.UpdateMcpSer
 		ToolsetID:             ids.ToolsetID,
 		UnproxiedMcpServerID:  ids.UnproxiedMcpServerID,
 		ToolVariationsGroupID: ids.ToolVariationsGroupID,
+		ToolsetVersion:        conv.PtrInt64ToPGInt8(payload.ToolsetVersion),
 		Visibility:            string(payload.Visibility),
 		ID:                    serverID,
 		ProjectID:             *authCtx.ProjectID,
@@ -1350,3 +1378,27 @@ func verifyServerReferenceOwnership(
 
 	return nil
 }
+
+// VerifyToolsetVersion checks that a toolset version pin refers to a recorded
+// version of the given toolset. A nil version means "serve the latest" and is
+// always valid. The toolset's project ownership is checked separately.
+func VerifyToolsetVersion(ctx context.Context, dbtx toolsetsrepo.DBTX, toolsetID uuid.NullUUID, version *int64) error {
+	if version == nil {
+		return nil
+	}
+	if !toolsetID.Valid {
+		return fmt.Errorf("toolset_version can only be set for toolset-backed mcp servers")
+	}
+
+	if _, err := toolsetsrepo.New(dbtx).GetToolsetVersion(ctx, toolsetsrepo.GetToolsetVersionParams{
+		ToolsetID: toolsetID.UUID,
+		Version:   *version,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("toolset_version %d does not exist for this toolset", *version)
+		}
+		return fmt.Errorf("check toolset version: %w", err)
+	}
+
+	return nil
+}
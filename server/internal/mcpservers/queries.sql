@@ -11,6 +11,7 @@ INSERT INTO mcp_servers (
     toolset_id,
     unproxied_mcp_server_id,
     tool_variations_group_id,
+    toolset_version,
     visibility
 )
 VALUES (
@@ -25,6 +26,7 @@ VALUES (
     @toolset_id,
     @unproxied_mcp_server_id,
     @tool_variations_group_id,
+    @toolset_version,
     @visibility
 )
 RETURNING *;
@@ -89,7 +91,7 @@ WHERE p.organization_id = @organization_id
 ORDER BY m.created_at DESC;
 
 -- name: ListMCPServersByProjectIDLimited :many
-SELECT id, project_id, name, slug, environment_id, user_session_issuer_id, remote_mcp_server_id, tunneled_mcp_server_id, toolset_id, unproxied_mcp_server_id, tool_variations_group_id, visibility, created_at, updated_at, deleted_at, deleted
+SELECT id, project_id, name, slug, environment_id, user_session_issuer_id, remote_mcp_server_id, tunneled_mcp_server_id, toolset_id, unproxied_mcp_server_id, tool_variations_group_id, toolset_version, visibility, created_at, updated_at, deleted_at, deleted
 FROM mcp_servers
 WHERE project_id = @project_id
   AND deleted IS FALSE
@@ -144,6 +146,7 @@ SET
     toolset_id = @toolset_id,
     unproxied_mcp_server_id = @unproxied_mcp_server_id,
     tool_variations_group_id = @tool_variations_group_id,
+    toolset_version = @toolset_version,
     visibility = @visibility,
     updated_at = clock_timestamp()
 WHERE id = @id AND project_id = @project_id AND deleted IS FALSE
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/speakeasy-api/gram/server/internal/audit"
 	"github.com/speakeasy-api/gram/server/internal/mcpservers/repo"
@@ -45,6 +46,7 @@ func CreateRemoteBackedMCPServer(ctx context.Context, tx pgx.Tx, auditLogger *au
 		ToolsetID:             uuid.NullUUID{UUID: uuid.Nil, Valid: false},
 		UnproxiedMCPServerID:  uuid.NullUUID{UUID: uuid.Nil, Valid: false},
 		ToolVariationsGroupID: uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		ToolsetVersion:        pgtype.Int8{Int64: 0, Valid: false},
 	})
 }
 
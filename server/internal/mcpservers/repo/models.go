@@ -22,6 +22,7 @@ type McpServer struct {
 	ToolsetID             uuid.NullUUID
 	UnproxiedMcpServerID  uuid.NullUUID
 	ToolVariationsGroupID uuid.NullUUID
+	ToolsetVersion        pgtype.Int8
 	Visibility            string
 	CreatedAt             pgtype.Timestamptz
 	UpdatedAt             pgtype.Timestamptz
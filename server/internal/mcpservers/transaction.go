@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 
 	"github.com/speakeasy-api/gram/server/internal/audit"
 	"github.com/speakeasy-api/gram/server/internal/conv"
@@ -29,6 +30,7 @@ type MCPServerTransactionInput struct {
 	ToolsetID             uuid.NullUUID
 	UnproxiedMCPServerID  uuid.NullUUID
 	ToolVariationsGroupID uuid.NullUUID
+	ToolsetVersion        pgtype.Int8
 }
 
 // CreateMCPServerInTransaction creates the MCP server, its required lifetime
@@ -69,6 +71,7 @@ func CreateMCPServerInTransaction(ctx context.Context, tx pgx.Tx, auditLogger *a
 		ToolsetID:             input.ToolsetID,
 		UnproxiedMcpServerID:  input.UnproxiedMCPServerID,
 		ToolVariationsGroupID: input.ToolVariationsGroupID,
+		ToolsetVersion:        input.ToolsetVersion,
 		Visibility:            input.Visibility,
 	})
 	if err != nil {
@@ -17,6 +17,7 @@ func BuildMcpEndpointView(endpoint repo.McpEndpoint) *types.McpEndpoint {
 		CustomDomainID:  conv.FromNullableUUID(endpoint.CustomDomainID),
 		McpServerID:     conv.FromNullableUUID(endpoint.McpServerID),
 		MetaMcpServerID: conv.FromNullableUUID(endpoint.MetaMcpServerID),
+		ToolsetVersion:  conv.FromPGInt8(endpoint.ToolsetVersion),
 		Slug:            types.McpEndpointSlug(endpoint.Slug),
 		IsDomainRoot:    endpoint.IsDomainRoot.Valid && endpoint.IsDomainRoot.Bool,
 		CreatedAt:       endpoint.CreatedAt.Time.Format(time.RFC3339),
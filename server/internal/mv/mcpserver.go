@@ -23,6 +23,7 @@ func BuildMcpServerView(server repo.McpServer) *types.McpServer {
 		ToolsetID:             conv.FromNullableUUID(server.ToolsetID),
 		UnproxiedMcpServerID:  conv.FromNullableUUID(server.UnproxiedMcpServerID),
 		ToolVariationsGroupID: conv.FromNullableUUID(server.ToolVariationsGroupID),
+		ToolsetVersion:        conv.FromPGInt8(server.ToolsetVersion),
 		Visibility:            types.McpServerVisibility(server.Visibility),
 		CreatedAt:             server.CreatedAt.Time.Format(time.RFC3339),
 		UpdatedAt:             server.UpdatedAt.Time.Format(time.RFC3339),
@@ -18,6 +18,7 @@ import (
 	"github.com/speakeasy-api/gram/server/gen/types"
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/canary"
 	"github.com/speakeasy-api/gram/server/internal/constants"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	deploymentR "github.com/speakeasy-api/gram/server/internal/deployments/repo"
@@ -311,7 +312,7 @@ func DescribeToolsetEntries(
 	eg1, eg1Ctx := errgroup.WithContext(ctx)
 	eg1.Go(func() error {
 		defer close(httpDefsCh)
-		rows, err := toolsRepo.FindHttpToolEntriesByUrn(eg1Ctx, tr.FindHttpToolEntriesByUrnParams{ProjectID: pid, Urns: allToolUrns})
+		rows, err := toolsRepo.FindHttpToolEntriesByUrn(eg1Ctx, tr.FindHttpToolEntriesByUrnParams{ProjectID: pid, Urns: allToolUrns, DeploymentID: canary.DeploymentID(eg1Ctx)})
 		if err != nil {
 			return fmt.Errorf("batch http tools: %w", err)
 		}
@@ -329,7 +330,7 @@ func DescribeToolsetEntries(
 	})
 	eg1.Go(func() error {
 		defer close(funcDefsCh)
-		rows, err := toolsRepo.FindFunctionToolEntriesByUrn(eg1Ctx, tr.FindFunctionToolEntriesByUrnParams{ProjectID: pid, Urns: allToolUrns})
+		rows, err := toolsRepo.FindFunctionToolEntriesByUrn(eg1Ctx, tr.FindFunctionToolEntriesByUrnParams{ProjectID: pid, Urns: allToolUrns, DeploymentID: canary.DeploymentID(eg1Ctx)})
 		if err != nil {
 			return fmt.Errorf("batch function tools: %w", err)
 		}
@@ -578,6 +579,28 @@ func DescribeToolsetEntries(
 	return result, nil
 }
 
+type pinnedToolsetVersionKey struct{}
+
+type pinnedToolsetVersion struct {
+	toolsetID uuid.UUID
+	version   int64
+}
+
+// WithPinnedToolsetVersion makes DescribeToolset read the given immutable
+// toolset_versions row for toolsetID instead of the latest one. Other
+// toolsets described under the same context are unaffected.
+func WithPinnedToolsetVersion(ctx context.Context, toolsetID uuid.UUID, version int64) context.Context {
+	return context.WithValue(ctx, pinnedToolsetVersionKey{}, pinnedToolsetVersion{toolsetID: toolsetID, version: version})
+}
+
+func pinnedToolsetVersionFromContext(ctx context.Context, toolsetID uuid.UUID) (int64, bool) {
+	pin, ok := ctx.Value(pinnedToolsetVersionKey{}).(pinnedToolsetVersion)
+	if !ok || pin.toolsetID != toolsetID {
+		return 0, false
+	}
+	return pin.version, true
+}
+
 // DescribeToolset builds the full view of a toolset including its tools with
 // variation overrides applied. toolVariationsGroupID selects which variation
 // group supplies those overrides: a non-nil value resolves overrides from that
@@ -628,11 +651,32 @@ func DescribeToolset(
 		logger.ErrorContext(ctx, "failed to get active deployment id", attr.SlogError(err))
 	}
 
-	// Get tool URNs and resource URNs from latest toolset version
+	// Requests under a canary read from the deployment their arm was assigned
+	// so the cache key and external MCP tool listing follow the same pin.
+	if pinned := canary.DeploymentID(ctx); pinned.Valid {
+		activeDeploymentID = pinned.UUID
+	}
+
+	// Get tool URNs and resource URNs from latest toolset version, or from
+	// the version the caller pinned for this toolset.
 	var toolUrns []string
 	var resourceUrns []string
 	var toolsetVersion int64
-	latestVersion, err := toolsetRepo.GetLatestToolsetVersion(ctx, toolset.ID)
+	var latestVersion tsr.ToolsetVersion
+	if pinned, ok := pinnedToolsetVersionFromContext(ctx, toolset.ID); ok {
+		latestVersion, err = toolsetRepo.GetToolsetVersion(ctx, tsr.GetToolsetVersionParams{
+			ToolsetID: toolset.ID,
+			Version:   pinned,
+		})
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, oops.E(oops.CodeNotFound, err, "pinned toolset version not found").LogError(ctx, logger)
+		case err != nil:
+			return nil, oops.E(oops.CodeUnexpected, err, "failed to load pinned toolset version").LogError(ctx, logger)
+		}
+	} else {
+		latestVersion, err = toolsetRepo.GetLatestToolsetVersion(ctx, toolset.ID)
+	}
 	if err == nil {
 		toolUrns = make([]string, len(latestVersion.ToolUrns))
 		for i, urn := range latestVersion.ToolUrns {
@@ -1109,8 +1153,9 @@ func readToolsetTools(
 
 	if len(toolUrns) > 0 {
 		definitions, err := toolsRepo.FindHttpToolsByUrn(ctx, tr.FindHttpToolsByUrnParams{
-			ProjectID: pid,
-			Urns:      toolUrns,
+			ProjectID:    pid,
+			Urns:         toolUrns,
+			DeploymentID: canary.DeploymentID(ctx),
 		})
 		if err != nil {
 			return nil, oops.E(oops.CodeUnexpected, err, "failed to list tools in toolset").LogError(ctx, logger)
@@ -1232,8 +1277,9 @@ func readToolsetTools(
 		tools = append(tools, platformtools.FindTypedTools(pid, toolUrns, platformExtras...)...)
 
 		functionDefinitions, err := toolsRepo.FindFunctionToolsByUrn(ctx, tr.FindFunctionToolsByUrnParams{
-			ProjectID: pid,
-			Urns:      toolUrns,
+			ProjectID:    pid,
+			Urns:         toolUrns,
+			DeploymentID: canary.DeploymentID(ctx),
 		})
 		if err != nil {
 			return nil, oops.E(oops.CodeUnexpected, err, "failed to get function tools for toolset").LogError(ctx, logger)
@@ -1489,24 +1535,34 @@ func ApplyVariations(ctx context.Context, logger *slog.Logger, tx DBTX, projectI
 
 	urnToVariation := make(map[string]types.ToolVariation, len(allVariations))
 	for _, variation := range allVariations {
+		// A tool whose bindings cannot be read must not be served without
+		// them, so the whole listing fails instead.
+		argumentBindings, err := conv.ArgumentBindingsFromJSON(variation.ArgumentBindings)
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "failed to decode tool variation argument bindings").LogError(ctx, logger, attr.SlogToolURN(variation.SrcToolUrn.String()))
+		}
+
 		urnToVariation[variation.SrcToolUrn.String()] = types.ToolVariation{
-			ID:              variation.ID.String(),
-			GroupID:         variation.GroupID.String(),
-			SrcToolUrn:      variation.SrcToolUrn.String(),
-			SrcToolName:     variation.SrcToolName,
-			Confirm:         conv.FromPGText[string](variation.Confirm),
-			ConfirmPrompt:   conv.FromPGText[string](variation.ConfirmPrompt),
-			Name:            conv.FromPGText[string](variation.Name),
-			Description:     conv.FromPGText[string](variation.Description),
-			Tags:            variation.Tags,
-			Summarizer:      conv.FromPGText[string](variation.Summarizer),
-			Title:           conv.FromPGText[string](variation.Title),
-			ReadOnlyHint:    conv.FromPGBool[bool](variation.ReadOnlyHint),
-			DestructiveHint: conv.FromPGBool[bool](variation.DestructiveHint),
-			IdempotentHint:  conv.FromPGBool[bool](variation.IdempotentHint),
-			OpenWorldHint:   conv.FromPGBool[bool](variation.OpenWorldHint),
-			CreatedAt:       variation.CreatedAt.Time.Format(time.RFC3339),
-			UpdatedAt:       variation.UpdatedAt.Time.Format(time.RFC3339),
+			ID:               variation.ID.String(),
+			GroupID:          variation.GroupID.String(),
+			SrcToolUrn:       variation.SrcToolUrn.String(),
+			SrcToolName:      variation.SrcToolName,
+			Confirm:          conv.FromPGText[string](variation.Confirm),
+			ConfirmPrompt:    conv.FromPGText[string](variation.ConfirmPrompt),
+			Name:             conv.FromPGText[string](variation.Name),
+			Description:      conv.FromPGText[string](variation.Description),
+			Tags:             variation.Tags,
+			Summarizer:       conv.FromPGText[string](variation.Summarizer),
+			Title:            conv.FromPGText[string](variation.Title),
+			ReadOnlyHint:     conv.FromPGBool[bool](variation.ReadOnlyHint),
+			DestructiveHint:  conv.FromPGBool[bool](variation.DestructiveHint),
+			IdempotentHint:   conv.FromPGBool[bool](variation.IdempotentHint),
+			OpenWorldHint:    conv.FromPGBool[bool](variation.OpenWorldHint),
+			CacheTTLSeconds:  conv.FromPGInt4(variation.CacheTtlSeconds),
+			CacheShared:      conv.FromPGBool[bool](variation.CacheShared),
+			ArgumentBindings: argumentBindings,
+			CreatedAt:        variation.CreatedAt.Time.Format(time.RFC3339),
+			UpdatedAt:        variation.UpdatedAt.Time.Format(time.RFC3339),
 		}
 	}
 
@@ -127,6 +127,8 @@ func (c Code) MCPCode() MCPCode {
 		return MCPCodeInvalidParams
 	case CodeNotImplemented:
 		return MCPCodeMethodNotFound
+	case CodeRateLimitExceeded:
+		return MCPCodeRateLimited
 	default:
 		return MCPCodeInternalError
 	}
@@ -36,6 +36,7 @@ func MCPErrHandle(logger *slog.Logger, handler func(http.ResponseWriter, *http.R
 			ID:      mcpID,
 			Code:    MCPCodeInternalError,
 			Message: MCPCodeInternalError.Message(),
+			Data:    nil,
 		}
 
 		var shareableErr *ShareableError
@@ -73,6 +74,7 @@ const (
 	MCPCodeUnauthorized     MCPCode = -32001
 	MCPCodeResourceNotFound MCPCode = -32002
 	MCPCodeForbidden        MCPCode = -32003
+	MCPCodeRateLimited      MCPCode = -32004
 )
 
 func (c MCPCode) Message() string {
@@ -93,6 +95,8 @@ func (c MCPCode) Message() string {
 		return "Resource not found"
 	case MCPCodeForbidden:
 		return "Forbidden"
+	case MCPCodeRateLimited:
+		return "Rate limit exceeded"
 	default:
 		return "Internal error"
 	}
@@ -102,6 +106,10 @@ type MCPError struct {
 	ID      mcpjsonrpc.ID
 	Code    MCPCode
 	Message string
+	// Data is an optional structured payload surfaced in the JSON-RPC error's
+	// "data" field, e.g. retry hints on a rate-limit rejection. Must be
+	// JSON-marshalable and safe to show to the caller.
+	Data any
 }
 
 func NewMCPErrorFromCause(id mcpjsonrpc.ID, source error) *MCPError {
@@ -119,12 +127,14 @@ func NewMCPErrorFromCause(id mcpjsonrpc.ID, source error) *MCPError {
 			ID:      id,
 			Code:    shareableErr.Code.MCPCode(),
 			Message: shareableErr.Error(),
+			Data:    nil,
 		}
 	default:
 		return &MCPError{
 			ID:      id,
 			Code:    MCPCodeInternalError,
 			Message: MCPCodeInternalError.Message(),
+			Data:    nil,
 		}
 	}
 }
@@ -145,6 +155,9 @@ func (e *MCPError) MarshalJSON() ([]byte, error) {
 		"code":    e.Code,
 		"message": e.message(),
 	}
+	if e.Data != nil {
+		errorBody["data"] = e.Data
+	}
 
 	payload := map[string]any{
 		"jsonrpc": "2.0",
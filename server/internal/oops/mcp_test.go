@@ -90,6 +90,29 @@ func TestMCPError_MarshalJSON(t *testing.T) {
 	require.NotContains(t, errorBody, "data")
 }
 
+func TestMCPError_MarshalJSONIncludesData(t *testing.T) {
+	t.Parallel()
+
+	err := &MCPError{
+		ID:      mcpjsonrpc.NumberID(1),
+		Code:    MCPCodeRateLimited,
+		Message: "rate limit exceeded",
+		Data:    map[string]any{"retry_after_seconds": 3},
+	}
+
+	data, marshalErr := json.Marshal(err)
+	require.NoError(t, marshalErr)
+
+	var response map[string]any
+	unmarshalErr := json.Unmarshal(data, &response)
+	require.NoError(t, unmarshalErr)
+
+	errorBody, ok := response["error"].(map[string]any)
+	require.True(t, ok)
+	require.InDelta(t, -32004, errorBody["code"], 0)
+	require.Equal(t, map[string]any{"retry_after_seconds": float64(3)}, errorBody["data"])
+}
+
 func TestCodeMCPCode(t *testing.T) {
 	t.Parallel()
 
@@ -107,6 +130,7 @@ func TestCodeMCPCode(t *testing.T) {
 		{"not_found_is_resource_not_found", CodeNotFound, MCPCodeResourceNotFound},
 		{"invalid_is_invalid_params", CodeInvalid, MCPCodeInvalidParams},
 		{"not_implemented_is_method_not_found", CodeNotImplemented, MCPCodeMethodNotFound},
+		{"rate_limit_exceeded_is_rate_limited", CodeRateLimitExceeded, MCPCodeRateLimited},
 		{"unexpected_defaults_to_internal", CodeUnexpected, MCPCodeInternalError},
 	}
 
@@ -121,7 +145,7 @@ func TestCodeMCPCode(t *testing.T) {
 	t.Run("auth_codes_are_in_server_defined_range", func(t *testing.T) {
 		t.Parallel()
 
-		for _, c := range []MCPCode{MCPCodeUnauthorized, MCPCodeForbidden} {
+		for _, c := range []MCPCode{MCPCodeUnauthorized, MCPCodeForbidden, MCPCodeRateLimited} {
 			require.GreaterOrEqual(t, int(c), -32099, "server-defined codes must be >= -32099")
 			require.LessOrEqual(t, int(c), -32000, "server-defined codes must be <= -32000")
 		}
@@ -119,6 +119,9 @@ type operationResult struct {
 	def              *repo.CreateOpenAPIv3ToolDefinitionParams
 	err              error
 	deploymentEvents []*repo.LogDeploymentEventParams
+	// serverCandidates holds the failover candidates of an operation that
+	// lists more than one server of its own.
+	serverCandidates []string
 }
 
 func (p *ToolExtractor) doSpeakeasy(
@@ -190,7 +193,26 @@ func (p *ToolExtractor) doSpeakeasy(
 	}
 
 	globalServerEnvVar := strcase.ToSNAKE(string(docInfo.Slug) + "_SERVER_URL")
-	globalDefaultServer := extractDefaultServerSpeakeasy(ctx, logger, docInfo, doc.GetServers())
+	globalServers := extractServerURLsSpeakeasy(ctx, logger, docInfo, doc.GetServers())
+	var globalDefaultServer *string
+	if len(globalServers) > 0 {
+		globalDefaultServer = &globalServers[0]
+	}
+
+	// Sources listing more than one server get their candidates recorded so
+	// the gateway can fail over between them.
+	if len(globalServers) > 1 {
+		err := tx.CreateHTTPServerCandidates(ctx, repo.CreateHTTPServerCandidatesParams{
+			DeploymentID:        task.DeploymentID,
+			ProjectID:           task.ProjectID,
+			Openapiv3DocumentID: task.DocumentID,
+			ServerEnvVar:        globalServerEnvVar,
+			ServerUrls:          globalServers,
+		})
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, oops.Permanent(err), "%s: error writing server candidates: %s", docInfo.Name, err.Error()).LogError(ctx, logger)
+		}
+	}
 
 	schemaCache := newConcurrentSchemaCache()
 
@@ -268,6 +290,23 @@ func (p *ToolExtractor) doSpeakeasy(
 				// TODO: Currently ignoring servers at path item level until we
 				// figure out how to name env variable
 
+				// Operations listing their own servers get a dedicated env var
+				// and, when they list more than one, their own candidates.
+				serverEnvVar := globalServerEnvVar
+				defaultServer := globalDefaultServer
+				var serverCandidates []string
+				if len(item.operation.Servers) > 0 {
+					serverEnvVar = strcase.ToSNAKE(fmt.Sprintf("%s_%s_SERVER_URL", docInfo.Slug, item.opID))
+					opServers := extractServerURLsSpeakeasy(ctx, logger, docInfo, item.operation.GetServers())
+					defaultServer = nil
+					if len(opServers) > 0 {
+						defaultServer = &opServers[0]
+					}
+					if len(opServers) > 1 {
+						serverCandidates = opServers
+					}
+				}
+
 				opTask := operationTask[
 					openapi.Operation,
 					openapi.ReferencedParameter,
@@ -279,8 +318,8 @@ func (p *ToolExtractor) doSpeakeasy(
 					operation:        item.operation,
 					sharedParameters: item.sharedParameters,
 					globalSecurity:   globalSecurity,
-					serverEnvVar:     globalServerEnvVar,
-					defaultServer:    globalDefaultServer,
+					serverEnvVar:     serverEnvVar,
+					defaultServer:    defaultServer,
 				}
 
 				def, events, err := extractToolDefSpeakeasy(
@@ -307,6 +346,7 @@ func (p *ToolExtractor) doSpeakeasy(
 						def:              nil,
 						err:              err,
 						deploymentEvents: events,
+						serverCandidates: nil,
 					}
 					continue
 				}
@@ -315,6 +355,7 @@ func (p *ToolExtractor) doSpeakeasy(
 					def:              &def,
 					err:              nil,
 					deploymentEvents: events,
+					serverCandidates: serverCandidates,
 				}
 			}
 		})
@@ -379,6 +420,22 @@ func (p *ToolExtractor) doSpeakeasy(
 			continue
 		}
 
+		if len(result.serverCandidates) > 0 {
+			if err := tx.CreateHTTPServerCandidates(ctx, repo.CreateHTTPServerCandidatesParams{
+				DeploymentID:        task.DeploymentID,
+				ProjectID:           task.ProjectID,
+				Openapiv3DocumentID: task.DocumentID,
+				ServerEnvVar:        result.def.ServerEnvVar,
+				ServerUrls:          result.serverCandidates,
+			}); err != nil {
+				if writeErr == nil {
+					writeErr = fmt.Errorf("%s: %s: error writing server candidates: %w", docInfo.Name, result.def.Openapiv3Operation.String, err)
+				}
+				writeErrCount++
+				continue
+			}
+		}
+
 		toolCount++
 	}
 
@@ -533,7 +590,12 @@ func extractSecuritySchemesSpeakeasy(ctx context.Context, logger *slog.Logger, d
 	return res, errs
 }
 
-func extractDefaultServerSpeakeasy(ctx context.Context, logger *slog.Logger, docInfo *types.OpenAPIv3DeploymentAsset, servers []*openapi.Server) *string {
+// extractServerURLsSpeakeasy returns the usable server URLs of a document in
+// the order they are listed. The first is the default server of its tools and
+// the rest are failover candidates. Servers with variables, malformed URLs or
+// a scheme other than https are skipped.
+func extractServerURLsSpeakeasy(ctx context.Context, logger *slog.Logger, docInfo *types.OpenAPIv3DeploymentAsset, servers []*openapi.Server) []string {
+	var urls []string
 	for _, server := range servers {
 		line, col := server.GetRootNodeLine(), server.GetRootNodeColumn()
 
@@ -556,11 +618,13 @@ func extractDefaultServerSpeakeasy(ctx context.Context, logger *slog.Logger, doc
 				continue
 			}
 
-			return &server.URL
+			if !slices.Contains(urls, server.URL) {
+				urls = append(urls, server.URL)
+			}
 		}
 	}
 
-	return nil
+	return urls
 }
 
 func extractToolDefSpeakeasy(ctx context.Context, logger *slog.Logger, doc *openapi.OpenAPI, schemaCache *concurrentSchemaCache, task operationTask[openapi.Operation, openapi.ReferencedParameter]) (repo.CreateOpenAPIv3ToolDefinitionParams, []*repo.LogDeploymentEventParams, error) {
@@ -594,10 +658,6 @@ func extractToolDefSpeakeasy(ctx context.Context, logger *slog.Logger, doc *open
 		return empty, deploymentEvents, tagError("invariants-violated", "not enough information to create tool definition: %w", err)
 	}
 
-	if len(op.Servers) > 0 {
-		return empty, deploymentEvents, tagError("op-servers", "per-operation servers are not currently supported [line: %d]", op.GetCore().Servers.GetKeyNodeOrRootLine(op.GetRootNode()))
-	}
-
 	defs := sequencedmap.New[string, *oas3.JSONSchema[oas3.Referenceable]]()
 
 	var bodyResult capturedRequestBodySpeakeasy
@@ -747,11 +807,6 @@ func extractToolDefSpeakeasy(ctx context.Context, logger *slog.Logger, doc *open
 		security = globalSecurity
 	}
 
-	if len(op.Servers) > 0 {
-		serverEnvVar = strcase.ToSNAKE(fmt.Sprintf("%s_%s_SERVER_URL", docInfo.Slug, opID))
-		defaultServer = extractDefaultServerSpeakeasy(ctx, logger, docInfo, op.GetServers())
-	}
-
 	var confirm *string
 	if descriptor.confirm != nil {
 		confirm = new(string(*descriptor.confirm))
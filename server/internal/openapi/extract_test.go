@@ -555,6 +555,82 @@ paths:
 	}
 }
 
+func TestDoProcess_OperationServersRecordFailoverCandidates(t *testing.T) {
+	t.Parallel()
+
+	logger := testenv.NewLogger(t)
+	tracer := testenv.NewTracerProvider(t).Tracer("github.com/speakeasy-api/gram/server/internal/openapi")
+
+	p := &ToolExtractor{
+		logger:       logger,
+		tracer:       tracer,
+		db:           nil,
+		feature:      nil,
+		assetStorage: nil,
+	}
+
+	mockedDBTX := &MockedDBTX{
+		recordedQueryRows: [][]any{},
+		recordedExec:      [][]any{},
+	}
+	tx := repo.New(mockedDBTX)
+
+	deploymentID := uuid.MustParse("87654321-4321-4321-4321-210987654321")
+	projectID := uuid.MustParse("12345678-1234-1234-1234-123456789012")
+	openapiDocID := uuid.MustParse("11111111-2222-3333-4444-555555555555")
+
+	doc := []byte(`
+openapi: 3.0.0
+info:
+  title: Test API
+  version: 1.0.0
+paths:
+  /test:
+    get:
+      operationId: testGet
+      summary: Test operation
+      servers:
+        - url: https://primary.example.com
+        - url: https://secondary.example.com
+      responses:
+        '200':
+          description: OK
+`)
+
+	tet := ToolExtractorTask{
+		Parser: "speakeasy",
+		DocInfo: &types.OpenAPIv3DeploymentAsset{
+			Name:    "test",
+			Slug:    "test",
+			ID:      "a",
+			AssetID: "b",
+		},
+		ProjectID:          projectID,
+		DeploymentID:       deploymentID,
+		DocumentID:         openapiDocID,
+		DocURL:             nil,
+		ProjectSlug:        "c",
+		OrgSlug:            "d",
+		OnOperationSkipped: nil,
+	}
+
+	result, err := p.doSpeakeasy(t.Context(), logger, tracer, tx, doc, tet)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	require.Len(t, mockedDBTX.recordedQueryRows, 1, "operation with its own servers should still produce a tool")
+	require.Contains(t, mockedDBTX.recordedQueryRows[0], "TEST_TEST_GET_SERVER_URL")
+
+	require.Len(t, mockedDBTX.recordedExec, 1, "operation servers should be recorded as failover candidates")
+	require.Equal(t, []any{
+		deploymentID,
+		projectID,
+		openapiDocID,
+		"TEST_TEST_GET_SERVER_URL",
+		[]string{"https://primary.example.com", "https://secondary.example.com"},
+	}, mockedDBTX.recordedExec[0])
+}
+
 // TestConcurrentSchemaCache_ReturnsIndependentSchemas guards the invariant
 // the extraction race fix depends on: concurrent readers of the same cache
 // entry must receive independent schema trees. The old cache handed out
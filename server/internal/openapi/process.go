@@ -216,6 +216,18 @@ func (p *ToolExtractor) Do(
 		logger.InfoContext(ctx, "cleared http security from previous deployment attempt", attr.SlogDBDeletedRowsCount(deletedSecurity))
 	}
 
+	deletedServers, err := tx.DangerouslyClearDeploymentHTTPServerCandidates(ctx, repo.DangerouslyClearDeploymentHTTPServerCandidatesParams{
+		DeploymentID:        deploymentID,
+		ProjectID:           projectID,
+		Openapiv3DocumentID: openapiDocID,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error clearing deployment server candidates").LogError(ctx, p.logger)
+	}
+	if deletedServers > 0 {
+		logger.InfoContext(ctx, "cleared server candidates from previous deployment attempt", attr.SlogDBDeletedRowsCount(deletedServers))
+	}
+
 	var res *ToolExtractorResult
 	if task.Parser != "speakeasy" {
 		logger.ErrorContext(ctx, "unrecognized parser specified: defaulting to speakeasy", attr.SlogDeploymentOpenAPIParser(task.Parser))
@@ -119,8 +119,8 @@ func (h *LogRelayHandler) handleBatch(ctx context.Context, messages []logRelayMe
 	}
 
 	type destinationResult struct {
-		destination *relayDestination
-		err         error
+		destinations []*relayDestination
+		err          error
 	}
 	destinations := make(map[string]destinationResult)
 	type destinationDelivery struct {
@@ -132,7 +132,7 @@ func (h *LogRelayHandler) handleBatch(ctx context.Context, messages []logRelayMe
 	for _, provenanceGroup := range groups {
 		result, ok := destinations[provenanceGroup.key.organizationID]
 		if !ok {
-			result.destination, result.err = h.relay.destinationForOrganization(ctx, provenanceGroup.key.organizationID)
+			result.destinations, result.err = h.relay.destinationsForOrganization(ctx, provenanceGroup.key.organizationID)
 			destinations[provenanceGroup.key.organizationID] = result
 		}
 		if result.err != nil {
@@ -149,7 +149,7 @@ func (h *LogRelayHandler) handleBatch(ctx context.Context, messages []logRelayMe
 			)
 			continue
 		}
-		if result.destination == nil {
+		if len(result.destinations) == 0 {
 			h.recordDroppedLogs(ctx, len(provenanceGroup.messages), relayReasonNoDestination)
 			continue
 		}
@@ -165,11 +165,13 @@ func (h *LogRelayHandler) handleBatch(ctx context.Context, messages []logRelayMe
 			)
 			continue
 		}
-		for _, batch := range batches {
-			deliveries = append(deliveries, destinationDelivery{
-				destination: result.destination,
-				batch:       batch,
-			})
+		for _, destination := range result.destinations {
+			for _, batch := range batches {
+				deliveries = append(deliveries, destinationDelivery{
+					destination: destination,
+					batch:       batch,
+				})
+			}
 		}
 	}
 
@@ -331,8 +331,8 @@ func cacheLogRelayTestDestination(
 	destination, err := handler.relay.newDestination(organizationID, baseURL, headers)
 	require.NoError(t, err)
 	handler.relay.destinationCache[organizationID] = cachedRelayDestination{
-		destination: destination,
-		expiresAt:   time.Now().Add(time.Hour),
+		destinations: []*relayDestination{destination},
+		expiresAt:    time.Now().Add(time.Hour),
 	}
 	return destination
 }
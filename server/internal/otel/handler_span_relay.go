@@ -123,8 +123,8 @@ func (h *SpanRelayHandler) handleBatch(ctx context.Context, messages []spanRelay
 	}
 
 	type destinationResult struct {
-		destination *relayDestination
-		err         error
+		destinations []*relayDestination
+		err          error
 	}
 	destinations := make(map[string]destinationResult)
 	type delivery struct {
@@ -137,7 +137,7 @@ func (h *SpanRelayHandler) handleBatch(ctx context.Context, messages []spanRelay
 	for _, provenanceGroup := range groups {
 		result, ok := destinations[provenanceGroup.key.organizationID]
 		if !ok {
-			result.destination, result.err = h.relay.destinationForOrganization(ctx, provenanceGroup.key.organizationID)
+			result.destinations, result.err = h.relay.destinationsForOrganization(ctx, provenanceGroup.key.organizationID)
 			destinations[provenanceGroup.key.organizationID] = result
 		}
 		if result.err != nil {
@@ -154,7 +154,7 @@ func (h *SpanRelayHandler) handleBatch(ctx context.Context, messages []spanRelay
 			)
 			continue
 		}
-		if result.destination == nil {
+		if len(result.destinations) == 0 {
 			h.recordDroppedSpans(ctx, len(provenanceGroup.messages), relayReasonNoDestination)
 			continue
 		}
@@ -168,11 +168,13 @@ func (h *SpanRelayHandler) handleBatch(ctx context.Context, messages []spanRelay
 			h.recordDroppedSpans(ctx, len(provenanceGroup.messages), relayReasonInvalid)
 			continue
 		}
-		deliveries = append(deliveries, delivery{
-			destination: result.destination,
-			request:     request,
-			messages:    provenanceGroup.messages,
-		})
+		for _, destination := range result.destinations {
+			deliveries = append(deliveries, delivery{
+				destination: destination,
+				request:     request,
+				messages:    provenanceGroup.messages,
+			})
+		}
 	}
 
 	var exportGroup errgroup.Group
@@ -181,8 +181,8 @@ func TestSpanRelayHandlerCountsInvalidAndMissingDestinationDrops(t *testing.T) {
 	t.Cleanup(func() { require.NoError(t, meterProvider.Shutdown(context.Background())) })
 	handler := newRelayTestHandler(t, meterProvider)
 	handler.relay.destinationCache["org-a"] = cachedRelayDestination{
-		destination: nil,
-		expiresAt:   time.Now().Add(time.Hour),
+		destinations: nil,
+		expiresAt:    time.Now().Add(time.Hour),
 	}
 
 	messages, failures := relayTestMessages(
@@ -324,8 +324,8 @@ func cacheRelayTestDestination(
 	destination, err := handler.relay.newDestination(organizationID, baseURL, headers)
 	require.NoError(t, err)
 	handler.relay.destinationCache[organizationID] = cachedRelayDestination{
-		destination: destination,
-		expiresAt:   time.Now().Add(time.Hour),
+		destinations: []*relayDestination{destination},
+		expiresAt:    time.Now().Add(time.Hour),
 	}
 	return destination
 }
@@ -9,17 +9,21 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/speakeasy-api/gram/server/internal/encryption"
 	"github.com/speakeasy-api/gram/server/internal/guardian"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/otelforwarding"
 	forwardingrepo "github.com/speakeasy-api/gram/server/internal/otelforwarding/repo"
 )
 
@@ -42,11 +46,13 @@ const (
 )
 
 // signalRelay resolves and caches customer-defined OTLP destinations, then
-// delivers one signal's protobuf export requests to its configured endpoint.
+// delivers one signal's export requests to each of an organization's
+// configured endpoints.
 type signalRelay struct {
 	readReplica      *pgxpool.Pool
 	encryptionClient *encryption.Client
 	policy           *guardian.Policy
+	grpcExporter     *otelforwarding.GRPCExporter
 	endpointPath     string
 	signalName       string
 	now              func() time.Time
@@ -57,18 +63,23 @@ type signalRelay struct {
 }
 
 type cachedRelayDestination struct {
-	destination *relayDestination
-	expiresAt   time.Time
+	destinations []*relayDestination
+	expiresAt    time.Time
 }
 
-// relayDestination sends protobuf exports to one organization's configured
-// OTLP endpoint using its forwarding headers and HTTP policy.
+// relayDestination sends exports to one of an organization's configured OTLP
+// endpoints using its forwarding headers and HTTP policy. The org-wide
+// forwarding config relays protobuf as is; forwarding destinations run each
+// export through their pipeline and encode it for their protocol.
 type relayDestination struct {
 	organizationID string
 	endpoint       string
 	headers        http.Header
 	httpClient     *guardian.HTTPClient
 	signalName     string
+	protocol       otelforwarding.Protocol
+	pipeline       *otelforwarding.Pipeline
+	grpcExporter   *otelforwarding.GRPCExporter
 }
 
 type relayExportError struct {
@@ -92,10 +103,15 @@ func newSignalRelay(
 	endpointPath string,
 	signalName string,
 ) *signalRelay {
+	var grpcExporter *otelforwarding.GRPCExporter
+	if policy != nil {
+		grpcExporter = otelforwarding.NewGRPCExporter(policy)
+	}
 	return &signalRelay{
 		readReplica:      readReplica,
 		encryptionClient: encryptionClient,
 		policy:           policy,
+		grpcExporter:     grpcExporter,
 		endpointPath:     endpointPath,
 		signalName:       signalName,
 		now:              time.Now,
@@ -105,26 +121,26 @@ func newSignalRelay(
 	}
 }
 
-func (r *signalRelay) destinationForOrganization(ctx context.Context, organizationID string) (*relayDestination, error) {
+func (r *signalRelay) destinationsForOrganization(ctx context.Context, organizationID string) ([]*relayDestination, error) {
 	now := r.now()
-	if cached, ok := r.cachedDestination(organizationID, now); ok {
+	if cached, ok := r.cachedDestinations(organizationID, now); ok {
 		return cached, nil
 	}
 
 	value, err, _ := r.destinationLoads.Do(organizationID, func() (any, error) {
 		now := r.now()
-		if cached, ok := r.cachedDestination(organizationID, now); ok {
-			return cachedRelayDestination{destination: cached, expiresAt: now.Add(relayDestinationCacheTTL)}, nil
+		if cached, ok := r.cachedDestinations(organizationID, now); ok {
+			return cachedRelayDestination{destinations: cached, expiresAt: now.Add(relayDestinationCacheTTL)}, nil
 		}
 
-		destination, err := r.loadDestination(ctx, organizationID)
+		destinations, err := r.loadDestinations(ctx, organizationID)
 		if err != nil {
 			return nil, err
 		}
 
 		cached := cachedRelayDestination{
-			destination: destination,
-			expiresAt:   now.Add(relayDestinationCacheTTL),
+			destinations: destinations,
+			expiresAt:    now.Add(relayDestinationCacheTTL),
 		}
 		r.cacheDestination(organizationID, cached, now)
 		return cached, nil
@@ -137,11 +153,46 @@ func (r *signalRelay) destinationForOrganization(ctx context.Context, organizati
 	if !ok {
 		return nil, fmt.Errorf("unexpected cached relay destination type %T", value)
 	}
-	return cached.destination, nil
+	return cached.destinations, nil
 }
 
-func (r *signalRelay) loadDestination(ctx context.Context, organizationID string) (*relayDestination, error) {
-	config, err := forwardingrepo.New(r.readReplica).GetOrgOTELForwardingConfig(ctx, organizationID)
+// loadDestinations returns the org-wide forwarding config, when enabled,
+// followed by every enabled forwarding destination.
+func (r *signalRelay) loadDestinations(ctx context.Context, organizationID string) ([]*relayDestination, error) {
+	queries := forwardingrepo.New(r.readReplica)
+
+	var destinations []*relayDestination
+	legacy, err := r.loadConfigDestination(ctx, queries, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if legacy != nil {
+		destinations = append(destinations, legacy)
+	}
+
+	rows, err := queries.ListOTELForwardingDestinations(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("list forwarding destinations for organization: %w", err)
+	}
+	for _, row := range rows {
+		if !row.Enabled {
+			continue
+		}
+		forwarding, err := otelforwarding.DecodeDestination(r.encryptionClient, row)
+		if err != nil {
+			return nil, fmt.Errorf("decode forwarding destination %s: %w", row.ID, err)
+		}
+		destination, err := r.newForwardingDestination(organizationID, forwarding)
+		if err != nil {
+			return nil, err
+		}
+		destinations = append(destinations, destination)
+	}
+	return destinations, nil
+}
+
+func (r *signalRelay) loadConfigDestination(ctx context.Context, queries *forwardingrepo.Queries, organizationID string) (*relayDestination, error) {
+	config, err := queries.GetOrgOTELForwardingConfig(ctx, organizationID)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, nil
 	}
@@ -204,17 +255,45 @@ func (r *signalRelay) newDestination(
 		headers:        headers,
 		httpClient:     httpClient,
 		signalName:     r.signalName,
+		protocol:       otelforwarding.ProtocolHTTPProtobuf,
+		pipeline:       nil,
+		grpcExporter:   nil,
 	}, nil
 }
 
+// newForwardingDestination builds a relay destination for one of the org's
+// forwarding destinations. gRPC destinations dial the configured URL itself
+// rather than a signal path beneath it.
+func (r *signalRelay) newForwardingDestination(organizationID string, forwarding otelforwarding.Destination) (*relayDestination, error) {
+	destination, err := r.newDestination(organizationID, forwarding.URL, forwarding.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	destination.protocol = forwarding.Protocol
+	if forwarding.Protocol == otelforwarding.ProtocolGRPC {
+		if r.grpcExporter == nil {
+			return nil, fmt.Errorf("forwarding destination %s uses grpc but no exporter is configured", forwarding.ID)
+		}
+		destination.endpoint = forwarding.URL
+		destination.grpcExporter = r.grpcExporter
+	}
+	if pipeline := otelforwarding.NewPipeline(forwarding); !pipeline.IsNoop() {
+		destination.pipeline = pipeline
+	}
+	return destination, nil
+}
+
 func (r *signalRelay) cacheDestination(organizationID string, cached cachedRelayDestination, now time.Time) {
 	var evicted []*relayDestination
 
 	r.cacheMu.Lock()
 	if replaced, ok := r.destinationCache[organizationID]; ok {
 		delete(r.destinationCache, organizationID)
-		if replaced.destination != nil && replaced.destination != cached.destination {
-			evicted = append(evicted, replaced.destination)
+		for _, destination := range replaced.destinations {
+			if !slices.Contains(cached.destinations, destination) {
+				evicted = append(evicted, destination)
+			}
 		}
 	}
 	for cachedOrganizationID, candidate := range r.destinationCache {
@@ -222,9 +301,7 @@ func (r *signalRelay) cacheDestination(organizationID string, cached cachedRelay
 			continue
 		}
 		delete(r.destinationCache, cachedOrganizationID)
-		if candidate.destination != nil {
-			evicted = append(evicted, candidate.destination)
-		}
+		evicted = append(evicted, candidate.destinations...)
 	}
 	for len(r.destinationCache) >= relayDestinationCacheMaxEntries {
 		evictOrganizationID := ""
@@ -238,9 +315,7 @@ func (r *signalRelay) cacheDestination(organizationID string, cached cachedRelay
 			}
 		}
 		delete(r.destinationCache, evictOrganizationID)
-		if evictCandidate.destination != nil {
-			evicted = append(evicted, evictCandidate.destination)
-		}
+		evicted = append(evicted, evictCandidate.destinations...)
 	}
 	r.destinationCache[organizationID] = cached
 	r.cacheMu.Unlock()
@@ -257,7 +332,7 @@ func closeIdleRelayDestination(destination *relayDestination) {
 	destination.httpClient.CloseIdleConnections()
 }
 
-func (r *signalRelay) cachedDestination(organizationID string, now time.Time) (*relayDestination, bool) {
+func (r *signalRelay) cachedDestinations(organizationID string, now time.Time) ([]*relayDestination, bool) {
 	r.cacheMu.RLock()
 	cached, ok := r.destinationCache[organizationID]
 	if !ok {
@@ -266,7 +341,7 @@ func (r *signalRelay) cachedDestination(organizationID string, now time.Time) (*
 	}
 	if now.Before(cached.expiresAt) {
 		r.cacheMu.RUnlock()
-		return cached.destination, true
+		return cached.destinations, true
 	}
 	r.cacheMu.RUnlock()
 
@@ -280,12 +355,14 @@ func (r *signalRelay) cachedDestination(organizationID string, now time.Time) (*
 	}
 	if now.Before(cached.expiresAt) {
 		r.cacheMu.Unlock()
-		return cached.destination, true
+		return cached.destinations, true
 	}
 	delete(r.destinationCache, organizationID)
 	r.cacheMu.Unlock()
 
-	closeIdleRelayDestination(cached.destination)
+	for _, destination := range cached.destinations {
+		closeIdleRelayDestination(destination)
+	}
 	return nil, false
 }
 
@@ -293,8 +370,30 @@ func (d *relayDestination) export(ctx context.Context, message proto.Message) er
 	return d.exportWithLimit(ctx, message, 0)
 }
 
+// exportWithLimit delivers message, which may be shared with other
+// destinations and is never modified. Exports the destination's pipeline
+// filters down to nothing are skipped and reported as delivered.
 func (d *relayDestination) exportWithLimit(ctx context.Context, message proto.Message, maxBytes int) error {
-	body, err := proto.Marshal(message)
+	if d.pipeline != nil {
+		message = proto.Clone(message)
+		kept, err := d.pipeline.Apply(message)
+		if err != nil {
+			return &relayExportError{
+				reason:    relayReasonInvalid,
+				retryable: false,
+				err:       fmt.Errorf("filter OTLP %s export: %w", d.signalName, err),
+			}
+		}
+		if kept == 0 {
+			return nil
+		}
+	}
+
+	if d.protocol == otelforwarding.ProtocolGRPC {
+		return d.exportGRPC(ctx, message, maxBytes)
+	}
+
+	body, contentType, err := otelforwarding.EncodeHTTPExport(d.protocol, message)
 	if err != nil {
 		return &relayExportError{
 			reason:    relayReasonInvalid,
@@ -323,7 +422,7 @@ func (d *relayDestination) exportWithLimit(ctx context.Context, message proto.Me
 			req.Header.Add(key, value)
 		}
 	}
-	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Type", contentType)
 
 	response, err := d.httpClient.Do(req)
 	if err != nil {
@@ -355,6 +454,47 @@ func (d *relayDestination) exportWithLimit(ctx context.Context, message proto.Me
 	}
 }
 
+func (d *relayDestination) exportGRPC(ctx context.Context, message proto.Message, maxBytes int) error {
+	if size := proto.Size(message); maxBytes > 0 && size > maxBytes {
+		return &relayExportError{
+			reason:    relayReasonInvalid,
+			retryable: false,
+			err:       fmt.Errorf("OTLP %s export is %d bytes, limit is %d", d.signalName, size, maxBytes),
+		}
+	}
+
+	metadata := make(map[string]string, len(d.headers))
+	for key := range d.headers {
+		metadata[key] = d.headers.Get(key)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.httpClient.Timeout)
+	defer cancel()
+
+	if err := d.grpcExporter.Export(ctx, d.endpoint, metadata, message); err != nil {
+		reason, retryable := classifyRelayGRPCStatus(status.Code(err))
+		return &relayExportError{
+			reason:    reason,
+			retryable: retryable,
+			err:       fmt.Errorf("send OTLP %s export: %w", d.signalName, err),
+		}
+	}
+	return nil
+}
+
+// classifyRelayGRPCStatus follows the OTLP/gRPC spec's list of retryable
+// status codes.
+func classifyRelayGRPCStatus(code codes.Code) (relayReason, bool) {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.Aborted, codes.OutOfRange, codes.DataLoss:
+		return relayReasonNetworkError, true
+	case codes.ResourceExhausted:
+		return relayReasonHTTP4xx, true
+	default:
+		return relayReasonPermanentHTTPError, false
+	}
+}
+
 func classifyRelayStatus(statusCode int) (relayReason, bool) {
 	switch {
 	case statusCode >= http.StatusInternalServerError:
@@ -12,9 +12,11 @@ import (
 	"time"
 
 	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/otelforwarding"
 	"github.com/speakeasy-api/gram/server/internal/testenv"
 	"github.com/stretchr/testify/require"
 	collectortracev1 "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
 )
 
 func TestSignalRelayDestinationUsesConfiguredSignalEndpoint(t *testing.T) {
@@ -50,6 +52,65 @@ func TestSignalRelayDestinationUsesConfiguredSignalEndpoint(t *testing.T) {
 	require.Equal(t, "Bearer test-token", requestHeader)
 }
 
+func TestSignalRelayForwardingDestinationFiltersAndReencodes(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int64
+	requestPath := ""
+	requestContentType := ""
+	var requestBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+		requests.Add(1)
+		requestPath = request.URL.Path
+		requestContentType = request.Header.Get("Content-Type")
+		requestBody, _ = io.ReadAll(request.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	policy, err := guardian.NewUnsafePolicy(testenv.NewTracerProvider(t), nil)
+	require.NoError(t, err)
+	relay := newSignalRelay(nil, nil, policy, "/v1/traces", "trace")
+	destination, err := relay.newForwardingDestination("organization-id", otelforwarding.Destination{
+		ID:            "destination-id",
+		Name:          "datadog",
+		URL:           server.URL,
+		Protocol:      otelforwarding.ProtocolHTTPJSON,
+		Headers:       nil,
+		Enabled:       true,
+		SamplingRatio: 1,
+		Filters: otelforwarding.Filters{
+			IncludeNames:      nil,
+			ExcludeNames:      []string{"hook.*"},
+			IncludeAttributes: nil,
+			ExcludeAttributes: nil,
+		},
+		ScrubRules: nil,
+	})
+	require.NoError(t, err)
+
+	request := &collectortracev1.ExportTraceServiceRequest{ResourceSpans: []*tracev1.ResourceSpans{{
+		ScopeSpans: []*tracev1.ScopeSpans{{Spans: []*tracev1.Span{
+			{Name: "hook.pre_tool_use"},
+			{Name: "tool.call"},
+		}}},
+	}}}
+	require.NoError(t, destination.export(t.Context(), request))
+
+	require.Equal(t, int64(1), requests.Load())
+	require.Equal(t, "/v1/traces", requestPath)
+	require.Equal(t, "application/json", requestContentType)
+	require.Contains(t, string(requestBody), "tool.call")
+	require.NotContains(t, string(requestBody), "hook.pre_tool_use")
+	require.Len(t, request.ResourceSpans[0].ScopeSpans[0].Spans, 2, "shared export must not be modified")
+
+	hooksOnly := &collectortracev1.ExportTraceServiceRequest{ResourceSpans: []*tracev1.ResourceSpans{{
+		ScopeSpans: []*tracev1.ScopeSpans{{Spans: []*tracev1.Span{{Name: "hook.stop"}}}},
+	}}}
+	require.NoError(t, destination.export(t.Context(), hooksOnly))
+	require.Equal(t, int64(1), requests.Load(), "fully filtered exports are not sent")
+}
+
 func TestSignalRelayDestinationDrainsFailedResponses(t *testing.T) {
 	t.Parallel()
 
@@ -124,20 +185,20 @@ func TestSignalRelayCacheReturnsActiveAndRemovesExpiredDestinations(t *testing.T
 	active, activeTransport := newTrackedRelayDestination()
 	expired, expiredTransport := newTrackedRelayDestination()
 	relay.destinationCache["active"] = cachedRelayDestination{
-		destination: active,
-		expiresAt:   now.Add(time.Minute),
+		destinations: []*relayDestination{active},
+		expiresAt:    now.Add(time.Minute),
 	}
 	relay.destinationCache["expired"] = cachedRelayDestination{
-		destination: expired,
-		expiresAt:   now,
+		destinations: []*relayDestination{expired},
+		expiresAt:    now,
 	}
 
-	got, ok := relay.cachedDestination("active", now)
+	got, ok := relay.cachedDestinations("active", now)
 	require.True(t, ok)
-	require.Same(t, active, got)
+	require.Equal(t, []*relayDestination{active}, got)
 	require.Zero(t, activeTransport.closeCalls.Load())
 
-	got, ok = relay.cachedDestination("expired", now)
+	got, ok = relay.cachedDestinations("expired", now)
 	require.False(t, ok)
 	require.Nil(t, got)
 	require.NotContains(t, relay.destinationCache, "expired")
@@ -151,13 +212,13 @@ func TestSignalRelayCacheInsertionPrunesExpiredDestinations(t *testing.T) {
 	relay := newSignalRelay(nil, nil, nil, "", "trace")
 	expired, expiredTransport := newTrackedRelayDestination()
 	relay.destinationCache["expired"] = cachedRelayDestination{
-		destination: expired,
-		expiresAt:   now,
+		destinations: []*relayDestination{expired},
+		expiresAt:    now,
 	}
 
 	relay.cacheDestination("new", cachedRelayDestination{
-		destination: nil,
-		expiresAt:   now.Add(time.Minute),
+		destinations: nil,
+		expiresAt:    now.Add(time.Minute),
 	}, now)
 
 	require.NotContains(t, relay.destinationCache, "expired")
@@ -173,18 +234,18 @@ func TestSignalRelayCacheReplacementClosesOldDestination(t *testing.T) {
 	oldDestination, oldTransport := newTrackedRelayDestination()
 	newDestination, newTransport := newTrackedRelayDestination()
 	relay.cacheDestination("organization-id", cachedRelayDestination{
-		destination: oldDestination,
-		expiresAt:   now.Add(time.Minute),
+		destinations: []*relayDestination{oldDestination},
+		expiresAt:    now.Add(time.Minute),
 	}, now)
 
 	relay.cacheDestination("organization-id", cachedRelayDestination{
-		destination: newDestination,
-		expiresAt:   now.Add(2 * time.Minute),
+		destinations: []*relayDestination{newDestination},
+		expiresAt:    now.Add(2 * time.Minute),
 	}, now)
 
-	got, ok := relay.cachedDestination("organization-id", now)
+	got, ok := relay.cachedDestinations("organization-id", now)
 	require.True(t, ok)
-	require.Same(t, newDestination, got)
+	require.Equal(t, []*relayDestination{newDestination}, got)
 	require.Equal(t, int64(1), oldTransport.closeCalls.Load())
 	require.Zero(t, newTransport.closeCalls.Load())
 }
@@ -196,19 +257,19 @@ func TestSignalRelayCacheEvictsEarliestExpiryAtCapacity(t *testing.T) {
 	relay := newSignalRelay(nil, nil, nil, "", "trace")
 	oldestDestination, oldestTransport := newTrackedRelayDestination()
 	for i := range relayDestinationCacheMaxEntries {
-		destination := (*relayDestination)(nil)
+		var destinations []*relayDestination
 		if i == 0 {
-			destination = oldestDestination
+			destinations = []*relayDestination{oldestDestination}
 		}
 		relay.destinationCache[fmt.Sprintf("organization-%04d", i)] = cachedRelayDestination{
-			destination: destination,
-			expiresAt:   now.Add(time.Duration(i+1) * time.Second),
+			destinations: destinations,
+			expiresAt:    now.Add(time.Duration(i+1) * time.Second),
 		}
 	}
 
 	relay.cacheDestination("new-organization", cachedRelayDestination{
-		destination: nil,
-		expiresAt:   now.Add(time.Hour),
+		destinations: nil,
+		expiresAt:    now.Add(time.Hour),
 	}, now)
 
 	require.Len(t, relay.destinationCache, relayDestinationCacheMaxEntries)
@@ -239,6 +300,9 @@ func newTrackedRelayDestination() (*relayDestination, *closeIdleTrackingRoundTri
 		headers:        nil,
 		httpClient:     client,
 		signalName:     "",
+		protocol:       otelforwarding.ProtocolHTTPProtobuf,
+		pipeline:       nil,
+		grpcExporter:   nil,
 	}, transport
 }
 
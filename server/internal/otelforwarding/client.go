@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
@@ -43,8 +46,9 @@ func NewClient(logger *slog.Logger, db *pgxpool.Pool, enc *encryption.Client, ca
 }
 
 // GetForOrg returns the cached/decoded config for an org. A returned
-// CachedConfig with URL == "" means "no config configured" — this is also
-// cached to avoid hammering the DB on the hot OTEL ingest path.
+// CachedConfig with URL == "" and no Destinations means "no config
+// configured" — this is also cached to avoid hammering the DB on the hot
+// OTEL ingest path.
 func (c *Client) GetForOrg(ctx context.Context, orgID string) (CachedConfig, error) {
 	cacheKey := emptyCachedConfig(orgID).CacheKey()
 	if cached, err := c.cache.Get(ctx, cacheKey); err == nil {
@@ -65,19 +69,31 @@ func (c *Client) GetForOrg(ctx context.Context, orgID string) (CachedConfig, err
 	return cfg, nil
 }
 
-// LoadForOrg bypasses the cache and reads the row directly from the
-// database. Returns an empty CachedConfig (with URL == "") if the org has
-// no active config. Pairs with LoadForOrgRow when callers need the full
-// repo row (e.g. for audit subject IDs).
+// LoadForOrg bypasses the cache and reads the org-wide row and the org's
+// destinations directly from the database. Returns an empty CachedConfig
+// (with URL == "") if the org has no active org-wide config. Pairs with
+// LoadForOrgRow when callers need the full repo row (e.g. for audit subject
+// IDs).
 func (c *Client) LoadForOrg(ctx context.Context, orgID string) (CachedConfig, error) {
+	cfg := emptyCachedConfig(orgID)
 	row, err := c.repo.GetOrgOTELForwardingConfig(ctx, orgID)
 	switch {
 	case errors.Is(err, pgx.ErrNoRows):
-		return emptyCachedConfig(orgID), nil
 	case err != nil:
 		return emptyCachedConfig(orgID), oops.E(oops.CodeUnexpected, err, "failed to load otel forwarding config")
+	default:
+		cfg, err = c.rowToConfig(orgID, row)
+		if err != nil {
+			return emptyCachedConfig(orgID), err
+		}
 	}
-	return c.rowToConfig(orgID, row)
+
+	destinations, err := c.listDestinations(ctx, c.repo, orgID)
+	if err != nil {
+		return emptyCachedConfig(orgID), err
+	}
+	cfg.Destinations = destinations
+	return cfg, nil
 }
 
 // LoadForOrgRow returns the full repo row alongside the decoded config. A
@@ -102,7 +118,7 @@ func (c *Client) LoadForOrgRow(ctx context.Context, orgID string) (CachedConfig,
 // Caller is responsible for committing the transaction and then calling
 // RefreshCache(ctx, returned) so subsequent reads see the new value.
 func (c *Client) UpsertWithTx(ctx context.Context, dbtx repo.DBTX, orgID, url string, headers map[string]string, enabled bool) (CachedConfig, *repo.OtelForwardingConfig, error) {
-	headersEncrypted, err := c.encryptHeaders(headers)
+	headersEncrypted, err := encryptHeaders(c.enc, headers)
 	if err != nil {
 		return emptyCachedConfig(orgID), nil, err
 	}
@@ -117,11 +133,19 @@ func (c *Client) UpsertWithTx(ctx context.Context, dbtx repo.DBTX, orgID, url st
 		return emptyCachedConfig(orgID), nil, oops.E(oops.CodeUnexpected, err, "failed to save otel forwarding config")
 	}
 
+	// Carry the org's destinations along so RefreshCache does not drop them
+	// from the cached entry.
+	destinations, err := c.listDestinations(ctx, repo.New(dbtx), orgID)
+	if err != nil {
+		return emptyCachedConfig(orgID), nil, err
+	}
+
 	cfg := CachedConfig{
 		OrganizationID: orgID,
 		URL:            url,
 		Headers:        headers,
 		Enabled:        enabled,
+		Destinations:   destinations,
 	}
 	return cfg, &row, nil
 }
@@ -136,6 +160,107 @@ func (c *Client) SoftDeleteWithTx(ctx context.Context, dbtx repo.DBTX, orgID str
 	return nil
 }
 
+// ListDestinations reads the org's destinations from the database, oldest
+// first.
+func (c *Client) ListDestinations(ctx context.Context, orgID string) ([]Destination, []repo.OtelForwardingDestination, error) {
+	rows, err := c.repo.ListOTELForwardingDestinations(ctx, orgID)
+	if err != nil {
+		return nil, nil, oops.E(oops.CodeUnexpected, err, "failed to list otel forwarding destinations")
+	}
+	destinations, err := c.decodeDestinations(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	return destinations, rows, nil
+}
+
+// LockDestinationWithTx loads and row-locks one destination via the given
+// dbtx. A nil row means no such destination exists in the org.
+func (c *Client) LockDestinationWithTx(ctx context.Context, dbtx repo.DBTX, orgID string, id uuid.UUID) (Destination, *repo.OtelForwardingDestination, error) {
+	row, err := repo.New(dbtx).GetOTELForwardingDestinationForUpdate(ctx, repo.GetOTELForwardingDestinationForUpdateParams{
+		ID:             id,
+		OrganizationID: orgID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return Destination{}, nil, nil
+	case err != nil:
+		return Destination{}, nil, oops.E(oops.CodeUnexpected, err, "failed to load otel forwarding destination")
+	}
+	d, err := DecodeDestination(c.enc, row)
+	if err != nil {
+		return Destination{}, nil, oops.E(oops.CodeUnexpected, err, "decode otel forwarding destination")
+	}
+	return d, &row, nil
+}
+
+// CreateDestinationWithTx encrypts the headers and inserts d via the given
+// dbtx; d.ID is ignored. Caller is responsible for committing the
+// transaction and then calling InvalidateCache(ctx, orgID).
+func (c *Client) CreateDestinationWithTx(ctx context.Context, dbtx repo.DBTX, orgID string, d Destination) (Destination, *repo.OtelForwardingDestination, error) {
+	params, err := c.destinationParams(d)
+	if err != nil {
+		return Destination{}, nil, err
+	}
+
+	row, err := repo.New(dbtx).CreateOTELForwardingDestination(ctx, repo.CreateOTELForwardingDestinationParams{
+		OrganizationID:   orgID,
+		Name:             params.Name,
+		EndpointUrl:      params.EndpointUrl,
+		Protocol:         params.Protocol,
+		HeadersEncrypted: params.HeadersEncrypted,
+		Enabled:          params.Enabled,
+		SamplingRatio:    params.SamplingRatio,
+		Filters:          params.Filters,
+		ScrubRules:       params.ScrubRules,
+	})
+	if isUniqueViolation(err) {
+		return Destination{}, nil, oops.E(oops.CodeConflict, err, "a destination named %q already exists", d.Name)
+	}
+	if err != nil {
+		return Destination{}, nil, oops.E(oops.CodeUnexpected, err, "failed to create otel forwarding destination")
+	}
+
+	d.ID = row.ID.String()
+	return d, &row, nil
+}
+
+// UpdateDestinationWithTx replaces destination id with d via the given dbtx.
+// Caller is responsible for committing the transaction and then calling
+// InvalidateCache(ctx, orgID).
+func (c *Client) UpdateDestinationWithTx(ctx context.Context, dbtx repo.DBTX, orgID string, id uuid.UUID, d Destination) (Destination, *repo.OtelForwardingDestination, error) {
+	params, err := c.destinationParams(d)
+	if err != nil {
+		return Destination{}, nil, err
+	}
+	params.ID = id
+	params.OrganizationID = orgID
+
+	row, err := repo.New(dbtx).UpdateOTELForwardingDestination(ctx, params)
+	if isUniqueViolation(err) {
+		return Destination{}, nil, oops.E(oops.CodeConflict, err, "a destination named %q already exists", d.Name)
+	}
+	if err != nil {
+		return Destination{}, nil, oops.E(oops.CodeUnexpected, err, "failed to update otel forwarding destination")
+	}
+
+	d.ID = row.ID.String()
+	return d, &row, nil
+}
+
+// SoftDeleteDestinationWithTx soft-deletes destination id via the given
+// dbtx. Caller is responsible for committing and then calling
+// InvalidateCache(ctx, orgID).
+func (c *Client) SoftDeleteDestinationWithTx(ctx context.Context, dbtx repo.DBTX, orgID string, id uuid.UUID) error {
+	if err := repo.New(dbtx).SoftDeleteOTELForwardingDestination(ctx, repo.SoftDeleteOTELForwardingDestinationParams{
+		ID:             id,
+		OrganizationID: orgID,
+	}); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "failed to delete otel forwarding destination")
+	}
+	return nil
+}
+
 // RefreshCache stores the given config in the cache so subsequent reads
 // don't wait for the TTL.
 func (c *Client) RefreshCache(ctx context.Context, cfg CachedConfig) {
@@ -158,7 +283,7 @@ func (c *Client) InvalidateCache(ctx context.Context, orgID string) {
 }
 
 func (c *Client) rowToConfig(orgID string, row repo.OtelForwardingConfig) (CachedConfig, error) {
-	headers, err := c.decryptHeaders(row.HeadersEncrypted)
+	headers, err := decryptHeaders(c.enc, row.HeadersEncrypted)
 	if err != nil {
 		return emptyCachedConfig(orgID), err
 	}
@@ -167,10 +292,72 @@ func (c *Client) rowToConfig(orgID string, row repo.OtelForwardingConfig) (Cache
 		URL:            row.EndpointUrl,
 		Headers:        headers,
 		Enabled:        row.Enabled,
+		Destinations:   nil,
+	}, nil
+}
+
+func (c *Client) listDestinations(ctx context.Context, queries *repo.Queries, orgID string) ([]Destination, error) {
+	rows, err := queries.ListOTELForwardingDestinations(ctx, orgID)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to load otel forwarding destinations")
+	}
+	return c.decodeDestinations(rows)
+}
+
+func (c *Client) decodeDestinations(rows []repo.OtelForwardingDestination) ([]Destination, error) {
+	destinations := make([]Destination, 0, len(rows))
+	for _, row := range rows {
+		d, err := DecodeDestination(c.enc, row)
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "decode otel forwarding destination")
+		}
+		destinations = append(destinations, d)
+	}
+	return destinations, nil
+}
+
+// destinationParams encodes the stored columns of d. ID and OrganizationID
+// are left for the caller to fill in.
+func (c *Client) destinationParams(d Destination) (repo.UpdateOTELForwardingDestinationParams, error) {
+	var params repo.UpdateOTELForwardingDestinationParams
+
+	headersEncrypted, err := encryptHeaders(c.enc, d.Headers)
+	if err != nil {
+		return params, err
+	}
+	filters, err := json.Marshal(d.Filters)
+	if err != nil {
+		return params, oops.E(oops.CodeUnexpected, err, "marshal destination filters")
+	}
+	scrubRules := d.ScrubRules
+	if scrubRules == nil {
+		scrubRules = []ScrubRule{}
+	}
+	scrubJSON, err := json.Marshal(scrubRules)
+	if err != nil {
+		return params, oops.E(oops.CodeUnexpected, err, "marshal destination scrub rules")
+	}
+
+	return repo.UpdateOTELForwardingDestinationParams{
+		Name:             d.Name,
+		EndpointUrl:      d.URL,
+		Protocol:         string(d.Protocol),
+		HeadersEncrypted: headersEncrypted,
+		Enabled:          d.Enabled,
+		SamplingRatio:    d.SamplingRatio,
+		Filters:          filters,
+		ScrubRules:       scrubJSON,
+		ID:               uuid.Nil,
+		OrganizationID:   "",
 	}, nil
 }
 
-func (c *Client) encryptHeaders(headers map[string]string) (pgtype.Text, error) {
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation
+}
+
+func encryptHeaders(enc *encryption.Client, headers map[string]string) (pgtype.Text, error) {
 	null := pgtype.Text{String: "", Valid: false}
 	if len(headers) == 0 {
 		return null, nil
@@ -179,18 +366,18 @@ func (c *Client) encryptHeaders(headers map[string]string) (pgtype.Text, error)
 	if err != nil {
 		return null, oops.E(oops.CodeUnexpected, err, "marshal forwarding headers")
 	}
-	ct, err := c.enc.Encrypt(b)
+	ct, err := enc.Encrypt(b)
 	if err != nil {
 		return null, oops.E(oops.CodeUnexpected, err, "encrypt forwarding headers")
 	}
 	return pgtype.Text{String: ct, Valid: true}, nil
 }
 
-func (c *Client) decryptHeaders(stored pgtype.Text) (map[string]string, error) {
+func decryptHeaders(enc *encryption.Client, stored pgtype.Text) (map[string]string, error) {
 	if !stored.Valid || stored.String == "" {
 		return nil, nil
 	}
-	plaintext, err := c.enc.Decrypt(stored.String)
+	plaintext, err := enc.Decrypt(stored.String)
 	if err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "decrypt forwarding headers")
 	}
@@ -0,0 +1,177 @@
+package otelforwarding
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/otelforwarding/repo"
+)
+
+// Protocol is the OTLP transport a destination receives payloads over. The
+// values match OTEL_EXPORTER_OTLP_PROTOCOL so they read the same as the
+// customer's own collector config.
+type Protocol string
+
+const (
+	ProtocolHTTPProtobuf Protocol = "http/protobuf"
+	ProtocolHTTPJSON     Protocol = "http/json"
+	ProtocolGRPC         Protocol = "grpc"
+)
+
+func (p Protocol) Valid() bool {
+	switch p {
+	case ProtocolHTTPProtobuf, ProtocolHTTPJSON, ProtocolGRPC:
+		return true
+	default:
+		return false
+	}
+}
+
+// Signal is the OTLP signal carried by a forwarded payload.
+type Signal string
+
+const (
+	SignalLogs    Signal = "logs"
+	SignalMetrics Signal = "metrics"
+	SignalTraces  Signal = "traces"
+)
+
+// Path returns the OTLP/HTTP path for the signal, joined onto a
+// destination's base URL.
+func (s Signal) Path() string {
+	return "/v1/" + string(s)
+}
+
+// ScrubAction is what a scrub rule does to a matching attribute.
+type ScrubAction string
+
+const (
+	// ScrubActionDrop removes the attribute entirely.
+	ScrubActionDrop ScrubAction = "drop"
+	// ScrubActionRedact keeps the key but replaces the value with a fixed
+	// placeholder so downstream queries can still see the field existed.
+	ScrubActionRedact ScrubAction = "redact"
+	// ScrubActionHash replaces the value with its SHA-256 so it can still be
+	// grouped on without revealing the original.
+	ScrubActionHash ScrubAction = "hash"
+)
+
+func (a ScrubAction) Valid() bool {
+	switch a {
+	case ScrubActionDrop, ScrubActionRedact, ScrubActionHash:
+		return true
+	default:
+		return false
+	}
+}
+
+// ScrubRule rewrites every attribute whose key matches Key. Key may contain
+// "*" wildcards, e.g. "gen_ai.tool.call.*".
+type ScrubRule struct {
+	Key    string      `json:"key"`
+	Action ScrubAction `json:"action"`
+}
+
+// AttributeMatch matches an item carrying attribute Key. With a nil Value
+// any value matches; otherwise the value's string form must equal it.
+type AttributeMatch struct {
+	Key   string  `json:"key"`
+	Value *string `json:"value,omitempty"`
+}
+
+// Filters decide which spans, log records and metrics reach a destination.
+// Names are span names, log event names and metric names, and may contain
+// "*" wildcards. Empty include lists admit everything; an exclude match
+// always wins over an include match.
+type Filters struct {
+	IncludeNames      []string         `json:"include_names,omitempty"`
+	ExcludeNames      []string         `json:"exclude_names,omitempty"`
+	IncludeAttributes []AttributeMatch `json:"include_attributes,omitempty"`
+	ExcludeAttributes []AttributeMatch `json:"exclude_attributes,omitempty"`
+}
+
+// IsZero reports whether the filters admit everything.
+func (f Filters) IsZero() bool {
+	return len(f.IncludeNames) == 0 &&
+		len(f.ExcludeNames) == 0 &&
+		len(f.IncludeAttributes) == 0 &&
+		len(f.ExcludeAttributes) == 0
+}
+
+// Destination is one decoded forwarding destination. Unlike the single
+// org-wide config, which relays payloads verbatim, every destination
+// re-encodes payloads for its protocol after running them through its
+// Pipeline.
+type Destination struct {
+	ID            string            `json:"id"`
+	Name          string            `json:"name"`
+	URL           string            `json:"url"`
+	Protocol      Protocol          `json:"protocol"`
+	Headers       map[string]string `json:"headers"`
+	Enabled       bool              `json:"enabled"`
+	SamplingRatio float64           `json:"sampling_ratio"`
+	Filters       Filters           `json:"filters"`
+	ScrubRules    []ScrubRule       `json:"scrub_rules"`
+}
+
+// DecodeDestination decrypts and unmarshals a destination row. It is shared
+// by the forwarding client and the signal relay so both read rows the same
+// way.
+func DecodeDestination(enc *encryption.Client, row repo.OtelForwardingDestination) (Destination, error) {
+	headers, err := decryptHeaders(enc, row.HeadersEncrypted)
+	if err != nil {
+		return Destination{}, err
+	}
+
+	var filters Filters
+	if len(row.Filters) > 0 {
+		if err := json.Unmarshal(row.Filters, &filters); err != nil {
+			return Destination{}, fmt.Errorf("unmarshal destination filters: %w", err)
+		}
+	}
+
+	var scrubRules []ScrubRule
+	if len(row.ScrubRules) > 0 {
+		if err := json.Unmarshal(row.ScrubRules, &scrubRules); err != nil {
+			return Destination{}, fmt.Errorf("unmarshal destination scrub rules: %w", err)
+		}
+	}
+
+	return Destination{
+		ID:            row.ID.String(),
+		Name:          row.Name,
+		URL:           row.EndpointUrl,
+		Protocol:      Protocol(row.Protocol),
+		Headers:       headers,
+		Enabled:       row.Enabled,
+		SamplingRatio: row.SamplingRatio,
+		Filters:       filters,
+		ScrubRules:    scrubRules,
+	}, nil
+}
+
+// matchGlob reports whether s matches pattern, where "*" matches any run of
+// characters (including none) and every other character matches itself.
+func matchGlob(pattern, s string) bool {
+	if !strings.Contains(pattern, "*") {
+		return pattern == s
+	}
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return strings.HasSuffix(s, last)
+}
@@ -0,0 +1,238 @@
+package otelforwarding
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/url"
+	"sync"
+
+	collectorlogsv1 "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	collectormetricsv1 "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortracev1 "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+)
+
+const (
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeJSON     = "application/json"
+
+	defaultGRPCPort = "4317"
+
+	// maxGRPCConns bounds the connection cache. Destinations are capped per
+	// org, so hitting this means many orgs forward over gRPC at once; the
+	// oldest-dialed connection is closed to make room.
+	maxGRPCConns = 256
+)
+
+// NewExportRequest returns an empty OTLP export request for the signal.
+func NewExportRequest(signal Signal) (proto.Message, error) {
+	switch signal {
+	case SignalLogs:
+		return &collectorlogsv1.ExportLogsServiceRequest{}, nil
+	case SignalMetrics:
+		return &collectormetricsv1.ExportMetricsServiceRequest{}, nil
+	case SignalTraces:
+		return &collectortracev1.ExportTraceServiceRequest{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported otlp signal %q", signal)
+	}
+}
+
+// DecodeExportRequest parses an inbound OTLP/HTTP body into msg. Bodies may
+// be gzip-compressed and either protobuf or JSON encoded, as the OTLP/HTTP
+// spec allows.
+func DecodeExportRequest(contentType, contentEncoding string, body []byte, msg proto.Message) error {
+	if contentEncoding == "gzip" {
+		zr, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("open gzip body: %w", err)
+		}
+		decompressed, err := io.ReadAll(io.LimitReader(zr, MaxForwardBodyBytes+1))
+		if err != nil {
+			return fmt.Errorf("decompress body: %w", err)
+		}
+		if len(decompressed) > MaxForwardBodyBytes {
+			return fmt.Errorf("decompressed body exceeds %d bytes", MaxForwardBodyBytes)
+		}
+		body = decompressed
+	}
+
+	if inboundProtocol(contentType) == ProtocolHTTPJSON {
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(body, msg); err != nil {
+			return fmt.Errorf("unmarshal otlp json: %w", err)
+		}
+		return nil
+	}
+	if err := proto.Unmarshal(body, msg); err != nil {
+		return fmt.Errorf("unmarshal otlp protobuf: %w", err)
+	}
+	return nil
+}
+
+// inboundProtocol reports which OTLP/HTTP encoding a request body with the
+// given content type uses.
+func inboundProtocol(contentType string) Protocol {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == contentTypeJSON {
+		return ProtocolHTTPJSON
+	}
+	return ProtocolHTTPProtobuf
+}
+
+// EncodeHTTPExport marshals an export request for an OTLP/HTTP destination
+// and returns the body along with its content type.
+func EncodeHTTPExport(protocol Protocol, msg proto.Message) ([]byte, string, error) {
+	if protocol == ProtocolHTTPJSON {
+		body, err := protojson.Marshal(msg)
+		if err != nil {
+			return nil, "", fmt.Errorf("marshal otlp json: %w", err)
+		}
+		return body, contentTypeJSON, nil
+	}
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, "", fmt.Errorf("marshal otlp protobuf: %w", err)
+	}
+	return body, contentTypeProtobuf, nil
+}
+
+// GRPCExporter sends export requests to OTLP/gRPC destinations, reusing one
+// client connection per endpoint. Connections dial through the guardian
+// policy so gRPC destinations get the same SSRF protection as HTTP ones.
+type GRPCExporter struct {
+	policy *guardian.Policy
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+	order []string
+}
+
+func NewGRPCExporter(policy *guardian.Policy) *GRPCExporter {
+	return &GRPCExporter{
+		policy: policy,
+		mu:     sync.Mutex{},
+		conns:  make(map[string]*grpc.ClientConn),
+		order:  nil,
+	}
+}
+
+// Export sends msg to the OTLP/gRPC endpoint. Headers are sent as request
+// metadata, which is where collectors expect API keys for gRPC.
+func (e *GRPCExporter) Export(ctx context.Context, endpoint string, headers map[string]string, msg proto.Message) error {
+	conn, err := e.conn(endpoint)
+	if err != nil {
+		return err
+	}
+
+	if len(headers) > 0 {
+		md := metadata.MD{}
+		for k, v := range headers {
+			md.Set(k, v)
+		}
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+
+	switch req := msg.(type) {
+	case *collectorlogsv1.ExportLogsServiceRequest:
+		_, err = collectorlogsv1.NewLogsServiceClient(conn).Export(ctx, req)
+	case *collectormetricsv1.ExportMetricsServiceRequest:
+		_, err = collectormetricsv1.NewMetricsServiceClient(conn).Export(ctx, req)
+	case *collectortracev1.ExportTraceServiceRequest:
+		_, err = collectortracev1.NewTraceServiceClient(conn).Export(ctx, req)
+	default:
+		return fmt.Errorf("unsupported otlp message %T", msg)
+	}
+	if err != nil {
+		return fmt.Errorf("otlp grpc export: %w", err)
+	}
+	return nil
+}
+
+// Close closes every cached connection.
+func (e *GRPCExporter) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var errs []error
+	for endpoint, conn := range e.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("close grpc connection to %s: %w", endpoint, err))
+		}
+	}
+	e.conns = make(map[string]*grpc.ClientConn)
+	e.order = nil
+	return errors.Join(errs...)
+}
+
+func (e *GRPCExporter) conn(endpoint string) (*grpc.ClientConn, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if conn, ok := e.conns[endpoint]; ok {
+		return conn, nil
+	}
+
+	target, secure, err := grpcTarget(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := insecure.NewCredentials()
+	if secure {
+		creds = credentials.NewTLS(&tls.Config{RootCAs: e.policy.TLSRootCAs(), MinVersion: tls.VersionTLS12})
+	}
+	dialer := e.policy.Dialer()
+	conn, err := grpc.NewClient(target,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return dialer.DialContext(ctx, "tcp", addr)
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create grpc client for %s: %w", target, err)
+	}
+
+	if len(e.order) >= maxGRPCConns {
+		oldest := e.order[0]
+		e.order = e.order[1:]
+		if evicted, ok := e.conns[oldest]; ok {
+			_ = evicted.Close()
+			delete(e.conns, oldest)
+		}
+	}
+	e.conns[endpoint] = conn
+	e.order = append(e.order, endpoint)
+	return conn, nil
+}
+
+// grpcTarget turns a destination URL into a gRPC dial target. https URLs use
+// TLS and http URLs plaintext; a missing port defaults to the OTLP/gRPC 4317.
+func grpcTarget(endpoint string) (string, bool, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", false, fmt.Errorf("parse grpc endpoint: %w", err)
+	}
+	if u.Hostname() == "" {
+		return "", false, fmt.Errorf("grpc endpoint %q has no host", endpoint)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = defaultGRPCPort
+	}
+	return "passthrough:///" + net.JoinHostPort(u.Hostname(), port), u.Scheme == "https", nil
+}
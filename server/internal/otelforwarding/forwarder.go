@@ -24,14 +24,19 @@ const (
 )
 
 // Job is a single OTEL payload queued for forwarding to a customer endpoint.
-// Body is the raw bytes received on /rpc/hooks.otel/v1/*, forwarded verbatim
-// (raw passthrough).
+// Body is the raw bytes received on /rpc/hooks.otel/v1/*. Jobs for the
+// org-wide config forward it verbatim (raw passthrough); jobs carrying a
+// Destination decode it, run the destination's pipeline and re-encode it
+// for the destination's protocol on the worker, off the ingest path.
 type Job struct {
-	OrgID       string
-	URL         string
-	ContentType string
-	Headers     map[string]string
-	Body        []byte
+	OrgID           string
+	URL             string
+	ContentType     string
+	ContentEncoding string
+	Headers         map[string]string
+	Body            []byte
+	Signal          Signal
+	Destination     *Destination
 }
 
 // Forwarder is a bounded async worker pool. Jobs that don't fit in the queue
@@ -41,15 +46,17 @@ type Forwarder struct {
 	logger  *slog.Logger
 	tracer  trace.Tracer
 	client  *guardian.HTTPClient
+	grpc    *GRPCExporter
 	jobs    chan Job
 	stop    chan struct{}
 	wg      sync.WaitGroup
 	started bool
 	mu      sync.Mutex
 
-	dropped metric.Int64Counter
-	sent    metric.Int64Counter
-	failed  metric.Int64Counter
+	dropped  metric.Int64Counter
+	sent     metric.Int64Counter
+	failed   metric.Int64Counter
+	filtered metric.Int64Counter
 }
 
 func NewForwarder(logger *slog.Logger, tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider, policy *guardian.Policy) *Forwarder {
@@ -59,19 +66,22 @@ func NewForwarder(logger *slog.Logger, tracerProvider trace.TracerProvider, mete
 	dropped, _ := meter.Int64Counter("otelforwarding.dropped", metric.WithDescription("OTEL forward jobs dropped due to a full queue"))
 	sent, _ := meter.Int64Counter("otelforwarding.sent", metric.WithDescription("OTEL forward jobs successfully delivered"))
 	failed, _ := meter.Int64Counter("otelforwarding.failed", metric.WithDescription("OTEL forward jobs that failed to deliver"))
+	filtered, _ := meter.Int64Counter("otelforwarding.filtered", metric.WithDescription("OTEL forward jobs left empty by a destination's filters or sampling and not sent"))
 
 	return &Forwarder{
-		logger:  logger,
-		tracer:  tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/otelforwarding"),
-		client:  policy.PooledClient(),
-		jobs:    make(chan Job, defaultQueueSize),
-		stop:    make(chan struct{}),
-		wg:      sync.WaitGroup{},
-		started: false,
-		mu:      sync.Mutex{},
-		dropped: dropped,
-		sent:    sent,
-		failed:  failed,
+		logger:   logger,
+		tracer:   tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/otelforwarding"),
+		client:   policy.PooledClient(),
+		grpc:     NewGRPCExporter(policy),
+		jobs:     make(chan Job, defaultQueueSize),
+		stop:     make(chan struct{}),
+		wg:       sync.WaitGroup{},
+		started:  false,
+		mu:       sync.Mutex{},
+		dropped:  dropped,
+		sent:     sent,
+		failed:   failed,
+		filtered: filtered,
 	}
 }
 
@@ -111,6 +121,10 @@ func (f *Forwarder) Shutdown(ctx context.Context) {
 	case <-done:
 	case <-ctx.Done():
 	}
+
+	if err := f.grpc.Close(); err != nil {
+		f.logger.WarnContext(ctx, "close otel forward grpc connections", attr.SlogError(err))
+	}
 }
 
 // Enqueue queues a forward job. If the queue is full, the job is dropped and
@@ -158,6 +172,88 @@ func (f *Forwarder) send(ctx context.Context, job Job) {
 
 	span.SetAttributes(attr.URLFull(job.URL))
 
+	if job.Destination != nil {
+		f.sendToDestination(ctx, sendCtx, job)
+		return
+	}
+	f.post(ctx, sendCtx, job)
+}
+
+// sendToDestination runs the job's payload through its destination's
+// pipeline and delivers what is left over the destination's protocol. A
+// payload the pipeline would not change and that already arrived in the
+// destination's encoding is posted verbatim without decoding.
+func (f *Forwarder) sendToDestination(ctx, sendCtx context.Context, job Job) {
+	dest := job.Destination
+	pipeline := NewPipeline(*dest)
+	if pipeline.IsNoop() && dest.Protocol == inboundProtocol(job.ContentType) {
+		f.post(ctx, sendCtx, job)
+		return
+	}
+
+	msg, err := NewExportRequest(job.Signal)
+	if err == nil {
+		err = DecodeExportRequest(job.ContentType, job.ContentEncoding, job.Body, msg)
+	}
+	if err != nil {
+		f.failed.Add(ctx, 1)
+		f.logger.WarnContext(sendCtx, "decode otel forward payload",
+			attr.SlogError(err),
+			attr.SlogOrganizationID(job.OrgID),
+			attr.SlogURLFull(job.URL),
+		)
+		return
+	}
+
+	kept, err := pipeline.Apply(msg)
+	if err != nil {
+		f.failed.Add(ctx, 1)
+		f.logger.WarnContext(sendCtx, "apply otel forward pipeline",
+			attr.SlogError(err),
+			attr.SlogOrganizationID(job.OrgID),
+			attr.SlogURLFull(job.URL),
+		)
+		return
+	}
+	if kept == 0 {
+		f.filtered.Add(ctx, 1)
+		return
+	}
+
+	if dest.Protocol == ProtocolGRPC {
+		if err := f.grpc.Export(sendCtx, dest.URL, dest.Headers, msg); err != nil {
+			f.failed.Add(ctx, 1)
+			f.logger.WarnContext(sendCtx, "otel forward request failed",
+				attr.SlogError(err),
+				attr.SlogOrganizationID(job.OrgID),
+				attr.SlogURLFull(job.URL),
+			)
+			return
+		}
+		f.sent.Add(ctx, 1)
+		return
+	}
+
+	body, contentType, err := EncodeHTTPExport(dest.Protocol, msg)
+	if err != nil {
+		f.failed.Add(ctx, 1)
+		f.logger.WarnContext(sendCtx, "encode otel forward payload",
+			attr.SlogError(err),
+			attr.SlogOrganizationID(job.OrgID),
+			attr.SlogURLFull(job.URL),
+		)
+		return
+	}
+	job.Body = body
+	job.ContentType = contentType
+	job.ContentEncoding = ""
+	f.post(ctx, sendCtx, job)
+}
+
+// post sends the job's body as is to job.URL.
+func (f *Forwarder) post(ctx, sendCtx context.Context, job Job) {
+	span := trace.SpanFromContext(sendCtx)
+
 	req, err := http.NewRequestWithContext(sendCtx, http.MethodPost, job.URL, bytes.NewReader(job.Body))
 	if err != nil {
 		f.failed.Add(ctx, 1)
@@ -171,6 +267,9 @@ func (f *Forwarder) send(ctx context.Context, job Job) {
 	if job.ContentType != "" {
 		req.Header.Set("Content-Type", job.ContentType)
 	}
+	if job.ContentEncoding != "" {
+		req.Header.Set("Content-Encoding", job.ContentEncoding)
+	}
 	for k, v := range job.Headers {
 		req.Header.Set(k, v)
 	}
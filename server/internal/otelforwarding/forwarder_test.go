@@ -62,11 +62,14 @@ func TestForwarderSendErrorStatusLogsURLAndBody(t *testing.T) {
 	const secretHeaderValue = "Bearer super-secret-token"
 	const destURL = "/v1/traces"
 	f.send(t.Context(), Job{
-		OrgID:       "org-123",
-		URL:         server.URL + destURL,
-		ContentType: "application/x-protobuf",
-		Headers:     map[string]string{"Authorization": secretHeaderValue},
-		Body:        []byte("payload"),
+		OrgID:           "org-123",
+		URL:             server.URL + destURL,
+		ContentType:     "application/x-protobuf",
+		ContentEncoding: "",
+		Headers:         map[string]string{"Authorization": secretHeaderValue},
+		Body:            []byte("payload"),
+		Signal:          SignalTraces,
+		Destination:     nil,
 	})
 
 	record := findLog(t, &buf, "otel forward returned error status")
@@ -88,11 +91,14 @@ func TestForwarderSendRequestFailedLogsURL(t *testing.T) {
 	server.Close()
 
 	f.send(t.Context(), Job{
-		OrgID:       "org-456",
-		URL:         server.URL + "/v1/metrics",
-		ContentType: "application/x-protobuf",
-		Headers:     nil,
-		Body:        []byte("payload"),
+		OrgID:           "org-456",
+		URL:             server.URL + "/v1/metrics",
+		ContentType:     "application/x-protobuf",
+		ContentEncoding: "",
+		Headers:         nil,
+		Body:            []byte("payload"),
+		Signal:          SignalMetrics,
+		Destination:     nil,
 	})
 
 	record := findLog(t, &buf, "otel forward request failed")
@@ -112,11 +118,14 @@ func TestForwarderSendSuccessNoWarning(t *testing.T) {
 	f := newCapturingForwarder(t, &buf)
 
 	f.send(t.Context(), Job{
-		OrgID:       "org-789",
-		URL:         server.URL + "/v1/traces",
-		ContentType: "application/x-protobuf",
-		Headers:     nil,
-		Body:        []byte("payload"),
+		OrgID:           "org-789",
+		URL:             server.URL + "/v1/traces",
+		ContentType:     "application/x-protobuf",
+		ContentEncoding: "",
+		Headers:         nil,
+		Body:            []byte("payload"),
+		Signal:          SignalTraces,
+		Destination:     nil,
 	})
 
 	require.NotContains(t, buf.String(), "otel forward", "successful forward should not emit a warning")
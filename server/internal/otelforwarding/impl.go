@@ -5,7 +5,6 @@ import (
 	"errors"
 	"log/slog"
 	"net/url"
-	"sort"
 	"strings"
 	"time"
 
@@ -284,11 +283,7 @@ func normalizeHeaderInputs(in []*gen.OtelForwardingHeaderInput, existing map[str
 }
 
 func snapshotFromConfig(cfg CachedConfig) audit.OtelForwardingSnapshot {
-	names := make([]string, 0, len(cfg.Headers))
-	for k := range cfg.Headers {
-		names = append(names, k)
-	}
-	sort.Strings(names)
+	names := sortedHeaderNames(cfg.Headers)
 	return audit.OtelForwardingSnapshot{
 		EndpointURL: cfg.URL,
 		HeaderNames: names,
@@ -309,11 +304,7 @@ func emptyView(orgID string) *gen.OtelForwardingConfig {
 }
 
 func buildView(cfg CachedConfig, row repo.OtelForwardingConfig) *gen.OtelForwardingConfig {
-	names := make([]string, 0, len(cfg.Headers))
-	for k := range cfg.Headers {
-		names = append(names, k)
-	}
-	sort.Strings(names)
+	names := sortedHeaderNames(cfg.Headers)
 	headers := make([]*gen.OtelForwardingHeader, 0, len(names))
 	for _, n := range names {
 		headers = append(headers, &gen.OtelForwardingHeader{
@@ -0,0 +1,434 @@
+package otelforwarding
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	gen "github.com/speakeasy-api/gram/server/gen/otel_forwarding"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/otelforwarding/repo"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+const (
+	// maxDestinationsPerOrg bounds the fan-out of every forwarded request.
+	maxDestinationsPerOrg = 10
+	// maxDestinationRules bounds each filter list and the scrub rule list;
+	// every rule is evaluated against every forwarded item.
+	maxDestinationRules = 50
+	maxDestinationName  = 100
+)
+
+func (s *Service) ListDestinations(ctx context.Context, _ *gen.ListDestinationsPayload) (*gen.ListOtelForwardingDestinationsResult, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeOrgRead, ResourceKind: "", ResourceID: authCtx.ActiveOrganizationID, Dimensions: nil}); err != nil {
+		return nil, err
+	}
+
+	destinations, rows, err := s.client.ListDestinations(ctx, authCtx.ActiveOrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]*gen.OtelForwardingDestination, 0, len(destinations))
+	for i, d := range destinations {
+		views = append(views, buildDestinationView(d, rows[i]))
+	}
+	return &gen.ListOtelForwardingDestinationsResult{Destinations: views}, nil
+}
+
+func (s *Service) CreateDestination(ctx context.Context, payload *gen.CreateDestinationPayload) (*gen.OtelForwardingDestination, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeOrgAdmin, ResourceKind: "", ResourceID: authCtx.ActiveOrganizationID, Dimensions: nil}); err != nil {
+		return nil, err
+	}
+
+	dest, err := destinationFromPayload(payload.Name, payload.EndpointURL, payload.Protocol, payload.Enabled, payload.SamplingRatio, payload.Filters, payload.ScrubRules)
+	if err != nil {
+		return nil, err
+	}
+	dest.Headers, err = normalizeHeaderInputs(payload.Headers, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogOrganizationID(authCtx.ActiveOrganizationID), attr.SlogUserID(authCtx.UserID))
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	count, err := repo.New(dbtx).CountOTELForwardingDestinations(ctx, authCtx.ActiveOrganizationID)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "count otel forwarding destinations").LogError(ctx, logger)
+	}
+	if count >= maxDestinationsPerOrg {
+		return nil, oops.E(oops.CodeInvalid, nil, "an organization can have at most %d forwarding destinations", maxDestinationsPerOrg)
+	}
+
+	dest, row, err := s.client.CreateDestinationWithTx(ctx, dbtx, authCtx.ActiveOrganizationID, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	afterSnap := snapshotFromDestination(dest, *row)
+	if err := s.audit.LogOtelForwardingDestinationCreate(ctx, dbtx, audit.LogOtelForwardingDestinationCreateEvent{
+		OrganizationID:      authCtx.ActiveOrganizationID,
+		Actor:               urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:    authCtx.Email,
+		ActorSlug:           nil,
+		DestinationURN:      urn.NewOtelForwardingDestination(row.ID),
+		DestinationName:     dest.Name,
+		DestinationSnapshot: &afterSnap,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log otel forwarding destination create").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit otel forwarding destination create").LogError(ctx, logger)
+	}
+
+	s.client.InvalidateCache(ctx, authCtx.ActiveOrganizationID)
+	return buildDestinationView(dest, *row), nil
+}
+
+func (s *Service) UpdateDestination(ctx context.Context, payload *gen.UpdateDestinationPayload) (*gen.OtelForwardingDestination, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeOrgAdmin, ResourceKind: "", ResourceID: authCtx.ActiveOrganizationID, Dimensions: nil}); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil, oops.E(oops.CodeInvalid, err, "invalid destination id")
+	}
+	dest, err := destinationFromPayload(payload.Name, payload.EndpointURL, payload.Protocol, payload.Enabled, payload.SamplingRatio, payload.Filters, payload.ScrubRules)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogOrganizationID(authCtx.ActiveOrganizationID), attr.SlogUserID(authCtx.UserID))
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	before, beforeRow, err := s.client.LockDestinationWithTx(ctx, dbtx, authCtx.ActiveOrganizationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if beforeRow == nil {
+		return nil, oops.E(oops.CodeNotFound, nil, "otel forwarding destination not found")
+	}
+
+	dest.Headers, err = normalizeHeaderInputs(payload.Headers, before.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	dest, row, err := s.client.UpdateDestinationWithTx(ctx, dbtx, authCtx.ActiveOrganizationID, id, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	beforeSnap := snapshotFromDestination(before, *beforeRow)
+	afterSnap := snapshotFromDestination(dest, *row)
+	if err := s.audit.LogOtelForwardingDestinationUpdate(ctx, dbtx, audit.LogOtelForwardingDestinationUpdateEvent{
+		OrganizationID:            authCtx.ActiveOrganizationID,
+		Actor:                     urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:          authCtx.Email,
+		ActorSlug:                 nil,
+		DestinationURN:            urn.NewOtelForwardingDestination(row.ID),
+		DestinationName:           dest.Name,
+		DestinationSnapshotBefore: &beforeSnap,
+		DestinationSnapshotAfter:  &afterSnap,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log otel forwarding destination update").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit otel forwarding destination update").LogError(ctx, logger)
+	}
+
+	s.client.InvalidateCache(ctx, authCtx.ActiveOrganizationID)
+	return buildDestinationView(dest, *row), nil
+}
+
+func (s *Service) DeleteDestination(ctx context.Context, payload *gen.DeleteDestinationPayload) error {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil {
+		return oops.C(oops.CodeUnauthorized)
+	}
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeOrgAdmin, ResourceKind: "", ResourceID: authCtx.ActiveOrganizationID, Dimensions: nil}); err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return oops.E(oops.CodeInvalid, err, "invalid destination id")
+	}
+
+	logger := s.logger.With(attr.SlogOrganizationID(authCtx.ActiveOrganizationID), attr.SlogUserID(authCtx.UserID))
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "failed to begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	dest, row, err := s.client.LockDestinationWithTx(ctx, dbtx, authCtx.ActiveOrganizationID, id)
+	if err != nil {
+		return err
+	}
+	if row == nil {
+		return oops.E(oops.CodeNotFound, nil, "otel forwarding destination not found")
+	}
+
+	if err := s.client.SoftDeleteDestinationWithTx(ctx, dbtx, authCtx.ActiveOrganizationID, id); err != nil {
+		return err
+	}
+
+	if err := s.audit.LogOtelForwardingDestinationDelete(ctx, dbtx, audit.LogOtelForwardingDestinationDeleteEvent{
+		OrganizationID:   authCtx.ActiveOrganizationID,
+		Actor:            urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName: authCtx.Email,
+		ActorSlug:        nil,
+		DestinationURN:   urn.NewOtelForwardingDestination(row.ID),
+		DestinationName:  dest.Name,
+	}); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "log otel forwarding destination delete").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "commit otel forwarding destination delete").LogError(ctx, logger)
+	}
+
+	s.client.InvalidateCache(ctx, authCtx.ActiveOrganizationID)
+	return nil
+}
+
+// destinationFromPayload validates the shared create/update fields and
+// returns the destination they describe. Headers are left for the caller
+// since updates merge them with the stored values.
+func destinationFromPayload(
+	name string,
+	endpointURL string,
+	protocol string,
+	enabled bool,
+	samplingRatio float64,
+	filters *gen.OtelForwardingFilters,
+	scrubRules []*gen.OtelForwardingScrubRule,
+) (Destination, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Destination{}, oops.E(oops.CodeInvalid, nil, "name is required")
+	}
+	if len(name) > maxDestinationName {
+		return Destination{}, oops.E(oops.CodeInvalid, nil, "name must be at most %d characters", maxDestinationName)
+	}
+
+	endpointURL = strings.TrimSpace(endpointURL)
+	if err := validateForwardingURL(endpointURL); err != nil {
+		return Destination{}, err
+	}
+
+	wire := Protocol(protocol)
+	if !wire.Valid() {
+		return Destination{}, oops.E(oops.CodeInvalid, nil, "unsupported protocol: %q", protocol)
+	}
+	if wire == ProtocolGRPC {
+		if _, _, err := grpcTarget(endpointURL); err != nil {
+			return Destination{}, oops.E(oops.CodeInvalid, err, "endpoint_url is not a valid gRPC endpoint")
+		}
+	}
+
+	if samplingRatio < 0 || samplingRatio > 1 {
+		return Destination{}, oops.E(oops.CodeInvalid, nil, "sampling_ratio must be between 0 and 1")
+	}
+
+	f, err := filtersFromPayload(filters)
+	if err != nil {
+		return Destination{}, err
+	}
+
+	if len(scrubRules) > maxDestinationRules {
+		return Destination{}, oops.E(oops.CodeInvalid, nil, "at most %d scrub rules are allowed", maxDestinationRules)
+	}
+	rules := make([]ScrubRule, 0, len(scrubRules))
+	for _, r := range scrubRules {
+		if r == nil {
+			continue
+		}
+		key := strings.TrimSpace(r.Key)
+		if key == "" {
+			return Destination{}, oops.E(oops.CodeInvalid, nil, "scrub rule key cannot be empty")
+		}
+		action := ScrubAction(r.Action)
+		if !action.Valid() {
+			return Destination{}, oops.E(oops.CodeInvalid, nil, "unsupported scrub action: %q", r.Action)
+		}
+		rules = append(rules, ScrubRule{Key: key, Action: action})
+	}
+
+	return Destination{
+		ID:            "",
+		Name:          name,
+		URL:           endpointURL,
+		Protocol:      wire,
+		Headers:       nil,
+		Enabled:       enabled,
+		SamplingRatio: samplingRatio,
+		Filters:       f,
+		ScrubRules:    rules,
+	}, nil
+}
+
+func filtersFromPayload(in *gen.OtelForwardingFilters) (Filters, error) {
+	var out Filters
+	if in == nil {
+		return out, nil
+	}
+
+	var err error
+	if out.IncludeNames, err = namePatternsFromPayload("include_names", in.IncludeNames); err != nil {
+		return Filters{}, err
+	}
+	if out.ExcludeNames, err = namePatternsFromPayload("exclude_names", in.ExcludeNames); err != nil {
+		return Filters{}, err
+	}
+	if out.IncludeAttributes, err = attributeMatchesFromPayload("include_attributes", in.IncludeAttributes); err != nil {
+		return Filters{}, err
+	}
+	if out.ExcludeAttributes, err = attributeMatchesFromPayload("exclude_attributes", in.ExcludeAttributes); err != nil {
+		return Filters{}, err
+	}
+	return out, nil
+}
+
+func namePatternsFromPayload(field string, in []string) ([]string, error) {
+	if len(in) > maxDestinationRules {
+		return nil, oops.E(oops.CodeInvalid, nil, "%s allows at most %d entries", field, maxDestinationRules)
+	}
+	var out []string
+	for _, pattern := range in {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			return nil, oops.E(oops.CodeInvalid, nil, "%s entries cannot be empty", field)
+		}
+		out = append(out, pattern)
+	}
+	return out, nil
+}
+
+func attributeMatchesFromPayload(field string, in []*gen.OtelForwardingAttributeMatch) ([]AttributeMatch, error) {
+	if len(in) > maxDestinationRules {
+		return nil, oops.E(oops.CodeInvalid, nil, "%s allows at most %d entries", field, maxDestinationRules)
+	}
+	var out []AttributeMatch
+	for _, m := range in {
+		if m == nil {
+			continue
+		}
+		key := strings.TrimSpace(m.Key)
+		if key == "" {
+			return nil, oops.E(oops.CodeInvalid, nil, "%s keys cannot be empty", field)
+		}
+		out = append(out, AttributeMatch{Key: key, Value: m.Value})
+	}
+	return out, nil
+}
+
+func snapshotFromDestination(d Destination, row repo.OtelForwardingDestination) audit.OtelForwardingDestinationSnapshot {
+	return audit.OtelForwardingDestinationSnapshot{
+		Name:          d.Name,
+		EndpointURL:   d.URL,
+		Protocol:      string(d.Protocol),
+		HeaderNames:   sortedHeaderNames(d.Headers),
+		Enabled:       d.Enabled,
+		SamplingRatio: d.SamplingRatio,
+		Filters:       row.Filters,
+		ScrubRules:    row.ScrubRules,
+	}
+}
+
+func buildDestinationView(d Destination, row repo.OtelForwardingDestination) *gen.OtelForwardingDestination {
+	names := sortedHeaderNames(d.Headers)
+	headers := make([]*gen.OtelForwardingHeader, 0, len(names))
+	for _, n := range names {
+		headers = append(headers, &gen.OtelForwardingHeader{
+			Name:     n,
+			HasValue: d.Headers[n] != "",
+		})
+	}
+
+	scrubRules := make([]*gen.OtelForwardingScrubRule, 0, len(d.ScrubRules))
+	for _, r := range d.ScrubRules {
+		scrubRules = append(scrubRules, &gen.OtelForwardingScrubRule{Key: r.Key, Action: string(r.Action)})
+	}
+
+	return &gen.OtelForwardingDestination{
+		ID:             row.ID.String(),
+		OrganizationID: row.OrganizationID,
+		Name:           d.Name,
+		EndpointURL:    d.URL,
+		Protocol:       string(d.Protocol),
+		Enabled:        d.Enabled,
+		Headers:        headers,
+		SamplingRatio:  d.SamplingRatio,
+		Filters: &gen.OtelForwardingFilters{
+			IncludeNames:      nonNilStrings(d.Filters.IncludeNames),
+			ExcludeNames:      nonNilStrings(d.Filters.ExcludeNames),
+			IncludeAttributes: attributeMatchesView(d.Filters.IncludeAttributes),
+			ExcludeAttributes: attributeMatchesView(d.Filters.ExcludeAttributes),
+		},
+		ScrubRules: scrubRules,
+		CreatedAt:  row.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:  row.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
+
+func attributeMatchesView(in []AttributeMatch) []*gen.OtelForwardingAttributeMatch {
+	out := make([]*gen.OtelForwardingAttributeMatch, 0, len(in))
+	for _, m := range in {
+		out = append(out, &gen.OtelForwardingAttributeMatch{Key: m.Key, Value: m.Value})
+	}
+	return out
+}
+
+func nonNilStrings(in []string) []string {
+	if in == nil {
+		return []string{}
+	}
+	return in
+}
+
+func sortedHeaderNames(headers map[string]string) []string {
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
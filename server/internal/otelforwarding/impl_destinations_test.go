@@ -0,0 +1,71 @@
+package otelforwarding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/otel_forwarding"
+)
+
+func TestDestinationFromPayloadNormalizesInput(t *testing.T) {
+	t.Parallel()
+
+	d, err := destinationFromPayload(
+		"  datadog  ",
+		" https://otlp.datadoghq.com ",
+		"http/json",
+		true,
+		0.25,
+		&gen.OtelForwardingFilters{
+			IncludeNames:      nil,
+			ExcludeNames:      []string{" hook.* "},
+			IncludeAttributes: nil,
+			ExcludeAttributes: []*gen.OtelForwardingAttributeMatch{{Key: "gram.hook.source", Value: nil}},
+		},
+		[]*gen.OtelForwardingScrubRule{{Key: "gen_ai.tool.call.arguments", Action: "drop"}},
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, "datadog", d.Name)
+	require.Equal(t, "https://otlp.datadoghq.com", d.URL)
+	require.Equal(t, ProtocolHTTPJSON, d.Protocol)
+	require.InDelta(t, 0.25, d.SamplingRatio, 0)
+	require.Equal(t, []string{"hook.*"}, d.Filters.ExcludeNames)
+	require.Equal(t, []AttributeMatch{{Key: "gram.hook.source", Value: nil}}, d.Filters.ExcludeAttributes)
+	require.Equal(t, []ScrubRule{{Key: "gen_ai.tool.call.arguments", Action: ScrubActionDrop}}, d.ScrubRules)
+}
+
+func TestDestinationFromPayloadRejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		name     string
+		url      string
+		protocol string
+		ratio    float64
+		filters  *gen.OtelForwardingFilters
+		rules    []*gen.OtelForwardingScrubRule
+	}{
+		"empty name":       {name: " ", url: "https://x.example.com", protocol: "grpc", ratio: 1},
+		"bad scheme":       {name: "x", url: "ftp://x.example.com", protocol: "grpc", ratio: 1},
+		"unknown protocol": {name: "x", url: "https://x.example.com", protocol: "zipkin", ratio: 1},
+		"ratio above one":  {name: "x", url: "https://x.example.com", protocol: "grpc", ratio: 1.5},
+		"empty name filter": {name: "x", url: "https://x.example.com", protocol: "grpc", ratio: 1, filters: &gen.OtelForwardingFilters{
+			IncludeNames:      []string{""},
+			ExcludeNames:      nil,
+			IncludeAttributes: nil,
+			ExcludeAttributes: nil,
+		}},
+		"empty scrub key":      {name: "x", url: "https://x.example.com", protocol: "grpc", ratio: 1, rules: []*gen.OtelForwardingScrubRule{{Key: "", Action: "drop"}}},
+		"unknown scrub action": {name: "x", url: "https://x.example.com", protocol: "grpc", ratio: 1, rules: []*gen.OtelForwardingScrubRule{{Key: "k", Action: "mask"}}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := destinationFromPayload(tc.name, tc.url, tc.protocol, true, tc.ratio, tc.filters, tc.rules)
+			require.Error(t, err)
+		})
+	}
+}
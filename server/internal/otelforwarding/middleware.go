@@ -32,9 +32,9 @@ const (
 
 // Middleware returns an HTTP middleware that intercepts requests to the
 // hooks.otel endpoints, buffers the body, and asynchronously forwards a copy
-// to the customer's configured endpoint. The original request continues to
-// the downstream Goa handler unchanged so we still process the payload
-// ourselves.
+// to the customer's configured endpoint and to each enabled destination. The
+// original request continues to the downstream Goa handler unchanged so we
+// still process the payload ourselves.
 //
 // Failures (queue full, customer endpoint 5xx, lookup errors) are logged
 // only — they never affect the response returned to the caller.
@@ -133,26 +133,64 @@ func tryForward(ctx context.Context, logger *slog.Logger, client *Client, forwar
 		)
 		return
 	}
-	if !cfg.IsConfigured() || !cfg.Enabled {
-		return
+	subpath := strings.TrimPrefix(r.URL.Path, pathPrefix)
+	contentType := r.Header.Get("Content-Type")
+	contentEncoding := r.Header.Get("Content-Encoding")
+
+	if cfg.IsConfigured() && cfg.Enabled {
+		targetURL, err := url.JoinPath(cfg.URL, subpath)
+		if err != nil {
+			logger.WarnContext(ctx, "build otel forwarding target url",
+				attr.SlogError(err),
+				attr.SlogOrganizationID(orgID),
+				attr.SlogURLFull(cfg.URL),
+			)
+		} else {
+			forwarder.Enqueue(ctx, Job{
+				OrgID:           orgID,
+				URL:             targetURL,
+				ContentType:     contentType,
+				ContentEncoding: contentEncoding,
+				Headers:         cfg.Headers,
+				Body:            body,
+				Signal:          signalForPath(r.URL.Path),
+				Destination:     nil,
+			})
+		}
 	}
 
-	subpath := strings.TrimPrefix(r.URL.Path, pathPrefix)
-	targetURL, err := url.JoinPath(cfg.URL, subpath)
-	if err != nil {
-		logger.WarnContext(ctx, "build otel forwarding target url",
-			attr.SlogError(err),
-			attr.SlogOrganizationID(orgID),
-			attr.SlogURLFull(cfg.URL),
-		)
-		return
+	for _, dest := range cfg.EnabledDestinations() {
+		// gRPC destinations name the collector itself; the service method,
+		// not a URL path, selects the signal.
+		targetURL := dest.URL
+		if dest.Protocol != ProtocolGRPC {
+			targetURL, err = url.JoinPath(dest.URL, subpath)
+			if err != nil {
+				logger.WarnContext(ctx, "build otel forwarding target url",
+					attr.SlogError(err),
+					attr.SlogOrganizationID(orgID),
+					attr.SlogURLFull(dest.URL),
+				)
+				continue
+			}
+		}
+
+		forwarder.Enqueue(ctx, Job{
+			OrgID:           orgID,
+			URL:             targetURL,
+			ContentType:     contentType,
+			ContentEncoding: contentEncoding,
+			Headers:         dest.Headers,
+			Body:            body,
+			Signal:          signalForPath(r.URL.Path),
+			Destination:     &dest,
+		})
 	}
+}
 
-	forwarder.Enqueue(ctx, Job{
-		OrgID:       orgID,
-		URL:         targetURL,
-		ContentType: r.Header.Get("Content-Type"),
-		Headers:     cfg.Headers,
-		Body:        body,
-	})
+func signalForPath(path string) Signal {
+	if path == pathMetrics {
+		return SignalMetrics
+	}
+	return SignalLogs
 }
@@ -0,0 +1,374 @@
+package otelforwarding
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+
+	collectorlogsv1 "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	collectormetricsv1 "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortracev1 "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricsv1 "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	redactedValue = "[REDACTED]"
+
+	// eventNameKey carries the event name on log records from emitters that
+	// predate the dedicated LogRecord.event_name field.
+	eventNameKey = "event.name"
+)
+
+// Pipeline applies a destination's filters, head sampling and scrub rules to
+// OTLP export requests in place. Filters run first so excluded items are
+// never sampled or scrubbed; scrubbing touches resource, scope and item
+// attributes alike so a dropped key cannot leak through the resource.
+type Pipeline struct {
+	filters       Filters
+	samplingRatio float64
+	scrubRules    []ScrubRule
+	random        func() float64
+}
+
+func NewPipeline(d Destination) *Pipeline {
+	return &Pipeline{
+		filters:       d.Filters,
+		samplingRatio: d.SamplingRatio,
+		scrubRules:    d.ScrubRules,
+		random:        rand.Float64,
+	}
+}
+
+// IsNoop reports whether Apply would leave every request untouched, letting
+// callers skip decoding a payload they can forward as is.
+func (p *Pipeline) IsNoop() bool {
+	return p.filters.IsZero() && p.samplingRatio >= 1 && len(p.scrubRules) == 0
+}
+
+// Apply runs the pipeline over an OTLP export request and returns the number
+// of spans, log records or metrics left in it. Metrics are filtered and
+// scrubbed but never sampled: dropping a share of cumulative points would
+// skew the aggregates downstream rather than thin them out.
+func (p *Pipeline) Apply(msg proto.Message) (int, error) {
+	switch m := msg.(type) {
+	case *collectortracev1.ExportTraceServiceRequest:
+		return p.applyTraces(m), nil
+	case *collectorlogsv1.ExportLogsServiceRequest:
+		return p.applyLogs(m), nil
+	case *collectormetricsv1.ExportMetricsServiceRequest:
+		return p.applyMetrics(m), nil
+	default:
+		return 0, fmt.Errorf("unsupported otlp message %T", msg)
+	}
+}
+
+func (p *Pipeline) applyTraces(req *collectortracev1.ExportTraceServiceRequest) int {
+	kept := 0
+	resources := req.ResourceSpans[:0]
+	for _, rs := range req.ResourceSpans {
+		resourceAttrs := rs.GetResource().GetAttributes()
+		scopes := rs.ScopeSpans[:0]
+		for _, ss := range rs.ScopeSpans {
+			spans := ss.Spans[:0]
+			for _, span := range ss.Spans {
+				if !p.admit(span.GetName(), span.GetAttributes(), resourceAttrs) || !p.sample(span.GetTraceId()) {
+					continue
+				}
+				span.Attributes = p.scrub(span.Attributes)
+				for _, event := range span.Events {
+					event.Attributes = p.scrub(event.Attributes)
+				}
+				for _, link := range span.Links {
+					link.Attributes = p.scrub(link.Attributes)
+				}
+				spans = append(spans, span)
+			}
+			if len(spans) == 0 {
+				continue
+			}
+			ss.Spans = spans
+			if ss.Scope != nil {
+				ss.Scope.Attributes = p.scrub(ss.Scope.Attributes)
+			}
+			scopes = append(scopes, ss)
+			kept += len(spans)
+		}
+		if len(scopes) == 0 {
+			continue
+		}
+		rs.ScopeSpans = scopes
+		if rs.Resource != nil {
+			rs.Resource.Attributes = p.scrub(rs.Resource.Attributes)
+		}
+		resources = append(resources, rs)
+	}
+	req.ResourceSpans = resources
+	return kept
+}
+
+func (p *Pipeline) applyLogs(req *collectorlogsv1.ExportLogsServiceRequest) int {
+	kept := 0
+	resources := req.ResourceLogs[:0]
+	for _, rl := range req.ResourceLogs {
+		resourceAttrs := rl.GetResource().GetAttributes()
+		scopes := rl.ScopeLogs[:0]
+		for _, sl := range rl.ScopeLogs {
+			records := sl.LogRecords[:0]
+			for _, record := range sl.LogRecords {
+				if !p.admit(logEventName(record), record.GetAttributes(), resourceAttrs) || !p.sample(record.GetTraceId()) {
+					continue
+				}
+				record.Attributes = p.scrub(record.Attributes)
+				records = append(records, record)
+			}
+			if len(records) == 0 {
+				continue
+			}
+			sl.LogRecords = records
+			if sl.Scope != nil {
+				sl.Scope.Attributes = p.scrub(sl.Scope.Attributes)
+			}
+			scopes = append(scopes, sl)
+			kept += len(records)
+		}
+		if len(scopes) == 0 {
+			continue
+		}
+		rl.ScopeLogs = scopes
+		if rl.Resource != nil {
+			rl.Resource.Attributes = p.scrub(rl.Resource.Attributes)
+		}
+		resources = append(resources, rl)
+	}
+	req.ResourceLogs = resources
+	return kept
+}
+
+func (p *Pipeline) applyMetrics(req *collectormetricsv1.ExportMetricsServiceRequest) int {
+	kept := 0
+	resources := req.ResourceMetrics[:0]
+	for _, rm := range req.ResourceMetrics {
+		resourceAttrs := rm.GetResource().GetAttributes()
+		scopes := rm.ScopeMetrics[:0]
+		for _, sm := range rm.ScopeMetrics {
+			metrics := sm.Metrics[:0]
+			for _, metric := range sm.Metrics {
+				if p.applyMetric(metric, resourceAttrs) {
+					metrics = append(metrics, metric)
+				}
+			}
+			if len(metrics) == 0 {
+				continue
+			}
+			sm.Metrics = metrics
+			if sm.Scope != nil {
+				sm.Scope.Attributes = p.scrub(sm.Scope.Attributes)
+			}
+			scopes = append(scopes, sm)
+			kept += len(metrics)
+		}
+		if len(scopes) == 0 {
+			continue
+		}
+		rm.ScopeMetrics = scopes
+		if rm.Resource != nil {
+			rm.Resource.Attributes = p.scrub(rm.Resource.Attributes)
+		}
+		resources = append(resources, rm)
+	}
+	req.ResourceMetrics = resources
+	return kept
+}
+
+// applyMetric filters a metric's data points on their attributes, scrubs the
+// survivors and reports whether any remain.
+func (p *Pipeline) applyMetric(metric *metricsv1.Metric, resourceAttrs []*commonv1.KeyValue) bool {
+	name := metric.GetName()
+	switch {
+	case metric.GetGauge() != nil:
+		g := metric.GetGauge()
+		g.DataPoints = applyPoints(p, name, g.DataPoints, resourceAttrs, func(dp *metricsv1.NumberDataPoint, attrs []*commonv1.KeyValue) { dp.Attributes = attrs })
+		return len(g.DataPoints) > 0
+	case metric.GetSum() != nil:
+		sum := metric.GetSum()
+		sum.DataPoints = applyPoints(p, name, sum.DataPoints, resourceAttrs, func(dp *metricsv1.NumberDataPoint, attrs []*commonv1.KeyValue) { dp.Attributes = attrs })
+		return len(sum.DataPoints) > 0
+	case metric.GetHistogram() != nil:
+		h := metric.GetHistogram()
+		h.DataPoints = applyPoints(p, name, h.DataPoints, resourceAttrs, func(dp *metricsv1.HistogramDataPoint, attrs []*commonv1.KeyValue) { dp.Attributes = attrs })
+		return len(h.DataPoints) > 0
+	case metric.GetExponentialHistogram() != nil:
+		h := metric.GetExponentialHistogram()
+		h.DataPoints = applyPoints(p, name, h.DataPoints, resourceAttrs, func(dp *metricsv1.ExponentialHistogramDataPoint, attrs []*commonv1.KeyValue) { dp.Attributes = attrs })
+		return len(h.DataPoints) > 0
+	case metric.GetSummary() != nil:
+		summary := metric.GetSummary()
+		summary.DataPoints = applyPoints(p, name, summary.DataPoints, resourceAttrs, func(dp *metricsv1.SummaryDataPoint, attrs []*commonv1.KeyValue) { dp.Attributes = attrs })
+		return len(summary.DataPoints) > 0
+	default:
+		// A metric with no data carries nothing worth forwarding.
+		return false
+	}
+}
+
+type dataPoint interface {
+	GetAttributes() []*commonv1.KeyValue
+}
+
+func applyPoints[T dataPoint](p *Pipeline, name string, points []T, resourceAttrs []*commonv1.KeyValue, setAttrs func(T, []*commonv1.KeyValue)) []T {
+	kept := points[:0]
+	for _, dp := range points {
+		if !p.admit(name, dp.GetAttributes(), resourceAttrs) {
+			continue
+		}
+		setAttrs(dp, p.scrub(dp.GetAttributes()))
+		kept = append(kept, dp)
+	}
+	return kept
+}
+
+// admit reports whether an item passes the destination's filters. Attribute
+// matches look at the item's own attributes first and fall back to its
+// resource, so a filter on service.name works on every signal.
+func (p *Pipeline) admit(name string, attrs, resourceAttrs []*commonv1.KeyValue) bool {
+	f := p.filters
+	if len(f.IncludeNames) > 0 && !matchAnyName(f.IncludeNames, name) {
+		return false
+	}
+	if matchAnyName(f.ExcludeNames, name) {
+		return false
+	}
+	if len(f.IncludeAttributes) > 0 && !matchAnyAttribute(f.IncludeAttributes, attrs, resourceAttrs) {
+		return false
+	}
+	return !matchAnyAttribute(f.ExcludeAttributes, attrs, resourceAttrs)
+}
+
+// sample makes the head sampling decision. Items carrying a trace ID are
+// kept or dropped with the rest of their trace, using the same
+// trace-ID-ratio rule as the OpenTelemetry SDK sampler; anything else is
+// sampled independently.
+func (p *Pipeline) sample(traceID []byte) bool {
+	switch {
+	case p.samplingRatio >= 1:
+		return true
+	case p.samplingRatio <= 0:
+		return false
+	case len(traceID) == 16:
+		bound := uint64(p.samplingRatio * (1 << 63))
+		return binary.BigEndian.Uint64(traceID[8:16])>>1 < bound
+	default:
+		return p.random() < p.samplingRatio
+	}
+}
+
+func (p *Pipeline) scrub(attrs []*commonv1.KeyValue) []*commonv1.KeyValue {
+	if len(p.scrubRules) == 0 {
+		return attrs
+	}
+
+	kept := attrs[:0]
+	for _, kv := range attrs {
+		action, ok := p.scrubAction(kv.GetKey())
+		switch {
+		case !ok:
+		case action == ScrubActionDrop:
+			continue
+		case action == ScrubActionRedact:
+			kv.Value = stringValue(redactedValue)
+		case action == ScrubActionHash:
+			digest := sha256.Sum256([]byte(anyValueString(kv.GetValue())))
+			kv.Value = stringValue(hex.EncodeToString(digest[:]))
+		}
+		kept = append(kept, kv)
+	}
+	return kept
+}
+
+// scrubAction returns the action of the first rule matching key.
+func (p *Pipeline) scrubAction(key string) (ScrubAction, bool) {
+	for _, rule := range p.scrubRules {
+		if matchGlob(rule.Key, key) {
+			return rule.Action, true
+		}
+	}
+	return "", false
+}
+
+func logEventName(record *logsv1.LogRecord) string {
+	if name := record.GetEventName(); name != "" {
+		return name
+	}
+	if v, ok := findAttribute(record.GetAttributes(), eventNameKey); ok {
+		return anyValueString(v)
+	}
+	return ""
+}
+
+func matchAnyName(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matchGlob(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAnyAttribute(matches []AttributeMatch, attrs, resourceAttrs []*commonv1.KeyValue) bool {
+	for _, m := range matches {
+		v, ok := findAttribute(attrs, m.Key)
+		if !ok {
+			v, ok = findAttribute(resourceAttrs, m.Key)
+		}
+		if !ok {
+			continue
+		}
+		if m.Value == nil || anyValueString(v) == *m.Value {
+			return true
+		}
+	}
+	return false
+}
+
+func findAttribute(attrs []*commonv1.KeyValue, key string) (*commonv1.AnyValue, bool) {
+	for _, kv := range attrs {
+		if kv.GetKey() == key {
+			return kv.GetValue(), true
+		}
+	}
+	return nil, false
+}
+
+// anyValueString renders a value the way a user would type it into a filter:
+// scalars as plain text and anything structured as its OTLP/JSON encoding.
+func anyValueString(v *commonv1.AnyValue) string {
+	switch val := v.GetValue().(type) {
+	case nil:
+		return ""
+	case *commonv1.AnyValue_StringValue:
+		return val.StringValue
+	case *commonv1.AnyValue_BoolValue:
+		return strconv.FormatBool(val.BoolValue)
+	case *commonv1.AnyValue_IntValue:
+		return strconv.FormatInt(val.IntValue, 10)
+	case *commonv1.AnyValue_DoubleValue:
+		return strconv.FormatFloat(val.DoubleValue, 'f', -1, 64)
+	default:
+		b, err := protojson.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+}
+
+func stringValue(s string) *commonv1.AnyValue {
+	return &commonv1.AnyValue{Value: &commonv1.AnyValue_StringValue{StringValue: s}}
+}
@@ -0,0 +1,311 @@
+package otelforwarding
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	collectorlogsv1 "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	collectormetricsv1 "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortracev1 "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonv1 "go.opentelemetry.io/proto/otlp/common/v1"
+	logsv1 "go.opentelemetry.io/proto/otlp/logs/v1"
+	metricsv1 "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcev1 "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMatchGlob(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{"tool.call", "tool.call", true},
+		{"tool.call", "tool.calls", false},
+		{"*", "", true},
+		{"hook.*", "hook.pre_tool_use", true},
+		{"hook.*", "hooks.pre_tool_use", false},
+		{"*.args", "gen_ai.tool.call.args", true},
+		{"gen_ai.*.args", "gen_ai.tool.call.args", true},
+		{"gen_ai.*.args", "gen_ai.tool.call.result", false},
+		{"a*b*c", "abc", true},
+		{"a*b*c", "acb", false},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.want, matchGlob(tc.pattern, tc.value), "%q vs %q", tc.pattern, tc.value)
+	}
+}
+
+func TestPipelineFiltersSpansByName(t *testing.T) {
+	t.Parallel()
+
+	req := traceRequest(
+		span("hook.pre_tool_use", traceID(0), nil),
+		span("tool.call", traceID(0), nil),
+	)
+	p := NewPipeline(testDestination(Filters{ExcludeNames: []string{"hook.*"}}, 1, nil))
+
+	kept, err := p.Apply(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, kept)
+	require.Equal(t, []string{"tool.call"}, spanNames(req))
+}
+
+func TestPipelineFiltersByAttributeIncludingResource(t *testing.T) {
+	t.Parallel()
+
+	prod := "production"
+	req := traceRequest(
+		span("a", traceID(0), []*commonv1.KeyValue{kv("gram.tool.urn", "tools:http:x:y")}),
+		span("b", traceID(0), nil),
+	)
+	req.ResourceSpans[0].Resource = &resourcev1.Resource{Attributes: []*commonv1.KeyValue{kv("deployment.environment", "production")}}
+
+	p := NewPipeline(testDestination(Filters{
+		IncludeAttributes: []AttributeMatch{{Key: "deployment.environment", Value: &prod}},
+		ExcludeAttributes: []AttributeMatch{{Key: "gram.tool.urn", Value: nil}},
+	}, 1, nil))
+
+	kept, err := p.Apply(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, kept)
+	require.Equal(t, []string{"b"}, spanNames(req))
+}
+
+func TestPipelineDropsEmptyResources(t *testing.T) {
+	t.Parallel()
+
+	req := traceRequest(span("hook.stop", traceID(0), nil))
+	p := NewPipeline(testDestination(Filters{IncludeNames: []string{"tool.*"}}, 1, nil))
+
+	kept, err := p.Apply(req)
+	require.NoError(t, err)
+	require.Zero(t, kept)
+	require.Empty(t, req.ResourceSpans)
+}
+
+func TestPipelineScrubsAttributes(t *testing.T) {
+	t.Parallel()
+
+	req := traceRequest(span("tool.call", traceID(0), []*commonv1.KeyValue{
+		kv("gen_ai.tool.call.arguments", `{"password":"hunter2"}`),
+		kv("user.email", "someone@example.com"),
+		kv("session.id", "abc"),
+		kv("gen_ai.tool.name", "search"),
+	}))
+	req.ResourceSpans[0].Resource = &resourcev1.Resource{Attributes: []*commonv1.KeyValue{kv("session.id", "abc")}}
+
+	p := NewPipeline(testDestination(Filters{}, 1, []ScrubRule{
+		{Key: "gen_ai.tool.call.*", Action: ScrubActionDrop},
+		{Key: "user.email", Action: ScrubActionRedact},
+		{Key: "session.id", Action: ScrubActionHash},
+	}))
+
+	kept, err := p.Apply(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, kept)
+
+	digest := sha256.Sum256([]byte("abc"))
+	wantHash := hex.EncodeToString(digest[:])
+
+	attrs := attributeMap(req.ResourceSpans[0].ScopeSpans[0].Spans[0].Attributes)
+	require.Equal(t, map[string]string{
+		"user.email":       redactedValue,
+		"session.id":       wantHash,
+		"gen_ai.tool.name": "search",
+	}, attrs)
+	require.Equal(t, map[string]string{"session.id": wantHash}, attributeMap(req.ResourceSpans[0].Resource.Attributes))
+}
+
+func TestPipelineSamplesWholeTraces(t *testing.T) {
+	t.Parallel()
+
+	// The low 63 bits of the trace ID decide: all-zero is always under the
+	// bound and all-ones never is.
+	keep := traceID(0x00)
+	drop := traceID(0xff)
+	req := traceRequest(
+		span("a", keep, nil),
+		span("b", drop, nil),
+		span("c", keep, nil),
+		span("d", drop, nil),
+	)
+	p := NewPipeline(testDestination(Filters{}, 0.5, nil))
+
+	kept, err := p.Apply(req)
+	require.NoError(t, err)
+	require.Equal(t, 2, kept)
+	require.Equal(t, []string{"a", "c"}, spanNames(req))
+}
+
+func TestPipelineSamplesLogsWithoutTraceID(t *testing.T) {
+	t.Parallel()
+
+	req := &collectorlogsv1.ExportLogsServiceRequest{ResourceLogs: []*logsv1.ResourceLogs{{
+		ScopeLogs: []*logsv1.ScopeLogs{{LogRecords: []*logsv1.LogRecord{
+			{EventName: "first"},
+			{EventName: "second"},
+		}}},
+	}}}
+	p := NewPipeline(testDestination(Filters{}, 0.5, nil))
+	draws := []float64{0.1, 0.9}
+	p.random = func() float64 {
+		v := draws[0]
+		draws = draws[1:]
+		return v
+	}
+
+	kept, err := p.Apply(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, kept)
+	require.Equal(t, "first", req.ResourceLogs[0].ScopeLogs[0].LogRecords[0].EventName)
+}
+
+func TestPipelineFiltersLogsByEventNameAttribute(t *testing.T) {
+	t.Parallel()
+
+	req := &collectorlogsv1.ExportLogsServiceRequest{ResourceLogs: []*logsv1.ResourceLogs{{
+		ScopeLogs: []*logsv1.ScopeLogs{{LogRecords: []*logsv1.LogRecord{
+			{Attributes: []*commonv1.KeyValue{kv(eventNameKey, "hook.post_tool_use")}},
+			{EventName: "tool.error"},
+		}}},
+	}}}
+	p := NewPipeline(testDestination(Filters{ExcludeNames: []string{"hook.*"}}, 1, nil))
+
+	kept, err := p.Apply(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, kept)
+	require.Equal(t, "tool.error", req.ResourceLogs[0].ScopeLogs[0].LogRecords[0].EventName)
+}
+
+func TestPipelineNeverSamplesMetrics(t *testing.T) {
+	t.Parallel()
+
+	req := &collectormetricsv1.ExportMetricsServiceRequest{ResourceMetrics: []*metricsv1.ResourceMetrics{{
+		ScopeMetrics: []*metricsv1.ScopeMetrics{{Metrics: []*metricsv1.Metric{
+			{Name: "tool.calls", Data: &metricsv1.Metric_Sum{Sum: &metricsv1.Sum{DataPoints: []*metricsv1.NumberDataPoint{{}}}}},
+			{Name: "hook.events", Data: &metricsv1.Metric_Gauge{Gauge: &metricsv1.Gauge{DataPoints: []*metricsv1.NumberDataPoint{{}}}}},
+		}}},
+	}}}
+	p := NewPipeline(testDestination(Filters{ExcludeNames: []string{"hook.*"}}, 0, nil))
+
+	kept, err := p.Apply(req)
+	require.NoError(t, err)
+	require.Equal(t, 1, kept)
+	require.Equal(t, "tool.calls", req.ResourceMetrics[0].ScopeMetrics[0].Metrics[0].Name)
+}
+
+func TestPipelineIsNoop(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, NewPipeline(testDestination(Filters{}, 1, nil)).IsNoop())
+	require.False(t, NewPipeline(testDestination(Filters{}, 0.9, nil)).IsNoop())
+	require.False(t, NewPipeline(testDestination(Filters{ExcludeNames: []string{"x"}}, 1, nil)).IsNoop())
+	require.False(t, NewPipeline(testDestination(Filters{}, 1, []ScrubRule{{Key: "x", Action: ScrubActionDrop}})).IsNoop())
+}
+
+func TestExportRoundTripAcrossEncodings(t *testing.T) {
+	t.Parallel()
+
+	req := traceRequest(span("tool.call", traceID(0x01), []*commonv1.KeyValue{kv("k", "v")}))
+
+	jsonBody, contentType, err := EncodeHTTPExport(ProtocolHTTPJSON, req)
+	require.NoError(t, err)
+	require.Equal(t, contentTypeJSON, contentType)
+
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, err = zw.Write(jsonBody)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	decoded, err := NewExportRequest(SignalTraces)
+	require.NoError(t, err)
+	require.NoError(t, DecodeExportRequest("application/json; charset=utf-8", "gzip", gz.Bytes(), decoded))
+	require.True(t, proto.Equal(req, decoded))
+
+	protoBody, contentType, err := EncodeHTTPExport(ProtocolHTTPProtobuf, decoded)
+	require.NoError(t, err)
+	require.Equal(t, contentTypeProtobuf, contentType)
+
+	again, err := NewExportRequest(SignalTraces)
+	require.NoError(t, err)
+	require.NoError(t, DecodeExportRequest(contentTypeProtobuf, "", protoBody, again))
+	require.True(t, proto.Equal(req, again))
+}
+
+func TestGRPCTarget(t *testing.T) {
+	t.Parallel()
+
+	target, secure, err := grpcTarget("https://otlp.example.com")
+	require.NoError(t, err)
+	require.Equal(t, "passthrough:///otlp.example.com:4317", target)
+	require.True(t, secure)
+
+	target, secure, err = grpcTarget("http://collector:55680")
+	require.NoError(t, err)
+	require.Equal(t, "passthrough:///collector:55680", target)
+	require.False(t, secure)
+
+	_, _, err = grpcTarget("http://")
+	require.Error(t, err)
+}
+
+func testDestination(filters Filters, samplingRatio float64, scrubRules []ScrubRule) Destination {
+	return Destination{
+		ID:            "",
+		Name:          "test",
+		URL:           "https://otlp.example.com",
+		Protocol:      ProtocolHTTPProtobuf,
+		Headers:       nil,
+		Enabled:       true,
+		SamplingRatio: samplingRatio,
+		Filters:       filters,
+		ScrubRules:    scrubRules,
+	}
+}
+
+func traceID(fill byte) []byte {
+	return bytes.Repeat([]byte{fill}, 16)
+}
+
+func kv(key, value string) *commonv1.KeyValue {
+	return &commonv1.KeyValue{Key: key, Value: stringValue(value)}
+}
+
+func span(name string, traceID []byte, attrs []*commonv1.KeyValue) *tracev1.Span {
+	return &tracev1.Span{Name: name, TraceId: traceID, Attributes: attrs}
+}
+
+func traceRequest(spans ...*tracev1.Span) *collectortracev1.ExportTraceServiceRequest {
+	return &collectortracev1.ExportTraceServiceRequest{ResourceSpans: []*tracev1.ResourceSpans{{
+		ScopeSpans: []*tracev1.ScopeSpans{{Spans: spans}},
+	}}}
+}
+
+func spanNames(req *collectortracev1.ExportTraceServiceRequest) []string {
+	var names []string
+	for _, rs := range req.ResourceSpans {
+		for _, ss := range rs.ScopeSpans {
+			for _, s := range ss.Spans {
+				names = append(names, s.Name)
+			}
+		}
+	}
+	return names
+}
+
+func attributeMap(attrs []*commonv1.KeyValue) map[string]string {
+	out := make(map[string]string, len(attrs))
+	for _, a := range attrs {
+		out[a.Key] = anyValueString(a.Value)
+	}
+	return out
+}
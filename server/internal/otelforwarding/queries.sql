@@ -47,3 +47,71 @@ SELECT organization_id
 FROM api_keys
 WHERE key_hash = @key_hash
   AND deleted IS FALSE;
+
+-- name: ListOTELForwardingDestinations :many
+SELECT *
+FROM otel_forwarding_destinations
+WHERE organization_id = @organization_id
+  AND deleted IS FALSE
+ORDER BY created_at ASC, id ASC;
+
+-- name: GetOTELForwardingDestinationForUpdate :one
+SELECT *
+FROM otel_forwarding_destinations
+WHERE id = @id
+  AND organization_id = @organization_id
+  AND deleted IS FALSE
+FOR UPDATE;
+
+-- name: CountOTELForwardingDestinations :one
+SELECT COUNT(*)
+FROM otel_forwarding_destinations
+WHERE organization_id = @organization_id
+  AND deleted IS FALSE;
+
+-- name: CreateOTELForwardingDestination :one
+INSERT INTO otel_forwarding_destinations (
+    organization_id
+  , name
+  , endpoint_url
+  , protocol
+  , headers_encrypted
+  , enabled
+  , sampling_ratio
+  , filters
+  , scrub_rules
+) VALUES (
+    @organization_id
+  , @name
+  , @endpoint_url
+  , @protocol
+  , @headers_encrypted
+  , @enabled
+  , @sampling_ratio
+  , @filters
+  , @scrub_rules
+)
+RETURNING *;
+
+-- name: UpdateOTELForwardingDestination :one
+UPDATE otel_forwarding_destinations
+SET name = @name
+  , endpoint_url = @endpoint_url
+  , protocol = @protocol
+  , headers_encrypted = @headers_encrypted
+  , enabled = @enabled
+  , sampling_ratio = @sampling_ratio
+  , filters = @filters
+  , scrub_rules = @scrub_rules
+  , updated_at = clock_timestamp()
+WHERE id = @id
+  AND organization_id = @organization_id
+  AND deleted IS FALSE
+RETURNING *;
+
+-- name: SoftDeleteOTELForwardingDestination :exec
+UPDATE otel_forwarding_destinations
+SET deleted_at = clock_timestamp()
+WHERE id = @id
+  AND organization_id = @organization_id
+  AND deleted IS FALSE;
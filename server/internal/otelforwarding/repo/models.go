@@ -21,3 +21,20 @@ type OtelForwardingConfig struct {
 	ID               uuid.UUID
 	Deleted          bool
 }
+
+type OtelForwardingDestination struct {
+	ID               uuid.UUID
+	OrganizationID   string
+	Name             string
+	EndpointUrl      string
+	Protocol         string
+	HeadersEncrypted pgtype.Text
+	Enabled          bool
+	SamplingRatio    float64
+	Filters          []byte
+	ScrubRules       []byte
+	CreatedAt        pgtype.Timestamptz
+	UpdatedAt        pgtype.Timestamptz
+	DeletedAt        pgtype.Timestamptz
+	Deleted          bool
+}
@@ -8,9 +8,93 @@ package repo
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countOTELForwardingDestinations = `-- name: CountOTELForwardingDestinations :one
+SELECT COUNT(*)
+FROM otel_forwarding_destinations
+WHERE organization_id = $1
+  AND deleted IS FALSE
+`
+
+func (q *Queries) CountOTELForwardingDestinations(ctx context.Context, organizationID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countOTELForwardingDestinations, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createOTELForwardingDestination = `-- name: CreateOTELForwardingDestination :one
+INSERT INTO otel_forwarding_destinations (
+    organization_id
+  , name
+  , endpoint_url
+  , protocol
+  , headers_encrypted
+  , enabled
+  , sampling_ratio
+  , filters
+  , scrub_rules
+) VALUES (
+    $1
+  , $2
+  , $3
+  , $4
+  , $5
+  , $6
+  , $7
+  , $8
+  , $9
+)
+RETURNING id, organization_id, name, endpoint_url, protocol, headers_encrypted, enabled, sampling_ratio, filters, scrub_rules, created_at, updated_at, deleted_at, deleted
+`
+
+type CreateOTELForwardingDestinationParams struct {
+	OrganizationID   string
+	Name             string
+	EndpointUrl      string
+	Protocol         string
+	HeadersEncrypted pgtype.Text
+	Enabled          bool
+	SamplingRatio    float64
+	Filters          []byte
+	ScrubRules       []byte
+}
+
+func (q *Queries) CreateOTELForwardingDestination(ctx context.Context, arg CreateOTELForwardingDestinationParams) (OtelForwardingDestination, error) {
+	row := q.db.QueryRow(ctx, createOTELForwardingDestination,
+		arg.OrganizationID,
+		arg.Name,
+		arg.EndpointUrl,
+		arg.Protocol,
+		arg.HeadersEncrypted,
+		arg.Enabled,
+		arg.SamplingRatio,
+		arg.Filters,
+		arg.ScrubRules,
+	)
+	var i OtelForwardingDestination
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.EndpointUrl,
+		&i.Protocol,
+		&i.HeadersEncrypted,
+		&i.Enabled,
+		&i.SamplingRatio,
+		&i.Filters,
+		&i.ScrubRules,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Deleted,
+	)
+	return i, err
+}
+
 const getAPIKeyOrgByKeyHash = `-- name: GetAPIKeyOrgByKeyHash :one
 SELECT organization_id
 FROM api_keys
@@ -27,6 +111,42 @@ func (q *Queries) GetAPIKeyOrgByKeyHash(ctx context.Context, keyHash string) (st
 	return organization_id, err
 }
 
+const getOTELForwardingDestinationForUpdate = `-- name: GetOTELForwardingDestinationForUpdate :one
+SELECT id, organization_id, name, endpoint_url, protocol, headers_encrypted, enabled, sampling_ratio, filters, scrub_rules, created_at, updated_at, deleted_at, deleted
+FROM otel_forwarding_destinations
+WHERE id = $1
+  AND organization_id = $2
+  AND deleted IS FALSE
+FOR UPDATE
+`
+
+type GetOTELForwardingDestinationForUpdateParams struct {
+	ID             uuid.UUID
+	OrganizationID string
+}
+
+func (q *Queries) GetOTELForwardingDestinationForUpdate(ctx context.Context, arg GetOTELForwardingDestinationForUpdateParams) (OtelForwardingDestination, error) {
+	row := q.db.QueryRow(ctx, getOTELForwardingDestinationForUpdate, arg.ID, arg.OrganizationID)
+	var i OtelForwardingDestination
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.EndpointUrl,
+		&i.Protocol,
+		&i.HeadersEncrypted,
+		&i.Enabled,
+		&i.SamplingRatio,
+		&i.Filters,
+		&i.ScrubRules,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Deleted,
+	)
+	return i, err
+}
+
 const getOrgOTELForwardingConfig = `-- name: GetOrgOTELForwardingConfig :one
 SELECT created_at, deleted_at, updated_at, endpoint_url, headers_encrypted, organization_id, project_id, enabled, id, deleted
 FROM otel_forwarding_configs
@@ -80,6 +200,67 @@ func (q *Queries) GetOrgOTELForwardingConfigForUpdate(ctx context.Context, organ
 	return i, err
 }
 
+const listOTELForwardingDestinations = `-- name: ListOTELForwardingDestinations :many
+SELECT id, organization_id, name, endpoint_url, protocol, headers_encrypted, enabled, sampling_ratio, filters, scrub_rules, created_at, updated_at, deleted_at, deleted
+FROM otel_forwarding_destinations
+WHERE organization_id = $1
+  AND deleted IS FALSE
+ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) ListOTELForwardingDestinations(ctx context.Context, organizationID string) ([]OtelForwardingDestination, error) {
+	rows, err := q.db.Query(ctx, listOTELForwardingDestinations, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OtelForwardingDestination
+	for rows.Next() {
+		var i OtelForwardingDestination
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.EndpointUrl,
+			&i.Protocol,
+			&i.HeadersEncrypted,
+			&i.Enabled,
+			&i.SamplingRatio,
+			&i.Filters,
+			&i.ScrubRules,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Deleted,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const softDeleteOTELForwardingDestination = `-- name: SoftDeleteOTELForwardingDestination :exec
+UPDATE otel_forwarding_destinations
+SET deleted_at = clock_timestamp()
+WHERE id = $1
+  AND organization_id = $2
+  AND deleted IS FALSE
+`
+
+type SoftDeleteOTELForwardingDestinationParams struct {
+	ID             uuid.UUID
+	OrganizationID string
+}
+
+func (q *Queries) SoftDeleteOTELForwardingDestination(ctx context.Context, arg SoftDeleteOTELForwardingDestinationParams) error {
+	_, err := q.db.Exec(ctx, softDeleteOTELForwardingDestination, arg.ID, arg.OrganizationID)
+	return err
+}
+
 const softDeleteOrgOTELForwardingConfig = `-- name: SoftDeleteOrgOTELForwardingConfig :exec
 UPDATE otel_forwarding_configs
 SET deleted_at = clock_timestamp()
@@ -93,6 +274,69 @@ func (q *Queries) SoftDeleteOrgOTELForwardingConfig(ctx context.Context, organiz
 	return err
 }
 
+const updateOTELForwardingDestination = `-- name: UpdateOTELForwardingDestination :one
+UPDATE otel_forwarding_destinations
+SET name = $1
+  , endpoint_url = $2
+  , protocol = $3
+  , headers_encrypted = $4
+  , enabled = $5
+  , sampling_ratio = $6
+  , filters = $7
+  , scrub_rules = $8
+  , updated_at = clock_timestamp()
+WHERE id = $9
+  AND organization_id = $10
+  AND deleted IS FALSE
+RETURNING id, organization_id, name, endpoint_url, protocol, headers_encrypted, enabled, sampling_ratio, filters, scrub_rules, created_at, updated_at, deleted_at, deleted
+`
+
+type UpdateOTELForwardingDestinationParams struct {
+	Name             string
+	EndpointUrl      string
+	Protocol         string
+	HeadersEncrypted pgtype.Text
+	Enabled          bool
+	SamplingRatio    float64
+	Filters          []byte
+	ScrubRules       []byte
+	ID               uuid.UUID
+	OrganizationID   string
+}
+
+func (q *Queries) UpdateOTELForwardingDestination(ctx context.Context, arg UpdateOTELForwardingDestinationParams) (OtelForwardingDestination, error) {
+	row := q.db.QueryRow(ctx, updateOTELForwardingDestination,
+		arg.Name,
+		arg.EndpointUrl,
+		arg.Protocol,
+		arg.HeadersEncrypted,
+		arg.Enabled,
+		arg.SamplingRatio,
+		arg.Filters,
+		arg.ScrubRules,
+		arg.ID,
+		arg.OrganizationID,
+	)
+	var i OtelForwardingDestination
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.EndpointUrl,
+		&i.Protocol,
+		&i.HeadersEncrypted,
+		&i.Enabled,
+		&i.SamplingRatio,
+		&i.Filters,
+		&i.ScrubRules,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Deleted,
+	)
+	return i, err
+}
+
 const upsertOrgOTELForwardingConfig = `-- name: UpsertOrgOTELForwardingConfig :one
 INSERT INTO otel_forwarding_configs (
     organization_id
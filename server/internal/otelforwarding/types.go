@@ -6,14 +6,16 @@ import (
 
 const cacheTTL = 30 * time.Second
 
-// CachedConfig holds the decrypted forwarding config for one org.
-// An empty struct (URL == "") means "no config" — cached to avoid hitting
+// CachedConfig holds the decrypted forwarding config for one org: the
+// org-wide verbatim config plus any additional destinations. An empty struct
+// (URL == "", no Destinations) means "no config" — cached to avoid hitting
 // the DB on every OTEL request for orgs that haven't configured forwarding.
 type CachedConfig struct {
 	OrganizationID string            `json:"organization_id"`
 	URL            string            `json:"url"`
 	Headers        map[string]string `json:"headers"`
 	Enabled        bool              `json:"enabled"`
+	Destinations   []Destination     `json:"destinations"`
 }
 
 func (c CachedConfig) CacheKey() string {
@@ -24,13 +26,26 @@ func (c CachedConfig) TTL() time.Duration {
 	return cacheTTL
 }
 
-// IsConfigured reports whether the org has a non-deleted forwarding config.
-// Note: a configured-but-disabled config still returns true here; callers
-// check Enabled separately.
+// IsConfigured reports whether the org has a non-deleted org-wide
+// forwarding config. Note: a configured-but-disabled config still returns
+// true here; callers check Enabled separately. Destinations are tracked on
+// their own and do not count.
 func (c CachedConfig) IsConfigured() bool {
 	return c.URL != ""
 }
 
+// EnabledDestinations returns the destinations currently accepting
+// payloads.
+func (c CachedConfig) EnabledDestinations() []Destination {
+	var enabled []Destination
+	for _, d := range c.Destinations {
+		if d.Enabled {
+			enabled = append(enabled, d)
+		}
+	}
+	return enabled
+}
+
 // emptyCachedConfig returns an exhaustruct-friendly zero value scoped to a
 // specific org. Used as the cache sentinel for orgs with no configured
 // forwarding and as the return value on error paths.
@@ -40,5 +55,6 @@ func emptyCachedConfig(orgID string) CachedConfig {
 		URL:            "",
 		Headers:        nil,
 		Enabled:        false,
+		Destinations:   nil,
 	}
 }
@@ -23,6 +23,7 @@ var (
 	AccessRoleV1                           = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.access_role_event_v1", "Emitted when changes to roles are made")
 	APIKeyV1                               = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.api_key_event_v1", "Emitted when changes to API keys are made")
 	AssetV1                                = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.asset_event_v1", "Emitted when changes to assets are made")
+	AuditLogExportV1                       = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.audit_log_export_event_v1", "Emitted when changes to audit log export configs are made")
 	AwsIamCredentialV1                     = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.aws_iam_credential_event_v1", "Emitted when changes to AWS IAM external credentials are made")
 	AwsKmsKeyV1                            = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.aws_kms_key_event_v1", "Emitted when changes to AWS KMS external keys are made")
 	AssistantToolCallV1                    = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.assistant_tool_call_event_v1", "Emitted when an assistant executes a tool call")
@@ -48,6 +49,7 @@ var (
 	OpenRouterAPIKeyV1                     = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.openrouter_api_key_event_v1", "Emitted when changes to the organization's platform OpenRouter key are made")
 	OrganizationHooksFailOpenV1            = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.organization_hooks_fail_open_event_v1", "Emitted when the organization's hooks fail-open setting is toggled")
 	OrganizationBillingV1                  = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.organization_billing_event_v1", "Emitted when the organization's billing state changes")
+	OrganizationDataKeyV1                  = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.organization_data_key_event_v1", "Emitted when customer-managed encryption is enabled for the organization or its data key is shredded")
 	OrganizationDeviceAgentConfigurationV1 = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.organization_device_agent_configuration_event_v1", "Emitted when the organization's device-agent configuration is changed")
 	OrganizationEnterpriseTrialV1          = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.organization_enterprise_trial_event_v1", "Emitted when the organization's enterprise trial is armed, extended, demoted, or re-armed")
 	OrganizationInviteV1                   = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.organization_invite_event_v1", "Emitted when changes to organization invites are made")
@@ -72,8 +74,12 @@ var (
 	SkillEfficacySettingsV1                = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.skill_efficacy_settings_event_v1", "Emitted when skill efficacy sampling settings are changed")
 	SkillV1                                = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.skill_event_v1", "Emitted when changes to skills are made")
 	SpendRuleV1                            = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.spend_rule_event_v1", "Emitted when changes to spend rules are made")
+	TelemetryAlertRuleV1                   = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.telemetry_alert_rule_event_v1", "Emitted when changes to telemetry alert rules are made")
 	TemplateV1                             = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.template_event_v1", "Emitted when changes to prompt templates are made")
+	ToolConstraintV1                       = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.tool_constraint_event_v1", "Emitted when changes to tool argument constraints are made")
+	ToolApprovalPolicyV1                   = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.tool_approval_policy_event_v1", "Emitted when changes to tool approval policies are made")
 	ToolsetV1                              = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.toolset_event_v1", "Emitted when changes to toolsets used by MCP servers are made")
+	ToolRateLimitV1                        = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.tool_rate_limit_event_v1", "Emitted when changes to tool-call rate limits are made")
 	TunneledMcpServerV1                    = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.tunneled_mcp_server_event_v1", "Emitted when changes to tunneled MCP servers are made")
 	TriggerInstanceV1                      = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.trigger_instance_event_v1", "Emitted when changes to assistant triggers are made")
 	UserSessionClientV1                    = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.user_session_client_event_v1", "Emitted when changes to user session clients are made")
@@ -82,6 +88,7 @@ var (
 	UserSessionIssuerV1                    = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.user_session_issuer_event_v1", "Emitted when changes to user session issuers are made")
 	UserSessionIssuerCimdClientV1          = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.user_session_issuer_cimd_client_event_v1", "Emitted when the CIMD client URLs a user session issuer admits are changed")
 	VariationV1                            = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.variation_event_v1", "Emitted when changes to tool names and other properties are made")
+	WebhookEndpointV1                      = outbox.NewEventDef[AuditLogCreatedPayloadV1]("audit_log.webhook_endpoint_event_v1", "Emitted when changes to self-hosted webhook endpoints are made")
 )
 
 // AuditLogCreatedPayloadV1 is the webhook payload for audit_log.created events.
@@ -17,12 +17,16 @@ var All = []outbox.EventRegistration{
 	AssistantToolCallV1,
 	AssistantWakeV1,
 	AuditLogCreated,
+	AuditLogExportV1,
 	AwsIamCredentialV1,
 	AwsKmsKeyV1,
 	BillingMetadataV1,
 	ChatAnalysisSettingsV1,
 	ChatSessionV1,
 	CustomDomainV1,
+	DeploymentCreatedV1,
+	DeploymentFailedV1,
+	DeploymentSucceededV1,
 	DeploymentV1,
 	DeviceIntegrationV1,
 	EnvironmentV1,
@@ -31,6 +35,8 @@ var All = []outbox.EventRegistration{
 	JsonWebKeySetV1,
 	JsonWebKeyV1,
 	LiteLLMInstanceV1,
+	McpApprovalRequestDecidedV1,
+	McpApprovalRequestRequestedV1,
 	McpApprovalRequestV1,
 	McpCollectionV1,
 	McpEndpointV1,
@@ -39,6 +45,7 @@ var All = []outbox.EventRegistration{
 	ModelProviderKeyV1,
 	OpenRouterAPIKeyV1,
 	OrganizationBillingV1,
+	OrganizationDataKeyV1,
 	OrganizationDeviceAgentConfigurationV1,
 	OrganizationEnterpriseTrialV1,
 	OrganizationHooksFailOpenV1,
@@ -51,6 +58,7 @@ var All = []outbox.EventRegistration{
 	RemoteMcpServerHeaderV1,
 	RemoteMcpServerV1,
 	RemoteSessionClientV1,
+	RemoteSessionExpiredV1,
 	RemoteSessionIssuerV1,
 	RemoteSessionV1,
 	RiskExclusionV1,
@@ -62,8 +70,18 @@ var All = []outbox.EventRegistration{
 	ShadowMCPApprovalV1,
 	SkillEfficacySettingsV1,
 	SkillV1,
+	SpendRuleBlockedV1,
+	SpendRuleFlaggedV1,
 	SpendRuleV1,
+	SpendRuleWarnedV1,
+	TelemetryAlertFiringV1,
+	TelemetryAlertResolvedV1,
+	TelemetryAlertRuleV1,
 	TemplateV1,
+	ToolApprovalPolicyV1,
+	ToolCallErrorRateSpikeV1,
+	ToolConstraintV1,
+	ToolRateLimitV1,
 	ToolsetV1,
 	TriggerInstanceV1,
 	TunneledMcpServerV1,
@@ -74,4 +92,5 @@ var All = []outbox.EventRegistration{
 	UserSessionIssuerV1,
 	UserSessionV1,
 	VariationV1,
+	WebhookEndpointV1,
 }
@@ -0,0 +1,72 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+)
+
+var (
+	DeploymentCreatedV1 = outbox.NewEventDef[DeploymentPayloadV1](
+		"deployment.created_v1",
+		"A deployment was created and is waiting to be processed",
+	)
+	DeploymentSucceededV1 = outbox.NewEventDef[DeploymentPayloadV1](
+		"deployment.succeeded_v1",
+		"A deployment finished processing and its tools are available",
+	)
+	DeploymentFailedV1 = outbox.NewEventDef[DeploymentFailedPayloadV1](
+		"deployment.failed_v1",
+		"A deployment failed to process; the payload summarizes the errors it logged",
+	)
+)
+
+// DeploymentPayloadV1 describes a deployment at a lifecycle transition.
+type DeploymentPayloadV1 struct {
+	ID             uuid.UUID     `json:"id"`
+	OrganizationID string        `json:"organization_id"`
+	ProjectID      uuid.UUID     `json:"project_id"`
+	Status         string        `json:"status"`
+	UserID         string        `json:"user_id"`
+	ClonedFrom     uuid.NullUUID `json:"cloned_from,omitzero"`
+	GithubRepo     string        `json:"github_repo,omitzero"`
+	GithubPr       string        `json:"github_pr,omitzero"`
+	GithubSha      string        `json:"github_sha,omitzero"`
+	ExternalID     string        `json:"external_id,omitzero"`
+	ExternalURL    string        `json:"external_url,omitzero"`
+	CreatedAt      time.Time     `json:"created_at"`
+}
+
+// DeploymentFailedPayloadV1 is DeploymentPayloadV1 plus a summary of the
+// errors the deployment logged, so a consumer can report the failure without
+// paging through the deployment's logs.
+type DeploymentFailedPayloadV1 struct {
+	ID             uuid.UUID              `json:"id"`
+	OrganizationID string                 `json:"organization_id"`
+	ProjectID      uuid.UUID              `json:"project_id"`
+	Status         string                 `json:"status"`
+	UserID         string                 `json:"user_id"`
+	ClonedFrom     uuid.NullUUID          `json:"cloned_from,omitzero"`
+	GithubRepo     string                 `json:"github_repo,omitzero"`
+	GithubPr       string                 `json:"github_pr,omitzero"`
+	GithubSha      string                 `json:"github_sha,omitzero"`
+	ExternalID     string                 `json:"external_id,omitzero"`
+	ExternalURL    string                 `json:"external_url,omitzero"`
+	CreatedAt      time.Time              `json:"created_at"`
+	LogSummary     DeploymentLogSummaryV1 `json:"log_summary"`
+}
+
+// DeploymentLogSummaryV1 carries the first error entries a failed deployment
+// logged. ErrorCount is the total, which may exceed len(Errors).
+type DeploymentLogSummaryV1 struct {
+	ErrorCount int64                  `json:"error_count"`
+	Errors     []DeploymentLogEntryV1 `json:"errors"`
+}
+
+type DeploymentLogEntryV1 struct {
+	Event     string    `json:"event"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"created_at"`
+}
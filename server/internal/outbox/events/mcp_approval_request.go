@@ -0,0 +1,50 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+)
+
+var (
+	McpApprovalRequestRequestedV1 = outbox.NewEventDef[McpApprovalRequestRequestedPayloadV1](
+		"mcp_approval_request.requested_v1",
+		"Someone asked for an MCP server to be approved, or asked again for one already under review",
+	)
+	McpApprovalRequestDecidedV1 = outbox.NewEventDef[McpApprovalRequestDecidedPayloadV1](
+		"mcp_approval_request.decided_v1",
+		"An MCP approval request was approved or denied",
+	)
+)
+
+type McpApprovalRequestRequestedPayloadV1 struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID string    `json:"organization_id"`
+	ProjectID      uuid.UUID `json:"project_id"`
+	// Target is the stored, redacted form of the requested server reference.
+	Target     string `json:"target"`
+	TargetKind string `json:"target_kind"`
+	// RequesterID is absent when the ask could not be attributed to a user.
+	RequesterID    string    `json:"requester_id,omitzero"`
+	RequesterEmail string    `json:"requester_email,omitzero"`
+	Note           string    `json:"note,omitzero"`
+	RequestedAt    time.Time `json:"requested_at"`
+}
+
+type McpApprovalRequestDecidedPayloadV1 struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID string    `json:"organization_id"`
+	ProjectID      uuid.UUID `json:"project_id"`
+	Target         string    `json:"target"`
+	TargetKind     string    `json:"target_kind"`
+	// Decision is "approved" or "denied".
+	Decision  string `json:"decision"`
+	DecidedBy string `json:"decided_by"`
+	Rationale string `json:"rationale"`
+	// GrantedPrincipalURNs names who an approval grants access to. Empty for
+	// a denial.
+	GrantedPrincipalURNs []string  `json:"granted_principal_urns"`
+	DecidedAt            time.Time `json:"decided_at"`
+}
@@ -0,0 +1,29 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+)
+
+var RemoteSessionExpiredV1 = outbox.NewEventDef[RemoteSessionExpiredPayloadV1](
+	"remote_session.expired_v1",
+	"An upstream provider rejected a remote session's refresh grant; the subject must reconnect once the current access token lapses",
+)
+
+type RemoteSessionExpiredPayloadV1 struct {
+	ID                    uuid.UUID `json:"id"`
+	OrganizationID        string    `json:"organization_id"`
+	ProjectID             uuid.UUID `json:"project_id"`
+	SubjectURN            string    `json:"subject_urn"`
+	RemoteSessionClientID uuid.UUID `json:"remote_session_client_id"`
+	UserSessionIssuerID   uuid.UUID `json:"user_session_issuer_id"`
+	// Reason is the OAuth error the provider answered the refresh with.
+	Reason string `json:"reason"`
+	// AccessExpiresAt is when the still-held access token stops working.
+	// Absent when the provider never reported an expiry.
+	AccessExpiresAt time.Time `json:"access_expires_at,omitzero"`
+	ExpiredAt       time.Time `json:"expired_at"`
+}
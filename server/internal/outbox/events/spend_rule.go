@@ -0,0 +1,43 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+)
+
+// Spend rule transitions fire once per actor, rule and window: the first
+// evaluation that finds the actor past a threshold emits the event, and later
+// evaluations in the same window stay quiet.
+var (
+	SpendRuleWarnedV1 = outbox.NewEventDef[SpendRuleTransitionPayloadV1](
+		"spend_rule.warned_v1",
+		"A user's spend crossed a spend rule's warning threshold for the current window",
+	)
+	SpendRuleFlaggedV1 = outbox.NewEventDef[SpendRuleTransitionPayloadV1](
+		"spend_rule.flagged_v1",
+		"A user breached a flag-action spend rule for the current window",
+	)
+	SpendRuleBlockedV1 = outbox.NewEventDef[SpendRuleTransitionPayloadV1](
+		"spend_rule.blocked_v1",
+		"A user breached a block-action spend rule and is blocked until the window resets",
+	)
+)
+
+type SpendRuleTransitionPayloadV1 struct {
+	OrganizationID string    `json:"organization_id"`
+	SpendRuleID    uuid.UUID `json:"spend_rule_id"`
+	RuleURN        string    `json:"rule_urn"`
+	RuleName       string    `json:"rule_name"`
+	Action         string    `json:"action"`
+	UserID         string    `json:"user_id,omitzero"`
+	Email          string    `json:"email"`
+	SpendUSDCents  int64     `json:"spend_usd_cents"`
+	LimitUSDCents  int64     `json:"limit_usd_cents"`
+	WarnAtPct      int32     `json:"warn_at_pct"`
+	WindowKind     string    `json:"window_kind"`
+	WindowStart    time.Time `json:"window_start"`
+	WindowEnd      time.Time `json:"window_end"`
+}
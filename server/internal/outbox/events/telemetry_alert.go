@@ -0,0 +1,43 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+)
+
+// A telemetry alert rule fires once when its metric first breaches the
+// threshold and resolves once when it drops back. Both events of an episode
+// carry the same incident ID.
+var (
+	TelemetryAlertFiringV1 = outbox.NewEventDef[TelemetryAlertPayloadV1](
+		"telemetry_alert.firing_v1",
+		"A telemetry alert rule's tool error rate or latency crossed its threshold",
+	)
+	TelemetryAlertResolvedV1 = outbox.NewEventDef[TelemetryAlertPayloadV1](
+		"telemetry_alert.resolved_v1",
+		"A firing telemetry alert rule's tool error rate or latency is back within its threshold",
+	)
+)
+
+type TelemetryAlertPayloadV1 struct {
+	OrganizationID string    `json:"organization_id"`
+	ProjectID      uuid.UUID `json:"project_id"`
+	RuleID         uuid.UUID `json:"rule_id"`
+	RuleURN        string    `json:"rule_urn"`
+	RuleName       string    `json:"rule_name"`
+	IncidentID     uuid.UUID `json:"incident_id"`
+	ToolsetSlug    string    `json:"toolset_slug,omitzero"`
+	ToolURN        string    `json:"tool_urn,omitzero"`
+	Metric         string    `json:"metric"`
+	// Value and Threshold are a failure ratio between 0 and 1 for error_rate
+	// and milliseconds for the latency metrics.
+	Value       float64   `json:"value"`
+	Threshold   float64   `json:"threshold"`
+	Calls       uint64    `json:"calls"`
+	Failures    uint64    `json:"failures"`
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+}
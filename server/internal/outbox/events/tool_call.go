@@ -0,0 +1,31 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+)
+
+var ToolCallErrorRateSpikeV1 = outbox.NewEventDef[ToolCallErrorRateSpikePayloadV1](
+	"tool_call.error_rate_spike_v1",
+	"A tool's failure rate over the last few minutes rose sharply above its daily baseline",
+)
+
+type ToolCallErrorRateSpikePayloadV1 struct {
+	OrganizationID string    `json:"organization_id"`
+	ProjectID      uuid.UUID `json:"project_id"`
+	ToolURN        string    `json:"tool_urn"`
+	// The recent window is the span tested for the spike; the baseline window
+	// ends at the same time and covers the preceding day.
+	WindowStart         time.Time `json:"window_start"`
+	WindowEnd           time.Time `json:"window_end"`
+	RecentCalls         uint64    `json:"recent_calls"`
+	RecentFailures      uint64    `json:"recent_failures"`
+	RecentFailureRate   float64   `json:"recent_failure_rate"`
+	BaselineStart       time.Time `json:"baseline_start"`
+	BaselineCalls       uint64    `json:"baseline_calls"`
+	BaselineFailureRate float64   `json:"baseline_failure_rate"`
+	DetectedAt          time.Time `json:"detected_at"`
+}
@@ -13,12 +13,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/attr"
 )
 
-func StartPublisher(ctx context.Context, logger *slog.Logger, broker gcp.PublisherBroker) error {
-	pub, err := gcp.PubSubPublisherForMessage(ctx, broker, &pingv2.Message{})
-	if err != nil {
-		return fmt.Errorf("get publisher for ping messages: %w", err)
-	}
-
+func StartPublisher(ctx context.Context, logger *slog.Logger, pub gcp.Publisher[*pingv2.Message]) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -104,6 +104,7 @@ func (s *RemoteMCPProvisioningService) ProvisionDashboardRemoteMCP(ctx context.C
 		ToolsetID:             uuid.NullUUID{UUID: uuid.Nil, Valid: false},
 		UnproxiedMCPServerID:  uuid.NullUUID{UUID: uuid.Nil, Valid: false},
 		ToolVariationsGroupID: uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		ToolsetVersion:        pgtype.Int8{Int64: 0, Valid: false},
 	})
 	if err != nil {
 		return RemoteMCPProvisioningResult{}, oops.E(oops.CodeUnexpected, err, "materialize remote-backed MCP server")
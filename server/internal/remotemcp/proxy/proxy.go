@@ -1452,7 +1452,8 @@ func (p *Proxy) runUserRequestInterceptors(ctx context.Context, req *UserRequest
 // envelope; an invalid id (notification rejection) leads to omitting the
 // "id" field and using HTTP 400 per MCP § Streamable HTTP transport.
 // Otherwise the envelope is written with HTTP 200 — the JSON-RPC error is
-// the response.
+// the response. A cause implementing [RejectToolResulter] on a request with
+// a valid id is answered with an isError tool result instead.
 //
 // Always marks the span as failed with the original cause: a successful
 // rejection write is still a non-success outcome from the user's
@@ -1470,8 +1471,14 @@ func (p *Proxy) runUserRequestInterceptors(ctx context.Context, req *UserRequest
 // cause [oops.ErrHandle] to attempt a second WriteHeader on top of the
 // one this helper already issued.
 func (p *Proxy) writeRejection(ctx context.Context, w http.ResponseWriter, span trace.Span, id jsonrpc.ID, cause error) int64 {
-	rejectErr := RejectErrorFromCause(cause)
-	payload, err := marshalErrorResponse(id, rejectErr)
+	var payload []byte
+	var err error
+	var resulter RejectToolResulter
+	if id.IsValid() && errors.As(cause, &resulter) {
+		payload, err = marshalToolErrorResult(id, resulter.RejectToolResult())
+	} else {
+		payload, err = marshalErrorResponse(id, RejectErrorFromCause(cause))
+	}
 	if err != nil {
 		p.Logger.WarnContext(ctx, "failed to marshal jsonrpc rejection envelope; client will see no body",
 			attr.SlogError(err),
@@ -1487,6 +1494,13 @@ func (p *Proxy) writeRejection(ctx context.Context, w http.ResponseWriter, span
 		statusCode = http.StatusBadRequest
 	}
 
+	var headerer RejectHeaderer
+	if errors.As(cause, &headerer) {
+		for key, values := range headerer.RejectHeaders() {
+			w.Header()[key] = values
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
 	n, writeErr := w.Write(payload)
@@ -1543,6 +1543,106 @@ func TestProxy_Post_ToolsCallRequestInterceptor_RejectionWritesJSONRPCError(t *t
 	require.Contains(t, rr.Body.String(), "policy violation", "RejectError message must propagate to the envelope")
 }
 
+// headeredRejection is an interceptor error carrying HTTP response headers
+// through [proxy.RejectHeaderer], the way a rate-limit rejection does.
+type headeredRejection struct {
+	headers http.Header
+}
+
+func (e *headeredRejection) Error() string { return "rate limited" }
+
+func (e *headeredRejection) Unwrap() error {
+	return &proxy.RejectError{Code: proxy.RejectCodeRateLimited, Message: "rate limited", Data: map[string]any{"retry_after_seconds": 7}}
+}
+
+func (e *headeredRejection) RejectHeaders() http.Header { return e.headers }
+
+func TestProxy_Post_ToolsCallRequestInterceptor_RejectionCopiesHeaders(t *testing.T) {
+	t.Parallel()
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("upstream must not be called when a typed interceptor rejects tools/call")
+	}))
+	t.Cleanup(upstream.Close)
+
+	headers := http.Header{}
+	headers.Set("Retry-After", "7")
+	headers.Set("X-RateLimit-Limit", "10")
+
+	p := newProxyForTest(t, upstream.URL)
+	p.ToolsCallRequestInterceptors = []proxy.ToolsCallRequestInterceptor{
+		&mockToolsCallRequestInterceptor{name: "rate-limit", err: &headeredRejection{headers: headers}},
+	}
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/x/mcp/id", strings.NewReader(toolsCallRequest))
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	require.NoError(t, p.Post(rr, req))
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "7", rr.Header().Get("Retry-After"))
+	require.Equal(t, "10", rr.Header().Get("X-RateLimit-Limit"))
+	require.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	require.Contains(t, rr.Body.String(), `"code":-32004`)
+	require.Contains(t, rr.Body.String(), `"retry_after_seconds":7`)
+}
+
+// toolResultRejection is an interceptor error answered with an isError tool
+// result through [proxy.RejectToolResulter], the way an argument-constraint
+// violation is.
+type toolResultRejection struct {
+	text string
+}
+
+func (e *toolResultRejection) Error() string { return "constraint violated" }
+
+func (e *toolResultRejection) Unwrap() error {
+	return &proxy.RejectError{Code: proxy.RejectCodeInvalidParams, Message: e.text, Data: nil}
+}
+
+func (e *toolResultRejection) RejectToolResult() string { return e.text }
+
+func TestProxy_Post_ToolsCallRequestInterceptor_RejectionAsToolResult(t *testing.T) {
+	t.Parallel()
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		t.Error("upstream must not be called when a typed interceptor rejects tools/call")
+	}))
+	t.Cleanup(upstream.Close)
+
+	p := newProxyForTest(t, upstream.URL)
+	p.ToolsCallRequestInterceptors = []proxy.ToolsCallRequestInterceptor{
+		&mockToolsCallRequestInterceptor{name: "constraint", err: &toolResultRejection{text: "amount must be at most 500"}},
+	}
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/x/mcp/id", strings.NewReader(toolsCallRequest))
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	require.NoError(t, p.Post(rr, req))
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+	var resp struct {
+		ID     int             `json:"id"`
+		Error  json.RawMessage `json:"error"`
+		Result struct {
+			Content []struct {
+				Type string `json:"type"`
+				Text string `json:"text"`
+			} `json:"content"`
+			IsError bool `json:"isError"`
+		} `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Equal(t, 1, resp.ID, "result must preserve the originating tools/call request id")
+	require.Nil(t, resp.Error, "a tool-result rejection must not carry a JSON-RPC error")
+	require.True(t, resp.Result.IsError)
+	require.Len(t, resp.Result.Content, 1)
+	require.Equal(t, "text", resp.Result.Content[0].Type)
+	require.Equal(t, "amount must be at most 500", resp.Result.Content[0].Text)
+}
+
 func TestProxy_Post_ToolsCallRequestInterceptor_RunsAfterGeneric(t *testing.T) {
 	t.Parallel()
 
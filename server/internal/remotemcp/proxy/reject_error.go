@@ -3,6 +3,7 @@ package proxy
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/speakeasy-api/gram/server/internal/oops"
 )
@@ -29,6 +30,10 @@ const (
 	// RejectCodeHeaderMismatch reports a 2026-07-28 mirrored request header
 	// that disagrees with the JSON-RPC body it claims to describe.
 	RejectCodeHeaderMismatch = -32020
+
+	// RejectCodeRateLimited reports a call refused by a rate limit. Matches
+	// oops.MCPCodeRateLimited on the /mcp endpoint.
+	RejectCodeRateLimited = -32004
 )
 
 // RejectError is the typed rejection shape an interceptor can return when it
@@ -56,6 +61,26 @@ type RejectError struct {
 	Data any
 }
 
+// RejectHeaderer is implemented by interceptor errors that carry HTTP
+// response headers for the rejection, such as the X-RateLimit-* and
+// Retry-After headers of a rate-limited tools/call. The proxy finds it
+// anywhere in the error chain and copies the headers onto the rejection
+// response. Rejections substituted into an upstream SSE stream carry the
+// JSON-RPC error alone, since the response headers are already committed.
+type RejectHeaderer interface {
+	RejectHeaders() http.Header
+}
+
+// RejectToolResulter is implemented by tools/call rejections that answer the
+// call with a tool result flagged isError instead of a JSON-RPC error, such as
+// an argument-constraint violation the model should read and correct. The
+// proxy finds it anywhere in the error chain; RejectToolResult returns the
+// text content of the result. Rejections without a request id to answer fall
+// back to the JSON-RPC error envelope.
+type RejectToolResulter interface {
+	RejectToolResult() string
+}
+
 // Error implements [error]. The string form is intended for logs, not for
 // user surfaces — the user-facing string is RejectError.Message.
 func (e *RejectError) Error() string {
@@ -114,6 +139,8 @@ func rejectCodeForOops(code oops.Code) int {
 		return RejectCodeInvalidRequest
 	case oops.CodeInvalid:
 		return RejectCodeInvalidParams
+	case oops.CodeRateLimitExceeded:
+		return RejectCodeRateLimited
 	case oops.CodeUnexpected:
 		return RejectCodeInternalError
 	default:
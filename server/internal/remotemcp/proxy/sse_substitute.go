@@ -100,6 +100,26 @@ func marshalErrorResponse(id jsonrpc.ID, reject *RejectError) ([]byte, error) {
 	return bs, nil
 }
 
+// marshalToolErrorResult produces a tools/call result flagged isError whose
+// single text content block is text.
+func marshalToolErrorResult(id jsonrpc.ID, text string) ([]byte, error) {
+	payload := map[string]any{
+		"jsonrpc": "2.0",
+		"id":      id.Raw(),
+		"result": map[string]any{
+			"content": []map[string]any{
+				{"type": "text", "text": text},
+			},
+			"isError": true,
+		},
+	}
+	bs, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal tools/call error result: %w", err)
+	}
+	return bs, nil
+}
+
 // marshalLogNotification produces a "notifications/message" log
 // notification carrying the rejection reason. originalMethod is included
 // in the data payload so observers can see which message was suppressed.
@@ -19,12 +19,14 @@ import (
 	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
 	"github.com/speakeasy-api/gram/server/internal/thirdparty/posthog"
 	"github.com/speakeasy-api/gram/server/internal/toolcallobserver"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
 )
 
 // ProxyManager builds configured remote-MCP proxies wired up with the
-// MCP-aware interceptor stack: usage limits and tracking, per-tool RBAC,
-// argument scrubbing, ClickHouse logging, OTel counters, and PostHog event
-// capture.
+// MCP-aware interceptor stack: usage limits and tracking, tool-call rate
+// limits, per-tool RBAC, argument scrubbing, ClickHouse logging, OTel
+// counters, and PostHog event capture.
 //
 // One factory is constructed at server startup and reused across requests.
 // The interceptors that hold no per-request state (usage limits/tracking)
@@ -56,6 +58,14 @@ type ProxyManager struct {
 	resourcesReadUsageTrackingInterceptor *ResourcesReadUsageTrackingInterceptor
 	platformMCPSelectedUseRecorder        toolcallobserver.SuccessRecorder
 
+	// toolRateLimits enforces user-configured tool-call rate limits. Nil
+	// disables enforcement on the proxied surfaces.
+	toolRateLimits *toolratelimits.Enforcer
+
+	// toolConstraints enforces user-configured argument constraints. Nil
+	// disables enforcement on the proxied surfaces.
+	toolConstraints *toolconstraints.Enforcer
+
 	// witnessStore backs listing-witnessed live enforcement of live
 	// annotation grants: the list interceptor records the rows each session
 	// was shown, the call interceptor matches against them.
@@ -79,6 +89,8 @@ func NewProxyManager(
 	toolDispositions ToolDispositionResolver,
 	platformMCPSelectedUseRecorder toolcallobserver.SuccessRecorder,
 	witnessStore *toolfilter.SessionToolWitnessStore,
+	toolRateLimits *toolratelimits.Enforcer,
+	toolConstraints *toolconstraints.Enforcer,
 ) *ProxyManager {
 	logger = logger.With(attr.SlogComponent("remotemcp"))
 	meter := meterProvider.Meter("github.com/speakeasy-api/gram/server/internal/remotemcp")
@@ -99,6 +111,8 @@ func NewProxyManager(
 		resourcesReadUsageLimitsInterceptor:   NewResourcesReadUsageLimitsInterceptor(billingRepo, logger),
 		resourcesReadUsageTrackingInterceptor: NewResourcesReadUsageTrackingInterceptor(billingTracker, logger),
 		platformMCPSelectedUseRecorder:        platformMCPSelectedUseRecorder,
+		toolRateLimits:                        toolRateLimits,
+		toolConstraints:                       toolConstraints,
 		witnessStore:                          witnessStore,
 	}
 }
@@ -196,7 +210,9 @@ func (f *ProxyManager) BuildTarget(
 	toolsCallReqInterceptors := []proxy.ToolsCallRequestInterceptor{
 		NewToolsCallOTELCounterInterceptor(f.mcpMetrics, identity, logger),
 		f.toolsCallUsageLimitsInterceptor,
+		NewToolsCallRateLimitInterceptor(f.toolRateLimits, identity.McpServerID, projectID),
 		NewToolsCallStripToolsetIDInterceptor(logger),
+		NewToolsCallConstraintInterceptor(f.toolConstraints, identity.McpServerID, projectID),
 		clickHouseLogInterceptor,
 	}
 	if visibility == mcpservers.VisibilityPrivate {
@@ -0,0 +1,74 @@
+package remotemcp
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/remotemcp/proxy"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+)
+
+// NewToolsCallConstraintInterceptor constructs an interceptor that enforces
+// the user-configured argument constraints attached to the fronting MCP
+// server.
+//
+// A violation is answered with a tool result flagged isError explaining which
+// constraint the arguments broke, so the model can retry with conforming
+// arguments. Like the enforcer itself, the interceptor fails closed: a
+// constraint that cannot be checked rejects the call with a JSON-RPC error.
+func NewToolsCallConstraintInterceptor(enforcer *toolconstraints.Enforcer, mcpServerID string, projectID string) proxy.ToolsCallRequestInterceptor {
+	var check serverToolsCallCheck
+	if enforcer != nil {
+		check = func(ctx context.Context, projectID uuid.UUID, mcpServerID uuid.NullUUID, call *proxy.ToolsCallRequest, authCtx *contextvalues.AuthContext) error {
+			constraintCall := toolconstraints.Call{
+				OrganizationID: "",
+				ProjectID:      projectID,
+				ToolsetID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+				McpServerID:    mcpServerID,
+				ToolName:       call.Params.Name,
+				Arguments:      call.Params.Arguments,
+			}
+			if authCtx != nil {
+				constraintCall.OrganizationID = authCtx.ActiveOrganizationID
+			}
+
+			err := enforcer.Check(ctx, constraintCall)
+			if violation, ok := errors.AsType[*toolconstraints.ViolationError](err); ok {
+				return &toolsCallConstraintRejection{violation: violation}
+			}
+			return err
+		}
+	}
+
+	return newToolsCallServerEnforcerInterceptor("tools-call-constraint", mcpServerID, projectID, check)
+}
+
+// toolsCallConstraintRejection adapts a [toolconstraints.ViolationError] to
+// the proxy's rejection contract: it implements [proxy.RejectToolResulter] so
+// the call is answered with an isError tool result, and unwraps to a
+// [proxy.RejectError] for requests the proxy cannot answer with a result.
+type toolsCallConstraintRejection struct {
+	violation *toolconstraints.ViolationError
+}
+
+var _ proxy.RejectToolResulter = (*toolsCallConstraintRejection)(nil)
+
+func (r *toolsCallConstraintRejection) Error() string {
+	return r.violation.Error()
+}
+
+func (r *toolsCallConstraintRejection) Unwrap() error {
+	return &proxy.RejectError{
+		Code:    proxy.RejectCodeInvalidParams,
+		Message: r.violation.Message(),
+		Data:    nil,
+	}
+}
+
+// RejectToolResult implements [proxy.RejectToolResulter].
+func (r *toolsCallConstraintRejection) RejectToolResult() string {
+	return r.violation.Message()
+}
@@ -0,0 +1,78 @@
+package remotemcp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/remotemcp/proxy"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
+)
+
+// NewToolsCallRateLimitInterceptor constructs an interceptor that enforces the
+// user-configured tool-call rate limits attached to the fronting MCP server.
+//
+// A rejection surfaces as a JSON-RPC error with code
+// [proxy.RejectCodeRateLimited], retry hints in its data, and the
+// X-RateLimit-* and Retry-After headers on the HTTP response. Any other
+// enforcer error is returned as is, so the call fails or proceeds exactly as
+// the enforcer decided.
+func NewToolsCallRateLimitInterceptor(enforcer *toolratelimits.Enforcer, mcpServerID string, projectID string) proxy.ToolsCallRequestInterceptor {
+	var check serverToolsCallCheck
+	if enforcer != nil {
+		check = func(ctx context.Context, projectID uuid.UUID, mcpServerID uuid.NullUUID, call *proxy.ToolsCallRequest, authCtx *contextvalues.AuthContext) error {
+			rateLimitCall := toolratelimits.Call{
+				ProjectID:      projectID,
+				ToolsetID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+				McpServerID:    mcpServerID,
+				ToolName:       call.Params.Name,
+				UserID:         "",
+				ExternalUserID: "",
+				APIKeyID:       "",
+			}
+			if authCtx != nil {
+				rateLimitCall.UserID = authCtx.UserID
+				rateLimitCall.ExternalUserID = authCtx.ExternalUserID
+				rateLimitCall.APIKeyID = authCtx.APIKeyID
+			}
+
+			err := enforcer.Check(ctx, rateLimitCall)
+			if exceeded, ok := errors.AsType[*toolratelimits.ExceededError](err); ok {
+				return &toolsCallRateLimitRejection{exceeded: exceeded}
+			}
+			return err
+		}
+	}
+
+	return newToolsCallServerEnforcerInterceptor("tools-call-rate-limit", mcpServerID, projectID, check)
+}
+
+// toolsCallRateLimitRejection adapts an [toolratelimits.ExceededError] to the
+// proxy's rejection contract: it unwraps to a [proxy.RejectError] for the
+// JSON-RPC envelope and implements [proxy.RejectHeaderer] for the HTTP
+// headers.
+type toolsCallRateLimitRejection struct {
+	exceeded *toolratelimits.ExceededError
+}
+
+var _ proxy.RejectHeaderer = (*toolsCallRateLimitRejection)(nil)
+
+func (r *toolsCallRateLimitRejection) Error() string {
+	return r.exceeded.Error()
+}
+
+func (r *toolsCallRateLimitRejection) Unwrap() error {
+	return &proxy.RejectError{
+		Code:    proxy.RejectCodeRateLimited,
+		Message: r.exceeded.Message(),
+		Data:    r.exceeded.Data(),
+	}
+}
+
+// RejectHeaders implements [proxy.RejectHeaderer].
+func (r *toolsCallRateLimitRejection) RejectHeaders() http.Header {
+	return r.exceeded.Headers()
+}
@@ -0,0 +1,66 @@
+package remotemcp
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/remotemcp/proxy"
+)
+
+// serverToolsCallCheck enforces one user-configured policy on a tools/call
+// against the fronting MCP server. authCtx is nil for anonymous callers. A
+// returned error fails or rejects the call.
+type serverToolsCallCheck func(ctx context.Context, projectID uuid.UUID, mcpServerID uuid.NullUUID, call *proxy.ToolsCallRequest, authCtx *contextvalues.AuthContext) error
+
+// toolsCallServerEnforcerInterceptor runs a check that reads configuration
+// attached to the fronting MCP server, such as its rate limits or argument
+// constraints. It is a [proxy.ToolsCallRequestInterceptor] constructed per
+// [ProxyManager.BuildTarget] so it can close over the server identity and
+// project.
+type toolsCallServerEnforcerInterceptor struct {
+	name        string
+	projectID   uuid.UUID
+	mcpServerID uuid.NullUUID
+	check       serverToolsCallCheck
+}
+
+var _ proxy.ToolsCallRequestInterceptor = (*toolsCallServerEnforcerInterceptor)(nil)
+
+// newToolsCallServerEnforcerInterceptor constructs an interceptor for a single
+// proxied server. Unparseable ids or a nil check leave the interceptor inert
+// rather than failing the request.
+func newToolsCallServerEnforcerInterceptor(name string, mcpServerID string, projectID string, check serverToolsCallCheck) *toolsCallServerEnforcerInterceptor {
+	parsedProjectID, err := uuid.Parse(projectID)
+	if err != nil {
+		parsedProjectID = uuid.Nil
+	}
+	parsedServerID, err := uuid.Parse(mcpServerID)
+
+	return &toolsCallServerEnforcerInterceptor{
+		name:        name,
+		projectID:   parsedProjectID,
+		mcpServerID: uuid.NullUUID{UUID: parsedServerID, Valid: err == nil && parsedProjectID != uuid.Nil},
+		check:       check,
+	}
+}
+
+// Name implements [proxy.ToolsCallRequestInterceptor].
+func (i *toolsCallServerEnforcerInterceptor) Name() string {
+	return i.name
+}
+
+// InterceptToolsCallRequest implements [proxy.ToolsCallRequestInterceptor].
+func (i *toolsCallServerEnforcerInterceptor) InterceptToolsCallRequest(ctx context.Context, call *proxy.ToolsCallRequest) error {
+	if i.check == nil || !i.mcpServerID.Valid || call == nil || call.Params == nil {
+		return nil
+	}
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok {
+		authCtx = nil
+	}
+
+	return i.check(ctx, i.projectID, i.mcpServerID, call, authCtx)
+}
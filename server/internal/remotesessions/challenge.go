@@ -47,6 +47,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/cache"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
 	"github.com/speakeasy-api/gram/server/internal/guardian"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
 	"github.com/speakeasy-api/gram/server/internal/oops"
@@ -135,6 +136,7 @@ type ChallengeManager struct {
 	logger    *slog.Logger
 	db        *pgxpool.Pool
 	enc       *encryption.Client
+	tokens    tokenKeys
 	policy    *guardian.Policy
 	cache     cache.TypedCacheObject[RemoteLoginState]
 	locks     cache.Cache
@@ -162,6 +164,7 @@ func NewChallengeManager(
 	meterProvider metric.MeterProvider,
 	db *pgxpool.Pool,
 	enc *encryption.Client,
+	orgKeys *envelope.Keyring,
 	policy *guardian.Policy,
 	cacheImpl cache.Cache,
 	serverURL *url.URL,
@@ -171,6 +174,7 @@ func NewChallengeManager(
 		logger: logger,
 		db:     db,
 		enc:    enc,
+		tokens: tokenKeys{enc: enc, orgKeys: orgKeys},
 		policy: policy,
 		cache: cache.NewTypedObjectCache[RemoteLoginState](
 			logger.With(attr.SlogCacheNamespace("remote_login")),
@@ -178,9 +182,9 @@ func NewChallengeManager(
 			cache.SuffixNone,
 		),
 		locks:     cacheImpl,
-		refresher: NewRefreshService(logger, db, enc, policy, cacheImpl),
+		refresher: NewRefreshService(logger, db, enc, orgKeys, policy, cacheImpl),
 		serverURL: serverURL,
-		revoker:   NewUpstreamRevoker(logger, tracerProvider, meterProvider, db, enc, policy),
+		revoker:   NewUpstreamRevoker(logger, tracerProvider, meterProvider, db, enc, orgKeys, policy),
 		authorizeInterceptors: []interceptors.AuthorizeInterceptor{
 			interceptors.NewGoogle(logger),
 		},
@@ -631,13 +635,17 @@ func (m *ChallengeManager) HandleRemoteLoginCallback(w http.ResponseWriter, r *h
 		return oops.E(oops.CodeUnauthorized, err, "upstream token exchange failed").LogError(ctx, logger)
 	}
 
-	accessEnc, err := m.enc.Encrypt([]byte(tok.AccessToken))
+	sealer, err := m.tokens.sealer(ctx, state.ProjectID)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "resolve token encryption key").LogError(ctx, logger)
+	}
+	accessEnc, err := sealer.Encrypt([]byte(tok.AccessToken))
 	if err != nil {
 		return oops.E(oops.CodeUnexpected, err, "encrypt access token").LogError(ctx, logger)
 	}
 	var refreshEnc *string
 	if tok.RefreshToken != "" {
-		v, eerr := m.enc.Encrypt([]byte(tok.RefreshToken))
+		v, eerr := sealer.Encrypt([]byte(tok.RefreshToken))
 		if eerr != nil {
 			return oops.E(oops.CodeUnexpected, eerr, "encrypt refresh token").LogError(ctx, logger)
 		}
@@ -68,6 +68,7 @@ func TestBuildAuthorizationUrl_AudienceResolution(t *testing.T) {
 				testenv.NewMeterProvider(t),
 				ti.conn,
 				enc,
+				nil,
 				policy,
 				cache.NoopCache,
 				mustURL(t, "http://localhost"),
@@ -67,6 +67,7 @@ func setupResourceDanceFixture(t *testing.T, resource string, slugSuffix string,
 		testenv.NewMeterProvider(t),
 		ti.conn,
 		enc,
+		nil,
 		policy,
 		ti.redisCache,
 		mustURL(t, "http://localhost"),
@@ -69,6 +69,7 @@ func TestBuildAuthorizationUrl_ScopeResolution(t *testing.T) {
 				testenv.NewMeterProvider(t),
 				ti.conn,
 				enc,
+				nil,
 				policy,
 				cache.NoopCache,
 				mustURL(t, "http://localhost"),
@@ -165,6 +166,7 @@ func TestBuildAuthorizationUrl_OrgLevelIssuer(t *testing.T) {
 		testenv.NewMeterProvider(t),
 		ti.conn,
 		enc,
+		nil,
 		policy,
 		cache.NoopCache,
 		mustURL(t, "http://localhost"),
@@ -93,6 +93,7 @@ func newTestUpstreamRevoker(t *testing.T, ti *testInstance) *remotesessions.Upst
 		testenv.NewMeterProvider(t),
 		ti.conn,
 		testenv.NewEncryptionClient(t),
+		nil,
 		policy,
 	)
 }
@@ -533,6 +534,7 @@ func seedRefreshableSharedGrant(t *testing.T, slug string, handler http.HandlerF
 		testenv.NewMeterProvider(t),
 		ti.conn,
 		enc,
+		nil,
 		policy,
 		cache.NoopCache,
 		mustURL(t, "http://localhost"),
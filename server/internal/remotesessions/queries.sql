@@ -519,6 +519,15 @@ SELECT id
 FROM user_session_issuers
 WHERE id = @id AND project_id = @project_id AND deleted IS FALSE;
 
+-- name: GetUserSessionIssuerOwner :one
+-- Resolves the project and organization a session belongs to, for events that
+-- are routed by organization. Deleted issuers still resolve: a session can
+-- outlive the soft delete of its issuer.
+SELECT usi.project_id, p.organization_id
+FROM user_session_issuers AS usi
+JOIN projects AS p ON p.id = usi.project_id
+WHERE usi.id = @id;
+
 -- name: ListRemoteSessionClientsByProjectID :many
 SELECT
     sqlc.embed(c),
@@ -23,8 +23,11 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/cache"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
 	"github.com/speakeasy-api/gram/server/internal/guardian"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+	"github.com/speakeasy-api/gram/server/internal/outbox/events"
 	remotesessions_repo "github.com/speakeasy-api/gram/server/internal/remotesessions/repo"
 	"github.com/speakeasy-api/gram/server/internal/urn"
 )
@@ -61,16 +64,16 @@ type RefreshResult struct {
 type RefreshService struct {
 	logger *slog.Logger
 	db     *pgxpool.Pool
-	enc    *encryption.Client
+	tokens tokenKeys
 	policy *guardian.Policy
 	locks  cache.Cache
 }
 
-func NewRefreshService(logger *slog.Logger, db *pgxpool.Pool, enc *encryption.Client, policy *guardian.Policy, locks cache.Cache) *RefreshService {
+func NewRefreshService(logger *slog.Logger, db *pgxpool.Pool, enc *encryption.Client, orgKeys *envelope.Keyring, policy *guardian.Policy, locks cache.Cache) *RefreshService {
 	return &RefreshService{
 		logger: logger.With(attr.SlogComponent("remotesessions_refresh")),
 		db:     db,
-		enc:    enc,
+		tokens: tokenKeys{enc: enc, orgKeys: orgKeys},
 		policy: policy,
 		locks:  locks,
 	}
@@ -198,7 +201,7 @@ func (s *RefreshService) RefreshNow(ctx context.Context, sess remotesessions_rep
 
 	if current.UpdatedAt.Time.After(snapshotAt) {
 		if accessTokenUsable(current, time.Now()) {
-			plain, err := s.enc.Decrypt(current.AccessTokenEncrypted)
+			plain, err := s.tokens.decrypt(ctx, current.AccessTokenEncrypted)
 			if err != nil {
 				return zero, fmt.Errorf("decrypt concurrently refreshed access token: %w", err)
 			}
@@ -220,20 +223,14 @@ func (s *RefreshService) RefreshNow(ctx context.Context, sess remotesessions_rep
 		resource = fallbackResource
 	}
 
-	updated, accessToken, refreshErr := refreshSessionTokens(ctx, q, s.enc, s.policy, sess, resource)
+	updated, accessToken, refreshErr := refreshSessionTokens(ctx, q, s.tokens, s.policy, sess, resource)
 	if refreshErr == nil {
 		return RefreshResult{Session: updated, AccessToken: accessToken, Outcome: RefreshOutcomeRefreshed}, nil
 	}
 
 	var tokenRefreshErr *TokenRefreshError
 	if errors.As(refreshErr, &tokenRefreshErr) && tokenRefreshErr.invalidGrant() {
-		_, err := q.ClearRemoteSessionRefreshTokenAfterInvalidGrant(ctx, remotesessions_repo.ClearRemoteSessionRefreshTokenAfterInvalidGrantParams{
-			ID:                    sess.ID,
-			SubjectUrn:            sess.SubjectUrn,
-			UserSessionIssuerID:   sess.UserSessionIssuerID,
-			RemoteSessionClientID: sess.RemoteSessionClientID,
-			ExpectedUpdatedAt:     sess.UpdatedAt,
-		})
+		err := s.clearAfterInvalidGrant(ctx, sess)
 		switch {
 		case err == nil:
 			return zero, refreshErr
@@ -261,7 +258,7 @@ func (s *RefreshService) RefreshNow(ctx context.Context, sess remotesessions_rep
 	if !accessTokenUsable(latest, time.Now()) {
 		return zero, refreshErr
 	}
-	plain, err := s.enc.Decrypt(latest.AccessTokenEncrypted)
+	plain, err := s.tokens.decrypt(ctx, latest.AccessTokenEncrypted)
 	if err != nil {
 		return zero, refreshErr
 	}
@@ -269,6 +266,60 @@ func (s *RefreshService) RefreshNow(ctx context.Context, sess remotesessions_rep
 	return RefreshResult{Session: latest, AccessToken: plain, Outcome: RefreshOutcomeAdoptedConcurrentWinner}, nil
 }
 
+// clearAfterInvalidGrant drops the dead refresh grant and announces the
+// expiry in one transaction, so only the caller whose compare-and-swap wins
+// publishes remote_session.expired_v1. Returns pgx.ErrNoRows when the row
+// moved underneath the refresh.
+func (s *RefreshService) clearAfterInvalidGrant(ctx context.Context, sess remotesessions_repo.RemoteSession) error {
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	q := remotesessions_repo.New(dbtx)
+	cleared, err := q.ClearRemoteSessionRefreshTokenAfterInvalidGrant(ctx, remotesessions_repo.ClearRemoteSessionRefreshTokenAfterInvalidGrantParams{
+		ID:                    sess.ID,
+		SubjectUrn:            sess.SubjectUrn,
+		UserSessionIssuerID:   sess.UserSessionIssuerID,
+		RemoteSessionClientID: sess.RemoteSessionClientID,
+		ExpectedUpdatedAt:     sess.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("clear refresh token: %w", err)
+	}
+
+	owner, err := q.GetUserSessionIssuerOwner(ctx, cleared.UserSessionIssuerID)
+	if err != nil {
+		return fmt.Errorf("resolve session owner: %w", err)
+	}
+
+	var accessExpiresAt time.Time
+	if cleared.AccessExpiresAt.Valid {
+		accessExpiresAt = cleared.AccessExpiresAt.Time
+	}
+
+	if _, err := outbox.PublishWebhookEvent(ctx, dbtx, owner.OrganizationID, events.RemoteSessionExpiredV1, events.RemoteSessionExpiredPayloadV1{
+		ID:                    cleared.ID,
+		OrganizationID:        owner.OrganizationID,
+		ProjectID:             owner.ProjectID,
+		SubjectURN:            cleared.SubjectUrn.String(),
+		RemoteSessionClientID: cleared.RemoteSessionClientID,
+		UserSessionIssuerID:   cleared.UserSessionIssuerID,
+		Reason:                oauthErrInvalidGrant,
+		AccessExpiresAt:       accessExpiresAt,
+		ExpiredAt:             cleared.UpdatedAt.Time,
+	}); err != nil {
+		return fmt.Errorf("publish remote session expiry: %w", err)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 func accessTokenUsable(sess remotesessions_repo.RemoteSession, now time.Time) bool {
 	return authorizationUsable(sess, now) &&
 		(!sess.AccessExpiresAt.Valid || sess.AccessExpiresAt.Time.After(now))
@@ -322,7 +373,7 @@ func (s *RefreshService) awaitRefreshedSession(
 			return zero, false
 		}
 
-		plain, err := s.enc.Decrypt(latest.AccessTokenEncrypted)
+		plain, err := s.tokens.decrypt(ctx, latest.AccessTokenEncrypted)
 		if err != nil {
 			return zero, false
 		}
@@ -2342,6 +2342,28 @@ func (q *Queries) GetUserSessionIssuerForProject(ctx context.Context, arg GetUse
 	return id, err
 }
 
+const getUserSessionIssuerOwner = `-- name: GetUserSessionIssuerOwner :one
+SELECT usi.project_id, p.organization_id
+FROM user_session_issuers AS usi
+JOIN projects AS p ON p.id = usi.project_id
+WHERE usi.id = $1
+`
+
+type GetUserSessionIssuerOwnerRow struct {
+	ProjectID      uuid.UUID
+	OrganizationID string
+}
+
+// Resolves the project and organization a session belongs to, for events that
+// are routed by organization. Deleted issuers still resolve: a session can
+// outlive the soft delete of its issuer.
+func (q *Queries) GetUserSessionIssuerOwner(ctx context.Context, id uuid.UUID) (GetUserSessionIssuerOwnerRow, error) {
+	row := q.db.QueryRow(ctx, getUserSessionIssuerOwner, id)
+	var i GetUserSessionIssuerOwnerRow
+	err := row.Scan(&i.ProjectID, &i.OrganizationID)
+	return i, err
+}
+
 const listConflictingClientBindingsForIssuerMigration = `-- name: ListConflictingClientBindingsForIssuerMigration :many
 SELECT DISTINCT
     link_source.user_session_issuer_id AS user_session_issuer_id,
@@ -100,7 +100,7 @@ func newTestService(t *testing.T) (context.Context, *testInstance) {
 	ctx = authztest.InitAuthContext(t, ctx, conn, sessionManager)
 
 	enc := testenv.NewEncryptionClient(t)
-	envEntries := environments.NewEnvironmentEntries(logger, conn, enc, mcpmetadatarepo.New(conn))
+	envEntries := environments.NewEnvironmentEntries(logger, conn, enc, nil, nil, mcpmetadatarepo.New(conn))
 
 	serverURL, err := url.Parse(testServerURL)
 	require.NoError(t, err)
@@ -113,11 +113,12 @@ func newTestService(t *testing.T) (context.Context, *testInstance) {
 		sessionManager,
 		authz.NewEngine(logger, conn, authztest.ChallengeLoggingAlwaysDisabled, workos.NewStubClient()),
 		enc,
+		nil,
 		envEntries,
 		guardianPolicy,
 		audit.NewLogger(),
 		serverURL,
-		remotesessions.NewRefreshService(logger, conn, enc, guardianPolicy, redisCache),
+		remotesessions.NewRefreshService(logger, conn, enc, nil, guardianPolicy, redisCache),
 	)
 
 	return ctx, &testInstance{
@@ -0,0 +1,48 @@
+package remotesessions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
+)
+
+// tokenKeys encrypts the upstream tokens a remote session holds. They are
+// sealed under the owning organization's data key when it has one, and under
+// the application keyring otherwise. Client secrets are configuration rather
+// than a customer's credentials and stay on the application keyring.
+type tokenKeys struct {
+	enc *encryption.Client
+
+	// orgKeys is nil when customer-managed encryption is not configured.
+	orgKeys *envelope.Keyring
+}
+
+func (k tokenKeys) sealer(ctx context.Context, projectID uuid.UUID) (envelope.Sealer, error) {
+	if k.orgKeys == nil {
+		return k.enc, nil
+	}
+
+	sealer, err := k.orgKeys.SealerForProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve token encryption key: %w", err)
+	}
+	return sealer, nil
+}
+
+func (k tokenKeys) decrypt(ctx context.Context, ciphertext string) (string, error) {
+	var plaintext string
+	var err error
+	if k.orgKeys == nil {
+		plaintext, err = k.enc.Decrypt(ciphertext)
+	} else {
+		plaintext, err = k.orgKeys.Decrypt(ctx, ciphertext)
+	}
+	if err != nil {
+		return "", fmt.Errorf("decrypt: %w", err)
+	}
+	return plaintext, nil
+}
@@ -41,7 +41,6 @@ import (
 
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/conv"
-	"github.com/speakeasy-api/gram/server/internal/encryption"
 	"github.com/speakeasy-api/gram/server/internal/guardian"
 	"github.com/speakeasy-api/gram/server/internal/inv"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
@@ -369,7 +368,7 @@ func (m *ChallengeManager) validateAndRefresh(
 	hasRefresh := sess.RefreshTokenEncrypted.Valid && sess.RefreshTokenEncrypted.String != ""
 
 	if !sess.AccessExpiresAt.Valid || sess.AccessExpiresAt.Time.After(now) {
-		plain, err := m.enc.Decrypt(sess.AccessTokenEncrypted)
+		plain, err := m.tokens.decrypt(ctx, sess.AccessTokenEncrypted)
 		if err != nil {
 			return "", fmt.Errorf("decrypt access token: %w", err)
 		}
@@ -405,7 +404,7 @@ func (m *ChallengeManager) validateAndRefresh(
 func refreshSessionTokens(
 	ctx context.Context,
 	q *remotesessions_repo.Queries,
-	enc *encryption.Client,
+	tokens tokenKeys,
 	policy *guardian.Policy,
 	sess remotesessions_repo.RemoteSession,
 	resource string,
@@ -420,14 +419,14 @@ func refreshSessionTokens(
 		return zero, "", newTokenRefreshError("the identity provider has no token endpoint configured", nil)
 	}
 
-	refreshToken, err := enc.Decrypt(sess.RefreshTokenEncrypted.String)
+	refreshToken, err := tokens.decrypt(ctx, sess.RefreshTokenEncrypted.String)
 	if err != nil {
 		return zero, "", newTokenRefreshError("the session's stored refresh token could not be read; revoke and re-link the session", err)
 	}
 
 	var clientSecret string
 	if client.ClientSecretEncrypted.Valid {
-		clientSecret, err = enc.Decrypt(client.ClientSecretEncrypted.String)
+		clientSecret, err = tokens.enc.Decrypt(client.ClientSecretEncrypted.String)
 		if err != nil {
 			return zero, "", newTokenRefreshError("the client secret could not be read; check the issuer's configuration", err)
 		}
@@ -479,13 +478,22 @@ func refreshSessionTokens(
 		return zero, "", newTokenRefreshError("the identity provider returned no access token", nil)
 	}
 
-	accessEnc, err := enc.Encrypt([]byte(tok.AccessToken))
+	owner, err := q.GetUserSessionIssuerOwner(ctx, sess.UserSessionIssuerID)
+	if err != nil {
+		return zero, "", fmt.Errorf("load remote session owner: %w", err)
+	}
+	sealer, err := tokens.sealer(ctx, owner.ProjectID)
+	if err != nil {
+		return zero, "", err
+	}
+
+	accessEnc, err := sealer.Encrypt([]byte(tok.AccessToken))
 	if err != nil {
 		return zero, "", fmt.Errorf("encrypt new access token: %w", err)
 	}
 	newRefreshEnc := sess.RefreshTokenEncrypted
 	if tok.RefreshToken != "" {
-		v, eerr := enc.Encrypt([]byte(tok.RefreshToken))
+		v, eerr := sealer.Encrypt([]byte(tok.RefreshToken))
 		if eerr != nil {
 			return zero, "", fmt.Errorf("encrypt new refresh token: %w", eerr)
 		}
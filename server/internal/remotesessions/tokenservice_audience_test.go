@@ -68,6 +68,7 @@ func setupRefreshFixtureWithAudience(t *testing.T, audience pgtype.Text, spy *up
 		testenv.NewMeterProvider(t),
 		ti.conn,
 		enc,
+		nil,
 		policy,
 		cache.NoopCache,
 		mustURL(t, "http://localhost"),
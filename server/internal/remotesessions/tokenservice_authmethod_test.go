@@ -90,6 +90,7 @@ func setupRefreshFixture(t *testing.T, authMethod string, clientSecret string, s
 		testenv.NewMeterProvider(t),
 		ti.conn,
 		enc,
+		nil,
 		policy,
 		cache.NoopCache,
 		mustURL(t, "http://localhost"),
@@ -45,6 +45,7 @@ func newResolveManager(t *testing.T, conn *pgxpool.Pool, enc *encryption.Client)
 		testenv.NewMeterProvider(t),
 		conn,
 		enc,
+		nil,
 		policy,
 		cache.NoopCache,
 		mustURL(t, "http://localhost"),
@@ -38,6 +38,7 @@ import (
 
 	"github.com/speakeasy-api/gram/server/internal/attr"
 	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/encryption/envelope"
 	"github.com/speakeasy-api/gram/server/internal/guardian"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
 	"github.com/speakeasy-api/gram/server/internal/remotesessions/remotesessionmetrics"
@@ -80,6 +81,7 @@ type UpstreamRevoker struct {
 	tracer trace.Tracer
 	db     *pgxpool.Pool
 	enc    *encryption.Client
+	tokens tokenKeys
 
 	// client is built once and shared by every revocation. Guardian's pooled
 	// transport is meant for exactly this — a long-lived client making repeated
@@ -93,13 +95,14 @@ type UpstreamRevoker struct {
 	metrics *remotesessionmetrics.Revoke
 }
 
-func NewUpstreamRevoker(logger *slog.Logger, tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider, db *pgxpool.Pool, enc *encryption.Client, policy *guardian.Policy) *UpstreamRevoker {
+func NewUpstreamRevoker(logger *slog.Logger, tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider, db *pgxpool.Pool, enc *encryption.Client, orgKeys *envelope.Keyring, policy *guardian.Policy) *UpstreamRevoker {
 	logger = logger.With(attr.SlogComponent("remote-session-upstream-revoke"))
 	return &UpstreamRevoker{
 		logger:  logger,
 		tracer:  tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/remotesessions"),
 		db:      db,
 		enc:     enc,
+		tokens:  tokenKeys{enc: enc, orgKeys: orgKeys},
 		client:  policy.PooledClient(),
 		metrics: remotesessionmetrics.NewRevoke(logger, meterProvider),
 	}
@@ -445,7 +448,7 @@ func (r *UpstreamRevoker) tokenToRevoke(ctx context.Context, cred RevokedCredent
 		return "", "", false
 	}
 
-	plain, err := r.enc.Decrypt(encrypted)
+	plain, err := r.tokens.decrypt(ctx, encrypted)
 	if err != nil {
 		// Logged without the session id's token material and without retry: an
 		// undecryptable token cannot be sent to anyone, so there is nothing to
@@ -615,6 +615,7 @@ func newDisconnectChallengeManager(t *testing.T, ti *testInstance) *remotesessio
 		testenv.NewMeterProvider(t),
 		ti.conn,
 		testenv.NewEncryptionClient(t),
+		nil,
 		policy,
 		cache.NoopCache,
 		mustURL(t, "http://localhost"),
@@ -44,14 +44,15 @@ const (
 // Source names are literals (same precedent as the categories package) because
 // the scanner subpackages import this one. Canonical definitions:
 //
-//	gitleaks          server/internal/scanners/gitleaks.Source
-//	presidio          pystreams/src/pystreams/risk/handler.py SOURCE_PRESIDIO
-//	prompt_injection  server/internal/scanners/promptinjection.Source
-//	llm_judge         server/internal/scanners/promptpolicy.Source
-//	shadow_mcp        server/internal/scanners/shadowmcpscan.Source
-//	account_identity  server/internal/background/activities/risk_analysis.SourceAccountIdentity
-//	destructive_tool  server/internal/scanners/destructivetool.Source
-//	cli_destructive   server/internal/scanners/clidestructive.Source
+//	gitleaks             server/internal/scanners/gitleaks.Source
+//	presidio             pystreams/src/pystreams/risk/handler.py SOURCE_PRESIDIO
+//	prompt_injection     server/internal/scanners/promptinjection.Source
+//	llm_judge            server/internal/scanners/promptpolicy.Source
+//	shadow_mcp           server/internal/scanners/shadowmcpscan.Source
+//	account_identity     server/internal/background/activities/risk_analysis.SourceAccountIdentity
+//	destructive_tool     server/internal/scanners/destructivetool.Source
+//	cli_destructive      server/internal/scanners/clidestructive.Source
+//	argument_constraint  server/internal/toolconstraints.FindingSource
 func FindingSurface(source, field, path string) string {
 	switch {
 	case path != "":
@@ -76,7 +77,7 @@ func FindingSurface(source, field, path string) string {
 		// The judge's match is a rendered artifact of the judged content; the
 		// rationale carries the signal and the offsets index no stored text.
 		return SurfaceNone
-	case "shadow_mcp", "account_identity", "destructive_tool", "cli_destructive":
+	case "shadow_mcp", "account_identity", "destructive_tool", "cli_destructive", "argument_constraint":
 		// The match is derived metadata (server identifier, account email,
 		// tool name), not a slice of any recorded surface.
 		return SurfaceDerived
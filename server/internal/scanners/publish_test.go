@@ -235,6 +235,7 @@ func TestFindingSurface(t *testing.T) {
 		{source: "account_identity", field: "", path: "", want: "derived"},
 		{source: "destructive_tool", field: "", path: "", want: "derived"},
 		{source: "cli_destructive", field: "", path: "", want: "derived"},
+		{source: "argument_constraint", field: "", path: "", want: "derived"},
 		{source: "custom", field: "", path: "", want: ""},
 	}
 	for _, tc := range cases {
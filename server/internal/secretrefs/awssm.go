@@ -0,0 +1,117 @@
+package secretrefs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/secretrefs/repo"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/aws/awsauth"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+func (r *Resolver) readSecretsManager(ctx context.Context, projectID uuid.UUID, settings *toolconfig.CaseInsensitiveEnv, ref Ref) (string, error) {
+	cred, err := r.awsCredential(ctx, projectID, settings.Get(AWSCredentialVar))
+	if err != nil {
+		return "", err
+	}
+
+	region := arnRegion(ref.Path)
+	if region == "" {
+		region = settings.Get(AWSRegionVar)
+	}
+	if region == "" {
+		region = cred.StsRegion.String
+	}
+	if region == "" {
+		return "", fmt.Errorf("set %s in the environment, or reference the secret by its ARN", AWSRegionVar)
+	}
+
+	cfg, err := r.aws.Config(ctx, awsauth.Credential{
+		AssumeRoleArn: cred.AssumeRoleArn.String,
+		ExternalID:    cred.ExternalID.String,
+		OidcAudience:  cred.OidcAudience.String,
+		StsRegion:     cred.StsRegion.String,
+	}, region)
+	switch {
+	case errors.Is(err, awsauth.ErrUnsupportedMode), errors.Is(err, awsauth.ErrUnusableCredential):
+		return "", fmt.Errorf("aws iam credential %q: %w", cred.Name, err)
+	case err != nil:
+		return "", &internalError{err: err}
+	}
+
+	out, err := r.secretsManager(cfg).GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(ref.Path),
+	})
+	if err != nil {
+		return "", describeSecretsManagerError(ref, region, err)
+	}
+	if out.SecretString == nil {
+		return "", errors.New("binary secrets are not supported; store the value as a secret string")
+	}
+
+	if ref.Field == "" {
+		return *out.SecretString, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal([]byte(*out.SecretString), &fields); err != nil {
+		return "", fmt.Errorf("secret is not a JSON object, so it has no field %q", ref.Field)
+	}
+	return pickField(fields, ref.Field)
+}
+
+// awsCredential picks the AWS IAM credential a project's references are read
+// with: the one named by AWSCredentialVar, or else the only one available.
+func (r *Resolver) awsCredential(ctx context.Context, projectID uuid.UUID, selector string) (repo.ListProjectAwsIamCredentialsRow, error) {
+	var none repo.ListProjectAwsIamCredentialsRow
+
+	creds, err := repo.New(r.db).ListProjectAwsIamCredentials(ctx, projectID)
+	if err != nil {
+		return none, &internalError{err: fmt.Errorf("list aws iam credentials: %w", err)}
+	}
+
+	if selector != "" {
+		for _, cred := range creds {
+			if cred.ID.String() == selector || cred.Name == selector {
+				return cred, nil
+			}
+		}
+		return none, fmt.Errorf("%s names no aws iam credential available to this project", AWSCredentialVar)
+	}
+
+	switch len(creds) {
+	case 0:
+		return none, errors.New("no aws iam credential is available to this project; create one to read from aws secrets manager")
+	case 1:
+		return creds[0], nil
+	default:
+		return none, fmt.Errorf("%d aws iam credentials are available to this project; set %s to the one to use", len(creds), AWSCredentialVar)
+	}
+}
+
+func describeSecretsManagerError(ref Ref, region string, err error) error {
+	var notFound *types.ResourceNotFoundException
+	if errors.As(err, &notFound) {
+		return fmt.Errorf("aws secrets manager has no secret %s in %s", ref.Path, region)
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.ErrorCode() {
+		case "AccessDeniedException", "AccessDenied":
+			return fmt.Errorf("aws denied access to %s; check the role's trust policy and that it allows secretsmanager:GetSecretValue: %s", ref.Path, apiErr.ErrorMessage())
+		default:
+			return fmt.Errorf("aws secrets manager: %s: %s", apiErr.ErrorCode(), apiErr.ErrorMessage())
+		}
+	}
+
+	return fmt.Errorf("read from aws secrets manager: %w", err)
+}
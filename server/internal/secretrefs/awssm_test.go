@@ -0,0 +1,209 @@
+package secretrefs
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	extcredrepo "github.com/speakeasy-api/gram/server/internal/externalcredentials/repo"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+// fakeSecretsManager serves secret strings by name and records the region
+// each read was made in.
+type fakeSecretsManager struct {
+	mu      sync.Mutex
+	secrets map[string]string
+	regions []string
+}
+
+func (f *fakeSecretsManager) client(cfg aws.Config) secretsManagerAPI {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.regions = append(f.regions, cfg.Region)
+	return f
+}
+
+func (f *fakeSecretsManager) GetSecretValue(_ context.Context, params *secretsmanager.GetSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	secret, ok := f.secrets[aws.ToString(params.SecretId)]
+	if !ok {
+		return nil, &types.ResourceNotFoundException{Message: aws.String("Secrets Manager can't find the specified secret.")}
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(secret)}, nil
+}
+
+func newAwsResolver(t *testing.T) (*testInstance, *Resolver, *fakeSecretsManager) {
+	t.Helper()
+
+	ti := newTestInstance(t)
+	resolver := ti.newResolver(t, nil)
+	fake := &fakeSecretsManager{
+		secrets: map[string]string{
+			"prod/stripe": `{"api_key":"sk_live_123","account":"acct_1"}`,
+			"arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/token-AbCdEf": "plain-token",
+		},
+	}
+	resolver.secretsManager = fake.client
+
+	return ti, resolver, fake
+}
+
+func TestResolve_AWSSecretsManager(t *testing.T) {
+	t.Parallel()
+
+	ti, resolver, fake := newAwsResolver(t)
+	organizationID, projectID := ti.organizationID, ti.projectID
+	ti.seedAwsCredential(t, organizationID, "secrets-reader", "us-west-2")
+
+	env := toolconfig.NewCaseInsensitiveEnv()
+	env.Set("STRIPE_KEY", "awssm://prod/stripe#api_key")
+	env.Set("TOKEN", "awssm://arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/token-AbCdEf")
+
+	err := resolver.Resolve(t.Context(), projectID, env, env.Keys())
+	require.NoError(t, err)
+	require.Equal(t, "sk_live_123", env.Get("STRIPE_KEY"))
+	require.Equal(t, "plain-token", env.Get("TOKEN"))
+
+	// Names fall back to the credential's STS region; ARNs carry their own.
+	require.ElementsMatch(t, []string{"us-west-2", "eu-west-1"}, fake.regions)
+}
+
+func TestResolve_AWSSecretsManagerRegionOverride(t *testing.T) {
+	t.Parallel()
+
+	ti, resolver, fake := newAwsResolver(t)
+	organizationID, projectID := ti.organizationID, ti.projectID
+	ti.seedAwsCredential(t, organizationID, "secrets-reader", "us-west-2")
+
+	env := toolconfig.NewCaseInsensitiveEnv()
+	env.Set(AWSRegionVar, "ap-southeast-2")
+	env.Set("STRIPE_KEY", "awssm://prod/stripe#api_key")
+
+	require.NoError(t, resolver.Resolve(t.Context(), projectID, env, env.Keys()))
+	require.Equal(t, []string{"ap-southeast-2"}, fake.regions)
+}
+
+func TestResolve_AWSSecretsManagerCredentialSelection(t *testing.T) {
+	t.Parallel()
+
+	ti, resolver, _ := newAwsResolver(t)
+	organizationID, projectID := ti.organizationID, ti.projectID
+
+	env := toolconfig.NewCaseInsensitiveEnv()
+	env.Set("STRIPE_KEY", "awssm://prod/stripe#api_key")
+	err := resolver.Resolve(t.Context(), projectID, env, env.Keys())
+	require.ErrorContains(t, err, "no aws iam credential is available")
+
+	ti.seedAwsCredential(t, organizationID, "reader-a", "us-west-2")
+	second := ti.seedAwsCredential(t, organizationID, "reader-b", "us-west-2")
+
+	err = resolver.Resolve(t.Context(), projectID, env, env.Keys())
+	require.ErrorContains(t, err, "2 aws iam credentials")
+
+	env.Set(AWSCredentialVar, second.String())
+	require.NoError(t, resolver.Resolve(t.Context(), projectID, env, env.Keys()))
+	require.Equal(t, "sk_live_123", env.Get("STRIPE_KEY"))
+
+	env = toolconfig.NewCaseInsensitiveEnv()
+	env.Set(AWSCredentialVar, "reader-c")
+	env.Set("STRIPE_KEY", "awssm://prod/stripe#api_key")
+	err = resolver.Resolve(t.Context(), projectID, env, env.Keys())
+	require.ErrorContains(t, err, "names no aws iam credential")
+}
+
+func TestResolve_AWSSecretsManagerIgnoresOtherOrganizations(t *testing.T) {
+	t.Parallel()
+
+	ti, resolver, _ := newAwsResolver(t)
+	projectID := ti.projectID
+	otherOrganizationID, _ := testenv.CreateOrganizationWithProject(t, t.Context(), ti.conn)
+	otherCredential := ti.seedAwsCredential(t, otherOrganizationID, "theirs", "us-west-2")
+
+	env := toolconfig.NewCaseInsensitiveEnv()
+	env.Set(AWSCredentialVar, otherCredential.String())
+	env.Set("STRIPE_KEY", "awssm://prod/stripe#api_key")
+
+	err := resolver.Resolve(t.Context(), projectID, env, env.Keys())
+	var refErr *toolconfig.SecretReferenceError
+	require.ErrorAs(t, err, &refErr)
+	require.ErrorContains(t, err, "names no aws iam credential")
+}
+
+func TestResolve_AWSSecretsManagerErrors(t *testing.T) {
+	t.Parallel()
+
+	ti, resolver, _ := newAwsResolver(t)
+	organizationID, projectID := ti.organizationID, ti.projectID
+	ti.seedAwsCredential(t, organizationID, "secrets-reader", "us-west-2")
+
+	tests := []struct {
+		name    string
+		value   string
+		wantErr string
+	}{
+		{name: "missing secret", value: "awssm://prod/missing", wantErr: "no secret prod/missing in us-west-2"},
+		{name: "missing field", value: "awssm://prod/stripe#password", wantErr: `no field "password"`},
+		{name: "not json", value: "awssm://arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/token-AbCdEf#key", wantErr: "not a JSON object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := toolconfig.NewCaseInsensitiveEnv()
+			env.Set("SECRET", tt.value)
+
+			err := resolver.Resolve(t.Context(), projectID, env, env.Keys())
+			var refErr *toolconfig.SecretReferenceError
+			require.ErrorAs(t, err, &refErr)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestResolve_AWSSecretsManagerNeedsRegion(t *testing.T) {
+	t.Parallel()
+
+	ti, resolver, _ := newAwsResolver(t)
+	organizationID, projectID := ti.organizationID, ti.projectID
+	ti.seedAwsCredential(t, organizationID, "secrets-reader", "")
+
+	env := toolconfig.NewCaseInsensitiveEnv()
+	env.Set("STRIPE_KEY", "awssm://prod/stripe#api_key")
+
+	err := resolver.Resolve(t.Context(), projectID, env, env.Keys())
+	require.ErrorContains(t, err, AWSRegionVar)
+}
+
+func TestResolve_AWSSecretsManagerUnusableCredential(t *testing.T) {
+	t.Parallel()
+
+	ti, resolver, _ := newAwsResolver(t)
+	organizationID, projectID := ti.organizationID, ti.projectID
+
+	testenv.CreateAWSIAMCredential(t, t.Context(), ti.conn, organizationID, "web-identity", extcredrepo.CreateAwsIamCredentialParams{
+		ExternalCredentialID: uuid.Nil,
+		AssumeRoleArn:        conv.ToPGText("arn:aws:iam::123456789012:role/gram-secrets"),
+		ExternalID:           pgtype.Text{String: "", Valid: false},
+		OidcAudience:         conv.ToPGText("sts.amazonaws.com"),
+		OidcSubject:          pgtype.Text{String: "", Valid: false},
+		StsRegion:            conv.ToPGText("us-west-2"),
+	})
+
+	env := toolconfig.NewCaseInsensitiveEnv()
+	env.Set("STRIPE_KEY", "awssm://prod/stripe#api_key")
+
+	err := resolver.Resolve(t.Context(), projectID, env, env.Keys())
+	var refErr *toolconfig.SecretReferenceError
+	require.ErrorAs(t, err, &refErr)
+	require.ErrorContains(t, err, `aws iam credential "web-identity"`)
+}
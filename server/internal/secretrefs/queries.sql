@@ -0,0 +1,18 @@
+-- name: ListProjectAwsIamCredentials :many
+-- The AWS IAM credentials a project's secret references can authenticate
+-- with: the organization's, plus any scoped to the project itself.
+SELECT
+  ec.id,
+  ec.name,
+  aic.assume_role_arn,
+  aic.external_id,
+  aic.oidc_audience,
+  aic.sts_region
+FROM external_credentials ec
+JOIN aws_iam_credentials aic ON aic.external_credential_id = ec.id
+JOIN projects p ON p.id = @project_id
+WHERE ec.provider = 'aws_iam'
+  AND ec.deleted IS FALSE
+  AND ec.organization_id = p.organization_id
+  AND (ec.project_id IS NULL OR ec.project_id = p.id)
+ORDER BY ec.id;
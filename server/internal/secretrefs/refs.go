@@ -0,0 +1,117 @@
+// Package secretrefs resolves environment variable values that reference
+// secrets held in an external secret manager, so credentials can stay in a
+// customer's HashiCorp Vault or AWS Secrets Manager instead of being copied
+// into Gram.
+//
+// A reference is an entire variable value:
+//
+//	vault://kv/data/stripe#api_key   field api_key of the Vault secret at kv/data/stripe
+//	awssm://prod/stripe              the whole SecretString of prod/stripe
+//	awssm://prod/stripe#api_key      field api_key of a JSON SecretString
+//
+// References are resolved when a tool is called, never stored resolved, and
+// each resolved value is cached for a short TTL. How Gram authenticates to the
+// secret manager is configured with variables in the same environments; see
+// [VaultAddrVar] and [AWSCredentialVar].
+package secretrefs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Scheme names the secret manager a reference points into.
+type Scheme string
+
+const (
+	SchemeVault             Scheme = "vault"
+	SchemeAWSSecretsManager Scheme = "awssm"
+)
+
+const (
+	schemeSeparator    = "://"
+	fieldSeparator     = "#"
+	maxReferenceLength = 2048
+)
+
+var errInvalidReference = errors.New("invalid secret reference")
+
+// Ref is a parsed secret reference.
+type Ref struct {
+	Scheme Scheme
+	// Path is the Vault API path below /v1/, or the Secrets Manager secret
+	// name or ARN.
+	Path string
+	// Field selects one key of a secret holding several. Empty takes the
+	// whole secret.
+	Field string
+}
+
+func (r Ref) String() string {
+	s := string(r.Scheme) + schemeSeparator + r.Path
+	if r.Field != "" {
+		s += fieldSeparator + r.Field
+	}
+	return s
+}
+
+// IsReference reports whether a value is written as a secret reference, valid
+// or not. Values that are not are used as they are.
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, string(SchemeVault)+schemeSeparator) ||
+		strings.HasPrefix(value, string(SchemeAWSSecretsManager)+schemeSeparator)
+}
+
+// Parse parses a secret reference. It reports false for values that are not
+// written as one, and an error for values that are but are malformed.
+func Parse(value string) (Ref, bool, error) {
+	var ref Ref
+	if !IsReference(value) {
+		return ref, false, nil
+	}
+	if len(value) > maxReferenceLength {
+		return ref, true, fmt.Errorf("%w: longer than %d characters", errInvalidReference, maxReferenceLength)
+	}
+	if strings.ContainsFunc(value, unicode.IsSpace) {
+		return ref, true, fmt.Errorf("%w: contains whitespace", errInvalidReference)
+	}
+
+	scheme, rest, _ := strings.Cut(value, schemeSeparator)
+	path, field, hasField := strings.Cut(rest, fieldSeparator)
+	if path == "" {
+		return ref, true, fmt.Errorf("%w: no secret path", errInvalidReference)
+	}
+	if hasField && field == "" {
+		return ref, true, fmt.Errorf("%w: empty field after %q", errInvalidReference, fieldSeparator)
+	}
+
+	ref = Ref{Scheme: Scheme(scheme), Path: path, Field: field}
+	if ref.Scheme == SchemeVault {
+		if strings.ContainsAny(path, "?%\\") {
+			return ref, true, fmt.Errorf("%w: vault path must not contain ?, %% or \\", errInvalidReference)
+		}
+		if strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") {
+			return ref, true, fmt.Errorf("%w: vault path must not start or end with /", errInvalidReference)
+		}
+		for segment := range strings.SplitSeq(path, "/") {
+			if segment == "" || segment == "." || segment == ".." {
+				return ref, true, fmt.Errorf("%w: vault path has an empty or relative segment", errInvalidReference)
+			}
+		}
+	}
+
+	return ref, true, nil
+}
+
+// arnRegion returns the region named by a Secrets Manager ARN, or "" when the
+// secret is identified by name.
+func arnRegion(secretID string) string {
+	// arn:partition:secretsmanager:region:account:secret:name
+	parts := strings.SplitN(secretID, ":", 6)
+	if len(parts) < 6 || parts[0] != "arn" || parts[2] != "secretsmanager" {
+		return ""
+	}
+	return parts[3]
+}
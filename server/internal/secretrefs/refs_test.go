@@ -0,0 +1,64 @@
+package secretrefs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		isRef   bool
+		want    Ref
+		wantErr string
+	}{
+		{name: "plain value", value: "sk_live_123", isRef: false},
+		{name: "other scheme", value: "https://example.com", isRef: false},
+		{name: "vault with field", value: "vault://kv/data/stripe#api_key", isRef: true, want: Ref{Scheme: SchemeVault, Path: "kv/data/stripe", Field: "api_key"}},
+		{name: "vault without field", value: "vault://secret/stripe", isRef: true, want: Ref{Scheme: SchemeVault, Path: "secret/stripe", Field: ""}},
+		{name: "awssm name", value: "awssm://prod/stripe", isRef: true, want: Ref{Scheme: SchemeAWSSecretsManager, Path: "prod/stripe", Field: ""}},
+		{name: "awssm arn with field", value: "awssm://arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/stripe-AbCdEf#api_key", isRef: true, want: Ref{Scheme: SchemeAWSSecretsManager, Path: "arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/stripe-AbCdEf", Field: "api_key"}},
+		{name: "empty path", value: "vault://", isRef: true, wantErr: "no secret path"},
+		{name: "empty field", value: "awssm://prod/stripe#", isRef: true, wantErr: "empty field"},
+		{name: "whitespace", value: "vault://kv/data/stripe #api_key", isRef: true, wantErr: "whitespace"},
+		{name: "too long", value: "awssm://" + strings.Repeat("a", maxReferenceLength), isRef: true, wantErr: "longer than"},
+		{name: "vault traversal", value: "vault://kv/../sys/seal", isRef: true, wantErr: "relative segment"},
+		{name: "vault empty segment", value: "vault://kv//stripe", isRef: true, wantErr: "relative segment"},
+		{name: "vault leading slash", value: "vault:///kv/stripe", isRef: true, wantErr: "start or end"},
+		{name: "vault query", value: "vault://kv/stripe?version=1", isRef: true, wantErr: "must not contain"},
+		{name: "vault escape", value: "vault://kv/%2e%2e/sys", isRef: true, wantErr: "must not contain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ref, isRef, err := Parse(tt.value)
+			require.Equal(t, tt.isRef, isRef)
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, errInvalidReference)
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.isRef {
+				require.Equal(t, tt.want, ref)
+				require.Equal(t, tt.value, ref.String())
+			}
+		})
+	}
+}
+
+func TestArnRegion(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "eu-west-1", arnRegion("arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/stripe-AbCdEf"))
+	require.Equal(t, "us-gov-west-1", arnRegion("arn:aws-us-gov:secretsmanager:us-gov-west-1:123456789012:secret:x"))
+	require.Empty(t, arnRegion("prod/stripe"))
+	require.Empty(t, arnRegion("arn:aws:s3:::bucket"))
+}
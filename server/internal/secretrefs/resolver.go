@@ -0,0 +1,240 @@
+package secretrefs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/google/uuid"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/aws/awsauth"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+// DefaultCacheTTL bounds how long a resolved secret is served before it is
+// read again, and so how long a rotated or revoked secret keeps working.
+const DefaultCacheTTL = time.Minute
+
+const (
+	// VaultAddrVar is the address of the Vault server vault:// references
+	// are read from. It must be a public https URL.
+	VaultAddrVar = "GRAM_VAULT_ADDR"
+	// VaultTokenVar is the Vault token vault:// references are read with.
+	// Store it as a secret.
+	VaultTokenVar = "GRAM_VAULT_TOKEN"
+	// VaultNamespaceVar optionally selects a Vault Enterprise namespace.
+	VaultNamespaceVar = "GRAM_VAULT_NAMESPACE"
+	// AWSCredentialVar names the AWS IAM external credential, by ID or name,
+	// that awssm:// references are read with. It can be left unset when the
+	// organization has exactly one.
+	AWSCredentialVar = "GRAM_AWS_CREDENTIAL"
+	// AWSRegionVar is the region awssm:// references given by name are read
+	// from. ARNs carry their own region, and the credential's STS region is
+	// used when neither is set.
+	AWSRegionVar = "GRAM_AWS_REGION"
+)
+
+// maxCachedSecrets bounds the cache across every project a replica serves.
+const maxCachedSecrets = 4096
+
+// secretsManagerAPI is the part of the Secrets Manager client the resolver
+// uses, so tests can stand one in.
+type secretsManagerAPI interface {
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+// internalError marks a failure Gram is answerable for, as opposed to a
+// reference or secret manager configuration the caller has to fix.
+type internalError struct {
+	err error
+}
+
+func (e *internalError) Error() string { return e.err.Error() }
+func (e *internalError) Unwrap() error { return e.err }
+
+// Resolver replaces secret references in an environment with the secrets they
+// name. It is safe for concurrent use.
+type Resolver struct {
+	logger         *slog.Logger
+	db             *pgxpool.Pool
+	policy         *guardian.Policy
+	vault          *guardian.HTTPClient
+	aws            *awsauth.Identity
+	secretsManager func(aws.Config) secretsManagerAPI
+	cache          *expirable.LRU[string, string]
+	fetches        singleflight.Group
+}
+
+// NewResolver returns a resolver. Vault is reached through the guardian
+// policy, so its address is held to the same blocklist as tool traffic.
+func NewResolver(logger *slog.Logger, db *pgxpool.Pool, policy *guardian.Policy, identity *awsauth.Identity, ttl time.Duration) *Resolver {
+	return &Resolver{
+		logger: logger.With(attr.SlogComponent("secretrefs")),
+		db:     db,
+		policy: policy,
+		vault:  policy.PooledClient(),
+		aws:    identity,
+		secretsManager: func(cfg aws.Config) secretsManagerAPI {
+			return secretsmanager.NewFromConfig(cfg)
+		},
+		cache:   expirable.NewLRU[string, string](maxCachedSecrets, nil, ttl),
+		fetches: singleflight.Group{},
+	}
+}
+
+// Resolve replaces the secret references held by the named variables of env
+// with the secrets they name, in place. Names are matched case-insensitively,
+// and names env does not hold are skipped. Only the variables a call reads
+// are named, so a reference nothing uses is never fetched and cannot fail
+// the call.
+//
+// Settings are read from env as it was passed in, so a setting written as a
+// reference is used as written rather than depending on which variable
+// happened to be resolved first.
+//
+// # Errors
+//   - [*toolconfig.SecretReferenceError]: a reference is malformed, or its
+//     secret could not be read.
+//   - `error`: Gram could not look up what the reference needs.
+func (r *Resolver) Resolve(ctx context.Context, projectID uuid.UUID, env *toolconfig.CaseInsensitiveEnv, names []string) error {
+	settings := toolconfig.CIEnvFrom(env.All())
+
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		name = strings.ToUpper(name)
+		if _, ok := seen[name]; ok || !settings.Has(name) {
+			continue
+		}
+		seen[name] = struct{}{}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(seen)) {
+		value := settings.Get(name)
+		ref, ok, err := Parse(value)
+		if !ok {
+			continue
+		}
+
+		var secret string
+		if err == nil {
+			secret, err = r.resolve(ctx, projectID, settings, ref)
+		}
+
+		var internal *internalError
+		switch {
+		case errors.As(err, &internal):
+			return fmt.Errorf("resolve %s: %w", name, internal.err)
+		case err != nil:
+			r.logger.WarnContext(ctx, "secret reference could not be resolved",
+				attr.SlogProjectID(projectID.String()),
+				attr.SlogEnvVarName(name),
+				attr.SlogError(err),
+			)
+			return &toolconfig.SecretReferenceError{Name: name, Reference: value, Err: err}
+		}
+
+		env.Set(name, secret)
+	}
+
+	return nil
+}
+
+func (r *Resolver) resolve(ctx context.Context, projectID uuid.UUID, settings *toolconfig.CaseInsensitiveEnv, ref Ref) (string, error) {
+	key := cacheKey(projectID, settings, ref)
+	if secret, ok := r.cache.Get(key); ok {
+		return secret, nil
+	}
+
+	v, err, _ := r.fetches.Do(key, func() (any, error) {
+		var secret string
+		var err error
+		switch ref.Scheme {
+		case SchemeVault:
+			secret, err = r.readVault(ctx, settings, ref)
+		case SchemeAWSSecretsManager:
+			secret, err = r.readSecretsManager(ctx, projectID, settings, ref)
+		default:
+			err = fmt.Errorf("unsupported secret manager %q", ref.Scheme)
+		}
+		if err != nil {
+			return "", err
+		}
+
+		r.cache.Add(key, secret)
+		return secret, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	secret, ok := v.(string)
+	if !ok {
+		return "", &internalError{err: fmt.Errorf("unexpected secret type %T", v)}
+	}
+	return secret, nil
+}
+
+// cacheKey scopes a cached secret to the project and to everything that
+// decides what the reference reads as, so changing a token or credential
+// takes effect on the next call rather than after the TTL.
+func cacheKey(projectID uuid.UUID, settings *toolconfig.CaseInsensitiveEnv, ref Ref) string {
+	parts := []string{projectID.String(), ref.String()}
+	switch ref.Scheme {
+	case SchemeVault:
+		parts = append(parts, settings.Get(VaultAddrVar), settings.Get(VaultNamespaceVar), settings.Get(VaultTokenVar))
+	case SchemeAWSSecretsManager:
+		parts = append(parts, settings.Get(AWSCredentialVar), settings.Get(AWSRegionVar))
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// pickField returns the named field of a secret holding several, or the only
+// field when none is named.
+func pickField(fields map[string]any, field string) (string, error) {
+	if field == "" {
+		if len(fields) != 1 {
+			return "", fmt.Errorf("secret has %d fields; name one with #<field>", len(fields))
+		}
+		return stringValue(slices.Collect(maps.Values(fields))[0])
+	}
+
+	value, ok := fields[field]
+	if !ok {
+		return "", fmt.Errorf("secret has no field %q", field)
+	}
+	return stringValue(value)
+}
+
+// stringValue renders a secret field as a variable value: strings as they
+// are, anything else as JSON.
+func stringValue(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case nil:
+		return "", errors.New("secret field is null")
+	default:
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("encode secret field: %w", err)
+		}
+		return string(bs), nil
+	}
+}
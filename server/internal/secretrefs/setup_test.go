@@ -0,0 +1,113 @@
+package secretrefs
+
+import (
+	"context"
+	"crypto/x509"
+	"log"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/billing"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	extcredrepo "github.com/speakeasy-api/gram/server/internal/externalcredentials/repo"
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/aws/awsauth"
+)
+
+var (
+	infra *testenv.Environment
+)
+
+func TestMain(m *testing.M) {
+	res, cleanup, err := testenv.Launch(context.Background(), testenv.LaunchOptions{Postgres: true, Redis: true})
+	if err != nil {
+		log.Fatalf("Failed to launch test infrastructure: %v", err)
+		os.Exit(1)
+	}
+
+	infra = res
+
+	code := m.Run()
+
+	if err := cleanup(); err != nil {
+		log.Fatalf("Failed to cleanup test infrastructure: %v", err)
+		os.Exit(1)
+	}
+
+	os.Exit(code)
+}
+
+type testInstance struct {
+	conn           *pgxpool.Pool
+	organizationID string
+	projectID      uuid.UUID
+}
+
+func newTestInstance(t *testing.T) *testInstance {
+	t.Helper()
+
+	logger := testenv.NewLogger(t)
+	tracerProvider := testenv.NewTracerProvider(t)
+
+	conn, err := infra.CloneTestDatabase(t, "testdb")
+	require.NoError(t, err)
+
+	redisClient, err := infra.NewRedisClient(t, 0)
+	require.NoError(t, err)
+
+	billingClient := billing.NewStubClient(logger, tracerProvider)
+	sessionManager := testenv.NewTestManager(t, logger, tracerProvider, conn, redisClient, cache.Suffix("gram-local"), billingClient)
+
+	ctx := testenv.InitAuthContext(t, t.Context(), conn, sessionManager)
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+	require.NotNil(t, authCtx.ProjectID)
+
+	return &testInstance{
+		conn:           conn,
+		organizationID: authCtx.ActiveOrganizationID,
+		projectID:      *authCtx.ProjectID,
+	}
+}
+
+// newResolver builds a resolver whose guardian policy lets it reach the
+// given TLS test server on loopback and trusts its certificate.
+func (ti *testInstance) newResolver(t *testing.T, vault *httptest.Server) *Resolver {
+	t.Helper()
+
+	options := []func(*guardian.Policy){}
+	if vault != nil {
+		pool := x509.NewCertPool()
+		pool.AddCert(vault.Certificate())
+		options = append(options, guardian.WithTLSRootCAs(pool))
+	}
+
+	policy, err := guardian.NewUnsafePolicy(testenv.NewTracerProvider(t), nil, options...)
+	require.NoError(t, err)
+
+	return NewResolver(testenv.NewLogger(t), ti.conn, policy, awsauth.NewIdentity(), DefaultCacheTTL)
+}
+
+// seedAwsCredential creates an AWS IAM credential that assumes a role with
+// an external id, and returns its ID.
+func (ti *testInstance) seedAwsCredential(t *testing.T, organizationID string, name string, stsRegion string) uuid.UUID {
+	t.Helper()
+
+	return testenv.CreateAWSIAMCredential(t, t.Context(), ti.conn, organizationID, name, extcredrepo.CreateAwsIamCredentialParams{
+		ExternalCredentialID: uuid.Nil,
+		AssumeRoleArn:        conv.ToPGText("arn:aws:iam::123456789012:role/gram-secrets"),
+		ExternalID:           conv.ToPGText("external-id"),
+		OidcAudience:         pgtype.Text{String: "", Valid: false},
+		OidcSubject:          pgtype.Text{String: "", Valid: false},
+		StsRegion:            conv.ToPGTextEmpty(stsRegion),
+	})
+}
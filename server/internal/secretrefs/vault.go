@@ -0,0 +1,80 @@
+package secretrefs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+// maxVaultResponseBytes bounds how much of a Vault response is read. A secret
+// is a few kilobytes at most; anything larger is not one.
+const maxVaultResponseBytes = 1 << 20
+
+// vaultResponse is the envelope every Vault read returns. A KV version 2
+// secret nests its fields one level further down, under data.data.
+type vaultResponse struct {
+	Data map[string]any `json:"data"`
+}
+
+func (r *Resolver) readVault(ctx context.Context, settings *toolconfig.CaseInsensitiveEnv, ref Ref) (string, error) {
+	addr := strings.TrimSpace(settings.Get(VaultAddrVar))
+	token := settings.Get(VaultTokenVar)
+	if addr == "" || token == "" {
+		return "", fmt.Errorf("set %s and %s in the environment to read secrets from vault", VaultAddrVar, VaultTokenVar)
+	}
+
+	base, err := r.policy.ValidateHTTPSURL(ctx, addr)
+	if err != nil {
+		return "", fmt.Errorf("%s must be a public https url: %w", VaultAddrVar, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base.JoinPath("v1", ref.Path).String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("build vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if namespace := strings.TrimSpace(settings.Get(VaultNamespaceVar)); namespace != "" {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+
+	resp, err := r.vault.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("reach vault: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return resp.Body.Close() })
+
+	// Vault's error bodies are not echoed: they are the caller's to read in
+	// Vault's own audit log, and the status already says what to fix.
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusForbidden:
+		return "", fmt.Errorf("vault denied access to %s; check that %s is valid and its policy can read the path", ref.Path, VaultTokenVar)
+	case http.StatusNotFound:
+		return "", fmt.Errorf("vault has no secret at %s", ref.Path)
+	default:
+		return "", fmt.Errorf("vault answered with status %d", resp.StatusCode)
+	}
+
+	var body vaultResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxVaultResponseBytes)).Decode(&body); err != nil {
+		return "", fmt.Errorf("decode vault response: %w", err)
+	}
+
+	fields := body.Data
+	if nested, ok := fields["data"].(map[string]any); ok {
+		if _, versioned := fields["metadata"]; versioned {
+			fields = nested
+		}
+	}
+	if fields == nil {
+		return "", fmt.Errorf("vault returned no data for %s", ref.Path)
+	}
+
+	return pickField(fields, ref.Field)
+}
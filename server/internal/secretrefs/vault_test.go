@@ -0,0 +1,190 @@
+package secretrefs
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+)
+
+type fakeVault struct {
+	*httptest.Server
+	reads atomic.Int32
+}
+
+// newFakeVault serves a KV version 2 secret at kv/data/stripe and a KV
+// version 1 secret at secret/webhook, readable with the token "root".
+func newFakeVault(t *testing.T) *fakeVault {
+	t.Helper()
+
+	fv := &fakeVault{Server: nil, reads: atomic.Int32{}}
+	fv.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fv.reads.Add(1)
+		if r.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/kv/data/stripe":
+			_, _ = w.Write([]byte(`{"data":{"data":{"api_key":"sk_live_123","limits":{"rps":5}},"metadata":{"version":3}}}`))
+		case "/v1/secret/webhook":
+			_, _ = w.Write([]byte(`{"data":{"signing_secret":"whsec_456"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	t.Cleanup(fv.Close)
+
+	return fv
+}
+
+func vaultEnv(fv *fakeVault, token string, vars map[string]string) *toolconfig.CaseInsensitiveEnv {
+	env := toolconfig.NewCaseInsensitiveEnv()
+	env.Set(VaultAddrVar, fv.URL)
+	env.Set(VaultTokenVar, token)
+	for k, v := range vars {
+		env.Set(k, v)
+	}
+	return env
+}
+
+func TestResolve_Vault(t *testing.T) {
+	t.Parallel()
+
+	ti := newTestInstance(t)
+	fv := newFakeVault(t)
+	resolver := ti.newResolver(t, fv.Server)
+
+	env := vaultEnv(fv, "root", map[string]string{
+		"STRIPE_KEY":     "vault://kv/data/stripe#api_key",
+		"STRIPE_LIMITS":  "vault://kv/data/stripe#limits",
+		"WEBHOOK_SECRET": "vault://secret/webhook",
+		"PLAIN":          "unchanged",
+	})
+
+	err := resolver.Resolve(t.Context(), uuid.New(), env, env.Keys())
+	require.NoError(t, err)
+	require.Equal(t, "sk_live_123", env.Get("STRIPE_KEY"))
+	require.JSONEq(t, `{"rps":5}`, env.Get("STRIPE_LIMITS"))
+	require.Equal(t, "whsec_456", env.Get("WEBHOOK_SECRET"))
+	require.Equal(t, "unchanged", env.Get("PLAIN"))
+}
+
+func TestResolve_OnlyNamedVariables(t *testing.T) {
+	t.Parallel()
+
+	ti := newTestInstance(t)
+	fv := newFakeVault(t)
+	resolver := ti.newResolver(t, fv.Server)
+
+	env := vaultEnv(fv, "root", map[string]string{
+		"STRIPE_KEY": "vault://kv/data/stripe#api_key",
+		"UNUSED":     "vault://kv/data/missing",
+	})
+
+	err := resolver.Resolve(t.Context(), uuid.New(), env, []string{"stripe_key", "NOT_SET"})
+	require.NoError(t, err)
+	require.Equal(t, "sk_live_123", env.Get("STRIPE_KEY"))
+	require.Equal(t, "vault://kv/data/missing", env.Get("UNUSED"))
+	require.Equal(t, int32(1), fv.reads.Load())
+}
+
+func TestResolve_VaultCachesPerToken(t *testing.T) {
+	t.Parallel()
+
+	ti := newTestInstance(t)
+	fv := newFakeVault(t)
+	resolver := ti.newResolver(t, fv.Server)
+	projectID := uuid.New()
+
+	for range 3 {
+		env := vaultEnv(fv, "root", map[string]string{"STRIPE_KEY": "vault://kv/data/stripe#api_key"})
+		require.NoError(t, resolver.Resolve(t.Context(), projectID, env, env.Keys()))
+		require.Equal(t, "sk_live_123", env.Get("STRIPE_KEY"))
+	}
+	require.Equal(t, int32(1), fv.reads.Load())
+
+	// A revoked token must not keep reading the cached secret.
+	env := vaultEnv(fv, "revoked", map[string]string{"STRIPE_KEY": "vault://kv/data/stripe#api_key"})
+	err := resolver.Resolve(t.Context(), projectID, env, env.Keys())
+	require.Error(t, err)
+	require.Equal(t, int32(2), fv.reads.Load())
+}
+
+func TestResolve_VaultErrors(t *testing.T) {
+	t.Parallel()
+
+	ti := newTestInstance(t)
+	fv := newFakeVault(t)
+	resolver := ti.newResolver(t, fv.Server)
+
+	tests := []struct {
+		name    string
+		token   string
+		value   string
+		wantErr string
+	}{
+		{name: "denied", token: "wrong", value: "vault://kv/data/stripe#api_key", wantErr: "vault denied access"},
+		{name: "missing secret", token: "root", value: "vault://kv/data/missing#api_key", wantErr: "no secret at kv/data/missing"},
+		{name: "missing field", token: "root", value: "vault://kv/data/stripe#password", wantErr: `no field "password"`},
+		{name: "ambiguous field", token: "root", value: "vault://kv/data/stripe", wantErr: "has 2 fields"},
+		{name: "malformed", token: "root", value: "vault://kv/../sys", wantErr: "invalid secret reference"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			env := vaultEnv(fv, tt.token, map[string]string{"stripe_key": tt.value})
+			err := resolver.Resolve(t.Context(), uuid.New(), env, env.Keys())
+
+			var refErr *toolconfig.SecretReferenceError
+			require.ErrorAs(t, err, &refErr)
+			require.Equal(t, "STRIPE_KEY", refErr.Name)
+			require.Equal(t, tt.value, refErr.Reference)
+			require.ErrorContains(t, err, tt.wantErr)
+			require.NotContains(t, err.Error(), "permission denied")
+		})
+	}
+}
+
+func TestResolve_VaultRequiresSettings(t *testing.T) {
+	t.Parallel()
+
+	ti := newTestInstance(t)
+	resolver := ti.newResolver(t, nil)
+
+	env := toolconfig.NewCaseInsensitiveEnv()
+	env.Set("STRIPE_KEY", "vault://kv/data/stripe#api_key")
+
+	err := resolver.Resolve(t.Context(), uuid.New(), env, env.Keys())
+	var refErr *toolconfig.SecretReferenceError
+	require.ErrorAs(t, err, &refErr)
+	require.ErrorContains(t, err, VaultAddrVar)
+}
+
+func TestResolve_VaultRejectsPlainHTTP(t *testing.T) {
+	t.Parallel()
+
+	ti := newTestInstance(t)
+	resolver := ti.newResolver(t, nil)
+
+	env := toolconfig.NewCaseInsensitiveEnv()
+	env.Set(VaultAddrVar, "http://vault.example.com")
+	env.Set(VaultTokenVar, "root")
+	env.Set("STRIPE_KEY", "vault://kv/data/stripe#api_key")
+
+	err := resolver.Resolve(t.Context(), uuid.New(), env, env.Keys())
+	var refErr *toolconfig.SecretReferenceError
+	require.ErrorAs(t, err, &refErr)
+	require.ErrorContains(t, err, "https")
+}
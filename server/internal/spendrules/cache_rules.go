@@ -26,6 +26,7 @@ func BuildGateRules(rows []repo.SpendRule, now time.Time) (GateRules, error) {
 			RuleName:    row.Name,
 			Action:      row.Action,
 			TargetExpr:  row.TargetExpr,
+			ScopeExpr:   row.ScopeExpr,
 			RuleExpr:    row.RuleExpr,
 			LimitUSD:    row.LimitUsdCents.USD(),
 			WarnAtPct:   row.WarnAtPct,
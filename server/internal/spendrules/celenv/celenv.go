@@ -1,5 +1,5 @@
-// Package celenv defines the single CEL environment for spend rule
-// expressions. Every expression is a boolean predicate over one actor — an
+// Package celenv defines the CEL environments for spend rule expressions.
+// Target and rule expressions are boolean predicates over one actor — an
 // organization member enriched with whatever we know about them — plus that
 // actor's current usage against the rule.
 //
@@ -14,10 +14,17 @@
 // Standard CEL string functions (contains, startsWith, endsWith, matches) and
 // list membership (`in`) are available via the strings extension and the core
 // language.
+//
+// Scope expressions are a second, narrower environment over one usage
+// dimension tuple (model, model_family, assistant_id, project_id,
+// toolset_slug). A rule's scope selects which of an actor's spend counts
+// toward the budget; it cannot reference actor attributes, and target or rule
+// expressions cannot reference usage dimensions.
 package celenv
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/google/cel-go/cel"
 	"github.com/google/cel-go/common/types"
@@ -91,8 +98,88 @@ func TargetAttributeKind(name string) (AttributeKind, bool) {
 	return "", false
 }
 
+// Usage is one usage-dimension tuple a scope expression evaluates against.
+// Dimensions absent on the underlying telemetry rows are empty strings.
+type Usage struct {
+	Model       string
+	ModelFamily string
+	AssistantID string
+	ProjectID   string
+	ToolsetSlug string
+}
+
+// UsageDimension is one usage dimension a scope condition can reference.
+type UsageDimension struct {
+	Name        string
+	Description string
+}
+
+// UsageDimensions is the catalog of usage dimensions a spend rule scope may be
+// written against — shared by the scope CEL environment, scope-condition
+// validation (spendrules.scopeConditionExpr), and the rule editor. All
+// dimensions are scalar strings.
+var UsageDimensions = []UsageDimension{
+	{Name: "model", Description: "Model identifier reported on the usage row, e.g. claude-opus-4-1."},
+	{Name: "model_family", Description: "Coarse model family derived from the model, e.g. opus, sonnet, gpt-5."},
+	{Name: "assistant_id", Description: "Gram assistant the usage was attributed to."},
+	{Name: "project_id", Description: "Gram project the usage was recorded under."},
+	{Name: "toolset_slug", Description: "MCP toolset slug the usage was attributed to."},
+}
+
+// IsUsageDimension reports whether name is a known usage dimension.
+func IsUsageDimension(name string) bool {
+	for _, d := range UsageDimensions {
+		if d.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// claudeTiers are the Anthropic model tiers recognised by ModelFamily.
+var claudeTiers = []string{"opus", "sonnet", "haiku"}
+
+// ModelFamily collapses a model identifier into the coarse family scope
+// expressions compare against: Anthropic models map to their tier (opus,
+// sonnet, haiku), OpenAI gpt models to their generation (gpt-5, gpt-4o) and
+// Gemini models to their tier (gemini-pro, gemini-flash). Provider prefixes
+// such as "anthropic/" are ignored. Unrecognised models are returned
+// lowercased so exact-match scopes still work.
+func ModelFamily(model string) string {
+	m := strings.ToLower(strings.TrimSpace(model))
+	if i := strings.LastIndex(m, "/"); i >= 0 {
+		m = m[i+1:]
+	}
+	if m == "" {
+		return ""
+	}
+	if strings.HasPrefix(m, "claude") {
+		for _, tier := range claudeTiers {
+			if strings.Contains(m, tier) {
+				return tier
+			}
+		}
+		return "claude"
+	}
+	parts := strings.Split(m, "-")
+	switch {
+	case parts[0] == "gpt" && len(parts) > 1:
+		return "gpt-" + parts[1]
+	case parts[0] == "gemini":
+		for _, tier := range []string{"pro", "flash"} {
+			if strings.Contains(m, tier) {
+				return "gemini-" + tier
+			}
+		}
+		return "gemini"
+	default:
+		return m
+	}
+}
+
 type Engine struct {
-	env *cel.Env
+	env      *cel.Env
+	scopeEnv *cel.Env
 }
 
 // New builds the CEL environment — the single source of truth for what a
@@ -121,21 +208,41 @@ func New() (*Engine, error) {
 	if err != nil {
 		return nil, fmt.Errorf("build spend rule cel env: %w", err)
 	}
-	return &Engine{env: env}, nil
+
+	scopeOpts := []cel.EnvOption{ext.Strings()}
+	for _, d := range UsageDimensions {
+		scopeOpts = append(scopeOpts, cel.Variable(d.Name, cel.StringType))
+	}
+	scopeEnv, err := cel.NewEnv(scopeOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("build spend rule scope cel env: %w", err)
+	}
+
+	return &Engine{env: env, scopeEnv: scopeEnv}, nil
 }
 
 // Compile type-checks a target expression and asserts it is a boolean
 // predicate. Use at rule create/update time for validation and before
 // evaluation.
 func (e *Engine) Compile(expr string) (cel.Program, error) {
-	ast, iss := e.env.Compile(expr)
+	return compileBool(e.env, expr)
+}
+
+// CompileScope type-checks a scope expression against the usage-dimension
+// environment and asserts it is a boolean predicate.
+func (e *Engine) CompileScope(expr string) (cel.Program, error) {
+	return compileBool(e.scopeEnv, expr)
+}
+
+func compileBool(env *cel.Env, expr string) (cel.Program, error) {
+	ast, iss := env.Compile(expr)
 	if iss != nil && iss.Err() != nil {
 		return nil, fmt.Errorf("compile %q: %w", expr, iss.Err())
 	}
 	if out := ast.OutputType(); !out.IsExactType(cel.BoolType) {
 		return nil, fmt.Errorf("expression must evaluate to bool, got %s", out)
 	}
-	prg, err := e.env.Program(ast)
+	prg, err := env.Program(ast)
 	if err != nil {
 		return nil, fmt.Errorf("program %q: %w", expr, err)
 	}
@@ -172,3 +279,22 @@ func (e *Engine) Eval(prg cel.Program, actor Actor) (bool, error) {
 	}
 	return bool(b), nil
 }
+
+// EvalScope evaluates a compiled scope expression against one usage tuple.
+func (e *Engine) EvalScope(prg cel.Program, usage Usage) (bool, error) {
+	out, _, err := prg.Eval(map[string]any{
+		"model":        usage.Model,
+		"model_family": usage.ModelFamily,
+		"assistant_id": usage.AssistantID,
+		"project_id":   usage.ProjectID,
+		"toolset_slug": usage.ToolsetSlug,
+	})
+	if err != nil {
+		return false, fmt.Errorf("eval scope expression: %w", err)
+	}
+	b, ok := out.(types.Bool)
+	if !ok {
+		return false, fmt.Errorf("scope expression evaluated to %s, want bool", out.Type())
+	}
+	return bool(b), nil
+}
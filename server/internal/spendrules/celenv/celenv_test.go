@@ -113,3 +113,67 @@ func TestCompileRejectsSyntaxErrors(t *testing.T) {
 	_, err := newEngine(t).Compile(`department_name == `)
 	require.Error(t, err)
 }
+
+func TestCompileAndEvalScopeExpressions(t *testing.T) {
+	t.Parallel()
+
+	eng := newEngine(t)
+	usage := celenv.Usage{
+		Model:       "claude-opus-4-1-20250805",
+		ModelFamily: "opus",
+		AssistantID: "7f1c9a52-4c1e-4b8e-9b7d-6f0e2c1a3b4d",
+		ProjectID:   "0b0e8a55-5f0f-4d6b-8a5e-2a9f4c0d1e2f",
+		ToolsetSlug: "research-tools",
+	}
+
+	cases := []struct {
+		expr string
+		want bool
+	}{
+		{expr: `model_family == "opus"`, want: true},
+		{expr: `model_family == "sonnet"`, want: false},
+		{expr: `model.startsWith("claude-opus")`, want: true},
+		{expr: `toolset_slug == "research-tools" && assistant_id != ""`, want: true},
+		{expr: `project_id == "other"`, want: false},
+	}
+
+	for _, tc := range cases {
+		prg, err := eng.CompileScope(tc.expr)
+		require.NoError(t, err, "compile %q", tc.expr)
+		got, err := eng.EvalScope(prg, usage)
+		require.NoError(t, err, "eval %q", tc.expr)
+		require.Equal(t, tc.want, got, "eval %q", tc.expr)
+	}
+}
+
+func TestScopeAndTargetEnvironmentsAreDisjoint(t *testing.T) {
+	t.Parallel()
+
+	eng := newEngine(t)
+
+	_, err := eng.CompileScope(`department_name == "Engineering"`)
+	require.Error(t, err, "scope expressions cannot reference actor attributes")
+
+	_, err = eng.Compile(`model_family == "opus"`)
+	require.Error(t, err, "target expressions cannot reference usage dimensions")
+}
+
+func TestModelFamily(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"claude-opus-4-1-20250805":   "opus",
+		"claude-3-5-sonnet-20241022": "sonnet",
+		"anthropic/claude-haiku-4-5": "haiku",
+		"claude-instant":             "claude",
+		"gpt-5-mini":                 "gpt-5",
+		"GPT-4o-2024-08-06":          "gpt-4o",
+		"gemini-2.5-pro":             "gemini-pro",
+		"gemini-2.0-flash":           "gemini-flash",
+		"o3":                         "o3",
+		"":                           "",
+	}
+	for model, want := range cases {
+		require.Equal(t, want, celenv.ModelFamily(model), "model %q", model)
+	}
+}
@@ -1,6 +1,9 @@
 package chrepo
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // ActorSpendRow is one actor's total LLM cost over the queried range, keyed by
 // the user_email recorded on ClickHouse rows.
@@ -30,3 +33,49 @@ func (s ActorWindowSpendRow) SpendUSD(kind string) (float64, error) {
 		return 0, fmt.Errorf("unknown window kind %q", kind)
 	}
 }
+
+// ActorDimensionWindowSpendRow is one actor's fixed-window LLM cost for a
+// single usage-dimension tuple. Scoped spend rules sum the tuples their scope
+// expression matches; model_family is derived from Model by the caller.
+type ActorDimensionWindowSpendRow struct {
+	Email       string  `ch:"user_email" json:"email"`
+	ProjectID   string  `ch:"project_id" json:"project_id"`
+	Model       string  `ch:"model" json:"model"`
+	AssistantID string  `ch:"assistant_id" json:"assistant_id"`
+	ToolsetSlug string  `ch:"toolset_slug" json:"toolset_slug"`
+	DailyCost   float64 `ch:"m_daily_total_cost" json:"daily_cost"`
+	WeeklyCost  float64 `ch:"m_weekly_total_cost" json:"weekly_cost"`
+	MonthlyCost float64 `ch:"m_monthly_total_cost" json:"monthly_cost"`
+}
+
+// WindowSpend projects the dimension row onto the plain per-actor window
+// spend shape.
+func (s ActorDimensionWindowSpendRow) WindowSpend() ActorWindowSpendRow {
+	return ActorWindowSpendRow{
+		Email:       s.Email,
+		DailyCost:   s.DailyCost,
+		WeeklyCost:  s.WeeklyCost,
+		MonthlyCost: s.MonthlyCost,
+	}
+}
+
+// ActorSpendBucketRow is one actor's LLM cost within a single time bucket.
+// Spend rule simulation replays these buckets in order to find when an actor
+// would have crossed a draft rule's thresholds.
+type ActorSpendBucketRow struct {
+	Email     string    `ch:"user_email"`
+	Bucket    time.Time `ch:"bucket"`
+	TotalCost float64   `ch:"m_total_cost"`
+}
+
+// ActorDimensionSpendBucketRow is one actor's LLM cost within a single time
+// bucket for a single usage-dimension tuple, for simulating scoped rules.
+type ActorDimensionSpendBucketRow struct {
+	Email       string    `ch:"user_email"`
+	ProjectID   string    `ch:"project_id"`
+	Model       string    `ch:"model"`
+	AssistantID string    `ch:"assistant_id"`
+	ToolsetSlug string    `ch:"toolset_slug"`
+	Bucket      time.Time `ch:"bucket"`
+	TotalCost   float64   `ch:"m_total_cost"`
+}
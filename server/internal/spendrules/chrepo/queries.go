@@ -213,6 +213,214 @@ func (q *Queries) ListActorWindowSpendForRulesByEmail(
 	return out, nil
 }
 
+// LoadActorDimensionWindowSpend returns fixed-window spend broken down by
+// usage dimension, keyed by normalized email. Rows differing only in email
+// casing are merged per dimension tuple.
+func LoadActorDimensionWindowSpend(ctx context.Context, queries *Queries, projectIDs []string, now time.Time) (map[string][]ActorDimensionWindowSpendRow, error) {
+	return loadActorDimensionWindowSpend(ctx, queries, projectIDs, "", now)
+}
+
+// LoadActorDimensionWindowSpendByEmail is LoadActorDimensionWindowSpend
+// narrowed to one actor, for per-actor gate refreshes.
+func LoadActorDimensionWindowSpendByEmail(ctx context.Context, queries *Queries, projectIDs []string, email string, now time.Time) ([]ActorDimensionWindowSpendRow, error) {
+	email = conv.NormalizeEmail(email)
+	if email == "" {
+		return nil, nil
+	}
+	byEmail, err := loadActorDimensionWindowSpend(ctx, queries, projectIDs, email, now)
+	if err != nil {
+		return nil, err
+	}
+	return byEmail[email], nil
+}
+
+func loadActorDimensionWindowSpend(ctx context.Context, queries *Queries, projectIDs []string, email string, now time.Time) (map[string][]ActorDimensionWindowSpendRow, error) {
+	out := map[string][]ActorDimensionWindowSpendRow{}
+	if len(projectIDs) == 0 {
+		return out, nil
+	}
+
+	dailyStart, weeklyStart, monthlyStart := fixedWindowStarts(now)
+	timeStart := earliestTime(dailyStart, weeklyStart, monthlyStart)
+	rows, err := queries.ListActorDimensionWindowSpendForRules(
+		ctx,
+		projectIDs,
+		email,
+		dailyStart.UnixNano(),
+		weeklyStart.UnixNano(),
+		monthlyStart.UnixNano(),
+		timeStart.UnixNano(),
+		now.UnixNano(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list actor dimension window spend: %w", err)
+	}
+
+	type tupleKey struct {
+		email, projectID, model, assistantID, toolsetSlug string
+	}
+	index := map[tupleKey]int{}
+	for _, row := range rows {
+		normalized := conv.NormalizeEmail(row.Email)
+		if normalized == "" {
+			continue
+		}
+		key := tupleKey{normalized, row.ProjectID, row.Model, row.AssistantID, row.ToolsetSlug}
+		if i, ok := index[key]; ok {
+			existing := &out[normalized][i]
+			existing.DailyCost += row.DailyCost
+			existing.WeeklyCost += row.WeeklyCost
+			existing.MonthlyCost += row.MonthlyCost
+			continue
+		}
+		row.Email = normalized
+		index[key] = len(out[normalized])
+		out[normalized] = append(out[normalized], row)
+	}
+	return out, nil
+}
+
+// ListActorDimensionWindowSpendForRules returns per-actor, per-dimension-tuple
+// spend for the daily, weekly, and monthly fixed windows. An empty email
+// returns every actor; otherwise rows are narrowed to the normalized email.
+func (q *Queries) ListActorDimensionWindowSpendForRules(
+	ctx context.Context,
+	projectIDs []string,
+	email string,
+	dailyStart, weeklyStart, monthlyStart, timeStart, timeEnd int64,
+) ([]ActorDimensionWindowSpendRow, error) {
+	if len(projectIDs) == 0 {
+		return nil, nil
+	}
+
+	sb := sq.Select("user_email", "model", "assistant_id", "toolset_slug").
+		Column(squirrel.Expr("toString(gram_project_id) AS project_id")).
+		Column(squirrel.Expr("sumIf(total_cost, time_bucket >= toStartOfMinute(fromUnixTimestamp64Nano(?))) AS m_daily_total_cost", dailyStart)).
+		Column(squirrel.Expr("sumIf(total_cost, time_bucket >= toStartOfMinute(fromUnixTimestamp64Nano(?))) AS m_weekly_total_cost", weeklyStart)).
+		Column(squirrel.Expr("sumIf(total_cost, time_bucket >= toStartOfMinute(fromUnixTimestamp64Nano(?))) AS m_monthly_total_cost", monthlyStart)).
+		From("spend_rule_dimension_usage_summaries").
+		Where(squirrel.Eq{"gram_project_id": projectIDs}).
+		Where("time_bucket >= toStartOfMinute(fromUnixTimestamp64Nano(?))", timeStart).
+		Where("time_bucket <= toStartOfMinute(fromUnixTimestamp64Nano(?))", timeEnd).
+		Where("user_email != ''") //nolint:glint // fold-neutral emptiness check; spend enforcement identity semantics pending a product decision (DNO-857 tail)
+	if email != "" {
+		sb = sb.Where("lowerUTF8(user_email) = ?", email) //nolint:glint // spend ENFORCEMENT matches the literal actor email deliberately - folding changes blocking semantics and needs its own decision (DNO-857 tail)
+	}
+	sb = sb.GroupBy("user_email", "gram_project_id", "model", "assistant_id", "toolset_slug") //nolint:glint // actor rows keyed by literal email; casing collapsed in Go after read - enforcement fold pending decision (DNO-857 tail)
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building actor dimension window spend for rules query: %w", err)
+	}
+
+	rows, err := q.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query actor dimension window spend for rules: %w", err)
+	}
+	defer o11y.NoLogDefer(rows.Close)
+
+	var out []ActorDimensionWindowSpendRow
+	for rows.Next() {
+		var row ActorDimensionWindowSpendRow
+		if err = rows.ScanStruct(&row); err != nil {
+			return nil, fmt.Errorf("scanning actor dimension window spend for rules row: %w", err)
+		}
+		out = append(out, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate actor dimension window spend for rules rows: %w", err)
+	}
+	return out, nil
+}
+
+// ListActorSpendBucketsForRules returns per-actor spend over [timeStart,
+// timeEnd] grouped into buckets of bucketSeconds, aligned to the Unix epoch so
+// day-sized buckets start at midnight UTC. Rows are ordered by bucket.
+func (q *Queries) ListActorSpendBucketsForRules(ctx context.Context, projectIDs []string, bucketSeconds, timeStart, timeEnd int64) ([]ActorSpendBucketRow, error) {
+	if len(projectIDs) == 0 {
+		return nil, nil
+	}
+
+	sb := sq.Select("user_email").
+		Column(squirrel.Expr("toStartOfInterval(time_bucket, toIntervalSecond(?)) AS bucket", bucketSeconds)).
+		Column(squirrel.Expr("sum(total_cost) AS m_total_cost")).
+		From("spend_rule_usage_summaries").
+		Where(squirrel.Eq{"gram_project_id": projectIDs}).
+		Where("time_bucket >= toStartOfMinute(fromUnixTimestamp64Nano(?))", timeStart).
+		Where("time_bucket <= toStartOfMinute(fromUnixTimestamp64Nano(?))", timeEnd).
+		Where("user_email != ''"). //nolint:glint // fold-neutral emptiness check; spend enforcement identity semantics pending a product decision (DNO-857 tail)
+		OrderBy("bucket").
+		GroupBy("user_email", "bucket") //nolint:glint // actor rows keyed by literal email; casing collapsed in Go after read - enforcement fold pending decision (DNO-857 tail)
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building actor spend buckets for rules query: %w", err)
+	}
+
+	rows, err := q.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query actor spend buckets for rules: %w", err)
+	}
+	defer o11y.NoLogDefer(rows.Close)
+
+	var out []ActorSpendBucketRow
+	for rows.Next() {
+		var row ActorSpendBucketRow
+		if err = rows.ScanStruct(&row); err != nil {
+			return nil, fmt.Errorf("scanning actor spend buckets for rules row: %w", err)
+		}
+		out = append(out, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate actor spend buckets for rules rows: %w", err)
+	}
+	return out, nil
+}
+
+// ListActorDimensionSpendBucketsForRules is ListActorSpendBucketsForRules
+// additionally keyed by usage-dimension tuple, for simulating scoped rules.
+func (q *Queries) ListActorDimensionSpendBucketsForRules(ctx context.Context, projectIDs []string, bucketSeconds, timeStart, timeEnd int64) ([]ActorDimensionSpendBucketRow, error) {
+	if len(projectIDs) == 0 {
+		return nil, nil
+	}
+
+	sb := sq.Select("user_email", "model", "assistant_id", "toolset_slug").
+		Column(squirrel.Expr("toString(gram_project_id) AS project_id")).
+		Column(squirrel.Expr("toStartOfInterval(time_bucket, toIntervalSecond(?)) AS bucket", bucketSeconds)).
+		Column(squirrel.Expr("sum(total_cost) AS m_total_cost")).
+		From("spend_rule_dimension_usage_summaries").
+		Where(squirrel.Eq{"gram_project_id": projectIDs}).
+		Where("time_bucket >= toStartOfMinute(fromUnixTimestamp64Nano(?))", timeStart).
+		Where("time_bucket <= toStartOfMinute(fromUnixTimestamp64Nano(?))", timeEnd).
+		Where("user_email != ''"). //nolint:glint // fold-neutral emptiness check; spend enforcement identity semantics pending a product decision (DNO-857 tail)
+		OrderBy("bucket").
+		GroupBy("user_email", "gram_project_id", "model", "assistant_id", "toolset_slug", "bucket") //nolint:glint // actor rows keyed by literal email; casing collapsed in Go after read - enforcement fold pending decision (DNO-857 tail)
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building actor dimension spend buckets for rules query: %w", err)
+	}
+
+	rows, err := q.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query actor dimension spend buckets for rules: %w", err)
+	}
+	defer o11y.NoLogDefer(rows.Close)
+
+	var out []ActorDimensionSpendBucketRow
+	for rows.Next() {
+		var row ActorDimensionSpendBucketRow
+		if err = rows.ScanStruct(&row); err != nil {
+			return nil, fmt.Errorf("scanning actor dimension spend buckets for rules row: %w", err)
+		}
+		out = append(out, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate actor dimension spend buckets for rules rows: %w", err)
+	}
+	return out, nil
+}
+
 func (q *Queries) listActorSpend(ctx context.Context, query string, args []any, label string) ([]ActorSpendRow, error) {
 	rows, err := q.conn.Query(ctx, query, args...)
 	if err != nil {
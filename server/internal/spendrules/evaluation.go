@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/google/cel-go/cel"
+
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	"github.com/speakeasy-api/gram/server/internal/spendrules/celenv"
 	"github.com/speakeasy-api/gram/server/internal/spendrules/chrepo"
@@ -179,6 +181,93 @@ func BuildActorWindowUsages(matched []Actor, windowSpendByEmail map[string]chrep
 	return usages, nil
 }
 
+// UsageFromDimensionRow is the scope-expression view of one dimension spend
+// row, with model_family derived from the reported model.
+func UsageFromDimensionRow(row chrepo.ActorDimensionWindowSpendRow) celenv.Usage {
+	return celenv.Usage{
+		Model:       row.Model,
+		ModelFamily: celenv.ModelFamily(row.Model),
+		AssistantID: row.AssistantID,
+		ProjectID:   row.ProjectID,
+		ToolsetSlug: row.ToolsetSlug,
+	}
+}
+
+// ScopedWindowSpend sums one actor's dimension rows whose usage tuple satisfies
+// the compiled scope expression.
+func ScopedWindowSpend(eng *celenv.Engine, prg cel.Program, email string, rows []chrepo.ActorDimensionWindowSpendRow) (chrepo.ActorWindowSpendRow, error) {
+	spend := EmptyActorSpend(email)
+	for _, row := range rows {
+		ok, err := eng.EvalScope(prg, UsageFromDimensionRow(row))
+		if err != nil {
+			return chrepo.ActorWindowSpendRow{}, fmt.Errorf("evaluate scope expression for %s: %w", email, err)
+		}
+		if !ok {
+			continue
+		}
+		spend.DailyCost += row.DailyCost
+		spend.WeeklyCost += row.WeeklyCost
+		spend.MonthlyCost += row.MonthlyCost
+	}
+	return spend, nil
+}
+
+// RuleWindowSpend returns the per-actor window spend a rule is evaluated
+// against, keyed by normalized email. Unscoped rules use the plain rollup as
+// is; scoped rules sum only the dimension tuples their scope matches.
+func RuleWindowSpend(
+	eng *celenv.Engine,
+	scopeExpr string,
+	unscoped map[string]chrepo.ActorWindowSpendRow,
+	byDimension map[string][]chrepo.ActorDimensionWindowSpendRow,
+) (map[string]chrepo.ActorWindowSpendRow, error) {
+	if scopeExpr == "" {
+		return unscoped, nil
+	}
+	prg, err := eng.CompileScope(scopeExpr)
+	if err != nil {
+		return nil, fmt.Errorf("compile scope expression: %w", err)
+	}
+	scoped := make(map[string]chrepo.ActorWindowSpendRow, len(byDimension))
+	for email, rows := range byDimension {
+		spend, err := ScopedWindowSpend(eng, prg, email, rows)
+		if err != nil {
+			return nil, err
+		}
+		scoped[email] = spend
+	}
+	return scoped, nil
+}
+
+// EnforcedWindowSpend is RuleWindowSpend for enforcement. A scope that cannot
+// be evaluated falls back to the unscoped rollup, as the request-time gate
+// does: scoped spend never exceeds it, so the rule still warns and blocks
+// rather than being skipped. The scope error is returned with the fallback
+// for the caller to log.
+func EnforcedWindowSpend(
+	eng *celenv.Engine,
+	scopeExpr string,
+	unscoped map[string]chrepo.ActorWindowSpendRow,
+	byDimension map[string][]chrepo.ActorDimensionWindowSpendRow,
+) (map[string]chrepo.ActorWindowSpendRow, error) {
+	scoped, err := RuleWindowSpend(eng, scopeExpr, unscoped, byDimension)
+	if err != nil {
+		return unscoped, err
+	}
+	return scoped, nil
+}
+
+// HasScopedRule reports whether any rule restricts spend by usage dimension,
+// so callers only pay for the dimension rollup read when it is needed.
+func HasScopedRule(rules []repo.SpendRule) bool {
+	for _, rule := range rules {
+		if rule.ScopeExpr != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // EvalRuleUsages compiles the rule expression and marks each matched actor
 // whose actor+usage view satisfies the rule. The expression is the budget
 // breach predicate (for example: `spend_usd >= limit_usd`), while target_expr
@@ -7,6 +7,7 @@ import (
 
 	"github.com/speakeasy-api/gram/server/internal/spendrules"
 	"github.com/speakeasy-api/gram/server/internal/spendrules/celenv"
+	"github.com/speakeasy-api/gram/server/internal/spendrules/chrepo"
 )
 
 func testActors() []spendrules.Actor {
@@ -217,3 +218,30 @@ func TestRuleStatusDerivation(t *testing.T) {
 	require.Equal(t, spendrules.StatusHealthy,
 		spendrules.RuleStatus(spendrules.ActionBlock, 80, nil))
 }
+
+func TestEnforcedWindowSpendFallsBackOnInvalidScope(t *testing.T) {
+	t.Parallel()
+
+	eng := newEngine(t)
+	unscoped := map[string]chrepo.ActorWindowSpendRow{
+		"ada@acme.com": {Email: "ada@acme.com", DailyCost: 40, WeeklyCost: 120, MonthlyCost: 300},
+	}
+	byDimension := map[string][]chrepo.ActorDimensionWindowSpendRow{
+		"ada@acme.com": {
+			{Email: "ada@acme.com", ProjectID: "p1", Model: "claude-opus", AssistantID: "", ToolsetSlug: "", DailyCost: 10, WeeklyCost: 30, MonthlyCost: 75},
+		},
+	}
+
+	// A scope stored before validation tightened, or broken by an engine
+	// change, must not exempt the rule.
+	spend, err := spendrules.EnforcedWindowSpend(eng, "usage.model ==", unscoped, byDimension)
+	require.Error(t, err)
+	require.Equal(t, unscoped, spend)
+
+	usages, err := spendrules.BuildActorWindowUsages(testActors()[:1], spend, "weekly", 100)
+	require.NoError(t, err)
+	usages, err = spendrules.EvalRuleUsages(eng, spendrules.DefaultRuleExpr, 80, usages)
+	require.NoError(t, err)
+	require.Len(t, usages, 1)
+	require.True(t, usages[0].Breached)
+}
@@ -52,6 +52,7 @@ type GateRule struct {
 	RuleName    string    `json:"rule_name"`
 	Action      string    `json:"action"`
 	TargetExpr  string    `json:"target_expr"`
+	ScopeExpr   string    `json:"scope_expr,omitempty"`
 	RuleExpr    string    `json:"rule_expr"`
 	LimitUSD    float64   `json:"limit_usd"`
 	WarnAtPct   int32     `json:"warn_at_pct"`
@@ -65,13 +66,17 @@ type GateRules struct {
 	Rules           []GateRule `json:"rules"`
 }
 
+// GateActor is one actor's request-path spend snapshot. DimensionSpend breaks
+// the same spend down by usage dimension so scoped rules can be evaluated on
+// the hot path; it is empty when no rule in the organization is scoped.
 type GateActor struct {
-	ComputedAt  time.Time                  `json:"computed_at"`
-	UserID      string                     `json:"user_id"`
-	Email       string                     `json:"email"`
-	DisplayName string                     `json:"display_name"`
-	Attrs       celenv.Actor               `json:"attrs"`
-	Spend       chrepo.ActorWindowSpendRow `json:"spend"`
+	ComputedAt     time.Time                             `json:"computed_at"`
+	UserID         string                                `json:"user_id"`
+	Email          string                                `json:"email"`
+	DisplayName    string                                `json:"display_name"`
+	Attrs          celenv.Actor                          `json:"attrs"`
+	Spend          chrepo.ActorWindowSpendRow            `json:"spend"`
+	DimensionSpend []chrepo.ActorDimensionWindowSpendRow `json:"dimension_spend,omitempty"`
 }
 
 type mutatingCache interface {
@@ -90,8 +95,9 @@ func spendGateActorKey(organizationID, userID string) string {
 }
 
 // Gate is the hot-path spend check consulted by the Claude hooks handlers
-// before risk-policy scans. Reads stay Redis-only; every failure mode resolves
-// to "not blocked" (fail-open) so a cache outage never denies traffic.
+// before risk-policy scans. Reads stay Redis-only; a cache outage resolves to
+// "not blocked" (fail-open) so it never denies traffic, while a scoped rule
+// whose scope cannot be evaluated is enforced on the actor's unscoped spend.
 type Gate struct {
 	logger       *slog.Logger
 	cache        cache.Cache
@@ -170,7 +176,21 @@ func (g *Gate) CheckBlocked(ctx context.Context, organizationID, userID string)
 			continue
 		}
 
-		spendUSD, err := actor.Spend.SpendUSD(rule.WindowKind)
+		spend := actor.Spend
+		if rule.ScopeExpr != "" {
+			// A scope that cannot be evaluated falls back to the actor's
+			// unscoped spend rather than skipping the rule: scoped spend never
+			// exceeds it, so a hard block still holds when the scope breaks.
+			if scoped, err := g.scopedSpend(rule.ScopeExpr, actor); err == nil {
+				spend = scoped
+			} else {
+				g.logger.WarnContext(ctx, "enforce scoped spend rule on unscoped spend",
+					attr.SlogError(err),
+					attr.SlogOrganizationID(organizationID),
+				)
+			}
+		}
+		spendUSD, err := spend.SpendUSD(rule.WindowKind)
 		if err != nil {
 			g.logger.WarnContext(ctx, "read spend gate window spend",
 				attr.SlogError(err),
@@ -209,6 +229,21 @@ func (g *Gate) CheckBlocked(ctx context.Context, organizationID, userID string)
 	return nil, nil
 }
 
+// scopedSpend folds the actor's dimension spend down to the tuples the rule
+// scope matches. Scope programs share the compiled-program cache under a
+// prefixed key: the same source text can compile in both environments.
+func (g *Gate) scopedSpend(scopeExpr string, actor GateActor) (chrepo.ActorWindowSpendRow, error) {
+	prg, err := g.compileWith(scopeProgramKeyPrefix+scopeExpr, scopeExpr, g.celEng.CompileScope)
+	if err != nil {
+		return chrepo.ActorWindowSpendRow{}, err
+	}
+	spend, err := ScopedWindowSpend(g.celEng, prg, actor.Email, actor.DimensionSpend)
+	if err != nil {
+		return chrepo.ActorWindowSpendRow{}, fmt.Errorf("evaluate spend gate scope expression: %w", err)
+	}
+	return spend, nil
+}
+
 func (g *Gate) eval(ctx context.Context, organizationID, expr string, actor celenv.Actor) (bool, error) {
 	prg, err := g.compile(expr)
 	if err != nil {
@@ -230,19 +265,27 @@ func (g *Gate) eval(ctx context.Context, organizationID, expr string, actor cele
 	return ok, nil
 }
 
+// scopeProgramKeyPrefix namespaces compiled scope programs in the gate's
+// program cache.
+const scopeProgramKeyPrefix = "scope\x00"
+
 func (g *Gate) compile(expr string) (cel.Program, error) {
-	if prg, ok := g.programs.Load(expr); ok {
+	return g.compileWith(expr, expr, g.celEng.Compile)
+}
+
+func (g *Gate) compileWith(key, expr string, compileFn func(string) (cel.Program, error)) (cel.Program, error) {
+	if prg, ok := g.programs.Load(key); ok {
 		typed, ok := prg.(cel.Program)
 		if !ok {
 			return nil, fmt.Errorf("cached spend gate program has unexpected type %T", prg)
 		}
 		return typed, nil
 	}
-	prg, err := g.celEng.Compile(expr)
+	prg, err := compileFn(expr)
 	if err != nil {
 		return nil, fmt.Errorf("compile spend gate expression: %w", err)
 	}
-	actual, loaded := g.programs.LoadOrStore(expr, prg)
+	actual, loaded := g.programs.LoadOrStore(key, prg)
 	if !loaded && g.programCount.Add(1) > maxGatePrograms {
 		// Soft bound: flush wholesale once the cache outgrows the cap so
 		// repeated rule edits cannot grow program memory without limit. The
@@ -258,14 +301,15 @@ func (g *Gate) compile(expr string) (cel.Program, error) {
 	return typed, nil
 }
 
-func NewGateActor(actor Actor, spend chrepo.ActorWindowSpendRow, computedAt time.Time) GateActor {
+func NewGateActor(actor Actor, spend chrepo.ActorWindowSpendRow, dimensionSpend []chrepo.ActorDimensionWindowSpendRow, computedAt time.Time) GateActor {
 	return GateActor{
-		ComputedAt:  computedAt.UTC(),
-		UserID:      actor.UserID,
-		Email:       actor.Email,
-		DisplayName: actor.DisplayName,
-		Attrs:       actor.Attrs,
-		Spend:       spend,
+		ComputedAt:     computedAt.UTC(),
+		UserID:         actor.UserID,
+		Email:          actor.Email,
+		DisplayName:    actor.DisplayName,
+		Attrs:          actor.Attrs,
+		Spend:          spend,
+		DimensionSpend: dimensionSpend,
 	}
 }
 
@@ -345,7 +389,8 @@ func WriteGateActor(ctx context.Context, cacheImpl cache.Cache, organizationID s
 				UsedPct:        0,
 				WarnAtPct:      0,
 			},
-			Spend: chrepo.ActorWindowSpendRow{Email: "", DailyCost: 0, WeeklyCost: 0, MonthlyCost: 0},
+			Spend:          chrepo.ActorWindowSpendRow{Email: "", DailyCost: 0, WeeklyCost: 0, MonthlyCost: 0},
+			DimensionSpend: nil,
 		}
 		if err := mutable.Mutate(ctx, key, &current, spendGateTTL, func(exists bool) error {
 			if exists && current.ComputedAt.After(actor.ComputedAt) {
@@ -126,7 +126,7 @@ func writeGateRules(t *testing.T, ctx context.Context, cacheImpl *gateCache, org
 func writeGateActor(t *testing.T, ctx context.Context, cacheImpl *gateCache, organizationID string, actor spendrules.Actor, spend chrepo.ActorWindowSpendRow) {
 	t.Helper()
 
-	require.NoError(t, spendrules.WriteGateActor(ctx, cacheImpl, organizationID, spendrules.NewGateActor(actor, spend, time.Now().UTC())))
+	require.NoError(t, spendrules.WriteGateActor(ctx, cacheImpl, organizationID, spendrules.NewGateActor(actor, spend, nil, time.Now().UTC())))
 }
 
 func TestGateEvaluatesRuleCELAgainstCachedUsage(t *testing.T) {
@@ -231,6 +231,103 @@ func TestGateEvaluatesTargetCELBeforeRuleCEL(t *testing.T) {
 	require.Nil(t, block)
 }
 
+func TestGateEvaluatesScopedRuleAgainstMatchingDimensions(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	cacheImpl := newGateCache()
+	actors := testActors()
+	writeGateRules(t, ctx, cacheImpl, "org_123", spendrules.GateRule{
+		RuleURN:     "spend_rule:opus:v1",
+		RuleName:    "Opus budget",
+		Action:      spendrules.ActionBlock,
+		TargetExpr:  `department_name == "Engineering"`,
+		ScopeExpr:   `model_family == "opus"`,
+		RuleExpr:    `spend_usd >= limit_usd`,
+		LimitUSD:    100,
+		WarnAtPct:   80,
+		WindowKind:  spendrules.WindowMonthly,
+		WindowStart: time.Now().UTC().Add(-time.Hour),
+		WindowEnd:   time.Now().UTC().AddDate(0, 0, 7),
+	})
+	dimensionRow := func(model string, monthlyCost float64) chrepo.ActorDimensionWindowSpendRow {
+		return chrepo.ActorDimensionWindowSpendRow{
+			Email:       "ada@acme.com",
+			ProjectID:   "project_1",
+			Model:       model,
+			AssistantID: "",
+			ToolsetSlug: "",
+			DailyCost:   0,
+			WeeklyCost:  0,
+			MonthlyCost: monthlyCost,
+		}
+	}
+	// The actor's total spend breaches the limit, but only the Opus share counts
+	// against an Opus-scoped rule.
+	require.NoError(t, spendrules.WriteGateActor(ctx, cacheImpl, "org_123", spendrules.NewGateActor(actors[0], chrepo.ActorWindowSpendRow{
+		Email:       "ada@acme.com",
+		DailyCost:   0,
+		WeeklyCost:  0,
+		MonthlyCost: 150,
+	}, []chrepo.ActorDimensionWindowSpendRow{
+		dimensionRow("claude-opus-4-1", 60),
+		dimensionRow("claude-sonnet-4-5", 90),
+	}, time.Now().UTC())))
+	gate := newTestGate(t, cacheImpl)
+
+	block, err := gate.CheckBlocked(ctx, "org_123", "user_ada")
+	require.NoError(t, err)
+	require.Nil(t, block)
+
+	require.NoError(t, spendrules.WriteGateActor(ctx, cacheImpl, "org_123", spendrules.NewGateActor(actors[0], chrepo.ActorWindowSpendRow{
+		Email:       "ada@acme.com",
+		DailyCost:   0,
+		WeeklyCost:  0,
+		MonthlyCost: 150,
+	}, []chrepo.ActorDimensionWindowSpendRow{
+		dimensionRow("claude-opus-4-1", 120),
+		dimensionRow("claude-sonnet-4-5", 30),
+	}, time.Now().UTC())))
+
+	block, err = gate.CheckBlocked(ctx, "org_123", "user_ada")
+	require.NoError(t, err)
+	require.NotNil(t, block)
+	require.Equal(t, "spend_rule:opus:v1", block.RuleURN)
+}
+
+func TestGateEnforcesUnevaluableScopeOnUnscopedSpend(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	cacheImpl := newGateCache()
+	actors := testActors()
+	writeGateRules(t, ctx, cacheImpl, "org_123", spendrules.GateRule{
+		RuleURN:     "spend_rule:broken:v1",
+		RuleName:    "Broken scope",
+		Action:      spendrules.ActionBlock,
+		TargetExpr:  `department_name == "Engineering"`,
+		ScopeExpr:   `model_family ==`,
+		RuleExpr:    `spend_usd >= limit_usd`,
+		LimitUSD:    100,
+		WarnAtPct:   80,
+		WindowKind:  spendrules.WindowMonthly,
+		WindowStart: time.Now().UTC().Add(-time.Hour),
+		WindowEnd:   time.Now().UTC().AddDate(0, 0, 7),
+	})
+	require.NoError(t, spendrules.WriteGateActor(ctx, cacheImpl, "org_123", spendrules.NewGateActor(actors[0], chrepo.ActorWindowSpendRow{
+		Email:       "ada@acme.com",
+		DailyCost:   0,
+		WeeklyCost:  0,
+		MonthlyCost: 150,
+	}, nil, time.Now().UTC())))
+	gate := newTestGate(t, cacheImpl)
+
+	block, err := gate.CheckBlocked(ctx, "org_123", "user_ada")
+	require.NoError(t, err)
+	require.NotNil(t, block)
+	require.Equal(t, "spend_rule:broken:v1", block.RuleURN)
+}
+
 func TestGateSkipsActorComputedBeforeWindowStart(t *testing.T) {
 	t.Parallel()
 
@@ -255,7 +352,7 @@ func TestGateSkipsActorComputedBeforeWindowStart(t *testing.T) {
 		DailyCost:   0,
 		WeeklyCost:  0,
 		MonthlyCost: 150,
-	}, windowStart.Add(-time.Second))))
+	}, nil, windowStart.Add(-time.Second))))
 
 	gate := newTestGate(t, cacheImpl)
 	block, err := gate.CheckBlocked(ctx, "org_123", "user_ada")
@@ -316,7 +413,7 @@ func TestWriteGateActorNoopCacheDoesNotFail(t *testing.T) {
 	t.Parallel()
 
 	actor := testActors()[0]
-	err := spendrules.WriteGateActor(t.Context(), cache.NoopCache, "org_123", spendrules.NewGateActor(actor, spendrules.EmptyActorSpend(actor.Email), time.Now().UTC()))
+	err := spendrules.WriteGateActor(t.Context(), cache.NoopCache, "org_123", spendrules.NewGateActor(actor, spendrules.EmptyActorSpend(actor.Email), nil, time.Now().UTC()))
 	require.NoError(t, err)
 }
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -163,6 +164,10 @@ func (s *Service) CreateSpendRule(ctx context.Context, payload *gen.CreateSpendR
 	if _, err := s.celEng.Compile(targetExpr); err != nil {
 		return nil, oops.E(oops.CodeBadRequest, err, "invalid target expression: %s", err.Error())
 	}
+	scopeExpr, err := s.scopeExprFromCondition(payload.Scope)
+	if err != nil {
+		return nil, err
+	}
 	ruleExpr := DefaultRuleExpr
 	if _, err := s.celEng.Compile(ruleExpr); err != nil {
 		return nil, oops.E(oops.CodeBadRequest, err, "invalid rule expression: %s", err.Error())
@@ -187,6 +192,7 @@ func (s *Service) CreateSpendRule(ctx context.Context, payload *gen.CreateSpendR
 		Slug:           slug,
 		Description:    payload.Description,
 		TargetExpr:     targetExpr,
+		ScopeExpr:      scopeExpr,
 		RuleExpr:       ruleExpr,
 		LimitUsdCents:  limitUSDCents,
 		WindowKind:     payload.WindowKind,
@@ -273,6 +279,30 @@ func (s *Service) ListActorAttributes(ctx context.Context, payload *gen.ListActo
 	return &gen.ListActorAttributesResult{Attributes: attributes}, nil
 }
 
+// ListUsageDimensions returns the static catalog of usage dimensions a scope
+// condition may reference. The source of truth is celenv.UsageDimensions,
+// shared with the scope CEL environment and scope validation.
+func (s *Service) ListUsageDimensions(ctx context.Context, payload *gen.ListUsageDimensionsPayload) (*gen.ListUsageDimensionsResult, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeOrgAdmin, ResourceKind: "", ResourceID: authCtx.ActiveOrganizationID, Dimensions: nil}); err != nil {
+		return nil, err
+	}
+
+	dimensions := make([]*gen.UsageDimension, 0, len(celenv.UsageDimensions))
+	for _, d := range celenv.UsageDimensions {
+		dimensions = append(dimensions, &gen.UsageDimension{
+			Name:        d.Name,
+			Description: d.Description,
+		})
+	}
+
+	return &gen.ListUsageDimensionsResult{Dimensions: dimensions}, nil
+}
+
 func (s *Service) GetSpendRule(ctx context.Context, payload *gen.GetSpendRulePayload) (*types.SpendRule, error) {
 	authCtx, ok := contextvalues.GetAuthContext(ctx)
 	if !ok || authCtx == nil {
@@ -343,6 +373,10 @@ func (s *Service) UpdateSpendRule(ctx context.Context, payload *gen.UpdateSpendR
 			return nil, oops.E(oops.CodeBadRequest, err, "invalid target expression: %s", err.Error())
 		}
 	}
+	suppliedScopeExpr, err := s.scopeExprFromCondition(payload.Scope)
+	if err != nil {
+		return nil, err
+	}
 
 	dbtx, err := s.db.Begin(ctx)
 	if err != nil {
@@ -372,6 +406,13 @@ func (s *Service) UpdateSpendRule(ctx context.Context, payload *gen.UpdateSpendR
 	if payload.Target != nil {
 		targetExpr = suppliedTargetExpr
 	}
+	scopeExpr := existing.ScopeExpr
+	switch {
+	case payload.Scope != nil:
+		scopeExpr = suppliedScopeExpr
+	case conv.PtrValOr(payload.ClearScope, false):
+		scopeExpr = ""
+	}
 	ruleExpr := existing.RuleExpr
 	limitUSDCents := existing.LimitUsdCents
 	if payload.LimitUsd != nil {
@@ -394,6 +435,7 @@ func (s *Service) UpdateSpendRule(ctx context.Context, payload *gen.UpdateSpendR
 	configChanged := name != existing.Name ||
 		description != existing.Description ||
 		targetExpr != existing.TargetExpr ||
+		scopeExpr != existing.ScopeExpr ||
 		ruleExpr != existing.RuleExpr ||
 		limitUSDCents != existing.LimitUsdCents ||
 		windowKind != existing.WindowKind ||
@@ -432,6 +474,7 @@ func (s *Service) UpdateSpendRule(ctx context.Context, payload *gen.UpdateSpendR
 			Slug:           existing.Slug,
 			Description:    description,
 			TargetExpr:     targetExpr,
+			ScopeExpr:      scopeExpr,
 			RuleExpr:       ruleExpr,
 			LimitUsdCents:  limitUSDCents,
 			WindowKind:     windowKind,
@@ -587,6 +630,11 @@ func (s *Service) PreviewSpendRule(ctx context.Context, payload *gen.PreviewSpen
 		return nil, oops.E(oops.CodeBadRequest, err, "invalid target")
 	}
 
+	scopeExpr, err := s.scopeExprFromCondition(payload.Scope)
+	if err != nil {
+		return nil, err
+	}
+
 	matched, err := MatchActors(s.celEng, targetExpr, actors)
 	if err != nil {
 		return nil, oops.E(oops.CodeBadRequest, err, "invalid target expression: %s", err.Error())
@@ -594,7 +642,11 @@ func (s *Service) PreviewSpendRule(ctx context.Context, payload *gen.PreviewSpen
 
 	spendByEmail := map[string]float64{}
 	if len(matched) > 0 {
-		spendByEmail, err = s.actorSpendByEmail(ctx, authCtx.ActiveOrganizationID, windowStart, now)
+		if scopeExpr == "" {
+			spendByEmail, err = s.actorSpendByEmail(ctx, authCtx.ActiveOrganizationID, windowStart, now)
+		} else {
+			spendByEmail, err = s.scopedActorSpendByEmail(ctx, authCtx.ActiveOrganizationID, scopeExpr, payload.WindowKind, now)
+		}
 		if err != nil {
 			return nil, oops.E(oops.CodeUnexpected, err, "load actor spend").LogError(ctx, s.logger)
 		}
@@ -631,6 +683,66 @@ func (s *Service) PreviewSpendRule(ctx context.Context, payload *gen.PreviewSpen
 	}, nil
 }
 
+func (s *Service) SimulateSpendRule(ctx context.Context, payload *gen.SimulateSpendRulePayload) (*gen.SimulateSpendRuleResult, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.Check{Scope: authz.ScopeOrgAdmin, ResourceKind: "", ResourceID: authCtx.ActiveOrganizationID, Dimensions: nil}); err != nil {
+		return nil, err
+	}
+
+	if payload.LimitUsd <= 0 {
+		return nil, oops.E(oops.CodeBadRequest, nil, "limit must be greater than zero")
+	}
+	if payload.Target == nil {
+		return nil, oops.E(oops.CodeBadRequest, nil, "target is required")
+	}
+	now := time.Now().UTC()
+	windows, err := RecentWindows(payload.WindowKind, now, payload.Windows)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid simulation windows")
+	}
+
+	targetExpr, err := targetConditionExpr(payload.Target.Attribute, payload.Target.Operator, payload.Target.Value)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid target")
+	}
+
+	scopeExpr, err := s.scopeExprFromCondition(payload.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	actors, err := LoadActors(ctx, repo.New(s.db), authCtx.ActiveOrganizationID)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "load org actors").LogError(ctx, s.logger)
+	}
+
+	matched, err := MatchActors(s.celEng, targetExpr, actors)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid target expression: %s", err.Error())
+	}
+
+	var buckets []chrepo.ActorSpendBucketRow
+	if len(matched) > 0 {
+		buckets, err = s.simulationSpendBuckets(ctx, authCtx.ActiveOrganizationID, scopeExpr, SimulationBucket(payload.WindowKind), windows[0].Start, now)
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "load actor spend history").LogError(ctx, s.logger)
+		}
+	}
+
+	// Simulated rules always use DefaultRuleExpr, so a failure to evaluate it is
+	// a server fault rather than anything the caller sent.
+	simulated, err := SimulateRule(s.celEng, DefaultRuleExpr, int32(payload.WarnAtPct), payload.LimitUsd, matched, windows, buckets) //nolint:gosec // design constrains warn_at_pct to 1..100
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "simulate spend rule").LogError(ctx, s.logger)
+	}
+
+	return buildSimulationResult(payload.Action, len(matched), windows, simulated, now), nil
+}
+
 func (s *Service) ListSpendRuleEvents(ctx context.Context, payload *gen.ListSpendRuleEventsPayload) (*gen.ListSpendRuleEventsResult, error) {
 	authCtx, ok := contextvalues.GetAuthContext(ctx)
 	if !ok || authCtx == nil {
@@ -753,6 +865,13 @@ func (s *Service) GetSpendRulesOverview(ctx context.Context, payload *gen.GetSpe
 	if err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "load actor window spend").LogError(ctx, s.logger)
 	}
+	var actorDimensionSpend map[string][]chrepo.ActorDimensionWindowSpendRow
+	if HasScopedRule(rules) {
+		actorDimensionSpend, err = chrepo.LoadActorDimensionWindowSpend(ctx, chrepo.New(s.chConn), projectIDs, now)
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "load actor dimension window spend").LogError(ctx, s.logger)
+		}
+	}
 
 	matchedEmails := map[string]struct{}{}
 	breachedEmails := map[string]struct{}{}
@@ -770,9 +889,15 @@ func (s *Service) GetSpendRulesOverview(ctx context.Context, payload *gen.GetSpe
 			return nil, oops.E(oops.CodeUnexpected, err, "compute window bounds").LogError(ctx, s.logger)
 		}
 
+		// The overview reports the spend the rule is enforced on.
+		ruleSpend, err := EnforcedWindowSpend(s.celEng, rule.ScopeExpr, actorWindowSpend, actorDimensionSpend)
+		if err != nil {
+			s.logger.WarnContext(ctx, "report scoped spend rule on unscoped spend", attr.SlogError(err), attr.SlogOrganizationID(rule.OrganizationID))
+		}
+
 		usages := []ActorUsage{}
 		if len(matched) > 0 {
-			usages, err = BuildActorWindowUsages(matched, actorWindowSpend, rule.WindowKind, limitUSD)
+			usages, err = BuildActorWindowUsages(matched, ruleSpend, rule.WindowKind, limitUSD)
 			if err != nil {
 				return nil, oops.E(oops.CodeUnexpected, err, "load actor spend").LogError(ctx, s.logger)
 			}
@@ -784,12 +909,12 @@ func (s *Service) GetSpendRulesOverview(ctx context.Context, payload *gen.GetSpe
 			continue
 		}
 
-		ruleSpend := 0.0
+		spendUSD := 0.0
 		usersWarned := 0
 		usersBreached := 0
 		worstPct := 0.0
 		for _, u := range usages {
-			ruleSpend += u.SpendUSD
+			spendUSD += u.SpendUSD
 			email := conv.NormalizeEmail(u.Actor.Email)
 			matchedEmails[email] = struct{}{}
 			if u.Breached {
@@ -809,7 +934,7 @@ func (s *Service) GetSpendRulesOverview(ctx context.Context, payload *gen.GetSpe
 		budget := limitUSD * float64(len(matched))
 		status := RuleStatus(rule.Action, rule.WarnAtPct, usages)
 
-		result.TotalSpendUsd += ruleSpend
+		result.TotalSpendUsd += spendUSD
 		result.TotalBudgetUsd += budget
 		if status != StatusHealthy {
 			result.RulesUnhealthy++
@@ -820,7 +945,7 @@ func (s *Service) GetSpendRulesOverview(ctx context.Context, payload *gen.GetSpe
 			MatchedUsers:  len(matched),
 			UsersWarned:   usersWarned,
 			UsersBreached: usersBreached,
-			SpendUsd:      ruleSpend,
+			SpendUsd:      spendUSD,
 			BudgetUsd:     budget,
 			WorstUsedPct:  worstPct,
 			Status:        status,
@@ -857,6 +982,90 @@ func (s *Service) actorSpendByEmail(ctx context.Context, organizationID string,
 	return spend, nil
 }
 
+// simulationSpendBuckets reads bucketed actor spend across the organization's
+// projects over [from, to] for replaying a draft rule. Scoped rules read the
+// dimension rollup and keep only the tuples the scope expression matches.
+func (s *Service) simulationSpendBuckets(ctx context.Context, organizationID, scopeExpr string, bucket time.Duration, from, to time.Time) ([]chrepo.ActorSpendBucketRow, error) {
+	projects, err := projectsRepo.New(s.db).ListProjectsByOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("list organization projects: %w", err)
+	}
+	projectIDs := make([]string, 0, len(projects))
+	for _, p := range projects {
+		projectIDs = append(projectIDs, p.ID.String())
+	}
+
+	queries := chrepo.New(s.chConn)
+	bucketSeconds := int64(bucket / time.Second)
+	if scopeExpr == "" {
+		rows, err := queries.ListActorSpendBucketsForRules(ctx, projectIDs, bucketSeconds, from.UnixNano(), to.UnixNano())
+		if err != nil {
+			return nil, fmt.Errorf("list actor spend buckets: %w", err)
+		}
+		return rows, nil
+	}
+
+	rows, err := queries.ListActorDimensionSpendBucketsForRules(ctx, projectIDs, bucketSeconds, from.UnixNano(), to.UnixNano())
+	if err != nil {
+		return nil, fmt.Errorf("list actor dimension spend buckets: %w", err)
+	}
+	scoped, err := ScopedSpendBuckets(s.celEng, scopeExpr, rows)
+	if err != nil {
+		return nil, fmt.Errorf("scope actor spend buckets: %w", err)
+	}
+	return scoped, nil
+}
+
+// scopedActorSpendByEmail sums each actor's current-window spend across the
+// organization's projects, restricted to the usage dimensions the scope
+// expression matches, keyed by normalized email.
+func (s *Service) scopedActorSpendByEmail(ctx context.Context, organizationID, scopeExpr, windowKind string, now time.Time) (map[string]float64, error) {
+	projects, err := projectsRepo.New(s.db).ListProjectsByOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, fmt.Errorf("list organization projects: %w", err)
+	}
+	projectIDs := make([]string, 0, len(projects))
+	for _, p := range projects {
+		projectIDs = append(projectIDs, p.ID.String())
+	}
+
+	byDimension, err := chrepo.LoadActorDimensionWindowSpend(ctx, chrepo.New(s.chConn), projectIDs, now)
+	if err != nil {
+		return nil, fmt.Errorf("load actor dimension window spend: %w", err)
+	}
+	scoped, err := RuleWindowSpend(s.celEng, scopeExpr, nil, byDimension)
+	if err != nil {
+		return nil, fmt.Errorf("scope actor spend: %w", err)
+	}
+
+	spend := make(map[string]float64, len(scoped))
+	for email, row := range scoped {
+		usd, err := row.SpendUSD(windowKind)
+		if err != nil {
+			return nil, fmt.Errorf("select scoped actor spend: %w", err)
+		}
+		spend[email] = usd
+	}
+	return spend, nil
+}
+
+// scopeExprFromCondition converts an optional structured scope condition into
+// its CEL expression, validating it against the scope environment. A nil
+// condition yields the empty (unscoped) expression.
+func (s *Service) scopeExprFromCondition(scope *types.SpendRuleScopeCondition) (string, error) {
+	if scope == nil {
+		return "", nil
+	}
+	scopeExpr, err := scopeConditionExpr(scope.Dimension, scope.Operator, scope.Value)
+	if err != nil {
+		return "", oops.E(oops.CodeBadRequest, err, "invalid scope")
+	}
+	if _, err := s.celEng.CompileScope(scopeExpr); err != nil {
+		return "", oops.E(oops.CodeBadRequest, err, "invalid scope expression: %s", err.Error())
+	}
+	return scopeExpr, nil
+}
+
 func (s *Service) signalEvaluation(ctx context.Context, organizationID string) {
 	if s.signaler == nil {
 		return
@@ -1053,6 +1262,132 @@ func targetConditionFromExpr(expr string) *types.SpendRuleTargetCondition {
 	}
 }
 
+func scopeConditionExpr(dimension, operator, value string) (string, error) {
+	if !celenv.IsUsageDimension(dimension) {
+		return "", fmt.Errorf("unknown scope dimension %q", dimension)
+	}
+	quoted := strconv.Quote(value)
+	switch operator {
+	case "equals":
+		return dimension + " == " + quoted, nil
+	case "not_equals":
+		return dimension + " != " + quoted, nil
+	case "starts_with":
+		return dimension + ".startsWith(" + quoted + ")", nil
+	case "ends_with":
+		return dimension + ".endsWith(" + quoted + ")", nil
+	case "contains":
+		return dimension + ".contains(" + quoted + ")", nil
+	case "matches":
+		return dimension + ".matches(" + quoted + ")", nil
+	default:
+		return "", fmt.Errorf("unknown scope operator %q", operator)
+	}
+}
+
+// scopeConditionFromExpr recovers the structured scope condition from a
+// stored scope expression. Scope conditions share the target condition's
+// expression shapes, so the target parser does the work; an empty expression
+// means the rule is unscoped.
+func scopeConditionFromExpr(expr string) *types.SpendRuleScopeCondition {
+	if expr == "" {
+		return nil
+	}
+	cond := targetConditionFromExpr(expr)
+	return &types.SpendRuleScopeCondition{
+		Dimension: cond.Attribute,
+		Operator:  cond.Operator,
+		Value:     cond.Value,
+	}
+}
+
+// buildSimulationResult summarizes a replayed rule per window and lists the
+// actors who would have crossed a threshold at least once, most breached
+// windows first, then by peak usage.
+func buildSimulationResult(action string, matchedCount int, windows []Window, simulated []SimulatedActor, now time.Time) *gen.SimulateSpendRuleResult {
+	windowViews := make([]*gen.SpendRuleSimulationWindow, 0, len(windows))
+	for _, window := range windows {
+		windowViews = append(windowViews, &gen.SpendRuleSimulationWindow{
+			WindowStart:   window.Start.Format(time.RFC3339),
+			WindowEnd:     window.End.Format(time.RFC3339),
+			InProgress:    !now.Before(window.Start) && now.Before(window.End),
+			SpendUsd:      0,
+			UsersWarned:   0,
+			UsersBreached: 0,
+		})
+	}
+
+	type rankedActor struct {
+		view    *gen.SpendRuleSimulationActor
+		peakPct float64
+	}
+	ranked := make([]rankedActor, 0, len(simulated))
+	for _, sim := range simulated {
+		view := &gen.SpendRuleSimulationActor{
+			Email:           sim.Actor.Email,
+			DisplayName:     conv.PtrEmpty(sim.Actor.DisplayName),
+			UserID:          conv.PtrEmpty(sim.Actor.UserID),
+			WindowsWarned:   0,
+			WindowsBreached: 0,
+			Timeline:        make([]*gen.SpendRuleSimulationActorWindow, 0, len(sim.Windows)),
+		}
+		peakPct := 0.0
+		for i, w := range sim.Windows {
+			windowViews[i].SpendUsd += w.SpendUSD
+			if !w.WarnedAt.IsZero() {
+				view.WindowsWarned++
+				windowViews[i].UsersWarned++
+			}
+			if !w.BreachedAt.IsZero() {
+				view.WindowsBreached++
+				windowViews[i].UsersBreached++
+			}
+			peakPct = max(peakPct, w.UsedPct)
+			view.Timeline = append(view.Timeline, &gen.SpendRuleSimulationActorWindow{
+				WindowStart: w.Window.Start.Format(time.RFC3339),
+				WindowEnd:   w.Window.End.Format(time.RFC3339),
+				SpendUsd:    w.SpendUSD,
+				UsedPct:     w.UsedPct,
+				WarnedAt:    formatSimulationTime(w.WarnedAt),
+				BreachedAt:  formatSimulationTime(w.BreachedAt),
+				Blocked:     action == ActionBlock && !w.BreachedAt.IsZero(),
+			})
+		}
+		if view.WindowsWarned == 0 {
+			continue
+		}
+		ranked = append(ranked, rankedActor{view: view, peakPct: peakPct})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].view.WindowsBreached != ranked[j].view.WindowsBreached {
+			return ranked[i].view.WindowsBreached > ranked[j].view.WindowsBreached
+		}
+		return ranked[i].peakPct > ranked[j].peakPct
+	})
+	if len(ranked) > previewActorCap {
+		ranked = ranked[:previewActorCap]
+	}
+	actorViews := make([]*gen.SpendRuleSimulationActor, 0, len(ranked))
+	for _, r := range ranked {
+		actorViews = append(actorViews, r.view)
+	}
+
+	return &gen.SimulateSpendRuleResult{
+		MatchedCount: matchedCount,
+		Action:       action,
+		Windows:      windowViews,
+		Actors:       actorViews,
+	}
+}
+
+func formatSimulationTime(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	return new(t.Format(time.RFC3339))
+}
+
 func buildSpendRuleView(row repo.SpendRule) *types.SpendRule {
 	return &types.SpendRule{
 		ID:             row.ID.String(),
@@ -1063,6 +1398,8 @@ func buildSpendRuleView(row repo.SpendRule) *types.SpendRule {
 		Description:    row.Description,
 		Target:         targetConditionFromExpr(row.TargetExpr),
 		TargetExpr:     row.TargetExpr,
+		Scope:          scopeConditionFromExpr(row.ScopeExpr),
+		ScopeExpr:      row.ScopeExpr,
 		RuleExpr:       row.RuleExpr,
 		LimitUsd:       row.LimitUsdCents.USD(),
 		WindowKind:     row.WindowKind,
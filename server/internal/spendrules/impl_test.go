@@ -114,6 +114,35 @@ func TestCreateSpendRule_RejectsInvalidExpression(t *testing.T) {
 	require.Equal(t, oops.CodeBadRequest, oopsErr.Code)
 }
 
+func TestCreateSpendRule_WithScope(t *testing.T) {
+	t.Parallel()
+	ctx, ti := newTestSpendRulesService(t)
+	ctx = withOrgAdmin(t, ctx, ti.conn)
+
+	payload := createTestRulePayload()
+	payload.Scope = &types.SpendRuleScopeCondition{
+		Dimension: "model_family",
+		Operator:  "equals",
+		Value:     "opus",
+	}
+	result, err := ti.service.CreateSpendRule(ctx, payload)
+	require.NoError(t, err)
+	require.Equal(t, payload.Scope, result.Scope)
+	require.Equal(t, `model_family == "opus"`, result.ScopeExpr)
+
+	payload = createTestRulePayload()
+	payload.Scope = &types.SpendRuleScopeCondition{
+		Dimension: "department_name",
+		Operator:  "equals",
+		Value:     "Engineering",
+	}
+	_, err = ti.service.CreateSpendRule(ctx, payload)
+	require.Error(t, err)
+	var oopsErr *oops.ShareableError
+	require.ErrorAs(t, err, &oopsErr)
+	require.Equal(t, oops.CodeBadRequest, oopsErr.Code)
+}
+
 func TestCreateSpendRule_RejectsEmptyNameAndZeroLimit(t *testing.T) {
 	t.Parallel()
 	ctx, ti := newTestSpendRulesService(t)
@@ -420,6 +449,28 @@ func TestPreviewSpendRuleWithNoMatchingMembers(t *testing.T) {
 	require.Empty(t, result.Actors)
 }
 
+func TestSimulateSpendRuleWithNoMatchingMembers(t *testing.T) {
+	t.Parallel()
+	ctx, ti := newTestSpendRulesService(t)
+	ctx = withOrgAdmin(t, ctx, ti.conn)
+
+	result, err := ti.service.SimulateSpendRule(ctx, &gen.SimulateSpendRulePayload{
+		Target:     createTestRulePayload().Target,
+		LimitUsd:   500,
+		WarnAtPct:  80,
+		WindowKind: "weekly",
+		Action:     "block",
+		Windows:    4,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, result.MatchedCount)
+	require.Equal(t, "block", result.Action)
+	require.Len(t, result.Windows, 4)
+	require.True(t, result.Windows[3].InProgress)
+	require.False(t, result.Windows[0].InProgress)
+	require.Empty(t, result.Actors)
+}
+
 func TestGetSpendRulesOverviewWithNoRules(t *testing.T) {
 	t.Parallel()
 	ctx, ti := newTestSpendRulesService(t)
@@ -9,6 +9,7 @@ INSERT INTO spend_rules (
   , slug
   , description
   , target_expr
+  , scope_expr
   , rule_expr
   , limit_usd_cents
   , window_kind
@@ -23,6 +24,7 @@ VALUES (
   , @slug
   , @description
   , @target_expr
+  , @scope_expr
   , @rule_expr
   , @limit_usd_cents
   , @window_kind
@@ -17,6 +17,7 @@ type SpendRule struct {
 	Slug           string
 	Description    string
 	TargetExpr     string
+	ScopeExpr      string
 	LimitUsdCents  money.Cents
 	RuleExpr       string
 	WindowKind     string
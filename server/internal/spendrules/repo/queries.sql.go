@@ -20,7 +20,7 @@ SET archived_at = clock_timestamp()
 WHERE id = $1
   AND organization_id = $2
   AND archived IS FALSE
-RETURNING id, organization_id, name, slug, description, target_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
+RETURNING id, organization_id, name, slug, description, target_expr, scope_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
 `
 
 type ArchiveSpendRuleParams struct {
@@ -40,6 +40,7 @@ func (q *Queries) ArchiveSpendRule(ctx context.Context, arg ArchiveSpendRulePara
 		&i.Slug,
 		&i.Description,
 		&i.TargetExpr,
+		&i.ScopeExpr,
 		&i.LimitUsdCents,
 		&i.RuleExpr,
 		&i.WindowKind,
@@ -63,6 +64,7 @@ INSERT INTO spend_rules (
   , slug
   , description
   , target_expr
+  , scope_expr
   , rule_expr
   , limit_usd_cents
   , window_kind
@@ -84,8 +86,9 @@ VALUES (
   , $10
   , $11
   , $12
+  , $13
 )
-RETURNING id, organization_id, name, slug, description, target_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
+RETURNING id, organization_id, name, slug, description, target_expr, scope_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
 `
 
 type CreateSpendRuleParams struct {
@@ -94,6 +97,7 @@ type CreateSpendRuleParams struct {
 	Slug           string
 	Description    string
 	TargetExpr     string
+	ScopeExpr      string
 	RuleExpr       string
 	LimitUsdCents  money.Cents
 	WindowKind     string
@@ -114,6 +118,7 @@ func (q *Queries) CreateSpendRule(ctx context.Context, arg CreateSpendRuleParams
 		arg.Slug,
 		arg.Description,
 		arg.TargetExpr,
+		arg.ScopeExpr,
 		arg.RuleExpr,
 		arg.LimitUsdCents,
 		arg.WindowKind,
@@ -130,6 +135,7 @@ func (q *Queries) CreateSpendRule(ctx context.Context, arg CreateSpendRuleParams
 		&i.Slug,
 		&i.Description,
 		&i.TargetExpr,
+		&i.ScopeExpr,
 		&i.LimitUsdCents,
 		&i.RuleExpr,
 		&i.WindowKind,
@@ -147,7 +153,7 @@ func (q *Queries) CreateSpendRule(ctx context.Context, arg CreateSpendRuleParams
 }
 
 const getArchivedSpendRule = `-- name: GetArchivedSpendRule :one
-SELECT id, organization_id, name, slug, description, target_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
+SELECT id, organization_id, name, slug, description, target_expr, scope_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
 FROM spend_rules
 WHERE id = $1
   AND organization_id = $2
@@ -171,6 +177,7 @@ func (q *Queries) GetArchivedSpendRule(ctx context.Context, arg GetArchivedSpend
 		&i.Slug,
 		&i.Description,
 		&i.TargetExpr,
+		&i.ScopeExpr,
 		&i.LimitUsdCents,
 		&i.RuleExpr,
 		&i.WindowKind,
@@ -188,7 +195,7 @@ func (q *Queries) GetArchivedSpendRule(ctx context.Context, arg GetArchivedSpend
 }
 
 const getSpendRule = `-- name: GetSpendRule :one
-SELECT id, organization_id, name, slug, description, target_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
+SELECT id, organization_id, name, slug, description, target_expr, scope_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
 FROM spend_rules
 WHERE id = $1
   AND organization_id = $2
@@ -211,6 +218,7 @@ func (q *Queries) GetSpendRule(ctx context.Context, arg GetSpendRuleParams) (Spe
 		&i.Slug,
 		&i.Description,
 		&i.TargetExpr,
+		&i.ScopeExpr,
 		&i.LimitUsdCents,
 		&i.RuleExpr,
 		&i.WindowKind,
@@ -228,7 +236,7 @@ func (q *Queries) GetSpendRule(ctx context.Context, arg GetSpendRuleParams) (Spe
 }
 
 const getSpendRuleForUpdate = `-- name: GetSpendRuleForUpdate :one
-SELECT id, organization_id, name, slug, description, target_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
+SELECT id, organization_id, name, slug, description, target_expr, scope_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
 FROM spend_rules
 WHERE id = $1
   AND organization_id = $2
@@ -251,6 +259,7 @@ func (q *Queries) GetSpendRuleForUpdate(ctx context.Context, arg GetSpendRuleFor
 		&i.Slug,
 		&i.Description,
 		&i.TargetExpr,
+		&i.ScopeExpr,
 		&i.LimitUsdCents,
 		&i.RuleExpr,
 		&i.WindowKind,
@@ -332,7 +341,7 @@ func (q *Queries) InsertSpendRuleEvent(ctx context.Context, arg InsertSpendRuleE
 }
 
 const listEnabledSpendRules = `-- name: ListEnabledSpendRules :many
-SELECT id, organization_id, name, slug, description, target_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
+SELECT id, organization_id, name, slug, description, target_expr, scope_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
 FROM spend_rules
 WHERE organization_id = $1
   AND enabled IS TRUE
@@ -356,6 +365,7 @@ func (q *Queries) ListEnabledSpendRules(ctx context.Context, organizationID stri
 			&i.Slug,
 			&i.Description,
 			&i.TargetExpr,
+			&i.ScopeExpr,
 			&i.LimitUsdCents,
 			&i.RuleExpr,
 			&i.WindowKind,
@@ -595,7 +605,7 @@ func (q *Queries) ListSpendRuleEvents(ctx context.Context, arg ListSpendRuleEven
 }
 
 const listSpendRules = `-- name: ListSpendRules :many
-SELECT id, organization_id, name, slug, description, target_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
+SELECT id, organization_id, name, slug, description, target_expr, scope_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
 FROM spend_rules
 WHERE organization_id = $1
   AND archived IS FALSE
@@ -618,6 +628,7 @@ func (q *Queries) ListSpendRules(ctx context.Context, organizationID string) ([]
 			&i.Slug,
 			&i.Description,
 			&i.TargetExpr,
+			&i.ScopeExpr,
 			&i.LimitUsdCents,
 			&i.RuleExpr,
 			&i.WindowKind,
@@ -692,7 +703,7 @@ SET enabled = $1
 WHERE id = $2
   AND organization_id = $3
   AND archived IS FALSE
-RETURNING id, organization_id, name, slug, description, target_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
+RETURNING id, organization_id, name, slug, description, target_expr, scope_expr, limit_usd_cents, rule_expr, window_kind, warn_at_pct, action, enabled, version, created_at, updated_at, archived_at, archived, superseded_by
 `
 
 type ToggleSpendRuleEnabledParams struct {
@@ -714,6 +725,7 @@ func (q *Queries) ToggleSpendRuleEnabled(ctx context.Context, arg ToggleSpendRul
 		&i.Slug,
 		&i.Description,
 		&i.TargetExpr,
+		&i.ScopeExpr,
 		&i.LimitUsdCents,
 		&i.RuleExpr,
 		&i.WindowKind,
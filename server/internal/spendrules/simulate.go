@@ -0,0 +1,173 @@
+package spendrules
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/spendrules/celenv"
+	"github.com/speakeasy-api/gram/server/internal/spendrules/chrepo"
+)
+
+// SimulatedWindow is one actor's replayed outcome for a single window.
+// WarnedAt and BreachedAt are the start of the spend bucket in which the
+// actor's cumulative window spend first reached the warning threshold or
+// satisfied the rule expression; both are zero when the threshold was never
+// crossed.
+type SimulatedWindow struct {
+	Window     Window
+	SpendUSD   float64
+	UsedPct    float64
+	WarnedAt   time.Time
+	BreachedAt time.Time
+}
+
+// SimulatedActor is one matched actor's replayed timeline. Windows is
+// parallel to the windows the rule was simulated over.
+type SimulatedActor struct {
+	Actor   Actor
+	Windows []SimulatedWindow
+}
+
+// SimulationBucket returns the spend bucket size used to replay a window
+// kind. Monthly windows replay day by day to bound the rows read for long
+// look-backs; shorter windows replay hour by hour.
+func SimulationBucket(windowKind string) time.Duration {
+	if windowKind == WindowMonthly {
+		return 24 * time.Hour
+	}
+	return time.Hour
+}
+
+// ScopedSpendBuckets collapses dimension-keyed spend buckets into plain
+// per-actor buckets, keeping only the tuples the scope expression matches.
+func ScopedSpendBuckets(eng *celenv.Engine, scopeExpr string, rows []chrepo.ActorDimensionSpendBucketRow) ([]chrepo.ActorSpendBucketRow, error) {
+	prg, err := eng.CompileScope(scopeExpr)
+	if err != nil {
+		return nil, fmt.Errorf("compile scope expression: %w", err)
+	}
+	out := make([]chrepo.ActorSpendBucketRow, 0, len(rows))
+	for _, row := range rows {
+		ok, err := eng.EvalScope(prg, celenv.Usage{
+			Model:       row.Model,
+			ModelFamily: celenv.ModelFamily(row.Model),
+			AssistantID: row.AssistantID,
+			ProjectID:   row.ProjectID,
+			ToolsetSlug: row.ToolsetSlug,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("evaluate scope expression for %s: %w", row.Email, err)
+		}
+		if !ok {
+			continue
+		}
+		out = append(out, chrepo.ActorSpendBucketRow{
+			Email:     row.Email,
+			Bucket:    row.Bucket,
+			TotalCost: row.TotalCost,
+		})
+	}
+	return out, nil
+}
+
+// simulationStep ties one replayed usage row back to its actor and bucket.
+type simulationStep struct {
+	actor  int
+	bucket time.Time
+}
+
+// SimulateRule replays a draft rule over historical spend. For every matched
+// actor and window it accumulates the actor's bucketed spend in time order
+// and records when the cumulative spend first crossed the warning threshold
+// and first satisfied the rule expression. Each cumulative step is evaluated
+// as an independent usage row, so a single EvalRuleUsages pass covers a
+// whole window.
+func SimulateRule(
+	eng *celenv.Engine,
+	ruleExpr string,
+	warnAtPct int32,
+	limitUSD float64,
+	matched []Actor,
+	windows []Window,
+	buckets []chrepo.ActorSpendBucketRow,
+) ([]SimulatedActor, error) {
+	bucketsByEmail := make(map[string][]chrepo.ActorSpendBucketRow, len(matched))
+	for _, row := range buckets {
+		email := conv.NormalizeEmail(row.Email)
+		if email == "" {
+			continue
+		}
+		bucketsByEmail[email] = append(bucketsByEmail[email], row)
+	}
+	for _, rows := range bucketsByEmail {
+		sort.SliceStable(rows, func(i, j int) bool { return rows[i].Bucket.Before(rows[j].Bucket) })
+	}
+
+	out := make([]SimulatedActor, len(matched))
+	for i, actor := range matched {
+		out[i] = SimulatedActor{Actor: actor, Windows: make([]SimulatedWindow, len(windows))}
+	}
+
+	for w, window := range windows {
+		var usages []ActorUsage
+		var steps []simulationStep
+		for i, actor := range matched {
+			cumulative := 0.0
+			appendStep := func(bucket time.Time) {
+				usages = append(usages, ActorUsage{
+					Actor:    actor,
+					SpendUSD: cumulative,
+					LimitUSD: limitUSD,
+					UsedPct:  usedPct(cumulative, limitUSD),
+					Breached: false,
+				})
+				steps = append(steps, simulationStep{actor: i, bucket: bucket})
+			}
+
+			// Seed the zero-spend state at the window start so a rule that
+			// already holds before any spend lands is reported from the reset.
+			appendStep(window.Start)
+			for _, row := range bucketsByEmail[conv.NormalizeEmail(actor.Email)] {
+				if row.Bucket.Before(window.Start) || !row.Bucket.Before(window.End) {
+					continue
+				}
+				cumulative += row.TotalCost
+				appendStep(row.Bucket)
+			}
+
+			out[i].Windows[w] = SimulatedWindow{
+				Window:     window,
+				SpendUSD:   cumulative,
+				UsedPct:    usedPct(cumulative, limitUSD),
+				WarnedAt:   time.Time{},
+				BreachedAt: time.Time{},
+			}
+		}
+
+		evaluated, err := EvalRuleUsages(eng, ruleExpr, warnAtPct, usages)
+		if err != nil {
+			return nil, err
+		}
+		// Steps are appended in time order per actor, so the first crossing
+		// seen for an actor is the earliest one.
+		for k, usage := range evaluated {
+			sim := &out[steps[k].actor].Windows[w]
+			if sim.WarnedAt.IsZero() && (usage.Breached || usage.UsedPct >= float64(warnAtPct)) {
+				sim.WarnedAt = steps[k].bucket
+			}
+			if sim.BreachedAt.IsZero() && usage.Breached {
+				sim.BreachedAt = steps[k].bucket
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func usedPct(spendUSD, limitUSD float64) float64 {
+	if limitUSD <= 0 {
+		return 0
+	}
+	return spendUSD / limitUSD * 100
+}
@@ -0,0 +1,76 @@
+package spendrules_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/spendrules"
+	"github.com/speakeasy-api/gram/server/internal/spendrules/chrepo"
+)
+
+func TestSimulateRuleRecordsFirstCrossingPerWindow(t *testing.T) {
+	t.Parallel()
+
+	eng := newEngine(t)
+	windows := []spendrules.Window{
+		{Start: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC)},
+		{Start: time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 7, 3, 0, 0, 0, 0, time.UTC)},
+	}
+	at := func(day, hour int) time.Time { return time.Date(2026, 7, day, hour, 0, 0, 0, time.UTC) }
+	buckets := []chrepo.ActorSpendBucketRow{
+		// Ada warns at 10:00 and breaches at 14:00 on day one, then resets.
+		{Email: "ada@acme.com", Bucket: at(1, 14), TotalCost: 30},
+		{Email: "ada@acme.com", Bucket: at(1, 9), TotalCost: 50},
+		{Email: "ada@acme.com", Bucket: at(1, 10), TotalCost: 35},
+		{Email: "ada@acme.com", Bucket: at(2, 8), TotalCost: 10},
+		// Sam's casing variants accumulate into one actor.
+		{Email: "sam@acme.com", Bucket: at(2, 3), TotalCost: 60},
+		{Email: "SAM@acme.com", Bucket: at(2, 5), TotalCost: 25},
+		// Outside every window; ignored.
+		{Email: "ada@acme.com", Bucket: at(3, 1), TotalCost: 500},
+	}
+
+	simulated, err := spendrules.SimulateRule(eng, spendrules.DefaultRuleExpr, 80, 100, testActors()[:2], windows, buckets)
+	require.NoError(t, err)
+	require.Len(t, simulated, 2)
+
+	ada := simulated[0]
+	require.Equal(t, "ada@acme.com", ada.Actor.Email)
+	require.Len(t, ada.Windows, 2)
+	require.InDelta(t, 115.0, ada.Windows[0].SpendUSD, 0.001)
+	require.Equal(t, at(1, 10), ada.Windows[0].WarnedAt)
+	require.Equal(t, at(1, 14), ada.Windows[0].BreachedAt)
+	require.InDelta(t, 10.0, ada.Windows[1].SpendUSD, 0.001)
+	require.True(t, ada.Windows[1].WarnedAt.IsZero())
+	require.True(t, ada.Windows[1].BreachedAt.IsZero())
+
+	sam := simulated[1]
+	require.True(t, sam.Windows[0].WarnedAt.IsZero())
+	require.InDelta(t, 85.0, sam.Windows[1].SpendUSD, 0.001)
+	require.Equal(t, at(2, 5), sam.Windows[1].WarnedAt)
+	require.True(t, sam.Windows[1].BreachedAt.IsZero())
+}
+
+func TestSimulateRuleRejectsInvalidRuleExpression(t *testing.T) {
+	t.Parallel()
+
+	windows := []spendrules.Window{{Start: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC)}}
+	_, err := spendrules.SimulateRule(newEngine(t), "spend_usd >", 80, 100, testActors(), windows, nil)
+	require.Error(t, err)
+}
+
+func TestScopedSpendBucketsKeepsMatchingTuples(t *testing.T) {
+	t.Parallel()
+
+	bucket := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	rows := []chrepo.ActorDimensionSpendBucketRow{
+		{Email: "ada@acme.com", ProjectID: "p1", Model: "claude-opus-4-1", AssistantID: "", ToolsetSlug: "", Bucket: bucket, TotalCost: 40},
+		{Email: "ada@acme.com", ProjectID: "p1", Model: "claude-sonnet-4-5", AssistantID: "", ToolsetSlug: "", Bucket: bucket, TotalCost: 10},
+	}
+
+	scoped, err := spendrules.ScopedSpendBuckets(newEngine(t), `model_family == "opus"`, rows)
+	require.NoError(t, err)
+	require.Equal(t, []chrepo.ActorSpendBucketRow{{Email: "ada@acme.com", Bucket: bucket, TotalCost: 40}}, scoped)
+}
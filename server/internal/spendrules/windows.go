@@ -33,3 +33,28 @@ func WindowBounds(kind string, now time.Time) (start time.Time, end time.Time, e
 		return time.Time{}, time.Time{}, fmt.Errorf("unknown window kind %q", kind)
 	}
 }
+
+// Window is one UTC calendar budget window; End is exclusive.
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// RecentWindows returns the n most recent windows of the given kind, oldest
+// first. The last window is the one containing now and is still in progress.
+func RecentWindows(kind string, now time.Time, n int) ([]Window, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("window count must be positive, got %d", n)
+	}
+	windows := make([]Window, n)
+	at := now
+	for i := n - 1; i >= 0; i-- {
+		start, end, err := WindowBounds(kind, at)
+		if err != nil {
+			return nil, err
+		}
+		windows[i] = Window{Start: start, End: end}
+		at = start.Add(-time.Nanosecond)
+	}
+	return windows, nil
+}
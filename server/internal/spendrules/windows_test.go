@@ -73,3 +73,23 @@ func TestWindowBoundsRejectsUnknownKind(t *testing.T) {
 	_, _, err := spendrules.WindowBounds("fortnightly", time.Now())
 	require.Error(t, err)
 }
+
+func TestRecentWindowsMonthlyOldestFirst(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	windows, err := spendrules.RecentWindows(spendrules.WindowMonthly, now, 3)
+	require.NoError(t, err)
+	require.Equal(t, []spendrules.Window{
+		{Start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{Start: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Start: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}, windows)
+}
+
+func TestRecentWindowsRejectsNonPositiveCount(t *testing.T) {
+	t.Parallel()
+
+	_, err := spendrules.RecentWindows(spendrules.WindowDaily, time.Now(), 0)
+	require.Error(t, err)
+}
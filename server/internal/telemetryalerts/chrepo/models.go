@@ -0,0 +1,13 @@
+package chrepo
+
+// WindowStatsRow is the tool call volume, failures and latency quantiles for
+// one alert rule's scope over its evaluation window. Quantiles are in
+// milliseconds and are NaN when the window has no calls.
+type WindowStatsRow struct {
+	Calls      uint64  `ch:"calls"`
+	Failures   uint64  `ch:"failures"`
+	LatencyP50 float64 `ch:"latency_p50"`
+	LatencyP90 float64 `ch:"latency_p90"`
+	LatencyP95 float64 `ch:"latency_p95"`
+	LatencyP99 float64 `ch:"latency_p99"`
+}
@@ -0,0 +1,78 @@
+package chrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+)
+
+// sq is the squirrel statement builder pre-configured for ClickHouse.
+var sq = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Question)
+
+// failureExpr and latencyMsExpr match the definitions used by the tool
+// metrics breakdown, so an alert lines up with what the dashboard shows.
+const (
+	failureExpr   = "toInt32OrZero(toString(attributes.http.response.status_code)) >= 400"
+	latencyMsExpr = "toFloat64OrZero(toString(attributes.http.server.request.duration)) * 1000"
+)
+
+// WindowStatsParams scopes a window stats query. ToolsetSlug and ToolURN are
+// optional; leaving them empty widens the scope to every toolset or tool in
+// the project.
+type WindowStatsParams struct {
+	ProjectID   string
+	ToolsetSlug string
+	ToolURN     string
+	Start       int64
+	End         int64
+}
+
+// GetWindowStats returns tool call counts and latency quantiles for the
+// window [Start, End] in the given scope.
+func (q *Queries) GetWindowStats(ctx context.Context, arg WindowStatsParams) (WindowStatsRow, error) {
+	sb := sq.Select(
+		"count(*) AS calls",
+		"countIf("+failureExpr+") AS failures",
+		"quantile(0.5)("+latencyMsExpr+") AS latency_p50",
+		"quantile(0.9)("+latencyMsExpr+") AS latency_p90",
+		"quantile(0.95)("+latencyMsExpr+") AS latency_p95",
+		"quantile(0.99)("+latencyMsExpr+") AS latency_p99",
+	).
+		From("telemetry_logs").
+		Where("gram_project_id = ?", arg.ProjectID).
+		Where("time_unix_nano >= ?", arg.Start).
+		Where("time_unix_nano <= ?", arg.End).
+		Where("startsWith(gram_urn, 'tools:')")
+	if arg.ToolsetSlug != "" {
+		sb = sb.Where("toolset_slug = ?", arg.ToolsetSlug)
+	}
+	if arg.ToolURN != "" {
+		sb = sb.Where("gram_urn = ?", arg.ToolURN)
+	}
+
+	var row WindowStatsRow
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return row, fmt.Errorf("building window stats query: %w", err)
+	}
+
+	rows, err := q.conn.Query(ctx, query, args...)
+	if err != nil {
+		return row, fmt.Errorf("query window stats: %w", err)
+	}
+	defer o11y.NoLogDefer(rows.Close)
+
+	if rows.Next() {
+		if err = rows.ScanStruct(&row); err != nil {
+			return row, fmt.Errorf("scanning window stats row: %w", err)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return row, fmt.Errorf("iterate window stats rows: %w", err)
+	}
+	return row, nil
+}
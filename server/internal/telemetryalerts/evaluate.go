@@ -0,0 +1,246 @@
+package telemetryalerts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+	"github.com/speakeasy-api/gram/server/internal/outbox/events"
+	"github.com/speakeasy-api/gram/server/internal/telemetryalerts/chrepo"
+	"github.com/speakeasy-api/gram/server/internal/telemetryalerts/repo"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+// EvaluateResult summarizes one sweep. Transitions lists the rules that fired
+// or resolved so the caller can notify their recipients. FailedRuleIDs lists
+// the rules that could not be evaluated; their state was left unchanged.
+type EvaluateResult struct {
+	Evaluated     int          `json:"evaluated"`
+	Fired         int          `json:"fired"`
+	Resolved      int          `json:"resolved"`
+	Transitions   []Transition `json:"transitions"`
+	FailedRuleIDs []uuid.UUID  `json:"failed_rule_ids"`
+}
+
+// Transition is one rule moving between ok and firing, with everything needed
+// to notify its recipients without reading the rule again.
+type Transition struct {
+	RuleID           uuid.UUID `json:"rule_id"`
+	RuleName         string    `json:"rule_name"`
+	IncidentID       uuid.UUID `json:"incident_id"`
+	State            State     `json:"state"`
+	OrganizationID   string    `json:"organization_id"`
+	OrganizationSlug string    `json:"organization_slug"`
+	ProjectID        uuid.UUID `json:"project_id"`
+	ProjectSlug      string    `json:"project_slug"`
+	ToolsetSlug      string    `json:"toolset_slug"`
+	ToolURN          string    `json:"tool_urn"`
+	Metric           Metric    `json:"metric"`
+	Threshold        float64   `json:"threshold"`
+	Value            float64   `json:"value"`
+	Calls            uint64    `json:"calls"`
+	Failures         uint64    `json:"failures"`
+	WindowStart      time.Time `json:"window_start"`
+	WindowEnd        time.Time `json:"window_end"`
+	EmailRecipients  []string  `json:"email_recipients"`
+}
+
+// Evaluator runs the alert sweep: read each enabled rule's window from
+// ClickHouse, compare it against the threshold, and record the outcome,
+// announcing the rules whose state changed.
+type Evaluator struct {
+	logger    *slog.Logger
+	db        *pgxpool.Pool
+	chQueries *chrepo.Queries
+}
+
+func NewEvaluator(logger *slog.Logger, db *pgxpool.Pool, chQueries *chrepo.Queries) *Evaluator {
+	return &Evaluator{
+		logger:    logger.With(attr.SlogComponent("telemetry_alerts")),
+		db:        db,
+		chQueries: chQueries,
+	}
+}
+
+// Evaluate sweeps every enabled rule over the window ending at now. A failure
+// on one rule is logged, reported in FailedRuleIDs, and the sweep carries on;
+// the rule is picked up again on the next run because its state was never
+// changed. Per-rule failures are not returned as an error: the transitions
+// already committed can only be announced from this result, and a retried
+// sweep would not see them again.
+func (e *Evaluator) Evaluate(ctx context.Context, now time.Time) (EvaluateResult, error) {
+	result := EvaluateResult{Evaluated: 0, Fired: 0, Resolved: 0, Transitions: nil, FailedRuleIDs: nil}
+	if e.chQueries == nil {
+		return result, nil
+	}
+
+	rules, err := repo.New(e.db).ListEnabledTelemetryAlertRules(ctx)
+	if err != nil {
+		return result, fmt.Errorf("list enabled telemetry alert rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		transition, err := e.evaluateRule(ctx, rule, now)
+		if err != nil {
+			e.logger.ErrorContext(ctx, "evaluate telemetry alert rule",
+				attr.SlogProjectID(rule.ProjectID.String()),
+				attr.SlogTelemetryAlertRuleID(rule.ID.String()),
+				attr.SlogError(err),
+			)
+			result.FailedRuleIDs = append(result.FailedRuleIDs, rule.ID)
+			continue
+		}
+		result.Evaluated++
+
+		if transition == nil {
+			continue
+		}
+		switch transition.State {
+		case StateFiring:
+			result.Fired++
+		case StateOK:
+			result.Resolved++
+		}
+		result.Transitions = append(result.Transitions, *transition)
+	}
+
+	return result, nil
+}
+
+func (e *Evaluator) evaluateRule(ctx context.Context, rule repo.ListEnabledTelemetryAlertRulesRow, now time.Time) (*Transition, error) {
+	metric := Metric(rule.Metric)
+	windowStart := now.Add(-time.Duration(rule.WindowSeconds) * time.Second)
+
+	row, err := e.chQueries.GetWindowStats(ctx, chrepo.WindowStatsParams{
+		ProjectID:   rule.ProjectID.String(),
+		ToolsetSlug: rule.ToolsetSlug.String,
+		ToolURN:     rule.ToolUrn.String,
+		Start:       windowStart.UnixNano(),
+		End:         now.UnixNano(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get window stats: %w", err)
+	}
+	stats := windowStatsFromRow(row)
+
+	value, hasValue := stats.Value(metric)
+	lastValue := pgtype.Float8{Float64: value, Valid: hasValue}
+
+	current := State(rule.State)
+	next := StateOK
+	if stats.Breached(metric, rule.Threshold, rule.MinCalls) {
+		next = StateFiring
+	}
+
+	if next == current {
+		if err := repo.New(e.db).RecordTelemetryAlertEvaluation(ctx, repo.RecordTelemetryAlertEvaluationParams{
+			LastValue:   lastValue,
+			EvaluatedAt: conv.ToPGTimestamptz(now),
+			ID:          rule.ID,
+			State:       string(current),
+		}); err != nil {
+			return nil, fmt.Errorf("record evaluation: %w", err)
+		}
+		return nil, nil
+	}
+
+	dbtx, err := e.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	// A firing opens a new incident; a resolution keeps the one it ends.
+	incidentID := uuid.NullUUID{UUID: uuid.Nil, Valid: false}
+	if next == StateFiring {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("generate incident id: %w", err)
+		}
+		incidentID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+
+	updated, err := repo.New(dbtx).TransitionTelemetryAlertRule(ctx, repo.TransitionTelemetryAlertRuleParams{
+		ToState:     string(next),
+		IncidentID:  incidentID,
+		EvaluatedAt: conv.ToPGTimestamptz(now),
+		LastValue:   lastValue,
+		ID:          rule.ID,
+		FromState:   string(current),
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// Disabled, deleted or already moved by an overlapping sweep.
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("transition rule: %w", err)
+	}
+
+	transition := Transition{
+		RuleID:           rule.ID,
+		RuleName:         updated.Name,
+		IncidentID:       updated.IncidentID.UUID,
+		State:            next,
+		OrganizationID:   rule.OrganizationID,
+		OrganizationSlug: rule.OrganizationSlug,
+		ProjectID:        rule.ProjectID,
+		ProjectSlug:      rule.ProjectSlug,
+		ToolsetSlug:      rule.ToolsetSlug.String,
+		ToolURN:          rule.ToolUrn.String,
+		Metric:           metric,
+		Threshold:        updated.Threshold,
+		Value:            value,
+		Calls:            stats.Calls,
+		Failures:         stats.Failures,
+		WindowStart:      windowStart,
+		WindowEnd:        now,
+		EmailRecipients:  updated.EmailRecipients,
+	}
+
+	event := events.TelemetryAlertFiringV1
+	if next == StateOK {
+		event = events.TelemetryAlertResolvedV1
+	}
+	if _, err := outbox.PublishWebhookEvent(ctx, dbtx, rule.OrganizationID, event, events.TelemetryAlertPayloadV1{
+		OrganizationID: transition.OrganizationID,
+		ProjectID:      transition.ProjectID,
+		RuleID:         transition.RuleID,
+		RuleURN:        urn.NewTelemetryAlertRule(transition.RuleID).String(),
+		RuleName:       transition.RuleName,
+		IncidentID:     transition.IncidentID,
+		ToolsetSlug:    transition.ToolsetSlug,
+		ToolURN:        transition.ToolURN,
+		Metric:         string(transition.Metric),
+		Value:          transition.Value,
+		Threshold:      transition.Threshold,
+		Calls:          transition.Calls,
+		Failures:       transition.Failures,
+		WindowStart:    transition.WindowStart,
+		WindowEnd:      transition.WindowEnd,
+	}); err != nil {
+		return nil, fmt.Errorf("publish transition: %w", err)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	e.logger.InfoContext(ctx, "telemetry alert rule changed state",
+		attr.SlogProjectID(rule.ProjectID.String()),
+		attr.SlogTelemetryAlertRuleID(rule.ID.String()),
+		attr.SlogTelemetryAlertState(string(next)),
+	)
+
+	return &transition, nil
+}
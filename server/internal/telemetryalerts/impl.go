@@ -0,0 +1,383 @@
+package telemetryalerts
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+	goahttp "goa.design/goa/v3/http"
+	"goa.design/goa/v3/security"
+
+	srv "github.com/speakeasy-api/gram/server/gen/http/telemetry_alerts/server"
+	gen "github.com/speakeasy-api/gram/server/gen/telemetry_alerts"
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/auth"
+	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/middleware"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/telemetryalerts/repo"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+// defaultMinCalls mirrors the column default for rules created without one.
+const defaultMinCalls = 10
+
+type Service struct {
+	tracer trace.Tracer
+	logger *slog.Logger
+	db     *pgxpool.Pool
+	auth   *auth.Auth
+	authz  *authz.Engine
+	audit  *audit.Logger
+}
+
+var _ gen.Service = (*Service)(nil)
+var _ gen.Auther = (*Service)(nil)
+
+func NewService(
+	logger *slog.Logger,
+	tracerProvider trace.TracerProvider,
+	db *pgxpool.Pool,
+	sessions *sessions.Manager,
+	authzEngine *authz.Engine,
+	auditLogger *audit.Logger,
+) *Service {
+	logger = logger.With(attr.SlogComponent("telemetryalerts"))
+
+	return &Service{
+		tracer: tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/telemetryalerts"),
+		logger: logger,
+		db:     db,
+		auth:   auth.New(logger, db, sessions, authzEngine),
+		authz:  authzEngine,
+		audit:  auditLogger,
+	}
+}
+
+func Attach(mux goahttp.Muxer, service *Service) {
+	endpoints := gen.NewEndpoints(service)
+	endpoints.Use(middleware.MapErrors())
+	endpoints.Use(middleware.TraceMethods(service.tracer))
+	srv.Mount(
+		mux,
+		srv.New(endpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil),
+	)
+}
+
+func (s *Service) APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error) {
+	return s.auth.Authorize(ctx, key, schema)
+}
+
+func (s *Service) CreateTelemetryAlertRule(ctx context.Context, payload *gen.CreateTelemetryAlertRulePayload) (*types.TelemetryAlertRule, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	metric := Metric(payload.Metric)
+	if !metric.Valid() {
+		return nil, oops.E(oops.CodeInvalid, nil, "unsupported metric").LogError(ctx, logger)
+	}
+	if !metric.ValidThreshold(payload.Threshold) {
+		return nil, oops.E(oops.CodeInvalid, nil, "error_rate threshold must be a fraction between 0 and 1").LogError(ctx, logger)
+	}
+
+	toolsetID, err := conv.PtrToNullUUID(payload.ToolsetID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid toolset_id").LogError(ctx, logger)
+	}
+	if payload.ToolUrn != nil {
+		if _, err := urn.ParseTool(*payload.ToolUrn); err != nil {
+			return nil, oops.E(oops.CodeInvalid, err, "invalid tool_urn").LogError(ctx, logger)
+		}
+	}
+	if err := s.verifyToolset(ctx, *authCtx.ProjectID, toolsetID); err != nil {
+		return nil, err
+	}
+
+	minCalls := int32(defaultMinCalls)
+	if payload.MinCalls != nil {
+		minCalls = *payload.MinCalls
+	}
+	enabled := true
+	if payload.Enabled != nil {
+		enabled = *payload.Enabled
+	}
+	recipients := payload.EmailRecipients
+	if recipients == nil {
+		recipients = []string{}
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	created, err := repo.New(dbtx).CreateTelemetryAlertRule(ctx, repo.CreateTelemetryAlertRuleParams{
+		ProjectID:       *authCtx.ProjectID,
+		Name:            payload.Name,
+		ToolsetID:       toolsetID,
+		ToolUrn:         conv.PtrToPGText(payload.ToolUrn),
+		Metric:          string(metric),
+		Threshold:       payload.Threshold,
+		WindowSeconds:   payload.WindowSeconds,
+		MinCalls:        minCalls,
+		EmailRecipients: recipients,
+		Enabled:         enabled,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "create telemetry alert rule").LogError(ctx, logger)
+	}
+
+	view := toView(created)
+
+	if err := s.audit.LogTelemetryAlertRuleCreate(ctx, dbtx, audit.LogTelemetryAlertRuleCreateEvent{
+		OrganizationID:             authCtx.ActiveOrganizationID,
+		ProjectID:                  *authCtx.ProjectID,
+		Actor:                      urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:           authCtx.Email,
+		ActorSlug:                  nil,
+		TelemetryAlertRuleURN:      urn.NewTelemetryAlertRule(created.ID),
+		TelemetryAlertRuleName:     created.Name,
+		TelemetryAlertRuleSnapshot: view,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log telemetry alert rule creation").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	return view, nil
+}
+
+func (s *Service) ListTelemetryAlertRules(ctx context.Context, payload *gen.ListTelemetryAlertRulesPayload) (*gen.ListTelemetryAlertRulesResult, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPRead, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	toolsetID, err := conv.PtrToNullUUID(payload.ToolsetID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid toolset_id").LogError(ctx, logger)
+	}
+
+	rows, err := repo.New(s.db).ListTelemetryAlertRules(ctx, repo.ListTelemetryAlertRulesParams{
+		ProjectID: *authCtx.ProjectID,
+		ToolsetID: toolsetID,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "list telemetry alert rules").LogError(ctx, logger)
+	}
+
+	rules := make([]*types.TelemetryAlertRule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, toView(row))
+	}
+
+	return &gen.ListTelemetryAlertRulesResult{Rules: rules}, nil
+}
+
+func (s *Service) UpdateTelemetryAlertRule(ctx context.Context, payload *gen.UpdateTelemetryAlertRulePayload) (*types.TelemetryAlertRule, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	ruleID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid telemetry alert rule id").LogError(ctx, logger)
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	txRepo := repo.New(dbtx)
+
+	existing, err := txRepo.GetTelemetryAlertRule(ctx, repo.GetTelemetryAlertRuleParams{
+		ID:        ruleID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.E(oops.CodeNotFound, err, "telemetry alert rule not found").LogError(ctx, logger)
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "get telemetry alert rule").LogError(ctx, logger)
+	}
+
+	if payload.Threshold != nil && !Metric(existing.Metric).ValidThreshold(*payload.Threshold) {
+		return nil, oops.E(oops.CodeInvalid, nil, "error_rate threshold must be a fraction between 0 and 1").LogError(ctx, logger)
+	}
+
+	updated, err := txRepo.UpdateTelemetryAlertRule(ctx, repo.UpdateTelemetryAlertRuleParams{
+		Name:            conv.PtrToPGText(payload.Name),
+		Threshold:       conv.PtrToPGFloat8(payload.Threshold),
+		WindowSeconds:   conv.PtrToPGInt4(payload.WindowSeconds),
+		MinCalls:        conv.PtrToPGInt4(payload.MinCalls),
+		EmailRecipients: payload.EmailRecipients,
+		Enabled:         conv.PtrToPGBool(payload.Enabled),
+		ID:              ruleID,
+		ProjectID:       *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.E(oops.CodeNotFound, err, "telemetry alert rule not found").LogError(ctx, logger)
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "update telemetry alert rule").LogError(ctx, logger)
+	}
+
+	view := toView(updated)
+
+	if err := s.audit.LogTelemetryAlertRuleUpdate(ctx, dbtx, audit.LogTelemetryAlertRuleUpdateEvent{
+		OrganizationID:                   authCtx.ActiveOrganizationID,
+		ProjectID:                        *authCtx.ProjectID,
+		Actor:                            urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:                 authCtx.Email,
+		ActorSlug:                        nil,
+		TelemetryAlertRuleURN:            urn.NewTelemetryAlertRule(updated.ID),
+		TelemetryAlertRuleName:           updated.Name,
+		TelemetryAlertRuleSnapshotBefore: toView(existing),
+		TelemetryAlertRuleSnapshotAfter:  view,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log telemetry alert rule update").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	return view, nil
+}
+
+func (s *Service) DeleteTelemetryAlertRule(ctx context.Context, payload *gen.DeleteTelemetryAlertRulePayload) error {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	ruleID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid telemetry alert rule id").LogError(ctx, logger)
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	deleted, err := repo.New(dbtx).DeleteTelemetryAlertRule(ctx, repo.DeleteTelemetryAlertRuleParams{
+		ID:        ruleID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return oops.E(oops.CodeNotFound, err, "telemetry alert rule not found").LogError(ctx, logger)
+		}
+		return oops.E(oops.CodeUnexpected, err, "delete telemetry alert rule").LogError(ctx, logger)
+	}
+
+	if err := s.audit.LogTelemetryAlertRuleDelete(ctx, dbtx, audit.LogTelemetryAlertRuleDeleteEvent{
+		OrganizationID:         authCtx.ActiveOrganizationID,
+		ProjectID:              *authCtx.ProjectID,
+		Actor:                  urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:       authCtx.Email,
+		ActorSlug:              nil,
+		TelemetryAlertRuleURN:  urn.NewTelemetryAlertRule(deleted.ID),
+		TelemetryAlertRuleName: deleted.Name,
+	}); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "log telemetry alert rule deletion").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	return nil
+}
+
+// verifyToolset checks that the toolset a rule is narrowed to is a live
+// toolset in the caller's project. Returned errors are fully formed oops
+// errors, already logged.
+func (s *Service) verifyToolset(ctx context.Context, projectID uuid.UUID, toolsetID uuid.NullUUID) error {
+	if !toolsetID.Valid {
+		return nil
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(projectID.String()))
+
+	exists, err := repo.New(s.db).ToolsetExistsInProject(ctx, repo.ToolsetExistsInProjectParams{
+		ID:        toolsetID.UUID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "check toolset").LogError(ctx, logger)
+	}
+	if !exists {
+		return oops.E(oops.CodeInvalid, nil, "toolset_id does not reference a toolset in this project").LogError(ctx, logger)
+	}
+
+	return nil
+}
+
+func toView(row repo.TelemetryAlertRule) *types.TelemetryAlertRule {
+	return &types.TelemetryAlertRule{
+		ID:              row.ID.String(),
+		ProjectID:       row.ProjectID.String(),
+		Name:            row.Name,
+		ToolsetID:       conv.FromNullableUUID(row.ToolsetID),
+		ToolUrn:         conv.FromPGText[string](row.ToolUrn),
+		Metric:          row.Metric,
+		Threshold:       row.Threshold,
+		WindowSeconds:   row.WindowSeconds,
+		MinCalls:        row.MinCalls,
+		EmailRecipients: row.EmailRecipients,
+		Enabled:         row.Enabled,
+		State:           row.State,
+		IncidentID:      conv.FromNullableUUID(row.IncidentID),
+		StateChangedAt:  conv.PtrEmpty(conv.FromPGTimestamptz(row.StateChangedAt)),
+		LastValue:       conv.FromPGFloat8(row.LastValue),
+		LastEvaluatedAt: conv.PtrEmpty(conv.FromPGTimestamptz(row.LastEvaluatedAt)),
+		CreatedAt:       row.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:       row.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,145 @@
+package telemetryalerts
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/email"
+)
+
+// Notifier emails a transition to its rule's recipients.
+type Notifier struct {
+	emails  *email.Service
+	siteURL *url.URL
+}
+
+func NewNotifier(emails *email.Service, siteURL *url.URL) *Notifier {
+	return &Notifier{
+		emails:  emails,
+		siteURL: siteURL,
+	}
+}
+
+// Notify returns delivery failures for Temporal retry; per-recipient keys
+// make retries idempotent, so each recipient hears of each transition once.
+func (n *Notifier) Notify(ctx context.Context, t Transition) error {
+	if n.emails == nil || len(t.EmailRecipients) == 0 {
+		return nil
+	}
+
+	logsLink := ""
+	if n.siteURL != nil {
+		logsLink = n.siteURL.JoinPath(t.OrganizationSlug, "projects", t.ProjectSlug, "logs").String()
+	}
+
+	status := "FIRING"
+	if t.State == StateOK {
+		status = "RESOLVED"
+	}
+
+	var errs []error
+	for _, recipient := range t.EmailRecipients {
+		tmpl := email.TelemetryAlert{
+			Email:       recipient,
+			RuleName:    t.RuleName,
+			Status:      status,
+			Headline:    headline(t),
+			ProjectSlug: t.ProjectSlug,
+			Scope:       describeScope(t.ToolsetSlug, t.ToolURN),
+			Condition:   describeCondition(t.Metric, t.Threshold, t.WindowEnd.Sub(t.WindowStart)),
+			Observed:    describeObserved(t.Metric, t.Value, t.Calls),
+			LogsLink:    logsLink,
+		}
+		if err := n.emails.SendIdempotent(ctx, recipient, idempotencyKey(t, recipient), tmpl); err != nil {
+			errs = append(errs, fmt.Errorf("send telemetry alert notification: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func idempotencyKey(t Transition, recipient string) string {
+	digest := sha256.Sum256([]byte(strings.Join([]string{
+		"telemetry-alert", t.IncidentID.String(), string(t.State), strings.ToLower(recipient),
+	}, ":")))
+	return hex.EncodeToString(digest[:])
+}
+
+func headline(t Transition) string {
+	if t.State == StateOK {
+		return "This rule is back within its threshold and has resolved."
+	}
+	return "This rule crossed its threshold and is now firing."
+}
+
+func describeScope(toolsetSlug, toolURN string) string {
+	switch {
+	case toolsetSlug != "" && toolURN != "":
+		return "Toolset " + toolsetSlug + ", tool " + toolURN
+	case toolsetSlug != "":
+		return "Toolset " + toolsetSlug
+	case toolURN != "":
+		return "Tool " + toolURN
+	default:
+		return "All tools"
+	}
+}
+
+func describeCondition(m Metric, threshold float64, window time.Duration) string {
+	return metricLabel(m) + " above " + formatValue(m, threshold) + " over " + formatWindow(window)
+}
+
+func describeObserved(m Metric, value float64, calls uint64) string {
+	return formatValue(m, value) + " across " + strconv.FormatUint(calls, 10) + " calls"
+}
+
+func metricLabel(m Metric) string {
+	switch m {
+	case MetricErrorRate:
+		return "Error rate"
+	case MetricLatencyP50:
+		return "p50 latency"
+	case MetricLatencyP90:
+		return "p90 latency"
+	case MetricLatencyP95:
+		return "p95 latency"
+	case MetricLatencyP99:
+		return "p99 latency"
+	default:
+		return string(m)
+	}
+}
+
+func formatValue(m Metric, v float64) string {
+	if m == MetricErrorRate {
+		return roundTo(v*100, 2) + "%"
+	}
+	if v >= 1000 {
+		return roundTo(v/1000, 2) + "s"
+	}
+	return roundTo(v, 0) + "ms"
+}
+
+func roundTo(v float64, decimals int) string {
+	scale := math.Pow10(decimals)
+	return strconv.FormatFloat(math.Round(v*scale)/scale, 'f', -1, 64)
+}
+
+func formatWindow(d time.Duration) string {
+	switch {
+	case d >= time.Hour && d%time.Hour == 0:
+		return strconv.FormatInt(int64(d/time.Hour), 10) + "h"
+	case d >= time.Minute && d%time.Minute == 0:
+		return strconv.FormatInt(int64(d/time.Minute), 10) + "m"
+	default:
+		return d.String()
+	}
+}
@@ -0,0 +1,135 @@
+-- name: CreateTelemetryAlertRule :one
+INSERT INTO telemetry_alert_rules (
+  project_id,
+  name,
+  toolset_id,
+  tool_urn,
+  metric,
+  threshold,
+  window_seconds,
+  min_calls,
+  email_recipients,
+  enabled
+) VALUES (
+  @project_id,
+  @name,
+  @toolset_id,
+  @tool_urn,
+  @metric,
+  @threshold,
+  @window_seconds,
+  @min_calls,
+  @email_recipients,
+  @enabled
+)
+RETURNING *;
+
+-- name: GetTelemetryAlertRule :one
+SELECT *
+FROM telemetry_alert_rules
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE;
+
+-- name: ListTelemetryAlertRules :many
+SELECT *
+FROM telemetry_alert_rules
+WHERE project_id = @project_id
+  AND deleted IS FALSE
+  AND (sqlc.narg(toolset_id)::uuid IS NULL OR toolset_id = sqlc.narg(toolset_id)::uuid)
+ORDER BY id DESC;
+
+-- name: UpdateTelemetryAlertRule :one
+-- Disabling a rule also clears a firing state, without a resolved
+-- notification, so re-enabling it starts a fresh episode.
+UPDATE telemetry_alert_rules
+SET
+  name = COALESCE(sqlc.narg(name)::text, name),
+  threshold = COALESCE(sqlc.narg(threshold)::double precision, threshold),
+  window_seconds = COALESCE(sqlc.narg(window_seconds)::integer, window_seconds),
+  min_calls = COALESCE(sqlc.narg(min_calls)::integer, min_calls),
+  email_recipients = COALESCE(sqlc.narg(email_recipients)::text[], email_recipients),
+  enabled = COALESCE(sqlc.narg(enabled)::boolean, enabled),
+  state = CASE WHEN sqlc.narg(enabled)::boolean IS FALSE THEN 'ok' ELSE state END,
+  updated_at = clock_timestamp()
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE
+RETURNING *;
+
+-- name: DeleteTelemetryAlertRule :one
+UPDATE telemetry_alert_rules
+SET
+  deleted_at = clock_timestamp(),
+  updated_at = clock_timestamp()
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE
+RETURNING *;
+
+-- name: ToolsetExistsInProject :one
+SELECT EXISTS (
+  SELECT 1
+  FROM toolsets
+  WHERE id = @id
+    AND project_id = @project_id
+    AND deleted IS FALSE
+);
+
+-- name: ListEnabledTelemetryAlertRules :many
+-- Returns every enabled rule with the identifiers the sweep needs to query
+-- telemetry and route notifications. A rule whose toolset has been deleted is
+-- skipped rather than silently widened to the whole project.
+SELECT
+  r.id,
+  r.project_id,
+  r.name,
+  r.toolset_id,
+  r.tool_urn,
+  r.metric,
+  r.threshold,
+  r.window_seconds,
+  r.min_calls,
+  r.email_recipients,
+  r.state,
+  r.incident_id,
+  p.slug AS project_slug,
+  p.organization_id,
+  o.slug AS organization_slug,
+  t.slug AS toolset_slug
+FROM telemetry_alert_rules AS r
+JOIN projects AS p ON p.id = r.project_id AND p.deleted IS FALSE
+JOIN organization_metadata AS o ON o.id = p.organization_id
+LEFT JOIN toolsets AS t ON t.id = r.toolset_id AND t.deleted IS FALSE
+WHERE r.deleted IS FALSE
+  AND r.enabled IS TRUE
+  AND (r.toolset_id IS NULL OR t.id IS NOT NULL)
+ORDER BY r.id ASC;
+
+-- name: RecordTelemetryAlertEvaluation :exec
+-- Records an evaluation that left the rule's state unchanged.
+UPDATE telemetry_alert_rules
+SET
+  last_value = sqlc.narg(last_value)::double precision,
+  last_evaluated_at = @evaluated_at
+WHERE id = @id
+  AND state = @state
+  AND deleted IS FALSE;
+
+-- name: TransitionTelemetryAlertRule :one
+-- Moves a rule from @from_state to @to_state. Returns no row when the rule
+-- is no longer enabled and in @from_state, so an overlapping or retried sweep
+-- announces each transition once. A NULL @incident_id keeps the current
+-- incident, which is how a resolution is tied to the firing it ends.
+UPDATE telemetry_alert_rules
+SET
+  state = @to_state,
+  incident_id = COALESCE(sqlc.narg(incident_id)::uuid, incident_id),
+  state_changed_at = @evaluated_at,
+  last_value = sqlc.narg(last_value)::double precision,
+  last_evaluated_at = @evaluated_at
+WHERE id = @id
+  AND state = @from_state
+  AND enabled IS TRUE
+  AND deleted IS FALSE
+RETURNING *;
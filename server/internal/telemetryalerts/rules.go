@@ -0,0 +1,123 @@
+// Package telemetryalerts evaluates user-defined alert rules over a project's
+// tool call error rate and latency. A scheduled sweep moves each rule between
+// ok and firing, announcing every transition once through the outbox as
+// telemetry_alert.firing_v1 or telemetry_alert.resolved_v1 and by email to the
+// rule's recipients.
+package telemetryalerts
+
+import (
+	"math"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/telemetryalerts/chrepo"
+)
+
+// EvaluationInterval is how often the scheduled sweep runs.
+const EvaluationInterval = time.Minute
+
+// Metric is what a rule compares against its threshold.
+type Metric string
+
+const (
+	MetricErrorRate  Metric = "error_rate"
+	MetricLatencyP50 Metric = "latency_p50"
+	MetricLatencyP90 Metric = "latency_p90"
+	MetricLatencyP95 Metric = "latency_p95"
+	MetricLatencyP99 Metric = "latency_p99"
+)
+
+// Valid reports whether m is one of the metrics a rule may watch.
+func (m Metric) Valid() bool {
+	switch m {
+	case MetricErrorRate, MetricLatencyP50, MetricLatencyP90, MetricLatencyP95, MetricLatencyP99:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidThreshold reports whether threshold is meaningful for m: a fraction in
+// (0, 1] for the error rate, a positive number of milliseconds for latency.
+func (m Metric) ValidThreshold(threshold float64) bool {
+	if threshold <= 0 || math.IsNaN(threshold) || math.IsInf(threshold, 0) {
+		return false
+	}
+	if m == MetricErrorRate {
+		return threshold <= 1
+	}
+	return true
+}
+
+// State is whether a rule is currently firing.
+type State string
+
+const (
+	StateOK     State = "ok"
+	StateFiring State = "firing"
+)
+
+// WindowStats is the tool call volume, failures and latency quantiles for
+// one rule's scope over its window.
+type WindowStats struct {
+	Calls      uint64
+	Failures   uint64
+	LatencyP50 float64
+	LatencyP90 float64
+	LatencyP95 float64
+	LatencyP99 float64
+}
+
+func windowStatsFromRow(row chrepo.WindowStatsRow) WindowStats {
+	return WindowStats{
+		Calls:      row.Calls,
+		Failures:   row.Failures,
+		LatencyP50: row.LatencyP50,
+		LatencyP90: row.LatencyP90,
+		LatencyP95: row.LatencyP95,
+		LatencyP99: row.LatencyP99,
+	}
+}
+
+// Value returns the metric's value over the window, or false when the window
+// had no calls and the metric is undefined.
+func (s WindowStats) Value(m Metric) (float64, bool) {
+	if s.Calls == 0 {
+		return 0, false
+	}
+
+	var v float64
+	switch m {
+	case MetricErrorRate:
+		v = float64(s.Failures) / float64(s.Calls)
+	case MetricLatencyP50:
+		v = s.LatencyP50
+	case MetricLatencyP90:
+		v = s.LatencyP90
+	case MetricLatencyP95:
+		v = s.LatencyP95
+	case MetricLatencyP99:
+		v = s.LatencyP99
+	default:
+		return 0, false
+	}
+
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, false
+	}
+	return v, true
+}
+
+// Breached reports whether the window puts a rule over its threshold. A
+// window with fewer than minCalls calls never breaches, so a quiet tool
+// neither fires nor holds a rule in firing on a handful of calls.
+func (s WindowStats) Breached(m Metric, threshold float64, minCalls int32) bool {
+	if minCalls < 1 {
+		minCalls = 1
+	}
+	if s.Calls < uint64(minCalls) {
+		return false
+	}
+
+	v, ok := s.Value(m)
+	return ok && v > threshold
+}
@@ -0,0 +1,91 @@
+package telemetryalerts
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWindowStatsValue(t *testing.T) {
+	t.Parallel()
+
+	stats := WindowStats{Calls: 200, Failures: 15, LatencyP50: 120, LatencyP90: 800, LatencyP95: 3200, LatencyP99: 9000}
+
+	v, ok := stats.Value(MetricErrorRate)
+	require.True(t, ok)
+	require.InDelta(t, 0.075, v, 1e-9)
+
+	v, ok = stats.Value(MetricLatencyP95)
+	require.True(t, ok)
+	require.InDelta(t, 3200, v, 0)
+
+	_, ok = WindowStats{Calls: 0, Failures: 0, LatencyP50: math.NaN(), LatencyP90: math.NaN(), LatencyP95: math.NaN(), LatencyP99: math.NaN()}.Value(MetricLatencyP50)
+	require.False(t, ok, "an empty window has no value")
+}
+
+func TestWindowStatsBreached(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		stats     WindowStats
+		metric    Metric
+		threshold float64
+		minCalls  int32
+		want      bool
+	}{
+		"error rate above threshold": {
+			stats:  WindowStats{Calls: 100, Failures: 6, LatencyP50: 0, LatencyP90: 0, LatencyP95: 0, LatencyP99: 0},
+			metric: MetricErrorRate, threshold: 0.05, minCalls: 10, want: true,
+		},
+		"error rate exactly on threshold": {
+			stats:  WindowStats{Calls: 100, Failures: 5, LatencyP50: 0, LatencyP90: 0, LatencyP95: 0, LatencyP99: 0},
+			metric: MetricErrorRate, threshold: 0.05, minCalls: 10, want: false,
+		},
+		"too few calls": {
+			stats:  WindowStats{Calls: 4, Failures: 4, LatencyP50: 0, LatencyP90: 0, LatencyP95: 0, LatencyP99: 0},
+			metric: MetricErrorRate, threshold: 0.05, minCalls: 10, want: false,
+		},
+		"p95 latency above threshold": {
+			stats:  WindowStats{Calls: 50, Failures: 0, LatencyP50: 200, LatencyP90: 2500, LatencyP95: 3100, LatencyP99: 4000},
+			metric: MetricLatencyP95, threshold: 3000, minCalls: 10, want: true,
+		},
+		"p50 latency below threshold": {
+			stats:  WindowStats{Calls: 50, Failures: 0, LatencyP50: 200, LatencyP90: 2500, LatencyP95: 3100, LatencyP99: 4000},
+			metric: MetricLatencyP50, threshold: 3000, minCalls: 10, want: false,
+		},
+	}
+	for name, tc := range cases {
+		require.Equal(t, tc.want, tc.stats.Breached(tc.metric, tc.threshold, tc.minCalls), name)
+	}
+}
+
+func TestMetricValidThreshold(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, MetricErrorRate.ValidThreshold(0.05))
+	require.True(t, MetricErrorRate.ValidThreshold(1))
+	require.False(t, MetricErrorRate.ValidThreshold(5), "error rate thresholds are fractions, not percentages")
+	require.False(t, MetricErrorRate.ValidThreshold(0))
+	require.True(t, MetricLatencyP95.ValidThreshold(3000))
+	require.False(t, MetricLatencyP95.ValidThreshold(math.Inf(1)))
+	require.False(t, Metric("latency_max").Valid())
+}
+
+func TestDescribeCondition(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "Error rate above 5% over 10m", describeCondition(MetricErrorRate, 0.05, 10*time.Minute))
+	require.Equal(t, "p95 latency above 3s over 1h", describeCondition(MetricLatencyP95, 3000, time.Hour))
+	require.Equal(t, "8.33% across 240 calls", describeObserved(MetricErrorRate, 20.0/240, 240))
+	require.Equal(t, "450ms across 12 calls", describeObserved(MetricLatencyP50, 449.6, 12))
+}
+
+func TestDescribeScope(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "All tools", describeScope("", ""))
+	require.Equal(t, "Toolset billing", describeScope("billing", ""))
+	require.Equal(t, "Toolset billing, tool tools:http:billing:get_invoice", describeScope("billing", "tools:http:billing:get_invoice"))
+}
@@ -0,0 +1,132 @@
+package telemetryalerts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/telemetry_alerts"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/audit/audittest"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+func TestCreateTelemetryAlertRule_ToolsetErrorRate(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	beforeCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionTelemetryAlertRuleCreate)
+	require.NoError(t, err)
+
+	result, err := ti.service.CreateTelemetryAlertRule(ctx, &gen.CreateTelemetryAlertRulePayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		Name:             "Search failing",
+		ToolsetID:        new(toolsetID.String()),
+		ToolUrn:          new("tools:http:github:search_issues"),
+		Metric:           "error_rate",
+		Threshold:        0.05,
+		WindowSeconds:    600,
+		MinCalls:         nil,
+		EmailRecipients:  []string{"oncall@example.com"},
+		Enabled:          nil,
+	})
+	require.NoError(t, err)
+	require.Equal(t, new(toolsetID.String()), result.ToolsetID)
+	require.Equal(t, int32(10), result.MinCalls, "min_calls defaults to 10")
+	require.True(t, result.Enabled)
+	require.Equal(t, "ok", result.State)
+	require.Nil(t, result.IncidentID)
+
+	afterCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionTelemetryAlertRuleCreate)
+	require.NoError(t, err)
+	require.Equal(t, beforeCount+1, afterCount)
+}
+
+func TestCreateTelemetryAlertRule_RejectsPercentErrorRate(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	_, err := ti.service.CreateTelemetryAlertRule(ctx, &gen.CreateTelemetryAlertRulePayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		Name:             "Everything failing",
+		ToolsetID:        nil,
+		ToolUrn:          nil,
+		Metric:           "error_rate",
+		Threshold:        5,
+		WindowSeconds:    600,
+		MinCalls:         nil,
+		EmailRecipients:  nil,
+		Enabled:          nil,
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+}
+
+func TestUpdateAndDeleteTelemetryAlertRule(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	created, err := ti.service.CreateTelemetryAlertRule(ctx, &gen.CreateTelemetryAlertRulePayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		Name:             "Slow tools",
+		ToolsetID:        nil,
+		ToolUrn:          nil,
+		Metric:           "latency_p95",
+		Threshold:        3000,
+		WindowSeconds:    300,
+		MinCalls:         new(int32(20)),
+		EmailRecipients:  nil,
+		Enabled:          nil,
+	})
+	require.NoError(t, err)
+	require.Empty(t, created.EmailRecipients)
+
+	updated, err := ti.service.UpdateTelemetryAlertRule(ctx, &gen.UpdateTelemetryAlertRulePayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ID:               created.ID,
+		Name:             nil,
+		Threshold:        new(5000.0),
+		WindowSeconds:    nil,
+		MinCalls:         nil,
+		EmailRecipients:  []string{"oncall@example.com"},
+		Enabled:          new(false),
+	})
+	require.NoError(t, err)
+	require.InDelta(t, 5000, updated.Threshold, 0)
+	require.Equal(t, int32(20), updated.MinCalls, "omitted fields keep their values")
+	require.Equal(t, []string{"oncall@example.com"}, updated.EmailRecipients)
+	require.False(t, updated.Enabled)
+
+	err = ti.service.DeleteTelemetryAlertRule(ctx, &gen.DeleteTelemetryAlertRulePayload{
+		ID:               created.ID,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+
+	listed, err := ti.service.ListTelemetryAlertRules(ctx, &gen.ListTelemetryAlertRulesPayload{
+		ToolsetID:        nil,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Empty(t, listed.Rules)
+}
@@ -162,6 +162,86 @@ func TestTemplatesService_CreateTemplate_InvalidArguments(t *testing.T) {
 	}
 }
 
+func TestTemplatesService_CreateTemplate_InvalidServerWorkflow(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestTemplateService(t)
+
+	tests := []struct {
+		name   string
+		prompt string
+	}{
+		{
+			name:   "no-steps",
+			prompt: `{"toolName": "wf", "purpose": "p", "inputs": [], "steps": [], "execution": "server"}`,
+		},
+		{
+			name:   "missing-tool-urn",
+			prompt: `{"toolName": "wf", "purpose": "p", "inputs": [], "steps": [{"id": "a"}], "execution": "server"}`,
+		},
+		{
+			name:   "invalid-binding",
+			prompt: `{"toolName": "wf", "purpose": "p", "inputs": [], "steps": [{"id": "a", "toolUrn": "tools:http:petstore:get_pet", "bindings": {"id": ".steps.["}}], "execution": "server"}`,
+		},
+		{
+			name:   "unknown-execution-mode",
+			prompt: `{"toolName": "wf", "purpose": "p", "inputs": [], "steps": [], "execution": "remote"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ti.service.CreateTemplate(ctx, &gen.CreateTemplatePayload{
+				ApikeyToken:      nil,
+				SessionToken:     nil,
+				ProjectSlugInput: nil,
+				Name:             types.Slug("workflow-" + tt.name),
+				Prompt:           tt.prompt,
+				Description:      nil,
+				Engine:           "",
+				Kind:             "higher_order_tool",
+				ToolsHint:        nil,
+				Arguments:        nil,
+			})
+			require.Error(t, err, "expected error for %s", tt.name)
+			require.Contains(t, err.Error(), "invalid workflow definition")
+		})
+	}
+}
+
+func TestTemplatesService_CreateTemplate_ServerWorkflow(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestTemplateService(t)
+
+	result, err := ti.service.CreateTemplate(ctx, &gen.CreateTemplatePayload{
+		ApikeyToken:      nil,
+		SessionToken:     nil,
+		ProjectSlugInput: nil,
+		Name:             types.Slug("server-workflow"),
+		Prompt: `{
+			"toolName": "server-workflow",
+			"purpose": "Look up a pet and summarize it",
+			"inputs": [{"name": "petId", "description": "The pet to look up"}],
+			"execution": "server",
+			"steps": [
+				{"id": "lookup", "toolUrn": "tools:http:petstore:get_pet", "bindings": {"pathParameters.petId": ".inputs.petId"}, "onError": "continue"},
+				{"id": "summary", "toolUrn": "tools:prompt:prompt:summarize", "when": ".steps.lookup.status == \"ok\"", "bindings": {"text": ".steps.lookup.output | tostring"}}
+			]
+		}`,
+		Description: nil,
+		Engine:      "",
+		Kind:        "higher_order_tool",
+		ToolsHint:   nil,
+		Arguments:   nil,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, "higher_order_tool", result.Template.Kind)
+}
+
 func TestTemplatesService_CreateTemplate_EmptyArgumentsSchema(t *testing.T) {
 	t.Parallel()
 
@@ -134,6 +134,10 @@ func (s *Service) CreateTemplate(ctx context.Context, payload *gen.CreateTemplat
 		}
 	}
 
+	if _, err := ParseServerWorkflow(payload.Kind, payload.Prompt); err != nil {
+		return nil, oops.E(oops.CodeInvalid, err, "invalid workflow definition").LogError(ctx, logger)
+	}
+
 	toolURN := urn.NewTool(urn.ToolKindPrompt, payload.Kind, string(payload.Name))
 	if err := toolURN.Validate(); err != nil {
 		return nil, oops.E(oops.CodeBadRequest, err, "invalid tool URN").LogError(ctx, logger)
@@ -248,6 +252,12 @@ func (s *Service) UpdateTemplate(ctx context.Context, payload *gen.UpdateTemplat
 		return nil, oops.E(oops.CodeBadRequest, nil, "kind cannot be changed").LogError(ctx, logger)
 	}
 
+	if payload.Prompt != nil {
+		if _, err := ParseServerWorkflow(current.Kind.String, *payload.Prompt); err != nil {
+			return nil, oops.E(oops.CodeInvalid, err, "invalid workflow definition").LogError(ctx, logger)
+		}
+	}
+
 	toolURN := urn.NewTool(urn.ToolKindPrompt, current.Kind.String, current.Name)
 	if err := toolURN.Validate(); err != nil {
 		return nil, oops.E(oops.CodeBadRequest, err, "invalid tool URN").LogError(ctx, logger)
@@ -273,6 +283,9 @@ func (s *Service) UpdateTemplate(ctx context.Context, payload *gen.UpdateTemplat
 			DestructiveHint:  nil,
 			IdempotentHint:   nil,
 			OpenWorldHint:    nil,
+			CacheTTLSeconds:  nil,
+			CacheShared:      nil,
+			ArgumentBindings: nil,
 		})
 		if err != nil {
 			return nil, oops.E(oops.CodeUnexpected, err, "failed to update template").LogError(ctx, logger)
@@ -566,7 +579,7 @@ func (s *Service) RenderTemplate(ctx context.Context, payload *gen.RenderTemplat
 func RenderTemplate(ctx context.Context, logger *slog.Logger, template string, kind string, engine string, arguments map[string]any) (string, error) {
 	var err error
 	renderedPrompt := template
-	if kind == "higher_order_tool" {
+	if kind == KindHigherOrderTool {
 		renderedPrompt, err = RenderTemplateJSON(ctx, logger, template)
 		if err != nil {
 			return "", oops.E(oops.CodeBadRequest, err, "failed to render template").LogError(ctx, logger)
@@ -594,6 +607,10 @@ type CustomToolJSONV1 struct {
 	Purpose  string  `json:"purpose"`
 	Inputs   []Input `json:"inputs"`
 	Steps    []Step  `json:"steps"`
+	// Execution selects how the tool runs when called. The default,
+	// ExecutionModePrompt, renders the steps as instructions for the model;
+	// ExecutionModeServer has Gram call each step's tool itself.
+	Execution string `json:"execution,omitempty"`
 }
 
 type Input struct {
@@ -608,6 +625,19 @@ type Step struct {
 	ToolUrn       string   `json:"toolUrn"`
 	Instructions  string   `json:"instructions"`
 	Inputs        []string `json:"inputs"`
+
+	// The fields below only apply to server-executed workflows.
+
+	// Arguments are literal arguments sent to the step's tool.
+	Arguments map[string]any `json:"arguments,omitempty"`
+	// Bindings map an argument path, dot-separated for nested objects, to a
+	// jq expression evaluated against the workflow state.
+	Bindings map[string]string `json:"bindings,omitempty"`
+	// When is a jq expression evaluated against the workflow state. The step
+	// is skipped unless it yields a value other than false or null.
+	When string `json:"when,omitempty"`
+	// OnError is StepOnErrorFail (the default) or StepOnErrorContinue.
+	OnError string `json:"onError,omitempty"`
 }
 
 func RenderTemplateJSON(ctx context.Context, logger *slog.Logger, promptJSON string) (string, error) {
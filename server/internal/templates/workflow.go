@@ -0,0 +1,100 @@
+package templates
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/itchyny/gojq"
+
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+const (
+	// KindHigherOrderTool is the prompt template kind whose prompt holds a
+	// CustomToolJSONV1 definition.
+	KindHigherOrderTool = "higher_order_tool"
+
+	ExecutionModePrompt = "prompt"
+	ExecutionModeServer = "server"
+
+	StepOnErrorFail     = "fail"
+	StepOnErrorContinue = "continue"
+)
+
+// ParseServerWorkflow returns the definition of a higher-order tool that is
+// configured for server-side execution. It returns nil for any other template,
+// including higher-order tools rendered as prompts. A prompt that is not a
+// JSON definition at all is left for RenderTemplateJSON to report.
+func ParseServerWorkflow(kind string, prompt string) (*CustomToolJSONV1, error) {
+	if kind != KindHigherOrderTool {
+		return nil, nil
+	}
+
+	var workflow CustomToolJSONV1
+	if err := json.Unmarshal([]byte(prompt), &workflow); err != nil {
+		return nil, nil
+	}
+
+	switch workflow.Execution {
+	case "", ExecutionModePrompt:
+		return nil, nil
+	case ExecutionModeServer:
+	default:
+		return nil, fmt.Errorf("unsupported execution mode %q", workflow.Execution)
+	}
+
+	if err := validateWorkflow(&workflow); err != nil {
+		return nil, err
+	}
+
+	return &workflow, nil
+}
+
+func validateWorkflow(workflow *CustomToolJSONV1) error {
+	if len(workflow.Steps) == 0 {
+		return errors.New("workflow has no steps")
+	}
+
+	seen := make(map[string]struct{}, len(workflow.Steps))
+	for i, step := range workflow.Steps {
+		if step.ID == "" {
+			return fmt.Errorf("step %d: id is required", i)
+		}
+		if _, ok := seen[step.ID]; ok {
+			return fmt.Errorf("step %q: duplicate id", step.ID)
+		}
+		seen[step.ID] = struct{}{}
+
+		if step.ToolUrn == "" {
+			return fmt.Errorf("step %q: toolUrn is required", step.ID)
+		}
+		if _, err := urn.ParseTool(step.ToolUrn); err != nil {
+			return fmt.Errorf("step %q: invalid toolUrn: %w", step.ID, err)
+		}
+
+		switch step.OnError {
+		case "", StepOnErrorFail, StepOnErrorContinue:
+		default:
+			return fmt.Errorf("step %q: unsupported onError %q", step.ID, step.OnError)
+		}
+
+		if step.When != "" {
+			if _, err := gojq.Parse(step.When); err != nil {
+				return fmt.Errorf("step %q: invalid when expression: %w", step.ID, err)
+			}
+		}
+
+		for path, expr := range step.Bindings {
+			if path == "" || strings.Contains(path, "..") || strings.HasPrefix(path, ".") || strings.HasSuffix(path, ".") {
+				return fmt.Errorf("step %q: invalid binding path %q", step.ID, path)
+			}
+			if _, err := gojq.Parse(expr); err != nil {
+				return fmt.Errorf("step %q: invalid binding for %q: %w", step.ID, path, err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -60,6 +60,7 @@ func NewTestManager(t *testing.T, logger *slog.Logger, tracerProvider trace.Trac
 		"test-client-id",
 		idpClient,
 		nil, // no WorkOS client in tests
+		nil, // no generic OIDC provider in tests
 		orgRepo.New(db),
 		userRepo.New(db),
 		fakePylon,
@@ -151,3 +152,30 @@ func InitAuthContext(t *testing.T, ctx context.Context, conn *pgxpool.Pool, sess
 
 	return ctx
 }
+
+// CreateOrganizationWithProject creates an organization outside the one
+// InitAuthContext authenticates into, with one project, for tests that need a
+// second tenant. It returns the organization and project IDs.
+func CreateOrganizationWithProject(t *testing.T, ctx context.Context, conn *pgxpool.Pool) (string, uuid.UUID) {
+	t.Helper()
+
+	organizationID := "org_" + uuid.NewString()
+	_, err := orgRepo.New(conn).UpsertOrganizationMetadata(ctx, orgRepo.UpsertOrganizationMetadataParams{
+		ID:          organizationID,
+		Name:        "Second Test Org",
+		Slug:        "test-org-" + uuid.New().String()[:8],
+		WorkosID:    conv.ToPGText(organizationID),
+		Whitelisted: pgtype.Bool{Bool: false, Valid: false},
+	})
+	require.NoError(t, err)
+
+	projectSlug := fmt.Sprintf("test-%s", uuid.New().String()[:8])
+	p, err := projectsRepo.New(conn).CreateProject(ctx, projectsRepo.CreateProjectParams{
+		Name:           projectSlug,
+		Slug:           projectSlug,
+		OrganizationID: organizationID,
+	})
+	require.NoError(t, err)
+
+	return organizationID, p.ID
+}
@@ -0,0 +1,70 @@
+package testenv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	extcredrepo "github.com/speakeasy-api/gram/server/internal/externalcredentials/repo"
+	"github.com/speakeasy-api/gram/server/internal/testenv/testrepo"
+)
+
+// CreateGCPIAMCredential creates an organization's GCP IAM credential that
+// impersonates the given service account, and returns its ID.
+func CreateGCPIAMCredential(t *testing.T, ctx context.Context, conn *pgxpool.Pool, organizationID string, name string, serviceAccount string) uuid.UUID {
+	t.Helper()
+
+	queries := extcredrepo.New(conn)
+	credential, err := queries.CreateExternalCredential(ctx, extcredrepo.CreateExternalCredentialParams{
+		OrganizationID: conv.ToPGText(organizationID),
+		Provider:       "gcp_iam",
+		Name:           name,
+	})
+	require.NoError(t, err)
+
+	_, err = queries.CreateGcpIamCredential(ctx, extcredrepo.CreateGcpIamCredentialParams{
+		ExternalCredentialID:      credential.ID,
+		ImpersonateServiceAccount: conv.ToPGText(serviceAccount),
+		WifPoolID:                 pgtype.Text{String: "", Valid: false},
+		WifProviderID:             pgtype.Text{String: "", Valid: false},
+		WifProjectNumber:          pgtype.Text{String: "", Valid: false},
+	})
+	require.NoError(t, err)
+
+	return credential.ID
+}
+
+// CreateAWSIAMCredential creates an organization's AWS IAM credential with the
+// given role configuration, and returns its ID. The ExternalCredentialID of
+// params is ignored.
+func CreateAWSIAMCredential(t *testing.T, ctx context.Context, conn *pgxpool.Pool, organizationID string, name string, params extcredrepo.CreateAwsIamCredentialParams) uuid.UUID {
+	t.Helper()
+
+	queries := extcredrepo.New(conn)
+	credential, err := queries.CreateExternalCredential(ctx, extcredrepo.CreateExternalCredentialParams{
+		OrganizationID: conv.ToPGText(organizationID),
+		Provider:       "aws_iam",
+		Name:           name,
+	})
+	require.NoError(t, err)
+
+	params.ExternalCredentialID = credential.ID
+	_, err = queries.CreateAwsIamCredential(ctx, params)
+	require.NoError(t, err)
+
+	return credential.ID
+}
+
+// DeleteExternalCredential hard-deletes a credential, as an operator removing
+// the row outright would, rather than soft-deleting it through the service.
+func DeleteExternalCredential(t *testing.T, ctx context.Context, conn *pgxpool.Pool, credentialID uuid.UUID) {
+	t.Helper()
+
+	err := testrepo.New(conn).DeleteExternalCredentialFixture(ctx, credentialID)
+	require.NoError(t, err)
+}
@@ -0,0 +1,98 @@
+package testenv
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	mockoidc "github.com/speakeasy-api/gram/mock-oidc"
+)
+
+// MockOIDCIssuer is the repo's mock OIDC provider serving one confidential
+// client.
+type MockOIDCIssuer struct {
+	URL          string
+	ClientID     string
+	ClientSecret string
+}
+
+// NewMockOIDCIssuer starts a mock OIDC provider that signs in the given users
+// and lets its client redirect to redirectURI.
+func NewMockOIDCIssuer(t *testing.T, redirectURI string, users ...mockoidc.User) *MockOIDCIssuer {
+	t.Helper()
+
+	issuer := &MockOIDCIssuer{
+		URL:          "",
+		ClientID:     "gram-test",
+		ClientSecret: "gram-test-secret",
+	}
+	cfg := &mockoidc.Config{
+		Provider: mockoidc.ProviderConfig{
+			Users: users,
+			OAuthClients: []mockoidc.OAuthClient{{
+				ClientID:     issuer.ClientID,
+				ClientSecret: issuer.ClientSecret,
+				Name:         "Gram",
+				RedirectURIs: []string{redirectURI},
+			}},
+		},
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	// The issuer URL is only known once the server is listening.
+	srv := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(srv.Close)
+	provider, err := mockoidc.NewProvider(cfg, NewLogger(t), srv.URL, key)
+	require.NoError(t, err)
+	srv.Config.Handler = mockoidc.NewServer(provider, NewLogger(t)).Handler()
+
+	issuer.URL = srv.URL
+	return issuer
+}
+
+// SignIn completes the issuer's login screen as email, starting from an
+// authorization URL, and returns the URL the issuer redirects back to.
+func (m *MockOIDCIssuer) SignIn(t *testing.T, authorizationURL string, email string) *url.URL {
+	t.Helper()
+
+	client := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, authorizationURL, nil)
+	require.NoError(t, err)
+	res, err := client.Do(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, res.Body.Close())
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, res.StatusCode, string(body))
+
+	_, rest, ok := strings.Cut(string(body), `name="state_token" value="`)
+	require.True(t, ok, "login screen has no state token")
+	stateToken, _, _ := strings.Cut(rest, `"`)
+
+	form := url.Values{"user_email": {email}, "state_token": {stateToken}}
+	req, err = http.NewRequestWithContext(t.Context(), http.MethodPost, m.URL+"/authorize", strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res, err = client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusFound, res.StatusCode)
+
+	location, err := res.Location()
+	require.NoError(t, err)
+	return location
+}
@@ -487,3 +487,9 @@ SELECT
 SELECT blob_url, consumed_at
 FROM session_handoff_links
 WHERE token = @token;
+
+-- name: DeleteExternalCredentialFixture :exec
+-- Hard-deletes a credential, bypassing the soft-delete preflight, so tests can
+-- exercise the ON DELETE behaviour of the rows that reference it.
+DELETE FROM external_credentials
+WHERE id = @id;
@@ -265,6 +265,18 @@ func (q *Queries) DeferDeviceIntegrationSyncsFixture(ctx context.Context, device
 	return err
 }
 
+const deleteExternalCredentialFixture = `-- name: DeleteExternalCredentialFixture :exec
+DELETE FROM external_credentials
+WHERE id = $1
+`
+
+// Hard-deletes a credential, bypassing the soft-delete preflight, so tests can
+// exercise the ON DELETE behaviour of the rows that reference it.
+func (q *Queries) DeleteExternalCredentialFixture(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteExternalCredentialFixture, id)
+	return err
+}
+
 const disableDeviceIntegrationSchedulesFixture = `-- name: DisableDeviceIntegrationSchedulesFixture :exec
 UPDATE device_integration_schedules
 SET disabled_at = clock_timestamp()
@@ -0,0 +1,30 @@
+package awsauth
+
+import "errors"
+
+var (
+	// ErrUnsupportedMode is returned for credentials whose authentication
+	// approach is recognized but not yet implemented.
+	ErrUnsupportedMode = errors.New("aws credential mode is not supported")
+
+	// ErrUnusableCredential is returned for credentials that cannot
+	// authenticate as the customer at all.
+	ErrUnusableCredential = errors.New("aws credential cannot be used")
+)
+
+// Credential is the subset of an AWS IAM credential that determines how its
+// identity resolves.
+type Credential struct {
+	// AssumeRoleArn is the role Gram assumes. Empty for a key-policy grant.
+	AssumeRoleArn string
+
+	// ExternalID is the Gram-generated ExternalId the role's trust policy
+	// requires. Set exactly when the role is assumed from Gram's own identity.
+	ExternalID string
+
+	// OidcAudience is set when the role is assumed with a web identity.
+	OidcAudience string
+
+	// StsRegion pins the regional STS endpoint. Empty uses the global one.
+	StsRegion string
+}
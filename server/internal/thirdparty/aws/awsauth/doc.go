@@ -0,0 +1,11 @@
+// Package awsauth turns an AWS IAM external credential into the
+// aws.CredentialsProvider that authenticates calls made as the customer's
+// identity.
+//
+// Assuming the credential's role with its Gram-generated ExternalId is
+// supported today. Web identity federation is recognized and reported as
+// not-yet-supported (ErrUnsupportedMode) rather than silently ignored. A
+// credential that names no role records a KMS key-policy grant to Gram's own
+// account, which is refused (ErrUnusableCredential) for anything else: using it
+// would act on a customer-supplied resource with Gram's own reach.
+package awsauth
@@ -0,0 +1,135 @@
+package awsauth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+)
+
+// roleSessionName names the sessions Gram opens on a customer's role, so they
+// are recognizable in the customer's CloudTrail.
+const roleSessionName = "gram"
+
+// defaultSTSRegion is where role assumption goes when neither the credential
+// nor Gram's own configuration names a region. STS answers from every region.
+const defaultSTSRegion = "us-east-1"
+
+// Identity builds credentials providers for AWS IAM external credentials,
+// assuming customer roles from Gram's own AWS identity.
+//
+// Safe for concurrent use. Providers are kept per credential so the temporary
+// credentials they hold are reused until they near expiry rather than
+// re-assumed on every call.
+type Identity struct {
+	// baseMu guards base.
+	baseMu sync.Mutex
+
+	// base is Gram's own AWS configuration, nil until first loaded. Only a
+	// successful load is kept, so an environment that has not yet been granted
+	// credentials retries.
+	base *aws.Config
+
+	providers *expirable.LRU[Credential, aws.CredentialsProvider]
+}
+
+// NewIdentity returns an identity that assumes roles from Gram's ambient AWS
+// configuration.
+func NewIdentity() *Identity {
+	return &Identity{
+		baseMu:    sync.Mutex{},
+		base:      nil,
+		providers: expirable.NewLRU[Credential, aws.CredentialsProvider](256, nil, time.Hour),
+	}
+}
+
+// CredentialsProvider returns the provider that authenticates calls as the
+// credential's identity.
+//
+// # Errors
+//   - [ErrUnsupportedMode]: the credential assumes its role with a web identity.
+//   - [ErrUnusableCredential]: the credential names no role to assume.
+//   - `error`: Gram's own AWS configuration could not be loaded.
+func (i *Identity) CredentialsProvider(ctx context.Context, cred Credential) (aws.CredentialsProvider, error) {
+	switch {
+	case cred.OidcAudience != "":
+		return nil, fmt.Errorf("%w: assuming a role with a web identity", ErrUnsupportedMode)
+	case cred.AssumeRoleArn == "":
+		return nil, fmt.Errorf("%w: it names no role to assume", ErrUnusableCredential)
+	case cred.ExternalID == "":
+		return nil, fmt.Errorf("%w: it has no external id; save it again to generate one", ErrUnusableCredential)
+	}
+
+	if provider, ok := i.providers.Get(cred); ok {
+		return provider, nil
+	}
+
+	base, err := i.baseConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	region := cred.StsRegion
+	if region == "" {
+		region = base.Region
+	}
+	if region == "" {
+		region = defaultSTSRegion
+	}
+
+	client := sts.NewFromConfig(*base, func(o *sts.Options) {
+		o.Region = region
+	})
+	provider := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(client, cred.AssumeRoleArn, func(o *stscreds.AssumeRoleOptions) {
+		o.ExternalID = aws.String(cred.ExternalID)
+		o.RoleSessionName = roleSessionName
+	}))
+	i.providers.Add(cred, provider)
+
+	return provider, nil
+}
+
+// Config returns Gram's own AWS configuration with its credentials replaced by
+// the credential's identity and its region set, ready to build a service
+// client from. It fails as [Identity.CredentialsProvider] does.
+func (i *Identity) Config(ctx context.Context, cred Credential, region string) (aws.Config, error) {
+	var cfg aws.Config
+
+	provider, err := i.CredentialsProvider(ctx, cred)
+	if err != nil {
+		return cfg, err
+	}
+
+	base, err := i.baseConfig(ctx)
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg = base.Copy()
+	cfg.Credentials = provider
+	cfg.Region = region
+	return cfg, nil
+}
+
+func (i *Identity) baseConfig(ctx context.Context) (*aws.Config, error) {
+	i.baseMu.Lock()
+	defer i.baseMu.Unlock()
+
+	if i.base != nil {
+		return i.base, nil
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load gram's own aws configuration: %w", err)
+	}
+
+	i.base = &cfg
+	return i.base, nil
+}
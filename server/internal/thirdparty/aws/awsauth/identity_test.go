@@ -0,0 +1,70 @@
+package awsauth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/aws/awsauth"
+)
+
+func TestIdentity_RejectsUnusableCredentials(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cred    awsauth.Credential
+		wantErr error
+	}{
+		{
+			name:    "web identity",
+			cred:    awsauth.Credential{AssumeRoleArn: "arn:aws:iam::123456789012:role/gram", ExternalID: "", OidcAudience: "sts.amazonaws.com", StsRegion: ""},
+			wantErr: awsauth.ErrUnsupportedMode,
+		},
+		{
+			name:    "no role",
+			cred:    awsauth.Credential{AssumeRoleArn: "", ExternalID: "", OidcAudience: "", StsRegion: ""},
+			wantErr: awsauth.ErrUnusableCredential,
+		},
+		{
+			name:    "no external id",
+			cred:    awsauth.Credential{AssumeRoleArn: "arn:aws:iam::123456789012:role/gram", ExternalID: "", OidcAudience: "", StsRegion: ""},
+			wantErr: awsauth.ErrUnusableCredential,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := awsauth.NewIdentity().Config(t.Context(), tt.cred, "us-east-1")
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestIdentity_Config(t *testing.T) {
+	t.Setenv("AWS_REGION", "eu-central-1")
+	t.Setenv("AWS_CONFIG_FILE", "/dev/null")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", "/dev/null")
+
+	identity := awsauth.NewIdentity()
+	cred := awsauth.Credential{
+		AssumeRoleArn: "arn:aws:iam::123456789012:role/gram",
+		ExternalID:    "external-id",
+		OidcAudience:  "",
+		StsRegion:     "",
+	}
+
+	cfg, err := identity.Config(t.Context(), cred, "ap-southeast-2")
+	require.NoError(t, err)
+	require.Equal(t, "ap-southeast-2", cfg.Region)
+	require.NotNil(t, cfg.Credentials)
+
+	// The provider is kept, so its temporary credentials are reused.
+	first, err := identity.CredentialsProvider(t.Context(), cred)
+	require.NoError(t, err)
+	second, err := identity.CredentialsProvider(t.Context(), cred)
+	require.NoError(t, err)
+	require.Same(t, first, second)
+}
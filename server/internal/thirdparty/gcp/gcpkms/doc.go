@@ -1,5 +1,6 @@
 // Package gcpkms signs with GCP Cloud KMS asymmetric keys whose private half
-// never leaves the provider.
+// never leaves the provider, and encrypts with symmetric keys that likewise
+// never leave it.
 //
 // The package is deliberately GCP-concrete. The cross-provider seam is
 // jose.OpaqueSigner, which NewSigner returns and which every go-jose JWS and JWT
@@ -12,12 +13,12 @@
 // a key version configured for RSA-PSS reports that it is PS256 instead of
 // silently producing signatures no verifier accepts.
 //
-// Everything here is scoped to keys whose KMS purpose is ASYMMETRIC_SIGN, which
-// is why the identifiers say "signing" and why ValidateKeyVersionName requires a
-// cryptoKeyVersions suffix. A key's purpose is fixed at creation, so symmetric
-// ENCRYPT_DECRYPT support would not extend these types: it addresses keys at the
-// cryptoKeys level (KMS picks the version, and the ciphertext records which one),
-// has no public half, and so needs its own interface and validator. The parts
-// worth sharing at that point are the token source and the authenticated
-// connection setup in NewSigningClient, not this API.
+// The signing half is scoped to keys whose KMS purpose is ASYMMETRIC_SIGN,
+// which is why those identifiers say "signing" and why ValidateKeyVersionName
+// requires a cryptoKeyVersions suffix. A key's purpose is fixed at creation, so
+// symmetric ENCRYPT_DECRYPT support does not extend those types: it has its own
+// EncryptionClient and ValidateCryptoKeyName, addresses keys at the cryptoKeys
+// level (KMS picks the version, and the ciphertext records which one), and has
+// no public half. What the two halves share is the token source and the
+// authenticated connection setup, not an API.
 package gcpkms
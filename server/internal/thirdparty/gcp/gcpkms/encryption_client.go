@@ -0,0 +1,61 @@
+package gcpkms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrKeyUnavailable is returned when KMS refuses an encrypt or decrypt because
+// the customer has withdrawn the key: the grant was revoked, or the key or its
+// versions were disabled, destroyed or deleted. Callers treat it as the
+// customer's decision rather than an outage, and must not retry it into a
+// success they are no longer entitled to.
+var ErrKeyUnavailable = errors.New("gcp kms key unavailable")
+
+// EncryptionClient is the transport for keys whose purpose is ENCRYPT_DECRYPT,
+// the counterpart to SigningClient. It is an interface for the same reason:
+// consumers substitute LocalEncryptionClient in tests and local development,
+// where no GCP network path is reachable.
+//
+// Keys are named at the cryptoKeys level (see ValidateCryptoKeyName). The
+// additional authenticated data is bound into the ciphertext, so a wrapped value
+// cannot be replayed under a different context.
+type EncryptionClient interface {
+	// Encrypt seals plaintext under the key's primary version.
+	Encrypt(ctx context.Context, keyName string, plaintext, aad []byte) ([]byte, error)
+
+	// Decrypt opens a ciphertext produced by Encrypt with the same key and aad.
+	Decrypt(ctx context.Context, keyName string, ciphertext, aad []byte) ([]byte, error)
+
+	// Close releases the underlying connection. Every client must be closed.
+	io.Closer
+}
+
+// EncryptionClientFactory builds an EncryptionClient authenticated as some
+// identity, mirroring SigningClientFactory.
+type EncryptionClientFactory func(ctx context.Context, tokenSource oauth2.TokenSource) (EncryptionClient, error)
+
+var _ EncryptionClientFactory = NewEncryptionClient
+
+// keyUnavailable wraps err in ErrKeyUnavailable when its status says the key
+// has been withdrawn. Everything else, including transient unavailability, is
+// returned as it came so callers can tell a revocation from an outage.
+func keyUnavailable(err error) error {
+	sts, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch sts.Code() {
+	case codes.PermissionDenied, codes.NotFound, codes.FailedPrecondition:
+		return fmt.Errorf("%w: %w", ErrKeyUnavailable, err)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,29 @@
+package gcpkms
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A withdrawn key and an outage must stay distinguishable: callers give up on
+// the first and retry the second.
+func TestKeyUnavailable(t *testing.T) {
+	t.Parallel()
+
+	for _, code := range []codes.Code{codes.PermissionDenied, codes.NotFound, codes.FailedPrecondition} {
+		err := keyUnavailable(status.Error(code, "key withdrawn"))
+		require.ErrorIs(t, err, ErrKeyUnavailable, "%s", code)
+	}
+
+	for _, err := range []error{
+		status.Error(codes.Unavailable, "try again"),
+		status.Error(codes.DeadlineExceeded, "slow"),
+		errors.New("not a status"),
+	} {
+		require.NotErrorIs(t, keyUnavailable(err), ErrKeyUnavailable)
+	}
+}
@@ -0,0 +1,113 @@
+package gcpkms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	kms "cloud.google.com/go/kms/apiv1"
+	kmspb "cloud.google.com/go/kms/apiv1/kmspb"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/option"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var _ EncryptionClient = (*kmsEncryptionClient)(nil)
+
+// kmsEncryptionClient is the EncryptionClient backed by real GCP Cloud KMS.
+type kmsEncryptionClient struct {
+	kms *kms.KeyManagementClient
+}
+
+// NewEncryptionClient opens an authenticated GCP KMS client for symmetric keys.
+// As with NewSigningClient, the caller owns its lifetime and MUST Close it.
+func NewEncryptionClient(ctx context.Context, tokenSource oauth2.TokenSource) (EncryptionClient, error) {
+	c, err := kms.NewKeyManagementClient(ctx, option.WithTokenSource(tokenSource))
+	if err != nil {
+		return nil, fmt.Errorf("build gcp kms client: %w", err)
+	}
+
+	return &kmsEncryptionClient{kms: c}, nil
+}
+
+// Close releases the underlying gRPC connection.
+func (c *kmsEncryptionClient) Close() error {
+	if err := c.kms.Close(); err != nil {
+		return fmt.Errorf("close gcp kms client: %w", err)
+	}
+
+	return nil
+}
+
+// Encrypt seals plaintext under the key's primary version. Every CRC-32C guard
+// GCP offers is enforced in both directions, and the response must name a
+// version of the requested key, so a ciphertext that arrived corrupted or came
+// from the wrong key is an error rather than something stored and later found
+// to be unreadable.
+func (c *kmsEncryptionClient) Encrypt(ctx context.Context, keyName string, plaintext, aad []byte) ([]byte, error) {
+	if err := ValidateCryptoKeyName(keyName); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.kms.Encrypt(ctx, &kmspb.EncryptRequest{
+		Name:                              keyName,
+		Plaintext:                         plaintext,
+		AdditionalAuthenticatedData:       aad,
+		PlaintextCrc32C:                   wrapperspb.Int64(crc32c(plaintext)),
+		AdditionalAuthenticatedDataCrc32C: wrapperspb.Int64(crc32c(aad)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gcp kms encrypt: %w", keyUnavailable(err))
+	}
+
+	if got := resp.GetName(); !strings.HasPrefix(got, keyName+"/cryptoKeyVersions/") {
+		return nil, fmt.Errorf("gcp kms encrypt: response encrypted with %q, requested %q", got, keyName)
+	}
+
+	if !resp.GetVerifiedPlaintextCrc32C() || !resp.GetVerifiedAdditionalAuthenticatedDataCrc32C() {
+		return nil, errors.New("gcp kms encrypt: server could not verify the request checksums, request corrupted in transit")
+	}
+
+	ciphertext := resp.GetCiphertext()
+	checksum := resp.GetCiphertextCrc32C()
+	switch {
+	case checksum == nil:
+		return nil, errors.New("gcp kms encrypt: response omitted the ciphertext checksum")
+	case checksum.GetValue() != crc32c(ciphertext):
+		return nil, errors.New("gcp kms encrypt: ciphertext checksum mismatch, response corrupted in transit")
+	}
+
+	return ciphertext, nil
+}
+
+// Decrypt opens a ciphertext produced by Encrypt. KMS reads the version from
+// the ciphertext itself, so only the key is named. A key the customer has
+// disabled, destroyed or stopped granting fails with ErrKeyUnavailable.
+func (c *kmsEncryptionClient) Decrypt(ctx context.Context, keyName string, ciphertext, aad []byte) ([]byte, error) {
+	if err := ValidateCryptoKeyName(keyName); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.kms.Decrypt(ctx, &kmspb.DecryptRequest{
+		Name:                              keyName,
+		Ciphertext:                        ciphertext,
+		AdditionalAuthenticatedData:       aad,
+		CiphertextCrc32C:                  wrapperspb.Int64(crc32c(ciphertext)),
+		AdditionalAuthenticatedDataCrc32C: wrapperspb.Int64(crc32c(aad)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("gcp kms decrypt: %w", keyUnavailable(err))
+	}
+
+	plaintext := resp.GetPlaintext()
+	checksum := resp.GetPlaintextCrc32C()
+	switch {
+	case checksum == nil:
+		return nil, errors.New("gcp kms decrypt: response omitted the plaintext checksum")
+	case checksum.GetValue() != crc32c(plaintext):
+		return nil, errors.New("gcp kms decrypt: plaintext checksum mismatch, response corrupted in transit")
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,131 @@
+package gcpkms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var _ EncryptionClient = (*LocalEncryptionClient)(nil)
+
+// LocalEncryptionClient is an in-process EncryptionClient for use where no GCP
+// network path exists: CI, and local development without KMS access.
+//
+// Each key name gets its own AES-256-GCM key, derived from the client's seed
+// and the name, so a value encrypted under one name does not open under
+// another, and the additional authenticated data is bound exactly as KMS binds
+// it. Clients built from the same seed open each other's ciphertexts, which is
+// what lets separate local processes share wrapped keys. Revoke withdraws a key
+// the way a customer would, which is what lets crypto-shredding be exercised
+// without a cloud project.
+//
+// The zero value is not usable; construct one with NewLocalEncryptionClient.
+type LocalEncryptionClient struct {
+	mu      sync.Mutex
+	seed    []byte
+	keys    map[string]cipher.AEAD
+	revoked map[string]bool
+}
+
+// NewLocalEncryptionClient returns a client whose keys derive from seed.
+func NewLocalEncryptionClient(seed []byte) *LocalEncryptionClient {
+	return &LocalEncryptionClient{
+		mu:      sync.Mutex{},
+		seed:    seed,
+		keys:    make(map[string]cipher.AEAD),
+		revoked: make(map[string]bool),
+	}
+}
+
+// Revoke makes every later Encrypt and Decrypt under keyName fail with
+// ErrKeyUnavailable, as disabling or destroying the key does in KMS.
+func (c *LocalEncryptionClient) Revoke(keyName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.revoked[keyName] = true
+}
+
+// Encrypt seals plaintext under the key's in-process AES-GCM key. The resource
+// name is validated as the real client validates it, and a canceled or expired
+// context fails the call as it would against KMS.
+func (c *LocalEncryptionClient) Encrypt(ctx context.Context, keyName string, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := c.key(ctx, keyName)
+	if err != nil {
+		return nil, fmt.Errorf("local kms encrypt: %w", err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("local kms encrypt: generate nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+// Decrypt opens a ciphertext produced by Encrypt under the same key name and
+// additional authenticated data.
+func (c *LocalEncryptionClient) Decrypt(ctx context.Context, keyName string, ciphertext, aad []byte) ([]byte, error) {
+	gcm, err := c.key(ctx, keyName)
+	if err != nil {
+		return nil, fmt.Errorf("local kms decrypt: %w", err)
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("local kms decrypt: ciphertext too short")
+	}
+
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, sealed, aad)
+	if err != nil {
+		return nil, fmt.Errorf("local kms decrypt: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// Close is a no-op: the keys live in memory and there is no connection to
+// release.
+func (c *LocalEncryptionClient) Close() error {
+	return nil
+}
+
+func (c *LocalEncryptionClient) key(ctx context.Context, keyName string) (cipher.AEAD, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateCryptoKeyName(keyName); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.revoked[keyName] {
+		return nil, fmt.Errorf("%w: %s has been revoked", ErrKeyUnavailable, keyName)
+	}
+
+	if gcm, ok := c.keys[keyName]; ok {
+		return gcm, nil
+	}
+
+	mac := hmac.New(sha256.New, c.seed)
+	_, _ = mac.Write([]byte(keyName))
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create gcm: %w", err)
+	}
+
+	c.keys[keyName] = gcm
+	return gcm, nil
+}
@@ -0,0 +1,78 @@
+package gcpkms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testCryptoKeyName = "projects/gram-test/locations/us-central1/keyRings/byok/cryptoKeys/data"
+
+func TestLocalEncryptionClient_RoundTripBindsKeyAndAAD(t *testing.T) {
+	t.Parallel()
+
+	client := NewLocalEncryptionClient([]byte("test-seed"))
+	ctx := t.Context()
+
+	ciphertext, err := client.Encrypt(ctx, testCryptoKeyName, []byte("data key"), []byte("org-1"))
+	require.NoError(t, err)
+
+	plaintext, err := client.Decrypt(ctx, testCryptoKeyName, ciphertext, []byte("org-1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("data key"), plaintext)
+
+	_, err = client.Decrypt(ctx, testCryptoKeyName, ciphertext, []byte("org-2"))
+	require.Error(t, err, "a wrapped key must not open under another organization's aad")
+
+	_, err = client.Decrypt(ctx, testCryptoKeyName+"-other", ciphertext, []byte("org-1"))
+	require.Error(t, err, "a wrapped key must not open under another key")
+}
+
+func TestLocalEncryptionClient_Revoke(t *testing.T) {
+	t.Parallel()
+
+	client := NewLocalEncryptionClient([]byte("test-seed"))
+	ctx := t.Context()
+
+	ciphertext, err := client.Encrypt(ctx, testCryptoKeyName, []byte("data key"), nil)
+	require.NoError(t, err)
+
+	client.Revoke(testCryptoKeyName)
+
+	_, err = client.Decrypt(ctx, testCryptoKeyName, ciphertext, nil)
+	require.ErrorIs(t, err, ErrKeyUnavailable)
+	_, err = client.Encrypt(ctx, testCryptoKeyName, []byte("data key"), nil)
+	require.ErrorIs(t, err, ErrKeyUnavailable)
+}
+
+func TestLocalEncryptionClient_HonoursCanceledContextAndValidatesName(t *testing.T) {
+	t.Parallel()
+
+	client := NewLocalEncryptionClient([]byte("test-seed"))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err := client.Encrypt(ctx, testCryptoKeyName, []byte("x"), nil)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = client.Encrypt(t.Context(), testCryptoKeyName+"/cryptoKeyVersions/1", []byte("x"), nil)
+	require.ErrorIs(t, err, ErrInvalidResourceName)
+}
+
+// Separate local processes share wrapped keys only if they derive the same
+// key material from the same seed.
+func TestLocalEncryptionClient_SharedSeed(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	ciphertext, err := NewLocalEncryptionClient([]byte("seed")).Encrypt(ctx, testCryptoKeyName, []byte("data key"), nil)
+	require.NoError(t, err)
+
+	plaintext, err := NewLocalEncryptionClient([]byte("seed")).Decrypt(ctx, testCryptoKeyName, ciphertext, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte("data key"), plaintext)
+
+	_, err = NewLocalEncryptionClient([]byte("other")).Decrypt(ctx, testCryptoKeyName, ciphertext, nil)
+	require.Error(t, err)
+}
@@ -36,3 +36,25 @@ func ValidateKeyVersionName(resourceName string) error {
 
 	return nil
 }
+
+// cryptoKeyNamePattern matches a crypto key resource name WITHOUT a version.
+// Symmetric ENCRYPT_DECRYPT keys are addressed at this level: KMS encrypts with
+// the primary version and records in the ciphertext which version it used, so
+// decryption keeps working across the customer's own key rotations. A versioned
+// path is rejected because KMS refuses it for symmetric encryption anyway.
+var cryptoKeyNamePattern = regexp.MustCompile(
+	`^projects/[^/\s]+/locations/[^/\s]+/keyRings/[^/\s]+/cryptoKeys/[^/\s]+$`,
+)
+
+// ValidateCryptoKeyName reports whether a resource name identifies a crypto key
+// usable for symmetric encryption.
+func ValidateCryptoKeyName(resourceName string) error {
+	if !cryptoKeyNamePattern.MatchString(resourceName) {
+		return fmt.Errorf(
+			"%w: %q is not a projects/<p>/locations/<l>/keyRings/<r>/cryptoKeys/<k> path",
+			ErrInvalidResourceName, resourceName,
+		)
+	}
+
+	return nil
+}
@@ -40,3 +40,18 @@ func TestValidateKeyVersionName_RejectsMalformed(t *testing.T) {
 		require.ErrorIs(t, ValidateKeyVersionName(name), ErrInvalidResourceName, "should reject %q", name)
 	}
 }
+
+func TestValidateCryptoKeyName(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateCryptoKeyName("projects/p/locations/global/keyRings/r/cryptoKeys/k"))
+
+	for _, name := range []string{
+		"",
+		testResourceName,
+		"projects/p/locations/l/keyRings/r/cryptoKeys/",
+		"projects/p/locations/l/keyRings/r",
+	} {
+		require.ErrorIs(t, ValidateCryptoKeyName(name), ErrInvalidResourceName, "should reject %q", name)
+	}
+}
@@ -30,8 +30,8 @@ type PublicKey struct {
 // stand-in) in tests, where no GCP network path is reachable.
 //
 // The name is deliberate: a KMS key's purpose is fixed at creation, so a key
-// that encrypts cannot sign and vice versa. An encryption counterpart would be a
-// separate interface over Encrypt/Decrypt rather than more methods here.
+// that encrypts cannot sign and vice versa. The encryption counterpart is
+// EncryptionClient, a separate interface rather than more methods here.
 //
 // Signatures are returned in the provider's own encoding — PKCS#1 v1.5 for RSA,
 // ASN.1 DER for ECDSA — not the JOSE encoding. Converting is the signer's job,
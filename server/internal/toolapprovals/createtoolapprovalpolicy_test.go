@@ -0,0 +1,161 @@
+package toolapprovals_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_approvals"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/audit/audittest"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+func TestCreateToolApprovalPolicy_ToolsetTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	beforeCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolApprovalPolicyCreate)
+	require.NoError(t, err)
+
+	result, err := ti.service.CreateToolApprovalPolicy(ctx, &gen.CreateToolApprovalPolicyPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         nil,
+		MatchDestructive: true,
+		Expression:       new("amount > 500"),
+		Message:          new("Large refunds need a second pair of eyes."),
+		NotifyEmails:     []string{"Ops@Example.com", "ops@example.com"},
+		SlackWebhookURL:  new("https://hooks.slack.com/services/T000/B000/XXXX"),
+		TimeoutSeconds:   120,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, result.ID)
+	require.Equal(t, new(toolsetID.String()), result.ToolsetID)
+	require.Nil(t, result.McpServerID)
+	require.Nil(t, result.ToolName)
+	require.True(t, result.MatchDestructive)
+	require.Equal(t, new("amount > 500"), result.Expression)
+	require.Equal(t, []string{"ops@example.com"}, result.NotifyEmails)
+	require.True(t, result.SlackWebhookConfigured)
+	require.Equal(t, 120, result.TimeoutSeconds)
+
+	afterCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolApprovalPolicyCreate)
+	require.NoError(t, err)
+	require.Equal(t, beforeCount+1, afterCount)
+}
+
+func TestCreateToolApprovalPolicy_RequiresMatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	_, err := ti.service.CreateToolApprovalPolicy(ctx, &gen.CreateToolApprovalPolicyPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         new("delete_repo"),
+		MatchDestructive: false,
+		Expression:       nil,
+		Message:          nil,
+		NotifyEmails:     nil,
+		SlackWebhookURL:  nil,
+		TimeoutSeconds:   300,
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+}
+
+func TestCreateToolApprovalPolicy_RejectsInvalidExpression(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	_, err := ti.service.CreateToolApprovalPolicy(ctx, &gen.CreateToolApprovalPolicyPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         nil,
+		MatchDestructive: false,
+		Expression:       new("amount +"),
+		Message:          nil,
+		NotifyEmails:     nil,
+		SlackWebhookURL:  nil,
+		TimeoutSeconds:   300,
+	})
+	requireOopsCode(t, err, oops.CodeBadRequest)
+}
+
+func TestCreateToolApprovalPolicy_RejectsNonSlackWebhook(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	for _, webhook := range []string{"http://hooks.slack.com/services/x", "https://internal.example.com/hook", "https://user@hooks.slack.com/services/x"} {
+		_, err := ti.service.CreateToolApprovalPolicy(ctx, &gen.CreateToolApprovalPolicyPayload{
+			SessionToken:     nil,
+			ApikeyToken:      nil,
+			ProjectSlugInput: nil,
+			ToolsetID:        new(toolsetID.String()),
+			McpServerID:      nil,
+			ToolName:         nil,
+			MatchDestructive: true,
+			Expression:       nil,
+			Message:          nil,
+			NotifyEmails:     nil,
+			SlackWebhookURL:  new(webhook),
+			TimeoutSeconds:   300,
+		})
+		requireOopsCode(t, err, oops.CodeBadRequest)
+	}
+}
+
+func TestCreateToolApprovalPolicy_RequiresExactlyOneTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	_, err := ti.service.CreateToolApprovalPolicy(ctx, &gen.CreateToolApprovalPolicyPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        nil,
+		McpServerID:      nil,
+		ToolName:         nil,
+		MatchDestructive: true,
+		Expression:       nil,
+		Message:          nil,
+		NotifyEmails:     nil,
+		SlackWebhookURL:  nil,
+		TimeoutSeconds:   300,
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+}
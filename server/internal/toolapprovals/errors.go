@@ -0,0 +1,45 @@
+package toolapprovals
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// RefusedError reports a tools/call held for approval that was not approved.
+// It is not a protocol failure: MCP surfaces answer it with a tool result
+// flagged isError carrying Message, so the model learns the call did not run
+// and why.
+type RefusedError struct {
+	// ApprovalID is the held call's approval row, or uuid.Nil when the call was
+	// refused before one was created.
+	ApprovalID uuid.UUID
+	ToolName   string
+	// Status is StatusDenied or StatusExpired.
+	Status string
+	// Explanation overrides the status-derived reason when set.
+	Explanation string
+}
+
+func (e *RefusedError) Error() string {
+	return fmt.Sprintf("tool call approval %s %s", e.ApprovalID, e.Status)
+}
+
+// Message is the model-facing explanation of the refusal.
+func (e *RefusedError) Message() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "The call to %s did not run: ", e.ToolName)
+
+	switch {
+	case e.Explanation != "":
+		b.WriteString(e.Explanation)
+	case e.Status == StatusExpired:
+		b.WriteString("it requires human approval and no decision was made in time")
+	default:
+		b.WriteString("a human declined to approve it")
+	}
+	b.WriteString(". Do not retry it unless the user asks you to.")
+
+	return b.String()
+}
@@ -0,0 +1,38 @@
+package toolapprovals_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals"
+)
+
+func TestRefusedError_Message(t *testing.T) {
+	t.Parallel()
+
+	denied := &toolapprovals.RefusedError{
+		ApprovalID:  uuid.New(),
+		ToolName:    "delete_customer",
+		Status:      toolapprovals.StatusDenied,
+		Explanation: "",
+	}
+	require.Equal(t, "The call to delete_customer did not run: a human declined to approve it. Do not retry it unless the user asks you to.", denied.Message())
+
+	expired := &toolapprovals.RefusedError{
+		ApprovalID:  uuid.New(),
+		ToolName:    "delete_customer",
+		Status:      toolapprovals.StatusExpired,
+		Explanation: "",
+	}
+	require.Contains(t, expired.Message(), "no decision was made in time")
+
+	unreachable := &toolapprovals.RefusedError{
+		ApprovalID:  uuid.Nil,
+		ToolName:    "delete_customer",
+		Status:      toolapprovals.StatusDenied,
+		Explanation: "no approver could be reached",
+	}
+	require.Contains(t, unreachable.Message(), "no approver could be reached")
+}
@@ -0,0 +1,599 @@
+package toolapprovals
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals/repo"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints/celenv"
+)
+
+// Channels an approval can be requested on.
+const (
+	// ChannelElicitation asks the end user through the calling MCP client.
+	ChannelElicitation = "elicitation"
+	// ChannelLink sends an approval link to the policy's email and Slack
+	// approvers.
+	ChannelLink = "link"
+)
+
+// Statuses of a held call.
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusDenied   = "denied"
+	StatusExpired  = "expired"
+)
+
+// ElicitationRequestIDPrefix prefixes the JSON-RPC id of every
+// elicitation/create request the gate sends, so the MCP handler receiving the
+// client's response can tell it apart from anything else and route it back
+// with [Gate.RecordElicitationDecision].
+const ElicitationRequestIDPrefix = "gram-approval:"
+
+const (
+	// programCacheSize bounds the number of distinct compiled policy
+	// expressions held in memory across all projects.
+	programCacheSize = 1024
+
+	// recheckInterval is how often a held call rechecks its approval row and
+	// keeps the client connection alive. Decisions are announced on
+	// decisionChannel, so the recheck only covers an announcement the
+	// listener missed.
+	recheckInterval = 5 * time.Second
+
+	// maxPromptArgumentsLength bounds the arguments echoed to an approver.
+	maxPromptArgumentsLength = 1000
+
+	// linkTokenBytes is the entropy of an approval link token.
+	linkTokenBytes = 32
+)
+
+// Call identifies one tools/call for approval. At least one of ToolsetID and
+// McpServerID is set; policies attached to either apply.
+type Call struct {
+	OrganizationID string
+	ProjectID      uuid.UUID
+	ProjectSlug    string
+	ToolsetID      uuid.NullUUID
+	ToolsetSlug    string
+	McpServerID    uuid.NullUUID
+	ToolName       string
+	Arguments      json.RawMessage
+	// Destructive reports whether the tool's annotations mark it destructive.
+	Destructive bool
+	// SessionID is the caller's MCP session. An elicitation is bound to it so
+	// only the session that was asked can answer.
+	SessionID string
+	// RequestedBy identifies the caller to approvers, e.g. their email.
+	RequestedBy string
+}
+
+// ClientChannel is the response stream of the held tools/call. A nil
+// ClientChannel means the response cannot stream, so the gate can neither
+// elicit nor keep the connection alive while it waits.
+type ClientChannel interface {
+	// CanElicit reports whether the MCP client declared the elicitation
+	// capability. It is only consulted once a policy has matched.
+	CanElicit(ctx context.Context) bool
+	// Elicit sends an elicitation/create request with the given JSON-RPC id
+	// asking the end user to approve the call.
+	Elicit(ctx context.Context, requestID string, message string) error
+	// Keepalive is called periodically while the call is held.
+	Keepalive(ctx context.Context)
+}
+
+// Gate holds tools/call requests that match a user-configured approval policy
+// until a human approves them, refuses them, or the policy's timeout passes.
+//
+// The approval is requested from the end user through MCP elicitation when
+// the client supports it and the response can stream; otherwise each of the
+// policy's email and Slack approvers is sent their own approval link, so the
+// decision records who made it. Like the constraint enforcer the gate fails
+// closed: a policy that cannot be loaded or evaluated holds the call, and a
+// call no one can be asked about is refused. A nil *Gate admits every call.
+type Gate struct {
+	logger          *slog.Logger
+	db              *pgxpool.Pool
+	policies        *PolicyCache
+	programs        *celenv.Programs
+	notifier        *Notifier
+	serverURL       *url.URL
+	listener        *decisionListener
+	recheckInterval time.Duration
+	decisions       metric.Int64Counter
+}
+
+func NewGate(
+	logger *slog.Logger,
+	meterProvider metric.MeterProvider,
+	db *pgxpool.Pool,
+	policies *PolicyCache,
+	celEng *celenv.Engine,
+	notifier *Notifier,
+	serverURL *url.URL,
+) (*Gate, error) {
+	logger = logger.With(attr.SlogComponent("toolapprovals"))
+	meter := meterProvider.Meter("github.com/speakeasy-api/gram/server/internal/toolapprovals")
+
+	decisions, err := meter.Int64Counter(
+		"mcp.tool_call.approval",
+		metric.WithDescription("Tool calls held for human approval, by channel and outcome"),
+		metric.WithUnit("{call}"),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "failed to create tool call approval counter", attr.SlogError(err))
+	}
+
+	programs, err := celenv.NewPrograms(celEng, programCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("create approval policy program cache: %w", err)
+	}
+
+	return &Gate{
+		logger:          logger,
+		db:              db,
+		policies:        policies,
+		programs:        programs,
+		notifier:        notifier,
+		serverURL:       serverURL,
+		listener:        newDecisionListener(logger, db),
+		recheckInterval: recheckInterval,
+		decisions:       decisions,
+	}, nil
+}
+
+// Shutdown closes the connection the gate listens for decisions on. Calls
+// still held keep rechecking their approval rows until they settle.
+func (g *Gate) Shutdown(ctx context.Context) error {
+	if g == nil {
+		return nil
+	}
+	return g.listener.stop(ctx)
+}
+
+// Await returns nil when no policy governs call or a human approved it. A
+// call that was denied, declined or not decided in time returns a
+// *RefusedError; any other error means the call could not be held.
+func (g *Gate) Await(ctx context.Context, call Call, client ClientChannel) error {
+	if g == nil || (!call.ToolsetID.Valid && !call.McpServerID.Valid) {
+		return nil
+	}
+
+	logger := g.logger.With(attr.SlogProjectID(call.ProjectID.String()), attr.SlogToolName(call.ToolName))
+
+	policy, matched, err := g.match(ctx, logger, call)
+	if err != nil {
+		return err
+	}
+	if !matched {
+		return nil
+	}
+
+	channel := ChannelLink
+	if client != nil && call.SessionID != "" && client.CanElicit(ctx) {
+		channel = ChannelElicitation
+	}
+
+	if channel == ChannelLink && !g.notifier.CanNotify(policy) {
+		refusal := &RefusedError{
+			ApprovalID:  uuid.Nil,
+			ToolName:    call.ToolName,
+			Status:      StatusDenied,
+			Explanation: "this tool requires approval, but the client cannot ask for it and the policy names no approvers",
+		}
+		g.record(ctx, logger, policy.ID, uuid.Nil, channel, StatusDenied)
+		return refusal
+	}
+
+	approval, reviewURLs, err := g.createApproval(ctx, policy, call, channel)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "create tool call approval").LogError(ctx, logger)
+	}
+	logger = logger.With(attr.SlogToolCallApprovalID(approval.ID.String()), attr.SlogToolCallApprovalChannel(channel))
+
+	// Subscribe before asking anyone, so a decision made straight away is
+	// not announced before the gate is listening for it.
+	decided, unsubscribe := g.listener.subscribe(approval.ID)
+	defer unsubscribe()
+
+	trace.SpanFromContext(ctx).AddEvent("tool_call.approval_requested", trace.WithAttributes(
+		attr.ToolApprovalPolicyID(policy.ID.String()),
+		attr.ToolCallApprovalID(approval.ID.String()),
+		attr.ToolCallApprovalChannel(channel),
+	))
+
+	prompt := approvalPrompt(policy, call)
+
+	switch channel {
+	case ChannelElicitation:
+		if err := client.Elicit(ctx, ElicitationRequestIDPrefix+approval.ID.String(), prompt); err != nil {
+			g.expire(ctx, logger, approval.ID)
+			return oops.E(oops.CodeUnexpected, err, "send approval elicitation").LogError(ctx, logger)
+		}
+	default:
+		delivered, err := g.notifier.Notify(ctx, ApprovalRequest{
+			Policy:     policy,
+			Approval:   approval,
+			Call:       call,
+			Prompt:     prompt,
+			ReviewURLs: reviewURLs,
+		})
+		if err != nil {
+			logger.WarnContext(ctx, "failed to deliver some tool call approval requests", attr.SlogError(err))
+		}
+		if delivered == 0 {
+			g.expire(ctx, logger, approval.ID)
+			g.record(ctx, logger, policy.ID, approval.ID, channel, StatusDenied)
+			return &RefusedError{
+				ApprovalID:  approval.ID,
+				ToolName:    call.ToolName,
+				Status:      StatusDenied,
+				Explanation: "this tool requires approval, but no approver could be reached",
+			}
+		}
+	}
+
+	status, err := g.wait(ctx, logger, approval, decided, client)
+	if err != nil {
+		return err
+	}
+
+	g.record(ctx, logger, policy.ID, approval.ID, channel, status)
+
+	if status == StatusApproved {
+		return nil
+	}
+
+	return &RefusedError{
+		ApprovalID:  approval.ID,
+		ToolName:    call.ToolName,
+		Status:      status,
+		Explanation: "",
+	}
+}
+
+// wait blocks until the approval is decided or expires. It reads the
+// approval row whenever a decision is announced on decided and, as a fallback
+// for a missed announcement, every recheckInterval, when it also keeps the
+// client connection alive.
+func (g *Gate) wait(ctx context.Context, logger *slog.Logger, approval repo.ToolCallApproval, decided <-chan struct{}, client ClientChannel) (string, error) {
+	deadline := time.NewTimer(time.Until(approval.ExpiresAt.Time))
+	defer deadline.Stop()
+	ticker := time.NewTicker(g.recheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			g.expire(ctx, logger, approval.ID)
+			return "", oops.E(oops.CodeUnexpected, ctx.Err(), "tool call abandoned while awaiting approval")
+		case <-deadline.C:
+			g.expire(ctx, logger, approval.ID)
+			status, err := repo.New(g.db).GetToolCallApprovalStatus(context.WithoutCancel(ctx), approval.ID)
+			if err != nil {
+				return "", oops.E(oops.CodeUnexpected, err, "read tool call approval").LogError(ctx, logger)
+			}
+			return status.Status, nil
+		case <-decided:
+		case <-ticker.C:
+			if client != nil {
+				client.Keepalive(ctx)
+			}
+		}
+
+		status, err := repo.New(g.db).GetToolCallApprovalStatus(ctx, approval.ID)
+		if err != nil {
+			return "", oops.E(oops.CodeUnexpected, err, "read tool call approval").LogError(ctx, logger)
+		}
+		if status.Status != StatusPending {
+			return status.Status, nil
+		}
+	}
+}
+
+// RecordElicitationDecision records the end user's answer to an approval
+// elicitation. requestID is the JSON-RPC id the gate sent; only the MCP
+// session the elicitation was sent to can answer it. It reports whether
+// requestID belongs to the gate at all. An answer to an approval that was
+// already decided, has expired or belongs to another session is ignored.
+func (g *Gate) RecordElicitationDecision(ctx context.Context, requestID string, sessionID string, approved bool) (bool, error) {
+	rawID, ok := strings.CutPrefix(requestID, ElicitationRequestIDPrefix)
+	if !ok {
+		return false, nil
+	}
+	if g == nil {
+		return true, nil
+	}
+
+	approvalID, err := uuid.Parse(rawID)
+	if err != nil || sessionID == "" {
+		return true, nil
+	}
+
+	status := StatusDenied
+	if approved {
+		status = StatusApproved
+	}
+
+	_, err = repo.New(g.db).DecideToolCallApprovalBySession(ctx, repo.DecideToolCallApprovalBySessionParams{
+		Status:        status,
+		ID:            approvalID,
+		SessionIDHash: conv.ToPGText(hashSecret(sessionID)),
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		g.logger.InfoContext(ctx, "ignored answer to unknown or settled approval elicitation", attr.SlogToolCallApprovalID(approvalID.String()))
+		return true, nil
+	case err != nil:
+		return true, oops.E(oops.CodeUnexpected, err, "record approval elicitation answer").LogError(ctx, g.logger, attr.SlogToolCallApprovalID(approvalID.String()))
+	}
+
+	g.announce(ctx, approvalID)
+
+	return true, nil
+}
+
+// decideByLink records a decision made through an approval link, attributed
+// to the approver the link was sent to. It returns pgx.ErrNoRows when the link
+// is unknown, spent or expired.
+func (g *Gate) decideByLink(ctx context.Context, approvalID uuid.UUID, token string, approved bool) (repo.ToolCallApproval, error) {
+	status := StatusDenied
+	if approved {
+		status = StatusApproved
+	}
+
+	decided, err := repo.New(g.db).DecideToolCallApprovalByLink(ctx, repo.DecideToolCallApprovalByLinkParams{
+		Status:    status,
+		ID:        approvalID,
+		TokenHash: hashSecret(token),
+	})
+	if err != nil {
+		return repo.ToolCallApproval{}, fmt.Errorf("decide tool call approval: %w", err)
+	}
+
+	g.announce(ctx, approvalID)
+
+	return decided, nil
+}
+
+// announce wakes the server instance holding a decided call. The decision is
+// committed, so a failed announcement only delays the holder until its next
+// recheck.
+func (g *Gate) announce(ctx context.Context, approvalID uuid.UUID) {
+	if err := repo.New(g.db).NotifyToolCallApprovalDecided(ctx, repo.NotifyToolCallApprovalDecidedParams{
+		Channel:    decisionChannel,
+		ApprovalID: approvalID.String(),
+	}); err != nil {
+		g.logger.WarnContext(ctx, "failed to announce tool call approval decision", attr.SlogToolCallApprovalID(approvalID.String()), attr.SlogError(err))
+	}
+}
+
+// match returns the first policy governing call whose matcher selects it. A
+// policy expression that cannot be evaluated selects the call, so a broken
+// policy holds calls rather than waving them through.
+func (g *Gate) match(ctx context.Context, logger *slog.Logger, call Call) (repo.ToolApprovalPolicy, bool, error) {
+	policies, err := g.policiesForCall(ctx, call)
+	if err != nil {
+		return repo.ToolApprovalPolicy{}, false, oops.E(oops.CodeUnexpected, err, "failed to load tool approval policies").LogError(ctx, logger)
+	}
+	if len(policies) == 0 {
+		return repo.ToolApprovalPolicy{}, false, nil
+	}
+
+	var args map[string]any
+	var argsErr error
+	argsDecoded := false
+
+	for _, policy := range policies {
+		if policy.MatchDestructive && call.Destructive {
+			return policy, true, nil
+		}
+		if !policy.Expression.Valid {
+			continue
+		}
+
+		if !argsDecoded {
+			args, argsErr = celenv.DecodeArguments(call.Arguments)
+			argsDecoded = true
+		}
+
+		matched, evalErr := false, argsErr
+		if evalErr == nil {
+			matched, evalErr = g.programs.Eval(policy.Expression.String, args)
+		}
+		if evalErr != nil {
+			logger.WarnContext(ctx, "approval policy expression could not be evaluated; holding call", attr.SlogToolApprovalPolicyID(policy.ID.String()), attr.SlogError(evalErr))
+			return policy, true, nil
+		}
+		if matched {
+			return policy, true, nil
+		}
+	}
+
+	return repo.ToolApprovalPolicy{}, false, nil
+}
+
+// policiesForCall returns the policies governing call: those attached to its
+// toolset or MCP server that name the called tool or govern every tool,
+// ordered by id.
+func (g *Gate) policiesForCall(ctx context.Context, call Call) ([]repo.ToolApprovalPolicy, error) {
+	var policies []repo.ToolApprovalPolicy
+	if call.ToolsetID.Valid {
+		toolsetPolicies, err := g.policies.Get(ctx, call.ProjectID, call.ToolsetID, uuid.NullUUID{UUID: uuid.Nil, Valid: false})
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, toolsetPolicies...)
+	}
+	if call.McpServerID.Valid {
+		serverPolicies, err := g.policies.Get(ctx, call.ProjectID, uuid.NullUUID{UUID: uuid.Nil, Valid: false}, call.McpServerID)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, serverPolicies...)
+	}
+
+	policies = slices.DeleteFunc(policies, func(policy repo.ToolApprovalPolicy) bool {
+		return policy.ToolName.Valid && policy.ToolName.String != call.ToolName
+	})
+	slices.SortFunc(policies, func(a, b repo.ToolApprovalPolicy) int {
+		return bytes.Compare(a.ID[:], b.ID[:])
+	})
+
+	return policies, nil
+}
+
+// createApproval stores the held call. A link approval gets one link per
+// approver the notifier can reach, each with its own token so a decision is
+// attributed to whoever made it; the review URLs are returned keyed by
+// approver.
+func (g *Gate) createApproval(ctx context.Context, policy repo.ToolApprovalPolicy, call Call, channel string) (repo.ToolCallApproval, map[string]string, error) {
+	arguments := call.Arguments
+	if len(strings.TrimSpace(string(arguments))) == 0 || string(arguments) == "null" {
+		arguments = json.RawMessage("{}")
+	}
+
+	sessionIDHash := pgtype.Text{String: "", Valid: false}
+	if channel == ChannelElicitation {
+		sessionIDHash = conv.ToPGText(hashSecret(call.SessionID))
+	}
+
+	dbtx, err := g.db.Begin(ctx)
+	if err != nil {
+		return repo.ToolCallApproval{}, nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	tx := repo.New(dbtx)
+
+	approval, err := tx.CreateToolCallApproval(ctx, repo.CreateToolCallApprovalParams{
+		ProjectID:     call.ProjectID,
+		PolicyID:      policy.ID,
+		ToolsetID:     call.ToolsetID,
+		McpServerID:   call.McpServerID,
+		ToolName:      call.ToolName,
+		Arguments:     arguments,
+		Channel:       channel,
+		SessionIDHash: sessionIDHash,
+		RequestedBy:   conv.ToPGTextEmpty(call.RequestedBy),
+		ExpiresAt:     pgtype.Timestamptz{Time: time.Now().Add(time.Duration(policy.TimeoutSeconds) * time.Second), InfinityModifier: pgtype.Finite, Valid: true},
+	})
+	if err != nil {
+		return repo.ToolCallApproval{}, nil, fmt.Errorf("insert tool call approval: %w", err)
+	}
+
+	reviewURLs := map[string]string{}
+	if channel == ChannelLink {
+		for _, approver := range g.notifier.Approvers(policy) {
+			token, err := newLinkToken()
+			if err != nil {
+				return repo.ToolCallApproval{}, nil, err
+			}
+
+			if err := tx.CreateToolCallApprovalLink(ctx, repo.CreateToolCallApprovalLinkParams{
+				ApprovalID: approval.ID,
+				Approver:   approver,
+				TokenHash:  hashSecret(token),
+			}); err != nil {
+				return repo.ToolCallApproval{}, nil, fmt.Errorf("insert tool call approval link: %w", err)
+			}
+
+			reviewURLs[approver] = g.reviewURL(approval.ID, token)
+		}
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return repo.ToolCallApproval{}, nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return approval, reviewURLs, nil
+}
+
+// expire marks a pending approval expired. It runs detached from ctx so an
+// abandoned call still settles its row.
+func (g *Gate) expire(ctx context.Context, logger *slog.Logger, approvalID uuid.UUID) {
+	if err := repo.New(g.db).ExpireToolCallApproval(context.WithoutCancel(ctx), approvalID); err != nil {
+		logger.ErrorContext(ctx, "failed to expire tool call approval", attr.SlogError(err))
+	}
+}
+
+func (g *Gate) record(ctx context.Context, logger *slog.Logger, policyID uuid.UUID, approvalID uuid.UUID, channel string, status string) {
+	if g.decisions != nil {
+		g.decisions.Add(ctx, 1, metric.WithAttributes(
+			attr.ToolCallApprovalChannel(channel),
+			attr.ToolCallApprovalStatus(status),
+		))
+	}
+
+	logger.InfoContext(ctx, "tool call approval settled",
+		attr.SlogToolApprovalPolicyID(policyID.String()),
+		attr.SlogToolCallApprovalID(approvalID.String()),
+		attr.SlogToolCallApprovalChannel(channel),
+		attr.SlogToolCallApprovalStatus(status),
+	)
+}
+
+func (g *Gate) reviewURL(approvalID uuid.UUID, token string) string {
+	if g.serverURL == nil {
+		return ""
+	}
+
+	u := g.serverURL.JoinPath("tool-approvals", approvalID.String())
+	u.RawQuery = url.Values{"token": []string{token}}.Encode()
+	return u.String()
+}
+
+// approvalPrompt is the question put to the approver.
+func approvalPrompt(policy repo.ToolApprovalPolicy, call Call) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Allow %s to run", call.ToolName)
+
+	args := strings.TrimSpace(string(call.Arguments))
+	if args != "" && args != "null" && args != "{}" {
+		fmt.Fprintf(&b, " with arguments %s", conv.TruncateString(args, maxPromptArgumentsLength))
+	}
+	b.WriteString("?")
+
+	if policy.Message.Valid && policy.Message.String != "" {
+		fmt.Fprintf(&b, " %s", policy.Message.String)
+	}
+
+	return b.String()
+}
+
+func newLinkToken() (string, error) {
+	buf := make([]byte, linkTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate approval link token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,252 @@
+package toolapprovals_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_approvals"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals/repo"
+)
+
+// fakeClient answers every elicitation with the scripted decisions, in order,
+// as if the MCP client POSTed them back.
+type fakeClient struct {
+	gate    *toolapprovals.Gate
+	answers []fakeAnswer
+
+	mu       sync.Mutex
+	messages []string
+}
+
+type fakeAnswer struct {
+	sessionID string
+	approved  bool
+}
+
+func (c *fakeClient) CanElicit(context.Context) bool { return true }
+
+func (c *fakeClient) Elicit(ctx context.Context, requestID string, message string) error {
+	c.mu.Lock()
+	c.messages = append(c.messages, message)
+	c.mu.Unlock()
+
+	go func() {
+		for _, answer := range c.answers {
+			_, _ = c.gate.RecordElicitationDecision(context.WithoutCancel(ctx), requestID, answer.sessionID, answer.approved)
+		}
+	}()
+	return nil
+}
+
+func (c *fakeClient) Keepalive(context.Context) {}
+
+func createDestructivePolicy(t *testing.T, ctx context.Context, ti *testInstance, toolsetID uuid.UUID) {
+	t.Helper()
+
+	_, err := ti.service.CreateToolApprovalPolicy(ctx, &gen.CreateToolApprovalPolicyPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         nil,
+		MatchDestructive: true,
+		Expression:       new(`has(args.force) && args.force`),
+		Message:          new("This cannot be undone."),
+		NotifyEmails:     nil,
+		SlackWebhookURL:  nil,
+		TimeoutSeconds:   30,
+	})
+	require.NoError(t, err)
+}
+
+func destructiveCall(ctx context.Context, t *testing.T, toolsetID uuid.UUID, destructive bool, args string) toolapprovals.Call {
+	t.Helper()
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	return toolapprovals.Call{
+		OrganizationID: authCtx.ActiveOrganizationID,
+		ProjectID:      *authCtx.ProjectID,
+		ProjectSlug:    "test-project",
+		ToolsetID:      uuid.NullUUID{UUID: toolsetID, Valid: true},
+		ToolsetSlug:    "test-toolset",
+		McpServerID:    uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		ToolName:       "delete_repo",
+		Arguments:      json.RawMessage(args),
+		Destructive:    destructive,
+		SessionID:      "session-1",
+		RequestedBy:    "dev@example.com",
+	}
+}
+
+func TestGate_AdmitsCallsNoPolicyMatches(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+	createDestructivePolicy(t, ctx, ti, toolsetID)
+
+	require.NoError(t, ti.gate.Await(ctx, destructiveCall(ctx, t, toolsetID, false, `{"force":false}`), nil))
+}
+
+func TestGate_ElicitationApproved(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+	createDestructivePolicy(t, ctx, ti, toolsetID)
+
+	client := &fakeClient{gate: ti.gate, answers: []fakeAnswer{{sessionID: "session-1", approved: true}}, mu: sync.Mutex{}, messages: nil}
+	require.NoError(t, ti.gate.Await(ctx, destructiveCall(ctx, t, toolsetID, true, `{"repo":"widgets"}`), client))
+
+	require.Len(t, client.messages, 1)
+	require.Contains(t, client.messages[0], "delete_repo")
+	require.Contains(t, client.messages[0], "This cannot be undone.")
+}
+
+func TestGate_ElicitationIgnoresOtherSessions(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+	createDestructivePolicy(t, ctx, ti, toolsetID)
+
+	client := &fakeClient{gate: ti.gate, answers: []fakeAnswer{
+		{sessionID: "someone-else", approved: true},
+		{sessionID: "session-1", approved: false},
+	}, mu: sync.Mutex{}, messages: nil}
+
+	err := ti.gate.Await(ctx, destructiveCall(ctx, t, toolsetID, false, `{"force":true}`), client)
+	refusal, ok := errors.AsType[*toolapprovals.RefusedError](err)
+	require.True(t, ok, "expected a refusal, got %v", err)
+	require.Equal(t, toolapprovals.StatusDenied, refusal.Status)
+}
+
+func TestGate_RefusesWhenNoApproverCanBeAsked(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+	createDestructivePolicy(t, ctx, ti, toolsetID)
+
+	err := ti.gate.Await(ctx, destructiveCall(ctx, t, toolsetID, true, `{}`), nil)
+	refusal, ok := errors.AsType[*toolapprovals.RefusedError](err)
+	require.True(t, ok, "expected a refusal, got %v", err)
+	require.Contains(t, refusal.Message(), "Do not retry")
+}
+
+func TestGate_ApprovalLink(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	router := chi.NewRouter()
+	router.Get("/tool-approvals/{id}", oops.ErrHandle(testenv.NewLogger(t), ti.gate.ServeApprovalPage).ServeHTTP)
+	router.Post("/tool-approvals/{id}", oops.ErrHandle(testenv.NewLogger(t), ti.gate.DecideApproval).ServeHTTP)
+
+	reviewLink := regexp.MustCompile(`<(http[^|>]+)\|`)
+	reviewed := make(chan string, 1)
+	slack := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Text string `json:"text"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		match := reviewLink.FindStringSubmatch(body.Text)
+		if match == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+
+		go func() {
+			link, err := url.Parse(match[1])
+			if err != nil {
+				return
+			}
+			reviewed <- path.Base(link.Path)
+
+			page := httptest.NewRecorder()
+			router.ServeHTTP(page, httptest.NewRequestWithContext(context.Background(), http.MethodGet, link.RequestURI(), nil))
+			if page.Code != http.StatusOK || !strings.Contains(page.Body.String(), "delete_repo") {
+				return
+			}
+
+			form := url.Values{"token": {link.Query().Get("token")}, "decision": {"approve"}}
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, link.Path, strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			router.ServeHTTP(httptest.NewRecorder(), req)
+		}()
+	}))
+	t.Cleanup(slack.Close)
+
+	// The service only accepts hooks.slack.com webhooks, so the policy is
+	// seeded directly to point at the local stand-in.
+	sealed, err := ti.enc.Encrypt([]byte(slack.URL))
+	require.NoError(t, err)
+	_, err = repo.New(ti.conn).CreateToolApprovalPolicy(ctx, repo.CreateToolApprovalPolicyParams{
+		ProjectID:                *authCtx.ProjectID,
+		ToolsetID:                uuid.NullUUID{UUID: toolsetID, Valid: true},
+		McpServerID:              uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		ToolName:                 conv.ToPGText("delete_repo"),
+		MatchDestructive:         true,
+		Expression:               conv.ToPGTextEmpty(""),
+		Message:                  conv.ToPGTextEmpty(""),
+		NotifyEmails:             []string{},
+		SlackWebhookUrlEncrypted: conv.ToPGText(sealed),
+		TimeoutSeconds:           30,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ti.gate.Await(ctx, destructiveCall(ctx, t, toolsetID, true, `{"repo":"widgets"}`), nil))
+
+	approvalID, err := uuid.Parse(<-reviewed)
+	require.NoError(t, err)
+	approval, err := repo.New(ti.conn).GetToolCallApproval(ctx, approvalID)
+	require.NoError(t, err)
+	require.Equal(t, toolapprovals.StatusApproved, approval.Status)
+	require.Equal(t, "slack", conv.PtrValOr(conv.FromPGText[string](approval.DecidedBy), ""))
+}
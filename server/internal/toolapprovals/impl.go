@@ -0,0 +1,515 @@
+package toolapprovals
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+	goahttp "goa.design/goa/v3/http"
+	"goa.design/goa/v3/security"
+
+	srv "github.com/speakeasy-api/gram/server/gen/http/tool_approvals/server"
+	gen "github.com/speakeasy-api/gram/server/gen/tool_approvals"
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/auth"
+	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/middleware"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals/repo"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints/celenv"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+type Service struct {
+	tracer   trace.Tracer
+	logger   *slog.Logger
+	db       *pgxpool.Pool
+	auth     *auth.Auth
+	authz    *authz.Engine
+	audit    *audit.Logger
+	celEng   *celenv.Engine
+	enc      *encryption.Client
+	policies *PolicyCache
+}
+
+var _ gen.Service = (*Service)(nil)
+var _ gen.Auther = (*Service)(nil)
+
+func NewService(
+	logger *slog.Logger,
+	tracerProvider trace.TracerProvider,
+	db *pgxpool.Pool,
+	sessions *sessions.Manager,
+	authzEngine *authz.Engine,
+	auditLogger *audit.Logger,
+	celEng *celenv.Engine,
+	enc *encryption.Client,
+	policies *PolicyCache,
+) *Service {
+	logger = logger.With(attr.SlogComponent("toolapprovals"))
+
+	return &Service{
+		tracer:   tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/toolapprovals"),
+		logger:   logger,
+		db:       db,
+		auth:     auth.New(logger, db, sessions, authzEngine),
+		authz:    authzEngine,
+		audit:    auditLogger,
+		celEng:   celEng,
+		enc:      enc,
+		policies: policies,
+	}
+}
+
+// Attach mounts the policy management API and, when gate is non-nil, the
+// public page approvers reach through an approval link.
+func Attach(mux goahttp.Muxer, service *Service, gate *Gate) {
+	endpoints := gen.NewEndpoints(service)
+	endpoints.Use(middleware.MapErrors())
+	endpoints.Use(middleware.TraceMethods(service.tracer))
+	srv.Mount(
+		mux,
+		srv.New(endpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil),
+	)
+
+	if gate != nil {
+		o11y.AttachHandler(mux, http.MethodGet, "/tool-approvals/{id}", oops.ErrHandle(gate.logger, gate.ServeApprovalPage).ServeHTTP)
+		o11y.AttachHandler(mux, http.MethodPost, "/tool-approvals/{id}", oops.ErrHandle(gate.logger, gate.DecideApproval).ServeHTTP)
+	}
+}
+
+func (s *Service) APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error) {
+	return s.auth.Authorize(ctx, key, schema)
+}
+
+func (s *Service) CreateToolApprovalPolicy(ctx context.Context, payload *gen.CreateToolApprovalPolicyPayload) (*types.ToolApprovalPolicy, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	toolsetID, err := conv.PtrToNullUUID(payload.ToolsetID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid toolset_id").LogError(ctx, logger)
+	}
+	mcpServerID, err := conv.PtrToNullUUID(payload.McpServerID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid mcp_server_id").LogError(ctx, logger)
+	}
+	if toolsetID.Valid == mcpServerID.Valid {
+		return nil, oops.E(oops.CodeInvalid, nil, "exactly one of toolset_id or mcp_server_id is required").LogError(ctx, logger)
+	}
+
+	expression := conv.PtrToPGTextEmpty(payload.Expression)
+	if !payload.MatchDestructive && !expression.Valid {
+		return nil, oops.E(oops.CodeInvalid, nil, "a policy must set match_destructive or an expression").LogError(ctx, logger)
+	}
+	if expression.Valid {
+		if err := s.compileExpression(expression.String); err != nil {
+			return nil, err
+		}
+	}
+
+	slackWebhook, err := s.sealSlackWebhook(payload.SlackWebhookURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTarget(ctx, *authCtx.ProjectID, toolsetID, mcpServerID); err != nil {
+		return nil, err
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	created, err := repo.New(dbtx).CreateToolApprovalPolicy(ctx, repo.CreateToolApprovalPolicyParams{
+		ProjectID:                *authCtx.ProjectID,
+		ToolsetID:                toolsetID,
+		McpServerID:              mcpServerID,
+		ToolName:                 conv.PtrToPGTextEmpty(payload.ToolName),
+		MatchDestructive:         payload.MatchDestructive,
+		Expression:               expression,
+		Message:                  conv.PtrToPGTextEmpty(payload.Message),
+		NotifyEmails:             normalizeEmails(payload.NotifyEmails),
+		SlackWebhookUrlEncrypted: slackWebhook,
+		TimeoutSeconds:           int32(payload.TimeoutSeconds), // #nosec G115 // bounded to [10, 900] by the design
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "create tool approval policy").LogError(ctx, logger)
+	}
+
+	view := toView(created)
+
+	if err := s.audit.LogToolApprovalPolicyCreate(ctx, dbtx, audit.LogToolApprovalPolicyCreateEvent{
+		OrganizationID:             authCtx.ActiveOrganizationID,
+		ProjectID:                  *authCtx.ProjectID,
+		Actor:                      urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:           authCtx.Email,
+		ActorSlug:                  nil,
+		ToolApprovalPolicyURN:      urn.NewToolApprovalPolicy(created.ID),
+		ToolApprovalPolicySnapshot: view,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log tool approval policy creation").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidatePolicies(ctx, created, logger)
+
+	return view, nil
+}
+
+func (s *Service) ListToolApprovalPolicies(ctx context.Context, payload *gen.ListToolApprovalPoliciesPayload) (*gen.ListToolApprovalPoliciesResult, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPRead, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	toolsetID, err := conv.PtrToNullUUID(payload.ToolsetID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid toolset_id").LogError(ctx, logger)
+	}
+	mcpServerID, err := conv.PtrToNullUUID(payload.McpServerID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid mcp_server_id").LogError(ctx, logger)
+	}
+
+	rows, err := repo.New(s.db).ListToolApprovalPolicies(ctx, repo.ListToolApprovalPoliciesParams{
+		ProjectID:   *authCtx.ProjectID,
+		ToolsetID:   toolsetID,
+		McpServerID: mcpServerID,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "list tool approval policies").LogError(ctx, logger)
+	}
+
+	policies := make([]*types.ToolApprovalPolicy, 0, len(rows))
+	for _, row := range rows {
+		policies = append(policies, toView(row))
+	}
+
+	return &gen.ListToolApprovalPoliciesResult{Policies: policies}, nil
+}
+
+func (s *Service) UpdateToolApprovalPolicy(ctx context.Context, payload *gen.UpdateToolApprovalPolicyPayload) (*types.ToolApprovalPolicy, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	policyID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid tool approval policy id").LogError(ctx, logger)
+	}
+
+	if payload.Expression != nil && *payload.Expression != "" {
+		if err := s.compileExpression(*payload.Expression); err != nil {
+			return nil, err
+		}
+	}
+
+	var slackWebhook pgtype.Text
+	if payload.SlackWebhookURL != nil {
+		slackWebhook, err = s.sealSlackWebhook(payload.SlackWebhookURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	txRepo := repo.New(dbtx)
+
+	existing, err := txRepo.GetToolApprovalPolicy(ctx, repo.GetToolApprovalPolicyParams{
+		ID:        policyID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.E(oops.CodeNotFound, err, "tool approval policy not found").LogError(ctx, logger)
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "get tool approval policy").LogError(ctx, logger)
+	}
+
+	params := repo.UpdateToolApprovalPolicyParams{
+		ToolName:                 mergeText(existing.ToolName, payload.ToolName),
+		MatchDestructive:         conv.PtrValOr(payload.MatchDestructive, existing.MatchDestructive),
+		Expression:               mergeText(existing.Expression, payload.Expression),
+		Message:                  mergeText(existing.Message, payload.Message),
+		NotifyEmails:             existing.NotifyEmails,
+		SlackWebhookUrlEncrypted: existing.SlackWebhookUrlEncrypted,
+		TimeoutSeconds:           existing.TimeoutSeconds,
+		ID:                       policyID,
+		ProjectID:                *authCtx.ProjectID,
+	}
+	if payload.NotifyEmails != nil {
+		params.NotifyEmails = normalizeEmails(payload.NotifyEmails)
+	}
+	if payload.SlackWebhookURL != nil {
+		params.SlackWebhookUrlEncrypted = slackWebhook
+	}
+	if payload.TimeoutSeconds != nil {
+		params.TimeoutSeconds = int32(*payload.TimeoutSeconds) // #nosec G115 // bounded to [10, 900] by the design
+	}
+
+	if !params.MatchDestructive && !params.Expression.Valid {
+		return nil, oops.E(oops.CodeInvalid, nil, "a policy must set match_destructive or an expression").LogError(ctx, logger)
+	}
+
+	updated, err := txRepo.UpdateToolApprovalPolicy(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.E(oops.CodeNotFound, err, "tool approval policy not found").LogError(ctx, logger)
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "update tool approval policy").LogError(ctx, logger)
+	}
+
+	view := toView(updated)
+
+	if err := s.audit.LogToolApprovalPolicyUpdate(ctx, dbtx, audit.LogToolApprovalPolicyUpdateEvent{
+		OrganizationID:                   authCtx.ActiveOrganizationID,
+		ProjectID:                        *authCtx.ProjectID,
+		Actor:                            urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:                 authCtx.Email,
+		ActorSlug:                        nil,
+		ToolApprovalPolicyURN:            urn.NewToolApprovalPolicy(updated.ID),
+		ToolApprovalPolicySnapshotBefore: toView(existing),
+		ToolApprovalPolicySnapshotAfter:  view,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log tool approval policy update").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidatePolicies(ctx, updated, logger)
+
+	return view, nil
+}
+
+func (s *Service) DeleteToolApprovalPolicy(ctx context.Context, payload *gen.DeleteToolApprovalPolicyPayload) error {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	policyID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid tool approval policy id").LogError(ctx, logger)
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	deleted, err := repo.New(dbtx).DeleteToolApprovalPolicy(ctx, repo.DeleteToolApprovalPolicyParams{
+		ID:        policyID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return oops.E(oops.CodeNotFound, err, "tool approval policy not found").LogError(ctx, logger)
+		}
+		return oops.E(oops.CodeUnexpected, err, "delete tool approval policy").LogError(ctx, logger)
+	}
+
+	if err := s.audit.LogToolApprovalPolicyDelete(ctx, dbtx, audit.LogToolApprovalPolicyDeleteEvent{
+		OrganizationID:        authCtx.ActiveOrganizationID,
+		ProjectID:             *authCtx.ProjectID,
+		Actor:                 urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:      authCtx.Email,
+		ActorSlug:             nil,
+		ToolApprovalPolicyURN: urn.NewToolApprovalPolicy(deleted.ID),
+	}); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "log tool approval policy deletion").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidatePolicies(ctx, deleted, logger)
+
+	return nil
+}
+
+// invalidatePolicies evicts the cached policies of a written policy's target.
+// It is best effort: a failed eviction is logged and the change takes effect
+// once the cache entry expires.
+func (s *Service) invalidatePolicies(ctx context.Context, policy repo.ToolApprovalPolicy, logger *slog.Logger) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	if err := s.policies.Invalidate(ctx, policy.ToolsetID, policy.McpServerID); err != nil {
+		logger.WarnContext(ctx, "invalidate tool approval policies", attr.SlogError(err))
+	}
+}
+
+// compileExpression rejects an expression that does not compile to a boolean
+// predicate. The gate treats an unevaluable expression as a match, so a
+// broken policy saved here would hold every call to its tool.
+func (s *Service) compileExpression(expr string) error {
+	if _, err := s.celEng.Compile(expr); err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid approval policy expression: %s", err.Error())
+	}
+
+	return nil
+}
+
+// sealSlackWebhook validates and encrypts a Slack incoming webhook URL. A nil
+// or empty URL yields a NULL column.
+func (s *Service) sealSlackWebhook(raw *string) (pgtype.Text, error) {
+	if raw == nil || *raw == "" {
+		return pgtype.Text{String: "", Valid: false}, nil
+	}
+
+	u, err := url.Parse(*raw)
+	if err != nil || u.Scheme != "https" || !strings.EqualFold(u.Hostname(), slackWebhookHost) || u.User != nil {
+		return pgtype.Text{}, oops.E(oops.CodeBadRequest, err, "slack_webhook_url must be a Slack incoming webhook (https://%s/...)", slackWebhookHost)
+	}
+
+	sealed, err := s.enc.Encrypt([]byte(u.String()))
+	if err != nil {
+		return pgtype.Text{}, oops.E(oops.CodeUnexpected, err, "encrypt slack webhook url").LogError(context.Background(), s.logger)
+	}
+
+	return conv.ToPGText(sealed), nil
+}
+
+// verifyTarget checks that the toolset or MCP server a policy attaches to is
+// a live resource in the caller's project. Returned errors are fully formed
+// oops errors, already logged.
+func (s *Service) verifyTarget(ctx context.Context, projectID uuid.UUID, toolsetID uuid.NullUUID, mcpServerID uuid.NullUUID) error {
+	logger := s.logger.With(attr.SlogProjectID(projectID.String()))
+
+	if toolsetID.Valid {
+		exists, err := repo.New(s.db).ToolsetExistsInProject(ctx, repo.ToolsetExistsInProjectParams{
+			ID:        toolsetID.UUID,
+			ProjectID: projectID,
+		})
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "check toolset").LogError(ctx, logger)
+		}
+		if !exists {
+			return oops.E(oops.CodeInvalid, nil, "toolset_id does not reference a toolset in this project").LogError(ctx, logger)
+		}
+	}
+
+	if mcpServerID.Valid {
+		exists, err := repo.New(s.db).MCPServerExistsInProject(ctx, repo.MCPServerExistsInProjectParams{
+			ID:        mcpServerID.UUID,
+			ProjectID: projectID,
+		})
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "check mcp server").LogError(ctx, logger)
+		}
+		if !exists {
+			return oops.E(oops.CodeInvalid, nil, "mcp_server_id does not reference an MCP server in this project").LogError(ctx, logger)
+		}
+	}
+
+	return nil
+}
+
+// mergeText applies an optional update to a nullable column: nil keeps the
+// current value and an empty string clears it.
+func mergeText(current pgtype.Text, update *string) pgtype.Text {
+	if update == nil {
+		return current
+	}
+	return conv.ToPGTextEmpty(*update)
+}
+
+// normalizeEmails lowercases and de-duplicates approver addresses, so the
+// same inbox is never sent two links for one call.
+func normalizeEmails(emails []string) []string {
+	out := make([]string, 0, len(emails))
+	seen := make(map[string]struct{}, len(emails))
+	for _, e := range emails {
+		e = strings.ToLower(strings.TrimSpace(e))
+		if e == "" {
+			continue
+		}
+		if _, ok := seen[e]; ok {
+			continue
+		}
+		seen[e] = struct{}{}
+		out = append(out, e)
+	}
+	return out
+}
+
+func toView(row repo.ToolApprovalPolicy) *types.ToolApprovalPolicy {
+	notifyEmails := row.NotifyEmails
+	if notifyEmails == nil {
+		notifyEmails = []string{}
+	}
+
+	return &types.ToolApprovalPolicy{
+		ID:                     row.ID.String(),
+		ProjectID:              row.ProjectID.String(),
+		ToolsetID:              conv.FromNullableUUID(row.ToolsetID),
+		McpServerID:            conv.FromNullableUUID(row.McpServerID),
+		ToolName:               conv.FromPGText[string](row.ToolName),
+		MatchDestructive:       row.MatchDestructive,
+		Expression:             conv.FromPGText[string](row.Expression),
+		Message:                conv.FromPGText[string](row.Message),
+		NotifyEmails:           notifyEmails,
+		SlackWebhookConfigured: row.SlackWebhookUrlEncrypted.Valid,
+		TimeoutSeconds:         int(row.TimeoutSeconds),
+		CreatedAt:              row.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:              row.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,178 @@
+package toolapprovals
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+)
+
+// decisionChannel is the LISTEN channel approval decisions are announced on.
+// The payload is the decided approval's id. A call is held by whichever
+// server instance received it while the decision may land on any other, so
+// the holder learns of it through this channel rather than from memory.
+const decisionChannel = "gram_tool_call_approvals"
+
+// listenerRetryInterval is how long the listener waits before reconnecting
+// after losing its connection.
+const listenerRetryInterval = 5 * time.Second
+
+// decisionListener holds one LISTEN connection per process and fans each
+// decision out to the calls waiting on that approval. The notification only
+// shortens the wait: holders also recheck their approval row periodically, so
+// a decision announced while the listener was reconnecting delays the call
+// rather than stranding it.
+type decisionListener struct {
+	logger *slog.Logger
+	db     *pgxpool.Pool
+
+	// listenCtx scopes the shared LISTEN connection, which is started by the
+	// first subscribe and stopped by stop.
+	listenCtx  context.Context
+	stopListen context.CancelFunc
+	listenOnce sync.Once
+	listenDone chan struct{}
+
+	mu      sync.Mutex
+	waiters map[uuid.UUID]map[chan struct{}]struct{}
+}
+
+func newDecisionListener(logger *slog.Logger, db *pgxpool.Pool) *decisionListener {
+	listenCtx, stopListen := context.WithCancel(context.Background())
+
+	return &decisionListener{
+		logger:     logger,
+		db:         db,
+		listenCtx:  listenCtx,
+		stopListen: stopListen,
+		listenOnce: sync.Once{},
+		listenDone: make(chan struct{}),
+		mu:         sync.Mutex{},
+		waiters:    make(map[uuid.UUID]map[chan struct{}]struct{}),
+	}
+}
+
+// subscribe registers a wake-up channel for approvalID and starts the shared
+// listener on first use.
+func (l *decisionListener) subscribe(approvalID uuid.UUID) (<-chan struct{}, func()) {
+	l.listenOnce.Do(func() {
+		go func() {
+			defer close(l.listenDone)
+			l.listen(l.listenCtx)
+		}()
+	})
+
+	ch := make(chan struct{}, 1)
+
+	l.mu.Lock()
+	if l.waiters[approvalID] == nil {
+		l.waiters[approvalID] = make(map[chan struct{}]struct{})
+	}
+	l.waiters[approvalID][ch] = struct{}{}
+	l.mu.Unlock()
+
+	return ch, func() {
+		l.mu.Lock()
+		delete(l.waiters[approvalID], ch)
+		if len(l.waiters[approvalID]) == 0 {
+			delete(l.waiters, approvalID)
+		}
+		l.mu.Unlock()
+	}
+}
+
+// wake signals the waiters of approvalID, or of every approval when
+// approvalID is nil. Sends never block: a waiter with a wake-up already
+// pending rechecks its row anyway.
+func (l *decisionListener) wake(approvalID uuid.NullUUID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for id, chans := range l.waiters {
+		if approvalID.Valid && id != approvalID.UUID {
+			continue
+		}
+		for ch := range chans {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// stop closes the shared LISTEN connection. Held calls fall back to
+// rechecking their rows until their own contexts are cancelled.
+func (l *decisionListener) stop(ctx context.Context) error {
+	l.stopListen()
+
+	// Claim the once so a subscribe racing stop cannot start a listener that
+	// nothing would wait for.
+	l.listenOnce.Do(func() { close(l.listenDone) })
+
+	select {
+	case <-l.listenDone:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("stop tool approval listener: %w", ctx.Err())
+	}
+}
+
+// listen holds a LISTEN connection open until ctx is cancelled, reconnecting
+// after failures.
+func (l *decisionListener) listen(ctx context.Context) {
+	for {
+		err := l.listenConn(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		l.logger.WarnContext(ctx, "tool approval listener disconnected", attr.SlogError(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenerRetryInterval):
+		}
+	}
+}
+
+func (l *decisionListener) listenConn(ctx context.Context) error {
+	pooled, err := l.db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire listener connection: %w", err)
+	}
+	// The connection is taken out of the pool for good: returning it with a
+	// LISTEN still registered would leak notifications into whichever caller
+	// checked it out next.
+	conn := pooled.Hijack()
+	defer o11y.NoLogDefer(func() error { return conn.Close(context.WithoutCancel(ctx)) })
+
+	if _, err := conn.Exec(ctx, "LISTEN "+decisionChannel); err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	// Decisions made while the listener was down were announced to nobody, so
+	// send every held call to recheck its row now rather than at its next
+	// recheck.
+	l.wake(uuid.NullUUID{UUID: uuid.Nil, Valid: false})
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+
+		approvalID, err := uuid.Parse(n.Payload)
+		if err != nil {
+			continue
+		}
+		l.wake(uuid.NullUUID{UUID: approvalID, Valid: true})
+	}
+}
@@ -0,0 +1,189 @@
+package toolapprovals
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/email"
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals/repo"
+)
+
+// slackWebhookHost is the only host approval links are posted to. Restricting
+// the webhook to Slack keeps a project member from aiming server-side POSTs at
+// arbitrary URLs.
+const slackWebhookHost = "hooks.slack.com"
+
+// slackPostTimeout bounds one Slack webhook delivery.
+const slackPostTimeout = 10 * time.Second
+
+// slackApprover names the policy's Slack channel as an approver. A webhook
+// posts to a channel rather than a person, so a decision made through the
+// Slack link is attributed to the channel.
+const slackApprover = "slack"
+
+// ApprovalRequest is one held call to be announced to approvers.
+type ApprovalRequest struct {
+	Policy   repo.ToolApprovalPolicy
+	Approval repo.ToolCallApproval
+	Call     Call
+	Prompt   string
+	// ReviewURLs holds each approver's own approval link, keyed by the
+	// approver as returned by [Notifier.Approvers].
+	ReviewURLs map[string]string
+}
+
+// Notifier sends approval links to a policy's out-of-band approvers: each of
+// its notify_emails and its Slack incoming webhook.
+type Notifier struct {
+	emails     *email.Service
+	enc        *encryption.Client
+	httpClient *http.Client
+}
+
+func NewNotifier(emails *email.Service, enc *encryption.Client, httpClient *http.Client) *Notifier {
+	return &Notifier{
+		emails:     emails,
+		enc:        enc,
+		httpClient: httpClient,
+	}
+}
+
+// CanNotify reports whether policy names any approver this notifier can reach.
+func (n *Notifier) CanNotify(policy repo.ToolApprovalPolicy) bool {
+	return len(n.Approvers(policy)) > 0
+}
+
+// Approvers returns the approvers of policy this notifier can reach: each of
+// its notify_emails, and slackApprover for its Slack channel.
+func (n *Notifier) Approvers(policy repo.ToolApprovalPolicy) []string {
+	if n == nil {
+		return nil
+	}
+
+	var approvers []string
+	if n.emails != nil {
+		approvers = append(approvers, policy.NotifyEmails...)
+	}
+	if n.httpClient != nil && policy.SlackWebhookUrlEncrypted.Valid {
+		approvers = append(approvers, slackApprover)
+	}
+	return approvers
+}
+
+// Notify announces req to every approver and returns how many deliveries
+// succeeded alongside any delivery failures. The call is held only if at
+// least one approver was reached.
+func (n *Notifier) Notify(ctx context.Context, req ApprovalRequest) (int, error) {
+	if n == nil {
+		return 0, errors.New("approval links are not configured")
+	}
+
+	delivered := 0
+	var errs []error
+
+	if n.emails != nil {
+		for _, recipient := range req.Policy.NotifyEmails {
+			reviewURL := req.ReviewURLs[recipient]
+			if reviewURL == "" {
+				errs = append(errs, errors.New("no approval link for email approver"))
+				continue
+			}
+
+			tmpl := email.ToolCallApproval{
+				Email:       recipient,
+				ToolName:    req.Call.ToolName,
+				ProjectSlug: req.Call.ProjectSlug,
+				Scope:       describeScope(req.Call),
+				Reason:      req.Prompt,
+				Arguments:   conv.TruncateString(string(req.Approval.Arguments), maxPromptArgumentsLength),
+				ExpiresIn:   formatTimeout(req.Policy.TimeoutSeconds),
+				ApprovalURL: reviewURL,
+			}
+			idempotencyKey := hashSecret(strings.Join([]string{"tool-call-approval", req.Approval.ID.String(), strings.ToLower(recipient)}, ":"))
+			if err := n.emails.SendIdempotent(ctx, recipient, idempotencyKey, tmpl); err != nil {
+				errs = append(errs, fmt.Errorf("send tool call approval email: %w", err))
+				continue
+			}
+			delivered++
+		}
+	}
+
+	if n.httpClient != nil && req.Policy.SlackWebhookUrlEncrypted.Valid {
+		if err := n.postSlack(ctx, req, req.ReviewURLs[slackApprover]); err != nil {
+			errs = append(errs, err)
+		} else {
+			delivered++
+		}
+	}
+
+	return delivered, errors.Join(errs...)
+}
+
+func (n *Notifier) postSlack(ctx context.Context, req ApprovalRequest, reviewURL string) error {
+	if reviewURL == "" {
+		return errors.New("no approval link for slack approver")
+	}
+
+	webhookURL, err := n.enc.Decrypt(req.Policy.SlackWebhookUrlEncrypted.String)
+	if err != nil {
+		return fmt.Errorf("decrypt slack webhook url: %w", err)
+	}
+
+	body, err := json.Marshal(map[string]string{
+		"text": fmt.Sprintf("*Approval needed* in %s (%s)\n%s\n<%s|Review the call> · expires in %s",
+			req.Call.ProjectSlug, describeScope(req.Call), req.Prompt, reviewURL, formatTimeout(req.Policy.TimeoutSeconds)),
+	})
+	if err != nil {
+		return fmt.Errorf("encode slack approval message: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, slackPostTimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build slack webhook request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("post slack approval message: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("post slack approval message: unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func describeScope(call Call) string {
+	switch {
+	case call.ToolsetSlug != "":
+		return "Toolset " + call.ToolsetSlug
+	case call.McpServerID.Valid:
+		return "MCP server " + call.McpServerID.UUID.String()
+	default:
+		return "Toolset"
+	}
+}
+
+func formatTimeout(seconds int32) string {
+	d := time.Duration(seconds) * time.Second
+	if d >= time.Minute && d%time.Minute == 0 {
+		return fmt.Sprintf("%dm", int64(d/time.Minute))
+	}
+	return d.String()
+}
@@ -0,0 +1,217 @@
+package toolapprovals
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals/repo"
+)
+
+//go:embed approval_page.html.tmpl
+var approvalPageTmplData string
+
+var approvalPageTmpl = template.Must(template.New("approval_page").Parse(approvalPageTmplData))
+
+// Token bounds accept the tokens newLinkToken mints with some headroom.
+const (
+	minLinkTokenLength = 32
+	maxLinkTokenLength = 128
+)
+
+type approvalPageData struct {
+	Unavailable bool
+	Pending     bool
+	Token       string
+	ToolName    string
+	Arguments   string
+	RequestedBy string
+	Status      string
+	CreatedAt   string
+	ExpiresAt   string
+}
+
+// ServeApprovalPage renders the page an approval link opens at
+// GET /tool-approvals/{id}?token=. The token is the only credential: the page
+// never reveals a held call to anyone without it.
+func (g *Gate) ServeApprovalPage(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	token := r.URL.Query().Get("token")
+
+	approval, ok, err := g.loadLinkApproval(r, token)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return renderApprovalPage(w, http.StatusNotFound, unavailableApprovalPage())
+	}
+
+	g.logger.DebugContext(ctx, "serving tool call approval page", attr.SlogToolCallApprovalID(approval.ID.String()))
+
+	return renderApprovalPage(w, http.StatusOK, approvalPage(approval, token))
+}
+
+// DecideApproval records the decision posted from the approval page at
+// POST /tool-approvals/{id}. A link that was already decided or has expired
+// shows its settled status rather than an error.
+func (g *Gate) DecideApproval(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid approval form")
+	}
+	token := r.PostFormValue("token")
+
+	var approved bool
+	switch r.PostFormValue("decision") {
+	case "approve":
+		approved = true
+	case "deny":
+		approved = false
+	default:
+		return oops.E(oops.CodeBadRequest, nil, "decision must be approve or deny")
+	}
+
+	approval, ok, err := g.loadLinkApproval(r, token)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return renderApprovalPage(w, http.StatusNotFound, unavailableApprovalPage())
+	}
+
+	decided, err := g.decideByLink(ctx, approval.ID, token, approved)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// Lost a race with another approver or the deadline; show whatever
+		// the call settled as.
+	case err != nil:
+		return oops.E(oops.CodeUnexpected, err, "record approval decision").LogError(ctx, g.logger, attr.SlogToolCallApprovalID(approval.ID.String()))
+	default:
+		g.logger.InfoContext(ctx, "tool call approval decided by link",
+			attr.SlogToolCallApprovalID(decided.ID.String()),
+			attr.SlogToolCallApprovalStatus(decided.Status),
+		)
+		approval = decided
+	}
+
+	return renderApprovalPage(w, http.StatusOK, approvalPage(approval, ""))
+}
+
+// loadLinkApproval looks up the approval named in the path and checks token
+// against its links. It reports false for an unknown approval, one that was
+// not requested by link, or a wrong token, without distinguishing between
+// them.
+func (g *Gate) loadLinkApproval(r *http.Request, token string) (repo.ToolCallApproval, bool, error) {
+	ctx := r.Context()
+
+	approvalID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil || len(token) < minLinkTokenLength || len(token) > maxLinkTokenLength {
+		return repo.ToolCallApproval{}, false, nil
+	}
+
+	queries := repo.New(g.db)
+
+	// Links are looked up by the hash of their token, so the lookup's timing
+	// reveals nothing about the token itself.
+	exists, err := queries.ToolCallApprovalLinkExists(ctx, repo.ToolCallApprovalLinkExistsParams{
+		ApprovalID: approvalID,
+		TokenHash:  hashSecret(token),
+	})
+	if err != nil {
+		return repo.ToolCallApproval{}, false, oops.E(oops.CodeUnexpected, err, "check tool call approval link").LogError(ctx, g.logger, attr.SlogToolCallApprovalID(approvalID.String()))
+	}
+	if !exists {
+		return repo.ToolCallApproval{}, false, nil
+	}
+
+	approval, err := queries.GetToolCallApproval(ctx, approvalID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return repo.ToolCallApproval{}, false, nil
+	case err != nil:
+		return repo.ToolCallApproval{}, false, oops.E(oops.CodeUnexpected, err, "get tool call approval").LogError(ctx, g.logger, attr.SlogToolCallApprovalID(approvalID.String()))
+	}
+
+	if approval.Channel != ChannelLink {
+		return repo.ToolCallApproval{}, false, nil
+	}
+
+	return approval, true, nil
+}
+
+func approvalPage(approval repo.ToolCallApproval, token string) approvalPageData {
+	status := approval.Status
+	pending := status == StatusPending && time.Now().Before(approval.ExpiresAt.Time)
+	if status == StatusPending && !pending {
+		status = StatusExpired
+	}
+	if !pending {
+		token = ""
+	}
+
+	arguments := string(approval.Arguments)
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, approval.Arguments, "", "  "); err == nil {
+		arguments = indented.String()
+	}
+
+	return approvalPageData{
+		Unavailable: false,
+		Pending:     pending,
+		Token:       token,
+		ToolName:    approval.ToolName,
+		Arguments:   arguments,
+		RequestedBy: conv.PtrValOr(conv.FromPGText[string](approval.RequestedBy), ""),
+		Status:      status,
+		CreatedAt:   approval.CreatedAt.Time.UTC().Format(time.RFC1123),
+		ExpiresAt:   approval.ExpiresAt.Time.UTC().Format(time.RFC1123),
+	}
+}
+
+func unavailableApprovalPage() approvalPageData {
+	return approvalPageData{
+		Unavailable: true,
+		Pending:     false,
+		Token:       "",
+		ToolName:    "",
+		Arguments:   "",
+		RequestedBy: "",
+		Status:      "",
+		CreatedAt:   "",
+		ExpiresAt:   "",
+	}
+}
+
+func renderApprovalPage(w http.ResponseWriter, status int, data approvalPageData) error {
+	var buf bytes.Buffer
+	if err := approvalPageTmpl.Execute(&buf, data); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "render tool call approval page")
+	}
+
+	// The link carries a capability token, so the page must never be cached,
+	// indexed or leak its URL through a referrer.
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Robots-Tag", "noindex, nofollow")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; base-uri 'none'; form-action 'self'; frame-ancestors 'none'")
+	w.WriteHeader(status)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("write tool call approval page: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package toolapprovals
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals/repo"
+)
+
+// PolicyCache serves the approval policies attached to a toolset or MCP server
+// without a database query per tools/call. One instance is shared by the Gate,
+// which reads it, and the Service, which evicts it on every write.
+type PolicyCache = cache.TargetCache[repo.ToolApprovalPolicy]
+
+// NewPolicyCache builds the cache over the given database and cache backends.
+func NewPolicyCache(logger *slog.Logger, db *pgxpool.Pool, c cache.Cache) *PolicyCache {
+	logger = logger.With(attr.SlogComponent("toolapprovals-cache"))
+	return cache.NewTargetCache(logger, c, "toolapprovals", func(ctx context.Context, projectID uuid.UUID, toolsetID uuid.NullUUID, mcpServerID uuid.NullUUID) ([]repo.ToolApprovalPolicy, error) {
+		policies, err := repo.New(db).ListToolApprovalPoliciesForTarget(ctx, repo.ListToolApprovalPoliciesForTargetParams{
+			ProjectID:   projectID,
+			ToolsetID:   toolsetID,
+			McpServerID: mcpServerID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list tool approval policies: %w", err)
+		}
+		return policies, nil
+	})
+}
@@ -0,0 +1,202 @@
+-- name: CreateToolApprovalPolicy :one
+INSERT INTO tool_approval_policies (
+  project_id,
+  toolset_id,
+  mcp_server_id,
+  tool_name,
+  match_destructive,
+  expression,
+  message,
+  notify_emails,
+  slack_webhook_url_encrypted,
+  timeout_seconds
+) VALUES (
+  @project_id,
+  @toolset_id,
+  @mcp_server_id,
+  @tool_name,
+  @match_destructive,
+  @expression,
+  @message,
+  @notify_emails,
+  @slack_webhook_url_encrypted,
+  @timeout_seconds
+)
+RETURNING *;
+
+-- name: GetToolApprovalPolicy :one
+SELECT *
+FROM tool_approval_policies
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE;
+
+-- name: ListToolApprovalPolicies :many
+SELECT *
+FROM tool_approval_policies
+WHERE project_id = @project_id
+  AND deleted IS FALSE
+  AND (sqlc.narg(toolset_id)::uuid IS NULL OR toolset_id = sqlc.narg(toolset_id)::uuid)
+  AND (sqlc.narg(mcp_server_id)::uuid IS NULL OR mcp_server_id = sqlc.narg(mcp_server_id)::uuid)
+ORDER BY id DESC;
+
+-- name: ListToolApprovalPoliciesForTarget :many
+-- Returns the live policies attached to one toolset or MCP server, across all
+-- of its tools. The gate caches them per target and narrows them to the
+-- called tool in memory.
+SELECT *
+FROM tool_approval_policies
+WHERE project_id = @project_id
+  AND deleted IS FALSE
+  AND (toolset_id = sqlc.narg(toolset_id)::uuid OR mcp_server_id = sqlc.narg(mcp_server_id)::uuid)
+ORDER BY id ASC;
+
+-- name: UpdateToolApprovalPolicy :one
+UPDATE tool_approval_policies
+SET
+  tool_name = @tool_name,
+  match_destructive = @match_destructive,
+  expression = @expression,
+  message = @message,
+  notify_emails = @notify_emails,
+  slack_webhook_url_encrypted = @slack_webhook_url_encrypted,
+  timeout_seconds = @timeout_seconds,
+  updated_at = clock_timestamp()
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE
+RETURNING *;
+
+-- name: DeleteToolApprovalPolicy :one
+UPDATE tool_approval_policies
+SET
+  deleted_at = clock_timestamp(),
+  updated_at = clock_timestamp()
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE
+RETURNING *;
+
+-- name: ToolsetExistsInProject :one
+SELECT EXISTS (
+  SELECT 1
+  FROM toolsets
+  WHERE id = @id
+    AND project_id = @project_id
+    AND deleted IS FALSE
+);
+
+-- name: MCPServerExistsInProject :one
+SELECT EXISTS (
+  SELECT 1
+  FROM mcp_servers
+  WHERE id = @id
+    AND project_id = @project_id
+    AND deleted IS FALSE
+);
+
+-- name: ToolCallApprovalLinkExists :one
+SELECT EXISTS (
+  SELECT 1
+  FROM tool_call_approval_links
+  WHERE approval_id = @approval_id
+    AND token_hash = @token_hash
+);
+
+-- name: CreateToolCallApproval :one
+INSERT INTO tool_call_approvals (
+  project_id,
+  policy_id,
+  toolset_id,
+  mcp_server_id,
+  tool_name,
+  arguments,
+  channel,
+  session_id_hash,
+  requested_by,
+  expires_at
+) VALUES (
+  @project_id,
+  @policy_id,
+  @toolset_id,
+  @mcp_server_id,
+  @tool_name,
+  @arguments,
+  @channel,
+  @session_id_hash,
+  @requested_by,
+  @expires_at
+)
+RETURNING *;
+
+-- name: CreateToolCallApprovalLink :exec
+INSERT INTO tool_call_approval_links (
+  approval_id,
+  approver,
+  token_hash
+) VALUES (
+  @approval_id,
+  @approver,
+  @token_hash
+);
+
+-- name: GetToolCallApproval :one
+SELECT *
+FROM tool_call_approvals
+WHERE id = @id;
+
+
+-- name: GetToolCallApprovalStatus :one
+SELECT status, decided_by
+FROM tool_call_approvals
+WHERE id = @id;
+
+-- name: DecideToolCallApprovalByLink :one
+-- Records a decision made through an approval link, attributed to the
+-- approver the link was sent to. Only a pending, unexpired link approval with
+-- a link matching the token hash can be decided, and only once.
+UPDATE tool_call_approvals a
+SET
+  status = @status,
+  decided_by = l.approver,
+  decided_at = clock_timestamp(),
+  updated_at = clock_timestamp()
+FROM tool_call_approval_links l
+WHERE a.id = @id
+  AND l.approval_id = a.id
+  AND l.token_hash = @token_hash
+  AND a.channel = 'link'
+  AND a.status = 'pending'
+  AND a.expires_at > clock_timestamp()
+RETURNING a.*;
+
+-- name: DecideToolCallApprovalBySession :one
+-- Records the answer to an elicitation. Only the MCP session the elicitation
+-- was sent to can answer it, and only once. That session is the caller's, so
+-- the decision is attributed to the caller.
+UPDATE tool_call_approvals
+SET
+  status = @status,
+  decided_by = requested_by,
+  decided_at = clock_timestamp(),
+  updated_at = clock_timestamp()
+WHERE id = @id
+  AND channel = 'elicitation'
+  AND session_id_hash = @session_id_hash
+  AND status = 'pending'
+  AND expires_at > clock_timestamp()
+RETURNING *;
+
+-- name: ExpireToolCallApproval :exec
+UPDATE tool_call_approvals
+SET
+  status = 'expired',
+  updated_at = clock_timestamp()
+WHERE id = @id
+  AND status = 'pending';
+
+-- name: NotifyToolCallApprovalDecided :exec
+-- Wakes the server instance holding the call. The notification is only a
+-- hint: the holder also polls, so a notification lost while its listener was
+-- reconnecting delays the call but never strands it.
+SELECT pg_notify(@channel::text, @approval_id::text);
@@ -0,0 +1,133 @@
+package toolapprovals_test
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/authztest"
+	"github.com/speakeasy-api/gram/server/internal/billing"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/workos"
+	"github.com/speakeasy-api/gram/server/internal/toolapprovals"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints/celenv"
+	toolsetsrepo "github.com/speakeasy-api/gram/server/internal/toolsets/repo"
+)
+
+var (
+	infra *testenv.Environment
+)
+
+func TestMain(m *testing.M) {
+	res, cleanup, err := testenv.Launch(context.Background(), testenv.LaunchOptions{Postgres: true, Redis: true, ClickHouse: true})
+	if err != nil {
+		log.Fatalf("Failed to launch test infrastructure: %v", err)
+		os.Exit(1)
+	}
+
+	infra = res
+
+	code := m.Run()
+
+	if err := cleanup(); err != nil {
+		log.Fatalf("Failed to cleanup test infrastructure: %v", err)
+		os.Exit(1)
+	}
+
+	os.Exit(code)
+}
+
+type testInstance struct {
+	service        *toolapprovals.Service
+	gate           *toolapprovals.Gate
+	conn           *pgxpool.Pool
+	enc            *encryption.Client
+	sessionManager *sessions.Manager
+}
+
+func newTestService(t *testing.T) (context.Context, *testInstance) {
+	t.Helper()
+
+	ctx := t.Context()
+
+	logger := testenv.NewLogger(t)
+	tracerProvider := testenv.NewTracerProvider(t)
+	conn, err := infra.CloneTestDatabase(t, "testdb")
+	require.NoError(t, err)
+
+	redisClient, err := infra.NewRedisClient(t, 0)
+	require.NoError(t, err)
+
+	billingClient := billing.NewStubClient(logger, tracerProvider)
+	sessionManager := testenv.NewTestManager(t, logger, tracerProvider, conn, redisClient, cache.Suffix("gram-local"), billingClient)
+
+	ctx = authztest.InitAuthContext(t, ctx, conn, sessionManager)
+
+	authzEngine := authz.NewEngine(logger, conn, authztest.ChallengeLoggingAlwaysDisabled, workos.NewStubClient())
+	auditLogger := audit.NewLogger()
+	enc := testenv.NewEncryptionClient(t)
+
+	celEng, err := celenv.New()
+	require.NoError(t, err)
+
+	serverURL, err := url.Parse("http://localhost:8080")
+	require.NoError(t, err)
+
+	policies := toolapprovals.NewPolicyCache(logger, conn, cache.NewRedisCacheAdapter(redisClient))
+	svc := toolapprovals.NewService(logger, tracerProvider, conn, sessionManager, authzEngine, auditLogger, celEng, enc, policies)
+	notifier := toolapprovals.NewNotifier(nil, enc, http.DefaultClient)
+	gate, err := toolapprovals.NewGate(logger, testenv.NewMeterProvider(t), conn, policies, celEng, notifier, serverURL)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, gate.Shutdown(context.Background()))
+	})
+
+	return ctx, &testInstance{
+		service:        svc,
+		gate:           gate,
+		conn:           conn,
+		enc:            enc,
+		sessionManager: sessionManager,
+	}
+}
+
+func requireOopsCode(t *testing.T, err error, code oops.Code) {
+	t.Helper()
+
+	var oopsErr *oops.ShareableError
+	require.ErrorAs(t, err, &oopsErr)
+	require.Equal(t, code, oopsErr.Code)
+}
+
+func seedToolset(t *testing.T, ctx context.Context, conn *pgxpool.Pool, organizationID string, projectID uuid.UUID) uuid.UUID {
+	t.Helper()
+
+	slug := "tsl-" + uuid.NewString()[:8]
+	toolset, err := toolsetsrepo.New(conn).CreateToolset(ctx, toolsetsrepo.CreateToolsetParams{
+		OrganizationID:         organizationID,
+		ProjectID:              projectID,
+		Name:                   slug,
+		Slug:                   slug,
+		Description:            pgtype.Text{String: "", Valid: false},
+		DefaultEnvironmentSlug: pgtype.Text{String: "", Valid: false},
+		McpSlug:                pgtype.Text{String: slug, Valid: true},
+		McpEnabled:             true,
+	})
+	require.NoError(t, err)
+
+	return toolset.ID
+}
@@ -0,0 +1,124 @@
+package toolapprovals_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_approvals"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+func TestUpdateToolApprovalPolicy_MergesAndClears(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	created, err := ti.service.CreateToolApprovalPolicy(ctx, &gen.CreateToolApprovalPolicyPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         new("delete_repo"),
+		MatchDestructive: true,
+		Expression:       nil,
+		Message:          new("Deleting a repository cannot be undone."),
+		NotifyEmails:     []string{"ops@example.com"},
+		SlackWebhookURL:  new("https://hooks.slack.com/services/T000/B000/XXXX"),
+		TimeoutSeconds:   300,
+	})
+	require.NoError(t, err)
+
+	updated, err := ti.service.UpdateToolApprovalPolicy(ctx, &gen.UpdateToolApprovalPolicyPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ID:               created.ID,
+		ToolName:         new(""),
+		MatchDestructive: nil,
+		Expression:       new(`repo == "prod"`),
+		Message:          new(""),
+		NotifyEmails:     nil,
+		SlackWebhookURL:  new(""),
+		TimeoutSeconds:   new(60),
+	})
+	require.NoError(t, err)
+	require.Nil(t, updated.ToolName)
+	require.True(t, updated.MatchDestructive)
+	require.Equal(t, new(`repo == "prod"`), updated.Expression)
+	require.Nil(t, updated.Message)
+	require.Equal(t, []string{"ops@example.com"}, updated.NotifyEmails)
+	require.False(t, updated.SlackWebhookConfigured)
+	require.Equal(t, 60, updated.TimeoutSeconds)
+}
+
+func TestUpdateToolApprovalPolicy_RejectsRemovingLastMatcher(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	created, err := ti.service.CreateToolApprovalPolicy(ctx, &gen.CreateToolApprovalPolicyPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         nil,
+		MatchDestructive: true,
+		Expression:       nil,
+		Message:          nil,
+		NotifyEmails:     nil,
+		SlackWebhookURL:  nil,
+		TimeoutSeconds:   300,
+	})
+	require.NoError(t, err)
+
+	_, err = ti.service.UpdateToolApprovalPolicy(ctx, &gen.UpdateToolApprovalPolicyPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ID:               created.ID,
+		ToolName:         nil,
+		MatchDestructive: new(false),
+		Expression:       nil,
+		Message:          nil,
+		NotifyEmails:     nil,
+		SlackWebhookURL:  nil,
+		TimeoutSeconds:   nil,
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+}
+
+func TestUpdateToolApprovalPolicy_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	_, err := ti.service.UpdateToolApprovalPolicy(ctx, &gen.UpdateToolApprovalPolicyPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ID:               uuid.NewString(),
+		ToolName:         nil,
+		MatchDestructive: new(true),
+		Expression:       nil,
+		Message:          nil,
+		NotifyEmails:     nil,
+		SlackWebhookURL:  nil,
+		TimeoutSeconds:   nil,
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
@@ -0,0 +1,184 @@
+package toolcallrecordings
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+)
+
+// Kinds of difference between a recorded and a replayed tool result.
+const (
+	// DifferenceStatus means the tool returned a different HTTP status.
+	DifferenceStatus = "status"
+	// DifferenceSchema means the shape of the result changed: a value changed
+	// type or a field appeared or disappeared.
+	DifferenceSchema = "schema"
+	// DifferenceContent means the shape held but a value changed.
+	DifferenceContent = "content"
+)
+
+// maxDifferences bounds how many differences are reported for one call.
+const maxDifferences = 50
+
+// Difference is one way a replayed tool result departs from its recording.
+type Difference struct {
+	Kind        string
+	Path        string
+	Description string
+}
+
+// Compare reports how a replayed tool result differs from the recorded one.
+// JSON bodies are compared structurally, with paths in $.a[0].b notation;
+// other bodies are compared as text.
+func Compare(recorded, replayed gateway.RecordedResponse) []Difference {
+	var diffs []Difference
+
+	if recorded.StatusCode != replayed.StatusCode {
+		diffs = append(diffs, Difference{
+			Kind:        DifferenceStatus,
+			Path:        "",
+			Description: fmt.Sprintf("status changed from %d to %d", recorded.StatusCode, replayed.StatusCode),
+		})
+	}
+
+	// A recording that was cut short cannot be compared reliably.
+	if recorded.Truncated {
+		return diffs
+	}
+
+	recordedValue, recordedIsJSON := decodeJSON(recorded.Body)
+	replayedValue, replayedIsJSON := decodeJSON(replayed.Body)
+	if recordedIsJSON && replayedIsJSON {
+		c := &comparison{diffs: diffs}
+		c.compare("$", recordedValue, replayedValue)
+		return c.diffs
+	}
+
+	if recorded.Body != replayed.Body {
+		diffs = append(diffs, Difference{
+			Kind:        DifferenceContent,
+			Path:        "",
+			Description: "response body changed",
+		})
+	}
+
+	return diffs
+}
+
+func decodeJSON(body string) (any, bool) {
+	trimmed := bytes.TrimSpace([]byte(body))
+	if len(trimmed) == 0 {
+		return nil, false
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(trimmed))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+type comparison struct {
+	diffs []Difference
+}
+
+func (c *comparison) add(kind, path, description string) {
+	if len(c.diffs) >= maxDifferences {
+		return
+	}
+	c.diffs = append(c.diffs, Difference{Kind: kind, Path: path, Description: description})
+}
+
+func (c *comparison) compare(path string, recorded, replayed any) {
+	if len(c.diffs) >= maxDifferences {
+		return
+	}
+
+	recordedType, replayedType := jsonType(recorded), jsonType(replayed)
+	if recordedType != replayedType {
+		c.add(DifferenceSchema, path, fmt.Sprintf("type changed from %s to %s", recordedType, replayedType))
+		return
+	}
+
+	switch r := recorded.(type) {
+	case map[string]any:
+		p, _ := replayed.(map[string]any)
+
+		keys := make([]string, 0, len(r)+len(p))
+		for key := range r {
+			keys = append(keys, key)
+		}
+		for key := range p {
+			if _, ok := r[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			fieldPath := path + "." + key
+			recordedField, inRecorded := r[key]
+			replayedField, inReplayed := p[key]
+			switch {
+			case !inReplayed:
+				c.add(DifferenceSchema, fieldPath, "field removed")
+			case !inRecorded:
+				c.add(DifferenceSchema, fieldPath, "field added")
+			default:
+				c.compare(fieldPath, recordedField, replayedField)
+			}
+		}
+	case []any:
+		p, _ := replayed.([]any)
+		if len(r) != len(p) {
+			c.add(DifferenceContent, path, fmt.Sprintf("length changed from %d to %d", len(r), len(p)))
+		}
+		for i := range min(len(r), len(p)) {
+			c.compare(path+"["+strconv.Itoa(i)+"]", r[i], p[i])
+		}
+	default:
+		if !reflect.DeepEqual(recorded, replayed) {
+			c.add(DifferenceContent, path, fmt.Sprintf("value changed from %s to %s", formatLeaf(recorded), formatLeaf(replayed)))
+		}
+	}
+}
+
+func jsonType(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func formatLeaf(v any) string {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	const maxLeafLength = 80
+	if len(bs) > maxLeafLength {
+		return string(bs[:maxLeafLength]) + "…"
+	}
+	return string(bs)
+}
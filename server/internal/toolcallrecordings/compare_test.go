@@ -0,0 +1,52 @@
+package toolcallrecordings
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+)
+
+func response(status int, body string) gateway.RecordedResponse {
+	return gateway.RecordedResponse{StatusCode: status, Header: http.Header{}, Body: body, Truncated: false}
+}
+
+func TestCompare_IdenticalResults(t *testing.T) {
+	t.Parallel()
+
+	diffs := Compare(
+		response(http.StatusOK, `{"pets":[{"id":1,"name":"Rex"}],"next":null}`),
+		response(http.StatusOK, `{"next":null,"pets":[{"name":"Rex","id":1}]}`),
+	)
+	require.Empty(t, diffs)
+}
+
+func TestCompare_ClassifiesDifferences(t *testing.T) {
+	t.Parallel()
+
+	diffs := Compare(
+		response(http.StatusOK, `{"pets":[{"id":1,"name":"Rex","age":3}],"total":1}`),
+		response(http.StatusCreated, `{"pets":[{"id":"1","name":"Max","owner":"ann"},{"id":"2"}],"total":2}`),
+	)
+
+	require.Equal(t, []Difference{
+		{Kind: DifferenceStatus, Path: "", Description: "status changed from 200 to 201"},
+		{Kind: DifferenceContent, Path: "$.pets", Description: "length changed from 1 to 2"},
+		{Kind: DifferenceSchema, Path: "$.pets[0].age", Description: "field removed"},
+		{Kind: DifferenceSchema, Path: "$.pets[0].id", Description: "type changed from number to string"},
+		{Kind: DifferenceContent, Path: "$.pets[0].name", Description: `value changed from "Rex" to "Max"`},
+		{Kind: DifferenceSchema, Path: "$.pets[0].owner", Description: "field added"},
+		{Kind: DifferenceContent, Path: "$.total", Description: "value changed from 1 to 2"},
+	}, diffs)
+}
+
+func TestCompare_NonJSONBodies(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, Compare(response(http.StatusOK, "pong"), response(http.StatusOK, "pong")))
+	require.Equal(t, []Difference{
+		{Kind: DifferenceContent, Path: "", Description: "response body changed"},
+	}, Compare(response(http.StatusOK, "pong"), response(http.StatusOK, `{"pong":true}`)))
+}
@@ -0,0 +1,423 @@
+package toolcallrecordings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	goahttp "goa.design/goa/v3/http"
+	"goa.design/goa/v3/security"
+
+	srv "github.com/speakeasy-api/gram/server/gen/http/tool_call_recordings/server"
+	gen "github.com/speakeasy-api/gram/server/gen/tool_call_recordings"
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/auth"
+	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	deploymentsRepo "github.com/speakeasy-api/gram/server/internal/deployments/repo"
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/middleware"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolcallrecordings/repo"
+	"github.com/speakeasy-api/gram/server/internal/toolsets"
+)
+
+type Service struct {
+	tracer    trace.Tracer
+	logger    *slog.Logger
+	db        *pgxpool.Pool
+	recorder  *Recorder
+	toolsets  *toolsets.Toolsets
+	toolProxy *gateway.ToolProxy
+	auth      *auth.Auth
+	authz     *authz.Engine
+}
+
+var _ gen.Service = (*Service)(nil)
+var _ gen.Auther = (*Service)(nil)
+
+func NewService(
+	logger *slog.Logger,
+	tracerProvider trace.TracerProvider,
+	meterProvider metric.MeterProvider,
+	db *pgxpool.Pool,
+	sessions *sessions.Manager,
+	authzEngine *authz.Engine,
+	enc *encryption.Client,
+	guardianPolicy *guardian.Policy,
+	recorder *Recorder,
+) *Service {
+	logger = logger.With(attr.SlogComponent("toolcallrecordings"))
+
+	return &Service{
+		tracer:   tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/toolcallrecordings"),
+		logger:   logger,
+		db:       db,
+		recorder: recorder,
+		toolsets: toolsets.NewToolsets(db),
+		// Replays only ever reach the upstream stand-in, so failover, function
+		// and platform executors are not needed and there is no response cache.
+		toolProxy: gateway.NewToolProxy(
+			logger,
+			tracerProvider,
+			meterProvider,
+			gateway.ToolCallSourceReplay,
+			enc,
+			nil,
+			guardianPolicy,
+			nil,
+			nil,
+			nil,
+		),
+		auth:  auth.New(logger, db, sessions, authzEngine),
+		authz: authzEngine,
+	}
+}
+
+func Attach(mux goahttp.Muxer, service *Service) {
+	endpoints := gen.NewEndpoints(service)
+	endpoints.Use(middleware.MapErrors())
+	endpoints.Use(middleware.TraceMethods(service.tracer))
+	srv.Mount(
+		mux,
+		srv.New(endpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil),
+	)
+}
+
+func (s *Service) APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error) {
+	return s.auth.Authorize(ctx, key, schema)
+}
+
+func (s *Service) StartToolCallRecording(ctx context.Context, payload *gen.StartToolCallRecordingPayload) (*types.ToolCallRecordingSession, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	toolsetID, err := s.toolsetIDBySlug(ctx, logger, *authCtx.ProjectID, string(payload.ToolsetSlug))
+	if err != nil {
+		return nil, err
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	tx := repo.New(dbtx)
+
+	if err := tx.StopActiveRecordingSessions(ctx, repo.StopActiveRecordingSessionsParams{
+		ToolsetID: toolsetID,
+		ProjectID: *authCtx.ProjectID,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "stop active recording sessions").LogError(ctx, logger)
+	}
+
+	created, err := tx.CreateRecordingSession(ctx, repo.CreateRecordingSessionParams{
+		ProjectID: *authCtx.ProjectID,
+		ToolsetID: toolsetID,
+		MaxCalls:  payload.MaxCalls,
+		ExpiresAt: pgtype.Timestamptz{
+			Time:             time.Now().Add(time.Duration(payload.DurationMinutes) * time.Minute),
+			InfinityModifier: pgtype.Finite,
+			Valid:            true,
+		},
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "create recording session").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidateRecorder(ctx, toolsetID, logger)
+
+	return sessionView(created), nil
+}
+
+func (s *Service) StopToolCallRecording(ctx context.Context, payload *gen.StopToolCallRecordingPayload) (*types.ToolCallRecordingSession, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	sessionID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid recording session id").LogError(ctx, logger)
+	}
+
+	stopped, err := repo.New(s.db).StopRecordingSession(ctx, repo.StopRecordingSessionParams{
+		ID:        sessionID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.E(oops.CodeNotFound, err, "recording session not found").LogError(ctx, logger)
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "stop recording session").LogError(ctx, logger)
+	}
+
+	s.invalidateRecorder(ctx, stopped.ToolsetID, logger)
+
+	return sessionView(stopped), nil
+}
+
+func (s *Service) ListToolCallRecordingSessions(ctx context.Context, payload *gen.ListToolCallRecordingSessionsPayload) (*gen.ListToolCallRecordingSessionsResult, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPRead, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	toolsetID := uuid.NullUUID{UUID: uuid.Nil, Valid: false}
+	if payload.ToolsetSlug != nil {
+		id, err := s.toolsetIDBySlug(ctx, logger, *authCtx.ProjectID, string(*payload.ToolsetSlug))
+		if err != nil {
+			return nil, err
+		}
+		toolsetID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+
+	rows, err := repo.New(s.db).ListRecordingSessions(ctx, repo.ListRecordingSessionsParams{
+		ProjectID: *authCtx.ProjectID,
+		ToolsetID: toolsetID,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "list recording sessions").LogError(ctx, logger)
+	}
+
+	sessions := make([]*types.ToolCallRecordingSession, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, sessionView(row))
+	}
+
+	return &gen.ListToolCallRecordingSessionsResult{Sessions: sessions}, nil
+}
+
+func (s *Service) ExportToolCallRecordings(ctx context.Context, payload *gen.ExportToolCallRecordingsPayload) (*gen.ExportToolCallRecordingsResult, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPRead, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	session, rows, err := s.loadSession(ctx, logger, *authCtx.ProjectID, payload.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	recordings := make([]*types.ToolCallRecording, 0, len(rows))
+	for _, row := range rows {
+		var recording any
+		if err := json.Unmarshal(row.Recording, &recording); err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "decode tool call recording").LogError(ctx, logger)
+		}
+
+		recordings = append(recordings, &types.ToolCallRecording{
+			ID:        row.ID.String(),
+			ToolUrn:   row.ToolUrn,
+			ToolName:  row.ToolName,
+			Recording: recording,
+			CreatedAt: row.CreatedAt.Time.Format(time.RFC3339),
+		})
+	}
+
+	return &gen.ExportToolCallRecordingsResult{
+		Session:    sessionView(session),
+		Recordings: recordings,
+	}, nil
+}
+
+func (s *Service) ReplayToolCallRecordings(ctx context.Context, payload *gen.ReplayToolCallRecordingsPayload) (*gen.ReplayToolCallRecordingsResult, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPRead, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	deploymentID, err := s.replayDeploymentID(ctx, logger, *authCtx.ProjectID, payload.DeploymentID)
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.With(attr.SlogDeploymentID(deploymentID.String()))
+
+	session, rows, err := s.loadSession(ctx, logger, *authCtx.ProjectID, payload.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	replays := make([]*types.ToolCallReplay, 0, len(rows))
+	for _, row := range rows {
+		replays = append(replays, s.replayRecording(ctx, logger, *authCtx.ProjectID, deploymentID, row))
+	}
+
+	counts := replayStatusCounts(replays)
+
+	return &gen.ReplayToolCallRecordingsResult{
+		SessionID:    session.ID.String(),
+		DeploymentID: deploymentID.String(),
+		Passed:       counts[ReplayPassed],
+		Failed:       counts[ReplayFailed],
+		Skipped:      counts[ReplaySkipped],
+		Errored:      counts[ReplayErrored],
+		Replays:      replays,
+	}, nil
+}
+
+// replayDeploymentID resolves the deployment a replay runs against: the
+// requested one, which must belong to the project, or else the latest.
+// Returned errors are fully formed oops errors, already logged.
+func (s *Service) replayDeploymentID(ctx context.Context, logger *slog.Logger, projectID uuid.UUID, requested *string) (uuid.UUID, error) {
+	if requested == nil {
+		latest, err := deploymentsRepo.New(s.db).GetLatestDeploymentID(ctx, projectID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return uuid.Nil, oops.E(oops.CodeNotFound, err, "project has no deployments").LogError(ctx, logger)
+			}
+			return uuid.Nil, oops.E(oops.CodeUnexpected, err, "get latest deployment").LogError(ctx, logger)
+		}
+		return latest, nil
+	}
+
+	deploymentID, err := uuid.Parse(*requested)
+	if err != nil {
+		return uuid.Nil, oops.E(oops.CodeBadRequest, err, "invalid deployment id").LogError(ctx, logger)
+	}
+
+	exists, err := repo.New(s.db).DeploymentExistsInProject(ctx, repo.DeploymentExistsInProjectParams{
+		ID:        deploymentID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return uuid.Nil, oops.E(oops.CodeUnexpected, err, "check deployment").LogError(ctx, logger)
+	}
+	if !exists {
+		return uuid.Nil, oops.E(oops.CodeNotFound, nil, "deployment not found").LogError(ctx, logger)
+	}
+
+	return deploymentID, nil
+}
+
+// loadSession reads a recording session and its recordings. Returned errors
+// are fully formed oops errors, already logged.
+func (s *Service) loadSession(ctx context.Context, logger *slog.Logger, projectID uuid.UUID, rawSessionID string) (repo.ToolCallRecordingSession, []repo.ToolCallRecording, error) {
+	var session repo.ToolCallRecordingSession
+
+	sessionID, err := uuid.Parse(rawSessionID)
+	if err != nil {
+		return session, nil, oops.E(oops.CodeBadRequest, err, "invalid recording session id").LogError(ctx, logger)
+	}
+
+	session, err = repo.New(s.db).GetRecordingSession(ctx, repo.GetRecordingSessionParams{
+		ID:        sessionID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return session, nil, oops.E(oops.CodeNotFound, err, "recording session not found").LogError(ctx, logger)
+		}
+		return session, nil, oops.E(oops.CodeUnexpected, err, "get recording session").LogError(ctx, logger)
+	}
+
+	rows, err := repo.New(s.db).ListToolCallRecordings(ctx, repo.ListToolCallRecordingsParams{
+		SessionID: sessionID,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		return session, nil, oops.E(oops.CodeUnexpected, err, "list tool call recordings").LogError(ctx, logger)
+	}
+
+	return session, rows, nil
+}
+
+// invalidateRecorder evicts the recorder's cached session of a toolset whose
+// recording session started or stopped. It is best effort: a failed eviction
+// is logged and the change takes effect once the cache entry expires.
+func (s *Service) invalidateRecorder(ctx context.Context, toolsetID uuid.UUID, logger *slog.Logger) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	if err := s.recorder.Invalidate(ctx, toolsetID); err != nil {
+		logger.WarnContext(ctx, "invalidate tool call recording session", attr.SlogError(err))
+	}
+}
+
+// toolsetIDBySlug resolves a live toolset in the project. Returned errors are
+// fully formed oops errors, already logged.
+func (s *Service) toolsetIDBySlug(ctx context.Context, logger *slog.Logger, projectID uuid.UUID, slug string) (uuid.UUID, error) {
+	toolsetID, err := repo.New(s.db).GetToolsetIDBySlug(ctx, repo.GetToolsetIDBySlugParams{
+		Slug:      slug,
+		ProjectID: projectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, oops.E(oops.CodeNotFound, err, "toolset not found").LogError(ctx, logger)
+		}
+		return uuid.Nil, oops.E(oops.CodeUnexpected, err, "get toolset").LogError(ctx, logger)
+	}
+
+	return toolsetID, nil
+}
+
+func sessionView(row repo.ToolCallRecordingSession) *types.ToolCallRecordingSession {
+	var stoppedAt *string
+	if row.StoppedAt.Valid {
+		formatted := row.StoppedAt.Time.Format(time.RFC3339)
+		stoppedAt = &formatted
+	}
+
+	return &types.ToolCallRecordingSession{
+		ID:            row.ID.String(),
+		ProjectID:     row.ProjectID.String(),
+		ToolsetID:     row.ToolsetID.String(),
+		MaxCalls:      row.MaxCalls,
+		RecordedCalls: row.RecordedCalls,
+		Active:        !row.StoppedAt.Valid && row.RecordedCalls < row.MaxCalls && time.Now().Before(row.ExpiresAt.Time),
+		ExpiresAt:     row.ExpiresAt.Time.Format(time.RFC3339),
+		StoppedAt:     stoppedAt,
+		CreatedAt:     row.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:     row.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,116 @@
+-- name: CreateRecordingSession :one
+INSERT INTO tool_call_recording_sessions (
+  project_id,
+  toolset_id,
+  max_calls,
+  expires_at
+) VALUES (
+  @project_id,
+  @toolset_id,
+  @max_calls,
+  @expires_at
+)
+RETURNING *;
+
+-- name: StopActiveRecordingSessions :exec
+-- A toolset records into at most one session at a time, so starting a session
+-- stops any that are still running.
+UPDATE tool_call_recording_sessions
+SET stopped_at = clock_timestamp(),
+    updated_at = clock_timestamp()
+WHERE toolset_id = @toolset_id
+  AND project_id = @project_id
+  AND stopped_at IS NULL;
+
+-- name: StopRecordingSession :one
+UPDATE tool_call_recording_sessions
+SET stopped_at = COALESCE(stopped_at, clock_timestamp()),
+    updated_at = clock_timestamp()
+WHERE id = @id
+  AND project_id = @project_id
+RETURNING *;
+
+-- name: GetRecordingSession :one
+SELECT *
+FROM tool_call_recording_sessions
+WHERE id = @id
+  AND project_id = @project_id;
+
+-- name: ListRecordingSessions :many
+SELECT *
+FROM tool_call_recording_sessions
+WHERE project_id = @project_id
+  AND (sqlc.narg(toolset_id)::uuid IS NULL OR toolset_id = sqlc.narg(toolset_id)::uuid)
+ORDER BY created_at DESC
+LIMIT 100;
+
+-- name: GetActiveRecordingSessionExpiry :one
+-- Reports when the toolset's running recording session ends, so callers can
+-- skip claiming a slot for toolsets that are not being recorded. No row is
+-- returned when there is no session with room left.
+SELECT s.expires_at
+FROM tool_call_recording_sessions s
+WHERE s.toolset_id = @toolset_id
+  AND s.project_id = @project_id
+  AND s.stopped_at IS NULL
+  AND s.expires_at > clock_timestamp()
+  AND s.recorded_calls < s.max_calls
+ORDER BY s.created_at DESC
+LIMIT 1;
+
+-- name: ClaimRecordingSlot :one
+-- Reserves one call on the toolset's running recording session. No row is
+-- returned when the toolset is not being recorded.
+UPDATE tool_call_recording_sessions
+SET recorded_calls = recorded_calls + 1,
+    updated_at = clock_timestamp()
+WHERE id = (
+  SELECT s.id
+  FROM tool_call_recording_sessions s
+  WHERE s.toolset_id = @toolset_id
+    AND s.project_id = @project_id
+    AND s.stopped_at IS NULL
+    AND s.expires_at > clock_timestamp()
+    AND s.recorded_calls < s.max_calls
+  ORDER BY s.created_at DESC
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id;
+
+-- name: InsertToolCallRecording :exec
+INSERT INTO tool_call_recordings (
+  session_id,
+  project_id,
+  tool_urn,
+  tool_name,
+  recording
+) VALUES (
+  @session_id,
+  @project_id,
+  @tool_urn,
+  @tool_name,
+  @recording
+);
+
+-- name: ListToolCallRecordings :many
+SELECT *
+FROM tool_call_recordings
+WHERE session_id = @session_id
+  AND project_id = @project_id
+ORDER BY id;
+
+-- name: GetToolsetIDBySlug :one
+SELECT id
+FROM toolsets
+WHERE slug = @slug
+  AND project_id = @project_id
+  AND deleted IS FALSE;
+
+-- name: DeploymentExistsInProject :one
+SELECT EXISTS (
+  SELECT 1
+  FROM deployments
+  WHERE id = @id
+    AND project_id = @project_id
+);
@@ -0,0 +1,188 @@
+package toolcallrecordings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+	"github.com/speakeasy-api/gram/server/internal/toolcallrecordings/repo"
+)
+
+// sessionCacheTTL bounds staleness of the recording session cache. Starting
+// and stopping a session evict eagerly (see Recorder.Invalidate), so this is
+// only the ceiling for a change that slips past invalidation, e.g. an
+// eviction that failed against a briefly unavailable Redis.
+const sessionCacheTTL = 10 * time.Minute
+
+// toolsetSession is the cached answer to whether a toolset has a running
+// recording session with room left, and until when. An inactive entry is a
+// valid negative entry: almost no toolset is being recorded and must not cost
+// a slot claim per call.
+type toolsetSession struct {
+	ToolsetID string    `json:"toolset_id"`
+	Active    bool      `json:"active"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+var _ cache.CacheableObject[toolsetSession] = (*toolsetSession)(nil)
+
+func toolsetSessionCacheKey(toolsetID string) string {
+	return fmt.Sprintf("toolcallrecordings:toolset:%s", toolsetID)
+}
+
+func (t toolsetSession) CacheKey() string {
+	return toolsetSessionCacheKey(t.ToolsetID)
+}
+
+func (t toolsetSession) AdditionalCacheKeys() []string {
+	return []string{}
+}
+
+func (t toolsetSession) TTL() time.Duration {
+	return sessionCacheTTL
+}
+
+// Recorder captures tools/call requests against toolsets that have a running
+// recording session.
+//
+// Recording fails open: a failure to claim a slot or store a recording is
+// logged and the call proceeds unrecorded. A nil *Recorder records nothing.
+type Recorder struct {
+	logger   *slog.Logger
+	db       *pgxpool.Pool
+	sessions cache.TypedCacheObject[toolsetSession]
+}
+
+// NewRecorder builds a recorder over the given database and cache backends.
+// One instance is shared by the MCP service, which records through it, and
+// the Service, which evicts its cache whenever a session starts or stops.
+func NewRecorder(logger *slog.Logger, db *pgxpool.Pool, c cache.Cache) *Recorder {
+	logger = logger.With(attr.SlogComponent("toolcallrecordings"))
+	return &Recorder{
+		logger:   logger,
+		db:       db,
+		sessions: cache.NewTypedObjectCache[toolsetSession](logger.With(attr.SlogCacheNamespace("tool_call_recording_sessions")), c, cache.SuffixNone),
+	}
+}
+
+// ForToolCall reserves a slot on the toolset's running recording session and
+// returns a recorder for a single tool call, or nil when the toolset is not
+// being recorded or its session is full.
+func (r *Recorder) ForToolCall(ctx context.Context, projectID uuid.UUID, toolsetID uuid.UUID) gateway.ToolCallRecorder {
+	if r == nil {
+		return nil
+	}
+
+	logger := r.logger.With(attr.SlogProjectID(projectID.String()), attr.SlogToolsetID(toolsetID.String()))
+
+	if !r.recording(ctx, logger, projectID, toolsetID) {
+		return nil
+	}
+
+	sessionID, err := repo.New(r.db).ClaimRecordingSlot(ctx, repo.ClaimRecordingSlotParams{
+		ToolsetID: toolsetID,
+		ProjectID: projectID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// The session filled up or was stopped since it was cached. Evict
+		// rather than store a negative entry so a session started meanwhile is
+		// not hidden until the TTL lapses.
+		if err := r.Invalidate(ctx, toolsetID); err != nil {
+			logger.WarnContext(ctx, "invalidate tool call recording session", attr.SlogError(err))
+		}
+		return nil
+	case err != nil:
+		logger.ErrorContext(ctx, "failed to claim tool call recording slot", attr.SlogError(err))
+		return nil
+	}
+
+	return &sessionRecorder{
+		logger:    logger,
+		db:        r.db,
+		sessionID: sessionID,
+		projectID: projectID,
+	}
+}
+
+// recording reports whether the toolset has a running recording session with
+// room left, reading through the session cache.
+func (r *Recorder) recording(ctx context.Context, logger *slog.Logger, projectID uuid.UUID, toolsetID uuid.UUID) bool {
+	if cached, err := r.sessions.Get(ctx, toolsetSessionCacheKey(toolsetID.String())); err == nil {
+		return cached.Active && time.Now().Before(cached.ExpiresAt)
+	}
+
+	entry := toolsetSession{ToolsetID: toolsetID.String(), Active: false, ExpiresAt: time.Time{}}
+
+	expiresAt, err := repo.New(r.db).GetActiveRecordingSessionExpiry(ctx, repo.GetActiveRecordingSessionExpiryParams{
+		ToolsetID: toolsetID,
+		ProjectID: projectID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		logger.ErrorContext(ctx, "failed to look up tool call recording session", attr.SlogError(err))
+		return false
+	default:
+		entry.Active = true
+		entry.ExpiresAt = expiresAt.Time
+	}
+
+	if err := r.sessions.Store(ctx, entry); err != nil {
+		logger.WarnContext(ctx, "cache tool call recording session", attr.SlogError(err))
+	}
+
+	return entry.Active
+}
+
+// Invalidate evicts the cached recording session of a toolset, so starting or
+// stopping a session takes effect before the TTL lapses.
+func (r *Recorder) Invalidate(ctx context.Context, toolsetID uuid.UUID) error {
+	if r == nil {
+		return nil
+	}
+
+	if err := r.sessions.DeleteByKey(ctx, toolsetSessionCacheKey(toolsetID.String())); err != nil {
+		return fmt.Errorf("invalidate tool call recording session: %w", err)
+	}
+	return nil
+}
+
+type sessionRecorder struct {
+	logger    *slog.Logger
+	db        *pgxpool.Pool
+	sessionID uuid.UUID
+	projectID uuid.UUID
+}
+
+func (r *sessionRecorder) RecordToolCall(ctx context.Context, recording *gateway.ToolCallRecording) {
+	bs, err := json.Marshal(recording)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to serialize tool call recording", attr.SlogError(err))
+		return
+	}
+
+	// The call has completed by now and its request context may be cancelled
+	// as soon as the response is written.
+	ctx = context.WithoutCancel(ctx)
+
+	if err := repo.New(r.db).InsertToolCallRecording(ctx, repo.InsertToolCallRecordingParams{
+		SessionID: r.sessionID,
+		ProjectID: r.projectID,
+		ToolUrn:   recording.ToolURN,
+		ToolName:  recording.ToolName,
+		Recording: bs,
+	}); err != nil {
+		r.logger.ErrorContext(ctx, "failed to store tool call recording", attr.SlogError(err))
+	}
+}
@@ -0,0 +1,153 @@
+package toolcallrecordings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/canary"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+	tm "github.com/speakeasy-api/gram/server/internal/telemetry"
+	"github.com/speakeasy-api/gram/server/internal/toolcallrecordings/repo"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+// Outcomes of replaying one recorded tool call.
+const (
+	ReplayPassed  = "passed"
+	ReplayFailed  = "failed"
+	ReplaySkipped = "skipped"
+	ReplayErrored = "errored"
+)
+
+// replayRecording calls the tool a recording captured, as defined in the
+// given deployment, with the recorded arguments. Upstream requests are
+// answered by a stand-in built from the recorded exchanges, so a replay never
+// reaches the network. Only HTTP tools and the workflows built on them can be
+// replayed this way; other tools are skipped.
+func (s *Service) replayRecording(ctx context.Context, logger *slog.Logger, projectID uuid.UUID, deploymentID uuid.UUID, row repo.ToolCallRecording) *types.ToolCallReplay {
+	replay := &types.ToolCallReplay{
+		RecordingID: row.ID.String(),
+		ToolUrn:     row.ToolUrn,
+		ToolName:    row.ToolName,
+		Status:      ReplayPassed,
+		Reason:      nil,
+		Differences: []*types.ToolCallReplayDifference{},
+	}
+	settle := func(status string, reason string) *types.ToolCallReplay {
+		replay.Status = status
+		replay.Reason = &reason
+		return replay
+	}
+
+	var recording gateway.ToolCallRecording
+	if err := json.Unmarshal(row.Recording, &recording); err != nil {
+		logger.ErrorContext(ctx, "failed to decode tool call recording", attr.SlogError(err))
+		return settle(ReplayErrored, "recording could not be decoded")
+	}
+
+	toolURN, err := urn.ParseTool(recording.ToolURN)
+	if err != nil {
+		return settle(ReplayErrored, "recording has an invalid tool urn")
+	}
+
+	switch toolURN.Kind {
+	case urn.ToolKindHTTP, urn.ToolKindPrompt:
+	default:
+		return settle(ReplaySkipped, fmt.Sprintf("%s tools cannot be replayed offline", toolURN.Kind))
+	}
+
+	plan, err := s.toolsets.GetToolCallPlanByURN(canary.WithDeployment(ctx, deploymentID), toolURN, projectID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return settle(ReplayFailed, "tool is not part of the deployment")
+	case err != nil:
+		logger.ErrorContext(ctx, "failed to load tool for replay", attr.SlogToolURN(recording.ToolURN), attr.SlogError(err))
+		return settle(ReplayErrored, "tool could not be loaded")
+	}
+
+	env := toolconfig.ToolCallEnv{
+		SystemEnv:  toolconfig.NewCaseInsensitiveEnv(),
+		UserConfig: toolconfig.NewCaseInsensitiveEnv(),
+		OAuthToken: "",
+		GramEmail:  "",
+		GramChatID: "",
+		MCPClient:  toolconfig.MCPClientIdentity{Name: "", Version: "", OAuthClientID: ""},
+	}
+	// Tools whose server URL comes from the environment still need one to
+	// build a request. The stand-in matches on method and path, so any origin
+	// will do; the recorded one keeps replayed URLs recognisable.
+	if plan.Kind == gateway.ToolKindHTTP && !plan.HTTP.DefaultServerUrl.Valid && plan.HTTP.ServerEnvVar != "" {
+		if origin := recordedOrigin(recording.Upstream); origin != "" {
+			env.SystemEnv.Set(plan.HTTP.ServerEnvVar, origin)
+		}
+	}
+
+	// Recorded arguments were captured after their bindings were applied, and
+	// replays have no caller to resolve principal bindings for.
+	plan.ArgumentBindings = nil
+
+	replayCtx := gateway.WithUpstreamStandIn(ctx, gateway.NewUpstreamStandIn(recording.Upstream))
+
+	rw := httptest.NewRecorder()
+	if err := s.toolProxy.Do(replayCtx, rw, bytes.NewReader(recording.Arguments), env, plan, tm.HTTPLogAttributes{}); err != nil {
+		logger.InfoContext(ctx, "replayed tool call failed", attr.SlogToolURN(recording.ToolURN), attr.SlogError(err))
+		return settle(ReplayErrored, err.Error())
+	}
+
+	replayed := gateway.RecordedResponse{
+		StatusCode: rw.Code,
+		Header:     rw.Header(),
+		Body:       rw.Body.String(),
+		Truncated:  false,
+	}
+	for _, diff := range Compare(recording.Result, replayed) {
+		replay.Differences = append(replay.Differences, &types.ToolCallReplayDifference{
+			Kind:        diff.Kind,
+			Path:        conv.PtrEmpty(diff.Path),
+			Description: diff.Description,
+		})
+	}
+	if len(replay.Differences) > 0 {
+		replay.Status = ReplayFailed
+	}
+
+	return replay
+}
+
+func recordedOrigin(exchanges []gateway.RecordedExchange) string {
+	for _, exchange := range exchanges {
+		u, err := url.Parse(exchange.Request.URL)
+		if err != nil || u.Host == "" {
+			continue
+		}
+		return u.Scheme + "://" + u.Host
+	}
+	return ""
+}
+
+// replayStatusCounts tallies replays by outcome.
+func replayStatusCounts(replays []*types.ToolCallReplay) map[string]int32 {
+	counts := map[string]int32{
+		ReplayPassed:  0,
+		ReplayFailed:  0,
+		ReplaySkipped: 0,
+		ReplayErrored: 0,
+	}
+	for _, replay := range replays {
+		counts[replay.Status]++
+	}
+	return counts
+}
@@ -0,0 +1,143 @@
+package toolcallrecordings_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_call_recordings"
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/gateway"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+func TestToolCallRecording_RecordsUpToMaxCalls(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID, slug := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	require.Nil(t, ti.recorder.ForToolCall(ctx, *authCtx.ProjectID, toolsetID), "nothing is recorded without a session")
+
+	session, err := ti.service.StartToolCallRecording(ctx, &gen.StartToolCallRecordingPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetSlug:      types.Slug(slug),
+		MaxCalls:         2,
+		DurationMinutes:  60,
+	})
+	require.NoError(t, err)
+	require.True(t, session.Active)
+	require.Equal(t, toolsetID.String(), session.ToolsetID)
+
+	for i := range 3 {
+		recorder := ti.recorder.ForToolCall(ctx, *authCtx.ProjectID, toolsetID)
+		if i == 2 {
+			require.Nil(t, recorder, "the session is full")
+			break
+		}
+		require.NotNil(t, recorder)
+		recorder.RecordToolCall(ctx, &gateway.ToolCallRecording{
+			ToolURN:   "tools:http:petstore:list_pets",
+			ToolName:  "list_pets",
+			Arguments: []byte(`{}`),
+			Upstream:  []gateway.RecordedExchange{},
+			Result: gateway.RecordedResponse{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       `[]`,
+				Truncated:  false,
+			},
+			RecordedAt: time.Now(),
+		})
+	}
+
+	exported, err := ti.service.ExportToolCallRecordings(ctx, &gen.ExportToolCallRecordingsPayload{
+		SessionID:        session.ID,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), exported.Session.RecordedCalls)
+	require.False(t, exported.Session.Active)
+	require.Len(t, exported.Recordings, 2)
+	require.Equal(t, "list_pets", exported.Recordings[0].ToolName)
+}
+
+func TestStartToolCallRecording_StopsPreviousSession(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID, slug := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+	_, otherSlug := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	start := func(slug string) *types.ToolCallRecordingSession {
+		session, err := ti.service.StartToolCallRecording(ctx, &gen.StartToolCallRecordingPayload{
+			SessionToken:     nil,
+			ApikeyToken:      nil,
+			ProjectSlugInput: nil,
+			ToolsetSlug:      types.Slug(slug),
+			MaxCalls:         100,
+			DurationMinutes:  60,
+		})
+		require.NoError(t, err)
+		return session
+	}
+
+	first := start(slug)
+	second := start(slug)
+	start(otherSlug)
+
+	sessions, err := ti.service.ListToolCallRecordingSessions(ctx, &gen.ListToolCallRecordingSessionsPayload{
+		ToolsetSlug:      new(types.Slug(slug)),
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Len(t, sessions.Sessions, 2)
+	require.Equal(t, second.ID, sessions.Sessions[0].ID)
+	require.True(t, sessions.Sessions[0].Active)
+	require.Equal(t, first.ID, sessions.Sessions[1].ID)
+	require.False(t, sessions.Sessions[1].Active)
+	require.NotNil(t, sessions.Sessions[1].StoppedAt)
+
+	stopped, err := ti.service.StopToolCallRecording(ctx, &gen.StopToolCallRecordingPayload{
+		ID:               second.ID,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.False(t, stopped.Active)
+	require.Nil(t, ti.recorder.ForToolCall(ctx, *authCtx.ProjectID, toolsetID))
+}
+
+func TestStartToolCallRecording_UnknownToolset(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	_, err := ti.service.StartToolCallRecording(ctx, &gen.StartToolCallRecordingPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetSlug:      "does-not-exist",
+		MaxCalls:         100,
+		DurationMinutes:  60,
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
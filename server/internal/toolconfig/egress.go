@@ -0,0 +1,60 @@
+package toolconfig
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+)
+
+const (
+	// EgressProxyURLVar routes a tool's outbound calls through an egress
+	// proxy when set in one of the environments the tool runs with. It takes
+	// an http, https, socks5 or socks5h URL, with credentials as userinfo.
+	EgressProxyURLVar = "GRAM_EGRESS_PROXY_URL"
+	// EgressProxyCABundleVar holds PEM certificates to trust for the proxy set
+	// in EgressProxyURLVar and for upstream TLS it intercepts.
+	EgressProxyCABundleVar = "GRAM_EGRESS_PROXY_CA_BUNDLE"
+)
+
+// parsedEgressProxies keeps environment-configured proxies parsed, keyed by
+// a hash of their settings, so CA bundles are not decoded on every call.
+var parsedEgressProxies = expirable.NewLRU[string, *guardian.Proxy](256, nil, time.Hour)
+
+// EgressProxy returns the proxy a tool call's outbound traffic leaves
+// through, or nil to use the policy's default. A proxy set in the system
+// environment wins over one the operator registered for the project with
+// [guardian.WithScopedProxy].
+//
+// Only the system environment is consulted: user config can be supplied by
+// the caller, who must not be able to choose where traffic goes. Proxies set
+// in an environment are untrusted, so their own address is held to the
+// policy's blocklist. Errors describe a misconfigured environment and are
+// safe to show to the caller.
+func EgressProxy(policy *guardian.Policy, systemEnv *CaseInsensitiveEnv, projectID string) (*guardian.Proxy, error) {
+	if systemEnv != nil {
+		if rawURL := systemEnv.Get(EgressProxyURLVar); rawURL != "" {
+			caBundle := systemEnv.Get(EgressProxyCABundleVar)
+
+			sum := sha256.Sum256([]byte(rawURL + "\x00" + caBundle))
+			key := hex.EncodeToString(sum[:])
+			if proxy, ok := parsedEgressProxies.Get(key); ok {
+				return proxy, nil
+			}
+
+			proxy, err := guardian.NewUntrustedProxy(rawURL, []byte(caBundle))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", EgressProxyURLVar, err)
+			}
+			parsedEgressProxies.Add(key, proxy)
+
+			return proxy, nil
+		}
+	}
+
+	return policy.ScopedProxy(projectID), nil
+}
@@ -31,6 +31,34 @@ type EnvironmentLoader interface {
 	// # Errors
 	//   * `error`: when an unrecognized error occurs.
 	LoadSystemEnv(ctx context.Context, projectID uuid.UUID, toolsetID uuid.UUID, sourceKind string, sourceSlug string) (*CaseInsensitiveEnv, error)
+
+	// ResolveSecretRefs replaces the values of the named variables that
+	// reference an external secret manager with the secrets they name, in
+	// place. Load and LoadSystemEnv return references as written, so callers
+	// name only the variables the call reads.
+	//
+	// # Errors
+	//   * [*SecretReferenceError]: when a secret reference cannot be resolved.
+	//   * `error`: when an unrecognized error occurs.
+	ResolveSecretRefs(ctx context.Context, projectID uuid.UUID, env *CaseInsensitiveEnv, names []string) error
+}
+
+// SecretReferenceError reports an environment variable whose value references
+// a secret in an external secret manager that could not be read. The message
+// names the variable and the reference, never a secret, and says what to fix,
+// so it is safe to show to the caller.
+type SecretReferenceError struct {
+	Name      string
+	Reference string
+	Err       error
+}
+
+func (e *SecretReferenceError) Error() string {
+	return fmt.Sprintf("environment variable %s: cannot resolve %s: %s", e.Name, e.Reference, e.Err)
+}
+
+func (e *SecretReferenceError) Unwrap() error {
+	return e.Err
 }
 
 // SlugOrID represents either a slug string or a UUID identifier.
@@ -0,0 +1,52 @@
+package toolconfig
+
+// Claims of the authenticated caller that tool arguments can be bound to.
+const (
+	PrincipalClaimEmail            = "email"
+	PrincipalClaimUserID           = "user_id"
+	PrincipalClaimExternalUserID   = "external_user_id"
+	PrincipalClaimOrganizationID   = "organization_id"
+	PrincipalClaimOrganizationSlug = "organization_slug"
+)
+
+// PrincipalClaims lists the claims tool arguments can be bound to.
+var PrincipalClaims = []string{
+	PrincipalClaimEmail,
+	PrincipalClaimUserID,
+	PrincipalClaimExternalUserID,
+	PrincipalClaimOrganizationID,
+	PrincipalClaimOrganizationSlug,
+}
+
+// Principal holds the claims of the caller a tool call is made for. Claims the
+// caller does not have are empty.
+//
+// Group membership is deliberately not a claim: a binding fills an argument
+// with one value, and Gram does not resolve a caller's groups when a tool is
+// called, so bindings to groups are rejected when the variation is saved.
+type Principal struct {
+	Email            string
+	UserID           string
+	ExternalUserID   string
+	OrganizationID   string
+	OrganizationSlug string
+}
+
+// Claim returns the named claim of the principal, or an empty string when the
+// principal does not have it or the claim is unknown.
+func (p Principal) Claim(name string) string {
+	switch name {
+	case PrincipalClaimEmail:
+		return p.Email
+	case PrincipalClaimUserID:
+		return p.UserID
+	case PrincipalClaimExternalUserID:
+		return p.ExternalUserID
+	case PrincipalClaimOrganizationID:
+		return p.OrganizationID
+	case PrincipalClaimOrganizationSlug:
+		return p.OrganizationSlug
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,145 @@
+// Package celenv defines the CEL environment for tool argument constraints.
+// A constraint expression is a boolean predicate over the arguments of one
+// tools/call, written against the call's input exactly as the model sent it.
+//
+// Every top-level argument is available as a variable of dynamic type, so
+// `amount <= 500` and `repo in ["docs", "web"]` read the amount and repo
+// arguments directly. The same arguments are also available together as the
+// args map, which is how an expression tests for an optional argument:
+// `!has(args.force) || args.force == false`. Referencing an argument the call
+// did not supply is an evaluation error, which enforcement treats as a
+// violation. Standard CEL string functions (contains, startsWith, endsWith,
+// matches) are available via the strings extension, and numbers compare
+// across int and double since JSON does not distinguish them.
+package celenv
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+)
+
+// ArgsVariable is the variable holding every argument of the call as a map.
+const ArgsVariable = "args"
+
+// typeIdentifiers are the CEL type names an expression may reference as bare
+// identifiers, e.g. `type(amount) == int`. They are never bound to arguments.
+var typeIdentifiers = []string{"bool", "bytes", "double", "dyn", "int", "list", "map", "null_type", "string", "type", "uint"}
+
+// Expression is a compiled constraint expression. It is safe for concurrent
+// evaluation.
+type Expression struct {
+	program   cel.Program
+	variables []string
+}
+
+type Engine struct {
+	env *cel.Env
+}
+
+// New builds the base CEL environment for constraint expressions. Argument
+// variables are declared per expression at compile time, since they depend on
+// the tool rather than on the environment.
+func New() (*Engine, error) {
+	env, err := cel.NewEnv(
+		ext.Strings(),
+		cel.CrossTypeNumericComparisons(true),
+		cel.Variable(ArgsVariable, cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("build tool constraint cel env: %w", err)
+	}
+
+	return &Engine{env: env}, nil
+}
+
+// Compile parses and type-checks a constraint expression and asserts it is a
+// boolean predicate. Use at constraint create/update time for validation and
+// before evaluation.
+func (e *Engine) Compile(expr string) (*Expression, error) {
+	parsed, iss := e.env.Parse(expr)
+	if iss != nil && iss.Err() != nil {
+		return nil, fmt.Errorf("parse %q: %w", expr, iss.Err())
+	}
+
+	variables := argumentVariables(parsed)
+	env := e.env
+	if len(variables) > 0 {
+		opts := make([]cel.EnvOption, 0, len(variables))
+		for _, name := range variables {
+			opts = append(opts, cel.Variable(name, cel.DynType))
+		}
+
+		extended, err := e.env.Extend(opts...)
+		if err != nil {
+			return nil, fmt.Errorf("declare arguments of %q: %w", expr, err)
+		}
+		env = extended
+	}
+
+	checked, iss := env.Check(parsed)
+	if iss != nil && iss.Err() != nil {
+		return nil, fmt.Errorf("compile %q: %w", expr, iss.Err())
+	}
+	if out := checked.OutputType(); !out.IsExactType(cel.BoolType) {
+		return nil, fmt.Errorf("expression must evaluate to bool, got %s", out)
+	}
+
+	prg, err := env.Program(checked)
+	if err != nil {
+		return nil, fmt.Errorf("program %q: %w", expr, err)
+	}
+
+	return &Expression{program: prg, variables: variables}, nil
+}
+
+// Eval evaluates a compiled expression against the arguments of one call.
+// Arguments the call did not supply are left unbound, so an expression that
+// reads one fails to evaluate.
+func (e *Engine) Eval(expr *Expression, args map[string]any) (bool, error) {
+	if args == nil {
+		args = map[string]any{}
+	}
+
+	activation := make(map[string]any, len(expr.variables)+1)
+	activation[ArgsVariable] = args
+	for _, name := range expr.variables {
+		if value, ok := args[name]; ok {
+			activation[name] = value
+		}
+	}
+
+	out, _, err := expr.program.Eval(activation)
+	if err != nil {
+		return false, fmt.Errorf("eval constraint expression: %w", err)
+	}
+	b, ok := out.(types.Bool)
+	if !ok {
+		return false, fmt.Errorf("constraint expression evaluated to %s, want bool", out.Type())
+	}
+	return bool(b), nil
+}
+
+// argumentVariables returns the free identifiers of a parsed expression that
+// name arguments, sorted and without duplicates. Comprehension variables are
+// included too; they shadow the declaration inside their comprehension, so
+// declaring them is harmless.
+func argumentVariables(parsed *cel.Ast) []string {
+	idents := celast.MatchDescendants(celast.NavigateAST(parsed.NativeRep()), celast.KindMatcher(celast.IdentKind))
+
+	variables := make([]string, 0, len(idents))
+	for _, ident := range idents {
+		name := ident.AsIdent()
+		if name == ArgsVariable || slices.Contains(typeIdentifiers, name) || slices.Contains(variables, name) {
+			continue
+		}
+		variables = append(variables, name)
+	}
+	slices.Sort(variables)
+
+	return variables
+}
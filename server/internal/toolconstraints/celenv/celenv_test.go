@@ -0,0 +1,131 @@
+package celenv_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints/celenv"
+)
+
+func newEngine(t *testing.T) *celenv.Engine {
+	t.Helper()
+	eng, err := celenv.New()
+	require.NoError(t, err)
+	return eng
+}
+
+func sampleArgs(t *testing.T) map[string]any {
+	t.Helper()
+
+	var args map[string]any
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"amount": 250,
+		"repo": "docs",
+		"path": "docs/guides/intro.md",
+		"labels": ["bug", "p1"],
+		"body": {"currency": "USD", "lines": [{"qty": 2}]}
+	}`), &args))
+	return args
+}
+
+func TestCompileAndEvalExpressions(t *testing.T) {
+	t.Parallel()
+
+	eng := newEngine(t)
+	args := sampleArgs(t)
+
+	cases := []struct {
+		expr string
+		want bool
+	}{
+		{expr: `amount <= 500`, want: true},
+		{expr: `amount > 200.5`, want: true},
+		{expr: `amount == 250`, want: true},
+		{expr: `repo in ["docs", "web"]`, want: true},
+		{expr: `repo in ["api"]`, want: false},
+		{expr: `path.matches("^docs/")`, want: true},
+		{expr: `path.startsWith("src/")`, want: false},
+		{expr: `"p1" in labels`, want: true},
+		{expr: `body.currency == "USD" && body.lines.all(l, l.qty < 10)`, want: true},
+		{expr: `args.amount <= 500`, want: true},
+		{expr: `!has(args.force) || args.force == false`, want: true},
+		{expr: `has(args.force)`, want: false},
+		{expr: `type(repo) == string`, want: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.expr, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := eng.Compile(tc.expr)
+			require.NoError(t, err)
+
+			got, err := eng.Eval(expr, args)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCompileRejectsInvalidExpressions(t *testing.T) {
+	t.Parallel()
+
+	eng := newEngine(t)
+
+	for _, expr := range []string{
+		`amount <=`,
+		`amount + 1`,
+		`"constant"`,
+		`amount.exists(`,
+	} {
+		_, err := eng.Compile(expr)
+		require.Error(t, err, expr)
+	}
+}
+
+func TestEvalMissingArgumentErrors(t *testing.T) {
+	t.Parallel()
+
+	eng := newEngine(t)
+
+	expr, err := eng.Compile(`amount <= 500`)
+	require.NoError(t, err)
+
+	_, err = eng.Eval(expr, map[string]any{"repo": "docs"})
+	require.Error(t, err)
+
+	_, err = eng.Eval(expr, nil)
+	require.Error(t, err)
+}
+
+func TestProgramsEvalDecodedArguments(t *testing.T) {
+	t.Parallel()
+
+	programs, err := celenv.NewPrograms(newEngine(t), 8)
+	require.NoError(t, err)
+
+	args, err := celenv.DecodeArguments(json.RawMessage(`{"amount": 250}`))
+	require.NoError(t, err)
+
+	ok, err := programs.Eval("amount <= 500", args)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	first, err := programs.Program("amount <= 500")
+	require.NoError(t, err)
+	second, err := programs.Program("amount <= 500")
+	require.NoError(t, err)
+	require.Same(t, first, second)
+
+	_, err = programs.Eval("amount +", args)
+	require.Error(t, err)
+
+	empty, err := celenv.DecodeArguments(nil)
+	require.NoError(t, err)
+	require.Empty(t, empty)
+
+	_, err = celenv.DecodeArguments(json.RawMessage(`[1, 2]`))
+	require.Error(t, err)
+}
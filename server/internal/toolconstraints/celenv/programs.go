@@ -0,0 +1,91 @@
+package celenv
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/sync/singleflight"
+)
+
+// Programs is a bounded cache of compiled expressions keyed by their source,
+// shared by everything that evaluates user-configured expressions on the
+// tools/call path so an expression is compiled once rather than per call.
+type Programs struct {
+	eng      *Engine
+	programs *lru.Cache[string, *Expression]
+	flight   singleflight.Group
+}
+
+// NewPrograms builds a cache holding at most size compiled expressions.
+func NewPrograms(eng *Engine, size int) (*Programs, error) {
+	programs, err := lru.New[string, *Expression](size)
+	if err != nil {
+		return nil, fmt.Errorf("create cel program cache: %w", err)
+	}
+
+	return &Programs{
+		eng:      eng,
+		programs: programs,
+		flight:   singleflight.Group{},
+	}, nil
+}
+
+// Program returns the compiled expression for expr, compiling on a cache
+// miss. Concurrent misses for the same expression share one compilation, and
+// a compile failure is not cached.
+func (p *Programs) Program(expr string) (*Expression, error) {
+	if compiled, ok := p.programs.Get(expr); ok {
+		return compiled, nil
+	}
+
+	v, err, _ := p.flight.Do(expr, func() (any, error) {
+		if compiled, ok := p.programs.Get(expr); ok {
+			return compiled, nil
+		}
+
+		compiled, err := p.eng.Compile(expr)
+		if err != nil {
+			return nil, err
+		}
+		p.programs.Add(expr, compiled)
+		return compiled, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	compiled, ok := v.(*Expression)
+	if !ok {
+		return nil, fmt.Errorf("compile flight returned unexpected type %T", v)
+	}
+	return compiled, nil
+}
+
+// Eval compiles expr through the cache and evaluates it against args.
+func (p *Programs) Eval(expr string, args map[string]any) (bool, error) {
+	compiled, err := p.Program(expr)
+	if err != nil {
+		return false, err
+	}
+
+	return p.eng.Eval(compiled, args)
+}
+
+// DecodeArguments reads a call's arguments object into the form Eval expects.
+// Absent arguments are an empty object; anything other than an object cannot
+// be evaluated.
+func DecodeArguments(raw json.RawMessage) (map[string]any, error) {
+	args := map[string]any{}
+	trimmed := strings.TrimSpace(string(raw))
+	if trimmed == "" || trimmed == "null" {
+		return args, nil
+	}
+
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return nil, fmt.Errorf("tool call arguments must be a JSON object: %w", err)
+	}
+
+	return args, nil
+}
@@ -0,0 +1,36 @@
+package toolconstraints
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints/repo"
+)
+
+// ConstraintCache serves the constraints attached to a toolset or MCP server
+// without a database query per tools/call. One instance is shared by the
+// Enforcer, which reads it, and the Service, which evicts it on every write.
+type ConstraintCache = cache.TargetCache[repo.ToolCallConstraint]
+
+// NewConstraintCache builds the cache over the given database and cache
+// backends.
+func NewConstraintCache(logger *slog.Logger, db *pgxpool.Pool, c cache.Cache) *ConstraintCache {
+	logger = logger.With(attr.SlogComponent("toolconstraints-cache"))
+	return cache.NewTargetCache(logger, c, "toolconstraints", func(ctx context.Context, projectID uuid.UUID, toolsetID uuid.NullUUID, mcpServerID uuid.NullUUID) ([]repo.ToolCallConstraint, error) {
+		constraints, err := repo.New(db).ListToolCallConstraintsForTarget(ctx, repo.ListToolCallConstraintsForTargetParams{
+			ProjectID:   projectID,
+			ToolsetID:   toolsetID,
+			McpServerID: mcpServerID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list tool call constraints: %w", err)
+		}
+		return constraints, nil
+	})
+}
@@ -0,0 +1,165 @@
+package toolconstraints_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_constraints"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/audit/audittest"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+)
+
+func TestCreateToolConstraint_ToolsetTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	beforeCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolConstraintCreate)
+	require.NoError(t, err)
+
+	result, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         "create_refund",
+		Effect:           toolconstraints.EffectAllow,
+		Expression:       "amount <= 500",
+		Message:          new("Refunds above $500 need a human."),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, result.ID)
+	require.Equal(t, authCtx.ProjectID.String(), result.ProjectID)
+	require.Equal(t, new(toolsetID.String()), result.ToolsetID)
+	require.Nil(t, result.McpServerID)
+	require.Equal(t, "create_refund", result.ToolName)
+	require.Equal(t, toolconstraints.EffectAllow, result.Effect)
+	require.Equal(t, "amount <= 500", result.Expression)
+	require.Equal(t, new("Refunds above $500 need a human."), result.Message)
+
+	afterCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolConstraintCreate)
+	require.NoError(t, err)
+	require.Equal(t, beforeCount+1, afterCount)
+}
+
+func TestCreateToolConstraint_McpServerTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	mcpServerID := seedMcpServer(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	result, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        nil,
+		McpServerID:      new(mcpServerID.String()),
+		ToolName:         "push_commit",
+		Effect:           toolconstraints.EffectDeny,
+		Expression:       `repo in ["prod-infra"]`,
+		Message:          nil,
+	})
+	require.NoError(t, err)
+	require.Nil(t, result.ToolsetID)
+	require.Equal(t, new(mcpServerID.String()), result.McpServerID)
+	require.Equal(t, toolconstraints.EffectDeny, result.Effect)
+	require.Nil(t, result.Message)
+}
+
+func TestCreateToolConstraint_RejectsInvalidExpression(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	for _, expr := range []string{"amount <=", "amount + 1"} {
+		_, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+			SessionToken:     nil,
+			ApikeyToken:      nil,
+			ProjectSlugInput: nil,
+			ToolsetID:        new(toolsetID.String()),
+			McpServerID:      nil,
+			ToolName:         "create_refund",
+			Effect:           toolconstraints.EffectAllow,
+			Expression:       expr,
+			Message:          nil,
+		})
+		requireOopsCode(t, err, oops.CodeBadRequest)
+	}
+}
+
+func TestCreateToolConstraint_RequiresExactlyOneTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+	mcpServerID := seedMcpServer(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	_, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      new(mcpServerID.String()),
+		ToolName:         "echo",
+		Effect:           toolconstraints.EffectAllow,
+		Expression:       "true",
+		Message:          nil,
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+
+	_, err = ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        nil,
+		McpServerID:      nil,
+		ToolName:         "echo",
+		Effect:           toolconstraints.EffectAllow,
+		Expression:       "true",
+		Message:          nil,
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+}
+
+func TestCreateToolConstraint_RejectsUnknownTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	_, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(uuid.NewString()),
+		McpServerID:      nil,
+		ToolName:         "echo",
+		Effect:           toolconstraints.EffectAllow,
+		Expression:       "true",
+		Message:          nil,
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+}
@@ -0,0 +1,86 @@
+package toolconstraints_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_constraints"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/audit/audittest"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+)
+
+func TestDeleteToolConstraint(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	created, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         "create_refund",
+		Effect:           toolconstraints.EffectAllow,
+		Expression:       "amount <= 500",
+		Message:          nil,
+	})
+	require.NoError(t, err)
+
+	beforeCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolConstraintDelete)
+	require.NoError(t, err)
+
+	err = ti.service.DeleteToolConstraint(ctx, &gen.DeleteToolConstraintPayload{
+		ID:               created.ID,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+
+	afterCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolConstraintDelete)
+	require.NoError(t, err)
+	require.Equal(t, beforeCount+1, afterCount)
+
+	list, err := ti.service.ListToolConstraints(ctx, &gen.ListToolConstraintsPayload{
+		ToolsetID:        nil,
+		McpServerID:      nil,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Empty(t, list.Constraints)
+
+	err = ti.service.DeleteToolConstraint(ctx, &gen.DeleteToolConstraintPayload{
+		ID:               created.ID,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
+
+func TestDeleteToolConstraint_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	err := ti.service.DeleteToolConstraint(ctx, &gen.DeleteToolConstraintPayload{
+		ID:               uuid.NewString(),
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
@@ -0,0 +1,252 @@
+package toolconstraints
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	riskv1 "github.com/speakeasy-api/gram/infra/gen/gram/risk/v1"
+	"github.com/speakeasy-api/gram/infra/pkg/gcp"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/scanners"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints/celenv"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints/repo"
+)
+
+// Effects decide how a constraint's expression result is read.
+const (
+	// EffectAllow admits a call only when the expression is true.
+	EffectAllow = "allow"
+	// EffectDeny rejects a call when the expression is true.
+	EffectDeny = "deny"
+)
+
+// FindingSource is the risk finding source recorded for constraint
+// violations. No category owns it, so violations classify as custom,
+// organization-defined findings.
+const FindingSource = "argument_constraint"
+
+// programCacheSize bounds the number of distinct compiled constraint
+// expressions held in memory across all projects.
+const programCacheSize = 4096
+
+// Call identifies one tools/call for enforcement. At least one of ToolsetID
+// and McpServerID is set; constraints attached to either apply. Arguments is
+// the call's raw arguments object with its server-side bindings already
+// applied (see gateway.BindArguments), so constraints judge the values the
+// tool will actually receive rather than ones a binding is about to replace.
+type Call struct {
+	OrganizationID string
+	ProjectID      uuid.UUID
+	ToolsetID      uuid.NullUUID
+	McpServerID    uuid.NullUUID
+	ToolName       string
+	Arguments      json.RawMessage
+}
+
+// Enforcer checks the arguments of tools/call requests against the
+// user-configured CEL constraints in tool_call_constraints before the call is
+// proxied.
+//
+// Enforcement fails closed: a constraint that cannot be loaded, compiled or
+// evaluated against a call's arguments rejects the call, because a guardrail
+// that lapses whenever it errors is not a guardrail. A nil *Enforcer allows
+// every call.
+type Enforcer struct {
+	logger      *slog.Logger
+	constraints *ConstraintCache
+	programs    *celenv.Programs
+	findingsPub gcp.Publisher[*riskv1.Finding]
+	violations  metric.Int64Counter
+}
+
+func NewEnforcer(
+	logger *slog.Logger,
+	meterProvider metric.MeterProvider,
+	constraints *ConstraintCache,
+	celEng *celenv.Engine,
+	findingsPub gcp.Publisher[*riskv1.Finding],
+) (*Enforcer, error) {
+	logger = logger.With(attr.SlogComponent("toolconstraints"))
+	meter := meterProvider.Meter("github.com/speakeasy-api/gram/server/internal/toolconstraints")
+
+	violations, err := meter.Int64Counter(
+		"mcp.tool_call.constraint_violated",
+		metric.WithDescription("Tool calls rejected by a user-configured argument constraint"),
+		metric.WithUnit("{call}"),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "failed to create tool call constraint counter", attr.SlogError(err))
+	}
+
+	programs, err := celenv.NewPrograms(celEng, programCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("create constraint program cache: %w", err)
+	}
+
+	return &Enforcer{
+		logger:      logger,
+		constraints: constraints,
+		programs:    programs,
+		findingsPub: findingsPub,
+		violations:  violations,
+	}, nil
+}
+
+// Check evaluates every constraint governing call and returns a
+// *ViolationError for the first one the call's arguments violate. Constraints
+// are evaluated in a stable order. Violations are logged and published as
+// risk findings.
+func (e *Enforcer) Check(ctx context.Context, call Call) error {
+	if e == nil || (!call.ToolsetID.Valid && !call.McpServerID.Valid) {
+		return nil
+	}
+
+	constraints, err := e.constraintsForCall(ctx, call)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "failed to load tool call constraints").LogError(ctx, e.logger, attr.SlogProjectID(call.ProjectID.String()))
+	}
+	if len(constraints) == 0 {
+		return nil
+	}
+
+	args, argsErr := celenv.DecodeArguments(call.Arguments)
+
+	for _, constraint := range constraints {
+		evalErr := argsErr
+		satisfied := false
+		if evalErr == nil {
+			satisfied, evalErr = e.evaluate(constraint, args)
+		}
+		if evalErr == nil && satisfied {
+			continue
+		}
+
+		violation := newViolationError(constraint, evalErr)
+		e.recordViolation(ctx, constraint, call, violation)
+		return violation
+	}
+
+	return nil
+}
+
+// constraintsForCall returns the constraints governing call: those attached to
+// its toolset or MCP server that name the called tool, ordered by id.
+func (e *Enforcer) constraintsForCall(ctx context.Context, call Call) ([]repo.ToolCallConstraint, error) {
+	var constraints []repo.ToolCallConstraint
+	if call.ToolsetID.Valid {
+		toolsetConstraints, err := e.constraints.Get(ctx, call.ProjectID, call.ToolsetID, uuid.NullUUID{UUID: uuid.Nil, Valid: false})
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, toolsetConstraints...)
+	}
+	if call.McpServerID.Valid {
+		serverConstraints, err := e.constraints.Get(ctx, call.ProjectID, uuid.NullUUID{UUID: uuid.Nil, Valid: false}, call.McpServerID)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, serverConstraints...)
+	}
+
+	constraints = slices.DeleteFunc(constraints, func(constraint repo.ToolCallConstraint) bool {
+		return constraint.ToolName != call.ToolName
+	})
+	slices.SortFunc(constraints, func(a, b repo.ToolCallConstraint) int {
+		return bytes.Compare(a.ID[:], b.ID[:])
+	})
+
+	return constraints, nil
+}
+
+// evaluate reports whether args satisfy constraint: for an allow constraint
+// the expression must be true, for a deny constraint it must be false.
+func (e *Enforcer) evaluate(constraint repo.ToolCallConstraint, args map[string]any) (bool, error) {
+	result, err := e.programs.Eval(constraint.Expression, args)
+	if err != nil {
+		return false, err
+	}
+
+	switch constraint.Effect {
+	case EffectAllow:
+		return result, nil
+	case EffectDeny:
+		return !result, nil
+	default:
+		return false, fmt.Errorf("unsupported constraint effect %q", constraint.Effect)
+	}
+}
+
+func (e *Enforcer) recordViolation(ctx context.Context, constraint repo.ToolCallConstraint, call Call, violation *ViolationError) {
+	if e.violations != nil {
+		e.violations.Add(ctx, 1, metric.WithAttributes(
+			attr.ToolConstraintEffect(constraint.Effect),
+		))
+	}
+
+	trace.SpanFromContext(ctx).AddEvent("tool_call.constraint_violated", trace.WithAttributes(
+		attr.ToolConstraintID(constraint.ID.String()),
+		attr.ToolConstraintEffect(constraint.Effect),
+		attr.ToolName(call.ToolName),
+	))
+
+	logAttrs := []any{
+		attr.SlogProjectID(call.ProjectID.String()),
+		attr.SlogToolName(call.ToolName),
+		attr.SlogToolConstraintID(constraint.ID.String()),
+		attr.SlogToolConstraintEffect(constraint.Effect),
+	}
+	if violation.EvalErr != nil {
+		logAttrs = append(logAttrs, attr.SlogError(violation.EvalErr))
+	}
+	e.logger.InfoContext(ctx, "tool call rejected by argument constraint", logAttrs...)
+
+	e.publishFinding(ctx, constraint, call, violation)
+}
+
+// publishFinding records the violation as a risk finding. Publishing is
+// asynchronous and best effort: the call has already been rejected, so a
+// publish failure is only logged.
+func (e *Enforcer) publishFinding(ctx context.Context, constraint repo.ToolCallConstraint, call Call, violation *ViolationError) {
+	if e.findingsPub == nil {
+		return
+	}
+
+	meta := scanners.FindingMetadata{
+		RequestID:         uuid.NewString(),
+		ChatMessageID:     "",
+		ContentPartID:     "",
+		ProjectID:         call.ProjectID.String(),
+		OrganizationID:    call.OrganizationID,
+		RiskPolicyID:      "",
+		RiskPolicyVersion: 0,
+	}
+	finding := scanners.Finding{
+		RuleID:              FindingSource + "." + constraint.Effect,
+		Description:         violation.Message(),
+		Match:               call.ToolName,
+		StartPos:            0,
+		EndPos:              0,
+		Tags:                []string{"constraint:" + constraint.ID.String()},
+		Source:              FindingSource,
+		Confidence:          1,
+		DeadLetterReason:    "",
+		McpLookupToolCallID: "",
+		SpanGroupKey:        "",
+		Field:               "",
+		Path:                "",
+	}
+
+	go func() {
+		ctx := context.WithoutCancel(ctx)
+		_, _, _ = scanners.PublishFindings(ctx, e.logger, e.findingsPub, meta, []scanners.Finding{finding}, FindingSource)
+	}()
+}
@@ -0,0 +1,182 @@
+package toolconstraints_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_constraints"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+)
+
+func TestEnforcer_AllowAndDenyConstraints(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	allow, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         "create_refund",
+		Effect:           toolconstraints.EffectAllow,
+		Expression:       "amount <= 500",
+		Message:          new("Refunds above $500 need a human."),
+	})
+	require.NoError(t, err)
+
+	_, err = ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         "create_refund",
+		Effect:           toolconstraints.EffectDeny,
+		Expression:       `currency == "BTC"`,
+		Message:          nil,
+	})
+	require.NoError(t, err)
+
+	call := func(toolName string, args string) toolconstraints.Call {
+		return toolconstraints.Call{
+			OrganizationID: authCtx.ActiveOrganizationID,
+			ProjectID:      *authCtx.ProjectID,
+			ToolsetID:      uuid.NullUUID{UUID: toolsetID, Valid: true},
+			McpServerID:    uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+			ToolName:       toolName,
+			Arguments:      json.RawMessage(args),
+		}
+	}
+
+	require.NoError(t, ti.enforcer.Check(ctx, call("create_refund", `{"amount": 120, "currency": "USD"}`)))
+
+	err = ti.enforcer.Check(ctx, call("create_refund", `{"amount": 900, "currency": "USD"}`))
+	var violation *toolconstraints.ViolationError
+	require.ErrorAs(t, err, &violation)
+	require.Equal(t, allow.ID, violation.ConstraintID.String())
+	require.NoError(t, violation.EvalErr)
+
+	err = ti.enforcer.Check(ctx, call("create_refund", `{"amount": 10, "currency": "BTC"}`))
+	require.ErrorAs(t, err, &violation)
+	require.Equal(t, toolconstraints.EffectDeny, violation.Effect)
+
+	require.NoError(t, ti.enforcer.Check(ctx, call("list_refunds", `{"amount": 900}`)), "constraints only govern their own tool")
+}
+
+func TestEnforcer_FailsClosed(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	mcpServerID := seedMcpServer(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	_, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        nil,
+		McpServerID:      new(mcpServerID.String()),
+		ToolName:         "push_commit",
+		Effect:           toolconstraints.EffectAllow,
+		Expression:       `branch != "main"`,
+		Message:          nil,
+	})
+	require.NoError(t, err)
+
+	call := func(args string) toolconstraints.Call {
+		return toolconstraints.Call{
+			OrganizationID: authCtx.ActiveOrganizationID,
+			ProjectID:      *authCtx.ProjectID,
+			ToolsetID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+			McpServerID:    uuid.NullUUID{UUID: mcpServerID, Valid: true},
+			ToolName:       "push_commit",
+			Arguments:      json.RawMessage(args),
+		}
+	}
+
+	require.NoError(t, ti.enforcer.Check(ctx, call(`{"branch": "feature"}`)))
+
+	for _, args := range []string{`{}`, `["feature"]`} {
+		err := ti.enforcer.Check(ctx, call(args))
+		var violation *toolconstraints.ViolationError
+		require.ErrorAs(t, err, &violation, args)
+		require.Error(t, violation.EvalErr, args)
+	}
+}
+
+func TestEnforcer_WritesEvictCachedConstraints(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	call := toolconstraints.Call{
+		OrganizationID: authCtx.ActiveOrganizationID,
+		ProjectID:      *authCtx.ProjectID,
+		ToolsetID:      uuid.NullUUID{UUID: toolsetID, Valid: true},
+		McpServerID:    uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		ToolName:       "create_refund",
+		Arguments:      json.RawMessage(`{"amount": 900}`),
+	}
+
+	// Caches the toolset as having no constraints.
+	require.NoError(t, ti.enforcer.Check(ctx, call))
+
+	created, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         "create_refund",
+		Effect:           toolconstraints.EffectAllow,
+		Expression:       "amount <= 500",
+		Message:          nil,
+	})
+	require.NoError(t, err)
+
+	var violation *toolconstraints.ViolationError
+	require.ErrorAs(t, ti.enforcer.Check(ctx, call), &violation, "a new constraint applies without waiting for the cache to expire")
+
+	err = ti.service.DeleteToolConstraint(ctx, &gen.DeleteToolConstraintPayload{
+		ID:               created.ID,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ti.enforcer.Check(ctx, call), "a deleted constraint stops applying without waiting for the cache to expire")
+}
+
+func TestEnforcer_NilAllowsEverything(t *testing.T) {
+	t.Parallel()
+
+	var enforcer *toolconstraints.Enforcer
+	require.NoError(t, enforcer.Check(t.Context(), toolconstraints.Call{
+		OrganizationID: "",
+		ProjectID:      uuid.New(),
+		ToolsetID:      uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		McpServerID:    uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		ToolName:       "echo",
+		Arguments:      json.RawMessage(`{"anything": true}`),
+	}))
+}
@@ -0,0 +1,68 @@
+package toolconstraints
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints/repo"
+)
+
+// ViolationError reports a tools/call whose arguments violate an argument
+// constraint. It is not a protocol failure: MCP surfaces answer it with a
+// tool result flagged isError carrying Message, so the model can read why the
+// call was refused and correct its arguments.
+type ViolationError struct {
+	ConstraintID uuid.UUID
+	ToolName     string
+	Effect       string
+	Expression   string
+	// Explanation is the constraint's configured message, if any.
+	Explanation string
+	// EvalErr is set when the constraint could not be evaluated against the
+	// call's arguments, e.g. because an argument it reads was omitted.
+	EvalErr error
+}
+
+func newViolationError(constraint repo.ToolCallConstraint, evalErr error) *ViolationError {
+	return &ViolationError{
+		ConstraintID: constraint.ID,
+		ToolName:     constraint.ToolName,
+		Effect:       constraint.Effect,
+		Expression:   constraint.Expression,
+		Explanation:  constraint.Message.String,
+		EvalErr:      evalErr,
+	}
+}
+
+func (e *ViolationError) Error() string {
+	if e.EvalErr != nil {
+		return fmt.Sprintf("tool call constraint %s could not be evaluated: %s", e.ConstraintID, e.EvalErr)
+	}
+	return fmt.Sprintf("tool call constraint %s violated", e.ConstraintID)
+}
+
+// Message is the model-facing explanation of the rejection. It names the
+// constraint's expression so the model can retry with conforming arguments.
+func (e *ViolationError) Message() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "The call to %s was blocked by an argument constraint", e.ToolName)
+	if e.Explanation != "" {
+		fmt.Fprintf(&b, ": %s", e.Explanation)
+	}
+	b.WriteString(".")
+
+	switch e.Effect {
+	case EffectDeny:
+		fmt.Fprintf(&b, " Arguments must not satisfy `%s`.", e.Expression)
+	default:
+		fmt.Fprintf(&b, " Arguments must satisfy `%s`.", e.Expression)
+	}
+
+	if e.EvalErr != nil {
+		b.WriteString(" The constraint could not be checked against the arguments provided; make sure every argument it references is present and of the expected type.")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,39 @@
+package toolconstraints_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+)
+
+func TestViolationError_Message(t *testing.T) {
+	t.Parallel()
+
+	allow := &toolconstraints.ViolationError{
+		ConstraintID: uuid.New(),
+		ToolName:     "create_refund",
+		Effect:       toolconstraints.EffectAllow,
+		Expression:   "amount <= 500",
+		Explanation:  "Refunds above $500 need a human",
+		EvalErr:      nil,
+	}
+	require.Equal(t,
+		"The call to create_refund was blocked by an argument constraint: Refunds above $500 need a human. Arguments must satisfy `amount <= 500`.",
+		allow.Message())
+
+	deny := &toolconstraints.ViolationError{
+		ConstraintID: uuid.New(),
+		ToolName:     "push_commit",
+		Effect:       toolconstraints.EffectDeny,
+		Expression:   `branch == "main"`,
+		Explanation:  "",
+		EvalErr:      errors.New("no such attribute"),
+	}
+	require.Contains(t, deny.Message(), "The call to push_commit was blocked by an argument constraint. Arguments must not satisfy `branch == \"main\"`.")
+	require.Contains(t, deny.Message(), "could not be checked")
+	require.Contains(t, deny.Error(), "could not be evaluated")
+}
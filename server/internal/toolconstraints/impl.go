@@ -0,0 +1,403 @@
+package toolconstraints
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+	goahttp "goa.design/goa/v3/http"
+	"goa.design/goa/v3/security"
+
+	srv "github.com/speakeasy-api/gram/server/gen/http/tool_constraints/server"
+	gen "github.com/speakeasy-api/gram/server/gen/tool_constraints"
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/auth"
+	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/middleware"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints/celenv"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints/repo"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+type Service struct {
+	tracer      trace.Tracer
+	logger      *slog.Logger
+	db          *pgxpool.Pool
+	auth        *auth.Auth
+	authz       *authz.Engine
+	audit       *audit.Logger
+	celEng      *celenv.Engine
+	constraints *ConstraintCache
+}
+
+var _ gen.Service = (*Service)(nil)
+var _ gen.Auther = (*Service)(nil)
+
+func NewService(
+	logger *slog.Logger,
+	tracerProvider trace.TracerProvider,
+	db *pgxpool.Pool,
+	sessions *sessions.Manager,
+	authzEngine *authz.Engine,
+	auditLogger *audit.Logger,
+	celEng *celenv.Engine,
+	constraints *ConstraintCache,
+) *Service {
+	logger = logger.With(attr.SlogComponent("toolconstraints"))
+
+	return &Service{
+		tracer:      tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/toolconstraints"),
+		logger:      logger,
+		db:          db,
+		auth:        auth.New(logger, db, sessions, authzEngine),
+		authz:       authzEngine,
+		audit:       auditLogger,
+		celEng:      celEng,
+		constraints: constraints,
+	}
+}
+
+func Attach(mux goahttp.Muxer, service *Service) {
+	endpoints := gen.NewEndpoints(service)
+	endpoints.Use(middleware.MapErrors())
+	endpoints.Use(middleware.TraceMethods(service.tracer))
+	srv.Mount(
+		mux,
+		srv.New(endpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil),
+	)
+}
+
+func (s *Service) APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error) {
+	return s.auth.Authorize(ctx, key, schema)
+}
+
+func (s *Service) CreateToolConstraint(ctx context.Context, payload *gen.CreateToolConstraintPayload) (*types.ToolConstraint, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	toolsetID, err := conv.PtrToNullUUID(payload.ToolsetID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid toolset_id").LogError(ctx, logger)
+	}
+	mcpServerID, err := conv.PtrToNullUUID(payload.McpServerID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid mcp_server_id").LogError(ctx, logger)
+	}
+	if toolsetID.Valid == mcpServerID.Valid {
+		return nil, oops.E(oops.CodeInvalid, nil, "exactly one of toolset_id or mcp_server_id is required").LogError(ctx, logger)
+	}
+
+	if err := s.compileExpression(payload.Expression); err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyTarget(ctx, *authCtx.ProjectID, toolsetID, mcpServerID); err != nil {
+		return nil, err
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	created, err := repo.New(dbtx).CreateToolCallConstraint(ctx, repo.CreateToolCallConstraintParams{
+		ProjectID:   *authCtx.ProjectID,
+		ToolsetID:   toolsetID,
+		McpServerID: mcpServerID,
+		ToolName:    payload.ToolName,
+		Effect:      payload.Effect,
+		Expression:  payload.Expression,
+		Message:     conv.PtrToPGTextEmpty(payload.Message),
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "create tool constraint").LogError(ctx, logger)
+	}
+
+	view := toView(created)
+
+	if err := s.audit.LogToolConstraintCreate(ctx, dbtx, audit.LogToolConstraintCreateEvent{
+		OrganizationID:         authCtx.ActiveOrganizationID,
+		ProjectID:              *authCtx.ProjectID,
+		Actor:                  urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:       authCtx.Email,
+		ActorSlug:              nil,
+		ToolConstraintURN:      urn.NewToolConstraint(created.ID),
+		ToolConstraintSnapshot: view,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log tool constraint creation").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidateConstraints(ctx, created, logger)
+
+	return view, nil
+}
+
+func (s *Service) ListToolConstraints(ctx context.Context, payload *gen.ListToolConstraintsPayload) (*gen.ListToolConstraintsResult, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPRead, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	toolsetID, err := conv.PtrToNullUUID(payload.ToolsetID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid toolset_id").LogError(ctx, logger)
+	}
+	mcpServerID, err := conv.PtrToNullUUID(payload.McpServerID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid mcp_server_id").LogError(ctx, logger)
+	}
+
+	rows, err := repo.New(s.db).ListToolCallConstraints(ctx, repo.ListToolCallConstraintsParams{
+		ProjectID:   *authCtx.ProjectID,
+		ToolsetID:   toolsetID,
+		McpServerID: mcpServerID,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "list tool constraints").LogError(ctx, logger)
+	}
+
+	constraints := make([]*types.ToolConstraint, 0, len(rows))
+	for _, row := range rows {
+		constraints = append(constraints, toView(row))
+	}
+
+	return &gen.ListToolConstraintsResult{Constraints: constraints}, nil
+}
+
+func (s *Service) UpdateToolConstraint(ctx context.Context, payload *gen.UpdateToolConstraintPayload) (*types.ToolConstraint, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	constraintID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid tool constraint id").LogError(ctx, logger)
+	}
+
+	if payload.Expression != nil {
+		if err := s.compileExpression(*payload.Expression); err != nil {
+			return nil, err
+		}
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	txRepo := repo.New(dbtx)
+
+	existing, err := txRepo.GetToolCallConstraint(ctx, repo.GetToolCallConstraintParams{
+		ID:        constraintID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.E(oops.CodeNotFound, err, "tool constraint not found").LogError(ctx, logger)
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "get tool constraint").LogError(ctx, logger)
+	}
+
+	updated, err := txRepo.UpdateToolCallConstraint(ctx, repo.UpdateToolCallConstraintParams{
+		Effect:     conv.PtrToPGText(payload.Effect),
+		Expression: conv.PtrToPGText(payload.Expression),
+		Message:    conv.PtrToPGText(payload.Message),
+		ID:         constraintID,
+		ProjectID:  *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.E(oops.CodeNotFound, err, "tool constraint not found").LogError(ctx, logger)
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "update tool constraint").LogError(ctx, logger)
+	}
+
+	view := toView(updated)
+
+	if err := s.audit.LogToolConstraintUpdate(ctx, dbtx, audit.LogToolConstraintUpdateEvent{
+		OrganizationID:               authCtx.ActiveOrganizationID,
+		ProjectID:                    *authCtx.ProjectID,
+		Actor:                        urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:             authCtx.Email,
+		ActorSlug:                    nil,
+		ToolConstraintURN:            urn.NewToolConstraint(updated.ID),
+		ToolConstraintSnapshotBefore: toView(existing),
+		ToolConstraintSnapshotAfter:  view,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log tool constraint update").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidateConstraints(ctx, updated, logger)
+
+	return view, nil
+}
+
+func (s *Service) DeleteToolConstraint(ctx context.Context, payload *gen.DeleteToolConstraintPayload) error {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	constraintID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid tool constraint id").LogError(ctx, logger)
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	deleted, err := repo.New(dbtx).DeleteToolCallConstraint(ctx, repo.DeleteToolCallConstraintParams{
+		ID:        constraintID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return oops.E(oops.CodeNotFound, err, "tool constraint not found").LogError(ctx, logger)
+		}
+		return oops.E(oops.CodeUnexpected, err, "delete tool constraint").LogError(ctx, logger)
+	}
+
+	if err := s.audit.LogToolConstraintDelete(ctx, dbtx, audit.LogToolConstraintDeleteEvent{
+		OrganizationID:    authCtx.ActiveOrganizationID,
+		ProjectID:         *authCtx.ProjectID,
+		Actor:             urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:  authCtx.Email,
+		ActorSlug:         nil,
+		ToolConstraintURN: urn.NewToolConstraint(deleted.ID),
+	}); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "log tool constraint deletion").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidateConstraints(ctx, deleted, logger)
+
+	return nil
+}
+
+// invalidateConstraints evicts the cached constraints of a written
+// constraint's target. It is best effort: a failed eviction is logged and the
+// change takes effect once the cache entry expires.
+func (s *Service) invalidateConstraints(ctx context.Context, constraint repo.ToolCallConstraint, logger *slog.Logger) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	if err := s.constraints.Invalidate(ctx, constraint.ToolsetID, constraint.McpServerID); err != nil {
+		logger.WarnContext(ctx, "invalidate tool call constraints", attr.SlogError(err))
+	}
+}
+
+// compileExpression rejects an expression that does not compile to a boolean
+// predicate, so a broken constraint never reaches enforcement where it would
+// block every call to its tool.
+func (s *Service) compileExpression(expr string) error {
+	if _, err := s.celEng.Compile(expr); err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid constraint expression: %s", err.Error())
+	}
+
+	return nil
+}
+
+// verifyTarget checks that the toolset or MCP server a constraint attaches to
+// is a live resource in the caller's project. Returned errors are fully formed
+// oops errors, already logged.
+func (s *Service) verifyTarget(ctx context.Context, projectID uuid.UUID, toolsetID uuid.NullUUID, mcpServerID uuid.NullUUID) error {
+	logger := s.logger.With(attr.SlogProjectID(projectID.String()))
+
+	if toolsetID.Valid {
+		exists, err := repo.New(s.db).ToolsetExistsInProject(ctx, repo.ToolsetExistsInProjectParams{
+			ID:        toolsetID.UUID,
+			ProjectID: projectID,
+		})
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "check toolset").LogError(ctx, logger)
+		}
+		if !exists {
+			return oops.E(oops.CodeInvalid, nil, "toolset_id does not reference a toolset in this project").LogError(ctx, logger)
+		}
+	}
+
+	if mcpServerID.Valid {
+		exists, err := repo.New(s.db).MCPServerExistsInProject(ctx, repo.MCPServerExistsInProjectParams{
+			ID:        mcpServerID.UUID,
+			ProjectID: projectID,
+		})
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "check mcp server").LogError(ctx, logger)
+		}
+		if !exists {
+			return oops.E(oops.CodeInvalid, nil, "mcp_server_id does not reference an MCP server in this project").LogError(ctx, logger)
+		}
+	}
+
+	return nil
+}
+
+func toView(row repo.ToolCallConstraint) *types.ToolConstraint {
+	return &types.ToolConstraint{
+		ID:          row.ID.String(),
+		ProjectID:   row.ProjectID.String(),
+		ToolsetID:   conv.FromNullableUUID(row.ToolsetID),
+		McpServerID: conv.FromNullableUUID(row.McpServerID),
+		ToolName:    row.ToolName,
+		Effect:      row.Effect,
+		Expression:  row.Expression,
+		Message:     conv.FromPGText[string](row.Message),
+		CreatedAt:   row.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:   row.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
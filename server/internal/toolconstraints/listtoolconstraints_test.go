@@ -0,0 +1,71 @@
+package toolconstraints_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_constraints"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+)
+
+func TestListToolConstraints_FiltersByTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+	mcpServerID := seedMcpServer(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	onToolset, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         "create_refund",
+		Effect:           toolconstraints.EffectAllow,
+		Expression:       "amount <= 500",
+		Message:          nil,
+	})
+	require.NoError(t, err)
+
+	onServer, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        nil,
+		McpServerID:      new(mcpServerID.String()),
+		ToolName:         "push_commit",
+		Effect:           toolconstraints.EffectDeny,
+		Expression:       `branch == "main"`,
+		Message:          nil,
+	})
+	require.NoError(t, err)
+
+	all, err := ti.service.ListToolConstraints(ctx, &gen.ListToolConstraintsPayload{
+		ToolsetID:        nil,
+		McpServerID:      nil,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Len(t, all.Constraints, 2)
+
+	filtered, err := ti.service.ListToolConstraints(ctx, &gen.ListToolConstraintsPayload{
+		ToolsetID:        nil,
+		McpServerID:      new(mcpServerID.String()),
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Len(t, filtered.Constraints, 1)
+	require.Equal(t, onServer.ID, filtered.Constraints[0].ID)
+	require.NotEqual(t, onToolset.ID, filtered.Constraints[0].ID)
+}
@@ -0,0 +1,89 @@
+-- name: CreateToolCallConstraint :one
+INSERT INTO tool_call_constraints (
+  project_id,
+  toolset_id,
+  mcp_server_id,
+  tool_name,
+  effect,
+  expression,
+  message
+) VALUES (
+  @project_id,
+  @toolset_id,
+  @mcp_server_id,
+  @tool_name,
+  @effect,
+  @expression,
+  @message
+)
+RETURNING *;
+
+-- name: GetToolCallConstraint :one
+SELECT *
+FROM tool_call_constraints
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE;
+
+-- name: ListToolCallConstraints :many
+SELECT *
+FROM tool_call_constraints
+WHERE project_id = @project_id
+  AND deleted IS FALSE
+  AND (sqlc.narg(toolset_id)::uuid IS NULL OR toolset_id = sqlc.narg(toolset_id)::uuid)
+  AND (sqlc.narg(mcp_server_id)::uuid IS NULL OR mcp_server_id = sqlc.narg(mcp_server_id)::uuid)
+ORDER BY id DESC;
+
+-- name: ListToolCallConstraintsForTarget :many
+-- Returns the live constraints attached to one toolset or MCP server, across
+-- all of its tools. Enforcement caches them per target and narrows them to the
+-- called tool in memory.
+SELECT *
+FROM tool_call_constraints
+WHERE project_id = @project_id
+  AND deleted IS FALSE
+  AND (toolset_id = sqlc.narg(toolset_id)::uuid OR mcp_server_id = sqlc.narg(mcp_server_id)::uuid)
+ORDER BY id ASC;
+
+-- name: UpdateToolCallConstraint :one
+UPDATE tool_call_constraints
+SET
+  effect = COALESCE(sqlc.narg(effect)::text, effect),
+  expression = COALESCE(sqlc.narg(expression)::text, expression),
+  message = CASE
+    WHEN sqlc.narg(message)::text IS NULL THEN message
+    ELSE NULLIF(sqlc.narg(message)::text, '')
+  END,
+  updated_at = clock_timestamp()
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE
+RETURNING *;
+
+-- name: DeleteToolCallConstraint :one
+UPDATE tool_call_constraints
+SET
+  deleted_at = clock_timestamp(),
+  updated_at = clock_timestamp()
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE
+RETURNING *;
+
+-- name: ToolsetExistsInProject :one
+SELECT EXISTS (
+  SELECT 1
+  FROM toolsets
+  WHERE id = @id
+    AND project_id = @project_id
+    AND deleted IS FALSE
+);
+
+-- name: MCPServerExistsInProject :one
+SELECT EXISTS (
+  SELECT 1
+  FROM mcp_servers
+  WHERE id = @id
+    AND project_id = @project_id
+    AND deleted IS FALSE
+);
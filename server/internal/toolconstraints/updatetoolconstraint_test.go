@@ -0,0 +1,124 @@
+package toolconstraints_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_constraints"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/audit/audittest"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolconstraints"
+)
+
+func TestUpdateToolConstraint_PartialUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	created, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         "create_refund",
+		Effect:           toolconstraints.EffectAllow,
+		Expression:       "amount <= 500",
+		Message:          new("Refunds above $500 need a human."),
+	})
+	require.NoError(t, err)
+
+	beforeCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolConstraintUpdate)
+	require.NoError(t, err)
+
+	updated, err := ti.service.UpdateToolConstraint(ctx, &gen.UpdateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ID:               created.ID,
+		Effect:           nil,
+		Expression:       new("amount <= 1000"),
+		Message:          nil,
+	})
+	require.NoError(t, err)
+	require.Equal(t, toolconstraints.EffectAllow, updated.Effect)
+	require.Equal(t, "amount <= 1000", updated.Expression)
+	require.Equal(t, created.Message, updated.Message)
+
+	afterCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolConstraintUpdate)
+	require.NoError(t, err)
+	require.Equal(t, beforeCount+1, afterCount)
+
+	cleared, err := ti.service.UpdateToolConstraint(ctx, &gen.UpdateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ID:               created.ID,
+		Effect:           nil,
+		Expression:       nil,
+		Message:          new(""),
+	})
+	require.NoError(t, err)
+	require.Nil(t, cleared.Message, "an empty message clears it")
+}
+
+func TestUpdateToolConstraint_RejectsInvalidExpression(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	created, err := ti.service.CreateToolConstraint(ctx, &gen.CreateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		ToolName:         "create_refund",
+		Effect:           toolconstraints.EffectAllow,
+		Expression:       "amount <= 500",
+		Message:          nil,
+	})
+	require.NoError(t, err)
+
+	_, err = ti.service.UpdateToolConstraint(ctx, &gen.UpdateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ID:               created.ID,
+		Effect:           nil,
+		Expression:       new(`"not a predicate"`),
+		Message:          nil,
+	})
+	requireOopsCode(t, err, oops.CodeBadRequest)
+}
+
+func TestUpdateToolConstraint_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	_, err := ti.service.UpdateToolConstraint(ctx, &gen.UpdateToolConstraintPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ID:               uuid.NewString(),
+		Effect:           new(toolconstraints.EffectDeny),
+		Expression:       nil,
+		Message:          nil,
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
@@ -0,0 +1,12 @@
+package chrepo
+
+// ToolWindowStatsRow is one project's tool with its call and failure counts
+// over the recent and baseline windows.
+type ToolWindowStatsRow struct {
+	ProjectID        string `ch:"project_id"`
+	ToolURN          string `ch:"gram_urn"`
+	RecentCalls      uint64 `ch:"recent_calls"`
+	RecentFailures   uint64 `ch:"recent_failures"`
+	BaselineCalls    uint64 `ch:"baseline_calls"`
+	BaselineFailures uint64 `ch:"baseline_failures"`
+}
@@ -0,0 +1,63 @@
+package chrepo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+)
+
+// sq is the squirrel statement builder pre-configured for ClickHouse.
+var sq = squirrel.StatementBuilder.PlaceholderFormat(squirrel.Question)
+
+// failureExpr matches the failure definition used by the tool metrics
+// breakdown, so a spike lines up with what the dashboard shows.
+const failureExpr = "toInt32OrZero(toString(attributes.http.response.status_code)) >= 400"
+
+// ListSpikeCandidates returns tool call counts across every project for the
+// recent window [recentStart, end] and the baseline window [baselineStart,
+// end]. Only tools with at least minRecentCalls recent calls are returned;
+// the spike test itself is applied by the caller.
+func (q *Queries) ListSpikeCandidates(
+	ctx context.Context,
+	recentStart, baselineStart, end int64,
+	minRecentCalls uint64,
+) ([]ToolWindowStatsRow, error) {
+	sb := sq.Select("toString(gram_project_id) AS project_id", "gram_urn").
+		Column(squirrel.Expr("countIf(time_unix_nano >= ?) AS recent_calls", recentStart)).
+		Column(squirrel.Expr("countIf(time_unix_nano >= ? AND "+failureExpr+") AS recent_failures", recentStart)).
+		Column("count(*) AS baseline_calls").
+		Column("countIf("+failureExpr+") AS baseline_failures").
+		From("telemetry_logs").
+		Where("time_unix_nano >= ?", baselineStart).
+		Where("time_unix_nano <= ?", end).
+		Where("startsWith(gram_urn, 'tools:')").
+		GroupBy("gram_project_id", "gram_urn").
+		Having("recent_calls >= ?", minRecentCalls)
+
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building spike candidates query: %w", err)
+	}
+
+	rows, err := q.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query spike candidates: %w", err)
+	}
+	defer o11y.NoLogDefer(rows.Close)
+
+	var out []ToolWindowStatsRow
+	for rows.Next() {
+		var row ToolWindowStatsRow
+		if err = rows.ScanStruct(&row); err != nil {
+			return nil, fmt.Errorf("scanning spike candidates row: %w", err)
+		}
+		out = append(out, row)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate spike candidates rows: %w", err)
+	}
+	return out, nil
+}
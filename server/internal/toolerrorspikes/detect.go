@@ -0,0 +1,153 @@
+package toolerrorspikes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/outbox"
+	"github.com/speakeasy-api/gram/server/internal/outbox/events"
+	"github.com/speakeasy-api/gram/server/internal/toolerrorspikes/chrepo"
+	"github.com/speakeasy-api/gram/server/internal/toolerrorspikes/repo"
+)
+
+// DetectResult summarizes one sweep.
+type DetectResult struct {
+	Candidates int `json:"candidates"`
+	Announced  int `json:"announced"`
+}
+
+// Detector runs the spike sweep: read per-tool counts from ClickHouse, test
+// each against its baseline, and announce the ones that pass and are outside
+// their cooldown.
+type Detector struct {
+	logger    *slog.Logger
+	db        *pgxpool.Pool
+	chQueries *chrepo.Queries
+}
+
+func NewDetector(logger *slog.Logger, db *pgxpool.Pool, chQueries *chrepo.Queries) *Detector {
+	return &Detector{
+		logger:    logger.With(attr.SlogComponent("tool_error_spikes")),
+		db:        db,
+		chQueries: chQueries,
+	}
+}
+
+// Detect sweeps every project for spikes ending at now. A failure to
+// announce one tool is logged and the sweep carries on; the tool is picked up
+// again on the next run because its cooldown was never claimed.
+func (d *Detector) Detect(ctx context.Context, now time.Time) (DetectResult, error) {
+	result := DetectResult{Candidates: 0, Announced: 0}
+	if d.chQueries == nil {
+		return result, nil
+	}
+
+	recentStart := now.Add(-RecentWindow)
+	baselineStart := now.Add(-BaselineWindow)
+
+	rows, err := d.chQueries.ListSpikeCandidates(ctx, recentStart.UnixNano(), baselineStart.UnixNano(), now.UnixNano(), MinRecentCalls)
+	if err != nil {
+		return result, fmt.Errorf("list spike candidates: %w", err)
+	}
+
+	var errs []error
+	for _, row := range rows {
+		stats := ToolWindowStats{
+			RecentCalls:      row.RecentCalls,
+			RecentFailures:   row.RecentFailures,
+			BaselineCalls:    row.BaselineCalls,
+			BaselineFailures: row.BaselineFailures,
+		}
+		if !stats.IsSpike() {
+			continue
+		}
+		result.Candidates++
+
+		projectID, err := uuid.Parse(row.ProjectID)
+		if err != nil {
+			// Telemetry without a project cannot be routed to anyone.
+			continue
+		}
+
+		announced, err := d.announce(ctx, projectID, row.ToolURN, stats, recentStart, baselineStart, now)
+		if err != nil {
+			d.logger.ErrorContext(ctx, "announce tool error rate spike",
+				attr.SlogProjectID(projectID.String()),
+				attr.SlogToolURN(row.ToolURN),
+				attr.SlogError(err),
+			)
+			errs = append(errs, err)
+			continue
+		}
+		if announced {
+			result.Announced++
+		}
+	}
+
+	if len(errs) > 0 {
+		return result, fmt.Errorf("announce %d of %d tool error rate spikes: %w", len(errs), result.Candidates, errors.Join(errs...))
+	}
+
+	return result, nil
+}
+
+func (d *Detector) announce(
+	ctx context.Context,
+	projectID uuid.UUID,
+	toolURN string,
+	stats ToolWindowStats,
+	recentStart, baselineStart, now time.Time,
+) (bool, error) {
+	dbtx, err := d.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	claimed, err := repo.New(dbtx).ClaimToolErrorRateSpike(ctx, repo.ClaimToolErrorRateSpikeParams{
+		ProjectID:      projectID,
+		ToolUrn:        toolURN,
+		CooldownCutoff: conv.ToPGTimestamptz(now.Add(-Cooldown)),
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// Still cooling down from an earlier announcement, or the project is
+		// gone.
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("claim spike: %w", err)
+	}
+
+	if _, err := outbox.PublishWebhookEvent(ctx, dbtx, claimed.OrganizationID, events.ToolCallErrorRateSpikeV1, events.ToolCallErrorRateSpikePayloadV1{
+		OrganizationID:      claimed.OrganizationID,
+		ProjectID:           projectID,
+		ToolURN:             toolURN,
+		WindowStart:         recentStart,
+		WindowEnd:           now,
+		RecentCalls:         stats.RecentCalls,
+		RecentFailures:      stats.RecentFailures,
+		RecentFailureRate:   stats.RecentFailureRate(),
+		BaselineStart:       baselineStart,
+		BaselineCalls:       stats.BaselineCalls,
+		BaselineFailureRate: stats.BaselineFailureRate(),
+		DetectedAt:          claimed.LastDetectedAt.Time,
+	}); err != nil {
+		return false, fmt.Errorf("publish spike: %w", err)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,17 @@
+-- name: ClaimToolErrorRateSpike :one
+-- Records a spike for a project's tool unless one was already recorded after
+-- @cooldown_cutoff. Returns no row when the detection falls inside the
+-- cooldown, so concurrent or repeated sweeps announce each spike once.
+WITH claimed AS (
+  INSERT INTO tool_error_rate_spikes (project_id, tool_urn, last_detected_at)
+  VALUES (@project_id, @tool_urn, clock_timestamp())
+  ON CONFLICT (project_id, tool_urn) DO UPDATE
+  SET
+    last_detected_at = EXCLUDED.last_detected_at,
+    updated_at = clock_timestamp()
+  WHERE tool_error_rate_spikes.last_detected_at < @cooldown_cutoff
+  RETURNING project_id, last_detected_at
+)
+SELECT claimed.project_id, p.organization_id, claimed.last_detected_at
+FROM claimed
+JOIN projects AS p ON p.id = claimed.project_id;
@@ -0,0 +1,76 @@
+// Package toolerrorspikes detects tools whose failure rate has jumped well
+// above their own recent baseline and announces each spike once through the
+// outbox as tool_call.error_rate_spike_v1.
+package toolerrorspikes
+
+import "time"
+
+const (
+	// DetectionInterval is how often the scheduled sweep runs.
+	DetectionInterval = 5 * time.Minute
+
+	// RecentWindow is the span whose failure rate is tested for a spike.
+	RecentWindow = 15 * time.Minute
+	// BaselineWindow is the span the recent rate is compared against. It
+	// includes the recent window; a spike long enough to dominate the
+	// baseline has already been announced.
+	BaselineWindow = 24 * time.Hour
+	// Cooldown is the minimum gap between two announcements for the same
+	// project and tool.
+	Cooldown = time.Hour
+
+	// MinRecentCalls keeps a handful of failed calls on a quiet tool from
+	// reading as a spike.
+	MinRecentCalls = 20
+	// MinRecentFailureRate is the floor below which no rise counts.
+	MinRecentFailureRate = 0.2
+	// MinBaselineMultiple is how many times the baseline rate the recent rate
+	// must reach.
+	MinBaselineMultiple = 3
+)
+
+// ToolWindowStats is one tool's call and failure counts over the recent and
+// baseline windows.
+type ToolWindowStats struct {
+	RecentCalls      uint64
+	RecentFailures   uint64
+	BaselineCalls    uint64
+	BaselineFailures uint64
+}
+
+// RecentFailureRate is the failure rate over the recent window.
+func (s ToolWindowStats) RecentFailureRate() float64 {
+	return rate(s.RecentFailures, s.RecentCalls)
+}
+
+// BaselineFailureRate is the failure rate over the baseline window.
+func (s ToolWindowStats) BaselineFailureRate() float64 {
+	return rate(s.BaselineFailures, s.BaselineCalls)
+}
+
+// IsSpike reports whether the recent window is busy enough, failing often
+// enough and far enough above the baseline to be worth announcing. A tool
+// with a clean baseline spikes on the absolute floor alone.
+func (s ToolWindowStats) IsSpike() bool {
+	if s.RecentCalls < MinRecentCalls {
+		return false
+	}
+
+	if s.RecentFailureRate() < MinRecentFailureRate {
+		return false
+	}
+	if s.BaselineCalls == 0 {
+		return true
+	}
+
+	// Cross-multiplied so a rate sitting exactly on the multiple is not lost
+	// to float rounding.
+	return s.RecentFailures*s.BaselineCalls >= MinBaselineMultiple*s.BaselineFailures*s.RecentCalls
+}
+
+func rate(failures, calls uint64) float64 {
+	if calls == 0 {
+		return 0
+	}
+	return float64(failures) / float64(calls)
+}
@@ -0,0 +1,57 @@
+package toolerrorspikes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/toolerrorspikes"
+)
+
+func TestToolWindowStats_IsSpike(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		stats toolerrorspikes.ToolWindowStats
+		want  bool
+	}{
+		{
+			name:  "clean baseline crossing the floor",
+			stats: toolerrorspikes.ToolWindowStats{RecentCalls: 40, RecentFailures: 10, BaselineCalls: 1000, BaselineFailures: 10},
+			want:  true,
+		},
+		{
+			name:  "too few recent calls",
+			stats: toolerrorspikes.ToolWindowStats{RecentCalls: 10, RecentFailures: 10, BaselineCalls: 1000, BaselineFailures: 10},
+			want:  false,
+		},
+		{
+			name:  "below the failure rate floor",
+			stats: toolerrorspikes.ToolWindowStats{RecentCalls: 100, RecentFailures: 15, BaselineCalls: 1000, BaselineFailures: 0},
+			want:  false,
+		},
+		{
+			name:  "chronically failing tool",
+			stats: toolerrorspikes.ToolWindowStats{RecentCalls: 100, RecentFailures: 50, BaselineCalls: 1000, BaselineFailures: 400},
+			want:  false,
+		},
+		{
+			name:  "exactly three times the baseline",
+			stats: toolerrorspikes.ToolWindowStats{RecentCalls: 100, RecentFailures: 30, BaselineCalls: 1000, BaselineFailures: 100},
+			want:  true,
+		},
+		{
+			name:  "no baseline calls",
+			stats: toolerrorspikes.ToolWindowStats{RecentCalls: 20, RecentFailures: 20, BaselineCalls: 0, BaselineFailures: 0},
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, tt.stats.IsSpike())
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package toolratelimits_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_rate_limits"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/audit/audittest"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
+)
+
+func TestCreateToolRateLimit_ToolsetTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	beforeCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolRateLimitCreate)
+	require.NoError(t, err)
+
+	result, err := ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		Subject:          toolratelimits.SubjectEndUser,
+		ToolName:         new("search_issues"),
+		Requests:         10,
+		IntervalSeconds:  60,
+		Burst:            nil,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, result.ID)
+	require.Equal(t, authCtx.ProjectID.String(), result.ProjectID)
+	require.Equal(t, new(toolsetID.String()), result.ToolsetID)
+	require.Nil(t, result.McpServerID)
+	require.Equal(t, toolratelimits.SubjectEndUser, result.Subject)
+	require.Equal(t, new("search_issues"), result.ToolName)
+	require.Equal(t, int32(10), result.Requests)
+	require.Equal(t, int32(60), result.IntervalSeconds)
+	require.Equal(t, int32(10), result.Burst, "burst defaults to requests")
+
+	afterCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolRateLimitCreate)
+	require.NoError(t, err)
+	require.Equal(t, beforeCount+1, afterCount)
+}
+
+func TestCreateToolRateLimit_McpServerTargetWithBurst(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	mcpServerID := seedMcpServer(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	result, err := ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        nil,
+		McpServerID:      new(mcpServerID.String()),
+		Subject:          toolratelimits.SubjectAPIKey,
+		ToolName:         nil,
+		Requests:         100,
+		IntervalSeconds:  3600,
+		Burst:            new(int32(20)),
+	})
+	require.NoError(t, err)
+	require.Nil(t, result.ToolsetID)
+	require.Equal(t, new(mcpServerID.String()), result.McpServerID)
+	require.Nil(t, result.ToolName)
+	require.Equal(t, int32(20), result.Burst)
+}
+
+func TestCreateToolRateLimit_RequiresExactlyOneTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+	mcpServerID := seedMcpServer(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	_, err := ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      new(mcpServerID.String()),
+		Subject:          toolratelimits.SubjectAll,
+		ToolName:         nil,
+		Requests:         1,
+		IntervalSeconds:  1,
+		Burst:            nil,
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+
+	_, err = ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        nil,
+		McpServerID:      nil,
+		Subject:          toolratelimits.SubjectAll,
+		ToolName:         nil,
+		Requests:         1,
+		IntervalSeconds:  1,
+		Burst:            nil,
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+}
+
+func TestCreateToolRateLimit_RejectsUnknownTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	_, err := ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(uuid.NewString()),
+		McpServerID:      nil,
+		Subject:          toolratelimits.SubjectAll,
+		ToolName:         nil,
+		Requests:         1,
+		IntervalSeconds:  1,
+		Burst:            nil,
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+}
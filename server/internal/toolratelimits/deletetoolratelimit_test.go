@@ -0,0 +1,87 @@
+package toolratelimits_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_rate_limits"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/audit/audittest"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
+)
+
+func TestDeleteToolRateLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	created, err := ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		Subject:          toolratelimits.SubjectAll,
+		ToolName:         nil,
+		Requests:         10,
+		IntervalSeconds:  60,
+		Burst:            nil,
+	})
+	require.NoError(t, err)
+
+	beforeCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolRateLimitDelete)
+	require.NoError(t, err)
+
+	err = ti.service.DeleteToolRateLimit(ctx, &gen.DeleteToolRateLimitPayload{
+		ID:               created.ID,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+
+	afterCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolRateLimitDelete)
+	require.NoError(t, err)
+	require.Equal(t, beforeCount+1, afterCount)
+
+	list, err := ti.service.ListToolRateLimits(ctx, &gen.ListToolRateLimitsPayload{
+		ToolsetID:        nil,
+		McpServerID:      nil,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Empty(t, list.RateLimits)
+
+	err = ti.service.DeleteToolRateLimit(ctx, &gen.DeleteToolRateLimitPayload{
+		ID:               created.ID,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
+
+func TestDeleteToolRateLimit_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	err := ti.service.DeleteToolRateLimit(ctx, &gen.DeleteToolRateLimitPayload{
+		ID:               uuid.NewString(),
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
@@ -0,0 +1,218 @@
+package toolratelimits
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/ratelimit"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits/repo"
+)
+
+// Subjects decide who shares a limit's token bucket.
+const (
+	// SubjectEndUser gives every authenticated end user their own bucket.
+	SubjectEndUser = "end_user"
+	// SubjectAPIKey gives every Gram API key its own bucket.
+	SubjectAPIKey = "api_key"
+	// SubjectAll pools every caller of the target into one bucket.
+	SubjectAll = "all"
+)
+
+// Call identifies one tools/call for enforcement. At least one of ToolsetID
+// and McpServerID is set; limits attached to either apply. The caller
+// identity fields are empty when the request did not carry them.
+type Call struct {
+	ProjectID      uuid.UUID
+	ToolsetID      uuid.NullUUID
+	McpServerID    uuid.NullUUID
+	ToolName       string
+	UserID         string
+	ExternalUserID string
+	APIKeyID       string
+}
+
+// Enforcer checks tools/call requests against the user-configured limits in
+// tool_call_rate_limits, spending tokens from a shared ratelimit.Store so a
+// quota holds across every replica and across the hosted and proxied paths.
+//
+// Limits are read through a LimitCache, so a call costs no database query
+// once its toolset and MCP server have been seen.
+//
+// Failure handling differs by what failed. A failure to load the limits
+// fails closed: the configured quotas are unknown, and the call path already
+// depends on the same database to resolve the tool, so refusing the call
+// costs no availability that the outage has not already taken. A failure to
+// reach the Store fails open for that limit: the quotas are known but the
+// bucket state is not, and a counter outage must not take down tool
+// invocation. A nil *Enforcer allows every call.
+type Enforcer struct {
+	logger     *slog.Logger
+	limits     *LimitCache
+	store      ratelimit.Store
+	rejections metric.Int64Counter
+}
+
+func NewEnforcer(logger *slog.Logger, meterProvider metric.MeterProvider, limits *LimitCache, store ratelimit.Store) *Enforcer {
+	logger = logger.With(attr.SlogComponent("toolratelimits"))
+	meter := meterProvider.Meter("github.com/speakeasy-api/gram/server/internal/toolratelimits")
+
+	rejections, err := meter.Int64Counter(
+		"mcp.tool_call.rate_limited",
+		metric.WithDescription("Tool calls rejected by a user-configured tool-call rate limit"),
+		metric.WithUnit("{call}"),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "failed to create tool call rate limit counter", attr.SlogError(err))
+	}
+
+	return &Enforcer{
+		logger:     logger,
+		limits:     limits,
+		store:      store,
+		rejections: rejections,
+	}
+}
+
+// Check spends one token from every limit governing call and returns an
+// *ExceededError for the first limit whose bucket is empty. Limits are
+// checked in a stable order, so tokens already spent on earlier limits are
+// not refunded when a later one rejects; the rejected call still counts
+// against the quotas it passed.
+func (e *Enforcer) Check(ctx context.Context, call Call) error {
+	if e == nil || (!call.ToolsetID.Valid && !call.McpServerID.Valid) {
+		return nil
+	}
+
+	limits, err := e.limitsForCall(ctx, call)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "failed to load tool call rate limits").LogError(ctx, e.logger, attr.SlogProjectID(call.ProjectID.String()))
+	}
+
+	for _, limit := range limits {
+		key, ok := bucketKey(limit.Subject, call)
+		if !ok {
+			continue
+		}
+
+		rate := ratelimit.Rate{
+			Tokens:   int(limit.Requests),
+			Interval: time.Duration(limit.IntervalSeconds) * time.Second,
+			Burst:    int(limit.Burst),
+		}
+		res, err := ratelimit.New(e.store, "tool-call:"+limit.ID.String(), rate).Allow(ctx, key)
+		if err != nil {
+			e.logger.WarnContext(ctx, "tool call rate limit check failed; allowing call",
+				attr.SlogError(err),
+				attr.SlogProjectID(call.ProjectID.String()),
+				attr.SlogToolName(call.ToolName),
+			)
+			continue
+		}
+		if res.Allowed {
+			continue
+		}
+
+		exceeded := newExceededError(limit, res, time.Now())
+		e.recordRejection(ctx, limit, call, exceeded)
+		return exceeded
+	}
+
+	return nil
+}
+
+// limitsForCall returns the limits governing call: those attached to its
+// toolset or MCP server, either tool-agnostic or naming the tool, ordered by
+// id.
+func (e *Enforcer) limitsForCall(ctx context.Context, call Call) ([]repo.ToolCallRateLimit, error) {
+	var limits []repo.ToolCallRateLimit
+	if call.ToolsetID.Valid {
+		toolsetLimits, err := e.limits.Get(ctx, call.ProjectID, call.ToolsetID, uuid.NullUUID{UUID: uuid.Nil, Valid: false})
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, toolsetLimits...)
+	}
+	if call.McpServerID.Valid {
+		serverLimits, err := e.limits.Get(ctx, call.ProjectID, uuid.NullUUID{UUID: uuid.Nil, Valid: false}, call.McpServerID)
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, serverLimits...)
+	}
+
+	limits = slices.DeleteFunc(limits, func(limit repo.ToolCallRateLimit) bool {
+		return limit.ToolName.Valid && limit.ToolName.String != call.ToolName
+	})
+	slices.SortFunc(limits, func(a, b repo.ToolCallRateLimit) int {
+		return bytes.Compare(a.ID[:], b.ID[:])
+	})
+
+	return limits, nil
+}
+
+// bucketKey returns the bucket a call spends from under a limit's subject.
+// It reports false when the call carries no identity for the subject (e.g.
+// an anonymous caller against an end_user limit); such calls are not
+// attributable, so the limit does not apply to them.
+func bucketKey(subject string, call Call) (string, bool) {
+	switch subject {
+	case SubjectEndUser:
+		switch {
+		case call.UserID != "":
+			return "user:" + call.UserID, true
+		case call.ExternalUserID != "":
+			return "external-user:" + call.ExternalUserID, true
+		default:
+			return "", false
+		}
+	case SubjectAPIKey:
+		if call.APIKeyID == "" {
+			return "", false
+		}
+		return "api-key:" + call.APIKeyID, true
+	case SubjectAll:
+		return "all", true
+	default:
+		return "", false
+	}
+}
+
+func (e *Enforcer) recordRejection(ctx context.Context, limit repo.ToolCallRateLimit, call Call, exceeded *ExceededError) {
+	target := "mcp_server"
+	if limit.ToolsetID.Valid {
+		target = "toolset"
+	}
+
+	if e.rejections != nil {
+		e.rejections.Add(ctx, 1, metric.WithAttributes(
+			attr.ToolRateLimitSubject(limit.Subject),
+			attr.ToolRateLimitTarget(target),
+		))
+	}
+
+	trace.SpanFromContext(ctx).AddEvent("tool_call.rate_limited", trace.WithAttributes(
+		attr.ToolRateLimitID(limit.ID.String()),
+		attr.ToolRateLimitSubject(limit.Subject),
+		attr.ToolRateLimitTarget(target),
+		attr.ToolName(call.ToolName),
+		attr.RetryWait(exceeded.RetryAfter),
+	))
+
+	e.logger.InfoContext(ctx, "tool call rejected by rate limit",
+		attr.SlogProjectID(call.ProjectID.String()),
+		attr.SlogToolName(call.ToolName),
+		attr.SlogToolRateLimitID(limit.ID.String()),
+		attr.SlogToolRateLimitSubject(limit.Subject),
+		attr.SlogToolRateLimitTarget(target),
+		attr.SlogRetryWait(exceeded.RetryAfter),
+	)
+}
@@ -0,0 +1,160 @@
+package toolratelimits_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_rate_limits"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
+)
+
+func TestEnforcer_PerEndUserBuckets(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	_, err := ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		Subject:          toolratelimits.SubjectEndUser,
+		ToolName:         nil,
+		Requests:         2,
+		IntervalSeconds:  3600,
+		Burst:            nil,
+	})
+	require.NoError(t, err)
+
+	call := func(userID string) toolratelimits.Call {
+		return toolratelimits.Call{
+			ProjectID:      *authCtx.ProjectID,
+			ToolsetID:      uuid.NullUUID{UUID: toolsetID, Valid: true},
+			McpServerID:    uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+			ToolName:       "echo",
+			UserID:         userID,
+			ExternalUserID: "",
+			APIKeyID:       "",
+		}
+	}
+
+	require.NoError(t, ti.enforcer.Check(ctx, call("alice")))
+	require.NoError(t, ti.enforcer.Check(ctx, call("alice")))
+
+	err = ti.enforcer.Check(ctx, call("alice"))
+	var exceeded *toolratelimits.ExceededError
+	require.ErrorAs(t, err, &exceeded)
+	require.Equal(t, 2, exceeded.Limit)
+	require.Positive(t, exceeded.RetryAfter)
+
+	require.NoError(t, ti.enforcer.Check(ctx, call("bob")), "other users have their own bucket")
+	require.NoError(t, ti.enforcer.Check(ctx, call("")), "anonymous calls are not attributable to an end user")
+}
+
+func TestEnforcer_ToolScopedLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	mcpServerID := seedMcpServer(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	_, err := ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        nil,
+		McpServerID:      new(mcpServerID.String()),
+		Subject:          toolratelimits.SubjectAll,
+		ToolName:         new("expensive"),
+		Requests:         1,
+		IntervalSeconds:  3600,
+		Burst:            nil,
+	})
+	require.NoError(t, err)
+
+	call := func(toolName string) toolratelimits.Call {
+		return toolratelimits.Call{
+			ProjectID:      *authCtx.ProjectID,
+			ToolsetID:      uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+			McpServerID:    uuid.NullUUID{UUID: mcpServerID, Valid: true},
+			ToolName:       toolName,
+			UserID:         "",
+			ExternalUserID: "",
+			APIKeyID:       "",
+		}
+	}
+
+	require.NoError(t, ti.enforcer.Check(ctx, call("expensive")))
+	require.Error(t, ti.enforcer.Check(ctx, call("expensive")))
+	require.NoError(t, ti.enforcer.Check(ctx, call("cheap")))
+	require.NoError(t, ti.enforcer.Check(ctx, call("cheap")))
+}
+
+func TestEnforcer_CreateEvictsCachedLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	call := toolratelimits.Call{
+		ProjectID:      *authCtx.ProjectID,
+		ToolsetID:      uuid.NullUUID{UUID: toolsetID, Valid: true},
+		McpServerID:    uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		ToolName:       "echo",
+		UserID:         "",
+		ExternalUserID: "",
+		APIKeyID:       "",
+	}
+
+	// Caches the toolset as having no limits.
+	require.NoError(t, ti.enforcer.Check(ctx, call))
+
+	_, err := ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		Subject:          toolratelimits.SubjectAll,
+		ToolName:         nil,
+		Requests:         1,
+		IntervalSeconds:  3600,
+		Burst:            nil,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ti.enforcer.Check(ctx, call))
+	var exceeded *toolratelimits.ExceededError
+	require.ErrorAs(t, ti.enforcer.Check(ctx, call), &exceeded, "a new limit applies without waiting for the cache to expire")
+}
+
+func TestEnforcer_NilAllowsEverything(t *testing.T) {
+	t.Parallel()
+
+	var enforcer *toolratelimits.Enforcer
+	require.NoError(t, enforcer.Check(t.Context(), toolratelimits.Call{
+		ProjectID:      uuid.New(),
+		ToolsetID:      uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		McpServerID:    uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		ToolName:       "echo",
+		UserID:         "alice",
+		ExternalUserID: "",
+		APIKeyID:       "",
+	}))
+}
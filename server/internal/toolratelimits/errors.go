@@ -0,0 +1,88 @@
+package toolratelimits
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/speakeasy-api/gram/server/internal/mcpjsonrpc"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/ratelimit"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits/repo"
+)
+
+// ExceededError reports a tools/call rejected by a tool-call rate limit. It
+// unwraps to an *oops.MCPError carrying retry hints in the JSON-RPC error's
+// data, so the hosted /mcp error path serializes it without special casing;
+// HTTP transports should additionally copy Headers onto the response.
+type ExceededError struct {
+	LimitID    uuid.UUID
+	Limit      int
+	Interval   time.Duration
+	Remaining  int
+	RetryAfter time.Duration
+	ResetAt    time.Time
+}
+
+func newExceededError(limit repo.ToolCallRateLimit, res ratelimit.Result, now time.Time) *ExceededError {
+	return &ExceededError{
+		LimitID:    limit.ID,
+		Limit:      int(limit.Requests),
+		Interval:   time.Duration(limit.IntervalSeconds) * time.Second,
+		Remaining:  res.Remaining,
+		RetryAfter: res.RetryAfter,
+		ResetAt:    now.Add(res.RetryAfter),
+	}
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("tool call rate limit %s exceeded: retry after %s", e.LimitID, e.RetryAfter)
+}
+
+// Message is the user-facing rejection summary.
+func (e *ExceededError) Message() string {
+	return fmt.Sprintf("tool call rate limit exceeded, retry after %d seconds", e.retryAfterSeconds())
+}
+
+// Data is the structured retry hint surfaced in the JSON-RPC error's data
+// field.
+func (e *ExceededError) Data() map[string]any {
+	return map[string]any{
+		"retry_after_seconds": e.retryAfterSeconds(),
+		"limit":               e.Limit,
+		"interval_seconds":    int(e.Interval / time.Second),
+		"remaining":           e.Remaining,
+		"reset_at":            e.ResetAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// Headers returns the rate-limit response headers for an HTTP rejection:
+// X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset (unix seconds)
+// and Retry-After (seconds).
+func (e *ExceededError) Headers() http.Header {
+	h := make(http.Header, 4)
+	h.Set("X-RateLimit-Limit", strconv.Itoa(e.Limit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(e.Remaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(e.ResetAt.Unix(), 10))
+	h.Set("Retry-After", strconv.FormatInt(e.retryAfterSeconds(), 10))
+	return h
+}
+
+func (e *ExceededError) Unwrap() error {
+	return &oops.MCPError{
+		ID:      mcpjsonrpc.ID{}, // unset: NewMCPErrorFromCause stamps the request id
+		Code:    oops.MCPCodeRateLimited,
+		Message: e.Message(),
+		Data:    e.Data(),
+	}
+}
+
+// retryAfterSeconds rounds RetryAfter up to whole seconds, never below one,
+// so a client honoring it does not retry into the same empty bucket.
+func (e *ExceededError) retryAfterSeconds() int64 {
+	return max(int64(math.Ceil(e.RetryAfter.Seconds())), 1)
+}
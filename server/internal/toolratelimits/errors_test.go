@@ -0,0 +1,58 @@
+package toolratelimits_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
+)
+
+func TestExceededError_RetryHints(t *testing.T) {
+	t.Parallel()
+
+	resetAt := time.Unix(1_800_000_000, 0)
+	exceeded := &toolratelimits.ExceededError{
+		LimitID:    uuid.New(),
+		Limit:      10,
+		Interval:   time.Minute,
+		Remaining:  0,
+		RetryAfter: 2500 * time.Millisecond,
+		ResetAt:    resetAt,
+	}
+
+	headers := exceeded.Headers()
+	require.Equal(t, "10", headers.Get("X-RateLimit-Limit"))
+	require.Equal(t, "0", headers.Get("X-RateLimit-Remaining"))
+	require.Equal(t, "1800000000", headers.Get("X-RateLimit-Reset"))
+	require.Equal(t, "3", headers.Get("Retry-After"), "retry-after rounds up to whole seconds")
+
+	data := exceeded.Data()
+	require.Equal(t, int64(3), data["retry_after_seconds"])
+	require.Equal(t, 60, data["interval_seconds"])
+
+	mcpErr, ok := errors.AsType[*oops.MCPError](exceeded)
+	require.True(t, ok)
+	require.Equal(t, oops.MCPCodeRateLimited, mcpErr.Code)
+	require.Equal(t, "tool call rate limit exceeded, retry after 3 seconds", mcpErr.Message)
+	require.Equal(t, data, mcpErr.Data)
+}
+
+func TestExceededError_RetryAfterAtLeastOneSecond(t *testing.T) {
+	t.Parallel()
+
+	exceeded := &toolratelimits.ExceededError{
+		LimitID:    uuid.New(),
+		Limit:      1,
+		Interval:   time.Second,
+		Remaining:  0,
+		RetryAfter: 0,
+		ResetAt:    time.Unix(0, 0),
+	}
+
+	require.Equal(t, "1", exceeded.Headers().Get("Retry-After"))
+}
@@ -0,0 +1,385 @@
+package toolratelimits
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+	goahttp "goa.design/goa/v3/http"
+	"goa.design/goa/v3/security"
+
+	srv "github.com/speakeasy-api/gram/server/gen/http/tool_rate_limits/server"
+	gen "github.com/speakeasy-api/gram/server/gen/tool_rate_limits"
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/auth"
+	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/middleware"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits/repo"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+type Service struct {
+	tracer trace.Tracer
+	logger *slog.Logger
+	db     *pgxpool.Pool
+	limits *LimitCache
+	auth   *auth.Auth
+	authz  *authz.Engine
+	audit  *audit.Logger
+}
+
+var _ gen.Service = (*Service)(nil)
+var _ gen.Auther = (*Service)(nil)
+
+func NewService(
+	logger *slog.Logger,
+	tracerProvider trace.TracerProvider,
+	db *pgxpool.Pool,
+	sessions *sessions.Manager,
+	authzEngine *authz.Engine,
+	auditLogger *audit.Logger,
+	limits *LimitCache,
+) *Service {
+	logger = logger.With(attr.SlogComponent("toolratelimits"))
+
+	return &Service{
+		tracer: tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/toolratelimits"),
+		logger: logger,
+		db:     db,
+		limits: limits,
+		auth:   auth.New(logger, db, sessions, authzEngine),
+		authz:  authzEngine,
+		audit:  auditLogger,
+	}
+}
+
+func Attach(mux goahttp.Muxer, service *Service) {
+	endpoints := gen.NewEndpoints(service)
+	endpoints.Use(middleware.MapErrors())
+	endpoints.Use(middleware.TraceMethods(service.tracer))
+	srv.Mount(
+		mux,
+		srv.New(endpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil),
+	)
+}
+
+func (s *Service) APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error) {
+	return s.auth.Authorize(ctx, key, schema)
+}
+
+func (s *Service) CreateToolRateLimit(ctx context.Context, payload *gen.CreateToolRateLimitPayload) (*types.ToolRateLimit, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	toolsetID, err := conv.PtrToNullUUID(payload.ToolsetID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid toolset_id").LogError(ctx, logger)
+	}
+	mcpServerID, err := conv.PtrToNullUUID(payload.McpServerID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid mcp_server_id").LogError(ctx, logger)
+	}
+	if toolsetID.Valid == mcpServerID.Valid {
+		return nil, oops.E(oops.CodeInvalid, nil, "exactly one of toolset_id or mcp_server_id is required").LogError(ctx, logger)
+	}
+
+	burst := payload.Requests
+	if payload.Burst != nil {
+		burst = *payload.Burst
+	}
+
+	if err := s.verifyTarget(ctx, *authCtx.ProjectID, toolsetID, mcpServerID); err != nil {
+		return nil, err
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	created, err := repo.New(dbtx).CreateToolCallRateLimit(ctx, repo.CreateToolCallRateLimitParams{
+		ProjectID:       *authCtx.ProjectID,
+		ToolsetID:       toolsetID,
+		McpServerID:     mcpServerID,
+		Subject:         payload.Subject,
+		ToolName:        conv.PtrToPGText(payload.ToolName),
+		Requests:        payload.Requests,
+		IntervalSeconds: payload.IntervalSeconds,
+		Burst:           burst,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "create tool rate limit").LogError(ctx, logger)
+	}
+
+	view := toView(created)
+
+	if err := s.audit.LogToolRateLimitCreate(ctx, dbtx, audit.LogToolRateLimitCreateEvent{
+		OrganizationID:        authCtx.ActiveOrganizationID,
+		ProjectID:             *authCtx.ProjectID,
+		Actor:                 urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:      authCtx.Email,
+		ActorSlug:             nil,
+		ToolRateLimitURN:      urn.NewToolRateLimit(created.ID),
+		ToolRateLimitSnapshot: view,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log tool rate limit creation").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidateLimits(ctx, created, logger)
+
+	return view, nil
+}
+
+func (s *Service) ListToolRateLimits(ctx context.Context, payload *gen.ListToolRateLimitsPayload) (*gen.ListToolRateLimitsResult, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPRead, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	toolsetID, err := conv.PtrToNullUUID(payload.ToolsetID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid toolset_id").LogError(ctx, logger)
+	}
+	mcpServerID, err := conv.PtrToNullUUID(payload.McpServerID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid mcp_server_id").LogError(ctx, logger)
+	}
+
+	rows, err := repo.New(s.db).ListToolCallRateLimits(ctx, repo.ListToolCallRateLimitsParams{
+		ProjectID:   *authCtx.ProjectID,
+		ToolsetID:   toolsetID,
+		McpServerID: mcpServerID,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "list tool rate limits").LogError(ctx, logger)
+	}
+
+	limits := make([]*types.ToolRateLimit, 0, len(rows))
+	for _, row := range rows {
+		limits = append(limits, toView(row))
+	}
+
+	return &gen.ListToolRateLimitsResult{RateLimits: limits}, nil
+}
+
+func (s *Service) UpdateToolRateLimit(ctx context.Context, payload *gen.UpdateToolRateLimitPayload) (*types.ToolRateLimit, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	limitID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid tool rate limit id").LogError(ctx, logger)
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	txRepo := repo.New(dbtx)
+
+	existing, err := txRepo.GetToolCallRateLimit(ctx, repo.GetToolCallRateLimitParams{
+		ID:        limitID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.E(oops.CodeNotFound, err, "tool rate limit not found").LogError(ctx, logger)
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "get tool rate limit").LogError(ctx, logger)
+	}
+
+	updated, err := txRepo.UpdateToolCallRateLimit(ctx, repo.UpdateToolCallRateLimitParams{
+		Requests:        conv.PtrToPGInt4(payload.Requests),
+		IntervalSeconds: conv.PtrToPGInt4(payload.IntervalSeconds),
+		Burst:           conv.PtrToPGInt4(payload.Burst),
+		ID:              limitID,
+		ProjectID:       *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.E(oops.CodeNotFound, err, "tool rate limit not found").LogError(ctx, logger)
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "update tool rate limit").LogError(ctx, logger)
+	}
+
+	view := toView(updated)
+
+	if err := s.audit.LogToolRateLimitUpdate(ctx, dbtx, audit.LogToolRateLimitUpdateEvent{
+		OrganizationID:              authCtx.ActiveOrganizationID,
+		ProjectID:                   *authCtx.ProjectID,
+		Actor:                       urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:            authCtx.Email,
+		ActorSlug:                   nil,
+		ToolRateLimitURN:            urn.NewToolRateLimit(updated.ID),
+		ToolRateLimitSnapshotBefore: toView(existing),
+		ToolRateLimitSnapshotAfter:  view,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log tool rate limit update").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidateLimits(ctx, updated, logger)
+
+	return view, nil
+}
+
+func (s *Service) DeleteToolRateLimit(ctx context.Context, payload *gen.DeleteToolRateLimitPayload) error {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return oops.C(oops.CodeUnauthorized)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, authCtx.ProjectID.String(), authCtx.ProjectID.String())); err != nil {
+		return err
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()))
+
+	limitID, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid tool rate limit id").LogError(ctx, logger)
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	deleted, err := repo.New(dbtx).DeleteToolCallRateLimit(ctx, repo.DeleteToolCallRateLimitParams{
+		ID:        limitID,
+		ProjectID: *authCtx.ProjectID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return oops.E(oops.CodeNotFound, err, "tool rate limit not found").LogError(ctx, logger)
+		}
+		return oops.E(oops.CodeUnexpected, err, "delete tool rate limit").LogError(ctx, logger)
+	}
+
+	if err := s.audit.LogToolRateLimitDelete(ctx, dbtx, audit.LogToolRateLimitDeleteEvent{
+		OrganizationID:   authCtx.ActiveOrganizationID,
+		ProjectID:        *authCtx.ProjectID,
+		Actor:            urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName: authCtx.Email,
+		ActorSlug:        nil,
+		ToolRateLimitURN: urn.NewToolRateLimit(deleted.ID),
+	}); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "log tool rate limit deletion").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	s.invalidateLimits(ctx, deleted, logger)
+
+	return nil
+}
+
+// invalidateLimits evicts the cached limits of a written limit's target. It is
+// best effort: a failed eviction is logged and the change takes effect once
+// the cache entry expires.
+func (s *Service) invalidateLimits(ctx context.Context, limit repo.ToolCallRateLimit, logger *slog.Logger) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+
+	if err := s.limits.Invalidate(ctx, limit.ToolsetID, limit.McpServerID); err != nil {
+		logger.WarnContext(ctx, "invalidate tool call rate limits", attr.SlogError(err))
+	}
+}
+
+// verifyTarget checks that the toolset or MCP server a limit attaches to is a
+// live resource in the caller's project. Returned errors are fully formed oops
+// errors, already logged.
+func (s *Service) verifyTarget(ctx context.Context, projectID uuid.UUID, toolsetID uuid.NullUUID, mcpServerID uuid.NullUUID) error {
+	logger := s.logger.With(attr.SlogProjectID(projectID.String()))
+
+	if toolsetID.Valid {
+		exists, err := repo.New(s.db).ToolsetExistsInProject(ctx, repo.ToolsetExistsInProjectParams{
+			ID:        toolsetID.UUID,
+			ProjectID: projectID,
+		})
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "check toolset").LogError(ctx, logger)
+		}
+		if !exists {
+			return oops.E(oops.CodeInvalid, nil, "toolset_id does not reference a toolset in this project").LogError(ctx, logger)
+		}
+	}
+
+	if mcpServerID.Valid {
+		exists, err := repo.New(s.db).MCPServerExistsInProject(ctx, repo.MCPServerExistsInProjectParams{
+			ID:        mcpServerID.UUID,
+			ProjectID: projectID,
+		})
+		if err != nil {
+			return oops.E(oops.CodeUnexpected, err, "check mcp server").LogError(ctx, logger)
+		}
+		if !exists {
+			return oops.E(oops.CodeInvalid, nil, "mcp_server_id does not reference an MCP server in this project").LogError(ctx, logger)
+		}
+	}
+
+	return nil
+}
+
+func toView(row repo.ToolCallRateLimit) *types.ToolRateLimit {
+	return &types.ToolRateLimit{
+		ID:              row.ID.String(),
+		ProjectID:       row.ProjectID.String(),
+		ToolsetID:       conv.FromNullableUUID(row.ToolsetID),
+		McpServerID:     conv.FromNullableUUID(row.McpServerID),
+		Subject:         row.Subject,
+		ToolName:        conv.FromPGText[string](row.ToolName),
+		Requests:        row.Requests,
+		IntervalSeconds: row.IntervalSeconds,
+		Burst:           row.Burst,
+		CreatedAt:       row.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:       row.UpdatedAt.Time.Format(time.RFC3339),
+	}
+}
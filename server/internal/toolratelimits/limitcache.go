@@ -0,0 +1,35 @@
+package toolratelimits
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits/repo"
+)
+
+// LimitCache serves the limits attached to a toolset or MCP server without a
+// database query per tools/call. One instance is shared by the Enforcer, which
+// reads it, and the Service, which evicts it on every write.
+type LimitCache = cache.TargetCache[repo.ToolCallRateLimit]
+
+// NewLimitCache builds the cache over the given database and cache backends.
+func NewLimitCache(logger *slog.Logger, db *pgxpool.Pool, c cache.Cache) *LimitCache {
+	logger = logger.With(attr.SlogComponent("toolratelimits-cache"))
+	return cache.NewTargetCache(logger, c, "toolratelimits", func(ctx context.Context, projectID uuid.UUID, toolsetID uuid.NullUUID, mcpServerID uuid.NullUUID) ([]repo.ToolCallRateLimit, error) {
+		limits, err := repo.New(db).ListToolCallRateLimitsForTarget(ctx, repo.ListToolCallRateLimitsForTargetParams{
+			ProjectID:   projectID,
+			ToolsetID:   toolsetID,
+			McpServerID: mcpServerID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list tool call rate limits: %w", err)
+		}
+		return limits, nil
+	})
+}
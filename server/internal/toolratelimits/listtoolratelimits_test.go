@@ -0,0 +1,73 @@
+package toolratelimits_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_rate_limits"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
+)
+
+func TestListToolRateLimits_FiltersByTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+	mcpServerID := seedMcpServer(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	onToolset, err := ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		Subject:          toolratelimits.SubjectAll,
+		ToolName:         nil,
+		Requests:         5,
+		IntervalSeconds:  60,
+		Burst:            nil,
+	})
+	require.NoError(t, err)
+
+	onServer, err := ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        nil,
+		McpServerID:      new(mcpServerID.String()),
+		Subject:          toolratelimits.SubjectEndUser,
+		ToolName:         nil,
+		Requests:         5,
+		IntervalSeconds:  60,
+		Burst:            nil,
+	})
+	require.NoError(t, err)
+
+	all, err := ti.service.ListToolRateLimits(ctx, &gen.ListToolRateLimitsPayload{
+		ToolsetID:        nil,
+		McpServerID:      nil,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Len(t, all.RateLimits, 2)
+
+	filtered, err := ti.service.ListToolRateLimits(ctx, &gen.ListToolRateLimitsPayload{
+		ToolsetID:        nil,
+		McpServerID:      new(mcpServerID.String()),
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Len(t, filtered.RateLimits, 1)
+	require.Equal(t, onServer.ID, filtered.RateLimits[0].ID)
+	require.NotEqual(t, onToolset.ID, filtered.RateLimits[0].ID)
+}
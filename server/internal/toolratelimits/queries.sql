@@ -0,0 +1,88 @@
+-- name: CreateToolCallRateLimit :one
+INSERT INTO tool_call_rate_limits (
+  project_id,
+  toolset_id,
+  mcp_server_id,
+  subject,
+  tool_name,
+  requests,
+  interval_seconds,
+  burst
+) VALUES (
+  @project_id,
+  @toolset_id,
+  @mcp_server_id,
+  @subject,
+  @tool_name,
+  @requests,
+  @interval_seconds,
+  @burst
+)
+RETURNING *;
+
+-- name: GetToolCallRateLimit :one
+SELECT *
+FROM tool_call_rate_limits
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE;
+
+-- name: ListToolCallRateLimits :many
+SELECT *
+FROM tool_call_rate_limits
+WHERE project_id = @project_id
+  AND deleted IS FALSE
+  AND (sqlc.narg(toolset_id)::uuid IS NULL OR toolset_id = sqlc.narg(toolset_id)::uuid)
+  AND (sqlc.narg(mcp_server_id)::uuid IS NULL OR mcp_server_id = sqlc.narg(mcp_server_id)::uuid)
+ORDER BY id DESC;
+
+-- name: ListToolCallRateLimitsForTarget :many
+-- Returns the live limits attached to one toolset or MCP server, across all
+-- of its tools. Enforcement caches them per target and narrows them to the
+-- called tool in memory.
+SELECT *
+FROM tool_call_rate_limits
+WHERE project_id = @project_id
+  AND deleted IS FALSE
+  AND (toolset_id = sqlc.narg(toolset_id)::uuid OR mcp_server_id = sqlc.narg(mcp_server_id)::uuid)
+ORDER BY id ASC;
+
+-- name: UpdateToolCallRateLimit :one
+UPDATE tool_call_rate_limits
+SET
+  requests = COALESCE(sqlc.narg(requests)::integer, requests),
+  interval_seconds = COALESCE(sqlc.narg(interval_seconds)::integer, interval_seconds),
+  burst = COALESCE(sqlc.narg(burst)::integer, burst),
+  updated_at = clock_timestamp()
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE
+RETURNING *;
+
+-- name: DeleteToolCallRateLimit :one
+UPDATE tool_call_rate_limits
+SET
+  deleted_at = clock_timestamp(),
+  updated_at = clock_timestamp()
+WHERE id = @id
+  AND project_id = @project_id
+  AND deleted IS FALSE
+RETURNING *;
+
+-- name: ToolsetExistsInProject :one
+SELECT EXISTS (
+  SELECT 1
+  FROM toolsets
+  WHERE id = @id
+    AND project_id = @project_id
+    AND deleted IS FALSE
+);
+
+-- name: MCPServerExistsInProject :one
+SELECT EXISTS (
+  SELECT 1
+  FROM mcp_servers
+  WHERE id = @id
+    AND project_id = @project_id
+    AND deleted IS FALSE
+);
@@ -0,0 +1,78 @@
+package toolratelimits_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/tool_rate_limits"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/audit/audittest"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolratelimits"
+)
+
+func TestUpdateToolRateLimit_PartialUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	toolsetID := seedToolset(t, ctx, ti.conn, authCtx.ActiveOrganizationID, *authCtx.ProjectID)
+
+	created, err := ti.service.CreateToolRateLimit(ctx, &gen.CreateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ToolsetID:        new(toolsetID.String()),
+		McpServerID:      nil,
+		Subject:          toolratelimits.SubjectEndUser,
+		ToolName:         nil,
+		Requests:         10,
+		IntervalSeconds:  60,
+		Burst:            nil,
+	})
+	require.NoError(t, err)
+
+	beforeCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolRateLimitUpdate)
+	require.NoError(t, err)
+
+	updated, err := ti.service.UpdateToolRateLimit(ctx, &gen.UpdateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ID:               created.ID,
+		Requests:         nil,
+		IntervalSeconds:  nil,
+		Burst:            new(int32(3)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(10), updated.Requests)
+	require.Equal(t, int32(60), updated.IntervalSeconds)
+	require.Equal(t, int32(3), updated.Burst)
+
+	afterCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolRateLimitUpdate)
+	require.NoError(t, err)
+	require.Equal(t, beforeCount+1, afterCount)
+}
+
+func TestUpdateToolRateLimit_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	_, err := ti.service.UpdateToolRateLimit(ctx, &gen.UpdateToolRateLimitPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+		ID:               uuid.NewString(),
+		Requests:         new(int32(1)),
+		IntervalSeconds:  nil,
+		Burst:            nil,
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
@@ -73,6 +73,7 @@ WITH deployment AS (
     JOIN deployment_statuses ds ON d.id = ds.deployment_id
     WHERE d.project_id = @project_id
     AND ds.status = 'completed'
+    AND (sqlc.narg(deployment_id)::uuid IS NULL OR d.id = sqlc.narg(deployment_id)::uuid)
     ORDER BY d.seq DESC
     LIMIT 1
 ),
@@ -107,6 +108,7 @@ WITH deployment AS (
     JOIN deployment_statuses ds ON d.id = ds.deployment_id
     WHERE d.project_id = @project_id
     AND ds.status = 'completed'
+    AND (sqlc.narg(deployment_id)::uuid IS NULL OR d.id = sqlc.narg(deployment_id)::uuid)
     ORDER BY d.seq DESC
     LIMIT 1
 ),
@@ -128,16 +130,24 @@ WHERE
 ORDER BY htd.id DESC;
 
 -- name: GetHTTPToolDefinitionByURN :one
+-- Returns the tool together with the ordered failover candidates recorded for
+-- its server. Servers listing a single URL have no candidates row.
 WITH deployment AS (
   SELECT d.id 
   FROM deployments d
   JOIN deployment_statuses ds ON d.id = ds.deployment_id
   WHERE d.project_id = @project_id
   AND ds.status = 'completed'
+  AND (sqlc.narg(deployment_id)::uuid IS NULL OR d.id = sqlc.narg(deployment_id)::uuid)
   ORDER BY d.seq DESC LIMIT 1
 )
-SELECT *
+SELECT
+  sqlc.embed(http_tool_definitions),
+  hsc.server_urls AS server_candidates
 FROM http_tool_definitions
+LEFT JOIN http_server_candidates hsc
+  ON hsc.deployment_id = http_tool_definitions.deployment_id
+  AND hsc.server_env_var = http_tool_definitions.server_env_var
 WHERE http_tool_definitions.tool_urn = @urn
   AND http_tool_definitions.project_id = @project_id
   AND http_tool_definitions.deleted IS FALSE 
@@ -198,6 +208,7 @@ WITH deployment AS (
     JOIN deployment_statuses ds ON d.id = ds.deployment_id
     WHERE d.project_id = @project_id
     AND ds.status = 'completed'
+    AND (sqlc.narg(deployment_id)::uuid IS NULL OR d.id = sqlc.narg(deployment_id)::uuid)
     ORDER BY d.seq DESC
     LIMIT 1
 )
@@ -220,6 +231,7 @@ WITH deployment AS (
     JOIN deployment_statuses ds ON d.id = ds.deployment_id
     WHERE d.project_id = @project_id
     AND ds.status = 'completed'
+    AND (sqlc.narg(deployment_id)::uuid IS NULL OR d.id = sqlc.narg(deployment_id)::uuid)
     ORDER BY d.seq DESC
     LIMIT 1
 )
@@ -240,6 +252,7 @@ WITH deployment AS (
   JOIN deployment_statuses ds ON d.id = ds.deployment_id
   WHERE d.project_id = @project_id
   AND ds.status = 'completed'
+  AND (sqlc.narg(deployment_id)::uuid IS NULL OR d.id = sqlc.narg(deployment_id)::uuid)
   ORDER BY d.seq DESC LIMIT 1
 )
 SELECT
@@ -122,6 +122,7 @@ WITH deployment AS (
     JOIN deployment_statuses ds ON d.id = ds.deployment_id
     WHERE d.project_id = $2
     AND ds.status = 'completed'
+    AND ($3::uuid IS NULL OR d.id = $3::uuid)
     ORDER BY d.seq DESC
     LIMIT 1
 )
@@ -137,8 +138,9 @@ ORDER BY ftd.id DESC
 `
 
 type FindFunctionToolEntriesByUrnParams struct {
-	Urns      []string
-	ProjectID uuid.UUID
+	Urns         []string
+	ProjectID    uuid.UUID
+	DeploymentID uuid.NullUUID
 }
 
 type FindFunctionToolEntriesByUrnRow struct {
@@ -155,7 +157,7 @@ type FindFunctionToolEntriesByUrnRow struct {
 }
 
 func (q *Queries) FindFunctionToolEntriesByUrn(ctx context.Context, arg FindFunctionToolEntriesByUrnParams) ([]FindFunctionToolEntriesByUrnRow, error) {
-	rows, err := q.db.Query(ctx, findFunctionToolEntriesByUrn, arg.Urns, arg.ProjectID)
+	rows, err := q.db.Query(ctx, findFunctionToolEntriesByUrn, arg.Urns, arg.ProjectID, arg.DeploymentID)
 	if err != nil {
 		return nil, err
 	}
@@ -256,6 +258,7 @@ WITH deployment AS (
     JOIN deployment_statuses ds ON d.id = ds.deployment_id
     WHERE d.project_id = $2
     AND ds.status = 'completed'
+    AND ($3::uuid IS NULL OR d.id = $3::uuid)
     ORDER BY d.seq DESC
     LIMIT 1
 )
@@ -273,8 +276,9 @@ ORDER BY ftd.id DESC
 `
 
 type FindFunctionToolsByUrnParams struct {
-	Urns      []string
-	ProjectID uuid.UUID
+	Urns         []string
+	ProjectID    uuid.UUID
+	DeploymentID uuid.NullUUID
 }
 
 type FindFunctionToolsByUrnRow struct {
@@ -284,7 +288,7 @@ type FindFunctionToolsByUrnRow struct {
 }
 
 func (q *Queries) FindFunctionToolsByUrn(ctx context.Context, arg FindFunctionToolsByUrnParams) ([]FindFunctionToolsByUrnRow, error) {
-	rows, err := q.db.Query(ctx, findFunctionToolsByUrn, arg.Urns, arg.ProjectID)
+	rows, err := q.db.Query(ctx, findFunctionToolsByUrn, arg.Urns, arg.ProjectID, arg.DeploymentID)
 	if err != nil {
 		return nil, err
 	}
@@ -334,6 +338,7 @@ WITH deployment AS (
     JOIN deployment_statuses ds ON d.id = ds.deployment_id
     WHERE d.project_id = $2
     AND ds.status = 'completed'
+    AND ($3::uuid IS NULL OR d.id = $3::uuid)
     ORDER BY d.seq DESC
     LIMIT 1
 ),
@@ -356,8 +361,9 @@ ORDER BY htd.id DESC
 `
 
 type FindHttpToolEntriesByUrnParams struct {
-	Urns      []string
-	ProjectID uuid.UUID
+	Urns         []string
+	ProjectID    uuid.UUID
+	DeploymentID uuid.NullUUID
 }
 
 type FindHttpToolEntriesByUrnRow struct {
@@ -376,7 +382,7 @@ type FindHttpToolEntriesByUrnRow struct {
 }
 
 func (q *Queries) FindHttpToolEntriesByUrn(ctx context.Context, arg FindHttpToolEntriesByUrnParams) ([]FindHttpToolEntriesByUrnRow, error) {
-	rows, err := q.db.Query(ctx, findHttpToolEntriesByUrn, arg.Urns, arg.ProjectID)
+	rows, err := q.db.Query(ctx, findHttpToolEntriesByUrn, arg.Urns, arg.ProjectID, arg.DeploymentID)
 	if err != nil {
 		return nil, err
 	}
@@ -492,6 +498,7 @@ WITH deployment AS (
     JOIN deployment_statuses ds ON d.id = ds.deployment_id
     WHERE d.project_id = $1
     AND ds.status = 'completed'
+    AND ($3::uuid IS NULL OR d.id = $3::uuid)
     ORDER BY d.seq DESC
     LIMIT 1
 ),
@@ -521,8 +528,9 @@ ORDER BY http_tool_definitions.id DESC
 `
 
 type FindHttpToolsByUrnParams struct {
-	ProjectID uuid.UUID
-	Urns      []string
+	ProjectID    uuid.UUID
+	Urns         []string
+	DeploymentID uuid.NullUUID
 }
 
 type FindHttpToolsByUrnRow struct {
@@ -533,7 +541,7 @@ type FindHttpToolsByUrnRow struct {
 }
 
 func (q *Queries) FindHttpToolsByUrn(ctx context.Context, arg FindHttpToolsByUrnParams) ([]FindHttpToolsByUrnRow, error) {
-	rows, err := q.db.Query(ctx, findHttpToolsByUrn, arg.ProjectID, arg.Urns)
+	rows, err := q.db.Query(ctx, findHttpToolsByUrn, arg.ProjectID, arg.Urns, arg.DeploymentID)
 	if err != nil {
 		return nil, err
 	}
@@ -601,6 +609,7 @@ WITH deployment AS (
   JOIN deployment_statuses ds ON d.id = ds.deployment_id
   WHERE d.project_id = $2
   AND ds.status = 'completed'
+  AND ($3::uuid IS NULL OR d.id = $3::uuid)
   ORDER BY d.seq DESC LIMIT 1
 )
 SELECT
@@ -634,8 +643,9 @@ LIMIT 1
 `
 
 type GetFunctionToolByURNParams struct {
-	Urn       urn.Tool
-	ProjectID uuid.UUID
+	Urn          urn.Tool
+	ProjectID    uuid.UUID
+	DeploymentID uuid.NullUUID
 }
 
 type GetFunctionToolByURNRow struct {
@@ -656,7 +666,7 @@ type GetFunctionToolByURNRow struct {
 }
 
 func (q *Queries) GetFunctionToolByURN(ctx context.Context, arg GetFunctionToolByURNParams) (GetFunctionToolByURNRow, error) {
-	row := q.db.QueryRow(ctx, getFunctionToolByURN, arg.Urn, arg.ProjectID)
+	row := q.db.QueryRow(ctx, getFunctionToolByURN, arg.Urn, arg.ProjectID, arg.DeploymentID)
 	var i GetFunctionToolByURNRow
 	err := row.Scan(
 		&i.ID,
@@ -684,10 +694,16 @@ WITH deployment AS (
   JOIN deployment_statuses ds ON d.id = ds.deployment_id
   WHERE d.project_id = $2
   AND ds.status = 'completed'
+  AND ($3::uuid IS NULL OR d.id = $3::uuid)
   ORDER BY d.seq DESC LIMIT 1
 )
-SELECT id, tool_urn, project_id, deployment_id, openapiv3_document_id, confirm, confirm_prompt, summarizer, name, untruncated_name, summary, description, openapiv3_operation, tags, x_gram, original_name, original_summary, original_description, server_env_var, default_server_url, security, http_method, path, schema_version, schema, header_settings, query_settings, path_settings, request_content_type, response_filter, read_only_hint, destructive_hint, idempotent_hint, open_world_hint, created_at, updated_at, deleted_at, deleted
+SELECT
+  http_tool_definitions.id, http_tool_definitions.tool_urn, http_tool_definitions.project_id, http_tool_definitions.deployment_id, http_tool_definitions.openapiv3_document_id, http_tool_definitions.confirm, http_tool_definitions.confirm_prompt, http_tool_definitions.summarizer, http_tool_definitions.name, http_tool_definitions.untruncated_name, http_tool_definitions.summary, http_tool_definitions.description, http_tool_definitions.openapiv3_operation, http_tool_definitions.tags, http_tool_definitions.x_gram, http_tool_definitions.original_name, http_tool_definitions.original_summary, http_tool_definitions.original_description, http_tool_definitions.server_env_var, http_tool_definitions.default_server_url, http_tool_definitions.security, http_tool_definitions.http_method, http_tool_definitions.path, http_tool_definitions.schema_version, http_tool_definitions.schema, http_tool_definitions.header_settings, http_tool_definitions.query_settings, http_tool_definitions.path_settings, http_tool_definitions.request_content_type, http_tool_definitions.response_filter, http_tool_definitions.read_only_hint, http_tool_definitions.destructive_hint, http_tool_definitions.idempotent_hint, http_tool_definitions.open_world_hint, http_tool_definitions.created_at, http_tool_definitions.updated_at, http_tool_definitions.deleted_at, http_tool_definitions.deleted,
+  hsc.server_urls AS server_candidates
 FROM http_tool_definitions
+LEFT JOIN http_server_candidates hsc
+  ON hsc.deployment_id = http_tool_definitions.deployment_id
+  AND hsc.server_env_var = http_tool_definitions.server_env_var
 WHERE http_tool_definitions.tool_urn = $1
   AND http_tool_definitions.project_id = $2
   AND http_tool_definitions.deleted IS FALSE 
@@ -696,52 +712,61 @@ LIMIT 1
 `
 
 type GetHTTPToolDefinitionByURNParams struct {
-	Urn       urn.Tool
-	ProjectID uuid.UUID
+	Urn          urn.Tool
+	ProjectID    uuid.UUID
+	DeploymentID uuid.NullUUID
+}
+
+type GetHTTPToolDefinitionByURNRow struct {
+	HttpToolDefinition HttpToolDefinition
+	ServerCandidates   []string
 }
 
-func (q *Queries) GetHTTPToolDefinitionByURN(ctx context.Context, arg GetHTTPToolDefinitionByURNParams) (HttpToolDefinition, error) {
-	row := q.db.QueryRow(ctx, getHTTPToolDefinitionByURN, arg.Urn, arg.ProjectID)
-	var i HttpToolDefinition
+// Returns the tool together with the ordered failover candidates recorded for
+// its server. Servers listing a single URL have no candidates row.
+func (q *Queries) GetHTTPToolDefinitionByURN(ctx context.Context, arg GetHTTPToolDefinitionByURNParams) (GetHTTPToolDefinitionByURNRow, error) {
+	row := q.db.QueryRow(ctx, getHTTPToolDefinitionByURN, arg.Urn, arg.ProjectID, arg.DeploymentID)
+	var i GetHTTPToolDefinitionByURNRow
 	err := row.Scan(
-		&i.ID,
-		&i.ToolUrn,
-		&i.ProjectID,
-		&i.DeploymentID,
-		&i.Openapiv3DocumentID,
-		&i.Confirm,
-		&i.ConfirmPrompt,
-		&i.Summarizer,
-		&i.Name,
-		&i.UntruncatedName,
-		&i.Summary,
-		&i.Description,
-		&i.Openapiv3Operation,
-		&i.Tags,
-		&i.XGram,
-		&i.OriginalName,
-		&i.OriginalSummary,
-		&i.OriginalDescription,
-		&i.ServerEnvVar,
-		&i.DefaultServerUrl,
-		&i.Security,
-		&i.HttpMethod,
-		&i.Path,
-		&i.SchemaVersion,
-		&i.Schema,
-		&i.HeaderSettings,
-		&i.QuerySettings,
-		&i.PathSettings,
-		&i.RequestContentType,
-		&i.ResponseFilter,
-		&i.ReadOnlyHint,
-		&i.DestructiveHint,
-		&i.IdempotentHint,
-		&i.OpenWorldHint,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.DeletedAt,
-		&i.Deleted,
+		&i.HttpToolDefinition.ID,
+		&i.HttpToolDefinition.ToolUrn,
+		&i.HttpToolDefinition.ProjectID,
+		&i.HttpToolDefinition.DeploymentID,
+		&i.HttpToolDefinition.Openapiv3DocumentID,
+		&i.HttpToolDefinition.Confirm,
+		&i.HttpToolDefinition.ConfirmPrompt,
+		&i.HttpToolDefinition.Summarizer,
+		&i.HttpToolDefinition.Name,
+		&i.HttpToolDefinition.UntruncatedName,
+		&i.HttpToolDefinition.Summary,
+		&i.HttpToolDefinition.Description,
+		&i.HttpToolDefinition.Openapiv3Operation,
+		&i.HttpToolDefinition.Tags,
+		&i.HttpToolDefinition.XGram,
+		&i.HttpToolDefinition.OriginalName,
+		&i.HttpToolDefinition.OriginalSummary,
+		&i.HttpToolDefinition.OriginalDescription,
+		&i.HttpToolDefinition.ServerEnvVar,
+		&i.HttpToolDefinition.DefaultServerUrl,
+		&i.HttpToolDefinition.Security,
+		&i.HttpToolDefinition.HttpMethod,
+		&i.HttpToolDefinition.Path,
+		&i.HttpToolDefinition.SchemaVersion,
+		&i.HttpToolDefinition.Schema,
+		&i.HttpToolDefinition.HeaderSettings,
+		&i.HttpToolDefinition.QuerySettings,
+		&i.HttpToolDefinition.PathSettings,
+		&i.HttpToolDefinition.RequestContentType,
+		&i.HttpToolDefinition.ResponseFilter,
+		&i.HttpToolDefinition.ReadOnlyHint,
+		&i.HttpToolDefinition.DestructiveHint,
+		&i.HttpToolDefinition.IdempotentHint,
+		&i.HttpToolDefinition.OpenWorldHint,
+		&i.HttpToolDefinition.CreatedAt,
+		&i.HttpToolDefinition.UpdatedAt,
+		&i.HttpToolDefinition.DeletedAt,
+		&i.HttpToolDefinition.Deleted,
+		&i.ServerCandidates,
 	)
 	return i, err
 }
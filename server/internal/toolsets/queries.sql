@@ -350,6 +350,13 @@ WHERE toolset_id = ANY(@toolset_ids::uuid[])
   AND deleted IS FALSE
 ORDER BY toolset_id, version DESC;
 
+-- name: GetToolsetVersion :one
+SELECT *
+FROM toolset_versions
+WHERE toolset_id = @toolset_id
+  AND version = @version
+  AND deleted IS FALSE;
+
 -- name: GetToolsetPromptTemplateNames :many
 SELECT tp.prompt_name
 FROM toolset_prompts tp
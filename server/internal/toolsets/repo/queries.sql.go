@@ -1057,6 +1057,37 @@ func (q *Queries) GetToolsetPromptTemplateNames(ctx context.Context, arg GetTool
 	return items, nil
 }
 
+const getToolsetVersion = `-- name: GetToolsetVersion :one
+SELECT id, toolset_id, version, tool_urns, resource_urns, predecessor_id, created_at, updated_at, deleted_at, deleted
+FROM toolset_versions
+WHERE toolset_id = $1
+  AND version = $2
+  AND deleted IS FALSE
+`
+
+type GetToolsetVersionParams struct {
+	ToolsetID uuid.UUID
+	Version   int64
+}
+
+func (q *Queries) GetToolsetVersion(ctx context.Context, arg GetToolsetVersionParams) (ToolsetVersion, error) {
+	row := q.db.QueryRow(ctx, getToolsetVersion, arg.ToolsetID, arg.Version)
+	var i ToolsetVersion
+	err := row.Scan(
+		&i.ID,
+		&i.ToolsetID,
+		&i.Version,
+		&i.ToolUrns,
+		&i.ResourceUrns,
+		&i.PredecessorID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Deleted,
+	)
+	return i, err
+}
+
 const getToolsetsByToolURN = `-- name: GetToolsetsByToolURN :many
 SELECT
     t.id, t.organization_id, t.project_id, t.name, t.slug, t.description, t.default_environment_slug, t.mcp_slug, t.mcp_is_public, t.mcp_enabled, t.tool_selection_mode, t.custom_domain_id, t.external_oauth_server_id, t.oauth_proxy_server_id, t.user_session_issuer_id, t.tool_variations_group_id, t.created_at, t.updated_at, t.deleted_at, t.deleted,
@@ -10,6 +10,8 @@ import (
 
 	"github.com/ettle/strcase"
 	"github.com/google/uuid"
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/canary"
 	"github.com/speakeasy-api/gram/server/internal/conv"
 	deploymentsRepo "github.com/speakeasy-api/gram/server/internal/deployments/repo"
 	externalmcpRepo "github.com/speakeasy-api/gram/server/internal/externalmcp/repo"
@@ -20,10 +22,12 @@ import (
 	projectsRepo "github.com/speakeasy-api/gram/server/internal/projects/repo"
 	resourcesRepo "github.com/speakeasy-api/gram/server/internal/resources/repo"
 	templatesRepo "github.com/speakeasy-api/gram/server/internal/templates/repo"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
 	toolsRepo "github.com/speakeasy-api/gram/server/internal/tools/repo"
 	"github.com/speakeasy-api/gram/server/internal/tools/security"
 	"github.com/speakeasy-api/gram/server/internal/toolsets/repo"
 	"github.com/speakeasy-api/gram/server/internal/urn"
+	variationsRepo "github.com/speakeasy-api/gram/server/internal/variations/repo"
 )
 
 // Shared service for aggregating toolset details
@@ -35,6 +39,7 @@ type Toolsets struct {
 	projects        *projectsRepo.Queries
 	externalmcpRepo *externalmcpRepo.Queries
 	deploymentsRepo *deploymentsRepo.Queries
+	variationsRepo  *variationsRepo.Queries
 	platformExtras  []platformtools.ExternalTool
 }
 
@@ -47,26 +52,114 @@ func NewToolsets(tx repo.DBTX, platformExtras ...platformtools.ExternalTool) *To
 		projects:        projectsRepo.New(tx),
 		externalmcpRepo: externalmcpRepo.New(tx),
 		deploymentsRepo: deploymentsRepo.New(tx),
+		variationsRepo:  variationsRepo.New(tx),
 		platformExtras:  platformExtras,
 	}
 }
 
+// GetToolCallPlanByURN resolves the plan for calling a tool. The plan carries
+// the argument bindings of the project's default variation of the tool, so
+// every caller that executes it applies them; callers resolve them for the
+// calling principal, and for the toolset's own variations where it has them,
+// with gateway.ToolCallPlan.WithArgumentBindings.
 func (t *Toolsets) GetToolCallPlanByURN(ctx context.Context, toolUrn urn.Tool, projectID uuid.UUID) (*gateway.ToolCallPlan, error) {
+	plan, err := t.getToolCallPlanByURN(ctx, toolUrn, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := t.DefaultArgumentBindings(ctx, toolUrn, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if len(bindings) > 0 {
+		plan.ArgumentBindings = &gateway.ArgumentBindings{
+			Bindings:  bindings,
+			Principal: toolconfig.Principal{Email: "", UserID: "", ExternalUserID: "", OrganizationID: "", OrganizationSlug: ""},
+		}
+	}
+
+	return plan, nil
+}
+
+// DefaultArgumentBindings returns the argument bindings of the project's
+// default variation of a tool.
+func (t *Toolsets) DefaultArgumentBindings(ctx context.Context, toolUrn urn.Tool, projectID uuid.UUID) ([]gateway.ArgumentBinding, error) {
+	variations, err := t.variationsRepo.FindGlobalVariationsByToolURNs(ctx, variationsRepo.FindGlobalVariationsByToolURNsParams{
+		ProjectID: projectID,
+		ToolUrns:  []string{toolUrn.String()},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("find default tool variation: %w", err)
+	}
+	if len(variations) == 0 {
+		return nil, nil
+	}
+
+	bindings, err := conv.ArgumentBindingsFromJSON(variations[0].ArgumentBindings)
+	if err != nil {
+		return nil, fmt.Errorf("decode default tool variation argument bindings: %w", err)
+	}
+
+	return ArgumentBindings(bindings), nil
+}
+
+// ArgumentBindings converts the argument bindings of a tool variation to the
+// form the gateway applies.
+func ArgumentBindings(bindings []*types.ToolArgumentBinding) []gateway.ArgumentBinding {
+	converted := make([]gateway.ArgumentBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		if binding == nil {
+			continue
+		}
+		converted = append(converted, gateway.ArgumentBinding{
+			Argument: binding.Argument,
+			Source:   gateway.ArgumentBindingSource(binding.Source),
+			Value:    binding.Value,
+		})
+	}
+
+	return converted
+}
+
+// ToolArgumentBindings returns the argument bindings of the variation applied
+// to a tool listed in a toolset, which replace the project defaults the plan
+// resolver loaded. A tool without a variation binds nothing. It returns nil,
+// keeping the defaults, when tool is nil or not a materialized tool.
+func ToolArgumentBindings(tool *types.Tool) []gateway.ArgumentBinding {
+	if tool == nil {
+		return nil
+	}
+
+	baseTool, err := conv.ToBaseTool(tool)
+	if err != nil {
+		return nil
+	}
+	if baseTool.Variation == nil {
+		return []gateway.ArgumentBinding{}
+	}
+
+	return ArgumentBindings(baseTool.Variation.ArgumentBindings)
+}
+
+func (t *Toolsets) getToolCallPlanByURN(ctx context.Context, toolUrn urn.Tool, projectID uuid.UUID) (*gateway.ToolCallPlan, error) {
 	switch toolUrn.Kind {
 	case urn.ToolKindHTTP:
 		tool, err := t.toolsRepo.GetHTTPToolDefinitionByURN(ctx, toolsRepo.GetHTTPToolDefinitionByURNParams{
-			ProjectID: projectID,
-			Urn:       toolUrn,
+			ProjectID:    projectID,
+			Urn:          toolUrn,
+			DeploymentID: canary.DeploymentID(ctx),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("get http tool definition by urn: %w", err)
 		}
-		return t.extractHTTPToolCallPlan(ctx, tool)
+		return t.extractHTTPToolCallPlan(ctx, tool.HttpToolDefinition, tool.ServerCandidates)
 
 	case urn.ToolKindFunction:
 		tool, err := t.toolsRepo.GetFunctionToolByURN(ctx, toolsRepo.GetFunctionToolByURNParams{
-			ProjectID: projectID,
-			Urn:       toolUrn,
+			ProjectID:    projectID,
+			Urn:          toolUrn,
+			DeploymentID: canary.DeploymentID(ctx),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("get function tool definition by urn: %w", err)
@@ -140,7 +233,7 @@ func (t *Toolsets) extractPlatformToolCallPlan(ctx context.Context, projectID uu
 	return gateway.NewPlatformToolCallPlan(descriptor, plan), nil
 }
 
-func (t *Toolsets) extractHTTPToolCallPlan(ctx context.Context, tool toolsRepo.HttpToolDefinition) (*gateway.ToolCallPlan, error) {
+func (t *Toolsets) extractHTTPToolCallPlan(ctx context.Context, tool toolsRepo.HttpToolDefinition, serverCandidates []string) (*gateway.ToolCallPlan, error) {
 	securityKeysMap := make(map[string]bool)
 	securityKeys, securityScopes, err := security.ParseHTTPToolSecurityKeys(tool.Security)
 	if err != nil {
@@ -227,6 +320,7 @@ func (t *Toolsets) extractHTTPToolCallPlan(ctx context.Context, tool toolsRepo.H
 	plan := &gateway.HTTPToolCallPlan{
 		DefaultServerUrl:   gateway.NullString{Valid: tool.DefaultServerUrl.Valid, Value: tool.DefaultServerUrl.String},
 		ServerEnvVar:       tool.ServerEnvVar,
+		ServerCandidates:   serverCandidates,
 		Method:             tool.HttpMethod,
 		Path:               trimFragment(tool.Path),
 		Schema:             tool.Schema,
@@ -320,11 +414,18 @@ func (t *Toolsets) extractPromptToolCallPlan(ctx context.Context, tool templates
 		engine = tool.Engine.String
 	}
 
+	workflow, err := gateway.CompileWorkflow(tool.Kind.String, tool.Prompt)
+	if err != nil {
+		return nil, fmt.Errorf("compile workflow for %s: %w", tool.ToolUrn.String(), err)
+	}
+
 	plan := &gateway.PromptToolCallPlan{
 		TemplateID: tool.ID.String(),
 		Engine:     engine,
 		Prompt:     tool.Prompt,
 		Kind:       tool.Kind.String,
+		Workflow:   workflow,
+		StepCaller: nil,
 	}
 	return gateway.NewPromptToolCallPlan(descriptor, plan), nil
 }
@@ -0,0 +1,193 @@
+package toolsets
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	gen "github.com/speakeasy-api/gram/server/gen/toolsets"
+	"github.com/speakeasy-api/gram/server/gen/types"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/mv"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolsets/repo"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+)
+
+// DiffToolsetVersions reports the tool and resource URNs added and removed
+// between two recorded versions of a toolset. Versions are immutable, so the
+// result for a given pair never changes.
+func (s *Service) DiffToolsetVersions(ctx context.Context, payload *gen.DiffToolsetVersionsPayload) (*gen.ToolsetVersionDiff, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()), attr.SlogToolsetSlug(string(payload.Slug)))
+
+	toolset, err := s.repo.GetToolset(ctx, repo.GetToolsetParams{
+		Slug:      conv.ToLower(string(payload.Slug)),
+		ProjectID: *authCtx.ProjectID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "toolset not found").LogError(ctx, logger)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to load toolset").LogError(ctx, logger)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPRead, toolset.ID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	from, err := getToolsetVersion(ctx, logger, s.repo, toolset.ID, payload.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := getToolsetVersion(ctx, logger, s.repo, toolset.ID, payload.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	addedTools, removedTools := diffURNs(urnStrings(from.ToolUrns), urnStrings(to.ToolUrns))
+	addedResources, removedResources := diffURNs(urnStrings(from.ResourceUrns), urnStrings(to.ResourceUrns))
+
+	return &gen.ToolsetVersionDiff{
+		FromVersion:         from.Version,
+		ToVersion:           to.Version,
+		AddedToolUrns:       addedTools,
+		RemovedToolUrns:     removedTools,
+		AddedResourceUrns:   addedResources,
+		RemovedResourceUrns: removedResources,
+	}, nil
+}
+
+// RevertToolset records a new toolset version carrying the tools and
+// resources of an earlier one. Older versions are left untouched, so MCP
+// servers and endpoints pinned to them keep serving the same tools.
+func (s *Service) RevertToolset(ctx context.Context, payload *gen.RevertToolsetPayload) (*types.Toolset, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ProjectID == nil {
+		return nil, oops.C(oops.CodeUnauthorized)
+	}
+
+	logger := s.logger.With(attr.SlogProjectID(authCtx.ProjectID.String()), attr.SlogToolsetSlug(string(payload.Slug)))
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error accessing toolsets").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	tr := s.repo.WithTx(dbtx)
+
+	toolset, err := tr.GetToolset(ctx, repo.GetToolsetParams{
+		Slug:      conv.ToLower(string(payload.Slug)),
+		ProjectID: *authCtx.ProjectID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "toolset not found").LogError(ctx, logger)
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to load toolset").LogError(ctx, logger)
+	}
+
+	if err := s.authz.Require(ctx, authz.MCPCheck(authz.ScopeMCPWrite, toolset.ID.String(), authCtx.ProjectID.String())); err != nil {
+		return nil, err
+	}
+
+	target, err := getToolsetVersion(ctx, logger, tr, toolset.ID, payload.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	beforeView, err := mv.DescribeToolset(ctx, logger, dbtx, mv.ProjectID(*authCtx.ProjectID), mv.ToolsetSlug(toolset.Slug), new(s.toolsetCache.SkipCache()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// createToolsetVersion skips writing a version when the URNs already
+	// match the latest one, which makes reverting to the current state a
+	// no-op rather than an error.
+	if err := s.createToolsetVersion(ctx, urnStrings(target.ToolUrns), urnStrings(target.ResourceUrns), toolset.ID, tr); err != nil {
+		return nil, err
+	}
+
+	afterView, err := mv.DescribeToolset(ctx, logger, dbtx, mv.ProjectID(*authCtx.ProjectID), mv.ToolsetSlug(toolset.Slug), new(s.toolsetCache.SkipCache()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.audit.LogToolsetUpdate(ctx, dbtx, audit.LogToolsetUpdateEvent{
+		OrganizationID:        authCtx.ActiveOrganizationID,
+		ProjectID:             *authCtx.ProjectID,
+		Actor:                 urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:      authCtx.Email,
+		ActorSlug:             nil,
+		ToolsetURN:            urn.NewToolset(toolset.ID),
+		ToolsetName:           toolset.Name,
+		ToolsetSlug:           toolset.Slug,
+		ToolsetVersionAfter:   afterView.ToolsetVersion,
+		ToolsetSnapshotBefore: beforeView,
+		ToolsetSnapshotAfter:  afterView,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "failed to log toolset revert").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error saving reverted toolset").LogError(ctx, logger)
+	}
+
+	return afterView, nil
+}
+
+func getToolsetVersion(ctx context.Context, logger *slog.Logger, tr *repo.Queries, toolsetID uuid.UUID, version int64) (repo.ToolsetVersion, error) {
+	row, err := tr.GetToolsetVersion(ctx, repo.GetToolsetVersionParams{
+		ToolsetID: toolsetID,
+		Version:   version,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return row, oops.E(oops.CodeNotFound, err, "toolset version %d not found", version).LogError(ctx, logger)
+	case err != nil:
+		return row, oops.E(oops.CodeUnexpected, err, "failed to load toolset version").LogError(ctx, logger)
+	}
+
+	return row, nil
+}
+
+func urnStrings[T interface{ String() string }](urns []T) []string {
+	out := make([]string, len(urns))
+	for i, u := range urns {
+		out[i] = u.String()
+	}
+	return out
+}
+
+// diffURNs returns the URNs only present in to and the URNs only present in
+// from, each sorted for stable output.
+func diffURNs(from, to []string) (added []string, removed []string) {
+	added, removed = []string{}, []string{}
+	for _, u := range to {
+		if !slices.Contains(from, u) {
+			added = append(added, u)
+		}
+	}
+	for _, u := range from {
+		if !slices.Contains(to, u) {
+			removed = append(removed, u)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+	return added, removed
+}
@@ -0,0 +1,147 @@
+package toolsets_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/toolsets"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/audit/audittest"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/testenv/testrepo"
+)
+
+func TestDiffToolsetVersions_ReportsAddedAndRemovedTools(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestToolsetsService(t)
+
+	dep := createPetstoreDeployment(t, ctx, ti)
+	tools, err := testrepo.New(ti.conn).ListDeploymentHTTPTools(ctx, uuid.MustParse(dep.Deployment.ID))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(tools), 2)
+
+	created, err := ti.service.CreateToolset(ctx, &gen.CreateToolsetPayload{
+		SessionToken:           nil,
+		ApikeyToken:            nil,
+		Name:                   "Versioned Toolset",
+		Description:            nil,
+		ToolUrns:               []string{tools[0].ToolUrn.String()},
+		ResourceUrns:           nil,
+		DefaultEnvironmentSlug: nil,
+		ProjectSlugInput:       nil,
+	})
+	require.NoError(t, err)
+
+	updated, err := ti.service.UpdateToolset(ctx, &gen.UpdateToolsetPayload{
+		SessionToken:           nil,
+		Slug:                   created.Slug,
+		Name:                   nil,
+		Description:            nil,
+		DefaultEnvironmentSlug: nil,
+		ToolUrns:               []string{tools[1].ToolUrn.String()},
+		ResourceUrns:           nil,
+		PromptTemplateNames:    nil,
+		McpSlug:                nil,
+		McpIsPublic:            nil,
+		McpEnabled:             nil,
+		CustomDomainID:         nil,
+		ProjectSlugInput:       nil,
+	})
+	require.NoError(t, err)
+	require.Greater(t, updated.ToolsetVersion, created.ToolsetVersion)
+
+	diff, err := ti.service.DiffToolsetVersions(ctx, &gen.DiffToolsetVersionsPayload{
+		Slug:             created.Slug,
+		FromVersion:      created.ToolsetVersion,
+		ToVersion:        updated.ToolsetVersion,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{tools[1].ToolUrn.String()}, diff.AddedToolUrns)
+	require.Equal(t, []string{tools[0].ToolUrn.String()}, diff.RemovedToolUrns)
+	require.Empty(t, diff.AddedResourceUrns)
+	require.Empty(t, diff.RemovedResourceUrns)
+}
+
+func TestDiffToolsetVersions_VersionNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestToolsetsService(t)
+
+	toolset := createMinimalPrivateToolset(t, ctx, ti, "diff missing version")
+
+	_, err := ti.service.DiffToolsetVersions(ctx, &gen.DiffToolsetVersionsPayload{
+		Slug:             toolset.Slug,
+		FromVersion:      toolset.ToolsetVersion,
+		ToVersion:        toolset.ToolsetVersion + 100,
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		ProjectSlugInput: nil,
+	})
+	var oopsErr *oops.ShareableError
+	require.ErrorAs(t, err, &oopsErr)
+	require.Equal(t, oops.CodeNotFound, oopsErr.Code)
+}
+
+func TestRevertToolset_CreatesNewVersionFromOldOne(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestToolsetsService(t)
+
+	dep := createPetstoreDeployment(t, ctx, ti)
+	tools, err := testrepo.New(ti.conn).ListDeploymentHTTPTools(ctx, uuid.MustParse(dep.Deployment.ID))
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(tools), 2)
+
+	created, err := ti.service.CreateToolset(ctx, &gen.CreateToolsetPayload{
+		SessionToken:           nil,
+		ApikeyToken:            nil,
+		Name:                   "Revertible Toolset",
+		Description:            nil,
+		ToolUrns:               []string{tools[0].ToolUrn.String()},
+		ResourceUrns:           nil,
+		DefaultEnvironmentSlug: nil,
+		ProjectSlugInput:       nil,
+	})
+	require.NoError(t, err)
+
+	updated, err := ti.service.UpdateToolset(ctx, &gen.UpdateToolsetPayload{
+		SessionToken:           nil,
+		Slug:                   created.Slug,
+		Name:                   nil,
+		Description:            nil,
+		DefaultEnvironmentSlug: nil,
+		ToolUrns:               []string{tools[1].ToolUrn.String()},
+		ResourceUrns:           nil,
+		PromptTemplateNames:    nil,
+		McpSlug:                nil,
+		McpIsPublic:            nil,
+		McpEnabled:             nil,
+		CustomDomainID:         nil,
+		ProjectSlugInput:       nil,
+	})
+	require.NoError(t, err)
+
+	beforeCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolsetUpdate)
+	require.NoError(t, err)
+
+	reverted, err := ti.service.RevertToolset(ctx, &gen.RevertToolsetPayload{
+		SessionToken:     nil,
+		ApikeyToken:      nil,
+		Slug:             created.Slug,
+		Version:          created.ToolsetVersion,
+		ProjectSlugInput: nil,
+	})
+	require.NoError(t, err)
+	require.Greater(t, reverted.ToolsetVersion, updated.ToolsetVersion)
+	require.Equal(t, []string{tools[0].ToolUrn.String()}, reverted.ToolUrns)
+
+	afterCount, err := audittest.AuditLogCountByAction(ctx, ti.conn, audit.ActionToolsetUpdate)
+	require.NoError(t, err)
+	require.Equal(t, beforeCount+1, afterCount)
+}
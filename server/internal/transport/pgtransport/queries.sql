@@ -0,0 +1,97 @@
+-- name: UpsertTransportSubscription :exec
+-- Registers a subscription so later publishes to its topic fan out to it.
+-- Opening an existing subscription is a no-op, matching Pub/Sub where a
+-- subscription only receives messages published after it was created.
+INSERT INTO transport_subscriptions (topic, subscription)
+VALUES (@topic, @subscription)
+ON CONFLICT (topic, subscription) DO NOTHING;
+
+-- name: ProvisionTransportSubscription :exec
+-- Registers a declared subscription at boot, ahead of its receivers, so a
+-- publish made before they first open still fans out to it. Retention follows
+-- the declaration, so a changed declaration takes effect on the next boot.
+INSERT INTO transport_subscriptions (topic, subscription, retention)
+VALUES (@topic, @subscription, @retention::interval)
+ON CONFLICT (topic, subscription) DO UPDATE SET retention = EXCLUDED.retention;
+
+-- name: EnqueueTransportDeliveries :execrows
+-- Writes one delivery per subscription open on the topic. Every copy shares
+-- message_id so subscribers see the same ID for the same publish, and each is
+-- settled independently. Each copy expires after its subscription's retention,
+-- as an unacked Pub/Sub message does.
+INSERT INTO transport_deliveries (message_id, topic, subscription, data, attributes, expires_at)
+SELECT @message_id::uuid, s.topic, s.subscription, @data::bytea, @attributes::jsonb, clock_timestamp() + COALESCE(s.retention, INTERVAL '7 days')
+FROM transport_subscriptions s
+WHERE s.topic = @topic
+ORDER BY s.subscription;
+
+-- name: NotifyTransportTopic :exec
+-- Wakes receivers blocked on the topic. The notification is only a hint:
+-- receivers poll as well, so a notification lost while no listener was
+-- connected delays delivery but never drops it.
+SELECT pg_notify(@channel::text, @topic::text);
+
+-- name: ClaimTransportDeliveries :many
+-- Leases the oldest visible deliveries for a subscription. SKIP LOCKED keeps
+-- concurrent receivers of the same subscription on disjoint rows, and the
+-- lease hides claimed rows until it elapses, which is what redelivers a
+-- message whose receiver died before settling it. attempts is incremented on
+-- claim so it counts deliveries rather than failures.
+--
+-- lease_token fences settlement: a receiver that overran its lease finds no
+-- row to ack once another receiver has claimed it again. The caller mints it
+-- so every row in the batch shares one value.
+UPDATE transport_deliveries SET
+    visible_at = clock_timestamp() + @lease::interval,
+    lease_token = @lease_token::uuid,
+    attempts = attempts + 1
+WHERE id IN (
+  SELECT d.id
+  FROM transport_deliveries d
+  WHERE d.topic = @topic
+    AND d.subscription = @subscription
+    AND d.visible_at <= clock_timestamp()
+    AND (d.expires_at IS NULL OR d.expires_at > clock_timestamp())
+  ORDER BY d.id ASC
+  LIMIT @batch_size
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, message_id, data, attributes, attempts;
+
+-- name: DeleteExpiredTransportDeliveries :execrows
+-- Removes deliveries past their retention, including those of subscriptions
+-- no receiver in this deployment consumes.
+DELETE FROM transport_deliveries
+WHERE id IN (
+  SELECT d.id
+  FROM transport_deliveries d
+  WHERE d.expires_at <= clock_timestamp()
+  ORDER BY d.expires_at ASC
+  LIMIT @batch_size
+  FOR UPDATE SKIP LOCKED
+);
+
+-- name: DeleteTransportDeliveries :execrows
+-- Removes acknowledged deliveries. ids and lease_tokens are expanded in
+-- lockstep because one flush can settle rows from several claims.
+DELETE FROM transport_deliveries d
+USING (
+  SELECT unnest(@ids::bigint[]) AS id,
+         unnest(@lease_tokens::uuid[]) AS lease_token
+) AS settlement
+WHERE d.id = settlement.id
+  AND d.lease_token = settlement.lease_token;
+
+-- name: RetryTransportDeliveries :exec
+-- Releases nacked deliveries so they become visible again at visible_at,
+-- which the caller derives from each row's attempt count.
+UPDATE transport_deliveries d SET
+    visible_at = settlement.visible_at,
+    lease_token = NULL
+FROM (
+  SELECT unnest(@ids::bigint[]) AS id,
+         unnest(@lease_tokens::uuid[]) AS lease_token,
+         unnest(@visible_ats::timestamptz[]) AS visible_at
+) AS settlement
+WHERE d.id = settlement.id
+  AND d.lease_token = settlement.lease_token;
@@ -0,0 +1,27 @@
+package pgtransport_test
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+)
+
+var infra *testenv.Environment
+
+func TestMain(m *testing.M) {
+	res, cleanup, err := testenv.Launch(context.Background(), testenv.LaunchOptions{Postgres: true})
+	if err != nil {
+		log.Fatalf("failed to launch test infrastructure: %v", err)
+	}
+	infra = res
+
+	code := m.Run()
+
+	if err := cleanup(); err != nil {
+		log.Printf("cleanup failed: %v", err)
+	}
+	os.Exit(code)
+}
@@ -0,0 +1,605 @@
+// Package pgtransport implements transport.Transport on Postgres, for
+// deployments that have a database but no Pub/Sub.
+//
+// A publish writes one transport_deliveries row per subscription registered
+// on the topic and sends a NOTIFY naming the topic. Declared subscriptions are
+// registered by Provision at boot, so the fan-out does not depend on a
+// receiver having opened first. Receivers claim rows oldest first under a
+// lease, hand them to the subscriber, and delete them on ack. A nacked row is
+// backed off while later rows keep flowing, so delivery order is not
+// guaranteed. Rows that outlive their subscription's retention are swept,
+// which bounds the backlog of subscriptions no process here consumes. The
+// NOTIFY only shortens the wait: receivers also poll, so a notification lost
+// while the listener was reconnecting delays delivery rather than dropping it.
+package pgtransport
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/speakeasy-api/gram/infra/pkg/gcp"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/transport"
+	"github.com/speakeasy-api/gram/server/internal/transport/pgtransport/repo"
+)
+
+// notifyChannel is the single LISTEN channel every topic shares. The payload
+// names the topic, and the transport fans the wake-up out to the receivers of
+// that topic, so one connection serves every subscription in the process.
+const notifyChannel = "gram_transport"
+
+// Options tunes delivery. Zero values select the defaults.
+type Options struct {
+	// Lease is how long a claimed delivery stays hidden from other receivers
+	// before it is redelivered. It plays the role of Pub/Sub's ack deadline.
+	// Defaults to 10 minutes.
+	Lease time.Duration
+	// PollInterval bounds how long a receiver waits when no notification
+	// arrives. Defaults to 5 seconds.
+	PollInterval time.Duration
+	// ClaimBatchSize caps the rows claimed per query. Defaults to 100.
+	ClaimBatchSize int
+	// MaxOutstanding caps deliveries handed to a subscriber but not yet
+	// settled, so a batching subscriber cannot pull the whole backlog into
+	// memory. Defaults to 1000.
+	MaxOutstanding int
+	// MaxDeliveryAttempts drops a message, with an error log, once it has been
+	// delivered this many times without an ack. Defaults to 20.
+	MaxDeliveryAttempts int
+}
+
+const (
+	defaultLease               = 10 * time.Minute
+	defaultPollInterval        = 5 * time.Second
+	defaultClaimBatchSize      = 100
+	defaultMaxOutstanding      = 1000
+	defaultMaxDeliveryAttempts = 20
+
+	// minRetryBackoff and maxRetryBackoff bound the delay before a nacked
+	// delivery is visible again, mirroring a Pub/Sub exponential retry policy.
+	minRetryBackoff = 10 * time.Second
+	maxRetryBackoff = 10 * time.Minute
+
+	// defaultRetention applies to subscriptions opened by Receive without
+	// being provisioned, and to declarations without a retention. It matches
+	// Pub/Sub's default message retention.
+	defaultRetention = 7 * 24 * time.Hour
+	// sweepInterval and sweepBatchSize pace the deletion of expired rows.
+	sweepInterval  = time.Minute
+	sweepBatchSize = 1000
+
+	// drainTimeout bounds how long a cancelled receiver waits for in-flight
+	// deliveries to settle before returning. Anything still unsettled is
+	// redelivered once its lease elapses.
+	drainTimeout = 30 * time.Second
+	// settleTimeout bounds each settlement statement, which runs detached from
+	// the receive context so a shutdown can still ack what it finished.
+	settleTimeout = 10 * time.Second
+)
+
+var _ transport.Transport = (*Transport)(nil)
+
+type Transport struct {
+	logger *slog.Logger
+	db     *pgxpool.Pool
+	opts   Options
+
+	// listenCtx scopes the shared LISTEN connection and the expiry sweep,
+	// which are started by the first Receive and stopped by Stop.
+	listenCtx   context.Context
+	stopListen  context.CancelFunc
+	listenOnce  sync.Once
+	listenDone  chan struct{}
+	mu          sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+}
+
+func New(logger *slog.Logger, db *pgxpool.Pool, opts Options) *Transport {
+	if opts.Lease <= 0 {
+		opts.Lease = defaultLease
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.ClaimBatchSize <= 0 {
+		opts.ClaimBatchSize = defaultClaimBatchSize
+	}
+	if opts.MaxOutstanding <= 0 {
+		opts.MaxOutstanding = defaultMaxOutstanding
+	}
+	if opts.MaxDeliveryAttempts <= 0 {
+		opts.MaxDeliveryAttempts = defaultMaxDeliveryAttempts
+	}
+
+	listenCtx, stopListen := context.WithCancel(context.Background())
+
+	return &Transport{
+		logger:      logger.With(attr.SlogComponent("pgtransport")),
+		db:          db,
+		opts:        opts,
+		listenCtx:   listenCtx,
+		stopListen:  stopListen,
+		listenOnce:  sync.Once{},
+		listenDone:  make(chan struct{}),
+		mu:          sync.Mutex{},
+		subscribers: make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+// Publish enqueues data for every subscription open on topic and wakes their
+// receivers. A topic with no subscriptions accepts the publish and stores
+// nothing, as Pub/Sub does.
+func (t *Transport) Publish(ctx context.Context, topic string, data []byte, attrs map[string]string) gcp.PublishResult {
+	messageID, err := uuid.NewV7()
+	if err != nil {
+		return gcp.NewErrPublishResult(fmt.Errorf("generate message id: %w", err))
+	}
+
+	if attrs == nil {
+		attrs = map[string]string{}
+	}
+	attributes, err := json.Marshal(attrs)
+	if err != nil {
+		return gcp.NewErrPublishResult(fmt.Errorf("marshal message attributes: %w", err))
+	}
+
+	n, err := repo.New(t.db).EnqueueTransportDeliveries(ctx, repo.EnqueueTransportDeliveriesParams{
+		MessageID:  messageID,
+		Data:       data,
+		Attributes: attributes,
+		Topic:      topic,
+	})
+	if err != nil {
+		return gcp.NewErrPublishResult(fmt.Errorf("enqueue %s deliveries: %w", topic, err))
+	}
+
+	if n > 0 {
+		// The rows are committed, so a failed notification only delays them
+		// until the next poll; it must not turn a stored publish into an error
+		// the caller would retry into a duplicate.
+		if err := repo.New(t.db).NotifyTransportTopic(ctx, repo.NotifyTransportTopicParams{
+			Channel: notifyChannel,
+			Topic:   topic,
+		}); err != nil {
+			t.logger.WarnContext(ctx, "failed to notify transport receivers", attr.SlogTopicProtoName(topic), attr.SlogError(err))
+		}
+	}
+
+	return gcp.NewSettledPublishResult(messageID.String())
+}
+
+// Provision registers subscriptions so publishes fan out to them before their
+// receivers first open, and updates the retention of ones that exist.
+func (t *Transport) Provision(ctx context.Context, subscriptions []transport.Subscription) error {
+	queries := repo.New(t.db)
+	for _, sub := range subscriptions {
+		retention := cmp.Or(sub.Retention, defaultRetention)
+		if err := queries.ProvisionTransportSubscription(ctx, repo.ProvisionTransportSubscriptionParams{
+			Topic:        sub.Topic,
+			Subscription: sub.Name,
+			Retention:    pgtype.Interval{Microseconds: retention.Microseconds(), Days: 0, Months: 0, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("provision %s subscription: %w", sub.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Receive delivers subscription's messages until ctx is cancelled, then waits
+// up to drainTimeout for in-flight deliveries to settle. A subscription that
+// was not provisioned is registered here, and only sees messages published
+// from then on.
+func (t *Transport) Receive(ctx context.Context, topic string, subscription string, deliver func(gcp.ReceivedMessage)) error {
+	if err := repo.New(t.db).UpsertTransportSubscription(ctx, repo.UpsertTransportSubscriptionParams{
+		Topic:        topic,
+		Subscription: subscription,
+	}); err != nil {
+		return fmt.Errorf("open %s subscription: %w", subscription, err)
+	}
+
+	wake, unsubscribe := t.subscribe(topic)
+	defer unsubscribe()
+
+	r := &receiver{
+		transport:    t,
+		logger:       t.logger.With(attr.SlogTopicProtoName(topic), attr.SlogSubscriptionProtoName(subscription)),
+		topic:        topic,
+		subscription: subscription,
+		deliver:      deliver,
+		settled:      make(chan struct{}, 1),
+		mu:           sync.Mutex{},
+		outstanding:  0,
+		acks:         nil,
+		nacks:        nil,
+	}
+
+	return r.run(ctx, wake)
+}
+
+// Stop closes the shared LISTEN connection and stops the expiry sweep.
+// Receivers keep polling until their own contexts are cancelled.
+func (t *Transport) Stop(ctx context.Context) error {
+	t.stopListen()
+
+	// Claim the once so a Receive racing Stop cannot start a listener that
+	// nothing would wait for.
+	t.listenOnce.Do(func() { close(t.listenDone) })
+
+	select {
+	case <-t.listenDone:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("stop pg transport: %w", ctx.Err())
+	}
+}
+
+// subscribe registers a wake-up channel for topic and starts the shared
+// listener and expiry sweep on first use.
+func (t *Transport) subscribe(topic string) (<-chan struct{}, func()) {
+	t.listenOnce.Do(func() {
+		go func() {
+			defer close(t.listenDone)
+
+			var sweep sync.WaitGroup
+			sweep.Go(func() { t.sweep(t.listenCtx) })
+			t.listen(t.listenCtx)
+			sweep.Wait()
+		}()
+	})
+
+	ch := make(chan struct{}, 1)
+
+	t.mu.Lock()
+	if t.subscribers[topic] == nil {
+		t.subscribers[topic] = make(map[chan struct{}]struct{})
+	}
+	t.subscribers[topic][ch] = struct{}{}
+	t.mu.Unlock()
+
+	return ch, func() {
+		t.mu.Lock()
+		delete(t.subscribers[topic], ch)
+		if len(t.subscribers[topic]) == 0 {
+			delete(t.subscribers, topic)
+		}
+		t.mu.Unlock()
+	}
+}
+
+// wake signals the receivers of topic, or of every topic when topic is empty.
+// Sends never block: a receiver with a wake-up already pending will claim
+// everything the new one would have told it about.
+func (t *Transport) wake(topic string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for name, chans := range t.subscribers {
+		if topic != "" && name != topic {
+			continue
+		}
+		for ch := range chans {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// listen holds a LISTEN connection open until ctx is cancelled, reconnecting
+// after failures.
+func (t *Transport) listen(ctx context.Context) {
+	for {
+		err := t.listenConn(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		t.logger.WarnContext(ctx, "transport listener disconnected", attr.SlogError(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(t.opts.PollInterval):
+		}
+	}
+}
+
+func (t *Transport) listenConn(ctx context.Context) error {
+	pooled, err := t.db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire listener connection: %w", err)
+	}
+	// The connection is taken out of the pool for good: returning it with a
+	// LISTEN still registered would leak notifications into whichever caller
+	// checked it out next.
+	conn := pooled.Hijack()
+	defer o11y.NoLogDefer(func() error { return conn.Close(context.WithoutCancel(ctx)) })
+
+	if _, err := conn.Exec(ctx, "LISTEN "+notifyChannel); err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	// Anything published while the listener was down produced notifications
+	// nobody heard, so send every receiver looking now rather than at its next
+	// poll.
+	t.wake("")
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+		t.wake(n.Payload)
+	}
+}
+
+// sweep deletes expired deliveries until ctx is cancelled. Every receiving
+// process sweeps the whole table; SKIP LOCKED keeps concurrent sweeps apart.
+func (t *Transport) sweep(ctx context.Context) {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			n, err := repo.New(t.db).DeleteExpiredTransportDeliveries(ctx, sweepBatchSize)
+			if err != nil {
+				if ctx.Err() == nil {
+					t.logger.WarnContext(ctx, "failed to delete expired transport deliveries", attr.SlogError(err))
+				}
+				break
+			}
+			if n > 0 {
+				t.logger.WarnContext(ctx, "dropped transport deliveries past their retention", attr.SlogSubscriberBatchSize(int(n)))
+			}
+			if n < sweepBatchSize {
+				break
+			}
+		}
+	}
+}
+
+type settlement struct {
+	id         int64
+	leaseToken uuid.UUID
+	attempts   int32
+}
+
+type receiver struct {
+	transport    *Transport
+	logger       *slog.Logger
+	topic        string
+	subscription string
+	deliver      func(gcp.ReceivedMessage)
+
+	// settled is signalled whenever a delivery is acked or nacked so the loop
+	// flushes settlements and, if it was at MaxOutstanding, claims again.
+	settled chan struct{}
+
+	mu          sync.Mutex
+	outstanding int
+	acks        []settlement
+	nacks       []settlement
+}
+
+func (r *receiver) run(ctx context.Context, wake <-chan struct{}) error {
+	poll := time.NewTicker(r.transport.opts.PollInterval)
+	defer poll.Stop()
+
+	for {
+		r.flush(ctx)
+
+		if ctx.Err() != nil {
+			r.drain(ctx)
+			return nil
+		}
+
+		claimed, err := r.claim(ctx)
+		switch {
+		case err != nil && ctx.Err() == nil:
+			r.logger.WarnContext(ctx, "failed to claim transport deliveries", attr.SlogError(err))
+		case err == nil && claimed == r.transport.opts.ClaimBatchSize:
+			// A full batch suggests more rows are waiting; claim again without
+			// sleeping.
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-wake:
+		case <-poll.C:
+		case <-r.settled:
+		}
+	}
+}
+
+// claim leases up to the free outstanding capacity and hands the rows to the
+// subscriber oldest first. It returns how many rows it claimed.
+func (r *receiver) claim(ctx context.Context) (int, error) {
+	opts := r.transport.opts
+
+	r.mu.Lock()
+	capacity := min(opts.MaxOutstanding-r.outstanding, opts.ClaimBatchSize)
+	r.mu.Unlock()
+	if capacity <= 0 {
+		return 0, nil
+	}
+
+	leaseToken, err := uuid.NewV7()
+	if err != nil {
+		return 0, fmt.Errorf("generate lease token: %w", err)
+	}
+
+	rows, err := repo.New(r.transport.db).ClaimTransportDeliveries(ctx, repo.ClaimTransportDeliveriesParams{
+		Lease:        pgtype.Interval{Microseconds: opts.Lease.Microseconds(), Days: 0, Months: 0, Valid: true},
+		LeaseToken:   leaseToken,
+		Topic:        r.topic,
+		Subscription: r.subscription,
+		BatchSize:    conv.SafeInt32(capacity),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("claim deliveries: %w", err)
+	}
+	// UPDATE ... RETURNING does not promise the subquery's order.
+	slices.SortFunc(rows, func(a, b repo.ClaimTransportDeliveriesRow) int { return cmp.Compare(a.ID, b.ID) })
+
+	for _, row := range rows {
+		s := settlement{id: row.ID, leaseToken: leaseToken, attempts: row.Attempts}
+
+		if int(row.Attempts) > opts.MaxDeliveryAttempts {
+			r.logger.ErrorContext(ctx, "dropping transport message after exhausting delivery attempts",
+				attr.SlogRetryAttempt(int(row.Attempts)),
+			)
+			r.mu.Lock()
+			r.acks = append(r.acks, s)
+			r.mu.Unlock()
+			continue
+		}
+
+		var attributes map[string]string
+		if err := json.Unmarshal(row.Attributes, &attributes); err != nil {
+			r.logger.WarnContext(ctx, "failed to decode transport message attributes", attr.SlogError(err))
+		}
+
+		r.mu.Lock()
+		r.outstanding++
+		r.mu.Unlock()
+
+		attempt := int(row.Attempts)
+		var once sync.Once
+		r.deliver(gcp.ReceivedMessage{
+			ID:              row.MessageID.String(),
+			Data:            row.Data,
+			Attributes:      attributes,
+			DeliveryAttempt: &attempt,
+			Ack:             func() { once.Do(func() { r.settle(s, false) }) },
+			Nack:            func() { once.Do(func() { r.settle(s, true) }) },
+		})
+	}
+
+	return len(rows), nil
+}
+
+func (r *receiver) settle(s settlement, nack bool) {
+	r.mu.Lock()
+	r.outstanding--
+	if nack {
+		r.nacks = append(r.nacks, s)
+	} else {
+		r.acks = append(r.acks, s)
+	}
+	r.mu.Unlock()
+
+	select {
+	case r.settled <- struct{}{}:
+	default:
+	}
+}
+
+// flush writes pending acks and nacks. It runs detached from ctx so a
+// receiver shutting down still records what its subscriber finished; a
+// settlement that fails to write is redelivered after its lease.
+func (r *receiver) flush(ctx context.Context) {
+	r.mu.Lock()
+	acks, nacks := r.acks, r.nacks
+	r.acks, r.nacks = nil, nil
+	r.mu.Unlock()
+
+	if len(acks) == 0 && len(nacks) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), settleTimeout)
+	defer cancel()
+
+	if len(acks) > 0 {
+		ids := make([]int64, 0, len(acks))
+		tokens := make([]uuid.UUID, 0, len(acks))
+		for _, s := range acks {
+			ids = append(ids, s.id)
+			tokens = append(tokens, s.leaseToken)
+		}
+		if _, err := repo.New(r.transport.db).DeleteTransportDeliveries(ctx, repo.DeleteTransportDeliveriesParams{
+			Ids:         ids,
+			LeaseTokens: tokens,
+		}); err != nil {
+			r.logger.WarnContext(ctx, "failed to ack transport deliveries", attr.SlogError(err))
+		}
+	}
+
+	if len(nacks) > 0 {
+		now := time.Now()
+		ids := make([]int64, 0, len(nacks))
+		tokens := make([]uuid.UUID, 0, len(nacks))
+		visibleAts := make([]pgtype.Timestamptz, 0, len(nacks))
+		for _, s := range nacks {
+			ids = append(ids, s.id)
+			tokens = append(tokens, s.leaseToken)
+			visibleAts = append(visibleAts, conv.ToPGTimestamptz(now.Add(retryBackoff(s.attempts))))
+		}
+		if err := repo.New(r.transport.db).RetryTransportDeliveries(ctx, repo.RetryTransportDeliveriesParams{
+			Ids:         ids,
+			LeaseTokens: tokens,
+			VisibleAts:  visibleAts,
+		}); err != nil {
+			r.logger.WarnContext(ctx, "failed to nack transport deliveries", attr.SlogError(err))
+		}
+	}
+}
+
+// drain waits for in-flight deliveries to settle after ctx is cancelled.
+// Batching subscribers flush their buffered batch only once they observe the
+// cancellation, so returning immediately would strand acks for work that
+// completed.
+func (r *receiver) drain(ctx context.Context) {
+	deadline := time.NewTimer(drainTimeout)
+	defer deadline.Stop()
+
+	for {
+		r.flush(ctx)
+
+		r.mu.Lock()
+		outstanding := r.outstanding
+		r.mu.Unlock()
+		if outstanding <= 0 {
+			return
+		}
+
+		select {
+		case <-r.settled:
+		case <-deadline.C:
+			r.logger.WarnContext(ctx, "transport receiver stopped with unsettled deliveries",
+				attr.SlogSubscriberBatchSize(outstanding),
+			)
+			return
+		}
+	}
+}
+
+// retryBackoff is the delay before a delivery nacked on its attempts-th
+// delivery is visible again.
+func retryBackoff(attempts int32) time.Duration {
+	delay := minRetryBackoff
+	for i := int32(1); i < attempts && delay < maxRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryBackoff)
+}
@@ -0,0 +1,197 @@
+package pgtransport_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/infra/pkg/gcp"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/transport"
+	"github.com/speakeasy-api/gram/server/internal/transport/pgtransport"
+)
+
+const testTopic = "gram.test.v1.Message"
+
+func newTestTransport(t *testing.T) (*pgtransport.Transport, *pgxpool.Pool) {
+	t.Helper()
+
+	conn, err := infra.CloneTestDatabase(t, "testdb")
+	require.NoError(t, err)
+
+	tr := pgtransport.New(testenv.NewLogger(t), conn, pgtransport.Options{
+		Lease:               0,
+		PollInterval:        50 * time.Millisecond,
+		ClaimBatchSize:      0,
+		MaxOutstanding:      0,
+		MaxDeliveryAttempts: 0,
+	})
+	t.Cleanup(func() {
+		require.NoError(t, tr.Stop(context.Background()))
+	})
+
+	return tr, conn
+}
+
+// collector records the payloads a receiver is handed and settles each one
+// with the configured outcome.
+type collector struct {
+	mu       sync.Mutex
+	received []gcp.ReceivedMessage
+	nack     func(gcp.ReceivedMessage) bool
+}
+
+func (c *collector) deliver(m gcp.ReceivedMessage) {
+	c.mu.Lock()
+	c.received = append(c.received, m)
+	c.mu.Unlock()
+
+	if c.nack != nil && c.nack(m) {
+		m.Nack()
+		return
+	}
+	m.Ack()
+}
+
+func (c *collector) payloads() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	out := make([]string, 0, len(c.received))
+	for _, m := range c.received {
+		out = append(out, string(m.Data))
+	}
+	return out
+}
+
+func startReceiver(t *testing.T, tr *pgtransport.Transport, conn *pgxpool.Pool, subscription string, c *collector) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error, 1)
+	go func() { done <- tr.Receive(ctx, testTopic, subscription, c.deliver) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	// Publishes only fan out to subscriptions that exist, so wait for the
+	// receiver to register before the test publishes anything.
+	require.Eventually(t, func() bool {
+		var n int
+		err := conn.QueryRow(t.Context(),
+			"SELECT count(*) FROM transport_subscriptions WHERE topic = $1 AND subscription = $2",
+			testTopic, subscription,
+		).Scan(&n)
+		return err == nil && n == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestTransport_FansOutToEverySubscriptionInOrder(t *testing.T) {
+	t.Parallel()
+
+	tr, conn := newTestTransport(t)
+
+	first := &collector{mu: sync.Mutex{}, received: nil, nack: nil}
+	second := &collector{mu: sync.Mutex{}, received: nil, nack: nil}
+	startReceiver(t, tr, conn, "gram.test.v1.First", first)
+	startReceiver(t, tr, conn, "gram.test.v1.Second", second)
+
+	for _, payload := range []string{"one", "two", "three"} {
+		_, err := tr.Publish(t.Context(), testTopic, []byte(payload), map[string]string{"k": "v"}).Get(t.Context())
+		require.NoError(t, err)
+	}
+
+	want := []string{"one", "two", "three"}
+	require.Eventually(t, func() bool { return len(first.payloads()) == 3 && len(second.payloads()) == 3 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, want, first.payloads(), "each subscription must see messages in publish order")
+	require.Equal(t, want, second.payloads())
+	require.Equal(t, "v", first.received[0].Attributes["k"])
+	require.Equal(t, first.received[0].ID, second.received[0].ID, "copies of one publish must share its message ID")
+
+	require.Eventually(t, func() bool {
+		var n int
+		err := conn.QueryRow(t.Context(), "SELECT count(*) FROM transport_deliveries").Scan(&n)
+		return err == nil && n == 0
+	}, 5*time.Second, 10*time.Millisecond, "acked deliveries must be deleted")
+}
+
+func TestTransport_PublishWithoutSubscriptionsStoresNothing(t *testing.T) {
+	t.Parallel()
+
+	tr, conn := newTestTransport(t)
+
+	id, err := tr.Publish(t.Context(), testTopic, []byte("dropped"), nil).Get(t.Context())
+	require.NoError(t, err)
+	require.NotEmpty(t, id)
+
+	var n int
+	require.NoError(t, conn.QueryRow(t.Context(), "SELECT count(*) FROM transport_deliveries").Scan(&n))
+	require.Zero(t, n)
+}
+
+func TestTransport_NackDefersRedelivery(t *testing.T) {
+	t.Parallel()
+
+	tr, conn := newTestTransport(t)
+
+	c := &collector{mu: sync.Mutex{}, received: nil, nack: func(gcp.ReceivedMessage) bool { return true }}
+	startReceiver(t, tr, conn, "gram.test.v1.Nacker", c)
+
+	_, err := tr.Publish(t.Context(), testTopic, []byte("retry-me"), nil).Get(t.Context())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return len(c.payloads()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, *c.received[0].DeliveryAttempt)
+
+	// The nack backs the delivery off rather than redelivering it at once, and
+	// the row survives to be claimed again.
+	require.Eventually(t, func() bool {
+		var visibleInSeconds float64
+		err := conn.QueryRow(t.Context(),
+			"SELECT EXTRACT(EPOCH FROM visible_at - clock_timestamp())::float8 FROM transport_deliveries WHERE lease_token IS NULL",
+		).Scan(&visibleInSeconds)
+		return err == nil && visibleInSeconds > 5
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(t, c.payloads(), 1)
+}
+
+func TestTransport_ProvisionedSubscriptionReceivesEarlierPublish(t *testing.T) {
+	t.Parallel()
+
+	tr, conn := newTestTransport(t)
+
+	err := tr.Provision(t.Context(), []transport.Subscription{{Topic: testTopic, Name: "gram.test.v1.Late", Retention: time.Hour}})
+	require.NoError(t, err)
+
+	_, err = tr.Publish(t.Context(), testTopic, []byte("early"), nil).Get(t.Context())
+	require.NoError(t, err)
+
+	c := &collector{mu: sync.Mutex{}, received: nil, nack: nil}
+	startReceiver(t, tr, conn, "gram.test.v1.Late", c)
+
+	require.Eventually(t, func() bool { return len(c.payloads()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"early"}, c.payloads())
+}
+
+func TestTransport_ExpiredDeliveryIsNotClaimed(t *testing.T) {
+	t.Parallel()
+
+	tr, conn := newTestTransport(t)
+
+	err := tr.Provision(t.Context(), []transport.Subscription{{Topic: testTopic, Name: "gram.test.v1.Short", Retention: time.Microsecond}})
+	require.NoError(t, err)
+
+	_, err = tr.Publish(t.Context(), testTopic, []byte("stale"), nil).Get(t.Context())
+	require.NoError(t, err)
+
+	c := &collector{mu: sync.Mutex{}, received: nil, nack: nil}
+	startReceiver(t, tr, conn, "gram.test.v1.Short", c)
+
+	time.Sleep(200 * time.Millisecond)
+	require.Empty(t, c.payloads(), "a delivery past its retention must not be delivered")
+}
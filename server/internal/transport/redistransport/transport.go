@@ -0,0 +1,483 @@
+// Package redistransport implements transport.Transport on Redis Streams, for
+// deployments that run Redis but not Pub/Sub.
+//
+// Each topic is one stream and each subscription is a consumer group on it,
+// so every subscription reads its own copy of the stream while the replicas
+// sharing a subscription split it between them. Declared groups are created by
+// Provision at boot, so entries appended before a receiver first opens are
+// still read. Entries are acked with XACK.
+// One that is nacked, or whose consumer dies holding it, stays in the group's
+// pending list and is claimed again once it has sat idle for the lease, after
+// entries appended later, so delivery order is not guaranteed.
+package redistransport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/speakeasy-api/gram/infra/pkg/gcp"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/transport"
+)
+
+const (
+	keyPrefix = "gram:transport:"
+
+	fieldData       = "data"
+	fieldAttributes = "attributes"
+)
+
+// Options tunes delivery. Zero values select the defaults.
+type Options struct {
+	// Lease is how long a delivered entry may stay unacked before another
+	// consumer in the group claims it. It plays the role of Pub/Sub's ack
+	// deadline. Defaults to 10 minutes.
+	Lease time.Duration
+	// PollInterval bounds each blocking read and how often the pending list is
+	// scanned for entries to reclaim. Defaults to 2 seconds.
+	PollInterval time.Duration
+	// ReadBatchSize caps the entries read per command. Defaults to 100.
+	ReadBatchSize int
+	// MaxOutstanding caps entries handed to a subscriber but not yet settled,
+	// so a batching subscriber cannot pull the whole backlog into memory.
+	// Defaults to 1000.
+	MaxOutstanding int
+	// MaxDeliveryAttempts acks an entry away, with an error log, once it has
+	// been delivered this many times without an ack. Defaults to 20.
+	MaxDeliveryAttempts int
+	// MaxLen approximately bounds each stream. Entries trimmed before a slow
+	// subscription reads them are lost to it, so size this well above the
+	// deepest backlog a consumer is expected to fall behind. Defaults to
+	// 1,000,000.
+	MaxLen int64
+}
+
+const (
+	defaultLease               = 10 * time.Minute
+	defaultPollInterval        = 2 * time.Second
+	defaultReadBatchSize       = 100
+	defaultMaxOutstanding      = 1000
+	defaultMaxDeliveryAttempts = 20
+	defaultMaxLen              = 1_000_000
+
+	// minRetryBackoff and maxRetryBackoff bound the delay before a nacked
+	// entry is reclaimed, mirroring a Pub/Sub exponential retry policy. The
+	// delay is capped by the lease, since a nack can only shorten the wait.
+	minRetryBackoff = 10 * time.Second
+	maxRetryBackoff = 10 * time.Minute
+
+	// drainTimeout bounds how long a cancelled receiver waits for in-flight
+	// entries to settle before returning. Anything still pending is reclaimed
+	// once its lease elapses.
+	drainTimeout = 30 * time.Second
+	// settleTimeout bounds each ack or nack, which runs detached from the
+	// receive context so a shutdown can still ack what it finished.
+	settleTimeout = 10 * time.Second
+)
+
+var _ transport.Transport = (*Transport)(nil)
+
+type Transport struct {
+	logger *slog.Logger
+	client redis.UniversalClient
+	opts   Options
+}
+
+func New(logger *slog.Logger, client redis.UniversalClient, opts Options) *Transport {
+	if opts.Lease <= 0 {
+		opts.Lease = defaultLease
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.ReadBatchSize <= 0 {
+		opts.ReadBatchSize = defaultReadBatchSize
+	}
+	if opts.MaxOutstanding <= 0 {
+		opts.MaxOutstanding = defaultMaxOutstanding
+	}
+	if opts.MaxDeliveryAttempts <= 0 {
+		opts.MaxDeliveryAttempts = defaultMaxDeliveryAttempts
+	}
+	if opts.MaxLen <= 0 {
+		opts.MaxLen = defaultMaxLen
+	}
+
+	return &Transport{
+		logger: logger.With(attr.SlogComponent("redistransport")),
+		client: client,
+		opts:   opts,
+	}
+}
+
+func streamKey(topic string) string { return keyPrefix + topic }
+
+// Publish appends data to topic's stream. Consumer groups created after the
+// append do not see it, matching a Pub/Sub subscription created after the
+// publish; Provision creates the declared groups before anything publishes.
+func (t *Transport) Publish(ctx context.Context, topic string, data []byte, attrs map[string]string) gcp.PublishResult {
+	if attrs == nil {
+		attrs = map[string]string{}
+	}
+	attributes, err := json.Marshal(attrs)
+	if err != nil {
+		return gcp.NewErrPublishResult(fmt.Errorf("marshal message attributes: %w", err))
+	}
+
+	id, err := t.client.XAdd(ctx, &redis.XAddArgs{
+		Stream:     streamKey(topic),
+		NoMkStream: false,
+		MaxLen:     t.opts.MaxLen,
+		MinID:      "",
+		Approx:     true,
+		Limit:      0,
+		ID:         "",
+		Values:     []any{fieldData, data, fieldAttributes, attributes},
+	}).Result()
+	if err != nil {
+		return gcp.NewErrPublishResult(fmt.Errorf("append to %s stream: %w", topic, err))
+	}
+
+	return gcp.NewSettledPublishResult(id)
+}
+
+// Provision creates the consumer groups of subscriptions that do not have one
+// yet. Streams are bounded by MaxLen rather than retention, so Retention is
+// ignored.
+func (t *Transport) Provision(ctx context.Context, subscriptions []transport.Subscription) error {
+	for _, sub := range subscriptions {
+		if err := t.createGroup(ctx, streamKey(sub.Topic), sub.Name); err != nil {
+			return fmt.Errorf("provision %s subscription: %w", sub.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Receive reads subscription's consumer group until ctx is cancelled, then
+// waits up to drainTimeout for in-flight entries to settle. A subscription that
+// was not provisioned is created here, and only sees entries appended from then
+// on.
+func (t *Transport) Receive(ctx context.Context, topic string, subscription string, deliver func(gcp.ReceivedMessage)) error {
+	key := streamKey(topic)
+
+	if err := t.createGroup(ctx, key, subscription); err != nil {
+		return fmt.Errorf("open %s subscription: %w", subscription, err)
+	}
+
+	r := &receiver{
+		transport:   t,
+		logger:      t.logger.With(attr.SlogTopicProtoName(topic), attr.SlogSubscriptionProtoName(subscription)),
+		key:         key,
+		group:       subscription,
+		consumer:    subscription + "-" + uuid.NewString(),
+		deliver:     deliver,
+		settled:     make(chan struct{}, 1),
+		mu:          sync.Mutex{},
+		outstanding: 0,
+	}
+
+	return r.run(ctx)
+}
+
+// createGroup creates group on the stream at key unless it exists. "$" starts a
+// new group at the end of the stream, so it sees entries appended after it was
+// created.
+func (t *Transport) createGroup(ctx context.Context, key string, group string) error {
+	err := t.client.XGroupCreateMkStream(ctx, key, group, "$").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("create consumer group: %w", err)
+	}
+	return nil
+}
+
+// Stop is a no-op: the Redis client is shared and closed by its owner.
+func (t *Transport) Stop(context.Context) error { return nil }
+
+type receiver struct {
+	transport *Transport
+	logger    *slog.Logger
+	key       string
+	group     string
+	// consumer is unique per Receive call, so a restarted process never
+	// inherits a dead consumer's pending entries by name; they come back
+	// through the idle reclaim like any other abandoned entry.
+	consumer string
+	deliver  func(gcp.ReceivedMessage)
+
+	// settled is signalled whenever an entry is acked or nacked so a receiver
+	// held at MaxOutstanding reads again.
+	settled chan struct{}
+
+	mu          sync.Mutex
+	outstanding int
+}
+
+func (r *receiver) run(ctx context.Context) error {
+	opts := r.transport.opts
+	var lastReclaim time.Time
+
+	for {
+		if ctx.Err() != nil {
+			r.drain(ctx)
+			return nil
+		}
+
+		if r.capacity() <= 0 {
+			select {
+			case <-ctx.Done():
+			case <-r.settled:
+			}
+			continue
+		}
+
+		// Reclaimed entries go first so a redelivery is not starved behind a
+		// steady stream of new ones.
+		if time.Since(lastReclaim) >= opts.PollInterval {
+			lastReclaim = time.Now()
+			if err := r.reclaim(ctx); err != nil && ctx.Err() == nil {
+				r.logger.WarnContext(ctx, "failed to reclaim transport entries", attr.SlogError(err))
+			}
+		}
+
+		if err := r.read(ctx); err != nil && ctx.Err() == nil {
+			r.logger.WarnContext(ctx, "failed to read transport entries", attr.SlogError(err))
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(opts.PollInterval):
+			}
+		}
+	}
+}
+
+func (r *receiver) capacity() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return min(r.transport.opts.MaxOutstanding-r.outstanding, r.transport.opts.ReadBatchSize)
+}
+
+// read blocks for up to PollInterval waiting for entries no consumer in the
+// group has seen yet.
+func (r *receiver) read(ctx context.Context) error {
+	streams, err := r.transport.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    r.group,
+		Consumer: r.consumer,
+		Streams:  []string{r.key, ">"},
+		Count:    int64(r.capacity()),
+		Block:    r.transport.opts.PollInterval,
+		NoAck:    false,
+	}).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return nil
+	case err != nil:
+		return fmt.Errorf("read group: %w", err)
+	}
+
+	for _, stream := range streams {
+		for _, msg := range stream.Messages {
+			r.dispatch(ctx, msg, 1)
+		}
+	}
+
+	return nil
+}
+
+// reclaim takes over entries that have sat unacked for the lease, whether
+// nacked or abandoned by a consumer that died.
+func (r *receiver) reclaim(ctx context.Context) error {
+	opts := r.transport.opts
+
+	pending, err := r.transport.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream:   r.key,
+		Group:    r.group,
+		Idle:     opts.Lease,
+		Start:    "-",
+		End:      "+",
+		Count:    int64(r.capacity()),
+		Consumer: "",
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("list pending: %w", err)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(pending))
+	attempts := make(map[string]int, len(pending))
+	var exhausted []string
+	for _, p := range pending {
+		if int(p.RetryCount) >= opts.MaxDeliveryAttempts {
+			exhausted = append(exhausted, p.ID)
+			continue
+		}
+		ids = append(ids, p.ID)
+		attempts[p.ID] = int(p.RetryCount) + 1
+	}
+
+	if len(exhausted) > 0 {
+		r.logger.ErrorContext(ctx, "dropping transport entries after exhausting delivery attempts",
+			attr.SlogRetryAttempt(opts.MaxDeliveryAttempts),
+			attr.SlogSubscriberBatchSize(len(exhausted)),
+		)
+		if err := r.transport.client.XAck(ctx, r.key, r.group, exhausted...).Err(); err != nil {
+			return fmt.Errorf("ack exhausted entries: %w", err)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// MinIdle is checked again by XCLAIM, so when replicas race for the same
+	// entry only the first claim wins and the rest get nothing back.
+	msgs, err := r.transport.client.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   r.key,
+		Group:    r.group,
+		Consumer: r.consumer,
+		MinIdle:  opts.Lease,
+		Messages: ids,
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("claim pending: %w", err)
+	}
+
+	for _, msg := range msgs {
+		r.dispatch(ctx, msg, attempts[msg.ID])
+	}
+
+	return nil
+}
+
+func (r *receiver) dispatch(ctx context.Context, msg redis.XMessage, attempt int) {
+	data, _ := msg.Values[fieldData].(string)
+
+	var attributes map[string]string
+	if raw, ok := msg.Values[fieldAttributes].(string); ok {
+		if err := json.Unmarshal([]byte(raw), &attributes); err != nil {
+			r.logger.WarnContext(ctx, "failed to decode transport message attributes", attr.SlogError(err))
+		}
+	}
+
+	r.mu.Lock()
+	r.outstanding++
+	r.mu.Unlock()
+
+	var once sync.Once
+	r.deliver(gcp.ReceivedMessage{
+		ID:              msg.ID,
+		Data:            []byte(data),
+		Attributes:      attributes,
+		DeliveryAttempt: &attempt,
+		Ack:             func() { once.Do(func() { r.settle(ctx, msg.ID, attempt, false) }) },
+		Nack:            func() { once.Do(func() { r.settle(ctx, msg.ID, attempt, true) }) },
+	})
+}
+
+// settle acks or nacks one entry. It runs detached from ctx so a receiver
+// shutting down still records what its subscriber finished; a settlement that
+// fails leaves the entry pending, to be reclaimed after the lease.
+func (r *receiver) settle(ctx context.Context, id string, attempt int, nack bool) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), settleTimeout)
+	defer cancel()
+
+	var err error
+	if nack {
+		// Re-claiming the entry to ourselves with a back-dated idle time makes
+		// it eligible for reclaim once the back-off has elapsed rather than a
+		// full lease from now. RETRYCOUNT keeps the claim from counting as a
+		// delivery; the reclaim that redelivers it will.
+		idle := r.transport.opts.Lease - min(retryBackoff(attempt), r.transport.opts.Lease)
+		err = r.transport.client.Do(ctx, "XCLAIM", r.key, r.group, r.consumer, 0, id,
+			"IDLE", idle.Milliseconds(), "RETRYCOUNT", attempt, "JUSTID",
+		).Err()
+	} else {
+		err = r.transport.client.XAck(ctx, r.key, r.group, id).Err()
+	}
+	if err != nil {
+		r.logger.WarnContext(ctx, "failed to settle transport entry", attr.SlogError(err))
+	}
+
+	r.mu.Lock()
+	r.outstanding--
+	r.mu.Unlock()
+
+	select {
+	case r.settled <- struct{}{}:
+	default:
+	}
+}
+
+// drain waits for in-flight entries to settle after ctx is cancelled, then
+// removes this receiver's consumer from the group if it holds nothing.
+// Batching subscribers flush their buffered batch only once they observe the
+// cancellation, so returning immediately would strand acks for work that
+// completed.
+func (r *receiver) drain(ctx context.Context) {
+	deadline := time.NewTimer(drainTimeout)
+	defer deadline.Stop()
+
+	for {
+		r.mu.Lock()
+		outstanding := r.outstanding
+		r.mu.Unlock()
+		if outstanding <= 0 {
+			break
+		}
+
+		select {
+		case <-r.settled:
+			continue
+		case <-deadline.C:
+			r.logger.WarnContext(ctx, "transport receiver stopped with unsettled entries",
+				attr.SlogSubscriberBatchSize(outstanding),
+			)
+			// The consumer still owns pending entries, which XGROUP
+			// DELCONSUMER would discard; leave it for the reclaim to empty.
+			return
+		}
+	}
+
+	cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), settleTimeout)
+	defer cancel()
+
+	// Consumers are unique per Receive, so without this every restart would
+	// leave one more behind in the group. DELCONSUMER drops the consumer's
+	// pending entries with it, so only remove one that holds none.
+	pending, err := r.transport.client.XPendingExt(cleanupCtx, &redis.XPendingExtArgs{
+		Stream:   r.key,
+		Group:    r.group,
+		Idle:     0,
+		Start:    "-",
+		End:      "+",
+		Count:    1,
+		Consumer: r.consumer,
+	}).Result()
+	if err != nil || len(pending) > 0 {
+		return
+	}
+	if err := r.transport.client.XGroupDelConsumer(cleanupCtx, r.key, r.group, r.consumer).Err(); err != nil {
+		r.logger.WarnContext(ctx, "failed to remove transport consumer", attr.SlogError(err))
+	}
+}
+
+// retryBackoff is the delay before an entry nacked on its attempt-th delivery
+// is reclaimed.
+func retryBackoff(attempt int) time.Duration {
+	delay := minRetryBackoff
+	for i := 1; i < attempt && delay < maxRetryBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryBackoff)
+}
@@ -0,0 +1,187 @@
+package redistransport_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/infra/pkg/gcp"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/transport"
+	"github.com/speakeasy-api/gram/server/internal/transport/redistransport"
+)
+
+const testTopic = "gram.test.v1.Message"
+
+func newTestTransport(t *testing.T) (*redistransport.Transport, *redis.Client) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { require.NoError(t, client.Close()) })
+
+	tr := redistransport.New(testenv.NewLogger(t), client, redistransport.Options{
+		Lease:               100 * time.Millisecond,
+		PollInterval:        20 * time.Millisecond,
+		ReadBatchSize:       0,
+		MaxOutstanding:      0,
+		MaxDeliveryAttempts: 3,
+		MaxLen:              0,
+	})
+
+	return tr, client
+}
+
+// collector records what a receiver is handed and settles each message with
+// the configured outcome.
+type collector struct {
+	mu       sync.Mutex
+	received []gcp.ReceivedMessage
+	nack     func(gcp.ReceivedMessage) bool
+}
+
+func (c *collector) deliver(m gcp.ReceivedMessage) {
+	c.mu.Lock()
+	c.received = append(c.received, m)
+	c.mu.Unlock()
+
+	if c.nack != nil && c.nack(m) {
+		m.Nack()
+		return
+	}
+	m.Ack()
+}
+
+func (c *collector) snapshot() []gcp.ReceivedMessage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]gcp.ReceivedMessage(nil), c.received...)
+}
+
+func (c *collector) payloads() []string {
+	msgs := c.snapshot()
+	out := make([]string, 0, len(msgs))
+	for _, m := range msgs {
+		out = append(out, string(m.Data))
+	}
+	return out
+}
+
+func startReceiver(t *testing.T, tr *redistransport.Transport, client *redis.Client, subscription string, c *collector) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error, 1)
+	go func() { done <- tr.Receive(ctx, testTopic, subscription, c.deliver) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	// A new group starts at the end of the stream, so wait for it to exist
+	// before the test publishes anything.
+	require.Eventually(t, func() bool {
+		groups, err := client.XInfoGroups(t.Context(), "gram:transport:"+testTopic).Result()
+		if err != nil {
+			return false
+		}
+		for _, g := range groups {
+			if g.Name == subscription {
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestTransport_FansOutToEverySubscriptionInOrder(t *testing.T) {
+	t.Parallel()
+
+	tr, client := newTestTransport(t)
+
+	first := &collector{mu: sync.Mutex{}, received: nil, nack: nil}
+	second := &collector{mu: sync.Mutex{}, received: nil, nack: nil}
+	startReceiver(t, tr, client, "gram.test.v1.First", first)
+	startReceiver(t, tr, client, "gram.test.v1.Second", second)
+
+	for _, payload := range []string{"one", "two", "three"} {
+		_, err := tr.Publish(t.Context(), testTopic, []byte(payload), map[string]string{"k": "v"}).Get(t.Context())
+		require.NoError(t, err)
+	}
+
+	want := []string{"one", "two", "three"}
+	require.Eventually(t, func() bool { return len(first.payloads()) == 3 && len(second.payloads()) == 3 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, want, first.payloads(), "each subscription must see messages in publish order")
+	require.Equal(t, want, second.payloads())
+
+	got := first.snapshot()[0]
+	require.Equal(t, "v", got.Attributes["k"])
+	require.Equal(t, 1, *got.DeliveryAttempt)
+
+	require.Eventually(t, func() bool {
+		pending, err := client.XPending(t.Context(), "gram:transport:"+testTopic, "gram.test.v1.First").Result()
+		return err == nil && pending.Count == 0
+	}, 5*time.Second, 10*time.Millisecond, "acked entries must leave the pending list")
+}
+
+func TestTransport_NackedEntryIsRedelivered(t *testing.T) {
+	t.Parallel()
+
+	tr, client := newTestTransport(t)
+
+	c := &collector{mu: sync.Mutex{}, received: nil, nack: func(m gcp.ReceivedMessage) bool { return *m.DeliveryAttempt == 1 }}
+	startReceiver(t, tr, client, "gram.test.v1.Retrier", c)
+
+	_, err := tr.Publish(t.Context(), testTopic, []byte("retry-me"), nil).Get(t.Context())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return len(c.snapshot()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	msgs := c.snapshot()
+	require.Equal(t, msgs[0].ID, msgs[1].ID, "a redelivery must carry the original entry")
+	require.Equal(t, 2, *msgs[1].DeliveryAttempt)
+}
+
+func TestTransport_DropsEntryAfterMaxDeliveryAttempts(t *testing.T) {
+	t.Parallel()
+
+	tr, client := newTestTransport(t)
+
+	c := &collector{mu: sync.Mutex{}, received: nil, nack: func(gcp.ReceivedMessage) bool { return true }}
+	startReceiver(t, tr, client, "gram.test.v1.Poison", c)
+
+	_, err := tr.Publish(t.Context(), testTopic, []byte("poison"), nil).Get(t.Context())
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		pending, err := client.XPending(t.Context(), "gram:transport:"+testTopic, "gram.test.v1.Poison").Result()
+		return err == nil && pending.Count == 0 && len(c.snapshot()) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Nothing left to reclaim, so no fourth delivery follows.
+	time.Sleep(200 * time.Millisecond)
+	require.Len(t, c.snapshot(), 3)
+}
+
+func TestTransport_ProvisionedGroupReadsEntriesPublishedBeforeReceive(t *testing.T) {
+	t.Parallel()
+
+	tr, client := newTestTransport(t)
+
+	err := tr.Provision(t.Context(), []transport.Subscription{{Topic: testTopic, Name: "gram.test.v1.Late", Retention: 0}})
+	require.NoError(t, err)
+
+	_, err = tr.Publish(t.Context(), testTopic, []byte("early"), nil).Get(t.Context())
+	require.NoError(t, err)
+
+	c := &collector{mu: sync.Mutex{}, received: nil, nack: nil}
+	startReceiver(t, tr, client, "gram.test.v1.Late", c)
+
+	require.Eventually(t, func() bool { return len(c.payloads()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"early"}, c.payloads())
+}
@@ -0,0 +1,195 @@
+// Package transport abstracts the message bus Gram's publishers and
+// subscribers run over, so a deployment without Google Pub/Sub can move the
+// same messages through infrastructure it already operates.
+//
+// Pub/Sub remains the default and is reached through the gcp package directly.
+// A Transport is the alternative: it carries already-marshaled payloads
+// between topics and named subscriptions, and the adapters here lift it back
+// into the typed gcp.Publisher, gcp.Subscriber and topics.Publisher seams the
+// rest of the server is written against. Two implementations exist:
+//
+//   - pgtransport stores deliveries in Postgres and wakes receivers with
+//     LISTEN/NOTIFY, falling back to polling.
+//   - redistransport appends to Redis Streams and consumes them through
+//     consumer groups.
+//
+// Both follow the Pub/Sub model the callers already assume: every subscription
+// to a topic receives its own copy of each message published after the
+// subscription was provisioned, delivery is at least once, and a message that
+// is nacked or not settled within its lease is delivered again. As with a
+// Pub/Sub subscription without ordering keys, there is no ordering guarantee:
+// a redelivered message arrives after messages published later.
+//
+// Subscriptions are provisioned from the descriptor set at boot, the way
+// Config Connector provisions Pub/Sub subscriptions ahead of the consumers,
+// so a message published before its consumer first starts is not lost.
+package transport
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	pubsubv1 "github.com/speakeasy-api/gram/infra/gen/gcp/pubsub/v1"
+	"github.com/speakeasy-api/gram/infra/pkg/gcp"
+	"github.com/speakeasy-api/gram/infra/pkg/topics"
+)
+
+// Transport publishes and receives raw payloads on topics named by the proto
+// full name of the message they carry.
+type Transport interface {
+	// Publish stores data on topic for every subscription currently open on
+	// it. The result is settled by the time Publish returns.
+	Publish(ctx context.Context, topic string, data []byte, attrs map[string]string) gcp.PublishResult
+	// Provision creates subscriptions that do not exist yet so publishes fan
+	// out to them before their receivers first open. It is idempotent.
+	Provision(ctx context.Context, subscriptions []Subscription) error
+	// Receive opens subscription on topic, creating it if needed, and calls
+	// deliver for each message until ctx is cancelled. Delivery order is not
+	// guaranteed; Ack and Nack remain usable after ctx is cancelled so
+	// in-flight batches can settle during shutdown.
+	Receive(ctx context.Context, topic string, subscription string, deliver func(gcp.ReceivedMessage)) error
+	// Stop releases the transport's background resources.
+	Stop(ctx context.Context) error
+}
+
+// Subscription names a subscription and the topic it consumes, both by proto
+// full name as Receive takes them.
+type Subscription struct {
+	Topic string
+	Name  string
+	// Retention is how long an unacked message is kept for the subscription.
+	// Zero selects the transport's default.
+	Retention time.Duration
+}
+
+// DeclaredSubscriptions returns every subscription declared in descriptors, a
+// serialized FileDescriptorSet, sorted by topic and name.
+func DeclaredSubscriptions(descriptors []byte) ([]Subscription, error) {
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(descriptors, &set); err != nil {
+		return nil, fmt.Errorf("unmarshal descriptor set: %w", err)
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, fmt.Errorf("build proto file registry: %w", err)
+	}
+
+	var subs []Subscription
+	var walk func(protoreflect.MessageDescriptors)
+	walk = func(messages protoreflect.MessageDescriptors) {
+		for i := range messages.Len() {
+			message := messages.Get(i)
+			if options, ok := message.Options().(*descriptorpb.MessageOptions); ok && options != nil && proto.HasExtension(options, pubsubv1.E_Subscription) {
+				if sub, ok := proto.GetExtension(options, pubsubv1.E_Subscription).(*pubsubv1.SubscriptionOptions); ok && sub.GetTopic() != "" {
+					subs = append(subs, Subscription{
+						Topic:     sub.GetTopic(),
+						Name:      string(message.FullName()),
+						Retention: sub.GetRetention().AsDuration(),
+					})
+				}
+			}
+			walk(message.Messages())
+		}
+	}
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		walk(file.Messages())
+		return true
+	})
+
+	slices.SortFunc(subs, func(a, b Subscription) int {
+		return cmp.Or(cmp.Compare(a.Topic, b.Topic), cmp.Compare(a.Name, b.Name))
+	})
+
+	return subs, nil
+}
+
+// NewPublisher adapts t to a typed publisher for msg's topic. Attributes match
+// what the Pub/Sub publisher sets, including trace context from ctx, so
+// subscribers cannot tell which transport carried a message.
+func NewPublisher[M proto.Message](t Transport, msg M) gcp.Publisher[M] {
+	return &publisher[M]{
+		transport: t,
+		topic:     string(proto.MessageName(msg)),
+		prop:      otel.GetTextMapPropagator(),
+	}
+}
+
+type publisher[M proto.Message] struct {
+	transport Transport
+	topic     string
+	prop      propagation.TextMapPropagator
+}
+
+func (p *publisher[M]) Publish(ctx context.Context, msg M) gcp.PublishResult {
+	bs, err := proto.Marshal(msg)
+	if err != nil {
+		return gcp.NewErrPublishResult(fmt.Errorf("marshal proto: %w", err))
+	}
+
+	attrs := map[string]string{
+		"content-type": "application/x-protobuf",
+		"schema":       p.topic,
+	}
+	p.prop.Inject(ctx, propagation.MapCarrier(attrs))
+
+	return p.transport.Publish(ctx, p.topic, bs, attrs)
+}
+
+// Stop is a no-op: the transport is shared and stopped by its owner.
+func (p *publisher[M]) Stop(context.Context) error { return nil }
+
+// NewOutboxPublisher adapts t to the runtime-named publisher the outbox relay
+// drains into. Names are validated against the same registry topics.Mux uses,
+// so an unknown topic fails with topics.ErrUnknownTopic on either path.
+func NewOutboxPublisher(t Transport) topics.Publisher {
+	return &outboxPublisher{transport: t}
+}
+
+type outboxPublisher struct {
+	transport Transport
+}
+
+func (p *outboxPublisher) Publish(ctx context.Context, name string, data []byte, attrs map[string]string) gcp.PublishResult {
+	topic, ok := topics.Lookup(name)
+	if !ok {
+		return gcp.NewErrPublishResult(fmt.Errorf("%w: %s", topics.ErrUnknownTopic, name))
+	}
+
+	merged := make(map[string]string, len(attrs)+2)
+	maps.Copy(merged, attrs)
+	merged["content-type"] = "application/x-protobuf"
+	merged["schema"] = string(topic)
+
+	return p.transport.Publish(ctx, string(topic), data, merged)
+}
+
+// Stop is a no-op: the transport is shared and stopped by its owner.
+func (p *outboxPublisher) Stop(context.Context) error { return nil }
+
+// NewSubscriber adapts t to a typed subscriber. As with Pub/Sub, the topic is
+// named by msg and the subscription by the proto full name of the marker
+// message, so each consumer keeps the subscription identity it has today.
+func NewSubscriber[M proto.Message](t Transport, msg M, subscription proto.Message, options ...gcp.SubscriberOption) (gcp.Subscriber[M], error) {
+	topic := string(proto.MessageName(msg))
+	name := string(proto.MessageName(subscription))
+
+	sub, err := gcp.SubscriberForSource(msg, subscription, func(ctx context.Context, deliver func(gcp.ReceivedMessage)) error {
+		return t.Receive(ctx, topic, name, deliver)
+	}, options...)
+	if err != nil {
+		return nil, fmt.Errorf("new %s subscriber: %w", topic, err)
+	}
+
+	return sub, nil
+}
@@ -0,0 +1,27 @@
+package transport_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/infra/gen"
+	"github.com/speakeasy-api/gram/server/internal/transport"
+)
+
+func TestDeclaredSubscriptions_ReadsTopicAndRetentionFromDescriptors(t *testing.T) {
+	t.Parallel()
+
+	subs, err := transport.DeclaredSubscriptions(gen.Descriptors)
+	require.NoError(t, err)
+
+	var relay *transport.Subscription
+	for i := range subs {
+		if subs[i].Name == "gram.webhooks.v1.SvixRelay" {
+			relay = &subs[i]
+		}
+	}
+	require.NotNil(t, relay, "the Svix relay subscription must be declared")
+	require.Equal(t, "gram.webhooks.v1.Event", relay.Topic)
+	require.Positive(t, relay.Retention)
+}
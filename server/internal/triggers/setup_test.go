@@ -55,6 +55,10 @@ func (s stubEnvLoader) Load(_ context.Context, _ uuid.UUID, _ toolconfig.SlugOrI
 	return s.values, nil
 }
 
+func (s stubEnvLoader) ResolveSecretRefs(_ context.Context, _ uuid.UUID, _ *toolconfig.CaseInsensitiveEnv, _ []string) error {
+	return nil
+}
+
 type testInstance struct {
 	service       *triggers.Service
 	conn          *pgxpool.Pool
@@ -326,6 +326,7 @@ func (s *Service) probeListTools(probeCtx context.Context, serverURL string) *ge
 		Authorization:  "",
 		Headers:        nil,
 		DisableRetries: true,
+		Proxy:          nil,
 	})
 	if err != nil {
 		var authErr *externalmcp.AuthRejectedError
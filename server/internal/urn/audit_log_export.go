@@ -0,0 +1,156 @@
+package urn
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type AuditLogExport struct {
+	ID uuid.UUID
+
+	checked bool
+	err     error
+}
+
+func NewAuditLogExport(id uuid.UUID) AuditLogExport {
+	a := AuditLogExport{
+		ID:      id,
+		checked: false,
+		err:     nil,
+	}
+
+	_ = a.validate()
+
+	return a
+}
+
+func ParseAuditLogExport(value string) (AuditLogExport, error) {
+	if value == "" {
+		return AuditLogExport{}, fmt.Errorf("%w: empty string", ErrInvalid)
+	}
+
+	parts := strings.SplitN(value, delimiter, 2)
+	if len(parts) != 2 || parts[1] == "" || strings.Contains(parts[1], delimiter) {
+		return AuditLogExport{}, fmt.Errorf("%w: expected two segments (audit-log-export:<uuid>)", ErrInvalid)
+	}
+
+	if parts[0] != "audit-log-export" {
+		truncated := parts[0][:min(maxSegmentLength, len(parts[0]))]
+		return AuditLogExport{}, fmt.Errorf("%w: expected audit-log-export urn (got: %q)", ErrInvalid, truncated)
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return AuditLogExport{}, fmt.Errorf("%w: invalid audit-log-export uuid", ErrInvalid)
+	}
+
+	return NewAuditLogExport(id), nil
+}
+
+func (u AuditLogExport) IsZero() bool {
+	return u.ID == uuid.Nil
+}
+
+func (u AuditLogExport) String() string {
+	return "audit-log-export" + delimiter + u.ID.String()
+}
+
+func (u AuditLogExport) MarshalJSON() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(u.String())
+	if err != nil {
+		return nil, fmt.Errorf("audit-log-export urn to json: %w", err)
+	}
+
+	return b, nil
+}
+
+func (u *AuditLogExport) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("read audit-log-export urn string from json: %w", err)
+	}
+
+	parsed, err := ParseAuditLogExport(s)
+	if err != nil {
+		return fmt.Errorf("parse audit-log-export urn json string: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *AuditLogExport) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into AuditLogExport", value)
+	}
+
+	parsed, err := ParseAuditLogExport(s)
+	if err != nil {
+		return fmt.Errorf("scan database value: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u AuditLogExport) Value() (driver.Value, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	return u.String(), nil
+}
+
+func (u AuditLogExport) MarshalText() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, fmt.Errorf("marshal audit-log-export urn text: %w", err)
+	}
+
+	return []byte(u.String()), nil
+}
+
+func (u *AuditLogExport) UnmarshalText(text []byte) error {
+	parsed, err := ParseAuditLogExport(string(text))
+	if err != nil {
+		return fmt.Errorf("unmarshal audit-log-export urn text: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *AuditLogExport) validate() error {
+	if u.checked {
+		return u.err
+	}
+
+	u.checked = true
+
+	if u.ID == uuid.Nil {
+		u.err = fmt.Errorf("%w: empty id", ErrInvalid)
+		return u.err
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package urn
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type OtelForwardingDestination struct {
+	ID uuid.UUID
+
+	checked bool
+	err     error
+}
+
+func NewOtelForwardingDestination(id uuid.UUID) OtelForwardingDestination {
+	a := OtelForwardingDestination{
+		ID:      id,
+		checked: false,
+		err:     nil,
+	}
+
+	_ = a.validate()
+
+	return a
+}
+
+func ParseOtelForwardingDestination(value string) (OtelForwardingDestination, error) {
+	if value == "" {
+		return OtelForwardingDestination{}, fmt.Errorf("%w: empty string", ErrInvalid)
+	}
+
+	parts := strings.SplitN(value, delimiter, 2)
+	if len(parts) != 2 || parts[1] == "" || strings.Contains(parts[1], delimiter) {
+		return OtelForwardingDestination{}, fmt.Errorf("%w: expected two segments (otel_forwarding_destination:<uuid>)", ErrInvalid)
+	}
+
+	if parts[0] != "otel_forwarding_destination" {
+		truncated := parts[0][:min(maxSegmentLength, len(parts[0]))]
+		return OtelForwardingDestination{}, fmt.Errorf("%w: expected otel_forwarding_destination urn (got: %q)", ErrInvalid, truncated)
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return OtelForwardingDestination{}, fmt.Errorf("%w: invalid otel_forwarding_destination uuid", ErrInvalid)
+	}
+
+	return NewOtelForwardingDestination(id), nil
+}
+
+func (u OtelForwardingDestination) IsZero() bool {
+	return u.ID == uuid.Nil
+}
+
+func (u OtelForwardingDestination) String() string {
+	return "otel_forwarding_destination" + delimiter + u.ID.String()
+}
+
+func (u OtelForwardingDestination) MarshalJSON() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(u.String())
+	if err != nil {
+		return nil, fmt.Errorf("otel_forwarding_destination urn to json: %w", err)
+	}
+
+	return b, nil
+}
+
+func (u *OtelForwardingDestination) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("read otel_forwarding_destination urn string from json: %w", err)
+	}
+
+	parsed, err := ParseOtelForwardingDestination(s)
+	if err != nil {
+		return fmt.Errorf("parse otel_forwarding_destination urn json string: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *OtelForwardingDestination) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into OtelForwardingDestination", value)
+	}
+
+	parsed, err := ParseOtelForwardingDestination(s)
+	if err != nil {
+		return fmt.Errorf("scan database value: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u OtelForwardingDestination) Value() (driver.Value, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	return u.String(), nil
+}
+
+func (u OtelForwardingDestination) MarshalText() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, fmt.Errorf("marshal otel_forwarding_destination urn text: %w", err)
+	}
+
+	return []byte(u.String()), nil
+}
+
+func (u *OtelForwardingDestination) UnmarshalText(text []byte) error {
+	parsed, err := ParseOtelForwardingDestination(string(text))
+	if err != nil {
+		return fmt.Errorf("unmarshal otel_forwarding_destination urn text: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *OtelForwardingDestination) validate() error {
+	if u.checked {
+		return u.err
+	}
+
+	u.checked = true
+
+	if u.ID == uuid.Nil {
+		u.err = fmt.Errorf("%w: empty id", ErrInvalid)
+		return u.err
+	}
+
+	return nil
+}
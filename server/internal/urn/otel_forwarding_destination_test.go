@@ -0,0 +1,64 @@
+package urn_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOtelForwardingDestinationRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("44444444-4444-4444-4444-444444444444")
+	original := urn.NewOtelForwardingDestination(id)
+
+	require.Equal(t, "otel_forwarding_destination:44444444-4444-4444-4444-444444444444", original.String())
+
+	parsed, err := urn.ParseOtelForwardingDestination(original.String())
+	require.NoError(t, err)
+	require.Equal(t, original.ID, parsed.ID)
+
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+	require.Equal(t, `"otel_forwarding_destination:44444444-4444-4444-4444-444444444444"`, string(data))
+
+	var fromJSON urn.OtelForwardingDestination
+	err = json.Unmarshal(data, &fromJSON)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromJSON.ID)
+
+	text, err := original.MarshalText()
+	require.NoError(t, err)
+
+	var fromText urn.OtelForwardingDestination
+	err = fromText.UnmarshalText(text)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromText.ID)
+
+	value, err := original.Value()
+	require.NoError(t, err)
+
+	var fromDB urn.OtelForwardingDestination
+	err = fromDB.Scan(value)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromDB.ID)
+}
+
+func TestOtelForwardingDestinationRejectsInvalidValues(t *testing.T) {
+	t.Parallel()
+
+	_, err := urn.ParseOtelForwardingDestination("")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.ParseOtelForwardingDestination("toolset:44444444-4444-4444-4444-444444444444")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.ParseOtelForwardingDestination("otel_forwarding_destination:not-a-uuid")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.NewOtelForwardingDestination(uuid.Nil).MarshalJSON()
+	require.ErrorIs(t, err, urn.ErrInvalid)
+}
@@ -0,0 +1,156 @@
+package urn
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type TelemetryAlertRule struct {
+	ID uuid.UUID
+
+	checked bool
+	err     error
+}
+
+func NewTelemetryAlertRule(id uuid.UUID) TelemetryAlertRule {
+	a := TelemetryAlertRule{
+		ID:      id,
+		checked: false,
+		err:     nil,
+	}
+
+	_ = a.validate()
+
+	return a
+}
+
+func ParseTelemetryAlertRule(value string) (TelemetryAlertRule, error) {
+	if value == "" {
+		return TelemetryAlertRule{}, fmt.Errorf("%w: empty string", ErrInvalid)
+	}
+
+	parts := strings.SplitN(value, delimiter, 2)
+	if len(parts) != 2 || parts[1] == "" || strings.Contains(parts[1], delimiter) {
+		return TelemetryAlertRule{}, fmt.Errorf("%w: expected two segments (telemetry-alert-rule:<uuid>)", ErrInvalid)
+	}
+
+	if parts[0] != "telemetry-alert-rule" {
+		truncated := parts[0][:min(maxSegmentLength, len(parts[0]))]
+		return TelemetryAlertRule{}, fmt.Errorf("%w: expected telemetry-alert-rule urn (got: %q)", ErrInvalid, truncated)
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return TelemetryAlertRule{}, fmt.Errorf("%w: invalid telemetry-alert-rule uuid", ErrInvalid)
+	}
+
+	return NewTelemetryAlertRule(id), nil
+}
+
+func (u TelemetryAlertRule) IsZero() bool {
+	return u.ID == uuid.Nil
+}
+
+func (u TelemetryAlertRule) String() string {
+	return "telemetry-alert-rule" + delimiter + u.ID.String()
+}
+
+func (u TelemetryAlertRule) MarshalJSON() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(u.String())
+	if err != nil {
+		return nil, fmt.Errorf("telemetry-alert-rule urn to json: %w", err)
+	}
+
+	return b, nil
+}
+
+func (u *TelemetryAlertRule) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("read telemetry-alert-rule urn string from json: %w", err)
+	}
+
+	parsed, err := ParseTelemetryAlertRule(s)
+	if err != nil {
+		return fmt.Errorf("parse telemetry-alert-rule urn json string: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *TelemetryAlertRule) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into TelemetryAlertRule", value)
+	}
+
+	parsed, err := ParseTelemetryAlertRule(s)
+	if err != nil {
+		return fmt.Errorf("scan database value: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u TelemetryAlertRule) Value() (driver.Value, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	return u.String(), nil
+}
+
+func (u TelemetryAlertRule) MarshalText() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, fmt.Errorf("marshal telemetry-alert-rule urn text: %w", err)
+	}
+
+	return []byte(u.String()), nil
+}
+
+func (u *TelemetryAlertRule) UnmarshalText(text []byte) error {
+	parsed, err := ParseTelemetryAlertRule(string(text))
+	if err != nil {
+		return fmt.Errorf("unmarshal telemetry-alert-rule urn text: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *TelemetryAlertRule) validate() error {
+	if u.checked {
+		return u.err
+	}
+
+	u.checked = true
+
+	if u.ID == uuid.Nil {
+		u.err = fmt.Errorf("%w: empty id", ErrInvalid)
+		return u.err
+	}
+
+	return nil
+}
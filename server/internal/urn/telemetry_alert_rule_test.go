@@ -0,0 +1,64 @@
+package urn_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelemetryAlertRuleRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("44444444-4444-4444-4444-444444444444")
+	original := urn.NewTelemetryAlertRule(id)
+
+	require.Equal(t, "telemetry-alert-rule:44444444-4444-4444-4444-444444444444", original.String())
+
+	parsed, err := urn.ParseTelemetryAlertRule(original.String())
+	require.NoError(t, err)
+	require.Equal(t, original.ID, parsed.ID)
+
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+	require.Equal(t, `"telemetry-alert-rule:44444444-4444-4444-4444-444444444444"`, string(data))
+
+	var fromJSON urn.TelemetryAlertRule
+	err = json.Unmarshal(data, &fromJSON)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromJSON.ID)
+
+	text, err := original.MarshalText()
+	require.NoError(t, err)
+
+	var fromText urn.TelemetryAlertRule
+	err = fromText.UnmarshalText(text)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromText.ID)
+
+	value, err := original.Value()
+	require.NoError(t, err)
+
+	var fromDB urn.TelemetryAlertRule
+	err = fromDB.Scan(value)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromDB.ID)
+}
+
+func TestTelemetryAlertRuleRejectsInvalidValues(t *testing.T) {
+	t.Parallel()
+
+	_, err := urn.ParseTelemetryAlertRule("")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.ParseTelemetryAlertRule("toolset:44444444-4444-4444-4444-444444444444")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.ParseTelemetryAlertRule("telemetry-alert-rule:not-a-uuid")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.NewTelemetryAlertRule(uuid.Nil).MarshalJSON()
+	require.ErrorIs(t, err, urn.ErrInvalid)
+}
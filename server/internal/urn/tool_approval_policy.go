@@ -0,0 +1,156 @@
+package urn
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type ToolApprovalPolicy struct {
+	ID uuid.UUID
+
+	checked bool
+	err     error
+}
+
+func NewToolApprovalPolicy(id uuid.UUID) ToolApprovalPolicy {
+	a := ToolApprovalPolicy{
+		ID:      id,
+		checked: false,
+		err:     nil,
+	}
+
+	_ = a.validate()
+
+	return a
+}
+
+func ParseToolApprovalPolicy(value string) (ToolApprovalPolicy, error) {
+	if value == "" {
+		return ToolApprovalPolicy{}, fmt.Errorf("%w: empty string", ErrInvalid)
+	}
+
+	parts := strings.SplitN(value, delimiter, 2)
+	if len(parts) != 2 || parts[1] == "" || strings.Contains(parts[1], delimiter) {
+		return ToolApprovalPolicy{}, fmt.Errorf("%w: expected two segments (tool-approval-policy:<uuid>)", ErrInvalid)
+	}
+
+	if parts[0] != "tool-approval-policy" {
+		truncated := parts[0][:min(maxSegmentLength, len(parts[0]))]
+		return ToolApprovalPolicy{}, fmt.Errorf("%w: expected tool-approval-policy urn (got: %q)", ErrInvalid, truncated)
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return ToolApprovalPolicy{}, fmt.Errorf("%w: invalid tool-approval-policy uuid", ErrInvalid)
+	}
+
+	return NewToolApprovalPolicy(id), nil
+}
+
+func (u ToolApprovalPolicy) IsZero() bool {
+	return u.ID == uuid.Nil
+}
+
+func (u ToolApprovalPolicy) String() string {
+	return "tool-approval-policy" + delimiter + u.ID.String()
+}
+
+func (u ToolApprovalPolicy) MarshalJSON() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(u.String())
+	if err != nil {
+		return nil, fmt.Errorf("tool-approval-policy urn to json: %w", err)
+	}
+
+	return b, nil
+}
+
+func (u *ToolApprovalPolicy) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("read tool-approval-policy urn string from json: %w", err)
+	}
+
+	parsed, err := ParseToolApprovalPolicy(s)
+	if err != nil {
+		return fmt.Errorf("parse tool-approval-policy urn json string: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *ToolApprovalPolicy) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into ToolApprovalPolicy", value)
+	}
+
+	parsed, err := ParseToolApprovalPolicy(s)
+	if err != nil {
+		return fmt.Errorf("scan database value: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u ToolApprovalPolicy) Value() (driver.Value, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	return u.String(), nil
+}
+
+func (u ToolApprovalPolicy) MarshalText() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, fmt.Errorf("marshal tool-approval-policy urn text: %w", err)
+	}
+
+	return []byte(u.String()), nil
+}
+
+func (u *ToolApprovalPolicy) UnmarshalText(text []byte) error {
+	parsed, err := ParseToolApprovalPolicy(string(text))
+	if err != nil {
+		return fmt.Errorf("unmarshal tool-approval-policy urn text: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *ToolApprovalPolicy) validate() error {
+	if u.checked {
+		return u.err
+	}
+
+	u.checked = true
+
+	if u.ID == uuid.Nil {
+		u.err = fmt.Errorf("%w: empty id", ErrInvalid)
+		return u.err
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package urn_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolApprovalPolicyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("33333333-3333-3333-3333-333333333333")
+	original := urn.NewToolApprovalPolicy(id)
+
+	require.Equal(t, "tool-approval-policy:33333333-3333-3333-3333-333333333333", original.String())
+
+	parsed, err := urn.ParseToolApprovalPolicy(original.String())
+	require.NoError(t, err)
+	require.Equal(t, original.ID, parsed.ID)
+
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+	require.Equal(t, `"tool-approval-policy:33333333-3333-3333-3333-333333333333"`, string(data))
+
+	var fromJSON urn.ToolApprovalPolicy
+	err = json.Unmarshal(data, &fromJSON)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromJSON.ID)
+
+	text, err := original.MarshalText()
+	require.NoError(t, err)
+
+	var fromText urn.ToolApprovalPolicy
+	err = fromText.UnmarshalText(text)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromText.ID)
+
+	value, err := original.Value()
+	require.NoError(t, err)
+
+	var fromDB urn.ToolApprovalPolicy
+	err = fromDB.Scan(value)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromDB.ID)
+}
+
+func TestToolApprovalPolicyRejectsInvalidValues(t *testing.T) {
+	t.Parallel()
+
+	_, err := urn.ParseToolApprovalPolicy("")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.ParseToolApprovalPolicy("toolset:33333333-3333-3333-3333-333333333333")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.ParseToolApprovalPolicy("tool-approval-policy:not-a-uuid")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.NewToolApprovalPolicy(uuid.Nil).MarshalJSON()
+	require.ErrorIs(t, err, urn.ErrInvalid)
+}
@@ -0,0 +1,156 @@
+package urn
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type ToolConstraint struct {
+	ID uuid.UUID
+
+	checked bool
+	err     error
+}
+
+func NewToolConstraint(id uuid.UUID) ToolConstraint {
+	a := ToolConstraint{
+		ID:      id,
+		checked: false,
+		err:     nil,
+	}
+
+	_ = a.validate()
+
+	return a
+}
+
+func ParseToolConstraint(value string) (ToolConstraint, error) {
+	if value == "" {
+		return ToolConstraint{}, fmt.Errorf("%w: empty string", ErrInvalid)
+	}
+
+	parts := strings.SplitN(value, delimiter, 2)
+	if len(parts) != 2 || parts[1] == "" || strings.Contains(parts[1], delimiter) {
+		return ToolConstraint{}, fmt.Errorf("%w: expected two segments (tool-constraint:<uuid>)", ErrInvalid)
+	}
+
+	if parts[0] != "tool-constraint" {
+		truncated := parts[0][:min(maxSegmentLength, len(parts[0]))]
+		return ToolConstraint{}, fmt.Errorf("%w: expected tool-constraint urn (got: %q)", ErrInvalid, truncated)
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return ToolConstraint{}, fmt.Errorf("%w: invalid tool-constraint uuid", ErrInvalid)
+	}
+
+	return NewToolConstraint(id), nil
+}
+
+func (u ToolConstraint) IsZero() bool {
+	return u.ID == uuid.Nil
+}
+
+func (u ToolConstraint) String() string {
+	return "tool-constraint" + delimiter + u.ID.String()
+}
+
+func (u ToolConstraint) MarshalJSON() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(u.String())
+	if err != nil {
+		return nil, fmt.Errorf("tool-constraint urn to json: %w", err)
+	}
+
+	return b, nil
+}
+
+func (u *ToolConstraint) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("read tool-constraint urn string from json: %w", err)
+	}
+
+	parsed, err := ParseToolConstraint(s)
+	if err != nil {
+		return fmt.Errorf("parse tool-constraint urn json string: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *ToolConstraint) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into ToolConstraint", value)
+	}
+
+	parsed, err := ParseToolConstraint(s)
+	if err != nil {
+		return fmt.Errorf("scan database value: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u ToolConstraint) Value() (driver.Value, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	return u.String(), nil
+}
+
+func (u ToolConstraint) MarshalText() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, fmt.Errorf("marshal tool-constraint urn text: %w", err)
+	}
+
+	return []byte(u.String()), nil
+}
+
+func (u *ToolConstraint) UnmarshalText(text []byte) error {
+	parsed, err := ParseToolConstraint(string(text))
+	if err != nil {
+		return fmt.Errorf("unmarshal tool-constraint urn text: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *ToolConstraint) validate() error {
+	if u.checked {
+		return u.err
+	}
+
+	u.checked = true
+
+	if u.ID == uuid.Nil {
+		u.err = fmt.Errorf("%w: empty id", ErrInvalid)
+		return u.err
+	}
+
+	return nil
+}
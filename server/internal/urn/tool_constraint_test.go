@@ -0,0 +1,64 @@
+package urn_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolConstraintRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("33333333-3333-3333-3333-333333333333")
+	original := urn.NewToolConstraint(id)
+
+	require.Equal(t, "tool-constraint:33333333-3333-3333-3333-333333333333", original.String())
+
+	parsed, err := urn.ParseToolConstraint(original.String())
+	require.NoError(t, err)
+	require.Equal(t, original.ID, parsed.ID)
+
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+	require.Equal(t, `"tool-constraint:33333333-3333-3333-3333-333333333333"`, string(data))
+
+	var fromJSON urn.ToolConstraint
+	err = json.Unmarshal(data, &fromJSON)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromJSON.ID)
+
+	text, err := original.MarshalText()
+	require.NoError(t, err)
+
+	var fromText urn.ToolConstraint
+	err = fromText.UnmarshalText(text)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromText.ID)
+
+	value, err := original.Value()
+	require.NoError(t, err)
+
+	var fromDB urn.ToolConstraint
+	err = fromDB.Scan(value)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromDB.ID)
+}
+
+func TestToolConstraintRejectsInvalidValues(t *testing.T) {
+	t.Parallel()
+
+	_, err := urn.ParseToolConstraint("")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.ParseToolConstraint("toolset:33333333-3333-3333-3333-333333333333")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.ParseToolConstraint("tool-constraint:not-a-uuid")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.NewToolConstraint(uuid.Nil).MarshalJSON()
+	require.ErrorIs(t, err, urn.ErrInvalid)
+}
@@ -0,0 +1,156 @@
+package urn
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type ToolRateLimit struct {
+	ID uuid.UUID
+
+	checked bool
+	err     error
+}
+
+func NewToolRateLimit(id uuid.UUID) ToolRateLimit {
+	a := ToolRateLimit{
+		ID:      id,
+		checked: false,
+		err:     nil,
+	}
+
+	_ = a.validate()
+
+	return a
+}
+
+func ParseToolRateLimit(value string) (ToolRateLimit, error) {
+	if value == "" {
+		return ToolRateLimit{}, fmt.Errorf("%w: empty string", ErrInvalid)
+	}
+
+	parts := strings.SplitN(value, delimiter, 2)
+	if len(parts) != 2 || parts[1] == "" || strings.Contains(parts[1], delimiter) {
+		return ToolRateLimit{}, fmt.Errorf("%w: expected two segments (tool-rate-limit:<uuid>)", ErrInvalid)
+	}
+
+	if parts[0] != "tool-rate-limit" {
+		truncated := parts[0][:min(maxSegmentLength, len(parts[0]))]
+		return ToolRateLimit{}, fmt.Errorf("%w: expected tool-rate-limit urn (got: %q)", ErrInvalid, truncated)
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return ToolRateLimit{}, fmt.Errorf("%w: invalid tool-rate-limit uuid", ErrInvalid)
+	}
+
+	return NewToolRateLimit(id), nil
+}
+
+func (u ToolRateLimit) IsZero() bool {
+	return u.ID == uuid.Nil
+}
+
+func (u ToolRateLimit) String() string {
+	return "tool-rate-limit" + delimiter + u.ID.String()
+}
+
+func (u ToolRateLimit) MarshalJSON() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(u.String())
+	if err != nil {
+		return nil, fmt.Errorf("tool-rate-limit urn to json: %w", err)
+	}
+
+	return b, nil
+}
+
+func (u *ToolRateLimit) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("read tool-rate-limit urn string from json: %w", err)
+	}
+
+	parsed, err := ParseToolRateLimit(s)
+	if err != nil {
+		return fmt.Errorf("parse tool-rate-limit urn json string: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *ToolRateLimit) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into ToolRateLimit", value)
+	}
+
+	parsed, err := ParseToolRateLimit(s)
+	if err != nil {
+		return fmt.Errorf("scan database value: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u ToolRateLimit) Value() (driver.Value, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	return u.String(), nil
+}
+
+func (u ToolRateLimit) MarshalText() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, fmt.Errorf("marshal tool-rate-limit urn text: %w", err)
+	}
+
+	return []byte(u.String()), nil
+}
+
+func (u *ToolRateLimit) UnmarshalText(text []byte) error {
+	parsed, err := ParseToolRateLimit(string(text))
+	if err != nil {
+		return fmt.Errorf("unmarshal tool-rate-limit urn text: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *ToolRateLimit) validate() error {
+	if u.checked {
+		return u.err
+	}
+
+	u.checked = true
+
+	if u.ID == uuid.Nil {
+		u.err = fmt.Errorf("%w: empty id", ErrInvalid)
+		return u.err
+	}
+
+	return nil
+}
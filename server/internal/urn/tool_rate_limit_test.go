@@ -0,0 +1,64 @@
+package urn_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolRateLimitRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("33333333-3333-3333-3333-333333333333")
+	original := urn.NewToolRateLimit(id)
+
+	require.Equal(t, "tool-rate-limit:33333333-3333-3333-3333-333333333333", original.String())
+
+	parsed, err := urn.ParseToolRateLimit(original.String())
+	require.NoError(t, err)
+	require.Equal(t, original.ID, parsed.ID)
+
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+	require.Equal(t, `"tool-rate-limit:33333333-3333-3333-3333-333333333333"`, string(data))
+
+	var fromJSON urn.ToolRateLimit
+	err = json.Unmarshal(data, &fromJSON)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromJSON.ID)
+
+	text, err := original.MarshalText()
+	require.NoError(t, err)
+
+	var fromText urn.ToolRateLimit
+	err = fromText.UnmarshalText(text)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromText.ID)
+
+	value, err := original.Value()
+	require.NoError(t, err)
+
+	var fromDB urn.ToolRateLimit
+	err = fromDB.Scan(value)
+	require.NoError(t, err)
+	require.Equal(t, original.ID, fromDB.ID)
+}
+
+func TestToolRateLimitRejectsInvalidValues(t *testing.T) {
+	t.Parallel()
+
+	_, err := urn.ParseToolRateLimit("")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.ParseToolRateLimit("toolset:33333333-3333-3333-3333-333333333333")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.ParseToolRateLimit("tool-rate-limit:not-a-uuid")
+	require.ErrorIs(t, err, urn.ErrInvalid)
+
+	_, err = urn.NewToolRateLimit(uuid.Nil).MarshalJSON()
+	require.ErrorIs(t, err, urn.ErrInvalid)
+}
@@ -0,0 +1,156 @@
+package urn
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type WebhookEndpoint struct {
+	ID uuid.UUID
+
+	checked bool
+	err     error
+}
+
+func NewWebhookEndpoint(id uuid.UUID) WebhookEndpoint {
+	a := WebhookEndpoint{
+		ID:      id,
+		checked: false,
+		err:     nil,
+	}
+
+	_ = a.validate()
+
+	return a
+}
+
+func ParseWebhookEndpoint(value string) (WebhookEndpoint, error) {
+	if value == "" {
+		return WebhookEndpoint{}, fmt.Errorf("%w: empty string", ErrInvalid)
+	}
+
+	parts := strings.SplitN(value, delimiter, 2)
+	if len(parts) != 2 || parts[1] == "" || strings.Contains(parts[1], delimiter) {
+		return WebhookEndpoint{}, fmt.Errorf("%w: expected two segments (webhook-endpoint:<uuid>)", ErrInvalid)
+	}
+
+	if parts[0] != "webhook-endpoint" {
+		truncated := parts[0][:min(maxSegmentLength, len(parts[0]))]
+		return WebhookEndpoint{}, fmt.Errorf("%w: expected webhook-endpoint urn (got: %q)", ErrInvalid, truncated)
+	}
+
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return WebhookEndpoint{}, fmt.Errorf("%w: invalid webhook-endpoint uuid", ErrInvalid)
+	}
+
+	return NewWebhookEndpoint(id), nil
+}
+
+func (u WebhookEndpoint) IsZero() bool {
+	return u.ID == uuid.Nil
+}
+
+func (u WebhookEndpoint) String() string {
+	return "webhook-endpoint" + delimiter + u.ID.String()
+}
+
+func (u WebhookEndpoint) MarshalJSON() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(u.String())
+	if err != nil {
+		return nil, fmt.Errorf("webhook-endpoint urn to json: %w", err)
+	}
+
+	return b, nil
+}
+
+func (u *WebhookEndpoint) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("read webhook-endpoint urn string from json: %w", err)
+	}
+
+	parsed, err := ParseWebhookEndpoint(s)
+	if err != nil {
+		return fmt.Errorf("parse webhook-endpoint urn json string: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *WebhookEndpoint) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into WebhookEndpoint", value)
+	}
+
+	parsed, err := ParseWebhookEndpoint(s)
+	if err != nil {
+		return fmt.Errorf("scan database value: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u WebhookEndpoint) Value() (driver.Value, error) {
+	if err := u.validate(); err != nil {
+		return nil, err
+	}
+
+	return u.String(), nil
+}
+
+func (u WebhookEndpoint) MarshalText() ([]byte, error) {
+	if err := u.validate(); err != nil {
+		return nil, fmt.Errorf("marshal webhook-endpoint urn text: %w", err)
+	}
+
+	return []byte(u.String()), nil
+}
+
+func (u *WebhookEndpoint) UnmarshalText(text []byte) error {
+	parsed, err := ParseWebhookEndpoint(string(text))
+	if err != nil {
+		return fmt.Errorf("unmarshal webhook-endpoint urn text: %w", err)
+	}
+
+	*u = parsed
+
+	return nil
+}
+
+func (u *WebhookEndpoint) validate() error {
+	if u.checked {
+		return u.err
+	}
+
+	u.checked = true
+
+	if u.ID == uuid.Nil {
+		u.err = fmt.Errorf("%w: empty id", ErrInvalid)
+		return u.err
+	}
+
+	return nil
+}
@@ -118,6 +118,7 @@ func newTestServiceWithRevoker(t *testing.T, revoker usersessions.TokenRevoker,
 		audit.NewLogger(),
 		guardianPolicy,
 		testenv.NewEncryptionClient(t),
+		nil,
 		usersessions.NewSigner("test-jwt-secret"),
 		"http://0.0.0.0",
 		ratelimit.NewRedisStore(redisClient),
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,6 +30,7 @@ import (
 	"github.com/speakeasy-api/gram/server/internal/middleware"
 	"github.com/speakeasy-api/gram/server/internal/o11y"
 	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/toolconfig"
 	"github.com/speakeasy-api/gram/server/internal/urn"
 	"github.com/speakeasy-api/gram/server/internal/variations/repo"
 )
@@ -96,24 +99,32 @@ func (s *Service) ListGlobal(ctx context.Context, payload *gen.ListGlobalPayload
 
 	variations := make([]*types.ToolVariation, 0, len(rows))
 	for _, row := range rows {
+		argumentBindings, err := conv.ArgumentBindingsFromJSON(row.ToolVariation.ArgumentBindings)
+		if err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "error decoding tool variation argument bindings").LogError(ctx, s.logger)
+		}
+
 		variations = append(variations, &types.ToolVariation{
-			ID:              row.ToolVariation.ID.String(),
-			GroupID:         row.ToolVariation.GroupID.String(),
-			SrcToolUrn:      row.ToolVariation.SrcToolUrn.String(),
-			SrcToolName:     row.ToolVariation.SrcToolName,
-			Confirm:         conv.FromPGText[string](row.ToolVariation.Confirm),
-			ConfirmPrompt:   conv.FromPGText[string](row.ToolVariation.ConfirmPrompt),
-			Name:            conv.FromPGText[string](row.ToolVariation.Name),
-			Description:     conv.FromPGText[string](row.ToolVariation.Description),
-			Tags:            row.ToolVariation.Tags,
-			Summarizer:      conv.FromPGText[string](row.ToolVariation.Summarizer),
-			Title:           conv.FromPGText[string](row.ToolVariation.Title),
-			ReadOnlyHint:    conv.FromPGBool[bool](row.ToolVariation.ReadOnlyHint),
-			DestructiveHint: conv.FromPGBool[bool](row.ToolVariation.DestructiveHint),
-			IdempotentHint:  conv.FromPGBool[bool](row.ToolVariation.IdempotentHint),
-			OpenWorldHint:   conv.FromPGBool[bool](row.ToolVariation.OpenWorldHint),
-			CreatedAt:       row.ToolVariation.CreatedAt.Time.Format(time.RFC3339),
-			UpdatedAt:       row.ToolVariation.UpdatedAt.Time.Format(time.RFC3339),
+			ID:               row.ToolVariation.ID.String(),
+			GroupID:          row.ToolVariation.GroupID.String(),
+			SrcToolUrn:       row.ToolVariation.SrcToolUrn.String(),
+			SrcToolName:      row.ToolVariation.SrcToolName,
+			Confirm:          conv.FromPGText[string](row.ToolVariation.Confirm),
+			ConfirmPrompt:    conv.FromPGText[string](row.ToolVariation.ConfirmPrompt),
+			Name:             conv.FromPGText[string](row.ToolVariation.Name),
+			Description:      conv.FromPGText[string](row.ToolVariation.Description),
+			Tags:             row.ToolVariation.Tags,
+			Summarizer:       conv.FromPGText[string](row.ToolVariation.Summarizer),
+			Title:            conv.FromPGText[string](row.ToolVariation.Title),
+			ReadOnlyHint:     conv.FromPGBool[bool](row.ToolVariation.ReadOnlyHint),
+			DestructiveHint:  conv.FromPGBool[bool](row.ToolVariation.DestructiveHint),
+			IdempotentHint:   conv.FromPGBool[bool](row.ToolVariation.IdempotentHint),
+			OpenWorldHint:    conv.FromPGBool[bool](row.ToolVariation.OpenWorldHint),
+			CacheTTLSeconds:  conv.FromPGInt4(row.ToolVariation.CacheTtlSeconds),
+			CacheShared:      conv.FromPGBool[bool](row.ToolVariation.CacheShared),
+			ArgumentBindings: argumentBindings,
+			CreatedAt:        row.ToolVariation.CreatedAt.Time.Format(time.RFC3339),
+			UpdatedAt:        row.ToolVariation.UpdatedAt.Time.Format(time.RFC3339),
 		})
 	}
 
@@ -255,10 +266,10 @@ func (s *Service) UpsertGlobal(ctx context.Context, payload *gen.UpsertGlobalPay
 	case 0:
 		// No existing variation, will create new one.
 	case 1:
-		existing = toVariation(existingVariations[0])
+		existing, err = toVariation(existingVariations[0])
 	default:
 		// Multiple existing variations with the same source tool urn is unexpected, will log a warning and update the first one.
-		existing = toVariation(existingVariations[0])
+		existing, err = toVariation(existingVariations[0])
 		logger.WarnContext(
 			ctx,
 			"multiple active global tool variations found with same source tool urn",
@@ -267,29 +278,46 @@ func (s *Service) UpsertGlobal(ctx context.Context, payload *gen.UpsertGlobalPay
 			attr.SlogToolURN(srcToolUrn.String()),
 		)
 	}
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error decoding existing global tool variation").LogError(ctx, logger)
+	}
+
+	if err := validateArgumentBindings(payload.ArgumentBindings); err != nil {
+		return nil, err
+	}
+	argumentBindings, err := conv.ArgumentBindingsToJSON(payload.ArgumentBindings)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error encoding argument bindings").LogError(ctx, logger)
+	}
 
 	row, err := tx.UpsertToolVariation(ctx, repo.UpsertToolVariationParams{
-		GroupID:         groupID,
-		SrcToolUrn:      srcToolUrn,
-		SrcToolName:     payload.SrcToolName,
-		Confirm:         conv.PtrToPGText(payload.Confirm),
-		ConfirmPrompt:   conv.PtrToPGText(payload.ConfirmPrompt),
-		Name:            conv.PtrToPGText(payload.Name),
-		Summary:         conv.PtrToPGText(payload.Summary),
-		Description:     conv.PtrToPGText(payload.Description),
-		Tags:            payload.Tags,
-		Summarizer:      conv.PtrToPGText(payload.Summarizer),
-		Title:           conv.PtrToPGText(payload.Title),
-		ReadOnlyHint:    conv.PtrToPGBool(payload.ReadOnlyHint),
-		DestructiveHint: conv.PtrToPGBool(payload.DestructiveHint),
-		IdempotentHint:  conv.PtrToPGBool(payload.IdempotentHint),
-		OpenWorldHint:   conv.PtrToPGBool(payload.OpenWorldHint),
+		GroupID:          groupID,
+		SrcToolUrn:       srcToolUrn,
+		SrcToolName:      payload.SrcToolName,
+		Confirm:          conv.PtrToPGText(payload.Confirm),
+		ConfirmPrompt:    conv.PtrToPGText(payload.ConfirmPrompt),
+		Name:             conv.PtrToPGText(payload.Name),
+		Summary:          conv.PtrToPGText(payload.Summary),
+		Description:      conv.PtrToPGText(payload.Description),
+		Tags:             payload.Tags,
+		Summarizer:       conv.PtrToPGText(payload.Summarizer),
+		Title:            conv.PtrToPGText(payload.Title),
+		ReadOnlyHint:     conv.PtrToPGBool(payload.ReadOnlyHint),
+		DestructiveHint:  conv.PtrToPGBool(payload.DestructiveHint),
+		IdempotentHint:   conv.PtrToPGBool(payload.IdempotentHint),
+		OpenWorldHint:    conv.PtrToPGBool(payload.OpenWorldHint),
+		CacheTtlSeconds:  conv.PtrToPGInt4(payload.CacheTTLSeconds),
+		CacheShared:      conv.PtrToPGBool(payload.CacheShared),
+		ArgumentBindings: argumentBindings,
 	})
 	if err != nil {
 		return nil, oops.E(oops.CodeUnexpected, err, "error upserting global tool variation").LogError(ctx, logger)
 	}
 
-	result := toVariation(row)
+	result, err := toVariation(row)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "error decoding global tool variation").LogError(ctx, logger)
+	}
 
 	if err := s.audit.LogVariationUpdateGlobal(ctx, dbtx, audit.LogVariationUpdateGlobalEvent{
 		OrganizationID:          authCtx.ActiveOrganizationID,
@@ -377,24 +405,58 @@ func toGroup(row repo.ToolVariationsGroup) *types.ToolVariationGroup {
 	}
 }
 
-func toVariation(row repo.ToolVariation) *types.ToolVariation {
+func toVariation(row repo.ToolVariation) (*types.ToolVariation, error) {
+	argumentBindings, err := conv.ArgumentBindingsFromJSON(row.ArgumentBindings)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.ToolVariation{
-		ID:              row.ID.String(),
-		GroupID:         row.GroupID.String(),
-		SrcToolUrn:      row.SrcToolUrn.String(),
-		SrcToolName:     row.SrcToolName,
-		Confirm:         conv.FromPGText[string](row.Confirm),
-		ConfirmPrompt:   conv.FromPGText[string](row.ConfirmPrompt),
-		Name:            conv.FromPGText[string](row.Name),
-		Description:     conv.FromPGText[string](row.Description),
-		Tags:            row.Tags,
-		Summarizer:      conv.FromPGText[string](row.Summarizer),
-		Title:           conv.FromPGText[string](row.Title),
-		ReadOnlyHint:    conv.FromPGBool[bool](row.ReadOnlyHint),
-		DestructiveHint: conv.FromPGBool[bool](row.DestructiveHint),
-		IdempotentHint:  conv.FromPGBool[bool](row.IdempotentHint),
-		OpenWorldHint:   conv.FromPGBool[bool](row.OpenWorldHint),
-		CreatedAt:       row.CreatedAt.Time.Format(time.RFC3339),
-		UpdatedAt:       row.UpdatedAt.Time.Format(time.RFC3339),
+		ID:               row.ID.String(),
+		GroupID:          row.GroupID.String(),
+		SrcToolUrn:       row.SrcToolUrn.String(),
+		SrcToolName:      row.SrcToolName,
+		Confirm:          conv.FromPGText[string](row.Confirm),
+		ConfirmPrompt:    conv.FromPGText[string](row.ConfirmPrompt),
+		Name:             conv.FromPGText[string](row.Name),
+		Description:      conv.FromPGText[string](row.Description),
+		Tags:             row.Tags,
+		Summarizer:       conv.FromPGText[string](row.Summarizer),
+		Title:            conv.FromPGText[string](row.Title),
+		ReadOnlyHint:     conv.FromPGBool[bool](row.ReadOnlyHint),
+		DestructiveHint:  conv.FromPGBool[bool](row.DestructiveHint),
+		IdempotentHint:   conv.FromPGBool[bool](row.IdempotentHint),
+		OpenWorldHint:    conv.FromPGBool[bool](row.OpenWorldHint),
+		CacheTTLSeconds:  conv.FromPGInt4(row.CacheTtlSeconds),
+		CacheShared:      conv.FromPGBool[bool](row.CacheShared),
+		ArgumentBindings: argumentBindings,
+		CreatedAt:        row.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:        row.UpdatedAt.Time.Format(time.RFC3339),
+	}, nil
+}
+
+// validateArgumentBindings rejects bindings that could never be applied: paths
+// with empty segments, arguments bound twice and principal bindings to claims
+// the gateway does not know.
+func validateArgumentBindings(bindings []*types.ToolArgumentBinding) error {
+	seen := make(map[string]struct{}, len(bindings))
+	for _, binding := range bindings {
+		if binding == nil {
+			continue
+		}
+
+		if slices.Contains(strings.Split(binding.Argument, "."), "") {
+			return oops.E(oops.CodeBadRequest, nil, "invalid bound argument path %q", binding.Argument)
+		}
+		if _, ok := seen[binding.Argument]; ok {
+			return oops.E(oops.CodeBadRequest, nil, "argument %q is bound more than once", binding.Argument)
+		}
+		seen[binding.Argument] = struct{}{}
+
+		if binding.Source == "principal" && !slices.Contains(toolconfig.PrincipalClaims, binding.Value) {
+			return oops.E(oops.CodeBadRequest, nil, "unsupported principal claim %q, expected one of: %s", binding.Value, strings.Join(toolconfig.PrincipalClaims, ", "))
+		}
 	}
+
+	return nil
 }
@@ -52,7 +52,10 @@ INSERT INTO tool_variations (
   read_only_hint,
   destructive_hint,
   idempotent_hint,
-  open_world_hint
+  open_world_hint,
+  cache_ttl_seconds,
+  cache_shared,
+  argument_bindings
 ) VALUES (
   @group_id,
   @src_tool_urn,
@@ -68,7 +71,10 @@ INSERT INTO tool_variations (
   @read_only_hint,
   @destructive_hint,
   @idempotent_hint,
-  @open_world_hint
+  @open_world_hint,
+  @cache_ttl_seconds,
+  @cache_shared,
+  @argument_bindings
 ) ON CONFLICT (group_id, src_tool_urn) WHERE deleted IS FALSE DO UPDATE SET
   confirm = EXCLUDED.confirm,
   confirm_prompt = EXCLUDED.confirm_prompt,
@@ -82,6 +88,9 @@ INSERT INTO tool_variations (
   destructive_hint = EXCLUDED.destructive_hint,
   idempotent_hint = EXCLUDED.idempotent_hint,
   open_world_hint = EXCLUDED.open_world_hint,
+  cache_ttl_seconds = EXCLUDED.cache_ttl_seconds,
+  cache_shared = EXCLUDED.cache_shared,
+  argument_bindings = EXCLUDED.argument_bindings,
   updated_at = clock_timestamp()
 RETURNING *;
 
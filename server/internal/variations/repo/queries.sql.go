@@ -52,7 +52,7 @@ WITH global_group AS (
   ORDER BY project_tool_variations.id DESC
   LIMIT 1
 )
-SELECT id, group_id, src_tool_urn, src_tool_name, confirm, confirm_prompt, name, summary, description, tags, summarizer, title, read_only_hint, destructive_hint, idempotent_hint, open_world_hint, created_at, updated_at, deleted_at, deleted
+SELECT id, group_id, src_tool_urn, src_tool_name, confirm, confirm_prompt, name, summary, description, tags, summarizer, title, read_only_hint, destructive_hint, idempotent_hint, open_world_hint, cache_ttl_seconds, cache_shared, argument_bindings, created_at, updated_at, deleted_at, deleted
 FROM tool_variations
 WHERE
   group_id = (SELECT id FROM global_group)
@@ -91,6 +91,9 @@ func (q *Queries) FindGlobalVariationsByToolURNs(ctx context.Context, arg FindGl
 			&i.DestructiveHint,
 			&i.IdempotentHint,
 			&i.OpenWorldHint,
+			&i.CacheTtlSeconds,
+			&i.CacheShared,
+			&i.ArgumentBindings,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -208,7 +211,7 @@ func (q *Queries) InitGlobalToolVariationsGroup(ctx context.Context, arg InitGlo
 }
 
 const listByGroupIDAndToolURNs = `-- name: ListByGroupIDAndToolURNs :many
-SELECT tool_variations.id, tool_variations.group_id, tool_variations.src_tool_urn, tool_variations.src_tool_name, tool_variations.confirm, tool_variations.confirm_prompt, tool_variations.name, tool_variations.summary, tool_variations.description, tool_variations.tags, tool_variations.summarizer, tool_variations.title, tool_variations.read_only_hint, tool_variations.destructive_hint, tool_variations.idempotent_hint, tool_variations.open_world_hint, tool_variations.created_at, tool_variations.updated_at, tool_variations.deleted_at, tool_variations.deleted
+SELECT tool_variations.id, tool_variations.group_id, tool_variations.src_tool_urn, tool_variations.src_tool_name, tool_variations.confirm, tool_variations.confirm_prompt, tool_variations.name, tool_variations.summary, tool_variations.description, tool_variations.tags, tool_variations.summarizer, tool_variations.title, tool_variations.read_only_hint, tool_variations.destructive_hint, tool_variations.idempotent_hint, tool_variations.open_world_hint, tool_variations.cache_ttl_seconds, tool_variations.cache_shared, tool_variations.argument_bindings, tool_variations.created_at, tool_variations.updated_at, tool_variations.deleted_at, tool_variations.deleted
 FROM tool_variations
 INNER JOIN tool_variations_groups
   ON tool_variations.group_id = tool_variations_groups.id
@@ -254,6 +257,9 @@ func (q *Queries) ListByGroupIDAndToolURNs(ctx context.Context, arg ListByGroupI
 			&i.DestructiveHint,
 			&i.IdempotentHint,
 			&i.OpenWorldHint,
+			&i.CacheTtlSeconds,
+			&i.CacheShared,
+			&i.ArgumentBindings,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -270,7 +276,7 @@ func (q *Queries) ListByGroupIDAndToolURNs(ctx context.Context, arg ListByGroupI
 }
 
 const listGlobalToolVariations = `-- name: ListGlobalToolVariations :many
-SELECT tool_variations.id, tool_variations.group_id, tool_variations.src_tool_urn, tool_variations.src_tool_name, tool_variations.confirm, tool_variations.confirm_prompt, tool_variations.name, tool_variations.summary, tool_variations.description, tool_variations.tags, tool_variations.summarizer, tool_variations.title, tool_variations.read_only_hint, tool_variations.destructive_hint, tool_variations.idempotent_hint, tool_variations.open_world_hint, tool_variations.created_at, tool_variations.updated_at, tool_variations.deleted_at, tool_variations.deleted
+SELECT tool_variations.id, tool_variations.group_id, tool_variations.src_tool_urn, tool_variations.src_tool_name, tool_variations.confirm, tool_variations.confirm_prompt, tool_variations.name, tool_variations.summary, tool_variations.description, tool_variations.tags, tool_variations.summarizer, tool_variations.title, tool_variations.read_only_hint, tool_variations.destructive_hint, tool_variations.idempotent_hint, tool_variations.open_world_hint, tool_variations.cache_ttl_seconds, tool_variations.cache_shared, tool_variations.argument_bindings, tool_variations.created_at, tool_variations.updated_at, tool_variations.deleted_at, tool_variations.deleted
 FROM tool_variations
 INNER JOIN tool_variations_groups
   ON tool_variations.group_id = tool_variations_groups.id
@@ -312,6 +318,9 @@ func (q *Queries) ListGlobalToolVariations(ctx context.Context, projectID uuid.U
 			&i.ToolVariation.DestructiveHint,
 			&i.ToolVariation.IdempotentHint,
 			&i.ToolVariation.OpenWorldHint,
+			&i.ToolVariation.CacheTtlSeconds,
+			&i.ToolVariation.CacheShared,
+			&i.ToolVariation.ArgumentBindings,
 			&i.ToolVariation.CreatedAt,
 			&i.ToolVariation.UpdatedAt,
 			&i.ToolVariation.DeletedAt,
@@ -395,7 +404,10 @@ INSERT INTO tool_variations (
   read_only_hint,
   destructive_hint,
   idempotent_hint,
-  open_world_hint
+  open_world_hint,
+  cache_ttl_seconds,
+  cache_shared,
+  argument_bindings
 ) VALUES (
   $1,
   $2,
@@ -411,7 +423,10 @@ INSERT INTO tool_variations (
   $12,
   $13,
   $14,
-  $15
+  $15,
+  $16,
+  $17,
+  $18
 ) ON CONFLICT (group_id, src_tool_urn) WHERE deleted IS FALSE DO UPDATE SET
   confirm = EXCLUDED.confirm,
   confirm_prompt = EXCLUDED.confirm_prompt,
@@ -425,26 +440,32 @@ INSERT INTO tool_variations (
   destructive_hint = EXCLUDED.destructive_hint,
   idempotent_hint = EXCLUDED.idempotent_hint,
   open_world_hint = EXCLUDED.open_world_hint,
+  cache_ttl_seconds = EXCLUDED.cache_ttl_seconds,
+  cache_shared = EXCLUDED.cache_shared,
+  argument_bindings = EXCLUDED.argument_bindings,
   updated_at = clock_timestamp()
-RETURNING id, group_id, src_tool_urn, src_tool_name, confirm, confirm_prompt, name, summary, description, tags, summarizer, title, read_only_hint, destructive_hint, idempotent_hint, open_world_hint, created_at, updated_at, deleted_at, deleted
+RETURNING id, group_id, src_tool_urn, src_tool_name, confirm, confirm_prompt, name, summary, description, tags, summarizer, title, read_only_hint, destructive_hint, idempotent_hint, open_world_hint, cache_ttl_seconds, cache_shared, argument_bindings, created_at, updated_at, deleted_at, deleted
 `
 
 type UpsertToolVariationParams struct {
-	GroupID         uuid.UUID
-	SrcToolUrn      urn.Tool
-	SrcToolName     string
-	Confirm         pgtype.Text
-	ConfirmPrompt   pgtype.Text
-	Name            pgtype.Text
-	Summary         pgtype.Text
-	Description     pgtype.Text
-	Tags            []string
-	Summarizer      pgtype.Text
-	Title           pgtype.Text
-	ReadOnlyHint    pgtype.Bool
-	DestructiveHint pgtype.Bool
-	IdempotentHint  pgtype.Bool
-	OpenWorldHint   pgtype.Bool
+	GroupID          uuid.UUID
+	SrcToolUrn       urn.Tool
+	SrcToolName      string
+	Confirm          pgtype.Text
+	ConfirmPrompt    pgtype.Text
+	Name             pgtype.Text
+	Summary          pgtype.Text
+	Description      pgtype.Text
+	Tags             []string
+	Summarizer       pgtype.Text
+	Title            pgtype.Text
+	ReadOnlyHint     pgtype.Bool
+	DestructiveHint  pgtype.Bool
+	IdempotentHint   pgtype.Bool
+	OpenWorldHint    pgtype.Bool
+	CacheTtlSeconds  pgtype.Int4
+	CacheShared      pgtype.Bool
+	ArgumentBindings []byte
 }
 
 func (q *Queries) UpsertToolVariation(ctx context.Context, arg UpsertToolVariationParams) (ToolVariation, error) {
@@ -464,6 +485,9 @@ func (q *Queries) UpsertToolVariation(ctx context.Context, arg UpsertToolVariati
 		arg.DestructiveHint,
 		arg.IdempotentHint,
 		arg.OpenWorldHint,
+		arg.CacheTtlSeconds,
+		arg.CacheShared,
+		arg.ArgumentBindings,
 	)
 	var i ToolVariation
 	err := row.Scan(
@@ -483,6 +507,9 @@ func (q *Queries) UpsertToolVariation(ctx context.Context, arg UpsertToolVariati
 		&i.DestructiveHint,
 		&i.IdempotentHint,
 		&i.OpenWorldHint,
+		&i.CacheTtlSeconds,
+		&i.CacheShared,
+		&i.ArgumentBindings,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
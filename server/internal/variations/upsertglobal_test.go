@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/speakeasy-api/gram/server/gen/types"
 	gen "github.com/speakeasy-api/gram/server/gen/variations"
 	"github.com/speakeasy-api/gram/server/internal/audit"
 	"github.com/speakeasy-api/gram/server/internal/audit/audittest"
@@ -208,6 +209,88 @@ func TestVariationsService_UpsertGlobal_MinimalPayload(t *testing.T) {
 	require.NotEmpty(t, result.Variation.UpdatedAt, "updated at should not be empty")
 }
 
+func TestVariationsService_UpsertGlobal_ResponseCache(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestVariationsService(t)
+
+	ttl := int32(300)
+	shared := true
+
+	result, err := ti.service.UpsertGlobal(ctx, &gen.UpsertGlobalPayload{
+		ApikeyToken:      nil,
+		SessionToken:     nil,
+		ProjectSlugInput: nil,
+		SrcToolUrn:       "tools:http:test:cached-tool",
+		SrcToolName:      "cached-tool",
+		CacheTTLSeconds:  &ttl,
+		CacheShared:      &shared,
+	})
+	require.NoError(t, err, "upsert with cache settings should not error")
+	require.Equal(t, &ttl, result.Variation.CacheTTLSeconds, "cache ttl should match")
+	require.Equal(t, &shared, result.Variation.CacheShared, "cache shared should match")
+
+	// Clearing the TTL turns caching back off for the tool.
+	result, err = ti.service.UpsertGlobal(ctx, &gen.UpsertGlobalPayload{
+		ApikeyToken:      nil,
+		SessionToken:     nil,
+		ProjectSlugInput: nil,
+		SrcToolUrn:       "tools:http:test:cached-tool",
+		SrcToolName:      "cached-tool",
+		CacheTTLSeconds:  nil,
+		CacheShared:      nil,
+	})
+	require.NoError(t, err, "upsert without cache settings should not error")
+	require.Nil(t, result.Variation.CacheTTLSeconds, "cache ttl should be cleared")
+	require.Nil(t, result.Variation.CacheShared, "cache shared should be cleared")
+}
+
+func TestVariationsService_UpsertGlobal_ArgumentBindings(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestVariationsService(t)
+
+	bindings := []*types.ToolArgumentBinding{
+		{Argument: "body.tenant_id", Source: "principal", Value: "organization_id"},
+		{Argument: "queryParameters.region", Source: "constant", Value: `"eu"`},
+	}
+
+	result, err := ti.service.UpsertGlobal(ctx, &gen.UpsertGlobalPayload{
+		ApikeyToken:      nil,
+		SessionToken:     nil,
+		ProjectSlugInput: nil,
+		SrcToolUrn:       "tools:http:test:bound-tool",
+		SrcToolName:      "bound-tool",
+		ArgumentBindings: bindings,
+	})
+	require.NoError(t, err, "upsert with argument bindings should not error")
+	require.Equal(t, bindings, result.Variation.ArgumentBindings, "argument bindings should round-trip")
+
+	_, err = ti.service.UpsertGlobal(ctx, &gen.UpsertGlobalPayload{
+		ApikeyToken:      nil,
+		SessionToken:     nil,
+		ProjectSlugInput: nil,
+		SrcToolUrn:       "tools:http:test:bound-tool",
+		SrcToolName:      "bound-tool",
+		ArgumentBindings: []*types.ToolArgumentBinding{
+			{Argument: "body.tenant_id", Source: "principal", Value: "groups"},
+		},
+	})
+	require.Error(t, err, "binding an unknown principal claim should be rejected")
+
+	_, err = ti.service.UpsertGlobal(ctx, &gen.UpsertGlobalPayload{
+		ApikeyToken:      nil,
+		SessionToken:     nil,
+		ProjectSlugInput: nil,
+		SrcToolUrn:       "tools:http:test:bound-tool",
+		SrcToolName:      "bound-tool",
+		ArgumentBindings: []*types.ToolArgumentBinding{
+			{Argument: "body..tenant_id", Source: "constant", Value: "acme"},
+		},
+	})
+	require.Error(t, err, "a path with an empty segment should be rejected")
+}
+
 func TestVariationsService_UpsertGlobal_EmptyTags(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,275 @@
+package selfhosted_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/webhook_endpoints"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/audit/audittest"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted"
+)
+
+// receivedRequest is what the test endpoint saw, captured on the server
+// goroutine and asserted on the test goroutine.
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+type receiver struct {
+	mu       sync.Mutex
+	requests []receivedRequest
+	status   atomic.Int32
+}
+
+func newReceiver(t *testing.T, status int) (*receiver, *httptest.Server) {
+	t.Helper()
+
+	r := &receiver{mu: sync.Mutex{}, requests: nil, status: atomic.Int32{}}
+	r.status.Store(int32(status))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+
+		r.mu.Lock()
+		r.requests = append(r.requests, receivedRequest{header: req.Header.Clone(), body: body})
+		r.mu.Unlock()
+
+		w.WriteHeader(int(r.status.Load()))
+	}))
+	t.Cleanup(srv.Close)
+
+	return r, srv
+}
+
+func (r *receiver) observed() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]receivedRequest(nil), r.requests...)
+}
+
+func TestHandle_FiltersByEventTypeAndIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	all, err := ti.service.CreateEndpoint(ctx, &gen.CreateEndpointPayload{
+		URL: "https://example.com/all", Description: nil, EventTypes: nil, SessionToken: nil,
+	})
+	require.NoError(t, err)
+	filtered, err := ti.service.CreateEndpoint(ctx, &gen.CreateEndpointPayload{
+		URL: "https://example.com/assets", Description: nil, EventTypes: []string{"audit_log.asset_event_v1"}, SessionToken: nil,
+	})
+	require.NoError(t, err)
+
+	ev := newEvent(authCtx.ActiveOrganizationID, uuid.NewString(), "audit_log.toolset_event_v1", []byte(`{"id":12345678901234567890}`))
+	require.NoError(t, ti.handler.Handle(ctx, ev, testMetadata()))
+	// A Pub/Sub redelivery of the same event queues nothing new.
+	require.NoError(t, ti.handler.Handle(ctx, ev, testMetadata()))
+
+	allDeliveries, err := ti.service.ListDeliveries(ctx, &gen.ListDeliveriesPayload{
+		EndpointID: all.Endpoint.ID, DeliveryStatus: nil, Cursor: nil, Limit: 50, SessionToken: nil,
+	})
+	require.NoError(t, err)
+	require.Len(t, allDeliveries.Deliveries, 1)
+	require.Equal(t, selfhosted.StatusPending, allDeliveries.Deliveries[0].DeliveryStatus)
+
+	filteredDeliveries, err := ti.service.ListDeliveries(ctx, &gen.ListDeliveriesPayload{
+		EndpointID: filtered.Endpoint.ID, DeliveryStatus: nil, Cursor: nil, Limit: 50, SessionToken: nil,
+	})
+	require.NoError(t, err)
+	require.Empty(t, filteredDeliveries.Deliveries)
+}
+
+func TestDispatcher_SendsSignedRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	recv, srv := newReceiver(t, http.StatusNoContent)
+
+	created, err := ti.service.CreateEndpoint(ctx, &gen.CreateEndpointPayload{
+		URL: srv.URL, Description: nil, EventTypes: nil, SessionToken: nil,
+	})
+	require.NoError(t, err)
+
+	eventID := uuid.NewString()
+	ev := newEvent(authCtx.ActiveOrganizationID, eventID, "audit_log.toolset_event_v1", []byte(`{"id":12345678901234567890}`))
+	require.NoError(t, ti.handler.Handle(ctx, ev, testMetadata()))
+
+	n, err := ti.dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	requests := recv.observed()
+	require.Len(t, requests, 1)
+	got := requests[0]
+
+	require.Equal(t, eventID, got.header.Get(selfhosted.HeaderWebhookID))
+	require.JSONEq(t, `{"type":"audit_log.toolset_event_v1","timestamp":"`+ev.GetCreatedAt()+`","data":{"id":12345678901234567890}}`, string(got.body))
+	// The large integer survives byte-for-byte rather than being rounded.
+	require.Contains(t, string(got.body), `12345678901234567890`)
+
+	ts, err := strconv.ParseInt(got.header.Get(selfhosted.HeaderWebhookTimestamp), 10, 64)
+	require.NoError(t, err)
+	want, err := selfhosted.Sign(created.Secret, eventID, time.Unix(ts, 0), got.body)
+	require.NoError(t, err)
+	require.Equal(t, want, got.header.Get(selfhosted.HeaderWebhookSignature))
+
+	deliveries, err := ti.service.ListDeliveries(ctx, &gen.ListDeliveriesPayload{
+		EndpointID: created.Endpoint.ID, DeliveryStatus: nil, Cursor: nil, Limit: 50, SessionToken: nil,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries.Deliveries, 1)
+	delivery := deliveries.Deliveries[0]
+	require.Equal(t, selfhosted.StatusSucceeded, delivery.DeliveryStatus)
+	require.Len(t, delivery.AttemptLog, 1)
+	require.Equal(t, int32(http.StatusNoContent), *delivery.AttemptLog[0].ResponseStatus)
+	require.Nil(t, delivery.AttemptLog[0].Error)
+}
+
+func TestDispatcher_RetriesThenDeadLettersThenRedelivers(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	recv, srv := newReceiver(t, http.StatusInternalServerError)
+
+	created, err := ti.service.CreateEndpoint(ctx, &gen.CreateEndpointPayload{
+		URL: srv.URL, Description: nil, EventTypes: nil, SessionToken: nil,
+	})
+	require.NoError(t, err)
+
+	ev := newEvent(authCtx.ActiveOrganizationID, uuid.NewString(), "audit_log.toolset_event_v1", []byte(`{}`))
+	require.NoError(t, ti.handler.Handle(ctx, ev, testMetadata()))
+
+	for range selfhosted.MaxAttempts {
+		makeDue(t, ti.conn)
+		n, err := ti.dispatcher.DispatchDue(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+	}
+
+	// Dead-lettered deliveries are not claimed again.
+	makeDue(t, ti.conn)
+	n, err := ti.dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+	require.Len(t, recv.observed(), selfhosted.MaxAttempts)
+
+	deliveries, err := ti.service.ListDeliveries(ctx, &gen.ListDeliveriesPayload{
+		EndpointID: created.Endpoint.ID, DeliveryStatus: new(selfhosted.StatusDeadLettered), Cursor: nil, Limit: 50, SessionToken: nil,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries.Deliveries, 1)
+	delivery := deliveries.Deliveries[0]
+	require.Equal(t, int32(selfhosted.MaxAttempts), delivery.Attempts)
+	require.Len(t, delivery.AttemptLog, selfhosted.MaxAttempts)
+	for i, attempt := range delivery.AttemptLog {
+		require.Equal(t, int32(i+1), attempt.Attempt)
+		require.Equal(t, int32(http.StatusInternalServerError), *attempt.ResponseStatus)
+		require.NotNil(t, attempt.Error)
+	}
+
+	// The endpoint recovers and the delivery is redelivered by hand.
+	recv.status.Store(http.StatusOK)
+	redelivered, err := ti.service.Redeliver(ctx, &gen.RedeliverPayload{ID: delivery.ID, SessionToken: nil})
+	require.NoError(t, err)
+	require.Equal(t, selfhosted.StatusPending, redelivered.DeliveryStatus)
+
+	n, err = ti.dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	deliveries, err = ti.service.ListDeliveries(ctx, &gen.ListDeliveriesPayload{
+		EndpointID: created.Endpoint.ID, DeliveryStatus: nil, Cursor: nil, Limit: 50, SessionToken: nil,
+	})
+	require.NoError(t, err)
+	require.Equal(t, selfhosted.StatusSucceeded, deliveries.Deliveries[0].DeliveryStatus)
+	require.Len(t, deliveries.Deliveries[0].AttemptLog, selfhosted.MaxAttempts+1)
+}
+
+func TestUpdateEndpoint_DisablingDeadLettersPendingDeliveries(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	require.True(t, ok)
+
+	recv, srv := newReceiver(t, http.StatusOK)
+
+	created, err := ti.service.CreateEndpoint(ctx, &gen.CreateEndpointPayload{
+		URL: srv.URL, Description: nil, EventTypes: nil, SessionToken: nil,
+	})
+	require.NoError(t, err)
+
+	ev := newEvent(authCtx.ActiveOrganizationID, uuid.NewString(), "audit_log.toolset_event_v1", []byte(`{}`))
+	require.NoError(t, ti.handler.Handle(ctx, ev, testMetadata()))
+
+	_, err = ti.service.UpdateEndpoint(ctx, &gen.UpdateEndpointPayload{
+		ID: created.Endpoint.ID, URL: nil, Description: nil, EventTypes: nil, Disabled: new(true), SessionToken: nil,
+	})
+	require.NoError(t, err)
+
+	deliveries, err := ti.service.ListDeliveries(ctx, &gen.ListDeliveriesPayload{
+		EndpointID: created.Endpoint.ID, DeliveryStatus: nil, Cursor: nil, Limit: 50, SessionToken: nil,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries.Deliveries, 1)
+	delivery := deliveries.Deliveries[0]
+	require.Equal(t, selfhosted.StatusDeadLettered, delivery.DeliveryStatus)
+	require.Empty(t, delivery.AttemptLog)
+
+	// A disabled endpoint takes no redeliveries either.
+	_, err = ti.service.Redeliver(ctx, &gen.RedeliverPayload{ID: delivery.ID, SessionToken: nil})
+	requireOopsCode(t, err, oops.CodeNotFound)
+
+	_, err = ti.service.UpdateEndpoint(ctx, &gen.UpdateEndpointPayload{
+		ID: created.Endpoint.ID, URL: nil, Description: nil, EventTypes: nil, Disabled: new(false), SessionToken: nil,
+	})
+	require.NoError(t, err)
+
+	_, err = ti.service.Redeliver(ctx, &gen.RedeliverPayload{ID: delivery.ID, SessionToken: nil})
+	require.NoError(t, err)
+
+	record, err := audittest.LatestAuditLogByAction(ctx, ti.conn, audit.ActionWebhookDeliveryRedeliver)
+	require.NoError(t, err)
+	require.Equal(t, created.Endpoint.ID, record.SubjectID)
+	metadata, err := audittest.DecodeAuditData(record.Metadata)
+	require.NoError(t, err)
+	require.Equal(t, delivery.ID, metadata["delivery_id"])
+
+	n, err := ti.dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Len(t, recv.observed(), 1)
+}
+
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 30*time.Second, selfhosted.RetryDelay(1))
+	require.Equal(t, 2*time.Minute, selfhosted.RetryDelay(2))
+	require.Equal(t, 8*time.Minute, selfhosted.RetryDelay(3))
+	require.Equal(t, 6*time.Hour, selfhosted.RetryDelay(7))
+	require.Equal(t, 6*time.Hour, selfhosted.RetryDelay(100))
+}
@@ -0,0 +1,317 @@
+package selfhosted
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted/repo"
+)
+
+const (
+	meterAttempts = "webhooks.selfhosted.attempts"
+
+	// MaxAttempts is the retry budget for a delivery. With the backoff
+	// below, the last automatic attempt lands roughly fifteen hours after
+	// the first.
+	MaxAttempts = 8
+
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = 6 * time.Hour
+
+	defaultPollInterval = 5 * time.Second
+	defaultBatchSize    = 50
+	defaultConcurrency  = 8
+	requestTimeout      = 15 * time.Second
+	// The lease must outlast a request so a slow endpoint is not claimed a
+	// second time while the first attempt is still in flight.
+	claimLease = 2 * requestTimeout
+)
+
+// Delivery statuses. Pending deliveries are waiting for their next attempt;
+// the other two are terminal until someone asks for a redelivery.
+const (
+	StatusPending      = "pending"
+	StatusSucceeded    = "succeeded"
+	StatusDeadLettered = "dead_lettered"
+)
+
+// Attempt outcomes, recorded as a metric dimension.
+const (
+	outcomeSucceeded    = "succeeded"
+	outcomeRetrying     = "retrying"
+	outcomeDeadLettered = "dead_lettered"
+)
+
+// Dispatcher sends queued deliveries to customer endpoints.
+type Dispatcher struct {
+	logger       *slog.Logger
+	tracer       trace.Tracer
+	db           *pgxpool.Pool
+	enc          *encryption.Client
+	client       *guardian.HTTPClient
+	pollInterval time.Duration
+	attempts     metric.Int64Counter
+}
+
+func NewDispatcher(
+	logger *slog.Logger,
+	tracerProvider trace.TracerProvider,
+	meterProvider metric.MeterProvider,
+	db *pgxpool.Pool,
+	enc *encryption.Client,
+	guardianPolicy *guardian.Policy,
+) *Dispatcher {
+	meter := meterProvider.Meter("github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted")
+
+	attempts, err := meter.Int64Counter(
+		meterAttempts,
+		metric.WithDescription("Number of webhook delivery attempts by outcome"),
+		metric.WithUnit("{attempt}"),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "create metric error", attr.SlogMetricName(meterAttempts), attr.SlogError(err))
+	}
+
+	client := guardianPolicy.PooledClient()
+	// A redirect is reported as the endpoint's answer rather than followed:
+	// the registered URL is what the customer vouched for, and a 3xx is
+	// almost always a misconfigured endpoint that should surface in the
+	// attempt log.
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	client.Timeout = requestTimeout
+
+	return &Dispatcher{
+		logger:       logger.With(attr.SlogComponent("webhooks-selfhosted-dispatcher")),
+		tracer:       tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted"),
+		db:           db,
+		enc:          enc,
+		client:       client,
+		pollInterval: defaultPollInterval,
+		attempts:     attempts,
+	}
+}
+
+// Run polls for due deliveries until ctx is cancelled. Several dispatchers
+// may run against the same database; claiming is safe under concurrency.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Drain everything that is due before sleeping, so a backlog clears
+		// at batch speed rather than one batch per tick.
+		for {
+			n, err := d.DispatchDue(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				d.logger.ErrorContext(ctx, "dispatch webhook deliveries", attr.SlogError(err))
+				break
+			}
+			if n < defaultBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue claims one batch of due deliveries and attempts each of them,
+// returning how many were claimed.
+func (d *Dispatcher) DispatchDue(ctx context.Context) (int, error) {
+	due, err := repo.New(d.db).ClaimDueWebhookDeliveries(ctx, repo.ClaimDueWebhookDeliveriesParams{
+		BatchSize:    defaultBatchSize,
+		LeaseSeconds: int32(claimLease / time.Second),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("claim due webhook deliveries: %w", err)
+	}
+
+	group, gctx := errgroup.WithContext(ctx)
+	group.SetLimit(defaultConcurrency)
+	for _, delivery := range due {
+		group.Go(func() error {
+			d.attempt(gctx, delivery)
+			return nil
+		})
+	}
+	_ = group.Wait()
+
+	return len(due), nil
+}
+
+func (d *Dispatcher) attempt(ctx context.Context, delivery repo.ClaimDueWebhookDeliveriesRow) {
+	ctx, span := d.tracer.Start(ctx, "webhooks.selfhosted.attempt")
+	defer span.End()
+
+	attemptNumber := int(delivery.Attempts) + 1
+	logger := d.logger.With(
+		attr.SlogOrganizationID(delivery.OrganizationID),
+		attr.SlogWebhookEndpointID(delivery.EndpointID.String()),
+		attr.SlogWebhookDeliveryID(delivery.ID.String()),
+		attr.SlogWebhookEventType(delivery.EventType),
+		attr.SlogWebhookDeliveryAttempt(attemptNumber),
+	)
+	span.SetAttributes(
+		attr.WebhookEndpointID(delivery.EndpointID.String()),
+		attr.WebhookDeliveryID(delivery.ID.String()),
+		attr.WebhookDeliveryAttempt(attemptNumber),
+	)
+
+	start := time.Now()
+	status, sendErr := d.send(ctx, delivery)
+	elapsed := time.Since(start)
+
+	attempt32 := int32(attemptNumber)          //nolint:gosec // bounded by MaxAttempts plus manual redeliveries
+	status32 := int32(status)                  //nolint:gosec // HTTP status codes are small
+	elapsedMs := int32(elapsed.Milliseconds()) //nolint:gosec // bounded by the request timeout
+
+	if err := repo.New(d.db).RecordWebhookDeliveryAttempt(ctx, repo.RecordWebhookDeliveryAttemptParams{
+		DeliveryID:     delivery.ID,
+		Attempt:        attempt32,
+		ResponseStatus: pgtype.Int4{Int32: status32, Valid: status != 0},
+		Error:          errorText(sendErr),
+		DurationMs:     elapsedMs,
+	}); err != nil {
+		// The outcome below is what drives retries; a missing log row only
+		// costs visibility, so carry on rather than leave the delivery to the
+		// lease.
+		logger.ErrorContext(ctx, "record webhook delivery attempt", attr.SlogError(err))
+	}
+
+	outcome := outcomeSucceeded
+	params := repo.UpdateWebhookDeliveryOutcomeParams{
+		Status:        StatusSucceeded,
+		Attempts:      attempt32,
+		NextAttemptAt: conv.PtrToPGTimestamptz(nil),
+		ID:            delivery.ID,
+	}
+	switch {
+	case sendErr == nil:
+	case attemptNumber >= MaxAttempts:
+		outcome = outcomeDeadLettered
+		params.Status = StatusDeadLettered
+	default:
+		outcome = outcomeRetrying
+		params.Status = StatusPending
+		params.NextAttemptAt = conv.ToPGTimestamptz(time.Now().Add(RetryDelay(attemptNumber)))
+	}
+
+	if err := repo.New(d.db).UpdateWebhookDeliveryOutcome(ctx, params); err != nil {
+		// The claim lease expires and the delivery is attempted again, so
+		// the worst case is a duplicate the receiver dedupes on webhook-id.
+		logger.ErrorContext(ctx, "update webhook delivery outcome", attr.SlogError(err))
+	}
+
+	if d.attempts != nil {
+		d.attempts.Add(ctx, 1, metric.WithAttributes(attr.WebhookDeliveryOutcome(outcome)))
+	}
+	span.SetAttributes(attr.WebhookDeliveryOutcome(outcome))
+
+	switch outcome {
+	case outcomeDeadLettered:
+		logger.WarnContext(ctx, "webhook delivery dead-lettered",
+			attr.SlogHTTPResponseStatusCode(status),
+			attr.SlogError(sendErr),
+		)
+	case outcomeRetrying:
+		logger.InfoContext(ctx, "webhook delivery attempt failed",
+			attr.SlogHTTPResponseStatusCode(status),
+			attr.SlogError(sendErr),
+		)
+	}
+}
+
+// send performs one signed POST. It returns the response status when one was
+// received, and an error for anything other than a 2xx.
+func (d *Dispatcher) send(ctx context.Context, delivery repo.ClaimDueWebhookDeliveriesRow) (int, error) {
+	secret, err := d.enc.Decrypt(delivery.SecretEncrypted)
+	if err != nil {
+		return 0, fmt.Errorf("decrypt signing secret: %w", err)
+	}
+
+	// The timestamp is the time of this attempt, not of the event: receivers
+	// reject signatures outside a tolerance window, so a retry hours later
+	// must carry a fresh one.
+	now := time.Now()
+	signature, err := Sign(secret, delivery.EventID, now, delivery.Payload)
+	if err != nil {
+		return 0, fmt.Errorf("sign webhook: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookID, delivery.EventID)
+	req.Header.Set(HeaderWebhookTimestamp, fmt.Sprintf("%d", now.Unix()))
+	req.Header.Set(HeaderWebhookSignature, signature)
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("send webhook: %w", err)
+	}
+	defer o11y.NoLogDefer(func() error { return resp.Body.Close() })
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// RetryDelay is the wait before the attempt that follows attempt n: 30s after
+// the first failure, quadrupling each time, capped at six hours.
+func RetryDelay(n int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < n; i++ {
+		delay *= 4
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+
+	return delay
+}
+
+func errorText(err error) pgtype.Text {
+	if err == nil {
+		return pgtype.Text{String: "", Valid: false}
+	}
+
+	// A client timeout surfaces as a url.Error that repeats the method and
+	// endpoint URL; the attempt log already has both.
+	msg := err.Error()
+	if errors.Is(err, context.DeadlineExceeded) {
+		msg = "request timed out"
+	}
+
+	return pgtype.Text{String: msg, Valid: true}
+}
@@ -0,0 +1,127 @@
+package selfhosted_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gen "github.com/speakeasy-api/gram/server/gen/webhook_endpoints"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+)
+
+func TestCreateEndpoint_ReturnsSecretOnce(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	created, err := ti.service.CreateEndpoint(ctx, &gen.CreateEndpointPayload{
+		URL:          "https://example.com/hooks",
+		Description:  new("primary"),
+		EventTypes:   []string{" audit_log.toolset_event_v1 ", "audit_log.asset_event_v1", "audit_log.toolset_event_v1"},
+		SessionToken: nil,
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(created.Secret, "whsec_"))
+	require.Equal(t, "https://example.com/hooks", created.Endpoint.URL)
+	require.Equal(t, []string{"audit_log.asset_event_v1", "audit_log.toolset_event_v1"}, created.Endpoint.EventTypes)
+	require.False(t, created.Endpoint.Disabled)
+
+	listed, err := ti.service.ListEndpoints(ctx, &gen.ListEndpointsPayload{SessionToken: nil})
+	require.NoError(t, err)
+	require.Len(t, listed.Endpoints, 1)
+	require.Equal(t, created.Endpoint.ID, listed.Endpoints[0].ID)
+	require.Equal(t, "primary", *listed.Endpoints[0].Description)
+}
+
+func TestCreateEndpoint_RejectsNonHTTPURL(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	_, err := ti.service.CreateEndpoint(ctx, &gen.CreateEndpointPayload{
+		URL:          "ftp://example.com/hooks",
+		Description:  nil,
+		EventTypes:   nil,
+		SessionToken: nil,
+	})
+	requireOopsCode(t, err, oops.CodeInvalid)
+}
+
+func TestUpdateEndpoint_DisablesAndClearsFilter(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	created, err := ti.service.CreateEndpoint(ctx, &gen.CreateEndpointPayload{
+		URL:          "https://example.com/hooks",
+		Description:  nil,
+		EventTypes:   []string{"audit_log.asset_event_v1"},
+		SessionToken: nil,
+	})
+	require.NoError(t, err)
+
+	updated, err := ti.service.UpdateEndpoint(ctx, &gen.UpdateEndpointPayload{
+		ID:           created.Endpoint.ID,
+		URL:          nil,
+		Description:  nil,
+		EventTypes:   []string{},
+		Disabled:     new(true),
+		SessionToken: nil,
+	})
+	require.NoError(t, err)
+	require.Empty(t, updated.EventTypes)
+	require.True(t, updated.Disabled)
+	require.Equal(t, created.Endpoint.URL, updated.URL)
+
+	// Omitted fields are left alone.
+	updated, err = ti.service.UpdateEndpoint(ctx, &gen.UpdateEndpointPayload{
+		ID:           created.Endpoint.ID,
+		URL:          new("https://example.com/v2/hooks"),
+		Description:  nil,
+		EventTypes:   nil,
+		Disabled:     nil,
+		SessionToken: nil,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/v2/hooks", updated.URL)
+	require.True(t, updated.Disabled)
+}
+
+func TestUpdateEndpoint_NotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	_, err := ti.service.UpdateEndpoint(ctx, &gen.UpdateEndpointPayload{
+		ID:           "0195e2b0-0000-7000-8000-000000000000",
+		URL:          nil,
+		Description:  nil,
+		EventTypes:   nil,
+		Disabled:     new(true),
+		SessionToken: nil,
+	})
+	requireOopsCode(t, err, oops.CodeNotFound)
+}
+
+func TestDeleteEndpoint(t *testing.T) {
+	t.Parallel()
+
+	ctx, ti := newTestService(t)
+
+	created, err := ti.service.CreateEndpoint(ctx, &gen.CreateEndpointPayload{
+		URL:          "https://example.com/hooks",
+		Description:  nil,
+		EventTypes:   nil,
+		SessionToken: nil,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, ti.service.DeleteEndpoint(ctx, &gen.DeleteEndpointPayload{ID: created.Endpoint.ID, SessionToken: nil}))
+	// Deleting again is a no-op.
+	require.NoError(t, ti.service.DeleteEndpoint(ctx, &gen.DeleteEndpointPayload{ID: created.Endpoint.ID, SessionToken: nil}))
+
+	listed, err := ti.service.ListEndpoints(ctx, &gen.ListEndpointsPayload{SessionToken: nil})
+	require.NoError(t, err)
+	require.Empty(t, listed.Endpoints)
+}
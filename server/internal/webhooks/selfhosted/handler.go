@@ -0,0 +1,165 @@
+// Package selfhosted is a built-in webhook delivery backend for installs that
+// do not use Svix.
+//
+// It has three halves. [Handler] consumes the gram.webhooks.v1.Event topic in
+// place of the Svix relay and fans each event out into one delivery row per
+// subscribed endpoint. [Dispatcher] polls those rows, signs and POSTs each one
+// following the Standard Webhooks specification, and retries failures with
+// exponential backoff until the attempt budget is spent and the delivery is
+// dead-lettered. [Service] is the management API: endpoint registration,
+// event-type filtering and the delivery-attempt log.
+//
+// Unlike the Svix relay, retrying is this package's job rather than the
+// subscription's. The handler's only failure mode is being unable to write
+// the delivery rows, which nacks the message so Pub/Sub redelivers it; once
+// the rows exist, the event is durable and the dispatcher owns it.
+package selfhosted
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/metric"
+
+	webhooksv1 "github.com/speakeasy-api/gram/infra/gen/gram/webhooks/v1"
+	"github.com/speakeasy-api/gram/infra/pkg/gcp"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted/repo"
+)
+
+const (
+	meterEnqueued = "webhooks.selfhosted.enqueued"
+	meterDropped  = "webhooks.selfhosted.dropped"
+)
+
+// Drop reasons, recorded as a metric dimension.
+const (
+	dropReasonInvalidEvent   = "invalid_event"
+	dropReasonInvalidPayload = "invalid_payload"
+)
+
+type Handler struct {
+	logger   *slog.Logger
+	db       *pgxpool.Pool
+	enqueued metric.Int64Counter
+	dropped  metric.Int64Counter
+}
+
+// NewHandler builds the subscriber.
+func NewHandler(logger *slog.Logger, meterProvider metric.MeterProvider, db *pgxpool.Pool) *Handler {
+	meter := meterProvider.Meter("github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted")
+
+	enqueued, err := meter.Int64Counter(
+		meterEnqueued,
+		metric.WithDescription("Number of webhook deliveries queued for self-hosted dispatch"),
+		metric.WithUnit("{delivery}"),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "create metric error", attr.SlogMetricName(meterEnqueued), attr.SlogError(err))
+	}
+
+	dropped, err := meter.Int64Counter(
+		meterDropped,
+		metric.WithDescription("Number of webhook events acknowledged without delivery"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		logger.ErrorContext(context.Background(), "create metric error", attr.SlogMetricName(meterDropped), attr.SlogError(err))
+	}
+
+	return &Handler{
+		logger:   logger.With(attr.SlogComponent("webhooks-selfhosted")),
+		db:       db,
+		enqueued: enqueued,
+		dropped:  dropped,
+	}
+}
+
+func (h *Handler) Handle(ctx context.Context, ev *webhooksv1.Event, _ gcp.MessageMetadata) error {
+	orgID := ev.GetOrganizationId()
+	eventID := ev.GetEventId()
+
+	// Without an event id there is nothing to send as webhook-id and nothing
+	// to deduplicate redeliveries on. Neither field can appear on a later
+	// attempt, so this is a drop rather than a nack.
+	if orgID == "" || eventID == "" || ev.GetEventType() == "" {
+		h.drop(ctx, dropReasonInvalidEvent)
+		h.logger.ErrorContext(ctx, "dropping webhook event with missing identifiers",
+			attr.SlogWebhookDropReason(dropReasonInvalidEvent),
+			attr.SlogOrganizationID(orgID),
+			attr.SlogOutboxPublicID(eventID),
+		)
+		return nil
+	}
+
+	body, err := encodeBody(ev)
+	if err != nil {
+		h.drop(ctx, dropReasonInvalidPayload)
+		h.logger.ErrorContext(ctx, "dropping webhook event with unreadable payload",
+			attr.SlogWebhookDropReason(dropReasonInvalidPayload),
+			attr.SlogOrganizationID(orgID),
+			attr.SlogOutboxPublicID(eventID),
+			attr.SlogError(err),
+		)
+		return nil
+	}
+
+	// Zero rows is the steady state — the organization has no endpoint
+	// subscribed to this event type, or this is a redelivery of an event that
+	// was already queued — so it is neither counted as a drop nor logged.
+	n, err := repo.New(h.db).EnqueueWebhookDeliveries(ctx, repo.EnqueueWebhookDeliveriesParams{
+		EventID:        eventID,
+		EventType:      ev.GetEventType(),
+		Payload:        body,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue webhook deliveries: %w", err)
+	}
+
+	if n > 0 && h.enqueued != nil {
+		h.enqueued.Add(ctx, n, metric.WithAttributes(attr.WebhookEventType(ev.GetEventType())))
+	}
+
+	return nil
+}
+
+// envelope is the request body every endpoint receives, in the shape the
+// Standard Webhooks specification recommends. Data is the producer's payload
+// carried through verbatim; json.RawMessage keeps its number literals intact
+// where a decode into map[string]any would round large integers.
+type envelope struct {
+	Type      string          `json:"type"`
+	Timestamp string          `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// encodeBody renders the body once, at enqueue time, so every attempt signs
+// and sends exactly the same bytes.
+func encodeBody(ev *webhooksv1.Event) ([]byte, error) {
+	payload := ev.GetPayload()
+	if !json.Valid(payload) {
+		return nil, errors.New("payload is not valid json")
+	}
+
+	body, err := json.Marshal(envelope{
+		Type:      ev.GetEventType(),
+		Timestamp: ev.GetCreatedAt(),
+		Data:      payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal webhook body: %w", err)
+	}
+
+	return body, nil
+}
+
+func (h *Handler) drop(ctx context.Context, reason string) {
+	if h.dropped != nil {
+		h.dropped.Add(ctx, 1, metric.WithAttributes(attr.WebhookDropReason(reason)))
+	}
+}
@@ -0,0 +1,563 @@
+package selfhosted
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/trace"
+	goahttp "goa.design/goa/v3/http"
+	"goa.design/goa/v3/security"
+
+	srv "github.com/speakeasy-api/gram/server/gen/http/webhook_endpoints/server"
+	gen "github.com/speakeasy-api/gram/server/gen/webhook_endpoints"
+	"github.com/speakeasy-api/gram/server/internal/attr"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/auth"
+	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/contextvalues"
+	"github.com/speakeasy-api/gram/server/internal/conv"
+	"github.com/speakeasy-api/gram/server/internal/encryption"
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/middleware"
+	"github.com/speakeasy-api/gram/server/internal/o11y"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/urn"
+	"github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted/repo"
+)
+
+const (
+	maxEventTypes      = 64
+	maxEventTypeLength = 128
+)
+
+type Service struct {
+	tracer   trace.Tracer
+	logger   *slog.Logger
+	db       *pgxpool.Pool
+	auth     *auth.Auth
+	authz    *authz.Engine
+	audit    *audit.Logger
+	enc      *encryption.Client
+	guardian *guardian.Policy
+}
+
+var _ gen.Service = (*Service)(nil)
+var _ gen.Auther = (*Service)(nil)
+
+func NewService(
+	logger *slog.Logger,
+	tracerProvider trace.TracerProvider,
+	db *pgxpool.Pool,
+	sessions *sessions.Manager,
+	authzEngine *authz.Engine,
+	auditLogger *audit.Logger,
+	enc *encryption.Client,
+	guardianPolicy *guardian.Policy,
+) *Service {
+	logger = logger.With(attr.SlogComponent("webhookendpoints"))
+
+	return &Service{
+		tracer:   tracerProvider.Tracer("github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted"),
+		logger:   logger,
+		db:       db,
+		auth:     auth.New(logger, db, sessions, authzEngine),
+		authz:    authzEngine,
+		audit:    auditLogger,
+		enc:      enc,
+		guardian: guardianPolicy,
+	}
+}
+
+func Attach(mux goahttp.Muxer, service *Service) {
+	endpoints := gen.NewEndpoints(service)
+	endpoints.Use(middleware.MapErrors())
+	endpoints.Use(middleware.TraceMethods(service.tracer))
+	srv.Mount(
+		mux,
+		srv.New(endpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, nil, nil),
+	)
+}
+
+func (s *Service) APIKeyAuth(ctx context.Context, key string, schema *security.APIKeyScheme) (context.Context, error) {
+	return s.auth.Authorize(ctx, key, schema)
+}
+
+func (s *Service) requireOrgAccess(ctx context.Context, scope authz.Scope) (*contextvalues.AuthContext, *slog.Logger, error) {
+	authCtx, ok := contextvalues.GetAuthContext(ctx)
+	if !ok || authCtx == nil || authCtx.ActiveOrganizationID == "" {
+		return nil, s.logger, oops.C(oops.CodeUnauthorized)
+	}
+
+	logger := s.logger.With(attr.SlogOrganizationID(authCtx.ActiveOrganizationID))
+
+	if err := s.authz.Require(ctx, authz.Check{Scope: scope, ResourceKind: "", ResourceID: authCtx.ActiveOrganizationID, Dimensions: nil}); err != nil {
+		return nil, logger, err
+	}
+
+	return authCtx, logger, nil
+}
+
+func (s *Service) CreateEndpoint(ctx context.Context, payload *gen.CreateEndpointPayload) (*gen.CreateWebhookEndpointResult, error) {
+	authCtx, logger, err := s.requireOrgAccess(ctx, authz.ScopeOrgAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateURL(ctx, payload.URL); err != nil {
+		return nil, err
+	}
+	eventTypes, err := normalizeEventTypes(payload.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := GenerateSecret()
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "generate signing secret").LogError(ctx, logger)
+	}
+	secretEncrypted, err := s.enc.Encrypt([]byte(secret))
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "encrypt signing secret").LogError(ctx, logger)
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	created, err := repo.New(dbtx).CreateWebhookEndpoint(ctx, repo.CreateWebhookEndpointParams{
+		OrganizationID:  authCtx.ActiveOrganizationID,
+		Url:             payload.URL,
+		Description:     conv.PtrToPGText(payload.Description),
+		SecretEncrypted: secretEncrypted,
+		EventTypes:      eventTypes,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "create webhook endpoint").LogError(ctx, logger)
+	}
+
+	if err := s.audit.LogWebhookEndpointCreate(ctx, dbtx, audit.LogWebhookEndpointCreateEvent{
+		OrganizationID:          authCtx.ActiveOrganizationID,
+		ProjectID:               uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		Actor:                   urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:        authCtx.Email,
+		ActorSlug:               nil,
+		WebhookEndpointURN:      urn.NewWebhookEndpoint(created.ID),
+		WebhookEndpointSnapshot: toSnapshot(created),
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log webhook endpoint creation").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	return &gen.CreateWebhookEndpointResult{
+		Endpoint: toEndpointView(created),
+		Secret:   secret,
+	}, nil
+}
+
+func (s *Service) ListEndpoints(ctx context.Context, payload *gen.ListEndpointsPayload) (*gen.ListWebhookEndpointsResult, error) {
+	authCtx, logger, err := s.requireOrgAccess(ctx, authz.ScopeOrgRead)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := repo.New(s.db).ListWebhookEndpoints(ctx, authCtx.ActiveOrganizationID)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "list webhook endpoints").LogError(ctx, logger)
+	}
+
+	endpoints := make([]*gen.WebhookEndpoint, 0, len(rows))
+	for _, row := range rows {
+		endpoints = append(endpoints, toEndpointView(row))
+	}
+
+	return &gen.ListWebhookEndpointsResult{Endpoints: endpoints}, nil
+}
+
+func (s *Service) UpdateEndpoint(ctx context.Context, payload *gen.UpdateEndpointPayload) (*gen.WebhookEndpoint, error) {
+	authCtx, logger, err := s.requireOrgAccess(ctx, authz.ScopeOrgAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid webhook endpoint id").LogError(ctx, logger)
+	}
+	logger = logger.With(attr.SlogWebhookEndpointID(id.String()))
+
+	if payload.URL != nil {
+		if err := s.validateURL(ctx, *payload.URL); err != nil {
+			return nil, err
+		}
+	}
+
+	// A nil slice leaves the subscription untouched; an empty one, which is
+	// what an explicit [] in the request decodes to, subscribes to every type.
+	var eventTypes []string
+	if payload.EventTypes != nil {
+		eventTypes, err = normalizeEventTypes(payload.EventTypes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	tx := repo.New(dbtx)
+
+	existing, err := tx.GetWebhookEndpoint(ctx, repo.GetWebhookEndpointParams{
+		ID:             id,
+		OrganizationID: authCtx.ActiveOrganizationID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "webhook endpoint not found")
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "get webhook endpoint").LogError(ctx, logger)
+	}
+
+	updated, err := tx.UpdateWebhookEndpoint(ctx, repo.UpdateWebhookEndpointParams{
+		Url:            conv.PtrToPGText(payload.URL),
+		Description:    conv.PtrToPGText(payload.Description),
+		EventTypes:     eventTypes,
+		Disabled:       conv.PtrToPGBool(payload.Disabled),
+		ID:             id,
+		OrganizationID: authCtx.ActiveOrganizationID,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "update webhook endpoint").LogError(ctx, logger)
+	}
+
+	if updated.DisabledAt.Valid {
+		if _, err := tx.DeadLetterPendingWebhookDeliveries(ctx, repo.DeadLetterPendingWebhookDeliveriesParams{
+			EndpointID:     updated.ID,
+			OrganizationID: authCtx.ActiveOrganizationID,
+		}); err != nil {
+			return nil, oops.E(oops.CodeUnexpected, err, "settle pending webhook deliveries").LogError(ctx, logger)
+		}
+	}
+
+	if err := s.audit.LogWebhookEndpointUpdate(ctx, dbtx, audit.LogWebhookEndpointUpdateEvent{
+		OrganizationID:                authCtx.ActiveOrganizationID,
+		ProjectID:                     uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		Actor:                         urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:              authCtx.Email,
+		ActorSlug:                     nil,
+		WebhookEndpointURN:            urn.NewWebhookEndpoint(updated.ID),
+		WebhookEndpointSnapshotBefore: toSnapshot(existing),
+		WebhookEndpointSnapshotAfter:  toSnapshot(updated),
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log webhook endpoint update").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	return toEndpointView(updated), nil
+}
+
+func (s *Service) DeleteEndpoint(ctx context.Context, payload *gen.DeleteEndpointPayload) error {
+	authCtx, logger, err := s.requireOrgAccess(ctx, authz.ScopeOrgAdmin)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return oops.E(oops.CodeBadRequest, err, "invalid webhook endpoint id").LogError(ctx, logger)
+	}
+	logger = logger.With(attr.SlogWebhookEndpointID(id.String()))
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	tx := repo.New(dbtx)
+
+	deleted, err := tx.DeleteWebhookEndpoint(ctx, repo.DeleteWebhookEndpointParams{
+		ID:             id,
+		OrganizationID: authCtx.ActiveOrganizationID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// Deleting an endpoint that is already gone is a no-op.
+		return nil
+	case err != nil:
+		return oops.E(oops.CodeUnexpected, err, "delete webhook endpoint").LogError(ctx, logger)
+	}
+
+	if _, err := tx.DeadLetterPendingWebhookDeliveries(ctx, repo.DeadLetterPendingWebhookDeliveriesParams{
+		EndpointID:     deleted.ID,
+		OrganizationID: authCtx.ActiveOrganizationID,
+	}); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "settle pending webhook deliveries").LogError(ctx, logger)
+	}
+
+	if err := s.audit.LogWebhookEndpointDelete(ctx, dbtx, audit.LogWebhookEndpointDeleteEvent{
+		OrganizationID:          authCtx.ActiveOrganizationID,
+		ProjectID:               uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		Actor:                   urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:        authCtx.Email,
+		ActorSlug:               nil,
+		WebhookEndpointURN:      urn.NewWebhookEndpoint(deleted.ID),
+		WebhookEndpointSnapshot: toSnapshot(deleted),
+	}); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "log webhook endpoint deletion").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	return nil
+}
+
+func (s *Service) ListDeliveries(ctx context.Context, payload *gen.ListDeliveriesPayload) (*gen.ListWebhookDeliveriesResult, error) {
+	authCtx, logger, err := s.requireOrgAccess(ctx, authz.ScopeOrgRead)
+	if err != nil {
+		return nil, err
+	}
+
+	endpointID, err := uuid.Parse(payload.EndpointID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid webhook endpoint id").LogError(ctx, logger)
+	}
+	cursor, err := conv.PtrToNullUUID(payload.Cursor)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid cursor").LogError(ctx, logger)
+	}
+	logger = logger.With(attr.SlogWebhookEndpointID(endpointID.String()))
+
+	if _, err := repo.New(s.db).GetWebhookEndpoint(ctx, repo.GetWebhookEndpointParams{
+		ID:             endpointID,
+		OrganizationID: authCtx.ActiveOrganizationID,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oops.E(oops.CodeNotFound, err, "webhook endpoint not found")
+		}
+		return nil, oops.E(oops.CodeUnexpected, err, "get webhook endpoint").LogError(ctx, logger)
+	}
+
+	// One extra row tells us whether there is another page without a count.
+	rows, err := repo.New(s.db).ListWebhookDeliveries(ctx, repo.ListWebhookDeliveriesParams{
+		EndpointID:     endpointID,
+		OrganizationID: authCtx.ActiveOrganizationID,
+		Status:         conv.PtrToPGText(payload.DeliveryStatus),
+		Cursor:         cursor,
+		PageLimit:      payload.Limit + 1,
+	})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "list webhook deliveries").LogError(ctx, logger)
+	}
+
+	var nextCursor *string
+	if len(rows) > int(payload.Limit) {
+		rows = rows[:payload.Limit]
+		nextCursor = new(rows[len(rows)-1].ID.String())
+	}
+
+	attempts, err := s.attemptLogs(ctx, rows)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "list webhook delivery attempts").LogError(ctx, logger)
+	}
+
+	deliveries := make([]*gen.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, toDeliveryView(row, attempts[row.ID]))
+	}
+
+	return &gen.ListWebhookDeliveriesResult{
+		Deliveries: deliveries,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *Service) Redeliver(ctx context.Context, payload *gen.RedeliverPayload) (*gen.WebhookDelivery, error) {
+	authCtx, logger, err := s.requireOrgAccess(ctx, authz.ScopeOrgAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil, oops.E(oops.CodeBadRequest, err, "invalid webhook delivery id").LogError(ctx, logger)
+	}
+	logger = logger.With(attr.SlogWebhookDeliveryID(id.String()))
+
+	dbtx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "begin transaction").LogError(ctx, logger)
+	}
+	defer o11y.NoLogDefer(func() error { return dbtx.Rollback(ctx) })
+
+	row, err := repo.New(dbtx).RedeliverWebhookDelivery(ctx, repo.RedeliverWebhookDeliveryParams{
+		ID:             id,
+		OrganizationID: authCtx.ActiveOrganizationID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, oops.E(oops.CodeNotFound, err, "webhook delivery not found, already pending or its endpoint is disabled")
+	case err != nil:
+		return nil, oops.E(oops.CodeUnexpected, err, "redeliver webhook").LogError(ctx, logger)
+	}
+
+	if err := s.audit.LogWebhookDeliveryRedeliver(ctx, dbtx, audit.LogWebhookDeliveryRedeliverEvent{
+		OrganizationID:     authCtx.ActiveOrganizationID,
+		ProjectID:          uuid.NullUUID{UUID: uuid.Nil, Valid: false},
+		Actor:              urn.NewPrincipal(urn.PrincipalTypeUser, authCtx.UserID),
+		ActorDisplayName:   authCtx.Email,
+		ActorSlug:          nil,
+		WebhookEndpointURN: urn.NewWebhookEndpoint(row.EndpointID),
+		DeliveryID:         row.ID,
+		EventID:            row.EventID,
+		EventType:          row.EventType,
+	}); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "log webhook redelivery").LogError(ctx, logger)
+	}
+
+	if err := dbtx.Commit(ctx); err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "commit transaction").LogError(ctx, logger)
+	}
+
+	attempts, err := s.attemptLogs(ctx, []repo.WebhookDelivery{row})
+	if err != nil {
+		return nil, oops.E(oops.CodeUnexpected, err, "list webhook delivery attempts").LogError(ctx, logger)
+	}
+
+	return toDeliveryView(row, attempts[row.ID]), nil
+}
+
+func (s *Service) attemptLogs(ctx context.Context, deliveries []repo.WebhookDelivery) (map[uuid.UUID][]repo.WebhookDeliveryAttempt, error) {
+	if len(deliveries) == 0 {
+		return map[uuid.UUID][]repo.WebhookDeliveryAttempt{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(deliveries))
+	for _, d := range deliveries {
+		ids = append(ids, d.ID)
+	}
+
+	rows, err := repo.New(s.db).ListWebhookDeliveryAttempts(ctx, ids)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // wrapped by the caller's oops.E
+	}
+
+	byDelivery := make(map[uuid.UUID][]repo.WebhookDeliveryAttempt, len(deliveries))
+	for _, row := range rows {
+		byDelivery[row.DeliveryID] = append(byDelivery[row.DeliveryID], row)
+	}
+
+	return byDelivery, nil
+}
+
+// validateURL checks a registered URL against the same guardian policy the
+// dispatcher dials through, so an endpoint pointing at an internal address is
+// refused at registration rather than failing every delivery.
+func (s *Service) validateURL(ctx context.Context, rawURL string) error {
+	if _, err := s.guardian.ValidateHTTPURL(ctx, rawURL); err != nil {
+		return oops.E(oops.CodeInvalid, err, "invalid webhook endpoint url: %s", err.Error())
+	}
+
+	return nil
+}
+
+// normalizeEventTypes trims, deduplicates and sorts an event-type filter. The
+// result is never nil so an empty filter is stored as an empty array.
+func normalizeEventTypes(in []string) ([]string, error) {
+	if len(in) > maxEventTypes {
+		return nil, oops.E(oops.CodeInvalid, nil, "at most %d event types may be subscribed", maxEventTypes)
+	}
+
+	out := make([]string, 0, len(in))
+	for _, eventType := range in {
+		eventType = strings.TrimSpace(eventType)
+		if eventType == "" || len(eventType) > maxEventTypeLength {
+			return nil, oops.E(oops.CodeInvalid, nil, "event types must be between 1 and %d characters", maxEventTypeLength)
+		}
+		out = append(out, eventType)
+	}
+
+	slices.Sort(out)
+	return slices.Compact(out), nil
+}
+
+func toEndpointView(row repo.WebhookEndpoint) *gen.WebhookEndpoint {
+	eventTypes := row.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	return &gen.WebhookEndpoint{
+		ID:             row.ID.String(),
+		OrganizationID: row.OrganizationID,
+		URL:            row.Url,
+		Description:    conv.FromPGText[string](row.Description),
+		EventTypes:     eventTypes,
+		Disabled:       row.DisabledAt.Valid,
+		CreatedAt:      conv.FromPGTimestamptz(row.CreatedAt),
+		UpdatedAt:      conv.FromPGTimestamptz(row.UpdatedAt),
+	}
+}
+
+func toSnapshot(row repo.WebhookEndpoint) *audit.WebhookEndpointSnapshot {
+	return &audit.WebhookEndpointSnapshot{
+		URL:         row.Url,
+		Description: conv.FromPGText[string](row.Description),
+		EventTypes:  row.EventTypes,
+		Disabled:    row.DisabledAt.Valid,
+	}
+}
+
+func toDeliveryView(row repo.WebhookDelivery, attempts []repo.WebhookDeliveryAttempt) *gen.WebhookDelivery {
+	log := make([]*gen.WebhookDeliveryAttempt, 0, len(attempts))
+	for _, a := range attempts {
+		log = append(log, &gen.WebhookDeliveryAttempt{
+			Attempt:        a.Attempt,
+			ResponseStatus: conv.FromPGInt4(a.ResponseStatus),
+			Error:          conv.FromPGText[string](a.Error),
+			DurationMs:     a.DurationMs,
+			AttemptedAt:    conv.FromPGTimestamptz(a.AttemptedAt),
+		})
+	}
+
+	return &gen.WebhookDelivery{
+		ID:             row.ID.String(),
+		EndpointID:     row.EndpointID.String(),
+		EventID:        row.EventID,
+		EventType:      row.EventType,
+		DeliveryStatus: row.Status,
+		Attempts:       row.Attempts,
+		NextAttemptAt:  formatTimestamptz(row.NextAttemptAt),
+		LastAttemptAt:  formatTimestamptz(row.LastAttemptAt),
+		CreatedAt:      conv.FromPGTimestamptz(row.CreatedAt),
+		AttemptLog:     log,
+	}
+}
+
+func formatTimestamptz(ts pgtype.Timestamptz) *string {
+	if !ts.Valid {
+		return nil
+	}
+
+	return new(conv.FromPGTimestamptz(ts))
+}
@@ -0,0 +1,195 @@
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (
+  organization_id,
+  url,
+  description,
+  secret_encrypted,
+  event_types
+) VALUES (
+  @organization_id,
+  @url,
+  @description,
+  @secret_encrypted,
+  @event_types::text[]
+)
+RETURNING *;
+
+-- name: GetWebhookEndpoint :one
+SELECT *
+FROM webhook_endpoints
+WHERE id = @id
+  AND organization_id = @organization_id
+  AND deleted IS FALSE;
+
+-- name: ListWebhookEndpoints :many
+SELECT *
+FROM webhook_endpoints
+WHERE organization_id = @organization_id
+  AND deleted IS FALSE
+ORDER BY id DESC;
+
+-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoints
+SET
+  url = COALESCE(sqlc.narg(url)::text, url),
+  description = COALESCE(sqlc.narg(description)::text, description),
+  event_types = COALESCE(sqlc.narg(event_types)::text[], event_types),
+  disabled_at = CASE
+    WHEN sqlc.narg(disabled)::boolean IS NULL THEN disabled_at
+    WHEN sqlc.narg(disabled)::boolean THEN COALESCE(disabled_at, clock_timestamp())
+    ELSE NULL
+  END,
+  updated_at = clock_timestamp()
+WHERE id = @id
+  AND organization_id = @organization_id
+  AND deleted IS FALSE
+RETURNING *;
+
+-- name: DeleteWebhookEndpoint :one
+UPDATE webhook_endpoints
+SET deleted_at = clock_timestamp(),
+    updated_at = clock_timestamp()
+WHERE id = @id
+  AND organization_id = @organization_id
+  AND deleted IS FALSE
+RETURNING *;
+
+-- name: EnqueueWebhookDeliveries :execrows
+-- Fans one event out to every live endpoint of the organization that
+-- subscribes to its type. An endpoint with no event types subscribes to all
+-- of them. The unique (endpoint_id, event_id) key makes a Pub/Sub redelivery
+-- of the same event a no-op rather than a second webhook.
+INSERT INTO webhook_deliveries (
+  organization_id,
+  endpoint_id,
+  event_id,
+  event_type,
+  payload,
+  next_attempt_at
+)
+SELECT
+  e.organization_id,
+  e.id,
+  @event_id::text,
+  @event_type::text,
+  @payload::bytea,
+  clock_timestamp()
+FROM webhook_endpoints e
+JOIN organization_metadata o ON o.id = e.organization_id
+WHERE e.organization_id = @organization_id
+  AND e.deleted IS FALSE
+  AND e.disabled_at IS NULL
+  AND o.disabled_at IS NULL
+  AND (cardinality(e.event_types) = 0 OR @event_type::text = ANY(e.event_types))
+ON CONFLICT (endpoint_id, event_id) DO NOTHING;
+
+-- name: ClaimDueWebhookDeliveries :many
+-- Claims a batch of due deliveries by pushing their next_attempt_at forward
+-- by a lease. SKIP LOCKED lets several dispatchers poll concurrently without
+-- sending the same delivery twice; a dispatcher that dies mid-send leaves the
+-- lease to expire and the delivery is claimed again. Disabling or deleting an
+-- endpoint dead-letters its pending deliveries; the endpoint checks here only
+-- cover a delivery enqueued concurrently with that.
+WITH due AS (
+  SELECT d.id
+  FROM webhook_deliveries d
+  JOIN webhook_endpoints we ON we.id = d.endpoint_id
+  WHERE d.status = 'pending'
+    AND d.next_attempt_at <= clock_timestamp()
+    AND we.deleted IS FALSE
+    AND we.disabled_at IS NULL
+  ORDER BY d.next_attempt_at ASC
+  LIMIT @batch_size::integer
+  FOR UPDATE OF d SKIP LOCKED
+)
+UPDATE webhook_deliveries wd
+SET next_attempt_at = clock_timestamp() + make_interval(secs => @lease_seconds::integer),
+    updated_at = clock_timestamp()
+FROM due, webhook_endpoints e
+WHERE wd.id = due.id
+  AND e.id = wd.endpoint_id
+RETURNING
+  wd.id,
+  wd.organization_id,
+  wd.endpoint_id,
+  wd.event_id,
+  wd.event_type,
+  wd.payload,
+  wd.attempts,
+  e.url,
+  e.secret_encrypted;
+
+-- name: RecordWebhookDeliveryAttempt :exec
+INSERT INTO webhook_delivery_attempts (
+  delivery_id,
+  attempt,
+  response_status,
+  error,
+  duration_ms
+) VALUES (
+  @delivery_id,
+  @attempt,
+  @response_status,
+  @error,
+  @duration_ms
+);
+
+-- name: UpdateWebhookDeliveryOutcome :exec
+UPDATE webhook_deliveries
+SET status = @status,
+    attempts = @attempts,
+    next_attempt_at = sqlc.narg(next_attempt_at),
+    last_attempt_at = clock_timestamp(),
+    updated_at = clock_timestamp()
+WHERE id = @id
+  AND status = 'pending';
+
+-- name: ListWebhookDeliveries :many
+SELECT *
+FROM webhook_deliveries
+WHERE endpoint_id = @endpoint_id
+  AND organization_id = @organization_id
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+  AND (sqlc.narg(cursor)::uuid IS NULL OR id < sqlc.narg(cursor)::uuid)
+ORDER BY id DESC
+LIMIT @page_limit::integer;
+
+-- name: ListWebhookDeliveryAttempts :many
+SELECT *
+FROM webhook_delivery_attempts
+WHERE delivery_id = ANY(@delivery_ids::uuid[])
+ORDER BY delivery_id ASC, attempt ASC;
+
+-- name: RedeliverWebhookDelivery :one
+-- Queues one more attempt for a delivery that has already succeeded or been
+-- dead-lettered. The attempt count carries on, so a redelivery that fails
+-- returns straight to the dead-letter state rather than starting a fresh
+-- retry schedule.
+UPDATE webhook_deliveries
+SET status = 'pending',
+    next_attempt_at = clock_timestamp(),
+    updated_at = clock_timestamp()
+WHERE id = @id
+  AND organization_id = @organization_id
+  AND status <> 'pending'
+  AND endpoint_id IN (
+    SELECT id
+    FROM webhook_endpoints
+    WHERE organization_id = @organization_id
+      AND deleted IS FALSE
+      AND disabled_at IS NULL
+  )
+RETURNING *;
+
+-- name: DeadLetterPendingWebhookDeliveries :execrows
+-- Settles the pending deliveries of an endpoint that was just disabled or
+-- deleted. They would otherwise sit pending forever, since the dispatcher
+-- never claims them; dead-lettered, they can be redelivered once the endpoint
+-- is enabled again.
+UPDATE webhook_deliveries
+SET status = 'dead_lettered',
+    next_attempt_at = NULL,
+    updated_at = clock_timestamp()
+WHERE endpoint_id = @endpoint_id
+  AND organization_id = @organization_id
+  AND status = 'pending';
@@ -0,0 +1,131 @@
+package selfhosted_test
+
+import (
+	"context"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+
+	webhooksv1 "github.com/speakeasy-api/gram/infra/gen/gram/webhooks/v1"
+	"github.com/speakeasy-api/gram/infra/pkg/gcp"
+	"github.com/speakeasy-api/gram/server/internal/audit"
+	"github.com/speakeasy-api/gram/server/internal/auth/sessions"
+	"github.com/speakeasy-api/gram/server/internal/authz"
+	"github.com/speakeasy-api/gram/server/internal/authztest"
+	"github.com/speakeasy-api/gram/server/internal/billing"
+	"github.com/speakeasy-api/gram/server/internal/cache"
+	"github.com/speakeasy-api/gram/server/internal/guardian"
+	"github.com/speakeasy-api/gram/server/internal/oops"
+	"github.com/speakeasy-api/gram/server/internal/testenv"
+	"github.com/speakeasy-api/gram/server/internal/thirdparty/workos"
+	"github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted"
+)
+
+var (
+	infra *testenv.Environment
+)
+
+func TestMain(m *testing.M) {
+	res, cleanup, err := testenv.Launch(context.Background(), testenv.LaunchOptions{Postgres: true, Redis: true, ClickHouse: true})
+	if err != nil {
+		log.Fatalf("Failed to launch test infrastructure: %v", err)
+		os.Exit(1)
+	}
+
+	infra = res
+
+	code := m.Run()
+
+	if err := cleanup(); err != nil {
+		log.Fatalf("Failed to cleanup test infrastructure: %v", err)
+		os.Exit(1)
+	}
+
+	os.Exit(code)
+}
+
+type testInstance struct {
+	service        *selfhosted.Service
+	handler        *selfhosted.Handler
+	dispatcher     *selfhosted.Dispatcher
+	conn           *pgxpool.Pool
+	sessionManager *sessions.Manager
+}
+
+func newTestService(t *testing.T) (context.Context, *testInstance) {
+	t.Helper()
+
+	ctx := t.Context()
+
+	logger := testenv.NewLogger(t)
+	tracerProvider := testenv.NewTracerProvider(t)
+	meterProvider := testenv.NewMeterProvider(t)
+	conn, err := infra.CloneTestDatabase(t, "testdb")
+	require.NoError(t, err)
+
+	redisClient, err := infra.NewRedisClient(t, 0)
+	require.NoError(t, err)
+
+	billingClient := billing.NewStubClient(logger, tracerProvider)
+	sessionManager := testenv.NewTestManager(t, logger, tracerProvider, conn, redisClient, cache.Suffix("gram-local"), billingClient)
+
+	ctx = authztest.InitAuthContext(t, ctx, conn, sessionManager)
+
+	authzEngine := authz.NewEngine(logger, conn, authztest.ChallengeLoggingAlwaysDisabled, workos.NewStubClient())
+	auditLogger := audit.NewLogger()
+	enc := testenv.NewEncryptionClient(t)
+
+	// Endpoints in these tests are httptest servers on loopback, which the
+	// default policy blocks.
+	policy, err := guardian.NewUnsafePolicy(tracerProvider, []string{})
+	require.NoError(t, err)
+
+	return ctx, &testInstance{
+		service:        selfhosted.NewService(logger, tracerProvider, conn, sessionManager, authzEngine, auditLogger, enc, policy),
+		handler:        selfhosted.NewHandler(logger, meterProvider, conn),
+		dispatcher:     selfhosted.NewDispatcher(logger, tracerProvider, meterProvider, conn, enc, policy),
+		conn:           conn,
+		sessionManager: sessionManager,
+	}
+}
+
+func requireOopsCode(t *testing.T, err error, code oops.Code) {
+	t.Helper()
+
+	var oopsErr *oops.ShareableError
+	require.ErrorAs(t, err, &oopsErr)
+	require.Equal(t, code, oopsErr.Code)
+}
+
+func newEvent(orgID, eventID, eventType string, payload []byte) *webhooksv1.Event {
+	createdAt := time.Now().UTC().Format(time.RFC3339)
+
+	return webhooksv1.Event_builder{
+		EventId:        &eventID,
+		OrganizationId: &orgID,
+		EventType:      &eventType,
+		Payload:        payload,
+		CreatedAt:      &createdAt,
+	}.Build()
+}
+
+func testMetadata() gcp.MessageMetadata {
+	return gcp.MessageMetadata{
+		ID:              "message-id",
+		Attributes:      nil,
+		DeliveryAttempt: nil,
+	}
+}
+
+// makeDue pulls every pending delivery's next attempt forward to now so a
+// test can step through the retry schedule without waiting it out.
+func makeDue(t *testing.T, conn *pgxpool.Pool) {
+	t.Helper()
+
+	_, err := conn.Exec(t.Context(), `UPDATE webhook_deliveries SET next_attempt_at = clock_timestamp() WHERE status = 'pending'`)
+	require.NoError(t, err)
+}
@@ -0,0 +1,51 @@
+package selfhosted_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/speakeasy-api/gram/server/internal/webhooks/selfhosted"
+)
+
+func TestSign_StandardWebhooksVector(t *testing.T) {
+	t.Parallel()
+
+	// The reference vector from the Standard Webhooks specification, so any
+	// conforming receiver library verifies what the dispatcher sends.
+	sig, err := selfhosted.Sign(
+		"whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw",
+		"msg_p5jXN8AQM9LWM0D4loKWxJek",
+		time.Unix(1614265330, 0),
+		[]byte(`{"test": 2432232314}`),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "v1,g0hM9SsE+OTPJTGt/tmIKtSyZlE3uFJELVlNIOLJ1OE=", sig)
+}
+
+func TestSign_RejectsMalformedSecret(t *testing.T) {
+	t.Parallel()
+
+	_, err := selfhosted.Sign("whsec_not base64!", "msg_1", time.Unix(0, 0), []byte(`{}`))
+	require.Error(t, err)
+
+	_, err = selfhosted.Sign("whsec_", "msg_1", time.Unix(0, 0), []byte(`{}`))
+	require.Error(t, err)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	t.Parallel()
+
+	a, err := selfhosted.GenerateSecret()
+	require.NoError(t, err)
+	b, err := selfhosted.GenerateSecret()
+	require.NoError(t, err)
+
+	require.True(t, strings.HasPrefix(a, "whsec_"))
+	require.NotEqual(t, a, b)
+
+	_, err = selfhosted.Sign(a, "msg_1", time.Unix(0, 0), []byte(`{}`))
+	require.NoError(t, err)
+}
@@ -0,0 +1,2 @@
+-- Modify "spend_rules" table
+ALTER TABLE "spend_rules" ADD COLUMN "scope_expr" text NOT NULL DEFAULT '';
@@ -0,0 +1,32 @@
+-- Create "tool_call_rate_limits" table
+CREATE TABLE "tool_call_rate_limits" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "project_id" uuid NOT NULL,
+  "toolset_id" uuid NULL,
+  "mcp_server_id" uuid NULL,
+  "subject" text NOT NULL,
+  "tool_name" text NULL,
+  "requests" integer NOT NULL,
+  "interval_seconds" integer NOT NULL,
+  "burst" integer NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "deleted_at" timestamptz NULL,
+  "deleted" boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tool_call_rate_limits_mcp_server_id_fkey" FOREIGN KEY ("mcp_server_id") REFERENCES "mcp_servers" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "tool_call_rate_limits_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "tool_call_rate_limits_toolset_id_fkey" FOREIGN KEY ("toolset_id") REFERENCES "toolsets" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "tool_call_rate_limits_burst_check" CHECK (burst > 0),
+  CONSTRAINT "tool_call_rate_limits_interval_seconds_check" CHECK ((interval_seconds > 0) AND (interval_seconds <= 86400)),
+  CONSTRAINT "tool_call_rate_limits_requests_check" CHECK (requests > 0),
+  CONSTRAINT "tool_call_rate_limits_subject_check" CHECK (subject = ANY (ARRAY['end_user'::text, 'api_key'::text, 'all'::text])),
+  CONSTRAINT "tool_call_rate_limits_target_exclusivity_check" CHECK (num_nonnulls(toolset_id, mcp_server_id) <= 1),
+  CONSTRAINT "tool_call_rate_limits_tool_name_check" CHECK ((tool_name IS NULL) OR ((tool_name <> ''::text) AND (char_length(tool_name) <= 128)))
+);
+-- Create index "tool_call_rate_limits_mcp_server_id_idx" to table: "tool_call_rate_limits"
+CREATE INDEX "tool_call_rate_limits_mcp_server_id_idx" ON "tool_call_rate_limits" ("mcp_server_id") WHERE ((deleted IS FALSE) AND (mcp_server_id IS NOT NULL));
+-- Create index "tool_call_rate_limits_project_id_idx" to table: "tool_call_rate_limits"
+CREATE INDEX "tool_call_rate_limits_project_id_idx" ON "tool_call_rate_limits" ("project_id") WHERE (deleted IS FALSE);
+-- Create index "tool_call_rate_limits_toolset_id_idx" to table: "tool_call_rate_limits"
+CREATE INDEX "tool_call_rate_limits_toolset_id_idx" ON "tool_call_rate_limits" ("toolset_id") WHERE ((deleted IS FALSE) AND (toolset_id IS NOT NULL));
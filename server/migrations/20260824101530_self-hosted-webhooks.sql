@@ -0,0 +1,57 @@
+-- Create "webhook_endpoints" table
+CREATE TABLE "webhook_endpoints" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "organization_id" text NOT NULL,
+  "url" text NOT NULL,
+  "description" text NULL,
+  "secret_encrypted" text NOT NULL,
+  "event_types" text[] NOT NULL DEFAULT '{}',
+  "disabled_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "deleted_at" timestamptz NULL,
+  "deleted" boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webhook_endpoints_organization_id_fkey" FOREIGN KEY ("organization_id") REFERENCES "organization_metadata" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "webhook_endpoints_description_check" CHECK ((description IS NULL) OR (char_length(description) <= 500)),
+  CONSTRAINT "webhook_endpoints_url_check" CHECK ((url <> ''::text) AND (char_length(url) <= 2048))
+);
+-- Create index "webhook_endpoints_organization_id_idx" to table: "webhook_endpoints"
+CREATE INDEX "webhook_endpoints_organization_id_idx" ON "webhook_endpoints" ("organization_id") WHERE (deleted IS FALSE);
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "organization_id" text NOT NULL,
+  "endpoint_id" uuid NOT NULL,
+  "event_id" text NOT NULL,
+  "event_type" text NOT NULL,
+  "payload" bytea NOT NULL,
+  "status" text NOT NULL DEFAULT 'pending',
+  "attempts" integer NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NULL,
+  "last_attempt_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webhook_deliveries_endpoint_id_event_id_key" UNIQUE ("endpoint_id", "event_id"),
+  CONSTRAINT "webhook_deliveries_endpoint_id_fkey" FOREIGN KEY ("endpoint_id") REFERENCES "webhook_endpoints" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "webhook_deliveries_status_check" CHECK (status = ANY (ARRAY['pending'::text, 'succeeded'::text, 'dead_lettered'::text]))
+);
+-- Create index "webhook_deliveries_due_idx" to table: "webhook_deliveries"
+CREATE INDEX "webhook_deliveries_due_idx" ON "webhook_deliveries" ("next_attempt_at") WHERE (status = 'pending'::text);
+-- Create index "webhook_deliveries_endpoint_id_idx" to table: "webhook_deliveries"
+CREATE INDEX "webhook_deliveries_endpoint_id_idx" ON "webhook_deliveries" ("endpoint_id", "id" DESC);
+-- Create "webhook_delivery_attempts" table
+CREATE TABLE "webhook_delivery_attempts" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "delivery_id" uuid NOT NULL,
+  "attempt" integer NOT NULL,
+  "response_status" integer NULL,
+  "error" text NULL,
+  "duration_ms" integer NOT NULL,
+  "attempted_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webhook_delivery_attempts_delivery_id_fkey" FOREIGN KEY ("delivery_id") REFERENCES "webhook_deliveries" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "webhook_delivery_attempts_delivery_id_idx" to table: "webhook_delivery_attempts"
+CREATE INDEX "webhook_delivery_attempts_delivery_id_idx" ON "webhook_delivery_attempts" ("delivery_id", "attempt");
@@ -0,0 +1,10 @@
+-- Create "tool_error_rate_spikes" table
+CREATE TABLE "tool_error_rate_spikes" (
+  "project_id" uuid NOT NULL,
+  "tool_urn" text NOT NULL,
+  "last_detected_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("project_id", "tool_urn"),
+  CONSTRAINT "tool_error_rate_spikes_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
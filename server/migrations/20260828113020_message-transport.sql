@@ -0,0 +1,25 @@
+-- Create "transport_deliveries" table
+CREATE TABLE "transport_deliveries" (
+  "id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+  "message_id" uuid NOT NULL,
+  "topic" text NOT NULL,
+  "subscription" text NOT NULL,
+  "data" bytea NOT NULL,
+  "attributes" jsonb NOT NULL DEFAULT '{}',
+  "attempts" integer NOT NULL DEFAULT 0,
+  "visible_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "lease_token" uuid NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("id")
+) WITH (fillfactor = 80, autovacuum_vacuum_scale_factor = 0.05, autovacuum_analyze_scale_factor = 0.05, autovacuum_vacuum_insert_scale_factor = 0.05);
+-- Create index "transport_deliveries_subscription_id_idx" to table: "transport_deliveries"
+CREATE INDEX "transport_deliveries_subscription_id_idx" ON "transport_deliveries" ("topic", "subscription", "id");
+-- Set comment to column: "visible_at" on table: "transport_deliveries"
+COMMENT ON COLUMN "transport_deliveries"."visible_at" IS 'When the delivery may next be claimed: pushed forward by the claim lease and by nack back-off.';
+-- Create "transport_subscriptions" table
+CREATE TABLE "transport_subscriptions" (
+  "topic" text NOT NULL,
+  "subscription" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("topic", "subscription")
+);
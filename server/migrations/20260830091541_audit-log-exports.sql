@@ -0,0 +1,22 @@
+-- Create "audit_log_exports" table
+CREATE TABLE "audit_log_exports" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "organization_id" text NOT NULL,
+  "enabled" boolean NOT NULL DEFAULT true,
+  "format" text NOT NULL,
+  "prefix" text NOT NULL DEFAULT '',
+  "cursor_seq" bigint NOT NULL DEFAULT 0,
+  "last_exported_at" timestamptz NULL,
+  "last_object_key" text NULL,
+  "last_error" text NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "deleted_at" timestamptz NULL,
+  "deleted" boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "audit_log_exports_organization_id_fkey" FOREIGN KEY ("organization_id") REFERENCES "organization_metadata" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "audit_log_exports_format_check" CHECK (format = ANY (ARRAY['ndjson'::text, 'parquet'::text, 'ocsf'::text, 'cef'::text])),
+  CONSTRAINT "audit_log_exports_prefix_check" CHECK (char_length(prefix) <= 256)
+);
+-- Create index "audit_log_exports_organization_id_key" to table: "audit_log_exports"
+CREATE UNIQUE INDEX "audit_log_exports_organization_id_key" ON "audit_log_exports" ("organization_id") WHERE (deleted IS FALSE);
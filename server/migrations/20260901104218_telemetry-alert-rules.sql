@@ -0,0 +1,36 @@
+-- Create "telemetry_alert_rules" table
+CREATE TABLE "telemetry_alert_rules" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "project_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "toolset_id" uuid NULL,
+  "tool_urn" text NULL,
+  "metric" text NOT NULL,
+  "threshold" double precision NOT NULL,
+  "window_seconds" integer NOT NULL,
+  "min_calls" integer NOT NULL DEFAULT 10,
+  "email_recipients" text[] NOT NULL DEFAULT ARRAY[]::text[],
+  "enabled" boolean NOT NULL DEFAULT true,
+  "state" text NOT NULL DEFAULT 'ok',
+  "incident_id" uuid NULL,
+  "state_changed_at" timestamptz NULL,
+  "last_value" double precision NULL,
+  "last_evaluated_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "deleted_at" timestamptz NULL,
+  "deleted" boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "telemetry_alert_rules_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "telemetry_alert_rules_toolset_id_fkey" FOREIGN KEY ("toolset_id") REFERENCES "toolsets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "telemetry_alert_rules_email_recipients_check" CHECK (array_length(email_recipients, 1) <= 20),
+  CONSTRAINT "telemetry_alert_rules_metric_check" CHECK (metric = ANY (ARRAY['error_rate'::text, 'latency_p50'::text, 'latency_p90'::text, 'latency_p95'::text, 'latency_p99'::text])),
+  CONSTRAINT "telemetry_alert_rules_min_calls_check" CHECK (min_calls > 0),
+  CONSTRAINT "telemetry_alert_rules_name_check" CHECK ((name <> ''::text) AND (char_length(name) <= 100)),
+  CONSTRAINT "telemetry_alert_rules_state_check" CHECK (state = ANY (ARRAY['ok'::text, 'firing'::text])),
+  CONSTRAINT "telemetry_alert_rules_threshold_check" CHECK (threshold > (0)::double precision),
+  CONSTRAINT "telemetry_alert_rules_tool_urn_check" CHECK ((tool_urn IS NULL) OR ((tool_urn <> ''::text) AND (char_length(tool_urn) <= 500))),
+  CONSTRAINT "telemetry_alert_rules_window_seconds_check" CHECK ((window_seconds >= 60) AND (window_seconds <= 86400))
+);
+-- Create index "telemetry_alert_rules_project_id_idx" to table: "telemetry_alert_rules"
+CREATE INDEX "telemetry_alert_rules_project_id_idx" ON "telemetry_alert_rules" ("project_id") WHERE (deleted IS FALSE);
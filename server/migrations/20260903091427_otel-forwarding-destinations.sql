@@ -0,0 +1,23 @@
+-- Create "otel_forwarding_destinations" table
+CREATE TABLE "otel_forwarding_destinations" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "organization_id" text NOT NULL,
+  "name" text NOT NULL,
+  "endpoint_url" text NOT NULL,
+  "protocol" text NOT NULL DEFAULT 'http/protobuf',
+  "headers_encrypted" text NULL,
+  "enabled" boolean NOT NULL DEFAULT true,
+  "sampling_ratio" double precision NOT NULL DEFAULT 1,
+  "filters" jsonb NOT NULL DEFAULT '{}',
+  "scrub_rules" jsonb NOT NULL DEFAULT '[]',
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "deleted_at" timestamptz NULL,
+  "deleted" boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "otel_forwarding_destinations_name_check" CHECK ((name <> ''::text) AND (char_length(name) <= 100)),
+  CONSTRAINT "otel_forwarding_destinations_protocol_check" CHECK (protocol = ANY (ARRAY['http/protobuf'::text, 'http/json'::text, 'grpc'::text])),
+  CONSTRAINT "otel_forwarding_destinations_sampling_ratio_check" CHECK ((sampling_ratio >= (0)::double precision) AND (sampling_ratio <= (1)::double precision))
+);
+-- Create index "otel_forwarding_destinations_org_name_key" to table: "otel_forwarding_destinations"
+CREATE UNIQUE INDEX "otel_forwarding_destinations_org_name_key" ON "otel_forwarding_destinations" ("organization_id", "name") WHERE (deleted IS FALSE);
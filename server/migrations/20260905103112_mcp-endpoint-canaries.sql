@@ -0,0 +1,28 @@
+-- Create "mcp_endpoint_canaries" table
+CREATE TABLE "mcp_endpoint_canaries" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "project_id" uuid NOT NULL,
+  "mcp_endpoint_id" uuid NOT NULL,
+  "baseline_deployment_id" uuid NOT NULL,
+  "candidate_deployment_id" uuid NULL,
+  "percentage" integer NOT NULL,
+  "sticky_by" text NOT NULL DEFAULT 'session',
+  "status" text NOT NULL DEFAULT 'running',
+  "started_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "ended_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "mcp_endpoint_canaries_baseline_deployment_id_fkey" FOREIGN KEY ("baseline_deployment_id") REFERENCES "deployments" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "mcp_endpoint_canaries_candidate_deployment_id_fkey" FOREIGN KEY ("candidate_deployment_id") REFERENCES "deployments" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "mcp_endpoint_canaries_mcp_endpoint_id_fkey" FOREIGN KEY ("mcp_endpoint_id") REFERENCES "mcp_endpoints" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "mcp_endpoint_canaries_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "mcp_endpoint_canaries_distinct_deployments_check" CHECK (baseline_deployment_id <> candidate_deployment_id),
+  CONSTRAINT "mcp_endpoint_canaries_percentage_check" CHECK ((percentage >= 0) AND (percentage <= 100)),
+  CONSTRAINT "mcp_endpoint_canaries_status_check" CHECK (status = ANY (ARRAY['running'::text, 'promoted'::text, 'aborted'::text])),
+  CONSTRAINT "mcp_endpoint_canaries_sticky_by_check" CHECK (sticky_by = ANY (ARRAY['session'::text, 'user'::text]))
+);
+-- Create index "mcp_endpoint_canaries_mcp_endpoint_id_idx" to table: "mcp_endpoint_canaries"
+CREATE INDEX "mcp_endpoint_canaries_mcp_endpoint_id_idx" ON "mcp_endpoint_canaries" ("mcp_endpoint_id", "created_at" DESC);
+-- Create index "mcp_endpoint_canaries_running_key" to table: "mcp_endpoint_canaries"
+CREATE UNIQUE INDEX "mcp_endpoint_canaries_running_key" ON "mcp_endpoint_canaries" ("mcp_endpoint_id") WHERE (status = 'running'::text);
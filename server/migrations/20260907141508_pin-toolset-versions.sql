@@ -0,0 +1,4 @@
+-- Modify "mcp_endpoints" table
+ALTER TABLE "mcp_endpoints" ADD CONSTRAINT "mcp_endpoints_toolset_version_check" CHECK ((toolset_version IS NULL) OR (toolset_version > 0)), ADD CONSTRAINT "mcp_endpoints_toolset_version_requires_mcp_server_check" CHECK ((toolset_version IS NULL) OR (mcp_server_id IS NOT NULL)), ADD COLUMN "toolset_version" bigint NULL;
+-- Modify "mcp_servers" table
+ALTER TABLE "mcp_servers" ADD CONSTRAINT "mcp_servers_toolset_version_check" CHECK ((toolset_version IS NULL) OR (toolset_version > 0)), ADD CONSTRAINT "mcp_servers_toolset_version_requires_toolset_check" CHECK ((toolset_version IS NULL) OR (toolset_id IS NOT NULL)), ADD COLUMN "toolset_version" bigint NULL;
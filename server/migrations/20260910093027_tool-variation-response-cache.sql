@@ -0,0 +1,2 @@
+-- Modify "tool_variations" table
+ALTER TABLE "tool_variations" ADD CONSTRAINT "tool_variations_cache_ttl_seconds_check" CHECK ((cache_ttl_seconds IS NULL) OR ((cache_ttl_seconds > 0) AND (cache_ttl_seconds <= 86400))), ADD COLUMN "cache_ttl_seconds" integer NULL, ADD COLUMN "cache_shared" boolean NULL;
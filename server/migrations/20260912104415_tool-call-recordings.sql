@@ -0,0 +1,36 @@
+-- Create "tool_call_recording_sessions" table
+CREATE TABLE "tool_call_recording_sessions" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "project_id" uuid NOT NULL,
+  "toolset_id" uuid NOT NULL,
+  "max_calls" integer NOT NULL,
+  "recorded_calls" integer NOT NULL DEFAULT 0,
+  "expires_at" timestamptz NOT NULL,
+  "stopped_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tool_call_recording_sessions_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_call_recording_sessions_toolset_id_fkey" FOREIGN KEY ("toolset_id") REFERENCES "toolsets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_call_recording_sessions_max_calls_check" CHECK ((max_calls > 0) AND (max_calls <= 1000)),
+  CONSTRAINT "tool_call_recording_sessions_recorded_calls_check" CHECK (recorded_calls >= 0)
+);
+-- Create index "tool_call_recording_sessions_active_idx" to table: "tool_call_recording_sessions"
+CREATE INDEX "tool_call_recording_sessions_active_idx" ON "tool_call_recording_sessions" ("toolset_id") WHERE (stopped_at IS NULL);
+-- Create index "tool_call_recording_sessions_project_id_idx" to table: "tool_call_recording_sessions"
+CREATE INDEX "tool_call_recording_sessions_project_id_idx" ON "tool_call_recording_sessions" ("project_id", "created_at" DESC);
+-- Create "tool_call_recordings" table
+CREATE TABLE "tool_call_recordings" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "session_id" uuid NOT NULL,
+  "project_id" uuid NOT NULL,
+  "tool_urn" text NOT NULL,
+  "tool_name" text NOT NULL,
+  "recording" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tool_call_recordings_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_call_recordings_session_id_fkey" FOREIGN KEY ("session_id") REFERENCES "tool_call_recording_sessions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "tool_call_recordings_session_id_idx" to table: "tool_call_recordings"
+CREATE INDEX "tool_call_recordings_session_id_idx" ON "tool_call_recordings" ("session_id", "id");
@@ -0,0 +1,18 @@
+-- Create "http_server_candidates" table
+CREATE TABLE "http_server_candidates" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "deployment_id" uuid NOT NULL,
+  "project_id" uuid NOT NULL,
+  "openapiv3_document_id" uuid NOT NULL,
+  "server_env_var" text NOT NULL,
+  "server_urls" text[] NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "http_server_candidates_deployment_id_fkey" FOREIGN KEY ("deployment_id") REFERENCES "deployments" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "http_server_candidates_openapiv3_document_id_fkey" FOREIGN KEY ("openapiv3_document_id") REFERENCES "deployments_openapiv3_assets" ("id") ON UPDATE NO ACTION ON DELETE RESTRICT,
+  CONSTRAINT "http_server_candidates_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "http_server_candidates_server_env_var_check" CHECK (server_env_var <> ''::text),
+  CONSTRAINT "http_server_candidates_server_urls_check" CHECK (cardinality(server_urls) > 0)
+);
+-- Create index "http_server_candidates_deployment_env_var_key" to table: "http_server_candidates"
+CREATE UNIQUE INDEX "http_server_candidates_deployment_env_var_key" ON "http_server_candidates" ("deployment_id", "server_env_var");
@@ -0,0 +1,2 @@
+-- Modify "tool_variations" table
+ALTER TABLE "tool_variations" ADD CONSTRAINT "tool_variations_argument_bindings_check" CHECK ((argument_bindings IS NULL) OR (jsonb_typeof(argument_bindings) = 'array'::text)), ADD COLUMN "argument_bindings" jsonb NULL;
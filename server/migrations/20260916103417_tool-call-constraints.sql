@@ -0,0 +1,30 @@
+-- Create "tool_call_constraints" table
+CREATE TABLE "tool_call_constraints" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "project_id" uuid NOT NULL,
+  "toolset_id" uuid NULL,
+  "mcp_server_id" uuid NULL,
+  "tool_name" text NOT NULL,
+  "effect" text NOT NULL,
+  "expression" text NOT NULL,
+  "message" text NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "deleted_at" timestamptz NULL,
+  "deleted" boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tool_call_constraints_mcp_server_id_fkey" FOREIGN KEY ("mcp_server_id") REFERENCES "mcp_servers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_call_constraints_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_call_constraints_toolset_id_fkey" FOREIGN KEY ("toolset_id") REFERENCES "toolsets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_call_constraints_effect_check" CHECK (effect = ANY (ARRAY['allow'::text, 'deny'::text])),
+  CONSTRAINT "tool_call_constraints_expression_check" CHECK ((expression <> ''::text) AND (char_length(expression) <= 4096)),
+  CONSTRAINT "tool_call_constraints_message_check" CHECK ((message IS NULL) OR (char_length(message) <= 1000)),
+  CONSTRAINT "tool_call_constraints_target_exclusivity_check" CHECK (num_nonnulls(toolset_id, mcp_server_id) = 1),
+  CONSTRAINT "tool_call_constraints_tool_name_check" CHECK ((tool_name <> ''::text) AND (char_length(tool_name) <= 128))
+);
+-- Create index "tool_call_constraints_mcp_server_id_idx" to table: "tool_call_constraints"
+CREATE INDEX "tool_call_constraints_mcp_server_id_idx" ON "tool_call_constraints" ("mcp_server_id", "tool_name") WHERE ((deleted IS FALSE) AND (mcp_server_id IS NOT NULL));
+-- Create index "tool_call_constraints_project_id_idx" to table: "tool_call_constraints"
+CREATE INDEX "tool_call_constraints_project_id_idx" ON "tool_call_constraints" ("project_id") WHERE (deleted IS FALSE);
+-- Create index "tool_call_constraints_toolset_id_idx" to table: "tool_call_constraints"
+CREATE INDEX "tool_call_constraints_toolset_id_idx" ON "tool_call_constraints" ("toolset_id", "tool_name") WHERE ((deleted IS FALSE) AND (toolset_id IS NOT NULL));
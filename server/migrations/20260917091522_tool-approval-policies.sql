@@ -0,0 +1,74 @@
+-- Create "tool_approval_policies" table
+CREATE TABLE "tool_approval_policies" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "project_id" uuid NOT NULL,
+  "toolset_id" uuid NULL,
+  "mcp_server_id" uuid NULL,
+  "tool_name" text NULL,
+  "match_destructive" boolean NOT NULL DEFAULT false,
+  "expression" text NULL,
+  "message" text NULL,
+  "notify_emails" text[] NOT NULL DEFAULT '{}',
+  "slack_webhook_url_encrypted" text NULL,
+  "timeout_seconds" integer NOT NULL DEFAULT 300,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "deleted_at" timestamptz NULL,
+  "deleted" boolean NOT NULL GENERATED ALWAYS AS (deleted_at IS NOT NULL) STORED,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tool_approval_policies_mcp_server_id_fkey" FOREIGN KEY ("mcp_server_id") REFERENCES "mcp_servers" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_approval_policies_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_approval_policies_toolset_id_fkey" FOREIGN KEY ("toolset_id") REFERENCES "toolsets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_approval_policies_expression_check" CHECK ((expression IS NULL) OR ((expression <> ''::text) AND (char_length(expression) <= 4096))),
+  CONSTRAINT "tool_approval_policies_matcher_check" CHECK (match_destructive OR (expression IS NOT NULL)),
+  CONSTRAINT "tool_approval_policies_message_check" CHECK ((message IS NULL) OR (char_length(message) <= 1000)),
+  CONSTRAINT "tool_approval_policies_notify_emails_check" CHECK (cardinality(notify_emails) <= 20),
+  CONSTRAINT "tool_approval_policies_target_exclusivity_check" CHECK (num_nonnulls(toolset_id, mcp_server_id) = 1),
+  CONSTRAINT "tool_approval_policies_timeout_seconds_check" CHECK ((timeout_seconds >= 10) AND (timeout_seconds <= 900)),
+  CONSTRAINT "tool_approval_policies_tool_name_check" CHECK ((tool_name IS NULL) OR ((tool_name <> ''::text) AND (char_length(tool_name) <= 128)))
+);
+-- Create index "tool_approval_policies_mcp_server_id_idx" to table: "tool_approval_policies"
+CREATE INDEX "tool_approval_policies_mcp_server_id_idx" ON "tool_approval_policies" ("mcp_server_id") WHERE ((deleted IS FALSE) AND (mcp_server_id IS NOT NULL));
+-- Create index "tool_approval_policies_project_id_idx" to table: "tool_approval_policies"
+CREATE INDEX "tool_approval_policies_project_id_idx" ON "tool_approval_policies" ("project_id") WHERE (deleted IS FALSE);
+-- Create index "tool_approval_policies_toolset_id_idx" to table: "tool_approval_policies"
+CREATE INDEX "tool_approval_policies_toolset_id_idx" ON "tool_approval_policies" ("toolset_id") WHERE ((deleted IS FALSE) AND (toolset_id IS NOT NULL));
+-- Create "tool_call_approvals" table
+CREATE TABLE "tool_call_approvals" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "project_id" uuid NOT NULL,
+  "policy_id" uuid NOT NULL,
+  "toolset_id" uuid NULL,
+  "mcp_server_id" uuid NULL,
+  "tool_name" text NOT NULL,
+  "arguments" jsonb NOT NULL DEFAULT '{}',
+  "channel" text NOT NULL,
+  "status" text NOT NULL DEFAULT 'pending',
+  "session_id_hash" text NULL,
+  "requested_by" text NULL,
+  "decided_by" text NULL,
+  "decided_at" timestamptz NULL,
+  "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tool_call_approvals_policy_id_fkey" FOREIGN KEY ("policy_id") REFERENCES "tool_approval_policies" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_call_approvals_project_id_fkey" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_call_approvals_channel_check" CHECK (channel = ANY (ARRAY['elicitation'::text, 'link'::text])),
+  CONSTRAINT "tool_call_approvals_status_check" CHECK (status = ANY (ARRAY['pending'::text, 'approved'::text, 'denied'::text, 'expired'::text]))
+);
+-- Create index "tool_call_approvals_project_id_created_at_idx" to table: "tool_call_approvals"
+CREATE INDEX "tool_call_approvals_project_id_created_at_idx" ON "tool_call_approvals" ("project_id", "created_at" DESC);
+-- Create "tool_call_approval_links" table
+CREATE TABLE "tool_call_approval_links" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "approval_id" uuid NOT NULL,
+  "approver" text NOT NULL,
+  "token_hash" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "tool_call_approval_links_approval_id_fkey" FOREIGN KEY ("approval_id") REFERENCES "tool_call_approvals" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "tool_call_approval_links_approver_check" CHECK (approver <> ''::text)
+);
+-- Create index "tool_call_approval_links_approval_id_token_hash_key" to table: "tool_call_approval_links"
+CREATE UNIQUE INDEX "tool_call_approval_links_approval_id_token_hash_key" ON "tool_call_approval_links" ("approval_id", "token_hash");
@@ -0,0 +1,18 @@
+-- Create "organization_data_keys" table
+CREATE TABLE "organization_data_keys" (
+  "id" uuid NOT NULL DEFAULT generate_uuidv7(),
+  "organization_id" text NOT NULL,
+  "kms_key_name" text NOT NULL,
+  "external_credential_id" uuid NULL,
+  "wrapped_key" bytea NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "shredded_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "organization_data_keys_external_credential_id_fkey" FOREIGN KEY ("external_credential_id") REFERENCES "external_credentials" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "organization_data_keys_organization_id_fkey" FOREIGN KEY ("organization_id") REFERENCES "organization_metadata" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "organization_data_keys_kms_key_name_check" CHECK ((kms_key_name <> ''::text) AND (char_length(kms_key_name) <= 512)),
+  CONSTRAINT "organization_data_keys_shredded_check" CHECK ((shredded_at IS NULL) = (wrapped_key IS NOT NULL))
+);
+-- Create index "organization_data_keys_organization_id_key" to table: "organization_data_keys"
+CREATE UNIQUE INDEX "organization_data_keys_organization_id_key" ON "organization_data_keys" ("organization_id") WHERE (shredded_at IS NULL);
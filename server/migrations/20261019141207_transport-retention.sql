@@ -0,0 +1,6 @@
+-- Modify "transport_deliveries" table
+ALTER TABLE "transport_deliveries" ADD COLUMN "expires_at" timestamptz NULL;
+-- Create index "transport_deliveries_expires_at_idx" to table: "transport_deliveries"
+CREATE INDEX "transport_deliveries_expires_at_idx" ON "transport_deliveries" ("expires_at") WHERE (expires_at IS NOT NULL);
+-- Modify "transport_subscriptions" table
+ALTER TABLE "transport_subscriptions" ADD COLUMN "retention" interval NULL;
@@ -0,0 +1,6 @@
+-- Modify "audit_log_exports" table
+ALTER TABLE "audit_log_exports" DROP CONSTRAINT "audit_log_exports_organization_id_fkey", ADD COLUMN "cursor_tx_id" bigint NULL, ADD CONSTRAINT "audit_log_exports_organization_id_fkey" FOREIGN KEY ("organization_id") REFERENCES "organization_metadata" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Modify "audit_logs" table
+ALTER TABLE "audit_logs" ADD COLUMN "tx_id" bigint NULL DEFAULT ((pg_current_xact_id())::text)::bigint;
+-- Create index "audit_logs_organization_id_tx_id_seq_idx" to table: "audit_logs"
+CREATE INDEX "audit_logs_organization_id_tx_id_seq_idx" ON "audit_logs" ("organization_id", "tx_id", "seq");
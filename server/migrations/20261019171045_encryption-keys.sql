@@ -0,0 +1,9 @@
+-- Create "encryption_keys" table
+CREATE TABLE "encryption_keys" (
+  "id" text NOT NULL,
+  "last_primary_at" timestamptz NULL,
+  "reencrypted_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  "updated_at" timestamptz NOT NULL DEFAULT clock_timestamp(),
+  PRIMARY KEY ("id")
+);